@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+const (
+	defaultMaxBodySize       = 10 << 20 // 10 MB
+	defaultUploadMaxBodySize = 2 << 30  // 2 GB
+	defaultISOMaxBodySize    = 10 << 30 // 10 GB
+
+	// uploadFilePath takes game server file uploads, which are far larger than RPC payloads
+	uploadFilePath = "/internal/gameservers/upload-file"
+	// isoUploadPath takes VPS install ISOs; the VPS service enforces each organization's ISO quota
+	isoUploadPath = "/vps/isos/upload"
+)
+
+var errBodyTooLarge = errors.New("request body too large")
+
+// bodyLimits holds the maximum request body size for each route
+type bodyLimits struct {
+	routes       map[string]int64 // Path prefix -> limit in bytes
+	defaultLimit int64            // Routes without their own limit
+	uploadLimit  int64            // uploadFilePath
+	isoLimit     int64            // isoUploadPath
+}
+
+// newBodyLimits reads per-route limits from MAX_BODY_SIZE_BYTES, a JSON object of path prefix to
+// bytes (e.g. {"/obiente.cloud.billing.v1.BillingService/":1048576}), and the upload limits from
+// UPLOAD_MAX_BODY_BYTES and ISO_UPLOAD_MAX_BODY_BYTES
+func newBodyLimits() *bodyLimits {
+	limits := &bodyLimits{
+		routes:       make(map[string]int64),
+		defaultLimit: defaultMaxBodySize,
+		uploadLimit:  int64(envInt("UPLOAD_MAX_BODY_BYTES", defaultUploadMaxBodySize)),
+		isoLimit:     int64(envInt("ISO_UPLOAD_MAX_BODY_BYTES", defaultISOMaxBodySize)),
+	}
+
+	if raw := strings.TrimSpace(os.Getenv("MAX_BODY_SIZE_BYTES")); raw != "" {
+		var routes map[string]int64
+		if err := json.Unmarshal([]byte(raw), &routes); err != nil {
+			logger.Warn("Invalid MAX_BODY_SIZE_BYTES, using the default limit for every route: %v", err)
+			return limits
+		}
+		for path, limit := range routes {
+			if limit <= 0 {
+				logger.Warn("Invalid MAX_BODY_SIZE_BYTES limit %d for %s, using the default", limit, path)
+				continue
+			}
+			limits.routes[path] = limit
+		}
+	}
+	return limits
+}
+
+// limitFor returns the body limit for a request path, matching the longest configured prefix.
+// A nil bodyLimits applies the default limit.
+func (l *bodyLimits) limitFor(path string) int64 {
+	if l == nil {
+		return defaultMaxBodySize
+	}
+	switch path {
+	case uploadFilePath:
+		return l.uploadLimit
+	case isoUploadPath:
+		return l.isoLimit
+	}
+	limit, matchedLen := l.defaultLimit, -1
+	for prefix, routeLimit := range l.routes {
+		if strings.HasPrefix(path, prefix) && len(prefix) > matchedLen {
+			limit, matchedLen = routeLimit, len(prefix)
+		}
+	}
+	return limit
+}
+
+// limitedBody passes a request body through until it exceeds limit bytes, then fails reads with
+// errBodyTooLarge
+type limitedBody struct {
+	body      io.ReadCloser
+	reader    io.Reader
+	remaining int64
+	exceeded  atomic.Bool
+}
+
+func newLimitedBody(body io.ReadCloser, limit int64) *limitedBody {
+	// Read one byte past the limit so a body of exactly limit bytes is allowed
+	return &limitedBody{body: body, reader: io.LimitReader(body, limit+1), remaining: limit}
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.reader.Read(p)
+	if int64(n) > b.remaining {
+		n = int(b.remaining)
+		b.remaining = 0
+		b.exceeded.Store(true)
+		return n, errBodyTooLarge
+	}
+	b.remaining -= int64(n)
+	return n, err
+}
+
+func (b *limitedBody) Close() error {
+	return b.body.Close()
+}
+
+// writeBodyTooLarge responds with 413 and a JSON error in the Connect error format
+func writeBodyTooLarge(w http.ResponseWriter, path string, limit int64) {
+	logger.Warn("[API Gateway] Rejected request to %s: body exceeds %d bytes", path, limit)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"code":        "resource_exhausted",
+		"message":     fmt.Sprintf("request body exceeds the %d byte limit", limit),
+		"limit_bytes": limit,
+	})
+}
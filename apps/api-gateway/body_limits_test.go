@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBodyLimitsFromEnv(t *testing.T) {
+	t.Setenv("MAX_BODY_SIZE_BYTES", `{"/obiente.cloud.billing.v1.BillingService/":1048576,"/obiente.cloud.billing.v1.BillingService/Upload":4096,"/bad/":-1}`)
+	t.Setenv("UPLOAD_MAX_BODY_BYTES", "")
+	t.Setenv("ISO_UPLOAD_MAX_BODY_BYTES", "")
+
+	limits := newBodyLimits()
+	tests := []struct {
+		path string
+		want int64
+	}{
+		{"/obiente.cloud.billing.v1.BillingService/GetInvoice", 1048576},
+		{"/obiente.cloud.billing.v1.BillingService/UploadReceipt", 4096},
+		{"/obiente.cloud.auth.v1.AuthService/Login", defaultMaxBodySize},
+		{"/bad/path", defaultMaxBodySize},
+		{uploadFilePath, defaultUploadMaxBodySize},
+		{isoUploadPath, defaultISOMaxBodySize},
+	}
+	for _, tt := range tests {
+		if got := limits.limitFor(tt.path); got != tt.want {
+			t.Errorf("limitFor(%q) = %d, want %d", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestServeHTTPBodyLimitBoundary(t *testing.T) {
+	var received int
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = len(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer backend.Close()
+
+	const route = "/obiente.cloud.billing.v1.BillingService/"
+	proxy := &ReverseProxy{
+		routes:     map[string]string{route: backend.URL},
+		upstreams:  newUpstreamPool([]string{backend.URL}),
+		bodyLimits: &bodyLimits{routes: map[string]int64{route: 16}, defaultLimit: 32, uploadLimit: 64},
+	}
+
+	tests := []struct {
+		name          string
+		size          int
+		unknownLength bool
+		want          int
+	}{
+		{"at limit", 16, false, http.StatusOK},
+		{"over limit", 17, false, http.StatusRequestEntityTooLarge},
+		{"streamed at limit", 16, true, http.StatusOK},
+		{"streamed over limit", 17, true, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = 0
+			req := httptest.NewRequest(http.MethodPost, route+"CreateCheckout", strings.NewReader(strings.Repeat("x", tt.size)))
+			if tt.unknownLength {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+			proxy.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d (body %q)", rec.Code, tt.want, rec.Body.String())
+			}
+			if tt.want == http.StatusOK && received != tt.size {
+				t.Fatalf("backend received %d bytes, want %d", received, tt.size)
+			}
+			if tt.want == http.StatusRequestEntityTooLarge {
+				var body struct {
+					Code       string `json:"code"`
+					LimitBytes int64  `json:"limit_bytes"`
+				}
+				if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body.Code != "resource_exhausted" || body.LimitBytes != 16 {
+					t.Fatalf("413 body = %q (%v), want a resource_exhausted error with the limit", rec.Body.String(), err)
+				}
+			}
+		})
+	}
+}
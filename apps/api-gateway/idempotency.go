@@ -0,0 +1,292 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+const (
+	idempotencyHeader         = "Idempotency-Key"
+	idempotencyReplayedHeader = "Idempotent-Replayed"
+	idempotencyKeyPrefix      = "idempotency:"
+	idempotencyTTL            = 24 * time.Hour
+	// A reservation outlives the longest request the server lets run, so a gateway that dies
+	// mid-request blocks retries of that key for at most this long
+	idempotencyPendingTTL     = writeTimeout
+	idempotencyTimeout        = 500 * time.Millisecond // Redis round trips must not hold up the request
+	idempotencyMaxCachedBytes = 1 << 20                // Larger responses are not cached
+)
+
+var idempotencyKeyPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// idempotencyStore is the subset of the Redis cache used to keep idempotency records
+type idempotencyStore interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// idempotencyRecord is what is stored in Redis under an Idempotency-Key: the fingerprint of the
+// first request and, once it completed, its response
+type idempotencyRecord struct {
+	Fingerprint string              `json:"fingerprint"`
+	Pending     bool                `json:"pending,omitempty"`
+	Status      int                 `json:"status,omitempty"`
+	Header      map[string][]string `json:"header,omitempty"`
+	Body        []byte              `json:"body,omitempty"`
+}
+
+// IdempotencyMiddleware makes authenticated POST requests carrying an Idempotency-Key header safe
+// to retry. The first request with a key is forwarded and its successful response is kept in Redis
+// for 24 hours; retries with the same key, credentials, method, path and body get that response
+// without reaching the backend. Reusing a key for a different request is rejected with 422.
+type IdempotencyMiddleware struct {
+	store      idempotencyStore
+	bodyLimits *bodyLimits
+}
+
+// newIdempotencyMiddlewareFromEnv enables idempotency keys unless GATEWAY_IDEMPOTENCY_ENABLED is
+// false. Records are kept in Redis; without Redis the header is ignored.
+func newIdempotencyMiddlewareFromEnv(limits *bodyLimits) *IdempotencyMiddleware {
+	enabled := os.Getenv("GATEWAY_IDEMPOTENCY_ENABLED")
+	if enabled == "false" || enabled == "0" {
+		return nil
+	}
+	cache := database.NewRedisCache()
+	if err := cache.Connect(); err != nil {
+		logger.Warn("[API Gateway] Idempotency keys disabled: failed to connect to Redis: %v", err)
+		return nil
+	}
+	logger.Info("✓ Idempotency keys enabled for POST requests (TTL %v)", idempotencyTTL)
+	return newIdempotencyMiddleware(cache, limits)
+}
+
+func newIdempotencyMiddleware(store idempotencyStore, limits *bodyLimits) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{store: store, bodyLimits: limits}
+}
+
+// appliesTo reports whether a request is deduplicated: authenticated POST requests with an
+// Idempotency-Key, except WebSocket upgrades, streaming RPCs, gRPC and file uploads. Requests
+// without credentials have no caller to scope the key to and are forwarded as is. A nil
+// IdempotencyMiddleware (disabled) applies to no request.
+func (m *IdempotencyMiddleware) appliesTo(r *http.Request) bool {
+	if m == nil || r.Method != http.MethodPost || r.Header.Get(idempotencyHeader) == "" || r.Header.Get("Authorization") == "" {
+		return false
+	}
+	if r.Header.Get("Upgrade") != "" || isStreamingPath(r.URL.Path) || r.URL.Path == uploadFilePath || r.URL.Path == isoUploadPath {
+		return false
+	}
+	// gRPC reports failures in trailers behind a 200, and Connect streams carry their end-of-stream
+	// error in the body, so neither can be told apart from a success here
+	contentType := strings.ToLower(r.Header.Get("Content-Type"))
+	return !strings.HasPrefix(contentType, "application/grpc") && !strings.HasPrefix(contentType, "application/connect+")
+}
+
+// Wrap returns next with idempotency keys handled in front of it
+func (m *IdempotencyMiddleware) Wrap(next http.Handler) http.Handler {
+	if m == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.appliesTo(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		m.serve(w, r, next)
+	})
+}
+
+func (m *IdempotencyMiddleware) serve(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	idempotencyKey := r.Header.Get(idempotencyHeader)
+	if !idempotencyKeyPattern.MatchString(idempotencyKey) {
+		writeIdempotencyError(w, http.StatusBadRequest, "invalid_argument", "Idempotency-Key must be a UUID")
+		return
+	}
+
+	// The body is part of the fingerprint, so it is read up front within the route's limit
+	bodyLimit := m.bodyLimits.limitFor(r.URL.Path)
+	if r.ContentLength > bodyLimit {
+		writeBodyTooLarge(w, r.URL.Path, bodyLimit)
+		return
+	}
+	body, err := io.ReadAll(newLimitedBody(r.Body, bodyLimit))
+	if errors.Is(err, errBodyTooLarge) {
+		writeBodyTooLarge(w, r.URL.Path, bodyLimit)
+		return
+	}
+	if err != nil {
+		writeIdempotencyError(w, http.StatusBadRequest, "invalid_argument", "failed to read request body")
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	// Keys are scoped to the caller's credentials so one caller can never be sent another's response
+	subject := idempotencySubject(r)
+	key := idempotencyKeyPrefix + subject + ":" + idempotencyKey
+	fingerprint := idempotencyFingerprint(subject, r.Method, r.URL.Path, body)
+
+	ctx, cancel := context.WithTimeout(r.Context(), idempotencyTimeout)
+	reserved, err := m.store.SetNX(ctx, key, idempotencyRecord{Fingerprint: fingerprint, Pending: true}, idempotencyPendingTTL)
+	var existing idempotencyRecord
+	if err == nil && !reserved {
+		var raw string
+		if raw, err = m.store.Get(ctx, key); err == nil {
+			err = json.Unmarshal([]byte(raw), &existing)
+		}
+	}
+	cancel()
+	if err != nil {
+		// Deduplication is best effort; an unavailable Redis must not fail the request
+		logger.Warn("[API Gateway] Idempotency key lookup failed, forwarding without deduplication: %v", err)
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	if !reserved {
+		switch {
+		case existing.Fingerprint != fingerprint:
+			writeIdempotencyError(w, http.StatusUnprocessableEntity, "invalid_argument", "Idempotency-Key was already used for a different request")
+		case existing.Pending:
+			w.Header().Set("Retry-After", "1")
+			writeIdempotencyError(w, http.StatusConflict, "aborted", "a request with this Idempotency-Key is still in progress")
+		default:
+			logger.Debug("[API Gateway] Replaying response for idempotency key %s on %s", idempotencyKey, r.URL.Path)
+			replayIdempotentResponse(w, existing)
+		}
+		return
+	}
+
+	recorder := &idempotencyRecorder{ResponseWriter: w, status: http.StatusOK}
+	next.ServeHTTP(recorder, r)
+
+	// Store the outcome even if the client already went away, since that client is the one retrying
+	ctx, cancel = context.WithTimeout(context.WithoutCancel(r.Context()), idempotencyTimeout)
+	defer cancel()
+	if recorder.status < 200 || recorder.status >= 300 || recorder.overflowed || hasTrailers(recorder.Header()) {
+		// Only successful responses are replayed; anything else releases the key for a retry.
+		// Trailers may carry the real outcome (grpc-status) and are not stored, so those aren't either
+		if err := m.store.Delete(ctx, key); err != nil {
+			logger.Warn("[API Gateway] Failed to release idempotency key: %v", err)
+		}
+		return
+	}
+	record := idempotencyRecord{
+		Fingerprint: fingerprint,
+		Status:      recorder.status,
+		Header:      replayableHeader(recorder.Header()),
+		Body:        recorder.body.Bytes(),
+	}
+	if err := m.store.Set(ctx, key, record, idempotencyTTL); err != nil {
+		logger.Warn("[API Gateway] Failed to store idempotent response: %v", err)
+	}
+}
+
+// idempotencySubject identifies the caller of a request by a SHA-256 of its Authorization header
+func idempotencySubject(r *http.Request) string {
+	sum := sha256.Sum256([]byte(r.Header.Get("Authorization")))
+	return hex.EncodeToString(sum[:])
+}
+
+// idempotencyFingerprint identifies a request by its caller, method, path and body
+func idempotencyFingerprint(subject, method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(subject + "\n" + method + "\n" + path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// hasTrailers reports whether a response declared or set trailers, or carries a gRPC status in
+// its headers as trailers-only gRPC responses do
+func hasTrailers(header http.Header) bool {
+	if len(header.Values("Trailer")) > 0 || header.Get("Grpc-Status") != "" {
+		return true
+	}
+	for name := range header {
+		if strings.HasPrefix(name, http.TrailerPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// replayableHeader drops the response headers that must not be sent again with a replay
+func replayableHeader(header http.Header) map[string][]string {
+	replay := make(map[string][]string, len(header))
+	for name, values := range header {
+		switch http.CanonicalHeaderKey(name) {
+		case "Set-Cookie", "Date", "Content-Length", "Connection", "Transfer-Encoding":
+			continue
+		}
+		if strings.HasPrefix(http.CanonicalHeaderKey(name), "Access-Control-") {
+			continue
+		}
+		replay[name] = values
+	}
+	return replay
+}
+
+func replayIdempotentResponse(w http.ResponseWriter, record idempotencyRecord) {
+	for name, values := range record.Header {
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	w.Header().Set(idempotencyReplayedHeader, "true")
+	w.WriteHeader(record.Status)
+	_, _ = w.Write(record.Body)
+}
+
+// writeIdempotencyError responds with a JSON error in the Connect error format
+func writeIdempotencyError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"code": code, "message": message})
+}
+
+// idempotencyRecorder passes a response through while keeping a copy of it to store
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+	overflowed  bool // The body passed idempotencyMaxCachedBytes and is not kept
+}
+
+func (rec *idempotencyRecorder) WriteHeader(status int) {
+	if !rec.wroteHeader {
+		rec.status = status
+		rec.wroteHeader = true
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *idempotencyRecorder) Write(p []byte) (int, error) {
+	rec.wroteHeader = true
+	if !rec.overflowed {
+		if rec.body.Len()+len(p) > idempotencyMaxCachedBytes {
+			rec.overflowed = true
+			rec.body.Reset()
+		} else {
+			rec.body.Write(p)
+		}
+	}
+	return rec.ResponseWriter.Write(p)
+}
+
+func (rec *idempotencyRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testIdempotencyKey = "0b6c7d1e-2f3a-4b5c-8d9e-0f1a2b3c4d5e"
+
+// memoryIdempotencyStore is an in-memory stand-in for the Redis cache whose keys expire on a fake clock
+type memoryIdempotencyStore struct {
+	mu      sync.Mutex
+	now     time.Time
+	data    map[string]string
+	expires map[string]time.Time
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{
+		now:     time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC),
+		data:    make(map[string]string),
+		expires: make(map[string]time.Time),
+	}
+}
+
+func (m *memoryIdempotencyStore) advance(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.now = m.now.Add(d)
+}
+
+func (m *memoryIdempotencyStore) live(key string) bool {
+	expires, ok := m.expires[key]
+	return ok && m.now.Before(expires)
+}
+
+func (m *memoryIdempotencyStore) Get(ctx context.Context, key string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.live(key) {
+		return "", errors.New("redis: nil")
+	}
+	return m.data[key], nil
+}
+
+func (m *memoryIdempotencyStore) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[key] = string(data)
+	m.expires[key] = m.now.Add(expiration)
+	return nil
+}
+
+func (m *memoryIdempotencyStore) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	m.mu.Lock()
+	live := m.live(key)
+	m.mu.Unlock()
+	if live {
+		return false, nil
+	}
+	return true, m.Set(ctx, key, value, expiration)
+}
+
+func (m *memoryIdempotencyStore) Delete(ctx context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		delete(m.data, key)
+		delete(m.expires, key)
+	}
+	return nil
+}
+
+// newCountingBackend answers every request with 201 and the number of requests it has handled
+func newCountingBackend(calls *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		n := calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"call":%d,"echo":%q}`, n, body)
+	})
+}
+
+func idempotentPost(handler http.Handler, path, key, body string) *httptest.ResponseRecorder {
+	return idempotentPostAs(handler, "Bearer user-a", path, key, body)
+}
+
+// idempotentPostAs sends a POST with the given Authorization header, or none if it is empty
+func idempotentPostAs(handler http.Handler, authorization, path, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(idempotencyHeader, key)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotencyMiddlewareReplaysRetries(t *testing.T) {
+	var calls atomic.Int32
+	store := newMemoryIdempotencyStore()
+	handler := newIdempotencyMiddleware(store, nil).Wrap(newCountingBackend(&calls))
+
+	const path = "/obiente.cloud.vps.v1.VPSService/CreateVPS"
+	first := idempotentPost(handler, path, testIdempotencyKey, `{"name":"web"}`)
+	retry := idempotentPost(handler, path, testIdempotencyKey, `{"name":"web"}`)
+
+	if calls.Load() != 1 {
+		t.Fatalf("backend handled %d requests, want 1", calls.Load())
+	}
+	if retry.Code != http.StatusCreated || retry.Body.String() != first.Body.String() {
+		t.Fatalf("retry = %d %s, want the first response %d %s", retry.Code, retry.Body, first.Code, first.Body)
+	}
+	if retry.Header().Get("Content-Type") != "application/json" || retry.Header().Get(idempotencyReplayedHeader) != "true" {
+		t.Fatalf("unexpected replay headers: %v", retry.Header())
+	}
+	if retry.Header().Get("Set-Cookie") != "" {
+		t.Fatalf("replay must not repeat Set-Cookie: %v", retry.Header())
+	}
+
+	// Requests without a key, and streaming RPCs, are always forwarded
+	idempotentPost(handler, path, "", `{"name":"web"}`)
+	idempotentPost(handler, "/obiente.cloud.vps.v1.VPSService/StreamVPSLogs", testIdempotencyKey, `{"name":"web"}`)
+	if calls.Load() != 3 {
+		t.Fatalf("backend handled %d requests, want 3", calls.Load())
+	}
+}
+
+func TestIdempotencyMiddlewareRejectsConflictingKey(t *testing.T) {
+	var calls atomic.Int32
+	handler := newIdempotencyMiddleware(newMemoryIdempotencyStore(), nil).Wrap(newCountingBackend(&calls))
+
+	const path = "/obiente.cloud.vps.v1.VPSService/CreateVPS"
+	idempotentPost(handler, path, testIdempotencyKey, `{"name":"web"}`)
+
+	tests := []struct {
+		name string
+		path string
+		body string
+	}{
+		{"different body", path, `{"name":"db"}`},
+		{"different path", "/obiente.cloud.vps.v1.VPSService/DeleteVPS", `{"name":"web"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := idempotentPost(handler, tt.path, testIdempotencyKey, tt.body)
+			if rec.Code != http.StatusUnprocessableEntity {
+				t.Fatalf("status = %d, want 422", rec.Code)
+			}
+		})
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("backend handled %d requests, want 1", calls.Load())
+	}
+
+	if rec := idempotentPost(handler, path, "not-a-uuid", `{}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("status for a malformed key = %d, want 400", rec.Code)
+	}
+}
+
+func TestIdempotencyMiddlewareKeyExpires(t *testing.T) {
+	var calls atomic.Int32
+	store := newMemoryIdempotencyStore()
+	handler := newIdempotencyMiddleware(store, nil).Wrap(newCountingBackend(&calls))
+
+	const path = "/obiente.cloud.vps.v1.VPSService/CreateVPS"
+	idempotentPost(handler, path, testIdempotencyKey, `{"name":"web"}`)
+	store.advance(idempotencyTTL - time.Minute)
+	idempotentPost(handler, path, testIdempotencyKey, `{"name":"web"}`)
+	if calls.Load() != 1 {
+		t.Fatalf("backend handled %d requests before the TTL, want 1", calls.Load())
+	}
+
+	store.advance(2 * time.Minute)
+	rec := idempotentPost(handler, path, testIdempotencyKey, `{"name":"db"}`)
+	if calls.Load() != 2 || rec.Code != http.StatusCreated || rec.Header().Get(idempotencyReplayedHeader) != "" {
+		t.Fatalf("after the TTL the key should be free again: calls=%d status=%d headers=%v", calls.Load(), rec.Code, rec.Header())
+	}
+}
+
+func TestIdempotencyMiddlewareReleasesKeyOnFailure(t *testing.T) {
+	var calls atomic.Int32
+	handler := newIdempotencyMiddleware(newMemoryIdempotencyStore(), nil).Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	const path = "/obiente.cloud.vps.v1.VPSService/CreateVPS"
+	if rec := idempotentPost(handler, path, testIdempotencyKey, `{}`); rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("first status = %d, want 503", rec.Code)
+	}
+	if rec := idempotentPost(handler, path, testIdempotencyKey, `{}`); rec.Code != http.StatusOK || calls.Load() != 2 {
+		t.Fatalf("retry after a failure = %d with %d backend calls, want 200 with 2", rec.Code, calls.Load())
+	}
+}
+
+func TestIdempotencyMiddlewareScopesKeysToCaller(t *testing.T) {
+	var calls atomic.Int32
+	handler := newIdempotencyMiddleware(newMemoryIdempotencyStore(), nil).Wrap(newCountingBackend(&calls))
+
+	const path = "/obiente.cloud.vps.v1.VPSService/CreateVPS"
+	first := idempotentPostAs(handler, "Bearer user-a", path, testIdempotencyKey, `{"name":"web"}`)
+	other := idempotentPostAs(handler, "Bearer user-b", path, testIdempotencyKey, `{"name":"web"}`)
+	if calls.Load() != 2 || other.Header().Get(idempotencyReplayedHeader) != "" || other.Body.String() == first.Body.String() {
+		t.Fatalf("another caller reusing the key got %d %s (replayed %q) after %d backend calls, want its own response",
+			other.Code, other.Body, other.Header().Get(idempotencyReplayedHeader), calls.Load())
+	}
+
+	// Without credentials there is no caller to scope the key to, so nothing is deduplicated
+	idempotentPostAs(handler, "", path, testIdempotencyKey, `{"name":"web"}`)
+	anonymous := idempotentPostAs(handler, "", path, testIdempotencyKey, `{"name":"web"}`)
+	if calls.Load() != 4 || anonymous.Header().Get(idempotencyReplayedHeader) != "" {
+		t.Fatalf("backend handled %d requests, want unauthenticated retries forwarded", calls.Load())
+	}
+}
+
+func TestIdempotencyMiddlewareSkipsGRPC(t *testing.T) {
+	var calls atomic.Int32
+	handler := newIdempotencyMiddleware(newMemoryIdempotencyStore(), nil).Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		// A failed call that still answers 200, with its status in a trailer
+		w.Header().Set("Trailer", "Grpc-Status")
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set("Grpc-Status", "14")
+	}))
+
+	const path = "/obiente.cloud.vps.v1.VPSService/CreateVPS"
+	for _, contentType := range []string{"application/grpc", "application/grpc-web+proto", "application/connect+json"} {
+		for i := 0; i < 2; i++ {
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{}`))
+			req.Header.Set(idempotencyHeader, testIdempotencyKey)
+			req.Header.Set("Authorization", "Bearer user-a")
+			req.Header.Set("Content-Type", contentType)
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+		}
+	}
+	if calls.Load() != 6 {
+		t.Fatalf("backend handled %d gRPC and Connect streaming requests, want all 6", calls.Load())
+	}
+
+	// Responses with trailers are not stored even when the request itself is deduplicated
+	idempotentPost(handler, path, testIdempotencyKey, `{}`)
+	if retry := idempotentPost(handler, path, testIdempotencyKey, `{}`); retry.Header().Get(idempotencyReplayedHeader) != "" || calls.Load() != 8 {
+		t.Fatalf("a response with trailers was replayed (backend calls %d)", calls.Load())
+	}
+}
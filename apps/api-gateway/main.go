@@ -10,6 +10,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
 	"os"
 	"os/signal"
@@ -19,8 +20,12 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
 	"github.com/obiente/cloud/apps/shared/pkg/logger"
 	"github.com/obiente/cloud/apps/shared/pkg/middleware"
+	"github.com/obiente/cloud/apps/shared/pkg/openapi"
+
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
 
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -50,16 +55,20 @@ var baseServiceRoutes = map[string]string{
 	"/obiente.cloud.superadmin.v1.SuperadminService/":      "superadmin-service:3011",
 	"/obiente.cloud.support.v1.SupportService/":            "support-service:3009",
 	"/obiente.cloud.audit.v1.AuditService/":                "audit-service:3010",
+	"/obiente.cloud.audit.v1.SFTPAuditService/":            "audit-service:3010",
 	"/obiente.cloud.notifications.v1.NotificationService/": "notifications-service:3012",
 	"/obiente.cloud.databases.v1.DatabaseService/":         "databases-service:3014",
 	"/webhooks/stripe":                                     "billing-service:3004",
 	"/webhooks/github":                                     "deployments-service:3005",
-	"/dns/push":                                            "dns-service:8053",         // DNS delegation push endpoint
-	"/dns/push/batch":                                      "dns-service:8053",         // DNS delegation batch push endpoint
-	"/terminal/ws":                                         "deployments-service:3005", // Deployment terminals
-	"/gameservers/terminal/ws":                             "gameservers-service:3006", // Game server terminals
-	"/vps/":                                                "vps-service:3008",         // VPS terminals and other VPS endpoints
-	"/vps/ssh/":                                            "vps-service:3008",         // VPS SSH proxy
+	"/auth/saml/":                                          "auth-service:3002",          // SAML SSO callback and SP metadata
+	"/dns/push":                                            "dns-service:8053",           // DNS delegation push endpoint
+	"/dns/push/batch":                                      "dns-service:8053",           // DNS delegation batch push endpoint
+	"/terminal/ws":                                         "deployments-service:3005",   // Deployment terminals
+	"/logs/ws":                                             "deployments-service:3005",   // Deployment log streaming
+	"/gameservers/terminal/ws":                             "gameservers-service:3006",   // Game server terminals
+	"/notifications/ws":                                    "notifications-service:3012", // Real-time notification push
+	"/vps/":                                                "vps-service:3008",           // VPS terminals and other VPS endpoints
+	"/vps/ssh/":                                            "vps-service:3008",           // VPS SSH proxy
 }
 
 // Service name to domain mapping (for Traefik routing)
@@ -146,6 +155,11 @@ func main() {
 		port = "3001"
 	}
 
+	tlsMode, err := tlsModeFromEnv()
+	if err != nil {
+		logger.Fatalf("invalid TLS configuration: %v", err)
+	}
+
 	serviceRoutes := buildServiceRoutes()
 	useTraefik := os.Getenv("USE_TRAEFIK_ROUTING")
 	if useTraefik == "true" || useTraefik == "1" {
@@ -160,12 +174,21 @@ func main() {
 	defer stop()
 
 	mux := http.NewServeMux()
+	targets := make([]string, 0, len(serviceRoutes))
+	for _, targetURL := range serviceRoutes {
+		targets = append(targets, targetURL)
+	}
 	proxy := &ReverseProxy{
 		routes:           serviceRoutes,
 		healthCheckURLs:  healthCheckURLs,
 		baseServiceAddrs: baseServiceAddrs,
 		shutdownCtx:      shutdownCtx,
+		upstreams:        newUpstreamPool(targets),
+		bodyLimits:       newBodyLimits(),
+		stickySessions:   newStickySessionFromEnv(),
 	}
+	logger.Info("✓ Upstream connection pools created (max idle per host: %d, max per host: %d)",
+		proxy.upstreams.maxIdleConnsPerHost, proxy.upstreams.maxConnsPerHost)
 
 	proxy.initHealthChecker()
 	logger.Info("✓ Health checker initialized for backend services")
@@ -270,12 +293,28 @@ func main() {
 			"unhealthy_backends":   unhealthyServices,
 			"total_backends":       checkedCount,
 			"services":             serviceDetails,
+			"connection_pools":     proxy.upstreams.Stats(),
 		}
 
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(response)
 	})
 
+	// OpenAPI spec for REST integrators, generated once from the registered proto descriptors
+	openAPISpec := openapi.GenerateOpenAPISpec()
+	mux.HandleFunc("/openapi.yaml", openAPISpecHandler(openAPISpec))
+	mux.HandleFunc(openapi.PathPrefix+"/", restHandler(proxy))
+	logger.Info("✓ OpenAPI spec generated (%d bytes): /openapi.yaml, REST routes under %s/", len(openAPISpec), openapi.PathPrefix)
+
+	// Requests captured by the request logger with DEBUG_LOG_BODIES, for superadmins debugging production issues
+	authConfig := auth.NewAuthConfig()
+	mux.HandleFunc(middleware.RequestLogPath, requestLogHandler(func(r *http.Request) (*authv1.User, error) {
+		return auth.AuthenticateHTTPRequest(authConfig, r)
+	}))
+	if middleware.BodyLoggingEnabled() {
+		logger.Info("✓ Request body logging enabled (DEBUG_LOG_BODIES): recent requests at %s", middleware.RequestLogPath)
+	}
+
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/" {
 			for path := range serviceRoutes {
@@ -291,9 +330,12 @@ func main() {
 		_, _ = w.Write([]byte("api-gateway"))
 	})
 
-	h2cHandler := h2c.NewHandler(mux, &http2.Server{})
-
-	var handler http.Handler = h2cHandler
+	// Retried POST requests with an Idempotency-Key get the first response instead of reaching the backend again
+	var handler http.Handler = newIdempotencyMiddlewareFromEnv(proxy.bodyLimits).Wrap(mux)
+	if tlsMode == tlsModeH2C {
+		// TLS is terminated in front of the gateway, so HTTP/2 arrives in cleartext
+		handler = h2c.NewHandler(handler, &http2.Server{})
+	}
 	handler = middleware.CORSHandler(handler)
 	handler = middleware.RequestLogger(handler)
 
@@ -306,12 +348,47 @@ func main() {
 	}
 
 	serverErr := make(chan error, 1)
-	go func() {
-		logger.Info("=== API Gateway Ready - Listening on %s ===", httpServer.Addr)
-		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			serverErr <- err
+	var passthroughListener net.Listener
+	switch tlsMode {
+	case tlsModeDirect:
+		tlsConfig, reloader, err := newDirectTLSConfig()
+		if err != nil {
+			logger.Fatalf("invalid TLS configuration: %v", err)
 		}
-	}()
+		httpServer.TLSConfig = tlsConfig
+		if reloader != nil {
+			reloadCertificateOnSIGHUP(shutdownCtx, reloader)
+			logger.Info("✓ TLS certificate loaded from %s (send SIGHUP to reload)", reloader.certPath)
+		}
+		go func() {
+			logger.Info("=== API Gateway Ready - Listening on %s (TLS) ===", httpServer.Addr)
+			if err := httpServer.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				serverErr <- err
+			}
+		}()
+	case tlsModePassthrough:
+		passthrough, err := newPassthroughProxyFromEnv()
+		if err != nil {
+			logger.Fatalf("invalid TLS configuration: %v", err)
+		}
+		passthroughListener, err = net.Listen("tcp", httpServer.Addr)
+		if err != nil {
+			logger.Fatalf("server failed: %v", err)
+		}
+		go func() {
+			logger.Info("=== API Gateway Ready - Forwarding %s to %s without TLS termination ===", httpServer.Addr, passthrough.target)
+			if err := passthrough.Serve(passthroughListener); err != nil {
+				serverErr <- err
+			}
+		}()
+	default:
+		go func() {
+			logger.Info("=== API Gateway Ready - Listening on %s ===", httpServer.Addr)
+			if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				serverErr <- err
+			}
+		}()
+	}
 
 	select {
 	case err := <-serverErr:
@@ -322,6 +399,9 @@ func main() {
 		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 		defer cancel()
 
+		if passthroughListener != nil {
+			passthroughListener.Close()
+		}
 		if err := httpServer.Shutdown(ctx); err != nil {
 			logger.Warn("Error during server shutdown: %v", err)
 		} else {
@@ -333,6 +413,7 @@ func main() {
 // ReplicaHealth tracks health status of individual replicas
 type ReplicaHealth struct {
 	ReplicaID string
+	Address   string // host:port the health check reached the replica at (empty when unknown)
 	Healthy   bool
 	LastSeen  time.Time
 }
@@ -352,11 +433,10 @@ type ReverseProxy struct {
 	healthStatus     map[string]*ServiceHealth // Tracks health status of each backend service and its replicas
 	healthMutex      sync.RWMutex
 	shutdownCtx      context.Context
-	httpClient       *http.Client // Shared HTTP client with connection pooling
-	httpClientOnce   sync.Once    // Ensures client is initialized once
-	streamingClient  *http.Client // Shared client for long-lived streaming requests
-	streamingOnce    sync.Once
-	healthClient     *http.Client // Shared health-check client to avoid per-probe allocations
+	upstreams        *upstreamPool  // Per-service HTTP clients with their own connection pools
+	bodyLimits       *bodyLimits    // Maximum request body size per route
+	stickySessions   *StickySession // Replica affinity for stateful WebSocket routes (nil when disabled)
+	healthClient     *http.Client   // Shared health-check client to avoid per-probe allocations
 	healthClientOnce sync.Once
 }
 
@@ -497,7 +577,7 @@ func (p *ReverseProxy) checkServiceHealthWithReplicas(healthURL string, serviceU
 		go func(attempt int) {
 			defer checkWg.Done()
 
-			healthy, replicaID, err := p.checkServiceHealth(healthURL)
+			healthy, replicaID, replicaAddr, err := p.checkServiceHealth(healthURL)
 			if err != nil {
 				replicaMutex.Lock()
 				lastError = err
@@ -513,12 +593,16 @@ func (p *ReverseProxy) checkServiceHealthWithReplicas(healthURL string, serviceU
 				if _, exists := discoveredReplicas[replicaID]; !exists {
 					discoveredReplicas[replicaID] = &ReplicaHealth{
 						ReplicaID: replicaID,
+						Address:   replicaAddr,
 						Healthy:   healthy,
 						LastSeen:  time.Now(),
 					}
 				} else {
 					discoveredReplicas[replicaID].Healthy = healthy
 					discoveredReplicas[replicaID].LastSeen = time.Now()
+					if replicaAddr != "" {
+						discoveredReplicas[replicaID].Address = replicaAddr
+					}
 				}
 			} else if healthy {
 				// Backwards compatibility: no replica ID returned
@@ -552,6 +636,9 @@ func (p *ReverseProxy) checkServiceHealthWithReplicas(healthURL string, serviceU
 		if existing, exists := serviceHealth.Replicas[replicaID]; exists {
 			existing.Healthy = replica.Healthy
 			existing.LastSeen = replica.LastSeen
+			if replica.Address != "" {
+				existing.Address = replica.Address
+			}
 		} else {
 			serviceHealth.Replicas[replicaID] = replica
 			logger.Debug("[API Gateway] Discovered new replica %s for service %s", replicaID, serviceURL)
@@ -602,38 +689,49 @@ type HealthResponse struct {
 	Extra     map[string]interface{} `json:"extra,omitempty"`
 }
 
-// checkServiceHealth checks service health. Returns (isHealthy, replicaID, error).
+// checkServiceHealth checks service health. Returns (isHealthy, replicaID, replicaAddr, error),
+// where replicaAddr is the host:port of the connection that answered.
 // Health checks are informational and don't block routing. When using Traefik routing,
 // health checks bypass Traefik to ensure independent status.
-func (p *ReverseProxy) checkServiceHealth(healthURL string) (bool, string, error) {
+func (p *ReverseProxy) checkServiceHealth(healthURL string) (bool, string, string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 
+	// Record which replica answered so sticky sessions can connect to it directly
+	var replicaAddr string
+	ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if info.Conn != nil {
+				replicaAddr = info.Conn.RemoteAddr().String()
+			}
+		},
+	})
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthURL, nil)
 	if err != nil {
-		return false, "", fmt.Errorf("failed to create request: %w", err)
+		return false, "", "", fmt.Errorf("failed to create request: %w", err)
 	}
 
 	resp, err := p.getHealthHTTPClient().Do(req)
 	if err != nil {
-		return false, "", fmt.Errorf("request failed: %w", err)
+		return false, "", "", fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Accept both 200 and 503 as valid responses
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusServiceUnavailable {
-		return false, "", fmt.Errorf("unexpected status code: %d (expected 200 or 503)", resp.StatusCode)
+		return false, "", "", fmt.Errorf("unexpected status code: %d (expected 200 or 503)", resp.StatusCode)
 	}
 
 	var healthResp HealthResponse
 	if err := json.NewDecoder(resp.Body).Decode(&healthResp); err != nil {
 		// Backwards compatibility: no JSON response
 		isHealthy := resp.StatusCode == http.StatusOK
-		return isHealthy, "", nil
+		return isHealthy, "", "", nil
 	}
 
 	isHealthy := healthResp.Status == "healthy"
-	return isHealthy, healthResp.ReplicaID, nil
+	return isHealthy, healthResp.ReplicaID, replicaAddr, nil
 }
 
 func (p *ReverseProxy) getHealthHTTPClient() *http.Client {
@@ -680,73 +778,10 @@ func (p *ReverseProxy) isServiceHealthy(targetURL string) bool {
 	return true
 }
 
-// getHTTPClient returns a shared HTTP client with proper connection pooling and timeouts
-func (p *ReverseProxy) getHTTPClient() *http.Client {
-	p.httpClientOnce.Do(func() {
-		skipTLSVerify := os.Getenv("SKIP_TLS_VERIFY")
-		shouldSkipVerify := skipTLSVerify == "true" || skipTLSVerify == "1"
-
-		transport := &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: shouldSkipVerify,
-			},
-			DialContext: (&net.Dialer{
-				Timeout:   10 * time.Second,
-				KeepAlive: 30 * time.Second,
-			}).DialContext,
-			MaxIdleConns:          100,
-			MaxIdleConnsPerHost:   10,
-			IdleConnTimeout:       90 * time.Second,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-			// Creation calls can take minutes; give backends room to respond
-			ResponseHeaderTimeout: 2 * time.Minute,
-			DisableKeepAlives:     false,
-			ForceAttemptHTTP2:     false, // Disable forced HTTP/2 - let it negotiate naturally
-			WriteBufferSize:       4096,
-			ReadBufferSize:        4096,
-		}
-
-		p.httpClient = &http.Client{
-			Transport: transport,
-			// Allow long-running unary requests like CreateVPS; streaming still uses the zero-timeout client branch
-			Timeout: 5 * time.Minute,
-		}
-	})
-	return p.httpClient
-}
-
-func (p *ReverseProxy) getStreamingHTTPClient() *http.Client {
-	p.streamingOnce.Do(func() {
-		skipTLSVerify := os.Getenv("SKIP_TLS_VERIFY")
-		shouldSkipVerify := skipTLSVerify == "true" || skipTLSVerify == "1"
-
-		transport := &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: shouldSkipVerify,
-			},
-			DialContext: (&net.Dialer{
-				Timeout:   10 * time.Second,
-				KeepAlive: 30 * time.Second,
-			}).DialContext,
-			MaxIdleConns:          100,
-			MaxIdleConnsPerHost:   10,
-			IdleConnTimeout:       90 * time.Second,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-			ResponseHeaderTimeout: 0,
-			DisableKeepAlives:     false,
-			ForceAttemptHTTP2:     false,
-			WriteBufferSize:       4096,
-			ReadBufferSize:        4096,
-		}
-
-		p.streamingClient = &http.Client{
-			Transport: transport,
-			Timeout:   0,
-		}
-	})
-	return p.streamingClient
+// isStreamingPath reports whether a path is a server streaming RPC; Connect-RPC server streaming
+// endpoints typically have "Stream" in the path
+func isStreamingPath(path string) bool {
+	return strings.Contains(path, "Stream") || strings.Contains(path, "stream")
 }
 
 func (p *ReverseProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -824,7 +859,15 @@ func (p *ReverseProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	if isWebSocket {
 		logger.Debug("[API Gateway] WebSocket upgrade detected for %s -> %s", r.URL.Path, targetURL)
-		p.handleWebSocket(w, r, target, matchedPath)
+		var stickyCookie *http.Cookie
+		if p.stickySessions.appliesTo(matchedPath) {
+			if replica, cookie, ok := p.stickySessions.route(r, p.stickyReplicas(targetURL)); ok {
+				logger.Debug("[API Gateway] Sticky session: %s -> replica %s (%s)", r.URL.Path, replica.ReplicaID, replica.Address)
+				target = &url.URL{Scheme: "http", Host: replica.Address}
+				stickyCookie = cookie
+			}
+		}
+		p.handleWebSocket(w, r, target, matchedPath, stickyCookie)
 		return
 	}
 
@@ -832,10 +875,25 @@ func (p *ReverseProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	proxyURL.Scheme = target.Scheme
 	proxyURL.Host = target.Host
 
-	// Get or create shared HTTP client with connection pooling
-	client := p.getHTTPClient()
+	// Reject oversized bodies up front when the length is declared, and cut off streamed
+	// bodies once they pass the limit
+	bodyLimit := p.bodyLimits.limitFor(r.URL.Path)
+	if r.ContentLength > bodyLimit {
+		writeBodyTooLarge(w, r.URL.Path, bodyLimit)
+		return
+	}
+	body := r.Body
+	var limited *limitedBody
+	if body != nil && body != http.NoBody {
+		limited = newLimitedBody(body, bodyLimit)
+		body = limited
+	}
 
-	req, err := http.NewRequestWithContext(r.Context(), r.Method, proxyURL.String(), r.Body)
+	// Use the target's pooled clients so connections to each service are reused
+	upstream := p.upstreams.get(targetURL)
+	client := upstream.client
+
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, proxyURL.String(), body)
 	if err != nil {
 		logger.Error("[API Gateway] Failed to create request: %v", err)
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
@@ -927,9 +985,7 @@ func (p *ReverseProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Check if this is a streaming request (server streaming RPC)
-	// Connect-RPC server streaming endpoints typically have "Stream" in the path
-	isStreamingRequest := strings.Contains(r.URL.Path, "Stream") ||
-		strings.Contains(r.URL.Path, "stream")
+	isStreamingRequest := isStreamingPath(r.URL.Path)
 
 	// For streaming requests, use a shared client without a request timeout.
 	if isStreamingRequest {
@@ -939,10 +995,18 @@ func (p *ReverseProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Forward request - use streaming client if this is a streaming request
 	requestClient := client
 	if isStreamingRequest {
-		requestClient = p.getStreamingHTTPClient()
+		requestClient = upstream.streaming
 	}
 
 	resp, err := requestClient.Do(req)
+	if limited != nil && limited.exceeded.Load() {
+		if err == nil {
+			// The backend answered a truncated body; don't pass that response on
+			resp.Body.Close()
+		}
+		writeBodyTooLarge(w, r.URL.Path, bodyLimit)
+		return
+	}
 	if err != nil {
 		// Check if context was cancelled (client disconnected)
 		if r.Context().Err() != nil {
@@ -959,7 +1023,7 @@ func (p *ReverseProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			strings.Contains(err.Error(), "connection") ||
 			strings.Contains(err.Error(), "EOF") {
 			// Force close idle connections for this host to avoid reusing bad connections
-			if transport, ok := client.Transport.(*http.Transport); ok {
+			if transport, ok := requestClient.Transport.(*http.Transport); ok {
 				transport.CloseIdleConnections()
 			}
 		}
@@ -1227,8 +1291,9 @@ func (p *ReverseProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// handleWebSocket handles WebSocket upgrade requests by proxying the connection
-func (p *ReverseProxy) handleWebSocket(w http.ResponseWriter, r *http.Request, target *url.URL, matchedPath string) {
+// handleWebSocket handles WebSocket upgrade requests by proxying the connection.
+// A non-nil stickyCookie is added to the backend's handshake response.
+func (p *ReverseProxy) handleWebSocket(w http.ResponseWriter, r *http.Request, target *url.URL, matchedPath string, stickyCookie *http.Cookie) {
 	logger.Info("[API Gateway] Handling WebSocket upgrade: %s -> %s (matched: %s)", r.URL.Path, target.String(), matchedPath)
 
 	// Hijack the connection
@@ -1257,35 +1322,11 @@ func (p *ReverseProxy) handleWebSocket(w http.ResponseWriter, r *http.Request, t
 		}
 	}
 
-	var backendConn net.Conn
-	if target.Scheme == "https" {
-		skipTLSVerify := os.Getenv("SKIP_TLS_VERIFY")
-		shouldSkipVerify := skipTLSVerify == "true" || skipTLSVerify == "1"
-
-		tcpConn, err := net.DialTimeout("tcp", backendAddr, 10*time.Second)
-		if err != nil {
-			logger.Error("[API Gateway] Failed to connect to backend %s: %v", backendAddr, err)
-			clientConn.Write([]byte("HTTP/1.1 502 Bad Gateway\r\n\r\n"))
-			return
-		}
-
-		hostname := target.Host
-		if idx := strings.Index(hostname, ":"); idx != -1 {
-			hostname = hostname[:idx]
-		}
-
-		tlsConfig := &tls.Config{
-			ServerName:         hostname,
-			InsecureSkipVerify: shouldSkipVerify,
-		}
-		backendConn = tls.Client(tcpConn, tlsConfig)
-	} else {
-		backendConn, err = net.DialTimeout("tcp", backendAddr, 10*time.Second)
-		if err != nil {
-			logger.Error("[API Gateway] Failed to connect to backend %s: %v", backendAddr, err)
-			clientConn.Write([]byte("HTTP/1.1 502 Bad Gateway\r\n\r\n"))
-			return
-		}
+	backendConn, err := dialBackend(r.Context(), backendAddr, target.Scheme == "https", target.Hostname())
+	if err != nil {
+		logger.Error("[API Gateway] Failed to connect to backend %s: %v", backendAddr, err)
+		clientConn.Write([]byte("HTTP/1.1 502 Bad Gateway\r\n\r\n"))
+		return
 	}
 	defer backendConn.Close()
 
@@ -1455,6 +1496,10 @@ func (p *ReverseProxy) handleWebSocket(w http.ResponseWriter, r *http.Request, t
 		}
 	}
 
+	if stickyCookie != nil && headerEndFound {
+		responseBuf = insertResponseHeader(responseBuf, "Set-Cookie", stickyCookie.String())
+	}
+
 	if len(responseBuf) > 0 {
 		if _, err := clientConn.Write(responseBuf); err != nil {
 			logger.Error("[API Gateway] Failed to forward WebSocket handshake response: %v", err)
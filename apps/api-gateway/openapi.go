@@ -0,0 +1,40 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/openapi"
+)
+
+// openAPISpecHandler serves the OpenAPI document generated at startup
+func openAPISpecHandler(spec []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = w.Write(spec)
+	}
+}
+
+// restHandler maps the spec's REST paths onto Connect procedures, so
+// POST /api/v1/{service}/{method} is proxied as POST /{service}/{method}
+func restHandler(next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		procedure := strings.TrimPrefix(r.URL.Path, openapi.PathPrefix)
+		if strings.Count(procedure, "/") != 2 {
+			http.NotFound(w, r)
+			return
+		}
+		r.URL.Path = procedure
+		r.URL.RawPath = ""
+		next.ServeHTTP(w, r)
+	}
+}
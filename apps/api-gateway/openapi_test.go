@@ -0,0 +1,49 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRESTHandlerRewritesToConnectProcedure(t *testing.T) {
+	var proxied string
+	handler := restHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.Path
+	}))
+
+	tests := []struct {
+		method, path string
+		wantStatus   int
+		wantProxied  string
+	}{
+		{http.MethodPost, "/api/v1/obiente.cloud.vps.v1.VPSService/StartVPS", http.StatusOK, "/obiente.cloud.vps.v1.VPSService/StartVPS"},
+		{http.MethodGet, "/api/v1/obiente.cloud.vps.v1.VPSService/StartVPS", http.StatusMethodNotAllowed, ""},
+		{http.MethodPost, "/api/v1/obiente.cloud.vps.v1.VPSService", http.StatusNotFound, ""},
+		{http.MethodPost, "/api/v1/obiente.cloud.vps.v1.VPSService/StartVPS/extra", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		proxied = ""
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+		if rec.Code != tt.wantStatus || proxied != tt.wantProxied {
+			t.Errorf("%s %s: status %d proxied %q, want %d %q", tt.method, tt.path, rec.Code, proxied, tt.wantStatus, tt.wantProxied)
+		}
+	}
+}
+
+func TestOpenAPISpecHandler(t *testing.T) {
+	handler := openAPISpecHandler([]byte("openapi: 3.0.3\n"))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.yaml", nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != "openapi: 3.0.3\n" || rec.Header().Get("Content-Type") != "application/yaml" {
+		t.Fatalf("GET /openapi.yaml = %d %q (%s)", rec.Code, rec.Body.String(), rec.Header().Get("Content-Type"))
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/openapi.yaml", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("POST /openapi.yaml = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
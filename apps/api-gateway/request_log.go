@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/middleware"
+
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+)
+
+// requestLogHandler serves the requests captured with DEBUG_LOG_BODIES to superadmins, oldest first.
+// The since and until query parameters (RFC 3339) bound the request time and path filters by
+// path prefix, e.g. GET /admin/request-log?path=/obiente.cloud.billing.v1.BillingService/
+func requestLogHandler(authenticate func(*http.Request) (*authv1.User, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		user, err := authenticate(r)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		if !auth.HasRole(user, auth.RoleSuperAdmin) {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		filter, err := requestLogFilter(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"enabled": middleware.BodyLoggingEnabled(),
+			"entries": middleware.DebugRequestLog.Entries(filter),
+		})
+	}
+}
+
+// requestLogFilter reads the time and path filters of a request log query
+func requestLogFilter(r *http.Request) (middleware.RequestLogFilter, error) {
+	query := r.URL.Query()
+	filter := middleware.RequestLogFilter{PathPrefix: query.Get("path")}
+	for name, target := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+		raw := query.Get(name)
+		if raw == "" {
+			continue
+		}
+		value, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return filter, fmt.Errorf("invalid %s %q: want an RFC 3339 time", name, raw)
+		}
+		*target = value
+	}
+	return filter, nil
+}
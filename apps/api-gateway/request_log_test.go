@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/middleware"
+
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+)
+
+func TestRequestLogHandlerFiltersForSuperadmins(t *testing.T) {
+	previousLog := middleware.DebugRequestLog
+	middleware.DebugRequestLog = middleware.NewRequestLogBuffer(10)
+	t.Cleanup(func() { middleware.DebugRequestLog = previousLog })
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	middleware.DebugRequestLog.Add(middleware.RequestLogEntry{Time: base, Path: "/obiente.cloud.vps.v1.VPSService/CreateVPS"})
+	middleware.DebugRequestLog.Add(middleware.RequestLogEntry{Time: base.Add(time.Minute), Path: "/obiente.cloud.billing.v1.BillingService/CreateSpendAlert"})
+	middleware.DebugRequestLog.Add(middleware.RequestLogEntry{Time: base.Add(2 * time.Minute), Path: "/obiente.cloud.vps.v1.VPSService/DeleteVPS"})
+
+	users := map[string]*authv1.User{
+		"Bearer superadmin": {Id: "user-super", Roles: []string{auth.RoleSuperAdmin}},
+		"Bearer member":     {Id: "user-member"},
+	}
+	handler := requestLogHandler(func(r *http.Request) (*authv1.User, error) {
+		if user, ok := users[r.Header.Get("Authorization")]; ok {
+			return user, nil
+		}
+		return nil, errors.New("invalid token")
+	})
+
+	tests := []struct {
+		token, query string
+		wantStatus   int
+		wantPaths    []string
+	}{
+		{"Bearer superadmin", "", http.StatusOK, []string{"/obiente.cloud.vps.v1.VPSService/CreateVPS", "/obiente.cloud.billing.v1.BillingService/CreateSpendAlert", "/obiente.cloud.vps.v1.VPSService/DeleteVPS"}},
+		{"Bearer superadmin", "?path=/obiente.cloud.vps.v1.VPSService/", http.StatusOK, []string{"/obiente.cloud.vps.v1.VPSService/CreateVPS", "/obiente.cloud.vps.v1.VPSService/DeleteVPS"}},
+		{"Bearer superadmin", "?since=2026-01-01T00:01:00Z&until=2026-01-01T00:01:30Z", http.StatusOK, []string{"/obiente.cloud.billing.v1.BillingService/CreateSpendAlert"}},
+		{"Bearer superadmin", "?since=yesterday", http.StatusBadRequest, nil},
+		{"Bearer member", "", http.StatusForbidden, nil},
+		{"", "", http.StatusUnauthorized, nil},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, middleware.RequestLogPath+tt.query, nil)
+		if tt.token != "" {
+			req.Header.Set("Authorization", tt.token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != tt.wantStatus {
+			t.Fatalf("%s with %q: status %d, want %d", tt.query, tt.token, rec.Code, tt.wantStatus)
+		}
+		if tt.wantStatus != http.StatusOK {
+			continue
+		}
+
+		var body struct {
+			Entries []middleware.RequestLogEntry `json:"entries"`
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+			t.Fatalf("decode %s: %v", tt.query, err)
+		}
+		if len(body.Entries) != len(tt.wantPaths) {
+			t.Fatalf("%s: %d entries, want %v", tt.query, len(body.Entries), tt.wantPaths)
+		}
+		for i, entry := range body.Entries {
+			if entry.Path != tt.wantPaths[i] {
+				t.Fatalf("%s: entry %d is %s, want %v", tt.query, i, entry.Path, tt.wantPaths)
+			}
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	mathrand "math/rand/v2"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+const (
+	stickySessionCookie    = "obiente_session"
+	stickySessionKeyPrefix = "sticky:"
+	stickySessionTTL       = 30 * time.Minute
+	stickySessionTimeout   = 500 * time.Millisecond // Redis round trips must not hold up the upgrade
+)
+
+// stickySessionRoutes are the routes whose backends keep per-connection state on the replica
+var stickySessionRoutes = map[string]bool{
+	"/gameservers/terminal/ws": true,
+}
+
+// stickyStore is the subset of the Redis cache used to persist sessions
+type stickyStore interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+}
+
+// stickyReplica is the replica a session is pinned to, as stored in Redis
+type stickyReplica struct {
+	ReplicaID string `json:"replica_id"`
+	Address   string `json:"address"`
+}
+
+// StickySession pins WebSocket connections of the same client to one backend replica. The first
+// upgrade picks a healthy replica and hands out an obiente_session cookie; later upgrades with
+// that cookie go to the same replica for as long as it stays healthy.
+type StickySession struct {
+	store  stickyStore
+	routes map[string]bool // Matched route paths with sticky sessions
+}
+
+// newStickySessionFromEnv enables sticky sessions when GATEWAY_STICKY_SESSION_ENABLED is set.
+// Sessions are kept in Redis; without Redis sticky sessions stay disabled.
+func newStickySessionFromEnv() *StickySession {
+	enabled := os.Getenv("GATEWAY_STICKY_SESSION_ENABLED")
+	if enabled != "true" && enabled != "1" {
+		return nil
+	}
+	cache := database.NewRedisCache()
+	if err := cache.Connect(); err != nil {
+		logger.Warn("[API Gateway] Sticky sessions disabled: failed to connect to Redis: %v", err)
+		return nil
+	}
+	logger.Info("✓ Sticky sessions enabled for %d route(s) (TTL %v)", len(stickySessionRoutes), stickySessionTTL)
+	return newStickySession(cache)
+}
+
+func newStickySession(store stickyStore) *StickySession {
+	return &StickySession{store: store, routes: stickySessionRoutes}
+}
+
+// appliesTo reports whether upgrades on the matched route path are pinned to a replica.
+// A nil StickySession (disabled) applies to no route.
+func (s *StickySession) appliesTo(matchedPath string) bool {
+	return s != nil && s.routes[matchedPath]
+}
+
+// route picks the replica for an upgrade from the currently healthy replicas. A session cookie
+// whose replica is still healthy keeps its replica; otherwise a new session is started on a
+// random replica and its cookie is returned. ok is false when the request should use normal
+// routing (no replica known, or Redis unavailable).
+func (s *StickySession) route(r *http.Request, replicas []stickyReplica) (replica stickyReplica, cookie *http.Cookie, ok bool) {
+	if len(replicas) == 0 {
+		return stickyReplica{}, nil, false
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), stickySessionTimeout)
+	defer cancel()
+
+	if c, err := r.Cookie(stickySessionCookie); err == nil && c.Value != "" {
+		key := stickySessionKeyPrefix + c.Value
+		// A missing key means the session expired; if Redis is down, storing the new session fails below
+		var pinned stickyReplica
+		if raw, err := s.store.Get(ctx, key); err == nil && json.Unmarshal([]byte(raw), &pinned) == nil {
+			for _, candidate := range replicas {
+				if candidate == pinned {
+					// Refresh the TTL so active sessions don't expire mid-game
+					if err := s.store.Set(ctx, key, pinned, stickySessionTTL); err != nil {
+						logger.Debug("[API Gateway] Failed to refresh sticky session: %v", err)
+					}
+					return pinned, nil, true
+				}
+			}
+			logger.Debug("[API Gateway] Sticky session replica %s is gone, starting a new session", pinned.ReplicaID)
+		}
+	}
+
+	token, err := newStickySessionToken()
+	if err != nil {
+		logger.Warn("[API Gateway] Failed to generate sticky session token: %v", err)
+		return stickyReplica{}, nil, false
+	}
+	replica = replicas[mathrand.IntN(len(replicas))]
+	if err := s.store.Set(ctx, stickySessionKeyPrefix+token, replica, stickySessionTTL); err != nil {
+		logger.Warn("[API Gateway] Failed to store sticky session, using normal routing: %v", err)
+		return stickyReplica{}, nil, false
+	}
+
+	return replica, &http.Cookie{
+		Name:     stickySessionCookie,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int(stickySessionTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https"),
+		SameSite: http.SameSiteLaxMode,
+	}, true
+}
+
+func newStickySessionToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// stickyReplicas returns the healthy replicas of a service that health checks have an address for,
+// sorted by replica ID
+func (p *ReverseProxy) stickyReplicas(targetURL string) []stickyReplica {
+	p.healthMutex.RLock()
+	defer p.healthMutex.RUnlock()
+
+	serviceHealth := p.healthStatus[targetURL]
+	if serviceHealth == nil {
+		return nil
+	}
+	replicas := make([]stickyReplica, 0, len(serviceHealth.Replicas))
+	for _, replica := range serviceHealth.Replicas {
+		if replica.Healthy && replica.Address != "" {
+			replicas = append(replicas, stickyReplica{ReplicaID: replica.ReplicaID, Address: replica.Address})
+		}
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].ReplicaID < replicas[j].ReplicaID
+	})
+	return replicas
+}
+
+// insertResponseHeader adds a header line to a raw HTTP response head ending in \r\n\r\n
+func insertResponseHeader(response []byte, key, value string) []byte {
+	end := bytes.Index(response, []byte("\r\n\r\n"))
+	if end == -1 {
+		return response
+	}
+	header := []byte(key + ": " + value + "\r\n")
+	out := make([]byte, 0, len(response)+len(header))
+	out = append(out, response[:end+2]...)
+	out = append(out, header...)
+	return append(out, response[end+2:]...)
+}
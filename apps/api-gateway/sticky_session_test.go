@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryStickyStore is an in-memory stand-in for the Redis cache
+type memoryStickyStore struct {
+	mu   sync.Mutex
+	data map[string]string
+	ttls map[string]time.Duration
+}
+
+func newMemoryStickyStore() *memoryStickyStore {
+	return &memoryStickyStore{data: make(map[string]string), ttls: make(map[string]time.Duration)}
+}
+
+func (m *memoryStickyStore) Get(ctx context.Context, key string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, ok := m.data[key]
+	if !ok {
+		return "", errors.New("redis: nil")
+	}
+	return value, nil
+}
+
+func (m *memoryStickyStore) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[key] = string(data)
+	m.ttls[key] = expiration
+	return nil
+}
+
+// newReplicaServer answers WebSocket upgrades with a 101 naming the replica
+func newReplicaServer(t *testing.T, replicaID string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/terminal/ws" {
+			t.Errorf("replica %s got path %s, want /terminal/ws", replicaID, r.URL.Path)
+		}
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("hijack: %v", err)
+			return
+		}
+		defer conn.Close()
+		_, _ = conn.Write([]byte("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nX-Replica: " + replicaID + "\r\n\r\n"))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// upgrade sends a WebSocket upgrade for the game server terminal through the gateway
+func upgrade(t *testing.T, gatewayAddr string, cookie *http.Cookie) *http.Response {
+	t.Helper()
+	conn, err := net.Dial("tcp", gatewayAddr)
+	if err != nil {
+		t.Fatalf("dial gateway: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	req, _ := http.NewRequest(http.MethodGet, "http://"+gatewayAddr+"/gameservers/terminal/ws", nil)
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+	if err := req.Write(conn); err != nil {
+		t.Fatalf("write upgrade: %v", err)
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		t.Fatalf("read upgrade response: %v", err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("upgrade status = %d, want 101", resp.StatusCode)
+	}
+	return resp
+}
+
+func TestStickySessionKeepsReplicaAcrossUpgrades(t *testing.T) {
+	replicas := map[string]*httptest.Server{
+		"gs-a": newReplicaServer(t, "gs-a"),
+		"gs-b": newReplicaServer(t, "gs-b"),
+		"gs-c": newReplicaServer(t, "gs-c"),
+	}
+	const serviceURL = "http://gameservers-service.invalid:3006"
+	health := &ServiceHealth{Healthy: true, Replicas: make(map[string]*ReplicaHealth)}
+	for id, server := range replicas {
+		health.Replicas[id] = &ReplicaHealth{ReplicaID: id, Address: server.Listener.Addr().String(), Healthy: true, LastSeen: time.Now()}
+	}
+	health.ReplicaCount = len(health.Replicas)
+
+	store := newMemoryStickyStore()
+	proxy := &ReverseProxy{
+		routes:         map[string]string{"/gameservers/terminal/ws": serviceURL},
+		healthStatus:   map[string]*ServiceHealth{serviceURL: health},
+		stickySessions: newStickySession(store),
+	}
+	gateway := httptest.NewServer(proxy)
+	defer gateway.Close()
+	gatewayAddr := gateway.Listener.Addr().String()
+
+	first := upgrade(t, gatewayAddr, nil)
+	var session *http.Cookie
+	for _, c := range first.Cookies() {
+		if c.Name == stickySessionCookie {
+			session = c
+		}
+	}
+	if session == nil || session.Value == "" || !session.HttpOnly || session.MaxAge != int(stickySessionTTL.Seconds()) {
+		t.Fatalf("first upgrade cookies = %v, want an HttpOnly %s cookie for %v", first.Cookies(), stickySessionCookie, stickySessionTTL)
+	}
+	pinned := first.Header.Get("X-Replica")
+	key := stickySessionKeyPrefix + session.Value
+	if store.ttls[key] != stickySessionTTL || !strings.Contains(store.data[key], replicas[pinned].Listener.Addr().String()) {
+		t.Fatalf("stored session %s = %q (TTL %v), want replica %s for %v", key, store.data[key], store.ttls[key], pinned, stickySessionTTL)
+	}
+
+	for i := 0; i < 10; i++ {
+		resp := upgrade(t, gatewayAddr, session)
+		if got := resp.Header.Get("X-Replica"); got != pinned {
+			t.Fatalf("upgrade %d with the session cookie reached replica %s, want %s", i, got, pinned)
+		}
+		if len(resp.Cookies()) != 0 {
+			t.Fatalf("upgrade %d with a live session set cookies %v", i, resp.Cookies())
+		}
+	}
+
+	// Once the pinned replica is gone, the session moves to a replica that still exists
+	proxy.healthMutex.Lock()
+	delete(health.Replicas, pinned)
+	proxy.healthMutex.Unlock()
+	resp := upgrade(t, gatewayAddr, session)
+	if got := resp.Header.Get("X-Replica"); got == pinned || got == "" {
+		t.Fatalf("upgrade after replica %s disappeared reached %q", pinned, got)
+	}
+	if cookies := resp.Cookies(); len(cookies) != 1 || cookies[0].Value == session.Value {
+		t.Fatalf("upgrade after replica disappeared set cookies %v, want a new session", cookies)
+	}
+}
+
+func TestStickySessionFallsThroughWithoutReplicas(t *testing.T) {
+	sessions := newStickySession(newMemoryStickyStore())
+	if !sessions.appliesTo("/gameservers/terminal/ws") || sessions.appliesTo("/terminal/ws") {
+		t.Fatal("sticky sessions should only apply to the game server terminal")
+	}
+	var disabled *StickySession
+	if disabled.appliesTo("/gameservers/terminal/ws") {
+		t.Fatal("disabled sticky sessions applied to a route")
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/gameservers/terminal/ws", nil)
+	if _, cookie, ok := sessions.route(req, nil); ok || cookie != nil {
+		t.Fatal("route picked a replica with none tracked")
+	}
+}
+
+func TestCheckServiceHealthRecordsReplicaAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"healthy","service":"gameservers-service","replica_id":"gs-a"}`))
+	}))
+	defer server.Close()
+
+	proxy := &ReverseProxy{}
+	healthy, replicaID, replicaAddr, err := proxy.checkServiceHealth(server.URL + "/health")
+	if err != nil || !healthy || replicaID != "gs-a" {
+		t.Fatalf("checkServiceHealth = %v, %q, %v", healthy, replicaID, err)
+	}
+	if replicaAddr != server.Listener.Addr().String() {
+		t.Fatalf("replica address = %q, want %q", replicaAddr, server.Listener.Addr().String())
+	}
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	"golang.org/x/crypto/acme/autocert"
+)
+
+const (
+	tlsModeH2C         = ""            // Cleartext HTTP/2, TLS is terminated in front of the gateway (Traefik)
+	tlsModeDirect      = "direct"      // The gateway terminates TLS itself
+	tlsModePassthrough = "passthrough" // Raw TCP is forwarded to TLS_PASSTHROUGH_TARGET without termination
+
+	defaultACMECacheDir = "/var/cache/api-gateway/acme"
+	backendDialTimeout  = 10 * time.Second
+)
+
+// tlsModeFromEnv returns the TLS_MODE the gateway runs in
+func tlsModeFromEnv() (string, error) {
+	mode := strings.ToLower(strings.TrimSpace(os.Getenv("TLS_MODE")))
+	switch mode {
+	case tlsModeH2C, tlsModeDirect, tlsModePassthrough:
+		return mode, nil
+	}
+	return "", fmt.Errorf("TLS_MODE must be %q, %q or unset, got %q", tlsModeDirect, tlsModePassthrough, mode)
+}
+
+// certificateReloader serves a certificate loaded from disk and swaps it when reloaded,
+// so certificates can be rotated without restarting the gateway
+type certificateReloader struct {
+	certPath string
+	keyPath  string
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+}
+
+func newCertificateReloader(certPath, keyPath string) (*certificateReloader, error) {
+	r := &certificateReloader{certPath: certPath, keyPath: keyPath}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the certificate again. The previous certificate stays in use when it fails.
+func (r *certificateReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	r.mu.Lock()
+	r.cert = &cert
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *certificateReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// newDirectTLSConfig builds the TLS configuration for TLS_MODE=direct
+//
+// With TLS_ACME_EMAIL set, certificates for TLS_ACME_DOMAINS are provisioned and renewed through
+// ACME (TLS-ALPN-01 challenges, so the gateway must be reachable on port 443) and cached in
+// TLS_ACME_CACHE_DIR. Otherwise the certificate is loaded from TLS_CERT_PATH / TLS_KEY_PATH and the
+// returned reloader reads it again on demand; it is nil for ACME.
+func newDirectTLSConfig() (*tls.Config, *certificateReloader, error) {
+	if email := strings.TrimSpace(os.Getenv("TLS_ACME_EMAIL")); email != "" {
+		var domains []string
+		for _, domain := range strings.Split(os.Getenv("TLS_ACME_DOMAINS"), ",") {
+			if domain = strings.TrimSpace(domain); domain != "" {
+				domains = append(domains, domain)
+			}
+		}
+		if len(domains) == 0 {
+			return nil, nil, fmt.Errorf("TLS_ACME_EMAIL requires TLS_ACME_DOMAINS")
+		}
+		cacheDir := os.Getenv("TLS_ACME_CACHE_DIR")
+		if cacheDir == "" {
+			cacheDir = defaultACMECacheDir
+		}
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			Email:      email,
+			HostPolicy: autocert.HostWhitelist(domains...),
+			Cache:      autocert.DirCache(cacheDir),
+		}
+		tlsConfig := manager.TLSConfig()
+		tlsConfig.MinVersion = tls.VersionTLS12
+		logger.Info("✓ TLS certificates provisioned through ACME for %s (cache: %s)", strings.Join(domains, ", "), cacheDir)
+		return tlsConfig, nil, nil
+	}
+
+	certPath := os.Getenv("TLS_CERT_PATH")
+	keyPath := os.Getenv("TLS_KEY_PATH")
+	if certPath == "" || keyPath == "" {
+		return nil, nil, fmt.Errorf("TLS_MODE=direct requires TLS_CERT_PATH and TLS_KEY_PATH, or TLS_ACME_EMAIL")
+	}
+	reloader, err := newCertificateReloader(certPath, keyPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	tlsConfig := &tls.Config{
+		GetCertificate: reloader.GetCertificate,
+		MinVersion:     tls.VersionTLS12,
+		NextProtos:     []string{"h2", "http/1.1"},
+	}
+	return tlsConfig, reloader, nil
+}
+
+// reloadCertificateOnSIGHUP reloads the certificate each time the process receives SIGHUP, until ctx is done
+func reloadCertificateOnSIGHUP(ctx context.Context, reloader *certificateReloader) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(hup)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				if err := reloader.Reload(); err != nil {
+					logger.Error("[API Gateway] SIGHUP: %v (keeping the current certificate)", err)
+					continue
+				}
+				logger.Info("[API Gateway] SIGHUP: reloaded TLS certificate from %s", reloader.certPath)
+			}
+		}
+	}()
+}
+
+// dialBackend connects to a backend for a proxied WebSocket. TLS connections complete their handshake
+// within the dial timeout and negotiate HTTP/1.1, which the upgrade requires.
+func dialBackend(ctx context.Context, addr string, useTLS bool, serverName string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: backendDialTimeout}
+	if !useTLS {
+		return dialer.DialContext(ctx, "tcp", addr)
+	}
+	skipTLSVerify := os.Getenv("SKIP_TLS_VERIFY")
+	tlsDialer := &tls.Dialer{
+		NetDialer: dialer,
+		Config: &tls.Config{
+			ServerName:         serverName,
+			InsecureSkipVerify: skipTLSVerify == "true" || skipTLSVerify == "1",
+			NextProtos:         []string{"http/1.1"},
+		},
+	}
+	return tlsDialer.DialContext(ctx, "tcp", addr)
+}
+
+// passthroughProxy forwards raw TCP connections to target, leaving TLS to the target
+type passthroughProxy struct {
+	target string
+}
+
+// newPassthroughProxyFromEnv creates the proxy for TLS_MODE=passthrough
+func newPassthroughProxyFromEnv() (*passthroughProxy, error) {
+	target := strings.TrimSpace(os.Getenv("TLS_PASSTHROUGH_TARGET"))
+	if target == "" {
+		return nil, fmt.Errorf("TLS_MODE=passthrough requires TLS_PASSTHROUGH_TARGET")
+	}
+	if _, _, err := net.SplitHostPort(target); err != nil {
+		return nil, fmt.Errorf("TLS_PASSTHROUGH_TARGET must be host:port: %w", err)
+	}
+	return &passthroughProxy{target: target}, nil
+}
+
+// Serve accepts connections on ln until it is closed
+func (p *passthroughProxy) Serve(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go p.forward(conn)
+	}
+}
+
+func (p *passthroughProxy) forward(clientConn net.Conn) {
+	defer clientConn.Close()
+
+	backendConn, err := net.DialTimeout("tcp", p.target, backendDialTimeout)
+	if err != nil {
+		logger.Error("[API Gateway] Passthrough: failed to connect to %s: %v", p.target, err)
+		return
+	}
+	defer backendConn.Close()
+
+	done := make(chan struct{})
+	go func() {
+		io.Copy(backendConn, clientConn)
+		// Let the target see the client's end of stream while its response is still copied back
+		if tcpConn, ok := backendConn.(*net.TCPConn); ok {
+			tcpConn.CloseWrite()
+		}
+		close(done)
+	}()
+	io.Copy(clientConn, backendConn)
+	clientConn.Close()
+	<-done
+}
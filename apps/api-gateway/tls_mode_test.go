@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeTestCertificate writes a self-signed certificate for 127.0.0.1 and returns it parsed
+func writeTestCertificate(t *testing.T, certPath, keyPath, commonName string) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	if err := os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write certificate: %v", err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return cert
+}
+
+// tlsClient returns a client that trusts only the given certificates
+func tlsClient(certs ...*x509.Certificate) *http.Client {
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{RootCAs: pool},
+			ForceAttemptHTTP2: true,
+			DisableKeepAlives: true,
+		},
+	}
+}
+
+// serveTLS serves handler over TLS like main does and returns the server's address
+func serveTLS(t *testing.T, tlsConfig *tls.Config, handler http.Handler) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	server := &http.Server{Handler: handler, TLSConfig: tlsConfig, ReadHeaderTimeout: readHeaderTimeout}
+	go server.ServeTLS(ln, "", "")
+	t.Cleanup(func() { server.Close() })
+	return ln.Addr().String()
+}
+
+func TestDirectTLSServesAndReloadsCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "tls.crt")
+	keyPath := filepath.Join(dir, "tls.key")
+	first := writeTestCertificate(t, certPath, keyPath, "first")
+
+	t.Setenv("TLS_ACME_EMAIL", "")
+	t.Setenv("TLS_CERT_PATH", certPath)
+	t.Setenv("TLS_KEY_PATH", keyPath)
+	tlsConfig, reloader, err := newDirectTLSConfig()
+	if err != nil {
+		t.Fatalf("newDirectTLSConfig: %v", err)
+	}
+
+	url := "https://" + serveTLS(t, tlsConfig, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Proto))
+	}))
+
+	resp, err := tlsClient(first).Get(url)
+	if err != nil {
+		t.Fatalf("request over TLS: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.TLS == nil || resp.TLS.PeerCertificates[0].Subject.CommonName != "first" || string(body) != "HTTP/2.0" {
+		t.Fatalf("served %q over %s, want the first certificate over HTTP/2", resp.TLS.PeerCertificates[0].Subject.CommonName, body)
+	}
+
+	// A failed reload keeps the current certificate
+	if err := os.WriteFile(keyPath, []byte("not a key"), 0o600); err != nil {
+		t.Fatalf("corrupt key: %v", err)
+	}
+	if err := reloader.Reload(); err == nil {
+		t.Fatal("Reload accepted an invalid key")
+	}
+	if _, err := tlsClient(first).Get(url); err != nil {
+		t.Fatalf("request after failed reload: %v", err)
+	}
+
+	second := writeTestCertificate(t, certPath, keyPath, "second")
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	resp, err = tlsClient(second).Get(url)
+	if err != nil {
+		t.Fatalf("request after reload: %v", err)
+	}
+	resp.Body.Close()
+	if name := resp.TLS.PeerCertificates[0].Subject.CommonName; name != "second" {
+		t.Fatalf("served %q after reload, want the second certificate", name)
+	}
+}
+
+func TestDirectTLSConfigRequiresCertificateSource(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+	}{
+		{"no certificate", map[string]string{}},
+		{"key without certificate", map[string]string{"TLS_KEY_PATH": "/tmp/tls.key"}},
+		{"acme without domains", map[string]string{"TLS_ACME_EMAIL": "ops@example.com"}},
+	}
+	for _, tt := range tests {
+		for _, key := range []string{"TLS_CERT_PATH", "TLS_KEY_PATH", "TLS_ACME_EMAIL", "TLS_ACME_DOMAINS"} {
+			t.Setenv(key, tt.env[key])
+		}
+		if _, _, err := newDirectTLSConfig(); err == nil {
+			t.Errorf("%s: newDirectTLSConfig succeeded", tt.name)
+		}
+	}
+
+	t.Setenv("TLS_ACME_EMAIL", "ops@example.com")
+	t.Setenv("TLS_ACME_DOMAINS", "api.example.com, api2.example.com")
+	t.Setenv("TLS_ACME_CACHE_DIR", t.TempDir())
+	tlsConfig, reloader, err := newDirectTLSConfig()
+	if err != nil || reloader != nil || tlsConfig.GetCertificate == nil {
+		t.Fatalf("ACME config = %v, %v, %v", tlsConfig, reloader, err)
+	}
+}
+
+func TestPassthroughForwardsTLSUnterminated(t *testing.T) {
+	backend := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(append([]byte("backend:"), body...))
+	}))
+	defer backend.Close()
+
+	t.Setenv("TLS_PASSTHROUGH_TARGET", backend.Listener.Addr().String())
+	passthrough, err := newPassthroughProxyFromEnv()
+	if err != nil {
+		t.Fatalf("newPassthroughProxyFromEnv: %v", err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	served := make(chan error, 1)
+	go func() { served <- passthrough.Serve(ln) }()
+
+	// The client completes its handshake with the backend's certificate through the gateway
+	client := tlsClient(backend.Certificate())
+	for i := 0; i < 3; i++ {
+		resp, err := client.Post("https://"+ln.Addr().String(), "text/plain", strings.NewReader("ping"))
+		if err != nil {
+			t.Fatalf("request %d through passthrough: %v", i, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != "backend:ping" || !resp.TLS.PeerCertificates[0].Equal(backend.Certificate()) {
+			t.Fatalf("request %d = %q, want the backend's response over its own certificate", i, body)
+		}
+	}
+
+	ln.Close()
+	if err := <-served; err != nil {
+		t.Fatalf("Serve after close = %v, want nil", err)
+	}
+
+	t.Setenv("TLS_PASSTHROUGH_TARGET", "backend")
+	if _, err := newPassthroughProxyFromEnv(); err == nil {
+		t.Fatal("accepted a target without a port")
+	}
+}
+
+func TestDialBackendCompletesTLSHandshake(t *testing.T) {
+	dir := t.TempDir()
+	writeTestCertificate(t, filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), "backend")
+	cert, err := tls.LoadX509KeyPair(filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"))
+	if err != nil {
+		t.Fatalf("load certificate: %v", err)
+	}
+	// The backend prefers HTTP/2, which a WebSocket upgrade cannot use
+	addr := serveTLS(t, &tls.Config{Certificates: []tls.Certificate{cert}}, http.NotFoundHandler())
+
+	t.Setenv("SKIP_TLS_VERIFY", "true")
+	conn, err := dialBackend(context.Background(), addr, true, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("dialBackend: %v", err)
+	}
+	defer conn.Close()
+	state := conn.(*tls.Conn).ConnectionState()
+	if !state.HandshakeComplete || state.NegotiatedProtocol != "http/1.1" {
+		t.Fatalf("handshake complete = %v, protocol = %q; want an HTTP/1.1 connection ready for the upgrade", state.HandshakeComplete, state.NegotiatedProtocol)
+	}
+
+	t.Setenv("SKIP_TLS_VERIFY", "")
+	if _, err := dialBackend(context.Background(), addr, true, "127.0.0.1"); err == nil {
+		t.Fatal("dialBackend trusted an unknown certificate")
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+const (
+	defaultMaxIdleConnsPerHost = 100
+	defaultMaxConnsPerHost     = 200
+)
+
+// upstreamPool holds one set of HTTP clients per backend target URL, so each service
+// gets its own connection pool and limits instead of sharing a single transport
+type upstreamPool struct {
+	mu                  sync.RWMutex
+	upstreams           map[string]*upstream
+	maxIdleConnsPerHost int
+	maxConnsPerHost     int
+	skipTLSVerify       bool
+}
+
+// upstream is the connection pool for a single backend target
+type upstream struct {
+	client    *http.Client // Unary requests
+	streaming *http.Client // Server-streaming requests (no response timeout)
+	transport *http.Transport
+	streamTr  *http.Transport
+	openConns atomic.Int64 // Connections currently open across both transports
+	dials     atomic.Int64 // Connections established since startup
+}
+
+// UpstreamPoolStats describes a backend's connection pool for /health/detailed
+type UpstreamPoolStats struct {
+	OpenConnections     int64 `json:"open_connections"`
+	ConnectionsOpened   int64 `json:"connections_opened"`
+	MaxIdleConnsPerHost int   `json:"max_idle_conns_per_host"`
+	MaxConnsPerHost     int   `json:"max_conns_per_host"`
+}
+
+// newUpstreamPool creates the pool with limits from GATEWAY_MAX_IDLE_CONNS_PER_HOST and
+// GATEWAY_MAX_CONNS_PER_HOST, and pre-creates clients for the given targets
+func newUpstreamPool(targets []string) *upstreamPool {
+	skipTLSVerify := os.Getenv("SKIP_TLS_VERIFY")
+	p := &upstreamPool{
+		upstreams:           make(map[string]*upstream, len(targets)),
+		maxIdleConnsPerHost: envInt("GATEWAY_MAX_IDLE_CONNS_PER_HOST", defaultMaxIdleConnsPerHost),
+		maxConnsPerHost:     envInt("GATEWAY_MAX_CONNS_PER_HOST", defaultMaxConnsPerHost),
+		skipTLSVerify:       skipTLSVerify == "true" || skipTLSVerify == "1",
+	}
+	for _, target := range targets {
+		if _, ok := p.upstreams[target]; !ok {
+			p.upstreams[target] = p.newUpstream()
+		}
+	}
+	return p
+}
+
+// get returns the clients for a target, creating them if the target wasn't known at startup
+func (p *upstreamPool) get(targetURL string) *upstream {
+	p.mu.RLock()
+	u, ok := p.upstreams[targetURL]
+	p.mu.RUnlock()
+	if ok {
+		return u
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if u, ok := p.upstreams[targetURL]; ok {
+		return u
+	}
+	u = p.newUpstream()
+	p.upstreams[targetURL] = u
+	return u
+}
+
+// Stats returns pool sizes for every target
+func (p *upstreamPool) Stats() map[string]UpstreamPoolStats {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	stats := make(map[string]UpstreamPoolStats, len(p.upstreams))
+	for target, u := range p.upstreams {
+		stats[target] = UpstreamPoolStats{
+			OpenConnections:     u.openConns.Load(),
+			ConnectionsOpened:   u.dials.Load(),
+			MaxIdleConnsPerHost: p.maxIdleConnsPerHost,
+			MaxConnsPerHost:     p.maxConnsPerHost,
+		}
+	}
+	return stats
+}
+
+func (p *upstreamPool) newUpstream() *upstream {
+	u := &upstream{}
+
+	u.transport = p.newTransport(u)
+	// Creation calls can take minutes; give backends room to respond
+	u.transport.ResponseHeaderTimeout = 2 * time.Minute
+	u.client = &http.Client{
+		Transport: u.transport,
+		// Allow long-running unary requests like CreateVPS; streaming uses the zero-timeout client
+		Timeout: 5 * time.Minute,
+	}
+
+	u.streamTr = p.newTransport(u)
+	u.streaming = &http.Client{Transport: u.streamTr}
+	return u
+}
+
+func (p *upstreamPool) newTransport(u *upstream) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	return &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: p.skipTLSVerify,
+		},
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			conn, err := dialer.DialContext(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+			u.dials.Add(1)
+			u.openConns.Add(1)
+			return &countedConn{Conn: conn, open: &u.openConns}, nil
+		},
+		MaxIdleConns:          p.maxIdleConnsPerHost,
+		MaxIdleConnsPerHost:   p.maxIdleConnsPerHost,
+		MaxConnsPerHost:       p.maxConnsPerHost,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     false, // Let HTTP/2 negotiate naturally
+		WriteBufferSize:       4096,
+		ReadBufferSize:        4096,
+	}
+}
+
+// countedConn decrements the upstream's open connection count once when closed
+type countedConn struct {
+	net.Conn
+	open   *atomic.Int64
+	closed atomic.Bool
+}
+
+func (c *countedConn) Close() error {
+	if c.closed.CompareAndSwap(false, true) {
+		c.open.Add(-1)
+	}
+	return c.Conn.Close()
+}
+
+// envInt reads a positive integer from the environment, falling back to the default
+func envInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed <= 0 {
+		logger.Warn("Invalid %s=%q, using default %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestUpstreamPoolReusesConnections(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	t.Setenv("GATEWAY_MAX_IDLE_CONNS_PER_HOST", "")
+	t.Setenv("GATEWAY_MAX_CONNS_PER_HOST", "")
+	pool := newUpstreamPool([]string{server.URL})
+	if pool.get(server.URL) != pool.get(server.URL) {
+		t.Fatal("pool returned different clients for the same target")
+	}
+
+	for i := 0; i < 20; i++ {
+		resp, err := pool.get(server.URL).client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+
+	stats := pool.Stats()[server.URL]
+	if stats.ConnectionsOpened != 1 || stats.OpenConnections != 1 {
+		t.Fatalf("sequential requests opened %d connections (%d open), want 1", stats.ConnectionsOpened, stats.OpenConnections)
+	}
+	if stats.MaxIdleConnsPerHost != defaultMaxIdleConnsPerHost || stats.MaxConnsPerHost != defaultMaxConnsPerHost {
+		t.Fatalf("unexpected default limits %+v", stats)
+	}
+}
+
+func TestUpstreamPoolLimitsFromEnv(t *testing.T) {
+	t.Setenv("GATEWAY_MAX_IDLE_CONNS_PER_HOST", "8")
+	t.Setenv("GATEWAY_MAX_CONNS_PER_HOST", "invalid")
+
+	pool := newUpstreamPool(nil)
+	u := pool.get("http://auth-service:3002")
+	if u.transport.MaxIdleConnsPerHost != 8 || u.transport.MaxConnsPerHost != defaultMaxConnsPerHost {
+		t.Fatalf("transport limits = %d idle / %d max, want 8 / %d",
+			u.transport.MaxIdleConnsPerHost, u.transport.MaxConnsPerHost, defaultMaxConnsPerHost)
+	}
+	if len(pool.Stats()) != 1 {
+		t.Fatal("lazily created upstream missing from stats")
+	}
+}
+
+// BenchmarkUpstreamConnections fires 1,000 concurrent requests per iteration and reports
+// the time spent establishing connections, comparing the pooled transports with the
+// previous behaviour of a new transport per request
+func BenchmarkUpstreamConnections(b *testing.B) {
+	const concurrentRequests = 1000
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Millisecond)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	run := func(b *testing.B, clientFor func() (*http.Client, func())) {
+		var connectNanos, newConns atomic.Int64
+		trace := &httptrace.ClientTrace{
+			GotConn: func(info httptrace.GotConnInfo) {
+				if !info.Reused {
+					newConns.Add(1)
+				}
+			},
+		}
+		var connectStart sync.Map
+		trace.ConnectStart = func(network, addr string) { connectStart.Store(addr, time.Now()) }
+		trace.ConnectDone = func(network, addr string, err error) {
+			if start, ok := connectStart.Load(addr); ok {
+				connectNanos.Add(int64(time.Since(start.(time.Time))))
+			}
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var wg sync.WaitGroup
+			for j := 0; j < concurrentRequests; j++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					client, done := clientFor()
+					defer done()
+					req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+					req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+					resp, err := client.Do(req)
+					if err != nil {
+						b.Error(err)
+						return
+					}
+					_, _ = io.Copy(io.Discard, resp.Body)
+					resp.Body.Close()
+				}()
+			}
+			wg.Wait()
+		}
+		b.StopTimer()
+		b.ReportMetric(float64(newConns.Load())/float64(b.N), "conns/op")
+		b.ReportMetric(float64(connectNanos.Load())/float64(b.N)/1e6, "connect-ms/op")
+	}
+
+	b.Run("pooled", func(b *testing.B) {
+		pool := newUpstreamPool([]string{server.URL})
+		run(b, func() (*http.Client, func()) {
+			return pool.get(server.URL).client, func() {}
+		})
+	})
+
+	b.Run("per-request-transport", func(b *testing.B) {
+		run(b, func() (*http.Client, func()) {
+			transport := &http.Transport{}
+			return &http.Client{Transport: transport}, transport.CloseIdleConnections
+		})
+	})
+}
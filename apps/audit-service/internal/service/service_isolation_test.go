@@ -12,7 +12,6 @@ import (
 	auditv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/audit/v1"
 	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
 	"google.golang.org/protobuf/proto"
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -64,28 +63,11 @@ func TestAuditServiceTenantIsolation(t *testing.T) {
 func newAuditServiceTestDB(t *testing.T) *gorm.DB {
 	t.Helper()
 
-	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	if err != nil {
-		t.Fatalf("open sqlite db: %v", err)
-	}
-	if err := db.AutoMigrate(
+	return newTestDB(t,
 		&database.AuditLog{},
 		&database.Organization{},
 		&database.OrganizationMember{},
-	); err != nil {
-		t.Fatalf("migrate sqlite db: %v", err)
-	}
-
-	previousDB := database.DB
-	previousMetricsDB := database.MetricsDB
-	database.DB = db
-	database.MetricsDB = db
-	t.Cleanup(func() {
-		database.DB = previousDB
-		database.MetricsDB = previousMetricsDB
-	})
-
-	return db
+	)
 }
 
 func seedAuditServiceIsolationData(t *testing.T, db *gorm.DB) {
@@ -0,0 +1,194 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	auditv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/audit/v1"
+	auditv1connect "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/audit/v1/auditv1connect"
+
+	"connectrpc.com/connect"
+	"gorm.io/gorm"
+)
+
+const (
+	// fileTransferService is the service name the file transfer service records SFTP events under
+	fileTransferService = "FileTransferService"
+
+	defaultSFTPExportPageSize = 500
+	maxSFTPExportPageSize     = 5000
+)
+
+var sftpAuditCSVHeader = []string{
+	"id", "created_at", "user_id", "organization_id", "action", "resource_type", "resource_id",
+	"ip_address", "user_agent", "request_data", "response_status", "error_message",
+}
+
+// SFTPAuditService exports the SFTP events recorded by the file transfer service
+type SFTPAuditService struct {
+	auditv1connect.UnimplementedSFTPAuditServiceHandler
+	db *gorm.DB
+}
+
+func NewSFTPAuditService(db *gorm.DB) *SFTPAuditService {
+	return &SFTPAuditService{db: db}
+}
+
+// sftpAuditRow is the exported shape of an SFTP audit event
+type sftpAuditRow struct {
+	ID             string          `json:"id"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UserID         string          `json:"user_id"`
+	OrganizationID string          `json:"organization_id,omitempty"`
+	Action         string          `json:"action"`
+	ResourceType   string          `json:"resource_type,omitempty"`
+	ResourceID     string          `json:"resource_id,omitempty"`
+	IPAddress      string          `json:"ip_address,omitempty"`
+	UserAgent      string          `json:"user_agent,omitempty"`
+	RequestData    json.RawMessage `json:"request_data,omitempty"`
+	ResponseStatus int32           `json:"response_status"`
+	ErrorMessage   string          `json:"error_message,omitempty"`
+}
+
+// GetSFTPAuditLogs streams matching SFTP events oldest first, one page per message.
+// Pages are read with keyset pagination on (created_at, id) so large exports stay cheap.
+func (s *SFTPAuditService) GetSFTPAuditLogs(ctx context.Context, req *connect.Request[auditv1.GetSFTPAuditLogsRequest], stream *connect.ServerStream[auditv1.GetSFTPAuditLogsResponse]) error {
+	if err := authorizeAuditLogRead(ctx, req.Msg.OrganizationId); err != nil {
+		return err
+	}
+	if s.db == nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("metrics database (TimescaleDB) not initialized - audit logs require TimescaleDB"))
+	}
+
+	pageSize := defaultSFTPExportPageSize
+	if req.Msg.PageSize != nil && *req.Msg.PageSize > 0 {
+		pageSize = int(*req.Msg.PageSize)
+		if pageSize > maxSFTPExportPageSize {
+			pageSize = maxSFTPExportPageSize
+		}
+	}
+	format := req.Msg.GetFormat()
+	if format == auditv1.SFTPAuditLogFormat_SFTP_AUDIT_LOG_FORMAT_UNSPECIFIED {
+		format = auditv1.SFTPAuditLogFormat_SFTP_AUDIT_LOG_FORMAT_JSON
+	}
+
+	var cursor *database.AuditLog
+	for page := 1; ; page++ {
+		query := s.db.WithContext(ctx).Model(&database.AuditLog{})
+		fileTransfer := fileTransferService
+		query = applyAuditFilters(query, req.Msg.OrganizationId, nil, req.Msg.ResourceId, nil, &fileTransfer, nil, req.Msg.StartTime, req.Msg.EndTime, nil, nil)
+		if cursor != nil {
+			query = query.Where("(created_at > ? OR (created_at = ? AND id > ?))", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+		}
+
+		var logs []database.AuditLog
+		if err := query.Order("created_at ASC, id ASC").Limit(pageSize).Find(&logs).Error; err != nil {
+			logger.Error("[SFTPAuditService] Failed to query SFTP audit logs: %v", err)
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query SFTP audit logs: %w", err))
+		}
+		if len(logs) == 0 && page > 1 {
+			return nil
+		}
+
+		data, err := encodeSFTPAuditPage(logs, format, page == 1)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to encode SFTP audit logs: %w", err))
+		}
+		if err := stream.Send(&auditv1.GetSFTPAuditLogsResponse{
+			Page:     int32(page),
+			RowCount: int32(len(logs)),
+			Data:     data,
+		}); err != nil {
+			return err
+		}
+
+		if len(logs) < pageSize {
+			return nil
+		}
+		cursor = &logs[len(logs)-1]
+	}
+}
+
+func encodeSFTPAuditPage(logs []database.AuditLog, format auditv1.SFTPAuditLogFormat, withHeader bool) ([]byte, error) {
+	var buf bytes.Buffer
+	switch format {
+	case auditv1.SFTPAuditLogFormat_SFTP_AUDIT_LOG_FORMAT_CSV:
+		w := csv.NewWriter(&buf)
+		if withHeader {
+			if err := w.Write(sftpAuditCSVHeader); err != nil {
+				return nil, err
+			}
+		}
+		for i := range logs {
+			row := newSFTPAuditRow(&logs[i])
+			if err := w.Write([]string{
+				row.ID,
+				row.CreatedAt.Format(time.RFC3339Nano),
+				row.UserID,
+				row.OrganizationID,
+				row.Action,
+				row.ResourceType,
+				row.ResourceID,
+				row.IPAddress,
+				row.UserAgent,
+				string(row.RequestData),
+				strconv.Itoa(int(row.ResponseStatus)),
+				row.ErrorMessage,
+			}); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, err
+		}
+	case auditv1.SFTPAuditLogFormat_SFTP_AUDIT_LOG_FORMAT_JSON:
+		enc := json.NewEncoder(&buf)
+		for i := range logs {
+			if err := enc.Encode(newSFTPAuditRow(&logs[i])); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported export format %v", format)
+	}
+	return buf.Bytes(), nil
+}
+
+func newSFTPAuditRow(log *database.AuditLog) sftpAuditRow {
+	row := sftpAuditRow{
+		ID:             log.ID,
+		CreatedAt:      log.CreatedAt.UTC(),
+		UserID:         log.UserID,
+		Action:         log.Action,
+		IPAddress:      log.IPAddress,
+		UserAgent:      log.UserAgent,
+		ResponseStatus: log.ResponseStatus,
+	}
+	if log.OrganizationID != nil {
+		row.OrganizationID = *log.OrganizationID
+	}
+	if log.ResourceType != nil {
+		row.ResourceType = *log.ResourceType
+	}
+	if log.ResourceID != nil {
+		row.ResourceID = *log.ResourceID
+	}
+	if log.ErrorMessage != nil {
+		row.ErrorMessage = *log.ErrorMessage
+	}
+	if json.Valid([]byte(log.RequestData)) {
+		row.RequestData = json.RawMessage(log.RequestData)
+	} else if log.RequestData != "" {
+		row.RequestData, _ = json.Marshal(log.RequestData)
+	}
+	return row
+}
@@ -0,0 +1,168 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	auditv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/audit/v1"
+	auditv1connect "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/audit/v1/auditv1connect"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+func TestGetSFTPAuditLogsPaginates(t *testing.T) {
+	db := newTestDB(t, &database.AuditLog{})
+
+	// 1000 SFTP events for org-a, three per second so pages split inside equal timestamps,
+	// plus events the export must skip
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	orgA, orgB := "org-a", "org-b"
+	var logs []database.AuditLog
+	var want []string
+	for i := 0; i < 1000; i++ {
+		id := fmt.Sprintf("sftp-%04d", i)
+		resourceID := "gs-1"
+		if i%2 == 1 {
+			resourceID = "gs-2"
+		}
+		logs = append(logs, sftpTestAuditLog(id, &orgA, fileTransferService, resourceID, base.Add(time.Duration(i/3)*time.Second)))
+		want = append(want, id)
+	}
+	logs = append(logs,
+		sftpTestAuditLog("other-org", &orgB, fileTransferService, "gs-3", base),
+		sftpTestAuditLog("other-service", &orgA, "DeploymentService", "gs-1", base),
+	)
+	if err := db.CreateInBatches(logs, 200).Error; err != nil {
+		t.Fatalf("seed audit logs: %v", err)
+	}
+
+	client := newSFTPAuditTestClient(t, db)
+
+	pages, rows := exportSFTPAuditLogs(t, client, &auditv1.GetSFTPAuditLogsRequest{
+		OrganizationId: proto.String(orgA),
+		PageSize:       proto.Int32(300),
+		Format:         auditv1.SFTPAuditLogFormat_SFTP_AUDIT_LOG_FORMAT_JSON,
+	})
+	if got, wantCounts := pageRowCounts(pages), []int32{300, 300, 300, 100}; fmt.Sprint(got) != fmt.Sprint(wantCounts) {
+		t.Fatalf("page row counts = %v, want %v", got, wantCounts)
+	}
+	for i, page := range pages {
+		if page.GetPage() != int32(i+1) {
+			t.Fatalf("page %d numbered %d", i+1, page.GetPage())
+		}
+	}
+
+	var ids []string
+	for _, line := range rows {
+		var row sftpAuditRow
+		if err := json.Unmarshal(line, &row); err != nil {
+			t.Fatalf("decode JSON row %q: %v", line, err)
+		}
+		if row.OrganizationID != orgA || row.Action != "SFTPUpload" {
+			t.Fatalf("unexpected row %+v", row)
+		}
+		ids = append(ids, row.ID)
+	}
+	if fmt.Sprint(ids) != fmt.Sprint(want) {
+		t.Fatalf("exported %d ids out of order or with gaps", len(ids))
+	}
+
+	pages, _ = exportSFTPAuditLogs(t, client, &auditv1.GetSFTPAuditLogsRequest{
+		OrganizationId: proto.String(orgA),
+		ResourceId:     proto.String("gs-1"),
+		PageSize:       proto.Int32(200),
+		Format:         auditv1.SFTPAuditLogFormat_SFTP_AUDIT_LOG_FORMAT_CSV,
+	})
+	var csvData bytes.Buffer
+	for _, page := range pages {
+		csvData.Write(page.GetData())
+	}
+	records, err := csv.NewReader(&csvData).ReadAll()
+	if err != nil {
+		t.Fatalf("read CSV export: %v", err)
+	}
+	if len(records) != 501 || fmt.Sprint(records[0]) != fmt.Sprint(sftpAuditCSVHeader) {
+		t.Fatalf("CSV export has %d records (header %v), want header + 500 rows", len(records), records[0])
+	}
+	if records[1][0] != "sftp-0000" || records[500][0] != "sftp-0998" {
+		t.Fatalf("CSV export spans %s..%s, want sftp-0000..sftp-0998", records[1][0], records[500][0])
+	}
+}
+
+func newSFTPAuditTestClient(t *testing.T, db *gorm.DB) auditv1connect.SFTPAuditServiceClient {
+	t.Helper()
+
+	path, handler := auditv1connect.NewSFTPAuditServiceHandler(NewSFTPAuditService(db))
+	mux := http.NewServeMux()
+	mux.Handle(path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := auth.WithUser(r.Context(), &authv1.User{Id: "superadmin", Roles: []string{auth.RoleSuperAdmin}})
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	}))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return auditv1connect.NewSFTPAuditServiceClient(server.Client(), server.URL)
+}
+
+func exportSFTPAuditLogs(t *testing.T, client auditv1connect.SFTPAuditServiceClient, req *auditv1.GetSFTPAuditLogsRequest) ([]*auditv1.GetSFTPAuditLogsResponse, [][]byte) {
+	t.Helper()
+
+	stream, err := client.GetSFTPAuditLogs(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		t.Fatalf("GetSFTPAuditLogs: %v", err)
+	}
+	defer stream.Close()
+
+	var pages []*auditv1.GetSFTPAuditLogsResponse
+	var rows [][]byte
+	for stream.Receive() {
+		page := stream.Msg()
+		pages = append(pages, page)
+		scanner := bufio.NewScanner(bytes.NewReader(page.GetData()))
+		for scanner.Scan() {
+			rows = append(rows, append([]byte(nil), scanner.Bytes()...))
+		}
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatalf("receive SFTP audit logs: %v", err)
+	}
+	return pages, rows
+}
+
+func pageRowCounts(pages []*auditv1.GetSFTPAuditLogsResponse) []int32 {
+	counts := make([]int32, 0, len(pages))
+	for _, page := range pages {
+		counts = append(counts, page.GetRowCount())
+	}
+	return counts
+}
+
+func sftpTestAuditLog(id string, orgID *string, service, resourceID string, createdAt time.Time) database.AuditLog {
+	resourceType := "gameserver"
+	return database.AuditLog{
+		ID:             id,
+		UserID:         "user-1",
+		OrganizationID: orgID,
+		Action:         "SFTPUpload",
+		Service:        service,
+		ResourceType:   &resourceType,
+		ResourceID:     &resourceID,
+		IPAddress:      "203.0.113.7",
+		UserAgent:      "SSH-2.0-OpenSSH_9.6",
+		RequestData:    `{"path":"/world/level.dat"}`,
+		ResponseStatus: 200,
+		CreatedAt:      createdAt,
+	}
+}
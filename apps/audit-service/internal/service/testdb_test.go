@@ -0,0 +1,36 @@
+package audit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestDB installs an in-memory SQLite database with models migrated as both
+// database.DB and database.MetricsDB for the test
+func newTestDB(t *testing.T, models ...any) *gorm.DB {
+	t.Helper()
+
+	dbName := "file:" + strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()) + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+
+	previousDB := database.DB
+	previousMetricsDB := database.MetricsDB
+	database.DB = db
+	database.MetricsDB = db
+	t.Cleanup(func() {
+		database.DB = previousDB
+		database.MetricsDB = previousMetricsDB
+	})
+
+	return db
+}
@@ -79,6 +79,14 @@ func main() {
 	)
 	mux.Handle(auditPath, auditHandler)
 
+	// Register SFTP audit export service
+	sftpAuditService := audit.NewSFTPAuditService(database.MetricsDB)
+	sftpAuditPath, sftpAuditHandler := auditv1connect.NewSFTPAuditServiceHandler(
+		sftpAuditService,
+		connect.WithInterceptors(auditInterceptor, authInterceptor),
+	)
+	mux.Handle(sftpAuditPath, sftpAuditHandler)
+
 	// Health check endpoint with replica ID
 	mux.HandleFunc("/health", health.HandleHealth("audit-service", func() (bool, string, map[string]interface{}) {
 		// Check metrics database connection (TimescaleDB)
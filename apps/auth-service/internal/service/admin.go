@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/obiente/cloud/apps/shared/pkg/auth"
 	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/middleware"
 	"github.com/obiente/cloud/apps/shared/pkg/services/common"
 	adminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/admin/v1"
 	adminv1connect "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/admin/v1/adminv1connect"
@@ -238,6 +239,7 @@ func (s *AdminService) UpdateRole(ctx context.Context, req *connect.Request[admi
 	if auth.IsSystemRole(role.Name) {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cannot update system role '%s'. System roles are defined in code and cannot be modified", role.Name))
 	}
+	before := &adminv1.Role{Id: role.ID, Name: role.Name, PermissionsJson: role.Permissions}
 
 	// Update name if provided
 	if name := strings.TrimSpace(req.Msg.GetName()); name != "" {
@@ -269,13 +271,10 @@ func (s *AdminService) UpdateRole(ctx context.Context, req *connect.Request[admi
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update role: %w", err))
 	}
 
-	return connect.NewResponse(&adminv1.UpdateRoleResponse{
-		Role: &adminv1.Role{
-			Id:              role.ID,
-			Name:            role.Name,
-			PermissionsJson: role.Permissions,
-		},
-	}), nil
+	after := &adminv1.Role{Id: role.ID, Name: role.Name, PermissionsJson: role.Permissions}
+	middleware.RecordAuditDiff(ctx, before, after)
+
+	return connect.NewResponse(&adminv1.UpdateRoleResponse{Role: after}), nil
 }
 
 // DeleteRole deletes a role
@@ -320,7 +319,14 @@ func (s *AdminService) DeleteRole(ctx context.Context, req *connect.Request[admi
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("cannot delete role: %d role binding(s) still reference it", bindingCount))
 	}
 
-	if err := database.DB.Delete(&role).Error; err != nil {
+	// Roles that inherited from this one lose its permissions along with the edge
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := database.DeleteRoleInheritanceForRole(ctx, tx, role.ID); err != nil {
+			return err
+		}
+		return tx.Delete(&role).Error
+	})
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("delete role: %w", err))
 	}
 
@@ -0,0 +1,291 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/platform"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+
+	"github.com/crewjam/saml"
+	"gorm.io/gorm"
+)
+
+// samlSessionTTL is how long the platform token issued after a SAML sign-in stays valid
+const samlSessionTTL = 8 * time.Hour
+
+// SAMLHandler serves the SAML 2.0 service provider endpoints for organizations that
+// configured an identity provider with OrganizationService.ConfigureSAML.
+// Sign-ins are IdP-initiated: the IdP posts a signed assertion to the callback.
+type SAMLHandler struct {
+	dashboardURL string
+}
+
+func NewSAMLHandler() *SAMLHandler {
+	return &SAMLHandler{dashboardURL: platform.DashboardURL()}
+}
+
+// Register adds the metadata and callback endpoints to mux
+func (h *SAMLHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc(auth.SAMLMetadataPath, h.ServeMetadata)
+	mux.HandleFunc(auth.SAMLCallbackPath, h.ServeCallback)
+}
+
+// ServeMetadata handles GET /auth/saml/metadata?organization_id=... with the SP metadata XML
+func (h *SAMLHandler) ServeMetadata(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	config, status, err := loadSAMLConfig(r.URL.Query().Get("organization_id"))
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	sp, err := newSAMLServiceProvider(config)
+	if err != nil {
+		logger.Error("[SAML] Invalid SAML config for org %s: %v", config.OrganizationID, err)
+		http.Error(w, "SAML is misconfigured for this organization", http.StatusInternalServerError)
+		return
+	}
+
+	metadata, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+	if err != nil {
+		http.Error(w, "failed to encode metadata", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(metadata)
+}
+
+// ServeCallback handles POST /auth/saml/callback?organization_id=... (the assertion consumer service).
+// It validates the assertion against the stored IdP certificate, provisions the user's membership,
+// issues a platform token and redirects to the dashboard.
+func (h *SAMLHandler) ServeCallback(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form body", http.StatusBadRequest)
+		return
+	}
+
+	config, status, err := loadSAMLConfig(r.URL.Query().Get("organization_id"))
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	sp, err := newSAMLServiceProvider(config)
+	if err != nil {
+		logger.Error("[SAML] Invalid SAML config for org %s: %v", config.OrganizationID, err)
+		http.Error(w, "SAML is misconfigured for this organization", http.StatusInternalServerError)
+		return
+	}
+
+	assertion, err := sp.ParseResponse(r, nil)
+	if err != nil {
+		var invalid *saml.InvalidResponseError
+		if errors.As(err, &invalid) {
+			err = invalid.PrivateErr
+		}
+		logger.Warn("[SAML] Rejected assertion for org %s: %v", config.OrganizationID, err)
+		http.Error(w, "invalid SAML assertion", http.StatusForbidden)
+		return
+	}
+
+	mapping, err := database.DecodeSAMLAttributeMapping(config.AttributeMapping)
+	if err != nil {
+		logger.Warn("[SAML] Ignoring unreadable attribute mapping for org %s: %v", config.OrganizationID, err)
+		mapping = map[string]string{}
+	}
+	user, err := samlUser(config.OrganizationID, assertion, mapping)
+	if err != nil {
+		logger.Warn("[SAML] Assertion for org %s has no usable email: %v", config.OrganizationID, err)
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err := provisionSAMLMember(config.OrganizationID, user); err != nil {
+		logger.Error("[SAML] Failed to provision %s in org %s: %v", user.Email, config.OrganizationID, err)
+		http.Error(w, "failed to provision user", http.StatusInternalServerError)
+		return
+	}
+
+	token, err := auth.IssuePlatformToken(user, config.OrganizationID, samlSessionTTL)
+	if err != nil {
+		logger.Error("[SAML] Failed to issue platform token: %v", err)
+		http.Error(w, "failed to issue session", http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("[SAML] Signed in %s to org %s", user.Email, config.OrganizationID)
+	redirect := h.dashboardURL + "/auth/saml?" + url.Values{
+		"token":           {token},
+		"expires_in":      {strconv.Itoa(int(samlSessionTTL.Seconds()))},
+		"organization_id": {config.OrganizationID},
+	}.Encode()
+	http.Redirect(w, r, redirect, http.StatusSeeOther)
+}
+
+func loadSAMLConfig(organizationID string) (*database.SAMLConfig, int, error) {
+	organizationID = strings.TrimSpace(organizationID)
+	if organizationID == "" {
+		return nil, http.StatusBadRequest, fmt.Errorf("organization_id is required")
+	}
+	var config database.SAMLConfig
+	if err := database.DB.Where("organization_id = ?", organizationID).First(&config).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, http.StatusNotFound, fmt.Errorf("SAML is not configured for this organization")
+		}
+		return nil, http.StatusInternalServerError, fmt.Errorf("failed to load SAML config")
+	}
+	return &config, http.StatusOK, nil
+}
+
+func newSAMLServiceProvider(config *database.SAMLConfig) (*saml.ServiceProvider, error) {
+	block, _ := pem.Decode([]byte(config.Certificate))
+	if block == nil {
+		return nil, fmt.Errorf("IdP certificate is not PEM encoded")
+	}
+	acsURL, metadataURL := auth.SAMLServiceProviderURLs(config.OrganizationID)
+	acs, err := url.Parse(acsURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse ACS URL: %w", err)
+	}
+	metadata, err := url.Parse(metadataURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse metadata URL: %w", err)
+	}
+
+	return &saml.ServiceProvider{
+		EntityID:    metadataURL,
+		MetadataURL: *metadata,
+		AcsURL:      *acs,
+		IDPMetadata: &saml.EntityDescriptor{
+			EntityID: config.EntityID,
+			IDPSSODescriptors: []saml.IDPSSODescriptor{{
+				SSODescriptor: saml.SSODescriptor{
+					RoleDescriptor: saml.RoleDescriptor{
+						ProtocolSupportEnumeration: "urn:oasis:names:tc:SAML:2.0:protocol",
+						KeyDescriptors: []saml.KeyDescriptor{{
+							Use: "signing",
+							KeyInfo: saml.KeyInfo{X509Data: saml.X509Data{
+								X509Certificates: []saml.X509Certificate{{Data: base64.StdEncoding.EncodeToString(block.Bytes)}},
+							}},
+						}},
+					},
+				},
+				SingleSignOnServices: []saml.Endpoint{
+					{Binding: saml.HTTPRedirectBinding, Location: config.SSOURL},
+					{Binding: saml.HTTPPostBinding, Location: config.SSOURL},
+				},
+			}},
+		},
+		AllowIDPInitiated: true,
+	}, nil
+}
+
+// samlUser builds the platform user for an assertion. User IDs are scoped to the organization
+// so one organization's IdP can never sign in as a member of another organization.
+func samlUser(organizationID string, assertion *saml.Assertion, mapping map[string]string) (*authv1.User, error) {
+	email := ""
+	if attribute := mapping[database.SAMLAttributeEmail]; attribute != "" {
+		email = samlAttribute(assertion, attribute)
+	} else if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		email = assertion.Subject.NameID.Value
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	if !strings.Contains(email, "@") {
+		return nil, fmt.Errorf("SAML assertion does not contain an email address")
+	}
+
+	user := &authv1.User{
+		Id:            "saml:" + organizationID + ":" + email,
+		Email:         email,
+		EmailVerified: true,
+	}
+	if attribute := mapping[database.SAMLAttributeGivenName]; attribute != "" {
+		user.GivenName = samlAttribute(assertion, attribute)
+	}
+	if attribute := mapping[database.SAMLAttributeFamilyName]; attribute != "" {
+		user.FamilyName = samlAttribute(assertion, attribute)
+	}
+	if attribute := mapping[database.SAMLAttributeName]; attribute != "" {
+		user.Name = samlAttribute(assertion, attribute)
+	}
+	if user.Name == "" {
+		user.Name = strings.TrimSpace(user.GivenName + " " + user.FamilyName)
+	}
+	if user.Name == "" {
+		user.Name = email
+	}
+	return user, nil
+}
+
+// samlAttribute returns the first value of the attribute whose Name or FriendlyName matches
+func samlAttribute(assertion *saml.Assertion, name string) string {
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			if attribute.Name != name && attribute.FriendlyName != name {
+				continue
+			}
+			for _, value := range attribute.Values {
+				if v := strings.TrimSpace(value.Value); v != "" {
+					return v
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// provisionSAMLMember makes user an active member of the organization. A pending invite for the
+// user's email is accepted with its invited role; otherwise the user joins as a member.
+func provisionSAMLMember(organizationID string, user *authv1.User) error {
+	var member database.OrganizationMember
+	err := database.DB.Where("organization_id = ? AND user_id = ?", organizationID, user.Id).First(&member).Error
+	if err == nil {
+		if member.Status == "active" {
+			return nil
+		}
+		return database.DB.Model(&member).Update("status", "active").Error
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("load membership: %w", err)
+	}
+
+	pendingUserID := "pending:" + strings.ToLower(user.Email)
+	err = database.DB.Where("organization_id = ? AND LOWER(user_id) = ? AND status = ?", organizationID, pendingUserID, "invited").First(&member).Error
+	if err == nil {
+		member.UserID = user.Id
+		member.Status = "active"
+		member.JoinedAt = time.Now()
+		return database.DB.Save(&member).Error
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("load pending invite: %w", err)
+	}
+
+	return database.DB.Create(&database.OrganizationMember{
+		ID:             generateID("mem"),
+		OrganizationID: organizationID,
+		UserID:         user.Id,
+		Role:           auth.SystemRoleIDMember,
+		Status:         "active",
+		JoinedAt:       time.Now(),
+	}).Error
+}
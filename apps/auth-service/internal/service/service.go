@@ -13,6 +13,8 @@ import (
 	"fmt"
 	"github.com/obiente/cloud/apps/shared/pkg/auth"
 	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/middleware"
 	"github.com/obiente/cloud/apps/shared/pkg/services/common"
 	"github.com/obiente/cloud/apps/shared/pkg/services/organizations"
 	"github.com/obiente/cloud/apps/shared/pkg/zitadel"
@@ -65,6 +67,17 @@ func (s *Service) Login(ctx context.Context, req *connect.Request[authv1.LoginRe
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("email and password are required"))
 	}
 
+	// Reject logins from banned IP ranges and email domains before contacting Zitadel
+	clientIP := middleware.GetRequestClientIP(req)
+	ban, err := database.FindBanListMatch(ctx, s.db, clientIP, email)
+	if err != nil {
+		// Fail open: a ban list outage should not lock everyone out
+		logger.Warn("[Auth] Failed to check ban list: %v", err)
+	} else if ban != nil {
+		logger.Info("[Auth] Rejected login for %s from %s: matches ban list entry %s (%s %s)", email, clientIP, ban.ID, ban.Type, ban.Value)
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("login is not allowed from this network or email domain"))
+	}
+
 	// Use Zitadel client to authenticate
 	zitadelClient := zitadel.NewClient()
 	loginResp, err := zitadelClient.Login(email, password)
@@ -51,6 +51,7 @@ func main() {
 		&database.OrganizationMember{},
 		&database.OrgRole{},
 		&database.OrgRoleBinding{},
+		&database.RoleInheritance{},
 		&database.OrgQuota{},
 		&database.GitHubIntegration{},
 		&database.SuperadminRole{},
@@ -63,6 +64,13 @@ func main() {
 	}
 	logger.Info("✓ Database initialized")
 
+	// Initialize Redis (for maintenance mode, etc.)
+	if err := database.InitRedis(); err != nil {
+		logger.Warn("Redis initialization failed: %v. Some features may not work correctly.", err)
+	} else {
+		logger.Info("✓ Redis initialized")
+	}
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "3002"
@@ -78,6 +86,9 @@ func main() {
 	// Create audit interceptor
 	auditInterceptor := middleware.AuditLogInterceptor()
 
+	// Create maintenance mode interceptor (rejects mutating RPCs during platform maintenance)
+	maintenanceInterceptor := middleware.MaintenanceModeInterceptor()
+
 	// Register all service procedures for permission discovery
 	auth.RegisterAllServices()
 
@@ -87,7 +98,7 @@ func main() {
 	authService := authsvc.NewService()
 	authPath, authHandler := authv1connect.NewAuthServiceHandler(
 		authService,
-		connect.WithInterceptors(auditInterceptor, authInterceptor),
+		connect.WithInterceptors(auditInterceptor, authInterceptor, maintenanceInterceptor),
 	)
 	mux.Handle(authPath, authHandler)
 
@@ -95,10 +106,13 @@ func main() {
 	adminService := authsvc.NewAdminService()
 	adminPath, adminHandler := adminv1connect.NewAdminServiceHandler(
 		adminService,
-		connect.WithInterceptors(auditInterceptor, authInterceptor),
+		connect.WithInterceptors(auditInterceptor, authInterceptor, maintenanceInterceptor),
 	)
 	mux.Handle(adminPath, adminHandler)
 
+	// SAML single sign-on service provider endpoints (public; the assertion is the credential)
+	authsvc.NewSAMLHandler().Register(mux)
+
 	// Health check endpoint with replica ID
 	mux.HandleFunc("/health", health.HandleHealth("auth-service", func() (bool, string, map[string]interface{}) {
 		// Check database connection
@@ -0,0 +1,118 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// freeCreditLifetime is how long granted free credits can be used before the unused remainder expires
+const freeCreditLifetime = 90 * 24 * time.Hour
+
+// freeCreditExpiry returns the expiry time for free credits granted at grantedAt
+func freeCreditExpiry(grantedAt time.Time) *time.Time {
+	expiresAt := grantedAt.Add(freeCreditLifetime)
+	return &expiresAt
+}
+
+// ExpireCredits removes the unused remainder of credit grants whose expiry has passed
+// Credits are treated as spent oldest first, so a grant is unused only as far as the current balance
+// is not covered by credits added after it
+func ExpireCredits(ctx context.Context) error {
+	return expireCredits(ctx, time.Now())
+}
+
+func expireCredits(ctx context.Context, now time.Time) error {
+	var orgIDs []string
+	if err := database.DB.WithContext(ctx).Model(&database.CreditTransaction{}).
+		Where("expires_at <= ? AND expired_at IS NULL AND amount_cents > 0", now).
+		Distinct().Pluck("organization_id", &orgIDs).Error; err != nil {
+		return fmt.Errorf("get expired credits: %w", err)
+	}
+	if len(orgIDs) == 0 {
+		return nil
+	}
+
+	log.Printf("[Credit Expiry] Expiring credits for %d organizations", len(orgIDs))
+
+	var totalExpired int64
+	for _, orgID := range orgIDs {
+		expired, err := expireOrganizationCredits(ctx, orgID, now)
+		if err != nil {
+			log.Printf("[Credit Expiry] Error expiring credits for org %s: %v", orgID, err)
+			continue
+		}
+		totalExpired += expired
+	}
+
+	log.Printf("[Credit Expiry] Completed: %d cents expired", totalExpired)
+	return nil
+}
+
+func expireOrganizationCredits(ctx context.Context, orgID string, now time.Time) (int64, error) {
+	var totalExpired int64
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var org database.Organization
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&org, "id = ?", orgID).Error; err != nil {
+			return fmt.Errorf("organization not found: %w", err)
+		}
+
+		var grants []database.CreditTransaction
+		if err := tx.Where("organization_id = ? AND expires_at <= ? AND expired_at IS NULL AND amount_cents > 0", orgID, now).
+			Order("created_at ASC").Find(&grants).Error; err != nil {
+			return fmt.Errorf("get expired credits: %w", err)
+		}
+
+		for _, grant := range grants {
+			var addedAfter int64
+			if err := tx.Model(&database.CreditTransaction{}).
+				Where("organization_id = ? AND amount_cents > 0 AND created_at > ?", orgID, grant.CreatedAt).
+				Select("COALESCE(SUM(amount_cents), 0)").Scan(&addedAfter).Error; err != nil {
+				return fmt.Errorf("sum later credits: %w", err)
+			}
+
+			unused := min(max(org.Credits-addedAfter, 0), grant.AmountCents)
+			if unused > 0 {
+				org.Credits -= unused
+				if err := tx.Model(&org).Update("credits", org.Credits).Error; err != nil {
+					return fmt.Errorf("update credits: %w", err)
+				}
+
+				note := fmt.Sprintf("Expired unused credits from %s (transaction %s)", grant.CreatedAt.Format("2006-01-02"), grant.ID)
+				if err := tx.Create(&database.CreditTransaction{
+					ID:             generateID("ct"),
+					OrganizationID: orgID,
+					AmountCents:    -unused,
+					BalanceAfter:   org.Credits,
+					Type:           "credit_expiry",
+					Source:         "system",
+					Note:           &note,
+					CreatedAt:      now,
+				}).Error; err != nil {
+					return fmt.Errorf("create transaction: %w", err)
+				}
+				totalExpired += unused
+			}
+
+			if err := tx.Model(&database.CreditTransaction{}).Where("id = ?", grant.ID).
+				Update("expired_at", now).Error; err != nil {
+				return fmt.Errorf("mark expired: %w", err)
+			}
+		}
+
+		if totalExpired > 0 {
+			log.Printf("[Credit Expiry] Expired %d cents for org %s (balance: %d cents)", totalExpired, orgID, org.Credits)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return totalExpired, nil
+}
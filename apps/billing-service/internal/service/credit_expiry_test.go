@@ -0,0 +1,103 @@
+package billing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/gorm"
+)
+
+func TestExpireCreditsAfterNinetyDays(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationPlan{},
+		&database.OrgQuota{},
+		&database.MonthlyCreditGrant{},
+		&database.CreditTransaction{},
+	)
+
+	records := []any{
+		&database.Organization{ID: "org-a", Name: "Org A", Slug: "org-a", Status: "active", CreatedAt: time.Now()},
+		&database.OrganizationPlan{ID: "plan-basic", Name: "Basic", MonthlyFreeCreditsCents: 1000},
+		&database.OrgQuota{OrganizationID: "org-a", PlanID: "plan-basic"},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	if err := GrantMonthlyFreeCredits(); err != nil {
+		t.Fatalf("GrantMonthlyFreeCredits: %v", err)
+	}
+	if balance := creditBalance(t, db, "org-a"); balance != 1000 {
+		t.Fatalf("balance after grant = %d, want 1000", balance)
+	}
+
+	// Not yet expired
+	if err := expireCredits(context.Background(), time.Now().Add(89*24*time.Hour)); err != nil {
+		t.Fatalf("expireCredits: %v", err)
+	}
+	if balance := creditBalance(t, db, "org-a"); balance != 1000 {
+		t.Fatalf("balance after 89 days = %d, want 1000", balance)
+	}
+
+	later := time.Now().Add(91 * 24 * time.Hour)
+	for run := 0; run < 2; run++ {
+		if err := expireCredits(context.Background(), later); err != nil {
+			t.Fatalf("expireCredits run %d: %v", run, err)
+		}
+		if balance := creditBalance(t, db, "org-a"); balance != 0 {
+			t.Fatalf("run %d: balance after 91 days = %d, want 0", run, balance)
+		}
+	}
+
+	var expiries []database.CreditTransaction
+	db.Where("organization_id = ? AND type = ?", "org-a", "credit_expiry").Find(&expiries)
+	if len(expiries) != 1 || expiries[0].AmountCents != -1000 {
+		t.Fatalf("expiry transactions = %+v, want one of -1000 cents", expiries)
+	}
+}
+
+func TestExpireCreditsKeepsSpentAndNewerCredits(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationPlan{},
+		&database.OrgQuota{},
+		&database.MonthlyCreditGrant{},
+		&database.CreditTransaction{},
+	)
+
+	granted := time.Now().Add(-100 * 24 * time.Hour)
+	records := []any{
+		// 1000 granted, 300 spent, then 500 bought: 1200 left, of which 500 are the newer purchase
+		&database.Organization{ID: "org-a", Name: "Org A", Slug: "org-a", Status: "active", Credits: 1200, CreatedAt: granted},
+		&database.CreditTransaction{ID: "ct-grant", OrganizationID: "org-a", AmountCents: 1000, BalanceAfter: 1000, Type: "admin_add", Source: "system", ExpiresAt: freeCreditExpiry(granted), CreatedAt: granted},
+		&database.CreditTransaction{ID: "ct-usage", OrganizationID: "org-a", AmountCents: -300, BalanceAfter: 700, Type: "usage", Source: "system", CreatedAt: granted.Add(24 * time.Hour)},
+		&database.CreditTransaction{ID: "ct-payment", OrganizationID: "org-a", AmountCents: 500, BalanceAfter: 1200, Type: "payment", Source: "stripe", CreatedAt: granted.Add(48 * time.Hour)},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	if err := ExpireCredits(context.Background()); err != nil {
+		t.Fatalf("ExpireCredits: %v", err)
+	}
+	if balance := creditBalance(t, db, "org-a"); balance != 500 {
+		t.Fatalf("balance = %d, want 500", balance)
+	}
+}
+
+func creditBalance(t *testing.T, db *gorm.DB, orgID string) int64 {
+	t.Helper()
+
+	var org database.Organization
+	if err := db.First(&org, "id = ?", orgID).Error; err != nil {
+		t.Fatalf("load org: %v", err)
+	}
+	return org.Credits
+}
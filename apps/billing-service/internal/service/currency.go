@@ -0,0 +1,352 @@
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// baseCurrency is the currency all prices, credits and bills are stored in
+	baseCurrency = "USD"
+	// CurrencyRefreshInterval is how often exchange rates are fetched
+	CurrencyRefreshInterval = 6 * time.Hour
+)
+
+var (
+	// ecbRatesURL serves the ECB's daily euro foreign exchange reference rates (used without an Open Exchange Rates app ID)
+	ecbRatesURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+	// openExchangeRatesURL serves the latest USD rates from Open Exchange Rates (OPEN_EXCHANGE_RATES_APP_ID)
+	openExchangeRatesURL = "https://openexchangerates.org/api/latest.json"
+
+	exchangeRateHTTPClient = &http.Client{Timeout: 30 * time.Second}
+)
+
+// supportedCurrencies maps the currencies prices can be shown and charged in to their symbols
+// Only currencies with two decimal places are offered, so amounts stay in cents
+var supportedCurrencies = map[string]string{
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"CAD": "CA$",
+	"AUD": "A$",
+	"NZD": "NZ$",
+	"CHF": "CHF",
+	"SEK": "kr",
+	"NOK": "kr",
+	"DKK": "kr",
+	"PLN": "zł",
+	"SGD": "S$",
+}
+
+// RefreshExchangeRates fetches current exchange rates and stores those of the supported currencies
+func RefreshExchangeRates(ctx context.Context) error {
+	rates, source, err := fetchExchangeRates(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	currencies := make([]database.Currency, 0, len(supportedCurrencies))
+	for code, symbol := range supportedCurrencies {
+		rate, ok := rates[code]
+		if !ok || rate <= 0 {
+			log.Printf("[Currency] %s has no rate for %s, keeping the previous rate", source, code)
+			continue
+		}
+		currencies = append(currencies, database.Currency{Code: code, Symbol: symbol, ExchangeRateToUSD: rate, UpdatedAt: now})
+	}
+	if len(currencies) == 0 {
+		return fmt.Errorf("%s returned no rates for supported currencies", source)
+	}
+
+	if err := database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "code"}},
+		DoUpdates: clause.AssignmentColumns([]string{"symbol", "exchange_rate_to_usd", "updated_at"}),
+	}).Create(&currencies).Error; err != nil {
+		return fmt.Errorf("store exchange rates: %w", err)
+	}
+
+	log.Printf("[Currency] Updated %d exchange rates from %s", len(currencies), source)
+	return nil
+}
+
+// fetchExchangeRates returns units of each currency per US dollar and the name of the source
+func fetchExchangeRates(ctx context.Context) (map[string]float64, string, error) {
+	if appID := strings.TrimSpace(os.Getenv("OPEN_EXCHANGE_RATES_APP_ID")); appID != "" {
+		rates, err := fetchOpenExchangeRates(ctx, appID)
+		return rates, "Open Exchange Rates", err
+	}
+	rates, err := fetchECBRates(ctx)
+	return rates, "ECB", err
+}
+
+func fetchOpenExchangeRates(ctx context.Context, appID string) (map[string]float64, error) {
+	body, err := getExchangeRates(ctx, openExchangeRatesURL+"?app_id="+url.QueryEscape(appID))
+	if err != nil {
+		return nil, err
+	}
+
+	var payload struct {
+		Base  string             `json:"base"`
+		Rates map[string]float64 `json:"rates"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decode Open Exchange Rates response: %w", err)
+	}
+	if payload.Base != baseCurrency {
+		return nil, fmt.Errorf("Open Exchange Rates returned %s rates, want %s", payload.Base, baseCurrency)
+	}
+	payload.Rates[baseCurrency] = 1
+	return payload.Rates, nil
+}
+
+// fetchECBRates converts the ECB's euro reference rates to rates against the dollar
+func fetchECBRates(ctx context.Context) (map[string]float64, error) {
+	body, err := getExchangeRates(ctx, ecbRatesURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var envelope struct {
+		Cube struct {
+			Cube struct {
+				Rates []struct {
+					Currency string  `xml:"currency,attr"`
+					Rate     float64 `xml:"rate,attr"`
+				} `xml:"Cube"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	}
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("decode ECB response: %w", err)
+	}
+
+	perEUR := map[string]float64{"EUR": 1}
+	for _, rate := range envelope.Cube.Cube.Rates {
+		perEUR[rate.Currency] = rate.Rate
+	}
+	usdPerEUR := perEUR[baseCurrency]
+	if usdPerEUR <= 0 {
+		return nil, errors.New("ECB response has no USD rate")
+	}
+
+	rates := make(map[string]float64, len(perEUR))
+	for code, rate := range perEUR {
+		rates[code] = rate / usdPerEUR
+	}
+	return rates, nil
+}
+
+func getExchangeRates(ctx context.Context, rawURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create exchange rate request: %w", err)
+	}
+	resp, err := exchangeRateHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch exchange rates: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch exchange rates: unexpected status %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read exchange rates: %w", err)
+	}
+	return body, nil
+}
+
+// convertFromUSD converts an amount in USD cents to cents of a currency, rounded to the nearest cent
+func convertFromUSD(usdCents int64, rate float64) int64 {
+	return int64(math.Round(float64(usdCents) * rate))
+}
+
+// currencyRate returns the stored exchange rate for a currency; USD is always available
+func currencyRate(ctx context.Context, code string) (*database.Currency, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" || code == baseCurrency {
+		return &database.Currency{Code: baseCurrency, Symbol: supportedCurrencies[baseCurrency], ExchangeRateToUSD: 1}, nil
+	}
+	if _, ok := supportedCurrencies[code]; !ok {
+		return nil, fmt.Errorf("unsupported currency %q", code)
+	}
+
+	var currency database.Currency
+	if err := database.DB.WithContext(ctx).First(&currency, "code = ?", code).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("no exchange rate for %s yet", code)
+		}
+		return nil, fmt.Errorf("get exchange rate for %s: %w", code, err)
+	}
+	return &currency, nil
+}
+
+// displayAmount converts USD cents to an organization's preferred currency. Without a rate for
+// the currency the amount is shown in USD.
+func displayAmount(ctx context.Context, code string, usdCents int64) (*database.Currency, int64) {
+	currency, err := currencyRate(ctx, code)
+	if err != nil {
+		log.Printf("[Currency] %v; showing the amount in %s", err, baseCurrency)
+		currency, _ = currencyRate(ctx, baseCurrency)
+	}
+	return currency, convertFromUSD(usdCents, currency.ExchangeRateToUSD)
+}
+
+func currencyToProto(currency *database.Currency) *billingv1.Currency {
+	proto := &billingv1.Currency{
+		Code:              currency.Code,
+		Symbol:            currency.Symbol,
+		ExchangeRateToUsd: currency.ExchangeRateToUSD,
+	}
+	if !currency.UpdatedAt.IsZero() {
+		proto.UpdatedAt = timestamppb.New(currency.UpdatedAt)
+	}
+	return proto
+}
+
+// GetBalance returns an organization's credit balance in USD and in its preferred currency
+func (s *Service) GetBalance(ctx context.Context, req *connect.Request[billingv1.GetBalanceRequest]) (*connect.Response[billingv1.GetBalanceResponse], error) {
+	if err := s.checkBillingEnabled(); err != nil {
+		return nil, err
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+
+	if err := common.VerifyOrgAccess(ctx, orgID, user); err != nil {
+		return nil, err
+	}
+
+	balance, err := organizationBalance(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(balance), nil
+}
+
+func organizationBalance(ctx context.Context, orgID string) (*billingv1.GetBalanceResponse, error) {
+	var org database.Organization
+	if err := database.DB.WithContext(ctx).First(&org, "id = ?", orgID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get organization: %w", err))
+	}
+
+	preferred := baseCurrency
+	var billingAccount database.BillingAccount
+	if err := database.DB.WithContext(ctx).Where("organization_id = ?", orgID).First(&billingAccount).Error; err == nil {
+		preferred = billingAccount.Currency
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get billing account: %w", err))
+	}
+
+	currency, displayCents := displayAmount(ctx, preferred, org.Credits)
+	return &billingv1.GetBalanceResponse{
+		BalanceCents:        org.Credits,
+		Currency:            currency.Code,
+		DisplayBalanceCents: displayCents,
+		ExchangeRateToUsd:   currency.ExchangeRateToUSD,
+	}, nil
+}
+
+// GetSupportedCurrencies lists the currencies an organization can choose, with their current rates
+func (s *Service) GetSupportedCurrencies(ctx context.Context, req *connect.Request[billingv1.GetSupportedCurrenciesRequest]) (*connect.Response[billingv1.GetSupportedCurrenciesResponse], error) {
+	if err := s.checkBillingEnabled(); err != nil {
+		return nil, err
+	}
+
+	var stored []database.Currency
+	if err := database.DB.WithContext(ctx).Find(&stored).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list currencies: %w", err))
+	}
+
+	// Currencies without a rate yet can't be converted to, so they are left out
+	currencies := []*billingv1.Currency{}
+	hasBase := false
+	for i := range stored {
+		if _, ok := supportedCurrencies[stored[i].Code]; !ok {
+			continue
+		}
+		hasBase = hasBase || stored[i].Code == baseCurrency
+		currencies = append(currencies, currencyToProto(&stored[i]))
+	}
+	if !hasBase {
+		base, _ := currencyRate(ctx, baseCurrency)
+		currencies = append(currencies, currencyToProto(base))
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i].Code < currencies[j].Code })
+
+	return connect.NewResponse(&billingv1.GetSupportedCurrenciesResponse{Currencies: currencies}), nil
+}
+
+// SetPreferredCurrency sets the currency an organization's balance and bills are shown in and credits are charged in
+func (s *Service) SetPreferredCurrency(ctx context.Context, req *connect.Request[billingv1.SetPreferredCurrencyRequest]) (*connect.Response[billingv1.SetPreferredCurrencyResponse], error) {
+	if err := s.checkBillingEnabled(); err != nil {
+		return nil, err
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+
+	if err := common.AuthorizeOrgAdmin(ctx, orgID, user); err != nil {
+		return nil, err
+	}
+
+	currency, err := currencyRate(ctx, req.Msg.GetCurrency())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	billingAccount, err := s.getOrCreateBillingAccount(orgID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get billing account: %w", err))
+	}
+	billingAccount.Currency = currency.Code
+	billingAccount.UpdatedAt = time.Now()
+	if err := database.DB.WithContext(ctx).Save(billingAccount).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update billing account: %w", err))
+	}
+
+	log.Printf("[Currency] Organization %s now uses %s", orgID, currency.Code)
+	return connect.NewResponse(&billingv1.SetPreferredCurrencyResponse{Account: s.billingAccountToProto(billingAccount)}), nil
+}
@@ -0,0 +1,147 @@
+package billing
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+const ecbTestResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2026-10-15">
+			<Cube currency="USD" rate="1.0850"/>
+			<Cube currency="JPY" rate="162.10"/>
+			<Cube currency="GBP" rate="0.8432"/>
+			<Cube currency="CHF" rate="0.9401"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestConvertFromUSDRoundsToCents(t *testing.T) {
+	tests := []struct {
+		usdCents int64
+		rate     float64
+		want     int64
+	}{
+		{1000, 1, 1000},
+		{1000, 0.92345, 923},    // 923.45
+		{1000, 0.92355, 924},    // 923.55
+		{1, 0.925, 1},           // half a cent or more rounds up
+		{1, 0.495, 0},           // under half a cent rounds down
+		{12345, 1.23456, 15241}, // 15240.6432
+		{-1005, 0.5, -503},      // negative balances round away from zero like positive ones
+		{0, 1.5, 0},
+	}
+	for _, tt := range tests {
+		if got := convertFromUSD(tt.usdCents, tt.rate); got != tt.want {
+			t.Errorf("convertFromUSD(%d, %v) = %d, want %d", tt.usdCents, tt.rate, got, tt.want)
+		}
+	}
+}
+
+func TestRefreshExchangeRatesFromECB(t *testing.T) {
+	db := newTestDB(t, &database.Currency{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(ecbTestResponse))
+	}))
+	t.Cleanup(server.Close)
+	previousURL := ecbRatesURL
+	ecbRatesURL = server.URL
+	t.Cleanup(func() { ecbRatesURL = previousURL })
+	t.Setenv("OPEN_EXCHANGE_RATES_APP_ID", "")
+
+	if err := RefreshExchangeRates(context.Background()); err != nil {
+		t.Fatalf("RefreshExchangeRates: %v", err)
+	}
+
+	var currencies []database.Currency
+	db.Order("code").Find(&currencies)
+	rates := make(map[string]float64, len(currencies))
+	for _, currency := range currencies {
+		rates[currency.Code] = currency.ExchangeRateToUSD
+	}
+	want := map[string]float64{"CHF": 0.9401 / 1.085, "EUR": 1 / 1.085, "GBP": 0.8432 / 1.085, "USD": 1}
+	if len(rates) != len(want) {
+		t.Fatalf("stored rates = %v, want only the supported currencies in %v", rates, want)
+	}
+	for code, rate := range want {
+		if math.Abs(rates[code]-rate) > 1e-9 {
+			t.Errorf("%s rate = %v, want %v", code, rates[code], rate)
+		}
+	}
+
+	// A second refresh updates the rows in place
+	if err := RefreshExchangeRates(context.Background()); err != nil {
+		t.Fatalf("second RefreshExchangeRates: %v", err)
+	}
+	var count int64
+	db.Model(&database.Currency{}).Count(&count)
+	if count != int64(len(want)) {
+		t.Fatalf("currencies after second refresh = %d, want %d", count, len(want))
+	}
+}
+
+func TestOrganizationBalanceInPreferredCurrency(t *testing.T) {
+	db := newTestDB(t, &database.Organization{}, &database.BillingAccount{}, &database.Currency{})
+	records := []any{
+		&database.Organization{ID: "org-eur", Name: "Euro", Slug: "euro", Status: "active", Credits: 1234},
+		&database.BillingAccount{ID: "ba-eur", OrganizationID: "org-eur", Status: "ACTIVE", Currency: "EUR"},
+		&database.Organization{ID: "org-gbp", Name: "Pound", Slug: "pound", Status: "active", Credits: 1234},
+		&database.BillingAccount{ID: "ba-gbp", OrganizationID: "org-gbp", Status: "ACTIVE", Currency: "GBP"},
+		&database.Organization{ID: "org-none", Name: "No account", Slug: "no-account", Status: "active", Credits: 500},
+		&database.Currency{Code: "EUR", Symbol: "€", ExchangeRateToUSD: 0.9215, UpdatedAt: time.Now()},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	tests := []struct {
+		orgID        string
+		usdCents     int64
+		currency     string
+		displayCents int64
+	}{
+		{"org-eur", 1234, "EUR", 1137}, // 1137.131
+		{"org-gbp", 1234, "USD", 1234}, // no GBP rate yet, so the balance is shown in USD
+		{"org-none", 500, "USD", 500},  // no billing account defaults to USD
+	}
+	for _, tt := range tests {
+		balance, err := organizationBalance(context.Background(), tt.orgID)
+		if err != nil {
+			t.Fatalf("organizationBalance(%s): %v", tt.orgID, err)
+		}
+		if balance.GetCurrency() != tt.currency || balance.GetDisplayBalanceCents() != tt.displayCents {
+			t.Errorf("%s balance = %d %s, want %d %s", tt.orgID, balance.GetDisplayBalanceCents(), balance.GetCurrency(), tt.displayCents, tt.currency)
+		}
+		if balance.GetBalanceCents() != tt.usdCents {
+			t.Errorf("%s USD balance = %d, want %d", tt.orgID, balance.GetBalanceCents(), tt.usdCents)
+		}
+	}
+}
+
+func TestBillStoresDisplayAmount(t *testing.T) {
+	db := newTestDB(t, &database.Currency{})
+	if err := db.Create(&database.Currency{Code: "CHF", Symbol: "CHF", ExchangeRateToUSD: 0.86651}).Error; err != nil {
+		t.Fatalf("seed currency: %v", err)
+	}
+
+	bill := &database.MonthlyBill{AmountCents: 4999}
+	setBillDisplayAmount(bill, "CHF")
+	if bill.AmountCents != 4999 || bill.DisplayCurrency != "CHF" || bill.DisplayAmountCents != 4332 { // 4331.68
+		t.Fatalf("bill = %d USD / %d %s, want 4999 USD / 4332 CHF", bill.AmountCents, bill.DisplayAmountCents, bill.DisplayCurrency)
+	}
+
+	proto := monthlyBillToProto(&database.MonthlyBill{AmountCents: 700})
+	if proto.GetDisplayCurrency() != "USD" || proto.GetDisplayAmountCents() != 700 {
+		t.Fatalf("bill without a display currency = %d %s, want 700 USD", proto.GetDisplayAmountCents(), proto.GetDisplayCurrency())
+	}
+}
@@ -0,0 +1,394 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/docker"
+	"github.com/obiente/cloud/apps/shared/pkg/email"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+	"github.com/obiente/cloud/apps/shared/pkg/services/organizations"
+
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// dunningSuspendedBy marks organization suspensions imposed by the dunning process
+const dunningSuspendedBy = "billing"
+
+// Billing account status once the dunning process gives up on an invoice
+const billingAccountStatusMarkedForDeletion = "MARKED_FOR_DELETION"
+
+var (
+	// billingMailer sends the dunning and spend alert emails; tests replace it
+	billingMailer = sync.OnceValue(email.NewSenderFromEnv)
+	// stopOrganizationWorkloads stops an organization's running resources; tests replace it
+	stopOrganizationWorkloads = stopRunningWorkloads
+)
+
+// HandlePaymentFailed escalates an organization's unpaid invoice after Stripe reports a failed
+// payment attempt. Stripe retries the payment 3, 7 and 14 days after the first failure, and each
+// attempt moves the organization one stage further:
+//
+//  1. a warning email to the billing contact
+//  2. new resource creation is suspended (org_quotas.allow_new_resources = false)
+//  3. the organization is suspended and its running resources are stopped
+//  4. the account is marked for deletion
+//
+// Every stage up to attemptCount is applied, so a missed webhook is caught up by the next one,
+// and stages already applied are not repeated when Stripe redelivers an event.
+func HandlePaymentFailed(ctx context.Context, orgID string, invoiceID string, attemptCount int32) error {
+	if attemptCount < 1 {
+		attemptCount = 1
+	}
+	now := time.Now()
+
+	var state database.BillingDunningState
+	err := database.DB.WithContext(ctx).Where("organization_id = ?", orgID).First(&state).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("load dunning state: %w", err)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		state = database.BillingDunningState{OrganizationID: orgID, CreatedAt: now}
+	}
+	state.InvoiceID = invoiceID
+	if attemptCount > state.AttemptCount {
+		state.AttemptCount = attemptCount
+	}
+	state.LastFailedAt = now
+
+	if state.WarningSentAt == nil {
+		sendBillingEmail(ctx, orgID, "Payment failed",
+			fmt.Sprintf("We could not collect payment for invoice %s.", invoiceID),
+			"Please update your payment method. If the payment keeps failing, creating new resources will be suspended when it is next retried in 3 days.")
+		state.WarningSentAt = &now
+		state.Stage = database.DunningStageWarning
+	}
+
+	if state.AttemptCount >= 2 && state.ResourceCreationSuspendedAt == nil {
+		if err := suspendNewResources(ctx, orgID); err != nil {
+			return err
+		}
+		sendBillingEmail(ctx, orgID, "Resource creation suspended",
+			fmt.Sprintf("Payment for invoice %s failed again, so new resources cannot be created until it is paid.", invoiceID),
+			"Running resources are not affected yet. They will be stopped if the payment fails again.")
+		state.ResourceCreationSuspendedAt = &now
+		state.Stage = database.DunningStageResourceCreationSuspended
+	}
+
+	if state.AttemptCount >= 3 && state.ResourcesSuspendedAt == nil {
+		reason := fmt.Sprintf("Payment failed for invoice %s", invoiceID)
+		if err := suspendOrganizationForBilling(ctx, orgID, reason, now); err != nil {
+			return err
+		}
+		stopOrganizationWorkloads(ctx, orgID)
+		sendBillingEmail(ctx, orgID, "Organization suspended",
+			fmt.Sprintf("Payment for invoice %s failed a third time, so your organization has been suspended and its running resources were stopped.", invoiceID),
+			"Pay the invoice to have the suspension lifted. If the final payment attempt fails, the account will be marked for deletion.")
+		state.ResourcesSuspendedAt = &now
+		state.Stage = database.DunningStageResourcesSuspended
+	}
+
+	if state.AttemptCount >= 4 && state.MarkedForDeletionAt == nil {
+		if err := database.DB.WithContext(ctx).Model(&database.BillingAccount{}).
+			Where("organization_id = ?", orgID).
+			Updates(map[string]interface{}{"status": billingAccountStatusMarkedForDeletion, "updated_at": now}).Error; err != nil {
+			return fmt.Errorf("mark billing account for deletion: %w", err)
+		}
+		sendBillingEmail(ctx, orgID, "Account marked for deletion",
+			fmt.Sprintf("The final payment attempt for invoice %s failed, and your account has been marked for deletion.", invoiceID),
+			"Contact support as soon as possible if you want to keep your organization and its data.")
+		state.MarkedForDeletionAt = &now
+		state.Stage = database.DunningStageMarkedForDeletion
+	}
+
+	state.UpdatedAt = now
+	if err := database.DB.WithContext(ctx).Save(&state).Error; err != nil {
+		return fmt.Errorf("save dunning state: %w", err)
+	}
+
+	log.Printf("[Dunning] Organization %s is at stage %s after failed attempt %d for invoice %s", orgID, state.Stage, attemptCount, invoiceID)
+	return nil
+}
+
+// suspendNewResources blocks the organization from creating new resources
+func suspendNewResources(ctx context.Context, orgID string) error {
+	// The quota row carries the flag; make sure it exists
+	_ = organizations.EnsurePlanAssigned(orgID)
+
+	allowed := false
+	quota := database.OrgQuota{OrganizationID: orgID}
+	if err := database.DB.WithContext(ctx).Where("organization_id = ?", orgID).
+		Assign(database.OrgQuota{AllowNewResources: &allowed}).
+		FirstOrCreate(&quota).Error; err != nil {
+		return fmt.Errorf("suspend new resources: %w", err)
+	}
+	return nil
+}
+
+// suspendOrganizationForBilling suspends an active organization for non-payment or a budget cap and records the suspension.
+// Organizations already suspended or banned by moderation keep their current state.
+func suspendOrganizationForBilling(ctx context.Context, orgID, reason string, now time.Time) error {
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&database.Organization{}).
+			Where("id = ? AND (status IS NULL OR status IN ?)", orgID, []string{"", database.OrganizationStatusActive}).
+			Updates(map[string]interface{}{
+				"status":             database.OrganizationStatusSuspended,
+				"suspended_at":       now,
+				"suspended_by":       dunningSuspendedBy,
+				"suspension_reason":  reason,
+				"suspension_expires": nil,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("suspend organization: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		suspension := database.OrgSuspension{
+			ID:             uuid.New().String(),
+			OrganizationID: orgID,
+			Reason:         &reason,
+			SuspendedBy:    dunningSuspendedBy,
+			SuspendedAt:    now,
+		}
+		if err := tx.Create(&suspension).Error; err != nil {
+			return fmt.Errorf("record suspension: %w", err)
+		}
+		return nil
+	})
+}
+
+// stopRunningWorkloads stops the organization's running deployment containers and game servers.
+// Failures are logged; the organization stays suspended either way.
+func stopRunningWorkloads(ctx context.Context, orgID string) {
+	var deploymentIDs []string
+	if err := database.DB.WithContext(ctx).Model(&database.Deployment{}).
+		Where("organization_id = ? AND status IN ?", orgID, []int32{
+			int32(deploymentsv1.DeploymentStatus_RUNNING),
+			int32(deploymentsv1.DeploymentStatus_DEPLOYING),
+			int32(deploymentsv1.DeploymentStatus_BUILDING),
+		}).
+		Pluck("id", &deploymentIDs).Error; err != nil {
+		log.Printf("[Dunning] Failed to list deployments of organization %s: %v", orgID, err)
+	}
+
+	var gameServers []database.GameServer
+	if err := database.DB.WithContext(ctx).Where("organization_id = ? AND status IN ?", orgID, []int32{
+		int32(gameserversv1.GameServerStatus_STARTING),
+		int32(gameserversv1.GameServerStatus_RUNNING),
+		int32(gameserversv1.GameServerStatus_RESTARTING),
+	}).Find(&gameServers).Error; err != nil {
+		log.Printf("[Dunning] Failed to list game servers of organization %s: %v", orgID, err)
+	}
+
+	if len(deploymentIDs) == 0 && len(gameServers) == 0 {
+		return
+	}
+
+	dockerClient, err := docker.New()
+	if err != nil {
+		log.Printf("[Dunning] Failed to create docker client to stop workloads of organization %s: %v", orgID, err)
+		return
+	}
+	defer dockerClient.Close()
+
+	if len(deploymentIDs) > 0 {
+		var locations []database.DeploymentLocation
+		if err := database.DB.WithContext(ctx).Where("deployment_id IN ? AND status = ?", deploymentIDs, "running").Find(&locations).Error; err != nil {
+			log.Printf("[Dunning] Failed to list deployment containers of organization %s: %v", orgID, err)
+		}
+		for _, location := range locations {
+			if err := dockerClient.StopContainer(ctx, location.ContainerID, 30*time.Second); err != nil {
+				log.Printf("[Dunning] Failed to stop deployment container %s: %v", location.ContainerID, err)
+				continue
+			}
+			database.DB.Model(&database.DeploymentLocation{}).Where("id = ?", location.ID).Update("status", "stopped")
+		}
+		database.DB.Model(&database.Deployment{}).Where("id IN ?", deploymentIDs).
+			Update("status", int32(deploymentsv1.DeploymentStatus_STOPPED))
+	}
+
+	for _, gs := range gameServers {
+		if gs.ContainerID != nil {
+			if err := dockerClient.StopContainer(ctx, *gs.ContainerID, 30*time.Second); err != nil {
+				log.Printf("[Dunning] Failed to stop game server container %s: %v", *gs.ContainerID, err)
+			}
+		}
+		database.DB.Model(&database.GameServer{}).Where("id = ?", gs.ID).
+			Update("status", int32(gameserversv1.GameServerStatus_STOPPED))
+	}
+
+	log.Printf("[Dunning] Stopped %d deployment(s) and %d game server(s) of organization %s", len(deploymentIDs), len(gameServers), orgID)
+}
+
+// sendBillingEmail emails the organization's billing contact
+func sendBillingEmail(ctx context.Context, orgID, heading string, introLines ...string) {
+	sender := billingMailer()
+	if sender == nil || !sender.Enabled() {
+		return
+	}
+	account, err := common.GetBillingAccount(orgID)
+	if err != nil {
+		log.Printf("[Billing] Failed to load billing account of organization %s: %v", orgID, err)
+		return
+	}
+	if account == nil || account.BillingEmail == nil || strings.TrimSpace(*account.BillingEmail) == "" {
+		log.Printf("[Billing] Organization %s has no billing contact, skipping %q email", orgID, heading)
+		return
+	}
+
+	message := &email.Message{
+		To:      []string{strings.TrimSpace(*account.BillingEmail)},
+		Subject: fmt.Sprintf("Obiente Cloud: %s", heading),
+		Template: &email.TemplateData{
+			Heading:    heading,
+			IntroLines: introLines,
+		},
+		Category: email.CategoryBilling,
+		Metadata: map[string]string{
+			"organization_id": orgID,
+		},
+	}
+	if err := sender.Send(ctx, message); err != nil {
+		log.Printf("[Billing] Failed to send %q email to organization %s: %v", heading, orgID, err)
+	}
+}
+
+// resetDunningState removes an organization's dunning state and lifts the restrictions it
+// imposed. Suspensions imposed by moderation are left in place. It reports whether the
+// organization had a dunning state.
+func resetDunningState(ctx context.Context, orgID, resetBy string) (bool, error) {
+	found := false
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("organization_id = ?", orgID).Delete(&database.BillingDunningState{})
+		if result.Error != nil {
+			return fmt.Errorf("delete dunning state: %w", result.Error)
+		}
+		found = result.RowsAffected > 0
+
+		if err := tx.Model(&database.OrgQuota{}).Where("organization_id = ?", orgID).
+			Update("allow_new_resources", true).Error; err != nil {
+			return fmt.Errorf("allow new resources: %w", err)
+		}
+
+		now := time.Now()
+		lifted := tx.Model(&database.Organization{}).
+			Where("id = ? AND status = ? AND suspended_by = ?", orgID, database.OrganizationStatusSuspended, dunningSuspendedBy).
+			Updates(map[string]interface{}{
+				"status":             database.OrganizationStatusActive,
+				"suspended_at":       nil,
+				"suspended_by":       nil,
+				"suspension_reason":  nil,
+				"suspension_expires": nil,
+			})
+		if lifted.Error != nil {
+			return fmt.Errorf("lift suspension: %w", lifted.Error)
+		}
+		if lifted.RowsAffected > 0 {
+			if err := tx.Model(&database.OrgSuspension{}).
+				Where("organization_id = ? AND suspended_by = ? AND lifted_at IS NULL", orgID, dunningSuspendedBy).
+				Updates(map[string]interface{}{"lifted_at": now, "lifted_by": resetBy}).Error; err != nil {
+				return fmt.Errorf("close suspension record: %w", err)
+			}
+		}
+
+		if err := tx.Model(&database.BillingAccount{}).
+			Where("organization_id = ? AND status IN ?", orgID, []string{"PAYMENT_FAILED", billingAccountStatusMarkedForDeletion}).
+			Updates(map[string]interface{}{"status": "ACTIVE", "updated_at": now}).Error; err != nil {
+			return fmt.Errorf("reactivate billing account: %w", err)
+		}
+		return nil
+	})
+	return found, err
+}
+
+// GetDunningState returns how far an organization's failed invoice has escalated (superadmin only)
+func (s *Service) GetDunningState(ctx context.Context, req *connect.Request[billingv1.GetDunningStateRequest]) (*connect.Response[billingv1.GetDunningStateResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.billing.read") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+
+	var state database.BillingDunningState
+	if err := database.DB.WithContext(ctx).Where("organization_id = ?", orgID).First(&state).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return connect.NewResponse(&billingv1.GetDunningStateResponse{}), nil
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("load dunning state: %w", err))
+	}
+
+	return connect.NewResponse(&billingv1.GetDunningStateResponse{State: dunningStateToProto(&state)}), nil
+}
+
+// ResetDunningState clears an organization's dunning state and lifts the restrictions it imposed (superadmin only)
+func (s *Service) ResetDunningState(ctx context.Context, req *connect.Request[billingv1.ResetDunningStateRequest]) (*connect.Response[billingv1.ResetDunningStateResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.billing.update") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+
+	found, err := resetDunningState(ctx, orgID, user.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !found {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization %s has no dunning state", orgID))
+	}
+
+	log.Printf("[Dunning] Dunning state of organization %s reset by %s", orgID, user.Id)
+	return connect.NewResponse(&billingv1.ResetDunningStateResponse{
+		Success: true,
+		Message: "Dunning state reset",
+	}), nil
+}
+
+func dunningStateToProto(state *database.BillingDunningState) *billingv1.DunningState {
+	optionalTimestamp := func(t *time.Time) *timestamppb.Timestamp {
+		if t == nil {
+			return nil
+		}
+		return timestamppb.New(*t)
+	}
+	return &billingv1.DunningState{
+		OrganizationId:              state.OrganizationID,
+		InvoiceId:                   state.InvoiceID,
+		AttemptCount:                state.AttemptCount,
+		Stage:                       state.Stage,
+		WarningSentAt:               optionalTimestamp(state.WarningSentAt),
+		ResourceCreationSuspendedAt: optionalTimestamp(state.ResourceCreationSuspendedAt),
+		ResourcesSuspendedAt:        optionalTimestamp(state.ResourcesSuspendedAt),
+		MarkedForDeletionAt:         optionalTimestamp(state.MarkedForDeletionAt),
+		LastFailedAt:                timestamppb.New(state.LastFailedAt),
+	}
+}
@@ -0,0 +1,184 @@
+package billing
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/email"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+	"gorm.io/gorm"
+)
+
+// setupDunningTest seeds an active org-a with a billing contact and records emails and stopped workloads
+func setupDunningTest(t *testing.T) (*gorm.DB, *recordingSender, *[]string) {
+	t.Helper()
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationPlan{},
+		&database.OrgQuota{},
+		&database.BillingAccount{},
+		&database.BillingDunningState{},
+		&database.OrgSuspension{},
+		&database.SuperadminRoleBinding{},
+	)
+	billingEmail := "billing@org-a.example"
+	seed := []any{
+		&database.Organization{ID: "org-a", Name: "Acme", Slug: "acme", Status: database.OrganizationStatusActive},
+		&database.OrgQuota{OrganizationID: "org-a", PlanID: "plan-starter"},
+		&database.BillingAccount{ID: "ba-org-a", OrganizationID: "org-a", Status: "PAYMENT_FAILED", BillingEmail: &billingEmail},
+	}
+	for _, record := range seed {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	sender := &recordingSender{}
+	previousMailer, previousStop := billingMailer, stopOrganizationWorkloads
+	billingMailer = func() email.Sender { return sender }
+	stopped := &[]string{}
+	stopOrganizationWorkloads = func(ctx context.Context, orgID string) { *stopped = append(*stopped, orgID) }
+	t.Cleanup(func() { billingMailer, stopOrganizationWorkloads = previousMailer, previousStop })
+
+	return db, sender, stopped
+}
+
+func loadDunningFixtures(t *testing.T, db *gorm.DB) (database.BillingDunningState, database.OrgQuota, database.Organization, database.BillingAccount) {
+	t.Helper()
+	var state database.BillingDunningState
+	var quota database.OrgQuota
+	var org database.Organization
+	var account database.BillingAccount
+	db.Where("organization_id = ?", "org-a").Find(&state)
+	db.Where("organization_id = ?", "org-a").First(&quota)
+	db.Where("id = ?", "org-a").First(&org)
+	db.Where("organization_id = ?", "org-a").First(&account)
+	return state, quota, org, account
+}
+
+func TestHandlePaymentFailedEscalatesPerAttempt(t *testing.T) {
+	db, sender, stopped := setupDunningTest(t)
+	ctx := context.Background()
+
+	if err := HandlePaymentFailed(ctx, "org-a", "in_123", 1); err != nil {
+		t.Fatalf("attempt 1: %v", err)
+	}
+	state, quota, org, _ := loadDunningFixtures(t, db)
+	if state.Stage != database.DunningStageWarning || state.WarningSentAt == nil {
+		t.Fatalf("after attempt 1 stage = %q, warning sent at %v", state.Stage, state.WarningSentAt)
+	}
+	if len(sender.messages) != 1 || sender.messages[0].To[0] != "billing@org-a.example" {
+		t.Fatalf("after attempt 1 sent %d emails, want a warning to the billing contact", len(sender.messages))
+	}
+	if !quota.NewResourcesAllowed() || org.Status != database.OrganizationStatusActive {
+		t.Fatal("attempt 1 must only warn")
+	}
+
+	// Stripe redelivering an attempt does not repeat its stage
+	for i := 0; i < 2; i++ {
+		if err := HandlePaymentFailed(ctx, "org-a", "in_123", 2); err != nil {
+			t.Fatalf("attempt 2: %v", err)
+		}
+	}
+	state, quota, org, _ = loadDunningFixtures(t, db)
+	if state.Stage != database.DunningStageResourceCreationSuspended || quota.NewResourcesAllowed() {
+		t.Fatalf("after attempt 2 stage = %q, new resources allowed = %v", state.Stage, quota.NewResourcesAllowed())
+	}
+	if org.Status != database.OrganizationStatusActive || len(*stopped) != 0 {
+		t.Fatal("attempt 2 must not suspend running resources")
+	}
+	if len(sender.messages) != 2 {
+		t.Fatalf("after redelivered attempt 2 sent %d emails, want 2", len(sender.messages))
+	}
+
+	if err := HandlePaymentFailed(ctx, "org-a", "in_123", 3); err != nil {
+		t.Fatalf("attempt 3: %v", err)
+	}
+	state, _, org, _ = loadDunningFixtures(t, db)
+	if state.Stage != database.DunningStageResourcesSuspended || org.Status != database.OrganizationStatusSuspended {
+		t.Fatalf("after attempt 3 stage = %q, organization status = %q", state.Stage, org.Status)
+	}
+	if org.SuspendedBy == nil || *org.SuspendedBy != dunningSuspendedBy || len(*stopped) != 1 {
+		t.Fatalf("attempt 3 suspended by %v and stopped workloads %d times", org.SuspendedBy, len(*stopped))
+	}
+
+	if err := HandlePaymentFailed(ctx, "org-a", "in_123", 4); err != nil {
+		t.Fatalf("attempt 4: %v", err)
+	}
+	state, _, _, account := loadDunningFixtures(t, db)
+	if state.Stage != database.DunningStageMarkedForDeletion || state.MarkedForDeletionAt == nil || state.AttemptCount != 4 {
+		t.Fatalf("after attempt 4 state = %+v", state)
+	}
+	if account.Status != billingAccountStatusMarkedForDeletion {
+		t.Fatalf("billing account status = %q, want %q", account.Status, billingAccountStatusMarkedForDeletion)
+	}
+}
+
+func TestHandlePaymentFailedCatchesUpMissedAttempts(t *testing.T) {
+	db, sender, stopped := setupDunningTest(t)
+
+	if err := HandlePaymentFailed(context.Background(), "org-a", "in_123", 3); err != nil {
+		t.Fatalf("attempt 3: %v", err)
+	}
+	state, quota, org, _ := loadDunningFixtures(t, db)
+	if state.WarningSentAt == nil || state.ResourceCreationSuspendedAt == nil || state.ResourcesSuspendedAt == nil {
+		t.Fatalf("earlier stages not applied: %+v", state)
+	}
+	if quota.NewResourcesAllowed() || org.Status != database.OrganizationStatusSuspended || len(*stopped) != 1 {
+		t.Fatal("attempt 3 without earlier webhooks did not apply every stage")
+	}
+	if len(sender.messages) != 3 {
+		t.Fatalf("sent %d emails, want one per stage", len(sender.messages))
+	}
+}
+
+func TestResetDunningStateRequiresSuperadminAndLiftsRestrictions(t *testing.T) {
+	db, _, _ := setupDunningTest(t)
+	service := &Service{billingEnabled: true}
+	if err := HandlePaymentFailed(context.Background(), "org-a", "in_123", 4); err != nil {
+		t.Fatalf("HandlePaymentFailed: %v", err)
+	}
+
+	member := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+	if _, err := service.GetDunningState(member, connect.NewRequest(&billingv1.GetDunningStateRequest{OrganizationId: "org-a"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("GetDunningState as non-superadmin code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+	}
+	if _, err := service.ResetDunningState(member, connect.NewRequest(&billingv1.ResetDunningStateRequest{OrganizationId: "org-a"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("ResetDunningState as non-superadmin code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+	}
+
+	superadmin := auth.WithUser(context.Background(), &authv1.User{Id: "admin", Roles: []string{auth.RoleSuperAdmin}})
+	resp, err := service.GetDunningState(superadmin, connect.NewRequest(&billingv1.GetDunningStateRequest{OrganizationId: "org-a"}))
+	if err != nil {
+		t.Fatalf("GetDunningState: %v", err)
+	}
+	if got := resp.Msg.GetState(); got.GetStage() != database.DunningStageMarkedForDeletion || got.GetInvoiceId() != "in_123" || got.GetMarkedForDeletionAt() == nil {
+		t.Fatalf("dunning state = %+v", got)
+	}
+
+	if _, err := service.ResetDunningState(superadmin, connect.NewRequest(&billingv1.ResetDunningStateRequest{OrganizationId: "org-a"})); err != nil {
+		t.Fatalf("ResetDunningState: %v", err)
+	}
+	_, quota, org, account := loadDunningFixtures(t, db)
+	if !quota.NewResourcesAllowed() || org.Status != database.OrganizationStatusActive || account.Status != "ACTIVE" {
+		t.Fatalf("after reset: new resources allowed = %v, org status = %q, billing status = %q",
+			quota.NewResourcesAllowed(), org.Status, account.Status)
+	}
+	var openSuspensions int64
+	db.Model(&database.OrgSuspension{}).Where("organization_id = ? AND lifted_at IS NULL", "org-a").Count(&openSuspensions)
+	if openSuspensions != 0 {
+		t.Fatalf("open suspensions after reset = %d, want 0", openSuspensions)
+	}
+
+	resp, err = service.GetDunningState(superadmin, connect.NewRequest(&billingv1.GetDunningStateRequest{OrganizationId: "org-a"}))
+	if err != nil || resp.Msg.GetState() != nil {
+		t.Fatalf("dunning state after reset = %v, %v; want none", resp, err)
+	}
+	if _, err := service.ResetDunningState(superadmin, connect.NewRequest(&billingv1.ResetDunningStateRequest{OrganizationId: "org-a"})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("second reset code = %v, want %v", connect.CodeOf(err), connect.CodeNotFound)
+	}
+}
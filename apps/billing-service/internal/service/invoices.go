@@ -0,0 +1,387 @@
+package billing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/email"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+
+	"connectrpc.com/connect"
+	"github.com/jung-kurt/gofpdf"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// invoiceChunkSize is the size of each DownloadInvoice stream message
+const invoiceChunkSize = 32 * 1024
+
+// GenerateInvoice renders the invoice PDF for an organization's credit transactions in a month
+// and stores it in the invoices table, replacing any earlier rendering for that month
+func GenerateInvoice(ctx context.Context, orgID string, billingMonth time.Time) ([]byte, error) {
+	monthStart := invoiceMonth(billingMonth)
+	monthEnd := monthStart.AddDate(0, 1, 0)
+
+	var org database.Organization
+	if err := database.DB.WithContext(ctx).First(&org, "id = ?", orgID).Error; err != nil {
+		return nil, fmt.Errorf("organization not found: %w", err)
+	}
+
+	var billingAccount *database.BillingAccount
+	var accounts []database.BillingAccount
+	if err := database.DB.WithContext(ctx).Where("organization_id = ?", orgID).Limit(1).Find(&accounts).Error; err != nil {
+		return nil, fmt.Errorf("get billing account: %w", err)
+	}
+	if len(accounts) > 0 {
+		billingAccount = &accounts[0]
+	}
+
+	var transactions []database.CreditTransaction
+	if err := database.DB.WithContext(ctx).
+		Where("organization_id = ? AND created_at >= ? AND created_at < ?", orgID, monthStart, monthEnd).
+		Order("created_at ASC").
+		Find(&transactions).Error; err != nil {
+		return nil, fmt.Errorf("get credit transactions: %w", err)
+	}
+
+	pdfBytes, err := renderInvoicePDF(&org, billingAccount, monthStart, transactions, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("render invoice: %w", err)
+	}
+
+	now := time.Now()
+	invoice := &database.Invoice{
+		ID:             generateID("inv"),
+		OrganizationID: orgID,
+		Month:          monthStart,
+		PDFBytes:       pdfBytes,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}, {Name: "month"}},
+		DoUpdates: clause.AssignmentColumns([]string{"pdf_bytes", "updated_at"}),
+	}).Create(invoice).Error; err != nil {
+		return nil, fmt.Errorf("store invoice: %w", err)
+	}
+
+	return pdfBytes, nil
+}
+
+// SendMonthlyInvoice emails last month's invoice to every active billing account that has not received it yet
+// It is safe to run daily: invoices already sent are skipped, so a missed first-of-month run catches up later
+func SendMonthlyInvoice(ctx context.Context, sender email.Sender) error {
+	if sender == nil || !sender.Enabled() {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	month := invoiceMonth(now).AddDate(0, -1, 0)
+	log.Printf("[Invoices] Sending invoices for %s", month.Format("2006-01"))
+
+	var billingAccounts []database.BillingAccount
+	if err := database.DB.WithContext(ctx).Where("status = ? AND billing_email IS NOT NULL AND billing_email != ''", "ACTIVE").
+		Find(&billingAccounts).Error; err != nil {
+		return fmt.Errorf("get billing accounts: %w", err)
+	}
+
+	var sent, skipped int
+	for _, account := range billingAccounts {
+		var existing []database.Invoice
+		if err := database.DB.WithContext(ctx).Select("id, sent_at").
+			Where("organization_id = ? AND month = ?", account.OrganizationID, month).
+			Limit(1).Find(&existing).Error; err != nil {
+			log.Printf("[Invoices] Error checking invoice for org %s: %v", account.OrganizationID, err)
+			skipped++
+			continue
+		}
+		if len(existing) > 0 && existing[0].SentAt != nil {
+			continue
+		}
+
+		// Nothing happened on the account this month - no invoice to send
+		var transactionCount int64
+		if err := database.DB.WithContext(ctx).Model(&database.CreditTransaction{}).
+			Where("organization_id = ? AND created_at >= ? AND created_at < ?", account.OrganizationID, month, month.AddDate(0, 1, 0)).
+			Count(&transactionCount).Error; err != nil {
+			log.Printf("[Invoices] Error counting transactions for org %s: %v", account.OrganizationID, err)
+			skipped++
+			continue
+		}
+		if transactionCount == 0 {
+			continue
+		}
+
+		if err := sendInvoice(ctx, sender, &account, month); err != nil {
+			log.Printf("[Invoices] Error sending invoice to org %s: %v", account.OrganizationID, err)
+			skipped++
+			continue
+		}
+		sent++
+	}
+
+	log.Printf("[Invoices] Completed: %d invoices sent, %d failed", sent, skipped)
+	return nil
+}
+
+func sendInvoice(ctx context.Context, sender email.Sender, account *database.BillingAccount, month time.Time) error {
+	pdfBytes, err := GenerateInvoice(ctx, account.OrganizationID, month)
+	if err != nil {
+		return err
+	}
+
+	monthLabel := month.Format("January 2006")
+	message := &email.Message{
+		To:      []string{*account.BillingEmail},
+		Subject: fmt.Sprintf("Your Obiente Cloud invoice for %s", monthLabel),
+		Template: &email.TemplateData{
+			Heading:    "Your monthly invoice",
+			IntroLines: []string{fmt.Sprintf("Your invoice for %s is attached as a PDF.", monthLabel)},
+		},
+		Category: email.CategoryBilling,
+		Metadata: map[string]string{
+			"organization_id": account.OrganizationID,
+			"invoice_month":   month.Format("2006-01"),
+		},
+		Attachments: []email.Attachment{{
+			Filename:    invoiceFilename(month),
+			ContentType: "application/pdf",
+			Data:        pdfBytes,
+		}},
+	}
+	if err := sender.Send(ctx, message); err != nil {
+		return fmt.Errorf("send email: %w", err)
+	}
+
+	now := time.Now()
+	if err := database.DB.WithContext(ctx).Model(&database.Invoice{}).
+		Where("organization_id = ? AND month = ?", account.OrganizationID, month).
+		Updates(map[string]interface{}{"sent_at": now, "updated_at": now}).Error; err != nil {
+		return fmt.Errorf("mark invoice sent: %w", err)
+	}
+
+	log.Printf("[Invoices] Sent %s invoice to org %s", month.Format("2006-01"), account.OrganizationID)
+	return nil
+}
+
+// DownloadInvoice streams the stored invoice PDF for a month
+// Invoices for completed months that were never generated are generated on demand
+func (s *Service) DownloadInvoice(ctx context.Context, req *connect.Request[billingv1.DownloadInvoiceRequest], stream *connect.ServerStream[billingv1.DownloadInvoiceResponse]) error {
+	if err := s.checkBillingEnabled(); err != nil {
+		return err
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+
+	month, err := time.Parse("2006-01", strings.TrimSpace(req.Msg.GetMonth()))
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("month must be in YYYY-MM format"))
+	}
+
+	// Only owners and admins can view invoices
+	if err := common.AuthorizeOrgAdmin(ctx, orgID, user); err != nil {
+		return err
+	}
+
+	var invoice database.Invoice
+	var pdfBytes []byte
+	if err := database.DB.WithContext(ctx).Where("organization_id = ? AND month = ?", orgID, month).First(&invoice).Error; err == nil {
+		pdfBytes = invoice.PDFBytes
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("get invoice: %w", err))
+	} else if !month.Before(invoiceMonth(time.Now())) {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("no invoice for %s yet", month.Format("2006-01")))
+	} else {
+		pdfBytes, err = GenerateInvoice(ctx, orgID, month)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("generate invoice: %w", err))
+		}
+	}
+
+	for offset := 0; offset == 0 || offset < len(pdfBytes); offset += invoiceChunkSize {
+		end := min(offset+invoiceChunkSize, len(pdfBytes))
+		msg := &billingv1.DownloadInvoiceResponse{Chunk: pdfBytes[offset:end]}
+		if offset == 0 {
+			msg.Filename = invoiceFilename(month)
+			msg.TotalSize = int64(len(pdfBytes))
+		}
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderInvoicePDF lays out the invoice: header, bill-to block, transaction table and totals
+func renderInvoicePDF(org *database.Organization, account *database.BillingAccount, month time.Time, transactions []database.CreditTransaction, issuedAt time.Time) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle(fmt.Sprintf("Invoice %s", invoiceNumber(org.ID, month)), true)
+	pdf.SetAuthor("Obiente Cloud", true)
+	// Core PDF fonts are cp1252; translate UTF-8 input
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(0, 10, "Invoice", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 5, "Invoice number: "+invoiceNumber(org.ID, month), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 5, "Billing period: "+month.Format("January 2006"), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 5, "Issued: "+issuedAt.Format("2006-01-02"), "", 1, "L", false, 0, "")
+	pdf.Ln(6)
+
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(0, 6, "Bill to", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	for _, line := range invoiceBillToLines(org, account) {
+		pdf.CellFormat(0, 5, tr(line), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(6)
+
+	widths := []float64{30, 95, 25, 30}
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.SetFillColor(240, 240, 240)
+	for i, header := range []string{"Date", "Description", "Type", "Amount"} {
+		align := "L"
+		if i == len(widths)-1 {
+			align = "R"
+		}
+		pdf.CellFormat(widths[i], 7, header, "B", 0, align, true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 9)
+	var charges, credits int64
+	for _, transaction := range transactions {
+		if transaction.AmountCents < 0 {
+			charges += -transaction.AmountCents
+		} else {
+			credits += transaction.AmountCents
+		}
+		description := transaction.Type
+		if transaction.Note != nil && *transaction.Note != "" {
+			description = *transaction.Note
+		}
+		if runes := []rune(description); len(runes) > 60 {
+			description = string(runes[:57]) + "..."
+		}
+		pdf.CellFormat(widths[0], 6, transaction.CreatedAt.UTC().Format("2006-01-02"), "", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[1], 6, tr(description), "", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[2], 6, tr(transaction.Type), "", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[3], 6, formatInvoiceAmount(transaction.AmountCents), "", 1, "R", false, 0, "")
+	}
+	if len(transactions) == 0 {
+		pdf.CellFormat(0, 6, "No transactions in this period.", "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	labelWidth := widths[0] + widths[1] + widths[2]
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(labelWidth, 6, "Credits added", "T", 0, "R", false, 0, "")
+	pdf.CellFormat(widths[3], 6, formatInvoiceAmount(credits), "T", 1, "R", false, 0, "")
+	pdf.CellFormat(labelWidth, 6, "Charges", "", 0, "R", false, 0, "")
+	pdf.CellFormat(widths[3], 6, formatInvoiceAmount(-charges), "", 1, "R", false, 0, "")
+	if len(transactions) > 0 {
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.CellFormat(labelWidth, 7, "Closing credit balance", "", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 7, formatInvoiceAmount(transactions[len(transactions)-1].BalanceAfter), "", 1, "R", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// invoiceBillToLines returns the customer name, address and tax details for the bill-to block
+func invoiceBillToLines(org *database.Organization, account *database.BillingAccount) []string {
+	name := org.Name
+	if account != nil && account.CompanyName != nil && *account.CompanyName != "" {
+		name = *account.CompanyName
+	}
+	lines := []string{name}
+	if account == nil {
+		return lines
+	}
+
+	if account.Address != nil && *account.Address != "" {
+		var address billingv1.Address
+		if err := json.Unmarshal([]byte(*account.Address), &address); err == nil {
+			if address.GetLine1() != "" {
+				lines = append(lines, address.GetLine1())
+			}
+			if address.GetLine2() != "" {
+				lines = append(lines, address.GetLine2())
+			}
+			cityLine := strings.TrimSpace(strings.Join(nonEmpty(address.GetCity(), address.GetState(), address.GetPostalCode()), " "))
+			if cityLine != "" {
+				lines = append(lines, cityLine)
+			}
+			if address.GetCountry() != "" {
+				lines = append(lines, address.GetCountry())
+			}
+		}
+	}
+	if account.TaxID != nil && *account.TaxID != "" {
+		lines = append(lines, "Tax ID: "+*account.TaxID)
+	}
+	if account.BillingEmail != nil && *account.BillingEmail != "" {
+		lines = append(lines, *account.BillingEmail)
+	}
+	return lines
+}
+
+func nonEmpty(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// formatInvoiceAmount formats signed cents as a dollar amount (e.g. -$1.05)
+func formatInvoiceAmount(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s$%d.%02d", sign, cents/100, cents%100)
+}
+
+// invoiceMonth returns the first instant of t's month in UTC
+func invoiceMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func invoiceNumber(orgID string, month time.Time) string {
+	suffix := strings.ToUpper(strings.TrimPrefix(orgID, "org-"))
+	if len(suffix) > 8 {
+		suffix = suffix[:8]
+	}
+	return fmt.Sprintf("INV-%s-%s", month.Format("200601"), suffix)
+}
+
+func invoiceFilename(month time.Time) string {
+	return fmt.Sprintf("invoice-%s.pdf", month.Format("2006-01"))
+}
@@ -0,0 +1,126 @@
+package billing
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/email"
+	"gorm.io/gorm"
+)
+
+type recordingSender struct {
+	messages []*email.Message
+}
+
+func (s *recordingSender) Send(_ context.Context, msg *email.Message) error {
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+func (s *recordingSender) Enabled() bool { return true }
+
+func TestGenerateInvoiceStoresPDF(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.BillingAccount{},
+		&database.CreditTransaction{},
+		&database.Invoice{},
+	)
+	month := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	seedInvoiceOrg(t, db, "org-a", month)
+
+	pdf, err := GenerateInvoice(context.Background(), "org-a", month.Add(10*24*time.Hour))
+	if err != nil {
+		t.Fatalf("GenerateInvoice: %v", err)
+	}
+	if !bytes.HasPrefix(pdf, []byte("%PDF")) {
+		t.Fatalf("invoice does not look like a PDF: %q", pdf[:min(len(pdf), 8)])
+	}
+
+	// Regenerating replaces the stored PDF rather than adding a second invoice
+	if _, err := GenerateInvoice(context.Background(), "org-a", month); err != nil {
+		t.Fatalf("GenerateInvoice again: %v", err)
+	}
+	var invoices []database.Invoice
+	db.Where("organization_id = ?", "org-a").Find(&invoices)
+	if len(invoices) != 1 {
+		t.Fatalf("invoices = %d, want 1", len(invoices))
+	}
+	if !invoices[0].Month.Equal(month) || len(invoices[0].PDFBytes) == 0 {
+		t.Fatalf("stored invoice = month %v, %d bytes", invoices[0].Month, len(invoices[0].PDFBytes))
+	}
+}
+
+func TestSendMonthlyInvoiceSendsOnce(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.BillingAccount{},
+		&database.CreditTransaction{},
+		&database.Invoice{},
+	)
+	lastMonth := invoiceMonth(time.Now().UTC()).AddDate(0, -1, 0)
+	seedInvoiceOrg(t, db, "org-a", lastMonth)
+	// No activity last month - nothing to send
+	seedInvoiceOrg(t, db, "org-b", lastMonth.AddDate(0, -1, 0))
+
+	sender := &recordingSender{}
+	for run := 0; run < 2; run++ {
+		if err := SendMonthlyInvoice(context.Background(), sender); err != nil {
+			t.Fatalf("SendMonthlyInvoice run %d: %v", run, err)
+		}
+	}
+
+	if len(sender.messages) != 1 {
+		t.Fatalf("messages sent = %d, want 1", len(sender.messages))
+	}
+	msg := sender.messages[0]
+	if msg.To[0] != "billing@org-a.example" || len(msg.Attachments) != 1 {
+		t.Fatalf("unexpected message: to %v, %d attachments", msg.To, len(msg.Attachments))
+	}
+	if want := invoiceFilename(lastMonth); msg.Attachments[0].Filename != want {
+		t.Fatalf("attachment filename = %q, want %q", msg.Attachments[0].Filename, want)
+	}
+
+	var invoice database.Invoice
+	if err := db.First(&invoice, "organization_id = ?", "org-a").Error; err != nil {
+		t.Fatalf("load invoice: %v", err)
+	}
+	if invoice.SentAt == nil {
+		t.Fatal("invoice was not marked as sent")
+	}
+}
+
+func TestFormatInvoiceAmount(t *testing.T) {
+	cases := map[int64]string{
+		0:         "$0.00",
+		5:         "$0.05",
+		123456:    "$1234.56",
+		-2500:     "-$25.00",
+		100000000: "$1000000.00",
+	}
+	for cents, want := range cases {
+		if got := formatInvoiceAmount(cents); got != want {
+			t.Errorf("formatInvoiceAmount(%d) = %q, want %q", cents, got, want)
+		}
+	}
+}
+
+func seedInvoiceOrg(t *testing.T, db *gorm.DB, orgID string, activity time.Time) {
+	t.Helper()
+
+	billingEmail := "billing@" + orgID + ".example"
+	records := []any{
+		&database.Organization{ID: orgID, Name: "Org " + orgID, Slug: orgID, Status: "active", CreatedAt: activity},
+		&database.BillingAccount{ID: "ba-" + orgID, OrganizationID: orgID, Status: "ACTIVE", BillingEmail: &billingEmail},
+		&database.CreditTransaction{ID: "ct-" + orgID + "-1", OrganizationID: orgID, AmountCents: 5000, BalanceAfter: 5000, Type: "payment", Source: "stripe", CreatedAt: activity.Add(time.Hour)},
+		&database.CreditTransaction{ID: "ct-" + orgID + "-2", OrganizationID: orgID, AmountCents: -1234, BalanceAfter: 3766, Type: "usage", Source: "system", CreatedAt: activity.Add(48 * time.Hour)},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+}
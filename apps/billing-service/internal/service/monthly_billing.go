@@ -1,6 +1,7 @@
 package billing
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -19,7 +20,17 @@ type UsageBreakdown struct {
 	BandwidthCostCents int64 `json:"bandwidth_cost_cents"`
 	StorageCostCents   int64 `json:"storage_cost_cents"`
 	PublicIPCostCents  int64 `json:"public_ip_cost_cents"` // Flat rate cost for public IPs
-	TotalCostCents     int64 `json:"total_cost_cents"`
+	MeteredDebtCents   int64 `json:"metered_debt_cents"`   // Hourly metered usage the organization's credits did not cover
+	TotalCostCents     int64 `json:"total_cost_cents"`     // Includes the managed organizations
+
+	ManagedOrganizations []ManagedOrganizationCharge `json:"managed_organizations,omitempty"`
+}
+
+// ManagedOrganizationCharge is the sub-line-item of a managed organization on its parent's bill
+type ManagedOrganizationCharge struct {
+	OrganizationID string `json:"organization_id"`
+	Name           string `json:"name"`
+	UsageBreakdown
 }
 
 // ProcessMonthlyBilling processes monthly billing for all organizations that should be billed today
@@ -31,8 +42,12 @@ func ProcessMonthlyBilling() error {
 	today := now.Day()
 
 	// Get all billing accounts that should be billed today
+	// Managed organizations are left out: their usage is billed on their parent's bill
+	managedOrgs := database.DB.Model(&database.Organization{}).Select("id").Where("parent_org_id IS NOT NULL")
 	var billingAccounts []database.BillingAccount
-	if err := database.DB.Where("billing_date = ? AND status = ?", today, "ACTIVE").Find(&billingAccounts).Error; err != nil {
+	if err := database.DB.Where("billing_date = ? AND status = ?", today, "ACTIVE").
+		Where("organization_id NOT IN (?)", managedOrgs).
+		Find(&billingAccounts).Error; err != nil {
 		return fmt.Errorf("get billing accounts: %w", err)
 	}
 
@@ -62,6 +77,14 @@ func ProcessMonthlyBilling() error {
 	return nil
 }
 
+// setBillDisplayAmount stores the bill amount in the organization's preferred currency at the
+// current exchange rate, so the bill keeps showing what it was worth when it was issued
+func setBillDisplayAmount(bill *database.MonthlyBill, currencyCode string) {
+	currency, displayCents := displayAmount(context.Background(), currencyCode, bill.AmountCents)
+	bill.DisplayCurrency = currency.Code
+	bill.DisplayAmountCents = displayCents
+}
+
 // processOrganizationBilling processes billing for a single organization
 func processOrganizationBilling(orgID string, billingDate time.Time) error {
 	// Check if we've already created a bill for this billing period
@@ -116,183 +139,11 @@ func processOrganizationBilling(orgID string, billingDate time.Time) error {
 		return fmt.Errorf("metrics database not available")
 	}
 
-	// Get usage from hourly aggregates for the billing period
-	var hourlyUsage struct {
-		CPUCoreSeconds    int64
-		MemoryByteSeconds int64
-		BandwidthRxBytes  int64
-		BandwidthTxBytes  int64
-	}
-	metricsDB.Table("deployment_usage_hourly duh").
-		Select(`
-			COALESCE(CAST(SUM((duh.avg_cpu_usage / 100.0) * 3600) AS BIGINT), 0) as cpu_core_seconds,
-			COALESCE(CAST(SUM(duh.avg_memory_usage * 3600) AS BIGINT), 0) as memory_byte_seconds,
-			COALESCE(SUM(duh.bandwidth_rx_bytes), 0) as bandwidth_rx_bytes,
-			COALESCE(SUM(duh.bandwidth_tx_bytes), 0) as bandwidth_tx_bytes
-		`).
-		Where("duh.organization_id = ? AND duh.hour >= ? AND duh.hour < ?", orgID, billingPeriodStart, billingPeriodEnd).
-		Scan(&hourlyUsage)
-
-	// Get game server usage from hourly aggregates and add to deployment usage
-	var gameServerHourlyUsage struct {
-		CPUCoreSeconds    int64
-		MemoryByteSeconds int64
-		BandwidthRxBytes  int64
-		BandwidthTxBytes  int64
-	}
-	metricsDB.Table("game_server_usage_hourly gsuh").
-		Select(`
-			COALESCE(CAST(SUM((gsuh.avg_cpu_usage / 100.0) * 3600) AS BIGINT), 0) as cpu_core_seconds,
-			COALESCE(CAST(SUM(gsuh.avg_memory_usage * 3600) AS BIGINT), 0) as memory_byte_seconds,
-			COALESCE(SUM(gsuh.bandwidth_rx_bytes), 0) as bandwidth_rx_bytes,
-			COALESCE(SUM(gsuh.bandwidth_tx_bytes), 0) as bandwidth_tx_bytes
-		`).
-		Where("gsuh.organization_id = ? AND gsuh.hour >= ? AND gsuh.hour < ?", orgID, billingPeriodStart, billingPeriodEnd).
-		Scan(&gameServerHourlyUsage)
-
-	// Get VPS usage from hourly aggregates and add to deployment/game server usage
-	var vpsHourlyUsage struct {
-		CPUCoreSeconds    int64
-		MemoryByteSeconds int64
-		BandwidthRxBytes  int64
-		BandwidthTxBytes  int64
-	}
-	metricsDB.Table("vps_usage_hourly vuh").
-		Select(`
-			COALESCE(CAST(SUM((vuh.avg_cpu_usage / 100.0) * 3600) AS BIGINT), 0) as cpu_core_seconds,
-			COALESCE(CAST(SUM(vuh.avg_memory_usage * 3600) AS BIGINT), 0) as memory_byte_seconds,
-			COALESCE(SUM(vuh.bandwidth_rx_bytes), 0) as bandwidth_rx_bytes,
-			COALESCE(SUM(vuh.bandwidth_tx_bytes), 0) as bandwidth_tx_bytes
-		`).
-		Where("vuh.organization_id = ? AND vuh.hour >= ? AND vuh.hour < ?", orgID, billingPeriodStart, billingPeriodEnd).
-		Scan(&vpsHourlyUsage)
-
-	// Get database hourly usage
-	var databaseHourlyUsage struct {
-		CPUCoreSeconds    int64
-		MemoryByteSeconds int64
-		BandwidthRxBytes  int64
-		BandwidthTxBytes  int64
-	}
-	metricsDB.Table("database_usage_hourly duh").
-		Select(`
-			COALESCE(CAST(SUM((duh.avg_cpu_usage / 100.0) * 3600) AS BIGINT), 0) as cpu_core_seconds,
-			COALESCE(CAST(SUM(duh.avg_memory_usage * 3600) AS BIGINT), 0) as memory_byte_seconds,
-			COALESCE(SUM(duh.bandwidth_rx_bytes), 0) as bandwidth_rx_bytes,
-			COALESCE(SUM(duh.bandwidth_tx_bytes), 0) as bandwidth_tx_bytes
-		`).
-		Where("duh.organization_id = ? AND duh.hour >= ? AND duh.hour < ?", orgID, billingPeriodStart, billingPeriodEnd).
-		Scan(&databaseHourlyUsage)
-
-	// Combine deployment, game server, VPS, and database hourly usage
-	hourlyUsage.CPUCoreSeconds += gameServerHourlyUsage.CPUCoreSeconds + vpsHourlyUsage.CPUCoreSeconds + databaseHourlyUsage.CPUCoreSeconds
-	hourlyUsage.MemoryByteSeconds += gameServerHourlyUsage.MemoryByteSeconds + vpsHourlyUsage.MemoryByteSeconds + databaseHourlyUsage.MemoryByteSeconds
-	hourlyUsage.BandwidthRxBytes += gameServerHourlyUsage.BandwidthRxBytes + vpsHourlyUsage.BandwidthRxBytes + databaseHourlyUsage.BandwidthRxBytes
-	hourlyUsage.BandwidthTxBytes += gameServerHourlyUsage.BandwidthTxBytes + vpsHourlyUsage.BandwidthTxBytes + databaseHourlyUsage.BandwidthTxBytes
-
-	// Get storage bytes (snapshot from deployments, game servers, and VPS tables)
-	var deploymentStorage struct {
-		StorageBytes int64
-	}
-	database.DB.Table("deployments d").
-		Select("COALESCE(SUM(d.storage_bytes), 0) as storage_bytes").
-		Where("d.organization_id = ?", orgID).
-		Scan(&deploymentStorage)
-
-	var gameServerStorage struct {
-		StorageBytes int64
-	}
-	database.DB.Table("game_servers gs").
-		Select("COALESCE(SUM(gs.storage_bytes), 0) as storage_bytes").
-		Where("gs.organization_id = ?", orgID).
-		Scan(&gameServerStorage)
-
-	var vpsStorage struct {
-		StorageBytes int64
-	}
-	database.DB.Table("vps_instances vps").
-		Select("COALESCE(SUM(vps.disk_bytes), 0) as storage_bytes").
-		Where("vps.organization_id = ? AND vps.deleted_at IS NULL", orgID).
-		Scan(&vpsStorage)
-
-	var databaseStorage struct {
-		StorageBytes int64
-	}
-	database.DB.Table("database_instances db").
-		Select("COALESCE(SUM(db.disk_bytes), 0) as storage_bytes").
-		Where("db.organization_id = ? AND db.deleted_at IS NULL", orgID).
-		Scan(&databaseStorage)
-	databaseStorageVal := databaseStorage.StorageBytes
-
-	storageSum := struct {
-		StorageBytes int64
-	}{
-		StorageBytes: deploymentStorage.StorageBytes + gameServerStorage.StorageBytes + vpsStorage.StorageBytes + databaseStorageVal,
-	}
-
-	// Calculate costs using pricing model
-	pricingModel := pricing.GetPricing()
-
-	var cpuCost, memoryCost, bandwidthCost, storageCost int64
-
-	cpuCost = pricingModel.CalculateCPUCost(hourlyUsage.CPUCoreSeconds)
-	memoryCost = pricingModel.CalculateMemoryCost(hourlyUsage.MemoryByteSeconds)
-	bandwidthBytes := hourlyUsage.BandwidthRxBytes + hourlyUsage.BandwidthTxBytes
-	bandwidthCost = pricingModel.CalculateBandwidthCost(bandwidthBytes)
-	// Storage is monthly cost, prorate based on billing period duration
-	storageCostFullMonth := pricingModel.CalculateStorageCost(storageSum.StorageBytes)
-	periodDuration := billingPeriodEnd.Sub(billingPeriodStart)
-	daysInMonth := float64(time.Date(billingPeriodEnd.Year(), billingPeriodEnd.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day())
-	daysInPeriod := periodDuration.Hours() / 24.0
-	if daysInPeriod > 0 && daysInMonth > 0 {
-		storageCost = int64(float64(storageCostFullMonth) * (daysInPeriod / daysInMonth))
-	} else {
-		storageCost = storageCostFullMonth
-	}
-
-	// Calculate public IP costs (flat rate, prorated based on billing period)
-	var publicIPCost int64
-	var publicIPAssignments []struct {
-		MonthlyCostCents int64
-		AssignedAt       time.Time
-	}
-	database.DB.Table("vps_public_ips ip").
-		Select("ip.monthly_cost_cents, ip.assigned_at").
-		Joins("INNER JOIN vps_instances vps ON vps.id = ip.vps_id").
-		Where("ip.organization_id = ? AND ip.vps_id IS NOT NULL AND vps.deleted_at IS NULL", orgID).
-		Where("ip.assigned_at IS NOT NULL AND ip.assigned_at <= ?", billingPeriodEnd).
-		Scan(&publicIPAssignments)
-
-	for _, assignment := range publicIPAssignments {
-		// Calculate prorated cost based on when IP was assigned
-		assignmentDate := assignment.AssignedAt
-		if assignmentDate.Before(billingPeriodStart) {
-			assignmentDate = billingPeriodStart
-		}
-
-		periodDuration := billingPeriodEnd.Sub(assignmentDate)
-		daysInMonth := float64(time.Date(billingPeriodEnd.Year(), billingPeriodEnd.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day())
-		daysInPeriod := periodDuration.Hours() / 24.0
-
-		if daysInPeriod > 0 && daysInMonth > 0 {
-			proratedCost := int64(float64(assignment.MonthlyCostCents) * (daysInPeriod / daysInMonth))
-			publicIPCost += proratedCost
-		} else {
-			publicIPCost += assignment.MonthlyCostCents
-		}
-	}
-
-	totalCostCents := cpuCost + memoryCost + bandwidthCost + storageCost + publicIPCost
-
-	// Create usage breakdown
-	breakdown := UsageBreakdown{
-		CPUCostCents:       cpuCost,
-		MemoryCostCents:    memoryCost,
-		BandwidthCostCents: bandwidthCost,
-		StorageCostCents:   storageCost,
-		PublicIPCostCents:  publicIPCost,
-		TotalCostCents:     totalCostCents,
+	breakdown, err := billingPeriodUsage(metricsDB, orgID, billingPeriodStart, billingPeriodEnd)
+	if err != nil {
+		return err
 	}
+	totalCostCents := breakdown.TotalCostCents
 
 	breakdownJSON, err := json.Marshal(breakdown)
 	if err != nil {
@@ -315,6 +166,7 @@ func processOrganizationBilling(orgID string, billingDate time.Time) error {
 		CreatedAt:          time.Now(),
 		UpdatedAt:          time.Now(),
 	}
+	setBillDisplayAmount(bill, billingAccount.Currency)
 
 	// Process payment in a transaction
 	err = database.DB.Transaction(func(tx *gorm.DB) error {
@@ -322,6 +174,9 @@ func processOrganizationBilling(orgID string, billingDate time.Time) error {
 		if err := tx.Create(bill).Error; err != nil {
 			return fmt.Errorf("create bill: %w", err)
 		}
+		if err := settleBillingPeriodDebt(tx, orgID, breakdown); err != nil {
+			return err
+		}
 
 		// Try to pay from credits
 		if totalCostCents > 0 {
@@ -394,81 +249,45 @@ func processOrganizationBilling(orgID string, billingDate time.Time) error {
 	return nil
 }
 
-// GenerateCurrentBillEarly generates a bill for the current billing period ending at the current date/time
-// This allows users to create and pay bills before their scheduled billing date
-func GenerateCurrentBillEarly(orgID string) (*database.MonthlyBill, bool, error) {
-	now := time.Now()
-
-	// Get the billing account to find the billing date
-	var billingAccount database.BillingAccount
-	if err := database.DB.Where("organization_id = ?", orgID).First(&billingAccount).Error; err != nil {
-		return nil, false, fmt.Errorf("billing account not found: %w", err)
-	}
-
-	if billingAccount.BillingDate == nil {
-		return nil, false, fmt.Errorf("billing date not set for organization %s", orgID)
-	}
-
-	billingDay := *billingAccount.BillingDate
-
-	// Calculate billing period
-	var org database.Organization
-	if err := database.DB.First(&org, "id = ?", orgID).Error; err != nil {
-		return nil, false, fmt.Errorf("organization not found: %w", err)
-	}
-
-	// Find the last bill to determine the start of this billing period
-	var lastBill database.MonthlyBill
-	var billingPeriodStart time.Time
-	var billingPeriodEnd time.Time
-
-	if err := database.DB.Where("organization_id = ? AND status IN ?", orgID, []string{"PAID", "PENDING"}).
-		Order("billing_period_end DESC").First(&lastBill).Error; err == nil {
-		// Use the end of the last billing period as the start of this one
-		billingPeriodStart = lastBill.BillingPeriodEnd
-	} else {
-		// First bill: start from org creation date
-		billingPeriodStart = org.CreatedAt.UTC().Truncate(24 * time.Hour)
-	}
-
-	// Calculate the next billing date
-	nextBillingDate := time.Date(now.Year(), now.Month(), billingDay, 0, 0, 0, 0, time.UTC)
-	if nextBillingDate.Before(now) || nextBillingDate.Equal(now) {
-		// If the billing date has passed this month, use next month
-		nextBillingDate = nextBillingDate.AddDate(0, 1, 0)
-	}
-
-	// Use the earlier of: now or next billing date
-	// This ensures we don't create bills for future periods
-	if now.Before(nextBillingDate) {
-		billingPeriodEnd = now.UTC().Truncate(time.Hour) // Round to hour for consistency
-	} else {
-		billingPeriodEnd = nextBillingDate
-	}
-
-	// Check if a bill already exists for this period (or overlapping period)
-	var existingBill database.MonthlyBill
-	if err := database.DB.Where("organization_id = ? AND billing_period_start = ? AND billing_period_end = ?",
-		orgID, billingPeriodStart, billingPeriodEnd).First(&existingBill).Error; err == nil {
-		log.Printf("[Generate Current Bill] Bill already exists for org %s for period %s to %s", orgID,
-			billingPeriodStart.Format("2006-01-02"), billingPeriodEnd.Format("2006-01-02"))
-		return &existingBill, true, nil
-	}
-
-	// Also check if there's a bill that covers this period (e.g., if billing date already passed)
-	var overlappingBill database.MonthlyBill
-	if err := database.DB.Where("organization_id = ? AND billing_period_start <= ? AND billing_period_end >= ?",
-		orgID, billingPeriodStart, billingPeriodEnd).First(&overlappingBill).Error; err == nil {
-		log.Printf("[Generate Current Bill] Overlapping bill exists for org %s", orgID)
-		return &overlappingBill, true, nil
+// billingPeriodUsage prices the usage of an organization in a billing period. Managed organizations
+// are billed through their parent, so the usage of each organization it manages is added to the
+// total and listed as a sub-line-item.
+func billingPeriodUsage(metricsDB *gorm.DB, orgID string, billingPeriodStart, billingPeriodEnd time.Time) (UsageBreakdown, error) {
+	breakdown, err := organizationUsage(metricsDB, orgID, billingPeriodStart, billingPeriodEnd)
+	if err != nil {
+		return UsageBreakdown{}, err
 	}
 
-	// Calculate usage for the billing period
-	metricsDB := database.GetMetricsDB()
-	if metricsDB == nil {
-		return nil, false, fmt.Errorf("metrics database not available")
+	var managedOrgs []database.Organization
+	if err := database.DB.Where("parent_org_id = ?", orgID).Order("created_at ASC, id ASC").Find(&managedOrgs).Error; err != nil {
+		return UsageBreakdown{}, fmt.Errorf("get managed organizations: %w", err)
 	}
+	for _, managed := range managedOrgs {
+		// Storage and public IPs of organizations created during the period are prorated from their creation
+		periodStart := billingPeriodStart
+		if created := managed.CreatedAt.UTC().Truncate(24 * time.Hour); created.After(periodStart) {
+			periodStart = created
+		}
+		if !periodStart.Before(billingPeriodEnd) {
+			continue
+		}
+		usage, err := organizationUsage(metricsDB, managed.ID, periodStart, billingPeriodEnd)
+		if err != nil {
+			return UsageBreakdown{}, fmt.Errorf("managed organization %s: %w", managed.ID, err)
+		}
+		breakdown.ManagedOrganizations = append(breakdown.ManagedOrganizations, ManagedOrganizationCharge{
+			OrganizationID: managed.ID,
+			Name:           managed.Name,
+			UsageBreakdown: usage,
+		})
+		breakdown.TotalCostCents += usage.TotalCostCents
+	}
+	return breakdown, nil
+}
 
+// organizationUsage prices the usage of a single organization in a billing period, including
+// metered usage its credits did not cover
+func organizationUsage(metricsDB *gorm.DB, orgID string, billingPeriodStart, billingPeriodEnd time.Time) (UsageBreakdown, error) {
 	// Get usage from hourly aggregates for the billing period
 	var hourlyUsage struct {
 		CPUCoreSeconds    int64
@@ -543,6 +362,14 @@ func GenerateCurrentBillEarly(orgID string) (*database.MonthlyBill, bool, error)
 	hourlyUsage.BandwidthRxBytes += gameServerHourlyUsage.BandwidthRxBytes + vpsHourlyUsage.BandwidthRxBytes + databaseHourlyUsage.BandwidthRxBytes
 	hourlyUsage.BandwidthTxBytes += gameServerHourlyUsage.BandwidthTxBytes + vpsHourlyUsage.BandwidthTxBytes + databaseHourlyUsage.BandwidthTxBytes
 
+	// CPU and memory metered hourly are not priced again; unpaid metered usage is billed as debt below
+	meteredCPUCoreSeconds, meteredMemoryByteSeconds, err := meteredUsageForPeriod(orgID, billingPeriodStart, billingPeriodEnd)
+	if err != nil {
+		return UsageBreakdown{}, err
+	}
+	hourlyUsage.CPUCoreSeconds = max(hourlyUsage.CPUCoreSeconds-meteredCPUCoreSeconds, 0)
+	hourlyUsage.MemoryByteSeconds = max(hourlyUsage.MemoryByteSeconds-meteredMemoryByteSeconds, 0)
+
 	// Get storage bytes (snapshot from deployments, game servers, and VPS tables)
 	var deploymentStorage struct {
 		StorageBytes int64
@@ -635,18 +462,117 @@ func GenerateCurrentBillEarly(orgID string) (*database.MonthlyBill, bool, error)
 		}
 	}
 
-	totalCostCents := cpuCost + memoryCost + bandwidthCost + storageCost + publicIPCost
+	meteredDebt, err := meteredUsageDebt(orgID)
+	if err != nil {
+		return UsageBreakdown{}, err
+	}
 
-	// Create usage breakdown
-	breakdown := UsageBreakdown{
+	return UsageBreakdown{
 		CPUCostCents:       cpuCost,
 		MemoryCostCents:    memoryCost,
 		BandwidthCostCents: bandwidthCost,
 		StorageCostCents:   storageCost,
 		PublicIPCostCents:  publicIPCost,
-		TotalCostCents:     totalCostCents,
+		MeteredDebtCents:   meteredDebt,
+		TotalCostCents:     cpuCost + memoryCost + bandwidthCost + storageCost + publicIPCost + meteredDebt,
+	}, nil
+}
+
+// settleBillingPeriodDebt removes the metered usage debt moved onto a bill from the metering balances
+// of the organization and the managed organizations billed with it
+func settleBillingPeriodDebt(tx *gorm.DB, orgID string, breakdown UsageBreakdown) error {
+	if err := settleMeteredUsageDebt(tx, orgID, breakdown.MeteredDebtCents); err != nil {
+		return err
+	}
+	for _, managed := range breakdown.ManagedOrganizations {
+		if err := settleMeteredUsageDebt(tx, managed.OrganizationID, managed.MeteredDebtCents); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GenerateCurrentBillEarly generates a bill for the current billing period ending at the current date/time
+// This allows users to create and pay bills before their scheduled billing date
+func GenerateCurrentBillEarly(orgID string) (*database.MonthlyBill, bool, error) {
+	now := time.Now()
+
+	// Get the billing account to find the billing date
+	var billingAccount database.BillingAccount
+	if err := database.DB.Where("organization_id = ?", orgID).First(&billingAccount).Error; err != nil {
+		return nil, false, fmt.Errorf("billing account not found: %w", err)
+	}
+
+	if billingAccount.BillingDate == nil {
+		return nil, false, fmt.Errorf("billing date not set for organization %s", orgID)
+	}
+
+	billingDay := *billingAccount.BillingDate
+
+	// Calculate billing period
+	var org database.Organization
+	if err := database.DB.First(&org, "id = ?", orgID).Error; err != nil {
+		return nil, false, fmt.Errorf("organization not found: %w", err)
+	}
+
+	// Find the last bill to determine the start of this billing period
+	var lastBill database.MonthlyBill
+	var billingPeriodStart time.Time
+	var billingPeriodEnd time.Time
+
+	if err := database.DB.Where("organization_id = ? AND status IN ?", orgID, []string{"PAID", "PENDING"}).
+		Order("billing_period_end DESC").First(&lastBill).Error; err == nil {
+		// Use the end of the last billing period as the start of this one
+		billingPeriodStart = lastBill.BillingPeriodEnd
+	} else {
+		// First bill: start from org creation date
+		billingPeriodStart = org.CreatedAt.UTC().Truncate(24 * time.Hour)
 	}
 
+	// Calculate the next billing date
+	nextBillingDate := time.Date(now.Year(), now.Month(), billingDay, 0, 0, 0, 0, time.UTC)
+	if nextBillingDate.Before(now) || nextBillingDate.Equal(now) {
+		// If the billing date has passed this month, use next month
+		nextBillingDate = nextBillingDate.AddDate(0, 1, 0)
+	}
+
+	// Use the earlier of: now or next billing date
+	// This ensures we don't create bills for future periods
+	if now.Before(nextBillingDate) {
+		billingPeriodEnd = now.UTC().Truncate(time.Hour) // Round to hour for consistency
+	} else {
+		billingPeriodEnd = nextBillingDate
+	}
+
+	// Check if a bill already exists for this period (or overlapping period)
+	var existingBill database.MonthlyBill
+	if err := database.DB.Where("organization_id = ? AND billing_period_start = ? AND billing_period_end = ?",
+		orgID, billingPeriodStart, billingPeriodEnd).First(&existingBill).Error; err == nil {
+		log.Printf("[Generate Current Bill] Bill already exists for org %s for period %s to %s", orgID,
+			billingPeriodStart.Format("2006-01-02"), billingPeriodEnd.Format("2006-01-02"))
+		return &existingBill, true, nil
+	}
+
+	// Also check if there's a bill that covers this period (e.g., if billing date already passed)
+	var overlappingBill database.MonthlyBill
+	if err := database.DB.Where("organization_id = ? AND billing_period_start <= ? AND billing_period_end >= ?",
+		orgID, billingPeriodStart, billingPeriodEnd).First(&overlappingBill).Error; err == nil {
+		log.Printf("[Generate Current Bill] Overlapping bill exists for org %s", orgID)
+		return &overlappingBill, true, nil
+	}
+
+	// Calculate usage for the billing period
+	metricsDB := database.GetMetricsDB()
+	if metricsDB == nil {
+		return nil, false, fmt.Errorf("metrics database not available")
+	}
+
+	breakdown, err := billingPeriodUsage(metricsDB, orgID, billingPeriodStart, billingPeriodEnd)
+	if err != nil {
+		return nil, false, err
+	}
+	totalCostCents := breakdown.TotalCostCents
+
 	breakdownJSON, err := json.Marshal(breakdown)
 	if err != nil {
 		return nil, false, fmt.Errorf("marshal breakdown: %w", err)
@@ -668,10 +594,16 @@ func GenerateCurrentBillEarly(orgID string) (*database.MonthlyBill, bool, error)
 		CreatedAt:          time.Now(),
 		UpdatedAt:          time.Now(),
 	}
+	setBillDisplayAmount(bill, billingAccount.Currency)
 
 	// Create the bill (but don't auto-pay - let user pay it manually)
-	if err := database.DB.Create(bill).Error; err != nil {
-		return nil, false, fmt.Errorf("create bill: %w", err)
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(bill).Error; err != nil {
+			return fmt.Errorf("create bill: %w", err)
+		}
+		return settleBillingPeriodDebt(tx, orgID, breakdown)
+	}); err != nil {
+		return nil, false, err
 	}
 
 	log.Printf("[Generate Current Bill] Created bill %s for org %s: %d cents (period %s to %s)",
@@ -0,0 +1,124 @@
+package billing
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/pricing"
+)
+
+func TestProcessMonthlyBillingRollsUpManagedOrganizations(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.BillingAccount{},
+		&database.MonthlyBill{},
+		&database.CreditTransaction{},
+		&database.MeteredUsageRecord{},
+		&database.MeteredUsageBalance{},
+		&database.Deployment{},
+		&database.GameServer{},
+		&database.VPSInstance{},
+		&database.VPSPublicIP{},
+		&database.DatabaseInstance{},
+	)
+	metricsDB := newTestMetricsDB(t,
+		&database.DeploymentUsageHourly{},
+		&database.GameServerUsageHourly{},
+		&database.VPSUsageHourly{},
+		&database.DatabaseUsageHourly{},
+	)
+
+	now := time.Now()
+	billingDay := now.Day()
+	periodEnd := time.Date(now.Year(), now.Month(), billingDay, 0, 0, 0, 0, time.UTC)
+	created := periodEnd.AddDate(0, -1, 0)
+	parentID := "org-reseller"
+	records := []any{
+		&database.Organization{ID: parentID, Name: "Reseller", Slug: "reseller", Status: "active", Credits: 1_000_000, CreatedAt: created},
+		&database.Organization{ID: "org-client-a", Name: "Client A", Slug: "client-a", Status: "active", ParentOrgID: &parentID, CreatedAt: created},
+		&database.Organization{ID: "org-client-b", Name: "Client B", Slug: "client-b", Status: "active", ParentOrgID: &parentID, CreatedAt: created},
+		// Managed organizations may have billing accounts of their own, which are not billed
+		&database.BillingAccount{ID: "ba-reseller", OrganizationID: parentID, Status: "ACTIVE", BillingDate: &billingDay, Currency: "USD"},
+		&database.BillingAccount{ID: "ba-client-a", OrganizationID: "org-client-a", Status: "ACTIVE", BillingDate: &billingDay, Currency: "USD"},
+		&database.MeteredUsageBalance{OrganizationID: "org-client-a", OutstandingCents: 7.5},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+	// An hour at 2000 cores for the reseller and 1000 cores for client A
+	hour := periodEnd.Add(-2 * time.Hour)
+	for _, usage := range []database.DeploymentUsageHourly{
+		{DeploymentID: "deploy-reseller", OrganizationID: parentID, Hour: hour, AvgCPUUsage: 200_000},
+		{DeploymentID: "deploy-client-a", OrganizationID: "org-client-a", Hour: hour, AvgCPUUsage: 100_000},
+	} {
+		if err := metricsDB.Create(&usage).Error; err != nil {
+			t.Fatalf("seed usage: %v", err)
+		}
+	}
+
+	if err := ProcessMonthlyBilling(); err != nil {
+		t.Fatalf("ProcessMonthlyBilling: %v", err)
+	}
+
+	var bills []database.MonthlyBill
+	if err := db.Find(&bills).Error; err != nil {
+		t.Fatalf("list bills: %v", err)
+	}
+	if len(bills) != 1 || bills[0].OrganizationID != parentID {
+		t.Fatalf("bills = %+v, want a single bill for the reseller", bills)
+	}
+	bill := bills[0]
+
+	var breakdown UsageBreakdown
+	if err := json.Unmarshal([]byte(bill.UsageBreakdown), &breakdown); err != nil {
+		t.Fatalf("unmarshal breakdown: %v", err)
+	}
+	pricingModel := pricing.GetPricing()
+	resellerCPU := pricingModel.CalculateCPUCost(2000 * 3600)
+	clientCPU := pricingModel.CalculateCPUCost(1000 * 3600)
+	if clientCPU == 0 {
+		t.Fatal("test usage is too small to be priced")
+	}
+	if breakdown.CPUCostCents != resellerCPU {
+		t.Fatalf("reseller CPU cost = %d, want %d without the managed organizations", breakdown.CPUCostCents, resellerCPU)
+	}
+	if len(breakdown.ManagedOrganizations) != 2 {
+		t.Fatalf("managed organization line items = %+v, want one per managed organization", breakdown.ManagedOrganizations)
+	}
+	clientA, clientB := breakdown.ManagedOrganizations[0], breakdown.ManagedOrganizations[1]
+	if clientA.OrganizationID != "org-client-a" || clientA.Name != "Client A" || clientA.CPUCostCents != clientCPU ||
+		clientA.MeteredDebtCents != 7 || clientA.TotalCostCents != clientCPU+7 {
+		t.Fatalf("client A line item = %+v, want %d cents CPU and 7 cents metered debt", clientA, clientCPU)
+	}
+	if clientB.OrganizationID != "org-client-b" || clientB.TotalCostCents != 0 {
+		t.Fatalf("client B line item = %+v, want an empty line item", clientB)
+	}
+
+	resellerOwn := breakdown.CPUCostCents + breakdown.MemoryCostCents + breakdown.BandwidthCostCents +
+		breakdown.StorageCostCents + breakdown.PublicIPCostCents + breakdown.MeteredDebtCents
+	if breakdown.TotalCostCents != resellerOwn+clientA.TotalCostCents || bill.AmountCents != breakdown.TotalCostCents {
+		t.Fatalf("bill amount = %d, total = %d, want the reseller's %d plus client A's %d",
+			bill.AmountCents, breakdown.TotalCostCents, resellerOwn, clientA.TotalCostCents)
+	}
+
+	// The whole bill is paid from the reseller's credits and the rolled-up debt is settled
+	var reseller database.Organization
+	if err := db.First(&reseller, "id = ?", parentID).Error; err != nil {
+		t.Fatalf("reload reseller: %v", err)
+	}
+	if bill.Status != "PAID" || reseller.Credits != 1_000_000-bill.AmountCents {
+		t.Fatalf("bill status %s, reseller credits %d, want the bill paid from credits", bill.Status, reseller.Credits)
+	}
+	var balance database.MeteredUsageBalance
+	if err := db.First(&balance, "organization_id = ?", "org-client-a").Error; err != nil {
+		t.Fatalf("reload metered usage balance: %v", err)
+	}
+	if math.Abs(balance.OutstandingCents-0.5) > 1e-9 {
+		t.Fatalf("client A outstanding metered usage = %v, want 0.5", balance.OutstandingCents)
+	}
+}
@@ -47,12 +47,6 @@ func GrantMonthlyFreeCredits() error {
 
 		// Grant credits in a transaction
 		err := database.DB.Transaction(func(tx *gorm.DB) error {
-			// Get organization
-			var org database.Organization
-			if err := tx.First(&org, "id = ?", quota.OrganizationID).Error; err != nil {
-				return fmt.Errorf("organization not found: %w", err)
-			}
-
 			// Check if we've already granted credits this month using the tracking table
 			now := time.Now()
 			monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
@@ -66,28 +60,11 @@ func GrantMonthlyFreeCredits() error {
 			}
 
 			// Grant credits
-			oldBalance := org.Credits
-			org.Credits += plan.MonthlyFreeCreditsCents
-			if err := tx.Save(&org).Error; err != nil {
-				return fmt.Errorf("update credits: %w", err)
-			}
-
-			// Record credit transaction
 			monthStr := monthStart.Format("2006-01")
 			note := fmt.Sprintf("Monthly free credits for %s (plan: %s)", monthStr, plan.Name)
-			transactionID := generateID("ct")
-			transaction := &database.CreditTransaction{
-				ID:             transactionID,
-				OrganizationID: quota.OrganizationID,
-				AmountCents:    plan.MonthlyFreeCreditsCents,
-				BalanceAfter:   org.Credits,
-				Type:           "admin_add",
-				Source:         "system",
-				Note:           &note,
-				CreatedAt:      time.Now(),
-			}
-			if err := tx.Create(transaction).Error; err != nil {
-				return fmt.Errorf("create transaction: %w", err)
+			balance, err := grantFreeCredits(tx, quota.OrganizationID, plan.MonthlyFreeCreditsCents, "admin_add", note, now)
+			if err != nil {
+				return err
 			}
 
 			// Record grant in tracking table for metrics and recovery
@@ -103,8 +80,8 @@ func GrantMonthlyFreeCredits() error {
 				return fmt.Errorf("create grant record: %w", err)
 			}
 
-			log.Printf("[Monthly Credits] Granted %d cents to org %s (plan: %s, balance: %d -> %d)",
-				plan.MonthlyFreeCreditsCents, quota.OrganizationID, plan.Name, oldBalance, org.Credits)
+			log.Printf("[Monthly Credits] Granted %d cents to org %s (plan: %s, balance: %d)",
+				plan.MonthlyFreeCreditsCents, quota.OrganizationID, plan.Name, balance)
 
 			totalGranted += plan.MonthlyFreeCreditsCents
 			orgsProcessed++
@@ -123,3 +100,33 @@ func GrantMonthlyFreeCredits() error {
 
 	return nil
 }
+
+// grantFreeCredits adds free credits to an organization within tx and records the credit transaction.
+// The credits expire like every other free credit grant. It returns the balance after the grant.
+func grantFreeCredits(tx *gorm.DB, orgID string, amountCents int64, transactionType, note string, now time.Time) (int64, error) {
+	var org database.Organization
+	if err := tx.First(&org, "id = ?", orgID).Error; err != nil {
+		return 0, fmt.Errorf("organization not found: %w", err)
+	}
+
+	org.Credits += amountCents
+	if err := tx.Save(&org).Error; err != nil {
+		return 0, fmt.Errorf("update credits: %w", err)
+	}
+
+	transaction := &database.CreditTransaction{
+		ID:             generateID("ct"),
+		OrganizationID: orgID,
+		AmountCents:    amountCents,
+		BalanceAfter:   org.Credits,
+		Type:           transactionType,
+		Source:         "system",
+		Note:           &note,
+		ExpiresAt:      freeCreditExpiry(now),
+		CreatedAt:      now,
+	}
+	if err := tx.Create(transaction).Error; err != nil {
+		return 0, fmt.Errorf("create transaction: %w", err)
+	}
+	return org.Credits, nil
+}
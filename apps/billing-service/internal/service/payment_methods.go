@@ -0,0 +1,215 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/notifications"
+
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+
+	"connectrpc.com/connect"
+	stripego "github.com/stripe/stripe-go/v83"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// paymentMethodExpiryWarning is how long before a card expires its organization is warned
+const paymentMethodExpiryWarning = 30 * 24 * time.Hour
+
+// notifyOrganization sends an in-app notification to an organization's members; tests replace it
+var notifyOrganization = notifications.CreateNotificationForOrganization
+
+// paymentMethodClient manages the payment methods of a Stripe customer; *stripe.Client implements it
+type paymentMethodClient interface {
+	ListPaymentMethods(ctx context.Context, customerID string) ([]*stripego.PaymentMethod, error)
+	AttachPaymentMethod(ctx context.Context, paymentMethodID, customerID string) (*stripego.PaymentMethod, error)
+	DetachPaymentMethod(ctx context.Context, paymentMethodID string) error
+	SetDefaultPaymentMethod(ctx context.Context, customerID, paymentMethodID string) error
+	GetCustomer(ctx context.Context, customerID string) (*stripego.Customer, error)
+}
+
+// checkPaymentMethodsConfigured returns an error if there is no Stripe client to manage payment methods with
+func (s *Service) checkPaymentMethodsConfigured() error {
+	if s.paymentMethods == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("Stripe is not configured. Please set STRIPE_SECRET_KEY environment variable"))
+	}
+	return nil
+}
+
+// checkNotManagedOrganization returns an error for managed organizations, which are charged through
+// their parent organization and cannot have payment methods of their own
+func checkNotManagedOrganization(orgID string) error {
+	parentID, err := database.ParentOrganizationID(database.DB, orgID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("check organization: %w", err))
+	}
+	if parentID != "" {
+		return connect.NewError(connect.CodeFailedPrecondition, database.ErrManagedOrganization)
+	}
+	return nil
+}
+
+func paymentMethodToProto(pm *stripego.PaymentMethod, isDefault bool) *billingv1.PaymentMethod {
+	protoPM := &billingv1.PaymentMethod{
+		Id:        pm.ID,
+		Type:      string(pm.Type),
+		IsDefault: isDefault,
+	}
+	if pm.Card != nil {
+		protoPM.Card = &billingv1.CardDetails{
+			Brand:    string(pm.Card.Brand),
+			Last4:    pm.Card.Last4,
+			ExpMonth: int32(pm.Card.ExpMonth),
+			ExpYear:  int32(pm.Card.ExpYear),
+		}
+		if pm.BillingDetails != nil && pm.BillingDetails.Name != "" {
+			protoPM.Card.Name = &pm.BillingDetails.Name
+		}
+	}
+	if pm.Created > 0 {
+		protoPM.CreatedAt = timestamppb.New(time.Unix(pm.Created, 0))
+	}
+	return protoPM
+}
+
+// savePaymentMethod stores the card details of a Stripe payment method for display. The expiry
+// warning is sent again when the card's expiry changes.
+func savePaymentMethod(tx *gorm.DB, orgID, customerID string, pm *stripego.PaymentMethod, isDefault bool) error {
+	record := database.PaymentMethod{
+		ID:               pm.ID,
+		OrganizationID:   orgID,
+		StripeCustomerID: customerID,
+		Type:             string(pm.Type),
+		IsDefault:        isDefault,
+	}
+	if pm.Card != nil {
+		record.Brand = string(pm.Card.Brand)
+		record.Last4 = pm.Card.Last4
+		record.ExpMonth = int32(pm.Card.ExpMonth)
+		record.ExpYear = int32(pm.Card.ExpYear)
+	}
+
+	var existing database.PaymentMethod
+	err := tx.Where("id = ?", pm.ID).First(&existing).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return tx.Create(&record).Error
+	case err != nil:
+		return err
+	}
+	record.CreatedAt = existing.CreatedAt
+	if existing.ExpMonth == record.ExpMonth && existing.ExpYear == record.ExpYear {
+		record.ExpiryNotifiedAt = existing.ExpiryNotifiedAt
+	}
+	return tx.Save(&record).Error
+}
+
+// storeDefaultPaymentMethod stores a payment method as the organization's only default one
+func storeDefaultPaymentMethod(ctx context.Context, orgID, customerID string, pm *stripego.PaymentMethod) error {
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&database.PaymentMethod{}).
+			Where("organization_id = ? AND id <> ?", orgID, pm.ID).
+			Update("is_default", false).Error; err != nil {
+			return fmt.Errorf("clear default payment method: %w", err)
+		}
+		if err := savePaymentMethod(tx, orgID, customerID, pm, true); err != nil {
+			return fmt.Errorf("save payment method: %w", err)
+		}
+		return nil
+	})
+}
+
+// syncPaymentMethods replaces the stored payment methods of an organization with the ones Stripe lists
+func syncPaymentMethods(ctx context.Context, orgID, customerID string, methods []*stripego.PaymentMethod, defaultID string) error {
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ids := make([]string, 0, len(methods))
+		for _, pm := range methods {
+			if err := savePaymentMethod(tx, orgID, customerID, pm, pm.ID == defaultID); err != nil {
+				return fmt.Errorf("save payment method: %w", err)
+			}
+			ids = append(ids, pm.ID)
+		}
+		removed := tx.Where("organization_id = ?", orgID)
+		if len(ids) > 0 {
+			removed = removed.Where("id NOT IN ?", ids)
+		}
+		if err := removed.Delete(&database.PaymentMethod{}).Error; err != nil {
+			return fmt.Errorf("delete removed payment methods: %w", err)
+		}
+		return nil
+	})
+}
+
+// setStoredDefaultPaymentMethod marks paymentMethodID as the organization's default payment method
+func setStoredDefaultPaymentMethod(ctx context.Context, orgID, paymentMethodID string) error {
+	if err := database.DB.WithContext(ctx).Model(&database.PaymentMethod{}).
+		Where("organization_id = ?", orgID).
+		Update("is_default", gorm.Expr("id = ?", paymentMethodID)).Error; err != nil {
+		return fmt.Errorf("set default payment method: %w", err)
+	}
+	return nil
+}
+
+// paymentMethodExpiresAt returns when a card stops working: cards are valid through the end of their expiry month
+func paymentMethodExpiresAt(pm *database.PaymentMethod) time.Time {
+	return time.Date(int(pm.ExpYear), time.Month(pm.ExpMonth)+1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// NotifyExpiringPaymentMethods warns organizations whose stored cards expire within 30 days.
+// Each card is warned about once per expiry date.
+func NotifyExpiringPaymentMethods(ctx context.Context) error {
+	return notifyExpiringPaymentMethods(ctx, time.Now())
+}
+
+func notifyExpiringPaymentMethods(ctx context.Context, now time.Time) error {
+	var methods []database.PaymentMethod
+	if err := database.DB.WithContext(ctx).
+		Where("expiry_notified_at IS NULL AND exp_year > 0 AND exp_month > 0").
+		Find(&methods).Error; err != nil {
+		return fmt.Errorf("get payment methods: %w", err)
+	}
+
+	notified := 0
+	for i := range methods {
+		pm := &methods[i]
+		expiresAt := paymentMethodExpiresAt(pm)
+		if !expiresAt.After(now) || expiresAt.Sub(now) > paymentMethodExpiryWarning {
+			continue
+		}
+
+		actionURL := "/billing"
+		actionLabel := "Update payment method"
+		if err := notifyOrganization(
+			ctx,
+			pm.OrganizationID,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_BILLING,
+			notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+			"Card expiring soon",
+			fmt.Sprintf("The %s card ending in %s expires at the end of %02d/%d. Add a new card to avoid failed payments.", pm.Brand, pm.Last4, pm.ExpMonth, pm.ExpYear),
+			&actionURL, &actionLabel,
+			map[string]string{"organization_id": pm.OrganizationID, "payment_method_id": pm.ID},
+			[]string{"owner", "admin"},
+		); err != nil {
+			log.Printf("[Payment Methods] Failed to warn organization %s about expiring card %s: %v", pm.OrganizationID, pm.ID, err)
+			continue
+		}
+
+		if err := database.DB.WithContext(ctx).Model(&database.PaymentMethod{}).
+			Where("id = ?", pm.ID).
+			Update("expiry_notified_at", now).Error; err != nil {
+			return fmt.Errorf("mark card %s notified: %w", pm.ID, err)
+		}
+		notified++
+	}
+
+	if notified > 0 {
+		log.Printf("[Payment Methods] Warned about %d expiring cards", notified)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+	stripego "github.com/stripe/stripe-go/v83"
+	"gorm.io/gorm"
+)
+
+// fakePaymentMethodClient keeps the payment methods of one Stripe customer in memory
+type fakePaymentMethodClient struct {
+	customerID string
+	cards      map[string]*stripego.PaymentMethod // payment methods that can be attached, by ID
+	attached   []string
+	defaultID  string
+}
+
+func newFakePaymentMethodClient(customerID string, cards ...*stripego.PaymentMethod) *fakePaymentMethodClient {
+	f := &fakePaymentMethodClient{customerID: customerID, cards: make(map[string]*stripego.PaymentMethod)}
+	for _, card := range cards {
+		f.cards[card.ID] = card
+	}
+	return f
+}
+
+func (f *fakePaymentMethodClient) ListPaymentMethods(ctx context.Context, customerID string) ([]*stripego.PaymentMethod, error) {
+	methods := make([]*stripego.PaymentMethod, 0, len(f.attached))
+	for _, id := range f.attached {
+		methods = append(methods, f.cards[id])
+	}
+	return methods, nil
+}
+
+func (f *fakePaymentMethodClient) AttachPaymentMethod(ctx context.Context, paymentMethodID, customerID string) (*stripego.PaymentMethod, error) {
+	card, ok := f.cards[paymentMethodID]
+	if !ok || customerID != f.customerID {
+		return nil, fmt.Errorf("no such payment method %s", paymentMethodID)
+	}
+	f.attached = append(f.attached, paymentMethodID)
+	return card, nil
+}
+
+func (f *fakePaymentMethodClient) DetachPaymentMethod(ctx context.Context, paymentMethodID string) error {
+	f.attached = slices.DeleteFunc(f.attached, func(id string) bool { return id == paymentMethodID })
+	if f.defaultID == paymentMethodID {
+		f.defaultID = ""
+	}
+	return nil
+}
+
+func (f *fakePaymentMethodClient) SetDefaultPaymentMethod(ctx context.Context, customerID, paymentMethodID string) error {
+	f.defaultID = paymentMethodID
+	return nil
+}
+
+func (f *fakePaymentMethodClient) GetCustomer(ctx context.Context, customerID string) (*stripego.Customer, error) {
+	cust := &stripego.Customer{ID: customerID, InvoiceSettings: &stripego.CustomerInvoiceSettings{}}
+	if f.defaultID != "" {
+		cust.InvoiceSettings.DefaultPaymentMethod = &stripego.PaymentMethod{ID: f.defaultID}
+	}
+	return cust, nil
+}
+
+func testCard(id string, brand stripego.PaymentMethodCardBrand, last4 string, expMonth, expYear int64) *stripego.PaymentMethod {
+	return &stripego.PaymentMethod{
+		ID:   id,
+		Type: stripego.PaymentMethodTypeCard,
+		Card: &stripego.PaymentMethodCard{Brand: brand, Last4: last4, ExpMonth: expMonth, ExpYear: expYear},
+	}
+}
+
+func storedPaymentMethods(t *testing.T, db *gorm.DB, orgID string) map[string]database.PaymentMethod {
+	t.Helper()
+	var records []database.PaymentMethod
+	if err := db.Where("organization_id = ?", orgID).Find(&records).Error; err != nil {
+		t.Fatalf("get stored payment methods: %v", err)
+	}
+	stored := make(map[string]database.PaymentMethod, len(records))
+	for _, record := range records {
+		stored[record.ID] = record
+	}
+	return stored
+}
+
+func TestPaymentMethodManagement(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.BillingAccount{},
+		&database.MonthlyBill{},
+		&database.PaymentMethod{},
+	)
+	seedBillingServiceIsolationData(t, db)
+	customerID := "cus_org_a"
+	if err := db.Model(&database.BillingAccount{}).Where("organization_id = ?", "org-a").Update("stripe_customer_id", customerID).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	stripeClient := newFakePaymentMethodClient(customerID,
+		testCard("pm_visa", stripego.PaymentMethodCardBrandVisa, "4242", 12, 2030),
+		testCard("pm_mastercard", stripego.PaymentMethodCardBrandMastercard, "4444", 3, 2031),
+	)
+	service := &Service{billingEnabled: true, paymentMethods: stripeClient}
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	for _, id := range []string{"pm_visa", "pm_mastercard"} {
+		res, err := service.AttachPaymentMethod(ctx, connect.NewRequest(&billingv1.AttachPaymentMethodRequest{
+			OrganizationId: "org-a", PaymentMethodId: id,
+		}))
+		if err != nil {
+			t.Fatalf("attach %s: %v", id, err)
+		}
+		if !res.Msg.GetPaymentMethod().GetIsDefault() {
+			t.Fatalf("attached %s is not the default", id)
+		}
+	}
+	if stripeClient.defaultID != "pm_mastercard" {
+		t.Fatalf("Stripe default = %q, want the card added last", stripeClient.defaultID)
+	}
+	stored := storedPaymentMethods(t, db, "org-a")
+	visa := stored["pm_visa"]
+	if visa.Brand != "visa" || visa.Last4 != "4242" || visa.ExpMonth != 12 || visa.ExpYear != 2030 || visa.IsDefault {
+		t.Fatalf("stored visa = %+v, want a non-default visa 4242 expiring 12/2030", visa)
+	}
+	if !stored["pm_mastercard"].IsDefault {
+		t.Fatal("stored mastercard is not the default")
+	}
+
+	if _, err := service.SetDefaultPaymentMethod(ctx, connect.NewRequest(&billingv1.SetDefaultPaymentMethodRequest{
+		OrganizationId: "org-a", PaymentMethodId: "pm_visa",
+	})); err != nil {
+		t.Fatalf("set default: %v", err)
+	}
+	stored = storedPaymentMethods(t, db, "org-a")
+	if !stored["pm_visa"].IsDefault || stored["pm_mastercard"].IsDefault {
+		t.Fatalf("stored defaults after SetDefaultPaymentMethod = %+v", stored)
+	}
+
+	if _, err := service.DetachPaymentMethod(ctx, connect.NewRequest(&billingv1.DetachPaymentMethodRequest{
+		OrganizationId: "org-a", PaymentMethodId: "pm_mastercard",
+	})); err != nil {
+		t.Fatalf("detach: %v", err)
+	}
+	if stored := storedPaymentMethods(t, db, "org-a"); len(stored) != 1 || !stored["pm_visa"].IsDefault {
+		t.Fatalf("stored payment methods after detach = %+v, want only the default visa", stored)
+	}
+
+	// A card removed in Stripe directly disappears from the stored ones when the cards are listed
+	stripeClient.attached = nil
+	stripeClient.defaultID = ""
+	list, err := service.ListPaymentMethods(ctx, connect.NewRequest(&billingv1.ListPaymentMethodsRequest{OrganizationId: "org-a"}))
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(list.Msg.GetPaymentMethods()) != 0 {
+		t.Fatalf("listed %d payment methods, want none", len(list.Msg.GetPaymentMethods()))
+	}
+	if stored := storedPaymentMethods(t, db, "org-a"); len(stored) != 0 {
+		t.Fatalf("stored payment methods after list = %+v, want none", stored)
+	}
+
+	_, err = service.AttachPaymentMethod(ctx, connect.NewRequest(&billingv1.AttachPaymentMethodRequest{
+		OrganizationId: "org-b", PaymentMethodId: "pm_visa",
+	}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("cross-org attach code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+	}
+}
+
+func TestNotifyExpiringPaymentMethods(t *testing.T) {
+	db := newTestDB(t, &database.PaymentMethod{})
+
+	var notified []string
+	previous := notifyOrganization
+	notifyOrganization = func(ctx context.Context, orgID string, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity, title, message string, actionURL, actionLabel *string, metadata map[string]string, roles []string) error {
+		if notificationType != notificationsv1.NotificationType_NOTIFICATION_TYPE_BILLING || !slices.Equal(roles, []string{"owner", "admin"}) {
+			t.Errorf("notification type %v for roles %v, want a billing notification for owners and admins", notificationType, roles)
+		}
+		notified = append(notified, metadata["payment_method_id"])
+		return nil
+	}
+	t.Cleanup(func() { notifyOrganization = previous })
+
+	// Cards are valid through the end of their expiry month
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	for _, record := range []*database.PaymentMethod{
+		{ID: "pm_march", OrganizationID: "org-a", StripeCustomerID: "cus_a", Type: "card", Brand: "visa", Last4: "4242", ExpMonth: 3, ExpYear: 2026},
+		{ID: "pm_april", OrganizationID: "org-a", StripeCustomerID: "cus_a", Type: "card", Brand: "visa", Last4: "1111", ExpMonth: 4, ExpYear: 2026},
+		{ID: "pm_expired", OrganizationID: "org-b", StripeCustomerID: "cus_b", Type: "card", Brand: "amex", Last4: "0005", ExpMonth: 2, ExpYear: 2026},
+	} {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %s: %v", record.ID, err)
+		}
+	}
+
+	for run := 0; run < 2; run++ {
+		if err := notifyExpiringPaymentMethods(context.Background(), now); err != nil {
+			t.Fatalf("run %d: %v", run, err)
+		}
+	}
+	if !slices.Equal(notified, []string{"pm_march"}) {
+		t.Fatalf("warned about %v, want pm_march once", notified)
+	}
+
+	// April ends 21 days after this, so its warning is due
+	notified = nil
+	if err := notifyExpiringPaymentMethods(context.Background(), now.AddDate(0, 0, 30)); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(notified, []string{"pm_april"}) {
+		t.Fatalf("warned about %v, want pm_april", notified)
+	}
+
+	// A renewed card with a new expiry is warned about again before that one
+	renewed := testCard("pm_march", stripego.PaymentMethodCardBrandVisa, "4242", 3, 2029)
+	if err := savePaymentMethod(db, "org-a", "cus_a", renewed, true); err != nil {
+		t.Fatal(err)
+	}
+	var record database.PaymentMethod
+	if err := db.First(&record, "id = ?", "pm_march").Error; err != nil {
+		t.Fatal(err)
+	}
+	if record.ExpiryNotifiedAt != nil || record.ExpYear != 2029 {
+		t.Fatalf("renewed card = %+v, want a 2029 expiry not yet warned about", record)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/obiente/cloud/apps/shared/pkg/database"
 
@@ -61,19 +62,32 @@ func checkAndUpgradePlan(orgID string, tx *gorm.DB) error {
 	if bestPlanFound {
 		currentPlanID := quota.PlanID
 		if currentPlanID != bestPlan.ID {
-			// Upgrade to the new plan
-			quota.PlanID = bestPlan.ID
-
-			// If quota doesn't exist yet, create it
-			if quota.OrganizationID == "" {
-				quota.OrganizationID = orgID
-				if err := tx.Create(&quota).Error; err != nil {
-					return fmt.Errorf("create quota: %w", err)
+			// The plan change and credit proration commit together (a savepoint when tx is already a
+			// transaction), so a failed proration leaves the old plan in place for the next check to retry
+			err := tx.Transaction(func(tx *gorm.DB) error {
+				// Upgrade to the new plan
+				quota.PlanID = bestPlan.ID
+
+				// If quota doesn't exist yet, create it
+				if quota.OrganizationID == "" {
+					quota.OrganizationID = orgID
+					if err := tx.Create(&quota).Error; err != nil {
+						return fmt.Errorf("create quota: %w", err)
+					}
+				} else {
+					if err := tx.Save(&quota).Error; err != nil {
+						return fmt.Errorf("update quota: %w", err)
+					}
 				}
-			} else {
-				if err := tx.Save(&quota).Error; err != nil {
-					return fmt.Errorf("update quota: %w", err)
+
+				// Prorate this month's free credits between the old and new plan
+				if err := prorateCredit(tx, orgID, currentPlanID, bestPlan.ID, time.Now()); err != nil {
+					return fmt.Errorf("prorate credits: %w", err)
 				}
+				return nil
+			})
+			if err != nil {
+				return err
 			}
 
 			log.Printf("[Plan Upgrade] Organization %s upgraded from plan %s to plan %s (total paid: %d cents, minimum required: %d cents)",
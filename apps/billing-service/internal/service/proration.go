@@ -0,0 +1,145 @@
+package billing
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// prorationTransactionType marks credit transactions written by plan-change proration,
+// so they are not mistaken for manual admin adjustments in the credit log
+const prorationTransactionType = "proration"
+
+// ProrateCredit adjusts an organization's monthly free credits when its plan changes mid-cycle
+// The unused part of the old plan's grant is taken back and the new plan's grant is given
+// for the remainder of the cycle, so the daily grant job does not pay out a second full month
+func ProrateCredit(orgID, fromPlanID, toPlanID string, changeTime time.Time) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		return prorateCredit(tx, orgID, fromPlanID, toPlanID, changeTime)
+	})
+}
+
+func prorateCredit(tx *gorm.DB, orgID, fromPlanID, toPlanID string, changeTime time.Time) error {
+	if fromPlanID == toPlanID {
+		return nil
+	}
+
+	// Monthly free credits are granted per calendar month (see GrantMonthlyFreeCredits)
+	cycleStart := time.Date(changeTime.UTC().Year(), changeTime.UTC().Month(), 1, 0, 0, 0, 0, time.UTC)
+	cycleEnd := cycleStart.AddDate(0, 1, 0)
+	remaining := cycleEnd.Sub(changeTime)
+	if remaining <= 0 {
+		return nil
+	}
+	cycleLength := cycleEnd.Sub(cycleStart)
+
+	var org database.Organization
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&org, "id = ?", orgID).Error; err != nil {
+		return fmt.Errorf("organization not found: %w", err)
+	}
+
+	// Take back the unused time of the old plan's grant, if it was granted this cycle
+	if fromPlanID != "" {
+		var grants []database.MonthlyCreditGrant
+		if err := tx.Where("organization_id = ? AND plan_id = ? AND grant_month = ?", orgID, fromPlanID, cycleStart).
+			Limit(1).Find(&grants).Error; err != nil {
+			return fmt.Errorf("get grant: %w", err)
+		}
+		if len(grants) > 0 {
+			unused := prorate(grants[0].AmountCents, remaining, cycleLength)
+			// Credits never go negative; anything already spent is not clawed back
+			unused = min(unused, org.Credits)
+			if unused > 0 {
+				note := fmt.Sprintf("Unused free credits for %s (plan change: %s -> %s)", cycleStart.Format("2006-01"), fromPlanID, toPlanID)
+				if err := applyProration(tx, &org, -unused, note, changeTime); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if toPlanID == "" {
+		return nil
+	}
+
+	var plan database.OrganizationPlan
+	if err := tx.First(&plan, "id = ?", toPlanID).Error; err != nil {
+		return fmt.Errorf("get plan: %w", err)
+	}
+	if plan.MonthlyFreeCreditsCents <= 0 {
+		return nil
+	}
+
+	var existing int64
+	if err := tx.Model(&database.MonthlyCreditGrant{}).
+		Where("organization_id = ? AND plan_id = ? AND grant_month = ?", orgID, toPlanID, cycleStart).
+		Count(&existing).Error; err != nil {
+		return fmt.Errorf("get grant: %w", err)
+	}
+	if existing > 0 {
+		return nil // Already granted for this cycle (e.g. a plan change back and forth)
+	}
+
+	granted := prorate(plan.MonthlyFreeCreditsCents, remaining, cycleLength)
+	if granted > 0 {
+		note := fmt.Sprintf("Prorated free credits for %s (plan: %s)", cycleStart.Format("2006-01"), plan.Name)
+		if err := applyProration(tx, &org, granted, note, changeTime); err != nil {
+			return err
+		}
+	}
+
+	// Record the grant so GrantMonthlyFreeCredits skips this plan for the rest of the cycle
+	grant := &database.MonthlyCreditGrant{
+		OrganizationID: orgID,
+		PlanID:         toPlanID,
+		GrantMonth:     cycleStart,
+		AmountCents:    granted,
+		GrantedAt:      changeTime,
+		CreatedAt:      time.Now(),
+	}
+	if err := tx.Create(grant).Error; err != nil {
+		return fmt.Errorf("create grant record: %w", err)
+	}
+
+	log.Printf("[Proration] Organization %s changed plan %s -> %s on %s (balance: %d cents)",
+		orgID, fromPlanID, toPlanID, changeTime.Format(time.RFC3339), org.Credits)
+	return nil
+}
+
+// prorate returns the share of amountCents covering remaining out of cycleLength, rounded down
+func prorate(amountCents int64, remaining, cycleLength time.Duration) int64 {
+	if amountCents <= 0 || cycleLength <= 0 {
+		return 0
+	}
+	return amountCents * int64(remaining/time.Second) / int64(cycleLength/time.Second)
+}
+
+func applyProration(tx *gorm.DB, org *database.Organization, amountCents int64, note string, changeTime time.Time) error {
+	org.Credits += amountCents
+	if err := tx.Model(org).Update("credits", org.Credits).Error; err != nil {
+		return fmt.Errorf("update credits: %w", err)
+	}
+
+	transaction := &database.CreditTransaction{
+		ID:             generateID("ct"),
+		OrganizationID: org.ID,
+		AmountCents:    amountCents,
+		BalanceAfter:   org.Credits,
+		Type:           prorationTransactionType,
+		Source:         "system",
+		Note:           &note,
+		CreatedAt:      changeTime,
+	}
+	if amountCents > 0 {
+		transaction.ExpiresAt = freeCreditExpiry(changeTime)
+	}
+	if err := tx.Create(transaction).Error; err != nil {
+		return fmt.Errorf("create transaction: %w", err)
+	}
+	return nil
+}
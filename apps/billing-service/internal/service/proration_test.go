@@ -0,0 +1,193 @@
+package billing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/gorm"
+)
+
+func TestProrateCreditMidMonth(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationPlan{},
+		&database.MonthlyCreditGrant{},
+		&database.CreditTransaction{},
+		&database.OrgQuota{},
+	)
+
+	// September has 30 days; the change happens after 15 full days
+	cycleStart := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	changeTime := time.Date(2026, time.September, 16, 0, 0, 0, 0, time.UTC)
+	seedProration(t, db, cycleStart, 1000)
+
+	if err := ProrateCredit("org-a", "plan-basic", "plan-pro", changeTime); err != nil {
+		t.Fatalf("ProrateCredit: %v", err)
+	}
+
+	var transactions []database.CreditTransaction
+	db.Where("organization_id = ?", "org-a").Order("amount_cents ASC").Find(&transactions)
+	if len(transactions) != 2 {
+		t.Fatalf("transactions = %d, want 2", len(transactions))
+	}
+	// Half of the old plan's 1000 cent grant is unused, half of the new plan's 3000 cents is granted
+	if debit := transactions[0]; debit.AmountCents != -500 || debit.Type != prorationTransactionType {
+		t.Fatalf("debit = %d (%s), want -500 (proration)", debit.AmountCents, debit.Type)
+	}
+	if credit := transactions[1]; credit.AmountCents != 1500 || credit.Type != prorationTransactionType {
+		t.Fatalf("credit = %d (%s), want 1500 (proration)", credit.AmountCents, credit.Type)
+	}
+
+	var org database.Organization
+	db.First(&org, "id = ?", "org-a")
+	if org.Credits != 2000 {
+		t.Fatalf("credits = %d, want 2000", org.Credits)
+	}
+
+	// The new plan counts as granted for the month, so the daily job does not grant it again
+	var grant database.MonthlyCreditGrant
+	if err := db.First(&grant, "organization_id = ? AND plan_id = ? AND grant_month = ?", "org-a", "plan-pro", cycleStart).Error; err != nil {
+		t.Fatalf("new plan grant not recorded: %v", err)
+	}
+	if grant.AmountCents != 1500 {
+		t.Fatalf("grant amount = %d, want 1500", grant.AmountCents)
+	}
+}
+
+func TestProrateCreditDoesNotGoNegative(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationPlan{},
+		&database.MonthlyCreditGrant{},
+		&database.CreditTransaction{},
+		&database.OrgQuota{},
+	)
+
+	cycleStart := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	// Most of the old plan's grant has already been spent
+	seedProration(t, db, cycleStart, 200)
+
+	if err := ProrateCredit("org-a", "plan-basic", "", time.Date(2026, time.September, 16, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("ProrateCredit: %v", err)
+	}
+
+	var org database.Organization
+	db.First(&org, "id = ?", "org-a")
+	if org.Credits != 0 {
+		t.Fatalf("credits = %d, want 0", org.Credits)
+	}
+}
+
+func TestCheckAndUpgradePlanProratesCredits(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationPlan{},
+		&database.MonthlyCreditGrant{},
+		&database.CreditTransaction{},
+		&database.OrgQuota{},
+	)
+	seedPlanUpgrade(t, db)
+
+	if err := checkAndUpgradePlan("org-a", db); err != nil {
+		t.Fatalf("checkAndUpgradePlan: %v", err)
+	}
+
+	var quota database.OrgQuota
+	db.First(&quota, "organization_id = ?", "org-a")
+	if quota.PlanID != "plan-pro" {
+		t.Fatalf("plan = %q, want plan-pro", quota.PlanID)
+	}
+	var count int64
+	db.Model(&database.CreditTransaction{}).Where("organization_id = ? AND type = ?", "org-a", prorationTransactionType).Count(&count)
+	if count == 0 {
+		t.Fatal("plan upgrade did not prorate credits")
+	}
+}
+
+func TestCheckAndUpgradePlanKeepsOldPlanWhenProrationFails(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationPlan{},
+		&database.MonthlyCreditGrant{},
+		&database.CreditTransaction{},
+		&database.OrgQuota{},
+	)
+	seedPlanUpgrade(t, db)
+
+	// Proration reads the grant table, so without it the upgrade cannot be prorated
+	if err := db.Migrator().DropTable(&database.MonthlyCreditGrant{}); err != nil {
+		t.Fatalf("drop grants table: %v", err)
+	}
+
+	if err := checkAndUpgradePlan("org-a", db); err == nil {
+		t.Fatal("checkAndUpgradePlan succeeded, want proration error")
+	}
+
+	var quota database.OrgQuota
+	db.First(&quota, "organization_id = ?", "org-a")
+	if quota.PlanID != "plan-basic" {
+		t.Fatalf("plan = %q, want plan-basic to be kept for a retry", quota.PlanID)
+	}
+}
+
+func TestProrate(t *testing.T) {
+	month := 30 * 24 * time.Hour
+	cases := []struct {
+		amount    int64
+		remaining time.Duration
+		want      int64
+	}{
+		{amount: 1000, remaining: 15 * 24 * time.Hour, want: 500},
+		{amount: 1000, remaining: month, want: 1000},
+		{amount: 1000, remaining: 0, want: 0},
+		{amount: 999, remaining: 10 * 24 * time.Hour, want: 333},
+		{amount: 0, remaining: month, want: 0},
+	}
+	for _, tc := range cases {
+		if got := prorate(tc.amount, tc.remaining, month); got != tc.want {
+			t.Errorf("prorate(%d, %v) = %d, want %d", tc.amount, tc.remaining, got, tc.want)
+		}
+	}
+}
+
+func seedProration(t *testing.T, db *gorm.DB, cycleStart time.Time, credits int64) {
+	t.Helper()
+
+	records := []any{
+		&database.Organization{ID: "org-a", Name: "Org A", Slug: "org-a", Status: "active", Credits: credits, CreatedAt: cycleStart},
+		&database.OrganizationPlan{ID: "plan-basic", Name: "Basic", MonthlyFreeCreditsCents: 1000},
+		&database.OrganizationPlan{ID: "plan-pro", Name: "Pro", MonthlyFreeCreditsCents: 3000},
+		&database.MonthlyCreditGrant{OrganizationID: "org-a", PlanID: "plan-basic", GrantMonth: cycleStart, AmountCents: 1000, GrantedAt: cycleStart},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+}
+
+// seedPlanUpgrade puts org-a on plan-basic with enough paid to qualify for plan-pro
+func seedPlanUpgrade(t *testing.T, db *gorm.DB) {
+	t.Helper()
+
+	now := time.Now().UTC()
+	seedProration(t, db, time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC), 1000)
+	updates := []struct {
+		model  any
+		column string
+		value  any
+	}{
+		{&database.Organization{ID: "org-a"}, "total_paid_cents", 5000},
+		{&database.OrganizationPlan{ID: "plan-basic"}, "minimum_payment_cents", 1000},
+		{&database.OrganizationPlan{ID: "plan-pro"}, "minimum_payment_cents", 5000},
+	}
+	for _, update := range updates {
+		if err := db.Model(update.model).Update(update.column, update.value).Error; err != nil {
+			t.Fatalf("seed %T.%s: %v", update.model, update.column, err)
+		}
+	}
+	if err := db.Create(&database.OrgQuota{OrganizationID: "org-a", PlanID: "plan-basic"}).Error; err != nil {
+		t.Fatalf("seed quota: %v", err)
+	}
+}
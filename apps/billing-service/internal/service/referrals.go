@@ -0,0 +1,339 @@
+package billing
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	referralCodeCreateLimit  = 3 // codes per user per window
+	referralCodeCreateWindow = 30 * 24 * time.Hour
+	// referralSignupWindow is how long after joining a user still counts as newly signed up
+	referralSignupWindow = 7 * 24 * time.Hour
+	referralCodeLength   = 8
+	// referralCodeAlphabet leaves out characters that are easily confused (0/O, 1/I)
+	referralCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+var (
+	errReferralProgramDisabled = errors.New("the referral program is not enabled")
+	errReferralCodeNotFound    = errors.New("referral code not found")
+	errReferralCodeExhausted   = errors.New("referral code has reached its maximum number of uses")
+	errSelfReferral            = errors.New("you cannot redeem your own referral code")
+	errAlreadyReferred         = errors.New("a referral code has already been redeemed for this user")
+	errNotNewUser              = errors.New("referral codes can only be redeemed by newly signed-up users")
+)
+
+// referralRateLimiter counts referral code creations; *database.RedisCache implements it
+type referralRateLimiter interface {
+	Increment(ctx context.Context, key string) (int64, error)
+	Expire(ctx context.Context, key string, expiration time.Duration) error
+}
+
+// referralSignupCredit is the credit in cents granted to both the referrer and the new user
+// (REFERRAL_SIGNUP_CREDIT). Zero disables the referral program.
+func referralSignupCredit() int64 {
+	return referralEnvCents("REFERRAL_SIGNUP_CREDIT")
+}
+
+// maxReferralCreditsPerUser caps the referral credits in cents a referrer earns per calendar
+// month (MAX_REFERRAL_CREDITS_PER_USER). Zero means no cap.
+func maxReferralCreditsPerUser() int64 {
+	return referralEnvCents("MAX_REFERRAL_CREDITS_PER_USER")
+}
+
+func referralEnvCents(key string) int64 {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return 0
+	}
+	cents, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || cents < 0 {
+		log.Printf("[Referrals] Invalid %s=%q, treating as 0", key, raw)
+		return 0
+	}
+	return cents
+}
+
+// CreateReferralCode creates a referral code for the current user
+func (s *Service) CreateReferralCode(ctx context.Context, req *connect.Request[billingv1.CreateReferralCodeRequest]) (*connect.Response[billingv1.CreateReferralCodeResponse], error) {
+	if err := s.checkBillingEnabled(); err != nil {
+		return nil, err
+	}
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if referralSignupCredit() <= 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errReferralProgramDisabled)
+	}
+
+	maxUses := req.Msg.GetMaxUses()
+	if maxUses < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max_uses cannot be negative"))
+	}
+
+	allowed, err := s.allowReferralCodeCreation(ctx, user.Id)
+	if err != nil {
+		log.Printf("[Referrals] Rate limit check failed for user %s, allowing code creation: %v", user.Id, err)
+	}
+	if !allowed {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("you can create at most %d referral codes every 30 days", referralCodeCreateLimit))
+	}
+
+	code := &database.ReferralCode{
+		ID:        generateID("ref"),
+		UserID:    user.Id,
+		MaxUses:   int(maxUses),
+		CreatedAt: time.Now(),
+	}
+	// Retry in the unlikely case the random code is already taken
+	for attempt := 0; attempt < 5; attempt++ {
+		if code.Code, err = generateReferralCode(); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("generate referral code: %w", err))
+		}
+		var taken int64
+		if err := database.DB.WithContext(ctx).Model(&database.ReferralCode{}).Where("code = ?", code.Code).Count(&taken).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("check referral code: %w", err))
+		}
+		if taken == 0 {
+			break
+		}
+	}
+	if err := database.DB.WithContext(ctx).Create(code).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create referral code: %w", err))
+	}
+
+	log.Printf("[Referrals] User %s created referral code %s", user.Id, code.Code)
+	return connect.NewResponse(&billingv1.CreateReferralCodeResponse{ReferralCode: referralCodeToProto(code)}), nil
+}
+
+// GetReferralCode returns the current user's most recent referral code
+func (s *Service) GetReferralCode(ctx context.Context, req *connect.Request[billingv1.GetReferralCodeRequest]) (*connect.Response[billingv1.GetReferralCodeResponse], error) {
+	if err := s.checkBillingEnabled(); err != nil {
+		return nil, err
+	}
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	var code database.ReferralCode
+	if err := database.DB.WithContext(ctx).Where("user_id = ?", user.Id).Order("created_at DESC").First(&code).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("you have no referral code"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get referral code: %w", err))
+	}
+
+	return connect.NewResponse(&billingv1.GetReferralCodeResponse{ReferralCode: referralCodeToProto(&code)}), nil
+}
+
+// RedeemReferralCode credits a newly signed-up user and the user who referred them
+func (s *Service) RedeemReferralCode(ctx context.Context, req *connect.Request[billingv1.RedeemReferralCodeRequest]) (*connect.Response[billingv1.RedeemReferralCodeResponse], error) {
+	if err := s.checkBillingEnabled(); err != nil {
+		return nil, err
+	}
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	code := strings.ToUpper(strings.TrimSpace(req.Msg.GetCode()))
+	if code == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("code is required"))
+	}
+
+	use, orgID, err := redeemReferralCode(ctx, user.Id, code, time.Now())
+	if err != nil {
+		switch {
+		case errors.Is(err, errReferralCodeNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, errAlreadyReferred):
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		case errors.Is(err, errReferralProgramDisabled), errors.Is(err, errReferralCodeExhausted),
+			errors.Is(err, errSelfReferral), errors.Is(err, errNotNewUser):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&billingv1.RedeemReferralCodeResponse{
+		OrganizationId: orgID,
+		CreditedCents:  use.RefereeCreditCents,
+	}), nil
+}
+
+// redeemReferralCode records refereeID's use of code and grants the signup credit to the
+// organizations of both users. The referrer's share is reduced so that their referral credits
+// this month stay within MAX_REFERRAL_CREDITS_PER_USER. It returns the recorded use and the
+// organization credited for the referee.
+func redeemReferralCode(ctx context.Context, refereeID, code string, now time.Time) (*database.ReferralUse, string, error) {
+	credit := referralSignupCredit()
+	if credit <= 0 {
+		return nil, "", errReferralProgramDisabled
+	}
+
+	var use *database.ReferralUse
+	var refereeOrgID string
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var referral database.ReferralCode
+		if err := tx.Where("code = ?", code).First(&referral).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errReferralCodeNotFound
+			}
+			return fmt.Errorf("get referral code: %w", err)
+		}
+		if referral.UserID == refereeID {
+			return errSelfReferral
+		}
+
+		var previous int64
+		if err := tx.Model(&database.ReferralUse{}).Where("referee_id = ?", refereeID).Count(&previous).Error; err != nil {
+			return fmt.Errorf("check previous referral: %w", err)
+		}
+		if previous > 0 {
+			return errAlreadyReferred
+		}
+
+		refereeOrg, joinedAt, err := referralCreditOrganization(tx, refereeID)
+		if err != nil {
+			return err
+		}
+		if refereeOrg == "" || now.Sub(joinedAt) > referralSignupWindow {
+			return errNotNewUser
+		}
+		referrerOrg, _, err := referralCreditOrganization(tx, referral.UserID)
+		if err != nil {
+			return err
+		}
+
+		result := tx.Model(&database.ReferralCode{}).
+			Where("id = ? AND (max_uses = 0 OR uses_count < max_uses)", referral.ID).
+			UpdateColumn("uses_count", gorm.Expr("uses_count + 1"))
+		if result.Error != nil {
+			return fmt.Errorf("count referral use: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errReferralCodeExhausted
+		}
+
+		referrerCredit := credit
+		if maxCredits := maxReferralCreditsPerUser(); maxCredits > 0 {
+			monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+			var earned int64
+			if err := tx.Model(&database.ReferralUse{}).
+				Where("referrer_id = ? AND credited_at >= ?", referral.UserID, monthStart).
+				Select("COALESCE(SUM(referrer_credit_cents), 0)").Scan(&earned).Error; err != nil {
+				return fmt.Errorf("sum referral credits: %w", err)
+			}
+			referrerCredit = min(credit, max(maxCredits-earned, 0))
+		}
+
+		if _, err := grantFreeCredits(tx, refereeOrg, credit, "referral", fmt.Sprintf("Referral signup credit (code %s)", code), now); err != nil {
+			return fmt.Errorf("credit referee: %w", err)
+		}
+		// A referrer without an organization is still recorded, with nothing credited
+		if referrerOrg == "" {
+			referrerCredit = 0
+		}
+		if referrerCredit > 0 {
+			if _, err := grantFreeCredits(tx, referrerOrg, referrerCredit, "referral", fmt.Sprintf("Referral credit for code %s", code), now); err != nil {
+				return fmt.Errorf("credit referrer: %w", err)
+			}
+		}
+
+		use = &database.ReferralUse{
+			ID:                  generateID("refuse"),
+			ReferrerID:          referral.UserID,
+			RefereeID:           refereeID,
+			Code:                code,
+			ReferrerCreditCents: referrerCredit,
+			RefereeCreditCents:  credit,
+			CreditedAt:          now,
+		}
+		if err := tx.Create(use).Error; err != nil {
+			return fmt.Errorf("record referral use: %w", err)
+		}
+		refereeOrgID = refereeOrg
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	log.Printf("[Referrals] User %s redeemed code %s: %d cents to org %s, %d cents to referrer %s",
+		refereeID, code, use.RefereeCreditCents, refereeOrgID, use.ReferrerCreditCents, use.ReferrerID)
+	return use, refereeOrgID, nil
+}
+
+// referralCreditOrganization returns the organization a user's referral credits go to, the first
+// organization they own (their personal organization), and when they joined it. The ID is empty
+// when the user owns no organization.
+func referralCreditOrganization(tx *gorm.DB, userID string) (string, time.Time, error) {
+	var member database.OrganizationMember
+	err := tx.Where("user_id = ? AND role = ? AND status = ?", userID, auth.SystemRoleIDOwner, "active").
+		Order("joined_at ASC").First(&member).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", time.Time{}, nil
+		}
+		return "", time.Time{}, fmt.Errorf("get organization of user %s: %w", userID, err)
+	}
+	return member.OrganizationID, member.JoinedAt, nil
+}
+
+// allowReferralCodeCreation counts a code creation against the user's 30-day limit
+// Redis keeps the count so the limit holds across replicas; without Redis creation is allowed.
+func (s *Service) allowReferralCodeCreation(ctx context.Context, userID string) (bool, error) {
+	if s.referralLimiter == nil {
+		return true, nil
+	}
+
+	key := fmt.Sprintf("billing:referral:create:%s", userID)
+	count, err := s.referralLimiter.Increment(ctx, key)
+	if err != nil {
+		return true, err
+	}
+	if count == 1 {
+		if err := s.referralLimiter.Expire(ctx, key, referralCodeCreateWindow); err != nil {
+			return true, err
+		}
+	}
+	return count <= referralCodeCreateLimit, nil
+}
+
+func generateReferralCode() (string, error) {
+	buf := make([]byte, referralCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i, b := range buf {
+		buf[i] = referralCodeAlphabet[int(b)%len(referralCodeAlphabet)]
+	}
+	return string(buf), nil
+}
+
+func referralCodeToProto(code *database.ReferralCode) *billingv1.ReferralCode {
+	return &billingv1.ReferralCode{
+		Code:      code.Code,
+		MaxUses:   int32(code.MaxUses),
+		UsesCount: int32(code.UsesCount),
+		CreatedAt: timestamppb.New(code.CreatedAt),
+	}
+}
@@ -0,0 +1,227 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// memoryRateLimiter is an in-memory referralRateLimiter
+type memoryRateLimiter map[string]int64
+
+func (m memoryRateLimiter) Increment(ctx context.Context, key string) (int64, error) {
+	m[key]++
+	return m[key], nil
+}
+
+func (m memoryRateLimiter) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return nil
+}
+
+func newReferralTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	t.Setenv("REFERRAL_SIGNUP_CREDIT", "500")
+	t.Setenv("MAX_REFERRAL_CREDITS_PER_USER", "")
+	return newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.CreditTransaction{},
+		&database.ReferralCode{},
+		&database.ReferralUse{},
+	)
+}
+
+// seedReferralUser creates userID's personal organization, joined at joinedAt
+func seedReferralUser(t *testing.T, db *gorm.DB, userID string, joinedAt time.Time) string {
+	t.Helper()
+	orgID := "org-" + userID
+	records := []any{
+		&database.Organization{ID: orgID, Name: "Personal", Slug: "personal-" + userID, Status: "active"},
+		&database.OrganizationMember{ID: "mem-" + userID, OrganizationID: orgID, UserID: userID, Role: auth.SystemRoleIDOwner, Status: "active", JoinedAt: joinedAt},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+	return orgID
+}
+
+func seedReferralCode(t *testing.T, db *gorm.DB, userID, code string, maxUses int) {
+	t.Helper()
+	if err := db.Create(&database.ReferralCode{ID: "ref-" + code, UserID: userID, Code: code, MaxUses: maxUses}).Error; err != nil {
+		t.Fatalf("seed referral code: %v", err)
+	}
+}
+
+func orgCredits(t *testing.T, db *gorm.DB, orgID string) int64 {
+	t.Helper()
+	var org database.Organization
+	if err := db.First(&org, "id = ?", orgID).Error; err != nil {
+		t.Fatalf("load organization %s: %v", orgID, err)
+	}
+	return org.Credits
+}
+
+func TestRedeemReferralCodeCreditsBothUsersOnce(t *testing.T) {
+	db := newReferralTestDB(t)
+	now := time.Now()
+	referrerOrg := seedReferralUser(t, db, "referrer", now.AddDate(0, -6, 0))
+	refereeOrg := seedReferralUser(t, db, "referee", now.Add(-time.Hour))
+	seedReferralCode(t, db, "referrer", "FRIENDS1", 0)
+
+	use, orgID, err := redeemReferralCode(context.Background(), "referee", "FRIENDS1", now)
+	if err != nil {
+		t.Fatalf("redeemReferralCode: %v", err)
+	}
+	if orgID != refereeOrg || use.RefereeCreditCents != 500 || use.ReferrerCreditCents != 500 {
+		t.Fatalf("redeemed into %s with %d/%d cents, want %s with 500/500", orgID, use.RefereeCreditCents, use.ReferrerCreditCents, refereeOrg)
+	}
+	if orgCredits(t, db, refereeOrg) != 500 || orgCredits(t, db, referrerOrg) != 500 {
+		t.Fatalf("credits = referee %d, referrer %d, want 500 each", orgCredits(t, db, refereeOrg), orgCredits(t, db, referrerOrg))
+	}
+
+	// A second redemption, with the same or another code, credits nothing
+	seedReferralCode(t, db, "referrer", "FRIENDS2", 0)
+	for _, code := range []string{"FRIENDS1", "FRIENDS2"} {
+		if _, _, err := redeemReferralCode(context.Background(), "referee", code, now); !errors.Is(err, errAlreadyReferred) {
+			t.Fatalf("second redemption with %s: err = %v, want errAlreadyReferred", code, err)
+		}
+	}
+	if orgCredits(t, db, refereeOrg) != 500 || orgCredits(t, db, referrerOrg) != 500 {
+		t.Fatal("repeated redemption changed credits")
+	}
+
+	var transactions []database.CreditTransaction
+	db.Where("type = ?", "referral").Find(&transactions)
+	if len(transactions) != 2 {
+		t.Fatalf("referral credit transactions = %d, want 2", len(transactions))
+	}
+	for _, transaction := range transactions {
+		if transaction.ExpiresAt == nil {
+			t.Fatalf("referral credits on %s do not expire", transaction.OrganizationID)
+		}
+	}
+}
+
+func TestRedeemReferralCodeRejectsIneligibleUsers(t *testing.T) {
+	db := newReferralTestDB(t)
+	now := time.Now()
+	seedReferralUser(t, db, "referrer", now.AddDate(0, -6, 0))
+	seedReferralUser(t, db, "old-user", now.AddDate(0, -1, 0))
+	seedReferralCode(t, db, "referrer", "FRIENDS1", 0)
+
+	if _, _, err := redeemReferralCode(context.Background(), "referrer", "FRIENDS1", now); !errors.Is(err, errSelfReferral) {
+		t.Fatalf("self referral: err = %v, want errSelfReferral", err)
+	}
+	if _, _, err := redeemReferralCode(context.Background(), "old-user", "FRIENDS1", now); !errors.Is(err, errNotNewUser) {
+		t.Fatalf("established user: err = %v, want errNotNewUser", err)
+	}
+	if _, _, err := redeemReferralCode(context.Background(), "old-user", "NOPE1234", now); !errors.Is(err, errReferralCodeNotFound) {
+		t.Fatalf("unknown code: err = %v, want errReferralCodeNotFound", err)
+	}
+}
+
+func TestRedeemReferralCodeEnforcesMaxUses(t *testing.T) {
+	db := newReferralTestDB(t)
+	now := time.Now()
+	seedReferralUser(t, db, "referrer", now.AddDate(0, -6, 0))
+	seedReferralCode(t, db, "referrer", "TWICE222", 2)
+
+	for i := 0; i < 3; i++ {
+		referee := fmt.Sprintf("referee-%d", i)
+		refereeOrg := seedReferralUser(t, db, referee, now)
+		_, _, err := redeemReferralCode(context.Background(), referee, "TWICE222", now)
+		if i < 2 && err != nil {
+			t.Fatalf("redemption %d: %v", i+1, err)
+		}
+		if i == 2 {
+			if !errors.Is(err, errReferralCodeExhausted) {
+				t.Fatalf("redemption beyond max uses: err = %v, want errReferralCodeExhausted", err)
+			}
+			if orgCredits(t, db, refereeOrg) != 0 {
+				t.Fatal("rejected redemption credited the referee")
+			}
+		}
+	}
+
+	var code database.ReferralCode
+	db.First(&code, "code = ?", "TWICE222")
+	if code.UsesCount != 2 {
+		t.Fatalf("uses count = %d, want 2", code.UsesCount)
+	}
+}
+
+func TestRedeemReferralCodeCapsReferrerCreditsPerMonth(t *testing.T) {
+	db := newReferralTestDB(t)
+	t.Setenv("MAX_REFERRAL_CREDITS_PER_USER", "1200")
+	now := time.Now()
+	referrerOrg := seedReferralUser(t, db, "referrer", now.AddDate(0, -6, 0))
+	seedReferralCode(t, db, "referrer", "CAPPED33", 0)
+
+	// Credits earned last month do not count towards this month's cap
+	lastMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).Add(-time.Hour)
+	if err := db.Create(&database.ReferralUse{ID: "use-old", ReferrerID: "referrer", RefereeID: "earlier", Code: "CAPPED33", ReferrerCreditCents: 1200, RefereeCreditCents: 500, CreditedAt: lastMonth}).Error; err != nil {
+		t.Fatalf("seed last month's referral: %v", err)
+	}
+
+	wantReferrerCredits := []int64{500, 500, 200, 0}
+	for i, want := range wantReferrerCredits {
+		referee := fmt.Sprintf("referee-%d", i)
+		refereeOrg := seedReferralUser(t, db, referee, now)
+		use, _, err := redeemReferralCode(context.Background(), referee, "CAPPED33", now)
+		if err != nil {
+			t.Fatalf("redemption %d: %v", i+1, err)
+		}
+		if use.ReferrerCreditCents != want {
+			t.Fatalf("redemption %d credited referrer %d cents, want %d", i+1, use.ReferrerCreditCents, want)
+		}
+		// The new user always gets the full credit
+		if orgCredits(t, db, refereeOrg) != 500 {
+			t.Fatalf("referee %d credits = %d, want 500", i+1, orgCredits(t, db, refereeOrg))
+		}
+	}
+	if got := orgCredits(t, db, referrerOrg); got != 1200 {
+		t.Fatalf("referrer credits = %d, want the 1200 cap", got)
+	}
+}
+
+func TestCreateReferralCodeRateLimited(t *testing.T) {
+	db := newReferralTestDB(t)
+	limiter := memoryRateLimiter{}
+	service := &Service{billingEnabled: true, referralLimiter: limiter}
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "referrer"})
+
+	for i := 0; i < referralCodeCreateLimit; i++ {
+		resp, err := service.CreateReferralCode(ctx, connect.NewRequest(&billingv1.CreateReferralCodeRequest{MaxUses: proto.Int32(5)}))
+		if err != nil {
+			t.Fatalf("create code %d: %v", i+1, err)
+		}
+		if code := resp.Msg.GetReferralCode(); len(code.GetCode()) != referralCodeLength || code.GetMaxUses() != 5 {
+			t.Fatalf("created code = %+v", code)
+		}
+	}
+	_, err := service.CreateReferralCode(ctx, connect.NewRequest(&billingv1.CreateReferralCodeRequest{}))
+	if connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Fatalf("fourth code: code = %v, want %v", connect.CodeOf(err), connect.CodeResourceExhausted)
+	}
+
+	var count int64
+	db.Model(&database.ReferralCode{}).Where("user_id = ?", "referrer").Count(&count)
+	if count != referralCodeCreateLimit {
+		t.Fatalf("stored codes = %d, want %d", count, referralCodeCreateLimit)
+	}
+	if _, err := service.GetReferralCode(ctx, connect.NewRequest(&billingv1.GetReferralCodeRequest{})); err != nil {
+		t.Fatalf("GetReferralCode: %v", err)
+	}
+}
@@ -26,17 +26,30 @@ import (
 
 type Service struct {
 	billingv1connect.UnimplementedBillingServiceHandler
-	stripeClient   *stripe.Client
-	consoleURL     string
-	billingEnabled bool
+	stripeClient    *stripe.Client
+	consoleURL      string
+	billingEnabled  bool
+	referralLimiter referralRateLimiter // nil when Redis is unavailable
+	forecastCache   spendForecastCache  // nil when Redis is unavailable
+	paymentMethods  paymentMethodClient // nil when Stripe is not configured
+	customers       customerClient      // nil when Stripe is not configured
 }
 
 func NewService(stripeClient *stripe.Client, consoleURL string, billingEnabled bool) billingv1connect.BillingServiceHandler {
-	return &Service{
+	svc := &Service{
 		stripeClient:   stripeClient,
 		consoleURL:     strings.TrimSuffix(strings.TrimSpace(consoleURL), "/"),
 		billingEnabled: billingEnabled,
 	}
+	if database.RedisClient != nil {
+		svc.referralLimiter = database.RedisClient
+		svc.forecastCache = database.RedisClient
+	}
+	if stripeClient != nil {
+		svc.paymentMethods = stripeClient
+		svc.customers = stripeClient
+	}
+	return svc
 }
 
 // checkBillingEnabled returns an error if billing is disabled
@@ -122,6 +135,31 @@ func (s *Service) CreateCheckoutSession(ctx context.Context, req *connect.Reques
 		sessionParams.CustomerID = *billingAccount.StripeCustomerID
 	}
 
+	// Charge in the organization's preferred currency; credits are always added in USD
+	currency, chargeCents := displayAmount(ctx, billingAccount.Currency, amountCents)
+	if currency.Code != baseCurrency {
+		sessionParams.Currency = strings.ToLower(currency.Code)
+		sessionParams.ChargeAmountCents = chargeCents
+	}
+
+	// Add tax on top of the credits when Stripe Tax is enabled
+	taxRecord, err := s.calculateTax(ctx, orgID, chargeCents, strings.ToLower(currency.Code))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("calculate tax: %w", err))
+	}
+	if taxRecord != nil {
+		sessionParams.TaxCalculationID = taxRecord.CalculationID
+		sessionParams.TaxAmountCents = taxRecord.TaxAmountCents
+	} else if taxEnabled() {
+		exemption, err := activeTaxExemption(ctx, orgID, time.Now())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("calculate tax: %w", err))
+		}
+		// No billing address to calculate tax for yet; Checkout collects one and taxes the purchase
+		// unless the organization is tax-exempt
+		sessionParams.AutomaticTax = exemption == nil
+	}
+
 	checkoutSession, err := s.stripeClient.CreateCheckoutSession(ctx, sessionParams)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create checkout session: %w", err))
@@ -258,6 +296,11 @@ func (s *Service) CreatePortalSession(ctx context.Context, req *connect.Request[
 		}
 	}
 
+	// Managed organizations are charged through their parent organization
+	if err := checkNotManagedOrganization(orgID); err != nil {
+		return nil, err
+	}
+
 	// Get billing account
 	var billingAccount database.BillingAccount
 	if err := database.DB.Where("organization_id = ?", orgID).First(&billingAccount).Error; err != nil {
@@ -391,6 +434,14 @@ func (s *Service) UpdateBillingAccount(ctx context.Context, req *connect.Request
 		addressStr := string(addressJSON)
 		billingAccount.Address = &addressStr
 	}
+	if req.Msg.VatNumber != nil {
+		vatNumber := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(req.Msg.GetVatNumber()), " ", ""))
+		if vatNumber == "" {
+			billingAccount.VATNumber = nil
+		} else {
+			billingAccount.VATNumber = &vatNumber
+		}
+	}
 	if req.Msg.BillingDate != nil {
 		billingDate := int(*req.Msg.BillingDate)
 		if billingDate < 1 || billingDate > 31 {
@@ -510,6 +561,11 @@ func (s *Service) CreateSetupIntent(ctx context.Context, req *connect.Request[bi
 		}
 	}
 
+	// Managed organizations are charged through their parent organization
+	if err := checkNotManagedOrganization(orgID); err != nil {
+		return nil, err
+	}
+
 	// Get or create billing account
 	billingAccount, err := s.getOrCreateBillingAccount(orgID)
 	if err != nil {
@@ -599,18 +655,18 @@ func (s *Service) ListPaymentMethods(ctx context.Context, req *connect.Request[b
 		}), nil
 	}
 
-	if err := s.checkStripeConfigured(); err != nil {
+	if err := s.checkPaymentMethodsConfigured(); err != nil {
 		return nil, err
 	}
 
 	// Get payment methods from Stripe
-	stripeMethods, err := s.stripeClient.ListPaymentMethods(ctx, *billingAccount.StripeCustomerID)
+	stripeMethods, err := s.paymentMethods.ListPaymentMethods(ctx, *billingAccount.StripeCustomerID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list payment methods: %w", err))
 	}
 
 	// Get customer to find default payment method
-	cust, err := s.stripeClient.GetCustomer(ctx, *billingAccount.StripeCustomerID)
+	cust, err := s.paymentMethods.GetCustomer(ctx, *billingAccount.StripeCustomerID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get customer: %w", err))
 	}
@@ -620,32 +676,15 @@ func (s *Service) ListPaymentMethods(ctx context.Context, req *connect.Request[b
 		defaultPaymentMethodID = cust.InvoiceSettings.DefaultPaymentMethod.ID
 	}
 
+	// Keep the stored cards in line with Stripe, where cards can also be added or removed
+	if err := syncPaymentMethods(ctx, orgID, *billingAccount.StripeCustomerID, stripeMethods, defaultPaymentMethodID); err != nil {
+		log.Printf("[ListPaymentMethods] Failed to store payment methods for org %s: %v", orgID, err)
+	}
+
 	// Convert to proto
 	protoMethods := make([]*billingv1.PaymentMethod, 0, len(stripeMethods))
 	for _, pm := range stripeMethods {
-		isDefault := pm.ID == defaultPaymentMethodID
-		protoPM := &billingv1.PaymentMethod{
-			Id:        pm.ID,
-			Type:      string(pm.Type),
-			IsDefault: isDefault,
-		}
-
-		if pm.Card != nil {
-			protoPM.Card = &billingv1.CardDetails{
-				Brand:    string(pm.Card.Brand),
-				Last4:    pm.Card.Last4,
-				ExpMonth: int32(pm.Card.ExpMonth),
-				ExpYear:  int32(pm.Card.ExpYear),
-			}
-			if pm.BillingDetails != nil && pm.BillingDetails.Name != "" {
-				protoPM.Card.Name = &pm.BillingDetails.Name
-			}
-		}
-		if pm.Created > 0 {
-			protoPM.CreatedAt = timestamppb.New(time.Unix(pm.Created, 0))
-		}
-
-		protoMethods = append(protoMethods, protoPM)
+		protoMethods = append(protoMethods, paymentMethodToProto(pm, pm.ID == defaultPaymentMethodID))
 	}
 
 	return connect.NewResponse(&billingv1.ListPaymentMethodsResponse{
@@ -688,6 +727,11 @@ func (s *Service) AttachPaymentMethod(ctx context.Context, req *connect.Request[
 		}
 	}
 
+	// Managed organizations are charged through their parent organization
+	if err := checkNotManagedOrganization(orgID); err != nil {
+		return nil, err
+	}
+
 	// Get billing account
 	var billingAccount database.BillingAccount
 	if err := database.DB.Where("organization_id = ?", orgID).First(&billingAccount).Error; err != nil {
@@ -701,39 +745,25 @@ func (s *Service) AttachPaymentMethod(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no Stripe customer found"))
 	}
 
-	if err := s.checkStripeConfigured(); err != nil {
+	if err := s.checkPaymentMethodsConfigured(); err != nil {
 		return nil, err
 	}
 
-	// Attach payment method
-	pm, err := s.stripeClient.AttachPaymentMethod(ctx, paymentMethodID, *billingAccount.StripeCustomerID)
+	// Attach payment method and make it the one invoices are charged to
+	pm, err := s.paymentMethods.AttachPaymentMethod(ctx, paymentMethodID, *billingAccount.StripeCustomerID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("attach payment method: %w", err))
 	}
-
-	// Convert to proto
-	protoPM := &billingv1.PaymentMethod{
-		Id:   pm.ID,
-		Type: string(pm.Type),
+	if err := s.paymentMethods.SetDefaultPaymentMethod(ctx, *billingAccount.StripeCustomerID, pm.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("set default payment method: %w", err))
 	}
 
-	if pm.Card != nil {
-		protoPM.Card = &billingv1.CardDetails{
-			Brand:    string(pm.Card.Brand),
-			Last4:    pm.Card.Last4,
-			ExpMonth: int32(pm.Card.ExpMonth),
-			ExpYear:  int32(pm.Card.ExpYear),
-		}
-		if pm.BillingDetails != nil && pm.BillingDetails.Name != "" {
-			protoPM.Card.Name = &pm.BillingDetails.Name
-		}
-	}
-	if pm.Created > 0 {
-		protoPM.CreatedAt = timestamppb.New(time.Unix(pm.Created, 0))
+	if err := storeDefaultPaymentMethod(ctx, orgID, *billingAccount.StripeCustomerID, pm); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("store payment method: %w", err))
 	}
 
 	return connect.NewResponse(&billingv1.AttachPaymentMethodResponse{
-		PaymentMethod: protoPM,
+		PaymentMethod: paymentMethodToProto(pm, true),
 	}), nil
 }
 
@@ -785,12 +815,12 @@ func (s *Service) DetachPaymentMethod(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no Stripe customer found"))
 	}
 
-	if err := s.checkStripeConfigured(); err != nil {
+	if err := s.checkPaymentMethodsConfigured(); err != nil {
 		return nil, err
 	}
 
 	// Verify payment method belongs to this customer
-	paymentMethods, err := s.stripeClient.ListPaymentMethods(ctx, *billingAccount.StripeCustomerID)
+	paymentMethods, err := s.paymentMethods.ListPaymentMethods(ctx, *billingAccount.StripeCustomerID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list payment methods: %w", err))
 	}
@@ -808,9 +838,12 @@ func (s *Service) DetachPaymentMethod(ctx context.Context, req *connect.Request[
 	}
 
 	// Detach payment method
-	if err := s.stripeClient.DetachPaymentMethod(ctx, paymentMethodID); err != nil {
+	if err := s.paymentMethods.DetachPaymentMethod(ctx, paymentMethodID); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("detach payment method: %w", err))
 	}
+	if err := database.DB.WithContext(ctx).Where("id = ? AND organization_id = ?", paymentMethodID, orgID).Delete(&database.PaymentMethod{}).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("delete stored payment method: %w", err))
+	}
 
 	return connect.NewResponse(&billingv1.DetachPaymentMethodResponse{
 		Success: true,
@@ -852,6 +885,11 @@ func (s *Service) SetDefaultPaymentMethod(ctx context.Context, req *connect.Requ
 		}
 	}
 
+	// Managed organizations are charged through their parent organization
+	if err := checkNotManagedOrganization(orgID); err != nil {
+		return nil, err
+	}
+
 	// Get billing account
 	var billingAccount database.BillingAccount
 	if err := database.DB.Where("organization_id = ?", orgID).First(&billingAccount).Error; err != nil {
@@ -865,12 +903,12 @@ func (s *Service) SetDefaultPaymentMethod(ctx context.Context, req *connect.Requ
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no Stripe customer found"))
 	}
 
-	if err := s.checkStripeConfigured(); err != nil {
+	if err := s.checkPaymentMethodsConfigured(); err != nil {
 		return nil, err
 	}
 
 	// Verify payment method belongs to this customer
-	paymentMethods, err := s.stripeClient.ListPaymentMethods(ctx, *billingAccount.StripeCustomerID)
+	paymentMethods, err := s.paymentMethods.ListPaymentMethods(ctx, *billingAccount.StripeCustomerID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list payment methods: %w", err))
 	}
@@ -888,9 +926,12 @@ func (s *Service) SetDefaultPaymentMethod(ctx context.Context, req *connect.Requ
 	}
 
 	// Set default payment method
-	if err := s.stripeClient.SetDefaultPaymentMethod(ctx, *billingAccount.StripeCustomerID, paymentMethodID); err != nil {
+	if err := s.paymentMethods.SetDefaultPaymentMethod(ctx, *billingAccount.StripeCustomerID, paymentMethodID); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("set default payment method: %w", err))
 	}
+	if err := setStoredDefaultPaymentMethod(ctx, orgID, paymentMethodID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 
 	return connect.NewResponse(&billingv1.SetDefaultPaymentMethodResponse{
 		Success: true,
@@ -1019,10 +1060,14 @@ func (s *Service) billingAccountToProto(ba *database.BillingAccount) *billingv1.
 		Id:             ba.ID,
 		OrganizationId: ba.OrganizationID,
 		Status:         ba.Status,
+		Currency:       ba.Currency,
 		CreatedAt:      timestamppb.New(ba.CreatedAt),
 		UpdatedAt:      timestamppb.New(ba.UpdatedAt),
 	}
 
+	if proto.Currency == "" {
+		proto.Currency = baseCurrency
+	}
 	if ba.StripeCustomerID != nil {
 		proto.StripeCustomerId = ba.StripeCustomerID
 	}
@@ -1035,6 +1080,9 @@ func (s *Service) billingAccountToProto(ba *database.BillingAccount) *billingv1.
 	if ba.TaxID != nil {
 		proto.TaxId = ba.TaxID
 	}
+	if ba.VATNumber != nil {
+		proto.VatNumber = ba.VATNumber
+	}
 	if ba.BillingDate != nil {
 		billingDate := int32(*ba.BillingDate)
 		proto.BillingDate = &billingDate
@@ -1394,6 +1442,11 @@ func (s *Service) UpdateSubscriptionPaymentMethod(ctx context.Context, req *conn
 		}
 	}
 
+	// Managed organizations are charged through their parent organization
+	if err := checkNotManagedOrganization(orgID); err != nil {
+		return nil, err
+	}
+
 	if err := s.checkStripeConfigured(); err != nil {
 		return nil, err
 	}
@@ -1665,6 +1718,11 @@ func (s *Service) GenerateCurrentBill(ctx context.Context, req *connect.Request[
 		return nil, err
 	}
 
+	// Managed organizations are charged through their parent organization
+	if err := checkNotManagedOrganization(orgID); err != nil {
+		return nil, err
+	}
+
 	// Generate the bill
 	bill, alreadyExists, err := GenerateCurrentBillEarly(orgID)
 	if err != nil {
@@ -1701,8 +1759,15 @@ func monthlyBillToProto(bill *database.MonthlyBill) *billingv1.MonthlyBill {
 		Note:               bill.Note,
 		CreatedAt:          timestamppb.New(bill.CreatedAt),
 		UpdatedAt:          timestamppb.New(bill.UpdatedAt),
+		DisplayCurrency:    bill.DisplayCurrency,
+		DisplayAmountCents: bill.DisplayAmountCents,
 	}
 
+	// Bills created before display currencies were stored are in USD
+	if bill.DisplayCurrency == "" || bill.DisplayCurrency == baseCurrency {
+		proto.DisplayCurrency = baseCurrency
+		proto.DisplayAmountCents = bill.AmountCents
+	}
 	if bill.PaidAt != nil {
 		proto.PaidAt = timestamppb.New(*bill.PaidAt)
 	}
@@ -12,12 +12,16 @@ import (
 	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
 	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
 	"google.golang.org/protobuf/proto"
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
 func TestBillingServiceTenantIsolation(t *testing.T) {
-	db := newBillingServiceTestDB(t)
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.BillingAccount{},
+		&database.MonthlyBill{},
+	)
 	service := &Service{billingEnabled: true}
 	seedBillingServiceIsolationData(t, db)
 
@@ -98,31 +102,6 @@ func TestBillingServiceTenantIsolation(t *testing.T) {
 	}
 }
 
-func newBillingServiceTestDB(t *testing.T) *gorm.DB {
-	t.Helper()
-
-	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	if err != nil {
-		t.Fatalf("open sqlite db: %v", err)
-	}
-	if err := db.AutoMigrate(
-		&database.Organization{},
-		&database.OrganizationMember{},
-		&database.BillingAccount{},
-		&database.MonthlyBill{},
-	); err != nil {
-		t.Fatalf("migrate sqlite db: %v", err)
-	}
-
-	previousDB := database.DB
-	database.DB = db
-	t.Cleanup(func() {
-		database.DB = previousDB
-	})
-
-	return db
-}
-
 func seedBillingServiceIsolationData(t *testing.T, db *gorm.DB) {
 	t.Helper()
 
@@ -0,0 +1,267 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxSpendAlertsPerOrganization limits how many spend alerts an organization can have
+const maxSpendAlertsPerOrganization = 20
+
+// CreateSpendAlert adds a threshold on an organization's monthly spend
+func (s *Service) CreateSpendAlert(ctx context.Context, req *connect.Request[billingv1.CreateSpendAlertRequest]) (*connect.Response[billingv1.CreateSpendAlertResponse], error) {
+	if err := s.checkBillingEnabled(); err != nil {
+		return nil, err
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.AuthorizeOrgAdmin(ctx, orgID, user); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GetThresholdCents() <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("threshold_cents must be positive"))
+	}
+	alertType := strings.TrimSpace(req.Msg.GetAlertType())
+	switch alertType {
+	case database.SpendAlertTypeEmail, database.SpendAlertTypeSuspendNew, database.SpendAlertTypeSuspendAll:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("alert_type must be %q, %q or %q",
+			database.SpendAlertTypeEmail, database.SpendAlertTypeSuspendNew, database.SpendAlertTypeSuspendAll))
+	}
+
+	var count int64
+	if err := database.DB.WithContext(ctx).Model(&database.SpendAlert{}).Where("organization_id = ?", orgID).Count(&count).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("count spend alerts: %w", err))
+	}
+	if count >= maxSpendAlertsPerOrganization {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("an organization can have at most %d spend alerts", maxSpendAlertsPerOrganization))
+	}
+
+	now := time.Now()
+	alert := &database.SpendAlert{
+		ID:             generateID("sa"),
+		OrganizationID: orgID,
+		ThresholdCents: req.Msg.GetThresholdCents(),
+		AlertType:      alertType,
+		CreatedBy:      user.Id,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := database.DB.WithContext(ctx).Create(alert).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create spend alert: %w", err))
+	}
+
+	log.Printf("[Spend Alerts] Organization %s added a %s alert at %s by %s", orgID, alertType, formatInvoiceAmount(alert.ThresholdCents), user.Id)
+	return connect.NewResponse(&billingv1.CreateSpendAlertResponse{Alert: spendAlertToProto(alert)}), nil
+}
+
+// DeleteSpendAlert removes one of an organization's spend alerts
+func (s *Service) DeleteSpendAlert(ctx context.Context, req *connect.Request[billingv1.DeleteSpendAlertRequest]) (*connect.Response[billingv1.DeleteSpendAlertResponse], error) {
+	if err := s.checkBillingEnabled(); err != nil {
+		return nil, err
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.AuthorizeOrgAdmin(ctx, orgID, user); err != nil {
+		return nil, err
+	}
+
+	result := database.DB.WithContext(ctx).
+		Where("id = ? AND organization_id = ?", req.Msg.GetAlertId(), orgID).
+		Delete(&database.SpendAlert{})
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("delete spend alert: %w", result.Error))
+	}
+	if result.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("spend alert not found"))
+	}
+
+	return connect.NewResponse(&billingv1.DeleteSpendAlertResponse{Success: true}), nil
+}
+
+// ListSpendAlerts lists an organization's spend alerts with its spend so far this month
+func (s *Service) ListSpendAlerts(ctx context.Context, req *connect.Request[billingv1.ListSpendAlertsRequest]) (*connect.Response[billingv1.ListSpendAlertsResponse], error) {
+	if err := s.checkBillingEnabled(); err != nil {
+		return nil, err
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.AuthorizeOrgAdmin(ctx, orgID, user); err != nil {
+		return nil, err
+	}
+
+	var alerts []database.SpendAlert
+	if err := database.DB.WithContext(ctx).Where("organization_id = ?", orgID).
+		Order("threshold_cents ASC").Find(&alerts).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list spend alerts: %w", err))
+	}
+	spend, err := monthSpendCents(ctx, orgID, time.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response := &billingv1.ListSpendAlertsResponse{
+		Alerts:          make([]*billingv1.SpendAlert, 0, len(alerts)),
+		MonthSpendCents: spend,
+	}
+	for i := range alerts {
+		response.Alerts = append(response.Alerts, spendAlertToProto(&alerts[i]))
+	}
+	return connect.NewResponse(response), nil
+}
+
+// monthStart returns the start of now's month in UTC, when spend alerts start counting again
+func monthStart(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// monthSpendCents returns the whole cents of metered usage the organization has run up since the
+// start of now's month, whether its credits paid for it or it is owed on the monthly bill
+func monthSpendCents(ctx context.Context, orgID string, now time.Time) (int64, error) {
+	var total float64
+	if err := database.DB.WithContext(ctx).Model(&database.MeteredUsageRecord{}).
+		Select("COALESCE(SUM(cost_cents), 0)").
+		Where("organization_id = ? AND hour >= ?", orgID, monthStart(now)).
+		Scan(&total).Error; err != nil {
+		return 0, fmt.Errorf("sum monthly spend: %w", err)
+	}
+	return int64(math.Floor(total)), nil
+}
+
+// resetSpendAlerts clears notified_at of alerts that fired in an earlier month, so they fire again this month
+func resetSpendAlerts(ctx context.Context, now time.Time) error {
+	result := database.DB.WithContext(ctx).Model(&database.SpendAlert{}).
+		Where("notified_at < ?", monthStart(now)).
+		Updates(map[string]interface{}{"notified_at": nil, "updated_at": now})
+	if result.Error != nil {
+		return fmt.Errorf("reset spend alerts: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		log.Printf("[Spend Alerts] Reset %d spend alerts for the new month", result.RowsAffected)
+	}
+	return nil
+}
+
+// checkSpendAlerts fires the organization's alerts whose thresholds its spend this month has reached.
+// Each alert fires at most once a month.
+func checkSpendAlerts(ctx context.Context, orgID string, now time.Time) error {
+	var alerts []database.SpendAlert
+	if err := database.DB.WithContext(ctx).
+		Where("organization_id = ? AND notified_at IS NULL", orgID).
+		Order("threshold_cents ASC").Find(&alerts).Error; err != nil {
+		return fmt.Errorf("get spend alerts: %w", err)
+	}
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	spend, err := monthSpendCents(ctx, orgID, now)
+	if err != nil {
+		return err
+	}
+
+	for i := range alerts {
+		alert := &alerts[i]
+		if spend < alert.ThresholdCents {
+			break
+		}
+
+		// Claim the alert so concurrent metering runs fire it once
+		claimed := database.DB.WithContext(ctx).Model(&database.SpendAlert{}).
+			Where("id = ? AND notified_at IS NULL", alert.ID).
+			Updates(map[string]interface{}{"notified_at": now, "updated_at": now})
+		if claimed.Error != nil {
+			return fmt.Errorf("mark spend alert %s notified: %w", alert.ID, claimed.Error)
+		}
+		if claimed.RowsAffected == 0 {
+			continue
+		}
+
+		if err := fireSpendAlert(ctx, alert, spend, now); err != nil {
+			log.Printf("[Spend Alerts] Failed to apply %s alert %s of organization %s: %v", alert.AlertType, alert.ID, orgID, err)
+			continue
+		}
+		log.Printf("[Spend Alerts] Organization %s reached its %s alert at %s (%s spent this month)",
+			orgID, alert.AlertType, formatInvoiceAmount(alert.ThresholdCents), formatInvoiceAmount(spend))
+	}
+	return nil
+}
+
+// fireSpendAlert applies the alert's action and emails the billing contact about it
+func fireSpendAlert(ctx context.Context, alert *database.SpendAlert, spend int64, now time.Time) error {
+	reached := fmt.Sprintf("Your organization has spent %s this month, reaching its spend alert of %s.",
+		formatInvoiceAmount(spend), formatInvoiceAmount(alert.ThresholdCents))
+
+	switch alert.AlertType {
+	case database.SpendAlertTypeSuspendNew:
+		if err := suspendNewResources(ctx, alert.OrganizationID); err != nil {
+			return err
+		}
+		sendBillingEmail(ctx, alert.OrganizationID, "Budget cap reached", reached,
+			"New resources cannot be created until the restriction is lifted. Running resources are not affected.")
+	case database.SpendAlertTypeSuspendAll:
+		reason := fmt.Sprintf("Monthly spend reached the budget cap of %s", formatInvoiceAmount(alert.ThresholdCents))
+		if err := suspendOrganizationForBilling(ctx, alert.OrganizationID, reason, now); err != nil {
+			return err
+		}
+		stopOrganizationWorkloads(ctx, alert.OrganizationID)
+		sendBillingEmail(ctx, alert.OrganizationID, "Budget cap reached", reached,
+			"Your organization has been suspended and its running resources were stopped.")
+	default:
+		sendBillingEmail(ctx, alert.OrganizationID, "Spend alert", reached)
+	}
+	return nil
+}
+
+func spendAlertToProto(alert *database.SpendAlert) *billingv1.SpendAlert {
+	proto := &billingv1.SpendAlert{
+		Id:             alert.ID,
+		OrganizationId: alert.OrganizationID,
+		ThresholdCents: alert.ThresholdCents,
+		AlertType:      alert.AlertType,
+		CreatedAt:      timestamppb.New(alert.CreatedAt),
+	}
+	if alert.NotifiedAt != nil {
+		proto.NotifiedAt = timestamppb.New(*alert.NotifiedAt)
+	}
+	return proto
+}
@@ -0,0 +1,152 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+)
+
+func TestSpendAlertsFireOncePerMonthPerThreshold(t *testing.T) {
+	db, sender, stopped := setupDunningTest(t)
+	if err := db.AutoMigrate(&database.MeteredUsageRecord{}, &database.SpendAlert{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	ctx := context.Background()
+
+	for _, alert := range []*database.SpendAlert{
+		{ID: "sa-email", OrganizationID: "org-a", ThresholdCents: 500, AlertType: database.SpendAlertTypeEmail},
+		{ID: "sa-suspend-new", OrganizationID: "org-a", ThresholdCents: 1000, AlertType: database.SpendAlertTypeSuspendNew},
+		{ID: "sa-suspend-all", OrganizationID: "org-a", ThresholdCents: 5000, AlertType: database.SpendAlertTypeSuspendAll},
+	} {
+		if err := db.Create(alert).Error; err != nil {
+			t.Fatalf("seed alert: %v", err)
+		}
+	}
+	resource := 0
+	addUsage := func(hour time.Time, costCents float64) {
+		t.Helper()
+		resource++
+		record := &database.MeteredUsageRecord{OrganizationID: "org-a", ResourceID: fmt.Sprintf("deploy-%d", resource), Hour: hour, ResourceType: "deployment", CostCents: costCents}
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed usage: %v", err)
+		}
+	}
+	check := func(now time.Time) {
+		t.Helper()
+		if err := resetSpendAlerts(ctx, now); err != nil {
+			t.Fatalf("resetSpendAlerts: %v", err)
+		}
+		if err := checkSpendAlerts(ctx, "org-a", now); err != nil {
+			t.Fatalf("checkSpendAlerts: %v", err)
+		}
+	}
+
+	march := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	// Usage from the previous month does not count
+	addUsage(time.Date(2026, time.February, 27, 0, 0, 0, 0, time.UTC), 900)
+	addUsage(march.Add(-time.Hour), 600.5)
+	check(march)
+	check(march.Add(time.Hour))
+	if len(sender.messages) != 1 || sender.messages[0].Subject != "Obiente Cloud: Spend alert" {
+		t.Fatalf("after reaching $5 sent %d emails, want one spend alert", len(sender.messages))
+	}
+	_, quota, _, _ := loadDunningFixtures(t, db)
+	if !quota.NewResourcesAllowed() {
+		t.Fatal("new resources suspended below the $10 cap")
+	}
+
+	addUsage(march, 450)
+	check(march.Add(2 * time.Hour))
+	_, quota, org, _ := loadDunningFixtures(t, db)
+	if quota.NewResourcesAllowed() || org.Status != database.OrganizationStatusActive {
+		t.Fatalf("after reaching $10 new resources allowed = %v, status = %q; want only new resources suspended", quota.NewResourcesAllowed(), org.Status)
+	}
+	if len(sender.messages) != 2 || sender.messages[1].Subject != "Obiente Cloud: Budget cap reached" {
+		t.Fatalf("after reaching $10 sent %d emails, want the budget cap email only", len(sender.messages))
+	}
+
+	// A new month re-arms the alerts, and they fire again once its spend reaches them
+	april := time.Date(2026, time.April, 1, 1, 0, 0, 0, time.UTC)
+	check(april)
+	var armed int64
+	db.Model(&database.SpendAlert{}).Where("notified_at IS NULL").Count(&armed)
+	if armed != 3 || len(sender.messages) != 2 {
+		t.Fatalf("at the start of April %d alerts armed and %d emails sent, want 3 and 2", armed, len(sender.messages))
+	}
+	addUsage(april, 700)
+	check(april.Add(time.Hour))
+	check(april.Add(2 * time.Hour))
+	if len(sender.messages) != 3 || sender.messages[2].Subject != "Obiente Cloud: Spend alert" {
+		t.Fatalf("after reaching $5 in April sent %d emails, want a third", len(sender.messages))
+	}
+
+	addUsage(april.Add(time.Hour), 4400)
+	check(april.Add(3 * time.Hour))
+	_, _, org, _ = loadDunningFixtures(t, db)
+	if org.Status != database.OrganizationStatusSuspended || len(*stopped) != 1 {
+		t.Fatalf("after reaching $50 status = %q and stopped = %v, want the organization suspended once", org.Status, *stopped)
+	}
+	if spend, err := monthSpendCents(ctx, "org-a", april); err != nil || spend != 5100 {
+		t.Fatalf("April spend = %d, %v; want 5100", spend, err)
+	}
+}
+
+func TestCreateSpendAlertValidatesRequest(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.MeteredUsageRecord{},
+		&database.SpendAlert{},
+		&database.SuperadminRoleBinding{},
+	)
+	for _, record := range []any{
+		&database.Organization{ID: "org-a", Name: "Acme", Slug: "acme", Status: database.OrganizationStatusActive},
+		&database.OrganizationMember{ID: "m-owner", OrganizationID: "org-a", UserID: "user-owner", Role: auth.SystemRoleIDOwner, Status: "active"},
+		&database.OrganizationMember{ID: "m-member", OrganizationID: "org-a", UserID: "user-member", Role: auth.SystemRoleIDMember, Status: "active"},
+	} {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+	service := &Service{billingEnabled: true}
+	ownerCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-owner"})
+	memberCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-member"})
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  *billingv1.CreateSpendAlertRequest
+		code connect.Code
+	}{
+		{"member", memberCtx, &billingv1.CreateSpendAlertRequest{OrganizationId: "org-a", ThresholdCents: 100, AlertType: "email"}, connect.CodePermissionDenied},
+		{"zero threshold", ownerCtx, &billingv1.CreateSpendAlertRequest{OrganizationId: "org-a", AlertType: "email"}, connect.CodeInvalidArgument},
+		{"unknown type", ownerCtx, &billingv1.CreateSpendAlertRequest{OrganizationId: "org-a", ThresholdCents: 100, AlertType: "sms"}, connect.CodeInvalidArgument},
+	}
+	for _, tt := range tests {
+		if _, err := service.CreateSpendAlert(tt.ctx, connect.NewRequest(tt.req)); connect.CodeOf(err) != tt.code {
+			t.Errorf("%s: code = %v, want %v (%v)", tt.name, connect.CodeOf(err), tt.code, err)
+		}
+	}
+
+	created, err := service.CreateSpendAlert(ownerCtx, connect.NewRequest(&billingv1.CreateSpendAlertRequest{OrganizationId: "org-a", ThresholdCents: 2500, AlertType: "suspend_all"}))
+	if err != nil {
+		t.Fatalf("CreateSpendAlert: %v", err)
+	}
+	listed, err := service.ListSpendAlerts(ownerCtx, connect.NewRequest(&billingv1.ListSpendAlertsRequest{OrganizationId: "org-a"}))
+	if err != nil || len(listed.Msg.GetAlerts()) != 1 || listed.Msg.GetAlerts()[0].GetId() != created.Msg.GetAlert().GetId() {
+		t.Fatalf("ListSpendAlerts = %v, %v", listed, err)
+	}
+	if _, err := service.DeleteSpendAlert(ownerCtx, connect.NewRequest(&billingv1.DeleteSpendAlertRequest{OrganizationId: "org-b", AlertId: created.Msg.GetAlert().GetId()})); err == nil {
+		t.Fatal("deleted another organization's spend alert")
+	}
+	if _, err := service.DeleteSpendAlert(ownerCtx, connect.NewRequest(&billingv1.DeleteSpendAlertRequest{OrganizationId: "org-a", AlertId: created.Msg.GetAlert().GetId()})); err != nil {
+		t.Fatalf("DeleteSpendAlert: %v", err)
+	}
+}
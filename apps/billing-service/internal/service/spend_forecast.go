@@ -0,0 +1,388 @@
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// spendForecastHistoryDays is how many days of spend a forecast is based on
+	spendForecastHistoryDays = 60
+	defaultSpendForecastDays = 30
+	maxSpendForecastDays     = 365
+	spendForecastCacheTTL    = 6 * time.Hour
+	// spendForecastZ is the z-score of the forecast's 95% confidence interval
+	spendForecastZ = 1.96
+
+	// spendAnomalyHistoryDays is how many days of spend today's spend is compared with
+	spendAnomalyHistoryDays = 30
+	// minSpendAnomalyHistoryDays is how much history an organization needs before its spend is checked
+	minSpendAnomalyHistoryDays = 7
+	spendAnomalyStdDevs        = 3
+	// minSpendAnomalyExcessCents keeps organizations with near-constant spend from being alerted
+	// about a few cents
+	minSpendAnomalyExcessCents = 100
+)
+
+// SpendForecast is an organization's projected metered spend over the coming days
+type SpendForecast struct {
+	OrganizationID   string    `json:"organization_id"`
+	PeriodDays       int32     `json:"period_days"`
+	ProjectedCents   int64     `json:"projected_cents"`
+	LowerCents       int64     `json:"lower_cents"`
+	UpperCents       int64     `json:"upper_cents"`
+	TrendCentsPerDay float64   `json:"trend_cents_per_day"`
+	HistoryDays      int32     `json:"history_days"`
+	GeneratedAt      time.Time `json:"generated_at"`
+}
+
+// spendForecastCache keeps forecasts between requests; *database.RedisCache implements it
+type spendForecastCache interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+}
+
+// GetSpendForecast projects an organization's metered spend over the coming days
+func (s *Service) GetSpendForecast(ctx context.Context, req *connect.Request[billingv1.GetSpendForecastRequest]) (*connect.Response[billingv1.GetSpendForecastResponse], error) {
+	if err := s.checkBillingEnabled(); err != nil {
+		return nil, err
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.AuthorizeOrgAdmin(ctx, orgID, user); err != nil {
+		return nil, err
+	}
+
+	periodDays := req.Msg.GetPeriodDays()
+	if periodDays == 0 {
+		periodDays = defaultSpendForecastDays
+	}
+	if periodDays < 0 || periodDays > maxSpendForecastDays {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("period_days must be between 1 and %d", maxSpendForecastDays))
+	}
+
+	forecast, err := s.cachedSpendForecast(ctx, orgID, periodDays)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&billingv1.GetSpendForecastResponse{Forecast: spendForecastToProto(forecast)}), nil
+}
+
+// cachedSpendForecast returns the organization's forecast from the last 6 hours, or makes a new one
+func (s *Service) cachedSpendForecast(ctx context.Context, orgID string, periodDays int32) (*SpendForecast, error) {
+	key := fmt.Sprintf("billing:spend_forecast:%s:%d", orgID, periodDays)
+	if s.forecastCache != nil {
+		if cached, err := s.forecastCache.Get(ctx, key); err == nil {
+			var forecast SpendForecast
+			if err := json.Unmarshal([]byte(cached), &forecast); err == nil {
+				return &forecast, nil
+			}
+		}
+	}
+
+	forecast, err := ForecastSpend(ctx, orgID, periodDays)
+	if err != nil {
+		return nil, err
+	}
+	if s.forecastCache != nil {
+		if err := s.forecastCache.Set(ctx, key, forecast, spendForecastCacheTTL); err != nil {
+			log.Printf("[Spend Forecast] Failed to cache forecast of organization %s: %v", orgID, err)
+		}
+	}
+	return forecast, nil
+}
+
+// ForecastSpend projects an organization's metered spend over the periodDays starting today (UTC)
+// from its daily spend over the last 60 days: the average day-of-week deviation from the 7-day moving
+// average plus a linear trend through the rest, with a 95% confidence interval from the fit's residuals
+func ForecastSpend(ctx context.Context, orgID string, periodDays int32) (*SpendForecast, error) {
+	now := time.Now().UTC()
+	today := dayStart(now)
+
+	daily, err := dailySpendCents(ctx, orgID, today.AddDate(0, 0, -spendForecastHistoryDays), today)
+	if err != nil {
+		return nil, err
+	}
+	first := firstSpendDay(daily)
+	history := daily[first:]
+	projection := projectSpend(history, today.AddDate(0, 0, -len(history)), int(periodDays))
+
+	return &SpendForecast{
+		OrganizationID:   orgID,
+		PeriodDays:       periodDays,
+		ProjectedCents:   int64(math.Round(projection.total)),
+		LowerCents:       int64(math.Round(projection.lower)),
+		UpperCents:       int64(math.Round(projection.upper)),
+		TrendCentsPerDay: projection.trend,
+		HistoryDays:      int32(len(history)),
+		GeneratedAt:      now,
+	}, nil
+}
+
+// spendProjection is the projected spend over a period in fractional cents
+type spendProjection struct {
+	total, lower, upper float64
+	trend               float64 // Slope of the linear trend in cents per day
+}
+
+// projectSpend extrapolates history, the daily spend of consecutive days starting at start, over the
+// periodDays that follow it
+func projectSpend(history []float64, start time.Time, periodDays int) spendProjection {
+	n := len(history)
+	if n == 0 || periodDays <= 0 {
+		return spendProjection{}
+	}
+
+	// Least-squares line through the spend with the weekday pattern taken out, so a week that ends
+	// on cheaper weekend days does not read as a downward trend
+	seasonal := weekdayDeviations(history, start)
+	weekday := func(t int) time.Weekday { return start.AddDate(0, 0, t).Weekday() }
+	var intercept, slope float64
+	if n == 1 {
+		intercept = history[0]
+	} else {
+		var meanT, meanY float64
+		for t, y := range history {
+			meanT += float64(t)
+			meanY += y - seasonal[weekday(t)]
+		}
+		meanT /= float64(n)
+		meanY /= float64(n)
+		var covariance, variance float64
+		for t, y := range history {
+			covariance += (float64(t) - meanT) * (y - seasonal[weekday(t)] - meanY)
+			variance += (float64(t) - meanT) * (float64(t) - meanT)
+		}
+		slope = covariance / variance
+		intercept = meanY - slope*meanT
+	}
+
+	// Spread of the history around the fitted trend and weekday pattern
+	var sumSquares float64
+	for t, y := range history {
+		residual := y - (intercept + slope*float64(t) + seasonal[weekday(t)])
+		sumSquares += residual * residual
+	}
+	var sigma float64
+	if n > 2 {
+		sigma = math.Sqrt(sumSquares / float64(n-2))
+	}
+
+	var total float64
+	for k := 0; k < periodDays; k++ {
+		t := n + k
+		total += math.Max(0, intercept+slope*float64(t)+seasonal[weekday(t)])
+	}
+	// Daily errors are taken as independent, so the period's error grows with the square root of its length
+	margin := spendForecastZ * sigma * math.Sqrt(float64(periodDays))
+
+	return spendProjection{
+		total: total,
+		lower: math.Max(0, total-margin),
+		upper: total + margin,
+		trend: slope,
+	}
+}
+
+// weekdayDeviations returns how far each weekday's spend is from the centered 7-day moving average on
+// average, shifted so the deviations of a week add up to zero. Histories shorter than two weeks have
+// too few of each weekday and get no adjustment.
+func weekdayDeviations(history []float64, start time.Time) [7]float64 {
+	var deviations [7]float64
+	if len(history) < 14 {
+		return deviations
+	}
+
+	var sums [7]float64
+	var counts [7]int
+	for t := 3; t < len(history)-3; t++ {
+		var window float64
+		for _, y := range history[t-3 : t+4] {
+			window += y
+		}
+		weekday := start.AddDate(0, 0, t).Weekday()
+		sums[weekday] += history[t] - window/7
+		counts[weekday]++
+	}
+
+	var mean float64
+	for weekday := range deviations {
+		if counts[weekday] > 0 {
+			deviations[weekday] = sums[weekday] / float64(counts[weekday])
+		}
+		mean += deviations[weekday]
+	}
+	mean /= 7
+	for weekday := range deviations {
+		deviations[weekday] -= mean
+	}
+	return deviations
+}
+
+// detectSpendAnomaly reports whether today's spend is more than 3 standard deviations above the mean
+// daily spend of history, and by at least $1
+func detectSpendAnomaly(history []float64, today float64) (anomaly bool, mean, stdDev float64) {
+	if len(history) < minSpendAnomalyHistoryDays {
+		return false, 0, 0
+	}
+	for _, y := range history {
+		mean += y
+	}
+	mean /= float64(len(history))
+	for _, y := range history {
+		stdDev += (y - mean) * (y - mean)
+	}
+	stdDev = math.Sqrt(stdDev / float64(len(history)))
+
+	anomaly = today > mean+spendAnomalyStdDevs*stdDev && today-mean >= minSpendAnomalyExcessCents
+	return anomaly, mean, stdDev
+}
+
+// checkSpendAnomaly alerts an organization's owners and admins, at most once a day, when its spend
+// so far today is unusually high compared with the last 30 days
+func checkSpendAnomaly(ctx context.Context, orgID string, now time.Time) error {
+	today := dayStart(now.UTC())
+	daily, err := dailySpendCents(ctx, orgID, today.AddDate(0, 0, -spendAnomalyHistoryDays), today.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+	history, todaySpend := daily[:len(daily)-1], daily[len(daily)-1]
+	history = history[firstSpendDay(history):]
+
+	anomaly, mean, stdDev := detectSpendAnomaly(history, todaySpend)
+	if !anomaly || !claimSpendAnomalyAlert(ctx, orgID, today) {
+		return nil
+	}
+
+	actionURL := "/billing"
+	actionLabel := "View Billing"
+	if err := notifyOrganization(
+		ctx,
+		orgID,
+		notificationsv1.NotificationType_NOTIFICATION_TYPE_BILLING,
+		notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+		"Unusual spend today",
+		fmt.Sprintf("Your organization has spent %s today, well above its daily average of %s over the last %d days. Check your running resources for unexpected usage.",
+			formatInvoiceAmount(int64(math.Floor(todaySpend))), formatInvoiceAmount(int64(math.Round(mean))), len(history)),
+		&actionURL, &actionLabel,
+		map[string]string{
+			"organization_id":   orgID,
+			"today_spend_cents": fmt.Sprintf("%.0f", todaySpend),
+			"mean_cents":        fmt.Sprintf("%.2f", mean),
+			"std_dev_cents":     fmt.Sprintf("%.2f", stdDev),
+		},
+		[]string{"owner", "admin"},
+	); err != nil {
+		return fmt.Errorf("notify unusual spend: %w", err)
+	}
+	log.Printf("[Spend Forecast] Organization %s spent %.0f cents today, above %.2f + %d x %.2f cents",
+		orgID, todaySpend, mean, spendAnomalyStdDevs, stdDev)
+	return nil
+}
+
+var (
+	spendAnomalyAlertMu sync.Mutex
+	spendAnomalyAlerted = make(map[string]string) // Organization ID to the day it was last alerted, without Redis
+)
+
+// claimSpendAnomalyAlert reports whether the organization has not been alerted about today's spend yet
+// and records that it is now. Redis shares the claim between billing instances.
+func claimSpendAnomalyAlert(ctx context.Context, orgID string, day time.Time) bool {
+	dayKey := day.Format("2006-01-02")
+	if database.RedisClient != nil {
+		claimed, err := database.RedisClient.SetNX(ctx, fmt.Sprintf("billing:spend_anomaly:%s:%s", orgID, dayKey), time.Now().Unix(), 48*time.Hour)
+		if err == nil {
+			return claimed
+		}
+		log.Printf("[Spend Forecast] Failed to claim the spend anomaly alert of organization %s, using local state: %v", orgID, err)
+	}
+
+	spendAnomalyAlertMu.Lock()
+	defer spendAnomalyAlertMu.Unlock()
+	if spendAnomalyAlerted[orgID] == dayKey {
+		return false
+	}
+	spendAnomalyAlerted[orgID] = dayKey
+	return true
+}
+
+// dailySpendCents returns the organization's metered spend for each UTC day from from up to to
+func dailySpendCents(ctx context.Context, orgID string, from, to time.Time) ([]float64, error) {
+	days := int(to.Sub(from).Hours() / 24)
+	if days <= 0 {
+		return nil, nil
+	}
+
+	var rows []struct {
+		Hour      time.Time
+		CostCents float64
+	}
+	if err := database.DB.WithContext(ctx).Model(&database.MeteredUsageRecord{}).
+		Select("hour, SUM(cost_cents) AS cost_cents").
+		Where("organization_id = ? AND hour >= ? AND hour < ?", orgID, from, to).
+		Group("hour").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("sum daily spend: %w", err)
+	}
+
+	daily := make([]float64, days)
+	for _, row := range rows {
+		day := int(row.Hour.UTC().Sub(from).Hours() / 24)
+		if day >= 0 && day < days {
+			daily[day] += row.CostCents
+		}
+	}
+	return daily, nil
+}
+
+// firstSpendDay returns the index of the first day with spend, so days before an organization used
+// anything do not count as days without spend
+func firstSpendDay(daily []float64) int {
+	for i, y := range daily {
+		if y > 0 {
+			return i
+		}
+	}
+	return len(daily)
+}
+
+func dayStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func spendForecastToProto(forecast *SpendForecast) *billingv1.SpendForecast {
+	return &billingv1.SpendForecast{
+		OrganizationId:   forecast.OrganizationID,
+		PeriodDays:       forecast.PeriodDays,
+		ProjectedCents:   forecast.ProjectedCents,
+		LowerCents:       forecast.LowerCents,
+		UpperCents:       forecast.UpperCents,
+		TrendCentsPerDay: forecast.TrendCentsPerDay,
+		HistoryDays:      forecast.HistoryDays,
+		GeneratedAt:      timestamppb.New(forecast.GeneratedAt),
+	}
+}
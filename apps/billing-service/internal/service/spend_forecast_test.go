@@ -0,0 +1,205 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+)
+
+func TestDetectSpendAnomaly(t *testing.T) {
+	// Mean 1000, standard deviation 100: anomalies start above 1300
+	steady := []float64{900, 1100, 900, 1100, 900, 1100, 900, 1100, 900, 1100}
+
+	tests := []struct {
+		name    string
+		history []float64
+		today   float64
+		want    bool
+	}{
+		{"typical day", steady, 1150, false},
+		{"at three standard deviations", steady, 1300, false},
+		{"above three standard deviations", steady, 1301, true},
+		{"spend spike", steady, 5000, true},
+		{"quiet day", steady, 0, false},
+		// Constant spend has no deviation, so any rise would count without the $1 floor
+		{"constant spend, small rise", []float64{500, 500, 500, 500, 500, 500, 500}, 550, false},
+		{"constant spend, large rise", []float64{500, 500, 500, 500, 500, 500, 500}, 650, true},
+		{"too little history", []float64{10, 10, 10, 10, 10, 10}, 10000, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, mean, stdDev := detectSpendAnomaly(tt.history, tt.today); got != tt.want {
+				t.Fatalf("detectSpendAnomaly(today %.0f) = %v (mean %.2f, std dev %.2f), want %v", tt.today, got, mean, stdDev, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckSpendAnomalyNotifiesOncePerDay(t *testing.T) {
+	db := newTestDB(t, &database.MeteredUsageRecord{})
+
+	type notification struct {
+		orgID    string
+		severity notificationsv1.NotificationSeverity
+	}
+	var notified []notification
+	previous := notifyOrganization
+	notifyOrganization = func(ctx context.Context, orgID string, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity, title, message string, actionURL, actionLabel *string, metadata map[string]string, roles []string) error {
+		notified = append(notified, notification{orgID: orgID, severity: severity})
+		return nil
+	}
+	t.Cleanup(func() { notifyOrganization = previous })
+
+	now := time.Date(2026, time.April, 20, 15, 0, 0, 0, time.UTC)
+	today := dayStart(now)
+	resource := 0
+	addUsage := func(orgID string, hour time.Time, costCents float64) {
+		t.Helper()
+		resource++
+		record := &database.MeteredUsageRecord{OrganizationID: orgID, ResourceID: fmt.Sprintf("deploy-%d", resource), Hour: hour, ResourceType: "deployment", CostCents: costCents}
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed usage: %v", err)
+		}
+	}
+	// Both organizations spend about $2 a day, spread over two hours, for the last 30 days
+	for day := 1; day <= 30; day++ {
+		for _, orgID := range []string{"org-spike", "org-steady"} {
+			addUsage(orgID, today.AddDate(0, 0, -day).Add(9*time.Hour), 100+float64(day%3))
+			addUsage(orgID, today.AddDate(0, 0, -day).Add(17*time.Hour), 100)
+		}
+	}
+	// Usage before the 30 day window does not count
+	addUsage("org-spike", today.AddDate(0, 0, -45), 100000)
+	addUsage("org-steady", today.Add(10*time.Hour), 150)
+	addUsage("org-spike", today.Add(10*time.Hour), 900)
+
+	for _, orgID := range []string{"org-spike", "org-steady"} {
+		if err := checkSpendAnomaly(context.Background(), orgID, now); err != nil {
+			t.Fatalf("checkSpendAnomaly(%s): %v", orgID, err)
+		}
+	}
+	if len(notified) != 1 || notified[0].orgID != "org-spike" || notified[0].severity != notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH {
+		t.Fatalf("notifications = %+v, want one HIGH notification for org-spike", notified)
+	}
+
+	// Later metering runs the same day do not repeat it
+	addUsage("org-spike", today.Add(11*time.Hour), 500)
+	if err := checkSpendAnomaly(context.Background(), "org-spike", now.Add(time.Hour)); err != nil {
+		t.Fatalf("checkSpendAnomaly: %v", err)
+	}
+	if len(notified) != 1 {
+		t.Fatalf("sent %d notifications after a second run the same day, want 1", len(notified))
+	}
+}
+
+func TestProjectSpend(t *testing.T) {
+	monday := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("constant spend", func(t *testing.T) {
+		history := make([]float64, 60)
+		for i := range history {
+			history[i] = 250
+		}
+		projection := projectSpend(history, monday, 30)
+		if math.Abs(projection.total-7500) > 1e-6 || math.Abs(projection.trend) > 1e-9 {
+			t.Fatalf("projection = %+v, want 7500 cents with no trend", projection)
+		}
+		if projection.lower != projection.total || projection.upper != projection.total {
+			t.Fatalf("interval [%.2f, %.2f] around a perfect fit, want no width", projection.lower, projection.upper)
+		}
+	})
+
+	t.Run("growing spend", func(t *testing.T) {
+		// 100 cents on the first day, 10 more every day after
+		history := make([]float64, 60)
+		for i := range history {
+			history[i] = 100 + 10*float64(i)
+		}
+		projection := projectSpend(history, monday, 10)
+		// Days 60 to 69: 10 * 100 + 10 * (60 + ... + 69)
+		if want := 1000 + 10*645.0; math.Abs(projection.total-want) > 1e-6 {
+			t.Fatalf("projected %.2f cents, want %.2f", projection.total, want)
+		}
+		if math.Abs(projection.trend-10) > 1e-9 {
+			t.Fatalf("trend %.4f cents per day, want 10", projection.trend)
+		}
+	})
+
+	t.Run("weekday pattern", func(t *testing.T) {
+		// 300 cents on weekdays, 50 on weekends
+		history := make([]float64, 56)
+		for i := range history {
+			history[i] = 300
+			if weekday := monday.AddDate(0, 0, i).Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+				history[i] = 50
+			}
+		}
+		// The history ends on a Sunday, so the forecast covers Monday to Sunday
+		projection := projectSpend(history, monday, 7)
+		if want := 5*300.0 + 2*50; math.Abs(projection.total-want) > 1 {
+			t.Fatalf("projected %.2f cents for a week, want about %.0f", projection.total, want)
+		}
+		nextDay := projectSpend(history, monday, 1)
+		if nextDay.total < 250 {
+			t.Fatalf("projected %.2f cents for a Monday, want weekday spend", nextDay.total)
+		}
+	})
+
+	t.Run("declining spend stays positive", func(t *testing.T) {
+		history := make([]float64, 30)
+		for i := range history {
+			history[i] = 300 - 10*float64(i)
+		}
+		projection := projectSpend(history, monday, 30)
+		if projection.total != 0 || projection.lower != 0 {
+			t.Fatalf("projection = %+v, want no spend once the trend reaches zero", projection)
+		}
+	})
+
+	t.Run("noisy spend has an interval", func(t *testing.T) {
+		history := make([]float64, 60)
+		for i := range history {
+			history[i] = 200 + float64((i*37)%41) - 20
+		}
+		projection := projectSpend(history, monday, 30)
+		if !(projection.lower < projection.total && projection.total < projection.upper) {
+			t.Fatalf("projection = %+v, want the total inside a non-empty interval", projection)
+		}
+	})
+
+	if projection := projectSpend(nil, monday, 30); projection != (spendProjection{}) {
+		t.Fatalf("projection without history = %+v, want zero", projection)
+	}
+}
+
+func TestDailySpendCents(t *testing.T) {
+	db := newTestDB(t, &database.MeteredUsageRecord{})
+
+	from := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)
+	records := []*database.MeteredUsageRecord{
+		{OrganizationID: "org-a", ResourceID: "deploy-1", Hour: from.Add(2 * time.Hour), ResourceType: "deployment", CostCents: 12.5},
+		{OrganizationID: "org-a", ResourceID: "deploy-2", Hour: from.Add(2 * time.Hour), ResourceType: "deployment", CostCents: 7.5},
+		{OrganizationID: "org-a", ResourceID: "vps-1", Hour: from.Add(23 * time.Hour), ResourceType: "vps", CostCents: 5},
+		{OrganizationID: "org-a", ResourceID: "vps-1", Hour: from.Add(50 * time.Hour), ResourceType: "vps", CostCents: 3},
+		{OrganizationID: "org-a", ResourceID: "vps-1", Hour: from.Add(72 * time.Hour), ResourceType: "vps", CostCents: 99}, // After the range
+		{OrganizationID: "org-b", ResourceID: "vps-2", Hour: from.Add(time.Hour), ResourceType: "vps", CostCents: 1000},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed usage: %v", err)
+		}
+	}
+
+	daily, err := dailySpendCents(context.Background(), "org-a", from, from.AddDate(0, 0, 3))
+	if err != nil {
+		t.Fatalf("dailySpendCents: %v", err)
+	}
+	if want := []float64{25, 0, 3}; len(daily) != len(want) || daily[0] != want[0] || daily[1] != want[1] || daily[2] != want[2] {
+		t.Fatalf("daily spend = %v, want %v", daily, want)
+	}
+}
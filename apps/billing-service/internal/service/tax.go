@@ -0,0 +1,219 @@
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/stripe"
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+
+	stripego "github.com/stripe/stripe-go/v83"
+)
+
+// euCountries are the EU member states where B2B purchases with a VAT number are reverse-charged
+var euCountries = map[string]bool{
+	"AT": true, "BE": true, "BG": true, "CY": true, "CZ": true, "DE": true, "DK": true,
+	"EE": true, "ES": true, "FI": true, "FR": true, "GR": true, "HR": true, "HU": true,
+	"IE": true, "IT": true, "LT": true, "LU": true, "LV": true, "MT": true, "NL": true,
+	"PL": true, "PT": true, "RO": true, "SE": true, "SI": true, "SK": true,
+}
+
+// taxEnabled reports whether purchases are taxed with Stripe Tax (STRIPE_TAX_ENABLED)
+// Stripe Tax has to be set up on the Stripe account (origin address and registrations) first
+func taxEnabled() bool {
+	v := os.Getenv("STRIPE_TAX_ENABLED")
+	return v == "true" || v == "1"
+}
+
+// CalculateTax returns the tax owed on a purchase of amount (in the currency's smallest unit)
+// The calculation is stored as a tax record so it can be archived as a tax transaction once paid
+func (s *Service) CalculateTax(ctx context.Context, orgID string, amount int64, currency string) (int64, error) {
+	record, err := s.calculateTax(ctx, orgID, amount, currency)
+	if err != nil || record == nil {
+		return 0, err
+	}
+	return record.TaxAmountCents, nil
+}
+
+// calculateTax runs a Stripe Tax calculation for a purchase and stores it
+// It returns nil when tax collection is disabled, the organization holds a verified tax-exempt
+// certificate or the billing account has no address yet; without an address checkout leaves the
+// calculation to Stripe (see CheckoutSessionParams.AutomaticTax)
+func (s *Service) calculateTax(ctx context.Context, orgID string, amount int64, currency string) (*database.TaxRecord, error) {
+	if !taxEnabled() {
+		return nil, nil
+	}
+
+	exemption, err := activeTaxExemption(ctx, orgID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if exemption != nil {
+		log.Printf("[Tax] Org %s is tax-exempt (%s certificate %s), skipping tax calculation", orgID, exemption.CertificateType, exemption.ID)
+		return nil, nil
+	}
+
+	billingAccount, err := s.getOrCreateBillingAccount(orgID)
+	if err != nil {
+		return nil, fmt.Errorf("get billing account: %w", err)
+	}
+	address := billingAddressParams(billingAccount)
+	if address == nil {
+		log.Printf("[Tax] No billing address for org %s, deferring tax calculation to checkout", orgID)
+		return nil, nil
+	}
+
+	if s.stripeClient == nil {
+		return nil, fmt.Errorf("stripe is not configured")
+	}
+
+	params := &stripe.TaxCalculationParams{
+		Address:     address,
+		AmountCents: amount,
+		Currency:    strings.ToLower(currency),
+		Reference:   "credits",
+	}
+	if billingAccount.VATNumber != nil {
+		params.VATNumber = *billingAccount.VATNumber
+	}
+	params.ReverseCharge = reverseChargeApplies(billingAccount)
+
+	calc, err := s.stripeClient.CalculateTax(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	record := &database.TaxRecord{
+		ID:             generateID("tax"),
+		OrganizationID: orgID,
+		CalculationID:  calc.ID,
+		AmountCents:    amount,
+		TaxAmountCents: calc.TaxAmountExclusive,
+		Currency:       params.Currency,
+		ReverseCharge:  params.ReverseCharge,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := database.DB.WithContext(ctx).Create(record).Error; err != nil {
+		return nil, fmt.Errorf("store tax calculation: %w", err)
+	}
+
+	log.Printf("[Tax] Calculated %d cents tax on %d cents for org %s (calculation %s, reverse charge: %t)",
+		record.TaxAmountCents, amount, orgID, calc.ID, record.ReverseCharge)
+	return record, nil
+}
+
+// RecordTaxTransaction archives a paid tax calculation as a Stripe Tax transaction
+// reference is the checkout session or invoice ID the tax was collected on; recording is idempotent
+func RecordTaxTransaction(ctx context.Context, calculationID, reference string) error {
+	var records []database.TaxRecord
+	if err := database.DB.WithContext(ctx).Where("tax_calculation_id = ?", calculationID).Limit(1).Find(&records).Error; err != nil {
+		return fmt.Errorf("get tax record: %w", err)
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("tax calculation %s not found", calculationID)
+	}
+	record := records[0]
+	if record.TransactionID != nil {
+		return nil // Already recorded
+	}
+
+	client, err := stripe.NewClient()
+	if err != nil {
+		return err
+	}
+	txn, err := client.CreateTaxTransaction(ctx, calculationID, reference)
+	if err != nil {
+		return err
+	}
+
+	if err := database.DB.WithContext(ctx).Model(&record).Updates(map[string]interface{}{
+		"tax_transaction_id": txn.ID,
+		"reference":          reference,
+		"updated_at":         time.Now(),
+	}).Error; err != nil {
+		return fmt.Errorf("store tax transaction: %w", err)
+	}
+
+	log.Printf("[Tax] Recorded tax transaction %s for calculation %s (%s)", txn.ID, calculationID, reference)
+	return nil
+}
+
+// checkoutCreditAmount returns the credits bought in a checkout session: the amount paid without tax
+func checkoutCreditAmount(session *stripego.CheckoutSession) (int64, error) {
+	// Purchases charged in another currency record the USD credits they buy
+	if raw := session.Metadata["credit_cents"]; raw != "" {
+		creditCents, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid credit_cents: %w", err)
+		}
+		return creditCents, nil
+	}
+	amount := session.AmountTotal
+	// Tax calculated before checkout is its own line item
+	if session.Metadata["tax_calculation_id"] != "" {
+		taxAmountCents, err := strconv.ParseInt(session.Metadata["tax_amount_cents"], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid tax_amount_cents: %w", err)
+		}
+		return amount - taxAmountCents, nil
+	}
+	// Tax calculated by Checkout itself (automatic tax)
+	if session.TotalDetails != nil {
+		amount -= session.TotalDetails.AmountTax
+	}
+	return amount, nil
+}
+
+// reverseChargeApplies reports whether VAT is reverse-charged: an EU business customer with a VAT number
+func reverseChargeApplies(account *database.BillingAccount) bool {
+	if account.VATNumber == nil || strings.TrimSpace(*account.VATNumber) == "" {
+		return false
+	}
+	address := billingAddress(account)
+	return address != nil && euCountries[strings.ToUpper(address.Country)]
+}
+
+func billingAddress(account *database.BillingAccount) *billingv1.Address {
+	if account.Address == nil || *account.Address == "" {
+		return nil
+	}
+	var address billingv1.Address
+	if err := json.Unmarshal([]byte(*account.Address), &address); err != nil {
+		return nil
+	}
+	return &address
+}
+
+// billingAddressParams converts the billing account's address for Stripe, or nil if it has none
+func billingAddressParams(account *database.BillingAccount) *stripego.AddressParams {
+	address := billingAddress(account)
+	if address == nil || address.Country == "" {
+		return nil
+	}
+	params := &stripego.AddressParams{Country: stripego.String(address.Country)}
+	if address.Line1 != "" {
+		params.Line1 = stripego.String(address.Line1)
+	}
+	if address.Line2 != nil && *address.Line2 != "" {
+		params.Line2 = stripego.String(*address.Line2)
+	}
+	if address.City != "" {
+		params.City = stripego.String(address.City)
+	}
+	if address.State != nil && *address.State != "" {
+		params.State = stripego.String(*address.State)
+	}
+	if address.PostalCode != "" {
+		params.PostalCode = stripego.String(address.PostalCode)
+	}
+	return params
+}
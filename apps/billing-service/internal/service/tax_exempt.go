@@ -0,0 +1,348 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+	"github.com/obiente/cloud/apps/shared/pkg/stripe"
+
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+
+	"connectrpc.com/connect"
+	stripego "github.com/stripe/stripe-go/v83"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// taxExemptExpiryWarning is how long before a verified certificate expires the billing contact is warned
+const taxExemptExpiryWarning = 60 * 24 * time.Hour
+
+// customerClient updates Stripe customers; *stripe.Client implements it
+type customerClient interface {
+	UpdateCustomer(ctx context.Context, customerID string, params *stripego.CustomerParams) (*stripego.Customer, error)
+}
+
+// newTaxExemptCustomerClient returns the Stripe client expired certificates are lifted with; tests replace it
+var newTaxExemptCustomerClient = func() (customerClient, error) {
+	client, err := stripe.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// stripeTaxExemptStatus returns the Stripe customer tax_exempt value for a certificate type.
+// Charities and public bodies are exempt outright; educational institutions are commonly
+// VAT-registered and self-assess, so their purchases are reverse-charged.
+func stripeTaxExemptStatus(certificateType string) stripego.CustomerTaxExempt {
+	if certificateType == database.TaxExemptTypeEducation {
+		return stripego.CustomerTaxExemptReverse
+	}
+	return stripego.CustomerTaxExemptExempt
+}
+
+// SubmitTaxExemptCertificate records a tax-exempt certificate for superadmins to verify
+func (s *Service) SubmitTaxExemptCertificate(ctx context.Context, req *connect.Request[billingv1.SubmitTaxExemptCertificateRequest]) (*connect.Response[billingv1.SubmitTaxExemptCertificateResponse], error) {
+	if err := s.checkBillingEnabled(); err != nil {
+		return nil, err
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.AuthorizeOrgAdmin(ctx, orgID, user); err != nil {
+		return nil, err
+	}
+	if err := checkNotManagedOrganization(orgID); err != nil {
+		return nil, err
+	}
+
+	certificateType := strings.ToLower(strings.TrimSpace(req.Msg.GetCertificateType()))
+	switch certificateType {
+	case database.TaxExemptTypeNonprofit, database.TaxExemptTypeEducation, database.TaxExemptTypeGovernment:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("certificate_type must be %q, %q or %q",
+			database.TaxExemptTypeNonprofit, database.TaxExemptTypeEducation, database.TaxExemptTypeGovernment))
+	}
+	number := strings.TrimSpace(req.Msg.GetCertificateNumber())
+	authority := strings.TrimSpace(req.Msg.GetIssuingAuthority())
+	if number == "" || authority == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("certificate_number and issuing_authority are required"))
+	}
+	if len(number) > 100 || len(authority) > 200 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("certificate_number must be at most 100 and issuing_authority at most 200 characters"))
+	}
+	if req.Msg.ValidFrom == nil || req.Msg.ValidUntil == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("valid_from and valid_until are required"))
+	}
+	now := time.Now()
+	validFrom, validUntil := req.Msg.GetValidFrom().AsTime(), req.Msg.GetValidUntil().AsTime()
+	if !validUntil.After(validFrom) || !validUntil.After(now) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("valid_until must be after valid_from and in the future"))
+	}
+
+	certificate := &database.TaxExemptCertificate{
+		ID:                generateID("tec"),
+		OrganizationID:    orgID,
+		CertificateType:   certificateType,
+		CertificateNumber: number,
+		IssuingAuthority:  authority,
+		ValidFrom:         validFrom,
+		ValidUntil:        validUntil,
+		Status:            database.TaxExemptStatusPending,
+		SubmittedBy:       user.Id,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	if err := database.DB.WithContext(ctx).Create(certificate).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create tax-exempt certificate: %w", err))
+	}
+
+	log.Printf("[Tax] Organization %s submitted %s tax-exempt certificate %s by %s", orgID, certificateType, certificate.ID, user.Id)
+	return connect.NewResponse(&billingv1.SubmitTaxExemptCertificateResponse{Certificate: taxExemptCertificateToProto(certificate)}), nil
+}
+
+// VerifyTaxExemptCertificate exempts the certificate's organization from tax (superadmin only)
+func (s *Service) VerifyTaxExemptCertificate(ctx context.Context, req *connect.Request[billingv1.VerifyTaxExemptCertificateRequest]) (*connect.Response[billingv1.VerifyTaxExemptCertificateResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.billing.update") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	certificate, err := loadTaxExemptCertificate(ctx, req.Msg.GetCertificateId())
+	if err != nil {
+		return nil, err
+	}
+	if certificate.Status != database.TaxExemptStatusPending {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("certificate is %s, only pending certificates can be verified", certificate.Status))
+	}
+	now := time.Now()
+	if !certificate.ValidUntil.After(now) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("certificate expired on %s", certificate.ValidUntil.Format("2006-01-02")))
+	}
+
+	// Update Stripe first so an organization is never shown as exempt while Stripe still taxes it
+	if err := updateCustomerTaxExempt(ctx, s.customers, certificate.OrganizationID, stripeTaxExemptStatus(certificate.CertificateType)); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	certificate.Status = database.TaxExemptStatusVerified
+	certificate.VerifiedBy = &user.Id
+	certificate.VerifiedAt = &now
+	certificate.UpdatedAt = now
+	if err := database.DB.WithContext(ctx).Save(certificate).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("verify tax-exempt certificate: %w", err))
+	}
+
+	log.Printf("[Tax] Tax-exempt certificate %s of organization %s verified by %s", certificate.ID, certificate.OrganizationID, user.Id)
+	return connect.NewResponse(&billingv1.VerifyTaxExemptCertificateResponse{Certificate: taxExemptCertificateToProto(certificate)}), nil
+}
+
+// RevokeTaxExemptCertificate withdraws a certificate so its organization is taxed again (superadmin only)
+func (s *Service) RevokeTaxExemptCertificate(ctx context.Context, req *connect.Request[billingv1.RevokeTaxExemptCertificateRequest]) (*connect.Response[billingv1.RevokeTaxExemptCertificateResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.billing.update") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	certificate, err := loadTaxExemptCertificate(ctx, req.Msg.GetCertificateId())
+	if err != nil {
+		return nil, err
+	}
+	if certificate.Status != database.TaxExemptStatusPending && certificate.Status != database.TaxExemptStatusVerified {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("certificate is already %s", certificate.Status))
+	}
+	wasVerified := certificate.Status == database.TaxExemptStatusVerified
+
+	now := time.Now()
+	certificate.Status = database.TaxExemptStatusRevoked
+	certificate.RevokedBy = &user.Id
+	certificate.RevokedAt = &now
+	certificate.UpdatedAt = now
+	if err := database.DB.WithContext(ctx).Save(certificate).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("revoke tax-exempt certificate: %w", err))
+	}
+
+	if wasVerified {
+		if err := liftTaxExemption(ctx, s.customers, certificate.OrganizationID, now); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	log.Printf("[Tax] Tax-exempt certificate %s of organization %s revoked by %s", certificate.ID, certificate.OrganizationID, user.Id)
+	return connect.NewResponse(&billingv1.RevokeTaxExemptCertificateResponse{Certificate: taxExemptCertificateToProto(certificate)}), nil
+}
+
+// ProcessTaxExemptCertificates warns billing contacts about certificates expiring within 60 days
+// and lifts the exemption of certificates that have expired
+func ProcessTaxExemptCertificates(ctx context.Context) error {
+	return processTaxExemptCertificates(ctx, time.Now())
+}
+
+func processTaxExemptCertificates(ctx context.Context, now time.Time) error {
+	var expiring []database.TaxExemptCertificate
+	if err := database.DB.WithContext(ctx).
+		Where("status = ? AND expiry_notified_at IS NULL AND valid_until > ? AND valid_until <= ?",
+			database.TaxExemptStatusVerified, now, now.Add(taxExemptExpiryWarning)).
+		Find(&expiring).Error; err != nil {
+		return fmt.Errorf("get expiring tax-exempt certificates: %w", err)
+	}
+	for i := range expiring {
+		certificate := &expiring[i]
+		sendBillingEmail(ctx, certificate.OrganizationID, "Tax exemption expiring",
+			fmt.Sprintf("Your tax-exempt certificate %s issued by %s expires on %s.",
+				certificate.CertificateNumber, certificate.IssuingAuthority, certificate.ValidUntil.Format("January 2, 2006")),
+			"Submit a renewed certificate before then to keep purchases tax-free.")
+		if err := database.DB.WithContext(ctx).Model(&database.TaxExemptCertificate{}).
+			Where("id = ?", certificate.ID).
+			Update("expiry_notified_at", now).Error; err != nil {
+			return fmt.Errorf("mark certificate %s notified: %w", certificate.ID, err)
+		}
+	}
+
+	var expired []database.TaxExemptCertificate
+	if err := database.DB.WithContext(ctx).
+		Where("status = ? AND valid_until <= ?", database.TaxExemptStatusVerified, now).
+		Find(&expired).Error; err != nil {
+		return fmt.Errorf("get expired tax-exempt certificates: %w", err)
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	client, err := newTaxExemptCustomerClient()
+	if err != nil {
+		// Organizations without a Stripe customer can still be expired
+		log.Printf("[Tax] Stripe is not available to lift expired tax exemptions: %v", err)
+		client = nil
+	}
+	expiredCount := 0
+	for i := range expired {
+		certificate := &expired[i]
+		// The certificate stays verified when Stripe cannot be updated so the next run retries it
+		if err := liftTaxExemption(ctx, client, certificate.OrganizationID, now); err != nil {
+			log.Printf("[Tax] Failed to lift expired tax exemption of organization %s: %v", certificate.OrganizationID, err)
+			continue
+		}
+		if err := database.DB.WithContext(ctx).Model(&database.TaxExemptCertificate{}).
+			Where("id = ?", certificate.ID).
+			Updates(map[string]interface{}{"status": database.TaxExemptStatusExpired, "updated_at": now}).Error; err != nil {
+			return fmt.Errorf("expire certificate %s: %w", certificate.ID, err)
+		}
+		expiredCount++
+		sendBillingEmail(ctx, certificate.OrganizationID, "Tax exemption expired",
+			fmt.Sprintf("Your tax-exempt certificate %s expired on %s, so purchases are taxed again.",
+				certificate.CertificateNumber, certificate.ValidUntil.Format("January 2, 2006")),
+			"Submit a renewed certificate to restore the exemption.")
+	}
+
+	if expiredCount > 0 {
+		log.Printf("[Tax] Expired %d tax-exempt certificate(s)", expiredCount)
+	}
+	return nil
+}
+
+// activeTaxExemption returns the organization's verified certificate that is valid at now, or nil
+func activeTaxExemption(ctx context.Context, orgID string, now time.Time) (*database.TaxExemptCertificate, error) {
+	var certificates []database.TaxExemptCertificate
+	if err := database.DB.WithContext(ctx).
+		Where("organization_id = ? AND status = ? AND valid_from <= ? AND valid_until > ?", orgID, database.TaxExemptStatusVerified, now, now).
+		Order("valid_until DESC").
+		Limit(1).
+		Find(&certificates).Error; err != nil {
+		return nil, fmt.Errorf("get tax-exempt certificate: %w", err)
+	}
+	if len(certificates) == 0 {
+		return nil, nil
+	}
+	return &certificates[0], nil
+}
+
+// liftTaxExemption makes the organization's Stripe customer taxable again unless another
+// verified certificate still exempts it
+func liftTaxExemption(ctx context.Context, client customerClient, orgID string, now time.Time) error {
+	remaining, err := activeTaxExemption(ctx, orgID, now)
+	if err != nil {
+		return err
+	}
+	status := stripego.CustomerTaxExemptNone
+	if remaining != nil {
+		status = stripeTaxExemptStatus(remaining.CertificateType)
+	}
+	return updateCustomerTaxExempt(ctx, client, orgID, status)
+}
+
+// updateCustomerTaxExempt sets tax_exempt on the organization's Stripe customer.
+// Organizations without a Stripe customer yet have nothing to update; CalculateTax checks certificates itself.
+func updateCustomerTaxExempt(ctx context.Context, client customerClient, orgID string, status stripego.CustomerTaxExempt) error {
+	account, err := common.GetBillingAccount(orgID)
+	if err != nil {
+		return fmt.Errorf("get billing account: %w", err)
+	}
+	if account == nil || account.StripeCustomerID == nil || *account.StripeCustomerID == "" {
+		return nil
+	}
+	if client == nil {
+		return fmt.Errorf("stripe is not configured")
+	}
+	params := &stripego.CustomerParams{TaxExempt: stripego.String(string(status))}
+	if _, err := client.UpdateCustomer(ctx, *account.StripeCustomerID, params); err != nil {
+		return fmt.Errorf("update Stripe customer tax exemption: %w", err)
+	}
+	return nil
+}
+
+func loadTaxExemptCertificate(ctx context.Context, id string) (*database.TaxExemptCertificate, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("certificate_id is required"))
+	}
+	var certificate database.TaxExemptCertificate
+	if err := database.DB.WithContext(ctx).Where("id = ?", id).First(&certificate).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tax-exempt certificate %s not found", id))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get tax-exempt certificate: %w", err))
+	}
+	return &certificate, nil
+}
+
+func taxExemptCertificateToProto(certificate *database.TaxExemptCertificate) *billingv1.TaxExemptCertificate {
+	protoCertificate := &billingv1.TaxExemptCertificate{
+		Id:                certificate.ID,
+		OrganizationId:    certificate.OrganizationID,
+		CertificateType:   certificate.CertificateType,
+		CertificateNumber: certificate.CertificateNumber,
+		IssuingAuthority:  certificate.IssuingAuthority,
+		ValidFrom:         timestamppb.New(certificate.ValidFrom),
+		ValidUntil:        timestamppb.New(certificate.ValidUntil),
+		Status:            certificate.Status,
+		VerifiedBy:        certificate.VerifiedBy,
+		CreatedAt:         timestamppb.New(certificate.CreatedAt),
+	}
+	if certificate.VerifiedAt != nil {
+		protoCertificate.VerifiedAt = timestamppb.New(*certificate.VerifiedAt)
+	}
+	if certificate.RevokedAt != nil {
+		protoCertificate.RevokedAt = timestamppb.New(*certificate.RevokedAt)
+	}
+	return protoCertificate
+}
@@ -0,0 +1,184 @@
+package billing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+	stripego "github.com/stripe/stripe-go/v83"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// fakeCustomerClient records the tax_exempt updates sent to Stripe customers
+type fakeCustomerClient struct {
+	updates []string // "customerID=tax_exempt"
+}
+
+func (f *fakeCustomerClient) UpdateCustomer(ctx context.Context, customerID string, params *stripego.CustomerParams) (*stripego.Customer, error) {
+	f.updates = append(f.updates, customerID+"="+stripego.StringValue(params.TaxExempt))
+	return &stripego.Customer{ID: customerID}, nil
+}
+
+func setupTaxExemptTest(t *testing.T) (*gorm.DB, *recordingSender, *fakeCustomerClient) {
+	t.Helper()
+	db, sender, _ := setupDunningTest(t)
+	if err := db.AutoMigrate(&database.OrganizationMember{}, &database.TaxExemptCertificate{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	customerID := "cus_123"
+	address := `{"line1":"1 Main St","city":"Berlin","postal_code":"10115","country":"DE"}`
+	if err := db.Model(&database.BillingAccount{}).Where("organization_id = ?", "org-a").
+		Updates(map[string]interface{}{"stripe_customer_id": customerID, "address": address, "status": "ACTIVE"}).Error; err != nil {
+		t.Fatalf("seed billing account: %v", err)
+	}
+	member := &database.OrganizationMember{ID: "m-owner", OrganizationID: "org-a", UserID: "user-owner", Role: auth.SystemRoleIDOwner, Status: "active"}
+	if err := db.Create(member).Error; err != nil {
+		t.Fatalf("seed member: %v", err)
+	}
+
+	customers := &fakeCustomerClient{}
+	previous := newTaxExemptCustomerClient
+	newTaxExemptCustomerClient = func() (customerClient, error) { return customers, nil }
+	t.Cleanup(func() { newTaxExemptCustomerClient = previous })
+	return db, sender, customers
+}
+
+func TestTaxExemptCertificateUpdatesStripeCustomer(t *testing.T) {
+	db, _, customers := setupTaxExemptTest(t)
+	t.Setenv("STRIPE_TAX_ENABLED", "true")
+	service := &Service{billingEnabled: true, customers: customers}
+	owner := auth.WithUser(context.Background(), &authv1.User{Id: "user-owner"})
+	superadmin := auth.WithUser(context.Background(), &authv1.User{Id: "admin", Roles: []string{auth.RoleSuperAdmin}})
+
+	submitted, err := service.SubmitTaxExemptCertificate(owner, connect.NewRequest(&billingv1.SubmitTaxExemptCertificateRequest{
+		OrganizationId:    "org-a",
+		CertificateType:   "nonprofit",
+		CertificateNumber: "VR 12345",
+		IssuingAuthority:  "Finanzamt Berlin",
+		ValidFrom:         timestamppb.New(time.Now().AddDate(-1, 0, 0)),
+		ValidUntil:        timestamppb.New(time.Now().AddDate(1, 0, 0)),
+	}))
+	if err != nil {
+		t.Fatalf("SubmitTaxExemptCertificate: %v", err)
+	}
+	certificateID := submitted.Msg.GetCertificate().GetId()
+	if submitted.Msg.GetCertificate().GetStatus() != database.TaxExemptStatusPending || len(customers.updates) != 0 {
+		t.Fatalf("submitted certificate = %+v, Stripe updates = %v; want pending and no update", submitted.Msg.GetCertificate(), customers.updates)
+	}
+
+	if _, err := service.VerifyTaxExemptCertificate(owner, connect.NewRequest(&billingv1.VerifyTaxExemptCertificateRequest{CertificateId: certificateID})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("VerifyTaxExemptCertificate as organization owner code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+	}
+	verified, err := service.VerifyTaxExemptCertificate(superadmin, connect.NewRequest(&billingv1.VerifyTaxExemptCertificateRequest{CertificateId: certificateID}))
+	if err != nil {
+		t.Fatalf("VerifyTaxExemptCertificate: %v", err)
+	}
+	if verified.Msg.GetCertificate().GetStatus() != database.TaxExemptStatusVerified || verified.Msg.GetCertificate().GetVerifiedBy() != "admin" {
+		t.Fatalf("verified certificate = %+v", verified.Msg.GetCertificate())
+	}
+	if len(customers.updates) != 1 || customers.updates[0] != "cus_123=exempt" {
+		t.Fatalf("Stripe updates = %v, want the customer marked exempt", customers.updates)
+	}
+
+	// A Stripe Tax calculation would fail without a Stripe client, so no error means it was skipped
+	tax, err := service.CalculateTax(context.Background(), "org-a", 1000, "usd")
+	if err != nil || tax != 0 {
+		t.Fatalf("CalculateTax = %d, %v; want 0, nil for an exempt organization", tax, err)
+	}
+
+	if _, err := service.RevokeTaxExemptCertificate(superadmin, connect.NewRequest(&billingv1.RevokeTaxExemptCertificateRequest{CertificateId: certificateID})); err != nil {
+		t.Fatalf("RevokeTaxExemptCertificate: %v", err)
+	}
+	if len(customers.updates) != 2 || customers.updates[1] != "cus_123=none" {
+		t.Fatalf("Stripe updates = %v, want the exemption lifted", customers.updates)
+	}
+	if _, err := service.CalculateTax(context.Background(), "org-a", 1000, "usd"); err == nil {
+		t.Fatalf("CalculateTax after revocation did not try to calculate tax")
+	}
+	var stored database.TaxExemptCertificate
+	db.Where("id = ?", certificateID).First(&stored)
+	if stored.Status != database.TaxExemptStatusRevoked || stored.RevokedBy == nil || *stored.RevokedBy != "admin" {
+		t.Fatalf("stored certificate = %+v, want revoked by admin", stored)
+	}
+}
+
+func TestVerifyEducationCertificateReverseCharges(t *testing.T) {
+	db, _, customers := setupTaxExemptTest(t)
+	service := &Service{billingEnabled: true, customers: customers}
+	superadmin := auth.WithUser(context.Background(), &authv1.User{Id: "admin", Roles: []string{auth.RoleSuperAdmin}})
+
+	certificate := &database.TaxExemptCertificate{
+		ID: "tec-edu", OrganizationID: "org-a", CertificateType: database.TaxExemptTypeEducation,
+		CertificateNumber: "EDU-1", IssuingAuthority: "Ministry of Education",
+		ValidFrom: time.Now().AddDate(0, -1, 0), ValidUntil: time.Now().AddDate(0, 6, 0), Status: database.TaxExemptStatusPending,
+	}
+	if err := db.Create(certificate).Error; err != nil {
+		t.Fatalf("seed certificate: %v", err)
+	}
+	if _, err := service.VerifyTaxExemptCertificate(superadmin, connect.NewRequest(&billingv1.VerifyTaxExemptCertificateRequest{CertificateId: "tec-edu"})); err != nil {
+		t.Fatalf("VerifyTaxExemptCertificate: %v", err)
+	}
+	if len(customers.updates) != 1 || customers.updates[0] != "cus_123=reverse" {
+		t.Fatalf("Stripe updates = %v, want the customer reverse-charged", customers.updates)
+	}
+	if _, err := service.VerifyTaxExemptCertificate(superadmin, connect.NewRequest(&billingv1.VerifyTaxExemptCertificateRequest{CertificateId: "tec-edu"})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("verifying twice code = %v, want %v", connect.CodeOf(err), connect.CodeFailedPrecondition)
+	}
+}
+
+func TestProcessTaxExemptCertificatesWarnsAndExpires(t *testing.T) {
+	db, sender, customers := setupTaxExemptTest(t)
+	ctx := context.Background()
+	now := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	verifiedAt := now.AddDate(-1, 0, 0)
+
+	certificate := &database.TaxExemptCertificate{
+		ID: "tec-1", OrganizationID: "org-a", CertificateType: database.TaxExemptTypeNonprofit,
+		CertificateNumber: "VR 12345", IssuingAuthority: "Finanzamt Berlin",
+		ValidFrom: now.AddDate(-1, 0, 0), ValidUntil: now.AddDate(0, 0, 90),
+		Status: database.TaxExemptStatusVerified, VerifiedAt: &verifiedAt,
+	}
+	if err := db.Create(certificate).Error; err != nil {
+		t.Fatalf("seed certificate: %v", err)
+	}
+
+	// 90 days before expiry: no warning yet
+	if err := processTaxExemptCertificates(ctx, now); err != nil {
+		t.Fatalf("processTaxExemptCertificates: %v", err)
+	}
+	if len(sender.messages) != 0 {
+		t.Fatalf("sent %d emails 90 days before expiry, want none", len(sender.messages))
+	}
+
+	// 59 days before expiry: warned once
+	for _, at := range []time.Time{now.AddDate(0, 0, 31), now.AddDate(0, 0, 32)} {
+		if err := processTaxExemptCertificates(ctx, at); err != nil {
+			t.Fatalf("processTaxExemptCertificates: %v", err)
+		}
+	}
+	if len(sender.messages) != 1 || sender.messages[0].Subject != "Obiente Cloud: Tax exemption expiring" {
+		t.Fatalf("sent %d emails within 60 days of expiry, want one warning", len(sender.messages))
+	}
+
+	// After expiry the Stripe exemption is lifted
+	if err := processTaxExemptCertificates(ctx, now.AddDate(0, 0, 91)); err != nil {
+		t.Fatalf("processTaxExemptCertificates: %v", err)
+	}
+	var stored database.TaxExemptCertificate
+	db.Where("id = ?", "tec-1").First(&stored)
+	if stored.Status != database.TaxExemptStatusExpired {
+		t.Fatalf("status after expiry = %q, want %q", stored.Status, database.TaxExemptStatusExpired)
+	}
+	if len(customers.updates) != 1 || customers.updates[0] != "cus_123=none" {
+		t.Fatalf("Stripe updates = %v, want the exemption lifted", customers.updates)
+	}
+	if len(sender.messages) != 2 || sender.messages[1].Subject != "Obiente Cloud: Tax exemption expired" {
+		t.Fatalf("sent %d emails, want an expiry notice", len(sender.messages))
+	}
+}
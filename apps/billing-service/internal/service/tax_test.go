@@ -0,0 +1,120 @@
+package billing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+
+	stripego "github.com/stripe/stripe-go/v83"
+)
+
+func TestReverseChargeApplies(t *testing.T) {
+	address := func(country string) *string {
+		s := `{"line1":"1 Main St","city":"Town","postal_code":"12345","country":"` + country + `"}`
+		return &s
+	}
+	vat := func(v string) *string { return &v }
+
+	cases := []struct {
+		name    string
+		account database.BillingAccount
+		want    bool
+	}{
+		{name: "EU business", account: database.BillingAccount{VATNumber: vat("DE123456789"), Address: address("DE")}, want: true},
+		{name: "lowercase country", account: database.BillingAccount{VATNumber: vat("FR12345678901"), Address: address("fr")}, want: true},
+		{name: "EU consumer", account: database.BillingAccount{Address: address("DE")}, want: false},
+		{name: "blank VAT number", account: database.BillingAccount{VATNumber: vat("  "), Address: address("DE")}, want: false},
+		{name: "outside EU", account: database.BillingAccount{VATNumber: vat("GB123456789"), Address: address("GB")}, want: false},
+		{name: "no address", account: database.BillingAccount{VATNumber: vat("DE123456789")}, want: false},
+	}
+	for _, tc := range cases {
+		if got := reverseChargeApplies(&tc.account); got != tc.want {
+			t.Errorf("%s: reverseChargeApplies = %t, want %t", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestCalculateTaxDisabled(t *testing.T) {
+	t.Setenv("STRIPE_TAX_ENABLED", "false")
+
+	s := &Service{}
+	tax, err := s.CalculateTax(context.Background(), "org-a", 1000, "usd")
+	if err != nil || tax != 0 {
+		t.Fatalf("CalculateTax = %d, %v; want 0, nil when tax is disabled", tax, err)
+	}
+}
+
+func TestCalculateTaxDefersWithoutBillingAddress(t *testing.T) {
+	t.Setenv("STRIPE_TAX_ENABLED", "true")
+	db := newTestDB(t, &database.Organization{}, &database.BillingAccount{})
+
+	customerID := "cus_123"
+	records := []any{
+		&database.Organization{ID: "org-a", Name: "Org A", Slug: "org-a", Status: "active", CreatedAt: time.Now()},
+		&database.BillingAccount{ID: "ba-a", OrganizationID: "org-a", Status: "ACTIVE", StripeCustomerID: &customerID},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	// No Stripe client: a deferred calculation must not reach Stripe
+	s := &Service{}
+	record, err := s.calculateTax(context.Background(), "org-a", 1000, "usd")
+	if err != nil || record != nil {
+		t.Fatalf("calculateTax = %+v, %v; want nil, nil without a billing address", record, err)
+	}
+}
+
+func TestCheckoutCreditAmount(t *testing.T) {
+	cases := []struct {
+		name    string
+		session stripego.CheckoutSession
+		want    int64
+		wantErr bool
+	}{
+		{
+			name:    "untaxed",
+			session: stripego.CheckoutSession{AmountTotal: 1000},
+			want:    1000,
+		},
+		{
+			name: "calculated before checkout",
+			session: stripego.CheckoutSession{
+				AmountTotal: 1190,
+				Metadata:    map[string]string{"tax_calculation_id": "taxcalc_1", "tax_amount_cents": "190"},
+			},
+			want: 1000,
+		},
+		{
+			name: "automatic tax",
+			session: stripego.CheckoutSession{
+				AmountTotal:  1200,
+				Metadata:     map[string]string{"automatic_tax": "true"},
+				TotalDetails: &stripego.CheckoutSessionTotalDetails{AmountTax: 200},
+			},
+			want: 1000,
+		},
+		{
+			name: "bad tax metadata",
+			session: stripego.CheckoutSession{
+				AmountTotal: 1190,
+				Metadata:    map[string]string{"tax_calculation_id": "taxcalc_1", "tax_amount_cents": "x"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		got, err := checkoutCreditAmount(&tc.session)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: checkoutCreditAmount error = %v, wantErr %t", tc.name, err, tc.wantErr)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%s: checkoutCreditAmount = %d, want %d", tc.name, got, tc.want)
+		}
+	}
+}
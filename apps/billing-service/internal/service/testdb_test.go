@@ -0,0 +1,46 @@
+package billing
+
+import (
+	"testing"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestDB installs an in-memory SQLite database with models migrated as database.DB for the test
+func newTestDB(t *testing.T, models ...any) *gorm.DB {
+	t.Helper()
+
+	db := openTestDB(t, t.Name()+"-main", models...)
+	previousDB := database.DB
+	database.DB = db
+	t.Cleanup(func() { database.DB = previousDB })
+
+	return db
+}
+
+// newTestMetricsDB installs an in-memory SQLite database with models migrated as database.MetricsDB for the test
+func newTestMetricsDB(t *testing.T, models ...any) *gorm.DB {
+	t.Helper()
+
+	db := openTestDB(t, t.Name()+"-metrics", models...)
+	previousMetricsDB := database.MetricsDB
+	database.MetricsDB = db
+	t.Cleanup(func() { database.MetricsDB = previousMetricsDB })
+
+	return db
+}
+
+func openTestDB(t *testing.T, name string, models ...any) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file:"+name+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+	return db
+}
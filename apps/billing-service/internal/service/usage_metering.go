@@ -0,0 +1,368 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// meteringLookback is how far back MeterUsage looks for hourly aggregates that have not been metered yet
+	// Hourly aggregates are written by the orchestrator once raw metrics age out, so they can arrive late
+	meteringLookback = 72 * time.Hour
+
+	meteringTransactionSource = "metering"
+	bytesPerGB                = 1024 * 1024 * 1024
+)
+
+// meteringRates holds the configured rates for metered resource types (nil = not metered)
+type meteringRates struct {
+	cpu    *database.BillingRateConfig
+	memory *database.BillingRateConfig
+}
+
+// hourlyResourceUsage is one resource's usage for one hour, read from the metrics database
+type hourlyResourceUsage struct {
+	ResourceType      string `gorm:"-"`
+	ResourceID        string
+	OrganizationID    string
+	Hour              time.Time
+	CPUCoreSeconds    int64
+	MemoryByteSeconds int64
+}
+
+// MeterUsage charges organizations hourly for deployment and VPS CPU and memory usage
+// Usage is read from the hourly aggregates in the metrics database and priced with billing_rate_configs.
+// Each (organization, resource, hour) is recorded once in metered_usage_records, so re-runs never double-charge.
+// Organizations with new usage have their spend alerts checked.
+func MeterUsage(ctx context.Context) error {
+	// Spend alerts count from the start of the month, so the first run of a month re-arms them
+	if err := resetSpendAlerts(ctx, time.Now()); err != nil {
+		log.Printf("[Usage Metering] %v", err)
+	}
+
+	rates, err := loadMeteringRates(ctx)
+	if err != nil {
+		return err
+	}
+	if rates.cpu == nil && rates.memory == nil {
+		// No rates configured - CPU and memory stay on the monthly bill
+		return nil
+	}
+
+	metricsDB := database.GetMetricsDB()
+	if metricsDB == nil {
+		return fmt.Errorf("metrics database not available")
+	}
+
+	windowEnd := time.Now().UTC().Truncate(time.Hour)
+	windowStart := windowEnd.Add(-meteringLookback)
+
+	var usage []hourlyResourceUsage
+	sources := []struct {
+		resourceType string
+		table        string
+		idColumn     string
+	}{
+		{"deployment", "deployment_usage_hourly", "deployment_id"},
+		{"vps", "vps_usage_hourly", "vps_instance_id"},
+	}
+	for _, source := range sources {
+		var rows []hourlyResourceUsage
+		if err := metricsDB.WithContext(ctx).Table(source.table).
+			Select(fmt.Sprintf(`
+				%s as resource_id,
+				organization_id,
+				hour,
+				CAST(AVG(avg_cpu_usage / 100.0) * 3600 AS BIGINT) as cpu_core_seconds,
+				CAST(AVG(avg_memory_usage) * 3600 AS BIGINT) as memory_byte_seconds
+			`, source.idColumn)).
+			Where("hour >= ? AND hour < ?", windowStart, windowEnd).
+			Group(source.idColumn + ", organization_id, hour").
+			Scan(&rows).Error; err != nil {
+			return fmt.Errorf("query %s: %w", source.table, err)
+		}
+		for i := range rows {
+			rows[i].ResourceType = source.resourceType
+		}
+		usage = append(usage, rows...)
+	}
+
+	if len(usage) == 0 {
+		return nil
+	}
+
+	// Skip hours that were already metered
+	var existing []database.MeteredUsageRecord
+	if err := database.DB.WithContext(ctx).Select("organization_id, resource_id, hour").
+		Where("hour >= ? AND hour < ?", windowStart, windowEnd).
+		Find(&existing).Error; err != nil {
+		return fmt.Errorf("get metered usage records: %w", err)
+	}
+	metered := make(map[string]bool, len(existing))
+	for _, record := range existing {
+		metered[meteringKey(record.OrganizationID, record.ResourceID, record.Hour)] = true
+	}
+
+	// Hours covered by an existing monthly bill were already charged there
+	billedUntil := make(map[string]time.Time)
+
+	var recordsCreated int
+	orgsToCharge := make(map[string]bool)
+	for _, row := range usage {
+		if metered[meteringKey(row.OrganizationID, row.ResourceID, row.Hour)] {
+			continue
+		}
+
+		until, ok := billedUntil[row.OrganizationID]
+		if !ok {
+			var lastBills []database.MonthlyBill
+			if err := database.DB.WithContext(ctx).Where("organization_id = ? AND status IN ?", row.OrganizationID, []string{"PAID", "PENDING"}).
+				Order("billing_period_end DESC").Limit(1).Find(&lastBills).Error; err != nil {
+				return fmt.Errorf("get last bill for org %s: %w", row.OrganizationID, err)
+			}
+			if len(lastBills) > 0 {
+				until = lastBills[0].BillingPeriodEnd
+			}
+			billedUntil[row.OrganizationID] = until
+		}
+		if row.Hour.Before(until) {
+			continue
+		}
+
+		cpuCoreSeconds, memoryByteSeconds, costCents := rates.cost(row.CPUCoreSeconds, row.MemoryByteSeconds)
+		record := &database.MeteredUsageRecord{
+			OrganizationID:    row.OrganizationID,
+			ResourceID:        row.ResourceID,
+			Hour:              row.Hour.UTC(),
+			ResourceType:      row.ResourceType,
+			CPUCoreSeconds:    cpuCoreSeconds,
+			MemoryByteSeconds: memoryByteSeconds,
+			CostCents:         costCents,
+			CreatedAt:         time.Now(),
+		}
+		// DoNothing on conflict keeps concurrent runs from double-recording an hour
+		result := database.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+		if result.Error != nil {
+			log.Printf("[Usage Metering] Failed to record usage for %s %s at %s: %v",
+				record.ResourceType, row.ResourceID, row.Hour.Format(time.RFC3339), result.Error)
+			continue
+		}
+		if result.RowsAffected > 0 {
+			recordsCreated++
+			orgsToCharge[row.OrganizationID] = true
+		}
+	}
+
+	var orgsCharged int
+	for orgID := range orgsToCharge {
+		charged, err := chargeMeteredUsage(ctx, orgID)
+		if err != nil {
+			log.Printf("[Usage Metering] Error charging org %s: %v", orgID, err)
+		} else if charged > 0 {
+			orgsCharged++
+		}
+
+		// New usage raised the organization's spend this month
+		if err := checkSpendAlerts(ctx, orgID, time.Now()); err != nil {
+			log.Printf("[Usage Metering] Error checking spend alerts of org %s: %v", orgID, err)
+		}
+		if err := checkSpendAnomaly(ctx, orgID, time.Now()); err != nil {
+			log.Printf("[Usage Metering] Error checking unusual spend of org %s: %v", orgID, err)
+		}
+	}
+
+	log.Printf("[Usage Metering] Completed: %d hourly records metered, %d orgs charged", recordsCreated, orgsCharged)
+	return nil
+}
+
+// chargeMeteredUsage deducts an organization's outstanding metered usage from its credits
+// Usage recorded since the last run is added to the organization's metering balance, which is kept
+// in fractional cents. The whole cents of the balance are charged, so fractions carry over to later
+// hours, and anything the credits cannot cover stays on the balance as debt for the monthly bill.
+// Returns the amount charged in cents.
+func chargeMeteredUsage(ctx context.Context, orgID string) (int64, error) {
+	var charged int64
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var org database.Organization
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&org, "id = ?", orgID).Error; err != nil {
+			return fmt.Errorf("organization not found: %w", err)
+		}
+
+		var unbilled []database.MeteredUsageRecord
+		if err := tx.Select("id, cost_cents").
+			Where("organization_id = ? AND charged_at IS NULL", orgID).
+			Find(&unbilled).Error; err != nil {
+			return fmt.Errorf("get unbilled metered usage: %w", err)
+		}
+
+		balance := database.MeteredUsageBalance{OrganizationID: orgID}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("organization_id = ?", orgID).
+			FirstOrInit(&balance).Error; err != nil {
+			return fmt.Errorf("get metered usage balance: %w", err)
+		}
+
+		now := time.Now()
+		if len(unbilled) > 0 {
+			ids := make([]uint, 0, len(unbilled))
+			for _, record := range unbilled {
+				balance.OutstandingCents += record.CostCents
+				ids = append(ids, record.ID)
+			}
+			if err := tx.Model(&database.MeteredUsageRecord{}).
+				Where("id IN ?", ids).
+				Update("charged_at", now).Error; err != nil {
+				return fmt.Errorf("mark metered usage charged: %w", err)
+			}
+		}
+
+		due := int64(math.Floor(balance.OutstandingCents))
+		// Credits never go negative; anything not covered stays outstanding
+		amount := max(min(due, org.Credits), 0)
+		if amount > 0 {
+			oldBalance := org.Credits
+			org.Credits -= amount
+			if err := tx.Save(&org).Error; err != nil {
+				return fmt.Errorf("update credits: %w", err)
+			}
+
+			note := "Metered CPU and memory usage"
+			transaction := &database.CreditTransaction{
+				ID:             generateID("ct"),
+				OrganizationID: orgID,
+				AmountCents:    -amount, // Negative for deduction
+				BalanceAfter:   org.Credits,
+				Type:           "usage",
+				Source:         meteringTransactionSource,
+				Note:           &note,
+				CreatedAt:      now,
+			}
+			if err := tx.Create(transaction).Error; err != nil {
+				return fmt.Errorf("create transaction: %w", err)
+			}
+
+			balance.OutstandingCents -= float64(amount)
+			log.Printf("[Usage Metering] Charged org %s %d cents for metered usage (%d -> %d cents)",
+				orgID, amount, oldBalance, org.Credits)
+		}
+		if amount < due {
+			log.Printf("[Usage Metering] Insufficient credits for org %s: %d cents of metered usage carried to the monthly bill",
+				orgID, due-amount)
+		}
+
+		balance.UpdatedAt = now
+		if err := tx.Save(&balance).Error; err != nil {
+			return fmt.Errorf("update metered usage balance: %w", err)
+		}
+		charged = amount
+		return nil
+	})
+	return charged, err
+}
+
+// loadMeteringRates reads billing_rate_configs, ignoring rates with units it cannot price
+func loadMeteringRates(ctx context.Context) (meteringRates, error) {
+	var configs []database.BillingRateConfig
+	if err := database.DB.WithContext(ctx).Find(&configs).Error; err != nil {
+		return meteringRates{}, fmt.Errorf("get billing rate configs: %w", err)
+	}
+
+	var rates meteringRates
+	for i := range configs {
+		config := &configs[i]
+		switch {
+		case config.ResourceType == "cpu" && (config.Unit == "core_hour" || config.Unit == "core_second"):
+			rates.cpu = config
+		case config.ResourceType == "memory" && (config.Unit == "gb_hour" || config.Unit == "byte_second"):
+			rates.memory = config
+		default:
+			log.Printf("[Usage Metering] Ignoring unsupported billing rate %s/%s", config.ResourceType, config.Unit)
+		}
+	}
+	return rates, nil
+}
+
+// cost prices one hour of usage, returning the metered quantities (0 for resources without a rate)
+// and the cost in fractional cents
+func (r meteringRates) cost(cpuCoreSeconds, memoryByteSeconds int64) (int64, int64, float64) {
+	var costCents float64
+
+	if r.cpu != nil {
+		quantity := float64(cpuCoreSeconds)
+		if r.cpu.Unit == "core_hour" {
+			quantity /= 3600
+		}
+		costCents += quantity * r.cpu.PricePerUnit * 100
+	} else {
+		cpuCoreSeconds = 0
+	}
+
+	if r.memory != nil {
+		quantity := float64(memoryByteSeconds)
+		if r.memory.Unit == "gb_hour" {
+			quantity /= bytesPerGB * 3600
+		}
+		costCents += quantity * r.memory.PricePerUnit * 100
+	} else {
+		memoryByteSeconds = 0
+	}
+
+	return cpuCoreSeconds, memoryByteSeconds, costCents
+}
+
+// meteredUsageForPeriod returns the CPU and memory metered hourly in a billing period
+// Their cost is either paid from credits or outstanding as metering debt, so the monthly bill
+// does not price them again.
+func meteredUsageForPeriod(orgID string, periodStart, periodEnd time.Time) (cpuCoreSeconds, memoryByteSeconds int64, err error) {
+	var totals struct {
+		CPUCoreSeconds    int64
+		MemoryByteSeconds int64
+	}
+	if err := database.DB.Model(&database.MeteredUsageRecord{}).
+		Select("COALESCE(SUM(cpu_core_seconds), 0) as cpu_core_seconds, COALESCE(SUM(memory_byte_seconds), 0) as memory_byte_seconds").
+		Where("organization_id = ? AND hour >= ? AND hour < ?", orgID, periodStart, periodEnd).
+		Scan(&totals).Error; err != nil {
+		return 0, 0, fmt.Errorf("sum metered usage: %w", err)
+	}
+	return totals.CPUCoreSeconds, totals.MemoryByteSeconds, nil
+}
+
+// meteredUsageDebt returns the whole cents of metered usage the organization's credits did not cover
+func meteredUsageDebt(orgID string) (int64, error) {
+	var balances []database.MeteredUsageBalance
+	if err := database.DB.Where("organization_id = ?", orgID).Limit(1).Find(&balances).Error; err != nil {
+		return 0, fmt.Errorf("get metered usage balance: %w", err)
+	}
+	if len(balances) == 0 {
+		return 0, nil
+	}
+	return max(int64(math.Floor(balances[0].OutstandingCents)), 0), nil
+}
+
+// settleMeteredUsageDebt removes debt that was moved onto a monthly bill from the metering balance
+func settleMeteredUsageDebt(tx *gorm.DB, orgID string, debtCents int64) error {
+	if debtCents <= 0 {
+		return nil
+	}
+	if err := tx.Model(&database.MeteredUsageBalance{}).
+		Where("organization_id = ?", orgID).
+		Updates(map[string]interface{}{
+			"outstanding_cents": gorm.Expr("outstanding_cents - ?", debtCents),
+			"updated_at":        time.Now(),
+		}).Error; err != nil {
+		return fmt.Errorf("settle metered usage debt: %w", err)
+	}
+	return nil
+}
+
+func meteringKey(orgID, resourceID string, hour time.Time) string {
+	return fmt.Sprintf("%s/%s/%d", orgID, resourceID, hour.Unix())
+}
@@ -0,0 +1,222 @@
+package billing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/gorm"
+)
+
+func TestMeterUsageChargesHourlyUsageOnce(t *testing.T) {
+	db, metricsDB := newMeteringTestDBs(t)
+
+	now := time.Now().UTC()
+	hour := now.Truncate(time.Hour)
+	records := []any{
+		&database.Organization{ID: "org-a", Name: "Org A", Slug: "org-a", Status: "active", Credits: 1000, CreatedAt: now},
+		&database.BillingRateConfig{ResourceType: "cpu", Unit: "core_hour", PricePerUnit: 0.10},
+		&database.BillingRateConfig{ResourceType: "memory", Unit: "gb_hour", PricePerUnit: 0.05},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	// 1 core + 1 GB for two hours (15 cents/hour) and half a core for one hour (5 cents)
+	metrics := []any{
+		&database.DeploymentUsageHourly{DeploymentID: "deploy-1", OrganizationID: "org-a", Hour: hour.Add(-2 * time.Hour), AvgCPUUsage: 100, AvgMemoryUsage: bytesPerGB},
+		&database.DeploymentUsageHourly{DeploymentID: "deploy-1", OrganizationID: "org-a", Hour: hour.Add(-3 * time.Hour), AvgCPUUsage: 100, AvgMemoryUsage: bytesPerGB},
+		&database.VPSUsageHourly{VPSInstanceID: "vps-1", OrganizationID: "org-a", Hour: hour.Add(-2 * time.Hour), AvgCPUUsage: 50},
+		// The current hour is still being aggregated and must not be metered
+		&database.VPSUsageHourly{VPSInstanceID: "vps-1", OrganizationID: "org-a", Hour: hour, AvgCPUUsage: 100},
+	}
+	for _, record := range metrics {
+		if err := metricsDB.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	for run := 0; run < 2; run++ {
+		if err := MeterUsage(context.Background()); err != nil {
+			t.Fatalf("MeterUsage run %d: %v", run, err)
+		}
+
+		var count int64
+		db.Model(&database.MeteredUsageRecord{}).Count(&count)
+		if count != 3 {
+			t.Fatalf("run %d: metered records = %d, want 3", run, count)
+		}
+
+		var org database.Organization
+		if err := db.First(&org, "id = ?", "org-a").Error; err != nil {
+			t.Fatalf("load org: %v", err)
+		}
+		if org.Credits != 965 {
+			t.Fatalf("run %d: credits = %d, want 965", run, org.Credits)
+		}
+
+		var transactions []database.CreditTransaction
+		db.Where("organization_id = ? AND source = ?", "org-a", meteringTransactionSource).Find(&transactions)
+		if len(transactions) != 1 || transactions[0].AmountCents != -35 {
+			t.Fatalf("run %d: metering transactions = %+v, want one of -35 cents", run, transactions)
+		}
+	}
+
+	// Metered usage is excluded from the monthly bill
+	cpuCoreSeconds, memoryByteSeconds, err := meteredUsageForPeriod("org-a", hour.Add(-24*time.Hour), hour)
+	if err != nil {
+		t.Fatalf("meteredUsageForPeriod: %v", err)
+	}
+	if cpuCoreSeconds != 3600*2+1800 {
+		t.Fatalf("metered cpu core-seconds = %d, want %d", cpuCoreSeconds, 3600*2+1800)
+	}
+	if memoryByteSeconds != 2*3600*bytesPerGB {
+		t.Fatalf("metered memory byte-seconds = %d, want %d", memoryByteSeconds, int64(2*3600*bytesPerGB))
+	}
+}
+
+func TestMeterUsageCarriesFractionalCents(t *testing.T) {
+	db, metricsDB := newMeteringTestDBs(t)
+
+	now := time.Now().UTC()
+	hour := now.Truncate(time.Hour)
+	if err := db.Create(&database.Organization{ID: "org-a", Name: "Org A", Slug: "org-a", Status: "active", Credits: 100, CreatedAt: now}).Error; err != nil {
+		t.Fatalf("seed org: %v", err)
+	}
+	// Only CPU is metered: 0.6 cents per core-hour
+	if err := db.Create(&database.BillingRateConfig{ResourceType: "cpu", Unit: "core_hour", PricePerUnit: 0.006}).Error; err != nil {
+		t.Fatalf("seed rate: %v", err)
+	}
+
+	addHour := func(offset time.Duration) {
+		t.Helper()
+		if err := metricsDB.Create(&database.DeploymentUsageHourly{
+			DeploymentID: "deploy-1", OrganizationID: "org-a", Hour: hour.Add(-offset), AvgCPUUsage: 100, AvgMemoryUsage: bytesPerGB,
+		}).Error; err != nil {
+			t.Fatalf("seed usage: %v", err)
+		}
+		if err := MeterUsage(context.Background()); err != nil {
+			t.Fatalf("MeterUsage: %v", err)
+		}
+	}
+
+	// 0.6 cents: nothing charged yet
+	addHour(3 * time.Hour)
+	var org database.Organization
+	db.First(&org, "id = ?", "org-a")
+	if org.Credits != 100 {
+		t.Fatalf("credits after first hour = %d, want 100", org.Credits)
+	}
+
+	// 1.2 cents in total: one whole cent charged, 0.2 carried over
+	addHour(2 * time.Hour)
+	db.First(&org, "id = ?", "org-a")
+	if org.Credits != 99 {
+		t.Fatalf("credits after second hour = %d, want 99", org.Credits)
+	}
+
+	// Memory has no rate, so it is left for the monthly bill
+	_, memoryByteSeconds, err := meteredUsageForPeriod("org-a", hour.Add(-24*time.Hour), hour)
+	if err != nil {
+		t.Fatalf("meteredUsageForPeriod: %v", err)
+	}
+	if memoryByteSeconds != 0 {
+		t.Fatalf("metered memory byte-seconds = %d, want 0", memoryByteSeconds)
+	}
+}
+
+func TestMeterUsageCarriesUnpaidUsageToMonthlyBill(t *testing.T) {
+	db, metricsDB := newMeteringTestDBs(t)
+
+	now := time.Now().UTC()
+	hour := now.Truncate(time.Hour)
+	if err := db.Create(&database.Organization{ID: "org-a", Name: "Org A", Slug: "org-a", Status: "active", Credits: 20, CreatedAt: now}).Error; err != nil {
+		t.Fatalf("seed org: %v", err)
+	}
+	if err := db.Create(&database.BillingRateConfig{ResourceType: "cpu", Unit: "core_hour", PricePerUnit: 0.10}).Error; err != nil {
+		t.Fatalf("seed rate: %v", err)
+	}
+
+	// 10 cents per hour for three hours
+	for offset := 1; offset <= 3; offset++ {
+		if err := metricsDB.Create(&database.DeploymentUsageHourly{
+			DeploymentID: "deploy-1", OrganizationID: "org-a", Hour: hour.Add(-time.Duration(offset) * time.Hour), AvgCPUUsage: 100,
+		}).Error; err != nil {
+			t.Fatalf("seed usage: %v", err)
+		}
+	}
+
+	// A second run only looks at usage since the first one, so the debt is not counted twice
+	for run := 0; run < 2; run++ {
+		if err := MeterUsage(context.Background()); err != nil {
+			t.Fatalf("MeterUsage run %d: %v", run, err)
+		}
+
+		var org database.Organization
+		db.First(&org, "id = ?", "org-a")
+		if org.Credits != 0 {
+			t.Fatalf("run %d: credits = %d, want 0", run, org.Credits)
+		}
+		debt, err := meteredUsageDebt("org-a")
+		if err != nil {
+			t.Fatalf("meteredUsageDebt: %v", err)
+		}
+		if debt != 10 {
+			t.Fatalf("run %d: metered debt = %d, want 10", run, debt)
+		}
+	}
+
+	var unbilled int64
+	db.Model(&database.MeteredUsageRecord{}).Where("charged_at IS NULL").Count(&unbilled)
+	if unbilled != 0 {
+		t.Fatalf("records without charged_at = %d, want 0", unbilled)
+	}
+
+	// Moving the debt onto a monthly bill clears it
+	if err := settleMeteredUsageDebt(db, "org-a", 10); err != nil {
+		t.Fatalf("settleMeteredUsageDebt: %v", err)
+	}
+	debt, err := meteredUsageDebt("org-a")
+	if err != nil {
+		t.Fatalf("meteredUsageDebt: %v", err)
+	}
+	if debt != 0 {
+		t.Fatalf("metered debt after settling = %d, want 0", debt)
+	}
+}
+
+func TestMeterUsageWithoutRatesIsNoop(t *testing.T) {
+	db, _ := newMeteringTestDBs(t)
+	database.MetricsDB = nil // Must not be touched when nothing is metered
+
+	if err := MeterUsage(context.Background()); err != nil {
+		t.Fatalf("MeterUsage: %v", err)
+	}
+	var count int64
+	db.Model(&database.MeteredUsageRecord{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("metered records = %d, want 0", count)
+	}
+}
+
+func newMeteringTestDBs(t *testing.T) (*gorm.DB, *gorm.DB) {
+	t.Helper()
+
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.MonthlyBill{},
+		&database.CreditTransaction{},
+		&database.BillingRateConfig{},
+		&database.MeteredUsageRecord{},
+		&database.MeteredUsageBalance{},
+		&database.SpendAlert{},
+	)
+	metricsDB := newTestMetricsDB(t,
+		&database.DeploymentUsageHourly{},
+		&database.VPSUsageHourly{},
+	)
+	return db, metricsDB
+}
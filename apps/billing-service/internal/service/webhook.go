@@ -1,6 +1,7 @@
 package billing
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -495,7 +496,12 @@ func handleCheckoutSessionCompleted(session *stripe.CheckoutSession, rawData []b
 	}
 
 	// Get payment intent amount from session (more reliable than metadata)
-	amountCents := session.AmountTotal
+	// Tax collected on the purchase is not added as credits
+	amountCents, err := checkoutCreditAmount(session)
+	if err != nil {
+		return err
+	}
+	taxCalculationID := session.Metadata["tax_calculation_id"]
 	if amountCents <= 0 {
 		return fmt.Errorf("invalid amount_total: %d", amountCents)
 	}
@@ -526,6 +532,13 @@ func handleCheckoutSessionCompleted(session *stripe.CheckoutSession, rawData []b
 	}
 
 	log.Printf("[Stripe Webhook] Successfully added %d cents to organization %s from checkout session %s", amountCents, orgID, session.ID)
+
+	if taxCalculationID != "" {
+		if err := RecordTaxTransaction(context.Background(), taxCalculationID, session.ID); err != nil {
+			// Credits were already added, so don't fail the webhook over the tax archive
+			log.Printf("[Stripe Webhook] Warning: failed to record tax transaction for checkout session %s: %v", session.ID, err)
+		}
+	}
 	return nil
 }
 
@@ -734,6 +747,19 @@ func handleInvoicePaid(invoice *stripe.Invoice, rawData []byte) error {
 		return fmt.Errorf("find billing account: %w", err)
 	}
 
+	// Paying the invoice that is in dunning lifts the restrictions it caused
+	var dunningCount int64
+	if err := database.DB.Model(&database.BillingDunningState{}).
+		Where("organization_id = ? AND invoice_id = ?", billingAccount.OrganizationID, invoice.ID).
+		Count(&dunningCount).Error; err != nil {
+		log.Printf("[Stripe Webhook] Failed to check dunning state for organization %s: %v", billingAccount.OrganizationID, err)
+	} else if dunningCount > 0 {
+		if _, err := resetDunningState(context.Background(), billingAccount.OrganizationID, dunningSuspendedBy); err != nil {
+			return fmt.Errorf("reset dunning state: %w", err)
+		}
+		log.Printf("[Stripe Webhook] Invoice %s paid, dunning state of organization %s reset", invoice.ID, billingAccount.OrganizationID)
+	}
+
 	// Only create transaction if this invoice actually resulted in credits being added
 	// Check if invoice amount is positive and invoice is paid
 	if invoice.AmountPaid <= 0 {
@@ -862,10 +888,12 @@ func handleInvoicePaymentFailed(invoice *stripe.Invoice, rawData []byte) error {
 		log.Printf("[Stripe Webhook] Failed to update billing account status: %v", err)
 	}
 
-	log.Printf("[Stripe Webhook] Invoice payment failed for organization %s (invoice %s, amount: %d cents)", 
-		billingAccount.OrganizationID, invoice.ID, invoice.AmountDue)
-	
-	// TODO: Could send notification email to user about failed payment
+	log.Printf("[Stripe Webhook] Invoice payment failed for organization %s (invoice %s, attempt %d, amount: %d cents)",
+		billingAccount.OrganizationID, invoice.ID, invoice.AttemptCount, invoice.AmountDue)
+
+	if err := HandlePaymentFailed(context.Background(), billingAccount.OrganizationID, invoice.ID, int32(invoice.AttemptCount)); err != nil {
+		return fmt.Errorf("dunning: %w", err)
+	}
 	return nil
 }
 
@@ -938,8 +966,11 @@ func handleCheckoutSessionAsyncPaymentSucceeded(session *stripe.CheckoutSession,
 		return fmt.Errorf("missing organization_id in metadata")
 	}
 
-	// Get payment intent amount from session
-	amountCents := session.AmountTotal
+	// Credits bought, without tax and in USD when charged in another currency
+	amountCents, err := checkoutCreditAmount(session)
+	if err != nil {
+		return err
+	}
 	if amountCents <= 0 {
 		return fmt.Errorf("invalid amount_total: %d", amountCents)
 	}
@@ -1098,6 +1129,7 @@ func grantMonthlyFreeCreditsFromPlan(orgID string, subscriptionID string) error
 			Type:           "admin_add",
 			Source:         "stripe",
 			Note:           &note,
+			ExpiresAt:      freeCreditExpiry(now),
 			CreatedAt:      time.Now(),
 		}
 		if err := tx.Create(transaction).Error; err != nil {
@@ -1305,6 +1337,13 @@ func handleInvoicePaymentSucceeded(invoice *stripe.Invoice, rawData []byte) {
 
 	log.Printf("[Stripe Webhook] Invoice payment succeeded for customer %s (invoice %s, amount: %d cents)", 
 		customerID, invoice.ID, invoice.AmountPaid)
+
+	// Archive the Stripe Tax transaction for invoices charged with a tax calculation
+	if calculationID := invoice.Metadata["tax_calculation_id"]; calculationID != "" {
+		if err := RecordTaxTransaction(context.Background(), calculationID, invoice.ID); err != nil {
+			log.Printf("[Stripe Webhook] Warning: failed to record tax transaction for invoice %s: %v", invoice.ID, err)
+		}
+	}
 }
 
 func handleInvoiceSent(invoice *stripe.Invoice, rawData []byte) {
@@ -14,6 +14,7 @@ import (
 
 	"github.com/obiente/cloud/apps/shared/pkg/auth"
 	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/email"
 	"github.com/obiente/cloud/apps/shared/pkg/health"
 	"github.com/obiente/cloud/apps/shared/pkg/logger"
 	"github.com/obiente/cloud/apps/shared/pkg/middleware"
@@ -101,6 +102,13 @@ func main() {
 	}
 	logger.Info("✓ Database initialized")
 
+	// Initialize Redis (for maintenance mode, etc.)
+	if err := database.InitRedis(); err != nil {
+		logger.Warn("Redis initialization failed: %v. Some features may not work correctly.", err)
+	} else {
+		logger.Info("✓ Redis initialized")
+	}
+
 	// Initialize metrics database (TimescaleDB for usage stats)
 	if err := database.InitMetricsDatabase(); err != nil {
 		logger.Warn("Metrics database initialization failed: %v. Usage stats may not work correctly.", err)
@@ -130,6 +138,9 @@ func main() {
 	// Create audit interceptor
 	auditInterceptor := middleware.AuditLogInterceptor()
 
+	// Create maintenance mode interceptor (rejects mutating RPCs during platform maintenance)
+	maintenanceInterceptor := middleware.MaintenanceModeInterceptor()
+
 	// Configure Stripe client
 	stripeClient, err := stripe.NewClient()
 	if err != nil {
@@ -148,7 +159,7 @@ func main() {
 	billingService := billing.NewService(stripeClient, consoleURL, billingEnabled)
 	billingPath, billingHandler := billingv1connect.NewBillingServiceHandler(
 		billingService,
-		connect.WithInterceptors(auditInterceptor, authInterceptor),
+		connect.WithInterceptors(auditInterceptor, authInterceptor, maintenanceInterceptor),
 	)
 	mux.Handle(billingPath, billingHandler)
 
@@ -204,6 +215,14 @@ func main() {
 	go startMonthlyCreditsService(shutdownCtx)
 	logger.Info("✓ Monthly free credits service started")
 
+	// Start monthly invoice delivery background service
+	go startMonthlyInvoiceService(shutdownCtx, email.NewSenderFromEnv())
+	logger.Info("✓ Monthly invoice service started")
+
+	// Start exchange rate refresh background service
+	go startCurrencyRateService(shutdownCtx)
+	logger.Info("✓ Exchange rate service started")
+
 	// Start server in a goroutine
 	serverErr := make(chan error, 1)
 	go func() {
@@ -232,28 +251,57 @@ func main() {
 }
 
 // startMonthlyBillingService starts the monthly billing background service
+// It also meters usage hourly, and daily expires unused free credits and tax-exempt certificates
 func startMonthlyBillingService(ctx context.Context) {
 	ticker := time.NewTicker(24 * time.Hour)
 	defer ticker.Stop()
+	meteringTicker := time.NewTicker(1 * time.Hour)
+	defer meteringTicker.Stop()
 
 	if err := waitForDatabaseReadiness(ctx, true); err != nil {
 		logger.Info("Monthly billing service stopped before initial run: %v", err)
 		return
 	}
 
+	// Meter before billing so the monthly bill excludes usage already charged hourly
+	if err := billing.MeterUsage(ctx); err != nil {
+		logger.Warn("Usage metering error: %v", err)
+	}
 	if err := billing.ProcessMonthlyBilling(); err != nil {
 		logger.Warn("Monthly billing process error: %v", err)
 	}
+	if err := billing.ExpireCredits(ctx); err != nil {
+		logger.Warn("Credit expiry error: %v", err)
+	}
+	if err := billing.NotifyExpiringPaymentMethods(ctx); err != nil {
+		logger.Warn("Payment method expiry warning error: %v", err)
+	}
+	if err := billing.ProcessTaxExemptCertificates(ctx); err != nil {
+		logger.Warn("Tax-exempt certificate expiry error: %v", err)
+	}
 
 	for {
 		select {
 		case <-ctx.Done():
 			logger.Info("Monthly billing service stopped")
 			return
+		case <-meteringTicker.C:
+			if err := billing.MeterUsage(ctx); err != nil {
+				logger.Warn("Usage metering error: %v", err)
+			}
 		case <-ticker.C:
 			if err := billing.ProcessMonthlyBilling(); err != nil {
 				logger.Warn("Monthly billing process error: %v", err)
 			}
+			if err := billing.ExpireCredits(ctx); err != nil {
+				logger.Warn("Credit expiry error: %v", err)
+			}
+			if err := billing.NotifyExpiringPaymentMethods(ctx); err != nil {
+				logger.Warn("Payment method expiry warning error: %v", err)
+			}
+			if err := billing.ProcessTaxExemptCertificates(ctx); err != nil {
+				logger.Warn("Tax-exempt certificate expiry error: %v", err)
+			}
 		}
 	}
 }
@@ -284,3 +332,58 @@ func startMonthlyCreditsService(ctx context.Context) {
 		}
 	}
 }
+
+// startMonthlyInvoiceService emails last month's invoices on the first day of each month
+// It checks daily so a missed run (e.g. downtime on the 1st) is caught up; sent invoices are skipped
+func startMonthlyInvoiceService(ctx context.Context, sender email.Sender) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	if err := waitForDatabaseReadiness(ctx, false); err != nil {
+		logger.Info("Monthly invoice service stopped before initial run: %v", err)
+		return
+	}
+
+	if err := billing.SendMonthlyInvoice(ctx, sender); err != nil {
+		logger.Warn("Monthly invoice process error: %v", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Monthly invoice service stopped")
+			return
+		case <-ticker.C:
+			if err := billing.SendMonthlyInvoice(ctx, sender); err != nil {
+				logger.Warn("Monthly invoice process error: %v", err)
+			}
+		}
+	}
+}
+
+// startCurrencyRateService refreshes the exchange rates of the supported display currencies
+func startCurrencyRateService(ctx context.Context) {
+	ticker := time.NewTicker(billing.CurrencyRefreshInterval)
+	defer ticker.Stop()
+
+	if err := waitForDatabaseReadiness(ctx, false); err != nil {
+		logger.Info("Exchange rate service stopped before initial run: %v", err)
+		return
+	}
+
+	if err := billing.RefreshExchangeRates(ctx); err != nil {
+		logger.Warn("Exchange rate refresh error: %v", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Exchange rate service stopped")
+			return
+		case <-ticker.C:
+			if err := billing.RefreshExchangeRates(ctx); err != nil {
+				logger.Warn("Exchange rate refresh error: %v", err)
+			}
+		}
+	}
+}
@@ -254,6 +254,28 @@ export const useSuperAdmin = () => {
     }
   };
 
+  const drainClusterNode = async (nodeId: string) => {
+    try {
+      return await client.drainClusterNode({ nodeId });
+    } catch (err) {
+      if (err instanceof ConnectError) {
+        throw new Error((err as Error).message);
+      }
+      throw err;
+    }
+  };
+
+  const enableClusterNode = async (nodeId: string) => {
+    try {
+      return await client.enableClusterNode({ nodeId });
+    } catch (err) {
+      if (err instanceof ConnectError) {
+        throw new Error((err as Error).message);
+      }
+      throw err;
+    }
+  };
+
   return {
     state,
     overview: computed(() => state.value.overview),
@@ -270,6 +292,8 @@ export const useSuperAdmin = () => {
     listNodes,
     getNode,
     updateNodeConfig,
+    drainClusterNode,
+    enableClusterNode,
   };
 };
 
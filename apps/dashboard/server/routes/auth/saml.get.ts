@@ -0,0 +1,63 @@
+import type { User } from "@obiente/types";
+
+/**
+ * SAML sign-in landing route
+ * The auth service validates the SAML assertion, issues a platform token and
+ * redirects here. The token is verified by the API on every request, so the
+ * claims are only decoded here to populate the session user.
+ */
+export default defineEventHandler(async (event) => {
+  const { token, expires_in } = getQuery<{
+    token?: string;
+    expires_in?: string;
+  }>(event);
+
+  if (!token) {
+    throw createError({ statusCode: 400, message: "Missing token" });
+  }
+
+  let claims: Record<string, any>;
+  try {
+    const payload = token.split(".")[1] || "";
+    claims = JSON.parse(Buffer.from(payload, "base64url").toString("utf8"));
+  } catch {
+    throw createError({ statusCode: 400, message: "Invalid token" });
+  }
+
+  const user: User = {
+    sub: claims.sub,
+    name: claims.name || claims.email,
+    given_name: claims.given_name || "",
+    family_name: claims.family_name || "",
+    locale: "",
+    updated_at: claims.iat || 0,
+    preferred_username: claims.email,
+    email: claims.email,
+    email_verified: true,
+  };
+  const expiresIn = Number(expires_in) || 3600;
+
+  await setUserSession(event, {
+    user,
+    secure: {
+      scope: "openid profile email",
+      token_type: "Bearer",
+      expires_in: expiresIn,
+      access_token: token,
+    },
+  });
+
+  const { AUTH_COOKIE_NAME } = await import("../../utils/auth");
+
+  // Platform tokens cannot be refreshed, so the cookie lives as long as the token
+  setCookie(event, AUTH_COOKIE_NAME, token, {
+    httpOnly: false,
+    path: "/",
+    maxAge: expiresIn,
+    secure: process.env.NODE_ENV === "production",
+    sameSite: "lax",
+    domain: undefined,
+  });
+
+  return sendRedirect(event, "/dashboard");
+});
@@ -84,6 +84,9 @@ func main() {
 	// Create audit interceptor
 	auditInterceptor := middleware.AuditLogInterceptor()
 
+	// Create maintenance mode interceptor (rejects mutating RPCs during platform maintenance)
+	maintenanceInterceptor := middleware.MaintenanceModeInterceptor()
+
 	// Create repositories and services
 	databaseRepo := database.NewDatabaseRepository(database.DB, database.RedisClient)
 	connRepo := database.NewDatabaseConnectionRepository(database.DB)
@@ -93,7 +96,7 @@ func main() {
 	// Register databases service
 	databasesPath, databasesHandler := databasesv1connect.NewDatabaseServiceHandler(
 		databaseService,
-		connect.WithInterceptors(auditInterceptor, authInterceptor),
+		connect.WithInterceptors(auditInterceptor, authInterceptor, maintenanceInterceptor),
 	)
 	mux.Handle(databasesPath, databasesHandler)
 
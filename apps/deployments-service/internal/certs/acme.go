@@ -0,0 +1,237 @@
+// Package certs issues TLS certificates for deployment custom domains over ACME (Let's Encrypt)
+// with DNS-01 challenges and publishes them to Traefik through its file provider.
+package certs
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"deployments-service/internal/secrets"
+
+	"github.com/google/uuid"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"golang.org/x/crypto/acme"
+	"gorm.io/gorm"
+)
+
+// completeTimeout bounds how long CompleteChallenge waits for the CA to validate and issue
+const completeTimeout = 2 * time.Minute
+
+// Challenge is a pending ACME DNS-01 challenge for one domain
+type Challenge struct {
+	OrderURL         string
+	AuthorizationURL string
+	ChallengeURL     string
+	// The TXT record the domain owner has to publish
+	RecordName  string
+	RecordValue string
+}
+
+// Certificate is an issued certificate chain with its private key, both PEM encoded
+type Certificate struct {
+	ChainPEM  []byte
+	KeyPEM    []byte
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// Issuer obtains certificates from an ACME directory
+type Issuer struct {
+	directoryURL string
+	email        string
+
+	mu     sync.Mutex
+	client *acme.Client
+}
+
+// NewIssuerFromEnv returns an issuer for ACME_DIRECTORY_URL (Let's Encrypt production by default)
+// that registers its account with ACME_EMAIL
+func NewIssuerFromEnv() *Issuer {
+	directoryURL := strings.TrimSpace(os.Getenv("ACME_DIRECTORY_URL"))
+	if directoryURL == "" {
+		directoryURL = acme.LetsEncryptURL
+	}
+	return &Issuer{
+		directoryURL: directoryURL,
+		email:        strings.TrimSpace(os.Getenv("ACME_EMAIL")),
+	}
+}
+
+// ChallengeRecordName returns the TXT record name of the DNS-01 challenge for domain
+func ChallengeRecordName(domain string) string {
+	return "_acme-challenge." + strings.TrimPrefix(domain, "*.")
+}
+
+// StartChallenge places an order for domain and returns its DNS-01 challenge
+func (i *Issuer) StartChallenge(ctx context.Context, domain string) (*Challenge, error) {
+	client, err := i.acmeClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(domain))
+	if err != nil {
+		return nil, fmt.Errorf("create ACME order: %w", err)
+	}
+	if len(order.AuthzURLs) == 0 {
+		return nil, fmt.Errorf("ACME order for %s has no authorizations", domain)
+	}
+	authz, err := client.GetAuthorization(ctx, order.AuthzURLs[0])
+	if err != nil {
+		return nil, fmt.Errorf("get ACME authorization: %w", err)
+	}
+
+	for _, chal := range authz.Challenges {
+		if chal.Type != "dns-01" {
+			continue
+		}
+		value, err := client.DNS01ChallengeRecord(chal.Token)
+		if err != nil {
+			return nil, fmt.Errorf("compute DNS challenge record: %w", err)
+		}
+		return &Challenge{
+			OrderURL:         order.URI,
+			AuthorizationURL: authz.URI,
+			ChallengeURL:     chal.URI,
+			RecordName:       ChallengeRecordName(domain),
+			RecordValue:      value,
+		}, nil
+	}
+	return nil, fmt.Errorf("ACME directory offers no dns-01 challenge for %s", domain)
+}
+
+// CompleteChallenge asks the CA to validate the challenge, waits for the order and returns the
+// issued certificate. The TXT record must already be published: a failed validation is final.
+func (i *Issuer) CompleteChallenge(ctx context.Context, domain string, challenge *Challenge) (*Certificate, error) {
+	client, err := i.acmeClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, completeTimeout)
+	defer cancel()
+
+	if _, err := client.Accept(ctx, &acme.Challenge{URI: challenge.ChallengeURL}); err != nil {
+		return nil, fmt.Errorf("accept ACME challenge: %w", err)
+	}
+	if _, err := client.WaitAuthorization(ctx, challenge.AuthorizationURL); err != nil {
+		return nil, fmt.Errorf("ACME authorization: %w", err)
+	}
+	order, err := client.WaitOrder(ctx, challenge.OrderURL)
+	if err != nil {
+		return nil, fmt.Errorf("ACME order: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate certificate key: %w", err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: domain},
+		DNSNames: []string{domain},
+	}, key)
+	if err != nil {
+		return nil, fmt.Errorf("create CSR: %w", err)
+	}
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, fmt.Errorf("finalize ACME order: %w", err)
+	}
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("ACME order for %s returned no certificate", domain)
+	}
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return nil, fmt.Errorf("parse issued certificate: %w", err)
+	}
+
+	var chainPEM []byte
+	for _, der := range chain {
+		chainPEM = append(chainPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("encode certificate key: %w", err)
+	}
+	return &Certificate{
+		ChainPEM:  chainPEM,
+		KeyPEM:    pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		NotBefore: leaf.NotBefore,
+		NotAfter:  leaf.NotAfter,
+	}, nil
+}
+
+// acmeClient returns a client for the platform's ACME account, registering it on first use.
+// The account key is stored in acme_accounts encrypted with the master key so every replica
+// signs with the same account and can finish orders started elsewhere.
+func (i *Issuer) acmeClient(ctx context.Context) (*acme.Client, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.client != nil {
+		return i.client, nil
+	}
+
+	var account database.ACMEAccount
+	err := database.DB.Where("directory_url = ?", i.directoryURL).First(&account).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("get ACME account: %w", err)
+	}
+
+	var key crypto.Signer
+	if err == nil {
+		raw, err := secrets.DecryptWithMasterKey(account.EncryptedKey, account.ID)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt ACME account key: %w", err)
+		}
+		if key, err = x509.ParseECPrivateKey(raw); err != nil {
+			return nil, fmt.Errorf("parse ACME account key: %w", err)
+		}
+		i.client = &acme.Client{Key: key, DirectoryURL: i.directoryURL, KID: acme.KeyID(account.AccountURL)}
+		return i.client, nil
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate ACME account key: %w", err)
+	}
+	client := &acme.Client{Key: ecKey, DirectoryURL: i.directoryURL}
+	acct := &acme.Account{}
+	if i.email != "" {
+		acct.Contact = []string{"mailto:" + i.email}
+	}
+	registered, err := client.Register(ctx, acct, acme.AcceptTOS)
+	if err != nil {
+		return nil, fmt.Errorf("register ACME account: %w", err)
+	}
+
+	raw, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		return nil, fmt.Errorf("encode ACME account key: %w", err)
+	}
+	account = database.ACMEAccount{
+		ID:           fmt.Sprintf("acme-%s", uuid.NewString()),
+		DirectoryURL: i.directoryURL,
+		Email:        i.email,
+		AccountURL:   registered.URI,
+		CreatedAt:    time.Now(),
+	}
+	if account.EncryptedKey, err = secrets.EncryptWithMasterKey(raw, account.ID); err != nil {
+		return nil, fmt.Errorf("encrypt ACME account key: %w", err)
+	}
+	if err := database.DB.Create(&account).Error; err != nil {
+		return nil, fmt.Errorf("store ACME account: %w", err)
+	}
+	i.client = client
+	return i.client, nil
+}
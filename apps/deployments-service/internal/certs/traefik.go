@@ -0,0 +1,142 @@
+package certs
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Route is a custom domain routed by Traefik to a deployment's label-defined service
+type Route struct {
+	CustomDomainID string
+	DeploymentID   string
+	Domain         string
+	ChainPEM       []byte
+	KeyPEM         []byte
+}
+
+// traefikDynamicConfig is the subset of Traefik's file provider schema written for custom domains
+type traefikDynamicConfig struct {
+	HTTP struct {
+		Routers map[string]traefikRouter `yaml:"routers"`
+	} `yaml:"http"`
+	TLS struct {
+		Certificates []traefikCertificate `yaml:"certificates"`
+	} `yaml:"tls"`
+}
+
+type traefikRouter struct {
+	Rule        string         `yaml:"rule"`
+	EntryPoints []string       `yaml:"entryPoints"`
+	Service     string         `yaml:"service"`
+	TLS         map[string]any `yaml:"tls"`
+}
+
+type traefikCertificate struct {
+	CertFile string `yaml:"certFile"`
+	KeyFile  string `yaml:"keyFile"`
+}
+
+// dynamicConfigDir returns the directory watched by Traefik's file provider, or "" when
+// custom domain routing through files is disabled
+func dynamicConfigDir() string {
+	return strings.TrimSpace(os.Getenv("TRAEFIK_DYNAMIC_CONFIG_DIR"))
+}
+
+// serviceProvider returns the Traefik provider that defines deployment services (swarm or docker)
+func serviceProvider() string {
+	if provider := strings.TrimSpace(os.Getenv("TRAEFIK_SERVICE_PROVIDER")); provider != "" {
+		return provider
+	}
+	return "swarm"
+}
+
+// WriteRoute writes the route's certificate, key and router to the Traefik dynamic
+// configuration directory. Traefik watches the directory and applies the files without a restart.
+func WriteRoute(route Route) error {
+	dir := dynamicConfigDir()
+	if dir == "" {
+		log.Printf("[Certs] TRAEFIK_DYNAMIC_CONFIG_DIR not set, not routing custom domain %s", route.Domain)
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create traefik config dir: %w", err)
+	}
+
+	name := "custom-domain-" + route.CustomDomainID
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	if err := writeFileAtomic(certFile, route.ChainPEM, 0o644); err != nil {
+		return err
+	}
+	if err := writeFileAtomic(keyFile, route.KeyPEM, 0o600); err != nil {
+		return err
+	}
+
+	var cfg traefikDynamicConfig
+	cfg.HTTP.Routers = map[string]traefikRouter{
+		name: {
+			Rule:        hostRule(route.Domain),
+			EntryPoints: []string{"websecure"},
+			Service:     route.DeploymentID + "@" + serviceProvider(),
+			TLS:         map[string]any{},
+		},
+	}
+	cfg.TLS.Certificates = []traefikCertificate{{CertFile: certFile, KeyFile: keyFile}}
+	data, err := yaml.Marshal(&cfg)
+	if err != nil {
+		return fmt.Errorf("encode traefik config: %w", err)
+	}
+	// The router is written last so Traefik never loads it before its certificate
+	return writeFileAtomic(filepath.Join(dir, name+".yml"), data, 0o644)
+}
+
+// RemoveRoute deletes the files written by WriteRoute
+func RemoveRoute(customDomainID string) error {
+	dir := dynamicConfigDir()
+	if dir == "" {
+		return nil
+	}
+	name := "custom-domain-" + customDomainID
+	for _, ext := range []string{".yml", ".crt", ".key"} {
+		if err := os.Remove(filepath.Join(dir, name+ext)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove traefik config: %w", err)
+		}
+	}
+	return nil
+}
+
+// hostRule matches the rule generated for deployment labels: wildcard domains use HostRegexp
+func hostRule(domain string) string {
+	if strings.HasPrefix(domain, "*.") {
+		return "HostRegexp(`{subdomain:[^.]+}." + strings.TrimPrefix(domain, "*.") + "`)"
+	}
+	return "Host(`" + domain + "`)"
+}
+
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
@@ -165,3 +165,44 @@ func (c *Client) GetFile(ctx context.Context, repoFullName, branch, path string)
 
 	return &fileContent, nil
 }
+
+type GitHubPullRequest struct {
+	Number int    `json:"number"`
+	State  string `json:"state"` // open or closed
+	Merged bool   `json:"merged"`
+}
+
+func (c *Client) GetPullRequest(ctx context.Context, repoFullName string, number int) (*GitHubPullRequest, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls/%d", c.baseURL, repoFullName, number)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("github API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return nil, fmt.Errorf("github app authentication failed (installation may be suspended, revoked, or missing repository access): %d - %s", resp.StatusCode, string(body))
+		}
+		return nil, fmt.Errorf("github API error: %d - %s", resp.StatusCode, string(body))
+	}
+
+	var pullRequest GitHubPullRequest
+	if err := json.NewDecoder(resp.Body).Decode(&pullRequest); err != nil {
+		return nil, fmt.Errorf("failed to decode pull request: %w", err)
+	}
+
+	return &pullRequest, nil
+}
@@ -0,0 +1,138 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GitHubDeployKey is an SSH key with access to a single repository
+type GitHubDeployKey struct {
+	ID       int64  `json:"id"`
+	Key      string `json:"key"`
+	Title    string `json:"title"`
+	ReadOnly bool   `json:"read_only"`
+}
+
+// GitHubHook is a repository webhook
+type GitHubHook struct {
+	ID     int64    `json:"id"`
+	Active bool     `json:"active"`
+	Events []string `json:"events"`
+	Config struct {
+		URL         string `json:"url"`
+		ContentType string `json:"content_type"`
+		InsecureSSL string `json:"insecure_ssl"`
+	} `json:"config"`
+}
+
+// CreateDeployKey adds a read-only deploy key to a repository
+func (c *Client) CreateDeployKey(ctx context.Context, repoFullName, title, publicKey string) (*GitHubDeployKey, error) {
+	body := map[string]any{"title": title, "key": publicKey, "read_only": true}
+	var key GitHubDeployKey
+	if err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/keys", repoFullName), body, http.StatusCreated, &key); err != nil {
+		return nil, fmt.Errorf("failed to create deploy key: %w", err)
+	}
+	return &key, nil
+}
+
+// DeleteDeployKey removes a deploy key from a repository; keys that no longer exist are ignored
+func (c *Client) DeleteDeployKey(ctx context.Context, repoFullName string, keyID int64) error {
+	err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/repos/%s/keys/%d", repoFullName, keyID), nil, http.StatusNoContent, nil)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("failed to delete deploy key: %w", err)
+	}
+	return nil
+}
+
+// ListRepoHooks lists the webhooks of a repository
+func (c *Client) ListRepoHooks(ctx context.Context, repoFullName string) ([]GitHubHook, error) {
+	var hooks []GitHubHook
+	if err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/repos/%s/hooks?per_page=100", repoFullName), nil, http.StatusOK, &hooks); err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	return hooks, nil
+}
+
+// UpdateRepoHookSecret replaces the HMAC secret of a repository webhook, keeping the rest of its config
+func (c *Client) UpdateRepoHookSecret(ctx context.Context, repoFullName string, hook GitHubHook, secret string) error {
+	config := map[string]string{
+		"url":    hook.Config.URL,
+		"secret": secret,
+	}
+	if hook.Config.ContentType != "" {
+		config["content_type"] = hook.Config.ContentType
+	}
+	if hook.Config.InsecureSSL != "" {
+		config["insecure_ssl"] = hook.Config.InsecureSSL
+	}
+	body := map[string]any{"config": config}
+	if err := c.doJSON(ctx, http.MethodPatch, fmt.Sprintf("/repos/%s/hooks/%d", repoFullName, hook.ID), body, http.StatusOK, nil); err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+	return nil
+}
+
+// apiError is a GitHub API response with an unexpected status code
+type apiError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	if e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden {
+		return fmt.Sprintf("github app authentication failed (installation may be suspended, revoked, or missing repository access): %d - %s", e.StatusCode, e.Body)
+	}
+	return fmt.Sprintf("github API error: %d - %s", e.StatusCode, e.Body)
+}
+
+func isNotFound(err error) bool {
+	apiErr, ok := err.(*apiError)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// doJSON sends body as JSON to the API path and decodes the response into out, if given
+func (c *Client) doJSON(ctx context.Context, method, path string, body any, wantStatus int, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("github API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != wantStatus {
+		respBody, _ := io.ReadAll(resp.Body)
+		return &apiError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+// Package linter checks Dockerfiles for common mistakes before an image is built.
+//
+// Lint warnings never change the Dockerfile; the build reports them in its logs and build history,
+// and only fails on them when BLOCK_ON_LINT=true.
+package linter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Rule names reported in LintWarning.Rule
+const (
+	RuleLatestBaseImage   = "latest-base-image"
+	RuleMissingExpose     = "missing-expose"
+	RulePlaintextSecret   = "plaintext-secret"
+	RuleCopyBeforeWorkdir = "copy-before-workdir"
+	RuleRootUser          = "root-user"
+)
+
+// LintWarning is one problem found in a Dockerfile
+type LintWarning struct {
+	Rule    string `json:"rule"`
+	Line    int    `json:"line"` // 1-based line of the offending instruction, 0 for the whole file
+	Message string `json:"message"`
+}
+
+func (w LintWarning) String() string {
+	if w.Line > 0 {
+		return fmt.Sprintf("line %d: %s (%s)", w.Line, w.Message, w.Rule)
+	}
+	return fmt.Sprintf("%s (%s)", w.Message, w.Rule)
+}
+
+// BlockOnLint reports whether BLOCK_ON_LINT=true makes lint warnings fail the build
+func BlockOnLint() bool {
+	return strings.EqualFold(strings.TrimSpace(os.Getenv("BLOCK_ON_LINT")), "true")
+}
+
+// instruction is one Dockerfile instruction with its continuation lines joined
+type instruction struct {
+	line    int
+	command string // Upper case: FROM, COPY, ...
+	args    string
+}
+
+var (
+	// secretNamePattern matches ENV/ARG names that usually hold credentials
+	secretNamePattern = regexp.MustCompile(`(?i)(passw(or)?d|passwd|secret|token|api[_-]?key|access[_-]?key|private[_-]?key|credentials?)`)
+	// secretValuePatterns match well-known credential formats, whatever the variable is called
+	secretValuePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`),                // AWS access key ID
+		regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`),      // GitHub token
+		regexp.MustCompile(`\bsk_(live|test)_[A-Za-z0-9]{16,}\b`), // Stripe secret key
+		regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}\b`),    // Slack token
+		regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),  // PEM private key
+		regexp.MustCompile(`://[^/\s:@]+:[^/\s@]+@`),              // Credentials in a URL
+	}
+)
+
+// LintDockerfile checks a Dockerfile and returns its warnings in line order, followed by the
+// warnings about the file as a whole. The rules are:
+//   - base images are pinned to a tag other than "latest"
+//   - EXPOSE declares at least one port
+//   - ENV and ARG do not set plaintext secrets
+//   - WORKDIR is set before COPY or ADD in every stage
+//   - the final stage switches to a non-root USER
+func LintDockerfile(ctx context.Context, content string) []LintWarning {
+	instructions := parseInstructions(content)
+	var warnings []LintWarning
+
+	stages := map[string]bool{} // Names of earlier build stages, which FROM may reference
+	exposed := false
+	workdirSet := false
+	var user *instruction // Last USER of the current stage
+
+	for i := range instructions {
+		if ctx.Err() != nil {
+			return warnings
+		}
+		inst := instructions[i]
+		switch inst.command {
+		case "FROM":
+			image, alias := parseFrom(inst.args)
+			if warning, ok := checkBaseImage(inst.line, image, stages); ok {
+				warnings = append(warnings, warning)
+			}
+			if alias != "" {
+				stages[strings.ToLower(alias)] = true
+			}
+			workdirSet = false
+			user = nil
+		case "EXPOSE":
+			if strings.TrimSpace(inst.args) != "" {
+				exposed = true
+			}
+		case "ENV", "ARG":
+			warnings = append(warnings, checkSecrets(inst)...)
+		case "WORKDIR":
+			workdirSet = true
+		case "COPY", "ADD":
+			if !workdirSet {
+				warnings = append(warnings, LintWarning{
+					Rule:    RuleCopyBeforeWorkdir,
+					Line:    inst.line,
+					Message: fmt.Sprintf("%s runs before WORKDIR is set; files land relative to the base image's working directory", inst.command),
+				})
+			}
+		case "USER":
+			user = &instructions[i]
+		}
+	}
+
+	if len(instructions) == 0 {
+		return warnings
+	}
+	if !exposed {
+		warnings = append(warnings, LintWarning{
+			Rule:    RuleMissingExpose,
+			Message: "no EXPOSE instruction declares the port the application listens on",
+		})
+	}
+	switch {
+	case user == nil:
+		warnings = append(warnings, LintWarning{
+			Rule:    RuleRootUser,
+			Message: "no USER instruction in the final stage, so the container runs as root",
+		})
+	case isRootUser(user.args):
+		warnings = append(warnings, LintWarning{
+			Rule:    RuleRootUser,
+			Line:    user.line,
+			Message: "the final USER is root; switch to a non-root user",
+		})
+	}
+	return warnings
+}
+
+// parseInstructions splits a Dockerfile into instructions, joining continuation lines and skipping comments
+func parseInstructions(content string) []instruction {
+	var instructions []instruction
+	var current *instruction
+
+	for i, raw := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		line := strings.TrimSpace(raw)
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if current == nil {
+			if line == "" {
+				continue
+			}
+			fields := strings.SplitN(line, " ", 2)
+			current = &instruction{line: i + 1, command: strings.ToUpper(strings.TrimSpace(fields[0]))}
+			if len(fields) > 1 {
+				line = fields[1]
+			} else {
+				line = ""
+			}
+		}
+		continued := strings.HasSuffix(line, `\`)
+		line = strings.TrimSpace(strings.TrimSuffix(line, `\`))
+		if line != "" {
+			if current.args != "" {
+				current.args += " "
+			}
+			current.args += line
+		}
+		if !continued {
+			instructions = append(instructions, *current)
+			current = nil
+		}
+	}
+	if current != nil {
+		instructions = append(instructions, *current)
+	}
+	return instructions
+}
+
+// parseFrom returns the image and the stage name of a FROM instruction, skipping flags such as --platform
+func parseFrom(args string) (image, alias string) {
+	var fields []string
+	for _, field := range strings.Fields(args) {
+		if !strings.HasPrefix(field, "--") {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 {
+		return "", ""
+	}
+	if len(fields) >= 3 && strings.EqualFold(fields[1], "AS") {
+		alias = fields[2]
+	}
+	return fields[0], alias
+}
+
+func checkBaseImage(line int, image string, stages map[string]bool) (LintWarning, bool) {
+	if image == "" || strings.EqualFold(image, "scratch") || stages[strings.ToLower(image)] {
+		return LintWarning{}, false
+	}
+	// Images pinned by digest are immutable, and build args are resolved at build time
+	if strings.Contains(image, "@") || strings.Contains(image, "$") {
+		return LintWarning{}, false
+	}
+	tag := ""
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		tag = image[i+1:]
+	}
+	switch tag {
+	case "":
+		return LintWarning{
+			Rule:    RuleLatestBaseImage,
+			Line:    line,
+			Message: fmt.Sprintf("base image %s has no tag and resolves to latest; pin a version", image),
+		}, true
+	case "latest":
+		return LintWarning{
+			Rule:    RuleLatestBaseImage,
+			Line:    line,
+			Message: fmt.Sprintf("base image %s uses the latest tag; pin a version", image),
+		}, true
+	}
+	return LintWarning{}, false
+}
+
+// checkSecrets flags ENV/ARG variables that set a secret-looking name to a literal value,
+// or any value in a well-known credential format
+func checkSecrets(inst instruction) []LintWarning {
+	var warnings []LintWarning
+	for _, pair := range splitAssignments(inst.args) {
+		name, value := pair[0], pair[1]
+		if value == "" || strings.HasPrefix(value, "$") {
+			continue // Declared without a value, or taken from a build arg
+		}
+		flagged := secretNamePattern.MatchString(name)
+		for _, pattern := range secretValuePatterns {
+			if pattern.MatchString(value) {
+				flagged = true
+				break
+			}
+		}
+		if flagged {
+			warnings = append(warnings, LintWarning{
+				Rule:    RulePlaintextSecret,
+				Line:    inst.line,
+				Message: fmt.Sprintf("%s %s sets what looks like a plaintext secret; pass it as an environment variable or build secret instead", inst.command, name),
+			})
+		}
+	}
+	return warnings
+}
+
+// splitAssignments parses "KEY=value OTHER=\"x y\"" and the legacy "KEY value" form into name/value pairs
+func splitAssignments(args string) [][2]string {
+	args = strings.TrimSpace(args)
+	if args == "" {
+		return nil
+	}
+	first := strings.Fields(args)[0]
+	if !strings.Contains(first, "=") {
+		name := first
+		value := strings.TrimSpace(strings.TrimPrefix(args, first))
+		return [][2]string{{name, unquote(value)}}
+	}
+
+	var pairs [][2]string
+	var token strings.Builder
+	var quote rune
+	flush := func() {
+		if token.Len() == 0 {
+			return
+		}
+		name, value, _ := strings.Cut(token.String(), "=")
+		pairs = append(pairs, [2]string{name, value})
+		token.Reset()
+	}
+	for _, r := range args {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				token.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == ' ' || r == '\t':
+			flush()
+		default:
+			token.WriteRune(r)
+		}
+	}
+	flush()
+	return pairs
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+func isRootUser(args string) bool {
+	user, _, _ := strings.Cut(strings.TrimSpace(args), ":")
+	return user == "root" || user == "0"
+}
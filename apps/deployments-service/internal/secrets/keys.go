@@ -0,0 +1,238 @@
+package secrets
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// dataKey returns the unwrapped data key keyID
+func dataKey(keyID string) ([]byte, error) {
+	if cached, ok := dataKeys.Load(keyID); ok {
+		return cached.([]byte), nil
+	}
+	if !Enabled() {
+		return nil, ErrNotConfigured
+	}
+
+	var record database.EnvEncryptionKey
+	if err := database.DB.Where("id = ?", keyID).First(&record).Error; err != nil {
+		return nil, fmt.Errorf("get key %s: %w", keyID, err)
+	}
+	key, err := open(masterKey, record.WrappedKey, []byte(record.ID))
+	if err != nil {
+		return nil, fmt.Errorf("unwrap key %s: %w", keyID, err)
+	}
+	dataKeys.Store(keyID, key)
+	return key, nil
+}
+
+// currentKeyID returns the organization's active data key, creating one if it has none
+func currentKeyID(tx *gorm.DB, orgID string) (string, error) {
+	var keys []database.EnvEncryptionKey
+	if err := tx.Where("organization_id = ? AND retired_at IS NULL", orgID).
+		Order("created_at DESC").Limit(1).Find(&keys).Error; err != nil {
+		return "", fmt.Errorf("get active key: %w", err)
+	}
+	if len(keys) > 0 {
+		return keys[0].ID, nil
+	}
+	key, err := createKey(tx, orgID)
+	if err != nil {
+		return "", err
+	}
+	return key.ID, nil
+}
+
+// createKey generates a data key for the organization and stores it wrapped with the master key
+func createKey(tx *gorm.DB, orgID string) (*database.EnvEncryptionKey, error) {
+	if !Enabled() {
+		return nil, ErrNotConfigured
+	}
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+
+	record := &database.EnvEncryptionKey{
+		ID:             fmt.Sprintf("envkey-%s", uuid.NewString()),
+		OrganizationID: orgID,
+		CreatedAt:      time.Now(),
+	}
+	wrapped, err := seal(masterKey, key, []byte(record.ID))
+	if err != nil {
+		return nil, fmt.Errorf("wrap key: %w", err)
+	}
+	record.WrappedKey = wrapped
+	if err := tx.Create(record).Error; err != nil {
+		return nil, fmt.Errorf("store key: %w", err)
+	}
+	dataKeys.Store(record.ID, key)
+	return record, nil
+}
+
+// RotateKey gives the organization a new data key, re-encrypts its deployments' env vars with it
+// and retires the previous keys. It returns the new key ID and the number of deployments re-encrypted.
+func RotateKey(ctx context.Context, orgID string) (string, int, error) {
+	if !Enabled() {
+		return "", 0, ErrNotConfigured
+	}
+
+	var keyID string
+	var count int
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		key, err := createKey(tx, orgID)
+		if err != nil {
+			return err
+		}
+		keyID = key.ID
+
+		// Loading decrypts with the old keys (AfterFind)
+		var deployments []database.Deployment
+		if err := tx.Where("organization_id = ? AND encrypted_env IS NOT NULL", orgID).Find(&deployments).Error; err != nil {
+			return fmt.Errorf("get deployments: %w", err)
+		}
+		for i := range deployments {
+			if err := sealWithKey(&deployments[i], keyID); err != nil {
+				return err
+			}
+			if err := updateSealedColumns(tx, &deployments[i]); err != nil {
+				return err
+			}
+		}
+		count = len(deployments)
+
+		if err := tx.Model(&database.EnvEncryptionKey{}).
+			Where("organization_id = ? AND id <> ? AND retired_at IS NULL", orgID, keyID).
+			Update("retired_at", time.Now()).Error; err != nil {
+			return fmt.Errorf("retire keys: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		dataKeys.Delete(keyID)
+		return "", 0, err
+	}
+
+	logger.Info("[Secrets] Rotated env key for organization %s to %s (%d deployments re-encrypted)", orgID, keyID, count)
+	return keyID, count, nil
+}
+
+// MigratePlaintext encrypts the env vars of deployments that are still stored as plaintext
+func MigratePlaintext(ctx context.Context) (int, error) {
+	if !Enabled() {
+		return 0, nil
+	}
+
+	var deployments []database.Deployment
+	if err := database.DB.WithContext(ctx).Where("encrypted_env IS NULL").Find(&deployments).Error; err != nil {
+		return 0, fmt.Errorf("get plaintext deployments: %w", err)
+	}
+
+	migrated := 0
+	for i := range deployments {
+		deployment := &deployments[i]
+		if err := Cipher().Seal(deployment); err != nil {
+			logger.Warn("[Secrets] Failed to encrypt env vars of deployment %s: %v", deployment.ID, err)
+			continue
+		}
+		if err := updateSealedColumns(database.DB.WithContext(ctx), deployment); err != nil {
+			logger.Warn("[Secrets] Failed to store encrypted env vars of deployment %s: %v", deployment.ID, err)
+			continue
+		}
+		migrated++
+	}
+	return migrated, nil
+}
+
+// updateSealedColumns writes a sealed deployment's env columns without running the save hooks
+func updateSealedColumns(tx *gorm.DB, deployment *database.Deployment) error {
+	if err := tx.Model(&database.Deployment{}).Where("id = ?", deployment.ID).UpdateColumns(map[string]interface{}{
+		"env_vars":           deployment.EnvVars,
+		"env_file_content":   deployment.EnvFileContent,
+		"encrypted_env":      deployment.EncryptedEnv,
+		"encrypted_env_file": deployment.EncryptedEnvFile,
+		"env_key_id":         deployment.EnvKeyID,
+	}).Error; err != nil {
+		return fmt.Errorf("store encrypted env vars of deployment %s: %w", deployment.ID, err)
+	}
+	return nil
+}
+
+// Cipher returns the database.DeploymentEnvCipher backed by the organizations' data keys
+func Cipher() database.DeploymentEnvCipher {
+	return deploymentCipher{}
+}
+
+type deploymentCipher struct{}
+
+// Seal encrypts a deployment's env vars with its organization's active key
+func (deploymentCipher) Seal(deployment *database.Deployment) error {
+	if deployment.OrganizationID == "" {
+		return fmt.Errorf("deployment has no organization")
+	}
+	keyID, err := currentKeyID(database.DB, deployment.OrganizationID)
+	if err != nil {
+		return err
+	}
+	return sealWithKey(deployment, keyID)
+}
+
+// Open decrypts a deployment's env vars into env_vars and env_file_content
+func (deploymentCipher) Open(deployment *database.Deployment) error {
+	envVars, err := DecryptEnvVars(deployment.EncryptedEnv, deployment.EnvKeyID)
+	if err != nil {
+		return err
+	}
+	envJSON, err := json.Marshal(envVars)
+	if err != nil {
+		return fmt.Errorf("marshal env vars: %w", err)
+	}
+
+	envFileContent := ""
+	if len(deployment.EncryptedEnvFile) > 0 {
+		content, err := decrypt(deployment.EncryptedEnvFile, deployment.EnvKeyID)
+		if err != nil {
+			return err
+		}
+		envFileContent = string(content)
+	}
+
+	deployment.EnvVars = string(envJSON)
+	deployment.EnvFileContent = envFileContent
+	return nil
+}
+
+func sealWithKey(deployment *database.Deployment, keyID string) error {
+	envVars := map[string]string{}
+	if deployment.EnvVars != "" {
+		if err := json.Unmarshal([]byte(deployment.EnvVars), &envVars); err != nil {
+			return fmt.Errorf("parse env vars: %w", err)
+		}
+	}
+	encryptedEnv, err := EncryptEnvVars(envVars, keyID)
+	if err != nil {
+		return err
+	}
+	var encryptedEnvFile []byte
+	if deployment.EnvFileContent != "" {
+		if encryptedEnvFile, err = encrypt([]byte(deployment.EnvFileContent), keyID); err != nil {
+			return err
+		}
+	}
+
+	deployment.EncryptedEnv = encryptedEnv
+	deployment.EncryptedEnvFile = encryptedEnvFile
+	deployment.EnvKeyID = keyID
+	deployment.EnvVars = "{}"
+	deployment.EnvFileContent = ""
+	return nil
+}
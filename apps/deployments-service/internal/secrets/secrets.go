@@ -0,0 +1,186 @@
+// Package secrets encrypts deployment environment variables at rest.
+//
+// Every organization has a data key (AES-256) that seals its deployments' env vars with AES-256-GCM
+// and a random nonce per row. Data keys are stored in env_encryption_keys wrapped with the master key
+// read from ENV_ENCRYPTION_KEY_PATH, so a database dump alone does not reveal any env vars.
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+const keySize = 32 // AES-256
+
+var (
+	masterKey []byte
+	dataKeys  sync.Map // key ID -> unwrapped data key
+)
+
+// ErrNotConfigured is returned when no master key has been loaded
+var ErrNotConfigured = errors.New("env var encryption is not configured (ENV_ENCRYPTION_KEY_PATH)")
+
+// Init loads the master key from the file at ENV_ENCRYPTION_KEY_PATH
+// The file holds 32 bytes, raw or hex/base64 encoded. Encryption stays disabled when the variable is unset.
+func Init() error {
+	path := strings.TrimSpace(os.Getenv("ENV_ENCRYPTION_KEY_PATH"))
+	if path == "" {
+		return nil
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read master key: %w", err)
+	}
+	key, err := parseKey(raw)
+	if err != nil {
+		return fmt.Errorf("master key %s: %w", path, err)
+	}
+	masterKey = key
+	return nil
+}
+
+// Enabled reports whether a master key is loaded
+func Enabled() bool {
+	return masterKey != nil
+}
+
+// EncryptEnvVars seals env vars with the data key keyID
+func EncryptEnvVars(plaintext map[string]string, keyID string) ([]byte, error) {
+	if plaintext == nil {
+		plaintext = map[string]string{}
+	}
+	data, err := json.Marshal(plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("marshal env vars: %w", err)
+	}
+	return encrypt(data, keyID)
+}
+
+// DecryptEnvVars opens env vars sealed by EncryptEnvVars with the data key keyID
+func DecryptEnvVars(ciphertext []byte, keyID string) (map[string]string, error) {
+	data, err := decrypt(ciphertext, keyID)
+	if err != nil {
+		return nil, err
+	}
+	envVars := map[string]string{}
+	if err := json.Unmarshal(data, &envVars); err != nil {
+		return nil, fmt.Errorf("unmarshal env vars: %w", err)
+	}
+	return envVars, nil
+}
+
+// EncryptForOrganization seals arbitrary data, such as a TLS private key, with the organization's
+// active data key and returns the ciphertext and the key ID needed to open it
+func EncryptForOrganization(orgID string, plaintext []byte) ([]byte, string, error) {
+	keyID, err := currentKeyID(database.DB, orgID)
+	if err != nil {
+		return nil, "", err
+	}
+	ciphertext, err := encrypt(plaintext, keyID)
+	if err != nil {
+		return nil, "", err
+	}
+	return ciphertext, keyID, nil
+}
+
+// Decrypt opens data sealed by EncryptForOrganization with the data key keyID
+func Decrypt(ciphertext []byte, keyID string) ([]byte, error) {
+	return decrypt(ciphertext, keyID)
+}
+
+// EncryptWithMasterKey seals platform-wide secrets that belong to no organization. id is
+// authenticated, so the ciphertext only opens for the record it was sealed for.
+func EncryptWithMasterKey(plaintext []byte, id string) ([]byte, error) {
+	if !Enabled() {
+		return nil, ErrNotConfigured
+	}
+	return seal(masterKey, plaintext, []byte(id))
+}
+
+// DecryptWithMasterKey opens data sealed by EncryptWithMasterKey for id
+func DecryptWithMasterKey(ciphertext []byte, id string) ([]byte, error) {
+	if !Enabled() {
+		return nil, ErrNotConfigured
+	}
+	return open(masterKey, ciphertext, []byte(id))
+}
+
+func encrypt(plaintext []byte, keyID string) ([]byte, error) {
+	key, err := dataKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return seal(key, plaintext, []byte(keyID))
+}
+
+func decrypt(ciphertext []byte, keyID string) ([]byte, error) {
+	key, err := dataKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return open(key, ciphertext, []byte(keyID))
+}
+
+// seal encrypts plaintext with AES-256-GCM; the random nonce is prepended to the ciphertext
+// additionalData (the key ID) is authenticated, so a row cannot be passed off under another key
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(key, ciphertext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, sealed, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// parseKey accepts a 32 byte key as raw bytes, hex or base64
+func parseKey(raw []byte) ([]byte, error) {
+	if len(raw) == keySize {
+		return raw, nil
+	}
+	text := strings.TrimSpace(string(raw))
+	if key, err := hex.DecodeString(text); err == nil && len(key) == keySize {
+		return key, nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(text); err == nil && len(key) == keySize {
+		return key, nil
+	}
+	return nil, fmt.Errorf("must be %d bytes (raw, hex or base64)", keySize)
+}
@@ -0,0 +1,201 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestEncryptDecryptEnvVars(t *testing.T) {
+	db := newSecretsTestDB(t)
+	keyID, err := currentKeyID(db, "org-a")
+	if err != nil {
+		t.Fatalf("currentKeyID: %v", err)
+	}
+
+	envVars := map[string]string{"DATABASE_URL": "postgres://secret", "API_KEY": "abc123"}
+	first, err := EncryptEnvVars(envVars, keyID)
+	if err != nil {
+		t.Fatalf("EncryptEnvVars: %v", err)
+	}
+	second, err := EncryptEnvVars(envVars, keyID)
+	if err != nil {
+		t.Fatalf("EncryptEnvVars: %v", err)
+	}
+	if bytes.Equal(first, second) {
+		t.Fatal("ciphertexts are equal, want a fresh nonce per encryption")
+	}
+	if bytes.Contains(first, []byte("postgres://secret")) {
+		t.Fatal("ciphertext contains plaintext")
+	}
+
+	got, err := DecryptEnvVars(first, keyID)
+	if err != nil {
+		t.Fatalf("DecryptEnvVars: %v", err)
+	}
+	if !reflect.DeepEqual(got, envVars) {
+		t.Fatalf("DecryptEnvVars = %v, want %v", got, envVars)
+	}
+
+	tampered := append([]byte(nil), first...)
+	tampered[len(tampered)-1] ^= 0xff
+	if _, err := DecryptEnvVars(tampered, keyID); err == nil {
+		t.Fatal("DecryptEnvVars accepted a tampered ciphertext")
+	}
+
+	otherKeyID, err := currentKeyID(db, "org-b")
+	if err != nil {
+		t.Fatalf("currentKeyID: %v", err)
+	}
+	if _, err := DecryptEnvVars(first, otherKeyID); err == nil {
+		t.Fatal("DecryptEnvVars decrypted with another organization's key")
+	}
+}
+
+func TestDeploymentEnvEncryptedAtRest(t *testing.T) {
+	db := newSecretsTestDB(t)
+	database.SetDeploymentEnvCipher(Cipher())
+
+	deployment := &database.Deployment{
+		ID:             "dep-1",
+		OrganizationID: "org-a",
+		EnvVars:        `{"API_KEY":"abc123"}`,
+		EnvFileContent: "# api\nAPI_KEY=abc123\n",
+	}
+	if err := db.Create(deployment).Error; err != nil {
+		t.Fatalf("create deployment: %v", err)
+	}
+	if deployment.EnvVars != `{"API_KEY":"abc123"}` {
+		t.Fatalf("EnvVars after create = %q, want plaintext restored", deployment.EnvVars)
+	}
+
+	var raw struct {
+		EnvVars        string
+		EnvFileContent string
+		EncryptedEnv   []byte
+		EnvKeyID       string
+	}
+	db.Table("deployments").Select("env_vars, env_file_content, encrypted_env, env_key_id").Where("id = ?", "dep-1").Scan(&raw)
+	if raw.EnvVars != "{}" || raw.EnvFileContent != "" || len(raw.EncryptedEnv) == 0 || raw.EnvKeyID == "" {
+		t.Fatalf("stored row = %+v, want only encrypted env", raw)
+	}
+
+	var loaded database.Deployment
+	if err := db.First(&loaded, "id = ?", "dep-1").Error; err != nil {
+		t.Fatalf("load deployment: %v", err)
+	}
+	if loaded.EnvVars != `{"API_KEY":"abc123"}` || loaded.EnvFileContent != "# api\nAPI_KEY=abc123\n" {
+		t.Fatalf("loaded env = %q / %q, want decrypted values", loaded.EnvVars, loaded.EnvFileContent)
+	}
+
+	newKeyID, count, err := RotateKey(context.Background(), "org-a")
+	if err != nil {
+		t.Fatalf("RotateKey: %v", err)
+	}
+	if count != 1 || newKeyID == raw.EnvKeyID {
+		t.Fatalf("RotateKey = %s, %d; want a new key and 1 deployment", newKeyID, count)
+	}
+
+	var rotated database.Deployment
+	if err := db.First(&rotated, "id = ?", "dep-1").Error; err != nil {
+		t.Fatalf("load rotated deployment: %v", err)
+	}
+	if rotated.EnvKeyID != newKeyID || rotated.EnvVars != `{"API_KEY":"abc123"}` {
+		t.Fatalf("rotated deployment key = %s env = %q", rotated.EnvKeyID, rotated.EnvVars)
+	}
+
+	var oldKey database.EnvEncryptionKey
+	db.First(&oldKey, "id = ?", raw.EnvKeyID)
+	if oldKey.RetiredAt == nil {
+		t.Fatal("previous key was not retired")
+	}
+}
+
+func TestMigratePlaintext(t *testing.T) {
+	db := newSecretsTestDB(t)
+
+	// Written before encryption was enabled
+	if err := db.Create(&database.Deployment{ID: "dep-1", OrganizationID: "org-a", EnvVars: `{"TOKEN":"t0k3n"}`}).Error; err != nil {
+		t.Fatalf("create deployment: %v", err)
+	}
+	database.SetDeploymentEnvCipher(Cipher())
+
+	migrated, err := MigratePlaintext(context.Background())
+	if err != nil {
+		t.Fatalf("MigratePlaintext: %v", err)
+	}
+	if migrated != 1 {
+		t.Fatalf("migrated = %d, want 1", migrated)
+	}
+
+	var envVars string
+	db.Table("deployments").Select("env_vars").Where("id = ?", "dep-1").Scan(&envVars)
+	if envVars != "{}" {
+		t.Fatalf("stored env_vars = %q, want {}", envVars)
+	}
+
+	var loaded database.Deployment
+	if err := db.First(&loaded, "id = ?", "dep-1").Error; err != nil {
+		t.Fatalf("load deployment: %v", err)
+	}
+	if loaded.EnvVars != `{"TOKEN":"t0k3n"}` {
+		t.Fatalf("loaded env = %q, want decrypted value", loaded.EnvVars)
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	key := bytes.Repeat([]byte{0xab}, keySize)
+	for _, raw := range [][]byte{
+		key,
+		[]byte("abababababababababababababababababababababababababababababababab\n"),
+		[]byte("q6urq6urq6urq6urq6urq6urq6urq6urq6urq6urq6s="),
+	} {
+		got, err := parseKey(raw)
+		if err != nil {
+			t.Fatalf("parseKey(%q): %v", raw, err)
+		}
+		if !bytes.Equal(got, key) {
+			t.Fatalf("parseKey(%q) = %x", raw, got)
+		}
+	}
+	if _, err := parseKey([]byte("too short")); err == nil {
+		t.Fatal("parseKey accepted a short key")
+	}
+}
+
+func newSecretsTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(&database.Deployment{}, &database.EnvEncryptionKey{}); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("generate master key: %v", err)
+	}
+
+	previousDB := database.DB
+	database.DB = db
+	masterKey = key
+	t.Cleanup(func() {
+		database.DB = previousDB
+		database.SetDeploymentEnvCipher(nil)
+		masterKey = nil
+		dataKeys = sync.Map{}
+	})
+
+	return db
+}
@@ -0,0 +1,101 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/orchestrator"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+
+	"connectrpc.com/connect"
+)
+
+// maxDeploymentAffinityRules bounds the rules a single deployment can carry
+const maxDeploymentAffinityRules = 10
+
+// SetDeploymentAffinityRules replaces the affinity rules used when the deployment is placed on a node
+func (s *Service) SetDeploymentAffinityRules(ctx context.Context, req *connect.Request[deploymentsv1.SetDeploymentAffinityRulesRequest]) (*connect.Response[deploymentsv1.SetDeploymentAffinityRulesResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+
+	if err := s.checkDeploymentOrgAccess(ctx, orgID, deploymentID, auth.PermissionDeploymentUpdate); err != nil {
+		return nil, err
+	}
+
+	if len(req.Msg.GetRules()) > maxDeploymentAffinityRules {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a deployment can have at most %d affinity rules", maxDeploymentAffinityRules))
+	}
+	rules := make([]database.DeploymentAffinityRule, 0, len(req.Msg.GetRules()))
+	for _, rule := range req.Msg.GetRules() {
+		affinityRule := orchestrator.AffinityRule{
+			RuleType:   strings.TrimSpace(rule.GetRuleType()),
+			LabelKey:   strings.TrimSpace(rule.GetLabelKey()),
+			LabelValue: strings.TrimSpace(rule.GetLabelValue()),
+		}
+		if err := affinityRule.Validate(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		rules = append(rules, database.DeploymentAffinityRule{
+			RuleType:   affinityRule.RuleType,
+			LabelKey:   affinityRule.LabelKey,
+			LabelValue: affinityRule.LabelValue,
+		})
+	}
+
+	if err := database.SetDeploymentAffinityRules(deploymentID, rules); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&deploymentsv1.SetDeploymentAffinityRulesResponse{
+		Rules: affinityRulesToProto(rules),
+	}), nil
+}
+
+// GetDeploymentAffinityRules returns the affinity rules of a deployment
+func (s *Service) GetDeploymentAffinityRules(ctx context.Context, req *connect.Request[deploymentsv1.GetDeploymentAffinityRulesRequest]) (*connect.Response[deploymentsv1.GetDeploymentAffinityRulesResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+
+	if err := s.checkDeploymentOrgAccess(ctx, orgID, deploymentID, auth.PermissionDeploymentRead); err != nil {
+		return nil, err
+	}
+
+	rules, err := database.GetDeploymentAffinityRules(deploymentID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&deploymentsv1.GetDeploymentAffinityRulesResponse{
+		Rules: affinityRulesToProto(rules),
+	}), nil
+}
+
+// checkDeploymentOrgAccess verifies the permission on the deployment and that it belongs to the organization
+func (s *Service) checkDeploymentOrgAccess(ctx context.Context, orgID, deploymentID, permission string) error {
+	if err := s.permissionChecker.CheckScopedPermission(ctx, orgID, auth.ScopedPermission{Permission: permission, ResourceType: "deployment", ResourceID: deploymentID}); err != nil {
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	dbDeployment, err := s.repo.GetByID(ctx, deploymentID)
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentID))
+	}
+	if dbDeployment.OrganizationID != orgID {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("deployment does not belong to organization"))
+	}
+	return nil
+}
+
+func affinityRulesToProto(rules []database.DeploymentAffinityRule) []*deploymentsv1.AffinityRule {
+	out := make([]*deploymentsv1.AffinityRule, len(rules))
+	for i, rule := range rules {
+		out[i] = &deploymentsv1.AffinityRule{
+			RuleType:   rule.RuleType,
+			LabelKey:   rule.LabelKey,
+			LabelValue: rule.LabelValue,
+		}
+	}
+	return out
+}
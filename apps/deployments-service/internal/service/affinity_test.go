@@ -0,0 +1,71 @@
+package deployments
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+)
+
+func TestDeploymentAffinityRules(t *testing.T) {
+	db := newTestDB(t,
+		&database.Deployment{},
+		&database.DeploymentAffinityRule{},
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.OrgRole{},
+		&database.OrgRoleBinding{},
+		&database.ResourceTag{},
+	)
+	seedDeploymentServiceIsolationData(t, db)
+
+	service := NewService(context.Background(), database.NewDeploymentRepository(db, nil), nil, nil)
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	if _, err := service.SetDeploymentAffinityRules(ctx, connect.NewRequest(&deploymentsv1.SetDeploymentAffinityRulesRequest{
+		OrganizationId: "org-a",
+		DeploymentId:   "dep-org-a-owner",
+		Rules:          []*deploymentsv1.AffinityRule{{RuleType: "spread", LabelKey: "app", LabelValue: "web"}},
+	})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("unknown rule type code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+	}
+
+	rules := []*deploymentsv1.AffinityRule{
+		{RuleType: "anti-affinity", LabelKey: "app", LabelValue: "web"},
+		{RuleType: "affinity", LabelKey: "tier", LabelValue: "cache"},
+	}
+	if _, err := service.SetDeploymentAffinityRules(ctx, connect.NewRequest(&deploymentsv1.SetDeploymentAffinityRulesRequest{
+		OrganizationId: "org-a", DeploymentId: "dep-org-a-owner", Rules: rules,
+	})); err != nil {
+		t.Fatalf("SetDeploymentAffinityRules: %v", err)
+	}
+	res, err := service.GetDeploymentAffinityRules(ctx, connect.NewRequest(&deploymentsv1.GetDeploymentAffinityRulesRequest{
+		OrganizationId: "org-a", DeploymentId: "dep-org-a-owner",
+	}))
+	if err != nil {
+		t.Fatalf("GetDeploymentAffinityRules: %v", err)
+	}
+	if got := res.Msg.GetRules(); len(got) != 2 || got[0].GetRuleType() != "anti-affinity" || got[1].GetLabelValue() != "cache" {
+		t.Fatalf("rules = %v, want the rules that were set", got)
+	}
+
+	// Setting rules replaces the existing ones
+	if _, err := service.SetDeploymentAffinityRules(ctx, connect.NewRequest(&deploymentsv1.SetDeploymentAffinityRulesRequest{
+		OrganizationId: "org-a", DeploymentId: "dep-org-a-owner",
+	})); err != nil {
+		t.Fatalf("clear affinity rules: %v", err)
+	}
+	if stored, _ := database.GetDeploymentAffinityRules("dep-org-a-owner"); len(stored) != 0 {
+		t.Fatalf("%d rules left after clearing", len(stored))
+	}
+
+	if _, err := service.GetDeploymentAffinityRules(ctx, connect.NewRequest(&deploymentsv1.GetDeploymentAffinityRulesRequest{
+		OrganizationId: "org-b", DeploymentId: "dep-org-b-owner",
+	})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("cross-org get code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+	}
+}
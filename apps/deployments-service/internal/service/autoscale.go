@@ -0,0 +1,149 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/orchestrator"
+	"github.com/obiente/cloud/apps/shared/pkg/quota"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SetAutoScalePolicy creates or replaces the auto-scaling policy of a deployment and enables it
+func (s *Service) SetAutoScalePolicy(ctx context.Context, req *connect.Request[deploymentsv1.SetAutoScalePolicyRequest]) (*connect.Response[deploymentsv1.SetAutoScalePolicyResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+
+	if err := s.checkDeploymentOrgAccess(ctx, orgID, deploymentID, auth.PermissionDeploymentScale); err != nil {
+		return nil, err
+	}
+
+	policy := orchestrator.AutoScalePolicy{
+		MinReplicas:       req.Msg.GetMinReplicas(),
+		MaxReplicas:       req.Msg.GetMaxReplicas(),
+		TargetCPUPercent:  req.Msg.GetTargetCpuPercent(),
+		ScaleUpCooldown:   time.Duration(req.Msg.GetScaleUpCooldownSeconds()) * time.Second,
+		ScaleDownCooldown: time.Duration(req.Msg.GetScaleDownCooldownSeconds()) * time.Second,
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if maxReplicas := quota.GetMaxReplicas(orgID); maxReplicas > 0 && int(policy.MaxReplicas) > maxReplicas {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("max_replicas exceeds the plan limit of %d replicas", maxReplicas))
+	}
+
+	dbDep, err := s.repo.GetByID(ctx, deploymentID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentID))
+	}
+	if dbDep.ComposeYaml != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("compose deployments cannot be auto-scaled"))
+	}
+
+	now := time.Now()
+	record := database.DeploymentAutoScalePolicy{
+		DeploymentID:             deploymentID,
+		OrganizationID:           orgID,
+		MinReplicas:              policy.MinReplicas,
+		MaxReplicas:              policy.MaxReplicas,
+		TargetCPUPercent:         policy.TargetCPUPercent,
+		ScaleUpCooldownSeconds:   req.Msg.GetScaleUpCooldownSeconds(),
+		ScaleDownCooldownSeconds: req.Msg.GetScaleDownCooldownSeconds(),
+		Enabled:                  true,
+		CreatedAt:                now,
+		UpdatedAt:                now,
+	}
+	if user, err := auth.GetUserFromContext(ctx); err == nil && user != nil {
+		record.UpdatedBy = user.Id
+	}
+	// Replacing a policy keeps its creation time and last scaling, so the cooldowns carry over
+	if err := database.DB.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "deployment_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"min_replicas", "max_replicas", "target_cpu_percent", "scale_up_cooldown_seconds",
+			"scale_down_cooldown_seconds", "enabled", "updated_by", "updated_at",
+		}),
+	}).Create(&record).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save auto-scaling policy: %w", err))
+	}
+
+	stored, err := database.GetDeploymentAutoScalePolicy(deploymentID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get auto-scaling policy: %w", err))
+	}
+
+	return connect.NewResponse(&deploymentsv1.SetAutoScalePolicyResponse{
+		Policy: autoScalePolicyToProto(stored),
+	}), nil
+}
+
+// GetAutoScalePolicy returns the auto-scaling policy of a deployment, enabled or not
+func (s *Service) GetAutoScalePolicy(ctx context.Context, req *connect.Request[deploymentsv1.GetAutoScalePolicyRequest]) (*connect.Response[deploymentsv1.GetAutoScalePolicyResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+
+	if err := s.checkDeploymentOrgAccess(ctx, orgID, deploymentID, auth.PermissionDeploymentRead); err != nil {
+		return nil, err
+	}
+
+	policy, err := database.GetDeploymentAutoScalePolicy(deploymentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return connect.NewResponse(&deploymentsv1.GetAutoScalePolicyResponse{}), nil
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get auto-scaling policy: %w", err))
+	}
+
+	return connect.NewResponse(&deploymentsv1.GetAutoScalePolicyResponse{
+		Policy: autoScalePolicyToProto(policy),
+	}), nil
+}
+
+// DisableAutoScale stops auto-scaling a deployment. The policy is kept so it can be re-enabled
+// with SetAutoScalePolicy, and the deployment keeps its current replica count.
+func (s *Service) DisableAutoScale(ctx context.Context, req *connect.Request[deploymentsv1.DisableAutoScaleRequest]) (*connect.Response[deploymentsv1.DisableAutoScaleResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+
+	if err := s.checkDeploymentOrgAccess(ctx, orgID, deploymentID, auth.PermissionDeploymentScale); err != nil {
+		return nil, err
+	}
+
+	result := database.DB.Model(&database.DeploymentAutoScalePolicy{}).
+		Where("deployment_id = ?", deploymentID).
+		Updates(map[string]interface{}{"enabled": false, "updated_at": time.Now()})
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to disable auto-scaling: %w", result.Error))
+	}
+	if result.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s has no auto-scaling policy", deploymentID))
+	}
+
+	return connect.NewResponse(&deploymentsv1.DisableAutoScaleResponse{Success: true}), nil
+}
+
+func autoScalePolicyToProto(policy *database.DeploymentAutoScalePolicy) *deploymentsv1.AutoScalePolicy {
+	out := &deploymentsv1.AutoScalePolicy{
+		DeploymentId:             policy.DeploymentID,
+		MinReplicas:              policy.MinReplicas,
+		MaxReplicas:              policy.MaxReplicas,
+		TargetCpuPercent:         policy.TargetCPUPercent,
+		ScaleUpCooldownSeconds:   policy.ScaleUpCooldownSeconds,
+		ScaleDownCooldownSeconds: policy.ScaleDownCooldownSeconds,
+		Enabled:                  policy.Enabled,
+		UpdatedAt:                timestamppb.New(policy.UpdatedAt),
+	}
+	if policy.LastScaledAt != nil {
+		out.LastScaledAt = timestamppb.New(*policy.LastScaledAt)
+	}
+	return out
+}
@@ -13,6 +13,8 @@ import (
 	"strconv"
 	"strings"
 
+	"deployments-service/internal/linter"
+
 	"github.com/obiente/cloud/apps/shared/pkg/database"
 	"github.com/obiente/cloud/apps/shared/pkg/orchestrator"
 	"github.com/obiente/cloud/apps/shared/pkg/platform"
@@ -74,6 +76,7 @@ type BuildResult struct {
 	Port           int    // Exposed port
 	Success        bool
 	Error          error
+	LintWarnings   []linter.LintWarning // Dockerfile lint warnings (Dockerfile builds only)
 }
 
 // BuildStrategyRegistry manages available build strategies
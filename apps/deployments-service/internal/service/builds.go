@@ -2,9 +2,12 @@ package deployments
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 
+	"deployments-service/internal/linter"
+
 	"github.com/obiente/cloud/apps/shared/pkg/auth"
 	"github.com/obiente/cloud/apps/shared/pkg/database"
 	"github.com/obiente/cloud/apps/shared/pkg/logger"
@@ -275,6 +278,18 @@ func dbBuildToProto(build *database.BuildHistory) *deploymentsv1.Build {
 	if build.Error != nil {
 		protoBuild.Error = build.Error
 	}
+	if build.LintWarnings != nil && *build.LintWarnings != "" {
+		var lintWarnings []linter.LintWarning
+		if err := json.Unmarshal([]byte(*build.LintWarnings), &lintWarnings); err == nil {
+			for _, warning := range lintWarnings {
+				protoBuild.LintWarnings = append(protoBuild.LintWarnings, &deploymentsv1.DockerfileLintWarning{
+					Rule:    warning.Rule,
+					Line:    int32(warning.Line),
+					Message: warning.Message,
+				})
+			}
+		}
+	}
 
 	return protoBuild
 }
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strings"
 
+	"deployments-service/internal/secrets"
+
 	"github.com/obiente/cloud/apps/shared/pkg/auth"
 	"github.com/obiente/cloud/apps/shared/pkg/orchestrator"
 
@@ -85,10 +87,35 @@ func (s *Service) UpdateDeploymentEnvVars(ctx context.Context, req *connect.Requ
 
 	// Reload to get updated state
 	dbDep, _ := s.repo.GetByID(ctx, deploymentID)
+	if dbDep != nil {
+		// New env vars make a new version, so the previous ones can be rolled back to
+		s.recordDeploymentVersion(ctx, dbDep)
+	}
 	res := connect.NewResponse(&deploymentsv1.UpdateDeploymentEnvVarsResponse{Deployment: dbDeploymentToProto(dbDep)})
 	return res, nil
 }
 
+// RotateEnvKey rotates the organization's env var encryption key and re-encrypts its deployments with it
+func (s *Service) RotateEnvKey(ctx context.Context, req *connect.Request[deploymentsv1.RotateEnvKeyRequest]) (*connect.Response[deploymentsv1.RotateEnvKeyResponse], error) {
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.permissionChecker.CheckScopedPermission(ctx, orgID, auth.ScopedPermission{Permission: auth.PermissionOrganizationUpdate, ResourceType: "organization", ResourceID: orgID}); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if !secrets.Enabled() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, secrets.ErrNotConfigured)
+	}
+
+	keyID, count, err := secrets.RotateKey(ctx, orgID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to rotate env key: %w", err))
+	}
+
+	return connect.NewResponse(&deploymentsv1.RotateEnvKeyResponse{
+		KeyId:                  keyID,
+		DeploymentsReencrypted: int32(count),
+	}), nil
+}
+
 // parseEnvVars parses environment variables from JSON string stored in database
 func parseEnvVars(envVarsJSON string) map[string]string {
 	if envVarsJSON == "" {
@@ -59,6 +59,7 @@ func dbDeploymentToProto(db *database.Deployment) *deploymentsv1.Deployment {
 	if db.AutoDeploy != nil {
 		deployment.AutoDeploy = proto.Bool(*db.AutoDeploy)
 	}
+	deployment.IsPreview = db.IsPreview
 	if db.BuildCommand != nil {
 		deployment.BuildCommand = proto.String(*db.BuildCommand)
 	}
@@ -104,6 +105,15 @@ func dbDeploymentToProto(db *database.Deployment) *deploymentsv1.Deployment {
 	if db.HealthcheckCustomCommand != nil {
 		deployment.HealthcheckCustomCommand = proto.String(*db.HealthcheckCustomCommand)
 	}
+	if db.HealthcheckInterval != nil {
+		deployment.HealthcheckIntervalSeconds = proto.Int32(*db.HealthcheckInterval)
+	}
+	if db.HealthcheckTimeout != nil {
+		deployment.HealthcheckTimeoutSeconds = proto.Int32(*db.HealthcheckTimeout)
+	}
+	if db.HealthcheckRetries != nil {
+		deployment.HealthcheckRetries = proto.Int32(*db.HealthcheckRetries)
+	}
 
 	// Runtime fields
 	if db.Image != nil {
@@ -115,6 +125,8 @@ func dbDeploymentToProto(db *database.Deployment) *deploymentsv1.Deployment {
 	if db.Replicas != nil {
 		deployment.Replicas = proto.Int32(*db.Replicas)
 	}
+	deployment.ActiveColor = db.ActiveColor
+	deployment.ZeroDowntimeDeploy = db.ZeroDowntimeDeploy
 
 	// Per-deployment resource limits (stored in DB as memory_bytes + cpu_shares)
 	if db.CPUShares != nil && *db.CPUShares > 0 {
@@ -187,6 +199,7 @@ func protoToDBDeployment(protoDep *deploymentsv1.Deployment, orgID string, creat
 		autoDeploy := protoDep.GetAutoDeploy()
 		db.AutoDeploy = &autoDeploy
 	}
+	db.IsPreview = protoDep.GetIsPreview()
 	if protoDep.BuildCommand != nil {
 		buildCmd := protoDep.GetBuildCommand()
 		db.BuildCommand = &buildCmd
@@ -249,6 +262,18 @@ func protoToDBDeployment(protoDep *deploymentsv1.Deployment, orgID string, creat
 			db.HealthcheckCustomCommand = &hcCmd
 		}
 	}
+	if protoDep.HealthcheckIntervalSeconds != nil {
+		hcInterval := protoDep.GetHealthcheckIntervalSeconds()
+		db.HealthcheckInterval = &hcInterval
+	}
+	if protoDep.HealthcheckTimeoutSeconds != nil {
+		hcTimeout := protoDep.GetHealthcheckTimeoutSeconds()
+		db.HealthcheckTimeout = &hcTimeout
+	}
+	if protoDep.HealthcheckRetries != nil {
+		hcRetries := protoDep.GetHealthcheckRetries()
+		db.HealthcheckRetries = &hcRetries
+	}
 
 	if protoDep.Image != nil {
 		img := protoDep.GetImage()
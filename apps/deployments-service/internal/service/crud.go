@@ -3,9 +3,9 @@ package deployments
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
-	"math"
 	containerpath "path"
 	"strings"
 	"time"
@@ -71,6 +71,16 @@ func (s *Service) ListDeployments(ctx context.Context, req *connect.Request[depl
 		filters.Status = &statusVal
 	}
 
+	// Add tag filter if provided; every key=value pair must match
+	if tags := req.Msg.GetTags(); len(tags) > 0 {
+		for key, value := range tags {
+			if err := database.ValidateResourceTag(key, value); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+		}
+		filters.Tags = tags
+	}
+
 	// Get deployments filtered by organization and user ID
 	dbDeployments, err := s.repo.GetAll(ctx, orgID, filters)
 	if err != nil {
@@ -174,6 +184,26 @@ func (s *Service) CreateDeployment(ctx context.Context, req *connect.Request[dep
 	}); err != nil {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("quota check failed: %w", err))
 	}
+	// The new deployment starts with the default resources
+	if err := s.validateDeploymentResources(ctx, orgID, nil, nil, nil, ""); err != nil {
+		return nil, err
+	}
+	regions, err := normalizeDeploymentRegions(req.Msg.GetRegions())
+	if err != nil {
+		return nil, err
+	}
+	teamID := strings.TrimSpace(req.Msg.GetTeamId())
+	if teamID != "" {
+		if err := s.quotaChecker.CheckTeamQuota(orgID, teamID); err != nil {
+			if errors.Is(err, quota.ErrTeamNotFound) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			if errors.Is(err, quota.ErrTeamQuotaExceeded) {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("quota check failed: %w", err))
+			}
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
 
 	id := fmt.Sprintf("deploy-%s", uuid.NewString())
 
@@ -211,12 +241,24 @@ func (s *Service) CreateDeployment(ctx context.Context, req *connect.Request[dep
 		Size:            "--",
 		CreatedAt:       timestamppb.Now(),
 		EnvVars:         map[string]string{},
+		IsPreview:       req.Msg.GetIsPreview(),
 	}
 
 	dbDeployment := protoToDBDeployment(deployment, orgID, userInfo.Id)
 	if err := s.repo.Create(ctx, dbDeployment); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create deployment: %w", err))
 	}
+	if teamID != "" {
+		if err := database.SetResourceTag(orgID, database.ResourceTagTypeDeployment, id, database.ResourceTagKeyTeam, teamID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to assign deployment to team: %w", err))
+		}
+	}
+
+	// Each region is provisioned on its own; the first region is the primary and the others replicas
+	var regionRecords []database.DeploymentRegion
+	if len(regions) > 0 {
+		regionRecords = s.provisionDeploymentRegions(ctx, orgID, id, regions, regions[0], req.Header().Get("Authorization"))
+	}
 
 	// Fetch the latest deployment from database to ensure all fields are included in response
 	updatedDeployment, err := s.repo.GetByID(ctx, id)
@@ -227,7 +269,13 @@ func (s *Service) CreateDeployment(ctx context.Context, req *connect.Request[dep
 		deployment = dbDeploymentToProto(updatedDeployment)
 	}
 
-	res := connect.NewResponse(&deploymentsv1.CreateDeploymentResponse{Deployment: deployment})
+	res := connect.NewResponse(&deploymentsv1.CreateDeploymentResponse{
+		Deployment: deployment,
+		TraefikIps: deploymentRegionTraefikIPs(regionRecords),
+	})
+	for i := range regionRecords {
+		res.Msg.Regions = append(res.Msg.Regions, deploymentRegionToProto(&regionRecords[i], deploymentRegionHealth(ctx, &regionRecords[i])))
+	}
 	return res, nil
 }
 
@@ -309,7 +357,8 @@ func (s *Service) UpdateDeployment(ctx context.Context, req *connect.Request[dep
 	ctx = orchestrator.WithTargetNode(ctx, req.Header().Get(orchestrator.ForwardTargetNodeHeader))
 	// Check if user has edit permission for this deployment
 	deploymentID := req.Msg.GetDeploymentId()
-	if shouldForward, targetNodeID := s.getDeploymentForwardTarget(ctx, deploymentID); shouldForward {
+	// Region-scoped updates stay here; each region is provisioned on a node of its own
+	if shouldForward, targetNodeID := s.getDeploymentForwardTarget(ctx, deploymentID); shouldForward && len(req.Msg.GetRegions()) == 0 {
 		reqBody, _ := json.Marshal(req.Msg)
 		headers := map[string]string{
 			"Authorization":                      req.Header().Get("Authorization"),
@@ -336,6 +385,10 @@ func (s *Service) UpdateDeployment(ctx context.Context, req *connect.Request[dep
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentID))
 	}
+	regions, primaryRegion, err := regionsToReprovision(deploymentID, req.Msg.GetRegions())
+	if err != nil {
+		return nil, err
+	}
 
 	// Update deployment fields (only update if provided)
 	if req.Msg.Name != nil {
@@ -361,6 +414,9 @@ func (s *Service) UpdateDeployment(ctx context.Context, req *connect.Request[dep
 		autoDeploy := req.Msg.GetAutoDeploy()
 		dbDeployment.AutoDeploy = &autoDeploy
 	}
+	if req.Msg.ZeroDowntimeDeploy != nil {
+		dbDeployment.ZeroDowntimeDeploy = req.Msg.GetZeroDowntimeDeploy()
+	}
 	if req.Msg.Branch != nil {
 		branch := req.Msg.GetBranch()
 		dbDeployment.Branch = branch
@@ -521,43 +577,44 @@ func (s *Service) UpdateDeployment(ctx context.Context, req *connect.Request[dep
 	// Per-deployment resource limits (overrides)
 	// Stored in DB as cpu_shares + memory_bytes for Docker.
 	// Clearing semantics: if client sends 0, clear the override (set NULL) to fall back to defaults.
-	// Cap values to organization plan limits before storing.
+	// Limits outside the minimums or the organization's plan are rejected.
 	if req.Msg.CpuLimit != nil || req.Msg.MemoryLimit != nil {
-		// Get organization plan limits
-		maxMemoryBytes, maxCPUCores, planErr := quota.GetEffectiveLimits(dbDeployment.OrganizationID)
-
 		if req.Msg.CpuLimit != nil {
-			cpuLimit := req.Msg.GetCpuLimit()
-			if cpuLimit <= 0 {
+			if cpuLimit := req.Msg.GetCpuLimit(); cpuLimit <= 0 {
 				dbDeployment.CPUShares = nil
 			} else {
-				// Cap to plan limit if set
-				if planErr == nil && maxCPUCores > 0 && cpuLimit > float64(maxCPUCores) {
-					cpuLimit = float64(maxCPUCores)
-					log.Printf("[UpdateDeployment] Capping CPU limit for deployment %s from %.2f to plan limit %d cores", deploymentID, req.Msg.GetCpuLimit(), maxCPUCores)
-				}
-				shares := int64(math.Round(cpuLimit * 1024.0))
-				if shares < 1 {
-					shares = 1
-				}
+				shares := cpuSharesFromCores(cpuLimit)
 				dbDeployment.CPUShares = &shares
 			}
 		}
 		if req.Msg.MemoryLimit != nil {
-			memMB := req.Msg.GetMemoryLimit()
-			if memMB <= 0 {
+			if memMB := req.Msg.GetMemoryLimit(); memMB <= 0 {
 				dbDeployment.MemoryBytes = nil
 			} else {
 				bytes := memMB * 1024 * 1024
-				// Cap to plan limit if set
-				if planErr == nil && maxMemoryBytes > 0 && bytes > maxMemoryBytes {
-					bytes = maxMemoryBytes
-					log.Printf("[UpdateDeployment] Capping memory limit for deployment %s from %d MB to plan limit %d bytes (%d MB)",
-						deploymentID, memMB, maxMemoryBytes, maxMemoryBytes/(1024*1024))
-				}
 				dbDeployment.MemoryBytes = &bytes
 			}
 		}
+
+		plan, err := containerResourcePlanFor(dbDeployment.OrganizationID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load plan limits: %w", err))
+		}
+		// Limits that are not overridden are validated at their defaults
+		cpuShares, memoryBytes := quota.DefaultDeploymentCPUShares, quota.DefaultDeploymentMemoryBytes
+		if dbDeployment.CPUShares != nil {
+			cpuShares = *dbDeployment.CPUShares
+		}
+		if dbDeployment.MemoryBytes != nil {
+			memoryBytes = *dbDeployment.MemoryBytes
+		}
+		if err := plan.validateContainerResources(cpuCoresFromShares(cpuShares), memoryBytes/(1024*1024), 0); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		if err := s.validateDeploymentResources(ctx, dbDeployment.OrganizationID, dbDeployment.CPUShares, dbDeployment.MemoryBytes, dbDeployment.Replicas, deploymentID); err != nil {
+			return nil, err
+		}
 	}
 	// Handle groups (repeated string -> JSON array)
 	if len(req.Msg.GetGroups()) > 0 {
@@ -676,6 +733,12 @@ func (s *Service) UpdateDeployment(ctx context.Context, req *connect.Request[dep
 	if err := s.repo.Update(ctx, dbDeployment); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update deployment: %w", err))
 	}
+	s.recordDeploymentVersion(ctx, dbDeployment)
+
+	// Only the listed regions of a multi-region deployment are provisioned with the update
+	if len(regions) > 0 {
+		s.provisionDeploymentRegions(ctx, dbDeployment.OrganizationID, deploymentID, regions, primaryRegion, req.Header().Get("Authorization"))
+	}
 
 	// Return updated deployment
 	protoDeployment := dbDeploymentToProto(dbDeployment)
@@ -922,11 +985,41 @@ func (s *Service) DeleteDeployment(ctx context.Context, req *connect.Request[dep
 	if err := s.repo.Delete(ctx, deploymentID); err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentID))
 	}
+	if err := s.versionRepo.DeleteByDeployment(ctx, deploymentID); err != nil {
+		log.Printf("[DeleteDeployment] Failed to delete versions of deployment %s: %v", deploymentID, err)
+	}
 
 	res := connect.NewResponse(&deploymentsv1.DeleteDeploymentResponse{Success: true})
 	return res, nil
 }
 
+// validateDeploymentResources rejects a deployment whose resources, across all replicas, don't fit in the
+// organization's plan next to its running deployments. Unset values use the container defaults.
+func (s *Service) validateDeploymentResources(ctx context.Context, orgID string, cpuShares, memoryBytes *int64, replicas *int32, excludeDeploymentID string) error {
+	shares := quota.DefaultDeploymentCPUShares
+	if cpuShares != nil {
+		shares = *cpuShares
+	}
+	memory := quota.DefaultDeploymentMemoryBytes
+	if memoryBytes != nil {
+		memory = *memoryBytes
+	}
+	count := int64(1)
+	if replicas != nil && *replicas > 0 {
+		count = int64(*replicas)
+	}
+
+	cpuCores := int((shares*count + 1023) / 1024)
+	memoryMB := int(memory * count / (1024 * 1024))
+	if err := s.quotaChecker.ValidateResourceRequest(ctx, orgID, cpuCores, memoryMB, 0, excludeDeploymentID); err != nil {
+		if errors.Is(err, quota.ErrResourceExhausted) {
+			return connect.NewError(connect.CodeResourceExhausted, err)
+		}
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("quota check failed: %w", err))
+	}
+	return nil
+}
+
 // resolveUserDefaultOrgID returns a membership org id for the authenticated user, if any
 func resolveUserDefaultOrgID(ctx context.Context) (string, bool) {
 	userInfo, err := auth.GetUserFromContext(ctx)
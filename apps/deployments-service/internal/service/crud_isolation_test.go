@@ -12,7 +12,6 @@ import (
 	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
 	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
 	"google.golang.org/protobuf/proto"
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -112,31 +111,17 @@ func TestDeploymentServiceTenantIsolation(t *testing.T) {
 func newDeploymentServiceTestDB(t *testing.T) *gorm.DB {
 	t.Helper()
 
-	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	if err != nil {
-		t.Fatalf("open sqlite db: %v", err)
-	}
-	if err := db.AutoMigrate(
+	return newTestDB(t,
 		&database.Deployment{},
+		&database.DeploymentAffinityRule{},
+		&database.DeploymentRegion{},
 		&database.BuildHistory{},
 		&database.Organization{},
 		&database.OrganizationMember{},
 		&database.OrgRole{},
 		&database.OrgRoleBinding{},
-	); err != nil {
-		t.Fatalf("migrate sqlite db: %v", err)
-	}
-
-	previousDB := database.DB
-	previousMetricsDB := database.MetricsDB
-	database.DB = db
-	database.MetricsDB = db
-	t.Cleanup(func() {
-		database.DB = previousDB
-		database.MetricsDB = previousMetricsDB
-	})
-
-	return db
+		&database.ResourceTag{},
+	)
 }
 
 func seedDeploymentServiceIsolationData(t *testing.T, db *gorm.DB) {
@@ -0,0 +1,263 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"deployments-service/internal/certs"
+	"deployments-service/internal/secrets"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// customDomainPattern accepts lowercase hostnames with at least two labels and an optional wildcard label
+var customDomainPattern = regexp.MustCompile(`^(\*\.)?([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z][a-z0-9-]{0,61}[a-z0-9]$`)
+
+// certificateIssuer obtains certificates over ACME; replaced in tests
+type certificateIssuer interface {
+	StartChallenge(ctx context.Context, domain string) (*certs.Challenge, error)
+	CompleteChallenge(ctx context.Context, domain string, challenge *certs.Challenge) (*certs.Certificate, error)
+}
+
+// Test hooks for the certificate key encryption and the challenge TXT lookup
+var (
+	certificateKeysEnabled = secrets.Enabled
+	encryptCertificateKey  = secrets.EncryptForOrganization
+	lookupChallengeTXT     = lookupTXT
+)
+
+// CreateCustomDomain maps a custom domain to a deployment and starts the ACME DNS-01 challenge
+// for its certificate. The caller publishes the returned TXT record and polls VerifyCustomDomain.
+func (s *Service) CreateCustomDomain(ctx context.Context, req *connect.Request[deploymentsv1.CreateCustomDomainRequest]) (*connect.Response[deploymentsv1.CreateCustomDomainResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.authorizeCustomDomain(ctx, orgID, deploymentID); err != nil {
+		return nil, err
+	}
+
+	domain := normalizeCustomDomain(req.Msg.GetDomain())
+	if len(domain) > 253 || !customDomainPattern.MatchString(domain) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid domain %q", req.Msg.GetDomain()))
+	}
+	if domain == previewDomainSuffix || strings.HasSuffix(domain, "."+previewDomainSuffix) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("domains under %s are managed by the platform", previewDomainSuffix))
+	}
+	if !certificateKeysEnabled() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("custom domain certificates require encryption: %w", secrets.ErrNotConfigured))
+	}
+	if err := s.checkDomainConflict(ctx, deploymentID, domain); err != nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, err)
+	}
+
+	// A failed domain of the same deployment is retried with a new order; anything else is taken
+	var existing database.CustomDomain
+	err := database.DB.Where("domain = ?", domain).First(&existing).Error
+	switch {
+	case err == nil && (existing.DeploymentID != deploymentID || existing.Status != database.CustomDomainStatusFailed):
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("domain %s is already mapped", domain))
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get custom domain: %w", err))
+	}
+
+	challenge, err := s.certIssuer.StartChallenge(ctx, domain)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("start certificate challenge: %w", err))
+	}
+
+	now := time.Now()
+	record := existing
+	if record.ID == "" {
+		record = database.CustomDomain{
+			ID:             fmt.Sprintf("cdom-%s", uuid.NewString()),
+			DeploymentID:   deploymentID,
+			OrganizationID: orgID,
+			Domain:         domain,
+			CreatedAt:      now,
+		}
+	}
+	record.Verified = false
+	record.Status = database.CustomDomainStatusPending
+	record.ChallengeRecordName = challenge.RecordName
+	record.ChallengeRecordValue = challenge.RecordValue
+	record.ACMEOrderURL = challenge.OrderURL
+	record.ACMEAuthorizationURL = challenge.AuthorizationURL
+	record.ACMEChallengeURL = challenge.ChallengeURL
+	record.LastError = ""
+	record.UpdatedAt = now
+	if err := database.DB.Save(&record).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("save custom domain: %w", err))
+	}
+
+	return connect.NewResponse(&deploymentsv1.CreateCustomDomainResponse{CustomDomain: customDomainToProto(&record, nil)}), nil
+}
+
+// VerifyCustomDomain polls a pending custom domain. Once the challenge TXT record resolves it
+// completes the ACME order, stores the certificate and routes the domain through Traefik.
+func (s *Service) VerifyCustomDomain(ctx context.Context, req *connect.Request[deploymentsv1.VerifyCustomDomainRequest]) (*connect.Response[deploymentsv1.VerifyCustomDomainResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.authorizeCustomDomain(ctx, orgID, deploymentID); err != nil {
+		return nil, err
+	}
+
+	domain := normalizeCustomDomain(req.Msg.GetDomain())
+	var record database.CustomDomain
+	if err := database.DB.Where("deployment_id = ? AND domain = ?", deploymentID, domain).First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("custom domain %s not found", domain))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get custom domain: %w", err))
+	}
+	respond := func(cert *database.TLSCertificate) (*connect.Response[deploymentsv1.VerifyCustomDomainResponse], error) {
+		return connect.NewResponse(&deploymentsv1.VerifyCustomDomainResponse{CustomDomain: customDomainToProto(&record, cert)}), nil
+	}
+
+	switch record.Status {
+	case database.CustomDomainStatusIssued:
+		var cert database.TLSCertificate
+		if record.TLSCertID != nil {
+			database.DB.Where("id = ?", *record.TLSCertID).Limit(1).Find(&cert)
+		}
+		return respond(&cert)
+	case database.CustomDomainStatusFailed:
+		return respond(nil)
+	}
+
+	// Accepting the challenge before the record is visible fails the authorization for good,
+	// so wait until our own lookup sees it
+	records, err := lookupChallengeTXT(record.ChallengeRecordName)
+	published := false
+	for _, value := range records {
+		if strings.TrimSpace(value) == record.ChallengeRecordValue {
+			published = true
+			break
+		}
+	}
+	if !published {
+		record.LastError = fmt.Sprintf("TXT record %s not found yet", record.ChallengeRecordName)
+		if err != nil {
+			record.LastError = fmt.Sprintf("%s: %v", record.LastError, err)
+		}
+		database.DB.Model(&record).Update("last_error", record.LastError)
+		return respond(nil)
+	}
+
+	issued, err := s.certIssuer.CompleteChallenge(ctx, record.Domain, &certs.Challenge{
+		OrderURL:         record.ACMEOrderURL,
+		AuthorizationURL: record.ACMEAuthorizationURL,
+		ChallengeURL:     record.ACMEChallengeURL,
+		RecordName:       record.ChallengeRecordName,
+		RecordValue:      record.ChallengeRecordValue,
+	})
+	if err != nil {
+		log.Printf("[VerifyCustomDomain] Certificate for %s failed: %v", record.Domain, err)
+		record.Status = database.CustomDomainStatusFailed
+		record.LastError = err.Error()
+		if err := database.DB.Model(&record).Updates(map[string]any{"status": record.Status, "last_error": record.LastError}).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("save custom domain: %w", err))
+		}
+		return respond(nil)
+	}
+
+	encryptedKey, keyID, err := encryptCertificateKey(record.OrganizationID, issued.KeyPEM)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("encrypt certificate key: %w", err))
+	}
+	now := time.Now()
+	cert := database.TLSCertificate{
+		ID:                  fmt.Sprintf("cert-%s", uuid.NewString()),
+		OrganizationID:      record.OrganizationID,
+		Domain:              record.Domain,
+		CertificatePEM:      string(issued.ChainPEM),
+		EncryptedPrivateKey: encryptedKey,
+		KeyID:               keyID,
+		NotBefore:           issued.NotBefore,
+		NotAfter:            issued.NotAfter,
+		CreatedAt:           now,
+	}
+	record.Verified = true
+	record.Status = database.CustomDomainStatusIssued
+	record.TLSCertID = &cert.ID
+	record.VerifiedAt = &now
+	record.LastError = ""
+	record.UpdatedAt = now
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&cert).Error; err != nil {
+			return err
+		}
+		return tx.Save(&record).Error
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("store certificate: %w", err))
+	}
+
+	// The TXT challenge also proves ownership, so the domain can be used as the deployment's domain
+	if err := s.storeVerifiedDomain(ctx, deploymentID, record.Domain); err != nil {
+		log.Printf("[VerifyCustomDomain] Failed to mark %s verified on deployment %s: %v", record.Domain, deploymentID, err)
+	}
+	if err := certs.WriteRoute(certs.Route{
+		CustomDomainID: record.ID,
+		DeploymentID:   deploymentID,
+		Domain:         record.Domain,
+		ChainPEM:       issued.ChainPEM,
+		KeyPEM:         issued.KeyPEM,
+	}); err != nil {
+		log.Printf("[VerifyCustomDomain] Failed to route %s: %v", record.Domain, err)
+		record.LastError = fmt.Sprintf("certificate issued but routing failed: %v", err)
+		database.DB.Model(&record).Update("last_error", record.LastError)
+	}
+
+	return respond(&cert)
+}
+
+// authorizeCustomDomain requires update permission on a deployment of the organization
+func (s *Service) authorizeCustomDomain(ctx context.Context, orgID, deploymentID string) error {
+	if orgID == "" || deploymentID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id and deployment_id are required"))
+	}
+	if err := s.permissionChecker.CheckScopedPermission(ctx, orgID, auth.ScopedPermission{Permission: auth.PermissionDeploymentUpdate, ResourceType: "deployment", ResourceID: deploymentID}); err != nil {
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	dbDeployment, err := s.repo.GetByID(ctx, deploymentID)
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentID))
+	}
+	if dbDeployment.OrganizationID != orgID {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("deployment does not belong to organization"))
+	}
+	return nil
+}
+
+func customDomainToProto(record *database.CustomDomain, cert *database.TLSCertificate) *deploymentsv1.CustomDomain {
+	out := &deploymentsv1.CustomDomain{
+		Id:                   record.ID,
+		DeploymentId:         record.DeploymentID,
+		Domain:               record.Domain,
+		Verified:             record.Verified,
+		Status:               record.Status,
+		ChallengeRecordName:  record.ChallengeRecordName,
+		ChallengeRecordValue: record.ChallengeRecordValue,
+		TlsCertId:            record.TLSCertID,
+		CreatedAt:            timestamppb.New(record.CreatedAt),
+	}
+	if record.LastError != "" {
+		out.LastError = &record.LastError
+	}
+	if cert != nil && !cert.NotAfter.IsZero() {
+		out.CertificateExpiresAt = timestamppb.New(cert.NotAfter)
+	}
+	return out
+}
@@ -0,0 +1,191 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"deployments-service/internal/certs"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+)
+
+type fakeCertificateIssuer struct {
+	completeErr error
+	completed   int
+}
+
+func (f *fakeCertificateIssuer) StartChallenge(_ context.Context, domain string) (*certs.Challenge, error) {
+	return &certs.Challenge{
+		OrderURL:         "https://acme.test/order/" + domain,
+		AuthorizationURL: "https://acme.test/authz/" + domain,
+		ChallengeURL:     "https://acme.test/chal/" + domain,
+		RecordName:       certs.ChallengeRecordName(domain),
+		RecordValue:      "challenge-" + domain,
+	}, nil
+}
+
+func (f *fakeCertificateIssuer) CompleteChallenge(_ context.Context, domain string, challenge *certs.Challenge) (*certs.Certificate, error) {
+	f.completed++
+	if f.completeErr != nil {
+		return nil, f.completeErr
+	}
+	now := time.Now()
+	return &certs.Certificate{
+		ChainPEM:  []byte("-----BEGIN CERTIFICATE-----\n" + domain + "\n-----END CERTIFICATE-----\n"),
+		KeyPEM:    []byte("private-key"),
+		NotBefore: now,
+		NotAfter:  now.Add(90 * 24 * time.Hour),
+	}, nil
+}
+
+func TestCustomDomainIssuesCertificate(t *testing.T) {
+	db := newTestDB(t,
+		&database.Deployment{},
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.OrgRole{},
+		&database.OrgRoleBinding{},
+		&database.ResourceTag{},
+		&database.CustomDomain{},
+		&database.TLSCertificate{},
+	)
+	seedDeploymentServiceIsolationData(t, db)
+
+	configDir := t.TempDir()
+	t.Setenv("TRAEFIK_DYNAMIC_CONFIG_DIR", configDir)
+	published := map[string][]string{}
+	previousEnabled, previousEncrypt, previousLookup := certificateKeysEnabled, encryptCertificateKey, lookupChallengeTXT
+	certificateKeysEnabled = func() bool { return true }
+	encryptCertificateKey = func(orgID string, plaintext []byte) ([]byte, string, error) {
+		return append([]byte("sealed:"), plaintext...), "key-" + orgID, nil
+	}
+	lookupChallengeTXT = func(name string) ([]string, error) { return published[name], nil }
+	t.Cleanup(func() {
+		certificateKeysEnabled, encryptCertificateKey, lookupChallengeTXT = previousEnabled, previousEncrypt, previousLookup
+	})
+
+	service := NewService(context.Background(), database.NewDeploymentRepository(db, nil), nil, nil)
+	issuer := &fakeCertificateIssuer{}
+	service.certIssuer = issuer
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	for _, domain := range []string{"not a domain", "localhost", "app.my.obiente.cloud"} {
+		_, err := service.CreateCustomDomain(ctx, connect.NewRequest(&deploymentsv1.CreateCustomDomainRequest{OrganizationId: "org-a", DeploymentId: "dep-org-a-owner", Domain: domain}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("CreateCustomDomain(%q) code = %v, want %v", domain, connect.CodeOf(err), connect.CodeInvalidArgument)
+		}
+	}
+	if _, err := service.CreateCustomDomain(ctx, connect.NewRequest(&deploymentsv1.CreateCustomDomainRequest{OrganizationId: "org-b", DeploymentId: "dep-org-b-owner", Domain: "app.example.com"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("CreateCustomDomain on another organization code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+	}
+
+	created, err := service.CreateCustomDomain(ctx, connect.NewRequest(&deploymentsv1.CreateCustomDomainRequest{OrganizationId: "org-a", DeploymentId: "dep-org-a-owner", Domain: "App.Example.com."}))
+	if err != nil {
+		t.Fatalf("CreateCustomDomain: %v", err)
+	}
+	customDomain := created.Msg.GetCustomDomain()
+	if customDomain.GetDomain() != "app.example.com" || customDomain.GetStatus() != database.CustomDomainStatusPending ||
+		customDomain.GetChallengeRecordName() != "_acme-challenge.app.example.com" {
+		t.Fatalf("created custom domain = %+v", customDomain)
+	}
+	if _, err := service.CreateCustomDomain(ctx, connect.NewRequest(&deploymentsv1.CreateCustomDomainRequest{OrganizationId: "org-a", DeploymentId: "dep-org-a-peer", Domain: "app.example.com"})); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Fatalf("CreateCustomDomain for a mapped domain code = %v, want %v", connect.CodeOf(err), connect.CodeAlreadyExists)
+	}
+
+	// Until the TXT record resolves the challenge is not accepted
+	verifyReq := &deploymentsv1.VerifyCustomDomainRequest{OrganizationId: "org-a", DeploymentId: "dep-org-a-owner", Domain: "app.example.com"}
+	pending, err := service.VerifyCustomDomain(ctx, connect.NewRequest(verifyReq))
+	if err != nil {
+		t.Fatalf("VerifyCustomDomain before publishing: %v", err)
+	}
+	if pending.Msg.GetCustomDomain().GetVerified() || issuer.completed != 0 {
+		t.Fatalf("domain verified before its TXT record was published")
+	}
+
+	published[customDomain.GetChallengeRecordName()] = []string{customDomain.GetChallengeRecordValue()}
+	verified, err := service.VerifyCustomDomain(ctx, connect.NewRequest(verifyReq))
+	if err != nil {
+		t.Fatalf("VerifyCustomDomain: %v", err)
+	}
+	got := verified.Msg.GetCustomDomain()
+	if !got.GetVerified() || got.GetStatus() != database.CustomDomainStatusIssued || got.GetCertificateExpiresAt() == nil {
+		t.Fatalf("verified custom domain = %+v", got)
+	}
+
+	var cert database.TLSCertificate
+	if err := db.First(&cert, "id = ?", got.GetTlsCertId()).Error; err != nil {
+		t.Fatalf("load certificate: %v", err)
+	}
+	if string(cert.EncryptedPrivateKey) != "sealed:private-key" || cert.KeyID != "key-org-a" {
+		t.Fatalf("certificate key stored as %q with key %q", cert.EncryptedPrivateKey, cert.KeyID)
+	}
+	var deployment database.Deployment
+	db.First(&deployment, "id = ?", "dep-org-a-owner")
+	if !strings.Contains(deployment.CustomDomains, "app.example.com:token:") || !strings.Contains(deployment.CustomDomains, ":verified") {
+		t.Fatalf("deployment custom domains = %s, want app.example.com verified", deployment.CustomDomains)
+	}
+	routeConfig, err := os.ReadFile(filepath.Join(configDir, "custom-domain-"+got.GetId()+".yml"))
+	if err != nil {
+		t.Fatalf("read traefik config: %v", err)
+	}
+	if !strings.Contains(string(routeConfig), "Host(`app.example.com`)") || !strings.Contains(string(routeConfig), "dep-org-a-owner@swarm") {
+		t.Fatalf("traefik config = %s", routeConfig)
+	}
+
+	// Polling an issued domain does not place another order
+	if _, err := service.VerifyCustomDomain(ctx, connect.NewRequest(verifyReq)); err != nil || issuer.completed != 1 {
+		t.Fatalf("VerifyCustomDomain on issued domain: err = %v, orders completed = %d", err, issuer.completed)
+	}
+}
+
+func TestCustomDomainFailedChallengeCanBeRetried(t *testing.T) {
+	db := newTestDB(t,
+		&database.Deployment{},
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.OrgRole{},
+		&database.OrgRoleBinding{},
+		&database.ResourceTag{},
+		&database.CustomDomain{},
+		&database.TLSCertificate{},
+	)
+	seedDeploymentServiceIsolationData(t, db)
+
+	previousEnabled, previousLookup := certificateKeysEnabled, lookupChallengeTXT
+	certificateKeysEnabled = func() bool { return true }
+	lookupChallengeTXT = func(name string) ([]string, error) { return []string{"challenge-*.example.org"}, nil }
+	t.Cleanup(func() { certificateKeysEnabled, lookupChallengeTXT = previousEnabled, previousLookup })
+
+	service := NewService(context.Background(), database.NewDeploymentRepository(db, nil), nil, nil)
+	service.certIssuer = &fakeCertificateIssuer{completeErr: errors.New("authorization invalid")}
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	createReq := &deploymentsv1.CreateCustomDomainRequest{OrganizationId: "org-a", DeploymentId: "dep-org-a-owner", Domain: "*.example.org"}
+	if _, err := service.CreateCustomDomain(ctx, connect.NewRequest(createReq)); err != nil {
+		t.Fatalf("CreateCustomDomain: %v", err)
+	}
+	failed, err := service.VerifyCustomDomain(ctx, connect.NewRequest(&deploymentsv1.VerifyCustomDomainRequest{OrganizationId: "org-a", DeploymentId: "dep-org-a-owner", Domain: "*.example.org"}))
+	if err != nil {
+		t.Fatalf("VerifyCustomDomain: %v", err)
+	}
+	if got := failed.Msg.GetCustomDomain(); got.GetStatus() != database.CustomDomainStatusFailed || got.GetLastError() != "authorization invalid" {
+		t.Fatalf("failed custom domain = %+v", got)
+	}
+
+	retried, err := service.CreateCustomDomain(ctx, connect.NewRequest(createReq))
+	if err != nil {
+		t.Fatalf("CreateCustomDomain retry: %v", err)
+	}
+	if got := retried.Msg.GetCustomDomain(); got.GetStatus() != database.CustomDomainStatusPending || got.GetId() != failed.Msg.GetCustomDomain().GetId() {
+		t.Fatalf("retried custom domain = %+v", got)
+	}
+}
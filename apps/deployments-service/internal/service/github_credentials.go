@@ -0,0 +1,455 @@
+package deployments
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	githubclient "deployments-service/internal/github"
+	"deployments-service/internal/secrets"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	// githubCredentialMaxAge is how long deploy keys and webhook secrets are used before they are rotated
+	githubCredentialMaxAge = 90 * 24 * time.Hour
+	// githubWebhookSecretGracePeriod is how long the previous webhook secret is accepted after a rotation,
+	// so deliveries signed before the rotation and redeliveries still verify
+	githubWebhookSecretGracePeriod = 24 * time.Hour
+	githubDeployKeyBits            = 4096
+)
+
+// githubCredentialAPI is the part of the GitHub API used to rotate repository credentials; replaced in tests
+type githubCredentialAPI interface {
+	CreateDeployKey(ctx context.Context, repoFullName, title, publicKey string) (*githubclient.GitHubDeployKey, error)
+	DeleteDeployKey(ctx context.Context, repoFullName string, keyID int64) error
+	ListRepoHooks(ctx context.Context, repoFullName string) ([]githubclient.GitHubHook, error)
+	UpdateRepoHookSecret(ctx context.Context, repoFullName string, hook githubclient.GitHubHook, secret string) error
+}
+
+// Test hooks for the encryption of repository credentials
+var (
+	githubCredentialsEnabled = secrets.Enabled
+	sealGitHubCredential     = secrets.EncryptWithMasterKey
+	openGitHubCredential     = secrets.DecryptWithMasterKey
+)
+
+func newGitHubCredentialClient(ctx context.Context, integration *database.GitHubIntegration) (githubCredentialAPI, error) {
+	client, _, err := getGitHubClientForIntegration(ctx, integration)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// RotateDeployKey replaces the deploy key of every repository deployed through a GitHub integration
+func (s *Service) RotateDeployKey(ctx context.Context, req *connect.Request[deploymentsv1.RotateDeployKeyRequest]) (*connect.Response[deploymentsv1.RotateDeployKeyResponse], error) {
+	integration, err := s.authorizeGitHubCredentialRotation(ctx, req.Msg.GetOrganizationId(), req.Msg.GetIntegrationId())
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.githubCredentialClient(ctx, integration)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	repos, err := githubIntegrationRepositories(integration.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &deploymentsv1.RotateDeployKeyResponse{}
+	var errs []error
+	for _, repo := range repos {
+		credential, err := rotateGitHubDeployKey(ctx, client, integration.ID, repo)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", repo, err))
+			continue
+		}
+		resp.DeployKeys = append(resp.DeployKeys, &deploymentsv1.GitHubDeployKey{
+			Repository:  credential.Repository,
+			PublicKey:   credential.DeployPublicKey,
+			GithubKeyId: *credential.DeployKeyID,
+			RotatedAt:   timestamppb.New(*credential.DeployKeyRotatedAt),
+		})
+	}
+	if len(errs) > 0 {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to rotate deploy keys: %w", errors.Join(errs...)))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RotateWebhookSecret replaces the secret of every repository webhook that delivers to Obiente for a GitHub integration
+func (s *Service) RotateWebhookSecret(ctx context.Context, req *connect.Request[deploymentsv1.RotateWebhookSecretRequest]) (*connect.Response[deploymentsv1.RotateWebhookSecretResponse], error) {
+	integration, err := s.authorizeGitHubCredentialRotation(ctx, req.Msg.GetOrganizationId(), req.Msg.GetIntegrationId())
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.githubCredentialClient(ctx, integration)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	repos, err := githubIntegrationRepositories(integration.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &deploymentsv1.RotateWebhookSecretResponse{}
+	var errs []error
+	for _, repo := range repos {
+		rotated, err := rotateGitHubWebhookSecret(ctx, client, integration.ID, repo)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", repo, err))
+			continue
+		}
+		if rotated {
+			resp.Repositories = append(resp.Repositories, repo)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to rotate webhook secrets: %w", errors.Join(errs...)))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// authorizeGitHubCredentialRotation loads an integration the caller may rotate credentials for: organization
+// integrations need deployment update permission, user integrations belong to their user
+func (s *Service) authorizeGitHubCredentialRotation(ctx context.Context, orgID, integrationID string) (*database.GitHubIntegration, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	if integrationID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("integration_id is required"))
+	}
+
+	var integration database.GitHubIntegration
+	if err := database.DB.Where("id = ?", integrationID).First(&integration).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("GitHub integration %s not found", integrationID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get integration: %w", err))
+	}
+
+	switch {
+	case integration.OrganizationID != nil:
+		if orgID != "" && orgID != *integration.OrganizationID {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("integration does not belong to organization"))
+		}
+		if err := s.permissionChecker.CheckScopedPermission(ctx, *integration.OrganizationID, auth.ScopedPermission{Permission: auth.PermissionDeploymentUpdate, ResourceType: "deployment"}); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+	case integration.UserID != nil && *integration.UserID == user.Id, auth.IsSuperadmin(ctx, user):
+	default:
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("integration belongs to another user"))
+	}
+
+	if !githubCredentialsEnabled() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, secrets.ErrNotConfigured)
+	}
+	return &integration, nil
+}
+
+// githubIntegrationRepositories returns the repositories deployed through an integration as sorted owner/repo names
+func githubIntegrationRepositories(integrationID string) ([]string, error) {
+	var repoURLs []string
+	if err := database.DB.Model(&database.Deployment{}).
+		Where("deleted_at IS NULL").
+		Where("github_integration_id = ?", integrationID).
+		Where("repository_url IS NOT NULL").
+		Distinct().
+		Pluck("repository_url", &repoURLs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list integration repositories: %w", err)
+	}
+
+	seen := map[string]bool{}
+	var repos []string
+	for _, repoURL := range repoURLs {
+		repo := normalizeGitHubRepoFullName(repoURL)
+		if repo == "" || seen[repo] {
+			continue
+		}
+		seen[repo] = true
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+	return repos, nil
+}
+
+// githubRepositoryCredential loads the credential row of a repository, or returns an unsaved one
+func githubRepositoryCredential(integrationID, repo string) (*database.GitHubRepositoryCredential, error) {
+	var credential database.GitHubRepositoryCredential
+	err := database.DB.Where("integration_id = ? AND repository = ?", integrationID, repo).First(&credential).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &database.GitHubRepositoryCredential{ID: uuid.NewString(), IntegrationID: integrationID, Repository: repo}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository credential: %w", err)
+	}
+	return &credential, nil
+}
+
+// rotateGitHubDeployKey adds a new deploy key to the repository, stores it and then removes the previous key,
+// so the repository always has a working key
+func rotateGitHubDeployKey(ctx context.Context, client githubCredentialAPI, integrationID, repo string) (*database.GitHubRepositoryCredential, error) {
+	credential, err := githubRepositoryCredential(integrationID, repo)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, privateKey, err := generateGitHubDeployKey()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := sealGitHubCredential(privateKey, credential.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt deploy key: %w", err)
+	}
+
+	now := time.Now()
+	key, err := client.CreateDeployKey(ctx, repo, fmt.Sprintf("Obiente Cloud (%s)", now.Format("2006-01-02")), publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	previousKeyID := credential.DeployKeyID
+	credential.DeployKeyID = &key.ID
+	credential.DeployPublicKey = publicKey
+	credential.EncryptedDeployKey = sealed
+	credential.DeployKeyRotatedAt = &now
+	if err := database.DB.Save(credential).Error; err != nil {
+		if deleteErr := client.DeleteDeployKey(ctx, repo, key.ID); deleteErr != nil {
+			logger.Warn("[GitHubCredentials] Failed to remove unsaved deploy key %d from %s: %v", key.ID, repo, deleteErr)
+		}
+		return nil, fmt.Errorf("failed to save deploy key: %w", err)
+	}
+
+	if previousKeyID != nil && *previousKeyID != key.ID {
+		if err := client.DeleteDeployKey(ctx, repo, *previousKeyID); err != nil {
+			logger.Warn("[GitHubCredentials] Failed to remove previous deploy key %d from %s: %v", *previousKeyID, repo, err)
+		}
+	}
+	logger.Info("[GitHubCredentials] Rotated deploy key of %s for integration %s", repo, integrationID)
+	return credential, nil
+}
+
+// generateGitHubDeployKey returns an RSA key pair as an OpenSSH public key and a PEM private key
+func generateGitHubDeployKey() (string, []byte, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, githubDeployKeyBits)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate deploy key: %w", err)
+	}
+	publicKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode deploy key: %w", err)
+	}
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey))), privateKeyPEM, nil
+}
+
+// rotateGitHubWebhookSecret replaces the secret of the repository webhook that delivers to Obiente. It reports
+// false when the repository has no such webhook. The new secret is stored before GitHub starts signing with it;
+// the previous one stays valid for githubWebhookSecretGracePeriod.
+func rotateGitHubWebhookSecret(ctx context.Context, client githubCredentialAPI, integrationID, repo string) (bool, error) {
+	hooks, err := client.ListRepoHooks(ctx, repo)
+	if err != nil {
+		return false, err
+	}
+	var hook *githubclient.GitHubHook
+	for i := range hooks {
+		if isObienteGitHubWebhook(hooks[i].Config.URL) {
+			hook = &hooks[i]
+			break
+		}
+	}
+	if hook == nil {
+		return false, nil
+	}
+
+	credential, err := githubRepositoryCredential(integrationID, repo)
+	if err != nil {
+		return false, err
+	}
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return false, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	secret := hex.EncodeToString(secretBytes)
+	sealed, err := sealGitHubCredential([]byte(secret), credential.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+
+	previous := *credential
+	now := time.Now()
+	credential.WebhookID = &hook.ID
+	credential.EncryptedPrevSecret = credential.EncryptedWebhookSecret
+	credential.EncryptedWebhookSecret = sealed
+	credential.WebhookRotatedAt = &now
+	if err := database.DB.Save(credential).Error; err != nil {
+		return false, fmt.Errorf("failed to save webhook secret: %w", err)
+	}
+
+	if err := client.UpdateRepoHookSecret(ctx, repo, *hook, secret); err != nil {
+		// GitHub still signs with the previous secret
+		if previous.CreatedAt.IsZero() {
+			err = errors.Join(err, database.DB.Delete(credential).Error)
+		} else {
+			err = errors.Join(err, database.DB.Save(&previous).Error)
+		}
+		return false, err
+	}
+	logger.Info("[GitHubCredentials] Rotated webhook secret of %s for integration %s", repo, integrationID)
+	return true, nil
+}
+
+// isObienteGitHubWebhook reports whether a webhook URL points at the GitHub webhook endpoint of this service
+func isObienteGitHubWebhook(hookURL string) bool {
+	parsed, err := url.Parse(hookURL)
+	if err != nil {
+		return false
+	}
+	return strings.HasSuffix(strings.TrimSuffix(parsed.Path, "/"), "/webhooks/github")
+}
+
+// githubRepositoryWebhookSecrets returns the rotated secrets accepted for a webhook delivery of the repository
+// named in the payload: the current secret and, during the grace period, the previous one
+func githubRepositoryWebhookSecrets(body []byte) []string {
+	if database.DB == nil || !githubCredentialsEnabled() {
+		return nil
+	}
+	var payload struct {
+		Repository struct {
+			FullName string `json:"full_name"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil
+	}
+	repo := normalizeGitHubRepoFullName(payload.Repository.FullName)
+	if repo == "" {
+		return nil
+	}
+
+	var credentials []database.GitHubRepositoryCredential
+	if err := database.DB.Where("repository = ? AND encrypted_webhook_secret IS NOT NULL", repo).Find(&credentials).Error; err != nil {
+		logger.Warn("[GitHubWebhook] Failed to load webhook secrets of %s: %v", repo, err)
+		return nil
+	}
+	var out []string
+	for _, credential := range credentials {
+		sealed := [][]byte{credential.EncryptedWebhookSecret}
+		if len(credential.EncryptedPrevSecret) > 0 && credential.WebhookRotatedAt != nil && time.Since(*credential.WebhookRotatedAt) < githubWebhookSecretGracePeriod {
+			sealed = append(sealed, credential.EncryptedPrevSecret)
+		}
+		for _, ciphertext := range sealed {
+			secret, err := openGitHubCredential(ciphertext, credential.ID)
+			if err != nil {
+				logger.Warn("[GitHubWebhook] Failed to decrypt webhook secret of %s: %v", repo, err)
+				continue
+			}
+			out = append(out, string(secret))
+		}
+	}
+	return out
+}
+
+// RotateStaleGitHubCredentials rotates deploy keys and webhook secrets older than githubCredentialMaxAge.
+// Only repositories whose credentials were rotated before are included.
+func (s *Service) RotateStaleGitHubCredentials(ctx context.Context) (int, error) {
+	if !githubCredentialsEnabled() {
+		return 0, nil
+	}
+	cutoff := time.Now().Add(-githubCredentialMaxAge)
+	var credentials []database.GitHubRepositoryCredential
+	if err := database.DB.
+		Where("(deploy_key_id IS NOT NULL AND deploy_key_rotated_at < ?) OR (webhook_id IS NOT NULL AND webhook_rotated_at < ?)", cutoff, cutoff).
+		Order("integration_id, repository").
+		Find(&credentials).Error; err != nil {
+		return 0, fmt.Errorf("failed to list stale repository credentials: %w", err)
+	}
+
+	rotated := 0
+	clients := map[string]githubCredentialAPI{}
+	for _, credential := range credentials {
+		client, ok := clients[credential.IntegrationID]
+		if !ok {
+			var integration database.GitHubIntegration
+			if err := database.DB.Where("id = ?", credential.IntegrationID).First(&integration).Error; err != nil {
+				logger.Warn("[GitHubCredentials] Skipping %s: failed to load integration %s: %v", credential.Repository, credential.IntegrationID, err)
+				clients[credential.IntegrationID] = nil
+				continue
+			}
+			var err error
+			if client, err = s.githubCredentialClient(ctx, &integration); err != nil {
+				logger.Warn("[GitHubCredentials] Skipping integration %s: %v", credential.IntegrationID, err)
+			}
+			clients[credential.IntegrationID] = client
+		}
+		if client == nil {
+			continue
+		}
+
+		if credential.DeployKeyID != nil && credential.DeployKeyRotatedAt != nil && credential.DeployKeyRotatedAt.Before(cutoff) {
+			if _, err := rotateGitHubDeployKey(ctx, client, credential.IntegrationID, credential.Repository); err != nil {
+				logger.Warn("[GitHubCredentials] Failed to rotate deploy key of %s: %v", credential.Repository, err)
+			} else {
+				rotated++
+			}
+		}
+		if credential.WebhookID != nil && credential.WebhookRotatedAt != nil && credential.WebhookRotatedAt.Before(cutoff) {
+			if ok, err := rotateGitHubWebhookSecret(ctx, client, credential.IntegrationID, credential.Repository); err != nil {
+				logger.Warn("[GitHubCredentials] Failed to rotate webhook secret of %s: %v", credential.Repository, err)
+			} else if ok {
+				rotated++
+			}
+		}
+	}
+	return rotated, nil
+}
+
+// StartGitHubCredentialRotator runs RotateStaleGitHubCredentials every interval until ctx is cancelled
+func (s *Service) StartGitHubCredentialRotator(ctx context.Context, interval time.Duration) {
+	rotate := func() {
+		rotated, err := s.RotateStaleGitHubCredentials(ctx)
+		if err != nil {
+			logger.Warn("[GitHubCredentials] Failed to rotate stale credentials: %v", err)
+			return
+		}
+		if rotated > 0 {
+			logger.Info("[GitHubCredentials] Rotated %d stale credential(s)", rotated)
+		}
+	}
+	rotate()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rotate()
+		}
+	}
+}
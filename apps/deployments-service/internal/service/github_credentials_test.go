@@ -0,0 +1,201 @@
+package deployments
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	githubclient "deployments-service/internal/github"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+)
+
+type fakeGitHubCredentialAPI struct {
+	nextID  int64
+	keys    map[string][]githubclient.GitHubDeployKey
+	hooks   map[string][]githubclient.GitHubHook
+	secrets map[int64]string // hook ID -> secret
+}
+
+func (f *fakeGitHubCredentialAPI) CreateDeployKey(_ context.Context, repo, title, publicKey string) (*githubclient.GitHubDeployKey, error) {
+	f.nextID++
+	key := githubclient.GitHubDeployKey{ID: f.nextID, Key: publicKey, Title: title, ReadOnly: true}
+	f.keys[repo] = append(f.keys[repo], key)
+	return &key, nil
+}
+
+func (f *fakeGitHubCredentialAPI) DeleteDeployKey(_ context.Context, repo string, keyID int64) error {
+	kept := f.keys[repo][:0]
+	for _, key := range f.keys[repo] {
+		if key.ID != keyID {
+			kept = append(kept, key)
+		}
+	}
+	f.keys[repo] = kept
+	return nil
+}
+
+func (f *fakeGitHubCredentialAPI) ListRepoHooks(_ context.Context, repo string) ([]githubclient.GitHubHook, error) {
+	return f.hooks[repo], nil
+}
+
+func (f *fakeGitHubCredentialAPI) UpdateRepoHookSecret(_ context.Context, repo string, hook githubclient.GitHubHook, secret string) error {
+	f.secrets[hook.ID] = secret
+	return nil
+}
+
+func githubWebhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestRotateGitHubRepositoryCredentials(t *testing.T) {
+	db := newTestDB(t,
+		&database.Deployment{},
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.OrgRole{},
+		&database.OrgRoleBinding{},
+		&database.ResourceTag{},
+		&database.GitHubIntegration{},
+		&database.GitHubRepositoryCredential{},
+	)
+	seedDeploymentServiceIsolationData(t, db)
+
+	orgA := "org-a"
+	installationID := int64(42)
+	if err := db.Create(&database.GitHubIntegration{ID: "gh-org-a", OrganizationID: &orgA, AuthType: "github_app", GitHubAppInstallationID: &installationID, ConnectedAt: time.Now()}).Error; err != nil {
+		t.Fatalf("seed integration: %v", err)
+	}
+	// Both org A deployments deploy the same repository through the integration
+	for id, repoURL := range map[string]string{"dep-org-a-owner": "https://github.com/Acme/App.git", "dep-org-a-peer": "acme/app"} {
+		if err := db.Model(&database.Deployment{}).Where("id = ?", id).Updates(map[string]any{"repository_url": repoURL, "github_integration_id": "gh-org-a"}).Error; err != nil {
+			t.Fatalf("link deployment %s: %v", id, err)
+		}
+	}
+
+	previousEnabled, previousSeal, previousOpen := githubCredentialsEnabled, sealGitHubCredential, openGitHubCredential
+	githubCredentialsEnabled = func() bool { return true }
+	sealGitHubCredential = func(plaintext []byte, id string) ([]byte, error) {
+		return append([]byte(id+":"), plaintext...), nil
+	}
+	openGitHubCredential = func(ciphertext []byte, id string) ([]byte, error) {
+		if !bytes.HasPrefix(ciphertext, []byte(id+":")) {
+			return nil, fmt.Errorf("sealed for another record")
+		}
+		return ciphertext[len(id)+1:], nil
+	}
+	t.Cleanup(func() {
+		githubCredentialsEnabled, sealGitHubCredential, openGitHubCredential = previousEnabled, previousSeal, previousOpen
+	})
+	t.Setenv("GITHUB_WEBHOOK_SECRET", "app-secret")
+
+	api := &fakeGitHubCredentialAPI{
+		keys: map[string][]githubclient.GitHubDeployKey{},
+		hooks: map[string][]githubclient.GitHubHook{
+			"acme/app": {{ID: 7}, {ID: 8}},
+		},
+		secrets: map[int64]string{},
+	}
+	api.hooks["acme/app"][0].Config.URL = "https://ci.example.com/hook"
+	api.hooks["acme/app"][1].Config.URL = "https://api.obiente.cloud/webhooks/github"
+	service := NewService(context.Background(), database.NewDeploymentRepository(db, nil), nil, nil)
+	service.githubCredentialClient = func(context.Context, *database.GitHubIntegration) (githubCredentialAPI, error) { return api, nil }
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	outsiderCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-b", Email: "user-org-b@example.com"})
+	if _, err := service.RotateDeployKey(outsiderCtx, connect.NewRequest(&deploymentsv1.RotateDeployKeyRequest{IntegrationId: "gh-org-a"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("RotateDeployKey by another organization code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+	}
+
+	// Deploy keys: the new key is added before the previous one is removed
+	first, err := service.RotateDeployKey(ctx, connect.NewRequest(&deploymentsv1.RotateDeployKeyRequest{OrganizationId: orgA, IntegrationId: "gh-org-a"}))
+	if err != nil {
+		t.Fatalf("RotateDeployKey: %v", err)
+	}
+	keys := first.Msg.GetDeployKeys()
+	if len(keys) != 1 || keys[0].GetRepository() != "acme/app" || !strings.HasPrefix(keys[0].GetPublicKey(), "ssh-rsa ") {
+		t.Fatalf("deploy keys = %v, want one RSA key for acme/app", keys)
+	}
+	second, err := service.RotateDeployKey(ctx, connect.NewRequest(&deploymentsv1.RotateDeployKeyRequest{IntegrationId: "gh-org-a"}))
+	if err != nil {
+		t.Fatalf("RotateDeployKey again: %v", err)
+	}
+	newKey := second.Msg.GetDeployKeys()[0]
+	if got := api.keys["acme/app"]; len(got) != 1 || got[0].ID != newKey.GetGithubKeyId() || got[0].Key != newKey.GetPublicKey() || newKey.GetPublicKey() == keys[0].GetPublicKey() {
+		t.Fatalf("GitHub deploy keys = %+v, want only the rotated key %d", got, newKey.GetGithubKeyId())
+	}
+	var credential database.GitHubRepositoryCredential
+	if err := db.First(&credential, "integration_id = ? AND repository = ?", "gh-org-a", "acme/app").Error; err != nil {
+		t.Fatalf("load credential: %v", err)
+	}
+	privateKey, err := openGitHubCredential(credential.EncryptedDeployKey, credential.ID)
+	if err != nil || !bytes.Contains(privateKey, []byte("RSA PRIVATE KEY")) {
+		t.Fatalf("stored deploy key = %q (%v), want an encrypted RSA private key", privateKey, err)
+	}
+
+	// Webhook secrets: only the hook delivering to Obiente is rotated
+	rotated, err := service.RotateWebhookSecret(ctx, connect.NewRequest(&deploymentsv1.RotateWebhookSecretRequest{IntegrationId: "gh-org-a"}))
+	if err != nil {
+		t.Fatalf("RotateWebhookSecret: %v", err)
+	}
+	if repos := rotated.Msg.GetRepositories(); len(repos) != 1 || repos[0] != "acme/app" || api.secrets[8] == "" || api.secrets[7] != "" {
+		t.Fatalf("rotated repositories = %v, hook secrets = %v", repos, api.secrets)
+	}
+	body := []byte(`{"ref":"refs/heads/main","repository":{"full_name":"Acme/App"}}`)
+	firstSecret := api.secrets[8]
+	if err := verifyGitHubWebhookSignature(body, githubWebhookSignature(firstSecret, body)); err != nil {
+		t.Fatalf("delivery signed with the rotated secret: %v", err)
+	}
+	if err := verifyGitHubWebhookSignature(body, githubWebhookSignature("app-secret", body)); err != nil {
+		t.Fatalf("delivery signed with the app secret: %v", err)
+	}
+	otherRepo := []byte(`{"repository":{"full_name":"acme/other"}}`)
+	if err := verifyGitHubWebhookSignature(otherRepo, githubWebhookSignature(firstSecret, otherRepo)); err == nil {
+		t.Fatal("another repository's delivery verified with acme/app's secret")
+	}
+
+	if _, err := service.RotateWebhookSecret(ctx, connect.NewRequest(&deploymentsv1.RotateWebhookSecretRequest{IntegrationId: "gh-org-a"})); err != nil {
+		t.Fatalf("RotateWebhookSecret again: %v", err)
+	}
+	if err := verifyGitHubWebhookSignature(body, githubWebhookSignature(api.secrets[8], body)); err != nil {
+		t.Fatalf("delivery signed with the new secret: %v", err)
+	}
+	if err := verifyGitHubWebhookSignature(body, githubWebhookSignature(firstSecret, body)); err != nil {
+		t.Fatalf("delivery signed with the previous secret during the grace period: %v", err)
+	}
+	if err := db.Model(&database.GitHubRepositoryCredential{}).Where("id = ?", credential.ID).Update("webhook_rotated_at", time.Now().Add(-2*githubWebhookSecretGracePeriod)).Error; err != nil {
+		t.Fatalf("age webhook secret: %v", err)
+	}
+	if err := verifyGitHubWebhookSignature(body, githubWebhookSignature(firstSecret, body)); err == nil {
+		t.Fatal("previous secret still accepted after the grace period")
+	}
+
+	// Credentials older than 90 days are rotated by the background job
+	secretBeforeJob := api.secrets[8]
+	stale := time.Now().Add(-githubCredentialMaxAge - time.Hour)
+	if err := db.Model(&database.GitHubRepositoryCredential{}).Where("id = ?", credential.ID).Updates(map[string]any{"deploy_key_rotated_at": stale, "webhook_rotated_at": stale}).Error; err != nil {
+		t.Fatalf("age credentials: %v", err)
+	}
+	count, err := service.RotateStaleGitHubCredentials(context.Background())
+	if err != nil || count != 2 {
+		t.Fatalf("RotateStaleGitHubCredentials = %d, %v, want 2 rotations", count, err)
+	}
+	if got := api.keys["acme/app"]; len(got) != 1 || got[0].ID == newKey.GetGithubKeyId() || api.secrets[8] == secretBeforeJob {
+		t.Fatalf("after the background job keys = %+v and webhook secret unchanged = %v", got, api.secrets[8] == secretBeforeJob)
+	}
+	if count, err := service.RotateStaleGitHubCredentials(context.Background()); err != nil || count != 0 {
+		t.Fatalf("RotateStaleGitHubCredentials on fresh credentials = %d, %v, want 0", count, err)
+	}
+}
@@ -55,7 +55,8 @@ type githubWebhookResponse struct {
 
 // HandleGitHubWebhook receives GitHub webhooks and triggers deployments linked to
 // the pushed repository and branch. Deployments opt into this path by storing a
-// github_integration_id alongside their repository_url.
+// github_integration_id alongside their repository_url. Pull request events manage
+// preview deployments (see handleGitHubPullRequestWebhook).
 func (s *Service) HandleGitHubWebhook(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		writeGitHubWebhookJSON(w, http.StatusMethodNotAllowed, githubWebhookResponse{
@@ -103,6 +104,8 @@ func (s *Service) HandleGitHubWebhook(w http.ResponseWriter, r *http.Request) {
 		})
 	case "push":
 		s.handleGitHubPushWebhook(w, event, body)
+	case "pull_request":
+		s.handleGitHubPullRequestWebhook(w, event, body)
 	default:
 		writeGitHubWebhookJSON(w, http.StatusAccepted, githubWebhookResponse{
 			OK:      true,
@@ -266,9 +269,14 @@ func (s *Service) ensureGitHubWebhookForDeployment(ctx context.Context, deployme
 	return nil
 }
 
+// verifyGitHubWebhookSignature accepts deliveries signed with GITHUB_WEBHOOK_SECRET or with a rotated secret
+// of the repository webhook that sent them
 func verifyGitHubWebhookSignature(body []byte, signatureHeader string) error {
-	secret := os.Getenv("GITHUB_WEBHOOK_SECRET")
-	if secret == "" {
+	candidates := githubRepositoryWebhookSecrets(body)
+	if secret := os.Getenv("GITHUB_WEBHOOK_SECRET"); secret != "" {
+		candidates = append(candidates, secret)
+	}
+	if len(candidates) == 0 {
 		if os.Getenv("DISABLE_AUTH") == "true" {
 			logger.Warn("[GitHubWebhook] GITHUB_WEBHOOK_SECRET is not set; accepting webhook because DISABLE_AUTH=true")
 			return nil
@@ -286,15 +294,15 @@ func verifyGitHubWebhookSignature(body []byte, signatureHeader string) error {
 		return fmt.Errorf("invalid signature encoding: %w", err)
 	}
 
-	mac := hmac.New(sha256.New, []byte(secret))
-	_, _ = mac.Write(body)
-	expectedSignature := mac.Sum(nil)
-
-	if !hmac.Equal(providedSignature, expectedSignature) {
-		return fmt.Errorf("signature mismatch")
+	for _, secret := range candidates {
+		mac := hmac.New(sha256.New, []byte(secret))
+		_, _ = mac.Write(body)
+		if hmac.Equal(providedSignature, mac.Sum(nil)) {
+			return nil
+		}
 	}
 
-	return nil
+	return fmt.Errorf("signature mismatch")
 }
 
 func findDeploymentsForGitHubPush(repoFullName, branch string) ([]database.Deployment, error) {
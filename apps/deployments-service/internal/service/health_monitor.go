@@ -76,9 +76,11 @@ func (s *Service) checkAndRedeployDeployments(ctx context.Context) {
 }
 
 // StartHealthMonitor starts a background service that periodically checks
-// and redeploys deployments that should be running but don't have containers
+// and redeploys deployments that should be running but don't have containers.
+// Deployments with an HTTP health check are probed on their own interval.
 func (s *Service) StartHealthMonitor(ctx context.Context, interval time.Duration) {
 	log.Printf("[HealthMonitor] Starting health monitor service (interval: %v)", interval)
+	go s.runHTTPHealthChecks(ctx)
 	
 	// Run immediately on startup
 	s.checkAndRedeployDeployments(ctx)
@@ -0,0 +1,345 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/docker"
+
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+
+	"connectrpc.com/connect"
+)
+
+const (
+	// httpHealthCheckTick is how often deployments are scanned for due HTTP probes
+	httpHealthCheckTick = 5 * time.Second
+
+	defaultHealthcheckInterval = 30
+	defaultHealthcheckTimeout  = 5
+	defaultHealthcheckRetries  = 3
+	minHealthcheckInterval     = 5
+	maxHealthcheckInterval     = 3600
+	maxHealthcheckRetries      = 10
+
+	healthStatusHealthy   = "healthy"
+	healthStatusUnhealthy = "unhealthy"
+)
+
+// Test hooks for resolving a container's address and redeploying an unhealthy deployment
+var (
+	healthCheckContainerAddress = containerNetworkAddress
+	redeployUnhealthyDeployment = func(ctx context.Context, s *Service, deploymentID string) error {
+		_, err := s.TriggerDeployment(ctx, connect.NewRequest(&deploymentsv1.TriggerDeploymentRequest{DeploymentId: deploymentID}))
+		return err
+	}
+)
+
+// httpHealthState is the probe history of one deployment on this node
+type httpHealthState struct {
+	lastProbe      time.Time
+	failures       int
+	unhealthySince time.Time
+}
+
+// httpHealthTracker keeps probe state in memory; each replica only probes the containers on its own node
+type httpHealthTracker struct {
+	mu     sync.Mutex
+	states map[string]*httpHealthState
+}
+
+func newHTTPHealthTracker() *httpHealthTracker {
+	return &httpHealthTracker{states: make(map[string]*httpHealthState)}
+}
+
+func (t *httpHealthTracker) state(deploymentID string) *httpHealthState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	state, ok := t.states[deploymentID]
+	if !ok {
+		state = &httpHealthState{}
+		t.states[deploymentID] = state
+	}
+	return state
+}
+
+// forget drops the state of deployments that are no longer probed
+func (t *httpHealthTracker) forget(keep map[string]bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for id := range t.states {
+		if !keep[id] {
+			delete(t.states, id)
+		}
+	}
+}
+
+// runHTTPHealthChecks probes deployments with an HTTP health check until ctx is cancelled
+func (s *Service) runHTTPHealthChecks(ctx context.Context) {
+	ticker := time.NewTicker(httpHealthCheckTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkHTTPHealth(ctx, time.Now())
+		}
+	}
+}
+
+// checkHTTPHealth probes every running deployment whose health check interval has elapsed.
+// A deployment becomes unhealthy after healthcheck_retries consecutive failed probes and is
+// redeployed once it has stayed unhealthy for interval * retries seconds.
+func (s *Service) checkHTTPHealth(ctx context.Context, now time.Time) {
+	var deployments []database.Deployment
+	err := database.DB.WithContext(ctx).
+		Where("status = ? AND deleted_at IS NULL AND healthcheck_interval_seconds > 0", int32(deploymentsv1.DeploymentStatus_RUNNING)).
+		Find(&deployments).Error
+	if err != nil {
+		log.Printf("[HealthMonitor] Failed to query deployments with HTTP health checks: %v", err)
+		return
+	}
+
+	configured := make(map[string]bool, len(deployments))
+	for i := range deployments {
+		deployment := &deployments[i]
+		configured[deployment.ID] = true
+
+		interval := time.Duration(*deployment.HealthcheckInterval) * time.Second
+		state := s.httpHealth.state(deployment.ID)
+		if now.Sub(state.lastProbe) < interval {
+			continue
+		}
+		state.lastProbe = now
+
+		probed, probeErr := s.probeDeployment(ctx, deployment)
+		if !probed {
+			continue // No containers on this node
+		}
+		if probeErr == nil {
+			state.failures = 0
+			state.unhealthySince = time.Time{}
+			if deployment.HealthStatus != healthStatusHealthy {
+				s.setHealthStatus(ctx, deployment.ID, healthStatusHealthy)
+			}
+			continue
+		}
+
+		state.failures++
+		retries := int(valueOr(deployment.HealthcheckRetries, defaultHealthcheckRetries))
+		log.Printf("[HealthMonitor] Health check %d/%d failed for deployment %s: %v", state.failures, retries, deployment.ID, probeErr)
+		if state.failures < retries {
+			continue
+		}
+		if state.unhealthySince.IsZero() {
+			state.unhealthySince = now
+			s.setHealthStatus(ctx, deployment.ID, healthStatusUnhealthy)
+			continue
+		}
+		if now.Sub(state.unhealthySince) <= interval*time.Duration(retries) {
+			continue
+		}
+
+		log.Printf("[HealthMonitor] Deployment %s unhealthy since %s, redeploying", deployment.ID, state.unhealthySince.Format(time.RFC3339))
+		// Start over so the new containers get the full grace period
+		state.failures = 0
+		state.unhealthySince = time.Time{}
+		if err := redeployUnhealthyDeployment(s.createSystemContext(), s, deployment.ID); err != nil {
+			log.Printf("[HealthMonitor] Failed to redeploy unhealthy deployment %s: %v", deployment.ID, err)
+		}
+	}
+	s.httpHealth.forget(configured)
+}
+
+// probeDeployment GETs the health check path of each running container of the deployment on this
+// node. probed is false when there is nothing to probe here.
+func (s *Service) probeDeployment(ctx context.Context, deployment *database.Deployment) (probed bool, err error) {
+	locations, err := database.GetAllDeploymentLocations(deployment.ID)
+	if err != nil {
+		log.Printf("[HealthMonitor] Failed to get locations for deployment %s: %v", deployment.ID, err)
+		return false, nil
+	}
+	nodeID := ""
+	if s.manager != nil {
+		nodeID = s.manager.GetNodeID()
+	}
+
+	port := int(valueOr(deployment.HealthcheckPort, 0))
+	if port == 0 {
+		port = determineDeploymentPort(deployment.ID, deployment)
+	}
+	path := "/"
+	if deployment.HealthcheckPath != nil && *deployment.HealthcheckPath != "" {
+		path = *deployment.HealthcheckPath
+	}
+	timeout := time.Duration(valueOr(deployment.HealthcheckTimeout, defaultHealthcheckTimeout)) * time.Second
+
+	for _, location := range locations {
+		if location.Status != "running" || (nodeID != "" && location.NodeID != nodeID) {
+			continue
+		}
+		if port == 0 {
+			return true, fmt.Errorf("deployment has no port to probe")
+		}
+		host, err := healthCheckContainerAddress(ctx, location.ContainerID)
+		if err != nil {
+			return true, err
+		}
+		url := "http://" + net.JoinHostPort(host, strconv.Itoa(port)) + path
+		if err := probeHTTP(ctx, url, timeout, deployment.HealthcheckExpectedStatus); err != nil {
+			return true, err
+		}
+		probed = true
+	}
+	return probed, nil
+}
+
+// probeHTTP succeeds on the expected status, or on any 2xx/3xx status when none is configured
+func probeHTTP(ctx context.Context, url string, timeout time.Duration, expectedStatus *int32) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "obiente-health-check")
+	// Redirects are a valid answer; following them could leave the container
+	httpClient := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if expectedStatus != nil && *expectedStatus > 0 {
+		if resp.StatusCode != int(*expectedStatus) {
+			return fmt.Errorf("GET %s returned %d, want %d", url, resp.StatusCode, *expectedStatus)
+		}
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return fmt.Errorf("GET %s returned %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+// containerNetworkAddress returns the IP of a local container on the obiente network
+func containerNetworkAddress(ctx context.Context, containerID string) (string, error) {
+	dcli, err := docker.New()
+	if err != nil {
+		return "", fmt.Errorf("docker client: %w", err)
+	}
+	defer dcli.Close()
+
+	info, err := dcli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return "", fmt.Errorf("inspect container: %w", err)
+	}
+	if settings := info.NetworkSettings; settings != nil {
+		if nw, ok := settings.Networks["obiente-network"]; ok && nw.IPAddress.IsValid() {
+			return nw.IPAddress.String(), nil
+		}
+		for _, nw := range settings.Networks {
+			if nw.IPAddress.IsValid() {
+				return nw.IPAddress.String(), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("container %s has no IP address", containerID)
+}
+
+func (s *Service) setHealthStatus(ctx context.Context, deploymentID, status string) {
+	if err := s.repo.UpdateHealthStatus(ctx, deploymentID, status); err != nil {
+		log.Printf("[HealthMonitor] Failed to set health status of deployment %s: %v", deploymentID, err)
+	}
+}
+
+func valueOr(v *int32, fallback int32) int32 {
+	if v == nil || *v <= 0 {
+		return fallback
+	}
+	return *v
+}
+
+func orDefault(v, fallback int32) int32 {
+	if v == 0 {
+		return fallback
+	}
+	return v
+}
+
+// UpdateDeploymentHealthCheck configures the HTTP health check probed by the health monitor
+func (s *Service) UpdateDeploymentHealthCheck(ctx context.Context, req *connect.Request[deploymentsv1.UpdateDeploymentHealthCheckRequest]) (*connect.Response[deploymentsv1.UpdateDeploymentHealthCheckResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+
+	if err := s.permissionChecker.CheckScopedPermission(ctx, orgID, auth.ScopedPermission{Permission: auth.PermissionDeploymentUpdate, ResourceType: "deployment", ResourceID: deploymentID}); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	dbDeployment, err := s.repo.GetByID(ctx, deploymentID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentID))
+	}
+	if dbDeployment.OrganizationID != orgID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("deployment does not belong to organization"))
+	}
+
+	dbDeployment.HealthcheckInterval = nil
+	dbDeployment.HealthcheckTimeout = nil
+	dbDeployment.HealthcheckRetries = nil
+	if req.Msg.GetEnabled() {
+		path := strings.TrimSpace(req.Msg.GetPath())
+		if path == "" {
+			path = "/"
+		}
+		if !strings.HasPrefix(path, "/") || len(path) > 1024 || strings.ContainsAny(path, " \t\r\n") {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path must be an absolute URL path"))
+		}
+		if req.Msg.Port != nil && (req.Msg.GetPort() < 1 || req.Msg.GetPort() > 65535) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("port must be between 1 and 65535"))
+		}
+		interval := orDefault(req.Msg.GetIntervalSeconds(), defaultHealthcheckInterval)
+		if interval < minHealthcheckInterval || interval > maxHealthcheckInterval {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("interval_seconds must be between %d and %d", minHealthcheckInterval, maxHealthcheckInterval))
+		}
+		timeout := orDefault(req.Msg.GetTimeoutSeconds(), defaultHealthcheckTimeout)
+		if timeout < 1 || timeout > interval {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("timeout_seconds must be between 1 and interval_seconds"))
+		}
+		retries := orDefault(req.Msg.GetRetries(), defaultHealthcheckRetries)
+		if retries < 1 || retries > maxHealthcheckRetries {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("retries must be between 1 and %d", maxHealthcheckRetries))
+		}
+		if req.Msg.ExpectedStatus != nil && (req.Msg.GetExpectedStatus() < 100 || req.Msg.GetExpectedStatus() > 599) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expected_status must be an HTTP status code"))
+		}
+
+		hcType := int32(deploymentsv1.HealthCheckType_HEALTHCHECK_HTTP)
+		dbDeployment.HealthcheckType = &hcType
+		dbDeployment.HealthcheckPath = &path
+		dbDeployment.HealthcheckPort = req.Msg.Port
+		dbDeployment.HealthcheckExpectedStatus = req.Msg.ExpectedStatus
+		dbDeployment.HealthcheckInterval = &interval
+		dbDeployment.HealthcheckTimeout = &timeout
+		dbDeployment.HealthcheckRetries = &retries
+	}
+	if err := s.repo.Update(ctx, dbDeployment); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update health check: %w", err))
+	}
+
+	return connect.NewResponse(&deploymentsv1.UpdateDeploymentHealthCheckResponse{Deployment: dbDeploymentToProto(dbDeployment)}), nil
+}
@@ -0,0 +1,147 @@
+package deployments
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestHTTPHealthCheckMarksUnhealthyAndRedeploys(t *testing.T) {
+	db := newTestDB(t,
+		&database.Deployment{},
+		&database.DeploymentLocation{},
+		&database.DeploymentRouting{},
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.OrgRole{},
+		&database.OrgRoleBinding{},
+		&database.ResourceTag{},
+	)
+	seedDeploymentServiceIsolationData(t, db)
+
+	var failing atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" || failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	host, portStr, _ := net.SplitHostPort(server.Listener.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+
+	var redeployed []string
+	previousAddress, previousRedeploy := healthCheckContainerAddress, redeployUnhealthyDeployment
+	healthCheckContainerAddress = func(context.Context, string) (string, error) { return host, nil }
+	redeployUnhealthyDeployment = func(_ context.Context, _ *Service, deploymentID string) error {
+		redeployed = append(redeployed, deploymentID)
+		return nil
+	}
+	t.Cleanup(func() { healthCheckContainerAddress, redeployUnhealthyDeployment = previousAddress, previousRedeploy })
+
+	running := int32(deploymentsv1.DeploymentStatus_RUNNING)
+	if err := db.Model(&database.Deployment{}).Where("id = ?", "dep-org-a-owner").Update("status", running).Error; err != nil {
+		t.Fatalf("set deployment running: %v", err)
+	}
+	if err := db.Create(&database.DeploymentLocation{ID: "loc-1", DeploymentID: "dep-org-a-owner", NodeID: "node-1", ContainerID: "container-1", Status: "running"}).Error; err != nil {
+		t.Fatalf("seed location: %v", err)
+	}
+
+	service := NewService(context.Background(), database.NewDeploymentRepository(db, nil), nil, nil)
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	if _, err := service.UpdateDeploymentHealthCheck(ctx, connect.NewRequest(&deploymentsv1.UpdateDeploymentHealthCheckRequest{
+		OrganizationId: "org-a", DeploymentId: "dep-org-a-owner", Enabled: true, Path: "healthz",
+	})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("relative path code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+	}
+	res, err := service.UpdateDeploymentHealthCheck(ctx, connect.NewRequest(&deploymentsv1.UpdateDeploymentHealthCheckRequest{
+		OrganizationId:  "org-a",
+		DeploymentId:    "dep-org-a-owner",
+		Enabled:         true,
+		Path:            "/healthz",
+		Port:            proto.Int32(int32(port)),
+		IntervalSeconds: 10,
+		TimeoutSeconds:  2,
+		Retries:         2,
+	}))
+	if err != nil {
+		t.Fatalf("UpdateDeploymentHealthCheck: %v", err)
+	}
+	if got := res.Msg.GetDeployment(); got.GetHealthcheckIntervalSeconds() != 10 || got.GetHealthcheckRetries() != 2 || got.GetHealthcheckPath() != "/healthz" {
+		t.Fatalf("health check config = interval %d, retries %d, path %q", got.GetHealthcheckIntervalSeconds(), got.GetHealthcheckRetries(), got.GetHealthcheckPath())
+	}
+
+	healthStatus := func() string {
+		var deployment database.Deployment
+		db.First(&deployment, "id = ?", "dep-org-a-owner")
+		return deployment.HealthStatus
+	}
+	start := time.Now()
+	at := func(seconds int) time.Time { return start.Add(time.Duration(seconds) * time.Second) }
+
+	service.checkHTTPHealth(context.Background(), at(0))
+	if got := healthStatus(); got != healthStatusHealthy {
+		t.Fatalf("health status after a passing probe = %q, want healthy", got)
+	}
+
+	// Probes before the interval has elapsed are skipped
+	failing.Store(true)
+	service.checkHTTPHealth(context.Background(), at(5))
+	if got := healthStatus(); got != healthStatusHealthy {
+		t.Fatalf("probe ran before its interval: health status = %q", got)
+	}
+
+	// Two failures make the deployment unhealthy
+	service.checkHTTPHealth(context.Background(), at(10))
+	service.checkHTTPHealth(context.Background(), at(20))
+	if got := healthStatus(); got != healthStatusUnhealthy {
+		t.Fatalf("health status after %d failed probes = %q, want unhealthy", 2, got)
+	}
+	if len(redeployed) != 0 {
+		t.Fatalf("redeployed as soon as the deployment became unhealthy")
+	}
+
+	// Unhealthy for more than interval * retries seconds triggers a redeploy
+	service.checkHTTPHealth(context.Background(), at(30))
+	service.checkHTTPHealth(context.Background(), at(40))
+	if len(redeployed) != 0 {
+		t.Fatalf("redeployed after 20s unhealthy, want more than 20s")
+	}
+	service.checkHTTPHealth(context.Background(), at(50))
+	if len(redeployed) != 1 || redeployed[0] != "dep-org-a-owner" {
+		t.Fatalf("redeployed = %v, want dep-org-a-owner once", redeployed)
+	}
+
+	// Recovery resets the health status
+	failing.Store(false)
+	service.checkHTTPHealth(context.Background(), at(60))
+	if got := healthStatus(); got != healthStatusHealthy {
+		t.Fatalf("health status after recovery = %q, want healthy", got)
+	}
+
+	// Disabling the health check stops probing
+	if _, err := service.UpdateDeploymentHealthCheck(ctx, connect.NewRequest(&deploymentsv1.UpdateDeploymentHealthCheckRequest{OrganizationId: "org-a", DeploymentId: "dep-org-a-owner"})); err != nil {
+		t.Fatalf("disable health check: %v", err)
+	}
+	failing.Store(true)
+	for i := 7; i < 12; i++ {
+		service.checkHTTPHealth(context.Background(), at(i*10))
+	}
+	if got := healthStatus(); got != healthStatusHealthy {
+		t.Fatalf("disabled health check still probed: health status = %q", got)
+	}
+}
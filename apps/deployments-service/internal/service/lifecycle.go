@@ -59,7 +59,7 @@ func (s *Service) TriggerDeployment(ctx context.Context, req *connect.Request[de
 	if s.manager != nil && orchestrator.TargetNodeFromContext(ctx) == "" {
 		locations, locErr := database.GetAllDeploymentLocations(deploymentID)
 		if locErr == nil && len(locations) == 0 {
-			targetNode, err := s.manager.SelectTargetNode(ctx, "")
+			targetNode, err := s.manager.SelectTargetNode(ctx, deploymentID, "")
 			if err != nil {
 				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to select target node: %w", err))
 			}
@@ -404,6 +404,13 @@ func (s *Service) TriggerDeployment(ctx context.Context, req *connect.Request[de
 			// Build using strategy
 			streamer.Write([]byte(fmt.Sprintf("🔨 Building deployment with %s...\n", strategy.Name())))
 			result, err := strategy.Build(buildCtx, dbDeployment, buildConfig)
+			if result != nil && len(result.LintWarnings) > 0 {
+				if lintJSON, jsonErr := json.Marshal(result.LintWarnings); jsonErr == nil {
+					if updateErr := s.buildHistoryRepo.UpdateBuildLintWarnings(buildCtx, buildID, string(lintJSON)); updateErr != nil {
+						logger.Warn("[TriggerDeployment] Failed to store Dockerfile lint warnings: %v", updateErr)
+					}
+				}
+			}
 			if err != nil || !result.Success {
 				logger.Error("[TriggerDeployment] Build failed: %v", err)
 				if result != nil && result.Error != nil {
@@ -595,6 +602,11 @@ func (s *Service) TriggerDeployment(ctx context.Context, req *connect.Request[de
 				dbDeployment.Port = &port
 			}
 			s.repo.Update(buildCtx, dbDeployment)
+
+			// Keep the deployed image and env vars so the deployment can be rolled back to them
+			if result.ComposeYaml == "" && result.ImageName != "" {
+				s.recordDeploymentVersion(buildCtx, dbDeployment)
+			}
 		}
 
 		// Update build status in build history as successful (build completed)
@@ -800,7 +812,7 @@ func (s *Service) StartDeployment(ctx context.Context, req *connect.Request[depl
 	if s.manager != nil && orchestrator.TargetNodeFromContext(ctx) == "" {
 		locations, locErr := database.GetAllDeploymentLocations(deploymentID)
 		if locErr == nil && len(locations) == 0 {
-			targetNode, err := s.manager.SelectTargetNode(ctx, "")
+			targetNode, err := s.manager.SelectTargetNode(ctx, deploymentID, "")
 			if err != nil {
 				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to select target node: %w", err))
 			}
@@ -1138,6 +1150,65 @@ func (s *Service) RestartDeployment(ctx context.Context, req *connect.Request[de
 	return res, nil
 }
 
+// RollbackDeployment discards the inactive blue-green color of a deployment and keeps the active one running
+func (s *Service) RollbackDeployment(ctx context.Context, req *connect.Request[deploymentsv1.RollbackDeploymentRequest]) (*connect.Response[deploymentsv1.RollbackDeploymentResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.permissionChecker.CheckScopedPermission(ctx, orgID, auth.ScopedPermission{Permission: auth.PermissionDeploymentRestart, ResourceType: "deployment", ResourceID: deploymentID}); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	dbDep, err := s.repo.GetByID(ctx, deploymentID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentID))
+	}
+	if dbDep.ComposeYaml != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("compose deployments do not support rollback"))
+	}
+
+	if shouldForward, targetNodeID := s.getDeploymentForwardTarget(ctx, deploymentID); shouldForward {
+		reqBody, _ := json.Marshal(req.Msg)
+		headers := map[string]string{"Authorization": req.Header().Get("Authorization")}
+		bodyBytes, err := s.forwardUnaryRequest(ctx, reqBody, targetNodeID, "/obiente.cloud.deployments.v1.DeploymentService/RollbackDeployment", headers, &deploymentsv1.RollbackDeploymentResponse{})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to forward request: %w", err))
+		}
+
+		var response deploymentsv1.RollbackDeploymentResponse
+		if err := json.Unmarshal(bodyBytes, &response); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode response: %w", err))
+		}
+		return connect.NewResponse(&response), nil
+	}
+
+	if s.manager == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("deployment manager not available"))
+	}
+	if req.Msg.Version != nil {
+		version, err := s.rollbackToVersion(ctx, dbDep, req.Msg.GetVersion())
+		if err != nil {
+			return nil, err
+		}
+		if refreshed, err := s.repo.GetByID(ctx, deploymentID); err == nil {
+			dbDep = refreshed
+		}
+		return connect.NewResponse(&deploymentsv1.RollbackDeploymentResponse{
+			Deployment: dbDeploymentToProto(dbDep),
+			Version:    deploymentVersionToProto(version),
+		}), nil
+	}
+	if err := s.manager.Rollback(ctx, deploymentID); err != nil {
+		logger.Warn("[RollbackDeployment] Failed to roll back deployment %s: %v", deploymentID, err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to roll back deployment: %w", err))
+	}
+
+	// Reload so the response carries the active color
+	if refreshed, err := s.repo.GetByID(ctx, deploymentID); err == nil {
+		dbDep = refreshed
+	}
+	return connect.NewResponse(&deploymentsv1.RollbackDeploymentResponse{Deployment: dbDeploymentToProto(dbDep)}), nil
+}
+
 // ScaleDeployment scales a deployment
 func (s *Service) ScaleDeployment(ctx context.Context, req *connect.Request[deploymentsv1.ScaleDeploymentRequest]) (*connect.Response[deploymentsv1.ScaleDeploymentResponse], error) {
 	deploymentID := req.Msg.GetDeploymentId()
@@ -1150,6 +1221,9 @@ func (s *Service) ScaleDeployment(ctx context.Context, req *connect.Request[depl
 	if newReplicas <= 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("replicas must be > 0"))
 	}
+	if maxReplicas := quota.GetMaxReplicas(orgID); maxReplicas > 0 && newReplicas > maxReplicas {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("replicas exceeds the plan limit of %d replicas", maxReplicas))
+	}
 	dbDep, err := s.repo.GetByID(ctx, deploymentID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentID))
@@ -0,0 +1,457 @@
+package deployments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/docker"
+	"github.com/obiente/cloud/apps/shared/pkg/middleware"
+
+	commonv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/common/v1"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+
+	"nhooyr.io/websocket"
+)
+
+const (
+	// deploymentLogHistoryLimit caps the recent lines kept in Redis for last_n replay
+	deploymentLogHistoryLimit = 1000
+	deploymentLogHistoryTTL   = 24 * time.Hour
+
+	deploymentLogFollowerTTL     = 30 * time.Second
+	deploymentLogFollowRetry     = 10 * time.Second
+	deploymentLogHeartbeat       = 30 * time.Second
+	deploymentLogWriteTimeout    = 10 * time.Second
+	deploymentLogMaxFilterLength = 512
+)
+
+var errDeploymentLogBusUnavailable = errors.New("redis is not configured")
+
+// deploymentLogFrame is a JSON frame sent to log WebSocket clients. Frames are stored in
+// Redis as-is, so replayed and live lines reach clients without re-encoding.
+type deploymentLogFrame struct {
+	Timestamp time.Time `json:"timestamp"`
+	Stream    string    `json:"stream"` // "stdout" or "stderr"
+	Message   string    `json:"message"`
+	Level     string    `json:"level,omitempty"` // "trace", "debug", "info", "warn" or "error"
+}
+
+// deploymentLogChannel returns the Redis pub/sub channel carrying live log lines for a deployment
+func deploymentLogChannel(deploymentID string) string {
+	return "deployment_logs:" + deploymentID
+}
+
+// deploymentLogHistoryKey returns the Redis list holding a deployment's recent log lines
+func deploymentLogHistoryKey(deploymentID string) string {
+	return "deployment_logs:" + deploymentID + ":recent"
+}
+
+// deploymentLogFollowerKey returns the Redis lock held by the replica publishing a deployment's logs
+func deploymentLogFollowerKey(deploymentID string) string {
+	return "deployment_logs:" + deploymentID + ":follower"
+}
+
+// deploymentLogBus moves encoded log frames through Redis; tests replace it with a fake
+type deploymentLogBus interface {
+	Publish(ctx context.Context, deploymentID string, frames [][]byte) error
+	Subscribe(ctx context.Context, deploymentID string) (<-chan string, func(), error)
+	Recent(ctx context.Context, deploymentID string, n int) ([]string, error)
+}
+
+var deploymentLogs deploymentLogBus = redisDeploymentLogBus{}
+
+type redisDeploymentLogBus struct{}
+
+func (redisDeploymentLogBus) Publish(ctx context.Context, deploymentID string, frames [][]byte) error {
+	if database.RedisClient == nil {
+		return errDeploymentLogBusUnavailable
+	}
+	historyKey := deploymentLogHistoryKey(deploymentID)
+	values := make([]interface{}, 0, len(frames))
+	pipe := database.RedisClient.GetClient().TxPipeline()
+	for _, frame := range frames {
+		pipe.Publish(ctx, deploymentLogChannel(deploymentID), frame)
+		values = append(values, frame)
+	}
+	pipe.RPush(ctx, historyKey, values...)
+	pipe.LTrim(ctx, historyKey, -deploymentLogHistoryLimit, -1)
+	pipe.Expire(ctx, historyKey, deploymentLogHistoryTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (redisDeploymentLogBus) Subscribe(ctx context.Context, deploymentID string) (<-chan string, func(), error) {
+	if database.RedisClient == nil {
+		return nil, nil, errDeploymentLogBusUnavailable
+	}
+	pubsub := database.RedisClient.GetClient().Subscribe(ctx, deploymentLogChannel(deploymentID))
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, nil, err
+	}
+	payloads := make(chan string, 256)
+	go func() {
+		defer close(payloads)
+		for msg := range pubsub.Channel() {
+			select {
+			case payloads <- msg.Payload:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return payloads, func() { _ = pubsub.Close() }, nil
+}
+
+func (redisDeploymentLogBus) Recent(ctx context.Context, deploymentID string, n int) ([]string, error) {
+	if database.RedisClient == nil {
+		return nil, errDeploymentLogBusUnavailable
+	}
+	return database.RedisClient.GetClient().LRange(ctx, deploymentLogHistoryKey(deploymentID), int64(-n), -1).Result()
+}
+
+// publishDeploymentLogLines sends log lines to live log WebSocket clients and the replay history
+func publishDeploymentLogLines(ctx context.Context, deploymentID string, lines []*deploymentsv1.DeploymentLogLine) {
+	frames := make([][]byte, 0, len(lines))
+	for _, line := range lines {
+		if line == nil || strings.TrimSpace(line.Line) == "" {
+			continue
+		}
+		frame := deploymentLogFrame{
+			Timestamp: time.Now().UTC(),
+			Stream:    "stdout",
+			Message:   line.Line,
+			Level:     logLevelName(line.LogLevel),
+		}
+		if line.Timestamp != nil {
+			frame.Timestamp = line.Timestamp.AsTime()
+		}
+		if line.Stderr {
+			frame.Stream = "stderr"
+		}
+		payload, err := json.Marshal(frame)
+		if err != nil {
+			continue
+		}
+		frames = append(frames, payload)
+	}
+	if len(frames) == 0 {
+		return
+	}
+	if err := deploymentLogs.Publish(ctx, deploymentID, frames); err != nil && !errors.Is(err, errDeploymentLogBusUnavailable) {
+		log.Printf("[Deployment Logs WS] Failed to publish %d log lines for %s: %v", len(frames), deploymentID, err)
+	}
+}
+
+var logLevelNames = map[commonv1.LogLevel]string{
+	commonv1.LogLevel_LOG_LEVEL_TRACE: "trace",
+	commonv1.LogLevel_LOG_LEVEL_DEBUG: "debug",
+	commonv1.LogLevel_LOG_LEVEL_INFO:  "info",
+	commonv1.LogLevel_LOG_LEVEL_WARN:  "warn",
+	commonv1.LogLevel_LOG_LEVEL_ERROR: "error",
+}
+
+func logLevelName(level commonv1.LogLevel) string {
+	if name, ok := logLevelNames[level]; ok {
+		return name
+	}
+	return "info"
+}
+
+func parseLogLevel(name string) (commonv1.LogLevel, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "warning" {
+		name = "warn"
+	}
+	for level, levelName := range logLevelNames {
+		if levelName == name {
+			return level, true
+		}
+	}
+	return commonv1.LogLevel_LOG_LEVEL_UNSPECIFIED, false
+}
+
+// deploymentLogFilter selects the log lines sent to a WebSocket client
+type deploymentLogFilter struct {
+	pattern  *regexp.Regexp    // nil matches every message
+	minLevel commonv1.LogLevel // lines below this level are dropped
+}
+
+func parseDeploymentLogFilter(pattern, level string) (deploymentLogFilter, error) {
+	var filter deploymentLogFilter
+	if pattern != "" {
+		if len(pattern) > deploymentLogMaxFilterLength {
+			return filter, fmt.Errorf("filter must be at most %d characters", deploymentLogMaxFilterLength)
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return filter, fmt.Errorf("invalid filter: %w", err)
+		}
+		filter.pattern = re
+	}
+	if level != "" {
+		minLevel, ok := parseLogLevel(level)
+		if !ok {
+			return filter, fmt.Errorf("invalid level %q: must be trace, debug, info, warn or error", level)
+		}
+		filter.minLevel = minLevel
+	}
+	return filter, nil
+}
+
+func (f deploymentLogFilter) matches(payload string) bool {
+	if f.pattern == nil && f.minLevel == commonv1.LogLevel_LOG_LEVEL_UNSPECIFIED {
+		return true
+	}
+	var frame deploymentLogFrame
+	if err := json.Unmarshal([]byte(payload), &frame); err != nil {
+		return false
+	}
+	if level, ok := parseLogLevel(frame.Level); ok && level < f.minLevel {
+		return false
+	}
+	return f.pattern == nil || f.pattern.MatchString(frame.Message)
+}
+
+// streamDeploymentLogFrames sends the last lastN stored lines followed by live ones until ctx ends.
+// It subscribes before reading the history so no line falls between the two.
+func streamDeploymentLogFrames(ctx context.Context, deploymentID string, filter deploymentLogFilter, lastN int, send func(payload string) error) error {
+	live, unsubscribe, err := deploymentLogs.Subscribe(ctx, deploymentID)
+	if err != nil {
+		return fmt.Errorf("subscribe: %w", err)
+	}
+	defer unsubscribe()
+
+	replayed := make(map[string]struct{})
+	if lastN > 0 {
+		recent, err := deploymentLogs.Recent(ctx, deploymentID, lastN)
+		if err != nil {
+			return fmt.Errorf("load recent logs: %w", err)
+		}
+		for _, payload := range recent {
+			replayed[payload] = struct{}{}
+			if !filter.matches(payload) {
+				continue
+			}
+			if err := send(payload); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case payload, ok := <-live:
+			if !ok {
+				return errors.New("log subscription closed")
+			}
+			if _, ok := replayed[payload]; ok {
+				// Published while the history was being read
+				delete(replayed, payload)
+				continue
+			}
+			if !filter.matches(payload) {
+				continue
+			}
+			if err := send(payload); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// followDeploymentLogs publishes the deployment's container logs while a log WebSocket client is
+// connected. A Redis lock keeps a single follower per deployment across connections and replicas.
+func (s *Service) followDeploymentLogs(ctx context.Context, deploymentID string) {
+	if database.RedisClient == nil {
+		return
+	}
+	client := database.RedisClient.GetClient()
+	lockKey := deploymentLogFollowerKey(deploymentID)
+
+	retry := time.NewTicker(deploymentLogFollowRetry)
+	defer retry.Stop()
+	for {
+		acquired, err := client.SetNX(ctx, lockKey, "1", deploymentLogFollowerTTL).Result()
+		if err == nil && acquired {
+			s.publishContainerLogs(ctx, deploymentID, lockKey)
+			client.Del(context.Background(), lockKey)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-retry.C:
+		}
+	}
+}
+
+func (s *Service) publishContainerLogs(ctx context.Context, deploymentID, lockKey string) {
+	dcli, err := docker.New()
+	if err != nil {
+		log.Printf("[Deployment Logs WS] Docker client unavailable for %s: %v", deploymentID, err)
+		return
+	}
+	defer dcli.Close()
+
+	loc, err := s.findContainerForDeployment(ctx, deploymentID, "", "", dcli)
+	if err != nil {
+		return
+	}
+	if shouldForward, nodeID := s.shouldForwardToNode(loc); shouldForward {
+		log.Printf("[Deployment Logs WS] Container for %s runs on node %s; not following it from here", deploymentID, nodeID)
+		return
+	}
+
+	reader, err := dcli.ContainerLogs(ctx, loc.ContainerID, "0", true, nil, nil)
+	if err != nil {
+		log.Printf("[Deployment Logs WS] Failed to follow logs for %s: %v", deploymentID, err)
+		return
+	}
+	defer reader.Close()
+
+	followCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		refresh := time.NewTicker(deploymentLogFollowerTTL / 3)
+		defer refresh.Stop()
+		for {
+			select {
+			case <-followCtx.Done():
+				return
+			case <-refresh.C:
+				database.RedisClient.GetClient().Expire(followCtx, lockKey, deploymentLogFollowerTTL)
+			}
+		}
+	}()
+
+	_ = readDockerContainerLogLines(reader, func(line *dockerLogLine) bool {
+		publishDeploymentLogLines(followCtx, deploymentID, []*deploymentsv1.DeploymentLogLine{dockerLogLineToProto(deploymentID, line)})
+		return followCtx.Err() == nil
+	})
+}
+
+// HandleDeploymentLogsWebSocket upgrades the connection to a WebSocket and streams a deployment's
+// logs to it as JSON frames (timestamp, stream, message, level).
+//
+// Query parameters: deployment_id (required); filter, a regular expression matched against each
+// message; level, the lowest log level to send; last_n, the number of stored lines to replay
+// before live ones. The JWT is taken from the Authorization header or the token query parameter.
+func (s *Service) HandleDeploymentLogsWebSocket(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if !middleware.IsOriginAllowed(origin) {
+		log.Printf("[Deployment Logs WS] Origin %s not allowed", origin)
+		http.Error(w, "Origin not allowed", http.StatusForbidden)
+		return
+	}
+
+	query := r.URL.Query()
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		if token := strings.TrimSpace(query.Get("token")); token != "" {
+			authHeader = "Bearer " + token
+		}
+	}
+	ctx, _, err := auth.AuthenticateAndSetContext(r.Context(), authHeader)
+	if err != nil {
+		http.Error(w, "Authentication required", http.StatusUnauthorized)
+		return
+	}
+
+	deploymentID := strings.TrimSpace(query.Get("deployment_id"))
+	if deploymentID == "" {
+		http.Error(w, "deployment_id is required", http.StatusBadRequest)
+		return
+	}
+	filter, err := parseDeploymentLogFilter(query.Get("filter"), query.Get("level"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	lastN := 0
+	if raw := query.Get("last_n"); raw != "" {
+		lastN, err = strconv.Atoi(raw)
+		if err != nil || lastN < 0 || lastN > deploymentLogHistoryLimit {
+			http.Error(w, fmt.Sprintf("last_n must be between 0 and %d", deploymentLogHistoryLimit), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var deployment database.Deployment
+	if err := database.DB.WithContext(ctx).Select("id", "organization_id").First(&deployment, "id = ?", deploymentID).Error; err != nil {
+		http.Error(w, "Deployment not found", http.StatusNotFound)
+		return
+	}
+	if err := s.permissionChecker.CheckScopedPermission(ctx, deployment.OrganizationID, auth.ScopedPermission{Permission: auth.PermissionDeploymentRead, ResourceType: "deployment", ResourceID: deploymentID}); err != nil {
+		http.Error(w, "Permission denied", http.StatusForbidden)
+		return
+	}
+
+	if database.RedisClient == nil {
+		http.Error(w, "Log streaming unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	normalizeWebSocketHostForOrigin(r, origin, "Deployment Logs WS")
+	acceptOptions := &websocket.AcceptOptions{}
+	corsConfig := middleware.DefaultCORSConfig()
+	if !(len(corsConfig.AllowedOrigins) == 1 && corsConfig.AllowedOrigins[0] == "*") {
+		acceptOptions.OriginPatterns = append([]string{}, corsConfig.AllowedOrigins...)
+		if origin != "" {
+			acceptOptions.OriginPatterns = append(acceptOptions.OriginPatterns, origin)
+		}
+	}
+
+	conn, err := websocket.Accept(w, r, acceptOptions)
+	if err != nil {
+		log.Printf("[Deployment Logs WS] Failed to accept websocket connection: %v", err)
+		return
+	}
+	defer conn.Close(websocket.StatusNormalClosure, "")
+
+	// Clients only send control frames; CloseRead handles pongs and cancels ctx on close
+	ctx, cancel := context.WithCancel(conn.CloseRead(ctx))
+	defer cancel()
+
+	go s.followDeploymentLogs(ctx, deploymentID)
+
+	go func() {
+		heartbeat := time.NewTicker(deploymentLogHeartbeat)
+		defer heartbeat.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-heartbeat.C:
+				pingCtx, pingCancel := context.WithTimeout(ctx, deploymentLogWriteTimeout)
+				err := conn.Ping(pingCtx)
+				pingCancel()
+				if err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	err = streamDeploymentLogFrames(ctx, deploymentID, filter, lastN, func(payload string) error {
+		writeCtx, writeCancel := context.WithTimeout(ctx, deploymentLogWriteTimeout)
+		defer writeCancel()
+		return conn.Write(writeCtx, websocket.MessageText, []byte(payload))
+	})
+	if err != nil && ctx.Err() == nil {
+		log.Printf("[Deployment Logs WS] Stream for %s ended: %v", deploymentID, err)
+		conn.Close(websocket.StatusInternalError, "log stream failed")
+	}
+}
@@ -0,0 +1,158 @@
+package deployments
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	commonv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/common/v1"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeDeploymentLogBus is an in-memory stand-in for Redis pub/sub and the recent-lines list
+type fakeDeploymentLogBus struct {
+	mu          sync.Mutex
+	history     map[string][]string
+	subscribers map[string][]chan string
+	subscribed  chan struct{}
+}
+
+func newFakeDeploymentLogBus(t *testing.T) *fakeDeploymentLogBus {
+	bus := &fakeDeploymentLogBus{
+		history:     make(map[string][]string),
+		subscribers: make(map[string][]chan string),
+		subscribed:  make(chan struct{}, 1),
+	}
+	previous := deploymentLogs
+	deploymentLogs = bus
+	t.Cleanup(func() { deploymentLogs = previous })
+	return bus
+}
+
+func (b *fakeDeploymentLogBus) Publish(ctx context.Context, deploymentID string, frames [][]byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, frame := range frames {
+		b.history[deploymentID] = append(b.history[deploymentID], string(frame))
+		for _, subscriber := range b.subscribers[deploymentID] {
+			subscriber <- string(frame)
+		}
+	}
+	return nil
+}
+
+func (b *fakeDeploymentLogBus) Subscribe(ctx context.Context, deploymentID string) (<-chan string, func(), error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	subscriber := make(chan string, 16)
+	b.subscribers[deploymentID] = append(b.subscribers[deploymentID], subscriber)
+	b.subscribed <- struct{}{}
+	return subscriber, func() {}, nil
+}
+
+func (b *fakeDeploymentLogBus) Recent(ctx context.Context, deploymentID string, n int) ([]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	history := b.history[deploymentID]
+	if len(history) > n {
+		history = history[len(history)-n:]
+	}
+	return append([]string(nil), history...), nil
+}
+
+func logLine(message string, level commonv1.LogLevel, stderr bool) *deploymentsv1.DeploymentLogLine {
+	return &deploymentsv1.DeploymentLogLine{
+		Line:      message,
+		Timestamp: timestamppb.New(time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)),
+		Stderr:    stderr,
+		LogLevel:  level,
+	}
+}
+
+func TestStreamDeploymentLogFramesReplaysAndFiltersLines(t *testing.T) {
+	newFakeDeploymentLogBus(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	publishDeploymentLogLines(ctx, "dep-1", []*deploymentsv1.DeploymentLogLine{
+		logLine("db connection timeout, retrying", commonv1.LogLevel_LOG_LEVEL_WARN, false), // outside last_n
+		logLine("listening on :8080", commonv1.LogLevel_LOG_LEVEL_INFO, false),
+		logLine("upstream timeout after 30s", commonv1.LogLevel_LOG_LEVEL_ERROR, true),
+		logLine("debug timeout tick", commonv1.LogLevel_LOG_LEVEL_DEBUG, false),
+	})
+	publishDeploymentLogLines(ctx, "dep-2", []*deploymentsv1.DeploymentLogLine{
+		logLine("other deployment timeout", commonv1.LogLevel_LOG_LEVEL_ERROR, false),
+	})
+
+	filter, err := parseDeploymentLogFilter("time(out|d out)", "warn")
+	if err != nil {
+		t.Fatalf("parse filter: %v", err)
+	}
+
+	frames := make(chan deploymentLogFrame, 16)
+	done := make(chan error, 1)
+	go func() {
+		done <- streamDeploymentLogFrames(ctx, "dep-1", filter, 3, func(payload string) error {
+			var frame deploymentLogFrame
+			if err := json.Unmarshal([]byte(payload), &frame); err != nil {
+				t.Errorf("frame %q is not JSON: %v", payload, err)
+			}
+			frames <- frame
+			return nil
+		})
+	}()
+	<-deploymentLogs.(*fakeDeploymentLogBus).subscribed
+
+	publishDeploymentLogLines(ctx, "dep-1", []*deploymentsv1.DeploymentLogLine{
+		logLine("request served", commonv1.LogLevel_LOG_LEVEL_ERROR, false),
+		logLine("cache timeout", commonv1.LogLevel_LOG_LEVEL_INFO, false),
+		logLine("worker timed out", commonv1.LogLevel_LOG_LEVEL_ERROR, true),
+	})
+
+	want := []deploymentLogFrame{
+		{Stream: "stderr", Message: "upstream timeout after 30s", Level: "error"},
+		{Stream: "stderr", Message: "worker timed out", Level: "error"},
+	}
+	for _, w := range want {
+		select {
+		case got := <-frames:
+			if got.Stream != w.Stream || got.Message != w.Message || got.Level != w.Level {
+				t.Fatalf("frame = %+v, want %+v", got, w)
+			}
+			if !got.Timestamp.Equal(time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)) {
+				t.Fatalf("frame timestamp = %s, want the log line's timestamp", got.Timestamp)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for %q", w.Message)
+		}
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("stream ended with %v, want nil after the client disconnects", err)
+	}
+	select {
+	case frame := <-frames:
+		t.Fatalf("unexpected frame %+v", frame)
+	default:
+	}
+}
+
+func TestParseDeploymentLogFilterRejectsInvalidInput(t *testing.T) {
+	for _, tt := range []struct{ pattern, level string }{
+		{"(unclosed", ""},
+		{"", "verbose"},
+	} {
+		if _, err := parseDeploymentLogFilter(tt.pattern, tt.level); err == nil {
+			t.Errorf("parseDeploymentLogFilter(%q, %q) succeeded, want error", tt.pattern, tt.level)
+		}
+	}
+
+	filter, err := parseDeploymentLogFilter("", "WARNING")
+	if err != nil || filter.minLevel != commonv1.LogLevel_LOG_LEVEL_WARN {
+		t.Fatalf("WARNING level = %v, %v, want warn", filter.minLevel, err)
+	}
+}
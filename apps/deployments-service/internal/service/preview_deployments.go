@@ -0,0 +1,487 @@
+package deployments
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const previewDomainSuffix = "my.obiente.cloud"
+
+// dnsLabelMaxLength is the maximum length of a single DNS label
+const dnsLabelMaxLength = 63
+
+var nonDNSLabelChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+type githubWebhookPullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Merged bool `json:"merged"`
+		Head   struct {
+			Ref  string `json:"ref"`
+			SHA  string `json:"sha"`
+			Repo *struct {
+				FullName string `json:"full_name"`
+			} `json:"repo"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+	} `json:"pull_request"`
+	Repository struct {
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	Installation *struct {
+		ID int64 `json:"id"`
+	} `json:"installation"`
+}
+
+// handleGitHubPullRequestWebhook creates a preview deployment when a pull request is opened and
+// deletes it again when the pull request is closed or merged. New commits on the pull request
+// branch are deployed through the push webhook, since previews have auto-deploy enabled.
+func (s *Service) handleGitHubPullRequestWebhook(w http.ResponseWriter, event string, body []byte) {
+	var payload githubWebhookPullRequestPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		writeGitHubWebhookJSON(w, http.StatusBadRequest, githubWebhookResponse{
+			OK:      false,
+			Event:   event,
+			Message: "invalid pull_request payload",
+		})
+		return
+	}
+
+	repoFullName := normalizeGitHubRepoFullName(payload.Repository.FullName)
+	if repoFullName == "" || payload.Number <= 0 {
+		writeGitHubWebhookJSON(w, http.StatusBadRequest, githubWebhookResponse{
+			OK:      false,
+			Event:   event,
+			Message: "pull_request payload is missing repository or number",
+		})
+		return
+	}
+
+	switch payload.Action {
+	case "opened", "reopened":
+		s.handleGitHubPullRequestOpened(w, event, repoFullName, payload)
+	case "closed":
+		go s.closePreviewDeployment(repoFullName, payload.Number, payload.PullRequest.Merged)
+		writeGitHubWebhookJSON(w, http.StatusAccepted, githubWebhookResponse{
+			OK:         true,
+			Event:      event,
+			Repository: repoFullName,
+			Branch:     payload.PullRequest.Head.Ref,
+			Message:    "preview deployment cleanup scheduled",
+		})
+	default:
+		writeGitHubWebhookJSON(w, http.StatusAccepted, githubWebhookResponse{
+			OK:         true,
+			Event:      event,
+			Repository: repoFullName,
+			Message:    fmt.Sprintf("pull_request action %q ignored", payload.Action),
+		})
+	}
+}
+
+func (s *Service) handleGitHubPullRequestOpened(w http.ResponseWriter, event, repoFullName string, payload githubWebhookPullRequestPayload) {
+	headBranch := payload.PullRequest.Head.Ref
+	response := githubWebhookResponse{
+		OK:         true,
+		Event:      event,
+		Repository: repoFullName,
+		Branch:     headBranch,
+	}
+
+	// Pull requests from forks would run untrusted code with the base deployment's env vars
+	if payload.PullRequest.Head.Repo == nil || normalizeGitHubRepoFullName(payload.PullRequest.Head.Repo.FullName) != repoFullName {
+		response.Message = "pull requests from forks do not get preview deployments"
+		writeGitHubWebhookJSON(w, http.StatusAccepted, response)
+		return
+	}
+	if payload.Installation == nil || payload.Installation.ID <= 0 {
+		response.Message = "pull_request payload has no GitHub App installation"
+		writeGitHubWebhookJSON(w, http.StatusAccepted, response)
+		return
+	}
+
+	var integration database.GitHubIntegration
+	if err := database.DB.Where("github_app_installation_id = ?", payload.Installation.ID).First(&integration).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			response.Message = "no GitHub integration is connected for this installation"
+			writeGitHubWebhookJSON(w, http.StatusAccepted, response)
+			return
+		}
+		logger.Error("[GitHubWebhook] Failed to load GitHub integration for installation %d: %v", payload.Installation.ID, err)
+		response.OK = false
+		response.Message = "failed to load GitHub integration"
+		writeGitHubWebhookJSON(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	source, err := findPreviewSourceDeployment(integration.ID, repoFullName, payload.PullRequest.Base.Ref)
+	if err != nil {
+		logger.Error("[GitHubWebhook] Failed to find deployment for %s@%s: %v", repoFullName, payload.PullRequest.Base.Ref, err)
+		response.OK = false
+		response.Message = "failed to find matching deployments"
+		writeGitHubWebhookJSON(w, http.StatusInternalServerError, response)
+		return
+	}
+	if source == nil {
+		response.Message = "no deployment tracks the pull request's base branch"
+		writeGitHubWebhookJSON(w, http.StatusAccepted, response)
+		return
+	}
+
+	go s.createPreviewDeployment(source, repoFullName, payload)
+
+	response.MatchedDeployments = 1
+	response.Message = "preview deployment scheduled"
+	writeGitHubWebhookJSON(w, http.StatusAccepted, response)
+}
+
+// createPreviewDeployment creates a deployment for the pull request from the source deployment's
+// configuration and deploys the pull request branch to its preview domain
+func (s *Service) createPreviewDeployment(source *database.Deployment, repoFullName string, payload githubWebhookPullRequestPayload) {
+	ctx, cancel := s.detachedContext(5 * time.Minute)
+	defer cancel()
+	ctx = auth.WithSystemUser(ctx)
+
+	var existing database.PreviewDeployment
+	err := database.DB.Where("repository_full_name = ? AND pr_number = ?", repoFullName, payload.Number).First(&existing).Error
+	switch {
+	case err == nil && existing.ClosedAt == nil:
+		logger.Info("[PreviewDeployments] Pull request %s#%d already has preview deployment %s", repoFullName, payload.Number, existing.DeploymentID)
+		return
+	case err == nil:
+		// Reopened before the previous preview was cleaned up
+		if err := s.deletePreviewDeployment(ctx, &existing); err != nil {
+			logger.Error("[PreviewDeployments] Failed to remove previous preview of %s#%d: %v", repoFullName, payload.Number, err)
+			return
+		}
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		logger.Error("[PreviewDeployments] Failed to load preview of %s#%d: %v", repoFullName, payload.Number, err)
+		return
+	}
+
+	name := source.Name
+	if name == "" {
+		name = payload.Repository.Name
+	}
+	created, err := s.CreateDeployment(ctx, connect.NewRequest(&deploymentsv1.CreateDeploymentRequest{
+		OrganizationId: source.OrganizationID,
+		Name:           fmt.Sprintf("%s (PR #%d)", name, payload.Number),
+		Environment:    deploymentsv1.Environment_DEVELOPMENT,
+		Groups:         []string{"preview"},
+		IsPreview:      true,
+	}))
+	if err != nil {
+		logger.Error("[PreviewDeployments] Failed to create preview of %s#%d: %v", repoFullName, payload.Number, err)
+		return
+	}
+	deploymentID := created.Msg.GetDeployment().GetId()
+
+	preview := &database.PreviewDeployment{
+		ID:                  fmt.Sprintf("preview-%s", uuid.NewString()),
+		DeploymentID:        deploymentID,
+		SourceDeploymentID:  source.ID,
+		OrganizationID:      source.OrganizationID,
+		GitHubIntegrationID: derefString(source.GitHubIntegrationID),
+		RepositoryFullName:  repoFullName,
+		PRNumber:            payload.Number,
+		HeadBranch:          payload.PullRequest.Head.Ref,
+		HeadSHA:             payload.PullRequest.Head.SHA,
+	}
+	if err := database.DB.Create(preview).Error; err != nil {
+		logger.Error("[PreviewDeployments] Failed to record preview %s of %s#%d: %v", deploymentID, repoFullName, payload.Number, err)
+		_ = s.deletePreviewDeployment(ctx, preview)
+		return
+	}
+
+	domain := previewDomain(payload.Number, payload.Repository.Name, deploymentID)
+	if err := s.configurePreviewDeployment(ctx, deploymentID, source, payload.PullRequest.Head.Ref, domain); err != nil {
+		logger.Error("[PreviewDeployments] Failed to configure preview %s of %s#%d: %v", deploymentID, repoFullName, payload.Number, err)
+		_ = s.deletePreviewDeployment(ctx, preview)
+		return
+	}
+
+	if _, err := s.TriggerDeployment(ctx, connect.NewRequest(&deploymentsv1.TriggerDeploymentRequest{
+		DeploymentId: deploymentID,
+	})); err != nil {
+		logger.Error("[PreviewDeployments] Failed to trigger preview %s of %s#%d: %v", deploymentID, repoFullName, payload.Number, err)
+		return
+	}
+
+	logger.Info("[PreviewDeployments] Deploying %s#%d (%s) to %s as %s", repoFullName, payload.Number, payload.PullRequest.Head.Ref, domain, deploymentID)
+}
+
+// configurePreviewDeployment copies the source deployment's build and runtime configuration to the
+// preview, points it at the pull request branch and moves the source's routing rules to the preview domain
+func (s *Service) configurePreviewDeployment(ctx context.Context, deploymentID string, source *database.Deployment, branch, domain string) error {
+	preview, err := s.repo.GetByID(ctx, deploymentID)
+	if err != nil {
+		return fmt.Errorf("get preview deployment: %w", err)
+	}
+
+	autoDeploy := true
+	preview.Domain = domain
+	preview.Type = source.Type
+	preview.BuildStrategy = source.BuildStrategy
+	preview.RepositoryURL = source.RepositoryURL
+	preview.GitHubIntegrationID = source.GitHubIntegrationID
+	preview.AutoDeploy = &autoDeploy
+	preview.Branch = branch
+	preview.BuildCommand = source.BuildCommand
+	preview.InstallCommand = source.InstallCommand
+	preview.StartCommand = source.StartCommand
+	preview.DockerfilePath = source.DockerfilePath
+	preview.ComposeFilePath = source.ComposeFilePath
+	preview.BuildPath = source.BuildPath
+	preview.BuildOutputPath = source.BuildOutputPath
+	preview.UseNginx = source.UseNginx
+	preview.NginxConfig = source.NginxConfig
+	preview.HealthcheckType = source.HealthcheckType
+	preview.HealthcheckPort = source.HealthcheckPort
+	preview.HealthcheckPath = source.HealthcheckPath
+	preview.HealthcheckExpectedStatus = source.HealthcheckExpectedStatus
+	preview.HealthcheckCustomCommand = source.HealthcheckCustomCommand
+	preview.Image = source.Image
+	preview.Port = source.Port
+	preview.MemoryBytes = source.MemoryBytes
+	preview.CPUShares = source.CPUShares
+	preview.EnvVars = source.EnvVars
+	preview.EnvFileContent = source.EnvFileContent
+	preview.ComposeYaml = source.ComposeYaml
+	preview.BuildArgs = source.BuildArgs
+	preview.DockerfileVolumes = source.DockerfileVolumes
+	preview.DockerfileBuildOptions = source.DockerfileBuildOptions
+	if err := s.repo.Update(ctx, preview); err != nil {
+		return fmt.Errorf("update preview deployment: %w", err)
+	}
+
+	routings, err := database.GetDeploymentRoutings(source.ID)
+	if err != nil {
+		return fmt.Errorf("get routing rules of %s: %w", source.ID, err)
+	}
+	for _, routing := range routings {
+		routingDomain := previewRoutingDomain(domain, routing.ServiceName)
+		if err := database.UpsertDeploymentRouting(&database.DeploymentRouting{
+			ID:              fmt.Sprintf("route-%s-%s-%s-%d", deploymentID, routingDomain, routing.ServiceName, routing.TargetPort),
+			DeploymentID:    deploymentID,
+			Domain:          routingDomain,
+			ServiceName:     routing.ServiceName,
+			PathPrefix:      routing.PathPrefix,
+			TargetPort:      routing.TargetPort,
+			Protocol:        routing.Protocol,
+			SSLEnabled:      routing.SSLEnabled,
+			SSLCertResolver: routing.SSLCertResolver,
+			Middleware:      routing.Middleware,
+		}); err != nil {
+			return fmt.Errorf("create routing rule for %s: %w", routingDomain, err)
+		}
+	}
+	return nil
+}
+
+// closePreviewDeployment deletes the preview deployment of a closed or merged pull request
+func (s *Service) closePreviewDeployment(repoFullName string, number int, merged bool) {
+	ctx, cancel := s.detachedContext(5 * time.Minute)
+	defer cancel()
+	ctx = auth.WithSystemUser(ctx)
+
+	var preview database.PreviewDeployment
+	if err := database.DB.Where("repository_full_name = ? AND pr_number = ?", repoFullName, number).First(&preview).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Error("[PreviewDeployments] Failed to load preview of %s#%d: %v", repoFullName, number, err)
+		}
+		return
+	}
+
+	// Record the close first so the daily purge can finish the cleanup if the deletion fails
+	if preview.ClosedAt == nil {
+		now := time.Now()
+		if err := database.DB.Model(&preview).Update("closed_at", now).Error; err != nil {
+			logger.Warn("[PreviewDeployments] Failed to mark preview of %s#%d closed: %v", repoFullName, number, err)
+		}
+		preview.ClosedAt = &now
+	}
+
+	if err := s.deletePreviewDeployment(ctx, &preview); err != nil {
+		logger.Error("[PreviewDeployments] Failed to delete preview %s of %s#%d: %v", preview.DeploymentID, repoFullName, number, err)
+		return
+	}
+	logger.Info("[PreviewDeployments] Deleted preview %s of %s#%d (merged: %t)", preview.DeploymentID, repoFullName, number, merged)
+}
+
+// deletePreviewDeployment removes a preview's deployment, its routing rules and its preview_deployments row
+func (s *Service) deletePreviewDeployment(ctx context.Context, preview *database.PreviewDeployment) error {
+	if _, err := s.DeleteDeployment(ctx, connect.NewRequest(&deploymentsv1.DeleteDeploymentRequest{
+		OrganizationId: preview.OrganizationID,
+		DeploymentId:   preview.DeploymentID,
+	})); err != nil && connect.CodeOf(err) != connect.CodeNotFound {
+		return err
+	}
+	// Free the preview domain in case the pull request is reopened
+	if err := database.DB.Where("deployment_id = ?", preview.DeploymentID).Delete(&database.DeploymentRouting{}).Error; err != nil {
+		return fmt.Errorf("delete routing rules: %w", err)
+	}
+	if err := database.DB.Delete(&database.PreviewDeployment{}, "id = ?", preview.ID).Error; err != nil {
+		return fmt.Errorf("delete preview record: %w", err)
+	}
+	return nil
+}
+
+// pullRequestClosed reports whether a preview's pull request has been closed on GitHub
+// It is a variable so tests can avoid calling GitHub.
+var pullRequestClosed = func(ctx context.Context, preview *database.PreviewDeployment) (bool, error) {
+	var integration database.GitHubIntegration
+	if err := database.DB.Where("id = ?", preview.GitHubIntegrationID).First(&integration).Error; err != nil {
+		return false, fmt.Errorf("get GitHub integration: %w", err)
+	}
+	client, _, err := getGitHubClientForIntegration(ctx, &integration)
+	if err != nil {
+		return false, err
+	}
+	pullRequest, err := client.GetPullRequest(ctx, preview.RepositoryFullName, preview.PRNumber)
+	if err != nil {
+		return false, err
+	}
+	return pullRequest.State == "closed", nil
+}
+
+// PurgeStalePreviewDeployments removes previews whose pull request is closed but that were not
+// cleaned up when the close webhook arrived, because the webhook was missed or the deletion failed.
+// It returns the number of previews removed.
+func (s *Service) PurgeStalePreviewDeployments(ctx context.Context) (int, error) {
+	var previews []database.PreviewDeployment
+	if err := database.DB.WithContext(ctx).Find(&previews).Error; err != nil {
+		return 0, fmt.Errorf("get preview deployments: %w", err)
+	}
+
+	ctx = auth.WithSystemUser(ctx)
+	purged := 0
+	for i := range previews {
+		preview := &previews[i]
+		if preview.ClosedAt == nil {
+			closed, err := pullRequestClosed(ctx, preview)
+			if err != nil {
+				logger.Warn("[PreviewDeployments] Failed to check %s#%d: %v", preview.RepositoryFullName, preview.PRNumber, err)
+				continue
+			}
+			if !closed {
+				continue
+			}
+		}
+		if err := s.deletePreviewDeployment(ctx, preview); err != nil {
+			logger.Warn("[PreviewDeployments] Failed to purge preview %s of %s#%d: %v", preview.DeploymentID, preview.RepositoryFullName, preview.PRNumber, err)
+			continue
+		}
+		purged++
+	}
+
+	if purged > 0 {
+		logger.Info("[PreviewDeployments] Purged %d stale preview deployment(s)", purged)
+	}
+	return purged, nil
+}
+
+// StartPreviewPurger runs PurgeStalePreviewDeployments every interval until ctx is cancelled
+// Previews missed while the service was down are purged right away.
+func (s *Service) StartPreviewPurger(ctx context.Context, interval time.Duration) {
+	purge := func() {
+		if _, err := s.PurgeStalePreviewDeployments(ctx); err != nil {
+			logger.Warn("[PreviewDeployments] Failed to purge stale previews: %v", err)
+		}
+	}
+	purge()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purge()
+		}
+	}
+}
+
+// findPreviewSourceDeployment returns the oldest non-preview deployment that deploys the repository's
+// base branch through the integration, or nil if there is none
+func findPreviewSourceDeployment(integrationID, repoFullName, baseBranch string) (*database.Deployment, error) {
+	var deployments []database.Deployment
+	if err := database.DB.
+		Where("deleted_at IS NULL").
+		Where("github_integration_id = ?", integrationID).
+		Where("is_preview = ?", false).
+		Where("branch = ?", baseBranch).
+		Order("created_at ASC").
+		Find(&deployments).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range deployments {
+		if deployments[i].RepositoryURL != nil && githubRepoURLMatchesFullName(*deployments[i].RepositoryURL, repoFullName) {
+			return &deployments[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// previewDomain returns the preview hostname pr-{number}-{repoSlug}-{hash}.my.obiente.cloud
+// The hash of the preview's deployment ID keeps hostnames unique across organizations
+// and forks that share a repository name.
+func previewDomain(number int, repoName, deploymentID string) string {
+	sum := sha256.Sum256([]byte(deploymentID))
+	hash := "-" + hex.EncodeToString(sum[:4])
+
+	label := fmt.Sprintf("pr-%d", number)
+	slug := strings.Trim(nonDNSLabelChars.ReplaceAllString(strings.ToLower(repoName), "-"), "-")
+	if slug != "" {
+		label = label + "-" + slug
+	}
+	if len(label) > dnsLabelMaxLength-len(hash) {
+		label = strings.TrimRight(label[:dnsLabelMaxLength-len(hash)], "-")
+	}
+	return fmt.Sprintf("%s%s.%s", label, hash, previewDomainSuffix)
+}
+
+// previewRoutingDomain returns the preview hostname for a routing rule
+// The default service uses the preview domain; other services get their own subdomain label.
+func previewRoutingDomain(domain, serviceName string) string {
+	if serviceName == "" || serviceName == "default" {
+		return domain
+	}
+	label, suffix, _ := strings.Cut(domain, ".")
+	label = strings.Trim(nonDNSLabelChars.ReplaceAllString(strings.ToLower(serviceName), "-"), "-") + "-" + label
+	if len(label) > dnsLabelMaxLength {
+		label = strings.TrimRight(label[:dnsLabelMaxLength], "-")
+	}
+	return label + "." + suffix
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
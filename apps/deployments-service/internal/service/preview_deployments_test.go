@@ -0,0 +1,146 @@
+package deployments
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/gorm"
+)
+
+func TestPreviewDomain(t *testing.T) {
+	tests := []struct {
+		number       int
+		repoName     string
+		deploymentID string
+		want         string
+	}{
+		{42, "web-app", "dep-a", "pr-42-web-app-e2ee4760.my.obiente.cloud"},
+		{7, "My_Repo.JS", "dep-b", "pr-7-my-repo-js-3d6c339c.my.obiente.cloud"},
+		{1, "---", "dep-c", "pr-1-8b6f231c.my.obiente.cloud"},
+		{12345, "a-very-long-repository-name-that-does-not-fit-in-a-single-dns-label", "dep-d", "pr-12345-a-very-long-repository-name-that-does-not-fit-7b789f4d.my.obiente.cloud"},
+	}
+	for _, tt := range tests {
+		if got := previewDomain(tt.number, tt.repoName, tt.deploymentID); got != tt.want {
+			t.Fatalf("previewDomain(%d, %q, %q) = %q, want %q", tt.number, tt.repoName, tt.deploymentID, got, tt.want)
+		}
+	}
+
+	// Same pull request number and repository name in another organization or fork
+	if previewDomain(42, "web-app", "dep-a") == previewDomain(42, "web-app", "dep-b") {
+		t.Fatal("previews of different deployments share a hostname")
+	}
+
+	if got := previewRoutingDomain("pr-42-web-app.my.obiente.cloud", "api"); got != "api-pr-42-web-app.my.obiente.cloud" {
+		t.Fatalf("previewRoutingDomain = %q", got)
+	}
+	if got := previewRoutingDomain("pr-42-web-app.my.obiente.cloud", "default"); got != "pr-42-web-app.my.obiente.cloud" {
+		t.Fatalf("previewRoutingDomain(default) = %q", got)
+	}
+}
+
+func TestFindPreviewSourceDeployment(t *testing.T) {
+	db := newPreviewTestDB(t)
+
+	repoURL := "https://github.com/Acme/Web.git"
+	otherRepoURL := "https://github.com/acme/other"
+	integrationID := "gh-1"
+	for _, deployment := range []database.Deployment{
+		{ID: "dep-preview", OrganizationID: "org-a", Branch: "main", RepositoryURL: &repoURL, GitHubIntegrationID: &integrationID, IsPreview: true, CreatedAt: time.Now().Add(-3 * time.Hour)},
+		{ID: "dep-other-repo", OrganizationID: "org-a", Branch: "main", RepositoryURL: &otherRepoURL, GitHubIntegrationID: &integrationID, CreatedAt: time.Now().Add(-2 * time.Hour)},
+		{ID: "dep-main", OrganizationID: "org-a", Branch: "main", RepositoryURL: &repoURL, GitHubIntegrationID: &integrationID, CreatedAt: time.Now().Add(-1 * time.Hour)},
+		{ID: "dep-staging", OrganizationID: "org-a", Branch: "staging", RepositoryURL: &repoURL, GitHubIntegrationID: &integrationID, CreatedAt: time.Now()},
+	} {
+		if err := db.Create(&deployment).Error; err != nil {
+			t.Fatalf("create deployment %s: %v", deployment.ID, err)
+		}
+	}
+
+	source, err := findPreviewSourceDeployment(integrationID, "acme/web", "main")
+	if err != nil {
+		t.Fatalf("findPreviewSourceDeployment: %v", err)
+	}
+	if source == nil || source.ID != "dep-main" {
+		t.Fatalf("source = %v, want dep-main", source)
+	}
+
+	source, err = findPreviewSourceDeployment(integrationID, "acme/web", "feature")
+	if err != nil {
+		t.Fatalf("findPreviewSourceDeployment: %v", err)
+	}
+	if source != nil {
+		t.Fatalf("source = %s, want none for an untracked base branch", source.ID)
+	}
+}
+
+func TestPurgeStalePreviewDeployments(t *testing.T) {
+	db := newPreviewTestDB(t)
+	service := NewService(context.Background(), database.NewDeploymentRepository(db, nil), nil, nil)
+
+	closedAt := time.Now().Add(-time.Hour)
+	previews := []database.PreviewDeployment{
+		{ID: "preview-closed", DeploymentID: "dep-pr-1", OrganizationID: "org-a", RepositoryFullName: "acme/web", PRNumber: 1, ClosedAt: &closedAt},
+		{ID: "preview-missed", DeploymentID: "dep-pr-2", OrganizationID: "org-a", RepositoryFullName: "acme/web", PRNumber: 2},
+		{ID: "preview-open", DeploymentID: "dep-pr-3", OrganizationID: "org-a", RepositoryFullName: "acme/web", PRNumber: 3},
+	}
+	for _, preview := range previews {
+		if err := db.Create(&database.Deployment{ID: preview.DeploymentID, OrganizationID: "org-a", IsPreview: true}).Error; err != nil {
+			t.Fatalf("create deployment: %v", err)
+		}
+		if err := db.Create(&database.DeploymentRouting{ID: "route-" + preview.DeploymentID, DeploymentID: preview.DeploymentID, Domain: preview.DeploymentID + ".my.obiente.cloud", TargetPort: 80}).Error; err != nil {
+			t.Fatalf("create routing: %v", err)
+		}
+		if err := db.Create(&preview).Error; err != nil {
+			t.Fatalf("create preview: %v", err)
+		}
+	}
+
+	previousPullRequestClosed := pullRequestClosed
+	pullRequestClosed = func(ctx context.Context, preview *database.PreviewDeployment) (bool, error) {
+		return preview.PRNumber == 2, nil
+	}
+	t.Cleanup(func() { pullRequestClosed = previousPullRequestClosed })
+
+	purged, err := service.PurgeStalePreviewDeployments(context.Background())
+	if err != nil {
+		t.Fatalf("PurgeStalePreviewDeployments: %v", err)
+	}
+	if purged != 2 {
+		t.Fatalf("purged = %d, want 2", purged)
+	}
+
+	var remaining []database.PreviewDeployment
+	db.Find(&remaining)
+	if len(remaining) != 1 || remaining[0].ID != "preview-open" {
+		t.Fatalf("remaining previews = %+v, want only preview-open", remaining)
+	}
+
+	for id, wantDeleted := range map[string]bool{"dep-pr-1": true, "dep-pr-2": true, "dep-pr-3": false} {
+		var deployment database.Deployment
+		if err := db.First(&deployment, "id = ?", id).Error; err != nil {
+			t.Fatalf("load deployment %s: %v", id, err)
+		}
+		if (deployment.DeletedAt != nil) != wantDeleted {
+			t.Fatalf("deployment %s deleted = %t, want %t", id, deployment.DeletedAt != nil, wantDeleted)
+		}
+
+		var routings int64
+		db.Model(&database.DeploymentRouting{}).Where("deployment_id = ?", id).Count(&routings)
+		if (routings == 0) != wantDeleted {
+			t.Fatalf("deployment %s has %d routing rules after purge", id, routings)
+		}
+	}
+}
+
+func newPreviewTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	return newTestDB(t,
+		&database.Deployment{},
+		&database.BuildHistory{},
+		&database.DeploymentRouting{},
+		&database.PreviewDeployment{},
+		&database.DeploymentVersion{},
+	)
+}
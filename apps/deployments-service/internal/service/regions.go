@@ -0,0 +1,262 @@
+package deployments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/orchestrator"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxDeploymentRegions bounds the regions a single deployment can run in
+const maxDeploymentRegions = 10
+
+// regionProvisionRequest asks for a deployment to be provisioned in one region
+type regionProvisionRequest struct {
+	OrganizationID string
+	DeploymentID   string
+	Region         string
+	// Authorization is sent along when the region's node has to be asked to provision itself
+	Authorization string
+}
+
+// regionPlacement is where a deployment was provisioned in a region. ContainerID is empty while the
+// deployment has no image; its containers are created when the region is provisioned after a build.
+type regionPlacement struct {
+	NodeID      string
+	ContainerID string
+	TraefikIP   string
+}
+
+// regionProvisioner provisions a deployment in one region; tests replace it with a fake
+type regionProvisioner interface {
+	ProvisionRegion(ctx context.Context, req regionProvisionRequest) (regionPlacement, error)
+}
+
+// orchestratorRegionProvisioner places the deployment on a node of the region and creates its
+// containers there, forwarding to that node when it is not this one
+type orchestratorRegionProvisioner struct {
+	service *Service
+}
+
+func (p orchestratorRegionProvisioner) ProvisionRegion(ctx context.Context, req regionProvisionRequest) (regionPlacement, error) {
+	s := p.service
+	if s.manager == nil {
+		return regionPlacement{}, fmt.Errorf("orchestrator is not available")
+	}
+	node, err := s.manager.SelectRegionNode(ctx, req.Region)
+	if err != nil {
+		return regionPlacement{}, err
+	}
+	placement := regionPlacement{NodeID: node.ID, TraefikIP: orchestrator.RegionTraefikIP(node)}
+
+	dbDeployment, err := s.repo.GetByID(ctx, req.DeploymentID)
+	if err != nil {
+		return placement, fmt.Errorf("deployment %s not found", req.DeploymentID)
+	}
+	if dbDeployment.Image == nil || *dbDeployment.Image == "" {
+		return placement, nil
+	}
+
+	if node.ID == s.manager.GetNodeID() {
+		placement.ContainerID, err = s.manager.CreateDeploymentFromDatabase(ctx, req.DeploymentID)
+		return placement, err
+	}
+
+	// Containers are created by the node that runs them: ask it to provision this region
+	if s.forwarder == nil || !s.forwarder.CanForward(node.ID) {
+		return placement, fmt.Errorf("cannot forward provisioning to node %s", node.ID)
+	}
+	reqBody, _ := json.Marshal(&deploymentsv1.UpdateDeploymentRequest{
+		OrganizationId: req.OrganizationID,
+		DeploymentId:   req.DeploymentID,
+		Regions:        []string{req.Region},
+	})
+	headers := map[string]string{
+		"Authorization":                      req.Authorization,
+		orchestrator.ForwardTargetNodeHeader: node.ID,
+	}
+	if _, err := s.forwardUnaryRequest(ctx, reqBody, node.ID, "/obiente.cloud.deployments.v1.DeploymentService/UpdateDeployment", headers, &deploymentsv1.UpdateDeploymentResponse{}); err != nil {
+		return placement, err
+	}
+	record, err := database.GetDeploymentRegionRecord(req.DeploymentID, req.Region)
+	if err != nil {
+		return placement, err
+	}
+	if record.Status == database.DeploymentRegionStatusFailed {
+		return placement, errors.New(record.Error)
+	}
+	placement.ContainerID = record.ContainerID
+	return placement, nil
+}
+
+// normalizeDeploymentRegions trims the requested regions and drops empty and repeated ones, keeping their order
+func normalizeDeploymentRegions(requested []string) ([]string, error) {
+	regions := make([]string, 0, len(requested))
+	seen := make(map[string]bool, len(requested))
+	for _, region := range requested {
+		region = strings.TrimSpace(region)
+		if region == "" || seen[region] {
+			continue
+		}
+		seen[region] = true
+		regions = append(regions, region)
+	}
+	if len(regions) > maxDeploymentRegions {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a deployment can run in at most %d regions", maxDeploymentRegions))
+	}
+	return regions, nil
+}
+
+// provisionDeploymentRegions provisions the deployment in each region in parallel and records the
+// outcome per region. A region that fails is recorded as failed without affecting the others.
+func (s *Service) provisionDeploymentRegions(ctx context.Context, orgID, deploymentID string, regions []string, primaryRegion, authorization string) []database.DeploymentRegion {
+	records := make([]database.DeploymentRegion, len(regions))
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		wg.Add(1)
+		go func(i int, region string) {
+			defer wg.Done()
+			placement, err := s.regionProvisioner.ProvisionRegion(ctx, regionProvisionRequest{
+				OrganizationID: orgID,
+				DeploymentID:   deploymentID,
+				Region:         region,
+				Authorization:  authorization,
+			})
+			record := database.DeploymentRegion{
+				DeploymentID: deploymentID,
+				Region:       region,
+				Primary:      region == primaryRegion,
+				NodeID:       placement.NodeID,
+				ContainerID:  placement.ContainerID,
+				TraefikIP:    placement.TraefikIP,
+			}
+			switch {
+			case err != nil:
+				logger.Warn("[DeploymentRegions] Failed to provision deployment %s in region %s: %v", deploymentID, region, err)
+				record.Status = database.DeploymentRegionStatusFailed
+				record.Error = err.Error()
+			case placement.ContainerID == "":
+				record.Status = database.DeploymentRegionStatusPending
+			default:
+				record.Status = database.DeploymentRegionStatusProvisioned
+			}
+			records[i] = record
+		}(i, region)
+	}
+	wg.Wait()
+
+	for i := range records {
+		if err := database.SaveDeploymentRegion(&records[i]); err != nil {
+			logger.Warn("[DeploymentRegions] Failed to record region %s of deployment %s: %v", records[i].Region, deploymentID, err)
+		}
+	}
+	return records
+}
+
+// regionsToReprovision checks that a multi-region deployment runs in each requested region and
+// returns the regions with the deployment's primary region
+func regionsToReprovision(deploymentID string, requested []string) ([]string, string, error) {
+	regions, err := normalizeDeploymentRegions(requested)
+	if err != nil || len(regions) == 0 {
+		return nil, "", err
+	}
+	existing, err := database.GetDeploymentRegions(deploymentID)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeInternal, err)
+	}
+	primaryRegion := ""
+	known := make(map[string]bool, len(existing))
+	for _, record := range existing {
+		known[record.Region] = true
+		if record.Primary {
+			primaryRegion = record.Region
+		}
+	}
+	for _, region := range regions {
+		if !known[region] {
+			return nil, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("deployment %s does not run in region %q", deploymentID, region))
+		}
+	}
+	return regions, primaryRegion, nil
+}
+
+// GetDeploymentRegionStatus returns the provisioning status and health of a deployment in each of its regions
+func (s *Service) GetDeploymentRegionStatus(ctx context.Context, req *connect.Request[deploymentsv1.GetDeploymentRegionStatusRequest]) (*connect.Response[deploymentsv1.GetDeploymentRegionStatusResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+
+	if err := s.checkDeploymentOrgAccess(ctx, orgID, deploymentID, auth.PermissionDeploymentRead); err != nil {
+		return nil, err
+	}
+
+	records, err := database.GetDeploymentRegions(deploymentID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	regions := make([]*deploymentsv1.DeploymentRegionStatus, len(records))
+	for i := range records {
+		regions[i] = deploymentRegionToProto(&records[i], deploymentRegionHealth(ctx, &records[i]))
+	}
+	return connect.NewResponse(&deploymentsv1.GetDeploymentRegionStatusResponse{Regions: regions}), nil
+}
+
+// deploymentRegionHealth reports the health of the container a region was provisioned with
+func deploymentRegionHealth(ctx context.Context, record *database.DeploymentRegion) string {
+	switch {
+	case record.Status == database.DeploymentRegionStatusFailed:
+		return "unhealthy"
+	case record.ContainerID == "":
+		return "unknown"
+	}
+	var location database.DeploymentLocation
+	if err := database.DB.WithContext(ctx).Where("container_id = ?", record.ContainerID).First(&location).Error; err != nil {
+		// The container is gone
+		return "unhealthy"
+	}
+	if location.Status != "running" {
+		return "unhealthy"
+	}
+	if location.HealthStatus == "" {
+		return "unknown"
+	}
+	return location.HealthStatus
+}
+
+func deploymentRegionToProto(record *database.DeploymentRegion, health string) *deploymentsv1.DeploymentRegionStatus {
+	status := &deploymentsv1.DeploymentRegionStatus{
+		Region:       record.Region,
+		Primary:      record.Primary,
+		Status:       record.Status,
+		HealthStatus: health,
+		ContainerId:  record.ContainerID,
+		TraefikIp:    record.TraefikIP,
+		NodeId:       record.NodeID,
+		UpdatedAt:    timestamppb.New(record.UpdatedAt),
+	}
+	if record.Error != "" {
+		status.Error = &record.Error
+	}
+	return status
+}
+
+// deploymentRegionTraefikIPs returns the Traefik IPs of the regions that did not fail, in region order
+func deploymentRegionTraefikIPs(records []database.DeploymentRegion) []string {
+	ips := make([]string, 0, len(records))
+	for _, record := range records {
+		if record.Status != database.DeploymentRegionStatusFailed && record.TraefikIP != "" {
+			ips = append(ips, record.TraefikIP)
+		}
+	}
+	return ips
+}
@@ -0,0 +1,205 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+	"gorm.io/gorm"
+)
+
+var testRegionTraefikIPs = map[string]string{
+	"us-east":  "203.0.113.10",
+	"eu-west":  "203.0.113.20",
+	"ap-south": "203.0.113.30",
+}
+
+// fakeRegionProvisioner holds every call until the expected number of regions are being
+// provisioned at once, so a sequential caller times out
+type fakeRegionProvisioner struct {
+	mu         sync.Mutex
+	calls      []string
+	failures   map[string]error
+	pending    int
+	allArrived chan struct{}
+}
+
+func newFakeRegionProvisioner() *fakeRegionProvisioner {
+	return &fakeRegionProvisioner{failures: make(map[string]error)}
+}
+
+// expect sets how many regions the next provisioning must run in parallel
+func (f *fakeRegionProvisioner) expect(parallel int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+	f.pending = parallel
+	f.allArrived = make(chan struct{})
+}
+
+func (f *fakeRegionProvisioner) ProvisionRegion(ctx context.Context, req regionProvisionRequest) (regionPlacement, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, req.Region)
+	f.pending--
+	if f.pending == 0 {
+		close(f.allArrived)
+	}
+	allArrived, err := f.allArrived, f.failures[req.Region]
+	f.mu.Unlock()
+
+	select {
+	case <-allArrived:
+	case <-time.After(2 * time.Second):
+		return regionPlacement{}, fmt.Errorf("region %s was not provisioned in parallel with the others", req.Region)
+	}
+	placement := regionPlacement{NodeID: "node-" + req.Region, TraefikIP: testRegionTraefikIPs[req.Region]}
+	if err != nil {
+		return placement, err
+	}
+	placement.ContainerID = "ctr-" + req.Region
+	return placement, nil
+}
+
+func newDeploymentRegionsTestService(t *testing.T) (*Service, *fakeRegionProvisioner, *gorm.DB) {
+	t.Helper()
+	db := newTestDB(t,
+		&database.Deployment{},
+		&database.DeploymentRegion{},
+		&database.DeploymentLocation{},
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.OrgRole{},
+		&database.OrgRoleBinding{},
+	)
+	seedDeploymentServiceIsolationData(t, db)
+
+	service := NewService(context.Background(), database.NewDeploymentRepository(db, nil), nil, nil)
+	provisioner := newFakeRegionProvisioner()
+	service.regionProvisioner = provisioner
+	return service, provisioner, db
+}
+
+func TestProvisionDeploymentRegionsInParallel(t *testing.T) {
+	service, provisioner, db := newDeploymentRegionsTestService(t)
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	provisioner.expect(3)
+	records := service.provisionDeploymentRegions(ctx, "org-a", "dep-org-a-owner", []string{"us-east", "eu-west", "ap-south"}, "us-east", "")
+	for i, want := range []string{"us-east", "eu-west", "ap-south"} {
+		record := records[i]
+		if record.Region != want || record.Status != database.DeploymentRegionStatusProvisioned || record.ContainerID != "ctr-"+want {
+			t.Fatalf("region %d = %s %s %q, want %s provisioned with its container", i, record.Region, record.Status, record.ContainerID, want)
+		}
+		if record.Primary != (want == "us-east") {
+			t.Fatalf("region %s primary = %v", want, record.Primary)
+		}
+	}
+
+	ips, err := database.GetDeploymentNodeIP("dep-org-a-owner", nil)
+	if err != nil {
+		t.Fatalf("GetDeploymentNodeIP: %v", err)
+	}
+	if want := []string{"203.0.113.10", "203.0.113.20", "203.0.113.30"}; !slices.Equal(ips, want) {
+		t.Fatalf("DNS IPs = %v, want %v", ips, want)
+	}
+
+	for _, location := range []*database.DeploymentLocation{
+		{ID: "loc-us-east", DeploymentID: "dep-org-a-owner", NodeID: "node-us-east", ContainerID: "ctr-us-east", Status: "running", HealthStatus: "healthy"},
+		{ID: "loc-eu-west", DeploymentID: "dep-org-a-owner", NodeID: "node-eu-west", ContainerID: "ctr-eu-west", Status: "running", HealthStatus: "unknown"},
+	} {
+		if err := db.Create(location).Error; err != nil {
+			t.Fatalf("seed location: %v", err)
+		}
+	}
+	res, err := service.GetDeploymentRegionStatus(ctx, connect.NewRequest(&deploymentsv1.GetDeploymentRegionStatusRequest{
+		OrganizationId: "org-a", DeploymentId: "dep-org-a-owner",
+	}))
+	if err != nil {
+		t.Fatalf("GetDeploymentRegionStatus: %v", err)
+	}
+	health := make(map[string]string)
+	for _, region := range res.Msg.GetRegions() {
+		health[region.GetRegion()] = region.GetHealthStatus()
+	}
+	if res.Msg.GetRegions()[0].GetRegion() != "us-east" || !res.Msg.GetRegions()[0].GetPrimary() {
+		t.Fatalf("first region = %s, want the primary us-east", res.Msg.GetRegions()[0].GetRegion())
+	}
+	// ap-south has no container location left, so it is reported unhealthy
+	if health["us-east"] != "healthy" || health["eu-west"] != "unknown" || health["ap-south"] != "unhealthy" {
+		t.Fatalf("region health = %v", health)
+	}
+
+	if _, err := service.GetDeploymentRegionStatus(ctx, connect.NewRequest(&deploymentsv1.GetDeploymentRegionStatusRequest{
+		OrganizationId: "org-b", DeploymentId: "dep-org-b-owner",
+	})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("cross-org region status code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+	}
+}
+
+func TestDeploymentRegionPartialFailure(t *testing.T) {
+	service, provisioner, _ := newDeploymentRegionsTestService(t)
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	provisioner.failures["ap-south"] = errors.New(`no available nodes in region "ap-south"`)
+	provisioner.expect(3)
+	records := service.provisionDeploymentRegions(ctx, "org-a", "dep-org-a-owner", []string{"us-east", "eu-west", "ap-south"}, "us-east", "")
+
+	if failed := records[2]; failed.Status != database.DeploymentRegionStatusFailed || failed.Error == "" {
+		t.Fatalf("ap-south = %s %q, want failed with the error", failed.Status, failed.Error)
+	}
+	if ips := deploymentRegionTraefikIPs(records); !slices.Equal(ips, []string{"203.0.113.10", "203.0.113.20"}) {
+		t.Fatalf("Traefik IPs = %v, want only the provisioned regions", ips)
+	}
+	if ips, _ := database.GetDeploymentNodeIP("dep-org-a-owner", nil); slices.Contains(ips, "203.0.113.30") {
+		t.Fatalf("DNS IPs %v include the failed region", ips)
+	}
+	primaryBefore, err := database.GetDeploymentRegionRecord("dep-org-a-owner", "us-east")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Updating with the failed region retries that region only
+	delete(provisioner.failures, "ap-south")
+	provisioner.expect(1)
+	if _, err := service.UpdateDeployment(ctx, connect.NewRequest(&deploymentsv1.UpdateDeploymentRequest{
+		OrganizationId: "org-a",
+		DeploymentId:   "dep-org-a-owner",
+		Regions:        []string{"ap-south"},
+	})); err != nil {
+		t.Fatalf("UpdateDeployment: %v", err)
+	}
+	if !slices.Equal(provisioner.calls, []string{"ap-south"}) {
+		t.Fatalf("update provisioned %v, want only ap-south", provisioner.calls)
+	}
+	retried, err := database.GetDeploymentRegionRecord("dep-org-a-owner", "ap-south")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retried.Status != database.DeploymentRegionStatusProvisioned || retried.Error != "" || retried.Primary {
+		t.Fatalf("ap-south after update = %s %q primary=%v, want a provisioned replica", retried.Status, retried.Error, retried.Primary)
+	}
+	primaryAfter, err := database.GetDeploymentRegionRecord("dep-org-a-owner", "us-east")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !primaryAfter.Primary || !primaryAfter.UpdatedAt.Equal(primaryBefore.UpdatedAt) {
+		t.Fatalf("us-east changed on an update of ap-south: %+v", primaryAfter)
+	}
+
+	if _, err := service.UpdateDeployment(ctx, connect.NewRequest(&deploymentsv1.UpdateDeploymentRequest{
+		OrganizationId: "org-a",
+		DeploymentId:   "dep-org-a-owner",
+		Regions:        []string{"moon-1"},
+	})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("unknown region code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+	}
+}
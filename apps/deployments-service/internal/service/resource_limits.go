@@ -0,0 +1,150 @@
+package deployments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/quota"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+
+	"connectrpc.com/connect"
+)
+
+// Smallest limits a deployment container can be given
+const (
+	minContainerCPU      = 0.1 // cores
+	minContainerMemoryMB = 128
+)
+
+// containerResourcePlan holds the largest CPU, memory and disk a single container of an
+// organization may request. Zero means unlimited.
+type containerResourcePlan struct {
+	MaxCPU      float64
+	MaxMemoryMB int64
+	MaxDiskMB   int64
+}
+
+// containerResourcePlanFor returns the container limits of an organization from its effective plan limits
+func containerResourcePlanFor(orgID string) (containerResourcePlan, error) {
+	memoryBytes, cpuCores, err := quota.GetEffectiveLimits(orgID)
+	if err != nil {
+		return containerResourcePlan{}, err
+	}
+	storageBytes, err := quota.GetEffectiveStorageLimit(orgID)
+	if err != nil {
+		return containerResourcePlan{}, err
+	}
+	return containerResourcePlan{
+		MaxCPU:      float64(cpuCores),
+		MaxMemoryMB: memoryBytes / (1024 * 1024),
+		MaxDiskMB:   storageBytes / (1024 * 1024),
+	}, nil
+}
+
+// validateContainerResources checks a container's CPU (cores), memory and disk (MB) against the
+// minimums and the plan. A diskMB of 0 requests no disk.
+func (plan containerResourcePlan) validateContainerResources(cpu float64, memoryMB, diskMB int64) error {
+	if math.IsNaN(cpu) || cpu < minContainerCPU {
+		return fmt.Errorf("CPU limit must be at least %.1f cores", minContainerCPU)
+	}
+	if plan.MaxCPU > 0 && cpu > plan.MaxCPU {
+		return fmt.Errorf("CPU limit of %g cores exceeds the plan limit of %g cores", cpu, plan.MaxCPU)
+	}
+	if memoryMB < minContainerMemoryMB {
+		return fmt.Errorf("memory limit must be at least %d MB", minContainerMemoryMB)
+	}
+	if plan.MaxMemoryMB > 0 && memoryMB > plan.MaxMemoryMB {
+		return fmt.Errorf("memory limit of %d MB exceeds the plan limit of %d MB", memoryMB, plan.MaxMemoryMB)
+	}
+	if diskMB < 0 {
+		return fmt.Errorf("disk limit cannot be negative")
+	}
+	if plan.MaxDiskMB > 0 && diskMB > plan.MaxDiskMB {
+		return fmt.Errorf("disk limit of %d MB exceeds the plan limit of %d MB", diskMB, plan.MaxDiskMB)
+	}
+	return nil
+}
+
+// cpuSharesFromCores converts a CPU limit in cores to Docker CPU shares (1024 = 1 core)
+func cpuSharesFromCores(cores float64) int64 {
+	shares := int64(math.Round(cores * 1024.0))
+	if shares < 1 {
+		shares = 1
+	}
+	return shares
+}
+
+// cpuCoresFromShares converts Docker CPU shares back to cores, rounded to hundredths so that a
+// limit set as 0.1 cores (102 shares) reads back as 0.1
+func cpuCoresFromShares(shares int64) float64 {
+	return math.Round(float64(shares)/1024.0*100) / 100
+}
+
+// SetResourceLimits sets the CPU and memory limits of a deployment and applies them to its running
+// containers without a redeploy
+func (s *Service) SetResourceLimits(ctx context.Context, req *connect.Request[deploymentsv1.SetResourceLimitsRequest]) (*connect.Response[deploymentsv1.SetResourceLimitsResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+
+	if err := s.checkDeploymentOrgAccess(ctx, orgID, deploymentID, auth.PermissionDeploymentUpdate); err != nil {
+		return nil, err
+	}
+
+	// Running containers are updated by the node that runs them
+	if shouldForward, targetNodeID := s.getDeploymentForwardTarget(ctx, deploymentID); shouldForward {
+		reqBody, _ := json.Marshal(req.Msg)
+		headers := map[string]string{"Authorization": req.Header().Get("Authorization")}
+		bodyBytes, err := s.forwardUnaryRequest(ctx, reqBody, targetNodeID, "/obiente.cloud.deployments.v1.DeploymentService/SetResourceLimits", headers, &deploymentsv1.SetResourceLimitsResponse{})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to forward request: %w", err))
+		}
+
+		var response deploymentsv1.SetResourceLimitsResponse
+		if err := json.Unmarshal(bodyBytes, &response); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode response: %w", err))
+		}
+		return connect.NewResponse(&response), nil
+	}
+
+	plan, err := containerResourcePlanFor(orgID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load plan limits: %w", err))
+	}
+	cpu := req.Msg.GetCpuLimit()
+	memoryMB := req.Msg.GetMemoryLimit()
+	if err := plan.validateContainerResources(cpu, memoryMB, 0); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	dbDeployment, err := s.repo.GetByID(ctx, deploymentID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentID))
+	}
+	cpuShares := cpuSharesFromCores(cpu)
+	memoryBytes := memoryMB * 1024 * 1024
+	// The organization's other running deployments plus this one must still fit in the plan
+	if err := s.validateDeploymentResources(ctx, orgID, &cpuShares, &memoryBytes, dbDeployment.Replicas, deploymentID); err != nil {
+		return nil, err
+	}
+
+	dbDeployment.CPUShares = &cpuShares
+	dbDeployment.MemoryBytes = &memoryBytes
+	if err := s.repo.Update(ctx, dbDeployment); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update deployment: %w", err))
+	}
+
+	// Containers pick the stored limits up on their next deploy if they cannot be updated now
+	if s.manager != nil {
+		if err := s.manager.UpdateDeploymentResources(ctx, deploymentID, cpuShares, memoryBytes); err != nil {
+			logger.Warn("[SetResourceLimits] Failed to update running containers of deployment %s: %v", deploymentID, err)
+		}
+	}
+
+	return connect.NewResponse(&deploymentsv1.SetResourceLimitsResponse{
+		Deployment: dbDeploymentToProto(dbDeployment),
+	}), nil
+}
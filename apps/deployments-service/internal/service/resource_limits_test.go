@@ -0,0 +1,90 @@
+package deployments
+
+import (
+	"testing"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+func TestValidateContainerResources(t *testing.T) {
+	plan := containerResourcePlan{MaxCPU: 2, MaxMemoryMB: 4096, MaxDiskMB: 10240}
+
+	tests := []struct {
+		name     string
+		cpu      float64
+		memoryMB int64
+		diskMB   int64
+		wantErr  bool
+	}{
+		{name: "within plan", cpu: 1, memoryMB: 512},
+		{name: "at the minimums", cpu: 0.1, memoryMB: 128},
+		{name: "at the plan limits", cpu: 2, memoryMB: 4096, diskMB: 10240},
+		{name: "cpu below minimum", cpu: 0.05, memoryMB: 512, wantErr: true},
+		{name: "cpu above plan", cpu: 2.5, memoryMB: 512, wantErr: true},
+		{name: "memory below minimum", cpu: 1, memoryMB: 64, wantErr: true},
+		{name: "memory above plan", cpu: 1, memoryMB: 8192, wantErr: true},
+		{name: "disk above plan", cpu: 1, memoryMB: 512, diskMB: 20480, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := plan.validateContainerResources(tt.cpu, tt.memoryMB, tt.diskMB)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateContainerResources(%g, %d, %d) = %v, want error %v", tt.cpu, tt.memoryMB, tt.diskMB, err, tt.wantErr)
+			}
+		})
+	}
+
+	// Without a plan only the minimums apply
+	if err := (containerResourcePlan{}).validateContainerResources(64, 1024*1024, 1024*1024); err != nil {
+		t.Fatalf("unlimited plan rejected large limits: %v", err)
+	}
+}
+
+func TestContainerResourcePlanForTracksQuota(t *testing.T) {
+	db := newTestDB(t, &database.Organization{}, &database.OrganizationPlan{}, &database.OrgQuota{})
+
+	const mb = int64(1024 * 1024)
+	memoryOverride := 2048 * mb
+	seed := []any{
+		&database.OrganizationPlan{ID: "plan-pro", Name: "Pro", CPUCores: 4, MemoryBytes: 8192 * mb, StorageBytes: 51200 * mb},
+		&database.Organization{ID: "org-a", Name: "Acme", Slug: "acme"},
+		&database.OrgQuota{OrganizationID: "org-a", PlanID: "plan-pro", MemoryBytesOverride: &memoryOverride},
+	}
+	for _, record := range seed {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	plan, err := containerResourcePlanFor("org-a")
+	if err != nil {
+		t.Fatalf("containerResourcePlanFor: %v", err)
+	}
+	want := containerResourcePlan{MaxCPU: 4, MaxMemoryMB: 2048, MaxDiskMB: 51200}
+	if plan != want {
+		t.Fatalf("plan = %+v, want %+v", plan, want)
+	}
+	if err := plan.validateContainerResources(1, 4096, 0); err == nil {
+		t.Fatal("memory above the organization's override accepted")
+	}
+
+	// Raising the override up to the plan raises the container limit with it
+	memoryOverride = 8192 * mb
+	if err := db.Model(&database.OrgQuota{}).Where("organization_id = ?", "org-a").Update("memory_bytes_override", memoryOverride).Error; err != nil {
+		t.Fatalf("update quota: %v", err)
+	}
+	if plan, err = containerResourcePlanFor("org-a"); err != nil || plan.MaxMemoryMB != 8192 {
+		t.Fatalf("plan after raising the override = %+v (%v), want 8192 MB of memory", plan, err)
+	}
+	if err := plan.validateContainerResources(1, 4096, 0); err != nil {
+		t.Fatalf("memory within the raised override rejected: %v", err)
+	}
+}
+
+func TestCPUSharesRoundTrip(t *testing.T) {
+	for _, cores := range []float64{0.1, 0.25, 0.5, 1, 1.5, 4} {
+		if got := cpuCoresFromShares(cpuSharesFromCores(cores)); got != cores {
+			t.Errorf("cpuCoresFromShares(cpuSharesFromCores(%g)) = %g", cores, got)
+		}
+	}
+}
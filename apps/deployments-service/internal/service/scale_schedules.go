@@ -0,0 +1,138 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/orchestrator"
+	"github.com/obiente/cloud/apps/shared/pkg/quota"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxDeploymentScaleSchedules bounds the scale schedules a single deployment can carry
+const maxDeploymentScaleSchedules = 20
+
+// CreateScaleSchedule adds a cron schedule that sets the deployment's replica count each time it matches
+func (s *Service) CreateScaleSchedule(ctx context.Context, req *connect.Request[deploymentsv1.CreateScaleScheduleRequest]) (*connect.Response[deploymentsv1.CreateScaleScheduleResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+
+	if err := s.checkDeploymentOrgAccess(ctx, orgID, deploymentID, auth.PermissionDeploymentScale); err != nil {
+		return nil, err
+	}
+
+	expression := strings.TrimSpace(req.Msg.GetCronExpression())
+	if _, err := orchestrator.ParseCronExpression(expression); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	replicas := req.Msg.GetReplicaCount()
+	if replicas < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("replica_count must be >= 0"))
+	}
+	if maxReplicas := quota.GetMaxReplicas(orgID); maxReplicas > 0 && int(replicas) > maxReplicas {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("replica_count exceeds the plan limit of %d replicas", maxReplicas))
+	}
+
+	dbDep, err := s.repo.GetByID(ctx, deploymentID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentID))
+	}
+	if dbDep.ComposeYaml != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("compose deployments cannot be scaled on a schedule"))
+	}
+
+	existing, err := database.ListDeploymentScaleSchedules(deploymentID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(existing) >= maxDeploymentScaleSchedules {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("a deployment can have at most %d scale schedules", maxDeploymentScaleSchedules))
+	}
+
+	schedule := database.DeploymentScaleSchedule{
+		ID:             fmt.Sprintf("scale-%s", uuid.NewString()),
+		DeploymentID:   deploymentID,
+		OrganizationID: orgID,
+		CronExpression: expression,
+		ReplicaCount:   replicas,
+		CreatedAt:      time.Now(),
+	}
+	if user, err := auth.GetUserFromContext(ctx); err == nil && user != nil {
+		schedule.CreatedBy = user.Id
+	}
+	if err := database.DB.Create(&schedule).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create scale schedule: %w", err))
+	}
+
+	return connect.NewResponse(&deploymentsv1.CreateScaleScheduleResponse{
+		Schedule: scaleScheduleToProto(schedule),
+	}), nil
+}
+
+// DeleteScaleSchedule removes a scale schedule from a deployment
+func (s *Service) DeleteScaleSchedule(ctx context.Context, req *connect.Request[deploymentsv1.DeleteScaleScheduleRequest]) (*connect.Response[deploymentsv1.DeleteScaleScheduleResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+
+	if err := s.checkDeploymentOrgAccess(ctx, orgID, deploymentID, auth.PermissionDeploymentScale); err != nil {
+		return nil, err
+	}
+
+	result := database.DB.Where("id = ? AND deployment_id = ?", req.Msg.GetScheduleId(), deploymentID).Delete(&database.DeploymentScaleSchedule{})
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete scale schedule: %w", result.Error))
+	}
+	if result.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("scale schedule %s not found", req.Msg.GetScheduleId()))
+	}
+
+	return connect.NewResponse(&deploymentsv1.DeleteScaleScheduleResponse{Success: true}), nil
+}
+
+// ListScaleSchedules returns the scale schedules of a deployment with their next run time
+func (s *Service) ListScaleSchedules(ctx context.Context, req *connect.Request[deploymentsv1.ListScaleSchedulesRequest]) (*connect.Response[deploymentsv1.ListScaleSchedulesResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+
+	if err := s.checkDeploymentOrgAccess(ctx, orgID, deploymentID, auth.PermissionDeploymentRead); err != nil {
+		return nil, err
+	}
+
+	schedules, err := database.ListDeploymentScaleSchedules(deploymentID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	out := make([]*deploymentsv1.ScaleSchedule, len(schedules))
+	for i, schedule := range schedules {
+		out[i] = scaleScheduleToProto(schedule)
+	}
+
+	return connect.NewResponse(&deploymentsv1.ListScaleSchedulesResponse{Schedules: out}), nil
+}
+
+func scaleScheduleToProto(schedule database.DeploymentScaleSchedule) *deploymentsv1.ScaleSchedule {
+	out := &deploymentsv1.ScaleSchedule{
+		Id:             schedule.ID,
+		DeploymentId:   schedule.DeploymentID,
+		CronExpression: schedule.CronExpression,
+		ReplicaCount:   schedule.ReplicaCount,
+		CreatedAt:      timestamppb.New(schedule.CreatedAt),
+	}
+	if cron, err := orchestrator.ParseCronExpression(schedule.CronExpression); err == nil {
+		if next := cron.Next(time.Now()); !next.IsZero() {
+			out.NextRunAt = timestamppb.New(next)
+		}
+	}
+	if schedule.LastRunAt != nil {
+		out.LastRunAt = timestamppb.New(*schedule.LastRunAt)
+	}
+	return out
+}
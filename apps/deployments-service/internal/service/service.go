@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"deployments-service/internal/certs"
+
 	"github.com/obiente/cloud/apps/shared/pkg/auth"
 	"github.com/obiente/cloud/apps/shared/pkg/database"
 	"github.com/obiente/cloud/apps/shared/pkg/orchestrator"
@@ -20,28 +22,41 @@ type Service struct {
 	deploymentsv1connect.UnimplementedDeploymentServiceHandler
 	repo              *database.DeploymentRepository
 	buildHistoryRepo  *database.BuildHistoryRepository
+	versionRepo       *database.DeploymentVersionRepository
 	runtimeLogsRepo   *database.DeploymentRuntimeLogsRepository
 	permissionChecker *auth.PermissionChecker
 	manager           *orchestrator.DeploymentManager
 	quotaChecker      *quota.Checker
 	buildRegistry     *BuildStrategyRegistry
 	forwarder         *orchestrator.NodeForwarder
+	certIssuer        certificateIssuer
+	httpHealth        *httpHealthTracker
+	regionProvisioner regionProvisioner
 	backgroundCtx     context.Context
+
+	githubCredentialClient func(ctx context.Context, integration *database.GitHubIntegration) (githubCredentialAPI, error)
 }
 
 func NewService(backgroundCtx context.Context, repo *database.DeploymentRepository, manager *orchestrator.DeploymentManager, qc *quota.Checker) *Service {
 	forwarder := orchestrator.NewNodeForwarder()
-	return &Service{
+	s := &Service{
 		repo:              repo,
 		buildHistoryRepo:  database.NewBuildHistoryRepository(database.DB),
+		versionRepo:       database.NewDeploymentVersionRepository(database.DB, database.DeploymentMaxVersionsFromEnv()),
 		runtimeLogsRepo:   database.NewDeploymentRuntimeLogsRepository(database.MetricsDB),
 		permissionChecker: auth.NewPermissionChecker(),
 		manager:           manager,
 		quotaChecker:      qc,
 		buildRegistry:     NewBuildStrategyRegistry(),
 		forwarder:         forwarder,
+		certIssuer:        certs.NewIssuerFromEnv(),
+		httpHealth:        newHTTPHealthTracker(),
 		backgroundCtx:     backgroundCtx,
+
+		githubCredentialClient: newGitHubCredentialClient,
 	}
+	s.regionProvisioner = orchestratorRegionProvisioner{service: s}
+	return s
 }
 
 func (s *Service) detachedContext(timeout time.Duration) (context.Context, context.CancelFunc) {
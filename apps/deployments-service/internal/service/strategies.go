@@ -12,6 +12,8 @@ import (
 	"strconv"
 	"strings"
 
+	"deployments-service/internal/linter"
+
 	"github.com/obiente/cloud/apps/shared/pkg/database"
 	"github.com/obiente/cloud/apps/shared/pkg/logger"
 )
@@ -1766,6 +1768,27 @@ func (s *DockerfileStrategy) Build(ctx context.Context, deployment *database.Dep
 	}
 	writeBuildLog("   🐳 Dockerfile: %s", dockerfilePathValue)
 
+	// Lint the Dockerfile before building; warnings only fail the build with BLOCK_ON_LINT=true
+	var lintWarnings []linter.LintWarning
+	if content, err := readFile(dockerfilePath); err != nil {
+		logger.Warn("[Dockerfile] Failed to read Dockerfile for linting: %v", err)
+	} else {
+		lintWarnings = linter.LintDockerfile(ctx, content)
+	}
+	if len(lintWarnings) > 0 {
+		writeBuildLog("   ⚠️  Dockerfile lint found %d issue(s):", len(lintWarnings))
+		for _, warning := range lintWarnings {
+			writeBuildLog("      - %s", warning)
+		}
+		if linter.BlockOnLint() {
+			return &BuildResult{
+				Success:      false,
+				Error:        fmt.Errorf("dockerfile lint failed with %d issue(s) (BLOCK_ON_LINT=true)", len(lintWarnings)),
+				LintWarnings: lintWarnings,
+			}, nil
+		}
+	}
+
 	if err := buildDockerImage(ctx, contextDir, imageName, dockerfilePath, config.BuildArgs, config.DockerfileBuildOptions, config.LogWriter, config.LogWriterErr); err != nil {
 		return &BuildResult{Success: false, Error: fmt.Errorf("docker build failed: %w", err)}, nil
 	}
@@ -1786,6 +1809,7 @@ func (s *DockerfileStrategy) Build(ctx context.Context, deployment *database.Dep
 		ImageSizeBytes: imageSize,
 		Port:           port,
 		Success:        true,
+		LintWarnings:   lintWarnings,
 	}, nil
 }
 
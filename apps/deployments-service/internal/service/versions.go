@@ -0,0 +1,130 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/orchestrator"
+
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// resolveImageReference pins an image to its content, since builds reuse the same tag
+// Registry images resolve to their repo digest (pullable on any swarm node), local images to their ID.
+// It is a variable so tests can avoid calling Docker.
+var resolveImageReference = func(ctx context.Context, image string) (string, error) {
+	out, err := exec.CommandContext(ctx, "docker", "image", "inspect", image,
+		"--format", "{{if .RepoDigests}}{{index .RepoDigests 0}}{{else}}{{.Id}}{{end}}").Output()
+	if err != nil {
+		return "", fmt.Errorf("inspect image %s: %w", image, err)
+	}
+	ref := strings.TrimSpace(string(out))
+	if ref == "" {
+		return "", fmt.Errorf("image %s has no ID", image)
+	}
+	return ref, nil
+}
+
+// recordDeploymentVersion adds the deployment's current image and env vars to its version history
+// Compose deployments are not versioned.
+func (s *Service) recordDeploymentVersion(ctx context.Context, deployment *database.Deployment) {
+	if deployment.ComposeYaml != "" || deployment.Image == nil || *deployment.Image == "" {
+		return
+	}
+	image, err := resolveImageReference(ctx, *deployment.Image)
+	if err != nil {
+		logger.Warn("[DeploymentVersions] Failed to pin image of deployment %s, recording tag %s: %v", deployment.ID, *deployment.Image, err)
+		image = *deployment.Image
+	}
+	version, err := s.versionRepo.Record(ctx, deployment, image)
+	if err != nil {
+		logger.Warn("[DeploymentVersions] %v", err)
+		return
+	}
+	logger.Info("[DeploymentVersions] Deployment %s is running version %d (%s)", deployment.ID, version.VersionNumber, image)
+}
+
+// rollbackToVersion restores a version's image and env vars and redeploys the deployment with them
+func (s *Service) rollbackToVersion(ctx context.Context, deployment *database.Deployment, versionNumber int32) (*database.DeploymentVersion, error) {
+	version, err := s.versionRepo.Get(ctx, deployment.ID, versionNumber)
+	if err != nil {
+		if errors.Is(err, database.ErrDeploymentVersionNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("version %d of deployment %s not found", versionNumber, deployment.ID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get version: %w", err))
+	}
+
+	// The deploy reads env vars from the database, so the version is saved first and
+	// the previous state is put back if the restored version never becomes healthy
+	previous := *deployment
+	if err := version.ApplyTo(deployment); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err := s.repo.Update(ctx, deployment); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to restore version %d: %w", versionNumber, err))
+	}
+
+	// Health-gated: the current containers keep serving until the restored version is healthy
+	// With zero-downtime deploys they also finish their in-flight requests
+	var deployErr error
+	if deployment.ZeroDowntimeDeploy {
+		deployErr = s.manager.DrainAndReplace(ctx, deployment.ID, version.Image, orchestrator.DefaultDrainTimeoutSeconds)
+	} else {
+		deployErr = s.manager.BlueGreenDeploy(ctx, deployment.ID, version.Image)
+	}
+	if deployErr != nil {
+		logger.Warn("[RollbackDeployment] Failed to deploy version %d of deployment %s: %v", versionNumber, deployment.ID, deployErr)
+		*deployment = previous
+		if restoreErr := s.repo.Update(ctx, deployment); restoreErr != nil {
+			logger.Error("[RollbackDeployment] Failed to restore the running configuration of deployment %s: %v", deployment.ID, restoreErr)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to deploy version %d: %w", versionNumber, deployErr))
+	}
+
+	if err := s.versionRepo.Activate(ctx, deployment.ID, versionNumber); err != nil {
+		logger.Warn("[RollbackDeployment] Failed to mark version %d of deployment %s active: %v", versionNumber, deployment.ID, err)
+	}
+	version.Active = true
+
+	logger.Info("[RollbackDeployment] Rolled back deployment %s to version %d (%s)", deployment.ID, versionNumber, version.Image)
+	return version, nil
+}
+
+// ListDeploymentVersions lists the versions a deployment can be rolled back to
+func (s *Service) ListDeploymentVersions(ctx context.Context, req *connect.Request[deploymentsv1.ListDeploymentVersionsRequest]) (*connect.Response[deploymentsv1.ListDeploymentVersionsResponse], error) {
+	deploymentID := req.Msg.GetDeploymentId()
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.permissionChecker.CheckScopedPermission(ctx, orgID, auth.ScopedPermission{Permission: auth.PermissionDeploymentRead, ResourceType: "deployment", ResourceID: deploymentID}); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	versions, err := s.versionRepo.List(ctx, deploymentID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list versions: %w", err))
+	}
+
+	protoVersions := make([]*deploymentsv1.DeploymentVersion, 0, len(versions))
+	for i := range versions {
+		protoVersions = append(protoVersions, deploymentVersionToProto(&versions[i]))
+	}
+	return connect.NewResponse(&deploymentsv1.ListDeploymentVersionsResponse{Versions: protoVersions}), nil
+}
+
+func deploymentVersionToProto(version *database.DeploymentVersion) *deploymentsv1.DeploymentVersion {
+	return &deploymentsv1.DeploymentVersion{
+		VersionNumber: version.VersionNumber,
+		Image:         version.Image,
+		EnvVarsHash:   version.EnvVarsHash,
+		Active:        version.Active,
+		CreatedAt:     timestamppb.New(version.CreatedAt),
+	}
+}
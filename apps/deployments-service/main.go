@@ -10,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	"deployments-service/internal/secrets"
 	deploymentsvc "deployments-service/internal/service"
 
 	"github.com/obiente/cloud/apps/shared/pkg/auth"
@@ -51,6 +52,10 @@ func main() {
 		&database.Organization{},
 		&database.OrganizationMember{},
 		&database.GitHubIntegration{},
+		&database.GitHubRepositoryCredential{},
+		&database.EnvEncryptionKey{},
+		&database.PreviewDeployment{},
+		&database.DeploymentVersion{},
 	)
 
 	// Initialize database
@@ -59,6 +64,21 @@ func main() {
 	}
 	logger.Info("✓ Database initialized")
 
+	// Encrypt deployment env vars at rest when a master key is configured
+	if err := secrets.Init(); err != nil {
+		logger.Fatalf("failed to load env encryption key: %v", err)
+	}
+	if secrets.Enabled() {
+		database.SetDeploymentEnvCipher(secrets.Cipher())
+		migrated, err := secrets.MigratePlaintext(context.Background())
+		if err != nil {
+			logger.Warn("Failed to encrypt plaintext env vars: %v", err)
+		}
+		logger.Info("✓ Env var encryption enabled (%d deployments migrated)", migrated)
+	} else {
+		logger.Warn("⚠️  ENV_ENCRYPTION_KEY_PATH not set, deployment env vars are stored in plaintext")
+	}
+
 	// Initialize metrics database (TimescaleDB for metrics)
 	if err := database.InitMetricsDatabase(); err != nil {
 		logger.Warn("Metrics database initialization failed: %v. Metrics may not work correctly.", err)
@@ -92,6 +112,12 @@ func main() {
 	// Create audit interceptor
 	auditInterceptor := middleware.AuditLogInterceptor()
 
+	// Create maintenance mode interceptor (rejects mutating RPCs during platform maintenance)
+	maintenanceInterceptor := middleware.MaintenanceModeInterceptor()
+
+	// Reject requests for suspended organizations
+	suspensionInterceptor := auth.OrgSuspensionInterceptor()
+
 	// Initialize orchestrator service for deployment management
 	// Try to get from global orchestrator service first
 	var manager *orchestrator.DeploymentManager
@@ -116,20 +142,38 @@ func main() {
 		}
 	}
 
+	// Evict old Docker build cache hourly so per-deployment layer caches don't fill the disk
+	if manager != nil {
+		cacheEviction := manager.CacheEvictionManager(orchestrator.DockerCacheMaxBytesFromEnv())
+		go cacheEviction.Run(shutdownCtx, 1*time.Hour)
+		logger.Info("✓ Build cache eviction started")
+	}
+
 	// Create repositories and services
 	deploymentRepo := database.NewDeploymentRepository(database.DB, database.RedisClient)
 	qc := quota.NewChecker()
 	deploymentService := deploymentsvc.NewService(shutdownCtx, deploymentRepo, manager, qc)
 
+	// Remove preview deployments of pull requests that were closed without a cleanup webhook
+	go deploymentService.StartPreviewPurger(shutdownCtx, 24*time.Hour)
+
+	// Redeploy deployments that lost their containers or keep failing their HTTP health check
+	go deploymentService.StartHealthMonitor(shutdownCtx, 5*time.Minute)
+
+	// Rotate GitHub deploy keys and repository webhook secrets older than 90 days
+	go deploymentService.StartGitHubCredentialRotator(shutdownCtx, 24*time.Hour)
+
 	// Register deployments service
 	deploymentsPath, deploymentsHandler := deploymentsv1connect.NewDeploymentServiceHandler(
 		deploymentService,
-		connect.WithInterceptors(auditInterceptor, authInterceptor),
+		connect.WithInterceptors(auditInterceptor, authInterceptor, maintenanceInterceptor, suspensionInterceptor),
 	)
 	mux.Handle(deploymentsPath, deploymentsHandler)
 
 	// WebSocket terminal endpoint (bypasses Connect RPC for direct access)
 	mux.HandleFunc("/terminal/ws", deploymentService.HandleTerminalWebSocket)
+	// WebSocket log stream with server-side filtering and replay of recent lines
+	mux.HandleFunc("/logs/ws", deploymentService.HandleDeploymentLogsWebSocket)
 	mux.HandleFunc("/webhooks/github", deploymentService.HandleGitHubWebhook)
 
 	// Health check endpoint with replica ID
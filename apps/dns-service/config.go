@@ -0,0 +1,166 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+// ValidateConfig checks the service's environment before anything is initialized.
+// It runs every check and returns all failures joined together, so a misconfigured
+// deployment can be fixed in one pass.
+func ValidateConfig() error {
+	var errs []error
+	check := func(err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Database (required even when the DNS server is disabled, for delegation endpoints)
+	for _, key := range []string{"DB_HOST", "DB_PORT", "DB_USER", "DB_PASSWORD", "DB_NAME"} {
+		if os.Getenv(key) == "" {
+			errs = append(errs, fmt.Errorf("%s is required", key))
+		}
+	}
+	check(validatePort("DB_PORT"))
+	check(validatePort("HTTP_PORT"))
+
+	// DNS server
+	if dnsServerEnabled() {
+		nodeIPs := os.Getenv("NODE_IPS")
+		if nodeIPs == "" {
+			errs = append(errs, fmt.Errorf("NODE_IPS is required when the DNS server is enabled (set ENABLE_DNS=false to disable it)"))
+		} else if parsed, err := database.ParseNodeIPsFromEnv(nodeIPs); err != nil {
+			errs = append(errs, fmt.Errorf("NODE_IPS is invalid: %w", err))
+		} else if len(parsed) == 0 {
+			errs = append(errs, fmt.Errorf("NODE_IPS does not contain any IP addresses"))
+		}
+		check(validatePort("DNS_PORT"))
+	}
+	check(validateDNSIPs())
+	check(validateStaleGrace())
+
+	// Query log
+	if value := os.Getenv("DNS_QUERY_LOG_RETENTION_DAYS"); value != "" {
+		if days, err := strconv.Atoi(value); err != nil || days < 1 {
+			errs = append(errs, fmt.Errorf("DNS_QUERY_LOG_RETENTION_DAYS must be a positive number of days, got %q", value))
+		}
+	}
+
+	// DNSSEC
+	if (os.Getenv("DNSSEC_KSK_PATH") == "") != (os.Getenv("DNSSEC_ZSK_PATH") == "") {
+		errs = append(errs, fmt.Errorf("DNSSEC_KSK_PATH and DNSSEC_ZSK_PATH must be set together"))
+	}
+	check(validatePositiveDuration("DNSSEC_ZSK_ROLLOVER", 0))
+
+	// DNS delegation pusher
+	productionAPIURL := delegationEnv("DNS_DELEGATION_PRODUCTION_API_URL")
+	apiKey := delegationEnv("DNS_DELEGATION_API_KEY")
+	switch {
+	case productionAPIURL != "" && apiKey == "":
+		errs = append(errs, fmt.Errorf("DNS_DELEGATION_PRODUCTION_API_URL is set but DNS_DELEGATION_API_KEY is not"))
+	case productionAPIURL == "" && apiKey != "":
+		errs = append(errs, fmt.Errorf("DNS_DELEGATION_API_KEY is set but DNS_DELEGATION_PRODUCTION_API_URL is not"))
+	}
+	if productionAPIURL != "" {
+		if u, err := url.Parse(productionAPIURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("DNS_DELEGATION_PRODUCTION_API_URL must be an http(s) URL, got %q", productionAPIURL))
+		}
+	}
+	check(validatePositiveDuration("DNS_DELEGATION_PUSH_INTERVAL", 0))
+	check(validatePositiveDuration("DNS_DELEGATION_TTL", time.Second))
+
+	return errors.Join(errs...)
+}
+
+// dnsServerEnabled reports whether ENABLE_DNS leaves the DNS server on (the default)
+func dnsServerEnabled() bool {
+	enableDNS := os.Getenv("ENABLE_DNS")
+	return enableDNS != "false" && enableDNS != "0"
+}
+
+// delegationEnv reads a DNS delegation variable, tolerating quotes copied from .env files
+func delegationEnv(key string) string {
+	return strings.Trim(strings.TrimSpace(os.Getenv(key)), `"'`)
+}
+
+func validatePort(key string) error {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("%s must be a port number between 1 and 65535, got %q", key, value)
+	}
+	return nil
+}
+
+// validatePositiveDuration checks that an optional duration parses and is at least min
+// (and above zero)
+func validatePositiveDuration(key string, min time.Duration) error {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s must be a duration (e.g. 2m, 300s), got %q", key, value)
+	}
+	if d <= 0 || d < min {
+		if min > 0 {
+			return fmt.Errorf("%s must be at least %s, got %s", key, min, d)
+		}
+		return fmt.Errorf("%s must be positive, got %s", key, d)
+	}
+	return nil
+}
+
+// validateStaleGrace checks GAMESERVER_DNS_STALE_GRACE and its legacy spelling
+// GAME_SERVER_DNS_STALE_GRACE, which must agree when both are set
+func validateStaleGrace() error {
+	grace := strings.TrimSpace(os.Getenv("GAMESERVER_DNS_STALE_GRACE"))
+	legacy := strings.TrimSpace(os.Getenv("GAME_SERVER_DNS_STALE_GRACE"))
+	if grace != "" && legacy != "" && grace != legacy {
+		return fmt.Errorf("GAMESERVER_DNS_STALE_GRACE (%q) and GAME_SERVER_DNS_STALE_GRACE (%q) conflict; set only one", grace, legacy)
+	}
+
+	key, value := "GAMESERVER_DNS_STALE_GRACE", grace
+	if value == "" {
+		key, value = "GAME_SERVER_DNS_STALE_GRACE", legacy
+	}
+	if value == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s must be a duration (e.g. 2m), got %q", key, value)
+	}
+	if d < 0 {
+		return fmt.Errorf("%s must not be negative, got %s", key, d)
+	}
+	return nil
+}
+
+// validateDNSIPs checks that DNS_IPS lists IP addresses rather than hostnames
+func validateDNSIPs() error {
+	var invalid []string
+	for _, ip := range strings.Split(os.Getenv("DNS_IPS"), ",") {
+		ip = strings.TrimSpace(ip)
+		if ip != "" && net.ParseIP(ip) == nil {
+			invalid = append(invalid, ip)
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("DNS_IPS must contain IP addresses, not hostnames: %s", strings.Join(invalid, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// configEnvKeys lists every variable ValidateConfig reads, so each case starts from a clean slate
+var configEnvKeys = []string{
+	"DB_HOST", "DB_PORT", "DB_USER", "DB_PASSWORD", "DB_NAME",
+	"HTTP_PORT", "DNS_PORT", "ENABLE_DNS", "NODE_IPS", "DNS_IPS",
+	"GAMESERVER_DNS_STALE_GRACE", "GAME_SERVER_DNS_STALE_GRACE",
+	"DNS_DELEGATION_PRODUCTION_API_URL", "DNS_DELEGATION_API_KEY",
+	"DNS_DELEGATION_PUSH_INTERVAL", "DNS_DELEGATION_TTL",
+	"DNSSEC_KSK_PATH", "DNSSEC_ZSK_PATH", "DNSSEC_ZSK_ROLLOVER",
+}
+
+func setConfigEnv(t *testing.T, env map[string]string) {
+	t.Helper()
+	for _, key := range configEnvKeys {
+		t.Setenv(key, "")
+	}
+	base := map[string]string{
+		"DB_HOST":     "postgres",
+		"DB_PORT":     "5432",
+		"DB_USER":     "obiente",
+		"DB_PASSWORD": "secret",
+		"DB_NAME":     "obiente",
+		"NODE_IPS":    "us-east-1:10.0.0.1,10.0.0.2",
+	}
+	for key, value := range base {
+		t.Setenv(key, value)
+	}
+	for key, value := range env {
+		t.Setenv(key, value)
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want []string // substrings expected in the error, one per failing check
+	}{
+		{name: "valid defaults"},
+		{
+			name: "valid delegation",
+			env: map[string]string{
+				"DNS_DELEGATION_PRODUCTION_API_URL": `"https://api.obiente.cloud"`,
+				"DNS_DELEGATION_API_KEY":            "key",
+				"DNS_DELEGATION_PUSH_INTERVAL":      "1m",
+				"DNS_DELEGATION_TTL":                "60s",
+			},
+		},
+		{
+			name: "missing database settings",
+			env:  map[string]string{"DB_HOST": "", "DB_PASSWORD": ""},
+			want: []string{"DB_HOST is required", "DB_PASSWORD is required"},
+		},
+		{
+			name: "invalid ports",
+			env:  map[string]string{"DB_PORT": "postgres", "HTTP_PORT": "70000", "DNS_PORT": "0"},
+			want: []string{"DB_PORT must be a port", "HTTP_PORT must be a port", "DNS_PORT must be a port"},
+		},
+		{
+			name: "dns enabled without node ips",
+			env:  map[string]string{"NODE_IPS": ""},
+			want: []string{"NODE_IPS is required"},
+		},
+		{
+			name: "dns disabled without node ips",
+			env:  map[string]string{"NODE_IPS": "", "ENABLE_DNS": "false", "DNS_PORT": "invalid"},
+		},
+		{
+			name: "dns ips with hostnames",
+			env:  map[string]string{"DNS_IPS": "10.0.9.10, dns-service"},
+			want: []string{"DNS_IPS must contain IP addresses, not hostnames: dns-service"},
+		},
+		{
+			name: "delegation url without api key",
+			env:  map[string]string{"DNS_DELEGATION_PRODUCTION_API_URL": "https://api.obiente.cloud"},
+			want: []string{"DNS_DELEGATION_PRODUCTION_API_URL is set but DNS_DELEGATION_API_KEY is not"},
+		},
+		{
+			name: "delegation api key without url",
+			env:  map[string]string{"DNS_DELEGATION_API_KEY": "key"},
+			want: []string{"DNS_DELEGATION_API_KEY is set but DNS_DELEGATION_PRODUCTION_API_URL is not"},
+		},
+		{
+			name: "delegation url without scheme",
+			env:  map[string]string{"DNS_DELEGATION_PRODUCTION_API_URL": "api.obiente.cloud", "DNS_DELEGATION_API_KEY": "key"},
+			want: []string{"DNS_DELEGATION_PRODUCTION_API_URL must be an http(s) URL"},
+		},
+		{
+			name: "invalid durations",
+			env: map[string]string{
+				"DNS_DELEGATION_PUSH_INTERVAL": "often",
+				"DNS_DELEGATION_TTL":           "500ms",
+				"GAMESERVER_DNS_STALE_GRACE":   "-1m",
+			},
+			want: []string{
+				"DNS_DELEGATION_PUSH_INTERVAL must be a duration",
+				"DNS_DELEGATION_TTL must be at least 1s",
+				"GAMESERVER_DNS_STALE_GRACE must not be negative",
+			},
+		},
+		{
+			name: "conflicting stale grace spellings",
+			env:  map[string]string{"GAMESERVER_DNS_STALE_GRACE": "1m", "GAME_SERVER_DNS_STALE_GRACE": "5m"},
+			want: []string{"conflict; set only one"},
+		},
+		{
+			name: "dnssec key paths set separately",
+			env:  map[string]string{"DNSSEC_KSK_PATH": "/keys/ksk.pem", "DNSSEC_ZSK_ROLLOVER": "soon"},
+			want: []string{"DNSSEC_KSK_PATH and DNSSEC_ZSK_PATH must be set together", "DNSSEC_ZSK_ROLLOVER must be a duration"},
+		},
+		{
+			name: "legacy stale grace spelling",
+			env:  map[string]string{"GAME_SERVER_DNS_STALE_GRACE": "soon"},
+			want: []string{"GAME_SERVER_DNS_STALE_GRACE must be a duration"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setConfigEnv(t, tt.env)
+
+			err := ValidateConfig()
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("ValidateConfig() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidateConfig() = nil, want errors %q", tt.want)
+			}
+			lines := strings.Split(err.Error(), "\n")
+			if len(lines) != len(tt.want) {
+				t.Fatalf("ValidateConfig() reported %d errors, want %d:\n%v", len(lines), len(tt.want), err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("ValidateConfig() error missing %q:\n%v", want, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,436 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	dnsZone = "my.obiente.cloud."
+
+	dnssecKeyTTL            = 3600
+	dnssecSignatureValidity = 7 * 24 * time.Hour
+	dnssecInceptionSkew     = time.Hour // Backdate signatures for resolvers with slow clocks
+	defaultZSKRollover      = 24 * time.Hour
+	dnssecMaxUDPSize        = 1232
+)
+
+// DNSSECSigner signs responses for the zone with ECDSA P-256 keys: the KSK signs the DNSKEY
+// set and the ZSK signs everything else. Retired ZSKs stay in the DNSKEY set until the
+// rollover period ends so cached signatures keep validating.
+type DNSSECSigner struct {
+	zone     string
+	zskPath  string // Rotated ZSKs are written here; empty keeps them in memory only
+	rollover time.Duration
+
+	mu      sync.RWMutex
+	ksk     *dns.DNSKEY
+	kskKey  *ecdsa.PrivateKey
+	zsk     *dns.DNSKEY
+	zskKey  *ecdsa.PrivateKey
+	retired []retiredZSK
+}
+
+type retiredZSK struct {
+	key   *dns.DNSKEY
+	until time.Time
+}
+
+// NewDNSSECSignerFromEnv loads the keys named by DNSSEC_KSK_PATH and DNSSEC_ZSK_PATH.
+// It returns nil without an error when DNSSEC is not configured.
+func NewDNSSECSignerFromEnv() (*DNSSECSigner, error) {
+	kskPath := strings.TrimSpace(os.Getenv("DNSSEC_KSK_PATH"))
+	zskPath := strings.TrimSpace(os.Getenv("DNSSEC_ZSK_PATH"))
+	if kskPath == "" && zskPath == "" {
+		return nil, nil
+	}
+	if kskPath == "" || zskPath == "" {
+		return nil, fmt.Errorf("DNSSEC_KSK_PATH and DNSSEC_ZSK_PATH must be set together")
+	}
+
+	kskKey, err := loadECDSAKey(kskPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load KSK: %w", err)
+	}
+	zskKey, err := loadECDSAKey(zskPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ZSK: %w", err)
+	}
+
+	signer := NewDNSSECSigner(dnsZone, kskKey, zskKey)
+	signer.zskPath = zskPath
+	if value := strings.TrimSpace(os.Getenv("DNSSEC_ZSK_ROLLOVER")); value != "" {
+		if signer.rollover, err = time.ParseDuration(value); err != nil || signer.rollover <= 0 {
+			return nil, fmt.Errorf("DNSSEC_ZSK_ROLLOVER must be a positive duration, got %q", value)
+		}
+	}
+	return signer, nil
+}
+
+// NewDNSSECSigner creates a signer for zone from a KSK and ZSK
+func NewDNSSECSigner(zone string, kskKey, zskKey *ecdsa.PrivateKey) *DNSSECSigner {
+	zone = dns.Fqdn(zone)
+	return &DNSSECSigner{
+		zone:     zone,
+		rollover: defaultZSKRollover,
+		ksk:      newDNSKEY(zone, dns.ZONE|dns.SEP, &kskKey.PublicKey),
+		kskKey:   kskKey,
+		zsk:      newDNSKEY(zone, dns.ZONE, &zskKey.PublicKey),
+		zskKey:   zskKey,
+	}
+}
+
+// loadECDSAKey reads a P-256 private key from a SEC1 ("EC PRIVATE KEY") or PKCS#8 PEM file
+func loadECDSAKey(path string) (*ecdsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s does not contain a PEM block", path)
+	}
+
+	var key *ecdsa.PrivateKey
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		var parsed interface{}
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err == nil {
+			var ok bool
+			if key, ok = parsed.(*ecdsa.PrivateKey); !ok {
+				return nil, fmt.Errorf("%s is not an ECDSA key", path)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%s has unsupported PEM type %q", path, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if key.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("%s is not a P-256 key", path)
+	}
+	return key, nil
+}
+
+// writeECDSAKey writes a private key as SEC1 PEM, replacing the file atomically
+func writeECDSAKey(path string, key *ecdsa.PrivateKey) error {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".zsk-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := pem.Encode(tmp, &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// newDNSKEY builds the DNSKEY record for an ECDSA P-256 public key (RFC 6605)
+func newDNSKEY(zone string, flags uint16, pub *ecdsa.PublicKey) *dns.DNSKEY {
+	point := make([]byte, 64)
+	pub.X.FillBytes(point[:32])
+	pub.Y.FillBytes(point[32:])
+	return &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: zone, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: dnssecKeyTTL},
+		Flags:     flags,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+		PublicKey: base64.StdEncoding.EncodeToString(point),
+	}
+}
+
+// DNSKEYs returns the zone's published keys: the KSK, the active ZSK and ZSKs still in rollover
+func (s *DNSSECSigner) DNSKEYs() []dns.RR {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := []dns.RR{dns.Copy(s.ksk), dns.Copy(s.zsk)}
+	now := time.Now()
+	for _, retired := range s.retired {
+		if now.Before(retired.until) {
+			keys = append(keys, dns.Copy(retired.key))
+		}
+	}
+	return keys
+}
+
+// DS returns the delegation signer record for the KSK, to be published in the parent zone
+func (s *DNSSECSigner) DS() *dns.DS {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.ksk.ToDS(dns.SHA256)
+}
+
+// Sign appends RRSIG records for every RRset in the message that belongs to the zone.
+// Negative answers are turned into NOERROR responses with an NSEC record proving the queried
+// type does not exist at the name ("black lies"), so they validate without a pre-signed chain.
+func (s *DNSSECSigner) Sign(msg *dns.Msg) error {
+	if len(msg.Question) > 0 && len(msg.Answer) == 0 && (msg.Rcode == dns.RcodeSuccess || msg.Rcode == dns.RcodeNameError) {
+		s.addDenialOfExistence(msg, msg.Question[0].Name)
+	}
+
+	var err error
+	if msg.Answer, err = s.signSection(msg.Answer); err != nil {
+		return err
+	}
+	if msg.Ns, err = s.signSection(msg.Ns); err != nil {
+		return err
+	}
+	if msg.Extra, err = s.signSection(msg.Extra); err != nil {
+		return err
+	}
+	return nil
+}
+
+// addDenialOfExistence adds the zone SOA and an NSEC record for name to a negative response
+func (s *DNSSECSigner) addDenialOfExistence(msg *dns.Msg, name string) {
+	name = dns.CanonicalName(name)
+	if !dns.IsSubDomain(s.zone, name) {
+		return
+	}
+
+	types := []uint16{dns.TypeRRSIG, dns.TypeNSEC}
+	if name == s.zone {
+		types = []uint16{dns.TypeSOA, dns.TypeRRSIG, dns.TypeNSEC, dns.TypeDNSKEY}
+	}
+	soa := soaRecord(s.zone)
+	hasSOA := false
+	for _, rr := range msg.Ns {
+		if existing, ok := rr.(*dns.SOA); ok {
+			soa, hasSOA = existing, true
+		}
+	}
+	if !hasSOA {
+		msg.Ns = append(msg.Ns, soa)
+	}
+	msg.Rcode = dns.RcodeSuccess
+	msg.Ns = append(msg.Ns, &dns.NSEC{
+		Hdr:        dns.RR_Header{Name: name, Rrtype: dns.TypeNSEC, Class: dns.ClassINET, Ttl: soa.Minttl},
+		NextDomain: "\\000." + name,
+		TypeBitMap: types,
+	})
+}
+
+// signSection returns the section with an RRSIG after each RRset in the zone
+func (s *DNSSECSigner) signSection(section []dns.RR) ([]dns.RR, error) {
+	type rrsetKey struct {
+		name   string
+		rrtype uint16
+		class  uint16
+	}
+	var order []rrsetKey
+	rrsets := make(map[rrsetKey][]dns.RR)
+	var unsigned []dns.RR
+	for _, rr := range section {
+		h := rr.Header()
+		if h.Rrtype == dns.TypeRRSIG || h.Rrtype == dns.TypeOPT || !dns.IsSubDomain(s.zone, dns.CanonicalName(h.Name)) {
+			unsigned = append(unsigned, rr)
+			continue
+		}
+		key := rrsetKey{dns.CanonicalName(h.Name), h.Rrtype, h.Class}
+		if _, seen := rrsets[key]; !seen {
+			order = append(order, key)
+		}
+		rrsets[key] = append(rrsets[key], rr)
+	}
+
+	signed := make([]dns.RR, 0, len(section)+len(order))
+	for _, key := range order {
+		sig, err := s.signRRset(rrsets[key])
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign %s %s: %w", key.name, dns.TypeToString[key.rrtype], err)
+		}
+		signed = append(signed, rrsets[key]...)
+		signed = append(signed, sig)
+	}
+	return append(signed, unsigned...), nil
+}
+
+// signRRset signs one RRset, with the KSK for the DNSKEY set and the ZSK otherwise
+func (s *DNSSECSigner) signRRset(rrset []dns.RR) (*dns.RRSIG, error) {
+	s.mu.RLock()
+	key, signingKey := s.zsk, s.zskKey
+	if rrset[0].Header().Rrtype == dns.TypeDNSKEY {
+		key, signingKey = s.ksk, s.kskKey
+	}
+	s.mu.RUnlock()
+
+	now := time.Now()
+	sig := &dns.RRSIG{
+		Hdr:        dns.RR_Header{Ttl: rrset[0].Header().Ttl},
+		Algorithm:  dns.ECDSAP256SHA256,
+		KeyTag:     key.KeyTag(),
+		SignerName: s.zone,
+		Inception:  uint32(now.Add(-dnssecInceptionSkew).Unix()),
+		Expiration: uint32(now.Add(dnssecSignatureValidity).Unix()),
+	}
+	if err := sig.Sign(signingKey, rrset); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// RotateZSK switches signing to a newly generated ZSK. The previous key stays in the DNSKEY
+// set for the rollover period so signatures cached by resolvers still validate.
+func (s *DNSSECSigner) RotateZSK() (newKey, oldKey *dns.DNSKEY, err error) {
+	zskKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate ZSK: %w", err)
+	}
+	if s.zskPath != "" {
+		if err := writeECDSAKey(s.zskPath, zskKey); err != nil {
+			return nil, nil, fmt.Errorf("failed to write ZSK to %s: %w", s.zskPath, err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	retired := s.retired[:0]
+	for _, key := range s.retired {
+		if now.Before(key.until) {
+			retired = append(retired, key)
+		}
+	}
+	oldKey = s.zsk
+	s.retired = append(retired, retiredZSK{key: oldKey, until: now.Add(s.rollover)})
+	s.zsk = newDNSKEY(s.zone, dns.ZONE, &zskKey.PublicKey)
+	s.zskKey = zskKey
+	return dns.Copy(s.zsk).(*dns.DNSKEY), dns.Copy(oldKey).(*dns.DNSKEY), nil
+}
+
+// rotateHandler serves POST /dnssec/rotate-zsk, authorized by a bearer token
+func (s *DNSSECSigner) rotateHandler(token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		provided := strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+		if provided == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			http.Error(w, "Invalid token", http.StatusUnauthorized)
+			return
+		}
+
+		newKey, oldKey, err := s.RotateZSK()
+		if err != nil {
+			log.Printf("[DNSSEC] ZSK rotation failed: %v", err)
+			http.Error(w, "ZSK rotation failed", http.StatusInternalServerError)
+			return
+		}
+		log.Printf("[DNSSEC] Rotated ZSK %d -> %d; the old key stays published for %s", oldKey.KeyTag(), newKey.KeyTag(), s.rollover)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"key_tag":          newKey.KeyTag(),
+			"previous_key_tag": oldKey.KeyTag(),
+			"rollover_until":   time.Now().Add(s.rollover).UTC().Format(time.RFC3339),
+		})
+	}
+}
+
+// wantsDNSSEC reports whether the query set the DNSSEC OK bit
+func wantsDNSSEC(r *dns.Msg) bool {
+	opt := r.IsEdns0()
+	return opt != nil && opt.Do()
+}
+
+// dnssecUDPSize is the UDP payload size for a signed reply: the client's EDNS0 size, capped to
+// avoid IP fragmentation
+func dnssecUDPSize(r *dns.Msg) uint16 {
+	size := r.IsEdns0().UDPSize()
+	if size < dns.MinMsgSize {
+		size = dns.MinMsgSize
+	}
+	if size > dnssecMaxUDPSize {
+		size = dnssecMaxUDPSize
+	}
+	return size
+}
+
+// signingResponseWriter signs replies before writing them to the client
+type signingResponseWriter struct {
+	dns.ResponseWriter
+	signer  *DNSSECSigner
+	udpSize uint16
+}
+
+func (w *signingResponseWriter) WriteMsg(msg *dns.Msg) error {
+	if err := w.signer.Sign(msg); err != nil {
+		log.Printf("[DNSSEC] %v", err)
+		msg.Rcode = dns.RcodeServerFailure
+	}
+	msg.SetEdns0(w.udpSize, true)
+	if _, isUDP := w.RemoteAddr().(*net.UDPAddr); isUDP {
+		msg.Truncate(int(w.udpSize))
+	}
+	return w.ResponseWriter.WriteMsg(msg)
+}
+
+// soaRecord is the zone's start of authority
+func soaRecord(zone string) *dns.SOA {
+	return &dns.SOA{
+		Hdr:     dns.RR_Header{Name: zone, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: dnssecKeyTTL},
+		Ns:      "ns1." + zone,
+		Mbox:    "hostmaster." + zone,
+		Serial:  uint32(time.Now().Unix() / 60),
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  604800,
+		Minttl:  60,
+	}
+}
+
+// handleApexQuery answers queries for the zone apex: SOA (with the KSK's DS record in the
+// additional section when DNSSEC is enabled) and DNSKEY. Other types get an empty answer.
+func (s *DNSServer) handleApexQuery(msg *dns.Msg, q dns.Question) {
+	switch q.Qtype {
+	case dns.TypeSOA:
+		msg.Answer = append(msg.Answer, soaRecord(dnsZone))
+		if s.dnssec != nil {
+			ds := s.dnssec.DS()
+			ds.Hdr.Ttl = dnssecKeyTTL
+			msg.Extra = append(msg.Extra, ds)
+		}
+	case dns.TypeDNSKEY:
+		if s.dnssec != nil {
+			msg.Answer = append(msg.Answer, s.dnssec.DNSKEYs()...)
+		}
+	}
+	if len(msg.Answer) == 0 {
+		msg.Ns = append(msg.Ns, soaRecord(dnsZone))
+	}
+}
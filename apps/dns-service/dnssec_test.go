@@ -0,0 +1,266 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+func newTestSigner(t *testing.T) *DNSSECSigner {
+	t.Helper()
+	ksk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate KSK: %v", err)
+	}
+	zsk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ZSK: %v", err)
+	}
+	return NewDNSSECSigner(dnsZone, ksk, zsk)
+}
+
+// udpRecordingWriter is a recordingWriter for a client connected over UDP
+type udpRecordingWriter struct {
+	recordingWriter
+}
+
+func (w *udpRecordingWriter) RemoteAddr() net.Addr {
+	return &net.UDPAddr{IP: net.ParseIP("192.0.2.10"), Port: 53000}
+}
+
+// verifySection checks every RRSIG in a section against the RRset it covers and returns the
+// number of RRSIGs
+func verifySection(t *testing.T, section []dns.RR, keys []dns.RR) int {
+	t.Helper()
+	signatures := 0
+	for _, rr := range section {
+		sig, ok := rr.(*dns.RRSIG)
+		if !ok {
+			continue
+		}
+		signatures++
+
+		var rrset []dns.RR
+		for _, candidate := range section {
+			h := candidate.Header()
+			if h.Rrtype == sig.TypeCovered && dns.CanonicalName(h.Name) == dns.CanonicalName(sig.Hdr.Name) {
+				rrset = append(rrset, candidate)
+			}
+		}
+		var key *dns.DNSKEY
+		for _, k := range keys {
+			if dnskey := k.(*dns.DNSKEY); dnskey.KeyTag() == sig.KeyTag {
+				key = dnskey
+			}
+		}
+		if key == nil {
+			t.Fatalf("no DNSKEY with tag %d for RRSIG %v", sig.KeyTag, sig)
+		}
+		if err := sig.Verify(key, rrset); err != nil {
+			t.Fatalf("RRSIG over %s %s does not verify: %v", sig.Hdr.Name, dns.TypeToString[sig.TypeCovered], err)
+		}
+		if !sig.ValidityPeriod(time.Now()) {
+			t.Fatalf("RRSIG %v is not currently valid", sig)
+		}
+	}
+	return signatures
+}
+
+func TestDNSSECSignerSignsAnswersWithZSK(t *testing.T) {
+	signer := newTestSigner(t)
+
+	msg := new(dns.Msg)
+	msg.SetQuestion("deploy-1.my.obiente.cloud.", dns.TypeA)
+	msg.Answer = []dns.RR{
+		&dns.A{Hdr: dns.RR_Header{Name: "deploy-1.my.obiente.cloud.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 300}, A: net.ParseIP("10.0.0.1")},
+		&dns.A{Hdr: dns.RR_Header{Name: "deploy-1.my.obiente.cloud.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 300}, A: net.ParseIP("10.0.0.2")},
+	}
+	if err := signer.Sign(msg); err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	if got := verifySection(t, msg.Answer, signer.DNSKEYs()); got != 1 {
+		t.Fatalf("answer has %d RRSIGs, want 1 covering the A RRset", got)
+	}
+	sig := msg.Answer[2].(*dns.RRSIG)
+	if sig.KeyTag != signer.zsk.KeyTag() || sig.Hdr.Ttl != 300 || sig.SignerName != dnsZone {
+		t.Fatalf("RRSIG = %v, want a ZSK signature by %s with the RRset TTL", sig, dnsZone)
+	}
+
+	// Tampering with the data must break the signature
+	msg.Answer[0].(*dns.A).A = net.ParseIP("10.0.0.9")
+	zsk := signer.zsk
+	if err := sig.Verify(zsk, msg.Answer[:2]); err == nil {
+		t.Fatal("signature verified over modified records")
+	}
+}
+
+func TestDNSSECApexServesSignedDNSKEYAndDS(t *testing.T) {
+	signer := newTestSigner(t)
+	server := &DNSServer{dnssec: signer}
+
+	query := new(dns.Msg)
+	query.SetQuestion(dnsZone, dns.TypeDNSKEY)
+	query.SetEdns0(4096, true)
+	w := &udpRecordingWriter{}
+	server.handleDNSRequest(w, query)
+	if w.reply == nil || len(w.reply.Answer) != 3 {
+		t.Fatalf("DNSKEY reply = %v, want the KSK, ZSK and an RRSIG", w.reply)
+	}
+	if got := verifySection(t, w.reply.Answer, signer.DNSKEYs()); got != 1 {
+		t.Fatalf("DNSKEY answer has %d RRSIGs, want 1", got)
+	}
+	if sig := w.reply.Answer[2].(*dns.RRSIG); sig.KeyTag != signer.ksk.KeyTag() {
+		t.Fatalf("DNSKEY set signed by key %d, want the KSK %d", sig.KeyTag, signer.ksk.KeyTag())
+	}
+	if opt := w.reply.IsEdns0(); opt == nil || !opt.Do() {
+		t.Fatal("signed reply does not set the DO bit")
+	}
+
+	query.SetQuestion(dnsZone, dns.TypeSOA)
+	w = &udpRecordingWriter{}
+	server.handleDNSRequest(w, query)
+	var ds *dns.DS
+	for _, rr := range w.reply.Extra {
+		if record, ok := rr.(*dns.DS); ok {
+			ds = record
+		}
+	}
+	if ds == nil || ds.KeyTag != signer.ksk.KeyTag() || ds.DigestType != dns.SHA256 || ds.Digest != signer.ksk.ToDS(dns.SHA256).Digest {
+		t.Fatalf("SOA reply extra = %v, want the KSK's SHA-256 DS record", w.reply.Extra)
+	}
+	verifySection(t, w.reply.Answer, signer.DNSKEYs())
+}
+
+func TestDNSSECUnsignedWithoutDOBit(t *testing.T) {
+	server := &DNSServer{dnssec: newTestSigner(t)}
+
+	query := new(dns.Msg)
+	query.SetQuestion(dnsZone, dns.TypeDNSKEY)
+	w := &udpRecordingWriter{}
+	server.handleDNSRequest(w, query)
+	for _, rr := range w.reply.Answer {
+		if _, ok := rr.(*dns.RRSIG); ok {
+			t.Fatal("reply to a query without the DO bit contains RRSIGs")
+		}
+	}
+}
+
+func TestDNSSECSignsDenialOfExistence(t *testing.T) {
+	signer := newTestSigner(t)
+
+	msg := new(dns.Msg)
+	msg.SetQuestion("deploy-missing.my.obiente.cloud.", dns.TypeA)
+	msg.Rcode = dns.RcodeNameError
+	if err := signer.Sign(msg); err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if msg.Rcode != dns.RcodeSuccess {
+		t.Fatalf("rcode = %s, want NOERROR with an NSEC proof", dns.RcodeToString[msg.Rcode])
+	}
+
+	var nsec *dns.NSEC
+	for _, rr := range msg.Ns {
+		if record, ok := rr.(*dns.NSEC); ok {
+			nsec = record
+		}
+	}
+	if nsec == nil || nsec.Hdr.Name != "deploy-missing.my.obiente.cloud." {
+		t.Fatalf("authority = %v, want an NSEC record for the queried name", msg.Ns)
+	}
+	for _, rrtype := range nsec.TypeBitMap {
+		if rrtype == dns.TypeA {
+			t.Fatal("NSEC claims the queried type exists")
+		}
+	}
+	if got := verifySection(t, msg.Ns, signer.DNSKEYs()); got != 2 {
+		t.Fatalf("authority has %d RRSIGs, want 2 covering the SOA and NSEC", got)
+	}
+}
+
+func TestDNSSECZSKRotationKeepsOldKeyPublished(t *testing.T) {
+	dir := t.TempDir()
+	kskPath, zskPath := filepath.Join(dir, "ksk.pem"), filepath.Join(dir, "zsk.pem")
+	for _, path := range []string{kskPath, zskPath} {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("generate key: %v", err)
+		}
+		if err := writeECDSAKey(path, key); err != nil {
+			t.Fatalf("write key: %v", err)
+		}
+	}
+	t.Setenv("DNSSEC_KSK_PATH", kskPath)
+	t.Setenv("DNSSEC_ZSK_PATH", zskPath)
+	t.Setenv("DNSSEC_ZSK_ROLLOVER", "1h")
+	signer, err := NewDNSSECSignerFromEnv()
+	if err != nil || signer == nil {
+		t.Fatalf("NewDNSSECSignerFromEnv = %v, %v", signer, err)
+	}
+	oldTag := signer.zsk.KeyTag()
+
+	handler := signer.rotateHandler("rotate-secret")
+	req := httptest.NewRequest(http.MethodPost, "/dnssec/rotate-zsk", nil)
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("rotation without a token: status %d, want 401", rec.Code)
+	}
+
+	req.Header.Set("Authorization", "Bearer rotate-secret")
+	rec = httptest.NewRecorder()
+	handler(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("rotation: status %d (%s)", rec.Code, rec.Body.String())
+	}
+	var resp struct {
+		KeyTag         uint16 `json:"key_tag"`
+		PreviousKeyTag uint16 `json:"previous_key_tag"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil || resp.PreviousKeyTag != oldTag || resp.KeyTag == oldTag {
+		t.Fatalf("rotation response = %s (%v), want a new key replacing %d", rec.Body.String(), err, oldTag)
+	}
+
+	keys := signer.DNSKEYs()
+	tags := make(map[uint16]bool)
+	for _, key := range keys {
+		tags[key.(*dns.DNSKEY).KeyTag()] = true
+	}
+	if len(keys) != 3 || !tags[oldTag] || !tags[resp.KeyTag] {
+		t.Fatalf("DNSKEY set = %v, want the KSK plus the old and new ZSKs during rollover", keys)
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion("gs-1.my.obiente.cloud.", dns.TypeA)
+	msg.Answer = []dns.RR{&dns.A{Hdr: dns.RR_Header{Name: "gs-1.my.obiente.cloud.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.ParseIP("10.0.0.3")}}
+	if err := signer.Sign(msg); err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if sig := msg.Answer[1].(*dns.RRSIG); sig.KeyTag != resp.KeyTag {
+		t.Fatalf("signed with key %d after rotation, want the new ZSK %d", sig.KeyTag, resp.KeyTag)
+	}
+	verifySection(t, msg.Answer, keys)
+
+	// The rotated key was persisted, so a restart keeps signing with it
+	reloaded, err := loadECDSAKey(zskPath)
+	if err != nil || !reloaded.Equal(signer.zskKey) {
+		t.Fatalf("ZSK file after rotation does not hold the new key (%v)", err)
+	}
+
+	// Once the rollover period is over the old key is withdrawn
+	signer.mu.Lock()
+	signer.retired[0].until = time.Now().Add(-time.Second)
+	signer.mu.Unlock()
+	if keys := signer.DNSKEYs(); len(keys) != 2 {
+		t.Fatalf("DNSKEY set after rollover = %v, want the KSK and new ZSK", keys)
+	}
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	dohPath            = "/dns-query"
+	dohContentType     = "application/dns-message"
+	dohRateLimit       = 100 // requests per window per client IP
+	dohRateLimitWindow = time.Second
+)
+
+// dohNow is the clock used by the DoH rate limiter (replaced in tests)
+var dohNow = time.Now
+
+// dohRateLimiter counts DoH requests per client; *database.RedisCache implements it
+type dohRateLimiter interface {
+	Get(ctx context.Context, key string) (string, error)
+	Increment(ctx context.Context, key string) (int64, error)
+	Expire(ctx context.Context, key string, expiration time.Duration) error
+}
+
+// dohHandler serves DNS-over-HTTPS queries (RFC 8484) with the same logic as the UDP/TCP servers
+type dohHandler struct {
+	server  *DNSServer
+	limiter dohRateLimiter // nil disables rate limiting
+}
+
+func newDoHHandler(server *DNSServer, limiter dohRateLimiter) *dohHandler {
+	return &dohHandler{server: server, limiter: limiter}
+}
+
+func (h *dohHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var wire []byte
+	switch r.Method {
+	case http.MethodGet:
+		// GET carries the message base64url-encoded without padding in the "dns" parameter
+		encoded := r.URL.Query().Get("dns")
+		if encoded == "" {
+			http.Error(w, "missing dns query parameter", http.StatusBadRequest)
+			return
+		}
+		decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+		if err != nil {
+			http.Error(w, "dns query parameter is not base64url", http.StatusBadRequest)
+			return
+		}
+		wire = decoded
+	case http.MethodPost:
+		if mediaType := strings.TrimSpace(strings.Split(r.Header.Get("Content-Type"), ";")[0]); mediaType != dohContentType {
+			http.Error(w, "Content-Type must be "+dohContentType, http.StatusUnsupportedMediaType)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, dns.MaxMsgSize+1))
+		if err != nil {
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		if len(body) > dns.MaxMsgSize {
+			http.Error(w, "DNS message too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		wire = body
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientIP := dohClientIP(r)
+	allowed, err := h.allow(r.Context(), clientIP)
+	if err != nil {
+		log.Printf("[DoH] Rate limit check failed for %s, allowing query: %v", clientIP, err)
+	}
+	if !allowed {
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, fmt.Sprintf("rate limit of %d queries per second exceeded", dohRateLimit), http.StatusTooManyRequests)
+		return
+	}
+
+	req := new(dns.Msg)
+	if err := req.Unpack(wire); err != nil {
+		http.Error(w, "malformed DNS message", http.StatusBadRequest)
+		return
+	}
+	if len(req.Question) == 0 {
+		http.Error(w, "DNS message has no question", http.StatusBadRequest)
+		return
+	}
+
+	recorder := &dohResponseWriter{remote: dohRemoteAddr(clientIP)}
+	h.server.handleDNSRequest(recorder, req)
+	if recorder.reply == nil {
+		http.Error(w, "no DNS response", http.StatusInternalServerError)
+		return
+	}
+
+	packed, err := recorder.reply.Pack()
+	if err != nil {
+		log.Printf("[DoH] Failed to pack response for %s: %v", req.Question[0].Name, err)
+		http.Error(w, "failed to encode DNS response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", dohContentType)
+	if ttl, ok := minAnswerTTL(recorder.reply); ok {
+		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", ttl))
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(packed)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(packed)
+}
+
+// allow counts a query against the client's limit using a sliding window over two fixed windows
+// in Redis, so the limit holds across replicas. Without Redis every query is allowed.
+func (h *dohHandler) allow(ctx context.Context, clientIP string) (bool, error) {
+	if h.limiter == nil {
+		return true, nil
+	}
+
+	now := dohNow().UnixNano()
+	window := now / int64(dohRateLimitWindow)
+	elapsed := float64(now%int64(dohRateLimitWindow)) / float64(dohRateLimitWindow)
+
+	key := fmt.Sprintf("dns:doh:ratelimit:%s:%d", clientIP, window)
+	count, err := h.limiter.Increment(ctx, key)
+	if err != nil {
+		return true, err
+	}
+	if count == 1 {
+		// Keep the counter through the next window, which weighs it into its estimate
+		if err := h.limiter.Expire(ctx, key, 2*dohRateLimitWindow); err != nil {
+			return true, err
+		}
+	}
+
+	var previous int64
+	if raw, err := h.limiter.Get(ctx, fmt.Sprintf("dns:doh:ratelimit:%s:%d", clientIP, window-1)); err == nil {
+		previous, _ = strconv.ParseInt(raw, 10, 64)
+	}
+
+	estimated := float64(previous)*(1-elapsed) + float64(count)
+	return estimated <= dohRateLimit, nil
+}
+
+// dohClientIP returns the address rate limits apply to. Behind the reverse proxy the peer is a
+// private address, so the address the proxy appended to X-Forwarded-For is used instead.
+func dohClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	peer := net.ParseIP(host)
+	if peer == nil || !(peer.IsPrivate() || peer.IsLoopback()) {
+		return host
+	}
+
+	forwarded := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	if last := strings.TrimSpace(forwarded[len(forwarded)-1]); net.ParseIP(last) != nil {
+		return last
+	}
+	return host
+}
+
+func dohRemoteAddr(clientIP string) net.Addr {
+	return &net.TCPAddr{IP: net.ParseIP(clientIP)}
+}
+
+// minAnswerTTL returns the lowest TTL in the answer section, used as the HTTP cache lifetime
+func minAnswerTTL(msg *dns.Msg) (uint32, bool) {
+	if len(msg.Answer) == 0 {
+		return 0, false
+	}
+	ttl := msg.Answer[0].Header().Ttl
+	for _, rr := range msg.Answer[1:] {
+		if rr.Header().Ttl < ttl {
+			ttl = rr.Header().Ttl
+		}
+	}
+	return ttl, true
+}
+
+// dohResponseWriter captures the reply handleDNSRequest writes so it can be sent over HTTP
+type dohResponseWriter struct {
+	remote net.Addr
+	reply  *dns.Msg
+}
+
+func (w *dohResponseWriter) LocalAddr() net.Addr {
+	return &net.TCPAddr{IP: net.IPv4zero}
+}
+
+func (w *dohResponseWriter) RemoteAddr() net.Addr {
+	return w.remote
+}
+
+func (w *dohResponseWriter) WriteMsg(msg *dns.Msg) error {
+	w.reply = msg
+	return nil
+}
+
+func (w *dohResponseWriter) Write(b []byte) (int, error) {
+	msg := new(dns.Msg)
+	if err := msg.Unpack(b); err != nil {
+		return 0, err
+	}
+	w.reply = msg
+	return len(b), nil
+}
+
+func (w *dohResponseWriter) Close() error        { return nil }
+func (w *dohResponseWriter) TsigStatus() error   { return nil }
+func (w *dohResponseWriter) TsigTimersOnly(bool) {}
+func (w *dohResponseWriter) Hijack()             {}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// memoryCounter is an in-memory dohRateLimiter
+type memoryCounter struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+func (c *memoryCounter) Get(ctx context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return strconv.FormatInt(c.counts[key], 10), nil
+}
+
+func (c *memoryCounter) Increment(ctx context.Context, key string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[key]++
+	return c.counts[key], nil
+}
+
+func (c *memoryCounter) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return nil
+}
+
+func newDoHTestServer(t *testing.T, limiter dohRateLimiter) *httptest.Server {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file:doh?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(&database.DeploymentLocation{}, &database.DelegatedDNSRecord{}, &database.GameServerLocation{}); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+	if err := db.Exec("CREATE TABLE IF NOT EXISTS game_servers (id TEXT PRIMARY KEY, game_type INTEGER)").Error; err != nil {
+		t.Fatalf("create game_servers: %v", err)
+	}
+	if err := db.Exec("INSERT OR REPLACE INTO game_servers (id, game_type) VALUES ('gs-42', 2)").Error; err != nil {
+		t.Fatalf("seed game server: %v", err)
+	}
+	location := &database.GameServerLocation{ID: "loc-42", GameServerID: "gs-42", NodeID: "node-1", NodeIP: "203.0.113.7", ContainerID: "container-42", Status: "running", Port: 25565}
+	if err := db.Save(location).Error; err != nil {
+		t.Fatalf("seed game server location: %v", err)
+	}
+	previousDB := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = previousDB
+	})
+
+	cache := memoryCache{}
+	if err := cache.Set(context.Background(), "dns:deployment:deploy-doh", []string{"198.51.100.4"}, cacheTTL); err != nil {
+		t.Fatalf("seed cache: %v", err)
+	}
+	server := &DNSServer{db: db, nodeIPMap: map[string][]string{}, redisCache: cache}
+
+	mux := http.NewServeMux()
+	mux.Handle(dohPath, newDoHHandler(server, limiter))
+	httpServer := httptest.NewServer(mux)
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+// queryDoH sends a query over GET or POST and decodes the DNS response
+func queryDoH(t *testing.T, baseURL, method, name string, qtype uint16) *dns.Msg {
+	t.Helper()
+	query := new(dns.Msg)
+	query.SetQuestion(name, qtype)
+	query.Id = 0 // RFC 8484 recommends ID 0 so responses are cacheable
+	wire, err := query.Pack()
+	if err != nil {
+		t.Fatalf("pack query: %v", err)
+	}
+
+	var resp *http.Response
+	if method == http.MethodGet {
+		resp, err = http.Get(baseURL + dohPath + "?dns=" + base64.RawURLEncoding.EncodeToString(wire))
+	} else {
+		resp, err = http.Post(baseURL+dohPath, dohContentType, bytes.NewReader(wire))
+	}
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, name, err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("%s %s: status %d (%s)", method, name, resp.StatusCode, body)
+	}
+	if got := resp.Header.Get("Content-Type"); got != dohContentType {
+		t.Fatalf("%s %s: Content-Type = %q, want %q", method, name, got, dohContentType)
+	}
+	reply := new(dns.Msg)
+	if err := reply.Unpack(body); err != nil {
+		t.Fatalf("%s %s: unpack response: %v", method, name, err)
+	}
+	return reply
+}
+
+func TestDoHAnswersAQueries(t *testing.T) {
+	httpServer := newDoHTestServer(t, nil)
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		reply := queryDoH(t, httpServer.URL, method, "deploy-doh.my.obiente.cloud.", dns.TypeA)
+		if reply.Rcode != dns.RcodeSuccess || len(reply.Answer) != 1 {
+			t.Fatalf("%s: reply = %v, want one A answer", method, reply)
+		}
+		a, ok := reply.Answer[0].(*dns.A)
+		if !ok || a.A.String() != "198.51.100.4" {
+			t.Fatalf("%s: answer = %v, want 198.51.100.4", method, reply.Answer[0])
+		}
+	}
+
+	reply := queryDoH(t, httpServer.URL, http.MethodPost, "example.com.", dns.TypeA)
+	if reply.Rcode != dns.RcodeNameError {
+		t.Fatalf("out-of-zone rcode = %s, want NXDOMAIN", dns.RcodeToString[reply.Rcode])
+	}
+}
+
+func TestDoHAnswersSRVQueries(t *testing.T) {
+	httpServer := newDoHTestServer(t, nil)
+
+	reply := queryDoH(t, httpServer.URL, http.MethodPost, "_minecraft._tcp.gs-42.my.obiente.cloud.", dns.TypeSRV)
+	if len(reply.Answer) != 1 || len(reply.Extra) != 1 {
+		t.Fatalf("reply = %v, want one SRV answer with its A record", reply)
+	}
+	srv, ok := reply.Answer[0].(*dns.SRV)
+	if !ok || srv.Port != 25565 || srv.Target != "gs-42.my.obiente.cloud." {
+		t.Fatalf("SRV answer = %v, want port 25565 targeting gs-42.my.obiente.cloud.", reply.Answer[0])
+	}
+	if a, ok := reply.Extra[0].(*dns.A); !ok || a.A.String() != "203.0.113.7" {
+		t.Fatalf("additional record = %v, want 203.0.113.7", reply.Extra[0])
+	}
+}
+
+func TestDoHRejectsBadRequests(t *testing.T) {
+	httpServer := newDoHTestServer(t, nil)
+
+	tests := []struct {
+		name        string
+		method      string
+		query       string
+		contentType string
+		body        []byte
+		want        int
+	}{
+		{"missing parameter", http.MethodGet, "", "", nil, http.StatusBadRequest},
+		{"invalid base64", http.MethodGet, "?dns=***", "", nil, http.StatusBadRequest},
+		{"wrong content type", http.MethodPost, "", "application/json", []byte("{}"), http.StatusUnsupportedMediaType},
+		{"malformed message", http.MethodPost, "", dohContentType, []byte{0x01}, http.StatusBadRequest},
+		{"wrong method", http.MethodPut, "", dohContentType, nil, http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, httpServer.URL+dohPath+tt.query, bytes.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("request: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.want {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoHRateLimitSlidingWindow(t *testing.T) {
+	counter := &memoryCounter{counts: make(map[string]int64)}
+	handler := newDoHHandler(nil, counter)
+
+	start := time.Unix(1700000000, 0)
+	now := start
+	dohNow = func() time.Time { return now }
+	t.Cleanup(func() { dohNow = time.Now })
+
+	ctx := context.Background()
+	for i := 0; i < dohRateLimit; i++ {
+		if allowed, _ := handler.allow(ctx, "192.0.2.1"); !allowed {
+			t.Fatalf("query %d was rate limited, want %d allowed per window", i+1, dohRateLimit)
+		}
+	}
+	if allowed, _ := handler.allow(ctx, "192.0.2.1"); allowed {
+		t.Fatal("query over the limit was allowed")
+	}
+	if allowed, _ := handler.allow(ctx, "192.0.2.2"); !allowed {
+		t.Fatal("another client was rate limited")
+	}
+
+	// Halfway through the next window half of the previous window still counts
+	now = start.Add(dohRateLimitWindow + dohRateLimitWindow/2)
+	allowed := 0
+	for i := 0; i < dohRateLimit; i++ {
+		if ok, _ := handler.allow(ctx, "192.0.2.1"); ok {
+			allowed++
+		}
+	}
+	if allowed < dohRateLimit/2-1 || allowed > dohRateLimit/2+1 {
+		t.Fatalf("allowed %d queries halfway into the next window, want about %d", allowed, dohRateLimit/2)
+	}
+}
+
+func TestDoHClientIP(t *testing.T) {
+	tests := []struct {
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{"198.51.100.9:5000", "", "198.51.100.9"},
+		{"198.51.100.9:5000", "192.0.2.1", "198.51.100.9"},
+		{"10.0.0.5:5000", "203.0.113.1, 192.0.2.1", "192.0.2.1"},
+		{"10.0.0.5:5000", "not-an-ip", "10.0.0.5"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, dohPath, nil)
+		req.RemoteAddr = tt.remoteAddr
+		if tt.forwarded != "" {
+			req.Header.Set("X-Forwarded-For", tt.forwarded)
+		}
+		if got := dohClientIP(req); got != tt.want {
+			t.Errorf("dohClientIP(%s, %q) = %s, want %s", tt.remoteAddr, tt.forwarded, got, tt.want)
+		}
+	}
+}
@@ -31,11 +31,19 @@ const (
 	serviceShutdownTimeout    = 30 * time.Second
 )
 
+// dnsCache is the subset of database.RedisCache used to cache deployment IPs
+type dnsCache interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+}
+
 type DNSServer struct {
 	db                       *gorm.DB
 	nodeIPMap                map[string][]string
-	redisCache               *database.RedisCache
+	redisCache               dnsCache
 	gameServerStaleGraceTime time.Duration
+	dnssec                   *DNSSECSigner // nil when DNSSEC is not configured
+	queryLogs                *queryLogger  // nil when query logging is disabled
 }
 
 func NewDNSServer() (*DNSServer, error) {
@@ -117,7 +125,9 @@ func NewDNSServer() (*DNSServer, error) {
 	} else {
 		log.Printf("[DNS] Redis cache initialized")
 	}
-	s.redisCache = database.RedisClient
+	if database.RedisClient != nil {
+		s.redisCache = database.RedisClient
+	}
 
 	// Configure grace period for stale game server DNS answers.
 	// This prevents immediate NXDOMAIN after stop/restart, reducing client disruption.
@@ -137,10 +147,33 @@ func NewDNSServer() (*DNSServer, error) {
 	}
 	log.Printf("[DNS] Game server DNS stale grace configured to %s", s.gameServerStaleGraceTime)
 
+	// Query logs are written to the metrics database
+	if queryLogEnabled() {
+		if err := database.InitMetricsDatabase(); err != nil {
+			log.Printf("[DNS] Warning: metrics database initialization failed: %v (query logging disabled)", err)
+		} else {
+			s.queryLogs = newQueryLogger()
+		}
+	}
+
+	s.dnssec, err = NewDNSSECSignerFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize DNSSEC: %w", err)
+	}
+	if s.dnssec != nil {
+		log.Printf("[DNSSEC] Signing %s; publish this DS record in the parent zone: %s", dnsZone, s.dnssec.DS().String())
+	}
+
 	return s, nil
 }
 
 func (s *DNSServer) getCached(ctx context.Context, deploymentID string) ([]string, bool) {
+	ips, ok := s.lookupCache(ctx, deploymentID)
+	metrics.RecordDNSCacheLookup(ok)
+	return ips, ok
+}
+
+func (s *DNSServer) lookupCache(ctx context.Context, deploymentID string) ([]string, bool) {
 	if s.redisCache == nil {
 		return nil, false
 	}
@@ -185,17 +218,43 @@ func (s *DNSServer) getGameServerAnswerTTL(isStale bool, staleRemaining time.Dur
 }
 
 func (s *DNSServer) handleDNSRequest(w dns.ResponseWriter, r *dns.Msg) {
+	start := time.Now()
+	defer func() {
+		metrics.ObserveDNSQueryDuration(time.Since(start))
+	}()
+
+	if s.dnssec != nil && wantsDNSSEC(r) {
+		w = &signingResponseWriter{ResponseWriter: w, signer: s.dnssec, udpSize: dnssecUDPSize(r)}
+	}
+
 	msg := new(dns.Msg)
 	msg.SetReply(r)
 	msg.Authoritative = true
 
 	ctx := context.Background()
+	clientIP := remoteIP(w)
+
+	if s.queryLogs != nil {
+		defer func() {
+			for _, q := range r.Question {
+				s.queryLog(ctx, q.Name, dns.TypeToString[q.Qtype], clientIP, dns.RcodeToString[msg.Rcode], time.Since(start))
+			}
+		}()
+	}
 
 	for _, q := range r.Question {
 		domain := strings.ToLower(q.Name)
 		// Normalize domain - remove trailing dot if present for comparison
 		domainNormalized := strings.TrimSuffix(domain, ".")
-		if !strings.HasSuffix(domainNormalized, ".my.obiente.cloud") {
+		isApex := domainNormalized == "my.obiente.cloud"
+		inZone := isApex || strings.HasSuffix(domainNormalized, ".my.obiente.cloud")
+		zone := "my.obiente.cloud"
+		if !inZone {
+			zone = "other"
+		}
+		metrics.RecordDNSQuery(dns.TypeToString[q.Qtype], zone)
+
+		if !inZone {
 			// Not our domain, return NXDOMAIN
 			log.Printf("[DNS] Query for non-my.obiente.cloud domain: %s", domain)
 			msg.SetRcode(r, dns.RcodeNameError)
@@ -203,10 +262,19 @@ func (s *DNSServer) handleDNSRequest(w dns.ResponseWriter, r *dns.Msg) {
 			return
 		}
 
+		// SOA and DNSKEY live at the zone apex
+		if isApex {
+			s.handleApexQuery(msg, q)
+			w.WriteMsg(msg)
+			return
+		}
+
 		// Handle SRV record queries for game servers
 		// Format: _minecraft._tcp.gs-123.my.obiente.cloud
 		// Format: _minecraft._udp.gs-123.my.obiente.cloud (Bedrock)
 		// Format: _rust._udp.gs-123.my.obiente.cloud
+		// Format: _valheim._udp.gs-123.my.obiente.cloud
+		// Format: _cs2._udp.gs-123.my.obiente.cloud
 		if q.Qtype == dns.TypeSRV {
 			if s.handleSRVQuery(msg, domain, q) {
 				w.WriteMsg(msg)
@@ -220,7 +288,7 @@ func (s *DNSServer) handleDNSRequest(w dns.ResponseWriter, r *dns.Msg) {
 		// Format: db-123.my.obiente.cloud (databases)
 		// Format: gs-123.my.obiente.cloud (game servers)
 		if q.Qtype == dns.TypeA {
-			if s.handleAQuery(ctx, msg, domain, q) {
+			if s.handleAQuery(ctx, msg, domain, q, clientIP) {
 				w.WriteMsg(msg)
 				return
 			}
@@ -235,11 +303,20 @@ func (s *DNSServer) handleDNSRequest(w dns.ResponseWriter, r *dns.Msg) {
 	w.WriteMsg(msg)
 }
 
+// lookupDelegatedRecord fetches a delegated DNS record and records whether one was found
+func lookupDelegatedRecord(domain, recordType string) (*database.DelegatedDNSRecord, error) {
+	record, err := database.GetDelegatedDNSRecord(domain, recordType)
+	metrics.RecordDNSDelegationLookup(recordType, err == nil && record != nil)
+	return record, err
+}
+
 // handleSRVQuery handles SRV record queries for game servers
 // Supports:
 // - Minecraft Java: _minecraft._tcp.gs-123.my.obiente.cloud
 // - Minecraft Bedrock: _minecraft._udp.gs-123.my.obiente.cloud
 // - Rust: _rust._udp.gs-123.my.obiente.cloud
+// - Valheim: _valheim._udp.gs-123.my.obiente.cloud
+// - Counter-Strike 2: _cs2._udp.gs-123.my.obiente.cloud
 func (s *DNSServer) handleSRVQuery(msg *dns.Msg, domain string, q dns.Question) bool {
 	// Parse SRV query format: _service._protocol.gs-123.my.obiente.cloud.
 	// Normalize domain - remove trailing dot if present
@@ -285,7 +362,7 @@ func (s *DNSServer) handleSRVQuery(msg *dns.Msg, domain string, q dns.Question)
 
 	// Validate SRV service/protocol matches game type
 	// GameType enum values:
-	// MINECRAFT = 1, MINECRAFT_JAVA = 2, MINECRAFT_BEDROCK = 3, RUST = 6
+	// MINECRAFT = 1, MINECRAFT_JAVA = 2, MINECRAFT_BEDROCK = 3, VALHEIM = 4, RUST = 6, CS2 = 7
 	isValid := false
 	if service == "_minecraft" {
 		if protocol == "_tcp" && (gameType == 1 || gameType == 2) {
@@ -298,6 +375,12 @@ func (s *DNSServer) handleSRVQuery(msg *dns.Msg, domain string, q dns.Question)
 	} else if service == "_rust" && protocol == "_udp" && gameType == 6 {
 		// Rust uses UDP
 		isValid = true
+	} else if service == "_valheim" && protocol == "_udp" && gameType == 4 {
+		// Valheim uses UDP
+		isValid = true
+	} else if service == "_cs2" && protocol == "_udp" && gameType == 7 {
+		// Counter-Strike 2 uses UDP
+		isValid = true
 	}
 
 	if !isValid {
@@ -324,7 +407,7 @@ func (s *DNSServer) handleSRVQuery(msg *dns.Msg, domain string, q dns.Question)
 			Priority: 0,
 			Weight:   0,
 			Port:     uint16(port),
-			Target:   targetHostname,
+			Target:   dns.Fqdn(targetHostname),
 		}
 		msg.Answer = append(msg.Answer, srv)
 
@@ -368,7 +451,7 @@ func (s *DNSServer) handleSRVQuery(msg *dns.Msg, domain string, q dns.Question)
 
 	// Fallback to delegated DNS records if local database lookup failed
 	// domainNormalized is already defined above
-	delegatedRecord, delegationErr := database.GetDelegatedDNSRecord(domainNormalized, "SRV")
+	delegatedRecord, delegationErr := lookupDelegatedRecord(domainNormalized, "SRV")
 	if delegationErr == nil && delegatedRecord != nil {
 		// Parse JSON records (SRV format: "priority weight port target")
 		var srvRecords []string
@@ -383,7 +466,7 @@ func (s *DNSServer) handleSRVQuery(msg *dns.Msg, domain string, q dns.Question)
 					targetDomain := parts[3]
 					// Normalize target domain by removing trailing dot for database lookup
 					targetDomainNormalized := strings.TrimSuffix(targetDomain, ".")
-					aRecord, aErr := database.GetDelegatedDNSRecord(targetDomainNormalized, "A")
+					aRecord, aErr := lookupDelegatedRecord(targetDomainNormalized, "A")
 					var nodeIP string
 					var ttl uint32 = uint32(delegatedRecord.TTL)
 					if aErr == nil && aRecord != nil {
@@ -431,7 +514,7 @@ func (s *DNSServer) handleSRVQuery(msg *dns.Msg, domain string, q dns.Question)
 								Priority: 0,
 								Weight:   0,
 								Port:     uint16(port),
-								Target:   targetHostname,
+								Target:   dns.Fqdn(targetHostname),
 							}
 							msg.Answer = append(msg.Answer, srv)
 							// Also add A record for the target
@@ -468,7 +551,8 @@ func (s *DNSServer) handleSRVQuery(msg *dns.Msg, domain string, q dns.Question)
 // Format: deploy-123.my.obiente.cloud -> deploy-123 (deployments)
 // Format: db-123.my.obiente.cloud -> db-123 (databases)
 // Format: gs-123.my.obiente.cloud -> gs-123 (game servers)
-func (s *DNSServer) handleAQuery(ctx context.Context, msg *dns.Msg, domain string, q dns.Question) bool {
+// clientIP is the address of the querying client, used for split-horizon answers ("" if unknown)
+func (s *DNSServer) handleAQuery(ctx context.Context, msg *dns.Msg, domain string, q dns.Question, clientIP string) bool {
 	// Normalize domain - remove trailing dot if present
 	domainNormalized := strings.TrimSuffix(domain, ".")
 	parts := strings.Split(domainNormalized, ".")
@@ -496,7 +580,7 @@ func (s *DNSServer) handleAQuery(ctx context.Context, msg *dns.Msg, domain strin
 	}
 
 	// Otherwise, treat as deployment (deploy-123)
-	return s.handleDeploymentAQuery(ctx, msg, domain, q, resourceID)
+	return s.handleDeploymentAQuery(ctx, msg, domain, q, resourceID, clientIP)
 }
 
 // handleDatabaseAQuery handles A record queries for managed databases.
@@ -538,7 +622,7 @@ func (s *DNSServer) handleDatabaseAQuery(msg *dns.Msg, domain string, q dns.Ques
 
 	// Fallback to delegated records when local lookup fails.
 	domainNormalized := strings.TrimSuffix(domain, ".")
-	delegatedRecord, delegationErr := database.GetDelegatedDNSRecord(domainNormalized, "A")
+	delegatedRecord, delegationErr := lookupDelegatedRecord(domainNormalized, "A")
 	if delegationErr == nil && delegatedRecord != nil {
 		var recordIPs []string
 		if err := json.Unmarshal([]byte(delegatedRecord.Records), &recordIPs); err == nil && len(recordIPs) > 0 {
@@ -569,11 +653,30 @@ func (s *DNSServer) handleDatabaseAQuery(msg *dns.Msg, domain string, q dns.Ques
 }
 
 // handleDeploymentAQuery handles A record queries for deployments
-func (s *DNSServer) handleDeploymentAQuery(ctx context.Context, msg *dns.Msg, domain string, q dns.Question, deploymentID string) bool {
+func (s *DNSServer) handleDeploymentAQuery(ctx context.Context, msg *dns.Msg, domain string, q dns.Question, deploymentID, clientIP string) bool {
 	if resolvedID, err := database.ResolveDeploymentIDByDomain(domain); err == nil {
 		deploymentID = resolvedID
 	}
 
+	// Clients inside one of the deployment's split-horizon CIDRs get its private IP
+	if privateIP, ok := s.lookupSplitHorizon(ctx, deploymentID, clientIP); ok {
+		rr := &dns.A{
+			Hdr: dns.RR_Header{
+				Name:   q.Name,
+				Rrtype: dns.TypeA,
+				Class:  dns.ClassINET,
+				Ttl:    uint32(cacheTTL.Seconds()),
+			},
+			A: net.ParseIP(privateIP),
+		}
+		if rr.A != nil {
+			msg.Answer = append(msg.Answer, rr)
+			log.Printf("[DNS] Resolved deployment %s for %s via split-horizon: %s", deploymentID, clientIP, privateIP)
+			return true
+		}
+		log.Printf("[DNS] Failed to parse split-horizon IP: %s", privateIP)
+	}
+
 	// When DNS is enabled locally, prioritize local database records over delegated records
 	// Local records are the source of truth for the local DNS server
 	// Delegated records are only used as a fallback when local records don't exist
@@ -637,7 +740,7 @@ func (s *DNSServer) handleDeploymentAQuery(ctx context.Context, msg *dns.Msg, do
 	// This allows self-hosted instances to push records that take precedence when local records don't exist
 	domainNormalized := strings.TrimSuffix(domain, ".")
 	log.Printf("[DNS] Looking up delegated record for deployment %s (fallback): original domain=%q, normalized domain=%q", deploymentID, domain, domainNormalized)
-	delegatedRecord, delegationErr := database.GetDelegatedDNSRecord(domainNormalized, "A")
+	delegatedRecord, delegationErr := lookupDelegatedRecord(domainNormalized, "A")
 	if delegationErr == nil && delegatedRecord != nil {
 		// Parse JSON records
 		var recordIPs []string
@@ -669,9 +772,88 @@ func (s *DNSServer) handleDeploymentAQuery(ctx context.Context, msg *dns.Msg, do
 		}
 	}
 
+	// Last, try the wildcard records covering the domain (e.g. *.app.my.obiente.cloud for foo.app.my.obiente.cloud)
+	if wildcard, ips, ttl, ok := s.lookupWildcardRecord(ctx, domainNormalized); ok {
+		for _, ip := range ips {
+			rr := &dns.A{
+				Hdr: dns.RR_Header{
+					Name:   q.Name,
+					Rrtype: dns.TypeA,
+					Class:  dns.ClassINET,
+					Ttl:    ttl,
+				},
+				A: net.ParseIP(ip),
+			}
+			if rr.A == nil {
+				log.Printf("[DNS] Failed to parse IP from wildcard record %s: %s", wildcard, ip)
+				continue
+			}
+			msg.Answer = append(msg.Answer, rr)
+		}
+		if len(msg.Answer) > 0 {
+			log.Printf("[DNS] Resolved %s via wildcard record %s: %v", domainNormalized, wildcard, ips)
+			return true
+		}
+	}
+
 	return len(msg.Answer) > 0
 }
 
+// wildcardRecord is a resolved wildcard A record as cached in Redis
+type wildcardRecord struct {
+	IPs []string `json:"ips"`
+	TTL uint32   `json:"ttl"`
+}
+
+// wildcardCandidates returns the wildcard domains that can answer for domain, closest first:
+// a.b.app.my.obiente.cloud is covered by *.b.app.my.obiente.cloud, then *.app.my.obiente.cloud.
+// There is no zone-wide wildcard, so *.my.obiente.cloud is never a candidate.
+func wildcardCandidates(domain string) []string {
+	labels := strings.Split(domain, ".")
+	var candidates []string
+	for i := 1; i < len(labels); i++ {
+		suffix := strings.Join(labels[i:], ".")
+		if !strings.HasSuffix(suffix, ".my.obiente.cloud") {
+			break
+		}
+		candidates = append(candidates, "*."+suffix)
+	}
+	return candidates
+}
+
+// lookupWildcardRecord finds the closest wildcard A record covering domain. Resolved wildcard
+// records are cached in Redis for up to cacheTTL.
+func (s *DNSServer) lookupWildcardRecord(ctx context.Context, domain string) (string, []string, uint32, bool) {
+	for _, wildcard := range wildcardCandidates(domain) {
+		cacheKey := fmt.Sprintf("dns:wildcard:%s", wildcard)
+		if s.redisCache != nil {
+			var cached wildcardRecord
+			data, err := s.redisCache.Get(ctx, cacheKey)
+			hit := err == nil && data != "" && json.Unmarshal([]byte(data), &cached) == nil && len(cached.IPs) > 0
+			metrics.RecordDNSWildcardCacheLookup(hit)
+			if hit {
+				return wildcard, cached.IPs, cached.TTL, true
+			}
+		}
+
+		record, err := lookupDelegatedRecord(wildcard, "A")
+		if err != nil || record == nil {
+			continue
+		}
+		var ips []string
+		if err := json.Unmarshal([]byte(record.Records), &ips); err != nil || len(ips) == 0 {
+			log.Printf("[DNS] Failed to parse wildcard record %s: %v", wildcard, err)
+			continue
+		}
+		ttl := uint32(record.TTL)
+		if s.redisCache != nil {
+			s.redisCache.Set(ctx, cacheKey, wildcardRecord{IPs: ips, TTL: ttl}, min(time.Duration(record.TTL)*time.Second, cacheTTL))
+		}
+		return wildcard, ips, ttl, true
+	}
+	return "", nil, 0, false
+}
+
 // handleGameServerAQuery handles A record queries for game servers
 func (s *DNSServer) handleGameServerAQuery(msg *dns.Msg, domain string, q dns.Question, gameServerID string) bool {
 	log.Printf("[DNS] Handling A query for game server %s (domain: %s)", gameServerID, domain)
@@ -743,7 +925,7 @@ func (s *DNSServer) handleGameServerAQuery(msg *dns.Msg, domain string, q dns.Qu
 
 	// Fallback to delegated DNS records if local database lookup failed
 	domainNormalized := strings.TrimSuffix(domain, ".")
-	delegatedRecord, delegationErr := database.GetDelegatedDNSRecord(domainNormalized, "A")
+	delegatedRecord, delegationErr := lookupDelegatedRecord(domainNormalized, "A")
 	if delegationErr == nil && delegatedRecord != nil {
 		// Parse JSON records
 		var recordIPs []string
@@ -836,9 +1018,11 @@ func handlePushDNSRecord(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Validate domain format - allow my.obiente.cloud domains or verified custom domains
+	// A wildcard domain (*.app.my.obiente.cloud) is allowed where its suffix is
 	domainLower := strings.ToLower(domain)
-	isMyObienteCloud := strings.HasSuffix(domainLower, ".my.obiente.cloud")
-	isVerifiedCustomDomain := isVerifiedCustomDomain(domain)
+	baseDomain, isWildcard := strings.CutPrefix(domainLower, "*.")
+	isMyObienteCloud := strings.HasSuffix(baseDomain, ".my.obiente.cloud")
+	isVerifiedCustomDomain := isVerifiedCustomDomain(baseDomain)
 
 	if !isMyObienteCloud && !isVerifiedCustomDomain {
 		http.Error(w, "Domain must be a *.my.obiente.cloud domain or a verified custom domain", http.StatusBadRequest)
@@ -850,6 +1034,10 @@ func handlePushDNSRecord(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Only A and SRV record types are supported", http.StatusBadRequest)
 		return
 	}
+	if isWildcard && recordType != "A" {
+		http.Error(w, "Wildcard domains are only supported for A records", http.StatusBadRequest)
+		return
+	}
 
 	// Set default TTL if not provided
 	ttl := req.TTL
@@ -882,6 +1070,11 @@ func handlePushDNSRecord(w http.ResponseWriter, r *http.Request) {
 
 	// Upsert the delegated DNS record with API key tracking
 	if err := database.UpsertDelegatedDNSRecordWithAPIKey(domain, recordType, string(recordsJSON), sourceAPI, apiKeyInfo.ID, apiKeyInfo.OrganizationID, ttl); err != nil {
+		if errors.Is(err, database.ErrWildcardRecordConflict) {
+			metrics.RecordDNSDelegationPushError(apiKeyInfo.OrganizationID, apiKeyInfo.ID, "wildcard_conflict")
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
 		metrics.RecordDNSDelegationPushError(apiKeyInfo.OrganizationID, apiKeyInfo.ID, "upsert_failed")
 		http.Error(w, fmt.Sprintf("Failed to store DNS record: %v", err), http.StatusInternalServerError)
 		return
@@ -986,9 +1179,11 @@ func handlePushDNSRecords(w http.ResponseWriter, r *http.Request) {
 		}
 
 		// Validate domain format - allow my.obiente.cloud domains or verified custom domains
+		// A wildcard domain (*.app.my.obiente.cloud) is allowed where its suffix is
 		domainLower := strings.ToLower(domain)
-		isMyObienteCloud := strings.HasSuffix(domainLower, ".my.obiente.cloud")
-		isVerifiedCustomDomain := isVerifiedCustomDomain(domain)
+		baseDomain, isWildcard := strings.CutPrefix(domainLower, "*.")
+		isMyObienteCloud := strings.HasSuffix(baseDomain, ".my.obiente.cloud")
+		isVerifiedCustomDomain := isVerifiedCustomDomain(baseDomain)
 
 		if !isMyObienteCloud && !isVerifiedCustomDomain {
 			errors = append(errors, fmt.Sprintf("Invalid domain format: %s (must be *.my.obiente.cloud or a verified custom domain)", domain))
@@ -999,6 +1194,10 @@ func handlePushDNSRecords(w http.ResponseWriter, r *http.Request) {
 			errors = append(errors, fmt.Sprintf("Unsupported record type: %s", recordType))
 			continue
 		}
+		if isWildcard && recordType != "A" {
+			errors = append(errors, fmt.Sprintf("Wildcard domains are only supported for A records: %s", domain))
+			continue
+		}
 
 		ttl := recordReq.TTL
 		if ttl == 0 {
@@ -1292,7 +1491,7 @@ func pushDNSRecords(ctx context.Context, client *http.Client, pushURL, apiKey, s
 				if err == nil {
 					// Minecraft Java (1 or 2) uses TCP
 					// Minecraft Bedrock (3) uses UDP
-					// Rust (6) uses UDP
+					// Rust (6), Valheim (4) and CS2 (7) use UDP
 					switch gameType {
 					case 1, 2:
 						// Minecraft Java - TCP SRV
@@ -1324,6 +1523,26 @@ func pushDNSRecords(ctx context.Context, client *http.Client, pushURL, apiKey, s
 							"records":     []string{srvRecord},
 							"ttl":         ttl,
 						})
+					case 4:
+						// Valheim - UDP SRV
+						srvDomain := fmt.Sprintf("_valheim._udp.%s.my.obiente.cloud", loc.GameServerID)
+						srvRecord := fmt.Sprintf("0 0 %d %s.my.obiente.cloud", loc.Port, loc.GameServerID)
+						records = append(records, map[string]interface{}{
+							"domain":      srvDomain,
+							"record_type": "SRV",
+							"records":     []string{srvRecord},
+							"ttl":         ttl,
+						})
+					case 7:
+						// Counter-Strike 2 - UDP SRV
+						srvDomain := fmt.Sprintf("_cs2._udp.%s.my.obiente.cloud", loc.GameServerID)
+						srvRecord := fmt.Sprintf("0 0 %d %s.my.obiente.cloud", loc.Port, loc.GameServerID)
+						records = append(records, map[string]interface{}{
+							"domain":      srvDomain,
+							"record_type": "SRV",
+							"records":     []string{srvRecord},
+							"ttl":         ttl,
+						})
 					}
 				}
 			}
@@ -1478,6 +1697,11 @@ func startDelegatedRecordCleanup(ctx context.Context) {
 }
 
 func main() {
+	if err := ValidateConfig(); err != nil {
+		log.Printf("[DNS] Invalid configuration:\n%v", err)
+		os.Exit(1)
+	}
+
 	shutdownCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
@@ -1492,6 +1716,9 @@ func main() {
 	// Health check endpoint for API Gateway with replica ID
 	httpMux.HandleFunc("/health", health.SimpleHealth("dns-service"))
 
+	// Prometheus metrics (query counters, cache and delegation hit rates, latency)
+	httpMux.Handle("/metrics", metrics.Handler())
+
 	httpPort := os.Getenv("HTTP_PORT")
 	if httpPort == "" {
 		httpPort = "8053" // Default HTTP port for DNS service
@@ -1544,8 +1771,8 @@ func main() {
 
 	// Start DNS pusher if delegation is configured
 	// This pushes DNS records to production API when using DNS delegation
-	productionAPIURL := strings.Trim(strings.TrimSpace(os.Getenv("DNS_DELEGATION_PRODUCTION_API_URL")), `"'`)
-	apiKey := strings.Trim(strings.TrimSpace(os.Getenv("DNS_DELEGATION_API_KEY")), `"'`)
+	productionAPIURL := delegationEnv("DNS_DELEGATION_PRODUCTION_API_URL")
+	apiKey := delegationEnv("DNS_DELEGATION_API_KEY")
 
 	// Debug logging to help diagnose configuration issues
 	apiKeyPreview := ""
@@ -1628,7 +1855,7 @@ func main() {
 	// Check if DNS service is enabled
 	// Set ENABLE_DNS=false to disable the DNS server (but keep HTTP server for delegation)
 	enableDNS := os.Getenv("ENABLE_DNS")
-	dnsEnabled := enableDNS != "false" && enableDNS != "0"
+	dnsEnabled := dnsServerEnabled()
 	var udpServer *dns.Server
 	var tcpServer *dns.Server
 	if !dnsEnabled {
@@ -1642,6 +1869,11 @@ func main() {
 			log.Fatalf("Failed to create DNS server: %v", err)
 		}
 
+		if server.queryLogs != nil {
+			go server.runQueryLogWriter(shutdownCtx)
+			go startQueryLogRetention(shutdownCtx)
+		}
+
 		log.Printf("[DNS] Starting DNS server for my.obiente.cloud zone")
 		log.Printf("[DNS] Node IPs configured for regions: %v", server.nodeIPMap)
 
@@ -1656,6 +1888,23 @@ func main() {
 		dnsMux := dns.NewServeMux()
 		dnsMux.HandleFunc("my.obiente.cloud.", server.handleDNSRequest)
 
+		// DNS-over-HTTPS on the HTTP server, rate limited per client IP through Redis
+		var dohLimiter dohRateLimiter
+		if database.RedisClient != nil {
+			dohLimiter = database.RedisClient
+		}
+		httpMux.Handle(dohPath, newDoHHandler(server, dohLimiter))
+
+		// ZSK rotation, authorized by DNSSEC_ROTATE_TOKEN
+		if server.dnssec != nil {
+			if token := strings.TrimSpace(os.Getenv("DNSSEC_ROTATE_TOKEN")); token != "" {
+				httpMux.Handle("/dnssec/rotate-zsk", server.dnssec.rotateHandler(token))
+			} else {
+				log.Printf("[DNSSEC] DNSSEC_ROTATE_TOKEN is not set; the ZSK rotation endpoint is disabled")
+			}
+		}
+		log.Printf("[DNS] Serving DNS-over-HTTPS on %s (HTTP port %s)", dohPath, httpPort)
+
 		udpServer = &dns.Server{
 			Addr:    "0.0.0.0:" + dnsPort,
 			Net:     "udp",
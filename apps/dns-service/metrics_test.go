@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/prometheus/client_golang/prometheus"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// memoryCache is an in-memory dnsCache that stores values JSON-encoded, like Redis
+type memoryCache map[string]string
+
+func (c memoryCache) Get(ctx context.Context, key string) (string, error) {
+	return c[key], nil
+}
+
+func (c memoryCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c[key] = string(data)
+	return nil
+}
+
+// recordingWriter captures the reply; the embedded interface covers the methods handleDNSRequest doesn't use
+type recordingWriter struct {
+	dns.ResponseWriter
+	reply *dns.Msg
+}
+
+func (w *recordingWriter) WriteMsg(msg *dns.Msg) error {
+	w.reply = msg
+	return nil
+}
+
+// RemoteAddr reports an unknown client
+func (w *recordingWriter) RemoteAddr() net.Addr {
+	return nil
+}
+
+// metricValue sums a counter, or a histogram's sample count, across series matching the labels
+func metricValue(t *testing.T, name string, labels map[string]string) float64 {
+	t.Helper()
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics: %v", err)
+	}
+	var total float64
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	series:
+		for _, m := range family.GetMetric() {
+			for key, value := range labels {
+				matched := false
+				for _, label := range m.GetLabel() {
+					if label.GetName() == key && label.GetValue() == value {
+						matched = true
+					}
+				}
+				if !matched {
+					continue series
+				}
+			}
+			if m.GetHistogram() != nil {
+				total += float64(m.GetHistogram().GetSampleCount())
+			} else {
+				total += m.GetCounter().GetValue()
+			}
+		}
+	}
+	return total
+}
+
+func TestDNSMetricsCountCacheHitsAndMisses(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(&database.DeploymentLocation{}, &database.DelegatedDNSRecord{}); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+	previousDB := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = previousDB
+	})
+
+	cache := memoryCache{}
+	for i := 0; i < 50; i++ {
+		if err := cache.Set(context.Background(), fmt.Sprintf("dns:deployment:deploy-hit-%d", i), []string{"10.0.0.1"}, cacheTTL); err != nil {
+			t.Fatalf("seed cache: %v", err)
+		}
+	}
+	server := &DNSServer{db: db, nodeIPMap: map[string][]string{}, redisCache: cache}
+
+	zoneA := map[string]string{"qtype": "A", "zone": "my.obiente.cloud"}
+	delegationMiss := map[string]string{"record_type": "A", "result": "miss"}
+	queriesBefore := metricValue(t, "dns_queries_total", zoneA)
+	hitsBefore := metricValue(t, "dns_cache_hits_total", nil)
+	missesBefore := metricValue(t, "dns_cache_misses_total", nil)
+	delegationBefore := metricValue(t, "dns_delegation_lookups_total", delegationMiss)
+	durationBefore := metricValue(t, "dns_query_duration_seconds", nil)
+
+	answered := 0
+	for i := 0; i < 100; i++ {
+		name := fmt.Sprintf("deploy-hit-%d.my.obiente.cloud.", i/2)
+		if i%2 == 1 {
+			name = fmt.Sprintf("deploy-miss-%d.my.obiente.cloud.", i/2)
+		}
+		req := new(dns.Msg)
+		req.SetQuestion(name, dns.TypeA)
+		w := &recordingWriter{}
+		server.handleDNSRequest(w, req)
+		if w.reply == nil {
+			t.Fatalf("no reply for %s", name)
+		}
+		if len(w.reply.Answer) > 0 {
+			answered++
+		}
+	}
+
+	if answered != 50 {
+		t.Fatalf("answered %d queries, want 50 from the cache", answered)
+	}
+	checks := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"dns_queries_total", metricValue(t, "dns_queries_total", zoneA) - queriesBefore, 100},
+		{"dns_cache_hits_total", metricValue(t, "dns_cache_hits_total", nil) - hitsBefore, 50},
+		{"dns_cache_misses_total", metricValue(t, "dns_cache_misses_total", nil) - missesBefore, 50},
+		{"dns_delegation_lookups_total{result=miss}", metricValue(t, "dns_delegation_lookups_total", delegationMiss) - delegationBefore, 50},
+		{"dns_query_duration_seconds count", metricValue(t, "dns_query_duration_seconds", nil) - durationBefore, 100},
+	}
+	for _, check := range checks {
+		if check.got != check.want {
+			t.Errorf("%s increased by %v, want %v", check.name, check.got, check.want)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+
+	"github.com/google/uuid"
+	"github.com/miekg/dns"
+	"gorm.io/gorm"
+)
+
+const (
+	queryLogBufferSize        = 10000
+	queryLogFlushInterval     = 2 * time.Second
+	queryLogOrgCacheTTL       = 5 * time.Minute
+	queryLogRetentionInterval = time.Hour
+	defaultQueryLogRetention  = 30 // days
+)
+
+// queryLogger buffers DNS query logs and writes them to the metrics database in batches,
+// so logging never adds a database round trip to a DNS answer
+type queryLogger struct {
+	entries chan database.DNSQueryLog
+	dropped atomic.Int64
+
+	// orgCache maps a queried domain to the organization owning it; only the writer goroutine uses it
+	orgCache map[string]cachedQueryLogOrg
+	// resolveOrganization finds the organization owning a domain (replaced in tests)
+	resolveOrganization func(domain string) (string, error)
+}
+
+type cachedQueryLogOrg struct {
+	organizationID string
+	expiresAt      time.Time
+}
+
+func newQueryLogger() *queryLogger {
+	return &queryLogger{
+		entries:             make(chan database.DNSQueryLog, queryLogBufferSize),
+		orgCache:            make(map[string]cachedQueryLogOrg),
+		resolveOrganization: domainOrganization,
+	}
+}
+
+// queryLogEnabled reports whether DNS_QUERY_LOG_ENABLED leaves query logging on (the default)
+func queryLogEnabled() bool {
+	value := strings.TrimSpace(os.Getenv("DNS_QUERY_LOG_ENABLED"))
+	return value != "false" && value != "0"
+}
+
+// queryLogRetentionDays reads DNS_QUERY_LOG_RETENTION_DAYS (default 30)
+func queryLogRetentionDays() int {
+	if value := strings.TrimSpace(os.Getenv("DNS_QUERY_LOG_RETENTION_DAYS")); value != "" {
+		if days, err := strconv.Atoi(value); err == nil && days > 0 {
+			return days
+		}
+	}
+	return defaultQueryLogRetention
+}
+
+// queryLog records a DNS query for the audit trail. It drops the entry when the buffer is full.
+func (s *DNSServer) queryLog(ctx context.Context, domain, qtype, clientIP, result string, latency time.Duration) {
+	if s.queryLogs == nil {
+		return
+	}
+	entry := database.DNSQueryLog{
+		ID:            uuid.NewString(),
+		Timestamp:     time.Now().UTC(),
+		Domain:        strings.TrimSuffix(strings.ToLower(domain), "."),
+		QueryType:     qtype,
+		ClientIP:      clientIP,
+		Result:        result,
+		LatencyMicros: latency.Microseconds(),
+	}
+	select {
+	case s.queryLogs.entries <- entry:
+	default:
+		s.queryLogs.dropped.Add(1)
+	}
+}
+
+// remoteIP returns the client IP of a DNS request, or "" when it is unknown
+func remoteIP(w dns.ResponseWriter) string {
+	addr := w.RemoteAddr()
+	switch a := addr.(type) {
+	case *net.UDPAddr:
+		return a.IP.String()
+	case *net.TCPAddr:
+		return a.IP.String()
+	case nil:
+		return ""
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	return host
+}
+
+// runQueryLogWriter writes buffered query logs until ctx is done, then writes what is left
+func (s *DNSServer) runQueryLogWriter(ctx context.Context) {
+	ticker := time.NewTicker(queryLogFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.flushQueryLogs(ctx)
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			s.flushQueryLogs(flushCtx)
+			cancel()
+			return
+		}
+	}
+}
+
+// flushQueryLogs writes the buffered query logs, tagged with the organization owning each domain
+func (s *DNSServer) flushQueryLogs(ctx context.Context) {
+	if s.queryLogs == nil {
+		return
+	}
+	if dropped := s.queryLogs.dropped.Swap(0); dropped > 0 {
+		log.Printf("[DNS] Query log buffer full: dropped %d query log entries", dropped)
+	}
+
+	var batch []database.DNSQueryLog
+drain:
+	for len(batch) < queryLogBufferSize {
+		select {
+		case entry := <-s.queryLogs.entries:
+			entry.OrganizationID = s.queryLogs.organization(entry.Domain)
+			batch = append(batch, entry)
+		default:
+			break drain
+		}
+	}
+	if err := database.CreateDNSQueryLogs(ctx, batch); err != nil {
+		log.Printf("[DNS] Failed to write %d query log entries: %v", len(batch), err)
+	}
+}
+
+func (l *queryLogger) organization(domain string) string {
+	now := time.Now()
+	if cached, ok := l.orgCache[domain]; ok && now.Before(cached.expiresAt) {
+		return cached.organizationID
+	}
+	organizationID, err := l.resolveOrganization(domain)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		// Not cached, so the lookup is retried on the next query
+		log.Printf("[DNS] Failed to resolve the organization of %s for the query log: %v", domain, err)
+		return ""
+	}
+	if len(l.orgCache) >= queryLogBufferSize {
+		l.orgCache = make(map[string]cachedQueryLogOrg)
+	}
+	l.orgCache[domain] = cachedQueryLogOrg{organizationID: organizationID, expiresAt: now.Add(queryLogOrgCacheTTL)}
+	return organizationID
+}
+
+// domainOrganization finds the organization owning the deployment, database or game server a
+// my.obiente.cloud name points at. SRV names (_service._proto.gs-123...) use their third label.
+func domainOrganization(domain string) (string, error) {
+	zone := strings.TrimSuffix(dnsZone, ".")
+	if !strings.HasSuffix(domain, "."+zone) {
+		return "", gorm.ErrRecordNotFound
+	}
+	labels := strings.Split(strings.TrimSuffix(domain, "."+zone), ".")
+	label := labels[0]
+	if strings.HasPrefix(label, "_") && len(labels) >= 3 {
+		label = labels[2]
+	}
+
+	table, id := "deployments", label
+	var err error
+	switch {
+	case strings.HasPrefix(label, "gs-"):
+		table = "game_servers"
+		id, err = database.ResolveGameServerIDByLabel(label)
+	case strings.HasPrefix(label, "db-"):
+		table = "database_instances"
+		id, err = database.ResolveDatabaseIDByLabel(label)
+	default:
+		id, err = database.ResolveDeploymentIDByDomain(domain)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		id, err = label, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var organizationID string
+	result := database.DB.Table(table).Select("organization_id").Where("id = ?", id).Limit(1).Scan(&organizationID)
+	if result.Error != nil {
+		return "", result.Error
+	}
+	if result.RowsAffected == 0 {
+		return "", gorm.ErrRecordNotFound
+	}
+	return organizationID, nil
+}
+
+// startQueryLogRetention removes query logs older than DNS_QUERY_LOG_RETENTION_DAYS every hour
+func startQueryLogRetention(ctx context.Context) {
+	retentionDays := queryLogRetentionDays()
+	log.Printf("[DNS] Keeping DNS query logs for %d days", retentionDays)
+
+	ticker := time.NewTicker(queryLogRetentionInterval)
+	defer ticker.Stop()
+
+	for {
+		removed, err := database.CleanOldDNSQueryLogs(ctx, retentionDays)
+		if err != nil {
+			log.Printf("[DNS] %v", err)
+		} else if removed > 0 {
+			log.Printf("[DNS] Removed %d DNS query logs older than %d days", removed, retentionDays)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// remoteRecordingWriter is a recordingWriter that reports a client address
+type remoteRecordingWriter struct {
+	recordingWriter
+	remote net.Addr
+}
+
+func (w *remoteRecordingWriter) RemoteAddr() net.Addr {
+	return w.remote
+}
+
+// useQueryLogTestDB points database.DB and database.MetricsDB at a fresh in-memory database for the test
+func useQueryLogTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(&database.Deployment{}, &database.DeploymentLocation{}, &database.DelegatedDNSRecord{}, &database.DNSQueryLog{}); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+	previousDB, previousMetricsDB := database.DB, database.MetricsDB
+	database.DB, database.MetricsDB = db, db
+	t.Cleanup(func() {
+		database.DB, database.MetricsDB = previousDB, previousMetricsDB
+	})
+	return db
+}
+
+func TestQueryLogRecordsEveryQuery(t *testing.T) {
+	db := useQueryLogTestDB(t)
+	if err := db.Create(&database.Deployment{ID: "deploy-logged", Domain: "deploy-logged.my.obiente.cloud", OrganizationID: "org-a"}).Error; err != nil {
+		t.Fatalf("seed deployment: %v", err)
+	}
+	cache := memoryCache{}
+	if err := cache.Set(context.Background(), "dns:deployment:deploy-logged", []string{"10.0.0.1"}, cacheTTL); err != nil {
+		t.Fatalf("seed cache: %v", err)
+	}
+	server := &DNSServer{db: db, nodeIPMap: map[string][]string{}, redisCache: cache, queryLogs: newQueryLogger()}
+
+	queries := []struct {
+		name  string
+		qtype uint16
+	}{
+		{"Deploy-Logged.my.obiente.cloud.", dns.TypeA},
+		{"deploy-missing.my.obiente.cloud.", dns.TypeA},
+		{"example.com.", dns.TypeTXT},
+	}
+	for _, q := range queries {
+		req := new(dns.Msg)
+		req.SetQuestion(q.name, q.qtype)
+		w := &remoteRecordingWriter{remote: &net.UDPAddr{IP: net.ParseIP("203.0.113.7"), Port: 5353}}
+		server.handleDNSRequest(w, req)
+		if w.reply == nil {
+			t.Fatalf("no reply for %s", q.name)
+		}
+	}
+	server.flushQueryLogs(context.Background())
+
+	logs, total, err := database.ListDNSQueryLogs(context.Background(), database.DNSQueryLogFilter{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListDNSQueryLogs: %v", err)
+	}
+	if total != 3 || len(logs) != 3 {
+		t.Fatalf("got %d query logs (total %d), want 3", len(logs), total)
+	}
+	byDomain := map[string]database.DNSQueryLog{}
+	for _, entry := range logs {
+		byDomain[entry.Domain] = entry
+	}
+
+	answered := byDomain["deploy-logged.my.obiente.cloud"]
+	if answered.Result != "NOERROR" || answered.QueryType != "A" || answered.ClientIP != "203.0.113.7" || answered.OrganizationID != "org-a" {
+		t.Errorf("answered query logged as %+v", answered)
+	}
+	if answered.ID == "" || answered.Timestamp.IsZero() || answered.LatencyMicros < 0 {
+		t.Errorf("answered query logged without id, timestamp or latency: %+v", answered)
+	}
+	if missing := byDomain["deploy-missing.my.obiente.cloud"]; missing.Result != "NXDOMAIN" || missing.OrganizationID != "" {
+		t.Errorf("unknown deployment logged as %+v", missing)
+	}
+	if outside := byDomain["example.com"]; outside.Result != "NXDOMAIN" || outside.QueryType != "TXT" {
+		t.Errorf("out-of-zone query logged as %+v", outside)
+	}
+
+	// Filters: organization, and a domain with its subdomains
+	if logs, _, err := database.ListDNSQueryLogs(context.Background(), database.DNSQueryLogFilter{OrganizationID: "org-a", Limit: 10}); err != nil || len(logs) != 1 || logs[0].Domain != "deploy-logged.my.obiente.cloud" {
+		t.Errorf("organization filter = %v, %v", logs, err)
+	}
+	if logs, _, err := database.ListDNSQueryLogs(context.Background(), database.DNSQueryLogFilter{Domain: "my.obiente.cloud.", Limit: 10}); err != nil || len(logs) != 2 {
+		t.Errorf("domain filter = %v, %v", logs, err)
+	}
+}
+
+func TestQueryLogCachesOrganizationsAndDropsWhenFull(t *testing.T) {
+	useQueryLogTestDB(t)
+	lookups := 0
+	logger := newQueryLogger()
+	logger.resolveOrganization = func(domain string) (string, error) {
+		lookups++
+		return "org-b", nil
+	}
+	server := &DNSServer{queryLogs: logger}
+
+	for i := 0; i < queryLogBufferSize+5; i++ {
+		server.queryLog(context.Background(), "gs-1.my.obiente.cloud.", "A", "198.51.100.1", "NOERROR", time.Millisecond)
+	}
+	if dropped := logger.dropped.Load(); dropped != 5 {
+		t.Fatalf("dropped %d entries, want 5", dropped)
+	}
+	server.flushQueryLogs(context.Background())
+
+	if lookups != 1 {
+		t.Errorf("resolved the organization %d times, want once", lookups)
+	}
+	logs, total, err := database.ListDNSQueryLogs(context.Background(), database.DNSQueryLogFilter{OrganizationID: "org-b", Limit: 1})
+	if err != nil || total != queryLogBufferSize || logs[0].LatencyMicros != 1000 {
+		t.Fatalf("stored %d entries (%v, %v), want %d with 1ms latency", total, logs, err, queryLogBufferSize)
+	}
+}
+
+func TestCleanOldDNSQueryLogs(t *testing.T) {
+	db := useQueryLogTestDB(t)
+	entries := []database.DNSQueryLog{
+		{ID: "old", Timestamp: time.Now().AddDate(0, 0, -31), Domain: "a.my.obiente.cloud", QueryType: "A", Result: "NOERROR"},
+		{ID: "recent", Timestamp: time.Now().AddDate(0, 0, -29), Domain: "a.my.obiente.cloud", QueryType: "A", Result: "NOERROR"},
+	}
+	if err := db.Create(&entries).Error; err != nil {
+		t.Fatalf("seed query logs: %v", err)
+	}
+
+	removed, err := database.CleanOldDNSQueryLogs(context.Background(), 30)
+	if err != nil {
+		t.Fatalf("CleanOldDNSQueryLogs: %v", err)
+	}
+	var remaining []database.DNSQueryLog
+	db.Find(&remaining)
+	if removed != 1 || len(remaining) != 1 || remaining[0].ID != "recent" {
+		t.Fatalf("removed %d, remaining %v; want only the recent entry kept", removed, remaining)
+	}
+}
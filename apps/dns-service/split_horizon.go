@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+// splitHorizonResult is a split-horizon lookup as cached in Redis. An empty IP records that the
+// client gets the public answer, so deployments without split-horizon entries are not looked up again.
+type splitHorizonResult struct {
+	IP string `json:"ip"`
+}
+
+// splitHorizonCacheKey is the Redis key of a client's split-horizon answer for a deployment. Clients
+// are cached by their host prefix (e.g. 10.0.0.5/32), since which configured CIDR matches is only
+// known after the lookup.
+func splitHorizonCacheKey(clientIP net.IP, deploymentID string) string {
+	bits := 128
+	if clientIP.To4() != nil {
+		bits = 32
+	}
+	clientCIDR := &net.IPNet{IP: clientIP, Mask: net.CIDRMask(bits, bits)}
+	return fmt.Sprintf("dns:split:%s:%s", clientCIDR.String(), deploymentID)
+}
+
+// matchSplitHorizon returns the entry whose CIDR contains the client IP. The most specific CIDR
+// wins, so a 10.1.0.0/16 entry overrides a 10.0.0.0/8 entry for clients in 10.1.0.0/16; entries
+// with equally specific CIDRs keep their stored order.
+func matchSplitHorizon(entries []database.SplitHorizonIP, clientIP net.IP) (database.SplitHorizonIP, bool) {
+	var best database.SplitHorizonIP
+	bestOnes := -1
+	for _, entry := range entries {
+		_, network, err := net.ParseCIDR(entry.ClientCIDR)
+		if err != nil {
+			log.Printf("[DNS] Ignoring invalid split-horizon CIDR %q of deployment %s: %v", entry.ClientCIDR, entry.DeploymentID, err)
+			continue
+		}
+		if !network.Contains(clientIP) {
+			continue
+		}
+		if ones, _ := network.Mask.Size(); ones > bestOnes {
+			best, bestOnes = entry, ones
+		}
+	}
+	return best, bestOnes >= 0
+}
+
+// lookupSplitHorizon returns the private IP a client inside one of the deployment's split-horizon
+// CIDRs should get instead of the Traefik IPs
+func (s *DNSServer) lookupSplitHorizon(ctx context.Context, deploymentID, clientIP string) (string, bool) {
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return "", false
+	}
+
+	cacheKey := splitHorizonCacheKey(ip, deploymentID)
+	if s.redisCache != nil {
+		var cached splitHorizonResult
+		if data, err := s.redisCache.Get(ctx, cacheKey); err == nil && data != "" && json.Unmarshal([]byte(data), &cached) == nil {
+			return cached.IP, cached.IP != ""
+		}
+	}
+
+	entries, err := database.GetSplitHorizonIPs(deploymentID)
+	if err != nil {
+		log.Printf("[DNS] Failed to look up split-horizon IPs of deployment %s: %v", deploymentID, err)
+		return "", false
+	}
+	var result splitHorizonResult
+	if entry, ok := matchSplitHorizon(entries, ip); ok {
+		result.IP = entry.IP
+	}
+	if s.redisCache != nil {
+		s.redisCache.Set(ctx, cacheKey, result, cacheTTL)
+	}
+	return result.IP, result.IP != ""
+}
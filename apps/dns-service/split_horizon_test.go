@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// useSplitHorizonTestDB points database.DB at a fresh in-memory database for the test
+func useSplitHorizonTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(&database.Deployment{}, &database.DeploymentLocation{}, &database.DelegatedDNSRecord{}, &database.SplitHorizonIP{}); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+	previousDB := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = previousDB
+	})
+	return db
+}
+
+func TestMatchSplitHorizonPrefersMostSpecificCIDR(t *testing.T) {
+	entries := []database.SplitHorizonIP{
+		{ClientCIDR: "10.0.0.0/8", IP: "172.16.0.8"},
+		{ClientCIDR: "10.1.2.0/24", IP: "172.16.0.24"},
+		{ClientCIDR: "10.1.0.0/16", IP: "172.16.0.16"},
+		{ClientCIDR: "not-a-cidr", IP: "172.16.0.99"},
+		{ClientCIDR: "10.1.2.0/24", IP: "172.16.0.25"}, // Equally specific: the first entry wins
+		{ClientCIDR: "fd00::/8", IP: "172.16.0.6"},
+	}
+
+	tests := []struct {
+		clientIP string
+		want     string
+	}{
+		{"10.9.9.9", "172.16.0.8"},
+		{"10.1.9.9", "172.16.0.16"},
+		{"10.1.2.3", "172.16.0.24"},
+		{"fd12::1", "172.16.0.6"},
+		{"192.0.2.1", ""},
+		{"::ffff:10.1.2.3", "172.16.0.24"}, // IPv4-mapped clients match IPv4 CIDRs
+	}
+	for _, tt := range tests {
+		entry, ok := matchSplitHorizon(entries, net.ParseIP(tt.clientIP))
+		if got := entry.IP; ok != (tt.want != "") || got != tt.want {
+			t.Errorf("matchSplitHorizon(%s) = %q, %v, want %q", tt.clientIP, got, ok, tt.want)
+		}
+	}
+}
+
+func TestSplitHorizonAnswers(t *testing.T) {
+	db := useSplitHorizonTestDB(t)
+	if err := database.SetSplitHorizonIPs("deploy-split", []database.SplitHorizonIP{
+		{ClientCIDR: "10.0.0.0/8", IP: "172.16.0.10"},
+		{ClientCIDR: "10.20.0.0/16", IP: "172.16.0.20"},
+	}); err != nil {
+		t.Fatalf("seed split-horizon IPs: %v", err)
+	}
+	cache := memoryCache{}
+	if err := cache.Set(context.Background(), "dns:deployment:deploy-split", []string{"203.0.113.10"}, cacheTTL); err != nil {
+		t.Fatalf("seed cache: %v", err)
+	}
+	server := &DNSServer{db: db, nodeIPMap: map[string][]string{}, redisCache: cache}
+
+	query := func(clientIP string) string {
+		t.Helper()
+		req := new(dns.Msg)
+		req.SetQuestion("deploy-split.my.obiente.cloud.", dns.TypeA)
+		w := &remoteRecordingWriter{remote: &net.UDPAddr{IP: net.ParseIP(clientIP), Port: 5353}}
+		server.handleDNSRequest(w, req)
+		if w.reply == nil || len(w.reply.Answer) != 1 {
+			t.Fatalf("expected one answer for %s, got %v", clientIP, w.reply)
+		}
+		return w.reply.Answer[0].(*dns.A).A.String()
+	}
+
+	tests := []struct {
+		clientIP string
+		want     string
+	}{
+		{"10.5.0.1", "172.16.0.10"},
+		{"10.20.3.4", "172.16.0.20"},
+		{"198.51.100.7", "203.0.113.10"}, // External clients get the Traefik IP
+	}
+	for _, tt := range tests {
+		if got := query(tt.clientIP); got != tt.want {
+			t.Errorf("answer for %s = %s, want %s", tt.clientIP, got, tt.want)
+		}
+	}
+	if cache["dns:split:10.20.3.4/32:deploy-split"] != `{"ip":"172.16.0.20"}` || cache["dns:split:198.51.100.7/32:deploy-split"] != `{"ip":""}` {
+		t.Fatalf("split-horizon results were not cached per client: %v", cache)
+	}
+
+	// Cached answers are served without the database
+	if err := database.SetSplitHorizonIPs("deploy-split", nil); err != nil {
+		t.Fatalf("clear split-horizon IPs: %v", err)
+	}
+	if got := query("10.20.3.4"); got != "172.16.0.20" {
+		t.Errorf("cached answer = %s, want 172.16.0.20", got)
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// useWildcardTestDB points database.DB at a fresh in-memory database for the test
+func useWildcardTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(&database.DeploymentLocation{}, &database.DelegatedDNSRecord{}); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+	previousDB := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = previousDB
+	})
+	return db
+}
+
+func pushARecord(t *testing.T, domain, ip, apiKeyID string) {
+	t.Helper()
+	if err := database.UpsertDelegatedDNSRecordWithAPIKey(domain, "A", `["`+ip+`"]`, "https://self-hosted.example", apiKeyID, "org-"+apiKeyID, 300); err != nil {
+		t.Fatalf("push %s: %v", domain, err)
+	}
+}
+
+func TestWildcardCandidates(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   []string
+	}{
+		{"foo.app.my.obiente.cloud", []string{"*.app.my.obiente.cloud"}},
+		{"a.b.app.my.obiente.cloud", []string{"*.b.app.my.obiente.cloud", "*.app.my.obiente.cloud"}},
+		{"app.my.obiente.cloud", nil},
+		{"foo.example.com", nil},
+	}
+	for _, tt := range tests {
+		if got := wildcardCandidates(tt.domain); !slices.Equal(got, tt.want) {
+			t.Errorf("wildcardCandidates(%s) = %v, want %v", tt.domain, got, tt.want)
+		}
+	}
+}
+
+func TestWildcardRecordPrecedence(t *testing.T) {
+	db := useWildcardTestDB(t)
+	pushARecord(t, "*.App.my.obiente.cloud.", "10.0.0.1", "key-a")
+	pushARecord(t, "*.b.app.my.obiente.cloud", "10.0.0.2", "key-a")
+	pushARecord(t, "exact.app.my.obiente.cloud", "10.0.0.3", "key-a")
+
+	server := &DNSServer{db: db, nodeIPMap: map[string][]string{}, redisCache: memoryCache{}}
+	hitsBefore := metricValue(t, "dns_wildcard_cache_hits_total", nil)
+	missesBefore := metricValue(t, "dns_wildcard_cache_misses_total", nil)
+
+	tests := []struct {
+		name, query, want string
+	}{
+		{"single level", "foo.app.my.obiente.cloud.", "10.0.0.1"},
+		{"single level from cache", "bar.app.my.obiente.cloud.", "10.0.0.1"},
+		{"exact record beats wildcard", "exact.app.my.obiente.cloud.", "10.0.0.3"},
+		{"closest wildcard wins", "x.b.app.my.obiente.cloud.", "10.0.0.2"},
+		{"deeper name under closest wildcard", "y.x.b.app.my.obiente.cloud.", "10.0.0.2"},
+		{"multi level falls back to parent wildcard", "x.c.app.my.obiente.cloud.", "10.0.0.1"},
+		{"wildcard suffix itself", "app.my.obiente.cloud.", ""},
+		{"no zone-wide wildcard", "foo.other.my.obiente.cloud.", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := new(dns.Msg)
+			req.SetQuestion(tt.query, dns.TypeA)
+			w := &recordingWriter{}
+			server.handleDNSRequest(w, req)
+			got := ""
+			if len(w.reply.Answer) > 0 {
+				got = w.reply.Answer[0].(*dns.A).A.String()
+			}
+			if got != tt.want {
+				t.Fatalf("%s resolved to %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+
+	// Misses: *.app (foo), *.b.app (x.b), *.x.b.app (y.x.b), *.c.app (x.c) and *.other.
+	// Hits: *.app (bar and x.c) and *.b.app (y.x.b), cached when first resolved.
+	if got := metricValue(t, "dns_wildcard_cache_hits_total", nil) - hitsBefore; got != 3 {
+		t.Errorf("wildcard cache hits increased by %v, want 3", got)
+	}
+	if got := metricValue(t, "dns_wildcard_cache_misses_total", nil) - missesBefore; got != 5 {
+		t.Errorf("wildcard cache misses increased by %v, want 5", got)
+	}
+}
+
+func TestWildcardRecordBelongsToOneAPIKey(t *testing.T) {
+	db := useWildcardTestDB(t)
+	pushARecord(t, "*.app.my.obiente.cloud", "10.0.0.1", "key-a")
+
+	err := database.UpsertDelegatedDNSRecordWithAPIKey("*.APP.my.obiente.cloud", "A", `["10.0.0.9"]`, "https://other.example", "key-b", "org-key-b", 300)
+	if !errors.Is(err, database.ErrWildcardRecordConflict) {
+		t.Fatalf("other key pushing the wildcard = %v, want %v", err, database.ErrWildcardRecordConflict)
+	}
+	pushARecord(t, "*.app.my.obiente.cloud", "10.0.0.2", "key-a")
+
+	var records []database.DelegatedDNSRecord
+	if err := db.Find(&records).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Domain != "*.app.my.obiente.cloud" || records[0].Records != `["10.0.0.2"]` || records[0].APIKeyID != "key-a" {
+		t.Fatalf("stored records = %+v, want one wildcard refreshed by key-a", records)
+	}
+
+	if err := database.UpsertDelegatedDNSRecordWithAPIKey("*.app.my.obiente.cloud", "SRV", `["0 0 25565 gs-1.my.obiente.cloud"]`, "https://self-hosted.example", "key-a", "org-key-a", 300); err == nil {
+		t.Fatal("wildcard SRV record accepted")
+	}
+	for _, domain := range []string{"*.*.app.my.obiente.cloud", "foo.*.app.my.obiente.cloud", "*."} {
+		if err := database.UpsertDelegatedDNSRecordWithAPIKey(domain, "A", `["10.0.0.1"]`, "https://self-hosted.example", "key-a", "org-key-a", 300); err == nil {
+			t.Fatalf("invalid wildcard domain %q accepted", domain)
+		}
+	}
+
+	// Once the record of key-a has expired, another key can take the wildcard over
+	if err := db.Model(&database.DelegatedDNSRecord{}).Where("domain = ?", "*.app.my.obiente.cloud").Update("expires_at", time.Now().Add(-time.Minute)).Error; err != nil {
+		t.Fatal(err)
+	}
+	pushARecord(t, "*.app.my.obiente.cloud", "10.0.0.9", "key-b")
+}
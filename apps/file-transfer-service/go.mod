@@ -7,6 +7,7 @@ require (
 	github.com/obiente/cloud/apps/shared v0.0.0
 	github.com/pkg/sftp v1.13.10
 	golang.org/x/crypto v0.44.0
+	gorm.io/gorm v1.31.0
 )
 
 require (
@@ -46,7 +47,6 @@ require (
 	golang.org/x/text v0.31.0 // indirect
 	google.golang.org/protobuf v1.36.10 // indirect
 	gorm.io/driver/postgres v1.6.0 // indirect
-	gorm.io/gorm v1.31.0 // indirect
 )
 
 replace github.com/obiente/cloud/apps/shared => ../shared
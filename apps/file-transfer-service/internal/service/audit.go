@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	pkgsftp "github.com/pkg/sftp"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/middleware"
+)
+
+// auditServiceName is the service name SFTP events are recorded under in audit_logs
+const auditServiceName = "FileTransferService"
+
+const (
+	auditActionLogin         = "SFTPLogin"
+	auditActionDownload      = "SFTPDownload"
+	auditActionUpload        = "SFTPUpload"
+	auditActionRename        = "SFTPRename"
+	auditActionRemove        = "SFTPRemove"
+	auditActionRmdir         = "SFTPRmdir"
+	auditActionMkdir         = "SFTPMkdir"
+	auditActionQuotaExceeded = "SFTPQuotaExceeded"
+)
+
+var sftpCmdAuditActions = map[string]string{
+	"Rename": auditActionRename,
+	"Remove": auditActionRemove,
+	"Rmdir":  auditActionRmdir,
+	"Mkdir":  auditActionMkdir,
+}
+
+// auditSFTPEvent records an SFTP operation in the audit log. The write happens in the
+// background so a slow metrics database never stalls a transfer.
+func auditSFTPEvent(session *Session, action string, details map[string]string, err error) {
+	entry := newSFTPAuditEntry(session, action, details, err)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := middleware.CreateAuditLog(ctx, entry); err != nil {
+			logger.Warn("[FileTransfer] Failed to audit %s for credential=%s: %v", action, session.CredentialID, err)
+		}
+	}()
+}
+
+func newSFTPAuditEntry(session *Session, action string, details map[string]string, err error) middleware.AuditEntry {
+	entry := middleware.AuditEntry{
+		UserID:         session.UserID,
+		Action:         action,
+		Service:        auditServiceName,
+		IPAddress:      session.RemoteIP,
+		UserAgent:      session.ClientVersion,
+		ResponseStatus: sftpAuditStatus(err),
+	}
+	if session.OrganizationID != "" {
+		organizationID := session.OrganizationID
+		entry.OrganizationID = &organizationID
+	}
+	if session.ResourceID != "" {
+		resourceType := session.ResourceType
+		resourceID := session.ResourceID
+		entry.ResourceType = &resourceType
+		entry.ResourceID = &resourceID
+	}
+
+	data := map[string]string{"credential_id": session.CredentialID}
+	for key, value := range details {
+		data[key] = value
+	}
+	if encoded, err := json.Marshal(data); err == nil {
+		entry.RequestData = string(encoded)
+	}
+
+	if err != nil {
+		message := err.Error()
+		entry.ErrorMessage = &message
+	}
+	return entry
+}
+
+// sftpAuditStatus maps an SFTP outcome onto the HTTP-style status stored in audit_logs
+func sftpAuditStatus(err error) int32 {
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case errors.Is(err, errQuotaExceeded):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, pkgsftp.ErrSSHFxPermissionDenied), errors.Is(err, os.ErrPermission):
+		return http.StatusForbidden
+	case errors.Is(err, os.ErrNotExist):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+)
+
+func TestSFTPAuditStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int32
+	}{
+		{"success", nil, http.StatusOK},
+		{"quota", errQuotaExceeded, http.StatusRequestEntityTooLarge},
+		{"read denied", errReadDenied, http.StatusForbidden},
+		{"write denied", fmt.Errorf("rename: %w", errWriteDenied), http.StatusForbidden},
+		{"path escape", errPathEscapesRoot, http.StatusForbidden},
+		{"os permission", &os.PathError{Op: "open", Path: "/x", Err: os.ErrPermission}, http.StatusForbidden},
+		{"not found", &os.PathError{Op: "open", Path: "/x", Err: os.ErrNotExist}, http.StatusNotFound},
+		{"untyped message", fmt.Errorf("permission denied"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sftpAuditStatus(tt.err); got != tt.want {
+				t.Fatalf("sftpAuditStatus(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
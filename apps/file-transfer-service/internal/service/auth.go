@@ -1,12 +1,16 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"golang.org/x/crypto/ssh"
+
 	"github.com/obiente/cloud/apps/shared/pkg/database"
 )
 
@@ -25,11 +29,29 @@ type Session struct {
 	ResourceID     string
 	RootPath       string
 	Permissions    []Permission
+	// AllowedPaths restricts the session to these prefixes of RootPath; empty allows all of it
+	AllowedPaths []string
+	// RemoteIP and ClientVersion describe the SSH connection for audit logs
+	RemoteIP      string
+	ClientVersion string
+}
+
+// gameServerUsernamePrefix prefixes the game server ID in SFTP usernames, as shown by gameservers-service
+const gameServerUsernamePrefix = "gs_"
+
+type credentialStore interface {
+	GetActiveBySecret(ctx context.Context, secret string, now time.Time) (*database.FileTransferCredential, error)
+	ListActiveByResource(ctx context.Context, resourceType string, resourceID string, now time.Time) ([]*database.FileTransferCredential, error)
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
+
+type gameServerStore interface {
+	GetByID(ctx context.Context, id string) (*database.GameServer, error)
 }
 
 type Authenticator struct {
-	credentials *database.FileTransferCredentialRepository
-	gameServers *database.GameServerRepository
+	credentials credentialStore
+	gameServers gameServerStore
 	volumeRoot  string
 }
 
@@ -49,7 +71,51 @@ func (a *Authenticator) Authenticate(ctx context.Context, secret string) (*Sessi
 	if err != nil {
 		return nil, fmt.Errorf("invalid credentials")
 	}
+	return a.sessionForCredential(ctx, credential)
+}
+
+// ValidatePublicKey authenticates an SSH public key against the authorized keys of
+// the active credentials of the resource named by username
+func (a *Authenticator) ValidatePublicKey(ctx context.Context, username string, key ssh.PublicKey) (*Session, error) {
+	resourceType, resourceID, ok := resourceFromUsername(username)
+	if !ok {
+		return nil, fmt.Errorf("unknown username %q", username)
+	}
+	credentials, err := a.credentials.ListActiveByResource(ctx, resourceType, resourceID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("list credentials: %w", err)
+	}
+	for _, credential := range credentials {
+		if credentialAuthorizesKey(credential, key) {
+			return a.sessionForCredential(ctx, credential)
+		}
+	}
+	return nil, fmt.Errorf("public key is not authorized")
+}
+
+// resourceFromUsername maps an SFTP username such as "gs_<id>" to its resource
+func resourceFromUsername(username string) (string, string, bool) {
+	if id, ok := strings.CutPrefix(username, gameServerUsernamePrefix); ok && id != "" {
+		return database.FileTransferResourceGameServer, id, true
+	}
+	return "", "", false
+}
+
+func credentialAuthorizesKey(credential *database.FileTransferCredential, key ssh.PublicKey) bool {
+	for _, line := range database.SplitFileTransferAuthorizedKeys(credential.AuthorizedPublicKeys) {
+		authorized, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(authorized.Marshal(), key.Marshal()) {
+			return true
+		}
+	}
+	return false
+}
 
+func (a *Authenticator) sessionForCredential(ctx context.Context, credential *database.FileTransferCredential) (*Session, error) {
+	var err error
 	resourceType := database.NormalizeFileTransferResourceType(credential.ResourceType)
 	var root string
 	switch resourceType {
@@ -73,6 +139,11 @@ func (a *Authenticator) Authenticate(ctx context.Context, secret string) (*Sessi
 		return nil, fmt.Errorf("credential has no file transfer permissions")
 	}
 
+	allowedPaths, err := database.DecodeFileTransferAllowedPaths(credential.AllowedPaths)
+	if err != nil {
+		return nil, err
+	}
+
 	go func() {
 		touchCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -87,6 +158,7 @@ func (a *Authenticator) Authenticate(ctx context.Context, secret string) (*Sessi
 		ResourceID:     credential.ResourceID,
 		RootPath:       root,
 		Permissions:    permissions,
+		AllowedPaths:   allowedPaths,
 	}, nil
 }
 
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pkgsftp "github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+// fakeCredentialStore serves credentials from memory
+type fakeCredentialStore struct {
+	credentials []*database.FileTransferCredential
+}
+
+func (f *fakeCredentialStore) GetActiveBySecret(_ context.Context, secret string, _ time.Time) (*database.FileTransferCredential, error) {
+	hash := database.HashFileTransferSecret(secret)
+	for _, credential := range f.credentials {
+		if credential.KeyHash == hash {
+			return credential, nil
+		}
+	}
+	return nil, fmt.Errorf("not found")
+}
+
+func (f *fakeCredentialStore) ListActiveByResource(_ context.Context, resourceType string, resourceID string, _ time.Time) ([]*database.FileTransferCredential, error) {
+	var out []*database.FileTransferCredential
+	for _, credential := range f.credentials {
+		if credential.ResourceType == resourceType && credential.ResourceID == resourceID {
+			out = append(out, credential)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeCredentialStore) TouchLastUsed(context.Context, string, time.Time) error { return nil }
+
+type fakeGameServerStore map[string]*database.GameServer
+
+func (f fakeGameServerStore) GetByID(_ context.Context, id string) (*database.GameServer, error) {
+	if gameServer, ok := f[id]; ok {
+		return gameServer, nil
+	}
+	return nil, fmt.Errorf("not found")
+}
+
+func TestSFTPPasswordAndPublicKeyAuth(t *testing.T) {
+	authorized := newTestSigner(t)
+	addr, volumeRoot := startAuthTestServer(t, authorizedKeyLine(authorized)+" ci@example\n")
+	if err := os.WriteFile(filepath.Join(volumeRoot, "gameserver-gs-1-data", "server.properties"), []byte("motd=hi"), 0o640); err != nil {
+		t.Fatal(err)
+	}
+
+	methods := map[string]ssh.AuthMethod{
+		"password":  ssh.Password("secret-1"),
+		"publickey": ssh.PublicKeys(authorized),
+	}
+	for name, method := range methods {
+		t.Run(name, func(t *testing.T) {
+			client, err := dialSFTP(addr, "gs_gs-1", method)
+			if err != nil {
+				t.Fatalf("dial: %v", err)
+			}
+			defer client.Close()
+
+			file, err := client.Open("/server.properties")
+			if err != nil {
+				t.Fatalf("open: %v", err)
+			}
+			defer file.Close()
+			data, err := io.ReadAll(file)
+			if err != nil || string(data) != "motd=hi" {
+				t.Fatalf("read = %q, %v, want the game server's file", data, err)
+			}
+		})
+	}
+}
+
+func TestSFTPPublicKeyAuthRejects(t *testing.T) {
+	authorized := newTestSigner(t)
+	addr, _ := startAuthTestServer(t, authorizedKeyLine(authorized))
+
+	tests := []struct {
+		name     string
+		username string
+		signer   ssh.Signer
+	}{
+		{"unknown key", "gs_gs-1", newTestSigner(t)},
+		{"other game server", "gs_gs-2", authorized},
+		{"username without resource", "ci", authorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if client, err := dialSFTP(addr, tt.username, ssh.PublicKeys(tt.signer)); err == nil {
+				client.Close()
+				t.Fatalf("login as %q succeeded, want authentication failure", tt.username)
+			}
+		})
+	}
+}
+
+func TestValidatePublicKeySkipsMalformedLines(t *testing.T) {
+	signer := newTestSigner(t)
+	volumeRoot := t.TempDir()
+	if err := os.Mkdir(filepath.Join(volumeRoot, "gameserver-gs-1-data"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	authenticator := &Authenticator{
+		credentials: &fakeCredentialStore{credentials: []*database.FileTransferCredential{{
+			ID:                   "cred-1",
+			OrganizationID:       "org-1",
+			ResourceType:         database.FileTransferResourceGameServer,
+			ResourceID:           "gs-1",
+			Scopes:               database.FileTransferScopeRead,
+			AuthorizedPublicKeys: "# laptop\nssh-ed25519 not-base64\n\n" + authorizedKeyLine(signer),
+		}}},
+		gameServers: fakeGameServerStore{"gs-1": {ID: "gs-1", OrganizationID: "org-1"}},
+		volumeRoot:  volumeRoot,
+	}
+
+	session, err := authenticator.ValidatePublicKey(context.Background(), "gs_gs-1", signer.PublicKey())
+	if err != nil {
+		t.Fatalf("ValidatePublicKey: %v", err)
+	}
+	if session.CredentialID != "cred-1" || len(session.Permissions) != 1 || session.Permissions[0] != PermissionRead {
+		t.Fatalf("session = %+v, want the read-only credential", session)
+	}
+}
+
+// startAuthTestServer serves SFTP on a loopback port for game server gs-1 with one read-write
+// credential whose password is "secret-1" and whose authorized keys are authorizedKeys
+func startAuthTestServer(t *testing.T, authorizedKeys string) (string, string) {
+	t.Helper()
+
+	volumeRoot := t.TempDir()
+	for _, id := range []string{"gs-1", "gs-2"} {
+		if err := os.Mkdir(filepath.Join(volumeRoot, "gameserver-"+id+"-data"), 0o750); err != nil {
+			t.Fatal(err)
+		}
+	}
+	authenticator := &Authenticator{
+		credentials: &fakeCredentialStore{credentials: []*database.FileTransferCredential{{
+			ID:                   "cred-1",
+			UserID:               "user-1",
+			OrganizationID:       "org-1",
+			ResourceType:         database.FileTransferResourceGameServer,
+			ResourceID:           "gs-1",
+			KeyHash:              database.HashFileTransferSecret("secret-1"),
+			Scopes:               database.FileTransferScopeRead + "," + database.FileTransferScopeWrite,
+			AuthorizedPublicKeys: authorizedKeys,
+		}}},
+		gameServers: fakeGameServerStore{
+			"gs-1": {ID: "gs-1", OrganizationID: "org-1"},
+			"gs-2": {ID: "gs-2", OrganizationID: "org-1"},
+		},
+		volumeRoot: volumeRoot,
+	}
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostKeyPath := filepath.Join(t.TempDir(), "ssh_host_key")
+	if err := os.WriteFile(hostKeyPath, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	server, err := NewSFTPServer("127.0.0.1:0", hostKeyPath, authenticator, nil)
+	if err != nil {
+		t.Fatalf("NewSFTPServer: %v", err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = server.serve(listener) }()
+	t.Cleanup(func() { _ = server.Shutdown() })
+	return listener.Addr().String(), volumeRoot
+}
+
+// sftpTestClient closes the SFTP client together with its SSH connection
+type sftpTestClient struct {
+	*pkgsftp.Client
+	conn *ssh.Client
+}
+
+func (c *sftpTestClient) Close() error {
+	_ = c.Client.Close()
+	return c.conn.Close()
+}
+
+func dialSFTP(addr, username string, method ssh.AuthMethod) (*sftpTestClient, error) {
+	conn, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User:            username,
+		Auth:            []ssh.AuthMethod{method},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	client, err := pkgsftp.NewClient(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &sftpTestClient{Client: client, conn: conn}, nil
+}
+
+func newTestSigner(t *testing.T) ssh.Signer {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+func authorizedKeyLine(signer ssh.Signer) string {
+	line := ssh.MarshalAuthorizedKey(signer.PublicKey())
+	return string(line[:len(line)-1])
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	pkgsftp "github.com/pkg/sftp"
+	"gorm.io/gorm"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+const (
+	// sshFxQuotaExceeded is SSH_FX_QUOTA_EXCEEDED from draft-ietf-secsh-filexfer-13
+	sshFxQuotaExceeded = 15
+
+	quotaKeyPrefix = "file-transfer:sftp-bytes:"
+)
+
+// errQuotaExceeded is reported to SFTP clients with the SSH_FX_QUOTA_EXCEEDED status code
+var errQuotaExceeded = fmt.Errorf("daily SFTP upload quota exceeded: %w", (&pkgsftp.StatusError{Code: sshFxQuotaExceeded}).FxCode())
+
+type quotaCounter interface {
+	IncrementBy(ctx context.Context, key string, value int64) (int64, error)
+	ScanPattern(ctx context.Context, pattern string, count int64) ([]string, error)
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// QuotaEnforcer limits how many bytes can be uploaded over SFTP to each resource per day.
+// Running totals are kept in Redis and the limit comes from the organization's quota
+// (org_quotas.max_sftp_bytes_per_resource); organizations without a limit are not tracked.
+type QuotaEnforcer struct {
+	counter      quotaCounter
+	loadLimit    func(ctx context.Context, organizationID string) (int64, error)
+	recordBreach func(session *Session, path string, used, limit int64)
+}
+
+// NewQuotaEnforcer returns nil when Redis is not available; a nil enforcer allows all writes.
+func NewQuotaEnforcer(cache *database.RedisCache) *QuotaEnforcer {
+	if cache == nil {
+		logger.Warn("[FileTransfer] Redis unavailable, SFTP upload quotas are not enforced")
+		return nil
+	}
+	return &QuotaEnforcer{
+		counter:      cache,
+		loadLimit:    loadSFTPByteLimit,
+		recordBreach: auditQuotaBreach,
+	}
+}
+
+// Wrap counts the bytes written through w against the session's resource.
+func (e *QuotaEnforcer) Wrap(ctx context.Context, session *Session, path string, w io.WriterAt) io.WriterAt {
+	if e == nil {
+		return w
+	}
+	limit, err := e.loadLimit(ctx, session.OrganizationID)
+	if err != nil {
+		logger.Warn("[FileTransfer] Failed to load SFTP quota for org %s: %v", session.OrganizationID, err)
+		return w
+	}
+	if limit <= 0 {
+		return w
+	}
+	return &quotaWriter{
+		enforcer: e,
+		ctx:      ctx,
+		session:  session,
+		path:     path,
+		limit:    limit,
+		w:        w,
+	}
+}
+
+// ResetCounters clears every resource's running total.
+func (e *QuotaEnforcer) ResetCounters(ctx context.Context) error {
+	keys, err := e.counter.ScanPattern(ctx, quotaKeyPrefix+"*", 0)
+	if err != nil {
+		return fmt.Errorf("scan SFTP quota counters: %w", err)
+	}
+	if err := e.counter.Delete(ctx, keys...); err != nil {
+		return fmt.Errorf("delete SFTP quota counters: %w", err)
+	}
+	return nil
+}
+
+// StartDailyReset resets the counters at every UTC midnight until ctx is done.
+func (e *QuotaEnforcer) StartDailyReset(ctx context.Context) {
+	if e == nil {
+		return
+	}
+	for {
+		now := time.Now().UTC()
+		next := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+		timer := time.NewTimer(next.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			if err := e.ResetCounters(ctx); err != nil {
+				logger.Warn("[FileTransfer] Failed to reset SFTP quota counters: %v", err)
+				continue
+			}
+			logger.Info("[FileTransfer] Reset daily SFTP quota counters")
+		}
+	}
+}
+
+// reserve adds n bytes to the resource's total, undoing the increment if it goes over limit
+func (e *QuotaEnforcer) reserve(ctx context.Context, session *Session, n, limit int64) (used int64, ok bool, err error) {
+	key := quotaKey(session)
+	used, err = e.counter.IncrementBy(ctx, key, n)
+	if err != nil {
+		return 0, false, err
+	}
+	if used <= limit {
+		return used, true, nil
+	}
+	if _, err := e.counter.IncrementBy(ctx, key, -n); err != nil {
+		logger.Warn("[FileTransfer] Failed to release rejected SFTP bytes for %s: %v", key, err)
+	}
+	return used - n, false, nil
+}
+
+func quotaKey(session *Session) string {
+	return quotaKeyPrefix + session.ResourceType + ":" + session.ResourceID
+}
+
+func loadSFTPByteLimit(ctx context.Context, organizationID string) (int64, error) {
+	var quota database.OrgQuota
+	err := database.DB.WithContext(ctx).Where("organization_id = ?", organizationID).First(&quota).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if quota.MaxSFTPBytesPerResource == nil {
+		return 0, nil
+	}
+	return *quota.MaxSFTPBytesPerResource, nil
+}
+
+func auditQuotaBreach(session *Session, path string, used, limit int64) {
+	auditSFTPEvent(session, auditActionQuotaExceeded, map[string]string{
+		"path":        path,
+		"used_bytes":  strconv.FormatInt(used, 10),
+		"limit_bytes": strconv.FormatInt(limit, 10),
+	}, errQuotaExceeded)
+}
+
+type quotaWriter struct {
+	enforcer *QuotaEnforcer
+	ctx      context.Context
+	session  *Session
+	path     string
+	limit    int64
+	w        io.WriterAt
+
+	mu sync.Mutex
+	// written is the file's high-water mark; rewriting bytes below it is not charged again
+	written int64
+	// breached keeps a run of rejected writes to one log line and audit entry
+	breached bool
+}
+
+func (q *quotaWriter) WriteAt(p []byte, off int64) (int, error) {
+	q.mu.Lock()
+	end := off + int64(len(p))
+	charge := end - max(off, q.written)
+	if charge > 0 {
+		used, ok, err := q.enforcer.reserve(q.ctx, q.session, charge, q.limit)
+		if err != nil {
+			// Fail open: an unavailable counter must not block uploads
+			logger.Warn("[FileTransfer] Failed to count SFTP bytes for %s:%s: %v", q.session.ResourceType, q.session.ResourceID, err)
+		} else if !ok {
+			first := !q.breached
+			q.breached = true
+			q.mu.Unlock()
+			if first {
+				logger.Warn("[FileTransfer] SFTP quota exceeded for %s:%s by credential=%s (%d of %d bytes)",
+					q.session.ResourceType, q.session.ResourceID, q.session.CredentialID, used, q.limit)
+				q.enforcer.recordBreach(q.session, q.path, used, q.limit)
+			}
+			return 0, errQuotaExceeded
+		}
+		q.written = end
+		q.breached = false
+	}
+	q.mu.Unlock()
+	return q.w.WriteAt(p, off)
+}
+
+func (q *quotaWriter) Close() error {
+	if c, ok := q.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
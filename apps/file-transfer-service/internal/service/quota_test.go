@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	pkgsftp "github.com/pkg/sftp"
+)
+
+type memoryCounter struct {
+	mu     sync.Mutex
+	values map[string]int64
+}
+
+func (m *memoryCounter) IncrementBy(_ context.Context, key string, value int64) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values[key] += value
+	return m.values[key], nil
+}
+
+func (m *memoryCounter) ScanPattern(_ context.Context, pattern string, _ int64) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []string
+	for key := range m.values {
+		if strings.HasPrefix(key, strings.TrimSuffix(pattern, "*")) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (m *memoryCounter) Delete(_ context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		delete(m.values, key)
+	}
+	return nil
+}
+
+func TestQuotaEnforcerRejectsWritesOverQuota(t *testing.T) {
+	root := t.TempDir()
+	counter := &memoryCounter{values: make(map[string]int64)}
+	var breaches int
+	enforcer := &QuotaEnforcer{
+		counter:   counter,
+		loadLimit: func(context.Context, string) (int64, error) { return 10, nil },
+		recordBreach: func(*Session, string, int64, int64) {
+			breaches++
+		},
+	}
+	session := &Session{
+		OrganizationID: "org-1",
+		ResourceType:   "gameserver",
+		ResourceID:     "gs-1",
+		RootPath:       root,
+		Permissions:    []Permission{PermissionWrite},
+	}
+	handler := newSFTPHandler(session, enforcer)
+
+	writer, err := handler.Filewrite(pkgsftp.NewRequest("Put", "/world.dat"))
+	if err != nil {
+		t.Fatalf("Filewrite returned error: %v", err)
+	}
+	if _, err := writer.WriteAt([]byte("0123456789"), 0); err != nil {
+		t.Fatalf("write within quota failed: %v", err)
+	}
+	if _, err := writer.WriteAt([]byte("x"), 10); !errors.Is(err, errQuotaExceeded) {
+		t.Fatalf("write over quota error = %v, want SSH_FX_QUOTA_EXCEEDED", err)
+	}
+	if _, err := writer.WriteAt([]byte("y"), 10); !errors.Is(err, errQuotaExceeded) {
+		t.Fatalf("later write error = %v, want SSH_FX_QUOTA_EXCEEDED", err)
+	}
+	if breaches != 1 {
+		t.Fatalf("recorded %d breaches, want 1", breaches)
+	}
+	// Rewriting bytes already uploaded does not count against the quota again
+	if _, err := writer.WriteAt([]byte("abc"), 0); err != nil {
+		t.Fatalf("rewrite below the high-water mark failed: %v", err)
+	}
+	if used := counter.values[quotaKey(session)]; used != 10 {
+		t.Fatalf("counted %d bytes, want 10", used)
+	}
+
+	// The counter is checked again on every write, so the same transfer resumes after a reset
+	if err := enforcer.ResetCounters(context.Background()); err != nil {
+		t.Fatalf("ResetCounters returned error: %v", err)
+	}
+	if _, err := writer.WriteAt([]byte("z"), 10); err != nil {
+		t.Fatalf("write after reset failed: %v", err)
+	}
+	if c, ok := writer.(interface{ Close() error }); ok {
+		_ = c.Close()
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, "world.dat"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "abc3456789z" {
+		t.Fatalf("file content = %q, want only the accepted writes", data)
+	}
+	if used := counter.values[quotaKey(session)]; used != 1 {
+		t.Fatalf("counted %d bytes after reset, want 1", used)
+	}
+}
+
+func TestQuotaWriterChargesOnlyNewBytes(t *testing.T) {
+	counter := &memoryCounter{values: make(map[string]int64)}
+	enforcer := &QuotaEnforcer{
+		counter:      counter,
+		loadLimit:    func(context.Context, string) (int64, error) { return 100, nil },
+		recordBreach: func(*Session, string, int64, int64) {},
+	}
+	session := &Session{ResourceType: "vps", ResourceID: "vps-1"}
+	f, err := os.Create(filepath.Join(t.TempDir(), "disk.img"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	writer := enforcer.Wrap(context.Background(), session, "/disk.img", f)
+
+	writes := []struct {
+		data string
+		off  int64
+		want int64
+	}{
+		{"0123456789", 0, 10},
+		{"abcde", 0, 10},  // entirely below the high-water mark
+		{"vwxyz", 8, 13},  // overlaps the last two bytes
+		{"hole", 20, 24},  // past a gap: the gap is counted too
+		{"again", 20, 25}, // extends the mark by one byte
+	}
+	for _, w := range writes {
+		if _, err := writer.WriteAt([]byte(w.data), w.off); err != nil {
+			t.Fatalf("WriteAt(%q, %d) error = %v", w.data, w.off, err)
+		}
+		if used := counter.values[quotaKey(session)]; used != w.want {
+			t.Fatalf("after WriteAt(%q, %d) counted %d bytes, want %d", w.data, w.off, used, w.want)
+		}
+	}
+}
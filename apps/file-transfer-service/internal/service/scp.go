@@ -0,0 +1,464 @@
+package service
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	pkgsftp "github.com/pkg/sftp"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+// scpArgs is the part of a remote "scp -t|-f path" command the server acts on
+type scpArgs struct {
+	sink      bool // -t: the client uploads to path
+	recursive bool // -r
+	preserve  bool // -p: send and apply modification times
+	targetDir bool // -d: path must be a directory
+	path      string
+}
+
+// parseSCPCommand accepts the commands an scp client runs on the remote side, e.g. "scp -t -- uploads/"
+func parseSCPCommand(command string) (scpArgs, bool) {
+	fields := strings.Fields(command)
+	if len(fields) < 3 || fields[0] != "scp" {
+		return scpArgs{}, false
+	}
+
+	var args scpArgs
+	var to, from bool
+	i := 1
+	for ; i < len(fields) && strings.HasPrefix(fields[i], "-"); i++ {
+		if fields[i] == "--" {
+			i++
+			break
+		}
+		for _, flag := range fields[i][1:] {
+			switch flag {
+			case 't':
+				to = true
+			case 'f':
+				from = true
+			case 'r':
+				args.recursive = true
+			case 'p':
+				args.preserve = true
+			case 'd':
+				args.targetDir = true
+			case 'v', 'q':
+			default:
+				return scpArgs{}, false
+			}
+		}
+	}
+	// The path may contain spaces; scp sends it unquoted as the rest of the command
+	if to == from || i >= len(fields) {
+		return scpArgs{}, false
+	}
+	args.sink = to
+	args.path = strings.Join(fields[i:], " ")
+	return args, true
+}
+
+// scpSession speaks the sink (-t) or source (-f) side of the SCP protocol over an exec channel.
+// Every file operation goes through the session's sftpHandler, so SCP transfers get the same
+// permission checks, path restrictions, upload quota and audit entries as SFTP.
+type scpSession struct {
+	in      *bufio.Reader
+	out     io.Writer
+	handler *sftpHandler
+	args    scpArgs
+	// failed is set when a file was skipped, which makes the command exit with status 1
+	failed bool
+}
+
+func newSCPSession(channel io.ReadWriter, handler *sftpHandler, args scpArgs) *scpSession {
+	return &scpSession{
+		in:      bufio.NewReader(channel),
+		out:     channel,
+		handler: handler,
+		args:    args,
+	}
+}
+
+// Run transfers the files and returns the command's exit status
+func (s *scpSession) Run() uint32 {
+	var err error
+	if s.args.sink {
+		err = s.runSink()
+	} else {
+		err = s.runSource()
+	}
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			logger.Warn("[FileTransfer] SCP session failed for credential=%s: %v", s.handler.session.CredentialID, err)
+			s.sendError(2, err)
+		}
+		return 1
+	}
+	if s.failed {
+		return 1
+	}
+	return 0
+}
+
+// runSink receives C (file), D/E (enter/leave directory) and T (times) records from the client
+func (s *scpSession) runSink() error {
+	if !hasPermission(s.handler.session.Permissions, PermissionWrite) {
+		auditSFTPEvent(s.handler.session, auditActionUpload, scpAuditDetails(s.args.path), errWriteDenied)
+		return errWriteDenied
+	}
+
+	targetIsDir := s.isDir(s.args.path)
+	if s.args.targetDir && !targetIsDir {
+		return fmt.Errorf("%s: not a directory", s.args.path)
+	}
+
+	dir := s.args.path
+	depth := 0
+	var times *[2]time.Time
+	if err := s.ack(); err != nil {
+		return err
+	}
+	for {
+		line, err := s.in.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) && line == "" {
+				return nil
+			}
+			return err
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return fmt.Errorf("empty SCP record")
+		}
+
+		switch line[0] {
+		case 'T':
+			parsed, err := parseSCPTimes(line[1:])
+			if err != nil {
+				return err
+			}
+			times = parsed
+			if err := s.ack(); err != nil {
+				return err
+			}
+		case 'C':
+			_, size, name, err := parseSCPRecord(line[1:])
+			if err != nil {
+				return err
+			}
+			target := dir
+			if depth > 0 || targetIsDir {
+				target = path.Join(dir, name)
+			}
+			if err := s.receiveFile(target, size, times); err != nil {
+				return err
+			}
+			times = nil
+		case 'D':
+			if !s.args.recursive {
+				return fmt.Errorf("received directory without -r")
+			}
+			_, _, name, err := parseSCPRecord(line[1:])
+			if err != nil {
+				return err
+			}
+			if depth > 0 || targetIsDir {
+				dir = path.Join(dir, name)
+			}
+			depth++
+			if err := s.handler.Filecmd(pkgsftp.NewRequest("Mkdir", dir)); err != nil {
+				return err
+			}
+			times = nil
+			if err := s.ack(); err != nil {
+				return err
+			}
+		case 'E':
+			if depth == 0 {
+				return fmt.Errorf("unexpected end of directory")
+			}
+			depth--
+			if depth > 0 || targetIsDir {
+				dir = path.Dir(dir)
+			}
+			if err := s.ack(); err != nil {
+				return err
+			}
+		case 1, 2:
+			logger.Warn("[FileTransfer] SCP client reported an error for credential=%s: %s", s.handler.session.CredentialID, line[1:])
+			if line[0] == 2 {
+				return io.EOF
+			}
+		default:
+			return fmt.Errorf("unknown SCP record %q", line[0])
+		}
+	}
+}
+
+// receiveFile writes one file of a C record. Once the client has started sending the data, a
+// failed write still reads it to the end so the stream stays in sync, and the client is warned.
+func (s *scpSession) receiveFile(target string, size int64, times *[2]time.Time) error {
+	writer, err := s.handler.Filewrite(pkgsftp.NewRequest("Put", target))
+	if err != nil {
+		s.failed = true
+		s.sendError(1, fmt.Errorf("%s: %w", target, err))
+		return nil
+	}
+	defer closeWriter(writer)
+	if err := s.ack(); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 32*1024)
+	var offset int64
+	var writeErr error
+	for offset < size {
+		n, err := s.in.Read(buf[:min(int64(len(buf)), size-offset)])
+		if n > 0 && writeErr == nil {
+			_, writeErr = writer.WriteAt(buf[:n], offset)
+		}
+		offset += int64(n)
+		if err != nil && offset < size {
+			if errors.Is(err, io.EOF) {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+	}
+	if err := s.readAck(); err != nil {
+		return err
+	}
+
+	if writeErr != nil {
+		s.failed = true
+		s.sendError(1, fmt.Errorf("%s: %w", target, writeErr))
+		return nil
+	}
+	if times != nil && s.args.preserve {
+		if resolved, err := s.handler.resolvePath(target); err == nil {
+			_ = os.Chtimes(resolved, times[1], times[0])
+		}
+	}
+	return s.ack()
+}
+
+// runSource sends the requested file, or directory tree with -r, to the client
+func (s *scpSession) runSource() error {
+	if err := s.readAck(); err != nil {
+		return err
+	}
+	return s.sendPath(s.args.path)
+}
+
+func (s *scpSession) sendPath(requestPath string) error {
+	info, err := s.stat(requestPath)
+	if err != nil {
+		auditSFTPEvent(s.handler.session, auditActionDownload, scpAuditDetails(requestPath), err)
+		return s.skip(requestPath, err)
+	}
+	if info.IsDir() {
+		if !s.args.recursive {
+			return s.skip(requestPath, fmt.Errorf("not a regular file"))
+		}
+		return s.sendDir(requestPath, info)
+	}
+	if !info.Mode().IsRegular() {
+		return s.skip(requestPath, fmt.Errorf("not a regular file"))
+	}
+	return s.sendFile(requestPath, info)
+}
+
+func (s *scpSession) sendDir(requestPath string, info os.FileInfo) error {
+	lister, err := s.handler.Filelist(pkgsftp.NewRequest("List", requestPath))
+	if err != nil {
+		return s.skip(requestPath, err)
+	}
+	entries := lister.(listerAt)
+
+	if err := s.sendTimes(info); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(s.out, "D%04o 0 %s\n", info.Mode().Perm(), info.Name()); err != nil {
+		return err
+	}
+	if err := s.readAck(); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := s.sendPath(path.Join(requestPath, entry.Name())); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(s.out, "E\n"); err != nil {
+		return err
+	}
+	return s.readAck()
+}
+
+func (s *scpSession) sendFile(requestPath string, info os.FileInfo) error {
+	reader, err := s.handler.Fileread(pkgsftp.NewRequest("Get", requestPath))
+	if err != nil {
+		return s.skip(requestPath, err)
+	}
+	if c, ok := reader.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	if err := s.sendTimes(info); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(s.out, "C%04o %d %s\n", info.Mode().Perm(), info.Size(), info.Name()); err != nil {
+		return err
+	}
+	if err := s.readAck(); err != nil {
+		return err
+	}
+	// A file that shrinks while it is sent is padded so the client still gets the announced size
+	copied, err := io.Copy(s.out, io.NewSectionReader(reader, 0, info.Size()))
+	if err != nil {
+		return err
+	}
+	if copied < info.Size() {
+		if _, err := io.CopyN(s.out, zeroReader{}, info.Size()-copied); err != nil {
+			return err
+		}
+		s.failed = true
+		s.sendError(1, fmt.Errorf("%s: file truncated while reading", requestPath))
+	} else if err := s.ack(); err != nil {
+		return err
+	}
+	return s.readAck()
+}
+
+func (s *scpSession) sendTimes(info os.FileInfo) error {
+	if !s.args.preserve {
+		return nil
+	}
+	mtime := info.ModTime().Unix()
+	if _, err := fmt.Fprintf(s.out, "T%d 0 %d 0\n", mtime, mtime); err != nil {
+		return err
+	}
+	return s.readAck()
+}
+
+// skip reports a file that cannot be sent and moves on to the next one
+func (s *scpSession) skip(requestPath string, err error) error {
+	s.failed = true
+	s.sendError(1, fmt.Errorf("%s: %w", requestPath, err))
+	return nil
+}
+
+func (s *scpSession) stat(requestPath string) (os.FileInfo, error) {
+	lister, err := s.handler.Filelist(pkgsftp.NewRequest("Stat", requestPath))
+	if err != nil {
+		return nil, err
+	}
+	return lister.(listerAt)[0], nil
+}
+
+func (s *scpSession) isDir(requestPath string) bool {
+	resolved, err := s.handler.resolvePath(requestPath)
+	if err != nil {
+		return false
+	}
+	info, err := os.Stat(resolved)
+	return err == nil && info.IsDir()
+}
+
+func (s *scpSession) ack() error {
+	_, err := s.out.Write([]byte{0})
+	return err
+}
+
+// readAck reads the peer's response to the last record: 0 is success, 1 a warning and 2 a fatal error
+func (s *scpSession) readAck() error {
+	code, err := s.in.ReadByte()
+	if err != nil {
+		return err
+	}
+	if code == 0 {
+		return nil
+	}
+	message, _ := s.in.ReadString('\n')
+	message = strings.TrimSuffix(message, "\n")
+	if code == 1 {
+		logger.Warn("[FileTransfer] SCP client reported an error for credential=%s: %s", s.handler.session.CredentialID, message)
+		return nil
+	}
+	return fmt.Errorf("%w: %s", io.EOF, message)
+}
+
+// sendError reports err to the client without the host paths of *os.PathError
+func (s *scpSession) sendError(code byte, err error) {
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		err = errors.New(strings.Replace(err.Error(), pathErr.Error(), pathErr.Err.Error(), 1))
+	}
+	message := strings.ReplaceAll(err.Error(), "\n", " ")
+	_, _ = fmt.Fprintf(s.out, "%cscp: %s\n", code, message)
+}
+
+// parseSCPRecord parses the "mode size name" of C and D records
+func parseSCPRecord(record string) (mode os.FileMode, size int64, name string, err error) {
+	parts := strings.SplitN(record, " ", 3)
+	if len(parts) != 3 {
+		return 0, 0, "", fmt.Errorf("malformed SCP record %q", record)
+	}
+	parsedMode, err := strconv.ParseUint(parts[0], 8, 32)
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("invalid SCP file mode %q", parts[0])
+	}
+	size, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || size < 0 {
+		return 0, 0, "", fmt.Errorf("invalid SCP file size %q", parts[1])
+	}
+	// Names are single path components; anything else would let a client write outside the target
+	name = parts[2]
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, "/\\") {
+		return 0, 0, "", fmt.Errorf("invalid SCP file name %q", name)
+	}
+	return os.FileMode(parsedMode), size, name, nil
+}
+
+// parseSCPTimes parses "mtime 0 atime 0" into modification and access times
+func parseSCPTimes(record string) (*[2]time.Time, error) {
+	parts := strings.Fields(record)
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("malformed SCP times %q", record)
+	}
+	mtime, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SCP modification time %q", parts[0])
+	}
+	atime, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SCP access time %q", parts[2])
+	}
+	return &[2]time.Time{time.Unix(mtime, 0), time.Unix(atime, 0)}, nil
+}
+
+func scpAuditDetails(requestPath string) map[string]string {
+	return map[string]string{"path": requestPath, "protocol": "scp"}
+}
+
+func closeWriter(w io.WriterAt) {
+	if c, ok := w.(io.Closer); ok {
+		_ = c.Close()
+	}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
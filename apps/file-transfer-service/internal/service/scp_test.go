@@ -0,0 +1,237 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSCPUploadAndDownload(t *testing.T) {
+	root := t.TempDir()
+	session := &Session{
+		CredentialID:   "cred-1",
+		OrganizationID: "org-1",
+		ResourceType:   "gameserver",
+		ResourceID:     "gs-1",
+		RootPath:       root,
+		Permissions:    []Permission{PermissionRead, PermissionWrite},
+		AllowedPaths:   []string{"uploads"},
+	}
+	client := startSCPTestServer(t, session, nil)
+
+	data := make([]byte, 1<<20)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(root, "uploads"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := scpUpload(client, "uploads", "world.bin", data); err != nil {
+		t.Fatalf("scp upload: %v", err)
+	}
+	stored, err := os.ReadFile(filepath.Join(root, "uploads", "world.bin"))
+	if err != nil {
+		t.Fatalf("read uploaded file: %v", err)
+	}
+	if !bytes.Equal(stored, data) {
+		t.Fatalf("uploaded file differs: got %d bytes, want %d", len(stored), len(data))
+	}
+
+	downloaded, err := scpDownload(client, "uploads/world.bin")
+	if err != nil {
+		t.Fatalf("scp download: %v", err)
+	}
+	if !bytes.Equal(downloaded, data) {
+		t.Fatalf("downloaded file differs: got %d bytes, want %d", len(downloaded), len(data))
+	}
+
+	// Paths outside the credential's allowed paths are rejected like over SFTP
+	if err := scpUpload(client, "config.yml", "config.yml", []byte("x")); err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Fatalf("upload outside allowed paths error = %v, want permission denied", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "config.yml")); !os.IsNotExist(err) {
+		t.Fatalf("file outside allowed paths was written: %v", err)
+	}
+}
+
+func TestSCPUploadEnforcesQuota(t *testing.T) {
+	root := t.TempDir()
+	session := &Session{
+		CredentialID:   "cred-1",
+		OrganizationID: "org-1",
+		ResourceType:   "gameserver",
+		ResourceID:     "gs-1",
+		RootPath:       root,
+		Permissions:    []Permission{PermissionWrite},
+	}
+	var breaches atomic.Int32
+	enforcer := &QuotaEnforcer{
+		counter:      &memoryCounter{values: make(map[string]int64)},
+		loadLimit:    func(context.Context, string) (int64, error) { return 512 << 10, nil },
+		recordBreach: func(*Session, string, int64, int64) { breaches.Add(1) },
+	}
+	client := startSCPTestServer(t, session, enforcer)
+
+	err := scpUpload(client, "world.bin", "world.bin", make([]byte, 1<<20))
+	if err == nil || !strings.Contains(err.Error(), "quota exceeded") {
+		t.Fatalf("upload over quota error = %v, want quota exceeded", err)
+	}
+	if got := breaches.Load(); got != 1 {
+		t.Fatalf("recorded %d breaches, want 1", got)
+	}
+}
+
+// startSCPTestServer serves SSH on a loopback port, authenticating the password "secret" as session
+func startSCPTestServer(t *testing.T, session *Session, quota *QuotaEnforcer) *ssh.Client {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostKeyPath := filepath.Join(t.TempDir(), "ssh_host_key")
+	if err := os.WriteFile(hostKeyPath, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	server, err := NewSFTPServer("127.0.0.1:0", hostKeyPath, &Authenticator{}, quota)
+	if err != nil {
+		t.Fatalf("NewSFTPServer: %v", err)
+	}
+	server.config.PasswordCallback = func(_ ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+		if string(password) != "secret" {
+			return nil, fmt.Errorf("authentication failed")
+		}
+		return sessionPermissions(session), nil
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = server.serve(listener) }()
+	t.Cleanup(func() { _ = server.Shutdown() })
+
+	client, err := ssh.Dial("tcp", listener.Addr().String(), &ssh.ClientConfig{
+		User:            "ci",
+		Auth:            []ssh.AuthMethod{ssh.Password("secret")},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+// scpUpload runs the client side of "scp file host:target" for a single file
+func scpUpload(client *ssh.Client, target, name string, data []byte) error {
+	session, stdin, stdout, err := startSCPCommand(client, "scp -t "+target)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	if err := readSCPAck(stdout); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(stdin, "C0644 %d %s\n", len(data), name); err != nil {
+		return err
+	}
+	if err := readSCPAck(stdout); err != nil {
+		return err
+	}
+	if _, err := stdin.Write(append(data, 0)); err != nil {
+		return err
+	}
+	if err := readSCPAck(stdout); err != nil {
+		return err
+	}
+	_ = stdin.Close()
+	return session.Wait()
+}
+
+// scpDownload runs the client side of "scp host:source file" for a single file
+func scpDownload(client *ssh.Client, source string) ([]byte, error) {
+	session, stdin, stdout, err := startSCPCommand(client, "scp -f "+source)
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	if _, err := stdin.Write([]byte{0}); err != nil {
+		return nil, err
+	}
+	header, err := stdout.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	var mode, name string
+	var size int
+	if _, err := fmt.Sscanf(header, "C%s %d %s\n", &mode, &size, &name); err != nil {
+		return nil, fmt.Errorf("unexpected SCP header %q: %w", header, err)
+	}
+	if _, err := stdin.Write([]byte{0}); err != nil {
+		return nil, err
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(stdout, data); err != nil {
+		return nil, err
+	}
+	if err := readSCPAck(stdout); err != nil {
+		return nil, err
+	}
+	if _, err := stdin.Write([]byte{0}); err != nil {
+		return nil, err
+	}
+	_ = stdin.Close()
+	return data, session.Wait()
+}
+
+func startSCPCommand(client *ssh.Client, command string) (*ssh.Session, io.WriteCloser, *bufio.Reader, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := session.Start(command); err != nil {
+		return nil, nil, nil, err
+	}
+	return session, stdin, bufio.NewReader(stdout), nil
+}
+
+func readSCPAck(r *bufio.Reader) error {
+	code, err := r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if code == 0 {
+		return nil
+	}
+	message, _ := r.ReadString('\n')
+	return errors.New(strings.TrimSpace(message))
+}
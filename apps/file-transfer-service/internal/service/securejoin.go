@@ -0,0 +1,76 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pkgsftp "github.com/pkg/sftp"
+)
+
+var errPathEscapesRoot = fmt.Errorf("path escapes transfer root: %w", pkgsftp.ErrSSHFxPermissionDenied)
+
+// SecureJoin joins an SFTP request path onto root as if root were a chroot: ".." cannot climb
+// above it, and every symlink along the way is resolved and must still point inside it.
+// The returned path has those symlinks resolved; components that do not exist yet are
+// appended as-is, so the result can be created safely.
+func SecureJoin(root, requestPath string) (string, error) {
+	rootAbs, err := filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+	realRoot, err := filepath.EvalSymlinks(rootAbs)
+	if err != nil {
+		return "", err
+	}
+
+	cleaned := strings.TrimSpace(strings.ReplaceAll(requestPath, "\\", "/"))
+	cleaned = strings.Trim(cleaned, "\x00\r\n")
+	cleaned = filepath.ToSlash(filepath.Clean("/" + cleaned))
+	relative := strings.TrimPrefix(cleaned, "/")
+	if relative == "" || relative == "." {
+		return realRoot, nil
+	}
+
+	current := realRoot
+	parts := strings.Split(relative, "/")
+	for i, part := range parts {
+		next := filepath.Join(current, part)
+		info, err := os.Lstat(next)
+		if os.IsNotExist(err) {
+			return filepath.Join(append([]string{next}, parts[i+1:]...)...), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			resolved, err := filepath.EvalSymlinks(next)
+			if err != nil {
+				return "", err
+			}
+			if !isWithinRoot(realRoot, resolved) {
+				return "", errPathEscapesRoot
+			}
+			next = resolved
+		}
+		current = next
+	}
+	return current, nil
+}
+
+func isWithinRoot(root, candidate string) bool {
+	rootAbs, err := filepath.Abs(root)
+	if err != nil {
+		return false
+	}
+	candidateAbs, err := filepath.Abs(candidate)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(rootAbs, candidateAbs)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(os.PathSeparator)) && !filepath.IsAbs(rel))
+}
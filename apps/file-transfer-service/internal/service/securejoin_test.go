@@ -0,0 +1,114 @@
+package service
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pkgsftp "github.com/pkg/sftp"
+)
+
+func TestSecureJoinResolvesSymlinksInsideRoot(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "world"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(root, "world"), filepath.Join(root, "current")); err != nil {
+		t.Skipf("symlink unavailable: %v", err)
+	}
+
+	resolved, err := SecureJoin(root, "/current/level.dat")
+	if err != nil {
+		t.Fatalf("SecureJoin returned error: %v", err)
+	}
+	if want := filepath.Join(root, "world", "level.dat"); resolved != want {
+		t.Fatalf("SecureJoin = %q, want %q", resolved, want)
+	}
+
+	if err := os.Symlink("/etc", filepath.Join(root, "etc")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := SecureJoin(root, "/etc/passwd"); !errors.Is(err, errPathEscapesRoot) {
+		t.Fatalf("SecureJoin through escaping symlink error = %v, want %v", err, errPathEscapesRoot)
+	}
+}
+
+func TestSFTPAllowedPathsRestrictSession(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"plugins", "world", "etc"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0o750); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client := newTestSFTPClient(t, &Session{
+		RootPath:     root,
+		Permissions:  []Permission{PermissionRead, PermissionWrite},
+		AllowedPaths: []string{"/plugins"},
+	})
+
+	if _, err := client.Stat("/plugins/../../../etc"); !errors.Is(err, os.ErrPermission) {
+		t.Fatalf("cd ../../../etc error = %v, want permission denied", err)
+	}
+	if _, err := client.ReadDir("/world"); !errors.Is(err, os.ErrPermission) {
+		t.Fatalf("listing /world error = %v, want permission denied", err)
+	}
+	if _, err := client.Create("/world/level.dat"); !errors.Is(err, os.ErrPermission) {
+		t.Fatalf("writing /world/level.dat error = %v, want permission denied", err)
+	}
+	if err := client.Rename("/plugins", "/world/plugins"); !errors.Is(err, os.ErrPermission) {
+		t.Fatalf("renaming out of /plugins error = %v, want permission denied", err)
+	}
+
+	if err := os.Symlink(filepath.Join(root, "world"), filepath.Join(root, "plugins", "world")); err != nil {
+		t.Skipf("symlink unavailable: %v", err)
+	}
+	if _, err := client.ReadDir("/plugins/world"); !errors.Is(err, os.ErrPermission) {
+		t.Fatalf("listing through symlink out of /plugins error = %v, want permission denied", err)
+	}
+
+	file, err := client.Create("/plugins/config.yml")
+	if err != nil {
+		t.Fatalf("creating /plugins/config.yml failed: %v", err)
+	}
+	if _, err := file.Write([]byte("enabled: true\n")); err != nil {
+		t.Fatalf("writing /plugins/config.yml failed: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(root, "plugins", "config.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "enabled: true\n" {
+		t.Fatalf("config.yml content = %q", data)
+	}
+	if _, err := client.Stat("/plugins/config.yml"); err != nil {
+		t.Fatalf("stat within allowed prefix failed: %v", err)
+	}
+}
+
+func newTestSFTPClient(t *testing.T, session *Session) *pkgsftp.Client {
+	t.Helper()
+	serverConn, clientConn := net.Pipe()
+	handler := newSFTPHandler(session, nil)
+	server := pkgsftp.NewRequestServer(serverConn, pkgsftp.Handlers{
+		FileGet:  handler,
+		FilePut:  handler,
+		FileCmd:  handler,
+		FileList: handler,
+	})
+	go func() { _ = server.Serve() }()
+
+	client, err := pkgsftp.NewClientPipe(clientConn, clientConn)
+	if err != nil {
+		t.Fatalf("start sftp client: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = server.Close()
+	})
+	return client
+}
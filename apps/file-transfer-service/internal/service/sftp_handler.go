@@ -5,26 +5,39 @@ import (
 	"io"
 	"os"
 	"path/filepath"
-	"strings"
 
 	pkgsftp "github.com/pkg/sftp"
 )
 
+// Permission failures wrap SSH_FX_PERMISSION_DENIED so clients and the audit log see the same status
+var (
+	errReadDenied  = fmt.Errorf("read permission denied: %w", pkgsftp.ErrSSHFxPermissionDenied)
+	errWriteDenied = fmt.Errorf("write permission denied: %w", pkgsftp.ErrSSHFxPermissionDenied)
+)
+
 type sftpHandler struct {
 	session *Session
 	root    string
+	quota   *QuotaEnforcer
 }
 
-func newSFTPHandler(session *Session) *sftpHandler {
+func newSFTPHandler(session *Session, quota *QuotaEnforcer) *sftpHandler {
 	return &sftpHandler{
 		session: session,
 		root:    filepath.Clean(session.RootPath),
+		quota:   quota,
 	}
 }
 
 func (h *sftpHandler) Fileread(r *pkgsftp.Request) (io.ReaderAt, error) {
+	reader, err := h.fileread(r)
+	auditSFTPEvent(h.session, auditActionDownload, map[string]string{"path": r.Filepath}, err)
+	return reader, err
+}
+
+func (h *sftpHandler) fileread(r *pkgsftp.Request) (io.ReaderAt, error) {
 	if !hasPermission(h.session.Permissions, PermissionRead) {
-		return nil, fmt.Errorf("read permission denied")
+		return nil, errReadDenied
 	}
 	resolved, err := h.resolvePath(r.Filepath)
 	if err != nil {
@@ -34,32 +47,51 @@ func (h *sftpHandler) Fileread(r *pkgsftp.Request) (io.ReaderAt, error) {
 }
 
 func (h *sftpHandler) Filewrite(r *pkgsftp.Request) (io.WriterAt, error) {
+	writer, err := h.filewrite(r)
+	auditSFTPEvent(h.session, auditActionUpload, map[string]string{"path": r.Filepath}, err)
+	return writer, err
+}
+
+func (h *sftpHandler) filewrite(r *pkgsftp.Request) (io.WriterAt, error) {
 	if !hasPermission(h.session.Permissions, PermissionWrite) {
-		return nil, fmt.Errorf("write permission denied")
+		return nil, errWriteDenied
 	}
 	resolved, err := h.resolvePath(r.Filepath)
 	if err != nil {
 		return nil, err
 	}
-	if err := h.ensureParentInsideRoot(resolved); err != nil {
+	if err := os.MkdirAll(filepath.Dir(resolved), 0750); err != nil {
 		return nil, err
 	}
-	if err := os.MkdirAll(filepath.Dir(resolved), 0750); err != nil {
+	file, err := os.OpenFile(resolved, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640)
+	if err != nil {
 		return nil, err
 	}
-	return os.OpenFile(resolved, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640)
+	return h.quota.Wrap(r.Context(), h.session, r.Filepath, file), nil
 }
 
 func (h *sftpHandler) Filecmd(r *pkgsftp.Request) error {
+	err := h.filecmd(r)
+	if action, ok := sftpCmdAuditActions[r.Method]; ok {
+		details := map[string]string{"path": r.Filepath}
+		if r.Method == "Rename" {
+			details["target"] = r.Target
+		}
+		auditSFTPEvent(h.session, action, details, err)
+	}
+	return err
+}
+
+func (h *sftpHandler) filecmd(r *pkgsftp.Request) error {
 	switch r.Method {
 	case "Setstat":
 		if !hasPermission(h.session.Permissions, PermissionWrite) {
-			return fmt.Errorf("write permission denied")
+			return errWriteDenied
 		}
 		return nil
 	case "Rename":
 		if !hasPermission(h.session.Permissions, PermissionWrite) {
-			return fmt.Errorf("write permission denied")
+			return errWriteDenied
 		}
 		source, err := h.resolvePath(r.Filepath)
 		if err != nil {
@@ -69,16 +101,13 @@ func (h *sftpHandler) Filecmd(r *pkgsftp.Request) error {
 		if err != nil {
 			return err
 		}
-		if err := h.ensureParentInsideRoot(target); err != nil {
-			return err
-		}
 		if err := os.MkdirAll(filepath.Dir(target), 0750); err != nil {
 			return err
 		}
 		return os.Rename(source, target)
 	case "Remove":
 		if !hasPermission(h.session.Permissions, PermissionWrite) {
-			return fmt.Errorf("write permission denied")
+			return errWriteDenied
 		}
 		resolved, err := h.resolvePath(r.Filepath)
 		if err != nil {
@@ -87,7 +116,7 @@ func (h *sftpHandler) Filecmd(r *pkgsftp.Request) error {
 		return os.Remove(resolved)
 	case "Rmdir":
 		if !hasPermission(h.session.Permissions, PermissionWrite) {
-			return fmt.Errorf("write permission denied")
+			return errWriteDenied
 		}
 		resolved, err := h.resolvePath(r.Filepath)
 		if err != nil {
@@ -96,15 +125,12 @@ func (h *sftpHandler) Filecmd(r *pkgsftp.Request) error {
 		return os.Remove(resolved)
 	case "Mkdir":
 		if !hasPermission(h.session.Permissions, PermissionWrite) {
-			return fmt.Errorf("write permission denied")
+			return errWriteDenied
 		}
 		resolved, err := h.resolvePath(r.Filepath)
 		if err != nil {
 			return err
 		}
-		if err := h.ensureParentInsideRoot(resolved); err != nil {
-			return err
-		}
 		return os.MkdirAll(resolved, 0750)
 	case "Link", "Symlink":
 		return fmt.Errorf("symlinks are not supported")
@@ -115,7 +141,7 @@ func (h *sftpHandler) Filecmd(r *pkgsftp.Request) error {
 
 func (h *sftpHandler) Filelist(r *pkgsftp.Request) (pkgsftp.ListerAt, error) {
 	if !hasPermission(h.session.Permissions, PermissionRead) {
-		return nil, fmt.Errorf("read permission denied")
+		return nil, errReadDenied
 	}
 	resolved, err := h.resolvePath(r.Filepath)
 	if err != nil {
@@ -150,81 +176,31 @@ func (h *sftpHandler) Filelist(r *pkgsftp.Request) (pkgsftp.ListerAt, error) {
 }
 
 func (h *sftpHandler) resolvePath(requestPath string) (string, error) {
-	cleaned := strings.TrimSpace(strings.ReplaceAll(requestPath, "\\", "/"))
-	cleaned = strings.Trim(cleaned, "\x00\r\n")
-	cleaned = filepath.ToSlash(filepath.Clean("/" + cleaned))
-	relative := strings.TrimPrefix(cleaned, "/")
-	if relative == "." {
-		relative = ""
-	}
-
-	candidate := filepath.Join(h.root, filepath.FromSlash(relative))
-	if !isWithinRoot(h.root, candidate) {
-		return "", fmt.Errorf("path escapes transfer root")
-	}
-	if err := h.ensureExistingPathInsideRoot(candidate); err != nil {
-		return "", err
-	}
-	return candidate, nil
-}
-
-func (h *sftpHandler) ensureParentInsideRoot(path string) error {
-	return h.ensureExistingPathInsideRoot(filepath.Dir(path))
-}
-
-func (h *sftpHandler) ensureExistingPathInsideRoot(candidate string) error {
-	root, err := filepath.Abs(h.root)
+	resolved, err := SecureJoin(h.root, requestPath)
 	if err != nil {
-		return err
-	}
-	current := root
-	rel, err := filepath.Rel(root, candidate)
-	if err != nil {
-		return err
+		return "", err
 	}
-	if rel == "." {
-		return nil
+	if !h.isAllowed(resolved) {
+		return "", pkgsftp.ErrSSHFxPermissionDenied
 	}
-	for _, part := range strings.Split(rel, string(os.PathSeparator)) {
-		if part == "" || part == "." {
-			continue
-		}
-		current = filepath.Join(current, part)
-		info, err := os.Lstat(current)
-		if os.IsNotExist(err) {
-			return nil
-		}
-		if err != nil {
-			return err
-		}
-		if info.Mode()&os.ModeSymlink == 0 {
-			continue
-		}
-		resolved, err := filepath.EvalSymlinks(current)
-		if err != nil {
-			return err
-		}
-		if !isWithinRoot(root, resolved) {
-			return fmt.Errorf("path escapes transfer root")
-		}
-	}
-	return nil
+	return resolved, nil
 }
 
-func isWithinRoot(root, candidate string) bool {
-	rootAbs, err := filepath.Abs(root)
-	if err != nil {
-		return false
+// isAllowed reports whether a resolved path lies under one of the session's allowed prefixes
+func (h *sftpHandler) isAllowed(resolved string) bool {
+	if len(h.session.AllowedPaths) == 0 {
+		return true
 	}
-	candidateAbs, err := filepath.Abs(candidate)
+	root, err := filepath.EvalSymlinks(h.root)
 	if err != nil {
 		return false
 	}
-	rel, err := filepath.Rel(rootAbs, candidateAbs)
-	if err != nil {
-		return false
+	for _, prefix := range h.session.AllowedPaths {
+		if isWithinRoot(filepath.Join(root, filepath.FromSlash(prefix)), resolved) {
+			return true
+		}
 	}
-	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(os.PathSeparator)) && !filepath.IsAbs(rel))
+	return false
 }
 
 type listerAt []os.FileInfo
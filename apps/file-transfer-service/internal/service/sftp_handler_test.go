@@ -8,7 +8,7 @@ import (
 
 func TestResolvePathStaysWithinRoot(t *testing.T) {
 	root := t.TempDir()
-	handler := newSFTPHandler(&Session{RootPath: root})
+	handler := newSFTPHandler(&Session{RootPath: root}, nil)
 
 	resolved, err := handler.resolvePath("../../etc/passwd")
 	if err != nil {
@@ -28,7 +28,7 @@ func TestResolvePathRejectsSymlinkEscape(t *testing.T) {
 		t.Skipf("symlink unavailable: %v", err)
 	}
 
-	handler := newSFTPHandler(&Session{RootPath: root})
+	handler := newSFTPHandler(&Session{RootPath: root}, nil)
 	if _, err := handler.resolvePath("/outside/file.txt"); err == nil {
 		t.Fatal("expected symlink escape to be rejected")
 	}
@@ -19,12 +19,14 @@ import (
 	pkgsftp "github.com/pkg/sftp"
 	"golang.org/x/crypto/ssh"
 
+	"github.com/obiente/cloud/apps/shared/pkg/database"
 	"github.com/obiente/cloud/apps/shared/pkg/logger"
 )
 
 type SFTPServer struct {
 	address       string
 	authenticator *Authenticator
+	quota         *QuotaEnforcer
 	config        *ssh.ServerConfig
 	listener      net.Listener
 	ctx           context.Context
@@ -32,7 +34,7 @@ type SFTPServer struct {
 	wg            sync.WaitGroup
 }
 
-func NewSFTPServer(address string, hostKeyPath string, authenticator *Authenticator) (*SFTPServer, error) {
+func NewSFTPServer(address string, hostKeyPath string, authenticator *Authenticator, quota *QuotaEnforcer) (*SFTPServer, error) {
 	if address == "" {
 		address = "0.0.0.0:2222"
 	}
@@ -49,12 +51,14 @@ func NewSFTPServer(address string, hostKeyPath string, authenticator *Authentica
 	server := &SFTPServer{
 		address:       address,
 		authenticator: authenticator,
+		quota:         quota,
 		ctx:           ctx,
 		cancel:        cancel,
 	}
 	server.config = &ssh.ServerConfig{
-		PasswordCallback: server.passwordCallback,
-		ServerVersion:    "SSH-2.0-ObienteFileTransfer",
+		PasswordCallback:  server.passwordCallback,
+		PublicKeyCallback: server.publicKeyCallback,
+		ServerVersion:     "SSH-2.0-ObienteFileTransfer",
 	}
 	server.config.AddHostKey(hostKey)
 
@@ -66,9 +70,12 @@ func (s *SFTPServer) Start() error {
 	if err != nil {
 		return fmt.Errorf("listen on %s: %w", s.address, err)
 	}
-	s.listener = listener
 	logger.Info("[FileTransfer] SFTP listening on %s", s.address)
+	return s.serve(listener)
+}
 
+func (s *SFTPServer) serve(listener net.Listener) error {
+	s.listener = listener
 	for {
 		conn, err := listener.Accept()
 		if err != nil {
@@ -105,6 +112,25 @@ func (s *SFTPServer) passwordCallback(conn ssh.ConnMetadata, password []byte) (*
 	logger.Info("[FileTransfer] SFTP auth ok: credential=%s resource=%s:%s user=%s org=%s",
 		session.CredentialID, session.ResourceType, session.ResourceID, session.UserID, session.OrganizationID)
 
+	return sessionPermissions(session), nil
+}
+
+// publicKeyCallback authenticates keys authorized on a credential of the resource named by the login, e.g. "gs_<id>"
+func (s *SFTPServer) publicKeyCallback(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+	session, err := s.authenticator.ValidatePublicKey(s.ctx, conn.User(), key)
+	if err != nil {
+		logger.Warn("[FileTransfer] SFTP public key auth failed for login %q from %s (%s): %v", conn.User(), conn.RemoteAddr(), ssh.FingerprintSHA256(key), err)
+		return nil, fmt.Errorf("authentication failed")
+	}
+
+	logger.Info("[FileTransfer] SFTP public key auth ok: credential=%s resource=%s:%s user=%s org=%s key=%s",
+		session.CredentialID, session.ResourceType, session.ResourceID, session.UserID, session.OrganizationID, ssh.FingerprintSHA256(key))
+
+	return sessionPermissions(session), nil
+}
+
+// sessionPermissions carries an authenticated session from the handshake to handleConnection
+func sessionPermissions(session *Session) *ssh.Permissions {
 	return &ssh.Permissions{
 		Extensions: map[string]string{
 			"credential_id":   session.CredentialID,
@@ -114,8 +140,9 @@ func (s *SFTPServer) passwordCallback(conn ssh.ConnMetadata, password []byte) (*
 			"resource_id":     session.ResourceID,
 			"root_path":       session.RootPath,
 			"permissions":     serializePermissions(session.Permissions),
+			"allowed_paths":   database.EncodeFileTransferAllowedPaths(session.AllowedPaths),
 		},
-	}, nil
+	}
 }
 
 func (s *SFTPServer) handleConnection(conn net.Conn) {
@@ -131,6 +158,11 @@ func (s *SFTPServer) handleConnection(conn net.Conn) {
 	go ssh.DiscardRequests(requests)
 
 	extensions := sshConn.Permissions.Extensions
+	allowedPaths, err := database.DecodeFileTransferAllowedPaths(extensions["allowed_paths"])
+	if err != nil {
+		logger.Warn("[FileTransfer] Rejecting SFTP connection for credential=%s: %v", extensions["credential_id"], err)
+		return
+	}
 	session := &Session{
 		CredentialID:   extensions["credential_id"],
 		UserID:         extensions["user_id"],
@@ -139,7 +171,11 @@ func (s *SFTPServer) handleConnection(conn net.Conn) {
 		ResourceID:     extensions["resource_id"],
 		RootPath:       extensions["root_path"],
 		Permissions:    deserializePermissions(extensions["permissions"]),
+		AllowedPaths:   allowedPaths,
+		RemoteIP:       remoteIP(conn.RemoteAddr()),
+		ClientVersion:  string(sshConn.ClientVersion()),
 	}
+	auditSFTPEvent(session, auditActionLogin, nil, nil)
 
 	for channel := range channels {
 		if channel.ChannelType() != "session" {
@@ -161,13 +197,26 @@ func (s *SFTPServer) handleChannel(channel ssh.Channel, requests <-chan *ssh.Req
 	defer channel.Close()
 
 	for req := range requests {
-		if req.Type != "subsystem" || parseSubsystem(req.Payload) != "sftp" {
+		switch {
+		case req.Type == "exec":
+			args, ok := parseSCPCommand(parseSSHString(req.Payload))
+			if !ok {
+				_ = req.Reply(false, nil)
+				continue
+			}
+			_ = req.Reply(true, nil)
+			go ssh.DiscardRequests(requests)
+
+			status := newSCPSession(channel, newSFTPHandler(session, s.quota), args).Run()
+			_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{status}))
+			return
+		case req.Type != "subsystem" || parseSSHString(req.Payload) != "sftp":
 			_ = req.Reply(false, nil)
 			continue
 		}
 		_ = req.Reply(true, nil)
 
-		handler := newSFTPHandler(session)
+		handler := newSFTPHandler(session, s.quota)
 		server := pkgsftp.NewRequestServer(channel, pkgsftp.Handlers{
 			FileGet:  handler,
 			FilePut:  handler,
@@ -181,7 +230,16 @@ func (s *SFTPServer) handleChannel(channel ssh.Channel, requests <-chan *ssh.Req
 	}
 }
 
-func parseSubsystem(payload []byte) string {
+func remoteIP(addr net.Addr) string {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	return host
+}
+
+// parseSSHString decodes the length-prefixed string payload of subsystem and exec requests
+func parseSSHString(payload []byte) string {
 	if len(payload) < 4 {
 		return ""
 	}
@@ -43,6 +43,12 @@ func main() {
 	}
 	logger.Info("✓ Database initialized")
 
+	if err := database.InitMetricsDatabase(); err != nil {
+		logger.Warn("Metrics database initialization failed: %v. SFTP quota breaches will not be audited.", err)
+	} else {
+		logger.Info("✓ Metrics database initialized")
+	}
+
 	if err := database.InitRedis(); err != nil {
 		logger.Warn("Redis cache initialization failed: %v", err)
 	}
@@ -53,7 +59,8 @@ func main() {
 	hostKeyPath := getenvDefault("SFTP_HOST_KEY_PATH", "/var/lib/obiente/file-transfer/ssh_host_key")
 
 	authenticator := filesvc.NewAuthenticator(volumeRoot)
-	sftpServer, err := filesvc.NewSFTPServer("0.0.0.0:"+sftpPort, hostKeyPath, authenticator)
+	quota := filesvc.NewQuotaEnforcer(database.RedisClient)
+	sftpServer, err := filesvc.NewSFTPServer("0.0.0.0:"+sftpPort, hostKeyPath, authenticator, quota)
 	if err != nil {
 		logger.Fatalf("failed to initialize SFTP server: %v", err)
 	}
@@ -61,6 +68,8 @@ func main() {
 	shutdownCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	go quota.StartDailyReset(shutdownCtx)
+
 	sftpErr := make(chan error, 1)
 	go func() {
 		sftpErr <- sftpServer.Start()
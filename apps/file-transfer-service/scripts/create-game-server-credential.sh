@@ -70,8 +70,9 @@ Created file transfer credential:
 SFTP connection:
   host:     <node-host>
   port:     \${FILE_TRANSFER_SFTP_PUBLIC_PORT:-2223}
-  username: any value
+  username: gs_$GAME_SERVER_ID (any value works for password logins)
   password: $SECRET
 
 Save the password now. Only its SHA-256 hash is stored.
+SSH keys added with AddAuthorizedKey log in with the username above instead of the password.
 EOF
@@ -0,0 +1,106 @@
+package backupstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+const defaultRegion = "us-east-1"
+
+var ErrNotConfigured = errors.New("backup storage is not configured (BACKUP_S3_ENDPOINT, BACKUP_S3_BUCKET, BACKUP_S3_ACCESS_KEY, BACKUP_S3_SECRET_KEY)")
+
+// Store keeps game server backups in an S3-compatible bucket.
+type Store struct {
+	client *s3.Client
+	bucket string
+}
+
+// NewStoreFromEnv creates a store from the BACKUP_S3_* environment variables.
+// BACKUP_S3_REGION is optional; endpoints are addressed path-style so MinIO and similar servers work.
+func NewStoreFromEnv() (*Store, error) {
+	endpoint := strings.TrimSpace(os.Getenv("BACKUP_S3_ENDPOINT"))
+	bucket := strings.TrimSpace(os.Getenv("BACKUP_S3_BUCKET"))
+	accessKey := strings.TrimSpace(os.Getenv("BACKUP_S3_ACCESS_KEY"))
+	secretKey := strings.TrimSpace(os.Getenv("BACKUP_S3_SECRET_KEY"))
+	if endpoint == "" || bucket == "" || accessKey == "" || secretKey == "" {
+		return nil, ErrNotConfigured
+	}
+
+	region := strings.TrimSpace(os.Getenv("BACKUP_S3_REGION"))
+	if region == "" {
+		region = defaultRegion
+	}
+
+	return NewStore(endpoint, region, bucket, accessKey, secretKey), nil
+}
+
+// NewStore creates a store for a bucket on an S3-compatible endpoint.
+func NewStore(endpoint, region, bucket, accessKey, secretKey string) *Store {
+	client := s3.New(s3.Options{
+		BaseEndpoint: aws.String(endpoint),
+		Region:       region,
+		Credentials:  credentials.NewStaticCredentialsProvider(accessKey, secretKey, ""),
+		UsePathStyle: true,
+	})
+	return &Store{client: client, bucket: bucket}
+}
+
+// Upload streams r to key and returns the number of bytes written.
+// The length of r does not need to be known; large streams are sent as multipart uploads.
+func (s *Store) Upload(ctx context.Context, key string, r io.Reader) (int64, error) {
+	counter := &countingReader{r: r}
+	uploader := manager.NewUploader(s.client)
+	if _, err := uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        counter,
+		ContentType: aws.String("application/gzip"),
+	}); err != nil {
+		return counter.n.Load(), fmt.Errorf("upload %s: %w", key, err)
+	}
+	return counter.n.Load(), nil
+}
+
+// Download opens the object at key. The caller closes the returned reader.
+func (s *Store) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("download %s: %w", key, err)
+	}
+	return out.Body, nil
+}
+
+// Delete removes the object at key. Deleting a missing object is not an error.
+func (s *Store) Delete(ctx context.Context, key string) error {
+	if _, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		return fmt.Errorf("delete %s: %w", key, err)
+	}
+	return nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n atomic.Int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n.Add(int64(n))
+	return n, err
+}
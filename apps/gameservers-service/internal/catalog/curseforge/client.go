@@ -0,0 +1,151 @@
+package curseforge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	defaultBaseURL = "https://api.curseforge.com/v1"
+	defaultUA      = "obiente-cloud-gameservers-service"
+)
+
+// CurseForge hash algorithm IDs
+const (
+	hashAlgoSHA1 = 1
+	hashAlgoMD5  = 2
+)
+
+var (
+	ErrNotFound         = errors.New("curseforge resource not found")
+	ErrNotConfigured    = errors.New("curseforge API key is not configured (CURSEFORGE_API_KEY)")
+	ErrDownloadDisabled = errors.New("the mod author does not allow third-party downloads of this file")
+)
+
+// Client wraps the CurseForge REST API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	userAgent  string
+	apiKey     string
+}
+
+// NewClient creates a CurseForge client authenticated with CURSEFORGE_API_KEY.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: 30 * time.Second,
+		}
+	}
+
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    defaultBaseURL,
+		userAgent:  defaultUA,
+		apiKey:     strings.TrimSpace(os.Getenv("CURSEFORGE_API_KEY")),
+	}
+}
+
+// File represents a downloadable file of a CurseForge mod.
+type File struct {
+	ID          int64
+	ModID       int64
+	DisplayName string
+	FileName    string
+	DownloadURL string
+	Size        int64
+	// GameVersions mixes Minecraft versions ("1.20.1") with loaders ("Forge", "Fabric") and sides ("Server")
+	GameVersions []string
+	Hashes       map[string]string // sha1 / md5
+}
+
+// GetModFile returns a file of a mod.
+func (c *Client) GetModFile(ctx context.Context, modID, fileID string) (*File, error) {
+	if c.apiKey == "" {
+		return nil, ErrNotConfigured
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/mods/%s/files/%s", c.baseURL, modID, fileID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("x-api-key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 8<<10))
+		return nil, fmt.Errorf("curseforge mod file failed: status=%d body=%s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var payload fileResponse
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("decode mod file: %w", err)
+	}
+
+	file := mapFile(payload.Data)
+	if file.DownloadURL == "" {
+		return nil, ErrDownloadDisabled
+	}
+	return &file, nil
+}
+
+// --- internal helpers ---
+
+type fileResponse struct {
+	Data fileData `json:"data"`
+}
+
+type fileData struct {
+	ID           int64    `json:"id"`
+	ModID        int64    `json:"modId"`
+	DisplayName  string   `json:"displayName"`
+	FileName     string   `json:"fileName"`
+	DownloadURL  *string  `json:"downloadUrl"`
+	FileLength   int64    `json:"fileLength"`
+	GameVersions []string `json:"gameVersions"`
+	Hashes       []struct {
+		Value string `json:"value"`
+		Algo  int    `json:"algo"`
+	} `json:"hashes"`
+}
+
+func mapFile(data fileData) File {
+	file := File{
+		ID:           data.ID,
+		ModID:        data.ModID,
+		DisplayName:  data.DisplayName,
+		FileName:     data.FileName,
+		Size:         data.FileLength,
+		GameVersions: data.GameVersions,
+		Hashes:       map[string]string{},
+	}
+	if data.DownloadURL != nil {
+		file.DownloadURL = *data.DownloadURL
+	}
+	for _, h := range data.Hashes {
+		switch h.Algo {
+		case hashAlgoSHA1:
+			file.Hashes["sha1"] = h.Value
+		case hashAlgoMD5:
+			file.Hashes["md5"] = h.Value
+		}
+	}
+	return file
+}
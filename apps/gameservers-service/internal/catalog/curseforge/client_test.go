@@ -0,0 +1,73 @@
+package curseforge
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetModFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mods/238222/files/4712345" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.Header.Get("x-api-key"); got != "test-key" {
+			t.Fatalf("expected api key header, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{
+			"id":4712345,
+			"modId":238222,
+			"displayName":"JEI 15.2.0",
+			"fileName":"jei-1.20.1-forge-15.2.0.jar",
+			"downloadUrl":"https://edge.forgecdn.net/files/4712/345/jei-1.20.1-forge-15.2.0.jar",
+			"fileLength":1234,
+			"gameVersions":["1.20.1","Forge","Server"],
+			"hashes":[{"value":"abc123","algo":1},{"value":"def456","algo":2}]
+		}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL = server.URL
+	client.apiKey = "test-key"
+
+	file, err := client.GetModFile(context.Background(), "238222", "4712345")
+	if err != nil {
+		t.Fatalf("GetModFile returned error: %v", err)
+	}
+	if file.FileName != "jei-1.20.1-forge-15.2.0.jar" || file.Size != 1234 {
+		t.Fatalf("unexpected file: %#v", file)
+	}
+	if file.Hashes["sha1"] != "abc123" || file.Hashes["md5"] != "def456" {
+		t.Fatalf("unexpected hashes: %#v", file.Hashes)
+	}
+}
+
+func TestGetModFileErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/mods/1/files/2" {
+			_, _ = w.Write([]byte(`{"data":{"id":2,"modId":1,"fileName":"private.jar","downloadUrl":null}}`))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL = server.URL
+
+	if _, err := client.GetModFile(context.Background(), "1", "2"); !errors.Is(err, ErrNotConfigured) {
+		t.Fatalf("expected ErrNotConfigured without an API key, got %v", err)
+	}
+
+	client.apiKey = "test-key"
+	if _, err := client.GetModFile(context.Background(), "1", "2"); !errors.Is(err, ErrDownloadDisabled) {
+		t.Fatalf("expected ErrDownloadDisabled, got %v", err)
+	}
+	if _, err := client.GetModFile(context.Background(), "1", "3"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
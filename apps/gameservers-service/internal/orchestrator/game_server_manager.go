@@ -45,6 +45,7 @@ type GameServerManager struct {
 // GameServerConfig holds configuration for a game server container
 type GameServerConfig struct {
 	GameServerID string
+	GameType     int32 // gameservers.v1.GameType
 	Image        string
 	Port         int32
 	ExtraPorts   []int32
@@ -53,6 +54,8 @@ type GameServerConfig struct {
 	CPUCores     int32
 	StartCommand *string // Optional start command to override container CMD
 	TargetNodeID string
+	RCONPort     int32  // 0 = RCON disabled
+	RCONPassword string // Plaintext, only passed to the container env
 }
 
 // NewGameServerManager creates a new game server manager
@@ -265,6 +268,7 @@ func (gsm *GameServerManager) StartGameServer(ctx context.Context, gameServerID
 		}
 		config := &GameServerConfig{
 			GameServerID: gameServerID,
+			GameType:     gameServer.GameType,
 			Image:        gameServer.DockerImage,
 			Port:         gameServer.Port,
 			ExtraPorts:   database.ParseGameServerExtraPorts(gameServer.ExtraPorts),
@@ -274,6 +278,7 @@ func (gsm *GameServerManager) StartGameServer(ctx context.Context, gameServerID
 			StartCommand: gameServer.StartCommand,
 			TargetNodeID: targetNodeID,
 		}
+		ApplyRCONConfig(config, gameServer)
 
 		// Log env vars we are about to use when creating container
 		if len(envVars) > 0 {
@@ -329,6 +334,7 @@ func (gsm *GameServerManager) StartGameServer(ctx context.Context, gameServerID
 		}
 		config := &GameServerConfig{
 			GameServerID: gameServerID,
+			GameType:     gameServer.GameType,
 			Image:        gameServer.DockerImage,
 			Port:         gameServer.Port,
 			ExtraPorts:   database.ParseGameServerExtraPorts(gameServer.ExtraPorts),
@@ -338,6 +344,7 @@ func (gsm *GameServerManager) StartGameServer(ctx context.Context, gameServerID
 			StartCommand: gameServer.StartCommand,
 			TargetNodeID: targetNodeID,
 		}
+		ApplyRCONConfig(config, gameServer)
 
 		// Create the container (this will reuse existing volumes)
 		if err := gsm.CreateGameServer(ctx, config); err != nil {
@@ -406,6 +413,7 @@ func (gsm *GameServerManager) StartGameServer(ctx context.Context, gameServerID
 			}
 			config := &GameServerConfig{
 				GameServerID: gameServerID,
+				GameType:     gameServer.GameType,
 				Image:        gameServer.DockerImage,
 				Port:         gameServer.Port,
 				ExtraPorts:   database.ParseGameServerExtraPorts(gameServer.ExtraPorts),
@@ -415,6 +423,7 @@ func (gsm *GameServerManager) StartGameServer(ctx context.Context, gameServerID
 				StartCommand: gameServer.StartCommand,
 				TargetNodeID: targetNodeID,
 			}
+			ApplyRCONConfig(config, gameServer)
 
 			// Create the new container (will register container info in DB)
 			if err := gsm.CreateGameServer(ctx, config); err != nil {
@@ -481,6 +490,7 @@ func (gsm *GameServerManager) StartGameServer(ctx context.Context, gameServerID
 				}
 				config := &GameServerConfig{
 					GameServerID: gameServerID,
+					GameType:     gameServer.GameType,
 					Image:        gameServer.DockerImage,
 					Port:         gameServer.Port,
 					ExtraPorts:   database.ParseGameServerExtraPorts(gameServer.ExtraPorts),
@@ -490,6 +500,7 @@ func (gsm *GameServerManager) StartGameServer(ctx context.Context, gameServerID
 					StartCommand: gameServer.StartCommand,
 					TargetNodeID: targetNodeID,
 				}
+				ApplyRCONConfig(config, gameServer)
 
 				// Recreate the container with current network
 				if err := gsm.CreateGameServer(ctx, config); err != nil {
@@ -1034,6 +1045,14 @@ func (gsm *GameServerManager) createContainer(ctx context.Context, config *GameS
 	if _, exists := config.EnvVars["BIND_IP"]; !exists {
 		env = append(env, "BIND_IP=0.0.0.0")
 	}
+	for key, value := range gameTemplateEnvVars(config.GameType, config.Port) {
+		if _, exists := config.EnvVars[key]; !exists {
+			env = append(env, fmt.Sprintf("%s=%s", key, value))
+		}
+	}
+	for key, value := range rconTemplateEnvVars(config.GameType, config.RCONPort, config.RCONPassword) {
+		env = append(env, fmt.Sprintf("%s=%s", key, value))
+	}
 
 	// Prepare labels
 	labels := map[string]string{
@@ -1057,6 +1076,8 @@ func (gsm *GameServerManager) createContainer(ctx context.Context, config *GameS
 	exposedPorts := network.PortSet{}
 	portBindings := network.PortMap{}
 	portsToBind := append([]int32{config.Port}, config.ExtraPorts...)
+	// Servers created before template ports were stored as extra ports still need them bound
+	portsToBind = append(portsToBind, GameTemplatePorts(config.GameType, config.Port)...)
 	seenPorts := make(map[int32]struct{}, len(portsToBind))
 	hostIP, _ := netip.ParseAddr("0.0.0.0")
 
@@ -0,0 +1,55 @@
+package orchestrator
+
+import "strconv"
+
+// GameType enum values used by the orchestrator (see gameservers.v1.GameType)
+const (
+	gameTypeValheim int32 = 4
+	gameTypeCS2     int32 = 7
+)
+
+// gameTemplateEnvVars returns the env vars an image needs to listen on the allocated port
+// Images default to their game's standard port, so those that do not read SERVER_PORT are
+// pointed at the allocated one here. User-provided env vars take precedence.
+func gameTemplateEnvVars(gameType int32, port int32) map[string]string {
+	p := strconv.Itoa(int(port))
+	switch gameType {
+	case gameTypeValheim:
+		// ghcr.io/lloesche/valheim-server reads SERVER_PORT (set for every game server)
+		return map[string]string{
+			"SERVER_NAME":   "Obiente Cloud Valheim Server",
+			"WORLD_NAME":    "Dedicated",
+			"SERVER_PUBLIC": "false",
+		}
+	case gameTypeCS2:
+		// joedwards32/cs2 listens on CS2_PORT (default 27015)
+		return map[string]string{
+			"CS2_PORT":       p,
+			"CS2_SERVERNAME": "Obiente Cloud CS2 Server",
+			"CS2_MAXPLAYERS": "10",
+		}
+	}
+	return nil
+}
+
+// GameTemplatePortOffsets returns the offsets from the game port of ports a game also listens on
+// Valheim answers Steam queries on the port after the game port. These ports are allocated and
+// stored as extra ports so no other game server is given them.
+func GameTemplatePortOffsets(gameType int32) []int32 {
+	switch gameType {
+	case gameTypeValheim:
+		return []int32{1}
+	}
+	return nil
+}
+
+// GameTemplatePorts returns the ports a game listens on in addition to the game port
+func GameTemplatePorts(gameType int32, port int32) []int32 {
+	var ports []int32
+	for _, offset := range GameTemplatePortOffsets(gameType) {
+		if extra := port + offset; port > 0 && extra <= 65535 {
+			ports = append(ports, extra)
+		}
+	}
+	return ports
+}
@@ -0,0 +1,24 @@
+package orchestrator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGameTemplatePorts(t *testing.T) {
+	tests := []struct {
+		gameType int32
+		port     int32
+		want     []int32
+	}{
+		{gameTypeValheim, 2456, []int32{2457}},
+		{gameTypeValheim, 65535, nil},
+		{gameTypeValheim, 0, nil},
+		{gameTypeCS2, 27015, nil},
+	}
+	for _, tt := range tests {
+		if got := GameTemplatePorts(tt.gameType, tt.port); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("GameTemplatePorts(%d, %d) = %v, want %v", tt.gameType, tt.port, got, tt.want)
+		}
+	}
+}
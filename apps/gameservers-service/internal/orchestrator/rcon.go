@@ -0,0 +1,167 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/gorcon/rcon"
+	"github.com/moby/moby/client"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/secrets"
+)
+
+const (
+	rconTimeout          = 10 * time.Second
+	minecraftRCONPort    = 25575
+	rconPasswordByteSize = 24
+)
+
+// ErrRCONNotConfigured is returned for game servers without an RCON port and password
+var ErrRCONNotConfigured = errors.New("rcon is not configured for this game server")
+
+// RCONSettings returns the RCON port and encrypted password for a new game server
+// Game types without Source RCON support get nil values. The password is generated here and only
+// stored encrypted; the container receives it in its env when it is created.
+func RCONSettings(gameType int32, port int32) (rconPort *int32, encryptedPassword *string, err error) {
+	var p int32
+	switch gameType {
+	case 1, 2: // MINECRAFT, MINECRAFT_JAVA
+		p = minecraftRCONPort
+	case gameTypeCS2:
+		// Source RCON shares the game port over TCP
+		p = port
+	default:
+		return nil, nil, nil
+	}
+
+	raw := make([]byte, rconPasswordByteSize)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, nil, fmt.Errorf("generate rcon password: %w", err)
+	}
+	cipher, err := secrets.NewTokenCipherFromEnv()
+	if err != nil {
+		return nil, nil, fmt.Errorf("rcon password: %w", err)
+	}
+	encrypted, err := cipher.EncryptString(hex.EncodeToString(raw))
+	if err != nil {
+		return nil, nil, fmt.Errorf("encrypt rcon password: %w", err)
+	}
+	return &p, &encrypted, nil
+}
+
+// rconTemplateEnvVars returns the env vars that enable RCON in the game's image
+func rconTemplateEnvVars(gameType int32, rconPort int32, password string) map[string]string {
+	if rconPort == 0 || password == "" {
+		return nil
+	}
+	switch gameType {
+	case 1, 2: // itzg/minecraft-server
+		return map[string]string{
+			"ENABLE_RCON":   "true",
+			"RCON_PORT":     strconv.Itoa(int(rconPort)),
+			"RCON_PASSWORD": password,
+		}
+	case gameTypeCS2: // joedwards32/cs2
+		return map[string]string{
+			"CS2_RCONPW": password,
+		}
+	}
+	return nil
+}
+
+// ApplyRCONConfig copies the game server's RCON port and decrypted password to the container config
+func ApplyRCONConfig(config *GameServerConfig, gameServer *database.GameServer) {
+	if gameServer.RCONPort == nil || gameServer.RCONPassword == nil {
+		return
+	}
+	password, err := decryptRCONPassword(*gameServer.RCONPassword)
+	if err != nil {
+		logger.Warn("[GameServerManager] Failed to decrypt RCON password of game server %s, RCON stays disabled: %v", gameServer.ID, err)
+		return
+	}
+	config.RCONPort = *gameServer.RCONPort
+	config.RCONPassword = password
+}
+
+func decryptRCONPassword(encrypted string) (string, error) {
+	if !secrets.IsEncryptedString(encrypted) {
+		return encrypted, nil
+	}
+	cipher, err := secrets.NewTokenCipherFromEnv()
+	if err != nil {
+		return "", err
+	}
+	return cipher.DecryptString(encrypted)
+}
+
+// ExecuteRCONCommand runs a console command on the game server over RCON and returns its response
+func (gsm *GameServerManager) ExecuteRCONCommand(ctx context.Context, gameServerID string, command string) (string, error) {
+	gameServer, err := database.NewGameServerRepository(database.DB, database.RedisClient).GetByID(ctx, gameServerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get game server: %w", err)
+	}
+	if gameServer.RCONPort == nil || gameServer.RCONPassword == nil {
+		return "", ErrRCONNotConfigured
+	}
+	if gameServer.ContainerID == nil {
+		return "", fmt.Errorf("game server %s has no container", gameServerID)
+	}
+
+	password, err := decryptRCONPassword(*gameServer.RCONPassword)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt rcon password: %w", err)
+	}
+
+	containerInfo, err := gsm.dockerClient.ContainerInspect(ctx, *gameServer.ContainerID, client.ContainerInspectOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect container: %w", err)
+	}
+	if containerInfo.Container.State == nil || !containerInfo.Container.State.Running {
+		return "", fmt.Errorf("game server %s is not running", gameServerID)
+	}
+
+	// RCON is not published on the host, so connect to the container over its network
+	host := ""
+	if settings := containerInfo.Container.NetworkSettings; settings != nil {
+		if nw, ok := settings.Networks[gsm.networkName]; ok && nw.IPAddress.IsValid() {
+			host = nw.IPAddress.String()
+		} else {
+			for _, nw := range settings.Networks {
+				if nw.IPAddress.IsValid() {
+					host = nw.IPAddress.String()
+					break
+				}
+			}
+		}
+	}
+	if host == "" {
+		return "", fmt.Errorf("game server %s container has no IP address", gameServerID)
+	}
+
+	return executeRCON(ctx, net.JoinHostPort(host, strconv.Itoa(int(*gameServer.RCONPort))), password, command)
+}
+
+// executeRCON authenticates to an RCON server, sends a single command and returns the response
+func executeRCON(ctx context.Context, addr string, password string, command string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	conn, err := rcon.Dial(addr, password, rcon.SetDialTimeout(rconTimeout), rcon.SetDeadline(rconTimeout))
+	if err != nil {
+		return "", fmt.Errorf("rcon connect: %w", err)
+	}
+	defer conn.Close()
+
+	response, err := conn.Execute(command)
+	if err != nil {
+		return "", fmt.Errorf("rcon command: %w", err)
+	}
+	return response, nil
+}
@@ -0,0 +1,78 @@
+package orchestrator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gorcon/rcon"
+	"github.com/gorcon/rcon/rcontest"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+func TestExecuteRCON(t *testing.T) {
+	server := rcontest.NewServer(
+		rcontest.SetSettings(rcontest.Settings{Password: "s3cret"}),
+		rcontest.SetCommandHandler(func(c *rcontest.Context) {
+			response := "Unknown command"
+			if c.Request().Body() == "list" {
+				response = "There are 2 of a max of 20 players online: alice, bob"
+			}
+			rcon.NewPacket(rcon.SERVERDATA_RESPONSE_VALUE, c.Request().ID, response).WriteTo(c.Conn())
+		}),
+	)
+	defer server.Close()
+
+	output, err := executeRCON(context.Background(), server.Addr(), "s3cret", "list")
+	if err != nil {
+		t.Fatalf("executeRCON: %v", err)
+	}
+	if output != "There are 2 of a max of 20 players online: alice, bob" {
+		t.Fatalf("output = %q", output)
+	}
+
+	if _, err := executeRCON(context.Background(), server.Addr(), "wrong", "list"); err == nil {
+		t.Fatal("executeRCON with a wrong password succeeded")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := executeRCON(ctx, server.Addr(), "s3cret", "list"); err == nil {
+		t.Fatal("executeRCON with a canceled context succeeded")
+	}
+}
+
+func TestRCONSettings(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN_ENCRYPTION_KEY", "test-key")
+
+	port, password, err := RCONSettings(1, 25565)
+	if err != nil {
+		t.Fatalf("RCONSettings: %v", err)
+	}
+	if port == nil || *port != minecraftRCONPort {
+		t.Fatalf("minecraft rcon port = %v, want %d", port, minecraftRCONPort)
+	}
+	if password == nil || !strings.HasPrefix(*password, "enc:v1:") {
+		t.Fatalf("password is not stored encrypted: %v", password)
+	}
+
+	config := &GameServerConfig{GameType: 1}
+	ApplyRCONConfig(config, &database.GameServer{ID: "gs-1", RCONPort: port, RCONPassword: password})
+	if config.RCONPort != minecraftRCONPort || config.RCONPassword == "" || strings.HasPrefix(config.RCONPassword, "enc:") {
+		t.Fatalf("config RCON = %d %q, want decrypted password", config.RCONPort, config.RCONPassword)
+	}
+	env := rconTemplateEnvVars(config.GameType, config.RCONPort, config.RCONPassword)
+	if env["ENABLE_RCON"] != "true" || env["RCON_PASSWORD"] != config.RCONPassword || env["RCON_PORT"] != "25575" {
+		t.Fatalf("minecraft rcon env = %v", env)
+	}
+
+	port, _, err = RCONSettings(gameTypeCS2, 27020)
+	if err != nil || port == nil || *port != 27020 {
+		t.Fatalf("cs2 rcon port = %v (%v), want the game port", port, err)
+	}
+
+	port, password, err = RCONSettings(gameTypeValheim, 2456)
+	if err != nil || port != nil || password != nil {
+		t.Fatalf("valheim RCONSettings = %v, %v, %v; want RCON disabled", port, password, err)
+	}
+}
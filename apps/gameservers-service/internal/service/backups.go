@@ -0,0 +1,362 @@
+package gameservers
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"gameservers-service/internal/backupstore"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/docker"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	gameServerBackupInProgress = "in_progress"
+	gameServerBackupCompleted  = "completed"
+	gameServerBackupFailed     = "failed"
+
+	defaultBackupRetention = 7
+	maxBackupRetention     = 100
+	minBackupInterval      = time.Hour
+)
+
+// BackupGameServer archives the game server's /data directory with tar inside the container,
+// streams it to backup storage and trims backups beyond the server's retention count.
+// The container must be running because the archive is created with docker exec.
+func (s *Service) BackupGameServer(ctx context.Context, gameServerID string) (string, error) {
+	if s.backupStore == nil {
+		return "", backupstore.ErrNotConfigured
+	}
+
+	dbGameServer, err := s.repo.GetByID(ctx, gameServerID)
+	if err != nil {
+		return "", fmt.Errorf("game server %s not found: %w", gameServerID, err)
+	}
+	if dbGameServer.Status != int32(gameserversv1.GameServerStatus_RUNNING) {
+		return "", fmt.Errorf("game server %s must be running to be backed up", gameServerID)
+	}
+
+	dcli, err := docker.New()
+	if err != nil {
+		return "", fmt.Errorf("docker client: %w", err)
+	}
+	defer dcli.Close()
+
+	containerID, err := s.findContainerForGameServer(ctx, gameServerID, dcli)
+	if err != nil {
+		return "", err
+	}
+
+	backupID := fmt.Sprintf("gsbackup-%s", uuid.NewString())
+	backup := &database.GameServerBackup{
+		ID:           backupID,
+		GameServerID: gameServerID,
+		ObjectKey:    fmt.Sprintf("gameservers/%s/%s.tar.gz", gameServerID, backupID),
+		Status:       gameServerBackupInProgress,
+		CreatedAt:    time.Now(),
+	}
+	if err := database.DB.WithContext(ctx).Create(backup).Error; err != nil {
+		return "", fmt.Errorf("failed to record backup: %w", err)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(dcli.ContainerExecStream(ctx, containerID, []string{"tar", "-czf", "-", "-C", "/data", "."}, pw))
+	}()
+	size, err := s.backupStore.Upload(ctx, backup.ObjectKey, pr)
+	pr.CloseWithError(err)
+
+	now := time.Now()
+	updates := map[string]interface{}{"size_bytes": size, "completed_at": now, "status": gameServerBackupCompleted}
+	if err != nil {
+		message := err.Error()
+		updates["status"] = gameServerBackupFailed
+		updates["error_message"] = message
+	}
+	if dbErr := database.DB.WithContext(ctx).Model(backup).Updates(updates).Error; dbErr != nil {
+		logger.Warn("[GameServerBackups] Failed to update backup %s: %v", backupID, dbErr)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to upload backup: %w", err)
+	}
+
+	logger.Info("[GameServerBackups] Backed up game server %s to %s (%d bytes)", gameServerID, backup.ObjectKey, size)
+	s.trimGameServerBackups(ctx, gameServerID, dbGameServer.BackupRetention)
+	return backupID, nil
+}
+
+// trimGameServerBackups keeps the newest completed backups and the newest failed attempts up to retention each
+func (s *Service) trimGameServerBackups(ctx context.Context, gameServerID string, retention int32) {
+	if retention <= 0 {
+		retention = defaultBackupRetention
+	}
+
+	for _, status := range []string{gameServerBackupCompleted, gameServerBackupFailed} {
+		var expired []database.GameServerBackup
+		if err := database.DB.WithContext(ctx).
+			Where("game_server_id = ? AND status = ?", gameServerID, status).
+			Order("created_at DESC").
+			Offset(int(retention)).
+			Find(&expired).Error; err != nil {
+			logger.Warn("[GameServerBackups] Failed to list expired backups for game server %s: %v", gameServerID, err)
+			return
+		}
+
+		for _, backup := range expired {
+			// Keep the record when the object could not be deleted so the next run retries
+			if err := s.backupStore.Delete(ctx, backup.ObjectKey); err != nil {
+				logger.Warn("[GameServerBackups] Failed to delete backup %s: %v", backup.ID, err)
+				continue
+			}
+			if err := database.DB.WithContext(ctx).Delete(&backup).Error; err != nil {
+				logger.Warn("[GameServerBackups] Failed to delete backup record %s: %v", backup.ID, err)
+			}
+		}
+	}
+}
+
+// StartBackupScheduler starts a background service that backs up game servers
+// whose backup_schedule interval has elapsed since their last backup
+func (s *Service) StartBackupScheduler(ctx context.Context, interval time.Duration) {
+	if s.backupStore == nil {
+		logger.Info("[GameServerBackups] Backup scheduler disabled: %v", backupstore.ErrNotConfigured)
+		return
+	}
+	logger.Info("[GameServerBackups] Starting backup scheduler (interval: %v)", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("[GameServerBackups] Backup scheduler shutting down")
+			return
+		case <-ticker.C:
+			s.runScheduledBackups(ctx)
+		}
+	}
+}
+
+func (s *Service) runScheduledBackups(ctx context.Context) {
+	var gameServers []database.GameServer
+	if err := database.DB.WithContext(ctx).
+		Where("backup_schedule IS NOT NULL AND backup_schedule <> '' AND status = ? AND deleted_at IS NULL", int32(gameserversv1.GameServerStatus_RUNNING)).
+		Find(&gameServers).Error; err != nil {
+		logger.Warn("[GameServerBackups] Failed to query scheduled game servers: %v", err)
+		return
+	}
+
+	for _, gameServer := range gameServers {
+		interval, err := parseBackupSchedule(*gameServer.BackupSchedule)
+		if err != nil {
+			logger.Warn("[GameServerBackups] Skipping game server %s: %v", gameServer.ID, err)
+			continue
+		}
+
+		// The scheduler on the node running the container takes the backup
+		if shouldForward, _ := s.getGameServerForwardTarget(ctx, gameServer.ID); shouldForward {
+			continue
+		}
+
+		// Failed attempts count too, so a broken server is retried once per interval rather than every tick
+		var last database.GameServerBackup
+		err = database.DB.WithContext(ctx).Where("game_server_id = ?", gameServer.ID).Order("created_at DESC").First(&last).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("[GameServerBackups] Failed to load last backup for game server %s: %v", gameServer.ID, err)
+			continue
+		}
+		if err == nil && time.Since(last.CreatedAt) < interval {
+			continue
+		}
+
+		if _, err := s.BackupGameServer(ctx, gameServer.ID); err != nil {
+			logger.Warn("[GameServerBackups] Scheduled backup of game server %s failed: %v", gameServer.ID, err)
+		}
+	}
+}
+
+// ScheduleGameServerBackup sets the automatic backup interval and retention of a game server
+func (s *Service) ScheduleGameServerBackup(ctx context.Context, req *connect.Request[gameserversv1.ScheduleGameServerBackupRequest]) (*connect.Response[gameserversv1.ScheduleGameServerBackupResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	if gameServerID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game_server_id is required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersUpdate); err != nil {
+		return nil, err
+	}
+
+	var schedule *string
+	value := strings.TrimSpace(req.Msg.GetSchedule())
+	if value != "" {
+		if _, err := parseBackupSchedule(value); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		schedule = &value
+	}
+	if schedule != nil && s.backupStore == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, backupstore.ErrNotConfigured)
+	}
+
+	retention := int32(defaultBackupRetention)
+	if req.Msg.Retention != nil {
+		retention = req.Msg.GetRetention()
+		if retention < 1 || retention > maxBackupRetention {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("retention must be between 1 and %d", maxBackupRetention))
+		}
+	}
+
+	if err := s.repo.UpdateBackupSchedule(ctx, gameServerID, schedule, retention); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update backup schedule: %w", err))
+	}
+
+	return connect.NewResponse(&gameserversv1.ScheduleGameServerBackupResponse{
+		Schedule:  value,
+		Retention: retention,
+	}), nil
+}
+
+// ListGameServerBackups lists the backups of a game server, newest first
+func (s *Service) ListGameServerBackups(ctx context.Context, req *connect.Request[gameserversv1.ListGameServerBackupsRequest]) (*connect.Response[gameserversv1.ListGameServerBackupsResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	if gameServerID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game_server_id is required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersRead); err != nil {
+		return nil, err
+	}
+
+	var backups []database.GameServerBackup
+	if err := database.DB.WithContext(ctx).Where("game_server_id = ?", gameServerID).Order("created_at DESC").Find(&backups).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list backups: %w", err))
+	}
+
+	resp := &gameserversv1.ListGameServerBackupsResponse{Backups: make([]*gameserversv1.GameServerBackup, 0, len(backups))}
+	for i := range backups {
+		resp.Backups = append(resp.Backups, gameServerBackupToProto(&backups[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RestoreGameServerBackup extracts a backup into the game server's /data directory.
+// A running server is stopped for the restore and started again afterwards; files
+// that are not in the backup are left in place.
+func (s *Service) RestoreGameServerBackup(ctx context.Context, req *connect.Request[gameserversv1.RestoreGameServerBackupRequest]) (*connect.Response[gameserversv1.RestoreGameServerBackupResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	backupID := strings.TrimSpace(req.Msg.GetBackupId())
+	if gameServerID == "" || backupID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game_server_id and backup_id are required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersUpdate); err != nil {
+		return nil, err
+	}
+
+	if shouldForward, targetNodeID := s.getGameServerForwardTarget(ctx, gameServerID); shouldForward {
+		var response gameserversv1.RestoreGameServerBackupResponse
+		if err := s.forwardGameServerRequest(ctx, req.Msg, req.Header().Get("Authorization"), targetNodeID, "RestoreGameServerBackup", &response); err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&response), nil
+	}
+
+	if s.backupStore == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, backupstore.ErrNotConfigured)
+	}
+
+	var backup database.GameServerBackup
+	err := database.DB.WithContext(ctx).Where("id = ? AND game_server_id = ?", backupID, gameServerID).First(&backup).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("backup %s not found", backupID))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load backup: %w", err))
+	}
+	if backup.Status != gameServerBackupCompleted {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("backup %s is %s", backupID, backup.Status))
+	}
+
+	dbGameServer, err := s.repo.GetByID(ctx, gameServerID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("game server %s not found", gameServerID))
+	}
+	manager, err := s.getGameServerManager()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get game server manager: %w", err))
+	}
+
+	wasRunning := dbGameServer.Status == int32(gameserversv1.GameServerStatus_RUNNING)
+	if wasRunning {
+		if err := manager.StopGameServer(ctx, gameServerID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to stop game server: %w", err))
+		}
+	}
+
+	if err := s.extractGameServerBackup(ctx, gameServerID, backup.ObjectKey); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to restore backup: %w", err))
+	}
+	logger.Info("[GameServerBackups] Restored backup %s to game server %s", backupID, gameServerID)
+
+	if wasRunning {
+		if err := manager.StartGameServer(ctx, gameServerID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("backup restored but the server failed to start: %w", err))
+		}
+	}
+	return connect.NewResponse(&gameserversv1.RestoreGameServerBackupResponse{Success: true}), nil
+}
+
+func (s *Service) extractGameServerBackup(ctx context.Context, gameServerID, objectKey string) error {
+	body, err := s.backupStore.Download(ctx, objectKey)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return fmt.Errorf("open archive: %w", err)
+	}
+	defer gz.Close()
+
+	return s.copyTarToGameServer(ctx, gameServerID, "", "/data", gz)
+}
+
+// parseBackupSchedule parses a backup interval such as "6h" or "24h"
+func parseBackupSchedule(value string) (time.Duration, error) {
+	interval, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid backup schedule %q: use an interval such as \"6h\"", value)
+	}
+	if interval < minBackupInterval {
+		return 0, fmt.Errorf("backup schedule must be at least %v", minBackupInterval)
+	}
+	return interval, nil
+}
+
+func gameServerBackupToProto(backup *database.GameServerBackup) *gameserversv1.GameServerBackup {
+	pb := &gameserversv1.GameServerBackup{
+		Id:           backup.ID,
+		GameServerId: backup.GameServerID,
+		SizeBytes:    backup.SizeBytes,
+		Status:       backup.Status,
+		ErrorMessage: backup.ErrorMessage,
+		CreatedAt:    timestamppb.New(backup.CreatedAt),
+	}
+	if backup.CompletedAt != nil {
+		pb.CompletedAt = timestamppb.New(*backup.CompletedAt)
+	}
+	return pb
+}
@@ -0,0 +1,74 @@
+package gameservers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"gameservers-service/internal/backupstore"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+func TestParseBackupSchedule(t *testing.T) {
+	if interval, err := parseBackupSchedule("6h"); err != nil || interval != 6*time.Hour {
+		t.Fatalf("parseBackupSchedule(6h) = %v, %v", interval, err)
+	}
+	for _, value := range []string{"", "daily", "30m"} {
+		if _, err := parseBackupSchedule(value); err == nil {
+			t.Fatalf("parseBackupSchedule(%q) succeeded, want error", value)
+		}
+	}
+}
+
+func TestTrimGameServerBackups(t *testing.T) {
+	db := newTestDB(t, &database.GameServerBackup{})
+
+	var mu sync.Mutex
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+		mu.Lock()
+		deleted = append(deleted, r.URL.Path)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	statuses := []string{gameServerBackupCompleted, gameServerBackupFailed, gameServerBackupCompleted, gameServerBackupCompleted, gameServerBackupFailed, gameServerBackupCompleted}
+	for i, status := range statuses {
+		backup := database.GameServerBackup{
+			ID:           fmt.Sprintf("backup-%d", i),
+			GameServerID: "gs-1",
+			ObjectKey:    fmt.Sprintf("gameservers/gs-1/backup-%d.tar.gz", i),
+			Status:       status,
+			CreatedAt:    now.Add(-time.Duration(i) * time.Hour),
+		}
+		if err := db.Create(&backup).Error; err != nil {
+			t.Fatalf("seed backup: %v", err)
+		}
+	}
+
+	service := &Service{backupStore: backupstore.NewStore(server.URL, "us-east-1", "backups", "key", "secret")}
+	service.trimGameServerBackups(context.Background(), "gs-1", 2)
+
+	var remaining []string
+	if err := db.Model(&database.GameServerBackup{}).Order("id").Pluck("id", &remaining).Error; err != nil {
+		t.Fatalf("list backups: %v", err)
+	}
+	if want := []string{"backup-0", "backup-1", "backup-2", "backup-4"}; !slices.Equal(remaining, want) {
+		t.Fatalf("remaining backups = %v, want %v", remaining, want)
+	}
+	slices.Sort(deleted)
+	if want := []string{"/backups/gameservers/gs-1/backup-3.tar.gz", "/backups/gameservers/gs-1/backup-5.tar.gz"}; !slices.Equal(deleted, want) {
+		t.Fatalf("deleted objects = %v, want %v", deleted, want)
+	}
+}
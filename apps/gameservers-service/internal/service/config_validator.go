@@ -0,0 +1,115 @@
+package gameservers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ValidationError describes an invalid game server setting
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// GameServerConfigValidator checks the environment variables of a game type before provisioning
+type GameServerConfigValidator interface {
+	Validate(gameType int32, env map[string]string) []ValidationError
+}
+
+// configValidators maps game types to the validator of their settings; game types without one are not checked
+var configValidators = map[gameserversv1.GameType]GameServerConfigValidator{
+	gameserversv1.GameType_MINECRAFT:         MinecraftValidator{},
+	gameserversv1.GameType_MINECRAFT_JAVA:    MinecraftValidator{},
+	gameserversv1.GameType_MINECRAFT_BEDROCK: MinecraftValidator{},
+	gameserversv1.GameType_RUST:              RustValidator{},
+}
+
+const maxGameServerPlayers = 1000
+
+// MinecraftValidator checks the settings of the itzg Minecraft server images
+type MinecraftValidator struct{}
+
+func (MinecraftValidator) Validate(_ int32, env map[string]string) []ValidationError {
+	var errs []ValidationError
+	if value, ok := env["DIFFICULTY"]; ok {
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "peaceful", "easy", "normal", "hard":
+		default:
+			errs = append(errs, ValidationError{Field: "DIFFICULTY", Message: "must be one of peaceful, easy, normal or hard"})
+		}
+	}
+	if value, ok := env["MAX_PLAYERS"]; ok {
+		if err := validatePlayerCount("MAX_PLAYERS", value); err != nil {
+			errs = append(errs, *err)
+		}
+	}
+	if value, ok := env["SEED"]; ok && len(value) > 20 {
+		errs = append(errs, ValidationError{Field: "SEED", Message: "must be at most 20 characters"})
+	}
+	return errs
+}
+
+// RustValidator checks the settings of the Rust dedicated server image
+type RustValidator struct{}
+
+func (RustValidator) Validate(_ int32, env map[string]string) []ValidationError {
+	var errs []ValidationError
+	if value, ok := env["RUST_SERVER_SEED"]; ok {
+		if _, err := strconv.ParseUint(strings.TrimSpace(value), 10, 32); err != nil {
+			errs = append(errs, ValidationError{Field: "RUST_SERVER_SEED", Message: "must be a number between 0 and 4294967295"})
+		}
+	}
+	if value, ok := env["RUST_SERVER_MAXPLAYERS"]; ok {
+		if err := validatePlayerCount("RUST_SERVER_MAXPLAYERS", value); err != nil {
+			errs = append(errs, *err)
+		}
+	}
+	return errs
+}
+
+func validatePlayerCount(field, value string) *ValidationError {
+	players, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || players < 1 || players > maxGameServerPlayers {
+		return &ValidationError{Field: field, Message: fmt.Sprintf("must be a number between 1 and %d", maxGameServerPlayers)}
+	}
+	return nil
+}
+
+// validateGameServerConfig runs the validator of the game type and returns an InvalidArgument
+// error listing every invalid field, with the field messages attached as an error detail
+func validateGameServerConfig(gameType gameserversv1.GameType, env map[string]string) error {
+	validator, ok := configValidators[gameType]
+	if !ok {
+		return nil
+	}
+	errs := validator.Validate(int32(gameType), env)
+	if len(errs) == 0 {
+		return nil
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Field < errs[j].Field })
+
+	messages := make([]string, 0, len(errs))
+	fields := make(map[string]any, len(errs))
+	for _, e := range errs {
+		messages = append(messages, e.Error())
+		fields[e.Field] = e.Message
+	}
+	connectErr := connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid game server settings: %s", strings.Join(messages, "; ")))
+	if details, err := structpb.NewStruct(fields); err == nil {
+		if detail, err := connect.NewErrorDetail(details); err == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+	return connectErr
+}
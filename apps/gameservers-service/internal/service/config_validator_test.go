@@ -0,0 +1,72 @@
+package gameservers
+
+import (
+	"errors"
+	"testing"
+
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestMinecraftValidator(t *testing.T) {
+	valid := map[string]string{"DIFFICULTY": "hard", "MAX_PLAYERS": "20", "SEED": "-1234567890"}
+	if errs := (MinecraftValidator{}).Validate(int32(gameserversv1.GameType_MINECRAFT_JAVA), valid); len(errs) != 0 {
+		t.Fatalf("valid Minecraft settings rejected: %v", errs)
+	}
+
+	invalid := map[string]string{"DIFFICULTY": "insane", "MAX_PLAYERS": "1001", "SEED": "123456789012345678901"}
+	errs := (MinecraftValidator{}).Validate(int32(gameserversv1.GameType_MINECRAFT_JAVA), invalid)
+	fields := map[string]bool{}
+	for _, e := range errs {
+		fields[e.Field] = true
+	}
+	if len(errs) != 3 || !fields["DIFFICULTY"] || !fields["MAX_PLAYERS"] || !fields["SEED"] {
+		t.Fatalf("invalid Minecraft settings errors = %v, want DIFFICULTY, MAX_PLAYERS and SEED", errs)
+	}
+	if errs := (MinecraftValidator{}).Validate(int32(gameserversv1.GameType_MINECRAFT_JAVA), map[string]string{"MAX_PLAYERS": "0"}); len(errs) != 1 {
+		t.Fatalf("MAX_PLAYERS=0 errors = %v, want one", errs)
+	}
+}
+
+func TestRustValidator(t *testing.T) {
+	valid := map[string]string{"RUST_SERVER_SEED": "4294967295", "RUST_SERVER_MAXPLAYERS": "100"}
+	if errs := (RustValidator{}).Validate(int32(gameserversv1.GameType_RUST), valid); len(errs) != 0 {
+		t.Fatalf("valid Rust settings rejected: %v", errs)
+	}
+
+	for _, env := range []map[string]string{
+		{"RUST_SERVER_SEED": "4294967296"},
+		{"RUST_SERVER_SEED": "-1"},
+		{"RUST_SERVER_MAXPLAYERS": "many"},
+		{"RUST_SERVER_MAXPLAYERS": "0"},
+	} {
+		if errs := (RustValidator{}).Validate(int32(gameserversv1.GameType_RUST), env); len(errs) != 1 {
+			t.Fatalf("Rust settings %v errors = %v, want one", env, errs)
+		}
+	}
+}
+
+func TestValidateGameServerConfig(t *testing.T) {
+	if err := validateGameServerConfig(gameserversv1.GameType_VALHEIM, map[string]string{"DIFFICULTY": "insane"}); err != nil {
+		t.Fatalf("game type without a validator rejected settings: %v", err)
+	}
+
+	err := validateGameServerConfig(gameserversv1.GameType_MINECRAFT, map[string]string{"DIFFICULTY": "insane", "MAX_PLAYERS": "5000"})
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+	}
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || len(connectErr.Details()) != 1 {
+		t.Fatalf("error %v has no field details", err)
+	}
+	value, detailErr := connectErr.Details()[0].Value()
+	if detailErr != nil {
+		t.Fatalf("decode detail: %v", detailErr)
+	}
+	fields, ok := value.(*structpb.Struct)
+	if !ok || fields.GetFields()["DIFFICULTY"] == nil || fields.GetFields()["MAX_PLAYERS"] == nil {
+		t.Fatalf("error detail = %v, want DIFFICULTY and MAX_PLAYERS", value)
+	}
+}
@@ -3,8 +3,10 @@ package gameservers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/obiente/cloud/apps/shared/pkg/inputvalidation"
 	"github.com/obiente/cloud/apps/shared/pkg/logger"
 	sharedorchestrator "github.com/obiente/cloud/apps/shared/pkg/orchestrator"
+	"github.com/obiente/cloud/apps/shared/pkg/quota"
 
 	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
 
@@ -102,6 +105,16 @@ func (s *Service) ListGameServers(ctx context.Context, req *connect.Request[game
 		}
 	}
 
+	// Add tag filter if provided; every key=value pair must match
+	if tags := req.Msg.GetTags(); len(tags) > 0 {
+		for key, value := range tags {
+			if err := database.ValidateResourceTag(key, value); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+		}
+		filters.Tags = tags
+	}
+
 	// Get game servers filtered by organization and user ID
 	dbGameServers, err := s.repo.GetAll(ctx, orgID, filters)
 	if err != nil {
@@ -195,6 +208,22 @@ func (s *Service) CreateGameServer(ctx context.Context, req *connect.Request[gam
 			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
 	}
+	if err := validateGameServerConfig(req.Msg.GetGameType(), req.Msg.GetEnvVars()); err != nil {
+		return nil, err
+	}
+
+	teamID := strings.TrimSpace(req.Msg.GetTeamId())
+	if teamID != "" {
+		if err := quota.NewChecker().CheckTeamQuota(orgID, teamID); err != nil {
+			if errors.Is(err, quota.ErrTeamNotFound) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			if errors.Is(err, quota.ErrTeamQuotaExceeded) {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("quota check failed: %w", err))
+			}
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
 
 	// Get memory bytes (default to 2GB if not specified)
 	memoryBytes := req.Msg.GetMemoryBytes()
@@ -252,31 +281,43 @@ func (s *Service) CreateGameServer(ctx context.Context, req *connect.Request[gam
 	}
 
 	if err := s.repo.WithPortAllocationLock(ctx, func(txRepo *database.GameServerRepository) error {
+		templatePortOffsets := gameserverorchestrator.GameTemplatePortOffsets(dbGameServer.GameType)
 		if port != 0 {
-			available, err := txRepo.IsPortAvailable(ctx, port, "")
-			if err != nil {
-				return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to validate requested port: %w", err))
+			templatePorts := gameserverorchestrator.GameTemplatePorts(dbGameServer.GameType, port)
+			if len(templatePorts) != len(templatePortOffsets) {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("requested port %d leaves no room for the game's additional ports", port))
 			}
-			if !available {
-				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("requested port %d is already in use", port))
+			for _, requiredPort := range append([]int32{port}, templatePorts...) {
+				available, err := txRepo.IsPortAvailable(ctx, requiredPort, "")
+				if err != nil {
+					return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to validate requested port: %w", err))
+				}
+				if !available {
+					return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("requested port %d is already in use", requiredPort))
+				}
 			}
 		}
 
 		if port == 0 {
-			availablePort, err := txRepo.GetAvailablePort(ctx, 25565) // Start from Minecraft default port
+			// Start from Minecraft default port
+			availablePorts, err := txRepo.GetAvailablePortWithOffsets(ctx, 25565, templatePortOffsets, "")
 			if err != nil {
 				return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get available port: %w", err))
 			}
-			port = availablePort
+			port = availablePorts[0]
 		}
 
-		extraPorts = make([]int32, 0)
+		// Ports the game itself listens on come first, so other game servers are never given them
+		extraPorts = gameserverorchestrator.GameTemplatePorts(dbGameServer.GameType, port)
 		if extraPortsCount > 0 {
-			allocatedPorts, err := txRepo.GetAvailablePorts(ctx, 25565, int(extraPortsCount), []int32{port}, "")
+			allocatedPorts, err := txRepo.GetAvailablePorts(ctx, 25565, int(extraPortsCount), append([]int32{port}, extraPorts...), "")
 			if err != nil {
 				return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to allocate extra ports: %w", err))
 			}
-			extraPorts = allocatedPorts
+			extraPorts = append(extraPorts, allocatedPorts...)
+		}
+		if extraPorts == nil {
+			extraPorts = make([]int32, 0)
 		}
 
 		extraPortsJSONBytes, err := json.Marshal(extraPorts)
@@ -287,6 +328,14 @@ func (s *Service) CreateGameServer(ctx context.Context, req *connect.Request[gam
 		dbGameServer.Port = port
 		dbGameServer.ExtraPorts = string(extraPortsJSONBytes)
 
+		// CS2 serves RCON on the game port, so credentials are generated once the port is known
+		rconPort, rconPassword, err := gameserverorchestrator.RCONSettings(dbGameServer.GameType, port)
+		if err != nil {
+			logger.Warn("[CreateGameServer] RCON disabled for game server %s: %v", id, err)
+		}
+		dbGameServer.RCONPort = rconPort
+		dbGameServer.RCONPassword = rconPassword
+
 		if err := txRepo.Create(ctx, dbGameServer); err != nil {
 			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create game server: %w", err))
 		}
@@ -296,6 +345,12 @@ func (s *Service) CreateGameServer(ctx context.Context, req *connect.Request[gam
 		return nil, err
 	}
 
+	if teamID != "" {
+		if err := database.SetResourceTag(orgID, database.ResourceTagTypeGameServer, id, database.ResourceTagKeyTeam, teamID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to assign game server to team: %w", err))
+		}
+	}
+
 	// Parse environment variables for container creation
 	envVars := make(map[string]string)
 	if len(req.Msg.GetEnvVars()) > 0 {
@@ -334,6 +389,7 @@ func (s *Service) CreateGameServer(ctx context.Context, req *connect.Request[gam
 		}
 		config := &gameserverorchestrator.GameServerConfig{
 			GameServerID: id,
+			GameType:     int32(req.Msg.GetGameType()),
 			Image:        dockerImage,
 			Port:         port,
 			ExtraPorts:   extraPorts,
@@ -343,6 +399,7 @@ func (s *Service) CreateGameServer(ctx context.Context, req *connect.Request[gam
 			StartCommand: req.Msg.StartCommand,
 			TargetNodeID: targetNodeID,
 		}
+		gameserverorchestrator.ApplyRCONConfig(config, dbGameServer)
 
 		if err := manager.CreateGameServer(ctx, config); err != nil {
 			// Update status to FAILED if container creation fails
@@ -598,19 +655,26 @@ func (s *Service) UpdateGameServer(ctx context.Context, req *connect.Request[gam
 		err := s.repo.WithPortAllocationLock(ctx, func(txRepo *database.GameServerRepository) error {
 			desiredCount := int(*req.Msg.ExtraPortsCount)
 
-			currentExtraPorts := database.ParseGameServerExtraPorts(dbGameServer.ExtraPorts)
-			desiredExtraPorts := append([]int32{}, currentExtraPorts...)
+			// The game's own ports are kept and do not count towards the requested extra ports
+			templatePorts := gameserverorchestrator.GameTemplatePorts(dbGameServer.GameType, dbGameServer.Port)
+			desiredExtraPorts := make([]int32, 0)
+			for _, extraPort := range database.ParseGameServerExtraPorts(dbGameServer.ExtraPorts) {
+				if !slices.Contains(templatePorts, extraPort) {
+					desiredExtraPorts = append(desiredExtraPorts, extraPort)
+				}
+			}
 
 			if desiredCount < len(desiredExtraPorts) {
 				desiredExtraPorts = desiredExtraPorts[:desiredCount]
 			} else if desiredCount > len(desiredExtraPorts) {
-				reserved := append([]int32{dbGameServer.Port}, desiredExtraPorts...)
+				reserved := append(append([]int32{dbGameServer.Port}, templatePorts...), desiredExtraPorts...)
 				newPorts, err := txRepo.GetAvailablePorts(ctx, 25565, desiredCount-len(desiredExtraPorts), reserved, dbGameServer.ID)
 				if err != nil {
 					return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to allocate extra ports: %w", err))
 				}
 				desiredExtraPorts = append(desiredExtraPorts, newPorts...)
 			}
+			desiredExtraPorts = append(templatePorts, desiredExtraPorts...)
 
 			extraPortsBytes, err := json.Marshal(desiredExtraPorts)
 			if err != nil {
@@ -768,6 +832,11 @@ func (s *Service) StartGameServer(ctx context.Context, req *connect.Request[game
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to start game server container: %w", err))
 	}
 
+	// The idle countdown starts over from this start
+	if err := database.ClearGameServerPlayerSeen(ctx, gameServerID); err != nil {
+		logger.Warn("[StartGameServer] %v", err)
+	}
+
 	// Update storage after container is started
 	go func() {
 		bgCtx, cancel := s.detachedContext(30 * time.Second)
@@ -955,7 +1024,7 @@ func getDefaultDockerImage(gameType gameserversv1.GameType) string {
 		return "itzg/minecraft-bedrock-server:latest"
 	case gameserversv1.GameType_VALHEIM:
 		// lloesche/valheim-server is a popular Valheim server image
-		return "lloesche/valheim-server:latest"
+		return "ghcr.io/lloesche/valheim-server:latest"
 	case gameserversv1.GameType_TERRARIA:
 		// beardedio/terraria is a well-maintained Terraria server image
 		// Alternative: ryshe/terraria (if beardedio doesn't work)
@@ -12,7 +12,6 @@ import (
 	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
 	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
 	"google.golang.org/protobuf/proto"
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -107,31 +106,15 @@ func TestGameServerServiceTenantIsolation(t *testing.T) {
 func newGameServerServiceTestDB(t *testing.T) *gorm.DB {
 	t.Helper()
 
-	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	if err != nil {
-		t.Fatalf("open sqlite db: %v", err)
-	}
-	if err := db.AutoMigrate(
+	return newTestDB(t,
 		&database.GameServer{},
 		&database.GameServerLocation{},
 		&database.Organization{},
 		&database.OrganizationMember{},
 		&database.OrgRole{},
 		&database.OrgRoleBinding{},
-	); err != nil {
-		t.Fatalf("migrate sqlite db: %v", err)
-	}
-
-	previousDB := database.DB
-	previousMetricsDB := database.MetricsDB
-	database.DB = db
-	database.MetricsDB = db
-	t.Cleanup(func() {
-		database.DB = previousDB
-		database.MetricsDB = previousMetricsDB
-	})
-
-	return db
+		&database.ResourceTag{},
+	)
 }
 
 func seedGameServerServiceIsolationData(t *testing.T, db *gorm.DB) {
@@ -0,0 +1,455 @@
+package gameservers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/redis"
+	"github.com/obiente/cloud/apps/shared/pkg/secrets"
+
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	discordRateLimit       = 5 // messages per window per integration
+	discordRateLimitWindow = 10 * time.Second
+	discordRequestTimeout  = 10 * time.Second
+	discordEventTimeout    = 30 * time.Second
+)
+
+// discordAPIBaseURL is the root of the Discord REST API (replaced in tests)
+var discordAPIBaseURL = "https://discord.com/api/v10"
+
+var discordHTTPClient = &http.Client{Timeout: discordRequestTimeout}
+
+// discordChannelIDPattern matches Discord snowflake IDs
+var discordChannelIDPattern = regexp.MustCompile(`^[0-9]{1,20}$`)
+
+// minecraftPlayerEventPattern matches the join and leave lines Minecraft servers log. Chat lines
+// start with "<name>" after the "]: " prefix, so players cannot fake these by chatting.
+var minecraftPlayerEventPattern = regexp.MustCompile(`\]: ([A-Za-z0-9_]{1,16}) (joined|left) the game$`)
+
+var ansiEscapePattern = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// SetDiscordIntegration creates or updates the Discord integration of a game server
+func (s *Service) SetDiscordIntegration(ctx context.Context, req *connect.Request[gameserversv1.SetDiscordIntegrationRequest]) (*connect.Response[gameserversv1.SetDiscordIntegrationResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	if gameServerID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game_server_id is required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersUpdate); err != nil {
+		return nil, err
+	}
+
+	channelID := strings.TrimSpace(req.Msg.GetChannelId())
+	if !discordChannelIDPattern.MatchString(channelID) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("channel_id must be a Discord channel ID"))
+	}
+	events, err := normalizeDiscordEvents(req.Msg.GetEvents())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	gameServer, err := s.repo.GetByID(ctx, gameServerID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("game server %s not found", gameServerID))
+	}
+
+	var integration database.DiscordIntegration
+	err = database.DB.WithContext(ctx).Where("game_server_id = ?", gameServerID).First(&integration).Error
+	exists := err == nil
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load Discord integration: %w", err))
+	}
+
+	botToken := strings.TrimSpace(req.Msg.GetBotToken())
+	if botToken == "" && !exists {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("bot_token is required"))
+	}
+	if botToken != "" {
+		cipher, err := secrets.NewTokenCipherFromEnv()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("bot token encryption: %w", err))
+		}
+		encrypted, err := cipher.EncryptString(botToken)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to encrypt bot token: %w", err))
+		}
+		integration.BotToken = encrypted
+	}
+
+	now := time.Now()
+	if !exists {
+		integration.ID = fmt.Sprintf("gsdiscord-%s", uuid.NewString())
+		integration.GameServerID = gameServerID
+		integration.OrganizationID = gameServer.OrganizationID
+		integration.CreatedAt = now
+		if userInfo, err := auth.GetUserFromContext(ctx); err == nil && userInfo != nil {
+			integration.CreatedBy = userInfo.Id
+		}
+	}
+	integration.ChannelID = channelID
+	integration.Events = strings.Join(events, ",")
+	integration.UpdatedAt = now
+
+	if err := database.DB.WithContext(ctx).Save(&integration).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save Discord integration: %w", err))
+	}
+	if !integration.HasEvent(database.DiscordEventPlayerJoin) && !integration.HasEvent(database.DiscordEventPlayerLeave) {
+		s.stopDiscordPlayerWatch(gameServerID)
+	}
+
+	logger.Info("[GameServerDiscord] Set Discord integration %s for game server %s (events: %s)", integration.ID, gameServerID, integration.Events)
+	return connect.NewResponse(&gameserversv1.SetDiscordIntegrationResponse{
+		Integration: discordIntegrationToProto(&integration),
+	}), nil
+}
+
+// DeleteDiscordIntegration removes the Discord integration of a game server
+func (s *Service) DeleteDiscordIntegration(ctx context.Context, req *connect.Request[gameserversv1.DeleteDiscordIntegrationRequest]) (*connect.Response[gameserversv1.DeleteDiscordIntegrationResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	if gameServerID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game_server_id is required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersUpdate); err != nil {
+		return nil, err
+	}
+
+	result := database.DB.WithContext(ctx).Where("game_server_id = ?", gameServerID).Delete(&database.DiscordIntegration{})
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete Discord integration: %w", result.Error))
+	}
+	if result.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("game server %s has no Discord integration", gameServerID))
+	}
+	s.stopDiscordPlayerWatch(gameServerID)
+
+	logger.Info("[GameServerDiscord] Deleted Discord integration of game server %s", gameServerID)
+	return connect.NewResponse(&gameserversv1.DeleteDiscordIntegrationResponse{Success: true}), nil
+}
+
+// normalizeDiscordEvents validates and deduplicates event names; no events subscribes to all of them
+func normalizeDiscordEvents(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return append([]string(nil), database.DiscordEvents...), nil
+	}
+	seen := make(map[string]bool, len(requested))
+	for _, event := range requested {
+		event = strings.ToLower(strings.TrimSpace(event))
+		valid := false
+		for _, known := range database.DiscordEvents {
+			if event == known {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("unknown event %q (expected one of %s)", event, strings.Join(database.DiscordEvents, ", "))
+		}
+		seen[event] = true
+	}
+	// Keep the canonical order so the stored value does not depend on the request
+	events := make([]string, 0, len(seen))
+	for _, known := range database.DiscordEvents {
+		if seen[known] {
+			events = append(events, known)
+		}
+	}
+	return events, nil
+}
+
+func discordIntegrationToProto(integration *database.DiscordIntegration) *gameserversv1.DiscordIntegration {
+	return &gameserversv1.DiscordIntegration{
+		Id:           integration.ID,
+		GameServerId: integration.GameServerID,
+		ChannelId:    integration.ChannelID,
+		Events:       integration.EventList(),
+		CreatedAt:    timestamppb.New(integration.CreatedAt),
+		UpdatedAt:    timestamppb.New(integration.UpdatedAt),
+	}
+}
+
+// discordEmbed is the subset of a Discord message embed used for game server events
+type discordEmbed struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description"`
+	Color       int                 `json:"color"`
+	Fields      []discordEmbedField `json:"fields,omitempty"`
+	Timestamp   string              `json:"timestamp"`
+	Footer      *discordEmbedFooter `json:"footer,omitempty"`
+}
+
+type discordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type discordEmbedFooter struct {
+	Text string `json:"text"`
+}
+
+type discordMessage struct {
+	Embeds []discordEmbed `json:"embeds"`
+}
+
+// discordEventStyles holds the label and embed color of each event
+var discordEventStyles = map[string]struct {
+	label string
+	color int
+}{
+	database.DiscordEventStart:       {"Server started", 0x57F287},
+	database.DiscordEventStop:        {"Server stopped", 0x95A5A6},
+	database.DiscordEventCrash:       {"Server crashed", 0xED4245},
+	database.DiscordEventPlayerJoin:  {"Player joined", 0x5865F2},
+	database.DiscordEventPlayerLeave: {"Player left", 0xFEE75C},
+}
+
+// buildDiscordEventMessage formats a game server event as a Discord embed. detail is the player
+// name for player events and an optional reason (e.g. the exit code) for the others.
+func buildDiscordEventMessage(serverName, event, detail string, at time.Time) discordMessage {
+	style := discordEventStyles[event]
+	description := style.label
+	fields := []discordEmbedField{{Name: "Event", Value: event, Inline: true}}
+	switch event {
+	case database.DiscordEventPlayerJoin, database.DiscordEventPlayerLeave:
+		verb := "joined"
+		if event == database.DiscordEventPlayerLeave {
+			verb = "left"
+		}
+		description = fmt.Sprintf("**%s** %s the server", detail, verb)
+		fields = append(fields, discordEmbedField{Name: "Player", Value: detail, Inline: true})
+	default:
+		if detail != "" {
+			fields = append(fields, discordEmbedField{Name: "Details", Value: detail, Inline: true})
+		}
+	}
+
+	return discordMessage{Embeds: []discordEmbed{{
+		Title:       serverName,
+		Description: description,
+		Color:       style.color,
+		Fields:      fields,
+		Timestamp:   at.UTC().Format(time.RFC3339),
+		Footer:      &discordEmbedFooter{Text: "Obiente Cloud"},
+	}}}
+}
+
+// postDiscordMessage sends a message to a channel as the bot
+func postDiscordMessage(ctx context.Context, botToken, channelID string, message discordMessage) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("encode Discord message: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/channels/%s/messages", discordAPIBaseURL, channelID), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create Discord request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bot "+botToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "DiscordBot (https://obiente.cloud, 1.0)")
+
+	resp, err := discordHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("post Discord message: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("discord API returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
+
+// sendDiscordEvent posts an event to every Discord integration of the game server subscribed to it
+func sendDiscordEvent(ctx context.Context, gameServerID, event, detail string) {
+	integrations, err := database.ListDiscordIntegrationsForEvent(ctx, gameServerID, event)
+	if err != nil {
+		logger.Warn("[GameServerDiscord] Failed to load Discord integrations for game server %s: %v", gameServerID, err)
+		return
+	}
+	if len(integrations) == 0 {
+		return
+	}
+
+	var gameServer database.GameServer
+	if err := database.DB.WithContext(ctx).Select("id", "name").Where("id = ?", gameServerID).First(&gameServer).Error; err != nil {
+		logger.Warn("[GameServerDiscord] Failed to load game server %s: %v", gameServerID, err)
+		return
+	}
+	message := buildDiscordEventMessage(gameServer.Name, event, detail, time.Now())
+
+	for _, integration := range integrations {
+		allowed, err := allowDiscordMessage(ctx, integration.ID)
+		if err != nil {
+			logger.Warn("[GameServerDiscord] Rate limit check failed for Discord integration %s, sending message: %v", integration.ID, err)
+		}
+		if !allowed {
+			logger.Debug("[GameServerDiscord] Dropped %s event of game server %s: integration %s is over %d messages per %s", event, gameServerID, integration.ID, discordRateLimit, discordRateLimitWindow)
+			continue
+		}
+
+		botToken, err := decryptDiscordBotToken(integration.BotToken)
+		if err != nil {
+			logger.Warn("[GameServerDiscord] Failed to decrypt bot token of Discord integration %s: %v", integration.ID, err)
+			continue
+		}
+		if err := postDiscordMessage(ctx, botToken, integration.ChannelID, message); err != nil {
+			logger.Warn("[GameServerDiscord] Failed to post %s event of game server %s to Discord channel %s: %v", event, gameServerID, integration.ChannelID, err)
+		}
+	}
+}
+
+func decryptDiscordBotToken(encrypted string) (string, error) {
+	if !secrets.IsEncryptedString(encrypted) {
+		return encrypted, nil
+	}
+	cipher, err := secrets.NewTokenCipherFromEnv()
+	if err != nil {
+		return "", err
+	}
+	return cipher.DecryptString(encrypted)
+}
+
+// notifyDiscord posts a game server event to Discord in the background
+func (s *Service) notifyDiscord(gameServerID, event, detail string) {
+	go func() {
+		ctx, cancel := s.detachedContext(discordEventTimeout)
+		defer cancel()
+		sendDiscordEvent(ctx, gameServerID, event, detail)
+	}()
+}
+
+// allowDiscordMessage counts a message against the integration's limit of discordRateLimit messages in
+// any discordRateLimitWindow. Redis keeps the window so the limit holds across nodes; without Redis
+// each node keeps its own.
+func allowDiscordMessage(ctx context.Context, integrationID string) (bool, error) {
+	now := time.Now()
+	rdb := redis.GetClient()
+	if rdb == nil {
+		return discordLocalLimiter.allow(integrationID, now), nil
+	}
+
+	key := fmt.Sprintf("gameserver:discord:ratelimit:%s", integrationID)
+	member := fmt.Sprintf("%d-%s", now.UnixNano(), uuid.NewString())
+	pipe := rdb.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-discordRateLimitWindow).UnixNano(), 10))
+	pipe.Do(ctx, "zadd", key, now.UnixNano(), member)
+	count := pipe.ZCard(ctx, key)
+	pipe.PExpire(ctx, key, discordRateLimitWindow)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return true, err
+	}
+	if count.Val() > discordRateLimit {
+		// Dropped messages do not use up the window
+		if err := rdb.ZRem(ctx, key, member).Err(); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+// discordWindowLimiter is the in-memory sliding window used when Redis is not configured
+type discordWindowLimiter struct {
+	mu   sync.Mutex
+	sent map[string][]time.Time
+}
+
+var discordLocalLimiter = &discordWindowLimiter{sent: make(map[string][]time.Time)}
+
+func (l *discordWindowLimiter) allow(integrationID string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cutoff := now.Add(-discordRateLimitWindow)
+	var recent []time.Time
+	for _, sentAt := range l.sent[integrationID] {
+		if sentAt.After(cutoff) {
+			recent = append(recent, sentAt)
+		}
+	}
+	if len(recent) >= discordRateLimit {
+		l.sent[integrationID] = recent
+		return false
+	}
+	l.sent[integrationID] = append(recent, now)
+	return true
+}
+
+// parsePlayerEvent returns the player event and player name of a Minecraft join or leave log line
+func parsePlayerEvent(line string) (event, player string, ok bool) {
+	line = strings.TrimSpace(ansiEscapePattern.ReplaceAllString(line, ""))
+	match := minecraftPlayerEventPattern.FindStringSubmatch(line)
+	if match == nil {
+		return "", "", false
+	}
+	if match[2] == "joined" {
+		return database.DiscordEventPlayerJoin, match[1], true
+	}
+	return database.DiscordEventPlayerLeave, match[1], true
+}
+
+// watchDiscordPlayerEvents follows the logs of a running game server whose Discord integration posts
+// player events, so join and leave lines are seen without a terminal attached. The log follower's
+// Redis lock keeps a single follower per game server across nodes.
+func (s *Service) watchDiscordPlayerEvents(ctx context.Context, gameServerID string) {
+	s.discordWatchMu.Lock()
+	_, watching := s.discordWatches[gameServerID]
+	s.discordWatchMu.Unlock()
+	if watching {
+		return
+	}
+
+	var count int64
+	if err := database.DB.WithContext(ctx).Model(&database.DiscordIntegration{}).
+		Where("game_server_id = ? AND (events LIKE ? OR events LIKE ?)", gameServerID, "%"+database.DiscordEventPlayerJoin+"%", "%"+database.DiscordEventPlayerLeave+"%").
+		Count(&count).Error; err != nil || count == 0 {
+		return
+	}
+
+	watchCtx, cancel := s.detachedContext(0)
+	s.discordWatchMu.Lock()
+	if _, watching := s.discordWatches[gameServerID]; watching {
+		s.discordWatchMu.Unlock()
+		cancel()
+		return
+	}
+	if s.discordWatches == nil {
+		s.discordWatches = make(map[string]context.CancelFunc)
+	}
+	s.discordWatches[gameServerID] = cancel
+	s.discordWatchMu.Unlock()
+
+	logger.Debug("[GameServerDiscord] Following logs of game server %s for player events", gameServerID)
+	go s.followGameServerLogs(watchCtx, gameServerID)
+}
+
+// stopDiscordPlayerWatch stops following a game server's logs for player events
+func (s *Service) stopDiscordPlayerWatch(gameServerID string) {
+	s.discordWatchMu.Lock()
+	cancel, watching := s.discordWatches[gameServerID]
+	delete(s.discordWatches, gameServerID)
+	s.discordWatchMu.Unlock()
+	if watching {
+		cancel()
+	}
+}
@@ -0,0 +1,202 @@
+package gameservers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/secrets"
+)
+
+// mockDiscordAPI records the messages posted to it
+type mockDiscordAPI struct {
+	mu       sync.Mutex
+	messages []mockDiscordRequest
+}
+
+type mockDiscordRequest struct {
+	path          string
+	authorization string
+	message       discordMessage
+}
+
+func (m *mockDiscordAPI) serve(t *testing.T) {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		var message discordMessage
+		if err := json.NewDecoder(r.Body).Decode(&message); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		m.mu.Lock()
+		m.messages = append(m.messages, mockDiscordRequest{path: r.URL.Path, authorization: r.Header.Get("Authorization"), message: message})
+		m.mu.Unlock()
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	previousURL := discordAPIBaseURL
+	discordAPIBaseURL = server.URL + "/api/v10"
+	t.Cleanup(func() { discordAPIBaseURL = previousURL })
+}
+
+func (m *mockDiscordAPI) received() []mockDiscordRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]mockDiscordRequest(nil), m.messages...)
+}
+
+func setupDiscordTest(t *testing.T, events string) *mockDiscordAPI {
+	t.Helper()
+	t.Setenv("SECRET", "discord-test-secret")
+	db := newTestDB(t, &database.GameServer{}, &database.DiscordIntegration{})
+
+	cipher, err := secrets.NewTokenCipherFromEnv()
+	if err != nil {
+		t.Fatalf("token cipher: %v", err)
+	}
+	botToken, err := cipher.EncryptString("bot-token")
+	if err != nil {
+		t.Fatalf("encrypt bot token: %v", err)
+	}
+	records := []any{
+		&database.GameServer{ID: "gs-1", Name: "Survival", OrganizationID: "org-1", EnvVars: "{}", ExtraPorts: "[]"},
+		&database.DiscordIntegration{ID: "gsdiscord-1", OrganizationID: "org-1", GameServerID: "gs-1", BotToken: botToken, ChannelID: "123456789", Events: events},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	previousLimiter := discordLocalLimiter
+	discordLocalLimiter = &discordWindowLimiter{sent: make(map[string][]time.Time)}
+	t.Cleanup(func() { discordLocalLimiter = previousLimiter })
+
+	api := &mockDiscordAPI{}
+	api.serve(t)
+	return api
+}
+
+func TestSendDiscordEventPostsEmbed(t *testing.T) {
+	api := setupDiscordTest(t, "start,player_join")
+
+	sendDiscordEvent(context.Background(), "gs-1", database.DiscordEventPlayerJoin, "Steve")
+	sendDiscordEvent(context.Background(), "gs-1", database.DiscordEventStop, "")
+
+	received := api.received()
+	if len(received) != 1 {
+		t.Fatalf("received %d messages, want only the subscribed player_join event", len(received))
+	}
+	request := received[0]
+	if request.path != "/api/v10/channels/123456789/messages" {
+		t.Errorf("path = %q, want the channel's messages endpoint", request.path)
+	}
+	if request.authorization != "Bot bot-token" {
+		t.Errorf("Authorization = %q, want the decrypted bot token", request.authorization)
+	}
+	if len(request.message.Embeds) != 1 {
+		t.Fatalf("embeds = %+v, want one", request.message.Embeds)
+	}
+	embed := request.message.Embeds[0]
+	if embed.Title != "Survival" || embed.Description != "**Steve** joined the server" || embed.Color != 0x5865F2 {
+		t.Errorf("embed = %+v, want the server name and the joining player", embed)
+	}
+	if len(embed.Fields) != 2 || embed.Fields[0].Value != database.DiscordEventPlayerJoin || embed.Fields[1].Value != "Steve" {
+		t.Errorf("fields = %+v, want the event and the player", embed.Fields)
+	}
+	if _, err := time.Parse(time.RFC3339, embed.Timestamp); err != nil {
+		t.Errorf("timestamp %q is not RFC 3339: %v", embed.Timestamp, err)
+	}
+}
+
+func TestSendDiscordEventRateLimit(t *testing.T) {
+	api := setupDiscordTest(t, "player_join,player_leave")
+
+	for i := 0; i < discordRateLimit+3; i++ {
+		sendDiscordEvent(context.Background(), "gs-1", database.DiscordEventPlayerLeave, "Alex")
+	}
+	if got := len(api.received()); got != discordRateLimit {
+		t.Fatalf("received %d messages, want the rate limit of %d", got, discordRateLimit)
+	}
+}
+
+func TestDiscordWindowLimiter(t *testing.T) {
+	limiter := &discordWindowLimiter{sent: make(map[string][]time.Time)}
+	start := time.Now()
+	for i := 0; i < discordRateLimit; i++ {
+		if !limiter.allow("a", start.Add(time.Duration(i)*time.Second)) {
+			t.Fatalf("message %d refused within the limit", i+1)
+		}
+	}
+	if limiter.allow("a", start.Add(5*time.Second)) {
+		t.Fatalf("message over the limit allowed")
+	}
+	if !limiter.allow("b", start.Add(5*time.Second)) {
+		t.Fatalf("another integration was limited")
+	}
+	// The first message leaves the window 10 seconds after it was sent
+	if !limiter.allow("a", start.Add(discordRateLimitWindow+time.Millisecond)) {
+		t.Fatalf("message refused after the oldest one left the window")
+	}
+	if limiter.allow("a", start.Add(discordRateLimitWindow+2*time.Millisecond)) {
+		t.Fatalf("window did not slide: second message allowed while four others are still in it")
+	}
+}
+
+func TestBuildDiscordEventMessageCrash(t *testing.T) {
+	at := time.Date(2026, 5, 1, 12, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+	embed := buildDiscordEventMessage("Creative", database.DiscordEventCrash, "Exit code 137 (out of memory)", at).Embeds[0]
+	if embed.Title != "Creative" || embed.Description != "Server crashed" || embed.Color != 0xED4245 {
+		t.Fatalf("embed = %+v, want a red crash message", embed)
+	}
+	if len(embed.Fields) != 2 || embed.Fields[1].Name != "Details" || embed.Fields[1].Value != "Exit code 137 (out of memory)" {
+		t.Fatalf("fields = %+v, want the event and the crash details", embed.Fields)
+	}
+	if embed.Timestamp != "2026-05-01T10:30:00Z" {
+		t.Fatalf("timestamp = %q, want UTC", embed.Timestamp)
+	}
+}
+
+func TestParsePlayerEvent(t *testing.T) {
+	tests := []struct {
+		line   string
+		event  string
+		player string
+	}{
+		{"[12:00:01] [Server thread/INFO]: Steve joined the game", database.DiscordEventPlayerJoin, "Steve"},
+		{"[12:05:44] [Server thread/INFO]: Alex_99 left the game\r", database.DiscordEventPlayerLeave, "Alex_99"},
+		{"\x1b[32m[12:00:01] [Server thread/INFO]: Steve joined the game\x1b[0m", database.DiscordEventPlayerJoin, "Steve"},
+		{"[12:00:02] [Server thread/INFO]: <Steve> Notch joined the game", "", ""},
+		{"[12:00:03] [Server thread/INFO]: Done (4.2s)! For help, type \"help\"", "", ""},
+	}
+	for _, tt := range tests {
+		event, player, ok := parsePlayerEvent(tt.line)
+		if ok != (tt.event != "") || event != tt.event || player != tt.player {
+			t.Errorf("parsePlayerEvent(%q) = %q, %q, %v, want %q, %q", tt.line, event, player, ok, tt.event, tt.player)
+		}
+	}
+}
+
+func TestNormalizeDiscordEvents(t *testing.T) {
+	events, err := normalizeDiscordEvents(nil)
+	if err != nil || len(events) != len(database.DiscordEvents) {
+		t.Fatalf("no events = %v, %v, want all events", events, err)
+	}
+	events, err = normalizeDiscordEvents([]string{"Crash", "start", "crash"})
+	if err != nil || len(events) != 2 || events[0] != "start" || events[1] != "crash" {
+		t.Fatalf("events = %v, %v, want [start crash]", events, err)
+	}
+	if _, err := normalizeDiscordEvents([]string{"restart"}); err == nil {
+		t.Fatalf("unknown event accepted")
+	}
+}
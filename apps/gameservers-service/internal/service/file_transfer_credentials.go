@@ -1,6 +1,7 @@
 package gameservers
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -8,6 +9,8 @@ import (
 	"strings"
 	"time"
 
+	"golang.org/x/crypto/ssh"
+
 	"github.com/obiente/cloud/apps/shared/pkg/auth"
 	"github.com/obiente/cloud/apps/shared/pkg/database"
 
@@ -19,6 +22,9 @@ import (
 
 const fileTransferUsernamePrefix = "gs_"
 
+// maxAuthorizedKeysPerCredential caps the SSH public keys a single credential accepts
+const maxAuthorizedKeysPerCredential = 20
+
 func (s *Service) ListGameServerFileTransferCredentials(ctx context.Context, req *connect.Request[gameserversv1.ListGameServerFileTransferCredentialsRequest]) (*connect.Response[gameserversv1.ListGameServerFileTransferCredentialsResponse], error) {
 	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
 	if gameServerID == "" {
@@ -68,6 +74,9 @@ func (s *Service) CreateGameServerFileTransferCredential(ctx context.Context, re
 	}
 
 	scopes := normalizeRequestedFileTransferScopes(req.Msg.GetScopes())
+	if err := database.ValidateFileTransferAllowedPaths(req.Msg.GetAllowedPaths()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	secret, err := database.GenerateFileTransferSecret()
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate file transfer password: %w", err))
@@ -90,6 +99,7 @@ func (s *Service) CreateGameServerFileTransferCredential(ctx context.Context, re
 		ResourceType:   database.FileTransferResourceGameServer,
 		ResourceID:     gameServerID,
 		Scopes:         strings.Join(scopes, ","),
+		AllowedPaths:   database.EncodeFileTransferAllowedPaths(req.Msg.GetAllowedPaths()),
 		ExpiresAt:      expiresAt,
 	}
 
@@ -123,6 +133,101 @@ func (s *Service) RevokeGameServerFileTransferCredential(ctx context.Context, re
 	return connect.NewResponse(&gameserversv1.RevokeGameServerFileTransferCredentialResponse{Success: true}), nil
 }
 
+func (s *Service) AddAuthorizedKey(ctx context.Context, req *connect.Request[gameserversv1.AddAuthorizedKeyRequest]) (*connect.Response[gameserversv1.AddAuthorizedKeyResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	credentialID := strings.TrimSpace(req.Msg.GetCredentialId())
+	if gameServerID == "" || credentialID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game server ID and credential ID are required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersUpdate); err != nil {
+		return nil, err
+	}
+
+	key, line, err := parseAuthorizedKeyLine(req.Msg.GetPublicKey())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	repo := database.NewFileTransferCredentialRepository(database.DB)
+	credential, err := repo.GetActiveByResource(ctx, credentialID, database.FileTransferResourceGameServer, gameServerID, time.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("file transfer credential %s not found", credentialID))
+	}
+
+	keys := database.SplitFileTransferAuthorizedKeys(credential.AuthorizedPublicKeys)
+	for _, existing := range keys {
+		if authorized, _, err := parseAuthorizedKeyLine(existing); err == nil && bytes.Equal(authorized.Marshal(), key.Marshal()) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("public key %s is already authorized", ssh.FingerprintSHA256(key)))
+		}
+	}
+	if len(keys) >= maxAuthorizedKeysPerCredential {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("a credential can have at most %d authorized keys", maxAuthorizedKeysPerCredential))
+	}
+
+	keys = append(keys, line)
+	if err := repo.UpdateAuthorizedPublicKeys(ctx, credential.ID, keys); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to authorize public key: %w", err))
+	}
+	credential.AuthorizedPublicKeys = strings.Join(keys, "\n")
+
+	return connect.NewResponse(&gameserversv1.AddAuthorizedKeyResponse{
+		Credential: dbFileTransferCredentialToProto(credential, gameServerID),
+	}), nil
+}
+
+func (s *Service) RemoveAuthorizedKey(ctx context.Context, req *connect.Request[gameserversv1.RemoveAuthorizedKeyRequest]) (*connect.Response[gameserversv1.RemoveAuthorizedKeyResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	credentialID := strings.TrimSpace(req.Msg.GetCredentialId())
+	fingerprint := strings.TrimSpace(req.Msg.GetFingerprint())
+	if gameServerID == "" || credentialID == "" || fingerprint == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game server ID, credential ID and fingerprint are required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersUpdate); err != nil {
+		return nil, err
+	}
+
+	repo := database.NewFileTransferCredentialRepository(database.DB)
+	credential, err := repo.GetActiveByResource(ctx, credentialID, database.FileTransferResourceGameServer, gameServerID, time.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("file transfer credential %s not found", credentialID))
+	}
+
+	keys := database.SplitFileTransferAuthorizedKeys(credential.AuthorizedPublicKeys)
+	remaining := make([]string, 0, len(keys))
+	for _, line := range keys {
+		if key, _, err := parseAuthorizedKeyLine(line); err == nil && ssh.FingerprintSHA256(key) == fingerprint {
+			continue
+		}
+		remaining = append(remaining, line)
+	}
+	if len(remaining) == len(keys) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("public key %s is not authorized", fingerprint))
+	}
+
+	if err := repo.UpdateAuthorizedPublicKeys(ctx, credential.ID, remaining); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove public key: %w", err))
+	}
+	credential.AuthorizedPublicKeys = strings.Join(remaining, "\n")
+
+	return connect.NewResponse(&gameserversv1.RemoveAuthorizedKeyResponse{
+		Credential: dbFileTransferCredentialToProto(credential, gameServerID),
+	}), nil
+}
+
+// parseAuthorizedKeyLine parses a public key in authorized_keys format and returns
+// it with its canonical line; options are dropped since the SFTP server ignores them
+func parseAuthorizedKeyLine(raw string) (ssh.PublicKey, string, error) {
+	key, comment, _, _, err := ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(raw)))
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid SSH public key: %w", err)
+	}
+	line := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+	if comment = strings.TrimSpace(comment); comment != "" {
+		line += " " + comment
+	}
+	return key, line, nil
+}
+
 func normalizeRequestedFileTransferScopes(scopes []string) []string {
 	normalized := database.NormalizeFileTransferScopes(strings.Join(scopes, ","))
 	out := strings.Split(normalized, ",")
@@ -157,6 +262,17 @@ func dbFileTransferCredentialToProto(credential *database.FileTransferCredential
 	if credential.ExpiresAt != nil {
 		item.ExpiresAt = timestamppb.New(*credential.ExpiresAt)
 	}
+	if allowedPaths, err := database.DecodeFileTransferAllowedPaths(credential.AllowedPaths); err == nil {
+		item.AllowedPaths = allowedPaths
+	}
+	for _, line := range database.SplitFileTransferAuthorizedKeys(credential.AuthorizedPublicKeys) {
+		if key, _, err := parseAuthorizedKeyLine(line); err == nil {
+			item.AuthorizedKeys = append(item.AuthorizedKeys, &gameserversv1.GameServerAuthorizedKey{
+				PublicKey:   line,
+				Fingerprint: ssh.FingerprintSHA256(key),
+			})
+		}
+	}
 	return item
 }
 
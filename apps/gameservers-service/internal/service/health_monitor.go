@@ -98,6 +98,8 @@ func (s *Service) checkAndSyncGameServerStatus(ctx context.Context) {
 					"previousStatus": gameserversv1.GameServerStatus(currentStatus).String(),
 					"currentStatus":  gameserversv1.GameServerStatus_STOPPED.String(),
 				}, nil)
+				s.stopDiscordPlayerWatch(gameServer.ID)
+				s.notifyDiscord(gameServer.ID, database.DiscordEventStop, "Container no longer exists")
 				syncedCount++
 			}
 			continue
@@ -109,6 +111,10 @@ func (s *Service) checkAndSyncGameServerStatus(ctx context.Context) {
 		// Sync status based on actual container state
 		if isRunning {
 			s.evaluateResourcePressure(ctx, dockerClient, &gameServer)
+			s.watchDiscordPlayerEvents(ctx, gameServer.ID)
+			if currentStatus == statusRunning {
+				s.evaluateIdleShutdown(ctx, &gameServer)
+			}
 
 			// Container is running - update to RUNNING if not already
 			if currentStatus != statusRunning {
@@ -133,6 +139,7 @@ func (s *Service) checkAndSyncGameServerStatus(ctx context.Context) {
 						"previousStatus": gameserversv1.GameServerStatus(currentStatus).String(),
 						"currentStatus":  gameserversv1.GameServerStatus_RUNNING.String(),
 					}, nil)
+					s.notifyDiscord(gameServer.ID, database.DiscordEventStart, "")
 					syncedCount++
 				}
 			} else {
@@ -140,6 +147,7 @@ func (s *Service) checkAndSyncGameServerStatus(ctx context.Context) {
 			}
 		} else {
 			s.clearResourcePressureState(gameServer.ID)
+			s.stopDiscordPlayerWatch(gameServer.ID)
 
 			// Container is not running - check exit code to determine status
 			exitCode := containerInfo.Container.State.ExitCode
@@ -169,6 +177,7 @@ func (s *Service) checkAndSyncGameServerStatus(ctx context.Context) {
 							"previousStatus": gameserversv1.GameServerStatus(currentStatus).String(),
 							"currentStatus":  gameserversv1.GameServerStatus_STOPPED.String(),
 						}, nil)
+						s.notifyDiscord(gameServer.ID, database.DiscordEventStop, "")
 						syncedCount++
 					}
 				} else {
@@ -211,6 +220,12 @@ func (s *Service) checkAndSyncGameServerStatus(ctx context.Context) {
 							"currentStatus":  gameserversv1.GameServerStatus_FAILED.String(),
 						}, nil)
 
+						crashDetail := fmt.Sprintf("Exit code %d", exitCode)
+						if isOOMKill {
+							crashDetail += " (out of memory)"
+						}
+						s.notifyDiscord(gameServer.ID, database.DiscordEventCrash, crashDetail)
+
 						syncedCount++
 					}
 				} else {
@@ -0,0 +1,358 @@
+package gameservers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/notifications"
+
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// idleShutdownPollInterval is how often the health monitor asks a game server for its player count
+	idleShutdownPollInterval  = 5 * time.Minute
+	idleShutdownRCONTimeout   = 15 * time.Second
+	minIdleTimeoutMinutes     = 5
+	maxIdleTimeoutMinutes     = 7 * 24 * 60
+	defaultMinPlayersToStayUp = 1
+)
+
+var (
+	// minecraftPlayerListPattern matches the response to Minecraft's "list" command, which differs
+	// slightly between versions ("There are 0 of a max of 20 players online" or "There are 0/20 players online")
+	minecraftPlayerListPattern = regexp.MustCompile(`There are (\d+)(?: of a max of |/)\d+ players online`)
+	// sourcePlayerStatusPattern matches the player line of a Source engine "status" response
+	sourcePlayerStatusPattern = regexp.MustCompile(`players\s*:\s*(\d+) humans`)
+)
+
+// IdleShutdownPolicy stops a game server once fewer than MinPlayersToStayAlive players have been
+// online for IdleTimeout
+type IdleShutdownPolicy struct {
+	IdleTimeout           time.Duration
+	MinPlayersToStayAlive int32
+}
+
+func idleShutdownPolicyFromSettings(settings *database.GameServerSettings) IdleShutdownPolicy {
+	return IdleShutdownPolicy{
+		IdleTimeout:           time.Duration(settings.IdleTimeoutMinutes) * time.Minute,
+		MinPlayersToStayAlive: settings.MinPlayersToStayAlive,
+	}
+}
+
+// Enabled reports whether the policy stops idle servers
+func (p IdleShutdownPolicy) Enabled() bool {
+	return p.IdleTimeout > 0
+}
+
+// IsIdle reports whether playerCount players are too few to keep the server running
+func (p IdleShutdownPolicy) IsIdle(playerCount int32) bool {
+	return playerCount < max(p.MinPlayersToStayAlive, 1)
+}
+
+// ShouldStop reports whether a server that has been idle since idleSince and has playerCount
+// players online should be stopped at now
+func (p IdleShutdownPolicy) ShouldStop(playerCount int32, idleSince time.Time, now time.Time) bool {
+	return p.Enabled() && p.IsIdle(playerCount) && now.After(idleSince.Add(p.IdleTimeout))
+}
+
+// idleSince returns when the idle countdown of a game server started: the last time enough players
+// were online, or else when the server last started or the policy last changed
+func idleSince(settings *database.GameServerSettings, gameServer *database.GameServer) time.Time {
+	since := settings.UpdatedAt
+	if gameServer.LastStartedAt != nil && gameServer.LastStartedAt.After(since) {
+		since = *gameServer.LastStartedAt
+	}
+	if settings.LastPlayerSeenAt != nil && settings.LastPlayerSeenAt.After(since) {
+		since = *settings.LastPlayerSeenAt
+	}
+	return since
+}
+
+// playerCountCommand returns the RCON command that lists the players of a game type
+func playerCountCommand(gameType int32) (string, bool) {
+	switch gameserversv1.GameType(gameType) {
+	case gameserversv1.GameType_MINECRAFT, gameserversv1.GameType_MINECRAFT_JAVA:
+		return "list", true
+	case gameserversv1.GameType_CS2:
+		return "status", true
+	}
+	return "", false
+}
+
+// parsePlayerCount reads the number of players online from the response to playerCountCommand
+func parsePlayerCount(gameType int32, output string) (int32, error) {
+	output = ansiEscapePattern.ReplaceAllString(output, "")
+	pattern := minecraftPlayerListPattern
+	if gameserversv1.GameType(gameType) == gameserversv1.GameType_CS2 {
+		pattern = sourcePlayerStatusPattern
+	}
+	match := pattern.FindStringSubmatch(output)
+	if match == nil {
+		return 0, fmt.Errorf("unexpected player list response %q", strings.TrimSpace(output))
+	}
+	count, err := strconv.ParseInt(match[1], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid player count %q: %w", match[1], err)
+	}
+	return int32(count), nil
+}
+
+// recordPlayerEvent restarts the idle countdown when a player joins or leaves. A join or leave only
+// proves that one player was online, so policies that need more players rely on the player count.
+func recordPlayerEvent(ctx context.Context, gameServerID string, at time.Time) {
+	settings, err := database.GetGameServerSettings(ctx, gameServerID)
+	if err != nil || !settings.IdleShutdownEnabled() || settings.MinPlayersToStayAlive > 1 {
+		return
+	}
+	if err := database.TouchGameServerPlayerSeen(ctx, gameServerID, at); err != nil {
+		logger.Debug("[IdleShutdown] %v", err)
+	}
+}
+
+// evaluateIdleShutdown polls the player count of a running game server every 5 minutes and stops it
+// once it has been idle for longer than its policy allows
+func (s *Service) evaluateIdleShutdown(ctx context.Context, gameServer *database.GameServer) {
+	if gameServer == nil || gameServer.RCONPort == nil {
+		return
+	}
+	command, ok := playerCountCommand(gameServer.GameType)
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	s.idleShutdownMu.Lock()
+	if last, polled := s.idleShutdownPolledAt[gameServer.ID]; polled && now.Sub(last) < idleShutdownPollInterval {
+		s.idleShutdownMu.Unlock()
+		return
+	}
+	if s.idleShutdownPolledAt == nil {
+		s.idleShutdownPolledAt = make(map[string]time.Time)
+	}
+	s.idleShutdownPolledAt[gameServer.ID] = now
+	s.idleShutdownMu.Unlock()
+
+	settings, err := database.GetGameServerSettings(ctx, gameServer.ID)
+	if err != nil {
+		logger.Debug("[IdleShutdown] %v", err)
+		return
+	}
+	policy := idleShutdownPolicyFromSettings(settings)
+	if !policy.Enabled() {
+		return
+	}
+
+	manager, err := s.getGameServerManager()
+	if err != nil {
+		return
+	}
+	rconCtx, cancel := context.WithTimeout(ctx, idleShutdownRCONTimeout)
+	output, err := manager.ExecuteRCONCommand(rconCtx, gameServer.ID, command)
+	cancel()
+	if err != nil {
+		// An unknown player count never stops the server
+		logger.Debug("[IdleShutdown] Failed to get the player count of game server %s: %v", gameServer.ID, err)
+		return
+	}
+	playerCount, err := parsePlayerCount(gameServer.GameType, output)
+	if err != nil {
+		logger.Debug("[IdleShutdown] Game server %s: %v", gameServer.ID, err)
+		return
+	}
+	if err := s.repo.UpdatePlayerCount(ctx, gameServer.ID, playerCount); err != nil {
+		logger.Debug("[IdleShutdown] Failed to update the player count of game server %s: %v", gameServer.ID, err)
+	}
+
+	if !policy.IsIdle(playerCount) {
+		if err := database.TouchGameServerPlayerSeen(ctx, gameServer.ID, now); err != nil {
+			logger.Debug("[IdleShutdown] %v", err)
+		}
+		return
+	}
+
+	since := idleSince(settings, gameServer)
+	if !policy.ShouldStop(playerCount, since, now) {
+		return
+	}
+	go s.stopIdleGameServer(gameServer.ID, playerCount, since, policy)
+}
+
+// stopIdleGameServer stops a game server that has been idle for longer than its policy allows
+func (s *Service) stopIdleGameServer(gameServerID string, playerCount int32, since time.Time, policy IdleShutdownPolicy) {
+	stopCtx, cancel := s.detachedContext(2 * time.Minute)
+	defer cancel()
+
+	auditFields := map[string]interface{}{
+		"playerCount":           playerCount,
+		"idleSince":             since.UTC().Format(time.RFC3339),
+		"idleTimeoutMinutes":    int(policy.IdleTimeout.Minutes()),
+		"minPlayersToStayAlive": policy.MinPlayersToStayAlive,
+	}
+
+	manager, err := s.getGameServerManager()
+	if err != nil {
+		logger.Warn("[IdleShutdown] Cannot stop idle game server %s: %v", gameServerID, err)
+		return
+	}
+	if err := manager.StopGameServer(stopCtx, gameServerID); err != nil {
+		logger.Warn("[IdleShutdown] Failed to stop idle game server %s: %v", gameServerID, err)
+		s.createSystemGameServerAuditLog(nil, gameServerID, "StopGameServer", "idle", gameServerAuditSourceMonitor, 500, auditFields, err)
+		return
+	}
+	if err := s.repo.UpdateStatus(stopCtx, gameServerID, int32(gameserversv1.GameServerStatus_STOPPED_IDLE)); err != nil {
+		logger.Warn("[IdleShutdown] Failed to update game server %s status to STOPPED_IDLE: %v", gameServerID, err)
+	}
+
+	s.idleShutdownMu.Lock()
+	delete(s.idleShutdownPolledAt, gameServerID)
+	s.idleShutdownMu.Unlock()
+	s.clearResourcePressureState(gameServerID)
+	s.stopDiscordPlayerWatch(gameServerID)
+
+	idleFor := time.Since(since).Round(time.Minute)
+	logger.Info("[IdleShutdown] Stopped game server %s after %v with %d player(s) online", gameServerID, idleFor, playerCount)
+	s.createSystemGameServerAuditLog(nil, gameServerID, "StopGameServer", "idle", gameServerAuditSourceMonitor, 200, auditFields, nil)
+	s.notifyDiscord(gameServerID, database.DiscordEventStop, fmt.Sprintf("Stopped after being idle for %v", idleFor))
+
+	notifyCtx, notifyCancel := s.detachedContext(15 * time.Second)
+	defer notifyCancel()
+	s.sendIdleShutdownNotification(notifyCtx, gameServerID, idleFor)
+}
+
+func (s *Service) sendIdleShutdownNotification(ctx context.Context, gameServerID string, idleFor time.Duration) {
+	gameServer, err := s.repo.GetByID(ctx, gameServerID)
+	if err != nil {
+		logger.Warn("[IdleShutdown] Failed to load game server %s for idle shutdown notification: %v", gameServerID, err)
+		return
+	}
+	if gameServer.CreatedBy == "" {
+		logger.Warn("[IdleShutdown] Cannot send idle shutdown notification for game server %s: no CreatedBy user ID", gameServer.ID)
+		return
+	}
+
+	title := fmt.Sprintf("Game Server \"%s\" Stopped While Idle", gameServer.Name)
+	message := fmt.Sprintf(
+		"Your game server \"%s\" was stopped automatically because no players were online for %v. Start it again whenever you want to play.",
+		gameServer.Name,
+		idleFor,
+	)
+	actionURL := fmt.Sprintf("/gameservers/%s", gameServer.ID)
+	actionLabel := "View Game Server"
+
+	var orgID *string
+	if gameServer.OrganizationID != "" {
+		orgID = &gameServer.OrganizationID
+	}
+
+	if err := notifications.CreateNotificationForUser(
+		ctx,
+		gameServer.CreatedBy,
+		orgID,
+		notificationsv1.NotificationType_NOTIFICATION_TYPE_INFO,
+		notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_LOW,
+		title,
+		message,
+		&actionURL,
+		&actionLabel,
+		map[string]string{
+			"game_server_id":   gameServer.ID,
+			"game_server_name": gameServer.Name,
+			"reason":           "idle_shutdown",
+			"idle_seconds":     fmt.Sprintf("%d", int(idleFor.Seconds())),
+		},
+	); err != nil {
+		logger.Warn("[IdleShutdown] Failed to send idle shutdown notification for game server %s to user %s: %v", gameServer.ID, gameServer.CreatedBy, err)
+	}
+}
+
+// SetIdleShutdownPolicy sets how long a game server may run without players before it is stopped
+func (s *Service) SetIdleShutdownPolicy(ctx context.Context, req *connect.Request[gameserversv1.SetIdleShutdownPolicyRequest]) (*connect.Response[gameserversv1.SetIdleShutdownPolicyResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	if gameServerID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game_server_id is required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersUpdate); err != nil {
+		return nil, err
+	}
+
+	idleTimeout := req.Msg.GetIdleTimeoutMinutes()
+	if idleTimeout != 0 && (idleTimeout < minIdleTimeoutMinutes || idleTimeout > maxIdleTimeoutMinutes) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("idle_timeout_minutes must be 0 or between %d and %d", minIdleTimeoutMinutes, maxIdleTimeoutMinutes))
+	}
+	minPlayers := req.Msg.GetMinPlayersToStayAlive()
+	if minPlayers < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("min_players_to_stay_alive cannot be negative"))
+	}
+	if minPlayers == 0 {
+		minPlayers = defaultMinPlayersToStayUp
+	}
+
+	gameServer, err := s.repo.GetByID(ctx, gameServerID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("game server %s not found", gameServerID))
+	}
+	if _, ok := playerCountCommand(gameServer.GameType); idleTimeout > 0 && (!ok || gameServer.RCONPort == nil) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("idle shutdown needs the player count, which is not available for this game server"))
+	}
+
+	var updatedBy string
+	if user, err := auth.GetUserFromContext(ctx); err == nil && user != nil {
+		updatedBy = user.Id
+	}
+	if err := database.SaveGameServerIdleShutdown(ctx, gameServerID, idleTimeout, minPlayers, updatedBy); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	settings, err := database.GetGameServerSettings(ctx, gameServerID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	logger.Info("[IdleShutdown] Set idle shutdown policy of game server %s (timeout: %d minutes, min players: %d)", gameServerID, idleTimeout, minPlayers)
+	return connect.NewResponse(&gameserversv1.SetIdleShutdownPolicyResponse{
+		Policy: idleShutdownPolicyToProto(settings),
+	}), nil
+}
+
+// GetIdleShutdownPolicy returns the idle shutdown policy of a game server
+func (s *Service) GetIdleShutdownPolicy(ctx context.Context, req *connect.Request[gameserversv1.GetIdleShutdownPolicyRequest]) (*connect.Response[gameserversv1.GetIdleShutdownPolicyResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	if gameServerID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game_server_id is required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersRead); err != nil {
+		return nil, err
+	}
+
+	settings, err := database.GetGameServerSettings(ctx, gameServerID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gameserversv1.GetIdleShutdownPolicyResponse{
+		Policy: idleShutdownPolicyToProto(settings),
+	}), nil
+}
+
+func idleShutdownPolicyToProto(settings *database.GameServerSettings) *gameserversv1.IdleShutdownPolicy {
+	policy := &gameserversv1.IdleShutdownPolicy{
+		IdleTimeoutMinutes:    settings.IdleTimeoutMinutes,
+		MinPlayersToStayAlive: settings.MinPlayersToStayAlive,
+	}
+	if settings.LastPlayerSeenAt != nil {
+		policy.LastPlayerSeenAt = timestamppb.New(*settings.LastPlayerSeenAt)
+	}
+	return policy
+}
@@ -0,0 +1,213 @@
+package gameservers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+)
+
+// simulateIdleShutdown polls a game server started at start every 5 minutes the way the health
+// monitor does, and returns the poll at which it is stopped, or -1 if it keeps running
+func simulateIdleShutdown(settings *database.GameServerSettings, start time.Time, players func(poll int) int32, polls int) int {
+	gameServer := &database.GameServer{ID: "gs-idle", LastStartedAt: &start}
+	policy := idleShutdownPolicyFromSettings(settings)
+	for poll := 0; poll < polls; poll++ {
+		now := start.Add(time.Duration(poll) * idleShutdownPollInterval)
+		playerCount := players(poll)
+		if !policy.IsIdle(playerCount) {
+			seenAt := now
+			settings.LastPlayerSeenAt = &seenAt
+			continue
+		}
+		if policy.ShouldStop(playerCount, idleSince(settings, gameServer), now) {
+			return poll
+		}
+	}
+	return -1
+}
+
+func TestIdleShutdownCountdown(t *testing.T) {
+	start := time.Date(2026, 5, 2, 18, 0, 0, 0, time.UTC)
+	newSettings := func(timeoutMinutes, minPlayers int32) *database.GameServerSettings {
+		return &database.GameServerSettings{
+			GameServerID:          "gs-idle",
+			IdleTimeoutMinutes:    timeoutMinutes,
+			MinPlayersToStayAlive: minPlayers,
+			UpdatedAt:             start.Add(-24 * time.Hour),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		settings   *database.GameServerSettings
+		players    func(poll int) int32
+		wantPolled int
+	}{
+		{
+			// Nobody joins: the countdown runs from the start, and 30 minutes have passed after poll 6
+			name:       "never joined",
+			settings:   newSettings(30, 1),
+			players:    func(int) int32 { return 0 },
+			wantPolled: 7,
+		},
+		{
+			// Players leave after poll 4 (20 minutes), so the countdown runs from there
+			name:     "players left",
+			settings: newSettings(30, 1),
+			players: func(poll int) int32 {
+				if poll <= 4 {
+					return 3
+				}
+				return 0
+			},
+			wantPolled: 11,
+		},
+		{
+			// A player drops in during the countdown, which starts it over
+			name:     "player came back",
+			settings: newSettings(30, 1),
+			players: func(poll int) int32 {
+				if poll == 5 {
+					return 1
+				}
+				return 0
+			},
+			wantPolled: 12,
+		},
+		{
+			// One player is not enough to keep a server that needs two alive
+			name:     "below the minimum",
+			settings: newSettings(15, 2),
+			players: func(poll int) int32 {
+				if poll < 2 {
+					return 2
+				}
+				return 1
+			},
+			wantPolled: 5,
+		},
+		{
+			name:       "always busy",
+			settings:   newSettings(15, 1),
+			players:    func(int) int32 { return 4 },
+			wantPolled: -1,
+		},
+		{
+			name:       "disabled",
+			settings:   newSettings(0, 1),
+			players:    func(int) int32 { return 0 },
+			wantPolled: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := simulateIdleShutdown(tt.settings, start, tt.players, 48); got != tt.wantPolled {
+				t.Fatalf("stopped at poll %d, want %d", got, tt.wantPolled)
+			}
+		})
+	}
+}
+
+func TestIdleShutdownCountdownStartsAtPolicyChange(t *testing.T) {
+	startedAt := time.Date(2026, 5, 2, 8, 0, 0, 0, time.UTC)
+	// The policy is set hours after the last start, on a server nobody has played on
+	settings := &database.GameServerSettings{IdleTimeoutMinutes: 60, MinPlayersToStayAlive: 1, UpdatedAt: startedAt.Add(4 * time.Hour)}
+	gameServer := &database.GameServer{LastStartedAt: &startedAt}
+	policy := idleShutdownPolicyFromSettings(settings)
+
+	since := idleSince(settings, gameServer)
+	if !since.Equal(settings.UpdatedAt) {
+		t.Fatalf("idle since %v, want the policy change at %v", since, settings.UpdatedAt)
+	}
+	if policy.ShouldStop(0, since, settings.UpdatedAt.Add(59*time.Minute)) {
+		t.Fatal("stopped before the timeout passed since the policy change")
+	}
+	if !policy.ShouldStop(0, since, settings.UpdatedAt.Add(61*time.Minute)) {
+		t.Fatal("kept running after the timeout passed since the policy change")
+	}
+}
+
+func TestParsePlayerCount(t *testing.T) {
+	minecraft := int32(gameserversv1.GameType_MINECRAFT_JAVA)
+	cs2 := int32(gameserversv1.GameType_CS2)
+
+	tests := []struct {
+		gameType int32
+		output   string
+		want     int32
+	}{
+		{minecraft, "There are 0 of a max of 20 players online: ", 0},
+		{minecraft, "There are 3 of a max of 20 players online: Alex, Steve, Notch", 3},
+		{minecraft, "There are 2/10 players online:\nAlex, Steve", 2},
+		{minecraft, "\x1b[0mThere are 1 of a max of 20 players online: Alex\x1b[0m", 1},
+		{cs2, "hostname: Obiente CS2\nplayers  : 5 humans, 2 bots (16 max)\n", 5},
+	}
+	for _, tt := range tests {
+		got, err := parsePlayerCount(tt.gameType, tt.output)
+		if err != nil {
+			t.Fatalf("parsePlayerCount(%q): %v", tt.output, err)
+		}
+		if got != tt.want {
+			t.Errorf("parsePlayerCount(%q) = %d, want %d", tt.output, got, tt.want)
+		}
+	}
+
+	if _, err := parsePlayerCount(minecraft, "Unknown command"); err == nil {
+		t.Fatal("parsePlayerCount accepted an unknown command response")
+	}
+}
+
+func TestGameServerPlayerSeenTracking(t *testing.T) {
+	newTestDB(t, &database.GameServerSettings{})
+	ctx := context.Background()
+
+	seenAt := time.Date(2026, 5, 2, 20, 0, 0, 0, time.UTC)
+	// Without a policy there is nothing to record
+	if err := database.TouchGameServerPlayerSeen(ctx, "gs-idle", seenAt); err != nil {
+		t.Fatalf("touch without settings: %v", err)
+	}
+	if err := database.SaveGameServerIdleShutdown(ctx, "gs-idle", 30, 1, "user-1"); err != nil {
+		t.Fatalf("save policy: %v", err)
+	}
+	if err := database.TouchGameServerPlayerSeen(ctx, "gs-idle", seenAt); err != nil {
+		t.Fatalf("touch: %v", err)
+	}
+	// An older sighting, e.g. a delayed log line, does not move the countdown back
+	if err := database.TouchGameServerPlayerSeen(ctx, "gs-idle", seenAt.Add(-time.Hour)); err != nil {
+		t.Fatalf("touch older: %v", err)
+	}
+
+	settings, err := database.GetGameServerSettings(ctx, "gs-idle")
+	if err != nil {
+		t.Fatalf("get settings: %v", err)
+	}
+	if settings.LastPlayerSeenAt == nil || !settings.LastPlayerSeenAt.Equal(seenAt) {
+		t.Fatalf("last player seen at %v, want %v", settings.LastPlayerSeenAt, seenAt)
+	}
+
+	// Starting the server clears it
+	if err := database.ClearGameServerPlayerSeen(ctx, "gs-idle"); err != nil {
+		t.Fatalf("clear: %v", err)
+	}
+	settings, err = database.GetGameServerSettings(ctx, "gs-idle")
+	if err != nil {
+		t.Fatalf("get settings: %v", err)
+	}
+	if settings.LastPlayerSeenAt != nil {
+		t.Fatalf("last player seen at %v after clearing, want nil", settings.LastPlayerSeenAt)
+	}
+	if settings.IdleTimeoutMinutes != 30 || settings.MinPlayersToStayAlive != 1 {
+		t.Fatalf("policy changed by tracking: %+v", settings)
+	}
+
+	defaults, err := database.GetGameServerSettings(ctx, "gs-other")
+	if err != nil {
+		t.Fatalf("get default settings: %v", err)
+	}
+	if defaults.IdleShutdownEnabled() || defaults.MinPlayersToStayAlive != 1 {
+		t.Fatalf("default settings = %+v, want idle shutdown disabled", defaults)
+	}
+}
@@ -0,0 +1,297 @@
+package gameservers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	v1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/common/v1"
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	gameServerLogHistoryTTL = 24 * time.Hour
+
+	// defaultGameServerLogReplayLines is how many stored lines a terminal replays on connect
+	defaultGameServerLogReplayLines = 200
+
+	gameServerLogFollowerTTL = 30 * time.Second
+	gameServerLogFollowRetry = 10 * time.Second
+)
+
+var errGameServerLogStoreUnavailable = errors.New("redis is not configured")
+
+// gameServerLogEntry is a log line as stored in the gslog sorted set and sent over pub/sub
+type gameServerLogEntry struct {
+	Timestamp time.Time   `json:"timestamp"`
+	Line      string      `json:"line"`
+	Level     v1.LogLevel `json:"level,omitempty"`
+}
+
+// gameServerLogKey returns the sorted set holding a game server's log lines, scored by Unix nanoseconds
+func gameServerLogKey(gameServerID string) string {
+	return "gslog:" + gameServerID
+}
+
+// gameServerLogChannel returns the pub/sub channel carrying a game server's live log lines
+func gameServerLogChannel(gameServerID string) string {
+	return "gslog:" + gameServerID + ":live"
+}
+
+// gameServerLogFollowerKey returns the Redis lock held by the replica ingesting a game server's logs
+func gameServerLogFollowerKey(gameServerID string) string {
+	return "gslog:" + gameServerID + ":follower"
+}
+
+// gameServerLogReplayLines returns REPLAY_LINES, the number of lines replayed to a terminal on connect
+func gameServerLogReplayLines() int {
+	if raw := strings.TrimSpace(os.Getenv("REPLAY_LINES")); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n >= 0 {
+			return n
+		}
+		logger.Warn("[GameServer Logs] Invalid REPLAY_LINES %q, using %d", raw, defaultGameServerLogReplayLines)
+	}
+	return defaultGameServerLogReplayLines
+}
+
+// gameServerLogStore keeps encoded log entries in Redis; tests replace it with a fake
+type gameServerLogStore interface {
+	Append(ctx context.Context, gameServerID string, entries []gameServerLogEntry) error
+	Recent(ctx context.Context, gameServerID string, n int) ([]string, error)
+	Subscribe(ctx context.Context, gameServerID string) (<-chan string, func(), error)
+}
+
+var gameServerLogs gameServerLogStore = redisGameServerLogStore{}
+
+type redisGameServerLogStore struct{}
+
+func (redisGameServerLogStore) Append(ctx context.Context, gameServerID string, entries []gameServerLogEntry) error {
+	if database.RedisClient == nil {
+		return errGameServerLogStoreUnavailable
+	}
+	key := gameServerLogKey(gameServerID)
+	now := time.Now()
+	zadd := []interface{}{"zadd", key}
+	pipe := database.RedisClient.GetClient().TxPipeline()
+	for _, entry := range entries {
+		payload, err := json.Marshal(entry)
+		if err != nil {
+			continue
+		}
+		zadd = append(zadd, entry.Timestamp.UnixNano(), string(payload))
+		pipe.Publish(ctx, gameServerLogChannel(gameServerID), payload)
+	}
+	if len(zadd) == 2 {
+		return nil
+	}
+	pipe.Do(ctx, zadd...)
+	// Lines older than the history window are dropped; the key itself expires once the server goes quiet
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-gameServerLogHistoryTTL).UnixNano(), 10))
+	pipe.ExpireAt(ctx, key, now.Add(gameServerLogHistoryTTL))
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (redisGameServerLogStore) Recent(ctx context.Context, gameServerID string, n int) ([]string, error) {
+	if database.RedisClient == nil {
+		return nil, errGameServerLogStoreUnavailable
+	}
+	return database.RedisClient.GetClient().ZRange(ctx, gameServerLogKey(gameServerID), int64(-n), -1).Result()
+}
+
+func (redisGameServerLogStore) Subscribe(ctx context.Context, gameServerID string) (<-chan string, func(), error) {
+	if database.RedisClient == nil {
+		return nil, nil, errGameServerLogStoreUnavailable
+	}
+	pubsub := database.RedisClient.GetClient().Subscribe(ctx, gameServerLogChannel(gameServerID))
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, nil, err
+	}
+	payloads := make(chan string, 256)
+	go func() {
+		defer close(payloads)
+		for msg := range pubsub.Channel() {
+			select {
+			case payloads <- msg.Payload:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return payloads, func() { _ = pubsub.Close() }, nil
+}
+
+// gameServerLogIngester is a logLineSender that stores each line it receives, posts player join and
+// leave lines to Discord and counts them as player activity for idle shutdown
+type gameServerLogIngester struct {
+	ctx          context.Context
+	gameServerID string
+}
+
+func (i gameServerLogIngester) Send(line *gameserversv1.GameServerLogLine) error {
+	appendGameServerLogLines(i.ctx, i.gameServerID, []*gameserversv1.GameServerLogLine{line})
+	if event, player, ok := parsePlayerEvent(line.GetLine()); ok {
+		go sendDiscordEvent(i.ctx, i.gameServerID, event, player)
+		go recordPlayerEvent(i.ctx, i.gameServerID, time.Now())
+	}
+	return nil
+}
+
+// appendGameServerLogLines stores log lines for replay and publishes them to live subscribers
+func appendGameServerLogLines(ctx context.Context, gameServerID string, lines []*gameserversv1.GameServerLogLine) {
+	entries := make([]gameServerLogEntry, 0, len(lines))
+	for _, line := range lines {
+		if line == nil || strings.TrimSpace(line.Line) == "" {
+			continue
+		}
+		entry := gameServerLogEntry{Timestamp: time.Now().UTC(), Line: line.Line, Level: line.GetLevel()}
+		if line.Timestamp != nil {
+			entry.Timestamp = line.Timestamp.AsTime().UTC()
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) == 0 {
+		return
+	}
+	if err := gameServerLogs.Append(ctx, gameServerID, entries); err != nil && !errors.Is(err, errGameServerLogStoreUnavailable) {
+		logger.Warn("[GameServer Logs] Failed to store %d log lines for %s: %v", len(entries), gameServerID, err)
+	}
+}
+
+// recentGameServerLogLines returns the last n stored log lines, oldest first
+func recentGameServerLogLines(ctx context.Context, gameServerID string, n int) ([]*gameserversv1.GameServerLogLine, error) {
+	payloads, err := gameServerLogs.Recent(ctx, gameServerID, n)
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]*gameserversv1.GameServerLogLine, 0, len(payloads))
+	for _, payload := range payloads {
+		var entry gameServerLogEntry
+		if err := json.Unmarshal([]byte(payload), &entry); err != nil {
+			continue
+		}
+		level := entry.Level
+		lines = append(lines, &gameserversv1.GameServerLogLine{
+			Line:      entry.Line,
+			Timestamp: timestamppb.New(entry.Timestamp),
+			Level:     &level,
+		})
+	}
+	return lines, nil
+}
+
+// forwardGameServerLogHistory sends the last lastN stored lines, then live ones from a subscription
+// opened before the history was read, until ctx ends. Lines published while the history was being
+// read arrive through both and are sent once.
+func forwardGameServerLogHistory(ctx context.Context, gameServerID string, live <-chan string, lastN int, send func(line string) error) error {
+	replayed := make(map[string]struct{})
+	if lastN > 0 {
+		recent, err := gameServerLogs.Recent(ctx, gameServerID, lastN)
+		if err != nil {
+			return fmt.Errorf("load recent logs: %w", err)
+		}
+		for _, payload := range recent {
+			replayed[payload] = struct{}{}
+			var entry gameServerLogEntry
+			if err := json.Unmarshal([]byte(payload), &entry); err != nil {
+				continue
+			}
+			if err := send(entry.Line); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case payload, ok := <-live:
+			if !ok {
+				return errors.New("log subscription closed")
+			}
+			if _, ok := replayed[payload]; ok {
+				delete(replayed, payload)
+				continue
+			}
+			var entry gameServerLogEntry
+			if err := json.Unmarshal([]byte(payload), &entry); err != nil {
+				continue
+			}
+			if err := send(entry.Line); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// followGameServerLogs ingests the game server's container logs while a terminal is connected. A
+// Redis lock keeps a single follower per game server across connections and replicas.
+func (s *Service) followGameServerLogs(ctx context.Context, gameServerID string) {
+	if database.RedisClient == nil {
+		return
+	}
+	client := database.RedisClient.GetClient()
+	lockKey := gameServerLogFollowerKey(gameServerID)
+
+	retry := time.NewTicker(gameServerLogFollowRetry)
+	defer retry.Stop()
+	for {
+		acquired, err := client.SetNX(ctx, lockKey, "1", gameServerLogFollowerTTL).Result()
+		if err == nil && acquired {
+			s.ingestContainerLogs(ctx, gameServerID, lockKey)
+			client.Del(context.Background(), lockKey)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-retry.C:
+		}
+	}
+}
+
+func (s *Service) ingestContainerLogs(ctx context.Context, gameServerID, lockKey string) {
+	if shouldForward, nodeID := s.getGameServerForwardTarget(ctx, gameServerID); shouldForward {
+		logger.Debug("[GameServer Logs] Game server %s runs on node %s; not following it from here", gameServerID, nodeID)
+		return
+	}
+	manager, err := s.getGameServerManager()
+	if err != nil {
+		return
+	}
+	logsReader, err := manager.GetGameServerLogs(ctx, gameServerID, "0", true, nil, nil)
+	if err != nil {
+		logger.Debug("[GameServer Logs] Failed to follow logs for %s: %v", gameServerID, err)
+		return
+	}
+	defer logsReader.Close()
+
+	followCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		refresh := time.NewTicker(gameServerLogFollowerTTL / 3)
+		defer refresh.Stop()
+		for {
+			select {
+			case <-followCtx.Done():
+				return
+			case <-refresh.C:
+				database.RedisClient.GetClient().Expire(followCtx, lockKey, gameServerLogFollowerTTL)
+			}
+		}
+	}()
+
+	if err := s.streamLiveLogs(followCtx, logsReader, gameServerLogIngester{ctx: followCtx, gameServerID: gameServerID}, ""); err != nil {
+		logger.Debug("[GameServer Logs] Log follower for %s stopped: %v", gameServerID, err)
+	}
+}
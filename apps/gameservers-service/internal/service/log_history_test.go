@@ -0,0 +1,202 @@
+package gameservers
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	v1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/common/v1"
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeGameServerLogStore keeps entries ordered by timestamp like the gslog sorted set
+type fakeGameServerLogStore struct {
+	mu          sync.Mutex
+	entries     map[string][]gameServerLogEntry
+	subscribers map[string][]chan string
+}
+
+func newFakeGameServerLogStore(t *testing.T) *fakeGameServerLogStore {
+	store := &fakeGameServerLogStore{
+		entries:     make(map[string][]gameServerLogEntry),
+		subscribers: make(map[string][]chan string),
+	}
+	previous := gameServerLogs
+	gameServerLogs = store
+	t.Cleanup(func() { gameServerLogs = previous })
+	return store
+}
+
+func (f *fakeGameServerLogStore) Append(ctx context.Context, gameServerID string, entries []gameServerLogEntry) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stored := append(f.entries[gameServerID], entries...)
+	sort.SliceStable(stored, func(i, j int) bool { return stored[i].Timestamp.Before(stored[j].Timestamp) })
+	f.entries[gameServerID] = stored
+	for _, entry := range entries {
+		payload, _ := json.Marshal(entry)
+		for _, subscriber := range f.subscribers[gameServerID] {
+			subscriber <- string(payload)
+		}
+	}
+	return nil
+}
+
+func (f *fakeGameServerLogStore) Recent(ctx context.Context, gameServerID string, n int) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stored := f.entries[gameServerID]
+	if len(stored) > n {
+		stored = stored[len(stored)-n:]
+	}
+	payloads := make([]string, 0, len(stored))
+	for _, entry := range stored {
+		payload, _ := json.Marshal(entry)
+		payloads = append(payloads, string(payload))
+	}
+	return payloads, nil
+}
+
+func (f *fakeGameServerLogStore) Subscribe(ctx context.Context, gameServerID string) (<-chan string, func(), error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	subscriber := make(chan string, 16)
+	f.subscribers[gameServerID] = append(f.subscribers[gameServerID], subscriber)
+	return subscriber, func() {}, nil
+}
+
+func gameServerLogLineAt(line string, at time.Time) *gameserversv1.GameServerLogLine {
+	level := v1.LogLevel_LOG_LEVEL_INFO
+	return &gameserversv1.GameServerLogLine{Line: line, Timestamp: timestamppb.New(at), Level: &level}
+}
+
+func TestForwardGameServerLogHistoryReplaysBeforeLiveLines(t *testing.T) {
+	newFakeGameServerLogStore(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	base := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	// Stored out of order; the replay follows the timestamps
+	appendGameServerLogLines(ctx, "gs-1", []*gameserversv1.GameServerLogLine{
+		gameServerLogLineAt("[Server] Done (4.2s)!", base.Add(3*time.Second)),
+		gameServerLogLineAt("[Server] Starting minecraft server", base),
+		gameServerLogLineAt("[Server] Preparing spawn area", base.Add(2*time.Second)),
+		gameServerLogLineAt("[Server] Loading libraries", base.Add(time.Second)),
+	})
+	appendGameServerLogLines(ctx, "gs-2", []*gameserversv1.GameServerLogLine{
+		gameServerLogLineAt("other server", base),
+	})
+
+	live, unsubscribe, err := gameServerLogs.Subscribe(ctx, "gs-1")
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	defer unsubscribe()
+	// Published after subscribing but before the history is read, so it arrives both ways
+	appendGameServerLogLines(ctx, "gs-1", []*gameserversv1.GameServerLogLine{
+		gameServerLogLineAt("Player joined the game", base.Add(4*time.Second)),
+	})
+
+	lines := make(chan string, 16)
+	done := make(chan error, 1)
+	go func() {
+		done <- forwardGameServerLogHistory(ctx, "gs-1", live, 3, func(line string) error {
+			lines <- line
+			return nil
+		})
+	}()
+
+	expectLines := func(want ...string) {
+		t.Helper()
+		for _, w := range want {
+			select {
+			case got := <-lines:
+				if got != w {
+					t.Fatalf("line = %q, want %q", got, w)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatalf("timed out waiting for %q", w)
+			}
+		}
+	}
+	expectLines("[Server] Preparing spawn area", "[Server] Done (4.2s)!", "Player joined the game")
+
+	appendGameServerLogLines(ctx, "gs-1", []*gameserversv1.GameServerLogLine{
+		gameServerLogLineAt("Player left the game", base.Add(5*time.Second)),
+	})
+	expectLines("Player left the game")
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("forward ended with %v, want nil after the client disconnects", err)
+	}
+	select {
+	case line := <-lines:
+		t.Fatalf("unexpected line %q", line)
+	default:
+	}
+}
+
+func TestGetGameServerLogsReturnsRecentLinesFromHistory(t *testing.T) {
+	db := newGameServerServiceTestDB(t)
+	seedGameServerServiceIsolationData(t, db)
+	newFakeGameServerLogStore(t)
+	service := NewService(context.Background(), database.NewGameServerRepository(db, nil), nil)
+
+	base := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	appendGameServerLogLines(context.Background(), "gs-org-a-owner", []*gameserversv1.GameServerLogLine{
+		gameServerLogLineAt("first", base),
+		gameServerLogLineAt("second", base.Add(time.Second)),
+		gameServerLogLineAt("third", base.Add(2*time.Second)),
+	})
+
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+	resp, err := service.GetGameServerLogs(ctx, connect.NewRequest(&gameserversv1.GetGameServerLogsRequest{
+		GameServerId: "gs-org-a-owner",
+		Limit:        proto.Int32(2),
+	}))
+	if err != nil {
+		t.Fatalf("GetGameServerLogs: %v", err)
+	}
+	var got []string
+	for _, line := range resp.Msg.GetLines() {
+		got = append(got, line.GetLine())
+	}
+	if !slices.Equal(got, []string{"second", "third"}) {
+		t.Fatalf("lines = %v, want the last two stored lines", got)
+	}
+	if ts := resp.Msg.GetLines()[1].GetTimestamp().AsTime(); !ts.Equal(base.Add(2 * time.Second)) {
+		t.Fatalf("timestamp = %s, want the stored line's timestamp", ts)
+	}
+
+	if _, err := service.GetGameServerLogs(ctx, connect.NewRequest(&gameserversv1.GetGameServerLogsRequest{
+		GameServerId: "gs-org-b-owner",
+	})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("cross-org logs code = %v, want %v: %v", connect.CodeOf(err), connect.CodePermissionDenied, err)
+	}
+}
+
+func TestGameServerLogReplayLines(t *testing.T) {
+	t.Setenv("REPLAY_LINES", "")
+	if got := gameServerLogReplayLines(); got != 200 {
+		t.Fatalf("default replay lines = %d, want 200", got)
+	}
+	t.Setenv("REPLAY_LINES", "50")
+	if got := gameServerLogReplayLines(); got != 50 {
+		t.Fatalf("REPLAY_LINES=50 gives %d", got)
+	}
+	t.Setenv("REPLAY_LINES", "lots")
+	if got := gameServerLogReplayLines(); got != 200 {
+		t.Fatalf("invalid REPLAY_LINES gives %d, want the default", got)
+	}
+}
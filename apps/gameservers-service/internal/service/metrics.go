@@ -96,6 +96,15 @@ func (s *Service) GetGameServerMetrics(ctx context.Context, req *connect.Request
 		})
 	}
 
+	// Attach TPS samples (Minecraft servers with /tps) to the raw points they were taken alongside
+	if len(metrics) > 0 {
+		tpsSamples, err := database.GetGameServerTPS(ctx, gameServerID, rawStartTime, rawEndTime)
+		if err != nil {
+			logger.Warn("[GetGameServerMetrics] Failed to query TPS samples: %v", err)
+		}
+		attachTPSSamples(metrics, tpsSamples)
+	}
+
 	// Convert hourly aggregates (use hour start time as timestamp)
 	for _, h := range hourlyAggregates {
 		cpuUsage := h.AvgCPUUsage
@@ -463,3 +472,25 @@ func (s *Service) GetGameServerUsage(ctx context.Context, req *connect.Request[g
 
 	return connect.NewResponse(response), nil
 }
+
+// attachTPSSamples sets TPS on each metric to the latest sample taken at most tpsSampleMatchWindow before it.
+// Both slices are ordered by timestamp.
+func attachTPSSamples(metrics []*gameserversv1.GameServerMetric, samples []database.GameServerTPSMetric) {
+	const tpsSampleMatchWindow = time.Minute
+
+	next := 0
+	for _, metric := range metrics {
+		at := metric.GetTimestamp().AsTime()
+		for next < len(samples) && !samples[next].Timestamp.After(at) {
+			next++
+		}
+		if next == 0 {
+			continue
+		}
+		sample := samples[next-1]
+		if at.Sub(sample.Timestamp) <= tpsSampleMatchWindow {
+			tps := sample.TPS
+			metric.Tps = &tps
+		}
+	}
+}
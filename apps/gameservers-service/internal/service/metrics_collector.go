@@ -0,0 +1,206 @@
+package gameservers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/notifications"
+
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+)
+
+const (
+	lowTPSThreshold    = 10.0
+	lowTPSAlertAfter   = 5 * time.Minute
+	tpsSampleRetention = 7 * 24 * time.Hour
+	tpsCleanupInterval = time.Hour
+)
+
+var (
+	minecraftFormattingCodes = regexp.MustCompile(`§.`)
+	// Paper/Spigot/Purpur: "TPS from last 1m, 5m, 15m: *20.0, 19.98, 19.97"
+	minecraftTPSPattern = regexp.MustCompile(`TPS from last [^:]*:\s*\*?([0-9]+(?:\.[0-9]+)?)`)
+)
+
+// MetricsCollector samples game-level metrics that docker stats cannot see.
+// Container CPU, memory and network usage are recorded by the orchestrator's
+// metrics streamer; this collector adds TPS for Minecraft servers, read over
+// RCON with /tps, and alerts the organization's admins when it stays low.
+type MetricsCollector struct {
+	service       *Service
+	lowTPSSince   map[string]time.Time
+	lowTPSAlerted map[string]bool
+	lastCleanup   time.Time
+	notifyLowTPS  func(ctx context.Context, gameServer *database.GameServer, tps float64, since time.Time)
+}
+
+// NewMetricsCollector creates a collector for the game servers running on this node
+func NewMetricsCollector(service *Service) *MetricsCollector {
+	return &MetricsCollector{
+		service:       service,
+		lowTPSSince:   make(map[string]time.Time),
+		lowTPSAlerted: make(map[string]bool),
+		notifyLowTPS:  sendLowTPSNotification,
+	}
+}
+
+// Start samples metrics every interval until ctx is done
+func (c *MetricsCollector) Start(ctx context.Context, interval time.Duration) {
+	logger.Info("[MetricsCollector] Starting game server metrics collector (interval: %v)", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("[MetricsCollector] Game server metrics collector shutting down")
+			return
+		case <-ticker.C:
+			c.collect(ctx)
+		}
+	}
+}
+
+func (c *MetricsCollector) collect(ctx context.Context) {
+	var gameServers []database.GameServer
+	if err := database.DB.WithContext(ctx).
+		Where("game_type IN ? AND status = ? AND rcon_port IS NOT NULL AND deleted_at IS NULL",
+			[]int32{int32(gameserversv1.GameType_MINECRAFT), int32(gameserversv1.GameType_MINECRAFT_JAVA)},
+			int32(gameserversv1.GameServerStatus_RUNNING)).
+		Find(&gameServers).Error; err != nil {
+		logger.Warn("[MetricsCollector] Failed to query game servers: %v", err)
+		return
+	}
+
+	manager, err := c.service.getGameServerManager()
+	if err != nil {
+		logger.Warn("[MetricsCollector] %v", err)
+		return
+	}
+
+	sampled := make(map[string]bool, len(gameServers))
+	for i := range gameServers {
+		gameServer := &gameServers[i]
+
+		// The collector on the node running the container samples it
+		if shouldForward, _ := c.service.getGameServerForwardTarget(ctx, gameServer.ID); shouldForward {
+			continue
+		}
+
+		output, err := manager.ExecuteRCONCommand(ctx, gameServer.ID, "tps")
+		if err != nil {
+			logger.Debug("[MetricsCollector] Failed to read TPS of game server %s: %v", gameServer.ID, err)
+			continue
+		}
+		// Vanilla and Forge servers have no /tps command
+		tps, ok := parseMinecraftTPS(output)
+		if !ok {
+			continue
+		}
+
+		now := time.Now()
+		sampled[gameServer.ID] = true
+		if err := database.RecordGameServerTPS(ctx, gameServer.ID, tps, now); err != nil {
+			logger.Warn("[MetricsCollector] Failed to record TPS of game server %s: %v", gameServer.ID, err)
+		}
+		c.observeTPS(ctx, gameServer, tps, now)
+	}
+
+	// Forget servers that stopped or lost /tps so a later dip starts a fresh window
+	for id := range c.lowTPSSince {
+		if !sampled[id] {
+			delete(c.lowTPSSince, id)
+			delete(c.lowTPSAlerted, id)
+		}
+	}
+
+	if time.Since(c.lastCleanup) >= tpsCleanupInterval {
+		c.lastCleanup = time.Now()
+		if err := database.CleanOldGameServerTPS(ctx, c.lastCleanup.Add(-tpsSampleRetention)); err != nil {
+			logger.Warn("[MetricsCollector] Failed to clean old TPS samples: %v", err)
+		}
+	}
+}
+
+// observeTPS tracks how long a server has been below lowTPSThreshold and alerts once it has been
+// for lowTPSAlertAfter. The alert is sent once per dip.
+func (c *MetricsCollector) observeTPS(ctx context.Context, gameServer *database.GameServer, tps float64, now time.Time) {
+	if tps >= lowTPSThreshold {
+		delete(c.lowTPSSince, gameServer.ID)
+		delete(c.lowTPSAlerted, gameServer.ID)
+		return
+	}
+
+	since, ok := c.lowTPSSince[gameServer.ID]
+	if !ok {
+		c.lowTPSSince[gameServer.ID] = now
+		return
+	}
+	if now.Sub(since) >= lowTPSAlertAfter && !c.lowTPSAlerted[gameServer.ID] {
+		c.lowTPSAlerted[gameServer.ID] = true
+		c.notifyLowTPS(ctx, gameServer, tps, since)
+	}
+}
+
+// parseMinecraftTPS returns the 1-minute TPS from /tps output
+func parseMinecraftTPS(output string) (float64, bool) {
+	match := minecraftTPSPattern.FindStringSubmatch(minecraftFormattingCodes.ReplaceAllString(output, ""))
+	if match == nil {
+		return 0, false
+	}
+	tps, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	return tps, true
+}
+
+// sendLowTPSNotification notifies the owners and admins of the game server's organization
+func sendLowTPSNotification(ctx context.Context, gameServer *database.GameServer, tps float64, since time.Time) {
+	if gameServer.OrganizationID == "" {
+		return
+	}
+
+	title := fmt.Sprintf("Game Server \"%s\" Is Lagging", gameServer.Name)
+	message := fmt.Sprintf(
+		"Your game server \"%s\" has been running below %.0f TPS since %s (currently %.1f TPS). "+
+			"Players will notice lag; consider reducing loaded chunks, entities or plugins, or increasing the server's resources.",
+		gameServer.Name,
+		lowTPSThreshold,
+		since.UTC().Format(time.RFC1123),
+		tps,
+	)
+	actionURL := fmt.Sprintf("/gameservers/%s", gameServer.ID)
+	actionLabel := "View Game Server"
+
+	if err := notifications.CreateNotificationForOrganization(
+		ctx,
+		gameServer.OrganizationID,
+		notificationsv1.NotificationType_NOTIFICATION_TYPE_WARNING,
+		notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+		title,
+		message,
+		&actionURL,
+		&actionLabel,
+		map[string]string{
+			"game_server_id":   gameServer.ID,
+			"game_server_name": gameServer.Name,
+			"reason":           "low_tps",
+			"tps":              fmt.Sprintf("%.1f", tps),
+			"low_since":        since.UTC().Format(time.RFC3339),
+		},
+		[]string{"owner", "admin"},
+	); err != nil {
+		logger.Warn("[MetricsCollector] Failed to send low TPS notification for game server %s: %v", gameServer.ID, err)
+		return
+	}
+
+	logger.Info("[MetricsCollector] Sent low TPS notification for game server %s (%.1f TPS)", gameServer.ID, tps)
+}
@@ -0,0 +1,84 @@
+package gameservers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParseMinecraftTPS(t *testing.T) {
+	cases := map[string]float64{
+		"§6TPS from last 1m, 5m, 15m: §a*20.0, §a19.98, §a19.97": 20,
+		"TPS from last 1m, 5m, 15m: 8.42, 12.1, 15.0":            8.42,
+	}
+	for output, want := range cases {
+		got, ok := parseMinecraftTPS(output)
+		if !ok || got != want {
+			t.Fatalf("parseMinecraftTPS(%q) = %v, %v; want %v", output, got, ok, want)
+		}
+	}
+	if _, ok := parseMinecraftTPS("Unknown or incomplete command, see below for error"); ok {
+		t.Fatal("parseMinecraftTPS accepted a vanilla unknown-command reply")
+	}
+}
+
+func TestObserveTPSAlertsAfterSustainedLowTPS(t *testing.T) {
+	collector := NewMetricsCollector(nil)
+	alerts := 0
+	collector.notifyLowTPS = func(ctx context.Context, gameServer *database.GameServer, tps float64, since time.Time) {
+		alerts++
+	}
+
+	gameServer := &database.GameServer{ID: "gs-1"}
+	start := time.Now()
+	sample := func(offset time.Duration, tps float64) {
+		collector.observeTPS(context.Background(), gameServer, tps, start.Add(offset))
+	}
+
+	sample(0, 8)
+	sample(4*time.Minute+30*time.Second, 9)
+	if alerts != 0 {
+		t.Fatalf("alerted after %d samples below threshold within 5 minutes", alerts)
+	}
+
+	// A recovery resets the window
+	sample(4*time.Minute+45*time.Second, 18)
+	sample(5*time.Minute, 7)
+	sample(9*time.Minute, 7)
+	if alerts != 0 {
+		t.Fatal("alerted although TPS recovered within the window")
+	}
+
+	sample(10*time.Minute, 6)
+	sample(11*time.Minute, 6)
+	if alerts != 1 {
+		t.Fatalf("alerts = %d after 5 minutes below threshold, want exactly 1", alerts)
+	}
+}
+
+func TestAttachTPSSamples(t *testing.T) {
+	start := time.Now().Truncate(time.Second)
+	metrics := []*gameserversv1.GameServerMetric{
+		{Timestamp: timestamppb.New(start)},
+		{Timestamp: timestamppb.New(start.Add(30 * time.Second))},
+		{Timestamp: timestamppb.New(start.Add(5 * time.Minute))},
+	}
+	attachTPSSamples(metrics, []database.GameServerTPSMetric{
+		{TPS: 19.5, Timestamp: start.Add(-10 * time.Second)},
+		{TPS: 12, Timestamp: start.Add(25 * time.Second)},
+	})
+
+	if metrics[0].Tps == nil || *metrics[0].Tps != 19.5 {
+		t.Fatalf("first metric TPS = %v, want 19.5", metrics[0].Tps)
+	}
+	if metrics[1].Tps == nil || *metrics[1].Tps != 12 {
+		t.Fatalf("second metric TPS = %v, want 12", metrics[1].Tps)
+	}
+	if metrics[2].Tps != nil {
+		t.Fatalf("third metric TPS = %v, want none for a stale sample", *metrics[2].Tps)
+	}
+}
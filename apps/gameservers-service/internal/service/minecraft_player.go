@@ -25,32 +25,49 @@ func (s *Service) GetMinecraftPlayerUUID(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("username is required"))
 	}
 
+	uuid, name, found, err := lookupMinecraftPlayer(ctx, username)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !found {
+		// Player not found
+		return connect.NewResponse(&gameserversv1.GetMinecraftPlayerUUIDResponse{}), nil
+	}
+
+	return connect.NewResponse(&gameserversv1.GetMinecraftPlayerUUIDResponse{
+		Uuid: &uuid,
+		Name: &name,
+	}), nil
+}
+
+// lookupMinecraftPlayer resolves a username to the player's dashed UUID and current name with the Mojang API.
+// found is false for unknown players. Tests replace it to stay offline.
+var lookupMinecraftPlayer = func(ctx context.Context, username string) (uuid string, name string, found bool, err error) {
 	// Proxy request to Mojang API
 	mojangURL := fmt.Sprintf("https://api.mojang.com/users/profiles/minecraft/%s", username)
 	client := &http.Client{Timeout: 10 * time.Second}
 	httpReq, err := http.NewRequestWithContext(ctx, "GET", mojangURL, nil)
 	if err != nil {
 		logger.Error("[MinecraftPlayer] Failed to create request: %v", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create request"))
+		return "", "", false, fmt.Errorf("failed to create request")
 	}
 	httpReq.Header.Set("User-Agent", "ObienteCloud/1.0")
 
 	resp, err := client.Do(httpReq)
 	if err != nil {
 		logger.Error("[MinecraftPlayer] Failed to fetch player UUID: %v", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch player data"))
+		return "", "", false, fmt.Errorf("failed to fetch player data")
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusNotFound {
-		// Player not found
-		return connect.NewResponse(&gameserversv1.GetMinecraftPlayerUUIDResponse{}), nil
+		return "", "", false, nil
 	}
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		logger.Error("[MinecraftPlayer] Mojang API returned status %d: %s", resp.StatusCode, string(body))
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch player data"))
+		return "", "", false, fmt.Errorf("failed to fetch player data")
 	}
 
 	var data struct {
@@ -60,16 +77,11 @@ func (s *Service) GetMinecraftPlayerUUID(
 
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
 		logger.Error("[MinecraftPlayer] Failed to decode response: %v", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse response"))
+		return "", "", false, fmt.Errorf("failed to parse response")
 	}
 
 	// Format UUID with dashes (Mojang returns undashed)
-	uuid := formatUUIDWithDashes(data.ID)
-
-	return connect.NewResponse(&gameserversv1.GetMinecraftPlayerUUIDResponse{
-		Uuid: &uuid,
-		Name: &data.Name,
-	}), nil
+	return formatUUIDWithDashes(data.ID), data.Name, true, nil
 }
 
 // GetMinecraftPlayerProfile gets a Minecraft player profile from their UUID
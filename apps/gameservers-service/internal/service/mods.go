@@ -0,0 +1,368 @@
+package gameservers
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gameservers-service/internal/catalog/curseforge"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/inputvalidation"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	modSourceModrinth   = "modrinth"
+	modSourceCurseForge = "curseforge"
+)
+
+// resolvedModFile is a mod file resolved from a catalog, ready to download
+type resolvedModFile struct {
+	Filename    string
+	DownloadURL string
+	Hashes      map[string]string
+}
+
+// InstallGameServerMod installs a mod from Modrinth or CurseForge
+func (s *Service) InstallGameServerMod(ctx context.Context, req *connect.Request[gameserversv1.InstallGameServerModRequest]) (*connect.Response[gameserversv1.InstallGameServerModResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	if gameServerID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game_server_id is required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersUpdate); err != nil {
+		return nil, err
+	}
+
+	if shouldForward, targetNodeID := s.getGameServerForwardTarget(ctx, gameServerID); shouldForward {
+		var response gameserversv1.InstallGameServerModResponse
+		if err := s.forwardGameServerRequest(ctx, req.Msg, req.Header().Get("Authorization"), targetNodeID, "InstallGameServerMod", &response); err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&response), nil
+	}
+
+	if err := s.InstallMod(ctx, gameServerID, req.Msg.GetSource(), req.Msg.GetModId(), req.Msg.GetVersion()); err != nil {
+		return nil, err
+	}
+
+	var mod database.GameServerMod
+	if err := database.DB.Where("game_server_id = ? AND source = ? AND mod_id = ?", gameServerID, normalizeModSource(req.Msg.GetSource()), strings.TrimSpace(req.Msg.GetModId())).First(&mod).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load installed mod: %w", err))
+	}
+	return connect.NewResponse(&gameserversv1.InstallGameServerModResponse{Mod: gameServerModToProto(&mod)}), nil
+}
+
+// UninstallGameServerMod removes a mod installed through InstallGameServerMod
+func (s *Service) UninstallGameServerMod(ctx context.Context, req *connect.Request[gameserversv1.UninstallGameServerModRequest]) (*connect.Response[gameserversv1.UninstallGameServerModResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	if gameServerID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game_server_id is required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersUpdate); err != nil {
+		return nil, err
+	}
+
+	if shouldForward, targetNodeID := s.getGameServerForwardTarget(ctx, gameServerID); shouldForward {
+		var response gameserversv1.UninstallGameServerModResponse
+		if err := s.forwardGameServerRequest(ctx, req.Msg, req.Header().Get("Authorization"), targetNodeID, "UninstallGameServerMod", &response); err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&response), nil
+	}
+
+	var mod database.GameServerMod
+	err := database.DB.Where("game_server_id = ? AND source = ? AND mod_id = ?", gameServerID, normalizeModSource(req.Msg.GetSource()), strings.TrimSpace(req.Msg.GetModId())).First(&mod).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("mod %s is not installed", req.Msg.GetModId()))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load mod: %w", err))
+	}
+
+	if err := s.removeGameServerModFile(ctx, gameServerID, mod.Filename); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove mod file: %w", err))
+	}
+	if err := database.DB.Delete(&mod).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete mod record: %w", err))
+	}
+	logger.Info("[GameServerMods] Uninstalled %s mod %s (%s) from game server %s", mod.Source, mod.ModID, mod.Filename, gameServerID)
+
+	if err := s.restartGameServerIfRunning(ctx, gameServerID); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&gameserversv1.UninstallGameServerModResponse{Success: true}), nil
+}
+
+// ListGameServerMods lists the mods installed through InstallGameServerMod
+func (s *Service) ListGameServerMods(ctx context.Context, req *connect.Request[gameserversv1.ListGameServerModsRequest]) (*connect.Response[gameserversv1.ListGameServerModsResponse], error) {
+	gameServerID := strings.TrimSpace(req.Msg.GetGameServerId())
+	if gameServerID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game_server_id is required"))
+	}
+	if err := s.checkGameServerPermission(ctx, gameServerID, auth.PermissionGameServersRead); err != nil {
+		return nil, err
+	}
+
+	var mods []database.GameServerMod
+	if err := database.DB.Where("game_server_id = ?", gameServerID).Order("installed_at ASC").Find(&mods).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list mods: %w", err))
+	}
+
+	resp := &gameserversv1.ListGameServerModsResponse{Mods: make([]*gameserversv1.GameServerMod, 0, len(mods))}
+	for i := range mods {
+		resp.Mods = append(resp.Mods, gameServerModToProto(&mods[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// InstallMod downloads a mod version from Modrinth or CurseForge into the server's mods directory,
+// records it and restarts the server if it is running. Installing another version of a recorded
+// mod replaces the previous file. Errors are connect errors.
+func (s *Service) InstallMod(ctx context.Context, gameServerID, source, modID, version string) error {
+	source = normalizeModSource(source)
+	modID = strings.TrimSpace(modID)
+	version = strings.TrimSpace(version)
+	if modID == "" || version == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("mod_id and version are required"))
+	}
+
+	dbGameServer, err := s.repo.GetByID(ctx, gameServerID)
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("game server %s not found", gameServerID))
+	}
+	if dbGameServer.GameType != int32(gameserversv1.GameType_MINECRAFT) && dbGameServer.GameType != int32(gameserversv1.GameType_MINECRAFT_JAVA) {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("mods are only supported on Minecraft Java servers"))
+	}
+
+	env := parseEnvVars(dbGameServer.EnvVars)
+	serverType := strings.ToUpper(env["TYPE"])
+	if _, err := buildInstallProfile(serverType, gameserversv1.MinecraftProjectType_MINECRAFT_PROJECT_TYPE_MOD); err != nil {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	serverVersion := minecraftServerVersion(dbGameServer.ServerVersion, env)
+
+	var file *resolvedModFile
+	switch source {
+	case modSourceModrinth:
+		file, err = s.resolveModrinthModFile(ctx, modID, version, serverType, serverVersion)
+	case modSourceCurseForge:
+		file, err = s.resolveCurseForgeModFile(ctx, modID, version, serverType, serverVersion)
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("source must be %q or %q", modSourceModrinth, modSourceCurseForge))
+	}
+	if err != nil {
+		return err
+	}
+	if err := inputvalidation.UploadFileName(file.Filename); err != nil {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("mod file has an invalid name: %w", err))
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gameserver-mod-*")
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to prepare download: %w", err))
+	}
+	defer os.RemoveAll(tmpDir)
+
+	localPath := filepath.Join(tmpDir, file.Filename)
+	if err := downloadAndVerify(ctx, file.DownloadURL, localPath, file.Hashes); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to download mod: %w", err))
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeModTar(pw, localPath, file.Filename))
+	}()
+	err = s.copyTarToGameServer(ctx, gameServerID, "", "/data", pr)
+	pr.Close()
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to copy mod to game server: %w", err))
+	}
+
+	var mod database.GameServerMod
+	err = database.DB.Where("game_server_id = ? AND source = ? AND mod_id = ?", gameServerID, source, modID).First(&mod).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		mod = database.GameServerMod{
+			ID:           fmt.Sprintf("gsmod-%s", uuid.NewString()),
+			GameServerID: gameServerID,
+			Source:       source,
+			ModID:        modID,
+		}
+	case err != nil:
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load mod: %w", err))
+	case mod.Filename != "" && mod.Filename != file.Filename:
+		if err := s.removeGameServerModFile(ctx, gameServerID, mod.Filename); err != nil {
+			logger.Warn("[GameServerMods] Failed to remove replaced file %s from game server %s: %v", mod.Filename, gameServerID, err)
+		}
+	}
+	mod.Version = version
+	mod.Filename = file.Filename
+	mod.InstalledAt = time.Now()
+	if err := database.DB.Save(&mod).Error; err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to record mod: %w", err))
+	}
+	logger.Info("[GameServerMods] Installed %s mod %s version %s (%s) on game server %s", source, modID, version, file.Filename, gameServerID)
+
+	return s.restartGameServerIfRunning(ctx, gameServerID)
+}
+
+func (s *Service) resolveModrinthModFile(ctx context.Context, projectID, versionID, serverType, serverVersion string) (*resolvedModFile, error) {
+	version, err := s.modClient.GetVersion(ctx, versionID)
+	if err != nil {
+		return nil, modrinthFetchConnectError("failed to fetch version", err)
+	}
+	if !strings.EqualFold(version.ProjectID, projectID) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("version does not belong to mod"))
+	}
+	if strings.EqualFold(version.ServerSide, "unsupported") {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("selected version is not server-compatible"))
+	}
+	if err := validateMinecraftVersionCompatibility(*version, serverType, gameserversv1.MinecraftProjectType_MINECRAFT_PROJECT_TYPE_MOD, serverVersion); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	file := selectDownloadFileForLoader(version.Files, loaderFromServerType(serverType))
+	if file == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("version has no downloadable file compatible with %s", serverType))
+	}
+	return &resolvedModFile{Filename: file.Filename, DownloadURL: file.URL, Hashes: file.Hashes}, nil
+}
+
+func (s *Service) resolveCurseForgeModFile(ctx context.Context, modID, fileID, serverType, serverVersion string) (*resolvedModFile, error) {
+	file, err := s.curseForgeClient.GetModFile(ctx, modID, fileID)
+	switch {
+	case errors.Is(err, curseforge.ErrNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("curseforge file %s of mod %s not found", fileID, modID))
+	case errors.Is(err, curseforge.ErrNotConfigured), errors.Is(err, curseforge.ErrDownloadDisabled):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	case err != nil:
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to fetch curseforge file: %w", err))
+	}
+	if err := validateCurseForgeCompatibility(*file, serverType, serverVersion); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return &resolvedModFile{Filename: file.FileName, DownloadURL: file.DownloadURL, Hashes: map[string]string{"sha1": file.Hashes["sha1"]}}, nil
+}
+
+// validateCurseForgeCompatibility checks a CurseForge file against the server's Minecraft version and loader.
+// CurseForge lists both in gameVersions, so loaders are matched against the same list.
+func validateCurseForgeCompatibility(file curseforge.File, serverType, serverVersion string) error {
+	if serverVersion != "" && len(file.GameVersions) > 0 && !containsFold(file.GameVersions, serverVersion) {
+		return fmt.Errorf("selected file does not support Minecraft %s", serverVersion)
+	}
+	loaders := compatibleLoadersForServer(serverType, gameserversv1.MinecraftProjectType_MINECRAFT_PROJECT_TYPE_MOD)
+	var fileLoaders []string
+	for _, value := range file.GameVersions {
+		if minecraftLoaderToken(value) {
+			fileLoaders = append(fileLoaders, value)
+		}
+	}
+	if len(loaders) > 0 && len(fileLoaders) > 0 && !intersectsFold(fileLoaders, loaders) {
+		return fmt.Errorf("selected file does not support %s", strings.ToUpper(serverType))
+	}
+	return nil
+}
+
+// writeModTar writes a tar holding the mods directory and the mod file, for extraction at /data
+func writeModTar(w io.Writer, localPath, filename string) error {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	dir := strings.TrimPrefix(minecraftModsDir, "/")
+	tw := tar.NewWriter(w)
+	if err := tw.WriteHeader(&tar.Header{Name: dir + "/", Typeflag: tar.TypeDir, Mode: 0o755, ModTime: time.Now()}); err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: dir + "/" + filename, Typeflag: tar.TypeReg, Mode: 0o644, Size: info.Size(), ModTime: time.Now()}); err != nil {
+		return err
+	}
+	if _, err := io.Copy(tw, f); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// removeGameServerModFile deletes a mod jar from the server's data volume; a missing file is not an error
+func (s *Service) removeGameServerModFile(ctx context.Context, gameServerID, filename string) error {
+	dataPath, err := s.resolveGameServerVolume(ctx, gameServerID)
+	if err != nil {
+		return err
+	}
+	target, err := resolveWithinVolume(dataPath, filepath.Join(minecraftModsDir, filename))
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *Service) restartGameServerIfRunning(ctx context.Context, gameServerID string) error {
+	dbGameServer, err := s.repo.GetByID(ctx, gameServerID)
+	if err != nil || dbGameServer.Status != int32(gameserversv1.GameServerStatus_RUNNING) {
+		return nil
+	}
+
+	manager, err := s.getGameServerManager()
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get game server manager: %w", err))
+	}
+	if err := manager.RestartGameServer(ctx, gameServerID); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("mods changed but the server failed to restart: %w", err))
+	}
+	return nil
+}
+
+func (s *Service) forwardGameServerRequest(ctx context.Context, msg any, authorization, targetNodeID, method string, response any) error {
+	reqBody, _ := json.Marshal(msg)
+	headers := map[string]string{"Authorization": authorization}
+	bodyBytes, err := s.forwardUnaryRequest(ctx, reqBody, targetNodeID, "/obiente.cloud.gameservers.v1.GameServerService/"+method, headers)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to forward request: %w", err))
+	}
+	if err := json.Unmarshal(bodyBytes, response); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode response: %w", err))
+	}
+	return nil
+}
+
+func normalizeModSource(source string) string {
+	return strings.ToLower(strings.TrimSpace(source))
+}
+
+func gameServerModToProto(mod *database.GameServerMod) *gameserversv1.GameServerMod {
+	return &gameserversv1.GameServerMod{
+		Id:           mod.ID,
+		GameServerId: mod.GameServerID,
+		Source:       mod.Source,
+		ModId:        mod.ModID,
+		Version:      mod.Version,
+		Filename:     mod.Filename,
+		InstalledAt:  timestamppb.New(mod.InstalledAt),
+	}
+}
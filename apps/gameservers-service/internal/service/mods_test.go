@@ -0,0 +1,61 @@
+package gameservers
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gameservers-service/internal/catalog/curseforge"
+)
+
+func TestValidateCurseForgeCompatibility(t *testing.T) {
+	file := curseforge.File{GameVersions: []string{"1.20.1", "Forge", "Server"}}
+
+	if err := validateCurseForgeCompatibility(file, "FORGE", "1.20.1"); err != nil {
+		t.Fatalf("expected Forge 1.20.1 server to accept file: %v", err)
+	}
+	if err := validateCurseForgeCompatibility(file, "FABRIC", "1.20.1"); err == nil {
+		t.Fatal("expected Fabric server to reject Forge-only file")
+	}
+	if err := validateCurseForgeCompatibility(file, "FORGE", "1.21.1"); err == nil {
+		t.Fatal("expected Minecraft 1.21.1 server to reject 1.20.1 file")
+	}
+}
+
+func TestWriteModTar(t *testing.T) {
+	localPath := filepath.Join(t.TempDir(), "jei.jar")
+	if err := os.WriteFile(localPath, []byte("jar-bytes"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := writeModTar(&buf, localPath, "jei.jar"); err != nil {
+		t.Fatalf("writeModTar returned error: %v", err)
+	}
+
+	tr := tar.NewReader(&buf)
+	var names []string
+	var content []byte
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, hdr.Name)
+		if hdr.Typeflag == tar.TypeReg {
+			content, _ = io.ReadAll(tr)
+		}
+	}
+	if len(names) != 2 || names[0] != "mods/" || names[1] != "mods/jei.jar" {
+		t.Fatalf("unexpected tar entries: %v", names)
+	}
+	if string(content) != "jar-bytes" {
+		t.Fatalf("unexpected jar content: %q", content)
+	}
+}
@@ -0,0 +1,339 @@
+package gameservers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	gameserverorchestrator "gameservers-service/internal/orchestrator"
+
+	"github.com/obiente/cloud/apps/shared/pkg/docker"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+
+	"connectrpc.com/connect"
+)
+
+// minecraftBanTimeFormat is the date format of banned-players.json
+const minecraftBanTimeFormat = "2006-01-02 15:04:05 -0700"
+
+var (
+	minecraftPlayerNamePattern = regexp.MustCompile(`^[A-Za-z0-9_]{3,16}$`)
+
+	errMinecraftPlayerNotFound = errors.New("minecraft player not found")
+)
+
+// minecraftPlayerList is a Minecraft access list: changed over RCON and saved by the server in a JSON file
+type minecraftPlayerList struct {
+	name          string
+	path          string
+	addCommand    string
+	removeCommand string
+	reloadCommand string // Makes the server reread the file; empty when it cannot
+	bans          bool   // Entries carry the ban fields
+}
+
+var (
+	minecraftWhitelist = minecraftPlayerList{
+		name:          "whitelist",
+		path:          "/data/whitelist.json",
+		addCommand:    "whitelist add %s",
+		removeCommand: "whitelist remove %s",
+		reloadCommand: "whitelist reload",
+	}
+	minecraftBanList = minecraftPlayerList{
+		name:          "ban list",
+		path:          "/data/banned-players.json",
+		addCommand:    "ban %s",
+		removeCommand: "pardon %s",
+		bans:          true,
+	}
+)
+
+// minecraftPlayerListEntry is an entry of whitelist.json or banned-players.json; only bans have the remaining fields
+type minecraftPlayerListEntry struct {
+	UUID    string `json:"uuid"`
+	Name    string `json:"name"`
+	Created string `json:"created,omitempty"`
+	Source  string `json:"source,omitempty"`
+	Expires string `json:"expires,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// playerListFiles reads and writes files in a game server's data directory
+type playerListFiles interface {
+	ReadFile(ctx context.Context, path string) ([]byte, error)
+	WriteFile(ctx context.Context, path string, content []byte) error
+}
+
+// containerPlayerListFiles accesses the files with docker exec in the game server's container
+type containerPlayerListFiles struct {
+	dcli        *docker.Client
+	containerID string
+}
+
+// ReadFile returns the file's content, or nothing when it does not exist yet
+func (f containerPlayerListFiles) ReadFile(ctx context.Context, path string) ([]byte, error) {
+	output, err := f.dcli.ContainerExecRun(ctx, f.containerID, []string{"sh", "-c", `[ ! -f "$1" ] || cat "$1"`, "sh", path})
+	if err != nil {
+		return nil, err
+	}
+	return []byte(output), nil
+}
+
+// WriteFile replaces the file's content, handing it to the owner of the data directory so the server can keep saving it
+func (f containerPlayerListFiles) WriteFile(ctx context.Context, path string, content []byte) error {
+	_, err := f.dcli.ContainerExecRunInput(ctx, f.containerID, []string{"sh", "-c", `cat > "$1" && chown "$(stat -c %u:%g "${1%/*}")" "$1"`, "sh", path}, content)
+	return err
+}
+
+// ManagePlayerWhitelist adds, removes or lists whitelisted players of a Minecraft server
+func (s *Service) ManagePlayerWhitelist(ctx context.Context, req *connect.Request[gameserversv1.ManagePlayerWhitelistRequest]) (*connect.Response[gameserversv1.ManagePlayerWhitelistResponse], error) {
+	gameServerID := req.Msg.GetGameServerId()
+	if err := s.checkGameServerPermission(ctx, gameServerID, "update"); err != nil {
+		return nil, err
+	}
+	if err := validatePlayerListRequest(req.Msg.GetAction(), req.Msg.GetPlayerName()); err != nil {
+		return nil, err
+	}
+
+	if shouldForward, targetNodeID := s.getGameServerForwardTarget(ctx, gameServerID); shouldForward {
+		reqBody, _ := json.Marshal(req.Msg)
+		headers := map[string]string{"Authorization": req.Header().Get("Authorization")}
+		bodyBytes, err := s.forwardUnaryRequest(ctx, reqBody, targetNodeID, "/obiente.cloud.gameservers.v1.GameServerService/ManagePlayerWhitelist", headers)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to forward request: %w", err))
+		}
+
+		var response gameserversv1.ManagePlayerWhitelistResponse
+		if err := json.Unmarshal(bodyBytes, &response); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode response: %w", err))
+		}
+		return connect.NewResponse(&response), nil
+	}
+
+	output, entries, err := s.managePlayerList(ctx, gameServerID, minecraftWhitelist, req.Msg.GetAction(), strings.TrimSpace(req.Msg.GetPlayerName()))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&gameserversv1.ManagePlayerWhitelistResponse{Players: playerListEntriesToProto(entries), Output: output}), nil
+}
+
+// ManagePlayerBanList bans, pardons or lists banned players of a Minecraft server
+func (s *Service) ManagePlayerBanList(ctx context.Context, req *connect.Request[gameserversv1.ManagePlayerBanListRequest]) (*connect.Response[gameserversv1.ManagePlayerBanListResponse], error) {
+	gameServerID := req.Msg.GetGameServerId()
+	if err := s.checkGameServerPermission(ctx, gameServerID, "update"); err != nil {
+		return nil, err
+	}
+	if err := validatePlayerListRequest(req.Msg.GetAction(), req.Msg.GetPlayerName()); err != nil {
+		return nil, err
+	}
+
+	if shouldForward, targetNodeID := s.getGameServerForwardTarget(ctx, gameServerID); shouldForward {
+		reqBody, _ := json.Marshal(req.Msg)
+		headers := map[string]string{"Authorization": req.Header().Get("Authorization")}
+		bodyBytes, err := s.forwardUnaryRequest(ctx, reqBody, targetNodeID, "/obiente.cloud.gameservers.v1.GameServerService/ManagePlayerBanList", headers)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to forward request: %w", err))
+		}
+
+		var response gameserversv1.ManagePlayerBanListResponse
+		if err := json.Unmarshal(bodyBytes, &response); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode response: %w", err))
+		}
+		return connect.NewResponse(&response), nil
+	}
+
+	output, entries, err := s.managePlayerList(ctx, gameServerID, minecraftBanList, req.Msg.GetAction(), strings.TrimSpace(req.Msg.GetPlayerName()))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&gameserversv1.ManagePlayerBanListResponse{Players: playerListEntriesToProto(entries), Output: output}), nil
+}
+
+func validatePlayerListRequest(action gameserversv1.PlayerListAction, playerName string) error {
+	switch action {
+	case gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_LIST:
+		return nil
+	case gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_ADD, gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_REMOVE:
+		if !minecraftPlayerNamePattern.MatchString(strings.TrimSpace(playerName)) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("player_name must be a Minecraft username of 3 to 16 letters, digits or underscores"))
+		}
+		return nil
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("action is required"))
+	}
+}
+
+// managePlayerList applies the action to a list of a running Minecraft server on this node
+func (s *Service) managePlayerList(ctx context.Context, gameServerID string, list minecraftPlayerList, action gameserversv1.PlayerListAction, playerName string) (string, []minecraftPlayerListEntry, error) {
+	gameServer, err := s.repo.GetByID(ctx, gameServerID)
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("game server not found: %w", err))
+	}
+	if gameServer.GameType != int32(gameserversv1.GameType_MINECRAFT) && gameServer.GameType != int32(gameserversv1.GameType_MINECRAFT_JAVA) {
+		return "", nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the %s is only available for Minecraft Java servers", list.name))
+	}
+	if gameServer.ContainerID == nil || *gameServer.ContainerID == "" {
+		return "", nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("game server is not running"))
+	}
+
+	if action != gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_LIST {
+		allowed, err := allowRCONCommand(ctx, gameServerID)
+		if err != nil {
+			logger.Warn("[ManagePlayerList] Rate limit check failed for game server %s, allowing command: %v", gameServerID, err)
+		}
+		if !allowed {
+			return "", nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("rate limit of %d commands per minute reached", rconRateLimit))
+		}
+	}
+
+	dcli, err := docker.New()
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeInternal, fmt.Errorf("docker client: %w", err))
+	}
+	defer dcli.Close()
+
+	containerInfo, err := dcli.ContainerInspect(ctx, *gameServer.ContainerID)
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("container not found: %w", err))
+	}
+	if containerInfo.State == nil || !containerInfo.State.Running {
+		return "", nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("game server is not running"))
+	}
+
+	manager, err := s.getGameServerManager()
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get game server manager: %w", err))
+	}
+	execute := func(ctx context.Context, command string) (string, error) {
+		return manager.ExecuteRCONCommand(ctx, gameServerID, command)
+	}
+
+	output, entries, err := applyPlayerListChange(ctx, list, action, playerName, execute, containerPlayerListFiles{dcli: dcli, containerID: *gameServer.ContainerID})
+	if err != nil {
+		switch {
+		case errors.Is(err, gameserverorchestrator.ErrRCONNotConfigured):
+			return "", nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, errMinecraftPlayerNotFound):
+			return "", nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return "", nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to update the %s: %w", list.name, err))
+	}
+
+	if action != gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_LIST {
+		logger.Info("[ManagePlayerList] Updated %s of game server %s (%s %s)", list.name, gameServerID, action, playerName)
+	}
+	return output, entries, nil
+}
+
+// applyPlayerListChange runs the RCON command for the action and makes sure the list file reflects it,
+// so the change survives a restart even when the server did not save it. It returns the RCON output and
+// the list after the change.
+func applyPlayerListChange(
+	ctx context.Context,
+	list minecraftPlayerList,
+	action gameserversv1.PlayerListAction,
+	playerName string,
+	execute func(ctx context.Context, command string) (string, error),
+	files playerListFiles,
+) (string, []minecraftPlayerListEntry, error) {
+	var output string
+	var err error
+	switch action {
+	case gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_ADD:
+		output, err = execute(ctx, fmt.Sprintf(list.addCommand, playerName))
+	case gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_REMOVE:
+		output, err = execute(ctx, fmt.Sprintf(list.removeCommand, playerName))
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	content, err := files.ReadFile(ctx, list.path)
+	if err != nil {
+		return "", nil, fmt.Errorf("read %s: %w", list.path, err)
+	}
+	var entries []minecraftPlayerListEntry
+	if len(bytes.TrimSpace(content)) > 0 {
+		if err := json.Unmarshal(content, &entries); err != nil {
+			return "", nil, fmt.Errorf("parse %s: %w", list.path, err)
+		}
+	}
+
+	index := -1
+	for i, entry := range entries {
+		if strings.EqualFold(entry.Name, playerName) {
+			index = i
+			break
+		}
+	}
+
+	switch {
+	case action == gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_ADD && index < 0:
+		uuid, name, found, err := lookupMinecraftPlayer(ctx, playerName)
+		if err != nil {
+			return "", nil, err
+		}
+		if !found {
+			return "", nil, fmt.Errorf("%w: %s", errMinecraftPlayerNotFound, playerName)
+		}
+		entry := minecraftPlayerListEntry{UUID: uuid, Name: name}
+		if list.bans {
+			entry.Created = time.Now().Format(minecraftBanTimeFormat)
+			entry.Source = "Server"
+			entry.Expires = "forever"
+			entry.Reason = "Banned by an operator."
+		}
+		entries = append(entries, entry)
+	case action == gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_REMOVE && index >= 0:
+		entries = append(entries[:index], entries[index+1:]...)
+	default:
+		// The server already saved the change, or nothing changed
+		return output, entries, nil
+	}
+
+	if entries == nil {
+		entries = []minecraftPlayerListEntry{}
+	}
+	content, err = json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return "", nil, err
+	}
+	if err := files.WriteFile(ctx, list.path, content); err != nil {
+		return "", nil, fmt.Errorf("write %s: %w", list.path, err)
+	}
+	if list.reloadCommand != "" {
+		if _, err := execute(ctx, list.reloadCommand); err != nil {
+			logger.Warn("[ManagePlayerList] Failed to reload the %s after writing %s: %v", list.name, list.path, err)
+		}
+	}
+	return output, entries, nil
+}
+
+func playerListEntriesToProto(entries []minecraftPlayerListEntry) []*gameserversv1.PlayerListEntry {
+	players := make([]*gameserversv1.PlayerListEntry, 0, len(entries))
+	for _, entry := range entries {
+		player := &gameserversv1.PlayerListEntry{Uuid: entry.UUID, Name: entry.Name}
+		if entry.Created != "" {
+			player.Created = &entry.Created
+		}
+		if entry.Source != "" {
+			player.Source = &entry.Source
+		}
+		if entry.Expires != "" {
+			player.Expires = &entry.Expires
+		}
+		if entry.Reason != "" {
+			player.Reason = &entry.Reason
+		}
+		players = append(players, player)
+	}
+	return players
+}
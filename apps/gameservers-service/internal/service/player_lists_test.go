@@ -0,0 +1,184 @@
+package gameservers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorcon/rcon"
+	"github.com/gorcon/rcon/rcontest"
+
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+
+	"connectrpc.com/connect"
+)
+
+type fakePlayerListFiles struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func (f *fakePlayerListFiles) ReadFile(_ context.Context, path string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.files[path], nil
+}
+
+func (f *fakePlayerListFiles) WriteFile(_ context.Context, path string, content []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.files[path] = content
+	return nil
+}
+
+func (f *fakePlayerListFiles) entries(t *testing.T, path string) []minecraftPlayerListEntry {
+	t.Helper()
+	content, _ := f.ReadFile(context.Background(), path)
+	var entries []minecraftPlayerListEntry
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &entries); err != nil {
+			t.Fatalf("parse %s: %v", path, err)
+		}
+	}
+	return entries
+}
+
+func TestApplyPlayerListChange(t *testing.T) {
+	files := &fakePlayerListFiles{files: map[string][]byte{}}
+	var commands []string
+	var mu sync.Mutex
+
+	// The mock server saves whitelist additions itself, like Minecraft does, and leaves every other change unsaved
+	server := rcontest.NewServer(
+		rcontest.SetSettings(rcontest.Settings{Password: "s3cret"}),
+		rcontest.SetCommandHandler(func(c *rcontest.Context) {
+			command := c.Request().Body()
+			mu.Lock()
+			commands = append(commands, command)
+			mu.Unlock()
+
+			response := "Unknown command"
+			switch {
+			case command == "whitelist add Alice":
+				files.WriteFile(context.Background(), minecraftWhitelist.path, []byte(`[{"uuid":"11111111-1111-1111-1111-111111111111","name":"Alice"}]`))
+				response = "Added Alice to the whitelist"
+			case command == "whitelist remove Alice":
+				response = "Removed Alice from the whitelist"
+			case command == "whitelist reload":
+				response = "Reloaded the whitelist"
+			case strings.HasPrefix(command, "ban "):
+				response = "Banned " + strings.TrimPrefix(command, "ban ")
+			}
+			rcon.NewPacket(rcon.SERVERDATA_RESPONSE_VALUE, c.Request().ID, response).WriteTo(c.Conn())
+		}),
+	)
+	defer server.Close()
+
+	execute := func(ctx context.Context, command string) (string, error) {
+		conn, err := rcon.Dial(server.Addr(), "s3cret")
+		if err != nil {
+			return "", err
+		}
+		defer conn.Close()
+		return conn.Execute(command)
+	}
+
+	previousLookup := lookupMinecraftPlayer
+	lookupMinecraftPlayer = func(_ context.Context, username string) (string, string, bool, error) {
+		if strings.EqualFold(username, "bob") {
+			return "22222222-2222-2222-2222-222222222222", "Bob", true, nil
+		}
+		return "", "", false, nil
+	}
+	t.Cleanup(func() { lookupMinecraftPlayer = previousLookup })
+
+	ctx := context.Background()
+	resetCommands := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		sent := commands
+		commands = nil
+		return sent
+	}
+
+	// Saved by the server: the file is left alone
+	output, entries, err := applyPlayerListChange(ctx, minecraftWhitelist, gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_ADD, "Alice", execute, files)
+	if err != nil {
+		t.Fatalf("whitelist add: %v", err)
+	}
+	if output != "Added Alice to the whitelist" || len(entries) != 1 || entries[0].Name != "Alice" {
+		t.Fatalf("whitelist add = %q, %+v", output, entries)
+	}
+	if sent := resetCommands(); len(sent) != 1 {
+		t.Fatalf("whitelist add sent %v, want only the add command", sent)
+	}
+
+	// Not saved by the server: the file is updated and the whitelist reloaded
+	_, entries, err = applyPlayerListChange(ctx, minecraftWhitelist, gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_REMOVE, "alice", execute, files)
+	if err != nil {
+		t.Fatalf("whitelist remove: %v", err)
+	}
+	if len(entries) != 0 || len(files.entries(t, minecraftWhitelist.path)) != 0 {
+		t.Fatalf("whitelist after remove = %+v, file = %s", entries, files.files[minecraftWhitelist.path])
+	}
+	if sent := resetCommands(); len(sent) != 2 || sent[0] != "whitelist remove alice" || sent[1] != "whitelist reload" {
+		t.Fatalf("whitelist remove sent %v", sent)
+	}
+
+	_, entries, err = applyPlayerListChange(ctx, minecraftBanList, gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_ADD, "bob", execute, files)
+	if err != nil {
+		t.Fatalf("ban: %v", err)
+	}
+	saved := files.entries(t, minecraftBanList.path)
+	if len(entries) != 1 || len(saved) != 1 || saved[0].UUID != "22222222-2222-2222-2222-222222222222" || saved[0].Name != "Bob" || saved[0].Expires != "forever" || saved[0].Created == "" {
+		t.Fatalf("ban list = %+v, file = %+v", entries, saved)
+	}
+	if sent := resetCommands(); len(sent) != 1 || sent[0] != "ban bob" {
+		t.Fatalf("ban sent %v, want no reload", sent)
+	}
+
+	// Listing reads the file without sending commands
+	_, entries, err = applyPlayerListChange(ctx, minecraftBanList, gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_LIST, "", execute, files)
+	if err != nil || len(entries) != 1 || entries[0].Name != "Bob" {
+		t.Fatalf("ban list = %+v, %v", entries, err)
+	}
+	if sent := resetCommands(); len(sent) != 0 {
+		t.Fatalf("list sent %v", sent)
+	}
+
+	_, _, err = applyPlayerListChange(ctx, minecraftBanList, gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_ADD, "nobody", execute, files)
+	if !errors.Is(err, errMinecraftPlayerNotFound) {
+		t.Fatalf("ban of an unknown player error = %v, want %v", err, errMinecraftPlayerNotFound)
+	}
+	if saved := files.entries(t, minecraftBanList.path); len(saved) != 1 {
+		t.Fatalf("ban list after failed ban = %+v", saved)
+	}
+}
+
+func TestValidatePlayerListRequest(t *testing.T) {
+	tests := []struct {
+		action gameserversv1.PlayerListAction
+		player string
+		valid  bool
+	}{
+		{gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_ADD, "Notch", true},
+		{gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_REMOVE, "player_123", true},
+		{gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_LIST, "", true},
+		{gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_UNSPECIFIED, "Notch", false},
+		{gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_ADD, "", false},
+		{gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_ADD, "bob; op bob", false},
+		{gameserversv1.PlayerListAction_PLAYER_LIST_ACTION_REMOVE, "a_name_that_is_too_long", false},
+	}
+	for _, tt := range tests {
+		err := validatePlayerListRequest(tt.action, tt.player)
+		if tt.valid && err != nil {
+			t.Errorf("validatePlayerListRequest(%v, %q) = %v, want nil", tt.action, tt.player, err)
+		}
+		if !tt.valid && connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("validatePlayerListRequest(%v, %q) code = %v, want %v", tt.action, tt.player, connect.CodeOf(err), connect.CodeInvalidArgument)
+		}
+	}
+}
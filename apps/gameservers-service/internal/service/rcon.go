@@ -0,0 +1,102 @@
+package gameservers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gameserverorchestrator "gameservers-service/internal/orchestrator"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/redis"
+
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+
+	"connectrpc.com/connect"
+)
+
+const (
+	rconRateLimit       = 60 // commands per window per game server
+	rconRateLimitWindow = time.Minute
+	rconMaxCommandBytes = 1446 // Largest body a Source RCON packet carries
+)
+
+// ExecuteGameServerCommand runs a console command on a game server over RCON
+func (s *Service) ExecuteGameServerCommand(ctx context.Context, req *connect.Request[gameserversv1.ExecuteGameServerCommandRequest]) (*connect.Response[gameserversv1.ExecuteGameServerCommandResponse], error) {
+	gameServerID := req.Msg.GetGameServerId()
+	if err := s.checkGameServerPermission(ctx, gameServerID, "update"); err != nil {
+		return nil, err
+	}
+
+	command := strings.TrimSpace(req.Msg.GetCommand())
+	if command == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("command is required"))
+	}
+	if len(command) > rconMaxCommandBytes {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("command must be at most %d bytes", rconMaxCommandBytes))
+	}
+
+	if shouldForward, targetNodeID := s.getGameServerForwardTarget(ctx, gameServerID); shouldForward {
+		reqBody, _ := json.Marshal(req.Msg)
+		headers := map[string]string{"Authorization": req.Header().Get("Authorization")}
+		bodyBytes, err := s.forwardUnaryRequest(ctx, reqBody, targetNodeID, "/obiente.cloud.gameservers.v1.GameServerService/ExecuteGameServerCommand", headers)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to forward request: %w", err))
+		}
+
+		var response gameserversv1.ExecuteGameServerCommandResponse
+		if err := json.Unmarshal(bodyBytes, &response); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode response: %w", err))
+		}
+		return connect.NewResponse(&response), nil
+	}
+
+	allowed, err := allowRCONCommand(ctx, gameServerID)
+	if err != nil {
+		logger.Warn("[ExecuteGameServerCommand] Rate limit check failed for game server %s, allowing command: %v", gameServerID, err)
+	}
+	if !allowed {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("rate limit of %d commands per minute reached", rconRateLimit))
+	}
+
+	manager, err := s.getGameServerManager()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get game server manager: %w", err))
+	}
+
+	output, err := manager.ExecuteRCONCommand(ctx, gameServerID, command)
+	if err != nil {
+		if errors.Is(err, gameserverorchestrator.ErrRCONNotConfigured) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to execute command: %w", err))
+	}
+
+	logger.Info("[ExecuteGameServerCommand] Executed RCON command on game server %s", gameServerID)
+	return connect.NewResponse(&gameserversv1.ExecuteGameServerCommandResponse{Output: output}), nil
+}
+
+// allowRCONCommand counts a command against the game server's per-minute limit
+// Redis keeps the count so the limit holds across nodes; without Redis commands are allowed.
+func allowRCONCommand(ctx context.Context, gameServerID string) (bool, error) {
+	rdb := redis.GetClient()
+	if rdb == nil {
+		return true, nil
+	}
+
+	window := time.Now().Unix() / int64(rconRateLimitWindow/time.Second)
+	key := fmt.Sprintf("gameserver:rcon:ratelimit:%s:%d", gameServerID, window)
+	count, err := rdb.Incr(ctx, key).Result()
+	if err != nil {
+		return true, err
+	}
+	if count == 1 {
+		if err := rdb.Expire(ctx, key, rconRateLimitWindow).Err(); err != nil {
+			return true, err
+		}
+	}
+	return count <= rconRateLimit, nil
+}
@@ -8,6 +8,8 @@ import (
 	"sync"
 	"time"
 
+	"gameservers-service/internal/backupstore"
+	"gameservers-service/internal/catalog/curseforge"
 	"gameservers-service/internal/catalog/modrinth"
 	"gameservers-service/internal/orchestrator"
 
@@ -27,9 +29,15 @@ type Service struct {
 	permissionChecker     *auth.PermissionChecker
 	manager               *orchestrator.GameServerManager // Manager created directly in gameservers-service
 	modClient             *modrinth.Client
+	curseForgeClient      *curseforge.Client
+	backupStore           *backupstore.Store // nil when BACKUP_S3_* is not configured
 	forwarder             *sharedorchestrator.NodeForwarder
 	resourcePressureMu    sync.Mutex
 	resourcePressureState map[string]*resourcePressureState
+	discordWatchMu        sync.Mutex
+	discordWatches        map[string]context.CancelFunc // Game servers whose logs are followed for Discord player events
+	idleShutdownMu        sync.Mutex
+	idleShutdownPolledAt  map[string]time.Time // Last player count poll of each game server
 	backgroundCtx         context.Context
 }
 
@@ -41,13 +49,18 @@ type resourcePressureState struct {
 }
 
 func NewService(backgroundCtx context.Context, repo *database.GameServerRepository, manager *orchestrator.GameServerManager) *Service {
+	backupStore, _ := backupstore.NewStoreFromEnv()
 	return &Service{
 		repo:                  repo,
 		permissionChecker:     auth.NewPermissionChecker(),
 		manager:               manager,
 		modClient:             modrinth.NewClient(nil),
+		curseForgeClient:      curseforge.NewClient(nil),
+		backupStore:           backupStore,
 		forwarder:             sharedorchestrator.NewNodeForwarder(),
 		resourcePressureState: make(map[string]*resourcePressureState),
+		discordWatches:        make(map[string]context.CancelFunc),
+		idleShutdownPolledAt:  make(map[string]time.Time),
 		backgroundCtx:         backgroundCtx,
 	}
 }
@@ -184,6 +184,22 @@ func (s *Service) GetGameServerLogs(ctx context.Context, req *connect.Request[ga
 		return nil, err
 	}
 
+	limit, sinceTime, untilTime, searchQuery := resolveGameServerLogOptions(req.Msg.Limit, req.Msg.Since, req.Msg.Until, req.Msg.SearchQuery, 100)
+
+	// The last lines are served from the Redis log history, which every node writes to. Time ranges,
+	// searches and servers without stored lines fall back to the container's own logs.
+	if sinceTime == nil && untilTime == nil && searchQuery == "" {
+		lines, err := recentGameServerLogLines(ctx, gameServerID, int(limit))
+		if err == nil && len(lines) > 0 {
+			return connect.NewResponse(&gameserversv1.GetGameServerLogsResponse{
+				Lines: lines,
+			}), nil
+		}
+		if err != nil && !errors.Is(err, errGameServerLogStoreUnavailable) {
+			logger.Warn("[GetGameServerLogs] Failed to read log history for %s: %v", gameServerID, err)
+		}
+	}
+
 	if shouldForward, targetNodeID := s.getGameServerForwardTarget(ctx, gameServerID); shouldForward {
 		reqBody, err := json.Marshal(req.Msg)
 		if err != nil {
@@ -211,8 +227,6 @@ func (s *Service) GetGameServerLogs(ctx context.Context, req *connect.Request[ga
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get game server manager: %w", err))
 	}
 
-	limit, sinceTime, untilTime, searchQuery := resolveGameServerLogOptions(req.Msg.Limit, req.Msg.Since, req.Msg.Until, req.Msg.SearchQuery, 100)
-
 	collector := &gameServerLogCollector{}
 	if err := s.streamHistoricalLogs(ctx, manager, gameServerID, collector, limit, sinceTime, untilTime, searchQuery); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get game server logs: %w", err))
@@ -296,6 +310,9 @@ func (s *Service) StreamGameServerLogs(ctx context.Context, req *connect.Request
 	}
 	defer logsReader.Close()
 
+	// Keep the Redis log history filled while someone is watching
+	go s.followGameServerLogs(ctx, gameServerID)
+
 	// Stream live logs
 	return s.streamLiveLogs(ctx, logsReader, gameServerLogStreamSender{stream: stream}, searchQuery)
 }
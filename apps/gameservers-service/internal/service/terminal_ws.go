@@ -3,6 +3,7 @@ package gameservers
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -256,15 +257,43 @@ func (s *Service) HandleTerminalWebSocket(w http.ResponseWriter, r *http.Request
 	outputCtx, outputCancel := context.WithCancel(ctx)
 	outputDone := make(chan struct{})
 
+	// Replay recent output and follow new log lines through the Redis log history. Without Redis,
+	// the Docker log readers below stream container logs directly.
+	historyCtx, historyCancel := context.WithCancel(ctx)
+	defer historyCancel()
+	historyStreaming := false
+	if live, unsubscribe, err := gameServerLogs.Subscribe(historyCtx, initMsg.GameServerID); err == nil {
+		historyStreaming = true
+		go s.followGameServerLogs(historyCtx, initMsg.GameServerID)
+		go func() {
+			defer unsubscribe()
+			err := forwardGameServerLogHistory(historyCtx, initMsg.GameServerID, live, gameServerLogReplayLines(), func(line string) error {
+				lineBytes := []byte(line + "\r\n")
+				data := make([]int, len(lineBytes))
+				for i, b := range lineBytes {
+					data[i] = int(b)
+				}
+				return writeJSON(gameServerTerminalWSOutput{Type: "output", Data: data})
+			})
+			if err != nil && historyCtx.Err() == nil {
+				log.Printf("[GameServer Terminal WS] Log history stream for %s ended: %v", initMsg.GameServerID, err)
+			}
+		}()
+	} else if !errors.Is(err, errGameServerLogStoreUnavailable) {
+		log.Printf("[GameServer Terminal WS] Failed to subscribe to logs for %s: %v", initMsg.GameServerID, err)
+	}
+
 	// Check game server status and handle accordingly
 	currentSession = getSession()
 	if currentSession == nil {
 		// Status 2 = STARTING, 3 = RUNNING, 5 = STOPPED
 		if gameServer.Status == 2 { // STARTING
-			// If container exists, stream logs during startup
+			// If container exists, stream logs during startup. With the log history stream, startup
+			// output already arrives through it.
 			if gameServer.ContainerID != nil {
-				// Stream container logs during startup
-				go s.streamStartingLogs(ctx, initMsg.GameServerID, *gameServer.ContainerID, writeJSON, getSession, setSession, outputCancel)
+				if !historyStreaming {
+					go s.streamStartingLogs(ctx, initMsg.GameServerID, *gameServer.ContainerID, writeJSON, getSession, setSession, outputCancel)
+				}
 			} else {
 				// Container not created yet, show starting message
 				startingMsg := "Starting game server...\r\n"
@@ -274,7 +303,9 @@ func (s *Service) HandleTerminalWebSocket(w http.ResponseWriter, r *http.Request
 				}
 				_ = writeJSON(gameServerTerminalWSOutput{Type: "output", Data: data})
 				// Poll for container creation and then stream logs
-				go s.pollForContainerAndStreamLogs(ctx, initMsg.GameServerID, writeJSON, getSession, setSession, outputCancel)
+				if !historyStreaming {
+					go s.pollForContainerAndStreamLogs(ctx, initMsg.GameServerID, writeJSON, getSession, setSession, outputCancel)
+				}
 			}
 		} else {
 			// Container is stopped
@@ -364,101 +395,103 @@ func (s *Service) HandleTerminalWebSocket(w http.ResponseWriter, r *http.Request
 
 		// Also start a log reader as fallback (some game servers don't output to attach stream)
 		// Docker logs API reads from the logging driver and may be more reliable than attach
-		outputDoneWg.Add(1)
-		go func() {
-			defer outputDoneWg.Done()
-			// Recover from any panics to prevent crashing the API
-			defer func() {
-				if r := recover(); r != nil {
-					log.Printf("[GameServer Terminal WS] Panic in logs reader goroutine: %v", r)
-					_ = writeJSON(gameServerTerminalWSOutput{Type: "error", Message: "Log stream error (panic recovered)"})
-				}
-			}()
-
-			dcli, err := docker.New()
-			if err != nil {
-				log.Printf("[GameServer Terminal WS] Failed to create docker client for logs: %v", err)
-				return
-			}
-			defer dcli.Close()
-
-			// Get the current session to access containerID
-			currentSession := getSession()
-			if currentSession == nil {
-				log.Printf("[GameServer Terminal WS] No session available for logs reader")
-				return
-			}
-			containerID := currentSession.containerID
+		if !historyStreaming {
+			outputDoneWg.Add(1)
+			go func() {
+				defer outputDoneWg.Done()
+				// Recover from any panics to prevent crashing the API
+				defer func() {
+					if r := recover(); r != nil {
+						log.Printf("[GameServer Terminal WS] Panic in logs reader goroutine: %v", r)
+						_ = writeJSON(gameServerTerminalWSOutput{Type: "error", Message: "Log stream error (panic recovered)"})
+					}
+				}()
 
-			// Read logs with follow=true to stream new output
-			// Use tail=0 to get all logs, or a small number to get recent logs
-			logsReader, err := dcli.ContainerLogs(ctx, containerID, "0", true, nil, nil)
-			if err != nil {
-				log.Printf("[GameServer Terminal WS] Failed to start log stream: %v", err)
-				return
-			}
-			defer logsReader.Close()
+				dcli, err := docker.New()
+				if err != nil {
+					log.Printf("[GameServer Terminal WS] Failed to create docker client for logs: %v", err)
+					return
+				}
+				defer dcli.Close()
 
-			// Docker logs API returns frames with 8-byte headers for non-TTY containers
-			// Read frame by frame and strip headers
-			header := make([]byte, 8)
-			frameBuf := make([]byte, 32*1024)
+				// Get the current session to access containerID
+				currentSession := getSession()
+				if currentSession == nil {
+					log.Printf("[GameServer Terminal WS] No session available for logs reader")
+					return
+				}
+				containerID := currentSession.containerID
 
-			for {
-				select {
-				case <-outputCtx.Done():
+				// Read logs with follow=true to stream new output
+				// Use tail=0 to get all logs, or a small number to get recent logs
+				logsReader, err := dcli.ContainerLogs(ctx, containerID, "0", true, nil, nil)
+				if err != nil {
+					log.Printf("[GameServer Terminal WS] Failed to start log stream: %v", err)
 					return
-				default:
 				}
+				defer logsReader.Close()
 
-				// Read header
-				if _, err := io.ReadFull(logsReader, header); err != nil {
-					if err == io.EOF || err == io.ErrUnexpectedEOF {
+				// Docker logs API returns frames with 8-byte headers for non-TTY containers
+				// Read frame by frame and strip headers
+				header := make([]byte, 8)
+				frameBuf := make([]byte, 32*1024)
+
+				for {
+					select {
+					case <-outputCtx.Done():
 						return
+					default:
 					}
-					log.Printf("[GameServer Terminal WS] Log stream header read error: %v", err)
-					return
-				}
 
-				// Parse payload length (bytes 4-7, big-endian)
-				payloadLength := int(uint32(header[4])<<24 | uint32(header[5])<<16 | uint32(header[6])<<8 | uint32(header[7]))
-				if payloadLength == 0 {
-					continue // Empty frame, read next
-				}
+					// Read header
+					if _, err := io.ReadFull(logsReader, header); err != nil {
+						if err == io.EOF || err == io.ErrUnexpectedEOF {
+							return
+						}
+						log.Printf("[GameServer Terminal WS] Log stream header read error: %v", err)
+						return
+					}
 
-				// Read payload
-				if payloadLength > len(frameBuf) {
-					frameBuf = make([]byte, payloadLength)
-				}
+					// Parse payload length (bytes 4-7, big-endian)
+					payloadLength := int(uint32(header[4])<<24 | uint32(header[5])<<16 | uint32(header[6])<<8 | uint32(header[7]))
+					if payloadLength == 0 {
+						continue // Empty frame, read next
+					}
 
-				n, err := io.ReadFull(logsReader, frameBuf[:payloadLength])
-				if err != nil {
-					if err == io.EOF || err == io.ErrUnexpectedEOF {
-						// Partial frame, send what we have
-						if n > 0 {
-							data := make([]int, n)
-							for i := 0; i < n; i++ {
-								data[i] = int(frameBuf[i])
+					// Read payload
+					if payloadLength > len(frameBuf) {
+						frameBuf = make([]byte, payloadLength)
+					}
+
+					n, err := io.ReadFull(logsReader, frameBuf[:payloadLength])
+					if err != nil {
+						if err == io.EOF || err == io.ErrUnexpectedEOF {
+							// Partial frame, send what we have
+							if n > 0 {
+								data := make([]int, n)
+								for i := 0; i < n; i++ {
+									data[i] = int(frameBuf[i])
+								}
+								log.Printf("[GameServer Terminal WS] Forwarding %d bytes from logs stream (partial)", n)
+								_ = writeJSON(gameServerTerminalWSOutput{Type: "output", Data: data})
 							}
-							log.Printf("[GameServer Terminal WS] Forwarding %d bytes from logs stream (partial)", n)
-							_ = writeJSON(gameServerTerminalWSOutput{Type: "output", Data: data})
+							return
 						}
+						log.Printf("[GameServer Terminal WS] Log stream payload read error: %v", err)
 						return
 					}
-					log.Printf("[GameServer Terminal WS] Log stream payload read error: %v", err)
-					return
-				}
 
-				if n > 0 {
-					data := make([]int, n)
-					for i := 0; i < n; i++ {
-						data[i] = int(frameBuf[i])
+					if n > 0 {
+						data := make([]int, n)
+						for i := 0; i < n; i++ {
+							data[i] = int(frameBuf[i])
+						}
+						log.Printf("[GameServer Terminal WS] Forwarding %d bytes from logs stream", n)
+						_ = writeJSON(gameServerTerminalWSOutput{Type: "output", Data: data})
 					}
-					log.Printf("[GameServer Terminal WS] Forwarding %d bytes from logs stream", n)
-					_ = writeJSON(gameServerTerminalWSOutput{Type: "output", Data: data})
 				}
-			}
-		}()
+			}()
+		}
 	} else {
 		// No session - just close the channel
 		close(outputDone)
@@ -2,6 +2,7 @@ package gameservers
 
 import (
 	"archive/tar"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -83,54 +84,7 @@ func (s *Service) HandleUploadFile(w http.ResponseWriter, r *http.Request) {
 	done := make(chan error, 1)
 	go func() {
 		defer pr.Close()
-		// Create docker client
-		dcli, derr := docker.New()
-		if derr != nil {
-			done <- fmt.Errorf("docker client: %w", derr)
-			return
-		}
-		defer dcli.Close()
-
-		// Find container
-		containerID, cerr := s.findContainerForGameServer(ctx, gameServerId, dcli)
-		if cerr != nil {
-			done <- cerr
-			return
-		}
-
-		if volumeName != "" {
-			// Find host path for volume
-			volumes, err := dcli.GetContainerVolumes(ctx, containerID)
-			if err != nil {
-				done <- err
-				return
-			}
-			var target string
-			for _, v := range volumes {
-				if v.Name == volumeName {
-					target = v.Source
-					break
-				}
-			}
-			if target == "" {
-				done <- fmt.Errorf("volume not found: %s", volumeName)
-				return
-			}
-
-			// Stream-extract tar directly into volume
-			if err := dcli.UploadVolumeFromTar(target, pr); err != nil {
-				done <- err
-				return
-			}
-		} else {
-			// Upload tar to container path using streaming CopyToContainer
-			if err := dcli.ContainerUploadFromTar(ctx, containerID, destPath, pr); err != nil {
-				done <- err
-				return
-			}
-		}
-
-		done <- nil
+		done <- s.copyTarToGameServer(ctx, gameServerId, volumeName, destPath, pr)
 	}()
 
 	// Now read multipart parts and write tar headers + file contents
@@ -222,3 +176,50 @@ func (s *Service) HandleUploadFile(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write([]byte(`{"success":true}`))
 }
+
+// copyTarToGameServer extracts a tar stream into a game server's container at destPath,
+// or into one of its volumes when volumeName is set.
+func (s *Service) copyTarToGameServer(ctx context.Context, gameServerID, volumeName, destPath string, tarStream io.Reader) error {
+	// Create docker client
+	dcli, err := docker.New()
+	if err != nil {
+		return fmt.Errorf("docker client: %w", err)
+	}
+	defer dcli.Close()
+
+	// Find container
+	containerID, err := s.findContainerForGameServer(ctx, gameServerID, dcli)
+	if err != nil {
+		return err
+	}
+
+	if volumeName != "" {
+		// Find host path for volume
+		volumes, err := dcli.GetContainerVolumes(ctx, containerID)
+		if err != nil {
+			return err
+		}
+		var target string
+		for _, v := range volumes {
+			if v.Name == volumeName {
+				target = v.Source
+				break
+			}
+		}
+		if target == "" {
+			return fmt.Errorf("volume not found: %s", volumeName)
+		}
+
+		// Stream-extract tar directly into volume
+		if err := dcli.UploadVolumeFromTar(target, tarStream); err != nil {
+			return err
+		}
+	} else {
+		// Upload tar to container path using streaming CopyToContainer
+		if err := dcli.ContainerUploadFromTar(ctx, containerID, destPath, tarStream); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
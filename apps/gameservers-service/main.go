@@ -50,7 +50,11 @@ func main() {
 
 	database.RegisterModels(
 		&database.GameServer{},
+		&database.GameServerMod{},
+		&database.GameServerBackup{},
 		&database.FileTransferCredential{},
+		&database.DiscordIntegration{},
+		&database.GameServerSettings{},
 	)
 
 	// Initialize database
@@ -106,6 +110,12 @@ func main() {
 	// Create audit interceptor
 	auditInterceptor := middleware.AuditLogInterceptor()
 
+	// Create maintenance mode interceptor (rejects mutating RPCs during platform maintenance)
+	maintenanceInterceptor := middleware.MaintenanceModeInterceptor()
+
+	// Reject requests for suspended organizations
+	suspensionInterceptor := auth.OrgSuspensionInterceptor()
+
 	// Initialize game server manager
 	manager, err := gameserverorchestrator.NewGameServerManager("least-loaded", 50)
 	if err != nil {
@@ -124,7 +134,7 @@ func main() {
 	// Register game servers service
 	gameServersPath, gameServersHandler := gameserversv1connect.NewGameServerServiceHandler(
 		gameServerService,
-		connect.WithInterceptors(auditInterceptor, authInterceptor),
+		connect.WithInterceptors(auditInterceptor, authInterceptor, maintenanceInterceptor, suspensionInterceptor),
 	)
 	mux.Handle(gameServersPath, gameServersHandler)
 
@@ -179,6 +189,16 @@ func main() {
 		gameServerService.StartHealthMonitor(healthMonitorCtx, 30*time.Second)
 	}()
 
+	// Start the metrics collector; samples TPS of Minecraft servers every 30 seconds
+	go func() {
+		gameserversvc.NewMetricsCollector(gameServerService).Start(healthMonitorCtx, 30*time.Second)
+	}()
+
+	// Start the backup scheduler; each tick backs up servers whose backup_schedule interval has elapsed
+	go func() {
+		gameServerService.StartBackupScheduler(healthMonitorCtx, 5*time.Minute)
+	}()
+
 	// Start server in a goroutine
 	serverErr := make(chan error, 1)
 	go func() {
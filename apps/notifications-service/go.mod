@@ -6,9 +6,11 @@ require (
 	connectrpc.com/connect v1.19.1
 	github.com/joho/godotenv v1.5.1
 	github.com/obiente/cloud/apps/shared v0.0.0
+	github.com/redis/go-redis/v9 v9.16.0
 	golang.org/x/net v0.47.0
 	google.golang.org/protobuf v1.36.10
 	gorm.io/gorm v1.31.0
+	nhooyr.io/websocket v1.8.17
 )
 
 require (
@@ -37,7 +39,6 @@ require (
 	github.com/opencontainers/go-digest v1.0.0 // indirect
 	github.com/opencontainers/image-spec v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
-	github.com/redis/go-redis/v9 v9.16.0 // indirect
 	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
 	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.60.0 // indirect
 	go.opentelemetry.io/otel v1.38.0 // indirect
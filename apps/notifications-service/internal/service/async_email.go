@@ -17,6 +17,7 @@ const (
 )
 
 type asyncEmailTask struct {
+	channels    deliveryChannels
 	userID      string
 	notifType   notificationsv1.NotificationType
 	severity    notificationsv1.NotificationSeverity
@@ -70,7 +71,8 @@ func ShutdownAsyncEmailDispatcher(ctx context.Context) {
 	}
 }
 
-func enqueueNotificationEmail(userID string, notifType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity, title, message string, actionURL, actionLabel *string) {
+// enqueueNotificationDelivery queues email and SMS delivery of a notification on the given channels
+func enqueueNotificationDelivery(channels deliveryChannels, userID string, notifType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity, title, message string, actionURL, actionLabel *string) {
 	InitAsyncEmailDispatcher(context.Background())
 
 	if asyncEmailCtx != nil {
@@ -83,6 +85,7 @@ func enqueueNotificationEmail(userID string, notifType notificationsv1.Notificat
 	}
 
 	task := asyncEmailTask{
+		channels:    channels,
 		userID:      userID,
 		notifType:   notifType,
 		severity:    severity,
@@ -108,9 +111,15 @@ func runAsyncEmailWorker() {
 			return
 		case task := <-asyncEmailQueue:
 			taskCtx, cancel := context.WithTimeout(asyncEmailCtx, asyncEmailTimeout)
-			logger.Info("[Notifications] Starting email check for user %s, type %s", task.userID, notificationTypeToString(task.notifType))
-			if err := sendNotificationEmailIfEnabled(taskCtx, task.userID, task.notifType, task.severity, task.title, task.message, task.actionURL, task.actionLabel); err != nil {
-				logger.Warn("[Notifications] Failed to send email notification for user %s: %v", task.userID, err)
+			if task.channels.Email {
+				if err := sendNotificationEmail(taskCtx, task.userID, task.notifType, task.title, task.message, task.actionURL, task.actionLabel); err != nil {
+					logger.Warn("[Notifications] Failed to send email notification for user %s: %v", task.userID, err)
+				}
+			}
+			if task.channels.SMS {
+				if err := sendNotificationSMS(taskCtx, task.userID, task.title, task.message); err != nil {
+					logger.Warn("[Notifications] Failed to send SMS notification for user %s: %v", task.userID, err)
+				}
 			}
 			cancel()
 		}
@@ -0,0 +1,128 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+
+	"gorm.io/gorm"
+)
+
+// severityRank orders severities for comparison against a preference's minimum severity
+var severityRank = map[string]int{
+	"LOW":      1,
+	"MEDIUM":   2,
+	"HIGH":     3,
+	"CRITICAL": 4,
+}
+
+// deliveryChannels are the channels a notification is delivered on
+type deliveryChannels struct {
+	InApp bool
+	Email bool
+	SMS   bool
+}
+
+func (c deliveryChannels) external() bool {
+	return c.Email || c.SMS
+}
+
+// loadNotificationPreference returns the user's preference for a notification type, or nil if
+// the user has not set one
+func loadNotificationPreference(ctx context.Context, userID string, notificationType notificationsv1.NotificationType) (*database.NotificationPreference, error) {
+	var preference database.NotificationPreference
+	err := database.DB.WithContext(ctx).
+		Where("user_id = ? AND notification_type = ?", userID, notificationTypeToString(notificationType)).
+		First(&preference).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get preference: %w", err)
+	}
+	return &preference, nil
+}
+
+// resolveDeliveryChannels decides which channels a notification goes to. A nil preference uses
+// the type's defaults from GetNotificationTypes. Email and SMS respect the frequency and minimum
+// severity; SMS is further limited to CRITICAL notifications.
+func resolveDeliveryChannels(preference *database.NotificationPreference, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity) deliveryChannels {
+	channels := deliveryChannels{InApp: true}
+	minSeverity := getDefaultMinSeverityForType(notificationType)
+	frequency := "immediate"
+
+	if preference == nil {
+		switch notificationType {
+		case notificationsv1.NotificationType_NOTIFICATION_TYPE_WARNING,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_ERROR,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_DEPLOYMENT,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_BILLING,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_QUOTA,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_INVITE,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM:
+			channels.Email = true
+		}
+	} else {
+		channels.InApp = preference.InAppEnabled
+		channels.Email = preference.EmailEnabled
+		channels.SMS = preference.SMSEnabled
+		minSeverity = preference.MinSeverity
+		frequency = preference.Frequency
+	}
+
+	if frequency == "never" || severityRank[notificationSeverityToString(severity)] < severityRank[minSeverity] {
+		channels.Email = false
+		channels.SMS = false
+	}
+	if severity != notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL {
+		channels.SMS = false
+	}
+	return channels
+}
+
+// deliverNotification delivers a notification on the user's channels, or holds it for the next
+// digest if the organization's digest policy groups it. It reports whether the notification was
+// stored.
+func deliverNotification(ctx context.Context, notification *database.Notification, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity) (bool, error) {
+	if notification.OrganizationID != nil && digestAggregator != nil {
+		policy, err := loadDigestPolicy(ctx, *notification.OrganizationID)
+		if err != nil {
+			logger.Warn("[Notifications] Error checking digest policy for organization %s: %v", *notification.OrganizationID, err)
+		} else if policy.Groups(notificationType, severity) {
+			digestAggregator.Add(notification, notificationType, severity)
+			return false, nil
+		}
+	}
+	return deliverImmediately(ctx, notification, notificationType, severity)
+}
+
+// deliverImmediately stores the notification and pushes it to connected clients if the user
+// receives this type in-app, and queues email and SMS delivery for the enabled channels.
+// It reports whether the notification was stored.
+func deliverImmediately(ctx context.Context, notification *database.Notification, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity) (bool, error) {
+	preference, err := loadNotificationPreference(ctx, notification.UserID, notificationType)
+	if err != nil {
+		// Fall back to the defaults rather than dropping the notification
+		logger.Warn("[Notifications] Error checking preferences for user %s, type %s: %v", notification.UserID, notification.Type, err)
+	}
+	channels := resolveDeliveryChannels(preference, notificationType, severity)
+
+	if channels.InApp {
+		if err := database.DB.WithContext(ctx).Create(notification).Error; err != nil {
+			return false, fmt.Errorf("create notification: %w", err)
+		}
+		publishNotification(ctx, notification)
+	} else {
+		logger.Debug("[Notifications] In-app delivery disabled for user %s, type %s", notification.UserID, notification.Type)
+	}
+
+	if channels.external() {
+		enqueueNotificationDelivery(channels, notification.UserID, notificationType, severity, notification.Title, notification.Message, notification.ActionURL, notification.ActionLabel)
+	}
+	return channels.InApp, nil
+}
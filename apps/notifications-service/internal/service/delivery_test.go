@@ -0,0 +1,141 @@
+package notifications
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+)
+
+func TestResolveDeliveryChannels(t *testing.T) {
+	critical := notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL
+	high := notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH
+	low := notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_LOW
+	billing := notificationsv1.NotificationType_NOTIFICATION_TYPE_BILLING
+	info := notificationsv1.NotificationType_NOTIFICATION_TYPE_INFO
+
+	allEnabled := &database.NotificationPreference{
+		InAppEnabled: true,
+		EmailEnabled: true,
+		SMSEnabled:   true,
+		Frequency:    "immediate",
+		MinSeverity:  "LOW",
+	}
+
+	tests := []struct {
+		name       string
+		preference *database.NotificationPreference
+		typ        notificationsv1.NotificationType
+		severity   notificationsv1.NotificationSeverity
+		want       deliveryChannels
+	}{
+		{"defaults email billing", nil, billing, high, deliveryChannels{InApp: true, Email: true}},
+		{"defaults skip email for info", nil, info, high, deliveryChannels{InApp: true}},
+		{"defaults never send sms", nil, billing, critical, deliveryChannels{InApp: true, Email: true}},
+		{"all channels for critical", allEnabled, billing, critical, deliveryChannels{InApp: true, Email: true, SMS: true}},
+		{"sms only for critical", allEnabled, billing, high, deliveryChannels{InApp: true, Email: true}},
+		{
+			"in-app disabled",
+			&database.NotificationPreference{InAppEnabled: false, EmailEnabled: true, Frequency: "immediate", MinSeverity: "LOW"},
+			billing, high,
+			deliveryChannels{Email: true},
+		},
+		{
+			"email and sms disabled",
+			&database.NotificationPreference{InAppEnabled: true, Frequency: "immediate", MinSeverity: "LOW"},
+			billing, critical,
+			deliveryChannels{InApp: true},
+		},
+		{
+			"below minimum severity",
+			&database.NotificationPreference{InAppEnabled: true, EmailEnabled: true, SMSEnabled: true, Frequency: "immediate", MinSeverity: "HIGH"},
+			billing, low,
+			deliveryChannels{InApp: true},
+		},
+		{
+			"frequency never",
+			&database.NotificationPreference{InAppEnabled: true, EmailEnabled: true, SMSEnabled: true, Frequency: "never", MinSeverity: "LOW"},
+			billing, critical,
+			deliveryChannels{InApp: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveDeliveryChannels(tt.preference, tt.typ, tt.severity); got != tt.want {
+				t.Fatalf("resolveDeliveryChannels() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateNotificationForUserSkipsDisabledInApp(t *testing.T) {
+	db := newNotificationServiceTestDB(t)
+
+	if err := db.Create(&database.NotificationPreference{
+		ID:               "pref-in-app-off",
+		UserID:           "user-in-app-off",
+		NotificationType: "INFO",
+		InAppEnabled:     false,
+		Frequency:        "immediate",
+		MinSeverity:      "LOW",
+	}).Error; err != nil {
+		t.Fatalf("seed preference: %v", err)
+	}
+	// gorm skips false for fields with a default on create
+	if err := db.Model(&database.NotificationPreference{}).Where("id = ?", "pref-in-app-off").Update("in_app_enabled", false).Error; err != nil {
+		t.Fatalf("disable in-app: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, userID := range []string{"user-in-app-off", "user-in-app-default"} {
+		if err := CreateNotificationForUser(ctx, userID, nil,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_INFO,
+			notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_LOW,
+			"Heads up", "Something happened", nil, nil, nil,
+		); err != nil {
+			t.Fatalf("create notification for %s: %v", userID, err)
+		}
+	}
+
+	var disabledCount, defaultCount int64
+	db.Model(&database.Notification{}).Where("user_id = ?", "user-in-app-off").Count(&disabledCount)
+	db.Model(&database.Notification{}).Where("user_id = ?", "user-in-app-default").Count(&defaultCount)
+	if disabledCount != 0 {
+		t.Fatalf("stored %d notifications for user with in-app disabled, want 0", disabledCount)
+	}
+	if defaultCount != 1 {
+		t.Fatalf("stored %d notifications for user with default preferences, want 1", defaultCount)
+	}
+}
+
+func TestTwilioSenderSend(t *testing.T) {
+	var gotPath, gotTo, gotFrom, gotBody, gotUser string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotUser, _, _ = r.BasicAuth()
+		_ = r.ParseForm()
+		gotTo, gotFrom, gotBody = r.PostForm.Get("To"), r.PostForm.Get("From"), r.PostForm.Get("Body")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	sender := &twilioSender{
+		accountSID: "AC123",
+		authToken:  "secret",
+		fromNumber: "+15550000000",
+		baseURL:    server.URL,
+		client:     server.Client(),
+	}
+	if err := sender.Send(context.Background(), "+15551234567", "Alert: disk full"); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if gotPath != "/2010-04-01/Accounts/AC123/Messages.json" || gotUser != "AC123" {
+		t.Fatalf("unexpected request path %q or account %q", gotPath, gotUser)
+	}
+	if gotTo != "+15551234567" || gotFrom != "+15550000000" || gotBody != "Alert: disk full" {
+		t.Fatalf("unexpected form To=%q From=%q Body=%q", gotTo, gotFrom, gotBody)
+	}
+}
@@ -0,0 +1,432 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/email"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/platform"
+
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// digestWindow is how long notifications of the same type are collected before a digest is sent
+	digestWindow = 10 * time.Minute
+
+	// digestMaxEvents caps the events kept per digest; the digest count still covers all of them
+	digestMaxEvents = 50
+
+	digestFlushTimeout = 2 * time.Minute
+)
+
+// DigestPolicy is an organization's choice of which notifications to group into digests
+type DigestPolicy struct {
+	Enabled           bool
+	NotificationTypes []notificationsv1.NotificationType // Empty groups every type
+}
+
+// Groups reports whether a notification is held for a digest. CRITICAL notifications never are.
+func (p DigestPolicy) Groups(notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity) bool {
+	if !p.Enabled || severity == notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL {
+		return false
+	}
+	return len(p.NotificationTypes) == 0 || slices.Contains(p.NotificationTypes, notificationType)
+}
+
+// loadDigestPolicy returns the organization's digest policy; organizations without one get a disabled policy
+func loadDigestPolicy(ctx context.Context, orgID string) (DigestPolicy, error) {
+	var record database.NotificationDigestPolicy
+	err := database.DB.WithContext(ctx).Where("organization_id = ?", orgID).First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return DigestPolicy{}, nil
+	}
+	if err != nil {
+		return DigestPolicy{}, fmt.Errorf("get digest policy: %w", err)
+	}
+	return digestPolicyFromRecord(&record), nil
+}
+
+func digestPolicyFromRecord(record *database.NotificationDigestPolicy) DigestPolicy {
+	policy := DigestPolicy{Enabled: record.Enabled}
+	var types []string
+	if record.NotificationTypes != "" {
+		if err := json.Unmarshal([]byte(record.NotificationTypes), &types); err != nil {
+			logger.Warn("[Notifications] Invalid digest notification types for organization %s: %v", record.OrganizationID, err)
+		}
+	}
+	for _, t := range types {
+		policy.NotificationTypes = append(policy.NotificationTypes, stringToNotificationType(t))
+	}
+	return policy
+}
+
+// GetNotificationDigestPolicy returns an organization's notification digest policy
+func (s *Service) GetNotificationDigestPolicy(ctx context.Context, req *connect.Request[notificationsv1.GetNotificationDigestPolicyRequest]) (*connect.Response[notificationsv1.GetNotificationDigestPolicyResponse], error) {
+	orgID := req.Msg.GetOrganizationId()
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := auth.CheckScopedPermissionWithError(ctx, s.permissionChecker, orgID, auth.ScopedPermission{
+		Permission:   auth.PermissionOrganizationRead,
+		ResourceType: "organization",
+		ResourceID:   orgID,
+	}); err != nil {
+		return nil, err
+	}
+
+	var record database.NotificationDigestPolicy
+	err := database.DB.WithContext(ctx).Where("organization_id = ?", orgID).First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		record = database.NotificationDigestPolicy{OrganizationID: orgID}
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get digest policy: %w", err))
+	}
+
+	return connect.NewResponse(&notificationsv1.GetNotificationDigestPolicyResponse{
+		Policy: digestPolicyToProto(&record),
+	}), nil
+}
+
+// SetNotificationDigestPolicy creates or replaces an organization's notification digest policy
+func (s *Service) SetNotificationDigestPolicy(ctx context.Context, req *connect.Request[notificationsv1.SetNotificationDigestPolicyRequest]) (*connect.Response[notificationsv1.SetNotificationDigestPolicyResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	orgID := req.Msg.GetOrganizationId()
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := auth.CheckScopedPermissionWithError(ctx, s.permissionChecker, orgID, auth.ScopedPermission{
+		Permission:   auth.PermissionOrganizationUpdate,
+		ResourceType: "organization",
+		ResourceID:   orgID,
+	}); err != nil {
+		return nil, err
+	}
+
+	types := make([]string, 0, len(req.Msg.GetNotificationTypes()))
+	for _, t := range req.Msg.GetNotificationTypes() {
+		if t == notificationsv1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("notification_types must not contain NOTIFICATION_TYPE_UNSPECIFIED"))
+		}
+		if typeStr := notificationTypeToString(t); !slices.Contains(types, typeStr) {
+			types = append(types, typeStr)
+		}
+	}
+	typesJSON, err := json.Marshal(types)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("marshal notification types: %w", err))
+	}
+
+	now := time.Now()
+	record := &database.NotificationDigestPolicy{
+		OrganizationID:    orgID,
+		Enabled:           req.Msg.GetEnabled(),
+		NotificationTypes: string(typesJSON),
+		UpdatedBy:         user.Id,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	if err := database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "notification_types", "updated_by", "updated_at"}),
+	}).Create(record).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("save digest policy: %w", err))
+	}
+
+	logger.Info("[Notifications] User %s set digest policy for organization %s (enabled=%t, types=%v)", user.Id, orgID, record.Enabled, types)
+
+	return connect.NewResponse(&notificationsv1.SetNotificationDigestPolicyResponse{
+		Policy: digestPolicyToProto(record),
+	}), nil
+}
+
+func digestPolicyToProto(record *database.NotificationDigestPolicy) *notificationsv1.NotificationDigestPolicy {
+	policy := digestPolicyFromRecord(record)
+	proto := &notificationsv1.NotificationDigestPolicy{
+		OrganizationId:    record.OrganizationID,
+		Enabled:           policy.Enabled,
+		NotificationTypes: policy.NotificationTypes,
+		WindowMinutes:     int32(digestWindow / time.Minute),
+	}
+	if !record.UpdatedAt.IsZero() {
+		proto.UpdatedAt = timestamppb.New(record.UpdatedAt)
+	}
+	return proto
+}
+
+// digestEmailSender emails a user one digest listing its grouped notifications
+type digestEmailSender func(ctx context.Context, userID string, notificationType notificationsv1.NotificationType, title string, events []*database.Notification) error
+
+type digestKey struct {
+	userID           string
+	organizationID   string
+	notificationType notificationsv1.NotificationType
+}
+
+type digestGroup struct {
+	events   []*database.Notification // Oldest first, at most digestMaxEvents
+	count    int
+	severity notificationsv1.NotificationSeverity // Highest severity in the group
+}
+
+// DigestAggregator holds notifications grouped by a digest policy and delivers each
+// user/organization/type group as a single digest notification and email when it flushes.
+// Held notifications are kept in memory and flushed on shutdown.
+type DigestAggregator struct {
+	interval  time.Duration
+	sendEmail digestEmailSender
+
+	mu     sync.Mutex
+	groups map[digestKey]*digestGroup
+}
+
+// NewDigestAggregator creates an aggregator that flushes every interval and sends digest
+// emails with sendEmail
+func NewDigestAggregator(interval time.Duration, sendEmail digestEmailSender) *DigestAggregator {
+	return &DigestAggregator{
+		interval:  interval,
+		sendEmail: sendEmail,
+		groups:    make(map[digestKey]*digestGroup),
+	}
+}
+
+var (
+	digestAggregatorInitOnce sync.Once
+	digestAggregator         *DigestAggregator
+	digestAggregatorCancel   context.CancelFunc
+	digestAggregatorDone     chan struct{}
+)
+
+// InitDigestAggregator starts the background digest aggregator
+func InitDigestAggregator(parent context.Context) {
+	digestAggregatorInitOnce.Do(func() {
+		if parent == nil {
+			parent = context.Background()
+		}
+
+		var ctx context.Context
+		ctx, digestAggregatorCancel = context.WithCancel(parent)
+		digestAggregatorDone = make(chan struct{})
+		digestAggregator = NewDigestAggregator(digestWindow, sendNotificationDigestEmail)
+
+		go func() {
+			defer close(digestAggregatorDone)
+			digestAggregator.Run(ctx)
+		}()
+	})
+}
+
+// ShutdownDigestAggregator stops the aggregator after it delivers the digests it is holding
+func ShutdownDigestAggregator(ctx context.Context) {
+	if digestAggregatorCancel == nil {
+		return
+	}
+
+	digestAggregatorCancel()
+
+	select {
+	case <-digestAggregatorDone:
+	case <-ctx.Done():
+		logger.Warn("[Notifications] Timed out waiting for pending digests to be delivered")
+	}
+}
+
+// Run flushes digests every interval until ctx is cancelled, then flushes once more
+func (a *DigestAggregator) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), digestFlushTimeout)
+			a.Flush(flushCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			flushCtx, cancel := context.WithTimeout(ctx, digestFlushTimeout)
+			a.Flush(flushCtx)
+			cancel()
+		}
+	}
+}
+
+// Add holds a notification for the next digest of its user, organization and type
+func (a *DigestAggregator) Add(notification *database.Notification, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity) {
+	key := digestKey{userID: notification.UserID, notificationType: notificationType}
+	if notification.OrganizationID != nil {
+		key.organizationID = *notification.OrganizationID
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	group, ok := a.groups[key]
+	if !ok {
+		group = &digestGroup{severity: severity}
+		a.groups[key] = group
+	}
+	if len(group.events) == digestMaxEvents {
+		group.events = group.events[1:]
+	}
+	group.events = append(group.events, notification)
+	group.count++
+	if severityRank[notificationSeverityToString(severity)] > severityRank[notificationSeverityToString(group.severity)] {
+		group.severity = severity
+	}
+}
+
+// Flush delivers every held group: a single notification as itself, larger groups as one digest
+func (a *DigestAggregator) Flush(ctx context.Context) {
+	a.mu.Lock()
+	groups := a.groups
+	a.groups = make(map[digestKey]*digestGroup)
+	a.mu.Unlock()
+
+	for key, group := range groups {
+		if group.count == 1 {
+			if _, err := deliverImmediately(ctx, group.events[0], key.notificationType, group.severity); err != nil {
+				logger.Warn("[Notifications] Failed to deliver held notification for user %s: %v", key.userID, err)
+			}
+			continue
+		}
+		if err := a.deliverDigest(ctx, key, group); err != nil {
+			logger.Warn("[Notifications] Failed to deliver digest for user %s, type %s: %v", key.userID, notificationTypeToString(key.notificationType), err)
+		}
+	}
+}
+
+// deliverDigest stores the digest notification and emails the grouped events, following the
+// user's preferences for the notification type
+func (a *DigestAggregator) deliverDigest(ctx context.Context, key digestKey, group *digestGroup) error {
+	typeName := strings.ToLower(notificationTypeToString(key.notificationType))
+	latest := group.events[len(group.events)-1]
+	now := time.Now()
+
+	metadata, _ := json.Marshal(map[string]string{
+		"digest":      "true",
+		"event_count": strconv.Itoa(group.count),
+	})
+	digest := &database.Notification{
+		ID:         generateID("notif"),
+		UserID:     key.userID,
+		Type:       notificationTypeToString(key.notificationType),
+		Severity:   notificationSeverityToString(group.severity),
+		Title:      fmt.Sprintf("%d events of type %s", group.count, typeName),
+		Message:    fmt.Sprintf("%d %s notifications in the last %d minutes. Most recent: %s", group.count, typeName, int(a.interval.Minutes()), latest.Title),
+		Metadata:   string(metadata),
+		Read:       false,
+		ClientOnly: false,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if key.organizationID != "" {
+		orgID := key.organizationID
+		digest.OrganizationID = &orgID
+	}
+
+	preference, err := loadNotificationPreference(ctx, key.userID, key.notificationType)
+	if err != nil {
+		logger.Warn("[Notifications] Error checking preferences for user %s, type %s: %v", key.userID, digest.Type, err)
+	}
+	channels := resolveDeliveryChannels(preference, key.notificationType, group.severity)
+
+	if channels.InApp {
+		if err := database.DB.WithContext(ctx).Create(digest).Error; err != nil {
+			return fmt.Errorf("create digest notification: %w", err)
+		}
+		publishNotification(ctx, digest)
+	}
+	if channels.Email {
+		if err := a.sendEmail(ctx, key.userID, key.notificationType, digest.Title, group.events); err != nil {
+			return fmt.Errorf("send digest email: %w", err)
+		}
+	}
+
+	logger.Info("[Notifications] Delivered digest of %d %s notifications to user %s", group.count, typeName, key.userID)
+	return nil
+}
+
+// sendNotificationDigestEmail emails a digest listing its grouped notifications to the user's profile address
+func sendNotificationDigestEmail(ctx context.Context, userID string, notificationType notificationsv1.NotificationType, title string, events []*database.Notification) error {
+	userProfile, err := resolveEmailRecipient(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	mailer := email.NewSenderFromEnv()
+	if !mailer.Enabled() {
+		logger.Debug("[Notifications] Email sender not enabled (SMTP not configured)")
+		return nil
+	}
+
+	consoleURL := platform.DashboardURL()
+	emailCategory := notificationEmailCategory(notificationType)
+
+	greetingName := userProfile.Name
+	if greetingName == "" {
+		greetingName = userProfile.Email
+	}
+
+	bullets := make([]email.Bullet, 0, len(events))
+	for i := len(events) - 1; i >= 0; i-- {
+		bullets = append(bullets, email.Bullet{
+			Label:       events[i].Title,
+			Description: events[i].Message,
+		})
+	}
+
+	template := email.TemplateData{
+		Subject:     title,
+		PreviewText: title,
+		Greeting:    fmt.Sprintf("Hi %s,", greetingName),
+		Heading:     title,
+		IntroLines: []string{
+			fmt.Sprintf("To keep your inbox quiet, these %s notifications were grouped into one email.", strings.ToLower(notificationTypeToString(notificationType))),
+		},
+		Sections: []email.Section{
+			{
+				Title:   "Events",
+				Bullets: bullets,
+			},
+		},
+		CTA: &email.CTA{
+			Label: "Open Dashboard",
+			URL:   consoleURL,
+		},
+		Category:     emailCategory,
+		BaseURL:      consoleURL,
+		BrandURL:     consoleURL,
+		SupportEmail: platform.SupportEmail(),
+	}
+
+	emailMsg := &email.Message{
+		To:       []string{userProfile.Email},
+		Template: &template,
+		Category: emailCategory,
+	}
+	if err := mailer.Send(ctx, emailMsg); err != nil {
+		return fmt.Errorf("send email: %w", err)
+	}
+
+	logger.Info("[Notifications] Sent digest email to %s with %d events", userProfile.Email, len(events))
+	return nil
+}
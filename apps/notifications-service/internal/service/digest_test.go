@@ -0,0 +1,99 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+)
+
+func TestDigestAggregatorGroupsRapidNotifications(t *testing.T) {
+	db := newNotificationServiceTestDB(t)
+
+	orgID := "org-digest"
+	userID := "user-digest"
+	if err := db.Create(&database.NotificationDigestPolicy{
+		OrganizationID:    orgID,
+		Enabled:           true,
+		NotificationTypes: "[]",
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}).Error; err != nil {
+		t.Fatalf("seed digest policy: %v", err)
+	}
+
+	var emailCalls int
+	var emailedEvents []*database.Notification
+	aggregator := NewDigestAggregator(digestWindow, func(ctx context.Context, gotUserID string, notificationType notificationsv1.NotificationType, title string, events []*database.Notification) error {
+		emailCalls++
+		emailedEvents = events
+		return nil
+	})
+	previous := digestAggregator
+	digestAggregator = aggregator
+	t.Cleanup(func() {
+		digestAggregator = previous
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 20; i++ {
+		if err := CreateNotificationForUser(ctx, userID, &orgID,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_DEPLOYMENT,
+			notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+			fmt.Sprintf("Deployment %d failed", i), "Build exited with status 1", nil, nil, nil,
+		); err != nil {
+			t.Fatalf("create notification %d: %v", i, err)
+		}
+	}
+
+	var held int64
+	db.Model(&database.Notification{}).Where("user_id = ?", userID).Count(&held)
+	if held != 0 {
+		t.Fatalf("stored %d notifications before the digest flushed, want 0", held)
+	}
+
+	aggregator.Flush(ctx)
+
+	if emailCalls != 1 {
+		t.Fatalf("digest email sent %d times, want 1", emailCalls)
+	}
+	if len(emailedEvents) != 20 {
+		t.Fatalf("digest email listed %d events, want 20", len(emailedEvents))
+	}
+
+	var stored []database.Notification
+	db.Where("user_id = ?", userID).Find(&stored)
+	if len(stored) != 1 {
+		t.Fatalf("stored %d notifications after flush, want 1 digest", len(stored))
+	}
+	if stored[0].Title != "20 events of type deployment" {
+		t.Fatalf("digest title = %q", stored[0].Title)
+	}
+}
+
+func TestDigestPolicyGroups(t *testing.T) {
+	deployment := notificationsv1.NotificationType_NOTIFICATION_TYPE_DEPLOYMENT
+	billing := notificationsv1.NotificationType_NOTIFICATION_TYPE_BILLING
+	high := notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH
+	critical := notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL
+
+	policy := DigestPolicy{Enabled: true, NotificationTypes: []notificationsv1.NotificationType{deployment}}
+	if !policy.Groups(deployment, high) {
+		t.Fatal("policy should group listed types")
+	}
+	if policy.Groups(billing, high) {
+		t.Fatal("policy should not group unlisted types")
+	}
+	if policy.Groups(deployment, critical) {
+		t.Fatal("policy should never group CRITICAL notifications")
+	}
+	if (DigestPolicy{}).Groups(deployment, high) {
+		t.Fatal("disabled policy should not group")
+	}
+	if !(DigestPolicy{Enabled: true}).Groups(billing, high) {
+		t.Fatal("policy without types should group every type")
+	}
+}
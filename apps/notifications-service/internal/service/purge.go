@@ -0,0 +1,77 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+const (
+	// defaultNotificationHardDeleteDays is how old a deleted or archived notification gets before it
+	// is purged when NOTIFICATION_HARD_DELETE_DAYS is unset
+	defaultNotificationHardDeleteDays = 90
+
+	notificationPurgeInterval = time.Hour
+)
+
+var notificationPurgeInitOnce sync.Once
+
+// InitNotificationPurge starts the background job that hard-deletes old deleted and archived notifications
+func InitNotificationPurge(parent context.Context) {
+	notificationPurgeInitOnce.Do(func() {
+		if parent == nil {
+			parent = context.Background()
+		}
+
+		retention := notificationHardDeleteAge()
+		go func() {
+			ticker := time.NewTicker(notificationPurgeInterval)
+			defer ticker.Stop()
+
+			for {
+				if purged, err := purgeNotifications(parent, time.Now().Add(-retention)); err != nil {
+					logger.Warn("[Notifications] Failed to purge old notifications: %v", err)
+				} else if purged > 0 {
+					logger.Info("[Notifications] Purged %d deleted or archived notifications", purged)
+				}
+
+				select {
+				case <-ticker.C:
+				case <-parent.Done():
+					return
+				}
+			}
+		}()
+	})
+}
+
+// purgeNotifications hard-deletes the deleted or archived notifications created before cutoff
+func purgeNotifications(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := database.DB.WithContext(ctx).
+		Where("created_at < ? AND (deleted_at IS NOT NULL OR archived_at IS NOT NULL)", cutoff).
+		Delete(&database.Notification{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("purge notifications: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// notificationHardDeleteAge reads NOTIFICATION_HARD_DELETE_DAYS
+func notificationHardDeleteAge() time.Duration {
+	days := defaultNotificationHardDeleteDays
+	if raw := strings.TrimSpace(os.Getenv("NOTIFICATION_HARD_DELETE_DAYS")); raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil && parsed > 0 {
+			days = parsed
+		} else {
+			logger.Warn("[Notifications] Invalid NOTIFICATION_HARD_DELETE_DAYS %q, using %d", raw, defaultNotificationHardDeleteDays)
+		}
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
@@ -0,0 +1,134 @@
+package notifications
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+)
+
+func TestNotificationSoftDeleteAndArchive(t *testing.T) {
+	db := newNotificationServiceTestDB(t)
+	service := &Service{}
+
+	now := time.Now().UTC()
+	for i, id := range []string{"notif-keep", "notif-delete", "notif-archive"} {
+		notification := testNotification(id, "user-inbox", nil, id, false, now.Add(time.Duration(i)*time.Second))
+		if err := db.Create(notification).Error; err != nil {
+			t.Fatalf("seed notification %s: %v", id, err)
+		}
+	}
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-inbox", Email: "user-inbox@example.com"})
+
+	if _, err := service.DeleteNotification(ctx, connect.NewRequest(&notificationsv1.DeleteNotificationRequest{NotificationId: "notif-delete"})); err != nil {
+		t.Fatalf("delete notification: %v", err)
+	}
+	if _, err := service.ArchiveNotification(ctx, connect.NewRequest(&notificationsv1.ArchiveNotificationRequest{NotificationId: "notif-archive"})); err != nil {
+		t.Fatalf("archive notification: %v", err)
+	}
+
+	var deleted database.Notification
+	if err := db.First(&deleted, "id = ?", "notif-delete").Error; err != nil {
+		t.Fatalf("deleted notification was removed from the database: %v", err)
+	}
+	if deleted.DeletedAt == nil {
+		t.Fatal("deleted notification has no deleted_at")
+	}
+
+	list, err := service.ListNotifications(ctx, connect.NewRequest(&notificationsv1.ListNotificationsRequest{}))
+	if err != nil {
+		t.Fatalf("list notifications: %v", err)
+	}
+	if got := notificationIDs(list.Msg.Notifications); !slices.Equal(got, []string{"notif-keep"}) {
+		t.Fatalf("inbox = %v, want only the notification that is neither deleted nor archived", got)
+	}
+
+	includeDeleted := true
+	list, err = service.ListNotifications(ctx, connect.NewRequest(&notificationsv1.ListNotificationsRequest{IncludeDeleted: &includeDeleted}))
+	if err != nil {
+		t.Fatalf("list notifications with deleted: %v", err)
+	}
+	if got := notificationIDs(list.Msg.Notifications); !slices.Equal(got, []string{"notif-delete", "notif-keep"}) {
+		t.Fatalf("inbox with deleted = %v, want notif-delete and notif-keep", got)
+	}
+	if list.Msg.Notifications[0].GetDeletedAt() == nil {
+		t.Fatal("listed deleted notification has no deleted_at")
+	}
+
+	archived, err := service.ListArchivedNotifications(ctx, connect.NewRequest(&notificationsv1.ListArchivedNotificationsRequest{PerPage: 1}))
+	if err != nil {
+		t.Fatalf("list archived notifications: %v", err)
+	}
+	if got := notificationIDs(archived.Msg.Notifications); !slices.Equal(got, []string{"notif-archive"}) {
+		t.Fatalf("archive = %v, want notif-archive", got)
+	}
+	if got := archived.Msg.Pagination.GetTotal(); got != 1 {
+		t.Fatalf("archive total = %d, want 1", got)
+	}
+
+	unread, err := service.GetUnreadCount(ctx, connect.NewRequest(&notificationsv1.GetUnreadCountRequest{}))
+	if err != nil {
+		t.Fatalf("get unread count: %v", err)
+	}
+	if got := unread.Msg.GetCount(); got != 1 {
+		t.Fatalf("unread count = %d, want 1", got)
+	}
+
+	_, err = service.DeleteNotification(ctx, connect.NewRequest(&notificationsv1.DeleteNotificationRequest{NotificationId: "notif-delete"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("deleting a deleted notification = %v, want not found", err)
+	}
+}
+
+func TestPurgeNotificationsCutoff(t *testing.T) {
+	db := newNotificationServiceTestDB(t)
+
+	cutoff := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	deletedAt := cutoff.Add(24 * time.Hour)
+	seed := []struct {
+		id        string
+		createdAt time.Time
+		deleted   bool
+		archived  bool
+	}{
+		{"purge-deleted-before", cutoff.Add(-time.Second), true, false},
+		{"purge-archived-before", cutoff.Add(-time.Hour), false, true},
+		{"purge-deleted-at-cutoff", cutoff, true, false},
+		{"purge-archived-after", cutoff.Add(time.Second), false, true},
+		{"purge-active-before", cutoff.Add(-30 * 24 * time.Hour), false, false},
+	}
+	for _, s := range seed {
+		notification := testNotification(s.id, "user-purge", nil, s.id, false, s.createdAt)
+		if s.deleted {
+			notification.DeletedAt = &deletedAt
+		}
+		if s.archived {
+			notification.ArchivedAt = &deletedAt
+		}
+		if err := db.Create(notification).Error; err != nil {
+			t.Fatalf("seed notification %s: %v", s.id, err)
+		}
+	}
+
+	purged, err := purgeNotifications(context.Background(), cutoff)
+	if err != nil {
+		t.Fatalf("purge notifications: %v", err)
+	}
+	if purged != 2 {
+		t.Fatalf("purged %d notifications, want 2", purged)
+	}
+
+	var remaining []string
+	if err := db.Model(&database.Notification{}).Where("user_id = ?", "user-purge").Order("id").Pluck("id", &remaining).Error; err != nil {
+		t.Fatalf("list remaining notifications: %v", err)
+	}
+	if want := []string{"purge-active-before", "purge-archived-after", "purge-deleted-at-cutoff"}; !slices.Equal(remaining, want) {
+		t.Fatalf("remaining notifications = %v, want %v", remaining, want)
+	}
+}
@@ -0,0 +1,223 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/middleware"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+const (
+	pushHeartbeatInterval = 30 * time.Second
+	pushWriteTimeout      = 10 * time.Second
+
+	// pushMissedLimit caps the burst of missed notifications sent when a client connects
+	pushMissedLimit = 100
+)
+
+// pushFrame is a JSON frame sent to WebSocket clients
+type pushFrame struct {
+	Type         string          `json:"type"` // "notification" or "error"
+	Notification json.RawMessage `json:"notification,omitempty"`
+	Message      string          `json:"message,omitempty"`
+}
+
+// pushChannel returns the Redis pub/sub channel carrying new notifications for a user
+func pushChannel(userID string) string {
+	return "notifications:" + userID
+}
+
+// publishNotification pushes a stored notification to the user's connected WebSocket clients.
+// Delivery is best effort: clients that miss it pick it up on reconnect or through ListNotifications.
+func publishNotification(ctx context.Context, n *database.Notification) {
+	if database.RedisClient == nil || n.ClientOnly {
+		return
+	}
+	payload, err := protojson.Marshal(notificationToProto(n))
+	if err != nil {
+		logger.Warn("[Notifications] Failed to encode notification %s for push: %v", n.ID, err)
+		return
+	}
+	if err := database.RedisClient.GetClient().Publish(ctx, pushChannel(n.UserID), payload).Err(); err != nil {
+		logger.Warn("[Notifications] Failed to publish notification %s: %v", n.ID, err)
+	}
+}
+
+// HandleNotificationsWebSocket upgrades the connection to a WebSocket and pushes the
+// authenticated user's new notifications to it as they are created.
+//
+// The JWT is taken from the Authorization header, or from the token query parameter for
+// browsers, which cannot set headers on WebSocket requests. If last_seen_at (RFC 3339) is
+// given, notifications created since then are sent first; otherwise unread ones are.
+func (s *Service) HandleNotificationsWebSocket(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if !middleware.IsOriginAllowed(origin) {
+		logger.Warn("[Notifications WS] Origin %s not allowed", origin)
+		http.Error(w, "Origin not allowed", http.StatusForbidden)
+		return
+	}
+
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		if token := strings.TrimSpace(r.URL.Query().Get("token")); token != "" {
+			authHeader = "Bearer " + token
+		}
+	}
+	ctx, user, err := auth.AuthenticateAndSetContext(r.Context(), authHeader)
+	if err != nil {
+		logger.Debug("[Notifications WS] Authentication failed: %v", err)
+		http.Error(w, "Authentication required", http.StatusUnauthorized)
+		return
+	}
+
+	var since *time.Time
+	if raw := r.URL.Query().Get("last_seen_at"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			http.Error(w, "last_seen_at must be an RFC 3339 timestamp", http.StatusBadRequest)
+			return
+		}
+		since = &parsed
+	}
+
+	if database.RedisClient == nil {
+		http.Error(w, "Push notifications unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	// Behind the API gateway the Host header names the internal service; match it to the
+	// Origin so websocket.Accept's same-origin check passes
+	if originURL, err := url.Parse(origin); err == nil && originURL.Host != "" {
+		r.Host = originURL.Host
+	}
+	acceptOptions := &websocket.AcceptOptions{}
+	corsConfig := middleware.DefaultCORSConfig()
+	if !(len(corsConfig.AllowedOrigins) == 1 && corsConfig.AllowedOrigins[0] == "*") {
+		acceptOptions.OriginPatterns = append([]string{}, corsConfig.AllowedOrigins...)
+		if origin != "" {
+			acceptOptions.OriginPatterns = append(acceptOptions.OriginPatterns, origin)
+		}
+	}
+
+	conn, err := websocket.Accept(w, r, acceptOptions)
+	if err != nil {
+		logger.Warn("[Notifications WS] Failed to accept websocket connection: %v", err)
+		return
+	}
+	defer conn.Close(websocket.StatusNormalClosure, "")
+
+	// Clients only send control frames; CloseRead handles pongs and cancels ctx on close
+	ctx = conn.CloseRead(ctx)
+
+	// Subscribe before loading missed notifications so none fall between the two
+	pubsub := database.RedisClient.GetClient().Subscribe(ctx, pushChannel(user.Id))
+	defer pubsub.Close()
+	if _, err := pubsub.Receive(ctx); err != nil {
+		logger.Warn("[Notifications WS] Failed to subscribe for user %s: %v", user.Id, err)
+		conn.Close(websocket.StatusInternalError, "subscribe failed")
+		return
+	}
+	messages := pubsub.Channel()
+
+	var writeMu sync.Mutex
+	write := func(frame pushFrame) error {
+		writeCtx, cancel := context.WithTimeout(ctx, pushWriteTimeout)
+		defer cancel()
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		return wsjson.Write(writeCtx, conn, frame)
+	}
+
+	missed, err := loadMissedNotifications(ctx, user.Id, since)
+	if err != nil {
+		logger.Warn("[Notifications WS] Failed to load missed notifications for user %s: %v", user.Id, err)
+		_ = write(pushFrame{Type: "error", Message: "Failed to load missed notifications"})
+	}
+	sent := make(map[string]struct{}, len(missed))
+	for i := range missed {
+		payload, err := protojson.Marshal(notificationToProto(&missed[i]))
+		if err != nil {
+			continue
+		}
+		if err := write(pushFrame{Type: "notification", Notification: payload}); err != nil {
+			return
+		}
+		sent[missed[i].ID] = struct{}{}
+	}
+
+	logger.Debug("[Notifications WS] User %s connected (%d missed notifications sent)", user.Id, len(missed))
+
+	heartbeat := time.NewTicker(pushHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Debug("[Notifications WS] User %s disconnected", user.Id)
+			return
+		case <-heartbeat.C:
+			pingCtx, cancel := context.WithTimeout(ctx, pushWriteTimeout)
+			err := conn.Ping(pingCtx)
+			cancel()
+			if err != nil {
+				logger.Debug("[Notifications WS] Heartbeat failed for user %s: %v", user.Id, err)
+				conn.Close(websocket.StatusGoingAway, "heartbeat timeout")
+				return
+			}
+		case msg, ok := <-messages:
+			if !ok {
+				conn.Close(websocket.StatusGoingAway, "subscription closed")
+				return
+			}
+			payload := json.RawMessage(msg.Payload)
+			if len(sent) > 0 {
+				// Skip notifications already delivered in the missed burst
+				var header struct {
+					ID string `json:"id"`
+				}
+				if json.Unmarshal(payload, &header) == nil {
+					if _, ok := sent[header.ID]; ok {
+						continue
+					}
+				}
+			}
+			if err := write(pushFrame{Type: "notification", Notification: payload}); err != nil {
+				logger.Debug("[Notifications WS] Failed to push to user %s: %v", user.Id, err)
+				return
+			}
+		}
+	}
+}
+
+// loadMissedNotifications returns the notifications a reconnecting client has not seen,
+// oldest first: those created after since, or all unread ones when since is nil
+func loadMissedNotifications(ctx context.Context, userID string, since *time.Time) ([]database.Notification, error) {
+	query := database.DB.WithContext(ctx).Where("user_id = ? AND client_only = ? AND archived_at IS NULL AND deleted_at IS NULL", userID, false)
+	if since != nil {
+		query = query.Where("created_at > ?", *since)
+	} else {
+		query = query.Where("read = ?", false)
+	}
+
+	var missed []database.Notification
+	if err := query.Order("created_at DESC").Limit(pushMissedLimit).Find(&missed).Error; err != nil {
+		return nil, fmt.Errorf("list missed notifications: %w", err)
+	}
+	for i, j := 0, len(missed)-1; i < j; i, j = i+1, j-1 {
+		missed[i], missed[j] = missed[j], missed[i]
+	}
+	return missed, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/obiente/cloud/apps/shared/pkg/platform"
 	"github.com/obiente/cloud/apps/shared/pkg/services/organizations"
 
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
 	commonv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/common/v1"
 	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
 	notificationsv1connect "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1/notificationsv1connect"
@@ -28,11 +29,16 @@ import (
 
 type Service struct {
 	notificationsv1connect.UnimplementedNotificationServiceHandler
+	permissionChecker *auth.PermissionChecker
 }
 
 func NewService(backgroundCtx context.Context) *Service {
 	InitAsyncEmailDispatcher(backgroundCtx)
-	return &Service{}
+	InitDigestAggregator(backgroundCtx)
+	InitNotificationPurge(backgroundCtx)
+	return &Service{
+		permissionChecker: auth.NewPermissionChecker(),
+	}
 }
 
 func (s *Service) ListNotifications(ctx context.Context, req *connect.Request[notificationsv1.ListNotificationsRequest]) (*connect.Response[notificationsv1.ListNotificationsResponse], error) {
@@ -41,10 +47,13 @@ func (s *Service) ListNotifications(ctx context.Context, req *connect.Request[no
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
 	}
 
-	// Build query
-	query := database.DB.Where("user_id = ? AND client_only = ?", user.Id, false)
+	// Build query; archived notifications are listed by ListArchivedNotifications
+	query := database.DB.Where("user_id = ? AND client_only = ? AND archived_at IS NULL", user.Id, false)
 
 	// Apply filters
+	if !req.Msg.GetIncludeDeleted() {
+		query = query.Where("deleted_at IS NULL")
+	}
 	if req.Msg.GetUnreadOnly() {
 		query = query.Where("read = ?", false)
 	}
@@ -61,12 +70,44 @@ func (s *Service) ListNotifications(ctx context.Context, req *connect.Request[no
 		}
 	}
 
+	notifications, pagination, err := listNotificationsPage(query, "created_at DESC", req.Msg.GetPage(), req.Msg.GetPerPage())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&notificationsv1.ListNotificationsResponse{
+		Notifications: notifications,
+		Pagination:    pagination,
+	}), nil
+}
+
+// ListArchivedNotifications lists the user's archived notifications, most recently archived first
+func (s *Service) ListArchivedNotifications(ctx context.Context, req *connect.Request[notificationsv1.ListArchivedNotificationsRequest]) (*connect.Response[notificationsv1.ListArchivedNotificationsResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	query := database.DB.Where("user_id = ? AND client_only = ? AND archived_at IS NOT NULL AND deleted_at IS NULL", user.Id, false)
+	notifications, pagination, err := listNotificationsPage(query, "archived_at DESC, created_at DESC", req.Msg.GetPage(), req.Msg.GetPerPage())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&notificationsv1.ListArchivedNotificationsResponse{
+		Notifications: notifications,
+		Pagination:    pagination,
+	}), nil
+}
+
+// listNotificationsPage returns one page of the notifications matched by query
+func listNotificationsPage(query *gorm.DB, order string, requestedPage, requestedPerPage int32) ([]*notificationsv1.Notification, *commonv1.Pagination, error) {
 	// Pagination
-	page := int(req.Msg.GetPage())
+	page := int(requestedPage)
 	if page < 1 {
 		page = 1
 	}
-	perPage := int(req.Msg.GetPerPage())
+	perPage := int(requestedPerPage)
 	if perPage < 1 {
 		perPage = 50
 	}
@@ -78,13 +119,13 @@ func (s *Service) ListNotifications(ctx context.Context, req *connect.Request[no
 	// Count total
 	var total int64
 	if err := query.Model(&database.Notification{}).Count(&total).Error; err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("count notifications: %w", err))
+		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("count notifications: %w", err))
 	}
 
 	// Fetch notifications
 	var notifications []database.Notification
-	if err := query.Order("created_at DESC").Limit(perPage).Offset(offset).Find(&notifications).Error; err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list notifications: %w", err))
+	if err := query.Order(order).Limit(perPage).Offset(offset).Find(&notifications).Error; err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list notifications: %w", err))
 	}
 
 	// Convert to proto
@@ -95,15 +136,12 @@ func (s *Service) ListNotifications(ctx context.Context, req *connect.Request[no
 
 	totalPages := (int(total) + perPage - 1) / perPage
 
-	return connect.NewResponse(&notificationsv1.ListNotificationsResponse{
-		Notifications: protoNotifications,
-		Pagination: &commonv1.Pagination{
-			Page:       int32(page),
-			PerPage:    int32(perPage),
-			Total:      int32(total),
-			TotalPages: int32(totalPages),
-		},
-	}), nil
+	return protoNotifications, &commonv1.Pagination{
+		Page:       int32(page),
+		PerPage:    int32(perPage),
+		Total:      int32(total),
+		TotalPages: int32(totalPages),
+	}, nil
 }
 
 func (s *Service) GetNotification(ctx context.Context, req *connect.Request[notificationsv1.GetNotificationRequest]) (*connect.Response[notificationsv1.GetNotificationResponse], error) {
@@ -113,7 +151,7 @@ func (s *Service) GetNotification(ctx context.Context, req *connect.Request[noti
 	}
 
 	var notification database.Notification
-	if err := database.DB.Where("id = ? AND user_id = ?", req.Msg.GetNotificationId(), user.Id).First(&notification).Error; err != nil {
+	if err := database.DB.Where("id = ? AND user_id = ? AND deleted_at IS NULL", req.Msg.GetNotificationId(), user.Id).First(&notification).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("notification not found"))
 		}
@@ -132,7 +170,7 @@ func (s *Service) MarkAsRead(ctx context.Context, req *connect.Request[notificat
 	}
 
 	var notification database.Notification
-	if err := database.DB.Where("id = ? AND user_id = ?", req.Msg.GetNotificationId(), user.Id).First(&notification).Error; err != nil {
+	if err := database.DB.Where("id = ? AND user_id = ? AND deleted_at IS NULL", req.Msg.GetNotificationId(), user.Id).First(&notification).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("notification not found"))
 		}
@@ -157,7 +195,7 @@ func (s *Service) MarkAllAsRead(ctx context.Context, req *connect.Request[notifi
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
 	}
 
-	query := database.DB.Model(&database.Notification{}).Where("user_id = ? AND read = ?", user.Id, false)
+	query := database.DB.Model(&database.Notification{}).Where("user_id = ? AND read = ? AND deleted_at IS NULL", user.Id, false)
 
 	// Apply filters
 	if req.Msg.Type != nil {
@@ -190,7 +228,9 @@ func (s *Service) DeleteNotification(ctx context.Context, req *connect.Request[n
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
 	}
 
-	result := database.DB.Where("id = ? AND user_id = ?", req.Msg.GetNotificationId(), user.Id).Delete(&database.Notification{})
+	result := database.DB.Model(&database.Notification{}).
+		Where("id = ? AND user_id = ? AND deleted_at IS NULL", req.Msg.GetNotificationId(), user.Id).
+		Update("deleted_at", time.Now())
 	if result.Error != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("delete notification: %w", result.Error))
 	}
@@ -203,13 +243,41 @@ func (s *Service) DeleteNotification(ctx context.Context, req *connect.Request[n
 	}), nil
 }
 
+// ArchiveNotification moves a notification out of the inbox into the archive
+func (s *Service) ArchiveNotification(ctx context.Context, req *connect.Request[notificationsv1.ArchiveNotificationRequest]) (*connect.Response[notificationsv1.ArchiveNotificationResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	var notification database.Notification
+	if err := database.DB.Where("id = ? AND user_id = ? AND deleted_at IS NULL", req.Msg.GetNotificationId(), user.Id).First(&notification).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("notification not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("archive notification: %w", err))
+	}
+
+	if notification.ArchivedAt == nil {
+		now := time.Now()
+		notification.ArchivedAt = &now
+		if err := database.DB.Save(&notification).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("archive notification: %w", err))
+		}
+	}
+
+	return connect.NewResponse(&notificationsv1.ArchiveNotificationResponse{
+		Notification: notificationToProto(&notification),
+	}), nil
+}
+
 func (s *Service) DeleteAllNotifications(ctx context.Context, req *connect.Request[notificationsv1.DeleteAllNotificationsRequest]) (*connect.Response[notificationsv1.DeleteAllNotificationsResponse], error) {
 	user, err := auth.GetUserFromContext(ctx)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
 	}
 
-	query := database.DB.Where("user_id = ?", user.Id)
+	query := database.DB.Model(&database.Notification{}).Where("user_id = ? AND deleted_at IS NULL", user.Id)
 
 	// Apply filters
 	if req.Msg.GetReadOnly() {
@@ -222,7 +290,7 @@ func (s *Service) DeleteAllNotifications(ctx context.Context, req *connect.Reque
 		}
 	}
 
-	result := query.Delete(&database.Notification{})
+	result := query.Update("deleted_at", time.Now())
 	if result.Error != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("delete all notifications: %w", result.Error))
 	}
@@ -238,7 +306,7 @@ func (s *Service) GetUnreadCount(ctx context.Context, req *connect.Request[notif
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
 	}
 
-	query := database.DB.Model(&database.Notification{}).Where("user_id = ? AND read = ? AND client_only = ?", user.Id, false, false)
+	query := database.DB.Model(&database.Notification{}).Where("user_id = ? AND read = ? AND client_only = ? AND archived_at IS NULL AND deleted_at IS NULL", user.Id, false, false)
 
 	// Apply filters
 	if req.Msg.Type != nil {
@@ -338,14 +406,13 @@ func (s *Service) CreateNotification(ctx context.Context, req *connect.Request[n
 		notification.Metadata = string(metadataJSON)
 	}
 
-	if err := database.DB.Create(notification).Error; err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create notification: %w", err))
+	if _, err := deliverNotification(ctx, notification, req.Msg.GetType(), req.Msg.GetSeverity()); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	dispatchWebhookDelivery(notification, req.Msg.GetSeverity())
 
 	logger.Info("[Notifications] Created notification via RPC for user %s, type %s, severity %s: %s", req.Msg.GetUserId(), notificationTypeToString(req.Msg.GetType()), notificationSeverityToString(req.Msg.GetSeverity()), req.Msg.GetTitle())
 
-	enqueueNotificationEmail(req.Msg.GetUserId(), req.Msg.GetType(), req.Msg.GetSeverity(), req.Msg.GetTitle(), req.Msg.GetMessage(), req.Msg.ActionUrl, req.Msg.ActionLabel)
-
 	return connect.NewResponse(&notificationsv1.CreateNotificationResponse{
 		Notification: notificationToProto(notification),
 	}), nil
@@ -399,6 +466,7 @@ func (s *Service) CreateOrganizationNotification(ctx context.Context, req *conne
 
 	// Create notifications for each member
 	notifications := make([]*notificationsv1.Notification, 0, len(members))
+	var delivered *database.Notification
 	for _, member := range members {
 		// Skip pending invites
 		if member.UserID == "" || strings.HasPrefix(member.UserID, "pending") {
@@ -438,18 +506,26 @@ func (s *Service) CreateOrganizationNotification(ctx context.Context, req *conne
 			}
 		}
 
-		if err := database.DB.Create(notification).Error; err != nil {
+		stored, err := deliverNotification(ctx, notification, req.Msg.GetType(), req.Msg.GetSeverity())
+		if err != nil {
 			logger.Warn("[Notifications] Failed to create notification for user %s: %v", member.UserID, err)
 			continue
 		}
+		if delivered == nil {
+			delivered = notification
+		}
+		if !stored {
+			continue
+		}
 
 		logger.Info("[Notifications] Created notification for org member %s, type %s, severity %s: %s", member.UserID, notificationTypeToString(req.Msg.GetType()), notificationSeverityToString(req.Msg.GetSeverity()), req.Msg.GetTitle())
 
-		enqueueNotificationEmail(member.UserID, req.Msg.GetType(), req.Msg.GetSeverity(), req.Msg.GetTitle(), req.Msg.GetMessage(), req.Msg.ActionUrl, req.Msg.ActionLabel)
-
 		notifications = append(notifications, notificationToProto(notification))
 	}
 
+	// Webhooks belong to the organization, so they get one message rather than one per member
+	dispatchWebhookDelivery(delivered, req.Msg.GetSeverity())
+
 	return connect.NewResponse(&notificationsv1.CreateOrganizationNotificationResponse{
 		CreatedCount:  int32(len(notifications)),
 		Notifications: notifications,
@@ -488,6 +564,12 @@ func notificationToProto(n *database.Notification) *notificationsv1.Notification
 	if n.ActionLabel != nil {
 		proto.ActionLabel = n.ActionLabel
 	}
+	if n.ArchivedAt != nil {
+		proto.ArchivedAt = timestamppb.New(*n.ArchivedAt)
+	}
+	if n.DeletedAt != nil {
+		proto.DeletedAt = timestamppb.New(*n.DeletedAt)
+	}
 
 	// Parse metadata JSON
 	if n.Metadata != "" {
@@ -582,6 +664,16 @@ func stringToNotificationSeverity(s string) notificationsv1.NotificationSeverity
 
 // CreateNotificationForUser is a helper function that can be called from other services
 func CreateNotificationForUser(ctx context.Context, userID string, orgID *string, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity, title, message string, actionURL, actionLabel *string, metadata map[string]string) error {
+	notification, err := createNotificationForUser(ctx, userID, orgID, notificationType, severity, title, message, actionURL, actionLabel, metadata)
+	if err != nil {
+		return err
+	}
+	dispatchWebhookDelivery(notification, severity)
+	return nil
+}
+
+// createNotificationForUser delivers a notification to a user without posting it to the organization's webhooks
+func createNotificationForUser(ctx context.Context, userID string, orgID *string, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity, title, message string, actionURL, actionLabel *string, metadata map[string]string) (*database.Notification, error) {
 	notification := &database.Notification{
 		ID:             generateID("notif"),
 		UserID:         userID,
@@ -607,105 +699,58 @@ func CreateNotificationForUser(ctx context.Context, userID string, orgID *string
 	if len(metadata) > 0 {
 		metadataJSON, err := json.Marshal(metadata)
 		if err != nil {
-			return fmt.Errorf("marshal metadata: %w", err)
+			return nil, fmt.Errorf("marshal metadata: %w", err)
 		}
 		notification.Metadata = string(metadataJSON)
 	}
 
-	if err := database.DB.Create(notification).Error; err != nil {
-		return fmt.Errorf("create notification: %w", err)
+	if _, err := deliverNotification(ctx, notification, notificationType, severity); err != nil {
+		return nil, err
 	}
 
 	logger.Info("[Notifications] Created notification for user %s, type %s, severity %s: %s", userID, notificationTypeToString(notificationType), notificationSeverityToString(severity), title)
 
-	enqueueNotificationEmail(userID, notificationType, severity, title, message, actionURL, actionLabel)
-
-	return nil
+	return notification, nil
 }
 
-// sendNotificationEmailIfEnabled checks user preferences and sends email if enabled
-func sendNotificationEmailIfEnabled(ctx context.Context, userID string, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity, title, message string, actionURL, actionLabel *string) error {
-	notificationTypeStr := notificationTypeToString(notificationType)
-
-	logger.Info("[Notifications] Checking email preferences for user %s, type %s, severity %s", userID, notificationTypeStr, notificationSeverityToString(severity))
-
-	// Get user preference for this notification type
-	var preference database.NotificationPreference
-	err := database.DB.Where("user_id = ? AND notification_type = ?", userID, notificationTypeStr).First(&preference).Error
-
-	// If no preference found, use defaults based on notification type
-	emailEnabled := false
-	var minSeverity string
-	frequency := "immediate"
-
-	if err == gorm.ErrRecordNotFound {
-		// Use defaults based on notification type (matching GetNotificationTypes defaults)
-		switch notificationType {
-		case notificationsv1.NotificationType_NOTIFICATION_TYPE_WARNING,
-			notificationsv1.NotificationType_NOTIFICATION_TYPE_ERROR,
-			notificationsv1.NotificationType_NOTIFICATION_TYPE_DEPLOYMENT,
-			notificationsv1.NotificationType_NOTIFICATION_TYPE_BILLING,
-			notificationsv1.NotificationType_NOTIFICATION_TYPE_QUOTA,
-			notificationsv1.NotificationType_NOTIFICATION_TYPE_INVITE,
-			notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM:
-			emailEnabled = true
-		case notificationsv1.NotificationType_NOTIFICATION_TYPE_INFO,
-			notificationsv1.NotificationType_NOTIFICATION_TYPE_SUCCESS:
-			emailEnabled = false
-		}
-
-		// Get type-specific default min severity (matching GetNotificationTypes defaults)
-		minSeverity = getDefaultMinSeverityForType(notificationType)
-
-		logger.Info("[Notifications] No preference found for user %s, type %s, using default emailEnabled=%v, minSeverity=%s, frequency=%s", userID, notificationTypeStr, emailEnabled, minSeverity, frequency)
-	} else if err != nil {
-		logger.Warn("[Notifications] Error checking preferences for user %s, type %s: %v", userID, notificationTypeStr, err)
-		return fmt.Errorf("get preference: %w", err)
-	} else {
-		emailEnabled = preference.EmailEnabled
-		minSeverity = preference.MinSeverity
-		frequency = preference.Frequency
-		logger.Info("[Notifications] Found preference for user %s, type %s: emailEnabled=%v, frequency=%s, minSeverity=%s", userID, notificationTypeStr, emailEnabled, frequency, minSeverity)
+// resolveEmailRecipient returns the user's profile, which must have an email address
+func resolveEmailRecipient(ctx context.Context, userID string) (*authv1.User, error) {
+	resolver := organizations.GetUserProfileResolver()
+	if resolver == nil || !resolver.IsConfigured() {
+		return nil, fmt.Errorf("user profile resolver not configured")
 	}
 
-	// Check if email is enabled and frequency is not "never"
-	if !emailEnabled {
-		logger.Info("[Notifications] Email disabled for user %s, type %s (emailEnabled=false)", userID, notificationTypeStr)
-		return nil // Email not enabled for this user/type
+	userProfile, err := resolver.Resolve(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve user profile: %w", err)
 	}
 
-	if frequency == "never" {
-		logger.Info("[Notifications] Email frequency is 'never' for user %s, type %s", userID, notificationTypeStr)
-		return nil // Email frequency set to never
+	if userProfile.Email == "" {
+		return nil, fmt.Errorf("user has no email address")
 	}
+	return userProfile, nil
+}
 
-	// Check severity threshold
-	severityMap := map[string]int{
-		"LOW":      1,
-		"MEDIUM":   2,
-		"HIGH":     3,
-		"CRITICAL": 4,
-	}
-	notificationSeverityValue := severityMap[notificationSeverityToString(severity)]
-	minSeverityValue := severityMap[minSeverity]
-	if notificationSeverityValue < minSeverityValue {
-		logger.Info("[Notifications] Severity below threshold for user %s, type %s (notification=%d, min=%d)", userID, notificationTypeStr, notificationSeverityValue, minSeverityValue)
-		return nil // Severity below threshold
+// notificationEmailCategory returns the email category for a notification type
+func notificationEmailCategory(notificationType notificationsv1.NotificationType) email.Category {
+	switch notificationType {
+	case notificationsv1.NotificationType_NOTIFICATION_TYPE_BILLING:
+		return email.CategoryBilling
+	case notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM:
+		return email.CategorySystem
+	case notificationsv1.NotificationType_NOTIFICATION_TYPE_INVITE:
+		return email.CategoryInvite
+	default:
+		return email.CategoryNotification
 	}
+}
 
+// sendNotificationEmail emails a notification to the user's profile address
+func sendNotificationEmail(ctx context.Context, userID string, notificationType notificationsv1.NotificationType, title, message string, actionURL, actionLabel *string) error {
 	// Get user email from profile resolver
-	resolver := organizations.GetUserProfileResolver()
-	if resolver == nil || !resolver.IsConfigured() {
-		return fmt.Errorf("user profile resolver not configured")
-	}
-
-	userProfile, err := resolver.Resolve(ctx, userID)
+	userProfile, err := resolveEmailRecipient(ctx, userID)
 	if err != nil {
-		return fmt.Errorf("resolve user profile: %w", err)
-	}
-
-	if userProfile.Email == "" {
-		return fmt.Errorf("user has no email address")
+		return err
 	}
 
 	// Initialize email sender
@@ -729,15 +774,7 @@ func sendNotificationEmailIfEnabled(ctx context.Context, userID string, notifica
 	}
 
 	// Determine email category based on notification type
-	emailCategory := email.CategoryNotification
-	switch notificationType {
-	case notificationsv1.NotificationType_NOTIFICATION_TYPE_BILLING:
-		emailCategory = email.CategoryBilling
-	case notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM:
-		emailCategory = email.CategorySystem
-	case notificationsv1.NotificationType_NOTIFICATION_TYPE_INVITE:
-		emailCategory = email.CategoryInvite
-	}
+	emailCategory := notificationEmailCategory(notificationType)
 
 	// Build email template
 	greetingName := userProfile.Name
@@ -800,18 +837,27 @@ func CreateNotificationForOrganization(ctx context.Context, orgID string, notifi
 	}
 
 	// Create notifications for each member
+	var delivered *database.Notification
 	for _, member := range members {
 		// Skip pending invites
 		if member.UserID == "" || len(member.UserID) < 7 || member.UserID[0:7] == "pending" {
 			continue
 		}
 
-		if err := CreateNotificationForUser(ctx, member.UserID, &orgID, notificationType, severity, title, message, actionURL, actionLabel, metadata); err != nil {
+		notification, err := createNotificationForUser(ctx, member.UserID, &orgID, notificationType, severity, title, message, actionURL, actionLabel, metadata)
+		if err != nil {
 			logger.Warn("[Notifications] Failed to create notification for user %s: %v", member.UserID, err)
 			// Continue with other members
+			continue
+		}
+		if delivered == nil {
+			delivered = notification
 		}
 	}
 
+	// Webhooks belong to the organization, so they get one message rather than one per member
+	dispatchWebhookDelivery(delivered, severity)
+
 	return nil
 }
 
@@ -914,13 +960,20 @@ func (s *Service) GetNotificationPreferences(ctx context.Context, req *connect.R
 			NotificationType: stringToNotificationType(p.NotificationType),
 			EmailEnabled:     p.EmailEnabled,
 			InAppEnabled:     p.InAppEnabled,
+			SmsEnabled:       p.SMSEnabled,
 			Frequency:        stringToNotificationFrequency(p.Frequency),
 			MinSeverity:      stringToNotificationSeverity(p.MinSeverity),
 		})
 	}
 
+	phoneNumber, err := loadSMSPhoneNumber(ctx, user.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	return connect.NewResponse(&notificationsv1.GetNotificationPreferencesResponse{
-		Preferences: protoPreferences,
+		Preferences:    protoPreferences,
+		SmsPhoneNumber: phoneNumber,
 	}), nil
 }
 
@@ -930,6 +983,16 @@ func (s *Service) UpdateNotificationPreferences(ctx context.Context, req *connec
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
 	}
 
+	if req.Msg.SmsPhoneNumber != nil {
+		phoneNumber := strings.TrimSpace(req.Msg.GetSmsPhoneNumber())
+		if phoneNumber != "" && !e164PhoneNumber.MatchString(phoneNumber) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("sms_phone_number must be in E.164 format, e.g. +14155550123"))
+		}
+		if err := saveSMSPhoneNumber(ctx, user.Id, phoneNumber); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
 	// Process each preference update
 	updatedPreferences := make([]*notificationsv1.NotificationPreference, 0, len(req.Msg.Preferences))
 	for _, pref := range req.Msg.Preferences {
@@ -951,6 +1014,7 @@ func (s *Service) UpdateNotificationPreferences(ctx context.Context, req *connec
 					NotificationType: notificationTypeStr,
 					EmailEnabled:     pref.EmailEnabled,
 					InAppEnabled:     pref.InAppEnabled,
+					SMSEnabled:       pref.SmsEnabled,
 					Frequency:        notificationFrequencyToString(pref.Frequency),
 					MinSeverity:      notificationSeverityToString(pref.MinSeverity),
 				}
@@ -976,6 +1040,7 @@ func (s *Service) UpdateNotificationPreferences(ctx context.Context, req *connec
 						updateData := map[string]interface{}{
 							"email_enabled":  pref.EmailEnabled,
 							"in_app_enabled": pref.InAppEnabled,
+							"sms_enabled":    pref.SmsEnabled,
 							"frequency":      notificationFrequencyToString(pref.Frequency),
 							"min_severity":   notificationSeverityToString(pref.MinSeverity),
 						}
@@ -1006,6 +1071,7 @@ func (s *Service) UpdateNotificationPreferences(ctx context.Context, req *connec
 				updateData := map[string]interface{}{
 					"email_enabled":  pref.EmailEnabled,
 					"in_app_enabled": pref.InAppEnabled,
+					"sms_enabled":    pref.SmsEnabled,
 					"frequency":      notificationFrequencyToString(pref.Frequency),
 					"min_severity":   notificationSeverityToString(pref.MinSeverity),
 				}
@@ -1030,13 +1096,20 @@ func (s *Service) UpdateNotificationPreferences(ctx context.Context, req *connec
 			NotificationType: pref.NotificationType,
 			EmailEnabled:     finalPreference.EmailEnabled,
 			InAppEnabled:     finalPreference.InAppEnabled,
+			SmsEnabled:       finalPreference.SMSEnabled,
 			Frequency:        stringToNotificationFrequency(finalPreference.Frequency),
 			MinSeverity:      stringToNotificationSeverity(finalPreference.MinSeverity),
 		})
 	}
 
+	phoneNumber, err := loadSMSPhoneNumber(ctx, user.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	return connect.NewResponse(&notificationsv1.UpdateNotificationPreferencesResponse{
-		Preferences: updatedPreferences,
+		Preferences:    updatedPreferences,
+		SmsPhoneNumber: phoneNumber,
 	}), nil
 }
 
@@ -72,13 +72,15 @@ func newNotificationServiceTestDB(t *testing.T) *gorm.DB {
 	if err != nil {
 		t.Fatalf("open sqlite db: %v", err)
 	}
-	if err := db.AutoMigrate(&database.Notification{}); err != nil {
+	if err := db.AutoMigrate(&database.Notification{}, &database.NotificationPreference{}, &database.NotificationContact{}, &database.NotificationDigestPolicy{}, &database.WebhookDeliveryConfig{}, &database.NotificationDeliveryAttempt{}); err != nil {
 		t.Fatalf("migrate sqlite db: %v", err)
 	}
 
 	previousDB := database.DB
 	database.DB = db
 	t.Cleanup(func() {
+		// Webhook deliveries started by the test still write to db
+		webhookDeliveries.Wait()
 		database.DB = previousDB
 	})
 
@@ -0,0 +1,155 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// smsMaxLength keeps alerts to a few SMS segments
+const smsMaxLength = 320
+
+var e164PhoneNumber = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// twilioSender sends SMS through the Twilio Messages API
+type twilioSender struct {
+	accountSID string
+	authToken  string
+	fromNumber string
+	baseURL    string
+	client     *http.Client
+}
+
+var (
+	smsSenderOnce sync.Once
+	smsSender     *twilioSender
+)
+
+// getSMSSender returns the Twilio sender configured from TWILIO_ACCOUNT_SID, TWILIO_AUTH_TOKEN
+// and TWILIO_FROM_NUMBER
+func getSMSSender() *twilioSender {
+	smsSenderOnce.Do(func() {
+		smsSender = &twilioSender{
+			accountSID: strings.TrimSpace(os.Getenv("TWILIO_ACCOUNT_SID")),
+			authToken:  strings.TrimSpace(os.Getenv("TWILIO_AUTH_TOKEN")),
+			fromNumber: strings.TrimSpace(os.Getenv("TWILIO_FROM_NUMBER")),
+			baseURL:    "https://api.twilio.com",
+			client:     &http.Client{Timeout: 15 * time.Second},
+		}
+	})
+	return smsSender
+}
+
+// Enabled reports whether Twilio credentials are configured
+func (t *twilioSender) Enabled() bool {
+	return t.accountSID != "" && t.authToken != "" && t.fromNumber != ""
+}
+
+// Send sends body to the given E.164 number
+func (t *twilioSender) Send(ctx context.Context, to, body string) error {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", t.fromNumber)
+	form.Set("Body", body)
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", t.baseURL, url.PathEscape(t.accountSID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("build twilio request: %w", err)
+	}
+	req.SetBasicAuth(t.accountSID, t.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("call twilio: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("twilio returned %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// sendNotificationSMS texts a notification to the user's SMS number, if they have one
+func sendNotificationSMS(ctx context.Context, userID, title, message string) error {
+	sender := getSMSSender()
+	if !sender.Enabled() {
+		logger.Debug("[Notifications] SMS sender not enabled (Twilio not configured)")
+		return nil
+	}
+
+	phoneNumber, err := loadSMSPhoneNumber(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if phoneNumber == nil {
+		logger.Debug("[Notifications] User %s has no SMS number, skipping SMS", userID)
+		return nil
+	}
+
+	body := title + ": " + message
+	if len(body) > smsMaxLength {
+		body = body[:smsMaxLength-3] + "..."
+	}
+	if err := sender.Send(ctx, *phoneNumber, body); err != nil {
+		return fmt.Errorf("send sms: %w", err)
+	}
+
+	logger.Info("[Notifications] Sent SMS notification to user %s", userID)
+	return nil
+}
+
+// loadSMSPhoneNumber returns the user's SMS number, or nil if none is set
+func loadSMSPhoneNumber(ctx context.Context, userID string) (*string, error) {
+	var contact database.NotificationContact
+	err := database.DB.WithContext(ctx).Where("user_id = ?", userID).First(&contact).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && contact.SMSPhoneNumber == "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get notification contact: %w", err)
+	}
+	return &contact.SMSPhoneNumber, nil
+}
+
+// saveSMSPhoneNumber sets the user's SMS number; an empty number removes it
+func saveSMSPhoneNumber(ctx context.Context, userID, phoneNumber string) error {
+	if phoneNumber == "" {
+		if err := database.DB.WithContext(ctx).Where("user_id = ?", userID).Delete(&database.NotificationContact{}).Error; err != nil {
+			return fmt.Errorf("remove sms phone number: %w", err)
+		}
+		return nil
+	}
+
+	now := time.Now()
+	contact := &database.NotificationContact{
+		UserID:         userID,
+		SMSPhoneNumber: phoneNumber,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"sms_phone_number", "updated_at"}),
+	}).Create(contact).Error; err != nil {
+		return fmt.Errorf("save sms phone number: %w", err)
+	}
+	return nil
+}
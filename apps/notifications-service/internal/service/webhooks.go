@@ -0,0 +1,472 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/platform"
+
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	webhookChannelSlack   = "slack"
+	webhookChannelDiscord = "discord"
+
+	// webhookTimeout bounds each POST to a webhook
+	webhookTimeout = 5 * time.Second
+
+	// webhookMaxRetries is how often a failed delivery is retried before it is given up
+	webhookMaxRetries = 3
+
+	// webhookDeliveryTimeout bounds delivering one notification to all of an organization's webhooks
+	webhookDeliveryTimeout = 2 * time.Minute
+
+	// webhookMessageMaxLength keeps messages within Discord's content limit
+	webhookMessageMaxLength = 2000
+
+	// maxWebhookConfigs bounds the webhooks a single organization can add
+	maxWebhookConfigs = 10
+)
+
+var (
+	// webhookHTTPClient posts notifications to webhooks; tests replace its transport
+	webhookHTTPClient = &http.Client{Timeout: webhookTimeout}
+
+	// webhookRetryBackoff is the delay before the first retry; it doubles for each later retry
+	webhookRetryBackoff = time.Second
+
+	webhookDeliveries sync.WaitGroup
+)
+
+// validateWebhookURL checks that rawURL is an https Slack incoming webhook or Discord channel webhook
+func validateWebhookURL(channelType, rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("webhook_url must be a valid URL")
+	}
+	if parsed.Scheme != "https" || parsed.User != nil {
+		return fmt.Errorf("webhook_url must be an https URL without credentials")
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	switch channelType {
+	case webhookChannelSlack:
+		if host != "hooks.slack.com" || !strings.HasPrefix(parsed.Path, "/services/") {
+			return fmt.Errorf("slack webhook_url must look like https://hooks.slack.com/services/...")
+		}
+	case webhookChannelDiscord:
+		validHost := host == "discord.com" || host == "discordapp.com" || host == "ptb.discord.com" || host == "canary.discord.com"
+		if !validHost || !strings.HasPrefix(parsed.Path, "/api/webhooks/") {
+			return fmt.Errorf("discord webhook_url must look like https://discord.com/api/webhooks/...")
+		}
+	default:
+		return fmt.Errorf("unknown webhook channel type %q", channelType)
+	}
+	return nil
+}
+
+// redactWebhookURL hides the secret part of a webhook URL, keeping the host and the last characters
+func redactWebhookURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	suffix := rawURL
+	if len(suffix) > 4 {
+		suffix = suffix[len(suffix)-4:]
+	}
+	return fmt.Sprintf("%s://%s/…%s", parsed.Scheme, parsed.Host, suffix)
+}
+
+// webhookPayload formats a notification as a Slack or Discord message body
+func webhookPayload(channelType string, notification *database.Notification, severity notificationsv1.NotificationSeverity) ([]byte, error) {
+	var actionLink string
+	if notification.ActionURL != nil && *notification.ActionURL != "" {
+		actionLink = *notification.ActionURL
+		if strings.HasPrefix(actionLink, "/") {
+			actionLink = platform.DashboardURL() + actionLink
+		}
+	}
+	actionLabel := "View details"
+	if notification.ActionLabel != nil && *notification.ActionLabel != "" {
+		actionLabel = *notification.ActionLabel
+	}
+	severityStr := notificationSeverityToString(severity)
+
+	var text string
+	var payload map[string]string
+	switch channelType {
+	case webhookChannelSlack:
+		text = fmt.Sprintf("*[%s] %s*\n%s", severityStr, notification.Title, notification.Message)
+		if actionLink != "" {
+			text += fmt.Sprintf("\n<%s|%s>", actionLink, actionLabel)
+		}
+		payload = map[string]string{"text": truncateWebhookMessage(text)}
+	case webhookChannelDiscord:
+		text = fmt.Sprintf("**[%s] %s**\n%s", severityStr, notification.Title, notification.Message)
+		if actionLink != "" {
+			text += fmt.Sprintf("\n%s: %s", actionLabel, actionLink)
+		}
+		payload = map[string]string{"content": truncateWebhookMessage(text)}
+	default:
+		return nil, fmt.Errorf("unknown webhook channel type %q", channelType)
+	}
+	return json.Marshal(payload)
+}
+
+func truncateWebhookMessage(text string) string {
+	if len(text) <= webhookMessageMaxLength {
+		return text
+	}
+	return text[:webhookMessageMaxLength-3] + "..."
+}
+
+// postWebhook POSTs a JSON payload to a webhook and returns the response status code
+func postWebhook(ctx context.Context, webhookURL string, payload []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhookHTTPClient.Do(req)
+	if err != nil {
+		// The URL embeds the webhook secret, so keep it out of the error
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return 0, fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return resp.StatusCode, fmt.Errorf("webhook returned %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return resp.StatusCode, nil
+}
+
+// retryableWebhookStatus reports whether a failed POST is worth retrying: network errors,
+// rate limiting and server errors are; other client errors will not go away
+func retryableWebhookStatus(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// deliverWebhook posts a notification to one webhook, retrying with exponential backoff, and
+// records every attempt in notification_delivery_attempts
+func deliverWebhook(ctx context.Context, db *gorm.DB, config *database.WebhookDeliveryConfig, notificationID string, payload []byte) error {
+	backoff := webhookRetryBackoff
+	for attempt := 1; ; attempt++ {
+		statusCode, err := postWebhook(ctx, config.WebhookURL, payload)
+		recordDeliveryAttempt(ctx, db, config, notificationID, attempt, statusCode, err)
+		if err == nil {
+			return nil
+		}
+		if attempt > webhookMaxRetries || !retryableWebhookStatus(statusCode) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+			backoff *= 2
+		}
+	}
+}
+
+func recordDeliveryAttempt(ctx context.Context, db *gorm.DB, config *database.WebhookDeliveryConfig, notificationID string, attempt, statusCode int, deliveryErr error) {
+	record := &database.NotificationDeliveryAttempt{
+		ID:              generateID("notif-delivery"),
+		NotificationID:  notificationID,
+		WebhookConfigID: config.ID,
+		OrganizationID:  config.OrganizationID,
+		ChannelType:     config.ChannelType,
+		Attempt:         attempt,
+		Status:          "delivered",
+		StatusCode:      statusCode,
+		CreatedAt:       time.Now(),
+	}
+	if deliveryErr != nil {
+		record.Status = "failed"
+		record.Error = deliveryErr.Error()
+	}
+	if err := db.WithContext(ctx).Create(record).Error; err != nil {
+		logger.Warn("[Notifications] Failed to record delivery attempt for webhook %s: %v", config.ID, err)
+	}
+}
+
+// webhookNotifiesSeverity reports whether a webhook receives notifications of the given severity
+func webhookNotifiesSeverity(config *database.WebhookDeliveryConfig, severity notificationsv1.NotificationSeverity) bool {
+	severities := webhookSeverities(config)
+	return len(severities) == 0 || slices.Contains(severities, notificationSeverityToString(severity))
+}
+
+func webhookSeverities(config *database.WebhookDeliveryConfig) []string {
+	var severities []string
+	if config.NotifySeverities != "" {
+		if err := json.Unmarshal([]byte(config.NotifySeverities), &severities); err != nil {
+			logger.Warn("[Notifications] Invalid severities for webhook %s: %v", config.ID, err)
+		}
+	}
+	return severities
+}
+
+// dispatchWebhookDelivery posts a notification to its organization's matching webhooks in the background.
+// The database handle is taken now so the delivery doesn't depend on database.DB after the caller returns.
+func dispatchWebhookDelivery(notification *database.Notification, severity notificationsv1.NotificationSeverity) {
+	if notification == nil || notification.OrganizationID == nil {
+		return
+	}
+	db := database.DB
+	if db == nil {
+		return
+	}
+
+	webhookDeliveries.Add(1)
+	go func() {
+		defer webhookDeliveries.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), webhookDeliveryTimeout)
+		defer cancel()
+		deliverNotificationWebhooks(ctx, db, notification, severity)
+	}()
+}
+
+func deliverNotificationWebhooks(ctx context.Context, db *gorm.DB, notification *database.Notification, severity notificationsv1.NotificationSeverity) {
+	orgID := *notification.OrganizationID
+
+	var configs []database.WebhookDeliveryConfig
+	if err := db.WithContext(ctx).Where("organization_id = ?", orgID).Find(&configs).Error; err != nil {
+		logger.Warn("[Notifications] Failed to load webhooks for organization %s: %v", orgID, err)
+		return
+	}
+
+	for i := range configs {
+		config := &configs[i]
+		if !webhookNotifiesSeverity(config, severity) {
+			continue
+		}
+		payload, err := webhookPayload(config.ChannelType, notification, severity)
+		if err != nil {
+			logger.Warn("[Notifications] Skipping webhook %s: %v", config.ID, err)
+			continue
+		}
+		if err := deliverWebhook(ctx, db, config, notification.ID, payload); err != nil {
+			logger.Warn("[Notifications] Failed to deliver notification %s to %s webhook %s: %v", notification.ID, config.ChannelType, config.ID, err)
+			continue
+		}
+		logger.Debug("[Notifications] Delivered notification %s to %s webhook %s", notification.ID, config.ChannelType, config.ID)
+	}
+}
+
+// ShutdownWebhookDelivery waits for in-flight webhook deliveries to finish
+func ShutdownWebhookDelivery(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		webhookDeliveries.Wait()
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		logger.Warn("[Notifications] Timed out waiting for webhook deliveries to finish")
+	}
+}
+
+// CreateWebhookConfig adds a Slack or Discord webhook for an organization's notifications after
+// posting a test message to it
+func (s *Service) CreateWebhookConfig(ctx context.Context, req *connect.Request[notificationsv1.CreateWebhookConfigRequest]) (*connect.Response[notificationsv1.CreateWebhookConfigResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	orgID := req.Msg.GetOrganizationId()
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := auth.CheckScopedPermissionWithError(ctx, s.permissionChecker, orgID, auth.ScopedPermission{
+		Permission:   auth.PermissionOrganizationUpdate,
+		ResourceType: "organization",
+		ResourceID:   orgID,
+	}); err != nil {
+		return nil, err
+	}
+
+	channelType, err := webhookChannelTypeToString(req.Msg.GetChannelType())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	webhookURL := strings.TrimSpace(req.Msg.GetWebhookUrl())
+	if err := validateWebhookURL(channelType, webhookURL); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	severities := make([]string, 0, len(req.Msg.GetNotifySeverities()))
+	for _, severity := range req.Msg.GetNotifySeverities() {
+		if severity == notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_UNSPECIFIED {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("notify_severities must not contain NOTIFICATION_SEVERITY_UNSPECIFIED"))
+		}
+		if severityStr := notificationSeverityToString(severity); !slices.Contains(severities, severityStr) {
+			severities = append(severities, severityStr)
+		}
+	}
+	severitiesJSON, err := json.Marshal(severities)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("marshal notify severities: %w", err))
+	}
+
+	var count int64
+	if err := database.DB.WithContext(ctx).Model(&database.WebhookDeliveryConfig{}).Where("organization_id = ?", orgID).Count(&count).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("count webhooks: %w", err))
+	}
+	if count >= maxWebhookConfigs {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("an organization can have at most %d notification webhooks", maxWebhookConfigs))
+	}
+
+	// Post a sample message so a mistyped or revoked webhook is caught now rather than on the next alert
+	sample := &database.Notification{
+		Title:   "Webhook connected",
+		Message: "Notifications for this organization will be posted here.",
+	}
+	payload, err := webhookPayload(channelType, sample, notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_LOW)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if _, err := postWebhook(ctx, webhookURL, payload); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("test delivery failed: %w", err))
+	}
+
+	now := time.Now()
+	config := &database.WebhookDeliveryConfig{
+		ID:               generateID("notif-webhook"),
+		OrganizationID:   orgID,
+		ChannelType:      channelType,
+		WebhookURL:       webhookURL,
+		NotifySeverities: string(severitiesJSON),
+		CreatedBy:        user.Id,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := database.DB.WithContext(ctx).Create(config).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create webhook: %w", err))
+	}
+
+	logger.Info("[Notifications] Added %s webhook %s for organization %s", channelType, config.ID, orgID)
+
+	return connect.NewResponse(&notificationsv1.CreateWebhookConfigResponse{
+		Config: webhookConfigToProto(config),
+	}), nil
+}
+
+// DeleteWebhookConfig removes a notification webhook from an organization
+func (s *Service) DeleteWebhookConfig(ctx context.Context, req *connect.Request[notificationsv1.DeleteWebhookConfigRequest]) (*connect.Response[notificationsv1.DeleteWebhookConfigResponse], error) {
+	orgID := req.Msg.GetOrganizationId()
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := auth.CheckScopedPermissionWithError(ctx, s.permissionChecker, orgID, auth.ScopedPermission{
+		Permission:   auth.PermissionOrganizationUpdate,
+		ResourceType: "organization",
+		ResourceID:   orgID,
+	}); err != nil {
+		return nil, err
+	}
+
+	result := database.DB.WithContext(ctx).
+		Where("id = ? AND organization_id = ?", req.Msg.GetConfigId(), orgID).
+		Delete(&database.WebhookDeliveryConfig{})
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("delete webhook: %w", result.Error))
+	}
+	if result.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("webhook %s not found", req.Msg.GetConfigId()))
+	}
+
+	return connect.NewResponse(&notificationsv1.DeleteWebhookConfigResponse{Success: true}), nil
+}
+
+// ListWebhookConfigs lists an organization's notification webhooks with their URLs redacted
+func (s *Service) ListWebhookConfigs(ctx context.Context, req *connect.Request[notificationsv1.ListWebhookConfigsRequest]) (*connect.Response[notificationsv1.ListWebhookConfigsResponse], error) {
+	orgID := req.Msg.GetOrganizationId()
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := auth.CheckScopedPermissionWithError(ctx, s.permissionChecker, orgID, auth.ScopedPermission{
+		Permission:   auth.PermissionOrganizationRead,
+		ResourceType: "organization",
+		ResourceID:   orgID,
+	}); err != nil {
+		return nil, err
+	}
+
+	var configs []database.WebhookDeliveryConfig
+	if err := database.DB.WithContext(ctx).Where("organization_id = ?", orgID).Order("created_at ASC").Find(&configs).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list webhooks: %w", err))
+	}
+
+	out := make([]*notificationsv1.WebhookConfig, len(configs))
+	for i := range configs {
+		out[i] = webhookConfigToProto(&configs[i])
+	}
+	return connect.NewResponse(&notificationsv1.ListWebhookConfigsResponse{Configs: out}), nil
+}
+
+func webhookConfigToProto(config *database.WebhookDeliveryConfig) *notificationsv1.WebhookConfig {
+	channelType := notificationsv1.WebhookChannelType_WEBHOOK_CHANNEL_TYPE_UNSPECIFIED
+	switch config.ChannelType {
+	case webhookChannelSlack:
+		channelType = notificationsv1.WebhookChannelType_WEBHOOK_CHANNEL_TYPE_SLACK
+	case webhookChannelDiscord:
+		channelType = notificationsv1.WebhookChannelType_WEBHOOK_CHANNEL_TYPE_DISCORD
+	}
+
+	severities := webhookSeverities(config)
+	notifySeverities := make([]notificationsv1.NotificationSeverity, len(severities))
+	for i, severity := range severities {
+		notifySeverities[i] = stringToNotificationSeverity(severity)
+	}
+
+	return &notificationsv1.WebhookConfig{
+		Id:               config.ID,
+		OrganizationId:   config.OrganizationID,
+		ChannelType:      channelType,
+		WebhookUrl:       redactWebhookURL(config.WebhookURL),
+		NotifySeverities: notifySeverities,
+		CreatedAt:        timestamppb.New(config.CreatedAt),
+	}
+}
+
+func webhookChannelTypeToString(channelType notificationsv1.WebhookChannelType) (string, error) {
+	switch channelType {
+	case notificationsv1.WebhookChannelType_WEBHOOK_CHANNEL_TYPE_SLACK:
+		return webhookChannelSlack, nil
+	case notificationsv1.WebhookChannelType_WEBHOOK_CHANNEL_TYPE_DISCORD:
+		return webhookChannelDiscord, nil
+	default:
+		return "", fmt.Errorf("channel_type must be slack or discord")
+	}
+}
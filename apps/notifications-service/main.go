@@ -50,6 +50,10 @@ func main() {
 		&database.Organization{},
 		&database.OrganizationMember{},
 		&database.NotificationPreference{},
+		&database.NotificationContact{},
+		&database.NotificationDigestPolicy{},
+		&database.WebhookDeliveryConfig{},
+		&database.NotificationDeliveryAttempt{},
 	)
 
 	// Initialize database
@@ -58,6 +62,13 @@ func main() {
 	}
 	logger.Info("✓ Database initialized")
 
+	// Initialize Redis (for real-time notification push)
+	if err := database.InitRedis(); err != nil {
+		logger.Warn("Redis initialization failed: %v. Real-time notifications will not work.", err)
+	} else {
+		logger.Info("✓ Redis initialized")
+	}
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "3012"
@@ -96,6 +107,9 @@ func main() {
 	)
 	mux.Handle(notificationsPath, notificationsHandler)
 
+	// WebSocket endpoint for real-time notification delivery
+	mux.HandleFunc("/notifications/ws", notificationsService.HandleNotificationsWebSocket)
+
 	// Health check endpoint
 	mux.HandleFunc("/health", health.HandleHealth("notifications-service", func() (bool, string, map[string]interface{}) {
 		// Check database connection
@@ -152,10 +166,15 @@ func main() {
 		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 		defer cancel()
 
+		// Stop taking requests first so no delivery starts after the background work has drained
+		serverShutdownErr := httpServer.Shutdown(ctx)
+
+		notificationsservice.ShutdownDigestAggregator(ctx)
 		notificationsservice.ShutdownAsyncEmailDispatcher(ctx)
+		notificationsservice.ShutdownWebhookDelivery(ctx)
 
-		if err := httpServer.Shutdown(ctx); err != nil {
-			logger.Warn("Error during server shutdown: %v", err)
+		if serverShutdownErr != nil {
+			logger.Warn("Error during server shutdown: %v", serverShutdownErr)
 		} else {
 			logger.Info(gracefulShutdownMessage)
 		}
@@ -0,0 +1,115 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	shared "github.com/obiente/cloud/apps/shared/pkg/orchestrator"
+
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+)
+
+const (
+	autoScalerInterval = 30 * time.Second
+	// autoScalerCPUWindow is how far back CPU usage is averaged for a scaling decision
+	autoScalerCPUWindow = 2 * time.Minute
+)
+
+// AutoScaler applies deployment auto-scaling policies: every 30 seconds it compares each running
+// deployment's average CPU usage per replica with its policy's target and scales it accordingly
+type AutoScaler struct {
+	deploymentManager *shared.DeploymentManager
+}
+
+// NewAutoScaler creates an auto-scaler
+func NewAutoScaler(deploymentManager *shared.DeploymentManager) *AutoScaler {
+	return &AutoScaler{deploymentManager: deploymentManager}
+}
+
+// Run evaluates the policies every 30 seconds until ctx is done
+func (as *AutoScaler) Run(ctx context.Context) {
+	ticker := time.NewTicker(autoScalerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			as.evaluatePolicies(ctx, time.Now())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (as *AutoScaler) evaluatePolicies(ctx context.Context, now time.Time) {
+	policies, err := database.ListEnabledDeploymentAutoScalePolicies()
+	if err != nil {
+		logger.Warn("[AutoScaler] Failed to list auto-scaling policies: %v", err)
+		return
+	}
+
+	for i := range policies {
+		if ctx.Err() != nil {
+			return
+		}
+		as.evaluatePolicy(ctx, &policies[i], now)
+	}
+}
+
+func (as *AutoScaler) evaluatePolicy(ctx context.Context, policy *database.DeploymentAutoScalePolicy, now time.Time) {
+	deploymentID := policy.DeploymentID
+
+	var deployment database.Deployment
+	if err := database.DB.Select("id", "status", "replicas", "compose_yaml").Where("id = ?", deploymentID).First(&deployment).Error; err != nil {
+		logger.Warn("[AutoScaler] Failed to get deployment %s: %v", deploymentID, err)
+		return
+	}
+	// Stopped deployments stay stopped, and deployments that are building or failing report no
+	// representative load
+	if deployment.Status != int32(deploymentsv1.DeploymentStatus_RUNNING) || deployment.ComposeYaml != "" {
+		return
+	}
+	current := int32(1)
+	if deployment.Replicas != nil {
+		current = *deployment.Replicas
+	}
+	if current <= 0 {
+		return
+	}
+
+	cpu, samples, err := database.GetDeploymentAverageCPUUsage(deploymentID, now.Add(-autoScalerCPUWindow))
+	if err != nil {
+		logger.Warn("[AutoScaler] %v", err)
+		return
+	}
+	if samples == 0 {
+		return
+	}
+
+	desired, ok := shared.AutoScalePolicyFromDatabase(policy).NextReplicas(current, cpu, policy.LastScaledAt, now)
+	if !ok {
+		return
+	}
+
+	// Another orchestrator instance may have scaled the deployment already
+	claimed, err := database.ClaimDeploymentAutoScale(deploymentID, policy.LastScaledAt, now)
+	if err != nil {
+		logger.Warn("[AutoScaler] %v", err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	scaleCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	err = as.deploymentManager.SetDeploymentReplicas(scaleCtx, deploymentID, desired)
+	cancel()
+	if err != nil {
+		logger.Error("[AutoScaler] Failed to scale deployment %s from %d to %d replica(s): %v", deploymentID, current, desired, err)
+		return
+	}
+	logger.Info("[AutoScaler] Scaled deployment %s from %d to %d replica(s) (average CPU %.1f%%, target %.1f%%)",
+		deploymentID, current, desired, cpu, policy.TargetCPUPercent)
+}
@@ -0,0 +1,197 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/notifications"
+	shared "github.com/obiente/cloud/apps/shared/pkg/orchestrator"
+
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+)
+
+// Internal HTTP endpoints for node maintenance, called by the superadmin service
+
+// InternalServiceSecretHeader carries the shared secret on internal service requests
+const InternalServiceSecretHeader = "x-internal-service-secret"
+
+type nodeMaintenanceRequest struct {
+	NodeID      string `json:"node_id"`
+	RequestedBy string `json:"requested_by"`
+}
+
+type nodeMaintenanceResponse struct {
+	NodeID  string `json:"node_id"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// RequireInternalServiceSecret rejects requests that don't carry the internal service secret
+func RequireInternalServiceSecret(secret string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		provided := r.Header.Get(InternalServiceSecretHeader)
+		if provided == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(secret)) != 1 {
+			writeNodeMaintenanceError(w, http.StatusUnauthorized, "invalid "+InternalServiceSecretHeader)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// HandleDrainNode starts draining a node and returns 202 once the node is set to drain.
+// The wait for containers to leave the node runs in the background and the requesting
+// superadmin is notified when it completes or times out.
+func (os *OrchestratorService) HandleDrainNode(w http.ResponseWriter, r *http.Request) {
+	req, node, ok := os.decodeNodeMaintenanceRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if !os.startDrain(node.ID) {
+		writeNodeMaintenanceError(w, http.StatusConflict, fmt.Sprintf("node %s is already draining", node.ID))
+		return
+	}
+
+	go func() {
+		defer os.finishDrain(node.ID)
+		err := os.deploymentManager.DrainNode(os.ctx, node.ID)
+		if err != nil {
+			logger.Warn("[Orchestrator] Drain of node %s failed: %v", node.ID, err)
+		}
+		os.notifyNodeDrainResult(req.RequestedBy, node, err)
+	}()
+
+	writeNodeMaintenanceJSON(w, http.StatusAccepted, nodeMaintenanceResponse{
+		NodeID:  node.ID,
+		Status:  database.ClusterNodeStatusDraining,
+		Message: fmt.Sprintf("Draining node %s; you will be notified when all containers have moved", node.Hostname),
+	})
+}
+
+// HandleEnableNode sets a drained node back to active
+func (os *OrchestratorService) HandleEnableNode(w http.ResponseWriter, r *http.Request) {
+	_, node, ok := os.decodeNodeMaintenanceRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if os.isDraining(node.ID) {
+		writeNodeMaintenanceError(w, http.StatusConflict, fmt.Sprintf("node %s is still draining", node.ID))
+		return
+	}
+
+	if err := os.deploymentManager.EnableNode(r.Context(), node.ID); err != nil {
+		logger.Warn("[Orchestrator] Failed to enable node %s: %v", node.ID, err)
+		writeNodeMaintenanceError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeNodeMaintenanceJSON(w, http.StatusOK, nodeMaintenanceResponse{
+		NodeID:  node.ID,
+		Status:  database.ClusterNodeStatusActive,
+		Message: fmt.Sprintf("Node %s is accepting deployments again", node.Hostname),
+	})
+}
+
+// decodeNodeMaintenanceRequest parses the request body and looks up the node,
+// writing an error response and returning false if either fails
+func (os *OrchestratorService) decodeNodeMaintenanceRequest(w http.ResponseWriter, r *http.Request) (nodeMaintenanceRequest, *database.NodeMetadata, bool) {
+	var req nodeMaintenanceRequest
+	if r.Method != http.MethodPost {
+		writeNodeMaintenanceError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return req, nil, false
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<16)).Decode(&req); err != nil {
+		writeNodeMaintenanceError(w, http.StatusBadRequest, "invalid request body")
+		return req, nil, false
+	}
+	if req.NodeID == "" {
+		writeNodeMaintenanceError(w, http.StatusBadRequest, "node_id is required")
+		return req, nil, false
+	}
+
+	var node database.NodeMetadata
+	if err := database.DB.Where("id = ?", req.NodeID).First(&node).Error; err != nil {
+		writeNodeMaintenanceError(w, http.StatusNotFound, fmt.Sprintf("node %s not found", req.NodeID))
+		return req, nil, false
+	}
+	if err := os.deploymentManager.CanDrainNode(node.ID); err != nil {
+		writeNodeMaintenanceError(w, http.StatusBadRequest, err.Error())
+		return req, nil, false
+	}
+	return req, &node, true
+}
+
+func (os *OrchestratorService) startDrain(nodeID string) bool {
+	os.drainingMu.Lock()
+	defer os.drainingMu.Unlock()
+	if os.draining[nodeID] {
+		return false
+	}
+	os.draining[nodeID] = true
+	return true
+}
+
+func (os *OrchestratorService) finishDrain(nodeID string) {
+	os.drainingMu.Lock()
+	defer os.drainingMu.Unlock()
+	delete(os.draining, nodeID)
+}
+
+func (os *OrchestratorService) isDraining(nodeID string) bool {
+	os.drainingMu.Lock()
+	defer os.drainingMu.Unlock()
+	return os.draining[nodeID]
+}
+
+// notifyNodeDrainResult tells the superadmin who requested a drain how it ended
+func (os *OrchestratorService) notifyNodeDrainResult(userID string, node *database.NodeMetadata, drainErr error) {
+	if userID == "" {
+		return
+	}
+	if errors.Is(drainErr, context.Canceled) {
+		// The orchestrator is shutting down; the drain will not complete
+		return
+	}
+
+	severity := notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_MEDIUM
+	title := fmt.Sprintf("Node %s drained", node.Hostname)
+	message := fmt.Sprintf("All containers have moved off node %s. It can now be taken down for maintenance.", node.Hostname)
+	switch {
+	case errors.Is(drainErr, shared.ErrNodeDrainTimeout):
+		severity = notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH
+		title = fmt.Sprintf("Drain of node %s timed out", node.Hostname)
+		message = fmt.Sprintf("Containers were still running on node %s after 10 minutes. The node remains in drain mode.", node.Hostname)
+	case drainErr != nil:
+		severity = notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH
+		title = fmt.Sprintf("Drain of node %s failed", node.Hostname)
+		message = fmt.Sprintf("Node %s could not be drained: %v", node.Hostname, drainErr)
+	}
+
+	metadata := map[string]string{
+		"node_id":  node.ID,
+		"hostname": node.Hostname,
+	}
+	if err := notifications.CreateNotificationForUser(context.Background(), userID, nil,
+		notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM, severity,
+		title, message, nil, nil, metadata,
+	); err != nil {
+		logger.Warn("[Orchestrator] Failed to notify %s about drain of node %s: %v", userID, node.ID, err)
+	}
+}
+
+func writeNodeMaintenanceJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeNodeMaintenanceError(w http.ResponseWriter, status int, message string) {
+	writeNodeMaintenanceJSON(w, status, map[string]string{"error": message})
+}
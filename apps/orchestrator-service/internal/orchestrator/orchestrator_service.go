@@ -28,6 +28,10 @@ type OrchestratorService struct {
 	syncInterval      time.Duration
 	ctx               context.Context
 	cancel            context.CancelFunc
+
+	// draining holds the IDs of nodes with a drain in progress
+	drainingMu sync.Mutex
+	draining   map[string]bool
 }
 
 // containerStats is now defined in shared package as ContainerStats
@@ -73,6 +77,7 @@ func NewOrchestratorService(strategy string, maxDeploymentsPerNode int, syncInte
 		syncInterval:      syncInterval,
 		ctx:               ctx,
 		cancel:            cancel,
+		draining:          make(map[string]bool),
 	}
 
 	// Register global orchestrator service for access from other services
@@ -82,6 +87,11 @@ func NewOrchestratorService(strategy string, maxDeploymentsPerNode int, syncInte
 	return service, nil
 }
 
+// NodeSelectionStrategy returns the name of the active node selection strategy
+func (os *OrchestratorService) NodeSelectionStrategy() string {
+	return os.deploymentManager.NodeSelectionStrategy()
+}
+
 func (os *OrchestratorService) Start() {
 	logger.Info("[Orchestrator] Starting orchestration service...")
 
@@ -139,6 +149,14 @@ func (os *OrchestratorService) Start() {
 	go os.syncNodeMetadataPeriodically()
 	logger.Debug("[Orchestrator] Started periodic node metadata sync")
 
+	// Start scheduled deployment scaling (every minute)
+	go NewScaleScheduler(os.deploymentManager).Run(os.ctx)
+	logger.Debug("[Orchestrator] Started deployment scale scheduler")
+
+	// Start CPU-based deployment auto-scaling (every 30 seconds)
+	go NewAutoScaler(os.deploymentManager).Run(os.ctx)
+	logger.Debug("[Orchestrator] Started deployment auto-scaler")
+
 	// Restore running deployments from database on startup
 	go os.restoreRunningDeployments()
 	logger.Debug("[Orchestrator] Started restoration of running deployments")
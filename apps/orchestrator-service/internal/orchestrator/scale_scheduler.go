@@ -0,0 +1,148 @@
+package orchestrator
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	shared "github.com/obiente/cloud/apps/shared/pkg/orchestrator"
+
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+)
+
+const (
+	scaleSchedulerInterval = time.Minute
+	// scaleScheduleCatchUp is how far back a missed run (e.g. while the orchestrator restarted) is still applied
+	scaleScheduleCatchUp = 5 * time.Minute
+)
+
+// ScaleScheduler applies deployment scale schedules: each minute it sets the replica count of every
+// deployment with a schedule that came due since its last run
+type ScaleScheduler struct {
+	deploymentManager *shared.DeploymentManager
+	dryRun            bool
+
+	// dryRunLogged holds the last run logged per schedule in dry-run mode, where runs are not recorded
+	dryRunLogged map[string]time.Time
+}
+
+// NewScaleScheduler creates a scale scheduler. With SCALE_SCHEDULER_DRY_RUN=true it only logs the
+// scaling it would do.
+func NewScaleScheduler(deploymentManager *shared.DeploymentManager) *ScaleScheduler {
+	return &ScaleScheduler{
+		deploymentManager: deploymentManager,
+		dryRun:            strings.EqualFold(os.Getenv("SCALE_SCHEDULER_DRY_RUN"), "true"),
+		dryRunLogged:      make(map[string]time.Time),
+	}
+}
+
+// Run evaluates the schedules every minute until ctx is done
+func (ss *ScaleScheduler) Run(ctx context.Context) {
+	if ss.dryRun {
+		logger.Info("[ScaleScheduler] Dry-run mode: scale schedules are logged but not applied")
+	}
+	ticker := time.NewTicker(scaleSchedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ss.runDueSchedules(ctx, time.Now())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+type dueScaleSchedule struct {
+	schedule database.DeploymentScaleSchedule
+	runAt    time.Time
+}
+
+func (ss *ScaleScheduler) runDueSchedules(ctx context.Context, now time.Time) {
+	schedules, err := database.ListActiveDeploymentScaleSchedules()
+	if err != nil {
+		logger.Warn("[ScaleScheduler] Failed to list scale schedules: %v", err)
+		return
+	}
+
+	// When several schedules of a deployment are due, the one that came due last wins
+	due := make(map[string]dueScaleSchedule)
+	for _, schedule := range schedules {
+		runAt, ok := ss.dueRun(schedule, now)
+		if !ok {
+			continue
+		}
+		if current, exists := due[schedule.DeploymentID]; !exists || runAt.After(current.runAt) {
+			due[schedule.DeploymentID] = dueScaleSchedule{schedule: schedule, runAt: runAt}
+		}
+	}
+
+	for deploymentID, run := range due {
+		schedule := run.schedule
+		if ss.dryRun {
+			ss.dryRunLogged[schedule.ID] = run.runAt
+			logger.Info("[ScaleScheduler] Dry run: would scale deployment %s to %d replica(s) (schedule %s, %q)",
+				deploymentID, schedule.ReplicaCount, schedule.ID, schedule.CronExpression)
+			continue
+		}
+
+		// Another orchestrator instance may have applied the run already
+		claimed, err := database.ClaimDeploymentScaleScheduleRun(schedule.ID, schedule.LastRunAt, run.runAt)
+		if err != nil {
+			logger.Warn("[ScaleScheduler] %v", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		scaleCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+		err = ss.deploymentManager.SetDeploymentReplicas(scaleCtx, deploymentID, schedule.ReplicaCount)
+		cancel()
+		if err != nil {
+			logger.Error("[ScaleScheduler] Failed to scale deployment %s to %d replica(s) (schedule %s): %v",
+				deploymentID, schedule.ReplicaCount, schedule.ID, err)
+			continue
+		}
+
+		status := deploymentsv1.DeploymentStatus_RUNNING
+		if schedule.ReplicaCount == 0 {
+			status = deploymentsv1.DeploymentStatus_STOPPED
+		}
+		if err := database.DB.Model(&database.Deployment{}).Where("id = ?", deploymentID).Update("status", int32(status)).Error; err != nil {
+			logger.Warn("[ScaleScheduler] Failed to update status of deployment %s: %v", deploymentID, err)
+		}
+		logger.Info("[ScaleScheduler] Scaled deployment %s to %d replica(s) (schedule %s, %q)",
+			deploymentID, schedule.ReplicaCount, schedule.ID, schedule.CronExpression)
+	}
+}
+
+// dueRun returns the latest time at or before now that the schedule matched and that has not run yet
+func (ss *ScaleScheduler) dueRun(schedule database.DeploymentScaleSchedule, now time.Time) (time.Time, bool) {
+	cron, err := shared.ParseCronExpression(schedule.CronExpression)
+	if err != nil {
+		logger.Warn("[ScaleScheduler] Skipping schedule %s with invalid cron expression %q: %v", schedule.ID, schedule.CronExpression, err)
+		return time.Time{}, false
+	}
+
+	from := schedule.CreatedAt
+	if schedule.LastRunAt != nil {
+		from = *schedule.LastRunAt
+	}
+	if logged, ok := ss.dryRunLogged[schedule.ID]; ok && logged.After(from) {
+		from = logged
+	}
+	if earliest := now.Add(-scaleScheduleCatchUp); from.Before(earliest) {
+		from = earliest
+	}
+
+	var runAt time.Time
+	for next := cron.Next(from); !next.IsZero() && !next.After(now); next = cron.Next(next) {
+		runAt = next
+	}
+	return runAt, !runAt.IsZero()
+}
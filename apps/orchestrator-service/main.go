@@ -69,11 +69,16 @@ func main() {
 		}
 	}
 
-	orchService, err := orchestrator.NewOrchestratorService("least-loaded", 50, syncInterval)
+	nodeStrategy := os.Getenv("ORCHESTRATOR_NODE_STRATEGY")
+	if nodeStrategy == "" {
+		nodeStrategy = "least-loaded"
+	}
+
+	orchService, err := orchestrator.NewOrchestratorService(nodeStrategy, 50, syncInterval)
 	if err != nil {
 		logger.Fatalf("failed to initialize orchestrator service: %v", err)
 	}
-	logger.Info("✓ Orchestrator service initialized")
+	logger.Info("✓ Orchestrator service initialized (node selection strategy: %s)", orchService.NodeSelectionStrategy())
 
 	// Start orchestrator service
 	orchService.Start()
@@ -102,9 +107,18 @@ func main() {
 			return false, "orchestrator service unavailable", extra
 		}
 		extra["orchestrator"] = "healthy"
+		extra["node_selection_strategy"] = orchService.NodeSelectionStrategy()
 		return true, "healthy", extra
 	}))
 
+	// Internal node maintenance endpoints (called by superadmin-service)
+	if internalServiceSecret := os.Getenv("INTERNAL_SERVICE_SECRET"); internalServiceSecret != "" {
+		mux.Handle("/internal/nodes/drain", orchestrator.RequireInternalServiceSecret(internalServiceSecret, http.HandlerFunc(orchService.HandleDrainNode)))
+		mux.Handle("/internal/nodes/enable", orchestrator.RequireInternalServiceSecret(internalServiceSecret, http.HandlerFunc(orchService.HandleEnableNode)))
+	} else {
+		logger.Warn("INTERNAL_SERVICE_SECRET not set - node drain/enable endpoints are disabled")
+	}
+
 	// Root endpoint
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/" {
@@ -0,0 +1,97 @@
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGetOrganizationActivityFeedPagesAndFilters(t *testing.T) {
+	db := newTestDB(t, &database.OrganizationMember{}, &database.OrgActivityEvent{})
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := []any{
+		&database.OrganizationMember{ID: "member-owner", OrganizationID: "org-a", UserID: "user-owner", Role: auth.SystemRoleIDOwner, Status: "active", JoinedAt: base},
+		&database.OrganizationMember{ID: "member-viewer", OrganizationID: "org-a", UserID: "user-viewer", Role: auth.SystemRoleIDViewer, Status: "active", JoinedAt: base},
+		&database.OrgActivityEvent{ID: "event-other-org", OrganizationID: "org-b", EventType: "deployment.created", ActorID: "user-owner", ResourceType: "deployment", Metadata: "{}", CreatedAt: base.Add(time.Hour)},
+	}
+	// Five events of org-a, the last two created in the same instant
+	for i, eventType := range []string{"deployment.created", "vps.created", "deployment.deleted", "member.created", "deployment.updated"} {
+		actor := "user-owner"
+		if i%2 == 1 {
+			actor = "user-admin"
+		}
+		createdAt := base.Add(time.Duration(min(i, 3)) * time.Minute)
+		records = append(records, &database.OrgActivityEvent{ID: fmt.Sprintf("event-%d", i), OrganizationID: "org-a", EventType: eventType, ActorID: actor, ResourceType: "deployment", Metadata: `{"action":"test"}`, CreatedAt: createdAt})
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	service := NewService(Config{}).(*Service)
+	ownerCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-owner"})
+	feed := func(req *organizationsv1.GetOrganizationActivityFeedRequest) ([]string, *string) {
+		t.Helper()
+		req.OrganizationId = "org-a"
+		resp, err := service.GetOrganizationActivityFeed(ownerCtx, connect.NewRequest(req))
+		if err != nil {
+			t.Fatalf("get activity feed: %v", err)
+		}
+		var ids []string
+		for _, event := range resp.Msg.GetEvents() {
+			ids = append(ids, event.GetId())
+		}
+		return ids, resp.Msg.NextAfterId
+	}
+
+	// Paging through the whole feed returns every event once, newest first
+	var all []string
+	var afterID *string
+	for page := 0; page < 5; page++ {
+		ids, next := feed(&organizationsv1.GetOrganizationActivityFeedRequest{AfterId: afterID, PageSize: 2})
+		all = append(all, ids...)
+		if next == nil {
+			break
+		}
+		afterID = next
+	}
+	if fmt.Sprint(all) != "[event-4 event-3 event-2 event-1 event-0]" {
+		t.Fatalf("paged feed = %v, want event-4 to event-0", all)
+	}
+
+	if ids, next := feed(&organizationsv1.GetOrganizationActivityFeedRequest{EventType: proto.String("deployment.created")}); fmt.Sprint(ids) != "[event-0]" || next != nil {
+		t.Fatalf("deployment.created events = %v (next %v), want only event-0", ids, next)
+	}
+	if ids, _ := feed(&organizationsv1.GetOrganizationActivityFeedRequest{ActorId: proto.String("user-admin")}); fmt.Sprint(ids) != "[event-3 event-1]" {
+		t.Fatalf("user-admin events = %v, want event-3 and event-1", ids)
+	}
+
+	resp, err := service.GetOrganizationActivityFeed(ownerCtx, connect.NewRequest(&organizationsv1.GetOrganizationActivityFeedRequest{OrganizationId: "org-a", PageSize: 1}))
+	if err != nil {
+		t.Fatalf("get activity feed: %v", err)
+	}
+	if event := resp.Msg.GetEvents()[0]; event.GetEventType() != "deployment.updated" || event.GetMetadata()["action"] != "test" {
+		t.Fatalf("newest event = %v, want deployment.updated with metadata", event)
+	}
+
+	_, err = service.GetOrganizationActivityFeed(ownerCtx, connect.NewRequest(&organizationsv1.GetOrganizationActivityFeedRequest{OrganizationId: "org-a", AfterId: proto.String("event-other-org")}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("foreign cursor code = %v, want %v: %v", connect.CodeOf(err), connect.CodeInvalidArgument, err)
+	}
+
+	viewerCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-viewer"})
+	_, err = service.GetOrganizationActivityFeed(viewerCtx, connect.NewRequest(&organizationsv1.GetOrganizationActivityFeedRequest{OrganizationId: "org-a"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("viewer access code = %v, want %v: %v", connect.CodeOf(err), connect.CodePermissionDenied, err)
+	}
+}
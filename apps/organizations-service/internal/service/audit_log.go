@@ -0,0 +1,121 @@
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/middleware"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+
+	commonv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/common/v1"
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetOrganizationAuditLog returns the organization's audit events newest first
+func (s *Service) GetOrganizationAuditLog(ctx context.Context, req *connect.Request[organizationsv1.GetOrganizationAuditLogRequest]) (*connect.Response[organizationsv1.GetOrganizationAuditLogResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.AuthorizeOrgRoles(ctx, orgID, user, "owner", "admin"); err != nil {
+		return nil, err
+	}
+
+	if database.MetricsDB == nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("metrics database (TimescaleDB) not initialized - audit logs require TimescaleDB"))
+	}
+
+	// Pagination
+	page := int(req.Msg.GetPage())
+	if page < 1 {
+		page = 1
+	}
+	perPage := int(req.Msg.GetPerPage())
+	if perPage < 1 {
+		perPage = 50
+	}
+	if perPage > 100 {
+		perPage = 100
+	}
+	offset := (page - 1) * perPage
+
+	var logs []database.AuditLog
+	var total int64
+
+	if err := database.MetricsDB.WithContext(ctx).Model(&database.AuditLog{}).
+		Where("organization_id = ?", orgID).
+		Count(&total).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get audit log: %w", err))
+	}
+	if err := database.MetricsDB.WithContext(ctx).Where("organization_id = ?", orgID).
+		Order("created_at DESC, id DESC").
+		Limit(perPage).
+		Offset(offset).
+		Find(&logs).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get audit log: %w", err))
+	}
+
+	events := make([]*organizationsv1.AuditEvent, 0, len(logs))
+	for i := range logs {
+		events = append(events, auditLogToEvent(&logs[i]))
+	}
+
+	totalPages := (int(total) + perPage - 1) / perPage
+
+	return connect.NewResponse(&organizationsv1.GetOrganizationAuditLogResponse{
+		Events: events,
+		Pagination: &commonv1.Pagination{
+			Page:       int32(page),
+			PerPage:    int32(perPage),
+			Total:      int32(total),
+			TotalPages: int32(totalPages),
+		},
+	}), nil
+}
+
+func auditLogToEvent(log *database.AuditLog) *organizationsv1.AuditEvent {
+	event := &organizationsv1.AuditEvent{
+		Id:           log.ID,
+		Actor:        log.UserID,
+		Action:       log.Action,
+		Service:      log.Service,
+		ResourceType: log.ResourceType,
+		ResourceId:   log.ResourceID,
+		Timestamp:    timestamppb.New(log.CreatedAt),
+	}
+	if log.AuditDiff == nil || *log.AuditDiff == "" {
+		return event
+	}
+
+	var changes []middleware.FieldChange
+	if err := json.Unmarshal([]byte(*log.AuditDiff), &changes); err != nil {
+		logger.Warn("[Organizations] Skipping unreadable audit diff on audit log %s: %v", log.ID, err)
+		return event
+	}
+	for _, change := range changes {
+		fieldChange := &organizationsv1.AuditFieldChange{Field: change.Field}
+		if len(change.Old) > 0 {
+			oldValue := string(change.Old)
+			fieldChange.OldValue = &oldValue
+		}
+		if len(change.New) > 0 {
+			newValue := string(change.New)
+			fieldChange.NewValue = &newValue
+		}
+		event.Diff = append(event.Diff, fieldChange)
+	}
+	return event
+}
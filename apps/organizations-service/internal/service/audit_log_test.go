@@ -0,0 +1,64 @@
+package organizations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+)
+
+func TestGetOrganizationAuditLogReturnsDiffs(t *testing.T) {
+	db := newTestDB(t, &database.OrganizationMember{}, &database.AuditLog{})
+	previousMetricsDB := database.MetricsDB
+	database.MetricsDB = db
+	t.Cleanup(func() { database.MetricsDB = previousMetricsDB })
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	orgA, orgB := "org-a", "org-b"
+	diff := `[{"field":"name","old":"Org A","new":"Acme"}]`
+	records := []any{
+		&database.OrganizationMember{ID: "member-owner", OrganizationID: orgA, UserID: "user-owner", Role: auth.SystemRoleIDOwner, Status: "active", JoinedAt: base},
+		&database.OrganizationMember{ID: "member-viewer", OrganizationID: orgA, UserID: "user-viewer", Role: auth.SystemRoleIDViewer, Status: "active", JoinedAt: base},
+		&database.AuditLog{ID: "audit-update", UserID: "user-owner", OrganizationID: &orgA, Action: "UpdateOrganization", Service: "OrganizationService", RequestData: "{}", AuditDiff: &diff, CreatedAt: base.Add(2 * time.Minute)},
+		&database.AuditLog{ID: "audit-invite", UserID: "user-owner", OrganizationID: &orgA, Action: "InviteMember", Service: "OrganizationService", RequestData: "{}", CreatedAt: base.Add(time.Minute)},
+		&database.AuditLog{ID: "audit-other-org", UserID: "user-other", OrganizationID: &orgB, Action: "UpdateOrganization", Service: "OrganizationService", RequestData: "{}", CreatedAt: base},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	service := NewService(Config{}).(*Service)
+	ownerCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-owner"})
+
+	resp, err := service.GetOrganizationAuditLog(ownerCtx, connect.NewRequest(&organizationsv1.GetOrganizationAuditLogRequest{
+		OrganizationId: orgA,
+		PerPage:        1,
+	}))
+	if err != nil {
+		t.Fatalf("get audit log: %v", err)
+	}
+	if total := resp.Msg.GetPagination().GetTotal(); total != 2 {
+		t.Fatalf("total = %d, want 2 events for org-a", total)
+	}
+	events := resp.Msg.GetEvents()
+	if len(events) != 1 || events[0].GetId() != "audit-update" || events[0].GetActor() != "user-owner" {
+		t.Fatalf("first page = %v, want newest event audit-update", events)
+	}
+	changes := events[0].GetDiff()
+	if len(changes) != 1 || changes[0].GetField() != "name" || changes[0].GetOldValue() != `"Org A"` || changes[0].GetNewValue() != `"Acme"` {
+		t.Fatalf("diff = %v, want name change only", changes)
+	}
+
+	viewerCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-viewer"})
+	_, err = service.GetOrganizationAuditLog(viewerCtx, connect.NewRequest(&organizationsv1.GetOrganizationAuditLogRequest{OrganizationId: orgA}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("viewer access code = %v, want %v: %v", connect.CodeOf(err), connect.CodePermissionDenied, err)
+	}
+}
@@ -0,0 +1,81 @@
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+const (
+	// inviteTTL is how long a pending invite can be accepted after it is sent or resent
+	inviteTTL = 72 * time.Hour
+	// inviteRetention is how long pending invites are kept before the cleanup job deletes them
+	inviteRetention = 30 * 24 * time.Hour
+)
+
+// inviteExpiresAt returns when a pending invite stops being acceptable.
+// Invites created before expiry was tracked expire inviteTTL after they were sent.
+func inviteExpiresAt(member *database.OrganizationMember) time.Time {
+	if member.InviteExpiresAt != nil {
+		return *member.InviteExpiresAt
+	}
+	return member.JoinedAt.Add(inviteTTL)
+}
+
+func inviteExpired(member *database.OrganizationMember, now time.Time) bool {
+	return !now.Before(inviteExpiresAt(member))
+}
+
+// inviteHoursRemaining rounds up so an invite with minutes left still reads "1 hour"
+func inviteHoursRemaining(member *database.OrganizationMember, now time.Time) int {
+	remaining := inviteExpiresAt(member).Sub(now)
+	if remaining <= 0 {
+		return 0
+	}
+	return int((remaining + time.Hour - 1) / time.Hour)
+}
+
+func formatInviteHours(hours int) string {
+	if hours == 1 {
+		return "1 hour"
+	}
+	return fmt.Sprintf("%d hours", hours)
+}
+
+// DeleteStaleInvites removes pending invites last sent more than inviteRetention ago.
+// Age is measured from the latest send (the expiry moves on every resend), so resent invites are kept.
+func DeleteStaleInvites(ctx context.Context) (int64, error) {
+	sentCutoff := time.Now().Add(-inviteRetention)
+	result := database.DB.WithContext(ctx).
+		Where("status = ?", "invited").
+		Where("(invite_expires_at IS NOT NULL AND invite_expires_at < ?) OR (invite_expires_at IS NULL AND joined_at < ?)",
+			sentCutoff.Add(inviteTTL), sentCutoff).
+		Delete(&database.OrganizationMember{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("delete stale invites: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// StartInviteCleanup deletes stale invites once a day until ctx is done
+func StartInviteCleanup(ctx context.Context) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		if deleted, err := DeleteStaleInvites(ctx); err != nil {
+			log.Printf("[Organizations] failed to clean up stale invites: %v", err)
+		} else if deleted > 0 {
+			log.Printf("[Organizations] deleted %d invites older than %v", deleted, inviteRetention)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package organizations
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+	"gorm.io/gorm"
+)
+
+func TestInviteExpiry(t *testing.T) {
+	db := newInviteTestDB(t)
+	service := NewService(Config{}).(*Service)
+	now := time.Now()
+
+	seedInvite(t, db, "invite-fresh", "fresh@example.com", now.Add(-time.Hour), now.Add(inviteTTL-time.Hour))
+	seedInvite(t, db, "invite-expired", "expired@example.com", now.Add(-inviteTTL-time.Hour), now.Add(-time.Hour))
+
+	_, err := service.AcceptInvite(inviteeContext("fresh@example.com"), connect.NewRequest(&organizationsv1.AcceptInviteRequest{
+		OrganizationId: "org-a",
+		MemberId:       "invite-fresh",
+	}))
+	if err != nil {
+		t.Fatalf("accept before expiry: %v", err)
+	}
+
+	_, err = service.AcceptInvite(inviteeContext("expired@example.com"), connect.NewRequest(&organizationsv1.AcceptInviteRequest{
+		OrganizationId: "org-a",
+		MemberId:       "invite-expired",
+	}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("accept after expiry code = %v, want %v: %v", connect.CodeOf(err), connect.CodeNotFound, err)
+	}
+
+	ownerCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-owner", Email: "owner@example.com"})
+	if _, err := service.ResendInvite(ownerCtx, connect.NewRequest(&organizationsv1.ResendInviteRequest{
+		OrganizationId: "org-a",
+		MemberId:       "invite-expired",
+	})); err != nil {
+		t.Fatalf("resend invite: %v", err)
+	}
+
+	var resent database.OrganizationMember
+	if err := db.First(&resent, "id = ?", "invite-expired").Error; err != nil {
+		t.Fatalf("reload resent invite: %v", err)
+	}
+	if resent.InviteExpiresAt == nil || resent.InviteExpiresAt.Before(now.Add(inviteTTL-time.Minute)) {
+		t.Fatalf("resent invite expires at %v, want about %v from now", resent.InviteExpiresAt, inviteTTL)
+	}
+	if _, err := service.AcceptInvite(inviteeContext("expired@example.com"), connect.NewRequest(&organizationsv1.AcceptInviteRequest{
+		OrganizationId: "org-a",
+		MemberId:       "invite-expired",
+	})); err != nil {
+		t.Fatalf("accept after resend: %v", err)
+	}
+}
+
+func TestDeleteStaleInvites(t *testing.T) {
+	db := newInviteTestDB(t)
+	now := time.Now()
+
+	seedInvite(t, db, "invite-recent", "recent@example.com", now.Add(-24*time.Hour), now.Add(48*time.Hour))
+	seedInvite(t, db, "invite-stale", "stale@example.com", now.Add(-inviteRetention-time.Hour), now.Add(-inviteRetention-time.Hour+inviteTTL))
+	// First sent long ago but resent yesterday, so it is still valid
+	seedInvite(t, db, "invite-resent", "resent@example.com", now.Add(-inviteRetention-time.Hour), now.Add(-24*time.Hour+inviteTTL))
+
+	deleted, err := DeleteStaleInvites(context.Background())
+	if err != nil {
+		t.Fatalf("delete stale invites: %v", err)
+	}
+	if deleted != 1 {
+		t.Fatalf("deleted %d invites, want 1", deleted)
+	}
+
+	var remaining []string
+	if err := db.Model(&database.OrganizationMember{}).Where("status = ?", "invited").Pluck("id", &remaining).Error; err != nil {
+		t.Fatalf("list remaining invites: %v", err)
+	}
+	sort.Strings(remaining)
+	if len(remaining) != 2 || remaining[0] != "invite-recent" || remaining[1] != "invite-resent" {
+		t.Fatalf("remaining invites = %v, want [invite-recent invite-resent]", remaining)
+	}
+	var owner database.OrganizationMember
+	if err := db.First(&owner, "id = ?", "member-owner").Error; err != nil {
+		t.Fatalf("active member was deleted: %v", err)
+	}
+}
+
+func newInviteTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db := newOrganizationServiceTestDB(t)
+
+	joined := time.Now().Add(-90 * 24 * time.Hour)
+	records := []any{
+		&database.Organization{ID: "org-a", Name: "Org A", Slug: "org-a", Plan: "starter", Status: "active", CreatedAt: joined},
+		&database.OrganizationMember{ID: "member-owner", OrganizationID: "org-a", UserID: "user-owner", Role: auth.SystemRoleIDOwner, Status: "active", JoinedAt: joined},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+	return db
+}
+
+func seedInvite(t *testing.T, db *gorm.DB, id, email string, invitedAt, expiresAt time.Time) {
+	t.Helper()
+
+	invite := &database.OrganizationMember{
+		ID:              id,
+		OrganizationID:  "org-a",
+		UserID:          "pending:" + email,
+		Role:            auth.SystemRoleIDMember,
+		Status:          "invited",
+		JoinedAt:        invitedAt,
+		InviteExpiresAt: &expiresAt,
+	}
+	if err := db.Create(invite).Error; err != nil {
+		t.Fatalf("seed invite %s: %v", id, err)
+	}
+}
+
+func inviteeContext(email string) context.Context {
+	return auth.WithUser(context.Background(), &authv1.User{Id: "user-" + email, Email: email})
+}
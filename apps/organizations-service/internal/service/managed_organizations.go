@@ -0,0 +1,154 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CreateManagedOrganization creates an organization on behalf of an end customer of the parent organization.
+// The managed organization runs on the parent's plan and quota and its charges roll up to the parent's bill.
+// The caller becomes its owner; the billing email, if given, is invited as an admin.
+func (s *Service) CreateManagedOrganization(ctx context.Context, req *connect.Request[organizationsv1.CreateManagedOrganizationRequest]) (*connect.Response[organizationsv1.CreateManagedOrganizationResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	parentID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if parentID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	name := strings.TrimSpace(req.Msg.GetName())
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization name is required"))
+	}
+	billingEmail := strings.ToLower(strings.TrimSpace(req.Msg.GetBillingEmail()))
+	if billingEmail != "" {
+		if _, err := mail.ParseAddress(billingEmail); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid billing email: %s", billingEmail))
+		}
+	}
+
+	if err := common.AuthorizeOrgRoles(ctx, parentID, user, "owner", "admin"); err != nil {
+		return nil, err
+	}
+
+	var parent database.Organization
+	if err := database.DB.First(&parent, "id = ?", parentID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("load organization: %w", err))
+	}
+	if parent.ParentOrgID != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, database.ErrNestedManagedOrganization)
+	}
+	if parent.Status == database.OrganizationStatusMerged {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("organization has been merged into another organization"))
+	}
+
+	slug, err := availableSlug(normalizeSlug(name))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("check slug: %w", err))
+	}
+
+	now := time.Now()
+	org := &database.Organization{
+		ID:          generateID("org"),
+		Name:        name,
+		Slug:        slug,
+		Plan:        parent.Plan,
+		Status:      "active",
+		ParentOrgID: &parent.ID,
+		CreatedAt:   now,
+	}
+	var invite *database.OrganizationMember
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(org).Error; err != nil {
+			return fmt.Errorf("create org: %w", err)
+		}
+		owner := &database.OrganizationMember{ID: generateID("mem"), OrganizationID: org.ID, UserID: user.Id, Role: auth.SystemRoleIDOwner, Status: "active", JoinedAt: now}
+		if err := tx.Create(owner).Error; err != nil {
+			return fmt.Errorf("add owner: %w", err)
+		}
+		if billingEmail == "" {
+			return nil
+		}
+		// The billing email is a contact only; monthly billing skips managed organizations
+		if err := tx.Create(&database.BillingAccount{ID: generateID("ba"), OrganizationID: org.ID, Status: "ACTIVE", BillingEmail: &billingEmail, Currency: "USD", CreatedAt: now, UpdatedAt: now}).Error; err != nil {
+			return fmt.Errorf("create billing account: %w", err)
+		}
+		if strings.EqualFold(user.GetEmail(), billingEmail) {
+			return nil
+		}
+		expiresAt := now.Add(inviteTTL)
+		invite = &database.OrganizationMember{ID: generateID("mem"), OrganizationID: org.ID, UserID: "pending:" + billingEmail, Role: auth.SystemRoleIDAdmin, Status: "invited", JoinedAt: now, InviteExpiresAt: &expiresAt}
+		if err := tx.Create(invite).Error; err != nil {
+			return fmt.Errorf("invite billing contact: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if invite != nil {
+		// Send invite email (errors are logged but don't fail the organization creation)
+		if err := s.dispatchInviteEmail(ctx, org, invite, user, billingEmail); err != nil {
+			log.Printf("[Organizations] failed to send invite email for managed organization %s: %v", org.ID, err)
+		}
+	}
+
+	return connect.NewResponse(&organizationsv1.CreateManagedOrganizationResponse{Organization: organizationToProto(org)}), nil
+}
+
+// ListManagedOrganizations lists the organizations managed by an organization, oldest first
+func (s *Service) ListManagedOrganizations(ctx context.Context, req *connect.Request[organizationsv1.ListManagedOrganizationsRequest]) (*connect.Response[organizationsv1.ListManagedOrganizationsResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	parentID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if parentID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.VerifyOrgAccess(ctx, parentID, user); err != nil {
+		return nil, err
+	}
+
+	var orgs []database.Organization
+	if err := database.DB.Where("parent_org_id = ?", parentID).Order("created_at ASC, id ASC").Find(&orgs).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list managed organizations: %w", err))
+	}
+	out := make([]*organizationsv1.Organization, 0, len(orgs))
+	for i := range orgs {
+		out = append(out, organizationToProto(&orgs[i]))
+	}
+	return connect.NewResponse(&organizationsv1.ListManagedOrganizationsResponse{Organizations: out}), nil
+}
+
+// availableSlug returns slug, or slug with a random suffix if another organization already uses it
+func availableSlug(slug string) (string, error) {
+	var count int64
+	if err := database.DB.Model(&database.Organization{}).Where("slug = ?", slug).Count(&count).Error; err != nil {
+		return "", err
+	}
+	if count == 0 {
+		return slug, nil
+	}
+	return slug + "-" + uuid.NewString()[:8], nil
+}
@@ -0,0 +1,101 @@
+package organizations
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+)
+
+func TestCreateManagedOrganization(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.OrganizationPlan{},
+		&database.OrgQuota{},
+		&database.BillingAccount{},
+	)
+	now := time.Now()
+	records := []any{
+		&database.OrganizationPlan{ID: "plan-reseller", Name: "Reseller"},
+		&database.Organization{ID: "org-reseller", Name: "Reseller", Slug: "client", Plan: "reseller", Status: "active", CreatedAt: now},
+		&database.OrgQuota{OrganizationID: "org-reseller", PlanID: "plan-reseller"},
+		&database.OrganizationMember{ID: "member-owner", OrganizationID: "org-reseller", UserID: "user-owner", Role: auth.SystemRoleIDOwner, Status: "active", JoinedAt: now},
+		&database.OrganizationMember{ID: "member-viewer", OrganizationID: "org-reseller", UserID: "user-viewer", Role: auth.SystemRoleIDMember, Status: "active", JoinedAt: now},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	service := NewService(Config{}).(*Service)
+	ownerCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-owner", Email: "owner@reseller.example"})
+	memberCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-viewer", Email: "viewer@reseller.example"})
+
+	_, err := service.CreateManagedOrganization(memberCtx, connect.NewRequest(&organizationsv1.CreateManagedOrganizationRequest{
+		OrganizationId: "org-reseller",
+		Name:           "Client",
+	}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("create by plain member code = %v, want %v: %v", connect.CodeOf(err), connect.CodePermissionDenied, err)
+	}
+
+	resp, err := service.CreateManagedOrganization(ownerCtx, connect.NewRequest(&organizationsv1.CreateManagedOrganizationRequest{
+		OrganizationId: "org-reseller",
+		Name:           "Client",
+		BillingEmail:   "Billing@Client.example",
+	}))
+	if err != nil {
+		t.Fatalf("create managed organization: %v", err)
+	}
+	managed := resp.Msg.GetOrganization()
+	if managed.GetParentOrganizationId() != "org-reseller" || managed.GetPlan() != "reseller" || managed.GetPlanInfo().GetPlanId() != "plan-reseller" {
+		t.Fatalf("managed organization = %+v, want the parent's plan", managed)
+	}
+	// "client" is taken by the parent
+	if !strings.HasPrefix(managed.GetSlug(), "client-") {
+		t.Fatalf("managed organization slug = %q, want a suffixed client slug", managed.GetSlug())
+	}
+
+	var members []database.OrganizationMember
+	if err := db.Where("organization_id = ?", managed.GetId()).Order("status").Find(&members).Error; err != nil {
+		t.Fatalf("list members: %v", err)
+	}
+	if len(members) != 2 ||
+		members[0].UserID != "user-owner" || members[0].Role != auth.SystemRoleIDOwner || members[0].Status != "active" ||
+		members[1].UserID != "pending:billing@client.example" || members[1].Role != auth.SystemRoleIDAdmin || members[1].Status != "invited" {
+		t.Fatalf("members = %+v, want the creator as owner and the billing email invited as admin", members)
+	}
+	var account database.BillingAccount
+	if err := db.First(&account, "organization_id = ?", managed.GetId()).Error; err != nil || account.BillingEmail == nil || *account.BillingEmail != "billing@client.example" {
+		t.Fatalf("billing account = %+v (%v), want billing@client.example", account, err)
+	}
+
+	// Managed organizations cannot manage organizations themselves
+	_, err = service.CreateManagedOrganization(ownerCtx, connect.NewRequest(&organizationsv1.CreateManagedOrganizationRequest{
+		OrganizationId: managed.GetId(),
+		Name:           "Nested",
+	}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("nested managed organization code = %v, want %v: %v", connect.CodeOf(err), connect.CodeFailedPrecondition, err)
+	}
+
+	list, err := service.ListManagedOrganizations(memberCtx, connect.NewRequest(&organizationsv1.ListManagedOrganizationsRequest{OrganizationId: "org-reseller"}))
+	if err != nil {
+		t.Fatalf("list managed organizations: %v", err)
+	}
+	if got := list.Msg.GetOrganizations(); len(got) != 1 || got[0].GetId() != managed.GetId() {
+		t.Fatalf("managed organizations = %v, want [%s]", got, managed.GetId())
+	}
+	outsiderCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-outsider"})
+	if _, err := service.ListManagedOrganizations(outsiderCtx, connect.NewRequest(&organizationsv1.ListManagedOrganizationsRequest{OrganizationId: "org-reseller"})); err == nil {
+		t.Fatal("outsider listed managed organizations")
+	}
+}
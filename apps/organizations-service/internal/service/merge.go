@@ -0,0 +1,199 @@
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/notifications"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+
+	"connectrpc.com/connect"
+	"gorm.io/gorm"
+)
+
+// subscriptionCanceler cancels the Stripe subscriptions of a customer
+type subscriptionCanceler interface {
+	CancelActiveSubscriptions(ctx context.Context, customerID string) ([]string, error)
+}
+
+// notifyMergedMember is replaced in tests
+var notifyMergedMember = notifications.CreateNotificationForUser
+
+// MergeOrganizations moves everything owned by the source organization into the target organization
+// and marks the source as merged. Only superadmins can merge organizations.
+func (s *Service) MergeOrganizations(ctx context.Context, req *connect.Request[organizationsv1.MergeOrganizationsRequest]) (*connect.Response[organizationsv1.MergeOrganizationsResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	if !auth.HasSuperadminPermission(ctx, user, "organization.admin.merge") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	sourceOrgID := strings.TrimSpace(req.Msg.GetSourceOrganizationId())
+	targetOrgID := strings.TrimSpace(req.Msg.GetTargetOrganizationId())
+	if sourceOrgID == "" || targetOrgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("source_organization_id and target_organization_id are required"))
+	}
+	if sourceOrgID == targetOrgID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cannot merge an organization into itself"))
+	}
+
+	var source, target database.Organization
+	if err := database.DB.First(&source, "id = ?", sourceOrgID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("source organization not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("load source organization: %w", err))
+	}
+	if err := database.DB.First(&target, "id = ?", targetOrgID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("target organization not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("load target organization: %w", err))
+	}
+	if source.Status == database.OrganizationStatusMerged {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("source organization has already been merged"))
+	}
+	if target.Status == database.OrganizationStatusMerged {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("target organization has been merged into another organization"))
+	}
+
+	var unpaidBills int64
+	if err := database.DB.Model(&database.MonthlyBill{}).
+		Where("organization_id = ? AND status IN ?", sourceOrgID, []string{"PENDING", "FAILED"}).
+		Count(&unpaidBills).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("check unpaid bills: %w", err))
+	}
+	if unpaidBills > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("source organization has %d unpaid invoice(s)", unpaidBills))
+	}
+
+	// Collect both member lists before the move so everyone hears about the merge
+	var notifyUserIDs []string
+	if err := database.DB.Model(&database.OrganizationMember{}).
+		Where("organization_id IN ? AND status = ?", []string{sourceOrgID, targetOrgID}, "active").
+		Distinct().Pluck("user_id", &notifyUserIDs).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("load members: %w", err))
+	}
+
+	resp := &organizationsv1.MergeOrganizationsResponse{}
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		moved := []struct {
+			model any
+			count *int32
+		}{
+			{&database.Deployment{}, &resp.DeploymentsMoved},
+			{&database.GameServer{}, &resp.GameServersMoved},
+			{&database.VPSInstance{}, &resp.VpsInstancesMoved},
+			{&database.CreditTransaction{}, &resp.CreditTransactionsMoved},
+		}
+		for _, m := range moved {
+			result := tx.Model(m.model).Where("organization_id = ?", sourceOrgID).Update("organization_id", targetOrgID)
+			if result.Error != nil {
+				return result.Error
+			}
+			*m.count = int32(result.RowsAffected)
+		}
+		// Public IPs follow their VPS instances
+		if err := tx.Model(&database.VPSPublicIP{}).Where("organization_id = ?", sourceOrgID).Update("organization_id", targetOrgID).Error; err != nil {
+			return err
+		}
+
+		var sourceMembers []database.OrganizationMember
+		if err := tx.Where("organization_id = ?", sourceOrgID).Find(&sourceMembers).Error; err != nil {
+			return err
+		}
+		for _, member := range sourceMembers {
+			var existing int64
+			if err := tx.Model(&database.OrganizationMember{}).
+				Where("organization_id = ? AND user_id = ?", targetOrgID, member.UserID).
+				Count(&existing).Error; err != nil {
+				return err
+			}
+			if existing > 0 {
+				// Already a member of the target; keep the target membership as is
+				if err := tx.Delete(&member).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			member.OrganizationID = targetOrgID
+			if member.Role == auth.SystemRoleIDOwner {
+				// The target keeps its single owner
+				member.Role = auth.SystemRoleIDAdmin
+			}
+			if err := tx.Save(&member).Error; err != nil {
+				return err
+			}
+			resp.MembersMoved++
+		}
+
+		target.Credits += source.Credits
+		target.TotalPaidCents += source.TotalPaidCents
+		if err := tx.Save(&target).Error; err != nil {
+			return err
+		}
+		resp.CreditsMovedCents = source.Credits
+
+		source.Credits = 0
+		source.TotalPaidCents = 0
+		source.Status = database.OrganizationStatusMerged
+		source.MergedIntoID = &targetOrgID
+		return tx.Save(&source).Error
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("merge organizations: %w", err))
+	}
+
+	// Audit logs live in the metrics database, so they move after the main transaction commits
+	if database.MetricsDB != nil {
+		metadata, _ := json.Marshal(map[string]string{"source_org_id": sourceOrgID})
+		if err := database.MetricsDB.WithContext(ctx).Model(&database.AuditLog{}).
+			Where("organization_id = ?", sourceOrgID).
+			Updates(map[string]any{"organization_id": targetOrgID, "metadata": string(metadata)}).Error; err != nil {
+			log.Printf("[MergeOrganizations] failed to move audit logs from %s to %s: %v", sourceOrgID, targetOrgID, err)
+		}
+	}
+
+	if s.subscriptions != nil {
+		var billingAccount database.BillingAccount
+		if err := database.DB.Where("organization_id = ?", sourceOrgID).First(&billingAccount).Error; err == nil && billingAccount.StripeCustomerID != nil {
+			canceled, err := s.subscriptions.CancelActiveSubscriptions(ctx, *billingAccount.StripeCustomerID)
+			if err != nil {
+				log.Printf("[MergeOrganizations] failed to cancel Stripe subscriptions for organization %s: %v", sourceOrgID, err)
+			} else if len(canceled) > 0 {
+				log.Printf("[MergeOrganizations] canceled Stripe subscriptions %v for organization %s", canceled, sourceOrgID)
+			}
+		}
+	}
+
+	actionURL := fmt.Sprintf("/organizations?organizationId=%s", targetOrgID)
+	actionLabel := "View Organization"
+	metadata := map[string]string{
+		"source_organization_id": sourceOrgID,
+		"target_organization_id": targetOrgID,
+	}
+	for _, userID := range notifyUserIDs {
+		if err := notifyMergedMember(ctx, userID, &targetOrgID,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM,
+			notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_MEDIUM,
+			"Organizations merged",
+			fmt.Sprintf("%s has been merged into %s.", source.Name, target.Name),
+			&actionURL, &actionLabel, metadata); err != nil {
+			log.Printf("[MergeOrganizations] failed to notify user %s: %v", userID, err)
+		}
+	}
+
+	log.Printf("[MergeOrganizations] %s merged organization %s into %s", user.Id, sourceOrgID, targetOrgID)
+
+	resp.TargetOrganization = organizationToProto(&target)
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,173 @@
+package organizations
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+)
+
+type fakeSubscriptionCanceler struct {
+	customers []string
+}
+
+func (f *fakeSubscriptionCanceler) CancelActiveSubscriptions(ctx context.Context, customerID string) ([]string, error) {
+	f.customers = append(f.customers, customerID)
+	return []string{"sub_" + customerID}, nil
+}
+
+func TestMergeOrganizations(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.OrganizationPlan{},
+		&database.OrgQuota{},
+		&database.Deployment{},
+		&database.GameServer{},
+		&database.VPSInstance{},
+		&database.VPSPublicIP{},
+		&database.CreditTransaction{},
+		&database.BillingAccount{},
+		&database.MonthlyBill{},
+		&database.AuditLog{},
+	)
+	previousMetricsDB := database.MetricsDB
+	database.MetricsDB = db
+	t.Cleanup(func() { database.MetricsDB = previousMetricsDB })
+
+	seedOrganizationServiceIsolationData(t, db)
+	now := time.Now().UTC()
+	customerID := "cus_org_a"
+	orgA := "org-a"
+	records := []any{
+		&database.OrganizationMember{ID: "member-shared-a", OrganizationID: "org-a", UserID: "user-shared", Role: auth.SystemRoleIDMember, Status: "active", JoinedAt: now},
+		&database.OrganizationMember{ID: "member-shared-b", OrganizationID: "org-b", UserID: "user-shared", Role: auth.SystemRoleIDAdmin, Status: "active", JoinedAt: now},
+		&database.Deployment{ID: "deploy-a", Name: "web", OrganizationID: "org-a", CreatedAt: now},
+		&database.GameServer{ID: "gs-a", Name: "minecraft", OrganizationID: "org-a", CreatedAt: now},
+		&database.VPSInstance{ID: "vps-a", Name: "box", OrganizationID: "org-a", CreatedAt: now},
+		&database.VPSPublicIP{ID: "ip-a", IPAddress: "203.0.113.10", OrganizationID: &orgA},
+		&database.CreditTransaction{ID: "ct-a", OrganizationID: "org-a", AmountCents: 2500, BalanceAfter: 2500, Type: "payment", Source: "stripe", CreatedAt: now},
+		&database.BillingAccount{ID: "ba-a", OrganizationID: "org-a", StripeCustomerID: &customerID, Status: "ACTIVE"},
+		&database.MonthlyBill{ID: "bill-a", OrganizationID: "org-a", AmountCents: 1200, Status: "PENDING", BillingPeriodStart: now.AddDate(0, -1, 0), BillingPeriodEnd: now, DueDate: now},
+		&database.AuditLog{ID: "audit-a", UserID: "user-org-a", OrganizationID: &orgA, Service: "DeploymentService", Action: "CreateDeployment", CreatedAt: now},
+	}
+	for _, record := range records {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+	if err := db.Model(&database.Organization{}).Where("id = ?", "org-a").Updates(map[string]any{"credits": 2500, "total_paid_cents": 2500}).Error; err != nil {
+		t.Fatalf("seed credits: %v", err)
+	}
+	if err := db.Model(&database.Organization{}).Where("id = ?", "org-b").Update("credits", 100).Error; err != nil {
+		t.Fatalf("seed credits: %v", err)
+	}
+
+	canceler := &fakeSubscriptionCanceler{}
+	service := NewService(Config{}).(*Service)
+	service.subscriptions = canceler
+
+	var notified []string
+	previousNotify := notifyMergedMember
+	notifyMergedMember = func(ctx context.Context, userID string, orgID *string, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity, title, message string, actionURL, actionLabel *string, metadata map[string]string) error {
+		notified = append(notified, userID)
+		return nil
+	}
+	t.Cleanup(func() { notifyMergedMember = previousNotify })
+
+	merge := connect.NewRequest(&organizationsv1.MergeOrganizationsRequest{
+		SourceOrganizationId: "org-a",
+		TargetOrganizationId: "org-b",
+	})
+
+	ownerCtx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a"})
+	if _, err := service.MergeOrganizations(ownerCtx, merge); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("merge by organization owner code = %v, want %v: %v", connect.CodeOf(err), connect.CodePermissionDenied, err)
+	}
+
+	superadmin := auth.WithUser(context.Background(), &authv1.User{Id: "admin", Roles: []string{auth.RoleSuperAdmin}})
+	if _, err := service.MergeOrganizations(superadmin, merge); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("merge with unpaid invoice code = %v, want %v: %v", connect.CodeOf(err), connect.CodeFailedPrecondition, err)
+	}
+	var deployment database.Deployment
+	db.First(&deployment, "id = ?", "deploy-a")
+	if deployment.OrganizationID != "org-a" {
+		t.Fatalf("rejected merge moved deployment to %s", deployment.OrganizationID)
+	}
+
+	if err := db.Model(&database.MonthlyBill{}).Where("id = ?", "bill-a").Update("status", "PAID").Error; err != nil {
+		t.Fatalf("pay bill: %v", err)
+	}
+	resp, err := service.MergeOrganizations(superadmin, merge)
+	if err != nil {
+		t.Fatalf("merge organizations: %v", err)
+	}
+	got := resp.Msg
+	if got.GetDeploymentsMoved() != 1 || got.GetGameServersMoved() != 1 || got.GetVpsInstancesMoved() != 1 || got.GetCreditTransactionsMoved() != 1 {
+		t.Fatalf("moved deployments=%d game servers=%d vps=%d credit transactions=%d, want 1 each",
+			got.GetDeploymentsMoved(), got.GetGameServersMoved(), got.GetVpsInstancesMoved(), got.GetCreditTransactionsMoved())
+	}
+	if got.GetMembersMoved() != 1 || got.GetCreditsMovedCents() != 2500 {
+		t.Fatalf("moved members=%d credits=%d, want 1 member and 2500 credits", got.GetMembersMoved(), got.GetCreditsMovedCents())
+	}
+	if got.GetTargetOrganization().GetCredits() != 2600 {
+		t.Fatalf("target credits = %d, want 2600", got.GetTargetOrganization().GetCredits())
+	}
+
+	for _, model := range []any{&database.Deployment{}, &database.GameServer{}, &database.VPSInstance{}, &database.VPSPublicIP{}, &database.CreditTransaction{}} {
+		var remaining int64
+		db.Model(model).Where("organization_id = ?", "org-a").Count(&remaining)
+		if remaining != 0 {
+			t.Errorf("%T rows left in source = %d, want 0", model, remaining)
+		}
+	}
+
+	var members []database.OrganizationMember
+	db.Where("organization_id = ?", "org-b").Order("id").Find(&members)
+	roles := map[string]string{}
+	for _, member := range members {
+		roles[member.UserID] = member.Role
+	}
+	want := map[string]string{"user-org-a": auth.SystemRoleIDAdmin, "user-org-b": auth.SystemRoleIDOwner, "user-shared": auth.SystemRoleIDAdmin}
+	if len(roles) != len(want) {
+		t.Fatalf("target members = %v, want %v", roles, want)
+	}
+	for userID, role := range want {
+		if roles[userID] != role {
+			t.Errorf("%s role = %q, want %q", userID, roles[userID], role)
+		}
+	}
+
+	var source database.Organization
+	db.First(&source, "id = ?", "org-a")
+	if source.Status != database.OrganizationStatusMerged || source.MergedIntoID == nil || *source.MergedIntoID != "org-b" || source.Credits != 0 {
+		t.Fatalf("source = status %q merged into %v credits %d, want merged into org-b with no credits", source.Status, source.MergedIntoID, source.Credits)
+	}
+
+	var auditLog database.AuditLog
+	db.First(&auditLog, "id = ?", "audit-a")
+	if auditLog.OrganizationID == nil || *auditLog.OrganizationID != "org-b" || auditLog.Metadata == nil || !strings.Contains(*auditLog.Metadata, `"source_org_id":"org-a"`) {
+		t.Fatalf("audit log = org %v metadata %v, want org-b with the source organization in metadata", auditLog.OrganizationID, auditLog.Metadata)
+	}
+
+	if !slices.Equal(canceler.customers, []string{customerID}) {
+		t.Fatalf("canceled subscriptions for %v, want %s", canceler.customers, customerID)
+	}
+
+	slices.Sort(notified)
+	if !slices.Equal(notified, []string{"user-org-a", "user-org-b", "user-shared"}) {
+		t.Fatalf("notified %v, want every member of both organizations once", notified)
+	}
+
+	if _, err := service.MergeOrganizations(superadmin, merge); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("second merge code = %v, want %v: %v", connect.CodeOf(err), connect.CodeFailedPrecondition, err)
+	}
+}
@@ -0,0 +1,159 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+
+	"connectrpc.com/connect"
+	"gorm.io/gorm"
+)
+
+// AddResourceTag sets a key=value tag on one of the organization's resources
+func (s *Service) AddResourceTag(ctx context.Context, req *connect.Request[organizationsv1.AddResourceTagRequest]) (*connect.Response[organizationsv1.AddResourceTagResponse], error) {
+	orgID, resourceType, resourceID, err := s.authorizeTaggedResource(ctx, req.Msg.GetOrganizationId(), req.Msg.GetResourceType(), req.Msg.GetResourceId())
+	if err != nil {
+		return nil, err
+	}
+
+	key := strings.TrimSpace(req.Msg.GetKey())
+	value := strings.TrimSpace(req.Msg.GetValue())
+	if err := database.SetResourceTag(orgID, resourceType, resourceID, key, value); err != nil {
+		if errors.Is(err, database.ErrInvalidResourceTag) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if errors.Is(err, database.ErrTooManyResourceTags) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("add resource tag: %w", err))
+	}
+
+	resource, err := taggedResourceToProto(resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&organizationsv1.AddResourceTagResponse{Resource: resource}), nil
+}
+
+// RemoveResourceTag removes a tag from one of the organization's resources
+func (s *Service) RemoveResourceTag(ctx context.Context, req *connect.Request[organizationsv1.RemoveResourceTagRequest]) (*connect.Response[organizationsv1.RemoveResourceTagResponse], error) {
+	_, resourceType, resourceID, err := s.authorizeTaggedResource(ctx, req.Msg.GetOrganizationId(), req.Msg.GetResourceType(), req.Msg.GetResourceId())
+	if err != nil {
+		return nil, err
+	}
+
+	key := strings.TrimSpace(req.Msg.GetKey())
+	if key == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key is required"))
+	}
+	if err := database.RemoveResourceTag(resourceType, resourceID, key); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tag %q is not set on %s %s", key, resourceType, resourceID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("remove resource tag: %w", err))
+	}
+
+	resource, err := taggedResourceToProto(resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&organizationsv1.RemoveResourceTagResponse{Resource: resource}), nil
+}
+
+// ListResourcesByTag lists the organization's resources that carry every given tag
+func (s *Service) ListResourcesByTag(ctx context.Context, req *connect.Request[organizationsv1.ListResourcesByTagRequest]) (*connect.Response[organizationsv1.ListResourcesByTagResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.VerifyOrgAccess(ctx, orgID, user); err != nil {
+		return nil, err
+	}
+
+	tags := req.Msg.GetTags()
+	if len(tags) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one tag is required"))
+	}
+	for key, value := range tags {
+		if err := database.ValidateResourceTag(key, value); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	resourceType := strings.TrimSpace(req.Msg.GetResourceType())
+	if resourceType != "" && !database.IsTaggableResourceType(resourceType) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported resource type %q", resourceType))
+	}
+
+	resources, err := database.ListResourcesByTag(orgID, resourceType, tags)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	out := make([]*organizationsv1.TaggedResource, 0, len(resources))
+	for _, resource := range resources {
+		out = append(out, &organizationsv1.TaggedResource{
+			ResourceType: resource.ResourceType,
+			ResourceId:   resource.ResourceID,
+			Tags:         resource.Tags,
+		})
+	}
+	return connect.NewResponse(&organizationsv1.ListResourcesByTagResponse{Resources: out}), nil
+}
+
+// authorizeTaggedResource checks that the resource belongs to the organization and that the
+// caller may update it; tagging a resource is treated as changing it
+func (s *Service) authorizeTaggedResource(ctx context.Context, orgID, resourceType, resourceID string) (string, string, string, error) {
+	if _, err := auth.GetUserFromContext(ctx); err != nil {
+		return "", "", "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID = strings.TrimSpace(orgID)
+	resourceType = strings.TrimSpace(resourceType)
+	resourceID = strings.TrimSpace(resourceID)
+	if orgID == "" || resourceID == "" {
+		return "", "", "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id and resource_id are required"))
+	}
+	if !database.IsTaggableResourceType(resourceType) {
+		return "", "", "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported resource type %q", resourceType))
+	}
+
+	ownerOrgID, err := database.ResourceOrganizationID(resourceType, resourceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", "", "", connect.NewError(connect.CodeNotFound, fmt.Errorf("%s %s not found", resourceType, resourceID))
+		}
+		return "", "", "", connect.NewError(connect.CodeInternal, err)
+	}
+	// Resources of other organizations are reported as missing rather than forbidden
+	if ownerOrgID != orgID {
+		return "", "", "", connect.NewError(connect.CodeNotFound, fmt.Errorf("%s %s not found", resourceType, resourceID))
+	}
+	if err := auth.CheckResourcePermissionWithError(ctx, s.permissionChecker, resourceType, resourceID, "update"); err != nil {
+		return "", "", "", err
+	}
+	return orgID, resourceType, resourceID, nil
+}
+
+func taggedResourceToProto(resourceType, resourceID string) (*organizationsv1.TaggedResource, error) {
+	tags, err := database.GetResourceTags(resourceType, resourceID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &organizationsv1.TaggedResource{
+		ResourceType: resourceType,
+		ResourceId:   resourceID,
+		Tags:         tags,
+	}, nil
+}
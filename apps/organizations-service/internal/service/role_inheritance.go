@@ -0,0 +1,166 @@
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+
+	"connectrpc.com/connect"
+	"gorm.io/gorm"
+)
+
+// AddRoleInheritance makes a custom role inherit every permission of a parent role
+func (s *Service) AddRoleInheritance(ctx context.Context, req *connect.Request[organizationsv1.AddRoleInheritanceRequest]) (*connect.Response[organizationsv1.AddRoleInheritanceResponse], error) {
+	orgID, childRoleID, parentRoleID, err := authorizeRoleInheritance(ctx, req.Msg.GetOrganizationId(), req.Msg.GetChildRoleId(), req.Msg.GetParentRoleId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := database.AddRoleInheritance(ctx, database.DB, orgID, childRoleID, parentRoleID); err != nil {
+		switch {
+		case errors.Is(err, database.ErrRoleInheritanceCycle):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("role %s already inherits from role %s; the edge would create a cycle", parentRoleID, childRoleID))
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("both roles must be custom roles of the organization"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("add role inheritance: %w", err))
+	}
+
+	return connect.NewResponse(&organizationsv1.AddRoleInheritanceResponse{
+		Edge: &organizationsv1.RoleInheritanceEdge{ChildRoleId: childRoleID, ParentRoleId: parentRoleID},
+	}), nil
+}
+
+// RemoveRoleInheritance stops a custom role inheriting from a parent role
+func (s *Service) RemoveRoleInheritance(ctx context.Context, req *connect.Request[organizationsv1.RemoveRoleInheritanceRequest]) (*connect.Response[organizationsv1.RemoveRoleInheritanceResponse], error) {
+	orgID, childRoleID, parentRoleID, err := authorizeRoleInheritance(ctx, req.Msg.GetOrganizationId(), req.Msg.GetChildRoleId(), req.Msg.GetParentRoleId())
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := database.RemoveRoleInheritance(ctx, database.DB, orgID, childRoleID, parentRoleID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("remove role inheritance: %w", err))
+	}
+	if !removed {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("role %s does not inherit from role %s", childRoleID, parentRoleID))
+	}
+	return connect.NewResponse(&organizationsv1.RemoveRoleInheritanceResponse{Success: true}), nil
+}
+
+// GetEffectivePermissions returns a member's flattened permissions, including inherited ones.
+// Members may look up their own permissions; anyone else's require org owner/admin.
+func (s *Service) GetEffectivePermissions(ctx context.Context, req *connect.Request[organizationsv1.GetEffectivePermissionsRequest]) (*connect.Response[organizationsv1.GetEffectivePermissionsResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	userID := strings.TrimSpace(req.Msg.GetUserId())
+	if userID == "" {
+		userID = user.Id
+	}
+
+	if userID == user.Id {
+		if auth.IsSuperadmin(ctx, user) {
+			return connect.NewResponse(&organizationsv1.GetEffectivePermissionsResponse{Permissions: []string{"*"}}), nil
+		}
+	} else if err := common.AuthorizeOrgAdmin(ctx, orgID, user); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&organizationsv1.GetEffectivePermissionsResponse{
+		Permissions: memberPermissions(ctx, orgID, userID),
+	}), nil
+}
+
+// RolesGraph returns the organization's custom roles and inheritance edges for visualization
+func (s *Service) RolesGraph(ctx context.Context, req *connect.Request[organizationsv1.RolesGraphRequest]) (*connect.Response[organizationsv1.RolesGraphResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.AuthorizeOrgAdmin(ctx, orgID, user); err != nil {
+		return nil, err
+	}
+
+	var roles []database.OrgRole
+	if err := database.DB.Where("organization_id = ?", orgID).Order("name").Find(&roles).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list roles: %w", err))
+	}
+	edges, err := database.ListRoleInheritance(ctx, database.DB, orgID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("list role inheritance: %w", err))
+	}
+
+	roleIDs := make([]string, 0, len(roles))
+	for _, role := range roles {
+		roleIDs = append(roleIDs, role.ID)
+	}
+	effective, err := database.ResolveRolePermissions(ctx, database.DB, roleIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("resolve role permissions: %w", err))
+	}
+
+	resp := &organizationsv1.RolesGraphResponse{
+		Roles: make([]*organizationsv1.RoleGraphNode, 0, len(roles)),
+		Edges: make([]*organizationsv1.RoleInheritanceEdge, 0, len(edges)),
+	}
+	for _, role := range roles {
+		// Skip system roles - they shouldn't be in the database
+		if auth.IsSystemRole(role.Name) {
+			continue
+		}
+		var permissions []string
+		_ = json.Unmarshal([]byte(role.Permissions), &permissions)
+		effectivePermissions := append([]string(nil), effective[role.ID]...)
+		sort.Strings(effectivePermissions)
+		resp.Roles = append(resp.Roles, &organizationsv1.RoleGraphNode{
+			Id:                   role.ID,
+			Name:                 role.Name,
+			Permissions:          permissions,
+			EffectivePermissions: effectivePermissions,
+		})
+	}
+	for _, edge := range edges {
+		resp.Edges = append(resp.Edges, &organizationsv1.RoleInheritanceEdge{ChildRoleId: edge.ChildRoleID, ParentRoleId: edge.ParentRoleID})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// authorizeRoleInheritance validates an inheritance edge request and requires org owner/admin
+func authorizeRoleInheritance(ctx context.Context, orgID, childRoleID, parentRoleID string) (string, string, string, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return "", "", "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID = strings.TrimSpace(orgID)
+	childRoleID = strings.TrimSpace(childRoleID)
+	parentRoleID = strings.TrimSpace(parentRoleID)
+	if orgID == "" || childRoleID == "" || parentRoleID == "" {
+		return "", "", "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id, child_role_id and parent_role_id are required"))
+	}
+	if err := common.AuthorizeOrgAdmin(ctx, orgID, user); err != nil {
+		return "", "", "", err
+	}
+	return orgID, childRoleID, parentRoleID, nil
+}
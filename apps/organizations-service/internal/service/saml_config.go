@@ -0,0 +1,138 @@
+package organizations
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// ConfigureSAML creates or replaces the organization's SAML identity provider
+func (s *Service) ConfigureSAML(ctx context.Context, req *connect.Request[organizationsv1.ConfigureSAMLRequest]) (*connect.Response[organizationsv1.ConfigureSAMLResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.AuthorizeOrgRoles(ctx, orgID, user, "owner", "admin"); err != nil {
+		return nil, err
+	}
+
+	entityID := strings.TrimSpace(req.Msg.GetEntityId())
+	if entityID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("entity_id is required"))
+	}
+	ssoURL := strings.TrimSpace(req.Msg.GetSsoUrl())
+	if parsed, err := url.Parse(ssoURL); err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("sso_url must be an absolute http(s) URL"))
+	}
+	certificate, err := normalizeSAMLCertificate(req.Msg.GetCertificate())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	mapping, err := database.EncodeSAMLAttributeMapping(req.Msg.GetAttributeMapping())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var config database.SAMLConfig
+	err = database.DB.Where("organization_id = ?", orgID).First(&config).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("configure SAML: %w", err))
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		config = database.SAMLConfig{ID: generateID("saml"), OrganizationID: orgID, CreatedAt: time.Now()}
+	}
+	config.EntityID = entityID
+	config.SSOURL = ssoURL
+	config.Certificate = certificate
+	config.AttributeMapping = mapping
+	config.UpdatedAt = time.Now()
+	if err := database.DB.Save(&config).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("configure SAML: %w", err))
+	}
+
+	return connect.NewResponse(&organizationsv1.ConfigureSAMLResponse{Config: samlConfigToProto(&config)}), nil
+}
+
+// GetSAMLConfig returns the organization's SAML identity provider, if configured
+func (s *Service) GetSAMLConfig(ctx context.Context, req *connect.Request[organizationsv1.GetSAMLConfigRequest]) (*connect.Response[organizationsv1.GetSAMLConfigResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.AuthorizeOrgRoles(ctx, orgID, user, "owner", "admin"); err != nil {
+		return nil, err
+	}
+
+	var config database.SAMLConfig
+	if err := database.DB.Where("organization_id = ?", orgID).First(&config).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return connect.NewResponse(&organizationsv1.GetSAMLConfigResponse{}), nil
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get SAML config: %w", err))
+	}
+
+	return connect.NewResponse(&organizationsv1.GetSAMLConfigResponse{Config: samlConfigToProto(&config)}), nil
+}
+
+// normalizeSAMLCertificate accepts a PEM certificate or the bare base64 body IdPs often display
+func normalizeSAMLCertificate(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("certificate is required")
+	}
+	if !strings.Contains(raw, "-----BEGIN") {
+		raw = "-----BEGIN CERTIFICATE-----\n" + raw + "\n-----END CERTIFICATE-----"
+	}
+	block, _ := pem.Decode([]byte(raw))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", fmt.Errorf("certificate must be a PEM encoded X.509 certificate")
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return "", fmt.Errorf("invalid certificate: %w", err)
+	}
+	return string(pem.EncodeToMemory(block)), nil
+}
+
+func samlConfigToProto(config *database.SAMLConfig) *organizationsv1.SAMLConfig {
+	mapping, err := database.DecodeSAMLAttributeMapping(config.AttributeMapping)
+	if err != nil {
+		mapping = nil
+	}
+	acsURL, metadataURL := auth.SAMLServiceProviderURLs(config.OrganizationID)
+	return &organizationsv1.SAMLConfig{
+		OrganizationId:   config.OrganizationID,
+		EntityId:         config.EntityID,
+		SsoUrl:           config.SSOURL,
+		Certificate:      config.Certificate,
+		AttributeMapping: mapping,
+		SpEntityId:       metadataURL,
+		AcsUrl:           acsURL,
+		MetadataUrl:      metadataURL,
+		UpdatedAt:        timestamppb.New(config.UpdatedAt),
+	}
+}
@@ -2,7 +2,6 @@ package organizations
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -15,11 +14,13 @@ import (
 	"github.com/obiente/cloud/apps/shared/pkg/database"
 	"github.com/obiente/cloud/apps/shared/pkg/email"
 	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/middleware"
 	"github.com/obiente/cloud/apps/shared/pkg/notifications"
 	"github.com/obiente/cloud/apps/shared/pkg/platform"
 	"github.com/obiente/cloud/apps/shared/pkg/pricing"
 	"github.com/obiente/cloud/apps/shared/pkg/services/common"
 	sharedorganizations "github.com/obiente/cloud/apps/shared/pkg/services/organizations"
+	"github.com/obiente/cloud/apps/shared/pkg/stripe"
 	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
 
 	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
@@ -42,9 +43,11 @@ type Config struct {
 
 type Service struct {
 	organizationsv1connect.UnimplementedOrganizationServiceHandler
-	mailer       email.Sender
-	consoleURL   string
-	supportEmail string
+	mailer            email.Sender
+	consoleURL        string
+	supportEmail      string
+	permissionChecker *auth.PermissionChecker
+	subscriptions     subscriptionCanceler // nil when Stripe is not configured
 }
 
 var _ organizationsv1connect.OrganizationServiceHandler = (*Service)(nil)
@@ -55,7 +58,16 @@ func NewService(cfg Config) organizationsv1connect.OrganizationServiceHandler {
 		consoleURL = platform.DashboardURL()
 	}
 
-	return &Service{mailer: cfg.EmailSender, consoleURL: consoleURL, supportEmail: strings.TrimSpace(cfg.SupportEmail)}
+	svc := &Service{
+		mailer:            cfg.EmailSender,
+		consoleURL:        consoleURL,
+		supportEmail:      strings.TrimSpace(cfg.SupportEmail),
+		permissionChecker: auth.NewPermissionChecker(),
+	}
+	if stripeClient, err := stripe.NewClient(); err == nil { // Stripe is optional outside billing
+		svc.subscriptions = stripeClient
+	}
+	return svc
 }
 
 func (s *Service) ListOrganizations(ctx context.Context, req *connect.Request[organizationsv1.ListOrganizationsRequest]) (*connect.Response[organizationsv1.ListOrganizationsResponse], error) {
@@ -202,6 +214,7 @@ func (s *Service) UpdateOrganization(ctx context.Context, req *connect.Request[o
 	if err := database.DB.First(&org, "id = ?", req.Msg.GetOrganizationId()).Error; err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
 	}
+	before := organizationToProto(&org)
 	if name := strings.TrimSpace(req.Msg.GetName()); name != "" {
 		org.Name = name
 	}
@@ -216,7 +229,9 @@ func (s *Service) UpdateOrganization(ctx context.Context, req *connect.Request[o
 	if err := database.DB.Save(&org).Error; err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update org: %w", err))
 	}
-	return connect.NewResponse(&organizationsv1.UpdateOrganizationResponse{Organization: organizationToProto(&org)}), nil
+	after := organizationToProto(&org)
+	middleware.RecordAuditDiff(ctx, before, after)
+	return connect.NewResponse(&organizationsv1.UpdateOrganizationResponse{Organization: after}), nil
 }
 
 func (s *Service) ListMembers(ctx context.Context, req *connect.Request[organizationsv1.ListMembersRequest]) (*connect.Response[organizationsv1.ListMembersResponse], error) {
@@ -329,6 +344,8 @@ func (s *Service) InviteMember(ctx context.Context, req *connect.Request[organiz
 		// User is already invited - update role and resend invite
 		existingMember.Role = roleID
 		existingMember.JoinedAt = time.Now() // Update invite timestamp
+		expiresAt := existingMember.JoinedAt.Add(inviteTTL)
+		existingMember.InviteExpiresAt = &expiresAt
 		if err := database.DB.Save(&existingMember).Error; err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update existing invite: %w", err))
 		}
@@ -355,7 +372,9 @@ func (s *Service) InviteMember(ctx context.Context, req *connect.Request[organiz
 		}
 	} else if errors.Is(err, gorm.ErrRecordNotFound) {
 		// New invite - create member
-		m = &database.OrganizationMember{ID: generateID("mem"), OrganizationID: org.ID, UserID: pendingUserID, Role: roleID, Status: "invited", JoinedAt: time.Now()}
+		invitedAt := time.Now()
+		expiresAt := invitedAt.Add(inviteTTL)
+		m = &database.OrganizationMember{ID: generateID("mem"), OrganizationID: org.ID, UserID: pendingUserID, Role: roleID, Status: "invited", JoinedAt: invitedAt, InviteExpiresAt: &expiresAt}
 		if err := database.DB.Create(m).Error; err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invite member: %w", err))
 		}
@@ -429,6 +448,14 @@ func (s *Service) ResendInvite(ctx context.Context, req *connect.Request[organiz
 		}
 	}
 
+	// Resending restarts the expiry clock. It is saved before sending so the email never
+	// quotes a deadline the invite does not have.
+	expiresAt := time.Now().Add(inviteTTL)
+	if err := database.DB.Model(&member).Update("invite_expires_at", expiresAt).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("resend invite: %w", err))
+	}
+	member.InviteExpiresAt = &expiresAt
+
 	// Resend the invite email
 	if err := s.dispatchInviteEmail(ctx, &org, &member, inviter, emailAddr); err != nil {
 		// If email fails, don't update LastInviteSentAt, so rate limit won't apply
@@ -460,7 +487,12 @@ func (s *Service) ListMyInvites(ctx context.Context, req *connect.Request[organi
 
 	// Get organization details for each invite and create notifications
 	invites := make([]*organizationsv1.PendingInvite, 0, len(members))
+	now := time.Now()
 	for _, member := range members {
+		if inviteExpired(&member, now) {
+			continue
+		}
+
 		var org database.Organization
 		if err := database.DB.First(&org, "id = ?", member.OrganizationID).Error; err != nil {
 			log.Printf("[Organizations] failed to load organization %s for invite: %v", member.OrganizationID, err)
@@ -502,6 +534,7 @@ func (s *Service) ListMyInvites(ctx context.Context, req *connect.Request[organi
 			Role:             roleName,
 			InvitedAt:        timestamppb.New(member.JoinedAt),
 			InviterEmail:     "", // TODO: Track inviter in future enhancement
+			ExpiresAt:        timestamppb.New(inviteExpiresAt(&member)),
 		})
 	}
 
@@ -541,6 +574,10 @@ func (s *Service) AcceptInvite(ctx context.Context, req *connect.Request[organiz
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("this invite is not for your email address"))
 	}
 
+	if inviteExpired(&member, time.Now()) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("invite has expired; ask an organization admin to resend it"))
+	}
+
 	// Get organization
 	var org database.Organization
 	if err := database.DB.First(&org, "id = ?", req.Msg.GetOrganizationId()).Error; err != nil {
@@ -629,6 +666,7 @@ func (s *Service) UpdateMember(ctx context.Context, req *connect.Request[organiz
 	if err := database.DB.First(&m, "id = ? AND organization_id = ?", req.Msg.GetMemberId(), req.Msg.GetOrganizationId()).Error; err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("member not found"))
 	}
+	before := memberAuditProto(&m)
 
 	requestedRole := strings.TrimSpace(req.Msg.GetRole())
 	if requestedRole != "" {
@@ -677,10 +715,16 @@ func (s *Service) UpdateMember(ctx context.Context, req *connect.Request[organiz
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update member: %w", err))
 	}
 
+	om := memberAuditProto(&m)
+	middleware.RecordAuditDiff(ctx, before, om)
+	return connect.NewResponse(&organizationsv1.UpdateMemberResponse{Member: om}), nil
+}
+
+// memberAuditProto builds the member shape returned by UpdateMember
+func memberAuditProto(m *database.OrganizationMember) *organizationsv1.OrganizationMember {
 	// Convert role ID to role name for frontend compatibility
 	roleName := getRoleNameForAPI(m.Role)
-	om := &organizationsv1.OrganizationMember{Id: m.ID, Role: roleName, Status: m.Status, JoinedAt: timestamppb.New(m.JoinedAt), User: &authv1.User{Id: m.UserID}}
-	return connect.NewResponse(&organizationsv1.UpdateMemberResponse{Member: om}), nil
+	return &organizationsv1.OrganizationMember{Id: m.ID, Role: roleName, Status: m.Status, JoinedAt: timestamppb.New(m.JoinedAt), User: &authv1.User{Id: m.UserID}}
 }
 
 func (s *Service) RemoveMember(ctx context.Context, req *connect.Request[organizationsv1.RemoveMemberRequest]) (*connect.Response[organizationsv1.RemoveMemberResponse], error) {
@@ -1509,10 +1553,19 @@ func organizationToProto(org *database.Organization) *organizationsv1.Organizati
 	if org.Domain != nil {
 		po.Domain = org.Domain
 	}
+	if org.MergedIntoID != nil {
+		po.MergedIntoOrganizationId = org.MergedIntoID
+	}
+	// Managed organizations run on the plan of their parent
+	planOrgID := org.ID
+	if org.ParentOrgID != nil {
+		po.ParentOrganizationId = org.ParentOrgID
+		planOrgID = *org.ParentOrgID
+	}
 
 	// Load plan info if organization has a plan assigned
 	var quota database.OrgQuota
-	if err := database.DB.First(&quota, "organization_id = ?", org.ID).Error; err != nil {
+	if err := database.DB.First(&quota, "organization_id = ?", planOrgID).Error; err != nil {
 		// No quota found - this is expected if plan hasn't been assigned yet
 		// EnsurePlanAssigned should have been called before this, but if not, we'll just skip plan info
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -1633,6 +1686,7 @@ func (s *Service) dispatchInviteEmail(ctx context.Context, org *database.Organiz
 	// Convert role ID to display name for email
 	roleLabel := getRoleDisplayName(member.Role)
 	greetingName := deriveNameFromEmail(inviteeEmail)
+	expiresIn := formatInviteHours(inviteHoursRemaining(member, time.Now()))
 
 	subject := fmt.Sprintf("%s invited you to %s on Obiente Cloud", inviterName, org.Name)
 	template := email.TemplateData{
@@ -1647,6 +1701,7 @@ func (s *Service) dispatchInviteEmail(ctx context.Context, org *database.Organiz
 		Highlights: []email.Highlight{
 			{Label: "Organization", Value: org.Name},
 			{Label: "Role", Value: roleLabel},
+			{Label: "Expires in", Value: expiresIn},
 		},
 		Sections: []email.Section{
 			{
@@ -1654,6 +1709,7 @@ func (s *Service) dispatchInviteEmail(ctx context.Context, org *database.Organiz
 				Lines: []string{
 					fmt.Sprintf("Sign in at %s using %s.", consoleURL, inviteeEmail),
 					"The invitation will be waiting on your dashboard - just confirm to activate access.",
+					fmt.Sprintf("The invitation expires in %s. Ask an organization admin to resend it if it lapses.", expiresIn),
 				},
 			},
 		},
@@ -2015,8 +2071,6 @@ func (s *Service) GetMyPermissions(ctx context.Context, req *connect.Request[org
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
 	}
 
-	permissionSet := make(map[string]bool)
-
 	// Superadmins have all permissions - return wildcard to indicate all permissions
 	isSuperAdmin := auth.IsSuperadmin(ctx, user)
 	if isSuperAdmin {
@@ -2025,82 +2079,89 @@ func (s *Service) GetMyPermissions(ctx context.Context, req *connect.Request[org
 		}), nil
 	}
 
+	return connect.NewResponse(&organizationsv1.GetMyPermissionsResponse{
+		Permissions: memberPermissions(ctx, orgID, user.Id),
+	}), nil
+}
+
+// memberPermissions returns the sorted permissions an organization member holds through their
+// system role, their directly assigned custom role and their role bindings, including the
+// permissions custom roles inherit from their ancestor roles. Non-members have none.
+func memberPermissions(ctx context.Context, orgID, userID string) []string {
+	permissionSet := make(map[string]bool)
+
 	// Get member record to check direct role assignment
 	// Note: We check without status filter first, then filter by status if needed
 	var member database.OrganizationMember
-	memberQuery := database.DB.Where("organization_id = ? AND user_id = ?", orgID, user.Id)
+	memberQuery := database.DB.Where("organization_id = ? AND user_id = ?", orgID, userID)
 	if err := memberQuery.First(&member).Error; err != nil {
 		// User is not a member - return empty permissions
-		logger.Debug("[GetMyPermissions] User %s is not a member of org %s", user.Id, orgID)
-		return connect.NewResponse(&organizationsv1.GetMyPermissionsResponse{
-			Permissions: []string{},
-		}), nil
+		logger.Debug("[memberPermissions] User %s is not a member of org %s", userID, orgID)
+		return []string{}
 	}
 
 	// Only process if member is active
 	if member.Status != "active" {
-		logger.Debug("[GetMyPermissions] User %s is not an active member of org %s (status: %s)", user.Id, orgID, member.Status)
-		return connect.NewResponse(&organizationsv1.GetMyPermissionsResponse{
-			Permissions: []string{},
-		}), nil
+		logger.Debug("[memberPermissions] User %s is not an active member of org %s (status: %s)", userID, orgID, member.Status)
+		return []string{}
 	}
 
 	roleID := member.Role
-	logger.Debug("[GetMyPermissions] User %s has role ID %s in org %s", user.Id, roleID, orgID)
+	logger.Debug("[memberPermissions] User %s has role ID %s in org %s", userID, roleID, orgID)
 
 	// Check if it's a system role ID
 	if auth.IsSystemRoleID(roleID) {
 		roleName := auth.GetSystemRoleNameFromID(roleID)
-		logger.Debug("[GetMyPermissions] Role ID %s maps to role name %s", roleID, roleName)
+		logger.Debug("[memberPermissions] Role ID %s maps to role name %s", roleID, roleName)
 		if roleName != "" {
 			perms := auth.GetSystemRolePermissions(roleName)
-			logger.Debug("[GetMyPermissions] System role %s has %d permissions: %v", roleName, len(perms), perms)
+			logger.Debug("[memberPermissions] System role %s has %d permissions: %v", roleName, len(perms), perms)
 			if len(perms) == 0 {
-				logger.Debug("[GetMyPermissions] WARNING: System role %s returned empty permissions!", roleName)
+				logger.Debug("[memberPermissions] WARNING: System role %s returned empty permissions!", roleName)
 			}
 			for _, perm := range perms {
 				permissionSet[perm] = true
 			}
 		} else {
-			logger.Debug("[GetMyPermissions] WARNING: Role ID %s is a system role ID but GetSystemRoleNameFromID returned empty!", roleID)
+			logger.Debug("[memberPermissions] WARNING: Role ID %s is a system role ID but GetSystemRoleNameFromID returned empty!", roleID)
 		}
 	} else {
 		// It's a custom role assigned directly - look it up in the database by ID
 		var customRole database.OrgRole
 		if err := database.DB.Where("id = ? AND organization_id = ?", roleID, orgID).First(&customRole).Error; err == nil {
-			var perms []string
-			if err := json.Unmarshal([]byte(customRole.Permissions), &perms); err == nil {
-				logger.Debug("[GetMyPermissions] Custom role %s has %d permissions", customRole.Name, len(perms))
+			if resolved, err := database.ResolveRolePermissions(ctx, database.DB, []string{customRole.ID}); err == nil {
+				perms := resolved[customRole.ID]
+				logger.Debug("[memberPermissions] Custom role %s has %d permissions including inherited ones", customRole.Name, len(perms))
 				for _, perm := range perms {
 					permissionSet[perm] = true
 				}
+			} else {
+				logger.Debug("[memberPermissions] Failed to resolve permissions of custom role %s: %v", customRole.Name, err)
 			}
 		} else {
-			logger.Debug("[GetMyPermissions] Custom role ID %s not found in org %s: %v", roleID, orgID, err)
+			logger.Debug("[memberPermissions] Custom role ID %s not found in org %s: %v", roleID, orgID, err)
 		}
 	}
 
 	// Check custom roles via role bindings
 	var bindings []database.OrgRoleBinding
-	if err := database.DB.Where("organization_id = ? AND user_id = ?", orgID, user.Id).Find(&bindings).Error; err == nil {
+	if err := database.DB.Where("organization_id = ? AND user_id = ?", orgID, userID).Find(&bindings).Error; err == nil {
 		if len(bindings) > 0 {
-			logger.Debug("[GetMyPermissions] User %s has %d role bindings in org %s", user.Id, len(bindings), orgID)
+			logger.Debug("[memberPermissions] User %s has %d role bindings in org %s", userID, len(bindings), orgID)
 			var roles []database.OrgRole
 			roleIDs := make([]string, 0, len(bindings))
 			for _, b := range bindings {
 				roleIDs = append(roleIDs, b.RoleID)
 			}
-			if err := database.DB.Where("id IN ?", roleIDs).Find(&roles).Error; err == nil {
+			resolved, resolveErr := database.ResolveRolePermissions(ctx, database.DB, roleIDs)
+			if err := database.DB.Where("id IN ?", roleIDs).Find(&roles).Error; err == nil && resolveErr == nil {
 				for _, r := range roles {
 					// Skip system roles in database (they shouldn't be there, but just in case)
 					if auth.IsSystemRole(r.Name) {
 						continue
 					}
-					var perms []string
-					if err := json.Unmarshal([]byte(r.Permissions), &perms); err == nil {
-						for _, perm := range perms {
-							permissionSet[perm] = true
-						}
+					for _, perm := range resolved[r.ID] {
+						permissionSet[perm] = true
 					}
 				}
 			}
@@ -2114,16 +2175,14 @@ func (s *Service) GetMyPermissions(ctx context.Context, req *connect.Request[org
 	}
 	sort.Strings(permissions)
 
-	logger.Debug("[GetMyPermissions] User %s has %d total permissions in org %s: %v", user.Id, len(permissions), orgID, permissions)
+	logger.Debug("[memberPermissions] User %s has %d total permissions in org %s: %v", userID, len(permissions), orgID, permissions)
 
 	// Ensure we always return a non-nil slice (even if empty)
 	if permissions == nil {
 		permissions = []string{}
 	}
 
-	return connect.NewResponse(&organizationsv1.GetMyPermissionsResponse{
-		Permissions: permissions,
-	}), nil
+	return permissions
 }
 
 // AdminSetPlan sets the active plan for an organization (superadmin only)
@@ -12,7 +12,6 @@ import (
 	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
 	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
 	"google.golang.org/protobuf/proto"
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -78,26 +77,12 @@ func TestOrganizationServiceTenantIsolation(t *testing.T) {
 func newOrganizationServiceTestDB(t *testing.T) *gorm.DB {
 	t.Helper()
 
-	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	if err != nil {
-		t.Fatalf("open sqlite db: %v", err)
-	}
-	if err := db.AutoMigrate(
+	return newTestDB(t,
 		&database.Organization{},
 		&database.OrganizationMember{},
 		&database.OrganizationPlan{},
 		&database.OrgQuota{},
-	); err != nil {
-		t.Fatalf("migrate sqlite db: %v", err)
-	}
-
-	previousDB := database.DB
-	database.DB = db
-	t.Cleanup(func() {
-		database.DB = previousDB
-	})
-
-	return db
+	)
 }
 
 func seedOrganizationServiceIsolationData(t *testing.T, db *gorm.DB) {
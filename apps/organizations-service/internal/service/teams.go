@@ -0,0 +1,278 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/quota"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const maxTeamNameLength = 63
+
+// CreateTeam creates a team of organization members
+func (s *Service) CreateTeam(ctx context.Context, req *connect.Request[organizationsv1.CreateTeamRequest]) (*connect.Response[organizationsv1.CreateTeamResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	if err := common.AuthorizeOrgRoles(ctx, orgID, user, "owner", "admin"); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Msg.GetName())
+	if name == "" || len(name) > maxTeamNameLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be 1-%d characters", maxTeamNameLength))
+	}
+
+	// Only active members of the organization can join its teams
+	memberIDs := make([]string, 0, len(req.Msg.GetMemberUserIds()))
+	seen := make(map[string]bool)
+	for _, userID := range req.Msg.GetMemberUserIds() {
+		userID = strings.TrimSpace(userID)
+		if userID == "" || seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		memberIDs = append(memberIDs, userID)
+	}
+	if len(memberIDs) > 0 {
+		var count int64
+		if err := database.DB.Model(&database.OrganizationMember{}).
+			Where("organization_id = ? AND user_id IN ? AND status = ?", orgID, memberIDs, "active").
+			Count(&count).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create team: %w", err))
+		}
+		if int(count) != len(memberIDs) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("team members must be active members of the organization"))
+		}
+	}
+
+	now := time.Now()
+	team := database.Team{
+		ID:             generateID("team"),
+		OrganizationID: orgID,
+		Name:           name,
+		CreatedBy:      user.Id,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&team).Error; err != nil {
+			return err
+		}
+		for _, userID := range memberIDs {
+			if err := tx.Create(&database.TeamMember{TeamID: team.ID, UserID: userID, CreatedAt: now}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create team: %w", err))
+	}
+
+	return connect.NewResponse(&organizationsv1.CreateTeamResponse{Team: teamToProto(&team, memberIDs)}), nil
+}
+
+// DeleteTeam deletes a team with its members and quota; its resources lose their team tag
+func (s *Service) DeleteTeam(ctx context.Context, req *connect.Request[organizationsv1.DeleteTeamRequest]) (*connect.Response[organizationsv1.DeleteTeamResponse], error) {
+	orgID, teamID, err := authorizeTeamAdmin(ctx, req.Msg.GetOrganizationId(), req.Msg.GetTeamId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := database.DeleteTeam(orgID, teamID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("team %s not found", teamID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("delete team: %w", err))
+	}
+	return connect.NewResponse(&organizationsv1.DeleteTeamResponse{Success: true}), nil
+}
+
+// SetTeamQuota creates or replaces the quota for the resources tagged with a team
+func (s *Service) SetTeamQuota(ctx context.Context, req *connect.Request[organizationsv1.SetTeamQuotaRequest]) (*connect.Response[organizationsv1.SetTeamQuotaResponse], error) {
+	orgID, teamID, err := authorizeTeamAdmin(ctx, req.Msg.GetOrganizationId(), req.Msg.GetTeamId())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := loadTeam(orgID, teamID); err != nil {
+		return nil, err
+	}
+
+	limits := req.Msg.GetQuota()
+	if limits == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("quota is required"))
+	}
+	if limits.GetCpuCores() < 0 || limits.GetMemoryBytes() < 0 || limits.GetDeploymentsMax() < 0 ||
+		limits.GetMaxVpsInstances() < 0 || limits.GetBandwidthBytesMonth() < 0 || limits.GetStorageBytes() < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("quota limits cannot be negative"))
+	}
+
+	teamQuota := database.TeamQuota{TeamID: teamID, OrganizationID: orgID}
+	if limits.CpuCores != nil {
+		teamQuota.CPUCores = intPtr(limits.GetCpuCores())
+	}
+	if limits.MemoryBytes != nil {
+		teamQuota.MemoryBytes = limits.MemoryBytes
+	}
+	if limits.DeploymentsMax != nil {
+		teamQuota.DeploymentsMax = intPtr(limits.GetDeploymentsMax())
+	}
+	if limits.MaxVpsInstances != nil {
+		teamQuota.MaxVpsInstances = intPtr(limits.GetMaxVpsInstances())
+	}
+	if limits.BandwidthBytesMonth != nil {
+		teamQuota.BandwidthBytesMonth = limits.BandwidthBytesMonth
+	}
+	if limits.StorageBytes != nil {
+		teamQuota.StorageBytes = limits.StorageBytes
+	}
+	if err := database.DB.Save(&teamQuota).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("set team quota: %w", err))
+	}
+
+	return connect.NewResponse(&organizationsv1.SetTeamQuotaResponse{Quota: teamQuotaToProto(&teamQuota)}), nil
+}
+
+// GetTeamUsage returns the usage of the resources tagged with a team next to its quota
+func (s *Service) GetTeamUsage(ctx context.Context, req *connect.Request[organizationsv1.GetTeamUsageRequest]) (*connect.Response[organizationsv1.GetTeamUsageResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID := strings.TrimSpace(req.Msg.GetOrganizationId())
+	teamID := strings.TrimSpace(req.Msg.GetTeamId())
+	if orgID == "" || teamID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id and team_id are required"))
+	}
+	if err := common.VerifyOrgAccess(ctx, orgID, user); err != nil {
+		return nil, err
+	}
+
+	team, err := loadTeam(orgID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	var memberIDs []string
+	if err := database.DB.Model(&database.TeamMember{}).Where("team_id = ?", teamID).
+		Order("created_at").Pluck("user_id", &memberIDs).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get team members: %w", err))
+	}
+
+	usage, err := quota.NewChecker().TeamUsage(orgID, teamID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &organizationsv1.GetTeamUsageResponse{
+		Team: teamToProto(team, memberIDs),
+		Usage: &organizationsv1.TeamUsage{
+			Deployments:    int32(usage.Deployments),
+			GameServers:    int32(usage.GameServers),
+			VpsInstances:   int32(usage.VPSInstances),
+			MemoryBytes:    usage.MemoryBytes,
+			CpuCores:       int32(usage.CPUCores),
+			StorageBytes:   usage.StorageBytes,
+			BandwidthBytes: usage.BandwidthBytes,
+		},
+	}
+	var teamQuota database.TeamQuota
+	if err := database.DB.Where("team_id = ?", teamID).Limit(1).Find(&teamQuota).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get team quota: %w", err))
+	}
+	if teamQuota.TeamID != "" {
+		res.Quota = teamQuotaToProto(&teamQuota)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// authorizeTeamAdmin validates the IDs and requires the caller to be an owner or admin of the organization
+func authorizeTeamAdmin(ctx context.Context, orgID, teamID string) (string, string, error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return "", "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+
+	orgID = strings.TrimSpace(orgID)
+	teamID = strings.TrimSpace(teamID)
+	if orgID == "" || teamID == "" {
+		return "", "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id and team_id are required"))
+	}
+	if err := common.AuthorizeOrgRoles(ctx, orgID, user, "owner", "admin"); err != nil {
+		return "", "", err
+	}
+	return orgID, teamID, nil
+}
+
+// loadTeam returns the organization's team; teams of other organizations are reported as missing
+func loadTeam(orgID, teamID string) (*database.Team, error) {
+	team, err := database.GetTeam(orgID, teamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("team %s not found", teamID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("get team: %w", err))
+	}
+	return team, nil
+}
+
+func teamToProto(team *database.Team, memberIDs []string) *organizationsv1.Team {
+	if memberIDs == nil {
+		memberIDs = []string{}
+	}
+	return &organizationsv1.Team{
+		Id:             team.ID,
+		OrganizationId: team.OrganizationID,
+		Name:           team.Name,
+		CreatedBy:      team.CreatedBy,
+		MemberUserIds:  memberIDs,
+		CreatedAt:      timestamppb.New(team.CreatedAt),
+	}
+}
+
+func teamQuotaToProto(q *database.TeamQuota) *organizationsv1.TeamQuota {
+	out := &organizationsv1.TeamQuota{
+		MemoryBytes:         q.MemoryBytes,
+		BandwidthBytesMonth: q.BandwidthBytesMonth,
+		StorageBytes:        q.StorageBytes,
+	}
+	if q.CPUCores != nil {
+		out.CpuCores = int32Ptr(*q.CPUCores)
+	}
+	if q.DeploymentsMax != nil {
+		out.DeploymentsMax = int32Ptr(*q.DeploymentsMax)
+	}
+	if q.MaxVpsInstances != nil {
+		out.MaxVpsInstances = int32Ptr(*q.MaxVpsInstances)
+	}
+	return out
+}
+
+func intPtr(v int32) *int {
+	i := int(v)
+	return &i
+}
+
+func int32Ptr(v int) *int32 {
+	i := int32(v)
+	return &i
+}
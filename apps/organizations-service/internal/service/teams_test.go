@@ -0,0 +1,106 @@
+package organizations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTeamLifecycle(t *testing.T) {
+	db := newTestDB(t,
+		&database.Organization{},
+		&database.OrganizationMember{},
+		&database.Deployment{},
+		&database.GameServer{},
+		&database.VPSInstance{},
+		&database.ResourceTag{},
+		&database.Team{},
+		&database.TeamMember{},
+		&database.TeamQuota{},
+	)
+	seedOrganizationServiceIsolationData(t, db)
+	now := time.Now().UTC()
+	memory := int64(512 * 1024 * 1024)
+	seed := []any{
+		&database.OrganizationMember{ID: "member-org-a-dev", OrganizationID: "org-a", UserID: "user-org-a-dev", Role: auth.SystemRoleIDMember, Status: "active", JoinedAt: now},
+		&database.Deployment{ID: "dep-a", OrganizationID: "org-a", MemoryBytes: &memory},
+	}
+	for _, record := range seed {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	service := NewService(Config{}).(*Service)
+	owner := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+	member := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a-dev", Email: "user-org-a-dev@example.com"})
+	outsider := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-b", Email: "user-org-b@example.com"})
+
+	if _, err := service.CreateTeam(member, connect.NewRequest(&organizationsv1.CreateTeamRequest{OrganizationId: "org-a", Name: "Platform"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("CreateTeam as member code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+	}
+	if _, err := service.CreateTeam(owner, connect.NewRequest(&organizationsv1.CreateTeamRequest{OrganizationId: "org-a", Name: "Platform", MemberUserIds: []string{"user-org-b"}})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("CreateTeam with a non-member code = %v, want %v", connect.CodeOf(err), connect.CodeInvalidArgument)
+	}
+
+	created, err := service.CreateTeam(owner, connect.NewRequest(&organizationsv1.CreateTeamRequest{
+		OrganizationId: "org-a",
+		Name:           "Platform",
+		MemberUserIds:  []string{"user-org-a-dev"},
+	}))
+	if err != nil {
+		t.Fatalf("CreateTeam: %v", err)
+	}
+	team := created.Msg.GetTeam()
+	if err := database.SetResourceTag("org-a", database.ResourceTagTypeDeployment, "dep-a", database.ResourceTagKeyTeam, team.GetId()); err != nil {
+		t.Fatalf("tag deployment: %v", err)
+	}
+
+	if _, err := service.SetTeamQuota(owner, connect.NewRequest(&organizationsv1.SetTeamQuotaRequest{
+		OrganizationId: "org-a",
+		TeamId:         team.GetId(),
+		Quota:          &organizationsv1.TeamQuota{DeploymentsMax: proto.Int32(3), MemoryBytes: proto.Int64(4 * memory)},
+	})); err != nil {
+		t.Fatalf("SetTeamQuota: %v", err)
+	}
+
+	usage, err := service.GetTeamUsage(member, connect.NewRequest(&organizationsv1.GetTeamUsageRequest{OrganizationId: "org-a", TeamId: team.GetId()}))
+	if err != nil {
+		t.Fatalf("GetTeamUsage: %v", err)
+	}
+	if got := usage.Msg.GetUsage(); got.GetDeployments() != 1 || got.GetMemoryBytes() != memory {
+		t.Fatalf("team usage = %+v, want the tagged deployment", got)
+	}
+	if got := usage.Msg.GetQuota(); got.GetDeploymentsMax() != 3 || got.CpuCores != nil {
+		t.Fatalf("team quota = %+v", got)
+	}
+	if members := usage.Msg.GetTeam().GetMemberUserIds(); len(members) != 1 || members[0] != "user-org-a-dev" {
+		t.Fatalf("team members = %v", members)
+	}
+
+	// Other organizations cannot see or change the team
+	if _, err := service.GetTeamUsage(outsider, connect.NewRequest(&organizationsv1.GetTeamUsageRequest{OrganizationId: "org-a", TeamId: team.GetId()})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("GetTeamUsage from another organization code = %v, want %v", connect.CodeOf(err), connect.CodePermissionDenied)
+	}
+	if _, err := service.DeleteTeam(outsider, connect.NewRequest(&organizationsv1.DeleteTeamRequest{OrganizationId: "org-b", TeamId: team.GetId()})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("DeleteTeam through another organization code = %v, want %v", connect.CodeOf(err), connect.CodeNotFound)
+	}
+
+	if _, err := service.DeleteTeam(owner, connect.NewRequest(&organizationsv1.DeleteTeamRequest{OrganizationId: "org-a", TeamId: team.GetId()})); err != nil {
+		t.Fatalf("DeleteTeam: %v", err)
+	}
+	for _, model := range []any{&database.Team{}, &database.TeamMember{}, &database.TeamQuota{}, &database.ResourceTag{}} {
+		var count int64
+		db.Model(model).Count(&count)
+		if count != 0 {
+			t.Fatalf("%d %T rows left after DeleteTeam", count, model)
+		}
+	}
+}
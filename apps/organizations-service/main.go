@@ -53,6 +53,9 @@ func main() {
 		&database.OrganizationMember{},
 		&database.OrgRole{},
 		&database.OrgRoleBinding{},
+		&database.RoleInheritance{},
+		&database.SAMLConfig{},
+		&database.OrgActivityEvent{},
 	)
 
 	// Initialize database
@@ -61,6 +64,13 @@ func main() {
 	}
 	logger.Info("✓ Database initialized")
 
+	// Initialize Redis (for maintenance mode, etc.)
+	if err := database.InitRedis(); err != nil {
+		logger.Warn("Redis initialization failed: %v. Some features may not work correctly.", err)
+	} else {
+		logger.Info("✓ Redis initialized")
+	}
+
 	// Run service-specific migrations
 	// Each service should only run migrations relevant to its own tables/data.
 	// This pattern avoids redundant migration execution and improves startup time.
@@ -96,6 +106,9 @@ func main() {
 	// Create audit interceptor
 	auditInterceptor := middleware.AuditLogInterceptor()
 
+	// Create maintenance mode interceptor (rejects mutating RPCs during platform maintenance)
+	maintenanceInterceptor := middleware.MaintenanceModeInterceptor()
+
 	// Configure email sender and shared links
 	mailer := email.NewSenderFromEnv()
 	consoleURL := platform.DashboardURL()
@@ -109,7 +122,7 @@ func main() {
 	})
 	organizationsPath, organizationsHandler := organizationsv1connect.NewOrganizationServiceHandler(
 		orgService,
-		connect.WithInterceptors(auditInterceptor, authInterceptor),
+		connect.WithInterceptors(auditInterceptor, authInterceptor, maintenanceInterceptor),
 	)
 	mux.Handle(organizationsPath, organizationsHandler)
 
@@ -154,6 +167,9 @@ func main() {
 	shutdownCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	// Delete invites that were never accepted
+	go orgservice.StartInviteCleanup(shutdownCtx)
+
 	// Start server in a goroutine
 	serverErr := make(chan error, 1)
 	go func() {
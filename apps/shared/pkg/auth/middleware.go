@@ -296,6 +296,11 @@ func MiddlewareInterceptor(config *AuthConfig) connect.UnaryInterceptorFunc {
 
 // validateToken validates a token against Zitadel's userinfo endpoint
 func (c *AuthConfig) validateToken(ctx context.Context, token string) (*authv1.User, error) {
+	// Tokens issued by the platform itself (e.g. after SAML SSO) are verified locally
+	if isPlatformToken(token) {
+		return parsePlatformToken(token)
+	}
+
 	// Check cache first
 	if cachedUser, found := c.UserInfoCache.Get(ctx, token); found {
 		logger.Debug("✓ Token validated (cached) for user: %s (%s)", cachedUser.Id, cachedUser.Email)
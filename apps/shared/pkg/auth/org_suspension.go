@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrOrganizationSuspended is returned for requests against a suspended or banned organization
+var ErrOrganizationSuspended = errors.New("organization is suspended")
+
+// orgSuspensionCacheTTL bounds how long a lifted suspension can keep blocking requests
+const orgSuspensionCacheTTL = 30 * time.Second
+
+// resourceIDFields maps request fields naming a resource to the resource type that owns them
+var resourceIDFields = []struct {
+	field        string
+	resourceType string
+}{
+	{"deploymentId", database.ResourceTagTypeDeployment},
+	{"gameServerId", database.ResourceTagTypeGameServer},
+	{"vpsId", database.ResourceTagTypeVPS},
+}
+
+type orgSuspensionCacheEntry struct {
+	suspended bool
+	expiresAt time.Time
+}
+
+// OrgSuspensionInterceptor rejects requests against suspended organizations with
+// CodePermissionDenied. The organization is taken from the request's organization_id, or
+// looked up from the deployment, game server or VPS the request names.
+// It must run after MiddlewareInterceptor so superadmins can be let through.
+func OrgSuspensionInterceptor() connect.UnaryInterceptorFunc {
+	var (
+		mu    sync.Mutex
+		cache = make(map[string]orgSuspensionCacheEntry)
+	)
+
+	isSuspended := func(orgID string) (bool, error) {
+		mu.Lock()
+		entry, ok := cache[orgID]
+		mu.Unlock()
+		if ok && time.Now().Before(entry.expiresAt) {
+			return entry.suspended, nil
+		}
+
+		suspended, err := database.IsOrganizationSuspended(database.DB, orgID)
+		if err != nil {
+			return false, err
+		}
+		mu.Lock()
+		cache[orgID] = orgSuspensionCacheEntry{suspended: suspended, expiresAt: time.Now().Add(orgSuspensionCacheTTL)}
+		mu.Unlock()
+		return suspended, nil
+	}
+
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			// Internal service calls carry no user and are not subject to suspension
+			user, err := GetUserFromContext(ctx)
+			if err != nil || HasRole(user, RoleSuperAdmin) {
+				return next(ctx, req)
+			}
+
+			orgID := requestOrganizationID(req)
+			if orgID == "" {
+				return next(ctx, req)
+			}
+
+			suspended, err := isSuspended(orgID)
+			if err != nil {
+				// Fail open: a database hiccup should not take every service down
+				logger.Warn("[Suspension] Failed to check suspension of organization %s: %v", orgID, err)
+				return next(ctx, req)
+			}
+			if suspended {
+				logger.Debug("[Suspension] Rejected %s for suspended organization %s", req.Spec().Procedure, orgID)
+				return nil, connect.NewError(connect.CodePermissionDenied, ErrOrganizationSuspended)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// requestOrganizationID returns the organization a request acts on, resolving it from the
+// named resource when the request has no organization_id
+func requestOrganizationID(req connect.AnyRequest) string {
+	if orgID := extractOrgID(req); orgID != "" {
+		return orgID
+	}
+
+	protoMsg, ok := req.Any().(proto.Message)
+	if !ok {
+		return ""
+	}
+	jsonBytes, err := protojson.Marshal(protoMsg)
+	if err != nil {
+		return ""
+	}
+	var jsonData map[string]interface{}
+	if err := json.Unmarshal(jsonBytes, &jsonData); err != nil {
+		return ""
+	}
+
+	for _, f := range resourceIDFields {
+		resourceID, ok := jsonData[f.field].(string)
+		if !ok || resourceID == "" {
+			continue
+		}
+		orgID, err := database.ResourceOrganizationID(f.resourceType, resourceID)
+		if err != nil {
+			// Missing resources are reported by the handler itself
+			return ""
+		}
+		return orgID
+	}
+	return ""
+}
@@ -71,7 +71,10 @@ func RegisterDeploymentServiceProcedures() {
 		{"/obiente.cloud.deployments.v1.DeploymentService/StartDeployment", "deployment.start", "deployment", "start", "Start deployment"},
 		{"/obiente.cloud.deployments.v1.DeploymentService/StopDeployment", "deployment.stop", "deployment", "stop", "Stop deployment"},
 		{"/obiente.cloud.deployments.v1.DeploymentService/RestartDeployment", "deployment.restart", "deployment", "restart", "Restart deployment"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/RollbackDeployment", "deployment.restart", "deployment", "restart", "Roll back deployment"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/ListDeploymentVersions", "deployment.read", "deployment", "read", "List deployment versions"},
 		{"/obiente.cloud.deployments.v1.DeploymentService/ScaleDeployment", "deployment.scale", "deployment", "scale", "Scale deployment"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/SetResourceLimits", "deployment.update", "deployment", "update", "Set deployment CPU and memory limits"},
 
 		// Logs and monitoring
 		{"/obiente.cloud.deployments.v1.DeploymentService/GetDeploymentLogs", "deployment.logs", "deployment", "logs", "View deployment logs"},
@@ -81,21 +84,38 @@ func RegisterDeploymentServiceProcedures() {
 		{"/obiente.cloud.deployments.v1.DeploymentService/StreamDeploymentMetrics", "deployment.read", "deployment", "read", "Stream deployment metrics"},
 		{"/obiente.cloud.deployments.v1.DeploymentService/GetDeploymentUsage", "deployment.read", "deployment", "read", "View deployment usage"},
 		{"/obiente.cloud.deployments.v1.DeploymentService/StreamDeploymentStatus", "deployment.read", "deployment", "read", "Stream deployment status"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/UpdateDeploymentHealthCheck", "deployment.update", "deployment", "update", "Configure deployment health check"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/SetDeploymentAffinityRules", "deployment.update", "deployment", "update", "Configure deployment affinity rules"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/GetDeploymentAffinityRules", "deployment.read", "deployment", "read", "View deployment affinity rules"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/CreateScaleSchedule", "deployment.scale", "deployment", "scale", "Create deployment scale schedule"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/DeleteScaleSchedule", "deployment.scale", "deployment", "scale", "Delete deployment scale schedule"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/ListScaleSchedules", "deployment.read", "deployment", "read", "View deployment scale schedules"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/SetAutoScalePolicy", "deployment.scale", "deployment", "scale", "Configure deployment auto-scaling"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/GetAutoScalePolicy", "deployment.read", "deployment", "read", "View deployment auto-scaling policy"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/DisableAutoScale", "deployment.scale", "deployment", "scale", "Disable deployment auto-scaling"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/GetDeploymentRegionStatus", "deployment.read", "deployment", "read", "View deployment region status"},
 
 		// Environment variables
 		{"/obiente.cloud.deployments.v1.DeploymentService/GetDeploymentEnvVars", "deployment.read", "deployment", "read", "View deployment environment variables"},
 		{"/obiente.cloud.deployments.v1.DeploymentService/UpdateDeploymentEnvVars", "deployment.update", "deployment", "update", "Update deployment environment variables"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/RotateEnvKey", "organization.update", "organization", "update", "Rotate environment variable encryption key"},
 
 		// Compose files
 		{"/obiente.cloud.deployments.v1.DeploymentService/GetDeploymentCompose", "deployment.read", "deployment", "read", "View deployment compose file"},
 		{"/obiente.cloud.deployments.v1.DeploymentService/ValidateDeploymentCompose", "deployment.read", "deployment", "read", "Validate deployment compose file"},
 		{"/obiente.cloud.deployments.v1.DeploymentService/UpdateDeploymentCompose", "deployment.update", "deployment", "update", "Update deployment compose file"},
 
+		// Custom domains
+		{"/obiente.cloud.deployments.v1.DeploymentService/CreateCustomDomain", "deployment.update", "deployment", "update", "Add custom domain"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/VerifyCustomDomain", "deployment.update", "deployment", "update", "Verify custom domain and issue certificate"},
+
 		// GitHub integration
 		{"/obiente.cloud.deployments.v1.DeploymentService/ListGitHubRepos", "deployment.read", "deployment", "read", "View GitHub repositories"},
 		{"/obiente.cloud.deployments.v1.DeploymentService/GetGitHubBranches", "deployment.read", "deployment", "read", "View GitHub branches"},
 		{"/obiente.cloud.deployments.v1.DeploymentService/GetGitHubFile", "deployment.read", "deployment", "read", "View GitHub file"},
 		{"/obiente.cloud.deployments.v1.DeploymentService/ListAvailableGitHubIntegrations", "deployment.read", "deployment", "read", "View available GitHub integrations"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/RotateDeployKey", "deployment.update", "deployment", "update", "Rotate GitHub deploy keys"},
+		{"/obiente.cloud.deployments.v1.DeploymentService/RotateWebhookSecret", "deployment.update", "deployment", "update", "Rotate GitHub webhook secrets"},
 
 		// Builds
 		{"/obiente.cloud.deployments.v1.DeploymentService/ListBuilds", "deployment.read", "deployment", "read", "View builds"},
@@ -143,6 +163,8 @@ func RegisterVPSServiceProcedures() {
 		"/obiente.cloud.vps.v1.VPSService/StartVPS":           "StartVPS",
 		"/obiente.cloud.vps.v1.VPSService/StopVPS":            "StopVPS",
 		"/obiente.cloud.vps.v1.VPSService/RebootVPS":          "RebootVPS",
+		"/obiente.cloud.vps.v1.VPSService/ForceStopVPS":       "ForceStopVPS",
+		"/obiente.cloud.vps.v1.VPSService/PatchVPS":           "PatchVPS",
 		"/obiente.cloud.vps.v1.VPSService/StreamVPSStatus":    "StreamVPSStatus",
 		"/obiente.cloud.vps.v1.VPSService/GetVPSMetrics":      "GetVPSMetrics",
 		"/obiente.cloud.vps.v1.VPSService/StreamVPSMetrics":   "StreamVPSMetrics",
@@ -152,39 +174,95 @@ func RegisterVPSServiceProcedures() {
 		"/obiente.cloud.vps.v1.VPSService/ListVPSSizes":       "ListVPSSizes",
 		"/obiente.cloud.vps.v1.VPSService/ListVPSRegions":     "ListVPSRegions",
 		"/obiente.cloud.vps.v1.VPSService/GetVPSProxyInfo":    "GetVPSProxyInfo",
+		"/obiente.cloud.vps.v1.VPSService/GetVPSConsoleURL":   "GetVPSConsoleURL",
+		"/obiente.cloud.vps.v1.VPSService/GetVPSVNCSession":   "GetVPSVNCSession",
 		"/obiente.cloud.vps.v1.VPSService/ListFirewallRules":  "ListFirewallRules",
 		"/obiente.cloud.vps.v1.VPSService/GetFirewallRule":    "GetFirewallRule",
 		"/obiente.cloud.vps.v1.VPSService/CreateFirewallRule": "CreateFirewallRule",
 		"/obiente.cloud.vps.v1.VPSService/UpdateFirewallRule": "UpdateFirewallRule",
 		"/obiente.cloud.vps.v1.VPSService/DeleteFirewallRule": "DeleteFirewallRule",
+		"/obiente.cloud.vps.v1.VPSService/CreateVPSTemplate":  "CreateVPSTemplate",
+		"/obiente.cloud.vps.v1.VPSService/ListVPSTemplates":   "ListVPSTemplates",
+		"/obiente.cloud.vps.v1.VPSService/DeleteVPSTemplate":  "DeleteVPSTemplate",
+		"/obiente.cloud.vps.v1.VPSService/ListOrgISOs":        "ListOrgISOs",
+		"/obiente.cloud.vps.v1.VPSService/DeleteISO":          "DeleteISO",
+		"/obiente.cloud.vps.v1.VPSService/MountISO":           "MountISO",
+		"/obiente.cloud.vps.v1.VPSService/UnmountISO":         "UnmountISO",
 	}
 
 	RegisterServiceProcedures("VPSService", procedures, public)
 }
 
 // RegisterGameServerServiceProcedures registers all GameServerService procedures
+// Note: Operations that change a running server (console commands, mods, backups) are
+// registered under gameserver.update rather than an action derived from the method name
 func RegisterGameServerServiceProcedures() {
+	registry := GetPermissionRegistry()
 	public := []string{}
-	procedures := map[string]string{
-		"/obiente.cloud.gameservers.v1.GameServerService/ListGameServers":                        "ListGameServers",
-		"/obiente.cloud.gameservers.v1.GameServerService/CreateGameServer":                       "CreateGameServer",
-		"/obiente.cloud.gameservers.v1.GameServerService/GetGameServer":                          "GetGameServer",
-		"/obiente.cloud.gameservers.v1.GameServerService/UpdateGameServer":                       "UpdateGameServer",
-		"/obiente.cloud.gameservers.v1.GameServerService/DeleteGameServer":                       "DeleteGameServer",
-		"/obiente.cloud.gameservers.v1.GameServerService/StartGameServer":                        "StartGameServer",
-		"/obiente.cloud.gameservers.v1.GameServerService/StopGameServer":                         "StopGameServer",
-		"/obiente.cloud.gameservers.v1.GameServerService/RestartGameServer":                      "RestartGameServer",
-		"/obiente.cloud.gameservers.v1.GameServerService/StreamGameServerStatus":                 "StreamGameServerStatus",
-		"/obiente.cloud.gameservers.v1.GameServerService/GetGameServerMetrics":                   "GetGameServerMetrics",
-		"/obiente.cloud.gameservers.v1.GameServerService/StreamGameServerMetrics":                "StreamGameServerMetrics",
-		"/obiente.cloud.gameservers.v1.GameServerService/GetGameServerUsage":                     "GetGameServerUsage",
-		"/obiente.cloud.gameservers.v1.GameServerService/ChunkUploadGameServerFiles":             "ChunkUploadGameServerFiles",
-		"/obiente.cloud.gameservers.v1.GameServerService/ListGameServerFileTransferCredentials":  "ListGameServerFileTransferCredentials",
-		"/obiente.cloud.gameservers.v1.GameServerService/CreateGameServerFileTransferCredential": "CreateGameServerFileTransferCredential",
-		"/obiente.cloud.gameservers.v1.GameServerService/RevokeGameServerFileTransferCredential": "RevokeGameServerFileTransferCredential",
+
+	gameServerProcedures := []struct {
+		procedure    string
+		permission   string
+		resourceType string
+		action       string
+		description  string
+	}{
+		// Basic CRUD
+		{"/obiente.cloud.gameservers.v1.GameServerService/ListGameServers", "gameserver.read", "gameserver", "read", "View game servers"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/CreateGameServer", "gameserver.create", "gameserver", "create", "Create game server"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/GetGameServer", "gameserver.read", "gameserver", "read", "View game server details"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/UpdateGameServer", "gameserver.update", "gameserver", "update", "Update game server configuration"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/DeleteGameServer", "gameserver.delete", "gameserver", "delete", "Delete game server"},
+
+		// Lifecycle operations
+		{"/obiente.cloud.gameservers.v1.GameServerService/StartGameServer", "gameserver.start", "gameserver", "start", "Start game server"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/StopGameServer", "gameserver.stop", "gameserver", "stop", "Stop game server"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/RestartGameServer", "gameserver.restart", "gameserver", "restart", "Restart game server"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/StreamGameServerStatus", "gameserver.read", "gameserver", "read", "Stream game server status"},
+
+		// Console
+		{"/obiente.cloud.gameservers.v1.GameServerService/ExecuteGameServerCommand", "gameserver.update", "gameserver", "update", "Execute game server console commands"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/ManagePlayerWhitelist", "gameserver.update", "gameserver", "update", "Manage game server whitelist"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/ManagePlayerBanList", "gameserver.update", "gameserver", "update", "Manage game server ban list"},
+
+		// Metrics and usage
+		{"/obiente.cloud.gameservers.v1.GameServerService/GetGameServerMetrics", "gameserver.read", "gameserver", "read", "View game server metrics"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/StreamGameServerMetrics", "gameserver.read", "gameserver", "read", "Stream game server metrics"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/GetGameServerUsage", "gameserver.read", "gameserver", "read", "View game server usage"},
+
+		// Files and file transfer
+		{"/obiente.cloud.gameservers.v1.GameServerService/ChunkUploadGameServerFiles", "gameserver.manage", "gameserver", "manage", "Upload game server files"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/ListGameServerFileTransferCredentials", "gameserver.read", "gameserver", "read", "View file transfer credentials"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/CreateGameServerFileTransferCredential", "gameserver.create", "gameserver", "create", "Create file transfer credential"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/RevokeGameServerFileTransferCredential", "gameserver.revoke", "gameserver", "revoke", "Revoke file transfer credential"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/AddAuthorizedKey", "gameserver.update", "gameserver", "update", "Authorize SSH key for file transfer credential"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/RemoveAuthorizedKey", "gameserver.update", "gameserver", "update", "Remove SSH key from file transfer credential"},
+
+		// Mods are installed into the server's volume and restart it
+		{"/obiente.cloud.gameservers.v1.GameServerService/InstallGameServerMod", "gameserver.update", "gameserver", "update", "Install game server mods"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/UninstallGameServerMod", "gameserver.update", "gameserver", "update", "Uninstall game server mods"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/ListGameServerMods", "gameserver.read", "gameserver", "read", "View installed game server mods"},
+
+		// Backup schedules and restores change the server's data
+		{"/obiente.cloud.gameservers.v1.GameServerService/ScheduleGameServerBackup", "gameserver.update", "gameserver", "update", "Schedule game server backups"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/ListGameServerBackups", "gameserver.read", "gameserver", "read", "View game server backups"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/RestoreGameServerBackup", "gameserver.update", "gameserver", "update", "Restore game server backups"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/SetDiscordIntegration", "gameserver.update", "gameserver", "update", "Configure game server Discord notifications"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/DeleteDiscordIntegration", "gameserver.update", "gameserver", "update", "Remove game server Discord notifications"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/SetIdleShutdownPolicy", "gameserver.update", "gameserver", "update", "Configure game server idle shutdown"},
+		{"/obiente.cloud.gameservers.v1.GameServerService/GetIdleShutdownPolicy", "gameserver.read", "gameserver", "read", "View game server idle shutdown"},
 	}
 
-	RegisterServiceProcedures("GameServerService", procedures, public)
+	for _, proc := range gameServerProcedures {
+		isPublic := false
+		for _, pub := range public {
+			if pub == proc.procedure {
+				isPublic = true
+				break
+			}
+		}
+		registry.RegisterProcedure(proc.procedure, proc.permission, proc.resourceType, proc.action, proc.description, isPublic)
+	}
 }
 
 // RegisterBillingServiceProcedures registers all BillingService procedures
@@ -192,7 +270,10 @@ func RegisterGameServerServiceProcedures() {
 // to avoid confusing permissions like "billing.attach" or "billing.detach"
 func RegisterBillingServiceProcedures() {
 	registry := GetPermissionRegistry()
-	public := []string{}
+	// GetSupportedCurrencies lists currencies and rates, which are not organization data
+	public := []string{
+		"/obiente.cloud.billing.v1.BillingService/GetSupportedCurrencies",
+	}
 
 	// Register with explicit, semantic permission names
 	billingProcedures := []struct {
@@ -214,6 +295,19 @@ func RegisterBillingServiceProcedures() {
 		{"/obiente.cloud.billing.v1.BillingService/SetDefaultPaymentMethod", "billing.update", "billing", "update", "Set default payment method"},
 		{"/obiente.cloud.billing.v1.BillingService/GetPaymentStatus", "billing.read", "billing", "read", "View payment status"},
 		{"/obiente.cloud.billing.v1.BillingService/ListInvoices", "billing.read", "billing", "read", "View invoices"},
+		{"/obiente.cloud.billing.v1.BillingService/DownloadInvoice", "billing.read", "billing", "read", "Download invoice PDFs"},
+		{"/obiente.cloud.billing.v1.BillingService/GetDunningState", "superadmin.billing.read", "superadmin", "billing.read", "View failed payment dunning state"},
+		{"/obiente.cloud.billing.v1.BillingService/ResetDunningState", "superadmin.billing.update", "superadmin", "billing.update", "Reset failed payment dunning state"},
+		{"/obiente.cloud.billing.v1.BillingService/GetBalance", "billing.read", "billing", "read", "View credit balance"},
+		{"/obiente.cloud.billing.v1.BillingService/GetSupportedCurrencies", "billing.read", "billing", "read", "View supported currencies"},
+		{"/obiente.cloud.billing.v1.BillingService/SetPreferredCurrency", "billing.update", "billing", "update", "Set preferred billing currency"},
+		{"/obiente.cloud.billing.v1.BillingService/CreateSpendAlert", "billing.update", "billing", "update", "Create spend alert"},
+		{"/obiente.cloud.billing.v1.BillingService/DeleteSpendAlert", "billing.update", "billing", "update", "Delete spend alert"},
+		{"/obiente.cloud.billing.v1.BillingService/ListSpendAlerts", "billing.read", "billing", "read", "View spend alerts"},
+		{"/obiente.cloud.billing.v1.BillingService/GetSpendForecast", "billing.read", "billing", "read", "View spend forecast"},
+		{"/obiente.cloud.billing.v1.BillingService/SubmitTaxExemptCertificate", "billing.update", "billing", "update", "Submit tax-exempt certificate"},
+		{"/obiente.cloud.billing.v1.BillingService/VerifyTaxExemptCertificate", "superadmin.billing.update", "superadmin", "billing.update", "Verify tax-exempt certificate"},
+		{"/obiente.cloud.billing.v1.BillingService/RevokeTaxExemptCertificate", "superadmin.billing.update", "superadmin", "billing.update", "Revoke tax-exempt certificate"},
 	}
 
 	for _, proc := range billingProcedures {
@@ -269,11 +363,39 @@ func RegisterOrganizationServiceProcedures() {
 		// Usage and billing
 		{"/obiente.cloud.organizations.v1.OrganizationService/GetUsage", PermissionOrganizationRead, "organization", "read", "View organization usage"},
 		{"/obiente.cloud.organizations.v1.OrganizationService/GetCreditLog", PermissionOrganizationRead, "organization", "read", "View credit log"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/GetOrganizationAuditLog", PermissionOrganizationRead, "organization", "read", "View organization audit log"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/GetOrganizationActivityFeed", PermissionOrganizationRead, "organization", "read", "View organization activity feed"},
+
+		// SAML single sign-on (requires org admin/owner)
+		{"/obiente.cloud.organizations.v1.OrganizationService/ConfigureSAML", PermissionOrganizationUpdate, "organization", "update", "Configure SAML single sign-on"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/GetSAMLConfig", PermissionOrganizationRead, "organization", "read", "View SAML single sign-on configuration"},
+
+		// Resource tags: the service checks update permission on the tagged resource itself
+		{"/obiente.cloud.organizations.v1.OrganizationService/AddResourceTag", PermissionOrganizationRead, "organization", "read", "Tag organization resources"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/RemoveResourceTag", PermissionOrganizationRead, "organization", "read", "Remove tags from organization resources"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/ListResourcesByTag", PermissionOrganizationRead, "organization", "read", "List organization resources by tag"},
+
+		// Teams (the service requires org admin/owner for changes)
+		{"/obiente.cloud.organizations.v1.OrganizationService/CreateTeam", PermissionOrganizationUpdate, "organization", "update", "Create team"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/DeleteTeam", PermissionOrganizationUpdate, "organization", "update", "Delete team"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/SetTeamQuota", PermissionOrganizationUpdate, "organization", "update", "Set team quota"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/GetTeamUsage", PermissionOrganizationRead, "organization", "read", "View team usage"},
+
+		// Managed organizations (the service requires org admin/owner of the parent to create)
+		{"/obiente.cloud.organizations.v1.OrganizationService/CreateManagedOrganization", PermissionOrganizationUpdate, "organization", "update", "Create managed organization"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/ListManagedOrganizations", PermissionOrganizationRead, "organization", "read", "List managed organizations"},
+
+		// Role inheritance (the service requires org admin/owner, or the member themselves for their own permissions)
+		{"/obiente.cloud.organizations.v1.OrganizationService/AddRoleInheritance", PermissionOrganizationUpdate, "organization", "update", "Add role inheritance"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/RemoveRoleInheritance", PermissionOrganizationUpdate, "organization", "update", "Remove role inheritance"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/GetEffectivePermissions", PermissionOrganizationRead, "organization", "read", "View effective permissions"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/RolesGraph", PermissionOrganizationRead, "organization", "read", "View role inheritance graph"},
 
 		// Admin operations (superadmin only) - hierarchical permissions
 		// These are marked as superadmin-only and won't appear in organization permission trees
 		{"/obiente.cloud.organizations.v1.OrganizationService/AdminAddCredits", "organization.admin.add_credits", "organization", "admin.add_credits", "Add credits (admin)"},
 		{"/obiente.cloud.organizations.v1.OrganizationService/AdminRemoveCredits", "organization.admin.remove_credits", "organization", "admin.remove_credits", "Remove credits (admin)"},
+		{"/obiente.cloud.organizations.v1.OrganizationService/MergeOrganizations", "organization.admin.merge", "organization", "admin.merge", "Merge organizations (admin)"},
 	}
 
 	for _, proc := range orgProcedures {
@@ -335,11 +457,18 @@ func RegisterNotificationServiceProcedures() {
 		"/obiente.cloud.notifications.v1.NotificationService/MarkAllAsRead",
 		"/obiente.cloud.notifications.v1.NotificationService/DeleteNotification",
 		"/obiente.cloud.notifications.v1.NotificationService/DeleteAllNotifications",
+		"/obiente.cloud.notifications.v1.NotificationService/ArchiveNotification",
+		"/obiente.cloud.notifications.v1.NotificationService/ListArchivedNotifications",
 		"/obiente.cloud.notifications.v1.NotificationService/CreateNotification",             // Admin-only, but service handles auth
 		"/obiente.cloud.notifications.v1.NotificationService/CreateOrganizationNotification", // Admin-only, but service handles auth
 		"/obiente.cloud.notifications.v1.NotificationService/GetNotificationTypes",
 		"/obiente.cloud.notifications.v1.NotificationService/GetNotificationPreferences",
 		"/obiente.cloud.notifications.v1.NotificationService/UpdateNotificationPreferences",
+		"/obiente.cloud.notifications.v1.NotificationService/GetNotificationDigestPolicy", // Service checks organization permissions
+		"/obiente.cloud.notifications.v1.NotificationService/SetNotificationDigestPolicy", // Service checks organization permissions
+		"/obiente.cloud.notifications.v1.NotificationService/CreateWebhookConfig",         // Service checks organization permissions
+		"/obiente.cloud.notifications.v1.NotificationService/DeleteWebhookConfig",         // Service checks organization permissions
+		"/obiente.cloud.notifications.v1.NotificationService/ListWebhookConfigs",          // Service checks organization permissions
 	}
 	procedures := map[string]string{
 		"/obiente.cloud.notifications.v1.NotificationService/ListNotifications":              "ListNotifications",
@@ -349,11 +478,18 @@ func RegisterNotificationServiceProcedures() {
 		"/obiente.cloud.notifications.v1.NotificationService/MarkAllAsRead":                  "MarkAllAsRead",
 		"/obiente.cloud.notifications.v1.NotificationService/DeleteNotification":             "DeleteNotification",
 		"/obiente.cloud.notifications.v1.NotificationService/DeleteAllNotifications":         "DeleteAllNotifications",
+		"/obiente.cloud.notifications.v1.NotificationService/ArchiveNotification":            "ArchiveNotification",
+		"/obiente.cloud.notifications.v1.NotificationService/ListArchivedNotifications":      "ListArchivedNotifications",
 		"/obiente.cloud.notifications.v1.NotificationService/CreateNotification":             "CreateNotification",
 		"/obiente.cloud.notifications.v1.NotificationService/CreateOrganizationNotification": "CreateOrganizationNotification",
 		"/obiente.cloud.notifications.v1.NotificationService/GetNotificationTypes":           "GetNotificationTypes",
 		"/obiente.cloud.notifications.v1.NotificationService/GetNotificationPreferences":     "GetNotificationPreferences",
 		"/obiente.cloud.notifications.v1.NotificationService/UpdateNotificationPreferences":  "UpdateNotificationPreferences",
+		"/obiente.cloud.notifications.v1.NotificationService/GetNotificationDigestPolicy":    "GetNotificationDigestPolicy",
+		"/obiente.cloud.notifications.v1.NotificationService/SetNotificationDigestPolicy":    "SetNotificationDigestPolicy",
+		"/obiente.cloud.notifications.v1.NotificationService/CreateWebhookConfig":            "CreateWebhookConfig",
+		"/obiente.cloud.notifications.v1.NotificationService/DeleteWebhookConfig":            "DeleteWebhookConfig",
+		"/obiente.cloud.notifications.v1.NotificationService/ListWebhookConfigs":             "ListWebhookConfigs",
 	}
 
 	RegisterServiceProcedures("NotificationService", procedures, public)
@@ -422,6 +558,8 @@ func RegisterSuperadminServiceProcedures() {
 	}{
 		// Overview
 		{"/obiente.cloud.superadmin.v1.SuperadminService/GetOverview", "superadmin.overview.read", "superadmin", "overview.read", "View system overview"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/GetPlatformUsageSummary", "superadmin.overview.read", "superadmin", "overview.read", "View platform resource usage summary"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/GetAllOrganizationsUsage", "superadmin.overview.read", "superadmin", "overview.read", "View resource usage of all organizations"},
 
 		// DNS management
 		{"/obiente.cloud.superadmin.v1.SuperadminService/QueryDNS", "superadmin.dns.read", "superadmin", "dns.read", "Query DNS records"},
@@ -429,6 +567,8 @@ func RegisterSuperadminServiceProcedures() {
 		{"/obiente.cloud.superadmin.v1.SuperadminService/GetDNSConfig", "superadmin.dns.read", "superadmin", "dns.read", "View DNS configuration"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/ListDelegatedDNSRecords", "superadmin.dns.read", "superadmin", "dns.read", "List delegated DNS records"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/HasDelegatedDNS", "superadmin.dns.read", "superadmin", "dns.read", "Check delegated DNS status"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/ListDNSQueryLogs", "superadmin.dns.read", "superadmin", "dns.read", "List DNS query logs"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/ConfigureSplitHorizon", "superadmin.dns.update", "superadmin", "dns.update", "Configure split-horizon DNS"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/CreateDNSDelegationAPIKey", "superadmin.dns.create", "superadmin", "dns.create", "Create DNS delegation API key"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/ListDNSDelegationAPIKeys", "superadmin.dns.read", "superadmin", "dns.read", "List DNS delegation API keys"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/RevokeDNSDelegationAPIKey", "superadmin.dns.delete", "superadmin", "dns.delete", "Revoke DNS delegation API key"},
@@ -454,6 +594,11 @@ func RegisterSuperadminServiceProcedures() {
 		{"/obiente.cloud.superadmin.v1.SuperadminService/GetUser", "superadmin.users.read", "superadmin", "users.read", "View user details"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/ListDormantResourceOwners", "superadmin.users.read", "superadmin", "users.read", "Audit dormant users with retained resources"},
 
+		// Platform ban list
+		{"/obiente.cloud.superadmin.v1.SuperadminService/CreateBanEntry", "superadmin.bans.create", "superadmin", "bans.create", "Ban an IP range or email domain"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/DeleteBanEntry", "superadmin.bans.delete", "superadmin", "bans.delete", "Remove a ban list entry"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/ListBanEntries", "superadmin.bans.read", "superadmin", "bans.read", "List ban list entries"},
+
 		// VPS management
 		{"/obiente.cloud.superadmin.v1.SuperadminService/ListAllVPS", "superadmin.vps.read", "superadmin", "vps.read", "List all VPS instances"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/SuperadminGetVPS", "superadmin.vps.read", "superadmin", "vps.read", "View VPS instance"},
@@ -463,6 +608,8 @@ func RegisterSuperadminServiceProcedures() {
 		{"/obiente.cloud.superadmin.v1.SuperadminService/SuperadminUpdateVPSCloudInit", "superadmin.vps.update", "superadmin", "vps.update", "Update VPS cloud-init"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/SuperadminForceStopVPS", "superadmin.vps.update", "superadmin", "vps.update", "Force stop VPS instance"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/SuperadminForceDeleteVPS", "superadmin.vps.delete", "superadmin", "vps.delete", "Force delete VPS instance"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/SuperadminMigrateVPS", "superadmin.vps.update", "superadmin", "vps.update", "Migrate VPS instance to another node"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/ListUnpatchedVPS", "superadmin.vps.read", "superadmin", "vps.read", "List VPS instances with outdated OS patches"},
 
 		// VPS size catalog
 		{"/obiente.cloud.superadmin.v1.SuperadminService/ListVPSSizes", "superadmin.vps_sizes.read", "superadmin", "vps_sizes.read", "List VPS sizes"},
@@ -477,14 +624,24 @@ func RegisterSuperadminServiceProcedures() {
 		{"/obiente.cloud.superadmin.v1.SuperadminService/DeleteVPSPublicIP", "superadmin.vps_public_ips.delete", "superadmin", "vps_public_ips.delete", "Delete VPS public IP"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/AssignVPSPublicIP", "superadmin.vps_public_ips.update", "superadmin", "vps_public_ips.update", "Assign VPS public IP"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/UnassignVPSPublicIP", "superadmin.vps_public_ips.update", "superadmin", "vps_public_ips.update", "Unassign VPS public IP"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/AllocateStaticIP", "superadmin.ip_allocations.create", "superadmin", "ip_allocations.create", "Allocate a static IP subnet to an organization"},
 
 		// Stripe webhook events
 		{"/obiente.cloud.superadmin.v1.SuperadminService/ListStripeWebhookEvents", "superadmin.webhooks.read", "superadmin", "webhooks.read", "List Stripe webhook events"},
 
+		// Platform maintenance
+		{"/obiente.cloud.superadmin.v1.SuperadminService/SetMaintenanceMode", "superadmin.maintenance.update", "superadmin", "maintenance.update", "Enable or disable platform maintenance mode"},
+
 		// Node management
 		{"/obiente.cloud.superadmin.v1.SuperadminService/ListNodes", "superadmin.nodes.read", "superadmin", "nodes.read", "List nodes"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/GetNode", "superadmin.nodes.read", "superadmin", "nodes.read", "View node details"},
 		{"/obiente.cloud.superadmin.v1.SuperadminService/UpdateNodeConfig", "superadmin.nodes.update", "superadmin", "nodes.update", "Update node configuration"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/DrainClusterNode", "superadmin.nodes.update", "superadmin", "nodes.update", "Drain a cluster node for maintenance"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/EnableClusterNode", "superadmin.nodes.update", "superadmin", "nodes.update", "Re-enable a drained cluster node"},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/GetCapacityReport", "superadmin.nodes.read", "superadmin", "nodes.read", "View node capacity planning report"},
+
+		// Proxmox credentials
+		{"/obiente.cloud.superadmin.v1.SuperadminService/RotateProxmoxToken", "superadmin.proxmox.update", "superadmin", "proxmox.update", "Rotate the Proxmox API token"},
 
 		// Superadmin permissions catalog
 		{"/obiente.cloud.superadmin.v1.SuperadminService/ListSuperadminPermissions", "admin.permissions.read", "admin", "permissions.read", "View superadmin permissions"},
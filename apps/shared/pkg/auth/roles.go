@@ -102,10 +102,9 @@ func (p *PermissionChecker) CheckScopedPermission(ctx context.Context, orgID str
 			var customRole database.OrgRole
 			lookupErr := database.DB.Where("id = ? AND organization_id = ?", roleID, orgID).First(&customRole).Error
 			if lookupErr == nil {
-				// Found the custom role, check its permissions
-				var perms []string
-				if err := json.Unmarshal([]byte(customRole.Permissions), &perms); err == nil {
-					for _, perm := range perms {
+				// Found the custom role, check its own and its inherited permissions
+				if resolved, err := database.ResolveRolePermissions(ctx, database.DB, []string{customRole.ID}); err == nil {
+					for _, perm := range resolved[customRole.ID] {
 						// Check exact match or wildcard match
 						if perm == sp.Permission || matchesPermission(perm, sp.Permission) {
 							// Custom role assigned directly grants org-wide access (no resource scoping)
@@ -134,15 +133,17 @@ func (p *PermissionChecker) CheckScopedPermission(ctx context.Context, orgID str
 	if err := database.DB.Where("id IN ?", roleIDs).Find(&roles).Error; err != nil {
 		return fmt.Errorf("permission lookup failed")
 	}
-	// Evaluate permissions JSON
+	// Evaluate each role's permissions, including those inherited from its ancestor roles
+	resolved, err := database.ResolveRolePermissions(ctx, database.DB, roleIDs)
+	if err != nil {
+		return fmt.Errorf("permission lookup failed")
+	}
 	for _, r := range roles {
 		// Skip system roles in database (they shouldn't be there, but just in case)
 		if IsSystemRole(r.Name) {
 			continue
 		}
-		var perms []string
-		_ = json.Unmarshal([]byte(r.Permissions), &perms)
-		for _, perm := range perms {
+		for _, perm := range resolved[r.ID] {
 			// Check exact match or wildcard match
 			if perm == sp.Permission || matchesPermission(perm, sp.Permission) {
 				// Resource scoping resolution
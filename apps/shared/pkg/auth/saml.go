@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"net/url"
+
+	"github.com/obiente/cloud/apps/shared/pkg/platform"
+)
+
+// SAML service provider endpoints, served by the auth service behind the API gateway
+const (
+	SAMLCallbackPath = "/auth/saml/callback"
+	SAMLMetadataPath = "/auth/saml/metadata"
+)
+
+// SAMLServiceProviderURLs returns an organization's assertion consumer service and metadata URLs.
+// The metadata URL doubles as the service provider entity ID.
+func SAMLServiceProviderURLs(organizationID string) (acsURL, metadataURL string) {
+	query := "?" + url.Values{"organization_id": {organizationID}}.Encode()
+	base := platform.APIURL()
+	return base + SAMLCallbackPath + query, base + SAMLMetadataPath + query
+}
@@ -0,0 +1,90 @@
+package database
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// TIMESCALE_TEST_DSN points at a disposable TimescaleDB database; the test is skipped without it.
+func newTimescaleTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TIMESCALE_TEST_DSN")
+	if dsn == "" {
+		t.Skip("TIMESCALE_TEST_DSN not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open timescale db: %v", err)
+	}
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS timescaledb").Error; err != nil {
+		t.Fatalf("enable timescaledb: %v", err)
+	}
+	if err := db.Exec("DROP TABLE IF EXISTS audit_logs CASCADE").Error; err != nil {
+		t.Fatalf("drop audit_logs: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = db.Exec("DROP TABLE IF EXISTS audit_logs CASCADE").Error
+	})
+	return db
+}
+
+func TestInitAuditLogsTimescaleDBAddsAuditDiffToExistingHypertable(t *testing.T) {
+	db := newTimescaleTestDB(t)
+
+	// An install from before audit_diff existed: the table is already a hypertable,
+	// so metrics DB setup never runs AutoMigrate on it again.
+	if err := db.Exec(`
+		CREATE TABLE audit_logs (
+			id TEXT NOT NULL,
+			user_id TEXT NOT NULL,
+			organization_id TEXT,
+			action TEXT NOT NULL,
+			service TEXT NOT NULL,
+			resource_type TEXT,
+			resource_id TEXT,
+			ip_address TEXT,
+			user_agent TEXT,
+			request_data JSONB,
+			response_status INTEGER,
+			error_message TEXT,
+			duration_ms BIGINT,
+			created_at TIMESTAMPTZ NOT NULL
+		)
+	`).Error; err != nil {
+		t.Fatalf("create legacy audit_logs: %v", err)
+	}
+	if err := db.Exec("SELECT create_hypertable('audit_logs', 'created_at')").Error; err != nil {
+		t.Fatalf("create hypertable: %v", err)
+	}
+
+	if err := InitAuditLogsTimescaleDB(db); err != nil {
+		t.Fatalf("InitAuditLogsTimescaleDB() error = %v", err)
+	}
+	if !db.Migrator().HasColumn(&AuditLog{}, "audit_diff") {
+		t.Fatal("audit_diff column was not added to the existing hypertable")
+	}
+
+	diff := `[{"field":"name","old_value":"a","new_value":"b"}]`
+	entry := &AuditLog{
+		ID:          "audit-1",
+		UserID:      "user-1",
+		Action:      "UpdateOrganization",
+		Service:     "OrganizationService",
+		RequestData: "{}",
+		AuditDiff:   &diff,
+		CreatedAt:   time.Now(),
+	}
+	if err := db.Create(entry).Error; err != nil {
+		t.Fatalf("insert audit log with diff: %v", err)
+	}
+
+	// Running setup again must be a no-op
+	if err := InitAuditLogsTimescaleDB(db); err != nil {
+		t.Fatalf("second InitAuditLogsTimescaleDB() error = %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Ban list entry types
+const (
+	BanListTypeIPRange     = "ip_range"     // Value is a CIDR block
+	BanListTypeEmailDomain = "email_domain" // Value is a domain, or *.domain for all of its subdomains
+)
+
+// banListCacheKey holds the active ban list in Redis for banListCacheTTL
+const banListCacheKey = "platform:ban_list"
+
+const banListCacheTTL = 60 * time.Second
+
+// BanListEntry blocks logins from an IP range or with emails of a domain across the platform
+type BanListEntry struct {
+	ID        string     `gorm:"primaryKey" json:"id"`
+	Type      string     `gorm:"column:type;index;not null" json:"type"` // ip_range, email_domain
+	Value     string     `gorm:"column:value;not null" json:"value"`
+	Reason    *string    `gorm:"column:reason" json:"reason"`
+	CreatedBy string     `gorm:"column:created_by;not null" json:"created_by"`
+	CreatedAt time.Time  `gorm:"column:created_at" json:"created_at"`
+	ExpiresAt *time.Time `gorm:"column:expires_at;index" json:"expires_at"` // nil = permanent
+}
+
+func (BanListEntry) TableName() string { return "ban_list" }
+
+// NormalizeBanListValue validates a ban list value and returns it in its stored form: IP ranges
+// as masked CIDR blocks (a single IP becomes a /32 or /128), email domains lowercased
+func NormalizeBanListValue(banType, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch banType {
+	case BanListTypeIPRange:
+		if !strings.Contains(value, "/") {
+			addr, err := netip.ParseAddr(value)
+			if err != nil {
+				return "", fmt.Errorf("invalid IP range %q", value)
+			}
+			return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()).String(), nil
+		}
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid IP range %q: %w", value, err)
+		}
+		return prefix.Masked().String(), nil
+	case BanListTypeEmailDomain:
+		domain := strings.ToLower(strings.TrimPrefix(value, "@"))
+		name := strings.TrimPrefix(domain, "*.")
+		if name == "" || !strings.Contains(name, ".") || strings.ContainsAny(name, "@*/ ") {
+			return "", fmt.Errorf("invalid email domain %q", value)
+		}
+		return domain, nil
+	default:
+		return "", fmt.Errorf("unknown ban list type %q", banType)
+	}
+}
+
+// Active reports whether the entry is in force at now
+func (e *BanListEntry) Active(now time.Time) bool {
+	return e.ExpiresAt == nil || e.ExpiresAt.After(now)
+}
+
+// Matches reports whether a login from clientIP with email is blocked by the entry
+func (e *BanListEntry) Matches(clientIP netip.Addr, email string) bool {
+	switch e.Type {
+	case BanListTypeIPRange:
+		if !clientIP.IsValid() {
+			return false
+		}
+		prefix, err := netip.ParsePrefix(e.Value)
+		return err == nil && prefix.Contains(clientIP.Unmap())
+	case BanListTypeEmailDomain:
+		at := strings.LastIndex(email, "@")
+		if at < 0 {
+			return false
+		}
+		domain := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(email[at+1:]), "."))
+		if suffix, ok := strings.CutPrefix(e.Value, "*"); ok {
+			return strings.HasSuffix(domain, suffix)
+		}
+		return domain == e.Value
+	}
+	return false
+}
+
+// GetActiveBanList returns the ban list entries in force. The list is cached in Redis for a minute,
+// so changes take up to that long to apply unless InvalidateBanListCache is called.
+func GetActiveBanList(ctx context.Context, db *gorm.DB) ([]BanListEntry, error) {
+	if RedisClient != nil {
+		if data, err := RedisClient.Get(ctx, banListCacheKey); err == nil {
+			var entries []BanListEntry
+			if err := json.Unmarshal([]byte(data), &entries); err == nil {
+				return entries, nil
+			}
+		}
+	}
+
+	if db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	var entries []BanListEntry
+	if err := db.WithContext(ctx).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("created_at").
+		Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to get ban list: %w", err)
+	}
+	if RedisClient != nil {
+		_ = RedisClient.Set(ctx, banListCacheKey, entries, banListCacheTTL)
+	}
+	return entries, nil
+}
+
+// InvalidateBanListCache drops the cached ban list so that changes apply on the next check
+func InvalidateBanListCache(ctx context.Context) error {
+	if RedisClient == nil {
+		return nil
+	}
+	return RedisClient.Delete(ctx, banListCacheKey)
+}
+
+// FindBanListMatch returns the active ban list entry that blocks a login from clientIP with email,
+// or nil when the login is allowed. An unparseable client IP is only checked against email domains.
+func FindBanListMatch(ctx context.Context, db *gorm.DB, clientIP, email string) (*BanListEntry, error) {
+	entries, err := GetActiveBanList(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	addr, _ := netip.ParseAddr(strings.TrimSpace(clientIP))
+	now := time.Now()
+	for i := range entries {
+		if entries[i].Active(now) && entries[i].Matches(addr, email) {
+			return &entries[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNormalizeBanListValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		banType, value, want string
+		wantErr              bool
+	}{
+		{BanListTypeIPRange, "203.0.113.77/24", "203.0.113.0/24", false},
+		{BanListTypeIPRange, " 198.51.100.7 ", "198.51.100.7/32", false},
+		{BanListTypeIPRange, "2001:db8::1/48", "2001:db8::/48", false},
+		{BanListTypeIPRange, "203.0.113.0/33", "", true},
+		{BanListTypeIPRange, "example.com", "", true},
+		{BanListTypeEmailDomain, "@Spam.Example", "spam.example", false},
+		{BanListTypeEmailDomain, "*.Spam.Example", "*.spam.example", false},
+		{BanListTypeEmailDomain, "localhost", "", true},
+		{BanListTypeEmailDomain, "*.*.example", "", true},
+		{"user", "x", "", true},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeBanListValue(tt.banType, tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("NormalizeBanListValue(%s, %q) = %q, %v; want %q, error %v", tt.banType, tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestFindBanListMatch(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t, &BanListEntry{})
+	past := time.Now().Add(-time.Hour)
+	for _, entry := range []*BanListEntry{
+		{ID: "ban-24", Type: BanListTypeIPRange, Value: "203.0.113.0/24", CreatedBy: "admin"},
+		{ID: "ban-16", Type: BanListTypeIPRange, Value: "198.51.0.0/16", CreatedBy: "admin"},
+		{ID: "ban-expired", Type: BanListTypeIPRange, Value: "192.0.2.0/24", CreatedBy: "admin", ExpiresAt: &past},
+		{ID: "ban-domain", Type: BanListTypeEmailDomain, Value: "spam.example", CreatedBy: "admin"},
+		{ID: "ban-wildcard", Type: BanListTypeEmailDomain, Value: "*.throwaway.example", CreatedBy: "admin"},
+	} {
+		if err := db.Create(entry).Error; err != nil {
+			t.Fatalf("seed %s: %v", entry.ID, err)
+		}
+	}
+
+	tests := []struct {
+		name, clientIP, email, want string
+	}{
+		{"in /24", "203.0.113.200", "user@example.com", "ban-24"},
+		{"next to /24", "203.0.114.1", "user@example.com", ""},
+		{"in /16", "198.51.250.3", "user@example.com", "ban-16"},
+		{"outside /16", "198.52.0.1", "user@example.com", ""},
+		{"IPv4-mapped IPv6 in /24", "::ffff:203.0.113.9", "user@example.com", "ban-24"},
+		{"expired range", "192.0.2.10", "user@example.com", ""},
+		{"domain", "", "user@SPAM.example", "ban-domain"},
+		{"subdomain of exact domain", "", "user@mail.spam.example", ""},
+		{"wildcard subdomain", "unknown", "user@mail.throwaway.example", "ban-wildcard"},
+		{"wildcard nested subdomain", "", "user@a.b.throwaway.example", "ban-wildcard"},
+		{"wildcard apex", "", "user@throwaway.example", ""},
+		{"wildcard lookalike", "", "user@notthrowaway.example", ""},
+		{"allowed", "8.8.8.8", "user@example.com", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := FindBanListMatch(context.Background(), db, tt.clientIP, tt.email)
+			if err != nil {
+				t.Fatalf("FindBanListMatch: %v", err)
+			}
+			got := ""
+			if entry != nil {
+				got = entry.ID
+			}
+			if got != tt.want {
+				t.Fatalf("FindBanListMatch(%q, %q) = %q, want %q", tt.clientIP, tt.email, got, tt.want)
+			}
+		})
+	}
+}
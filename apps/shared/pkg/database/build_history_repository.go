@@ -179,6 +179,24 @@ func (r *BuildHistoryRepository) UpdateBuildResults(ctx context.Context, buildID
 	return nil
 }
 
+// UpdateBuildLintWarnings stores the Dockerfile lint warnings of a build (a JSON array)
+func (r *BuildHistoryRepository) UpdateBuildLintWarnings(ctx context.Context, buildID string, lintWarnings string) error {
+	if err := r.db.WithContext(ctx).
+		Model(&BuildHistory{}).
+		Where("id = ?", buildID).
+		Updates(map[string]interface{}{
+			"lint_warnings": lintWarnings,
+			"updated_at":    time.Now(),
+		}).Error; err != nil {
+		return err
+	}
+
+	if r.cache != nil {
+		r.cache.Delete(ctx, fmt.Sprintf("build:%s", buildID))
+	}
+	return nil
+}
+
 // NOTE: Build log methods (AddBuildLog, AddBuildLogsBatch, GetBuildLogs) have been moved
 // to BuildLogsRepository which uses TimescaleDB. Use database.NewBuildLogsRepository(MetricsDB)
 // to get a repository instance for build logs.
@@ -0,0 +1,65 @@
+package database
+
+import "time"
+
+// Custom domain certificate states
+const (
+	CustomDomainStatusPending = "pending" // Waiting for the ACME DNS challenge TXT record
+	CustomDomainStatusIssued  = "issued"  // Certificate issued and routed through Traefik
+	CustomDomainStatusFailed  = "failed"  // The ACME authorization or order failed; create the domain again to retry
+)
+
+// CustomDomain is a domain mapped to a deployment with a certificate issued over ACME
+type CustomDomain struct {
+	ID             string `gorm:"primaryKey;column:id" json:"id"`
+	DeploymentID   string `gorm:"column:deployment_id;not null;index" json:"deployment_id"`
+	OrganizationID string `gorm:"column:organization_id;not null;index" json:"organization_id"`
+	Domain         string `gorm:"column:domain;not null;uniqueIndex" json:"domain"`
+	Verified       bool   `gorm:"column:verified;not null;default:false" json:"verified"`
+	Status         string `gorm:"column:status;not null;default:pending" json:"status"`
+	// TLSCertID points at the domain's current certificate in tls_certificates
+	TLSCertID *string `gorm:"column:tls_cert_id" json:"tls_cert_id"`
+
+	// ACME DNS-01 challenge state, kept until the certificate is issued
+	ChallengeRecordName  string `gorm:"column:challenge_record_name" json:"challenge_record_name"`
+	ChallengeRecordValue string `gorm:"column:challenge_record_value" json:"challenge_record_value"`
+	ACMEOrderURL         string `gorm:"column:acme_order_url" json:"acme_order_url"`
+	ACMEAuthorizationURL string `gorm:"column:acme_authorization_url" json:"acme_authorization_url"`
+	ACMEChallengeURL     string `gorm:"column:acme_challenge_url" json:"acme_challenge_url"`
+
+	LastError  string     `gorm:"column:last_error" json:"last_error"`
+	VerifiedAt *time.Time `gorm:"column:verified_at" json:"verified_at"`
+	CreatedAt  time.Time  `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (CustomDomain) TableName() string { return "custom_domains" }
+
+// TLSCertificate is an issued certificate chain; the private key is encrypted with the
+// organization's data key
+type TLSCertificate struct {
+	ID                  string    `gorm:"primaryKey;column:id" json:"id"`
+	OrganizationID      string    `gorm:"column:organization_id;not null;index" json:"organization_id"`
+	Domain              string    `gorm:"column:domain;not null;index" json:"domain"`
+	CertificatePEM      string    `gorm:"column:certificate_pem;type:text;not null" json:"certificate_pem"`
+	EncryptedPrivateKey []byte    `gorm:"column:encrypted_private_key;not null" json:"-"`
+	KeyID               string    `gorm:"column:key_id;not null" json:"key_id"` // Data key that encrypts the private key
+	NotBefore           time.Time `gorm:"column:not_before" json:"not_before"`
+	NotAfter            time.Time `gorm:"column:not_after;index" json:"not_after"`
+	CreatedAt           time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (TLSCertificate) TableName() string { return "tls_certificates" }
+
+// ACMEAccount is the platform's account with an ACME directory; the account key is
+// encrypted with the master key
+type ACMEAccount struct {
+	ID           string    `gorm:"primaryKey;column:id" json:"id"`
+	DirectoryURL string    `gorm:"column:directory_url;not null;uniqueIndex" json:"directory_url"`
+	Email        string    `gorm:"column:email" json:"email"`
+	AccountURL   string    `gorm:"column:account_url" json:"account_url"`
+	EncryptedKey []byte    `gorm:"column:encrypted_key;not null" json:"-"`
+	CreatedAt    time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (ACMEAccount) TableName() string { return "acme_accounts" }
@@ -532,23 +532,57 @@ func InitDatabase() error {
 	// Auto-migrate the schema (build_logs is stored in TimescaleDB, not here)
 	if err := db.AutoMigrate(
 		&Deployment{},
+		&DeploymentAffinityRule{},
+		&DeploymentScaleSchedule{},
+		&DeploymentAutoScalePolicy{},
+		&DeploymentRegion{},
 		&BuildHistory{},
 		&DelegatedDNSRecord{},
+		&SplitHorizonIP{},
 		&DNSDelegationAPIKey{},
 		&OrganizationPlan{},
 		&OrgQuota{},
 		&MonthlyCreditGrant{},
 		&MonthlyBill{},
+		&BillingRateConfig{},
+		&MeteredUsageRecord{},
+		&MeteredUsageBalance{},
+		&Invoice{},
+		&TaxRecord{},
+		&BillingDunningState{},
+		&PaymentMethod{},
+		&SpendAlert{},
+		&TaxExemptCertificate{},
+		&ReferralCode{},
+		&ReferralUse{},
+		&Currency{},
 		&StrayContainer{},
 		&VPSInstance{},
+		&VPSPowerEvent{},
+		&VPSPatchHistory{},
 		&VPSSizeCatalog{},
 		&VPSRegionCatalog{},
 		&VPSPublicIP{},
+		&OrgIPAllocation{},
+		&VPSStaticIP{},
 		&DHCPLease{},
 		&SSHKey{},
 		&VPSTerminalKey{},
 		&VPSBastionKey{},
+		&VPSFirewallRule{},
+		&ProxmoxCredential{},
+		&VPSCloudInitTemplate{},
+		&VPSTemplate{},
+		&VPSISO{},
+		&ResourceTag{},
+		&CustomDomain{},
+		&TLSCertificate{},
+		&ACMEAccount{},
+		&Team{},
+		&TeamMember{},
+		&TeamQuota{},
 		&Notification{},
+		&BanListEntry{},
 		&DatabaseInstance{},
 		&DatabaseConnection{},
 		&DatabaseBackup{},
@@ -624,6 +658,14 @@ func InitAuditLogsTimescaleDB(db *gorm.DB) error {
 		return nil
 	}
 
+	// AutoMigrate is skipped once audit_logs is a hypertable, so columns added later are created here
+	if err := db.Exec("ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS audit_diff JSONB").Error; err != nil {
+		return fmt.Errorf("failed to add audit_diff column to audit_logs: %w", err)
+	}
+	if err := db.Exec("ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS metadata JSONB").Error; err != nil {
+		return fmt.Errorf("failed to add metadata column to audit_logs: %w", err)
+	}
+
 	// Check if table is already a hypertable
 	var isHypertable bool
 	if err := db.Raw(`
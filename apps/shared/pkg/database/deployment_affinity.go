@@ -0,0 +1,123 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DeploymentAffinityRule places a deployment relative to the organization's deployments carrying
+// a label (resource tag): affinity prefers their nodes, anti-affinity excludes them
+type DeploymentAffinityRule struct {
+	ID           uint      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	DeploymentID string    `gorm:"column:deployment_id;index;not null" json:"deployment_id"`
+	RuleType     string    `gorm:"column:rule_type;not null" json:"rule_type"` // affinity, anti-affinity
+	LabelKey     string    `gorm:"column:label_key;not null" json:"label_key"`
+	LabelValue   string    `gorm:"column:label_value;not null" json:"label_value"`
+	CreatedAt    time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (DeploymentAffinityRule) TableName() string {
+	return "deployment_affinity_rules"
+}
+
+// GetDeploymentAffinityRules returns the affinity rules of a deployment in the order they were set
+func GetDeploymentAffinityRules(deploymentID string) ([]DeploymentAffinityRule, error) {
+	var rules []DeploymentAffinityRule
+	if err := DB.Where("deployment_id = ?", deploymentID).Order("id").Find(&rules).Error; err != nil {
+		return nil, fmt.Errorf("failed to get deployment affinity rules: %w", err)
+	}
+	return rules, nil
+}
+
+// SetDeploymentAffinityRules replaces the affinity rules of a deployment
+func SetDeploymentAffinityRules(deploymentID string, rules []DeploymentAffinityRule) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("deployment_id = ?", deploymentID).Delete(&DeploymentAffinityRule{}).Error; err != nil {
+			return fmt.Errorf("failed to clear deployment affinity rules: %w", err)
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+		for i := range rules {
+			rules[i].ID = 0
+			rules[i].DeploymentID = deploymentID
+		}
+		if err := tx.Create(&rules).Error; err != nil {
+			return fmt.Errorf("failed to save deployment affinity rules: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetNodeDeploymentLabels returns, per node ID, the labels of the organization's deployments running on it.
+// A deployment with several running containers on a node is listed once per container.
+func GetNodeDeploymentLabels(orgID string) (map[string][]map[string]string, error) {
+	var locations []DeploymentLocation
+	if err := DB.Table("deployment_locations").
+		Select("deployment_locations.deployment_id, deployment_locations.node_id").
+		Joins("JOIN deployments ON deployments.id = deployment_locations.deployment_id").
+		Where("deployments.organization_id = ? AND deployments.deleted_at IS NULL AND deployment_locations.status = ?", orgID, "running").
+		Scan(&locations).Error; err != nil {
+		return nil, fmt.Errorf("failed to get deployment locations: %w", err)
+	}
+
+	labels := make(map[string][]map[string]string)
+	if len(locations) == 0 {
+		return labels, nil
+	}
+
+	deploymentIDs := make([]string, 0, len(locations))
+	for _, location := range locations {
+		deploymentIDs = append(deploymentIDs, location.DeploymentID)
+	}
+	var tags []ResourceTag
+	if err := DB.Where("organization_id = ? AND resource_type = ? AND resource_id IN ?", orgID, ResourceTagTypeDeployment, deploymentIDs).
+		Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to get deployment labels: %w", err)
+	}
+	byDeployment := make(map[string]map[string]string)
+	for _, tag := range tags {
+		if byDeployment[tag.ResourceID] == nil {
+			byDeployment[tag.ResourceID] = make(map[string]string)
+		}
+		byDeployment[tag.ResourceID][tag.Key] = tag.Value
+	}
+
+	for _, location := range locations {
+		if deploymentLabels := byDeployment[location.DeploymentID]; deploymentLabels != nil {
+			labels[location.NodeID] = append(labels[location.NodeID], deploymentLabels)
+		}
+	}
+	return labels, nil
+}
+
+// GetNodeDeploymentCounts returns, per node ID, how many of the organization's deployments run on it
+func GetNodeDeploymentCounts(orgID string) (map[string]int, error) {
+	var rows []struct {
+		NodeID string
+		Count  int
+	}
+	if err := DB.Table("deployment_locations").
+		Select("deployment_locations.node_id, COUNT(DISTINCT deployment_locations.deployment_id) AS count").
+		Joins("JOIN deployments ON deployments.id = deployment_locations.deployment_id").
+		Where("deployments.organization_id = ? AND deployments.deleted_at IS NULL AND deployment_locations.status = ?", orgID, "running").
+		Group("deployment_locations.node_id").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count deployments per node: %w", err)
+	}
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.NodeID] = row.Count
+	}
+	return counts, nil
+}
+
+// DeleteDeploymentAffinityRules removes the affinity rules of a deleted deployment
+func DeleteDeploymentAffinityRules(db *gorm.DB, deploymentID string) error {
+	if err := db.Where("deployment_id = ?", deploymentID).Delete(&DeploymentAffinityRule{}).Error; err != nil {
+		return fmt.Errorf("failed to delete deployment affinity rules: %w", err)
+	}
+	return nil
+}
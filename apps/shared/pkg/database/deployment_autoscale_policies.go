@@ -0,0 +1,96 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DeploymentAutoScalePolicy scales a deployment between MinReplicas and MaxReplicas to hold its
+// average CPU utilization per replica at TargetCPUPercent
+type DeploymentAutoScalePolicy struct {
+	DeploymentID             string     `gorm:"primaryKey;column:deployment_id" json:"deployment_id"`
+	OrganizationID           string     `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	MinReplicas              int32      `gorm:"column:min_replicas;not null" json:"min_replicas"`
+	MaxReplicas              int32      `gorm:"column:max_replicas;not null" json:"max_replicas"`
+	TargetCPUPercent         float64    `gorm:"column:target_cpu_percent;not null" json:"target_cpu_percent"` // 100 is one full core
+	ScaleUpCooldownSeconds   int32      `gorm:"column:scale_up_cooldown_seconds;not null" json:"scale_up_cooldown_seconds"`
+	ScaleDownCooldownSeconds int32      `gorm:"column:scale_down_cooldown_seconds;not null" json:"scale_down_cooldown_seconds"`
+	Enabled                  bool       `gorm:"column:enabled;not null;default:true" json:"enabled"`
+	LastScaledAt             *time.Time `gorm:"column:last_scaled_at" json:"last_scaled_at"`
+	UpdatedBy                string     `gorm:"column:updated_by" json:"updated_by"`
+	CreatedAt                time.Time  `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt                time.Time  `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (DeploymentAutoScalePolicy) TableName() string {
+	return "deployment_autoscale_policies"
+}
+
+// GetDeploymentAutoScalePolicy returns the auto-scaling policy of a deployment
+func GetDeploymentAutoScalePolicy(deploymentID string) (*DeploymentAutoScalePolicy, error) {
+	var policy DeploymentAutoScalePolicy
+	if err := DB.Where("deployment_id = ?", deploymentID).First(&policy).Error; err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// ListEnabledDeploymentAutoScalePolicies returns the enabled auto-scaling policies of every deployment
+// that is not deleted
+func ListEnabledDeploymentAutoScalePolicies() ([]DeploymentAutoScalePolicy, error) {
+	var policies []DeploymentAutoScalePolicy
+	if err := DB.Model(&DeploymentAutoScalePolicy{}).
+		Joins("JOIN deployments ON deployments.id = deployment_autoscale_policies.deployment_id").
+		Where("deployment_autoscale_policies.enabled = ? AND deployments.deleted_at IS NULL", true).
+		Find(&policies).Error; err != nil {
+		return nil, fmt.Errorf("failed to get deployment auto-scaling policies: %w", err)
+	}
+	return policies, nil
+}
+
+// ClaimDeploymentAutoScale records scaledAt as the policy's last scaling unless another process
+// already recorded a scaling after previous. It reports whether this call claimed the scaling.
+func ClaimDeploymentAutoScale(deploymentID string, previous *time.Time, scaledAt time.Time) (bool, error) {
+	query := DB.Model(&DeploymentAutoScalePolicy{}).Where("deployment_id = ?", deploymentID)
+	if previous == nil {
+		query = query.Where("last_scaled_at IS NULL")
+	} else {
+		query = query.Where("last_scaled_at = ?", *previous)
+	}
+	result := query.Update("last_scaled_at", scaledAt)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to record auto-scaling: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetDeploymentAverageCPUUsage returns the average CPU usage per container of a deployment since the
+// given time, and the number of samples it is based on
+// Uses MetricsDB if available, otherwise falls back to main DB
+func GetDeploymentAverageCPUUsage(deploymentID string, since time.Time) (float64, int64, error) {
+	targetDB := MetricsDB
+	if targetDB == nil {
+		targetDB = DB
+	}
+	var result struct {
+		AvgCPU  float64
+		Samples int64
+	}
+	if err := targetDB.Model(&DeploymentMetrics{}).
+		Select("COALESCE(AVG(cpu_usage), 0) AS avg_cpu, COUNT(*) AS samples").
+		Where("deployment_id = ? AND timestamp >= ?", deploymentID, since).
+		Scan(&result).Error; err != nil {
+		return 0, 0, fmt.Errorf("failed to get deployment CPU usage: %w", err)
+	}
+	return result.AvgCPU, result.Samples, nil
+}
+
+// DeleteDeploymentAutoScalePolicy removes the auto-scaling policy of a deleted deployment
+func DeleteDeploymentAutoScalePolicy(db *gorm.DB, deploymentID string) error {
+	if err := db.Where("deployment_id = ?", deploymentID).Delete(&DeploymentAutoScalePolicy{}).Error; err != nil {
+		return fmt.Errorf("failed to delete deployment auto-scaling policy: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// DeploymentEnvCipher encrypts deployment environment variables at rest
+// Seal moves env_vars and env_file_content into the encrypted columns and clears them; Open reverses it.
+type DeploymentEnvCipher interface {
+	Seal(deployment *Deployment) error
+	Open(deployment *Deployment) error
+}
+
+var deploymentEnvCipher DeploymentEnvCipher
+
+// SetDeploymentEnvCipher registers the cipher for deployment environment variables
+// Services that hold the encryption key register one at startup. Deployments are then decrypted when
+// loaded and encrypted when saved; without a cipher env vars are read and written as plaintext.
+func SetDeploymentEnvCipher(cipher DeploymentEnvCipher) {
+	deploymentEnvCipher = cipher
+}
+
+// DeploymentEnvLocked reports whether a deployment's env vars are encrypted but cannot be decrypted
+// in this service, so it must not be (re)created from the database here
+func DeploymentEnvLocked(deployment *Deployment) bool {
+	return len(deployment.EncryptedEnv) > 0 && deploymentEnvCipher == nil
+}
+
+// AfterFind decrypts the environment variables of encrypted deployments
+func (d *Deployment) AfterFind(tx *gorm.DB) error {
+	if deploymentEnvCipher == nil || len(d.EncryptedEnv) == 0 {
+		return nil
+	}
+	if err := deploymentEnvCipher.Open(d); err != nil {
+		return fmt.Errorf("decrypt env vars of deployment %s: %w", d.ID, err)
+	}
+	return nil
+}
+
+// BeforeSave encrypts the environment variables so they are never written as plaintext
+// Partial updates that don't carry env vars leave the encrypted columns untouched.
+func (d *Deployment) BeforeSave(tx *gorm.DB) error {
+	if deploymentEnvCipher == nil || (d.EnvVars == "" && d.EnvFileContent == "") {
+		return nil
+	}
+	if err := deploymentEnvCipher.Seal(d); err != nil {
+		return fmt.Errorf("encrypt env vars of deployment %s: %w", d.ID, err)
+	}
+	return nil
+}
+
+// AfterSave restores the plaintext environment variables for the caller
+func (d *Deployment) AfterSave(tx *gorm.DB) error {
+	return d.AfterFind(tx)
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Deployment region statuses
+const (
+	DeploymentRegionStatusPending     = "pending"     // placed on a node; containers are created on first deploy
+	DeploymentRegionStatusProvisioned = "provisioned" // containers created on the region's node
+	DeploymentRegionStatusFailed      = "failed"
+)
+
+// DeploymentRegion is where a multi-region deployment runs in one region. The primary region is
+// the one listed first when the deployment was created; the others are replicas.
+type DeploymentRegion struct {
+	ID           uint      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	DeploymentID string    `gorm:"column:deployment_id;uniqueIndex:idx_deployment_regions_deployment_region;not null" json:"deployment_id"`
+	Region       string    `gorm:"column:region;uniqueIndex:idx_deployment_regions_deployment_region;not null" json:"region"`
+	Primary      bool      `gorm:"column:is_primary;not null;default:false" json:"primary"`
+	NodeID       string    `gorm:"column:node_id" json:"node_id"`
+	ContainerID  string    `gorm:"column:container_id" json:"container_id"`
+	TraefikIP    string    `gorm:"column:traefik_ip" json:"traefik_ip"`
+	Status       string    `gorm:"column:status;not null" json:"status"` // pending, provisioned, failed
+	Error        string    `gorm:"column:error" json:"error"`
+	CreatedAt    time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (DeploymentRegion) TableName() string {
+	return "deployment_regions"
+}
+
+// GetDeploymentRegions returns the regions of a deployment, primary first
+func GetDeploymentRegions(deploymentID string) ([]DeploymentRegion, error) {
+	var regions []DeploymentRegion
+	if err := DB.Where("deployment_id = ?", deploymentID).Order("is_primary DESC, id").Find(&regions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get deployment regions: %w", err)
+	}
+	return regions, nil
+}
+
+// GetDeploymentRegionRecord returns the record of a deployment in one region
+func GetDeploymentRegionRecord(deploymentID, region string) (*DeploymentRegion, error) {
+	var record DeploymentRegion
+	if err := DB.Where("deployment_id = ? AND region = ?", deploymentID, region).First(&record).Error; err != nil {
+		return nil, fmt.Errorf("failed to get deployment region %s: %w", region, err)
+	}
+	return &record, nil
+}
+
+// SaveDeploymentRegion creates or replaces the record of the deployment in region.Region
+func SaveDeploymentRegion(region *DeploymentRegion) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		var existing DeploymentRegion
+		err := tx.Where("deployment_id = ? AND region = ?", region.DeploymentID, region.Region).First(&existing).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			region.ID = 0
+			if err := tx.Create(region).Error; err != nil {
+				return fmt.Errorf("failed to save deployment region: %w", err)
+			}
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to get deployment region: %w", err)
+		}
+		region.ID = existing.ID
+		region.CreatedAt = existing.CreatedAt
+		if err := tx.Save(region).Error; err != nil {
+			return fmt.Errorf("failed to save deployment region: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetDeploymentRegionTraefikIPs returns the sorted Traefik IPs of the regions a deployment was
+// placed in, leaving out failed ones; the DNS service answers with one A record per IP
+func GetDeploymentRegionTraefikIPs(deploymentID string) ([]string, error) {
+	var ips []string
+	if err := DB.Model(&DeploymentRegion{}).
+		Where("deployment_id = ? AND status <> ? AND traefik_ip <> ''", deploymentID, DeploymentRegionStatusFailed).
+		Distinct().
+		Pluck("traefik_ip", &ips).Error; err != nil {
+		return nil, fmt.Errorf("failed to get deployment region IPs: %w", err)
+	}
+	for i := range ips {
+		ips[i] = strings.TrimSpace(ips[i])
+	}
+	sort.Strings(ips)
+	return ips, nil
+}
+
+// DeleteDeploymentRegions removes the region records of a deleted deployment
+func DeleteDeploymentRegions(db *gorm.DB, deploymentID string) error {
+	if err := db.Where("deployment_id = ?", deploymentID).Delete(&DeploymentRegion{}).Error; err != nil {
+		return fmt.Errorf("failed to delete deployment regions: %w", err)
+	}
+	return nil
+}
@@ -30,7 +30,7 @@ func (r *DeploymentRepository) Create(ctx context.Context, deployment *Deploymen
 	// Cache the newly created deployment
 	if r.cache != nil {
 		cacheKey := fmt.Sprintf("deployment:%s", deployment.ID)
-		r.cache.Set(ctx, cacheKey, deployment, 5*time.Minute)
+		r.cache.Set(ctx, cacheKey, cacheableDeployment(deployment), 5*time.Minute)
 	}
 
 	return nil
@@ -48,6 +48,9 @@ func (r *DeploymentRepository) GetByIDIncludeDeleted(ctx context.Context, id str
 		if cachedData, err := r.cache.Get(ctx, cacheKey); err == nil && cachedData != "" {
 			var deployment Deployment
 			if err := json.Unmarshal([]byte(cachedData), &deployment); err == nil {
+				if err := deployment.AfterFind(r.db); err != nil {
+					return nil, err
+				}
 				return &deployment, nil
 			}
 		}
@@ -64,7 +67,7 @@ func (r *DeploymentRepository) GetByIDIncludeDeleted(ctx context.Context, id str
 
 	// Cache the result
 	if r.cache != nil {
-		r.cache.Set(ctx, cacheKey, deployment, 5*time.Minute)
+		r.cache.Set(ctx, cacheKey, cacheableDeployment(&deployment), 5*time.Minute)
 	}
 
 	return &deployment, nil
@@ -75,19 +78,21 @@ func (r *DeploymentRepository) GetAll(ctx context.Context, organizationID string
 	// However, lists are often filtered/paginated, so caching is less effective
 	// We'll rely on individual item caching from GetByID calls
 
-	query := r.db.WithContext(ctx).Where("organization_id = ? AND deleted_at IS NULL", organizationID)
+	query := r.db.WithContext(ctx).Where("deployments.organization_id = ? AND deployments.deleted_at IS NULL", organizationID)
 
 	if filters != nil {
 		// Apply status filter if provided
 		if filters.Status != nil {
-			query = query.Where("status = ?", *filters.Status)
+			query = query.Where("deployments.status = ?", *filters.Status)
 		}
 
 		// Apply user ID filter if provided and not in "include all" mode
 		if filters.UserID != "" && !filters.IncludeAll {
-			query = query.Where("created_by = ?", filters.UserID)
+			query = query.Where("deployments.created_by = ?", filters.UserID)
 		}
 
+		query = FilterByResourceTags(query, "deployments", ResourceTagTypeDeployment, filters.Tags)
+
 		// Apply pagination
 		if filters.Limit > 0 {
 			query = query.Limit(int(filters.Limit))
@@ -106,7 +111,7 @@ func (r *DeploymentRepository) GetAll(ctx context.Context, organizationID string
 	if r.cache != nil && len(deployments) > 0 {
 		pairs := make(map[string]interface{})
 		for _, dep := range deployments {
-			pairs[fmt.Sprintf("deployment:%s", dep.ID)] = dep
+			pairs[fmt.Sprintf("deployment:%s", dep.ID)] = cacheableDeployment(dep)
 		}
 		// Use MSet for batch caching (more efficient)
 		r.cache.MSet(ctx, pairs, 5*time.Minute)
@@ -128,9 +133,10 @@ func (r *DeploymentRepository) Update(ctx context.Context, deployment *Deploymen
 			"dockerfile_path", "compose_file_path", "build_path", "build_output_path",
 			"use_nginx", "nginx_config", "github_integration_id", "auto_deploy",
 			"healthcheck_type", "healthcheck_port", "healthcheck_path", "healthcheck_expected_status", "healthcheck_custom_command",
+			"healthcheck_interval_seconds", "healthcheck_timeout_seconds", "healthcheck_retries",
 			"status", "health_status", "environment", "groups",
 			"image", "port", "replicas", "memory_bytes", "cpu_shares",
-			"env_vars", "env_file_content", "compose_yaml", "build_args", "dockerfile_volumes", "dockerfile_build_options",
+			"env_vars", "env_file_content", "encrypted_env", "encrypted_env_file", "env_key_id", "compose_yaml", "build_args", "dockerfile_volumes", "dockerfile_build_options",
 			"build_time", "size", "storage_bytes", "bandwidth_usage",
 			"last_deployed_at", "updated_at",
 		).
@@ -144,7 +150,7 @@ func (r *DeploymentRepository) Update(ctx context.Context, deployment *Deploymen
 		// Fetch the updated deployment to ensure we have all fields
 		var updatedDeployment Deployment
 		if err := r.db.WithContext(ctx).Where("id = ?", deployment.ID).First(&updatedDeployment).Error; err == nil {
-			r.cache.Set(ctx, cacheKey, updatedDeployment, 5*time.Minute)
+			r.cache.Set(ctx, cacheKey, cacheableDeployment(&updatedDeployment), 5*time.Minute)
 		} else {
 			// If fetch fails, at least clear the cache to avoid stale data
 			r.cache.Delete(ctx, cacheKey)
@@ -154,6 +160,16 @@ func (r *DeploymentRepository) Update(ctx context.Context, deployment *Deploymen
 	return nil
 }
 
+// cacheableDeployment returns a copy of deployment without decrypted env vars, so they are not cached in plaintext
+func cacheableDeployment(deployment *Deployment) Deployment {
+	cached := *deployment
+	if len(cached.EncryptedEnv) > 0 {
+		cached.EnvVars = "{}"
+		cached.EnvFileContent = ""
+	}
+	return cached
+}
+
 func normalizeDeploymentJSONFields(deployment *Deployment) {
 	if deployment == nil {
 		return
@@ -175,13 +191,30 @@ func defaultJSONValue(value, fallback string) string {
 
 // UpdateEnvVars updates only the environment variables fields
 func (r *DeploymentRepository) UpdateEnvVars(ctx context.Context, id string, envFileContent string, envVarsJSON string) error {
+	updates := map[string]interface{}{
+		"env_file_content": envFileContent,
+		"env_vars":         envVarsJSON,
+		"last_deployed_at": time.Now(),
+	}
+	if deploymentEnvCipher != nil {
+		deployment := Deployment{ID: id, EnvVars: envVarsJSON, EnvFileContent: envFileContent}
+		if err := r.db.WithContext(ctx).Model(&Deployment{}).Where("id = ?", id).
+			Pluck("organization_id", &deployment.OrganizationID).Error; err != nil {
+			return err
+		}
+		if err := deploymentEnvCipher.Seal(&deployment); err != nil {
+			return fmt.Errorf("encrypt env vars of deployment %s: %w", id, err)
+		}
+		updates["env_file_content"] = deployment.EnvFileContent
+		updates["env_vars"] = deployment.EnvVars
+		updates["encrypted_env"] = deployment.EncryptedEnv
+		updates["encrypted_env_file"] = deployment.EncryptedEnvFile
+		updates["env_key_id"] = deployment.EnvKeyID
+	}
+
 	if err := r.db.WithContext(ctx).Model(&Deployment{}).
 		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"env_file_content": envFileContent,
-			"env_vars":         envVarsJSON,
-			"last_deployed_at": time.Now(),
-		}).Error; err != nil {
+		Updates(updates).Error; err != nil {
 		return err
 	}
 
@@ -253,6 +286,21 @@ func (r *DeploymentRepository) Delete(ctx context.Context, id string) error {
 		Update("deleted_at", now).Error; err != nil {
 		return err
 	}
+	if err := DeleteResourceTags(r.db.WithContext(ctx), ResourceTagTypeDeployment, id); err != nil {
+		return err
+	}
+	if err := DeleteDeploymentAffinityRules(r.db.WithContext(ctx), id); err != nil {
+		return err
+	}
+	if err := DeleteDeploymentScaleSchedules(r.db.WithContext(ctx), id); err != nil {
+		return err
+	}
+	if err := DeleteDeploymentAutoScalePolicy(r.db.WithContext(ctx), id); err != nil {
+		return err
+	}
+	if err := DeleteDeploymentRegions(r.db.WithContext(ctx), id); err != nil {
+		return err
+	}
 
 	// Clear cache AFTER successful delete
 	if r.cache != nil {
@@ -267,19 +315,21 @@ func (r *DeploymentRepository) Delete(ctx context.Context, id string) error {
 
 func (r *DeploymentRepository) Count(ctx context.Context, organizationID string, filters *DeploymentFilters) (int64, error) {
 	query := r.db.WithContext(ctx).Model(&Deployment{}).
-		Where("organization_id = ? AND deleted_at IS NULL", organizationID)
+		Where("deployments.organization_id = ? AND deployments.deleted_at IS NULL", organizationID)
 
 	// Apply additional filters if provided
 	if filters != nil {
 		// Apply status filter
 		if filters.Status != nil {
-			query = query.Where("status = ?", *filters.Status)
+			query = query.Where("deployments.status = ?", *filters.Status)
 		}
 
 		// Apply user ID filter if provided and not in "include all" mode
 		if filters.UserID != "" && !filters.IncludeAll {
-			query = query.Where("created_by = ?", filters.UserID)
+			query = query.Where("deployments.created_by = ?", filters.UserID)
 		}
+
+		query = FilterByResourceTags(query, "deployments", ResourceTagTypeDeployment, filters.Tags)
 	}
 
 	var count int64
@@ -290,6 +340,7 @@ type DeploymentFilters struct {
 	Status     *int32
 	Limit      int
 	Offset     int
-	UserID     string // Filter by creator user ID
-	IncludeAll bool   // Include all deployments regardless of creator (for admins)
+	UserID     string            // Filter by creator user ID
+	IncludeAll bool              // Include all deployments regardless of creator (for admins)
+	Tags       map[string]string // Only deployments carrying every key=value tag
 }
@@ -6,7 +6,6 @@ import (
 	"testing"
 	"time"
 
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -115,16 +114,7 @@ func TestNormalizeDeploymentJSONFieldsPreservesExistingValues(t *testing.T) {
 func newDeploymentRepositoryTestDB(t *testing.T) *gorm.DB {
 	t.Helper()
 
-	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	if err != nil {
-		t.Fatalf("failed to open in-memory database: %v", err)
-	}
-
-	if err := db.AutoMigrate(&Deployment{}); err != nil {
-		t.Fatalf("failed to migrate deployment schema: %v", err)
-	}
-
-	return db
+	return newTestDB(t, &Deployment{})
 }
 
 func seedDeployments(t *testing.T, db *gorm.DB, deployments ...*Deployment) {
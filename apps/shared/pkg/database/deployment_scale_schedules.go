@@ -0,0 +1,70 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DeploymentScaleSchedule sets a deployment's replica count each time its cron expression matches
+type DeploymentScaleSchedule struct {
+	ID             string     `gorm:"primaryKey;column:id" json:"id"`
+	DeploymentID   string     `gorm:"column:deployment_id;index;not null" json:"deployment_id"`
+	OrganizationID string     `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	CronExpression string     `gorm:"column:cron_expression;not null" json:"cron_expression"` // Five-field cron expression, evaluated in UTC
+	ReplicaCount   int32      `gorm:"column:replica_count;not null" json:"replica_count"`
+	LastRunAt      *time.Time `gorm:"column:last_run_at" json:"last_run_at"` // Scheduled time of the last run
+	CreatedBy      string     `gorm:"column:created_by" json:"created_by"`
+	CreatedAt      time.Time  `gorm:"column:created_at" json:"created_at"`
+}
+
+func (DeploymentScaleSchedule) TableName() string {
+	return "deployment_scale_schedules"
+}
+
+// ListDeploymentScaleSchedules returns the scale schedules of a deployment, oldest first
+func ListDeploymentScaleSchedules(deploymentID string) ([]DeploymentScaleSchedule, error) {
+	var schedules []DeploymentScaleSchedule
+	if err := DB.Where("deployment_id = ?", deploymentID).Order("created_at, id").Find(&schedules).Error; err != nil {
+		return nil, fmt.Errorf("failed to get deployment scale schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// ListActiveDeploymentScaleSchedules returns the scale schedules of every deployment that is not deleted
+func ListActiveDeploymentScaleSchedules() ([]DeploymentScaleSchedule, error) {
+	var schedules []DeploymentScaleSchedule
+	if err := DB.Model(&DeploymentScaleSchedule{}).
+		Joins("JOIN deployments ON deployments.id = deployment_scale_schedules.deployment_id").
+		Where("deployments.deleted_at IS NULL").
+		Order("deployment_scale_schedules.created_at, deployment_scale_schedules.id").
+		Find(&schedules).Error; err != nil {
+		return nil, fmt.Errorf("failed to get deployment scale schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// ClaimDeploymentScaleScheduleRun records runAt as the schedule's last run unless another process
+// already recorded a run after previous. It reports whether this call claimed the run.
+func ClaimDeploymentScaleScheduleRun(id string, previous *time.Time, runAt time.Time) (bool, error) {
+	query := DB.Model(&DeploymentScaleSchedule{}).Where("id = ?", id)
+	if previous == nil {
+		query = query.Where("last_run_at IS NULL")
+	} else {
+		query = query.Where("last_run_at = ?", *previous)
+	}
+	result := query.Update("last_run_at", runAt)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to record scale schedule run: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteDeploymentScaleSchedules removes the scale schedules of a deleted deployment
+func DeleteDeploymentScaleSchedules(db *gorm.DB, deploymentID string) error {
+	if err := db.Where("deployment_id = ?", deploymentID).Delete(&DeploymentScaleSchedule{}).Error; err != nil {
+		return fmt.Errorf("failed to delete deployment scale schedules: %w", err)
+	}
+	return nil
+}
@@ -52,8 +52,34 @@ type NodeMetadata struct {
 	LastHeartbeat   time.Time `json:"last_heartbeat"`
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
+
+	// Labels of the deployments running on the node, loaded for affinity rules (not persisted)
+	DeploymentLabels []map[string]string `gorm:"-" json:"-"`
+	// Running deployments of the organization being placed, loaded for spread scoring (not persisted)
+	OrgDeploymentCount int `gorm:"-" json:"-"`
+}
+
+// Cluster node maintenance states recorded in cluster_nodes
+const (
+	ClusterNodeStatusActive        = "active"
+	ClusterNodeStatusDraining      = "draining"
+	ClusterNodeStatusDrained       = "drained"
+	ClusterNodeStatusDrainTimedOut = "drain_timed_out"
+)
+
+// ClusterNode tracks the maintenance state of a cluster node (drain / re-enable)
+type ClusterNode struct {
+	NodeID         string     `gorm:"primaryKey;column:node_id" json:"node_id"` // Swarm node ID
+	Hostname       string     `json:"hostname"`
+	Status         string     `gorm:"index;default:'active'" json:"status"` // active, draining, drained, drain_timed_out
+	DrainStartedAt *time.Time `json:"drain_started_at,omitempty"`
+	DrainedAt      *time.Time `json:"drained_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
 }
 
+func (ClusterNode) TableName() string { return "cluster_nodes" }
+
 // DeploymentRouting stores routing configuration for deployments
 // Supports multiple routing rules per deployment (e.g., different services/ports on different domains)
 type DeploymentRouting struct {
@@ -120,6 +146,7 @@ func InitDeploymentTracking() error {
 	if err := DB.AutoMigrate(
 		&DeploymentLocation{},
 		&NodeMetadata{},
+		&ClusterNode{},
 		&DeploymentRouting{},
 		&GameServerHTTPRoute{},
 		&GameServerDomainVerification{},
@@ -218,6 +245,14 @@ func createMetricsIndexes() error {
 		return fmt.Errorf("failed to create game_server_usage_hourly index: %w", err)
 	}
 
+	// Audit log exports page through one resource's events in time order
+	if err := db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_audit_logs_resource_created
+		ON audit_logs(resource_id, created_at)
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create audit_logs resource index: %w", err)
+	}
+
 	return nil
 }
 
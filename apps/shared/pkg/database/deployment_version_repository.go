@@ -0,0 +1,214 @@
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const defaultDeploymentMaxVersions = 10
+
+// ErrDeploymentVersionNotFound is returned when a deployment has no version with the requested number
+var ErrDeploymentVersionNotFound = errors.New("deployment version not found")
+
+type DeploymentVersionRepository struct {
+	db          *gorm.DB
+	maxVersions int
+}
+
+// NewDeploymentVersionRepository returns a repository that keeps at most maxVersions versions per deployment
+func NewDeploymentVersionRepository(db *gorm.DB, maxVersions int) *DeploymentVersionRepository {
+	if maxVersions <= 0 {
+		maxVersions = defaultDeploymentMaxVersions
+	}
+	return &DeploymentVersionRepository{
+		db:          db,
+		maxVersions: maxVersions,
+	}
+}
+
+// DeploymentMaxVersionsFromEnv returns DEPLOYMENT_MAX_VERSIONS (default 10)
+func DeploymentMaxVersionsFromEnv() int {
+	if v := strings.TrimSpace(os.Getenv("DEPLOYMENT_MAX_VERSIONS")); v != "" {
+		if parsed, err := strconv.Atoi(v); err == nil && parsed > 0 {
+			return parsed
+		}
+		logger.Warn("[DeploymentVersions] Invalid DEPLOYMENT_MAX_VERSIONS %q, using %d", v, defaultDeploymentMaxVersions)
+	}
+	return defaultDeploymentMaxVersions
+}
+
+// Record stores the deployment's image and env vars as its active version
+// If a retained version already has the same image and env vars it is reactivated instead of
+// adding a new one. Versions beyond the limit are pruned, oldest first; the active one is always kept.
+func (r *DeploymentVersionRepository) Record(ctx context.Context, deployment *Deployment, image string) (*DeploymentVersion, error) {
+	if image == "" {
+		return nil, fmt.Errorf("deployment %s has no image", deployment.ID)
+	}
+	envHash := deploymentEnvHash(deployment)
+
+	var version DeploymentVersion
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("deployment_id = ? AND image = ? AND env_vars_hash = ?", deployment.ID, image, envHash).
+			Order("version_number DESC").First(&version).Error
+		if err == nil {
+			return activateDeploymentVersion(tx, deployment.ID, version.VersionNumber)
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		var latest int32
+		if err := tx.Model(&DeploymentVersion{}).
+			Where("deployment_id = ?", deployment.ID).
+			Select("COALESCE(MAX(version_number), 0)").
+			Scan(&latest).Error; err != nil {
+			return err
+		}
+
+		version = DeploymentVersion{
+			ID:            fmt.Sprintf("depver-%s", uuid.NewString()),
+			DeploymentID:  deployment.ID,
+			VersionNumber: latest + 1,
+			Image:         image,
+			EnvVarsHash:   envHash,
+		}
+		// Keep the env vars in the form they are stored on the deployment
+		if len(deployment.EncryptedEnv) > 0 {
+			version.EnvVars = "{}"
+			version.EncryptedEnv = deployment.EncryptedEnv
+			version.EncryptedEnvFile = deployment.EncryptedEnvFile
+			version.EnvKeyID = deployment.EnvKeyID
+		} else {
+			version.EnvVars = deployment.EnvVars
+			version.EnvFileContent = deployment.EnvFileContent
+			if version.EnvVars == "" {
+				version.EnvVars = "{}"
+			}
+		}
+		if err := tx.Create(&version).Error; err != nil {
+			return err
+		}
+		if err := activateDeploymentVersion(tx, deployment.ID, version.VersionNumber); err != nil {
+			return err
+		}
+		return r.prune(tx, deployment.ID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("record version of deployment %s: %w", deployment.ID, err)
+	}
+	version.Active = true
+	return &version, nil
+}
+
+// List returns the deployment's retained versions, newest first
+func (r *DeploymentVersionRepository) List(ctx context.Context, deploymentID string) ([]DeploymentVersion, error) {
+	var versions []DeploymentVersion
+	if err := r.db.WithContext(ctx).
+		Where("deployment_id = ?", deploymentID).
+		Order("version_number DESC").
+		Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// Get returns a version of the deployment, or ErrDeploymentVersionNotFound
+func (r *DeploymentVersionRepository) Get(ctx context.Context, deploymentID string, versionNumber int32) (*DeploymentVersion, error) {
+	var version DeploymentVersion
+	if err := r.db.WithContext(ctx).
+		Where("deployment_id = ? AND version_number = ?", deploymentID, versionNumber).
+		First(&version).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDeploymentVersionNotFound
+		}
+		return nil, err
+	}
+	return &version, nil
+}
+
+// Activate marks versionNumber as the version the deployment runs
+func (r *DeploymentVersionRepository) Activate(ctx context.Context, deploymentID string, versionNumber int32) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return activateDeploymentVersion(tx, deploymentID, versionNumber)
+	})
+}
+
+// DeleteByDeployment removes all versions of a deployment
+func (r *DeploymentVersionRepository) DeleteByDeployment(ctx context.Context, deploymentID string) error {
+	return r.db.WithContext(ctx).Where("deployment_id = ?", deploymentID).Delete(&DeploymentVersion{}).Error
+}
+
+// prune deletes the oldest inactive versions beyond maxVersions
+func (r *DeploymentVersionRepository) prune(tx *gorm.DB, deploymentID string) error {
+	var keep []int32
+	if err := tx.Model(&DeploymentVersion{}).
+		Where("deployment_id = ?", deploymentID).
+		Order("version_number DESC").
+		Limit(r.maxVersions).
+		Pluck("version_number", &keep).Error; err != nil {
+		return err
+	}
+	if len(keep) < r.maxVersions {
+		return nil
+	}
+	return tx.Where("deployment_id = ? AND active = ? AND version_number NOT IN ?", deploymentID, false, keep).
+		Delete(&DeploymentVersion{}).Error
+}
+
+func activateDeploymentVersion(tx *gorm.DB, deploymentID string, versionNumber int32) error {
+	if err := tx.Model(&DeploymentVersion{}).
+		Where("deployment_id = ? AND version_number <> ?", deploymentID, versionNumber).
+		Update("active", false).Error; err != nil {
+		return err
+	}
+	return tx.Model(&DeploymentVersion{}).
+		Where("deployment_id = ? AND version_number = ?", deploymentID, versionNumber).
+		Update("active", true).Error
+}
+
+// ApplyTo restores the version's image and env vars on the deployment
+// Encrypted env vars are opened with the registered cipher, so the deployment holds plaintext
+// afterwards like any loaded deployment; saving it re-encrypts them with the current key.
+func (v *DeploymentVersion) ApplyTo(deployment *Deployment) error {
+	if len(v.EncryptedEnv) > 0 {
+		if deploymentEnvCipher == nil {
+			return fmt.Errorf("version %d of deployment %s has encrypted env vars but no cipher is registered", v.VersionNumber, v.DeploymentID)
+		}
+		restored := &Deployment{
+			ID:               deployment.ID,
+			OrganizationID:   deployment.OrganizationID,
+			EncryptedEnv:     v.EncryptedEnv,
+			EncryptedEnvFile: v.EncryptedEnvFile,
+			EnvKeyID:         v.EnvKeyID,
+		}
+		if err := deploymentEnvCipher.Open(restored); err != nil {
+			return fmt.Errorf("decrypt env vars of version %d: %w", v.VersionNumber, err)
+		}
+		deployment.EnvVars = restored.EnvVars
+		deployment.EnvFileContent = restored.EnvFileContent
+	} else {
+		deployment.EnvVars = v.EnvVars
+		deployment.EnvFileContent = v.EnvFileContent
+	}
+
+	image := v.Image
+	deployment.Image = &image
+	return nil
+}
+
+// deploymentEnvHash fingerprints the deployment's plaintext env vars and env file
+func deploymentEnvHash(deployment *Deployment) string {
+	sum := sha256.Sum256([]byte(deployment.EnvVars + "\x00" + deployment.EnvFileContent))
+	return hex.EncodeToString(sum[:])
+}
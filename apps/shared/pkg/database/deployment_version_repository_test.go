@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDeploymentVersionRepositoryRecord(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t, &DeploymentVersion{})
+	repo := NewDeploymentVersionRepository(db, 3)
+	deployment := &Deployment{ID: "dep-1", EnvVars: `{"A":"1"}`}
+
+	for i, image := range []string{"sha256:a", "sha256:b", "sha256:c", "sha256:d"} {
+		version, err := repo.Record(ctx, deployment, image)
+		if err != nil {
+			t.Fatalf("Record(%s): %v", image, err)
+		}
+		if version.VersionNumber != int32(i+1) {
+			t.Fatalf("Record(%s) version = %d, want %d", image, version.VersionNumber, i+1)
+		}
+	}
+
+	versions, err := repo.List(ctx, "dep-1")
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	assertVersionNumbers(t, versions, []int32{4, 3, 2})
+	if !versions[0].Active || versions[1].Active || versions[2].Active {
+		t.Fatalf("only version 4 should be active: %+v", versions)
+	}
+
+	// Deploying a retained image and env again reactivates its version
+	if err := repo.Activate(ctx, "dep-1", 2); err != nil {
+		t.Fatalf("Activate: %v", err)
+	}
+	version, err := repo.Record(ctx, deployment, "sha256:c")
+	if err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	if version.VersionNumber != 3 {
+		t.Fatalf("redeploying sha256:c recorded version %d, want 3", version.VersionNumber)
+	}
+
+	// Changed env vars make a new version; pruning keeps the newest and the active one
+	if err := repo.Activate(ctx, "dep-1", 2); err != nil {
+		t.Fatalf("Activate: %v", err)
+	}
+	deployment.EnvVars = `{"A":"2"}`
+	if _, err := repo.Record(ctx, deployment, "sha256:c"); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	versions, err = repo.List(ctx, "dep-1")
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	assertVersionNumbers(t, versions, []int32{5, 4, 3})
+
+	restored := &Deployment{ID: "dep-1"}
+	old, err := repo.Get(ctx, "dep-1", 3)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if err := old.ApplyTo(restored); err != nil {
+		t.Fatalf("ApplyTo: %v", err)
+	}
+	if restored.Image == nil || *restored.Image != "sha256:c" || restored.EnvVars != `{"A":"1"}` {
+		t.Fatalf("restored deployment = %v / %q", restored.Image, restored.EnvVars)
+	}
+
+	if _, err := repo.Get(ctx, "dep-1", 1); err != ErrDeploymentVersionNotFound {
+		t.Fatalf("Get(pruned) error = %v, want ErrDeploymentVersionNotFound", err)
+	}
+}
+
+func assertVersionNumbers(t *testing.T, versions []DeploymentVersion, want []int32) {
+	t.Helper()
+
+	got := make([]int32, 0, len(versions))
+	for _, version := range versions {
+		got = append(got, version.VersionNumber)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("versions = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("versions = %v, want %v", got, want)
+		}
+	}
+}
@@ -14,7 +14,13 @@ import (
 // GetDeploymentNodeIP returns the preferred IPs for a deployment based on where it's running.
 // It prefers the actual node IP recorded on the selected location, then falls back to the
 // node metadata IP, and only uses region->NODE_IPS mapping as a compatibility fallback.
+// Multi-region deployments resolve to the Traefik IP of every region they were provisioned in.
 func GetDeploymentNodeIP(deploymentID string, nodeIPMap map[string][]string) ([]string, error) {
+	// A failed lookup falls back to the deployment's locations rather than failing resolution
+	if regionIPs, err := GetDeploymentRegionTraefikIPs(deploymentID); err == nil && len(regionIPs) > 0 {
+		return regionIPs, nil
+	}
+
 	// Get deployment locations (where deployment is actually running)
 	var locations []DeploymentLocation
 	preferredStatuses := []string{"running", "restarting", "starting", "created"}
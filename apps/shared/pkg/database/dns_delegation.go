@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -65,11 +66,32 @@ func (d *DelegatedDNSRecord) IsExpired() bool {
 	return time.Now().After(d.ExpiresAt)
 }
 
+// ErrWildcardRecordConflict is returned when a wildcard record is pushed for a domain suffix
+// whose wildcard record belongs to another API key
+var ErrWildcardRecordConflict = errors.New("a wildcard record for this domain belongs to another API key")
+
+// NormalizeDelegatedDNSDomain returns a domain in its stored form: lowercased without the trailing dot.
+// A wildcard domain ("*.app.example.com") covers the subdomains of its suffix; "*" must be its whole
+// first label and can't be used anywhere else.
+func NormalizeDelegatedDNSDomain(domain string) (string, error) {
+	domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+	suffix, _ := strings.CutPrefix(domain, "*.")
+	if suffix == "" || strings.Contains(suffix, "*") || strings.Contains(suffix, "..") {
+		return "", fmt.Errorf("invalid domain %q", domain)
+	}
+	return domain, nil
+}
+
+// IsWildcardDNSDomain reports whether a normalized domain is a wildcard domain
+func IsWildcardDNSDomain(domain string) bool {
+	return strings.HasPrefix(domain, "*.")
+}
+
 // GetDelegatedDNSRecord retrieves a delegated DNS record if it exists and is not expired
 func GetDelegatedDNSRecord(domain, recordType string) (*DelegatedDNSRecord, error) {
 	// Normalize domain by removing trailing dot - DNS queries often include trailing dots
 	// but database stores domains without them
-	domainNormalized := strings.TrimSuffix(domain, ".")
+	domainNormalized := strings.ToLower(strings.TrimSuffix(domain, "."))
 	var record DelegatedDNSRecord
 	result := DB.Where("domain = ? AND record_type = ? AND expires_at > ?", domainNormalized, recordType, time.Now()).
 		First(&record)
@@ -86,9 +108,17 @@ func UpsertDelegatedDNSRecord(domain, recordType, recordsJSON, sourceAPI string,
 
 // UpsertDelegatedDNSRecordWithAPIKey creates or updates a delegated DNS record with API key tracking
 // Uses a transaction to prevent race conditions and handle expired records correctly
+// Wildcard A records are supported; an unexpired wildcard record can only be refreshed by the API key that pushed it
 func UpsertDelegatedDNSRecordWithAPIKey(domain, recordType, recordsJSON, sourceAPI, apiKeyID, organizationID string, ttl int64) error {
-	// Normalize domain by removing trailing dot - ensure consistent storage format
-	domainNormalized := strings.TrimSuffix(domain, ".")
+	// Normalize domain - ensure consistent storage format
+	domainNormalized, err := NormalizeDelegatedDNSDomain(domain)
+	if err != nil {
+		return err
+	}
+	isWildcard := IsWildcardDNSDomain(domainNormalized)
+	if isWildcard && recordType != "A" {
+		return fmt.Errorf("wildcard domains are only supported for A records")
+	}
 	now := time.Now()
 	expiresAt := now.Add(time.Duration(ttl) * time.Second)
 
@@ -98,6 +128,9 @@ func UpsertDelegatedDNSRecordWithAPIKey(domain, recordType, recordsJSON, sourceA
 		result := tx.Where("domain = ? AND record_type = ?", domainNormalized, recordType).First(&existing)
 		
 		if result.Error == nil {
+			if isWildcard && existing.APIKeyID != apiKeyID && !existing.IsExpired() {
+				return ErrWildcardRecordConflict
+			}
 			// Record exists - update it (this refreshes expiration even if it was expired)
 			updateData := map[string]interface{}{
 				"records":         recordsJSON,
@@ -137,6 +170,9 @@ func UpsertDelegatedDNSRecordWithAPIKey(domain, recordType, recordsJSON, sourceA
 					// Try to update it instead
 					var raceRecord DelegatedDNSRecord
 					if tx.Where("domain = ? AND record_type = ?", domainNormalized, recordType).First(&raceRecord).Error == nil {
+						if isWildcard && raceRecord.APIKeyID != apiKeyID {
+							return ErrWildcardRecordConflict
+						}
 						updateData := map[string]interface{}{
 							"records":         recordsJSON,
 							"source_api":      sourceAPI,
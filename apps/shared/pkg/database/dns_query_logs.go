@@ -0,0 +1,110 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+// DNSQueryLog is one query answered by the DNS service, stored in the metrics database
+type DNSQueryLog struct {
+	ID             string    `gorm:"primaryKey;column:id" json:"id"`
+	Timestamp      time.Time `gorm:"primaryKey;column:timestamp;index" json:"timestamp"` // Part of the primary key so the table can be a hypertable
+	Domain         string    `gorm:"column:domain;index;not null" json:"domain"`         // Lowercase, without the trailing dot
+	QueryType      string    `gorm:"column:query_type;not null" json:"query_type"`       // A, SRV, TXT, ...
+	ClientIP       string    `gorm:"column:client_ip" json:"client_ip"`
+	Result         string    `gorm:"column:result;not null" json:"result"` // Response code: NOERROR, NXDOMAIN, SERVFAIL, ...
+	LatencyMicros  int64     `gorm:"column:latency_micros" json:"latency_micros"`
+	OrganizationID string    `gorm:"column:organization_id;index" json:"organization_id"` // Owner of the queried resource, empty when unknown
+}
+
+func (DNSQueryLog) TableName() string {
+	return "dns_query_logs"
+}
+
+// DNSQueryLogFilter selects DNS query logs. Domain matches the domain itself and its subdomains.
+type DNSQueryLogFilter struct {
+	OrganizationID string
+	Domain         string
+	Since          time.Time
+	Limit          int
+	Offset         int
+}
+
+// CreateDNSQueryLogs stores a batch of DNS query logs
+func CreateDNSQueryLogs(ctx context.Context, logs []DNSQueryLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	if MetricsDB == nil {
+		return fmt.Errorf("metrics database not initialized")
+	}
+	if err := MetricsDB.WithContext(ctx).CreateInBatches(logs, 500).Error; err != nil {
+		return fmt.Errorf("failed to store DNS query logs: %w", err)
+	}
+	return nil
+}
+
+// ListDNSQueryLogs returns the logs matching the filter, newest first, and the total number of matches
+func ListDNSQueryLogs(ctx context.Context, filter DNSQueryLogFilter) ([]DNSQueryLog, int64, error) {
+	if MetricsDB == nil {
+		return nil, 0, fmt.Errorf("metrics database not initialized")
+	}
+	query := MetricsDB.WithContext(ctx).Model(&DNSQueryLog{})
+	if filter.OrganizationID != "" {
+		query = query.Where("organization_id = ?", filter.OrganizationID)
+	}
+	if domain := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(filter.Domain)), "."); domain != "" {
+		// Escape LIKE wildcards: SRV names such as _minecraft._tcp contain underscores
+		pattern := "%." + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(domain)
+		query = query.Where(`domain = ? OR domain LIKE ? ESCAPE '\'`, domain, pattern)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("timestamp >= ?", filter.Since)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count DNS query logs: %w", err)
+	}
+	var logs []DNSQueryLog
+	if err := query.Order("timestamp DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&logs).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list DNS query logs: %w", err)
+	}
+	return logs, total, nil
+}
+
+// CleanOldDNSQueryLogs removes DNS query logs older than the retention period and returns how many were removed
+func CleanOldDNSQueryLogs(ctx context.Context, retentionDays int) (int64, error) {
+	if MetricsDB == nil {
+		return 0, fmt.Errorf("metrics database not initialized")
+	}
+	cutoff := time.Now().AddDate(0, 0, -retentionDays)
+	result := MetricsDB.WithContext(ctx).Where("timestamp < ?", cutoff).Delete(&DNSQueryLog{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to clean old DNS query logs: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// initDNSQueryLogsHypertable converts dns_query_logs to a TimescaleDB hypertable with daily chunks
+func initDNSQueryLogsHypertable(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&DNSQueryLog{}) {
+		return nil
+	}
+	if err := db.Exec(`
+		SELECT create_hypertable('dns_query_logs', 'timestamp',
+			chunk_time_interval => INTERVAL '1 day',
+			if_not_exists => TRUE,
+			migrate_data => TRUE)
+	`).Error; err != nil {
+		return err
+	}
+	logger.Debug("dns_query_logs is a TimescaleDB hypertable")
+	return nil
+}
@@ -6,7 +6,9 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"path"
 	"strings"
 	"time"
 
@@ -23,20 +25,22 @@ const (
 
 // FileTransferCredential stores credentials for out-of-band file transfer protocols.
 type FileTransferCredential struct {
-	ID             string         `gorm:"type:text;primaryKey" json:"id"`
-	Name           string         `gorm:"type:text;not null" json:"name"`
-	KeyHash        string         `gorm:"type:text;uniqueIndex;not null" json:"-"`
-	UserID         string         `gorm:"type:text;not null;index" json:"user_id"`
-	OrganizationID string         `gorm:"type:text;not null;index" json:"organization_id"`
-	ResourceType   string         `gorm:"type:text;not null;index:idx_file_transfer_resource" json:"resource_type"`
-	ResourceID     string         `gorm:"type:text;not null;index:idx_file_transfer_resource" json:"resource_id"`
-	Scopes         string         `gorm:"type:text;not null" json:"scopes"`
-	LastUsedAt     *time.Time     `gorm:"type:timestamptz" json:"last_used_at,omitempty"`
-	ExpiresAt      *time.Time     `gorm:"type:timestamptz" json:"expires_at,omitempty"`
-	RevokedAt      *time.Time     `gorm:"type:timestamptz" json:"revoked_at,omitempty"`
-	CreatedAt      time.Time      `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
-	UpdatedAt      time.Time      `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
-	DeletedAt      gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+	ID                   string         `gorm:"type:text;primaryKey" json:"id"`
+	Name                 string         `gorm:"type:text;not null" json:"name"`
+	KeyHash              string         `gorm:"type:text;uniqueIndex;not null" json:"-"`
+	UserID               string         `gorm:"type:text;not null;index" json:"user_id"`
+	OrganizationID       string         `gorm:"type:text;not null;index" json:"organization_id"`
+	ResourceType         string         `gorm:"type:text;not null;index:idx_file_transfer_resource" json:"resource_type"`
+	ResourceID           string         `gorm:"type:text;not null;index:idx_file_transfer_resource" json:"resource_id"`
+	Scopes               string         `gorm:"type:text;not null" json:"scopes"`
+	AllowedPaths         string         `gorm:"type:text;not null;default:'[]'" json:"allowed_paths"`        // JSON array of path prefixes within the resource root; empty allows the whole root
+	AuthorizedPublicKeys string         `gorm:"type:text;not null;default:''" json:"authorized_public_keys"` // SSH public keys in authorized_keys format, one per line
+	LastUsedAt           *time.Time     `gorm:"type:timestamptz" json:"last_used_at,omitempty"`
+	ExpiresAt            *time.Time     `gorm:"type:timestamptz" json:"expires_at,omitempty"`
+	RevokedAt            *time.Time     `gorm:"type:timestamptz" json:"revoked_at,omitempty"`
+	CreatedAt            time.Time      `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt            time.Time      `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+	DeletedAt            gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
 }
 
 func (FileTransferCredential) TableName() string {
@@ -56,6 +60,9 @@ func (c *FileTransferCredential) BeforeCreate(tx *gorm.DB) error {
 	}
 	c.ResourceType = NormalizeFileTransferResourceType(c.ResourceType)
 	c.Scopes = NormalizeFileTransferScopes(c.Scopes)
+	if c.AllowedPaths == "" {
+		c.AllowedPaths = "[]"
+	}
 	return nil
 }
 
@@ -102,6 +109,30 @@ func (r *FileTransferCredentialRepository) GetActiveBySecret(ctx context.Context
 	return &credential, nil
 }
 
+// GetActiveByResource returns an active credential of the resource by ID
+func (r *FileTransferCredentialRepository) GetActiveByResource(ctx context.Context, id string, resourceType string, resourceID string, now time.Time) (*FileTransferCredential, error) {
+	resourceType = NormalizeFileTransferResourceType(resourceType)
+	var credential FileTransferCredential
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND resource_type = ? AND resource_id = ? AND revoked_at IS NULL AND deleted_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", id, resourceType, resourceID, now).
+		First(&credential).Error
+	if err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// UpdateAuthorizedPublicKeys replaces the authorized public keys of a credential
+func (r *FileTransferCredentialRepository) UpdateAuthorizedPublicKeys(ctx context.Context, id string, keys []string) error {
+	return r.db.WithContext(ctx).
+		Model(&FileTransferCredential{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"authorized_public_keys": strings.Join(keys, "\n"),
+			"updated_at":             time.Now(),
+		}).Error
+}
+
 func (r *FileTransferCredentialRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
 	return r.db.WithContext(ctx).
 		Model(&FileTransferCredential{}).
@@ -120,6 +151,17 @@ func (r *FileTransferCredentialRepository) RevokeByResource(ctx context.Context,
 		}).Error
 }
 
+// SplitFileTransferAuthorizedKeys returns the non-empty lines of the authorized_public_keys column
+func SplitFileTransferAuthorizedKeys(raw string) []string {
+	var keys []string
+	for _, line := range strings.Split(raw, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			keys = append(keys, line)
+		}
+	}
+	return keys
+}
+
 func GenerateFileTransferSecret() (string, error) {
 	buf := make([]byte, 32)
 	if _, err := rand.Read(buf); err != nil {
@@ -183,3 +225,84 @@ func FileTransferCredentialHasScope(scopes string, required string) bool {
 	}
 	return false
 }
+
+// ValidateFileTransferAllowedPaths rejects path prefixes that use ".." or name the whole resource root.
+// Access to the whole root is granted by an empty list, never by a prefix.
+func ValidateFileTransferAllowedPaths(paths []string) error {
+	for _, p := range paths {
+		if _, ok := cleanFileTransferAllowedPath(p); !ok {
+			return fmt.Errorf("invalid allowed path %q: use a directory inside the root without \"..\"", p)
+		}
+	}
+	return nil
+}
+
+// NormalizeFileTransferAllowedPaths cleans path prefixes into "/a/b" form relative to the resource root.
+// Duplicates are dropped, as are prefixes that ValidateFileTransferAllowedPaths rejects, so an
+// invalid prefix never widens access.
+func NormalizeFileTransferAllowedPaths(paths []string) []string {
+	seen := make(map[string]struct{}, len(paths))
+	out := make([]string, 0, len(paths))
+	for _, p := range paths {
+		cleaned, ok := cleanFileTransferAllowedPath(p)
+		if !ok {
+			continue
+		}
+		if _, ok := seen[cleaned]; ok {
+			continue
+		}
+		seen[cleaned] = struct{}{}
+		out = append(out, cleaned)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// cleanFileTransferAllowedPath returns p in "/a/b" form, or false if it is blank, climbs with ".."
+// or cleans to the root
+func cleanFileTransferAllowedPath(p string) (string, bool) {
+	p = strings.TrimSpace(strings.ReplaceAll(p, "\\", "/"))
+	for _, segment := range strings.Split(p, "/") {
+		if segment == ".." {
+			return "", false
+		}
+	}
+	cleaned := path.Clean("/" + p)
+	if cleaned == "/" {
+		return "", false
+	}
+	return cleaned, true
+}
+
+// EncodeFileTransferAllowedPaths serializes path prefixes for the allowed_paths column.
+func EncodeFileTransferAllowedPaths(paths []string) string {
+	normalized := NormalizeFileTransferAllowedPaths(paths)
+	if len(normalized) == 0 {
+		return "[]"
+	}
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return "[]"
+	}
+	return string(data)
+}
+
+// DecodeFileTransferAllowedPaths parses the allowed_paths column. An unreadable value allows
+// nothing rather than the whole root.
+func DecodeFileTransferAllowedPaths(raw string) ([]string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	var paths []string
+	if err := json.Unmarshal([]byte(raw), &paths); err != nil {
+		return nil, fmt.Errorf("invalid allowed paths: %w", err)
+	}
+	normalized := NormalizeFileTransferAllowedPaths(paths)
+	if len(paths) > 0 && len(normalized) == 0 {
+		return nil, fmt.Errorf("invalid allowed paths: no valid prefix in %s", raw)
+	}
+	return normalized, nil
+}
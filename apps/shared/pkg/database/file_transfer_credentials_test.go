@@ -26,3 +26,45 @@ func TestNormalizeFileTransferResourceType(t *testing.T) {
 		t.Fatalf("NormalizeFileTransferResourceType() = %q", got)
 	}
 }
+
+func TestFileTransferAllowedPathsRoundTrip(t *testing.T) {
+	encoded := EncodeFileTransferAllowedPaths([]string{" plugins/ ", "/world/./config", "plugins"})
+	if encoded != `["/plugins","/world/config"]` {
+		t.Fatalf("EncodeFileTransferAllowedPaths() = %s", encoded)
+	}
+	decoded, err := DecodeFileTransferAllowedPaths(encoded)
+	if err != nil {
+		t.Fatalf("DecodeFileTransferAllowedPaths() returned error: %v", err)
+	}
+	if len(decoded) != 2 || decoded[0] != "/plugins" || decoded[1] != "/world/config" {
+		t.Fatalf("DecodeFileTransferAllowedPaths() = %v", decoded)
+	}
+	if _, err := DecodeFileTransferAllowedPaths("not json"); err == nil {
+		t.Fatal("expected invalid allowed paths to be rejected")
+	}
+}
+
+func TestValidateFileTransferAllowedPaths(t *testing.T) {
+	if err := ValidateFileTransferAllowedPaths([]string{"plugins", "/world/config/"}); err != nil {
+		t.Fatalf("valid prefixes rejected: %v", err)
+	}
+	if err := ValidateFileTransferAllowedPaths(nil); err != nil {
+		t.Fatalf("empty list rejected: %v", err)
+	}
+	for _, p := range []string{"../..", "/world/../config", "..\\etc", "/", ".", "", "  "} {
+		if err := ValidateFileTransferAllowedPaths([]string{"plugins", p}); err == nil {
+			t.Fatalf("ValidateFileTransferAllowedPaths accepted %q", p)
+		}
+	}
+}
+
+func TestFileTransferAllowedPathsNeverWidenToRoot(t *testing.T) {
+	// Prefixes that would cover the root are dropped instead of granting it
+	if got := EncodeFileTransferAllowedPaths([]string{"/plugins", "../.."}); got != `["/plugins"]` {
+		t.Fatalf("EncodeFileTransferAllowedPaths() = %s, want only /plugins", got)
+	}
+	// A stored list with only such prefixes allows nothing
+	if _, err := DecodeFileTransferAllowedPaths(`["../..", "/"]`); err == nil {
+		t.Fatal("DecodeFileTransferAllowedPaths() granted the root for invalid prefixes")
+	}
+}
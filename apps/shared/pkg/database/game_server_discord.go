@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Game server events a Discord integration can subscribe to
+const (
+	DiscordEventStart       = "start"
+	DiscordEventStop        = "stop"
+	DiscordEventCrash       = "crash"
+	DiscordEventPlayerJoin  = "player_join"
+	DiscordEventPlayerLeave = "player_leave"
+)
+
+// DiscordEvents lists the events a Discord integration can subscribe to
+var DiscordEvents = []string{DiscordEventStart, DiscordEventStop, DiscordEventCrash, DiscordEventPlayerJoin, DiscordEventPlayerLeave}
+
+// DiscordIntegration posts game server events to a Discord channel through a bot
+type DiscordIntegration struct {
+	ID             string    `gorm:"primaryKey;column:id" json:"id"`
+	OrganizationID string    `gorm:"column:organization_id;index" json:"organization_id"`
+	GameServerID   string    `gorm:"column:game_server_id;uniqueIndex;not null" json:"game_server_id"` // One integration per game server
+	BotToken       string    `gorm:"column:bot_token;not null" json:"-"`                               // Encrypted at rest
+	ChannelID      string    `gorm:"column:channel_id;not null" json:"channel_id"`
+	Events         string    `gorm:"column:events" json:"events"` // Comma-separated DiscordEvent* values
+	CreatedBy      string    `gorm:"column:created_by" json:"created_by"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (DiscordIntegration) TableName() string { return "discord_integrations" }
+
+// EventList returns the events the integration is subscribed to
+func (d *DiscordIntegration) EventList() []string {
+	var events []string
+	for _, event := range strings.Split(d.Events, ",") {
+		if event = strings.TrimSpace(event); event != "" {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// HasEvent reports whether the integration is subscribed to event
+func (d *DiscordIntegration) HasEvent(event string) bool {
+	for _, subscribed := range d.EventList() {
+		if subscribed == event {
+			return true
+		}
+	}
+	return false
+}
+
+// ListDiscordIntegrationsForEvent returns the Discord integrations of a game server subscribed to event
+func ListDiscordIntegrationsForEvent(ctx context.Context, gameServerID, event string) ([]DiscordIntegration, error) {
+	var integrations []DiscordIntegration
+	if err := DB.WithContext(ctx).Where("game_server_id = ?", gameServerID).Find(&integrations).Error; err != nil {
+		return nil, fmt.Errorf("failed to list Discord integrations: %w", err)
+	}
+	subscribed := integrations[:0]
+	for _, integration := range integrations {
+		if integration.HasEvent(event) {
+			subscribed = append(subscribed, integration)
+		}
+	}
+	return subscribed, nil
+}
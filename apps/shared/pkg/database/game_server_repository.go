@@ -95,6 +95,7 @@ type GameServerFilters struct {
 	IncludeAll bool
 	Status     *int32
 	GameType   *int32
+	Tags       map[string]string // Only game servers carrying every key=value tag
 	Limit      int64
 	Offset     int64
 }
@@ -104,24 +105,26 @@ func (r *GameServerRepository) GetAll(ctx context.Context, organizationID string
 	// However, lists are often filtered/paginated, so caching is less effective
 	// We'll rely on individual item caching from GetByID calls
 
-	query := r.db.WithContext(ctx).Where("organization_id = ? AND deleted_at IS NULL", organizationID)
+	query := r.db.WithContext(ctx).Where("game_servers.organization_id = ? AND game_servers.deleted_at IS NULL", organizationID)
 
 	if filters != nil {
 		// Apply status filter if provided
 		if filters.Status != nil {
-			query = query.Where("status = ?", *filters.Status)
+			query = query.Where("game_servers.status = ?", *filters.Status)
 		}
 
 		// Apply game type filter if provided
 		if filters.GameType != nil {
-			query = query.Where("game_type = ?", *filters.GameType)
+			query = query.Where("game_servers.game_type = ?", *filters.GameType)
 		}
 
 		// Apply user ID filter if provided and not in "include all" mode
 		if filters.UserID != "" && !filters.IncludeAll {
-			query = query.Where("created_by = ?", filters.UserID)
+			query = query.Where("game_servers.created_by = ?", filters.UserID)
 		}
 
+		query = FilterByResourceTags(query, "game_servers", ResourceTagTypeGameServer, filters.Tags)
+
 		// Apply pagination
 		if filters.Limit > 0 {
 			query = query.Limit(int(filters.Limit))
@@ -237,6 +240,40 @@ func (r *GameServerRepository) UpdateStorage(ctx context.Context, id string, sto
 	return nil
 }
 
+// UpdatePlayerCount records the number of players online
+func (r *GameServerRepository) UpdatePlayerCount(ctx context.Context, id string, playerCount int32) error {
+	if err := r.db.WithContext(ctx).Model(&GameServer{}).
+		Where("id = ?", id).
+		Update("player_count", playerCount).Error; err != nil {
+		return err
+	}
+
+	// Clear cache AFTER successful update
+	if r.cache != nil {
+		r.cache.Delete(ctx, fmt.Sprintf("gameserver:%s", id))
+	}
+
+	return nil
+}
+
+func (r *GameServerRepository) UpdateBackupSchedule(ctx context.Context, id string, schedule *string, retention int32) error {
+	if err := r.db.WithContext(ctx).Model(&GameServer{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"backup_schedule":  schedule,
+			"backup_retention": retention,
+		}).Error; err != nil {
+		return err
+	}
+
+	// Clear cache AFTER successful update
+	if r.cache != nil {
+		r.cache.Delete(ctx, fmt.Sprintf("gameserver:%s", id))
+	}
+
+	return nil
+}
+
 func (r *GameServerRepository) Delete(ctx context.Context, id string) error {
 	// Soft delete
 	now := time.Now()
@@ -245,6 +282,9 @@ func (r *GameServerRepository) Delete(ctx context.Context, id string) error {
 		Update("deleted_at", now).Error; err != nil {
 		return err
 	}
+	if err := DeleteResourceTags(r.db.WithContext(ctx), ResourceTagTypeGameServer, id); err != nil {
+		return err
+	}
 
 	// Clear cache AFTER successful delete
 	if r.cache != nil {
@@ -430,6 +470,39 @@ func (r *GameServerRepository) GetAvailablePorts(ctx context.Context, basePort i
 	return ports, nil
 }
 
+// GetAvailablePortWithOffsets finds the first port from basePort that is free together with
+// port+offset for every offset, for games that listen on ports next to their game port.
+// It returns the port followed by the offset ports.
+func (r *GameServerRepository) GetAvailablePortWithOffsets(ctx context.Context, basePort int32, offsets []int32, excludeGameServerID string) ([]int32, error) {
+	if basePort < 1 {
+		basePort = 1
+	}
+
+	used, err := r.getUsedPortsSet(ctx, excludeGameServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	for candidate := basePort; candidate <= 65535; candidate++ {
+		ports := []int32{candidate}
+		for _, offset := range offsets {
+			ports = append(ports, candidate+offset)
+		}
+		free := true
+		for _, port := range ports {
+			if _, inUse := used[port]; inUse || port < 1 || port > 65535 {
+				free = false
+				break
+			}
+		}
+		if free {
+			return ports, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to allocate a port with %d adjacent port(s)", len(offsets))
+}
+
 // GetAvailablePort finds an available port starting from a base port
 func (r *GameServerRepository) GetAvailablePort(ctx context.Context, basePort int32) (int32, error) {
 	ports, err := r.GetAvailablePorts(ctx, basePort, 1, nil, "")
@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestGetAvailablePortWithOffsets(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t, &GameServer{})
+	repo := NewGameServerRepository(db, nil)
+
+	for _, server := range []*GameServer{
+		{ID: "gs-a", Port: 25565, ExtraPorts: "[]", EnvVars: "{}"},
+		{ID: "gs-b", Port: 25567, ExtraPorts: "[]", EnvVars: "{}"},
+	} {
+		if err := db.Create(server).Error; err != nil {
+			t.Fatalf("seed %s: %v", server.ID, err)
+		}
+	}
+
+	// 25566 is free but 25567 is not, so the pair moves past gs-b
+	ports, err := repo.GetAvailablePortWithOffsets(ctx, 25565, []int32{1}, "")
+	if err != nil {
+		t.Fatalf("GetAvailablePortWithOffsets() error = %v", err)
+	}
+	if !reflect.DeepEqual(ports, []int32{25568, 25569}) {
+		t.Fatalf("GetAvailablePortWithOffsets() = %v, want [25568 25569]", ports)
+	}
+
+	// Once stored as an extra port, the adjacent port is never handed to another server
+	if err := db.Create(&GameServer{ID: "gs-valheim", Port: 25568, ExtraPorts: "[25569]", EnvVars: "{}"}).Error; err != nil {
+		t.Fatalf("seed gs-valheim: %v", err)
+	}
+	ports, err = repo.GetAvailablePorts(ctx, 25568, 1, nil, "")
+	if err != nil {
+		t.Fatalf("GetAvailablePorts() error = %v", err)
+	}
+	if !reflect.DeepEqual(ports, []int32{25570}) {
+		t.Fatalf("GetAvailablePorts() = %v, want [25570]", ports)
+	}
+
+	// Without offsets it is the first free port
+	ports, err = repo.GetAvailablePortWithOffsets(ctx, 25565, nil, "")
+	if err != nil {
+		t.Fatalf("GetAvailablePortWithOffsets(nil) error = %v", err)
+	}
+	if !reflect.DeepEqual(ports, []int32{25566}) {
+		t.Fatalf("GetAvailablePortWithOffsets(nil) = %v, want [25566]", ports)
+	}
+}
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GameServerSettings holds per game server settings that are not part of its container configuration
+type GameServerSettings struct {
+	GameServerID string `gorm:"primaryKey;column:game_server_id" json:"game_server_id"`
+
+	// Idle shutdown: the server is stopped once fewer than MinPlayersToStayAlive players have been
+	// online for IdleTimeoutMinutes. 0 minutes disables it.
+	IdleTimeoutMinutes    int32      `gorm:"column:idle_timeout_minutes;default:0" json:"idle_timeout_minutes"`
+	MinPlayersToStayAlive int32      `gorm:"column:min_players_to_stay_alive;default:1" json:"min_players_to_stay_alive"`
+	LastPlayerSeenAt      *time.Time `gorm:"column:last_player_seen_at" json:"last_player_seen_at"` // Cleared when the server starts
+
+	UpdatedBy string    `gorm:"column:updated_by" json:"updated_by"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (GameServerSettings) TableName() string { return "game_server_settings" }
+
+// IdleShutdownEnabled reports whether the game server is stopped when idle
+func (s *GameServerSettings) IdleShutdownEnabled() bool {
+	return s.IdleTimeoutMinutes > 0
+}
+
+// GetGameServerSettings returns the settings of a game server, or defaults when it has none
+func GetGameServerSettings(ctx context.Context, gameServerID string) (*GameServerSettings, error) {
+	var settings GameServerSettings
+	err := DB.WithContext(ctx).Where("game_server_id = ?", gameServerID).First(&settings).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &GameServerSettings{GameServerID: gameServerID, MinPlayersToStayAlive: 1}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game server settings: %w", err)
+	}
+	return &settings, nil
+}
+
+// SaveGameServerIdleShutdown creates or updates the idle shutdown policy of a game server
+func SaveGameServerIdleShutdown(ctx context.Context, gameServerID string, idleTimeoutMinutes, minPlayersToStayAlive int32, updatedBy string) error {
+	now := time.Now()
+	settings := GameServerSettings{
+		GameServerID:          gameServerID,
+		IdleTimeoutMinutes:    idleTimeoutMinutes,
+		MinPlayersToStayAlive: minPlayersToStayAlive,
+		UpdatedBy:             updatedBy,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+	if err := DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "game_server_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"idle_timeout_minutes", "min_players_to_stay_alive", "updated_by", "updated_at"}),
+	}).Create(&settings).Error; err != nil {
+		return fmt.Errorf("failed to save game server idle shutdown policy: %w", err)
+	}
+	return nil
+}
+
+// TouchGameServerPlayerSeen records that enough players were online at seenAt
+// Servers without settings have no idle shutdown policy, so nothing is recorded for them.
+func TouchGameServerPlayerSeen(ctx context.Context, gameServerID string, seenAt time.Time) error {
+	if err := DB.WithContext(ctx).Model(&GameServerSettings{}).
+		Where("game_server_id = ? AND (last_player_seen_at IS NULL OR last_player_seen_at < ?)", gameServerID, seenAt).
+		UpdateColumn("last_player_seen_at", seenAt).Error; err != nil {
+		return fmt.Errorf("failed to record player activity: %w", err)
+	}
+	return nil
+}
+
+// ClearGameServerPlayerSeen forgets the last player activity, restarting the idle countdown on the next check
+func ClearGameServerPlayerSeen(ctx context.Context, gameServerID string) error {
+	if err := DB.WithContext(ctx).Model(&GameServerSettings{}).
+		Where("game_server_id = ? AND last_player_seen_at IS NOT NULL", gameServerID).
+		UpdateColumn("last_player_seen_at", nil).Error; err != nil {
+		return fmt.Errorf("failed to clear player activity: %w", err)
+	}
+	return nil
+}
@@ -70,6 +70,42 @@ func GetRecentGameServerMetrics(ctx context.Context, gameServerID string, since
 	return metrics, result.Error
 }
 
+// RecordGameServerTPS records a ticks-per-second sample for a game server
+func RecordGameServerTPS(ctx context.Context, gameServerID string, tps float64, timestamp time.Time) error {
+	targetDB := MetricsDB
+	if targetDB == nil {
+		targetDB = DB
+	}
+	return targetDB.WithContext(ctx).Create(&GameServerTPSMetric{
+		GameServerID: gameServerID,
+		TPS:          tps,
+		Timestamp:    timestamp,
+	}).Error
+}
+
+// GetGameServerTPS gets the TPS samples of a game server in a time range, oldest first
+func GetGameServerTPS(ctx context.Context, gameServerID string, start, end time.Time) ([]GameServerTPSMetric, error) {
+	var samples []GameServerTPSMetric
+	targetDB := MetricsDB
+	if targetDB == nil {
+		targetDB = DB
+	}
+	result := targetDB.WithContext(ctx).Where("game_server_id = ? AND timestamp >= ? AND timestamp <= ?", gameServerID, start, end).
+		Order("timestamp ASC").
+		Limit(10000).
+		Find(&samples)
+	return samples, result.Error
+}
+
+// CleanOldGameServerTPS removes TPS samples older than the cutoff
+func CleanOldGameServerTPS(ctx context.Context, cutoff time.Time) error {
+	targetDB := MetricsDB
+	if targetDB == nil {
+		targetDB = DB
+	}
+	return targetDB.WithContext(ctx).Where("timestamp < ?", cutoff).Delete(&GameServerTPSMetric{}).Error
+}
+
 // CleanOldGameServerMetrics removes metrics older than retention period
 func CleanOldGameServerMetrics(ctx context.Context, retentionDays int) error {
 	cutoff := time.Now().AddDate(0, 0, -retentionDays)
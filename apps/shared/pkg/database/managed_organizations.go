@@ -0,0 +1,62 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Managed organization errors
+var (
+	// ErrManagedOrganization is returned for billing changes a managed organization leaves to its parent
+	ErrManagedOrganization = errors.New("managed organizations are billed through their parent organization")
+	// ErrNestedManagedOrganization is returned when a managed organization tries to manage another one
+	ErrNestedManagedOrganization = errors.New("a managed organization cannot manage other organizations")
+)
+
+// ParentOrganizationID returns the parent of a managed organization, or "" for a regular or unknown organization
+func ParentOrganizationID(db *gorm.DB, orgID string) (string, error) {
+	var org Organization
+	err := db.Select("id", "parent_org_id").Where("id = ?", orgID).First(&org).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get organization: %w", err)
+	}
+	if org.ParentOrgID == nil {
+		return "", nil
+	}
+	return *org.ParentOrgID, nil
+}
+
+// ManagedOrganizationIDs returns the IDs of the organizations managed by parentID, oldest first
+func ManagedOrganizationIDs(db *gorm.DB, parentID string) ([]string, error) {
+	var ids []string
+	if err := db.Model(&Organization{}).
+		Where("parent_org_id = ?", parentID).
+		Order("created_at ASC, id ASC").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to list managed organizations: %w", err)
+	}
+	return ids, nil
+}
+
+// QuotaScope returns the organization whose plan and quota apply to orgID, and every organization
+// whose usage counts against that quota. A managed organization shares its parent's quota with the
+// parent and the parent's other managed organizations.
+func QuotaScope(db *gorm.DB, orgID string) (quotaOrgID string, orgIDs []string, err error) {
+	quotaOrgID, err = ParentOrganizationID(db, orgID)
+	if err != nil {
+		return "", nil, err
+	}
+	if quotaOrgID == "" {
+		quotaOrgID = orgID
+	}
+	managed, err := ManagedOrganizationIDs(db, quotaOrgID)
+	if err != nil {
+		return "", nil, err
+	}
+	return quotaOrgID, append([]string{quotaOrgID}, managed...), nil
+}
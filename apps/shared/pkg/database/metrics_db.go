@@ -164,6 +164,9 @@ func InitMetricsTables() error {
 	if !hypertableMap["game_server_usage_hourly"] {
 		tablesToMigrate = append(tablesToMigrate, &GameServerUsageHourly{})
 	}
+	if !hypertableMap["game_server_tps_metrics"] {
+		tablesToMigrate = append(tablesToMigrate, &GameServerTPSMetric{})
+	}
 	if !hypertableMap["build_logs"] {
 		tablesToMigrate = append(tablesToMigrate, &BuildLog{})
 	}
@@ -185,6 +188,12 @@ func InitMetricsTables() error {
 	if !hypertableMap["database_usage_hourly"] {
 		tablesToMigrate = append(tablesToMigrate, &DatabaseUsageHourly{})
 	}
+	if !hypertableMap["dns_query_logs"] {
+		tablesToMigrate = append(tablesToMigrate, &DNSQueryLog{})
+	}
+	if !hypertableMap["node_capacity_samples"] {
+		tablesToMigrate = append(tablesToMigrate, &NodeCapacitySample{})
+	}
 
 	if len(tablesToMigrate) > 0 {
 		if err := MetricsDB.AutoMigrate(tablesToMigrate...); err != nil {
@@ -205,6 +214,18 @@ func InitMetricsTables() error {
 		// Continue anyway - standard PostgreSQL will work fine
 	}
 
+	// Initialize TimescaleDB hypertable for dns_query_logs
+	if err := initDNSQueryLogsHypertable(MetricsDB); err != nil {
+		logger.Warn("Failed to initialize TimescaleDB hypertable for dns_query_logs: %v", err)
+		// Continue anyway - standard PostgreSQL will work fine
+	}
+
+	// Initialize TimescaleDB hypertable for node_capacity_samples
+	if err := initNodeCapacitySamplesHypertable(MetricsDB); err != nil {
+		logger.Warn("Failed to initialize TimescaleDB hypertable for node_capacity_samples: %v", err)
+		// Continue anyway - standard PostgreSQL will work fine
+	}
+
 	// Create composite indexes for better query performance
 	if err := createMetricsIndexes(); err != nil {
 		return fmt.Errorf("failed to create metrics indexes: %w", err)
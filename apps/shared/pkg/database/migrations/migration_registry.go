@@ -68,6 +68,9 @@ func RegisterDeploymentsMigrations(registry *MigrationRegistry) {
 	registry.Register("2026_05_03_001", "Add Dockerfile build args and volumes to deployments", addDockerfileBuildArgsAndVolumes)
 	registry.Register("2026_05_03_002", "Add GitHub App installation metadata to integrations", addGitHubAppInstallationMetadata)
 	registry.Register("2026_05_03_003", "Default GitHub integrations to app installs", defaultGitHubIntegrationsToApp)
+	registry.Register("2026_10_16_001", "Add encrypted env columns to deployments", addEncryptedEnvColumns)
+	registry.Register("2026_10_16_002", "Add pull request preview deployments", addPreviewDeployments)
+	registry.Register("2026_10_16_003", "Create deployment_versions table", createDeploymentVersionsTable)
 	registry.Register("2025_11_07_001", "Create deployment_metrics table", createDeploymentMetricsTable)
 	registry.Register("2025_11_07_002", "Create deployment_usage_hourly table", createDeploymentUsageHourlyTable)
 }
@@ -77,6 +80,7 @@ func RegisterDeploymentsMigrations(registry *MigrationRegistry) {
 // and should only be run by the organizations-service to avoid redundant execution.
 func RegisterOrganizationsMigrations(registry *MigrationRegistry) {
 	registry.Register("2025_01_07_001", "Migrate organization member roles from names to IDs", migrateOrganizationMemberRoles)
+	registry.Register("2026_10_16_004", "Add parent_org_id foreign key to organizations", addParentOrgIDToOrganizations)
 }
 
 // initialSchema creates the initial database schema
@@ -343,6 +347,103 @@ func addDockerfileBuildArgsAndVolumes(db *gorm.DB) error {
 	return nil
 }
 
+// addEncryptedEnvColumns adds the columns for env vars encrypted at rest
+// Existing plaintext values are encrypted by the deployments-service on startup (see secrets.MigratePlaintext)
+func addEncryptedEnvColumns(db *gorm.DB) error {
+	columns := []struct {
+		name string
+		sql  string
+	}{
+		{"encrypted_env", "ALTER TABLE deployments ADD COLUMN encrypted_env BYTEA"},
+		{"encrypted_env_file", "ALTER TABLE deployments ADD COLUMN encrypted_env_file BYTEA"},
+		{"env_key_id", "ALTER TABLE deployments ADD COLUMN env_key_id VARCHAR(255)"},
+	}
+	for _, column := range columns {
+		if db.Migrator().HasColumn("deployments", column.name) {
+			continue
+		}
+		if err := db.Exec(column.sql).Error; err != nil {
+			return err
+		}
+	}
+	if !db.Migrator().HasIndex("deployments", "idx_deployments_env_key_id") {
+		if err := db.Exec("CREATE INDEX idx_deployments_env_key_id ON deployments (env_key_id)").Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addPreviewDeployments adds deployments.is_preview and the preview_deployments table
+func addPreviewDeployments(db *gorm.DB) error {
+	if !db.Migrator().HasColumn("deployments", "is_preview") {
+		if err := db.Exec("ALTER TABLE deployments ADD COLUMN is_preview BOOLEAN NOT NULL DEFAULT FALSE").Error; err != nil {
+			return err
+		}
+	}
+	if err := db.Exec("CREATE INDEX IF NOT EXISTS idx_deployments_is_preview ON deployments (is_preview)").Error; err != nil {
+		return err
+	}
+	if db.Migrator().HasTable("preview_deployments") {
+		return nil
+	}
+	if err := db.Exec(`
+		CREATE TABLE preview_deployments (
+			id VARCHAR(255) PRIMARY KEY,
+			deployment_id VARCHAR(255) NOT NULL,
+			source_deployment_id VARCHAR(255),
+			organization_id VARCHAR(255) NOT NULL,
+			github_integration_id VARCHAR(255),
+			repository_full_name VARCHAR(255) NOT NULL,
+			pr_number INTEGER NOT NULL,
+			head_branch VARCHAR(255),
+			head_sha VARCHAR(64),
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			closed_at TIMESTAMP
+		)
+	`).Error; err != nil {
+		return err
+	}
+	for _, stmt := range []string{
+		"CREATE UNIQUE INDEX idx_preview_repo_pr ON preview_deployments (repository_full_name, pr_number)",
+		"CREATE INDEX idx_preview_deployments_deployment_id ON preview_deployments (deployment_id)",
+		"CREATE INDEX idx_preview_deployments_organization_id ON preview_deployments (organization_id)",
+		"CREATE INDEX idx_preview_deployments_closed_at ON preview_deployments (closed_at)",
+	} {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createDeploymentVersionsTable creates the rollback history of deployments
+func createDeploymentVersionsTable(db *gorm.DB) error {
+	if db.Migrator().HasTable("deployment_versions") {
+		return nil
+	}
+	if err := db.Exec(`
+		CREATE TABLE deployment_versions (
+			id VARCHAR(255) PRIMARY KEY,
+			deployment_id VARCHAR(255) NOT NULL,
+			version_number INTEGER NOT NULL,
+			image TEXT NOT NULL,
+			env_vars_hash VARCHAR(64),
+			env_vars JSONB DEFAULT '{}'::jsonb,
+			env_file_content TEXT,
+			encrypted_env BYTEA,
+			encrypted_env_file BYTEA,
+			env_key_id VARCHAR(255),
+			active BOOLEAN NOT NULL DEFAULT FALSE,
+			created_at TIMESTAMP NOT NULL
+		)
+	`).Error; err != nil {
+		return err
+	}
+	return db.Exec("CREATE UNIQUE INDEX idx_deployment_version ON deployment_versions (deployment_id, version_number)").Error
+}
+
 func addGitHubAppInstallationMetadata(db *gorm.DB) error {
 	columns := []struct {
 		name string
@@ -796,6 +897,28 @@ func migrateOrganizationMemberRoles(db *gorm.DB) error {
 	return nil
 }
 
+// addParentOrgIDToOrganizations adds organizations.parent_org_id, referencing the parent of a managed organization
+func addParentOrgIDToOrganizations(db *gorm.DB) error {
+	if !db.Migrator().HasColumn("organizations", "parent_org_id") {
+		if err := db.Exec("ALTER TABLE organizations ADD COLUMN parent_org_id TEXT").Error; err != nil {
+			return err
+		}
+	}
+	if !db.Migrator().HasIndex("organizations", "idx_organizations_parent_org_id") {
+		if err := db.Exec("CREATE INDEX idx_organizations_parent_org_id ON organizations (parent_org_id)").Error; err != nil {
+			return err
+		}
+	}
+	if !db.Migrator().HasConstraint("organizations", "fk_organizations_parent_org") {
+		// A parent can't be deleted while it still manages organizations
+		if err := db.Exec(`ALTER TABLE organizations ADD CONSTRAINT fk_organizations_parent_org
+			FOREIGN KEY (parent_org_id) REFERENCES organizations (id) ON DELETE RESTRICT`).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Template for creating a new migration:
 /*
 func yourNewMigration(db *gorm.DB) error {
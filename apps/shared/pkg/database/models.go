@@ -30,6 +30,7 @@ type Deployment struct {
 	NginxConfig         *string `gorm:"column:nginx_config;type:text" json:"nginx_config"`               // Custom nginx configuration (optional, uses default if empty)
 	GitHubIntegrationID *string `gorm:"column:github_integration_id;index" json:"github_integration_id"` // GitHub integration ID for autodeploys
 	AutoDeploy          *bool   `gorm:"column:auto_deploy;default:true" json:"auto_deploy"`              // Automatically deploy on GitHub push webhooks
+	IsPreview           bool    `gorm:"column:is_preview;default:false;index" json:"is_preview"`         // Ephemeral preview environment for a GitHub pull request (see PreviewDeployment)
 
 	// Health check configuration
 	HealthcheckType           *int32     `gorm:"column:healthcheck_type" json:"healthcheck_type"`                               // Type of health check (HealthCheckType enum)
@@ -37,6 +38,9 @@ type Deployment struct {
 	HealthcheckPath           *string    `gorm:"column:healthcheck_path" json:"healthcheck_path"`                               // HTTP path (default: "/", used with HEALTHCHECK_HTTP)
 	HealthcheckExpectedStatus *int32     `gorm:"column:healthcheck_expected_status" json:"healthcheck_expected_status"`         // Expected HTTP status code (default: 200)
 	HealthcheckCustomCommand  *string    `gorm:"column:healthcheck_custom_command;type:text" json:"healthcheck_custom_command"` // Custom command (sanitized)
+	HealthcheckInterval       *int32     `gorm:"column:healthcheck_interval_seconds" json:"healthcheck_interval_seconds"`       // HTTP probe interval in seconds; the health monitor only probes deployments with one set
+	HealthcheckTimeout        *int32     `gorm:"column:healthcheck_timeout_seconds" json:"healthcheck_timeout_seconds"`         // HTTP probe timeout in seconds
+	HealthcheckRetries        *int32     `gorm:"column:healthcheck_retries" json:"healthcheck_retries"`                         // Consecutive failed probes before health_status becomes unhealthy
 	Status                    int32      `gorm:"column:status;default:0" json:"status"`                                         // DeploymentStatus enum
 	HealthStatus              string     `gorm:"column:health_status" json:"health_status"`
 	Environment               int32      `gorm:"column:environment" json:"environment"`  // Environment enum
@@ -55,10 +59,15 @@ type Deployment struct {
 	Image                  *string `gorm:"column:image" json:"image"`
 	Port                   *int32  `gorm:"column:port" json:"port"`
 	Replicas               *int32  `gorm:"column:replicas" json:"replicas"`
+	ActiveColor            string  `gorm:"column:active_color;default:blue" json:"active_color"`                  // Color ("blue"/"green") of the containers serving traffic
+	ZeroDowntimeDeploy     bool    `gorm:"column:zero_downtime_deploy;default:false" json:"zero_downtime_deploy"` // Drain connections from the old containers on redeploys instead of stopping them at the cut-over
 	MemoryBytes            *int64  `gorm:"column:memory_bytes" json:"memory_bytes"`
 	CPUShares              *int64  `gorm:"column:cpu_shares" json:"cpu_shares"`
 	EnvVars                string  `gorm:"column:env_vars;type:jsonb" json:"env_vars"`                                 // Legacy: Stored as JSON object {"KEY": "value"} for backward compatibility
 	EnvFileContent         string  `gorm:"column:env_file_content;type:text" json:"env_file_content"`                  // Raw .env file content with comments
+	EncryptedEnv           []byte  `gorm:"column:encrypted_env" json:"encrypted_env,omitempty"`                        // env_vars sealed with the key env_key_id (see SetDeploymentEnvCipher)
+	EncryptedEnvFile       []byte  `gorm:"column:encrypted_env_file" json:"encrypted_env_file,omitempty"`              // env_file_content sealed with the key env_key_id
+	EnvKeyID               string  `gorm:"column:env_key_id;index" json:"env_key_id,omitempty"`                        // Encryption key of encrypted_env (empty while env vars are plaintext)
 	ComposeYaml            string  `gorm:"column:compose_yaml;type:text" json:"compose_yaml"`                          // Docker Compose YAML content
 	BuildArgs              string  `gorm:"column:build_args;type:jsonb" json:"build_args"`                             // Docker build args for Dockerfile deployments
 	DockerfileVolumes      string  `gorm:"column:dockerfile_volumes;type:jsonb" json:"dockerfile_volumes"`             // Persistent volume mounts for Dockerfile deployments
@@ -95,6 +104,7 @@ type OrganizationPlan struct {
 	MemoryBytes             int64  `json:"memory_bytes"`
 	DeploymentsMax          int    `json:"deployments_max"`
 	MaxVpsInstances         int    `gorm:"column:max_vps_instances;default:0" json:"max_vps_instances"` // Maximum VPS instances (0 = unlimited)
+	MaxReplicas             int    `gorm:"column:max_replicas;default:0" json:"max_replicas"`           // Maximum replicas per deployment (0 = unlimited)
 	BandwidthBytesMonth     int64  `json:"bandwidth_bytes_month"`
 	StorageBytes            int64  `json:"storage_bytes"`
 	MinimumPaymentCents     int64  `gorm:"column:minimum_payment_cents;default:0" json:"minimum_payment_cents"`           // Minimum payment in cents to automatically upgrade to this plan
@@ -115,10 +125,18 @@ type OrgQuota struct {
 	MaxVpsInstancesOverride     *int   `gorm:"column:max_vps_instances_override" json:"max_vps_instances_override"` // Override for max VPS instances (0 = unlimited)
 	BandwidthBytesMonthOverride *int64 `json:"bandwidth_bytes_month_override"`
 	StorageBytesOverride        *int64 `json:"storage_bytes_override"`
+	MaxSFTPBytesPerResource     *int64 `gorm:"column:max_sftp_bytes_per_resource" json:"max_sftp_bytes_per_resource"` // Daily SFTP upload limit per file transfer resource (nil or 0 = unlimited)
+	AllowNewResources           *bool  `gorm:"column:allow_new_resources;default:true" json:"allow_new_resources"`    // False while new resource creation is suspended for non-payment (nil = allowed)
+	ISOStorageBytesOverride     *int64 `gorm:"column:iso_storage_bytes_override" json:"iso_storage_bytes_override"`   // Storage for uploaded VPS install ISOs (nil or 0 = VPS_ISO_STORAGE_QUOTA_BYTES)
 }
 
 func (OrgQuota) TableName() string { return "org_quotas" }
 
+// NewResourcesAllowed reports whether the organization may create new resources
+func (q *OrgQuota) NewResourcesAllowed() bool {
+	return q.AllowNewResources == nil || *q.AllowNewResources
+}
+
 // MonthlyCreditGrant tracks monthly free credit grants for metrics and recovery
 type MonthlyCreditGrant struct {
 	ID             uint      `gorm:"primaryKey" json:"id"`
@@ -223,6 +241,12 @@ type Organization struct {
 	BannedAt          *time.Time `gorm:"column:banned_at" json:"banned_at"`
 	BanReason         *string    `gorm:"column:ban_reason" json:"ban_reason"`
 	BannedBy          *string    `gorm:"column:banned_by" json:"banned_by"`
+
+	// Set when a superadmin merged this organization into another one (status "merged")
+	MergedIntoID *string `gorm:"column:merged_into_id;index" json:"merged_into_id"`
+
+	// Set for managed organizations: the parent's plan and quota apply and charges roll up to its bill
+	ParentOrgID *string `gorm:"column:parent_org_id;index" json:"parent_org_id"`
 }
 
 func (Organization) TableName() string { return "organizations" }
@@ -265,21 +289,24 @@ type OrganizationMember struct {
 	Status           string     `json:"status"`
 	JoinedAt         time.Time  `json:"joined_at"`
 	LastInviteSentAt *time.Time `gorm:"column:last_invite_sent_at" json:"last_invite_sent_at"` // Tracks when invite email was last successfully sent (for rate limiting)
+	InviteExpiresAt  *time.Time `gorm:"column:invite_expires_at" json:"invite_expires_at"`     // Pending invites cannot be accepted after this time
 }
 
 func (OrganizationMember) TableName() string { return "organization_members" }
 
 // CreditTransaction tracks all credit additions and removals for audit and history
 type CreditTransaction struct {
-	ID             string    `gorm:"primaryKey" json:"id"`
-	OrganizationID string    `gorm:"index;not null" json:"organization_id"`
-	AmountCents    int64     `json:"amount_cents"`            // Positive for additions, negative for removals
-	BalanceAfter   int64     `json:"balance_after"`           // Credit balance after this transaction
-	Type           string    `json:"type"`                    // "payment", "admin_add", "admin_remove", "usage", "refund", etc.
-	Source         string    `json:"source"`                  // "stripe", "admin", "system", etc.
-	Note           *string   `json:"note"`                    // Optional note/reason
-	CreatedBy      *string   `gorm:"index" json:"created_by"` // User ID who initiated (nullable for system/automatic)
-	CreatedAt      time.Time `json:"created_at"`
+	ID             string     `gorm:"primaryKey" json:"id"`
+	OrganizationID string     `gorm:"index;not null" json:"organization_id"`
+	AmountCents    int64      `json:"amount_cents"`            // Positive for additions, negative for removals
+	BalanceAfter   int64      `json:"balance_after"`           // Credit balance after this transaction
+	Type           string     `json:"type"`                    // "payment", "admin_add", "admin_remove", "usage", "refund", "credit_expiry", "proration", etc.
+	Source         string     `json:"source"`                  // "stripe", "admin", "system", etc.
+	Note           *string    `json:"note"`                    // Optional note/reason
+	CreatedBy      *string    `gorm:"index" json:"created_by"` // User ID who initiated (nullable for system/automatic)
+	ExpiresAt      *time.Time `gorm:"index" json:"expires_at"` // When unused credits from this transaction expire (nil = never)
+	ExpiredAt      *time.Time `json:"expired_at"`              // When the unused remainder was expired (nil = not yet)
+	CreatedAt      time.Time  `json:"created_at"`
 }
 
 func (CreditTransaction) TableName() string { return "credit_transactions" }
@@ -309,8 +336,10 @@ type BillingAccount struct {
 	BillingEmail     *string   `gorm:"column:billing_email" json:"billing_email"`
 	CompanyName      *string   `gorm:"column:company_name" json:"company_name"`
 	TaxID            *string   `gorm:"column:tax_id" json:"tax_id"`
-	Address          *string   `gorm:"column:address;type:jsonb" json:"address"` // JSON-encoded address (nullable)
-	BillingDate      *int      `gorm:"column:billing_date" json:"billing_date"`  // Day of month (1-31) when billing occurs
+	VATNumber        *string   `gorm:"column:vat_number" json:"vat_number"`                  // EU VAT number (enables reverse charge for EU B2B customers)
+	Address          *string   `gorm:"column:address;type:jsonb" json:"address"`             // JSON-encoded address (nullable)
+	BillingDate      *int      `gorm:"column:billing_date" json:"billing_date"`              // Day of month (1-31) when billing occurs
+	Currency         string    `gorm:"column:currency;not null;default:USD" json:"currency"` // Preferred display currency (ISO 4217); prices are stored in USD
 	CreatedAt        time.Time `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt        time.Time `gorm:"column:updated_at" json:"updated_at"`
 }
@@ -327,6 +356,9 @@ type MonthlyBill struct {
 	Status             string     `gorm:"index;default:PENDING" json:"status"`        // "PENDING", "PAID", "FAILED", "CANCELLED"
 	PaidAt             *time.Time `gorm:"column:paid_at" json:"paid_at"`              // When the bill was paid
 	DueDate            time.Time  `gorm:"index;not null" json:"due_date"`             // When payment is due
+	// Amount in the organization's preferred currency at the exchange rate when the bill was created
+	DisplayCurrency    string `gorm:"column:display_currency;not null;default:USD" json:"display_currency"`
+	DisplayAmountCents int64  `gorm:"column:display_amount_cents;not null;default:0" json:"display_amount_cents"`
 	// Usage breakdown (stored as JSON for flexibility)
 	UsageBreakdown string    `gorm:"column:usage_breakdown;type:jsonb" json:"usage_breakdown"` // JSON with CPU, Memory, Bandwidth, Storage costs
 	Note           *string   `gorm:"column:note;type:text" json:"note"`                        // Optional note
@@ -336,6 +368,270 @@ type MonthlyBill struct {
 
 func (MonthlyBill) TableName() string { return "monthly_bills" }
 
+// BillingRateConfig is a per-resource rate used for hourly usage metering
+// A resource type without a rate is not metered and stays on the monthly bill
+type BillingRateConfig struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	ResourceType string    `gorm:"uniqueIndex;not null" json:"resource_type"` // "cpu", "memory"
+	Unit         string    `gorm:"not null" json:"unit"`                      // "core_hour" (cpu), "gb_hour" (memory)
+	PricePerUnit float64   `gorm:"not null" json:"price_per_unit"`            // Price in dollars per unit
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func (BillingRateConfig) TableName() string { return "billing_rate_configs" }
+
+// MeteredUsageRecord is one hour of metered CPU and memory usage for a deployment or VPS
+// The (organization_id, resource_id, hour) unique index makes metering re-runs idempotent
+type MeteredUsageRecord struct {
+	ID                uint       `gorm:"primaryKey" json:"id"`
+	OrganizationID    string     `gorm:"not null;uniqueIndex:idx_metered_usage_org_resource_hour,priority:1" json:"organization_id"`
+	ResourceID        string     `gorm:"not null;uniqueIndex:idx_metered_usage_org_resource_hour,priority:2" json:"resource_id"`
+	Hour              time.Time  `gorm:"not null;uniqueIndex:idx_metered_usage_org_resource_hour,priority:3" json:"hour"`
+	ResourceType      string     `gorm:"not null" json:"resource_type"` // "deployment", "vps"
+	CPUCoreSeconds    int64      `json:"cpu_core_seconds"`              // Metered CPU (0 if CPU is not metered)
+	MemoryByteSeconds int64      `json:"memory_byte_seconds"`           // Metered memory (0 if memory is not metered)
+	CostCents         float64    `gorm:"not null" json:"cost_cents"`    // Fractional cents; charged in whole cents per organization
+	ChargedAt         *time.Time `gorm:"index" json:"charged_at"`       // When the cost was added to the organization's metering balance (nil = not yet)
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+func (MeteredUsageRecord) TableName() string { return "metered_usage_records" }
+
+// MeteredUsageBalance is an organization's metered cost that has not been paid yet:
+// sub-cent remainders and usage its credits could not cover. Unpaid whole cents are
+// invoiced on the next monthly bill.
+type MeteredUsageBalance struct {
+	OrganizationID   string    `gorm:"primaryKey" json:"organization_id"`
+	OutstandingCents float64   `gorm:"not null;default:0" json:"outstanding_cents"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+func (MeteredUsageBalance) TableName() string { return "metered_usage_balances" }
+
+// Invoice is a generated monthly invoice PDF for an organization
+type Invoice struct {
+	ID             string     `gorm:"primaryKey" json:"id"`
+	OrganizationID string     `gorm:"not null;uniqueIndex:idx_invoice_org_month,priority:1" json:"organization_id"`
+	Month          time.Time  `gorm:"not null;uniqueIndex:idx_invoice_org_month,priority:2" json:"month"` // First day of the invoiced month (UTC)
+	PDFBytes       []byte     `gorm:"column:pdf_bytes;not null" json:"-"`
+	SentAt         *time.Time `gorm:"column:sent_at" json:"sent_at"` // When the invoice was emailed (nil = not sent yet)
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+func (Invoice) TableName() string { return "invoices" }
+
+// TaxRecord archives a Stripe Tax calculation for a charge and the tax transaction recorded once it was paid
+type TaxRecord struct {
+	ID             string    `gorm:"primaryKey" json:"id"`
+	OrganizationID string    `gorm:"index;not null" json:"organization_id"`
+	CalculationID  string    `gorm:"column:tax_calculation_id;uniqueIndex;not null" json:"tax_calculation_id"` // Stripe Tax calculation ID
+	TransactionID  *string   `gorm:"column:tax_transaction_id;index" json:"tax_transaction_id"`                // Stripe Tax transaction ID (nil until the charge is paid)
+	Reference      *string   `gorm:"column:reference" json:"reference"`                                        // Checkout session or invoice ID the tax was collected on
+	AmountCents    int64     `gorm:"not null" json:"amount_cents"`                                             // Amount before tax
+	TaxAmountCents int64     `gorm:"not null" json:"tax_amount_cents"`
+	Currency       string    `gorm:"not null" json:"currency"`
+	ReverseCharge  bool      `gorm:"not null;default:false" json:"reverse_charge"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func (TaxRecord) TableName() string { return "tax_records" }
+
+// Dunning stages, in the order a failing invoice escalates through them
+const (
+	DunningStageWarning                   = "warning"                     // Attempt 1: payment failure emailed
+	DunningStageResourceCreationSuspended = "resource_creation_suspended" // Attempt 2: new resources blocked
+	DunningStageResourcesSuspended        = "resources_suspended"         // Attempt 3: organization suspended and workloads stopped
+	DunningStageMarkedForDeletion         = "marked_for_deletion"         // Attempt 4: account queued for deletion
+)
+
+// BillingDunningState tracks how far an organization's failed invoice has escalated
+// through the dunning process. The row is removed once the state is reset.
+type BillingDunningState struct {
+	OrganizationID              string     `gorm:"primaryKey" json:"organization_id"`
+	InvoiceID                   string     `gorm:"column:invoice_id;index;not null" json:"invoice_id"` // Stripe invoice that failed
+	AttemptCount                int32      `gorm:"column:attempt_count;not null" json:"attempt_count"` // Stripe payment attempt that failed most recently
+	Stage                       string     `gorm:"column:stage;not null" json:"stage"`
+	WarningSentAt               *time.Time `gorm:"column:warning_sent_at" json:"warning_sent_at"`
+	ResourceCreationSuspendedAt *time.Time `gorm:"column:resource_creation_suspended_at" json:"resource_creation_suspended_at"`
+	ResourcesSuspendedAt        *time.Time `gorm:"column:resources_suspended_at" json:"resources_suspended_at"`
+	MarkedForDeletionAt         *time.Time `gorm:"column:marked_for_deletion_at" json:"marked_for_deletion_at"`
+	LastFailedAt                time.Time  `gorm:"column:last_failed_at;not null" json:"last_failed_at"`
+	CreatedAt                   time.Time  `json:"created_at"`
+	UpdatedAt                   time.Time  `json:"updated_at"`
+}
+
+func (BillingDunningState) TableName() string { return "billing_dunning_state" }
+
+// PaymentMethod is a card attached to an organization's Stripe customer, stored for display and
+// to warn about cards that are about to expire
+type PaymentMethod struct {
+	ID               string     `gorm:"primaryKey" json:"id"` // Stripe payment method ID
+	OrganizationID   string     `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	StripeCustomerID string     `gorm:"column:stripe_customer_id;not null" json:"stripe_customer_id"`
+	Type             string     `gorm:"column:type;not null" json:"type"`
+	Brand            string     `gorm:"column:brand" json:"brand"`
+	Last4            string     `gorm:"column:last4" json:"last4"`
+	ExpMonth         int32      `gorm:"column:exp_month" json:"exp_month"`
+	ExpYear          int32      `gorm:"column:exp_year" json:"exp_year"`
+	IsDefault        bool       `gorm:"column:is_default;not null;default:false" json:"is_default"`
+	ExpiryNotifiedAt *time.Time `gorm:"column:expiry_notified_at" json:"expiry_notified_at"` // When the organization was warned the card expires soon
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+func (PaymentMethod) TableName() string { return "payment_methods" }
+
+// Spend alert types: what happens when an organization's monthly spend reaches the threshold
+const (
+	SpendAlertTypeEmail      = "email"
+	SpendAlertTypeSuspendNew = "suspend_new"
+	SpendAlertTypeSuspendAll = "suspend_all"
+)
+
+// SpendAlert is an organization's budget threshold on its spend in the current month.
+// NotifiedAt is set when the alert fires and cleared at the start of each month so it fires again.
+type SpendAlert struct {
+	ID             string     `gorm:"primaryKey" json:"id"`
+	OrganizationID string     `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	ThresholdCents int64      `gorm:"column:threshold_cents;not null" json:"threshold_cents"`
+	AlertType      string     `gorm:"column:alert_type;not null" json:"alert_type"` // email, suspend_new or suspend_all
+	NotifiedAt     *time.Time `gorm:"column:notified_at" json:"notified_at"`
+	CreatedBy      string     `gorm:"column:created_by" json:"created_by"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+func (SpendAlert) TableName() string { return "spend_alerts" }
+
+// Tax-exempt certificate types
+const (
+	TaxExemptTypeNonprofit  = "nonprofit"
+	TaxExemptTypeEducation  = "edu"
+	TaxExemptTypeGovernment = "gov"
+)
+
+// Tax-exempt certificate statuses
+const (
+	TaxExemptStatusPending  = "pending"  // Submitted, awaiting superadmin verification
+	TaxExemptStatusVerified = "verified" // The organization is not charged tax
+	TaxExemptStatusRevoked  = "revoked"
+	TaxExemptStatusExpired  = "expired" // Past valid_until; the exemption was lifted
+)
+
+// TaxExemptCertificate is a certificate an organization submits to be exempted from tax.
+// Only a verified certificate within its validity period exempts the organization.
+type TaxExemptCertificate struct {
+	ID                string     `gorm:"primaryKey" json:"id"`
+	OrganizationID    string     `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	CertificateType   string     `gorm:"column:certificate_type;not null" json:"certificate_type"` // nonprofit, edu or gov
+	CertificateNumber string     `gorm:"column:certificate_number;not null" json:"certificate_number"`
+	IssuingAuthority  string     `gorm:"column:issuing_authority;not null" json:"issuing_authority"`
+	ValidFrom         time.Time  `gorm:"column:valid_from;not null" json:"valid_from"`
+	ValidUntil        time.Time  `gorm:"column:valid_until;not null;index" json:"valid_until"`
+	Status            string     `gorm:"column:status;not null;default:'pending';index" json:"status"`
+	SubmittedBy       string     `gorm:"column:submitted_by" json:"submitted_by"`
+	VerifiedBy        *string    `gorm:"column:verified_by" json:"verified_by"`
+	VerifiedAt        *time.Time `gorm:"column:verified_at" json:"verified_at"`
+	RevokedBy         *string    `gorm:"column:revoked_by" json:"revoked_by"`
+	RevokedAt         *time.Time `gorm:"column:revoked_at" json:"revoked_at"`
+	ExpiryNotifiedAt  *time.Time `gorm:"column:expiry_notified_at" json:"expiry_notified_at"` // When the billing contact was warned that the certificate expires soon
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+func (TaxExemptCertificate) TableName() string { return "tax_exempt_certificates" }
+
+// ReferralCode is a code a user shares so that new users who sign up with it earn both of them credits
+type ReferralCode struct {
+	ID        string    `gorm:"primaryKey" json:"id"`
+	UserID    string    `gorm:"column:user_id;index;not null" json:"user_id"`
+	Code      string    `gorm:"column:code;uniqueIndex;not null" json:"code"`
+	MaxUses   int       `gorm:"column:max_uses;not null;default:0" json:"max_uses"` // 0 = unlimited
+	UsesCount int       `gorm:"column:uses_count;not null;default:0" json:"uses_count"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (ReferralCode) TableName() string { return "referral_codes" }
+
+// ReferralUse records a referral code redemption and the credits it granted, for audit
+type ReferralUse struct {
+	ID                  string    `gorm:"primaryKey" json:"id"`
+	ReferrerID          string    `gorm:"column:referrer_id;index;not null" json:"referrer_id"`
+	RefereeID           string    `gorm:"column:referee_id;uniqueIndex;not null" json:"referee_id"` // A user can only be referred once
+	Code                string    `gorm:"column:code;index;not null" json:"code"`
+	ReferrerCreditCents int64     `gorm:"column:referrer_credit_cents;not null" json:"referrer_credit_cents"` // Less than the signup credit once the referrer reaches the monthly cap
+	RefereeCreditCents  int64     `gorm:"column:referee_credit_cents;not null" json:"referee_credit_cents"`
+	CreditedAt          time.Time `gorm:"column:credited_at;index;not null" json:"credited_at"`
+}
+
+func (ReferralUse) TableName() string { return "referral_uses" }
+
+// Currency is a currency prices can be displayed and charged in, with its exchange rate against
+// USD. All prices are stored in USD; the billing service refreshes the rates periodically.
+type Currency struct {
+	Code              string    `gorm:"primaryKey;size:3" json:"code"` // ISO 4217, e.g. "EUR"
+	Symbol            string    `gorm:"column:symbol;not null" json:"symbol"`
+	ExchangeRateToUSD float64   `gorm:"column:exchange_rate_to_usd;not null" json:"exchange_rate_to_usd"` // Units of this currency per US dollar
+	UpdatedAt         time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (Currency) TableName() string { return "currencies" }
+
+// EnvEncryptionKey is an organization's data key for deployment environment variables
+// The key is stored wrapped (encrypted) with the service's master key; retired keys are kept to read old backups
+type EnvEncryptionKey struct {
+	ID             string     `gorm:"primaryKey" json:"id"`
+	OrganizationID string     `gorm:"index;not null" json:"organization_id"`
+	WrappedKey     []byte     `gorm:"column:wrapped_key;not null" json:"-"`
+	CreatedAt      time.Time  `json:"created_at"`
+	RetiredAt      *time.Time `gorm:"index" json:"retired_at"` // Set when the key is rotated out
+}
+
+func (EnvEncryptionKey) TableName() string { return "env_encryption_keys" }
+
+// DeploymentVersion is a deployed image and environment that a deployment can be rolled back to
+// Image is pinned to the image digest so later builds, which reuse the tag, don't change it. The env
+// columns are stored the way the deployment stored them: sealed when env encryption is enabled.
+type DeploymentVersion struct {
+	ID               string    `gorm:"primaryKey" json:"id"`
+	DeploymentID     string    `gorm:"uniqueIndex:idx_deployment_version;not null" json:"deployment_id"`
+	VersionNumber    int32     `gorm:"uniqueIndex:idx_deployment_version;not null" json:"version_number"`
+	Image            string    `gorm:"not null" json:"image"`
+	EnvVarsHash      string    `gorm:"column:env_vars_hash" json:"env_vars_hash"` // SHA-256 of the plaintext env vars and env file
+	EnvVars          string    `gorm:"column:env_vars;type:jsonb" json:"-"`
+	EnvFileContent   string    `gorm:"column:env_file_content;type:text" json:"-"`
+	EncryptedEnv     []byte    `gorm:"column:encrypted_env" json:"-"`
+	EncryptedEnvFile []byte    `gorm:"column:encrypted_env_file" json:"-"`
+	EnvKeyID         string    `gorm:"column:env_key_id" json:"-"`
+	Active           bool      `gorm:"default:false" json:"active"` // Version the deployment currently runs
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+func (DeploymentVersion) TableName() string { return "deployment_versions" }
+
+// PreviewDeployment links a preview deployment to the GitHub pull request it was created for
+// The deployment is deleted when the pull request is closed or merged; ClosedAt is set first so
+// PurgeStalePreviewDeployments can finish the cleanup if the deletion fails.
+type PreviewDeployment struct {
+	ID                  string     `gorm:"primaryKey" json:"id"`
+	DeploymentID        string     `gorm:"index;not null" json:"deployment_id"`
+	SourceDeploymentID  string     `gorm:"index" json:"source_deployment_id"` // Deployment whose configuration the preview was cloned from
+	OrganizationID      string     `gorm:"index;not null" json:"organization_id"`
+	GitHubIntegrationID string     `gorm:"column:github_integration_id;index" json:"github_integration_id"`
+	RepositoryFullName  string     `gorm:"uniqueIndex:idx_preview_repo_pr;not null" json:"repository_full_name"` // owner/repo, lower case
+	PRNumber            int        `gorm:"column:pr_number;uniqueIndex:idx_preview_repo_pr;not null" json:"pr_number"`
+	HeadBranch          string     `json:"head_branch"`
+	HeadSHA             string     `gorm:"column:head_sha" json:"head_sha"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	ClosedAt            *time.Time `gorm:"index" json:"closed_at"` // Set when the pull request is closed or merged
+}
+
+func (PreviewDeployment) TableName() string { return "preview_deployments" }
+
 // GitHubIntegration stores GitHub App installations for Obiente workspaces.
 type GitHubIntegration struct {
 	ID                      string     `gorm:"primaryKey" json:"id"`
@@ -356,6 +652,26 @@ type GitHubIntegration struct {
 
 func (GitHubIntegration) TableName() string { return "github_integrations" }
 
+// GitHubRepositoryCredential holds the deploy key and repository webhook secret Obiente manages for a
+// repository deployed through a GitHub integration. The private key and secrets are encrypted with the master key.
+type GitHubRepositoryCredential struct {
+	ID                     string     `gorm:"primaryKey" json:"id"`
+	IntegrationID          string     `gorm:"column:integration_id;not null;uniqueIndex:idx_github_repo_credential" json:"integration_id"`
+	Repository             string     `gorm:"column:repository;not null;uniqueIndex:idx_github_repo_credential" json:"repository"` // owner/repo, lower case
+	DeployKeyID            *int64     `gorm:"column:deploy_key_id" json:"deploy_key_id"`                                           // GitHub ID of the current deploy key
+	DeployPublicKey        string     `gorm:"column:deploy_public_key;type:text" json:"deploy_public_key"`
+	EncryptedDeployKey     []byte     `gorm:"column:encrypted_deploy_key" json:"-"` // PEM private key
+	DeployKeyRotatedAt     *time.Time `gorm:"column:deploy_key_rotated_at;index" json:"deploy_key_rotated_at"`
+	WebhookID              *int64     `gorm:"column:webhook_id" json:"webhook_id"`
+	EncryptedWebhookSecret []byte     `gorm:"column:encrypted_webhook_secret" json:"-"`
+	EncryptedPrevSecret    []byte     `gorm:"column:encrypted_prev_webhook_secret" json:"-"` // Accepted for deliveries signed before the last rotation
+	WebhookRotatedAt       *time.Time `gorm:"column:webhook_rotated_at;index" json:"webhook_rotated_at"`
+	CreatedAt              time.Time  `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt              time.Time  `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (GitHubRepositoryCredential) TableName() string { return "github_repository_credentials" }
+
 // BuildHistory stores historical build information for deployments
 type BuildHistory struct {
 	ID             string     `gorm:"primaryKey" json:"id"`
@@ -384,6 +700,8 @@ type BuildHistory struct {
 	ComposeYaml *string `gorm:"column:compose_yaml;type:text" json:"compose_yaml"` // Docker Compose YAML (for compose deployments)
 	Size        *string `gorm:"column:size" json:"size"`                           // Human-readable bundle size
 	Error       *string `gorm:"column:error;type:text" json:"error"`               // Error message if build failed
+	// Dockerfile lint warnings as a JSON array of {rule, line, message}
+	LintWarnings *string `gorm:"column:lint_warnings;type:text" json:"lint_warnings"`
 
 	// Build logs stored separately in build_logs table (linked by build_id)
 	CreatedAt time.Time `json:"created_at"`
@@ -459,6 +777,14 @@ type GameServer struct {
 	// Game-specific configuration
 	ServerVersion *string `gorm:"column:server_version" json:"server_version"`
 
+	// RCON (remote console), nil for game types without Source RCON
+	RCONPort     *int32  `gorm:"column:rcon_port" json:"rcon_port"`
+	RCONPassword *string `gorm:"column:rcon_password" json:"rcon_password"` // Encrypted at rest (also in the Redis cache)
+
+	// Backups to S3-compatible storage
+	BackupSchedule  *string `gorm:"column:backup_schedule" json:"backup_schedule"`             // Interval between automatic backups (e.g. "6h"), nil disables them
+	BackupRetention int32   `gorm:"column:backup_retention;default:7" json:"backup_retention"` // Number of backups to keep
+
 	// Container information
 	ContainerID   *string `gorm:"column:container_id" json:"container_id"`
 	ContainerName *string `gorm:"column:container_name" json:"container_name"`
@@ -482,6 +808,33 @@ type GameServer struct {
 	CreatedBy      string `gorm:"column:created_by;index" json:"created_by"`
 }
 
+// GameServerMod records a mod installed on a game server from a mod catalog
+type GameServerMod struct {
+	ID           string    `gorm:"primaryKey;column:id" json:"id"`
+	GameServerID string    `gorm:"column:game_server_id;uniqueIndex:idx_game_server_mod" json:"game_server_id"`
+	Source       string    `gorm:"column:source;uniqueIndex:idx_game_server_mod" json:"source"` // modrinth, curseforge
+	ModID        string    `gorm:"column:mod_id;uniqueIndex:idx_game_server_mod" json:"mod_id"`
+	Version      string    `gorm:"column:version" json:"version"` // Modrinth version ID or CurseForge file ID
+	Filename     string    `gorm:"column:filename" json:"filename"`
+	InstalledAt  time.Time `gorm:"column:installed_at" json:"installed_at"`
+}
+
+func (GameServerMod) TableName() string { return "game_server_mods" }
+
+// GameServerBackup records an archive of a game server's data directory in backup storage
+type GameServerBackup struct {
+	ID           string     `gorm:"primaryKey;column:id" json:"id"`
+	GameServerID string     `gorm:"column:game_server_id;index;not null" json:"game_server_id"`
+	ObjectKey    string     `gorm:"column:object_key" json:"object_key"`
+	SizeBytes    int64      `gorm:"column:size_bytes;default:0" json:"size_bytes"`
+	Status       string     `gorm:"column:status;index" json:"status"` // in_progress, completed, failed
+	ErrorMessage *string    `gorm:"column:error_message;type:text" json:"error_message"`
+	CreatedAt    time.Time  `gorm:"column:created_at" json:"created_at"`
+	CompletedAt  *time.Time `gorm:"column:completed_at" json:"completed_at"`
+}
+
+func (GameServerBackup) TableName() string { return "game_server_backups" }
+
 func (GameServer) TableName() string {
 	return "game_servers"
 }
@@ -562,7 +915,8 @@ type VPSInstance struct {
 	CreatedAt     time.Time  `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt     time.Time  `gorm:"column:updated_at" json:"updated_at"`
 	LastStartedAt *time.Time `gorm:"column:last_started_at" json:"last_started_at"`
-	DeletedAt     *time.Time `gorm:"column:deleted_at;index" json:"deleted_at"` // Soft delete
+	LastPatchedAt *time.Time `gorm:"column:last_patched_at;index" json:"last_patched_at"` // Last successful OS security patch run
+	DeletedAt     *time.Time `gorm:"column:deleted_at;index" json:"deleted_at"`           // Soft delete
 
 	// Organization and ownership
 	OrganizationID string `gorm:"column:organization_id;index" json:"organization_id"`
@@ -573,6 +927,22 @@ func (VPSInstance) TableName() string {
 	return "vps_instances"
 }
 
+// VPSPowerEvent records a power action (start, stop, reboot, force stop) requested for a VPS
+type VPSPowerEvent struct {
+	ID          string     `gorm:"primaryKey;column:id" json:"id"`
+	VPSID       string     `gorm:"column:vps_id;index;not null" json:"vps_id"`
+	Action      string     `gorm:"column:action;not null" json:"action"`  // "start", "stop", "reboot", "force_stop"
+	ActorID     string     `gorm:"column:actor_id;index" json:"actor_id"` // User who requested the action
+	TaskID      string     `gorm:"column:task_id" json:"task_id"`         // Proxmox task UPID
+	RequestedAt time.Time  `gorm:"column:requested_at;index" json:"requested_at"`
+	CompletedAt *time.Time `gorm:"column:completed_at" json:"completed_at"`     // Nil while the task runs or when it timed out
+	Result      string     `gorm:"column:result;default:pending" json:"result"` // "pending", "ok", "timeout" or "failed: <reason>"
+}
+
+func (VPSPowerEvent) TableName() string {
+	return "vps_power_events"
+}
+
 // VPSPublicIP represents a public IP address that can be assigned to a VPS instance
 type VPSPublicIP struct {
 	ID               string     `gorm:"primaryKey;column:id" json:"id"`
@@ -720,6 +1090,16 @@ type GameServerMetrics struct {
 
 func (GameServerMetrics) TableName() string { return "game_server_metrics" }
 
+// GameServerTPSMetric stores ticks per second sampled from Minecraft servers over RCON
+type GameServerTPSMetric struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	GameServerID string    `gorm:"index;not null" json:"game_server_id"`
+	TPS          float64   `gorm:"column:tps" json:"tps"`
+	Timestamp    time.Time `gorm:"index" json:"timestamp"`
+}
+
+func (GameServerTPSMetric) TableName() string { return "game_server_tps_metrics" }
+
 // DatabaseMetrics stores historical metrics for managed database instances
 type DatabaseMetrics struct {
 	ID             uint      `gorm:"primaryKey" json:"id"`
@@ -842,6 +1222,8 @@ type AuditLog struct {
 	ResponseStatus int32     `gorm:"column:response_status" json:"response_status"`       // HTTP/Connect status code
 	ErrorMessage   *string   `gorm:"column:error_message;type:text" json:"error_message"` // Error message if action failed
 	DurationMs     int64     `gorm:"column:duration_ms" json:"duration_ms"`               // Request duration in milliseconds
+	AuditDiff      *string   `gorm:"column:audit_diff;type:jsonb" json:"audit_diff"`      // Field-level changes for update actions (nullable)
+	Metadata       *string   `gorm:"column:metadata;type:jsonb" json:"metadata"`          // Extra context added after the fact, e.g. source_org_id for entries moved by an organization merge
 	CreatedAt      time.Time `gorm:"column:created_at;index" json:"created_at"`
 }
 
@@ -903,6 +1285,91 @@ func (VPSBastionKey) TableName() string {
 	return "vps_bastion_keys"
 }
 
+// ProxmoxCredentialID is the ID of the single proxmox_credentials row
+const ProxmoxCredentialID = "proxmox"
+
+// ProxmoxCredential holds the Proxmox API token set by RotateProxmoxToken, which replaces
+// PROXMOX_TOKEN_ID / PROXMOX_TOKEN_SECRET. Tokens (USER@REALM!TOKENID=SECRET) are encrypted with the token cipher.
+type ProxmoxCredential struct {
+	ID                    string     `gorm:"primaryKey;column:id" json:"id"`
+	EncryptedPrimaryToken string     `gorm:"column:encrypted_primary_token;type:text;not null" json:"-"`
+	EncryptedStandbyToken string     `gorm:"column:encrypted_standby_token;type:text" json:"-"` // The replaced token, tried until StandbyRetiresAt
+	StandbyRetiresAt      *time.Time `gorm:"column:standby_retires_at" json:"standby_retires_at"`
+	RotatedBy             string     `gorm:"column:rotated_by" json:"rotated_by"`
+	RotatedAt             *time.Time `gorm:"column:rotated_at" json:"rotated_at"`
+	UpdatedAt             time.Time  `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (ProxmoxCredential) TableName() string {
+	return "proxmox_credentials"
+}
+
+// VPSFirewallRule mirrors a Proxmox VM firewall rule so rules can be listed without a Proxmox round-trip
+// Values are stored in Proxmox's own representation (e.g. action "ACCEPT", type "in", protocol "tcp")
+// Proxmox remains the source of truth; the mirror is rewritten after every rule change
+type VPSFirewallRule struct {
+	ID             uint      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	VPSID          string    `gorm:"column:vps_id;not null;uniqueIndex:idx_vps_firewall_rules_vps_pos" json:"vps_id"`
+	OrganizationID string    `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	Pos            int       `gorm:"column:pos;not null;uniqueIndex:idx_vps_firewall_rules_vps_pos" json:"pos"`
+	Enable         bool      `gorm:"column:enable;default:true" json:"enable"`
+	Action         string    `gorm:"column:action;not null" json:"action"` // ACCEPT, REJECT, DROP
+	Type           string    `gorm:"column:type;not null" json:"type"`     // in, out
+	Comment        string    `gorm:"column:comment" json:"comment,omitempty"`
+	Source         string    `gorm:"column:source" json:"source,omitempty"`
+	Dest           string    `gorm:"column:dest" json:"dest,omitempty"`
+	Iface          string    `gorm:"column:iface" json:"iface,omitempty"`
+	MacSource      string    `gorm:"column:mac_source" json:"mac_source,omitempty"`
+	Protocol       string    `gorm:"column:protocol" json:"protocol,omitempty"` // tcp, udp, icmp, icmpv6, all
+	Dport          string    `gorm:"column:dport" json:"dport,omitempty"`
+	Sport          string    `gorm:"column:sport" json:"sport,omitempty"`
+	IcmpType       *int      `gorm:"column:icmp_type" json:"icmp_type,omitempty"`
+	Log            *bool     `gorm:"column:log" json:"log,omitempty"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (VPSFirewallRule) TableName() string {
+	return "vps_firewall_rules"
+}
+
+// VPSCloudInitTemplate stores an organization's reusable cloud-init userData template
+// Content is a Go text/template rendered at VPS creation time (e.g. {{.Hostname}}, {{.SSHKeys}})
+type VPSCloudInitTemplate struct {
+	ID             string    `gorm:"primaryKey;column:id" json:"id"`
+	OrganizationID string    `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	Name           string    `gorm:"column:name;not null" json:"name"`
+	Content        string    `gorm:"column:content;type:text;not null" json:"content"`
+	CreatedBy      string    `gorm:"column:created_by" json:"created_by"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (VPSCloudInitTemplate) TableName() string {
+	return "vps_cloudinit_templates"
+}
+
+// VPSTemplate records a VM converted into a Proxmox template (golden image)
+// New VPS instances of the owning organization can be cloned from it
+type VPSTemplate struct {
+	ID                string    `gorm:"primaryKey;column:id" json:"id"`
+	OrganizationID    string    `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	ProxmoxTemplateID int       `gorm:"column:proxmox_template_id;not null" json:"proxmox_template_id"` // VMID of the template on NodeName
+	NodeName          string    `gorm:"column:node_name;not null" json:"node_name"`
+	Name              string    `gorm:"column:name;not null" json:"name"`
+	Description       *string   `gorm:"column:description" json:"description"`
+	SourceVPSID       string    `gorm:"column:source_vps_id;index" json:"source_vps_id"`
+	Region            string    `gorm:"column:region" json:"region"`
+	Image             int32     `gorm:"column:image" json:"image"`       // VPSImage enum of the source VPS
+	ImageID           *string   `gorm:"column:image_id" json:"image_id"` // Custom image ID of the source VPS
+	CreatedBy         string    `gorm:"column:created_by" json:"created_by"`
+	CreatedAt         time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (VPSTemplate) TableName() string {
+	return "vps_templates"
+}
+
 // Notification represents a notification in the database
 type Notification struct {
 	ID             string     `gorm:"primaryKey;column:id" json:"id"`
@@ -920,6 +1387,8 @@ type Notification struct {
 	ClientOnly     bool       `gorm:"column:client_only;default:false" json:"client_only"`     // If true, only stored client-side
 	CreatedAt      time.Time  `gorm:"column:created_at;index" json:"created_at"`
 	UpdatedAt      time.Time  `gorm:"column:updated_at" json:"updated_at"`
+	ArchivedAt     *time.Time `gorm:"column:archived_at;index" json:"archived_at,omitempty"` // Archived notifications are left out of the inbox
+	DeletedAt      *time.Time `gorm:"column:deleted_at;index" json:"deleted_at,omitempty"`   // Soft delete
 }
 
 func (Notification) TableName() string {
@@ -955,6 +1424,7 @@ type NotificationPreference struct {
 	NotificationType string    `gorm:"column:notification_type;not null;uniqueIndex:idx_user_type" json:"notification_type"` // INFO, SUCCESS, WARNING, ERROR, DEPLOYMENT, BILLING, QUOTA, INVITE, SYSTEM
 	EmailEnabled     bool      `gorm:"column:email_enabled;default:false" json:"email_enabled"`
 	InAppEnabled     bool      `gorm:"column:in_app_enabled;default:true" json:"in_app_enabled"`
+	SMSEnabled       bool      `gorm:"column:sms_enabled;default:false" json:"sms_enabled"`   // Only CRITICAL notifications are sent by SMS
 	Frequency        string    `gorm:"column:frequency;default:'immediate'" json:"frequency"` // immediate, daily, weekly, never
 	MinSeverity      string    `gorm:"column:min_severity;default:'LOW'" json:"min_severity"` // LOW, MEDIUM, HIGH, CRITICAL
 	CreatedAt        time.Time `gorm:"column:created_at" json:"created_at"`
@@ -988,6 +1458,66 @@ func (np *NotificationPreference) BeforeUpdate(tx *gorm.DB) error {
 	return nil
 }
 
+// NotificationContact stores a user's contact details for notification channels other than in-app and email
+type NotificationContact struct {
+	UserID         string    `gorm:"primaryKey;column:user_id" json:"user_id"`
+	SMSPhoneNumber string    `gorm:"column:sms_phone_number" json:"sms_phone_number"` // E.164 format
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (NotificationContact) TableName() string {
+	return "notification_contacts"
+}
+
+// NotificationDigestPolicy controls whether an organization's notifications are grouped into digests
+type NotificationDigestPolicy struct {
+	OrganizationID    string    `gorm:"primaryKey;column:organization_id" json:"organization_id"`
+	Enabled           bool      `gorm:"column:enabled;default:false" json:"enabled"`
+	NotificationTypes string    `gorm:"column:notification_types;type:jsonb;default:'[]'" json:"notification_types"` // JSON array of types to group; empty groups every type
+	UpdatedBy         string    `gorm:"column:updated_by" json:"updated_by"`
+	CreatedAt         time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt         time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (NotificationDigestPolicy) TableName() string {
+	return "notification_digest_policies"
+}
+
+// WebhookDeliveryConfig posts an organization's notifications to a Slack or Discord webhook
+type WebhookDeliveryConfig struct {
+	ID               string    `gorm:"primaryKey;column:id" json:"id"`
+	OrganizationID   string    `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	ChannelType      string    `gorm:"column:channel_type;not null" json:"channel_type"` // slack, discord
+	WebhookURL       string    `gorm:"column:webhook_url;not null" json:"-"`
+	NotifySeverities string    `gorm:"column:notify_severities;type:jsonb;default:'[]'" json:"notify_severities"` // JSON array of severities to deliver; empty delivers every severity
+	CreatedBy        string    `gorm:"column:created_by" json:"created_by"`
+	CreatedAt        time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt        time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (WebhookDeliveryConfig) TableName() string {
+	return "webhook_delivery_configs"
+}
+
+// NotificationDeliveryAttempt records one attempt to deliver a notification to a webhook
+type NotificationDeliveryAttempt struct {
+	ID              string    `gorm:"primaryKey;column:id" json:"id"`
+	NotificationID  string    `gorm:"column:notification_id;index" json:"notification_id"`
+	WebhookConfigID string    `gorm:"column:webhook_config_id;index" json:"webhook_config_id"`
+	OrganizationID  string    `gorm:"column:organization_id;index" json:"organization_id"`
+	ChannelType     string    `gorm:"column:channel_type" json:"channel_type"`
+	Attempt         int       `gorm:"column:attempt" json:"attempt"`
+	Status          string    `gorm:"column:status" json:"status"` // delivered, failed
+	StatusCode      int       `gorm:"column:status_code" json:"status_code"`
+	Error           string    `gorm:"column:error" json:"error"`
+	CreatedAt       time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (NotificationDeliveryAttempt) TableName() string {
+	return "notification_delivery_attempts"
+}
+
 // DatabaseInstance represents a managed database instance
 type DatabaseInstance struct {
 	ID               string     `gorm:"primaryKey;column:id" json:"id"`
@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+// Node types recorded in node_capacity_samples
+const (
+	NodeTypeDocker  = "docker"
+	NodeTypeProxmox = "proxmox"
+)
+
+// NodeCapacitySample is an hourly reading of a Docker or Proxmox node's resource usage, stored in the
+// metrics database for capacity planning. Usage is in percent (0-100).
+type NodeCapacitySample struct {
+	NodeID          string    `gorm:"primaryKey;column:node_id" json:"node_id"`
+	Timestamp       time.Time `gorm:"primaryKey;column:timestamp;index" json:"timestamp"` // Truncated to the hour so replicas record one sample
+	NodeName        string    `gorm:"column:node_name" json:"node_name"`
+	NodeType        string    `gorm:"column:node_type;not null" json:"node_type"` // docker, proxmox
+	CPUPercent      float64   `gorm:"column:cpu_percent" json:"cpu_percent"`
+	MemoryPercent   float64   `gorm:"column:memory_percent" json:"memory_percent"`
+	DiskPercent     *float64  `gorm:"column:disk_percent" json:"disk_percent,omitempty"` // Nil when the node does not report disk usage
+	DeploymentCount int       `gorm:"column:deployment_count" json:"deployment_count"`
+}
+
+func (NodeCapacitySample) TableName() string {
+	return "node_capacity_samples"
+}
+
+// RecordNodeCapacitySamples stores node capacity samples; a sample already recorded for the node
+// and hour is kept
+func RecordNodeCapacitySamples(ctx context.Context, samples []NodeCapacitySample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+	if MetricsDB == nil {
+		return fmt.Errorf("metrics database not initialized")
+	}
+	if err := MetricsDB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&samples).Error; err != nil {
+		return fmt.Errorf("failed to store node capacity samples: %w", err)
+	}
+	return nil
+}
+
+// ListNodeCapacitySamples returns the samples recorded since the given time, oldest first
+func ListNodeCapacitySamples(ctx context.Context, since time.Time) ([]NodeCapacitySample, error) {
+	if MetricsDB == nil {
+		return nil, fmt.Errorf("metrics database not initialized")
+	}
+	var samples []NodeCapacitySample
+	if err := MetricsDB.WithContext(ctx).Where("timestamp >= ?", since).Order("timestamp ASC").Find(&samples).Error; err != nil {
+		return nil, fmt.Errorf("failed to list node capacity samples: %w", err)
+	}
+	return samples, nil
+}
+
+// CleanOldNodeCapacitySamples removes samples recorded before the cutoff and returns how many were removed
+func CleanOldNodeCapacitySamples(ctx context.Context, cutoff time.Time) (int64, error) {
+	if MetricsDB == nil {
+		return 0, fmt.Errorf("metrics database not initialized")
+	}
+	result := MetricsDB.WithContext(ctx).Where("timestamp < ?", cutoff).Delete(&NodeCapacitySample{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to clean old node capacity samples: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// initNodeCapacitySamplesHypertable converts node_capacity_samples to a TimescaleDB hypertable with weekly chunks
+func initNodeCapacitySamplesHypertable(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&NodeCapacitySample{}) {
+		return nil
+	}
+	if err := db.Exec(`
+		SELECT create_hypertable('node_capacity_samples', 'timestamp',
+			chunk_time_interval => INTERVAL '7 days',
+			if_not_exists => TRUE,
+			migrate_data => TRUE)
+	`).Error; err != nil {
+		return err
+	}
+	logger.Debug("node_capacity_samples is a TimescaleDB hypertable")
+	return nil
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrUnknownActivityCursor is returned when a page of activity events is requested after an
+// event that isn't in the organization's feed
+var ErrUnknownActivityCursor = errors.New("unknown activity event cursor")
+
+// OrgActivityEvent is one entry of an organization's activity feed: a create, update or delete of
+// one of its deployments, game servers, VPSes, members or billing settings.
+type OrgActivityEvent struct {
+	ID             string    `gorm:"primaryKey" json:"id"`
+	OrganizationID string    `gorm:"column:org_id;not null;index:idx_org_activity_events_org_created_at,priority:1" json:"org_id"`
+	EventType      string    `gorm:"column:event_type;not null" json:"event_type"` // "<resource_type>.<created|updated|deleted>", e.g. "deployment.created"
+	ActorID        string    `gorm:"column:actor_id;not null" json:"actor_id"`     // user ID, or "system" for automated changes
+	ResourceType   string    `gorm:"column:resource_type;not null" json:"resource_type"`
+	ResourceID     *string   `gorm:"column:resource_id" json:"resource_id"`
+	Metadata       string    `gorm:"column:metadata;type:jsonb;default:'{}'" json:"metadata"` // JSON object of string values
+	CreatedAt      time.Time `gorm:"column:created_at;not null;index:idx_org_activity_events_org_created_at,priority:2,sort:desc" json:"created_at"`
+}
+
+func (OrgActivityEvent) TableName() string { return "org_activity_events" }
+
+// OrgActivityFilter selects a page of an organization's activity feed. Empty fields don't filter.
+type OrgActivityFilter struct {
+	AfterID   string // Return events older than this event
+	EventType string
+	ActorID   string
+	Limit     int
+}
+
+// ListOrgActivityEvents returns a page of the organization's activity events, newest first.
+// Events are ordered by creation time and then ID, so paging with AfterID never skips or repeats
+// events created in the same instant.
+func ListOrgActivityEvents(ctx context.Context, db *gorm.DB, orgID string, filter OrgActivityFilter) ([]OrgActivityEvent, error) {
+	query := db.WithContext(ctx).Where("org_id = ?", orgID)
+
+	if filter.AfterID != "" {
+		var cursor OrgActivityEvent
+		if err := db.WithContext(ctx).Select("id", "created_at").Where("id = ? AND org_id = ?", filter.AfterID, orgID).First(&cursor).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, ErrUnknownActivityCursor
+			}
+			return nil, fmt.Errorf("failed to get activity event cursor: %w", err)
+		}
+		query = query.Where("(created_at < ? OR (created_at = ? AND id < ?))", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+	}
+	if filter.EventType != "" {
+		query = query.Where("event_type = ?", filter.EventType)
+	}
+	if filter.ActorID != "" {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+
+	var events []OrgActivityEvent
+	if err := query.Order("created_at DESC, id DESC").Limit(filter.Limit).Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to list activity events: %w", err)
+	}
+	return events, nil
+}
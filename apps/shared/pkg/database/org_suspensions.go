@@ -0,0 +1,55 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Organization status values set by superadmin moderation
+const (
+	OrganizationStatusActive    = "active"
+	OrganizationStatusSuspended = "suspended"
+	OrganizationStatusBanned    = "banned"
+	// OrganizationStatusMerged marks an organization whose resources were moved into another one
+	OrganizationStatusMerged = "merged"
+)
+
+// OrgSuspension records one suspension of an organization, from when it was imposed
+// until it was lifted. Suspensions still in force have no LiftedAt.
+type OrgSuspension struct {
+	ID             string     `gorm:"primaryKey" json:"id"`
+	OrganizationID string     `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	Reason         *string    `gorm:"column:reason" json:"reason"`
+	SuspendedBy    string     `gorm:"column:suspended_by;not null" json:"suspended_by"` // user ID, or "system" for automated suspensions
+	SuspendedAt    time.Time  `gorm:"column:suspended_at;not null" json:"suspended_at"`
+	ExpiresAt      *time.Time `gorm:"column:expires_at" json:"expires_at"`
+	LiftedAt       *time.Time `gorm:"column:lifted_at" json:"lifted_at"`
+	LiftedBy       *string    `gorm:"column:lifted_by" json:"lifted_by"`
+}
+
+func (OrgSuspension) TableName() string { return "org_suspensions" }
+
+// IsOrganizationSuspended reports whether an organization is barred from using its resources:
+// it is banned, or suspended with no expiry or an expiry still in the future.
+// Unknown organizations are not reported as suspended.
+func IsOrganizationSuspended(db *gorm.DB, orgID string) (bool, error) {
+	var org Organization
+	err := db.Select("id", "status", "suspension_expires").Where("id = ?", orgID).First(&org).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get organization status: %w", err)
+	}
+
+	switch org.Status {
+	case OrganizationStatusBanned:
+		return true, nil
+	case OrganizationStatusSuspended:
+		return org.SuspensionExpires == nil || org.SuspensionExpires.After(time.Now()), nil
+	}
+	return false, nil
+}
@@ -0,0 +1,49 @@
+package database
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsOrganizationSuspended(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t, &Organization{})
+
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	for _, org := range []*Organization{
+		{ID: "org-active", Slug: "active", Status: OrganizationStatusActive},
+		{ID: "org-suspended", Slug: "suspended", Status: OrganizationStatusSuspended},
+		{ID: "org-suspended-until", Slug: "suspended-until", Status: OrganizationStatusSuspended, SuspensionExpires: &future},
+		{ID: "org-suspension-expired", Slug: "suspension-expired", Status: OrganizationStatusSuspended, SuspensionExpires: &past},
+		{ID: "org-banned", Slug: "banned", Status: OrganizationStatusBanned},
+	} {
+		if err := db.Create(org).Error; err != nil {
+			t.Fatalf("seed %s: %v", org.ID, err)
+		}
+	}
+
+	tests := []struct {
+		orgID string
+		want  bool
+	}{
+		{"org-active", false},
+		{"org-suspended", true},
+		{"org-suspended-until", true},
+		{"org-suspension-expired", false},
+		{"org-banned", true},
+		{"org-missing", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.orgID, func(t *testing.T) {
+			got, err := IsOrganizationSuspended(db, tt.orgID)
+			if err != nil {
+				t.Fatalf("IsOrganizationSuspended returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("IsOrganizationSuspended(%q) = %v, want %v", tt.orgID, got, tt.want)
+			}
+		})
+	}
+}
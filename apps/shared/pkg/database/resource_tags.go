@@ -0,0 +1,274 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// MaxResourceTags is the number of tags a single resource can carry
+	MaxResourceTags = 20
+	// MaxResourceTagLength bounds both tag keys and values
+	MaxResourceTagLength = 63
+)
+
+// Resource types that can be tagged
+const (
+	ResourceTagTypeDeployment = "deployment"
+	ResourceTagTypeGameServer = "gameserver"
+	ResourceTagTypeVPS        = "vps"
+)
+
+var (
+	// ErrInvalidResourceTag is returned for keys or values that fail validation
+	ErrInvalidResourceTag = errors.New("invalid resource tag")
+	// ErrTooManyResourceTags is returned when a resource already carries MaxResourceTags tags
+	ErrTooManyResourceTags = fmt.Errorf("a resource can carry at most %d tags", MaxResourceTags)
+
+	resourceTagKeyPattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+)
+
+// ResourceTag is a key=value label on an organization's deployment, game server or VPS
+type ResourceTag struct {
+	ID             uint      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	ResourceType   string    `gorm:"column:resource_type;not null;uniqueIndex:idx_resource_tags_resource_key" json:"resource_type"`
+	ResourceID     string    `gorm:"column:resource_id;not null;uniqueIndex:idx_resource_tags_resource_key" json:"resource_id"`
+	OrganizationID string    `gorm:"column:organization_id;not null;index:idx_resource_tags_org_key_value" json:"organization_id"`
+	Key            string    `gorm:"column:key;not null;uniqueIndex:idx_resource_tags_resource_key;index:idx_resource_tags_org_key_value" json:"key"`
+	Value          string    `gorm:"column:value;not null;index:idx_resource_tags_org_key_value" json:"value"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (ResourceTag) TableName() string {
+	return "resource_tags"
+}
+
+// TaggedResource is a resource with all of its tags
+type TaggedResource struct {
+	ResourceType string
+	ResourceID   string
+	Tags         map[string]string
+}
+
+// IsTaggableResourceType reports whether resources of the given type can carry tags
+func IsTaggableResourceType(resourceType string) bool {
+	switch resourceType {
+	case ResourceTagTypeDeployment, ResourceTagTypeGameServer, ResourceTagTypeVPS:
+		return true
+	}
+	return false
+}
+
+// ValidateResourceTag checks a tag key and value against the length and character rules
+func ValidateResourceTag(key, value string) error {
+	if key == "" || len(key) > MaxResourceTagLength {
+		return fmt.Errorf("%w: key must be 1-%d characters", ErrInvalidResourceTag, MaxResourceTagLength)
+	}
+	if !resourceTagKeyPattern.MatchString(key) {
+		return fmt.Errorf("%w: key %q may only contain lowercase letters, digits and dashes", ErrInvalidResourceTag, key)
+	}
+	if len(value) > MaxResourceTagLength {
+		return fmt.Errorf("%w: value for %q must be at most %d characters", ErrInvalidResourceTag, key, MaxResourceTagLength)
+	}
+	return nil
+}
+
+// ResourceOrganizationID returns the organization that owns a taggable resource
+func ResourceOrganizationID(resourceType, resourceID string) (string, error) {
+	var model interface{}
+	switch resourceType {
+	case ResourceTagTypeDeployment:
+		model = &Deployment{}
+	case ResourceTagTypeGameServer:
+		model = &GameServer{}
+	case ResourceTagTypeVPS:
+		model = &VPSInstance{}
+	default:
+		return "", fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+
+	var orgID string
+	err := DB.Model(model).Select("organization_id").
+		Where("id = ? AND deleted_at IS NULL", resourceID).
+		Limit(1).Scan(&orgID).Error
+	if err != nil {
+		return "", fmt.Errorf("failed to look up %s %s: %w", resourceType, resourceID, err)
+	}
+	if orgID == "" {
+		return "", gorm.ErrRecordNotFound
+	}
+	return orgID, nil
+}
+
+// SetResourceTag adds a tag to a resource or replaces the value of an existing key
+func SetResourceTag(orgID, resourceType, resourceID, key, value string) error {
+	if err := ValidateResourceTag(key, value); err != nil {
+		return err
+	}
+
+	return DB.Transaction(func(tx *gorm.DB) error {
+		var existing ResourceTag
+		err := tx.Where("resource_type = ? AND resource_id = ? AND key = ?", resourceType, resourceID, key).
+			First(&existing).Error
+		if err == nil {
+			existing.Value = value
+			existing.UpdatedAt = time.Now()
+			if err := tx.Save(&existing).Error; err != nil {
+				return fmt.Errorf("failed to update resource tag: %w", err)
+			}
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get resource tag: %w", err)
+		}
+
+		var count int64
+		if err := tx.Model(&ResourceTag{}).
+			Where("resource_type = ? AND resource_id = ?", resourceType, resourceID).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to count resource tags: %w", err)
+		}
+		if count >= MaxResourceTags {
+			return ErrTooManyResourceTags
+		}
+
+		now := time.Now()
+		tag := &ResourceTag{
+			ResourceType:   resourceType,
+			ResourceID:     resourceID,
+			OrganizationID: orgID,
+			Key:            key,
+			Value:          value,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		if err := tx.Create(tag).Error; err != nil {
+			return fmt.Errorf("failed to create resource tag: %w", err)
+		}
+		return nil
+	})
+}
+
+// RemoveResourceTag deletes a tag from a resource, returning gorm.ErrRecordNotFound if the key is not set
+func RemoveResourceTag(resourceType, resourceID, key string) error {
+	result := DB.Where("resource_type = ? AND resource_id = ? AND key = ?", resourceType, resourceID, key).
+		Delete(&ResourceTag{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to remove resource tag: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteResourceTags removes every tag from a resource
+func DeleteResourceTags(db *gorm.DB, resourceType, resourceID string) error {
+	if err := db.Where("resource_type = ? AND resource_id = ?", resourceType, resourceID).
+		Delete(&ResourceTag{}).Error; err != nil {
+		return fmt.Errorf("failed to delete resource tags: %w", err)
+	}
+	return nil
+}
+
+// GetResourceTags returns the tags of one resource
+func GetResourceTags(resourceType, resourceID string) (map[string]string, error) {
+	var tags []ResourceTag
+	if err := DB.Where("resource_type = ? AND resource_id = ?", resourceType, resourceID).
+		Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to get resource tags: %w", err)
+	}
+	out := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		out[tag.Key] = tag.Value
+	}
+	return out, nil
+}
+
+// ListResourcesByTag returns the organization's resources that carry every key=value pair in tags.
+// resourceType narrows the search to one type when set.
+func ListResourcesByTag(orgID, resourceType string, tags map[string]string) ([]TaggedResource, error) {
+	type match struct {
+		ResourceType string
+		ResourceID   string
+	}
+
+	query := DB.Table("resource_tags AS rt").
+		Select("DISTINCT rt.resource_type, rt.resource_id").
+		Where("rt.organization_id = ?", orgID)
+	if resourceType != "" {
+		query = query.Where("rt.resource_type = ?", resourceType)
+	}
+	query = joinResourceTags(query, "%[1]s.resource_type = rt.resource_type AND %[1]s.resource_id = rt.resource_id", nil, tags)
+
+	var matches []match
+	if err := query.Order("rt.resource_type, rt.resource_id").Scan(&matches).Error; err != nil {
+		return nil, fmt.Errorf("failed to list resources by tag: %w", err)
+	}
+	if len(matches) == 0 {
+		return []TaggedResource{}, nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, m := range matches {
+		ids = append(ids, m.ResourceID)
+	}
+	var rows []ResourceTag
+	if err := DB.Where("organization_id = ? AND resource_id IN ?", orgID, ids).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load resource tags: %w", err)
+	}
+	byResource := make(map[string]map[string]string, len(matches))
+	for _, row := range rows {
+		k := row.ResourceType + "/" + row.ResourceID
+		if byResource[k] == nil {
+			byResource[k] = make(map[string]string)
+		}
+		byResource[k][row.Key] = row.Value
+	}
+
+	resources := make([]TaggedResource, 0, len(matches))
+	for _, m := range matches {
+		resources = append(resources, TaggedResource{
+			ResourceType: m.ResourceType,
+			ResourceID:   m.ResourceID,
+			Tags:         byResource[m.ResourceType+"/"+m.ResourceID],
+		})
+	}
+	return resources, nil
+}
+
+// FilterByResourceTags restricts a query on table to rows carrying every key=value pair in tags.
+// Each pair adds one inner join on resource_tags, so the pairs are ANDed.
+func FilterByResourceTags(query *gorm.DB, table, resourceType string, tags map[string]string) *gorm.DB {
+	if len(tags) == 0 {
+		return query
+	}
+	return joinResourceTags(query, "%[1]s.resource_type = ? AND %[1]s.resource_id = "+table+".id", []interface{}{resourceType}, tags)
+}
+
+// joinResourceTags joins resource_tags once per tag. on is the join condition with the
+// alias as %[1]s; onArgs fill its placeholders.
+func joinResourceTags(query *gorm.DB, on string, onArgs []interface{}, tags map[string]string) *gorm.DB {
+	// Sorted keys keep the generated SQL stable
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for i, key := range keys {
+		alias := fmt.Sprintf("rt_filter_%d", i)
+		args := append(append([]interface{}{}, onArgs...), key, tags[key])
+		query = query.Joins(
+			fmt.Sprintf("JOIN resource_tags AS %[1]s ON "+on+" AND %[1]s.key = ? AND %[1]s.value = ?", alias),
+			args...,
+		)
+	}
+	return query
+}
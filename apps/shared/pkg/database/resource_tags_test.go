@@ -0,0 +1,155 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func TestDeploymentTagFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := newTestDB(t, &Deployment{}, &ResourceTag{})
+	repo := NewDeploymentRepository(db, nil)
+
+	seedDeployments(t, db,
+		&Deployment{ID: "dep-web-prod", Name: "web-prod", OrganizationID: "org-a"},
+		&Deployment{ID: "dep-web-staging", Name: "web-staging", OrganizationID: "org-a"},
+		&Deployment{ID: "dep-api-prod", Name: "api-prod", OrganizationID: "org-a"},
+		&Deployment{ID: "dep-untagged", Name: "untagged", OrganizationID: "org-a"},
+		&Deployment{ID: "dep-org-b", Name: "org-b", OrganizationID: "org-b"},
+	)
+	seedResourceTags(t, db, ResourceTagTypeDeployment,
+		&ResourceTag{ResourceID: "dep-web-prod", OrganizationID: "org-a", Key: "env", Value: "prod"},
+		&ResourceTag{ResourceID: "dep-web-prod", OrganizationID: "org-a", Key: "team", Value: "web"},
+		&ResourceTag{ResourceID: "dep-web-staging", OrganizationID: "org-a", Key: "env", Value: "staging"},
+		&ResourceTag{ResourceID: "dep-web-staging", OrganizationID: "org-a", Key: "team", Value: "web"},
+		&ResourceTag{ResourceID: "dep-api-prod", OrganizationID: "org-a", Key: "env", Value: "prod"},
+		&ResourceTag{ResourceID: "dep-api-prod", OrganizationID: "org-a", Key: "team", Value: "api"},
+		&ResourceTag{ResourceID: "dep-org-b", OrganizationID: "org-b", Key: "env", Value: "prod"},
+	)
+	// A game server sharing a deployment's ID must not satisfy the deployment filter
+	seedResourceTags(t, db, ResourceTagTypeGameServer,
+		&ResourceTag{ResourceID: "dep-web-staging", OrganizationID: "org-a", Key: "env", Value: "prod"},
+	)
+
+	tests := []struct {
+		name string
+		tags map[string]string
+		want []string
+	}{
+		{"no tags", nil, []string{"dep-web-prod", "dep-web-staging", "dep-api-prod", "dep-untagged"}},
+		{"single tag", map[string]string{"env": "prod"}, []string{"dep-web-prod", "dep-api-prod"}},
+		{"all pairs must match", map[string]string{"env": "prod", "team": "web"}, []string{"dep-web-prod"}},
+		{"shared key with different values", map[string]string{"team": "web"}, []string{"dep-web-prod", "dep-web-staging"}},
+		{"no resource carries every pair", map[string]string{"env": "staging", "team": "api"}, []string{}},
+		{"unknown key", map[string]string{"owner": "alice"}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters := &DeploymentFilters{IncludeAll: true, Tags: tt.tags}
+			got, err := repo.GetAll(ctx, "org-a", filters)
+			if err != nil {
+				t.Fatalf("GetAll returned error: %v", err)
+			}
+			assertDeploymentIDs(t, got, tt.want)
+
+			count, err := repo.Count(ctx, "org-a", filters)
+			if err != nil {
+				t.Fatalf("Count returned error: %v", err)
+			}
+			if count != int64(len(tt.want)) {
+				t.Fatalf("Count = %d, want %d", count, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestGameServerTagFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := newTestDB(t, &GameServer{}, &ResourceTag{})
+	repo := NewGameServerRepository(db, nil)
+
+	for _, server := range []*GameServer{
+		{ID: "gs-survival", OrganizationID: "org-a", ExtraPorts: "[]", EnvVars: "{}"},
+		{ID: "gs-creative", OrganizationID: "org-a", ExtraPorts: "[]", EnvVars: "{}"},
+	} {
+		if err := db.Create(server).Error; err != nil {
+			t.Fatalf("seed %s: %v", server.ID, err)
+		}
+	}
+	seedResourceTags(t, db, ResourceTagTypeGameServer,
+		&ResourceTag{ResourceID: "gs-survival", OrganizationID: "org-a", Key: "mode", Value: "survival"},
+		&ResourceTag{ResourceID: "gs-survival", OrganizationID: "org-a", Key: "public", Value: "true"},
+		&ResourceTag{ResourceID: "gs-creative", OrganizationID: "org-a", Key: "mode", Value: "creative"},
+		&ResourceTag{ResourceID: "gs-creative", OrganizationID: "org-a", Key: "public", Value: "true"},
+	)
+
+	got, err := repo.GetAll(ctx, "org-a", &GameServerFilters{IncludeAll: true, Tags: map[string]string{"public": "true", "mode": "survival"}})
+	if err != nil {
+		t.Fatalf("GetAll returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "gs-survival" {
+		t.Fatalf("GetAll returned %d game servers, want only gs-survival", len(got))
+	}
+
+	if err := repo.Delete(ctx, "gs-survival"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	var remaining int64
+	if err := db.Model(&ResourceTag{}).Where("resource_id = ?", "gs-survival").Count(&remaining).Error; err != nil {
+		t.Fatal(err)
+	}
+	if remaining != 0 {
+		t.Fatalf("deleting the game server left %d tags behind", remaining)
+	}
+}
+
+func TestValidateResourceTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		wantErr bool
+	}{
+		{"valid", "env", "prod", false},
+		{"digits and dashes", "cost-center-42", "Finance / EU", false},
+		{"empty value", "pinned", "", false},
+		{"max length", strings.Repeat("k", 63), strings.Repeat("v", 63), false},
+		{"empty key", "", "prod", true},
+		{"uppercase key", "Env", "prod", true},
+		{"underscore key", "cost_center", "42", true},
+		{"key too long", strings.Repeat("k", 64), "prod", true},
+		{"value too long", "env", strings.Repeat("v", 64), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateResourceTag(tt.key, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateResourceTag(%q, %q) error = %v, wantErr %v", tt.key, tt.value, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidResourceTag) {
+				t.Fatalf("error %v does not wrap ErrInvalidResourceTag", err)
+			}
+		})
+	}
+}
+
+func seedResourceTags(t *testing.T, db *gorm.DB, resourceType string, tags ...*ResourceTag) {
+	t.Helper()
+
+	for _, tag := range tags {
+		tag.ResourceType = resourceType
+		if err := db.Create(tag).Error; err != nil {
+			t.Fatalf("failed to seed tag %s=%s on %s: %v", tag.Key, tag.Value, tag.ResourceID, err)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MaxRoleInheritanceDepth is how many levels of ancestor roles are followed when resolving permissions
+const MaxRoleInheritanceDepth = 5
+
+// ErrRoleInheritanceCycle is returned when an inheritance edge would make a role its own ancestor
+var ErrRoleInheritanceCycle = errors.New("role inheritance would create a cycle")
+
+// RoleInheritance makes a custom organization role inherit every permission of a parent role.
+// The edges of an organization form a DAG; AddRoleInheritance rejects edges that close a cycle.
+type RoleInheritance struct {
+	ChildRoleID    string    `gorm:"primaryKey;column:child_role_id" json:"child_role_id"`
+	ParentRoleID   string    `gorm:"primaryKey;column:parent_role_id;index" json:"parent_role_id"`
+	OrganizationID string    `gorm:"column:organization_id;not null;index" json:"organization_id"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (RoleInheritance) TableName() string { return "role_inheritance" }
+
+// AddRoleInheritance makes childRoleID inherit parentRoleID. Both roles must be custom roles of the
+// organization, and the parent must not already inherit from the child (ErrRoleInheritanceCycle).
+func AddRoleInheritance(ctx context.Context, db *gorm.DB, orgID, childRoleID, parentRoleID string) error {
+	if childRoleID == parentRoleID {
+		return ErrRoleInheritanceCycle
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&OrgRole{}).Where("organization_id = ? AND id IN ?", orgID, []string{childRoleID, parentRoleID}).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to get roles: %w", err)
+		}
+		if count != 2 {
+			return gorm.ErrRecordNotFound
+		}
+
+		// The edge closes a cycle if the child is already an ancestor of the parent; the whole
+		// graph is walked here, not just MaxRoleInheritanceDepth levels, so no cycle can hide deeper
+		var edges []RoleInheritance
+		if err := tx.Where("organization_id = ?", orgID).Find(&edges).Error; err != nil {
+			return fmt.Errorf("failed to get role inheritance: %w", err)
+		}
+		if roleReachable(parentsByChild(edges), parentRoleID, childRoleID) {
+			return ErrRoleInheritanceCycle
+		}
+
+		edge := &RoleInheritance{ChildRoleID: childRoleID, ParentRoleID: parentRoleID, OrganizationID: orgID, CreatedAt: time.Now()}
+		if err := tx.Where(RoleInheritance{ChildRoleID: childRoleID, ParentRoleID: parentRoleID}).FirstOrCreate(edge).Error; err != nil {
+			return fmt.Errorf("failed to add role inheritance: %w", err)
+		}
+		return nil
+	})
+}
+
+// RemoveRoleInheritance removes an inheritance edge; it reports whether the edge existed
+func RemoveRoleInheritance(ctx context.Context, db *gorm.DB, orgID, childRoleID, parentRoleID string) (bool, error) {
+	result := db.WithContext(ctx).
+		Where("organization_id = ? AND child_role_id = ? AND parent_role_id = ?", orgID, childRoleID, parentRoleID).
+		Delete(&RoleInheritance{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to remove role inheritance: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteRoleInheritanceForRole removes every inheritance edge a role takes part in, e.g. when it is deleted
+func DeleteRoleInheritanceForRole(ctx context.Context, db *gorm.DB, roleID string) error {
+	if err := db.WithContext(ctx).Where("child_role_id = ? OR parent_role_id = ?", roleID, roleID).Delete(&RoleInheritance{}).Error; err != nil {
+		return fmt.Errorf("failed to remove role inheritance: %w", err)
+	}
+	return nil
+}
+
+// ListRoleInheritance returns the inheritance edges of an organization
+func ListRoleInheritance(ctx context.Context, db *gorm.DB, orgID string) ([]RoleInheritance, error) {
+	var edges []RoleInheritance
+	if err := db.WithContext(ctx).Where("organization_id = ?", orgID).Order("child_role_id, parent_role_id").Find(&edges).Error; err != nil {
+		return nil, fmt.Errorf("failed to list role inheritance: %w", err)
+	}
+	return edges, nil
+}
+
+// ResolveRolePermissions returns the permissions of each role in roleIDs, unioned with the
+// permissions of its ancestor roles up to MaxRoleInheritanceDepth levels up (breadth-first)
+func ResolveRolePermissions(ctx context.Context, db *gorm.DB, roleIDs []string) (map[string][]string, error) {
+	if len(roleIDs) == 0 {
+		return map[string][]string{}, nil
+	}
+
+	// Load the edges level by level so only the ancestors that can be reached are fetched
+	parents := make(map[string][]string)
+	seen := make(map[string]bool, len(roleIDs))
+	frontier := make([]string, 0, len(roleIDs))
+	for _, id := range roleIDs {
+		if !seen[id] {
+			seen[id] = true
+			frontier = append(frontier, id)
+		}
+	}
+	for depth := 0; depth < MaxRoleInheritanceDepth && len(frontier) > 0; depth++ {
+		var edges []RoleInheritance
+		if err := db.WithContext(ctx).Where("child_role_id IN ?", frontier).Find(&edges).Error; err != nil {
+			return nil, fmt.Errorf("failed to get role inheritance: %w", err)
+		}
+		frontier = frontier[:0]
+		for _, edge := range edges {
+			parents[edge.ChildRoleID] = append(parents[edge.ChildRoleID], edge.ParentRoleID)
+			if !seen[edge.ParentRoleID] {
+				seen[edge.ParentRoleID] = true
+				frontier = append(frontier, edge.ParentRoleID)
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	var roles []OrgRole
+	if err := db.WithContext(ctx).Where("id IN ?", ids).Find(&roles).Error; err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+	own := make(map[string][]string, len(roles))
+	for _, role := range roles {
+		var perms []string
+		if err := json.Unmarshal([]byte(role.Permissions), &perms); err == nil {
+			own[role.ID] = perms
+		}
+	}
+
+	resolved := make(map[string][]string, len(roleIDs))
+	for _, id := range roleIDs {
+		if _, ok := resolved[id]; ok {
+			continue
+		}
+		var perms []string
+		added := make(map[string]bool)
+		for _, roleID := range roleAncestry(parents, id, MaxRoleInheritanceDepth) {
+			for _, perm := range own[roleID] {
+				if !added[perm] {
+					added[perm] = true
+					perms = append(perms, perm)
+				}
+			}
+		}
+		resolved[id] = perms
+	}
+	return resolved, nil
+}
+
+// roleAncestry returns roleID followed by its ancestors in breadth-first order, at most maxDepth levels up
+func roleAncestry(parents map[string][]string, roleID string, maxDepth int) []string {
+	visited := map[string]bool{roleID: true}
+	order := []string{roleID}
+	level := []string{roleID}
+	for depth := 0; depth < maxDepth && len(level) > 0; depth++ {
+		var next []string
+		for _, id := range level {
+			for _, parent := range parents[id] {
+				if !visited[parent] {
+					visited[parent] = true
+					order = append(order, parent)
+					next = append(next, parent)
+				}
+			}
+		}
+		level = next
+	}
+	return order
+}
+
+// roleReachable reports whether target is from itself or one of its ancestors
+func roleReachable(parents map[string][]string, from, target string) bool {
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == target {
+			return true
+		}
+		for _, parent := range parents[id] {
+			if !visited[parent] {
+				visited[parent] = true
+				queue = append(queue, parent)
+			}
+		}
+	}
+	return false
+}
+
+func parentsByChild(edges []RoleInheritance) map[string][]string {
+	parents := make(map[string][]string)
+	for _, edge := range edges {
+		parents[edge.ChildRoleID] = append(parents[edge.ChildRoleID], edge.ParentRoleID)
+	}
+	return parents
+}
@@ -0,0 +1,173 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// seedRoles creates custom roles in orgID; each role's only permission is "<id>.read"
+func seedRoles(tb testing.TB, db *gorm.DB, orgID string, ids ...string) {
+	tb.Helper()
+	for _, id := range ids {
+		role := &OrgRole{ID: id, OrganizationID: orgID, Name: id, Permissions: fmt.Sprintf(`[%q]`, id+".read")}
+		if err := db.Create(role).Error; err != nil {
+			tb.Fatalf("seed role %s: %v", id, err)
+		}
+	}
+}
+
+func TestAddRoleInheritanceRejectsCycles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := newTestDB(t, &OrgRole{}, &RoleInheritance{})
+	seedRoles(t, db, "org-1", "r0", "r1", "r2", "r3", "r4", "r5", "r6")
+	seedRoles(t, db, "org-2", "other")
+
+	// r0 -> r1 -> ... -> r6, deeper than MaxRoleInheritanceDepth
+	for i := 0; i < 6; i++ {
+		if err := AddRoleInheritance(ctx, db, "org-1", fmt.Sprintf("r%d", i), fmt.Sprintf("r%d", i+1)); err != nil {
+			t.Fatalf("AddRoleInheritance r%d -> r%d: %v", i, i+1, err)
+		}
+	}
+	// Adding an existing edge again is a no-op
+	if err := AddRoleInheritance(ctx, db, "org-1", "r0", "r1"); err != nil {
+		t.Fatalf("AddRoleInheritance existing edge: %v", err)
+	}
+	// A shortcut keeps the graph acyclic
+	if err := AddRoleInheritance(ctx, db, "org-1", "r0", "r3"); err != nil {
+		t.Fatalf("AddRoleInheritance r0 -> r3: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		child, parent string
+		want          error
+	}{
+		{"self", "r2", "r2", ErrRoleInheritanceCycle},
+		{"direct", "r1", "r0", ErrRoleInheritanceCycle},
+		{"transitive", "r4", "r1", ErrRoleInheritanceCycle},
+		{"deeper than the depth limit", "r6", "r0", ErrRoleInheritanceCycle},
+		{"role of another organization", "r0", "other", gorm.ErrRecordNotFound},
+		{"missing role", "r0", "missing", gorm.ErrRecordNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := AddRoleInheritance(ctx, db, "org-1", tt.child, tt.parent); !errors.Is(err, tt.want) {
+				t.Fatalf("AddRoleInheritance(%s -> %s) = %v, want %v", tt.child, tt.parent, err, tt.want)
+			}
+		})
+	}
+
+	edges, err := ListRoleInheritance(ctx, db, "org-1")
+	if err != nil {
+		t.Fatalf("ListRoleInheritance: %v", err)
+	}
+	if len(edges) != 7 {
+		t.Fatalf("stored %d edges, want the 7 accepted ones", len(edges))
+	}
+}
+
+func TestResolveRolePermissions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := newTestDB(t, &OrgRole{}, &RoleInheritance{})
+	seedRoles(t, db, "org-1", "r0", "r1", "r2", "r3", "r4", "r5", "r6", "left", "right", "base")
+	edges := [][2]string{
+		{"r0", "r1"}, {"r1", "r2"}, {"r2", "r3"}, {"r3", "r4"}, {"r4", "r5"}, {"r5", "r6"},
+		{"left", "base"}, {"right", "base"}, {"r6", "left"}, {"r6", "right"},
+	}
+	for _, edge := range edges {
+		if err := AddRoleInheritance(ctx, db, "org-1", edge[0], edge[1]); err != nil {
+			t.Fatalf("AddRoleInheritance %s -> %s: %v", edge[0], edge[1], err)
+		}
+	}
+
+	resolved, err := ResolveRolePermissions(ctx, db, []string{"r0", "r6", "base"})
+	if err != nil {
+		t.Fatalf("ResolveRolePermissions: %v", err)
+	}
+	tests := []struct {
+		role string
+		want string
+	}{
+		// Ancestors more than MaxRoleInheritanceDepth levels up are not followed
+		{"r0", "r0.read,r1.read,r2.read,r3.read,r4.read,r5.read"},
+		// Permissions reached along several paths appear once
+		{"r6", "base.read,left.read,r6.read,right.read"},
+		{"base", "base.read"},
+	}
+	for _, tt := range tests {
+		got := append([]string(nil), resolved[tt.role]...)
+		sort.Strings(got)
+		if strings.Join(got, ",") != tt.want {
+			t.Errorf("permissions of %s = %v, want %s", tt.role, got, tt.want)
+		}
+	}
+
+	// Removing an edge drops the permissions inherited through it
+	if removed, err := RemoveRoleInheritance(ctx, db, "org-1", "r6", "left"); err != nil || !removed {
+		t.Fatalf("RemoveRoleInheritance = %v, %v; want true, nil", removed, err)
+	}
+	resolved, err = ResolveRolePermissions(ctx, db, []string{"r6"})
+	if err != nil {
+		t.Fatalf("ResolveRolePermissions: %v", err)
+	}
+	if got := strings.Join(resolved["r6"], ","); got != "r6.read,right.read,base.read" {
+		t.Fatalf("permissions of r6 after removing an edge = %s, want breadth-first r6.read,right.read,base.read", got)
+	}
+}
+
+// BenchmarkResolveRolePermissions resolves the bottom roles of a 5-level hierarchy of 50 roles,
+// where each role inherits from two roles of the level above
+func BenchmarkResolveRolePermissions(b *testing.B) {
+	ctx := context.Background()
+	db := newTestDB(b, &OrgRole{}, &RoleInheritance{})
+	// The benchmark function runs once per b.N round; closing the database drops its in-memory tables
+	b.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+
+	const levels, perLevel = 5, 10
+	roleID := func(level, i int) string { return fmt.Sprintf("l%d-r%d", level, i%perLevel) }
+	for level := 0; level < levels; level++ {
+		for i := 0; i < perLevel; i++ {
+			seedRoles(b, db, "org-1", roleID(level, i))
+		}
+	}
+	for level := 1; level < levels; level++ {
+		for i := 0; i < perLevel; i++ {
+			for _, parent := range []string{roleID(level-1, i), roleID(level-1, i+1)} {
+				if err := AddRoleInheritance(ctx, db, "org-1", roleID(level, i), parent); err != nil {
+					b.Fatalf("AddRoleInheritance: %v", err)
+				}
+			}
+		}
+	}
+
+	leaves := make([]string, 0, perLevel)
+	for i := 0; i < perLevel; i++ {
+		leaves = append(leaves, roleID(levels-1, i))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resolved, err := ResolveRolePermissions(ctx, db, leaves)
+		if err != nil {
+			b.Fatalf("ResolveRolePermissions: %v", err)
+		}
+		// Each leaf reaches itself plus 2, 3, 4 and 5 roles of the levels above
+		if len(resolved[leaves[0]]) != 15 {
+			b.Fatalf("leaf resolved %d permissions, want 15", len(resolved[leaves[0]]))
+		}
+	}
+}
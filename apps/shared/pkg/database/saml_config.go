@@ -0,0 +1,61 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SAML attribute mapping keys. Values name the IdP attribute that carries the field.
+const (
+	SAMLAttributeEmail      = "email"
+	SAMLAttributeName       = "name"
+	SAMLAttributeGivenName  = "given_name"
+	SAMLAttributeFamilyName = "family_name"
+)
+
+// SAMLConfig is an organization's SAML 2.0 identity provider (Okta, Azure AD, ...).
+type SAMLConfig struct {
+	ID               string    `gorm:"type:text;primaryKey" json:"id"`
+	OrganizationID   string    `gorm:"type:text;not null;uniqueIndex" json:"organization_id"`
+	EntityID         string    `gorm:"type:text;not null" json:"entity_id"`                      // IdP entity ID (issuer)
+	SSOURL           string    `gorm:"column:sso_url;type:text;not null" json:"sso_url"`         // IdP single sign-on URL
+	Certificate      string    `gorm:"type:text;not null" json:"certificate"`                    // PEM encoded IdP signing certificate
+	AttributeMapping string    `gorm:"type:text;not null;default:'{}'" json:"attribute_mapping"` // JSON object of mapping key -> IdP attribute name
+	CreatedAt        time.Time `gorm:"type:timestamptz;not null;default:now()" json:"created_at"`
+	UpdatedAt        time.Time `gorm:"type:timestamptz;not null;default:now()" json:"updated_at"`
+}
+
+func (SAMLConfig) TableName() string {
+	return "saml_configs"
+}
+
+// EncodeSAMLAttributeMapping trims the mapping and drops empty entries
+func EncodeSAMLAttributeMapping(mapping map[string]string) (string, error) {
+	cleaned := make(map[string]string, len(mapping))
+	for key, attribute := range mapping {
+		key = strings.ToLower(strings.TrimSpace(key))
+		attribute = strings.TrimSpace(attribute)
+		if key == "" || attribute == "" {
+			continue
+		}
+		cleaned[key] = attribute
+	}
+	encoded, err := json.Marshal(cleaned)
+	if err != nil {
+		return "", fmt.Errorf("encode SAML attribute mapping: %w", err)
+	}
+	return string(encoded), nil
+}
+
+func DecodeSAMLAttributeMapping(encoded string) (map[string]string, error) {
+	mapping := map[string]string{}
+	if strings.TrimSpace(encoded) == "" {
+		return mapping, nil
+	}
+	if err := json.Unmarshal([]byte(encoded), &mapping); err != nil {
+		return nil, fmt.Errorf("decode SAML attribute mapping: %w", err)
+	}
+	return mapping, nil
+}
@@ -0,0 +1,51 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SplitHorizonIP answers A queries for a deployment from clients inside ClientCIDR with a private
+// IP instead of the Traefik public IP, so internal services reach the container directly
+type SplitHorizonIP struct {
+	ID           uint      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	DeploymentID string    `gorm:"column:deployment_id;uniqueIndex:idx_split_horizon_deployment_cidr;not null" json:"deployment_id"`
+	ClientCIDR   string    `gorm:"column:client_cidr;uniqueIndex:idx_split_horizon_deployment_cidr;not null" json:"client_cidr"` // e.g. "10.0.0.0/8"
+	IP           string    `gorm:"column:ip;not null" json:"ip"`
+	CreatedAt    time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (SplitHorizonIP) TableName() string {
+	return "split_horizon_ips"
+}
+
+// GetSplitHorizonIPs returns the split-horizon entries of a deployment
+func GetSplitHorizonIPs(deploymentID string) ([]SplitHorizonIP, error) {
+	var entries []SplitHorizonIP
+	if err := DB.Where("deployment_id = ?", deploymentID).Order("id").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to get split-horizon IPs: %w", err)
+	}
+	return entries, nil
+}
+
+// SetSplitHorizonIPs replaces the split-horizon entries of a deployment
+func SetSplitHorizonIPs(deploymentID string, entries []SplitHorizonIP) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("deployment_id = ?", deploymentID).Delete(&SplitHorizonIP{}).Error; err != nil {
+			return fmt.Errorf("failed to clear split-horizon IPs: %w", err)
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		for i := range entries {
+			entries[i].ID = 0
+			entries[i].DeploymentID = deploymentID
+		}
+		if err := tx.Create(&entries).Error; err != nil {
+			return fmt.Errorf("failed to save split-horizon IPs: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ResourceTagKeyTeam is the resource tag key that assigns a resource to a team; the value is the team ID
+const ResourceTagKeyTeam = "team"
+
+// Team groups members and resources inside an organization so they can be given their own quota
+type Team struct {
+	ID             string    `gorm:"primaryKey;column:id" json:"id"`
+	OrganizationID string    `gorm:"column:organization_id;not null;index" json:"organization_id"`
+	Name           string    `gorm:"column:name;not null" json:"name"`
+	CreatedBy      string    `gorm:"column:created_by;index" json:"created_by"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (Team) TableName() string { return "teams" }
+
+// TeamMember links an organization member to a team
+type TeamMember struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	TeamID    string    `gorm:"column:team_id;not null;uniqueIndex:idx_team_members_team_user" json:"team_id"`
+	UserID    string    `gorm:"column:user_id;not null;uniqueIndex:idx_team_members_team_user;index" json:"user_id"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (TeamMember) TableName() string { return "team_members" }
+
+// TeamQuota caps the resources tagged with a team. It mirrors OrgQuota; nil or 0 means no team limit,
+// the organization's quota still applies.
+type TeamQuota struct {
+	TeamID              string `gorm:"primaryKey;column:team_id" json:"team_id"`
+	OrganizationID      string `gorm:"column:organization_id;not null;index" json:"organization_id"`
+	CPUCores            *int   `gorm:"column:cpu_cores" json:"cpu_cores"`
+	MemoryBytes         *int64 `gorm:"column:memory_bytes" json:"memory_bytes"`
+	DeploymentsMax      *int   `gorm:"column:deployments_max" json:"deployments_max"`
+	MaxVpsInstances     *int   `gorm:"column:max_vps_instances" json:"max_vps_instances"`
+	BandwidthBytesMonth *int64 `gorm:"column:bandwidth_bytes_month" json:"bandwidth_bytes_month"`
+	StorageBytes        *int64 `gorm:"column:storage_bytes" json:"storage_bytes"`
+}
+
+func (TeamQuota) TableName() string { return "team_quotas" }
+
+// GetTeam returns the organization's team, or gorm.ErrRecordNotFound if it belongs elsewhere
+func GetTeam(orgID, teamID string) (*Team, error) {
+	var team Team
+	if err := DB.Where("id = ? AND organization_id = ?", teamID, orgID).First(&team).Error; err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// DeleteTeam removes a team with its members and quota and untags its resources
+func DeleteTeam(orgID, teamID string) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND organization_id = ?", teamID, orgID).Delete(&Team{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete team: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := tx.Where("team_id = ?", teamID).Delete(&TeamMember{}).Error; err != nil {
+			return fmt.Errorf("failed to delete team members: %w", err)
+		}
+		if err := tx.Where("team_id = ?", teamID).Delete(&TeamQuota{}).Error; err != nil {
+			return fmt.Errorf("failed to delete team quota: %w", err)
+		}
+		if err := tx.Where("organization_id = ? AND key = ? AND value = ?", orgID, ResourceTagKeyTeam, teamID).
+			Delete(&ResourceTag{}).Error; err != nil {
+			return fmt.Errorf("failed to untag team resources: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,32 @@
+package database
+
+import (
+	"fmt"
+)
+
+// GetVPSCloudInitTemplate returns a cloud-init template owned by the given organization
+func GetVPSCloudInitTemplate(orgID, templateID string) (*VPSCloudInitTemplate, error) {
+	var tmpl VPSCloudInitTemplate
+	if err := DB.Where("id = ? AND organization_id = ?", templateID, orgID).First(&tmpl).Error; err != nil {
+		return nil, err
+	}
+	return &tmpl, nil
+}
+
+// CreateVPSCloudInitTemplate stores a new cloud-init template
+func CreateVPSCloudInitTemplate(tmpl *VPSCloudInitTemplate) error {
+	if err := DB.Create(tmpl).Error; err != nil {
+		return fmt.Errorf("failed to create cloud-init template: %w", err)
+	}
+	return nil
+}
+
+// DeleteVPSCloudInitTemplate deletes a cloud-init template owned by the given organization
+// Returns false if no matching template exists
+func DeleteVPSCloudInitTemplate(orgID, templateID string) (bool, error) {
+	result := DB.Where("id = ? AND organization_id = ?", templateID, orgID).Delete(&VPSCloudInitTemplate{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete cloud-init template: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
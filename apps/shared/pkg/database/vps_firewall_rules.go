@@ -0,0 +1,52 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GetVPSFirewallRules returns the mirrored firewall rules for a VPS ordered by position
+func GetVPSFirewallRules(vpsID string) ([]VPSFirewallRule, error) {
+	var rules []VPSFirewallRule
+	if err := DB.Where("vps_id = ?", vpsID).Order("pos ASC").Find(&rules).Error; err != nil {
+		return nil, fmt.Errorf("failed to get firewall rules: %w", err)
+	}
+	return rules, nil
+}
+
+// ReplaceVPSFirewallRules replaces the mirrored firewall rules for a VPS with the given rules.
+// Proxmox renumbers rule positions on every insert/delete, so the whole set is rewritten atomically.
+func ReplaceVPSFirewallRules(vpsID, orgID string, rules []VPSFirewallRule) error {
+	now := time.Now()
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("vps_id = ?", vpsID).Delete(&VPSFirewallRule{}).Error; err != nil {
+			return fmt.Errorf("failed to clear firewall rules: %w", err)
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+
+		for i := range rules {
+			rules[i].ID = 0
+			rules[i].VPSID = vpsID
+			rules[i].OrganizationID = orgID
+			rules[i].CreatedAt = now
+			rules[i].UpdatedAt = now
+		}
+
+		if err := tx.Create(&rules).Error; err != nil {
+			return fmt.Errorf("failed to store firewall rules: %w", err)
+		}
+		return nil
+	})
+}
+
+// DeleteVPSFirewallRules removes all mirrored firewall rules for a VPS
+func DeleteVPSFirewallRules(vpsID string) error {
+	if err := DB.Where("vps_id = ?", vpsID).Delete(&VPSFirewallRule{}).Error; err != nil {
+		return fmt.Errorf("failed to delete firewall rules: %w", err)
+	}
+	return nil
+}
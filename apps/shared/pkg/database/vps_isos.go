@@ -0,0 +1,76 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// VPSISO is an install ISO an organization uploaded to the Proxmox ISO storage. VPS instances of
+// the organization can boot from it with MountISO.
+type VPSISO struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	OrgID       string    `gorm:"column:org_id;uniqueIndex:idx_vps_isos_org_name;not null" json:"org_id"`
+	ISOName     string    `gorm:"column:iso_name;uniqueIndex:idx_vps_isos_org_name;not null" json:"iso_name"` // Name chosen by the organization, e.g. "debian-12.iso"
+	NodeName    string    `gorm:"column:node_name;not null" json:"node_name"`                                 // Node the ISO was uploaded through
+	StoragePath string    `gorm:"column:storage_path;not null" json:"storage_path"`                           // Proxmox volume ID, e.g. "local:iso/org-123-debian-12.iso"
+	SizeBytes   int64     `gorm:"column:size_bytes;not null" json:"size_bytes"`
+	UploadedAt  time.Time `gorm:"column:uploaded_at" json:"uploaded_at"`
+}
+
+func (VPSISO) TableName() string {
+	return "vps_isos"
+}
+
+// GetVPSISO returns an ISO of the given organization
+func GetVPSISO(orgID, isoName string) (*VPSISO, error) {
+	var iso VPSISO
+	if err := DB.Where("org_id = ? AND iso_name = ?", orgID, isoName).First(&iso).Error; err != nil {
+		return nil, err
+	}
+	return &iso, nil
+}
+
+// ListVPSISOs returns the ISOs of the given organization, newest first
+func ListVPSISOs(orgID string) ([]VPSISO, error) {
+	var isos []VPSISO
+	if err := DB.Where("org_id = ?", orgID).Order("uploaded_at DESC").Find(&isos).Error; err != nil {
+		return nil, fmt.Errorf("failed to list VPS ISOs: %w", err)
+	}
+	return isos, nil
+}
+
+// SumVPSISOBytes returns the ISO storage used by the given organizations
+func SumVPSISOBytes(orgIDs []string) (int64, error) {
+	var total int64
+	if err := DB.Model(&VPSISO{}).Where("org_id IN ?", orgIDs).Select("COALESCE(SUM(size_bytes), 0)").Scan(&total).Error; err != nil {
+		return 0, fmt.Errorf("failed to sum VPS ISO sizes: %w", err)
+	}
+	return total, nil
+}
+
+// CreateVPSISO stores the metadata of an uploaded ISO
+func CreateVPSISO(iso *VPSISO) error {
+	if err := DB.Create(iso).Error; err != nil {
+		return fmt.Errorf("failed to create VPS ISO: %w", err)
+	}
+	return nil
+}
+
+// DeleteVPSISO deletes an ISO of the given organization
+// Returns false if no matching ISO exists
+func DeleteVPSISO(orgID, isoName string) (bool, error) {
+	result := DB.Where("org_id = ? AND iso_name = ?", orgID, isoName).Delete(&VPSISO{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete VPS ISO: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ListOrphanedVPSISOs returns the ISOs whose organization no longer exists
+func ListOrphanedVPSISOs() ([]VPSISO, error) {
+	var isos []VPSISO
+	if err := DB.Where("org_id NOT IN (?)", DB.Model(&Organization{}).Select("id")).Find(&isos).Error; err != nil {
+		return nil, fmt.Errorf("failed to list orphaned VPS ISOs: %w", err)
+	}
+	return isos, nil
+}
@@ -0,0 +1,33 @@
+package database
+
+import "testing"
+
+func TestListOrphanedVPSISOs(t *testing.T) {
+	db := newTestDB(t, &Organization{}, &VPSISO{})
+	previousDB := DB
+	DB = db
+	t.Cleanup(func() { DB = previousDB })
+
+	seed := []any{
+		&Organization{ID: "org-a", Name: "Acme", Slug: "acme"},
+		&VPSISO{OrgID: "org-a", ISOName: "debian.iso", NodeName: "pve1", StoragePath: "local:iso/org-a-debian.iso", SizeBytes: 10},
+		&VPSISO{OrgID: "org-gone", ISOName: "debian.iso", NodeName: "pve1", StoragePath: "local:iso/org-gone-debian.iso", SizeBytes: 20},
+	}
+	for _, record := range seed {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	orphaned, err := ListOrphanedVPSISOs()
+	if err != nil {
+		t.Fatalf("ListOrphanedVPSISOs: %v", err)
+	}
+	if len(orphaned) != 1 || orphaned[0].OrgID != "org-gone" {
+		t.Fatalf("orphaned ISOs = %+v, want only the ISO of the deleted organization", orphaned)
+	}
+
+	if total, err := SumVPSISOBytes([]string{"org-a", "org-gone"}); err != nil || total != 30 {
+		t.Fatalf("SumVPSISOBytes = %d (%v), want 30", total, err)
+	}
+}
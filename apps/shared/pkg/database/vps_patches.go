@@ -0,0 +1,51 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// VPS patch run statuses stored in VPSPatchHistory.Status
+const (
+	VPSPatchStatusRunning   = "running"
+	VPSPatchStatusSucceeded = "succeeded"
+	VPSPatchStatusFailed    = "failed"
+)
+
+// VPSPatchHistory records one OS security patch run on a VPS
+type VPSPatchHistory struct {
+	ID             string     `gorm:"primaryKey;column:id" json:"id"`
+	VPSID          string     `gorm:"column:vps_id;index;not null" json:"vps_id"`
+	OrganizationID string     `gorm:"column:organization_id;index" json:"organization_id"`
+	RequestedBy    string     `gorm:"column:requested_by" json:"requested_by"` // User who requested the run
+	RebootAfter    bool       `gorm:"column:reboot_after" json:"reboot_after"`
+	Status         string     `gorm:"column:status;index;default:running" json:"status"` // "running", "succeeded" or "failed"
+	PackageManager string     `gorm:"column:package_manager" json:"package_manager"`     // apt-get, dnf or yum, as detected in the guest
+	ExitCode       *int32     `gorm:"column:exit_code" json:"exit_code"`
+	Output         string     `gorm:"column:output;type:text" json:"output"` // Tail of the patch script output, or the failure reason
+	StartedAt      time.Time  `gorm:"column:started_at;index" json:"started_at"`
+	CompletedAt    *time.Time `gorm:"column:completed_at" json:"completed_at"`
+}
+
+func (VPSPatchHistory) TableName() string {
+	return "vps_patch_history"
+}
+
+// unpatchableVPSStatuses are the VPSStatus values of instances that have no running OS to patch:
+// CREATING, FAILED, DELETING, DELETED and TERMINATED
+var unpatchableVPSStatuses = []int32{1, 7, 8, 9, 12}
+
+// ListUnpatchedVPSInstances returns the VPS instances that have not been patched since before, oldest first.
+// Instances that were never patched count as unpatched once they were created before the cutoff.
+func ListUnpatchedVPSInstances(ctx context.Context, before time.Time) ([]VPSInstance, error) {
+	var instances []VPSInstance
+	if err := DB.WithContext(ctx).
+		Where("deleted_at IS NULL AND status NOT IN ?", unpatchableVPSStatuses).
+		Where("(last_patched_at IS NULL AND created_at < ?) OR last_patched_at < ?", before, before).
+		Order("COALESCE(last_patched_at, created_at) ASC").
+		Find(&instances).Error; err != nil {
+		return nil, fmt.Errorf("failed to list unpatched VPS instances: %w", err)
+	}
+	return instances, nil
+}
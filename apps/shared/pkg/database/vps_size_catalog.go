@@ -16,6 +16,8 @@ type VPSSizeCatalog struct {
 	MemoryBytes         int64     `gorm:"column:memory_bytes" json:"memory_bytes"`
 	DiskBytes           int64     `gorm:"column:disk_bytes" json:"disk_bytes"`
 	BandwidthBytesMonth int64     `gorm:"column:bandwidth_bytes_month;default:0" json:"bandwidth_bytes_month"` // 0 = unlimited
+	MaxMbitDown         int32     `gorm:"column:max_mbit_down;default:0" json:"max_mbit_down"`                 // Download rate limit enforced by the gateway, 0 = unlimited
+	MaxMbitUp           int32     `gorm:"column:max_mbit_up;default:0" json:"max_mbit_up"`                     // Upload rate limit enforced by the gateway, 0 = unlimited
 	MinimumPaymentCents int64     `gorm:"column:minimum_payment_cents;default:0" json:"minimum_payment_cents"` // Minimum payment in cents required to create this VPS size
 	Available           bool      `gorm:"column:available;default:true" json:"available"`
 	Region              string    `gorm:"column:region;index" json:"region"` // Empty = all regions
@@ -59,6 +61,25 @@ func GetVPSSizeCatalog(sizeID string, region string) (*VPSSizeCatalog, error) {
 	return &size, nil
 }
 
+// GetVPSBandwidthLimits returns the gateway rate limits (Mbit/s, 0 = unlimited) for a VPS from its size
+// Unavailable sizes are included so existing VPS instances keep their limits
+func GetVPSBandwidthLimits(vpsID string) (downMbit, upMbit int32, err error) {
+	var vps VPSInstance
+	if err := DB.Select("size", "region").Where("id = ?", vpsID).First(&vps).Error; err != nil {
+		return 0, 0, err
+	}
+
+	var size VPSSizeCatalog
+	query := DB.Where("id = ?", vps.Size)
+	if vps.Region != "" {
+		query = query.Where("(region = ? OR region = '')", vps.Region)
+	}
+	if err := query.First(&size).Error; err != nil {
+		return 0, 0, err
+	}
+	return size.MaxMbitDown, size.MaxMbitUp, nil
+}
+
 // ListVPSSizeCatalog lists all available sizes, optionally filtered by region
 func ListVPSSizeCatalog(region string) ([]VPSSizeCatalog, error) {
 	var sizes []VPSSizeCatalog
@@ -1,11 +1,9 @@
 package database
 
 import (
-	"strings"
 	"testing"
 	"time"
 
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -115,14 +113,7 @@ func TestInitVPSCatalogPreservesExistingRegionSettings(t *testing.T) {
 func newVPSCatalogTestDB(t *testing.T) *gorm.DB {
 	t.Helper()
 
-	dbName := "file:vps_catalog_" + strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()) + "?mode=memory&cache=shared"
-	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{})
-	if err != nil {
-		t.Fatalf("open sqlite db: %v", err)
-	}
-	if err := db.AutoMigrate(&VPSSizeCatalog{}, &VPSRegionCatalog{}); err != nil {
-		t.Fatalf("migrate sqlite db: %v", err)
-	}
+	db := newTestDB(t, &VPSSizeCatalog{}, &VPSRegionCatalog{})
 
 	previousDB := DB
 	DB = db
@@ -0,0 +1,194 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Static IP allocation errors
+var (
+	ErrIPRangeOverlaps      = errors.New("IP range overlaps an existing allocation")
+	ErrStaticIPNotAllocated = errors.New("static IP is not within a subnet allocated to the organization")
+	ErrStaticIPReserved     = errors.New("static IP is the network, broadcast or gateway address of its subnet")
+	ErrStaticIPInUse        = errors.New("static IP is already assigned to another VPS")
+)
+
+// OrgIPAllocation is a subnet assigned to an organization for static VPS IPs
+type OrgIPAllocation struct {
+	ID             string    `gorm:"primaryKey;column:id" json:"id"`
+	OrganizationID string    `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	CIDR           string    `gorm:"column:cidr;uniqueIndex;not null" json:"cidr"` // Masked subnet, e.g. "10.20.0.0/24"
+	Gateway        string    `gorm:"column:gateway;not null" json:"gateway"`       // Default gateway inside the subnet
+	DNSServers     string    `gorm:"column:dns_servers" json:"dns_servers"`        // Comma-separated default DNS servers (optional)
+	CreatedBy      string    `gorm:"column:created_by;not null" json:"created_by"` // Superadmin who allocated the subnet
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (OrgIPAllocation) TableName() string {
+	return "org_ip_allocations"
+}
+
+// DNSServerList returns the default DNS servers of the allocation
+func (a *OrgIPAllocation) DNSServerList() []string {
+	if a.DNSServers == "" {
+		return nil
+	}
+	return strings.Split(a.DNSServers, ",")
+}
+
+// VPSStaticIP records a static IP taken from an organization's allocation by a VPS
+type VPSStaticIP struct {
+	VPSID          string    `gorm:"primaryKey;column:vps_id" json:"vps_id"`
+	IPAddress      string    `gorm:"column:ip_address;uniqueIndex;not null" json:"ip_address"`
+	OrganizationID string    `gorm:"column:organization_id;index;not null" json:"organization_id"`
+	AllocationID   string    `gorm:"column:allocation_id;index;not null" json:"allocation_id"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+func (VPSStaticIP) TableName() string {
+	return "vps_static_ips"
+}
+
+// AllocateOrgIPRange validates and stores a subnet allocation. The CIDR is stored masked and must
+// not overlap a subnet already allocated to any organization; the gateway must be a host of the subnet.
+func AllocateOrgIPRange(ctx context.Context, db *gorm.DB, allocation *OrgIPAllocation) error {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(allocation.CIDR))
+	if err != nil {
+		return fmt.Errorf("invalid CIDR %q: %w", allocation.CIDR, err)
+	}
+	prefix = prefix.Masked()
+	gateway, err := netip.ParseAddr(strings.TrimSpace(allocation.Gateway))
+	if err != nil {
+		return fmt.Errorf("invalid gateway %q", allocation.Gateway)
+	}
+	if !prefix.Contains(gateway) || isReservedSubnetAddr(prefix, gateway) {
+		return fmt.Errorf("gateway %s is not a host address of %s", gateway, prefix)
+	}
+	dnsServers := make([]string, 0)
+	for _, server := range allocation.DNSServerList() {
+		addr, err := netip.ParseAddr(strings.TrimSpace(server))
+		if err != nil {
+			return fmt.Errorf("invalid DNS server %q", server)
+		}
+		dnsServers = append(dnsServers, addr.String())
+	}
+
+	allocation.CIDR = prefix.String()
+	allocation.Gateway = gateway.String()
+	allocation.DNSServers = strings.Join(dnsServers, ",")
+	if allocation.CreatedAt.IsZero() {
+		allocation.CreatedAt = time.Now()
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []OrgIPAllocation
+		if err := tx.Find(&existing).Error; err != nil {
+			return fmt.Errorf("failed to get IP allocations: %w", err)
+		}
+		for _, other := range existing {
+			otherPrefix, err := netip.ParsePrefix(other.CIDR)
+			if err == nil && otherPrefix.Overlaps(prefix) {
+				return fmt.Errorf("%w: %s is allocated to organization %s", ErrIPRangeOverlaps, other.CIDR, other.OrganizationID)
+			}
+		}
+		if err := tx.Create(allocation).Error; err != nil {
+			return fmt.Errorf("failed to store IP allocation: %w", err)
+		}
+		return nil
+	})
+}
+
+// ReserveVPSStaticIP assigns a static IP from the organization's allocated subnets to a VPS and
+// returns the allocation it belongs to. An IP can only be held by one VPS at a time.
+func ReserveVPSStaticIP(ctx context.Context, db *gorm.DB, orgID, vpsID, ip string) (*OrgIPAllocation, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return nil, fmt.Errorf("invalid static IP %q", ip)
+	}
+	addr = addr.Unmap()
+
+	var allocation *OrgIPAllocation
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var allocations []OrgIPAllocation
+		if err := tx.Where("organization_id = ?", orgID).Find(&allocations).Error; err != nil {
+			return fmt.Errorf("failed to get IP allocations: %w", err)
+		}
+		for i := range allocations {
+			prefix, err := netip.ParsePrefix(allocations[i].CIDR)
+			if err == nil && prefix.Contains(addr) {
+				if isReservedSubnetAddr(prefix, addr) || addr.String() == allocations[i].Gateway {
+					return ErrStaticIPReserved
+				}
+				allocation = &allocations[i]
+				break
+			}
+		}
+		if allocation == nil {
+			return ErrStaticIPNotAllocated
+		}
+
+		var holder VPSStaticIP
+		err := tx.Where("ip_address = ?", addr.String()).First(&holder).Error
+		switch {
+		case err == nil:
+			return fmt.Errorf("%w (%s)", ErrStaticIPInUse, holder.VPSID)
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return fmt.Errorf("failed to check static IP: %w", err)
+		}
+
+		if err := tx.Create(&VPSStaticIP{
+			VPSID:          vpsID,
+			IPAddress:      addr.String(),
+			OrganizationID: orgID,
+			AllocationID:   allocation.ID,
+			CreatedAt:      time.Now(),
+		}).Error; err != nil {
+			// The unique index on ip_address catches a concurrent reservation of the same IP
+			if strings.Contains(err.Error(), "duplicate key") || strings.Contains(err.Error(), "UNIQUE constraint") {
+				return ErrStaticIPInUse
+			}
+			return fmt.Errorf("failed to reserve static IP: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return allocation, nil
+}
+
+// ReleaseVPSStaticIP frees the static IP held by a VPS, if any
+func ReleaseVPSStaticIP(ctx context.Context, db *gorm.DB, vpsID string) error {
+	if err := db.WithContext(ctx).Where("vps_id = ?", vpsID).Delete(&VPSStaticIP{}).Error; err != nil {
+		return fmt.Errorf("failed to release static IP: %w", err)
+	}
+	return nil
+}
+
+// isReservedSubnetAddr reports whether addr is the network or (IPv4) broadcast address of prefix.
+// /31 and /32 subnets have no reserved addresses.
+func isReservedSubnetAddr(prefix netip.Prefix, addr netip.Addr) bool {
+	if prefix.Bits() >= addr.BitLen()-1 {
+		return false
+	}
+	if addr == prefix.Masked().Addr() {
+		return true
+	}
+	if !addr.Is4() {
+		return false
+	}
+	last := prefix.Masked().Addr().As4()
+	hostBits := 32 - prefix.Bits()
+	for i := 3; i >= 0 && hostBits > 0; i-- {
+		n := min(hostBits, 8)
+		last[i] |= byte(1<<n - 1)
+		hostBits -= n
+	}
+	return addr == netip.AddrFrom4(last)
+}
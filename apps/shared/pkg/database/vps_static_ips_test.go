@@ -0,0 +1,100 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestAllocateOrgIPRangeRejectsOverlaps(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t, &OrgIPAllocation{})
+	ctx := context.Background()
+
+	first := &OrgIPAllocation{ID: "alloc-a", OrganizationID: "org-a", CIDR: "10.20.0.77/24", Gateway: "10.20.0.1", DNSServers: "1.1.1.1, 9.9.9.9", CreatedBy: "admin"}
+	if err := AllocateOrgIPRange(ctx, db, first); err != nil {
+		t.Fatalf("allocate first range: %v", err)
+	}
+	if first.CIDR != "10.20.0.0/24" || first.DNSServers != "1.1.1.1,9.9.9.9" {
+		t.Fatalf("stored allocation = %+v, want masked CIDR and normalized DNS servers", first)
+	}
+
+	tests := []struct {
+		name, cidr, gateway string
+		wantOverlap         bool
+	}{
+		{"same range", "10.20.0.0/24", "10.20.0.1", true},
+		{"enclosing range", "10.20.0.0/16", "10.20.1.1", true},
+		{"enclosed range", "10.20.0.128/25", "10.20.0.129", true},
+		{"adjacent range", "10.20.1.0/24", "10.20.1.1", false},
+	}
+	for i, tt := range tests {
+		err := AllocateOrgIPRange(ctx, db, &OrgIPAllocation{ID: tt.name, OrganizationID: "org-b", CIDR: tt.cidr, Gateway: tt.gateway, CreatedBy: "admin"})
+		if got := errors.Is(err, ErrIPRangeOverlaps); got != tt.wantOverlap || (!tt.wantOverlap && err != nil) {
+			t.Fatalf("case %d %s: AllocateOrgIPRange(%s) = %v, want overlap %v", i, tt.name, tt.cidr, err, tt.wantOverlap)
+		}
+	}
+
+	for _, gateway := range []string{"10.30.0.0", "10.30.0.255", "10.31.0.1", "gateway"} {
+		if err := AllocateOrgIPRange(ctx, db, &OrgIPAllocation{ID: "bad-" + gateway, OrganizationID: "org-c", CIDR: "10.30.0.0/24", Gateway: gateway, CreatedBy: "admin"}); err == nil {
+			t.Fatalf("gateway %s accepted for 10.30.0.0/24", gateway)
+		}
+	}
+}
+
+func TestReserveVPSStaticIPPreventsCollisions(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t, &OrgIPAllocation{}, &VPSStaticIP{})
+	ctx := context.Background()
+	for _, allocation := range []*OrgIPAllocation{
+		{ID: "alloc-a", OrganizationID: "org-a", CIDR: "10.20.0.0/24", Gateway: "10.20.0.1", CreatedBy: "admin"},
+		{ID: "alloc-b", OrganizationID: "org-b", CIDR: "10.20.1.0/24", Gateway: "10.20.1.1", CreatedBy: "admin"},
+	} {
+		if err := AllocateOrgIPRange(ctx, db, allocation); err != nil {
+			t.Fatalf("allocate %s: %v", allocation.ID, err)
+		}
+	}
+
+	allocation, err := ReserveVPSStaticIP(ctx, db, "org-a", "vps-1", "10.20.0.10")
+	if err != nil {
+		t.Fatalf("reserve 10.20.0.10: %v", err)
+	}
+	if allocation.ID != "alloc-a" {
+		t.Fatalf("reserved from allocation %s, want alloc-a", allocation.ID)
+	}
+
+	tests := []struct {
+		name, orgID, vpsID, ip string
+		want                   error
+	}{
+		{"same IP other VPS", "org-a", "vps-2", "10.20.0.10", ErrStaticIPInUse},
+		{"IPv4-mapped form of taken IP", "org-a", "vps-2", "::ffff:10.20.0.10", ErrStaticIPInUse},
+		{"other organization's subnet", "org-a", "vps-2", "10.20.1.10", ErrStaticIPNotAllocated},
+		{"unallocated IP", "org-a", "vps-2", "192.168.0.10", ErrStaticIPNotAllocated},
+		{"network address", "org-a", "vps-2", "10.20.0.0", ErrStaticIPReserved},
+		{"broadcast address", "org-a", "vps-2", "10.20.0.255", ErrStaticIPReserved},
+		{"gateway", "org-a", "vps-2", "10.20.0.1", ErrStaticIPReserved},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReserveVPSStaticIP(ctx, db, tt.orgID, tt.vpsID, tt.ip); !errors.Is(err, tt.want) {
+				t.Fatalf("ReserveVPSStaticIP(%s, %s) = %v, want %v", tt.orgID, tt.ip, err, tt.want)
+			}
+		})
+	}
+
+	// Another organization can't take an IP of org-a even by asking for it directly
+	if _, err := ReserveVPSStaticIP(ctx, db, "org-b", "vps-3", "10.20.0.10"); !errors.Is(err, ErrStaticIPNotAllocated) {
+		t.Fatalf("org-b reserving org-a's IP = %v, want %v", err, ErrStaticIPNotAllocated)
+	}
+
+	// A released IP can be reused
+	if err := ReleaseVPSStaticIP(ctx, db, "vps-1"); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	if _, err := ReserveVPSStaticIP(ctx, db, "org-a", "vps-2", "10.20.0.10"); err != nil {
+		t.Fatalf("reserve released IP: %v", err)
+	}
+}
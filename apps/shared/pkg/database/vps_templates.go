@@ -0,0 +1,41 @@
+package database
+
+import (
+	"fmt"
+)
+
+// GetVPSTemplate returns a VPS template owned by the given organization
+func GetVPSTemplate(orgID, templateID string) (*VPSTemplate, error) {
+	var tmpl VPSTemplate
+	if err := DB.Where("id = ? AND organization_id = ?", templateID, orgID).First(&tmpl).Error; err != nil {
+		return nil, err
+	}
+	return &tmpl, nil
+}
+
+// ListVPSTemplates returns the VPS templates owned by the given organization, newest first
+func ListVPSTemplates(orgID string) ([]VPSTemplate, error) {
+	var templates []VPSTemplate
+	if err := DB.Where("organization_id = ?", orgID).Order("created_at DESC").Find(&templates).Error; err != nil {
+		return nil, fmt.Errorf("failed to list VPS templates: %w", err)
+	}
+	return templates, nil
+}
+
+// CreateVPSTemplate stores a new VPS template
+func CreateVPSTemplate(tmpl *VPSTemplate) error {
+	if err := DB.Create(tmpl).Error; err != nil {
+		return fmt.Errorf("failed to create VPS template: %w", err)
+	}
+	return nil
+}
+
+// DeleteVPSTemplate deletes a VPS template owned by the given organization
+// Returns false if no matching template exists
+func DeleteVPSTemplate(orgID, templateID string) (bool, error) {
+	result := DB.Where("id = ? AND organization_id = ?", templateID, orgID).Delete(&VPSTemplate{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete VPS template: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -15,6 +15,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/moby/moby/api/pkg/stdcopy"
 	"github.com/moby/moby/api/types/container"
 	"github.com/moby/moby/api/types/events"
 	"github.com/moby/moby/api/types/network"
@@ -556,6 +557,51 @@ func (c *Client) ContainerExecRunInputEnv(ctx context.Context, containerID strin
 	return stdout.String(), nil
 }
 
+// ContainerExecStream runs a command in the container and streams its stdout to w,
+// so large outputs such as archives are never buffered. Stderr is included in errors.
+func (c *Client) ContainerExecStream(ctx context.Context, containerID string, cmd []string, w io.Writer) error {
+	if c == nil || c.api == nil {
+		return ErrUninitialized
+	}
+
+	execIDResp, err := c.api.ExecCreate(ctx, containerID, client.ExecCreateOptions{
+		Cmd:          cmd,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return fmt.Errorf("create exec: %w", err)
+	}
+
+	attachResp, err := c.api.ExecAttach(ctx, execIDResp.ID, client.ExecAttachOptions{})
+	if err != nil {
+		return fmt.Errorf("attach exec: %w", err)
+	}
+	defer attachResp.Close()
+
+	if _, err := c.api.ExecStart(ctx, execIDResp.ID, client.ExecStartOptions{Detach: false}); err != nil {
+		return fmt.Errorf("start exec: %w", err)
+	}
+
+	var stderr bytes.Buffer
+	if _, err := stdcopy.StdCopy(w, &stderr, attachResp.Reader); err != nil {
+		return fmt.Errorf("read output: %w", err)
+	}
+
+	inspect, err := c.api.ExecInspect(ctx, execIDResp.ID, client.ExecInspectOptions{})
+	if err != nil {
+		return fmt.Errorf("inspect exec: %w", err)
+	}
+	if inspect.ExitCode != 0 {
+		errMsg := fmt.Sprintf("command %q failed with exit code %d", strings.Join(cmd, " "), inspect.ExitCode)
+		if stderr.Len() > 0 {
+			errMsg += ": " + stderr.String()
+		}
+		return fmt.Errorf("%s", errMsg)
+	}
+	return nil
+}
+
 // ContainerListFiles lists files in a directory using ls command
 // If container is stopped, it temporarily starts it, performs the operation, then stops it again
 func (c *Client) ContainerListFiles(ctx context.Context, containerID, path string) ([]FileInfo, error) {
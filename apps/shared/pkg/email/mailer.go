@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
@@ -37,6 +38,15 @@ type Message struct {
 	Headers  map[string]string
 	Category Category
 	Metadata map[string]string
+	// Attachments are sent as additional MIME parts alongside the body.
+	Attachments []Attachment
+}
+
+// Attachment is a file attached to an email message.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
 }
 
 // SMTPConfig contains the configuration needed to deliver email via SMTP.
@@ -299,6 +309,19 @@ func composeMessage(cfg SMTPConfig, msg *Message) ([]byte, error) {
 		buf.WriteString("\r\n")
 	}
 
+	// With attachments the alternative bodies are nested inside a multipart/mixed envelope.
+	mixedBoundary := ""
+	if len(msg.Attachments) > 0 {
+		mixedBoundary = boundary
+		boundary = fmt.Sprintf("alt-%s", uuid.NewString())
+		buf.WriteString("Content-Type: multipart/mixed; boundary=")
+		buf.WriteString(mixedBoundary)
+		buf.WriteString("\r\n\r\n")
+		buf.WriteString("--")
+		buf.WriteString(mixedBoundary)
+		buf.WriteString("\r\n")
+	}
+
 	buf.WriteString("Content-Type: multipart/alternative; boundary=")
 	buf.WriteString(boundary)
 	buf.WriteString("\r\n\r\n")
@@ -335,9 +358,47 @@ func composeMessage(cfg SMTPConfig, msg *Message) ([]byte, error) {
 	buf.WriteString(boundary)
 	buf.WriteString("--\r\n")
 
+	if mixedBoundary != "" {
+		for _, attachment := range msg.Attachments {
+			writeAttachment(&buf, mixedBoundary, attachment)
+		}
+		buf.WriteString("--")
+		buf.WriteString(mixedBoundary)
+		buf.WriteString("--\r\n")
+	}
+
 	return buf.Bytes(), nil
 }
 
+func writeAttachment(buf *bytes.Buffer, boundary string, attachment Attachment) {
+	contentType := attachment.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	filename := mime.QEncoding.Encode("utf-8", attachment.Filename)
+
+	buf.WriteString("--")
+	buf.WriteString(boundary)
+	buf.WriteString("\r\n")
+	buf.WriteString("Content-Type: ")
+	buf.WriteString(mime.FormatMediaType(contentType, map[string]string{"name": filename}))
+	buf.WriteString("\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	buf.WriteString("Content-Disposition: ")
+	buf.WriteString(mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	buf.WriteString("\r\n\r\n")
+
+	// RFC 2045 limits base64 lines to 76 characters
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76])
+		buf.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded)
+	buf.WriteString("\r\n")
+}
+
 func normalizeNewlines(input string) string {
 	if input == "" {
 		return ""
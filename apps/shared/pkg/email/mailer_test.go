@@ -0,0 +1,93 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestComposeMessageWithAttachment(t *testing.T) {
+	cfg := SMTPConfig{Host: "smtp.example.com", FromAddress: "billing@example.com"}
+	pdf := bytes.Repeat([]byte("%PDF-1.3 invoice "), 20)
+	msg := &Message{
+		To:       []string{"owner@example.com"},
+		Subject:  "Your invoice",
+		TextBody: "See attached.",
+		HTMLBody: "<p>See attached.</p>",
+		Attachments: []Attachment{
+			{Filename: "invoice-2026-09.pdf", ContentType: "application/pdf", Data: pdf},
+		},
+	}
+
+	raw, err := composeMessage(cfg, msg)
+	if err != nil {
+		t.Fatalf("composeMessage: %v", err)
+	}
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/mixed" {
+		t.Fatalf("content type = %q (%v), want multipart/mixed", mediaType, err)
+	}
+
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+
+	body, err := reader.NextPart()
+	if err != nil {
+		t.Fatalf("read body part: %v", err)
+	}
+	if bodyType, _, _ := mime.ParseMediaType(body.Header.Get("Content-Type")); bodyType != "multipart/alternative" {
+		t.Fatalf("first part = %q, want multipart/alternative", bodyType)
+	}
+
+	attachment, err := reader.NextPart()
+	if err != nil {
+		t.Fatalf("read attachment part: %v", err)
+	}
+	if got := attachment.FileName(); got != "invoice-2026-09.pdf" {
+		t.Fatalf("attachment filename = %q", got)
+	}
+	encoded, err := io.ReadAll(attachment)
+	if err != nil {
+		t.Fatalf("read attachment: %v", err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(encoded)), "\r\n") {
+		if len(line) > 76 {
+			t.Fatalf("base64 line longer than 76 characters: %d", len(line))
+		}
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(encoded), "\r\n", ""))
+	if err != nil {
+		t.Fatalf("decode attachment: %v", err)
+	}
+	if !bytes.Equal(decoded, pdf) {
+		t.Fatal("attachment data does not round-trip")
+	}
+
+	if _, err := reader.NextPart(); err != io.EOF {
+		t.Fatalf("expected end of message, got %v", err)
+	}
+}
+
+func TestComposeMessageWithoutAttachmentsIsAlternative(t *testing.T) {
+	cfg := SMTPConfig{Host: "smtp.example.com", FromAddress: "billing@example.com"}
+	raw, err := composeMessage(cfg, &Message{To: []string{"owner@example.com"}, Subject: "Hi", TextBody: "Hello"})
+	if err != nil {
+		t.Fatalf("composeMessage: %v", err)
+	}
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	if mediaType, _, _ := mime.ParseMediaType(parsed.Header.Get("Content-Type")); mediaType != "multipart/alternative" {
+		t.Fatalf("content type = %q, want multipart/alternative", mediaType)
+	}
+}
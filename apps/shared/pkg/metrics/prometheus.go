@@ -221,6 +221,59 @@ var (
 		[]string{"deployment_id"},
 	)
 
+	// DNS server metrics
+	dnsQueriesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dns_queries_total",
+			Help: "Total number of DNS questions received",
+		},
+		[]string{"qtype", "zone"},
+	)
+
+	dnsCacheHits = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dns_cache_hits_total",
+			Help: "Total number of DNS lookups answered from the cache",
+		},
+	)
+
+	dnsCacheMisses = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dns_cache_misses_total",
+			Help: "Total number of DNS lookups not found in the cache",
+		},
+	)
+
+	dnsWildcardCacheHits = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dns_wildcard_cache_hits_total",
+			Help: "Total number of wildcard DNS record lookups answered from the cache",
+		},
+	)
+
+	dnsWildcardCacheMisses = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dns_wildcard_cache_misses_total",
+			Help: "Total number of wildcard DNS record lookups not found in the cache",
+		},
+	)
+
+	dnsDelegationLookups = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dns_delegation_lookups_total",
+			Help: "Total number of delegated DNS record lookups",
+		},
+		[]string{"record_type", "result"},
+	)
+
+	dnsQueryDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "dns_query_duration_seconds",
+			Help:    "DNS request handling duration in seconds",
+			Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		},
+	)
+
 	// DNS Delegation metrics
 	dnsDelegationRecordsPushed = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -466,3 +519,42 @@ func RecordDNSDelegationPushError(organizationID, apiKeyID, errorType string) {
 	dnsDelegationPushErrors.WithLabelValues(orgID, keyID, et).Inc()
 }
 
+// RecordDNSQuery records a DNS question by query type and zone
+func RecordDNSQuery(qtype, zone string) {
+	if qtype == "" {
+		qtype = "unknown"
+	}
+	dnsQueriesTotal.WithLabelValues(qtype, zone).Inc()
+}
+
+// ObserveDNSQueryDuration records how long a DNS request took to answer
+func ObserveDNSQueryDuration(duration time.Duration) {
+	dnsQueryDuration.Observe(duration.Seconds())
+}
+
+// RecordDNSCacheLookup records a DNS cache hit or miss
+func RecordDNSCacheLookup(hit bool) {
+	if hit {
+		dnsCacheHits.Inc()
+	} else {
+		dnsCacheMisses.Inc()
+	}
+}
+
+// RecordDNSWildcardCacheLookup records a wildcard DNS record cache hit or miss
+func RecordDNSWildcardCacheLookup(hit bool) {
+	if hit {
+		dnsWildcardCacheHits.Inc()
+	} else {
+		dnsWildcardCacheMisses.Inc()
+	}
+}
+
+// RecordDNSDelegationLookup records a delegated DNS record lookup and whether a record was found
+func RecordDNSDelegationLookup(recordType string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	dnsDelegationLookups.WithLabelValues(recordType, result).Inc()
+}
@@ -0,0 +1,185 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// activityProcedure describes how a successful call of an RPC appears in the organization activity feed
+type activityProcedure struct {
+	resourceType    string
+	operation       string   // "created", "updated" or "deleted"
+	requestIDField  string   // Request field holding the resource ID
+	responseIDField string   // Dotted response field path holding the ID of a created resource
+	metadataFields  []string // Request fields copied into the event metadata
+}
+
+// activityProcedures are the RPCs recorded in the organization activity feed, keyed by procedure
+var activityProcedures = map[string]activityProcedure{
+	"/obiente.cloud.deployments.v1.DeploymentService/CreateDeployment": {resourceType: "deployment", operation: "created", responseIDField: "deployment.id", metadataFields: []string{"name", "environment"}},
+	"/obiente.cloud.deployments.v1.DeploymentService/UpdateDeployment": {resourceType: "deployment", operation: "updated", requestIDField: "deployment_id", metadataFields: []string{"name"}},
+	"/obiente.cloud.deployments.v1.DeploymentService/DeleteDeployment": {resourceType: "deployment", operation: "deleted", requestIDField: "deployment_id"},
+
+	"/obiente.cloud.gameservers.v1.GameServerService/CreateGameServer": {resourceType: "game_server", operation: "created", responseIDField: "game_server.id", metadataFields: []string{"name", "game_type"}},
+	"/obiente.cloud.gameservers.v1.GameServerService/UpdateGameServer": {resourceType: "game_server", operation: "updated", requestIDField: "game_server_id", metadataFields: []string{"name"}},
+	"/obiente.cloud.gameservers.v1.GameServerService/DeleteGameServer": {resourceType: "game_server", operation: "deleted", requestIDField: "game_server_id"},
+
+	"/obiente.cloud.vps.v1.VPSService/CreateVPS": {resourceType: "vps", operation: "created", responseIDField: "vps.id", metadataFields: []string{"name", "region"}},
+	"/obiente.cloud.vps.v1.VPSService/UpdateVPS": {resourceType: "vps", operation: "updated", requestIDField: "vps_id", metadataFields: []string{"name"}},
+	"/obiente.cloud.vps.v1.VPSService/DeleteVPS": {resourceType: "vps", operation: "deleted", requestIDField: "vps_id"},
+
+	"/obiente.cloud.organizations.v1.OrganizationService/InviteMember": {resourceType: "member", operation: "created", responseIDField: "member.id", metadataFields: []string{"email", "role"}},
+	"/obiente.cloud.organizations.v1.OrganizationService/UpdateMember": {resourceType: "member", operation: "updated", requestIDField: "member_id", metadataFields: []string{"role"}},
+	"/obiente.cloud.organizations.v1.OrganizationService/RemoveMember": {resourceType: "member", operation: "deleted", requestIDField: "member_id"},
+
+	"/obiente.cloud.billing.v1.BillingService/UpdateBillingAccount":    {resourceType: "billing_account", operation: "updated", requestIDField: "organization_id"},
+	"/obiente.cloud.billing.v1.BillingService/AttachPaymentMethod":     {resourceType: "payment_method", operation: "created", requestIDField: "payment_method_id"},
+	"/obiente.cloud.billing.v1.BillingService/SetDefaultPaymentMethod": {resourceType: "payment_method", operation: "updated", requestIDField: "payment_method_id"},
+	"/obiente.cloud.billing.v1.BillingService/DetachPaymentMethod":     {resourceType: "payment_method", operation: "deleted", requestIDField: "payment_method_id"},
+	"/obiente.cloud.billing.v1.BillingService/CreateSpendAlert":        {resourceType: "spend_alert", operation: "created", responseIDField: "alert.id", metadataFields: []string{"threshold_cents", "alert_type"}},
+	"/obiente.cloud.billing.v1.BillingService/DeleteSpendAlert":        {resourceType: "spend_alert", operation: "deleted", requestIDField: "alert_id"},
+}
+
+// recordActivityEvent writes the organization activity event for a successful call in a
+// fire-and-forget goroutine. Procedures the feed doesn't cover are ignored; a failed write only
+// loses the event.
+func recordActivityEvent(procedure string, req connect.AnyRequest, resp connect.AnyResponse, actorID, orgID string) {
+	if _, ok := activityProcedures[procedure]; !ok {
+		return
+	}
+
+	var respMsg any
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				// resp is a typed nil - created resources are recorded without an ID
+			}
+		}()
+		if resp != nil {
+			respMsg = resp.Any()
+		}
+	}()
+
+	event, ok := activityEventFor(procedure, req.Any(), respMsg, actorID, orgID)
+	if !ok {
+		return
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("[Activity] Panic writing activity event %s for organization %s: %v", event.EventType, orgID, r)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := createActivityEvent(ctx, event); err != nil {
+			logger.Error("[Activity] Failed to write activity event %s for organization %s: %v", event.EventType, orgID, err)
+		}
+	}()
+}
+
+// activityEventFor builds the activity event for a successful call of procedure with the given
+// request and response messages. It reports false if the feed doesn't cover the procedure.
+func activityEventFor(procedure string, req, resp any, actorID, orgID string) (database.OrgActivityEvent, bool) {
+	activity, ok := activityProcedures[procedure]
+	if !ok || orgID == "" {
+		return database.OrgActivityEvent{}, false
+	}
+
+	reqFields := activityMessageFields(req)
+
+	var resourceID *string
+	if activity.requestIDField != "" {
+		resourceID = activityFieldString(reqFields, activity.requestIDField)
+	} else if activity.responseIDField != "" {
+		resourceID = activityFieldString(activityMessageFields(resp), activity.responseIDField)
+	}
+
+	_, action := parseProcedure(procedure)
+	metadata := map[string]string{"action": action}
+	for _, field := range activity.metadataFields {
+		if value := activityFieldString(reqFields, field); value != nil {
+			metadata[field] = *value
+		}
+	}
+	metadataJSON, _ := json.Marshal(metadata) // A map of strings always marshals
+
+	return database.OrgActivityEvent{
+		ID:             uuid.New().String(),
+		OrganizationID: orgID,
+		EventType:      activity.resourceType + "." + activity.operation,
+		ActorID:        actorID,
+		ResourceType:   activity.resourceType,
+		ResourceID:     resourceID,
+		Metadata:       string(metadataJSON),
+		CreatedAt:      time.Now(),
+	}, true
+}
+
+func createActivityEvent(ctx context.Context, event database.OrgActivityEvent) error {
+	if database.DB == nil {
+		logger.Debug("[Activity] Skipping activity event %s: database not initialized", event.EventType)
+		return nil
+	}
+	if err := database.DB.WithContext(ctx).Create(&event).Error; err != nil {
+		return fmt.Errorf("failed to create activity event: %w", err)
+	}
+	return nil
+}
+
+// activityMessageFields returns the fields of a proto message keyed by their proto names
+func activityMessageFields(msg any) map[string]any {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok || protoMsg == nil {
+		return nil
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(protoMsg)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+// activityFieldString returns the scalar value at a dotted field path, or nil if it is unset
+func activityFieldString(fields map[string]any, path string) *string {
+	var value any = fields
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case float64, bool:
+		s = fmt.Sprint(v)
+	default:
+		return nil
+	}
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,245 @@
+package middleware
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	billingv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestActivityEventForSupportedProcedures(t *testing.T) {
+	name := "renamed"
+	role := "admin"
+	tests := []struct {
+		procedure    string
+		req, resp    proto.Message
+		eventType    string
+		resourceType string
+		resourceID   string
+		metadata     map[string]string
+	}{
+		{
+			procedure: "/obiente.cloud.deployments.v1.DeploymentService/CreateDeployment",
+			req:       &deploymentsv1.CreateDeploymentRequest{OrganizationId: "org-a", Name: "web"},
+			resp:      &deploymentsv1.CreateDeploymentResponse{Deployment: &deploymentsv1.Deployment{Id: "deploy-1"}},
+			eventType: "deployment.created", resourceType: "deployment", resourceID: "deploy-1",
+			metadata: map[string]string{"action": "CreateDeployment", "name": "web"},
+		},
+		{
+			procedure: "/obiente.cloud.deployments.v1.DeploymentService/UpdateDeployment",
+			req:       &deploymentsv1.UpdateDeploymentRequest{OrganizationId: "org-a", DeploymentId: "deploy-1", Name: &name},
+			resp:      &deploymentsv1.UpdateDeploymentResponse{},
+			eventType: "deployment.updated", resourceType: "deployment", resourceID: "deploy-1",
+			metadata: map[string]string{"action": "UpdateDeployment", "name": "renamed"},
+		},
+		{
+			procedure: "/obiente.cloud.deployments.v1.DeploymentService/DeleteDeployment",
+			req:       &deploymentsv1.DeleteDeploymentRequest{OrganizationId: "org-a", DeploymentId: "deploy-1"},
+			resp:      &deploymentsv1.DeleteDeploymentResponse{Success: true},
+			eventType: "deployment.deleted", resourceType: "deployment", resourceID: "deploy-1",
+			metadata: map[string]string{"action": "DeleteDeployment"},
+		},
+		{
+			procedure: "/obiente.cloud.gameservers.v1.GameServerService/CreateGameServer",
+			req:       &gameserversv1.CreateGameServerRequest{OrganizationId: "org-a", Name: "survival"},
+			resp:      &gameserversv1.CreateGameServerResponse{GameServer: &gameserversv1.GameServer{Id: "gs-1"}},
+			eventType: "game_server.created", resourceType: "game_server", resourceID: "gs-1",
+			metadata: map[string]string{"action": "CreateGameServer", "name": "survival"},
+		},
+		{
+			procedure: "/obiente.cloud.gameservers.v1.GameServerService/UpdateGameServer",
+			req:       &gameserversv1.UpdateGameServerRequest{GameServerId: "gs-1", Name: &name},
+			resp:      &gameserversv1.UpdateGameServerResponse{},
+			eventType: "game_server.updated", resourceType: "game_server", resourceID: "gs-1",
+			metadata: map[string]string{"action": "UpdateGameServer", "name": "renamed"},
+		},
+		{
+			procedure: "/obiente.cloud.gameservers.v1.GameServerService/DeleteGameServer",
+			req:       &gameserversv1.DeleteGameServerRequest{GameServerId: "gs-1"},
+			resp:      &gameserversv1.DeleteGameServerResponse{},
+			eventType: "game_server.deleted", resourceType: "game_server", resourceID: "gs-1",
+			metadata: map[string]string{"action": "DeleteGameServer"},
+		},
+		{
+			procedure: "/obiente.cloud.vps.v1.VPSService/CreateVPS",
+			req:       &vpsv1.CreateVPSRequest{OrganizationId: "org-a", Name: "db", Region: "eu-west-1"},
+			resp:      &vpsv1.CreateVPSResponse{Vps: &vpsv1.VPSInstance{Id: "vps-1"}},
+			eventType: "vps.created", resourceType: "vps", resourceID: "vps-1",
+			metadata: map[string]string{"action": "CreateVPS", "name": "db", "region": "eu-west-1"},
+		},
+		{
+			procedure: "/obiente.cloud.vps.v1.VPSService/UpdateVPS",
+			req:       &vpsv1.UpdateVPSRequest{OrganizationId: "org-a", VpsId: "vps-1", Name: &name},
+			resp:      &vpsv1.UpdateVPSResponse{},
+			eventType: "vps.updated", resourceType: "vps", resourceID: "vps-1",
+			metadata: map[string]string{"action": "UpdateVPS", "name": "renamed"},
+		},
+		{
+			procedure: "/obiente.cloud.vps.v1.VPSService/DeleteVPS",
+			req:       &vpsv1.DeleteVPSRequest{OrganizationId: "org-a", VpsId: "vps-1"},
+			resp:      &vpsv1.DeleteVPSResponse{},
+			eventType: "vps.deleted", resourceType: "vps", resourceID: "vps-1",
+			metadata: map[string]string{"action": "DeleteVPS"},
+		},
+		{
+			procedure: "/obiente.cloud.organizations.v1.OrganizationService/InviteMember",
+			req:       &organizationsv1.InviteMemberRequest{OrganizationId: "org-a", Email: "new@example.com", Role: "member"},
+			resp:      &organizationsv1.InviteMemberResponse{Member: &organizationsv1.OrganizationMember{Id: "member-1"}},
+			eventType: "member.created", resourceType: "member", resourceID: "member-1",
+			metadata: map[string]string{"action": "InviteMember", "email": "new@example.com", "role": "member"},
+		},
+		{
+			procedure: "/obiente.cloud.organizations.v1.OrganizationService/UpdateMember",
+			req:       &organizationsv1.UpdateMemberRequest{OrganizationId: "org-a", MemberId: "member-1", Role: &role},
+			resp:      &organizationsv1.UpdateMemberResponse{},
+			eventType: "member.updated", resourceType: "member", resourceID: "member-1",
+			metadata: map[string]string{"action": "UpdateMember", "role": "admin"},
+		},
+		{
+			procedure: "/obiente.cloud.organizations.v1.OrganizationService/RemoveMember",
+			req:       &organizationsv1.RemoveMemberRequest{OrganizationId: "org-a", MemberId: "member-1"},
+			resp:      &organizationsv1.RemoveMemberResponse{},
+			eventType: "member.deleted", resourceType: "member", resourceID: "member-1",
+			metadata: map[string]string{"action": "RemoveMember"},
+		},
+		{
+			procedure: "/obiente.cloud.billing.v1.BillingService/UpdateBillingAccount",
+			req:       &billingv1.UpdateBillingAccountRequest{OrganizationId: "org-a"},
+			resp:      &billingv1.UpdateBillingAccountResponse{},
+			eventType: "billing_account.updated", resourceType: "billing_account", resourceID: "org-a",
+			metadata: map[string]string{"action": "UpdateBillingAccount"},
+		},
+		{
+			procedure: "/obiente.cloud.billing.v1.BillingService/AttachPaymentMethod",
+			req:       &billingv1.AttachPaymentMethodRequest{OrganizationId: "org-a", PaymentMethodId: "pm-1"},
+			resp:      &billingv1.AttachPaymentMethodResponse{},
+			eventType: "payment_method.created", resourceType: "payment_method", resourceID: "pm-1",
+			metadata: map[string]string{"action": "AttachPaymentMethod"},
+		},
+		{
+			procedure: "/obiente.cloud.billing.v1.BillingService/SetDefaultPaymentMethod",
+			req:       &billingv1.SetDefaultPaymentMethodRequest{OrganizationId: "org-a", PaymentMethodId: "pm-1"},
+			resp:      &billingv1.SetDefaultPaymentMethodResponse{Success: true},
+			eventType: "payment_method.updated", resourceType: "payment_method", resourceID: "pm-1",
+			metadata: map[string]string{"action": "SetDefaultPaymentMethod"},
+		},
+		{
+			procedure: "/obiente.cloud.billing.v1.BillingService/DetachPaymentMethod",
+			req:       &billingv1.DetachPaymentMethodRequest{OrganizationId: "org-a", PaymentMethodId: "pm-1"},
+			resp:      &billingv1.DetachPaymentMethodResponse{Success: true},
+			eventType: "payment_method.deleted", resourceType: "payment_method", resourceID: "pm-1",
+			metadata: map[string]string{"action": "DetachPaymentMethod"},
+		},
+		{
+			procedure: "/obiente.cloud.billing.v1.BillingService/CreateSpendAlert",
+			req:       &billingv1.CreateSpendAlertRequest{OrganizationId: "org-a", ThresholdCents: 5000, AlertType: "email"},
+			resp:      &billingv1.CreateSpendAlertResponse{Alert: &billingv1.SpendAlert{Id: "alert-1"}},
+			eventType: "spend_alert.created", resourceType: "spend_alert", resourceID: "alert-1",
+			metadata: map[string]string{"action": "CreateSpendAlert", "threshold_cents": "5000", "alert_type": "email"},
+		},
+		{
+			procedure: "/obiente.cloud.billing.v1.BillingService/DeleteSpendAlert",
+			req:       &billingv1.DeleteSpendAlertRequest{OrganizationId: "org-a", AlertId: "alert-1"},
+			resp:      &billingv1.DeleteSpendAlertResponse{Success: true},
+			eventType: "spend_alert.deleted", resourceType: "spend_alert", resourceID: "alert-1",
+			metadata: map[string]string{"action": "DeleteSpendAlert"},
+		},
+	}
+	if len(tests) != len(activityProcedures) {
+		t.Fatalf("%d cases for %d activity procedures, every procedure needs a case", len(tests), len(activityProcedures))
+	}
+
+	for _, tt := range tests {
+		_, action := parseProcedure(tt.procedure)
+		t.Run(action, func(t *testing.T) {
+			event, ok := activityEventFor(tt.procedure, tt.req, tt.resp, "user-1", "org-a")
+			if !ok {
+				t.Fatalf("no activity event for %s", tt.procedure)
+			}
+			if event.ID == "" || event.OrganizationID != "org-a" || event.ActorID != "user-1" {
+				t.Fatalf("event id/org/actor = %q/%q/%q", event.ID, event.OrganizationID, event.ActorID)
+			}
+			if event.EventType != tt.eventType || event.ResourceType != tt.resourceType {
+				t.Fatalf("event type = %q on %q, want %q on %q", event.EventType, event.ResourceType, tt.eventType, tt.resourceType)
+			}
+			if event.ResourceID == nil || *event.ResourceID != tt.resourceID {
+				t.Fatalf("resource id = %v, want %q", event.ResourceID, tt.resourceID)
+			}
+			var metadata map[string]string
+			if err := json.Unmarshal([]byte(event.Metadata), &metadata); err != nil {
+				t.Fatalf("metadata %q: %v", event.Metadata, err)
+			}
+			if len(metadata) != len(tt.metadata) {
+				t.Fatalf("metadata = %v, want %v", metadata, tt.metadata)
+			}
+			for key, want := range tt.metadata {
+				if metadata[key] != want {
+					t.Fatalf("metadata = %v, want %v", metadata, tt.metadata)
+				}
+			}
+		})
+	}
+}
+
+func TestActivityEventForSkipsUncoveredCalls(t *testing.T) {
+	req := &deploymentsv1.DeleteDeploymentRequest{OrganizationId: "org-a", DeploymentId: "deploy-1"}
+
+	if _, ok := activityEventFor("/obiente.cloud.deployments.v1.DeploymentService/StartDeployment", req, nil, "user-1", "org-a"); ok {
+		t.Fatal("StartDeployment is not part of the activity feed")
+	}
+	if _, ok := activityEventFor("/obiente.cloud.deployments.v1.DeploymentService/DeleteDeployment", req, nil, "user-1", ""); ok {
+		t.Fatal("calls without an organization have no feed to go to")
+	}
+
+	// A create whose response carries no resource is still recorded
+	event, ok := activityEventFor("/obiente.cloud.vps.v1.VPSService/CreateVPS", &vpsv1.CreateVPSRequest{OrganizationId: "org-a"}, (*vpsv1.CreateVPSResponse)(nil), "user-1", "org-a")
+	if !ok || event.ResourceID != nil {
+		t.Fatalf("event = %+v (%v), want vps.created without a resource id", event, ok)
+	}
+}
+
+func TestRecordActivityEventWritesInBackground(t *testing.T) {
+	dbName := "file:" + strings.ReplaceAll(t.Name(), "/", "_") + "?mode=memory&cache=shared"
+	db, err := gorm.Open(sqlite.Open(dbName), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(&database.OrgActivityEvent{}); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+	previousDB := database.DB
+	database.DB = db
+	t.Cleanup(func() { database.DB = previousDB })
+
+	recordActivityEvent("/obiente.cloud.deployments.v1.DeploymentService/CreateDeployment",
+		connect.NewRequest(&deploymentsv1.CreateDeploymentRequest{OrganizationId: "org-a", Name: "web"}),
+		connect.NewResponse(&deploymentsv1.CreateDeploymentResponse{Deployment: &deploymentsv1.Deployment{Id: "deploy-1"}}),
+		"user-1", "org-a")
+	recordActivityEvent("/obiente.cloud.deployments.v1.DeploymentService/StartDeployment",
+		connect.NewRequest(&deploymentsv1.StartDeploymentRequest{OrganizationId: "org-a", DeploymentId: "deploy-1"}),
+		connect.NewResponse(&deploymentsv1.StartDeploymentResponse{}),
+		"user-1", "org-a")
+
+	var events []database.OrgActivityEvent
+	deadline := time.Now().Add(2 * time.Second)
+	for len(events) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		if err := db.Find(&events).Error; err != nil {
+			t.Fatalf("list activity events: %v", err)
+		}
+	}
+	if len(events) != 1 || events[0].EventType != "deployment.created" || events[0].ResourceID == nil || *events[0].ResourceID != "deploy-1" {
+		t.Fatalf("activity events = %+v, want only deployment.created for deploy-1", events)
+	}
+}
@@ -44,7 +44,7 @@ func AuditLogInterceptor() connect.UnaryInterceptorFunc {
 			}
 
 			// Extract IP address and user agent (before calling next)
-			ipAddress := getClientIP(req)
+			ipAddress := GetRequestClientIP(req)
 
 			userAgent := req.Header().Get("User-Agent")
 			if userAgent == "" {
@@ -87,6 +87,9 @@ func AuditLogInterceptor() connect.UnaryInterceptorFunc {
 			// The context chain should allow us to access the user via context.Value().
 			var userID string = "system"
 
+			// Handlers report field-level changes through RecordAuditDiff
+			ctx, diffHolder := withAuditDiffHolder(ctx)
+
 			resp, err := next(ctx, req)
 
 			// Try to extract user from context - the auth interceptor should have set it
@@ -152,6 +155,11 @@ func AuditLogInterceptor() connect.UnaryInterceptorFunc {
 				responseStatus = 200 // Success
 			}
 
+			// Successful changes to an organization's resources also go to its activity feed
+			if err == nil && orgID != nil && *orgID != "" {
+				recordActivityEvent(procedure, req, resp, userID, *orgID)
+			}
+
 			// Queue audit log work on a bounded async worker pool so requests do not
 			// spawn an unbounded number of goroutines under load.
 			logOrgID := orgID
@@ -181,6 +189,7 @@ func AuditLogInterceptor() connect.UnaryInterceptorFunc {
 				ResponseStatus: responseStatus,
 				ErrorMessage:   errorMessage,
 				DurationMs:     duration.Milliseconds(),
+				AuditDiff:      encodeAuditDiff(diffHolder.changes),
 			})
 
 			return resp, err
@@ -257,6 +266,7 @@ type auditLogData struct {
 	ResponseStatus int32
 	ErrorMessage   *string
 	DurationMs     int64
+	AuditDiff      *string
 }
 
 func createAuditLog(ctx context.Context, data auditLogData) error {
@@ -285,6 +295,7 @@ func createAuditLog(ctx context.Context, data auditLogData) error {
 		ResponseStatus: data.ResponseStatus,
 		ErrorMessage:   data.ErrorMessage,
 		DurationMs:     data.DurationMs,
+		AuditDiff:      data.AuditDiff,
 		CreatedAt:      time.Now(),
 	}
 
@@ -367,6 +378,7 @@ type AuditEntry struct {
 	ResponseStatus int32
 	ErrorMessage   *string
 	DurationMs     int64
+	AuditDiff      []FieldChange // Field-level changes, see AuditDiff
 }
 
 // CreateAuditLog creates an audit log entry using the same internal path
@@ -386,6 +398,7 @@ func CreateAuditLog(ctx context.Context, entry AuditEntry) error {
 		ResponseStatus: entry.ResponseStatus,
 		ErrorMessage:   entry.ErrorMessage,
 		DurationMs:     entry.DurationMs,
+		AuditDiff:      encodeAuditDiff(entry.AuditDiff),
 	}
 
 	// Log that we're creating an audit entry so it's visible in service logs
@@ -881,10 +894,10 @@ func sanitizeMap(data map[string]interface{}, sensitiveFields []string) {
 	}
 }
 
-// getClientIP extracts the client IP address from the request
+// GetRequestClientIP extracts the client IP address from the request
 // It checks multiple headers in order of preference to get the real client IP
 // Traefik is configured with forwardedHeaders middleware to properly forward the real client IP
-func getClientIP(req connect.AnyRequest) string {
+func GetRequestClientIP(req connect.AnyRequest) string {
 	// Try X-Obiente-Client-IP (set by API gateway — most reliable canonical header)
 	if clientIP := req.Header().Get("X-Obiente-Client-IP"); clientIP != "" {
 		return strings.TrimSpace(clientIP)
@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldChange is one changed field between two versions of a protobuf message.
+// Field is the dotted proto field path; Old and New are the protojson values (absent when unset).
+type FieldChange struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old,omitempty"`
+	New   json.RawMessage `json:"new,omitempty"`
+}
+
+type auditDiffKey struct{}
+
+// auditDiffHolder lets a handler hand its diff back to the audit interceptor wrapping it
+type auditDiffHolder struct {
+	changes []FieldChange
+}
+
+// AuditDiff compares two messages of the same type field by field and returns the fields that changed.
+// Each field is compared by its deterministic wire encoding, and singular message fields are
+// descended into so only the leaf fields that actually changed are reported.
+func AuditDiff(oldMsg, newMsg proto.Message) []FieldChange {
+	if oldMsg == nil || newMsg == nil {
+		return nil
+	}
+	oldRef, newRef := oldMsg.ProtoReflect(), newMsg.ProtoReflect()
+	if oldRef.Descriptor().FullName() != newRef.Descriptor().FullName() {
+		return nil
+	}
+	return diffMessages("", oldRef, newRef)
+}
+
+// RecordAuditDiff attaches the change between oldMsg and newMsg to the audit log entry of the
+// current request. It is a no-op outside the audit interceptor or when nothing changed.
+func RecordAuditDiff(ctx context.Context, oldMsg, newMsg proto.Message) {
+	holder, ok := ctx.Value(auditDiffKey{}).(*auditDiffHolder)
+	if !ok {
+		return
+	}
+	holder.changes = append(holder.changes, AuditDiff(oldMsg, newMsg)...)
+}
+
+func withAuditDiffHolder(ctx context.Context) (context.Context, *auditDiffHolder) {
+	holder := &auditDiffHolder{}
+	return context.WithValue(ctx, auditDiffKey{}, holder), holder
+}
+
+// encodeAuditDiff renders changes for the audit_diff column; nil when there is nothing to store
+func encodeAuditDiff(changes []FieldChange) *string {
+	if len(changes) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return nil
+	}
+	encoded := string(data)
+	return &encoded
+}
+
+func diffMessages(prefix string, oldMsg, newMsg protoreflect.Message) []FieldChange {
+	var changes []FieldChange
+	fields := newMsg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := string(fd.Name())
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+
+		if fd.Message() != nil && !fd.IsList() && !fd.IsMap() && oldMsg.Has(fd) && newMsg.Has(fd) {
+			changes = append(changes, diffMessages(path, oldMsg.Get(fd).Message(), newMsg.Get(fd).Message())...)
+			continue
+		}
+
+		oldWire, newWire := marshalField(oldMsg, fd), marshalField(newMsg, fd)
+		if bytes.Equal(oldWire, newWire) {
+			continue
+		}
+		changes = append(changes, FieldChange{
+			Field: path,
+			Old:   fieldJSON(oldMsg, fd),
+			New:   fieldJSON(newMsg, fd),
+		})
+	}
+	return changes
+}
+
+// fieldOnly copies a single field of msg into an otherwise empty message of the same type
+func fieldOnly(msg protoreflect.Message, fd protoreflect.FieldDescriptor) protoreflect.Message {
+	single := msg.New()
+	if msg.Has(fd) {
+		single.Set(fd, msg.Get(fd))
+	}
+	return single
+}
+
+func marshalField(msg protoreflect.Message, fd protoreflect.FieldDescriptor) []byte {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(fieldOnly(msg, fd).Interface())
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func fieldJSON(msg protoreflect.Message, fd protoreflect.FieldDescriptor) json.RawMessage {
+	if !msg.Has(fd) {
+		return nil
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(fieldOnly(msg, fd).Interface())
+	if err != nil {
+		return nil
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil
+	}
+	return values[string(fd.Name())]
+}
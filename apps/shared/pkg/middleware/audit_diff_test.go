@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	organizationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAuditDiffCapturesOnlyChangedFields(t *testing.T) {
+	createdAt := timestamppb.Now()
+	before := &organizationsv1.Organization{
+		Id:        "org-a",
+		Name:      "Org A",
+		Slug:      "org-a",
+		Plan:      "starter",
+		Credits:   500,
+		CreatedAt: createdAt,
+	}
+	after := proto.Clone(before).(*organizationsv1.Organization)
+	after.Name = "Acme"
+	after.Domain = proto.String("acme.example")
+
+	changes := AuditDiff(before, after)
+	if len(changes) != 2 {
+		t.Fatalf("diff = %+v, want name and domain changes only", changes)
+	}
+	name, domain := changes[0], changes[1]
+	if name.Field != "name" || string(name.Old) != `"Org A"` || string(name.New) != `"Acme"` {
+		t.Fatalf("name change = %s: %s -> %s", name.Field, name.Old, name.New)
+	}
+	if domain.Field != "domain" || domain.Old != nil || string(domain.New) != `"acme.example"` {
+		t.Fatalf("domain change = %s: %s -> %s", domain.Field, domain.Old, domain.New)
+	}
+
+	if changes := AuditDiff(before, proto.Clone(before)); len(changes) != 0 {
+		t.Fatalf("diff of identical messages = %+v, want none", changes)
+	}
+}
+
+func TestAuditDiffDescendsIntoNestedMessages(t *testing.T) {
+	before := &organizationsv1.Organization{Id: "org-a", CreatedAt: &timestamppb.Timestamp{Seconds: 100}}
+	after := &organizationsv1.Organization{Id: "org-a", CreatedAt: &timestamppb.Timestamp{Seconds: 200}}
+
+	changes := AuditDiff(before, after)
+	if len(changes) != 1 || changes[0].Field != "created_at.seconds" {
+		t.Fatalf("diff = %+v, want only created_at.seconds", changes)
+	}
+}
+
+func TestRecordAuditDiffStoresChangesForInterceptor(t *testing.T) {
+	ctx, holder := withAuditDiffHolder(context.Background())
+	RecordAuditDiff(ctx, &organizationsv1.Organization{Name: "Org A"}, &organizationsv1.Organization{Name: "Acme"})
+
+	diff := encodeAuditDiff(holder.changes)
+	if diff == nil || *diff != `[{"field":"name","old":"Org A","new":"Acme"}]` {
+		t.Fatalf("encoded diff = %v", diff)
+	}
+
+	// Outside the interceptor there is nowhere to record to
+	RecordAuditDiff(context.Background(), &organizationsv1.Organization{}, &organizationsv1.Organization{Name: "Acme"})
+}
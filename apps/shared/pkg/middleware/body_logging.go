@@ -0,0 +1,208 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+const (
+	// RequestLogPath is where the API gateway serves DebugRequestLog; its own bodies aren't captured
+	RequestLogPath = "/admin/request-log"
+
+	maxLoggedBodySize  = 10 << 10 // 10 KB
+	requestLogCapacity = 10000
+)
+
+// DebugRequestLog holds the most recent requests RequestLogger captured with DEBUG_LOG_BODIES enabled
+var DebugRequestLog = NewRequestLogBuffer(requestLogCapacity)
+
+// BodyLoggingEnabled reports whether DEBUG_LOG_BODIES turns on request and response body logging
+func BodyLoggingEnabled() bool {
+	value := strings.TrimSpace(os.Getenv("DEBUG_LOG_BODIES"))
+	return value == "true" || value == "1"
+}
+
+// RequestLogEntry is one request captured with its PII-scrubbed bodies. Bodies are cut at 10 KB;
+// response bodies of streaming responses are not captured.
+type RequestLogEntry struct {
+	Time         time.Time `json:"time"`
+	Method       string    `json:"method"`
+	Path         string    `json:"path"`
+	Status       int       `json:"status"`
+	DurationMs   int64     `json:"duration_ms"`
+	ClientIP     string    `json:"client_ip"`
+	RequestBody  string    `json:"request_body,omitempty"`
+	ResponseBody string    `json:"response_body,omitempty"`
+}
+
+// RequestLogFilter selects entries of a RequestLogBuffer. Zero fields don't filter.
+type RequestLogFilter struct {
+	Since      time.Time
+	Until      time.Time
+	PathPrefix string
+}
+
+// RequestLogBuffer keeps the most recent request log entries in memory, dropping the oldest once full
+type RequestLogBuffer struct {
+	mu      sync.Mutex
+	entries []RequestLogEntry
+	start   int // Index of the oldest entry
+	count   int
+}
+
+// NewRequestLogBuffer creates a buffer holding up to capacity entries
+func NewRequestLogBuffer(capacity int) *RequestLogBuffer {
+	return &RequestLogBuffer{entries: make([]RequestLogEntry, capacity)}
+}
+
+// Add stores an entry, replacing the oldest one if the buffer is full
+func (b *RequestLogBuffer) Add(entry RequestLogEntry) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.entries) == 0 {
+		return
+	}
+	if b.count < len(b.entries) {
+		b.entries[(b.start+b.count)%len(b.entries)] = entry
+		b.count++
+		return
+	}
+	b.entries[b.start] = entry
+	b.start = (b.start + 1) % len(b.entries)
+}
+
+// Entries returns the entries matching filter, oldest first
+func (b *RequestLogBuffer) Entries(filter RequestLogFilter) []RequestLogEntry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	matches := make([]RequestLogEntry, 0)
+	for i := 0; i < b.count; i++ {
+		entry := b.entries[(b.start+i)%len(b.entries)]
+		if !filter.Since.IsZero() && entry.Time.Before(filter.Since) {
+			continue
+		}
+		if !filter.Until.IsZero() && entry.Time.After(filter.Until) {
+			continue
+		}
+		if filter.PathPrefix != "" && !strings.HasPrefix(entry.Path, filter.PathPrefix) {
+			continue
+		}
+		matches = append(matches, entry)
+	}
+	return matches
+}
+
+// bodyCapture keeps the first maxLoggedBodySize bytes written to it and discards the rest
+type bodyCapture struct {
+	buf       bytes.Buffer
+	truncated bool
+}
+
+func (c *bodyCapture) Write(p []byte) (int, error) {
+	if room := maxLoggedBodySize - c.buf.Len(); len(p) > room {
+		c.buf.Write(p[:room])
+		c.truncated = true
+	} else {
+		c.buf.Write(p)
+	}
+	return len(p), nil
+}
+
+// readCloser reads from a replacement reader but closes the original body
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// shouldCaptureBodies reports whether a request's bodies can be captured without holding up a
+// stream: WebSocket upgrades and streaming RPCs are skipped, as is the request log itself.
+func shouldCaptureBodies(r *http.Request) bool {
+	if r.URL.Path == RequestLogPath || r.Header.Get("Upgrade") != "" {
+		return false
+	}
+	return !isStreamingContentType(r.Header.Get("Content-Type"))
+}
+
+// isStreamingContentType reports whether a body with this content type is a stream of messages
+func isStreamingContentType(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	return strings.HasPrefix(contentType, "application/connect+") ||
+		strings.HasPrefix(contentType, "application/grpc") ||
+		strings.HasPrefix(contentType, "text/event-stream")
+}
+
+// captureRequestBody reads the start of the request body for logging and puts it back in front of
+// the rest, so the handler still reads the whole body
+func captureRequestBody(r *http.Request) *bodyCapture {
+	capture := &bodyCapture{}
+	if r.Body == nil || r.Body == http.NoBody {
+		return capture
+	}
+
+	head := make([]byte, maxLoggedBodySize+1)
+	n, err := io.ReadFull(r.Body, head)
+	head = head[:n]
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		logger.Debug("[Request] Failed to read body of %s %s for logging: %v", r.Method, r.URL.Path, err)
+	}
+	capture.Write(head)
+
+	r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(head), r.Body), Closer: r.Body}
+	return capture
+}
+
+// loggedBody renders a captured body for the log, scrubbed of personal data
+func loggedBody(scrubber *PIIScrubber, capture *bodyCapture) string {
+	data := capture.buf.Bytes()
+	if capture.truncated {
+		// The cut may have split a multi-byte character
+		for i := 0; i < utf8.UTFMax-1 && len(data) > 0 && !utf8.Valid(data); i++ {
+			data = data[:len(data)-1]
+		}
+	}
+	if len(data) == 0 {
+		return ""
+	}
+	if !utf8.Valid(data) {
+		return fmt.Sprintf("[%d bytes of binary data]", capture.buf.Len())
+	}
+
+	body := scrubber.Scrub(string(data))
+	if capture.truncated {
+		body += "...[truncated]"
+	}
+	return body
+}
+
+// logRequestBodies logs a request with its scrubbed bodies as a JSON line and keeps it in DebugRequestLog
+func logRequestBodies(scrubber *PIIScrubber, r *http.Request, rw *responseWriter, requestBody *bodyCapture, start time.Time) {
+	entry := RequestLogEntry{
+		Time:        start,
+		Method:      r.Method,
+		Path:        r.URL.Path,
+		Status:      rw.statusCode,
+		DurationMs:  time.Since(start).Milliseconds(),
+		ClientIP:    GetClientIP(r),
+		RequestBody: loggedBody(scrubber, requestBody),
+	}
+	if rw.body != nil && !isStreamingContentType(rw.Header().Get("Content-Type")) {
+		entry.ResponseBody = loggedBody(scrubber, rw.body)
+	}
+
+	DebugRequestLog.Add(entry)
+	if line, err := json.Marshal(entry); err == nil {
+		logger.Info("[RequestBody] %s", line)
+	}
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequestLogBufferKeepsNewestEntries(t *testing.T) {
+	buffer := NewRequestLogBuffer(3)
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		buffer.Add(RequestLogEntry{Time: base.Add(time.Duration(i) * time.Minute), Path: fmt.Sprintf("/svc%d/Method", i%2)})
+	}
+
+	paths := func(entries []RequestLogEntry) string {
+		var times []string
+		for _, entry := range entries {
+			times = append(times, fmt.Sprintf("%s@%d", entry.Path, entry.Time.Sub(base)/time.Minute))
+		}
+		return strings.Join(times, " ")
+	}
+
+	if got := paths(buffer.Entries(RequestLogFilter{})); got != "/svc0/Method@2 /svc1/Method@3 /svc0/Method@4" {
+		t.Fatalf("entries = %s, want the last three oldest first", got)
+	}
+	if got := paths(buffer.Entries(RequestLogFilter{PathPrefix: "/svc0/"})); got != "/svc0/Method@2 /svc0/Method@4" {
+		t.Fatalf("entries under /svc0/ = %s", got)
+	}
+	if got := paths(buffer.Entries(RequestLogFilter{Since: base.Add(3 * time.Minute)})); got != "/svc1/Method@3 /svc0/Method@4" {
+		t.Fatalf("entries since minute 3 = %s", got)
+	}
+	if got := paths(buffer.Entries(RequestLogFilter{Until: base.Add(3 * time.Minute)})); got != "/svc0/Method@2 /svc1/Method@3" {
+		t.Fatalf("entries until minute 3 = %s", got)
+	}
+}
+
+func TestRequestLoggerCapturesScrubbedBodies(t *testing.T) {
+	t.Setenv("DEBUG_LOG_BODIES", "true")
+	previousLog := DebugRequestLog
+	DebugRequestLog = NewRequestLogBuffer(10)
+	t.Cleanup(func() { DebugRequestLog = previousLog })
+
+	handler := RequestLogger(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path == "/stream" {
+			w.Header().Set("Content-Type", "application/connect+json")
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		fmt.Fprintf(w, `{"received":%d,"owner":"owner@example.com"}`, len(body))
+	}))
+
+	// The handler still reads the whole body, even past what is captured
+	large := `{"password":"hunter2","data":"` + strings.Repeat("x", 2*maxLoggedBodySize) + `"}`
+	requests := []*http.Request{
+		httptest.NewRequest(http.MethodPost, "/obiente.cloud.auth.v1.AuthService/Login", strings.NewReader(`{"email":"jane@example.com","password":"hunter2"}`)),
+		httptest.NewRequest(http.MethodPost, "/large", strings.NewReader(large)),
+		httptest.NewRequest(http.MethodPost, "/stream", strings.NewReader(`{}`)),
+		httptest.NewRequest(http.MethodGet, RequestLogPath, nil),
+	}
+	var responses []string
+	for _, req := range requests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		responses = append(responses, rec.Body.String())
+	}
+	if want := fmt.Sprintf(`{"received":%d,"owner":"owner@example.com"}`, len(large)); responses[1] != want {
+		t.Fatalf("large response = %s, want %s", responses[1], want)
+	}
+
+	entries := DebugRequestLog.Entries(RequestLogFilter{})
+	if len(entries) != 3 {
+		t.Fatalf("captured %d requests, want 3 (the request log itself is skipped)", len(entries))
+	}
+
+	login := entries[0]
+	if login.RequestBody != `{"email":"[REDACTED]","password":"[REDACTED]"}` || login.ResponseBody != `{"received":49,"owner":"[REDACTED]"}` || login.Status != http.StatusOK {
+		t.Fatalf("login entry = %+v", login)
+	}
+	if body := entries[1].RequestBody; !strings.HasPrefix(body, `{"password":"[REDACTED]","data":"xxx`) || !strings.HasSuffix(body, "...[truncated]") || len(body) > maxLoggedBodySize+64 {
+		t.Fatalf("large request body = %.80s... (%d bytes), want it scrubbed and cut at 10 KB", body, len(body))
+	}
+	if stream := entries[2]; stream.Path != "/stream" || stream.RequestBody != "{}" || stream.ResponseBody != "" {
+		t.Fatalf("streaming entry = %+v, want the request body only", stream)
+	}
+}
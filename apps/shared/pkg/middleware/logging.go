@@ -17,6 +17,7 @@ type responseWriter struct {
 	http.ResponseWriter
 	statusCode int
 	written    bool
+	body       *bodyCapture // Start of the response body, when bodies are logged
 }
 
 func (rw *responseWriter) WriteHeader(code int) {
@@ -31,6 +32,9 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 	if !rw.written {
 		rw.WriteHeader(http.StatusOK)
 	}
+	if rw.body != nil {
+		rw.body.Write(b)
+	}
 	return rw.ResponseWriter.Write(b)
 }
 
@@ -57,6 +61,13 @@ func RequestLogger(next http.Handler) http.Handler {
 
 	logger.Debug("[Middleware] RequestLogger initialized (debug=%v, LOG_LEVEL=%s)", debug, logger.GetLevel())
 
+	// With DEBUG_LOG_BODIES, bodies are logged at info level so they show up without debug logging
+	bodyLogging := BodyLoggingEnabled()
+	scrubber := NewPIIScrubber()
+	if bodyLogging {
+		logger.Warn("[Middleware] DEBUG_LOG_BODIES is enabled: request and response bodies are logged with personal data redacted")
+	}
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 
@@ -66,6 +77,12 @@ func RequestLogger(next http.Handler) http.Handler {
 			statusCode:     http.StatusOK,
 		}
 
+		var requestBody *bodyCapture
+		if bodyLogging && shouldCaptureBodies(r) {
+			requestBody = captureRequestBody(r)
+			wrapped.body = &bodyCapture{}
+		}
+
 		// Log incoming request with real client IP (extracted from proxied headers if available)
 		clientIP := GetClientIP(r)
 		logger.Debug("[Request] %s %s from %s (RemoteAddr: %s)", r.Method, r.URL.Path, clientIP, r.RemoteAddr)
@@ -104,6 +121,10 @@ func RequestLogger(next http.Handler) http.Handler {
 			logger.Debug("[Response] %s %s -> %d (%v)", r.Method, r.URL.Path, wrapped.statusCode, duration)
 		}
 
+		if requestBody != nil {
+			logRequestBodies(scrubber, r, wrapped, requestBody, start)
+		}
+
 		if debug {
 			// Log response headers
 			logger.Debugln("  Response Headers:")
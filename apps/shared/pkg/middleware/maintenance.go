@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	"connectrpc.com/connect"
+	"github.com/redis/go-redis/v9"
+)
+
+// MaintenanceModeKey is the Redis key holding the platform maintenance state while maintenance is active
+const MaintenanceModeKey = "platform:maintenance:until"
+
+const defaultMaintenanceMessage = "The platform is undergoing maintenance. Please try again later."
+
+// mutatingProcedurePrefixes are the RPC method prefixes rejected during maintenance
+var mutatingProcedurePrefixes = []string{"Create", "Update", "Delete", "Start", "Stop"}
+
+// MaintenanceState is the maintenance window stored under MaintenanceModeKey
+type MaintenanceState struct {
+	Until     time.Time `json:"until"` // Estimated end of maintenance
+	Message   string    `json:"message"`
+	StartedAt time.Time `json:"started_at"`
+	StartedBy string    `json:"started_by"`
+}
+
+// GetMaintenanceState returns the active maintenance window, or nil when the platform is not in maintenance
+func GetMaintenanceState(ctx context.Context) (*MaintenanceState, error) {
+	if database.RedisClient == nil {
+		return nil, nil
+	}
+	data, err := database.RedisClient.Get(ctx, MaintenanceModeKey)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read maintenance state: %w", err)
+	}
+	if data == "" {
+		return nil, nil
+	}
+
+	var state MaintenanceState
+	if err := json.Unmarshal([]byte(data), &state); err != nil {
+		return nil, fmt.Errorf("decode maintenance state: %w", err)
+	}
+	return &state, nil
+}
+
+// MaintenanceModeInterceptor rejects mutating RPCs with CodeUnavailable while the platform is in
+// maintenance. Read-only RPCs and superadmins pass through, so it must run after the auth interceptor.
+func MaintenanceModeInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if !isMutatingProcedure(req.Spec().Procedure) {
+				return next(ctx, req)
+			}
+			if user, err := auth.GetUserFromContext(ctx); err == nil && auth.HasRole(user, auth.RoleSuperAdmin) {
+				return next(ctx, req)
+			}
+
+			state, err := GetMaintenanceState(ctx)
+			if err != nil {
+				// Fail open: a Redis outage should not make the platform read-only
+				logger.Warn("[Maintenance] Failed to check maintenance mode: %v", err)
+				return next(ctx, req)
+			}
+			if state == nil {
+				return next(ctx, req)
+			}
+
+			message := state.Message
+			if message == "" {
+				message = defaultMaintenanceMessage
+			}
+			return nil, connect.NewError(connect.CodeUnavailable, errors.New(message))
+		}
+	}
+}
+
+// isMutatingProcedure reports whether a procedure like /pkg.Service/CreateThing changes state
+func isMutatingProcedure(procedure string) bool {
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	for _, prefix := range mutatingProcedurePrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
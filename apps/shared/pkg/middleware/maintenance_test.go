@@ -0,0 +1,26 @@
+package middleware
+
+import "testing"
+
+func TestIsMutatingProcedure(t *testing.T) {
+	tests := []struct {
+		procedure string
+		want      bool
+	}{
+		{"/obiente.cloud.deployments.v1.DeploymentService/CreateDeployment", true},
+		{"/obiente.cloud.deployments.v1.DeploymentService/UpdateDeployment", true},
+		{"/obiente.cloud.gameservers.v1.GameServerService/DeleteGameServer", true},
+		{"/obiente.cloud.gameservers.v1.GameServerService/StartGameServer", true},
+		{"/obiente.cloud.vps.v1.VPSService/StopVPS", true},
+		{"/obiente.cloud.deployments.v1.DeploymentService/GetDeployment", false},
+		{"/obiente.cloud.deployments.v1.DeploymentService/ListDeployments", false},
+		{"/obiente.cloud.superadmin.v1.SuperadminService/SetMaintenanceMode", false},
+		// Only the method name is checked, not the service name
+		{"/obiente.cloud.deployments.v1.CreateService/ListThings", false},
+	}
+	for _, tt := range tests {
+		if got := isMutatingProcedure(tt.procedure); got != tt.want {
+			t.Errorf("isMutatingProcedure(%q) = %v, want %v", tt.procedure, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import "regexp"
+
+// Redacted replaces personal data removed by PIIScrubber
+const Redacted = "[REDACTED]"
+
+var (
+	// JSON members whose key mentions a password, e.g. "password", "newPassword" or "db_passwd"
+	jsonPasswordPattern = regexp.MustCompile(`(?i)("[a-z0-9_\-]*passw(?:or)?d[a-z0-9_\-]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	// Form and query parameters whose name mentions a password
+	formPasswordPattern = regexp.MustCompile(`(?i)(\b[a-z0-9_\-]*passw(?:or)?d[a-z0-9_\-]*=)[^&\s"]*`)
+	emailPattern        = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`)
+	// 13 to 19 digits, optionally grouped with spaces or dashes; matches must also pass the Luhn check
+	cardNumberPattern = regexp.MustCompile(`\b\d(?:[ \-]?\d){12,18}\b`)
+)
+
+// PIIScrubber removes personal data from request and response bodies before they are logged:
+// password fields, email addresses and credit card numbers are replaced with [REDACTED].
+type PIIScrubber struct{}
+
+// NewPIIScrubber creates a scrubber for logged bodies
+func NewPIIScrubber() *PIIScrubber {
+	return &PIIScrubber{}
+}
+
+// Scrub returns body with every password field value, email address and credit card number redacted
+func (s *PIIScrubber) Scrub(body string) string {
+	// Passwords go first so a password that looks like an email is removed with its field
+	body = jsonPasswordPattern.ReplaceAllString(body, `${1}"`+Redacted+`"`)
+	body = formPasswordPattern.ReplaceAllString(body, `${1}`+Redacted)
+	body = emailPattern.ReplaceAllString(body, Redacted)
+	return cardNumberPattern.ReplaceAllStringFunc(body, func(match string) string {
+		if !luhnValid(match) {
+			// Long IDs and timestamps are digits too
+			return match
+		}
+		return Redacted
+	})
+}
+
+// luhnValid reports whether the digits of number pass the Luhn checksum used by card numbers.
+// Characters other than digits are ignored.
+func luhnValid(number string) bool {
+	sum, double := 0, false
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		digit := int(c - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import "testing"
+
+func TestPIIScrubberRedactsEmailAddresses(t *testing.T) {
+	scrubber := NewPIIScrubber()
+
+	tests := map[string]string{
+		`{"email":"jane.doe+billing@example.co.uk"}`:          `{"email":"[REDACTED]"}`,
+		`invite sent to ops@obiente.cloud and dev@example.io`: `invite sent to [REDACTED] and [REDACTED]`,
+		`{"email":"not-an-email@localhost"}`:                  `{"email":"not-an-email@localhost"}`,
+	}
+	for body, want := range tests {
+		if got := scrubber.Scrub(body); got != want {
+			t.Errorf("Scrub(%s) = %s, want %s", body, got, want)
+		}
+	}
+}
+
+func TestPIIScrubberRedactsCreditCardNumbers(t *testing.T) {
+	scrubber := NewPIIScrubber()
+
+	tests := map[string]string{
+		`{"card":"4242424242424242"}`:          `{"card":"[REDACTED]"}`,
+		`{"card":"4111 1111 1111 1111"}`:       `{"card":"[REDACTED]"}`,
+		`{"card":"5555-5555-5555-4444"}`:       `{"card":"[REDACTED]"}`,
+		`{"card":"378282246310005","cvc":"1"}`: `{"card":"[REDACTED]","cvc":"1"}`,
+		// Digit runs that fail the Luhn check, like timestamps and IDs, are kept
+		`{"createdAt":"1767225600123"}`:     `{"createdAt":"1767225600123"}`,
+		`{"card":"4242424242424241"}`:       `{"card":"4242424242424241"}`,
+		`{"port":8080,"memory":1073741824}`: `{"port":8080,"memory":1073741824}`,
+	}
+	for body, want := range tests {
+		if got := scrubber.Scrub(body); got != want {
+			t.Errorf("Scrub(%s) = %s, want %s", body, got, want)
+		}
+	}
+}
+
+func TestPIIScrubberRedactsPasswordFields(t *testing.T) {
+	scrubber := NewPIIScrubber()
+
+	tests := map[string]string{
+		`{"password":"hunter2"}`:                                 `{"password":"[REDACTED]"}`,
+		`{"name":"db","newPassword" : "s3cr\"et","port":5432}`:   `{"name":"db","newPassword" : "[REDACTED]","port":5432}`,
+		`{"root_passwd":"toor","PASSWORD_CONFIRMATION":"toor"}`:  `{"root_passwd":"[REDACTED]","PASSWORD_CONFIRMATION":"[REDACTED]"}`,
+		`username=jane&password=hunter2&remember=1`:              `username=jane&password=[REDACTED]&remember=1`,
+		`{"password":"me@example.com"}`:                          `{"password":"[REDACTED]"}`,
+		`{"passwordPolicy":{"minLength":12},"hasPassword":true}`: `{"passwordPolicy":{"minLength":12},"hasPassword":true}`,
+	}
+	for body, want := range tests {
+		if got := scrubber.Scrub(body); got != want {
+			t.Errorf("Scrub(%s) = %s, want %s", body, got, want)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+// Package openapi describes the public Connect API as an OpenAPI 3.0 document for REST integrators.
+//
+// Every unary RPC is exposed by the api-gateway as POST /api/v1/{service}/{method}, where
+// {service} is the fully qualified service name, taking and returning the protobuf JSON
+// encoding of its messages. Streaming RPCs need a Connect client and are left out.
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"gopkg.in/yaml.v3"
+
+	// Register the descriptors of the services routed by the api-gateway
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/admin/v1"
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/audit/v1"
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1"
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/databases/v1"
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1"
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/support/v1"
+	_ "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+)
+
+// PathPrefix is the REST path prefix the api-gateway maps onto Connect procedures
+const PathPrefix = "/api/v1"
+
+// apiPackagePrefix selects the proto packages that make up the public API
+const apiPackagePrefix = "obiente.cloud."
+
+// internalPackages hold services that are not reachable through the api-gateway
+var internalPackages = map[string]bool{
+	"obiente.cloud.vpsgateway.v1": true,
+}
+
+type document struct {
+	OpenAPI    string                `yaml:"openapi"`
+	Info       info                  `yaml:"info"`
+	Tags       []tag                 `yaml:"tags"`
+	Paths      map[string]pathItem   `yaml:"paths"`
+	Components components            `yaml:"components"`
+	Security   []map[string][]string `yaml:"security"`
+}
+
+type info struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+	Version     string `yaml:"version"`
+}
+
+type tag struct {
+	Name string `yaml:"name"`
+}
+
+type pathItem struct {
+	Post operation `yaml:"post"`
+}
+
+type operation struct {
+	OperationID string              `yaml:"operationId"`
+	Tags        []string            `yaml:"tags"`
+	Summary     string              `yaml:"summary"`
+	Deprecated  bool                `yaml:"deprecated,omitempty"`
+	RequestBody requestBody         `yaml:"requestBody"`
+	Responses   map[string]response `yaml:"responses"`
+}
+
+type requestBody struct {
+	Required bool                 `yaml:"required"`
+	Content  map[string]mediaType `yaml:"content"`
+}
+
+type response struct {
+	Description string               `yaml:"description"`
+	Content     map[string]mediaType `yaml:"content,omitempty"`
+}
+
+type mediaType struct {
+	Schema *schema `yaml:"schema"`
+}
+
+type components struct {
+	Schemas         map[string]*schema        `yaml:"schemas"`
+	SecuritySchemes map[string]securityScheme `yaml:"securitySchemes"`
+}
+
+type securityScheme struct {
+	Type   string `yaml:"type"`
+	Scheme string `yaml:"scheme"`
+}
+
+type schema struct {
+	Ref                  string             `yaml:"$ref,omitempty"`
+	Type                 string             `yaml:"type,omitempty"`
+	Format               string             `yaml:"format,omitempty"`
+	Description          string             `yaml:"description,omitempty"`
+	Enum                 []string           `yaml:"enum,omitempty"`
+	Items                *schema            `yaml:"items,omitempty"`
+	Properties           map[string]*schema `yaml:"properties,omitempty"`
+	AdditionalProperties *schema            `yaml:"additionalProperties,omitempty"`
+	Deprecated           bool               `yaml:"deprecated,omitempty"`
+}
+
+// GenerateOpenAPISpec returns an OpenAPI 3.0 YAML document for the unary RPCs of every
+// registered obiente.cloud service. It walks the whole registry, so callers should generate
+// the spec once and cache it.
+func GenerateOpenAPISpec() []byte {
+	spec, err := generate(protoregistry.GlobalFiles)
+	if err != nil {
+		// Only reachable if the document types above stop being marshalable
+		panic(fmt.Sprintf("openapi: failed to encode spec: %v", err))
+	}
+	return spec
+}
+
+func generate(files *protoregistry.Files) ([]byte, error) {
+	g := &generator{schemas: make(map[string]*schema)}
+	doc := document{
+		OpenAPI: "3.0.3",
+		Info: info{
+			Title: "Obiente Cloud API",
+			Description: "REST mapping of the Obiente Cloud Connect API. Each operation is a Connect unary call: " +
+				"POST the request message as protobuf JSON and receive the response message as protobuf JSON. " +
+				"Failed calls return a Connect error.",
+			Version: "v1",
+		},
+		Paths: make(map[string]pathItem),
+		Components: components{
+			Schemas:         g.schemas,
+			SecuritySchemes: map[string]securityScheme{"bearerAuth": {Type: "http", Scheme: "bearer"}},
+		},
+		Security: []map[string][]string{{"bearerAuth": {}}},
+	}
+	g.schemas["connect.error"] = &schema{
+		Type:        "object",
+		Description: "Connect error returned with a non-2xx status",
+		Properties: map[string]*schema{
+			"code":    {Type: "string", Description: "Connect error code, e.g. not_found or permission_denied"},
+			"message": {Type: "string"},
+			"details": {Type: "array", Items: &schema{Type: "object"}},
+		},
+	}
+
+	var services []protoreflect.ServiceDescriptor
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		pkg := string(file.Package())
+		if !strings.HasPrefix(pkg, apiPackagePrefix) || internalPackages[pkg] {
+			return true
+		}
+		for i := 0; i < file.Services().Len(); i++ {
+			services = append(services, file.Services().Get(i))
+		}
+		return true
+	})
+	sort.Slice(services, func(i, j int) bool { return services[i].FullName() < services[j].FullName() })
+
+	for _, service := range services {
+		serviceName := string(service.FullName())
+		doc.Tags = append(doc.Tags, tag{Name: serviceName})
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			method := methods.Get(i)
+			if method.IsStreamingClient() || method.IsStreamingServer() {
+				continue
+			}
+			doc.Paths[fmt.Sprintf("%s/%s/%s", PathPrefix, serviceName, method.Name())] = pathItem{Post: g.operation(service, method)}
+		}
+	}
+
+	return yaml.Marshal(doc)
+}
+
+type generator struct {
+	schemas map[string]*schema // component name -> schema
+}
+
+func (g *generator) operation(service protoreflect.ServiceDescriptor, method protoreflect.MethodDescriptor) operation {
+	jsonContent := func(s *schema) map[string]mediaType {
+		return map[string]mediaType{"application/json": {Schema: s}}
+	}
+	return operation{
+		OperationID: fmt.Sprintf("%s_%s", service.Name(), method.Name()),
+		Tags:        []string{string(service.FullName())},
+		Summary:     fmt.Sprintf("%s.%s", service.Name(), method.Name()),
+		Deprecated:  isDeprecated(method),
+		RequestBody: requestBody{Required: true, Content: jsonContent(g.messageRef(method.Input()))},
+		Responses: map[string]response{
+			"200":     {Description: "Success", Content: jsonContent(g.messageRef(method.Output()))},
+			"default": {Description: "Connect error", Content: jsonContent(&schema{Ref: "#/components/schemas/connect.error"})},
+		},
+	}
+}
+
+// messageRef returns a reference to the message's component schema, adding it (and the
+// messages it uses) on first use. Well-known types are inlined with their JSON mapping.
+func (g *generator) messageRef(message protoreflect.MessageDescriptor) *schema {
+	if wkt := wellKnownSchema(message); wkt != nil {
+		return wkt
+	}
+	name := string(message.FullName())
+	ref := &schema{Ref: "#/components/schemas/" + name}
+	if _, ok := g.schemas[name]; ok {
+		return ref
+	}
+
+	s := &schema{Type: "object", Properties: make(map[string]*schema)}
+	// Registered before its fields so recursive messages terminate
+	g.schemas[name] = s
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		s.Properties[field.JSONName()] = g.fieldSchema(field)
+	}
+	return ref
+}
+
+func (g *generator) fieldSchema(field protoreflect.FieldDescriptor) *schema {
+	var s *schema
+	switch {
+	case field.IsMap():
+		s = &schema{Type: "object", AdditionalProperties: g.singularSchema(field.MapValue())}
+	case field.IsList():
+		s = &schema{Type: "array", Items: g.singularSchema(field)}
+	default:
+		s = g.singularSchema(field)
+	}
+	// Siblings of $ref are ignored in OpenAPI 3.0, so deprecated message fields stay unmarked
+	if isDeprecated(field) && s.Ref == "" {
+		s.Deprecated = true
+	}
+	return s
+}
+
+// singularSchema maps a field's type to its protobuf JSON representation
+func (g *generator) singularSchema(field protoreflect.FieldDescriptor) *schema {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return &schema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &schema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &schema{Type: "integer", Format: "int64"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// 64-bit integers are JSON strings so they survive JavaScript numbers
+		return &schema{Type: "string", Format: "int64"}
+	case protoreflect.FloatKind:
+		return &schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &schema{Type: "number", Format: "double"}
+	case protoreflect.StringKind:
+		return &schema{Type: "string"}
+	case protoreflect.BytesKind:
+		return &schema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return &schema{Type: "string", Enum: names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return g.messageRef(field.Message())
+	default:
+		return &schema{}
+	}
+}
+
+// wellKnownSchema returns the JSON mapping of google.protobuf types, or nil for other messages
+func wellKnownSchema(message protoreflect.MessageDescriptor) *schema {
+	switch message.FullName() {
+	case "google.protobuf.Timestamp":
+		return &schema{Type: "string", Format: "date-time"}
+	case "google.protobuf.Duration":
+		return &schema{Type: "string", Description: "Duration in seconds with an s suffix, e.g. 1.5s"}
+	case "google.protobuf.FieldMask":
+		return &schema{Type: "string", Description: "Comma-separated field paths"}
+	case "google.protobuf.Empty", "google.protobuf.Struct", "google.protobuf.Any":
+		return &schema{Type: "object"}
+	case "google.protobuf.ListValue":
+		return &schema{Type: "array", Items: &schema{}}
+	case "google.protobuf.Value":
+		return &schema{}
+	case "google.protobuf.StringValue":
+		return &schema{Type: "string"}
+	case "google.protobuf.BytesValue":
+		return &schema{Type: "string", Format: "byte"}
+	case "google.protobuf.BoolValue":
+		return &schema{Type: "boolean"}
+	case "google.protobuf.Int32Value":
+		return &schema{Type: "integer", Format: "int32"}
+	case "google.protobuf.UInt32Value":
+		return &schema{Type: "integer", Format: "int64"}
+	case "google.protobuf.Int64Value", "google.protobuf.UInt64Value":
+		return &schema{Type: "string", Format: "int64"}
+	case "google.protobuf.FloatValue":
+		return &schema{Type: "number", Format: "float"}
+	case "google.protobuf.DoubleValue":
+		return &schema{Type: "number", Format: "double"}
+	}
+	return nil
+}
+
+// isDeprecated reports the deprecated option of a method or field, read from its descriptor proto
+func isDeprecated(descriptor protoreflect.Descriptor) bool {
+	switch d := descriptor.(type) {
+	case protoreflect.MethodDescriptor:
+		return protodesc.ToMethodDescriptorProto(d).GetOptions().GetDeprecated()
+	case protoreflect.FieldDescriptor:
+		return protodesc.ToFieldDescriptorProto(d).GetOptions().GetDeprecated()
+	}
+	return false
+}
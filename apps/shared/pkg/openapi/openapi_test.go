@@ -0,0 +1,57 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func TestGenerateOpenAPISpecIsValidOpenAPI(t *testing.T) {
+	spec := GenerateOpenAPISpec()
+
+	doc, err := openapi3.NewLoader().LoadFromData(spec)
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatalf("validate spec: %v", err)
+	}
+
+	start := doc.Paths.Find("/api/v1/obiente.cloud.vps.v1.VPSService/StartVPS")
+	if start == nil || start.Post == nil {
+		t.Fatal("StartVPS has no POST operation")
+	}
+	if ref := start.Post.RequestBody.Value.Content.Get("application/json").Schema.Ref; ref != "#/components/schemas/obiente.cloud.vps.v1.StartVPSRequest" {
+		t.Fatalf("StartVPS request schema = %q", ref)
+	}
+	if ref := start.Post.Responses.Status(200).Value.Content.Get("application/json").Schema.Ref; ref != "#/components/schemas/obiente.cloud.vps.v1.StartVPSResponse" {
+		t.Fatalf("StartVPS response schema = %q", ref)
+	}
+	if doc.Paths.Find("/api/v1/obiente.cloud.vps.v1.VPSService/StreamVPSLogs") != nil {
+		t.Fatal("server-streaming StreamVPSLogs has a REST path")
+	}
+	if doc.Paths.Find("/api/v1/obiente.cloud.auth.v1.AuthService/GetCurrentUser") == nil {
+		t.Fatal("spec is missing services other than VPS")
+	}
+
+	metric := doc.Components.Schemas["obiente.cloud.vps.v1.VPSMetric"].Value
+	if got := metric.Properties["memoryUsedBytes"].Value; !got.Type.Is("string") || got.Format != "int64" {
+		t.Fatalf("int64 field = %v %q, want a string with int64 format", got.Type, got.Format)
+	}
+	if got := metric.Properties["timestamp"].Value; !got.Type.Is("string") || got.Format != "date-time" {
+		t.Fatalf("Timestamp field = %v %q, want a date-time string", got.Type, got.Format)
+	}
+	if got := metric.Properties["cpuUsagePercent"].Value; !got.Type.Is("number") {
+		t.Fatalf("double field type = %v, want number", got.Type)
+	}
+	status := doc.Components.Schemas["obiente.cloud.vps.v1.VPSInstance"].Value.Properties["status"].Value
+	if !status.Type.Is("string") || len(status.Enum) == 0 || status.Enum[0] != "VPS_STATUS_UNSPECIFIED" {
+		t.Fatalf("enum field = %v %v, want the enum value names", status.Type, status.Enum)
+	}
+
+	if !bytes.Equal(spec, GenerateOpenAPISpec()) {
+		t.Fatal("spec differs between generations")
+	}
+}
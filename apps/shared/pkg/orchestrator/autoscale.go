@@ -0,0 +1,80 @@
+package orchestrator
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+// maxAutoScaleTargetCPUPercent matches the highest CPU usage the metrics streamer records for a container
+const maxAutoScaleTargetCPUPercent = 10000.0
+
+// AutoScalePolicy bounds and paces the replica counts the auto-scaler picks for a deployment
+type AutoScalePolicy struct {
+	MinReplicas      int32
+	MaxReplicas      int32
+	TargetCPUPercent float64 // Average CPU utilization per replica to hold; 100 is one full core
+	// Minimum time after the last scaling before the deployment is scaled up or down again
+	ScaleUpCooldown   time.Duration
+	ScaleDownCooldown time.Duration
+}
+
+// AutoScalePolicyFromDatabase converts a stored auto-scaling policy
+func AutoScalePolicyFromDatabase(policy *database.DeploymentAutoScalePolicy) AutoScalePolicy {
+	return AutoScalePolicy{
+		MinReplicas:       policy.MinReplicas,
+		MaxReplicas:       policy.MaxReplicas,
+		TargetCPUPercent:  policy.TargetCPUPercent,
+		ScaleUpCooldown:   time.Duration(policy.ScaleUpCooldownSeconds) * time.Second,
+		ScaleDownCooldown: time.Duration(policy.ScaleDownCooldownSeconds) * time.Second,
+	}
+}
+
+// Validate checks that the policy describes a usable replica range and target
+func (p AutoScalePolicy) Validate() error {
+	if p.MinReplicas < 1 {
+		return fmt.Errorf("min_replicas must be at least 1")
+	}
+	if p.MaxReplicas < p.MinReplicas {
+		return fmt.Errorf("max_replicas must not be less than min_replicas")
+	}
+	if p.TargetCPUPercent <= 0 || p.TargetCPUPercent > maxAutoScaleTargetCPUPercent {
+		return fmt.Errorf("target_cpu_percent must be between 0 and %.0f", maxAutoScaleTargetCPUPercent)
+	}
+	if p.ScaleUpCooldown < 0 || p.ScaleDownCooldown < 0 {
+		return fmt.Errorf("cooldowns must not be negative")
+	}
+	return nil
+}
+
+// DesiredReplicas returns the replica count that brings the average CPU utilization per replica to the
+// target: current * cpuPercent / target, rounded up and clamped to [MinReplicas, MaxReplicas]
+func (p AutoScalePolicy) DesiredReplicas(current int32, cpuPercent float64) int32 {
+	desired := current
+	if current > 0 && p.TargetCPUPercent > 0 {
+		// The small tolerance keeps a load exactly at the target from rounding up to one more replica
+		proportional := math.Ceil(float64(current)*cpuPercent/p.TargetCPUPercent - 1e-9)
+		desired = int32(min(proportional, float64(math.MaxInt32)))
+	}
+	return max(p.MinReplicas, min(desired, p.MaxReplicas))
+}
+
+// NextReplicas returns the replica count to scale to now, or false when the deployment should keep
+// its current count because it is already at the desired count or the cooldown has not passed
+func (p AutoScalePolicy) NextReplicas(current int32, cpuPercent float64, lastScaledAt *time.Time, now time.Time) (int32, bool) {
+	desired := p.DesiredReplicas(current, cpuPercent)
+	if desired == current {
+		return current, false
+	}
+
+	cooldown := p.ScaleDownCooldown
+	if desired > current {
+		cooldown = p.ScaleUpCooldown
+	}
+	if lastScaledAt != nil && now.Sub(*lastScaledAt) < cooldown {
+		return current, false
+	}
+	return desired, true
+}
@@ -0,0 +1,159 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAutoScalePolicyDesiredReplicas(t *testing.T) {
+	policy := AutoScalePolicy{MinReplicas: 2, MaxReplicas: 10, TargetCPUPercent: 50}
+
+	tests := []struct {
+		current int32
+		cpu     float64
+		want    int32
+	}{
+		{2, 50, 2},    // at the target
+		{2, 100, 4},   // twice the target
+		{3, 60, 4},    // 3.6 rounds up
+		{4, 10, 2},    // 0.8 is clamped to the minimum
+		{8, 400, 10},  // 64 is clamped to the maximum
+		{6, 25, 3},    // half the target
+		{1, 0, 2},     // idle, below the minimum
+		{12, 50, 10},  // above the maximum
+		{3, 50.01, 4}, // any load above the target adds a replica
+		{3, 49.99, 3}, // load just below the target keeps the count
+	}
+	for _, tt := range tests {
+		if got := policy.DesiredReplicas(tt.current, tt.cpu); got != tt.want {
+			t.Errorf("DesiredReplicas(%d, %.2f) = %d, want %d", tt.current, tt.cpu, got, tt.want)
+		}
+	}
+}
+
+func TestAutoScalePolicyValidate(t *testing.T) {
+	valid := AutoScalePolicy{MinReplicas: 1, MaxReplicas: 3, TargetCPUPercent: 70, ScaleUpCooldown: time.Minute, ScaleDownCooldown: 5 * time.Minute}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	for name, policy := range map[string]AutoScalePolicy{
+		"no minimum":        {MinReplicas: 0, MaxReplicas: 3, TargetCPUPercent: 70},
+		"maximum below min": {MinReplicas: 4, MaxReplicas: 3, TargetCPUPercent: 70},
+		"no target":         {MinReplicas: 1, MaxReplicas: 3},
+		"target too high":   {MinReplicas: 1, MaxReplicas: 3, TargetCPUPercent: 20000},
+		"negative cooldown": {MinReplicas: 1, MaxReplicas: 3, TargetCPUPercent: 70, ScaleDownCooldown: -time.Second},
+	} {
+		if err := policy.Validate(); err == nil {
+			t.Errorf("%s: Validate accepted %+v", name, policy)
+		}
+	}
+}
+
+// simulateAutoScaling runs the auto-scaler against a deployment whose total CPU load (100 per busy
+// core) is spread evenly over its replicas, and returns the replica count after each evaluation
+func simulateAutoScaling(policy AutoScalePolicy, replicas int32, load func(step int) float64, steps int) []int32 {
+	const interval = 30 * time.Second
+	start := time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC)
+
+	var lastScaledAt *time.Time
+	history := make([]int32, 0, steps)
+	for step := 0; step < steps; step++ {
+		now := start.Add(time.Duration(step) * interval)
+		cpu := load(step) / float64(replicas)
+		if next, ok := policy.NextReplicas(replicas, cpu, lastScaledAt, now); ok {
+			replicas = next
+			scaledAt := now
+			lastScaledAt = &scaledAt
+		}
+		history = append(history, replicas)
+	}
+	return history
+}
+
+func TestAutoScalingSimulationFollowsLoad(t *testing.T) {
+	policy := AutoScalePolicy{
+		MinReplicas:       1,
+		MaxReplicas:       8,
+		TargetCPUPercent:  50,
+		ScaleUpCooldown:   time.Minute,
+		ScaleDownCooldown: 5 * time.Minute,
+	}
+	// Quiet, a spike to 3 busy cores for 10 minutes, then quiet again
+	load := func(step int) float64 {
+		if step >= 4 && step < 24 {
+			return 300
+		}
+		return 20
+	}
+
+	history := simulateAutoScaling(policy, 1, load, 50)
+
+	// The spike needs 300 / 50 = 6 replicas; the first evaluation of it already gets there
+	if history[3] != 1 || history[4] != 6 {
+		t.Fatalf("scale-up: replicas before and at the spike = %d, %d, want 1, 6 (history %v)", history[3], history[4], history)
+	}
+	for step := 4; step < 24; step++ {
+		if history[step] != 6 {
+			t.Fatalf("replicas changed during steady load at step %d (history %v)", step, history)
+		}
+	}
+	// The 5 minute scale-down cooldown since the scale-up has passed by the time the spike ends
+	if history[24] != 1 {
+		t.Fatalf("replicas after the spike = %d, want 1 (history %v)", history[24], history)
+	}
+	for _, replicas := range history[24:] {
+		if replicas != 1 {
+			t.Fatalf("replicas flapped after scaling down (history %v)", history)
+		}
+	}
+}
+
+func TestAutoScalingSimulationHonorsCooldowns(t *testing.T) {
+	policy := AutoScalePolicy{
+		MinReplicas:       2,
+		MaxReplicas:       5,
+		TargetCPUPercent:  60,
+		ScaleUpCooldown:   2 * time.Minute,
+		ScaleDownCooldown: 10 * time.Minute,
+	}
+	// Load keeps growing past what the maximum can absorb, then drops
+	load := func(step int) float64 {
+		switch {
+		case step < 4:
+			return 200
+		case step < 8:
+			return 290
+		case step < 12:
+			return 600
+		default:
+			return 60
+		}
+	}
+
+	history := simulateAutoScaling(policy, 2, load, 40)
+
+	// 200 over 2 replicas is 100% each: ceil(2 * 100 / 60) = 4
+	if history[0] != 4 {
+		t.Fatalf("first evaluation = %d replicas, want 4 (history %v)", history[0], history)
+	}
+	// 290 over 4 replicas needs 5, but only once the 2 minute scale-up cooldown (4 evaluations) has passed
+	if history[4] != 5 {
+		t.Fatalf("replicas at step 4 = %d, want 5 (history %v)", history[4], history)
+	}
+	for step, replicas := range history {
+		if replicas > policy.MaxReplicas || replicas < policy.MinReplicas {
+			t.Fatalf("replicas %d at step %d outside [%d, %d]", replicas, step, policy.MinReplicas, policy.MaxReplicas)
+		}
+	}
+	// The load drops at step 12, but the 10 minute scale-down cooldown since the scale-up at step 4
+	// holds the replicas until step 24
+	for step := 12; step < 24; step++ {
+		if history[step] != 5 {
+			t.Fatalf("scaled down during the cooldown at step %d (history %v)", step, history)
+		}
+	}
+	if history[24] != 2 {
+		t.Fatalf("replicas after the scale-down cooldown = %d, want 2 (history %v)", history[24], history)
+	}
+}
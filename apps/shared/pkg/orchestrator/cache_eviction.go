@@ -0,0 +1,139 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	"github.com/moby/moby/api/types/build"
+	"github.com/moby/moby/client"
+)
+
+// defaultDockerCacheMaxGB is the build-cache size limit used when DOCKER_CACHE_MAX_GB is not set
+const defaultDockerCacheMaxGB = 20
+
+// buildCacheClient is the part of the Docker client used to inspect and prune the build cache
+type buildCacheClient interface {
+	DiskUsage(ctx context.Context, options client.DiskUsageOptions) (client.DiskUsageResult, error)
+	BuildCachePrune(ctx context.Context, opts client.BuildCachePruneOptions) (client.BuildCachePruneResult, error)
+}
+
+// CacheEvictionManager keeps the Docker build cache below a size limit
+// Builds leave layer caches behind for every deployment; the least recently used ones are evicted first
+type CacheEvictionManager struct {
+	docker   buildCacheClient
+	maxBytes int64
+}
+
+// NewCacheEvictionManager creates a cache eviction manager that keeps the build cache at or below maxBytes
+func NewCacheEvictionManager(docker buildCacheClient, maxBytes int64) *CacheEvictionManager {
+	return &CacheEvictionManager{docker: docker, maxBytes: maxBytes}
+}
+
+// CacheEvictionManager returns a cache eviction manager for the deployment manager's Docker daemon
+func (dm *DeploymentManager) CacheEvictionManager(maxBytes int64) *CacheEvictionManager {
+	return NewCacheEvictionManager(dm.dockerClient, maxBytes)
+}
+
+// DockerCacheMaxBytesFromEnv returns the build-cache size limit from DOCKER_CACHE_MAX_GB (default: 20)
+func DockerCacheMaxBytesFromEnv() int64 {
+	maxGB := float64(defaultDockerCacheMaxGB)
+	if v := strings.TrimSpace(os.Getenv("DOCKER_CACHE_MAX_GB")); v != "" {
+		if parsed, err := strconv.ParseFloat(v, 64); err == nil && parsed >= 0 {
+			maxGB = parsed
+		} else {
+			logger.Warn("[CacheEviction] Invalid DOCKER_CACHE_MAX_GB %q, using %d", v, defaultDockerCacheMaxGB)
+		}
+	}
+	return int64(maxGB * 1024 * 1024 * 1024)
+}
+
+// Run evicts build cache every interval until ctx is cancelled
+func (m *CacheEvictionManager) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := m.Evict(ctx); err != nil {
+				logger.Warn("[CacheEviction] Failed to evict build cache: %v", err)
+			}
+		}
+	}
+}
+
+// Evict deletes build-cache records, least recently used first, until the cache is within the limit
+// Records that are in use by a running build are never deleted. It returns the number of bytes reclaimed.
+func (m *CacheEvictionManager) Evict(ctx context.Context) (int64, error) {
+	usage, err := m.docker.DiskUsage(ctx, client.DiskUsageOptions{BuildCache: true, Verbose: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get build cache usage: %w", err)
+	}
+
+	total := usage.BuildCache.TotalSize
+	if total == 0 {
+		for _, record := range usage.BuildCache.Items {
+			total += record.Size
+		}
+	}
+	if total <= m.maxBytes {
+		return 0, nil
+	}
+
+	logger.Info("[CacheEviction] Build cache uses %d bytes, limit is %d bytes", total, m.maxBytes)
+
+	var reclaimed int64
+	for _, record := range evictionOrder(usage.BuildCache.Items) {
+		if total <= m.maxBytes {
+			break
+		}
+
+		filters := make(client.Filters)
+		filters.Add("id", record.ID)
+		result, err := m.docker.BuildCachePrune(ctx, client.BuildCachePruneOptions{All: true, Filters: filters})
+		if err != nil {
+			return reclaimed, fmt.Errorf("failed to prune build cache %s: %w", record.ID, err)
+		}
+
+		freed := int64(result.Report.SpaceReclaimed)
+		if freed == 0 {
+			freed = record.Size
+		}
+		total -= freed
+		reclaimed += freed
+	}
+
+	logger.Info("[CacheEviction] Reclaimed %d bytes of build cache (now %d bytes)", reclaimed, total)
+	return reclaimed, nil
+}
+
+// evictionOrder returns the cache records that can be evicted, least recently used first
+func evictionOrder(records []build.CacheRecord) []build.CacheRecord {
+	candidates := make([]build.CacheRecord, 0, len(records))
+	for _, record := range records {
+		if !record.InUse {
+			candidates = append(candidates, record)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return cacheLastUsed(candidates[i]).Before(cacheLastUsed(candidates[j]))
+	})
+	return candidates
+}
+
+// cacheLastUsed returns when a cache record was last used, falling back to its creation time
+func cacheLastUsed(record build.CacheRecord) time.Time {
+	if record.LastUsedAt != nil {
+		return *record.LastUsedAt
+	}
+	return record.CreatedAt
+}
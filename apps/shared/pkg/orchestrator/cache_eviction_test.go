@@ -0,0 +1,99 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/moby/moby/api/types/build"
+	"github.com/moby/moby/client"
+)
+
+const gb = 1024 * 1024 * 1024
+
+type fakeBuildCacheClient struct {
+	records []build.CacheRecord
+	pruned  []string
+}
+
+func (f *fakeBuildCacheClient) DiskUsage(ctx context.Context, options client.DiskUsageOptions) (client.DiskUsageResult, error) {
+	var total int64
+	for _, record := range f.records {
+		total += record.Size
+	}
+	return client.DiskUsageResult{BuildCache: client.BuildCacheDiskUsage{TotalSize: total, Items: f.records}}, nil
+}
+
+func (f *fakeBuildCacheClient) BuildCachePrune(ctx context.Context, opts client.BuildCachePruneOptions) (client.BuildCachePruneResult, error) {
+	var result client.BuildCachePruneResult
+	for i, record := range f.records {
+		if opts.Filters["id"][record.ID] {
+			f.pruned = append(f.pruned, record.ID)
+			f.records = append(f.records[:i], f.records[i+1:]...)
+			result.Report.CachesDeleted = []string{record.ID}
+			result.Report.SpaceReclaimed = uint64(record.Size)
+			break
+		}
+	}
+	return result, nil
+}
+
+func cacheRecord(id string, size int64, lastUsed time.Time) build.CacheRecord {
+	return build.CacheRecord{ID: id, Size: size, CreatedAt: lastUsed.Add(-time.Hour), LastUsedAt: &lastUsed}
+}
+
+func TestCacheEvictionStopsBelowThreshold(t *testing.T) {
+	now := time.Now()
+	docker := &fakeBuildCacheClient{records: []build.CacheRecord{
+		cacheRecord("newest", 2*gb, now),
+		cacheRecord("oldest", 2*gb, now.Add(-72*time.Hour)),
+		cacheRecord("middle", 2*gb, now.Add(-24*time.Hour)),
+		cacheRecord("older", 2*gb, now.Add(-48*time.Hour)),
+	}}
+
+	// 8GB in use with a 5GB limit: evicting the two oldest brings it to 4GB
+	reclaimed, err := NewCacheEvictionManager(docker, 5*gb).Evict(context.Background())
+	if err != nil {
+		t.Fatalf("Evict: %v", err)
+	}
+	if reclaimed != 4*gb {
+		t.Fatalf("reclaimed = %d, want %d", reclaimed, 4*gb)
+	}
+	if len(docker.pruned) != 2 || docker.pruned[0] != "oldest" || docker.pruned[1] != "older" {
+		t.Fatalf("pruned = %v, want [oldest older]", docker.pruned)
+	}
+	for _, record := range docker.records {
+		if record.ID == "newest" {
+			return
+		}
+	}
+	t.Fatal("most recently used cache was evicted")
+}
+
+func TestCacheEvictionWithinLimit(t *testing.T) {
+	docker := &fakeBuildCacheClient{records: []build.CacheRecord{cacheRecord("only", 1*gb, time.Now())}}
+
+	if _, err := NewCacheEvictionManager(docker, 5*gb).Evict(context.Background()); err != nil {
+		t.Fatalf("Evict: %v", err)
+	}
+	if len(docker.pruned) != 0 {
+		t.Fatalf("pruned = %v, want nothing", docker.pruned)
+	}
+}
+
+func TestCacheEvictionSkipsInUse(t *testing.T) {
+	now := time.Now()
+	inUse := cacheRecord("building", 4*gb, now.Add(-time.Hour))
+	inUse.InUse = true
+	docker := &fakeBuildCacheClient{records: []build.CacheRecord{
+		inUse,
+		cacheRecord("recent", 2*gb, now),
+	}}
+
+	if _, err := NewCacheEvictionManager(docker, 3*gb).Evict(context.Background()); err != nil {
+		t.Fatalf("Evict: %v", err)
+	}
+	if len(docker.pruned) != 1 || docker.pruned[0] != "recent" {
+		t.Fatalf("pruned = %v, want [recent]", docker.pruned)
+	}
+}
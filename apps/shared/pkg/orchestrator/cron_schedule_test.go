@@ -0,0 +1,97 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	at := func(value string) time.Time {
+		t.Helper()
+		parsed, err := time.Parse("2006-01-02 15:04", value)
+		if err != nil {
+			t.Fatalf("parse %q: %v", value, err)
+		}
+		return parsed
+	}
+
+	tests := []struct {
+		expression string
+		after      string
+		want       string
+	}{
+		{"* * * * *", "2026-03-10 08:00", "2026-03-10 08:01"},
+		{"*/15 * * * *", "2026-03-10 08:07", "2026-03-10 08:15"},
+		{"*/15 * * * *", "2026-03-10 08:45", "2026-03-10 09:00"},
+		{"5/20 * * * *", "2026-03-10 08:30", "2026-03-10 08:45"},
+		{"@hourly", "2026-03-10 23:30", "2026-03-11 00:00"},
+		{"30 2 * * *", "2026-03-10 02:30", "2026-03-11 02:30"},
+		// Scale up on weekday mornings: Friday evening rolls over to Monday
+		{"0 9 * * 1-5", "2026-03-13 18:00", "2026-03-16 09:00"},
+		{"0 9 * * mon-fri", "2026-03-16 08:59", "2026-03-16 09:00"},
+		{"0 9-17/4 * * *", "2026-03-10 13:00", "2026-03-10 17:00"},
+		{"0,30 8 * * *", "2026-03-10 08:10", "2026-03-10 08:30"},
+		{"0 0 * * 7", "2026-03-10 00:00", "2026-03-15 00:00"},
+		{"@monthly", "2026-01-31 12:00", "2026-02-01 00:00"},
+		{"0 0 31 * *", "2026-04-01 00:00", "2026-05-31 00:00"},
+		{"0 12 1 jan,jul *", "2026-02-01 00:00", "2026-07-01 12:00"},
+		{"@yearly", "2026-12-31 23:59", "2027-01-01 00:00"},
+		{"0 0 29 2 *", "2026-03-01 00:00", "2028-02-29 00:00"},
+		// Both day fields restricted: either may match
+		{"0 0 13 * 5", "2026-03-01 00:00", "2026-03-06 00:00"},
+		{"0 0 13 * 5", "2026-03-10 00:00", "2026-03-13 00:00"},
+		// Only the day of month restricted
+		{"0 0 13 * *", "2026-03-01 00:00", "2026-03-13 00:00"},
+	}
+	for _, tt := range tests {
+		schedule, err := ParseCronExpression(tt.expression)
+		if err != nil {
+			t.Fatalf("ParseCronExpression(%q): %v", tt.expression, err)
+		}
+		if got := schedule.Next(at(tt.after)); !got.Equal(at(tt.want)) {
+			t.Errorf("%q after %s = %s, want %s", tt.expression, tt.after, got.Format("2006-01-02 15:04"), tt.want)
+		}
+	}
+}
+
+func TestCronScheduleNextConvertsToUTC(t *testing.T) {
+	schedule, err := ParseCronExpression("0 9 * * *")
+	if err != nil {
+		t.Fatalf("ParseCronExpression: %v", err)
+	}
+	after := time.Date(2026, 3, 10, 10, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+	if got, want := schedule.Next(after), time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("Next = %s, want %s", got, want)
+	}
+}
+
+func TestCronScheduleNeverMatches(t *testing.T) {
+	schedule, err := ParseCronExpression("0 0 30 2 *")
+	if err != nil {
+		t.Fatalf("ParseCronExpression: %v", err)
+	}
+	if got := schedule.Next(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)); !got.IsZero() {
+		t.Fatalf("Next = %s, want the zero time", got)
+	}
+}
+
+func TestParseCronExpressionErrors(t *testing.T) {
+	for _, expression := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+		"@every 5m",
+	} {
+		if _, err := ParseCronExpression(expression); err == nil {
+			t.Errorf("ParseCronExpression(%q) succeeded", expression)
+		}
+	}
+}
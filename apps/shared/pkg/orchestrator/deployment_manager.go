@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/obiente/cloud/apps/shared/pkg/database"
@@ -27,6 +28,10 @@ type DeploymentManager struct {
 	nodeID       string
 	nodeHostname string
 	forwarder    *NodeForwarder
+
+	// cutovers holds the blue-green deployments still in their confirm period, by deployment ID
+	cutoverMu sync.Mutex
+	cutovers  map[string]*blueGreenCutover
 }
 
 type dockerHelper interface {
@@ -142,13 +147,29 @@ func (dm *DeploymentManager) GetNodeHostname() string {
 	return dm.nodeHostname
 }
 
-func (dm *DeploymentManager) SelectTargetNode(ctx context.Context, preferredNodeID string) (*database.NodeMetadata, error) {
+// SelectTargetNode resolves the node a deployment should run on: the preferred node if set,
+// otherwise the node picked by the selection strategy under the deployment's affinity rules
+func (dm *DeploymentManager) SelectTargetNode(ctx context.Context, deploymentID, preferredNodeID string) (*database.NodeMetadata, error) {
 	preferredNodeID = strings.TrimSpace(preferredNodeID)
 	if preferredNodeID == "" {
 		preferredNodeID = TargetNodeFromContext(ctx)
 	}
 	if preferredNodeID == "" {
-		return dm.nodeSelector.SelectNode(ctx)
+		if deploymentID == "" {
+			return dm.nodeSelector.SelectNode(ctx)
+		}
+		rules, err := database.GetDeploymentAffinityRules(deploymentID)
+		if err != nil {
+			return nil, err
+		}
+		if len(rules) == 0 {
+			return dm.nodeSelector.SelectNode(ctx)
+		}
+		var deployment database.Deployment
+		if err := database.DB.WithContext(ctx).Select("id", "organization_id").First(&deployment, "id = ?", deploymentID).Error; err != nil {
+			return nil, fmt.Errorf("failed to get deployment %s: %w", deploymentID, err)
+		}
+		return dm.nodeSelector.SelectNodeWithAffinity(ctx, deployment.OrganizationID, AffinityRulesFromDatabase(rules))
 	}
 
 	var node database.NodeMetadata
@@ -158,6 +179,11 @@ func (dm *DeploymentManager) SelectTargetNode(ctx context.Context, preferredNode
 	return &node, nil
 }
 
+// NodeSelectionStrategy returns the name of the strategy used to place new deployments
+func (dm *DeploymentManager) NodeSelectionStrategy() string {
+	return dm.nodeSelector.StrategyName()
+}
+
 // SyncNodeMetadata syncs node metadata with Docker Swarm/local Docker daemon
 // This updates node resource usage (CPU, memory) and other metadata
 func (dm *DeploymentManager) SyncNodeMetadata(ctx context.Context) error {
@@ -0,0 +1,434 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/utils"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+)
+
+// Blue-green deployments for plain (non-Swarm) containers
+//
+// The containers of a deployment are either "blue" or "green". A new version is started in the
+// inactive color next to the active one, and only takes traffic once it is healthy. Traefik does
+// not route to containers that are still starting or unhealthy, and container labels cannot be
+// changed after creation, so the cut-over is done by taking the old color out of rotation.
+
+const (
+	colorBlue  = "blue"
+	colorGreen = "green"
+
+	// colorLabel marks which color a deployment container belongs to
+	colorLabel = "cloud.obiente.color"
+
+	// blueGreenHealthTimeout is how long a new color has to become healthy
+	blueGreenHealthTimeout = 5 * time.Minute
+	// blueGreenSettlePeriod is how long a container without a health check has to keep running
+	blueGreenSettlePeriod = 10 * time.Second
+	// blueGreenConfirmPeriod is how long the new color is watched after the cut-over before the old one is removed
+	blueGreenConfirmPeriod = 60 * time.Second
+)
+
+// otherColor returns the inactive color for the given active color
+func otherColor(color string) string {
+	if color == colorGreen {
+		return colorBlue
+	}
+	return colorGreen
+}
+
+// activeColor returns the deployment's active color, treating an unset color as blue
+func activeColor(deployment *database.Deployment) string {
+	if deployment.ActiveColor == colorGreen {
+		return colorGreen
+	}
+	return colorBlue
+}
+
+// colorContainerName returns the container name for a replica of the given color
+// Blue keeps the original naming so containers created before blue-green deployments count as blue
+func colorContainerName(deploymentID, serviceName string, replicaIndex int, color string) string {
+	if color == colorGreen {
+		return fmt.Sprintf("%s-%s-green-replica-%d", deploymentID, serviceName, replicaIndex)
+	}
+	return fmt.Sprintf("%s-%s-replica-%d", deploymentID, serviceName, replicaIndex)
+}
+
+// containerColor returns the color of a deployment container from its labels
+func containerColor(labels map[string]string) string {
+	if labels[colorLabel] == colorGreen {
+		return colorGreen
+	}
+	return colorBlue
+}
+
+// blueGreenEnabled reports whether redeployments of plain containers use blue-green deployments
+// They can be turned off with BLUE_GREEN_DEPLOYMENTS_DISABLED, e.g. on nodes without room for two versions
+func blueGreenEnabled() bool {
+	return !envFlagEnabled("BLUE_GREEN_DEPLOYMENTS_DISABLED")
+}
+
+// BlueGreenDeploy rolls out newImage without downtime
+// The new version is started in the inactive color and takes traffic once it is healthy. The previous
+// color is only removed once the new one has stayed healthy for blueGreenConfirmPeriod, which is
+// watched in the background. If newImage is empty the current image is redeployed.
+func (dm *DeploymentManager) BlueGreenDeploy(ctx context.Context, deploymentID, newImage string) error {
+	var deployment database.Deployment
+	if err := database.DB.Where("id = ?", deploymentID).First(&deployment).Error; err != nil {
+		return fmt.Errorf("failed to get deployment from database: %w", err)
+	}
+	if deployment.ComposeYaml != "" {
+		return fmt.Errorf("blue-green deployments are not supported for compose deployments")
+	}
+
+	config, err := dm.deploymentConfigFromDatabase(ctx, &deployment)
+	if err != nil {
+		return err
+	}
+	if newImage != "" {
+		config.Image = newImage
+	}
+
+	// Swarm services are already updated start-first, which gives the same guarantees
+	if utils.IsSwarmModeEnabled() {
+		return dm.CreateDeployment(ctx, config)
+	}
+
+	if err := dm.applyPlanLimits(config); err != nil {
+		logger.Warn("[DeploymentManager] Failed to apply plan limits: %v (continuing anyway)", err)
+	}
+	if err := dm.ensureNetwork(ctx); err != nil {
+		return fmt.Errorf("network is required but could not be created: %w", err)
+	}
+
+	return dm.blueGreenDeploy(ctx, config, activeColor(&deployment))
+}
+
+// blueGreenDeploy starts config in the inactive color and cuts traffic over to it
+func (dm *DeploymentManager) blueGreenDeploy(ctx context.Context, config *DeploymentConfig, active string) error {
+	target := otherColor(active)
+	logger.Info("[DeploymentManager] Blue-green deployment of %s: %s -> %s (image: %s)", config.DeploymentID, active, target, config.Image)
+
+	// A newer deployment confirms the previous cut-over, since it replaces the fallback color
+	dm.settleCutover(ctx, config.DeploymentID)
+
+	activeContainers, err := dm.colorContainers(ctx, config.DeploymentID, active)
+	if err != nil {
+		return err
+	}
+
+	created, err := dm.startColor(ctx, config, target)
+	if err != nil {
+		return err
+	}
+
+	return dm.switchColor(ctx, config.DeploymentID, active, created, activeContainers)
+}
+
+// blueGreenCutover is a blue-green deployment whose new color serves traffic but is still being confirmed
+// The previous color's containers are only stopped until the confirm period is over, so traffic can go back to them.
+type blueGreenCutover struct {
+	previous string
+	fallback []container.Summary
+	cancel   context.CancelFunc
+}
+
+// switchColor waits for the created containers to become healthy, then moves traffic to them
+// The previous color is kept as a fallback while the new one is confirmed in the background.
+func (dm *DeploymentManager) switchColor(ctx context.Context, deploymentID, active string, created []string, activeContainers []container.Summary) error {
+	target := otherColor(active)
+	for _, containerID := range created {
+		if err := dm.waitForContainerHealthy(ctx, containerID, blueGreenHealthTimeout); err != nil {
+			logger.Warn("[DeploymentManager] %s containers of deployment %s did not become healthy: %v - rolling back", target, deploymentID, err)
+			if rollbackErr := dm.Rollback(ctx, deploymentID); rollbackErr != nil {
+				logger.Error("[DeploymentManager] Failed to roll back deployment %s: %v", deploymentID, rollbackErr)
+			}
+			return fmt.Errorf("%s health check failed: %w", target, err)
+		}
+	}
+
+	// Cut over: the new color is healthy and routed, take the old one out of rotation
+	for _, c := range activeContainers {
+		if err := dm.dockerHelper.StopContainer(ctx, c.ID, 10*time.Second); err != nil {
+			logger.Warn("[DeploymentManager] Failed to stop %s container %s: %v", active, c.ID[:12], err)
+		}
+	}
+	if err := setActiveColor(deploymentID, target); err != nil {
+		return err
+	}
+
+	// The confirm period outlives the request that started the deployment
+	confirmCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	cutover := &blueGreenCutover{previous: active, fallback: activeContainers, cancel: cancel}
+	dm.cutoverMu.Lock()
+	if dm.cutovers == nil {
+		dm.cutovers = make(map[string]*blueGreenCutover)
+	}
+	dm.cutovers[deploymentID] = cutover
+	dm.cutoverMu.Unlock()
+
+	logger.Info("[DeploymentManager] Deployment %s switched to %s, confirming for %s", deploymentID, target, blueGreenConfirmPeriod)
+	go dm.confirmCutover(confirmCtx, deploymentID, created, cutover)
+	return nil
+}
+
+// confirmCutover removes the fallback color once the new one stayed healthy for blueGreenConfirmPeriod,
+// and switches traffic back to the fallback if it did not
+func (dm *DeploymentManager) confirmCutover(ctx context.Context, deploymentID string, created []string, cutover *blueGreenCutover) {
+	err := dm.confirmContainersHealthy(ctx, created, blueGreenConfirmPeriod)
+
+	// A rollback or a newer deployment may have taken over the cut-over
+	dm.cutoverMu.Lock()
+	current := dm.cutovers[deploymentID] == cutover
+	if current {
+		delete(dm.cutovers, deploymentID)
+	}
+	dm.cutoverMu.Unlock()
+	if !current {
+		return
+	}
+	defer cutover.cancel()
+
+	if err != nil {
+		logger.Warn("[DeploymentManager] %s containers of deployment %s failed after cut-over: %v - switching back to %s",
+			otherColor(cutover.previous), deploymentID, err, cutover.previous)
+		if switchErr := dm.switchBack(ctx, deploymentID, cutover); switchErr != nil {
+			logger.Error("[DeploymentManager] Failed to switch deployment %s back to %s: %v", deploymentID, cutover.previous, switchErr)
+		}
+		return
+	}
+
+	dm.removeContainers(ctx, cutover.fallback)
+	logger.Info("[DeploymentManager] Blue-green deployment of %s completed, %s is active", deploymentID, otherColor(cutover.previous))
+}
+
+// takeCutover ends a deployment's confirm period early and returns it, or nil if there is none
+func (dm *DeploymentManager) takeCutover(deploymentID string) *blueGreenCutover {
+	dm.cutoverMu.Lock()
+	defer dm.cutoverMu.Unlock()
+
+	cutover := dm.cutovers[deploymentID]
+	if cutover != nil {
+		delete(dm.cutovers, deploymentID)
+		cutover.cancel()
+	}
+	return cutover
+}
+
+// settleCutover ends a deployment's confirm period early, keeping the new color and removing the fallback
+func (dm *DeploymentManager) settleCutover(ctx context.Context, deploymentID string) {
+	if cutover := dm.takeCutover(deploymentID); cutover != nil {
+		dm.removeContainers(ctx, cutover.fallback)
+	}
+}
+
+// switchBack makes the color that served traffic before a cut-over active again and removes the new one
+func (dm *DeploymentManager) switchBack(ctx context.Context, deploymentID string, cutover *blueGreenCutover) error {
+	if err := setActiveColor(deploymentID, cutover.previous); err != nil {
+		return err
+	}
+	return dm.rollbackToActiveColor(ctx, deploymentID, cutover.previous)
+}
+
+// Rollback discards the newer color of a deployment and makes sure the color serving traffic is running
+// During a blue-green confirm period it switches traffic back to the previous color; otherwise it removes
+// the inactive color. It is used when a blue-green deployment fails, and can be called to discard a
+// deployment that is stuck.
+func (dm *DeploymentManager) Rollback(ctx context.Context, deploymentID string) error {
+	if cutover := dm.takeCutover(deploymentID); cutover != nil {
+		logger.Info("[DeploymentManager] Switching deployment %s back to %s", deploymentID, cutover.previous)
+		return dm.switchBack(ctx, deploymentID, cutover)
+	}
+
+	var deployment database.Deployment
+	if err := database.DB.Where("id = ?", deploymentID).First(&deployment).Error; err != nil {
+		return fmt.Errorf("failed to get deployment from database: %w", err)
+	}
+	return dm.rollbackToActiveColor(ctx, deploymentID, activeColor(&deployment))
+}
+
+// rollbackToActiveColor starts the active color's containers and then removes the other color
+func (dm *DeploymentManager) rollbackToActiveColor(ctx context.Context, deploymentID, active string) error {
+	activeContainers, err := dm.colorContainers(ctx, deploymentID, active)
+	if err != nil {
+		return err
+	}
+	for _, c := range activeContainers {
+		if c.State == container.StateRunning {
+			continue
+		}
+		if err := dm.dockerHelper.StartContainer(ctx, c.ID); err != nil {
+			return fmt.Errorf("failed to start %s container %s: %w", active, c.ID[:12], err)
+		}
+	}
+
+	inactiveContainers, err := dm.colorContainers(ctx, deploymentID, otherColor(active))
+	if err != nil {
+		return err
+	}
+	dm.removeContainers(ctx, inactiveContainers)
+
+	logger.Info("[DeploymentManager] Rolled back deployment %s: removed %d %s container(s), %s is active",
+		deploymentID, len(inactiveContainers), otherColor(active), active)
+	return nil
+}
+
+// colorContainers lists the containers of a deployment on this node that belong to color
+func (dm *DeploymentManager) colorContainers(ctx context.Context, deploymentID, color string) ([]container.Summary, error) {
+	filters := make(client.Filters)
+	filters.Add("label", "cloud.obiente.deployment_id="+deploymentID)
+	result, err := dm.dockerClient.ContainerList(ctx, client.ContainerListOptions{All: true, Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	var containers []container.Summary
+	for _, c := range result.Items {
+		if containerColor(c.Labels) == color {
+			containers = append(containers, c)
+		}
+	}
+	return containers, nil
+}
+
+// removeContainers stops, removes and unregisters containers
+func (dm *DeploymentManager) removeContainers(ctx context.Context, containers []container.Summary) {
+	for _, c := range containers {
+		if c.State == container.StateRunning {
+			_ = dm.dockerHelper.StopContainer(ctx, c.ID, 10*time.Second)
+		}
+		if err := dm.dockerHelper.RemoveContainer(ctx, c.ID, true); err != nil {
+			logger.Warn("[DeploymentManager] Failed to remove container %s: %v", c.ID[:12], err)
+			continue
+		}
+		_ = dm.registry.UnregisterDeployment(ctx, c.ID)
+	}
+}
+
+// waitForContainerHealthy waits until a container passes its health check
+// Containers without a health check have to keep running for blueGreenSettlePeriod
+func (dm *DeploymentManager) waitForContainerHealthy(ctx context.Context, containerID string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var runningSince time.Time
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+	for {
+		healthy, err := dm.inspectContainerHealth(ctx, containerID)
+		if err != nil {
+			return err
+		}
+		switch {
+		case healthy == container.Healthy:
+			return nil
+		case healthy == container.NoHealthcheck:
+			if runningSince.IsZero() {
+				runningSince = time.Now()
+			} else if time.Since(runningSince) >= blueGreenSettlePeriod {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("container %s did not become healthy within %s", containerID[:12], timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// confirmContainersHealthy checks that containers stay running and healthy for period
+func (dm *DeploymentManager) confirmContainersHealthy(ctx context.Context, containerIDs []string, period time.Duration) error {
+	deadline := time.Now().Add(period)
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		for _, containerID := range containerIDs {
+			if _, err := dm.inspectContainerHealth(ctx, containerID); err != nil {
+				return err
+			}
+		}
+		if !time.Now().Before(deadline) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// inspectContainerHealth returns a running container's health status, or an error if it stopped or is unhealthy
+func (dm *DeploymentManager) inspectContainerHealth(ctx context.Context, containerID string) (container.HealthStatus, error) {
+	result, err := dm.dockerClient.ContainerInspect(ctx, containerID, client.ContainerInspectOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect container %s: %w", containerID[:12], err)
+	}
+	state := result.Container.State
+	if state == nil || !state.Running {
+		return "", fmt.Errorf("container %s is not running", containerID[:12])
+	}
+	if state.Health == nil {
+		return container.NoHealthcheck, nil
+	}
+	if state.Health.Status == container.Unhealthy {
+		return "", fmt.Errorf("container %s is unhealthy", containerID[:12])
+	}
+	return state.Health.Status, nil
+}
+
+// registerContainerLocation records where a deployment container runs
+func (dm *DeploymentManager) registerContainerLocation(ctx context.Context, config *DeploymentConfig, containerID, serviceName string, routings []database.DeploymentRouting) {
+	port := config.Port
+	for _, routing := range routings {
+		if routing.ServiceName == serviceName || (serviceName == "default" && routing.ServiceName == "") {
+			port = routing.TargetPort
+			break
+		}
+	}
+
+	location := &database.DeploymentLocation{
+		ID:           fmt.Sprintf("loc-%s-%s", config.DeploymentID, containerID[:12]),
+		DeploymentID: config.DeploymentID,
+		NodeID:       dm.nodeID,
+		NodeHostname: dm.nodeHostname,
+		ContainerID:  containerID,
+		Status:       "running",
+		Port:         port,
+		Domain:       config.Domain,
+		HealthStatus: "unknown",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	if err := dm.registry.RegisterDeployment(ctx, location); err != nil {
+		logger.Warn("[DeploymentManager] Failed to register deployment: %v", err)
+	}
+}
+
+// setActiveColor stores the color that serves a deployment's traffic
+func setActiveColor(deploymentID, color string) error {
+	if err := database.DB.Model(&database.Deployment{}).Where("id = ?", deploymentID).
+		UpdateColumn("active_color", color).Error; err != nil {
+		return fmt.Errorf("failed to update active color: %w", err)
+	}
+	return nil
+}
+
+// hasRunningContainer reports whether any of the containers is running
+func hasRunningContainer(containers []container.Summary) bool {
+	for _, c := range containers {
+		if c.State == container.StateRunning {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,340 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/registry"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestOtherColor(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		color string
+		want  string
+	}{
+		{color: colorBlue, want: colorGreen},
+		{color: colorGreen, want: colorBlue},
+		{color: "", want: colorGreen},
+	}
+
+	for _, tc := range cases {
+		if got := otherColor(tc.color); got != tc.want {
+			t.Fatalf("otherColor(%q) = %q, want %q", tc.color, got, tc.want)
+		}
+	}
+}
+
+func TestColorContainerName(t *testing.T) {
+	t.Parallel()
+
+	// Blue keeps the pre blue-green name so existing containers are treated as blue
+	if got := colorContainerName("dep-1", "default", 0, colorBlue); got != "dep-1-default-replica-0" {
+		t.Fatalf("blue container name = %q", got)
+	}
+	if got := colorContainerName("dep-1", "web", 2, colorGreen); got != "dep-1-web-green-replica-2" {
+		t.Fatalf("green container name = %q", got)
+	}
+}
+
+func TestContainerColor(t *testing.T) {
+	t.Parallel()
+
+	if got := containerColor(map[string]string{colorLabel: colorGreen}); got != colorGreen {
+		t.Fatalf("labelled green container = %q, want green", got)
+	}
+	if got := containerColor(map[string]string{"cloud.obiente.deployment_id": "dep-1"}); got != colorBlue {
+		t.Fatalf("unlabelled container = %q, want blue", got)
+	}
+}
+
+func TestDeploymentServiceNames(t *testing.T) {
+	t.Parallel()
+
+	if got := deploymentServiceNames(nil); !reflect.DeepEqual(got, []string{"default"}) {
+		t.Fatalf("service names without routing = %v, want [default]", got)
+	}
+
+	routings := []database.DeploymentRouting{
+		{ServiceName: ""},
+		{ServiceName: "api"},
+		{ServiceName: "api"},
+	}
+	if got := deploymentServiceNames(routings); !reflect.DeepEqual(got, []string{"default", "api"}) {
+		t.Fatalf("service names = %v, want [default api]", got)
+	}
+}
+
+func TestSwitchColorWaitsForHealthyContainers(t *testing.T) {
+	dm, docker := newBlueGreenTestManager(t)
+	docker.add("blue-container-0001", colorBlue, true, container.NoHealthcheck)
+	docker.add("green-container-001", colorGreen, true, container.Unhealthy)
+
+	blue, _ := dm.colorContainers(context.Background(), "dep-1", colorBlue)
+	if err := dm.switchColor(context.Background(), "dep-1", colorBlue, []string{"green-container-001"}, blue); err == nil {
+		t.Fatal("switchColor() succeeded with unhealthy containers")
+	}
+
+	if got := blueGreenActiveColor(t); got != colorBlue {
+		t.Fatalf("active color = %q, want blue", got)
+	}
+	if !docker.running("blue-container-0001") {
+		t.Fatal("blue container was stopped although green never became healthy")
+	}
+	if docker.exists("green-container-001") {
+		t.Fatal("unhealthy green container was not removed")
+	}
+}
+
+func TestSwitchColorKeepsFallbackUntilConfirmed(t *testing.T) {
+	dm, docker := newBlueGreenTestManager(t)
+	docker.add("blue-container-0001", colorBlue, true, container.NoHealthcheck)
+	docker.add("green-container-001", colorGreen, true, container.Healthy)
+
+	blue, _ := dm.colorContainers(context.Background(), "dep-1", colorBlue)
+	start := time.Now()
+	if err := dm.switchColor(context.Background(), "dep-1", colorBlue, []string{"green-container-001"}, blue); err != nil {
+		t.Fatalf("switchColor() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= blueGreenConfirmPeriod {
+		t.Fatalf("switchColor() blocked for the confirm period (%s)", elapsed)
+	}
+
+	if got := blueGreenActiveColor(t); got != colorGreen {
+		t.Fatalf("active color = %q, want green", got)
+	}
+	if docker.running("blue-container-0001") || !docker.exists("blue-container-0001") {
+		t.Fatal("blue container should be stopped but kept during the confirm period")
+	}
+
+	// Rolling back during the confirm period switches traffic back to blue
+	if err := dm.Rollback(context.Background(), "dep-1"); err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+	if got := blueGreenActiveColor(t); got != colorBlue {
+		t.Fatalf("active color after rollback = %q, want blue", got)
+	}
+	if !docker.running("blue-container-0001") {
+		t.Fatal("blue container was not restarted by the rollback")
+	}
+	if docker.exists("green-container-001") {
+		t.Fatal("green container was not removed by the rollback")
+	}
+}
+
+func TestSwitchColorSwitchesBackWhenNewColorFails(t *testing.T) {
+	dm, docker := newBlueGreenTestManager(t)
+	docker.add("blue-container-0001", colorBlue, true, container.NoHealthcheck)
+	docker.add("green-container-001", colorGreen, true, container.Healthy)
+
+	blue, _ := dm.colorContainers(context.Background(), "dep-1", colorBlue)
+	if err := dm.switchColor(context.Background(), "dep-1", colorBlue, []string{"green-container-001"}, blue); err != nil {
+		t.Fatalf("switchColor() error = %v", err)
+	}
+	docker.setHealth("green-container-001", container.Unhealthy)
+
+	deadline := time.Now().Add(15 * time.Second)
+	for docker.exists("green-container-001") {
+		if time.Now().After(deadline) {
+			t.Fatal("failing green container was not removed after the cut-over")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if got := blueGreenActiveColor(t); got != colorBlue {
+		t.Fatalf("active color = %q, want blue", got)
+	}
+	if !docker.running("blue-container-0001") {
+		t.Fatal("blue container was not restarted")
+	}
+}
+
+func newBlueGreenTestManager(t *testing.T) (*DeploymentManager, *fakeBlueGreenDocker) {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(&database.Deployment{}, &database.DeploymentLocation{}); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+	if err := db.Create(&database.Deployment{ID: "dep-1", ActiveColor: colorBlue}).Error; err != nil {
+		t.Fatalf("seed deployment: %v", err)
+	}
+	previousDB := database.DB
+	database.DB = db
+	t.Cleanup(func() { database.DB = previousDB })
+
+	docker := &fakeBlueGreenDocker{containers: make(map[string]*fakeBlueGreenContainer)}
+	dm := &DeploymentManager{dockerClient: docker, dockerHelper: docker, registry: &registry.ServiceRegistry{}}
+	// Stop the background confirmation before the database is swapped back
+	t.Cleanup(func() { dm.takeCutover("dep-1") })
+	return dm, docker
+}
+
+func blueGreenActiveColor(t *testing.T) string {
+	t.Helper()
+
+	var deployment database.Deployment
+	if err := database.DB.First(&deployment, "id = ?", "dep-1").Error; err != nil {
+		t.Fatalf("load deployment: %v", err)
+	}
+	return deployment.ActiveColor
+}
+
+type fakeBlueGreenContainer struct {
+	color   string
+	running bool
+	health  container.HealthStatus
+	// traffic is added to the network counters every time stats are read
+	traffic      uint64
+	networkBytes uint64
+}
+
+// fakeBlueGreenDocker is an in-memory Docker daemon holding the containers of deployment dep-1
+type fakeBlueGreenDocker struct {
+	client.APIClient
+
+	mu         sync.Mutex
+	containers map[string]*fakeBlueGreenContainer
+	// stopTimeouts records the timeout every container was stopped with
+	stopTimeouts map[string]time.Duration
+}
+
+func (d *fakeBlueGreenDocker) add(id, color string, running bool, health container.HealthStatus) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.containers[id] = &fakeBlueGreenContainer{color: color, running: running, health: health}
+}
+
+func (d *fakeBlueGreenDocker) setHealth(id string, health container.HealthStatus) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.containers[id].health = health
+}
+
+func (d *fakeBlueGreenDocker) setTraffic(id string, traffic uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.containers[id].traffic = traffic
+}
+
+func (d *fakeBlueGreenDocker) stopTimeout(id string) (time.Duration, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	timeout, ok := d.stopTimeouts[id]
+	return timeout, ok
+}
+
+func (d *fakeBlueGreenDocker) exists(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.containers[id] != nil
+}
+
+func (d *fakeBlueGreenDocker) running(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.containers[id] != nil && d.containers[id].running
+}
+
+func (d *fakeBlueGreenDocker) ContainerList(ctx context.Context, options client.ContainerListOptions) (client.ContainerListResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var result client.ContainerListResult
+	for id, c := range d.containers {
+		state := container.StateExited
+		if c.running {
+			state = container.StateRunning
+		}
+		result.Items = append(result.Items, container.Summary{
+			ID:     id,
+			State:  state,
+			Labels: map[string]string{"cloud.obiente.deployment_id": "dep-1", colorLabel: c.color},
+		})
+	}
+	return result, nil
+}
+
+func (d *fakeBlueGreenDocker) ContainerInspect(ctx context.Context, containerID string, options client.ContainerInspectOptions) (client.ContainerInspectResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c := d.containers[containerID]
+	if c == nil {
+		return client.ContainerInspectResult{}, fmt.Errorf("no such container: %s", containerID)
+	}
+	state := &container.State{Running: c.running}
+	if c.health != container.NoHealthcheck {
+		state.Health = &container.Health{Status: c.health}
+	}
+	return client.ContainerInspectResult{Container: container.InspectResponse{State: state}}, nil
+}
+
+func (d *fakeBlueGreenDocker) ContainerStats(ctx context.Context, containerID string, options client.ContainerStatsOptions) (client.ContainerStatsResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c := d.containers[containerID]
+	if c == nil || !c.running {
+		return client.ContainerStatsResult{}, fmt.Errorf("container %s is not running", containerID)
+	}
+	c.networkBytes += c.traffic
+	stats := fmt.Sprintf(`{"networks":{"eth0":{"rx_bytes":%d,"tx_bytes":%d}}}`, c.networkBytes/2, c.networkBytes-c.networkBytes/2)
+	return client.ContainerStatsResult{Body: io.NopCloser(strings.NewReader(stats))}, nil
+}
+
+func (d *fakeBlueGreenDocker) StartContainer(ctx context.Context, containerID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if c := d.containers[containerID]; c != nil {
+		c.running = true
+	}
+	return nil
+}
+
+func (d *fakeBlueGreenDocker) StopContainer(ctx context.Context, containerID string, timeout time.Duration) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if c := d.containers[containerID]; c != nil {
+		c.running = false
+	}
+	if d.stopTimeouts == nil {
+		d.stopTimeouts = make(map[string]time.Duration)
+	}
+	d.stopTimeouts[containerID] = timeout
+	return nil
+}
+
+func (d *fakeBlueGreenDocker) RemoveContainer(ctx context.Context, containerID string, force bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.containers, containerID)
+	return nil
+}
+
+func (d *fakeBlueGreenDocker) RestartContainer(ctx context.Context, containerID string, timeout time.Duration) error {
+	return nil
+}
+
+func (d *fakeBlueGreenDocker) ContainerLogs(ctx context.Context, containerID string, tail string, follow bool, since *time.Time, until *time.Time) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (d *fakeBlueGreenDocker) ContainerExecRun(ctx context.Context, containerID string, cmd []string) (string, error) {
+	return "", fmt.Errorf("not implemented")
+}
@@ -374,6 +374,10 @@ func (dm *DeploymentManager) createContainer(ctx context.Context, config *Deploy
 	for k, v := range config.Labels {
 		labels[k] = v
 	}
+	// Containers of a zero-downtime deployment outrank the ones they replace (see DrainAndReplace)
+	if weight, err := strconv.Atoi(labels[routingWeightLabel]); err == nil {
+		applyRoutingWeight(labels, weight)
+	}
 
 	// Prepare environment variables
 	env := []string{}
@@ -584,13 +588,6 @@ func (dm *DeploymentManager) createContainer(ctx context.Context, config *Deploy
 		containerConfig.Cmd = append([]string{}, startArgs...)
 	}
 
-	// Convert CPU shares to NanoCPUs for hard CPU limit
-	// CPUShares is in units where 1024 = 1 CPU core
-	// NanoCPUs: 1 CPU = 1,000,000,000 nanoseconds (1e9)
-	// This sets an absolute CPU limit, not just relative priority
-	cpuCores := float64(config.CPUShares) / 1024.0
-	nanoCPUs := int64(cpuCores * 1e9)
-
 	// Host configuration
 	binds, _ := sanitizedVolumeMounts(config.DeploymentID, config.Volumes)
 	hostConfig := &container.HostConfig{
@@ -599,11 +596,7 @@ func (dm *DeploymentManager) createContainer(ctx context.Context, config *Deploy
 		RestartPolicy: container.RestartPolicy{
 			Name: "unless-stopped",
 		},
-		Resources: container.Resources{
-			Memory:    config.Memory,
-			CPUShares: config.CPUShares, // Relative priority (for scheduling)
-			NanoCPUs:  nanoCPUs,         // Hard CPU limit (prevents exceeding allocated CPUs)
-		},
+		Resources: deploymentContainerResources(config.CPUShares, config.Memory),
 		// SECURITY: Explicitly set network mode to bridge (default) to prevent host network access
 		NetworkMode: container.NetworkMode(dm.networkName),
 		// SECURITY: Disable privileged mode to prevent container from gaining host access
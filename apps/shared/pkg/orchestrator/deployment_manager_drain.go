@@ -0,0 +1,324 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/utils"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+)
+
+// Zero-downtime deployments with connection draining
+//
+// A blue-green cut-over stops the old color as soon as the new one is healthy, which drops requests
+// still in flight on it. DrainAndReplace instead creates the new color with a higher routing weight:
+// its Traefik routers get their own names and a higher priority, so once Traefik discovers the healthy
+// containers every new request goes to them, while the old color keeps running to finish the requests
+// it already has. The old color is removed once its network traffic stops, or force-stopped when the
+// drain timeout runs out.
+
+const (
+	// routingWeightLabel holds the routing weight a container's Traefik router priorities were raised by
+	routingWeightLabel = "cloud.obiente.routing_weight"
+	// maxRoutingWeight keeps raised wildcard router priorities (100) below exact domain routers (200)
+	maxRoutingWeight = 99
+
+	// DefaultDrainTimeoutSeconds is how long zero-downtime deployments wait for the old containers to drain
+	DefaultDrainTimeoutSeconds int32 = 30
+	// drainIdleBytes is the traffic below which a container counts as idle over a poll interval
+	// (TCP keepalives and ARP still trickle through a drained container)
+	drainIdleBytes = 1024
+)
+
+// drainPollInterval is how often the network counters of draining containers are sampled
+var drainPollInterval = 2 * time.Second
+
+// DrainAndReplace rolls out newImage without dropping in-flight requests
+// The new version is started in the inactive color and, once healthy, takes all new requests. The
+// previous color is removed after its connections drain, and force-stopped if they have not drained
+// within drainTimeoutSeconds. If newImage is empty the current image is redeployed.
+func (dm *DeploymentManager) DrainAndReplace(ctx context.Context, deploymentID, newImage string, drainTimeoutSeconds int32) error {
+	var deployment database.Deployment
+	if err := database.DB.Where("id = ?", deploymentID).First(&deployment).Error; err != nil {
+		return fmt.Errorf("failed to get deployment from database: %w", err)
+	}
+	if deployment.ComposeYaml != "" {
+		return fmt.Errorf("zero-downtime deployments are not supported for compose deployments")
+	}
+
+	config, err := dm.deploymentConfigFromDatabase(ctx, &deployment)
+	if err != nil {
+		return err
+	}
+	if newImage != "" {
+		config.Image = newImage
+	}
+
+	// Swarm services are updated start-first, and the swarm ingress keeps existing connections
+	if utils.IsSwarmModeEnabled() {
+		return dm.CreateDeployment(ctx, config)
+	}
+
+	if err := dm.applyPlanLimits(config); err != nil {
+		logger.Warn("[DeploymentManager] Failed to apply plan limits: %v (continuing anyway)", err)
+	}
+	if err := dm.ensureNetwork(ctx); err != nil {
+		return fmt.Errorf("network is required but could not be created: %w", err)
+	}
+
+	return dm.drainAndReplace(ctx, config, activeColor(&deployment), drainTimeout(drainTimeoutSeconds))
+}
+
+// drainTimeout converts a drain timeout in seconds, using the default for unset or negative values
+func drainTimeout(seconds int32) time.Duration {
+	if seconds <= 0 {
+		seconds = DefaultDrainTimeoutSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// drainAndReplace starts config in the inactive color, routes new requests to it and drains the active color
+func (dm *DeploymentManager) drainAndReplace(ctx context.Context, config *DeploymentConfig, active string, timeout time.Duration) error {
+	// A newer deployment confirms the previous cut-over, since it replaces the fallback color
+	dm.settleCutover(ctx, config.DeploymentID)
+
+	activeContainers, err := dm.colorContainers(ctx, config.DeploymentID, active)
+	if err != nil {
+		return err
+	}
+
+	weight := nextRoutingWeight(activeContainers)
+	if weight > maxRoutingWeight {
+		// The new color cannot outrank the old one; a plain cut-over resets the weight
+		logger.Warn("[DeploymentManager] Routing weight of deployment %s is exhausted, using a blue-green cut-over without draining", config.DeploymentID)
+		return dm.blueGreenDeploy(ctx, config, active)
+	}
+
+	target := otherColor(active)
+	logger.Info("[DeploymentManager] Zero-downtime deployment of %s: %s -> %s (image: %s, routing weight %d, drain timeout %s)",
+		config.DeploymentID, active, target, config.Image, weight, timeout)
+
+	labels := make(map[string]string, len(config.Labels)+1)
+	for k, v := range config.Labels {
+		labels[k] = v
+	}
+	labels[routingWeightLabel] = strconv.Itoa(weight)
+	config.Labels = labels
+
+	created, err := dm.startColor(ctx, config, target)
+	if err != nil {
+		return err
+	}
+	return dm.drainCutover(ctx, config.DeploymentID, active, created, activeContainers, timeout)
+}
+
+// drainCutover waits for the created containers to become healthy, then drains and removes the active color
+// The created containers outrank the active ones in Traefik, so they take every new request once healthy.
+func (dm *DeploymentManager) drainCutover(ctx context.Context, deploymentID, active string, created []string, activeContainers []container.Summary, timeout time.Duration) error {
+	target := otherColor(active)
+	for _, containerID := range created {
+		if err := dm.waitForContainerHealthy(ctx, containerID, blueGreenHealthTimeout); err != nil {
+			logger.Warn("[DeploymentManager] %s containers of deployment %s did not become healthy: %v - rolling back", target, deploymentID, err)
+			if rollbackErr := dm.Rollback(ctx, deploymentID); rollbackErr != nil {
+				logger.Error("[DeploymentManager] Failed to roll back deployment %s: %v", deploymentID, rollbackErr)
+			}
+			return fmt.Errorf("%s health check failed: %w", target, err)
+		}
+	}
+	if err := setActiveColor(deploymentID, target); err != nil {
+		return err
+	}
+
+	if dm.drainContainers(ctx, activeContainers, timeout) {
+		dm.removeContainers(ctx, activeContainers)
+		logger.Info("[DeploymentManager] Zero-downtime deployment of %s completed, %s drained and removed", deploymentID, active)
+		return nil
+	}
+
+	logger.Warn("[DeploymentManager] %s containers of deployment %s still had traffic after %s, force-stopping them", active, deploymentID, timeout)
+	dm.forceRemoveContainers(ctx, activeContainers)
+	return nil
+}
+
+// nextRoutingWeight returns a routing weight above that of every container in containers
+func nextRoutingWeight(containers []container.Summary) int {
+	highest := 0
+	for _, c := range containers {
+		if weight, err := strconv.Atoi(c.Labels[routingWeightLabel]); err == nil && weight > highest {
+			highest = weight
+		}
+	}
+	return highest + 1
+}
+
+// applyRoutingWeight gives a container's Traefik routers and services their own names and raises the router
+// priorities by weight, so Traefik sends requests matching the routes to it instead of lower weight containers
+// Routers and services are renamed because Traefik drops routers defined differently by several containers.
+func applyRoutingWeight(labels map[string]string, weight int) {
+	if weight <= 0 {
+		return
+	}
+	const routersPrefix, servicesPrefix = "traefik.http.routers.", "traefik.http.services."
+	suffix := "-w" + strconv.Itoa(weight)
+
+	weighted := make(map[string]string)
+	for key, value := range labels {
+		prefix := routersPrefix
+		rest, ok := strings.CutPrefix(key, routersPrefix)
+		if !ok {
+			prefix = servicesPrefix
+			if rest, ok = strings.CutPrefix(key, servicesPrefix); !ok {
+				continue
+			}
+		}
+		name, attribute, ok := strings.Cut(rest, ".")
+		if !ok {
+			continue
+		}
+
+		if prefix == routersPrefix {
+			switch attribute {
+			case "priority":
+				if priority, err := strconv.Atoi(value); err == nil {
+					value = strconv.Itoa(priority + weight)
+				}
+			case "service":
+				value += suffix
+			}
+		}
+		delete(labels, key)
+		weighted[prefix+name+suffix+"."+attribute] = value
+	}
+	for key, value := range weighted {
+		labels[key] = value
+	}
+}
+
+// startColor creates and starts the containers of every service of config in color, returning their IDs
+// If a container cannot be created or started the deployment is rolled back.
+func (dm *DeploymentManager) startColor(ctx context.Context, config *DeploymentConfig, color string) ([]string, error) {
+	labels := make(map[string]string, len(config.Labels)+1)
+	for k, v := range config.Labels {
+		labels[k] = v
+	}
+	labels[colorLabel] = color
+	config.Labels = labels
+
+	routings, _ := database.GetDeploymentRoutings(config.DeploymentID)
+	var created []string
+	for _, serviceName := range deploymentServiceNames(routings) {
+		for i := 0; i < config.Replicas; i++ {
+			containerName := colorContainerName(config.DeploymentID, serviceName, i, color)
+			// A leftover from an earlier attempt would block the name
+			if err := dm.removeContainerByName(ctx, containerName); err != nil {
+				logger.Warn("[DeploymentManager] Failed to remove existing container %s: %v (will attempt to create anyway)", containerName, err)
+			}
+
+			containerID, err := dm.createContainer(ctx, config, containerName, i, serviceName)
+			if err != nil {
+				_ = dm.Rollback(ctx, config.DeploymentID)
+				return nil, fmt.Errorf("failed to create %s container: %w", color, err)
+			}
+			created = append(created, containerID)
+
+			if err := dm.dockerHelper.StartContainer(ctx, containerID); err != nil {
+				_ = dm.Rollback(ctx, config.DeploymentID)
+				return nil, fmt.Errorf("failed to start %s container: %w", color, err)
+			}
+			dm.registerContainerLocation(ctx, config, containerID, serviceName, routings)
+		}
+	}
+	return created, nil
+}
+
+// drainContainers waits until the running containers stop sending and receiving traffic
+// Docker does not report connection counts, so a container counts as drained once its network counters
+// grow by less than drainIdleBytes over a poll interval. Returns false if they are still busy after timeout.
+func (dm *DeploymentManager) drainContainers(ctx context.Context, containers []container.Summary, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	previous := make(map[string]uint64)
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for {
+		busy := false
+		for _, c := range containers {
+			if c.State != container.StateRunning {
+				continue
+			}
+			total, err := dm.containerNetworkBytes(ctx, c.ID)
+			if err != nil {
+				// A container that stopped or disappeared has nothing left to drain
+				logger.Debug("[DeploymentManager] Not draining container %s: %v", c.ID[:12], err)
+				continue
+			}
+			last, sampled := previous[c.ID]
+			if !sampled || total < last || total-last >= drainIdleBytes {
+				busy = true
+			}
+			previous[c.ID] = total
+		}
+		if !busy {
+			return true
+		}
+		if !time.Now().Before(deadline) {
+			return false
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// containerNetworkBytes returns the bytes a container has received and sent on all its interfaces
+func (dm *DeploymentManager) containerNetworkBytes(ctx context.Context, containerID string) (uint64, error) {
+	statsCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	stats, err := dm.dockerClient.ContainerStats(statsCtx, containerID, client.ContainerStatsOptions{Stream: false})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get container stats: %w", err)
+	}
+	defer stats.Body.Close()
+
+	var statsJSON struct {
+		Networks map[string]struct {
+			RxBytes uint64 `json:"rx_bytes"`
+			TxBytes uint64 `json:"tx_bytes"`
+		} `json:"networks"`
+	}
+	if err := json.NewDecoder(stats.Body).Decode(&statsJSON); err != nil {
+		return 0, fmt.Errorf("failed to decode container stats: %w", err)
+	}
+
+	var total uint64
+	for _, network := range statsJSON.Networks {
+		total += network.RxBytes + network.TxBytes
+	}
+	return total, nil
+}
+
+// forceRemoveContainers kills containers without waiting for a graceful shutdown, then removes them
+func (dm *DeploymentManager) forceRemoveContainers(ctx context.Context, containers []container.Summary) {
+	stopped := make([]container.Summary, 0, len(containers))
+	for _, c := range containers {
+		if c.State == container.StateRunning {
+			if err := dm.dockerHelper.StopContainer(ctx, c.ID, 0); err != nil {
+				logger.Warn("[DeploymentManager] Failed to force-stop container %s: %v", c.ID[:12], err)
+			}
+			c.State = container.StateExited
+		}
+		stopped = append(stopped, c)
+	}
+	dm.removeContainers(ctx, stopped)
+}
@@ -0,0 +1,143 @@
+package orchestrator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+)
+
+func TestApplyRoutingWeight(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{
+		"traefik.enable": "true",
+		"traefik.http.routers.dep-1-default.rule":                      "Host(`app.example.com`)",
+		"traefik.http.routers.dep-1-default.priority":                  "100",
+		"traefik.http.routers.dep-1-default.service":                   "dep-1-default",
+		"traefik.http.services.dep-1-default.loadbalancer.server.port": "8080",
+		"cloud.obiente.deployment_id":                                  "dep-1",
+	}
+	applyRoutingWeight(labels, 3)
+
+	want := map[string]string{
+		"traefik.enable": "true",
+		"traefik.http.routers.dep-1-default-w3.rule":                      "Host(`app.example.com`)",
+		"traefik.http.routers.dep-1-default-w3.priority":                  "103",
+		"traefik.http.routers.dep-1-default-w3.service":                   "dep-1-default-w3",
+		"traefik.http.services.dep-1-default-w3.loadbalancer.server.port": "8080",
+		"cloud.obiente.deployment_id":                                     "dep-1",
+	}
+	if !reflect.DeepEqual(labels, want) {
+		t.Fatalf("weighted labels = %v, want %v", labels, want)
+	}
+
+	unweighted := map[string]string{"traefik.http.routers.dep-1-default.priority": "100"}
+	applyRoutingWeight(unweighted, 0)
+	if unweighted["traefik.http.routers.dep-1-default.priority"] != "100" {
+		t.Fatalf("weight 0 changed the labels: %v", unweighted)
+	}
+}
+
+func TestNextRoutingWeight(t *testing.T) {
+	t.Parallel()
+
+	if got := nextRoutingWeight(nil); got != 1 {
+		t.Fatalf("weight without containers = %d, want 1", got)
+	}
+	containers := []container.Summary{
+		{Labels: map[string]string{}},
+		{Labels: map[string]string{routingWeightLabel: "4"}},
+		{Labels: map[string]string{routingWeightLabel: "2"}},
+	}
+	if got := nextRoutingWeight(containers); got != 5 {
+		t.Fatalf("weight = %d, want 5", got)
+	}
+}
+
+func TestDrainCutoverRemovesDrainedContainers(t *testing.T) {
+	setDrainPollInterval(t, 20*time.Millisecond)
+	dm, docker := newBlueGreenTestManager(t)
+	docker.add("blue-container-0001", colorBlue, true, container.NoHealthcheck)
+	docker.add("green-container-001", colorGreen, true, container.Healthy)
+
+	blue, _ := dm.colorContainers(context.Background(), "dep-1", colorBlue)
+	start := time.Now()
+	if err := dm.drainCutover(context.Background(), "dep-1", colorBlue, []string{"green-container-001"}, blue, 10*time.Second); err != nil {
+		t.Fatalf("drainCutover() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Fatalf("drainCutover() took %s for idle containers", elapsed)
+	}
+
+	if got := blueGreenActiveColor(t); got != colorGreen {
+		t.Fatalf("active color = %q, want green", got)
+	}
+	if docker.exists("blue-container-0001") {
+		t.Fatal("drained blue container was not removed")
+	}
+	if timeout, _ := docker.stopTimeout("blue-container-0001"); timeout == 0 {
+		t.Fatal("drained blue container was force-stopped")
+	}
+	if !docker.running("green-container-001") {
+		t.Fatal("green container is not running")
+	}
+}
+
+func TestDrainCutoverForceStopsAfterTimeout(t *testing.T) {
+	setDrainPollInterval(t, 20*time.Millisecond)
+	dm, docker := newBlueGreenTestManager(t)
+	docker.add("blue-container-0001", colorBlue, true, container.NoHealthcheck)
+	docker.add("green-container-001", colorGreen, true, container.Healthy)
+	// A client keeps a long-lived connection to blue busy
+	docker.setTraffic("blue-container-0001", 64*1024)
+
+	blue, _ := dm.colorContainers(context.Background(), "dep-1", colorBlue)
+	const timeout = 200 * time.Millisecond
+	start := time.Now()
+	if err := dm.drainCutover(context.Background(), "dep-1", colorBlue, []string{"green-container-001"}, blue, timeout); err != nil {
+		t.Fatalf("drainCutover() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < timeout {
+		t.Fatalf("drainCutover() returned after %s, before the drain timeout", elapsed)
+	}
+
+	if got := blueGreenActiveColor(t); got != colorGreen {
+		t.Fatalf("active color = %q, want green", got)
+	}
+	if docker.exists("blue-container-0001") {
+		t.Fatal("busy blue container was not removed after the drain timeout")
+	}
+	if timeout, ok := docker.stopTimeout("blue-container-0001"); !ok || timeout != 0 {
+		t.Fatalf("busy blue container was stopped with timeout %s (stopped: %v), want a force stop", timeout, ok)
+	}
+}
+
+func TestDrainCutoverKeepsActiveColorWhenNewColorIsUnhealthy(t *testing.T) {
+	setDrainPollInterval(t, 20*time.Millisecond)
+	dm, docker := newBlueGreenTestManager(t)
+	docker.add("blue-container-0001", colorBlue, true, container.NoHealthcheck)
+	docker.add("green-container-001", colorGreen, true, container.Unhealthy)
+
+	blue, _ := dm.colorContainers(context.Background(), "dep-1", colorBlue)
+	if err := dm.drainCutover(context.Background(), "dep-1", colorBlue, []string{"green-container-001"}, blue, time.Second); err == nil {
+		t.Fatal("drainCutover() succeeded with unhealthy containers")
+	}
+
+	if got := blueGreenActiveColor(t); got != colorBlue {
+		t.Fatalf("active color = %q, want blue", got)
+	}
+	if !docker.running("blue-container-0001") {
+		t.Fatal("blue container was stopped although green never became healthy")
+	}
+}
+
+func setDrainPollInterval(t *testing.T, interval time.Duration) {
+	t.Helper()
+
+	previous := drainPollInterval
+	drainPollInterval = interval
+	t.Cleanup(func() { drainPollInterval = previous })
+}
@@ -34,7 +34,7 @@ func (dm *DeploymentManager) CreateDeployment(ctx context.Context, config *Deplo
 	}
 
 	// Select best node for deployment
-	targetNode, err := dm.SelectTargetNode(ctx, config.TargetNodeID)
+	targetNode, err := dm.SelectTargetNode(ctx, config.DeploymentID, config.TargetNodeID)
 	if err != nil {
 		logger.Error("[DeploymentManager] Failed to select node for deployment %s: %v", config.DeploymentID, err)
 		return fmt.Errorf("failed to select node: %w", err)
@@ -57,6 +57,9 @@ func (dm *DeploymentManager) CreateDeployment(ctx context.Context, config *Deplo
 	// This ensures user-specified env vars are not missed
 	var deployment database.Deployment
 	if err := database.DB.Where("id = ?", config.DeploymentID).First(&deployment).Error; err == nil {
+		if database.DeploymentEnvLocked(&deployment) {
+			return fmt.Errorf("deployment %s has encrypted environment variables and no encryption key is configured on this node", config.DeploymentID)
+		}
 		envVars := make(map[string]string)
 		if deployment.EnvVars != "" {
 			if err := json.Unmarshal([]byte(deployment.EnvVars), &envVars); err == nil {
@@ -69,26 +72,34 @@ func (dm *DeploymentManager) CreateDeployment(ctx context.Context, config *Deplo
 	}
 	// Get routing rules to determine service names
 	routings, _ := database.GetDeploymentRoutings(config.DeploymentID)
-	serviceNames := []string{"default"}
-	if len(routings) > 0 {
-		// Extract unique service names from routing rules
-		serviceNameMap := make(map[string]bool)
-		for _, routing := range routings {
-			sn := routing.ServiceName
-			if sn == "" {
-				sn = "default"
+	serviceNames := deploymentServiceNames(routings)
+
+	// Check if we're in Swarm mode
+	isSwarmMode := utils.IsSwarmModeEnabled()
+
+	if !isSwarmMode {
+		// Redeploy running containers blue-green so the old version serves traffic until the new one is healthy
+		active := activeColor(&deployment)
+		if blueGreenEnabled() && deployment.ComposeYaml == "" {
+			if running, err := dm.colorContainers(ctx, config.DeploymentID, active); err == nil && hasRunningContainer(running) {
+				if deployment.ZeroDowntimeDeploy {
+					return dm.drainAndReplace(ctx, config, active, drainTimeout(DefaultDrainTimeoutSeconds))
+				}
+				return dm.blueGreenDeploy(ctx, config, active)
 			}
-			serviceNameMap[sn] = true
 		}
-		serviceNames = make([]string, 0, len(serviceNameMap))
-		for sn := range serviceNameMap {
-			serviceNames = append(serviceNames, sn)
+
+		// Nothing is serving traffic, so recreate in place as blue and drop any leftover green containers
+		if greens, err := dm.colorContainers(ctx, config.DeploymentID, colorGreen); err == nil {
+			dm.removeContainers(ctx, greens)
+		}
+		if deployment.ID != "" && active != colorBlue {
+			if err := setActiveColor(config.DeploymentID, colorBlue); err != nil {
+				logger.Warn("[DeploymentManager] %v", err)
+			}
 		}
 	}
 
-	// Check if we're in Swarm mode
-	isSwarmMode := utils.IsSwarmModeEnabled()
-
 	// Create containers/services for each service and replica
 	for _, serviceName := range serviceNames {
 		for i := 0; i < config.Replicas; i++ {
@@ -593,6 +604,9 @@ func (dm *DeploymentManager) StartDeployment(ctx context.Context, deploymentID s
 func (dm *DeploymentManager) StopDeployment(ctx context.Context, deploymentID string) error {
 	logger.Info("[DeploymentManager] Stopping deployment %s", deploymentID)
 
+	// A stopped deployment must not be brought back by a failing blue-green confirmation
+	dm.settleCutover(ctx, deploymentID)
+
 	// Check if we're in Swarm mode
 	isSwarmMode := utils.IsSwarmModeEnabled()
 
@@ -664,6 +678,7 @@ func (dm *DeploymentManager) StopDeployment(ctx context.Context, deploymentID st
 // DeleteDeployment removes all containers and data for a deployment
 func (dm *DeploymentManager) DeleteDeployment(ctx context.Context, deploymentID string) error {
 	logger.Info("[DeploymentManager] Deleting deployment %s", deploymentID)
+	dm.settleCutover(ctx, deploymentID)
 
 	locations, err := dm.registry.GetDeploymentLocations(deploymentID)
 	if err != nil {
@@ -775,6 +790,48 @@ func (dm *DeploymentManager) RestartDeployment(ctx context.Context, deploymentID
 	}
 
 	// Recreate containers with updated config from database
+	config, err := dm.deploymentConfigFromDatabase(ctx, &deployment)
+	if err != nil {
+		return err
+	}
+
+	// Log the config healthcheck values
+	logger.Info("[RestartDeployment] DeploymentConfig created - HealthcheckType: %v, HealthcheckPort: %v, HealthcheckPath: %v, HealthcheckExpectedStatus: %v, HealthcheckCustomCommand: %v",
+		config.HealthcheckType, config.HealthcheckPort, config.HealthcheckPath, config.HealthcheckExpectedStatus, config.HealthcheckCustomCommand)
+
+	// Recreate containers with updated config
+	if err := dm.CreateDeployment(ctx, config); err != nil {
+		return fmt.Errorf("failed to recreate deployment containers: %w", err)
+	}
+
+	logger.Info("[DeploymentManager] Successfully recreated containers for deployment %s with updated configs", deploymentID)
+	return nil
+}
+
+// deploymentServiceNames returns the unique service names of a deployment's routing rules, or "default"
+func deploymentServiceNames(routings []database.DeploymentRouting) []string {
+	if len(routings) == 0 {
+		return []string{"default"}
+	}
+	serviceNameMap := make(map[string]bool)
+	serviceNames := make([]string, 0, len(routings))
+	for _, routing := range routings {
+		sn := routing.ServiceName
+		if sn == "" {
+			sn = "default"
+		}
+		if !serviceNameMap[sn] {
+			serviceNameMap[sn] = true
+			serviceNames = append(serviceNames, sn)
+		}
+	}
+	return serviceNames
+}
+
+// deploymentConfigFromDatabase builds the container config for an image-based deployment from its database row
+func (dm *DeploymentManager) deploymentConfigFromDatabase(ctx context.Context, deployment *database.Deployment) (*DeploymentConfig, error) {
+	deploymentID := deployment.ID
+
 	// Parse environment variables from JSON
 	envVars := make(map[string]string)
 	if deployment.EnvVars != "" {
@@ -789,7 +846,7 @@ func (dm *DeploymentManager) RestartDeployment(ctx context.Context, deploymentID
 		image = *deployment.Image
 	}
 	if image == "" {
-		return fmt.Errorf("deployment %s has no image configured", deploymentID)
+		return nil, fmt.Errorf("deployment %s has no image configured", deploymentID)
 	}
 
 	// Get port from routing configuration (required - no default)
@@ -802,7 +859,7 @@ func (dm *DeploymentManager) RestartDeployment(ctx context.Context, deploymentID
 			if routing.ServiceName == "" || routing.ServiceName == "default" {
 				if routing.TargetPort > 0 {
 					port = routing.TargetPort
-					logger.Info("[DeploymentManager] Using target port %d from routing configuration (default service) for restart", port)
+					logger.Info("[DeploymentManager] Using target port %d from routing configuration (default service) for deployment %s", port, deploymentID)
 					foundRouting = true
 					break
 				}
@@ -811,17 +868,17 @@ func (dm *DeploymentManager) RestartDeployment(ctx context.Context, deploymentID
 		// If no default service routing found, use first routing's target port
 		if !foundRouting && len(routings) > 0 && routings[0].TargetPort > 0 {
 			port = routings[0].TargetPort
-			logger.Info("[DeploymentManager] Using target port %d from first routing rule for restart", port)
+			logger.Info("[DeploymentManager] Using target port %d from first routing rule for deployment %s", port, deploymentID)
 		}
 	}
 
 	// Fallback to deployment port only if no routing found
 	if port == 0 && deployment.Port != nil && *deployment.Port > 0 {
 		port = int(*deployment.Port)
-		logger.Info("[DeploymentManager] Using deployment port %d (no routing found) for restart", port)
+		logger.Info("[DeploymentManager] Using deployment port %d (no routing found) for deployment %s", port, deploymentID)
 	}
 	if port == 0 {
-		logger.Info("[DeploymentManager] Deployment %s restarting without an exposed port", deploymentID)
+		logger.Info("[DeploymentManager] Deployment %s has no exposed port", deploymentID)
 	}
 
 	// Defaults match StartDeployment: 2GB RAM, 0.5 CPU cores (512 shares).
@@ -865,17 +922,7 @@ func (dm *DeploymentManager) RestartDeployment(ctx context.Context, deploymentID
 		TargetNodeID:              targetNodeID,
 	}
 
-	// Log the config healthcheck values
-	logger.Info("[RestartDeployment] DeploymentConfig created - HealthcheckType: %v, HealthcheckPort: %v, HealthcheckPath: %v, HealthcheckExpectedStatus: %v, HealthcheckCustomCommand: %v",
-		config.HealthcheckType, config.HealthcheckPort, config.HealthcheckPath, config.HealthcheckExpectedStatus, config.HealthcheckCustomCommand)
-
-	// Recreate containers with updated config
-	if err := dm.CreateDeployment(ctx, config); err != nil {
-		return fmt.Errorf("failed to recreate deployment containers: %w", err)
-	}
-
-	logger.Info("[DeploymentManager] Successfully recreated containers for deployment %s with updated configs", deploymentID)
-	return nil
+	return config, nil
 }
 
 func parseStoredDockerfileVolumes(raw string) []DeploymentVolume {
@@ -942,6 +989,87 @@ func (dm *DeploymentManager) ScaleDeployment(ctx context.Context, deploymentID s
 	return nil
 }
 
+// SetDeploymentReplicas stores a deployment's replica count and redeploys it with that many replicas
+// per service. A count of 0 stops the deployment. Compose deployments take their replica counts from
+// the compose file and cannot be scaled this way.
+func (dm *DeploymentManager) SetDeploymentReplicas(ctx context.Context, deploymentID string, count int32) error {
+	if count < 0 {
+		return fmt.Errorf("replica count must not be negative")
+	}
+
+	var deployment database.Deployment
+	if err := database.DB.Where("id = ?", deploymentID).First(&deployment).Error; err != nil {
+		return fmt.Errorf("failed to get deployment from database: %w", err)
+	}
+	if deployment.ComposeYaml != "" {
+		return fmt.Errorf("compose deployments are scaled through their compose file")
+	}
+
+	previous := int32(1)
+	if deployment.Replicas != nil {
+		previous = *deployment.Replicas
+	}
+	if err := database.DB.Model(&database.Deployment{}).Where("id = ?", deploymentID).Update("replicas", count).Error; err != nil {
+		return fmt.Errorf("failed to update replica count: %w", err)
+	}
+	deployment.Replicas = &count
+	logger.Info("[DeploymentManager] Setting deployment %s to %d replicas (was %d)", deploymentID, count, previous)
+
+	if count == 0 {
+		return dm.StopDeployment(ctx, deploymentID)
+	}
+
+	config, err := dm.deploymentConfigFromDatabase(ctx, &deployment)
+	if err != nil {
+		return err
+	}
+	if err := dm.CreateDeployment(ctx, config); err != nil {
+		return fmt.Errorf("failed to redeploy with %d replicas: %w", count, err)
+	}
+
+	// CreateDeployment only replaces replicas it creates; drop the ones above the new count. A blue-green
+	// redeploy leaves the previous color as a fallback, which is removed once the cut-over is confirmed.
+	routings, _ := database.GetDeploymentRoutings(deploymentID)
+	isSwarmMode := utils.IsSwarmModeEnabled()
+	active := colorBlue
+	var current database.Deployment
+	if err := database.DB.Where("id = ?", deploymentID).First(&current).Error; err == nil {
+		active = activeColor(&current)
+	}
+	for _, serviceName := range deploymentServiceNames(routings) {
+		for i := int(count); i < int(previous); i++ {
+			if isSwarmMode {
+				swarmServiceName := fmt.Sprintf("deploy-%s-%s-replica-%d", deploymentID, serviceName, i)
+				var stderr bytes.Buffer
+				rmCmd := exec.CommandContext(ctx, "docker", "service", "rm", swarmServiceName)
+				rmCmd.Stderr = &stderr
+				if err := rmCmd.Run(); err != nil {
+					logger.Warn("[DeploymentManager] Failed to remove Swarm service %s: %v (stderr: %s)", swarmServiceName, err, stderr.String())
+				}
+				continue
+			}
+			containerName := colorContainerName(deploymentID, serviceName, i, active)
+			filters := make(client.Filters)
+			filters.Add("name", containerName)
+			result, err := dm.dockerClient.ContainerList(ctx, client.ContainerListOptions{All: true, Filters: filters})
+			if err != nil {
+				logger.Warn("[DeploymentManager] Failed to find container %s: %v", containerName, err)
+				continue
+			}
+			var excess []container.Summary
+			for _, c := range result.Items {
+				for _, name := range c.Names {
+					if strings.TrimPrefix(name, "/") == containerName {
+						excess = append(excess, c)
+					}
+				}
+			}
+			dm.removeContainers(ctx, excess)
+		}
+	}
+	return nil
+}
+
 // GetDeploymentLogs retrieves logs from a deployment
 func (dm *DeploymentManager) GetDeploymentLogs(ctx context.Context, deploymentID string, tail string) (string, error) {
 	locations, err := dm.registry.GetDeploymentLocations(deploymentID)
@@ -0,0 +1,191 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/api/types/swarm"
+	"github.com/moby/moby/client"
+	"gorm.io/gorm/clause"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/utils"
+)
+
+// Node maintenance: draining nodes before maintenance and re-enabling them afterwards
+
+const (
+	nodeDrainPollInterval = 5 * time.Second
+	nodeDrainTimeout      = 10 * time.Minute
+)
+
+// ErrNodeDrainTimeout is returned by DrainNode when tasks are still running on the
+// node after nodeDrainTimeout. The node stays in drain availability.
+var ErrNodeDrainTimeout = errors.New("timed out waiting for containers to leave the node")
+
+// CanDrainNode reports why a node cannot be drained, or nil if it can.
+// Only Swarm nodes can be drained; local nodes have nowhere to move containers to.
+func (dm *DeploymentManager) CanDrainNode(nodeID string) error {
+	if !utils.IsSwarmModeEnabled() {
+		return fmt.Errorf("node drain requires Docker Swarm mode")
+	}
+	if strings.HasPrefix(nodeID, "local-") {
+		return fmt.Errorf("node %s is not a Swarm node", nodeID)
+	}
+	return nil
+}
+
+// DrainNode sets the node's Swarm availability to drain and waits until no tasks are
+// running on it, polling every nodeDrainPollInterval for up to nodeDrainTimeout.
+// The maintenance state is recorded in cluster_nodes.
+func (dm *DeploymentManager) DrainNode(ctx context.Context, nodeID string) error {
+	if err := dm.CanDrainNode(nodeID); err != nil {
+		return err
+	}
+
+	hostname, err := dm.setNodeAvailability(ctx, nodeID, swarm.NodeAvailabilityDrain)
+	if err != nil {
+		return err
+	}
+
+	startedAt := time.Now()
+	if err := saveClusterNode(&database.ClusterNode{
+		NodeID:         nodeID,
+		Hostname:       hostname,
+		Status:         database.ClusterNodeStatusDraining,
+		DrainStartedAt: &startedAt,
+	}); err != nil {
+		return err
+	}
+	logger.Info("[DeploymentManager] Draining node %s (%s)", nodeID, hostname)
+
+	waitCtx, cancel := context.WithTimeout(ctx, nodeDrainTimeout)
+	defer cancel()
+	ticker := time.NewTicker(nodeDrainPollInterval)
+	defer ticker.Stop()
+
+	for {
+		running, err := dm.countRunningNodeTasks(waitCtx, nodeID)
+		if err != nil && waitCtx.Err() == nil {
+			logger.Warn("[DeploymentManager] Failed to list tasks on node %s: %v", nodeID, err)
+		}
+		if err == nil && running == 0 {
+			drainedAt := time.Now()
+			if err := saveClusterNode(&database.ClusterNode{
+				NodeID:         nodeID,
+				Hostname:       hostname,
+				Status:         database.ClusterNodeStatusDrained,
+				DrainStartedAt: &startedAt,
+				DrainedAt:      &drainedAt,
+			}); err != nil {
+				return err
+			}
+			logger.Info("[DeploymentManager] Node %s drained in %s", nodeID, drainedAt.Sub(startedAt).Round(time.Second))
+			return nil
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				// The caller gave up (e.g. shutdown); leave the node marked as draining
+				return ctx.Err()
+			}
+			if err := saveClusterNode(&database.ClusterNode{
+				NodeID:         nodeID,
+				Hostname:       hostname,
+				Status:         database.ClusterNodeStatusDrainTimedOut,
+				DrainStartedAt: &startedAt,
+			}); err != nil {
+				logger.Warn("[DeploymentManager] Failed to record drain timeout for node %s: %v", nodeID, err)
+			}
+			return fmt.Errorf("drain node %s: %w (%d tasks still running)", nodeID, ErrNodeDrainTimeout, running)
+		case <-ticker.C:
+		}
+	}
+}
+
+// EnableNode sets the node's Swarm availability back to active and clears its
+// maintenance state in cluster_nodes
+func (dm *DeploymentManager) EnableNode(ctx context.Context, nodeID string) error {
+	if err := dm.CanDrainNode(nodeID); err != nil {
+		return err
+	}
+
+	hostname, err := dm.setNodeAvailability(ctx, nodeID, swarm.NodeAvailabilityActive)
+	if err != nil {
+		return err
+	}
+
+	if err := saveClusterNode(&database.ClusterNode{
+		NodeID:   nodeID,
+		Hostname: hostname,
+		Status:   database.ClusterNodeStatusActive,
+	}); err != nil {
+		return err
+	}
+	logger.Info("[DeploymentManager] Node %s (%s) re-enabled", nodeID, hostname)
+	return nil
+}
+
+// setNodeAvailability updates the Swarm node spec and mirrors the availability into
+// node_metadata so the node selector stops (or resumes) placing deployments there.
+// It returns the node's hostname.
+func (dm *DeploymentManager) setNodeAvailability(ctx context.Context, nodeID string, availability swarm.NodeAvailability) (string, error) {
+	inspectResult, err := dm.dockerClient.NodeInspect(ctx, nodeID, client.NodeInspectOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect node %s: %w", nodeID, err)
+	}
+	node := inspectResult.Node
+
+	spec := node.Spec
+	spec.Availability = availability
+	if _, err := dm.dockerClient.NodeUpdate(ctx, nodeID, client.NodeUpdateOptions{
+		Version: node.Version,
+		Spec:    spec,
+	}); err != nil {
+		return "", fmt.Errorf("failed to set node %s availability to %s: %w", nodeID, availability, err)
+	}
+
+	if err := database.DB.Model(&database.NodeMetadata{}).
+		Where("id = ?", nodeID).
+		Update("availability", string(availability)).Error; err != nil {
+		logger.Warn("[DeploymentManager] Failed to update availability of node %s in metadata: %v", nodeID, err)
+	}
+
+	return node.Description.Hostname, nil
+}
+
+// countRunningNodeTasks returns the number of Swarm tasks still running on a node
+func (dm *DeploymentManager) countRunningNodeTasks(ctx context.Context, nodeID string) (int, error) {
+	filters := make(client.Filters)
+	filters.Add("node", nodeID)
+	result, err := dm.dockerClient.TaskList(ctx, client.TaskListOptions{Filters: filters})
+	if err != nil {
+		return 0, err
+	}
+
+	running := 0
+	for _, task := range result.Items {
+		if task.Status.State == swarm.TaskStateRunning {
+			running++
+		}
+	}
+	return running, nil
+}
+
+// saveClusterNode upserts a node's maintenance state, resetting the drain timestamps
+// to the values on the record
+func saveClusterNode(node *database.ClusterNode) error {
+	err := database.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "node_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"hostname", "status", "drain_started_at", "drained_at", "updated_at"}),
+	}).Create(node).Error
+	if err != nil {
+		return fmt.Errorf("failed to record state of node %s: %w", node.NodeID, err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+// SelectRegionNode resolves the node a deployment should run on in a region: the target node of a
+// forwarded request when it is in the region, otherwise the node picked by the selection strategy
+func (dm *DeploymentManager) SelectRegionNode(ctx context.Context, region string) (*database.NodeMetadata, error) {
+	if nodeID := TargetNodeFromContext(ctx); nodeID != "" {
+		var node database.NodeMetadata
+		if err := database.DB.WithContext(ctx).First(&node, "id = ?", nodeID).Error; err != nil {
+			return nil, fmt.Errorf("failed to resolve target node %s: %w", nodeID, err)
+		}
+		if node.Region == region {
+			return &node, nil
+		}
+	}
+	return dm.nodeSelector.SelectNodeInRegion(ctx, region)
+}
+
+// CreateDeploymentFromDatabase creates the containers of an image-based deployment on this node from
+// its stored configuration and returns the ID of the first one
+func (dm *DeploymentManager) CreateDeploymentFromDatabase(ctx context.Context, deploymentID string) (string, error) {
+	var deployment database.Deployment
+	if err := database.DB.WithContext(ctx).First(&deployment, "id = ?", deploymentID).Error; err != nil {
+		return "", fmt.Errorf("failed to get deployment %s: %w", deploymentID, err)
+	}
+	config, err := dm.deploymentConfigFromDatabase(ctx, &deployment)
+	if err != nil {
+		return "", err
+	}
+	config.TargetNodeID = dm.nodeID
+	if err := dm.CreateDeployment(ctx, config); err != nil {
+		return "", err
+	}
+
+	var location database.DeploymentLocation
+	if err := database.DB.WithContext(ctx).
+		Where("deployment_id = ? AND node_id = ?", deploymentID, dm.nodeID).
+		Order("created_at DESC").
+		First(&location).Error; err != nil {
+		return "", fmt.Errorf("containers of deployment %s were created but not registered: %w", deploymentID, err)
+	}
+	return location.ContainerID, nil
+}
+
+// RegionTraefikIP returns the IP Traefik serves deployments on for a node: the node's own IP, or
+// the first NODE_IPS address configured for its region
+func RegionTraefikIP(node *database.NodeMetadata) string {
+	if ip := strings.TrimSpace(node.IP); ip != "" {
+		return ip
+	}
+	nodeIPs, err := database.ParseNodeIPsFromEnv(os.Getenv("NODE_IPS"))
+	if err != nil {
+		return ""
+	}
+	if ips := nodeIPs[node.Region]; len(ips) > 0 {
+		return ips[0]
+	}
+	return ""
+}
@@ -0,0 +1,97 @@
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/utils"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+)
+
+// deploymentContainerResources returns the Docker resource limits of a deployment container.
+// CPUShares is in units where 1024 = 1 CPU core and only sets the relative scheduling priority;
+// NanoCPUs (1 core = 1e9) is the hard CPU limit. MemorySwap equal to Memory gives the container
+// no swap, so the memory limit cannot be exceeded by swapping.
+func deploymentContainerResources(cpuShares, memoryBytes int64) container.Resources {
+	cpuCores := float64(cpuShares) / 1024.0
+	return container.Resources{
+		Memory:     memoryBytes,
+		MemorySwap: memoryBytes,
+		CPUShares:  cpuShares,
+		NanoCPUs:   int64(cpuCores * 1e9),
+	}
+}
+
+// swarmResourceUpdateArgs returns the docker service update arguments that set a Swarm service's
+// CPU and memory limits along with the matching reservations
+func swarmResourceUpdateArgs(serviceName string, cpuShares, memoryBytes int64) []string {
+	cpuCores := float64(cpuShares) / 1024.0
+	return []string{"service", "update",
+		"--limit-memory", fmt.Sprintf("%d", memoryBytes),
+		"--limit-cpu", fmt.Sprintf("%.2f", cpuCores),
+		"--reserve-memory", fmt.Sprintf("%d", swarmMemoryReservation(memoryBytes)),
+		"--reserve-cpu", fmt.Sprintf("%.2f", swarmCPUReservation(cpuCores)),
+		serviceName,
+	}
+}
+
+// UpdateDeploymentResources applies new CPU and memory limits to the running containers of a
+// deployment. Containers on this node are updated in place with docker container update; in Swarm
+// mode the services are updated, which rolls their tasks.
+func (dm *DeploymentManager) UpdateDeploymentResources(ctx context.Context, deploymentID string, cpuShares, memoryBytes int64) error {
+	logger.Info("[DeploymentManager] Updating resource limits of deployment %s to %d CPU shares and %d bytes of memory", deploymentID, cpuShares, memoryBytes)
+
+	if utils.IsSwarmModeEnabled() {
+		cmd := exec.CommandContext(ctx, "docker", "service", "ls", "--format", "{{.Name}}")
+		var stdout bytes.Buffer
+		cmd.Stdout = &stdout
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to list Swarm services: %w", err)
+		}
+
+		var errs []error
+		prefix := fmt.Sprintf("deploy-%s-", deploymentID)
+		for _, serviceName := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+			serviceName = strings.TrimSpace(serviceName)
+			if !strings.HasPrefix(serviceName, prefix) {
+				continue
+			}
+			updateCmd := exec.CommandContext(ctx, "docker", swarmResourceUpdateArgs(serviceName, cpuShares, memoryBytes)...)
+			var stderr bytes.Buffer
+			updateCmd.Stderr = &stderr
+			if err := updateCmd.Run(); err != nil {
+				errs = append(errs, fmt.Errorf("failed to update Swarm service %s: %w (stderr: %s)", serviceName, err, stderr.String()))
+				continue
+			}
+			logger.Info("[DeploymentManager] Updated resource limits of Swarm service %s", serviceName)
+		}
+		return errors.Join(errs...)
+	}
+
+	locations, err := dm.registry.GetDeploymentLocations(deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to get deployment locations: %w", err)
+	}
+
+	resources := deploymentContainerResources(cpuShares, memoryBytes)
+	var errs []error
+	for _, location := range locations {
+		// Only update containers on this node
+		if location.NodeID != dm.nodeID {
+			continue
+		}
+		if _, err := dm.dockerClient.ContainerUpdate(ctx, location.ContainerID, client.ContainerUpdateOptions{Resources: &resources}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update container %s: %w", location.ContainerID, err))
+			continue
+		}
+		logger.Info("[DeploymentManager] Updated resource limits of container %s", location.ContainerID[:12])
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,31 @@
+package orchestrator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDeploymentContainerResources(t *testing.T) {
+	t.Parallel()
+
+	resources := deploymentContainerResources(512, 256*1024*1024)
+	if resources.NanoCPUs != 500_000_000 {
+		t.Fatalf("NanoCPUs = %d, want half a core", resources.NanoCPUs)
+	}
+	if resources.CPUShares != 512 || resources.Memory != 256*1024*1024 {
+		t.Fatalf("unexpected limits: %+v", resources)
+	}
+	if resources.MemorySwap != resources.Memory {
+		t.Fatalf("MemorySwap = %d, want it equal to Memory so the container cannot swap", resources.MemorySwap)
+	}
+}
+
+func TestSwarmResourceUpdateArgs(t *testing.T) {
+	t.Parallel()
+
+	args := strings.Join(swarmResourceUpdateArgs("deploy-dep-1-web", 2048, 1024*1024*1024), " ")
+	want := "service update --limit-memory 1073741824 --limit-cpu 2.00 --reserve-memory 53687091 --reserve-cpu 0.05 deploy-dep-1-web"
+	if args != want {
+		t.Fatalf("args = %q, want %q", args, want)
+	}
+}
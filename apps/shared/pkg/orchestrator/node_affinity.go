@@ -0,0 +1,103 @@
+package orchestrator
+
+import (
+	"fmt"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+// Affinity rule types accepted in AffinityRule.RuleType
+const (
+	AffinityRuleTypeAffinity     = "affinity"
+	AffinityRuleTypeAntiAffinity = "anti-affinity"
+)
+
+// AffinityRule places a deployment relative to deployments carrying the label LabelKey=LabelValue.
+// Affinity prefers nodes already running such a deployment; anti-affinity excludes them.
+type AffinityRule struct {
+	RuleType   string
+	LabelKey   string
+	LabelValue string
+}
+
+// Validate checks the rule type and label
+func (r AffinityRule) Validate() error {
+	if r.RuleType != AffinityRuleTypeAffinity && r.RuleType != AffinityRuleTypeAntiAffinity {
+		return fmt.Errorf("unknown affinity rule type %q (want %q or %q)", r.RuleType, AffinityRuleTypeAffinity, AffinityRuleTypeAntiAffinity)
+	}
+	if err := database.ValidateResourceTag(r.LabelKey, r.LabelValue); err != nil {
+		return fmt.Errorf("affinity rule label: %w", err)
+	}
+	return nil
+}
+
+// matchesNode reports whether a deployment carrying the rule's label runs on node
+func (r AffinityRule) matchesNode(node *database.NodeMetadata) bool {
+	for _, labels := range node.DeploymentLabels {
+		if value, ok := labels[r.LabelKey]; ok && value == r.LabelValue {
+			return true
+		}
+	}
+	return false
+}
+
+// AffinityRulesFromDatabase converts stored deployment affinity rules
+func AffinityRulesFromDatabase(rules []database.DeploymentAffinityRule) []AffinityRule {
+	out := make([]AffinityRule, len(rules))
+	for i, rule := range rules {
+		out[i] = AffinityRule{RuleType: rule.RuleType, LabelKey: rule.LabelKey, LabelValue: rule.LabelValue}
+	}
+	return out
+}
+
+// affinityStrategy filters nodes by affinity rules before delegating to the base strategy.
+// Nodes running a deployment matched by an anti-affinity rule are excluded; of the rest,
+// the nodes satisfying the most affinity rules are preferred. Select returns nil when
+// anti-affinity excludes every node.
+type affinityStrategy struct {
+	base  NodeSelectionStrategy
+	rules []AffinityRule
+}
+
+func (s affinityStrategy) Name() string { return s.base.Name() }
+
+func (s affinityStrategy) Select(nodes []database.NodeMetadata) *database.NodeMetadata {
+	candidates := make([]database.NodeMetadata, 0, len(nodes))
+	bestScore := 0
+	for i := range nodes {
+		excluded := false
+		score := 0
+		for _, rule := range s.rules {
+			if !rule.matchesNode(&nodes[i]) {
+				continue
+			}
+			if rule.RuleType == AffinityRuleTypeAntiAffinity {
+				excluded = true
+				break
+			}
+			score++
+		}
+		if excluded {
+			continue
+		}
+		switch {
+		case score > bestScore:
+			candidates = append(candidates[:0], nodes[i])
+			bestScore = score
+		case score == bestScore:
+			candidates = append(candidates, nodes[i])
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	selected := s.base.Select(candidates)
+	// Return a pointer into the caller's list, like the other strategies
+	for i := range nodes {
+		if nodes[i].ID == selected.ID {
+			return &nodes[i]
+		}
+	}
+	return selected
+}
@@ -0,0 +1,94 @@
+package orchestrator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+func TestNewStrategyRejectsInvalidAffinityRules(t *testing.T) {
+	for _, rule := range []AffinityRule{
+		{RuleType: "prefer", LabelKey: "app", LabelValue: "web"},
+		{RuleType: AffinityRuleTypeAntiAffinity, LabelKey: "App", LabelValue: "web"},
+		{RuleType: AffinityRuleTypeAffinity, LabelKey: "", LabelValue: "web"},
+	} {
+		if _, err := NewStrategy(StrategyLeastLoaded, rule); err == nil {
+			t.Fatalf("NewStrategy accepted affinity rule %+v", rule)
+		}
+	}
+}
+
+func TestAffinityRulesPlaceDeployments(t *testing.T) {
+	type deployment struct {
+		name  string
+		app   string
+		rules []AffinityRule
+	}
+	antiAffinity := func(app string) AffinityRule {
+		return AffinityRule{RuleType: AffinityRuleTypeAntiAffinity, LabelKey: "app", LabelValue: app}
+	}
+	affinity := func(app string) AffinityRule {
+		return AffinityRule{RuleType: AffinityRuleTypeAffinity, LabelKey: "app", LabelValue: app}
+	}
+	deployments := []deployment{
+		{name: "api", app: "api"},
+		{name: "web-1", app: "web", rules: []AffinityRule{antiAffinity("web")}},
+		{name: "web-2", app: "web", rules: []AffinityRule{antiAffinity("web")}},
+		{name: "web-3", app: "web", rules: []AffinityRule{antiAffinity("web")}},
+		{name: "web-4", app: "web", rules: []AffinityRule{antiAffinity("web")}},
+		{name: "sidecar", app: "sidecar", rules: []AffinityRule{affinity("api")}},
+		{name: "worker-1", app: "worker", rules: []AffinityRule{antiAffinity("worker")}},
+		{name: "worker-2", app: "worker", rules: []AffinityRule{antiAffinity("worker")}},
+		{name: "worker-3", app: "worker", rules: []AffinityRule{antiAffinity("worker")}},
+		{name: "web-5", app: "web", rules: []AffinityRule{antiAffinity("web")}},
+	}
+
+	for _, strategyName := range []string{StrategyLeastLoaded, StrategyRoundRobin, StrategyResourceBased, StrategyWeightedRoundRobin, StrategyRandom} {
+		t.Run(strategyName, func(t *testing.T) {
+			nodes := make([]database.NodeMetadata, 4)
+			for i := range nodes {
+				nodes[i] = database.NodeMetadata{ID: fmt.Sprintf("node-%d", i+1), TotalCPU: 4, TotalMemory: 8 << 30, MaxDeployments: 50}
+			}
+
+			placed := make(map[string]string)
+			for _, d := range deployments {
+				strategy, err := NewStrategy(strategyName, d.rules...)
+				if err != nil {
+					t.Fatalf("NewStrategy(%q): %v", strategyName, err)
+				}
+				node := strategy.Select(nodes)
+				if node == nil {
+					placed[d.name] = ""
+					continue
+				}
+				placed[d.name] = node.ID
+				node.DeploymentCount++
+				node.DeploymentLabels = append(node.DeploymentLabels, map[string]string{"app": d.app})
+			}
+
+			webNodes := make(map[string]bool)
+			for _, name := range []string{"web-1", "web-2", "web-3", "web-4"} {
+				if placed[name] == "" {
+					t.Fatalf("%s was not placed: %v", name, placed)
+				}
+				webNodes[placed[name]] = true
+			}
+			if len(webNodes) != 4 {
+				t.Fatalf("web replicas share nodes: %v", placed)
+			}
+			if placed["web-5"] != "" {
+				t.Fatalf("web-5 placed on %s, want no node once every node runs a web replica", placed["web-5"])
+			}
+
+			workerNodes := map[string]bool{placed["worker-1"]: true, placed["worker-2"]: true, placed["worker-3"]: true}
+			if len(workerNodes) != 3 || workerNodes[""] {
+				t.Fatalf("worker replicas not spread over distinct nodes: %v", placed)
+			}
+
+			if placed["sidecar"] != placed["api"] {
+				t.Fatalf("sidecar placed on %s, want the api node %s", placed["sidecar"], placed["api"])
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+// Scorer names accepted in PLACEMENT_SCORER_WEIGHTS
+const (
+	ScorerCPU            = "cpu"
+	ScorerMemory         = "memory"
+	ScorerSpread         = "spread"
+	ScorerNetworkLatency = "latency"
+)
+
+// defaultScorerWeights apply when PLACEMENT_SCORER_WEIGHTS is unset
+var defaultScorerWeights = map[string]float64{
+	ScorerCPU:            0.35,
+	ScorerMemory:         0.35,
+	ScorerSpread:         0.2,
+	ScorerNetworkLatency: 0.1,
+}
+
+// Scorer rates how well a node suits a new deployment, from 0 (worst) to 1 (best).
+// Implementations must be safe for concurrent use.
+type Scorer interface {
+	Score(node *database.NodeMetadata) float64
+}
+
+// CPUScorer scores a node by its free CPU fraction. UsedCPU is the summed container CPU
+// percentage, so a node's free cores are TotalCPU - UsedCPU/100.
+type CPUScorer struct{}
+
+func (CPUScorer) Score(node *database.NodeMetadata) float64 {
+	if node.TotalCPU <= 0 {
+		return 0
+	}
+	return clampScore((float64(node.TotalCPU) - node.UsedCPU/100) / float64(node.TotalCPU))
+}
+
+// MemoryScorer scores a node by its free memory fraction
+type MemoryScorer struct{}
+
+func (MemoryScorer) Score(node *database.NodeMetadata) float64 {
+	if node.TotalMemory <= 0 {
+		return 0
+	}
+	return clampScore(float64(node.TotalMemory-node.UsedMemory) / float64(node.TotalMemory))
+}
+
+// SpreadScorer prefers nodes running fewer of the organization's deployments, scoring
+// 1/(1+n) for a node with n of them so an organization's deployments spread across nodes
+type SpreadScorer struct{}
+
+func (SpreadScorer) Score(node *database.NodeMetadata) float64 {
+	return 1 / (1 + float64(node.OrgDeploymentCount))
+}
+
+// NetworkLatencyScorer scores a node by the round trip to it: 1 for an instant reply, falling
+// linearly to 0 at MaxLatency. Unreachable nodes score 0. Round trips are cached for CacheTTL
+// so scoring does not dial a node on every placement.
+type NetworkLatencyScorer struct {
+	// Ping measures the round trip to a node; nil times a TCP connect to the node's IP on Port
+	Ping       func(ctx context.Context, node *database.NodeMetadata) (time.Duration, error)
+	Port       string
+	MaxLatency time.Duration
+	CacheTTL   time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedLatency
+}
+
+type cachedLatency struct {
+	rtt        time.Duration
+	reachable  bool
+	measuredAt time.Time
+}
+
+// NewNetworkLatencyScorer pings the Docker Swarm node communication port (7946) and treats
+// round trips of 50ms or more as worst
+func NewNetworkLatencyScorer() *NetworkLatencyScorer {
+	return &NetworkLatencyScorer{
+		Port:       "7946",
+		MaxLatency: 50 * time.Millisecond,
+		CacheTTL:   time.Minute,
+	}
+}
+
+func (s *NetworkLatencyScorer) Score(node *database.NodeMetadata) float64 {
+	rtt, ok := s.roundTrip(node)
+	if !ok || s.MaxLatency <= 0 {
+		return 0
+	}
+	return clampScore(1 - float64(rtt)/float64(s.MaxLatency))
+}
+
+func (s *NetworkLatencyScorer) roundTrip(node *database.NodeMetadata) (time.Duration, bool) {
+	s.mu.Lock()
+	cached, found := s.cache[node.ID]
+	s.mu.Unlock()
+	if found && time.Since(cached.measuredAt) < s.CacheTTL {
+		return cached.rtt, cached.reachable
+	}
+
+	ping := s.Ping
+	if ping == nil {
+		ping = s.tcpPing
+	}
+	timeout := 2 * s.MaxLatency
+	if timeout < time.Second {
+		timeout = time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	rtt, err := ping(ctx, node)
+
+	s.mu.Lock()
+	if s.cache == nil {
+		s.cache = make(map[string]cachedLatency)
+	}
+	s.cache[node.ID] = cachedLatency{rtt: rtt, reachable: err == nil, measuredAt: time.Now()}
+	s.mu.Unlock()
+	return rtt, err == nil
+}
+
+// tcpPing times a TCP connect, which needs no privileges unlike an ICMP echo
+func (s *NetworkLatencyScorer) tcpPing(ctx context.Context, node *database.NodeMetadata) (time.Duration, error) {
+	if node.IP == "" {
+		return 0, fmt.Errorf("node %s has no IP address", node.ID)
+	}
+	var dialer net.Dialer
+	started := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(node.IP, s.Port))
+	if err != nil {
+		return 0, err
+	}
+	rtt := time.Since(started)
+	conn.Close()
+	return rtt, nil
+}
+
+func clampScore(score float64) float64 {
+	switch {
+	case score < 0:
+		return 0
+	case score > 1:
+		return 1
+	default:
+		return score
+	}
+}
+
+// WeightedScorer is a scorer and its weight in a CompositeScorer
+type WeightedScorer struct {
+	Name   string
+	Scorer Scorer
+	Weight float64
+}
+
+// CompositeScorer combines scorers into their weighted average
+type CompositeScorer struct {
+	scorers     []WeightedScorer
+	totalWeight float64
+}
+
+// NewCompositeScorer combines the scorers; weights must not be negative and at least one must be positive
+func NewCompositeScorer(scorers ...WeightedScorer) (*CompositeScorer, error) {
+	composite := &CompositeScorer{}
+	for _, scorer := range scorers {
+		if scorer.Weight < 0 {
+			return nil, fmt.Errorf("scorer %q has negative weight %v", scorer.Name, scorer.Weight)
+		}
+		if scorer.Weight == 0 {
+			continue
+		}
+		composite.scorers = append(composite.scorers, scorer)
+		composite.totalWeight += scorer.Weight
+	}
+	if composite.totalWeight == 0 {
+		return nil, fmt.Errorf("at least one scorer needs a positive weight")
+	}
+	return composite, nil
+}
+
+func (c *CompositeScorer) Score(node *database.NodeMetadata) float64 {
+	total := 0.0
+	for _, scorer := range c.scorers {
+		total += scorer.Weight * scorer.Scorer.Score(node)
+	}
+	return total / c.totalWeight
+}
+
+// NewCompositeScorerFromWeights builds a CompositeScorer from scorer names and weights
+func NewCompositeScorerFromWeights(weights map[string]float64) (*CompositeScorer, error) {
+	names := make([]string, 0, len(weights))
+	for name := range weights {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	scorers := make([]WeightedScorer, 0, len(names))
+	for _, name := range names {
+		var scorer Scorer
+		switch name {
+		case ScorerCPU:
+			scorer = CPUScorer{}
+		case ScorerMemory:
+			scorer = MemoryScorer{}
+		case ScorerSpread:
+			scorer = SpreadScorer{}
+		case ScorerNetworkLatency:
+			scorer = NewNetworkLatencyScorer()
+		default:
+			return nil, fmt.Errorf("unknown placement scorer %q (want %s, %s, %s or %s)", name, ScorerCPU, ScorerMemory, ScorerSpread, ScorerNetworkLatency)
+		}
+		scorers = append(scorers, WeightedScorer{Name: name, Scorer: scorer, Weight: weights[name]})
+	}
+	return NewCompositeScorer(scorers...)
+}
+
+// ParseScorerWeights parses PLACEMENT_SCORER_WEIGHTS, a JSON object of scorer names to weights
+// such as {"cpu": 0.4, "memory": 0.4, "spread": 0.2}. An empty value gives the default weights.
+func ParseScorerWeights(raw string) (map[string]float64, error) {
+	if strings.TrimSpace(raw) == "" {
+		weights := make(map[string]float64, len(defaultScorerWeights))
+		for name, weight := range defaultScorerWeights {
+			weights[name] = weight
+		}
+		return weights, nil
+	}
+	var weights map[string]float64
+	if err := json.Unmarshal([]byte(raw), &weights); err != nil {
+		return nil, fmt.Errorf("invalid PLACEMENT_SCORER_WEIGHTS: %w", err)
+	}
+	return weights, nil
+}
+
+// ScoredStrategy selects the node with the highest score, keeping the first of equal scores
+type ScoredStrategy struct {
+	Scorer Scorer
+}
+
+// NewScoredStrategyFromEnv builds a ScoredStrategy from the weights in PLACEMENT_SCORER_WEIGHTS
+func NewScoredStrategyFromEnv() (ScoredStrategy, error) {
+	weights, err := ParseScorerWeights(os.Getenv("PLACEMENT_SCORER_WEIGHTS"))
+	if err != nil {
+		return ScoredStrategy{}, err
+	}
+	scorer, err := NewCompositeScorerFromWeights(weights)
+	if err != nil {
+		return ScoredStrategy{}, fmt.Errorf("invalid PLACEMENT_SCORER_WEIGHTS: %w", err)
+	}
+	return ScoredStrategy{Scorer: scorer}, nil
+}
+
+func (ScoredStrategy) Name() string { return StrategyScored }
+
+func (s ScoredStrategy) Select(nodes []database.NodeMetadata) *database.NodeMetadata {
+	best := 0
+	bestScore := s.Scorer.Score(&nodes[0])
+	for i := 1; i < len(nodes); i++ {
+		if score := s.Scorer.Score(&nodes[i]); score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return &nodes[best]
+}
@@ -0,0 +1,221 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+// fixedScorer scores each node by ID
+type fixedScorer map[string]float64
+
+func (f fixedScorer) Score(node *database.NodeMetadata) float64 { return f[node.ID] }
+
+func TestResourceScorers(t *testing.T) {
+	node := &database.NodeMetadata{TotalCPU: 4, UsedCPU: 100, TotalMemory: 8 << 30, UsedMemory: 2 << 30, OrgDeploymentCount: 3}
+	for _, c := range []struct {
+		name   string
+		scorer Scorer
+		want   float64
+	}{
+		{ScorerCPU, CPUScorer{}, 0.75},
+		{ScorerMemory, MemoryScorer{}, 0.75},
+		{ScorerSpread, SpreadScorer{}, 0.25},
+	} {
+		if got := c.scorer.Score(node); math.Abs(got-c.want) > 1e-9 {
+			t.Fatalf("%s score = %v, want %v", c.name, got, c.want)
+		}
+	}
+
+	// Nodes without reported capacity or running over it score 0
+	if got := (CPUScorer{}).Score(&database.NodeMetadata{TotalCPU: 2, UsedCPU: 400}); got != 0 {
+		t.Fatalf("saturated CPU score = %v, want 0", got)
+	}
+	if got := (MemoryScorer{}).Score(&database.NodeMetadata{}); got != 0 {
+		t.Fatalf("unknown memory score = %v, want 0", got)
+	}
+}
+
+func TestNetworkLatencyScorerCachesRoundTrips(t *testing.T) {
+	pings := 0
+	scorer := &NetworkLatencyScorer{
+		Ping: func(ctx context.Context, node *database.NodeMetadata) (time.Duration, error) {
+			pings++
+			if node.ID == "down" {
+				return 0, errors.New("connection refused")
+			}
+			return 10 * time.Millisecond, nil
+		},
+		MaxLatency: 40 * time.Millisecond,
+		CacheTTL:   time.Minute,
+	}
+
+	up := &database.NodeMetadata{ID: "up"}
+	for i := 0; i < 3; i++ {
+		if got := scorer.Score(up); math.Abs(got-0.75) > 1e-9 {
+			t.Fatalf("latency score = %v, want 0.75", got)
+		}
+	}
+	if got := scorer.Score(&database.NodeMetadata{ID: "down"}); got != 0 {
+		t.Fatalf("unreachable node score = %v, want 0", got)
+	}
+	if pings != 2 {
+		t.Fatalf("pinged %d times, want once per node", pings)
+	}
+
+	scorer.CacheTTL = 0
+	scorer.Score(up)
+	if pings != 3 {
+		t.Fatalf("pinged %d times, want an expired round trip measured again", pings)
+	}
+}
+
+func TestCompositeScorerWeightsScores(t *testing.T) {
+	composite, err := NewCompositeScorer(
+		WeightedScorer{Name: "a", Scorer: fixedScorer{"n": 1}, Weight: 3},
+		WeightedScorer{Name: "b", Scorer: fixedScorer{"n": 0}, Weight: 1},
+		WeightedScorer{Name: "c", Scorer: fixedScorer{"n": 0}, Weight: 0},
+	)
+	if err != nil {
+		t.Fatalf("NewCompositeScorer: %v", err)
+	}
+	if got := composite.Score(&database.NodeMetadata{ID: "n"}); math.Abs(got-0.75) > 1e-9 {
+		t.Fatalf("composite score = %v, want 0.75", got)
+	}
+
+	if _, err := NewCompositeScorer(WeightedScorer{Name: "a", Scorer: fixedScorer{}, Weight: -1}); err == nil {
+		t.Fatal("NewCompositeScorer accepted a negative weight")
+	}
+	if _, err := NewCompositeScorer(WeightedScorer{Name: "a", Scorer: fixedScorer{}, Weight: 0}); err == nil {
+		t.Fatal("NewCompositeScorer accepted all-zero weights")
+	}
+}
+
+func TestParseScorerWeights(t *testing.T) {
+	weights, err := ParseScorerWeights("")
+	if err != nil {
+		t.Fatalf("ParseScorerWeights(\"\"): %v", err)
+	}
+	if len(weights) != len(defaultScorerWeights) || weights[ScorerCPU] != defaultScorerWeights[ScorerCPU] {
+		t.Fatalf("empty weights = %v, want the defaults", weights)
+	}
+	weights[ScorerCPU] = 5
+	if defaultScorerWeights[ScorerCPU] == 5 {
+		t.Fatal("ParseScorerWeights returned the shared defaults map")
+	}
+
+	weights, err = ParseScorerWeights(`{"cpu": 1, "spread": 2}`)
+	if err != nil || len(weights) != 2 || weights[ScorerSpread] != 2 {
+		t.Fatalf("ParseScorerWeights = %v, %v", weights, err)
+	}
+	if _, err := NewCompositeScorerFromWeights(weights); err != nil {
+		t.Fatalf("NewCompositeScorerFromWeights: %v", err)
+	}
+
+	if _, err := ParseScorerWeights(`{"cpu": "high"}`); err == nil {
+		t.Fatal("ParseScorerWeights accepted a non-numeric weight")
+	}
+	if _, err := NewCompositeScorerFromWeights(map[string]float64{"disk": 1}); err == nil {
+		t.Fatal("NewCompositeScorerFromWeights accepted an unknown scorer")
+	}
+
+	t.Setenv("PLACEMENT_SCORER_WEIGHTS", `{"gpu": 1}`)
+	if _, err := NewStrategy(StrategyScored); err == nil {
+		t.Fatal("NewStrategy(scored) accepted an unknown scorer in PLACEMENT_SCORER_WEIGHTS")
+	}
+}
+
+func TestScoredStrategyPrefersIdleNodesWithFewOrgDeployments(t *testing.T) {
+	t.Setenv("PLACEMENT_SCORER_WEIGHTS", `{"cpu": 1, "memory": 1, "spread": 2}`)
+	strategy, err := NewStrategy(StrategyScored)
+	if err != nil {
+		t.Fatalf("NewStrategy(scored): %v", err)
+	}
+
+	nodes := []database.NodeMetadata{
+		{ID: "busy", TotalCPU: 4, UsedCPU: 300, TotalMemory: 8 << 30, UsedMemory: 6 << 30},
+		{ID: "crowded", TotalCPU: 4, TotalMemory: 8 << 30, OrgDeploymentCount: 4},
+		{ID: "idle", TotalCPU: 4, UsedCPU: 100, TotalMemory: 8 << 30, UsedMemory: 2 << 30},
+	}
+	if got := strategy.Select(nodes).ID; got != "idle" {
+		t.Fatalf("scored strategy picked %s, want idle", got)
+	}
+
+	// Equal scores keep the first node
+	tied := ScoredStrategy{Scorer: fixedScorer{"a": 0.5, "b": 0.5}}
+	if got := tied.Select([]database.NodeMetadata{{ID: "a"}, {ID: "b"}}).ID; got != "a" {
+		t.Fatalf("tie picked %s, want a", got)
+	}
+}
+
+// BenchmarkPlacementScoring makes 10,000 scheduling decisions on 20 nodes per iteration. Each
+// placement is applied to the chosen node so later decisions see it, and the busiest node's CPU
+// utilization over the average is reported as max/avg-cpu.
+func BenchmarkPlacementScoring(b *testing.B) {
+	const (
+		nodeCount = 20
+		decisions = 10000
+	)
+	baseline := make([]database.NodeMetadata, nodeCount)
+	roundTrips := make(map[string]time.Duration, nodeCount)
+	for i := range baseline {
+		baseline[i] = database.NodeMetadata{
+			ID:             fmt.Sprintf("node-%02d", i),
+			IP:             fmt.Sprintf("10.0.0.%d", i+1),
+			TotalCPU:       4 + (i%4)*4,
+			UsedCPU:        float64(i%5) * 50,
+			TotalMemory:    int64(8+(i%3)*8) << 30,
+			UsedMemory:     int64(i%4) << 30,
+			MaxDeployments: decisions,
+		}
+		roundTrips[baseline[i].ID] = time.Duration(2+i%6*8) * time.Millisecond
+	}
+
+	latency := NewNetworkLatencyScorer()
+	latency.Ping = func(ctx context.Context, node *database.NodeMetadata) (time.Duration, error) {
+		return roundTrips[node.ID], nil
+	}
+	composite, err := NewCompositeScorer(
+		WeightedScorer{Name: ScorerCPU, Scorer: CPUScorer{}, Weight: defaultScorerWeights[ScorerCPU]},
+		WeightedScorer{Name: ScorerMemory, Scorer: MemoryScorer{}, Weight: defaultScorerWeights[ScorerMemory]},
+		WeightedScorer{Name: ScorerSpread, Scorer: SpreadScorer{}, Weight: defaultScorerWeights[ScorerSpread]},
+		WeightedScorer{Name: ScorerNetworkLatency, Scorer: latency, Weight: defaultScorerWeights[ScorerNetworkLatency]},
+	)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	strategies := []NodeSelectionStrategy{LeastLoadedStrategy{}, ScoredStrategy{Scorer: composite}}
+	for _, strategy := range strategies {
+		b.Run(strategy.Name(), func(b *testing.B) {
+			nodes := make([]database.NodeMetadata, nodeCount)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				copy(nodes, baseline)
+				for j := 0; j < decisions; j++ {
+					node := strategy.Select(nodes)
+					node.DeploymentCount++
+					node.OrgDeploymentCount++
+					// Each deployment uses a hundredth of a core and 16MiB
+					node.UsedCPU++
+					node.UsedMemory += 16 << 20
+				}
+			}
+			b.StopTimer()
+
+			maxUtilization, totalUtilization := 0.0, 0.0
+			for _, node := range nodes {
+				utilization := node.UsedCPU / 100 / float64(node.TotalCPU)
+				totalUtilization += utilization
+				maxUtilization = math.Max(maxUtilization, utilization)
+			}
+			b.ReportMetric(maxUtilization/(totalUtilization/nodeCount), "max/avg-cpu")
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"sort"
 	"strings"
 	"time"
 
@@ -19,20 +18,25 @@ import (
 
 // NodeSelector selects the best node for a deployment
 type NodeSelector struct {
-	strategy              string
+	strategy              NodeSelectionStrategy
 	maxDeploymentsPerNode int
 	dockerClient          client.APIClient
 }
 
 // NewNodeSelector creates a new node selector
 func NewNodeSelector(strategy string, maxDeploymentsPerNode int) (*NodeSelector, error) {
+	selectionStrategy, err := NewStrategy(strategy)
+	if err != nil {
+		return nil, err
+	}
+
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Docker client: %w", err)
 	}
 
 	return &NodeSelector{
-		strategy:              strategy,
+		strategy:              selectionStrategy,
 		maxDeploymentsPerNode: maxDeploymentsPerNode,
 		dockerClient:          cli,
 	}, nil
@@ -40,6 +44,12 @@ func NewNodeSelector(strategy string, maxDeploymentsPerNode int) (*NodeSelector,
 
 // SelectNode selects the best node for deployment based on the configured strategy
 func (ns *NodeSelector) SelectNode(ctx context.Context) (*database.NodeMetadata, error) {
+	return ns.SelectNodeWithAffinity(ctx, "", nil)
+}
+
+// SelectNodeWithAffinity selects the best node for a deployment of the organization, applying
+// affinity rules against the organization's running deployments before the configured strategy
+func (ns *NodeSelector) SelectNodeWithAffinity(ctx context.Context, organizationID string, rules []AffinityRule) (*database.NodeMetadata, error) {
 	// Sync node information with Docker Swarm first (this populates the database)
 	if err := ns.syncNodeMetadata(ctx); err != nil {
 		log.Printf("[NodeSelector] ERROR: Failed to sync node metadata: %v", err)
@@ -70,72 +80,66 @@ func (ns *NodeSelector) SelectNode(ctx context.Context) (*database.NodeMetadata,
 
 	log.Printf("[NodeSelector] Found %d available node(s)", len(nodes))
 
-	// Select node based on strategy
-	switch ns.strategy {
-	case "least-loaded":
-		return ns.selectLeastLoaded(nodes), nil
-	case "round-robin":
-		return ns.selectRoundRobin(nodes), nil
-	case "resource-based":
-		return ns.selectByResources(nodes), nil
-	default:
-		return ns.selectLeastLoaded(nodes), nil
-	}
-}
-
-// selectLeastLoaded selects the node with the lowest deployment count
-func (ns *NodeSelector) selectLeastLoaded(nodes []database.NodeMetadata) *database.NodeMetadata {
-	sort.Slice(nodes, func(i, j int) bool {
-		if nodes[i].DeploymentCount != nodes[j].DeploymentCount {
-			return nodes[i].DeploymentCount < nodes[j].DeploymentCount
+	if organizationID != "" && ns.strategy.Name() == StrategyScored {
+		counts, err := database.GetNodeDeploymentCounts(organizationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count deployments for spread scoring: %w", err)
 		}
-		// If deployment counts are equal, prefer node with lower CPU usage
-		return nodes[i].UsedCPU < nodes[j].UsedCPU
-	})
-
-	return &nodes[0]
-}
-
-// selectRoundRobin selects nodes in round-robin fashion
-func (ns *NodeSelector) selectRoundRobin(nodes []database.NodeMetadata) *database.NodeMetadata {
-	// Sort by deployment count to balance load
-	sort.Slice(nodes, func(i, j int) bool {
-		return nodes[i].DeploymentCount < nodes[j].DeploymentCount
-	})
-
-	return &nodes[0]
-}
+		for i := range nodes {
+			nodes[i].OrgDeploymentCount = counts[nodes[i].ID]
+		}
+	}
 
-// selectByResources selects the node with the most available resources
-func (ns *NodeSelector) selectByResources(nodes []database.NodeMetadata) *database.NodeMetadata {
-	type nodeScore struct {
-		node  *database.NodeMetadata
-		score float64
+	if len(rules) == 0 {
+		return ns.strategy.Select(nodes), nil
 	}
 
-	scores := make([]nodeScore, len(nodes))
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid affinity rules: %w", err)
+		}
+	}
+	// Wrap the configured strategy rather than building a new one so scorers keep their cached state
+	strategy := affinityStrategy{base: ns.strategy, rules: rules}
+	nodeLabels, err := database.GetNodeDeploymentLabels(organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load deployment labels for affinity rules: %w", err)
+	}
 	for i := range nodes {
-		// Calculate available resources
-		availableCPU := float64(nodes[i].TotalCPU) - nodes[i].UsedCPU
-		availableMemory := float64(nodes[i].TotalMemory - nodes[i].UsedMemory)
-
-		// Normalize scores (0-1 range)
-		cpuScore := availableCPU / float64(nodes[i].TotalCPU)
-		memoryScore := availableMemory / float64(nodes[i].TotalMemory)
-
-		// Weighted score (CPU: 40%, Memory: 40%, Deployment count: 20%)
-		deploymentScore := 1.0 - (float64(nodes[i].DeploymentCount) / float64(ns.maxDeploymentsPerNode))
-		totalScore := (cpuScore * 0.4) + (memoryScore * 0.4) + (deploymentScore * 0.2)
+		nodes[i].DeploymentLabels = nodeLabels[nodes[i].ID]
+	}
 
-		scores[i] = nodeScore{node: &nodes[i], score: totalScore}
+	node := strategy.Select(nodes)
+	if node == nil {
+		return nil, fmt.Errorf("no available node satisfies the deployment's anti-affinity rules")
 	}
+	return node, nil
+}
 
-	// Sort by score (highest first)
-	sort.Slice(scores, func(i, j int) bool {
-		return scores[i].score > scores[j].score
-	})
+// SelectNodeInRegion selects the best node in a region with the configured strategy
+func (ns *NodeSelector) SelectNodeInRegion(ctx context.Context, region string) (*database.NodeMetadata, error) {
+	if err := ns.syncNodeMetadata(ctx); err != nil {
+		return nil, fmt.Errorf("failed to sync node metadata: %w", err)
+	}
+	nodes, err := database.GetAvailableNodes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available nodes: %w", err)
+	}
+	inRegion := make([]database.NodeMetadata, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Region == region {
+			inRegion = append(inRegion, node)
+		}
+	}
+	if len(inRegion) == 0 {
+		return nil, fmt.Errorf("no available nodes in region %q", region)
+	}
+	return ns.strategy.Select(inRegion), nil
+}
 
-	return scores[0].node
+// StrategyName returns the name of the node selection strategy in use
+func (ns *NodeSelector) StrategyName() string {
+	return ns.strategy.Name()
 }
 
 // syncNodeMetadata synchronizes node metadata from Docker Swarm to the database
@@ -0,0 +1,173 @@
+package orchestrator
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+// Node selection strategy names accepted by NewStrategy
+const (
+	StrategyLeastLoaded        = "least-loaded"
+	StrategyRoundRobin         = "round-robin"
+	StrategyResourceBased      = "resource-based"
+	StrategyWeightedRoundRobin = "weighted-round-robin"
+	StrategyRandom             = "random"
+	StrategyScored             = "scored"
+)
+
+// minNodeWeight keeps fully loaded nodes selectable, at a much lower rate
+const minNodeWeight = 0.01
+
+// NodeSelectionStrategy picks one node from a non-empty list of available nodes.
+// Implementations must be safe for concurrent use and must not modify the list.
+type NodeSelectionStrategy interface {
+	Name() string
+	Select(nodes []database.NodeMetadata) *database.NodeMetadata
+}
+
+// NewStrategy returns the node selection strategy with the given name.
+// An empty name selects the least-loaded strategy.
+// Affinity rules, if any, filter the nodes before the strategy picks one; see affinityStrategy.
+func NewStrategy(name string, rules ...AffinityRule) (NodeSelectionStrategy, error) {
+	var strategy NodeSelectionStrategy
+	switch name {
+	case StrategyLeastLoaded, "":
+		strategy = LeastLoadedStrategy{}
+	case StrategyRoundRobin:
+		strategy = RoundRobinStrategy{}
+	case StrategyResourceBased:
+		strategy = ResourceBasedStrategy{}
+	case StrategyWeightedRoundRobin:
+		strategy = WeightedRoundRobinStrategy{}
+	case StrategyRandom:
+		strategy = RandomStrategy{}
+	case StrategyScored:
+		scored, err := NewScoredStrategyFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		strategy = scored
+	default:
+		return nil, fmt.Errorf("unknown node selection strategy %q", name)
+	}
+
+	if len(rules) == 0 {
+		return strategy, nil
+	}
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return affinityStrategy{base: strategy, rules: rules}, nil
+}
+
+// LeastLoadedStrategy selects the node with the lowest deployment count,
+// breaking ties by lower CPU usage
+type LeastLoadedStrategy struct{}
+
+func (LeastLoadedStrategy) Name() string { return StrategyLeastLoaded }
+
+func (LeastLoadedStrategy) Select(nodes []database.NodeMetadata) *database.NodeMetadata {
+	best := 0
+	for i := 1; i < len(nodes); i++ {
+		if nodes[i].DeploymentCount < nodes[best].DeploymentCount ||
+			(nodes[i].DeploymentCount == nodes[best].DeploymentCount && nodes[i].UsedCPU < nodes[best].UsedCPU) {
+			best = i
+		}
+	}
+	return &nodes[best]
+}
+
+// RoundRobinStrategy spreads deployments by selecting the node with the fewest deployments
+type RoundRobinStrategy struct{}
+
+func (RoundRobinStrategy) Name() string { return StrategyRoundRobin }
+
+func (RoundRobinStrategy) Select(nodes []database.NodeMetadata) *database.NodeMetadata {
+	best := 0
+	for i := 1; i < len(nodes); i++ {
+		if nodes[i].DeploymentCount < nodes[best].DeploymentCount {
+			best = i
+		}
+	}
+	return &nodes[best]
+}
+
+// ResourceBasedStrategy selects the node with the most available resources
+type ResourceBasedStrategy struct{}
+
+func (ResourceBasedStrategy) Name() string { return StrategyResourceBased }
+
+func (ResourceBasedStrategy) Select(nodes []database.NodeMetadata) *database.NodeMetadata {
+	best := 0
+	bestScore := resourceScore(&nodes[0])
+	for i := 1; i < len(nodes); i++ {
+		if score := resourceScore(&nodes[i]); score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return &nodes[best]
+}
+
+// resourceScore weights free CPU and memory at 40% each and free deployment slots at 20%
+func resourceScore(node *database.NodeMetadata) float64 {
+	var cpuScore, memoryScore, deploymentScore float64
+	if node.TotalCPU > 0 {
+		cpuScore = (float64(node.TotalCPU) - node.UsedCPU) / float64(node.TotalCPU)
+	}
+	if node.TotalMemory > 0 {
+		memoryScore = float64(node.TotalMemory-node.UsedMemory) / float64(node.TotalMemory)
+	}
+	if node.MaxDeployments > 0 {
+		deploymentScore = 1.0 - float64(node.DeploymentCount)/float64(node.MaxDeployments)
+	}
+	return cpuScore*0.4 + memoryScore*0.4 + deploymentScore*0.2
+}
+
+// WeightedRoundRobinStrategy picks nodes at random in proportion to their available CPU
+// cores. Core counts come from Docker (docker info on local nodes, the node description
+// in Swarm) and UsedCPU is the summed container CPU percentage, so a node's weight is
+// TotalCPU - UsedCPU/100, normalized over all nodes.
+type WeightedRoundRobinStrategy struct{}
+
+func (WeightedRoundRobinStrategy) Name() string { return StrategyWeightedRoundRobin }
+
+func (WeightedRoundRobinStrategy) Select(nodes []database.NodeMetadata) *database.NodeMetadata {
+	total := 0.0
+	for i := range nodes {
+		total += nodeWeight(&nodes[i])
+	}
+
+	// Normalizing the weights to sum to 1 is the same as scaling the target by their total
+	target := rand.Float64() * total
+	cumulative := 0.0
+	for i := range nodes {
+		cumulative += nodeWeight(&nodes[i])
+		if target < cumulative {
+			return &nodes[i]
+		}
+	}
+	// Rounding can leave the cumulative sum just under the target
+	return &nodes[len(nodes)-1]
+}
+
+// nodeWeight returns a node's available CPU cores, with a floor so saturated nodes stay selectable
+func nodeWeight(node *database.NodeMetadata) float64 {
+	available := float64(node.TotalCPU) - node.UsedCPU/100
+	if available < minNodeWeight {
+		return minNodeWeight
+	}
+	return available
+}
+
+// RandomStrategy selects a node uniformly at random
+type RandomStrategy struct{}
+
+func (RandomStrategy) Name() string { return StrategyRandom }
+
+func (RandomStrategy) Select(nodes []database.NodeMetadata) *database.NodeMetadata {
+	return &nodes[rand.IntN(len(nodes))]
+}
@@ -0,0 +1,137 @@
+package orchestrator
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+func strategyTestNodes() []database.NodeMetadata {
+	return []database.NodeMetadata{
+		{ID: "small", TotalCPU: 2, TotalMemory: 4 << 30, DeploymentCount: 1, MaxDeployments: 50},
+		{ID: "medium", TotalCPU: 4, TotalMemory: 8 << 30, DeploymentCount: 3, MaxDeployments: 50},
+		{ID: "large", TotalCPU: 8, UsedCPU: 200, TotalMemory: 16 << 30, DeploymentCount: 5, MaxDeployments: 50},
+	}
+}
+
+func TestNewStrategy(t *testing.T) {
+	for _, name := range []string{StrategyLeastLoaded, StrategyRoundRobin, StrategyResourceBased, StrategyWeightedRoundRobin, StrategyRandom, StrategyScored} {
+		strategy, err := NewStrategy(name)
+		if err != nil {
+			t.Fatalf("NewStrategy(%q): %v", name, err)
+		}
+		if strategy.Name() != name {
+			t.Fatalf("NewStrategy(%q).Name() = %q", name, strategy.Name())
+		}
+	}
+
+	strategy, err := NewStrategy("")
+	if err != nil || strategy.Name() != StrategyLeastLoaded {
+		t.Fatalf("NewStrategy(\"\") = %v, %v; want least-loaded", strategy, err)
+	}
+	if _, err := NewStrategy("fastest"); err == nil {
+		t.Fatal("NewStrategy accepted an unknown strategy")
+	}
+}
+
+func TestNodeWeightFollowsAvailableCPU(t *testing.T) {
+	// Available cores: 2, 4 and 8-2=6
+	nodes := strategyTestNodes()
+	for i, want := range []float64{2, 4, 6} {
+		if got := nodeWeight(&nodes[i]); math.Abs(got-want) > 1e-9 {
+			t.Fatalf("nodeWeight(%s) = %v, want %v", nodes[i].ID, got, want)
+		}
+	}
+
+	// A saturated node keeps a minimal weight
+	if got := nodeWeight(&database.NodeMetadata{TotalCPU: 2, UsedCPU: 400}); got != minNodeWeight {
+		t.Fatalf("saturated node weight = %v, want %v", got, minNodeWeight)
+	}
+}
+
+func TestWeightedRoundRobinStrategyDistribution(t *testing.T) {
+	nodes := strategyTestNodes()
+	strategy := WeightedRoundRobinStrategy{}
+
+	const picks = 60000
+	counts := make(map[string]int)
+	for i := 0; i < picks; i++ {
+		counts[strategy.Select(nodes).ID]++
+	}
+
+	for i, share := range []float64{2.0 / 12, 4.0 / 12, 6.0 / 12} {
+		got := float64(counts[nodes[i].ID]) / picks
+		if math.Abs(got-share) > 0.02 {
+			t.Fatalf("node %s picked %.3f of the time, want about %.3f", nodes[i].ID, got, share)
+		}
+	}
+}
+
+func TestDeterministicStrategies(t *testing.T) {
+	nodes := strategyTestNodes()
+	if got := (LeastLoadedStrategy{}).Select(nodes).ID; got != "small" {
+		t.Fatalf("least-loaded picked %s, want small", got)
+	}
+	if got := (RoundRobinStrategy{}).Select(nodes).ID; got != "small" {
+		t.Fatalf("round-robin picked %s, want small", got)
+	}
+	if got := (ResourceBasedStrategy{}).Select(nodes).ID; got != "small" {
+		t.Fatalf("resource-based picked %s, want small", got)
+	}
+	if nodes[0].ID != "small" || nodes[2].ID != "large" {
+		t.Fatal("strategies must not reorder the node list")
+	}
+}
+
+// BenchmarkNodeSelectionContention runs 10,000 node selections per iteration, split
+// across GOMAXPROCS goroutines sharing one strategy and node list
+func BenchmarkNodeSelectionContention(b *testing.B) {
+	const selections = 10000
+	nodes := make([]database.NodeMetadata, 0, 32)
+	for i := 0; i < 32; i++ {
+		nodes = append(nodes, database.NodeMetadata{
+			ID:              string(rune('a' + i%26)),
+			TotalCPU:        2 + i%8,
+			UsedCPU:         float64(i * 10),
+			TotalMemory:     int64(4+i%4) << 30,
+			DeploymentCount: i % 7,
+			MaxDeployments:  50,
+		})
+	}
+
+	for _, name := range []string{StrategyLeastLoaded, StrategyResourceBased, StrategyWeightedRoundRobin, StrategyRandom} {
+		strategy, err := NewStrategy(name)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(name, func(b *testing.B) {
+			workers := runtime.GOMAXPROCS(0)
+			perWorker := selections / workers
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var wg sync.WaitGroup
+				for w := 0; w < workers; w++ {
+					calls := perWorker
+					if w == 0 {
+						calls += selections % workers
+					}
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						for j := 0; j < calls; j++ {
+							if strategy.Select(nodes) == nil {
+								b.Error("no node selected")
+								return
+							}
+						}
+					}()
+				}
+				wg.Wait()
+			}
+		})
+	}
+}
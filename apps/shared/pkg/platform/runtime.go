@@ -8,6 +8,7 @@ import (
 
 const (
 	DefaultDashboardURL = "http://localhost:3000"
+	DefaultAPIURL       = "http://api.localhost"
 	DefaultZitadelURL   = "http://localhost:8080"
 	DefaultDomain       = "localhost"
 )
@@ -22,6 +23,17 @@ func DashboardURL() string {
 	return DefaultDashboardURL
 }
 
+// APIURL is the public URL of the API gateway
+func APIURL() string {
+	for _, key := range []string{"NUXT_PUBLIC_API_HOST", "API_URL"} {
+		if value := normalizeURL(os.Getenv(key)); value != "" {
+			return value
+		}
+	}
+
+	return DefaultAPIURL
+}
+
 func SupportEmail() string {
 	return strings.TrimSpace(os.Getenv("SUPPORT_EMAIL"))
 }
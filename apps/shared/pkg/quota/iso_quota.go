@@ -0,0 +1,66 @@
+package quota
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+// defaultISOStorageBytes is the ISO storage of an organization when neither its quota override
+// nor VPS_ISO_STORAGE_QUOTA_BYTES sets one
+const defaultISOStorageBytes int64 = 10 << 30 // 10 GiB
+
+// ErrISOQuotaExceeded is returned when an ISO upload does not fit into the organization's ISO storage
+var ErrISOQuotaExceeded = errors.New("quota exceeded: ISO storage limit reached")
+
+// GetEffectiveISOStorageLimit returns the storage the organization may use for uploaded VPS ISOs.
+// Managed organizations share their parent's limit. ISOs sit on the Proxmox nodes' own storage,
+// so unlike the plan limits there is no unlimited value.
+func GetEffectiveISOStorageLimit(organizationID string) (int64, error) {
+	quotaOrgID, _ := quotaScope(organizationID)
+	quota, err := NewChecker().getQuota(quotaOrgID)
+	if err != nil {
+		return 0, err
+	}
+	if quota.ISOStorageBytesOverride != nil && *quota.ISOStorageBytesOverride > 0 {
+		return *quota.ISOStorageBytesOverride, nil
+	}
+	return defaultISOStorageLimit(), nil
+}
+
+// GetISOStorageUsage returns the ISO storage used by the organization (including its managed
+// organizations, or its parent's) and the effective limit
+func GetISOStorageUsage(organizationID string) (used int64, limit int64, err error) {
+	limit, err = GetEffectiveISOStorageLimit(organizationID)
+	if err != nil {
+		return 0, 0, err
+	}
+	_, orgIDs := quotaScope(organizationID)
+	used, err = database.SumVPSISOBytes(orgIDs)
+	if err != nil {
+		return 0, 0, err
+	}
+	return used, limit, nil
+}
+
+// RemainingISOStorage returns how many more ISO bytes the organization may upload
+func RemainingISOStorage(organizationID string) (int64, error) {
+	used, limit, err := GetISOStorageUsage(organizationID)
+	if err != nil {
+		return 0, err
+	}
+	return max(limit-used, 0), nil
+}
+
+// defaultISOStorageLimit reads VPS_ISO_STORAGE_QUOTA_BYTES
+func defaultISOStorageLimit() int64 {
+	if raw := strings.TrimSpace(os.Getenv("VPS_ISO_STORAGE_QUOTA_BYTES")); raw != "" {
+		if limit, err := strconv.ParseInt(raw, 10, 64); err == nil && limit > 0 {
+			return limit
+		}
+	}
+	return defaultISOStorageBytes
+}
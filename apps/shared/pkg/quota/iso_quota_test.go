@@ -0,0 +1,44 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+func TestRemainingISOStorage(t *testing.T) {
+	db := newQuotaTestDB(t)
+	t.Setenv("VPS_ISO_STORAGE_QUOTA_BYTES", "1000")
+
+	parentID := "org-parent"
+	override := int64(5000)
+	seed := []any{
+		&database.Organization{ID: parentID, Name: "Agency", Slug: "agency"},
+		&database.OrgQuota{OrganizationID: parentID, ISOStorageBytesOverride: &override},
+		&database.Organization{ID: "org-client", Name: "Client", Slug: "client", ParentOrgID: &parentID},
+		&database.Organization{ID: "org-other", Name: "Other", Slug: "other"},
+		&database.VPSISO{OrgID: parentID, ISOName: "a.iso", NodeName: "pve1", StoragePath: "local:iso/a.iso", SizeBytes: 1500},
+		&database.VPSISO{OrgID: "org-client", ISOName: "b.iso", NodeName: "pve1", StoragePath: "local:iso/b.iso", SizeBytes: 2500},
+		&database.VPSISO{OrgID: "org-other", ISOName: "c.iso", NodeName: "pve1", StoragePath: "local:iso/c.iso", SizeBytes: 1200},
+	}
+	for _, record := range seed {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	tests := []struct {
+		orgID string
+		want  int64
+	}{
+		{parentID, 1000},     // 5000 shared with the managed organization
+		{"org-client", 1000}, // Managed organizations use the parent's override
+		{"org-other", 0},     // Over the 1000 byte default
+	}
+	for _, tt := range tests {
+		got, err := RemainingISOStorage(tt.orgID)
+		if err != nil || got != tt.want {
+			t.Errorf("RemainingISOStorage(%s) = %d (%v), want %d", tt.orgID, got, err, tt.want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package quota
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/obiente/cloud/apps/shared/pkg/database"
@@ -17,18 +18,29 @@ type RequestedResources struct {
 
 type Checker struct{}
 
+// ErrNewResourcesSuspended is returned while an organization's new resource creation is
+// suspended by the billing dunning process
+var ErrNewResourcesSuspended = errors.New("new resource creation is suspended due to failed payments")
+
 func NewChecker() *Checker { return &Checker{} }
 
 // CanAllocate validates if the organization can allocate requested resources on top of current running allocations.
+// Managed organizations are checked against their parent's quota, shared with the parent's other managed organizations.
 func (c *Checker) CanAllocate(ctx context.Context, organizationID string, req RequestedResources) error {
+	quotaOrgID, orgIDs := quotaScope(organizationID)
+
 	// Ensure organization has a plan assigned (defaults to Starter plan)
 	// This is called when resources are requested, so it's a good place to ensure plan assignment
-	_ = organizations.EnsurePlanAssigned(organizationID)
+	_ = organizations.EnsurePlanAssigned(quotaOrgID)
 
-	quota, err := c.getQuota(organizationID)
+	quota, err := c.getQuota(quotaOrgID)
 	if err != nil {
 		return fmt.Errorf("quota: load: %w", err)
 	}
+	// Starting or resizing an existing deployment is still allowed; new replicas are not
+	if req.ExcludeDeploymentID == "" && req.Replicas > 0 && !quota.NewResourcesAllowed() {
+		return ErrNewResourcesSuspended
+	}
 
 	// Get plan limits first (these are the maximum boundary)
 	planDeployMax, planMem, planCPU := c.getPlanLimitsFromQuota(quota)
@@ -78,7 +90,7 @@ func (c *Checker) CanAllocate(ctx context.Context, organizationID string, req Re
 	}
 
 	// Zero means unlimited; allow if not set
-	curReplicas, curMemBytes, curCPUcores, err := c.currentAllocations(organizationID, req.ExcludeDeploymentID)
+	curReplicas, curMemBytes, curCPUcores, err := c.currentAllocations(orgIDs, req.ExcludeDeploymentID)
 	if err != nil {
 		return fmt.Errorf("quota: current allocations: %w", err)
 	}
@@ -101,6 +113,16 @@ func (c *Checker) CanAllocate(ctx context.Context, organizationID string, req Re
 	return nil
 }
 
+// quotaScope returns the organization whose quota applies to orgID and the organizations whose usage
+// counts against it. On lookup errors the organization is checked on its own.
+func quotaScope(orgID string) (quotaOrgID string, orgIDs []string) {
+	quotaOrgID, orgIDs, err := database.QuotaScope(database.DB, orgID)
+	if err != nil {
+		return orgID, []string{orgID}
+	}
+	return quotaOrgID, orgIDs
+}
+
 func (c *Checker) getQuota(orgID string) (*database.OrgQuota, error) {
 	var quota database.OrgQuota
 	if err := database.DB.Where("organization_id = ?", orgID).First(&quota).Error; err != nil {
@@ -122,13 +144,14 @@ func (c *Checker) getPlanLimitsFromQuota(q *database.OrgQuota) (deploymentsMax i
 	return plan.DeploymentsMax, plan.MemoryBytes, plan.CPUCores
 }
 
-func (c *Checker) currentAllocations(orgID string, excludeDeploymentID string) (replicas int, memBytes int64, cpuCores int, err error) {
+// currentAllocations sums the running replicas, memory and CPU cores of the given organizations' deployments
+func (c *Checker) currentAllocations(orgIDs []string, excludeDeploymentID string) (replicas int, memBytes int64, cpuCores int, err error) {
 	// Count running replicas from deployment locations
 	var count int64
 	locationQuery := database.DB.Model(&database.DeploymentLocation{}).
 		Where("deployment_locations.status = ?", "running").
 		Joins("JOIN deployments d ON d.id = deployment_locations.deployment_id").
-		Where("d.organization_id = ?", orgID)
+		Where("d.organization_id IN ?", orgIDs)
 	if excludeDeploymentID != "" {
 		locationQuery = locationQuery.Where("d.id <> ?", excludeDeploymentID)
 	}
@@ -145,7 +168,7 @@ func (c *Checker) currentAllocations(orgID string, excludeDeploymentID string) (
 	var a agg
 	deploymentQuery := database.DB.Model(&database.Deployment{}).
 		Select("COALESCE(SUM(COALESCE(memory_bytes,0) * COALESCE(replicas,1)),0) as mem, COALESCE(SUM(COALESCE(cpu_shares,0) * COALESCE(replicas,1)),0) as cpu").
-		Where("organization_id = ? AND deleted_at IS NULL AND status IN (2,3,6)", orgID)
+		Where("organization_id IN ? AND deleted_at IS NULL AND status IN (2,3,6)", orgIDs)
 	if excludeDeploymentID != "" {
 		deploymentQuery = deploymentQuery.Where("id <> ?", excludeDeploymentID)
 	}
@@ -162,9 +185,12 @@ func (c *Checker) currentAllocations(orgID string, excludeDeploymentID string) (
 
 // GetEffectiveLimits returns the effective memory and CPU limits for an organization
 // Plan limits are the maximum boundary - org overrides cannot exceed them
+// Managed organizations get the limits of their parent
 // Returns (memoryBytes, cpuCores, error)
 // Zero values mean unlimited
 func GetEffectiveLimits(organizationID string) (memoryBytes int64, cpuCores int, err error) {
+	organizationID, _ = quotaScope(organizationID)
+
 	// Get organization quota
 	var quota database.OrgQuota
 	if err := database.DB.Where("organization_id = ?", organizationID).First(&quota).Error; err != nil {
@@ -217,6 +243,22 @@ func GetEffectiveLimits(organizationID string) (memoryBytes int64, cpuCores int,
 	return effMem, effCPU, nil
 }
 
+// GetMaxReplicas returns the maximum number of replicas a deployment of the organization can run,
+// from its plan. Managed organizations get the limit of their parent. Zero means unlimited.
+func GetMaxReplicas(organizationID string) int {
+	organizationID, _ = quotaScope(organizationID)
+
+	var quota database.OrgQuota
+	if err := database.DB.Where("organization_id = ?", organizationID).First(&quota).Error; err != nil || quota.PlanID == "" {
+		return 0
+	}
+	var plan database.OrganizationPlan
+	if err := database.DB.First(&plan, "id = ?", quota.PlanID).Error; err != nil {
+		return 0
+	}
+	return plan.MaxReplicas
+}
+
 func valueOr(p *int, d int) int {
 	if p == nil {
 		return d
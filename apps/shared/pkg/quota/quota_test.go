@@ -0,0 +1,43 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+func TestCanAllocateRejectsNewResourcesWhileSuspended(t *testing.T) {
+	db := newQuotaTestDB(t)
+
+	allowed := false
+	seed := []any{
+		&database.Organization{ID: "org-a", Name: "Acme", Slug: "acme"},
+		&database.OrgQuota{OrganizationID: "org-a", PlanID: "plan-small", AllowNewResources: &allowed},
+		&database.Organization{ID: "org-b", Name: "Bravo", Slug: "bravo"},
+		&database.OrgQuota{OrganizationID: "org-b", PlanID: "plan-small"},
+	}
+	for _, record := range seed {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	checker := NewChecker()
+	ctx := context.Background()
+	if err := checker.CanAllocate(ctx, "org-a", RequestedResources{Replicas: 1}); !errors.Is(err, ErrNewResourcesSuspended) {
+		t.Fatalf("new deployment while suspended: err = %v, want ErrNewResourcesSuspended", err)
+	}
+	if err := checker.CanAllocateVPS(ctx, "org-a"); !errors.Is(err, ErrNewResourcesSuspended) {
+		t.Fatalf("new VPS while suspended: err = %v, want ErrNewResourcesSuspended", err)
+	}
+	// Existing deployments can still be started
+	if err := checker.CanAllocate(ctx, "org-a", RequestedResources{Replicas: 1, ExcludeDeploymentID: "dep-1"}); err != nil {
+		t.Fatalf("start of existing deployment rejected: %v", err)
+	}
+	// Organizations in good standing are unaffected
+	if err := checker.CanAllocate(ctx, "org-b", RequestedResources{Replicas: 1}); err != nil {
+		t.Fatalf("new deployment for org in good standing rejected: %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/services/organizations"
+)
+
+// Resources a deployment container gets when it has no limits of its own
+const (
+	DefaultDeploymentCPUShares   = int64(1024)              // 1 core
+	DefaultDeploymentMemoryBytes = int64(512 * 1024 * 1024) // 512MB
+)
+
+// ErrResourceExhausted is returned when a resource request does not fit within the organization's plan
+var ErrResourceExhausted = errors.New("plan resource limit reached")
+
+// ValidateResourceRequest verifies that a deployment requesting cpuCores, memoryMB and diskMB fits within
+// the organization's plan on top of its running deployments. excludeDeploymentID is the deployment being
+// updated, whose current allocation is replaced by the request. Errors wrap ErrResourceExhausted and
+// describe the limit that was hit.
+func (c *Checker) ValidateResourceRequest(ctx context.Context, orgID string, cpuCores, memoryMB, diskMB int, excludeDeploymentID string) error {
+	return c.validateResourceRequest(orgID, cpuCores, memoryMB, diskMB, excludeDeploymentID)
+}
+
+func (c *Checker) validateResourceRequest(orgID string, cpuCores, memoryMB, diskMB int, excludeDeploymentID string) error {
+	// Managed organizations share their parent's plan with the parent's other managed organizations
+	quotaOrgID, orgIDs := quotaScope(orgID)
+	_ = organizations.EnsurePlanAssigned(quotaOrgID)
+
+	maxMemoryBytes, maxCPUCores, err := GetEffectiveLimits(quotaOrgID)
+	if err != nil {
+		return fmt.Errorf("quota: limits: %w", err)
+	}
+	maxStorageBytes, err := c.effectiveStorageLimit(quotaOrgID)
+	if err != nil {
+		return fmt.Errorf("quota: storage limit: %w", err)
+	}
+
+	curMemBytes, curCPUCores, err := c.runningResources(orgIDs, excludeDeploymentID)
+	if err != nil {
+		return fmt.Errorf("quota: current allocations: %w", err)
+	}
+
+	if maxCPUCores > 0 && curCPUCores+cpuCores > maxCPUCores {
+		return fmt.Errorf("%w: your plan allows %d CPU cores, running deployments use %d and this deployment needs %d",
+			ErrResourceExhausted, maxCPUCores, curCPUCores, cpuCores)
+	}
+	reqMemBytes := int64(memoryMB) * 1024 * 1024
+	if maxMemoryBytes > 0 && curMemBytes+reqMemBytes > maxMemoryBytes {
+		return fmt.Errorf("%w: your plan allows %d MB of memory, running deployments use %d MB and this deployment needs %d MB",
+			ErrResourceExhausted, maxMemoryBytes/(1024*1024), curMemBytes/(1024*1024), memoryMB)
+	}
+	if maxStorageBytes > 0 && diskMB > 0 {
+		curStorageBytes, err := c.currentStorage(orgIDs, excludeDeploymentID)
+		if err != nil {
+			return fmt.Errorf("quota: current storage: %w", err)
+		}
+		reqStorageBytes := int64(diskMB) * 1024 * 1024
+		if curStorageBytes+reqStorageBytes > maxStorageBytes {
+			return fmt.Errorf("%w: your plan allows %d MB of storage, deployments use %d MB and this deployment needs %d MB",
+				ErrResourceExhausted, maxStorageBytes/(1024*1024), curStorageBytes/(1024*1024), diskMB)
+		}
+	}
+	return nil
+}
+
+// runningResources sums the memory and CPU cores of the organizations' active deployments across replicas
+// Unlike currentAllocations, deployments without limits count with the container defaults they run with.
+func (c *Checker) runningResources(orgIDs []string, excludeDeploymentID string) (memBytes int64, cpuCores int, err error) {
+	var a struct {
+		Mem int64
+		CPU int64
+	}
+	query := database.DB.Model(&database.Deployment{}).
+		Select("COALESCE(SUM(COALESCE(memory_bytes,?) * COALESCE(replicas,1)),0) as mem, COALESCE(SUM(COALESCE(cpu_shares,?) * COALESCE(replicas,1)),0) as cpu",
+													DefaultDeploymentMemoryBytes, DefaultDeploymentCPUShares).
+		Where("organization_id IN ? AND deleted_at IS NULL AND status IN (2,3,6)", orgIDs) // BUILDING, RUNNING, DEPLOYING
+	if excludeDeploymentID != "" {
+		query = query.Where("id <> ?", excludeDeploymentID)
+	}
+	if err = query.Scan(&a).Error; err != nil {
+		return
+	}
+	cpuCores = int((a.CPU + 1023) / 1024) // round up partial cores
+	return a.Mem, cpuCores, nil
+}
+
+// GetEffectiveStorageLimit returns the storage limit of an organization in bytes. Managed organizations
+// get the limit of their parent. Zero means unlimited.
+func GetEffectiveStorageLimit(organizationID string) (int64, error) {
+	quotaOrgID, _ := quotaScope(organizationID)
+	return NewChecker().effectiveStorageLimit(quotaOrgID)
+}
+
+// effectiveStorageLimit returns the organization's storage limit in bytes (0 = unlimited)
+// Like the other limits, an override cannot exceed the plan.
+func (c *Checker) effectiveStorageLimit(orgID string) (int64, error) {
+	quota, err := c.getQuota(orgID)
+	if err != nil {
+		return 0, err
+	}
+	var planStorage int64
+	if quota.PlanID != "" {
+		var plan database.OrganizationPlan
+		if err := database.DB.First(&plan, "id = ?", quota.PlanID).Error; err == nil {
+			planStorage = plan.StorageBytes
+		}
+	}
+	if quota.StorageBytesOverride != nil && *quota.StorageBytesOverride > 0 {
+		if planStorage > 0 && *quota.StorageBytesOverride > planStorage {
+			return planStorage, nil
+		}
+		return *quota.StorageBytesOverride, nil
+	}
+	return planStorage, nil
+}
+
+// currentStorage sums the storage used by the organizations' deployments
+func (c *Checker) currentStorage(orgIDs []string, excludeDeploymentID string) (int64, error) {
+	var total int64
+	query := database.DB.Model(&database.Deployment{}).
+		Select("COALESCE(SUM(storage_bytes),0)").
+		Where("organization_id IN ? AND deleted_at IS NULL", orgIDs)
+	if excludeDeploymentID != "" {
+		query = query.Where("id <> ?", excludeDeploymentID)
+	}
+	if err := query.Scan(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
@@ -0,0 +1,179 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestValidateResourceRequestRejectsDeploymentsBeyondPlan(t *testing.T) {
+	db := newQuotaTestDB(t)
+
+	const maxCores = 3
+	seed := []any{
+		&database.Organization{ID: "org-a", Name: "Acme", Slug: "acme"},
+		&database.OrganizationPlan{ID: "plan-small", Name: "Small", CPUCores: maxCores, MemoryBytes: 8 * 1024 * 1024 * 1024},
+		&database.OrgQuota{OrganizationID: "org-a", PlanID: "plan-small"},
+	}
+	for _, record := range seed {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	checker := NewChecker()
+	for i := 0; i < maxCores; i++ {
+		if err := checker.validateResourceRequest("org-a", 1, 512, 0, ""); err != nil {
+			t.Fatalf("deployment %d within limits rejected: %v", i+1, err)
+		}
+		// Deployments without limits of their own run with one core and 512MB
+		running := database.Deployment{ID: fmt.Sprintf("dep-%d", i), OrganizationID: "org-a", Status: 3}
+		if err := db.Create(&running).Error; err != nil {
+			t.Fatalf("create deployment: %v", err)
+		}
+	}
+
+	err := checker.validateResourceRequest("org-a", 1, 512, 0, "")
+	if !errors.Is(err, ErrResourceExhausted) {
+		t.Fatalf("deployment beyond CPU limit: err = %v, want ErrResourceExhausted", err)
+	}
+
+	// Replacing an existing deployment's allocation does not count it twice
+	if err := checker.validateResourceRequest("org-a", 1, 512, 0, "dep-0"); err != nil {
+		t.Fatalf("update of running deployment rejected: %v", err)
+	}
+
+	now := time.Now()
+	if err := db.Model(&database.Deployment{}).Where("id = ?", "dep-0").Update("deleted_at", &now).Error; err != nil {
+		t.Fatalf("delete deployment: %v", err)
+	}
+	if err := checker.validateResourceRequest("org-a", 1, 512, 0, ""); err != nil {
+		t.Fatalf("deployment after freeing a core rejected: %v", err)
+	}
+}
+
+func TestManagedOrganizationsShareParentQuota(t *testing.T) {
+	db := newQuotaTestDB(t)
+
+	parentID := "org-parent"
+	seed := []any{
+		&database.OrganizationPlan{ID: "plan-small", Name: "Small", CPUCores: 2, MemoryBytes: 8 * 1024 * 1024 * 1024, MaxVpsInstances: 1},
+		&database.Organization{ID: parentID, Name: "Agency", Slug: "agency"},
+		&database.OrgQuota{OrganizationID: parentID, PlanID: "plan-small"},
+		&database.Organization{ID: "org-client-a", Name: "Client A", Slug: "client-a", ParentOrgID: &parentID},
+		&database.Organization{ID: "org-client-b", Name: "Client B", Slug: "client-b", ParentOrgID: &parentID},
+		// A plan of the managed organization's own does not apply
+		&database.OrganizationPlan{ID: "plan-large", Name: "Large", CPUCores: 64, MemoryBytes: 256 * 1024 * 1024 * 1024},
+		&database.OrgQuota{OrganizationID: "org-client-b", PlanID: "plan-large"},
+		&database.Organization{ID: "org-other", Name: "Other", Slug: "other"},
+		&database.OrgQuota{OrganizationID: "org-other", PlanID: "plan-small"},
+	}
+	for _, record := range seed {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	checker := NewChecker()
+	for i, orgID := range []string{parentID, "org-client-a"} {
+		if err := checker.validateResourceRequest(orgID, 1, 512, 0, ""); err != nil {
+			t.Fatalf("deployment of %s within the parent's plan rejected: %v", orgID, err)
+		}
+		if err := db.Create(&database.Deployment{ID: fmt.Sprintf("dep-%d", i), OrganizationID: orgID, Status: 3}).Error; err != nil {
+			t.Fatalf("create deployment: %v", err)
+		}
+	}
+
+	// Both cores of the parent's plan are used by the parent and client A
+	for _, orgID := range []string{parentID, "org-client-a", "org-client-b"} {
+		if err := checker.validateResourceRequest(orgID, 1, 512, 0, ""); !errors.Is(err, ErrResourceExhausted) {
+			t.Fatalf("deployment of %s beyond the parent's plan: err = %v, want ErrResourceExhausted", orgID, err)
+		}
+	}
+	if err := checker.validateResourceRequest("org-other", 1, 512, 0, ""); err != nil {
+		t.Fatalf("deployment of an unrelated organization rejected: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := db.Create(&database.VPSInstance{ID: "vps-1", OrganizationID: "org-client-b", Name: "vps-1"}).Error; err != nil {
+		t.Fatalf("create VPS: %v", err)
+	}
+	if err := checker.CanAllocateVPS(ctx, "org-client-a"); err == nil {
+		t.Fatal("VPS beyond the parent's plan accepted for a managed organization")
+	}
+	current, max, err := checker.GetVPSQuota(ctx, parentID)
+	if err != nil || current != 1 || max != 1 {
+		t.Fatalf("parent VPS quota = %d/%d (%v), want 1/1", current, max, err)
+	}
+}
+
+func TestGetEffectiveStorageLimit(t *testing.T) {
+	db := newQuotaTestDB(t)
+
+	const gb = int64(1024 * 1024 * 1024)
+	parentID := "org-parent"
+	largeOverride := 500 * gb
+	smallOverride := 20 * gb
+	seed := []any{
+		&database.OrganizationPlan{ID: "plan-small", Name: "Small", StorageBytes: 50 * gb},
+		&database.Organization{ID: parentID, Name: "Agency", Slug: "agency"},
+		&database.OrgQuota{OrganizationID: parentID, PlanID: "plan-small", StorageBytesOverride: &largeOverride},
+		&database.Organization{ID: "org-client", Name: "Client", Slug: "client", ParentOrgID: &parentID},
+		&database.Organization{ID: "org-other", Name: "Other", Slug: "other"},
+		&database.OrgQuota{OrganizationID: "org-other", PlanID: "plan-small", StorageBytesOverride: &smallOverride},
+		&database.Organization{ID: "org-free", Name: "Free", Slug: "free"},
+	}
+	for _, record := range seed {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	tests := []struct {
+		orgID string
+		want  int64
+	}{
+		{parentID, 50 * gb},     // Override capped to the plan
+		{"org-client", 50 * gb}, // Managed organizations share the parent's limit
+		{"org-other", 20 * gb},
+		{"org-free", 0}, // No quota: unlimited
+	}
+	for _, tt := range tests {
+		got, err := GetEffectiveStorageLimit(tt.orgID)
+		if err != nil || got != tt.want {
+			t.Errorf("GetEffectiveStorageLimit(%s) = %d (%v), want %d", tt.orgID, got, err, tt.want)
+		}
+	}
+}
+
+func newQuotaTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(
+		&database.Organization{},
+		&database.OrganizationPlan{},
+		&database.OrgQuota{},
+		&database.Deployment{},
+		&database.DeploymentLocation{},
+		&database.VPSInstance{},
+		&database.VPSISO{},
+	); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+
+	previousDB := database.DB
+	database.DB = db
+	t.Cleanup(func() { database.DB = previousDB })
+
+	return db
+}
@@ -0,0 +1,120 @@
+package quota
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrTeamQuotaExceeded is returned when a team has used up one of its team quota limits
+	ErrTeamQuotaExceeded = errors.New("team quota exceeded")
+	// ErrTeamNotFound is returned for teams that do not exist in the organization
+	ErrTeamNotFound = errors.New("team not found")
+)
+
+// TeamUsage is the allocation of the resources tagged with a team
+// Deployments without limits of their own count with the container defaults they run with.
+type TeamUsage struct {
+	Deployments    int
+	GameServers    int
+	VPSInstances   int
+	MemoryBytes    int64
+	CPUCores       int
+	StorageBytes   int64
+	BandwidthBytes int64
+}
+
+// TeamUsage aggregates the resources of the organization that are tagged with the team
+func (c *Checker) TeamUsage(orgID, teamID string) (*TeamUsage, error) {
+	tags := map[string]string{database.ResourceTagKeyTeam: teamID}
+	type agg struct {
+		Count     int64
+		Mem       int64
+		CPU       int64
+		Storage   int64
+		Bandwidth int64
+	}
+
+	var deployments agg
+	query := database.DB.Model(&database.Deployment{}).
+		Select("COUNT(*) as count, COALESCE(SUM(COALESCE(deployments.memory_bytes,?) * COALESCE(deployments.replicas,1)),0) as mem, COALESCE(SUM(COALESCE(deployments.cpu_shares,?) * COALESCE(deployments.replicas,1)),0) as cpu, COALESCE(SUM(deployments.storage_bytes),0) as storage, COALESCE(SUM(deployments.bandwidth_usage),0) as bandwidth",
+			DefaultDeploymentMemoryBytes, DefaultDeploymentCPUShares).
+		Where("deployments.organization_id = ? AND deployments.deleted_at IS NULL", orgID)
+	if err := database.FilterByResourceTags(query, "deployments", database.ResourceTagTypeDeployment, tags).Scan(&deployments).Error; err != nil {
+		return nil, fmt.Errorf("quota: team deployments: %w", err)
+	}
+
+	var gameServers agg
+	query = database.DB.Model(&database.GameServer{}).
+		Select("COUNT(*) as count, COALESCE(SUM(game_servers.memory_bytes),0) as mem, COALESCE(SUM(game_servers.cpu_cores),0) as cpu, COALESCE(SUM(game_servers.storage_bytes),0) as storage, COALESCE(SUM(game_servers.bandwidth_usage),0) as bandwidth").
+		Where("game_servers.organization_id = ? AND game_servers.deleted_at IS NULL", orgID)
+	if err := database.FilterByResourceTags(query, "game_servers", database.ResourceTagTypeGameServer, tags).Scan(&gameServers).Error; err != nil {
+		return nil, fmt.Errorf("quota: team game servers: %w", err)
+	}
+
+	var vpsCount int64
+	query = database.DB.Model(&database.VPSInstance{}).
+		Where("vps_instances.organization_id = ? AND vps_instances.deleted_at IS NULL", orgID)
+	if err := database.FilterByResourceTags(query, "vps_instances", database.ResourceTagTypeVPS, tags).Count(&vpsCount).Error; err != nil {
+		return nil, fmt.Errorf("quota: team VPS instances: %w", err)
+	}
+
+	return &TeamUsage{
+		Deployments:    int(deployments.Count),
+		GameServers:    int(gameServers.Count),
+		VPSInstances:   int(vpsCount),
+		MemoryBytes:    deployments.Mem + gameServers.Mem,
+		CPUCores:       int((deployments.CPU+1023)/1024) + int(gameServers.CPU), // round up partial deployment cores
+		StorageBytes:   deployments.Storage + gameServers.Storage,
+		BandwidthBytes: deployments.Bandwidth + gameServers.Bandwidth,
+	}, nil
+}
+
+// CheckTeamQuota validates that the organization's team can take on another resource: every limit
+// in its team quota must still have room. Teams without a quota are only bound by the organization's quota.
+func (c *Checker) CheckTeamQuota(orgID, teamID string) error {
+	if _, err := database.GetTeam(orgID, teamID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: %s", ErrTeamNotFound, teamID)
+		}
+		return fmt.Errorf("quota: load team: %w", err)
+	}
+
+	var teamQuota database.TeamQuota
+	err := database.DB.Where("team_id = ? AND organization_id = ?", teamID, orgID).Limit(1).Find(&teamQuota).Error
+	if err != nil {
+		return fmt.Errorf("quota: load team quota: %w", err)
+	}
+	if teamQuota.TeamID == "" {
+		return nil
+	}
+
+	usage, err := c.TeamUsage(orgID, teamID)
+	if err != nil {
+		return err
+	}
+
+	// Zero means unlimited
+	if limit := valueOr(teamQuota.DeploymentsMax, 0); limit > 0 && usage.Deployments >= limit {
+		return fmt.Errorf("%w: %d of %d deployments in use", ErrTeamQuotaExceeded, usage.Deployments, limit)
+	}
+	if limit := valueOr(teamQuota.MaxVpsInstances, 0); limit > 0 && usage.VPSInstances >= limit {
+		return fmt.Errorf("%w: %d of %d VPS instances in use", ErrTeamQuotaExceeded, usage.VPSInstances, limit)
+	}
+	if limit := valueOr64(teamQuota.MemoryBytes, 0); limit > 0 && usage.MemoryBytes >= limit {
+		return fmt.Errorf("%w: memory %d of %d bytes in use", ErrTeamQuotaExceeded, usage.MemoryBytes, limit)
+	}
+	if limit := valueOr(teamQuota.CPUCores, 0); limit > 0 && usage.CPUCores >= limit {
+		return fmt.Errorf("%w: %d of %d cpu cores in use", ErrTeamQuotaExceeded, usage.CPUCores, limit)
+	}
+	if limit := valueOr64(teamQuota.StorageBytes, 0); limit > 0 && usage.StorageBytes >= limit {
+		return fmt.Errorf("%w: storage %d of %d bytes in use", ErrTeamQuotaExceeded, usage.StorageBytes, limit)
+	}
+	if limit := valueOr64(teamQuota.BandwidthBytesMonth, 0); limit > 0 && usage.BandwidthBytes >= limit {
+		return fmt.Errorf("%w: bandwidth %d of %d bytes used", ErrTeamQuotaExceeded, usage.BandwidthBytes, limit)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package quota
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+func TestCheckTeamQuotaCountsOnlyTaggedResources(t *testing.T) {
+	db := newQuotaTestDB(t)
+	if err := db.AutoMigrate(&database.GameServer{}, &database.VPSInstance{}, &database.ResourceTag{}, &database.Team{}, &database.TeamQuota{}); err != nil {
+		t.Fatalf("migrate team tables: %v", err)
+	}
+
+	memory := int64(256 * 1024 * 1024)
+	maxDeployments := 2
+	maxMemory := int64(1024 * 1024 * 1024)
+	deletedAt := time.Now()
+	seed := []any{
+		&database.Team{ID: "team-a", OrganizationID: "org-a", Name: "Platform"},
+		&database.Team{ID: "team-b", OrganizationID: "org-a", Name: "Games"},
+		&database.TeamQuota{TeamID: "team-a", OrganizationID: "org-a", DeploymentsMax: &maxDeployments, MemoryBytes: &maxMemory},
+		&database.Deployment{ID: "dep-team", OrganizationID: "org-a", MemoryBytes: &memory},
+		&database.Deployment{ID: "dep-untagged", OrganizationID: "org-a", MemoryBytes: &memory},
+		&database.Deployment{ID: "dep-deleted", OrganizationID: "org-a", MemoryBytes: &memory, DeletedAt: &deletedAt},
+		&database.GameServer{ID: "gs-team", OrganizationID: "org-a", MemoryBytes: memory, CPUCores: 2},
+		&database.ResourceTag{ResourceType: database.ResourceTagTypeDeployment, ResourceID: "dep-team", OrganizationID: "org-a", Key: database.ResourceTagKeyTeam, Value: "team-a"},
+		&database.ResourceTag{ResourceType: database.ResourceTagTypeDeployment, ResourceID: "dep-deleted", OrganizationID: "org-a", Key: database.ResourceTagKeyTeam, Value: "team-a"},
+		&database.ResourceTag{ResourceType: database.ResourceTagTypeGameServer, ResourceID: "gs-team", OrganizationID: "org-a", Key: database.ResourceTagKeyTeam, Value: "team-a"},
+	}
+	for _, record := range seed {
+		if err := db.Create(record).Error; err != nil {
+			t.Fatalf("seed %T: %v", record, err)
+		}
+	}
+
+	checker := NewChecker()
+	usage, err := checker.TeamUsage("org-a", "team-a")
+	if err != nil {
+		t.Fatalf("TeamUsage: %v", err)
+	}
+	if usage.Deployments != 1 || usage.GameServers != 1 || usage.MemoryBytes != 2*memory || usage.CPUCores != 3 {
+		t.Fatalf("usage = %+v, want the tagged deployment and game server only", usage)
+	}
+	if err := checker.CheckTeamQuota("org-a", "team-a"); err != nil {
+		t.Fatalf("team within quota rejected: %v", err)
+	}
+
+	// A second tagged deployment reaches the deployment limit
+	second := &database.ResourceTag{ResourceType: database.ResourceTagTypeDeployment, ResourceID: "dep-untagged", OrganizationID: "org-a", Key: database.ResourceTagKeyTeam, Value: "team-a"}
+	if err := db.Create(second).Error; err != nil {
+		t.Fatalf("tag deployment: %v", err)
+	}
+	if err := checker.CheckTeamQuota("org-a", "team-a"); !errors.Is(err, ErrTeamQuotaExceeded) {
+		t.Fatalf("team at its deployment limit: err = %v, want ErrTeamQuotaExceeded", err)
+	}
+
+	// Teams without a quota are unlimited, and teams cannot be used from other organizations
+	if err := checker.CheckTeamQuota("org-a", "team-b"); err != nil {
+		t.Fatalf("team without quota rejected: %v", err)
+	}
+	if err := checker.CheckTeamQuota("org-b", "team-a"); !errors.Is(err, ErrTeamNotFound) {
+		t.Fatalf("another organization's team: err = %v, want ErrTeamNotFound", err)
+	}
+}
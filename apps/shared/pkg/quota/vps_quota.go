@@ -9,17 +9,23 @@ import (
 )
 
 // CanAllocateVPS validates if the organization can allocate a new VPS instance
+// Managed organizations count against their parent's VPS limit.
 func (c *Checker) CanAllocateVPS(ctx context.Context, organizationID string) error {
+	quotaOrgID, orgIDs := quotaScope(organizationID)
+
 	// Ensure organization has a plan assigned (defaults to Starter plan)
-	_ = organizations.EnsurePlanAssigned(organizationID)
+	_ = organizations.EnsurePlanAssigned(quotaOrgID)
 
-	quota, err := c.getQuota(organizationID)
+	quota, err := c.getQuota(quotaOrgID)
 	if err != nil {
 		return fmt.Errorf("quota: load: %w", err)
 	}
+	if !quota.NewResourcesAllowed() {
+		return ErrNewResourcesSuspended
+	}
 
 	// Get plan limits first (these are the maximum boundary)
-	planVPSMax := c.getPlanVPSMax(organizationID)
+	planVPSMax := c.getPlanVPSMax(quotaOrgID)
 
 	// Get effective limits: use overrides if set, but cap them to plan limits
 	effVPSMax := planVPSMax
@@ -37,7 +43,7 @@ func (c *Checker) CanAllocateVPS(ctx context.Context, organizationID string) err
 	}
 
 	// Zero means unlimited
-	currentVPSCount, err := c.currentVPSCount(organizationID)
+	currentVPSCount, err := c.currentVPSCount(orgIDs)
 	if err != nil {
 		return fmt.Errorf("quota: current VPS count: %w", err)
 	}
@@ -68,11 +74,11 @@ func (c *Checker) getPlanVPSMax(orgID string) int {
 	return plan.MaxVpsInstances
 }
 
-// currentVPSCount counts the number of active VPS instances for an organization
-func (c *Checker) currentVPSCount(orgID string) (int, error) {
+// currentVPSCount counts the number of active VPS instances of the organizations
+func (c *Checker) currentVPSCount(orgIDs []string) (int, error) {
 	var count int64
 	if err := database.DB.Model(&database.VPSInstance{}).
-		Where("organization_id = ? AND deleted_at IS NULL", orgID).
+		Where("organization_id IN ? AND deleted_at IS NULL", orgIDs).
 		Count(&count).Error; err != nil {
 		return 0, fmt.Errorf("failed to count VPS instances: %w", err)
 	}
@@ -80,15 +86,17 @@ func (c *Checker) currentVPSCount(orgID string) (int, error) {
 }
 
 // GetVPSQuota returns the current VPS count and the maximum allowed for an organization
+// For managed organizations both cover the parent and all of its managed organizations.
 func (c *Checker) GetVPSQuota(ctx context.Context, organizationID string) (current int, max int, err error) {
-	_ = organizations.EnsurePlanAssigned(organizationID)
+	quotaOrgID, orgIDs := quotaScope(organizationID)
+	_ = organizations.EnsurePlanAssigned(quotaOrgID)
 
-	quota, err := c.getQuota(organizationID)
+	quota, err := c.getQuota(quotaOrgID)
 	if err != nil {
 		return 0, 0, fmt.Errorf("quota: load: %w", err)
 	}
 
-	planVPSMax := c.getPlanVPSMax(organizationID)
+	planVPSMax := c.getPlanVPSMax(quotaOrgID)
 	effVPSMax := planVPSMax
 	if quota.MaxVpsInstancesOverride != nil {
 		overrideVPSMax := *quota.MaxVpsInstancesOverride
@@ -101,7 +109,7 @@ func (c *Checker) GetVPSQuota(ctx context.Context, organizationID string) (curre
 		}
 	}
 
-	currentCount, err := c.currentVPSCount(organizationID)
+	currentCount, err := c.currentVPSCount(orgIDs)
 	if err != nil {
 		return 0, 0, fmt.Errorf("quota: current VPS count: %w", err)
 	}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/obiente/cloud/apps/shared/pkg/platform"
@@ -21,6 +22,8 @@ import (
 	"github.com/stripe/stripe-go/v83/product"
 	"github.com/stripe/stripe-go/v83/setupintent"
 	"github.com/stripe/stripe-go/v83/subscription"
+	"github.com/stripe/stripe-go/v83/tax/calculation"
+	taxtransaction "github.com/stripe/stripe-go/v83/tax/transaction"
 )
 
 // Client wraps Stripe API client
@@ -65,32 +68,81 @@ func (c *Client) CreateCheckoutSession(ctx context.Context, params *CheckoutSess
 		customerID = custID
 	}
 
+	metadata := map[string]string{
+		"organization_id": params.OrganizationID,
+		"amount_cents":    strconv.FormatInt(params.AmountCents, 10),
+	}
+	currency := strings.ToLower(params.Currency)
+	chargeCents := params.AmountCents
+	if currency == "" || currency == "usd" {
+		currency = "usd"
+	} else {
+		// The amount charged is not the credit amount, so the webhook credits from metadata
+		chargeCents = params.ChargeAmountCents
+		metadata["currency"] = currency
+		metadata["credit_cents"] = strconv.FormatInt(params.AmountCents, 10)
+	}
+	lineItems := []*stripe.CheckoutSessionLineItemParams{
+		{
+			PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
+				Currency: stripe.String(currency),
+				ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
+					Name:        stripe.String("Obiente Cloud Credits"),
+					Description: stripe.String(fmt.Sprintf("Add %s credits to your account", formatAmount(params.AmountCents))),
+				},
+				UnitAmount: stripe.Int64(chargeCents),
+			},
+			Quantity: stripe.Int64(1),
+		},
+	}
+	// Tax calculated with Stripe Tax is charged as its own line item and is not added as credits
+	if params.TaxCalculationID != "" {
+		metadata["tax_calculation_id"] = params.TaxCalculationID
+		metadata["tax_amount_cents"] = strconv.FormatInt(params.TaxAmountCents, 10)
+		if params.TaxAmountCents > 0 {
+			lineItems = append(lineItems, &stripe.CheckoutSessionLineItemParams{
+				PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
+					Currency: stripe.String(currency),
+					ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
+						Name: stripe.String("Tax"),
+					},
+					UnitAmount: stripe.Int64(params.TaxAmountCents),
+				},
+				Quantity: stripe.Int64(1),
+			})
+		}
+	}
+
 	// Create checkout session
 	sessionParams := &stripe.CheckoutSessionParams{
 		Customer: stripe.String(customerID),
 		PaymentMethodTypes: stripe.StringSlice([]string{
 			"card",
 		}),
-		LineItems: []*stripe.CheckoutSessionLineItemParams{
-			{
-				PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
-					Currency: stripe.String("usd"),
-					ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
-						Name:        stripe.String("Obiente Cloud Credits"),
-						Description: stripe.String(fmt.Sprintf("Add %s credits to your account", formatAmount(params.AmountCents))),
-					},
-					UnitAmount: stripe.Int64(params.AmountCents),
-				},
-				Quantity: stripe.Int64(1),
-			},
-		},
+		LineItems:  lineItems,
 		Mode:       stripe.String(string(stripe.CheckoutSessionModePayment)),
 		SuccessURL: stripe.String(successURL),
 		CancelURL:  stripe.String(cancelURL),
-		Metadata: map[string]string{
-			"organization_id": params.OrganizationID,
-			"amount_cents":    strconv.FormatInt(params.AmountCents, 10),
-		},
+		Metadata:   metadata,
+	}
+	if params.TaxCalculationID != "" {
+		// Keep the calculation on the receipt invoice so invoice webhooks can archive it too
+		sessionParams.InvoiceCreation = &stripe.CheckoutSessionInvoiceCreationParams{
+			Enabled: stripe.Bool(true),
+			InvoiceData: &stripe.CheckoutSessionInvoiceCreationInvoiceDataParams{
+				Metadata: map[string]string{"tax_calculation_id": params.TaxCalculationID},
+			},
+		}
+	} else if params.AutomaticTax {
+		// No address on file yet: Checkout collects it and Stripe Tax calculates and records the tax
+		metadata["automatic_tax"] = "true"
+		sessionParams.AutomaticTax = &stripe.CheckoutSessionAutomaticTaxParams{Enabled: stripe.Bool(true)}
+		sessionParams.BillingAddressCollection = stripe.String(string(stripe.CheckoutSessionBillingAddressCollectionRequired))
+		sessionParams.TaxIDCollection = &stripe.CheckoutSessionTaxIDCollectionParams{Enabled: stripe.Bool(true)}
+		sessionParams.CustomerUpdate = &stripe.CheckoutSessionCustomerUpdateParams{
+			Address: stripe.String("auto"),
+			Name:    stripe.String("auto"),
+		}
 	}
 
 	sess, err := session.New(sessionParams)
@@ -319,9 +371,83 @@ type CheckoutSessionParams struct {
 	OrganizationID string
 	CustomerEmail  string
 	CustomerID     string // Optional: existing Stripe customer ID
-	AmountCents    int64
+	AmountCents    int64  // Credits to add, in USD cents
 	SuccessURL     string
 	CancelURL      string
+	// Optional: charge in another currency (lowercase ISO 4217, defaults to "usd"). ChargeAmountCents
+	// is AmountCents converted to it; tax amounts are in this currency too
+	Currency          string
+	ChargeAmountCents int64
+	// Optional: Stripe Tax calculation for the purchase, charged on top of AmountCents
+	TaxCalculationID string
+	TaxAmountCents   int64
+	// Optional: let Checkout calculate tax from the address it collects (ignored with TaxCalculationID)
+	AutomaticTax bool
+}
+
+// TaxCalculationParams contains parameters for a Stripe Tax calculation
+type TaxCalculationParams struct {
+	CustomerID    string                // Used for the customer's address when Address is nil
+	Address       *stripe.AddressParams // Billing address to calculate tax for
+	VATNumber     string                // EU VAT number, sent as an eu_vat tax ID
+	ReverseCharge bool                  // Don't collect tax; the customer self-assesses VAT
+	AmountCents   int64
+	Currency      string
+	Reference     string // Line item reference shown in Stripe tax reports
+}
+
+// CalculateTax calculates the tax owed on a purchase with Stripe Tax
+func (c *Client) CalculateTax(ctx context.Context, params *TaxCalculationParams) (*stripe.TaxCalculation, error) {
+	calcParams := &stripe.TaxCalculationParams{
+		Currency: stripe.String(params.Currency),
+		LineItems: []*stripe.TaxCalculationLineItemParams{
+			{
+				Amount:      stripe.Int64(params.AmountCents),
+				Reference:   stripe.String(params.Reference),
+				TaxBehavior: stripe.String("exclusive"),
+			},
+		},
+	}
+
+	if params.Address != nil {
+		details := &stripe.TaxCalculationCustomerDetailsParams{
+			Address:       params.Address,
+			AddressSource: stripe.String("billing"),
+		}
+		if params.VATNumber != "" {
+			details.TaxIDs = []*stripe.TaxCalculationCustomerDetailsTaxIDParams{
+				{Type: stripe.String("eu_vat"), Value: stripe.String(params.VATNumber)},
+			}
+		}
+		if params.ReverseCharge {
+			details.TaxabilityOverride = stripe.String("reverse_charge")
+		}
+		calcParams.CustomerDetails = details
+	} else if params.CustomerID != "" {
+		calcParams.Customer = stripe.String(params.CustomerID)
+	} else {
+		return nil, errors.New("customer ID or address is required to calculate tax")
+	}
+
+	calc, err := calculation.New(calcParams)
+	if err != nil {
+		return nil, fmt.Errorf("create tax calculation: %w", err)
+	}
+
+	return calc, nil
+}
+
+// CreateTaxTransaction records a tax calculation as a Stripe Tax transaction once the purchase is paid
+func (c *Client) CreateTaxTransaction(ctx context.Context, calculationID, reference string) (*stripe.TaxTransaction, error) {
+	txn, err := taxtransaction.CreateFromCalculation(&stripe.TaxTransactionCreateFromCalculationParams{
+		Calculation: stripe.String(calculationID),
+		Reference:   stripe.String(reference),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create tax transaction: %w", err)
+	}
+
+	return txn, nil
 }
 
 // formatAmount formats cents as a dollar amount string
@@ -474,6 +600,27 @@ func (c *Client) CancelSubscription(ctx context.Context, subscriptionID string)
 	return sub, nil
 }
 
+// CancelActiveSubscriptions cancels every active or trialing subscription of a customer at the end
+// of its billing period and returns the IDs of the canceled subscriptions
+func (c *Client) CancelActiveSubscriptions(ctx context.Context, customerID string) ([]string, error) {
+	subscriptions, err := c.ListSubscriptionsForCustomer(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var canceled []string
+	for _, sub := range subscriptions {
+		if sub.Status != "active" && sub.Status != "trialing" {
+			continue
+		}
+		if _, err := c.CancelSubscription(ctx, sub.ID); err != nil {
+			return canceled, err
+		}
+		canceled = append(canceled, sub.ID)
+	}
+	return canceled, nil
+}
+
 // UpdateSubscriptionPaymentMethod updates the payment method for a subscription
 func (c *Client) UpdateSubscriptionPaymentMethod(ctx context.Context, subscriptionID, paymentMethodID string) (*stripe.Subscription, error) {
 	params := &stripe.SubscriptionParams{
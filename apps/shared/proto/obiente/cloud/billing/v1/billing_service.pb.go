@@ -457,6 +457,7 @@ type UpdateBillingAccountRequest struct {
 	TaxId          *string                `protobuf:"bytes,4,opt,name=tax_id,json=taxId,proto3,oneof" json:"tax_id,omitempty"`
 	Address        *Address               `protobuf:"bytes,5,opt,name=address,proto3,oneof" json:"address,omitempty"`
 	BillingDate    *int32                 `protobuf:"varint,6,opt,name=billing_date,json=billingDate,proto3,oneof" json:"billing_date,omitempty"` // Day of month (1-31) when billing occurs
+	VatNumber      *string                `protobuf:"bytes,7,opt,name=vat_number,json=vatNumber,proto3,oneof" json:"vat_number,omitempty"`        // EU VAT number (empty string clears it)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -533,6 +534,13 @@ func (x *UpdateBillingAccountRequest) GetBillingDate() int32 {
 	return 0
 }
 
+func (x *UpdateBillingAccountRequest) GetVatNumber() string {
+	if x != nil && x.VatNumber != nil {
+		return *x.VatNumber
+	}
+	return ""
+}
+
 type UpdateBillingAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Account       *BillingAccount        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -1465,6 +1473,8 @@ type BillingAccount struct {
 	BillingDate      *int32                 `protobuf:"varint,9,opt,name=billing_date,json=billingDate,proto3,oneof" json:"billing_date,omitempty"` // Day of month (1-31) when billing occurs
 	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	VatNumber        *string                `protobuf:"bytes,12,opt,name=vat_number,json=vatNumber,proto3,oneof" json:"vat_number,omitempty"` // EU VAT number; enables reverse charge for EU business customers
+	Currency         string                 `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`                          // ISO 4217 code of the preferred display currency (e.g. "USD")
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1576,6 +1586,20 @@ func (x *BillingAccount) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *BillingAccount) GetVatNumber() string {
+	if x != nil && x.VatNumber != nil {
+		return *x.VatNumber
+	}
+	return ""
+}
+
+func (x *BillingAccount) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type PaymentMethod struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2844,8 +2868,11 @@ type MonthlyBill struct {
 	Note           *string                `protobuf:"bytes,10,opt,name=note,proto3,oneof" json:"note,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The amount in the organization's preferred currency when the bill was created (amount_cents is USD)
+	DisplayCurrency    string `protobuf:"bytes,13,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	DisplayAmountCents int64  `protobuf:"varint,14,opt,name=display_amount_cents,json=displayAmountCents,proto3" json:"display_amount_cents,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *MonthlyBill) Reset() {
@@ -2962,6 +2989,20 @@ func (x *MonthlyBill) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *MonthlyBill) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+func (x *MonthlyBill) GetDisplayAmountCents() int64 {
+	if x != nil {
+		return x.DisplayAmountCents
+	}
+	return 0
+}
+
 type GenerateCurrentBillRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -3074,236 +3115,2351 @@ func (x *GenerateCurrentBillResponse) GetAlreadyExists() bool {
 	return false
 }
 
-var File_obiente_cloud_billing_v1_billing_service_proto protoreflect.FileDescriptor
+type DownloadInvoiceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Month          string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"` // Invoiced month in YYYY-MM format
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_obiente_cloud_billing_v1_billing_service_proto_rawDesc = "" +
-	"\n" +
-	".obiente/cloud/billing/v1/billing_service.proto\x12\x18obiente.cloud.billing.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd3\x01\n" +
-	"\x1cCreateCheckoutSessionRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
-	"\famount_cents\x18\x02 \x01(\x03R\vamountCents\x12$\n" +
-	"\vsuccess_url\x18\x03 \x01(\tH\x00R\n" +
-	"successUrl\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"cancel_url\x18\x04 \x01(\tH\x01R\tcancelUrl\x88\x01\x01B\x0e\n" +
-	"\f_success_urlB\r\n" +
-	"\v_cancel_url\"a\n" +
-	"\x1dCreateCheckoutSessionResponse\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
-	"\fcheckout_url\x18\x02 \x01(\tR\vcheckoutUrl\"\xaf\x01\n" +
-	"\x1aCreatePaymentIntentRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
-	"\famount_cents\x18\x02 \x01(\x03R\vamountCents\x12/\n" +
-	"\x11payment_method_id\x18\x03 \x01(\tH\x00R\x0fpaymentMethodId\x88\x01\x01B\x14\n" +
-	"\x12_payment_method_id\"n\n" +
-	"\x1bCreatePaymentIntentResponse\x12*\n" +
-	"\x11payment_intent_id\x18\x01 \x01(\tR\x0fpaymentIntentId\x12#\n" +
-	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\"x\n" +
-	"\x1aCreatePortalSessionRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\"\n" +
-	"\n" +
-	"return_url\x18\x02 \x01(\tH\x00R\treturnUrl\x88\x01\x01B\r\n" +
-	"\v_return_url\"<\n" +
-	"\x1bCreatePortalSessionResponse\x12\x1d\n" +
-	"\n" +
-	"portal_url\x18\x01 \x01(\tR\tportalUrl\"C\n" +
-	"\x18GetBillingAccountRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"_\n" +
-	"\x19GetBillingAccountResponse\x12B\n" +
-	"\aaccount\x18\x01 \x01(\v2(.obiente.cloud.billing.v1.BillingAccountR\aaccount\"\xe9\x02\n" +
-	"\x1bUpdateBillingAccountRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12(\n" +
-	"\rbilling_email\x18\x02 \x01(\tH\x00R\fbillingEmail\x88\x01\x01\x12&\n" +
-	"\fcompany_name\x18\x03 \x01(\tH\x01R\vcompanyName\x88\x01\x01\x12\x1a\n" +
-	"\x06tax_id\x18\x04 \x01(\tH\x02R\x05taxId\x88\x01\x01\x12@\n" +
-	"\aaddress\x18\x05 \x01(\v2!.obiente.cloud.billing.v1.AddressH\x03R\aaddress\x88\x01\x01\x12&\n" +
-	"\fbilling_date\x18\x06 \x01(\x05H\x04R\vbillingDate\x88\x01\x01B\x10\n" +
-	"\x0e_billing_emailB\x0f\n" +
-	"\r_company_nameB\t\n" +
-	"\a_tax_idB\n" +
-	"\n" +
-	"\b_addressB\x0f\n" +
-	"\r_billing_date\"b\n" +
-	"\x1cUpdateBillingAccountResponse\x12B\n" +
-	"\aaccount\x18\x01 \x01(\v2(.obiente.cloud.billing.v1.BillingAccountR\aaccount\"D\n" +
-	"\x19ListPaymentMethodsRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"n\n" +
-	"\x1aListPaymentMethodsResponse\x12P\n" +
-	"\x0fpayment_methods\x18\x01 \x03(\v2'.obiente.cloud.billing.v1.PaymentMethodR\x0epaymentMethods\"E\n" +
-	"\x17GetPaymentStatusRequest\x12*\n" +
-	"\x11payment_intent_id\x18\x01 \x01(\tR\x0fpaymentIntentId\"n\n" +
-	"\x18GetPaymentStatusResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\x12(\n" +
-	"\rerror_message\x18\x02 \x01(\tH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
-	"\x0e_error_message\"v\n" +
-	"\x18CreateSetupIntentRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\"\n" +
-	"\n" +
-	"return_url\x18\x02 \x01(\tH\x00R\treturnUrl\x88\x01\x01B\r\n" +
-	"\v_return_url\"h\n" +
-	"\x19CreateSetupIntentResponse\x12#\n" +
-	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret\x12&\n" +
-	"\x0fsetup_intent_id\x18\x02 \x01(\tR\rsetupIntentId\"q\n" +
-	"\x1aAttachPaymentMethodRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12*\n" +
-	"\x11payment_method_id\x18\x02 \x01(\tR\x0fpaymentMethodId\"m\n" +
-	"\x1bAttachPaymentMethodResponse\x12N\n" +
-	"\x0epayment_method\x18\x01 \x01(\v2'.obiente.cloud.billing.v1.PaymentMethodR\rpaymentMethod\"q\n" +
-	"\x1aDetachPaymentMethodRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12*\n" +
-	"\x11payment_method_id\x18\x02 \x01(\tR\x0fpaymentMethodId\"7\n" +
-	"\x1bDetachPaymentMethodResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"u\n" +
-	"\x1eSetDefaultPaymentMethodRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12*\n" +
-	"\x11payment_method_id\x18\x02 \x01(\tR\x0fpaymentMethodId\";\n" +
-	"\x1fSetDefaultPaymentMethodResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"c\n" +
-	"\x13ListInvoicesRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
-	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01B\b\n" +
-	"\x06_limit\"p\n" +
-	"\x14ListInvoicesResponse\x12=\n" +
-	"\binvoices\x18\x01 \x03(\v2!.obiente.cloud.billing.v1.InvoiceR\binvoices\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xb7\x06\n" +
-	"\aInvoice\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06number\x18\x02 \x01(\tR\x06number\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
-	"\n" +
-	"amount_due\x18\x04 \x01(\x03R\tamountDue\x12\x1f\n" +
-	"\vamount_paid\x18\x05 \x01(\x03R\n" +
-	"amountPaid\x12\x1a\n" +
-	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12.\n" +
-	"\x04date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12:\n" +
-	"\bdue_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\adueDate\x88\x01\x01\x12$\n" +
-	"\vinvoice_pdf\x18\t \x01(\tH\x01R\n" +
-	"invoicePdf\x88\x01\x01\x121\n" +
-	"\x12hosted_invoice_url\x18\n" +
-	" \x01(\tH\x02R\x10hostedInvoiceUrl\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\v \x01(\tH\x03R\vdescription\x88\x01\x01\x12\x1f\n" +
-	"\bsubtotal\x18\f \x01(\x03H\x04R\bsubtotal\x88\x01\x01\x12\x19\n" +
-	"\x05total\x18\r \x01(\x03H\x05R\x05total\x88\x01\x01\x12.\n" +
-	"\x10amount_remaining\x18\x0e \x01(\x03H\x06R\x0famountRemaining\x88\x01\x01\x128\n" +
-	"\apaid_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\aR\x06paidAt\x88\x01\x01\x12(\n" +
-	"\rattempt_count\x18\x10 \x01(\x05H\bR\fattemptCount\x88\x01\x01\x120\n" +
-	"\x11collection_method\x18\x11 \x01(\tH\tR\x10collectionMethod\x88\x01\x01B\v\n" +
-	"\t_due_dateB\x0e\n" +
-	"\f_invoice_pdfB\x15\n" +
-	"\x13_hosted_invoice_urlB\x0e\n" +
-	"\f_descriptionB\v\n" +
-	"\t_subtotalB\b\n" +
-	"\x06_totalB\x13\n" +
-	"\x11_amount_remainingB\n" +
-	"\n" +
-	"\b_paid_atB\x10\n" +
-	"\x0e_attempt_countB\x14\n" +
-	"\x12_collection_method\"\xc4\x04\n" +
-	"\x0eBillingAccount\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
-	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x121\n" +
-	"\x12stripe_customer_id\x18\x03 \x01(\tH\x00R\x10stripeCustomerId\x88\x01\x01\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\x12(\n" +
-	"\rbilling_email\x18\x05 \x01(\tH\x01R\fbillingEmail\x88\x01\x01\x12&\n" +
-	"\fcompany_name\x18\x06 \x01(\tH\x02R\vcompanyName\x88\x01\x01\x12\x1a\n" +
-	"\x06tax_id\x18\a \x01(\tH\x03R\x05taxId\x88\x01\x01\x12@\n" +
-	"\aaddress\x18\b \x01(\v2!.obiente.cloud.billing.v1.AddressH\x04R\aaddress\x88\x01\x01\x12&\n" +
-	"\fbilling_date\x18\t \x01(\x05H\x05R\vbillingDate\x88\x01\x01\x129\n" +
-	"\n" +
-	"created_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x15\n" +
-	"\x13_stripe_customer_idB\x10\n" +
-	"\x0e_billing_emailB\x0f\n" +
-	"\r_company_nameB\t\n" +
-	"\a_tax_idB\n" +
-	"\n" +
-	"\b_addressB\x0f\n" +
-	"\r_billing_date\"\xd6\x01\n" +
-	"\rPaymentMethod\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\x12>\n" +
-	"\x04card\x18\x03 \x01(\v2%.obiente.cloud.billing.v1.CardDetailsH\x00R\x04card\x88\x01\x01\x12\x1d\n" +
-	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
-	"\x05_card\"\x93\x01\n" +
-	"\vCardDetails\x12\x14\n" +
-	"\x05brand\x18\x01 \x01(\tR\x05brand\x12\x14\n" +
-	"\x05last4\x18\x02 \x01(\tR\x05last4\x12\x1b\n" +
-	"\texp_month\x18\x03 \x01(\x05R\bexpMonth\x12\x19\n" +
-	"\bexp_year\x18\x04 \x01(\x05R\aexpYear\x12\x17\n" +
-	"\x04name\x18\x05 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
-	"\x05_name\"\xb8\x01\n" +
-	"\aAddress\x12\x14\n" +
-	"\x05line1\x18\x01 \x01(\tR\x05line1\x12\x19\n" +
-	"\x05line2\x18\x02 \x01(\tH\x00R\x05line2\x88\x01\x01\x12\x12\n" +
-	"\x04city\x18\x03 \x01(\tR\x04city\x12\x19\n" +
-	"\x05state\x18\x04 \x01(\tH\x01R\x05state\x88\x01\x01\x12\x1f\n" +
-	"\vpostal_code\x18\x05 \x01(\tR\n" +
-	"postalCode\x12\x18\n" +
-	"\acountry\x18\x06 \x01(\tR\acountryB\b\n" +
-	"\x06_line2B\b\n" +
-	"\x06_state\"\xc2\x01\n" +
-	".CreateDNSDelegationSubscriptionCheckoutRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12$\n" +
-	"\vsuccess_url\x18\x02 \x01(\tH\x00R\n" +
-	"successUrl\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"cancel_url\x18\x03 \x01(\tH\x01R\tcancelUrl\x88\x01\x01B\x0e\n" +
-	"\f_success_urlB\r\n" +
-	"\v_cancel_url\"s\n" +
-	"/CreateDNSDelegationSubscriptionCheckoutResponse\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
-	"\fcheckout_url\x18\x02 \x01(\tR\vcheckoutUrl\"T\n" +
-	")GetDNSDelegationSubscriptionStatusRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\xae\x03\n" +
-	"*GetDNSDelegationSubscriptionStatusResponse\x126\n" +
-	"\x17has_active_subscription\x18\x01 \x01(\bR\x15hasActiveSubscription\x124\n" +
-	"\x16stripe_subscription_id\x18\x02 \x01(\tR\x14stripeSubscriptionId\x12\x1e\n" +
-	"\vhas_api_key\x18\x03 \x01(\bR\thasApiKey\x12G\n" +
-	"\x12api_key_created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fapiKeyCreatedAt\x12/\n" +
-	"\x14cancel_at_period_end\x18\x05 \x01(\bR\x11cancelAtPeriodEnd\x12H\n" +
-	"\x12current_period_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x10currentPeriodEnd\x12.\n" +
-	"\x13api_key_description\x18\a \x01(\tR\x11apiKeyDescription\"Q\n" +
-	"&CancelDNSDelegationSubscriptionRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x9a\x01\n" +
-	"'CancelDNSDelegationSubscriptionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
-	"\vcanceled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"canceledAt\"C\n" +
-	"\x18ListSubscriptionsRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"i\n" +
-	"\x19ListSubscriptionsResponse\x12L\n" +
-	"\rsubscriptions\x18\x01 \x03(\v2&.obiente.cloud.billing.v1.SubscriptionR\rsubscriptions\"\x8b\x04\n" +
-	"\fSubscription\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\x12L\n" +
-	"\x14current_period_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x12currentPeriodStart\x12H\n" +
-	"\x12current_period_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x10currentPeriodEnd\x12;\n" +
-	"\vcanceled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"canceledAt\x12/\n" +
-	"\x14cancel_at_period_end\x18\x06 \x01(\bR\x11cancelAtPeriodEnd\x12\x16\n" +
-	"\x06amount\x18\a \x01(\x03R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1a\n" +
-	"\binterval\x18\t \x01(\tR\binterval\x12%\n" +
-	"\x0einterval_count\x18\n" +
-	" \x01(\x05R\rintervalCount\x12 \n" +
-	"\vdescription\x18\v \x01(\tR\vdescription\x124\n" +
-	"\acreated\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\acreated\"\xa6\x01\n" +
-	"&UpdateSubscriptionPaymentMethodRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
-	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12*\n" +
-	"\x11payment_method_id\x18\x03 \x01(\tR\x0fpaymentMethodId\"\x8f\x01\n" +
-	"'UpdateSubscriptionPaymentMethodResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12J\n" +
-	"\fsubscription\x18\x02 \x01(\v2&.obiente.cloud.billing.v1.SubscriptionR\fsubscription\"m\n" +
-	"\x19CancelSubscriptionRequest\x12'\n" +
+func (x *DownloadInvoiceRequest) Reset() {
+	*x = DownloadInvoiceRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadInvoiceRequest) ProtoMessage() {}
+
+func (x *DownloadInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*DownloadInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DownloadInvoiceRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DownloadInvoiceRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+type DownloadInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`                           // Raw PDF data for this chunk
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`                     // Suggested filename (first message only)
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"` // Total PDF size in bytes (first message only)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadInvoiceResponse) Reset() {
+	*x = DownloadInvoiceResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadInvoiceResponse) ProtoMessage() {}
+
+func (x *DownloadInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*DownloadInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DownloadInvoiceResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *DownloadInvoiceResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DownloadInvoiceResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// DunningState tracks the escalation of a failed invoice payment
+type DunningState struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId              string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	InvoiceId                   string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`           // Stripe invoice that failed
+	AttemptCount                int32                  `protobuf:"varint,3,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"` // Most recent failed payment attempt
+	Stage                       string                 `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`                                    // "warning", "resource_creation_suspended", "resources_suspended" or "marked_for_deletion"
+	WarningSentAt               *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=warning_sent_at,json=warningSentAt,proto3" json:"warning_sent_at,omitempty"`
+	ResourceCreationSuspendedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=resource_creation_suspended_at,json=resourceCreationSuspendedAt,proto3" json:"resource_creation_suspended_at,omitempty"`
+	ResourcesSuspendedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=resources_suspended_at,json=resourcesSuspendedAt,proto3" json:"resources_suspended_at,omitempty"`
+	MarkedForDeletionAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=marked_for_deletion_at,json=markedForDeletionAt,proto3" json:"marked_for_deletion_at,omitempty"`
+	LastFailedAt                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_failed_at,json=lastFailedAt,proto3" json:"last_failed_at,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *DunningState) Reset() {
+	*x = DunningState{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DunningState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DunningState) ProtoMessage() {}
+
+func (x *DunningState) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DunningState.ProtoReflect.Descriptor instead.
+func (*DunningState) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DunningState) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DunningState) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *DunningState) GetAttemptCount() int32 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *DunningState) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *DunningState) GetWarningSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WarningSentAt
+	}
+	return nil
+}
+
+func (x *DunningState) GetResourceCreationSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResourceCreationSuspendedAt
+	}
+	return nil
+}
+
+func (x *DunningState) GetResourcesSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResourcesSuspendedAt
+	}
+	return nil
+}
+
+func (x *DunningState) GetMarkedForDeletionAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MarkedForDeletionAt
+	}
+	return nil
+}
+
+func (x *DunningState) GetLastFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFailedAt
+	}
+	return nil
+}
+
+type GetDunningStateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDunningStateRequest) Reset() {
+	*x = GetDunningStateRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDunningStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDunningStateRequest) ProtoMessage() {}
+
+func (x *GetDunningStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDunningStateRequest.ProtoReflect.Descriptor instead.
+func (*GetDunningStateRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetDunningStateRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetDunningStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *DunningState          `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"` // Unset when the organization has no failed payment in dunning
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDunningStateResponse) Reset() {
+	*x = GetDunningStateResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDunningStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDunningStateResponse) ProtoMessage() {}
+
+func (x *GetDunningStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDunningStateResponse.ProtoReflect.Descriptor instead.
+func (*GetDunningStateResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetDunningStateResponse) GetState() *DunningState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type ResetDunningStateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResetDunningStateRequest) Reset() {
+	*x = ResetDunningStateRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetDunningStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetDunningStateRequest) ProtoMessage() {}
+
+func (x *ResetDunningStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetDunningStateRequest.ProtoReflect.Descriptor instead.
+func (*ResetDunningStateRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ResetDunningStateRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ResetDunningStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetDunningStateResponse) Reset() {
+	*x = ResetDunningStateResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetDunningStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetDunningStateResponse) ProtoMessage() {}
+
+func (x *ResetDunningStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetDunningStateResponse.ProtoReflect.Descriptor instead.
+func (*ResetDunningStateResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ResetDunningStateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResetDunningStateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ReferralCode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"` // 0 = unlimited
+	UsesCount     int32                  `protobuf:"varint,3,opt,name=uses_count,json=usesCount,proto3" json:"uses_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferralCode) Reset() {
+	*x = ReferralCode{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferralCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferralCode) ProtoMessage() {}
+
+func (x *ReferralCode) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferralCode.ProtoReflect.Descriptor instead.
+func (*ReferralCode) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReferralCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReferralCode) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *ReferralCode) GetUsesCount() int32 {
+	if x != nil {
+		return x.UsesCount
+	}
+	return 0
+}
+
+func (x *ReferralCode) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateReferralCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxUses       *int32                 `protobuf:"varint,1,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"` // Maximum number of redemptions (unset or 0 = unlimited)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReferralCodeRequest) Reset() {
+	*x = CreateReferralCodeRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReferralCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReferralCodeRequest) ProtoMessage() {}
+
+func (x *CreateReferralCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReferralCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateReferralCodeRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateReferralCodeRequest) GetMaxUses() int32 {
+	if x != nil && x.MaxUses != nil {
+		return *x.MaxUses
+	}
+	return 0
+}
+
+type CreateReferralCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferralCode  *ReferralCode          `protobuf:"bytes,1,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReferralCodeResponse) Reset() {
+	*x = CreateReferralCodeResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReferralCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReferralCodeResponse) ProtoMessage() {}
+
+func (x *CreateReferralCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReferralCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreateReferralCodeResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateReferralCodeResponse) GetReferralCode() *ReferralCode {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return nil
+}
+
+type GetReferralCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralCodeRequest) Reset() {
+	*x = GetReferralCodeRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralCodeRequest) ProtoMessage() {}
+
+func (x *GetReferralCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetReferralCodeRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{59}
+}
+
+type GetReferralCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferralCode  *ReferralCode          `protobuf:"bytes,1,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralCodeResponse) Reset() {
+	*x = GetReferralCodeResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralCodeResponse) ProtoMessage() {}
+
+func (x *GetReferralCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetReferralCodeResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetReferralCodeResponse) GetReferralCode() *ReferralCode {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return nil
+}
+
+type RedeemReferralCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemReferralCodeRequest) Reset() {
+	*x = RedeemReferralCodeRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemReferralCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemReferralCodeRequest) ProtoMessage() {}
+
+func (x *RedeemReferralCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemReferralCodeRequest.ProtoReflect.Descriptor instead.
+func (*RedeemReferralCodeRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RedeemReferralCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RedeemReferralCodeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization the credits were added to
+	CreditedCents  int64                  `protobuf:"varint,2,opt,name=credited_cents,json=creditedCents,proto3" json:"credited_cents,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RedeemReferralCodeResponse) Reset() {
+	*x = RedeemReferralCodeResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemReferralCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemReferralCodeResponse) ProtoMessage() {}
+
+func (x *RedeemReferralCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemReferralCodeResponse.ProtoReflect.Descriptor instead.
+func (*RedeemReferralCodeResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RedeemReferralCodeResponse) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RedeemReferralCodeResponse) GetCreditedCents() int64 {
+	if x != nil {
+		return x.CreditedCents
+	}
+	return 0
+}
+
+type Currency struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Code              string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // ISO 4217 code, e.g. "EUR"
+	Symbol            string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	ExchangeRateToUsd float64                `protobuf:"fixed64,3,opt,name=exchange_rate_to_usd,json=exchangeRateToUsd,proto3" json:"exchange_rate_to_usd,omitempty"` // Units of this currency per US dollar
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Currency) Reset() {
+	*x = Currency{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Currency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Currency) ProtoMessage() {}
+
+func (x *Currency) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
+func (*Currency) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Currency) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Currency) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Currency) GetExchangeRateToUsd() float64 {
+	if x != nil {
+		return x.ExchangeRateToUsd
+	}
+	return 0
+}
+
+func (x *Currency) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetBalanceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetBalanceRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetBalanceResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	BalanceCents        int64                  `protobuf:"varint,1,opt,name=balance_cents,json=balanceCents,proto3" json:"balance_cents,omitempty"`                        // Credit balance in USD cents
+	Currency            string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`                                                     // Preferred currency of the organization
+	DisplayBalanceCents int64                  `protobuf:"varint,3,opt,name=display_balance_cents,json=displayBalanceCents,proto3" json:"display_balance_cents,omitempty"` // Balance converted to currency, rounded to the nearest cent
+	ExchangeRateToUsd   float64                `protobuf:"fixed64,4,opt,name=exchange_rate_to_usd,json=exchangeRateToUsd,proto3" json:"exchange_rate_to_usd,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetBalanceResponse) GetBalanceCents() int64 {
+	if x != nil {
+		return x.BalanceCents
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetBalanceResponse) GetDisplayBalanceCents() int64 {
+	if x != nil {
+		return x.DisplayBalanceCents
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetExchangeRateToUsd() float64 {
+	if x != nil {
+		return x.ExchangeRateToUsd
+	}
+	return 0
+}
+
+type GetSupportedCurrenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupportedCurrenciesRequest) Reset() {
+	*x = GetSupportedCurrenciesRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedCurrenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedCurrenciesRequest) ProtoMessage() {}
+
+func (x *GetSupportedCurrenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedCurrenciesRequest.ProtoReflect.Descriptor instead.
+func (*GetSupportedCurrenciesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{66}
+}
+
+type GetSupportedCurrenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currencies    []*Currency            `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupportedCurrenciesResponse) Reset() {
+	*x = GetSupportedCurrenciesResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedCurrenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedCurrenciesResponse) ProtoMessage() {}
+
+func (x *GetSupportedCurrenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedCurrenciesResponse.ProtoReflect.Descriptor instead.
+func (*GetSupportedCurrenciesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetSupportedCurrenciesResponse) GetCurrencies() []*Currency {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+type SetPreferredCurrencyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Currency       string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 code returned by GetSupportedCurrencies
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetPreferredCurrencyRequest) Reset() {
+	*x = SetPreferredCurrencyRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPreferredCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPreferredCurrencyRequest) ProtoMessage() {}
+
+func (x *SetPreferredCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPreferredCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*SetPreferredCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetPreferredCurrencyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetPreferredCurrencyRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type SetPreferredCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *BillingAccount        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPreferredCurrencyResponse) Reset() {
+	*x = SetPreferredCurrencyResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPreferredCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPreferredCurrencyResponse) ProtoMessage() {}
+
+func (x *SetPreferredCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPreferredCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*SetPreferredCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetPreferredCurrencyResponse) GetAccount() *BillingAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type SpendAlert struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ThresholdCents int64                  `protobuf:"varint,3,opt,name=threshold_cents,json=thresholdCents,proto3" json:"threshold_cents,omitempty"` // Monthly spend in USD cents that triggers the alert
+	AlertType      string                 `protobuf:"bytes,4,opt,name=alert_type,json=alertType,proto3" json:"alert_type,omitempty"`                 // "email", "suspend_new" (block new resources) or "suspend_all" (suspend the organization)
+	NotifiedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=notified_at,json=notifiedAt,proto3" json:"notified_at,omitempty"`              // When the alert fired this month; unset until it does
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SpendAlert) Reset() {
+	*x = SpendAlert{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendAlert) ProtoMessage() {}
+
+func (x *SpendAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendAlert.ProtoReflect.Descriptor instead.
+func (*SpendAlert) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SpendAlert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SpendAlert) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SpendAlert) GetThresholdCents() int64 {
+	if x != nil {
+		return x.ThresholdCents
+	}
+	return 0
+}
+
+func (x *SpendAlert) GetAlertType() string {
+	if x != nil {
+		return x.AlertType
+	}
+	return ""
+}
+
+func (x *SpendAlert) GetNotifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotifiedAt
+	}
+	return nil
+}
+
+func (x *SpendAlert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateSpendAlertRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ThresholdCents int64                  `protobuf:"varint,2,opt,name=threshold_cents,json=thresholdCents,proto3" json:"threshold_cents,omitempty"` // Must be positive
+	AlertType      string                 `protobuf:"bytes,3,opt,name=alert_type,json=alertType,proto3" json:"alert_type,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateSpendAlertRequest) Reset() {
+	*x = CreateSpendAlertRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSpendAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSpendAlertRequest) ProtoMessage() {}
+
+func (x *CreateSpendAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSpendAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreateSpendAlertRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateSpendAlertRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateSpendAlertRequest) GetThresholdCents() int64 {
+	if x != nil {
+		return x.ThresholdCents
+	}
+	return 0
+}
+
+func (x *CreateSpendAlertRequest) GetAlertType() string {
+	if x != nil {
+		return x.AlertType
+	}
+	return ""
+}
+
+type CreateSpendAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *SpendAlert            `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSpendAlertResponse) Reset() {
+	*x = CreateSpendAlertResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSpendAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSpendAlertResponse) ProtoMessage() {}
+
+func (x *CreateSpendAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSpendAlertResponse.ProtoReflect.Descriptor instead.
+func (*CreateSpendAlertResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateSpendAlertResponse) GetAlert() *SpendAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+type DeleteSpendAlertRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AlertId        string                 `protobuf:"bytes,2,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteSpendAlertRequest) Reset() {
+	*x = DeleteSpendAlertRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSpendAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpendAlertRequest) ProtoMessage() {}
+
+func (x *DeleteSpendAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpendAlertRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSpendAlertRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteSpendAlertRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteSpendAlertRequest) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+type DeleteSpendAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSpendAlertResponse) Reset() {
+	*x = DeleteSpendAlertResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSpendAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpendAlertResponse) ProtoMessage() {}
+
+func (x *DeleteSpendAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpendAlertResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSpendAlertResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteSpendAlertResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListSpendAlertsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSpendAlertsRequest) Reset() {
+	*x = ListSpendAlertsRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpendAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpendAlertsRequest) ProtoMessage() {}
+
+func (x *ListSpendAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpendAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListSpendAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListSpendAlertsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListSpendAlertsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Alerts          []*SpendAlert          `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	MonthSpendCents int64                  `protobuf:"varint,2,opt,name=month_spend_cents,json=monthSpendCents,proto3" json:"month_spend_cents,omitempty"` // Metered usage cost since the start of the month (UTC)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListSpendAlertsResponse) Reset() {
+	*x = ListSpendAlertsResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpendAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpendAlertsResponse) ProtoMessage() {}
+
+func (x *ListSpendAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpendAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListSpendAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListSpendAlertsResponse) GetAlerts() []*SpendAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *ListSpendAlertsResponse) GetMonthSpendCents() int64 {
+	if x != nil {
+		return x.MonthSpendCents
+	}
+	return 0
+}
+
+// SpendForecast projects an organization's metered spend from a linear trend over its recent daily
+// spend, adjusted for day-of-week patterns
+type SpendForecast struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PeriodDays       int32                  `protobuf:"varint,2,opt,name=period_days,json=periodDays,proto3" json:"period_days,omitempty"`                        // Days projected, starting today (UTC)
+	ProjectedCents   int64                  `protobuf:"varint,3,opt,name=projected_cents,json=projectedCents,proto3" json:"projected_cents,omitempty"`            // Projected metered spend over the period in USD cents
+	LowerCents       int64                  `protobuf:"varint,4,opt,name=lower_cents,json=lowerCents,proto3" json:"lower_cents,omitempty"`                        // Lower bound of the 95% confidence interval
+	UpperCents       int64                  `protobuf:"varint,5,opt,name=upper_cents,json=upperCents,proto3" json:"upper_cents,omitempty"`                        // Upper bound of the 95% confidence interval
+	TrendCentsPerDay float64                `protobuf:"fixed64,6,opt,name=trend_cents_per_day,json=trendCentsPerDay,proto3" json:"trend_cents_per_day,omitempty"` // Daily change of the spend trend
+	HistoryDays      int32                  `protobuf:"varint,7,opt,name=history_days,json=historyDays,proto3" json:"history_days,omitempty"`                     // Days of spend history the forecast is based on (at most 60)
+	GeneratedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`                      // Forecasts are cached for 6 hours
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SpendForecast) Reset() {
+	*x = SpendForecast{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendForecast) ProtoMessage() {}
+
+func (x *SpendForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendForecast.ProtoReflect.Descriptor instead.
+func (*SpendForecast) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SpendForecast) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SpendForecast) GetPeriodDays() int32 {
+	if x != nil {
+		return x.PeriodDays
+	}
+	return 0
+}
+
+func (x *SpendForecast) GetProjectedCents() int64 {
+	if x != nil {
+		return x.ProjectedCents
+	}
+	return 0
+}
+
+func (x *SpendForecast) GetLowerCents() int64 {
+	if x != nil {
+		return x.LowerCents
+	}
+	return 0
+}
+
+func (x *SpendForecast) GetUpperCents() int64 {
+	if x != nil {
+		return x.UpperCents
+	}
+	return 0
+}
+
+func (x *SpendForecast) GetTrendCentsPerDay() float64 {
+	if x != nil {
+		return x.TrendCentsPerDay
+	}
+	return 0
+}
+
+func (x *SpendForecast) GetHistoryDays() int32 {
+	if x != nil {
+		return x.HistoryDays
+	}
+	return 0
+}
+
+func (x *SpendForecast) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+type GetSpendForecastRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PeriodDays     int32                  `protobuf:"varint,2,opt,name=period_days,json=periodDays,proto3" json:"period_days,omitempty"` // Defaults to 30, at most 365
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSpendForecastRequest) Reset() {
+	*x = GetSpendForecastRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendForecastRequest) ProtoMessage() {}
+
+func (x *GetSpendForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendForecastRequest.ProtoReflect.Descriptor instead.
+func (*GetSpendForecastRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetSpendForecastRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetSpendForecastRequest) GetPeriodDays() int32 {
+	if x != nil {
+		return x.PeriodDays
+	}
+	return 0
+}
+
+type GetSpendForecastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Forecast      *SpendForecast         `protobuf:"bytes,1,opt,name=forecast,proto3" json:"forecast,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpendForecastResponse) Reset() {
+	*x = GetSpendForecastResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendForecastResponse) ProtoMessage() {}
+
+func (x *GetSpendForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendForecastResponse.ProtoReflect.Descriptor instead.
+func (*GetSpendForecastResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetSpendForecastResponse) GetForecast() *SpendForecast {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
+// TaxExemptCertificate exempts a non-profit, educational or government organization from tax once verified
+type TaxExemptCertificate struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId    string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	CertificateType   string                 `protobuf:"bytes,3,opt,name=certificate_type,json=certificateType,proto3" json:"certificate_type,omitempty"` // "nonprofit", "edu" or "gov"
+	CertificateNumber string                 `protobuf:"bytes,4,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
+	IssuingAuthority  string                 `protobuf:"bytes,5,opt,name=issuing_authority,json=issuingAuthority,proto3" json:"issuing_authority,omitempty"`
+	ValidFrom         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidUntil        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	Status            string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // "pending", "verified", "revoked" or "expired"
+	VerifiedBy        *string                `protobuf:"bytes,9,opt,name=verified_by,json=verifiedBy,proto3,oneof" json:"verified_by,omitempty"`
+	VerifiedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	RevokedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TaxExemptCertificate) Reset() {
+	*x = TaxExemptCertificate{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxExemptCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxExemptCertificate) ProtoMessage() {}
+
+func (x *TaxExemptCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxExemptCertificate.ProtoReflect.Descriptor instead.
+func (*TaxExemptCertificate) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *TaxExemptCertificate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaxExemptCertificate) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *TaxExemptCertificate) GetCertificateType() string {
+	if x != nil {
+		return x.CertificateType
+	}
+	return ""
+}
+
+func (x *TaxExemptCertificate) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
+func (x *TaxExemptCertificate) GetIssuingAuthority() string {
+	if x != nil {
+		return x.IssuingAuthority
+	}
+	return ""
+}
+
+func (x *TaxExemptCertificate) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *TaxExemptCertificate) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *TaxExemptCertificate) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TaxExemptCertificate) GetVerifiedBy() string {
+	if x != nil && x.VerifiedBy != nil {
+		return *x.VerifiedBy
+	}
+	return ""
+}
+
+func (x *TaxExemptCertificate) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+func (x *TaxExemptCertificate) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *TaxExemptCertificate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SubmitTaxExemptCertificateRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId    string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	CertificateType   string                 `protobuf:"bytes,2,opt,name=certificate_type,json=certificateType,proto3" json:"certificate_type,omitempty"`
+	CertificateNumber string                 `protobuf:"bytes,3,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
+	IssuingAuthority  string                 `protobuf:"bytes,4,opt,name=issuing_authority,json=issuingAuthority,proto3" json:"issuing_authority,omitempty"`
+	ValidFrom         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidUntil        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"` // Must be after valid_from and in the future
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubmitTaxExemptCertificateRequest) Reset() {
+	*x = SubmitTaxExemptCertificateRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTaxExemptCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTaxExemptCertificateRequest) ProtoMessage() {}
+
+func (x *SubmitTaxExemptCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTaxExemptCertificateRequest.ProtoReflect.Descriptor instead.
+func (*SubmitTaxExemptCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SubmitTaxExemptCertificateRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SubmitTaxExemptCertificateRequest) GetCertificateType() string {
+	if x != nil {
+		return x.CertificateType
+	}
+	return ""
+}
+
+func (x *SubmitTaxExemptCertificateRequest) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
+func (x *SubmitTaxExemptCertificateRequest) GetIssuingAuthority() string {
+	if x != nil {
+		return x.IssuingAuthority
+	}
+	return ""
+}
+
+func (x *SubmitTaxExemptCertificateRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *SubmitTaxExemptCertificateRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+type SubmitTaxExemptCertificateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificate   *TaxExemptCertificate  `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTaxExemptCertificateResponse) Reset() {
+	*x = SubmitTaxExemptCertificateResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTaxExemptCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTaxExemptCertificateResponse) ProtoMessage() {}
+
+func (x *SubmitTaxExemptCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTaxExemptCertificateResponse.ProtoReflect.Descriptor instead.
+func (*SubmitTaxExemptCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SubmitTaxExemptCertificateResponse) GetCertificate() *TaxExemptCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type VerifyTaxExemptCertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CertificateId string                 `protobuf:"bytes,1,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTaxExemptCertificateRequest) Reset() {
+	*x = VerifyTaxExemptCertificateRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTaxExemptCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTaxExemptCertificateRequest) ProtoMessage() {}
+
+func (x *VerifyTaxExemptCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTaxExemptCertificateRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTaxExemptCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *VerifyTaxExemptCertificateRequest) GetCertificateId() string {
+	if x != nil {
+		return x.CertificateId
+	}
+	return ""
+}
+
+type VerifyTaxExemptCertificateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificate   *TaxExemptCertificate  `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTaxExemptCertificateResponse) Reset() {
+	*x = VerifyTaxExemptCertificateResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTaxExemptCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTaxExemptCertificateResponse) ProtoMessage() {}
+
+func (x *VerifyTaxExemptCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTaxExemptCertificateResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTaxExemptCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *VerifyTaxExemptCertificateResponse) GetCertificate() *TaxExemptCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type RevokeTaxExemptCertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CertificateId string                 `protobuf:"bytes,1,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTaxExemptCertificateRequest) Reset() {
+	*x = RevokeTaxExemptCertificateRequest{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTaxExemptCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTaxExemptCertificateRequest) ProtoMessage() {}
+
+func (x *RevokeTaxExemptCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTaxExemptCertificateRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTaxExemptCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RevokeTaxExemptCertificateRequest) GetCertificateId() string {
+	if x != nil {
+		return x.CertificateId
+	}
+	return ""
+}
+
+type RevokeTaxExemptCertificateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificate   *TaxExemptCertificate  `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTaxExemptCertificateResponse) Reset() {
+	*x = RevokeTaxExemptCertificateResponse{}
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTaxExemptCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTaxExemptCertificateResponse) ProtoMessage() {}
+
+func (x *RevokeTaxExemptCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTaxExemptCertificateResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTaxExemptCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RevokeTaxExemptCertificateResponse) GetCertificate() *TaxExemptCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+var File_obiente_cloud_billing_v1_billing_service_proto protoreflect.FileDescriptor
+
+const file_obiente_cloud_billing_v1_billing_service_proto_rawDesc = "" +
+	"\n" +
+	".obiente/cloud/billing/v1/billing_service.proto\x12\x18obiente.cloud.billing.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd3\x01\n" +
+	"\x1cCreateCheckoutSessionRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
+	"\famount_cents\x18\x02 \x01(\x03R\vamountCents\x12$\n" +
+	"\vsuccess_url\x18\x03 \x01(\tH\x00R\n" +
+	"successUrl\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"cancel_url\x18\x04 \x01(\tH\x01R\tcancelUrl\x88\x01\x01B\x0e\n" +
+	"\f_success_urlB\r\n" +
+	"\v_cancel_url\"a\n" +
+	"\x1dCreateCheckoutSessionResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
+	"\fcheckout_url\x18\x02 \x01(\tR\vcheckoutUrl\"\xaf\x01\n" +
+	"\x1aCreatePaymentIntentRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
+	"\famount_cents\x18\x02 \x01(\x03R\vamountCents\x12/\n" +
+	"\x11payment_method_id\x18\x03 \x01(\tH\x00R\x0fpaymentMethodId\x88\x01\x01B\x14\n" +
+	"\x12_payment_method_id\"n\n" +
+	"\x1bCreatePaymentIntentResponse\x12*\n" +
+	"\x11payment_intent_id\x18\x01 \x01(\tR\x0fpaymentIntentId\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\"x\n" +
+	"\x1aCreatePortalSessionRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\"\n" +
+	"\n" +
+	"return_url\x18\x02 \x01(\tH\x00R\treturnUrl\x88\x01\x01B\r\n" +
+	"\v_return_url\"<\n" +
+	"\x1bCreatePortalSessionResponse\x12\x1d\n" +
+	"\n" +
+	"portal_url\x18\x01 \x01(\tR\tportalUrl\"C\n" +
+	"\x18GetBillingAccountRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"_\n" +
+	"\x19GetBillingAccountResponse\x12B\n" +
+	"\aaccount\x18\x01 \x01(\v2(.obiente.cloud.billing.v1.BillingAccountR\aaccount\"\x9c\x03\n" +
+	"\x1bUpdateBillingAccountRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12(\n" +
+	"\rbilling_email\x18\x02 \x01(\tH\x00R\fbillingEmail\x88\x01\x01\x12&\n" +
+	"\fcompany_name\x18\x03 \x01(\tH\x01R\vcompanyName\x88\x01\x01\x12\x1a\n" +
+	"\x06tax_id\x18\x04 \x01(\tH\x02R\x05taxId\x88\x01\x01\x12@\n" +
+	"\aaddress\x18\x05 \x01(\v2!.obiente.cloud.billing.v1.AddressH\x03R\aaddress\x88\x01\x01\x12&\n" +
+	"\fbilling_date\x18\x06 \x01(\x05H\x04R\vbillingDate\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"vat_number\x18\a \x01(\tH\x05R\tvatNumber\x88\x01\x01B\x10\n" +
+	"\x0e_billing_emailB\x0f\n" +
+	"\r_company_nameB\t\n" +
+	"\a_tax_idB\n" +
+	"\n" +
+	"\b_addressB\x0f\n" +
+	"\r_billing_dateB\r\n" +
+	"\v_vat_number\"b\n" +
+	"\x1cUpdateBillingAccountResponse\x12B\n" +
+	"\aaccount\x18\x01 \x01(\v2(.obiente.cloud.billing.v1.BillingAccountR\aaccount\"D\n" +
+	"\x19ListPaymentMethodsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"n\n" +
+	"\x1aListPaymentMethodsResponse\x12P\n" +
+	"\x0fpayment_methods\x18\x01 \x03(\v2'.obiente.cloud.billing.v1.PaymentMethodR\x0epaymentMethods\"E\n" +
+	"\x17GetPaymentStatusRequest\x12*\n" +
+	"\x11payment_intent_id\x18\x01 \x01(\tR\x0fpaymentIntentId\"n\n" +
+	"\x18GetPaymentStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12(\n" +
+	"\rerror_message\x18\x02 \x01(\tH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"v\n" +
+	"\x18CreateSetupIntentRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\"\n" +
+	"\n" +
+	"return_url\x18\x02 \x01(\tH\x00R\treturnUrl\x88\x01\x01B\r\n" +
+	"\v_return_url\"h\n" +
+	"\x19CreateSetupIntentResponse\x12#\n" +
+	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret\x12&\n" +
+	"\x0fsetup_intent_id\x18\x02 \x01(\tR\rsetupIntentId\"q\n" +
+	"\x1aAttachPaymentMethodRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12*\n" +
+	"\x11payment_method_id\x18\x02 \x01(\tR\x0fpaymentMethodId\"m\n" +
+	"\x1bAttachPaymentMethodResponse\x12N\n" +
+	"\x0epayment_method\x18\x01 \x01(\v2'.obiente.cloud.billing.v1.PaymentMethodR\rpaymentMethod\"q\n" +
+	"\x1aDetachPaymentMethodRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12*\n" +
+	"\x11payment_method_id\x18\x02 \x01(\tR\x0fpaymentMethodId\"7\n" +
+	"\x1bDetachPaymentMethodResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"u\n" +
+	"\x1eSetDefaultPaymentMethodRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12*\n" +
+	"\x11payment_method_id\x18\x02 \x01(\tR\x0fpaymentMethodId\";\n" +
+	"\x1fSetDefaultPaymentMethodResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"c\n" +
+	"\x13ListInvoicesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"p\n" +
+	"\x14ListInvoicesResponse\x12=\n" +
+	"\binvoices\x18\x01 \x03(\v2!.obiente.cloud.billing.v1.InvoiceR\binvoices\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xb7\x06\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"amount_due\x18\x04 \x01(\x03R\tamountDue\x12\x1f\n" +
+	"\vamount_paid\x18\x05 \x01(\x03R\n" +
+	"amountPaid\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12.\n" +
+	"\x04date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12:\n" +
+	"\bdue_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\adueDate\x88\x01\x01\x12$\n" +
+	"\vinvoice_pdf\x18\t \x01(\tH\x01R\n" +
+	"invoicePdf\x88\x01\x01\x121\n" +
+	"\x12hosted_invoice_url\x18\n" +
+	" \x01(\tH\x02R\x10hostedInvoiceUrl\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\v \x01(\tH\x03R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bsubtotal\x18\f \x01(\x03H\x04R\bsubtotal\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\r \x01(\x03H\x05R\x05total\x88\x01\x01\x12.\n" +
+	"\x10amount_remaining\x18\x0e \x01(\x03H\x06R\x0famountRemaining\x88\x01\x01\x128\n" +
+	"\apaid_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\aR\x06paidAt\x88\x01\x01\x12(\n" +
+	"\rattempt_count\x18\x10 \x01(\x05H\bR\fattemptCount\x88\x01\x01\x120\n" +
+	"\x11collection_method\x18\x11 \x01(\tH\tR\x10collectionMethod\x88\x01\x01B\v\n" +
+	"\t_due_dateB\x0e\n" +
+	"\f_invoice_pdfB\x15\n" +
+	"\x13_hosted_invoice_urlB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_subtotalB\b\n" +
+	"\x06_totalB\x13\n" +
+	"\x11_amount_remainingB\n" +
+	"\n" +
+	"\b_paid_atB\x10\n" +
+	"\x0e_attempt_countB\x14\n" +
+	"\x12_collection_method\"\x93\x05\n" +
+	"\x0eBillingAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x121\n" +
+	"\x12stripe_customer_id\x18\x03 \x01(\tH\x00R\x10stripeCustomerId\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12(\n" +
+	"\rbilling_email\x18\x05 \x01(\tH\x01R\fbillingEmail\x88\x01\x01\x12&\n" +
+	"\fcompany_name\x18\x06 \x01(\tH\x02R\vcompanyName\x88\x01\x01\x12\x1a\n" +
+	"\x06tax_id\x18\a \x01(\tH\x03R\x05taxId\x88\x01\x01\x12@\n" +
+	"\aaddress\x18\b \x01(\v2!.obiente.cloud.billing.v1.AddressH\x04R\aaddress\x88\x01\x01\x12&\n" +
+	"\fbilling_date\x18\t \x01(\x05H\x05R\vbillingDate\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\"\n" +
+	"\n" +
+	"vat_number\x18\f \x01(\tH\x06R\tvatNumber\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\r \x01(\tR\bcurrencyB\x15\n" +
+	"\x13_stripe_customer_idB\x10\n" +
+	"\x0e_billing_emailB\x0f\n" +
+	"\r_company_nameB\t\n" +
+	"\a_tax_idB\n" +
+	"\n" +
+	"\b_addressB\x0f\n" +
+	"\r_billing_dateB\r\n" +
+	"\v_vat_number\"\xd6\x01\n" +
+	"\rPaymentMethod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12>\n" +
+	"\x04card\x18\x03 \x01(\v2%.obiente.cloud.billing.v1.CardDetailsH\x00R\x04card\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bR\tisDefault\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
+	"\x05_card\"\x93\x01\n" +
+	"\vCardDetails\x12\x14\n" +
+	"\x05brand\x18\x01 \x01(\tR\x05brand\x12\x14\n" +
+	"\x05last4\x18\x02 \x01(\tR\x05last4\x12\x1b\n" +
+	"\texp_month\x18\x03 \x01(\x05R\bexpMonth\x12\x19\n" +
+	"\bexp_year\x18\x04 \x01(\x05R\aexpYear\x12\x17\n" +
+	"\x04name\x18\x05 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"\xb8\x01\n" +
+	"\aAddress\x12\x14\n" +
+	"\x05line1\x18\x01 \x01(\tR\x05line1\x12\x19\n" +
+	"\x05line2\x18\x02 \x01(\tH\x00R\x05line2\x88\x01\x01\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x19\n" +
+	"\x05state\x18\x04 \x01(\tH\x01R\x05state\x88\x01\x01\x12\x1f\n" +
+	"\vpostal_code\x18\x05 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountryB\b\n" +
+	"\x06_line2B\b\n" +
+	"\x06_state\"\xc2\x01\n" +
+	".CreateDNSDelegationSubscriptionCheckoutRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12$\n" +
+	"\vsuccess_url\x18\x02 \x01(\tH\x00R\n" +
+	"successUrl\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"cancel_url\x18\x03 \x01(\tH\x01R\tcancelUrl\x88\x01\x01B\x0e\n" +
+	"\f_success_urlB\r\n" +
+	"\v_cancel_url\"s\n" +
+	"/CreateDNSDelegationSubscriptionCheckoutResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
+	"\fcheckout_url\x18\x02 \x01(\tR\vcheckoutUrl\"T\n" +
+	")GetDNSDelegationSubscriptionStatusRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\xae\x03\n" +
+	"*GetDNSDelegationSubscriptionStatusResponse\x126\n" +
+	"\x17has_active_subscription\x18\x01 \x01(\bR\x15hasActiveSubscription\x124\n" +
+	"\x16stripe_subscription_id\x18\x02 \x01(\tR\x14stripeSubscriptionId\x12\x1e\n" +
+	"\vhas_api_key\x18\x03 \x01(\bR\thasApiKey\x12G\n" +
+	"\x12api_key_created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fapiKeyCreatedAt\x12/\n" +
+	"\x14cancel_at_period_end\x18\x05 \x01(\bR\x11cancelAtPeriodEnd\x12H\n" +
+	"\x12current_period_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x10currentPeriodEnd\x12.\n" +
+	"\x13api_key_description\x18\a \x01(\tR\x11apiKeyDescription\"Q\n" +
+	"&CancelDNSDelegationSubscriptionRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x9a\x01\n" +
+	"'CancelDNSDelegationSubscriptionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\vcanceled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"canceledAt\"C\n" +
+	"\x18ListSubscriptionsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"i\n" +
+	"\x19ListSubscriptionsResponse\x12L\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2&.obiente.cloud.billing.v1.SubscriptionR\rsubscriptions\"\x8b\x04\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12L\n" +
+	"\x14current_period_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x12currentPeriodStart\x12H\n" +
+	"\x12current_period_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x10currentPeriodEnd\x12;\n" +
+	"\vcanceled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"canceledAt\x12/\n" +
+	"\x14cancel_at_period_end\x18\x06 \x01(\bR\x11cancelAtPeriodEnd\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1a\n" +
+	"\binterval\x18\t \x01(\tR\binterval\x12%\n" +
+	"\x0einterval_count\x18\n" +
+	" \x01(\x05R\rintervalCount\x12 \n" +
+	"\vdescription\x18\v \x01(\tR\vdescription\x124\n" +
+	"\acreated\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\acreated\"\xa6\x01\n" +
+	"&UpdateSubscriptionPaymentMethodRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12*\n" +
+	"\x11payment_method_id\x18\x03 \x01(\tR\x0fpaymentMethodId\"\x8f\x01\n" +
+	"'UpdateSubscriptionPaymentMethodResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12J\n" +
+	"\fsubscription\x18\x02 \x01(\v2&.obiente.cloud.billing.v1.SubscriptionR\fsubscription\"m\n" +
+	"\x19CancelSubscriptionRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
 	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\"\x9c\x01\n" +
 	"\x1aCancelSubscriptionResponse\x12\x18\n" +
@@ -3323,7 +5479,7 @@ const file_obiente_cloud_billing_v1_billing_service_proto_rawDesc = "" +
 	"\x06_limit\"k\n" +
 	"\x11ListBillsResponse\x12;\n" +
 	"\x05bills\x18\x01 \x03(\v2%.obiente.cloud.billing.v1.MonthlyBillR\x05bills\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xf0\x04\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xcd\x05\n" +
 	"\vMonthlyBill\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12L\n" +
@@ -3339,7 +5495,9 @@ const file_obiente_cloud_billing_v1_billing_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12)\n" +
+	"\x10display_currency\x18\r \x01(\tR\x0fdisplayCurrency\x120\n" +
+	"\x14display_amount_cents\x18\x0e \x01(\x03R\x12displayAmountCentsB\n" +
 	"\n" +
 	"\b_paid_atB\x12\n" +
 	"\x10_usage_breakdownB\a\n" +
@@ -3350,7 +5508,164 @@ const file_obiente_cloud_billing_v1_billing_service_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
 	"\x04bill\x18\x03 \x01(\v2%.obiente.cloud.billing.v1.MonthlyBillR\x04bill\x12%\n" +
-	"\x0ealready_exists\x18\x04 \x01(\bR\ralreadyExists2\xbe\x16\n" +
+	"\x0ealready_exists\x18\x04 \x01(\bR\ralreadyExists\"W\n" +
+	"\x16DownloadInvoiceRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\tR\x05month\"j\n" +
+	"\x17DownloadInvoiceResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"\x9b\x04\n" +
+	"\fDunningState\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\tR\tinvoiceId\x12#\n" +
+	"\rattempt_count\x18\x03 \x01(\x05R\fattemptCount\x12\x14\n" +
+	"\x05stage\x18\x04 \x01(\tR\x05stage\x12B\n" +
+	"\x0fwarning_sent_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rwarningSentAt\x12_\n" +
+	"\x1eresource_creation_suspended_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x1bresourceCreationSuspendedAt\x12P\n" +
+	"\x16resources_suspended_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x14resourcesSuspendedAt\x12O\n" +
+	"\x16marked_for_deletion_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x13markedForDeletionAt\x12@\n" +
+	"\x0elast_failed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\flastFailedAt\"A\n" +
+	"\x16GetDunningStateRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"W\n" +
+	"\x17GetDunningStateResponse\x12<\n" +
+	"\x05state\x18\x01 \x01(\v2&.obiente.cloud.billing.v1.DunningStateR\x05state\"C\n" +
+	"\x18ResetDunningStateRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"O\n" +
+	"\x19ResetDunningStateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x97\x01\n" +
+	"\fReferralCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bmax_uses\x18\x02 \x01(\x05R\amaxUses\x12\x1d\n" +
+	"\n" +
+	"uses_count\x18\x03 \x01(\x05R\tusesCount\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"H\n" +
+	"\x19CreateReferralCodeRequest\x12\x1e\n" +
+	"\bmax_uses\x18\x01 \x01(\x05H\x00R\amaxUses\x88\x01\x01B\v\n" +
+	"\t_max_uses\"i\n" +
+	"\x1aCreateReferralCodeResponse\x12K\n" +
+	"\rreferral_code\x18\x01 \x01(\v2&.obiente.cloud.billing.v1.ReferralCodeR\freferralCode\"\x18\n" +
+	"\x16GetReferralCodeRequest\"f\n" +
+	"\x17GetReferralCodeResponse\x12K\n" +
+	"\rreferral_code\x18\x01 \x01(\v2&.obiente.cloud.billing.v1.ReferralCodeR\freferralCode\"/\n" +
+	"\x19RedeemReferralCodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"l\n" +
+	"\x1aRedeemReferralCodeResponse\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12%\n" +
+	"\x0ecredited_cents\x18\x02 \x01(\x03R\rcreditedCents\"\xa2\x01\n" +
+	"\bCurrency\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12/\n" +
+	"\x14exchange_rate_to_usd\x18\x03 \x01(\x01R\x11exchangeRateToUsd\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"<\n" +
+	"\x11GetBalanceRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\xba\x01\n" +
+	"\x12GetBalanceResponse\x12#\n" +
+	"\rbalance_cents\x18\x01 \x01(\x03R\fbalanceCents\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x122\n" +
+	"\x15display_balance_cents\x18\x03 \x01(\x03R\x13displayBalanceCents\x12/\n" +
+	"\x14exchange_rate_to_usd\x18\x04 \x01(\x01R\x11exchangeRateToUsd\"\x1f\n" +
+	"\x1dGetSupportedCurrenciesRequest\"d\n" +
+	"\x1eGetSupportedCurrenciesResponse\x12B\n" +
+	"\n" +
+	"currencies\x18\x01 \x03(\v2\".obiente.cloud.billing.v1.CurrencyR\n" +
+	"currencies\"b\n" +
+	"\x1bSetPreferredCurrencyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"b\n" +
+	"\x1cSetPreferredCurrencyResponse\x12B\n" +
+	"\aaccount\x18\x01 \x01(\v2(.obiente.cloud.billing.v1.BillingAccountR\aaccount\"\x85\x02\n" +
+	"\n" +
+	"SpendAlert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12'\n" +
+	"\x0fthreshold_cents\x18\x03 \x01(\x03R\x0ethresholdCents\x12\x1d\n" +
+	"\n" +
+	"alert_type\x18\x04 \x01(\tR\talertType\x12;\n" +
+	"\vnotified_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"notifiedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8a\x01\n" +
+	"\x17CreateSpendAlertRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
+	"\x0fthreshold_cents\x18\x02 \x01(\x03R\x0ethresholdCents\x12\x1d\n" +
+	"\n" +
+	"alert_type\x18\x03 \x01(\tR\talertType\"V\n" +
+	"\x18CreateSpendAlertResponse\x12:\n" +
+	"\x05alert\x18\x01 \x01(\v2$.obiente.cloud.billing.v1.SpendAlertR\x05alert\"]\n" +
+	"\x17DeleteSpendAlertRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\balert_id\x18\x02 \x01(\tR\aalertId\"4\n" +
+	"\x18DeleteSpendAlertResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x16ListSpendAlertsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x83\x01\n" +
+	"\x17ListSpendAlertsResponse\x12<\n" +
+	"\x06alerts\x18\x01 \x03(\v2$.obiente.cloud.billing.v1.SpendAlertR\x06alerts\x12*\n" +
+	"\x11month_spend_cents\x18\x02 \x01(\x03R\x0fmonthSpendCents\"\xd5\x02\n" +
+	"\rSpendForecast\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vperiod_days\x18\x02 \x01(\x05R\n" +
+	"periodDays\x12'\n" +
+	"\x0fprojected_cents\x18\x03 \x01(\x03R\x0eprojectedCents\x12\x1f\n" +
+	"\vlower_cents\x18\x04 \x01(\x03R\n" +
+	"lowerCents\x12\x1f\n" +
+	"\vupper_cents\x18\x05 \x01(\x03R\n" +
+	"upperCents\x12-\n" +
+	"\x13trend_cents_per_day\x18\x06 \x01(\x01R\x10trendCentsPerDay\x12!\n" +
+	"\fhistory_days\x18\a \x01(\x05R\vhistoryDays\x12=\n" +
+	"\fgenerated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"c\n" +
+	"\x17GetSpendForecastRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vperiod_days\x18\x02 \x01(\x05R\n" +
+	"periodDays\"_\n" +
+	"\x18GetSpendForecastResponse\x12C\n" +
+	"\bforecast\x18\x01 \x01(\v2'.obiente.cloud.billing.v1.SpendForecastR\bforecast\"\xcf\x04\n" +
+	"\x14TaxExemptCertificate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x10certificate_type\x18\x03 \x01(\tR\x0fcertificateType\x12-\n" +
+	"\x12certificate_number\x18\x04 \x01(\tR\x11certificateNumber\x12+\n" +
+	"\x11issuing_authority\x18\x05 \x01(\tR\x10issuingAuthority\x129\n" +
+	"\n" +
+	"valid_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x12;\n" +
+	"\vvalid_until\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12$\n" +
+	"\vverified_by\x18\t \x01(\tH\x00R\n" +
+	"verifiedBy\x88\x01\x01\x12;\n" +
+	"\vverified_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_verified_by\"\xcb\x02\n" +
+	"!SubmitTaxExemptCertificateRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x10certificate_type\x18\x02 \x01(\tR\x0fcertificateType\x12-\n" +
+	"\x12certificate_number\x18\x03 \x01(\tR\x11certificateNumber\x12+\n" +
+	"\x11issuing_authority\x18\x04 \x01(\tR\x10issuingAuthority\x129\n" +
+	"\n" +
+	"valid_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x12;\n" +
+	"\vvalid_until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\"v\n" +
+	"\"SubmitTaxExemptCertificateResponse\x12P\n" +
+	"\vcertificate\x18\x01 \x01(\v2..obiente.cloud.billing.v1.TaxExemptCertificateR\vcertificate\"J\n" +
+	"!VerifyTaxExemptCertificateRequest\x12%\n" +
+	"\x0ecertificate_id\x18\x01 \x01(\tR\rcertificateId\"v\n" +
+	"\"VerifyTaxExemptCertificateResponse\x12P\n" +
+	"\vcertificate\x18\x01 \x01(\v2..obiente.cloud.billing.v1.TaxExemptCertificateR\vcertificate\"J\n" +
+	"!RevokeTaxExemptCertificateRequest\x12%\n" +
+	"\x0ecertificate_id\x18\x01 \x01(\tR\rcertificateId\"v\n" +
+	"\"RevokeTaxExemptCertificateResponse\x12P\n" +
+	"\vcertificate\x18\x01 \x01(\v2..obiente.cloud.billing.v1.TaxExemptCertificateR\vcertificate2\xde&\n" +
 	"\x0eBillingService\x12\x88\x01\n" +
 	"\x15CreateCheckoutSession\x126.obiente.cloud.billing.v1.CreateCheckoutSessionRequest\x1a7.obiente.cloud.billing.v1.CreateCheckoutSessionResponse\x12\x82\x01\n" +
 	"\x13CreatePaymentIntent\x124.obiente.cloud.billing.v1.CreatePaymentIntentRequest\x1a5.obiente.cloud.billing.v1.CreatePaymentIntentResponse\x12\x82\x01\n" +
@@ -3372,7 +5687,24 @@ const file_obiente_cloud_billing_v1_billing_service_proto_rawDesc = "" +
 	"\x12CancelSubscription\x123.obiente.cloud.billing.v1.CancelSubscriptionRequest\x1a4.obiente.cloud.billing.v1.CancelSubscriptionResponse\x12^\n" +
 	"\aPayBill\x12(.obiente.cloud.billing.v1.PayBillRequest\x1a).obiente.cloud.billing.v1.PayBillResponse\x12d\n" +
 	"\tListBills\x12*.obiente.cloud.billing.v1.ListBillsRequest\x1a+.obiente.cloud.billing.v1.ListBillsResponse\x12\x82\x01\n" +
-	"\x13GenerateCurrentBill\x124.obiente.cloud.billing.v1.GenerateCurrentBillRequest\x1a5.obiente.cloud.billing.v1.GenerateCurrentBillResponseBOZMgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1;billingv1b\x06proto3"
+	"\x13GenerateCurrentBill\x124.obiente.cloud.billing.v1.GenerateCurrentBillRequest\x1a5.obiente.cloud.billing.v1.GenerateCurrentBillResponse\x12x\n" +
+	"\x0fDownloadInvoice\x120.obiente.cloud.billing.v1.DownloadInvoiceRequest\x1a1.obiente.cloud.billing.v1.DownloadInvoiceResponse0\x01\x12v\n" +
+	"\x0fGetDunningState\x120.obiente.cloud.billing.v1.GetDunningStateRequest\x1a1.obiente.cloud.billing.v1.GetDunningStateResponse\x12|\n" +
+	"\x11ResetDunningState\x122.obiente.cloud.billing.v1.ResetDunningStateRequest\x1a3.obiente.cloud.billing.v1.ResetDunningStateResponse\x12\x7f\n" +
+	"\x12CreateReferralCode\x123.obiente.cloud.billing.v1.CreateReferralCodeRequest\x1a4.obiente.cloud.billing.v1.CreateReferralCodeResponse\x12v\n" +
+	"\x0fGetReferralCode\x120.obiente.cloud.billing.v1.GetReferralCodeRequest\x1a1.obiente.cloud.billing.v1.GetReferralCodeResponse\x12\x7f\n" +
+	"\x12RedeemReferralCode\x123.obiente.cloud.billing.v1.RedeemReferralCodeRequest\x1a4.obiente.cloud.billing.v1.RedeemReferralCodeResponse\x12g\n" +
+	"\n" +
+	"GetBalance\x12+.obiente.cloud.billing.v1.GetBalanceRequest\x1a,.obiente.cloud.billing.v1.GetBalanceResponse\x12\x8b\x01\n" +
+	"\x16GetSupportedCurrencies\x127.obiente.cloud.billing.v1.GetSupportedCurrenciesRequest\x1a8.obiente.cloud.billing.v1.GetSupportedCurrenciesResponse\x12\x85\x01\n" +
+	"\x14SetPreferredCurrency\x125.obiente.cloud.billing.v1.SetPreferredCurrencyRequest\x1a6.obiente.cloud.billing.v1.SetPreferredCurrencyResponse\x12y\n" +
+	"\x10CreateSpendAlert\x121.obiente.cloud.billing.v1.CreateSpendAlertRequest\x1a2.obiente.cloud.billing.v1.CreateSpendAlertResponse\x12y\n" +
+	"\x10DeleteSpendAlert\x121.obiente.cloud.billing.v1.DeleteSpendAlertRequest\x1a2.obiente.cloud.billing.v1.DeleteSpendAlertResponse\x12v\n" +
+	"\x0fListSpendAlerts\x120.obiente.cloud.billing.v1.ListSpendAlertsRequest\x1a1.obiente.cloud.billing.v1.ListSpendAlertsResponse\x12y\n" +
+	"\x10GetSpendForecast\x121.obiente.cloud.billing.v1.GetSpendForecastRequest\x1a2.obiente.cloud.billing.v1.GetSpendForecastResponse\x12\x97\x01\n" +
+	"\x1aSubmitTaxExemptCertificate\x12;.obiente.cloud.billing.v1.SubmitTaxExemptCertificateRequest\x1a<.obiente.cloud.billing.v1.SubmitTaxExemptCertificateResponse\x12\x97\x01\n" +
+	"\x1aVerifyTaxExemptCertificate\x12;.obiente.cloud.billing.v1.VerifyTaxExemptCertificateRequest\x1a<.obiente.cloud.billing.v1.VerifyTaxExemptCertificateResponse\x12\x97\x01\n" +
+	"\x1aRevokeTaxExemptCertificate\x12;.obiente.cloud.billing.v1.RevokeTaxExemptCertificateRequest\x1a<.obiente.cloud.billing.v1.RevokeTaxExemptCertificateResponseBOZMgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/billing/v1;billingv1b\x06proto3"
 
 var (
 	file_obiente_cloud_billing_v1_billing_service_proto_rawDescOnce sync.Once
@@ -3386,7 +5718,7 @@ func file_obiente_cloud_billing_v1_billing_service_proto_rawDescGZIP() []byte {
 	return file_obiente_cloud_billing_v1_billing_service_proto_rawDescData
 }
 
-var file_obiente_cloud_billing_v1_billing_service_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_obiente_cloud_billing_v1_billing_service_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
 var file_obiente_cloud_billing_v1_billing_service_proto_goTypes = []any{
 	(*CreateCheckoutSessionRequest)(nil),                    // 0: obiente.cloud.billing.v1.CreateCheckoutSessionRequest
 	(*CreateCheckoutSessionResponse)(nil),                   // 1: obiente.cloud.billing.v1.CreateCheckoutSessionResponse
@@ -3437,7 +5769,45 @@ var file_obiente_cloud_billing_v1_billing_service_proto_goTypes = []any{
 	(*MonthlyBill)(nil),                                     // 46: obiente.cloud.billing.v1.MonthlyBill
 	(*GenerateCurrentBillRequest)(nil),                      // 47: obiente.cloud.billing.v1.GenerateCurrentBillRequest
 	(*GenerateCurrentBillResponse)(nil),                     // 48: obiente.cloud.billing.v1.GenerateCurrentBillResponse
-	(*timestamppb.Timestamp)(nil),                           // 49: google.protobuf.Timestamp
+	(*DownloadInvoiceRequest)(nil),                          // 49: obiente.cloud.billing.v1.DownloadInvoiceRequest
+	(*DownloadInvoiceResponse)(nil),                         // 50: obiente.cloud.billing.v1.DownloadInvoiceResponse
+	(*DunningState)(nil),                                    // 51: obiente.cloud.billing.v1.DunningState
+	(*GetDunningStateRequest)(nil),                          // 52: obiente.cloud.billing.v1.GetDunningStateRequest
+	(*GetDunningStateResponse)(nil),                         // 53: obiente.cloud.billing.v1.GetDunningStateResponse
+	(*ResetDunningStateRequest)(nil),                        // 54: obiente.cloud.billing.v1.ResetDunningStateRequest
+	(*ResetDunningStateResponse)(nil),                       // 55: obiente.cloud.billing.v1.ResetDunningStateResponse
+	(*ReferralCode)(nil),                                    // 56: obiente.cloud.billing.v1.ReferralCode
+	(*CreateReferralCodeRequest)(nil),                       // 57: obiente.cloud.billing.v1.CreateReferralCodeRequest
+	(*CreateReferralCodeResponse)(nil),                      // 58: obiente.cloud.billing.v1.CreateReferralCodeResponse
+	(*GetReferralCodeRequest)(nil),                          // 59: obiente.cloud.billing.v1.GetReferralCodeRequest
+	(*GetReferralCodeResponse)(nil),                         // 60: obiente.cloud.billing.v1.GetReferralCodeResponse
+	(*RedeemReferralCodeRequest)(nil),                       // 61: obiente.cloud.billing.v1.RedeemReferralCodeRequest
+	(*RedeemReferralCodeResponse)(nil),                      // 62: obiente.cloud.billing.v1.RedeemReferralCodeResponse
+	(*Currency)(nil),                                        // 63: obiente.cloud.billing.v1.Currency
+	(*GetBalanceRequest)(nil),                               // 64: obiente.cloud.billing.v1.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                              // 65: obiente.cloud.billing.v1.GetBalanceResponse
+	(*GetSupportedCurrenciesRequest)(nil),                   // 66: obiente.cloud.billing.v1.GetSupportedCurrenciesRequest
+	(*GetSupportedCurrenciesResponse)(nil),                  // 67: obiente.cloud.billing.v1.GetSupportedCurrenciesResponse
+	(*SetPreferredCurrencyRequest)(nil),                     // 68: obiente.cloud.billing.v1.SetPreferredCurrencyRequest
+	(*SetPreferredCurrencyResponse)(nil),                    // 69: obiente.cloud.billing.v1.SetPreferredCurrencyResponse
+	(*SpendAlert)(nil),                                      // 70: obiente.cloud.billing.v1.SpendAlert
+	(*CreateSpendAlertRequest)(nil),                         // 71: obiente.cloud.billing.v1.CreateSpendAlertRequest
+	(*CreateSpendAlertResponse)(nil),                        // 72: obiente.cloud.billing.v1.CreateSpendAlertResponse
+	(*DeleteSpendAlertRequest)(nil),                         // 73: obiente.cloud.billing.v1.DeleteSpendAlertRequest
+	(*DeleteSpendAlertResponse)(nil),                        // 74: obiente.cloud.billing.v1.DeleteSpendAlertResponse
+	(*ListSpendAlertsRequest)(nil),                          // 75: obiente.cloud.billing.v1.ListSpendAlertsRequest
+	(*ListSpendAlertsResponse)(nil),                         // 76: obiente.cloud.billing.v1.ListSpendAlertsResponse
+	(*SpendForecast)(nil),                                   // 77: obiente.cloud.billing.v1.SpendForecast
+	(*GetSpendForecastRequest)(nil),                         // 78: obiente.cloud.billing.v1.GetSpendForecastRequest
+	(*GetSpendForecastResponse)(nil),                        // 79: obiente.cloud.billing.v1.GetSpendForecastResponse
+	(*TaxExemptCertificate)(nil),                            // 80: obiente.cloud.billing.v1.TaxExemptCertificate
+	(*SubmitTaxExemptCertificateRequest)(nil),               // 81: obiente.cloud.billing.v1.SubmitTaxExemptCertificateRequest
+	(*SubmitTaxExemptCertificateResponse)(nil),              // 82: obiente.cloud.billing.v1.SubmitTaxExemptCertificateResponse
+	(*VerifyTaxExemptCertificateRequest)(nil),               // 83: obiente.cloud.billing.v1.VerifyTaxExemptCertificateRequest
+	(*VerifyTaxExemptCertificateResponse)(nil),              // 84: obiente.cloud.billing.v1.VerifyTaxExemptCertificateResponse
+	(*RevokeTaxExemptCertificateRequest)(nil),               // 85: obiente.cloud.billing.v1.RevokeTaxExemptCertificateRequest
+	(*RevokeTaxExemptCertificateResponse)(nil),              // 86: obiente.cloud.billing.v1.RevokeTaxExemptCertificateResponse
+	(*timestamppb.Timestamp)(nil),                           // 87: google.protobuf.Timestamp
 }
 var file_obiente_cloud_billing_v1_billing_service_proto_depIdxs = []int32{
 	25, // 0: obiente.cloud.billing.v1.GetBillingAccountResponse.account:type_name -> obiente.cloud.billing.v1.BillingAccount
@@ -3446,80 +5816,140 @@ var file_obiente_cloud_billing_v1_billing_service_proto_depIdxs = []int32{
 	26, // 3: obiente.cloud.billing.v1.ListPaymentMethodsResponse.payment_methods:type_name -> obiente.cloud.billing.v1.PaymentMethod
 	26, // 4: obiente.cloud.billing.v1.AttachPaymentMethodResponse.payment_method:type_name -> obiente.cloud.billing.v1.PaymentMethod
 	24, // 5: obiente.cloud.billing.v1.ListInvoicesResponse.invoices:type_name -> obiente.cloud.billing.v1.Invoice
-	49, // 6: obiente.cloud.billing.v1.Invoice.date:type_name -> google.protobuf.Timestamp
-	49, // 7: obiente.cloud.billing.v1.Invoice.due_date:type_name -> google.protobuf.Timestamp
-	49, // 8: obiente.cloud.billing.v1.Invoice.paid_at:type_name -> google.protobuf.Timestamp
+	87, // 6: obiente.cloud.billing.v1.Invoice.date:type_name -> google.protobuf.Timestamp
+	87, // 7: obiente.cloud.billing.v1.Invoice.due_date:type_name -> google.protobuf.Timestamp
+	87, // 8: obiente.cloud.billing.v1.Invoice.paid_at:type_name -> google.protobuf.Timestamp
 	28, // 9: obiente.cloud.billing.v1.BillingAccount.address:type_name -> obiente.cloud.billing.v1.Address
-	49, // 10: obiente.cloud.billing.v1.BillingAccount.created_at:type_name -> google.protobuf.Timestamp
-	49, // 11: obiente.cloud.billing.v1.BillingAccount.updated_at:type_name -> google.protobuf.Timestamp
+	87, // 10: obiente.cloud.billing.v1.BillingAccount.created_at:type_name -> google.protobuf.Timestamp
+	87, // 11: obiente.cloud.billing.v1.BillingAccount.updated_at:type_name -> google.protobuf.Timestamp
 	27, // 12: obiente.cloud.billing.v1.PaymentMethod.card:type_name -> obiente.cloud.billing.v1.CardDetails
-	49, // 13: obiente.cloud.billing.v1.PaymentMethod.created_at:type_name -> google.protobuf.Timestamp
-	49, // 14: obiente.cloud.billing.v1.GetDNSDelegationSubscriptionStatusResponse.api_key_created_at:type_name -> google.protobuf.Timestamp
-	49, // 15: obiente.cloud.billing.v1.GetDNSDelegationSubscriptionStatusResponse.current_period_end:type_name -> google.protobuf.Timestamp
-	49, // 16: obiente.cloud.billing.v1.CancelDNSDelegationSubscriptionResponse.canceled_at:type_name -> google.protobuf.Timestamp
+	87, // 13: obiente.cloud.billing.v1.PaymentMethod.created_at:type_name -> google.protobuf.Timestamp
+	87, // 14: obiente.cloud.billing.v1.GetDNSDelegationSubscriptionStatusResponse.api_key_created_at:type_name -> google.protobuf.Timestamp
+	87, // 15: obiente.cloud.billing.v1.GetDNSDelegationSubscriptionStatusResponse.current_period_end:type_name -> google.protobuf.Timestamp
+	87, // 16: obiente.cloud.billing.v1.CancelDNSDelegationSubscriptionResponse.canceled_at:type_name -> google.protobuf.Timestamp
 	37, // 17: obiente.cloud.billing.v1.ListSubscriptionsResponse.subscriptions:type_name -> obiente.cloud.billing.v1.Subscription
-	49, // 18: obiente.cloud.billing.v1.Subscription.current_period_start:type_name -> google.protobuf.Timestamp
-	49, // 19: obiente.cloud.billing.v1.Subscription.current_period_end:type_name -> google.protobuf.Timestamp
-	49, // 20: obiente.cloud.billing.v1.Subscription.canceled_at:type_name -> google.protobuf.Timestamp
-	49, // 21: obiente.cloud.billing.v1.Subscription.created:type_name -> google.protobuf.Timestamp
+	87, // 18: obiente.cloud.billing.v1.Subscription.current_period_start:type_name -> google.protobuf.Timestamp
+	87, // 19: obiente.cloud.billing.v1.Subscription.current_period_end:type_name -> google.protobuf.Timestamp
+	87, // 20: obiente.cloud.billing.v1.Subscription.canceled_at:type_name -> google.protobuf.Timestamp
+	87, // 21: obiente.cloud.billing.v1.Subscription.created:type_name -> google.protobuf.Timestamp
 	37, // 22: obiente.cloud.billing.v1.UpdateSubscriptionPaymentMethodResponse.subscription:type_name -> obiente.cloud.billing.v1.Subscription
 	37, // 23: obiente.cloud.billing.v1.CancelSubscriptionResponse.subscription:type_name -> obiente.cloud.billing.v1.Subscription
 	46, // 24: obiente.cloud.billing.v1.PayBillResponse.bill:type_name -> obiente.cloud.billing.v1.MonthlyBill
 	46, // 25: obiente.cloud.billing.v1.ListBillsResponse.bills:type_name -> obiente.cloud.billing.v1.MonthlyBill
-	49, // 26: obiente.cloud.billing.v1.MonthlyBill.billing_period_start:type_name -> google.protobuf.Timestamp
-	49, // 27: obiente.cloud.billing.v1.MonthlyBill.billing_period_end:type_name -> google.protobuf.Timestamp
-	49, // 28: obiente.cloud.billing.v1.MonthlyBill.paid_at:type_name -> google.protobuf.Timestamp
-	49, // 29: obiente.cloud.billing.v1.MonthlyBill.due_date:type_name -> google.protobuf.Timestamp
-	49, // 30: obiente.cloud.billing.v1.MonthlyBill.created_at:type_name -> google.protobuf.Timestamp
-	49, // 31: obiente.cloud.billing.v1.MonthlyBill.updated_at:type_name -> google.protobuf.Timestamp
+	87, // 26: obiente.cloud.billing.v1.MonthlyBill.billing_period_start:type_name -> google.protobuf.Timestamp
+	87, // 27: obiente.cloud.billing.v1.MonthlyBill.billing_period_end:type_name -> google.protobuf.Timestamp
+	87, // 28: obiente.cloud.billing.v1.MonthlyBill.paid_at:type_name -> google.protobuf.Timestamp
+	87, // 29: obiente.cloud.billing.v1.MonthlyBill.due_date:type_name -> google.protobuf.Timestamp
+	87, // 30: obiente.cloud.billing.v1.MonthlyBill.created_at:type_name -> google.protobuf.Timestamp
+	87, // 31: obiente.cloud.billing.v1.MonthlyBill.updated_at:type_name -> google.protobuf.Timestamp
 	46, // 32: obiente.cloud.billing.v1.GenerateCurrentBillResponse.bill:type_name -> obiente.cloud.billing.v1.MonthlyBill
-	0,  // 33: obiente.cloud.billing.v1.BillingService.CreateCheckoutSession:input_type -> obiente.cloud.billing.v1.CreateCheckoutSessionRequest
-	2,  // 34: obiente.cloud.billing.v1.BillingService.CreatePaymentIntent:input_type -> obiente.cloud.billing.v1.CreatePaymentIntentRequest
-	4,  // 35: obiente.cloud.billing.v1.BillingService.CreatePortalSession:input_type -> obiente.cloud.billing.v1.CreatePortalSessionRequest
-	14, // 36: obiente.cloud.billing.v1.BillingService.CreateSetupIntent:input_type -> obiente.cloud.billing.v1.CreateSetupIntentRequest
-	6,  // 37: obiente.cloud.billing.v1.BillingService.GetBillingAccount:input_type -> obiente.cloud.billing.v1.GetBillingAccountRequest
-	8,  // 38: obiente.cloud.billing.v1.BillingService.UpdateBillingAccount:input_type -> obiente.cloud.billing.v1.UpdateBillingAccountRequest
-	10, // 39: obiente.cloud.billing.v1.BillingService.ListPaymentMethods:input_type -> obiente.cloud.billing.v1.ListPaymentMethodsRequest
-	16, // 40: obiente.cloud.billing.v1.BillingService.AttachPaymentMethod:input_type -> obiente.cloud.billing.v1.AttachPaymentMethodRequest
-	18, // 41: obiente.cloud.billing.v1.BillingService.DetachPaymentMethod:input_type -> obiente.cloud.billing.v1.DetachPaymentMethodRequest
-	20, // 42: obiente.cloud.billing.v1.BillingService.SetDefaultPaymentMethod:input_type -> obiente.cloud.billing.v1.SetDefaultPaymentMethodRequest
-	12, // 43: obiente.cloud.billing.v1.BillingService.GetPaymentStatus:input_type -> obiente.cloud.billing.v1.GetPaymentStatusRequest
-	22, // 44: obiente.cloud.billing.v1.BillingService.ListInvoices:input_type -> obiente.cloud.billing.v1.ListInvoicesRequest
-	29, // 45: obiente.cloud.billing.v1.BillingService.CreateDNSDelegationSubscriptionCheckout:input_type -> obiente.cloud.billing.v1.CreateDNSDelegationSubscriptionCheckoutRequest
-	31, // 46: obiente.cloud.billing.v1.BillingService.GetDNSDelegationSubscriptionStatus:input_type -> obiente.cloud.billing.v1.GetDNSDelegationSubscriptionStatusRequest
-	33, // 47: obiente.cloud.billing.v1.BillingService.CancelDNSDelegationSubscription:input_type -> obiente.cloud.billing.v1.CancelDNSDelegationSubscriptionRequest
-	35, // 48: obiente.cloud.billing.v1.BillingService.ListSubscriptions:input_type -> obiente.cloud.billing.v1.ListSubscriptionsRequest
-	38, // 49: obiente.cloud.billing.v1.BillingService.UpdateSubscriptionPaymentMethod:input_type -> obiente.cloud.billing.v1.UpdateSubscriptionPaymentMethodRequest
-	40, // 50: obiente.cloud.billing.v1.BillingService.CancelSubscription:input_type -> obiente.cloud.billing.v1.CancelSubscriptionRequest
-	42, // 51: obiente.cloud.billing.v1.BillingService.PayBill:input_type -> obiente.cloud.billing.v1.PayBillRequest
-	44, // 52: obiente.cloud.billing.v1.BillingService.ListBills:input_type -> obiente.cloud.billing.v1.ListBillsRequest
-	47, // 53: obiente.cloud.billing.v1.BillingService.GenerateCurrentBill:input_type -> obiente.cloud.billing.v1.GenerateCurrentBillRequest
-	1,  // 54: obiente.cloud.billing.v1.BillingService.CreateCheckoutSession:output_type -> obiente.cloud.billing.v1.CreateCheckoutSessionResponse
-	3,  // 55: obiente.cloud.billing.v1.BillingService.CreatePaymentIntent:output_type -> obiente.cloud.billing.v1.CreatePaymentIntentResponse
-	5,  // 56: obiente.cloud.billing.v1.BillingService.CreatePortalSession:output_type -> obiente.cloud.billing.v1.CreatePortalSessionResponse
-	15, // 57: obiente.cloud.billing.v1.BillingService.CreateSetupIntent:output_type -> obiente.cloud.billing.v1.CreateSetupIntentResponse
-	7,  // 58: obiente.cloud.billing.v1.BillingService.GetBillingAccount:output_type -> obiente.cloud.billing.v1.GetBillingAccountResponse
-	9,  // 59: obiente.cloud.billing.v1.BillingService.UpdateBillingAccount:output_type -> obiente.cloud.billing.v1.UpdateBillingAccountResponse
-	11, // 60: obiente.cloud.billing.v1.BillingService.ListPaymentMethods:output_type -> obiente.cloud.billing.v1.ListPaymentMethodsResponse
-	17, // 61: obiente.cloud.billing.v1.BillingService.AttachPaymentMethod:output_type -> obiente.cloud.billing.v1.AttachPaymentMethodResponse
-	19, // 62: obiente.cloud.billing.v1.BillingService.DetachPaymentMethod:output_type -> obiente.cloud.billing.v1.DetachPaymentMethodResponse
-	21, // 63: obiente.cloud.billing.v1.BillingService.SetDefaultPaymentMethod:output_type -> obiente.cloud.billing.v1.SetDefaultPaymentMethodResponse
-	13, // 64: obiente.cloud.billing.v1.BillingService.GetPaymentStatus:output_type -> obiente.cloud.billing.v1.GetPaymentStatusResponse
-	23, // 65: obiente.cloud.billing.v1.BillingService.ListInvoices:output_type -> obiente.cloud.billing.v1.ListInvoicesResponse
-	30, // 66: obiente.cloud.billing.v1.BillingService.CreateDNSDelegationSubscriptionCheckout:output_type -> obiente.cloud.billing.v1.CreateDNSDelegationSubscriptionCheckoutResponse
-	32, // 67: obiente.cloud.billing.v1.BillingService.GetDNSDelegationSubscriptionStatus:output_type -> obiente.cloud.billing.v1.GetDNSDelegationSubscriptionStatusResponse
-	34, // 68: obiente.cloud.billing.v1.BillingService.CancelDNSDelegationSubscription:output_type -> obiente.cloud.billing.v1.CancelDNSDelegationSubscriptionResponse
-	36, // 69: obiente.cloud.billing.v1.BillingService.ListSubscriptions:output_type -> obiente.cloud.billing.v1.ListSubscriptionsResponse
-	39, // 70: obiente.cloud.billing.v1.BillingService.UpdateSubscriptionPaymentMethod:output_type -> obiente.cloud.billing.v1.UpdateSubscriptionPaymentMethodResponse
-	41, // 71: obiente.cloud.billing.v1.BillingService.CancelSubscription:output_type -> obiente.cloud.billing.v1.CancelSubscriptionResponse
-	43, // 72: obiente.cloud.billing.v1.BillingService.PayBill:output_type -> obiente.cloud.billing.v1.PayBillResponse
-	45, // 73: obiente.cloud.billing.v1.BillingService.ListBills:output_type -> obiente.cloud.billing.v1.ListBillsResponse
-	48, // 74: obiente.cloud.billing.v1.BillingService.GenerateCurrentBill:output_type -> obiente.cloud.billing.v1.GenerateCurrentBillResponse
-	54, // [54:75] is the sub-list for method output_type
-	33, // [33:54] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	87, // 33: obiente.cloud.billing.v1.DunningState.warning_sent_at:type_name -> google.protobuf.Timestamp
+	87, // 34: obiente.cloud.billing.v1.DunningState.resource_creation_suspended_at:type_name -> google.protobuf.Timestamp
+	87, // 35: obiente.cloud.billing.v1.DunningState.resources_suspended_at:type_name -> google.protobuf.Timestamp
+	87, // 36: obiente.cloud.billing.v1.DunningState.marked_for_deletion_at:type_name -> google.protobuf.Timestamp
+	87, // 37: obiente.cloud.billing.v1.DunningState.last_failed_at:type_name -> google.protobuf.Timestamp
+	51, // 38: obiente.cloud.billing.v1.GetDunningStateResponse.state:type_name -> obiente.cloud.billing.v1.DunningState
+	87, // 39: obiente.cloud.billing.v1.ReferralCode.created_at:type_name -> google.protobuf.Timestamp
+	56, // 40: obiente.cloud.billing.v1.CreateReferralCodeResponse.referral_code:type_name -> obiente.cloud.billing.v1.ReferralCode
+	56, // 41: obiente.cloud.billing.v1.GetReferralCodeResponse.referral_code:type_name -> obiente.cloud.billing.v1.ReferralCode
+	87, // 42: obiente.cloud.billing.v1.Currency.updated_at:type_name -> google.protobuf.Timestamp
+	63, // 43: obiente.cloud.billing.v1.GetSupportedCurrenciesResponse.currencies:type_name -> obiente.cloud.billing.v1.Currency
+	25, // 44: obiente.cloud.billing.v1.SetPreferredCurrencyResponse.account:type_name -> obiente.cloud.billing.v1.BillingAccount
+	87, // 45: obiente.cloud.billing.v1.SpendAlert.notified_at:type_name -> google.protobuf.Timestamp
+	87, // 46: obiente.cloud.billing.v1.SpendAlert.created_at:type_name -> google.protobuf.Timestamp
+	70, // 47: obiente.cloud.billing.v1.CreateSpendAlertResponse.alert:type_name -> obiente.cloud.billing.v1.SpendAlert
+	70, // 48: obiente.cloud.billing.v1.ListSpendAlertsResponse.alerts:type_name -> obiente.cloud.billing.v1.SpendAlert
+	87, // 49: obiente.cloud.billing.v1.SpendForecast.generated_at:type_name -> google.protobuf.Timestamp
+	77, // 50: obiente.cloud.billing.v1.GetSpendForecastResponse.forecast:type_name -> obiente.cloud.billing.v1.SpendForecast
+	87, // 51: obiente.cloud.billing.v1.TaxExemptCertificate.valid_from:type_name -> google.protobuf.Timestamp
+	87, // 52: obiente.cloud.billing.v1.TaxExemptCertificate.valid_until:type_name -> google.protobuf.Timestamp
+	87, // 53: obiente.cloud.billing.v1.TaxExemptCertificate.verified_at:type_name -> google.protobuf.Timestamp
+	87, // 54: obiente.cloud.billing.v1.TaxExemptCertificate.revoked_at:type_name -> google.protobuf.Timestamp
+	87, // 55: obiente.cloud.billing.v1.TaxExemptCertificate.created_at:type_name -> google.protobuf.Timestamp
+	87, // 56: obiente.cloud.billing.v1.SubmitTaxExemptCertificateRequest.valid_from:type_name -> google.protobuf.Timestamp
+	87, // 57: obiente.cloud.billing.v1.SubmitTaxExemptCertificateRequest.valid_until:type_name -> google.protobuf.Timestamp
+	80, // 58: obiente.cloud.billing.v1.SubmitTaxExemptCertificateResponse.certificate:type_name -> obiente.cloud.billing.v1.TaxExemptCertificate
+	80, // 59: obiente.cloud.billing.v1.VerifyTaxExemptCertificateResponse.certificate:type_name -> obiente.cloud.billing.v1.TaxExemptCertificate
+	80, // 60: obiente.cloud.billing.v1.RevokeTaxExemptCertificateResponse.certificate:type_name -> obiente.cloud.billing.v1.TaxExemptCertificate
+	0,  // 61: obiente.cloud.billing.v1.BillingService.CreateCheckoutSession:input_type -> obiente.cloud.billing.v1.CreateCheckoutSessionRequest
+	2,  // 62: obiente.cloud.billing.v1.BillingService.CreatePaymentIntent:input_type -> obiente.cloud.billing.v1.CreatePaymentIntentRequest
+	4,  // 63: obiente.cloud.billing.v1.BillingService.CreatePortalSession:input_type -> obiente.cloud.billing.v1.CreatePortalSessionRequest
+	14, // 64: obiente.cloud.billing.v1.BillingService.CreateSetupIntent:input_type -> obiente.cloud.billing.v1.CreateSetupIntentRequest
+	6,  // 65: obiente.cloud.billing.v1.BillingService.GetBillingAccount:input_type -> obiente.cloud.billing.v1.GetBillingAccountRequest
+	8,  // 66: obiente.cloud.billing.v1.BillingService.UpdateBillingAccount:input_type -> obiente.cloud.billing.v1.UpdateBillingAccountRequest
+	10, // 67: obiente.cloud.billing.v1.BillingService.ListPaymentMethods:input_type -> obiente.cloud.billing.v1.ListPaymentMethodsRequest
+	16, // 68: obiente.cloud.billing.v1.BillingService.AttachPaymentMethod:input_type -> obiente.cloud.billing.v1.AttachPaymentMethodRequest
+	18, // 69: obiente.cloud.billing.v1.BillingService.DetachPaymentMethod:input_type -> obiente.cloud.billing.v1.DetachPaymentMethodRequest
+	20, // 70: obiente.cloud.billing.v1.BillingService.SetDefaultPaymentMethod:input_type -> obiente.cloud.billing.v1.SetDefaultPaymentMethodRequest
+	12, // 71: obiente.cloud.billing.v1.BillingService.GetPaymentStatus:input_type -> obiente.cloud.billing.v1.GetPaymentStatusRequest
+	22, // 72: obiente.cloud.billing.v1.BillingService.ListInvoices:input_type -> obiente.cloud.billing.v1.ListInvoicesRequest
+	29, // 73: obiente.cloud.billing.v1.BillingService.CreateDNSDelegationSubscriptionCheckout:input_type -> obiente.cloud.billing.v1.CreateDNSDelegationSubscriptionCheckoutRequest
+	31, // 74: obiente.cloud.billing.v1.BillingService.GetDNSDelegationSubscriptionStatus:input_type -> obiente.cloud.billing.v1.GetDNSDelegationSubscriptionStatusRequest
+	33, // 75: obiente.cloud.billing.v1.BillingService.CancelDNSDelegationSubscription:input_type -> obiente.cloud.billing.v1.CancelDNSDelegationSubscriptionRequest
+	35, // 76: obiente.cloud.billing.v1.BillingService.ListSubscriptions:input_type -> obiente.cloud.billing.v1.ListSubscriptionsRequest
+	38, // 77: obiente.cloud.billing.v1.BillingService.UpdateSubscriptionPaymentMethod:input_type -> obiente.cloud.billing.v1.UpdateSubscriptionPaymentMethodRequest
+	40, // 78: obiente.cloud.billing.v1.BillingService.CancelSubscription:input_type -> obiente.cloud.billing.v1.CancelSubscriptionRequest
+	42, // 79: obiente.cloud.billing.v1.BillingService.PayBill:input_type -> obiente.cloud.billing.v1.PayBillRequest
+	44, // 80: obiente.cloud.billing.v1.BillingService.ListBills:input_type -> obiente.cloud.billing.v1.ListBillsRequest
+	47, // 81: obiente.cloud.billing.v1.BillingService.GenerateCurrentBill:input_type -> obiente.cloud.billing.v1.GenerateCurrentBillRequest
+	49, // 82: obiente.cloud.billing.v1.BillingService.DownloadInvoice:input_type -> obiente.cloud.billing.v1.DownloadInvoiceRequest
+	52, // 83: obiente.cloud.billing.v1.BillingService.GetDunningState:input_type -> obiente.cloud.billing.v1.GetDunningStateRequest
+	54, // 84: obiente.cloud.billing.v1.BillingService.ResetDunningState:input_type -> obiente.cloud.billing.v1.ResetDunningStateRequest
+	57, // 85: obiente.cloud.billing.v1.BillingService.CreateReferralCode:input_type -> obiente.cloud.billing.v1.CreateReferralCodeRequest
+	59, // 86: obiente.cloud.billing.v1.BillingService.GetReferralCode:input_type -> obiente.cloud.billing.v1.GetReferralCodeRequest
+	61, // 87: obiente.cloud.billing.v1.BillingService.RedeemReferralCode:input_type -> obiente.cloud.billing.v1.RedeemReferralCodeRequest
+	64, // 88: obiente.cloud.billing.v1.BillingService.GetBalance:input_type -> obiente.cloud.billing.v1.GetBalanceRequest
+	66, // 89: obiente.cloud.billing.v1.BillingService.GetSupportedCurrencies:input_type -> obiente.cloud.billing.v1.GetSupportedCurrenciesRequest
+	68, // 90: obiente.cloud.billing.v1.BillingService.SetPreferredCurrency:input_type -> obiente.cloud.billing.v1.SetPreferredCurrencyRequest
+	71, // 91: obiente.cloud.billing.v1.BillingService.CreateSpendAlert:input_type -> obiente.cloud.billing.v1.CreateSpendAlertRequest
+	73, // 92: obiente.cloud.billing.v1.BillingService.DeleteSpendAlert:input_type -> obiente.cloud.billing.v1.DeleteSpendAlertRequest
+	75, // 93: obiente.cloud.billing.v1.BillingService.ListSpendAlerts:input_type -> obiente.cloud.billing.v1.ListSpendAlertsRequest
+	78, // 94: obiente.cloud.billing.v1.BillingService.GetSpendForecast:input_type -> obiente.cloud.billing.v1.GetSpendForecastRequest
+	81, // 95: obiente.cloud.billing.v1.BillingService.SubmitTaxExemptCertificate:input_type -> obiente.cloud.billing.v1.SubmitTaxExemptCertificateRequest
+	83, // 96: obiente.cloud.billing.v1.BillingService.VerifyTaxExemptCertificate:input_type -> obiente.cloud.billing.v1.VerifyTaxExemptCertificateRequest
+	85, // 97: obiente.cloud.billing.v1.BillingService.RevokeTaxExemptCertificate:input_type -> obiente.cloud.billing.v1.RevokeTaxExemptCertificateRequest
+	1,  // 98: obiente.cloud.billing.v1.BillingService.CreateCheckoutSession:output_type -> obiente.cloud.billing.v1.CreateCheckoutSessionResponse
+	3,  // 99: obiente.cloud.billing.v1.BillingService.CreatePaymentIntent:output_type -> obiente.cloud.billing.v1.CreatePaymentIntentResponse
+	5,  // 100: obiente.cloud.billing.v1.BillingService.CreatePortalSession:output_type -> obiente.cloud.billing.v1.CreatePortalSessionResponse
+	15, // 101: obiente.cloud.billing.v1.BillingService.CreateSetupIntent:output_type -> obiente.cloud.billing.v1.CreateSetupIntentResponse
+	7,  // 102: obiente.cloud.billing.v1.BillingService.GetBillingAccount:output_type -> obiente.cloud.billing.v1.GetBillingAccountResponse
+	9,  // 103: obiente.cloud.billing.v1.BillingService.UpdateBillingAccount:output_type -> obiente.cloud.billing.v1.UpdateBillingAccountResponse
+	11, // 104: obiente.cloud.billing.v1.BillingService.ListPaymentMethods:output_type -> obiente.cloud.billing.v1.ListPaymentMethodsResponse
+	17, // 105: obiente.cloud.billing.v1.BillingService.AttachPaymentMethod:output_type -> obiente.cloud.billing.v1.AttachPaymentMethodResponse
+	19, // 106: obiente.cloud.billing.v1.BillingService.DetachPaymentMethod:output_type -> obiente.cloud.billing.v1.DetachPaymentMethodResponse
+	21, // 107: obiente.cloud.billing.v1.BillingService.SetDefaultPaymentMethod:output_type -> obiente.cloud.billing.v1.SetDefaultPaymentMethodResponse
+	13, // 108: obiente.cloud.billing.v1.BillingService.GetPaymentStatus:output_type -> obiente.cloud.billing.v1.GetPaymentStatusResponse
+	23, // 109: obiente.cloud.billing.v1.BillingService.ListInvoices:output_type -> obiente.cloud.billing.v1.ListInvoicesResponse
+	30, // 110: obiente.cloud.billing.v1.BillingService.CreateDNSDelegationSubscriptionCheckout:output_type -> obiente.cloud.billing.v1.CreateDNSDelegationSubscriptionCheckoutResponse
+	32, // 111: obiente.cloud.billing.v1.BillingService.GetDNSDelegationSubscriptionStatus:output_type -> obiente.cloud.billing.v1.GetDNSDelegationSubscriptionStatusResponse
+	34, // 112: obiente.cloud.billing.v1.BillingService.CancelDNSDelegationSubscription:output_type -> obiente.cloud.billing.v1.CancelDNSDelegationSubscriptionResponse
+	36, // 113: obiente.cloud.billing.v1.BillingService.ListSubscriptions:output_type -> obiente.cloud.billing.v1.ListSubscriptionsResponse
+	39, // 114: obiente.cloud.billing.v1.BillingService.UpdateSubscriptionPaymentMethod:output_type -> obiente.cloud.billing.v1.UpdateSubscriptionPaymentMethodResponse
+	41, // 115: obiente.cloud.billing.v1.BillingService.CancelSubscription:output_type -> obiente.cloud.billing.v1.CancelSubscriptionResponse
+	43, // 116: obiente.cloud.billing.v1.BillingService.PayBill:output_type -> obiente.cloud.billing.v1.PayBillResponse
+	45, // 117: obiente.cloud.billing.v1.BillingService.ListBills:output_type -> obiente.cloud.billing.v1.ListBillsResponse
+	48, // 118: obiente.cloud.billing.v1.BillingService.GenerateCurrentBill:output_type -> obiente.cloud.billing.v1.GenerateCurrentBillResponse
+	50, // 119: obiente.cloud.billing.v1.BillingService.DownloadInvoice:output_type -> obiente.cloud.billing.v1.DownloadInvoiceResponse
+	53, // 120: obiente.cloud.billing.v1.BillingService.GetDunningState:output_type -> obiente.cloud.billing.v1.GetDunningStateResponse
+	55, // 121: obiente.cloud.billing.v1.BillingService.ResetDunningState:output_type -> obiente.cloud.billing.v1.ResetDunningStateResponse
+	58, // 122: obiente.cloud.billing.v1.BillingService.CreateReferralCode:output_type -> obiente.cloud.billing.v1.CreateReferralCodeResponse
+	60, // 123: obiente.cloud.billing.v1.BillingService.GetReferralCode:output_type -> obiente.cloud.billing.v1.GetReferralCodeResponse
+	62, // 124: obiente.cloud.billing.v1.BillingService.RedeemReferralCode:output_type -> obiente.cloud.billing.v1.RedeemReferralCodeResponse
+	65, // 125: obiente.cloud.billing.v1.BillingService.GetBalance:output_type -> obiente.cloud.billing.v1.GetBalanceResponse
+	67, // 126: obiente.cloud.billing.v1.BillingService.GetSupportedCurrencies:output_type -> obiente.cloud.billing.v1.GetSupportedCurrenciesResponse
+	69, // 127: obiente.cloud.billing.v1.BillingService.SetPreferredCurrency:output_type -> obiente.cloud.billing.v1.SetPreferredCurrencyResponse
+	72, // 128: obiente.cloud.billing.v1.BillingService.CreateSpendAlert:output_type -> obiente.cloud.billing.v1.CreateSpendAlertResponse
+	74, // 129: obiente.cloud.billing.v1.BillingService.DeleteSpendAlert:output_type -> obiente.cloud.billing.v1.DeleteSpendAlertResponse
+	76, // 130: obiente.cloud.billing.v1.BillingService.ListSpendAlerts:output_type -> obiente.cloud.billing.v1.ListSpendAlertsResponse
+	79, // 131: obiente.cloud.billing.v1.BillingService.GetSpendForecast:output_type -> obiente.cloud.billing.v1.GetSpendForecastResponse
+	82, // 132: obiente.cloud.billing.v1.BillingService.SubmitTaxExemptCertificate:output_type -> obiente.cloud.billing.v1.SubmitTaxExemptCertificateResponse
+	84, // 133: obiente.cloud.billing.v1.BillingService.VerifyTaxExemptCertificate:output_type -> obiente.cloud.billing.v1.VerifyTaxExemptCertificateResponse
+	86, // 134: obiente.cloud.billing.v1.BillingService.RevokeTaxExemptCertificate:output_type -> obiente.cloud.billing.v1.RevokeTaxExemptCertificateResponse
+	98, // [98:135] is the sub-list for method output_type
+	61, // [61:98] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_obiente_cloud_billing_v1_billing_service_proto_init() }
@@ -3542,13 +5972,15 @@ func file_obiente_cloud_billing_v1_billing_service_proto_init() {
 	file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[29].OneofWrappers = []any{}
 	file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[44].OneofWrappers = []any{}
 	file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[46].OneofWrappers = []any{}
+	file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[57].OneofWrappers = []any{}
+	file_obiente_cloud_billing_v1_billing_service_proto_msgTypes[80].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_obiente_cloud_billing_v1_billing_service_proto_rawDesc), len(file_obiente_cloud_billing_v1_billing_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   49,
+			NumMessages:   87,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -95,6 +95,54 @@ const (
 	// BillingServiceGenerateCurrentBillProcedure is the fully-qualified name of the BillingService's
 	// GenerateCurrentBill RPC.
 	BillingServiceGenerateCurrentBillProcedure = "/obiente.cloud.billing.v1.BillingService/GenerateCurrentBill"
+	// BillingServiceDownloadInvoiceProcedure is the fully-qualified name of the BillingService's
+	// DownloadInvoice RPC.
+	BillingServiceDownloadInvoiceProcedure = "/obiente.cloud.billing.v1.BillingService/DownloadInvoice"
+	// BillingServiceGetDunningStateProcedure is the fully-qualified name of the BillingService's
+	// GetDunningState RPC.
+	BillingServiceGetDunningStateProcedure = "/obiente.cloud.billing.v1.BillingService/GetDunningState"
+	// BillingServiceResetDunningStateProcedure is the fully-qualified name of the BillingService's
+	// ResetDunningState RPC.
+	BillingServiceResetDunningStateProcedure = "/obiente.cloud.billing.v1.BillingService/ResetDunningState"
+	// BillingServiceCreateReferralCodeProcedure is the fully-qualified name of the BillingService's
+	// CreateReferralCode RPC.
+	BillingServiceCreateReferralCodeProcedure = "/obiente.cloud.billing.v1.BillingService/CreateReferralCode"
+	// BillingServiceGetReferralCodeProcedure is the fully-qualified name of the BillingService's
+	// GetReferralCode RPC.
+	BillingServiceGetReferralCodeProcedure = "/obiente.cloud.billing.v1.BillingService/GetReferralCode"
+	// BillingServiceRedeemReferralCodeProcedure is the fully-qualified name of the BillingService's
+	// RedeemReferralCode RPC.
+	BillingServiceRedeemReferralCodeProcedure = "/obiente.cloud.billing.v1.BillingService/RedeemReferralCode"
+	// BillingServiceGetBalanceProcedure is the fully-qualified name of the BillingService's GetBalance
+	// RPC.
+	BillingServiceGetBalanceProcedure = "/obiente.cloud.billing.v1.BillingService/GetBalance"
+	// BillingServiceGetSupportedCurrenciesProcedure is the fully-qualified name of the BillingService's
+	// GetSupportedCurrencies RPC.
+	BillingServiceGetSupportedCurrenciesProcedure = "/obiente.cloud.billing.v1.BillingService/GetSupportedCurrencies"
+	// BillingServiceSetPreferredCurrencyProcedure is the fully-qualified name of the BillingService's
+	// SetPreferredCurrency RPC.
+	BillingServiceSetPreferredCurrencyProcedure = "/obiente.cloud.billing.v1.BillingService/SetPreferredCurrency"
+	// BillingServiceCreateSpendAlertProcedure is the fully-qualified name of the BillingService's
+	// CreateSpendAlert RPC.
+	BillingServiceCreateSpendAlertProcedure = "/obiente.cloud.billing.v1.BillingService/CreateSpendAlert"
+	// BillingServiceDeleteSpendAlertProcedure is the fully-qualified name of the BillingService's
+	// DeleteSpendAlert RPC.
+	BillingServiceDeleteSpendAlertProcedure = "/obiente.cloud.billing.v1.BillingService/DeleteSpendAlert"
+	// BillingServiceListSpendAlertsProcedure is the fully-qualified name of the BillingService's
+	// ListSpendAlerts RPC.
+	BillingServiceListSpendAlertsProcedure = "/obiente.cloud.billing.v1.BillingService/ListSpendAlerts"
+	// BillingServiceGetSpendForecastProcedure is the fully-qualified name of the BillingService's
+	// GetSpendForecast RPC.
+	BillingServiceGetSpendForecastProcedure = "/obiente.cloud.billing.v1.BillingService/GetSpendForecast"
+	// BillingServiceSubmitTaxExemptCertificateProcedure is the fully-qualified name of the
+	// BillingService's SubmitTaxExemptCertificate RPC.
+	BillingServiceSubmitTaxExemptCertificateProcedure = "/obiente.cloud.billing.v1.BillingService/SubmitTaxExemptCertificate"
+	// BillingServiceVerifyTaxExemptCertificateProcedure is the fully-qualified name of the
+	// BillingService's VerifyTaxExemptCertificate RPC.
+	BillingServiceVerifyTaxExemptCertificateProcedure = "/obiente.cloud.billing.v1.BillingService/VerifyTaxExemptCertificate"
+	// BillingServiceRevokeTaxExemptCertificateProcedure is the fully-qualified name of the
+	// BillingService's RevokeTaxExemptCertificate RPC.
+	BillingServiceRevokeTaxExemptCertificateProcedure = "/obiente.cloud.billing.v1.BillingService/RevokeTaxExemptCertificate"
 )
 
 // BillingServiceClient is a client for the obiente.cloud.billing.v1.BillingService service.
@@ -142,6 +190,39 @@ type BillingServiceClient interface {
 	// Generate the current bill early (before billing date)
 	// This allows users to create and pay their current bill before their scheduled billing date
 	GenerateCurrentBill(context.Context, *connect.Request[v1.GenerateCurrentBillRequest]) (*connect.Response[v1.GenerateCurrentBillResponse], error)
+	// Download the generated invoice PDF for a month
+	// The PDF is streamed in chunks; filename and total_size are set on the first message
+	DownloadInvoice(context.Context, *connect.Request[v1.DownloadInvoiceRequest]) (*connect.ServerStreamForClient[v1.DownloadInvoiceResponse], error)
+	// Get how far an organization's failed invoice has escalated through dunning (superadmin only)
+	GetDunningState(context.Context, *connect.Request[v1.GetDunningStateRequest]) (*connect.Response[v1.GetDunningStateResponse], error)
+	// Clear an organization's dunning state and lift the restrictions it imposed (superadmin only)
+	ResetDunningState(context.Context, *connect.Request[v1.ResetDunningStateRequest]) (*connect.Response[v1.ResetDunningStateResponse], error)
+	// Create a referral code for the current user (at most 3 per 30 days)
+	CreateReferralCode(context.Context, *connect.Request[v1.CreateReferralCodeRequest]) (*connect.Response[v1.CreateReferralCodeResponse], error)
+	// Get the current user's most recent referral code
+	GetReferralCode(context.Context, *connect.Request[v1.GetReferralCodeRequest]) (*connect.Response[v1.GetReferralCodeResponse], error)
+	// Redeem a referral code as a newly signed-up user, crediting both the new user and the referrer
+	RedeemReferralCode(context.Context, *connect.Request[v1.RedeemReferralCodeRequest]) (*connect.Response[v1.RedeemReferralCodeResponse], error)
+	// Get an organization's credit balance, converted to its preferred currency
+	GetBalance(context.Context, *connect.Request[v1.GetBalanceRequest]) (*connect.Response[v1.GetBalanceResponse], error)
+	// List the currencies prices can be displayed in, with their current exchange rates
+	GetSupportedCurrencies(context.Context, *connect.Request[v1.GetSupportedCurrenciesRequest]) (*connect.Response[v1.GetSupportedCurrenciesResponse], error)
+	// Set the currency an organization's balance, bills and credit purchases are shown and charged in
+	SetPreferredCurrency(context.Context, *connect.Request[v1.SetPreferredCurrencyRequest]) (*connect.Response[v1.SetPreferredCurrencyResponse], error)
+	// Add a threshold on an organization's monthly spend that emails the billing contact or suspends the organization
+	CreateSpendAlert(context.Context, *connect.Request[v1.CreateSpendAlertRequest]) (*connect.Response[v1.CreateSpendAlertResponse], error)
+	// Remove one of an organization's spend alerts
+	DeleteSpendAlert(context.Context, *connect.Request[v1.DeleteSpendAlertRequest]) (*connect.Response[v1.DeleteSpendAlertResponse], error)
+	// List an organization's spend alerts with its spend so far this month
+	ListSpendAlerts(context.Context, *connect.Request[v1.ListSpendAlertsRequest]) (*connect.Response[v1.ListSpendAlertsResponse], error)
+	// Project an organization's metered spend over the coming days from its last 60 days of usage
+	GetSpendForecast(context.Context, *connect.Request[v1.GetSpendForecastRequest]) (*connect.Response[v1.GetSpendForecastResponse], error)
+	// Submit a tax-exempt certificate for a non-profit, educational or government organization
+	SubmitTaxExemptCertificate(context.Context, *connect.Request[v1.SubmitTaxExemptCertificateRequest]) (*connect.Response[v1.SubmitTaxExemptCertificateResponse], error)
+	// Verify a submitted tax-exempt certificate so the organization is no longer charged tax (superadmin only)
+	VerifyTaxExemptCertificate(context.Context, *connect.Request[v1.VerifyTaxExemptCertificateRequest]) (*connect.Response[v1.VerifyTaxExemptCertificateResponse], error)
+	// Revoke a tax-exempt certificate so the organization is charged tax again (superadmin only)
+	RevokeTaxExemptCertificate(context.Context, *connect.Request[v1.RevokeTaxExemptCertificateRequest]) (*connect.Response[v1.RevokeTaxExemptCertificateResponse], error)
 }
 
 // NewBillingServiceClient constructs a client for the obiente.cloud.billing.v1.BillingService
@@ -281,6 +362,102 @@ func NewBillingServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(billingServiceMethods.ByName("GenerateCurrentBill")),
 			connect.WithClientOptions(opts...),
 		),
+		downloadInvoice: connect.NewClient[v1.DownloadInvoiceRequest, v1.DownloadInvoiceResponse](
+			httpClient,
+			baseURL+BillingServiceDownloadInvoiceProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("DownloadInvoice")),
+			connect.WithClientOptions(opts...),
+		),
+		getDunningState: connect.NewClient[v1.GetDunningStateRequest, v1.GetDunningStateResponse](
+			httpClient,
+			baseURL+BillingServiceGetDunningStateProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("GetDunningState")),
+			connect.WithClientOptions(opts...),
+		),
+		resetDunningState: connect.NewClient[v1.ResetDunningStateRequest, v1.ResetDunningStateResponse](
+			httpClient,
+			baseURL+BillingServiceResetDunningStateProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("ResetDunningState")),
+			connect.WithClientOptions(opts...),
+		),
+		createReferralCode: connect.NewClient[v1.CreateReferralCodeRequest, v1.CreateReferralCodeResponse](
+			httpClient,
+			baseURL+BillingServiceCreateReferralCodeProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("CreateReferralCode")),
+			connect.WithClientOptions(opts...),
+		),
+		getReferralCode: connect.NewClient[v1.GetReferralCodeRequest, v1.GetReferralCodeResponse](
+			httpClient,
+			baseURL+BillingServiceGetReferralCodeProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("GetReferralCode")),
+			connect.WithClientOptions(opts...),
+		),
+		redeemReferralCode: connect.NewClient[v1.RedeemReferralCodeRequest, v1.RedeemReferralCodeResponse](
+			httpClient,
+			baseURL+BillingServiceRedeemReferralCodeProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("RedeemReferralCode")),
+			connect.WithClientOptions(opts...),
+		),
+		getBalance: connect.NewClient[v1.GetBalanceRequest, v1.GetBalanceResponse](
+			httpClient,
+			baseURL+BillingServiceGetBalanceProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("GetBalance")),
+			connect.WithClientOptions(opts...),
+		),
+		getSupportedCurrencies: connect.NewClient[v1.GetSupportedCurrenciesRequest, v1.GetSupportedCurrenciesResponse](
+			httpClient,
+			baseURL+BillingServiceGetSupportedCurrenciesProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("GetSupportedCurrencies")),
+			connect.WithClientOptions(opts...),
+		),
+		setPreferredCurrency: connect.NewClient[v1.SetPreferredCurrencyRequest, v1.SetPreferredCurrencyResponse](
+			httpClient,
+			baseURL+BillingServiceSetPreferredCurrencyProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("SetPreferredCurrency")),
+			connect.WithClientOptions(opts...),
+		),
+		createSpendAlert: connect.NewClient[v1.CreateSpendAlertRequest, v1.CreateSpendAlertResponse](
+			httpClient,
+			baseURL+BillingServiceCreateSpendAlertProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("CreateSpendAlert")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSpendAlert: connect.NewClient[v1.DeleteSpendAlertRequest, v1.DeleteSpendAlertResponse](
+			httpClient,
+			baseURL+BillingServiceDeleteSpendAlertProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("DeleteSpendAlert")),
+			connect.WithClientOptions(opts...),
+		),
+		listSpendAlerts: connect.NewClient[v1.ListSpendAlertsRequest, v1.ListSpendAlertsResponse](
+			httpClient,
+			baseURL+BillingServiceListSpendAlertsProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("ListSpendAlerts")),
+			connect.WithClientOptions(opts...),
+		),
+		getSpendForecast: connect.NewClient[v1.GetSpendForecastRequest, v1.GetSpendForecastResponse](
+			httpClient,
+			baseURL+BillingServiceGetSpendForecastProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("GetSpendForecast")),
+			connect.WithClientOptions(opts...),
+		),
+		submitTaxExemptCertificate: connect.NewClient[v1.SubmitTaxExemptCertificateRequest, v1.SubmitTaxExemptCertificateResponse](
+			httpClient,
+			baseURL+BillingServiceSubmitTaxExemptCertificateProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("SubmitTaxExemptCertificate")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyTaxExemptCertificate: connect.NewClient[v1.VerifyTaxExemptCertificateRequest, v1.VerifyTaxExemptCertificateResponse](
+			httpClient,
+			baseURL+BillingServiceVerifyTaxExemptCertificateProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("VerifyTaxExemptCertificate")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeTaxExemptCertificate: connect.NewClient[v1.RevokeTaxExemptCertificateRequest, v1.RevokeTaxExemptCertificateResponse](
+			httpClient,
+			baseURL+BillingServiceRevokeTaxExemptCertificateProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("RevokeTaxExemptCertificate")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -307,6 +484,22 @@ type billingServiceClient struct {
 	payBill                                 *connect.Client[v1.PayBillRequest, v1.PayBillResponse]
 	listBills                               *connect.Client[v1.ListBillsRequest, v1.ListBillsResponse]
 	generateCurrentBill                     *connect.Client[v1.GenerateCurrentBillRequest, v1.GenerateCurrentBillResponse]
+	downloadInvoice                         *connect.Client[v1.DownloadInvoiceRequest, v1.DownloadInvoiceResponse]
+	getDunningState                         *connect.Client[v1.GetDunningStateRequest, v1.GetDunningStateResponse]
+	resetDunningState                       *connect.Client[v1.ResetDunningStateRequest, v1.ResetDunningStateResponse]
+	createReferralCode                      *connect.Client[v1.CreateReferralCodeRequest, v1.CreateReferralCodeResponse]
+	getReferralCode                         *connect.Client[v1.GetReferralCodeRequest, v1.GetReferralCodeResponse]
+	redeemReferralCode                      *connect.Client[v1.RedeemReferralCodeRequest, v1.RedeemReferralCodeResponse]
+	getBalance                              *connect.Client[v1.GetBalanceRequest, v1.GetBalanceResponse]
+	getSupportedCurrencies                  *connect.Client[v1.GetSupportedCurrenciesRequest, v1.GetSupportedCurrenciesResponse]
+	setPreferredCurrency                    *connect.Client[v1.SetPreferredCurrencyRequest, v1.SetPreferredCurrencyResponse]
+	createSpendAlert                        *connect.Client[v1.CreateSpendAlertRequest, v1.CreateSpendAlertResponse]
+	deleteSpendAlert                        *connect.Client[v1.DeleteSpendAlertRequest, v1.DeleteSpendAlertResponse]
+	listSpendAlerts                         *connect.Client[v1.ListSpendAlertsRequest, v1.ListSpendAlertsResponse]
+	getSpendForecast                        *connect.Client[v1.GetSpendForecastRequest, v1.GetSpendForecastResponse]
+	submitTaxExemptCertificate              *connect.Client[v1.SubmitTaxExemptCertificateRequest, v1.SubmitTaxExemptCertificateResponse]
+	verifyTaxExemptCertificate              *connect.Client[v1.VerifyTaxExemptCertificateRequest, v1.VerifyTaxExemptCertificateResponse]
+	revokeTaxExemptCertificate              *connect.Client[v1.RevokeTaxExemptCertificateRequest, v1.RevokeTaxExemptCertificateResponse]
 }
 
 // CreateCheckoutSession calls obiente.cloud.billing.v1.BillingService.CreateCheckoutSession.
@@ -418,6 +611,89 @@ func (c *billingServiceClient) GenerateCurrentBill(ctx context.Context, req *con
 	return c.generateCurrentBill.CallUnary(ctx, req)
 }
 
+// DownloadInvoice calls obiente.cloud.billing.v1.BillingService.DownloadInvoice.
+func (c *billingServiceClient) DownloadInvoice(ctx context.Context, req *connect.Request[v1.DownloadInvoiceRequest]) (*connect.ServerStreamForClient[v1.DownloadInvoiceResponse], error) {
+	return c.downloadInvoice.CallServerStream(ctx, req)
+}
+
+// GetDunningState calls obiente.cloud.billing.v1.BillingService.GetDunningState.
+func (c *billingServiceClient) GetDunningState(ctx context.Context, req *connect.Request[v1.GetDunningStateRequest]) (*connect.Response[v1.GetDunningStateResponse], error) {
+	return c.getDunningState.CallUnary(ctx, req)
+}
+
+// ResetDunningState calls obiente.cloud.billing.v1.BillingService.ResetDunningState.
+func (c *billingServiceClient) ResetDunningState(ctx context.Context, req *connect.Request[v1.ResetDunningStateRequest]) (*connect.Response[v1.ResetDunningStateResponse], error) {
+	return c.resetDunningState.CallUnary(ctx, req)
+}
+
+// CreateReferralCode calls obiente.cloud.billing.v1.BillingService.CreateReferralCode.
+func (c *billingServiceClient) CreateReferralCode(ctx context.Context, req *connect.Request[v1.CreateReferralCodeRequest]) (*connect.Response[v1.CreateReferralCodeResponse], error) {
+	return c.createReferralCode.CallUnary(ctx, req)
+}
+
+// GetReferralCode calls obiente.cloud.billing.v1.BillingService.GetReferralCode.
+func (c *billingServiceClient) GetReferralCode(ctx context.Context, req *connect.Request[v1.GetReferralCodeRequest]) (*connect.Response[v1.GetReferralCodeResponse], error) {
+	return c.getReferralCode.CallUnary(ctx, req)
+}
+
+// RedeemReferralCode calls obiente.cloud.billing.v1.BillingService.RedeemReferralCode.
+func (c *billingServiceClient) RedeemReferralCode(ctx context.Context, req *connect.Request[v1.RedeemReferralCodeRequest]) (*connect.Response[v1.RedeemReferralCodeResponse], error) {
+	return c.redeemReferralCode.CallUnary(ctx, req)
+}
+
+// GetBalance calls obiente.cloud.billing.v1.BillingService.GetBalance.
+func (c *billingServiceClient) GetBalance(ctx context.Context, req *connect.Request[v1.GetBalanceRequest]) (*connect.Response[v1.GetBalanceResponse], error) {
+	return c.getBalance.CallUnary(ctx, req)
+}
+
+// GetSupportedCurrencies calls obiente.cloud.billing.v1.BillingService.GetSupportedCurrencies.
+func (c *billingServiceClient) GetSupportedCurrencies(ctx context.Context, req *connect.Request[v1.GetSupportedCurrenciesRequest]) (*connect.Response[v1.GetSupportedCurrenciesResponse], error) {
+	return c.getSupportedCurrencies.CallUnary(ctx, req)
+}
+
+// SetPreferredCurrency calls obiente.cloud.billing.v1.BillingService.SetPreferredCurrency.
+func (c *billingServiceClient) SetPreferredCurrency(ctx context.Context, req *connect.Request[v1.SetPreferredCurrencyRequest]) (*connect.Response[v1.SetPreferredCurrencyResponse], error) {
+	return c.setPreferredCurrency.CallUnary(ctx, req)
+}
+
+// CreateSpendAlert calls obiente.cloud.billing.v1.BillingService.CreateSpendAlert.
+func (c *billingServiceClient) CreateSpendAlert(ctx context.Context, req *connect.Request[v1.CreateSpendAlertRequest]) (*connect.Response[v1.CreateSpendAlertResponse], error) {
+	return c.createSpendAlert.CallUnary(ctx, req)
+}
+
+// DeleteSpendAlert calls obiente.cloud.billing.v1.BillingService.DeleteSpendAlert.
+func (c *billingServiceClient) DeleteSpendAlert(ctx context.Context, req *connect.Request[v1.DeleteSpendAlertRequest]) (*connect.Response[v1.DeleteSpendAlertResponse], error) {
+	return c.deleteSpendAlert.CallUnary(ctx, req)
+}
+
+// ListSpendAlerts calls obiente.cloud.billing.v1.BillingService.ListSpendAlerts.
+func (c *billingServiceClient) ListSpendAlerts(ctx context.Context, req *connect.Request[v1.ListSpendAlertsRequest]) (*connect.Response[v1.ListSpendAlertsResponse], error) {
+	return c.listSpendAlerts.CallUnary(ctx, req)
+}
+
+// GetSpendForecast calls obiente.cloud.billing.v1.BillingService.GetSpendForecast.
+func (c *billingServiceClient) GetSpendForecast(ctx context.Context, req *connect.Request[v1.GetSpendForecastRequest]) (*connect.Response[v1.GetSpendForecastResponse], error) {
+	return c.getSpendForecast.CallUnary(ctx, req)
+}
+
+// SubmitTaxExemptCertificate calls
+// obiente.cloud.billing.v1.BillingService.SubmitTaxExemptCertificate.
+func (c *billingServiceClient) SubmitTaxExemptCertificate(ctx context.Context, req *connect.Request[v1.SubmitTaxExemptCertificateRequest]) (*connect.Response[v1.SubmitTaxExemptCertificateResponse], error) {
+	return c.submitTaxExemptCertificate.CallUnary(ctx, req)
+}
+
+// VerifyTaxExemptCertificate calls
+// obiente.cloud.billing.v1.BillingService.VerifyTaxExemptCertificate.
+func (c *billingServiceClient) VerifyTaxExemptCertificate(ctx context.Context, req *connect.Request[v1.VerifyTaxExemptCertificateRequest]) (*connect.Response[v1.VerifyTaxExemptCertificateResponse], error) {
+	return c.verifyTaxExemptCertificate.CallUnary(ctx, req)
+}
+
+// RevokeTaxExemptCertificate calls
+// obiente.cloud.billing.v1.BillingService.RevokeTaxExemptCertificate.
+func (c *billingServiceClient) RevokeTaxExemptCertificate(ctx context.Context, req *connect.Request[v1.RevokeTaxExemptCertificateRequest]) (*connect.Response[v1.RevokeTaxExemptCertificateResponse], error) {
+	return c.revokeTaxExemptCertificate.CallUnary(ctx, req)
+}
+
 // BillingServiceHandler is an implementation of the obiente.cloud.billing.v1.BillingService
 // service.
 type BillingServiceHandler interface {
@@ -464,6 +740,39 @@ type BillingServiceHandler interface {
 	// Generate the current bill early (before billing date)
 	// This allows users to create and pay their current bill before their scheduled billing date
 	GenerateCurrentBill(context.Context, *connect.Request[v1.GenerateCurrentBillRequest]) (*connect.Response[v1.GenerateCurrentBillResponse], error)
+	// Download the generated invoice PDF for a month
+	// The PDF is streamed in chunks; filename and total_size are set on the first message
+	DownloadInvoice(context.Context, *connect.Request[v1.DownloadInvoiceRequest], *connect.ServerStream[v1.DownloadInvoiceResponse]) error
+	// Get how far an organization's failed invoice has escalated through dunning (superadmin only)
+	GetDunningState(context.Context, *connect.Request[v1.GetDunningStateRequest]) (*connect.Response[v1.GetDunningStateResponse], error)
+	// Clear an organization's dunning state and lift the restrictions it imposed (superadmin only)
+	ResetDunningState(context.Context, *connect.Request[v1.ResetDunningStateRequest]) (*connect.Response[v1.ResetDunningStateResponse], error)
+	// Create a referral code for the current user (at most 3 per 30 days)
+	CreateReferralCode(context.Context, *connect.Request[v1.CreateReferralCodeRequest]) (*connect.Response[v1.CreateReferralCodeResponse], error)
+	// Get the current user's most recent referral code
+	GetReferralCode(context.Context, *connect.Request[v1.GetReferralCodeRequest]) (*connect.Response[v1.GetReferralCodeResponse], error)
+	// Redeem a referral code as a newly signed-up user, crediting both the new user and the referrer
+	RedeemReferralCode(context.Context, *connect.Request[v1.RedeemReferralCodeRequest]) (*connect.Response[v1.RedeemReferralCodeResponse], error)
+	// Get an organization's credit balance, converted to its preferred currency
+	GetBalance(context.Context, *connect.Request[v1.GetBalanceRequest]) (*connect.Response[v1.GetBalanceResponse], error)
+	// List the currencies prices can be displayed in, with their current exchange rates
+	GetSupportedCurrencies(context.Context, *connect.Request[v1.GetSupportedCurrenciesRequest]) (*connect.Response[v1.GetSupportedCurrenciesResponse], error)
+	// Set the currency an organization's balance, bills and credit purchases are shown and charged in
+	SetPreferredCurrency(context.Context, *connect.Request[v1.SetPreferredCurrencyRequest]) (*connect.Response[v1.SetPreferredCurrencyResponse], error)
+	// Add a threshold on an organization's monthly spend that emails the billing contact or suspends the organization
+	CreateSpendAlert(context.Context, *connect.Request[v1.CreateSpendAlertRequest]) (*connect.Response[v1.CreateSpendAlertResponse], error)
+	// Remove one of an organization's spend alerts
+	DeleteSpendAlert(context.Context, *connect.Request[v1.DeleteSpendAlertRequest]) (*connect.Response[v1.DeleteSpendAlertResponse], error)
+	// List an organization's spend alerts with its spend so far this month
+	ListSpendAlerts(context.Context, *connect.Request[v1.ListSpendAlertsRequest]) (*connect.Response[v1.ListSpendAlertsResponse], error)
+	// Project an organization's metered spend over the coming days from its last 60 days of usage
+	GetSpendForecast(context.Context, *connect.Request[v1.GetSpendForecastRequest]) (*connect.Response[v1.GetSpendForecastResponse], error)
+	// Submit a tax-exempt certificate for a non-profit, educational or government organization
+	SubmitTaxExemptCertificate(context.Context, *connect.Request[v1.SubmitTaxExemptCertificateRequest]) (*connect.Response[v1.SubmitTaxExemptCertificateResponse], error)
+	// Verify a submitted tax-exempt certificate so the organization is no longer charged tax (superadmin only)
+	VerifyTaxExemptCertificate(context.Context, *connect.Request[v1.VerifyTaxExemptCertificateRequest]) (*connect.Response[v1.VerifyTaxExemptCertificateResponse], error)
+	// Revoke a tax-exempt certificate so the organization is charged tax again (superadmin only)
+	RevokeTaxExemptCertificate(context.Context, *connect.Request[v1.RevokeTaxExemptCertificateRequest]) (*connect.Response[v1.RevokeTaxExemptCertificateResponse], error)
 }
 
 // NewBillingServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -599,6 +908,102 @@ func NewBillingServiceHandler(svc BillingServiceHandler, opts ...connect.Handler
 		connect.WithSchema(billingServiceMethods.ByName("GenerateCurrentBill")),
 		connect.WithHandlerOptions(opts...),
 	)
+	billingServiceDownloadInvoiceHandler := connect.NewServerStreamHandler(
+		BillingServiceDownloadInvoiceProcedure,
+		svc.DownloadInvoice,
+		connect.WithSchema(billingServiceMethods.ByName("DownloadInvoice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceGetDunningStateHandler := connect.NewUnaryHandler(
+		BillingServiceGetDunningStateProcedure,
+		svc.GetDunningState,
+		connect.WithSchema(billingServiceMethods.ByName("GetDunningState")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceResetDunningStateHandler := connect.NewUnaryHandler(
+		BillingServiceResetDunningStateProcedure,
+		svc.ResetDunningState,
+		connect.WithSchema(billingServiceMethods.ByName("ResetDunningState")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceCreateReferralCodeHandler := connect.NewUnaryHandler(
+		BillingServiceCreateReferralCodeProcedure,
+		svc.CreateReferralCode,
+		connect.WithSchema(billingServiceMethods.ByName("CreateReferralCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceGetReferralCodeHandler := connect.NewUnaryHandler(
+		BillingServiceGetReferralCodeProcedure,
+		svc.GetReferralCode,
+		connect.WithSchema(billingServiceMethods.ByName("GetReferralCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceRedeemReferralCodeHandler := connect.NewUnaryHandler(
+		BillingServiceRedeemReferralCodeProcedure,
+		svc.RedeemReferralCode,
+		connect.WithSchema(billingServiceMethods.ByName("RedeemReferralCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceGetBalanceHandler := connect.NewUnaryHandler(
+		BillingServiceGetBalanceProcedure,
+		svc.GetBalance,
+		connect.WithSchema(billingServiceMethods.ByName("GetBalance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceGetSupportedCurrenciesHandler := connect.NewUnaryHandler(
+		BillingServiceGetSupportedCurrenciesProcedure,
+		svc.GetSupportedCurrencies,
+		connect.WithSchema(billingServiceMethods.ByName("GetSupportedCurrencies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceSetPreferredCurrencyHandler := connect.NewUnaryHandler(
+		BillingServiceSetPreferredCurrencyProcedure,
+		svc.SetPreferredCurrency,
+		connect.WithSchema(billingServiceMethods.ByName("SetPreferredCurrency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceCreateSpendAlertHandler := connect.NewUnaryHandler(
+		BillingServiceCreateSpendAlertProcedure,
+		svc.CreateSpendAlert,
+		connect.WithSchema(billingServiceMethods.ByName("CreateSpendAlert")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceDeleteSpendAlertHandler := connect.NewUnaryHandler(
+		BillingServiceDeleteSpendAlertProcedure,
+		svc.DeleteSpendAlert,
+		connect.WithSchema(billingServiceMethods.ByName("DeleteSpendAlert")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceListSpendAlertsHandler := connect.NewUnaryHandler(
+		BillingServiceListSpendAlertsProcedure,
+		svc.ListSpendAlerts,
+		connect.WithSchema(billingServiceMethods.ByName("ListSpendAlerts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceGetSpendForecastHandler := connect.NewUnaryHandler(
+		BillingServiceGetSpendForecastProcedure,
+		svc.GetSpendForecast,
+		connect.WithSchema(billingServiceMethods.ByName("GetSpendForecast")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceSubmitTaxExemptCertificateHandler := connect.NewUnaryHandler(
+		BillingServiceSubmitTaxExemptCertificateProcedure,
+		svc.SubmitTaxExemptCertificate,
+		connect.WithSchema(billingServiceMethods.ByName("SubmitTaxExemptCertificate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceVerifyTaxExemptCertificateHandler := connect.NewUnaryHandler(
+		BillingServiceVerifyTaxExemptCertificateProcedure,
+		svc.VerifyTaxExemptCertificate,
+		connect.WithSchema(billingServiceMethods.ByName("VerifyTaxExemptCertificate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceRevokeTaxExemptCertificateHandler := connect.NewUnaryHandler(
+		BillingServiceRevokeTaxExemptCertificateProcedure,
+		svc.RevokeTaxExemptCertificate,
+		connect.WithSchema(billingServiceMethods.ByName("RevokeTaxExemptCertificate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/obiente.cloud.billing.v1.BillingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BillingServiceCreateCheckoutSessionProcedure:
@@ -643,6 +1048,38 @@ func NewBillingServiceHandler(svc BillingServiceHandler, opts ...connect.Handler
 			billingServiceListBillsHandler.ServeHTTP(w, r)
 		case BillingServiceGenerateCurrentBillProcedure:
 			billingServiceGenerateCurrentBillHandler.ServeHTTP(w, r)
+		case BillingServiceDownloadInvoiceProcedure:
+			billingServiceDownloadInvoiceHandler.ServeHTTP(w, r)
+		case BillingServiceGetDunningStateProcedure:
+			billingServiceGetDunningStateHandler.ServeHTTP(w, r)
+		case BillingServiceResetDunningStateProcedure:
+			billingServiceResetDunningStateHandler.ServeHTTP(w, r)
+		case BillingServiceCreateReferralCodeProcedure:
+			billingServiceCreateReferralCodeHandler.ServeHTTP(w, r)
+		case BillingServiceGetReferralCodeProcedure:
+			billingServiceGetReferralCodeHandler.ServeHTTP(w, r)
+		case BillingServiceRedeemReferralCodeProcedure:
+			billingServiceRedeemReferralCodeHandler.ServeHTTP(w, r)
+		case BillingServiceGetBalanceProcedure:
+			billingServiceGetBalanceHandler.ServeHTTP(w, r)
+		case BillingServiceGetSupportedCurrenciesProcedure:
+			billingServiceGetSupportedCurrenciesHandler.ServeHTTP(w, r)
+		case BillingServiceSetPreferredCurrencyProcedure:
+			billingServiceSetPreferredCurrencyHandler.ServeHTTP(w, r)
+		case BillingServiceCreateSpendAlertProcedure:
+			billingServiceCreateSpendAlertHandler.ServeHTTP(w, r)
+		case BillingServiceDeleteSpendAlertProcedure:
+			billingServiceDeleteSpendAlertHandler.ServeHTTP(w, r)
+		case BillingServiceListSpendAlertsProcedure:
+			billingServiceListSpendAlertsHandler.ServeHTTP(w, r)
+		case BillingServiceGetSpendForecastProcedure:
+			billingServiceGetSpendForecastHandler.ServeHTTP(w, r)
+		case BillingServiceSubmitTaxExemptCertificateProcedure:
+			billingServiceSubmitTaxExemptCertificateHandler.ServeHTTP(w, r)
+		case BillingServiceVerifyTaxExemptCertificateProcedure:
+			billingServiceVerifyTaxExemptCertificateHandler.ServeHTTP(w, r)
+		case BillingServiceRevokeTaxExemptCertificateProcedure:
+			billingServiceRevokeTaxExemptCertificateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -735,3 +1172,67 @@ func (UnimplementedBillingServiceHandler) ListBills(context.Context, *connect.Re
 func (UnimplementedBillingServiceHandler) GenerateCurrentBill(context.Context, *connect.Request[v1.GenerateCurrentBillRequest]) (*connect.Response[v1.GenerateCurrentBillResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.GenerateCurrentBill is not implemented"))
 }
+
+func (UnimplementedBillingServiceHandler) DownloadInvoice(context.Context, *connect.Request[v1.DownloadInvoiceRequest], *connect.ServerStream[v1.DownloadInvoiceResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.DownloadInvoice is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) GetDunningState(context.Context, *connect.Request[v1.GetDunningStateRequest]) (*connect.Response[v1.GetDunningStateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.GetDunningState is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) ResetDunningState(context.Context, *connect.Request[v1.ResetDunningStateRequest]) (*connect.Response[v1.ResetDunningStateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.ResetDunningState is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) CreateReferralCode(context.Context, *connect.Request[v1.CreateReferralCodeRequest]) (*connect.Response[v1.CreateReferralCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.CreateReferralCode is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) GetReferralCode(context.Context, *connect.Request[v1.GetReferralCodeRequest]) (*connect.Response[v1.GetReferralCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.GetReferralCode is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) RedeemReferralCode(context.Context, *connect.Request[v1.RedeemReferralCodeRequest]) (*connect.Response[v1.RedeemReferralCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.RedeemReferralCode is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) GetBalance(context.Context, *connect.Request[v1.GetBalanceRequest]) (*connect.Response[v1.GetBalanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.GetBalance is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) GetSupportedCurrencies(context.Context, *connect.Request[v1.GetSupportedCurrenciesRequest]) (*connect.Response[v1.GetSupportedCurrenciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.GetSupportedCurrencies is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) SetPreferredCurrency(context.Context, *connect.Request[v1.SetPreferredCurrencyRequest]) (*connect.Response[v1.SetPreferredCurrencyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.SetPreferredCurrency is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) CreateSpendAlert(context.Context, *connect.Request[v1.CreateSpendAlertRequest]) (*connect.Response[v1.CreateSpendAlertResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.CreateSpendAlert is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) DeleteSpendAlert(context.Context, *connect.Request[v1.DeleteSpendAlertRequest]) (*connect.Response[v1.DeleteSpendAlertResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.DeleteSpendAlert is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) ListSpendAlerts(context.Context, *connect.Request[v1.ListSpendAlertsRequest]) (*connect.Response[v1.ListSpendAlertsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.ListSpendAlerts is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) GetSpendForecast(context.Context, *connect.Request[v1.GetSpendForecastRequest]) (*connect.Response[v1.GetSpendForecastResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.GetSpendForecast is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) SubmitTaxExemptCertificate(context.Context, *connect.Request[v1.SubmitTaxExemptCertificateRequest]) (*connect.Response[v1.SubmitTaxExemptCertificateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.SubmitTaxExemptCertificate is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) VerifyTaxExemptCertificate(context.Context, *connect.Request[v1.VerifyTaxExemptCertificateRequest]) (*connect.Response[v1.VerifyTaxExemptCertificateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.VerifyTaxExemptCertificate is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) RevokeTaxExemptCertificate(context.Context, *connect.Request[v1.RevokeTaxExemptCertificateRequest]) (*connect.Response[v1.RevokeTaxExemptCertificateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.billing.v1.BillingService.RevokeTaxExemptCertificate is not implemented"))
+}
@@ -443,8 +443,10 @@ type ListDeploymentsRequest struct {
 	Status         *DeploymentStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=obiente.cloud.deployments.v1.DeploymentStatus,oneof" json:"status,omitempty"`
 	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	PerPage        int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Only list deployments that carry every key=value tag
+	Tags          map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListDeploymentsRequest) Reset() {
@@ -505,6 +507,13 @@ func (x *ListDeploymentsRequest) GetPerPage() int32 {
 	return 0
 }
 
+func (x *ListDeploymentsRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type ListDeploymentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Deployments   []*Deployment          `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
@@ -563,6 +572,9 @@ type CreateDeploymentRequest struct {
 	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Environment    Environment            `protobuf:"varint,3,opt,name=environment,proto3,enum=obiente.cloud.deployments.v1.Environment" json:"environment,omitempty"` // Environment (production/staging/development)
 	Groups         []string               `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`                                                          // Optional groups/labels for organizing deployments
+	IsPreview      bool                   `protobuf:"varint,5,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty"`                                  // Create an ephemeral preview environment (used by pull request webhooks)
+	TeamId         *string                `protobuf:"bytes,6,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`                                      // Team to assign the deployment to; counts towards the team's quota
+	Regions        []string               `protobuf:"bytes,7,rep,name=regions,proto3" json:"regions,omitempty"`                                                        // Regions to run the deployment in; the first is the primary and the rest are replicas
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -625,9 +637,32 @@ func (x *CreateDeploymentRequest) GetGroups() []string {
 	return nil
 }
 
+func (x *CreateDeploymentRequest) GetIsPreview() bool {
+	if x != nil {
+		return x.IsPreview
+	}
+	return false
+}
+
+func (x *CreateDeploymentRequest) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
+func (x *CreateDeploymentRequest) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
 type CreateDeploymentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Deployment    *Deployment               `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	TraefikIps    []string                  `protobuf:"bytes,2,rep,name=traefik_ips,json=traefikIps,proto3" json:"traefik_ips,omitempty"` // Traefik IPs of the regions the deployment was provisioned in
+	Regions       []*DeploymentRegionStatus `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty"`                         // Per-region result of a multi-region create
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -669,6 +704,20 @@ func (x *CreateDeploymentResponse) GetDeployment() *Deployment {
 	return nil
 }
 
+func (x *CreateDeploymentResponse) GetTraefikIps() []string {
+	if x != nil {
+		return x.TraefikIps
+	}
+	return nil
+}
+
+func (x *CreateDeploymentResponse) GetRegions() []*DeploymentRegionStatus {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
 type GetDeploymentRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -800,6 +849,8 @@ type UpdateDeploymentRequest struct {
 	BuildArgs                 map[string]string       `protobuf:"bytes,30,rep,name=build_args,json=buildArgs,proto3" json:"build_args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`  // Docker build args for Dockerfile deployments
 	DockerfileVolumes         []*DockerfileVolume     `protobuf:"bytes,31,rep,name=dockerfile_volumes,json=dockerfileVolumes,proto3" json:"dockerfile_volumes,omitempty"`                                                    // Persistent volume mounts for Dockerfile deployments
 	DockerfileBuildOptions    *DockerfileBuildOptions `protobuf:"bytes,32,opt,name=dockerfile_build_options,json=dockerfileBuildOptions,proto3,oneof" json:"dockerfile_build_options,omitempty"`                             // Additional Docker build options for Dockerfile deployments
+	Regions                   []string                `protobuf:"bytes,33,rep,name=regions,proto3" json:"regions,omitempty"`                                                                                                 // Regions of a multi-region deployment to re-provision with the update; others are left as they are
+	ZeroDowntimeDeploy        *bool                   `protobuf:"varint,34,opt,name=zero_downtime_deploy,json=zeroDowntimeDeploy,proto3,oneof" json:"zero_downtime_deploy,omitempty"`                                        // Drain in-flight requests from the old containers on redeploys instead of stopping them at the cut-over
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
 }
@@ -1058,6 +1109,20 @@ func (x *UpdateDeploymentRequest) GetDockerfileBuildOptions() *DockerfileBuildOp
 	return nil
 }
 
+func (x *UpdateDeploymentRequest) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *UpdateDeploymentRequest) GetZeroDowntimeDeploy() bool {
+	if x != nil && x.ZeroDowntimeDeploy != nil {
+		return *x.ZeroDowntimeDeploy
+	}
+	return false
+}
+
 type UpdateDeploymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
@@ -2034,29 +2099,29 @@ func (x *RestartDeploymentResponse) GetDeployment() *Deployment {
 	return nil
 }
 
-type ScaleDeploymentRequest struct {
+type RollbackDeploymentRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Replicas       int32                  `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Version        *int32                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // Version number to roll back to (see ListDeploymentVersions)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ScaleDeploymentRequest) Reset() {
-	*x = ScaleDeploymentRequest{}
+func (x *RollbackDeploymentRequest) Reset() {
+	*x = RollbackDeploymentRequest{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScaleDeploymentRequest) String() string {
+func (x *RollbackDeploymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScaleDeploymentRequest) ProtoMessage() {}
+func (*RollbackDeploymentRequest) ProtoMessage() {}
 
-func (x *ScaleDeploymentRequest) ProtoReflect() protoreflect.Message {
+func (x *RollbackDeploymentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2068,53 +2133,54 @@ func (x *ScaleDeploymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScaleDeploymentRequest.ProtoReflect.Descriptor instead.
-func (*ScaleDeploymentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*RollbackDeploymentRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ScaleDeploymentRequest) GetOrganizationId() string {
+func (x *RollbackDeploymentRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ScaleDeploymentRequest) GetDeploymentId() string {
+func (x *RollbackDeploymentRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *ScaleDeploymentRequest) GetReplicas() int32 {
-	if x != nil {
-		return x.Replicas
+func (x *RollbackDeploymentRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return 0
 }
 
-type ScaleDeploymentResponse struct {
+type RollbackDeploymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	Version       *DeploymentVersion     `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty"` // Version that is now active (set when rolling back to a version)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScaleDeploymentResponse) Reset() {
-	*x = ScaleDeploymentResponse{}
+func (x *RollbackDeploymentResponse) Reset() {
+	*x = RollbackDeploymentResponse{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScaleDeploymentResponse) String() string {
+func (x *RollbackDeploymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScaleDeploymentResponse) ProtoMessage() {}
+func (*RollbackDeploymentResponse) ProtoMessage() {}
 
-func (x *ScaleDeploymentResponse) ProtoReflect() protoreflect.Message {
+func (x *RollbackDeploymentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2126,40 +2192,50 @@ func (x *ScaleDeploymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScaleDeploymentResponse.ProtoReflect.Descriptor instead.
-func (*ScaleDeploymentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*RollbackDeploymentResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ScaleDeploymentResponse) GetDeployment() *Deployment {
+func (x *RollbackDeploymentResponse) GetDeployment() *Deployment {
 	if x != nil {
 		return x.Deployment
 	}
 	return nil
 }
 
-type GetDeploymentEnvVarsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *RollbackDeploymentResponse) GetVersion() *DeploymentVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
 }
 
-func (x *GetDeploymentEnvVarsRequest) Reset() {
-	*x = GetDeploymentEnvVarsRequest{}
+type DeploymentVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VersionNumber int32                  `protobuf:"varint,1,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`                                  // Image pinned by digest
+	EnvVarsHash   string                 `protobuf:"bytes,3,opt,name=env_vars_hash,json=envVarsHash,proto3" json:"env_vars_hash,omitempty"` // SHA-256 of the environment variables
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`                               // Version the deployment currently runs
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeploymentVersion) Reset() {
+	*x = DeploymentVersion{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeploymentEnvVarsRequest) String() string {
+func (x *DeploymentVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentEnvVarsRequest) ProtoMessage() {}
+func (*DeploymentVersion) ProtoMessage() {}
 
-func (x *GetDeploymentEnvVarsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeploymentVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2171,95 +2247,69 @@ func (x *GetDeploymentEnvVarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentEnvVarsRequest.ProtoReflect.Descriptor instead.
-func (*GetDeploymentEnvVarsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeploymentVersion.ProtoReflect.Descriptor instead.
+func (*DeploymentVersion) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetDeploymentEnvVarsRequest) GetOrganizationId() string {
+func (x *DeploymentVersion) GetVersionNumber() int32 {
 	if x != nil {
-		return x.OrganizationId
+		return x.VersionNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *GetDeploymentEnvVarsRequest) GetDeploymentId() string {
+func (x *DeploymentVersion) GetImage() string {
 	if x != nil {
-		return x.DeploymentId
+		return x.Image
 	}
 	return ""
 }
 
-type GetDeploymentEnvVarsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Raw .env file content (preserves comments and formatting)
-	EnvFileContent string `protobuf:"bytes,1,opt,name=env_file_content,json=envFileContent,proto3" json:"env_file_content,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetDeploymentEnvVarsResponse) Reset() {
-	*x = GetDeploymentEnvVarsResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetDeploymentEnvVarsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetDeploymentEnvVarsResponse) ProtoMessage() {}
-
-func (x *GetDeploymentEnvVarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[28]
+func (x *DeploymentVersion) GetEnvVarsHash() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EnvVarsHash
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetDeploymentEnvVarsResponse.ProtoReflect.Descriptor instead.
-func (*GetDeploymentEnvVarsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{28}
+func (x *DeploymentVersion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
 }
 
-func (x *GetDeploymentEnvVarsResponse) GetEnvFileContent() string {
+func (x *DeploymentVersion) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EnvFileContent
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-type UpdateDeploymentEnvVarsRequest struct {
+type ListDeploymentVersionsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	// Raw .env file content (preserves comments and formatting)
-	EnvFileContent string `protobuf:"bytes,3,opt,name=env_file_content,json=envFileContent,proto3" json:"env_file_content,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateDeploymentEnvVarsRequest) Reset() {
-	*x = UpdateDeploymentEnvVarsRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[29]
+func (x *ListDeploymentVersionsRequest) Reset() {
+	*x = ListDeploymentVersionsRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDeploymentEnvVarsRequest) String() string {
+func (x *ListDeploymentVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeploymentEnvVarsRequest) ProtoMessage() {}
+func (*ListDeploymentVersionsRequest) ProtoMessage() {}
 
-func (x *UpdateDeploymentEnvVarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[29]
+func (x *ListDeploymentVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2270,54 +2320,47 @@ func (x *UpdateDeploymentEnvVarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeploymentEnvVarsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDeploymentEnvVarsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListDeploymentVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeploymentVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UpdateDeploymentEnvVarsRequest) GetOrganizationId() string {
+func (x *ListDeploymentVersionsRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *UpdateDeploymentEnvVarsRequest) GetDeploymentId() string {
+func (x *ListDeploymentVersionsRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *UpdateDeploymentEnvVarsRequest) GetEnvFileContent() string {
-	if x != nil {
-		return x.EnvFileContent
-	}
-	return ""
-}
-
-type UpdateDeploymentEnvVarsResponse struct {
+type ListDeploymentVersionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	Versions      []*DeploymentVersion   `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"` // Newest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDeploymentEnvVarsResponse) Reset() {
-	*x = UpdateDeploymentEnvVarsResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[30]
+func (x *ListDeploymentVersionsResponse) Reset() {
+	*x = ListDeploymentVersionsResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDeploymentEnvVarsResponse) String() string {
+func (x *ListDeploymentVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeploymentEnvVarsResponse) ProtoMessage() {}
+func (*ListDeploymentVersionsResponse) ProtoMessage() {}
 
-func (x *UpdateDeploymentEnvVarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[30]
+func (x *ListDeploymentVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2328,41 +2371,42 @@ func (x *UpdateDeploymentEnvVarsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeploymentEnvVarsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDeploymentEnvVarsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ListDeploymentVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeploymentVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdateDeploymentEnvVarsResponse) GetDeployment() *Deployment {
+func (x *ListDeploymentVersionsResponse) GetVersions() []*DeploymentVersion {
 	if x != nil {
-		return x.Deployment
+		return x.Versions
 	}
 	return nil
 }
 
-type GetDeploymentComposeRequest struct {
+type ScaleDeploymentRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Replicas       int32                  `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetDeploymentComposeRequest) Reset() {
-	*x = GetDeploymentComposeRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[31]
+func (x *ScaleDeploymentRequest) Reset() {
+	*x = ScaleDeploymentRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeploymentComposeRequest) String() string {
+func (x *ScaleDeploymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentComposeRequest) ProtoMessage() {}
+func (*ScaleDeploymentRequest) ProtoMessage() {}
 
-func (x *GetDeploymentComposeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[31]
+func (x *ScaleDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2373,47 +2417,54 @@ func (x *GetDeploymentComposeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentComposeRequest.ProtoReflect.Descriptor instead.
-func (*GetDeploymentComposeRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ScaleDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*ScaleDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetDeploymentComposeRequest) GetOrganizationId() string {
+func (x *ScaleDeploymentRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GetDeploymentComposeRequest) GetDeploymentId() string {
+func (x *ScaleDeploymentRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-type GetDeploymentComposeResponse struct {
+func (x *ScaleDeploymentRequest) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+type ScaleDeploymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ComposeYaml   string                 `protobuf:"bytes,1,opt,name=compose_yaml,json=composeYaml,proto3" json:"compose_yaml,omitempty"`
+	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeploymentComposeResponse) Reset() {
-	*x = GetDeploymentComposeResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[32]
+func (x *ScaleDeploymentResponse) Reset() {
+	*x = ScaleDeploymentResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeploymentComposeResponse) String() string {
+func (x *ScaleDeploymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentComposeResponse) ProtoMessage() {}
+func (*ScaleDeploymentResponse) ProtoMessage() {}
 
-func (x *GetDeploymentComposeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[32]
+func (x *ScaleDeploymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2424,42 +2475,43 @@ func (x *GetDeploymentComposeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentComposeResponse.ProtoReflect.Descriptor instead.
-func (*GetDeploymentComposeResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ScaleDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*ScaleDeploymentResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetDeploymentComposeResponse) GetComposeYaml() string {
+func (x *ScaleDeploymentResponse) GetDeployment() *Deployment {
 	if x != nil {
-		return x.ComposeYaml
+		return x.Deployment
 	}
-	return ""
+	return nil
 }
 
-type ValidateDeploymentComposeRequest struct {
+type SetResourceLimitsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	ComposeYaml    string                 `protobuf:"bytes,3,opt,name=compose_yaml,json=composeYaml,proto3" json:"compose_yaml,omitempty"`
+	CpuLimit       float64                `protobuf:"fixed64,3,opt,name=cpu_limit,json=cpuLimit,proto3" json:"cpu_limit,omitempty"`         // CPU limit in cores, at least 0.1 and at most the plan's CPU cores
+	MemoryLimit    int64                  `protobuf:"varint,4,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"` // Memory limit in MB, at least 128 and at most the plan's memory
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ValidateDeploymentComposeRequest) Reset() {
-	*x = ValidateDeploymentComposeRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[33]
+func (x *SetResourceLimitsRequest) Reset() {
+	*x = SetResourceLimitsRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateDeploymentComposeRequest) String() string {
+func (x *SetResourceLimitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateDeploymentComposeRequest) ProtoMessage() {}
+func (*SetResourceLimitsRequest) ProtoMessage() {}
 
-func (x *ValidateDeploymentComposeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[33]
+func (x *SetResourceLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2470,55 +2522,61 @@ func (x *ValidateDeploymentComposeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateDeploymentComposeRequest.ProtoReflect.Descriptor instead.
-func (*ValidateDeploymentComposeRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use SetResourceLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetResourceLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ValidateDeploymentComposeRequest) GetOrganizationId() string {
+func (x *SetResourceLimitsRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ValidateDeploymentComposeRequest) GetDeploymentId() string {
+func (x *SetResourceLimitsRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *ValidateDeploymentComposeRequest) GetComposeYaml() string {
+func (x *SetResourceLimitsRequest) GetCpuLimit() float64 {
 	if x != nil {
-		return x.ComposeYaml
+		return x.CpuLimit
 	}
-	return ""
+	return 0
 }
 
-type ValidateDeploymentComposeResponse struct {
-	state            protoimpl.MessageState    `protogen:"open.v1"`
-	ValidationErrors []*ComposeValidationError `protobuf:"bytes,1,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
-	ValidationError  *string                   `protobuf:"bytes,2,opt,name=validation_error,json=validationError,proto3,oneof" json:"validation_error,omitempty"` // Deprecated: Use validation_errors instead
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *SetResourceLimitsRequest) GetMemoryLimit() int64 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
 }
 
-func (x *ValidateDeploymentComposeResponse) Reset() {
-	*x = ValidateDeploymentComposeResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[34]
+type SetResourceLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResourceLimitsResponse) Reset() {
+	*x = SetResourceLimitsResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateDeploymentComposeResponse) String() string {
+func (x *SetResourceLimitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateDeploymentComposeResponse) ProtoMessage() {}
+func (*SetResourceLimitsResponse) ProtoMessage() {}
 
-func (x *ValidateDeploymentComposeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[34]
+func (x *SetResourceLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2529,49 +2587,41 @@ func (x *ValidateDeploymentComposeResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateDeploymentComposeResponse.ProtoReflect.Descriptor instead.
-func (*ValidateDeploymentComposeResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use SetResourceLimitsResponse.ProtoReflect.Descriptor instead.
+func (*SetResourceLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ValidateDeploymentComposeResponse) GetValidationErrors() []*ComposeValidationError {
+func (x *SetResourceLimitsResponse) GetDeployment() *Deployment {
 	if x != nil {
-		return x.ValidationErrors
+		return x.Deployment
 	}
 	return nil
 }
 
-func (x *ValidateDeploymentComposeResponse) GetValidationError() string {
-	if x != nil && x.ValidationError != nil {
-		return *x.ValidationError
-	}
-	return ""
-}
-
-type UpdateDeploymentComposeRequest struct {
+type GetDeploymentEnvVarsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	ComposeYaml    string                 `protobuf:"bytes,3,opt,name=compose_yaml,json=composeYaml,proto3" json:"compose_yaml,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateDeploymentComposeRequest) Reset() {
-	*x = UpdateDeploymentComposeRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[35]
+func (x *GetDeploymentEnvVarsRequest) Reset() {
+	*x = GetDeploymentEnvVarsRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDeploymentComposeRequest) String() string {
+func (x *GetDeploymentEnvVarsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeploymentComposeRequest) ProtoMessage() {}
+func (*GetDeploymentEnvVarsRequest) ProtoMessage() {}
 
-func (x *UpdateDeploymentComposeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[35]
+func (x *GetDeploymentEnvVarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2582,56 +2632,48 @@ func (x *UpdateDeploymentComposeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeploymentComposeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDeploymentComposeRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetDeploymentEnvVarsRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentEnvVarsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UpdateDeploymentComposeRequest) GetOrganizationId() string {
+func (x *GetDeploymentEnvVarsRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *UpdateDeploymentComposeRequest) GetDeploymentId() string {
+func (x *GetDeploymentEnvVarsRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *UpdateDeploymentComposeRequest) GetComposeYaml() string {
-	if x != nil {
-		return x.ComposeYaml
-	}
-	return ""
-}
-
-type UpdateDeploymentComposeResponse struct {
-	state            protoimpl.MessageState    `protogen:"open.v1"`
-	Deployment       *Deployment               `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
-	ValidationError  *string                   `protobuf:"bytes,2,opt,name=validation_error,json=validationError,proto3,oneof" json:"validation_error,omitempty"` // Deprecated: Use validation_errors instead
-	ValidationErrors []*ComposeValidationError `protobuf:"bytes,3,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GetDeploymentEnvVarsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Raw .env file content (preserves comments and formatting)
+	EnvFileContent string `protobuf:"bytes,1,opt,name=env_file_content,json=envFileContent,proto3" json:"env_file_content,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateDeploymentComposeResponse) Reset() {
-	*x = UpdateDeploymentComposeResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[36]
+func (x *GetDeploymentEnvVarsResponse) Reset() {
+	*x = GetDeploymentEnvVarsResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDeploymentComposeResponse) String() string {
+func (x *GetDeploymentEnvVarsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeploymentComposeResponse) ProtoMessage() {}
+func (*GetDeploymentEnvVarsResponse) ProtoMessage() {}
 
-func (x *UpdateDeploymentComposeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[36]
+func (x *GetDeploymentEnvVarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2642,61 +2684,43 @@ func (x *UpdateDeploymentComposeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeploymentComposeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDeploymentComposeResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetDeploymentEnvVarsResponse.ProtoReflect.Descriptor instead.
+func (*GetDeploymentEnvVarsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *UpdateDeploymentComposeResponse) GetDeployment() *Deployment {
+func (x *GetDeploymentEnvVarsResponse) GetEnvFileContent() string {
 	if x != nil {
-		return x.Deployment
-	}
-	return nil
-}
-
-func (x *UpdateDeploymentComposeResponse) GetValidationError() string {
-	if x != nil && x.ValidationError != nil {
-		return *x.ValidationError
+		return x.EnvFileContent
 	}
 	return ""
 }
 
-func (x *UpdateDeploymentComposeResponse) GetValidationErrors() []*ComposeValidationError {
-	if x != nil {
-		return x.ValidationErrors
-	}
-	return nil
-}
-
-type ComposeValidationError struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`     // 1-based line number
-	Column        int32                  `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"` // 1-based column number
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`                           // "error" or "warning"
-	StartLine     int32                  `protobuf:"varint,5,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`       // Start line for multi-line errors
-	EndLine       int32                  `protobuf:"varint,6,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`             // End line for multi-line errors
-	StartColumn   int32                  `protobuf:"varint,7,opt,name=start_column,json=startColumn,proto3" json:"start_column,omitempty"` // Start column
-	EndColumn     int32                  `protobuf:"varint,8,opt,name=end_column,json=endColumn,proto3" json:"end_column,omitempty"`       // End column
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateDeploymentEnvVarsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	// Raw .env file content (preserves comments and formatting)
+	EnvFileContent string `protobuf:"bytes,3,opt,name=env_file_content,json=envFileContent,proto3" json:"env_file_content,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ComposeValidationError) Reset() {
-	*x = ComposeValidationError{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[37]
+func (x *UpdateDeploymentEnvVarsRequest) Reset() {
+	*x = UpdateDeploymentEnvVarsRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ComposeValidationError) String() string {
+func (x *UpdateDeploymentEnvVarsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComposeValidationError) ProtoMessage() {}
+func (*UpdateDeploymentEnvVarsRequest) ProtoMessage() {}
 
-func (x *ComposeValidationError) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[37]
+func (x *UpdateDeploymentEnvVarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2707,91 +2731,97 @@ func (x *ComposeValidationError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComposeValidationError.ProtoReflect.Descriptor instead.
-func (*ComposeValidationError) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use UpdateDeploymentEnvVarsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeploymentEnvVarsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ComposeValidationError) GetLine() int32 {
+func (x *UpdateDeploymentEnvVarsRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Line
+		return x.OrganizationId
 	}
-	return 0
+	return ""
 }
 
-func (x *ComposeValidationError) GetColumn() int32 {
+func (x *UpdateDeploymentEnvVarsRequest) GetDeploymentId() string {
 	if x != nil {
-		return x.Column
+		return x.DeploymentId
 	}
-	return 0
+	return ""
 }
 
-func (x *ComposeValidationError) GetMessage() string {
+func (x *UpdateDeploymentEnvVarsRequest) GetEnvFileContent() string {
 	if x != nil {
-		return x.Message
+		return x.EnvFileContent
 	}
 	return ""
 }
 
-func (x *ComposeValidationError) GetSeverity() string {
-	if x != nil {
-		return x.Severity
-	}
-	return ""
+type UpdateDeploymentEnvVarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ComposeValidationError) GetStartLine() int32 {
-	if x != nil {
-		return x.StartLine
-	}
-	return 0
+func (x *UpdateDeploymentEnvVarsResponse) Reset() {
+	*x = UpdateDeploymentEnvVarsResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ComposeValidationError) GetEndLine() int32 {
-	if x != nil {
-		return x.EndLine
-	}
-	return 0
+func (x *UpdateDeploymentEnvVarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ComposeValidationError) GetStartColumn() int32 {
+func (*UpdateDeploymentEnvVarsResponse) ProtoMessage() {}
+
+func (x *UpdateDeploymentEnvVarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[37]
 	if x != nil {
-		return x.StartColumn
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ComposeValidationError) GetEndColumn() int32 {
+// Deprecated: Use UpdateDeploymentEnvVarsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDeploymentEnvVarsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateDeploymentEnvVarsResponse) GetDeployment() *Deployment {
 	if x != nil {
-		return x.EndColumn
+		return x.Deployment
 	}
-	return 0
+	return nil
 }
 
-type ListGitHubReposRequest struct {
+type RotateEnvKeyRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization ID to use for GitHub token (optional, will use user token if not provided)
-	IntegrationId  string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`    // Optional: use specific GitHub integration ID
-	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage        int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListGitHubReposRequest) Reset() {
-	*x = ListGitHubReposRequest{}
+func (x *RotateEnvKeyRequest) Reset() {
+	*x = RotateEnvKeyRequest{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGitHubReposRequest) String() string {
+func (x *RotateEnvKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGitHubReposRequest) ProtoMessage() {}
+func (*RotateEnvKeyRequest) ProtoMessage() {}
 
-func (x *ListGitHubReposRequest) ProtoReflect() protoreflect.Message {
+func (x *RotateEnvKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2803,66 +2833,40 @@ func (x *ListGitHubReposRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGitHubReposRequest.ProtoReflect.Descriptor instead.
-func (*ListGitHubReposRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RotateEnvKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateEnvKeyRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListGitHubReposRequest) GetOrganizationId() string {
+func (x *RotateEnvKeyRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ListGitHubReposRequest) GetIntegrationId() string {
-	if x != nil {
-		return x.IntegrationId
-	}
-	return ""
+type RotateEnvKeyResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	KeyId                  string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	DeploymentsReencrypted int32                  `protobuf:"varint,2,opt,name=deployments_reencrypted,json=deploymentsReencrypted,proto3" json:"deployments_reencrypted,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *ListGitHubReposRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListGitHubReposRequest) GetPerPage() int32 {
-	if x != nil {
-		return x.PerPage
-	}
-	return 0
-}
-
-type GitHubRepo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
-	IsPrivate     bool                   `protobuf:"varint,6,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
-	DefaultBranch string                 `protobuf:"bytes,7,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GitHubRepo) Reset() {
-	*x = GitHubRepo{}
+func (x *RotateEnvKeyResponse) Reset() {
+	*x = RotateEnvKeyResponse{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitHubRepo) String() string {
+func (x *RotateEnvKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitHubRepo) ProtoMessage() {}
+func (*RotateEnvKeyResponse) ProtoMessage() {}
 
-func (x *GitHubRepo) ProtoReflect() protoreflect.Message {
+func (x *RotateEnvKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2874,82 +2878,47 @@ func (x *GitHubRepo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitHubRepo.ProtoReflect.Descriptor instead.
-func (*GitHubRepo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RotateEnvKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateEnvKeyResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GitHubRepo) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *GitHubRepo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GitHubRepo) GetFullName() string {
-	if x != nil {
-		return x.FullName
-	}
-	return ""
-}
-
-func (x *GitHubRepo) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *GitHubRepo) GetUrl() string {
+func (x *RotateEnvKeyResponse) GetKeyId() string {
 	if x != nil {
-		return x.Url
+		return x.KeyId
 	}
 	return ""
 }
 
-func (x *GitHubRepo) GetIsPrivate() bool {
-	if x != nil {
-		return x.IsPrivate
-	}
-	return false
-}
-
-func (x *GitHubRepo) GetDefaultBranch() string {
+func (x *RotateEnvKeyResponse) GetDeploymentsReencrypted() int32 {
 	if x != nil {
-		return x.DefaultBranch
+		return x.DeploymentsReencrypted
 	}
-	return ""
+	return 0
 }
 
-type ListGitHubReposResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repos         []*GitHubRepo          `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetDeploymentComposeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListGitHubReposResponse) Reset() {
-	*x = ListGitHubReposResponse{}
+func (x *GetDeploymentComposeRequest) Reset() {
+	*x = GetDeploymentComposeRequest{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGitHubReposResponse) String() string {
+func (x *GetDeploymentComposeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGitHubReposResponse) ProtoMessage() {}
+func (*GetDeploymentComposeRequest) ProtoMessage() {}
 
-func (x *ListGitHubReposResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDeploymentComposeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2961,48 +2930,46 @@ func (x *ListGitHubReposResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGitHubReposResponse.ProtoReflect.Descriptor instead.
-func (*ListGitHubReposResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDeploymentComposeRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentComposeRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ListGitHubReposResponse) GetRepos() []*GitHubRepo {
+func (x *GetDeploymentComposeRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Repos
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListGitHubReposResponse) GetTotal() int32 {
+func (x *GetDeploymentComposeRequest) GetDeploymentId() string {
 	if x != nil {
-		return x.Total
+		return x.DeploymentId
 	}
-	return 0
+	return ""
 }
 
-type GetGitHubBranchesRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization ID to use for GitHub token (optional, will use user token if not provided)
-	IntegrationId  string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`    // Optional: use specific GitHub integration ID
-	RepoFullName   string                 `protobuf:"bytes,3,opt,name=repo_full_name,json=repoFullName,proto3" json:"repo_full_name,omitempty"`     // e.g., "owner/repo"
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetDeploymentComposeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComposeYaml   string                 `protobuf:"bytes,1,opt,name=compose_yaml,json=composeYaml,proto3" json:"compose_yaml,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGitHubBranchesRequest) Reset() {
-	*x = GetGitHubBranchesRequest{}
+func (x *GetDeploymentComposeResponse) Reset() {
+	*x = GetDeploymentComposeResponse{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGitHubBranchesRequest) String() string {
+func (x *GetDeploymentComposeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGitHubBranchesRequest) ProtoMessage() {}
+func (*GetDeploymentComposeResponse) ProtoMessage() {}
 
-func (x *GetGitHubBranchesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDeploymentComposeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3014,55 +2981,41 @@ func (x *GetGitHubBranchesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGitHubBranchesRequest.ProtoReflect.Descriptor instead.
-func (*GetGitHubBranchesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDeploymentComposeResponse.ProtoReflect.Descriptor instead.
+func (*GetDeploymentComposeResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetGitHubBranchesRequest) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
-}
-
-func (x *GetGitHubBranchesRequest) GetIntegrationId() string {
-	if x != nil {
-		return x.IntegrationId
-	}
-	return ""
-}
-
-func (x *GetGitHubBranchesRequest) GetRepoFullName() string {
+func (x *GetDeploymentComposeResponse) GetComposeYaml() string {
 	if x != nil {
-		return x.RepoFullName
+		return x.ComposeYaml
 	}
 	return ""
 }
 
-type GitHubBranch struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	IsDefault     bool                   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	Sha           string                 `protobuf:"bytes,3,opt,name=sha,proto3" json:"sha,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ValidateDeploymentComposeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	ComposeYaml    string                 `protobuf:"bytes,3,opt,name=compose_yaml,json=composeYaml,proto3" json:"compose_yaml,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GitHubBranch) Reset() {
-	*x = GitHubBranch{}
+func (x *ValidateDeploymentComposeRequest) Reset() {
+	*x = ValidateDeploymentComposeRequest{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitHubBranch) String() string {
+func (x *ValidateDeploymentComposeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitHubBranch) ProtoMessage() {}
+func (*ValidateDeploymentComposeRequest) ProtoMessage() {}
 
-func (x *GitHubBranch) ProtoReflect() protoreflect.Message {
+func (x *ValidateDeploymentComposeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3074,53 +3027,54 @@ func (x *GitHubBranch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitHubBranch.ProtoReflect.Descriptor instead.
-func (*GitHubBranch) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateDeploymentComposeRequest.ProtoReflect.Descriptor instead.
+func (*ValidateDeploymentComposeRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GitHubBranch) GetName() string {
+func (x *ValidateDeploymentComposeRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Name
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GitHubBranch) GetIsDefault() bool {
+func (x *ValidateDeploymentComposeRequest) GetDeploymentId() string {
 	if x != nil {
-		return x.IsDefault
+		return x.DeploymentId
 	}
-	return false
+	return ""
 }
 
-func (x *GitHubBranch) GetSha() string {
+func (x *ValidateDeploymentComposeRequest) GetComposeYaml() string {
 	if x != nil {
-		return x.Sha
+		return x.ComposeYaml
 	}
 	return ""
 }
 
-type GetGitHubBranchesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Branches      []*GitHubBranch        `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ValidateDeploymentComposeResponse struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	ValidationErrors []*ComposeValidationError `protobuf:"bytes,1,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
+	ValidationError  *string                   `protobuf:"bytes,2,opt,name=validation_error,json=validationError,proto3,oneof" json:"validation_error,omitempty"` // Deprecated: Use validation_errors instead
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetGitHubBranchesResponse) Reset() {
-	*x = GetGitHubBranchesResponse{}
+func (x *ValidateDeploymentComposeResponse) Reset() {
+	*x = ValidateDeploymentComposeResponse{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGitHubBranchesResponse) String() string {
+func (x *ValidateDeploymentComposeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGitHubBranchesResponse) ProtoMessage() {}
+func (*ValidateDeploymentComposeResponse) ProtoMessage() {}
 
-func (x *GetGitHubBranchesResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidateDeploymentComposeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3132,43 +3086,48 @@ func (x *GetGitHubBranchesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGitHubBranchesResponse.ProtoReflect.Descriptor instead.
-func (*GetGitHubBranchesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateDeploymentComposeResponse.ProtoReflect.Descriptor instead.
+func (*ValidateDeploymentComposeResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetGitHubBranchesResponse) GetBranches() []*GitHubBranch {
+func (x *ValidateDeploymentComposeResponse) GetValidationErrors() []*ComposeValidationError {
 	if x != nil {
-		return x.Branches
+		return x.ValidationErrors
 	}
 	return nil
 }
 
-type GetGitHubFileRequest struct {
+func (x *ValidateDeploymentComposeResponse) GetValidationError() string {
+	if x != nil && x.ValidationError != nil {
+		return *x.ValidationError
+	}
+	return ""
+}
+
+type UpdateDeploymentComposeRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization ID to use for GitHub token (optional, will use user token if not provided)
-	IntegrationId  string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`    // Optional: use specific GitHub integration ID
-	RepoFullName   string                 `protobuf:"bytes,3,opt,name=repo_full_name,json=repoFullName,proto3" json:"repo_full_name,omitempty"`     // e.g., "owner/repo"
-	Branch         string                 `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
-	Path           string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"` // e.g., "docker-compose.yml"
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	ComposeYaml    string                 `protobuf:"bytes,3,opt,name=compose_yaml,json=composeYaml,proto3" json:"compose_yaml,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGitHubFileRequest) Reset() {
-	*x = GetGitHubFileRequest{}
+func (x *UpdateDeploymentComposeRequest) Reset() {
+	*x = UpdateDeploymentComposeRequest{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGitHubFileRequest) String() string {
+func (x *UpdateDeploymentComposeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGitHubFileRequest) ProtoMessage() {}
+func (*UpdateDeploymentComposeRequest) ProtoMessage() {}
 
-func (x *GetGitHubFileRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateDeploymentComposeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3180,69 +3139,55 @@ func (x *GetGitHubFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGitHubFileRequest.ProtoReflect.Descriptor instead.
-func (*GetGitHubFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDeploymentComposeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeploymentComposeRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetGitHubFileRequest) GetOrganizationId() string {
+func (x *UpdateDeploymentComposeRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GetGitHubFileRequest) GetIntegrationId() string {
-	if x != nil {
-		return x.IntegrationId
-	}
-	return ""
-}
-
-func (x *GetGitHubFileRequest) GetRepoFullName() string {
-	if x != nil {
-		return x.RepoFullName
-	}
-	return ""
-}
-
-func (x *GetGitHubFileRequest) GetBranch() string {
+func (x *UpdateDeploymentComposeRequest) GetDeploymentId() string {
 	if x != nil {
-		return x.Branch
+		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *GetGitHubFileRequest) GetPath() string {
+func (x *UpdateDeploymentComposeRequest) GetComposeYaml() string {
 	if x != nil {
-		return x.Path
+		return x.ComposeYaml
 	}
 	return ""
 }
 
-type GetGitHubFileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Encoding      string                 `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"` // "base64" or "text"
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateDeploymentComposeResponse struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	Deployment       *Deployment               `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	ValidationError  *string                   `protobuf:"bytes,2,opt,name=validation_error,json=validationError,proto3,oneof" json:"validation_error,omitempty"` // Deprecated: Use validation_errors instead
+	ValidationErrors []*ComposeValidationError `protobuf:"bytes,3,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetGitHubFileResponse) Reset() {
-	*x = GetGitHubFileResponse{}
+func (x *UpdateDeploymentComposeResponse) Reset() {
+	*x = UpdateDeploymentComposeResponse{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGitHubFileResponse) String() string {
+func (x *UpdateDeploymentComposeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGitHubFileResponse) ProtoMessage() {}
+func (*UpdateDeploymentComposeResponse) ProtoMessage() {}
 
-func (x *GetGitHubFileResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateDeploymentComposeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3254,53 +3199,60 @@ func (x *GetGitHubFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGitHubFileResponse.ProtoReflect.Descriptor instead.
-func (*GetGitHubFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDeploymentComposeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDeploymentComposeResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetGitHubFileResponse) GetContent() string {
+func (x *UpdateDeploymentComposeResponse) GetDeployment() *Deployment {
 	if x != nil {
-		return x.Content
+		return x.Deployment
 	}
-	return ""
+	return nil
 }
 
-func (x *GetGitHubFileResponse) GetEncoding() string {
-	if x != nil {
-		return x.Encoding
+func (x *UpdateDeploymentComposeResponse) GetValidationError() string {
+	if x != nil && x.ValidationError != nil {
+		return *x.ValidationError
 	}
 	return ""
 }
 
-func (x *GetGitHubFileResponse) GetSize() int64 {
+func (x *UpdateDeploymentComposeResponse) GetValidationErrors() []*ComposeValidationError {
 	if x != nil {
-		return x.Size
+		return x.ValidationErrors
 	}
-	return 0
+	return nil
 }
 
-type ListAvailableGitHubIntegrationsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional: filter integrations for this organization
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ComposeValidationError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`     // 1-based line number
+	Column        int32                  `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"` // 1-based column number
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`                           // "error" or "warning"
+	StartLine     int32                  `protobuf:"varint,5,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`       // Start line for multi-line errors
+	EndLine       int32                  `protobuf:"varint,6,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`             // End line for multi-line errors
+	StartColumn   int32                  `protobuf:"varint,7,opt,name=start_column,json=startColumn,proto3" json:"start_column,omitempty"` // Start column
+	EndColumn     int32                  `protobuf:"varint,8,opt,name=end_column,json=endColumn,proto3" json:"end_column,omitempty"`       // End column
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAvailableGitHubIntegrationsRequest) Reset() {
-	*x = ListAvailableGitHubIntegrationsRequest{}
+func (x *ComposeValidationError) Reset() {
+	*x = ComposeValidationError{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAvailableGitHubIntegrationsRequest) String() string {
+func (x *ComposeValidationError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAvailableGitHubIntegrationsRequest) ProtoMessage() {}
+func (*ComposeValidationError) ProtoMessage() {}
 
-func (x *ListAvailableGitHubIntegrationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ComposeValidationError) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3312,148 +3264,92 @@ func (x *ListAvailableGitHubIntegrationsRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAvailableGitHubIntegrationsRequest.ProtoReflect.Descriptor instead.
-func (*ListAvailableGitHubIntegrationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ComposeValidationError.ProtoReflect.Descriptor instead.
+func (*ComposeValidationError) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListAvailableGitHubIntegrationsRequest) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
-}
-
-type GitHubIntegrationOption struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username                string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	IsUser                  bool                   `protobuf:"varint,3,opt,name=is_user,json=isUser,proto3" json:"is_user,omitempty"`                          // true if user integration, false if organization
-	ObienteOrgId            string                 `protobuf:"bytes,4,opt,name=obiente_org_id,json=obienteOrgId,proto3" json:"obiente_org_id,omitempty"`       // Obiente cloud organization ID (only set if is_user is false)
-	ObienteOrgName          string                 `protobuf:"bytes,5,opt,name=obiente_org_name,json=obienteOrgName,proto3" json:"obiente_org_name,omitempty"` // Obiente cloud organization name (only set if is_user is false)
-	AuthType                string                 `protobuf:"bytes,6,opt,name=auth_type,json=authType,proto3" json:"auth_type,omitempty"`                     // github_app
-	GithubAppInstallationId int64                  `protobuf:"varint,7,opt,name=github_app_installation_id,json=githubAppInstallationId,proto3" json:"github_app_installation_id,omitempty"`
-	GithubAppAccountLogin   string                 `protobuf:"bytes,8,opt,name=github_app_account_login,json=githubAppAccountLogin,proto3" json:"github_app_account_login,omitempty"`
-	GithubAppAccountType    string                 `protobuf:"bytes,9,opt,name=github_app_account_type,json=githubAppAccountType,proto3" json:"github_app_account_type,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
-}
-
-func (x *GitHubIntegrationOption) Reset() {
-	*x = GitHubIntegrationOption{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[47]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GitHubIntegrationOption) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GitHubIntegrationOption) ProtoMessage() {}
-
-func (x *GitHubIntegrationOption) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[47]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GitHubIntegrationOption.ProtoReflect.Descriptor instead.
-func (*GitHubIntegrationOption) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *GitHubIntegrationOption) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *GitHubIntegrationOption) GetUsername() string {
+func (x *ComposeValidationError) GetLine() int32 {
 	if x != nil {
-		return x.Username
+		return x.Line
 	}
-	return ""
+	return 0
 }
 
-func (x *GitHubIntegrationOption) GetIsUser() bool {
+func (x *ComposeValidationError) GetColumn() int32 {
 	if x != nil {
-		return x.IsUser
+		return x.Column
 	}
-	return false
+	return 0
 }
 
-func (x *GitHubIntegrationOption) GetObienteOrgId() string {
+func (x *ComposeValidationError) GetMessage() string {
 	if x != nil {
-		return x.ObienteOrgId
+		return x.Message
 	}
 	return ""
 }
 
-func (x *GitHubIntegrationOption) GetObienteOrgName() string {
+func (x *ComposeValidationError) GetSeverity() string {
 	if x != nil {
-		return x.ObienteOrgName
+		return x.Severity
 	}
 	return ""
 }
 
-func (x *GitHubIntegrationOption) GetAuthType() string {
+func (x *ComposeValidationError) GetStartLine() int32 {
 	if x != nil {
-		return x.AuthType
+		return x.StartLine
 	}
-	return ""
+	return 0
 }
 
-func (x *GitHubIntegrationOption) GetGithubAppInstallationId() int64 {
+func (x *ComposeValidationError) GetEndLine() int32 {
 	if x != nil {
-		return x.GithubAppInstallationId
+		return x.EndLine
 	}
 	return 0
 }
 
-func (x *GitHubIntegrationOption) GetGithubAppAccountLogin() string {
+func (x *ComposeValidationError) GetStartColumn() int32 {
 	if x != nil {
-		return x.GithubAppAccountLogin
+		return x.StartColumn
 	}
-	return ""
+	return 0
 }
 
-func (x *GitHubIntegrationOption) GetGithubAppAccountType() string {
+func (x *ComposeValidationError) GetEndColumn() int32 {
 	if x != nil {
-		return x.GithubAppAccountType
+		return x.EndColumn
 	}
-	return ""
+	return 0
 }
 
-type ListAvailableGitHubIntegrationsResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Integrations  []*GitHubIntegrationOption `protobuf:"bytes,1,rep,name=integrations,proto3" json:"integrations,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListGitHubReposRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization ID to use for GitHub token (optional, will use user token if not provided)
+	IntegrationId  string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`    // Optional: use specific GitHub integration ID
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage        int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListAvailableGitHubIntegrationsResponse) Reset() {
-	*x = ListAvailableGitHubIntegrationsResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[48]
+func (x *ListGitHubReposRequest) Reset() {
+	*x = ListGitHubReposRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAvailableGitHubIntegrationsResponse) String() string {
+func (x *ListGitHubReposRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAvailableGitHubIntegrationsResponse) ProtoMessage() {}
+func (*ListGitHubReposRequest) ProtoMessage() {}
 
-func (x *ListAvailableGitHubIntegrationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[48]
+func (x *ListGitHubReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3464,43 +3360,67 @@ func (x *ListAvailableGitHubIntegrationsResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAvailableGitHubIntegrationsResponse.ProtoReflect.Descriptor instead.
-func (*ListAvailableGitHubIntegrationsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ListGitHubReposRequest.ProtoReflect.Descriptor instead.
+func (*ListGitHubReposRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListAvailableGitHubIntegrationsResponse) GetIntegrations() []*GitHubIntegrationOption {
+func (x *ListGitHubReposRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Integrations
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-type StreamTerminalOutputRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Cols           int32                  `protobuf:"varint,3,opt,name=cols,proto3" json:"cols,omitempty"` // Terminal width
-	Rows           int32                  `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"` // Terminal height
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ListGitHubReposRequest) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
 }
 
-func (x *StreamTerminalOutputRequest) Reset() {
-	*x = StreamTerminalOutputRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[49]
+func (x *ListGitHubReposRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGitHubReposRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GitHubRepo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	IsPrivate     bool                   `protobuf:"varint,6,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	DefaultBranch string                 `protobuf:"bytes,7,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitHubRepo) Reset() {
+	*x = GitHubRepo{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamTerminalOutputRequest) String() string {
+func (x *GitHubRepo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamTerminalOutputRequest) ProtoMessage() {}
+func (*GitHubRepo) ProtoMessage() {}
 
-func (x *StreamTerminalOutputRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[49]
+func (x *GitHubRepo) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3511,64 +3431,135 @@ func (x *StreamTerminalOutputRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamTerminalOutputRequest.ProtoReflect.Descriptor instead.
-func (*StreamTerminalOutputRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use GitHubRepo.ProtoReflect.Descriptor instead.
+func (*GitHubRepo) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *StreamTerminalOutputRequest) GetOrganizationId() string {
+func (x *GitHubRepo) GetId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *StreamTerminalOutputRequest) GetDeploymentId() string {
+func (x *GitHubRepo) GetName() string {
 	if x != nil {
-		return x.DeploymentId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *StreamTerminalOutputRequest) GetCols() int32 {
+func (x *GitHubRepo) GetFullName() string {
 	if x != nil {
-		return x.Cols
+		return x.FullName
 	}
-	return 0
+	return ""
 }
 
-func (x *StreamTerminalOutputRequest) GetRows() int32 {
+func (x *GitHubRepo) GetDescription() string {
 	if x != nil {
-		return x.Rows
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GitHubRepo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GitHubRepo) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *GitHubRepo) GetDefaultBranch() string {
+	if x != nil {
+		return x.DefaultBranch
+	}
+	return ""
+}
+
+type ListGitHubReposResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repos         []*GitHubRepo          `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGitHubReposResponse) Reset() {
+	*x = ListGitHubReposResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGitHubReposResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGitHubReposResponse) ProtoMessage() {}
+
+func (x *ListGitHubReposResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGitHubReposResponse.ProtoReflect.Descriptor instead.
+func (*ListGitHubReposResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListGitHubReposResponse) GetRepos() []*GitHubRepo {
+	if x != nil {
+		return x.Repos
+	}
+	return nil
+}
+
+func (x *ListGitHubReposResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-type SendTerminalInputRequest struct {
+type GetGitHubBranchesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Input          []byte                 `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"` // User keyboard input
-	Cols           int32                  `protobuf:"varint,4,opt,name=cols,proto3" json:"cols,omitempty"`  // Terminal width (for resize)
-	Rows           int32                  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`  // Terminal height (for resize)
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization ID to use for GitHub token (optional, will use user token if not provided)
+	IntegrationId  string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`    // Optional: use specific GitHub integration ID
+	RepoFullName   string                 `protobuf:"bytes,3,opt,name=repo_full_name,json=repoFullName,proto3" json:"repo_full_name,omitempty"`     // e.g., "owner/repo"
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SendTerminalInputRequest) Reset() {
-	*x = SendTerminalInputRequest{}
+func (x *GetGitHubBranchesRequest) Reset() {
+	*x = GetGitHubBranchesRequest{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendTerminalInputRequest) String() string {
+func (x *GetGitHubBranchesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendTerminalInputRequest) ProtoMessage() {}
+func (*GetGitHubBranchesRequest) ProtoMessage() {}
 
-func (x *SendTerminalInputRequest) ProtoReflect() protoreflect.Message {
+func (x *GetGitHubBranchesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3580,67 +3571,55 @@ func (x *SendTerminalInputRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendTerminalInputRequest.ProtoReflect.Descriptor instead.
-func (*SendTerminalInputRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGitHubBranchesRequest.ProtoReflect.Descriptor instead.
+func (*GetGitHubBranchesRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *SendTerminalInputRequest) GetOrganizationId() string {
+func (x *GetGitHubBranchesRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *SendTerminalInputRequest) GetDeploymentId() string {
+func (x *GetGitHubBranchesRequest) GetIntegrationId() string {
 	if x != nil {
-		return x.DeploymentId
+		return x.IntegrationId
 	}
 	return ""
 }
 
-func (x *SendTerminalInputRequest) GetInput() []byte {
-	if x != nil {
-		return x.Input
-	}
-	return nil
-}
-
-func (x *SendTerminalInputRequest) GetCols() int32 {
-	if x != nil {
-		return x.Cols
-	}
-	return 0
-}
-
-func (x *SendTerminalInputRequest) GetRows() int32 {
+func (x *GetGitHubBranchesRequest) GetRepoFullName() string {
 	if x != nil {
-		return x.Rows
+		return x.RepoFullName
 	}
-	return 0
+	return ""
 }
 
-type SendTerminalInputResponse struct {
+type GitHubBranch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Sha           string                 `protobuf:"bytes,3,opt,name=sha,proto3" json:"sha,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendTerminalInputResponse) Reset() {
-	*x = SendTerminalInputResponse{}
+func (x *GitHubBranch) Reset() {
+	*x = GitHubBranch{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendTerminalInputResponse) String() string {
+func (x *GitHubBranch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendTerminalInputResponse) ProtoMessage() {}
+func (*GitHubBranch) ProtoMessage() {}
 
-func (x *SendTerminalInputResponse) ProtoReflect() protoreflect.Message {
+func (x *GitHubBranch) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3652,45 +3631,53 @@ func (x *SendTerminalInputResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendTerminalInputResponse.ProtoReflect.Descriptor instead.
-func (*SendTerminalInputResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GitHubBranch.ProtoReflect.Descriptor instead.
+func (*GitHubBranch) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *SendTerminalInputResponse) GetSuccess() bool {
+func (x *GitHubBranch) GetName() string {
 	if x != nil {
-		return x.Success
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GitHubBranch) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
 	}
 	return false
 }
 
-type TerminalInput struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	ContainerId    string                 `protobuf:"bytes,6,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"` // Optional: specific container ID to connect to
-	ServiceName    string                 `protobuf:"bytes,7,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"` // Optional: service name to connect to (for Docker Compose)
-	Input          []byte                 `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`                                // User keyboard input
-	Cols           int32                  `protobuf:"varint,4,opt,name=cols,proto3" json:"cols,omitempty"`                                 // Terminal width (for resize/initial setup)
-	Rows           int32                  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`                                 // Terminal height (for resize/initial setup)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GitHubBranch) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
 }
 
-func (x *TerminalInput) Reset() {
-	*x = TerminalInput{}
+type GetGitHubBranchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Branches      []*GitHubBranch        `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGitHubBranchesResponse) Reset() {
+	*x = GetGitHubBranchesResponse{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TerminalInput) String() string {
+func (x *GetGitHubBranchesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TerminalInput) ProtoMessage() {}
+func (*GetGitHubBranchesResponse) ProtoMessage() {}
 
-func (x *TerminalInput) ProtoReflect() protoreflect.Message {
+func (x *GetGitHubBranchesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3702,82 +3689,43 @@ func (x *TerminalInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TerminalInput.ProtoReflect.Descriptor instead.
-func (*TerminalInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGitHubBranchesResponse.ProtoReflect.Descriptor instead.
+func (*GetGitHubBranchesResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *TerminalInput) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
-}
-
-func (x *TerminalInput) GetDeploymentId() string {
-	if x != nil {
-		return x.DeploymentId
-	}
-	return ""
-}
-
-func (x *TerminalInput) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
-}
-
-func (x *TerminalInput) GetServiceName() string {
-	if x != nil {
-		return x.ServiceName
-	}
-	return ""
-}
-
-func (x *TerminalInput) GetInput() []byte {
+func (x *GetGitHubBranchesResponse) GetBranches() []*GitHubBranch {
 	if x != nil {
-		return x.Input
+		return x.Branches
 	}
 	return nil
 }
 
-func (x *TerminalInput) GetCols() int32 {
-	if x != nil {
-		return x.Cols
-	}
-	return 0
-}
-
-func (x *TerminalInput) GetRows() int32 {
-	if x != nil {
-		return x.Rows
-	}
-	return 0
-}
-
-type TerminalOutput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Output        []byte                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"` // Terminal stdout/stderr
-	Exit          bool                   `protobuf:"varint,2,opt,name=exit,proto3" json:"exit,omitempty"`    // Whether the terminal session has ended
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetGitHubFileRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization ID to use for GitHub token (optional, will use user token if not provided)
+	IntegrationId  string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`    // Optional: use specific GitHub integration ID
+	RepoFullName   string                 `protobuf:"bytes,3,opt,name=repo_full_name,json=repoFullName,proto3" json:"repo_full_name,omitempty"`     // e.g., "owner/repo"
+	Branch         string                 `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+	Path           string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"` // e.g., "docker-compose.yml"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *TerminalOutput) Reset() {
-	*x = TerminalOutput{}
+func (x *GetGitHubFileRequest) Reset() {
+	*x = GetGitHubFileRequest{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TerminalOutput) String() string {
+func (x *GetGitHubFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TerminalOutput) ProtoMessage() {}
+func (*GetGitHubFileRequest) ProtoMessage() {}
 
-func (x *TerminalOutput) ProtoReflect() protoreflect.Message {
+func (x *GetGitHubFileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3789,49 +3737,69 @@ func (x *TerminalOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TerminalOutput.ProtoReflect.Descriptor instead.
-func (*TerminalOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGitHubFileRequest.ProtoReflect.Descriptor instead.
+func (*GetGitHubFileRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *TerminalOutput) GetOutput() []byte {
+func (x *GetGitHubFileRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Output
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *TerminalOutput) GetExit() bool {
+func (x *GetGitHubFileRequest) GetIntegrationId() string {
 	if x != nil {
-		return x.Exit
+		return x.IntegrationId
 	}
-	return false
+	return ""
 }
 
-type VolumeInfo struct {
+func (x *GetGitHubFileRequest) GetRepoFullName() string {
+	if x != nil {
+		return x.RepoFullName
+	}
+	return ""
+}
+
+func (x *GetGitHubFileRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *GetGitHubFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetGitHubFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // Volume name
-	MountPoint    string                 `protobuf:"bytes,2,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`        // Mount point inside container (e.g., "/data")
-	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                                  // Volume source path on host
-	IsPersistent  bool                   `protobuf:"varint,4,opt,name=is_persistent,json=isPersistent,proto3" json:"is_persistent,omitempty"` // Whether this is a persistent volume
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Encoding      string                 `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"` // "base64" or "text"
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VolumeInfo) Reset() {
-	*x = VolumeInfo{}
+func (x *GetGitHubFileResponse) Reset() {
+	*x = GetGitHubFileResponse{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolumeInfo) String() string {
+func (x *GetGitHubFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeInfo) ProtoMessage() {}
+func (*GetGitHubFileResponse) ProtoMessage() {}
 
-func (x *VolumeInfo) ProtoReflect() protoreflect.Message {
+func (x *GetGitHubFileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3843,68 +3811,53 @@ func (x *VolumeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeInfo.ProtoReflect.Descriptor instead.
-func (*VolumeInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGitHubFileResponse.ProtoReflect.Descriptor instead.
+func (*GetGitHubFileResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *VolumeInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *VolumeInfo) GetMountPoint() string {
+func (x *GetGitHubFileResponse) GetContent() string {
 	if x != nil {
-		return x.MountPoint
+		return x.Content
 	}
 	return ""
 }
 
-func (x *VolumeInfo) GetSource() string {
+func (x *GetGitHubFileResponse) GetEncoding() string {
 	if x != nil {
-		return x.Source
+		return x.Encoding
 	}
 	return ""
 }
 
-func (x *VolumeInfo) GetIsPersistent() bool {
+func (x *GetGitHubFileResponse) GetSize() int64 {
 	if x != nil {
-		return x.IsPersistent
+		return x.Size
 	}
-	return false
+	return 0
 }
 
-type ListContainerFilesRequest struct {
+type ListAvailableGitHubIntegrationsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Path           string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`                                     // Directory path (default: "/")
-	VolumeName     *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"` // If specified, list files from this volume instead of container filesystem
-	ListVolumes    bool                   `protobuf:"varint,5,opt,name=list_volumes,json=listVolumes,proto3" json:"list_volumes,omitempty"`   // If true, return list of available volumes instead of files
-	Cursor         *string                `protobuf:"bytes,6,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	PageSize       *int32                 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	ContainerId    *string                `protobuf:"bytes,8,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"` // Optional: use a specific container. If not specified, uses first container.
-	ServiceName    *string                `protobuf:"bytes,9,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"` // Optional: use a specific service. If container_id is specified, this is ignored.
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional: filter integrations for this organization
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListContainerFilesRequest) Reset() {
-	*x = ListContainerFilesRequest{}
+func (x *ListAvailableGitHubIntegrationsRequest) Reset() {
+	*x = ListAvailableGitHubIntegrationsRequest{}
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListContainerFilesRequest) String() string {
+func (x *ListAvailableGitHubIntegrationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContainerFilesRequest) ProtoMessage() {}
+func (*ListAvailableGitHubIntegrationsRequest) ProtoMessage() {}
 
-func (x *ListContainerFilesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListAvailableGitHubIntegrationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3916,109 +3869,148 @@ func (x *ListContainerFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContainerFilesRequest.ProtoReflect.Descriptor instead.
-func (*ListContainerFilesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAvailableGitHubIntegrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListAvailableGitHubIntegrationsRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ListContainerFilesRequest) GetOrganizationId() string {
+func (x *ListAvailableGitHubIntegrationsRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ListContainerFilesRequest) GetDeploymentId() string {
+type GitHubIntegrationOption struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username                string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsUser                  bool                   `protobuf:"varint,3,opt,name=is_user,json=isUser,proto3" json:"is_user,omitempty"`                          // true if user integration, false if organization
+	ObienteOrgId            string                 `protobuf:"bytes,4,opt,name=obiente_org_id,json=obienteOrgId,proto3" json:"obiente_org_id,omitempty"`       // Obiente cloud organization ID (only set if is_user is false)
+	ObienteOrgName          string                 `protobuf:"bytes,5,opt,name=obiente_org_name,json=obienteOrgName,proto3" json:"obiente_org_name,omitempty"` // Obiente cloud organization name (only set if is_user is false)
+	AuthType                string                 `protobuf:"bytes,6,opt,name=auth_type,json=authType,proto3" json:"auth_type,omitempty"`                     // github_app
+	GithubAppInstallationId int64                  `protobuf:"varint,7,opt,name=github_app_installation_id,json=githubAppInstallationId,proto3" json:"github_app_installation_id,omitempty"`
+	GithubAppAccountLogin   string                 `protobuf:"bytes,8,opt,name=github_app_account_login,json=githubAppAccountLogin,proto3" json:"github_app_account_login,omitempty"`
+	GithubAppAccountType    string                 `protobuf:"bytes,9,opt,name=github_app_account_type,json=githubAppAccountType,proto3" json:"github_app_account_type,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GitHubIntegrationOption) Reset() {
+	*x = GitHubIntegrationOption{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubIntegrationOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubIntegrationOption) ProtoMessage() {}
+
+func (x *GitHubIntegrationOption) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[56]
 	if x != nil {
-		return x.DeploymentId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListContainerFilesRequest) GetPath() string {
+// Deprecated: Use GitHubIntegrationOption.ProtoReflect.Descriptor instead.
+func (*GitHubIntegrationOption) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GitHubIntegrationOption) GetId() string {
 	if x != nil {
-		return x.Path
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListContainerFilesRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
+func (x *GitHubIntegrationOption) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-func (x *ListContainerFilesRequest) GetListVolumes() bool {
+func (x *GitHubIntegrationOption) GetIsUser() bool {
 	if x != nil {
-		return x.ListVolumes
+		return x.IsUser
 	}
 	return false
 }
 
-func (x *ListContainerFilesRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *GitHubIntegrationOption) GetObienteOrgId() string {
+	if x != nil {
+		return x.ObienteOrgId
 	}
 	return ""
 }
 
-func (x *ListContainerFilesRequest) GetPageSize() int32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
+func (x *GitHubIntegrationOption) GetObienteOrgName() string {
+	if x != nil {
+		return x.ObienteOrgName
 	}
-	return 0
+	return ""
 }
 
-func (x *ListContainerFilesRequest) GetContainerId() string {
-	if x != nil && x.ContainerId != nil {
-		return *x.ContainerId
-	}
+func (x *GitHubIntegrationOption) GetAuthType() string {
+	if x != nil {
+		return x.AuthType
+	}
 	return ""
 }
 
-func (x *ListContainerFilesRequest) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+func (x *GitHubIntegrationOption) GetGithubAppInstallationId() int64 {
+	if x != nil {
+		return x.GithubAppInstallationId
+	}
+	return 0
+}
+
+func (x *GitHubIntegrationOption) GetGithubAppAccountLogin() string {
+	if x != nil {
+		return x.GithubAppAccountLogin
 	}
 	return ""
 }
 
-type ContainerFile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	IsDirectory   bool                   `protobuf:"varint,3,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
-	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	Permissions   string                 `protobuf:"bytes,5,opt,name=permissions,proto3" json:"permissions,omitempty"`
-	VolumeName    *string                `protobuf:"bytes,6,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"` // If this file is in a volume, the volume name
-	Owner         *string                `protobuf:"bytes,7,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
-	Group         *string                `protobuf:"bytes,8,opt,name=group,proto3,oneof" json:"group,omitempty"`
-	ModeOctal     *uint32                `protobuf:"varint,9,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
-	IsSymlink     *bool                  `protobuf:"varint,10,opt,name=is_symlink,json=isSymlink,proto3,oneof" json:"is_symlink,omitempty"`
-	SymlinkTarget *string                `protobuf:"bytes,11,opt,name=symlink_target,json=symlinkTarget,proto3,oneof" json:"symlink_target,omitempty"`
-	MimeType      *string                `protobuf:"bytes,12,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
-	ModifiedTime  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=modified_time,json=modifiedTime,proto3,oneof" json:"modified_time,omitempty"`
-	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_time,json=createdTime,proto3,oneof" json:"created_time,omitempty"`
+func (x *GitHubIntegrationOption) GetGithubAppAccountType() string {
+	if x != nil {
+		return x.GithubAppAccountType
+	}
+	return ""
+}
+
+type ListAvailableGitHubIntegrationsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Integrations  []*GitHubIntegrationOption `protobuf:"bytes,1,rep,name=integrations,proto3" json:"integrations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerFile) Reset() {
-	*x = ContainerFile{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[56]
+func (x *ListAvailableGitHubIntegrationsResponse) Reset() {
+	*x = ListAvailableGitHubIntegrationsResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerFile) String() string {
+func (x *ListAvailableGitHubIntegrationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerFile) ProtoMessage() {}
+func (*ListAvailableGitHubIntegrationsResponse) ProtoMessage() {}
 
-func (x *ContainerFile) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[56]
+func (x *ListAvailableGitHubIntegrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4029,137 +4021,2798 @@ func (x *ContainerFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerFile.ProtoReflect.Descriptor instead.
-func (*ContainerFile) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ListAvailableGitHubIntegrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListAvailableGitHubIntegrationsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ContainerFile) GetName() string {
+func (x *ListAvailableGitHubIntegrationsResponse) GetIntegrations() []*GitHubIntegrationOption {
 	if x != nil {
-		return x.Name
+		return x.Integrations
 	}
-	return ""
+	return nil
 }
 
-func (x *ContainerFile) GetPath() string {
+type RotateDeployKeyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional: organization that owns the integration
+	IntegrationId  string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RotateDeployKeyRequest) Reset() {
+	*x = RotateDeployKeyRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateDeployKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateDeployKeyRequest) ProtoMessage() {}
+
+func (x *RotateDeployKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[58]
 	if x != nil {
-		return x.Path
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateDeployKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateDeployKeyRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RotateDeployKeyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ContainerFile) GetIsDirectory() bool {
+func (x *RotateDeployKeyRequest) GetIntegrationId() string {
 	if x != nil {
-		return x.IsDirectory
+		return x.IntegrationId
 	}
-	return false
+	return ""
 }
 
-func (x *ContainerFile) GetSize() int64 {
+type GitHubDeployKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    string                 `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`                // owner/repo
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // OpenSSH authorized_keys format
+	GithubKeyId   int64                  `protobuf:"varint,3,opt,name=github_key_id,json=githubKeyId,proto3" json:"github_key_id,omitempty"`
+	RotatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitHubDeployKey) Reset() {
+	*x = GitHubDeployKey{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubDeployKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubDeployKey) ProtoMessage() {}
+
+func (x *GitHubDeployKey) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[59]
 	if x != nil {
-		return x.Size
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ContainerFile) GetPermissions() string {
+// Deprecated: Use GitHubDeployKey.ProtoReflect.Descriptor instead.
+func (*GitHubDeployKey) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GitHubDeployKey) GetRepository() string {
 	if x != nil {
-		return x.Permissions
+		return x.Repository
 	}
 	return ""
 }
 
-func (x *ContainerFile) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
+func (x *GitHubDeployKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
 	}
 	return ""
 }
 
-func (x *ContainerFile) GetOwner() string {
-	if x != nil && x.Owner != nil {
-		return *x.Owner
+func (x *GitHubDeployKey) GetGithubKeyId() int64 {
+	if x != nil {
+		return x.GithubKeyId
 	}
-	return ""
+	return 0
 }
 
-func (x *ContainerFile) GetGroup() string {
-	if x != nil && x.Group != nil {
-		return *x.Group
+func (x *GitHubDeployKey) GetRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RotatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ContainerFile) GetModeOctal() uint32 {
-	if x != nil && x.ModeOctal != nil {
-		return *x.ModeOctal
+type RotateDeployKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeployKeys    []*GitHubDeployKey     `protobuf:"bytes,1,rep,name=deploy_keys,json=deployKeys,proto3" json:"deploy_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateDeployKeyResponse) Reset() {
+	*x = RotateDeployKeyResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateDeployKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateDeployKeyResponse) ProtoMessage() {}
+
+func (x *RotateDeployKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ContainerFile) GetIsSymlink() bool {
-	if x != nil && x.IsSymlink != nil {
-		return *x.IsSymlink
+// Deprecated: Use RotateDeployKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateDeployKeyResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RotateDeployKeyResponse) GetDeployKeys() []*GitHubDeployKey {
+	if x != nil {
+		return x.DeployKeys
 	}
-	return false
+	return nil
 }
 
-func (x *ContainerFile) GetSymlinkTarget() string {
-	if x != nil && x.SymlinkTarget != nil {
-		return *x.SymlinkTarget
+type RotateWebhookSecretRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Optional: organization that owns the integration
+	IntegrationId  string                 `protobuf:"bytes,2,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RotateWebhookSecretRequest) Reset() {
+	*x = RotateWebhookSecretRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateWebhookSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateWebhookSecretRequest) ProtoMessage() {}
+
+func (x *RotateWebhookSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateWebhookSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateWebhookSecretRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RotateWebhookSecretRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ContainerFile) GetMimeType() string {
-	if x != nil && x.MimeType != nil {
-		return *x.MimeType
+func (x *RotateWebhookSecretRequest) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+type RotateWebhookSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repositories  []string               `protobuf:"bytes,1,rep,name=repositories,proto3" json:"repositories,omitempty"` // Repositories whose webhook secret was replaced
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateWebhookSecretResponse) Reset() {
+	*x = RotateWebhookSecretResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateWebhookSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateWebhookSecretResponse) ProtoMessage() {}
+
+func (x *RotateWebhookSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateWebhookSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateWebhookSecretResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RotateWebhookSecretResponse) GetRepositories() []string {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+type StreamTerminalOutputRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Cols           int32                  `protobuf:"varint,3,opt,name=cols,proto3" json:"cols,omitempty"` // Terminal width
+	Rows           int32                  `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"` // Terminal height
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamTerminalOutputRequest) Reset() {
+	*x = StreamTerminalOutputRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTerminalOutputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTerminalOutputRequest) ProtoMessage() {}
+
+func (x *StreamTerminalOutputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTerminalOutputRequest.ProtoReflect.Descriptor instead.
+func (*StreamTerminalOutputRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *StreamTerminalOutputRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *StreamTerminalOutputRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *StreamTerminalOutputRequest) GetCols() int32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *StreamTerminalOutputRequest) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type SendTerminalInputRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Input          []byte                 `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"` // User keyboard input
+	Cols           int32                  `protobuf:"varint,4,opt,name=cols,proto3" json:"cols,omitempty"`  // Terminal width (for resize)
+	Rows           int32                  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`  // Terminal height (for resize)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SendTerminalInputRequest) Reset() {
+	*x = SendTerminalInputRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTerminalInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTerminalInputRequest) ProtoMessage() {}
+
+func (x *SendTerminalInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTerminalInputRequest.ProtoReflect.Descriptor instead.
+func (*SendTerminalInputRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SendTerminalInputRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SendTerminalInputRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *SendTerminalInputRequest) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *SendTerminalInputRequest) GetCols() int32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *SendTerminalInputRequest) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type SendTerminalInputResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTerminalInputResponse) Reset() {
+	*x = SendTerminalInputResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTerminalInputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTerminalInputResponse) ProtoMessage() {}
+
+func (x *SendTerminalInputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTerminalInputResponse.ProtoReflect.Descriptor instead.
+func (*SendTerminalInputResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SendTerminalInputResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type TerminalInput struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	ContainerId    string                 `protobuf:"bytes,6,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"` // Optional: specific container ID to connect to
+	ServiceName    string                 `protobuf:"bytes,7,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"` // Optional: service name to connect to (for Docker Compose)
+	Input          []byte                 `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`                                // User keyboard input
+	Cols           int32                  `protobuf:"varint,4,opt,name=cols,proto3" json:"cols,omitempty"`                                 // Terminal width (for resize/initial setup)
+	Rows           int32                  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`                                 // Terminal height (for resize/initial setup)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TerminalInput) Reset() {
+	*x = TerminalInput{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalInput) ProtoMessage() {}
+
+func (x *TerminalInput) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalInput.ProtoReflect.Descriptor instead.
+func (*TerminalInput) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *TerminalInput) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *TerminalInput) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *TerminalInput) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *TerminalInput) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *TerminalInput) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *TerminalInput) GetCols() int32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *TerminalInput) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type TerminalOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        []byte                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"` // Terminal stdout/stderr
+	Exit          bool                   `protobuf:"varint,2,opt,name=exit,proto3" json:"exit,omitempty"`    // Whether the terminal session has ended
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalOutput) Reset() {
+	*x = TerminalOutput{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalOutput) ProtoMessage() {}
+
+func (x *TerminalOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalOutput.ProtoReflect.Descriptor instead.
+func (*TerminalOutput) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *TerminalOutput) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *TerminalOutput) GetExit() bool {
+	if x != nil {
+		return x.Exit
+	}
+	return false
+}
+
+type VolumeInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // Volume name
+	MountPoint    string                 `protobuf:"bytes,2,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`        // Mount point inside container (e.g., "/data")
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                                  // Volume source path on host
+	IsPersistent  bool                   `protobuf:"varint,4,opt,name=is_persistent,json=isPersistent,proto3" json:"is_persistent,omitempty"` // Whether this is a persistent volume
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeInfo) Reset() {
+	*x = VolumeInfo{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeInfo) ProtoMessage() {}
+
+func (x *VolumeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeInfo.ProtoReflect.Descriptor instead.
+func (*VolumeInfo) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *VolumeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetIsPersistent() bool {
+	if x != nil {
+		return x.IsPersistent
+	}
+	return false
+}
+
+type ListContainerFilesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Path           string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`                                     // Directory path (default: "/")
+	VolumeName     *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"` // If specified, list files from this volume instead of container filesystem
+	ListVolumes    bool                   `protobuf:"varint,5,opt,name=list_volumes,json=listVolumes,proto3" json:"list_volumes,omitempty"`   // If true, return list of available volumes instead of files
+	Cursor         *string                `protobuf:"bytes,6,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	PageSize       *int32                 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	ContainerId    *string                `protobuf:"bytes,8,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"` // Optional: use a specific container. If not specified, uses first container.
+	ServiceName    *string                `protobuf:"bytes,9,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"` // Optional: use a specific service. If container_id is specified, this is ignored.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListContainerFilesRequest) Reset() {
+	*x = ListContainerFilesRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContainerFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContainerFilesRequest) ProtoMessage() {}
+
+func (x *ListContainerFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContainerFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListContainerFilesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListContainerFilesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListContainerFilesRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *ListContainerFilesRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ListContainerFilesRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *ListContainerFilesRequest) GetListVolumes() bool {
+	if x != nil {
+		return x.ListVolumes
+	}
+	return false
+}
+
+func (x *ListContainerFilesRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListContainerFilesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListContainerFilesRequest) GetContainerId() string {
+	if x != nil && x.ContainerId != nil {
+		return *x.ContainerId
+	}
+	return ""
+}
+
+func (x *ListContainerFilesRequest) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+type ContainerFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	IsDirectory   bool                   `protobuf:"varint,3,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Permissions   string                 `protobuf:"bytes,5,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	VolumeName    *string                `protobuf:"bytes,6,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"` // If this file is in a volume, the volume name
+	Owner         *string                `protobuf:"bytes,7,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	Group         *string                `protobuf:"bytes,8,opt,name=group,proto3,oneof" json:"group,omitempty"`
+	ModeOctal     *uint32                `protobuf:"varint,9,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
+	IsSymlink     *bool                  `protobuf:"varint,10,opt,name=is_symlink,json=isSymlink,proto3,oneof" json:"is_symlink,omitempty"`
+	SymlinkTarget *string                `protobuf:"bytes,11,opt,name=symlink_target,json=symlinkTarget,proto3,oneof" json:"symlink_target,omitempty"`
+	MimeType      *string                `protobuf:"bytes,12,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
+	ModifiedTime  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=modified_time,json=modifiedTime,proto3,oneof" json:"modified_time,omitempty"`
+	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_time,json=createdTime,proto3,oneof" json:"created_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerFile) Reset() {
+	*x = ContainerFile{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerFile) ProtoMessage() {}
+
+func (x *ContainerFile) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerFile.ProtoReflect.Descriptor instead.
+func (*ContainerFile) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ContainerFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ContainerFile) GetIsDirectory() bool {
+	if x != nil {
+		return x.IsDirectory
+	}
+	return false
+}
+
+func (x *ContainerFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ContainerFile) GetPermissions() string {
+	if x != nil {
+		return x.Permissions
+	}
+	return ""
+}
+
+func (x *ContainerFile) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *ContainerFile) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *ContainerFile) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *ContainerFile) GetModeOctal() uint32 {
+	if x != nil && x.ModeOctal != nil {
+		return *x.ModeOctal
+	}
+	return 0
+}
+
+func (x *ContainerFile) GetIsSymlink() bool {
+	if x != nil && x.IsSymlink != nil {
+		return *x.IsSymlink
+	}
+	return false
+}
+
+func (x *ContainerFile) GetSymlinkTarget() string {
+	if x != nil && x.SymlinkTarget != nil {
+		return *x.SymlinkTarget
+	}
+	return ""
+}
+
+func (x *ContainerFile) GetMimeType() string {
+	if x != nil && x.MimeType != nil {
+		return *x.MimeType
+	}
+	return ""
+}
+
+func (x *ContainerFile) GetModifiedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModifiedTime
+	}
+	return nil
+}
+
+func (x *ContainerFile) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+type ListContainerFilesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Files            []*ContainerFile       `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	CurrentPath      string                 `protobuf:"bytes,2,opt,name=current_path,json=currentPath,proto3" json:"current_path,omitempty"`
+	Volumes          []*VolumeInfo          `protobuf:"bytes,3,rep,name=volumes,proto3" json:"volumes,omitempty"`                                            // Available persistent volumes (only when list_volumes=true)
+	IsVolume         bool                   `protobuf:"varint,4,opt,name=is_volume,json=isVolume,proto3" json:"is_volume,omitempty"`                         // Whether the current listing is from a volume
+	ContainerRunning bool                   `protobuf:"varint,5,opt,name=container_running,json=containerRunning,proto3" json:"container_running,omitempty"` // Whether the container is currently running
+	HasMore          bool                   `protobuf:"varint,6,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor       *string                `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListContainerFilesResponse) Reset() {
+	*x = ListContainerFilesResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContainerFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContainerFilesResponse) ProtoMessage() {}
+
+func (x *ListContainerFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContainerFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListContainerFilesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListContainerFilesResponse) GetFiles() []*ContainerFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ListContainerFilesResponse) GetCurrentPath() string {
+	if x != nil {
+		return x.CurrentPath
+	}
+	return ""
+}
+
+func (x *ListContainerFilesResponse) GetVolumes() []*VolumeInfo {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *ListContainerFilesResponse) GetIsVolume() bool {
+	if x != nil {
+		return x.IsVolume
+	}
+	return false
+}
+
+func (x *ListContainerFilesResponse) GetContainerRunning() bool {
+	if x != nil {
+		return x.ContainerRunning
+	}
+	return false
+}
+
+func (x *ListContainerFilesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListContainerFilesResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type GetContainerFileRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Path           string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`                                        // File path
+	VolumeName     *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`    // If specified, read file from this volume instead of container filesystem
+	ContainerId    *string                `protobuf:"bytes,5,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"` // Optional: use a specific container. If not specified, uses first container.
+	ServiceName    *string                `protobuf:"bytes,6,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"` // Optional: use a specific service. If container_id is specified, this is ignored.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetContainerFileRequest) Reset() {
+	*x = GetContainerFileRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContainerFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContainerFileRequest) ProtoMessage() {}
+
+func (x *GetContainerFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContainerFileRequest.ProtoReflect.Descriptor instead.
+func (*GetContainerFileRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetContainerFileRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetContainerFileRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *GetContainerFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetContainerFileRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *GetContainerFileRequest) GetContainerId() string {
+	if x != nil && x.ContainerId != nil {
+		return *x.ContainerId
+	}
+	return ""
+}
+
+func (x *GetContainerFileRequest) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+type GetContainerFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Encoding      string                 `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"` // "text" or "base64"
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Truncated     *bool                  `protobuf:"varint,4,opt,name=truncated,proto3,oneof" json:"truncated,omitempty"`
+	Metadata      *ContainerFile         `protobuf:"bytes,5,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContainerFileResponse) Reset() {
+	*x = GetContainerFileResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContainerFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContainerFileResponse) ProtoMessage() {}
+
+func (x *GetContainerFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContainerFileResponse.ProtoReflect.Descriptor instead.
+func (*GetContainerFileResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetContainerFileResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GetContainerFileResponse) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *GetContainerFileResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetContainerFileResponse) GetTruncated() bool {
+	if x != nil && x.Truncated != nil {
+		return *x.Truncated
+	}
+	return false
+}
+
+func (x *GetContainerFileResponse) GetMetadata() *ContainerFile {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type UploadContainerFilesRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Metadata      *UploadContainerFilesMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`              // Metadata about the upload
+	TarData       []byte                        `protobuf:"bytes,2,opt,name=tar_data,json=tarData,proto3" json:"tar_data,omitempty"` // Complete tar archive containing all files
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadContainerFilesRequest) Reset() {
+	*x = UploadContainerFilesRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadContainerFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadContainerFilesRequest) ProtoMessage() {}
+
+func (x *UploadContainerFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadContainerFilesRequest.ProtoReflect.Descriptor instead.
+func (*UploadContainerFilesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UploadContainerFilesRequest) GetMetadata() *UploadContainerFilesMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UploadContainerFilesRequest) GetTarData() []byte {
+	if x != nil {
+		return x.TarData
+	}
+	return nil
+}
+
+type UploadContainerFilesMetadata struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId    string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	DestinationPath string                 `protobuf:"bytes,3,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"` // Directory path where files should be extracted (default: "/")
+	Files           []*FileMetadata        `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`                                            // Metadata about files being uploaded
+	VolumeName      *string                `protobuf:"bytes,5,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`          // If specified, upload files to this volume instead of container filesystem
+	ContainerId     *string                `protobuf:"bytes,6,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"`       // Optional: use a specific container. If not specified, uses first container.
+	ServiceName     *string                `protobuf:"bytes,7,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"`       // Optional: use a specific service. If container_id is specified, this is ignored.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UploadContainerFilesMetadata) Reset() {
+	*x = UploadContainerFilesMetadata{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadContainerFilesMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadContainerFilesMetadata) ProtoMessage() {}
+
+func (x *UploadContainerFilesMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadContainerFilesMetadata.ProtoReflect.Descriptor instead.
+func (*UploadContainerFilesMetadata) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UploadContainerFilesMetadata) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UploadContainerFilesMetadata) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *UploadContainerFilesMetadata) GetDestinationPath() string {
+	if x != nil {
+		return x.DestinationPath
+	}
+	return ""
+}
+
+func (x *UploadContainerFilesMetadata) GetFiles() []*FileMetadata {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *UploadContainerFilesMetadata) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *UploadContainerFilesMetadata) GetContainerId() string {
+	if x != nil && x.ContainerId != nil {
+		return *x.ContainerId
+	}
+	return ""
+}
+
+func (x *UploadContainerFilesMetadata) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+type FileMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                   // File name
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                                  // File size in bytes
+	IsDirectory   bool                   `protobuf:"varint,3,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"` // Whether this is a directory
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`                                   // Relative path within the archive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileMetadata) Reset() {
+	*x = FileMetadata{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileMetadata) ProtoMessage() {}
+
+func (x *FileMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileMetadata.ProtoReflect.Descriptor instead.
+func (*FileMetadata) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *FileMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileMetadata) GetIsDirectory() bool {
+	if x != nil {
+		return x.IsDirectory
+	}
+	return false
+}
+
+func (x *FileMetadata) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type UploadContainerFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	FilesUploaded int32                  `protobuf:"varint,3,opt,name=files_uploaded,json=filesUploaded,proto3" json:"files_uploaded,omitempty"` // Number of files successfully uploaded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadContainerFilesResponse) Reset() {
+	*x = UploadContainerFilesResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadContainerFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadContainerFilesResponse) ProtoMessage() {}
+
+func (x *UploadContainerFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadContainerFilesResponse.ProtoReflect.Descriptor instead.
+func (*UploadContainerFilesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UploadContainerFilesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UploadContainerFilesResponse) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *UploadContainerFilesResponse) GetFilesUploaded() int32 {
+	if x != nil {
+		return x.FilesUploaded
+	}
+	return 0
+}
+
+// Chunked upload for deployments using shared payload for consistency with other services.
+type ChunkUploadContainerFilesRequest struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	OrganizationId string                   `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                   `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Upload         *v1.ChunkedUploadPayload `protobuf:"bytes,3,opt,name=upload,proto3" json:"upload,omitempty"`
+	ContainerId    *string                  `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"` // Optional: target a specific container
+	ServiceName    *string                  `protobuf:"bytes,5,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"` // Optional: target a specific service (ignored if container_id provided)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChunkUploadContainerFilesRequest) Reset() {
+	*x = ChunkUploadContainerFilesRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkUploadContainerFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkUploadContainerFilesRequest) ProtoMessage() {}
+
+func (x *ChunkUploadContainerFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkUploadContainerFilesRequest.ProtoReflect.Descriptor instead.
+func (*ChunkUploadContainerFilesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ChunkUploadContainerFilesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ChunkUploadContainerFilesRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *ChunkUploadContainerFilesRequest) GetUpload() *v1.ChunkedUploadPayload {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+func (x *ChunkUploadContainerFilesRequest) GetContainerId() string {
+	if x != nil && x.ContainerId != nil {
+		return *x.ContainerId
+	}
+	return ""
+}
+
+func (x *ChunkUploadContainerFilesRequest) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+type ChunkUploadContainerFilesResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Result        *v1.ChunkedUploadResponsePayload `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkUploadContainerFilesResponse) Reset() {
+	*x = ChunkUploadContainerFilesResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkUploadContainerFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkUploadContainerFilesResponse) ProtoMessage() {}
+
+func (x *ChunkUploadContainerFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkUploadContainerFilesResponse.ProtoReflect.Descriptor instead.
+func (*ChunkUploadContainerFilesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ChunkUploadContainerFilesResponse) GetResult() *v1.ChunkedUploadResponsePayload {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DeleteContainerEntriesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Paths          []string               `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
+	VolumeName     *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
+	Recursive      bool                   `protobuf:"varint,5,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Force          bool                   `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteContainerEntriesRequest) Reset() {
+	*x = DeleteContainerEntriesRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContainerEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContainerEntriesRequest) ProtoMessage() {}
+
+func (x *DeleteContainerEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContainerEntriesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContainerEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteContainerEntriesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteContainerEntriesRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *DeleteContainerEntriesRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *DeleteContainerEntriesRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *DeleteContainerEntriesRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *DeleteContainerEntriesRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteContainerEntriesError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContainerEntriesError) Reset() {
+	*x = DeleteContainerEntriesError{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContainerEntriesError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContainerEntriesError) ProtoMessage() {}
+
+func (x *DeleteContainerEntriesError) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContainerEntriesError.ProtoReflect.Descriptor instead.
+func (*DeleteContainerEntriesError) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DeleteContainerEntriesError) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DeleteContainerEntriesError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteContainerEntriesResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Success       bool                           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	DeletedPaths  []string                       `protobuf:"bytes,2,rep,name=deleted_paths,json=deletedPaths,proto3" json:"deleted_paths,omitempty"`
+	Errors        []*DeleteContainerEntriesError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContainerEntriesResponse) Reset() {
+	*x = DeleteContainerEntriesResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContainerEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContainerEntriesResponse) ProtoMessage() {}
+
+func (x *DeleteContainerEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContainerEntriesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteContainerEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteContainerEntriesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteContainerEntriesResponse) GetDeletedPaths() []string {
+	if x != nil {
+		return x.DeletedPaths
+	}
+	return nil
+}
+
+func (x *DeleteContainerEntriesResponse) GetErrors() []*DeleteContainerEntriesError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type RenameContainerEntryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	SourcePath     string                 `protobuf:"bytes,3,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	TargetPath     string                 `protobuf:"bytes,4,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	VolumeName     *string                `protobuf:"bytes,5,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
+	Overwrite      bool                   `protobuf:"varint,6,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RenameContainerEntryRequest) Reset() {
+	*x = RenameContainerEntryRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameContainerEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameContainerEntryRequest) ProtoMessage() {}
+
+func (x *RenameContainerEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameContainerEntryRequest.ProtoReflect.Descriptor instead.
+func (*RenameContainerEntryRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RenameContainerEntryRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RenameContainerEntryRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *RenameContainerEntryRequest) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+func (x *RenameContainerEntryRequest) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+func (x *RenameContainerEntryRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *RenameContainerEntryRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type RenameContainerEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Entry         *ContainerFile         `protobuf:"bytes,2,opt,name=entry,proto3,oneof" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameContainerEntryResponse) Reset() {
+	*x = RenameContainerEntryResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameContainerEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameContainerEntryResponse) ProtoMessage() {}
+
+func (x *RenameContainerEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameContainerEntryResponse.ProtoReflect.Descriptor instead.
+func (*RenameContainerEntryResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RenameContainerEntryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RenameContainerEntryResponse) GetEntry() *ContainerFile {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type CreateContainerEntryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	ParentPath     string                 `protobuf:"bytes,3,opt,name=parent_path,json=parentPath,proto3" json:"parent_path,omitempty"`
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Type           ContainerEntryType     `protobuf:"varint,5,opt,name=type,proto3,enum=obiente.cloud.deployments.v1.ContainerEntryType" json:"type,omitempty"`
+	Template       *string                `protobuf:"bytes,6,opt,name=template,proto3,oneof" json:"template,omitempty"` // Optional template identifier for seeded content (required for symlinks - target path)
+	VolumeName     *string                `protobuf:"bytes,7,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
+	ModeOctal      *uint32                `protobuf:"varint,8,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
+	ContainerId    *string                `protobuf:"bytes,9,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"`  // Specific container to create entry in (for compose deployments)
+	ServiceName    *string                `protobuf:"bytes,10,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"` // Service name to create entry in (for compose deployments)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateContainerEntryRequest) Reset() {
+	*x = CreateContainerEntryRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContainerEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContainerEntryRequest) ProtoMessage() {}
+
+func (x *CreateContainerEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContainerEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateContainerEntryRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateContainerEntryRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateContainerEntryRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *CreateContainerEntryRequest) GetParentPath() string {
+	if x != nil {
+		return x.ParentPath
+	}
+	return ""
+}
+
+func (x *CreateContainerEntryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateContainerEntryRequest) GetType() ContainerEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return ContainerEntryType_CONTAINER_ENTRY_TYPE_UNSPECIFIED
+}
+
+func (x *CreateContainerEntryRequest) GetTemplate() string {
+	if x != nil && x.Template != nil {
+		return *x.Template
+	}
+	return ""
+}
+
+func (x *CreateContainerEntryRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *CreateContainerEntryRequest) GetModeOctal() uint32 {
+	if x != nil && x.ModeOctal != nil {
+		return *x.ModeOctal
+	}
+	return 0
+}
+
+func (x *CreateContainerEntryRequest) GetContainerId() string {
+	if x != nil && x.ContainerId != nil {
+		return *x.ContainerId
+	}
+	return ""
+}
+
+func (x *CreateContainerEntryRequest) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+type CreateContainerEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *ContainerFile         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContainerEntryResponse) Reset() {
+	*x = CreateContainerEntryResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContainerEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContainerEntryResponse) ProtoMessage() {}
+
+func (x *CreateContainerEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContainerEntryResponse.ProtoReflect.Descriptor instead.
+func (*CreateContainerEntryResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateContainerEntryResponse) GetEntry() *ContainerFile {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type WriteContainerFileRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId    string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Path            string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	VolumeName      *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
+	Content         string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Encoding        string                 `protobuf:"bytes,6,opt,name=encoding,proto3" json:"encoding,omitempty"` // "text" or "base64"
+	CreateIfMissing bool                   `protobuf:"varint,7,opt,name=create_if_missing,json=createIfMissing,proto3" json:"create_if_missing,omitempty"`
+	ModeOctal       *uint32                `protobuf:"varint,8,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WriteContainerFileRequest) Reset() {
+	*x = WriteContainerFileRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteContainerFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteContainerFileRequest) ProtoMessage() {}
+
+func (x *WriteContainerFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteContainerFileRequest.ProtoReflect.Descriptor instead.
+func (*WriteContainerFileRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *WriteContainerFileRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *WriteContainerFileRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *WriteContainerFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WriteContainerFileRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *WriteContainerFileRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *WriteContainerFileRequest) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *WriteContainerFileRequest) GetCreateIfMissing() bool {
+	if x != nil {
+		return x.CreateIfMissing
+	}
+	return false
+}
+
+func (x *WriteContainerFileRequest) GetModeOctal() uint32 {
+	if x != nil && x.ModeOctal != nil {
+		return *x.ModeOctal
+	}
+	return 0
+}
+
+type WriteContainerFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Entry         *ContainerFile         `protobuf:"bytes,2,opt,name=entry,proto3,oneof" json:"entry,omitempty"`
+	Error         *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteContainerFileResponse) Reset() {
+	*x = WriteContainerFileResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteContainerFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteContainerFileResponse) ProtoMessage() {}
+
+func (x *WriteContainerFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteContainerFileResponse.ProtoReflect.Descriptor instead.
+func (*WriteContainerFileResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *WriteContainerFileResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *WriteContainerFileResponse) GetEntry() *ContainerFile {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *WriteContainerFileResponse) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type ExtractDeploymentFileRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DeploymentId    string                 `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	OrganizationId  string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ZipPath         string                 `protobuf:"bytes,3,opt,name=zip_path,json=zipPath,proto3" json:"zip_path,omitempty"`                         // Path to the zip file to extract (from common.ExtractServerFileRequest)
+	DestinationPath string                 `protobuf:"bytes,4,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"` // Directory path where files should be extracted (from common.ExtractServerFileRequest)
+	VolumeName      *string                `protobuf:"bytes,5,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`          // If specified, extract to this volume instead of container filesystem (from common.ExtractServerFileRequest)
+	ContainerId     *string                `protobuf:"bytes,6,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"`       // If specified, extract to this container
+	ServiceName     *string                `protobuf:"bytes,7,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"`       // If specified, extract to container with this service name
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExtractDeploymentFileRequest) Reset() {
+	*x = ExtractDeploymentFileRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtractDeploymentFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractDeploymentFileRequest) ProtoMessage() {}
+
+func (x *ExtractDeploymentFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractDeploymentFileRequest.ProtoReflect.Descriptor instead.
+func (*ExtractDeploymentFileRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ExtractDeploymentFileRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *ExtractDeploymentFileRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ExtractDeploymentFileRequest) GetZipPath() string {
+	if x != nil {
+		return x.ZipPath
+	}
+	return ""
+}
+
+func (x *ExtractDeploymentFileRequest) GetDestinationPath() string {
+	if x != nil {
+		return x.DestinationPath
+	}
+	return ""
+}
+
+func (x *ExtractDeploymentFileRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *ExtractDeploymentFileRequest) GetContainerId() string {
+	if x != nil && x.ContainerId != nil {
+		return *x.ContainerId
+	}
+	return ""
+}
+
+func (x *ExtractDeploymentFileRequest) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+type ExtractDeploymentFileResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                     // From common.ExtractServerFileResponse
+	Error          *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`                                    // From common.ExtractServerFileResponse
+	FilesExtracted int32                  `protobuf:"varint,3,opt,name=files_extracted,json=filesExtracted,proto3" json:"files_extracted,omitempty"` // Number of files successfully extracted (from common.ExtractServerFileResponse)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExtractDeploymentFileResponse) Reset() {
+	*x = ExtractDeploymentFileResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtractDeploymentFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractDeploymentFileResponse) ProtoMessage() {}
+
+func (x *ExtractDeploymentFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractDeploymentFileResponse.ProtoReflect.Descriptor instead.
+func (*ExtractDeploymentFileResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ExtractDeploymentFileResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExtractDeploymentFileResponse) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *ExtractDeploymentFileResponse) GetFilesExtracted() int32 {
+	if x != nil {
+		return x.FilesExtracted
+	}
+	return 0
+}
+
+type CreateDeploymentFileArchiveRequest struct {
+	state          protoimpl.MessageState             `protogen:"open.v1"`
+	DeploymentId   string                             `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	OrganizationId string                             `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ArchiveRequest *v1.CreateServerFileArchiveRequest `protobuf:"bytes,3,opt,name=archive_request,json=archiveRequest,proto3" json:"archive_request,omitempty"` // Shared archive request
+	VolumeName     *string                            `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`       // If specified, create archive in this volume instead of container filesystem
+	ContainerId    *string                            `protobuf:"bytes,5,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"`    // If specified, create archive in this container
+	ServiceName    *string                            `protobuf:"bytes,6,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"`    // If specified, create archive in container with this service name
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateDeploymentFileArchiveRequest) Reset() {
+	*x = CreateDeploymentFileArchiveRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeploymentFileArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeploymentFileArchiveRequest) ProtoMessage() {}
+
+func (x *CreateDeploymentFileArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeploymentFileArchiveRequest.ProtoReflect.Descriptor instead.
+func (*CreateDeploymentFileArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateDeploymentFileArchiveRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *CreateDeploymentFileArchiveRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateDeploymentFileArchiveRequest) GetArchiveRequest() *v1.CreateServerFileArchiveRequest {
+	if x != nil {
+		return x.ArchiveRequest
+	}
+	return nil
+}
+
+func (x *CreateDeploymentFileArchiveRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *CreateDeploymentFileArchiveRequest) GetContainerId() string {
+	if x != nil && x.ContainerId != nil {
+		return *x.ContainerId
+	}
+	return ""
+}
+
+func (x *CreateDeploymentFileArchiveRequest) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+type CreateDeploymentFileArchiveResponse struct {
+	state           protoimpl.MessageState              `protogen:"open.v1"`
+	ArchiveResponse *v1.CreateServerFileArchiveResponse `protobuf:"bytes,1,opt,name=archive_response,json=archiveResponse,proto3" json:"archive_response,omitempty"` // Shared archive response
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateDeploymentFileArchiveResponse) Reset() {
+	*x = CreateDeploymentFileArchiveResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeploymentFileArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeploymentFileArchiveResponse) ProtoMessage() {}
+
+func (x *CreateDeploymentFileArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeploymentFileArchiveResponse.ProtoReflect.Descriptor instead.
+func (*CreateDeploymentFileArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateDeploymentFileArchiveResponse) GetArchiveResponse() *v1.CreateServerFileArchiveResponse {
+	if x != nil {
+		return x.ArchiveResponse
+	}
+	return nil
+}
+
+// Routing configuration messages
+type RoutingRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeploymentId    string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Domain          string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	ServiceName     string                 `protobuf:"bytes,4,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"` // Service name (e.g., "api", "web", "admin", "default")
+	PathPrefix      string                 `protobuf:"bytes,5,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`    // Optional path prefix (e.g., "/api")
+	TargetPort      int32                  `protobuf:"varint,6,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
+	Protocol        string                 `protobuf:"bytes,7,opt,name=protocol,proto3" json:"protocol,omitempty"` // "http", "https", "grpc"
+	SslEnabled      bool                   `protobuf:"varint,8,opt,name=ssl_enabled,json=sslEnabled,proto3" json:"ssl_enabled,omitempty"`
+	SslCertResolver string                 `protobuf:"bytes,9,opt,name=ssl_cert_resolver,json=sslCertResolver,proto3" json:"ssl_cert_resolver,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RoutingRule) Reset() {
+	*x = RoutingRule{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutingRule) ProtoMessage() {}
+
+func (x *RoutingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutingRule.ProtoReflect.Descriptor instead.
+func (*RoutingRule) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RoutingRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoutingRule) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *RoutingRule) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *RoutingRule) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *RoutingRule) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *RoutingRule) GetTargetPort() int32 {
+	if x != nil {
+		return x.TargetPort
+	}
+	return 0
+}
+
+func (x *RoutingRule) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *RoutingRule) GetSslEnabled() bool {
+	if x != nil {
+		return x.SslEnabled
+	}
+	return false
+}
+
+func (x *RoutingRule) GetSslCertResolver() string {
+	if x != nil {
+		return x.SslCertResolver
+	}
+	return ""
+}
+
+type GetDeploymentRoutingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDeploymentRoutingsRequest) Reset() {
+	*x = GetDeploymentRoutingsRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeploymentRoutingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeploymentRoutingsRequest) ProtoMessage() {}
+
+func (x *GetDeploymentRoutingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeploymentRoutingsRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentRoutingsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetDeploymentRoutingsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetDeploymentRoutingsRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+type GetDeploymentRoutingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*RoutingRule         `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeploymentRoutingsResponse) Reset() {
+	*x = GetDeploymentRoutingsResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeploymentRoutingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeploymentRoutingsResponse) ProtoMessage() {}
+
+func (x *GetDeploymentRoutingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeploymentRoutingsResponse.ProtoReflect.Descriptor instead.
+func (*GetDeploymentRoutingsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetDeploymentRoutingsResponse) GetRules() []*RoutingRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type UpdateDeploymentRoutingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Rules          []*RoutingRule         `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"` // Replace all existing rules with these
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateDeploymentRoutingsRequest) Reset() {
+	*x = UpdateDeploymentRoutingsRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeploymentRoutingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeploymentRoutingsRequest) ProtoMessage() {}
+
+func (x *UpdateDeploymentRoutingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeploymentRoutingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeploymentRoutingsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateDeploymentRoutingsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ContainerFile) GetModifiedTime() *timestamppb.Timestamp {
+func (x *UpdateDeploymentRoutingsRequest) GetDeploymentId() string {
 	if x != nil {
-		return x.ModifiedTime
+		return x.DeploymentId
 	}
-	return nil
+	return ""
 }
 
-func (x *ContainerFile) GetCreatedTime() *timestamppb.Timestamp {
+func (x *UpdateDeploymentRoutingsRequest) GetRules() []*RoutingRule {
 	if x != nil {
-		return x.CreatedTime
+		return x.Rules
 	}
 	return nil
 }
 
-type ListContainerFilesResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Files            []*ContainerFile       `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	CurrentPath      string                 `protobuf:"bytes,2,opt,name=current_path,json=currentPath,proto3" json:"current_path,omitempty"`
-	Volumes          []*VolumeInfo          `protobuf:"bytes,3,rep,name=volumes,proto3" json:"volumes,omitempty"`                                            // Available persistent volumes (only when list_volumes=true)
-	IsVolume         bool                   `protobuf:"varint,4,opt,name=is_volume,json=isVolume,proto3" json:"is_volume,omitempty"`                         // Whether the current listing is from a volume
-	ContainerRunning bool                   `protobuf:"varint,5,opt,name=container_running,json=containerRunning,proto3" json:"container_running,omitempty"` // Whether the container is currently running
-	HasMore          bool                   `protobuf:"varint,6,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	NextCursor       *string                `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type UpdateDeploymentRoutingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*RoutingRule         `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListContainerFilesResponse) Reset() {
-	*x = ListContainerFilesResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[57]
+func (x *UpdateDeploymentRoutingsResponse) Reset() {
+	*x = UpdateDeploymentRoutingsResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListContainerFilesResponse) String() string {
+func (x *UpdateDeploymentRoutingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContainerFilesResponse) ProtoMessage() {}
+func (*UpdateDeploymentRoutingsResponse) ProtoMessage() {}
 
-func (x *ListContainerFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[57]
+func (x *UpdateDeploymentRoutingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4170,87 +6823,139 @@ func (x *ListContainerFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContainerFilesResponse.ProtoReflect.Descriptor instead.
-func (*ListContainerFilesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use UpdateDeploymentRoutingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDeploymentRoutingsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *ListContainerFilesResponse) GetFiles() []*ContainerFile {
+func (x *UpdateDeploymentRoutingsResponse) GetRules() []*RoutingRule {
 	if x != nil {
-		return x.Files
+		return x.Rules
 	}
 	return nil
 }
 
-func (x *ListContainerFilesResponse) GetCurrentPath() string {
-	if x != nil {
-		return x.CurrentPath
-	}
-	return ""
+type GetDeploymentServiceNamesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListContainerFilesResponse) GetVolumes() []*VolumeInfo {
+func (x *GetDeploymentServiceNamesRequest) Reset() {
+	*x = GetDeploymentServiceNamesRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeploymentServiceNamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeploymentServiceNamesRequest) ProtoMessage() {}
+
+func (x *GetDeploymentServiceNamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[98]
 	if x != nil {
-		return x.Volumes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListContainerFilesResponse) GetIsVolume() bool {
+// Deprecated: Use GetDeploymentServiceNamesRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentServiceNamesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetDeploymentServiceNamesRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.IsVolume
+		return x.OrganizationId
 	}
-	return false
+	return ""
 }
 
-func (x *ListContainerFilesResponse) GetContainerRunning() bool {
+func (x *GetDeploymentServiceNamesRequest) GetDeploymentId() string {
 	if x != nil {
-		return x.ContainerRunning
+		return x.DeploymentId
 	}
-	return false
+	return ""
 }
 
-func (x *ListContainerFilesResponse) GetHasMore() bool {
+type GetDeploymentServiceNamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceNames  []string               `protobuf:"bytes,1,rep,name=service_names,json=serviceNames,proto3" json:"service_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeploymentServiceNamesResponse) Reset() {
+	*x = GetDeploymentServiceNamesResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeploymentServiceNamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeploymentServiceNamesResponse) ProtoMessage() {}
+
+func (x *GetDeploymentServiceNamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[99]
 	if x != nil {
-		return x.HasMore
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ListContainerFilesResponse) GetNextCursor() string {
-	if x != nil && x.NextCursor != nil {
-		return *x.NextCursor
+// Deprecated: Use GetDeploymentServiceNamesResponse.ProtoReflect.Descriptor instead.
+func (*GetDeploymentServiceNamesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetDeploymentServiceNamesResponse) GetServiceNames() []string {
+	if x != nil {
+		return x.ServiceNames
 	}
-	return ""
+	return nil
 }
 
-type GetContainerFileRequest struct {
+// Domain verification messages
+type GetDomainVerificationTokenRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Path           string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`                                        // File path
-	VolumeName     *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`    // If specified, read file from this volume instead of container filesystem
-	ContainerId    *string                `protobuf:"bytes,5,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"` // Optional: use a specific container. If not specified, uses first container.
-	ServiceName    *string                `protobuf:"bytes,6,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"` // Optional: use a specific service. If container_id is specified, this is ignored.
+	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetContainerFileRequest) Reset() {
-	*x = GetContainerFileRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[58]
+func (x *GetDomainVerificationTokenRequest) Reset() {
+	*x = GetDomainVerificationTokenRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContainerFileRequest) String() string {
+func (x *GetDomainVerificationTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainerFileRequest) ProtoMessage() {}
+func (*GetDomainVerificationTokenRequest) ProtoMessage() {}
 
-func (x *GetContainerFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[58]
+func (x *GetDomainVerificationTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4261,79 +6966,58 @@ func (x *GetContainerFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainerFileRequest.ProtoReflect.Descriptor instead.
-func (*GetContainerFileRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use GetDomainVerificationTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetDomainVerificationTokenRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetContainerFileRequest) GetOrganizationId() string {
+func (x *GetDomainVerificationTokenRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GetContainerFileRequest) GetDeploymentId() string {
+func (x *GetDomainVerificationTokenRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *GetContainerFileRequest) GetPath() string {
+func (x *GetDomainVerificationTokenRequest) GetDomain() string {
 	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *GetContainerFileRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
-	}
-	return ""
-}
-
-func (x *GetContainerFileRequest) GetContainerId() string {
-	if x != nil && x.ContainerId != nil {
-		return *x.ContainerId
-	}
-	return ""
-}
-
-func (x *GetContainerFileRequest) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+		return x.Domain
 	}
 	return ""
 }
 
-type GetContainerFileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Encoding      string                 `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"` // "text" or "base64"
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Truncated     *bool                  `protobuf:"varint,4,opt,name=truncated,proto3,oneof" json:"truncated,omitempty"`
-	Metadata      *ContainerFile         `protobuf:"bytes,5,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetDomainVerificationTokenResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Domain         string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Token          string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	TxtRecordName  string                 `protobuf:"bytes,3,opt,name=txt_record_name,json=txtRecordName,proto3" json:"txt_record_name,omitempty"`    // e.g., "_obiente-verification.example.com"
+	TxtRecordValue string                 `protobuf:"bytes,4,opt,name=txt_record_value,json=txtRecordValue,proto3" json:"txt_record_value,omitempty"` // e.g., "obiente-verification=abc123..."
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                         // "pending", "verified", "failed", "expired"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetContainerFileResponse) Reset() {
-	*x = GetContainerFileResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[59]
+func (x *GetDomainVerificationTokenResponse) Reset() {
+	*x = GetDomainVerificationTokenResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContainerFileResponse) String() string {
+func (x *GetDomainVerificationTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainerFileResponse) ProtoMessage() {}
+func (*GetDomainVerificationTokenResponse) ProtoMessage() {}
 
-func (x *GetContainerFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[59]
+func (x *GetDomainVerificationTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4344,69 +7028,70 @@ func (x *GetContainerFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainerFileResponse.ProtoReflect.Descriptor instead.
-func (*GetContainerFileResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use GetDomainVerificationTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetDomainVerificationTokenResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetContainerFileResponse) GetContent() string {
+func (x *GetDomainVerificationTokenResponse) GetDomain() string {
 	if x != nil {
-		return x.Content
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *GetContainerFileResponse) GetEncoding() string {
+func (x *GetDomainVerificationTokenResponse) GetToken() string {
 	if x != nil {
-		return x.Encoding
+		return x.Token
 	}
 	return ""
 }
 
-func (x *GetContainerFileResponse) GetSize() int64 {
+func (x *GetDomainVerificationTokenResponse) GetTxtRecordName() string {
 	if x != nil {
-		return x.Size
+		return x.TxtRecordName
 	}
-	return 0
+	return ""
 }
 
-func (x *GetContainerFileResponse) GetTruncated() bool {
-	if x != nil && x.Truncated != nil {
-		return *x.Truncated
+func (x *GetDomainVerificationTokenResponse) GetTxtRecordValue() string {
+	if x != nil {
+		return x.TxtRecordValue
 	}
-	return false
+	return ""
 }
 
-func (x *GetContainerFileResponse) GetMetadata() *ContainerFile {
+func (x *GetDomainVerificationTokenResponse) GetStatus() string {
 	if x != nil {
-		return x.Metadata
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type UploadContainerFilesRequest struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Metadata      *UploadContainerFilesMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`              // Metadata about the upload
-	TarData       []byte                        `protobuf:"bytes,2,opt,name=tar_data,json=tarData,proto3" json:"tar_data,omitempty"` // Complete tar archive containing all files
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type VerifyDomainOwnershipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UploadContainerFilesRequest) Reset() {
-	*x = UploadContainerFilesRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[60]
+func (x *VerifyDomainOwnershipRequest) Reset() {
+	*x = VerifyDomainOwnershipRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadContainerFilesRequest) String() string {
+func (x *VerifyDomainOwnershipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadContainerFilesRequest) ProtoMessage() {}
+func (*VerifyDomainOwnershipRequest) ProtoMessage() {}
 
-func (x *UploadContainerFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[60]
+func (x *VerifyDomainOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4417,53 +7102,57 @@ func (x *UploadContainerFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadContainerFilesRequest.ProtoReflect.Descriptor instead.
-func (*UploadContainerFilesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use VerifyDomainOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDomainOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *UploadContainerFilesRequest) GetMetadata() *UploadContainerFilesMetadata {
+func (x *VerifyDomainOwnershipRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Metadata
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadContainerFilesRequest) GetTarData() []byte {
+func (x *VerifyDomainOwnershipRequest) GetDeploymentId() string {
 	if x != nil {
-		return x.TarData
+		return x.DeploymentId
 	}
-	return nil
+	return ""
 }
 
-type UploadContainerFilesMetadata struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DeploymentId    string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	DestinationPath string                 `protobuf:"bytes,3,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"` // Directory path where files should be extracted (default: "/")
-	Files           []*FileMetadata        `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`                                            // Metadata about files being uploaded
-	VolumeName      *string                `protobuf:"bytes,5,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`          // If specified, upload files to this volume instead of container filesystem
-	ContainerId     *string                `protobuf:"bytes,6,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"`       // Optional: use a specific container. If not specified, uses first container.
-	ServiceName     *string                `protobuf:"bytes,7,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"`       // Optional: use a specific service. If container_id is specified, this is ignored.
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *VerifyDomainOwnershipRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
 }
 
-func (x *UploadContainerFilesMetadata) Reset() {
-	*x = UploadContainerFilesMetadata{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[61]
+type VerifyDomainOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Verified      bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`         // "verified", "failed", "pending"
+	Message       *string                `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"` // Error message if verification failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyDomainOwnershipResponse) Reset() {
+	*x = VerifyDomainOwnershipResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadContainerFilesMetadata) String() string {
+func (x *VerifyDomainOwnershipResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadContainerFilesMetadata) ProtoMessage() {}
+func (*VerifyDomainOwnershipResponse) ProtoMessage() {}
 
-func (x *UploadContainerFilesMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[61]
+func (x *VerifyDomainOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4474,85 +7163,72 @@ func (x *UploadContainerFilesMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadContainerFilesMetadata.ProtoReflect.Descriptor instead.
-func (*UploadContainerFilesMetadata) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *UploadContainerFilesMetadata) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
+// Deprecated: Use VerifyDomainOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDomainOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *UploadContainerFilesMetadata) GetDeploymentId() string {
+func (x *VerifyDomainOwnershipResponse) GetDomain() string {
 	if x != nil {
-		return x.DeploymentId
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *UploadContainerFilesMetadata) GetDestinationPath() string {
+func (x *VerifyDomainOwnershipResponse) GetVerified() bool {
 	if x != nil {
-		return x.DestinationPath
+		return x.Verified
 	}
-	return ""
+	return false
 }
 
-func (x *UploadContainerFilesMetadata) GetFiles() []*FileMetadata {
+func (x *VerifyDomainOwnershipResponse) GetStatus() string {
 	if x != nil {
-		return x.Files
-	}
-	return nil
-}
-
-func (x *UploadContainerFilesMetadata) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
-	}
-	return ""
-}
-
-func (x *UploadContainerFilesMetadata) GetContainerId() string {
-	if x != nil && x.ContainerId != nil {
-		return *x.ContainerId
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UploadContainerFilesMetadata) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+func (x *VerifyDomainOwnershipResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
 	}
 	return ""
 }
 
-type FileMetadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                   // File name
-	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                                  // File size in bytes
-	IsDirectory   bool                   `protobuf:"varint,3,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"` // Whether this is a directory
-	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`                                   // Relative path within the archive
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *FileMetadata) Reset() {
-	*x = FileMetadata{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[62]
+type CustomDomain struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeploymentId string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Domain       string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	Verified     bool                   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	Status       string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // "pending", "issued", "failed"
+	// TXT record to publish for the ACME DNS-01 challenge
+	ChallengeRecordName  string                 `protobuf:"bytes,6,opt,name=challenge_record_name,json=challengeRecordName,proto3" json:"challenge_record_name,omitempty"`
+	ChallengeRecordValue string                 `protobuf:"bytes,7,opt,name=challenge_record_value,json=challengeRecordValue,proto3" json:"challenge_record_value,omitempty"`
+	TlsCertId            *string                `protobuf:"bytes,8,opt,name=tls_cert_id,json=tlsCertId,proto3,oneof" json:"tls_cert_id,omitempty"`
+	CertificateExpiresAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=certificate_expires_at,json=certificateExpiresAt,proto3,oneof" json:"certificate_expires_at,omitempty"`
+	LastError            *string                `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CustomDomain) Reset() {
+	*x = CustomDomain{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileMetadata) String() string {
+func (x *CustomDomain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileMetadata) ProtoMessage() {}
+func (*CustomDomain) ProtoMessage() {}
 
-func (x *FileMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[62]
+func (x *CustomDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4563,126 +7239,112 @@ func (x *FileMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileMetadata.ProtoReflect.Descriptor instead.
-func (*FileMetadata) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use CustomDomain.ProtoReflect.Descriptor instead.
+func (*CustomDomain) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *FileMetadata) GetName() string {
+func (x *CustomDomain) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FileMetadata) GetSize() int64 {
+func (x *CustomDomain) GetDeploymentId() string {
 	if x != nil {
-		return x.Size
+		return x.DeploymentId
 	}
-	return 0
+	return ""
 }
 
-func (x *FileMetadata) GetIsDirectory() bool {
+func (x *CustomDomain) GetDomain() string {
 	if x != nil {
-		return x.IsDirectory
+		return x.Domain
 	}
-	return false
+	return ""
 }
 
-func (x *FileMetadata) GetPath() string {
+func (x *CustomDomain) GetVerified() bool {
 	if x != nil {
-		return x.Path
+		return x.Verified
 	}
-	return ""
-}
-
-type UploadContainerFilesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	FilesUploaded int32                  `protobuf:"varint,3,opt,name=files_uploaded,json=filesUploaded,proto3" json:"files_uploaded,omitempty"` // Number of files successfully uploaded
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return false
 }
 
-func (x *UploadContainerFilesResponse) Reset() {
-	*x = UploadContainerFilesResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[63]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CustomDomain) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *UploadContainerFilesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CustomDomain) GetChallengeRecordName() string {
+	if x != nil {
+		return x.ChallengeRecordName
+	}
+	return ""
 }
 
-func (*UploadContainerFilesResponse) ProtoMessage() {}
-
-func (x *UploadContainerFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[63]
+func (x *CustomDomain) GetChallengeRecordValue() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ChallengeRecordValue
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UploadContainerFilesResponse.ProtoReflect.Descriptor instead.
-func (*UploadContainerFilesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{63}
+func (x *CustomDomain) GetTlsCertId() string {
+	if x != nil && x.TlsCertId != nil {
+		return *x.TlsCertId
+	}
+	return ""
 }
 
-func (x *UploadContainerFilesResponse) GetSuccess() bool {
+func (x *CustomDomain) GetCertificateExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Success
+		return x.CertificateExpiresAt
 	}
-	return false
+	return nil
 }
 
-func (x *UploadContainerFilesResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+func (x *CustomDomain) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
 	}
 	return ""
 }
 
-func (x *UploadContainerFilesResponse) GetFilesUploaded() int32 {
+func (x *CustomDomain) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FilesUploaded
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-// Chunked upload for deployments using shared payload for consistency with other services.
-type ChunkUploadContainerFilesRequest struct {
-	state          protoimpl.MessageState   `protogen:"open.v1"`
-	OrganizationId string                   `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DeploymentId   string                   `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Upload         *v1.ChunkedUploadPayload `protobuf:"bytes,3,opt,name=upload,proto3" json:"upload,omitempty"`
-	ContainerId    *string                  `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"` // Optional: target a specific container
-	ServiceName    *string                  `protobuf:"bytes,5,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"` // Optional: target a specific service (ignored if container_id provided)
+type CreateCustomDomainRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ChunkUploadContainerFilesRequest) Reset() {
-	*x = ChunkUploadContainerFilesRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[64]
+func (x *CreateCustomDomainRequest) Reset() {
+	*x = CreateCustomDomainRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChunkUploadContainerFilesRequest) String() string {
+func (x *CreateCustomDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChunkUploadContainerFilesRequest) ProtoMessage() {}
+func (*CreateCustomDomainRequest) ProtoMessage() {}
 
-func (x *ChunkUploadContainerFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[64]
+func (x *CreateCustomDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4693,68 +7355,54 @@ func (x *ChunkUploadContainerFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChunkUploadContainerFilesRequest.ProtoReflect.Descriptor instead.
-func (*ChunkUploadContainerFilesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use CreateCustomDomainRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomDomainRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *ChunkUploadContainerFilesRequest) GetOrganizationId() string {
+func (x *CreateCustomDomainRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ChunkUploadContainerFilesRequest) GetDeploymentId() string {
+func (x *CreateCustomDomainRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *ChunkUploadContainerFilesRequest) GetUpload() *v1.ChunkedUploadPayload {
+func (x *CreateCustomDomainRequest) GetDomain() string {
 	if x != nil {
-		return x.Upload
-	}
-	return nil
-}
-
-func (x *ChunkUploadContainerFilesRequest) GetContainerId() string {
-	if x != nil && x.ContainerId != nil {
-		return *x.ContainerId
-	}
-	return ""
-}
-
-func (x *ChunkUploadContainerFilesRequest) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+		return x.Domain
 	}
 	return ""
 }
 
-type ChunkUploadContainerFilesResponse struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	Result        *v1.ChunkedUploadResponsePayload `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+type CreateCustomDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomDomain  *CustomDomain          `protobuf:"bytes,1,opt,name=custom_domain,json=customDomain,proto3" json:"custom_domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChunkUploadContainerFilesResponse) Reset() {
-	*x = ChunkUploadContainerFilesResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[65]
+func (x *CreateCustomDomainResponse) Reset() {
+	*x = CreateCustomDomainResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChunkUploadContainerFilesResponse) String() string {
+func (x *CreateCustomDomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChunkUploadContainerFilesResponse) ProtoMessage() {}
+func (*CreateCustomDomainResponse) ProtoMessage() {}
 
-func (x *ChunkUploadContainerFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[65]
+func (x *CreateCustomDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4765,45 +7413,42 @@ func (x *ChunkUploadContainerFilesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChunkUploadContainerFilesResponse.ProtoReflect.Descriptor instead.
-func (*ChunkUploadContainerFilesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use CreateCustomDomainResponse.ProtoReflect.Descriptor instead.
+func (*CreateCustomDomainResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *ChunkUploadContainerFilesResponse) GetResult() *v1.ChunkedUploadResponsePayload {
+func (x *CreateCustomDomainResponse) GetCustomDomain() *CustomDomain {
 	if x != nil {
-		return x.Result
+		return x.CustomDomain
 	}
 	return nil
 }
 
-type DeleteContainerEntriesRequest struct {
+type VerifyCustomDomainRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Paths          []string               `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
-	VolumeName     *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
-	Recursive      bool                   `protobuf:"varint,5,opt,name=recursive,proto3" json:"recursive,omitempty"`
-	Force          bool                   `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
+	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteContainerEntriesRequest) Reset() {
-	*x = DeleteContainerEntriesRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[66]
+func (x *VerifyCustomDomainRequest) Reset() {
+	*x = VerifyCustomDomainRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteContainerEntriesRequest) String() string {
+func (x *VerifyCustomDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteContainerEntriesRequest) ProtoMessage() {}
+func (*VerifyCustomDomainRequest) ProtoMessage() {}
 
-func (x *DeleteContainerEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[66]
+func (x *VerifyCustomDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4814,76 +7459,54 @@ func (x *DeleteContainerEntriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteContainerEntriesRequest.ProtoReflect.Descriptor instead.
-func (*DeleteContainerEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use VerifyCustomDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyCustomDomainRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *DeleteContainerEntriesRequest) GetOrganizationId() string {
+func (x *VerifyCustomDomainRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *DeleteContainerEntriesRequest) GetDeploymentId() string {
+func (x *VerifyCustomDomainRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *DeleteContainerEntriesRequest) GetPaths() []string {
+func (x *VerifyCustomDomainRequest) GetDomain() string {
 	if x != nil {
-		return x.Paths
-	}
-	return nil
-}
-
-func (x *DeleteContainerEntriesRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *DeleteContainerEntriesRequest) GetRecursive() bool {
-	if x != nil {
-		return x.Recursive
-	}
-	return false
-}
-
-func (x *DeleteContainerEntriesRequest) GetForce() bool {
-	if x != nil {
-		return x.Force
-	}
-	return false
-}
-
-type DeleteContainerEntriesError struct {
+type VerifyCustomDomainResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CustomDomain  *CustomDomain          `protobuf:"bytes,1,opt,name=custom_domain,json=customDomain,proto3" json:"custom_domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteContainerEntriesError) Reset() {
-	*x = DeleteContainerEntriesError{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[67]
+func (x *VerifyCustomDomainResponse) Reset() {
+	*x = VerifyCustomDomainResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteContainerEntriesError) String() string {
+func (x *VerifyCustomDomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteContainerEntriesError) ProtoMessage() {}
+func (*VerifyCustomDomainResponse) ProtoMessage() {}
 
-func (x *DeleteContainerEntriesError) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[67]
+func (x *VerifyCustomDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4894,49 +7517,49 @@ func (x *DeleteContainerEntriesError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteContainerEntriesError.ProtoReflect.Descriptor instead.
-func (*DeleteContainerEntriesError) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *DeleteContainerEntriesError) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use VerifyCustomDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyCustomDomainResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *DeleteContainerEntriesError) GetMessage() string {
+func (x *VerifyCustomDomainResponse) GetCustomDomain() *CustomDomain {
 	if x != nil {
-		return x.Message
+		return x.CustomDomain
 	}
-	return ""
+	return nil
 }
 
-type DeleteContainerEntriesResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Success       bool                           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	DeletedPaths  []string                       `protobuf:"bytes,2,rep,name=deleted_paths,json=deletedPaths,proto3" json:"deleted_paths,omitempty"`
-	Errors        []*DeleteContainerEntriesError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateDeploymentHealthCheckRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	// Disables probing when false; the other fields are then ignored
+	Enabled         bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Path            string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`                                                  // HTTP path to GET (default: "/")
+	Port            *int32 `protobuf:"varint,5,opt,name=port,proto3,oneof" json:"port,omitempty"`                                           // Container port (default: the deployment port)
+	IntervalSeconds int32  `protobuf:"varint,6,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`    // Default: 30
+	TimeoutSeconds  int32  `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`       // Default: 5
+	Retries         int32  `protobuf:"varint,8,opt,name=retries,proto3" json:"retries,omitempty"`                                           // Default: 3
+	ExpectedStatus  *int32 `protobuf:"varint,9,opt,name=expected_status,json=expectedStatus,proto3,oneof" json:"expected_status,omitempty"` // Expected HTTP status (default: any 2xx or 3xx)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeleteContainerEntriesResponse) Reset() {
-	*x = DeleteContainerEntriesResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[68]
+func (x *UpdateDeploymentHealthCheckRequest) Reset() {
+	*x = UpdateDeploymentHealthCheckRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteContainerEntriesResponse) String() string {
+func (x *UpdateDeploymentHealthCheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteContainerEntriesResponse) ProtoMessage() {}
+func (*UpdateDeploymentHealthCheckRequest) ProtoMessage() {}
 
-func (x *DeleteContainerEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[68]
+func (x *UpdateDeploymentHealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4947,139 +7570,144 @@ func (x *DeleteContainerEntriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteContainerEntriesResponse.ProtoReflect.Descriptor instead.
-func (*DeleteContainerEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use UpdateDeploymentHealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeploymentHealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *DeleteContainerEntriesResponse) GetSuccess() bool {
+func (x *UpdateDeploymentHealthCheckRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Success
+		return x.OrganizationId
 	}
-	return false
+	return ""
 }
 
-func (x *DeleteContainerEntriesResponse) GetDeletedPaths() []string {
+func (x *UpdateDeploymentHealthCheckRequest) GetDeploymentId() string {
 	if x != nil {
-		return x.DeletedPaths
+		return x.DeploymentId
 	}
-	return nil
+	return ""
 }
 
-func (x *DeleteContainerEntriesResponse) GetErrors() []*DeleteContainerEntriesError {
+func (x *UpdateDeploymentHealthCheckRequest) GetEnabled() bool {
 	if x != nil {
-		return x.Errors
+		return x.Enabled
 	}
-	return nil
-}
-
-type RenameContainerEntryRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	SourcePath     string                 `protobuf:"bytes,3,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
-	TargetPath     string                 `protobuf:"bytes,4,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
-	VolumeName     *string                `protobuf:"bytes,5,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
-	Overwrite      bool                   `protobuf:"varint,6,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *RenameContainerEntryRequest) Reset() {
-	*x = RenameContainerEntryRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[69]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RenameContainerEntryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return false
 }
 
-func (*RenameContainerEntryRequest) ProtoMessage() {}
-
-func (x *RenameContainerEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[69]
+func (x *UpdateDeploymentHealthCheckRequest) GetPath() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Path
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RenameContainerEntryRequest.ProtoReflect.Descriptor instead.
-func (*RenameContainerEntryRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{69}
+func (x *UpdateDeploymentHealthCheckRequest) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
 }
 
-func (x *RenameContainerEntryRequest) GetOrganizationId() string {
+func (x *UpdateDeploymentHealthCheckRequest) GetIntervalSeconds() int32 {
 	if x != nil {
-		return x.OrganizationId
+		return x.IntervalSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *RenameContainerEntryRequest) GetDeploymentId() string {
+func (x *UpdateDeploymentHealthCheckRequest) GetTimeoutSeconds() int32 {
 	if x != nil {
-		return x.DeploymentId
+		return x.TimeoutSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *RenameContainerEntryRequest) GetSourcePath() string {
+func (x *UpdateDeploymentHealthCheckRequest) GetRetries() int32 {
 	if x != nil {
-		return x.SourcePath
+		return x.Retries
 	}
-	return ""
+	return 0
 }
 
-func (x *RenameContainerEntryRequest) GetTargetPath() string {
-	if x != nil {
-		return x.TargetPath
+func (x *UpdateDeploymentHealthCheckRequest) GetExpectedStatus() int32 {
+	if x != nil && x.ExpectedStatus != nil {
+		return *x.ExpectedStatus
 	}
-	return ""
+	return 0
 }
 
-func (x *RenameContainerEntryRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
-	}
-	return ""
+type UpdateDeploymentHealthCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameContainerEntryRequest) GetOverwrite() bool {
+func (x *UpdateDeploymentHealthCheckResponse) Reset() {
+	*x = UpdateDeploymentHealthCheckResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeploymentHealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeploymentHealthCheckResponse) ProtoMessage() {}
+
+func (x *UpdateDeploymentHealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[110]
 	if x != nil {
-		return x.Overwrite
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type RenameContainerEntryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Entry         *ContainerFile         `protobuf:"bytes,2,opt,name=entry,proto3,oneof" json:"entry,omitempty"`
+// Deprecated: Use UpdateDeploymentHealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDeploymentHealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UpdateDeploymentHealthCheckResponse) GetDeployment() *Deployment {
+	if x != nil {
+		return x.Deployment
+	}
+	return nil
+}
+
+// AffinityRule places a deployment relative to the organization's deployments tagged label_key=label_value
+type AffinityRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "affinity" prefers nodes already running a matching deployment, "anti-affinity" excludes them
+	RuleType      string `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	LabelKey      string `protobuf:"bytes,2,opt,name=label_key,json=labelKey,proto3" json:"label_key,omitempty"`
+	LabelValue    string `protobuf:"bytes,3,opt,name=label_value,json=labelValue,proto3" json:"label_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameContainerEntryResponse) Reset() {
-	*x = RenameContainerEntryResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[70]
+func (x *AffinityRule) Reset() {
+	*x = AffinityRule{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameContainerEntryResponse) String() string {
+func (x *AffinityRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameContainerEntryResponse) ProtoMessage() {}
+func (*AffinityRule) ProtoMessage() {}
 
-func (x *RenameContainerEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[70]
+func (x *AffinityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5090,56 +7718,56 @@ func (x *RenameContainerEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameContainerEntryResponse.ProtoReflect.Descriptor instead.
-func (*RenameContainerEntryResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use AffinityRule.ProtoReflect.Descriptor instead.
+func (*AffinityRule) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *RenameContainerEntryResponse) GetSuccess() bool {
+func (x *AffinityRule) GetRuleType() string {
 	if x != nil {
-		return x.Success
+		return x.RuleType
 	}
-	return false
+	return ""
 }
 
-func (x *RenameContainerEntryResponse) GetEntry() *ContainerFile {
+func (x *AffinityRule) GetLabelKey() string {
 	if x != nil {
-		return x.Entry
+		return x.LabelKey
 	}
-	return nil
+	return ""
 }
 
-type CreateContainerEntryRequest struct {
+func (x *AffinityRule) GetLabelValue() string {
+	if x != nil {
+		return x.LabelValue
+	}
+	return ""
+}
+
+type SetDeploymentAffinityRulesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	ParentPath     string                 `protobuf:"bytes,3,opt,name=parent_path,json=parentPath,proto3" json:"parent_path,omitempty"`
-	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Type           ContainerEntryType     `protobuf:"varint,5,opt,name=type,proto3,enum=obiente.cloud.deployments.v1.ContainerEntryType" json:"type,omitempty"`
-	Template       *string                `protobuf:"bytes,6,opt,name=template,proto3,oneof" json:"template,omitempty"` // Optional template identifier for seeded content (required for symlinks - target path)
-	VolumeName     *string                `protobuf:"bytes,7,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
-	ModeOctal      *uint32                `protobuf:"varint,8,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
-	ContainerId    *string                `protobuf:"bytes,9,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"`  // Specific container to create entry in (for compose deployments)
-	ServiceName    *string                `protobuf:"bytes,10,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"` // Service name to create entry in (for compose deployments)
+	Rules          []*AffinityRule        `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"` // Replaces all existing rules; empty clears them
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateContainerEntryRequest) Reset() {
-	*x = CreateContainerEntryRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[71]
+func (x *SetDeploymentAffinityRulesRequest) Reset() {
+	*x = SetDeploymentAffinityRulesRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateContainerEntryRequest) String() string {
+func (x *SetDeploymentAffinityRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateContainerEntryRequest) ProtoMessage() {}
+func (*SetDeploymentAffinityRulesRequest) ProtoMessage() {}
 
-func (x *CreateContainerEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[71]
+func (x *SetDeploymentAffinityRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5150,103 +7778,150 @@ func (x *CreateContainerEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateContainerEntryRequest.ProtoReflect.Descriptor instead.
-func (*CreateContainerEntryRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use SetDeploymentAffinityRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetDeploymentAffinityRulesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *CreateContainerEntryRequest) GetOrganizationId() string {
+func (x *SetDeploymentAffinityRulesRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *CreateContainerEntryRequest) GetDeploymentId() string {
+func (x *SetDeploymentAffinityRulesRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *CreateContainerEntryRequest) GetParentPath() string {
+func (x *SetDeploymentAffinityRulesRequest) GetRules() []*AffinityRule {
 	if x != nil {
-		return x.ParentPath
+		return x.Rules
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateContainerEntryRequest) GetName() string {
+type SetDeploymentAffinityRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AffinityRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDeploymentAffinityRulesResponse) Reset() {
+	*x = SetDeploymentAffinityRulesResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDeploymentAffinityRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeploymentAffinityRulesResponse) ProtoMessage() {}
+
+func (x *SetDeploymentAffinityRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[113]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateContainerEntryRequest) GetType() ContainerEntryType {
+// Deprecated: Use SetDeploymentAffinityRulesResponse.ProtoReflect.Descriptor instead.
+func (*SetDeploymentAffinityRulesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SetDeploymentAffinityRulesResponse) GetRules() []*AffinityRule {
 	if x != nil {
-		return x.Type
+		return x.Rules
 	}
-	return ContainerEntryType_CONTAINER_ENTRY_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *CreateContainerEntryRequest) GetTemplate() string {
-	if x != nil && x.Template != nil {
-		return *x.Template
-	}
-	return ""
+type GetDeploymentAffinityRulesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateContainerEntryRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
-	}
-	return ""
+func (x *GetDeploymentAffinityRulesRequest) Reset() {
+	*x = GetDeploymentAffinityRulesRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateContainerEntryRequest) GetModeOctal() uint32 {
-	if x != nil && x.ModeOctal != nil {
-		return *x.ModeOctal
+func (x *GetDeploymentAffinityRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeploymentAffinityRulesRequest) ProtoMessage() {}
+
+func (x *GetDeploymentAffinityRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateContainerEntryRequest) GetContainerId() string {
-	if x != nil && x.ContainerId != nil {
-		return *x.ContainerId
+// Deprecated: Use GetDeploymentAffinityRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentAffinityRulesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetDeploymentAffinityRulesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *CreateContainerEntryRequest) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+func (x *GetDeploymentAffinityRulesRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
 	}
 	return ""
 }
 
-type CreateContainerEntryResponse struct {
+type GetDeploymentAffinityRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entry         *ContainerFile         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Rules         []*AffinityRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateContainerEntryResponse) Reset() {
-	*x = CreateContainerEntryResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[72]
+func (x *GetDeploymentAffinityRulesResponse) Reset() {
+	*x = GetDeploymentAffinityRulesResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateContainerEntryResponse) String() string {
+func (x *GetDeploymentAffinityRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateContainerEntryResponse) ProtoMessage() {}
+func (*GetDeploymentAffinityRulesResponse) ProtoMessage() {}
 
-func (x *CreateContainerEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[72]
+func (x *GetDeploymentAffinityRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5257,47 +7932,46 @@ func (x *CreateContainerEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateContainerEntryResponse.ProtoReflect.Descriptor instead.
-func (*CreateContainerEntryResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use GetDeploymentAffinityRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetDeploymentAffinityRulesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *CreateContainerEntryResponse) GetEntry() *ContainerFile {
+func (x *GetDeploymentAffinityRulesResponse) GetRules() []*AffinityRule {
 	if x != nil {
-		return x.Entry
+		return x.Rules
 	}
 	return nil
 }
 
-type WriteContainerFileRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DeploymentId    string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Path            string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	VolumeName      *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
-	Content         string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	Encoding        string                 `protobuf:"bytes,6,opt,name=encoding,proto3" json:"encoding,omitempty"` // "text" or "base64"
-	CreateIfMissing bool                   `protobuf:"varint,7,opt,name=create_if_missing,json=createIfMissing,proto3" json:"create_if_missing,omitempty"`
-	ModeOctal       *uint32                `protobuf:"varint,8,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ScaleSchedule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	CronExpression string                 `protobuf:"bytes,3,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"` // Five fields (minute hour day-of-month month day-of-week), evaluated in UTC
+	ReplicaCount   int32                  `protobuf:"varint,4,opt,name=replica_count,json=replicaCount,proto3" json:"replica_count,omitempty"`      // 0 stops the deployment
+	NextRunAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	LastRunAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_run_at,json=lastRunAt,proto3,oneof" json:"last_run_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *WriteContainerFileRequest) Reset() {
-	*x = WriteContainerFileRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[73]
+func (x *ScaleSchedule) Reset() {
+	*x = ScaleSchedule{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WriteContainerFileRequest) String() string {
+func (x *ScaleSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteContainerFileRequest) ProtoMessage() {}
+func (*ScaleSchedule) ProtoMessage() {}
 
-func (x *WriteContainerFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[73]
+func (x *ScaleSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5308,91 +7982,150 @@ func (x *WriteContainerFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteContainerFileRequest.ProtoReflect.Descriptor instead.
-func (*WriteContainerFileRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use ScaleSchedule.ProtoReflect.Descriptor instead.
+func (*ScaleSchedule) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *WriteContainerFileRequest) GetOrganizationId() string {
+func (x *ScaleSchedule) GetId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *WriteContainerFileRequest) GetDeploymentId() string {
+func (x *ScaleSchedule) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *WriteContainerFileRequest) GetPath() string {
+func (x *ScaleSchedule) GetCronExpression() string {
 	if x != nil {
-		return x.Path
+		return x.CronExpression
 	}
 	return ""
 }
 
-func (x *WriteContainerFileRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
+func (x *ScaleSchedule) GetReplicaCount() int32 {
+	if x != nil {
+		return x.ReplicaCount
 	}
-	return ""
+	return 0
 }
 
-func (x *WriteContainerFileRequest) GetContent() string {
+func (x *ScaleSchedule) GetNextRunAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Content
+		return x.NextRunAt
 	}
-	return ""
+	return nil
 }
 
-func (x *WriteContainerFileRequest) GetEncoding() string {
+func (x *ScaleSchedule) GetLastRunAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Encoding
+		return x.LastRunAt
+	}
+	return nil
+}
+
+func (x *ScaleSchedule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateScaleScheduleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	CronExpression string                 `protobuf:"bytes,3,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	ReplicaCount   int32                  `protobuf:"varint,4,opt,name=replica_count,json=replicaCount,proto3" json:"replica_count,omitempty"` // Between 0 and the plan's maximum replicas per deployment
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateScaleScheduleRequest) Reset() {
+	*x = CreateScaleScheduleRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScaleScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScaleScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScaleScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScaleScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScaleScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CreateScaleScheduleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *WriteContainerFileRequest) GetCreateIfMissing() bool {
+func (x *CreateScaleScheduleRequest) GetDeploymentId() string {
 	if x != nil {
-		return x.CreateIfMissing
+		return x.DeploymentId
 	}
-	return false
+	return ""
 }
 
-func (x *WriteContainerFileRequest) GetModeOctal() uint32 {
-	if x != nil && x.ModeOctal != nil {
-		return *x.ModeOctal
+func (x *CreateScaleScheduleRequest) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *CreateScaleScheduleRequest) GetReplicaCount() int32 {
+	if x != nil {
+		return x.ReplicaCount
 	}
 	return 0
 }
 
-type WriteContainerFileResponse struct {
+type CreateScaleScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Entry         *ContainerFile         `protobuf:"bytes,2,opt,name=entry,proto3,oneof" json:"entry,omitempty"`
-	Error         *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Schedule      *ScaleSchedule         `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WriteContainerFileResponse) Reset() {
-	*x = WriteContainerFileResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[74]
+func (x *CreateScaleScheduleResponse) Reset() {
+	*x = CreateScaleScheduleResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WriteContainerFileResponse) String() string {
+func (x *CreateScaleScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteContainerFileResponse) ProtoMessage() {}
+func (*CreateScaleScheduleResponse) ProtoMessage() {}
 
-func (x *WriteContainerFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[74]
+func (x *CreateScaleScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5403,60 +8136,42 @@ func (x *WriteContainerFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteContainerFileResponse.ProtoReflect.Descriptor instead.
-func (*WriteContainerFileResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{74}
-}
-
-func (x *WriteContainerFileResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use CreateScaleScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateScaleScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *WriteContainerFileResponse) GetEntry() *ContainerFile {
+func (x *CreateScaleScheduleResponse) GetSchedule() *ScaleSchedule {
 	if x != nil {
-		return x.Entry
+		return x.Schedule
 	}
 	return nil
 }
 
-func (x *WriteContainerFileResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
-}
-
-type ExtractDeploymentFileRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	DeploymentId    string                 `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	OrganizationId  string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	ZipPath         string                 `protobuf:"bytes,3,opt,name=zip_path,json=zipPath,proto3" json:"zip_path,omitempty"`                         // Path to the zip file to extract (from common.ExtractServerFileRequest)
-	DestinationPath string                 `protobuf:"bytes,4,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"` // Directory path where files should be extracted (from common.ExtractServerFileRequest)
-	VolumeName      *string                `protobuf:"bytes,5,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`          // If specified, extract to this volume instead of container filesystem (from common.ExtractServerFileRequest)
-	ContainerId     *string                `protobuf:"bytes,6,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"`       // If specified, extract to this container
-	ServiceName     *string                `protobuf:"bytes,7,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"`       // If specified, extract to container with this service name
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type DeleteScaleScheduleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	ScheduleId     string                 `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ExtractDeploymentFileRequest) Reset() {
-	*x = ExtractDeploymentFileRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[75]
+func (x *DeleteScaleScheduleRequest) Reset() {
+	*x = DeleteScaleScheduleRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExtractDeploymentFileRequest) String() string {
+func (x *DeleteScaleScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExtractDeploymentFileRequest) ProtoMessage() {}
+func (*DeleteScaleScheduleRequest) ProtoMessage() {}
 
-func (x *ExtractDeploymentFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[75]
+func (x *DeleteScaleScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5467,84 +8182,54 @@ func (x *ExtractDeploymentFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExtractDeploymentFileRequest.ProtoReflect.Descriptor instead.
-func (*ExtractDeploymentFileRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{75}
-}
-
-func (x *ExtractDeploymentFileRequest) GetDeploymentId() string {
-	if x != nil {
-		return x.DeploymentId
-	}
-	return ""
+// Deprecated: Use DeleteScaleScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScaleScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *ExtractDeploymentFileRequest) GetOrganizationId() string {
+func (x *DeleteScaleScheduleRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ExtractDeploymentFileRequest) GetZipPath() string {
+func (x *DeleteScaleScheduleRequest) GetDeploymentId() string {
 	if x != nil {
-		return x.ZipPath
+		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *ExtractDeploymentFileRequest) GetDestinationPath() string {
+func (x *DeleteScaleScheduleRequest) GetScheduleId() string {
 	if x != nil {
-		return x.DestinationPath
-	}
-	return ""
-}
-
-func (x *ExtractDeploymentFileRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
-	}
-	return ""
-}
-
-func (x *ExtractDeploymentFileRequest) GetContainerId() string {
-	if x != nil && x.ContainerId != nil {
-		return *x.ContainerId
-	}
-	return ""
-}
-
-func (x *ExtractDeploymentFileRequest) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+		return x.ScheduleId
 	}
 	return ""
 }
 
-type ExtractDeploymentFileResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                     // From common.ExtractServerFileResponse
-	Error          *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`                                    // From common.ExtractServerFileResponse
-	FilesExtracted int32                  `protobuf:"varint,3,opt,name=files_extracted,json=filesExtracted,proto3" json:"files_extracted,omitempty"` // Number of files successfully extracted (from common.ExtractServerFileResponse)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DeleteScaleScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExtractDeploymentFileResponse) Reset() {
-	*x = ExtractDeploymentFileResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[76]
+func (x *DeleteScaleScheduleResponse) Reset() {
+	*x = DeleteScaleScheduleResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExtractDeploymentFileResponse) String() string {
+func (x *DeleteScaleScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExtractDeploymentFileResponse) ProtoMessage() {}
+func (*DeleteScaleScheduleResponse) ProtoMessage() {}
 
-func (x *ExtractDeploymentFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[76]
+func (x *DeleteScaleScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5555,59 +8240,41 @@ func (x *ExtractDeploymentFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExtractDeploymentFileResponse.ProtoReflect.Descriptor instead.
-func (*ExtractDeploymentFileResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use DeleteScaleScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScaleScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *ExtractDeploymentFileResponse) GetSuccess() bool {
+func (x *DeleteScaleScheduleResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ExtractDeploymentFileResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
-}
-
-func (x *ExtractDeploymentFileResponse) GetFilesExtracted() int32 {
-	if x != nil {
-		return x.FilesExtracted
-	}
-	return 0
-}
-
-type CreateDeploymentFileArchiveRequest struct {
-	state          protoimpl.MessageState             `protogen:"open.v1"`
-	DeploymentId   string                             `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	OrganizationId string                             `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	ArchiveRequest *v1.CreateServerFileArchiveRequest `protobuf:"bytes,3,opt,name=archive_request,json=archiveRequest,proto3" json:"archive_request,omitempty"` // Shared archive request
-	VolumeName     *string                            `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`       // If specified, create archive in this volume instead of container filesystem
-	ContainerId    *string                            `protobuf:"bytes,5,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"`    // If specified, create archive in this container
-	ServiceName    *string                            `protobuf:"bytes,6,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"`    // If specified, create archive in container with this service name
+type ListScaleSchedulesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateDeploymentFileArchiveRequest) Reset() {
-	*x = CreateDeploymentFileArchiveRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[77]
+func (x *ListScaleSchedulesRequest) Reset() {
+	*x = ListScaleSchedulesRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDeploymentFileArchiveRequest) String() string {
+func (x *ListScaleSchedulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDeploymentFileArchiveRequest) ProtoMessage() {}
+func (*ListScaleSchedulesRequest) ProtoMessage() {}
 
-func (x *CreateDeploymentFileArchiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[77]
+func (x *ListScaleSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5618,75 +8285,47 @@ func (x *CreateDeploymentFileArchiveRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDeploymentFileArchiveRequest.ProtoReflect.Descriptor instead.
-func (*CreateDeploymentFileArchiveRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{77}
-}
-
-func (x *CreateDeploymentFileArchiveRequest) GetDeploymentId() string {
-	if x != nil {
-		return x.DeploymentId
-	}
-	return ""
+// Deprecated: Use ListScaleSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListScaleSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *CreateDeploymentFileArchiveRequest) GetOrganizationId() string {
+func (x *ListScaleSchedulesRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *CreateDeploymentFileArchiveRequest) GetArchiveRequest() *v1.CreateServerFileArchiveRequest {
+func (x *ListScaleSchedulesRequest) GetDeploymentId() string {
 	if x != nil {
-		return x.ArchiveRequest
-	}
-	return nil
-}
-
-func (x *CreateDeploymentFileArchiveRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
-	}
-	return ""
-}
-
-func (x *CreateDeploymentFileArchiveRequest) GetContainerId() string {
-	if x != nil && x.ContainerId != nil {
-		return *x.ContainerId
-	}
-	return ""
-}
-
-func (x *CreateDeploymentFileArchiveRequest) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+		return x.DeploymentId
 	}
 	return ""
 }
 
-type CreateDeploymentFileArchiveResponse struct {
-	state           protoimpl.MessageState              `protogen:"open.v1"`
-	ArchiveResponse *v1.CreateServerFileArchiveResponse `protobuf:"bytes,1,opt,name=archive_response,json=archiveResponse,proto3" json:"archive_response,omitempty"` // Shared archive response
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ListScaleSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*ScaleSchedule       `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDeploymentFileArchiveResponse) Reset() {
-	*x = CreateDeploymentFileArchiveResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[78]
+func (x *ListScaleSchedulesResponse) Reset() {
+	*x = ListScaleSchedulesResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDeploymentFileArchiveResponse) String() string {
+func (x *ListScaleSchedulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDeploymentFileArchiveResponse) ProtoMessage() {}
+func (*ListScaleSchedulesResponse) ProtoMessage() {}
 
-func (x *CreateDeploymentFileArchiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[78]
+func (x *ListScaleSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5697,49 +8336,48 @@ func (x *CreateDeploymentFileArchiveResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDeploymentFileArchiveResponse.ProtoReflect.Descriptor instead.
-func (*CreateDeploymentFileArchiveResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use ListScaleSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListScaleSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *CreateDeploymentFileArchiveResponse) GetArchiveResponse() *v1.CreateServerFileArchiveResponse {
+func (x *ListScaleSchedulesResponse) GetSchedules() []*ScaleSchedule {
 	if x != nil {
-		return x.ArchiveResponse
+		return x.Schedules
 	}
 	return nil
 }
 
-// Routing configuration messages
-type RoutingRule struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DeploymentId    string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Domain          string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
-	ServiceName     string                 `protobuf:"bytes,4,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"` // Service name (e.g., "api", "web", "admin", "default")
-	PathPrefix      string                 `protobuf:"bytes,5,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`    // Optional path prefix (e.g., "/api")
-	TargetPort      int32                  `protobuf:"varint,6,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
-	Protocol        string                 `protobuf:"bytes,7,opt,name=protocol,proto3" json:"protocol,omitempty"` // "http", "https", "grpc"
-	SslEnabled      bool                   `protobuf:"varint,8,opt,name=ssl_enabled,json=sslEnabled,proto3" json:"ssl_enabled,omitempty"`
-	SslCertResolver string                 `protobuf:"bytes,9,opt,name=ssl_cert_resolver,json=sslCertResolver,proto3" json:"ssl_cert_resolver,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *RoutingRule) Reset() {
-	*x = RoutingRule{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[79]
+type AutoScalePolicy struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	DeploymentId             string                 `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	MinReplicas              int32                  `protobuf:"varint,2,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`                                            // At least 1
+	MaxReplicas              int32                  `protobuf:"varint,3,opt,name=max_replicas,json=maxReplicas,proto3" json:"max_replicas,omitempty"`                                            // At most the plan's maximum replicas per deployment
+	TargetCpuPercent         float64                `protobuf:"fixed64,4,opt,name=target_cpu_percent,json=targetCpuPercent,proto3" json:"target_cpu_percent,omitempty"`                          // Average CPU utilization per replica to hold; 100 is one full core
+	ScaleUpCooldownSeconds   int32                  `protobuf:"varint,5,opt,name=scale_up_cooldown_seconds,json=scaleUpCooldownSeconds,proto3" json:"scale_up_cooldown_seconds,omitempty"`       // Minimum time after a scaling before scaling up again
+	ScaleDownCooldownSeconds int32                  `protobuf:"varint,6,opt,name=scale_down_cooldown_seconds,json=scaleDownCooldownSeconds,proto3" json:"scale_down_cooldown_seconds,omitempty"` // Minimum time after a scaling before scaling down again
+	Enabled                  bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LastScaledAt             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_scaled_at,json=lastScaledAt,proto3,oneof" json:"last_scaled_at,omitempty"`
+	UpdatedAt                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AutoScalePolicy) Reset() {
+	*x = AutoScalePolicy{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoutingRule) String() string {
+func (x *AutoScalePolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoutingRule) ProtoMessage() {}
+func (*AutoScalePolicy) ProtoMessage() {}
 
-func (x *RoutingRule) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[79]
+func (x *AutoScalePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5750,97 +8388,102 @@ func (x *RoutingRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoutingRule.ProtoReflect.Descriptor instead.
-func (*RoutingRule) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use AutoScalePolicy.ProtoReflect.Descriptor instead.
+func (*AutoScalePolicy) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *RoutingRule) GetId() string {
+func (x *AutoScalePolicy) GetDeploymentId() string {
 	if x != nil {
-		return x.Id
+		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *RoutingRule) GetDeploymentId() string {
+func (x *AutoScalePolicy) GetMinReplicas() int32 {
 	if x != nil {
-		return x.DeploymentId
+		return x.MinReplicas
 	}
-	return ""
+	return 0
 }
 
-func (x *RoutingRule) GetDomain() string {
+func (x *AutoScalePolicy) GetMaxReplicas() int32 {
 	if x != nil {
-		return x.Domain
+		return x.MaxReplicas
 	}
-	return ""
+	return 0
 }
 
-func (x *RoutingRule) GetServiceName() string {
+func (x *AutoScalePolicy) GetTargetCpuPercent() float64 {
 	if x != nil {
-		return x.ServiceName
+		return x.TargetCpuPercent
 	}
-	return ""
+	return 0
 }
 
-func (x *RoutingRule) GetPathPrefix() string {
+func (x *AutoScalePolicy) GetScaleUpCooldownSeconds() int32 {
 	if x != nil {
-		return x.PathPrefix
+		return x.ScaleUpCooldownSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *RoutingRule) GetTargetPort() int32 {
+func (x *AutoScalePolicy) GetScaleDownCooldownSeconds() int32 {
 	if x != nil {
-		return x.TargetPort
+		return x.ScaleDownCooldownSeconds
 	}
 	return 0
 }
 
-func (x *RoutingRule) GetProtocol() string {
+func (x *AutoScalePolicy) GetEnabled() bool {
 	if x != nil {
-		return x.Protocol
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *RoutingRule) GetSslEnabled() bool {
+func (x *AutoScalePolicy) GetLastScaledAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SslEnabled
+		return x.LastScaledAt
 	}
-	return false
+	return nil
 }
 
-func (x *RoutingRule) GetSslCertResolver() string {
+func (x *AutoScalePolicy) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SslCertResolver
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type GetDeploymentRoutingsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type SetAutoScalePolicyRequest struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId           string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DeploymentId             string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	MinReplicas              int32                  `protobuf:"varint,3,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
+	MaxReplicas              int32                  `protobuf:"varint,4,opt,name=max_replicas,json=maxReplicas,proto3" json:"max_replicas,omitempty"`
+	TargetCpuPercent         float64                `protobuf:"fixed64,5,opt,name=target_cpu_percent,json=targetCpuPercent,proto3" json:"target_cpu_percent,omitempty"`
+	ScaleUpCooldownSeconds   int32                  `protobuf:"varint,6,opt,name=scale_up_cooldown_seconds,json=scaleUpCooldownSeconds,proto3" json:"scale_up_cooldown_seconds,omitempty"`
+	ScaleDownCooldownSeconds int32                  `protobuf:"varint,7,opt,name=scale_down_cooldown_seconds,json=scaleDownCooldownSeconds,proto3" json:"scale_down_cooldown_seconds,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *GetDeploymentRoutingsRequest) Reset() {
-	*x = GetDeploymentRoutingsRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[80]
+func (x *SetAutoScalePolicyRequest) Reset() {
+	*x = SetAutoScalePolicyRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeploymentRoutingsRequest) String() string {
+func (x *SetAutoScalePolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentRoutingsRequest) ProtoMessage() {}
+func (*SetAutoScalePolicyRequest) ProtoMessage() {}
 
-func (x *GetDeploymentRoutingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[80]
+func (x *SetAutoScalePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5851,47 +8494,82 @@ func (x *GetDeploymentRoutingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentRoutingsRequest.ProtoReflect.Descriptor instead.
-func (*GetDeploymentRoutingsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use SetAutoScalePolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetAutoScalePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *GetDeploymentRoutingsRequest) GetOrganizationId() string {
+func (x *SetAutoScalePolicyRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GetDeploymentRoutingsRequest) GetDeploymentId() string {
+func (x *SetAutoScalePolicyRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-type GetDeploymentRoutingsResponse struct {
+func (x *SetAutoScalePolicyRequest) GetMinReplicas() int32 {
+	if x != nil {
+		return x.MinReplicas
+	}
+	return 0
+}
+
+func (x *SetAutoScalePolicyRequest) GetMaxReplicas() int32 {
+	if x != nil {
+		return x.MaxReplicas
+	}
+	return 0
+}
+
+func (x *SetAutoScalePolicyRequest) GetTargetCpuPercent() float64 {
+	if x != nil {
+		return x.TargetCpuPercent
+	}
+	return 0
+}
+
+func (x *SetAutoScalePolicyRequest) GetScaleUpCooldownSeconds() int32 {
+	if x != nil {
+		return x.ScaleUpCooldownSeconds
+	}
+	return 0
+}
+
+func (x *SetAutoScalePolicyRequest) GetScaleDownCooldownSeconds() int32 {
+	if x != nil {
+		return x.ScaleDownCooldownSeconds
+	}
+	return 0
+}
+
+type SetAutoScalePolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rules         []*RoutingRule         `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	Policy        *AutoScalePolicy       `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeploymentRoutingsResponse) Reset() {
-	*x = GetDeploymentRoutingsResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[81]
+func (x *SetAutoScalePolicyResponse) Reset() {
+	*x = SetAutoScalePolicyResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeploymentRoutingsResponse) String() string {
+func (x *SetAutoScalePolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentRoutingsResponse) ProtoMessage() {}
+func (*SetAutoScalePolicyResponse) ProtoMessage() {}
 
-func (x *GetDeploymentRoutingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[81]
+func (x *SetAutoScalePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5902,42 +8580,41 @@ func (x *GetDeploymentRoutingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentRoutingsResponse.ProtoReflect.Descriptor instead.
-func (*GetDeploymentRoutingsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use SetAutoScalePolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetAutoScalePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GetDeploymentRoutingsResponse) GetRules() []*RoutingRule {
+func (x *SetAutoScalePolicyResponse) GetPolicy() *AutoScalePolicy {
 	if x != nil {
-		return x.Rules
+		return x.Policy
 	}
 	return nil
 }
 
-type UpdateDeploymentRoutingsRequest struct {
+type GetAutoScalePolicyRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Rules          []*RoutingRule         `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"` // Replace all existing rules with these
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateDeploymentRoutingsRequest) Reset() {
-	*x = UpdateDeploymentRoutingsRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[82]
+func (x *GetAutoScalePolicyRequest) Reset() {
+	*x = GetAutoScalePolicyRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDeploymentRoutingsRequest) String() string {
+func (x *GetAutoScalePolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeploymentRoutingsRequest) ProtoMessage() {}
+func (*GetAutoScalePolicyRequest) ProtoMessage() {}
 
-func (x *UpdateDeploymentRoutingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[82]
+func (x *GetAutoScalePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5948,54 +8625,47 @@ func (x *UpdateDeploymentRoutingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeploymentRoutingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDeploymentRoutingsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use GetAutoScalePolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetAutoScalePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *UpdateDeploymentRoutingsRequest) GetOrganizationId() string {
+func (x *GetAutoScalePolicyRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *UpdateDeploymentRoutingsRequest) GetDeploymentId() string {
+func (x *GetAutoScalePolicyRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *UpdateDeploymentRoutingsRequest) GetRules() []*RoutingRule {
-	if x != nil {
-		return x.Rules
-	}
-	return nil
-}
-
-type UpdateDeploymentRoutingsResponse struct {
+type GetAutoScalePolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rules         []*RoutingRule         `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	Policy        *AutoScalePolicy       `protobuf:"bytes,1,opt,name=policy,proto3,oneof" json:"policy,omitempty"` // Unset when the deployment never had a policy
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDeploymentRoutingsResponse) Reset() {
-	*x = UpdateDeploymentRoutingsResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[83]
+func (x *GetAutoScalePolicyResponse) Reset() {
+	*x = GetAutoScalePolicyResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDeploymentRoutingsResponse) String() string {
+func (x *GetAutoScalePolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeploymentRoutingsResponse) ProtoMessage() {}
+func (*GetAutoScalePolicyResponse) ProtoMessage() {}
 
-func (x *UpdateDeploymentRoutingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[83]
+func (x *GetAutoScalePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6006,19 +8676,19 @@ func (x *UpdateDeploymentRoutingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeploymentRoutingsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDeploymentRoutingsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use GetAutoScalePolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetAutoScalePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *UpdateDeploymentRoutingsResponse) GetRules() []*RoutingRule {
+func (x *GetAutoScalePolicyResponse) GetPolicy() *AutoScalePolicy {
 	if x != nil {
-		return x.Rules
+		return x.Policy
 	}
 	return nil
 }
 
-type GetDeploymentServiceNamesRequest struct {
+type DisableAutoScaleRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
@@ -6026,21 +8696,21 @@ type GetDeploymentServiceNamesRequest struct {
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetDeploymentServiceNamesRequest) Reset() {
-	*x = GetDeploymentServiceNamesRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[84]
+func (x *DisableAutoScaleRequest) Reset() {
+	*x = DisableAutoScaleRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeploymentServiceNamesRequest) String() string {
+func (x *DisableAutoScaleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentServiceNamesRequest) ProtoMessage() {}
+func (*DisableAutoScaleRequest) ProtoMessage() {}
 
-func (x *GetDeploymentServiceNamesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[84]
+func (x *DisableAutoScaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6051,47 +8721,47 @@ func (x *GetDeploymentServiceNamesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentServiceNamesRequest.ProtoReflect.Descriptor instead.
-func (*GetDeploymentServiceNamesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use DisableAutoScaleRequest.ProtoReflect.Descriptor instead.
+func (*DisableAutoScaleRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GetDeploymentServiceNamesRequest) GetOrganizationId() string {
+func (x *DisableAutoScaleRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GetDeploymentServiceNamesRequest) GetDeploymentId() string {
+func (x *DisableAutoScaleRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-type GetDeploymentServiceNamesResponse struct {
+type DisableAutoScaleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceNames  []string               `protobuf:"bytes,1,rep,name=service_names,json=serviceNames,proto3" json:"service_names,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeploymentServiceNamesResponse) Reset() {
-	*x = GetDeploymentServiceNamesResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[85]
+func (x *DisableAutoScaleResponse) Reset() {
+	*x = DisableAutoScaleResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeploymentServiceNamesResponse) String() string {
+func (x *DisableAutoScaleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentServiceNamesResponse) ProtoMessage() {}
+func (*DisableAutoScaleResponse) ProtoMessage() {}
 
-func (x *GetDeploymentServiceNamesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[85]
+func (x *DisableAutoScaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6102,43 +8772,48 @@ func (x *GetDeploymentServiceNamesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentServiceNamesResponse.ProtoReflect.Descriptor instead.
-func (*GetDeploymentServiceNamesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use DisableAutoScaleResponse.ProtoReflect.Descriptor instead.
+func (*DisableAutoScaleResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *GetDeploymentServiceNamesResponse) GetServiceNames() []string {
+func (x *DisableAutoScaleResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ServiceNames
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// Domain verification messages
-type GetDomainVerificationTokenRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DeploymentRegionStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Primary       bool                   `protobuf:"varint,2,opt,name=primary,proto3" json:"primary,omitempty"`                              // The primary region; the others are replicas
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                 // pending, provisioned or failed
+	HealthStatus  string                 `protobuf:"bytes,4,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"` // healthy, unhealthy or unknown
+	ContainerId   string                 `protobuf:"bytes,5,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	TraefikIp     string                 `protobuf:"bytes,6,opt,name=traefik_ip,json=traefikIp,proto3" json:"traefik_ip,omitempty"`
+	NodeId        string                 `protobuf:"bytes,7,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Error         *string                `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"` // Why provisioning failed
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDomainVerificationTokenRequest) Reset() {
-	*x = GetDomainVerificationTokenRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[86]
+func (x *DeploymentRegionStatus) Reset() {
+	*x = DeploymentRegionStatus{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDomainVerificationTokenRequest) String() string {
+func (x *DeploymentRegionStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDomainVerificationTokenRequest) ProtoMessage() {}
+func (*DeploymentRegionStatus) ProtoMessage() {}
 
-func (x *GetDomainVerificationTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[86]
+func (x *DeploymentRegionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6149,132 +8824,97 @@ func (x *GetDomainVerificationTokenRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDomainVerificationTokenRequest.ProtoReflect.Descriptor instead.
-func (*GetDomainVerificationTokenRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use DeploymentRegionStatus.ProtoReflect.Descriptor instead.
+func (*DeploymentRegionStatus) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *GetDomainVerificationTokenRequest) GetOrganizationId() string {
+func (x *DeploymentRegionStatus) GetRegion() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Region
 	}
 	return ""
 }
 
-func (x *GetDomainVerificationTokenRequest) GetDeploymentId() string {
+func (x *DeploymentRegionStatus) GetPrimary() bool {
 	if x != nil {
-		return x.DeploymentId
+		return x.Primary
 	}
-	return ""
+	return false
 }
 
-func (x *GetDomainVerificationTokenRequest) GetDomain() string {
+func (x *DeploymentRegionStatus) GetStatus() string {
 	if x != nil {
-		return x.Domain
+		return x.Status
 	}
 	return ""
 }
 
-type GetDomainVerificationTokenResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Domain         string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	Token          string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	TxtRecordName  string                 `protobuf:"bytes,3,opt,name=txt_record_name,json=txtRecordName,proto3" json:"txt_record_name,omitempty"`    // e.g., "_obiente-verification.example.com"
-	TxtRecordValue string                 `protobuf:"bytes,4,opt,name=txt_record_value,json=txtRecordValue,proto3" json:"txt_record_value,omitempty"` // e.g., "obiente-verification=abc123..."
-	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                         // "pending", "verified", "failed", "expired"
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetDomainVerificationTokenResponse) Reset() {
-	*x = GetDomainVerificationTokenResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[87]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetDomainVerificationTokenResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetDomainVerificationTokenResponse) ProtoMessage() {}
-
-func (x *GetDomainVerificationTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[87]
+func (x *DeploymentRegionStatus) GetHealthStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.HealthStatus
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetDomainVerificationTokenResponse.ProtoReflect.Descriptor instead.
-func (*GetDomainVerificationTokenResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{87}
+	return ""
 }
 
-func (x *GetDomainVerificationTokenResponse) GetDomain() string {
+func (x *DeploymentRegionStatus) GetContainerId() string {
 	if x != nil {
-		return x.Domain
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *GetDomainVerificationTokenResponse) GetToken() string {
+func (x *DeploymentRegionStatus) GetTraefikIp() string {
 	if x != nil {
-		return x.Token
+		return x.TraefikIp
 	}
 	return ""
 }
 
-func (x *GetDomainVerificationTokenResponse) GetTxtRecordName() string {
+func (x *DeploymentRegionStatus) GetNodeId() string {
 	if x != nil {
-		return x.TxtRecordName
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *GetDomainVerificationTokenResponse) GetTxtRecordValue() string {
-	if x != nil {
-		return x.TxtRecordValue
+func (x *DeploymentRegionStatus) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-func (x *GetDomainVerificationTokenResponse) GetStatus() string {
+func (x *DeploymentRegionStatus) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type VerifyDomainOwnershipRequest struct {
+type GetDeploymentRegionStatusRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	DeploymentId   string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *VerifyDomainOwnershipRequest) Reset() {
-	*x = VerifyDomainOwnershipRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[88]
+func (x *GetDeploymentRegionStatusRequest) Reset() {
+	*x = GetDeploymentRegionStatusRequest{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyDomainOwnershipRequest) String() string {
+func (x *GetDeploymentRegionStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyDomainOwnershipRequest) ProtoMessage() {}
+func (*GetDeploymentRegionStatusRequest) ProtoMessage() {}
 
-func (x *VerifyDomainOwnershipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[88]
+func (x *GetDeploymentRegionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6285,57 +8925,47 @@ func (x *VerifyDomainOwnershipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyDomainOwnershipRequest.ProtoReflect.Descriptor instead.
-func (*VerifyDomainOwnershipRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use GetDeploymentRegionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentRegionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *VerifyDomainOwnershipRequest) GetOrganizationId() string {
+func (x *GetDeploymentRegionStatusRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *VerifyDomainOwnershipRequest) GetDeploymentId() string {
+func (x *GetDeploymentRegionStatusRequest) GetDeploymentId() string {
 	if x != nil {
 		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *VerifyDomainOwnershipRequest) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
-}
-
-type VerifyDomainOwnershipResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	Verified      bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`         // "verified", "failed", "pending"
-	Message       *string                `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"` // Error message if verification failed
+type GetDeploymentRegionStatusResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Regions       []*DeploymentRegionStatus `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *VerifyDomainOwnershipResponse) Reset() {
-	*x = VerifyDomainOwnershipResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[89]
+}
+
+func (x *GetDeploymentRegionStatusResponse) Reset() {
+	*x = GetDeploymentRegionStatusResponse{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyDomainOwnershipResponse) String() string {
+func (x *GetDeploymentRegionStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyDomainOwnershipResponse) ProtoMessage() {}
+func (*GetDeploymentRegionStatusResponse) ProtoMessage() {}
 
-func (x *VerifyDomainOwnershipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[89]
+func (x *GetDeploymentRegionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6346,37 +8976,16 @@ func (x *VerifyDomainOwnershipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyDomainOwnershipResponse.ProtoReflect.Descriptor instead.
-func (*VerifyDomainOwnershipResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{89}
-}
-
-func (x *VerifyDomainOwnershipResponse) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
-}
-
-func (x *VerifyDomainOwnershipResponse) GetVerified() bool {
-	if x != nil {
-		return x.Verified
-	}
-	return false
+// Deprecated: Use GetDeploymentRegionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetDeploymentRegionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *VerifyDomainOwnershipResponse) GetStatus() string {
+func (x *GetDeploymentRegionStatusResponse) GetRegions() []*DeploymentRegionStatus {
 	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *VerifyDomainOwnershipResponse) GetMessage() string {
-	if x != nil && x.Message != nil {
-		return *x.Message
+		return x.Regions
 	}
-	return ""
+	return nil
 }
 
 type GetDeploymentMetricsRequest struct {
@@ -6401,7 +9010,7 @@ type GetDeploymentMetricsRequest struct {
 
 func (x *GetDeploymentMetricsRequest) Reset() {
 	*x = GetDeploymentMetricsRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[90]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6413,7 +9022,7 @@ func (x *GetDeploymentMetricsRequest) String() string {
 func (*GetDeploymentMetricsRequest) ProtoMessage() {}
 
 func (x *GetDeploymentMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[90]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6426,7 +9035,7 @@ func (x *GetDeploymentMetricsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeploymentMetricsRequest.ProtoReflect.Descriptor instead.
 func (*GetDeploymentMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{90}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *GetDeploymentMetricsRequest) GetDeploymentId() string {
@@ -6494,7 +9103,7 @@ type GetDeploymentMetricsResponse struct {
 
 func (x *GetDeploymentMetricsResponse) Reset() {
 	*x = GetDeploymentMetricsResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[91]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6506,7 +9115,7 @@ func (x *GetDeploymentMetricsResponse) String() string {
 func (*GetDeploymentMetricsResponse) ProtoMessage() {}
 
 func (x *GetDeploymentMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[91]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6519,7 +9128,7 @@ func (x *GetDeploymentMetricsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeploymentMetricsResponse.ProtoReflect.Descriptor instead.
 func (*GetDeploymentMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{91}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *GetDeploymentMetricsResponse) GetMetrics() []*DeploymentMetric {
@@ -6548,7 +9157,7 @@ type StreamDeploymentMetricsRequest struct {
 
 func (x *StreamDeploymentMetricsRequest) Reset() {
 	*x = StreamDeploymentMetricsRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[92]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6560,7 +9169,7 @@ func (x *StreamDeploymentMetricsRequest) String() string {
 func (*StreamDeploymentMetricsRequest) ProtoMessage() {}
 
 func (x *StreamDeploymentMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[92]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6573,7 +9182,7 @@ func (x *StreamDeploymentMetricsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamDeploymentMetricsRequest.ProtoReflect.Descriptor instead.
 func (*StreamDeploymentMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{92}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *StreamDeploymentMetricsRequest) GetDeploymentId() string {
@@ -6648,7 +9257,7 @@ type DeploymentMetric struct {
 
 func (x *DeploymentMetric) Reset() {
 	*x = DeploymentMetric{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[93]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6660,7 +9269,7 @@ func (x *DeploymentMetric) String() string {
 func (*DeploymentMetric) ProtoMessage() {}
 
 func (x *DeploymentMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[93]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6673,7 +9282,7 @@ func (x *DeploymentMetric) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeploymentMetric.ProtoReflect.Descriptor instead.
 func (*DeploymentMetric) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{93}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *DeploymentMetric) GetDeploymentId() string {
@@ -6772,7 +9381,7 @@ type GetDeploymentUsageRequest struct {
 
 func (x *GetDeploymentUsageRequest) Reset() {
 	*x = GetDeploymentUsageRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[94]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6784,7 +9393,7 @@ func (x *GetDeploymentUsageRequest) String() string {
 func (*GetDeploymentUsageRequest) ProtoMessage() {}
 
 func (x *GetDeploymentUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[94]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6797,7 +9406,7 @@ func (x *GetDeploymentUsageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeploymentUsageRequest.ProtoReflect.Descriptor instead.
 func (*GetDeploymentUsageRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{94}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *GetDeploymentUsageRequest) GetDeploymentId() string {
@@ -6834,7 +9443,7 @@ type GetDeploymentUsageResponse struct {
 
 func (x *GetDeploymentUsageResponse) Reset() {
 	*x = GetDeploymentUsageResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[95]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6846,7 +9455,7 @@ func (x *GetDeploymentUsageResponse) String() string {
 func (*GetDeploymentUsageResponse) ProtoMessage() {}
 
 func (x *GetDeploymentUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[95]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6859,7 +9468,7 @@ func (x *GetDeploymentUsageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeploymentUsageResponse.ProtoReflect.Descriptor instead.
 func (*GetDeploymentUsageResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{95}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *GetDeploymentUsageResponse) GetDeploymentId() string {
@@ -6920,7 +9529,7 @@ type DeploymentUsageMetrics struct {
 
 func (x *DeploymentUsageMetrics) Reset() {
 	*x = DeploymentUsageMetrics{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[96]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6932,7 +9541,7 @@ func (x *DeploymentUsageMetrics) String() string {
 func (*DeploymentUsageMetrics) ProtoMessage() {}
 
 func (x *DeploymentUsageMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[96]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6945,7 +9554,7 @@ func (x *DeploymentUsageMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeploymentUsageMetrics.ProtoReflect.Descriptor instead.
 func (*DeploymentUsageMetrics) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{96}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *DeploymentUsageMetrics) GetCpuCoreSeconds() int64 {
@@ -7090,22 +9699,28 @@ type Deployment struct {
 	CpuLimit    *float64 `protobuf:"fixed64,38,opt,name=cpu_limit,json=cpuLimit,proto3,oneof" json:"cpu_limit,omitempty"`         // CPU limit in cores
 	MemoryLimit *int64   `protobuf:"varint,39,opt,name=memory_limit,json=memoryLimit,proto3,oneof" json:"memory_limit,omitempty"` // Memory limit in MB
 	// Health check configuration
-	HealthcheckType           *HealthCheckType        `protobuf:"varint,40,opt,name=healthcheck_type,json=healthcheckType,proto3,enum=obiente.cloud.deployments.v1.HealthCheckType,oneof" json:"healthcheck_type,omitempty"` // Type of health check
-	HealthcheckPort           *int32                  `protobuf:"varint,41,opt,name=healthcheck_port,json=healthcheckPort,proto3,oneof" json:"healthcheck_port,omitempty"`                                                   // Port to check (if different from main port)
-	HealthcheckPath           *string                 `protobuf:"bytes,42,opt,name=healthcheck_path,json=healthcheckPath,proto3,oneof" json:"healthcheck_path,omitempty"`                                                    // HTTP path (default: "/", used with HEALTHCHECK_HTTP)
-	HealthcheckExpectedStatus *int32                  `protobuf:"varint,43,opt,name=healthcheck_expected_status,json=healthcheckExpectedStatus,proto3,oneof" json:"healthcheck_expected_status,omitempty"`                   // Expected HTTP status code (default: 200, used with HEALTHCHECK_HTTP)
-	HealthcheckCustomCommand  *string                 `protobuf:"bytes,44,opt,name=healthcheck_custom_command,json=healthcheckCustomCommand,proto3,oneof" json:"healthcheck_custom_command,omitempty"`                       // Custom command (used with HEALTHCHECK_CUSTOM)
-	AutoDeploy                *bool                   `protobuf:"varint,45,opt,name=auto_deploy,json=autoDeploy,proto3,oneof" json:"auto_deploy,omitempty"`                                                                  // Automatically deploy when GitHub push webhooks arrive
-	BuildArgs                 map[string]string       `protobuf:"bytes,46,rep,name=build_args,json=buildArgs,proto3" json:"build_args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`  // Docker build args for Dockerfile deployments
-	DockerfileVolumes         []*DockerfileVolume     `protobuf:"bytes,47,rep,name=dockerfile_volumes,json=dockerfileVolumes,proto3" json:"dockerfile_volumes,omitempty"`                                                    // Persistent volume mounts for Dockerfile deployments
-	DockerfileBuildOptions    *DockerfileBuildOptions `protobuf:"bytes,48,opt,name=dockerfile_build_options,json=dockerfileBuildOptions,proto3,oneof" json:"dockerfile_build_options,omitempty"`                             // Additional Docker build options for Dockerfile deployments
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+	HealthcheckType            *HealthCheckType        `protobuf:"varint,40,opt,name=healthcheck_type,json=healthcheckType,proto3,enum=obiente.cloud.deployments.v1.HealthCheckType,oneof" json:"healthcheck_type,omitempty"` // Type of health check
+	HealthcheckPort            *int32                  `protobuf:"varint,41,opt,name=healthcheck_port,json=healthcheckPort,proto3,oneof" json:"healthcheck_port,omitempty"`                                                   // Port to check (if different from main port)
+	HealthcheckPath            *string                 `protobuf:"bytes,42,opt,name=healthcheck_path,json=healthcheckPath,proto3,oneof" json:"healthcheck_path,omitempty"`                                                    // HTTP path (default: "/", used with HEALTHCHECK_HTTP)
+	HealthcheckExpectedStatus  *int32                  `protobuf:"varint,43,opt,name=healthcheck_expected_status,json=healthcheckExpectedStatus,proto3,oneof" json:"healthcheck_expected_status,omitempty"`                   // Expected HTTP status code (default: 200, used with HEALTHCHECK_HTTP)
+	HealthcheckCustomCommand   *string                 `protobuf:"bytes,44,opt,name=healthcheck_custom_command,json=healthcheckCustomCommand,proto3,oneof" json:"healthcheck_custom_command,omitempty"`                       // Custom command (used with HEALTHCHECK_CUSTOM)
+	AutoDeploy                 *bool                   `protobuf:"varint,45,opt,name=auto_deploy,json=autoDeploy,proto3,oneof" json:"auto_deploy,omitempty"`                                                                  // Automatically deploy when GitHub push webhooks arrive
+	BuildArgs                  map[string]string       `protobuf:"bytes,46,rep,name=build_args,json=buildArgs,proto3" json:"build_args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`  // Docker build args for Dockerfile deployments
+	DockerfileVolumes          []*DockerfileVolume     `protobuf:"bytes,47,rep,name=dockerfile_volumes,json=dockerfileVolumes,proto3" json:"dockerfile_volumes,omitempty"`                                                    // Persistent volume mounts for Dockerfile deployments
+	DockerfileBuildOptions     *DockerfileBuildOptions `protobuf:"bytes,48,opt,name=dockerfile_build_options,json=dockerfileBuildOptions,proto3,oneof" json:"dockerfile_build_options,omitempty"`                             // Additional Docker build options for Dockerfile deployments
+	ActiveColor                string                  `protobuf:"bytes,49,opt,name=active_color,json=activeColor,proto3" json:"active_color,omitempty"`                                                                      // Color ("blue" or "green") of the containers currently serving traffic
+	IsPreview                  bool                    `protobuf:"varint,50,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty"`                                                                           // Ephemeral preview environment created for a GitHub pull request
+	HealthcheckIntervalSeconds *int32                  `protobuf:"varint,51,opt,name=healthcheck_interval_seconds,json=healthcheckIntervalSeconds,proto3,oneof" json:"healthcheck_interval_seconds,omitempty"`                // HTTP health check probe interval (unset when the health monitor does not probe)
+	HealthcheckTimeoutSeconds  *int32                  `protobuf:"varint,52,opt,name=healthcheck_timeout_seconds,json=healthcheckTimeoutSeconds,proto3,oneof" json:"healthcheck_timeout_seconds,omitempty"`                   // HTTP health check request timeout
+	HealthcheckRetries         *int32                  `protobuf:"varint,53,opt,name=healthcheck_retries,json=healthcheckRetries,proto3,oneof" json:"healthcheck_retries,omitempty"`                                          // Consecutive failed probes before the deployment is unhealthy
+	ZeroDowntimeDeploy         bool                    `protobuf:"varint,54,opt,name=zero_downtime_deploy,json=zeroDowntimeDeploy,proto3" json:"zero_downtime_deploy,omitempty"`                                              // Redeploys drain in-flight requests from the old containers before removing them
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
 func (x *Deployment) Reset() {
 	*x = Deployment{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[97]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7117,7 +9732,7 @@ func (x *Deployment) String() string {
 func (*Deployment) ProtoMessage() {}
 
 func (x *Deployment) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[97]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7130,7 +9745,7 @@ func (x *Deployment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Deployment.ProtoReflect.Descriptor instead.
 func (*Deployment) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{97}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *Deployment) GetId() string {
@@ -7469,6 +10084,48 @@ func (x *Deployment) GetDockerfileBuildOptions() *DockerfileBuildOptions {
 	return nil
 }
 
+func (x *Deployment) GetActiveColor() string {
+	if x != nil {
+		return x.ActiveColor
+	}
+	return ""
+}
+
+func (x *Deployment) GetIsPreview() bool {
+	if x != nil {
+		return x.IsPreview
+	}
+	return false
+}
+
+func (x *Deployment) GetHealthcheckIntervalSeconds() int32 {
+	if x != nil && x.HealthcheckIntervalSeconds != nil {
+		return *x.HealthcheckIntervalSeconds
+	}
+	return 0
+}
+
+func (x *Deployment) GetHealthcheckTimeoutSeconds() int32 {
+	if x != nil && x.HealthcheckTimeoutSeconds != nil {
+		return *x.HealthcheckTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Deployment) GetHealthcheckRetries() int32 {
+	if x != nil && x.HealthcheckRetries != nil {
+		return *x.HealthcheckRetries
+	}
+	return 0
+}
+
+func (x *Deployment) GetZeroDowntimeDeploy() bool {
+	if x != nil {
+		return x.ZeroDowntimeDeploy
+	}
+	return false
+}
+
 type DockerfileVolume struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // Safe persistent volume name, scoped to this deployment
@@ -7480,7 +10137,7 @@ type DockerfileVolume struct {
 
 func (x *DockerfileVolume) Reset() {
 	*x = DockerfileVolume{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[98]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7492,7 +10149,7 @@ func (x *DockerfileVolume) String() string {
 func (*DockerfileVolume) ProtoMessage() {}
 
 func (x *DockerfileVolume) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[98]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7505,7 +10162,7 @@ func (x *DockerfileVolume) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerfileVolume.ProtoReflect.Descriptor instead.
 func (*DockerfileVolume) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{98}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *DockerfileVolume) GetName() string {
@@ -7542,7 +10199,7 @@ type DockerfileBuildOptions struct {
 
 func (x *DockerfileBuildOptions) Reset() {
 	*x = DockerfileBuildOptions{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[99]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7554,7 +10211,7 @@ func (x *DockerfileBuildOptions) String() string {
 func (*DockerfileBuildOptions) ProtoMessage() {}
 
 func (x *DockerfileBuildOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[99]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7567,7 +10224,7 @@ func (x *DockerfileBuildOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerfileBuildOptions.ProtoReflect.Descriptor instead.
 func (*DockerfileBuildOptions) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{99}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *DockerfileBuildOptions) GetTarget() string {
@@ -7615,7 +10272,7 @@ type ListDeploymentContainersRequest struct {
 
 func (x *ListDeploymentContainersRequest) Reset() {
 	*x = ListDeploymentContainersRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[100]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7627,7 +10284,7 @@ func (x *ListDeploymentContainersRequest) String() string {
 func (*ListDeploymentContainersRequest) ProtoMessage() {}
 
 func (x *ListDeploymentContainersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[100]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7640,7 +10297,7 @@ func (x *ListDeploymentContainersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDeploymentContainersRequest.ProtoReflect.Descriptor instead.
 func (*ListDeploymentContainersRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{100}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *ListDeploymentContainersRequest) GetOrganizationId() string {
@@ -7666,7 +10323,7 @@ type ListDeploymentContainersResponse struct {
 
 func (x *ListDeploymentContainersResponse) Reset() {
 	*x = ListDeploymentContainersResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[101]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7678,7 +10335,7 @@ func (x *ListDeploymentContainersResponse) String() string {
 func (*ListDeploymentContainersResponse) ProtoMessage() {}
 
 func (x *ListDeploymentContainersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[101]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7691,7 +10348,7 @@ func (x *ListDeploymentContainersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDeploymentContainersResponse.ProtoReflect.Descriptor instead.
 func (*ListDeploymentContainersResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{101}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *ListDeploymentContainersResponse) GetContainers() []*DeploymentContainer {
@@ -7717,7 +10374,7 @@ type DeploymentContainer struct {
 
 func (x *DeploymentContainer) Reset() {
 	*x = DeploymentContainer{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[102]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7729,7 +10386,7 @@ func (x *DeploymentContainer) String() string {
 func (*DeploymentContainer) ProtoMessage() {}
 
 func (x *DeploymentContainer) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[102]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7742,7 +10399,7 @@ func (x *DeploymentContainer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeploymentContainer.ProtoReflect.Descriptor instead.
 func (*DeploymentContainer) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{102}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *DeploymentContainer) GetContainerId() string {
@@ -7813,7 +10470,7 @@ type StreamContainerLogsRequest struct {
 
 func (x *StreamContainerLogsRequest) Reset() {
 	*x = StreamContainerLogsRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[103]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7825,7 +10482,7 @@ func (x *StreamContainerLogsRequest) String() string {
 func (*StreamContainerLogsRequest) ProtoMessage() {}
 
 func (x *StreamContainerLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[103]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7838,7 +10495,7 @@ func (x *StreamContainerLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamContainerLogsRequest.ProtoReflect.Descriptor instead.
 func (*StreamContainerLogsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{103}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *StreamContainerLogsRequest) GetOrganizationId() string {
@@ -7880,7 +10537,7 @@ type StartContainerRequest struct {
 
 func (x *StartContainerRequest) Reset() {
 	*x = StartContainerRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[104]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7892,7 +10549,7 @@ func (x *StartContainerRequest) String() string {
 func (*StartContainerRequest) ProtoMessage() {}
 
 func (x *StartContainerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[104]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7905,7 +10562,7 @@ func (x *StartContainerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartContainerRequest.ProtoReflect.Descriptor instead.
 func (*StartContainerRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{104}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *StartContainerRequest) GetOrganizationId() string {
@@ -7939,7 +10596,7 @@ type StartContainerResponse struct {
 
 func (x *StartContainerResponse) Reset() {
 	*x = StartContainerResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[105]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7951,7 +10608,7 @@ func (x *StartContainerResponse) String() string {
 func (*StartContainerResponse) ProtoMessage() {}
 
 func (x *StartContainerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[105]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7964,7 +10621,7 @@ func (x *StartContainerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartContainerResponse.ProtoReflect.Descriptor instead.
 func (*StartContainerResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{105}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *StartContainerResponse) GetSuccess() bool {
@@ -7992,7 +10649,7 @@ type StopContainerRequest struct {
 
 func (x *StopContainerRequest) Reset() {
 	*x = StopContainerRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[106]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8004,7 +10661,7 @@ func (x *StopContainerRequest) String() string {
 func (*StopContainerRequest) ProtoMessage() {}
 
 func (x *StopContainerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[106]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8017,7 +10674,7 @@ func (x *StopContainerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopContainerRequest.ProtoReflect.Descriptor instead.
 func (*StopContainerRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{106}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *StopContainerRequest) GetOrganizationId() string {
@@ -8051,7 +10708,7 @@ type StopContainerResponse struct {
 
 func (x *StopContainerResponse) Reset() {
 	*x = StopContainerResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[107]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8063,7 +10720,7 @@ func (x *StopContainerResponse) String() string {
 func (*StopContainerResponse) ProtoMessage() {}
 
 func (x *StopContainerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[107]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8076,7 +10733,7 @@ func (x *StopContainerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopContainerResponse.ProtoReflect.Descriptor instead.
 func (*StopContainerResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{107}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *StopContainerResponse) GetSuccess() bool {
@@ -8104,7 +10761,7 @@ type RestartContainerRequest struct {
 
 func (x *RestartContainerRequest) Reset() {
 	*x = RestartContainerRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[108]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8116,7 +10773,7 @@ func (x *RestartContainerRequest) String() string {
 func (*RestartContainerRequest) ProtoMessage() {}
 
 func (x *RestartContainerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[108]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8129,7 +10786,7 @@ func (x *RestartContainerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartContainerRequest.ProtoReflect.Descriptor instead.
 func (*RestartContainerRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{108}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *RestartContainerRequest) GetOrganizationId() string {
@@ -8163,7 +10820,7 @@ type RestartContainerResponse struct {
 
 func (x *RestartContainerResponse) Reset() {
 	*x = RestartContainerResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[109]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8175,7 +10832,7 @@ func (x *RestartContainerResponse) String() string {
 func (*RestartContainerResponse) ProtoMessage() {}
 
 func (x *RestartContainerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[109]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8188,7 +10845,7 @@ func (x *RestartContainerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartContainerResponse.ProtoReflect.Descriptor instead.
 func (*RestartContainerResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{109}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *RestartContainerResponse) GetSuccess() bool {
@@ -8218,7 +10875,7 @@ type ListBuildsRequest struct {
 
 func (x *ListBuildsRequest) Reset() {
 	*x = ListBuildsRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[110]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8230,7 +10887,7 @@ func (x *ListBuildsRequest) String() string {
 func (*ListBuildsRequest) ProtoMessage() {}
 
 func (x *ListBuildsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[110]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8243,7 +10900,7 @@ func (x *ListBuildsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBuildsRequest.ProtoReflect.Descriptor instead.
 func (*ListBuildsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{110}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *ListBuildsRequest) GetOrganizationId() string {
@@ -8284,7 +10941,7 @@ type ListBuildsResponse struct {
 
 func (x *ListBuildsResponse) Reset() {
 	*x = ListBuildsResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[111]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8296,7 +10953,7 @@ func (x *ListBuildsResponse) String() string {
 func (*ListBuildsResponse) ProtoMessage() {}
 
 func (x *ListBuildsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[111]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8309,7 +10966,7 @@ func (x *ListBuildsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBuildsResponse.ProtoReflect.Descriptor instead.
 func (*ListBuildsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{111}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *ListBuildsResponse) GetBuilds() []*Build {
@@ -8337,7 +10994,7 @@ type GetBuildRequest struct {
 
 func (x *GetBuildRequest) Reset() {
 	*x = GetBuildRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[112]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8349,7 +11006,7 @@ func (x *GetBuildRequest) String() string {
 func (*GetBuildRequest) ProtoMessage() {}
 
 func (x *GetBuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[112]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8362,7 +11019,7 @@ func (x *GetBuildRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBuildRequest.ProtoReflect.Descriptor instead.
 func (*GetBuildRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{112}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *GetBuildRequest) GetOrganizationId() string {
@@ -8395,7 +11052,7 @@ type GetBuildResponse struct {
 
 func (x *GetBuildResponse) Reset() {
 	*x = GetBuildResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[113]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8407,7 +11064,7 @@ func (x *GetBuildResponse) String() string {
 func (*GetBuildResponse) ProtoMessage() {}
 
 func (x *GetBuildResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[113]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8420,7 +11077,7 @@ func (x *GetBuildResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBuildResponse.ProtoReflect.Descriptor instead.
 func (*GetBuildResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{113}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *GetBuildResponse) GetBuild() *Build {
@@ -8443,7 +11100,7 @@ type GetBuildLogsRequest struct {
 
 func (x *GetBuildLogsRequest) Reset() {
 	*x = GetBuildLogsRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[114]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8455,7 +11112,7 @@ func (x *GetBuildLogsRequest) String() string {
 func (*GetBuildLogsRequest) ProtoMessage() {}
 
 func (x *GetBuildLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[114]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8468,7 +11125,7 @@ func (x *GetBuildLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBuildLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetBuildLogsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{114}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *GetBuildLogsRequest) GetOrganizationId() string {
@@ -8516,7 +11173,7 @@ type GetBuildLogsResponse struct {
 
 func (x *GetBuildLogsResponse) Reset() {
 	*x = GetBuildLogsResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[115]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8528,7 +11185,7 @@ func (x *GetBuildLogsResponse) String() string {
 func (*GetBuildLogsResponse) ProtoMessage() {}
 
 func (x *GetBuildLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[115]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8541,7 +11198,7 @@ func (x *GetBuildLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBuildLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetBuildLogsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{115}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *GetBuildLogsResponse) GetLogs() []*DeploymentLogLine {
@@ -8569,7 +11226,7 @@ type RevertToBuildRequest struct {
 
 func (x *RevertToBuildRequest) Reset() {
 	*x = RevertToBuildRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[116]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8581,7 +11238,7 @@ func (x *RevertToBuildRequest) String() string {
 func (*RevertToBuildRequest) ProtoMessage() {}
 
 func (x *RevertToBuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[116]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8594,7 +11251,7 @@ func (x *RevertToBuildRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevertToBuildRequest.ProtoReflect.Descriptor instead.
 func (*RevertToBuildRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{116}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *RevertToBuildRequest) GetOrganizationId() string {
@@ -8628,7 +11285,7 @@ type RevertToBuildResponse struct {
 
 func (x *RevertToBuildResponse) Reset() {
 	*x = RevertToBuildResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[117]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8640,7 +11297,7 @@ func (x *RevertToBuildResponse) String() string {
 func (*RevertToBuildResponse) ProtoMessage() {}
 
 func (x *RevertToBuildResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[117]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8653,7 +11310,7 @@ func (x *RevertToBuildResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevertToBuildResponse.ProtoReflect.Descriptor instead.
 func (*RevertToBuildResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{117}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *RevertToBuildResponse) GetDeployment() *Deployment {
@@ -8681,7 +11338,7 @@ type DeleteBuildRequest struct {
 
 func (x *DeleteBuildRequest) Reset() {
 	*x = DeleteBuildRequest{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[118]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8693,7 +11350,7 @@ func (x *DeleteBuildRequest) String() string {
 func (*DeleteBuildRequest) ProtoMessage() {}
 
 func (x *DeleteBuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[118]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8706,7 +11363,7 @@ func (x *DeleteBuildRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBuildRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBuildRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{118}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *DeleteBuildRequest) GetOrganizationId() string {
@@ -8739,7 +11396,7 @@ type DeleteBuildResponse struct {
 
 func (x *DeleteBuildResponse) Reset() {
 	*x = DeleteBuildResponse{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[119]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8751,7 +11408,7 @@ func (x *DeleteBuildResponse) String() string {
 func (*DeleteBuildResponse) ProtoMessage() {}
 
 func (x *DeleteBuildResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[119]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8764,7 +11421,7 @@ func (x *DeleteBuildResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBuildResponse.ProtoReflect.Descriptor instead.
 func (*DeleteBuildResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{119}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *DeleteBuildResponse) GetSuccess() bool {
@@ -8796,19 +11453,20 @@ type Build struct {
 	ComposeFilePath *string       `protobuf:"bytes,17,opt,name=compose_file_path,json=composeFilePath,proto3,oneof" json:"compose_file_path,omitempty"`
 	BuildStrategy   BuildStrategy `protobuf:"varint,18,opt,name=build_strategy,json=buildStrategy,proto3,enum=obiente.cloud.deployments.v1.BuildStrategy" json:"build_strategy,omitempty"`
 	// Build results
-	ImageName     *string                `protobuf:"bytes,19,opt,name=image_name,json=imageName,proto3,oneof" json:"image_name,omitempty"`       // Built image name (for single container)
-	ComposeYaml   *string                `protobuf:"bytes,20,opt,name=compose_yaml,json=composeYaml,proto3,oneof" json:"compose_yaml,omitempty"` // Docker Compose YAML (for compose deployments)
-	Size          *string                `protobuf:"bytes,21,opt,name=size,proto3,oneof" json:"size,omitempty"`                                  // Human-readable bundle size
-	Error         *string                `protobuf:"bytes,22,opt,name=error,proto3,oneof" json:"error,omitempty"`                                // Error message if build failed
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ImageName     *string                  `protobuf:"bytes,19,opt,name=image_name,json=imageName,proto3,oneof" json:"image_name,omitempty"`       // Built image name (for single container)
+	ComposeYaml   *string                  `protobuf:"bytes,20,opt,name=compose_yaml,json=composeYaml,proto3,oneof" json:"compose_yaml,omitempty"` // Docker Compose YAML (for compose deployments)
+	Size          *string                  `protobuf:"bytes,21,opt,name=size,proto3,oneof" json:"size,omitempty"`                                  // Human-readable bundle size
+	Error         *string                  `protobuf:"bytes,22,opt,name=error,proto3,oneof" json:"error,omitempty"`                                // Error message if build failed
+	CreatedAt     *timestamppb.Timestamp   `protobuf:"bytes,23,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp   `protobuf:"bytes,24,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LintWarnings  []*DockerfileLintWarning `protobuf:"bytes,25,rep,name=lint_warnings,json=lintWarnings,proto3" json:"lint_warnings,omitempty"` // Dockerfile lint findings (Dockerfile builds only)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Build) Reset() {
 	*x = Build{}
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[120]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8820,7 +11478,7 @@ func (x *Build) String() string {
 func (*Build) ProtoMessage() {}
 
 func (x *Build) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[120]
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8833,7 +11491,7 @@ func (x *Build) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Build.ProtoReflect.Descriptor instead.
 func (*Build) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{120}
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *Build) GetId() string {
@@ -9004,38 +11662,120 @@ func (x *Build) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Build) GetLintWarnings() []*DockerfileLintWarning {
+	if x != nil {
+		return x.LintWarnings
+	}
+	return nil
+}
+
+// DockerfileLintWarning is a problem the Dockerfile linter found before the image was built.
+// Builds fail on lint warnings only when the deployments service runs with BLOCK_ON_LINT=true.
+type DockerfileLintWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`  // latest-base-image, missing-expose, plaintext-secret, copy-before-workdir or root-user
+	Line          int32                  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"` // 1-based Dockerfile line, 0 when the warning is about the whole file
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DockerfileLintWarning) Reset() {
+	*x = DockerfileLintWarning{}
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DockerfileLintWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DockerfileLintWarning) ProtoMessage() {}
+
+func (x *DockerfileLintWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DockerfileLintWarning.ProtoReflect.Descriptor instead.
+func (*DockerfileLintWarning) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *DockerfileLintWarning) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *DockerfileLintWarning) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *DockerfileLintWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_obiente_cloud_deployments_v1_deployment_service_proto protoreflect.FileDescriptor
 
 const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\n" +
-	"5obiente/cloud/deployments/v1/deployment_service.proto\x12\x1cobiente.cloud.deployments.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a9obiente/cloud/organizations/v1/organization_service.proto\x1a$obiente/cloud/common/v1/common.proto\"\xc8\x01\n" +
+	"5obiente/cloud/deployments/v1/deployment_service.proto\x12\x1cobiente.cloud.deployments.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a9obiente/cloud/organizations/v1/organization_service.proto\x1a$obiente/cloud/common/v1/common.proto\"\xd5\x02\n" +
 	"\x16ListDeploymentsRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12K\n" +
 	"\x06status\x18\x02 \x01(\x0e2..obiente.cloud.deployments.v1.DeploymentStatusH\x00R\x06status\x88\x01\x01\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x04 \x01(\x05R\aperPageB\t\n" +
+	"\bper_page\x18\x04 \x01(\x05R\aperPage\x12R\n" +
+	"\x04tags\x18\x05 \x03(\v2>.obiente.cloud.deployments.v1.ListDeploymentsRequest.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
 	"\a_status\"\xaa\x01\n" +
 	"\x17ListDeploymentsResponse\x12J\n" +
 	"\vdeployments\x18\x01 \x03(\v2(.obiente.cloud.deployments.v1.DeploymentR\vdeployments\x12C\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2#.obiente.cloud.common.v1.PaginationR\n" +
-	"pagination\"\xbb\x01\n" +
+	"pagination\"\x9e\x02\n" +
 	"\x17CreateDeploymentRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12K\n" +
 	"\venvironment\x18\x03 \x01(\x0e2).obiente.cloud.deployments.v1.EnvironmentR\venvironment\x12\x16\n" +
-	"\x06groups\x18\x04 \x03(\tR\x06groups\"d\n" +
+	"\x06groups\x18\x04 \x03(\tR\x06groups\x12\x1d\n" +
+	"\n" +
+	"is_preview\x18\x05 \x01(\bR\tisPreview\x12\x1c\n" +
+	"\ateam_id\x18\x06 \x01(\tH\x00R\x06teamId\x88\x01\x01\x12\x18\n" +
+	"\aregions\x18\a \x03(\tR\aregionsB\n" +
+	"\n" +
+	"\b_team_id\"\xd5\x01\n" +
 	"\x18CreateDeploymentResponse\x12H\n" +
 	"\n" +
 	"deployment\x18\x01 \x01(\v2(.obiente.cloud.deployments.v1.DeploymentR\n" +
-	"deployment\"d\n" +
+	"deployment\x12\x1f\n" +
+	"\vtraefik_ips\x18\x02 \x03(\tR\n" +
+	"traefikIps\x12N\n" +
+	"\aregions\x18\x03 \x03(\v24.obiente.cloud.deployments.v1.DeploymentRegionStatusR\aregions\"d\n" +
 	"\x14GetDeploymentRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
 	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\"a\n" +
 	"\x15GetDeploymentResponse\x12H\n" +
 	"\n" +
 	"deployment\x18\x01 \x01(\v2(.obiente.cloud.deployments.v1.DeploymentR\n" +
-	"deployment\"\xb8\x11\n" +
+	"deployment\"\xa2\x12\n" +
 	"\x17UpdateDeploymentRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
 	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12\x17\n" +
@@ -9073,7 +11813,9 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\n" +
 	"build_args\x18\x1e \x03(\v2D.obiente.cloud.deployments.v1.UpdateDeploymentRequest.BuildArgsEntryR\tbuildArgs\x12]\n" +
 	"\x12dockerfile_volumes\x18\x1f \x03(\v2..obiente.cloud.deployments.v1.DockerfileVolumeR\x11dockerfileVolumes\x12s\n" +
-	"\x18dockerfile_build_options\x18  \x01(\v24.obiente.cloud.deployments.v1.DockerfileBuildOptionsH\x19R\x16dockerfileBuildOptions\x88\x01\x01\x1a<\n" +
+	"\x18dockerfile_build_options\x18  \x01(\v24.obiente.cloud.deployments.v1.DockerfileBuildOptionsH\x19R\x16dockerfileBuildOptions\x88\x01\x01\x12\x18\n" +
+	"\aregions\x18! \x03(\tR\aregions\x125\n" +
+	"\x14zero_downtime_deploy\x18\" \x01(\bH\x1aR\x12zeroDowntimeDeploy\x88\x01\x01\x1a<\n" +
 	"\x0eBuildArgsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
@@ -9104,7 +11846,8 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\x1c_healthcheck_expected_statusB\x1d\n" +
 	"\x1b_healthcheck_custom_commandB\x0e\n" +
 	"\f_auto_deployB\x1b\n" +
-	"\x19_dockerfile_build_options\"d\n" +
+	"\x19_dockerfile_build_optionsB\x17\n" +
+	"\x15_zero_downtime_deploy\"d\n" +
 	"\x18UpdateDeploymentResponse\x12H\n" +
 	"\n" +
 	"deployment\x18\x01 \x01(\v2(.obiente.cloud.deployments.v1.DeploymentR\n" +
@@ -9178,7 +11921,32 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\x19RestartDeploymentResponse\x12H\n" +
 	"\n" +
 	"deployment\x18\x01 \x01(\v2(.obiente.cloud.deployments.v1.DeploymentR\n" +
-	"deployment\"\x82\x01\n" +
+	"deployment\"\x94\x01\n" +
+	"\x19RollbackDeploymentRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\x05H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\xc2\x01\n" +
+	"\x1aRollbackDeploymentResponse\x12H\n" +
+	"\n" +
+	"deployment\x18\x01 \x01(\v2(.obiente.cloud.deployments.v1.DeploymentR\n" +
+	"deployment\x12N\n" +
+	"\aversion\x18\x02 \x01(\v2/.obiente.cloud.deployments.v1.DeploymentVersionH\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\xc7\x01\n" +
+	"\x11DeploymentVersion\x12%\n" +
+	"\x0eversion_number\x18\x01 \x01(\x05R\rversionNumber\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12\"\n" +
+	"\renv_vars_hash\x18\x03 \x01(\tR\venvVarsHash\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"m\n" +
+	"\x1dListDeploymentVersionsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\"m\n" +
+	"\x1eListDeploymentVersionsResponse\x12K\n" +
+	"\bversions\x18\x01 \x03(\v2/.obiente.cloud.deployments.v1.DeploymentVersionR\bversions\"\x82\x01\n" +
 	"\x16ScaleDeploymentRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
 	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12\x1a\n" +
@@ -9186,6 +11954,15 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\x17ScaleDeploymentResponse\x12H\n" +
 	"\n" +
 	"deployment\x18\x01 \x01(\v2(.obiente.cloud.deployments.v1.DeploymentR\n" +
+	"deployment\"\xa8\x01\n" +
+	"\x18SetResourceLimitsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12\x1b\n" +
+	"\tcpu_limit\x18\x03 \x01(\x01R\bcpuLimit\x12!\n" +
+	"\fmemory_limit\x18\x04 \x01(\x03R\vmemoryLimit\"e\n" +
+	"\x19SetResourceLimitsResponse\x12H\n" +
+	"\n" +
+	"deployment\x18\x01 \x01(\v2(.obiente.cloud.deployments.v1.DeploymentR\n" +
 	"deployment\"k\n" +
 	"\x1bGetDeploymentEnvVarsRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
@@ -9199,7 +11976,12 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\x1fUpdateDeploymentEnvVarsResponse\x12H\n" +
 	"\n" +
 	"deployment\x18\x01 \x01(\v2(.obiente.cloud.deployments.v1.DeploymentR\n" +
-	"deployment\"k\n" +
+	"deployment\">\n" +
+	"\x13RotateEnvKeyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"f\n" +
+	"\x14RotateEnvKeyResponse\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x127\n" +
+	"\x17deployments_reencrypted\x18\x02 \x01(\x05R\x16deploymentsReencrypted\"k\n" +
 	"\x1bGetDeploymentComposeRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
 	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\"A\n" +
@@ -9287,7 +12069,27 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\x18github_app_account_login\x18\b \x01(\tR\x15githubAppAccountLogin\x125\n" +
 	"\x17github_app_account_type\x18\t \x01(\tR\x14githubAppAccountType\"\x84\x01\n" +
 	"'ListAvailableGitHubIntegrationsResponse\x12Y\n" +
-	"\fintegrations\x18\x01 \x03(\v25.obiente.cloud.deployments.v1.GitHubIntegrationOptionR\fintegrations\"\x93\x01\n" +
+	"\fintegrations\x18\x01 \x03(\v25.obiente.cloud.deployments.v1.GitHubIntegrationOptionR\fintegrations\"h\n" +
+	"\x16RotateDeployKeyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12%\n" +
+	"\x0eintegration_id\x18\x02 \x01(\tR\rintegrationId\"\xaf\x01\n" +
+	"\x0fGitHubDeployKey\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\tR\n" +
+	"repository\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\"\n" +
+	"\rgithub_key_id\x18\x03 \x01(\x03R\vgithubKeyId\x129\n" +
+	"\n" +
+	"rotated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\trotatedAt\"i\n" +
+	"\x17RotateDeployKeyResponse\x12N\n" +
+	"\vdeploy_keys\x18\x01 \x03(\v2-.obiente.cloud.deployments.v1.GitHubDeployKeyR\n" +
+	"deployKeys\"l\n" +
+	"\x1aRotateWebhookSecretRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12%\n" +
+	"\x0eintegration_id\x18\x02 \x01(\tR\rintegrationId\"A\n" +
+	"\x1bRotateWebhookSecretResponse\x12\"\n" +
+	"\frepositories\x18\x01 \x03(\tR\frepositories\"\x93\x01\n" +
 	"\x1bStreamTerminalOutputRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
 	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12\x12\n" +
@@ -9581,7 +12383,149 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
 	"\amessage\x18\x04 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
 	"\n" +
-	"\b_message\"\xdc\x03\n" +
+	"\b_message\"\x8e\x04\n" +
+	"\fCustomDomain\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\bR\bverified\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x122\n" +
+	"\x15challenge_record_name\x18\x06 \x01(\tR\x13challengeRecordName\x124\n" +
+	"\x16challenge_record_value\x18\a \x01(\tR\x14challengeRecordValue\x12#\n" +
+	"\vtls_cert_id\x18\b \x01(\tH\x00R\ttlsCertId\x88\x01\x01\x12U\n" +
+	"\x16certificate_expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x14certificateExpiresAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tH\x02R\tlastError\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_tls_cert_idB\x19\n" +
+	"\x17_certificate_expires_atB\r\n" +
+	"\v_last_error\"\x81\x01\n" +
+	"\x19CreateCustomDomainRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\"m\n" +
+	"\x1aCreateCustomDomainResponse\x12O\n" +
+	"\rcustom_domain\x18\x01 \x01(\v2*.obiente.cloud.deployments.v1.CustomDomainR\fcustomDomain\"\x81\x01\n" +
+	"\x19VerifyCustomDomainRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\"m\n" +
+	"\x1aVerifyCustomDomainResponse\x12O\n" +
+	"\rcustom_domain\x18\x01 \x01(\v2*.obiente.cloud.deployments.v1.CustomDomainR\fcustomDomain\"\xf2\x02\n" +
+	"\"UpdateDeploymentHealthCheckRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12\x17\n" +
+	"\x04port\x18\x05 \x01(\x05H\x00R\x04port\x88\x01\x01\x12)\n" +
+	"\x10interval_seconds\x18\x06 \x01(\x05R\x0fintervalSeconds\x12'\n" +
+	"\x0ftimeout_seconds\x18\a \x01(\x05R\x0etimeoutSeconds\x12\x18\n" +
+	"\aretries\x18\b \x01(\x05R\aretries\x12,\n" +
+	"\x0fexpected_status\x18\t \x01(\x05H\x01R\x0eexpectedStatus\x88\x01\x01B\a\n" +
+	"\x05_portB\x12\n" +
+	"\x10_expected_status\"o\n" +
+	"#UpdateDeploymentHealthCheckResponse\x12H\n" +
+	"\n" +
+	"deployment\x18\x01 \x01(\v2(.obiente.cloud.deployments.v1.DeploymentR\n" +
+	"deployment\"i\n" +
+	"\fAffinityRule\x12\x1b\n" +
+	"\trule_type\x18\x01 \x01(\tR\bruleType\x12\x1b\n" +
+	"\tlabel_key\x18\x02 \x01(\tR\blabelKey\x12\x1f\n" +
+	"\vlabel_value\x18\x03 \x01(\tR\n" +
+	"labelValue\"\xb3\x01\n" +
+	"!SetDeploymentAffinityRulesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12@\n" +
+	"\x05rules\x18\x03 \x03(\v2*.obiente.cloud.deployments.v1.AffinityRuleR\x05rules\"f\n" +
+	"\"SetDeploymentAffinityRulesResponse\x12@\n" +
+	"\x05rules\x18\x01 \x03(\v2*.obiente.cloud.deployments.v1.AffinityRuleR\x05rules\"q\n" +
+	"!GetDeploymentAffinityRulesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\"f\n" +
+	"\"GetDeploymentAffinityRulesResponse\x12@\n" +
+	"\x05rules\x18\x01 \x03(\v2*.obiente.cloud.deployments.v1.AffinityRuleR\x05rules\"\xda\x02\n" +
+	"\rScaleSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12'\n" +
+	"\x0fcron_expression\x18\x03 \x01(\tR\x0ecronExpression\x12#\n" +
+	"\rreplica_count\x18\x04 \x01(\x05R\freplicaCount\x12:\n" +
+	"\vnext_run_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x12?\n" +
+	"\vlast_run_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tlastRunAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_last_run_at\"\xb8\x01\n" +
+	"\x1aCreateScaleScheduleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12'\n" +
+	"\x0fcron_expression\x18\x03 \x01(\tR\x0ecronExpression\x12#\n" +
+	"\rreplica_count\x18\x04 \x01(\x05R\freplicaCount\"f\n" +
+	"\x1bCreateScaleScheduleResponse\x12G\n" +
+	"\bschedule\x18\x01 \x01(\v2+.obiente.cloud.deployments.v1.ScaleScheduleR\bschedule\"\x8b\x01\n" +
+	"\x1aDeleteScaleScheduleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\tR\n" +
+	"scheduleId\"7\n" +
+	"\x1bDeleteScaleScheduleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"i\n" +
+	"\x19ListScaleSchedulesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\"g\n" +
+	"\x1aListScaleSchedulesResponse\x12I\n" +
+	"\tschedules\x18\x01 \x03(\v2+.obiente.cloud.deployments.v1.ScaleScheduleR\tschedules\"\xd3\x03\n" +
+	"\x0fAutoScalePolicy\x12#\n" +
+	"\rdeployment_id\x18\x01 \x01(\tR\fdeploymentId\x12!\n" +
+	"\fmin_replicas\x18\x02 \x01(\x05R\vminReplicas\x12!\n" +
+	"\fmax_replicas\x18\x03 \x01(\x05R\vmaxReplicas\x12,\n" +
+	"\x12target_cpu_percent\x18\x04 \x01(\x01R\x10targetCpuPercent\x129\n" +
+	"\x19scale_up_cooldown_seconds\x18\x05 \x01(\x05R\x16scaleUpCooldownSeconds\x12=\n" +
+	"\x1bscale_down_cooldown_seconds\x18\x06 \x01(\x05R\x18scaleDownCooldownSeconds\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12E\n" +
+	"\x0elast_scaled_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\flastScaledAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x11\n" +
+	"\x0f_last_scaled_at\"\xd7\x02\n" +
+	"\x19SetAutoScalePolicyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12!\n" +
+	"\fmin_replicas\x18\x03 \x01(\x05R\vminReplicas\x12!\n" +
+	"\fmax_replicas\x18\x04 \x01(\x05R\vmaxReplicas\x12,\n" +
+	"\x12target_cpu_percent\x18\x05 \x01(\x01R\x10targetCpuPercent\x129\n" +
+	"\x19scale_up_cooldown_seconds\x18\x06 \x01(\x05R\x16scaleUpCooldownSeconds\x12=\n" +
+	"\x1bscale_down_cooldown_seconds\x18\a \x01(\x05R\x18scaleDownCooldownSeconds\"c\n" +
+	"\x1aSetAutoScalePolicyResponse\x12E\n" +
+	"\x06policy\x18\x01 \x01(\v2-.obiente.cloud.deployments.v1.AutoScalePolicyR\x06policy\"i\n" +
+	"\x19GetAutoScalePolicyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\"s\n" +
+	"\x1aGetAutoScalePolicyResponse\x12J\n" +
+	"\x06policy\x18\x01 \x01(\v2-.obiente.cloud.deployments.v1.AutoScalePolicyH\x00R\x06policy\x88\x01\x01B\t\n" +
+	"\a_policy\"g\n" +
+	"\x17DisableAutoScaleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\"4\n" +
+	"\x18DisableAutoScaleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc2\x02\n" +
+	"\x16DeploymentRegionStatus\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x18\n" +
+	"\aprimary\x18\x02 \x01(\bR\aprimary\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12#\n" +
+	"\rhealth_status\x18\x04 \x01(\tR\fhealthStatus\x12!\n" +
+	"\fcontainer_id\x18\x05 \x01(\tR\vcontainerId\x12\x1d\n" +
+	"\n" +
+	"traefik_ip\x18\x06 \x01(\tR\ttraefikIp\x12\x17\n" +
+	"\anode_id\x18\a \x01(\tR\x06nodeId\x12\x19\n" +
+	"\x05error\x18\b \x01(\tH\x00R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\b\n" +
+	"\x06_error\"p\n" +
+	" GetDeploymentRegionStatusRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\"s\n" +
+	"!GetDeploymentRegionStatusResponse\x12N\n" +
+	"\aregions\x18\x01 \x03(\v24.obiente.cloud.deployments.v1.DeploymentRegionStatusR\aregions\"\xdc\x03\n" +
 	"\x1bGetDeploymentMetricsRequest\x12#\n" +
 	"\rdeployment_id\x18\x01 \x01(\tR\fdeploymentId\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12>\n" +
@@ -9663,7 +12607,7 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\x0f_cpu_cost_centsB\x14\n" +
 	"\x12_memory_cost_centsB\x17\n" +
 	"\x15_bandwidth_cost_centsB\x15\n" +
-	"\x13_storage_cost_cents\"\xb2\x17\n" +
+	"\x13_storage_cost_cents\"\xc1\x1a\n" +
 	"\n" +
 	"Deployment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -9720,7 +12664,14 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\n" +
 	"build_args\x18. \x03(\v27.obiente.cloud.deployments.v1.Deployment.BuildArgsEntryR\tbuildArgs\x12]\n" +
 	"\x12dockerfile_volumes\x18/ \x03(\v2..obiente.cloud.deployments.v1.DockerfileVolumeR\x11dockerfileVolumes\x12s\n" +
-	"\x18dockerfile_build_options\x180 \x01(\v24.obiente.cloud.deployments.v1.DockerfileBuildOptionsH\x1aR\x16dockerfileBuildOptions\x88\x01\x01\x1a:\n" +
+	"\x18dockerfile_build_options\x180 \x01(\v24.obiente.cloud.deployments.v1.DockerfileBuildOptionsH\x1aR\x16dockerfileBuildOptions\x88\x01\x01\x12!\n" +
+	"\factive_color\x181 \x01(\tR\vactiveColor\x12\x1d\n" +
+	"\n" +
+	"is_preview\x182 \x01(\bR\tisPreview\x12E\n" +
+	"\x1chealthcheck_interval_seconds\x183 \x01(\x05H\x1bR\x1ahealthcheckIntervalSeconds\x88\x01\x01\x12C\n" +
+	"\x1bhealthcheck_timeout_seconds\x184 \x01(\x05H\x1cR\x19healthcheckTimeoutSeconds\x88\x01\x01\x124\n" +
+	"\x13healthcheck_retries\x185 \x01(\x05H\x1dR\x12healthcheckRetries\x88\x01\x01\x120\n" +
+	"\x14zero_downtime_deploy\x186 \x01(\bR\x12zeroDowntimeDeploy\x1a:\n" +
 	"\fEnvVarsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n" +
@@ -9756,7 +12707,10 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\x1c_healthcheck_expected_statusB\x1d\n" +
 	"\x1b_healthcheck_custom_commandB\x0e\n" +
 	"\f_auto_deployB\x1b\n" +
-	"\x19_dockerfile_build_options\"b\n" +
+	"\x19_dockerfile_build_optionsB\x1f\n" +
+	"\x1d_healthcheck_interval_secondsB\x1e\n" +
+	"\x1c_healthcheck_timeout_secondsB\x16\n" +
+	"\x14_healthcheck_retries\"b\n" +
 	"\x10DockerfileVolume\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
@@ -9869,7 +12823,8 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12\x19\n" +
 	"\bbuild_id\x18\x03 \x01(\tR\abuildId\"/\n" +
 	"\x13DeleteBuildResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe7\t\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc1\n" +
+	"\n" +
 	"\x05Build\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\x12'\n" +
@@ -9902,7 +12857,8 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0f\n" +
+	"updated_at\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12X\n" +
+	"\rlint_warnings\x18\x19 \x03(\v23.obiente.cloud.deployments.v1.DockerfileLintWarningR\flintWarningsB\x0f\n" +
 	"\r_completed_atB\x11\n" +
 	"\x0f_repository_urlB\r\n" +
 	"\v_commit_shaB\x10\n" +
@@ -9914,7 +12870,11 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\v_image_nameB\x0f\n" +
 	"\r_compose_yamlB\a\n" +
 	"\x05_sizeB\b\n" +
-	"\x06_error*\x9b\x01\n" +
+	"\x06_error\"Y\n" +
+	"\x15DockerfileLintWarning\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x05R\x04line\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage*\x9b\x01\n" +
 	"\x0eDeploymentType\x12\x1f\n" +
 	"\x1bDEPLOYMENT_TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -9971,7 +12931,7 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	" CONTAINER_ENTRY_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19CONTAINER_ENTRY_TYPE_FILE\x10\x01\x12\"\n" +
 	"\x1eCONTAINER_ENTRY_TYPE_DIRECTORY\x10\x02\x12 \n" +
-	"\x1cCONTAINER_ENTRY_TYPE_SYMLINK\x10\x032\x98:\n" +
+	"\x1cCONTAINER_ENTRY_TYPE_SYMLINK\x10\x032\x9bN\n" +
 	"\x11DeploymentService\x12~\n" +
 	"\x0fListDeployments\x124.obiente.cloud.deployments.v1.ListDeploymentsRequest\x1a5.obiente.cloud.deployments.v1.ListDeploymentsResponse\x12\x81\x01\n" +
 	"\x10CreateDeployment\x125.obiente.cloud.deployments.v1.CreateDeploymentRequest\x1a6.obiente.cloud.deployments.v1.CreateDeploymentResponse\x12x\n" +
@@ -9988,10 +12948,14 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\x0fStartDeployment\x124.obiente.cloud.deployments.v1.StartDeploymentRequest\x1a5.obiente.cloud.deployments.v1.StartDeploymentResponse\x12{\n" +
 	"\x0eStopDeployment\x123.obiente.cloud.deployments.v1.StopDeploymentRequest\x1a4.obiente.cloud.deployments.v1.StopDeploymentResponse\x12\x81\x01\n" +
 	"\x10DeleteDeployment\x125.obiente.cloud.deployments.v1.DeleteDeploymentRequest\x1a6.obiente.cloud.deployments.v1.DeleteDeploymentResponse\x12\x84\x01\n" +
-	"\x11RestartDeployment\x126.obiente.cloud.deployments.v1.RestartDeploymentRequest\x1a7.obiente.cloud.deployments.v1.RestartDeploymentResponse\x12~\n" +
-	"\x0fScaleDeployment\x124.obiente.cloud.deployments.v1.ScaleDeploymentRequest\x1a5.obiente.cloud.deployments.v1.ScaleDeploymentResponse\x12\x8d\x01\n" +
+	"\x11RestartDeployment\x126.obiente.cloud.deployments.v1.RestartDeploymentRequest\x1a7.obiente.cloud.deployments.v1.RestartDeploymentResponse\x12\x87\x01\n" +
+	"\x12RollbackDeployment\x127.obiente.cloud.deployments.v1.RollbackDeploymentRequest\x1a8.obiente.cloud.deployments.v1.RollbackDeploymentResponse\x12\x93\x01\n" +
+	"\x16ListDeploymentVersions\x12;.obiente.cloud.deployments.v1.ListDeploymentVersionsRequest\x1a<.obiente.cloud.deployments.v1.ListDeploymentVersionsResponse\x12~\n" +
+	"\x0fScaleDeployment\x124.obiente.cloud.deployments.v1.ScaleDeploymentRequest\x1a5.obiente.cloud.deployments.v1.ScaleDeploymentResponse\x12\x84\x01\n" +
+	"\x11SetResourceLimits\x126.obiente.cloud.deployments.v1.SetResourceLimitsRequest\x1a7.obiente.cloud.deployments.v1.SetResourceLimitsResponse\x12\x8d\x01\n" +
 	"\x14GetDeploymentEnvVars\x129.obiente.cloud.deployments.v1.GetDeploymentEnvVarsRequest\x1a:.obiente.cloud.deployments.v1.GetDeploymentEnvVarsResponse\x12\x96\x01\n" +
-	"\x17UpdateDeploymentEnvVars\x12<.obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsRequest\x1a=.obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsResponse\x12\x8d\x01\n" +
+	"\x17UpdateDeploymentEnvVars\x12<.obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsRequest\x1a=.obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsResponse\x12u\n" +
+	"\fRotateEnvKey\x121.obiente.cloud.deployments.v1.RotateEnvKeyRequest\x1a2.obiente.cloud.deployments.v1.RotateEnvKeyResponse\x12\x8d\x01\n" +
 	"\x14GetDeploymentCompose\x129.obiente.cloud.deployments.v1.GetDeploymentComposeRequest\x1a:.obiente.cloud.deployments.v1.GetDeploymentComposeResponse\x12\x9c\x01\n" +
 	"\x19ValidateDeploymentCompose\x12>.obiente.cloud.deployments.v1.ValidateDeploymentComposeRequest\x1a?.obiente.cloud.deployments.v1.ValidateDeploymentComposeResponse\x12\x96\x01\n" +
 	"\x17UpdateDeploymentCompose\x12<.obiente.cloud.deployments.v1.UpdateDeploymentComposeRequest\x1a=.obiente.cloud.deployments.v1.UpdateDeploymentComposeResponse\x12~\n" +
@@ -10004,7 +12968,9 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\fGetBuildLogs\x121.obiente.cloud.deployments.v1.GetBuildLogsRequest\x1a2.obiente.cloud.deployments.v1.GetBuildLogsResponse\x12x\n" +
 	"\rRevertToBuild\x122.obiente.cloud.deployments.v1.RevertToBuildRequest\x1a3.obiente.cloud.deployments.v1.RevertToBuildResponse\x12r\n" +
 	"\vDeleteBuild\x120.obiente.cloud.deployments.v1.DeleteBuildRequest\x1a1.obiente.cloud.deployments.v1.DeleteBuildResponse\x12\xae\x01\n" +
-	"\x1fListAvailableGitHubIntegrations\x12D.obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsRequest\x1aE.obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsResponse\x12o\n" +
+	"\x1fListAvailableGitHubIntegrations\x12D.obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsRequest\x1aE.obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsResponse\x12~\n" +
+	"\x0fRotateDeployKey\x124.obiente.cloud.deployments.v1.RotateDeployKeyRequest\x1a5.obiente.cloud.deployments.v1.RotateDeployKeyResponse\x12\x8a\x01\n" +
+	"\x13RotateWebhookSecret\x128.obiente.cloud.deployments.v1.RotateWebhookSecretRequest\x1a9.obiente.cloud.deployments.v1.RotateWebhookSecretResponse\x12o\n" +
 	"\x0eStreamTerminal\x12+.obiente.cloud.deployments.v1.TerminalInput\x1a,.obiente.cloud.deployments.v1.TerminalOutput(\x010\x01\x12\x81\x01\n" +
 	"\x14StreamTerminalOutput\x129.obiente.cloud.deployments.v1.StreamTerminalOutputRequest\x1a,.obiente.cloud.deployments.v1.TerminalOutput0\x01\x12\x84\x01\n" +
 	"\x11SendTerminalInput\x126.obiente.cloud.deployments.v1.SendTerminalInputRequest\x1a7.obiente.cloud.deployments.v1.SendTerminalInputResponse\x12\x87\x01\n" +
@@ -10022,7 +12988,19 @@ const file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc = "" +
 	"\x18UpdateDeploymentRoutings\x12=.obiente.cloud.deployments.v1.UpdateDeploymentRoutingsRequest\x1a>.obiente.cloud.deployments.v1.UpdateDeploymentRoutingsResponse\x12\x9c\x01\n" +
 	"\x19GetDeploymentServiceNames\x12>.obiente.cloud.deployments.v1.GetDeploymentServiceNamesRequest\x1a?.obiente.cloud.deployments.v1.GetDeploymentServiceNamesResponse\x12\x9f\x01\n" +
 	"\x1aGetDomainVerificationToken\x12?.obiente.cloud.deployments.v1.GetDomainVerificationTokenRequest\x1a@.obiente.cloud.deployments.v1.GetDomainVerificationTokenResponse\x12\x90\x01\n" +
-	"\x15VerifyDomainOwnership\x12:.obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest\x1a;.obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse\x12\x99\x01\n" +
+	"\x15VerifyDomainOwnership\x12:.obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest\x1a;.obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse\x12\x87\x01\n" +
+	"\x12CreateCustomDomain\x127.obiente.cloud.deployments.v1.CreateCustomDomainRequest\x1a8.obiente.cloud.deployments.v1.CreateCustomDomainResponse\x12\x87\x01\n" +
+	"\x12VerifyCustomDomain\x127.obiente.cloud.deployments.v1.VerifyCustomDomainRequest\x1a8.obiente.cloud.deployments.v1.VerifyCustomDomainResponse\x12\xa2\x01\n" +
+	"\x1bUpdateDeploymentHealthCheck\x12@.obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckRequest\x1aA.obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckResponse\x12\x9f\x01\n" +
+	"\x1aSetDeploymentAffinityRules\x12?.obiente.cloud.deployments.v1.SetDeploymentAffinityRulesRequest\x1a@.obiente.cloud.deployments.v1.SetDeploymentAffinityRulesResponse\x12\x9f\x01\n" +
+	"\x1aGetDeploymentAffinityRules\x12?.obiente.cloud.deployments.v1.GetDeploymentAffinityRulesRequest\x1a@.obiente.cloud.deployments.v1.GetDeploymentAffinityRulesResponse\x12\x8a\x01\n" +
+	"\x13CreateScaleSchedule\x128.obiente.cloud.deployments.v1.CreateScaleScheduleRequest\x1a9.obiente.cloud.deployments.v1.CreateScaleScheduleResponse\x12\x8a\x01\n" +
+	"\x13DeleteScaleSchedule\x128.obiente.cloud.deployments.v1.DeleteScaleScheduleRequest\x1a9.obiente.cloud.deployments.v1.DeleteScaleScheduleResponse\x12\x87\x01\n" +
+	"\x12ListScaleSchedules\x127.obiente.cloud.deployments.v1.ListScaleSchedulesRequest\x1a8.obiente.cloud.deployments.v1.ListScaleSchedulesResponse\x12\x87\x01\n" +
+	"\x12SetAutoScalePolicy\x127.obiente.cloud.deployments.v1.SetAutoScalePolicyRequest\x1a8.obiente.cloud.deployments.v1.SetAutoScalePolicyResponse\x12\x87\x01\n" +
+	"\x12GetAutoScalePolicy\x127.obiente.cloud.deployments.v1.GetAutoScalePolicyRequest\x1a8.obiente.cloud.deployments.v1.GetAutoScalePolicyResponse\x12\x81\x01\n" +
+	"\x10DisableAutoScale\x125.obiente.cloud.deployments.v1.DisableAutoScaleRequest\x1a6.obiente.cloud.deployments.v1.DisableAutoScaleResponse\x12\x9c\x01\n" +
+	"\x19GetDeploymentRegionStatus\x12>.obiente.cloud.deployments.v1.GetDeploymentRegionStatusRequest\x1a?.obiente.cloud.deployments.v1.GetDeploymentRegionStatusResponse\x12\x99\x01\n" +
 	"\x18ListDeploymentContainers\x12=.obiente.cloud.deployments.v1.ListDeploymentContainersRequest\x1a>.obiente.cloud.deployments.v1.ListDeploymentContainersResponse\x12\x82\x01\n" +
 	"\x13StreamContainerLogs\x128.obiente.cloud.deployments.v1.StreamContainerLogsRequest\x1a/.obiente.cloud.deployments.v1.DeploymentLogLine0\x01\x12{\n" +
 	"\x0eStartContainer\x123.obiente.cloud.deployments.v1.StartContainerRequest\x1a4.obiente.cloud.deployments.v1.StartContainerResponse\x12x\n" +
@@ -10042,7 +13020,7 @@ func file_obiente_cloud_deployments_v1_deployment_service_proto_rawDescGZIP() []
 }
 
 var file_obiente_cloud_deployments_v1_deployment_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 125)
+var file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 170)
 var file_obiente_cloud_deployments_v1_deployment_service_proto_goTypes = []any{
 	(DeploymentType)(0),                             // 0: obiente.cloud.deployments.v1.DeploymentType
 	(BuildStrategy)(0),                              // 1: obiente.cloud.deployments.v1.BuildStrategy
@@ -10076,306 +13054,416 @@ var file_obiente_cloud_deployments_v1_deployment_service_proto_goTypes = []any{
 	(*DeleteDeploymentResponse)(nil),                // 29: obiente.cloud.deployments.v1.DeleteDeploymentResponse
 	(*RestartDeploymentRequest)(nil),                // 30: obiente.cloud.deployments.v1.RestartDeploymentRequest
 	(*RestartDeploymentResponse)(nil),               // 31: obiente.cloud.deployments.v1.RestartDeploymentResponse
-	(*ScaleDeploymentRequest)(nil),                  // 32: obiente.cloud.deployments.v1.ScaleDeploymentRequest
-	(*ScaleDeploymentResponse)(nil),                 // 33: obiente.cloud.deployments.v1.ScaleDeploymentResponse
-	(*GetDeploymentEnvVarsRequest)(nil),             // 34: obiente.cloud.deployments.v1.GetDeploymentEnvVarsRequest
-	(*GetDeploymentEnvVarsResponse)(nil),            // 35: obiente.cloud.deployments.v1.GetDeploymentEnvVarsResponse
-	(*UpdateDeploymentEnvVarsRequest)(nil),          // 36: obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsRequest
-	(*UpdateDeploymentEnvVarsResponse)(nil),         // 37: obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsResponse
-	(*GetDeploymentComposeRequest)(nil),             // 38: obiente.cloud.deployments.v1.GetDeploymentComposeRequest
-	(*GetDeploymentComposeResponse)(nil),            // 39: obiente.cloud.deployments.v1.GetDeploymentComposeResponse
-	(*ValidateDeploymentComposeRequest)(nil),        // 40: obiente.cloud.deployments.v1.ValidateDeploymentComposeRequest
-	(*ValidateDeploymentComposeResponse)(nil),       // 41: obiente.cloud.deployments.v1.ValidateDeploymentComposeResponse
-	(*UpdateDeploymentComposeRequest)(nil),          // 42: obiente.cloud.deployments.v1.UpdateDeploymentComposeRequest
-	(*UpdateDeploymentComposeResponse)(nil),         // 43: obiente.cloud.deployments.v1.UpdateDeploymentComposeResponse
-	(*ComposeValidationError)(nil),                  // 44: obiente.cloud.deployments.v1.ComposeValidationError
-	(*ListGitHubReposRequest)(nil),                  // 45: obiente.cloud.deployments.v1.ListGitHubReposRequest
-	(*GitHubRepo)(nil),                              // 46: obiente.cloud.deployments.v1.GitHubRepo
-	(*ListGitHubReposResponse)(nil),                 // 47: obiente.cloud.deployments.v1.ListGitHubReposResponse
-	(*GetGitHubBranchesRequest)(nil),                // 48: obiente.cloud.deployments.v1.GetGitHubBranchesRequest
-	(*GitHubBranch)(nil),                            // 49: obiente.cloud.deployments.v1.GitHubBranch
-	(*GetGitHubBranchesResponse)(nil),               // 50: obiente.cloud.deployments.v1.GetGitHubBranchesResponse
-	(*GetGitHubFileRequest)(nil),                    // 51: obiente.cloud.deployments.v1.GetGitHubFileRequest
-	(*GetGitHubFileResponse)(nil),                   // 52: obiente.cloud.deployments.v1.GetGitHubFileResponse
-	(*ListAvailableGitHubIntegrationsRequest)(nil),  // 53: obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsRequest
-	(*GitHubIntegrationOption)(nil),                 // 54: obiente.cloud.deployments.v1.GitHubIntegrationOption
-	(*ListAvailableGitHubIntegrationsResponse)(nil), // 55: obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsResponse
-	(*StreamTerminalOutputRequest)(nil),             // 56: obiente.cloud.deployments.v1.StreamTerminalOutputRequest
-	(*SendTerminalInputRequest)(nil),                // 57: obiente.cloud.deployments.v1.SendTerminalInputRequest
-	(*SendTerminalInputResponse)(nil),               // 58: obiente.cloud.deployments.v1.SendTerminalInputResponse
-	(*TerminalInput)(nil),                           // 59: obiente.cloud.deployments.v1.TerminalInput
-	(*TerminalOutput)(nil),                          // 60: obiente.cloud.deployments.v1.TerminalOutput
-	(*VolumeInfo)(nil),                              // 61: obiente.cloud.deployments.v1.VolumeInfo
-	(*ListContainerFilesRequest)(nil),               // 62: obiente.cloud.deployments.v1.ListContainerFilesRequest
-	(*ContainerFile)(nil),                           // 63: obiente.cloud.deployments.v1.ContainerFile
-	(*ListContainerFilesResponse)(nil),              // 64: obiente.cloud.deployments.v1.ListContainerFilesResponse
-	(*GetContainerFileRequest)(nil),                 // 65: obiente.cloud.deployments.v1.GetContainerFileRequest
-	(*GetContainerFileResponse)(nil),                // 66: obiente.cloud.deployments.v1.GetContainerFileResponse
-	(*UploadContainerFilesRequest)(nil),             // 67: obiente.cloud.deployments.v1.UploadContainerFilesRequest
-	(*UploadContainerFilesMetadata)(nil),            // 68: obiente.cloud.deployments.v1.UploadContainerFilesMetadata
-	(*FileMetadata)(nil),                            // 69: obiente.cloud.deployments.v1.FileMetadata
-	(*UploadContainerFilesResponse)(nil),            // 70: obiente.cloud.deployments.v1.UploadContainerFilesResponse
-	(*ChunkUploadContainerFilesRequest)(nil),        // 71: obiente.cloud.deployments.v1.ChunkUploadContainerFilesRequest
-	(*ChunkUploadContainerFilesResponse)(nil),       // 72: obiente.cloud.deployments.v1.ChunkUploadContainerFilesResponse
-	(*DeleteContainerEntriesRequest)(nil),           // 73: obiente.cloud.deployments.v1.DeleteContainerEntriesRequest
-	(*DeleteContainerEntriesError)(nil),             // 74: obiente.cloud.deployments.v1.DeleteContainerEntriesError
-	(*DeleteContainerEntriesResponse)(nil),          // 75: obiente.cloud.deployments.v1.DeleteContainerEntriesResponse
-	(*RenameContainerEntryRequest)(nil),             // 76: obiente.cloud.deployments.v1.RenameContainerEntryRequest
-	(*RenameContainerEntryResponse)(nil),            // 77: obiente.cloud.deployments.v1.RenameContainerEntryResponse
-	(*CreateContainerEntryRequest)(nil),             // 78: obiente.cloud.deployments.v1.CreateContainerEntryRequest
-	(*CreateContainerEntryResponse)(nil),            // 79: obiente.cloud.deployments.v1.CreateContainerEntryResponse
-	(*WriteContainerFileRequest)(nil),               // 80: obiente.cloud.deployments.v1.WriteContainerFileRequest
-	(*WriteContainerFileResponse)(nil),              // 81: obiente.cloud.deployments.v1.WriteContainerFileResponse
-	(*ExtractDeploymentFileRequest)(nil),            // 82: obiente.cloud.deployments.v1.ExtractDeploymentFileRequest
-	(*ExtractDeploymentFileResponse)(nil),           // 83: obiente.cloud.deployments.v1.ExtractDeploymentFileResponse
-	(*CreateDeploymentFileArchiveRequest)(nil),      // 84: obiente.cloud.deployments.v1.CreateDeploymentFileArchiveRequest
-	(*CreateDeploymentFileArchiveResponse)(nil),     // 85: obiente.cloud.deployments.v1.CreateDeploymentFileArchiveResponse
-	(*RoutingRule)(nil),                             // 86: obiente.cloud.deployments.v1.RoutingRule
-	(*GetDeploymentRoutingsRequest)(nil),            // 87: obiente.cloud.deployments.v1.GetDeploymentRoutingsRequest
-	(*GetDeploymentRoutingsResponse)(nil),           // 88: obiente.cloud.deployments.v1.GetDeploymentRoutingsResponse
-	(*UpdateDeploymentRoutingsRequest)(nil),         // 89: obiente.cloud.deployments.v1.UpdateDeploymentRoutingsRequest
-	(*UpdateDeploymentRoutingsResponse)(nil),        // 90: obiente.cloud.deployments.v1.UpdateDeploymentRoutingsResponse
-	(*GetDeploymentServiceNamesRequest)(nil),        // 91: obiente.cloud.deployments.v1.GetDeploymentServiceNamesRequest
-	(*GetDeploymentServiceNamesResponse)(nil),       // 92: obiente.cloud.deployments.v1.GetDeploymentServiceNamesResponse
-	(*GetDomainVerificationTokenRequest)(nil),       // 93: obiente.cloud.deployments.v1.GetDomainVerificationTokenRequest
-	(*GetDomainVerificationTokenResponse)(nil),      // 94: obiente.cloud.deployments.v1.GetDomainVerificationTokenResponse
-	(*VerifyDomainOwnershipRequest)(nil),            // 95: obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest
-	(*VerifyDomainOwnershipResponse)(nil),           // 96: obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse
-	(*GetDeploymentMetricsRequest)(nil),             // 97: obiente.cloud.deployments.v1.GetDeploymentMetricsRequest
-	(*GetDeploymentMetricsResponse)(nil),            // 98: obiente.cloud.deployments.v1.GetDeploymentMetricsResponse
-	(*StreamDeploymentMetricsRequest)(nil),          // 99: obiente.cloud.deployments.v1.StreamDeploymentMetricsRequest
-	(*DeploymentMetric)(nil),                        // 100: obiente.cloud.deployments.v1.DeploymentMetric
-	(*GetDeploymentUsageRequest)(nil),               // 101: obiente.cloud.deployments.v1.GetDeploymentUsageRequest
-	(*GetDeploymentUsageResponse)(nil),              // 102: obiente.cloud.deployments.v1.GetDeploymentUsageResponse
-	(*DeploymentUsageMetrics)(nil),                  // 103: obiente.cloud.deployments.v1.DeploymentUsageMetrics
-	(*Deployment)(nil),                              // 104: obiente.cloud.deployments.v1.Deployment
-	(*DockerfileVolume)(nil),                        // 105: obiente.cloud.deployments.v1.DockerfileVolume
-	(*DockerfileBuildOptions)(nil),                  // 106: obiente.cloud.deployments.v1.DockerfileBuildOptions
-	(*ListDeploymentContainersRequest)(nil),         // 107: obiente.cloud.deployments.v1.ListDeploymentContainersRequest
-	(*ListDeploymentContainersResponse)(nil),        // 108: obiente.cloud.deployments.v1.ListDeploymentContainersResponse
-	(*DeploymentContainer)(nil),                     // 109: obiente.cloud.deployments.v1.DeploymentContainer
-	(*StreamContainerLogsRequest)(nil),              // 110: obiente.cloud.deployments.v1.StreamContainerLogsRequest
-	(*StartContainerRequest)(nil),                   // 111: obiente.cloud.deployments.v1.StartContainerRequest
-	(*StartContainerResponse)(nil),                  // 112: obiente.cloud.deployments.v1.StartContainerResponse
-	(*StopContainerRequest)(nil),                    // 113: obiente.cloud.deployments.v1.StopContainerRequest
-	(*StopContainerResponse)(nil),                   // 114: obiente.cloud.deployments.v1.StopContainerResponse
-	(*RestartContainerRequest)(nil),                 // 115: obiente.cloud.deployments.v1.RestartContainerRequest
-	(*RestartContainerResponse)(nil),                // 116: obiente.cloud.deployments.v1.RestartContainerResponse
-	(*ListBuildsRequest)(nil),                       // 117: obiente.cloud.deployments.v1.ListBuildsRequest
-	(*ListBuildsResponse)(nil),                      // 118: obiente.cloud.deployments.v1.ListBuildsResponse
-	(*GetBuildRequest)(nil),                         // 119: obiente.cloud.deployments.v1.GetBuildRequest
-	(*GetBuildResponse)(nil),                        // 120: obiente.cloud.deployments.v1.GetBuildResponse
-	(*GetBuildLogsRequest)(nil),                     // 121: obiente.cloud.deployments.v1.GetBuildLogsRequest
-	(*GetBuildLogsResponse)(nil),                    // 122: obiente.cloud.deployments.v1.GetBuildLogsResponse
-	(*RevertToBuildRequest)(nil),                    // 123: obiente.cloud.deployments.v1.RevertToBuildRequest
-	(*RevertToBuildResponse)(nil),                   // 124: obiente.cloud.deployments.v1.RevertToBuildResponse
-	(*DeleteBuildRequest)(nil),                      // 125: obiente.cloud.deployments.v1.DeleteBuildRequest
-	(*DeleteBuildResponse)(nil),                     // 126: obiente.cloud.deployments.v1.DeleteBuildResponse
-	(*Build)(nil),                                   // 127: obiente.cloud.deployments.v1.Build
-	nil,                                             // 128: obiente.cloud.deployments.v1.UpdateDeploymentRequest.BuildArgsEntry
-	nil,                                             // 129: obiente.cloud.deployments.v1.Deployment.EnvVarsEntry
-	nil,                                             // 130: obiente.cloud.deployments.v1.Deployment.BuildArgsEntry
-	nil,                                             // 131: obiente.cloud.deployments.v1.DockerfileBuildOptions.LabelsEntry
-	(*v1.Pagination)(nil),                           // 132: obiente.cloud.common.v1.Pagination
-	(*timestamppb.Timestamp)(nil),                   // 133: google.protobuf.Timestamp
-	(v1.LogLevel)(0),                                // 134: obiente.cloud.common.v1.LogLevel
-	(*v1.ChunkedUploadPayload)(nil),                 // 135: obiente.cloud.common.v1.ChunkedUploadPayload
-	(*v1.ChunkedUploadResponsePayload)(nil),         // 136: obiente.cloud.common.v1.ChunkedUploadResponsePayload
-	(*v1.CreateServerFileArchiveRequest)(nil),       // 137: obiente.cloud.common.v1.CreateServerFileArchiveRequest
-	(*v1.CreateServerFileArchiveResponse)(nil),      // 138: obiente.cloud.common.v1.CreateServerFileArchiveResponse
+	(*RollbackDeploymentRequest)(nil),               // 32: obiente.cloud.deployments.v1.RollbackDeploymentRequest
+	(*RollbackDeploymentResponse)(nil),              // 33: obiente.cloud.deployments.v1.RollbackDeploymentResponse
+	(*DeploymentVersion)(nil),                       // 34: obiente.cloud.deployments.v1.DeploymentVersion
+	(*ListDeploymentVersionsRequest)(nil),           // 35: obiente.cloud.deployments.v1.ListDeploymentVersionsRequest
+	(*ListDeploymentVersionsResponse)(nil),          // 36: obiente.cloud.deployments.v1.ListDeploymentVersionsResponse
+	(*ScaleDeploymentRequest)(nil),                  // 37: obiente.cloud.deployments.v1.ScaleDeploymentRequest
+	(*ScaleDeploymentResponse)(nil),                 // 38: obiente.cloud.deployments.v1.ScaleDeploymentResponse
+	(*SetResourceLimitsRequest)(nil),                // 39: obiente.cloud.deployments.v1.SetResourceLimitsRequest
+	(*SetResourceLimitsResponse)(nil),               // 40: obiente.cloud.deployments.v1.SetResourceLimitsResponse
+	(*GetDeploymentEnvVarsRequest)(nil),             // 41: obiente.cloud.deployments.v1.GetDeploymentEnvVarsRequest
+	(*GetDeploymentEnvVarsResponse)(nil),            // 42: obiente.cloud.deployments.v1.GetDeploymentEnvVarsResponse
+	(*UpdateDeploymentEnvVarsRequest)(nil),          // 43: obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsRequest
+	(*UpdateDeploymentEnvVarsResponse)(nil),         // 44: obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsResponse
+	(*RotateEnvKeyRequest)(nil),                     // 45: obiente.cloud.deployments.v1.RotateEnvKeyRequest
+	(*RotateEnvKeyResponse)(nil),                    // 46: obiente.cloud.deployments.v1.RotateEnvKeyResponse
+	(*GetDeploymentComposeRequest)(nil),             // 47: obiente.cloud.deployments.v1.GetDeploymentComposeRequest
+	(*GetDeploymentComposeResponse)(nil),            // 48: obiente.cloud.deployments.v1.GetDeploymentComposeResponse
+	(*ValidateDeploymentComposeRequest)(nil),        // 49: obiente.cloud.deployments.v1.ValidateDeploymentComposeRequest
+	(*ValidateDeploymentComposeResponse)(nil),       // 50: obiente.cloud.deployments.v1.ValidateDeploymentComposeResponse
+	(*UpdateDeploymentComposeRequest)(nil),          // 51: obiente.cloud.deployments.v1.UpdateDeploymentComposeRequest
+	(*UpdateDeploymentComposeResponse)(nil),         // 52: obiente.cloud.deployments.v1.UpdateDeploymentComposeResponse
+	(*ComposeValidationError)(nil),                  // 53: obiente.cloud.deployments.v1.ComposeValidationError
+	(*ListGitHubReposRequest)(nil),                  // 54: obiente.cloud.deployments.v1.ListGitHubReposRequest
+	(*GitHubRepo)(nil),                              // 55: obiente.cloud.deployments.v1.GitHubRepo
+	(*ListGitHubReposResponse)(nil),                 // 56: obiente.cloud.deployments.v1.ListGitHubReposResponse
+	(*GetGitHubBranchesRequest)(nil),                // 57: obiente.cloud.deployments.v1.GetGitHubBranchesRequest
+	(*GitHubBranch)(nil),                            // 58: obiente.cloud.deployments.v1.GitHubBranch
+	(*GetGitHubBranchesResponse)(nil),               // 59: obiente.cloud.deployments.v1.GetGitHubBranchesResponse
+	(*GetGitHubFileRequest)(nil),                    // 60: obiente.cloud.deployments.v1.GetGitHubFileRequest
+	(*GetGitHubFileResponse)(nil),                   // 61: obiente.cloud.deployments.v1.GetGitHubFileResponse
+	(*ListAvailableGitHubIntegrationsRequest)(nil),  // 62: obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsRequest
+	(*GitHubIntegrationOption)(nil),                 // 63: obiente.cloud.deployments.v1.GitHubIntegrationOption
+	(*ListAvailableGitHubIntegrationsResponse)(nil), // 64: obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsResponse
+	(*RotateDeployKeyRequest)(nil),                  // 65: obiente.cloud.deployments.v1.RotateDeployKeyRequest
+	(*GitHubDeployKey)(nil),                         // 66: obiente.cloud.deployments.v1.GitHubDeployKey
+	(*RotateDeployKeyResponse)(nil),                 // 67: obiente.cloud.deployments.v1.RotateDeployKeyResponse
+	(*RotateWebhookSecretRequest)(nil),              // 68: obiente.cloud.deployments.v1.RotateWebhookSecretRequest
+	(*RotateWebhookSecretResponse)(nil),             // 69: obiente.cloud.deployments.v1.RotateWebhookSecretResponse
+	(*StreamTerminalOutputRequest)(nil),             // 70: obiente.cloud.deployments.v1.StreamTerminalOutputRequest
+	(*SendTerminalInputRequest)(nil),                // 71: obiente.cloud.deployments.v1.SendTerminalInputRequest
+	(*SendTerminalInputResponse)(nil),               // 72: obiente.cloud.deployments.v1.SendTerminalInputResponse
+	(*TerminalInput)(nil),                           // 73: obiente.cloud.deployments.v1.TerminalInput
+	(*TerminalOutput)(nil),                          // 74: obiente.cloud.deployments.v1.TerminalOutput
+	(*VolumeInfo)(nil),                              // 75: obiente.cloud.deployments.v1.VolumeInfo
+	(*ListContainerFilesRequest)(nil),               // 76: obiente.cloud.deployments.v1.ListContainerFilesRequest
+	(*ContainerFile)(nil),                           // 77: obiente.cloud.deployments.v1.ContainerFile
+	(*ListContainerFilesResponse)(nil),              // 78: obiente.cloud.deployments.v1.ListContainerFilesResponse
+	(*GetContainerFileRequest)(nil),                 // 79: obiente.cloud.deployments.v1.GetContainerFileRequest
+	(*GetContainerFileResponse)(nil),                // 80: obiente.cloud.deployments.v1.GetContainerFileResponse
+	(*UploadContainerFilesRequest)(nil),             // 81: obiente.cloud.deployments.v1.UploadContainerFilesRequest
+	(*UploadContainerFilesMetadata)(nil),            // 82: obiente.cloud.deployments.v1.UploadContainerFilesMetadata
+	(*FileMetadata)(nil),                            // 83: obiente.cloud.deployments.v1.FileMetadata
+	(*UploadContainerFilesResponse)(nil),            // 84: obiente.cloud.deployments.v1.UploadContainerFilesResponse
+	(*ChunkUploadContainerFilesRequest)(nil),        // 85: obiente.cloud.deployments.v1.ChunkUploadContainerFilesRequest
+	(*ChunkUploadContainerFilesResponse)(nil),       // 86: obiente.cloud.deployments.v1.ChunkUploadContainerFilesResponse
+	(*DeleteContainerEntriesRequest)(nil),           // 87: obiente.cloud.deployments.v1.DeleteContainerEntriesRequest
+	(*DeleteContainerEntriesError)(nil),             // 88: obiente.cloud.deployments.v1.DeleteContainerEntriesError
+	(*DeleteContainerEntriesResponse)(nil),          // 89: obiente.cloud.deployments.v1.DeleteContainerEntriesResponse
+	(*RenameContainerEntryRequest)(nil),             // 90: obiente.cloud.deployments.v1.RenameContainerEntryRequest
+	(*RenameContainerEntryResponse)(nil),            // 91: obiente.cloud.deployments.v1.RenameContainerEntryResponse
+	(*CreateContainerEntryRequest)(nil),             // 92: obiente.cloud.deployments.v1.CreateContainerEntryRequest
+	(*CreateContainerEntryResponse)(nil),            // 93: obiente.cloud.deployments.v1.CreateContainerEntryResponse
+	(*WriteContainerFileRequest)(nil),               // 94: obiente.cloud.deployments.v1.WriteContainerFileRequest
+	(*WriteContainerFileResponse)(nil),              // 95: obiente.cloud.deployments.v1.WriteContainerFileResponse
+	(*ExtractDeploymentFileRequest)(nil),            // 96: obiente.cloud.deployments.v1.ExtractDeploymentFileRequest
+	(*ExtractDeploymentFileResponse)(nil),           // 97: obiente.cloud.deployments.v1.ExtractDeploymentFileResponse
+	(*CreateDeploymentFileArchiveRequest)(nil),      // 98: obiente.cloud.deployments.v1.CreateDeploymentFileArchiveRequest
+	(*CreateDeploymentFileArchiveResponse)(nil),     // 99: obiente.cloud.deployments.v1.CreateDeploymentFileArchiveResponse
+	(*RoutingRule)(nil),                             // 100: obiente.cloud.deployments.v1.RoutingRule
+	(*GetDeploymentRoutingsRequest)(nil),            // 101: obiente.cloud.deployments.v1.GetDeploymentRoutingsRequest
+	(*GetDeploymentRoutingsResponse)(nil),           // 102: obiente.cloud.deployments.v1.GetDeploymentRoutingsResponse
+	(*UpdateDeploymentRoutingsRequest)(nil),         // 103: obiente.cloud.deployments.v1.UpdateDeploymentRoutingsRequest
+	(*UpdateDeploymentRoutingsResponse)(nil),        // 104: obiente.cloud.deployments.v1.UpdateDeploymentRoutingsResponse
+	(*GetDeploymentServiceNamesRequest)(nil),        // 105: obiente.cloud.deployments.v1.GetDeploymentServiceNamesRequest
+	(*GetDeploymentServiceNamesResponse)(nil),       // 106: obiente.cloud.deployments.v1.GetDeploymentServiceNamesResponse
+	(*GetDomainVerificationTokenRequest)(nil),       // 107: obiente.cloud.deployments.v1.GetDomainVerificationTokenRequest
+	(*GetDomainVerificationTokenResponse)(nil),      // 108: obiente.cloud.deployments.v1.GetDomainVerificationTokenResponse
+	(*VerifyDomainOwnershipRequest)(nil),            // 109: obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest
+	(*VerifyDomainOwnershipResponse)(nil),           // 110: obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse
+	(*CustomDomain)(nil),                            // 111: obiente.cloud.deployments.v1.CustomDomain
+	(*CreateCustomDomainRequest)(nil),               // 112: obiente.cloud.deployments.v1.CreateCustomDomainRequest
+	(*CreateCustomDomainResponse)(nil),              // 113: obiente.cloud.deployments.v1.CreateCustomDomainResponse
+	(*VerifyCustomDomainRequest)(nil),               // 114: obiente.cloud.deployments.v1.VerifyCustomDomainRequest
+	(*VerifyCustomDomainResponse)(nil),              // 115: obiente.cloud.deployments.v1.VerifyCustomDomainResponse
+	(*UpdateDeploymentHealthCheckRequest)(nil),      // 116: obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckRequest
+	(*UpdateDeploymentHealthCheckResponse)(nil),     // 117: obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckResponse
+	(*AffinityRule)(nil),                            // 118: obiente.cloud.deployments.v1.AffinityRule
+	(*SetDeploymentAffinityRulesRequest)(nil),       // 119: obiente.cloud.deployments.v1.SetDeploymentAffinityRulesRequest
+	(*SetDeploymentAffinityRulesResponse)(nil),      // 120: obiente.cloud.deployments.v1.SetDeploymentAffinityRulesResponse
+	(*GetDeploymentAffinityRulesRequest)(nil),       // 121: obiente.cloud.deployments.v1.GetDeploymentAffinityRulesRequest
+	(*GetDeploymentAffinityRulesResponse)(nil),      // 122: obiente.cloud.deployments.v1.GetDeploymentAffinityRulesResponse
+	(*ScaleSchedule)(nil),                           // 123: obiente.cloud.deployments.v1.ScaleSchedule
+	(*CreateScaleScheduleRequest)(nil),              // 124: obiente.cloud.deployments.v1.CreateScaleScheduleRequest
+	(*CreateScaleScheduleResponse)(nil),             // 125: obiente.cloud.deployments.v1.CreateScaleScheduleResponse
+	(*DeleteScaleScheduleRequest)(nil),              // 126: obiente.cloud.deployments.v1.DeleteScaleScheduleRequest
+	(*DeleteScaleScheduleResponse)(nil),             // 127: obiente.cloud.deployments.v1.DeleteScaleScheduleResponse
+	(*ListScaleSchedulesRequest)(nil),               // 128: obiente.cloud.deployments.v1.ListScaleSchedulesRequest
+	(*ListScaleSchedulesResponse)(nil),              // 129: obiente.cloud.deployments.v1.ListScaleSchedulesResponse
+	(*AutoScalePolicy)(nil),                         // 130: obiente.cloud.deployments.v1.AutoScalePolicy
+	(*SetAutoScalePolicyRequest)(nil),               // 131: obiente.cloud.deployments.v1.SetAutoScalePolicyRequest
+	(*SetAutoScalePolicyResponse)(nil),              // 132: obiente.cloud.deployments.v1.SetAutoScalePolicyResponse
+	(*GetAutoScalePolicyRequest)(nil),               // 133: obiente.cloud.deployments.v1.GetAutoScalePolicyRequest
+	(*GetAutoScalePolicyResponse)(nil),              // 134: obiente.cloud.deployments.v1.GetAutoScalePolicyResponse
+	(*DisableAutoScaleRequest)(nil),                 // 135: obiente.cloud.deployments.v1.DisableAutoScaleRequest
+	(*DisableAutoScaleResponse)(nil),                // 136: obiente.cloud.deployments.v1.DisableAutoScaleResponse
+	(*DeploymentRegionStatus)(nil),                  // 137: obiente.cloud.deployments.v1.DeploymentRegionStatus
+	(*GetDeploymentRegionStatusRequest)(nil),        // 138: obiente.cloud.deployments.v1.GetDeploymentRegionStatusRequest
+	(*GetDeploymentRegionStatusResponse)(nil),       // 139: obiente.cloud.deployments.v1.GetDeploymentRegionStatusResponse
+	(*GetDeploymentMetricsRequest)(nil),             // 140: obiente.cloud.deployments.v1.GetDeploymentMetricsRequest
+	(*GetDeploymentMetricsResponse)(nil),            // 141: obiente.cloud.deployments.v1.GetDeploymentMetricsResponse
+	(*StreamDeploymentMetricsRequest)(nil),          // 142: obiente.cloud.deployments.v1.StreamDeploymentMetricsRequest
+	(*DeploymentMetric)(nil),                        // 143: obiente.cloud.deployments.v1.DeploymentMetric
+	(*GetDeploymentUsageRequest)(nil),               // 144: obiente.cloud.deployments.v1.GetDeploymentUsageRequest
+	(*GetDeploymentUsageResponse)(nil),              // 145: obiente.cloud.deployments.v1.GetDeploymentUsageResponse
+	(*DeploymentUsageMetrics)(nil),                  // 146: obiente.cloud.deployments.v1.DeploymentUsageMetrics
+	(*Deployment)(nil),                              // 147: obiente.cloud.deployments.v1.Deployment
+	(*DockerfileVolume)(nil),                        // 148: obiente.cloud.deployments.v1.DockerfileVolume
+	(*DockerfileBuildOptions)(nil),                  // 149: obiente.cloud.deployments.v1.DockerfileBuildOptions
+	(*ListDeploymentContainersRequest)(nil),         // 150: obiente.cloud.deployments.v1.ListDeploymentContainersRequest
+	(*ListDeploymentContainersResponse)(nil),        // 151: obiente.cloud.deployments.v1.ListDeploymentContainersResponse
+	(*DeploymentContainer)(nil),                     // 152: obiente.cloud.deployments.v1.DeploymentContainer
+	(*StreamContainerLogsRequest)(nil),              // 153: obiente.cloud.deployments.v1.StreamContainerLogsRequest
+	(*StartContainerRequest)(nil),                   // 154: obiente.cloud.deployments.v1.StartContainerRequest
+	(*StartContainerResponse)(nil),                  // 155: obiente.cloud.deployments.v1.StartContainerResponse
+	(*StopContainerRequest)(nil),                    // 156: obiente.cloud.deployments.v1.StopContainerRequest
+	(*StopContainerResponse)(nil),                   // 157: obiente.cloud.deployments.v1.StopContainerResponse
+	(*RestartContainerRequest)(nil),                 // 158: obiente.cloud.deployments.v1.RestartContainerRequest
+	(*RestartContainerResponse)(nil),                // 159: obiente.cloud.deployments.v1.RestartContainerResponse
+	(*ListBuildsRequest)(nil),                       // 160: obiente.cloud.deployments.v1.ListBuildsRequest
+	(*ListBuildsResponse)(nil),                      // 161: obiente.cloud.deployments.v1.ListBuildsResponse
+	(*GetBuildRequest)(nil),                         // 162: obiente.cloud.deployments.v1.GetBuildRequest
+	(*GetBuildResponse)(nil),                        // 163: obiente.cloud.deployments.v1.GetBuildResponse
+	(*GetBuildLogsRequest)(nil),                     // 164: obiente.cloud.deployments.v1.GetBuildLogsRequest
+	(*GetBuildLogsResponse)(nil),                    // 165: obiente.cloud.deployments.v1.GetBuildLogsResponse
+	(*RevertToBuildRequest)(nil),                    // 166: obiente.cloud.deployments.v1.RevertToBuildRequest
+	(*RevertToBuildResponse)(nil),                   // 167: obiente.cloud.deployments.v1.RevertToBuildResponse
+	(*DeleteBuildRequest)(nil),                      // 168: obiente.cloud.deployments.v1.DeleteBuildRequest
+	(*DeleteBuildResponse)(nil),                     // 169: obiente.cloud.deployments.v1.DeleteBuildResponse
+	(*Build)(nil),                                   // 170: obiente.cloud.deployments.v1.Build
+	(*DockerfileLintWarning)(nil),                   // 171: obiente.cloud.deployments.v1.DockerfileLintWarning
+	nil,                                             // 172: obiente.cloud.deployments.v1.ListDeploymentsRequest.TagsEntry
+	nil,                                             // 173: obiente.cloud.deployments.v1.UpdateDeploymentRequest.BuildArgsEntry
+	nil,                                             // 174: obiente.cloud.deployments.v1.Deployment.EnvVarsEntry
+	nil,                                             // 175: obiente.cloud.deployments.v1.Deployment.BuildArgsEntry
+	nil,                                             // 176: obiente.cloud.deployments.v1.DockerfileBuildOptions.LabelsEntry
+	(*v1.Pagination)(nil),                           // 177: obiente.cloud.common.v1.Pagination
+	(*timestamppb.Timestamp)(nil),                   // 178: google.protobuf.Timestamp
+	(v1.LogLevel)(0),                                // 179: obiente.cloud.common.v1.LogLevel
+	(*v1.ChunkedUploadPayload)(nil),                 // 180: obiente.cloud.common.v1.ChunkedUploadPayload
+	(*v1.ChunkedUploadResponsePayload)(nil),         // 181: obiente.cloud.common.v1.ChunkedUploadResponsePayload
+	(*v1.CreateServerFileArchiveRequest)(nil),       // 182: obiente.cloud.common.v1.CreateServerFileArchiveRequest
+	(*v1.CreateServerFileArchiveResponse)(nil),      // 183: obiente.cloud.common.v1.CreateServerFileArchiveResponse
 }
 var file_obiente_cloud_deployments_v1_deployment_service_proto_depIdxs = []int32{
 	3,   // 0: obiente.cloud.deployments.v1.ListDeploymentsRequest.status:type_name -> obiente.cloud.deployments.v1.DeploymentStatus
-	104, // 1: obiente.cloud.deployments.v1.ListDeploymentsResponse.deployments:type_name -> obiente.cloud.deployments.v1.Deployment
-	132, // 2: obiente.cloud.deployments.v1.ListDeploymentsResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
-	2,   // 3: obiente.cloud.deployments.v1.CreateDeploymentRequest.environment:type_name -> obiente.cloud.deployments.v1.Environment
-	104, // 4: obiente.cloud.deployments.v1.CreateDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
-	104, // 5: obiente.cloud.deployments.v1.GetDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
-	1,   // 6: obiente.cloud.deployments.v1.UpdateDeploymentRequest.build_strategy:type_name -> obiente.cloud.deployments.v1.BuildStrategy
-	2,   // 7: obiente.cloud.deployments.v1.UpdateDeploymentRequest.environment:type_name -> obiente.cloud.deployments.v1.Environment
-	5,   // 8: obiente.cloud.deployments.v1.UpdateDeploymentRequest.healthcheck_type:type_name -> obiente.cloud.deployments.v1.HealthCheckType
-	128, // 9: obiente.cloud.deployments.v1.UpdateDeploymentRequest.build_args:type_name -> obiente.cloud.deployments.v1.UpdateDeploymentRequest.BuildArgsEntry
-	105, // 10: obiente.cloud.deployments.v1.UpdateDeploymentRequest.dockerfile_volumes:type_name -> obiente.cloud.deployments.v1.DockerfileVolume
-	106, // 11: obiente.cloud.deployments.v1.UpdateDeploymentRequest.dockerfile_build_options:type_name -> obiente.cloud.deployments.v1.DockerfileBuildOptions
-	104, // 12: obiente.cloud.deployments.v1.UpdateDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
-	3,   // 13: obiente.cloud.deployments.v1.DeploymentStatusUpdate.status:type_name -> obiente.cloud.deployments.v1.DeploymentStatus
-	133, // 14: obiente.cloud.deployments.v1.DeploymentStatusUpdate.timestamp:type_name -> google.protobuf.Timestamp
-	133, // 15: obiente.cloud.deployments.v1.DeploymentLogLine.timestamp:type_name -> google.protobuf.Timestamp
-	134, // 16: obiente.cloud.deployments.v1.DeploymentLogLine.log_level:type_name -> obiente.cloud.common.v1.LogLevel
-	104, // 17: obiente.cloud.deployments.v1.StartDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
-	104, // 18: obiente.cloud.deployments.v1.StopDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
-	104, // 19: obiente.cloud.deployments.v1.RestartDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
-	104, // 20: obiente.cloud.deployments.v1.ScaleDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
-	104, // 21: obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
-	44,  // 22: obiente.cloud.deployments.v1.ValidateDeploymentComposeResponse.validation_errors:type_name -> obiente.cloud.deployments.v1.ComposeValidationError
-	104, // 23: obiente.cloud.deployments.v1.UpdateDeploymentComposeResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
-	44,  // 24: obiente.cloud.deployments.v1.UpdateDeploymentComposeResponse.validation_errors:type_name -> obiente.cloud.deployments.v1.ComposeValidationError
-	46,  // 25: obiente.cloud.deployments.v1.ListGitHubReposResponse.repos:type_name -> obiente.cloud.deployments.v1.GitHubRepo
-	49,  // 26: obiente.cloud.deployments.v1.GetGitHubBranchesResponse.branches:type_name -> obiente.cloud.deployments.v1.GitHubBranch
-	54,  // 27: obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsResponse.integrations:type_name -> obiente.cloud.deployments.v1.GitHubIntegrationOption
-	133, // 28: obiente.cloud.deployments.v1.ContainerFile.modified_time:type_name -> google.protobuf.Timestamp
-	133, // 29: obiente.cloud.deployments.v1.ContainerFile.created_time:type_name -> google.protobuf.Timestamp
-	63,  // 30: obiente.cloud.deployments.v1.ListContainerFilesResponse.files:type_name -> obiente.cloud.deployments.v1.ContainerFile
-	61,  // 31: obiente.cloud.deployments.v1.ListContainerFilesResponse.volumes:type_name -> obiente.cloud.deployments.v1.VolumeInfo
-	63,  // 32: obiente.cloud.deployments.v1.GetContainerFileResponse.metadata:type_name -> obiente.cloud.deployments.v1.ContainerFile
-	68,  // 33: obiente.cloud.deployments.v1.UploadContainerFilesRequest.metadata:type_name -> obiente.cloud.deployments.v1.UploadContainerFilesMetadata
-	69,  // 34: obiente.cloud.deployments.v1.UploadContainerFilesMetadata.files:type_name -> obiente.cloud.deployments.v1.FileMetadata
-	135, // 35: obiente.cloud.deployments.v1.ChunkUploadContainerFilesRequest.upload:type_name -> obiente.cloud.common.v1.ChunkedUploadPayload
-	136, // 36: obiente.cloud.deployments.v1.ChunkUploadContainerFilesResponse.result:type_name -> obiente.cloud.common.v1.ChunkedUploadResponsePayload
-	74,  // 37: obiente.cloud.deployments.v1.DeleteContainerEntriesResponse.errors:type_name -> obiente.cloud.deployments.v1.DeleteContainerEntriesError
-	63,  // 38: obiente.cloud.deployments.v1.RenameContainerEntryResponse.entry:type_name -> obiente.cloud.deployments.v1.ContainerFile
-	6,   // 39: obiente.cloud.deployments.v1.CreateContainerEntryRequest.type:type_name -> obiente.cloud.deployments.v1.ContainerEntryType
-	63,  // 40: obiente.cloud.deployments.v1.CreateContainerEntryResponse.entry:type_name -> obiente.cloud.deployments.v1.ContainerFile
-	63,  // 41: obiente.cloud.deployments.v1.WriteContainerFileResponse.entry:type_name -> obiente.cloud.deployments.v1.ContainerFile
-	137, // 42: obiente.cloud.deployments.v1.CreateDeploymentFileArchiveRequest.archive_request:type_name -> obiente.cloud.common.v1.CreateServerFileArchiveRequest
-	138, // 43: obiente.cloud.deployments.v1.CreateDeploymentFileArchiveResponse.archive_response:type_name -> obiente.cloud.common.v1.CreateServerFileArchiveResponse
-	86,  // 44: obiente.cloud.deployments.v1.GetDeploymentRoutingsResponse.rules:type_name -> obiente.cloud.deployments.v1.RoutingRule
-	86,  // 45: obiente.cloud.deployments.v1.UpdateDeploymentRoutingsRequest.rules:type_name -> obiente.cloud.deployments.v1.RoutingRule
-	86,  // 46: obiente.cloud.deployments.v1.UpdateDeploymentRoutingsResponse.rules:type_name -> obiente.cloud.deployments.v1.RoutingRule
-	133, // 47: obiente.cloud.deployments.v1.GetDeploymentMetricsRequest.start_time:type_name -> google.protobuf.Timestamp
-	133, // 48: obiente.cloud.deployments.v1.GetDeploymentMetricsRequest.end_time:type_name -> google.protobuf.Timestamp
-	100, // 49: obiente.cloud.deployments.v1.GetDeploymentMetricsResponse.metrics:type_name -> obiente.cloud.deployments.v1.DeploymentMetric
-	133, // 50: obiente.cloud.deployments.v1.DeploymentMetric.timestamp:type_name -> google.protobuf.Timestamp
-	103, // 51: obiente.cloud.deployments.v1.GetDeploymentUsageResponse.current:type_name -> obiente.cloud.deployments.v1.DeploymentUsageMetrics
-	103, // 52: obiente.cloud.deployments.v1.GetDeploymentUsageResponse.estimated_monthly:type_name -> obiente.cloud.deployments.v1.DeploymentUsageMetrics
-	0,   // 53: obiente.cloud.deployments.v1.Deployment.type:type_name -> obiente.cloud.deployments.v1.DeploymentType
-	1,   // 54: obiente.cloud.deployments.v1.Deployment.build_strategy:type_name -> obiente.cloud.deployments.v1.BuildStrategy
-	3,   // 55: obiente.cloud.deployments.v1.Deployment.status:type_name -> obiente.cloud.deployments.v1.DeploymentStatus
-	133, // 56: obiente.cloud.deployments.v1.Deployment.last_deployed_at:type_name -> google.protobuf.Timestamp
-	133, // 57: obiente.cloud.deployments.v1.Deployment.created_at:type_name -> google.protobuf.Timestamp
-	2,   // 58: obiente.cloud.deployments.v1.Deployment.environment:type_name -> obiente.cloud.deployments.v1.Environment
-	129, // 59: obiente.cloud.deployments.v1.Deployment.env_vars:type_name -> obiente.cloud.deployments.v1.Deployment.EnvVarsEntry
-	5,   // 60: obiente.cloud.deployments.v1.Deployment.healthcheck_type:type_name -> obiente.cloud.deployments.v1.HealthCheckType
-	130, // 61: obiente.cloud.deployments.v1.Deployment.build_args:type_name -> obiente.cloud.deployments.v1.Deployment.BuildArgsEntry
-	105, // 62: obiente.cloud.deployments.v1.Deployment.dockerfile_volumes:type_name -> obiente.cloud.deployments.v1.DockerfileVolume
-	106, // 63: obiente.cloud.deployments.v1.Deployment.dockerfile_build_options:type_name -> obiente.cloud.deployments.v1.DockerfileBuildOptions
-	131, // 64: obiente.cloud.deployments.v1.DockerfileBuildOptions.labels:type_name -> obiente.cloud.deployments.v1.DockerfileBuildOptions.LabelsEntry
-	109, // 65: obiente.cloud.deployments.v1.ListDeploymentContainersResponse.containers:type_name -> obiente.cloud.deployments.v1.DeploymentContainer
-	133, // 66: obiente.cloud.deployments.v1.DeploymentContainer.created_at:type_name -> google.protobuf.Timestamp
-	133, // 67: obiente.cloud.deployments.v1.DeploymentContainer.updated_at:type_name -> google.protobuf.Timestamp
-	127, // 68: obiente.cloud.deployments.v1.ListBuildsResponse.builds:type_name -> obiente.cloud.deployments.v1.Build
-	127, // 69: obiente.cloud.deployments.v1.GetBuildResponse.build:type_name -> obiente.cloud.deployments.v1.Build
-	23,  // 70: obiente.cloud.deployments.v1.GetBuildLogsResponse.logs:type_name -> obiente.cloud.deployments.v1.DeploymentLogLine
-	104, // 71: obiente.cloud.deployments.v1.RevertToBuildResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
-	4,   // 72: obiente.cloud.deployments.v1.Build.status:type_name -> obiente.cloud.deployments.v1.BuildStatus
-	133, // 73: obiente.cloud.deployments.v1.Build.started_at:type_name -> google.protobuf.Timestamp
-	133, // 74: obiente.cloud.deployments.v1.Build.completed_at:type_name -> google.protobuf.Timestamp
-	1,   // 75: obiente.cloud.deployments.v1.Build.build_strategy:type_name -> obiente.cloud.deployments.v1.BuildStrategy
-	133, // 76: obiente.cloud.deployments.v1.Build.created_at:type_name -> google.protobuf.Timestamp
-	133, // 77: obiente.cloud.deployments.v1.Build.updated_at:type_name -> google.protobuf.Timestamp
-	7,   // 78: obiente.cloud.deployments.v1.DeploymentService.ListDeployments:input_type -> obiente.cloud.deployments.v1.ListDeploymentsRequest
-	9,   // 79: obiente.cloud.deployments.v1.DeploymentService.CreateDeployment:input_type -> obiente.cloud.deployments.v1.CreateDeploymentRequest
-	11,  // 80: obiente.cloud.deployments.v1.DeploymentService.GetDeployment:input_type -> obiente.cloud.deployments.v1.GetDeploymentRequest
-	13,  // 81: obiente.cloud.deployments.v1.DeploymentService.UpdateDeployment:input_type -> obiente.cloud.deployments.v1.UpdateDeploymentRequest
-	15,  // 82: obiente.cloud.deployments.v1.DeploymentService.TriggerDeployment:input_type -> obiente.cloud.deployments.v1.TriggerDeploymentRequest
-	17,  // 83: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentStatus:input_type -> obiente.cloud.deployments.v1.StreamDeploymentStatusRequest
-	19,  // 84: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentLogs:input_type -> obiente.cloud.deployments.v1.GetDeploymentLogsRequest
-	21,  // 85: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentLogs:input_type -> obiente.cloud.deployments.v1.StreamDeploymentLogsRequest
-	22,  // 86: obiente.cloud.deployments.v1.DeploymentService.StreamBuildLogs:input_type -> obiente.cloud.deployments.v1.StreamBuildLogsRequest
-	97,  // 87: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentMetrics:input_type -> obiente.cloud.deployments.v1.GetDeploymentMetricsRequest
-	99,  // 88: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentMetrics:input_type -> obiente.cloud.deployments.v1.StreamDeploymentMetricsRequest
-	101, // 89: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentUsage:input_type -> obiente.cloud.deployments.v1.GetDeploymentUsageRequest
-	24,  // 90: obiente.cloud.deployments.v1.DeploymentService.StartDeployment:input_type -> obiente.cloud.deployments.v1.StartDeploymentRequest
-	26,  // 91: obiente.cloud.deployments.v1.DeploymentService.StopDeployment:input_type -> obiente.cloud.deployments.v1.StopDeploymentRequest
-	28,  // 92: obiente.cloud.deployments.v1.DeploymentService.DeleteDeployment:input_type -> obiente.cloud.deployments.v1.DeleteDeploymentRequest
-	30,  // 93: obiente.cloud.deployments.v1.DeploymentService.RestartDeployment:input_type -> obiente.cloud.deployments.v1.RestartDeploymentRequest
-	32,  // 94: obiente.cloud.deployments.v1.DeploymentService.ScaleDeployment:input_type -> obiente.cloud.deployments.v1.ScaleDeploymentRequest
-	34,  // 95: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentEnvVars:input_type -> obiente.cloud.deployments.v1.GetDeploymentEnvVarsRequest
-	36,  // 96: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentEnvVars:input_type -> obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsRequest
-	38,  // 97: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentCompose:input_type -> obiente.cloud.deployments.v1.GetDeploymentComposeRequest
-	40,  // 98: obiente.cloud.deployments.v1.DeploymentService.ValidateDeploymentCompose:input_type -> obiente.cloud.deployments.v1.ValidateDeploymentComposeRequest
-	42,  // 99: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentCompose:input_type -> obiente.cloud.deployments.v1.UpdateDeploymentComposeRequest
-	45,  // 100: obiente.cloud.deployments.v1.DeploymentService.ListGitHubRepos:input_type -> obiente.cloud.deployments.v1.ListGitHubReposRequest
-	48,  // 101: obiente.cloud.deployments.v1.DeploymentService.GetGitHubBranches:input_type -> obiente.cloud.deployments.v1.GetGitHubBranchesRequest
-	51,  // 102: obiente.cloud.deployments.v1.DeploymentService.GetGitHubFile:input_type -> obiente.cloud.deployments.v1.GetGitHubFileRequest
-	117, // 103: obiente.cloud.deployments.v1.DeploymentService.ListBuilds:input_type -> obiente.cloud.deployments.v1.ListBuildsRequest
-	119, // 104: obiente.cloud.deployments.v1.DeploymentService.GetBuild:input_type -> obiente.cloud.deployments.v1.GetBuildRequest
-	121, // 105: obiente.cloud.deployments.v1.DeploymentService.GetBuildLogs:input_type -> obiente.cloud.deployments.v1.GetBuildLogsRequest
-	123, // 106: obiente.cloud.deployments.v1.DeploymentService.RevertToBuild:input_type -> obiente.cloud.deployments.v1.RevertToBuildRequest
-	125, // 107: obiente.cloud.deployments.v1.DeploymentService.DeleteBuild:input_type -> obiente.cloud.deployments.v1.DeleteBuildRequest
-	53,  // 108: obiente.cloud.deployments.v1.DeploymentService.ListAvailableGitHubIntegrations:input_type -> obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsRequest
-	59,  // 109: obiente.cloud.deployments.v1.DeploymentService.StreamTerminal:input_type -> obiente.cloud.deployments.v1.TerminalInput
-	56,  // 110: obiente.cloud.deployments.v1.DeploymentService.StreamTerminalOutput:input_type -> obiente.cloud.deployments.v1.StreamTerminalOutputRequest
-	57,  // 111: obiente.cloud.deployments.v1.DeploymentService.SendTerminalInput:input_type -> obiente.cloud.deployments.v1.SendTerminalInputRequest
-	62,  // 112: obiente.cloud.deployments.v1.DeploymentService.ListContainerFiles:input_type -> obiente.cloud.deployments.v1.ListContainerFilesRequest
-	65,  // 113: obiente.cloud.deployments.v1.DeploymentService.GetContainerFile:input_type -> obiente.cloud.deployments.v1.GetContainerFileRequest
-	67,  // 114: obiente.cloud.deployments.v1.DeploymentService.UploadContainerFiles:input_type -> obiente.cloud.deployments.v1.UploadContainerFilesRequest
-	71,  // 115: obiente.cloud.deployments.v1.DeploymentService.ChunkUploadContainerFiles:input_type -> obiente.cloud.deployments.v1.ChunkUploadContainerFilesRequest
-	73,  // 116: obiente.cloud.deployments.v1.DeploymentService.DeleteContainerEntries:input_type -> obiente.cloud.deployments.v1.DeleteContainerEntriesRequest
-	76,  // 117: obiente.cloud.deployments.v1.DeploymentService.RenameContainerEntry:input_type -> obiente.cloud.deployments.v1.RenameContainerEntryRequest
-	78,  // 118: obiente.cloud.deployments.v1.DeploymentService.CreateContainerEntry:input_type -> obiente.cloud.deployments.v1.CreateContainerEntryRequest
-	80,  // 119: obiente.cloud.deployments.v1.DeploymentService.WriteContainerFile:input_type -> obiente.cloud.deployments.v1.WriteContainerFileRequest
-	82,  // 120: obiente.cloud.deployments.v1.DeploymentService.ExtractDeploymentFile:input_type -> obiente.cloud.deployments.v1.ExtractDeploymentFileRequest
-	84,  // 121: obiente.cloud.deployments.v1.DeploymentService.CreateDeploymentFileArchive:input_type -> obiente.cloud.deployments.v1.CreateDeploymentFileArchiveRequest
-	87,  // 122: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentRoutings:input_type -> obiente.cloud.deployments.v1.GetDeploymentRoutingsRequest
-	89,  // 123: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentRoutings:input_type -> obiente.cloud.deployments.v1.UpdateDeploymentRoutingsRequest
-	91,  // 124: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentServiceNames:input_type -> obiente.cloud.deployments.v1.GetDeploymentServiceNamesRequest
-	93,  // 125: obiente.cloud.deployments.v1.DeploymentService.GetDomainVerificationToken:input_type -> obiente.cloud.deployments.v1.GetDomainVerificationTokenRequest
-	95,  // 126: obiente.cloud.deployments.v1.DeploymentService.VerifyDomainOwnership:input_type -> obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest
-	107, // 127: obiente.cloud.deployments.v1.DeploymentService.ListDeploymentContainers:input_type -> obiente.cloud.deployments.v1.ListDeploymentContainersRequest
-	110, // 128: obiente.cloud.deployments.v1.DeploymentService.StreamContainerLogs:input_type -> obiente.cloud.deployments.v1.StreamContainerLogsRequest
-	111, // 129: obiente.cloud.deployments.v1.DeploymentService.StartContainer:input_type -> obiente.cloud.deployments.v1.StartContainerRequest
-	113, // 130: obiente.cloud.deployments.v1.DeploymentService.StopContainer:input_type -> obiente.cloud.deployments.v1.StopContainerRequest
-	115, // 131: obiente.cloud.deployments.v1.DeploymentService.RestartContainer:input_type -> obiente.cloud.deployments.v1.RestartContainerRequest
-	8,   // 132: obiente.cloud.deployments.v1.DeploymentService.ListDeployments:output_type -> obiente.cloud.deployments.v1.ListDeploymentsResponse
-	10,  // 133: obiente.cloud.deployments.v1.DeploymentService.CreateDeployment:output_type -> obiente.cloud.deployments.v1.CreateDeploymentResponse
-	12,  // 134: obiente.cloud.deployments.v1.DeploymentService.GetDeployment:output_type -> obiente.cloud.deployments.v1.GetDeploymentResponse
-	14,  // 135: obiente.cloud.deployments.v1.DeploymentService.UpdateDeployment:output_type -> obiente.cloud.deployments.v1.UpdateDeploymentResponse
-	16,  // 136: obiente.cloud.deployments.v1.DeploymentService.TriggerDeployment:output_type -> obiente.cloud.deployments.v1.TriggerDeploymentResponse
-	18,  // 137: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentStatus:output_type -> obiente.cloud.deployments.v1.DeploymentStatusUpdate
-	20,  // 138: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentLogs:output_type -> obiente.cloud.deployments.v1.GetDeploymentLogsResponse
-	23,  // 139: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentLogs:output_type -> obiente.cloud.deployments.v1.DeploymentLogLine
-	23,  // 140: obiente.cloud.deployments.v1.DeploymentService.StreamBuildLogs:output_type -> obiente.cloud.deployments.v1.DeploymentLogLine
-	98,  // 141: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentMetrics:output_type -> obiente.cloud.deployments.v1.GetDeploymentMetricsResponse
-	100, // 142: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentMetrics:output_type -> obiente.cloud.deployments.v1.DeploymentMetric
-	102, // 143: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentUsage:output_type -> obiente.cloud.deployments.v1.GetDeploymentUsageResponse
-	25,  // 144: obiente.cloud.deployments.v1.DeploymentService.StartDeployment:output_type -> obiente.cloud.deployments.v1.StartDeploymentResponse
-	27,  // 145: obiente.cloud.deployments.v1.DeploymentService.StopDeployment:output_type -> obiente.cloud.deployments.v1.StopDeploymentResponse
-	29,  // 146: obiente.cloud.deployments.v1.DeploymentService.DeleteDeployment:output_type -> obiente.cloud.deployments.v1.DeleteDeploymentResponse
-	31,  // 147: obiente.cloud.deployments.v1.DeploymentService.RestartDeployment:output_type -> obiente.cloud.deployments.v1.RestartDeploymentResponse
-	33,  // 148: obiente.cloud.deployments.v1.DeploymentService.ScaleDeployment:output_type -> obiente.cloud.deployments.v1.ScaleDeploymentResponse
-	35,  // 149: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentEnvVars:output_type -> obiente.cloud.deployments.v1.GetDeploymentEnvVarsResponse
-	37,  // 150: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentEnvVars:output_type -> obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsResponse
-	39,  // 151: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentCompose:output_type -> obiente.cloud.deployments.v1.GetDeploymentComposeResponse
-	41,  // 152: obiente.cloud.deployments.v1.DeploymentService.ValidateDeploymentCompose:output_type -> obiente.cloud.deployments.v1.ValidateDeploymentComposeResponse
-	43,  // 153: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentCompose:output_type -> obiente.cloud.deployments.v1.UpdateDeploymentComposeResponse
-	47,  // 154: obiente.cloud.deployments.v1.DeploymentService.ListGitHubRepos:output_type -> obiente.cloud.deployments.v1.ListGitHubReposResponse
-	50,  // 155: obiente.cloud.deployments.v1.DeploymentService.GetGitHubBranches:output_type -> obiente.cloud.deployments.v1.GetGitHubBranchesResponse
-	52,  // 156: obiente.cloud.deployments.v1.DeploymentService.GetGitHubFile:output_type -> obiente.cloud.deployments.v1.GetGitHubFileResponse
-	118, // 157: obiente.cloud.deployments.v1.DeploymentService.ListBuilds:output_type -> obiente.cloud.deployments.v1.ListBuildsResponse
-	120, // 158: obiente.cloud.deployments.v1.DeploymentService.GetBuild:output_type -> obiente.cloud.deployments.v1.GetBuildResponse
-	122, // 159: obiente.cloud.deployments.v1.DeploymentService.GetBuildLogs:output_type -> obiente.cloud.deployments.v1.GetBuildLogsResponse
-	124, // 160: obiente.cloud.deployments.v1.DeploymentService.RevertToBuild:output_type -> obiente.cloud.deployments.v1.RevertToBuildResponse
-	126, // 161: obiente.cloud.deployments.v1.DeploymentService.DeleteBuild:output_type -> obiente.cloud.deployments.v1.DeleteBuildResponse
-	55,  // 162: obiente.cloud.deployments.v1.DeploymentService.ListAvailableGitHubIntegrations:output_type -> obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsResponse
-	60,  // 163: obiente.cloud.deployments.v1.DeploymentService.StreamTerminal:output_type -> obiente.cloud.deployments.v1.TerminalOutput
-	60,  // 164: obiente.cloud.deployments.v1.DeploymentService.StreamTerminalOutput:output_type -> obiente.cloud.deployments.v1.TerminalOutput
-	58,  // 165: obiente.cloud.deployments.v1.DeploymentService.SendTerminalInput:output_type -> obiente.cloud.deployments.v1.SendTerminalInputResponse
-	64,  // 166: obiente.cloud.deployments.v1.DeploymentService.ListContainerFiles:output_type -> obiente.cloud.deployments.v1.ListContainerFilesResponse
-	66,  // 167: obiente.cloud.deployments.v1.DeploymentService.GetContainerFile:output_type -> obiente.cloud.deployments.v1.GetContainerFileResponse
-	70,  // 168: obiente.cloud.deployments.v1.DeploymentService.UploadContainerFiles:output_type -> obiente.cloud.deployments.v1.UploadContainerFilesResponse
-	72,  // 169: obiente.cloud.deployments.v1.DeploymentService.ChunkUploadContainerFiles:output_type -> obiente.cloud.deployments.v1.ChunkUploadContainerFilesResponse
-	75,  // 170: obiente.cloud.deployments.v1.DeploymentService.DeleteContainerEntries:output_type -> obiente.cloud.deployments.v1.DeleteContainerEntriesResponse
-	77,  // 171: obiente.cloud.deployments.v1.DeploymentService.RenameContainerEntry:output_type -> obiente.cloud.deployments.v1.RenameContainerEntryResponse
-	79,  // 172: obiente.cloud.deployments.v1.DeploymentService.CreateContainerEntry:output_type -> obiente.cloud.deployments.v1.CreateContainerEntryResponse
-	81,  // 173: obiente.cloud.deployments.v1.DeploymentService.WriteContainerFile:output_type -> obiente.cloud.deployments.v1.WriteContainerFileResponse
-	83,  // 174: obiente.cloud.deployments.v1.DeploymentService.ExtractDeploymentFile:output_type -> obiente.cloud.deployments.v1.ExtractDeploymentFileResponse
-	85,  // 175: obiente.cloud.deployments.v1.DeploymentService.CreateDeploymentFileArchive:output_type -> obiente.cloud.deployments.v1.CreateDeploymentFileArchiveResponse
-	88,  // 176: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentRoutings:output_type -> obiente.cloud.deployments.v1.GetDeploymentRoutingsResponse
-	90,  // 177: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentRoutings:output_type -> obiente.cloud.deployments.v1.UpdateDeploymentRoutingsResponse
-	92,  // 178: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentServiceNames:output_type -> obiente.cloud.deployments.v1.GetDeploymentServiceNamesResponse
-	94,  // 179: obiente.cloud.deployments.v1.DeploymentService.GetDomainVerificationToken:output_type -> obiente.cloud.deployments.v1.GetDomainVerificationTokenResponse
-	96,  // 180: obiente.cloud.deployments.v1.DeploymentService.VerifyDomainOwnership:output_type -> obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse
-	108, // 181: obiente.cloud.deployments.v1.DeploymentService.ListDeploymentContainers:output_type -> obiente.cloud.deployments.v1.ListDeploymentContainersResponse
-	23,  // 182: obiente.cloud.deployments.v1.DeploymentService.StreamContainerLogs:output_type -> obiente.cloud.deployments.v1.DeploymentLogLine
-	112, // 183: obiente.cloud.deployments.v1.DeploymentService.StartContainer:output_type -> obiente.cloud.deployments.v1.StartContainerResponse
-	114, // 184: obiente.cloud.deployments.v1.DeploymentService.StopContainer:output_type -> obiente.cloud.deployments.v1.StopContainerResponse
-	116, // 185: obiente.cloud.deployments.v1.DeploymentService.RestartContainer:output_type -> obiente.cloud.deployments.v1.RestartContainerResponse
-	132, // [132:186] is the sub-list for method output_type
-	78,  // [78:132] is the sub-list for method input_type
-	78,  // [78:78] is the sub-list for extension type_name
-	78,  // [78:78] is the sub-list for extension extendee
-	0,   // [0:78] is the sub-list for field type_name
+	172, // 1: obiente.cloud.deployments.v1.ListDeploymentsRequest.tags:type_name -> obiente.cloud.deployments.v1.ListDeploymentsRequest.TagsEntry
+	147, // 2: obiente.cloud.deployments.v1.ListDeploymentsResponse.deployments:type_name -> obiente.cloud.deployments.v1.Deployment
+	177, // 3: obiente.cloud.deployments.v1.ListDeploymentsResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	2,   // 4: obiente.cloud.deployments.v1.CreateDeploymentRequest.environment:type_name -> obiente.cloud.deployments.v1.Environment
+	147, // 5: obiente.cloud.deployments.v1.CreateDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	137, // 6: obiente.cloud.deployments.v1.CreateDeploymentResponse.regions:type_name -> obiente.cloud.deployments.v1.DeploymentRegionStatus
+	147, // 7: obiente.cloud.deployments.v1.GetDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	1,   // 8: obiente.cloud.deployments.v1.UpdateDeploymentRequest.build_strategy:type_name -> obiente.cloud.deployments.v1.BuildStrategy
+	2,   // 9: obiente.cloud.deployments.v1.UpdateDeploymentRequest.environment:type_name -> obiente.cloud.deployments.v1.Environment
+	5,   // 10: obiente.cloud.deployments.v1.UpdateDeploymentRequest.healthcheck_type:type_name -> obiente.cloud.deployments.v1.HealthCheckType
+	173, // 11: obiente.cloud.deployments.v1.UpdateDeploymentRequest.build_args:type_name -> obiente.cloud.deployments.v1.UpdateDeploymentRequest.BuildArgsEntry
+	148, // 12: obiente.cloud.deployments.v1.UpdateDeploymentRequest.dockerfile_volumes:type_name -> obiente.cloud.deployments.v1.DockerfileVolume
+	149, // 13: obiente.cloud.deployments.v1.UpdateDeploymentRequest.dockerfile_build_options:type_name -> obiente.cloud.deployments.v1.DockerfileBuildOptions
+	147, // 14: obiente.cloud.deployments.v1.UpdateDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	3,   // 15: obiente.cloud.deployments.v1.DeploymentStatusUpdate.status:type_name -> obiente.cloud.deployments.v1.DeploymentStatus
+	178, // 16: obiente.cloud.deployments.v1.DeploymentStatusUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	178, // 17: obiente.cloud.deployments.v1.DeploymentLogLine.timestamp:type_name -> google.protobuf.Timestamp
+	179, // 18: obiente.cloud.deployments.v1.DeploymentLogLine.log_level:type_name -> obiente.cloud.common.v1.LogLevel
+	147, // 19: obiente.cloud.deployments.v1.StartDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	147, // 20: obiente.cloud.deployments.v1.StopDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	147, // 21: obiente.cloud.deployments.v1.RestartDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	147, // 22: obiente.cloud.deployments.v1.RollbackDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	34,  // 23: obiente.cloud.deployments.v1.RollbackDeploymentResponse.version:type_name -> obiente.cloud.deployments.v1.DeploymentVersion
+	178, // 24: obiente.cloud.deployments.v1.DeploymentVersion.created_at:type_name -> google.protobuf.Timestamp
+	34,  // 25: obiente.cloud.deployments.v1.ListDeploymentVersionsResponse.versions:type_name -> obiente.cloud.deployments.v1.DeploymentVersion
+	147, // 26: obiente.cloud.deployments.v1.ScaleDeploymentResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	147, // 27: obiente.cloud.deployments.v1.SetResourceLimitsResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	147, // 28: obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	53,  // 29: obiente.cloud.deployments.v1.ValidateDeploymentComposeResponse.validation_errors:type_name -> obiente.cloud.deployments.v1.ComposeValidationError
+	147, // 30: obiente.cloud.deployments.v1.UpdateDeploymentComposeResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	53,  // 31: obiente.cloud.deployments.v1.UpdateDeploymentComposeResponse.validation_errors:type_name -> obiente.cloud.deployments.v1.ComposeValidationError
+	55,  // 32: obiente.cloud.deployments.v1.ListGitHubReposResponse.repos:type_name -> obiente.cloud.deployments.v1.GitHubRepo
+	58,  // 33: obiente.cloud.deployments.v1.GetGitHubBranchesResponse.branches:type_name -> obiente.cloud.deployments.v1.GitHubBranch
+	63,  // 34: obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsResponse.integrations:type_name -> obiente.cloud.deployments.v1.GitHubIntegrationOption
+	178, // 35: obiente.cloud.deployments.v1.GitHubDeployKey.rotated_at:type_name -> google.protobuf.Timestamp
+	66,  // 36: obiente.cloud.deployments.v1.RotateDeployKeyResponse.deploy_keys:type_name -> obiente.cloud.deployments.v1.GitHubDeployKey
+	178, // 37: obiente.cloud.deployments.v1.ContainerFile.modified_time:type_name -> google.protobuf.Timestamp
+	178, // 38: obiente.cloud.deployments.v1.ContainerFile.created_time:type_name -> google.protobuf.Timestamp
+	77,  // 39: obiente.cloud.deployments.v1.ListContainerFilesResponse.files:type_name -> obiente.cloud.deployments.v1.ContainerFile
+	75,  // 40: obiente.cloud.deployments.v1.ListContainerFilesResponse.volumes:type_name -> obiente.cloud.deployments.v1.VolumeInfo
+	77,  // 41: obiente.cloud.deployments.v1.GetContainerFileResponse.metadata:type_name -> obiente.cloud.deployments.v1.ContainerFile
+	82,  // 42: obiente.cloud.deployments.v1.UploadContainerFilesRequest.metadata:type_name -> obiente.cloud.deployments.v1.UploadContainerFilesMetadata
+	83,  // 43: obiente.cloud.deployments.v1.UploadContainerFilesMetadata.files:type_name -> obiente.cloud.deployments.v1.FileMetadata
+	180, // 44: obiente.cloud.deployments.v1.ChunkUploadContainerFilesRequest.upload:type_name -> obiente.cloud.common.v1.ChunkedUploadPayload
+	181, // 45: obiente.cloud.deployments.v1.ChunkUploadContainerFilesResponse.result:type_name -> obiente.cloud.common.v1.ChunkedUploadResponsePayload
+	88,  // 46: obiente.cloud.deployments.v1.DeleteContainerEntriesResponse.errors:type_name -> obiente.cloud.deployments.v1.DeleteContainerEntriesError
+	77,  // 47: obiente.cloud.deployments.v1.RenameContainerEntryResponse.entry:type_name -> obiente.cloud.deployments.v1.ContainerFile
+	6,   // 48: obiente.cloud.deployments.v1.CreateContainerEntryRequest.type:type_name -> obiente.cloud.deployments.v1.ContainerEntryType
+	77,  // 49: obiente.cloud.deployments.v1.CreateContainerEntryResponse.entry:type_name -> obiente.cloud.deployments.v1.ContainerFile
+	77,  // 50: obiente.cloud.deployments.v1.WriteContainerFileResponse.entry:type_name -> obiente.cloud.deployments.v1.ContainerFile
+	182, // 51: obiente.cloud.deployments.v1.CreateDeploymentFileArchiveRequest.archive_request:type_name -> obiente.cloud.common.v1.CreateServerFileArchiveRequest
+	183, // 52: obiente.cloud.deployments.v1.CreateDeploymentFileArchiveResponse.archive_response:type_name -> obiente.cloud.common.v1.CreateServerFileArchiveResponse
+	100, // 53: obiente.cloud.deployments.v1.GetDeploymentRoutingsResponse.rules:type_name -> obiente.cloud.deployments.v1.RoutingRule
+	100, // 54: obiente.cloud.deployments.v1.UpdateDeploymentRoutingsRequest.rules:type_name -> obiente.cloud.deployments.v1.RoutingRule
+	100, // 55: obiente.cloud.deployments.v1.UpdateDeploymentRoutingsResponse.rules:type_name -> obiente.cloud.deployments.v1.RoutingRule
+	178, // 56: obiente.cloud.deployments.v1.CustomDomain.certificate_expires_at:type_name -> google.protobuf.Timestamp
+	178, // 57: obiente.cloud.deployments.v1.CustomDomain.created_at:type_name -> google.protobuf.Timestamp
+	111, // 58: obiente.cloud.deployments.v1.CreateCustomDomainResponse.custom_domain:type_name -> obiente.cloud.deployments.v1.CustomDomain
+	111, // 59: obiente.cloud.deployments.v1.VerifyCustomDomainResponse.custom_domain:type_name -> obiente.cloud.deployments.v1.CustomDomain
+	147, // 60: obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	118, // 61: obiente.cloud.deployments.v1.SetDeploymentAffinityRulesRequest.rules:type_name -> obiente.cloud.deployments.v1.AffinityRule
+	118, // 62: obiente.cloud.deployments.v1.SetDeploymentAffinityRulesResponse.rules:type_name -> obiente.cloud.deployments.v1.AffinityRule
+	118, // 63: obiente.cloud.deployments.v1.GetDeploymentAffinityRulesResponse.rules:type_name -> obiente.cloud.deployments.v1.AffinityRule
+	178, // 64: obiente.cloud.deployments.v1.ScaleSchedule.next_run_at:type_name -> google.protobuf.Timestamp
+	178, // 65: obiente.cloud.deployments.v1.ScaleSchedule.last_run_at:type_name -> google.protobuf.Timestamp
+	178, // 66: obiente.cloud.deployments.v1.ScaleSchedule.created_at:type_name -> google.protobuf.Timestamp
+	123, // 67: obiente.cloud.deployments.v1.CreateScaleScheduleResponse.schedule:type_name -> obiente.cloud.deployments.v1.ScaleSchedule
+	123, // 68: obiente.cloud.deployments.v1.ListScaleSchedulesResponse.schedules:type_name -> obiente.cloud.deployments.v1.ScaleSchedule
+	178, // 69: obiente.cloud.deployments.v1.AutoScalePolicy.last_scaled_at:type_name -> google.protobuf.Timestamp
+	178, // 70: obiente.cloud.deployments.v1.AutoScalePolicy.updated_at:type_name -> google.protobuf.Timestamp
+	130, // 71: obiente.cloud.deployments.v1.SetAutoScalePolicyResponse.policy:type_name -> obiente.cloud.deployments.v1.AutoScalePolicy
+	130, // 72: obiente.cloud.deployments.v1.GetAutoScalePolicyResponse.policy:type_name -> obiente.cloud.deployments.v1.AutoScalePolicy
+	178, // 73: obiente.cloud.deployments.v1.DeploymentRegionStatus.updated_at:type_name -> google.protobuf.Timestamp
+	137, // 74: obiente.cloud.deployments.v1.GetDeploymentRegionStatusResponse.regions:type_name -> obiente.cloud.deployments.v1.DeploymentRegionStatus
+	178, // 75: obiente.cloud.deployments.v1.GetDeploymentMetricsRequest.start_time:type_name -> google.protobuf.Timestamp
+	178, // 76: obiente.cloud.deployments.v1.GetDeploymentMetricsRequest.end_time:type_name -> google.protobuf.Timestamp
+	143, // 77: obiente.cloud.deployments.v1.GetDeploymentMetricsResponse.metrics:type_name -> obiente.cloud.deployments.v1.DeploymentMetric
+	178, // 78: obiente.cloud.deployments.v1.DeploymentMetric.timestamp:type_name -> google.protobuf.Timestamp
+	146, // 79: obiente.cloud.deployments.v1.GetDeploymentUsageResponse.current:type_name -> obiente.cloud.deployments.v1.DeploymentUsageMetrics
+	146, // 80: obiente.cloud.deployments.v1.GetDeploymentUsageResponse.estimated_monthly:type_name -> obiente.cloud.deployments.v1.DeploymentUsageMetrics
+	0,   // 81: obiente.cloud.deployments.v1.Deployment.type:type_name -> obiente.cloud.deployments.v1.DeploymentType
+	1,   // 82: obiente.cloud.deployments.v1.Deployment.build_strategy:type_name -> obiente.cloud.deployments.v1.BuildStrategy
+	3,   // 83: obiente.cloud.deployments.v1.Deployment.status:type_name -> obiente.cloud.deployments.v1.DeploymentStatus
+	178, // 84: obiente.cloud.deployments.v1.Deployment.last_deployed_at:type_name -> google.protobuf.Timestamp
+	178, // 85: obiente.cloud.deployments.v1.Deployment.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 86: obiente.cloud.deployments.v1.Deployment.environment:type_name -> obiente.cloud.deployments.v1.Environment
+	174, // 87: obiente.cloud.deployments.v1.Deployment.env_vars:type_name -> obiente.cloud.deployments.v1.Deployment.EnvVarsEntry
+	5,   // 88: obiente.cloud.deployments.v1.Deployment.healthcheck_type:type_name -> obiente.cloud.deployments.v1.HealthCheckType
+	175, // 89: obiente.cloud.deployments.v1.Deployment.build_args:type_name -> obiente.cloud.deployments.v1.Deployment.BuildArgsEntry
+	148, // 90: obiente.cloud.deployments.v1.Deployment.dockerfile_volumes:type_name -> obiente.cloud.deployments.v1.DockerfileVolume
+	149, // 91: obiente.cloud.deployments.v1.Deployment.dockerfile_build_options:type_name -> obiente.cloud.deployments.v1.DockerfileBuildOptions
+	176, // 92: obiente.cloud.deployments.v1.DockerfileBuildOptions.labels:type_name -> obiente.cloud.deployments.v1.DockerfileBuildOptions.LabelsEntry
+	152, // 93: obiente.cloud.deployments.v1.ListDeploymentContainersResponse.containers:type_name -> obiente.cloud.deployments.v1.DeploymentContainer
+	178, // 94: obiente.cloud.deployments.v1.DeploymentContainer.created_at:type_name -> google.protobuf.Timestamp
+	178, // 95: obiente.cloud.deployments.v1.DeploymentContainer.updated_at:type_name -> google.protobuf.Timestamp
+	170, // 96: obiente.cloud.deployments.v1.ListBuildsResponse.builds:type_name -> obiente.cloud.deployments.v1.Build
+	170, // 97: obiente.cloud.deployments.v1.GetBuildResponse.build:type_name -> obiente.cloud.deployments.v1.Build
+	23,  // 98: obiente.cloud.deployments.v1.GetBuildLogsResponse.logs:type_name -> obiente.cloud.deployments.v1.DeploymentLogLine
+	147, // 99: obiente.cloud.deployments.v1.RevertToBuildResponse.deployment:type_name -> obiente.cloud.deployments.v1.Deployment
+	4,   // 100: obiente.cloud.deployments.v1.Build.status:type_name -> obiente.cloud.deployments.v1.BuildStatus
+	178, // 101: obiente.cloud.deployments.v1.Build.started_at:type_name -> google.protobuf.Timestamp
+	178, // 102: obiente.cloud.deployments.v1.Build.completed_at:type_name -> google.protobuf.Timestamp
+	1,   // 103: obiente.cloud.deployments.v1.Build.build_strategy:type_name -> obiente.cloud.deployments.v1.BuildStrategy
+	178, // 104: obiente.cloud.deployments.v1.Build.created_at:type_name -> google.protobuf.Timestamp
+	178, // 105: obiente.cloud.deployments.v1.Build.updated_at:type_name -> google.protobuf.Timestamp
+	171, // 106: obiente.cloud.deployments.v1.Build.lint_warnings:type_name -> obiente.cloud.deployments.v1.DockerfileLintWarning
+	7,   // 107: obiente.cloud.deployments.v1.DeploymentService.ListDeployments:input_type -> obiente.cloud.deployments.v1.ListDeploymentsRequest
+	9,   // 108: obiente.cloud.deployments.v1.DeploymentService.CreateDeployment:input_type -> obiente.cloud.deployments.v1.CreateDeploymentRequest
+	11,  // 109: obiente.cloud.deployments.v1.DeploymentService.GetDeployment:input_type -> obiente.cloud.deployments.v1.GetDeploymentRequest
+	13,  // 110: obiente.cloud.deployments.v1.DeploymentService.UpdateDeployment:input_type -> obiente.cloud.deployments.v1.UpdateDeploymentRequest
+	15,  // 111: obiente.cloud.deployments.v1.DeploymentService.TriggerDeployment:input_type -> obiente.cloud.deployments.v1.TriggerDeploymentRequest
+	17,  // 112: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentStatus:input_type -> obiente.cloud.deployments.v1.StreamDeploymentStatusRequest
+	19,  // 113: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentLogs:input_type -> obiente.cloud.deployments.v1.GetDeploymentLogsRequest
+	21,  // 114: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentLogs:input_type -> obiente.cloud.deployments.v1.StreamDeploymentLogsRequest
+	22,  // 115: obiente.cloud.deployments.v1.DeploymentService.StreamBuildLogs:input_type -> obiente.cloud.deployments.v1.StreamBuildLogsRequest
+	140, // 116: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentMetrics:input_type -> obiente.cloud.deployments.v1.GetDeploymentMetricsRequest
+	142, // 117: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentMetrics:input_type -> obiente.cloud.deployments.v1.StreamDeploymentMetricsRequest
+	144, // 118: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentUsage:input_type -> obiente.cloud.deployments.v1.GetDeploymentUsageRequest
+	24,  // 119: obiente.cloud.deployments.v1.DeploymentService.StartDeployment:input_type -> obiente.cloud.deployments.v1.StartDeploymentRequest
+	26,  // 120: obiente.cloud.deployments.v1.DeploymentService.StopDeployment:input_type -> obiente.cloud.deployments.v1.StopDeploymentRequest
+	28,  // 121: obiente.cloud.deployments.v1.DeploymentService.DeleteDeployment:input_type -> obiente.cloud.deployments.v1.DeleteDeploymentRequest
+	30,  // 122: obiente.cloud.deployments.v1.DeploymentService.RestartDeployment:input_type -> obiente.cloud.deployments.v1.RestartDeploymentRequest
+	32,  // 123: obiente.cloud.deployments.v1.DeploymentService.RollbackDeployment:input_type -> obiente.cloud.deployments.v1.RollbackDeploymentRequest
+	35,  // 124: obiente.cloud.deployments.v1.DeploymentService.ListDeploymentVersions:input_type -> obiente.cloud.deployments.v1.ListDeploymentVersionsRequest
+	37,  // 125: obiente.cloud.deployments.v1.DeploymentService.ScaleDeployment:input_type -> obiente.cloud.deployments.v1.ScaleDeploymentRequest
+	39,  // 126: obiente.cloud.deployments.v1.DeploymentService.SetResourceLimits:input_type -> obiente.cloud.deployments.v1.SetResourceLimitsRequest
+	41,  // 127: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentEnvVars:input_type -> obiente.cloud.deployments.v1.GetDeploymentEnvVarsRequest
+	43,  // 128: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentEnvVars:input_type -> obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsRequest
+	45,  // 129: obiente.cloud.deployments.v1.DeploymentService.RotateEnvKey:input_type -> obiente.cloud.deployments.v1.RotateEnvKeyRequest
+	47,  // 130: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentCompose:input_type -> obiente.cloud.deployments.v1.GetDeploymentComposeRequest
+	49,  // 131: obiente.cloud.deployments.v1.DeploymentService.ValidateDeploymentCompose:input_type -> obiente.cloud.deployments.v1.ValidateDeploymentComposeRequest
+	51,  // 132: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentCompose:input_type -> obiente.cloud.deployments.v1.UpdateDeploymentComposeRequest
+	54,  // 133: obiente.cloud.deployments.v1.DeploymentService.ListGitHubRepos:input_type -> obiente.cloud.deployments.v1.ListGitHubReposRequest
+	57,  // 134: obiente.cloud.deployments.v1.DeploymentService.GetGitHubBranches:input_type -> obiente.cloud.deployments.v1.GetGitHubBranchesRequest
+	60,  // 135: obiente.cloud.deployments.v1.DeploymentService.GetGitHubFile:input_type -> obiente.cloud.deployments.v1.GetGitHubFileRequest
+	160, // 136: obiente.cloud.deployments.v1.DeploymentService.ListBuilds:input_type -> obiente.cloud.deployments.v1.ListBuildsRequest
+	162, // 137: obiente.cloud.deployments.v1.DeploymentService.GetBuild:input_type -> obiente.cloud.deployments.v1.GetBuildRequest
+	164, // 138: obiente.cloud.deployments.v1.DeploymentService.GetBuildLogs:input_type -> obiente.cloud.deployments.v1.GetBuildLogsRequest
+	166, // 139: obiente.cloud.deployments.v1.DeploymentService.RevertToBuild:input_type -> obiente.cloud.deployments.v1.RevertToBuildRequest
+	168, // 140: obiente.cloud.deployments.v1.DeploymentService.DeleteBuild:input_type -> obiente.cloud.deployments.v1.DeleteBuildRequest
+	62,  // 141: obiente.cloud.deployments.v1.DeploymentService.ListAvailableGitHubIntegrations:input_type -> obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsRequest
+	65,  // 142: obiente.cloud.deployments.v1.DeploymentService.RotateDeployKey:input_type -> obiente.cloud.deployments.v1.RotateDeployKeyRequest
+	68,  // 143: obiente.cloud.deployments.v1.DeploymentService.RotateWebhookSecret:input_type -> obiente.cloud.deployments.v1.RotateWebhookSecretRequest
+	73,  // 144: obiente.cloud.deployments.v1.DeploymentService.StreamTerminal:input_type -> obiente.cloud.deployments.v1.TerminalInput
+	70,  // 145: obiente.cloud.deployments.v1.DeploymentService.StreamTerminalOutput:input_type -> obiente.cloud.deployments.v1.StreamTerminalOutputRequest
+	71,  // 146: obiente.cloud.deployments.v1.DeploymentService.SendTerminalInput:input_type -> obiente.cloud.deployments.v1.SendTerminalInputRequest
+	76,  // 147: obiente.cloud.deployments.v1.DeploymentService.ListContainerFiles:input_type -> obiente.cloud.deployments.v1.ListContainerFilesRequest
+	79,  // 148: obiente.cloud.deployments.v1.DeploymentService.GetContainerFile:input_type -> obiente.cloud.deployments.v1.GetContainerFileRequest
+	81,  // 149: obiente.cloud.deployments.v1.DeploymentService.UploadContainerFiles:input_type -> obiente.cloud.deployments.v1.UploadContainerFilesRequest
+	85,  // 150: obiente.cloud.deployments.v1.DeploymentService.ChunkUploadContainerFiles:input_type -> obiente.cloud.deployments.v1.ChunkUploadContainerFilesRequest
+	87,  // 151: obiente.cloud.deployments.v1.DeploymentService.DeleteContainerEntries:input_type -> obiente.cloud.deployments.v1.DeleteContainerEntriesRequest
+	90,  // 152: obiente.cloud.deployments.v1.DeploymentService.RenameContainerEntry:input_type -> obiente.cloud.deployments.v1.RenameContainerEntryRequest
+	92,  // 153: obiente.cloud.deployments.v1.DeploymentService.CreateContainerEntry:input_type -> obiente.cloud.deployments.v1.CreateContainerEntryRequest
+	94,  // 154: obiente.cloud.deployments.v1.DeploymentService.WriteContainerFile:input_type -> obiente.cloud.deployments.v1.WriteContainerFileRequest
+	96,  // 155: obiente.cloud.deployments.v1.DeploymentService.ExtractDeploymentFile:input_type -> obiente.cloud.deployments.v1.ExtractDeploymentFileRequest
+	98,  // 156: obiente.cloud.deployments.v1.DeploymentService.CreateDeploymentFileArchive:input_type -> obiente.cloud.deployments.v1.CreateDeploymentFileArchiveRequest
+	101, // 157: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentRoutings:input_type -> obiente.cloud.deployments.v1.GetDeploymentRoutingsRequest
+	103, // 158: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentRoutings:input_type -> obiente.cloud.deployments.v1.UpdateDeploymentRoutingsRequest
+	105, // 159: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentServiceNames:input_type -> obiente.cloud.deployments.v1.GetDeploymentServiceNamesRequest
+	107, // 160: obiente.cloud.deployments.v1.DeploymentService.GetDomainVerificationToken:input_type -> obiente.cloud.deployments.v1.GetDomainVerificationTokenRequest
+	109, // 161: obiente.cloud.deployments.v1.DeploymentService.VerifyDomainOwnership:input_type -> obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest
+	112, // 162: obiente.cloud.deployments.v1.DeploymentService.CreateCustomDomain:input_type -> obiente.cloud.deployments.v1.CreateCustomDomainRequest
+	114, // 163: obiente.cloud.deployments.v1.DeploymentService.VerifyCustomDomain:input_type -> obiente.cloud.deployments.v1.VerifyCustomDomainRequest
+	116, // 164: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentHealthCheck:input_type -> obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckRequest
+	119, // 165: obiente.cloud.deployments.v1.DeploymentService.SetDeploymentAffinityRules:input_type -> obiente.cloud.deployments.v1.SetDeploymentAffinityRulesRequest
+	121, // 166: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentAffinityRules:input_type -> obiente.cloud.deployments.v1.GetDeploymentAffinityRulesRequest
+	124, // 167: obiente.cloud.deployments.v1.DeploymentService.CreateScaleSchedule:input_type -> obiente.cloud.deployments.v1.CreateScaleScheduleRequest
+	126, // 168: obiente.cloud.deployments.v1.DeploymentService.DeleteScaleSchedule:input_type -> obiente.cloud.deployments.v1.DeleteScaleScheduleRequest
+	128, // 169: obiente.cloud.deployments.v1.DeploymentService.ListScaleSchedules:input_type -> obiente.cloud.deployments.v1.ListScaleSchedulesRequest
+	131, // 170: obiente.cloud.deployments.v1.DeploymentService.SetAutoScalePolicy:input_type -> obiente.cloud.deployments.v1.SetAutoScalePolicyRequest
+	133, // 171: obiente.cloud.deployments.v1.DeploymentService.GetAutoScalePolicy:input_type -> obiente.cloud.deployments.v1.GetAutoScalePolicyRequest
+	135, // 172: obiente.cloud.deployments.v1.DeploymentService.DisableAutoScale:input_type -> obiente.cloud.deployments.v1.DisableAutoScaleRequest
+	138, // 173: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentRegionStatus:input_type -> obiente.cloud.deployments.v1.GetDeploymentRegionStatusRequest
+	150, // 174: obiente.cloud.deployments.v1.DeploymentService.ListDeploymentContainers:input_type -> obiente.cloud.deployments.v1.ListDeploymentContainersRequest
+	153, // 175: obiente.cloud.deployments.v1.DeploymentService.StreamContainerLogs:input_type -> obiente.cloud.deployments.v1.StreamContainerLogsRequest
+	154, // 176: obiente.cloud.deployments.v1.DeploymentService.StartContainer:input_type -> obiente.cloud.deployments.v1.StartContainerRequest
+	156, // 177: obiente.cloud.deployments.v1.DeploymentService.StopContainer:input_type -> obiente.cloud.deployments.v1.StopContainerRequest
+	158, // 178: obiente.cloud.deployments.v1.DeploymentService.RestartContainer:input_type -> obiente.cloud.deployments.v1.RestartContainerRequest
+	8,   // 179: obiente.cloud.deployments.v1.DeploymentService.ListDeployments:output_type -> obiente.cloud.deployments.v1.ListDeploymentsResponse
+	10,  // 180: obiente.cloud.deployments.v1.DeploymentService.CreateDeployment:output_type -> obiente.cloud.deployments.v1.CreateDeploymentResponse
+	12,  // 181: obiente.cloud.deployments.v1.DeploymentService.GetDeployment:output_type -> obiente.cloud.deployments.v1.GetDeploymentResponse
+	14,  // 182: obiente.cloud.deployments.v1.DeploymentService.UpdateDeployment:output_type -> obiente.cloud.deployments.v1.UpdateDeploymentResponse
+	16,  // 183: obiente.cloud.deployments.v1.DeploymentService.TriggerDeployment:output_type -> obiente.cloud.deployments.v1.TriggerDeploymentResponse
+	18,  // 184: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentStatus:output_type -> obiente.cloud.deployments.v1.DeploymentStatusUpdate
+	20,  // 185: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentLogs:output_type -> obiente.cloud.deployments.v1.GetDeploymentLogsResponse
+	23,  // 186: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentLogs:output_type -> obiente.cloud.deployments.v1.DeploymentLogLine
+	23,  // 187: obiente.cloud.deployments.v1.DeploymentService.StreamBuildLogs:output_type -> obiente.cloud.deployments.v1.DeploymentLogLine
+	141, // 188: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentMetrics:output_type -> obiente.cloud.deployments.v1.GetDeploymentMetricsResponse
+	143, // 189: obiente.cloud.deployments.v1.DeploymentService.StreamDeploymentMetrics:output_type -> obiente.cloud.deployments.v1.DeploymentMetric
+	145, // 190: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentUsage:output_type -> obiente.cloud.deployments.v1.GetDeploymentUsageResponse
+	25,  // 191: obiente.cloud.deployments.v1.DeploymentService.StartDeployment:output_type -> obiente.cloud.deployments.v1.StartDeploymentResponse
+	27,  // 192: obiente.cloud.deployments.v1.DeploymentService.StopDeployment:output_type -> obiente.cloud.deployments.v1.StopDeploymentResponse
+	29,  // 193: obiente.cloud.deployments.v1.DeploymentService.DeleteDeployment:output_type -> obiente.cloud.deployments.v1.DeleteDeploymentResponse
+	31,  // 194: obiente.cloud.deployments.v1.DeploymentService.RestartDeployment:output_type -> obiente.cloud.deployments.v1.RestartDeploymentResponse
+	33,  // 195: obiente.cloud.deployments.v1.DeploymentService.RollbackDeployment:output_type -> obiente.cloud.deployments.v1.RollbackDeploymentResponse
+	36,  // 196: obiente.cloud.deployments.v1.DeploymentService.ListDeploymentVersions:output_type -> obiente.cloud.deployments.v1.ListDeploymentVersionsResponse
+	38,  // 197: obiente.cloud.deployments.v1.DeploymentService.ScaleDeployment:output_type -> obiente.cloud.deployments.v1.ScaleDeploymentResponse
+	40,  // 198: obiente.cloud.deployments.v1.DeploymentService.SetResourceLimits:output_type -> obiente.cloud.deployments.v1.SetResourceLimitsResponse
+	42,  // 199: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentEnvVars:output_type -> obiente.cloud.deployments.v1.GetDeploymentEnvVarsResponse
+	44,  // 200: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentEnvVars:output_type -> obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsResponse
+	46,  // 201: obiente.cloud.deployments.v1.DeploymentService.RotateEnvKey:output_type -> obiente.cloud.deployments.v1.RotateEnvKeyResponse
+	48,  // 202: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentCompose:output_type -> obiente.cloud.deployments.v1.GetDeploymentComposeResponse
+	50,  // 203: obiente.cloud.deployments.v1.DeploymentService.ValidateDeploymentCompose:output_type -> obiente.cloud.deployments.v1.ValidateDeploymentComposeResponse
+	52,  // 204: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentCompose:output_type -> obiente.cloud.deployments.v1.UpdateDeploymentComposeResponse
+	56,  // 205: obiente.cloud.deployments.v1.DeploymentService.ListGitHubRepos:output_type -> obiente.cloud.deployments.v1.ListGitHubReposResponse
+	59,  // 206: obiente.cloud.deployments.v1.DeploymentService.GetGitHubBranches:output_type -> obiente.cloud.deployments.v1.GetGitHubBranchesResponse
+	61,  // 207: obiente.cloud.deployments.v1.DeploymentService.GetGitHubFile:output_type -> obiente.cloud.deployments.v1.GetGitHubFileResponse
+	161, // 208: obiente.cloud.deployments.v1.DeploymentService.ListBuilds:output_type -> obiente.cloud.deployments.v1.ListBuildsResponse
+	163, // 209: obiente.cloud.deployments.v1.DeploymentService.GetBuild:output_type -> obiente.cloud.deployments.v1.GetBuildResponse
+	165, // 210: obiente.cloud.deployments.v1.DeploymentService.GetBuildLogs:output_type -> obiente.cloud.deployments.v1.GetBuildLogsResponse
+	167, // 211: obiente.cloud.deployments.v1.DeploymentService.RevertToBuild:output_type -> obiente.cloud.deployments.v1.RevertToBuildResponse
+	169, // 212: obiente.cloud.deployments.v1.DeploymentService.DeleteBuild:output_type -> obiente.cloud.deployments.v1.DeleteBuildResponse
+	64,  // 213: obiente.cloud.deployments.v1.DeploymentService.ListAvailableGitHubIntegrations:output_type -> obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsResponse
+	67,  // 214: obiente.cloud.deployments.v1.DeploymentService.RotateDeployKey:output_type -> obiente.cloud.deployments.v1.RotateDeployKeyResponse
+	69,  // 215: obiente.cloud.deployments.v1.DeploymentService.RotateWebhookSecret:output_type -> obiente.cloud.deployments.v1.RotateWebhookSecretResponse
+	74,  // 216: obiente.cloud.deployments.v1.DeploymentService.StreamTerminal:output_type -> obiente.cloud.deployments.v1.TerminalOutput
+	74,  // 217: obiente.cloud.deployments.v1.DeploymentService.StreamTerminalOutput:output_type -> obiente.cloud.deployments.v1.TerminalOutput
+	72,  // 218: obiente.cloud.deployments.v1.DeploymentService.SendTerminalInput:output_type -> obiente.cloud.deployments.v1.SendTerminalInputResponse
+	78,  // 219: obiente.cloud.deployments.v1.DeploymentService.ListContainerFiles:output_type -> obiente.cloud.deployments.v1.ListContainerFilesResponse
+	80,  // 220: obiente.cloud.deployments.v1.DeploymentService.GetContainerFile:output_type -> obiente.cloud.deployments.v1.GetContainerFileResponse
+	84,  // 221: obiente.cloud.deployments.v1.DeploymentService.UploadContainerFiles:output_type -> obiente.cloud.deployments.v1.UploadContainerFilesResponse
+	86,  // 222: obiente.cloud.deployments.v1.DeploymentService.ChunkUploadContainerFiles:output_type -> obiente.cloud.deployments.v1.ChunkUploadContainerFilesResponse
+	89,  // 223: obiente.cloud.deployments.v1.DeploymentService.DeleteContainerEntries:output_type -> obiente.cloud.deployments.v1.DeleteContainerEntriesResponse
+	91,  // 224: obiente.cloud.deployments.v1.DeploymentService.RenameContainerEntry:output_type -> obiente.cloud.deployments.v1.RenameContainerEntryResponse
+	93,  // 225: obiente.cloud.deployments.v1.DeploymentService.CreateContainerEntry:output_type -> obiente.cloud.deployments.v1.CreateContainerEntryResponse
+	95,  // 226: obiente.cloud.deployments.v1.DeploymentService.WriteContainerFile:output_type -> obiente.cloud.deployments.v1.WriteContainerFileResponse
+	97,  // 227: obiente.cloud.deployments.v1.DeploymentService.ExtractDeploymentFile:output_type -> obiente.cloud.deployments.v1.ExtractDeploymentFileResponse
+	99,  // 228: obiente.cloud.deployments.v1.DeploymentService.CreateDeploymentFileArchive:output_type -> obiente.cloud.deployments.v1.CreateDeploymentFileArchiveResponse
+	102, // 229: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentRoutings:output_type -> obiente.cloud.deployments.v1.GetDeploymentRoutingsResponse
+	104, // 230: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentRoutings:output_type -> obiente.cloud.deployments.v1.UpdateDeploymentRoutingsResponse
+	106, // 231: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentServiceNames:output_type -> obiente.cloud.deployments.v1.GetDeploymentServiceNamesResponse
+	108, // 232: obiente.cloud.deployments.v1.DeploymentService.GetDomainVerificationToken:output_type -> obiente.cloud.deployments.v1.GetDomainVerificationTokenResponse
+	110, // 233: obiente.cloud.deployments.v1.DeploymentService.VerifyDomainOwnership:output_type -> obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse
+	113, // 234: obiente.cloud.deployments.v1.DeploymentService.CreateCustomDomain:output_type -> obiente.cloud.deployments.v1.CreateCustomDomainResponse
+	115, // 235: obiente.cloud.deployments.v1.DeploymentService.VerifyCustomDomain:output_type -> obiente.cloud.deployments.v1.VerifyCustomDomainResponse
+	117, // 236: obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentHealthCheck:output_type -> obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckResponse
+	120, // 237: obiente.cloud.deployments.v1.DeploymentService.SetDeploymentAffinityRules:output_type -> obiente.cloud.deployments.v1.SetDeploymentAffinityRulesResponse
+	122, // 238: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentAffinityRules:output_type -> obiente.cloud.deployments.v1.GetDeploymentAffinityRulesResponse
+	125, // 239: obiente.cloud.deployments.v1.DeploymentService.CreateScaleSchedule:output_type -> obiente.cloud.deployments.v1.CreateScaleScheduleResponse
+	127, // 240: obiente.cloud.deployments.v1.DeploymentService.DeleteScaleSchedule:output_type -> obiente.cloud.deployments.v1.DeleteScaleScheduleResponse
+	129, // 241: obiente.cloud.deployments.v1.DeploymentService.ListScaleSchedules:output_type -> obiente.cloud.deployments.v1.ListScaleSchedulesResponse
+	132, // 242: obiente.cloud.deployments.v1.DeploymentService.SetAutoScalePolicy:output_type -> obiente.cloud.deployments.v1.SetAutoScalePolicyResponse
+	134, // 243: obiente.cloud.deployments.v1.DeploymentService.GetAutoScalePolicy:output_type -> obiente.cloud.deployments.v1.GetAutoScalePolicyResponse
+	136, // 244: obiente.cloud.deployments.v1.DeploymentService.DisableAutoScale:output_type -> obiente.cloud.deployments.v1.DisableAutoScaleResponse
+	139, // 245: obiente.cloud.deployments.v1.DeploymentService.GetDeploymentRegionStatus:output_type -> obiente.cloud.deployments.v1.GetDeploymentRegionStatusResponse
+	151, // 246: obiente.cloud.deployments.v1.DeploymentService.ListDeploymentContainers:output_type -> obiente.cloud.deployments.v1.ListDeploymentContainersResponse
+	23,  // 247: obiente.cloud.deployments.v1.DeploymentService.StreamContainerLogs:output_type -> obiente.cloud.deployments.v1.DeploymentLogLine
+	155, // 248: obiente.cloud.deployments.v1.DeploymentService.StartContainer:output_type -> obiente.cloud.deployments.v1.StartContainerResponse
+	157, // 249: obiente.cloud.deployments.v1.DeploymentService.StopContainer:output_type -> obiente.cloud.deployments.v1.StopContainerResponse
+	159, // 250: obiente.cloud.deployments.v1.DeploymentService.RestartContainer:output_type -> obiente.cloud.deployments.v1.RestartContainerResponse
+	179, // [179:251] is the sub-list for method output_type
+	107, // [107:179] is the sub-list for method input_type
+	107, // [107:107] is the sub-list for extension type_name
+	107, // [107:107] is the sub-list for extension extendee
+	0,   // [0:107] is the sub-list for field type_name
 }
 
 func init() { file_obiente_cloud_deployments_v1_deployment_service_proto_init() }
@@ -10384,51 +13472,60 @@ func file_obiente_cloud_deployments_v1_deployment_service_proto_init() {
 		return
 	}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[2].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[6].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[11].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[12].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[14].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[34].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[36].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[55].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[56].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[57].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[58].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[59].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[61].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[63].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[64].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[66].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[26].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[45].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[69].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[70].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[71].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[72].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[73].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[74].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[75].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[76].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[77].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[78].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[80].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[83].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[84].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[85].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[87].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[88].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[89].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[90].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[92].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[93].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[94].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[96].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[97].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[99].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[102].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[105].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[107].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[91].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[103].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[104].OneofWrappers = []any{}
 	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[109].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[110].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[114].OneofWrappers = []any{}
-	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[120].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[116].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[123].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[127].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[130].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[133].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[135].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[136].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[137].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[139].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[140].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[142].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[145].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[148].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[150].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[152].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[153].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[157].OneofWrappers = []any{}
+	file_obiente_cloud_deployments_v1_deployment_service_proto_msgTypes[163].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc), len(file_obiente_cloud_deployments_v1_deployment_service_proto_rawDesc)),
 			NumEnums:      7,
-			NumMessages:   125,
+			NumMessages:   170,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
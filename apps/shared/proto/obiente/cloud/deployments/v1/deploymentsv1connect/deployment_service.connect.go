@@ -81,15 +81,27 @@ const (
 	// DeploymentServiceRestartDeploymentProcedure is the fully-qualified name of the
 	// DeploymentService's RestartDeployment RPC.
 	DeploymentServiceRestartDeploymentProcedure = "/obiente.cloud.deployments.v1.DeploymentService/RestartDeployment"
+	// DeploymentServiceRollbackDeploymentProcedure is the fully-qualified name of the
+	// DeploymentService's RollbackDeployment RPC.
+	DeploymentServiceRollbackDeploymentProcedure = "/obiente.cloud.deployments.v1.DeploymentService/RollbackDeployment"
+	// DeploymentServiceListDeploymentVersionsProcedure is the fully-qualified name of the
+	// DeploymentService's ListDeploymentVersions RPC.
+	DeploymentServiceListDeploymentVersionsProcedure = "/obiente.cloud.deployments.v1.DeploymentService/ListDeploymentVersions"
 	// DeploymentServiceScaleDeploymentProcedure is the fully-qualified name of the DeploymentService's
 	// ScaleDeployment RPC.
 	DeploymentServiceScaleDeploymentProcedure = "/obiente.cloud.deployments.v1.DeploymentService/ScaleDeployment"
+	// DeploymentServiceSetResourceLimitsProcedure is the fully-qualified name of the
+	// DeploymentService's SetResourceLimits RPC.
+	DeploymentServiceSetResourceLimitsProcedure = "/obiente.cloud.deployments.v1.DeploymentService/SetResourceLimits"
 	// DeploymentServiceGetDeploymentEnvVarsProcedure is the fully-qualified name of the
 	// DeploymentService's GetDeploymentEnvVars RPC.
 	DeploymentServiceGetDeploymentEnvVarsProcedure = "/obiente.cloud.deployments.v1.DeploymentService/GetDeploymentEnvVars"
 	// DeploymentServiceUpdateDeploymentEnvVarsProcedure is the fully-qualified name of the
 	// DeploymentService's UpdateDeploymentEnvVars RPC.
 	DeploymentServiceUpdateDeploymentEnvVarsProcedure = "/obiente.cloud.deployments.v1.DeploymentService/UpdateDeploymentEnvVars"
+	// DeploymentServiceRotateEnvKeyProcedure is the fully-qualified name of the DeploymentService's
+	// RotateEnvKey RPC.
+	DeploymentServiceRotateEnvKeyProcedure = "/obiente.cloud.deployments.v1.DeploymentService/RotateEnvKey"
 	// DeploymentServiceGetDeploymentComposeProcedure is the fully-qualified name of the
 	// DeploymentService's GetDeploymentCompose RPC.
 	DeploymentServiceGetDeploymentComposeProcedure = "/obiente.cloud.deployments.v1.DeploymentService/GetDeploymentCompose"
@@ -126,6 +138,12 @@ const (
 	// DeploymentServiceListAvailableGitHubIntegrationsProcedure is the fully-qualified name of the
 	// DeploymentService's ListAvailableGitHubIntegrations RPC.
 	DeploymentServiceListAvailableGitHubIntegrationsProcedure = "/obiente.cloud.deployments.v1.DeploymentService/ListAvailableGitHubIntegrations"
+	// DeploymentServiceRotateDeployKeyProcedure is the fully-qualified name of the DeploymentService's
+	// RotateDeployKey RPC.
+	DeploymentServiceRotateDeployKeyProcedure = "/obiente.cloud.deployments.v1.DeploymentService/RotateDeployKey"
+	// DeploymentServiceRotateWebhookSecretProcedure is the fully-qualified name of the
+	// DeploymentService's RotateWebhookSecret RPC.
+	DeploymentServiceRotateWebhookSecretProcedure = "/obiente.cloud.deployments.v1.DeploymentService/RotateWebhookSecret"
 	// DeploymentServiceStreamTerminalProcedure is the fully-qualified name of the DeploymentService's
 	// StreamTerminal RPC.
 	DeploymentServiceStreamTerminalProcedure = "/obiente.cloud.deployments.v1.DeploymentService/StreamTerminal"
@@ -180,6 +198,42 @@ const (
 	// DeploymentServiceVerifyDomainOwnershipProcedure is the fully-qualified name of the
 	// DeploymentService's VerifyDomainOwnership RPC.
 	DeploymentServiceVerifyDomainOwnershipProcedure = "/obiente.cloud.deployments.v1.DeploymentService/VerifyDomainOwnership"
+	// DeploymentServiceCreateCustomDomainProcedure is the fully-qualified name of the
+	// DeploymentService's CreateCustomDomain RPC.
+	DeploymentServiceCreateCustomDomainProcedure = "/obiente.cloud.deployments.v1.DeploymentService/CreateCustomDomain"
+	// DeploymentServiceVerifyCustomDomainProcedure is the fully-qualified name of the
+	// DeploymentService's VerifyCustomDomain RPC.
+	DeploymentServiceVerifyCustomDomainProcedure = "/obiente.cloud.deployments.v1.DeploymentService/VerifyCustomDomain"
+	// DeploymentServiceUpdateDeploymentHealthCheckProcedure is the fully-qualified name of the
+	// DeploymentService's UpdateDeploymentHealthCheck RPC.
+	DeploymentServiceUpdateDeploymentHealthCheckProcedure = "/obiente.cloud.deployments.v1.DeploymentService/UpdateDeploymentHealthCheck"
+	// DeploymentServiceSetDeploymentAffinityRulesProcedure is the fully-qualified name of the
+	// DeploymentService's SetDeploymentAffinityRules RPC.
+	DeploymentServiceSetDeploymentAffinityRulesProcedure = "/obiente.cloud.deployments.v1.DeploymentService/SetDeploymentAffinityRules"
+	// DeploymentServiceGetDeploymentAffinityRulesProcedure is the fully-qualified name of the
+	// DeploymentService's GetDeploymentAffinityRules RPC.
+	DeploymentServiceGetDeploymentAffinityRulesProcedure = "/obiente.cloud.deployments.v1.DeploymentService/GetDeploymentAffinityRules"
+	// DeploymentServiceCreateScaleScheduleProcedure is the fully-qualified name of the
+	// DeploymentService's CreateScaleSchedule RPC.
+	DeploymentServiceCreateScaleScheduleProcedure = "/obiente.cloud.deployments.v1.DeploymentService/CreateScaleSchedule"
+	// DeploymentServiceDeleteScaleScheduleProcedure is the fully-qualified name of the
+	// DeploymentService's DeleteScaleSchedule RPC.
+	DeploymentServiceDeleteScaleScheduleProcedure = "/obiente.cloud.deployments.v1.DeploymentService/DeleteScaleSchedule"
+	// DeploymentServiceListScaleSchedulesProcedure is the fully-qualified name of the
+	// DeploymentService's ListScaleSchedules RPC.
+	DeploymentServiceListScaleSchedulesProcedure = "/obiente.cloud.deployments.v1.DeploymentService/ListScaleSchedules"
+	// DeploymentServiceSetAutoScalePolicyProcedure is the fully-qualified name of the
+	// DeploymentService's SetAutoScalePolicy RPC.
+	DeploymentServiceSetAutoScalePolicyProcedure = "/obiente.cloud.deployments.v1.DeploymentService/SetAutoScalePolicy"
+	// DeploymentServiceGetAutoScalePolicyProcedure is the fully-qualified name of the
+	// DeploymentService's GetAutoScalePolicy RPC.
+	DeploymentServiceGetAutoScalePolicyProcedure = "/obiente.cloud.deployments.v1.DeploymentService/GetAutoScalePolicy"
+	// DeploymentServiceDisableAutoScaleProcedure is the fully-qualified name of the DeploymentService's
+	// DisableAutoScale RPC.
+	DeploymentServiceDisableAutoScaleProcedure = "/obiente.cloud.deployments.v1.DeploymentService/DisableAutoScale"
+	// DeploymentServiceGetDeploymentRegionStatusProcedure is the fully-qualified name of the
+	// DeploymentService's GetDeploymentRegionStatus RPC.
+	DeploymentServiceGetDeploymentRegionStatusProcedure = "/obiente.cloud.deployments.v1.DeploymentService/GetDeploymentRegionStatus"
 	// DeploymentServiceListDeploymentContainersProcedure is the fully-qualified name of the
 	// DeploymentService's ListDeploymentContainers RPC.
 	DeploymentServiceListDeploymentContainersProcedure = "/obiente.cloud.deployments.v1.DeploymentService/ListDeploymentContainers"
@@ -232,12 +286,21 @@ type DeploymentServiceClient interface {
 	DeleteDeployment(context.Context, *connect.Request[v1.DeleteDeploymentRequest]) (*connect.Response[v1.DeleteDeploymentResponse], error)
 	// Restart a deployment
 	RestartDeployment(context.Context, *connect.Request[v1.RestartDeploymentRequest]) (*connect.Response[v1.RestartDeploymentResponse], error)
+	// Roll back to a previous deployment version, or roll back an in-progress blue-green deployment
+	// (keeping the active containers running) when no version is given
+	RollbackDeployment(context.Context, *connect.Request[v1.RollbackDeploymentRequest]) (*connect.Response[v1.RollbackDeploymentResponse], error)
+	// List the retained versions of a deployment that it can be rolled back to
+	ListDeploymentVersions(context.Context, *connect.Request[v1.ListDeploymentVersionsRequest]) (*connect.Response[v1.ListDeploymentVersionsResponse], error)
 	// Scale a deployment
 	ScaleDeployment(context.Context, *connect.Request[v1.ScaleDeploymentRequest]) (*connect.Response[v1.ScaleDeploymentResponse], error)
+	// Set the CPU and memory limits of a deployment's containers; running containers are updated in place
+	SetResourceLimits(context.Context, *connect.Request[v1.SetResourceLimitsRequest]) (*connect.Response[v1.SetResourceLimitsResponse], error)
 	// Get deployment environment variables
 	GetDeploymentEnvVars(context.Context, *connect.Request[v1.GetDeploymentEnvVarsRequest]) (*connect.Response[v1.GetDeploymentEnvVarsResponse], error)
 	// Update deployment environment variables
 	UpdateDeploymentEnvVars(context.Context, *connect.Request[v1.UpdateDeploymentEnvVarsRequest]) (*connect.Response[v1.UpdateDeploymentEnvVarsResponse], error)
+	// Rotate the organization's environment variable encryption key and re-encrypt its deployments
+	RotateEnvKey(context.Context, *connect.Request[v1.RotateEnvKeyRequest]) (*connect.Response[v1.RotateEnvKeyResponse], error)
 	// Get deployment docker compose configuration
 	GetDeploymentCompose(context.Context, *connect.Request[v1.GetDeploymentComposeRequest]) (*connect.Response[v1.GetDeploymentComposeResponse], error)
 	// Validate deployment docker compose configuration (validation only, no save)
@@ -264,6 +327,10 @@ type DeploymentServiceClient interface {
 	DeleteBuild(context.Context, *connect.Request[v1.DeleteBuildRequest]) (*connect.Response[v1.DeleteBuildResponse], error)
 	// List all available GitHub integrations for the current user
 	ListAvailableGitHubIntegrations(context.Context, *connect.Request[v1.ListAvailableGitHubIntegrationsRequest]) (*connect.Response[v1.ListAvailableGitHubIntegrationsResponse], error)
+	// Replace the SSH deploy keys of the repositories deployed through a GitHub integration
+	RotateDeployKey(context.Context, *connect.Request[v1.RotateDeployKeyRequest]) (*connect.Response[v1.RotateDeployKeyResponse], error)
+	// Replace the HMAC secrets of the repository webhooks that deliver to Obiente for a GitHub integration
+	RotateWebhookSecret(context.Context, *connect.Request[v1.RotateWebhookSecretRequest]) (*connect.Response[v1.RotateWebhookSecretResponse], error)
 	// Terminal access
 	// Stream terminal with bidirectional streaming (replaces StreamTerminalOutput + SendTerminalInput)
 	// Use this for better input/output synchronization
@@ -308,6 +375,30 @@ type DeploymentServiceClient interface {
 	GetDomainVerificationToken(context.Context, *connect.Request[v1.GetDomainVerificationTokenRequest]) (*connect.Response[v1.GetDomainVerificationTokenResponse], error)
 	// Verify domain ownership via DNS TXT record
 	VerifyDomainOwnership(context.Context, *connect.Request[v1.VerifyDomainOwnershipRequest]) (*connect.Response[v1.VerifyDomainOwnershipResponse], error)
+	// Map a custom domain to a deployment and start an ACME DNS-01 challenge for its certificate
+	CreateCustomDomain(context.Context, *connect.Request[v1.CreateCustomDomainRequest]) (*connect.Response[v1.CreateCustomDomainResponse], error)
+	// Poll a custom domain: once the challenge TXT record is published, issue the certificate and route the domain
+	VerifyCustomDomain(context.Context, *connect.Request[v1.VerifyCustomDomainRequest]) (*connect.Response[v1.VerifyCustomDomainResponse], error)
+	// Configure the HTTP health check the health monitor probes; unhealthy deployments are redeployed
+	UpdateDeploymentHealthCheck(context.Context, *connect.Request[v1.UpdateDeploymentHealthCheckRequest]) (*connect.Response[v1.UpdateDeploymentHealthCheckResponse], error)
+	// Replace the affinity rules used to place the deployment relative to the organization's labelled deployments
+	SetDeploymentAffinityRules(context.Context, *connect.Request[v1.SetDeploymentAffinityRulesRequest]) (*connect.Response[v1.SetDeploymentAffinityRulesResponse], error)
+	// Get the affinity rules of a deployment
+	GetDeploymentAffinityRules(context.Context, *connect.Request[v1.GetDeploymentAffinityRulesRequest]) (*connect.Response[v1.GetDeploymentAffinityRulesResponse], error)
+	// Scale a deployment to a replica count each time a cron schedule matches
+	CreateScaleSchedule(context.Context, *connect.Request[v1.CreateScaleScheduleRequest]) (*connect.Response[v1.CreateScaleScheduleResponse], error)
+	// Delete a scale schedule of a deployment
+	DeleteScaleSchedule(context.Context, *connect.Request[v1.DeleteScaleScheduleRequest]) (*connect.Response[v1.DeleteScaleScheduleResponse], error)
+	// List the scale schedules of a deployment
+	ListScaleSchedules(context.Context, *connect.Request[v1.ListScaleSchedulesRequest]) (*connect.Response[v1.ListScaleSchedulesResponse], error)
+	// Scale a deployment between a minimum and maximum replica count to hold a target CPU utilization
+	SetAutoScalePolicy(context.Context, *connect.Request[v1.SetAutoScalePolicyRequest]) (*connect.Response[v1.SetAutoScalePolicyResponse], error)
+	// Get the auto-scaling policy of a deployment
+	GetAutoScalePolicy(context.Context, *connect.Request[v1.GetAutoScalePolicyRequest]) (*connect.Response[v1.GetAutoScalePolicyResponse], error)
+	// Stop auto-scaling a deployment; it keeps its current replica count
+	DisableAutoScale(context.Context, *connect.Request[v1.DisableAutoScaleRequest]) (*connect.Response[v1.DisableAutoScaleResponse], error)
+	// Get the provisioning status and health of a multi-region deployment in each of its regions
+	GetDeploymentRegionStatus(context.Context, *connect.Request[v1.GetDeploymentRegionStatusRequest]) (*connect.Response[v1.GetDeploymentRegionStatusResponse], error)
 	// List all containers for a deployment
 	ListDeploymentContainers(context.Context, *connect.Request[v1.ListDeploymentContainersRequest]) (*connect.Response[v1.ListDeploymentContainersResponse], error)
 	// Stream logs from a specific container
@@ -428,12 +519,30 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(deploymentServiceMethods.ByName("RestartDeployment")),
 			connect.WithClientOptions(opts...),
 		),
+		rollbackDeployment: connect.NewClient[v1.RollbackDeploymentRequest, v1.RollbackDeploymentResponse](
+			httpClient,
+			baseURL+DeploymentServiceRollbackDeploymentProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("RollbackDeployment")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeploymentVersions: connect.NewClient[v1.ListDeploymentVersionsRequest, v1.ListDeploymentVersionsResponse](
+			httpClient,
+			baseURL+DeploymentServiceListDeploymentVersionsProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListDeploymentVersions")),
+			connect.WithClientOptions(opts...),
+		),
 		scaleDeployment: connect.NewClient[v1.ScaleDeploymentRequest, v1.ScaleDeploymentResponse](
 			httpClient,
 			baseURL+DeploymentServiceScaleDeploymentProcedure,
 			connect.WithSchema(deploymentServiceMethods.ByName("ScaleDeployment")),
 			connect.WithClientOptions(opts...),
 		),
+		setResourceLimits: connect.NewClient[v1.SetResourceLimitsRequest, v1.SetResourceLimitsResponse](
+			httpClient,
+			baseURL+DeploymentServiceSetResourceLimitsProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("SetResourceLimits")),
+			connect.WithClientOptions(opts...),
+		),
 		getDeploymentEnvVars: connect.NewClient[v1.GetDeploymentEnvVarsRequest, v1.GetDeploymentEnvVarsResponse](
 			httpClient,
 			baseURL+DeploymentServiceGetDeploymentEnvVarsProcedure,
@@ -446,6 +555,12 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(deploymentServiceMethods.ByName("UpdateDeploymentEnvVars")),
 			connect.WithClientOptions(opts...),
 		),
+		rotateEnvKey: connect.NewClient[v1.RotateEnvKeyRequest, v1.RotateEnvKeyResponse](
+			httpClient,
+			baseURL+DeploymentServiceRotateEnvKeyProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("RotateEnvKey")),
+			connect.WithClientOptions(opts...),
+		),
 		getDeploymentCompose: connect.NewClient[v1.GetDeploymentComposeRequest, v1.GetDeploymentComposeResponse](
 			httpClient,
 			baseURL+DeploymentServiceGetDeploymentComposeProcedure,
@@ -518,6 +633,18 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(deploymentServiceMethods.ByName("ListAvailableGitHubIntegrations")),
 			connect.WithClientOptions(opts...),
 		),
+		rotateDeployKey: connect.NewClient[v1.RotateDeployKeyRequest, v1.RotateDeployKeyResponse](
+			httpClient,
+			baseURL+DeploymentServiceRotateDeployKeyProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("RotateDeployKey")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateWebhookSecret: connect.NewClient[v1.RotateWebhookSecretRequest, v1.RotateWebhookSecretResponse](
+			httpClient,
+			baseURL+DeploymentServiceRotateWebhookSecretProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("RotateWebhookSecret")),
+			connect.WithClientOptions(opts...),
+		),
 		streamTerminal: connect.NewClient[v1.TerminalInput, v1.TerminalOutput](
 			httpClient,
 			baseURL+DeploymentServiceStreamTerminalProcedure,
@@ -626,6 +753,78 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(deploymentServiceMethods.ByName("VerifyDomainOwnership")),
 			connect.WithClientOptions(opts...),
 		),
+		createCustomDomain: connect.NewClient[v1.CreateCustomDomainRequest, v1.CreateCustomDomainResponse](
+			httpClient,
+			baseURL+DeploymentServiceCreateCustomDomainProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("CreateCustomDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyCustomDomain: connect.NewClient[v1.VerifyCustomDomainRequest, v1.VerifyCustomDomainResponse](
+			httpClient,
+			baseURL+DeploymentServiceVerifyCustomDomainProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("VerifyCustomDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		updateDeploymentHealthCheck: connect.NewClient[v1.UpdateDeploymentHealthCheckRequest, v1.UpdateDeploymentHealthCheckResponse](
+			httpClient,
+			baseURL+DeploymentServiceUpdateDeploymentHealthCheckProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("UpdateDeploymentHealthCheck")),
+			connect.WithClientOptions(opts...),
+		),
+		setDeploymentAffinityRules: connect.NewClient[v1.SetDeploymentAffinityRulesRequest, v1.SetDeploymentAffinityRulesResponse](
+			httpClient,
+			baseURL+DeploymentServiceSetDeploymentAffinityRulesProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("SetDeploymentAffinityRules")),
+			connect.WithClientOptions(opts...),
+		),
+		getDeploymentAffinityRules: connect.NewClient[v1.GetDeploymentAffinityRulesRequest, v1.GetDeploymentAffinityRulesResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetDeploymentAffinityRulesProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentAffinityRules")),
+			connect.WithClientOptions(opts...),
+		),
+		createScaleSchedule: connect.NewClient[v1.CreateScaleScheduleRequest, v1.CreateScaleScheduleResponse](
+			httpClient,
+			baseURL+DeploymentServiceCreateScaleScheduleProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("CreateScaleSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteScaleSchedule: connect.NewClient[v1.DeleteScaleScheduleRequest, v1.DeleteScaleScheduleResponse](
+			httpClient,
+			baseURL+DeploymentServiceDeleteScaleScheduleProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("DeleteScaleSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		listScaleSchedules: connect.NewClient[v1.ListScaleSchedulesRequest, v1.ListScaleSchedulesResponse](
+			httpClient,
+			baseURL+DeploymentServiceListScaleSchedulesProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("ListScaleSchedules")),
+			connect.WithClientOptions(opts...),
+		),
+		setAutoScalePolicy: connect.NewClient[v1.SetAutoScalePolicyRequest, v1.SetAutoScalePolicyResponse](
+			httpClient,
+			baseURL+DeploymentServiceSetAutoScalePolicyProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("SetAutoScalePolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		getAutoScalePolicy: connect.NewClient[v1.GetAutoScalePolicyRequest, v1.GetAutoScalePolicyResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetAutoScalePolicyProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetAutoScalePolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		disableAutoScale: connect.NewClient[v1.DisableAutoScaleRequest, v1.DisableAutoScaleResponse](
+			httpClient,
+			baseURL+DeploymentServiceDisableAutoScaleProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("DisableAutoScale")),
+			connect.WithClientOptions(opts...),
+		),
+		getDeploymentRegionStatus: connect.NewClient[v1.GetDeploymentRegionStatusRequest, v1.GetDeploymentRegionStatusResponse](
+			httpClient,
+			baseURL+DeploymentServiceGetDeploymentRegionStatusProcedure,
+			connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentRegionStatus")),
+			connect.WithClientOptions(opts...),
+		),
 		listDeploymentContainers: connect.NewClient[v1.ListDeploymentContainersRequest, v1.ListDeploymentContainersResponse](
 			httpClient,
 			baseURL+DeploymentServiceListDeploymentContainersProcedure,
@@ -677,9 +876,13 @@ type deploymentServiceClient struct {
 	stopDeployment                  *connect.Client[v1.StopDeploymentRequest, v1.StopDeploymentResponse]
 	deleteDeployment                *connect.Client[v1.DeleteDeploymentRequest, v1.DeleteDeploymentResponse]
 	restartDeployment               *connect.Client[v1.RestartDeploymentRequest, v1.RestartDeploymentResponse]
+	rollbackDeployment              *connect.Client[v1.RollbackDeploymentRequest, v1.RollbackDeploymentResponse]
+	listDeploymentVersions          *connect.Client[v1.ListDeploymentVersionsRequest, v1.ListDeploymentVersionsResponse]
 	scaleDeployment                 *connect.Client[v1.ScaleDeploymentRequest, v1.ScaleDeploymentResponse]
+	setResourceLimits               *connect.Client[v1.SetResourceLimitsRequest, v1.SetResourceLimitsResponse]
 	getDeploymentEnvVars            *connect.Client[v1.GetDeploymentEnvVarsRequest, v1.GetDeploymentEnvVarsResponse]
 	updateDeploymentEnvVars         *connect.Client[v1.UpdateDeploymentEnvVarsRequest, v1.UpdateDeploymentEnvVarsResponse]
+	rotateEnvKey                    *connect.Client[v1.RotateEnvKeyRequest, v1.RotateEnvKeyResponse]
 	getDeploymentCompose            *connect.Client[v1.GetDeploymentComposeRequest, v1.GetDeploymentComposeResponse]
 	validateDeploymentCompose       *connect.Client[v1.ValidateDeploymentComposeRequest, v1.ValidateDeploymentComposeResponse]
 	updateDeploymentCompose         *connect.Client[v1.UpdateDeploymentComposeRequest, v1.UpdateDeploymentComposeResponse]
@@ -692,6 +895,8 @@ type deploymentServiceClient struct {
 	revertToBuild                   *connect.Client[v1.RevertToBuildRequest, v1.RevertToBuildResponse]
 	deleteBuild                     *connect.Client[v1.DeleteBuildRequest, v1.DeleteBuildResponse]
 	listAvailableGitHubIntegrations *connect.Client[v1.ListAvailableGitHubIntegrationsRequest, v1.ListAvailableGitHubIntegrationsResponse]
+	rotateDeployKey                 *connect.Client[v1.RotateDeployKeyRequest, v1.RotateDeployKeyResponse]
+	rotateWebhookSecret             *connect.Client[v1.RotateWebhookSecretRequest, v1.RotateWebhookSecretResponse]
 	streamTerminal                  *connect.Client[v1.TerminalInput, v1.TerminalOutput]
 	streamTerminalOutput            *connect.Client[v1.StreamTerminalOutputRequest, v1.TerminalOutput]
 	sendTerminalInput               *connect.Client[v1.SendTerminalInputRequest, v1.SendTerminalInputResponse]
@@ -710,6 +915,18 @@ type deploymentServiceClient struct {
 	getDeploymentServiceNames       *connect.Client[v1.GetDeploymentServiceNamesRequest, v1.GetDeploymentServiceNamesResponse]
 	getDomainVerificationToken      *connect.Client[v1.GetDomainVerificationTokenRequest, v1.GetDomainVerificationTokenResponse]
 	verifyDomainOwnership           *connect.Client[v1.VerifyDomainOwnershipRequest, v1.VerifyDomainOwnershipResponse]
+	createCustomDomain              *connect.Client[v1.CreateCustomDomainRequest, v1.CreateCustomDomainResponse]
+	verifyCustomDomain              *connect.Client[v1.VerifyCustomDomainRequest, v1.VerifyCustomDomainResponse]
+	updateDeploymentHealthCheck     *connect.Client[v1.UpdateDeploymentHealthCheckRequest, v1.UpdateDeploymentHealthCheckResponse]
+	setDeploymentAffinityRules      *connect.Client[v1.SetDeploymentAffinityRulesRequest, v1.SetDeploymentAffinityRulesResponse]
+	getDeploymentAffinityRules      *connect.Client[v1.GetDeploymentAffinityRulesRequest, v1.GetDeploymentAffinityRulesResponse]
+	createScaleSchedule             *connect.Client[v1.CreateScaleScheduleRequest, v1.CreateScaleScheduleResponse]
+	deleteScaleSchedule             *connect.Client[v1.DeleteScaleScheduleRequest, v1.DeleteScaleScheduleResponse]
+	listScaleSchedules              *connect.Client[v1.ListScaleSchedulesRequest, v1.ListScaleSchedulesResponse]
+	setAutoScalePolicy              *connect.Client[v1.SetAutoScalePolicyRequest, v1.SetAutoScalePolicyResponse]
+	getAutoScalePolicy              *connect.Client[v1.GetAutoScalePolicyRequest, v1.GetAutoScalePolicyResponse]
+	disableAutoScale                *connect.Client[v1.DisableAutoScaleRequest, v1.DisableAutoScaleResponse]
+	getDeploymentRegionStatus       *connect.Client[v1.GetDeploymentRegionStatusRequest, v1.GetDeploymentRegionStatusResponse]
 	listDeploymentContainers        *connect.Client[v1.ListDeploymentContainersRequest, v1.ListDeploymentContainersResponse]
 	streamContainerLogs             *connect.Client[v1.StreamContainerLogsRequest, v1.DeploymentLogLine]
 	startContainer                  *connect.Client[v1.StartContainerRequest, v1.StartContainerResponse]
@@ -799,11 +1016,27 @@ func (c *deploymentServiceClient) RestartDeployment(ctx context.Context, req *co
 	return c.restartDeployment.CallUnary(ctx, req)
 }
 
+// RollbackDeployment calls obiente.cloud.deployments.v1.DeploymentService.RollbackDeployment.
+func (c *deploymentServiceClient) RollbackDeployment(ctx context.Context, req *connect.Request[v1.RollbackDeploymentRequest]) (*connect.Response[v1.RollbackDeploymentResponse], error) {
+	return c.rollbackDeployment.CallUnary(ctx, req)
+}
+
+// ListDeploymentVersions calls
+// obiente.cloud.deployments.v1.DeploymentService.ListDeploymentVersions.
+func (c *deploymentServiceClient) ListDeploymentVersions(ctx context.Context, req *connect.Request[v1.ListDeploymentVersionsRequest]) (*connect.Response[v1.ListDeploymentVersionsResponse], error) {
+	return c.listDeploymentVersions.CallUnary(ctx, req)
+}
+
 // ScaleDeployment calls obiente.cloud.deployments.v1.DeploymentService.ScaleDeployment.
 func (c *deploymentServiceClient) ScaleDeployment(ctx context.Context, req *connect.Request[v1.ScaleDeploymentRequest]) (*connect.Response[v1.ScaleDeploymentResponse], error) {
 	return c.scaleDeployment.CallUnary(ctx, req)
 }
 
+// SetResourceLimits calls obiente.cloud.deployments.v1.DeploymentService.SetResourceLimits.
+func (c *deploymentServiceClient) SetResourceLimits(ctx context.Context, req *connect.Request[v1.SetResourceLimitsRequest]) (*connect.Response[v1.SetResourceLimitsResponse], error) {
+	return c.setResourceLimits.CallUnary(ctx, req)
+}
+
 // GetDeploymentEnvVars calls obiente.cloud.deployments.v1.DeploymentService.GetDeploymentEnvVars.
 func (c *deploymentServiceClient) GetDeploymentEnvVars(ctx context.Context, req *connect.Request[v1.GetDeploymentEnvVarsRequest]) (*connect.Response[v1.GetDeploymentEnvVarsResponse], error) {
 	return c.getDeploymentEnvVars.CallUnary(ctx, req)
@@ -815,6 +1048,11 @@ func (c *deploymentServiceClient) UpdateDeploymentEnvVars(ctx context.Context, r
 	return c.updateDeploymentEnvVars.CallUnary(ctx, req)
 }
 
+// RotateEnvKey calls obiente.cloud.deployments.v1.DeploymentService.RotateEnvKey.
+func (c *deploymentServiceClient) RotateEnvKey(ctx context.Context, req *connect.Request[v1.RotateEnvKeyRequest]) (*connect.Response[v1.RotateEnvKeyResponse], error) {
+	return c.rotateEnvKey.CallUnary(ctx, req)
+}
+
 // GetDeploymentCompose calls obiente.cloud.deployments.v1.DeploymentService.GetDeploymentCompose.
 func (c *deploymentServiceClient) GetDeploymentCompose(ctx context.Context, req *connect.Request[v1.GetDeploymentComposeRequest]) (*connect.Response[v1.GetDeploymentComposeResponse], error) {
 	return c.getDeploymentCompose.CallUnary(ctx, req)
@@ -878,6 +1116,16 @@ func (c *deploymentServiceClient) ListAvailableGitHubIntegrations(ctx context.Co
 	return c.listAvailableGitHubIntegrations.CallUnary(ctx, req)
 }
 
+// RotateDeployKey calls obiente.cloud.deployments.v1.DeploymentService.RotateDeployKey.
+func (c *deploymentServiceClient) RotateDeployKey(ctx context.Context, req *connect.Request[v1.RotateDeployKeyRequest]) (*connect.Response[v1.RotateDeployKeyResponse], error) {
+	return c.rotateDeployKey.CallUnary(ctx, req)
+}
+
+// RotateWebhookSecret calls obiente.cloud.deployments.v1.DeploymentService.RotateWebhookSecret.
+func (c *deploymentServiceClient) RotateWebhookSecret(ctx context.Context, req *connect.Request[v1.RotateWebhookSecretRequest]) (*connect.Response[v1.RotateWebhookSecretResponse], error) {
+	return c.rotateWebhookSecret.CallUnary(ctx, req)
+}
+
 // StreamTerminal calls obiente.cloud.deployments.v1.DeploymentService.StreamTerminal.
 func (c *deploymentServiceClient) StreamTerminal(ctx context.Context) *connect.BidiStreamForClient[v1.TerminalInput, v1.TerminalOutput] {
 	return c.streamTerminal.CallBidiStream(ctx)
@@ -974,6 +1222,70 @@ func (c *deploymentServiceClient) VerifyDomainOwnership(ctx context.Context, req
 	return c.verifyDomainOwnership.CallUnary(ctx, req)
 }
 
+// CreateCustomDomain calls obiente.cloud.deployments.v1.DeploymentService.CreateCustomDomain.
+func (c *deploymentServiceClient) CreateCustomDomain(ctx context.Context, req *connect.Request[v1.CreateCustomDomainRequest]) (*connect.Response[v1.CreateCustomDomainResponse], error) {
+	return c.createCustomDomain.CallUnary(ctx, req)
+}
+
+// VerifyCustomDomain calls obiente.cloud.deployments.v1.DeploymentService.VerifyCustomDomain.
+func (c *deploymentServiceClient) VerifyCustomDomain(ctx context.Context, req *connect.Request[v1.VerifyCustomDomainRequest]) (*connect.Response[v1.VerifyCustomDomainResponse], error) {
+	return c.verifyCustomDomain.CallUnary(ctx, req)
+}
+
+// UpdateDeploymentHealthCheck calls
+// obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentHealthCheck.
+func (c *deploymentServiceClient) UpdateDeploymentHealthCheck(ctx context.Context, req *connect.Request[v1.UpdateDeploymentHealthCheckRequest]) (*connect.Response[v1.UpdateDeploymentHealthCheckResponse], error) {
+	return c.updateDeploymentHealthCheck.CallUnary(ctx, req)
+}
+
+// SetDeploymentAffinityRules calls
+// obiente.cloud.deployments.v1.DeploymentService.SetDeploymentAffinityRules.
+func (c *deploymentServiceClient) SetDeploymentAffinityRules(ctx context.Context, req *connect.Request[v1.SetDeploymentAffinityRulesRequest]) (*connect.Response[v1.SetDeploymentAffinityRulesResponse], error) {
+	return c.setDeploymentAffinityRules.CallUnary(ctx, req)
+}
+
+// GetDeploymentAffinityRules calls
+// obiente.cloud.deployments.v1.DeploymentService.GetDeploymentAffinityRules.
+func (c *deploymentServiceClient) GetDeploymentAffinityRules(ctx context.Context, req *connect.Request[v1.GetDeploymentAffinityRulesRequest]) (*connect.Response[v1.GetDeploymentAffinityRulesResponse], error) {
+	return c.getDeploymentAffinityRules.CallUnary(ctx, req)
+}
+
+// CreateScaleSchedule calls obiente.cloud.deployments.v1.DeploymentService.CreateScaleSchedule.
+func (c *deploymentServiceClient) CreateScaleSchedule(ctx context.Context, req *connect.Request[v1.CreateScaleScheduleRequest]) (*connect.Response[v1.CreateScaleScheduleResponse], error) {
+	return c.createScaleSchedule.CallUnary(ctx, req)
+}
+
+// DeleteScaleSchedule calls obiente.cloud.deployments.v1.DeploymentService.DeleteScaleSchedule.
+func (c *deploymentServiceClient) DeleteScaleSchedule(ctx context.Context, req *connect.Request[v1.DeleteScaleScheduleRequest]) (*connect.Response[v1.DeleteScaleScheduleResponse], error) {
+	return c.deleteScaleSchedule.CallUnary(ctx, req)
+}
+
+// ListScaleSchedules calls obiente.cloud.deployments.v1.DeploymentService.ListScaleSchedules.
+func (c *deploymentServiceClient) ListScaleSchedules(ctx context.Context, req *connect.Request[v1.ListScaleSchedulesRequest]) (*connect.Response[v1.ListScaleSchedulesResponse], error) {
+	return c.listScaleSchedules.CallUnary(ctx, req)
+}
+
+// SetAutoScalePolicy calls obiente.cloud.deployments.v1.DeploymentService.SetAutoScalePolicy.
+func (c *deploymentServiceClient) SetAutoScalePolicy(ctx context.Context, req *connect.Request[v1.SetAutoScalePolicyRequest]) (*connect.Response[v1.SetAutoScalePolicyResponse], error) {
+	return c.setAutoScalePolicy.CallUnary(ctx, req)
+}
+
+// GetAutoScalePolicy calls obiente.cloud.deployments.v1.DeploymentService.GetAutoScalePolicy.
+func (c *deploymentServiceClient) GetAutoScalePolicy(ctx context.Context, req *connect.Request[v1.GetAutoScalePolicyRequest]) (*connect.Response[v1.GetAutoScalePolicyResponse], error) {
+	return c.getAutoScalePolicy.CallUnary(ctx, req)
+}
+
+// DisableAutoScale calls obiente.cloud.deployments.v1.DeploymentService.DisableAutoScale.
+func (c *deploymentServiceClient) DisableAutoScale(ctx context.Context, req *connect.Request[v1.DisableAutoScaleRequest]) (*connect.Response[v1.DisableAutoScaleResponse], error) {
+	return c.disableAutoScale.CallUnary(ctx, req)
+}
+
+// GetDeploymentRegionStatus calls
+// obiente.cloud.deployments.v1.DeploymentService.GetDeploymentRegionStatus.
+func (c *deploymentServiceClient) GetDeploymentRegionStatus(ctx context.Context, req *connect.Request[v1.GetDeploymentRegionStatusRequest]) (*connect.Response[v1.GetDeploymentRegionStatusResponse], error) {
+	return c.getDeploymentRegionStatus.CallUnary(ctx, req)
+}
+
 // ListDeploymentContainers calls
 // obiente.cloud.deployments.v1.DeploymentService.ListDeploymentContainers.
 func (c *deploymentServiceClient) ListDeploymentContainers(ctx context.Context, req *connect.Request[v1.ListDeploymentContainersRequest]) (*connect.Response[v1.ListDeploymentContainersResponse], error) {
@@ -1035,12 +1347,21 @@ type DeploymentServiceHandler interface {
 	DeleteDeployment(context.Context, *connect.Request[v1.DeleteDeploymentRequest]) (*connect.Response[v1.DeleteDeploymentResponse], error)
 	// Restart a deployment
 	RestartDeployment(context.Context, *connect.Request[v1.RestartDeploymentRequest]) (*connect.Response[v1.RestartDeploymentResponse], error)
+	// Roll back to a previous deployment version, or roll back an in-progress blue-green deployment
+	// (keeping the active containers running) when no version is given
+	RollbackDeployment(context.Context, *connect.Request[v1.RollbackDeploymentRequest]) (*connect.Response[v1.RollbackDeploymentResponse], error)
+	// List the retained versions of a deployment that it can be rolled back to
+	ListDeploymentVersions(context.Context, *connect.Request[v1.ListDeploymentVersionsRequest]) (*connect.Response[v1.ListDeploymentVersionsResponse], error)
 	// Scale a deployment
 	ScaleDeployment(context.Context, *connect.Request[v1.ScaleDeploymentRequest]) (*connect.Response[v1.ScaleDeploymentResponse], error)
+	// Set the CPU and memory limits of a deployment's containers; running containers are updated in place
+	SetResourceLimits(context.Context, *connect.Request[v1.SetResourceLimitsRequest]) (*connect.Response[v1.SetResourceLimitsResponse], error)
 	// Get deployment environment variables
 	GetDeploymentEnvVars(context.Context, *connect.Request[v1.GetDeploymentEnvVarsRequest]) (*connect.Response[v1.GetDeploymentEnvVarsResponse], error)
 	// Update deployment environment variables
 	UpdateDeploymentEnvVars(context.Context, *connect.Request[v1.UpdateDeploymentEnvVarsRequest]) (*connect.Response[v1.UpdateDeploymentEnvVarsResponse], error)
+	// Rotate the organization's environment variable encryption key and re-encrypt its deployments
+	RotateEnvKey(context.Context, *connect.Request[v1.RotateEnvKeyRequest]) (*connect.Response[v1.RotateEnvKeyResponse], error)
 	// Get deployment docker compose configuration
 	GetDeploymentCompose(context.Context, *connect.Request[v1.GetDeploymentComposeRequest]) (*connect.Response[v1.GetDeploymentComposeResponse], error)
 	// Validate deployment docker compose configuration (validation only, no save)
@@ -1067,6 +1388,10 @@ type DeploymentServiceHandler interface {
 	DeleteBuild(context.Context, *connect.Request[v1.DeleteBuildRequest]) (*connect.Response[v1.DeleteBuildResponse], error)
 	// List all available GitHub integrations for the current user
 	ListAvailableGitHubIntegrations(context.Context, *connect.Request[v1.ListAvailableGitHubIntegrationsRequest]) (*connect.Response[v1.ListAvailableGitHubIntegrationsResponse], error)
+	// Replace the SSH deploy keys of the repositories deployed through a GitHub integration
+	RotateDeployKey(context.Context, *connect.Request[v1.RotateDeployKeyRequest]) (*connect.Response[v1.RotateDeployKeyResponse], error)
+	// Replace the HMAC secrets of the repository webhooks that deliver to Obiente for a GitHub integration
+	RotateWebhookSecret(context.Context, *connect.Request[v1.RotateWebhookSecretRequest]) (*connect.Response[v1.RotateWebhookSecretResponse], error)
 	// Terminal access
 	// Stream terminal with bidirectional streaming (replaces StreamTerminalOutput + SendTerminalInput)
 	// Use this for better input/output synchronization
@@ -1111,6 +1436,30 @@ type DeploymentServiceHandler interface {
 	GetDomainVerificationToken(context.Context, *connect.Request[v1.GetDomainVerificationTokenRequest]) (*connect.Response[v1.GetDomainVerificationTokenResponse], error)
 	// Verify domain ownership via DNS TXT record
 	VerifyDomainOwnership(context.Context, *connect.Request[v1.VerifyDomainOwnershipRequest]) (*connect.Response[v1.VerifyDomainOwnershipResponse], error)
+	// Map a custom domain to a deployment and start an ACME DNS-01 challenge for its certificate
+	CreateCustomDomain(context.Context, *connect.Request[v1.CreateCustomDomainRequest]) (*connect.Response[v1.CreateCustomDomainResponse], error)
+	// Poll a custom domain: once the challenge TXT record is published, issue the certificate and route the domain
+	VerifyCustomDomain(context.Context, *connect.Request[v1.VerifyCustomDomainRequest]) (*connect.Response[v1.VerifyCustomDomainResponse], error)
+	// Configure the HTTP health check the health monitor probes; unhealthy deployments are redeployed
+	UpdateDeploymentHealthCheck(context.Context, *connect.Request[v1.UpdateDeploymentHealthCheckRequest]) (*connect.Response[v1.UpdateDeploymentHealthCheckResponse], error)
+	// Replace the affinity rules used to place the deployment relative to the organization's labelled deployments
+	SetDeploymentAffinityRules(context.Context, *connect.Request[v1.SetDeploymentAffinityRulesRequest]) (*connect.Response[v1.SetDeploymentAffinityRulesResponse], error)
+	// Get the affinity rules of a deployment
+	GetDeploymentAffinityRules(context.Context, *connect.Request[v1.GetDeploymentAffinityRulesRequest]) (*connect.Response[v1.GetDeploymentAffinityRulesResponse], error)
+	// Scale a deployment to a replica count each time a cron schedule matches
+	CreateScaleSchedule(context.Context, *connect.Request[v1.CreateScaleScheduleRequest]) (*connect.Response[v1.CreateScaleScheduleResponse], error)
+	// Delete a scale schedule of a deployment
+	DeleteScaleSchedule(context.Context, *connect.Request[v1.DeleteScaleScheduleRequest]) (*connect.Response[v1.DeleteScaleScheduleResponse], error)
+	// List the scale schedules of a deployment
+	ListScaleSchedules(context.Context, *connect.Request[v1.ListScaleSchedulesRequest]) (*connect.Response[v1.ListScaleSchedulesResponse], error)
+	// Scale a deployment between a minimum and maximum replica count to hold a target CPU utilization
+	SetAutoScalePolicy(context.Context, *connect.Request[v1.SetAutoScalePolicyRequest]) (*connect.Response[v1.SetAutoScalePolicyResponse], error)
+	// Get the auto-scaling policy of a deployment
+	GetAutoScalePolicy(context.Context, *connect.Request[v1.GetAutoScalePolicyRequest]) (*connect.Response[v1.GetAutoScalePolicyResponse], error)
+	// Stop auto-scaling a deployment; it keeps its current replica count
+	DisableAutoScale(context.Context, *connect.Request[v1.DisableAutoScaleRequest]) (*connect.Response[v1.DisableAutoScaleResponse], error)
+	// Get the provisioning status and health of a multi-region deployment in each of its regions
+	GetDeploymentRegionStatus(context.Context, *connect.Request[v1.GetDeploymentRegionStatusRequest]) (*connect.Response[v1.GetDeploymentRegionStatusResponse], error)
 	// List all containers for a deployment
 	ListDeploymentContainers(context.Context, *connect.Request[v1.ListDeploymentContainersRequest]) (*connect.Response[v1.ListDeploymentContainersResponse], error)
 	// Stream logs from a specific container
@@ -1226,12 +1575,30 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		connect.WithSchema(deploymentServiceMethods.ByName("RestartDeployment")),
 		connect.WithHandlerOptions(opts...),
 	)
+	deploymentServiceRollbackDeploymentHandler := connect.NewUnaryHandler(
+		DeploymentServiceRollbackDeploymentProcedure,
+		svc.RollbackDeployment,
+		connect.WithSchema(deploymentServiceMethods.ByName("RollbackDeployment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListDeploymentVersionsHandler := connect.NewUnaryHandler(
+		DeploymentServiceListDeploymentVersionsProcedure,
+		svc.ListDeploymentVersions,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListDeploymentVersions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	deploymentServiceScaleDeploymentHandler := connect.NewUnaryHandler(
 		DeploymentServiceScaleDeploymentProcedure,
 		svc.ScaleDeployment,
 		connect.WithSchema(deploymentServiceMethods.ByName("ScaleDeployment")),
 		connect.WithHandlerOptions(opts...),
 	)
+	deploymentServiceSetResourceLimitsHandler := connect.NewUnaryHandler(
+		DeploymentServiceSetResourceLimitsProcedure,
+		svc.SetResourceLimits,
+		connect.WithSchema(deploymentServiceMethods.ByName("SetResourceLimits")),
+		connect.WithHandlerOptions(opts...),
+	)
 	deploymentServiceGetDeploymentEnvVarsHandler := connect.NewUnaryHandler(
 		DeploymentServiceGetDeploymentEnvVarsProcedure,
 		svc.GetDeploymentEnvVars,
@@ -1244,6 +1611,12 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		connect.WithSchema(deploymentServiceMethods.ByName("UpdateDeploymentEnvVars")),
 		connect.WithHandlerOptions(opts...),
 	)
+	deploymentServiceRotateEnvKeyHandler := connect.NewUnaryHandler(
+		DeploymentServiceRotateEnvKeyProcedure,
+		svc.RotateEnvKey,
+		connect.WithSchema(deploymentServiceMethods.ByName("RotateEnvKey")),
+		connect.WithHandlerOptions(opts...),
+	)
 	deploymentServiceGetDeploymentComposeHandler := connect.NewUnaryHandler(
 		DeploymentServiceGetDeploymentComposeProcedure,
 		svc.GetDeploymentCompose,
@@ -1316,6 +1689,18 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		connect.WithSchema(deploymentServiceMethods.ByName("ListAvailableGitHubIntegrations")),
 		connect.WithHandlerOptions(opts...),
 	)
+	deploymentServiceRotateDeployKeyHandler := connect.NewUnaryHandler(
+		DeploymentServiceRotateDeployKeyProcedure,
+		svc.RotateDeployKey,
+		connect.WithSchema(deploymentServiceMethods.ByName("RotateDeployKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceRotateWebhookSecretHandler := connect.NewUnaryHandler(
+		DeploymentServiceRotateWebhookSecretProcedure,
+		svc.RotateWebhookSecret,
+		connect.WithSchema(deploymentServiceMethods.ByName("RotateWebhookSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
 	deploymentServiceStreamTerminalHandler := connect.NewBidiStreamHandler(
 		DeploymentServiceStreamTerminalProcedure,
 		svc.StreamTerminal,
@@ -1424,6 +1809,78 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		connect.WithSchema(deploymentServiceMethods.ByName("VerifyDomainOwnership")),
 		connect.WithHandlerOptions(opts...),
 	)
+	deploymentServiceCreateCustomDomainHandler := connect.NewUnaryHandler(
+		DeploymentServiceCreateCustomDomainProcedure,
+		svc.CreateCustomDomain,
+		connect.WithSchema(deploymentServiceMethods.ByName("CreateCustomDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceVerifyCustomDomainHandler := connect.NewUnaryHandler(
+		DeploymentServiceVerifyCustomDomainProcedure,
+		svc.VerifyCustomDomain,
+		connect.WithSchema(deploymentServiceMethods.ByName("VerifyCustomDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceUpdateDeploymentHealthCheckHandler := connect.NewUnaryHandler(
+		DeploymentServiceUpdateDeploymentHealthCheckProcedure,
+		svc.UpdateDeploymentHealthCheck,
+		connect.WithSchema(deploymentServiceMethods.ByName("UpdateDeploymentHealthCheck")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceSetDeploymentAffinityRulesHandler := connect.NewUnaryHandler(
+		DeploymentServiceSetDeploymentAffinityRulesProcedure,
+		svc.SetDeploymentAffinityRules,
+		connect.WithSchema(deploymentServiceMethods.ByName("SetDeploymentAffinityRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetDeploymentAffinityRulesHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetDeploymentAffinityRulesProcedure,
+		svc.GetDeploymentAffinityRules,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentAffinityRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceCreateScaleScheduleHandler := connect.NewUnaryHandler(
+		DeploymentServiceCreateScaleScheduleProcedure,
+		svc.CreateScaleSchedule,
+		connect.WithSchema(deploymentServiceMethods.ByName("CreateScaleSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceDeleteScaleScheduleHandler := connect.NewUnaryHandler(
+		DeploymentServiceDeleteScaleScheduleProcedure,
+		svc.DeleteScaleSchedule,
+		connect.WithSchema(deploymentServiceMethods.ByName("DeleteScaleSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceListScaleSchedulesHandler := connect.NewUnaryHandler(
+		DeploymentServiceListScaleSchedulesProcedure,
+		svc.ListScaleSchedules,
+		connect.WithSchema(deploymentServiceMethods.ByName("ListScaleSchedules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceSetAutoScalePolicyHandler := connect.NewUnaryHandler(
+		DeploymentServiceSetAutoScalePolicyProcedure,
+		svc.SetAutoScalePolicy,
+		connect.WithSchema(deploymentServiceMethods.ByName("SetAutoScalePolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetAutoScalePolicyHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetAutoScalePolicyProcedure,
+		svc.GetAutoScalePolicy,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetAutoScalePolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceDisableAutoScaleHandler := connect.NewUnaryHandler(
+		DeploymentServiceDisableAutoScaleProcedure,
+		svc.DisableAutoScale,
+		connect.WithSchema(deploymentServiceMethods.ByName("DisableAutoScale")),
+		connect.WithHandlerOptions(opts...),
+	)
+	deploymentServiceGetDeploymentRegionStatusHandler := connect.NewUnaryHandler(
+		DeploymentServiceGetDeploymentRegionStatusProcedure,
+		svc.GetDeploymentRegionStatus,
+		connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentRegionStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
 	deploymentServiceListDeploymentContainersHandler := connect.NewUnaryHandler(
 		DeploymentServiceListDeploymentContainersProcedure,
 		svc.ListDeploymentContainers,
@@ -1488,12 +1945,20 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 			deploymentServiceDeleteDeploymentHandler.ServeHTTP(w, r)
 		case DeploymentServiceRestartDeploymentProcedure:
 			deploymentServiceRestartDeploymentHandler.ServeHTTP(w, r)
+		case DeploymentServiceRollbackDeploymentProcedure:
+			deploymentServiceRollbackDeploymentHandler.ServeHTTP(w, r)
+		case DeploymentServiceListDeploymentVersionsProcedure:
+			deploymentServiceListDeploymentVersionsHandler.ServeHTTP(w, r)
 		case DeploymentServiceScaleDeploymentProcedure:
 			deploymentServiceScaleDeploymentHandler.ServeHTTP(w, r)
+		case DeploymentServiceSetResourceLimitsProcedure:
+			deploymentServiceSetResourceLimitsHandler.ServeHTTP(w, r)
 		case DeploymentServiceGetDeploymentEnvVarsProcedure:
 			deploymentServiceGetDeploymentEnvVarsHandler.ServeHTTP(w, r)
 		case DeploymentServiceUpdateDeploymentEnvVarsProcedure:
 			deploymentServiceUpdateDeploymentEnvVarsHandler.ServeHTTP(w, r)
+		case DeploymentServiceRotateEnvKeyProcedure:
+			deploymentServiceRotateEnvKeyHandler.ServeHTTP(w, r)
 		case DeploymentServiceGetDeploymentComposeProcedure:
 			deploymentServiceGetDeploymentComposeHandler.ServeHTTP(w, r)
 		case DeploymentServiceValidateDeploymentComposeProcedure:
@@ -1518,6 +1983,10 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 			deploymentServiceDeleteBuildHandler.ServeHTTP(w, r)
 		case DeploymentServiceListAvailableGitHubIntegrationsProcedure:
 			deploymentServiceListAvailableGitHubIntegrationsHandler.ServeHTTP(w, r)
+		case DeploymentServiceRotateDeployKeyProcedure:
+			deploymentServiceRotateDeployKeyHandler.ServeHTTP(w, r)
+		case DeploymentServiceRotateWebhookSecretProcedure:
+			deploymentServiceRotateWebhookSecretHandler.ServeHTTP(w, r)
 		case DeploymentServiceStreamTerminalProcedure:
 			deploymentServiceStreamTerminalHandler.ServeHTTP(w, r)
 		case DeploymentServiceStreamTerminalOutputProcedure:
@@ -1554,6 +2023,30 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 			deploymentServiceGetDomainVerificationTokenHandler.ServeHTTP(w, r)
 		case DeploymentServiceVerifyDomainOwnershipProcedure:
 			deploymentServiceVerifyDomainOwnershipHandler.ServeHTTP(w, r)
+		case DeploymentServiceCreateCustomDomainProcedure:
+			deploymentServiceCreateCustomDomainHandler.ServeHTTP(w, r)
+		case DeploymentServiceVerifyCustomDomainProcedure:
+			deploymentServiceVerifyCustomDomainHandler.ServeHTTP(w, r)
+		case DeploymentServiceUpdateDeploymentHealthCheckProcedure:
+			deploymentServiceUpdateDeploymentHealthCheckHandler.ServeHTTP(w, r)
+		case DeploymentServiceSetDeploymentAffinityRulesProcedure:
+			deploymentServiceSetDeploymentAffinityRulesHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetDeploymentAffinityRulesProcedure:
+			deploymentServiceGetDeploymentAffinityRulesHandler.ServeHTTP(w, r)
+		case DeploymentServiceCreateScaleScheduleProcedure:
+			deploymentServiceCreateScaleScheduleHandler.ServeHTTP(w, r)
+		case DeploymentServiceDeleteScaleScheduleProcedure:
+			deploymentServiceDeleteScaleScheduleHandler.ServeHTTP(w, r)
+		case DeploymentServiceListScaleSchedulesProcedure:
+			deploymentServiceListScaleSchedulesHandler.ServeHTTP(w, r)
+		case DeploymentServiceSetAutoScalePolicyProcedure:
+			deploymentServiceSetAutoScalePolicyHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetAutoScalePolicyProcedure:
+			deploymentServiceGetAutoScalePolicyHandler.ServeHTTP(w, r)
+		case DeploymentServiceDisableAutoScaleProcedure:
+			deploymentServiceDisableAutoScaleHandler.ServeHTTP(w, r)
+		case DeploymentServiceGetDeploymentRegionStatusProcedure:
+			deploymentServiceGetDeploymentRegionStatusHandler.ServeHTTP(w, r)
 		case DeploymentServiceListDeploymentContainersProcedure:
 			deploymentServiceListDeploymentContainersHandler.ServeHTTP(w, r)
 		case DeploymentServiceStreamContainerLogsProcedure:
@@ -1637,10 +2130,22 @@ func (UnimplementedDeploymentServiceHandler) RestartDeployment(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.RestartDeployment is not implemented"))
 }
 
+func (UnimplementedDeploymentServiceHandler) RollbackDeployment(context.Context, *connect.Request[v1.RollbackDeploymentRequest]) (*connect.Response[v1.RollbackDeploymentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.RollbackDeployment is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListDeploymentVersions(context.Context, *connect.Request[v1.ListDeploymentVersionsRequest]) (*connect.Response[v1.ListDeploymentVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.ListDeploymentVersions is not implemented"))
+}
+
 func (UnimplementedDeploymentServiceHandler) ScaleDeployment(context.Context, *connect.Request[v1.ScaleDeploymentRequest]) (*connect.Response[v1.ScaleDeploymentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.ScaleDeployment is not implemented"))
 }
 
+func (UnimplementedDeploymentServiceHandler) SetResourceLimits(context.Context, *connect.Request[v1.SetResourceLimitsRequest]) (*connect.Response[v1.SetResourceLimitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.SetResourceLimits is not implemented"))
+}
+
 func (UnimplementedDeploymentServiceHandler) GetDeploymentEnvVars(context.Context, *connect.Request[v1.GetDeploymentEnvVarsRequest]) (*connect.Response[v1.GetDeploymentEnvVarsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.GetDeploymentEnvVars is not implemented"))
 }
@@ -1649,6 +2154,10 @@ func (UnimplementedDeploymentServiceHandler) UpdateDeploymentEnvVars(context.Con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentEnvVars is not implemented"))
 }
 
+func (UnimplementedDeploymentServiceHandler) RotateEnvKey(context.Context, *connect.Request[v1.RotateEnvKeyRequest]) (*connect.Response[v1.RotateEnvKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.RotateEnvKey is not implemented"))
+}
+
 func (UnimplementedDeploymentServiceHandler) GetDeploymentCompose(context.Context, *connect.Request[v1.GetDeploymentComposeRequest]) (*connect.Response[v1.GetDeploymentComposeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.GetDeploymentCompose is not implemented"))
 }
@@ -1697,6 +2206,14 @@ func (UnimplementedDeploymentServiceHandler) ListAvailableGitHubIntegrations(con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.ListAvailableGitHubIntegrations is not implemented"))
 }
 
+func (UnimplementedDeploymentServiceHandler) RotateDeployKey(context.Context, *connect.Request[v1.RotateDeployKeyRequest]) (*connect.Response[v1.RotateDeployKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.RotateDeployKey is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) RotateWebhookSecret(context.Context, *connect.Request[v1.RotateWebhookSecretRequest]) (*connect.Response[v1.RotateWebhookSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.RotateWebhookSecret is not implemented"))
+}
+
 func (UnimplementedDeploymentServiceHandler) StreamTerminal(context.Context, *connect.BidiStream[v1.TerminalInput, v1.TerminalOutput]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.StreamTerminal is not implemented"))
 }
@@ -1769,6 +2286,54 @@ func (UnimplementedDeploymentServiceHandler) VerifyDomainOwnership(context.Conte
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.VerifyDomainOwnership is not implemented"))
 }
 
+func (UnimplementedDeploymentServiceHandler) CreateCustomDomain(context.Context, *connect.Request[v1.CreateCustomDomainRequest]) (*connect.Response[v1.CreateCustomDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.CreateCustomDomain is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) VerifyCustomDomain(context.Context, *connect.Request[v1.VerifyCustomDomainRequest]) (*connect.Response[v1.VerifyCustomDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.VerifyCustomDomain is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) UpdateDeploymentHealthCheck(context.Context, *connect.Request[v1.UpdateDeploymentHealthCheckRequest]) (*connect.Response[v1.UpdateDeploymentHealthCheckResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentHealthCheck is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) SetDeploymentAffinityRules(context.Context, *connect.Request[v1.SetDeploymentAffinityRulesRequest]) (*connect.Response[v1.SetDeploymentAffinityRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.SetDeploymentAffinityRules is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetDeploymentAffinityRules(context.Context, *connect.Request[v1.GetDeploymentAffinityRulesRequest]) (*connect.Response[v1.GetDeploymentAffinityRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.GetDeploymentAffinityRules is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) CreateScaleSchedule(context.Context, *connect.Request[v1.CreateScaleScheduleRequest]) (*connect.Response[v1.CreateScaleScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.CreateScaleSchedule is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) DeleteScaleSchedule(context.Context, *connect.Request[v1.DeleteScaleScheduleRequest]) (*connect.Response[v1.DeleteScaleScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.DeleteScaleSchedule is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) ListScaleSchedules(context.Context, *connect.Request[v1.ListScaleSchedulesRequest]) (*connect.Response[v1.ListScaleSchedulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.ListScaleSchedules is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) SetAutoScalePolicy(context.Context, *connect.Request[v1.SetAutoScalePolicyRequest]) (*connect.Response[v1.SetAutoScalePolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.SetAutoScalePolicy is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetAutoScalePolicy(context.Context, *connect.Request[v1.GetAutoScalePolicyRequest]) (*connect.Response[v1.GetAutoScalePolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.GetAutoScalePolicy is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) DisableAutoScale(context.Context, *connect.Request[v1.DisableAutoScaleRequest]) (*connect.Response[v1.DisableAutoScaleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.DisableAutoScale is not implemented"))
+}
+
+func (UnimplementedDeploymentServiceHandler) GetDeploymentRegionStatus(context.Context, *connect.Request[v1.GetDeploymentRegionStatusRequest]) (*connect.Response[v1.GetDeploymentRegionStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.GetDeploymentRegionStatus is not implemented"))
+}
+
 func (UnimplementedDeploymentServiceHandler) ListDeploymentContainers(context.Context, *connect.Request[v1.ListDeploymentContainersRequest]) (*connect.Response[v1.ListDeploymentContainersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.deployments.v1.DeploymentService.ListDeploymentContainers is not implemented"))
 }
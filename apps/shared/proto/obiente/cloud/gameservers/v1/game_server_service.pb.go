@@ -122,6 +122,7 @@ const (
 	GameServerStatus_STOPPED                        GameServerStatus = 5 // Server is stopped
 	GameServerStatus_FAILED                         GameServerStatus = 6 // Server failed to start or crashed
 	GameServerStatus_RESTARTING                     GameServerStatus = 7 // Server is restarting
+	GameServerStatus_STOPPED_IDLE                   GameServerStatus = 8 // Server was stopped automatically after being idle
 )
 
 // Enum value maps for GameServerStatus.
@@ -135,6 +136,7 @@ var (
 		5: "STOPPED",
 		6: "FAILED",
 		7: "RESTARTING",
+		8: "STOPPED_IDLE",
 	}
 	GameServerStatus_value = map[string]int32{
 		"GAME_SERVER_STATUS_UNSPECIFIED": 0,
@@ -145,6 +147,7 @@ var (
 		"STOPPED":                        5,
 		"FAILED":                         6,
 		"RESTARTING":                     7,
+		"STOPPED_IDLE":                   8,
 	}
 )
 
@@ -175,6 +178,58 @@ func (GameServerStatus) EnumDescriptor() ([]byte, []int) {
 	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{1}
 }
 
+type PlayerListAction int32
+
+const (
+	PlayerListAction_PLAYER_LIST_ACTION_UNSPECIFIED PlayerListAction = 0
+	PlayerListAction_PLAYER_LIST_ACTION_ADD         PlayerListAction = 1
+	PlayerListAction_PLAYER_LIST_ACTION_REMOVE      PlayerListAction = 2
+	PlayerListAction_PLAYER_LIST_ACTION_LIST        PlayerListAction = 3
+)
+
+// Enum value maps for PlayerListAction.
+var (
+	PlayerListAction_name = map[int32]string{
+		0: "PLAYER_LIST_ACTION_UNSPECIFIED",
+		1: "PLAYER_LIST_ACTION_ADD",
+		2: "PLAYER_LIST_ACTION_REMOVE",
+		3: "PLAYER_LIST_ACTION_LIST",
+	}
+	PlayerListAction_value = map[string]int32{
+		"PLAYER_LIST_ACTION_UNSPECIFIED": 0,
+		"PLAYER_LIST_ACTION_ADD":         1,
+		"PLAYER_LIST_ACTION_REMOVE":      2,
+		"PLAYER_LIST_ACTION_LIST":        3,
+	}
+)
+
+func (x PlayerListAction) Enum() *PlayerListAction {
+	p := new(PlayerListAction)
+	*p = x
+	return p
+}
+
+func (x PlayerListAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerListAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes[2].Descriptor()
+}
+
+func (PlayerListAction) Type() protoreflect.EnumType {
+	return &file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes[2]
+}
+
+func (x PlayerListAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerListAction.Descriptor instead.
+func (PlayerListAction) EnumDescriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{2}
+}
+
 type GameServerEntryType int32
 
 const (
@@ -211,11 +266,11 @@ func (x GameServerEntryType) String() string {
 }
 
 func (GameServerEntryType) Descriptor() protoreflect.EnumDescriptor {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes[2].Descriptor()
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes[3].Descriptor()
 }
 
 func (GameServerEntryType) Type() protoreflect.EnumType {
-	return &file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes[2]
+	return &file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes[3]
 }
 
 func (x GameServerEntryType) Number() protoreflect.EnumNumber {
@@ -224,7 +279,7 @@ func (x GameServerEntryType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GameServerEntryType.Descriptor instead.
 func (GameServerEntryType) EnumDescriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{2}
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{3}
 }
 
 // Minecraft catalog messages
@@ -261,11 +316,11 @@ func (x MinecraftProjectType) String() string {
 }
 
 func (MinecraftProjectType) Descriptor() protoreflect.EnumDescriptor {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes[3].Descriptor()
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes[4].Descriptor()
 }
 
 func (MinecraftProjectType) Type() protoreflect.EnumType {
-	return &file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes[3]
+	return &file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes[4]
 }
 
 func (x MinecraftProjectType) Number() protoreflect.EnumNumber {
@@ -274,7 +329,7 @@ func (x MinecraftProjectType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MinecraftProjectType.Descriptor instead.
 func (MinecraftProjectType) EnumDescriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{3}
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{4}
 }
 
 // Request/Response messages
@@ -283,8 +338,10 @@ type ListGameServersRequest struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	GameType       *string                `protobuf:"bytes,2,opt,name=game_type,json=gameType,proto3,oneof" json:"game_type,omitempty"`                                 // Filter by game type
 	Status         *GameServerStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=obiente.cloud.gameservers.v1.GameServerStatus,oneof" json:"status,omitempty"` // Filter by status
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Only list game servers that carry every key=value tag
+	Tags          map[string]string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListGameServersRequest) Reset() {
@@ -338,6 +395,13 @@ func (x *ListGameServersRequest) GetStatus() GameServerStatus {
 	return GameServerStatus_GAME_SERVER_STATUS_UNSPECIFIED
 }
 
+func (x *ListGameServersRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type ListGameServersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameServers   []*GameServer          `protobuf:"bytes,1,rep,name=game_servers,json=gameServers,proto3" json:"game_servers,omitempty"`
@@ -400,6 +464,7 @@ type CreateGameServerRequest struct {
 	ServerVersion   *string `protobuf:"bytes,10,opt,name=server_version,json=serverVersion,proto3,oneof" json:"server_version,omitempty"`          // Game server version (e.g., "1.20.1" for Minecraft)
 	Description     *string `protobuf:"bytes,11,opt,name=description,proto3,oneof" json:"description,omitempty"`                                   // Optional description
 	ExtraPortsCount *int32  `protobuf:"varint,12,opt,name=extra_ports_count,json=extraPortsCount,proto3,oneof" json:"extra_ports_count,omitempty"` // Number of additional ports to allocate (0-2)
+	TeamId          *string `protobuf:"bytes,13,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`                               // Team to assign the game server to; counts towards the team's quota
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -518,6 +583,13 @@ func (x *CreateGameServerRequest) GetExtraPortsCount() int32 {
 	return 0
 }
 
+func (x *CreateGameServerRequest) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
 type CreateGameServerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameServer    *GameServer            `protobuf:"bytes,1,opt,name=game_server,json=gameServer,proto3" json:"game_server,omitempty"`
@@ -1154,34 +1226,28 @@ func (x *RestartGameServerResponse) GetGameServer() *GameServer {
 	return nil
 }
 
-type GameServerHTTPRoute struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GameServerId    string                 `protobuf:"bytes,2,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Domain          string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
-	PathPrefix      string                 `protobuf:"bytes,4,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
-	TargetPort      int32                  `protobuf:"varint,5,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
-	Protocol        string                 `protobuf:"bytes,6,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	SslEnabled      bool                   `protobuf:"varint,7,opt,name=ssl_enabled,json=sslEnabled,proto3" json:"ssl_enabled,omitempty"`
-	SslCertResolver *string                `protobuf:"bytes,8,opt,name=ssl_cert_resolver,json=sslCertResolver,proto3,oneof" json:"ssl_cert_resolver,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ExecuteGameServerCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Command       string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameServerHTTPRoute) Reset() {
-	*x = GameServerHTTPRoute{}
+func (x *ExecuteGameServerCommandRequest) Reset() {
+	*x = ExecuteGameServerCommandRequest{}
 	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServerHTTPRoute) String() string {
+func (x *ExecuteGameServerCommandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServerHTTPRoute) ProtoMessage() {}
+func (*ExecuteGameServerCommandRequest) ProtoMessage() {}
 
-func (x *GameServerHTTPRoute) ProtoReflect() protoreflect.Message {
+func (x *ExecuteGameServerCommandRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1193,90 +1259,178 @@ func (x *GameServerHTTPRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServerHTTPRoute.ProtoReflect.Descriptor instead.
-func (*GameServerHTTPRoute) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteGameServerCommandRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteGameServerCommandRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GameServerHTTPRoute) GetId() string {
+func (x *ExecuteGameServerCommandRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Id
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GameServerHTTPRoute) GetGameServerId() string {
+func (x *ExecuteGameServerCommandRequest) GetCommand() string {
 	if x != nil {
-		return x.GameServerId
+		return x.Command
 	}
 	return ""
 }
 
-func (x *GameServerHTTPRoute) GetDomain() string {
+type ExecuteGameServerCommandResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"` // Response returned by the game server's RCON console
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteGameServerCommandResponse) Reset() {
+	*x = ExecuteGameServerCommandResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteGameServerCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteGameServerCommandResponse) ProtoMessage() {}
+
+func (x *ExecuteGameServerCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[17]
 	if x != nil {
-		return x.Domain
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GameServerHTTPRoute) GetPathPrefix() string {
+// Deprecated: Use ExecuteGameServerCommandResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteGameServerCommandResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExecuteGameServerCommandResponse) GetOutput() string {
 	if x != nil {
-		return x.PathPrefix
+		return x.Output
 	}
 	return ""
 }
 
-func (x *GameServerHTTPRoute) GetTargetPort() int32 {
+// Entry of whitelist.json or banned-players.json
+type PlayerListEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Created       *string                `protobuf:"bytes,3,opt,name=created,proto3,oneof" json:"created,omitempty"` // Ban entries only
+	Source        *string                `protobuf:"bytes,4,opt,name=source,proto3,oneof" json:"source,omitempty"`   // Ban entries only
+	Expires       *string                `protobuf:"bytes,5,opt,name=expires,proto3,oneof" json:"expires,omitempty"` // Ban entries only
+	Reason        *string                `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`   // Ban entries only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerListEntry) Reset() {
+	*x = PlayerListEntry{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerListEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerListEntry) ProtoMessage() {}
+
+func (x *PlayerListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[18]
 	if x != nil {
-		return x.TargetPort
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GameServerHTTPRoute) GetProtocol() string {
+// Deprecated: Use PlayerListEntry.ProtoReflect.Descriptor instead.
+func (*PlayerListEntry) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PlayerListEntry) GetUuid() string {
 	if x != nil {
-		return x.Protocol
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GameServerHTTPRoute) GetSslEnabled() bool {
+func (x *PlayerListEntry) GetName() string {
 	if x != nil {
-		return x.SslEnabled
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *GameServerHTTPRoute) GetSslCertResolver() string {
-	if x != nil && x.SslCertResolver != nil {
-		return *x.SslCertResolver
+func (x *PlayerListEntry) GetCreated() string {
+	if x != nil && x.Created != nil {
+		return *x.Created
 	}
 	return ""
 }
 
-type GetGameServerHTTPRoutesRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId   string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *PlayerListEntry) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return ""
 }
 
-func (x *GetGameServerHTTPRoutesRequest) Reset() {
-	*x = GetGameServerHTTPRoutesRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[17]
+func (x *PlayerListEntry) GetExpires() string {
+	if x != nil && x.Expires != nil {
+		return *x.Expires
+	}
+	return ""
+}
+
+func (x *PlayerListEntry) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type ManagePlayerWhitelistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Action        PlayerListAction       `protobuf:"varint,2,opt,name=action,proto3,enum=obiente.cloud.gameservers.v1.PlayerListAction" json:"action,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"` // Required for add and remove
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManagePlayerWhitelistRequest) Reset() {
+	*x = ManagePlayerWhitelistRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerHTTPRoutesRequest) String() string {
+func (x *ManagePlayerWhitelistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameServerHTTPRoutesRequest) ProtoMessage() {}
+func (*ManagePlayerWhitelistRequest) ProtoMessage() {}
 
-func (x *GetGameServerHTTPRoutesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[17]
+func (x *ManagePlayerWhitelistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,47 +1441,55 @@ func (x *GetGameServerHTTPRoutesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameServerHTTPRoutesRequest.ProtoReflect.Descriptor instead.
-func (*GetGameServerHTTPRoutesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ManagePlayerWhitelistRequest.ProtoReflect.Descriptor instead.
+func (*ManagePlayerWhitelistRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetGameServerHTTPRoutesRequest) GetGameServerId() string {
+func (x *ManagePlayerWhitelistRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GetGameServerHTTPRoutesRequest) GetOrganizationId() string {
+func (x *ManagePlayerWhitelistRequest) GetAction() PlayerListAction {
 	if x != nil {
-		return x.OrganizationId
+		return x.Action
+	}
+	return PlayerListAction_PLAYER_LIST_ACTION_UNSPECIFIED
+}
+
+func (x *ManagePlayerWhitelistRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
 	}
 	return ""
 }
 
-type GetGameServerHTTPRoutesResponse struct {
+type ManagePlayerWhitelistResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Routes        []*GameServerHTTPRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	Players       []*PlayerListEntry     `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"` // Whitelist after the change
+	Output        string                 `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`   // RCON response to the add or remove command
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameServerHTTPRoutesResponse) Reset() {
-	*x = GetGameServerHTTPRoutesResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[18]
+func (x *ManagePlayerWhitelistResponse) Reset() {
+	*x = ManagePlayerWhitelistResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerHTTPRoutesResponse) String() string {
+func (x *ManagePlayerWhitelistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameServerHTTPRoutesResponse) ProtoMessage() {}
+func (*ManagePlayerWhitelistResponse) ProtoMessage() {}
 
-func (x *GetGameServerHTTPRoutesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[18]
+func (x *ManagePlayerWhitelistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1338,48 +1500,49 @@ func (x *GetGameServerHTTPRoutesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameServerHTTPRoutesResponse.ProtoReflect.Descriptor instead.
-func (*GetGameServerHTTPRoutesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ManagePlayerWhitelistResponse.ProtoReflect.Descriptor instead.
+func (*ManagePlayerWhitelistResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetGameServerHTTPRoutesResponse) GetRoutes() []*GameServerHTTPRoute {
+func (x *ManagePlayerWhitelistResponse) GetPlayers() []*PlayerListEntry {
 	if x != nil {
-		return x.Routes
+		return x.Players
 	}
 	return nil
 }
 
-type UpsertGameServerHTTPRouteRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId    string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	OrganizationId  string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	RouteId         *string                `protobuf:"bytes,3,opt,name=route_id,json=routeId,proto3,oneof" json:"route_id,omitempty"`
-	Domain          string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
-	PathPrefix      *string                `protobuf:"bytes,5,opt,name=path_prefix,json=pathPrefix,proto3,oneof" json:"path_prefix,omitempty"`
-	TargetPort      int32                  `protobuf:"varint,6,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
-	Protocol        *string                `protobuf:"bytes,7,opt,name=protocol,proto3,oneof" json:"protocol,omitempty"`
-	SslEnabled      *bool                  `protobuf:"varint,8,opt,name=ssl_enabled,json=sslEnabled,proto3,oneof" json:"ssl_enabled,omitempty"`
-	SslCertResolver *string                `protobuf:"bytes,9,opt,name=ssl_cert_resolver,json=sslCertResolver,proto3,oneof" json:"ssl_cert_resolver,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ManagePlayerWhitelistResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
 }
 
-func (x *UpsertGameServerHTTPRouteRequest) Reset() {
-	*x = UpsertGameServerHTTPRouteRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[19]
+type ManagePlayerBanListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Action        PlayerListAction       `protobuf:"varint,2,opt,name=action,proto3,enum=obiente.cloud.gameservers.v1.PlayerListAction" json:"action,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"` // Required for add and remove
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManagePlayerBanListRequest) Reset() {
+	*x = ManagePlayerBanListRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertGameServerHTTPRouteRequest) String() string {
+func (x *ManagePlayerBanListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertGameServerHTTPRouteRequest) ProtoMessage() {}
+func (*ManagePlayerBanListRequest) ProtoMessage() {}
 
-func (x *UpsertGameServerHTTPRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[19]
+func (x *ManagePlayerBanListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1390,96 +1553,113 @@ func (x *UpsertGameServerHTTPRouteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertGameServerHTTPRouteRequest.ProtoReflect.Descriptor instead.
-func (*UpsertGameServerHTTPRouteRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ManagePlayerBanListRequest.ProtoReflect.Descriptor instead.
+func (*ManagePlayerBanListRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpsertGameServerHTTPRouteRequest) GetGameServerId() string {
+func (x *ManagePlayerBanListRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *UpsertGameServerHTTPRouteRequest) GetOrganizationId() string {
+func (x *ManagePlayerBanListRequest) GetAction() PlayerListAction {
 	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
-}
-
-func (x *UpsertGameServerHTTPRouteRequest) GetRouteId() string {
-	if x != nil && x.RouteId != nil {
-		return *x.RouteId
+		return x.Action
 	}
-	return ""
+	return PlayerListAction_PLAYER_LIST_ACTION_UNSPECIFIED
 }
 
-func (x *UpsertGameServerHTTPRouteRequest) GetDomain() string {
+func (x *ManagePlayerBanListRequest) GetPlayerName() string {
 	if x != nil {
-		return x.Domain
+		return x.PlayerName
 	}
 	return ""
 }
 
-func (x *UpsertGameServerHTTPRouteRequest) GetPathPrefix() string {
-	if x != nil && x.PathPrefix != nil {
-		return *x.PathPrefix
-	}
-	return ""
+type ManagePlayerBanListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*PlayerListEntry     `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"` // Ban list after the change
+	Output        string                 `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`   // RCON response to the ban or pardon command
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertGameServerHTTPRouteRequest) GetTargetPort() int32 {
+func (x *ManagePlayerBanListResponse) Reset() {
+	*x = ManagePlayerBanListResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManagePlayerBanListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagePlayerBanListResponse) ProtoMessage() {}
+
+func (x *ManagePlayerBanListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[22]
 	if x != nil {
-		return x.TargetPort
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpsertGameServerHTTPRouteRequest) GetProtocol() string {
-	if x != nil && x.Protocol != nil {
-		return *x.Protocol
-	}
-	return ""
+// Deprecated: Use ManagePlayerBanListResponse.ProtoReflect.Descriptor instead.
+func (*ManagePlayerBanListResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpsertGameServerHTTPRouteRequest) GetSslEnabled() bool {
-	if x != nil && x.SslEnabled != nil {
-		return *x.SslEnabled
+func (x *ManagePlayerBanListResponse) GetPlayers() []*PlayerListEntry {
+	if x != nil {
+		return x.Players
 	}
-	return false
+	return nil
 }
 
-func (x *UpsertGameServerHTTPRouteRequest) GetSslCertResolver() string {
-	if x != nil && x.SslCertResolver != nil {
-		return *x.SslCertResolver
+func (x *ManagePlayerBanListResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
 	}
 	return ""
 }
 
-type UpsertGameServerHTTPRouteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Route         *GameServerHTTPRoute   `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GameServerHTTPRoute struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameServerId    string                 `protobuf:"bytes,2,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Domain          string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	PathPrefix      string                 `protobuf:"bytes,4,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	TargetPort      int32                  `protobuf:"varint,5,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
+	Protocol        string                 `protobuf:"bytes,6,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	SslEnabled      bool                   `protobuf:"varint,7,opt,name=ssl_enabled,json=sslEnabled,proto3" json:"ssl_enabled,omitempty"`
+	SslCertResolver *string                `protobuf:"bytes,8,opt,name=ssl_cert_resolver,json=sslCertResolver,proto3,oneof" json:"ssl_cert_resolver,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UpsertGameServerHTTPRouteResponse) Reset() {
-	*x = UpsertGameServerHTTPRouteResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[20]
+func (x *GameServerHTTPRoute) Reset() {
+	*x = GameServerHTTPRoute{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertGameServerHTTPRouteResponse) String() string {
+func (x *GameServerHTTPRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertGameServerHTTPRouteResponse) ProtoMessage() {}
+func (*GameServerHTTPRoute) ProtoMessage() {}
 
-func (x *UpsertGameServerHTTPRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[20]
+func (x *GameServerHTTPRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,42 +1670,90 @@ func (x *UpsertGameServerHTTPRouteResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertGameServerHTTPRouteResponse.ProtoReflect.Descriptor instead.
-func (*UpsertGameServerHTTPRouteResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GameServerHTTPRoute.ProtoReflect.Descriptor instead.
+func (*GameServerHTTPRoute) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpsertGameServerHTTPRouteResponse) GetRoute() *GameServerHTTPRoute {
+func (x *GameServerHTTPRoute) GetId() string {
 	if x != nil {
-		return x.Route
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type DeleteGameServerHTTPRouteRequest struct {
+func (x *GameServerHTTPRoute) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
+	}
+	return ""
+}
+
+func (x *GameServerHTTPRoute) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *GameServerHTTPRoute) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *GameServerHTTPRoute) GetTargetPort() int32 {
+	if x != nil {
+		return x.TargetPort
+	}
+	return 0
+}
+
+func (x *GameServerHTTPRoute) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *GameServerHTTPRoute) GetSslEnabled() bool {
+	if x != nil {
+		return x.SslEnabled
+	}
+	return false
+}
+
+func (x *GameServerHTTPRoute) GetSslCertResolver() string {
+	if x != nil && x.SslCertResolver != nil {
+		return *x.SslCertResolver
+	}
+	return ""
+}
+
+type GetGameServerHTTPRoutesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	GameServerId   string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	RouteId        string                 `protobuf:"bytes,3,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteGameServerHTTPRouteRequest) Reset() {
-	*x = DeleteGameServerHTTPRouteRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[21]
+func (x *GetGameServerHTTPRoutesRequest) Reset() {
+	*x = GetGameServerHTTPRoutesRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGameServerHTTPRouteRequest) String() string {
+func (x *GetGameServerHTTPRoutesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGameServerHTTPRouteRequest) ProtoMessage() {}
+func (*GetGameServerHTTPRoutesRequest) ProtoMessage() {}
 
-func (x *DeleteGameServerHTTPRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[21]
+func (x *GetGameServerHTTPRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,54 +1764,47 @@ func (x *DeleteGameServerHTTPRouteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGameServerHTTPRouteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGameServerHTTPRouteRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetGameServerHTTPRoutesRequest.ProtoReflect.Descriptor instead.
+func (*GetGameServerHTTPRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DeleteGameServerHTTPRouteRequest) GetGameServerId() string {
+func (x *GetGameServerHTTPRoutesRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *DeleteGameServerHTTPRouteRequest) GetOrganizationId() string {
+func (x *GetGameServerHTTPRoutesRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *DeleteGameServerHTTPRouteRequest) GetRouteId() string {
-	if x != nil {
-		return x.RouteId
-	}
-	return ""
-}
-
-type DeleteGameServerHTTPRouteResponse struct {
+type GetGameServerHTTPRoutesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Routes        []*GameServerHTTPRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGameServerHTTPRouteResponse) Reset() {
-	*x = DeleteGameServerHTTPRouteResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[22]
+func (x *GetGameServerHTTPRoutesResponse) Reset() {
+	*x = GetGameServerHTTPRoutesResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGameServerHTTPRouteResponse) String() string {
+func (x *GetGameServerHTTPRoutesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGameServerHTTPRouteResponse) ProtoMessage() {}
+func (*GetGameServerHTTPRoutesResponse) ProtoMessage() {}
 
-func (x *DeleteGameServerHTTPRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[22]
+func (x *GetGameServerHTTPRoutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1594,42 +1815,48 @@ func (x *DeleteGameServerHTTPRouteResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGameServerHTTPRouteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGameServerHTTPRouteResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetGameServerHTTPRoutesResponse.ProtoReflect.Descriptor instead.
+func (*GetGameServerHTTPRoutesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DeleteGameServerHTTPRouteResponse) GetSuccess() bool {
+func (x *GetGameServerHTTPRoutesResponse) GetRoutes() []*GameServerHTTPRoute {
 	if x != nil {
-		return x.Success
+		return x.Routes
 	}
-	return false
+	return nil
 }
 
-type GetGameServerDomainVerificationTokenRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId   string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type UpsertGameServerHTTPRouteRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId    string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	OrganizationId  string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	RouteId         *string                `protobuf:"bytes,3,opt,name=route_id,json=routeId,proto3,oneof" json:"route_id,omitempty"`
+	Domain          string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	PathPrefix      *string                `protobuf:"bytes,5,opt,name=path_prefix,json=pathPrefix,proto3,oneof" json:"path_prefix,omitempty"`
+	TargetPort      int32                  `protobuf:"varint,6,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
+	Protocol        *string                `protobuf:"bytes,7,opt,name=protocol,proto3,oneof" json:"protocol,omitempty"`
+	SslEnabled      *bool                  `protobuf:"varint,8,opt,name=ssl_enabled,json=sslEnabled,proto3,oneof" json:"ssl_enabled,omitempty"`
+	SslCertResolver *string                `protobuf:"bytes,9,opt,name=ssl_cert_resolver,json=sslCertResolver,proto3,oneof" json:"ssl_cert_resolver,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetGameServerDomainVerificationTokenRequest) Reset() {
-	*x = GetGameServerDomainVerificationTokenRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[23]
+func (x *UpsertGameServerHTTPRouteRequest) Reset() {
+	*x = UpsertGameServerHTTPRouteRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerDomainVerificationTokenRequest) String() string {
+func (x *UpsertGameServerHTTPRouteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameServerDomainVerificationTokenRequest) ProtoMessage() {}
+func (*UpsertGameServerHTTPRouteRequest) ProtoMessage() {}
 
-func (x *GetGameServerDomainVerificationTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[23]
+func (x *UpsertGameServerHTTPRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,132 +1867,96 @@ func (x *GetGameServerDomainVerificationTokenRequest) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameServerDomainVerificationTokenRequest.ProtoReflect.Descriptor instead.
-func (*GetGameServerDomainVerificationTokenRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpsertGameServerHTTPRouteRequest.ProtoReflect.Descriptor instead.
+func (*UpsertGameServerHTTPRouteRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetGameServerDomainVerificationTokenRequest) GetGameServerId() string {
+func (x *UpsertGameServerHTTPRouteRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GetGameServerDomainVerificationTokenRequest) GetOrganizationId() string {
+func (x *UpsertGameServerHTTPRouteRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GetGameServerDomainVerificationTokenRequest) GetDomain() string {
+func (x *UpsertGameServerHTTPRouteRequest) GetRouteId() string {
+	if x != nil && x.RouteId != nil {
+		return *x.RouteId
+	}
+	return ""
+}
+
+func (x *UpsertGameServerHTTPRouteRequest) GetDomain() string {
 	if x != nil {
 		return x.Domain
 	}
 	return ""
 }
 
-type GetGameServerDomainVerificationTokenResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Domain         string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	Token          string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	TxtRecordName  string                 `protobuf:"bytes,3,opt,name=txt_record_name,json=txtRecordName,proto3" json:"txt_record_name,omitempty"`
-	TxtRecordValue string                 `protobuf:"bytes,4,opt,name=txt_record_value,json=txtRecordValue,proto3" json:"txt_record_value,omitempty"`
-	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetGameServerDomainVerificationTokenResponse) Reset() {
-	*x = GetGameServerDomainVerificationTokenResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetGameServerDomainVerificationTokenResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetGameServerDomainVerificationTokenResponse) ProtoMessage() {}
-
-func (x *GetGameServerDomainVerificationTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[24]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetGameServerDomainVerificationTokenResponse.ProtoReflect.Descriptor instead.
-func (*GetGameServerDomainVerificationTokenResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *GetGameServerDomainVerificationTokenResponse) GetDomain() string {
-	if x != nil {
-		return x.Domain
+func (x *UpsertGameServerHTTPRouteRequest) GetPathPrefix() string {
+	if x != nil && x.PathPrefix != nil {
+		return *x.PathPrefix
 	}
 	return ""
 }
 
-func (x *GetGameServerDomainVerificationTokenResponse) GetToken() string {
+func (x *UpsertGameServerHTTPRouteRequest) GetTargetPort() int32 {
 	if x != nil {
-		return x.Token
+		return x.TargetPort
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGameServerDomainVerificationTokenResponse) GetTxtRecordName() string {
-	if x != nil {
-		return x.TxtRecordName
+func (x *UpsertGameServerHTTPRouteRequest) GetProtocol() string {
+	if x != nil && x.Protocol != nil {
+		return *x.Protocol
 	}
 	return ""
 }
 
-func (x *GetGameServerDomainVerificationTokenResponse) GetTxtRecordValue() string {
-	if x != nil {
-		return x.TxtRecordValue
+func (x *UpsertGameServerHTTPRouteRequest) GetSslEnabled() bool {
+	if x != nil && x.SslEnabled != nil {
+		return *x.SslEnabled
 	}
-	return ""
+	return false
 }
 
-func (x *GetGameServerDomainVerificationTokenResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *UpsertGameServerHTTPRouteRequest) GetSslCertResolver() string {
+	if x != nil && x.SslCertResolver != nil {
+		return *x.SslCertResolver
 	}
 	return ""
 }
 
-type VerifyGameServerDomainRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId   string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type UpsertGameServerHTTPRouteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Route         *GameServerHTTPRoute   `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyGameServerDomainRequest) Reset() {
-	*x = VerifyGameServerDomainRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[25]
+func (x *UpsertGameServerHTTPRouteResponse) Reset() {
+	*x = UpsertGameServerHTTPRouteResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyGameServerDomainRequest) String() string {
+func (x *UpsertGameServerHTTPRouteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyGameServerDomainRequest) ProtoMessage() {}
+func (*UpsertGameServerHTTPRouteResponse) ProtoMessage() {}
 
-func (x *VerifyGameServerDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[25]
+func (x *UpsertGameServerHTTPRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1776,57 +1967,42 @@ func (x *VerifyGameServerDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyGameServerDomainRequest.ProtoReflect.Descriptor instead.
-func (*VerifyGameServerDomainRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *VerifyGameServerDomainRequest) GetGameServerId() string {
-	if x != nil {
-		return x.GameServerId
-	}
-	return ""
-}
-
-func (x *VerifyGameServerDomainRequest) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
+// Deprecated: Use UpsertGameServerHTTPRouteResponse.ProtoReflect.Descriptor instead.
+func (*UpsertGameServerHTTPRouteResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *VerifyGameServerDomainRequest) GetDomain() string {
+func (x *UpsertGameServerHTTPRouteResponse) GetRoute() *GameServerHTTPRoute {
 	if x != nil {
-		return x.Domain
+		return x.Route
 	}
-	return ""
+	return nil
 }
 
-type VerifyGameServerDomainResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	Verified      bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Message       *string                `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DeleteGameServerHTTPRouteRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId   string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	RouteId        string                 `protobuf:"bytes,3,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *VerifyGameServerDomainResponse) Reset() {
-	*x = VerifyGameServerDomainResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[26]
+func (x *DeleteGameServerHTTPRouteRequest) Reset() {
+	*x = DeleteGameServerHTTPRouteRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyGameServerDomainResponse) String() string {
+func (x *DeleteGameServerHTTPRouteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyGameServerDomainResponse) ProtoMessage() {}
+func (*DeleteGameServerHTTPRouteRequest) ProtoMessage() {}
 
-func (x *VerifyGameServerDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[26]
+func (x *DeleteGameServerHTTPRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1837,61 +2013,54 @@ func (x *VerifyGameServerDomainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyGameServerDomainResponse.ProtoReflect.Descriptor instead.
-func (*VerifyGameServerDomainResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use DeleteGameServerHTTPRouteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameServerHTTPRouteRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *VerifyGameServerDomainResponse) GetDomain() string {
+func (x *DeleteGameServerHTTPRouteRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Domain
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *VerifyGameServerDomainResponse) GetVerified() bool {
-	if x != nil {
-		return x.Verified
-	}
-	return false
-}
-
-func (x *VerifyGameServerDomainResponse) GetStatus() string {
+func (x *DeleteGameServerHTTPRouteRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Status
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *VerifyGameServerDomainResponse) GetMessage() string {
-	if x != nil && x.Message != nil {
-		return *x.Message
+func (x *DeleteGameServerHTTPRouteRequest) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
 	}
 	return ""
 }
 
-type StreamGameServerStatusRequest struct {
+type DeleteGameServerHTTPRouteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamGameServerStatusRequest) Reset() {
-	*x = StreamGameServerStatusRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[27]
+func (x *DeleteGameServerHTTPRouteResponse) Reset() {
+	*x = DeleteGameServerHTTPRouteResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamGameServerStatusRequest) String() string {
+func (x *DeleteGameServerHTTPRouteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamGameServerStatusRequest) ProtoMessage() {}
+func (*DeleteGameServerHTTPRouteResponse) ProtoMessage() {}
 
-func (x *StreamGameServerStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[27]
+func (x *DeleteGameServerHTTPRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1902,43 +2071,42 @@ func (x *StreamGameServerStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamGameServerStatusRequest.ProtoReflect.Descriptor instead.
-func (*StreamGameServerStatusRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use DeleteGameServerHTTPRouteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGameServerHTTPRouteResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *StreamGameServerStatusRequest) GetGameServerId() string {
+func (x *DeleteGameServerHTTPRouteResponse) GetSuccess() bool {
 	if x != nil {
-		return x.GameServerId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type GameServerStatusUpdate struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Status        GameServerStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=obiente.cloud.gameservers.v1.GameServerStatus" json:"status,omitempty"`
-	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetGameServerDomainVerificationTokenRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId   string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GameServerStatusUpdate) Reset() {
-	*x = GameServerStatusUpdate{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[28]
+func (x *GetGameServerDomainVerificationTokenRequest) Reset() {
+	*x = GetGameServerDomainVerificationTokenRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServerStatusUpdate) String() string {
+func (x *GetGameServerDomainVerificationTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServerStatusUpdate) ProtoMessage() {}
+func (*GetGameServerDomainVerificationTokenRequest) ProtoMessage() {}
 
-func (x *GameServerStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[28]
+func (x *GetGameServerDomainVerificationTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1949,65 +2117,58 @@ func (x *GameServerStatusUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServerStatusUpdate.ProtoReflect.Descriptor instead.
-func (*GameServerStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetGameServerDomainVerificationTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetGameServerDomainVerificationTokenRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GameServerStatusUpdate) GetGameServerId() string {
+func (x *GetGameServerDomainVerificationTokenRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GameServerStatusUpdate) GetStatus() GameServerStatus {
+func (x *GetGameServerDomainVerificationTokenRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Status
+		return x.OrganizationId
 	}
-	return GameServerStatus_GAME_SERVER_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *GameServerStatusUpdate) GetMessage() string {
-	if x != nil && x.Message != nil {
-		return *x.Message
+func (x *GetGameServerDomainVerificationTokenRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *GameServerStatusUpdate) GetTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Timestamp
-	}
-	return nil
-}
-
-type GetGameServerLogsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                               // Max number of lines to return
-	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3,oneof" json:"since,omitempty"`                                // Get logs since this timestamp (for pagination)
-	Until         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3,oneof" json:"until,omitempty"`                                // Get logs until this timestamp (for historical loading)
-	SearchQuery   *string                `protobuf:"bytes,5,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"` // Search query to filter logs (case-insensitive substring match)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetGameServerDomainVerificationTokenResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Domain         string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Token          string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	TxtRecordName  string                 `protobuf:"bytes,3,opt,name=txt_record_name,json=txtRecordName,proto3" json:"txt_record_name,omitempty"`
+	TxtRecordValue string                 `protobuf:"bytes,4,opt,name=txt_record_value,json=txtRecordValue,proto3" json:"txt_record_value,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGameServerLogsRequest) Reset() {
-	*x = GetGameServerLogsRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[29]
+func (x *GetGameServerDomainVerificationTokenResponse) Reset() {
+	*x = GetGameServerDomainVerificationTokenResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerLogsRequest) String() string {
+func (x *GetGameServerDomainVerificationTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameServerLogsRequest) ProtoMessage() {}
+func (*GetGameServerDomainVerificationTokenResponse) ProtoMessage() {}
 
-func (x *GetGameServerLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[29]
+func (x *GetGameServerDomainVerificationTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,68 +2179,70 @@ func (x *GetGameServerLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameServerLogsRequest.ProtoReflect.Descriptor instead.
-func (*GetGameServerLogsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetGameServerDomainVerificationTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetGameServerDomainVerificationTokenResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetGameServerLogsRequest) GetGameServerId() string {
+func (x *GetGameServerDomainVerificationTokenResponse) GetDomain() string {
 	if x != nil {
-		return x.GameServerId
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *GetGameServerLogsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *GetGameServerDomainVerificationTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *GetGameServerLogsRequest) GetSince() *timestamppb.Timestamp {
+func (x *GetGameServerDomainVerificationTokenResponse) GetTxtRecordName() string {
 	if x != nil {
-		return x.Since
+		return x.TxtRecordName
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGameServerLogsRequest) GetUntil() *timestamppb.Timestamp {
+func (x *GetGameServerDomainVerificationTokenResponse) GetTxtRecordValue() string {
 	if x != nil {
-		return x.Until
+		return x.TxtRecordValue
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGameServerLogsRequest) GetSearchQuery() string {
-	if x != nil && x.SearchQuery != nil {
-		return *x.SearchQuery
+func (x *GetGameServerDomainVerificationTokenResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-type GetGameServerLogsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lines         []*GameServerLogLine   `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type VerifyGameServerDomainRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId   string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGameServerLogsResponse) Reset() {
-	*x = GetGameServerLogsResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[30]
+func (x *VerifyGameServerDomainRequest) Reset() {
+	*x = VerifyGameServerDomainRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerLogsResponse) String() string {
+func (x *VerifyGameServerDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameServerLogsResponse) ProtoMessage() {}
+func (*VerifyGameServerDomainRequest) ProtoMessage() {}
 
-func (x *GetGameServerLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[30]
+func (x *VerifyGameServerDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,45 +2253,57 @@ func (x *GetGameServerLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameServerLogsResponse.ProtoReflect.Descriptor instead.
-func (*GetGameServerLogsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use VerifyGameServerDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyGameServerDomainRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetGameServerLogsResponse) GetLines() []*GameServerLogLine {
+func (x *VerifyGameServerDomainRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Lines
+		return x.GameServerId
 	}
-	return nil
+	return ""
 }
 
-type StreamGameServerLogsRequest struct {
+func (x *VerifyGameServerDomainRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *VerifyGameServerDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type VerifyGameServerDomainResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Follow        *bool                  `protobuf:"varint,2,opt,name=follow,proto3,oneof" json:"follow,omitempty"`                             // Follow logs (like tail -f, default: true)
-	Tail          *int32                 `protobuf:"varint,3,opt,name=tail,proto3,oneof" json:"tail,omitempty"`                                 // Number of lines to tail (default: 100)
-	Since         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3,oneof" json:"since,omitempty"`                                // Get historical logs since this timestamp
-	Until         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3,oneof" json:"until,omitempty"`                                // Get historical logs until this timestamp (for pagination)
-	SearchQuery   *string                `protobuf:"bytes,6,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"` // Search query to filter logs (case-insensitive substring match)
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Verified      bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Message       *string                `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamGameServerLogsRequest) Reset() {
-	*x = StreamGameServerLogsRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[31]
+func (x *VerifyGameServerDomainResponse) Reset() {
+	*x = VerifyGameServerDomainResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamGameServerLogsRequest) String() string {
+func (x *VerifyGameServerDomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamGameServerLogsRequest) ProtoMessage() {}
+func (*VerifyGameServerDomainResponse) ProtoMessage() {}
 
-func (x *StreamGameServerLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[31]
+func (x *VerifyGameServerDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2139,78 +2314,61 @@ func (x *StreamGameServerLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamGameServerLogsRequest.ProtoReflect.Descriptor instead.
-func (*StreamGameServerLogsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use VerifyGameServerDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyGameServerDomainResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *StreamGameServerLogsRequest) GetGameServerId() string {
+func (x *VerifyGameServerDomainResponse) GetDomain() string {
 	if x != nil {
-		return x.GameServerId
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *StreamGameServerLogsRequest) GetFollow() bool {
-	if x != nil && x.Follow != nil {
-		return *x.Follow
-	}
-	return false
-}
-
-func (x *StreamGameServerLogsRequest) GetTail() int32 {
-	if x != nil && x.Tail != nil {
-		return *x.Tail
-	}
-	return 0
-}
-
-func (x *StreamGameServerLogsRequest) GetSince() *timestamppb.Timestamp {
+func (x *VerifyGameServerDomainResponse) GetVerified() bool {
 	if x != nil {
-		return x.Since
+		return x.Verified
 	}
-	return nil
+	return false
 }
 
-func (x *StreamGameServerLogsRequest) GetUntil() *timestamppb.Timestamp {
+func (x *VerifyGameServerDomainResponse) GetStatus() string {
 	if x != nil {
-		return x.Until
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamGameServerLogsRequest) GetSearchQuery() string {
-	if x != nil && x.SearchQuery != nil {
-		return *x.SearchQuery
+func (x *VerifyGameServerDomainResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
 	}
 	return ""
 }
 
-type GameServerLogLine struct {
+type StreamGameServerStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Line          string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Level         *v1.LogLevel           `protobuf:"varint,3,opt,name=level,proto3,enum=obiente.cloud.common.v1.LogLevel,oneof" json:"level,omitempty"`
-	Stderr        bool                   `protobuf:"varint,4,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameServerLogLine) Reset() {
-	*x = GameServerLogLine{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[32]
+func (x *StreamGameServerStatusRequest) Reset() {
+	*x = StreamGameServerStatusRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServerLogLine) String() string {
+func (x *StreamGameServerStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServerLogLine) ProtoMessage() {}
+func (*StreamGameServerStatusRequest) ProtoMessage() {}
 
-func (x *GameServerLogLine) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[32]
+func (x *StreamGameServerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2221,64 +2379,43 @@ func (x *GameServerLogLine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServerLogLine.ProtoReflect.Descriptor instead.
-func (*GameServerLogLine) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use StreamGameServerStatusRequest.ProtoReflect.Descriptor instead.
+func (*StreamGameServerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GameServerLogLine) GetLine() string {
+func (x *StreamGameServerStatusRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Line
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GameServerLogLine) GetTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Timestamp
-	}
-	return nil
-}
-
-func (x *GameServerLogLine) GetLevel() v1.LogLevel {
-	if x != nil && x.Level != nil {
-		return *x.Level
-	}
-	return v1.LogLevel(0)
-}
-
-func (x *GameServerLogLine) GetStderr() bool {
-	if x != nil {
-		return x.Stderr
-	}
-	return false
-}
-
-type GetGameServerMetricsRequest struct {
+type GameServerStatusUpdate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
-	Aggregation   *string                `protobuf:"bytes,4,opt,name=aggregation,proto3,oneof" json:"aggregation,omitempty"` // "1m", "5m", "1h", etc.
+	Status        GameServerStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=obiente.cloud.gameservers.v1.GameServerStatus" json:"status,omitempty"`
+	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameServerMetricsRequest) Reset() {
-	*x = GetGameServerMetricsRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[33]
+func (x *GameServerStatusUpdate) Reset() {
+	*x = GameServerStatusUpdate{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerMetricsRequest) String() string {
+func (x *GameServerStatusUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameServerMetricsRequest) ProtoMessage() {}
+func (*GameServerStatusUpdate) ProtoMessage() {}
 
-func (x *GetGameServerMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[33]
+func (x *GameServerStatusUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2289,61 +2426,65 @@ func (x *GetGameServerMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameServerMetricsRequest.ProtoReflect.Descriptor instead.
-func (*GetGameServerMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GameServerStatusUpdate.ProtoReflect.Descriptor instead.
+func (*GameServerStatusUpdate) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetGameServerMetricsRequest) GetGameServerId() string {
+func (x *GameServerStatusUpdate) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GetGameServerMetricsRequest) GetStartTime() *timestamppb.Timestamp {
+func (x *GameServerStatusUpdate) GetStatus() GameServerStatus {
 	if x != nil {
-		return x.StartTime
+		return x.Status
 	}
-	return nil
+	return GameServerStatus_GAME_SERVER_STATUS_UNSPECIFIED
 }
 
-func (x *GetGameServerMetricsRequest) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
+func (x *GameServerStatusUpdate) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGameServerMetricsRequest) GetAggregation() string {
-	if x != nil && x.Aggregation != nil {
-		return *x.Aggregation
+func (x *GameServerStatusUpdate) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
 	}
-	return ""
+	return nil
 }
 
-type GetGameServerMetricsResponse struct {
+type GetGameServerLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metrics       []*GameServerMetric    `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                               // Max number of lines to return
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3,oneof" json:"since,omitempty"`                                // Get logs since this timestamp (for pagination)
+	Until         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3,oneof" json:"until,omitempty"`                                // Get logs until this timestamp (for historical loading)
+	SearchQuery   *string                `protobuf:"bytes,5,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"` // Search query to filter logs (case-insensitive substring match)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameServerMetricsResponse) Reset() {
-	*x = GetGameServerMetricsResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[34]
+func (x *GetGameServerLogsRequest) Reset() {
+	*x = GetGameServerLogsRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerMetricsResponse) String() string {
+func (x *GetGameServerLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameServerMetricsResponse) ProtoMessage() {}
+func (*GetGameServerLogsRequest) ProtoMessage() {}
 
-func (x *GetGameServerMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[34]
+func (x *GetGameServerLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2354,40 +2495,68 @@ func (x *GetGameServerMetricsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameServerMetricsResponse.ProtoReflect.Descriptor instead.
-func (*GetGameServerMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GetGameServerLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetGameServerLogsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetGameServerMetricsResponse) GetMetrics() []*GameServerMetric {
+func (x *GetGameServerLogsRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Metrics
+		return x.GameServerId
+	}
+	return ""
+}
+
+func (x *GetGameServerLogsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetGameServerLogsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
 	}
 	return nil
 }
 
-type StreamGameServerMetricsRequest struct {
+func (x *GetGameServerLogsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *GetGameServerLogsRequest) GetSearchQuery() string {
+	if x != nil && x.SearchQuery != nil {
+		return *x.SearchQuery
+	}
+	return ""
+}
+
+type GetGameServerLogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Lines         []*GameServerLogLine   `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamGameServerMetricsRequest) Reset() {
-	*x = StreamGameServerMetricsRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[35]
+func (x *GetGameServerLogsResponse) Reset() {
+	*x = GetGameServerLogsResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamGameServerMetricsRequest) String() string {
+func (x *GetGameServerLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamGameServerMetricsRequest) ProtoMessage() {}
+func (*GetGameServerLogsResponse) ProtoMessage() {}
 
-func (x *StreamGameServerMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[35]
+func (x *GetGameServerLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,50 +2567,45 @@ func (x *StreamGameServerMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamGameServerMetricsRequest.ProtoReflect.Descriptor instead.
-func (*StreamGameServerMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetGameServerLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetGameServerLogsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *StreamGameServerMetricsRequest) GetGameServerId() string {
+func (x *GetGameServerLogsResponse) GetLines() []*GameServerLogLine {
 	if x != nil {
-		return x.GameServerId
+		return x.Lines
 	}
-	return ""
+	return nil
 }
 
-type GameServerMetric struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId     string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Timestamp        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	CpuUsagePercent  *float64               `protobuf:"fixed64,3,opt,name=cpu_usage_percent,json=cpuUsagePercent,proto3,oneof" json:"cpu_usage_percent,omitempty"`   // CPU usage percentage (0-100)
-	MemoryUsageBytes *int64                 `protobuf:"varint,4,opt,name=memory_usage_bytes,json=memoryUsageBytes,proto3,oneof" json:"memory_usage_bytes,omitempty"` // Current memory usage in bytes
-	MemoryLimitBytes *int64                 `protobuf:"varint,5,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3,oneof" json:"memory_limit_bytes,omitempty"` // Memory limit in bytes
-	NetworkRxBytes   *int64                 `protobuf:"varint,6,opt,name=network_rx_bytes,json=networkRxBytes,proto3,oneof" json:"network_rx_bytes,omitempty"`       // Network received bytes
-	NetworkTxBytes   *int64                 `protobuf:"varint,7,opt,name=network_tx_bytes,json=networkTxBytes,proto3,oneof" json:"network_tx_bytes,omitempty"`       // Network transmitted bytes
-	DiskReadBytes    *int64                 `protobuf:"varint,10,opt,name=disk_read_bytes,json=diskReadBytes,proto3,oneof" json:"disk_read_bytes,omitempty"`         // Disk read bytes
-	DiskWriteBytes   *int64                 `protobuf:"varint,11,opt,name=disk_write_bytes,json=diskWriteBytes,proto3,oneof" json:"disk_write_bytes,omitempty"`      // Disk write bytes
-	PlayerCount      *int32                 `protobuf:"varint,8,opt,name=player_count,json=playerCount,proto3,oneof" json:"player_count,omitempty"`                  // Current player count (if available)
-	MaxPlayers       *int32                 `protobuf:"varint,9,opt,name=max_players,json=maxPlayers,proto3,oneof" json:"max_players,omitempty"`                     // Max player count (if available)
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type StreamGameServerLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Follow        *bool                  `protobuf:"varint,2,opt,name=follow,proto3,oneof" json:"follow,omitempty"`                             // Follow logs (like tail -f, default: true)
+	Tail          *int32                 `protobuf:"varint,3,opt,name=tail,proto3,oneof" json:"tail,omitempty"`                                 // Number of lines to tail (default: 100)
+	Since         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3,oneof" json:"since,omitempty"`                                // Get historical logs since this timestamp
+	Until         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3,oneof" json:"until,omitempty"`                                // Get historical logs until this timestamp (for pagination)
+	SearchQuery   *string                `protobuf:"bytes,6,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"` // Search query to filter logs (case-insensitive substring match)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameServerMetric) Reset() {
-	*x = GameServerMetric{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[36]
+func (x *StreamGameServerLogsRequest) Reset() {
+	*x = StreamGameServerLogsRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServerMetric) String() string {
+func (x *StreamGameServerLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServerMetric) ProtoMessage() {}
+func (*StreamGameServerLogsRequest) ProtoMessage() {}
 
-func (x *GameServerMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[36]
+func (x *StreamGameServerLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2452,113 +2616,146 @@ func (x *GameServerMetric) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServerMetric.ProtoReflect.Descriptor instead.
-func (*GameServerMetric) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use StreamGameServerLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamGameServerLogsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GameServerMetric) GetGameServerId() string {
+func (x *StreamGameServerLogsRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GameServerMetric) GetTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Timestamp
+func (x *StreamGameServerLogsRequest) GetFollow() bool {
+	if x != nil && x.Follow != nil {
+		return *x.Follow
 	}
-	return nil
+	return false
 }
 
-func (x *GameServerMetric) GetCpuUsagePercent() float64 {
-	if x != nil && x.CpuUsagePercent != nil {
-		return *x.CpuUsagePercent
+func (x *StreamGameServerLogsRequest) GetTail() int32 {
+	if x != nil && x.Tail != nil {
+		return *x.Tail
 	}
 	return 0
 }
 
-func (x *GameServerMetric) GetMemoryUsageBytes() int64 {
-	if x != nil && x.MemoryUsageBytes != nil {
-		return *x.MemoryUsageBytes
+func (x *StreamGameServerLogsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
 	}
-	return 0
+	return nil
 }
 
-func (x *GameServerMetric) GetMemoryLimitBytes() int64 {
-	if x != nil && x.MemoryLimitBytes != nil {
-		return *x.MemoryLimitBytes
+func (x *StreamGameServerLogsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
 	}
-	return 0
+	return nil
 }
 
-func (x *GameServerMetric) GetNetworkRxBytes() int64 {
-	if x != nil && x.NetworkRxBytes != nil {
-		return *x.NetworkRxBytes
+func (x *StreamGameServerLogsRequest) GetSearchQuery() string {
+	if x != nil && x.SearchQuery != nil {
+		return *x.SearchQuery
 	}
-	return 0
+	return ""
 }
 
-func (x *GameServerMetric) GetNetworkTxBytes() int64 {
-	if x != nil && x.NetworkTxBytes != nil {
-		return *x.NetworkTxBytes
-	}
-	return 0
+type GameServerLogLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Level         *v1.LogLevel           `protobuf:"varint,3,opt,name=level,proto3,enum=obiente.cloud.common.v1.LogLevel,oneof" json:"level,omitempty"`
+	Stderr        bool                   `protobuf:"varint,4,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameServerMetric) GetDiskReadBytes() int64 {
-	if x != nil && x.DiskReadBytes != nil {
-		return *x.DiskReadBytes
-	}
-	return 0
+func (x *GameServerLogLine) Reset() {
+	*x = GameServerLogLine{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServerMetric) GetDiskWriteBytes() int64 {
-	if x != nil && x.DiskWriteBytes != nil {
-		return *x.DiskWriteBytes
-	}
-	return 0
+func (x *GameServerLogLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GameServerMetric) GetPlayerCount() int32 {
-	if x != nil && x.PlayerCount != nil {
-		return *x.PlayerCount
-	}
-	return 0
-}
+func (*GameServerLogLine) ProtoMessage() {}
 
-func (x *GameServerMetric) GetMaxPlayers() int32 {
-	if x != nil && x.MaxPlayers != nil {
-		return *x.MaxPlayers
+func (x *GameServerLogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type GetGameServerUsageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId   string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	// Optional: specify a month (YYYY-MM format). Defaults to current month.
-	Month         *string `protobuf:"bytes,3,opt,name=month,proto3,oneof" json:"month,omitempty"`
+// Deprecated: Use GameServerLogLine.ProtoReflect.Descriptor instead.
+func (*GameServerLogLine) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GameServerLogLine) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *GameServerLogLine) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *GameServerLogLine) GetLevel() v1.LogLevel {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return v1.LogLevel(0)
+}
+
+func (x *GameServerLogLine) GetStderr() bool {
+	if x != nil {
+		return x.Stderr
+	}
+	return false
+}
+
+type GetGameServerMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	Aggregation   *string                `protobuf:"bytes,4,opt,name=aggregation,proto3,oneof" json:"aggregation,omitempty"` // "1m", "5m", "1h", etc.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameServerUsageRequest) Reset() {
-	*x = GetGameServerUsageRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[37]
+func (x *GetGameServerMetricsRequest) Reset() {
+	*x = GetGameServerMetricsRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerUsageRequest) String() string {
+func (x *GetGameServerMetricsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameServerUsageRequest) ProtoMessage() {}
+func (*GetGameServerMetricsRequest) ProtoMessage() {}
 
-func (x *GetGameServerUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[37]
+func (x *GetGameServerMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2569,58 +2766,61 @@ func (x *GetGameServerUsageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameServerUsageRequest.ProtoReflect.Descriptor instead.
-func (*GetGameServerUsageRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetGameServerMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetGameServerMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetGameServerUsageRequest) GetGameServerId() string {
+func (x *GetGameServerMetricsRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GetGameServerUsageRequest) GetOrganizationId() string {
+func (x *GetGameServerMetricsRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OrganizationId
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-func (x *GetGameServerUsageRequest) GetMonth() string {
-	if x != nil && x.Month != nil {
-		return *x.Month
+func (x *GetGameServerMetricsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetGameServerMetricsRequest) GetAggregation() string {
+	if x != nil && x.Aggregation != nil {
+		return *x.Aggregation
 	}
 	return ""
 }
 
-type GetGameServerUsageResponse struct {
-	state            protoimpl.MessageState  `protogen:"open.v1"`
-	GameServerId     string                  `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	OrganizationId   string                  `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Month            string                  `protobuf:"bytes,3,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM format
-	Current          *GameServerUsageMetrics `protobuf:"bytes,4,opt,name=current,proto3" json:"current,omitempty"`
-	EstimatedMonthly *GameServerUsageMetrics `protobuf:"bytes,5,opt,name=estimated_monthly,json=estimatedMonthly,proto3" json:"estimated_monthly,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GetGameServerMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       []*GameServerMetric    `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameServerUsageResponse) Reset() {
-	*x = GetGameServerUsageResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[38]
+func (x *GetGameServerMetricsResponse) Reset() {
+	*x = GetGameServerMetricsResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerUsageResponse) String() string {
+func (x *GetGameServerMetricsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameServerUsageResponse) ProtoMessage() {}
+func (*GetGameServerMetricsResponse) ProtoMessage() {}
 
-func (x *GetGameServerUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[38]
+func (x *GetGameServerMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2631,80 +2831,95 @@ func (x *GetGameServerUsageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameServerUsageResponse.ProtoReflect.Descriptor instead.
-func (*GetGameServerUsageResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetGameServerMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetGameServerMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetGameServerUsageResponse) GetGameServerId() string {
+func (x *GetGameServerMetricsResponse) GetMetrics() []*GameServerMetric {
 	if x != nil {
-		return x.GameServerId
+		return x.Metrics
 	}
-	return ""
+	return nil
 }
 
-func (x *GetGameServerUsageResponse) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
+type StreamGameServerMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameServerUsageResponse) GetMonth() string {
-	if x != nil {
-		return x.Month
-	}
-	return ""
+func (x *StreamGameServerMetricsRequest) Reset() {
+	*x = StreamGameServerMetricsRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerUsageResponse) GetCurrent() *GameServerUsageMetrics {
+func (x *StreamGameServerMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamGameServerMetricsRequest) ProtoMessage() {}
+
+func (x *StreamGameServerMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[42]
 	if x != nil {
-		return x.Current
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetGameServerUsageResponse) GetEstimatedMonthly() *GameServerUsageMetrics {
+// Deprecated: Use StreamGameServerMetricsRequest.ProtoReflect.Descriptor instead.
+func (*StreamGameServerMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StreamGameServerMetricsRequest) GetGameServerId() string {
 	if x != nil {
-		return x.EstimatedMonthly
+		return x.GameServerId
 	}
-	return nil
+	return ""
 }
 
-type GameServerUsageMetrics struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	CpuCoreSeconds    int64                  `protobuf:"varint,1,opt,name=cpu_core_seconds,json=cpuCoreSeconds,proto3" json:"cpu_core_seconds,omitempty"`
-	MemoryByteSeconds int64                  `protobuf:"varint,2,opt,name=memory_byte_seconds,json=memoryByteSeconds,proto3" json:"memory_byte_seconds,omitempty"`
-	BandwidthRxBytes  int64                  `protobuf:"varint,3,opt,name=bandwidth_rx_bytes,json=bandwidthRxBytes,proto3" json:"bandwidth_rx_bytes,omitempty"`
-	BandwidthTxBytes  int64                  `protobuf:"varint,4,opt,name=bandwidth_tx_bytes,json=bandwidthTxBytes,proto3" json:"bandwidth_tx_bytes,omitempty"`
-	StorageBytes      int64                  `protobuf:"varint,5,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
-	UptimeSeconds     int64                  `protobuf:"varint,6,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
-	// Estimated cost in cents
-	EstimatedCostCents int64 `protobuf:"varint,7,opt,name=estimated_cost_cents,json=estimatedCostCents,proto3" json:"estimated_cost_cents,omitempty"`
-	// Per-resource cost breakdown in cents
-	CpuCostCents       *int64 `protobuf:"varint,8,opt,name=cpu_cost_cents,json=cpuCostCents,proto3,oneof" json:"cpu_cost_cents,omitempty"`
-	MemoryCostCents    *int64 `protobuf:"varint,9,opt,name=memory_cost_cents,json=memoryCostCents,proto3,oneof" json:"memory_cost_cents,omitempty"`
-	BandwidthCostCents *int64 `protobuf:"varint,10,opt,name=bandwidth_cost_cents,json=bandwidthCostCents,proto3,oneof" json:"bandwidth_cost_cents,omitempty"`
-	StorageCostCents   *int64 `protobuf:"varint,11,opt,name=storage_cost_cents,json=storageCostCents,proto3,oneof" json:"storage_cost_cents,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GameServerMetric struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId     string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Timestamp        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CpuUsagePercent  *float64               `protobuf:"fixed64,3,opt,name=cpu_usage_percent,json=cpuUsagePercent,proto3,oneof" json:"cpu_usage_percent,omitempty"`   // CPU usage percentage (0-100)
+	MemoryUsageBytes *int64                 `protobuf:"varint,4,opt,name=memory_usage_bytes,json=memoryUsageBytes,proto3,oneof" json:"memory_usage_bytes,omitempty"` // Current memory usage in bytes
+	MemoryLimitBytes *int64                 `protobuf:"varint,5,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3,oneof" json:"memory_limit_bytes,omitempty"` // Memory limit in bytes
+	NetworkRxBytes   *int64                 `protobuf:"varint,6,opt,name=network_rx_bytes,json=networkRxBytes,proto3,oneof" json:"network_rx_bytes,omitempty"`       // Network received bytes
+	NetworkTxBytes   *int64                 `protobuf:"varint,7,opt,name=network_tx_bytes,json=networkTxBytes,proto3,oneof" json:"network_tx_bytes,omitempty"`       // Network transmitted bytes
+	DiskReadBytes    *int64                 `protobuf:"varint,10,opt,name=disk_read_bytes,json=diskReadBytes,proto3,oneof" json:"disk_read_bytes,omitempty"`         // Disk read bytes
+	DiskWriteBytes   *int64                 `protobuf:"varint,11,opt,name=disk_write_bytes,json=diskWriteBytes,proto3,oneof" json:"disk_write_bytes,omitempty"`      // Disk write bytes
+	PlayerCount      *int32                 `protobuf:"varint,8,opt,name=player_count,json=playerCount,proto3,oneof" json:"player_count,omitempty"`                  // Current player count (if available)
+	MaxPlayers       *int32                 `protobuf:"varint,9,opt,name=max_players,json=maxPlayers,proto3,oneof" json:"max_players,omitempty"`                     // Max player count (if available)
+	Tps              *float64               `protobuf:"fixed64,12,opt,name=tps,proto3,oneof" json:"tps,omitempty"`                                                   // Ticks per second sampled over RCON (Minecraft servers with /tps)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GameServerUsageMetrics) Reset() {
-	*x = GameServerUsageMetrics{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[39]
+func (x *GameServerMetric) Reset() {
+	*x = GameServerMetric{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServerUsageMetrics) String() string {
+func (x *GameServerMetric) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServerUsageMetrics) ProtoMessage() {}
+func (*GameServerMetric) ProtoMessage() {}
 
-func (x *GameServerUsageMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[39]
+func (x *GameServerMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2715,142 +2930,120 @@ func (x *GameServerUsageMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServerUsageMetrics.ProtoReflect.Descriptor instead.
-func (*GameServerUsageMetrics) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GameServerMetric.ProtoReflect.Descriptor instead.
+func (*GameServerMetric) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GameServerUsageMetrics) GetCpuCoreSeconds() int64 {
+func (x *GameServerMetric) GetGameServerId() string {
 	if x != nil {
-		return x.CpuCoreSeconds
+		return x.GameServerId
 	}
-	return 0
+	return ""
 }
 
-func (x *GameServerUsageMetrics) GetMemoryByteSeconds() int64 {
+func (x *GameServerMetric) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MemoryByteSeconds
+		return x.Timestamp
 	}
-	return 0
+	return nil
 }
 
-func (x *GameServerUsageMetrics) GetBandwidthRxBytes() int64 {
-	if x != nil {
-		return x.BandwidthRxBytes
+func (x *GameServerMetric) GetCpuUsagePercent() float64 {
+	if x != nil && x.CpuUsagePercent != nil {
+		return *x.CpuUsagePercent
 	}
 	return 0
 }
 
-func (x *GameServerUsageMetrics) GetBandwidthTxBytes() int64 {
-	if x != nil {
-		return x.BandwidthTxBytes
+func (x *GameServerMetric) GetMemoryUsageBytes() int64 {
+	if x != nil && x.MemoryUsageBytes != nil {
+		return *x.MemoryUsageBytes
 	}
 	return 0
 }
 
-func (x *GameServerUsageMetrics) GetStorageBytes() int64 {
-	if x != nil {
-		return x.StorageBytes
+func (x *GameServerMetric) GetMemoryLimitBytes() int64 {
+	if x != nil && x.MemoryLimitBytes != nil {
+		return *x.MemoryLimitBytes
 	}
 	return 0
 }
 
-func (x *GameServerUsageMetrics) GetUptimeSeconds() int64 {
-	if x != nil {
-		return x.UptimeSeconds
+func (x *GameServerMetric) GetNetworkRxBytes() int64 {
+	if x != nil && x.NetworkRxBytes != nil {
+		return *x.NetworkRxBytes
 	}
 	return 0
 }
 
-func (x *GameServerUsageMetrics) GetEstimatedCostCents() int64 {
-	if x != nil {
-		return x.EstimatedCostCents
+func (x *GameServerMetric) GetNetworkTxBytes() int64 {
+	if x != nil && x.NetworkTxBytes != nil {
+		return *x.NetworkTxBytes
 	}
 	return 0
 }
 
-func (x *GameServerUsageMetrics) GetCpuCostCents() int64 {
-	if x != nil && x.CpuCostCents != nil {
-		return *x.CpuCostCents
+func (x *GameServerMetric) GetDiskReadBytes() int64 {
+	if x != nil && x.DiskReadBytes != nil {
+		return *x.DiskReadBytes
 	}
 	return 0
 }
 
-func (x *GameServerUsageMetrics) GetMemoryCostCents() int64 {
-	if x != nil && x.MemoryCostCents != nil {
-		return *x.MemoryCostCents
+func (x *GameServerMetric) GetDiskWriteBytes() int64 {
+	if x != nil && x.DiskWriteBytes != nil {
+		return *x.DiskWriteBytes
 	}
 	return 0
 }
 
-func (x *GameServerUsageMetrics) GetBandwidthCostCents() int64 {
-	if x != nil && x.BandwidthCostCents != nil {
-		return *x.BandwidthCostCents
+func (x *GameServerMetric) GetPlayerCount() int32 {
+	if x != nil && x.PlayerCount != nil {
+		return *x.PlayerCount
 	}
 	return 0
 }
 
-func (x *GameServerUsageMetrics) GetStorageCostCents() int64 {
-	if x != nil && x.StorageCostCents != nil {
-		return *x.StorageCostCents
+func (x *GameServerMetric) GetMaxPlayers() int32 {
+	if x != nil && x.MaxPlayers != nil {
+		return *x.MaxPlayers
 	}
 	return 0
 }
 
-// GameServer represents a game server instance
-type GameServer struct {
+func (x *GameServerMetric) GetTps() float64 {
+	if x != nil && x.Tps != nil {
+		return *x.Tps
+	}
+	return 0
+}
+
+type GetGameServerUsageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameServerId   string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description    *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	GameType       GameType               `protobuf:"varint,5,opt,name=game_type,json=gameType,proto3,enum=obiente.cloud.gameservers.v1.GameType" json:"game_type,omitempty"`
-	Status         GameServerStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=obiente.cloud.gameservers.v1.GameServerStatus" json:"status,omitempty"`
-	// Resource configuration
-	MemoryBytes int64   `protobuf:"varint,7,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
-	CpuCores    int32   `protobuf:"varint,8,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
-	Port        int32   `protobuf:"varint,9,opt,name=port,proto3" json:"port,omitempty"`
-	ExtraPorts  []int32 `protobuf:"varint,23,rep,packed,name=extra_ports,json=extraPorts,proto3" json:"extra_ports,omitempty"`
-	// Docker configuration
-	DockerImage  string  `protobuf:"bytes,10,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
-	StartCommand *string `protobuf:"bytes,11,opt,name=start_command,json=startCommand,proto3,oneof" json:"start_command,omitempty"`
-	// Environment variables
-	EnvVars map[string]string `protobuf:"bytes,12,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Game-specific configuration
-	ServerVersion *string `protobuf:"bytes,13,opt,name=server_version,json=serverVersion,proto3,oneof" json:"server_version,omitempty"`
-	// Resource usage (if available)
-	PlayerCount *int32 `protobuf:"varint,14,opt,name=player_count,json=playerCount,proto3,oneof" json:"player_count,omitempty"`
-	MaxPlayers  *int32 `protobuf:"varint,15,opt,name=max_players,json=maxPlayers,proto3,oneof" json:"max_players,omitempty"`
-	// Timestamps
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	LastStartedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=last_started_at,json=lastStartedAt,proto3,oneof" json:"last_started_at,omitempty"`
-	// Container information
-	ContainerId   *string `protobuf:"bytes,19,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"`
-	ContainerName *string `protobuf:"bytes,20,opt,name=container_name,json=containerName,proto3,oneof" json:"container_name,omitempty"`
-	// Storage
-	StorageBytes int64 `protobuf:"varint,21,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
-	// Created by
-	CreatedBy     string `protobuf:"bytes,22,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Optional: specify a month (YYYY-MM format). Defaults to current month.
+	Month         *string `protobuf:"bytes,3,opt,name=month,proto3,oneof" json:"month,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameServer) Reset() {
-	*x = GameServer{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[40]
+func (x *GetGameServerUsageRequest) Reset() {
+	*x = GetGameServerUsageRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServer) String() string {
+func (x *GetGameServerUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServer) ProtoMessage() {}
+func (*GetGameServerUsageRequest) ProtoMessage() {}
 
-func (x *GameServer) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[40]
+func (x *GetGameServerUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2861,208 +3054,1842 @@ func (x *GameServer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServer.ProtoReflect.Descriptor instead.
-func (*GameServer) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetGameServerUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetGameServerUsageRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GameServer) GetId() string {
+func (x *GetGameServerUsageRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Id
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GameServer) GetOrganizationId() string {
+func (x *GetGameServerUsageRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GameServer) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *GetGameServerUsageRequest) GetMonth() string {
+	if x != nil && x.Month != nil {
+		return *x.Month
 	}
 	return ""
 }
 
-func (x *GameServer) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+type GetGameServerUsageResponse struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	GameServerId     string                  `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	OrganizationId   string                  `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Month            string                  `protobuf:"bytes,3,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM format
+	Current          *GameServerUsageMetrics `protobuf:"bytes,4,opt,name=current,proto3" json:"current,omitempty"`
+	EstimatedMonthly *GameServerUsageMetrics `protobuf:"bytes,5,opt,name=estimated_monthly,json=estimatedMonthly,proto3" json:"estimated_monthly,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GameServer) GetGameType() GameType {
-	if x != nil {
-		return x.GameType
-	}
-	return GameType_GAME_TYPE_UNSPECIFIED
+func (x *GetGameServerUsageResponse) Reset() {
+	*x = GetGameServerUsageResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServer) GetStatus() GameServerStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GameServerStatus_GAME_SERVER_STATUS_UNSPECIFIED
+func (x *GetGameServerUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GameServer) GetMemoryBytes() int64 {
-	if x != nil {
-		return x.MemoryBytes
-	}
-	return 0
-}
+func (*GetGameServerUsageResponse) ProtoMessage() {}
 
-func (x *GameServer) GetCpuCores() int32 {
+func (x *GetGameServerUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[45]
 	if x != nil {
-		return x.CpuCores
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GameServer) GetPort() int32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
+// Deprecated: Use GetGameServerUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetGameServerUsageResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GameServer) GetExtraPorts() []int32 {
+func (x *GetGameServerUsageResponse) GetGameServerId() string {
 	if x != nil {
-		return x.ExtraPorts
+		return x.GameServerId
 	}
-	return nil
+	return ""
 }
 
-func (x *GameServer) GetDockerImage() string {
+func (x *GetGameServerUsageResponse) GetOrganizationId() string {
 	if x != nil {
-		return x.DockerImage
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GameServer) GetStartCommand() string {
-	if x != nil && x.StartCommand != nil {
-		return *x.StartCommand
+func (x *GetGameServerUsageResponse) GetMonth() string {
+	if x != nil {
+		return x.Month
 	}
 	return ""
 }
 
-func (x *GameServer) GetEnvVars() map[string]string {
+func (x *GetGameServerUsageResponse) GetCurrent() *GameServerUsageMetrics {
 	if x != nil {
-		return x.EnvVars
+		return x.Current
 	}
 	return nil
 }
 
-func (x *GameServer) GetServerVersion() string {
-	if x != nil && x.ServerVersion != nil {
-		return *x.ServerVersion
+func (x *GetGameServerUsageResponse) GetEstimatedMonthly() *GameServerUsageMetrics {
+	if x != nil {
+		return x.EstimatedMonthly
 	}
-	return ""
+	return nil
 }
 
-func (x *GameServer) GetPlayerCount() int32 {
-	if x != nil && x.PlayerCount != nil {
-		return *x.PlayerCount
-	}
-	return 0
+type GameServerUsageMetrics struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CpuCoreSeconds    int64                  `protobuf:"varint,1,opt,name=cpu_core_seconds,json=cpuCoreSeconds,proto3" json:"cpu_core_seconds,omitempty"`
+	MemoryByteSeconds int64                  `protobuf:"varint,2,opt,name=memory_byte_seconds,json=memoryByteSeconds,proto3" json:"memory_byte_seconds,omitempty"`
+	BandwidthRxBytes  int64                  `protobuf:"varint,3,opt,name=bandwidth_rx_bytes,json=bandwidthRxBytes,proto3" json:"bandwidth_rx_bytes,omitempty"`
+	BandwidthTxBytes  int64                  `protobuf:"varint,4,opt,name=bandwidth_tx_bytes,json=bandwidthTxBytes,proto3" json:"bandwidth_tx_bytes,omitempty"`
+	StorageBytes      int64                  `protobuf:"varint,5,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	UptimeSeconds     int64                  `protobuf:"varint,6,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	// Estimated cost in cents
+	EstimatedCostCents int64 `protobuf:"varint,7,opt,name=estimated_cost_cents,json=estimatedCostCents,proto3" json:"estimated_cost_cents,omitempty"`
+	// Per-resource cost breakdown in cents
+	CpuCostCents       *int64 `protobuf:"varint,8,opt,name=cpu_cost_cents,json=cpuCostCents,proto3,oneof" json:"cpu_cost_cents,omitempty"`
+	MemoryCostCents    *int64 `protobuf:"varint,9,opt,name=memory_cost_cents,json=memoryCostCents,proto3,oneof" json:"memory_cost_cents,omitempty"`
+	BandwidthCostCents *int64 `protobuf:"varint,10,opt,name=bandwidth_cost_cents,json=bandwidthCostCents,proto3,oneof" json:"bandwidth_cost_cents,omitempty"`
+	StorageCostCents   *int64 `protobuf:"varint,11,opt,name=storage_cost_cents,json=storageCostCents,proto3,oneof" json:"storage_cost_cents,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GameServer) GetMaxPlayers() int32 {
-	if x != nil && x.MaxPlayers != nil {
-		return *x.MaxPlayers
-	}
-	return 0
+func (x *GameServerUsageMetrics) Reset() {
+	*x = GameServerUsageMetrics{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServer) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *GameServerUsageMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GameServer) GetUpdatedAt() *timestamppb.Timestamp {
+func (*GameServerUsageMetrics) ProtoMessage() {}
+
+func (x *GameServerUsageMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[46]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GameServer) GetLastStartedAt() *timestamppb.Timestamp {
+// Deprecated: Use GameServerUsageMetrics.ProtoReflect.Descriptor instead.
+func (*GameServerUsageMetrics) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GameServerUsageMetrics) GetCpuCoreSeconds() int64 {
 	if x != nil {
-		return x.LastStartedAt
+		return x.CpuCoreSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *GameServer) GetContainerId() string {
-	if x != nil && x.ContainerId != nil {
-		return *x.ContainerId
+func (x *GameServerUsageMetrics) GetMemoryByteSeconds() int64 {
+	if x != nil {
+		return x.MemoryByteSeconds
+	}
+	return 0
+}
+
+func (x *GameServerUsageMetrics) GetBandwidthRxBytes() int64 {
+	if x != nil {
+		return x.BandwidthRxBytes
+	}
+	return 0
+}
+
+func (x *GameServerUsageMetrics) GetBandwidthTxBytes() int64 {
+	if x != nil {
+		return x.BandwidthTxBytes
+	}
+	return 0
+}
+
+func (x *GameServerUsageMetrics) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *GameServerUsageMetrics) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *GameServerUsageMetrics) GetEstimatedCostCents() int64 {
+	if x != nil {
+		return x.EstimatedCostCents
+	}
+	return 0
+}
+
+func (x *GameServerUsageMetrics) GetCpuCostCents() int64 {
+	if x != nil && x.CpuCostCents != nil {
+		return *x.CpuCostCents
+	}
+	return 0
+}
+
+func (x *GameServerUsageMetrics) GetMemoryCostCents() int64 {
+	if x != nil && x.MemoryCostCents != nil {
+		return *x.MemoryCostCents
+	}
+	return 0
+}
+
+func (x *GameServerUsageMetrics) GetBandwidthCostCents() int64 {
+	if x != nil && x.BandwidthCostCents != nil {
+		return *x.BandwidthCostCents
+	}
+	return 0
+}
+
+func (x *GameServerUsageMetrics) GetStorageCostCents() int64 {
+	if x != nil && x.StorageCostCents != nil {
+		return *x.StorageCostCents
+	}
+	return 0
+}
+
+// GameServer represents a game server instance
+type GameServer struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description    *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	GameType       GameType               `protobuf:"varint,5,opt,name=game_type,json=gameType,proto3,enum=obiente.cloud.gameservers.v1.GameType" json:"game_type,omitempty"`
+	Status         GameServerStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=obiente.cloud.gameservers.v1.GameServerStatus" json:"status,omitempty"`
+	// Resource configuration
+	MemoryBytes int64   `protobuf:"varint,7,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	CpuCores    int32   `protobuf:"varint,8,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	Port        int32   `protobuf:"varint,9,opt,name=port,proto3" json:"port,omitempty"`
+	ExtraPorts  []int32 `protobuf:"varint,23,rep,packed,name=extra_ports,json=extraPorts,proto3" json:"extra_ports,omitempty"`
+	// Docker configuration
+	DockerImage  string  `protobuf:"bytes,10,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
+	StartCommand *string `protobuf:"bytes,11,opt,name=start_command,json=startCommand,proto3,oneof" json:"start_command,omitempty"`
+	// Environment variables
+	EnvVars map[string]string `protobuf:"bytes,12,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Game-specific configuration
+	ServerVersion *string `protobuf:"bytes,13,opt,name=server_version,json=serverVersion,proto3,oneof" json:"server_version,omitempty"`
+	// Resource usage (if available)
+	PlayerCount *int32 `protobuf:"varint,14,opt,name=player_count,json=playerCount,proto3,oneof" json:"player_count,omitempty"`
+	MaxPlayers  *int32 `protobuf:"varint,15,opt,name=max_players,json=maxPlayers,proto3,oneof" json:"max_players,omitempty"`
+	// Timestamps
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LastStartedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=last_started_at,json=lastStartedAt,proto3,oneof" json:"last_started_at,omitempty"`
+	// Container information
+	ContainerId   *string `protobuf:"bytes,19,opt,name=container_id,json=containerId,proto3,oneof" json:"container_id,omitempty"`
+	ContainerName *string `protobuf:"bytes,20,opt,name=container_name,json=containerName,proto3,oneof" json:"container_name,omitempty"`
+	// Storage
+	StorageBytes int64 `protobuf:"varint,21,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	// Created by
+	CreatedBy     string `protobuf:"bytes,22,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameServer) Reset() {
+	*x = GameServer{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameServer) ProtoMessage() {}
+
+func (x *GameServer) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameServer.ProtoReflect.Descriptor instead.
+func (*GameServer) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GameServer) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GameServer) GetContainerName() string {
-	if x != nil && x.ContainerName != nil {
-		return *x.ContainerName
+func (x *GameServer) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GameServer) GetStorageBytes() int64 {
+func (x *GameServer) GetName() string {
 	if x != nil {
-		return x.StorageBytes
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameServer) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *GameServer) GetGameType() GameType {
+	if x != nil {
+		return x.GameType
+	}
+	return GameType_GAME_TYPE_UNSPECIFIED
+}
+
+func (x *GameServer) GetStatus() GameServerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameServerStatus_GAME_SERVER_STATUS_UNSPECIFIED
+}
+
+func (x *GameServer) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
 	}
 	return 0
 }
 
-func (x *GameServer) GetCreatedBy() string {
+func (x *GameServer) GetCpuCores() int32 {
 	if x != nil {
-		return x.CreatedBy
+		return x.CpuCores
+	}
+	return 0
+}
+
+func (x *GameServer) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *GameServer) GetExtraPorts() []int32 {
+	if x != nil {
+		return x.ExtraPorts
+	}
+	return nil
+}
+
+func (x *GameServer) GetDockerImage() string {
+	if x != nil {
+		return x.DockerImage
+	}
+	return ""
+}
+
+func (x *GameServer) GetStartCommand() string {
+	if x != nil && x.StartCommand != nil {
+		return *x.StartCommand
+	}
+	return ""
+}
+
+func (x *GameServer) GetEnvVars() map[string]string {
+	if x != nil {
+		return x.EnvVars
+	}
+	return nil
+}
+
+func (x *GameServer) GetServerVersion() string {
+	if x != nil && x.ServerVersion != nil {
+		return *x.ServerVersion
 	}
 	return ""
 }
 
-// File system messages (similar to deployments)
-type GameServerFile struct {
+func (x *GameServer) GetPlayerCount() int32 {
+	if x != nil && x.PlayerCount != nil {
+		return *x.PlayerCount
+	}
+	return 0
+}
+
+func (x *GameServer) GetMaxPlayers() int32 {
+	if x != nil && x.MaxPlayers != nil {
+		return *x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *GameServer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GameServer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GameServer) GetLastStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastStartedAt
+	}
+	return nil
+}
+
+func (x *GameServer) GetContainerId() string {
+	if x != nil && x.ContainerId != nil {
+		return *x.ContainerId
+	}
+	return ""
+}
+
+func (x *GameServer) GetContainerName() string {
+	if x != nil && x.ContainerName != nil {
+		return *x.ContainerName
+	}
+	return ""
+}
+
+func (x *GameServer) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *GameServer) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// File system messages (similar to deployments)
+type GameServerFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	IsDirectory   bool                   `protobuf:"varint,3,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Permissions   string                 `protobuf:"bytes,5,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	VolumeName    *string                `protobuf:"bytes,6,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"` // If this file is in a volume, the volume name
+	Owner         *string                `protobuf:"bytes,7,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	Group         *string                `protobuf:"bytes,8,opt,name=group,proto3,oneof" json:"group,omitempty"`
+	ModeOctal     *uint32                `protobuf:"varint,9,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
+	IsSymlink     *bool                  `protobuf:"varint,10,opt,name=is_symlink,json=isSymlink,proto3,oneof" json:"is_symlink,omitempty"`
+	SymlinkTarget *string                `protobuf:"bytes,11,opt,name=symlink_target,json=symlinkTarget,proto3,oneof" json:"symlink_target,omitempty"`
+	MimeType      *string                `protobuf:"bytes,12,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
+	ModifiedTime  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=modified_time,json=modifiedTime,proto3,oneof" json:"modified_time,omitempty"`
+	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_time,json=createdTime,proto3,oneof" json:"created_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameServerFile) Reset() {
+	*x = GameServerFile{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameServerFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameServerFile) ProtoMessage() {}
+
+func (x *GameServerFile) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameServerFile.ProtoReflect.Descriptor instead.
+func (*GameServerFile) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GameServerFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameServerFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GameServerFile) GetIsDirectory() bool {
+	if x != nil {
+		return x.IsDirectory
+	}
+	return false
+}
+
+func (x *GameServerFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GameServerFile) GetPermissions() string {
+	if x != nil {
+		return x.Permissions
+	}
+	return ""
+}
+
+func (x *GameServerFile) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *GameServerFile) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *GameServerFile) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *GameServerFile) GetModeOctal() uint32 {
+	if x != nil && x.ModeOctal != nil {
+		return *x.ModeOctal
+	}
+	return 0
+}
+
+func (x *GameServerFile) GetIsSymlink() bool {
+	if x != nil && x.IsSymlink != nil {
+		return *x.IsSymlink
+	}
+	return false
+}
+
+func (x *GameServerFile) GetSymlinkTarget() string {
+	if x != nil && x.SymlinkTarget != nil {
+		return *x.SymlinkTarget
+	}
+	return ""
+}
+
+func (x *GameServerFile) GetMimeType() string {
+	if x != nil && x.MimeType != nil {
+		return *x.MimeType
+	}
+	return ""
+}
+
+func (x *GameServerFile) GetModifiedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModifiedTime
+	}
+	return nil
+}
+
+func (x *GameServerFile) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+type GameServerVolumeInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // Volume name
+	MountPoint    string                 `protobuf:"bytes,2,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`        // Mount point inside container (e.g., "/data")
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                                  // Volume source path on host
+	IsPersistent  bool                   `protobuf:"varint,4,opt,name=is_persistent,json=isPersistent,proto3" json:"is_persistent,omitempty"` // Whether this is a persistent volume
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameServerVolumeInfo) Reset() {
+	*x = GameServerVolumeInfo{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameServerVolumeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameServerVolumeInfo) ProtoMessage() {}
+
+func (x *GameServerVolumeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameServerVolumeInfo.ProtoReflect.Descriptor instead.
+func (*GameServerVolumeInfo) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GameServerVolumeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameServerVolumeInfo) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *GameServerVolumeInfo) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GameServerVolumeInfo) GetIsPersistent() bool {
+	if x != nil {
+		return x.IsPersistent
+	}
+	return false
+}
+
+type ListGameServerFilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                                         // Directory path (default: "/")
+	VolumeName    *string                `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`     // If specified, list files from this volume instead of container filesystem
+	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`                               // Pagination cursor
+	PageSize      *int32                 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`          // Number of files per page
+	ListVolumes   *bool                  `protobuf:"varint,6,opt,name=list_volumes,json=listVolumes,proto3,oneof" json:"list_volumes,omitempty"` // If true, only return available volumes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGameServerFilesRequest) Reset() {
+	*x = ListGameServerFilesRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGameServerFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameServerFilesRequest) ProtoMessage() {}
+
+func (x *ListGameServerFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGameServerFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListGameServerFilesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListGameServerFilesRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
+	}
+	return ""
+}
+
+func (x *ListGameServerFilesRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ListGameServerFilesRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *ListGameServerFilesRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListGameServerFilesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGameServerFilesRequest) GetListVolumes() bool {
+	if x != nil && x.ListVolumes != nil {
+		return *x.ListVolumes
+	}
+	return false
+}
+
+type ListGameServerFilesResponse struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Files            []*GameServerFile       `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	CurrentPath      string                  `protobuf:"bytes,2,opt,name=current_path,json=currentPath,proto3" json:"current_path,omitempty"`
+	Volumes          []*GameServerVolumeInfo `protobuf:"bytes,3,rep,name=volumes,proto3" json:"volumes,omitempty"`                                            // Available persistent volumes (only when list_volumes=true)
+	IsVolume         bool                    `protobuf:"varint,4,opt,name=is_volume,json=isVolume,proto3" json:"is_volume,omitempty"`                         // Whether the current listing is from a volume
+	ContainerRunning bool                    `protobuf:"varint,5,opt,name=container_running,json=containerRunning,proto3" json:"container_running,omitempty"` // Whether the container is currently running
+	HasMore          bool                    `protobuf:"varint,6,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor       *string                 `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListGameServerFilesResponse) Reset() {
+	*x = ListGameServerFilesResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGameServerFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameServerFilesResponse) ProtoMessage() {}
+
+func (x *ListGameServerFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGameServerFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListGameServerFilesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListGameServerFilesResponse) GetFiles() []*GameServerFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ListGameServerFilesResponse) GetCurrentPath() string {
+	if x != nil {
+		return x.CurrentPath
+	}
+	return ""
+}
+
+func (x *ListGameServerFilesResponse) GetVolumes() []*GameServerVolumeInfo {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *ListGameServerFilesResponse) GetIsVolume() bool {
+	if x != nil {
+		return x.IsVolume
+	}
+	return false
+}
+
+func (x *ListGameServerFilesResponse) GetContainerRunning() bool {
+	if x != nil {
+		return x.ContainerRunning
+	}
+	return false
+}
+
+func (x *ListGameServerFilesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListGameServerFilesResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type SearchGameServerFilesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId    string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Query           string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                                                   // Search query (searches in file and directory names, case-insensitive)
+	RootPath        *string                `protobuf:"bytes,3,opt,name=root_path,json=rootPath,proto3,oneof" json:"root_path,omitempty"`                       // Root path to search from (default: "/")
+	VolumeName      *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`                 // If specified, search in this volume instead of container filesystem
+	MaxResults      *int32                 `protobuf:"varint,5,opt,name=max_results,json=maxResults,proto3,oneof" json:"max_results,omitempty"`                // Maximum number of results to return (default: 100)
+	FilesOnly       *bool                  `protobuf:"varint,6,opt,name=files_only,json=filesOnly,proto3,oneof" json:"files_only,omitempty"`                   // If true, only return files (exclude directories)
+	DirectoriesOnly *bool                  `protobuf:"varint,7,opt,name=directories_only,json=directoriesOnly,proto3,oneof" json:"directories_only,omitempty"` // If true, only return directories (exclude files)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchGameServerFilesRequest) Reset() {
+	*x = SearchGameServerFilesRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchGameServerFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGameServerFilesRequest) ProtoMessage() {}
+
+func (x *SearchGameServerFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGameServerFilesRequest.ProtoReflect.Descriptor instead.
+func (*SearchGameServerFilesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SearchGameServerFilesRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
+	}
+	return ""
+}
+
+func (x *SearchGameServerFilesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchGameServerFilesRequest) GetRootPath() string {
+	if x != nil && x.RootPath != nil {
+		return *x.RootPath
+	}
+	return ""
+}
+
+func (x *SearchGameServerFilesRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *SearchGameServerFilesRequest) GetMaxResults() int32 {
+	if x != nil && x.MaxResults != nil {
+		return *x.MaxResults
+	}
+	return 0
+}
+
+func (x *SearchGameServerFilesRequest) GetFilesOnly() bool {
+	if x != nil && x.FilesOnly != nil {
+		return *x.FilesOnly
+	}
+	return false
+}
+
+func (x *SearchGameServerFilesRequest) GetDirectoriesOnly() bool {
+	if x != nil && x.DirectoriesOnly != nil {
+		return *x.DirectoriesOnly
+	}
+	return false
+}
+
+type SearchGameServerFilesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Results          []*GameServerFile      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`                                            // Matching files and directories
+	TotalFound       int32                  `protobuf:"varint,2,opt,name=total_found,json=totalFound,proto3" json:"total_found,omitempty"`                   // Total number of matches found
+	HasMore          bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`                            // Whether there are more results beyond max_results
+	ContainerRunning bool                   `protobuf:"varint,4,opt,name=container_running,json=containerRunning,proto3" json:"container_running,omitempty"` // Whether the container is currently running
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchGameServerFilesResponse) Reset() {
+	*x = SearchGameServerFilesResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchGameServerFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGameServerFilesResponse) ProtoMessage() {}
+
+func (x *SearchGameServerFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGameServerFilesResponse.ProtoReflect.Descriptor instead.
+func (*SearchGameServerFilesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SearchGameServerFilesResponse) GetResults() []*GameServerFile {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchGameServerFilesResponse) GetTotalFound() int32 {
+	if x != nil {
+		return x.TotalFound
+	}
+	return 0
+}
+
+func (x *SearchGameServerFilesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *SearchGameServerFilesResponse) GetContainerRunning() bool {
+	if x != nil {
+		return x.ContainerRunning
+	}
+	return false
+}
+
+type GetGameServerFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                                     // File path
+	VolumeName    *string                `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"` // If specified, read file from this volume instead of container filesystem
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameServerFileRequest) Reset() {
+	*x = GetGameServerFileRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameServerFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameServerFileRequest) ProtoMessage() {}
+
+func (x *GetGameServerFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameServerFileRequest.ProtoReflect.Descriptor instead.
+func (*GetGameServerFileRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetGameServerFileRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
+	}
+	return ""
+}
+
+func (x *GetGameServerFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetGameServerFileRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+type GetGameServerFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Encoding      string                 `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"` // "text" or "base64"
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Truncated     *bool                  `protobuf:"varint,4,opt,name=truncated,proto3,oneof" json:"truncated,omitempty"`
+	Metadata      *GameServerFile        `protobuf:"bytes,5,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameServerFileResponse) Reset() {
+	*x = GetGameServerFileResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameServerFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameServerFileResponse) ProtoMessage() {}
+
+func (x *GetGameServerFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameServerFileResponse.ProtoReflect.Descriptor instead.
+func (*GetGameServerFileResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetGameServerFileResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GetGameServerFileResponse) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *GetGameServerFileResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetGameServerFileResponse) GetTruncated() bool {
+	if x != nil && x.Truncated != nil {
+		return *x.Truncated
+	}
+	return false
+}
+
+func (x *GetGameServerFileResponse) GetMetadata() *GameServerFile {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type UploadGameServerFilesRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Metadata      *UploadGameServerFilesMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`              // Metadata about the upload
+	TarData       []byte                         `protobuf:"bytes,2,opt,name=tar_data,json=tarData,proto3" json:"tar_data,omitempty"` // Complete tar archive containing all files
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadGameServerFilesRequest) Reset() {
+	*x = UploadGameServerFilesRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadGameServerFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadGameServerFilesRequest) ProtoMessage() {}
+
+func (x *UploadGameServerFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadGameServerFilesRequest.ProtoReflect.Descriptor instead.
+func (*UploadGameServerFilesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UploadGameServerFilesRequest) GetMetadata() *UploadGameServerFilesMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UploadGameServerFilesRequest) GetTarData() []byte {
+	if x != nil {
+		return x.TarData
+	}
+	return nil
+}
+
+type UploadGameServerFilesMetadata struct {
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	GameServerId    string                    `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	DestinationPath string                    `protobuf:"bytes,2,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"` // Directory path where files should be extracted (default: "/")
+	Files           []*GameServerFileMetadata `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`                                            // Metadata about files being uploaded
+	VolumeName      *string                   `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`          // If specified, upload files to this volume instead of container filesystem
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UploadGameServerFilesMetadata) Reset() {
+	*x = UploadGameServerFilesMetadata{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadGameServerFilesMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadGameServerFilesMetadata) ProtoMessage() {}
+
+func (x *UploadGameServerFilesMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadGameServerFilesMetadata.ProtoReflect.Descriptor instead.
+func (*UploadGameServerFilesMetadata) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UploadGameServerFilesMetadata) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
+	}
+	return ""
+}
+
+func (x *UploadGameServerFilesMetadata) GetDestinationPath() string {
+	if x != nil {
+		return x.DestinationPath
+	}
+	return ""
+}
+
+func (x *UploadGameServerFilesMetadata) GetFiles() []*GameServerFileMetadata {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *UploadGameServerFilesMetadata) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+type GameServerFileMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                   // File name
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                                  // File size in bytes
+	IsDirectory   bool                   `protobuf:"varint,3,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"` // Whether this is a directory
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`                                   // Relative path within the archive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameServerFileMetadata) Reset() {
+	*x = GameServerFileMetadata{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameServerFileMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameServerFileMetadata) ProtoMessage() {}
+
+func (x *GameServerFileMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameServerFileMetadata.ProtoReflect.Descriptor instead.
+func (*GameServerFileMetadata) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GameServerFileMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameServerFileMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GameServerFileMetadata) GetIsDirectory() bool {
+	if x != nil {
+		return x.IsDirectory
+	}
+	return false
+}
+
+func (x *GameServerFileMetadata) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type UploadGameServerFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	FilesUploaded int32                  `protobuf:"varint,3,opt,name=files_uploaded,json=filesUploaded,proto3" json:"files_uploaded,omitempty"` // Number of files successfully uploaded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadGameServerFilesResponse) Reset() {
+	*x = UploadGameServerFilesResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadGameServerFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadGameServerFilesResponse) ProtoMessage() {}
+
+func (x *UploadGameServerFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadGameServerFilesResponse.ProtoReflect.Descriptor instead.
+func (*UploadGameServerFilesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UploadGameServerFilesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UploadGameServerFilesResponse) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *UploadGameServerFilesResponse) GetFilesUploaded() int32 {
+	if x != nil {
+		return x.FilesUploaded
+	}
+	return 0
+}
+
+// Chunked upload uses the shared payload/response for consistency across services.
+type ChunkUploadGameServerFilesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	GameServerId  string                   `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Upload        *v1.ChunkedUploadPayload `protobuf:"bytes,2,opt,name=upload,proto3" json:"upload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkUploadGameServerFilesRequest) Reset() {
+	*x = ChunkUploadGameServerFilesRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkUploadGameServerFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkUploadGameServerFilesRequest) ProtoMessage() {}
+
+func (x *ChunkUploadGameServerFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkUploadGameServerFilesRequest.ProtoReflect.Descriptor instead.
+func (*ChunkUploadGameServerFilesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ChunkUploadGameServerFilesRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
+	}
+	return ""
+}
+
+func (x *ChunkUploadGameServerFilesRequest) GetUpload() *v1.ChunkedUploadPayload {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+type ChunkUploadGameServerFilesResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Result        *v1.ChunkedUploadResponsePayload `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkUploadGameServerFilesResponse) Reset() {
+	*x = ChunkUploadGameServerFilesResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkUploadGameServerFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkUploadGameServerFilesResponse) ProtoMessage() {}
+
+func (x *ChunkUploadGameServerFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkUploadGameServerFilesResponse.ProtoReflect.Descriptor instead.
+func (*ChunkUploadGameServerFilesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ChunkUploadGameServerFilesResponse) GetResult() *v1.ChunkedUploadResponsePayload {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DeleteGameServerEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Paths         []string               `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	VolumeName    *string                `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
+	Recursive     bool                   `protobuf:"varint,4,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Force         bool                   `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameServerEntriesRequest) Reset() {
+	*x = DeleteGameServerEntriesRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameServerEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameServerEntriesRequest) ProtoMessage() {}
+
+func (x *DeleteGameServerEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameServerEntriesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameServerEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteGameServerEntriesRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
+	}
+	return ""
+}
+
+func (x *DeleteGameServerEntriesRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *DeleteGameServerEntriesRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *DeleteGameServerEntriesRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *DeleteGameServerEntriesRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteGameServerEntriesError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameServerEntriesError) Reset() {
+	*x = DeleteGameServerEntriesError{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameServerEntriesError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameServerEntriesError) ProtoMessage() {}
+
+func (x *DeleteGameServerEntriesError) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameServerEntriesError.ProtoReflect.Descriptor instead.
+func (*DeleteGameServerEntriesError) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteGameServerEntriesError) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DeleteGameServerEntriesError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteGameServerEntriesResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Success       bool                            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	DeletedPaths  []string                        `protobuf:"bytes,2,rep,name=deleted_paths,json=deletedPaths,proto3" json:"deleted_paths,omitempty"`
+	Errors        []*DeleteGameServerEntriesError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameServerEntriesResponse) Reset() {
+	*x = DeleteGameServerEntriesResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameServerEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameServerEntriesResponse) ProtoMessage() {}
+
+func (x *DeleteGameServerEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameServerEntriesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGameServerEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteGameServerEntriesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteGameServerEntriesResponse) GetDeletedPaths() []string {
+	if x != nil {
+		return x.DeletedPaths
+	}
+	return nil
+}
+
+func (x *DeleteGameServerEntriesResponse) GetErrors() []*DeleteGameServerEntriesError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type RenameGameServerEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	SourcePath    string                 `protobuf:"bytes,2,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	TargetPath    string                 `protobuf:"bytes,3,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	VolumeName    *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
+	Overwrite     bool                   `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameGameServerEntryRequest) Reset() {
+	*x = RenameGameServerEntryRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameGameServerEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameGameServerEntryRequest) ProtoMessage() {}
+
+func (x *RenameGameServerEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameGameServerEntryRequest.ProtoReflect.Descriptor instead.
+func (*RenameGameServerEntryRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RenameGameServerEntryRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
+	}
+	return ""
+}
+
+func (x *RenameGameServerEntryRequest) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+func (x *RenameGameServerEntryRequest) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+func (x *RenameGameServerEntryRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+func (x *RenameGameServerEntryRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type RenameGameServerEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Entry         *GameServerFile        `protobuf:"bytes,2,opt,name=entry,proto3,oneof" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameGameServerEntryResponse) Reset() {
+	*x = RenameGameServerEntryResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameGameServerEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameGameServerEntryResponse) ProtoMessage() {}
+
+func (x *RenameGameServerEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameGameServerEntryResponse.ProtoReflect.Descriptor instead.
+func (*RenameGameServerEntryResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RenameGameServerEntryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RenameGameServerEntryResponse) GetEntry() *GameServerFile {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type CreateGameServerEntryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	IsDirectory   bool                   `protobuf:"varint,3,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
-	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	Permissions   string                 `protobuf:"bytes,5,opt,name=permissions,proto3" json:"permissions,omitempty"`
-	VolumeName    *string                `protobuf:"bytes,6,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"` // If this file is in a volume, the volume name
-	Owner         *string                `protobuf:"bytes,7,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
-	Group         *string                `protobuf:"bytes,8,opt,name=group,proto3,oneof" json:"group,omitempty"`
-	ModeOctal     *uint32                `protobuf:"varint,9,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
-	IsSymlink     *bool                  `protobuf:"varint,10,opt,name=is_symlink,json=isSymlink,proto3,oneof" json:"is_symlink,omitempty"`
-	SymlinkTarget *string                `protobuf:"bytes,11,opt,name=symlink_target,json=symlinkTarget,proto3,oneof" json:"symlink_target,omitempty"`
-	MimeType      *string                `protobuf:"bytes,12,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
-	ModifiedTime  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=modified_time,json=modifiedTime,proto3,oneof" json:"modified_time,omitempty"`
-	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_time,json=createdTime,proto3,oneof" json:"created_time,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	ParentPath    string                 `protobuf:"bytes,2,opt,name=parent_path,json=parentPath,proto3" json:"parent_path,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          GameServerEntryType    `protobuf:"varint,4,opt,name=type,proto3,enum=obiente.cloud.gameservers.v1.GameServerEntryType" json:"type,omitempty"`
+	Template      *string                `protobuf:"bytes,5,opt,name=template,proto3,oneof" json:"template,omitempty"` // Optional template identifier for seeded content (required for symlinks - target path)
+	VolumeName    *string                `protobuf:"bytes,6,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
+	ModeOctal     *uint32                `protobuf:"varint,7,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameServerFile) Reset() {
-	*x = GameServerFile{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[41]
+func (x *CreateGameServerEntryRequest) Reset() {
+	*x = CreateGameServerEntryRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServerFile) String() string {
+func (x *CreateGameServerEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServerFile) ProtoMessage() {}
+func (*CreateGameServerEntryRequest) ProtoMessage() {}
 
-func (x *GameServerFile) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[41]
+func (x *CreateGameServerEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3073,134 +4900,82 @@ func (x *GameServerFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServerFile.ProtoReflect.Descriptor instead.
-func (*GameServerFile) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CreateGameServerEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameServerEntryRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GameServerFile) GetName() string {
+func (x *CreateGameServerEntryRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Name
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GameServerFile) GetPath() string {
+func (x *CreateGameServerEntryRequest) GetParentPath() string {
 	if x != nil {
-		return x.Path
+		return x.ParentPath
 	}
 	return ""
 }
 
-func (x *GameServerFile) GetIsDirectory() bool {
+func (x *CreateGameServerEntryRequest) GetName() string {
 	if x != nil {
-		return x.IsDirectory
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *GameServerFile) GetSize() int64 {
+func (x *CreateGameServerEntryRequest) GetType() GameServerEntryType {
 	if x != nil {
-		return x.Size
+		return x.Type
 	}
-	return 0
+	return GameServerEntryType_GAME_SERVER_ENTRY_TYPE_UNSPECIFIED
 }
 
-func (x *GameServerFile) GetPermissions() string {
-	if x != nil {
-		return x.Permissions
+func (x *CreateGameServerEntryRequest) GetTemplate() string {
+	if x != nil && x.Template != nil {
+		return *x.Template
 	}
 	return ""
 }
 
-func (x *GameServerFile) GetVolumeName() string {
+func (x *CreateGameServerEntryRequest) GetVolumeName() string {
 	if x != nil && x.VolumeName != nil {
 		return *x.VolumeName
 	}
 	return ""
 }
 
-func (x *GameServerFile) GetOwner() string {
-	if x != nil && x.Owner != nil {
-		return *x.Owner
-	}
-	return ""
-}
-
-func (x *GameServerFile) GetGroup() string {
-	if x != nil && x.Group != nil {
-		return *x.Group
-	}
-	return ""
-}
-
-func (x *GameServerFile) GetModeOctal() uint32 {
+func (x *CreateGameServerEntryRequest) GetModeOctal() uint32 {
 	if x != nil && x.ModeOctal != nil {
 		return *x.ModeOctal
 	}
 	return 0
 }
 
-func (x *GameServerFile) GetIsSymlink() bool {
-	if x != nil && x.IsSymlink != nil {
-		return *x.IsSymlink
-	}
-	return false
-}
-
-func (x *GameServerFile) GetSymlinkTarget() string {
-	if x != nil && x.SymlinkTarget != nil {
-		return *x.SymlinkTarget
-	}
-	return ""
-}
-
-func (x *GameServerFile) GetMimeType() string {
-	if x != nil && x.MimeType != nil {
-		return *x.MimeType
-	}
-	return ""
-}
-
-func (x *GameServerFile) GetModifiedTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ModifiedTime
-	}
-	return nil
-}
-
-func (x *GameServerFile) GetCreatedTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedTime
-	}
-	return nil
-}
-
-type GameServerVolumeInfo struct {
+type CreateGameServerEntryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // Volume name
-	MountPoint    string                 `protobuf:"bytes,2,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`        // Mount point inside container (e.g., "/data")
-	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                                  // Volume source path on host
-	IsPersistent  bool                   `protobuf:"varint,4,opt,name=is_persistent,json=isPersistent,proto3" json:"is_persistent,omitempty"` // Whether this is a persistent volume
+	Entry         *GameServerFile        `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameServerVolumeInfo) Reset() {
-	*x = GameServerVolumeInfo{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[42]
+func (x *CreateGameServerEntryResponse) Reset() {
+	*x = CreateGameServerEntryResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServerVolumeInfo) String() string {
+func (x *CreateGameServerEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServerVolumeInfo) ProtoMessage() {}
+func (*CreateGameServerEntryResponse) ProtoMessage() {}
 
-func (x *GameServerVolumeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[42]
+func (x *CreateGameServerEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3211,66 +4986,46 @@ func (x *GameServerVolumeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServerVolumeInfo.ProtoReflect.Descriptor instead.
-func (*GameServerVolumeInfo) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *GameServerVolumeInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GameServerVolumeInfo) GetMountPoint() string {
-	if x != nil {
-		return x.MountPoint
-	}
-	return ""
-}
-
-func (x *GameServerVolumeInfo) GetSource() string {
-	if x != nil {
-		return x.Source
-	}
-	return ""
+// Deprecated: Use CreateGameServerEntryResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameServerEntryResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GameServerVolumeInfo) GetIsPersistent() bool {
+func (x *CreateGameServerEntryResponse) GetEntry() *GameServerFile {
 	if x != nil {
-		return x.IsPersistent
+		return x.Entry
 	}
-	return false
+	return nil
 }
 
-type ListGameServerFilesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                                         // Directory path (default: "/")
-	VolumeName    *string                `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`     // If specified, list files from this volume instead of container filesystem
-	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`                               // Pagination cursor
-	PageSize      *int32                 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`          // Number of files per page
-	ListVolumes   *bool                  `protobuf:"varint,6,opt,name=list_volumes,json=listVolumes,proto3,oneof" json:"list_volumes,omitempty"` // If true, only return available volumes
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type WriteGameServerFileRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId    string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Path            string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	VolumeName      *string                `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
+	Content         string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Encoding        string                 `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"` // "text" or "base64"
+	CreateIfMissing bool                   `protobuf:"varint,6,opt,name=create_if_missing,json=createIfMissing,proto3" json:"create_if_missing,omitempty"`
+	ModeOctal       *uint32                `protobuf:"varint,7,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListGameServerFilesRequest) Reset() {
-	*x = ListGameServerFilesRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[43]
+func (x *WriteGameServerFileRequest) Reset() {
+	*x = WriteGameServerFileRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGameServerFilesRequest) String() string {
+func (x *WriteGameServerFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGameServerFilesRequest) ProtoMessage() {}
+func (*WriteGameServerFileRequest) ProtoMessage() {}
 
-func (x *ListGameServerFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[43]
+func (x *WriteGameServerFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3281,81 +5036,84 @@ func (x *ListGameServerFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGameServerFilesRequest.ProtoReflect.Descriptor instead.
-func (*ListGameServerFilesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use WriteGameServerFileRequest.ProtoReflect.Descriptor instead.
+func (*WriteGameServerFileRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ListGameServerFilesRequest) GetGameServerId() string {
+func (x *WriteGameServerFileRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *ListGameServerFilesRequest) GetPath() string {
+func (x *WriteGameServerFileRequest) GetPath() string {
 	if x != nil {
 		return x.Path
 	}
 	return ""
 }
 
-func (x *ListGameServerFilesRequest) GetVolumeName() string {
+func (x *WriteGameServerFileRequest) GetVolumeName() string {
 	if x != nil && x.VolumeName != nil {
 		return *x.VolumeName
 	}
 	return ""
 }
 
-func (x *ListGameServerFilesRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *WriteGameServerFileRequest) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-func (x *ListGameServerFilesRequest) GetPageSize() int32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
+func (x *WriteGameServerFileRequest) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
 	}
-	return 0
+	return ""
 }
 
-func (x *ListGameServerFilesRequest) GetListVolumes() bool {
-	if x != nil && x.ListVolumes != nil {
-		return *x.ListVolumes
+func (x *WriteGameServerFileRequest) GetCreateIfMissing() bool {
+	if x != nil {
+		return x.CreateIfMissing
 	}
 	return false
 }
 
-type ListGameServerFilesResponse struct {
-	state            protoimpl.MessageState  `protogen:"open.v1"`
-	Files            []*GameServerFile       `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	CurrentPath      string                  `protobuf:"bytes,2,opt,name=current_path,json=currentPath,proto3" json:"current_path,omitempty"`
-	Volumes          []*GameServerVolumeInfo `protobuf:"bytes,3,rep,name=volumes,proto3" json:"volumes,omitempty"`                                            // Available persistent volumes (only when list_volumes=true)
-	IsVolume         bool                    `protobuf:"varint,4,opt,name=is_volume,json=isVolume,proto3" json:"is_volume,omitempty"`                         // Whether the current listing is from a volume
-	ContainerRunning bool                    `protobuf:"varint,5,opt,name=container_running,json=containerRunning,proto3" json:"container_running,omitempty"` // Whether the container is currently running
-	HasMore          bool                    `protobuf:"varint,6,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	NextCursor       *string                 `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *WriteGameServerFileRequest) GetModeOctal() uint32 {
+	if x != nil && x.ModeOctal != nil {
+		return *x.ModeOctal
+	}
+	return 0
 }
 
-func (x *ListGameServerFilesResponse) Reset() {
-	*x = ListGameServerFilesResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[44]
+type WriteGameServerFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Entry         *GameServerFile        `protobuf:"bytes,2,opt,name=entry,proto3,oneof" json:"entry,omitempty"`
+	Error         *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteGameServerFileResponse) Reset() {
+	*x = WriteGameServerFileResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGameServerFilesResponse) String() string {
+func (x *WriteGameServerFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGameServerFilesResponse) ProtoMessage() {}
+func (*WriteGameServerFileResponse) ProtoMessage() {}
 
-func (x *ListGameServerFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[44]
+func (x *WriteGameServerFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3366,88 +5124,57 @@ func (x *ListGameServerFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGameServerFilesResponse.ProtoReflect.Descriptor instead.
-func (*ListGameServerFilesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *ListGameServerFilesResponse) GetFiles() []*GameServerFile {
-	if x != nil {
-		return x.Files
-	}
-	return nil
-}
-
-func (x *ListGameServerFilesResponse) GetCurrentPath() string {
-	if x != nil {
-		return x.CurrentPath
-	}
-	return ""
-}
-
-func (x *ListGameServerFilesResponse) GetVolumes() []*GameServerVolumeInfo {
-	if x != nil {
-		return x.Volumes
-	}
-	return nil
-}
-
-func (x *ListGameServerFilesResponse) GetIsVolume() bool {
-	if x != nil {
-		return x.IsVolume
-	}
-	return false
+// Deprecated: Use WriteGameServerFileResponse.ProtoReflect.Descriptor instead.
+func (*WriteGameServerFileResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListGameServerFilesResponse) GetContainerRunning() bool {
+func (x *WriteGameServerFileResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ContainerRunning
+		return x.Success
 	}
 	return false
 }
 
-func (x *ListGameServerFilesResponse) GetHasMore() bool {
+func (x *WriteGameServerFileResponse) GetEntry() *GameServerFile {
 	if x != nil {
-		return x.HasMore
+		return x.Entry
 	}
-	return false
+	return nil
 }
 
-func (x *ListGameServerFilesResponse) GetNextCursor() string {
-	if x != nil && x.NextCursor != nil {
-		return *x.NextCursor
+func (x *WriteGameServerFileResponse) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-type SearchGameServerFilesRequest struct {
+type ExtractGameServerFileRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	GameServerId    string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Query           string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                                                   // Search query (searches in file and directory names, case-insensitive)
-	RootPath        *string                `protobuf:"bytes,3,opt,name=root_path,json=rootPath,proto3,oneof" json:"root_path,omitempty"`                       // Root path to search from (default: "/")
-	VolumeName      *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`                 // If specified, search in this volume instead of container filesystem
-	MaxResults      *int32                 `protobuf:"varint,5,opt,name=max_results,json=maxResults,proto3,oneof" json:"max_results,omitempty"`                // Maximum number of results to return (default: 100)
-	FilesOnly       *bool                  `protobuf:"varint,6,opt,name=files_only,json=filesOnly,proto3,oneof" json:"files_only,omitempty"`                   // If true, only return files (exclude directories)
-	DirectoriesOnly *bool                  `protobuf:"varint,7,opt,name=directories_only,json=directoriesOnly,proto3,oneof" json:"directories_only,omitempty"` // If true, only return directories (exclude files)
+	ZipPath         string                 `protobuf:"bytes,2,opt,name=zip_path,json=zipPath,proto3" json:"zip_path,omitempty"`                         // Path to the zip file to extract (from common.ExtractServerFileRequest)
+	DestinationPath string                 `protobuf:"bytes,3,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"` // Directory path where files should be extracted (from common.ExtractServerFileRequest)
+	VolumeName      *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`          // If specified, extract to this volume instead of container filesystem (from common.ExtractServerFileRequest)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SearchGameServerFilesRequest) Reset() {
-	*x = SearchGameServerFilesRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[45]
+func (x *ExtractGameServerFileRequest) Reset() {
+	*x = ExtractGameServerFileRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchGameServerFilesRequest) String() string {
+func (x *ExtractGameServerFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchGameServerFilesRequest) ProtoMessage() {}
+func (*ExtractGameServerFileRequest) ProtoMessage() {}
 
-func (x *SearchGameServerFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[45]
+func (x *ExtractGameServerFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3458,85 +5185,123 @@ func (x *SearchGameServerFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchGameServerFilesRequest.ProtoReflect.Descriptor instead.
-func (*SearchGameServerFilesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ExtractGameServerFileRequest.ProtoReflect.Descriptor instead.
+func (*ExtractGameServerFileRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *SearchGameServerFilesRequest) GetGameServerId() string {
+func (x *ExtractGameServerFileRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *SearchGameServerFilesRequest) GetQuery() string {
+func (x *ExtractGameServerFileRequest) GetZipPath() string {
 	if x != nil {
-		return x.Query
+		return x.ZipPath
 	}
 	return ""
 }
 
-func (x *SearchGameServerFilesRequest) GetRootPath() string {
-	if x != nil && x.RootPath != nil {
-		return *x.RootPath
+func (x *ExtractGameServerFileRequest) GetDestinationPath() string {
+	if x != nil {
+		return x.DestinationPath
 	}
 	return ""
 }
 
-func (x *SearchGameServerFilesRequest) GetVolumeName() string {
+func (x *ExtractGameServerFileRequest) GetVolumeName() string {
 	if x != nil && x.VolumeName != nil {
 		return *x.VolumeName
 	}
 	return ""
 }
 
-func (x *SearchGameServerFilesRequest) GetMaxResults() int32 {
-	if x != nil && x.MaxResults != nil {
-		return *x.MaxResults
+type ExtractGameServerFileResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                     // From common.ExtractServerFileResponse
+	Error          *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`                                    // From common.ExtractServerFileResponse
+	FilesExtracted int32                  `protobuf:"varint,3,opt,name=files_extracted,json=filesExtracted,proto3" json:"files_extracted,omitempty"` // Number of files successfully extracted (from common.ExtractServerFileResponse)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExtractGameServerFileResponse) Reset() {
+	*x = ExtractGameServerFileResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtractGameServerFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractGameServerFileResponse) ProtoMessage() {}
+
+func (x *ExtractGameServerFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SearchGameServerFilesRequest) GetFilesOnly() bool {
-	if x != nil && x.FilesOnly != nil {
-		return *x.FilesOnly
+// Deprecated: Use ExtractGameServerFileResponse.ProtoReflect.Descriptor instead.
+func (*ExtractGameServerFileResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ExtractGameServerFileResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
 	return false
 }
 
-func (x *SearchGameServerFilesRequest) GetDirectoriesOnly() bool {
-	if x != nil && x.DirectoriesOnly != nil {
-		return *x.DirectoriesOnly
+func (x *ExtractGameServerFileResponse) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
-	return false
+	return ""
 }
 
-type SearchGameServerFilesResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Results          []*GameServerFile      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`                                            // Matching files and directories
-	TotalFound       int32                  `protobuf:"varint,2,opt,name=total_found,json=totalFound,proto3" json:"total_found,omitempty"`                   // Total number of matches found
-	HasMore          bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`                            // Whether there are more results beyond max_results
-	ContainerRunning bool                   `protobuf:"varint,4,opt,name=container_running,json=containerRunning,proto3" json:"container_running,omitempty"` // Whether the container is currently running
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *ExtractGameServerFileResponse) GetFilesExtracted() int32 {
+	if x != nil {
+		return x.FilesExtracted
+	}
+	return 0
 }
 
-func (x *SearchGameServerFilesResponse) Reset() {
-	*x = SearchGameServerFilesResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[46]
+type CreateGameServerFileArchiveRequest struct {
+	state          protoimpl.MessageState             `protogen:"open.v1"`
+	GameServerId   string                             `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	ArchiveRequest *v1.CreateServerFileArchiveRequest `protobuf:"bytes,2,opt,name=archive_request,json=archiveRequest,proto3" json:"archive_request,omitempty"` // Shared archive request
+	VolumeName     *string                            `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`       // If specified, create archive in this volume instead of container filesystem
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateGameServerFileArchiveRequest) Reset() {
+	*x = CreateGameServerFileArchiveRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchGameServerFilesResponse) String() string {
+func (x *CreateGameServerFileArchiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchGameServerFilesResponse) ProtoMessage() {}
+func (*CreateGameServerFileArchiveRequest) ProtoMessage() {}
 
-func (x *SearchGameServerFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[46]
+func (x *CreateGameServerFileArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3547,63 +5312,54 @@ func (x *SearchGameServerFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchGameServerFilesResponse.ProtoReflect.Descriptor instead.
-func (*SearchGameServerFilesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *SearchGameServerFilesResponse) GetResults() []*GameServerFile {
-	if x != nil {
-		return x.Results
-	}
-	return nil
+// Deprecated: Use CreateGameServerFileArchiveRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameServerFileArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *SearchGameServerFilesResponse) GetTotalFound() int32 {
+func (x *CreateGameServerFileArchiveRequest) GetGameServerId() string {
 	if x != nil {
-		return x.TotalFound
+		return x.GameServerId
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchGameServerFilesResponse) GetHasMore() bool {
+func (x *CreateGameServerFileArchiveRequest) GetArchiveRequest() *v1.CreateServerFileArchiveRequest {
 	if x != nil {
-		return x.HasMore
+		return x.ArchiveRequest
 	}
-	return false
+	return nil
 }
 
-func (x *SearchGameServerFilesResponse) GetContainerRunning() bool {
-	if x != nil {
-		return x.ContainerRunning
+func (x *CreateGameServerFileArchiveRequest) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
 	}
-	return false
+	return ""
 }
 
-type GetGameServerFileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                                     // File path
-	VolumeName    *string                `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"` // If specified, read file from this volume instead of container filesystem
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateGameServerFileArchiveResponse struct {
+	state           protoimpl.MessageState              `protogen:"open.v1"`
+	ArchiveResponse *v1.CreateServerFileArchiveResponse `protobuf:"bytes,1,opt,name=archive_response,json=archiveResponse,proto3" json:"archive_response,omitempty"` // Shared archive response
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetGameServerFileRequest) Reset() {
-	*x = GetGameServerFileRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[47]
+func (x *CreateGameServerFileArchiveResponse) Reset() {
+	*x = CreateGameServerFileArchiveResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerFileRequest) String() string {
+func (x *CreateGameServerFileArchiveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameServerFileRequest) ProtoMessage() {}
+func (*CreateGameServerFileArchiveResponse) ProtoMessage() {}
 
-func (x *GetGameServerFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[47]
+func (x *CreateGameServerFileArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3614,58 +5370,48 @@ func (x *GetGameServerFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameServerFileRequest.ProtoReflect.Descriptor instead.
-func (*GetGameServerFileRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *GetGameServerFileRequest) GetGameServerId() string {
-	if x != nil {
-		return x.GameServerId
-	}
-	return ""
+// Deprecated: Use CreateGameServerFileArchiveResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameServerFileArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetGameServerFileRequest) GetPath() string {
+func (x *CreateGameServerFileArchiveResponse) GetArchiveResponse() *v1.CreateServerFileArchiveResponse {
 	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *GetGameServerFileRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
+		return x.ArchiveResponse
 	}
-	return ""
+	return nil
 }
 
-type GetGameServerFileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Encoding      string                 `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"` // "text" or "base64"
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Truncated     *bool                  `protobuf:"varint,4,opt,name=truncated,proto3,oneof" json:"truncated,omitempty"`
-	Metadata      *GameServerFile        `protobuf:"bytes,5,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GameServerFileTransferCredential struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	Id             string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Username       string                     `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Scopes         []string                   `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	LastUsedAt     *timestamppb.Timestamp     `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp     `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp     `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AllowedPaths   []string                   `protobuf:"bytes,8,rep,name=allowed_paths,json=allowedPaths,proto3" json:"allowed_paths,omitempty"`       // Path prefixes the credential is restricted to; empty allows the whole server
+	AuthorizedKeys []*GameServerAuthorizedKey `protobuf:"bytes,9,rep,name=authorized_keys,json=authorizedKeys,proto3" json:"authorized_keys,omitempty"` // SSH public keys that log in as this credential
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGameServerFileResponse) Reset() {
-	*x = GetGameServerFileResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[48]
+func (x *GameServerFileTransferCredential) Reset() {
+	*x = GameServerFileTransferCredential{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameServerFileResponse) String() string {
+func (x *GameServerFileTransferCredential) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameServerFileResponse) ProtoMessage() {}
+func (*GameServerFileTransferCredential) ProtoMessage() {}
 
-func (x *GetGameServerFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[48]
+func (x *GameServerFileTransferCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3676,69 +5422,97 @@ func (x *GetGameServerFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameServerFileResponse.ProtoReflect.Descriptor instead.
-func (*GetGameServerFileResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use GameServerFileTransferCredential.ProtoReflect.Descriptor instead.
+func (*GameServerFileTransferCredential) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetGameServerFileResponse) GetContent() string {
+func (x *GameServerFileTransferCredential) GetId() string {
 	if x != nil {
-		return x.Content
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetGameServerFileResponse) GetEncoding() string {
+func (x *GameServerFileTransferCredential) GetName() string {
 	if x != nil {
-		return x.Encoding
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetGameServerFileResponse) GetSize() int64 {
+func (x *GameServerFileTransferCredential) GetUsername() string {
 	if x != nil {
-		return x.Size
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *GetGameServerFileResponse) GetTruncated() bool {
-	if x != nil && x.Truncated != nil {
-		return *x.Truncated
+func (x *GameServerFileTransferCredential) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
 	}
-	return false
+	return nil
 }
 
-func (x *GetGameServerFileResponse) GetMetadata() *GameServerFile {
+func (x *GameServerFileTransferCredential) GetLastUsedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Metadata
+		return x.LastUsedAt
 	}
 	return nil
 }
 
-type UploadGameServerFilesRequest struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Metadata      *UploadGameServerFilesMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`              // Metadata about the upload
-	TarData       []byte                         `protobuf:"bytes,2,opt,name=tar_data,json=tarData,proto3" json:"tar_data,omitempty"` // Complete tar archive containing all files
+func (x *GameServerFileTransferCredential) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GameServerFileTransferCredential) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GameServerFileTransferCredential) GetAllowedPaths() []string {
+	if x != nil {
+		return x.AllowedPaths
+	}
+	return nil
+}
+
+func (x *GameServerFileTransferCredential) GetAuthorizedKeys() []*GameServerAuthorizedKey {
+	if x != nil {
+		return x.AuthorizedKeys
+	}
+	return nil
+}
+
+type GameServerAuthorizedKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublicKey     string                 `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // authorized_keys line, e.g. "ssh-ed25519 AAAA... user@host"
+	Fingerprint   string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`              // SHA256 fingerprint, e.g. "SHA256:..."
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadGameServerFilesRequest) Reset() {
-	*x = UploadGameServerFilesRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[49]
+func (x *GameServerAuthorizedKey) Reset() {
+	*x = GameServerAuthorizedKey{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadGameServerFilesRequest) String() string {
+func (x *GameServerAuthorizedKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadGameServerFilesRequest) ProtoMessage() {}
+func (*GameServerAuthorizedKey) ProtoMessage() {}
 
-func (x *UploadGameServerFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[49]
+func (x *GameServerAuthorizedKey) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3749,50 +5523,51 @@ func (x *UploadGameServerFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadGameServerFilesRequest.ProtoReflect.Descriptor instead.
-func (*UploadGameServerFilesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use GameServerAuthorizedKey.ProtoReflect.Descriptor instead.
+func (*GameServerAuthorizedKey) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *UploadGameServerFilesRequest) GetMetadata() *UploadGameServerFilesMetadata {
+func (x *GameServerAuthorizedKey) GetPublicKey() string {
 	if x != nil {
-		return x.Metadata
+		return x.PublicKey
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadGameServerFilesRequest) GetTarData() []byte {
+func (x *GameServerAuthorizedKey) GetFingerprint() string {
 	if x != nil {
-		return x.TarData
+		return x.Fingerprint
 	}
-	return nil
+	return ""
 }
 
-type UploadGameServerFilesMetadata struct {
-	state           protoimpl.MessageState    `protogen:"open.v1"`
-	GameServerId    string                    `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	DestinationPath string                    `protobuf:"bytes,2,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"` // Directory path where files should be extracted (default: "/")
-	Files           []*GameServerFileMetadata `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`                                            // Metadata about files being uploaded
-	VolumeName      *string                   `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`          // If specified, upload files to this volume instead of container filesystem
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GameServerFileTransferConnectionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Protocol      string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Command       string                 `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadGameServerFilesMetadata) Reset() {
-	*x = UploadGameServerFilesMetadata{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[50]
+func (x *GameServerFileTransferConnectionInfo) Reset() {
+	*x = GameServerFileTransferConnectionInfo{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadGameServerFilesMetadata) String() string {
+func (x *GameServerFileTransferConnectionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadGameServerFilesMetadata) ProtoMessage() {}
+func (*GameServerFileTransferConnectionInfo) ProtoMessage() {}
 
-func (x *UploadGameServerFilesMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[50]
+func (x *GameServerFileTransferConnectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3803,64 +5578,113 @@ func (x *UploadGameServerFilesMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadGameServerFilesMetadata.ProtoReflect.Descriptor instead.
-func (*UploadGameServerFilesMetadata) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use GameServerFileTransferConnectionInfo.ProtoReflect.Descriptor instead.
+func (*GameServerFileTransferConnectionInfo) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *UploadGameServerFilesMetadata) GetGameServerId() string {
+func (x *GameServerFileTransferConnectionInfo) GetHost() string {
 	if x != nil {
-		return x.GameServerId
+		return x.Host
 	}
 	return ""
 }
 
-func (x *UploadGameServerFilesMetadata) GetDestinationPath() string {
+func (x *GameServerFileTransferConnectionInfo) GetPort() int32 {
 	if x != nil {
-		return x.DestinationPath
+		return x.Port
+	}
+	return 0
+}
+
+func (x *GameServerFileTransferConnectionInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-func (x *UploadGameServerFilesMetadata) GetFiles() []*GameServerFileMetadata {
+func (x *GameServerFileTransferConnectionInfo) GetProtocol() string {
 	if x != nil {
-		return x.Files
+		return x.Protocol
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadGameServerFilesMetadata) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
+func (x *GameServerFileTransferConnectionInfo) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type ListGameServerFileTransferCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGameServerFileTransferCredentialsRequest) Reset() {
+	*x = ListGameServerFileTransferCredentialsRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGameServerFileTransferCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameServerFileTransferCredentialsRequest) ProtoMessage() {}
+
+func (x *ListGameServerFileTransferCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGameServerFileTransferCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ListGameServerFileTransferCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListGameServerFileTransferCredentialsRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
 	}
 	return ""
 }
 
-type GameServerFileMetadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                   // File name
-	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                                  // File size in bytes
-	IsDirectory   bool                   `protobuf:"varint,3,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"` // Whether this is a directory
-	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`                                   // Relative path within the archive
+type ListGameServerFileTransferCredentialsResponse struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Credentials   []*GameServerFileTransferCredential   `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	Connection    *GameServerFileTransferConnectionInfo `protobuf:"bytes,2,opt,name=connection,proto3" json:"connection,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameServerFileMetadata) Reset() {
-	*x = GameServerFileMetadata{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[51]
+func (x *ListGameServerFileTransferCredentialsResponse) Reset() {
+	*x = ListGameServerFileTransferCredentialsResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServerFileMetadata) String() string {
+func (x *ListGameServerFileTransferCredentialsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServerFileMetadata) ProtoMessage() {}
+func (*ListGameServerFileTransferCredentialsResponse) ProtoMessage() {}
 
-func (x *GameServerFileMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[51]
+func (x *ListGameServerFileTransferCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3871,63 +5695,51 @@ func (x *GameServerFileMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServerFileMetadata.ProtoReflect.Descriptor instead.
-func (*GameServerFileMetadata) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *GameServerFileMetadata) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GameServerFileMetadata) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+// Deprecated: Use ListGameServerFileTransferCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*ListGameServerFileTransferCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GameServerFileMetadata) GetIsDirectory() bool {
+func (x *ListGameServerFileTransferCredentialsResponse) GetCredentials() []*GameServerFileTransferCredential {
 	if x != nil {
-		return x.IsDirectory
+		return x.Credentials
 	}
-	return false
+	return nil
 }
 
-func (x *GameServerFileMetadata) GetPath() string {
+func (x *ListGameServerFileTransferCredentialsResponse) GetConnection() *GameServerFileTransferConnectionInfo {
 	if x != nil {
-		return x.Path
+		return x.Connection
 	}
-	return ""
+	return nil
 }
 
-type UploadGameServerFilesResponse struct {
+type CreateGameServerFileTransferCredentialRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	FilesUploaded int32                  `protobuf:"varint,3,opt,name=files_uploaded,json=filesUploaded,proto3" json:"files_uploaded,omitempty"` // Number of files successfully uploaded
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	AllowedPaths  []string               `protobuf:"bytes,5,rep,name=allowed_paths,json=allowedPaths,proto3" json:"allowed_paths,omitempty"` // Restrict the credential to these path prefixes (e.g. "/plugins"); empty allows the whole server
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadGameServerFilesResponse) Reset() {
-	*x = UploadGameServerFilesResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[52]
+func (x *CreateGameServerFileTransferCredentialRequest) Reset() {
+	*x = CreateGameServerFileTransferCredentialRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadGameServerFilesResponse) String() string {
+func (x *CreateGameServerFileTransferCredentialRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadGameServerFilesResponse) ProtoMessage() {}
+func (*CreateGameServerFileTransferCredentialRequest) ProtoMessage() {}
 
-func (x *UploadGameServerFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[52]
+func (x *CreateGameServerFileTransferCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3938,56 +5750,70 @@ func (x *UploadGameServerFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadGameServerFilesResponse.ProtoReflect.Descriptor instead.
-func (*UploadGameServerFilesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use CreateGameServerFileTransferCredentialRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameServerFileTransferCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *UploadGameServerFilesResponse) GetSuccess() bool {
+func (x *CreateGameServerFileTransferCredentialRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Success
+		return x.GameServerId
 	}
-	return false
+	return ""
 }
 
-func (x *UploadGameServerFilesResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+func (x *CreateGameServerFileTransferCredentialRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UploadGameServerFilesResponse) GetFilesUploaded() int32 {
+func (x *CreateGameServerFileTransferCredentialRequest) GetScopes() []string {
 	if x != nil {
-		return x.FilesUploaded
+		return x.Scopes
 	}
-	return 0
+	return nil
 }
 
-// Chunked upload uses the shared payload/response for consistency across services.
-type ChunkUploadGameServerFilesRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	GameServerId  string                   `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Upload        *v1.ChunkedUploadPayload `protobuf:"bytes,2,opt,name=upload,proto3" json:"upload,omitempty"`
+func (x *CreateGameServerFileTransferCredentialRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateGameServerFileTransferCredentialRequest) GetAllowedPaths() []string {
+	if x != nil {
+		return x.AllowedPaths
+	}
+	return nil
+}
+
+type CreateGameServerFileTransferCredentialResponse struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Credential    *GameServerFileTransferCredential     `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	Password      string                                `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Connection    *GameServerFileTransferConnectionInfo `protobuf:"bytes,3,opt,name=connection,proto3" json:"connection,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChunkUploadGameServerFilesRequest) Reset() {
-	*x = ChunkUploadGameServerFilesRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[53]
+func (x *CreateGameServerFileTransferCredentialResponse) Reset() {
+	*x = CreateGameServerFileTransferCredentialResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChunkUploadGameServerFilesRequest) String() string {
+func (x *CreateGameServerFileTransferCredentialResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChunkUploadGameServerFilesRequest) ProtoMessage() {}
+func (*CreateGameServerFileTransferCredentialResponse) ProtoMessage() {}
 
-func (x *ChunkUploadGameServerFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[53]
+func (x *CreateGameServerFileTransferCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3998,47 +5824,55 @@ func (x *ChunkUploadGameServerFilesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChunkUploadGameServerFilesRequest.ProtoReflect.Descriptor instead.
-func (*ChunkUploadGameServerFilesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use CreateGameServerFileTransferCredentialResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameServerFileTransferCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ChunkUploadGameServerFilesRequest) GetGameServerId() string {
+func (x *CreateGameServerFileTransferCredentialResponse) GetCredential() *GameServerFileTransferCredential {
 	if x != nil {
-		return x.GameServerId
+		return x.Credential
+	}
+	return nil
+}
+
+func (x *CreateGameServerFileTransferCredentialResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
 	}
 	return ""
 }
 
-func (x *ChunkUploadGameServerFilesRequest) GetUpload() *v1.ChunkedUploadPayload {
+func (x *CreateGameServerFileTransferCredentialResponse) GetConnection() *GameServerFileTransferConnectionInfo {
 	if x != nil {
-		return x.Upload
+		return x.Connection
 	}
 	return nil
 }
 
-type ChunkUploadGameServerFilesResponse struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	Result        *v1.ChunkedUploadResponsePayload `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+type RevokeGameServerFileTransferCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	CredentialId  string                 `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChunkUploadGameServerFilesResponse) Reset() {
-	*x = ChunkUploadGameServerFilesResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[54]
+func (x *RevokeGameServerFileTransferCredentialRequest) Reset() {
+	*x = RevokeGameServerFileTransferCredentialRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChunkUploadGameServerFilesResponse) String() string {
+func (x *RevokeGameServerFileTransferCredentialRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChunkUploadGameServerFilesResponse) ProtoMessage() {}
+func (*RevokeGameServerFileTransferCredentialRequest) ProtoMessage() {}
 
-func (x *ChunkUploadGameServerFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[54]
+func (x *RevokeGameServerFileTransferCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4049,44 +5883,47 @@ func (x *ChunkUploadGameServerFilesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChunkUploadGameServerFilesResponse.ProtoReflect.Descriptor instead.
-func (*ChunkUploadGameServerFilesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use RevokeGameServerFileTransferCredentialRequest.ProtoReflect.Descriptor instead.
+func (*RevokeGameServerFileTransferCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ChunkUploadGameServerFilesResponse) GetResult() *v1.ChunkedUploadResponsePayload {
+func (x *RevokeGameServerFileTransferCredentialRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Result
+		return x.GameServerId
 	}
-	return nil
+	return ""
 }
 
-type DeleteGameServerEntriesRequest struct {
+func (x *RevokeGameServerFileTransferCredentialRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+type RevokeGameServerFileTransferCredentialResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Paths         []string               `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
-	VolumeName    *string                `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
-	Recursive     bool                   `protobuf:"varint,4,opt,name=recursive,proto3" json:"recursive,omitempty"`
-	Force         bool                   `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGameServerEntriesRequest) Reset() {
-	*x = DeleteGameServerEntriesRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[55]
+func (x *RevokeGameServerFileTransferCredentialResponse) Reset() {
+	*x = RevokeGameServerFileTransferCredentialResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGameServerEntriesRequest) String() string {
+func (x *RevokeGameServerFileTransferCredentialResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGameServerEntriesRequest) ProtoMessage() {}
+func (*RevokeGameServerFileTransferCredentialResponse) ProtoMessage() {}
 
-func (x *DeleteGameServerEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[55]
+func (x *RevokeGameServerFileTransferCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4097,69 +5934,42 @@ func (x *DeleteGameServerEntriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGameServerEntriesRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGameServerEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{55}
-}
-
-func (x *DeleteGameServerEntriesRequest) GetGameServerId() string {
-	if x != nil {
-		return x.GameServerId
-	}
-	return ""
-}
-
-func (x *DeleteGameServerEntriesRequest) GetPaths() []string {
-	if x != nil {
-		return x.Paths
-	}
-	return nil
-}
-
-func (x *DeleteGameServerEntriesRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
-	}
-	return ""
-}
-
-func (x *DeleteGameServerEntriesRequest) GetRecursive() bool {
-	if x != nil {
-		return x.Recursive
-	}
-	return false
+// Deprecated: Use RevokeGameServerFileTransferCredentialResponse.ProtoReflect.Descriptor instead.
+func (*RevokeGameServerFileTransferCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *DeleteGameServerEntriesRequest) GetForce() bool {
+func (x *RevokeGameServerFileTransferCredentialResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Force
+		return x.Success
 	}
 	return false
 }
 
-type DeleteGameServerEntriesError struct {
+type AddAuthorizedKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	CredentialId  string                 `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // authorized_keys line, e.g. "ssh-ed25519 AAAA... user@host"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGameServerEntriesError) Reset() {
-	*x = DeleteGameServerEntriesError{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[56]
+func (x *AddAuthorizedKeyRequest) Reset() {
+	*x = AddAuthorizedKeyRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGameServerEntriesError) String() string {
+func (x *AddAuthorizedKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGameServerEntriesError) ProtoMessage() {}
+func (*AddAuthorizedKeyRequest) ProtoMessage() {}
 
-func (x *DeleteGameServerEntriesError) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[56]
+func (x *AddAuthorizedKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4170,49 +5980,54 @@ func (x *DeleteGameServerEntriesError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGameServerEntriesError.ProtoReflect.Descriptor instead.
-func (*DeleteGameServerEntriesError) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use AddAuthorizedKeyRequest.ProtoReflect.Descriptor instead.
+func (*AddAuthorizedKeyRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *DeleteGameServerEntriesError) GetPath() string {
+func (x *AddAuthorizedKeyRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Path
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *DeleteGameServerEntriesError) GetMessage() string {
+func (x *AddAuthorizedKeyRequest) GetCredentialId() string {
 	if x != nil {
-		return x.Message
+		return x.CredentialId
 	}
 	return ""
 }
 
-type DeleteGameServerEntriesResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Success       bool                            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	DeletedPaths  []string                        `protobuf:"bytes,2,rep,name=deleted_paths,json=deletedPaths,proto3" json:"deleted_paths,omitempty"`
-	Errors        []*DeleteGameServerEntriesError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+func (x *AddAuthorizedKeyRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type AddAuthorizedKeyResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Credential    *GameServerFileTransferCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGameServerEntriesResponse) Reset() {
-	*x = DeleteGameServerEntriesResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[57]
+func (x *AddAuthorizedKeyResponse) Reset() {
+	*x = AddAuthorizedKeyResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGameServerEntriesResponse) String() string {
+func (x *AddAuthorizedKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGameServerEntriesResponse) ProtoMessage() {}
+func (*AddAuthorizedKeyResponse) ProtoMessage() {}
 
-func (x *DeleteGameServerEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[57]
+func (x *AddAuthorizedKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4223,58 +6038,42 @@ func (x *DeleteGameServerEntriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGameServerEntriesResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGameServerEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *DeleteGameServerEntriesResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *DeleteGameServerEntriesResponse) GetDeletedPaths() []string {
-	if x != nil {
-		return x.DeletedPaths
-	}
-	return nil
+// Deprecated: Use AddAuthorizedKeyResponse.ProtoReflect.Descriptor instead.
+func (*AddAuthorizedKeyResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *DeleteGameServerEntriesResponse) GetErrors() []*DeleteGameServerEntriesError {
+func (x *AddAuthorizedKeyResponse) GetCredential() *GameServerFileTransferCredential {
 	if x != nil {
-		return x.Errors
+		return x.Credential
 	}
 	return nil
 }
 
-type RenameGameServerEntryRequest struct {
+type RemoveAuthorizedKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	SourcePath    string                 `protobuf:"bytes,2,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
-	TargetPath    string                 `protobuf:"bytes,3,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
-	VolumeName    *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
-	Overwrite     bool                   `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	CredentialId  string                 `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"` // SHA256 fingerprint of the key to remove
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameGameServerEntryRequest) Reset() {
-	*x = RenameGameServerEntryRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[58]
+func (x *RemoveAuthorizedKeyRequest) Reset() {
+	*x = RemoveAuthorizedKeyRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameGameServerEntryRequest) String() string {
+func (x *RemoveAuthorizedKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameGameServerEntryRequest) ProtoMessage() {}
+func (*RemoveAuthorizedKeyRequest) ProtoMessage() {}
 
-func (x *RenameGameServerEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[58]
+func (x *RemoveAuthorizedKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4285,69 +6084,99 @@ func (x *RenameGameServerEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameGameServerEntryRequest.ProtoReflect.Descriptor instead.
-func (*RenameGameServerEntryRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use RemoveAuthorizedKeyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAuthorizedKeyRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *RenameGameServerEntryRequest) GetGameServerId() string {
+func (x *RemoveAuthorizedKeyRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *RenameGameServerEntryRequest) GetSourcePath() string {
+func (x *RemoveAuthorizedKeyRequest) GetCredentialId() string {
 	if x != nil {
-		return x.SourcePath
+		return x.CredentialId
 	}
 	return ""
 }
 
-func (x *RenameGameServerEntryRequest) GetTargetPath() string {
+func (x *RemoveAuthorizedKeyRequest) GetFingerprint() string {
 	if x != nil {
-		return x.TargetPath
+		return x.Fingerprint
 	}
 	return ""
 }
 
-func (x *RenameGameServerEntryRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
+type RemoveAuthorizedKeyResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Credential    *GameServerFileTransferCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAuthorizedKeyResponse) Reset() {
+	*x = RemoveAuthorizedKeyResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAuthorizedKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAuthorizedKeyResponse) ProtoMessage() {}
+
+func (x *RemoveAuthorizedKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RenameGameServerEntryRequest) GetOverwrite() bool {
+// Deprecated: Use RemoveAuthorizedKeyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAuthorizedKeyResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RemoveAuthorizedKeyResponse) GetCredential() *GameServerFileTransferCredential {
 	if x != nil {
-		return x.Overwrite
+		return x.Credential
 	}
-	return false
+	return nil
 }
 
-type RenameGameServerEntryResponse struct {
+// Minecraft player lookup messages
+type GetMinecraftPlayerUUIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Entry         *GameServerFile        `protobuf:"bytes,2,opt,name=entry,proto3,oneof" json:"entry,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // Minecraft username
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameGameServerEntryResponse) Reset() {
-	*x = RenameGameServerEntryResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[59]
+func (x *GetMinecraftPlayerUUIDRequest) Reset() {
+	*x = GetMinecraftPlayerUUIDRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameGameServerEntryResponse) String() string {
+func (x *GetMinecraftPlayerUUIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameGameServerEntryResponse) ProtoMessage() {}
+func (*GetMinecraftPlayerUUIDRequest) ProtoMessage() {}
 
-func (x *RenameGameServerEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[59]
+func (x *GetMinecraftPlayerUUIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4358,53 +6187,41 @@ func (x *RenameGameServerEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameGameServerEntryResponse.ProtoReflect.Descriptor instead.
-func (*RenameGameServerEntryResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *RenameGameServerEntryResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use GetMinecraftPlayerUUIDRequest.ProtoReflect.Descriptor instead.
+func (*GetMinecraftPlayerUUIDRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *RenameGameServerEntryResponse) GetEntry() *GameServerFile {
+func (x *GetMinecraftPlayerUUIDRequest) GetUsername() string {
 	if x != nil {
-		return x.Entry
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-type CreateGameServerEntryRequest struct {
+type GetMinecraftPlayerUUIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	ParentPath    string                 `protobuf:"bytes,2,opt,name=parent_path,json=parentPath,proto3" json:"parent_path,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type          GameServerEntryType    `protobuf:"varint,4,opt,name=type,proto3,enum=obiente.cloud.gameservers.v1.GameServerEntryType" json:"type,omitempty"`
-	Template      *string                `protobuf:"bytes,5,opt,name=template,proto3,oneof" json:"template,omitempty"` // Optional template identifier for seeded content (required for symlinks - target path)
-	VolumeName    *string                `protobuf:"bytes,6,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
-	ModeOctal     *uint32                `protobuf:"varint,7,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
+	Uuid          *string                `protobuf:"bytes,1,opt,name=uuid,proto3,oneof" json:"uuid,omitempty"` // Player UUID (dashed format, e.g., "550e8400-e29b-41d4-a716-446655440000")
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Current username (may differ from requested username if changed)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGameServerEntryRequest) Reset() {
-	*x = CreateGameServerEntryRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[60]
+func (x *GetMinecraftPlayerUUIDResponse) Reset() {
+	*x = GetMinecraftPlayerUUIDResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameServerEntryRequest) String() string {
+func (x *GetMinecraftPlayerUUIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameServerEntryRequest) ProtoMessage() {}
+func (*GetMinecraftPlayerUUIDResponse) ProtoMessage() {}
 
-func (x *CreateGameServerEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[60]
+func (x *GetMinecraftPlayerUUIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4415,82 +6232,93 @@ func (x *CreateGameServerEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameServerEntryRequest.ProtoReflect.Descriptor instead.
-func (*CreateGameServerEntryRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use GetMinecraftPlayerUUIDResponse.ProtoReflect.Descriptor instead.
+func (*GetMinecraftPlayerUUIDResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *CreateGameServerEntryRequest) GetGameServerId() string {
-	if x != nil {
-		return x.GameServerId
+func (x *GetMinecraftPlayerUUIDResponse) GetUuid() string {
+	if x != nil && x.Uuid != nil {
+		return *x.Uuid
 	}
 	return ""
 }
 
-func (x *CreateGameServerEntryRequest) GetParentPath() string {
-	if x != nil {
-		return x.ParentPath
+func (x *GetMinecraftPlayerUUIDResponse) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *CreateGameServerEntryRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type GetMinecraftPlayerProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // Player UUID (dashed or undashed format)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGameServerEntryRequest) GetType() GameServerEntryType {
-	if x != nil {
-		return x.Type
-	}
-	return GameServerEntryType_GAME_SERVER_ENTRY_TYPE_UNSPECIFIED
+func (x *GetMinecraftPlayerProfileRequest) Reset() {
+	*x = GetMinecraftPlayerProfileRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameServerEntryRequest) GetTemplate() string {
-	if x != nil && x.Template != nil {
-		return *x.Template
-	}
-	return ""
+func (x *GetMinecraftPlayerProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateGameServerEntryRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
+func (*GetMinecraftPlayerProfileRequest) ProtoMessage() {}
+
+func (x *GetMinecraftPlayerProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMinecraftPlayerProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetMinecraftPlayerProfileRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *CreateGameServerEntryRequest) GetModeOctal() uint32 {
-	if x != nil && x.ModeOctal != nil {
-		return *x.ModeOctal
+func (x *GetMinecraftPlayerProfileRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
 	}
-	return 0
+	return ""
 }
 
-type CreateGameServerEntryResponse struct {
+type GetMinecraftPlayerProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entry         *GameServerFile        `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Uuid          *string                `protobuf:"bytes,1,opt,name=uuid,proto3,oneof" json:"uuid,omitempty"`                            // Player UUID (dashed format)
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                            // Current username
+	AvatarUrl     *string                `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"` // Avatar URL (from Crafatar)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGameServerEntryResponse) Reset() {
-	*x = CreateGameServerEntryResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[61]
+func (x *GetMinecraftPlayerProfileResponse) Reset() {
+	*x = GetMinecraftPlayerProfileResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameServerEntryResponse) String() string {
+func (x *GetMinecraftPlayerProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameServerEntryResponse) ProtoMessage() {}
+func (*GetMinecraftPlayerProfileResponse) ProtoMessage() {}
 
-func (x *CreateGameServerEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[61]
+func (x *GetMinecraftPlayerProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4501,46 +6329,71 @@ func (x *CreateGameServerEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameServerEntryResponse.ProtoReflect.Descriptor instead.
-func (*CreateGameServerEntryResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use GetMinecraftPlayerProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetMinecraftPlayerProfileResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *CreateGameServerEntryResponse) GetEntry() *GameServerFile {
-	if x != nil {
-		return x.Entry
+func (x *GetMinecraftPlayerProfileResponse) GetUuid() string {
+	if x != nil && x.Uuid != nil {
+		return *x.Uuid
 	}
-	return nil
+	return ""
 }
 
-type WriteGameServerFileRequest struct {
+func (x *GetMinecraftPlayerProfileResponse) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *GetMinecraftPlayerProfileResponse) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
+	}
+	return ""
+}
+
+type MinecraftProject struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId    string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Path            string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	VolumeName      *string                `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`
-	Content         string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Encoding        string                 `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"` // "text" or "base64"
-	CreateIfMissing bool                   `protobuf:"varint,6,opt,name=create_if_missing,json=createIfMissing,proto3" json:"create_if_missing,omitempty"`
-	ModeOctal       *uint32                `protobuf:"varint,7,opt,name=mode_octal,json=modeOctal,proto3,oneof" json:"mode_octal,omitempty"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug            string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ProjectType     MinecraftProjectType   `protobuf:"varint,5,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
+	IconUrl         string                 `protobuf:"bytes,6,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	Categories      []string               `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+	Loaders         []string               `protobuf:"bytes,8,rep,name=loaders,proto3" json:"loaders,omitempty"`
+	GameVersions    []string               `protobuf:"bytes,9,rep,name=game_versions,json=gameVersions,proto3" json:"game_versions,omitempty"`
+	Authors         []string               `protobuf:"bytes,10,rep,name=authors,proto3" json:"authors,omitempty"`
+	Downloads       int64                  `protobuf:"varint,11,opt,name=downloads,proto3" json:"downloads,omitempty"`
+	Rating          float64                `protobuf:"fixed64,12,opt,name=rating,proto3" json:"rating,omitempty"`
+	LatestVersionId *string                `protobuf:"bytes,13,opt,name=latest_version_id,json=latestVersionId,proto3,oneof" json:"latest_version_id,omitempty"`
+	ProjectUrl      *string                `protobuf:"bytes,14,opt,name=project_url,json=projectUrl,proto3,oneof" json:"project_url,omitempty"`
+	SourceUrl       *string                `protobuf:"bytes,15,opt,name=source_url,json=sourceUrl,proto3,oneof" json:"source_url,omitempty"`
+	IssuesUrl       *string                `protobuf:"bytes,16,opt,name=issues_url,json=issuesUrl,proto3,oneof" json:"issues_url,omitempty"`
+	Body            *string                `protobuf:"bytes,17,opt,name=body,proto3,oneof" json:"body,omitempty"` // Full body/description with markdown
+	Gallery         []string               `protobuf:"bytes,18,rep,name=gallery,proto3" json:"gallery,omitempty"` // Screenshot/image URLs
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *WriteGameServerFileRequest) Reset() {
-	*x = WriteGameServerFileRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[62]
+func (x *MinecraftProject) Reset() {
+	*x = MinecraftProject{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WriteGameServerFileRequest) String() string {
+func (x *MinecraftProject) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteGameServerFileRequest) ProtoMessage() {}
+func (*MinecraftProject) ProtoMessage() {}
 
-func (x *WriteGameServerFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[62]
+func (x *MinecraftProject) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4551,145 +6404,166 @@ func (x *WriteGameServerFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteGameServerFileRequest.ProtoReflect.Descriptor instead.
-func (*WriteGameServerFileRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use MinecraftProject.ProtoReflect.Descriptor instead.
+func (*MinecraftProject) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *WriteGameServerFileRequest) GetGameServerId() string {
+func (x *MinecraftProject) GetId() string {
 	if x != nil {
-		return x.GameServerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *WriteGameServerFileRequest) GetPath() string {
+func (x *MinecraftProject) GetSlug() string {
 	if x != nil {
-		return x.Path
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *WriteGameServerFileRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
+func (x *MinecraftProject) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *WriteGameServerFileRequest) GetContent() string {
+func (x *MinecraftProject) GetDescription() string {
 	if x != nil {
-		return x.Content
+		return x.Description
 	}
 	return ""
 }
 
-func (x *WriteGameServerFileRequest) GetEncoding() string {
+func (x *MinecraftProject) GetProjectType() MinecraftProjectType {
 	if x != nil {
-		return x.Encoding
+		return x.ProjectType
 	}
-	return ""
+	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
 }
 
-func (x *WriteGameServerFileRequest) GetCreateIfMissing() bool {
+func (x *MinecraftProject) GetIconUrl() string {
 	if x != nil {
-		return x.CreateIfMissing
+		return x.IconUrl
 	}
-	return false
+	return ""
 }
 
-func (x *WriteGameServerFileRequest) GetModeOctal() uint32 {
-	if x != nil && x.ModeOctal != nil {
-		return *x.ModeOctal
+func (x *MinecraftProject) GetCategories() []string {
+	if x != nil {
+		return x.Categories
 	}
-	return 0
+	return nil
 }
 
-type WriteGameServerFileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Entry         *GameServerFile        `protobuf:"bytes,2,opt,name=entry,proto3,oneof" json:"entry,omitempty"`
-	Error         *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MinecraftProject) GetLoaders() []string {
+	if x != nil {
+		return x.Loaders
+	}
+	return nil
 }
 
-func (x *WriteGameServerFileResponse) Reset() {
-	*x = WriteGameServerFileResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[63]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MinecraftProject) GetGameVersions() []string {
+	if x != nil {
+		return x.GameVersions
+	}
+	return nil
 }
 
-func (x *WriteGameServerFileResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MinecraftProject) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
 }
 
-func (*WriteGameServerFileResponse) ProtoMessage() {}
+func (x *MinecraftProject) GetDownloads() int64 {
+	if x != nil {
+		return x.Downloads
+	}
+	return 0
+}
 
-func (x *WriteGameServerFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[63]
+func (x *MinecraftProject) GetRating() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Rating
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use WriteGameServerFileResponse.ProtoReflect.Descriptor instead.
-func (*WriteGameServerFileResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{63}
+func (x *MinecraftProject) GetLatestVersionId() string {
+	if x != nil && x.LatestVersionId != nil {
+		return *x.LatestVersionId
+	}
+	return ""
 }
 
-func (x *WriteGameServerFileResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
+func (x *MinecraftProject) GetProjectUrl() string {
+	if x != nil && x.ProjectUrl != nil {
+		return *x.ProjectUrl
 	}
-	return false
+	return ""
 }
 
-func (x *WriteGameServerFileResponse) GetEntry() *GameServerFile {
-	if x != nil {
-		return x.Entry
+func (x *MinecraftProject) GetSourceUrl() string {
+	if x != nil && x.SourceUrl != nil {
+		return *x.SourceUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *WriteGameServerFileResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+func (x *MinecraftProject) GetIssuesUrl() string {
+	if x != nil && x.IssuesUrl != nil {
+		return *x.IssuesUrl
 	}
 	return ""
 }
 
-type ExtractGameServerFileRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId    string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	ZipPath         string                 `protobuf:"bytes,2,opt,name=zip_path,json=zipPath,proto3" json:"zip_path,omitempty"`                         // Path to the zip file to extract (from common.ExtractServerFileRequest)
-	DestinationPath string                 `protobuf:"bytes,3,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"` // Directory path where files should be extracted (from common.ExtractServerFileRequest)
-	VolumeName      *string                `protobuf:"bytes,4,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`          // If specified, extract to this volume instead of container filesystem (from common.ExtractServerFileRequest)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *MinecraftProject) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
 }
 
-func (x *ExtractGameServerFileRequest) Reset() {
-	*x = ExtractGameServerFileRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[64]
+func (x *MinecraftProject) GetGallery() []string {
+	if x != nil {
+		return x.Gallery
+	}
+	return nil
+}
+
+type ListMinecraftProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Query         *string                `protobuf:"bytes,2,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	GameVersions  []string               `protobuf:"bytes,3,rep,name=game_versions,json=gameVersions,proto3" json:"game_versions,omitempty"`
+	Loaders       []string               `protobuf:"bytes,4,rep,name=loaders,proto3" json:"loaders,omitempty"`
+	Categories    []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	Cursor        *string                `protobuf:"bytes,6,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32                 `protobuf:"varint,7,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	ProjectType   MinecraftProjectType   `protobuf:"varint,8,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMinecraftProjectsRequest) Reset() {
+	*x = ListMinecraftProjectsRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExtractGameServerFileRequest) String() string {
+func (x *ListMinecraftProjectsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExtractGameServerFileRequest) ProtoMessage() {}
+func (*ListMinecraftProjectsRequest) ProtoMessage() {}
 
-func (x *ExtractGameServerFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[64]
+func (x *ListMinecraftProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4700,63 +6574,91 @@ func (x *ExtractGameServerFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExtractGameServerFileRequest.ProtoReflect.Descriptor instead.
-func (*ExtractGameServerFileRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ListMinecraftProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListMinecraftProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *ExtractGameServerFileRequest) GetGameServerId() string {
+func (x *ListMinecraftProjectsRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *ExtractGameServerFileRequest) GetZipPath() string {
-	if x != nil {
-		return x.ZipPath
+func (x *ListMinecraftProjectsRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
 	}
 	return ""
 }
 
-func (x *ExtractGameServerFileRequest) GetDestinationPath() string {
+func (x *ListMinecraftProjectsRequest) GetGameVersions() []string {
 	if x != nil {
-		return x.DestinationPath
+		return x.GameVersions
 	}
-	return ""
+	return nil
 }
 
-func (x *ExtractGameServerFileRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
+func (x *ListMinecraftProjectsRequest) GetLoaders() []string {
+	if x != nil {
+		return x.Loaders
+	}
+	return nil
+}
+
+func (x *ListMinecraftProjectsRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListMinecraftProjectsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
 	}
 	return ""
 }
 
-type ExtractGameServerFileResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                     // From common.ExtractServerFileResponse
-	Error          *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`                                    // From common.ExtractServerFileResponse
-	FilesExtracted int32                  `protobuf:"varint,3,opt,name=files_extracted,json=filesExtracted,proto3" json:"files_extracted,omitempty"` // Number of files successfully extracted (from common.ExtractServerFileResponse)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ListMinecraftProjectsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
 }
 
-func (x *ExtractGameServerFileResponse) Reset() {
-	*x = ExtractGameServerFileResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[65]
+func (x *ListMinecraftProjectsRequest) GetProjectType() MinecraftProjectType {
+	if x != nil {
+		return x.ProjectType
+	}
+	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
+}
+
+type ListMinecraftProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*MinecraftProject    `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMinecraftProjectsResponse) Reset() {
+	*x = ListMinecraftProjectsResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExtractGameServerFileResponse) String() string {
+func (x *ListMinecraftProjectsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExtractGameServerFileResponse) ProtoMessage() {}
+func (*ListMinecraftProjectsResponse) ProtoMessage() {}
 
-func (x *ExtractGameServerFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[65]
+func (x *ListMinecraftProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4767,56 +6669,73 @@ func (x *ExtractGameServerFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExtractGameServerFileResponse.ProtoReflect.Descriptor instead.
-func (*ExtractGameServerFileResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use ListMinecraftProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListMinecraftProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *ExtractGameServerFileResponse) GetSuccess() bool {
+func (x *ListMinecraftProjectsResponse) GetProjects() []*MinecraftProject {
 	if x != nil {
-		return x.Success
+		return x.Projects
 	}
-	return false
+	return nil
 }
 
-func (x *ExtractGameServerFileResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+func (x *ListMinecraftProjectsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
 	}
-	return ""
+	return false
 }
 
-func (x *ExtractGameServerFileResponse) GetFilesExtracted() int32 {
-	if x != nil {
-		return x.FilesExtracted
+func (x *ListMinecraftProjectsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
 	}
-	return 0
+	return ""
 }
 
-type CreateGameServerFileArchiveRequest struct {
-	state          protoimpl.MessageState             `protogen:"open.v1"`
-	GameServerId   string                             `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	ArchiveRequest *v1.CreateServerFileArchiveRequest `protobuf:"bytes,2,opt,name=archive_request,json=archiveRequest,proto3" json:"archive_request,omitempty"` // Shared archive request
-	VolumeName     *string                            `protobuf:"bytes,3,opt,name=volume_name,json=volumeName,proto3,oneof" json:"volume_name,omitempty"`       // If specified, create archive in this volume instead of container filesystem
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type InstalledMinecraftProjectFile struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filename            string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	InstalledPath       string                 `protobuf:"bytes,3,opt,name=installed_path,json=installedPath,proto3" json:"installed_path,omitempty"`
+	ProjectType         MinecraftProjectType   `protobuf:"varint,4,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
+	SizeBytes           int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ModifiedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=modified_at,json=modifiedAt,proto3,oneof" json:"modified_at,omitempty"`
+	Managed             bool                   `protobuf:"varint,7,opt,name=managed,proto3" json:"managed,omitempty"`
+	ProjectId           *string                `protobuf:"bytes,8,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	ProjectSlug         *string                `protobuf:"bytes,9,opt,name=project_slug,json=projectSlug,proto3,oneof" json:"project_slug,omitempty"`
+	Title               *string                `protobuf:"bytes,10,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	IconUrl             *string                `protobuf:"bytes,11,opt,name=icon_url,json=iconUrl,proto3,oneof" json:"icon_url,omitempty"`
+	VersionId           *string                `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3,oneof" json:"version_id,omitempty"`
+	VersionNumber       *string                `protobuf:"bytes,13,opt,name=version_number,json=versionNumber,proto3,oneof" json:"version_number,omitempty"`
+	GameVersions        []string               `protobuf:"bytes,14,rep,name=game_versions,json=gameVersions,proto3" json:"game_versions,omitempty"`
+	Loaders             []string               `protobuf:"bytes,15,rep,name=loaders,proto3" json:"loaders,omitempty"`
+	InstalledAt         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=installed_at,json=installedAt,proto3,oneof" json:"installed_at,omitempty"`
+	UpdateAvailable     bool                   `protobuf:"varint,17,opt,name=update_available,json=updateAvailable,proto3" json:"update_available,omitempty"`
+	LatestVersionId     *string                `protobuf:"bytes,18,opt,name=latest_version_id,json=latestVersionId,proto3,oneof" json:"latest_version_id,omitempty"`
+	LatestVersionNumber *string                `protobuf:"bytes,19,opt,name=latest_version_number,json=latestVersionNumber,proto3,oneof" json:"latest_version_number,omitempty"`
+	LatestGameVersions  []string               `protobuf:"bytes,20,rep,name=latest_game_versions,json=latestGameVersions,proto3" json:"latest_game_versions,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *CreateGameServerFileArchiveRequest) Reset() {
-	*x = CreateGameServerFileArchiveRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[66]
+func (x *InstalledMinecraftProjectFile) Reset() {
+	*x = InstalledMinecraftProjectFile{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameServerFileArchiveRequest) String() string {
+func (x *InstalledMinecraftProjectFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameServerFileArchiveRequest) ProtoMessage() {}
+func (*InstalledMinecraftProjectFile) ProtoMessage() {}
 
-func (x *CreateGameServerFileArchiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[66]
+func (x *InstalledMinecraftProjectFile) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4827,194 +6746,175 @@ func (x *CreateGameServerFileArchiveRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameServerFileArchiveRequest.ProtoReflect.Descriptor instead.
-func (*CreateGameServerFileArchiveRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use InstalledMinecraftProjectFile.ProtoReflect.Descriptor instead.
+func (*InstalledMinecraftProjectFile) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *CreateGameServerFileArchiveRequest) GetGameServerId() string {
+func (x *InstalledMinecraftProjectFile) GetId() string {
 	if x != nil {
-		return x.GameServerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateGameServerFileArchiveRequest) GetArchiveRequest() *v1.CreateServerFileArchiveRequest {
+func (x *InstalledMinecraftProjectFile) GetFilename() string {
 	if x != nil {
-		return x.ArchiveRequest
+		return x.Filename
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateGameServerFileArchiveRequest) GetVolumeName() string {
-	if x != nil && x.VolumeName != nil {
-		return *x.VolumeName
+func (x *InstalledMinecraftProjectFile) GetInstalledPath() string {
+	if x != nil {
+		return x.InstalledPath
 	}
 	return ""
 }
 
-type CreateGameServerFileArchiveResponse struct {
-	state           protoimpl.MessageState              `protogen:"open.v1"`
-	ArchiveResponse *v1.CreateServerFileArchiveResponse `protobuf:"bytes,1,opt,name=archive_response,json=archiveResponse,proto3" json:"archive_response,omitempty"` // Shared archive response
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *CreateGameServerFileArchiveResponse) Reset() {
-	*x = CreateGameServerFileArchiveResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[67]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateGameServerFileArchiveResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateGameServerFileArchiveResponse) ProtoMessage() {}
-
-func (x *CreateGameServerFileArchiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[67]
+func (x *InstalledMinecraftProjectFile) GetProjectType() MinecraftProjectType {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProjectType
 	}
-	return mi.MessageOf(x)
+	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
 }
 
-// Deprecated: Use CreateGameServerFileArchiveResponse.ProtoReflect.Descriptor instead.
-func (*CreateGameServerFileArchiveResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{67}
+func (x *InstalledMinecraftProjectFile) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
 }
 
-func (x *CreateGameServerFileArchiveResponse) GetArchiveResponse() *v1.CreateServerFileArchiveResponse {
+func (x *InstalledMinecraftProjectFile) GetModifiedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ArchiveResponse
+		return x.ModifiedAt
 	}
 	return nil
 }
 
-type GameServerFileTransferCredential struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GameServerFileTransferCredential) Reset() {
-	*x = GameServerFileTransferCredential{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[68]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InstalledMinecraftProjectFile) GetManaged() bool {
+	if x != nil {
+		return x.Managed
+	}
+	return false
 }
 
-func (x *GameServerFileTransferCredential) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InstalledMinecraftProjectFile) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
 }
 
-func (*GameServerFileTransferCredential) ProtoMessage() {}
-
-func (x *GameServerFileTransferCredential) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[68]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *InstalledMinecraftProjectFile) GetProjectSlug() string {
+	if x != nil && x.ProjectSlug != nil {
+		return *x.ProjectSlug
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GameServerFileTransferCredential.ProtoReflect.Descriptor instead.
-func (*GameServerFileTransferCredential) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{68}
+func (x *InstalledMinecraftProjectFile) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
 }
 
-func (x *GameServerFileTransferCredential) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *InstalledMinecraftProjectFile) GetIconUrl() string {
+	if x != nil && x.IconUrl != nil {
+		return *x.IconUrl
 	}
 	return ""
 }
 
-func (x *GameServerFileTransferCredential) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *InstalledMinecraftProjectFile) GetVersionId() string {
+	if x != nil && x.VersionId != nil {
+		return *x.VersionId
 	}
 	return ""
 }
 
-func (x *GameServerFileTransferCredential) GetUsername() string {
-	if x != nil {
-		return x.Username
+func (x *InstalledMinecraftProjectFile) GetVersionNumber() string {
+	if x != nil && x.VersionNumber != nil {
+		return *x.VersionNumber
 	}
 	return ""
 }
 
-func (x *GameServerFileTransferCredential) GetScopes() []string {
+func (x *InstalledMinecraftProjectFile) GetGameVersions() []string {
 	if x != nil {
-		return x.Scopes
+		return x.GameVersions
 	}
 	return nil
 }
 
-func (x *GameServerFileTransferCredential) GetLastUsedAt() *timestamppb.Timestamp {
+func (x *InstalledMinecraftProjectFile) GetLoaders() []string {
 	if x != nil {
-		return x.LastUsedAt
+		return x.Loaders
 	}
 	return nil
 }
 
-func (x *GameServerFileTransferCredential) GetExpiresAt() *timestamppb.Timestamp {
+func (x *InstalledMinecraftProjectFile) GetInstalledAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ExpiresAt
+		return x.InstalledAt
 	}
 	return nil
 }
 
-func (x *GameServerFileTransferCredential) GetCreatedAt() *timestamppb.Timestamp {
+func (x *InstalledMinecraftProjectFile) GetUpdateAvailable() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.UpdateAvailable
+	}
+	return false
+}
+
+func (x *InstalledMinecraftProjectFile) GetLatestVersionId() string {
+	if x != nil && x.LatestVersionId != nil {
+		return *x.LatestVersionId
+	}
+	return ""
+}
+
+func (x *InstalledMinecraftProjectFile) GetLatestVersionNumber() string {
+	if x != nil && x.LatestVersionNumber != nil {
+		return *x.LatestVersionNumber
+	}
+	return ""
+}
+
+func (x *InstalledMinecraftProjectFile) GetLatestGameVersions() []string {
+	if x != nil {
+		return x.LatestGameVersions
 	}
 	return nil
 }
 
-type GameServerFileTransferConnectionInfo struct {
+type ListInstalledMinecraftProjectsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Protocol      string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Command       string                 `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	ProjectType   MinecraftProjectType   `protobuf:"varint,2,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
+	CheckUpdates  *bool                  `protobuf:"varint,3,opt,name=check_updates,json=checkUpdates,proto3,oneof" json:"check_updates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameServerFileTransferConnectionInfo) Reset() {
-	*x = GameServerFileTransferConnectionInfo{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[69]
+func (x *ListInstalledMinecraftProjectsRequest) Reset() {
+	*x = ListInstalledMinecraftProjectsRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServerFileTransferConnectionInfo) String() string {
+func (x *ListInstalledMinecraftProjectsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServerFileTransferConnectionInfo) ProtoMessage() {}
+func (*ListInstalledMinecraftProjectsRequest) ProtoMessage() {}
 
-func (x *GameServerFileTransferConnectionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[69]
+func (x *ListInstalledMinecraftProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5025,68 +6925,54 @@ func (x *GameServerFileTransferConnectionInfo) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServerFileTransferConnectionInfo.ProtoReflect.Descriptor instead.
-func (*GameServerFileTransferConnectionInfo) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{69}
-}
-
-func (x *GameServerFileTransferConnectionInfo) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
-}
-
-func (x *GameServerFileTransferConnectionInfo) GetPort() int32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
+// Deprecated: Use ListInstalledMinecraftProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListInstalledMinecraftProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GameServerFileTransferConnectionInfo) GetUsername() string {
+func (x *ListInstalledMinecraftProjectsRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Username
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GameServerFileTransferConnectionInfo) GetProtocol() string {
+func (x *ListInstalledMinecraftProjectsRequest) GetProjectType() MinecraftProjectType {
 	if x != nil {
-		return x.Protocol
+		return x.ProjectType
 	}
-	return ""
+	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
 }
 
-func (x *GameServerFileTransferConnectionInfo) GetCommand() string {
-	if x != nil {
-		return x.Command
+func (x *ListInstalledMinecraftProjectsRequest) GetCheckUpdates() bool {
+	if x != nil && x.CheckUpdates != nil {
+		return *x.CheckUpdates
 	}
-	return ""
+	return false
 }
 
-type ListGameServerFileTransferCredentialsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+type ListInstalledMinecraftProjectsResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Files         []*InstalledMinecraftProjectFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGameServerFileTransferCredentialsRequest) Reset() {
-	*x = ListGameServerFileTransferCredentialsRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[70]
+func (x *ListInstalledMinecraftProjectsResponse) Reset() {
+	*x = ListInstalledMinecraftProjectsResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGameServerFileTransferCredentialsRequest) String() string {
+func (x *ListInstalledMinecraftProjectsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGameServerFileTransferCredentialsRequest) ProtoMessage() {}
+func (*ListInstalledMinecraftProjectsResponse) ProtoMessage() {}
 
-func (x *ListGameServerFileTransferCredentialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[70]
+func (x *ListInstalledMinecraftProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5097,41 +6983,44 @@ func (x *ListGameServerFileTransferCredentialsRequest) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGameServerFileTransferCredentialsRequest.ProtoReflect.Descriptor instead.
-func (*ListGameServerFileTransferCredentialsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use ListInstalledMinecraftProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListInstalledMinecraftProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *ListGameServerFileTransferCredentialsRequest) GetGameServerId() string {
+func (x *ListInstalledMinecraftProjectsResponse) GetFiles() []*InstalledMinecraftProjectFile {
 	if x != nil {
-		return x.GameServerId
+		return x.Files
 	}
-	return ""
+	return nil
 }
 
-type ListGameServerFileTransferCredentialsResponse struct {
-	state         protoimpl.MessageState                `protogen:"open.v1"`
-	Credentials   []*GameServerFileTransferCredential   `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
-	Connection    *GameServerFileTransferConnectionInfo `protobuf:"bytes,2,opt,name=connection,proto3" json:"connection,omitempty"`
+type MinecraftProjectFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Hashes        map[string]string      `protobuf:"bytes,4,rep,name=hashes,proto3" json:"hashes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Primary       bool                   `protobuf:"varint,5,opt,name=primary,proto3" json:"primary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGameServerFileTransferCredentialsResponse) Reset() {
-	*x = ListGameServerFileTransferCredentialsResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[71]
+func (x *MinecraftProjectFile) Reset() {
+	*x = MinecraftProjectFile{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGameServerFileTransferCredentialsResponse) String() string {
+func (x *MinecraftProjectFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGameServerFileTransferCredentialsResponse) ProtoMessage() {}
+func (*MinecraftProjectFile) ProtoMessage() {}
 
-func (x *ListGameServerFileTransferCredentialsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[71]
+func (x *MinecraftProjectFile) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5142,50 +7031,78 @@ func (x *ListGameServerFileTransferCredentialsResponse) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGameServerFileTransferCredentialsResponse.ProtoReflect.Descriptor instead.
-func (*ListGameServerFileTransferCredentialsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use MinecraftProjectFile.ProtoReflect.Descriptor instead.
+func (*MinecraftProjectFile) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *ListGameServerFileTransferCredentialsResponse) GetCredentials() []*GameServerFileTransferCredential {
+func (x *MinecraftProjectFile) GetFilename() string {
 	if x != nil {
-		return x.Credentials
+		return x.Filename
 	}
-	return nil
+	return ""
 }
 
-func (x *ListGameServerFileTransferCredentialsResponse) GetConnection() *GameServerFileTransferConnectionInfo {
+func (x *MinecraftProjectFile) GetUrl() string {
 	if x != nil {
-		return x.Connection
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MinecraftProjectFile) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *MinecraftProjectFile) GetHashes() map[string]string {
+	if x != nil {
+		return x.Hashes
 	}
 	return nil
 }
 
-type CreateGameServerFileTransferCredentialRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MinecraftProjectFile) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
 }
 
-func (x *CreateGameServerFileTransferCredentialRequest) Reset() {
-	*x = CreateGameServerFileTransferCredentialRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[72]
+type MinecraftProjectVersion struct {
+	state               protoimpl.MessageState  `protogen:"open.v1"`
+	Id                  string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	VersionNumber       string                  `protobuf:"bytes,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	GameVersions        []string                `protobuf:"bytes,4,rep,name=game_versions,json=gameVersions,proto3" json:"game_versions,omitempty"`
+	Loaders             []string                `protobuf:"bytes,5,rep,name=loaders,proto3" json:"loaders,omitempty"`
+	ServerSideSupported bool                    `protobuf:"varint,6,opt,name=server_side_supported,json=serverSideSupported,proto3" json:"server_side_supported,omitempty"`
+	ClientSideSupported bool                    `protobuf:"varint,7,opt,name=client_side_supported,json=clientSideSupported,proto3" json:"client_side_supported,omitempty"`
+	PublishedAt         *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3,oneof" json:"published_at,omitempty"`
+	Changelog           *string                 `protobuf:"bytes,9,opt,name=changelog,proto3,oneof" json:"changelog,omitempty"`
+	Files               []*MinecraftProjectFile `protobuf:"bytes,10,rep,name=files,proto3" json:"files,omitempty"`
+	VersionType         *string                 `protobuf:"bytes,11,opt,name=version_type,json=versionType,proto3,oneof" json:"version_type,omitempty"` // "release", "beta", "alpha"
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MinecraftProjectVersion) Reset() {
+	*x = MinecraftProjectVersion{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameServerFileTransferCredentialRequest) String() string {
+func (x *MinecraftProjectVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameServerFileTransferCredentialRequest) ProtoMessage() {}
+func (*MinecraftProjectVersion) ProtoMessage() {}
 
-func (x *CreateGameServerFileTransferCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[72]
+func (x *MinecraftProjectVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5196,122 +7113,116 @@ func (x *CreateGameServerFileTransferCredentialRequest) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameServerFileTransferCredentialRequest.ProtoReflect.Descriptor instead.
-func (*CreateGameServerFileTransferCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use MinecraftProjectVersion.ProtoReflect.Descriptor instead.
+func (*MinecraftProjectVersion) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *CreateGameServerFileTransferCredentialRequest) GetGameServerId() string {
+func (x *MinecraftProjectVersion) GetId() string {
 	if x != nil {
-		return x.GameServerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateGameServerFileTransferCredentialRequest) GetName() string {
+func (x *MinecraftProjectVersion) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateGameServerFileTransferCredentialRequest) GetScopes() []string {
+func (x *MinecraftProjectVersion) GetVersionNumber() string {
 	if x != nil {
-		return x.Scopes
+		return x.VersionNumber
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateGameServerFileTransferCredentialRequest) GetExpiresAt() *timestamppb.Timestamp {
+func (x *MinecraftProjectVersion) GetGameVersions() []string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.GameVersions
 	}
 	return nil
 }
 
-type CreateGameServerFileTransferCredentialResponse struct {
-	state         protoimpl.MessageState                `protogen:"open.v1"`
-	Credential    *GameServerFileTransferCredential     `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
-	Password      string                                `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Connection    *GameServerFileTransferConnectionInfo `protobuf:"bytes,3,opt,name=connection,proto3" json:"connection,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateGameServerFileTransferCredentialResponse) Reset() {
-	*x = CreateGameServerFileTransferCredentialResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[73]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateGameServerFileTransferCredentialResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MinecraftProjectVersion) GetLoaders() []string {
+	if x != nil {
+		return x.Loaders
+	}
+	return nil
 }
 
-func (*CreateGameServerFileTransferCredentialResponse) ProtoMessage() {}
-
-func (x *CreateGameServerFileTransferCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[73]
+func (x *MinecraftProjectVersion) GetServerSideSupported() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ServerSideSupported
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use CreateGameServerFileTransferCredentialResponse.ProtoReflect.Descriptor instead.
-func (*CreateGameServerFileTransferCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{73}
+func (x *MinecraftProjectVersion) GetClientSideSupported() bool {
+	if x != nil {
+		return x.ClientSideSupported
+	}
+	return false
 }
 
-func (x *CreateGameServerFileTransferCredentialResponse) GetCredential() *GameServerFileTransferCredential {
+func (x *MinecraftProjectVersion) GetPublishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Credential
+		return x.PublishedAt
 	}
 	return nil
 }
 
-func (x *CreateGameServerFileTransferCredentialResponse) GetPassword() string {
-	if x != nil {
-		return x.Password
+func (x *MinecraftProjectVersion) GetChangelog() string {
+	if x != nil && x.Changelog != nil {
+		return *x.Changelog
 	}
 	return ""
 }
 
-func (x *CreateGameServerFileTransferCredentialResponse) GetConnection() *GameServerFileTransferConnectionInfo {
+func (x *MinecraftProjectVersion) GetFiles() []*MinecraftProjectFile {
 	if x != nil {
-		return x.Connection
+		return x.Files
 	}
 	return nil
 }
 
-type RevokeGameServerFileTransferCredentialRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	CredentialId  string                 `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MinecraftProjectVersion) GetVersionType() string {
+	if x != nil && x.VersionType != nil {
+		return *x.VersionType
+	}
+	return ""
 }
 
-func (x *RevokeGameServerFileTransferCredentialRequest) Reset() {
-	*x = RevokeGameServerFileTransferCredentialRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[74]
+type GetMinecraftProjectVersionsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId       string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	ProjectId          string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	GameVersions       []string               `protobuf:"bytes,3,rep,name=game_versions,json=gameVersions,proto3" json:"game_versions,omitempty"`
+	Loaders            []string               `protobuf:"bytes,4,rep,name=loaders,proto3" json:"loaders,omitempty"`
+	ProjectType        MinecraftProjectType   `protobuf:"varint,5,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
+	Limit              *int32                 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	IncludePrereleases *bool                  `protobuf:"varint,7,opt,name=include_prereleases,json=includePrereleases,proto3,oneof" json:"include_prereleases,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetMinecraftProjectVersionsRequest) Reset() {
+	*x = GetMinecraftProjectVersionsRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeGameServerFileTransferCredentialRequest) String() string {
+func (x *GetMinecraftProjectVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeGameServerFileTransferCredentialRequest) ProtoMessage() {}
+func (*GetMinecraftProjectVersionsRequest) ProtoMessage() {}
 
-func (x *RevokeGameServerFileTransferCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[74]
+func (x *GetMinecraftProjectVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5322,137 +7233,82 @@ func (x *RevokeGameServerFileTransferCredentialRequest) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeGameServerFileTransferCredentialRequest.ProtoReflect.Descriptor instead.
-func (*RevokeGameServerFileTransferCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use GetMinecraftProjectVersionsRequest.ProtoReflect.Descriptor instead.
+func (*GetMinecraftProjectVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *RevokeGameServerFileTransferCredentialRequest) GetGameServerId() string {
+func (x *GetMinecraftProjectVersionsRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *RevokeGameServerFileTransferCredentialRequest) GetCredentialId() string {
+func (x *GetMinecraftProjectVersionsRequest) GetProjectId() string {
 	if x != nil {
-		return x.CredentialId
+		return x.ProjectId
 	}
 	return ""
 }
 
-type RevokeGameServerFileTransferCredentialResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RevokeGameServerFileTransferCredentialResponse) Reset() {
-	*x = RevokeGameServerFileTransferCredentialResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[75]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RevokeGameServerFileTransferCredentialResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RevokeGameServerFileTransferCredentialResponse) ProtoMessage() {}
-
-func (x *RevokeGameServerFileTransferCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[75]
+func (x *GetMinecraftProjectVersionsRequest) GetGameVersions() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.GameVersions
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RevokeGameServerFileTransferCredentialResponse.ProtoReflect.Descriptor instead.
-func (*RevokeGameServerFileTransferCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{75}
+	return nil
 }
 
-func (x *RevokeGameServerFileTransferCredentialResponse) GetSuccess() bool {
+func (x *GetMinecraftProjectVersionsRequest) GetLoaders() []string {
 	if x != nil {
-		return x.Success
+		return x.Loaders
 	}
-	return false
-}
-
-// Minecraft player lookup messages
-type GetMinecraftPlayerUUIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // Minecraft username
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetMinecraftPlayerUUIDRequest) Reset() {
-	*x = GetMinecraftPlayerUUIDRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[76]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetMinecraftPlayerUUIDRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*GetMinecraftPlayerUUIDRequest) ProtoMessage() {}
-
-func (x *GetMinecraftPlayerUUIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[76]
+func (x *GetMinecraftProjectVersionsRequest) GetProjectType() MinecraftProjectType {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProjectType
 	}
-	return mi.MessageOf(x)
+	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
 }
 
-// Deprecated: Use GetMinecraftPlayerUUIDRequest.ProtoReflect.Descriptor instead.
-func (*GetMinecraftPlayerUUIDRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{76}
+func (x *GetMinecraftProjectVersionsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
 }
 
-func (x *GetMinecraftPlayerUUIDRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
+func (x *GetMinecraftProjectVersionsRequest) GetIncludePrereleases() bool {
+	if x != nil && x.IncludePrereleases != nil {
+		return *x.IncludePrereleases
 	}
-	return ""
+	return false
 }
 
-type GetMinecraftPlayerUUIDResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          *string                `protobuf:"bytes,1,opt,name=uuid,proto3,oneof" json:"uuid,omitempty"` // Player UUID (dashed format, e.g., "550e8400-e29b-41d4-a716-446655440000")
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Current username (may differ from requested username if changed)
+type GetMinecraftProjectVersionsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Versions      []*MinecraftProjectVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMinecraftPlayerUUIDResponse) Reset() {
-	*x = GetMinecraftPlayerUUIDResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[77]
+func (x *GetMinecraftProjectVersionsResponse) Reset() {
+	*x = GetMinecraftProjectVersionsResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMinecraftPlayerUUIDResponse) String() string {
+func (x *GetMinecraftProjectVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinecraftPlayerUUIDResponse) ProtoMessage() {}
+func (*GetMinecraftProjectVersionsResponse) ProtoMessage() {}
 
-func (x *GetMinecraftPlayerUUIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[77]
+func (x *GetMinecraftProjectVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5463,47 +7319,41 @@ func (x *GetMinecraftPlayerUUIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinecraftPlayerUUIDResponse.ProtoReflect.Descriptor instead.
-func (*GetMinecraftPlayerUUIDResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{77}
-}
-
-func (x *GetMinecraftPlayerUUIDResponse) GetUuid() string {
-	if x != nil && x.Uuid != nil {
-		return *x.Uuid
-	}
-	return ""
+// Deprecated: Use GetMinecraftProjectVersionsResponse.ProtoReflect.Descriptor instead.
+func (*GetMinecraftProjectVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetMinecraftPlayerUUIDResponse) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *GetMinecraftProjectVersionsResponse) GetVersions() []*MinecraftProjectVersion {
+	if x != nil {
+		return x.Versions
 	}
-	return ""
+	return nil
 }
 
-type GetMinecraftPlayerProfileRequest struct {
+type GetMinecraftProjectRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // Player UUID (dashed or undashed format)
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMinecraftPlayerProfileRequest) Reset() {
-	*x = GetMinecraftPlayerProfileRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[78]
+func (x *GetMinecraftProjectRequest) Reset() {
+	*x = GetMinecraftProjectRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMinecraftPlayerProfileRequest) String() string {
+func (x *GetMinecraftProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinecraftPlayerProfileRequest) ProtoMessage() {}
+func (*GetMinecraftProjectRequest) ProtoMessage() {}
 
-func (x *GetMinecraftPlayerProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[78]
+func (x *GetMinecraftProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5514,42 +7364,47 @@ func (x *GetMinecraftPlayerProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinecraftPlayerProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetMinecraftPlayerProfileRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use GetMinecraftProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetMinecraftProjectRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetMinecraftPlayerProfileRequest) GetUuid() string {
+func (x *GetMinecraftProjectRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Uuid
+		return x.GameServerId
 	}
 	return ""
 }
 
-type GetMinecraftPlayerProfileResponse struct {
+func (x *GetMinecraftProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetMinecraftProjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          *string                `protobuf:"bytes,1,opt,name=uuid,proto3,oneof" json:"uuid,omitempty"`                            // Player UUID (dashed format)
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                            // Current username
-	AvatarUrl     *string                `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"` // Avatar URL (from Crafatar)
+	Project       *MinecraftProject      `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMinecraftPlayerProfileResponse) Reset() {
-	*x = GetMinecraftPlayerProfileResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[79]
+func (x *GetMinecraftProjectResponse) Reset() {
+	*x = GetMinecraftProjectResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMinecraftPlayerProfileResponse) String() string {
+func (x *GetMinecraftProjectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinecraftPlayerProfileResponse) ProtoMessage() {}
+func (*GetMinecraftProjectResponse) ProtoMessage() {}
 
-func (x *GetMinecraftPlayerProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[79]
+func (x *GetMinecraftProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5560,71 +7415,46 @@ func (x *GetMinecraftPlayerProfileResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinecraftPlayerProfileResponse.ProtoReflect.Descriptor instead.
-func (*GetMinecraftPlayerProfileResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{79}
-}
-
-func (x *GetMinecraftPlayerProfileResponse) GetUuid() string {
-	if x != nil && x.Uuid != nil {
-		return *x.Uuid
-	}
-	return ""
-}
-
-func (x *GetMinecraftPlayerProfileResponse) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+// Deprecated: Use GetMinecraftProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetMinecraftProjectResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetMinecraftPlayerProfileResponse) GetAvatarUrl() string {
-	if x != nil && x.AvatarUrl != nil {
-		return *x.AvatarUrl
+func (x *GetMinecraftProjectResponse) GetProject() *MinecraftProject {
+	if x != nil {
+		return x.Project
 	}
-	return ""
+	return nil
 }
 
-type MinecraftProject struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Slug            string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
-	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	ProjectType     MinecraftProjectType   `protobuf:"varint,5,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
-	IconUrl         string                 `protobuf:"bytes,6,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	Categories      []string               `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
-	Loaders         []string               `protobuf:"bytes,8,rep,name=loaders,proto3" json:"loaders,omitempty"`
-	GameVersions    []string               `protobuf:"bytes,9,rep,name=game_versions,json=gameVersions,proto3" json:"game_versions,omitempty"`
-	Authors         []string               `protobuf:"bytes,10,rep,name=authors,proto3" json:"authors,omitempty"`
-	Downloads       int64                  `protobuf:"varint,11,opt,name=downloads,proto3" json:"downloads,omitempty"`
-	Rating          float64                `protobuf:"fixed64,12,opt,name=rating,proto3" json:"rating,omitempty"`
-	LatestVersionId *string                `protobuf:"bytes,13,opt,name=latest_version_id,json=latestVersionId,proto3,oneof" json:"latest_version_id,omitempty"`
-	ProjectUrl      *string                `protobuf:"bytes,14,opt,name=project_url,json=projectUrl,proto3,oneof" json:"project_url,omitempty"`
-	SourceUrl       *string                `protobuf:"bytes,15,opt,name=source_url,json=sourceUrl,proto3,oneof" json:"source_url,omitempty"`
-	IssuesUrl       *string                `protobuf:"bytes,16,opt,name=issues_url,json=issuesUrl,proto3,oneof" json:"issues_url,omitempty"`
-	Body            *string                `protobuf:"bytes,17,opt,name=body,proto3,oneof" json:"body,omitempty"` // Full body/description with markdown
-	Gallery         []string               `protobuf:"bytes,18,rep,name=gallery,proto3" json:"gallery,omitempty"` // Screenshot/image URLs
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type InstallMinecraftProjectFileRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId   string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	VersionId      string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	ProjectType    MinecraftProjectType   `protobuf:"varint,4,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
+	ProjectTitle   *string                `protobuf:"bytes,5,opt,name=project_title,json=projectTitle,proto3,oneof" json:"project_title,omitempty"`
+	ProjectSlug    *string                `protobuf:"bytes,6,opt,name=project_slug,json=projectSlug,proto3,oneof" json:"project_slug,omitempty"`
+	ProjectIconUrl *string                `protobuf:"bytes,7,opt,name=project_icon_url,json=projectIconUrl,proto3,oneof" json:"project_icon_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *MinecraftProject) Reset() {
-	*x = MinecraftProject{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[80]
+func (x *InstallMinecraftProjectFileRequest) Reset() {
+	*x = InstallMinecraftProjectFileRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MinecraftProject) String() string {
+func (x *InstallMinecraftProjectFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MinecraftProject) ProtoMessage() {}
+func (*InstallMinecraftProjectFileRequest) ProtoMessage() {}
 
-func (x *MinecraftProject) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[80]
+func (x *InstallMinecraftProjectFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5635,166 +7465,165 @@ func (x *MinecraftProject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MinecraftProject.ProtoReflect.Descriptor instead.
-func (*MinecraftProject) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{80}
-}
-
-func (x *MinecraftProject) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use InstallMinecraftProjectFileRequest.ProtoReflect.Descriptor instead.
+func (*InstallMinecraftProjectFileRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *MinecraftProject) GetSlug() string {
+func (x *InstallMinecraftProjectFileRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Slug
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *MinecraftProject) GetTitle() string {
+func (x *InstallMinecraftProjectFileRequest) GetProjectId() string {
 	if x != nil {
-		return x.Title
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *MinecraftProject) GetDescription() string {
+func (x *InstallMinecraftProjectFileRequest) GetVersionId() string {
 	if x != nil {
-		return x.Description
+		return x.VersionId
 	}
 	return ""
 }
 
-func (x *MinecraftProject) GetProjectType() MinecraftProjectType {
+func (x *InstallMinecraftProjectFileRequest) GetProjectType() MinecraftProjectType {
 	if x != nil {
 		return x.ProjectType
 	}
 	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
 }
 
-func (x *MinecraftProject) GetIconUrl() string {
-	if x != nil {
-		return x.IconUrl
+func (x *InstallMinecraftProjectFileRequest) GetProjectTitle() string {
+	if x != nil && x.ProjectTitle != nil {
+		return *x.ProjectTitle
 	}
 	return ""
 }
 
-func (x *MinecraftProject) GetCategories() []string {
-	if x != nil {
-		return x.Categories
+func (x *InstallMinecraftProjectFileRequest) GetProjectSlug() string {
+	if x != nil && x.ProjectSlug != nil {
+		return *x.ProjectSlug
 	}
-	return nil
+	return ""
 }
 
-func (x *MinecraftProject) GetLoaders() []string {
-	if x != nil {
-		return x.Loaders
+func (x *InstallMinecraftProjectFileRequest) GetProjectIconUrl() string {
+	if x != nil && x.ProjectIconUrl != nil {
+		return *x.ProjectIconUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *MinecraftProject) GetGameVersions() []string {
-	if x != nil {
-		return x.GameVersions
-	}
-	return nil
+type InstallMinecraftProjectFileResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Filename        string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	InstalledPath   string                 `protobuf:"bytes,3,opt,name=installed_path,json=installedPath,proto3" json:"installed_path,omitempty"`
+	RestartRequired bool                   `protobuf:"varint,4,opt,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	Message         *string                `protobuf:"bytes,5,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *MinecraftProject) GetAuthors() []string {
-	if x != nil {
-		return x.Authors
-	}
-	return nil
+func (x *InstallMinecraftProjectFileResponse) Reset() {
+	*x = InstallMinecraftProjectFileResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MinecraftProject) GetDownloads() int64 {
-	if x != nil {
-		return x.Downloads
-	}
-	return 0
+func (x *InstallMinecraftProjectFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MinecraftProject) GetRating() float64 {
+func (*InstallMinecraftProjectFileResponse) ProtoMessage() {}
+
+func (x *InstallMinecraftProjectFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[105]
 	if x != nil {
-		return x.Rating
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MinecraftProject) GetLatestVersionId() string {
-	if x != nil && x.LatestVersionId != nil {
-		return *x.LatestVersionId
-	}
-	return ""
+// Deprecated: Use InstallMinecraftProjectFileResponse.ProtoReflect.Descriptor instead.
+func (*InstallMinecraftProjectFileResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *MinecraftProject) GetProjectUrl() string {
-	if x != nil && x.ProjectUrl != nil {
-		return *x.ProjectUrl
+func (x *InstallMinecraftProjectFileResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *MinecraftProject) GetSourceUrl() string {
-	if x != nil && x.SourceUrl != nil {
-		return *x.SourceUrl
+func (x *InstallMinecraftProjectFileResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *MinecraftProject) GetIssuesUrl() string {
-	if x != nil && x.IssuesUrl != nil {
-		return *x.IssuesUrl
+func (x *InstallMinecraftProjectFileResponse) GetInstalledPath() string {
+	if x != nil {
+		return x.InstalledPath
 	}
 	return ""
 }
 
-func (x *MinecraftProject) GetBody() string {
-	if x != nil && x.Body != nil {
-		return *x.Body
+func (x *InstallMinecraftProjectFileResponse) GetRestartRequired() bool {
+	if x != nil {
+		return x.RestartRequired
 	}
-	return ""
+	return false
 }
 
-func (x *MinecraftProject) GetGallery() []string {
-	if x != nil {
-		return x.Gallery
+func (x *InstallMinecraftProjectFileResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
 	}
-	return nil
+	return ""
 }
 
-type ListMinecraftProjectsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Query         *string                `protobuf:"bytes,2,opt,name=query,proto3,oneof" json:"query,omitempty"`
-	GameVersions  []string               `protobuf:"bytes,3,rep,name=game_versions,json=gameVersions,proto3" json:"game_versions,omitempty"`
-	Loaders       []string               `protobuf:"bytes,4,rep,name=loaders,proto3" json:"loaders,omitempty"`
-	Categories    []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
-	Cursor        *string                `protobuf:"bytes,6,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit         *int32                 `protobuf:"varint,7,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	ProjectType   MinecraftProjectType   `protobuf:"varint,8,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateMinecraftProjectFileRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId    string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	ProjectId       string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	VersionId       string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	ProjectType     MinecraftProjectType   `protobuf:"varint,4,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
+	CurrentFilename string                 `protobuf:"bytes,5,opt,name=current_filename,json=currentFilename,proto3" json:"current_filename,omitempty"`
+	ProjectTitle    *string                `protobuf:"bytes,6,opt,name=project_title,json=projectTitle,proto3,oneof" json:"project_title,omitempty"`
+	ProjectSlug     *string                `protobuf:"bytes,7,opt,name=project_slug,json=projectSlug,proto3,oneof" json:"project_slug,omitempty"`
+	ProjectIconUrl  *string                `protobuf:"bytes,8,opt,name=project_icon_url,json=projectIconUrl,proto3,oneof" json:"project_icon_url,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListMinecraftProjectsRequest) Reset() {
-	*x = ListMinecraftProjectsRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[81]
+func (x *UpdateMinecraftProjectFileRequest) Reset() {
+	*x = UpdateMinecraftProjectFileRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMinecraftProjectsRequest) String() string {
+func (x *UpdateMinecraftProjectFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMinecraftProjectsRequest) ProtoMessage() {}
+func (*UpdateMinecraftProjectFileRequest) ProtoMessage() {}
 
-func (x *ListMinecraftProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[81]
+func (x *UpdateMinecraftProjectFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5805,91 +7634,94 @@ func (x *ListMinecraftProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMinecraftProjectsRequest.ProtoReflect.Descriptor instead.
-func (*ListMinecraftProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use UpdateMinecraftProjectFileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMinecraftProjectFileRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *ListMinecraftProjectsRequest) GetGameServerId() string {
+func (x *UpdateMinecraftProjectFileRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *ListMinecraftProjectsRequest) GetQuery() string {
-	if x != nil && x.Query != nil {
-		return *x.Query
+func (x *UpdateMinecraftProjectFileRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *ListMinecraftProjectsRequest) GetGameVersions() []string {
+func (x *UpdateMinecraftProjectFileRequest) GetVersionId() string {
 	if x != nil {
-		return x.GameVersions
+		return x.VersionId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListMinecraftProjectsRequest) GetLoaders() []string {
+func (x *UpdateMinecraftProjectFileRequest) GetProjectType() MinecraftProjectType {
 	if x != nil {
-		return x.Loaders
+		return x.ProjectType
 	}
-	return nil
+	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
 }
 
-func (x *ListMinecraftProjectsRequest) GetCategories() []string {
+func (x *UpdateMinecraftProjectFileRequest) GetCurrentFilename() string {
 	if x != nil {
-		return x.Categories
+		return x.CurrentFilename
 	}
-	return nil
+	return ""
 }
 
-func (x *ListMinecraftProjectsRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *UpdateMinecraftProjectFileRequest) GetProjectTitle() string {
+	if x != nil && x.ProjectTitle != nil {
+		return *x.ProjectTitle
 	}
 	return ""
 }
 
-func (x *ListMinecraftProjectsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *UpdateMinecraftProjectFileRequest) GetProjectSlug() string {
+	if x != nil && x.ProjectSlug != nil {
+		return *x.ProjectSlug
 	}
-	return 0
+	return ""
 }
 
-func (x *ListMinecraftProjectsRequest) GetProjectType() MinecraftProjectType {
-	if x != nil {
-		return x.ProjectType
+func (x *UpdateMinecraftProjectFileRequest) GetProjectIconUrl() string {
+	if x != nil && x.ProjectIconUrl != nil {
+		return *x.ProjectIconUrl
 	}
-	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
+	return ""
 }
 
-type ListMinecraftProjectsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*MinecraftProject    `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	NextCursor    *string                `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateMinecraftProjectFileResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Filename         string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	InstalledPath    string                 `protobuf:"bytes,3,opt,name=installed_path,json=installedPath,proto3" json:"installed_path,omitempty"`
+	ReplacedFilename *string                `protobuf:"bytes,4,opt,name=replaced_filename,json=replacedFilename,proto3,oneof" json:"replaced_filename,omitempty"`
+	RestartRequired  bool                   `protobuf:"varint,5,opt,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	Message          *string                `protobuf:"bytes,6,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListMinecraftProjectsResponse) Reset() {
-	*x = ListMinecraftProjectsResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[82]
+func (x *UpdateMinecraftProjectFileResponse) Reset() {
+	*x = UpdateMinecraftProjectFileResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMinecraftProjectsResponse) String() string {
+func (x *UpdateMinecraftProjectFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMinecraftProjectsResponse) ProtoMessage() {}
+func (*UpdateMinecraftProjectFileResponse) ProtoMessage() {}
 
-func (x *ListMinecraftProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[82]
+func (x *UpdateMinecraftProjectFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5900,73 +7732,81 @@ func (x *ListMinecraftProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMinecraftProjectsResponse.ProtoReflect.Descriptor instead.
-func (*ListMinecraftProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use UpdateMinecraftProjectFileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMinecraftProjectFileResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *ListMinecraftProjectsResponse) GetProjects() []*MinecraftProject {
+func (x *UpdateMinecraftProjectFileResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Projects
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *ListMinecraftProjectsResponse) GetHasMore() bool {
+func (x *UpdateMinecraftProjectFileResponse) GetFilename() string {
 	if x != nil {
-		return x.HasMore
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UpdateMinecraftProjectFileResponse) GetInstalledPath() string {
+	if x != nil {
+		return x.InstalledPath
+	}
+	return ""
+}
+
+func (x *UpdateMinecraftProjectFileResponse) GetReplacedFilename() string {
+	if x != nil && x.ReplacedFilename != nil {
+		return *x.ReplacedFilename
+	}
+	return ""
+}
+
+func (x *UpdateMinecraftProjectFileResponse) GetRestartRequired() bool {
+	if x != nil {
+		return x.RestartRequired
 	}
 	return false
 }
 
-func (x *ListMinecraftProjectsResponse) GetNextCursor() string {
-	if x != nil && x.NextCursor != nil {
-		return *x.NextCursor
+func (x *UpdateMinecraftProjectFileResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
 	}
 	return ""
 }
 
-type InstalledMinecraftProjectFile struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Filename            string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	InstalledPath       string                 `protobuf:"bytes,3,opt,name=installed_path,json=installedPath,proto3" json:"installed_path,omitempty"`
-	ProjectType         MinecraftProjectType   `protobuf:"varint,4,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
-	SizeBytes           int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	ModifiedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=modified_at,json=modifiedAt,proto3,oneof" json:"modified_at,omitempty"`
-	Managed             bool                   `protobuf:"varint,7,opt,name=managed,proto3" json:"managed,omitempty"`
-	ProjectId           *string                `protobuf:"bytes,8,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
-	ProjectSlug         *string                `protobuf:"bytes,9,opt,name=project_slug,json=projectSlug,proto3,oneof" json:"project_slug,omitempty"`
-	Title               *string                `protobuf:"bytes,10,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	IconUrl             *string                `protobuf:"bytes,11,opt,name=icon_url,json=iconUrl,proto3,oneof" json:"icon_url,omitempty"`
-	VersionId           *string                `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3,oneof" json:"version_id,omitempty"`
-	VersionNumber       *string                `protobuf:"bytes,13,opt,name=version_number,json=versionNumber,proto3,oneof" json:"version_number,omitempty"`
-	GameVersions        []string               `protobuf:"bytes,14,rep,name=game_versions,json=gameVersions,proto3" json:"game_versions,omitempty"`
-	Loaders             []string               `protobuf:"bytes,15,rep,name=loaders,proto3" json:"loaders,omitempty"`
-	InstalledAt         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=installed_at,json=installedAt,proto3,oneof" json:"installed_at,omitempty"`
-	UpdateAvailable     bool                   `protobuf:"varint,17,opt,name=update_available,json=updateAvailable,proto3" json:"update_available,omitempty"`
-	LatestVersionId     *string                `protobuf:"bytes,18,opt,name=latest_version_id,json=latestVersionId,proto3,oneof" json:"latest_version_id,omitempty"`
-	LatestVersionNumber *string                `protobuf:"bytes,19,opt,name=latest_version_number,json=latestVersionNumber,proto3,oneof" json:"latest_version_number,omitempty"`
-	LatestGameVersions  []string               `protobuf:"bytes,20,rep,name=latest_game_versions,json=latestGameVersions,proto3" json:"latest_game_versions,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type GameServerMod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,2,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`            // "modrinth" or "curseforge"
+	ModId         string                 `protobuf:"bytes,4,opt,name=mod_id,json=modId,proto3" json:"mod_id,omitempty"` // Modrinth project ID or CurseForge mod ID
+	Version       string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`          // Modrinth version ID or CurseForge file ID
+	Filename      string                 `protobuf:"bytes,6,opt,name=filename,proto3" json:"filename,omitempty"`        // Jar file name in the mods directory
+	InstalledAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=installed_at,json=installedAt,proto3" json:"installed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstalledMinecraftProjectFile) Reset() {
-	*x = InstalledMinecraftProjectFile{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[83]
+func (x *GameServerMod) Reset() {
+	*x = GameServerMod{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstalledMinecraftProjectFile) String() string {
+func (x *GameServerMod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstalledMinecraftProjectFile) ProtoMessage() {}
+func (*GameServerMod) ProtoMessage() {}
 
-func (x *InstalledMinecraftProjectFile) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[83]
+func (x *GameServerMod) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5977,175 +7817,254 @@ func (x *InstalledMinecraftProjectFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstalledMinecraftProjectFile.ProtoReflect.Descriptor instead.
-func (*InstalledMinecraftProjectFile) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use GameServerMod.ProtoReflect.Descriptor instead.
+func (*GameServerMod) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *InstalledMinecraftProjectFile) GetId() string {
+func (x *GameServerMod) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetFilename() string {
+func (x *GameServerMod) GetGameServerId() string {
 	if x != nil {
-		return x.Filename
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetInstalledPath() string {
+func (x *GameServerMod) GetSource() string {
 	if x != nil {
-		return x.InstalledPath
+		return x.Source
 	}
 	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetProjectType() MinecraftProjectType {
+func (x *GameServerMod) GetModId() string {
 	if x != nil {
-		return x.ProjectType
+		return x.ModId
 	}
-	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetSizeBytes() int64 {
+func (x *GameServerMod) GetVersion() string {
 	if x != nil {
-		return x.SizeBytes
+		return x.Version
 	}
-	return 0
+	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetModifiedAt() *timestamppb.Timestamp {
+func (x *GameServerMod) GetFilename() string {
 	if x != nil {
-		return x.ModifiedAt
+		return x.Filename
 	}
-	return nil
+	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetManaged() bool {
+func (x *GameServerMod) GetInstalledAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Managed
+		return x.InstalledAt
 	}
-	return false
+	return nil
 }
 
-func (x *InstalledMinecraftProjectFile) GetProjectId() string {
-	if x != nil && x.ProjectId != nil {
-		return *x.ProjectId
-	}
-	return ""
+type InstallGameServerModRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	ModId         string                 `protobuf:"bytes,3,opt,name=mod_id,json=modId,proto3" json:"mod_id,omitempty"`
+	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstalledMinecraftProjectFile) GetProjectSlug() string {
-	if x != nil && x.ProjectSlug != nil {
-		return *x.ProjectSlug
+func (x *InstallGameServerModRequest) Reset() {
+	*x = InstallGameServerModRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallGameServerModRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallGameServerModRequest) ProtoMessage() {}
+
+func (x *InstallGameServerModRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallGameServerModRequest.ProtoReflect.Descriptor instead.
+func (*InstallGameServerModRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *InstalledMinecraftProjectFile) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *InstallGameServerModRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetIconUrl() string {
-	if x != nil && x.IconUrl != nil {
-		return *x.IconUrl
+func (x *InstallGameServerModRequest) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetVersionId() string {
-	if x != nil && x.VersionId != nil {
-		return *x.VersionId
+func (x *InstallGameServerModRequest) GetModId() string {
+	if x != nil {
+		return x.ModId
 	}
 	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetVersionNumber() string {
-	if x != nil && x.VersionNumber != nil {
-		return *x.VersionNumber
+func (x *InstallGameServerModRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
 	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetGameVersions() []string {
-	if x != nil {
-		return x.GameVersions
-	}
-	return nil
+type InstallGameServerModResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mod           *GameServerMod         `protobuf:"bytes,1,opt,name=mod,proto3" json:"mod,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstalledMinecraftProjectFile) GetLoaders() []string {
+func (x *InstallGameServerModResponse) Reset() {
+	*x = InstallGameServerModResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallGameServerModResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallGameServerModResponse) ProtoMessage() {}
+
+func (x *InstallGameServerModResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[110]
 	if x != nil {
-		return x.Loaders
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *InstalledMinecraftProjectFile) GetInstalledAt() *timestamppb.Timestamp {
+// Deprecated: Use InstallGameServerModResponse.ProtoReflect.Descriptor instead.
+func (*InstallGameServerModResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *InstallGameServerModResponse) GetMod() *GameServerMod {
 	if x != nil {
-		return x.InstalledAt
+		return x.Mod
 	}
 	return nil
 }
 
-func (x *InstalledMinecraftProjectFile) GetUpdateAvailable() bool {
+type UninstallGameServerModRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	ModId         string                 `protobuf:"bytes,3,opt,name=mod_id,json=modId,proto3" json:"mod_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UninstallGameServerModRequest) Reset() {
+	*x = UninstallGameServerModRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UninstallGameServerModRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninstallGameServerModRequest) ProtoMessage() {}
+
+func (x *UninstallGameServerModRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[111]
 	if x != nil {
-		return x.UpdateAvailable
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *InstalledMinecraftProjectFile) GetLatestVersionId() string {
-	if x != nil && x.LatestVersionId != nil {
-		return *x.LatestVersionId
+// Deprecated: Use UninstallGameServerModRequest.ProtoReflect.Descriptor instead.
+func (*UninstallGameServerModRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UninstallGameServerModRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetLatestVersionNumber() string {
-	if x != nil && x.LatestVersionNumber != nil {
-		return *x.LatestVersionNumber
+func (x *UninstallGameServerModRequest) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-func (x *InstalledMinecraftProjectFile) GetLatestGameVersions() []string {
+func (x *UninstallGameServerModRequest) GetModId() string {
 	if x != nil {
-		return x.LatestGameVersions
+		return x.ModId
 	}
-	return nil
+	return ""
 }
 
-type ListInstalledMinecraftProjectsRequest struct {
+type UninstallGameServerModResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	ProjectType   MinecraftProjectType   `protobuf:"varint,2,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
-	CheckUpdates  *bool                  `protobuf:"varint,3,opt,name=check_updates,json=checkUpdates,proto3,oneof" json:"check_updates,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInstalledMinecraftProjectsRequest) Reset() {
-	*x = ListInstalledMinecraftProjectsRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[84]
+func (x *UninstallGameServerModResponse) Reset() {
+	*x = UninstallGameServerModResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInstalledMinecraftProjectsRequest) String() string {
+func (x *UninstallGameServerModResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInstalledMinecraftProjectsRequest) ProtoMessage() {}
+func (*UninstallGameServerModResponse) ProtoMessage() {}
 
-func (x *ListInstalledMinecraftProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[84]
+func (x *UninstallGameServerModResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6156,54 +8075,84 @@ func (x *ListInstalledMinecraftProjectsRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInstalledMinecraftProjectsRequest.ProtoReflect.Descriptor instead.
-func (*ListInstalledMinecraftProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use UninstallGameServerModResponse.ProtoReflect.Descriptor instead.
+func (*UninstallGameServerModResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *ListInstalledMinecraftProjectsRequest) GetGameServerId() string {
+func (x *UninstallGameServerModResponse) GetSuccess() bool {
 	if x != nil {
-		return x.GameServerId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ListInstalledMinecraftProjectsRequest) GetProjectType() MinecraftProjectType {
+type ListGameServerModsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGameServerModsRequest) Reset() {
+	*x = ListGameServerModsRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGameServerModsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameServerModsRequest) ProtoMessage() {}
+
+func (x *ListGameServerModsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[113]
 	if x != nil {
-		return x.ProjectType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *ListInstalledMinecraftProjectsRequest) GetCheckUpdates() bool {
-	if x != nil && x.CheckUpdates != nil {
-		return *x.CheckUpdates
+// Deprecated: Use ListGameServerModsRequest.ProtoReflect.Descriptor instead.
+func (*ListGameServerModsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ListGameServerModsRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
 	}
-	return false
+	return ""
 }
 
-type ListInstalledMinecraftProjectsResponse struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	Files         []*InstalledMinecraftProjectFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+type ListGameServerModsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mods          []*GameServerMod       `protobuf:"bytes,1,rep,name=mods,proto3" json:"mods,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInstalledMinecraftProjectsResponse) Reset() {
-	*x = ListInstalledMinecraftProjectsResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[85]
+func (x *ListGameServerModsResponse) Reset() {
+	*x = ListGameServerModsResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInstalledMinecraftProjectsResponse) String() string {
+func (x *ListGameServerModsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInstalledMinecraftProjectsResponse) ProtoMessage() {}
+func (*ListGameServerModsResponse) ProtoMessage() {}
 
-func (x *ListInstalledMinecraftProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[85]
+func (x *ListGameServerModsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6214,44 +8163,46 @@ func (x *ListInstalledMinecraftProjectsResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInstalledMinecraftProjectsResponse.ProtoReflect.Descriptor instead.
-func (*ListInstalledMinecraftProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use ListGameServerModsResponse.ProtoReflect.Descriptor instead.
+func (*ListGameServerModsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *ListInstalledMinecraftProjectsResponse) GetFiles() []*InstalledMinecraftProjectFile {
+func (x *ListGameServerModsResponse) GetMods() []*GameServerMod {
 	if x != nil {
-		return x.Files
+		return x.Mods
 	}
 	return nil
 }
 
-type MinecraftProjectFile struct {
+type GameServerBackup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,2,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
 	SizeBytes     int64                  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	Hashes        map[string]string      `protobuf:"bytes,4,rep,name=hashes,proto3" json:"hashes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Primary       bool                   `protobuf:"varint,5,opt,name=primary,proto3" json:"primary,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "in_progress", "completed" or "failed"
+	ErrorMessage  *string                `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MinecraftProjectFile) Reset() {
-	*x = MinecraftProjectFile{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[86]
+func (x *GameServerBackup) Reset() {
+	*x = GameServerBackup{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MinecraftProjectFile) String() string {
+func (x *GameServerBackup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MinecraftProjectFile) ProtoMessage() {}
+func (*GameServerBackup) ProtoMessage() {}
 
-func (x *MinecraftProjectFile) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[86]
+func (x *GameServerBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6262,78 +8213,84 @@ func (x *MinecraftProjectFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MinecraftProjectFile.ProtoReflect.Descriptor instead.
-func (*MinecraftProjectFile) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use GameServerBackup.ProtoReflect.Descriptor instead.
+func (*GameServerBackup) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *MinecraftProjectFile) GetFilename() string {
+func (x *GameServerBackup) GetId() string {
 	if x != nil {
-		return x.Filename
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MinecraftProjectFile) GetUrl() string {
+func (x *GameServerBackup) GetGameServerId() string {
 	if x != nil {
-		return x.Url
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *MinecraftProjectFile) GetSizeBytes() int64 {
+func (x *GameServerBackup) GetSizeBytes() int64 {
 	if x != nil {
 		return x.SizeBytes
 	}
 	return 0
 }
 
-func (x *MinecraftProjectFile) GetHashes() map[string]string {
+func (x *GameServerBackup) GetStatus() string {
 	if x != nil {
-		return x.Hashes
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GameServerBackup) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *GameServerBackup) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MinecraftProjectFile) GetPrimary() bool {
+func (x *GameServerBackup) GetCompletedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Primary
+		return x.CompletedAt
 	}
-	return false
+	return nil
 }
 
-type MinecraftProjectVersion struct {
-	state               protoimpl.MessageState  `protogen:"open.v1"`
-	Id                  string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	VersionNumber       string                  `protobuf:"bytes,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
-	GameVersions        []string                `protobuf:"bytes,4,rep,name=game_versions,json=gameVersions,proto3" json:"game_versions,omitempty"`
-	Loaders             []string                `protobuf:"bytes,5,rep,name=loaders,proto3" json:"loaders,omitempty"`
-	ServerSideSupported bool                    `protobuf:"varint,6,opt,name=server_side_supported,json=serverSideSupported,proto3" json:"server_side_supported,omitempty"`
-	ClientSideSupported bool                    `protobuf:"varint,7,opt,name=client_side_supported,json=clientSideSupported,proto3" json:"client_side_supported,omitempty"`
-	PublishedAt         *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3,oneof" json:"published_at,omitempty"`
-	Changelog           *string                 `protobuf:"bytes,9,opt,name=changelog,proto3,oneof" json:"changelog,omitempty"`
-	Files               []*MinecraftProjectFile `protobuf:"bytes,10,rep,name=files,proto3" json:"files,omitempty"`
-	VersionType         *string                 `protobuf:"bytes,11,opt,name=version_type,json=versionType,proto3,oneof" json:"version_type,omitempty"` // "release", "beta", "alpha"
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type ScheduleGameServerBackupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Schedule      string                 `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`          // Interval between backups (e.g. "6h", at least 1h); empty disables automatic backups
+	Retention     *int32                 `protobuf:"varint,3,opt,name=retention,proto3,oneof" json:"retention,omitempty"` // Number of backups to keep (default 7)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MinecraftProjectVersion) Reset() {
-	*x = MinecraftProjectVersion{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[87]
+func (x *ScheduleGameServerBackupRequest) Reset() {
+	*x = ScheduleGameServerBackupRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MinecraftProjectVersion) String() string {
+func (x *ScheduleGameServerBackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MinecraftProjectVersion) ProtoMessage() {}
+func (*ScheduleGameServerBackupRequest) ProtoMessage() {}
 
-func (x *MinecraftProjectVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[87]
+func (x *ScheduleGameServerBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6344,116 +8301,150 @@ func (x *MinecraftProjectVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MinecraftProjectVersion.ProtoReflect.Descriptor instead.
-func (*MinecraftProjectVersion) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use ScheduleGameServerBackupRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleGameServerBackupRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *MinecraftProjectVersion) GetId() string {
+func (x *ScheduleGameServerBackupRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Id
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *MinecraftProjectVersion) GetName() string {
+func (x *ScheduleGameServerBackupRequest) GetSchedule() string {
 	if x != nil {
-		return x.Name
+		return x.Schedule
 	}
 	return ""
 }
 
-func (x *MinecraftProjectVersion) GetVersionNumber() string {
-	if x != nil {
-		return x.VersionNumber
+func (x *ScheduleGameServerBackupRequest) GetRetention() int32 {
+	if x != nil && x.Retention != nil {
+		return *x.Retention
 	}
-	return ""
+	return 0
 }
 
-func (x *MinecraftProjectVersion) GetGameVersions() []string {
+type ScheduleGameServerBackupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      string                 `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Retention     int32                  `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleGameServerBackupResponse) Reset() {
+	*x = ScheduleGameServerBackupResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleGameServerBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleGameServerBackupResponse) ProtoMessage() {}
+
+func (x *ScheduleGameServerBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[117]
 	if x != nil {
-		return x.GameVersions
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleGameServerBackupResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleGameServerBackupResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *MinecraftProjectVersion) GetLoaders() []string {
+func (x *ScheduleGameServerBackupResponse) GetSchedule() string {
 	if x != nil {
-		return x.Loaders
+		return x.Schedule
 	}
-	return nil
+	return ""
 }
 
-func (x *MinecraftProjectVersion) GetServerSideSupported() bool {
+func (x *ScheduleGameServerBackupResponse) GetRetention() int32 {
 	if x != nil {
-		return x.ServerSideSupported
+		return x.Retention
 	}
-	return false
+	return 0
 }
 
-func (x *MinecraftProjectVersion) GetClientSideSupported() bool {
-	if x != nil {
-		return x.ClientSideSupported
-	}
-	return false
+type ListGameServerBackupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MinecraftProjectVersion) GetPublishedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.PublishedAt
-	}
-	return nil
+func (x *ListGameServerBackupsRequest) Reset() {
+	*x = ListGameServerBackupsRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MinecraftProjectVersion) GetChangelog() string {
-	if x != nil && x.Changelog != nil {
-		return *x.Changelog
-	}
-	return ""
+func (x *ListGameServerBackupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MinecraftProjectVersion) GetFiles() []*MinecraftProjectFile {
+func (*ListGameServerBackupsRequest) ProtoMessage() {}
+
+func (x *ListGameServerBackupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[118]
 	if x != nil {
-		return x.Files
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MinecraftProjectVersion) GetVersionType() string {
-	if x != nil && x.VersionType != nil {
-		return *x.VersionType
+// Deprecated: Use ListGameServerBackupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGameServerBackupsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListGameServerBackupsRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
 	}
 	return ""
 }
 
-type GetMinecraftProjectVersionsRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId       string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	ProjectId          string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	GameVersions       []string               `protobuf:"bytes,3,rep,name=game_versions,json=gameVersions,proto3" json:"game_versions,omitempty"`
-	Loaders            []string               `protobuf:"bytes,4,rep,name=loaders,proto3" json:"loaders,omitempty"`
-	ProjectType        MinecraftProjectType   `protobuf:"varint,5,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
-	Limit              *int32                 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	IncludePrereleases *bool                  `protobuf:"varint,7,opt,name=include_prereleases,json=includePrereleases,proto3,oneof" json:"include_prereleases,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ListGameServerBackupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Backups       []*GameServerBackup    `protobuf:"bytes,1,rep,name=backups,proto3" json:"backups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMinecraftProjectVersionsRequest) Reset() {
-	*x = GetMinecraftProjectVersionsRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[88]
+func (x *ListGameServerBackupsResponse) Reset() {
+	*x = ListGameServerBackupsResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMinecraftProjectVersionsRequest) String() string {
+func (x *ListGameServerBackupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinecraftProjectVersionsRequest) ProtoMessage() {}
+func (*ListGameServerBackupsResponse) ProtoMessage() {}
 
-func (x *GetMinecraftProjectVersionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[88]
+func (x *ListGameServerBackupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6464,82 +8455,92 @@ func (x *GetMinecraftProjectVersionsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinecraftProjectVersionsRequest.ProtoReflect.Descriptor instead.
-func (*GetMinecraftProjectVersionsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use ListGameServerBackupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGameServerBackupsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetMinecraftProjectVersionsRequest) GetGameServerId() string {
+func (x *ListGameServerBackupsResponse) GetBackups() []*GameServerBackup {
 	if x != nil {
-		return x.GameServerId
+		return x.Backups
 	}
-	return ""
+	return nil
 }
 
-func (x *GetMinecraftProjectVersionsRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+type RestoreGameServerBackupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	BackupId      string                 `protobuf:"bytes,2,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMinecraftProjectVersionsRequest) GetGameVersions() []string {
-	if x != nil {
-		return x.GameVersions
-	}
-	return nil
+func (x *RestoreGameServerBackupRequest) Reset() {
+	*x = RestoreGameServerBackupRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMinecraftProjectVersionsRequest) GetLoaders() []string {
-	if x != nil {
-		return x.Loaders
-	}
-	return nil
+func (x *RestoreGameServerBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetMinecraftProjectVersionsRequest) GetProjectType() MinecraftProjectType {
+func (*RestoreGameServerBackupRequest) ProtoMessage() {}
+
+func (x *RestoreGameServerBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[120]
 	if x != nil {
-		return x.ProjectType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *GetMinecraftProjectVersionsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+// Deprecated: Use RestoreGameServerBackupRequest.ProtoReflect.Descriptor instead.
+func (*RestoreGameServerBackupRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *RestoreGameServerBackupRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetMinecraftProjectVersionsRequest) GetIncludePrereleases() bool {
-	if x != nil && x.IncludePrereleases != nil {
-		return *x.IncludePrereleases
+func (x *RestoreGameServerBackupRequest) GetBackupId() string {
+	if x != nil {
+		return x.BackupId
 	}
-	return false
+	return ""
 }
 
-type GetMinecraftProjectVersionsResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Versions      []*MinecraftProjectVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+type RestoreGameServerBackupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMinecraftProjectVersionsResponse) Reset() {
-	*x = GetMinecraftProjectVersionsResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[89]
+func (x *RestoreGameServerBackupResponse) Reset() {
+	*x = RestoreGameServerBackupResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMinecraftProjectVersionsResponse) String() string {
+func (x *RestoreGameServerBackupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinecraftProjectVersionsResponse) ProtoMessage() {}
+func (*RestoreGameServerBackupResponse) ProtoMessage() {}
 
-func (x *GetMinecraftProjectVersionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[89]
+func (x *RestoreGameServerBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6550,41 +8551,45 @@ func (x *GetMinecraftProjectVersionsResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinecraftProjectVersionsResponse.ProtoReflect.Descriptor instead.
-func (*GetMinecraftProjectVersionsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use RestoreGameServerBackupResponse.ProtoReflect.Descriptor instead.
+func (*RestoreGameServerBackupResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GetMinecraftProjectVersionsResponse) GetVersions() []*MinecraftProjectVersion {
+func (x *RestoreGameServerBackupResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Versions
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type GetMinecraftProjectRequest struct {
+type DiscordIntegration struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,2,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Events        []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"` // "start", "stop", "crash", "player_join" and/or "player_leave"
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMinecraftProjectRequest) Reset() {
-	*x = GetMinecraftProjectRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[90]
+func (x *DiscordIntegration) Reset() {
+	*x = DiscordIntegration{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMinecraftProjectRequest) String() string {
+func (x *DiscordIntegration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinecraftProjectRequest) ProtoMessage() {}
+func (*DiscordIntegration) ProtoMessage() {}
 
-func (x *GetMinecraftProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[90]
+func (x *DiscordIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6595,47 +8600,78 @@ func (x *GetMinecraftProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinecraftProjectRequest.ProtoReflect.Descriptor instead.
-func (*GetMinecraftProjectRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use DiscordIntegration.ProtoReflect.Descriptor instead.
+func (*DiscordIntegration) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *GetMinecraftProjectRequest) GetGameServerId() string {
+func (x *DiscordIntegration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiscordIntegration) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *GetMinecraftProjectRequest) GetProjectId() string {
+func (x *DiscordIntegration) GetChannelId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.ChannelId
 	}
 	return ""
 }
 
-type GetMinecraftProjectResponse struct {
+func (x *DiscordIntegration) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *DiscordIntegration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DiscordIntegration) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetDiscordIntegrationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *MinecraftProject      `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	BotToken      *string                `protobuf:"bytes,2,opt,name=bot_token,json=botToken,proto3,oneof" json:"bot_token,omitempty"` // Required when creating the integration; kept when omitted on update
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Events        []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"` // Events to post; empty subscribes to all of them
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMinecraftProjectResponse) Reset() {
-	*x = GetMinecraftProjectResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[91]
+func (x *SetDiscordIntegrationRequest) Reset() {
+	*x = SetDiscordIntegrationRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMinecraftProjectResponse) String() string {
+func (x *SetDiscordIntegrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinecraftProjectResponse) ProtoMessage() {}
+func (*SetDiscordIntegrationRequest) ProtoMessage() {}
 
-func (x *GetMinecraftProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[91]
+func (x *SetDiscordIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6646,46 +8682,61 @@ func (x *GetMinecraftProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinecraftProjectResponse.ProtoReflect.Descriptor instead.
-func (*GetMinecraftProjectResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use SetDiscordIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*SetDiscordIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *GetMinecraftProjectResponse) GetProject() *MinecraftProject {
+func (x *SetDiscordIntegrationRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Project
+		return x.GameServerId
+	}
+	return ""
+}
+
+func (x *SetDiscordIntegrationRequest) GetBotToken() string {
+	if x != nil && x.BotToken != nil {
+		return *x.BotToken
+	}
+	return ""
+}
+
+func (x *SetDiscordIntegrationRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SetDiscordIntegrationRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
 	}
 	return nil
 }
 
-type InstallMinecraftProjectFileRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId   string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	VersionId      string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	ProjectType    MinecraftProjectType   `protobuf:"varint,4,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
-	ProjectTitle   *string                `protobuf:"bytes,5,opt,name=project_title,json=projectTitle,proto3,oneof" json:"project_title,omitempty"`
-	ProjectSlug    *string                `protobuf:"bytes,6,opt,name=project_slug,json=projectSlug,proto3,oneof" json:"project_slug,omitempty"`
-	ProjectIconUrl *string                `protobuf:"bytes,7,opt,name=project_icon_url,json=projectIconUrl,proto3,oneof" json:"project_icon_url,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type SetDiscordIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Integration   *DiscordIntegration    `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstallMinecraftProjectFileRequest) Reset() {
-	*x = InstallMinecraftProjectFileRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[92]
+func (x *SetDiscordIntegrationResponse) Reset() {
+	*x = SetDiscordIntegrationResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstallMinecraftProjectFileRequest) String() string {
+func (x *SetDiscordIntegrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallMinecraftProjectFileRequest) ProtoMessage() {}
+func (*SetDiscordIntegrationResponse) ProtoMessage() {}
 
-func (x *InstallMinecraftProjectFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[92]
+func (x *SetDiscordIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6696,86 +8747,84 @@ func (x *InstallMinecraftProjectFileRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallMinecraftProjectFileRequest.ProtoReflect.Descriptor instead.
-func (*InstallMinecraftProjectFileRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use SetDiscordIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*SetDiscordIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *InstallMinecraftProjectFileRequest) GetGameServerId() string {
+func (x *SetDiscordIntegrationResponse) GetIntegration() *DiscordIntegration {
 	if x != nil {
-		return x.GameServerId
+		return x.Integration
 	}
-	return ""
+	return nil
 }
 
-func (x *InstallMinecraftProjectFileRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+type DeleteDiscordIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstallMinecraftProjectFileRequest) GetVersionId() string {
-	if x != nil {
-		return x.VersionId
-	}
-	return ""
+func (x *DeleteDiscordIntegrationRequest) Reset() {
+	*x = DeleteDiscordIntegrationRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstallMinecraftProjectFileRequest) GetProjectType() MinecraftProjectType {
-	if x != nil {
-		return x.ProjectType
-	}
-	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
+func (x *DeleteDiscordIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InstallMinecraftProjectFileRequest) GetProjectTitle() string {
-	if x != nil && x.ProjectTitle != nil {
-		return *x.ProjectTitle
+func (*DeleteDiscordIntegrationRequest) ProtoMessage() {}
+
+func (x *DeleteDiscordIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InstallMinecraftProjectFileRequest) GetProjectSlug() string {
-	if x != nil && x.ProjectSlug != nil {
-		return *x.ProjectSlug
-	}
-	return ""
+// Deprecated: Use DeleteDiscordIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDiscordIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *InstallMinecraftProjectFileRequest) GetProjectIconUrl() string {
-	if x != nil && x.ProjectIconUrl != nil {
-		return *x.ProjectIconUrl
+func (x *DeleteDiscordIntegrationRequest) GetGameServerId() string {
+	if x != nil {
+		return x.GameServerId
 	}
 	return ""
 }
 
-type InstallMinecraftProjectFileResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Filename        string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	InstalledPath   string                 `protobuf:"bytes,3,opt,name=installed_path,json=installedPath,proto3" json:"installed_path,omitempty"`
-	RestartRequired bool                   `protobuf:"varint,4,opt,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
-	Message         *string                `protobuf:"bytes,5,opt,name=message,proto3,oneof" json:"message,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type DeleteDiscordIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstallMinecraftProjectFileResponse) Reset() {
-	*x = InstallMinecraftProjectFileResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[93]
+func (x *DeleteDiscordIntegrationResponse) Reset() {
+	*x = DeleteDiscordIntegrationResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstallMinecraftProjectFileResponse) String() string {
+func (x *DeleteDiscordIntegrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallMinecraftProjectFileResponse) ProtoMessage() {}
+func (*DeleteDiscordIntegrationResponse) ProtoMessage() {}
 
-func (x *InstallMinecraftProjectFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[93]
+func (x *DeleteDiscordIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6786,75 +8835,102 @@ func (x *InstallMinecraftProjectFileResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallMinecraftProjectFileResponse.ProtoReflect.Descriptor instead.
-func (*InstallMinecraftProjectFileResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use DeleteDiscordIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDiscordIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *InstallMinecraftProjectFileResponse) GetSuccess() bool {
+func (x *DeleteDiscordIntegrationResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *InstallMinecraftProjectFileResponse) GetFilename() string {
+type IdleShutdownPolicy struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	IdleTimeoutMinutes    int32                  `protobuf:"varint,1,opt,name=idle_timeout_minutes,json=idleTimeoutMinutes,proto3" json:"idle_timeout_minutes,omitempty"`              // 0 disables idle shutdown
+	MinPlayersToStayAlive int32                  `protobuf:"varint,2,opt,name=min_players_to_stay_alive,json=minPlayersToStayAlive,proto3" json:"min_players_to_stay_alive,omitempty"` // The server counts as idle while fewer players are online
+	LastPlayerSeenAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_player_seen_at,json=lastPlayerSeenAt,proto3,oneof" json:"last_player_seen_at,omitempty"`             // Last time enough players were online since the server started
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *IdleShutdownPolicy) Reset() {
+	*x = IdleShutdownPolicy{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdleShutdownPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdleShutdownPolicy) ProtoMessage() {}
+
+func (x *IdleShutdownPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[127]
 	if x != nil {
-		return x.Filename
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InstallMinecraftProjectFileResponse) GetInstalledPath() string {
+// Deprecated: Use IdleShutdownPolicy.ProtoReflect.Descriptor instead.
+func (*IdleShutdownPolicy) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *IdleShutdownPolicy) GetIdleTimeoutMinutes() int32 {
 	if x != nil {
-		return x.InstalledPath
+		return x.IdleTimeoutMinutes
 	}
-	return ""
+	return 0
 }
 
-func (x *InstallMinecraftProjectFileResponse) GetRestartRequired() bool {
+func (x *IdleShutdownPolicy) GetMinPlayersToStayAlive() int32 {
 	if x != nil {
-		return x.RestartRequired
+		return x.MinPlayersToStayAlive
 	}
-	return false
+	return 0
 }
 
-func (x *InstallMinecraftProjectFileResponse) GetMessage() string {
-	if x != nil && x.Message != nil {
-		return *x.Message
+func (x *IdleShutdownPolicy) GetLastPlayerSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPlayerSeenAt
 	}
-	return ""
+	return nil
 }
 
-type UpdateMinecraftProjectFileRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId    string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	ProjectId       string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	VersionId       string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	ProjectType     MinecraftProjectType   `protobuf:"varint,4,opt,name=project_type,json=projectType,proto3,enum=obiente.cloud.gameservers.v1.MinecraftProjectType" json:"project_type,omitempty"`
-	CurrentFilename string                 `protobuf:"bytes,5,opt,name=current_filename,json=currentFilename,proto3" json:"current_filename,omitempty"`
-	ProjectTitle    *string                `protobuf:"bytes,6,opt,name=project_title,json=projectTitle,proto3,oneof" json:"project_title,omitempty"`
-	ProjectSlug     *string                `protobuf:"bytes,7,opt,name=project_slug,json=projectSlug,proto3,oneof" json:"project_slug,omitempty"`
-	ProjectIconUrl  *string                `protobuf:"bytes,8,opt,name=project_icon_url,json=projectIconUrl,proto3,oneof" json:"project_icon_url,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SetIdleShutdownPolicyRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId          string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	IdleTimeoutMinutes    int32                  `protobuf:"varint,2,opt,name=idle_timeout_minutes,json=idleTimeoutMinutes,proto3" json:"idle_timeout_minutes,omitempty"`              // 0 disables idle shutdown, otherwise between 5 and 10080 (7 days)
+	MinPlayersToStayAlive int32                  `protobuf:"varint,3,opt,name=min_players_to_stay_alive,json=minPlayersToStayAlive,proto3" json:"min_players_to_stay_alive,omitempty"` // Defaults to 1
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *UpdateMinecraftProjectFileRequest) Reset() {
-	*x = UpdateMinecraftProjectFileRequest{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[94]
+func (x *SetIdleShutdownPolicyRequest) Reset() {
+	*x = SetIdleShutdownPolicyRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMinecraftProjectFileRequest) String() string {
+func (x *SetIdleShutdownPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMinecraftProjectFileRequest) ProtoMessage() {}
+func (*SetIdleShutdownPolicyRequest) ProtoMessage() {}
 
-func (x *UpdateMinecraftProjectFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[94]
+func (x *SetIdleShutdownPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6865,94 +8941,98 @@ func (x *UpdateMinecraftProjectFileRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMinecraftProjectFileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMinecraftProjectFileRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use SetIdleShutdownPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetIdleShutdownPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *UpdateMinecraftProjectFileRequest) GetGameServerId() string {
+func (x *SetIdleShutdownPolicyRequest) GetGameServerId() string {
 	if x != nil {
 		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *UpdateMinecraftProjectFileRequest) GetProjectId() string {
+func (x *SetIdleShutdownPolicyRequest) GetIdleTimeoutMinutes() int32 {
 	if x != nil {
-		return x.ProjectId
+		return x.IdleTimeoutMinutes
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateMinecraftProjectFileRequest) GetVersionId() string {
+func (x *SetIdleShutdownPolicyRequest) GetMinPlayersToStayAlive() int32 {
 	if x != nil {
-		return x.VersionId
+		return x.MinPlayersToStayAlive
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateMinecraftProjectFileRequest) GetProjectType() MinecraftProjectType {
-	if x != nil {
-		return x.ProjectType
-	}
-	return MinecraftProjectType_MINECRAFT_PROJECT_TYPE_UNSPECIFIED
+type SetIdleShutdownPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *IdleShutdownPolicy    `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMinecraftProjectFileRequest) GetCurrentFilename() string {
-	if x != nil {
-		return x.CurrentFilename
-	}
-	return ""
+func (x *SetIdleShutdownPolicyResponse) Reset() {
+	*x = SetIdleShutdownPolicyResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMinecraftProjectFileRequest) GetProjectTitle() string {
-	if x != nil && x.ProjectTitle != nil {
-		return *x.ProjectTitle
-	}
-	return ""
+func (x *SetIdleShutdownPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateMinecraftProjectFileRequest) GetProjectSlug() string {
-	if x != nil && x.ProjectSlug != nil {
-		return *x.ProjectSlug
+func (*SetIdleShutdownPolicyResponse) ProtoMessage() {}
+
+func (x *SetIdleShutdownPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateMinecraftProjectFileRequest) GetProjectIconUrl() string {
-	if x != nil && x.ProjectIconUrl != nil {
-		return *x.ProjectIconUrl
+// Deprecated: Use SetIdleShutdownPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetIdleShutdownPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *SetIdleShutdownPolicyResponse) GetPolicy() *IdleShutdownPolicy {
+	if x != nil {
+		return x.Policy
 	}
-	return ""
+	return nil
 }
 
-type UpdateMinecraftProjectFileResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Filename         string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	InstalledPath    string                 `protobuf:"bytes,3,opt,name=installed_path,json=installedPath,proto3" json:"installed_path,omitempty"`
-	ReplacedFilename *string                `protobuf:"bytes,4,opt,name=replaced_filename,json=replacedFilename,proto3,oneof" json:"replaced_filename,omitempty"`
-	RestartRequired  bool                   `protobuf:"varint,5,opt,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
-	Message          *string                `protobuf:"bytes,6,opt,name=message,proto3,oneof" json:"message,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GetIdleShutdownPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMinecraftProjectFileResponse) Reset() {
-	*x = UpdateMinecraftProjectFileResponse{}
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[95]
+func (x *GetIdleShutdownPolicyRequest) Reset() {
+	*x = GetIdleShutdownPolicyRequest{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMinecraftProjectFileResponse) String() string {
+func (x *GetIdleShutdownPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMinecraftProjectFileResponse) ProtoMessage() {}
+func (*GetIdleShutdownPolicyRequest) ProtoMessage() {}
 
-func (x *UpdateMinecraftProjectFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[95]
+func (x *GetIdleShutdownPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6963,67 +9043,80 @@ func (x *UpdateMinecraftProjectFileResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMinecraftProjectFileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateMinecraftProjectFileResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use GetIdleShutdownPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetIdleShutdownPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *UpdateMinecraftProjectFileResponse) GetSuccess() bool {
+func (x *GetIdleShutdownPolicyRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Success
+		return x.GameServerId
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateMinecraftProjectFileResponse) GetFilename() string {
-	if x != nil {
-		return x.Filename
-	}
-	return ""
+type GetIdleShutdownPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *IdleShutdownPolicy    `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // Disabled when the game server has no policy
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMinecraftProjectFileResponse) GetInstalledPath() string {
-	if x != nil {
-		return x.InstalledPath
-	}
-	return ""
+func (x *GetIdleShutdownPolicyResponse) Reset() {
+	*x = GetIdleShutdownPolicyResponse{}
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMinecraftProjectFileResponse) GetReplacedFilename() string {
-	if x != nil && x.ReplacedFilename != nil {
-		return *x.ReplacedFilename
-	}
-	return ""
+func (x *GetIdleShutdownPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateMinecraftProjectFileResponse) GetRestartRequired() bool {
+func (*GetIdleShutdownPolicyResponse) ProtoMessage() {}
+
+func (x *GetIdleShutdownPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[131]
 	if x != nil {
-		return x.RestartRequired
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateMinecraftProjectFileResponse) GetMessage() string {
-	if x != nil && x.Message != nil {
-		return *x.Message
+// Deprecated: Use GetIdleShutdownPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetIdleShutdownPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetIdleShutdownPolicyResponse) GetPolicy() *IdleShutdownPolicy {
+	if x != nil {
+		return x.Policy
 	}
-	return ""
+	return nil
 }
 
 var File_obiente_cloud_gameservers_v1_game_server_service_proto protoreflect.FileDescriptor
 
 const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\n" +
-	"6obiente/cloud/gameservers/v1/game_server_service.proto\x12\x1cobiente.cloud.gameservers.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a9obiente/cloud/organizations/v1/organization_service.proto\x1a$obiente/cloud/common/v1/common.proto\"\xc9\x01\n" +
+	"6obiente/cloud/gameservers/v1/game_server_service.proto\x12\x1cobiente.cloud.gameservers.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a9obiente/cloud/organizations/v1/organization_service.proto\x1a$obiente/cloud/common/v1/common.proto\"\xd6\x02\n" +
 	"\x16ListGameServersRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12 \n" +
 	"\tgame_type\x18\x02 \x01(\tH\x00R\bgameType\x88\x01\x01\x12K\n" +
-	"\x06status\x18\x03 \x01(\x0e2..obiente.cloud.gameservers.v1.GameServerStatusH\x01R\x06status\x88\x01\x01B\f\n" +
+	"\x06status\x18\x03 \x01(\x0e2..obiente.cloud.gameservers.v1.GameServerStatusH\x01R\x06status\x88\x01\x01\x12R\n" +
+	"\x04tags\x18\x04 \x03(\v2>.obiente.cloud.gameservers.v1.ListGameServersRequest.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
 	"\n" +
 	"_game_typeB\t\n" +
 	"\a_status\"f\n" +
 	"\x17ListGameServersResponse\x12K\n" +
-	"\fgame_servers\x18\x01 \x03(\v2(.obiente.cloud.gameservers.v1.GameServerR\vgameServers\"\xf3\x05\n" +
+	"\fgame_servers\x18\x01 \x03(\v2(.obiente.cloud.gameservers.v1.GameServerR\vgameServers\"\x9d\x06\n" +
 	"\x17CreateGameServerRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12C\n" +
@@ -7037,7 +9130,8 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\x0eserver_version\x18\n" +
 	" \x01(\tH\x05R\rserverVersion\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\v \x01(\tH\x06R\vdescription\x88\x01\x01\x12/\n" +
-	"\x11extra_ports_count\x18\f \x01(\x05H\aR\x0fextraPortsCount\x88\x01\x01\x1a:\n" +
+	"\x11extra_ports_count\x18\f \x01(\x05H\aR\x0fextraPortsCount\x88\x01\x01\x12\x1c\n" +
+	"\ateam_id\x18\r \x01(\tH\bR\x06teamId\x88\x01\x01\x1a:\n" +
 	"\fEnvVarsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
@@ -7049,7 +9143,9 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\x0e_start_commandB\x11\n" +
 	"\x0f_server_versionB\x0e\n" +
 	"\f_descriptionB\x14\n" +
-	"\x12_extra_ports_count\"e\n" +
+	"\x12_extra_ports_countB\n" +
+	"\n" +
+	"\b_team_id\"e\n" +
 	"\x18CreateGameServerResponse\x12I\n" +
 	"\vgame_server\x18\x01 \x01(\v2(.obiente.cloud.gameservers.v1.GameServerR\n" +
 	"gameServer\"<\n" +
@@ -7100,7 +9196,41 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\"f\n" +
 	"\x19RestartGameServerResponse\x12I\n" +
 	"\vgame_server\x18\x01 \x01(\v2(.obiente.cloud.gameservers.v1.GameServerR\n" +
-	"gameServer\"\xa9\x02\n" +
+	"gameServer\"a\n" +
+	"\x1fExecuteGameServerCommandRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12\x18\n" +
+	"\acommand\x18\x02 \x01(\tR\acommand\":\n" +
+	" ExecuteGameServerCommandResponse\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\"\xdf\x01\n" +
+	"\x0fPlayerListEntry\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\acreated\x18\x03 \x01(\tH\x00R\acreated\x88\x01\x01\x12\x1b\n" +
+	"\x06source\x18\x04 \x01(\tH\x01R\x06source\x88\x01\x01\x12\x1d\n" +
+	"\aexpires\x18\x05 \x01(\tH\x02R\aexpires\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x06 \x01(\tH\x03R\x06reason\x88\x01\x01B\n" +
+	"\n" +
+	"\b_createdB\t\n" +
+	"\a_sourceB\n" +
+	"\n" +
+	"\b_expiresB\t\n" +
+	"\a_reason\"\xad\x01\n" +
+	"\x1cManagePlayerWhitelistRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12F\n" +
+	"\x06action\x18\x02 \x01(\x0e2..obiente.cloud.gameservers.v1.PlayerListActionR\x06action\x12\x1f\n" +
+	"\vplayer_name\x18\x03 \x01(\tR\n" +
+	"playerName\"\x80\x01\n" +
+	"\x1dManagePlayerWhitelistResponse\x12G\n" +
+	"\aplayers\x18\x01 \x03(\v2-.obiente.cloud.gameservers.v1.PlayerListEntryR\aplayers\x12\x16\n" +
+	"\x06output\x18\x02 \x01(\tR\x06output\"\xab\x01\n" +
+	"\x1aManagePlayerBanListRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12F\n" +
+	"\x06action\x18\x02 \x01(\x0e2..obiente.cloud.gameservers.v1.PlayerListActionR\x06action\x12\x1f\n" +
+	"\vplayer_name\x18\x03 \x01(\tR\n" +
+	"playerName\"~\n" +
+	"\x1bManagePlayerBanListResponse\x12G\n" +
+	"\aplayers\x18\x01 \x03(\v2-.obiente.cloud.gameservers.v1.PlayerListEntryR\aplayers\x12\x16\n" +
+	"\x06output\x18\x02 \x01(\tR\x06output\"\xa9\x02\n" +
 	"\x13GameServerHTTPRoute\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
 	"\x0egame_server_id\x18\x02 \x01(\tR\fgameServerId\x12\x16\n" +
@@ -7217,7 +9347,7 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\x1cGetGameServerMetricsResponse\x12H\n" +
 	"\ametrics\x18\x01 \x03(\v2..obiente.cloud.gameservers.v1.GameServerMetricR\ametrics\"F\n" +
 	"\x1eStreamGameServerMetricsRequest\x12$\n" +
-	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\"\xc9\x05\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\"\xe8\x05\n" +
 	"\x10GameServerMetric\x12$\n" +
 	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12/\n" +
@@ -7231,7 +9361,8 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\x10disk_write_bytes\x18\v \x01(\x03H\x06R\x0ediskWriteBytes\x88\x01\x01\x12&\n" +
 	"\fplayer_count\x18\b \x01(\x05H\aR\vplayerCount\x88\x01\x01\x12$\n" +
 	"\vmax_players\x18\t \x01(\x05H\bR\n" +
-	"maxPlayers\x88\x01\x01B\x14\n" +
+	"maxPlayers\x88\x01\x01\x12\x15\n" +
+	"\x03tps\x18\f \x01(\x01H\tR\x03tps\x88\x01\x01B\x14\n" +
 	"\x12_cpu_usage_percentB\x15\n" +
 	"\x13_memory_usage_bytesB\x15\n" +
 	"\x13_memory_limit_bytesB\x13\n" +
@@ -7240,7 +9371,8 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\x10_disk_read_bytesB\x13\n" +
 	"\x11_disk_write_bytesB\x0f\n" +
 	"\r_player_countB\x0e\n" +
-	"\f_max_players\"\x8f\x01\n" +
+	"\f_max_playersB\x06\n" +
+	"\x04_tps\"\x8f\x01\n" +
 	"\x19GetGameServerUsageRequest\x12$\n" +
 	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x19\n" +
@@ -7514,7 +9646,7 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"volumeName\x88\x01\x01B\x0e\n" +
 	"\f_volume_name\"\x8a\x01\n" +
 	"#CreateGameServerFileArchiveResponse\x12c\n" +
-	"\x10archive_response\x18\x01 \x01(\v28.obiente.cloud.common.v1.CreateServerFileArchiveResponseR\x0farchiveResponse\"\xd8\x02\n" +
+	"\x10archive_response\x18\x01 \x01(\v28.obiente.cloud.common.v1.CreateServerFileArchiveResponseR\x0farchiveResponse\"\xdd\x03\n" +
 	" GameServerFileTransferCredential\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -7525,9 +9657,15 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\n" +
 	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01\x129\n" +
 	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0f\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\rallowed_paths\x18\b \x03(\tR\fallowedPaths\x12^\n" +
+	"\x0fauthorized_keys\x18\t \x03(\v25.obiente.cloud.gameservers.v1.GameServerAuthorizedKeyR\x0eauthorizedKeysB\x0f\n" +
 	"\r_last_used_atB\r\n" +
-	"\v_expires_at\"\xa0\x01\n" +
+	"\v_expires_at\"Z\n" +
+	"\x17GameServerAuthorizedKey\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\tR\tpublicKey\x12 \n" +
+	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\"\xa0\x01\n" +
 	"$GameServerFileTransferConnectionInfo\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
@@ -7540,13 +9678,14 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\vcredentials\x18\x01 \x03(\v2>.obiente.cloud.gameservers.v1.GameServerFileTransferCredentialR\vcredentials\x12b\n" +
 	"\n" +
 	"connection\x18\x02 \x01(\v2B.obiente.cloud.gameservers.v1.GameServerFileTransferConnectionInfoR\n" +
-	"connection\"\xd0\x01\n" +
+	"connection\"\xf5\x01\n" +
 	"-CreateGameServerFileTransferCredentialRequest\x12$\n" +
 	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
 	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12>\n" +
 	"\n" +
-	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x12#\n" +
+	"\rallowed_paths\x18\x05 \x03(\tR\fallowedPathsB\r\n" +
 	"\v_expires_at\"\x90\x02\n" +
 	".CreateGameServerFileTransferCredentialResponse\x12^\n" +
 	"\n" +
@@ -7560,7 +9699,24 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12#\n" +
 	"\rcredential_id\x18\x02 \x01(\tR\fcredentialId\"J\n" +
 	".RevokeGameServerFileTransferCredentialResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\";\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x83\x01\n" +
+	"\x17AddAuthorizedKeyRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12#\n" +
+	"\rcredential_id\x18\x02 \x01(\tR\fcredentialId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\tR\tpublicKey\"z\n" +
+	"\x18AddAuthorizedKeyResponse\x12^\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2>.obiente.cloud.gameservers.v1.GameServerFileTransferCredentialR\n" +
+	"credential\"\x89\x01\n" +
+	"\x1aRemoveAuthorizedKeyRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12#\n" +
+	"\rcredential_id\x18\x02 \x01(\tR\fcredentialId\x12 \n" +
+	"\vfingerprint\x18\x03 \x01(\tR\vfingerprint\"}\n" +
+	"\x1bRemoveAuthorizedKeyResponse\x12^\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2>.obiente.cloud.gameservers.v1.GameServerFileTransferCredentialR\n" +
+	"credential\";\n" +
 	"\x1dGetMinecraftPlayerUUIDRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\"d\n" +
 	"\x1eGetMinecraftPlayerUUIDResponse\x12\x17\n" +
@@ -7761,7 +9917,101 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\amessage\x18\x06 \x01(\tH\x01R\amessage\x88\x01\x01B\x14\n" +
 	"\x12_replaced_filenameB\n" +
 	"\n" +
-	"\b_message*\xe9\x01\n" +
+	"\b_message\"\xe9\x01\n" +
+	"\rGameServerMod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\x0egame_server_id\x18\x02 \x01(\tR\fgameServerId\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x15\n" +
+	"\x06mod_id\x18\x04 \x01(\tR\x05modId\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\tR\aversion\x12\x1a\n" +
+	"\bfilename\x18\x06 \x01(\tR\bfilename\x12=\n" +
+	"\finstalled_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vinstalledAt\"\x8c\x01\n" +
+	"\x1bInstallGameServerModRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x15\n" +
+	"\x06mod_id\x18\x03 \x01(\tR\x05modId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\"]\n" +
+	"\x1cInstallGameServerModResponse\x12=\n" +
+	"\x03mod\x18\x01 \x01(\v2+.obiente.cloud.gameservers.v1.GameServerModR\x03mod\"t\n" +
+	"\x1dUninstallGameServerModRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x15\n" +
+	"\x06mod_id\x18\x03 \x01(\tR\x05modId\":\n" +
+	"\x1eUninstallGameServerModResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x19ListGameServerModsRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\"]\n" +
+	"\x1aListGameServerModsResponse\x12?\n" +
+	"\x04mods\x18\x01 \x03(\v2+.obiente.cloud.gameservers.v1.GameServerModR\x04mods\"\xcb\x02\n" +
+	"\x10GameServerBackup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\x0egame_server_id\x18\x02 \x01(\tR\fgameServerId\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12(\n" +
+	"\rerror_message\x18\x05 \x01(\tH\x00R\ferrorMessage\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vcompletedAt\x88\x01\x01B\x10\n" +
+	"\x0e_error_messageB\x0f\n" +
+	"\r_completed_at\"\x94\x01\n" +
+	"\x1fScheduleGameServerBackupRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12\x1a\n" +
+	"\bschedule\x18\x02 \x01(\tR\bschedule\x12!\n" +
+	"\tretention\x18\x03 \x01(\x05H\x00R\tretention\x88\x01\x01B\f\n" +
+	"\n" +
+	"_retention\"\\\n" +
+	" ScheduleGameServerBackupResponse\x12\x1a\n" +
+	"\bschedule\x18\x01 \x01(\tR\bschedule\x12\x1c\n" +
+	"\tretention\x18\x02 \x01(\x05R\tretention\"D\n" +
+	"\x1cListGameServerBackupsRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\"i\n" +
+	"\x1dListGameServerBackupsResponse\x12H\n" +
+	"\abackups\x18\x01 \x03(\v2..obiente.cloud.gameservers.v1.GameServerBackupR\abackups\"c\n" +
+	"\x1eRestoreGameServerBackupRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12\x1b\n" +
+	"\tbackup_id\x18\x02 \x01(\tR\bbackupId\";\n" +
+	"\x1fRestoreGameServerBackupResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf7\x01\n" +
+	"\x12DiscordIntegration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\x0egame_server_id\x18\x02 \x01(\tR\fgameServerId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xab\x01\n" +
+	"\x1cSetDiscordIntegrationRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x12 \n" +
+	"\tbot_token\x18\x02 \x01(\tH\x00R\bbotToken\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06eventsB\f\n" +
+	"\n" +
+	"_bot_token\"s\n" +
+	"\x1dSetDiscordIntegrationResponse\x12R\n" +
+	"\vintegration\x18\x01 \x01(\v20.obiente.cloud.gameservers.v1.DiscordIntegrationR\vintegration\"G\n" +
+	"\x1fDeleteDiscordIntegrationRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\"<\n" +
+	" DeleteDiscordIntegrationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe8\x01\n" +
+	"\x12IdleShutdownPolicy\x120\n" +
+	"\x14idle_timeout_minutes\x18\x01 \x01(\x05R\x12idleTimeoutMinutes\x128\n" +
+	"\x19min_players_to_stay_alive\x18\x02 \x01(\x05R\x15minPlayersToStayAlive\x12N\n" +
+	"\x13last_player_seen_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x10lastPlayerSeenAt\x88\x01\x01B\x16\n" +
+	"\x14_last_player_seen_at\"\xb0\x01\n" +
+	"\x1cSetIdleShutdownPolicyRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\x120\n" +
+	"\x14idle_timeout_minutes\x18\x02 \x01(\x05R\x12idleTimeoutMinutes\x128\n" +
+	"\x19min_players_to_stay_alive\x18\x03 \x01(\x05R\x15minPlayersToStayAlive\"i\n" +
+	"\x1dSetIdleShutdownPolicyResponse\x12H\n" +
+	"\x06policy\x18\x01 \x01(\v20.obiente.cloud.gameservers.v1.IdleShutdownPolicyR\x06policy\"D\n" +
+	"\x1cGetIdleShutdownPolicyRequest\x12$\n" +
+	"\x0egame_server_id\x18\x01 \x01(\tR\fgameServerId\"i\n" +
+	"\x1dGetIdleShutdownPolicyResponse\x12H\n" +
+	"\x06policy\x18\x01 \x01(\v20.obiente.cloud.gameservers.v1.IdleShutdownPolicyR\x06policy*\xe9\x01\n" +
 	"\bGameType\x12\x19\n" +
 	"\x15GAME_TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tMINECRAFT\x10\x01\x12\x12\n" +
@@ -7779,7 +10029,7 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"SEVEN_DAYS\x10\v\x12\f\n" +
 	"\bFACTORIO\x10\f\x12\x14\n" +
 	"\x10SPACED_ENGINEERS\x10\r\x12\t\n" +
-	"\x05OTHER\x10c*\x95\x01\n" +
+	"\x05OTHER\x10c*\xa7\x01\n" +
 	"\x10GameServerStatus\x12\"\n" +
 	"\x1eGAME_SERVER_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aCREATED\x10\x01\x12\f\n" +
@@ -7790,7 +10040,13 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\n" +
 	"\x06FAILED\x10\x06\x12\x0e\n" +
 	"\n" +
-	"RESTARTING\x10\a*\xa8\x01\n" +
+	"RESTARTING\x10\a\x12\x10\n" +
+	"\fSTOPPED_IDLE\x10\b*\x8e\x01\n" +
+	"\x10PlayerListAction\x12\"\n" +
+	"\x1ePLAYER_LIST_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PLAYER_LIST_ACTION_ADD\x10\x01\x12\x1d\n" +
+	"\x19PLAYER_LIST_ACTION_REMOVE\x10\x02\x12\x1b\n" +
+	"\x17PLAYER_LIST_ACTION_LIST\x10\x03*\xa8\x01\n" +
 	"\x13GameServerEntryType\x12&\n" +
 	"\"GAME_SERVER_ENTRY_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bGAME_SERVER_ENTRY_TYPE_FILE\x10\x01\x12$\n" +
@@ -7799,7 +10055,7 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\x14MinecraftProjectType\x12&\n" +
 	"\"MINECRAFT_PROJECT_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aMINECRAFT_PROJECT_TYPE_MOD\x10\x01\x12!\n" +
-	"\x1dMINECRAFT_PROJECT_TYPE_PLUGIN\x10\x022\xac0\n" +
+	"\x1dMINECRAFT_PROJECT_TYPE_PLUGIN\x10\x022\xc6A\n" +
 	"\x11GameServerService\x12~\n" +
 	"\x0fListGameServers\x124.obiente.cloud.gameservers.v1.ListGameServersRequest\x1a5.obiente.cloud.gameservers.v1.ListGameServersResponse\x12\x81\x01\n" +
 	"\x10CreateGameServer\x125.obiente.cloud.gameservers.v1.CreateGameServerRequest\x1a6.obiente.cloud.gameservers.v1.CreateGameServerResponse\x12x\n" +
@@ -7816,7 +10072,10 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\x16VerifyGameServerDomain\x12;.obiente.cloud.gameservers.v1.VerifyGameServerDomainRequest\x1a<.obiente.cloud.gameservers.v1.VerifyGameServerDomainResponse\x12\x8d\x01\n" +
 	"\x16StreamGameServerStatus\x12;.obiente.cloud.gameservers.v1.StreamGameServerStatusRequest\x1a4.obiente.cloud.gameservers.v1.GameServerStatusUpdate0\x01\x12\x84\x01\n" +
 	"\x11GetGameServerLogs\x126.obiente.cloud.gameservers.v1.GetGameServerLogsRequest\x1a7.obiente.cloud.gameservers.v1.GetGameServerLogsResponse\x12\x84\x01\n" +
-	"\x14StreamGameServerLogs\x129.obiente.cloud.gameservers.v1.StreamGameServerLogsRequest\x1a/.obiente.cloud.gameservers.v1.GameServerLogLine0\x01\x12\x8d\x01\n" +
+	"\x14StreamGameServerLogs\x129.obiente.cloud.gameservers.v1.StreamGameServerLogsRequest\x1a/.obiente.cloud.gameservers.v1.GameServerLogLine0\x01\x12\x99\x01\n" +
+	"\x18ExecuteGameServerCommand\x12=.obiente.cloud.gameservers.v1.ExecuteGameServerCommandRequest\x1a>.obiente.cloud.gameservers.v1.ExecuteGameServerCommandResponse\x12\x90\x01\n" +
+	"\x15ManagePlayerWhitelist\x12:.obiente.cloud.gameservers.v1.ManagePlayerWhitelistRequest\x1a;.obiente.cloud.gameservers.v1.ManagePlayerWhitelistResponse\x12\x8a\x01\n" +
+	"\x13ManagePlayerBanList\x128.obiente.cloud.gameservers.v1.ManagePlayerBanListRequest\x1a9.obiente.cloud.gameservers.v1.ManagePlayerBanListResponse\x12\x8d\x01\n" +
 	"\x14GetGameServerMetrics\x129.obiente.cloud.gameservers.v1.GetGameServerMetricsRequest\x1a:.obiente.cloud.gameservers.v1.GetGameServerMetricsResponse\x12\x89\x01\n" +
 	"\x17StreamGameServerMetrics\x12<.obiente.cloud.gameservers.v1.StreamGameServerMetricsRequest\x1a..obiente.cloud.gameservers.v1.GameServerMetric0\x01\x12\x87\x01\n" +
 	"\x12GetGameServerUsage\x127.obiente.cloud.gameservers.v1.GetGameServerUsageRequest\x1a8.obiente.cloud.gameservers.v1.GetGameServerUsageResponse\x12\x8a\x01\n" +
@@ -7833,7 +10092,9 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\x1bCreateGameServerFileArchive\x12@.obiente.cloud.gameservers.v1.CreateGameServerFileArchiveRequest\x1aA.obiente.cloud.gameservers.v1.CreateGameServerFileArchiveResponse\x12\xc0\x01\n" +
 	"%ListGameServerFileTransferCredentials\x12J.obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsRequest\x1aK.obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsResponse\x12\xc3\x01\n" +
 	"&CreateGameServerFileTransferCredential\x12K.obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialRequest\x1aL.obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialResponse\x12\xc3\x01\n" +
-	"&RevokeGameServerFileTransferCredential\x12K.obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialRequest\x1aL.obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialResponse\x12\x93\x01\n" +
+	"&RevokeGameServerFileTransferCredential\x12K.obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialRequest\x1aL.obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialResponse\x12\x81\x01\n" +
+	"\x10AddAuthorizedKey\x125.obiente.cloud.gameservers.v1.AddAuthorizedKeyRequest\x1a6.obiente.cloud.gameservers.v1.AddAuthorizedKeyResponse\x12\x8a\x01\n" +
+	"\x13RemoveAuthorizedKey\x128.obiente.cloud.gameservers.v1.RemoveAuthorizedKeyRequest\x1a9.obiente.cloud.gameservers.v1.RemoveAuthorizedKeyResponse\x12\x93\x01\n" +
 	"\x16GetMinecraftPlayerUUID\x12;.obiente.cloud.gameservers.v1.GetMinecraftPlayerUUIDRequest\x1a<.obiente.cloud.gameservers.v1.GetMinecraftPlayerUUIDResponse\x12\x9c\x01\n" +
 	"\x19GetMinecraftPlayerProfile\x12>.obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileRequest\x1a?.obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileResponse\x12\x90\x01\n" +
 	"\x15ListMinecraftProjects\x12:.obiente.cloud.gameservers.v1.ListMinecraftProjectsRequest\x1a;.obiente.cloud.gameservers.v1.ListMinecraftProjectsResponse\x12\xab\x01\n" +
@@ -7841,7 +10102,17 @@ const file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc = "" +
 	"\x1bGetMinecraftProjectVersions\x12@.obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsRequest\x1aA.obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsResponse\x12\x8a\x01\n" +
 	"\x13GetMinecraftProject\x128.obiente.cloud.gameservers.v1.GetMinecraftProjectRequest\x1a9.obiente.cloud.gameservers.v1.GetMinecraftProjectResponse\x12\xa2\x01\n" +
 	"\x1bInstallMinecraftProjectFile\x12@.obiente.cloud.gameservers.v1.InstallMinecraftProjectFileRequest\x1aA.obiente.cloud.gameservers.v1.InstallMinecraftProjectFileResponse\x12\x9f\x01\n" +
-	"\x1aUpdateMinecraftProjectFile\x12?.obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileRequest\x1a@.obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileResponseBWZUgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1;gameserversv1b\x06proto3"
+	"\x1aUpdateMinecraftProjectFile\x12?.obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileRequest\x1a@.obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileResponse\x12\x8d\x01\n" +
+	"\x14InstallGameServerMod\x129.obiente.cloud.gameservers.v1.InstallGameServerModRequest\x1a:.obiente.cloud.gameservers.v1.InstallGameServerModResponse\x12\x93\x01\n" +
+	"\x16UninstallGameServerMod\x12;.obiente.cloud.gameservers.v1.UninstallGameServerModRequest\x1a<.obiente.cloud.gameservers.v1.UninstallGameServerModResponse\x12\x87\x01\n" +
+	"\x12ListGameServerMods\x127.obiente.cloud.gameservers.v1.ListGameServerModsRequest\x1a8.obiente.cloud.gameservers.v1.ListGameServerModsResponse\x12\x99\x01\n" +
+	"\x18ScheduleGameServerBackup\x12=.obiente.cloud.gameservers.v1.ScheduleGameServerBackupRequest\x1a>.obiente.cloud.gameservers.v1.ScheduleGameServerBackupResponse\x12\x90\x01\n" +
+	"\x15ListGameServerBackups\x12:.obiente.cloud.gameservers.v1.ListGameServerBackupsRequest\x1a;.obiente.cloud.gameservers.v1.ListGameServerBackupsResponse\x12\x96\x01\n" +
+	"\x17RestoreGameServerBackup\x12<.obiente.cloud.gameservers.v1.RestoreGameServerBackupRequest\x1a=.obiente.cloud.gameservers.v1.RestoreGameServerBackupResponse\x12\x90\x01\n" +
+	"\x15SetDiscordIntegration\x12:.obiente.cloud.gameservers.v1.SetDiscordIntegrationRequest\x1a;.obiente.cloud.gameservers.v1.SetDiscordIntegrationResponse\x12\x99\x01\n" +
+	"\x18DeleteDiscordIntegration\x12=.obiente.cloud.gameservers.v1.DeleteDiscordIntegrationRequest\x1a>.obiente.cloud.gameservers.v1.DeleteDiscordIntegrationResponse\x12\x90\x01\n" +
+	"\x15SetIdleShutdownPolicy\x12:.obiente.cloud.gameservers.v1.SetIdleShutdownPolicyRequest\x1a;.obiente.cloud.gameservers.v1.SetIdleShutdownPolicyResponse\x12\x90\x01\n" +
+	"\x15GetIdleShutdownPolicy\x12:.obiente.cloud.gameservers.v1.GetIdleShutdownPolicyRequest\x1a;.obiente.cloud.gameservers.v1.GetIdleShutdownPolicyResponseBWZUgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1;gameserversv1b\x06proto3"
 
 var (
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescOnce sync.Once
@@ -7855,283 +10126,371 @@ func file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescGZIP() [
 	return file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDescData
 }
 
-var file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes = make([]protoimpl.MessageInfo, 100)
+var file_obiente_cloud_gameservers_v1_game_server_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes = make([]protoimpl.MessageInfo, 137)
 var file_obiente_cloud_gameservers_v1_game_server_service_proto_goTypes = []any{
 	(GameType)(0),                                          // 0: obiente.cloud.gameservers.v1.GameType
 	(GameServerStatus)(0),                                  // 1: obiente.cloud.gameservers.v1.GameServerStatus
-	(GameServerEntryType)(0),                               // 2: obiente.cloud.gameservers.v1.GameServerEntryType
-	(MinecraftProjectType)(0),                              // 3: obiente.cloud.gameservers.v1.MinecraftProjectType
-	(*ListGameServersRequest)(nil),                         // 4: obiente.cloud.gameservers.v1.ListGameServersRequest
-	(*ListGameServersResponse)(nil),                        // 5: obiente.cloud.gameservers.v1.ListGameServersResponse
-	(*CreateGameServerRequest)(nil),                        // 6: obiente.cloud.gameservers.v1.CreateGameServerRequest
-	(*CreateGameServerResponse)(nil),                       // 7: obiente.cloud.gameservers.v1.CreateGameServerResponse
-	(*GetGameServerRequest)(nil),                           // 8: obiente.cloud.gameservers.v1.GetGameServerRequest
-	(*GetGameServerResponse)(nil),                          // 9: obiente.cloud.gameservers.v1.GetGameServerResponse
-	(*UpdateGameServerRequest)(nil),                        // 10: obiente.cloud.gameservers.v1.UpdateGameServerRequest
-	(*UpdateGameServerResponse)(nil),                       // 11: obiente.cloud.gameservers.v1.UpdateGameServerResponse
-	(*DeleteGameServerRequest)(nil),                        // 12: obiente.cloud.gameservers.v1.DeleteGameServerRequest
-	(*DeleteGameServerResponse)(nil),                       // 13: obiente.cloud.gameservers.v1.DeleteGameServerResponse
-	(*StartGameServerRequest)(nil),                         // 14: obiente.cloud.gameservers.v1.StartGameServerRequest
-	(*StartGameServerResponse)(nil),                        // 15: obiente.cloud.gameservers.v1.StartGameServerResponse
-	(*StopGameServerRequest)(nil),                          // 16: obiente.cloud.gameservers.v1.StopGameServerRequest
-	(*StopGameServerResponse)(nil),                         // 17: obiente.cloud.gameservers.v1.StopGameServerResponse
-	(*RestartGameServerRequest)(nil),                       // 18: obiente.cloud.gameservers.v1.RestartGameServerRequest
-	(*RestartGameServerResponse)(nil),                      // 19: obiente.cloud.gameservers.v1.RestartGameServerResponse
-	(*GameServerHTTPRoute)(nil),                            // 20: obiente.cloud.gameservers.v1.GameServerHTTPRoute
-	(*GetGameServerHTTPRoutesRequest)(nil),                 // 21: obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesRequest
-	(*GetGameServerHTTPRoutesResponse)(nil),                // 22: obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesResponse
-	(*UpsertGameServerHTTPRouteRequest)(nil),               // 23: obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteRequest
-	(*UpsertGameServerHTTPRouteResponse)(nil),              // 24: obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteResponse
-	(*DeleteGameServerHTTPRouteRequest)(nil),               // 25: obiente.cloud.gameservers.v1.DeleteGameServerHTTPRouteRequest
-	(*DeleteGameServerHTTPRouteResponse)(nil),              // 26: obiente.cloud.gameservers.v1.DeleteGameServerHTTPRouteResponse
-	(*GetGameServerDomainVerificationTokenRequest)(nil),    // 27: obiente.cloud.gameservers.v1.GetGameServerDomainVerificationTokenRequest
-	(*GetGameServerDomainVerificationTokenResponse)(nil),   // 28: obiente.cloud.gameservers.v1.GetGameServerDomainVerificationTokenResponse
-	(*VerifyGameServerDomainRequest)(nil),                  // 29: obiente.cloud.gameservers.v1.VerifyGameServerDomainRequest
-	(*VerifyGameServerDomainResponse)(nil),                 // 30: obiente.cloud.gameservers.v1.VerifyGameServerDomainResponse
-	(*StreamGameServerStatusRequest)(nil),                  // 31: obiente.cloud.gameservers.v1.StreamGameServerStatusRequest
-	(*GameServerStatusUpdate)(nil),                         // 32: obiente.cloud.gameservers.v1.GameServerStatusUpdate
-	(*GetGameServerLogsRequest)(nil),                       // 33: obiente.cloud.gameservers.v1.GetGameServerLogsRequest
-	(*GetGameServerLogsResponse)(nil),                      // 34: obiente.cloud.gameservers.v1.GetGameServerLogsResponse
-	(*StreamGameServerLogsRequest)(nil),                    // 35: obiente.cloud.gameservers.v1.StreamGameServerLogsRequest
-	(*GameServerLogLine)(nil),                              // 36: obiente.cloud.gameservers.v1.GameServerLogLine
-	(*GetGameServerMetricsRequest)(nil),                    // 37: obiente.cloud.gameservers.v1.GetGameServerMetricsRequest
-	(*GetGameServerMetricsResponse)(nil),                   // 38: obiente.cloud.gameservers.v1.GetGameServerMetricsResponse
-	(*StreamGameServerMetricsRequest)(nil),                 // 39: obiente.cloud.gameservers.v1.StreamGameServerMetricsRequest
-	(*GameServerMetric)(nil),                               // 40: obiente.cloud.gameservers.v1.GameServerMetric
-	(*GetGameServerUsageRequest)(nil),                      // 41: obiente.cloud.gameservers.v1.GetGameServerUsageRequest
-	(*GetGameServerUsageResponse)(nil),                     // 42: obiente.cloud.gameservers.v1.GetGameServerUsageResponse
-	(*GameServerUsageMetrics)(nil),                         // 43: obiente.cloud.gameservers.v1.GameServerUsageMetrics
-	(*GameServer)(nil),                                     // 44: obiente.cloud.gameservers.v1.GameServer
-	(*GameServerFile)(nil),                                 // 45: obiente.cloud.gameservers.v1.GameServerFile
-	(*GameServerVolumeInfo)(nil),                           // 46: obiente.cloud.gameservers.v1.GameServerVolumeInfo
-	(*ListGameServerFilesRequest)(nil),                     // 47: obiente.cloud.gameservers.v1.ListGameServerFilesRequest
-	(*ListGameServerFilesResponse)(nil),                    // 48: obiente.cloud.gameservers.v1.ListGameServerFilesResponse
-	(*SearchGameServerFilesRequest)(nil),                   // 49: obiente.cloud.gameservers.v1.SearchGameServerFilesRequest
-	(*SearchGameServerFilesResponse)(nil),                  // 50: obiente.cloud.gameservers.v1.SearchGameServerFilesResponse
-	(*GetGameServerFileRequest)(nil),                       // 51: obiente.cloud.gameservers.v1.GetGameServerFileRequest
-	(*GetGameServerFileResponse)(nil),                      // 52: obiente.cloud.gameservers.v1.GetGameServerFileResponse
-	(*UploadGameServerFilesRequest)(nil),                   // 53: obiente.cloud.gameservers.v1.UploadGameServerFilesRequest
-	(*UploadGameServerFilesMetadata)(nil),                  // 54: obiente.cloud.gameservers.v1.UploadGameServerFilesMetadata
-	(*GameServerFileMetadata)(nil),                         // 55: obiente.cloud.gameservers.v1.GameServerFileMetadata
-	(*UploadGameServerFilesResponse)(nil),                  // 56: obiente.cloud.gameservers.v1.UploadGameServerFilesResponse
-	(*ChunkUploadGameServerFilesRequest)(nil),              // 57: obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesRequest
-	(*ChunkUploadGameServerFilesResponse)(nil),             // 58: obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesResponse
-	(*DeleteGameServerEntriesRequest)(nil),                 // 59: obiente.cloud.gameservers.v1.DeleteGameServerEntriesRequest
-	(*DeleteGameServerEntriesError)(nil),                   // 60: obiente.cloud.gameservers.v1.DeleteGameServerEntriesError
-	(*DeleteGameServerEntriesResponse)(nil),                // 61: obiente.cloud.gameservers.v1.DeleteGameServerEntriesResponse
-	(*RenameGameServerEntryRequest)(nil),                   // 62: obiente.cloud.gameservers.v1.RenameGameServerEntryRequest
-	(*RenameGameServerEntryResponse)(nil),                  // 63: obiente.cloud.gameservers.v1.RenameGameServerEntryResponse
-	(*CreateGameServerEntryRequest)(nil),                   // 64: obiente.cloud.gameservers.v1.CreateGameServerEntryRequest
-	(*CreateGameServerEntryResponse)(nil),                  // 65: obiente.cloud.gameservers.v1.CreateGameServerEntryResponse
-	(*WriteGameServerFileRequest)(nil),                     // 66: obiente.cloud.gameservers.v1.WriteGameServerFileRequest
-	(*WriteGameServerFileResponse)(nil),                    // 67: obiente.cloud.gameservers.v1.WriteGameServerFileResponse
-	(*ExtractGameServerFileRequest)(nil),                   // 68: obiente.cloud.gameservers.v1.ExtractGameServerFileRequest
-	(*ExtractGameServerFileResponse)(nil),                  // 69: obiente.cloud.gameservers.v1.ExtractGameServerFileResponse
-	(*CreateGameServerFileArchiveRequest)(nil),             // 70: obiente.cloud.gameservers.v1.CreateGameServerFileArchiveRequest
-	(*CreateGameServerFileArchiveResponse)(nil),            // 71: obiente.cloud.gameservers.v1.CreateGameServerFileArchiveResponse
-	(*GameServerFileTransferCredential)(nil),               // 72: obiente.cloud.gameservers.v1.GameServerFileTransferCredential
-	(*GameServerFileTransferConnectionInfo)(nil),           // 73: obiente.cloud.gameservers.v1.GameServerFileTransferConnectionInfo
-	(*ListGameServerFileTransferCredentialsRequest)(nil),   // 74: obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsRequest
-	(*ListGameServerFileTransferCredentialsResponse)(nil),  // 75: obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsResponse
-	(*CreateGameServerFileTransferCredentialRequest)(nil),  // 76: obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialRequest
-	(*CreateGameServerFileTransferCredentialResponse)(nil), // 77: obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialResponse
-	(*RevokeGameServerFileTransferCredentialRequest)(nil),  // 78: obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialRequest
-	(*RevokeGameServerFileTransferCredentialResponse)(nil), // 79: obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialResponse
-	(*GetMinecraftPlayerUUIDRequest)(nil),                  // 80: obiente.cloud.gameservers.v1.GetMinecraftPlayerUUIDRequest
-	(*GetMinecraftPlayerUUIDResponse)(nil),                 // 81: obiente.cloud.gameservers.v1.GetMinecraftPlayerUUIDResponse
-	(*GetMinecraftPlayerProfileRequest)(nil),               // 82: obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileRequest
-	(*GetMinecraftPlayerProfileResponse)(nil),              // 83: obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileResponse
-	(*MinecraftProject)(nil),                               // 84: obiente.cloud.gameservers.v1.MinecraftProject
-	(*ListMinecraftProjectsRequest)(nil),                   // 85: obiente.cloud.gameservers.v1.ListMinecraftProjectsRequest
-	(*ListMinecraftProjectsResponse)(nil),                  // 86: obiente.cloud.gameservers.v1.ListMinecraftProjectsResponse
-	(*InstalledMinecraftProjectFile)(nil),                  // 87: obiente.cloud.gameservers.v1.InstalledMinecraftProjectFile
-	(*ListInstalledMinecraftProjectsRequest)(nil),          // 88: obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsRequest
-	(*ListInstalledMinecraftProjectsResponse)(nil),         // 89: obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsResponse
-	(*MinecraftProjectFile)(nil),                           // 90: obiente.cloud.gameservers.v1.MinecraftProjectFile
-	(*MinecraftProjectVersion)(nil),                        // 91: obiente.cloud.gameservers.v1.MinecraftProjectVersion
-	(*GetMinecraftProjectVersionsRequest)(nil),             // 92: obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsRequest
-	(*GetMinecraftProjectVersionsResponse)(nil),            // 93: obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsResponse
-	(*GetMinecraftProjectRequest)(nil),                     // 94: obiente.cloud.gameservers.v1.GetMinecraftProjectRequest
-	(*GetMinecraftProjectResponse)(nil),                    // 95: obiente.cloud.gameservers.v1.GetMinecraftProjectResponse
-	(*InstallMinecraftProjectFileRequest)(nil),             // 96: obiente.cloud.gameservers.v1.InstallMinecraftProjectFileRequest
-	(*InstallMinecraftProjectFileResponse)(nil),            // 97: obiente.cloud.gameservers.v1.InstallMinecraftProjectFileResponse
-	(*UpdateMinecraftProjectFileRequest)(nil),              // 98: obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileRequest
-	(*UpdateMinecraftProjectFileResponse)(nil),             // 99: obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileResponse
-	nil,                                     // 100: obiente.cloud.gameservers.v1.CreateGameServerRequest.EnvVarsEntry
-	nil,                                     // 101: obiente.cloud.gameservers.v1.UpdateGameServerRequest.EnvVarsEntry
-	nil,                                     // 102: obiente.cloud.gameservers.v1.GameServer.EnvVarsEntry
-	nil,                                     // 103: obiente.cloud.gameservers.v1.MinecraftProjectFile.HashesEntry
-	(*timestamppb.Timestamp)(nil),           // 104: google.protobuf.Timestamp
-	(v1.LogLevel)(0),                        // 105: obiente.cloud.common.v1.LogLevel
-	(*v1.ChunkedUploadPayload)(nil),         // 106: obiente.cloud.common.v1.ChunkedUploadPayload
-	(*v1.ChunkedUploadResponsePayload)(nil), // 107: obiente.cloud.common.v1.ChunkedUploadResponsePayload
-	(*v1.CreateServerFileArchiveRequest)(nil),  // 108: obiente.cloud.common.v1.CreateServerFileArchiveRequest
-	(*v1.CreateServerFileArchiveResponse)(nil), // 109: obiente.cloud.common.v1.CreateServerFileArchiveResponse
+	(PlayerListAction)(0),                                  // 2: obiente.cloud.gameservers.v1.PlayerListAction
+	(GameServerEntryType)(0),                               // 3: obiente.cloud.gameservers.v1.GameServerEntryType
+	(MinecraftProjectType)(0),                              // 4: obiente.cloud.gameservers.v1.MinecraftProjectType
+	(*ListGameServersRequest)(nil),                         // 5: obiente.cloud.gameservers.v1.ListGameServersRequest
+	(*ListGameServersResponse)(nil),                        // 6: obiente.cloud.gameservers.v1.ListGameServersResponse
+	(*CreateGameServerRequest)(nil),                        // 7: obiente.cloud.gameservers.v1.CreateGameServerRequest
+	(*CreateGameServerResponse)(nil),                       // 8: obiente.cloud.gameservers.v1.CreateGameServerResponse
+	(*GetGameServerRequest)(nil),                           // 9: obiente.cloud.gameservers.v1.GetGameServerRequest
+	(*GetGameServerResponse)(nil),                          // 10: obiente.cloud.gameservers.v1.GetGameServerResponse
+	(*UpdateGameServerRequest)(nil),                        // 11: obiente.cloud.gameservers.v1.UpdateGameServerRequest
+	(*UpdateGameServerResponse)(nil),                       // 12: obiente.cloud.gameservers.v1.UpdateGameServerResponse
+	(*DeleteGameServerRequest)(nil),                        // 13: obiente.cloud.gameservers.v1.DeleteGameServerRequest
+	(*DeleteGameServerResponse)(nil),                       // 14: obiente.cloud.gameservers.v1.DeleteGameServerResponse
+	(*StartGameServerRequest)(nil),                         // 15: obiente.cloud.gameservers.v1.StartGameServerRequest
+	(*StartGameServerResponse)(nil),                        // 16: obiente.cloud.gameservers.v1.StartGameServerResponse
+	(*StopGameServerRequest)(nil),                          // 17: obiente.cloud.gameservers.v1.StopGameServerRequest
+	(*StopGameServerResponse)(nil),                         // 18: obiente.cloud.gameservers.v1.StopGameServerResponse
+	(*RestartGameServerRequest)(nil),                       // 19: obiente.cloud.gameservers.v1.RestartGameServerRequest
+	(*RestartGameServerResponse)(nil),                      // 20: obiente.cloud.gameservers.v1.RestartGameServerResponse
+	(*ExecuteGameServerCommandRequest)(nil),                // 21: obiente.cloud.gameservers.v1.ExecuteGameServerCommandRequest
+	(*ExecuteGameServerCommandResponse)(nil),               // 22: obiente.cloud.gameservers.v1.ExecuteGameServerCommandResponse
+	(*PlayerListEntry)(nil),                                // 23: obiente.cloud.gameservers.v1.PlayerListEntry
+	(*ManagePlayerWhitelistRequest)(nil),                   // 24: obiente.cloud.gameservers.v1.ManagePlayerWhitelistRequest
+	(*ManagePlayerWhitelistResponse)(nil),                  // 25: obiente.cloud.gameservers.v1.ManagePlayerWhitelistResponse
+	(*ManagePlayerBanListRequest)(nil),                     // 26: obiente.cloud.gameservers.v1.ManagePlayerBanListRequest
+	(*ManagePlayerBanListResponse)(nil),                    // 27: obiente.cloud.gameservers.v1.ManagePlayerBanListResponse
+	(*GameServerHTTPRoute)(nil),                            // 28: obiente.cloud.gameservers.v1.GameServerHTTPRoute
+	(*GetGameServerHTTPRoutesRequest)(nil),                 // 29: obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesRequest
+	(*GetGameServerHTTPRoutesResponse)(nil),                // 30: obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesResponse
+	(*UpsertGameServerHTTPRouteRequest)(nil),               // 31: obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteRequest
+	(*UpsertGameServerHTTPRouteResponse)(nil),              // 32: obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteResponse
+	(*DeleteGameServerHTTPRouteRequest)(nil),               // 33: obiente.cloud.gameservers.v1.DeleteGameServerHTTPRouteRequest
+	(*DeleteGameServerHTTPRouteResponse)(nil),              // 34: obiente.cloud.gameservers.v1.DeleteGameServerHTTPRouteResponse
+	(*GetGameServerDomainVerificationTokenRequest)(nil),    // 35: obiente.cloud.gameservers.v1.GetGameServerDomainVerificationTokenRequest
+	(*GetGameServerDomainVerificationTokenResponse)(nil),   // 36: obiente.cloud.gameservers.v1.GetGameServerDomainVerificationTokenResponse
+	(*VerifyGameServerDomainRequest)(nil),                  // 37: obiente.cloud.gameservers.v1.VerifyGameServerDomainRequest
+	(*VerifyGameServerDomainResponse)(nil),                 // 38: obiente.cloud.gameservers.v1.VerifyGameServerDomainResponse
+	(*StreamGameServerStatusRequest)(nil),                  // 39: obiente.cloud.gameservers.v1.StreamGameServerStatusRequest
+	(*GameServerStatusUpdate)(nil),                         // 40: obiente.cloud.gameservers.v1.GameServerStatusUpdate
+	(*GetGameServerLogsRequest)(nil),                       // 41: obiente.cloud.gameservers.v1.GetGameServerLogsRequest
+	(*GetGameServerLogsResponse)(nil),                      // 42: obiente.cloud.gameservers.v1.GetGameServerLogsResponse
+	(*StreamGameServerLogsRequest)(nil),                    // 43: obiente.cloud.gameservers.v1.StreamGameServerLogsRequest
+	(*GameServerLogLine)(nil),                              // 44: obiente.cloud.gameservers.v1.GameServerLogLine
+	(*GetGameServerMetricsRequest)(nil),                    // 45: obiente.cloud.gameservers.v1.GetGameServerMetricsRequest
+	(*GetGameServerMetricsResponse)(nil),                   // 46: obiente.cloud.gameservers.v1.GetGameServerMetricsResponse
+	(*StreamGameServerMetricsRequest)(nil),                 // 47: obiente.cloud.gameservers.v1.StreamGameServerMetricsRequest
+	(*GameServerMetric)(nil),                               // 48: obiente.cloud.gameservers.v1.GameServerMetric
+	(*GetGameServerUsageRequest)(nil),                      // 49: obiente.cloud.gameservers.v1.GetGameServerUsageRequest
+	(*GetGameServerUsageResponse)(nil),                     // 50: obiente.cloud.gameservers.v1.GetGameServerUsageResponse
+	(*GameServerUsageMetrics)(nil),                         // 51: obiente.cloud.gameservers.v1.GameServerUsageMetrics
+	(*GameServer)(nil),                                     // 52: obiente.cloud.gameservers.v1.GameServer
+	(*GameServerFile)(nil),                                 // 53: obiente.cloud.gameservers.v1.GameServerFile
+	(*GameServerVolumeInfo)(nil),                           // 54: obiente.cloud.gameservers.v1.GameServerVolumeInfo
+	(*ListGameServerFilesRequest)(nil),                     // 55: obiente.cloud.gameservers.v1.ListGameServerFilesRequest
+	(*ListGameServerFilesResponse)(nil),                    // 56: obiente.cloud.gameservers.v1.ListGameServerFilesResponse
+	(*SearchGameServerFilesRequest)(nil),                   // 57: obiente.cloud.gameservers.v1.SearchGameServerFilesRequest
+	(*SearchGameServerFilesResponse)(nil),                  // 58: obiente.cloud.gameservers.v1.SearchGameServerFilesResponse
+	(*GetGameServerFileRequest)(nil),                       // 59: obiente.cloud.gameservers.v1.GetGameServerFileRequest
+	(*GetGameServerFileResponse)(nil),                      // 60: obiente.cloud.gameservers.v1.GetGameServerFileResponse
+	(*UploadGameServerFilesRequest)(nil),                   // 61: obiente.cloud.gameservers.v1.UploadGameServerFilesRequest
+	(*UploadGameServerFilesMetadata)(nil),                  // 62: obiente.cloud.gameservers.v1.UploadGameServerFilesMetadata
+	(*GameServerFileMetadata)(nil),                         // 63: obiente.cloud.gameservers.v1.GameServerFileMetadata
+	(*UploadGameServerFilesResponse)(nil),                  // 64: obiente.cloud.gameservers.v1.UploadGameServerFilesResponse
+	(*ChunkUploadGameServerFilesRequest)(nil),              // 65: obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesRequest
+	(*ChunkUploadGameServerFilesResponse)(nil),             // 66: obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesResponse
+	(*DeleteGameServerEntriesRequest)(nil),                 // 67: obiente.cloud.gameservers.v1.DeleteGameServerEntriesRequest
+	(*DeleteGameServerEntriesError)(nil),                   // 68: obiente.cloud.gameservers.v1.DeleteGameServerEntriesError
+	(*DeleteGameServerEntriesResponse)(nil),                // 69: obiente.cloud.gameservers.v1.DeleteGameServerEntriesResponse
+	(*RenameGameServerEntryRequest)(nil),                   // 70: obiente.cloud.gameservers.v1.RenameGameServerEntryRequest
+	(*RenameGameServerEntryResponse)(nil),                  // 71: obiente.cloud.gameservers.v1.RenameGameServerEntryResponse
+	(*CreateGameServerEntryRequest)(nil),                   // 72: obiente.cloud.gameservers.v1.CreateGameServerEntryRequest
+	(*CreateGameServerEntryResponse)(nil),                  // 73: obiente.cloud.gameservers.v1.CreateGameServerEntryResponse
+	(*WriteGameServerFileRequest)(nil),                     // 74: obiente.cloud.gameservers.v1.WriteGameServerFileRequest
+	(*WriteGameServerFileResponse)(nil),                    // 75: obiente.cloud.gameservers.v1.WriteGameServerFileResponse
+	(*ExtractGameServerFileRequest)(nil),                   // 76: obiente.cloud.gameservers.v1.ExtractGameServerFileRequest
+	(*ExtractGameServerFileResponse)(nil),                  // 77: obiente.cloud.gameservers.v1.ExtractGameServerFileResponse
+	(*CreateGameServerFileArchiveRequest)(nil),             // 78: obiente.cloud.gameservers.v1.CreateGameServerFileArchiveRequest
+	(*CreateGameServerFileArchiveResponse)(nil),            // 79: obiente.cloud.gameservers.v1.CreateGameServerFileArchiveResponse
+	(*GameServerFileTransferCredential)(nil),               // 80: obiente.cloud.gameservers.v1.GameServerFileTransferCredential
+	(*GameServerAuthorizedKey)(nil),                        // 81: obiente.cloud.gameservers.v1.GameServerAuthorizedKey
+	(*GameServerFileTransferConnectionInfo)(nil),           // 82: obiente.cloud.gameservers.v1.GameServerFileTransferConnectionInfo
+	(*ListGameServerFileTransferCredentialsRequest)(nil),   // 83: obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsRequest
+	(*ListGameServerFileTransferCredentialsResponse)(nil),  // 84: obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsResponse
+	(*CreateGameServerFileTransferCredentialRequest)(nil),  // 85: obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialRequest
+	(*CreateGameServerFileTransferCredentialResponse)(nil), // 86: obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialResponse
+	(*RevokeGameServerFileTransferCredentialRequest)(nil),  // 87: obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialRequest
+	(*RevokeGameServerFileTransferCredentialResponse)(nil), // 88: obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialResponse
+	(*AddAuthorizedKeyRequest)(nil),                        // 89: obiente.cloud.gameservers.v1.AddAuthorizedKeyRequest
+	(*AddAuthorizedKeyResponse)(nil),                       // 90: obiente.cloud.gameservers.v1.AddAuthorizedKeyResponse
+	(*RemoveAuthorizedKeyRequest)(nil),                     // 91: obiente.cloud.gameservers.v1.RemoveAuthorizedKeyRequest
+	(*RemoveAuthorizedKeyResponse)(nil),                    // 92: obiente.cloud.gameservers.v1.RemoveAuthorizedKeyResponse
+	(*GetMinecraftPlayerUUIDRequest)(nil),                  // 93: obiente.cloud.gameservers.v1.GetMinecraftPlayerUUIDRequest
+	(*GetMinecraftPlayerUUIDResponse)(nil),                 // 94: obiente.cloud.gameservers.v1.GetMinecraftPlayerUUIDResponse
+	(*GetMinecraftPlayerProfileRequest)(nil),               // 95: obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileRequest
+	(*GetMinecraftPlayerProfileResponse)(nil),              // 96: obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileResponse
+	(*MinecraftProject)(nil),                               // 97: obiente.cloud.gameservers.v1.MinecraftProject
+	(*ListMinecraftProjectsRequest)(nil),                   // 98: obiente.cloud.gameservers.v1.ListMinecraftProjectsRequest
+	(*ListMinecraftProjectsResponse)(nil),                  // 99: obiente.cloud.gameservers.v1.ListMinecraftProjectsResponse
+	(*InstalledMinecraftProjectFile)(nil),                  // 100: obiente.cloud.gameservers.v1.InstalledMinecraftProjectFile
+	(*ListInstalledMinecraftProjectsRequest)(nil),          // 101: obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsRequest
+	(*ListInstalledMinecraftProjectsResponse)(nil),         // 102: obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsResponse
+	(*MinecraftProjectFile)(nil),                           // 103: obiente.cloud.gameservers.v1.MinecraftProjectFile
+	(*MinecraftProjectVersion)(nil),                        // 104: obiente.cloud.gameservers.v1.MinecraftProjectVersion
+	(*GetMinecraftProjectVersionsRequest)(nil),             // 105: obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsRequest
+	(*GetMinecraftProjectVersionsResponse)(nil),            // 106: obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsResponse
+	(*GetMinecraftProjectRequest)(nil),                     // 107: obiente.cloud.gameservers.v1.GetMinecraftProjectRequest
+	(*GetMinecraftProjectResponse)(nil),                    // 108: obiente.cloud.gameservers.v1.GetMinecraftProjectResponse
+	(*InstallMinecraftProjectFileRequest)(nil),             // 109: obiente.cloud.gameservers.v1.InstallMinecraftProjectFileRequest
+	(*InstallMinecraftProjectFileResponse)(nil),            // 110: obiente.cloud.gameservers.v1.InstallMinecraftProjectFileResponse
+	(*UpdateMinecraftProjectFileRequest)(nil),              // 111: obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileRequest
+	(*UpdateMinecraftProjectFileResponse)(nil),             // 112: obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileResponse
+	(*GameServerMod)(nil),                                  // 113: obiente.cloud.gameservers.v1.GameServerMod
+	(*InstallGameServerModRequest)(nil),                    // 114: obiente.cloud.gameservers.v1.InstallGameServerModRequest
+	(*InstallGameServerModResponse)(nil),                   // 115: obiente.cloud.gameservers.v1.InstallGameServerModResponse
+	(*UninstallGameServerModRequest)(nil),                  // 116: obiente.cloud.gameservers.v1.UninstallGameServerModRequest
+	(*UninstallGameServerModResponse)(nil),                 // 117: obiente.cloud.gameservers.v1.UninstallGameServerModResponse
+	(*ListGameServerModsRequest)(nil),                      // 118: obiente.cloud.gameservers.v1.ListGameServerModsRequest
+	(*ListGameServerModsResponse)(nil),                     // 119: obiente.cloud.gameservers.v1.ListGameServerModsResponse
+	(*GameServerBackup)(nil),                               // 120: obiente.cloud.gameservers.v1.GameServerBackup
+	(*ScheduleGameServerBackupRequest)(nil),                // 121: obiente.cloud.gameservers.v1.ScheduleGameServerBackupRequest
+	(*ScheduleGameServerBackupResponse)(nil),               // 122: obiente.cloud.gameservers.v1.ScheduleGameServerBackupResponse
+	(*ListGameServerBackupsRequest)(nil),                   // 123: obiente.cloud.gameservers.v1.ListGameServerBackupsRequest
+	(*ListGameServerBackupsResponse)(nil),                  // 124: obiente.cloud.gameservers.v1.ListGameServerBackupsResponse
+	(*RestoreGameServerBackupRequest)(nil),                 // 125: obiente.cloud.gameservers.v1.RestoreGameServerBackupRequest
+	(*RestoreGameServerBackupResponse)(nil),                // 126: obiente.cloud.gameservers.v1.RestoreGameServerBackupResponse
+	(*DiscordIntegration)(nil),                             // 127: obiente.cloud.gameservers.v1.DiscordIntegration
+	(*SetDiscordIntegrationRequest)(nil),                   // 128: obiente.cloud.gameservers.v1.SetDiscordIntegrationRequest
+	(*SetDiscordIntegrationResponse)(nil),                  // 129: obiente.cloud.gameservers.v1.SetDiscordIntegrationResponse
+	(*DeleteDiscordIntegrationRequest)(nil),                // 130: obiente.cloud.gameservers.v1.DeleteDiscordIntegrationRequest
+	(*DeleteDiscordIntegrationResponse)(nil),               // 131: obiente.cloud.gameservers.v1.DeleteDiscordIntegrationResponse
+	(*IdleShutdownPolicy)(nil),                             // 132: obiente.cloud.gameservers.v1.IdleShutdownPolicy
+	(*SetIdleShutdownPolicyRequest)(nil),                   // 133: obiente.cloud.gameservers.v1.SetIdleShutdownPolicyRequest
+	(*SetIdleShutdownPolicyResponse)(nil),                  // 134: obiente.cloud.gameservers.v1.SetIdleShutdownPolicyResponse
+	(*GetIdleShutdownPolicyRequest)(nil),                   // 135: obiente.cloud.gameservers.v1.GetIdleShutdownPolicyRequest
+	(*GetIdleShutdownPolicyResponse)(nil),                  // 136: obiente.cloud.gameservers.v1.GetIdleShutdownPolicyResponse
+	nil,                                                    // 137: obiente.cloud.gameservers.v1.ListGameServersRequest.TagsEntry
+	nil,                                                    // 138: obiente.cloud.gameservers.v1.CreateGameServerRequest.EnvVarsEntry
+	nil,                                                    // 139: obiente.cloud.gameservers.v1.UpdateGameServerRequest.EnvVarsEntry
+	nil,                                                    // 140: obiente.cloud.gameservers.v1.GameServer.EnvVarsEntry
+	nil,                                                    // 141: obiente.cloud.gameservers.v1.MinecraftProjectFile.HashesEntry
+	(*timestamppb.Timestamp)(nil),                          // 142: google.protobuf.Timestamp
+	(v1.LogLevel)(0),                                       // 143: obiente.cloud.common.v1.LogLevel
+	(*v1.ChunkedUploadPayload)(nil),                        // 144: obiente.cloud.common.v1.ChunkedUploadPayload
+	(*v1.ChunkedUploadResponsePayload)(nil),                // 145: obiente.cloud.common.v1.ChunkedUploadResponsePayload
+	(*v1.CreateServerFileArchiveRequest)(nil),              // 146: obiente.cloud.common.v1.CreateServerFileArchiveRequest
+	(*v1.CreateServerFileArchiveResponse)(nil),             // 147: obiente.cloud.common.v1.CreateServerFileArchiveResponse
 }
 var file_obiente_cloud_gameservers_v1_game_server_service_proto_depIdxs = []int32{
 	1,   // 0: obiente.cloud.gameservers.v1.ListGameServersRequest.status:type_name -> obiente.cloud.gameservers.v1.GameServerStatus
-	44,  // 1: obiente.cloud.gameservers.v1.ListGameServersResponse.game_servers:type_name -> obiente.cloud.gameservers.v1.GameServer
-	0,   // 2: obiente.cloud.gameservers.v1.CreateGameServerRequest.game_type:type_name -> obiente.cloud.gameservers.v1.GameType
-	100, // 3: obiente.cloud.gameservers.v1.CreateGameServerRequest.env_vars:type_name -> obiente.cloud.gameservers.v1.CreateGameServerRequest.EnvVarsEntry
-	44,  // 4: obiente.cloud.gameservers.v1.CreateGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
-	44,  // 5: obiente.cloud.gameservers.v1.GetGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
-	101, // 6: obiente.cloud.gameservers.v1.UpdateGameServerRequest.env_vars:type_name -> obiente.cloud.gameservers.v1.UpdateGameServerRequest.EnvVarsEntry
-	44,  // 7: obiente.cloud.gameservers.v1.UpdateGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
-	44,  // 8: obiente.cloud.gameservers.v1.StartGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
-	44,  // 9: obiente.cloud.gameservers.v1.StopGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
-	44,  // 10: obiente.cloud.gameservers.v1.RestartGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
-	20,  // 11: obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesResponse.routes:type_name -> obiente.cloud.gameservers.v1.GameServerHTTPRoute
-	20,  // 12: obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteResponse.route:type_name -> obiente.cloud.gameservers.v1.GameServerHTTPRoute
-	1,   // 13: obiente.cloud.gameservers.v1.GameServerStatusUpdate.status:type_name -> obiente.cloud.gameservers.v1.GameServerStatus
-	104, // 14: obiente.cloud.gameservers.v1.GameServerStatusUpdate.timestamp:type_name -> google.protobuf.Timestamp
-	104, // 15: obiente.cloud.gameservers.v1.GetGameServerLogsRequest.since:type_name -> google.protobuf.Timestamp
-	104, // 16: obiente.cloud.gameservers.v1.GetGameServerLogsRequest.until:type_name -> google.protobuf.Timestamp
-	36,  // 17: obiente.cloud.gameservers.v1.GetGameServerLogsResponse.lines:type_name -> obiente.cloud.gameservers.v1.GameServerLogLine
-	104, // 18: obiente.cloud.gameservers.v1.StreamGameServerLogsRequest.since:type_name -> google.protobuf.Timestamp
-	104, // 19: obiente.cloud.gameservers.v1.StreamGameServerLogsRequest.until:type_name -> google.protobuf.Timestamp
-	104, // 20: obiente.cloud.gameservers.v1.GameServerLogLine.timestamp:type_name -> google.protobuf.Timestamp
-	105, // 21: obiente.cloud.gameservers.v1.GameServerLogLine.level:type_name -> obiente.cloud.common.v1.LogLevel
-	104, // 22: obiente.cloud.gameservers.v1.GetGameServerMetricsRequest.start_time:type_name -> google.protobuf.Timestamp
-	104, // 23: obiente.cloud.gameservers.v1.GetGameServerMetricsRequest.end_time:type_name -> google.protobuf.Timestamp
-	40,  // 24: obiente.cloud.gameservers.v1.GetGameServerMetricsResponse.metrics:type_name -> obiente.cloud.gameservers.v1.GameServerMetric
-	104, // 25: obiente.cloud.gameservers.v1.GameServerMetric.timestamp:type_name -> google.protobuf.Timestamp
-	43,  // 26: obiente.cloud.gameservers.v1.GetGameServerUsageResponse.current:type_name -> obiente.cloud.gameservers.v1.GameServerUsageMetrics
-	43,  // 27: obiente.cloud.gameservers.v1.GetGameServerUsageResponse.estimated_monthly:type_name -> obiente.cloud.gameservers.v1.GameServerUsageMetrics
-	0,   // 28: obiente.cloud.gameservers.v1.GameServer.game_type:type_name -> obiente.cloud.gameservers.v1.GameType
-	1,   // 29: obiente.cloud.gameservers.v1.GameServer.status:type_name -> obiente.cloud.gameservers.v1.GameServerStatus
-	102, // 30: obiente.cloud.gameservers.v1.GameServer.env_vars:type_name -> obiente.cloud.gameservers.v1.GameServer.EnvVarsEntry
-	104, // 31: obiente.cloud.gameservers.v1.GameServer.created_at:type_name -> google.protobuf.Timestamp
-	104, // 32: obiente.cloud.gameservers.v1.GameServer.updated_at:type_name -> google.protobuf.Timestamp
-	104, // 33: obiente.cloud.gameservers.v1.GameServer.last_started_at:type_name -> google.protobuf.Timestamp
-	104, // 34: obiente.cloud.gameservers.v1.GameServerFile.modified_time:type_name -> google.protobuf.Timestamp
-	104, // 35: obiente.cloud.gameservers.v1.GameServerFile.created_time:type_name -> google.protobuf.Timestamp
-	45,  // 36: obiente.cloud.gameservers.v1.ListGameServerFilesResponse.files:type_name -> obiente.cloud.gameservers.v1.GameServerFile
-	46,  // 37: obiente.cloud.gameservers.v1.ListGameServerFilesResponse.volumes:type_name -> obiente.cloud.gameservers.v1.GameServerVolumeInfo
-	45,  // 38: obiente.cloud.gameservers.v1.SearchGameServerFilesResponse.results:type_name -> obiente.cloud.gameservers.v1.GameServerFile
-	45,  // 39: obiente.cloud.gameservers.v1.GetGameServerFileResponse.metadata:type_name -> obiente.cloud.gameservers.v1.GameServerFile
-	54,  // 40: obiente.cloud.gameservers.v1.UploadGameServerFilesRequest.metadata:type_name -> obiente.cloud.gameservers.v1.UploadGameServerFilesMetadata
-	55,  // 41: obiente.cloud.gameservers.v1.UploadGameServerFilesMetadata.files:type_name -> obiente.cloud.gameservers.v1.GameServerFileMetadata
-	106, // 42: obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesRequest.upload:type_name -> obiente.cloud.common.v1.ChunkedUploadPayload
-	107, // 43: obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesResponse.result:type_name -> obiente.cloud.common.v1.ChunkedUploadResponsePayload
-	60,  // 44: obiente.cloud.gameservers.v1.DeleteGameServerEntriesResponse.errors:type_name -> obiente.cloud.gameservers.v1.DeleteGameServerEntriesError
-	45,  // 45: obiente.cloud.gameservers.v1.RenameGameServerEntryResponse.entry:type_name -> obiente.cloud.gameservers.v1.GameServerFile
-	2,   // 46: obiente.cloud.gameservers.v1.CreateGameServerEntryRequest.type:type_name -> obiente.cloud.gameservers.v1.GameServerEntryType
-	45,  // 47: obiente.cloud.gameservers.v1.CreateGameServerEntryResponse.entry:type_name -> obiente.cloud.gameservers.v1.GameServerFile
-	45,  // 48: obiente.cloud.gameservers.v1.WriteGameServerFileResponse.entry:type_name -> obiente.cloud.gameservers.v1.GameServerFile
-	108, // 49: obiente.cloud.gameservers.v1.CreateGameServerFileArchiveRequest.archive_request:type_name -> obiente.cloud.common.v1.CreateServerFileArchiveRequest
-	109, // 50: obiente.cloud.gameservers.v1.CreateGameServerFileArchiveResponse.archive_response:type_name -> obiente.cloud.common.v1.CreateServerFileArchiveResponse
-	104, // 51: obiente.cloud.gameservers.v1.GameServerFileTransferCredential.last_used_at:type_name -> google.protobuf.Timestamp
-	104, // 52: obiente.cloud.gameservers.v1.GameServerFileTransferCredential.expires_at:type_name -> google.protobuf.Timestamp
-	104, // 53: obiente.cloud.gameservers.v1.GameServerFileTransferCredential.created_at:type_name -> google.protobuf.Timestamp
-	72,  // 54: obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsResponse.credentials:type_name -> obiente.cloud.gameservers.v1.GameServerFileTransferCredential
-	73,  // 55: obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsResponse.connection:type_name -> obiente.cloud.gameservers.v1.GameServerFileTransferConnectionInfo
-	104, // 56: obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialRequest.expires_at:type_name -> google.protobuf.Timestamp
-	72,  // 57: obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialResponse.credential:type_name -> obiente.cloud.gameservers.v1.GameServerFileTransferCredential
-	73,  // 58: obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialResponse.connection:type_name -> obiente.cloud.gameservers.v1.GameServerFileTransferConnectionInfo
-	3,   // 59: obiente.cloud.gameservers.v1.MinecraftProject.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
-	3,   // 60: obiente.cloud.gameservers.v1.ListMinecraftProjectsRequest.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
-	84,  // 61: obiente.cloud.gameservers.v1.ListMinecraftProjectsResponse.projects:type_name -> obiente.cloud.gameservers.v1.MinecraftProject
-	3,   // 62: obiente.cloud.gameservers.v1.InstalledMinecraftProjectFile.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
-	104, // 63: obiente.cloud.gameservers.v1.InstalledMinecraftProjectFile.modified_at:type_name -> google.protobuf.Timestamp
-	104, // 64: obiente.cloud.gameservers.v1.InstalledMinecraftProjectFile.installed_at:type_name -> google.protobuf.Timestamp
-	3,   // 65: obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsRequest.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
-	87,  // 66: obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsResponse.files:type_name -> obiente.cloud.gameservers.v1.InstalledMinecraftProjectFile
-	103, // 67: obiente.cloud.gameservers.v1.MinecraftProjectFile.hashes:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectFile.HashesEntry
-	104, // 68: obiente.cloud.gameservers.v1.MinecraftProjectVersion.published_at:type_name -> google.protobuf.Timestamp
-	90,  // 69: obiente.cloud.gameservers.v1.MinecraftProjectVersion.files:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectFile
-	3,   // 70: obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsRequest.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
-	91,  // 71: obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsResponse.versions:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectVersion
-	84,  // 72: obiente.cloud.gameservers.v1.GetMinecraftProjectResponse.project:type_name -> obiente.cloud.gameservers.v1.MinecraftProject
-	3,   // 73: obiente.cloud.gameservers.v1.InstallMinecraftProjectFileRequest.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
-	3,   // 74: obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileRequest.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
-	4,   // 75: obiente.cloud.gameservers.v1.GameServerService.ListGameServers:input_type -> obiente.cloud.gameservers.v1.ListGameServersRequest
-	6,   // 76: obiente.cloud.gameservers.v1.GameServerService.CreateGameServer:input_type -> obiente.cloud.gameservers.v1.CreateGameServerRequest
-	8,   // 77: obiente.cloud.gameservers.v1.GameServerService.GetGameServer:input_type -> obiente.cloud.gameservers.v1.GetGameServerRequest
-	10,  // 78: obiente.cloud.gameservers.v1.GameServerService.UpdateGameServer:input_type -> obiente.cloud.gameservers.v1.UpdateGameServerRequest
-	12,  // 79: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServer:input_type -> obiente.cloud.gameservers.v1.DeleteGameServerRequest
-	14,  // 80: obiente.cloud.gameservers.v1.GameServerService.StartGameServer:input_type -> obiente.cloud.gameservers.v1.StartGameServerRequest
-	16,  // 81: obiente.cloud.gameservers.v1.GameServerService.StopGameServer:input_type -> obiente.cloud.gameservers.v1.StopGameServerRequest
-	18,  // 82: obiente.cloud.gameservers.v1.GameServerService.RestartGameServer:input_type -> obiente.cloud.gameservers.v1.RestartGameServerRequest
-	21,  // 83: obiente.cloud.gameservers.v1.GameServerService.GetGameServerHTTPRoutes:input_type -> obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesRequest
-	23,  // 84: obiente.cloud.gameservers.v1.GameServerService.UpsertGameServerHTTPRoute:input_type -> obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteRequest
-	25,  // 85: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServerHTTPRoute:input_type -> obiente.cloud.gameservers.v1.DeleteGameServerHTTPRouteRequest
-	27,  // 86: obiente.cloud.gameservers.v1.GameServerService.GetGameServerDomainVerificationToken:input_type -> obiente.cloud.gameservers.v1.GetGameServerDomainVerificationTokenRequest
-	29,  // 87: obiente.cloud.gameservers.v1.GameServerService.VerifyGameServerDomain:input_type -> obiente.cloud.gameservers.v1.VerifyGameServerDomainRequest
-	31,  // 88: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerStatus:input_type -> obiente.cloud.gameservers.v1.StreamGameServerStatusRequest
-	33,  // 89: obiente.cloud.gameservers.v1.GameServerService.GetGameServerLogs:input_type -> obiente.cloud.gameservers.v1.GetGameServerLogsRequest
-	35,  // 90: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerLogs:input_type -> obiente.cloud.gameservers.v1.StreamGameServerLogsRequest
-	37,  // 91: obiente.cloud.gameservers.v1.GameServerService.GetGameServerMetrics:input_type -> obiente.cloud.gameservers.v1.GetGameServerMetricsRequest
-	39,  // 92: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerMetrics:input_type -> obiente.cloud.gameservers.v1.StreamGameServerMetricsRequest
-	41,  // 93: obiente.cloud.gameservers.v1.GameServerService.GetGameServerUsage:input_type -> obiente.cloud.gameservers.v1.GetGameServerUsageRequest
-	47,  // 94: obiente.cloud.gameservers.v1.GameServerService.ListGameServerFiles:input_type -> obiente.cloud.gameservers.v1.ListGameServerFilesRequest
-	49,  // 95: obiente.cloud.gameservers.v1.GameServerService.SearchGameServerFiles:input_type -> obiente.cloud.gameservers.v1.SearchGameServerFilesRequest
-	51,  // 96: obiente.cloud.gameservers.v1.GameServerService.GetGameServerFile:input_type -> obiente.cloud.gameservers.v1.GetGameServerFileRequest
-	53,  // 97: obiente.cloud.gameservers.v1.GameServerService.UploadGameServerFiles:input_type -> obiente.cloud.gameservers.v1.UploadGameServerFilesRequest
-	57,  // 98: obiente.cloud.gameservers.v1.GameServerService.ChunkUploadGameServerFiles:input_type -> obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesRequest
-	59,  // 99: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServerEntries:input_type -> obiente.cloud.gameservers.v1.DeleteGameServerEntriesRequest
-	64,  // 100: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerEntry:input_type -> obiente.cloud.gameservers.v1.CreateGameServerEntryRequest
-	66,  // 101: obiente.cloud.gameservers.v1.GameServerService.WriteGameServerFile:input_type -> obiente.cloud.gameservers.v1.WriteGameServerFileRequest
-	62,  // 102: obiente.cloud.gameservers.v1.GameServerService.RenameGameServerEntry:input_type -> obiente.cloud.gameservers.v1.RenameGameServerEntryRequest
-	68,  // 103: obiente.cloud.gameservers.v1.GameServerService.ExtractGameServerFile:input_type -> obiente.cloud.gameservers.v1.ExtractGameServerFileRequest
-	70,  // 104: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerFileArchive:input_type -> obiente.cloud.gameservers.v1.CreateGameServerFileArchiveRequest
-	74,  // 105: obiente.cloud.gameservers.v1.GameServerService.ListGameServerFileTransferCredentials:input_type -> obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsRequest
-	76,  // 106: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerFileTransferCredential:input_type -> obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialRequest
-	78,  // 107: obiente.cloud.gameservers.v1.GameServerService.RevokeGameServerFileTransferCredential:input_type -> obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialRequest
-	80,  // 108: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftPlayerUUID:input_type -> obiente.cloud.gameservers.v1.GetMinecraftPlayerUUIDRequest
-	82,  // 109: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftPlayerProfile:input_type -> obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileRequest
-	85,  // 110: obiente.cloud.gameservers.v1.GameServerService.ListMinecraftProjects:input_type -> obiente.cloud.gameservers.v1.ListMinecraftProjectsRequest
-	88,  // 111: obiente.cloud.gameservers.v1.GameServerService.ListInstalledMinecraftProjects:input_type -> obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsRequest
-	92,  // 112: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftProjectVersions:input_type -> obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsRequest
-	94,  // 113: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftProject:input_type -> obiente.cloud.gameservers.v1.GetMinecraftProjectRequest
-	96,  // 114: obiente.cloud.gameservers.v1.GameServerService.InstallMinecraftProjectFile:input_type -> obiente.cloud.gameservers.v1.InstallMinecraftProjectFileRequest
-	98,  // 115: obiente.cloud.gameservers.v1.GameServerService.UpdateMinecraftProjectFile:input_type -> obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileRequest
-	5,   // 116: obiente.cloud.gameservers.v1.GameServerService.ListGameServers:output_type -> obiente.cloud.gameservers.v1.ListGameServersResponse
-	7,   // 117: obiente.cloud.gameservers.v1.GameServerService.CreateGameServer:output_type -> obiente.cloud.gameservers.v1.CreateGameServerResponse
-	9,   // 118: obiente.cloud.gameservers.v1.GameServerService.GetGameServer:output_type -> obiente.cloud.gameservers.v1.GetGameServerResponse
-	11,  // 119: obiente.cloud.gameservers.v1.GameServerService.UpdateGameServer:output_type -> obiente.cloud.gameservers.v1.UpdateGameServerResponse
-	13,  // 120: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServer:output_type -> obiente.cloud.gameservers.v1.DeleteGameServerResponse
-	15,  // 121: obiente.cloud.gameservers.v1.GameServerService.StartGameServer:output_type -> obiente.cloud.gameservers.v1.StartGameServerResponse
-	17,  // 122: obiente.cloud.gameservers.v1.GameServerService.StopGameServer:output_type -> obiente.cloud.gameservers.v1.StopGameServerResponse
-	19,  // 123: obiente.cloud.gameservers.v1.GameServerService.RestartGameServer:output_type -> obiente.cloud.gameservers.v1.RestartGameServerResponse
-	22,  // 124: obiente.cloud.gameservers.v1.GameServerService.GetGameServerHTTPRoutes:output_type -> obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesResponse
-	24,  // 125: obiente.cloud.gameservers.v1.GameServerService.UpsertGameServerHTTPRoute:output_type -> obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteResponse
-	26,  // 126: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServerHTTPRoute:output_type -> obiente.cloud.gameservers.v1.DeleteGameServerHTTPRouteResponse
-	28,  // 127: obiente.cloud.gameservers.v1.GameServerService.GetGameServerDomainVerificationToken:output_type -> obiente.cloud.gameservers.v1.GetGameServerDomainVerificationTokenResponse
-	30,  // 128: obiente.cloud.gameservers.v1.GameServerService.VerifyGameServerDomain:output_type -> obiente.cloud.gameservers.v1.VerifyGameServerDomainResponse
-	32,  // 129: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerStatus:output_type -> obiente.cloud.gameservers.v1.GameServerStatusUpdate
-	34,  // 130: obiente.cloud.gameservers.v1.GameServerService.GetGameServerLogs:output_type -> obiente.cloud.gameservers.v1.GetGameServerLogsResponse
-	36,  // 131: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerLogs:output_type -> obiente.cloud.gameservers.v1.GameServerLogLine
-	38,  // 132: obiente.cloud.gameservers.v1.GameServerService.GetGameServerMetrics:output_type -> obiente.cloud.gameservers.v1.GetGameServerMetricsResponse
-	40,  // 133: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerMetrics:output_type -> obiente.cloud.gameservers.v1.GameServerMetric
-	42,  // 134: obiente.cloud.gameservers.v1.GameServerService.GetGameServerUsage:output_type -> obiente.cloud.gameservers.v1.GetGameServerUsageResponse
-	48,  // 135: obiente.cloud.gameservers.v1.GameServerService.ListGameServerFiles:output_type -> obiente.cloud.gameservers.v1.ListGameServerFilesResponse
-	50,  // 136: obiente.cloud.gameservers.v1.GameServerService.SearchGameServerFiles:output_type -> obiente.cloud.gameservers.v1.SearchGameServerFilesResponse
-	52,  // 137: obiente.cloud.gameservers.v1.GameServerService.GetGameServerFile:output_type -> obiente.cloud.gameservers.v1.GetGameServerFileResponse
-	56,  // 138: obiente.cloud.gameservers.v1.GameServerService.UploadGameServerFiles:output_type -> obiente.cloud.gameservers.v1.UploadGameServerFilesResponse
-	58,  // 139: obiente.cloud.gameservers.v1.GameServerService.ChunkUploadGameServerFiles:output_type -> obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesResponse
-	61,  // 140: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServerEntries:output_type -> obiente.cloud.gameservers.v1.DeleteGameServerEntriesResponse
-	65,  // 141: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerEntry:output_type -> obiente.cloud.gameservers.v1.CreateGameServerEntryResponse
-	67,  // 142: obiente.cloud.gameservers.v1.GameServerService.WriteGameServerFile:output_type -> obiente.cloud.gameservers.v1.WriteGameServerFileResponse
-	63,  // 143: obiente.cloud.gameservers.v1.GameServerService.RenameGameServerEntry:output_type -> obiente.cloud.gameservers.v1.RenameGameServerEntryResponse
-	69,  // 144: obiente.cloud.gameservers.v1.GameServerService.ExtractGameServerFile:output_type -> obiente.cloud.gameservers.v1.ExtractGameServerFileResponse
-	71,  // 145: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerFileArchive:output_type -> obiente.cloud.gameservers.v1.CreateGameServerFileArchiveResponse
-	75,  // 146: obiente.cloud.gameservers.v1.GameServerService.ListGameServerFileTransferCredentials:output_type -> obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsResponse
-	77,  // 147: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerFileTransferCredential:output_type -> obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialResponse
-	79,  // 148: obiente.cloud.gameservers.v1.GameServerService.RevokeGameServerFileTransferCredential:output_type -> obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialResponse
-	81,  // 149: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftPlayerUUID:output_type -> obiente.cloud.gameservers.v1.GetMinecraftPlayerUUIDResponse
-	83,  // 150: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftPlayerProfile:output_type -> obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileResponse
-	86,  // 151: obiente.cloud.gameservers.v1.GameServerService.ListMinecraftProjects:output_type -> obiente.cloud.gameservers.v1.ListMinecraftProjectsResponse
-	89,  // 152: obiente.cloud.gameservers.v1.GameServerService.ListInstalledMinecraftProjects:output_type -> obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsResponse
-	93,  // 153: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftProjectVersions:output_type -> obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsResponse
-	95,  // 154: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftProject:output_type -> obiente.cloud.gameservers.v1.GetMinecraftProjectResponse
-	97,  // 155: obiente.cloud.gameservers.v1.GameServerService.InstallMinecraftProjectFile:output_type -> obiente.cloud.gameservers.v1.InstallMinecraftProjectFileResponse
-	99,  // 156: obiente.cloud.gameservers.v1.GameServerService.UpdateMinecraftProjectFile:output_type -> obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileResponse
-	116, // [116:157] is the sub-list for method output_type
-	75,  // [75:116] is the sub-list for method input_type
-	75,  // [75:75] is the sub-list for extension type_name
-	75,  // [75:75] is the sub-list for extension extendee
-	0,   // [0:75] is the sub-list for field type_name
+	137, // 1: obiente.cloud.gameservers.v1.ListGameServersRequest.tags:type_name -> obiente.cloud.gameservers.v1.ListGameServersRequest.TagsEntry
+	52,  // 2: obiente.cloud.gameservers.v1.ListGameServersResponse.game_servers:type_name -> obiente.cloud.gameservers.v1.GameServer
+	0,   // 3: obiente.cloud.gameservers.v1.CreateGameServerRequest.game_type:type_name -> obiente.cloud.gameservers.v1.GameType
+	138, // 4: obiente.cloud.gameservers.v1.CreateGameServerRequest.env_vars:type_name -> obiente.cloud.gameservers.v1.CreateGameServerRequest.EnvVarsEntry
+	52,  // 5: obiente.cloud.gameservers.v1.CreateGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
+	52,  // 6: obiente.cloud.gameservers.v1.GetGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
+	139, // 7: obiente.cloud.gameservers.v1.UpdateGameServerRequest.env_vars:type_name -> obiente.cloud.gameservers.v1.UpdateGameServerRequest.EnvVarsEntry
+	52,  // 8: obiente.cloud.gameservers.v1.UpdateGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
+	52,  // 9: obiente.cloud.gameservers.v1.StartGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
+	52,  // 10: obiente.cloud.gameservers.v1.StopGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
+	52,  // 11: obiente.cloud.gameservers.v1.RestartGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
+	2,   // 12: obiente.cloud.gameservers.v1.ManagePlayerWhitelistRequest.action:type_name -> obiente.cloud.gameservers.v1.PlayerListAction
+	23,  // 13: obiente.cloud.gameservers.v1.ManagePlayerWhitelistResponse.players:type_name -> obiente.cloud.gameservers.v1.PlayerListEntry
+	2,   // 14: obiente.cloud.gameservers.v1.ManagePlayerBanListRequest.action:type_name -> obiente.cloud.gameservers.v1.PlayerListAction
+	23,  // 15: obiente.cloud.gameservers.v1.ManagePlayerBanListResponse.players:type_name -> obiente.cloud.gameservers.v1.PlayerListEntry
+	28,  // 16: obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesResponse.routes:type_name -> obiente.cloud.gameservers.v1.GameServerHTTPRoute
+	28,  // 17: obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteResponse.route:type_name -> obiente.cloud.gameservers.v1.GameServerHTTPRoute
+	1,   // 18: obiente.cloud.gameservers.v1.GameServerStatusUpdate.status:type_name -> obiente.cloud.gameservers.v1.GameServerStatus
+	142, // 19: obiente.cloud.gameservers.v1.GameServerStatusUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	142, // 20: obiente.cloud.gameservers.v1.GetGameServerLogsRequest.since:type_name -> google.protobuf.Timestamp
+	142, // 21: obiente.cloud.gameservers.v1.GetGameServerLogsRequest.until:type_name -> google.protobuf.Timestamp
+	44,  // 22: obiente.cloud.gameservers.v1.GetGameServerLogsResponse.lines:type_name -> obiente.cloud.gameservers.v1.GameServerLogLine
+	142, // 23: obiente.cloud.gameservers.v1.StreamGameServerLogsRequest.since:type_name -> google.protobuf.Timestamp
+	142, // 24: obiente.cloud.gameservers.v1.StreamGameServerLogsRequest.until:type_name -> google.protobuf.Timestamp
+	142, // 25: obiente.cloud.gameservers.v1.GameServerLogLine.timestamp:type_name -> google.protobuf.Timestamp
+	143, // 26: obiente.cloud.gameservers.v1.GameServerLogLine.level:type_name -> obiente.cloud.common.v1.LogLevel
+	142, // 27: obiente.cloud.gameservers.v1.GetGameServerMetricsRequest.start_time:type_name -> google.protobuf.Timestamp
+	142, // 28: obiente.cloud.gameservers.v1.GetGameServerMetricsRequest.end_time:type_name -> google.protobuf.Timestamp
+	48,  // 29: obiente.cloud.gameservers.v1.GetGameServerMetricsResponse.metrics:type_name -> obiente.cloud.gameservers.v1.GameServerMetric
+	142, // 30: obiente.cloud.gameservers.v1.GameServerMetric.timestamp:type_name -> google.protobuf.Timestamp
+	51,  // 31: obiente.cloud.gameservers.v1.GetGameServerUsageResponse.current:type_name -> obiente.cloud.gameservers.v1.GameServerUsageMetrics
+	51,  // 32: obiente.cloud.gameservers.v1.GetGameServerUsageResponse.estimated_monthly:type_name -> obiente.cloud.gameservers.v1.GameServerUsageMetrics
+	0,   // 33: obiente.cloud.gameservers.v1.GameServer.game_type:type_name -> obiente.cloud.gameservers.v1.GameType
+	1,   // 34: obiente.cloud.gameservers.v1.GameServer.status:type_name -> obiente.cloud.gameservers.v1.GameServerStatus
+	140, // 35: obiente.cloud.gameservers.v1.GameServer.env_vars:type_name -> obiente.cloud.gameservers.v1.GameServer.EnvVarsEntry
+	142, // 36: obiente.cloud.gameservers.v1.GameServer.created_at:type_name -> google.protobuf.Timestamp
+	142, // 37: obiente.cloud.gameservers.v1.GameServer.updated_at:type_name -> google.protobuf.Timestamp
+	142, // 38: obiente.cloud.gameservers.v1.GameServer.last_started_at:type_name -> google.protobuf.Timestamp
+	142, // 39: obiente.cloud.gameservers.v1.GameServerFile.modified_time:type_name -> google.protobuf.Timestamp
+	142, // 40: obiente.cloud.gameservers.v1.GameServerFile.created_time:type_name -> google.protobuf.Timestamp
+	53,  // 41: obiente.cloud.gameservers.v1.ListGameServerFilesResponse.files:type_name -> obiente.cloud.gameservers.v1.GameServerFile
+	54,  // 42: obiente.cloud.gameservers.v1.ListGameServerFilesResponse.volumes:type_name -> obiente.cloud.gameservers.v1.GameServerVolumeInfo
+	53,  // 43: obiente.cloud.gameservers.v1.SearchGameServerFilesResponse.results:type_name -> obiente.cloud.gameservers.v1.GameServerFile
+	53,  // 44: obiente.cloud.gameservers.v1.GetGameServerFileResponse.metadata:type_name -> obiente.cloud.gameservers.v1.GameServerFile
+	62,  // 45: obiente.cloud.gameservers.v1.UploadGameServerFilesRequest.metadata:type_name -> obiente.cloud.gameservers.v1.UploadGameServerFilesMetadata
+	63,  // 46: obiente.cloud.gameservers.v1.UploadGameServerFilesMetadata.files:type_name -> obiente.cloud.gameservers.v1.GameServerFileMetadata
+	144, // 47: obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesRequest.upload:type_name -> obiente.cloud.common.v1.ChunkedUploadPayload
+	145, // 48: obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesResponse.result:type_name -> obiente.cloud.common.v1.ChunkedUploadResponsePayload
+	68,  // 49: obiente.cloud.gameservers.v1.DeleteGameServerEntriesResponse.errors:type_name -> obiente.cloud.gameservers.v1.DeleteGameServerEntriesError
+	53,  // 50: obiente.cloud.gameservers.v1.RenameGameServerEntryResponse.entry:type_name -> obiente.cloud.gameservers.v1.GameServerFile
+	3,   // 51: obiente.cloud.gameservers.v1.CreateGameServerEntryRequest.type:type_name -> obiente.cloud.gameservers.v1.GameServerEntryType
+	53,  // 52: obiente.cloud.gameservers.v1.CreateGameServerEntryResponse.entry:type_name -> obiente.cloud.gameservers.v1.GameServerFile
+	53,  // 53: obiente.cloud.gameservers.v1.WriteGameServerFileResponse.entry:type_name -> obiente.cloud.gameservers.v1.GameServerFile
+	146, // 54: obiente.cloud.gameservers.v1.CreateGameServerFileArchiveRequest.archive_request:type_name -> obiente.cloud.common.v1.CreateServerFileArchiveRequest
+	147, // 55: obiente.cloud.gameservers.v1.CreateGameServerFileArchiveResponse.archive_response:type_name -> obiente.cloud.common.v1.CreateServerFileArchiveResponse
+	142, // 56: obiente.cloud.gameservers.v1.GameServerFileTransferCredential.last_used_at:type_name -> google.protobuf.Timestamp
+	142, // 57: obiente.cloud.gameservers.v1.GameServerFileTransferCredential.expires_at:type_name -> google.protobuf.Timestamp
+	142, // 58: obiente.cloud.gameservers.v1.GameServerFileTransferCredential.created_at:type_name -> google.protobuf.Timestamp
+	81,  // 59: obiente.cloud.gameservers.v1.GameServerFileTransferCredential.authorized_keys:type_name -> obiente.cloud.gameservers.v1.GameServerAuthorizedKey
+	80,  // 60: obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsResponse.credentials:type_name -> obiente.cloud.gameservers.v1.GameServerFileTransferCredential
+	82,  // 61: obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsResponse.connection:type_name -> obiente.cloud.gameservers.v1.GameServerFileTransferConnectionInfo
+	142, // 62: obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialRequest.expires_at:type_name -> google.protobuf.Timestamp
+	80,  // 63: obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialResponse.credential:type_name -> obiente.cloud.gameservers.v1.GameServerFileTransferCredential
+	82,  // 64: obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialResponse.connection:type_name -> obiente.cloud.gameservers.v1.GameServerFileTransferConnectionInfo
+	80,  // 65: obiente.cloud.gameservers.v1.AddAuthorizedKeyResponse.credential:type_name -> obiente.cloud.gameservers.v1.GameServerFileTransferCredential
+	80,  // 66: obiente.cloud.gameservers.v1.RemoveAuthorizedKeyResponse.credential:type_name -> obiente.cloud.gameservers.v1.GameServerFileTransferCredential
+	4,   // 67: obiente.cloud.gameservers.v1.MinecraftProject.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
+	4,   // 68: obiente.cloud.gameservers.v1.ListMinecraftProjectsRequest.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
+	97,  // 69: obiente.cloud.gameservers.v1.ListMinecraftProjectsResponse.projects:type_name -> obiente.cloud.gameservers.v1.MinecraftProject
+	4,   // 70: obiente.cloud.gameservers.v1.InstalledMinecraftProjectFile.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
+	142, // 71: obiente.cloud.gameservers.v1.InstalledMinecraftProjectFile.modified_at:type_name -> google.protobuf.Timestamp
+	142, // 72: obiente.cloud.gameservers.v1.InstalledMinecraftProjectFile.installed_at:type_name -> google.protobuf.Timestamp
+	4,   // 73: obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsRequest.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
+	100, // 74: obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsResponse.files:type_name -> obiente.cloud.gameservers.v1.InstalledMinecraftProjectFile
+	141, // 75: obiente.cloud.gameservers.v1.MinecraftProjectFile.hashes:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectFile.HashesEntry
+	142, // 76: obiente.cloud.gameservers.v1.MinecraftProjectVersion.published_at:type_name -> google.protobuf.Timestamp
+	103, // 77: obiente.cloud.gameservers.v1.MinecraftProjectVersion.files:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectFile
+	4,   // 78: obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsRequest.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
+	104, // 79: obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsResponse.versions:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectVersion
+	97,  // 80: obiente.cloud.gameservers.v1.GetMinecraftProjectResponse.project:type_name -> obiente.cloud.gameservers.v1.MinecraftProject
+	4,   // 81: obiente.cloud.gameservers.v1.InstallMinecraftProjectFileRequest.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
+	4,   // 82: obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileRequest.project_type:type_name -> obiente.cloud.gameservers.v1.MinecraftProjectType
+	142, // 83: obiente.cloud.gameservers.v1.GameServerMod.installed_at:type_name -> google.protobuf.Timestamp
+	113, // 84: obiente.cloud.gameservers.v1.InstallGameServerModResponse.mod:type_name -> obiente.cloud.gameservers.v1.GameServerMod
+	113, // 85: obiente.cloud.gameservers.v1.ListGameServerModsResponse.mods:type_name -> obiente.cloud.gameservers.v1.GameServerMod
+	142, // 86: obiente.cloud.gameservers.v1.GameServerBackup.created_at:type_name -> google.protobuf.Timestamp
+	142, // 87: obiente.cloud.gameservers.v1.GameServerBackup.completed_at:type_name -> google.protobuf.Timestamp
+	120, // 88: obiente.cloud.gameservers.v1.ListGameServerBackupsResponse.backups:type_name -> obiente.cloud.gameservers.v1.GameServerBackup
+	142, // 89: obiente.cloud.gameservers.v1.DiscordIntegration.created_at:type_name -> google.protobuf.Timestamp
+	142, // 90: obiente.cloud.gameservers.v1.DiscordIntegration.updated_at:type_name -> google.protobuf.Timestamp
+	127, // 91: obiente.cloud.gameservers.v1.SetDiscordIntegrationResponse.integration:type_name -> obiente.cloud.gameservers.v1.DiscordIntegration
+	142, // 92: obiente.cloud.gameservers.v1.IdleShutdownPolicy.last_player_seen_at:type_name -> google.protobuf.Timestamp
+	132, // 93: obiente.cloud.gameservers.v1.SetIdleShutdownPolicyResponse.policy:type_name -> obiente.cloud.gameservers.v1.IdleShutdownPolicy
+	132, // 94: obiente.cloud.gameservers.v1.GetIdleShutdownPolicyResponse.policy:type_name -> obiente.cloud.gameservers.v1.IdleShutdownPolicy
+	5,   // 95: obiente.cloud.gameservers.v1.GameServerService.ListGameServers:input_type -> obiente.cloud.gameservers.v1.ListGameServersRequest
+	7,   // 96: obiente.cloud.gameservers.v1.GameServerService.CreateGameServer:input_type -> obiente.cloud.gameservers.v1.CreateGameServerRequest
+	9,   // 97: obiente.cloud.gameservers.v1.GameServerService.GetGameServer:input_type -> obiente.cloud.gameservers.v1.GetGameServerRequest
+	11,  // 98: obiente.cloud.gameservers.v1.GameServerService.UpdateGameServer:input_type -> obiente.cloud.gameservers.v1.UpdateGameServerRequest
+	13,  // 99: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServer:input_type -> obiente.cloud.gameservers.v1.DeleteGameServerRequest
+	15,  // 100: obiente.cloud.gameservers.v1.GameServerService.StartGameServer:input_type -> obiente.cloud.gameservers.v1.StartGameServerRequest
+	17,  // 101: obiente.cloud.gameservers.v1.GameServerService.StopGameServer:input_type -> obiente.cloud.gameservers.v1.StopGameServerRequest
+	19,  // 102: obiente.cloud.gameservers.v1.GameServerService.RestartGameServer:input_type -> obiente.cloud.gameservers.v1.RestartGameServerRequest
+	29,  // 103: obiente.cloud.gameservers.v1.GameServerService.GetGameServerHTTPRoutes:input_type -> obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesRequest
+	31,  // 104: obiente.cloud.gameservers.v1.GameServerService.UpsertGameServerHTTPRoute:input_type -> obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteRequest
+	33,  // 105: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServerHTTPRoute:input_type -> obiente.cloud.gameservers.v1.DeleteGameServerHTTPRouteRequest
+	35,  // 106: obiente.cloud.gameservers.v1.GameServerService.GetGameServerDomainVerificationToken:input_type -> obiente.cloud.gameservers.v1.GetGameServerDomainVerificationTokenRequest
+	37,  // 107: obiente.cloud.gameservers.v1.GameServerService.VerifyGameServerDomain:input_type -> obiente.cloud.gameservers.v1.VerifyGameServerDomainRequest
+	39,  // 108: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerStatus:input_type -> obiente.cloud.gameservers.v1.StreamGameServerStatusRequest
+	41,  // 109: obiente.cloud.gameservers.v1.GameServerService.GetGameServerLogs:input_type -> obiente.cloud.gameservers.v1.GetGameServerLogsRequest
+	43,  // 110: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerLogs:input_type -> obiente.cloud.gameservers.v1.StreamGameServerLogsRequest
+	21,  // 111: obiente.cloud.gameservers.v1.GameServerService.ExecuteGameServerCommand:input_type -> obiente.cloud.gameservers.v1.ExecuteGameServerCommandRequest
+	24,  // 112: obiente.cloud.gameservers.v1.GameServerService.ManagePlayerWhitelist:input_type -> obiente.cloud.gameservers.v1.ManagePlayerWhitelistRequest
+	26,  // 113: obiente.cloud.gameservers.v1.GameServerService.ManagePlayerBanList:input_type -> obiente.cloud.gameservers.v1.ManagePlayerBanListRequest
+	45,  // 114: obiente.cloud.gameservers.v1.GameServerService.GetGameServerMetrics:input_type -> obiente.cloud.gameservers.v1.GetGameServerMetricsRequest
+	47,  // 115: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerMetrics:input_type -> obiente.cloud.gameservers.v1.StreamGameServerMetricsRequest
+	49,  // 116: obiente.cloud.gameservers.v1.GameServerService.GetGameServerUsage:input_type -> obiente.cloud.gameservers.v1.GetGameServerUsageRequest
+	55,  // 117: obiente.cloud.gameservers.v1.GameServerService.ListGameServerFiles:input_type -> obiente.cloud.gameservers.v1.ListGameServerFilesRequest
+	57,  // 118: obiente.cloud.gameservers.v1.GameServerService.SearchGameServerFiles:input_type -> obiente.cloud.gameservers.v1.SearchGameServerFilesRequest
+	59,  // 119: obiente.cloud.gameservers.v1.GameServerService.GetGameServerFile:input_type -> obiente.cloud.gameservers.v1.GetGameServerFileRequest
+	61,  // 120: obiente.cloud.gameservers.v1.GameServerService.UploadGameServerFiles:input_type -> obiente.cloud.gameservers.v1.UploadGameServerFilesRequest
+	65,  // 121: obiente.cloud.gameservers.v1.GameServerService.ChunkUploadGameServerFiles:input_type -> obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesRequest
+	67,  // 122: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServerEntries:input_type -> obiente.cloud.gameservers.v1.DeleteGameServerEntriesRequest
+	72,  // 123: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerEntry:input_type -> obiente.cloud.gameservers.v1.CreateGameServerEntryRequest
+	74,  // 124: obiente.cloud.gameservers.v1.GameServerService.WriteGameServerFile:input_type -> obiente.cloud.gameservers.v1.WriteGameServerFileRequest
+	70,  // 125: obiente.cloud.gameservers.v1.GameServerService.RenameGameServerEntry:input_type -> obiente.cloud.gameservers.v1.RenameGameServerEntryRequest
+	76,  // 126: obiente.cloud.gameservers.v1.GameServerService.ExtractGameServerFile:input_type -> obiente.cloud.gameservers.v1.ExtractGameServerFileRequest
+	78,  // 127: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerFileArchive:input_type -> obiente.cloud.gameservers.v1.CreateGameServerFileArchiveRequest
+	83,  // 128: obiente.cloud.gameservers.v1.GameServerService.ListGameServerFileTransferCredentials:input_type -> obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsRequest
+	85,  // 129: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerFileTransferCredential:input_type -> obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialRequest
+	87,  // 130: obiente.cloud.gameservers.v1.GameServerService.RevokeGameServerFileTransferCredential:input_type -> obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialRequest
+	89,  // 131: obiente.cloud.gameservers.v1.GameServerService.AddAuthorizedKey:input_type -> obiente.cloud.gameservers.v1.AddAuthorizedKeyRequest
+	91,  // 132: obiente.cloud.gameservers.v1.GameServerService.RemoveAuthorizedKey:input_type -> obiente.cloud.gameservers.v1.RemoveAuthorizedKeyRequest
+	93,  // 133: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftPlayerUUID:input_type -> obiente.cloud.gameservers.v1.GetMinecraftPlayerUUIDRequest
+	95,  // 134: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftPlayerProfile:input_type -> obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileRequest
+	98,  // 135: obiente.cloud.gameservers.v1.GameServerService.ListMinecraftProjects:input_type -> obiente.cloud.gameservers.v1.ListMinecraftProjectsRequest
+	101, // 136: obiente.cloud.gameservers.v1.GameServerService.ListInstalledMinecraftProjects:input_type -> obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsRequest
+	105, // 137: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftProjectVersions:input_type -> obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsRequest
+	107, // 138: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftProject:input_type -> obiente.cloud.gameservers.v1.GetMinecraftProjectRequest
+	109, // 139: obiente.cloud.gameservers.v1.GameServerService.InstallMinecraftProjectFile:input_type -> obiente.cloud.gameservers.v1.InstallMinecraftProjectFileRequest
+	111, // 140: obiente.cloud.gameservers.v1.GameServerService.UpdateMinecraftProjectFile:input_type -> obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileRequest
+	114, // 141: obiente.cloud.gameservers.v1.GameServerService.InstallGameServerMod:input_type -> obiente.cloud.gameservers.v1.InstallGameServerModRequest
+	116, // 142: obiente.cloud.gameservers.v1.GameServerService.UninstallGameServerMod:input_type -> obiente.cloud.gameservers.v1.UninstallGameServerModRequest
+	118, // 143: obiente.cloud.gameservers.v1.GameServerService.ListGameServerMods:input_type -> obiente.cloud.gameservers.v1.ListGameServerModsRequest
+	121, // 144: obiente.cloud.gameservers.v1.GameServerService.ScheduleGameServerBackup:input_type -> obiente.cloud.gameservers.v1.ScheduleGameServerBackupRequest
+	123, // 145: obiente.cloud.gameservers.v1.GameServerService.ListGameServerBackups:input_type -> obiente.cloud.gameservers.v1.ListGameServerBackupsRequest
+	125, // 146: obiente.cloud.gameservers.v1.GameServerService.RestoreGameServerBackup:input_type -> obiente.cloud.gameservers.v1.RestoreGameServerBackupRequest
+	128, // 147: obiente.cloud.gameservers.v1.GameServerService.SetDiscordIntegration:input_type -> obiente.cloud.gameservers.v1.SetDiscordIntegrationRequest
+	130, // 148: obiente.cloud.gameservers.v1.GameServerService.DeleteDiscordIntegration:input_type -> obiente.cloud.gameservers.v1.DeleteDiscordIntegrationRequest
+	133, // 149: obiente.cloud.gameservers.v1.GameServerService.SetIdleShutdownPolicy:input_type -> obiente.cloud.gameservers.v1.SetIdleShutdownPolicyRequest
+	135, // 150: obiente.cloud.gameservers.v1.GameServerService.GetIdleShutdownPolicy:input_type -> obiente.cloud.gameservers.v1.GetIdleShutdownPolicyRequest
+	6,   // 151: obiente.cloud.gameservers.v1.GameServerService.ListGameServers:output_type -> obiente.cloud.gameservers.v1.ListGameServersResponse
+	8,   // 152: obiente.cloud.gameservers.v1.GameServerService.CreateGameServer:output_type -> obiente.cloud.gameservers.v1.CreateGameServerResponse
+	10,  // 153: obiente.cloud.gameservers.v1.GameServerService.GetGameServer:output_type -> obiente.cloud.gameservers.v1.GetGameServerResponse
+	12,  // 154: obiente.cloud.gameservers.v1.GameServerService.UpdateGameServer:output_type -> obiente.cloud.gameservers.v1.UpdateGameServerResponse
+	14,  // 155: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServer:output_type -> obiente.cloud.gameservers.v1.DeleteGameServerResponse
+	16,  // 156: obiente.cloud.gameservers.v1.GameServerService.StartGameServer:output_type -> obiente.cloud.gameservers.v1.StartGameServerResponse
+	18,  // 157: obiente.cloud.gameservers.v1.GameServerService.StopGameServer:output_type -> obiente.cloud.gameservers.v1.StopGameServerResponse
+	20,  // 158: obiente.cloud.gameservers.v1.GameServerService.RestartGameServer:output_type -> obiente.cloud.gameservers.v1.RestartGameServerResponse
+	30,  // 159: obiente.cloud.gameservers.v1.GameServerService.GetGameServerHTTPRoutes:output_type -> obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesResponse
+	32,  // 160: obiente.cloud.gameservers.v1.GameServerService.UpsertGameServerHTTPRoute:output_type -> obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteResponse
+	34,  // 161: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServerHTTPRoute:output_type -> obiente.cloud.gameservers.v1.DeleteGameServerHTTPRouteResponse
+	36,  // 162: obiente.cloud.gameservers.v1.GameServerService.GetGameServerDomainVerificationToken:output_type -> obiente.cloud.gameservers.v1.GetGameServerDomainVerificationTokenResponse
+	38,  // 163: obiente.cloud.gameservers.v1.GameServerService.VerifyGameServerDomain:output_type -> obiente.cloud.gameservers.v1.VerifyGameServerDomainResponse
+	40,  // 164: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerStatus:output_type -> obiente.cloud.gameservers.v1.GameServerStatusUpdate
+	42,  // 165: obiente.cloud.gameservers.v1.GameServerService.GetGameServerLogs:output_type -> obiente.cloud.gameservers.v1.GetGameServerLogsResponse
+	44,  // 166: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerLogs:output_type -> obiente.cloud.gameservers.v1.GameServerLogLine
+	22,  // 167: obiente.cloud.gameservers.v1.GameServerService.ExecuteGameServerCommand:output_type -> obiente.cloud.gameservers.v1.ExecuteGameServerCommandResponse
+	25,  // 168: obiente.cloud.gameservers.v1.GameServerService.ManagePlayerWhitelist:output_type -> obiente.cloud.gameservers.v1.ManagePlayerWhitelistResponse
+	27,  // 169: obiente.cloud.gameservers.v1.GameServerService.ManagePlayerBanList:output_type -> obiente.cloud.gameservers.v1.ManagePlayerBanListResponse
+	46,  // 170: obiente.cloud.gameservers.v1.GameServerService.GetGameServerMetrics:output_type -> obiente.cloud.gameservers.v1.GetGameServerMetricsResponse
+	48,  // 171: obiente.cloud.gameservers.v1.GameServerService.StreamGameServerMetrics:output_type -> obiente.cloud.gameservers.v1.GameServerMetric
+	50,  // 172: obiente.cloud.gameservers.v1.GameServerService.GetGameServerUsage:output_type -> obiente.cloud.gameservers.v1.GetGameServerUsageResponse
+	56,  // 173: obiente.cloud.gameservers.v1.GameServerService.ListGameServerFiles:output_type -> obiente.cloud.gameservers.v1.ListGameServerFilesResponse
+	58,  // 174: obiente.cloud.gameservers.v1.GameServerService.SearchGameServerFiles:output_type -> obiente.cloud.gameservers.v1.SearchGameServerFilesResponse
+	60,  // 175: obiente.cloud.gameservers.v1.GameServerService.GetGameServerFile:output_type -> obiente.cloud.gameservers.v1.GetGameServerFileResponse
+	64,  // 176: obiente.cloud.gameservers.v1.GameServerService.UploadGameServerFiles:output_type -> obiente.cloud.gameservers.v1.UploadGameServerFilesResponse
+	66,  // 177: obiente.cloud.gameservers.v1.GameServerService.ChunkUploadGameServerFiles:output_type -> obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesResponse
+	69,  // 178: obiente.cloud.gameservers.v1.GameServerService.DeleteGameServerEntries:output_type -> obiente.cloud.gameservers.v1.DeleteGameServerEntriesResponse
+	73,  // 179: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerEntry:output_type -> obiente.cloud.gameservers.v1.CreateGameServerEntryResponse
+	75,  // 180: obiente.cloud.gameservers.v1.GameServerService.WriteGameServerFile:output_type -> obiente.cloud.gameservers.v1.WriteGameServerFileResponse
+	71,  // 181: obiente.cloud.gameservers.v1.GameServerService.RenameGameServerEntry:output_type -> obiente.cloud.gameservers.v1.RenameGameServerEntryResponse
+	77,  // 182: obiente.cloud.gameservers.v1.GameServerService.ExtractGameServerFile:output_type -> obiente.cloud.gameservers.v1.ExtractGameServerFileResponse
+	79,  // 183: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerFileArchive:output_type -> obiente.cloud.gameservers.v1.CreateGameServerFileArchiveResponse
+	84,  // 184: obiente.cloud.gameservers.v1.GameServerService.ListGameServerFileTransferCredentials:output_type -> obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsResponse
+	86,  // 185: obiente.cloud.gameservers.v1.GameServerService.CreateGameServerFileTransferCredential:output_type -> obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialResponse
+	88,  // 186: obiente.cloud.gameservers.v1.GameServerService.RevokeGameServerFileTransferCredential:output_type -> obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialResponse
+	90,  // 187: obiente.cloud.gameservers.v1.GameServerService.AddAuthorizedKey:output_type -> obiente.cloud.gameservers.v1.AddAuthorizedKeyResponse
+	92,  // 188: obiente.cloud.gameservers.v1.GameServerService.RemoveAuthorizedKey:output_type -> obiente.cloud.gameservers.v1.RemoveAuthorizedKeyResponse
+	94,  // 189: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftPlayerUUID:output_type -> obiente.cloud.gameservers.v1.GetMinecraftPlayerUUIDResponse
+	96,  // 190: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftPlayerProfile:output_type -> obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileResponse
+	99,  // 191: obiente.cloud.gameservers.v1.GameServerService.ListMinecraftProjects:output_type -> obiente.cloud.gameservers.v1.ListMinecraftProjectsResponse
+	102, // 192: obiente.cloud.gameservers.v1.GameServerService.ListInstalledMinecraftProjects:output_type -> obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsResponse
+	106, // 193: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftProjectVersions:output_type -> obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsResponse
+	108, // 194: obiente.cloud.gameservers.v1.GameServerService.GetMinecraftProject:output_type -> obiente.cloud.gameservers.v1.GetMinecraftProjectResponse
+	110, // 195: obiente.cloud.gameservers.v1.GameServerService.InstallMinecraftProjectFile:output_type -> obiente.cloud.gameservers.v1.InstallMinecraftProjectFileResponse
+	112, // 196: obiente.cloud.gameservers.v1.GameServerService.UpdateMinecraftProjectFile:output_type -> obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileResponse
+	115, // 197: obiente.cloud.gameservers.v1.GameServerService.InstallGameServerMod:output_type -> obiente.cloud.gameservers.v1.InstallGameServerModResponse
+	117, // 198: obiente.cloud.gameservers.v1.GameServerService.UninstallGameServerMod:output_type -> obiente.cloud.gameservers.v1.UninstallGameServerModResponse
+	119, // 199: obiente.cloud.gameservers.v1.GameServerService.ListGameServerMods:output_type -> obiente.cloud.gameservers.v1.ListGameServerModsResponse
+	122, // 200: obiente.cloud.gameservers.v1.GameServerService.ScheduleGameServerBackup:output_type -> obiente.cloud.gameservers.v1.ScheduleGameServerBackupResponse
+	124, // 201: obiente.cloud.gameservers.v1.GameServerService.ListGameServerBackups:output_type -> obiente.cloud.gameservers.v1.ListGameServerBackupsResponse
+	126, // 202: obiente.cloud.gameservers.v1.GameServerService.RestoreGameServerBackup:output_type -> obiente.cloud.gameservers.v1.RestoreGameServerBackupResponse
+	129, // 203: obiente.cloud.gameservers.v1.GameServerService.SetDiscordIntegration:output_type -> obiente.cloud.gameservers.v1.SetDiscordIntegrationResponse
+	131, // 204: obiente.cloud.gameservers.v1.GameServerService.DeleteDiscordIntegration:output_type -> obiente.cloud.gameservers.v1.DeleteDiscordIntegrationResponse
+	134, // 205: obiente.cloud.gameservers.v1.GameServerService.SetIdleShutdownPolicy:output_type -> obiente.cloud.gameservers.v1.SetIdleShutdownPolicyResponse
+	136, // 206: obiente.cloud.gameservers.v1.GameServerService.GetIdleShutdownPolicy:output_type -> obiente.cloud.gameservers.v1.GetIdleShutdownPolicyResponse
+	151, // [151:207] is the sub-list for method output_type
+	95,  // [95:151] is the sub-list for method input_type
+	95,  // [95:95] is the sub-list for extension type_name
+	95,  // [95:95] is the sub-list for extension extendee
+	0,   // [0:95] is the sub-list for field type_name
 }
 
 func init() { file_obiente_cloud_gameservers_v1_game_server_service_proto_init() }
@@ -8142,57 +10501,62 @@ func file_obiente_cloud_gameservers_v1_game_server_service_proto_init() {
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[0].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[2].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[6].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[16].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[19].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[18].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[23].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[26].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[28].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[29].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[31].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[32].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[33].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[35].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[36].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[38].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[39].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[40].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[41].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[43].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[44].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[45].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[46].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[47].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[48].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[50].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[51].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[52].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[54].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[55].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[58].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[57].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[59].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[60].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[62].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[63].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[64].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[65].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[66].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[68].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[67].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[69].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[70].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[71].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[72].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[77].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[79].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[73].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[75].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[80].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[81].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[82].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[83].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[84].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[87].OneofWrappers = []any{}
-	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[88].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[89].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[91].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[92].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[93].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[94].OneofWrappers = []any{}
 	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[95].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[96].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[99].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[100].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[104].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[105].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[106].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[107].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[115].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[116].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[123].OneofWrappers = []any{}
+	file_obiente_cloud_gameservers_v1_game_server_service_proto_msgTypes[127].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc), len(file_obiente_cloud_gameservers_v1_game_server_service_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   100,
+			NumEnums:      5,
+			NumMessages:   137,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
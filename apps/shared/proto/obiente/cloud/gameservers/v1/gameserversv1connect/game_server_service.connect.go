@@ -81,6 +81,15 @@ const (
 	// GameServerServiceStreamGameServerLogsProcedure is the fully-qualified name of the
 	// GameServerService's StreamGameServerLogs RPC.
 	GameServerServiceStreamGameServerLogsProcedure = "/obiente.cloud.gameservers.v1.GameServerService/StreamGameServerLogs"
+	// GameServerServiceExecuteGameServerCommandProcedure is the fully-qualified name of the
+	// GameServerService's ExecuteGameServerCommand RPC.
+	GameServerServiceExecuteGameServerCommandProcedure = "/obiente.cloud.gameservers.v1.GameServerService/ExecuteGameServerCommand"
+	// GameServerServiceManagePlayerWhitelistProcedure is the fully-qualified name of the
+	// GameServerService's ManagePlayerWhitelist RPC.
+	GameServerServiceManagePlayerWhitelistProcedure = "/obiente.cloud.gameservers.v1.GameServerService/ManagePlayerWhitelist"
+	// GameServerServiceManagePlayerBanListProcedure is the fully-qualified name of the
+	// GameServerService's ManagePlayerBanList RPC.
+	GameServerServiceManagePlayerBanListProcedure = "/obiente.cloud.gameservers.v1.GameServerService/ManagePlayerBanList"
 	// GameServerServiceGetGameServerMetricsProcedure is the fully-qualified name of the
 	// GameServerService's GetGameServerMetrics RPC.
 	GameServerServiceGetGameServerMetricsProcedure = "/obiente.cloud.gameservers.v1.GameServerService/GetGameServerMetrics"
@@ -132,6 +141,12 @@ const (
 	// GameServerServiceRevokeGameServerFileTransferCredentialProcedure is the fully-qualified name of
 	// the GameServerService's RevokeGameServerFileTransferCredential RPC.
 	GameServerServiceRevokeGameServerFileTransferCredentialProcedure = "/obiente.cloud.gameservers.v1.GameServerService/RevokeGameServerFileTransferCredential"
+	// GameServerServiceAddAuthorizedKeyProcedure is the fully-qualified name of the GameServerService's
+	// AddAuthorizedKey RPC.
+	GameServerServiceAddAuthorizedKeyProcedure = "/obiente.cloud.gameservers.v1.GameServerService/AddAuthorizedKey"
+	// GameServerServiceRemoveAuthorizedKeyProcedure is the fully-qualified name of the
+	// GameServerService's RemoveAuthorizedKey RPC.
+	GameServerServiceRemoveAuthorizedKeyProcedure = "/obiente.cloud.gameservers.v1.GameServerService/RemoveAuthorizedKey"
 	// GameServerServiceGetMinecraftPlayerUUIDProcedure is the fully-qualified name of the
 	// GameServerService's GetMinecraftPlayerUUID RPC.
 	GameServerServiceGetMinecraftPlayerUUIDProcedure = "/obiente.cloud.gameservers.v1.GameServerService/GetMinecraftPlayerUUID"
@@ -156,6 +171,36 @@ const (
 	// GameServerServiceUpdateMinecraftProjectFileProcedure is the fully-qualified name of the
 	// GameServerService's UpdateMinecraftProjectFile RPC.
 	GameServerServiceUpdateMinecraftProjectFileProcedure = "/obiente.cloud.gameservers.v1.GameServerService/UpdateMinecraftProjectFile"
+	// GameServerServiceInstallGameServerModProcedure is the fully-qualified name of the
+	// GameServerService's InstallGameServerMod RPC.
+	GameServerServiceInstallGameServerModProcedure = "/obiente.cloud.gameservers.v1.GameServerService/InstallGameServerMod"
+	// GameServerServiceUninstallGameServerModProcedure is the fully-qualified name of the
+	// GameServerService's UninstallGameServerMod RPC.
+	GameServerServiceUninstallGameServerModProcedure = "/obiente.cloud.gameservers.v1.GameServerService/UninstallGameServerMod"
+	// GameServerServiceListGameServerModsProcedure is the fully-qualified name of the
+	// GameServerService's ListGameServerMods RPC.
+	GameServerServiceListGameServerModsProcedure = "/obiente.cloud.gameservers.v1.GameServerService/ListGameServerMods"
+	// GameServerServiceScheduleGameServerBackupProcedure is the fully-qualified name of the
+	// GameServerService's ScheduleGameServerBackup RPC.
+	GameServerServiceScheduleGameServerBackupProcedure = "/obiente.cloud.gameservers.v1.GameServerService/ScheduleGameServerBackup"
+	// GameServerServiceListGameServerBackupsProcedure is the fully-qualified name of the
+	// GameServerService's ListGameServerBackups RPC.
+	GameServerServiceListGameServerBackupsProcedure = "/obiente.cloud.gameservers.v1.GameServerService/ListGameServerBackups"
+	// GameServerServiceRestoreGameServerBackupProcedure is the fully-qualified name of the
+	// GameServerService's RestoreGameServerBackup RPC.
+	GameServerServiceRestoreGameServerBackupProcedure = "/obiente.cloud.gameservers.v1.GameServerService/RestoreGameServerBackup"
+	// GameServerServiceSetDiscordIntegrationProcedure is the fully-qualified name of the
+	// GameServerService's SetDiscordIntegration RPC.
+	GameServerServiceSetDiscordIntegrationProcedure = "/obiente.cloud.gameservers.v1.GameServerService/SetDiscordIntegration"
+	// GameServerServiceDeleteDiscordIntegrationProcedure is the fully-qualified name of the
+	// GameServerService's DeleteDiscordIntegration RPC.
+	GameServerServiceDeleteDiscordIntegrationProcedure = "/obiente.cloud.gameservers.v1.GameServerService/DeleteDiscordIntegration"
+	// GameServerServiceSetIdleShutdownPolicyProcedure is the fully-qualified name of the
+	// GameServerService's SetIdleShutdownPolicy RPC.
+	GameServerServiceSetIdleShutdownPolicyProcedure = "/obiente.cloud.gameservers.v1.GameServerService/SetIdleShutdownPolicy"
+	// GameServerServiceGetIdleShutdownPolicyProcedure is the fully-qualified name of the
+	// GameServerService's GetIdleShutdownPolicy RPC.
+	GameServerServiceGetIdleShutdownPolicyProcedure = "/obiente.cloud.gameservers.v1.GameServerService/GetIdleShutdownPolicy"
 )
 
 // GameServerServiceClient is a client for the obiente.cloud.gameservers.v1.GameServerService
@@ -193,6 +238,12 @@ type GameServerServiceClient interface {
 	GetGameServerLogs(context.Context, *connect.Request[v1.GetGameServerLogsRequest]) (*connect.Response[v1.GetGameServerLogsResponse], error)
 	// Stream game server logs (tail/follow)
 	StreamGameServerLogs(context.Context, *connect.Request[v1.StreamGameServerLogsRequest]) (*connect.ServerStreamForClient[v1.GameServerLogLine], error)
+	// Execute a console command over RCON (rate-limited to 60 per minute per game server)
+	ExecuteGameServerCommand(context.Context, *connect.Request[v1.ExecuteGameServerCommandRequest]) (*connect.Response[v1.ExecuteGameServerCommandResponse], error)
+	// Add, remove or list whitelisted players of a running Minecraft Java server
+	ManagePlayerWhitelist(context.Context, *connect.Request[v1.ManagePlayerWhitelistRequest]) (*connect.Response[v1.ManagePlayerWhitelistResponse], error)
+	// Ban, pardon or list banned players of a running Minecraft Java server
+	ManagePlayerBanList(context.Context, *connect.Request[v1.ManagePlayerBanListRequest]) (*connect.Response[v1.ManagePlayerBanListResponse], error)
 	// Get game server metrics (real-time or historical)
 	GetGameServerMetrics(context.Context, *connect.Request[v1.GetGameServerMetricsRequest]) (*connect.Response[v1.GetGameServerMetricsResponse], error)
 	// Stream real-time game server metrics
@@ -229,6 +280,10 @@ type GameServerServiceClient interface {
 	CreateGameServerFileTransferCredential(context.Context, *connect.Request[v1.CreateGameServerFileTransferCredentialRequest]) (*connect.Response[v1.CreateGameServerFileTransferCredentialResponse], error)
 	// Revoke an SFTP credential
 	RevokeGameServerFileTransferCredential(context.Context, *connect.Request[v1.RevokeGameServerFileTransferCredentialRequest]) (*connect.Response[v1.RevokeGameServerFileTransferCredentialResponse], error)
+	// Authorize an SSH public key to log in as an SFTP credential
+	AddAuthorizedKey(context.Context, *connect.Request[v1.AddAuthorizedKeyRequest]) (*connect.Response[v1.AddAuthorizedKeyResponse], error)
+	// Remove an authorized SSH public key from an SFTP credential
+	RemoveAuthorizedKey(context.Context, *connect.Request[v1.RemoveAuthorizedKeyRequest]) (*connect.Response[v1.RemoveAuthorizedKeyResponse], error)
 	// Minecraft player lookup (proxies Mojang API to avoid CORS)
 	// Get player UUID from username
 	GetMinecraftPlayerUUID(context.Context, *connect.Request[v1.GetMinecraftPlayerUUIDRequest]) (*connect.Response[v1.GetMinecraftPlayerUUIDResponse], error)
@@ -245,6 +300,26 @@ type GameServerServiceClient interface {
 	InstallMinecraftProjectFile(context.Context, *connect.Request[v1.InstallMinecraftProjectFileRequest]) (*connect.Response[v1.InstallMinecraftProjectFileResponse], error)
 	// Download a newer version and replace the previously managed mod/plugin jar
 	UpdateMinecraftProjectFile(context.Context, *connect.Request[v1.UpdateMinecraftProjectFileRequest]) (*connect.Response[v1.UpdateMinecraftProjectFileResponse], error)
+	// Install a mod from Modrinth or CurseForge, record it and restart the server
+	InstallGameServerMod(context.Context, *connect.Request[v1.InstallGameServerModRequest]) (*connect.Response[v1.InstallGameServerModResponse], error)
+	// Remove an installed mod and restart the server
+	UninstallGameServerMod(context.Context, *connect.Request[v1.UninstallGameServerModRequest]) (*connect.Response[v1.UninstallGameServerModResponse], error)
+	// List mods installed through InstallGameServerMod
+	ListGameServerMods(context.Context, *connect.Request[v1.ListGameServerModsRequest]) (*connect.Response[v1.ListGameServerModsResponse], error)
+	// Set how often the server's data directory is backed up to object storage and how many backups are kept
+	ScheduleGameServerBackup(context.Context, *connect.Request[v1.ScheduleGameServerBackupRequest]) (*connect.Response[v1.ScheduleGameServerBackupResponse], error)
+	// List backups of a game server, newest first
+	ListGameServerBackups(context.Context, *connect.Request[v1.ListGameServerBackupsRequest]) (*connect.Response[v1.ListGameServerBackupsResponse], error)
+	// Restore a backup into the server's data directory (the server is stopped while restoring)
+	RestoreGameServerBackup(context.Context, *connect.Request[v1.RestoreGameServerBackupRequest]) (*connect.Response[v1.RestoreGameServerBackupResponse], error)
+	// Post game server events (start, stop, crash, player join/leave) to a Discord channel through a bot
+	SetDiscordIntegration(context.Context, *connect.Request[v1.SetDiscordIntegrationRequest]) (*connect.Response[v1.SetDiscordIntegrationResponse], error)
+	// Stop posting game server events to Discord
+	DeleteDiscordIntegration(context.Context, *connect.Request[v1.DeleteDiscordIntegrationRequest]) (*connect.Response[v1.DeleteDiscordIntegrationResponse], error)
+	// Stop the server automatically when no players have been online for a while
+	SetIdleShutdownPolicy(context.Context, *connect.Request[v1.SetIdleShutdownPolicyRequest]) (*connect.Response[v1.SetIdleShutdownPolicyResponse], error)
+	// Get the idle shutdown policy of a game server
+	GetIdleShutdownPolicy(context.Context, *connect.Request[v1.GetIdleShutdownPolicyRequest]) (*connect.Response[v1.GetIdleShutdownPolicyResponse], error)
 }
 
 // NewGameServerServiceClient constructs a client for the
@@ -355,6 +430,24 @@ func NewGameServerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(gameServerServiceMethods.ByName("StreamGameServerLogs")),
 			connect.WithClientOptions(opts...),
 		),
+		executeGameServerCommand: connect.NewClient[v1.ExecuteGameServerCommandRequest, v1.ExecuteGameServerCommandResponse](
+			httpClient,
+			baseURL+GameServerServiceExecuteGameServerCommandProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("ExecuteGameServerCommand")),
+			connect.WithClientOptions(opts...),
+		),
+		managePlayerWhitelist: connect.NewClient[v1.ManagePlayerWhitelistRequest, v1.ManagePlayerWhitelistResponse](
+			httpClient,
+			baseURL+GameServerServiceManagePlayerWhitelistProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("ManagePlayerWhitelist")),
+			connect.WithClientOptions(opts...),
+		),
+		managePlayerBanList: connect.NewClient[v1.ManagePlayerBanListRequest, v1.ManagePlayerBanListResponse](
+			httpClient,
+			baseURL+GameServerServiceManagePlayerBanListProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("ManagePlayerBanList")),
+			connect.WithClientOptions(opts...),
+		),
 		getGameServerMetrics: connect.NewClient[v1.GetGameServerMetricsRequest, v1.GetGameServerMetricsResponse](
 			httpClient,
 			baseURL+GameServerServiceGetGameServerMetricsProcedure,
@@ -457,6 +550,18 @@ func NewGameServerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(gameServerServiceMethods.ByName("RevokeGameServerFileTransferCredential")),
 			connect.WithClientOptions(opts...),
 		),
+		addAuthorizedKey: connect.NewClient[v1.AddAuthorizedKeyRequest, v1.AddAuthorizedKeyResponse](
+			httpClient,
+			baseURL+GameServerServiceAddAuthorizedKeyProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("AddAuthorizedKey")),
+			connect.WithClientOptions(opts...),
+		),
+		removeAuthorizedKey: connect.NewClient[v1.RemoveAuthorizedKeyRequest, v1.RemoveAuthorizedKeyResponse](
+			httpClient,
+			baseURL+GameServerServiceRemoveAuthorizedKeyProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("RemoveAuthorizedKey")),
+			connect.WithClientOptions(opts...),
+		),
 		getMinecraftPlayerUUID: connect.NewClient[v1.GetMinecraftPlayerUUIDRequest, v1.GetMinecraftPlayerUUIDResponse](
 			httpClient,
 			baseURL+GameServerServiceGetMinecraftPlayerUUIDProcedure,
@@ -505,6 +610,66 @@ func NewGameServerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(gameServerServiceMethods.ByName("UpdateMinecraftProjectFile")),
 			connect.WithClientOptions(opts...),
 		),
+		installGameServerMod: connect.NewClient[v1.InstallGameServerModRequest, v1.InstallGameServerModResponse](
+			httpClient,
+			baseURL+GameServerServiceInstallGameServerModProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("InstallGameServerMod")),
+			connect.WithClientOptions(opts...),
+		),
+		uninstallGameServerMod: connect.NewClient[v1.UninstallGameServerModRequest, v1.UninstallGameServerModResponse](
+			httpClient,
+			baseURL+GameServerServiceUninstallGameServerModProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("UninstallGameServerMod")),
+			connect.WithClientOptions(opts...),
+		),
+		listGameServerMods: connect.NewClient[v1.ListGameServerModsRequest, v1.ListGameServerModsResponse](
+			httpClient,
+			baseURL+GameServerServiceListGameServerModsProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("ListGameServerMods")),
+			connect.WithClientOptions(opts...),
+		),
+		scheduleGameServerBackup: connect.NewClient[v1.ScheduleGameServerBackupRequest, v1.ScheduleGameServerBackupResponse](
+			httpClient,
+			baseURL+GameServerServiceScheduleGameServerBackupProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("ScheduleGameServerBackup")),
+			connect.WithClientOptions(opts...),
+		),
+		listGameServerBackups: connect.NewClient[v1.ListGameServerBackupsRequest, v1.ListGameServerBackupsResponse](
+			httpClient,
+			baseURL+GameServerServiceListGameServerBackupsProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("ListGameServerBackups")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreGameServerBackup: connect.NewClient[v1.RestoreGameServerBackupRequest, v1.RestoreGameServerBackupResponse](
+			httpClient,
+			baseURL+GameServerServiceRestoreGameServerBackupProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("RestoreGameServerBackup")),
+			connect.WithClientOptions(opts...),
+		),
+		setDiscordIntegration: connect.NewClient[v1.SetDiscordIntegrationRequest, v1.SetDiscordIntegrationResponse](
+			httpClient,
+			baseURL+GameServerServiceSetDiscordIntegrationProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("SetDiscordIntegration")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteDiscordIntegration: connect.NewClient[v1.DeleteDiscordIntegrationRequest, v1.DeleteDiscordIntegrationResponse](
+			httpClient,
+			baseURL+GameServerServiceDeleteDiscordIntegrationProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("DeleteDiscordIntegration")),
+			connect.WithClientOptions(opts...),
+		),
+		setIdleShutdownPolicy: connect.NewClient[v1.SetIdleShutdownPolicyRequest, v1.SetIdleShutdownPolicyResponse](
+			httpClient,
+			baseURL+GameServerServiceSetIdleShutdownPolicyProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("SetIdleShutdownPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		getIdleShutdownPolicy: connect.NewClient[v1.GetIdleShutdownPolicyRequest, v1.GetIdleShutdownPolicyResponse](
+			httpClient,
+			baseURL+GameServerServiceGetIdleShutdownPolicyProcedure,
+			connect.WithSchema(gameServerServiceMethods.ByName("GetIdleShutdownPolicy")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -526,6 +691,9 @@ type gameServerServiceClient struct {
 	streamGameServerStatus                 *connect.Client[v1.StreamGameServerStatusRequest, v1.GameServerStatusUpdate]
 	getGameServerLogs                      *connect.Client[v1.GetGameServerLogsRequest, v1.GetGameServerLogsResponse]
 	streamGameServerLogs                   *connect.Client[v1.StreamGameServerLogsRequest, v1.GameServerLogLine]
+	executeGameServerCommand               *connect.Client[v1.ExecuteGameServerCommandRequest, v1.ExecuteGameServerCommandResponse]
+	managePlayerWhitelist                  *connect.Client[v1.ManagePlayerWhitelistRequest, v1.ManagePlayerWhitelistResponse]
+	managePlayerBanList                    *connect.Client[v1.ManagePlayerBanListRequest, v1.ManagePlayerBanListResponse]
 	getGameServerMetrics                   *connect.Client[v1.GetGameServerMetricsRequest, v1.GetGameServerMetricsResponse]
 	streamGameServerMetrics                *connect.Client[v1.StreamGameServerMetricsRequest, v1.GameServerMetric]
 	getGameServerUsage                     *connect.Client[v1.GetGameServerUsageRequest, v1.GetGameServerUsageResponse]
@@ -543,6 +711,8 @@ type gameServerServiceClient struct {
 	listGameServerFileTransferCredentials  *connect.Client[v1.ListGameServerFileTransferCredentialsRequest, v1.ListGameServerFileTransferCredentialsResponse]
 	createGameServerFileTransferCredential *connect.Client[v1.CreateGameServerFileTransferCredentialRequest, v1.CreateGameServerFileTransferCredentialResponse]
 	revokeGameServerFileTransferCredential *connect.Client[v1.RevokeGameServerFileTransferCredentialRequest, v1.RevokeGameServerFileTransferCredentialResponse]
+	addAuthorizedKey                       *connect.Client[v1.AddAuthorizedKeyRequest, v1.AddAuthorizedKeyResponse]
+	removeAuthorizedKey                    *connect.Client[v1.RemoveAuthorizedKeyRequest, v1.RemoveAuthorizedKeyResponse]
 	getMinecraftPlayerUUID                 *connect.Client[v1.GetMinecraftPlayerUUIDRequest, v1.GetMinecraftPlayerUUIDResponse]
 	getMinecraftPlayerProfile              *connect.Client[v1.GetMinecraftPlayerProfileRequest, v1.GetMinecraftPlayerProfileResponse]
 	listMinecraftProjects                  *connect.Client[v1.ListMinecraftProjectsRequest, v1.ListMinecraftProjectsResponse]
@@ -551,6 +721,16 @@ type gameServerServiceClient struct {
 	getMinecraftProject                    *connect.Client[v1.GetMinecraftProjectRequest, v1.GetMinecraftProjectResponse]
 	installMinecraftProjectFile            *connect.Client[v1.InstallMinecraftProjectFileRequest, v1.InstallMinecraftProjectFileResponse]
 	updateMinecraftProjectFile             *connect.Client[v1.UpdateMinecraftProjectFileRequest, v1.UpdateMinecraftProjectFileResponse]
+	installGameServerMod                   *connect.Client[v1.InstallGameServerModRequest, v1.InstallGameServerModResponse]
+	uninstallGameServerMod                 *connect.Client[v1.UninstallGameServerModRequest, v1.UninstallGameServerModResponse]
+	listGameServerMods                     *connect.Client[v1.ListGameServerModsRequest, v1.ListGameServerModsResponse]
+	scheduleGameServerBackup               *connect.Client[v1.ScheduleGameServerBackupRequest, v1.ScheduleGameServerBackupResponse]
+	listGameServerBackups                  *connect.Client[v1.ListGameServerBackupsRequest, v1.ListGameServerBackupsResponse]
+	restoreGameServerBackup                *connect.Client[v1.RestoreGameServerBackupRequest, v1.RestoreGameServerBackupResponse]
+	setDiscordIntegration                  *connect.Client[v1.SetDiscordIntegrationRequest, v1.SetDiscordIntegrationResponse]
+	deleteDiscordIntegration               *connect.Client[v1.DeleteDiscordIntegrationRequest, v1.DeleteDiscordIntegrationResponse]
+	setIdleShutdownPolicy                  *connect.Client[v1.SetIdleShutdownPolicyRequest, v1.SetIdleShutdownPolicyResponse]
+	getIdleShutdownPolicy                  *connect.Client[v1.GetIdleShutdownPolicyRequest, v1.GetIdleShutdownPolicyResponse]
 }
 
 // ListGameServers calls obiente.cloud.gameservers.v1.GameServerService.ListGameServers.
@@ -639,6 +819,22 @@ func (c *gameServerServiceClient) StreamGameServerLogs(ctx context.Context, req
 	return c.streamGameServerLogs.CallServerStream(ctx, req)
 }
 
+// ExecuteGameServerCommand calls
+// obiente.cloud.gameservers.v1.GameServerService.ExecuteGameServerCommand.
+func (c *gameServerServiceClient) ExecuteGameServerCommand(ctx context.Context, req *connect.Request[v1.ExecuteGameServerCommandRequest]) (*connect.Response[v1.ExecuteGameServerCommandResponse], error) {
+	return c.executeGameServerCommand.CallUnary(ctx, req)
+}
+
+// ManagePlayerWhitelist calls obiente.cloud.gameservers.v1.GameServerService.ManagePlayerWhitelist.
+func (c *gameServerServiceClient) ManagePlayerWhitelist(ctx context.Context, req *connect.Request[v1.ManagePlayerWhitelistRequest]) (*connect.Response[v1.ManagePlayerWhitelistResponse], error) {
+	return c.managePlayerWhitelist.CallUnary(ctx, req)
+}
+
+// ManagePlayerBanList calls obiente.cloud.gameservers.v1.GameServerService.ManagePlayerBanList.
+func (c *gameServerServiceClient) ManagePlayerBanList(ctx context.Context, req *connect.Request[v1.ManagePlayerBanListRequest]) (*connect.Response[v1.ManagePlayerBanListResponse], error) {
+	return c.managePlayerBanList.CallUnary(ctx, req)
+}
+
 // GetGameServerMetrics calls obiente.cloud.gameservers.v1.GameServerService.GetGameServerMetrics.
 func (c *gameServerServiceClient) GetGameServerMetrics(ctx context.Context, req *connect.Request[v1.GetGameServerMetricsRequest]) (*connect.Response[v1.GetGameServerMetricsResponse], error) {
 	return c.getGameServerMetrics.CallUnary(ctx, req)
@@ -731,6 +927,16 @@ func (c *gameServerServiceClient) RevokeGameServerFileTransferCredential(ctx con
 	return c.revokeGameServerFileTransferCredential.CallUnary(ctx, req)
 }
 
+// AddAuthorizedKey calls obiente.cloud.gameservers.v1.GameServerService.AddAuthorizedKey.
+func (c *gameServerServiceClient) AddAuthorizedKey(ctx context.Context, req *connect.Request[v1.AddAuthorizedKeyRequest]) (*connect.Response[v1.AddAuthorizedKeyResponse], error) {
+	return c.addAuthorizedKey.CallUnary(ctx, req)
+}
+
+// RemoveAuthorizedKey calls obiente.cloud.gameservers.v1.GameServerService.RemoveAuthorizedKey.
+func (c *gameServerServiceClient) RemoveAuthorizedKey(ctx context.Context, req *connect.Request[v1.RemoveAuthorizedKeyRequest]) (*connect.Response[v1.RemoveAuthorizedKeyResponse], error) {
+	return c.removeAuthorizedKey.CallUnary(ctx, req)
+}
+
 // GetMinecraftPlayerUUID calls
 // obiente.cloud.gameservers.v1.GameServerService.GetMinecraftPlayerUUID.
 func (c *gameServerServiceClient) GetMinecraftPlayerUUID(ctx context.Context, req *connect.Request[v1.GetMinecraftPlayerUUIDRequest]) (*connect.Response[v1.GetMinecraftPlayerUUIDResponse], error) {
@@ -777,6 +983,60 @@ func (c *gameServerServiceClient) UpdateMinecraftProjectFile(ctx context.Context
 	return c.updateMinecraftProjectFile.CallUnary(ctx, req)
 }
 
+// InstallGameServerMod calls obiente.cloud.gameservers.v1.GameServerService.InstallGameServerMod.
+func (c *gameServerServiceClient) InstallGameServerMod(ctx context.Context, req *connect.Request[v1.InstallGameServerModRequest]) (*connect.Response[v1.InstallGameServerModResponse], error) {
+	return c.installGameServerMod.CallUnary(ctx, req)
+}
+
+// UninstallGameServerMod calls
+// obiente.cloud.gameservers.v1.GameServerService.UninstallGameServerMod.
+func (c *gameServerServiceClient) UninstallGameServerMod(ctx context.Context, req *connect.Request[v1.UninstallGameServerModRequest]) (*connect.Response[v1.UninstallGameServerModResponse], error) {
+	return c.uninstallGameServerMod.CallUnary(ctx, req)
+}
+
+// ListGameServerMods calls obiente.cloud.gameservers.v1.GameServerService.ListGameServerMods.
+func (c *gameServerServiceClient) ListGameServerMods(ctx context.Context, req *connect.Request[v1.ListGameServerModsRequest]) (*connect.Response[v1.ListGameServerModsResponse], error) {
+	return c.listGameServerMods.CallUnary(ctx, req)
+}
+
+// ScheduleGameServerBackup calls
+// obiente.cloud.gameservers.v1.GameServerService.ScheduleGameServerBackup.
+func (c *gameServerServiceClient) ScheduleGameServerBackup(ctx context.Context, req *connect.Request[v1.ScheduleGameServerBackupRequest]) (*connect.Response[v1.ScheduleGameServerBackupResponse], error) {
+	return c.scheduleGameServerBackup.CallUnary(ctx, req)
+}
+
+// ListGameServerBackups calls obiente.cloud.gameservers.v1.GameServerService.ListGameServerBackups.
+func (c *gameServerServiceClient) ListGameServerBackups(ctx context.Context, req *connect.Request[v1.ListGameServerBackupsRequest]) (*connect.Response[v1.ListGameServerBackupsResponse], error) {
+	return c.listGameServerBackups.CallUnary(ctx, req)
+}
+
+// RestoreGameServerBackup calls
+// obiente.cloud.gameservers.v1.GameServerService.RestoreGameServerBackup.
+func (c *gameServerServiceClient) RestoreGameServerBackup(ctx context.Context, req *connect.Request[v1.RestoreGameServerBackupRequest]) (*connect.Response[v1.RestoreGameServerBackupResponse], error) {
+	return c.restoreGameServerBackup.CallUnary(ctx, req)
+}
+
+// SetDiscordIntegration calls obiente.cloud.gameservers.v1.GameServerService.SetDiscordIntegration.
+func (c *gameServerServiceClient) SetDiscordIntegration(ctx context.Context, req *connect.Request[v1.SetDiscordIntegrationRequest]) (*connect.Response[v1.SetDiscordIntegrationResponse], error) {
+	return c.setDiscordIntegration.CallUnary(ctx, req)
+}
+
+// DeleteDiscordIntegration calls
+// obiente.cloud.gameservers.v1.GameServerService.DeleteDiscordIntegration.
+func (c *gameServerServiceClient) DeleteDiscordIntegration(ctx context.Context, req *connect.Request[v1.DeleteDiscordIntegrationRequest]) (*connect.Response[v1.DeleteDiscordIntegrationResponse], error) {
+	return c.deleteDiscordIntegration.CallUnary(ctx, req)
+}
+
+// SetIdleShutdownPolicy calls obiente.cloud.gameservers.v1.GameServerService.SetIdleShutdownPolicy.
+func (c *gameServerServiceClient) SetIdleShutdownPolicy(ctx context.Context, req *connect.Request[v1.SetIdleShutdownPolicyRequest]) (*connect.Response[v1.SetIdleShutdownPolicyResponse], error) {
+	return c.setIdleShutdownPolicy.CallUnary(ctx, req)
+}
+
+// GetIdleShutdownPolicy calls obiente.cloud.gameservers.v1.GameServerService.GetIdleShutdownPolicy.
+func (c *gameServerServiceClient) GetIdleShutdownPolicy(ctx context.Context, req *connect.Request[v1.GetIdleShutdownPolicyRequest]) (*connect.Response[v1.GetIdleShutdownPolicyResponse], error) {
+	return c.getIdleShutdownPolicy.CallUnary(ctx, req)
+}
+
 // GameServerServiceHandler is an implementation of the
 // obiente.cloud.gameservers.v1.GameServerService service.
 type GameServerServiceHandler interface {
@@ -812,6 +1072,12 @@ type GameServerServiceHandler interface {
 	GetGameServerLogs(context.Context, *connect.Request[v1.GetGameServerLogsRequest]) (*connect.Response[v1.GetGameServerLogsResponse], error)
 	// Stream game server logs (tail/follow)
 	StreamGameServerLogs(context.Context, *connect.Request[v1.StreamGameServerLogsRequest], *connect.ServerStream[v1.GameServerLogLine]) error
+	// Execute a console command over RCON (rate-limited to 60 per minute per game server)
+	ExecuteGameServerCommand(context.Context, *connect.Request[v1.ExecuteGameServerCommandRequest]) (*connect.Response[v1.ExecuteGameServerCommandResponse], error)
+	// Add, remove or list whitelisted players of a running Minecraft Java server
+	ManagePlayerWhitelist(context.Context, *connect.Request[v1.ManagePlayerWhitelistRequest]) (*connect.Response[v1.ManagePlayerWhitelistResponse], error)
+	// Ban, pardon or list banned players of a running Minecraft Java server
+	ManagePlayerBanList(context.Context, *connect.Request[v1.ManagePlayerBanListRequest]) (*connect.Response[v1.ManagePlayerBanListResponse], error)
 	// Get game server metrics (real-time or historical)
 	GetGameServerMetrics(context.Context, *connect.Request[v1.GetGameServerMetricsRequest]) (*connect.Response[v1.GetGameServerMetricsResponse], error)
 	// Stream real-time game server metrics
@@ -848,6 +1114,10 @@ type GameServerServiceHandler interface {
 	CreateGameServerFileTransferCredential(context.Context, *connect.Request[v1.CreateGameServerFileTransferCredentialRequest]) (*connect.Response[v1.CreateGameServerFileTransferCredentialResponse], error)
 	// Revoke an SFTP credential
 	RevokeGameServerFileTransferCredential(context.Context, *connect.Request[v1.RevokeGameServerFileTransferCredentialRequest]) (*connect.Response[v1.RevokeGameServerFileTransferCredentialResponse], error)
+	// Authorize an SSH public key to log in as an SFTP credential
+	AddAuthorizedKey(context.Context, *connect.Request[v1.AddAuthorizedKeyRequest]) (*connect.Response[v1.AddAuthorizedKeyResponse], error)
+	// Remove an authorized SSH public key from an SFTP credential
+	RemoveAuthorizedKey(context.Context, *connect.Request[v1.RemoveAuthorizedKeyRequest]) (*connect.Response[v1.RemoveAuthorizedKeyResponse], error)
 	// Minecraft player lookup (proxies Mojang API to avoid CORS)
 	// Get player UUID from username
 	GetMinecraftPlayerUUID(context.Context, *connect.Request[v1.GetMinecraftPlayerUUIDRequest]) (*connect.Response[v1.GetMinecraftPlayerUUIDResponse], error)
@@ -864,6 +1134,26 @@ type GameServerServiceHandler interface {
 	InstallMinecraftProjectFile(context.Context, *connect.Request[v1.InstallMinecraftProjectFileRequest]) (*connect.Response[v1.InstallMinecraftProjectFileResponse], error)
 	// Download a newer version and replace the previously managed mod/plugin jar
 	UpdateMinecraftProjectFile(context.Context, *connect.Request[v1.UpdateMinecraftProjectFileRequest]) (*connect.Response[v1.UpdateMinecraftProjectFileResponse], error)
+	// Install a mod from Modrinth or CurseForge, record it and restart the server
+	InstallGameServerMod(context.Context, *connect.Request[v1.InstallGameServerModRequest]) (*connect.Response[v1.InstallGameServerModResponse], error)
+	// Remove an installed mod and restart the server
+	UninstallGameServerMod(context.Context, *connect.Request[v1.UninstallGameServerModRequest]) (*connect.Response[v1.UninstallGameServerModResponse], error)
+	// List mods installed through InstallGameServerMod
+	ListGameServerMods(context.Context, *connect.Request[v1.ListGameServerModsRequest]) (*connect.Response[v1.ListGameServerModsResponse], error)
+	// Set how often the server's data directory is backed up to object storage and how many backups are kept
+	ScheduleGameServerBackup(context.Context, *connect.Request[v1.ScheduleGameServerBackupRequest]) (*connect.Response[v1.ScheduleGameServerBackupResponse], error)
+	// List backups of a game server, newest first
+	ListGameServerBackups(context.Context, *connect.Request[v1.ListGameServerBackupsRequest]) (*connect.Response[v1.ListGameServerBackupsResponse], error)
+	// Restore a backup into the server's data directory (the server is stopped while restoring)
+	RestoreGameServerBackup(context.Context, *connect.Request[v1.RestoreGameServerBackupRequest]) (*connect.Response[v1.RestoreGameServerBackupResponse], error)
+	// Post game server events (start, stop, crash, player join/leave) to a Discord channel through a bot
+	SetDiscordIntegration(context.Context, *connect.Request[v1.SetDiscordIntegrationRequest]) (*connect.Response[v1.SetDiscordIntegrationResponse], error)
+	// Stop posting game server events to Discord
+	DeleteDiscordIntegration(context.Context, *connect.Request[v1.DeleteDiscordIntegrationRequest]) (*connect.Response[v1.DeleteDiscordIntegrationResponse], error)
+	// Stop the server automatically when no players have been online for a while
+	SetIdleShutdownPolicy(context.Context, *connect.Request[v1.SetIdleShutdownPolicyRequest]) (*connect.Response[v1.SetIdleShutdownPolicyResponse], error)
+	// Get the idle shutdown policy of a game server
+	GetIdleShutdownPolicy(context.Context, *connect.Request[v1.GetIdleShutdownPolicyRequest]) (*connect.Response[v1.GetIdleShutdownPolicyResponse], error)
 }
 
 // NewGameServerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -969,6 +1259,24 @@ func NewGameServerServiceHandler(svc GameServerServiceHandler, opts ...connect.H
 		connect.WithSchema(gameServerServiceMethods.ByName("StreamGameServerLogs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServerServiceExecuteGameServerCommandHandler := connect.NewUnaryHandler(
+		GameServerServiceExecuteGameServerCommandProcedure,
+		svc.ExecuteGameServerCommand,
+		connect.WithSchema(gameServerServiceMethods.ByName("ExecuteGameServerCommand")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceManagePlayerWhitelistHandler := connect.NewUnaryHandler(
+		GameServerServiceManagePlayerWhitelistProcedure,
+		svc.ManagePlayerWhitelist,
+		connect.WithSchema(gameServerServiceMethods.ByName("ManagePlayerWhitelist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceManagePlayerBanListHandler := connect.NewUnaryHandler(
+		GameServerServiceManagePlayerBanListProcedure,
+		svc.ManagePlayerBanList,
+		connect.WithSchema(gameServerServiceMethods.ByName("ManagePlayerBanList")),
+		connect.WithHandlerOptions(opts...),
+	)
 	gameServerServiceGetGameServerMetricsHandler := connect.NewUnaryHandler(
 		GameServerServiceGetGameServerMetricsProcedure,
 		svc.GetGameServerMetrics,
@@ -1071,6 +1379,18 @@ func NewGameServerServiceHandler(svc GameServerServiceHandler, opts ...connect.H
 		connect.WithSchema(gameServerServiceMethods.ByName("RevokeGameServerFileTransferCredential")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServerServiceAddAuthorizedKeyHandler := connect.NewUnaryHandler(
+		GameServerServiceAddAuthorizedKeyProcedure,
+		svc.AddAuthorizedKey,
+		connect.WithSchema(gameServerServiceMethods.ByName("AddAuthorizedKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceRemoveAuthorizedKeyHandler := connect.NewUnaryHandler(
+		GameServerServiceRemoveAuthorizedKeyProcedure,
+		svc.RemoveAuthorizedKey,
+		connect.WithSchema(gameServerServiceMethods.ByName("RemoveAuthorizedKey")),
+		connect.WithHandlerOptions(opts...),
+	)
 	gameServerServiceGetMinecraftPlayerUUIDHandler := connect.NewUnaryHandler(
 		GameServerServiceGetMinecraftPlayerUUIDProcedure,
 		svc.GetMinecraftPlayerUUID,
@@ -1119,6 +1439,66 @@ func NewGameServerServiceHandler(svc GameServerServiceHandler, opts ...connect.H
 		connect.WithSchema(gameServerServiceMethods.ByName("UpdateMinecraftProjectFile")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServerServiceInstallGameServerModHandler := connect.NewUnaryHandler(
+		GameServerServiceInstallGameServerModProcedure,
+		svc.InstallGameServerMod,
+		connect.WithSchema(gameServerServiceMethods.ByName("InstallGameServerMod")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceUninstallGameServerModHandler := connect.NewUnaryHandler(
+		GameServerServiceUninstallGameServerModProcedure,
+		svc.UninstallGameServerMod,
+		connect.WithSchema(gameServerServiceMethods.ByName("UninstallGameServerMod")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceListGameServerModsHandler := connect.NewUnaryHandler(
+		GameServerServiceListGameServerModsProcedure,
+		svc.ListGameServerMods,
+		connect.WithSchema(gameServerServiceMethods.ByName("ListGameServerMods")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceScheduleGameServerBackupHandler := connect.NewUnaryHandler(
+		GameServerServiceScheduleGameServerBackupProcedure,
+		svc.ScheduleGameServerBackup,
+		connect.WithSchema(gameServerServiceMethods.ByName("ScheduleGameServerBackup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceListGameServerBackupsHandler := connect.NewUnaryHandler(
+		GameServerServiceListGameServerBackupsProcedure,
+		svc.ListGameServerBackups,
+		connect.WithSchema(gameServerServiceMethods.ByName("ListGameServerBackups")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceRestoreGameServerBackupHandler := connect.NewUnaryHandler(
+		GameServerServiceRestoreGameServerBackupProcedure,
+		svc.RestoreGameServerBackup,
+		connect.WithSchema(gameServerServiceMethods.ByName("RestoreGameServerBackup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceSetDiscordIntegrationHandler := connect.NewUnaryHandler(
+		GameServerServiceSetDiscordIntegrationProcedure,
+		svc.SetDiscordIntegration,
+		connect.WithSchema(gameServerServiceMethods.ByName("SetDiscordIntegration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceDeleteDiscordIntegrationHandler := connect.NewUnaryHandler(
+		GameServerServiceDeleteDiscordIntegrationProcedure,
+		svc.DeleteDiscordIntegration,
+		connect.WithSchema(gameServerServiceMethods.ByName("DeleteDiscordIntegration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceSetIdleShutdownPolicyHandler := connect.NewUnaryHandler(
+		GameServerServiceSetIdleShutdownPolicyProcedure,
+		svc.SetIdleShutdownPolicy,
+		connect.WithSchema(gameServerServiceMethods.ByName("SetIdleShutdownPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerServiceGetIdleShutdownPolicyHandler := connect.NewUnaryHandler(
+		GameServerServiceGetIdleShutdownPolicyProcedure,
+		svc.GetIdleShutdownPolicy,
+		connect.WithSchema(gameServerServiceMethods.ByName("GetIdleShutdownPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/obiente.cloud.gameservers.v1.GameServerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GameServerServiceListGameServersProcedure:
@@ -1153,6 +1533,12 @@ func NewGameServerServiceHandler(svc GameServerServiceHandler, opts ...connect.H
 			gameServerServiceGetGameServerLogsHandler.ServeHTTP(w, r)
 		case GameServerServiceStreamGameServerLogsProcedure:
 			gameServerServiceStreamGameServerLogsHandler.ServeHTTP(w, r)
+		case GameServerServiceExecuteGameServerCommandProcedure:
+			gameServerServiceExecuteGameServerCommandHandler.ServeHTTP(w, r)
+		case GameServerServiceManagePlayerWhitelistProcedure:
+			gameServerServiceManagePlayerWhitelistHandler.ServeHTTP(w, r)
+		case GameServerServiceManagePlayerBanListProcedure:
+			gameServerServiceManagePlayerBanListHandler.ServeHTTP(w, r)
 		case GameServerServiceGetGameServerMetricsProcedure:
 			gameServerServiceGetGameServerMetricsHandler.ServeHTTP(w, r)
 		case GameServerServiceStreamGameServerMetricsProcedure:
@@ -1187,6 +1573,10 @@ func NewGameServerServiceHandler(svc GameServerServiceHandler, opts ...connect.H
 			gameServerServiceCreateGameServerFileTransferCredentialHandler.ServeHTTP(w, r)
 		case GameServerServiceRevokeGameServerFileTransferCredentialProcedure:
 			gameServerServiceRevokeGameServerFileTransferCredentialHandler.ServeHTTP(w, r)
+		case GameServerServiceAddAuthorizedKeyProcedure:
+			gameServerServiceAddAuthorizedKeyHandler.ServeHTTP(w, r)
+		case GameServerServiceRemoveAuthorizedKeyProcedure:
+			gameServerServiceRemoveAuthorizedKeyHandler.ServeHTTP(w, r)
 		case GameServerServiceGetMinecraftPlayerUUIDProcedure:
 			gameServerServiceGetMinecraftPlayerUUIDHandler.ServeHTTP(w, r)
 		case GameServerServiceGetMinecraftPlayerProfileProcedure:
@@ -1203,6 +1593,26 @@ func NewGameServerServiceHandler(svc GameServerServiceHandler, opts ...connect.H
 			gameServerServiceInstallMinecraftProjectFileHandler.ServeHTTP(w, r)
 		case GameServerServiceUpdateMinecraftProjectFileProcedure:
 			gameServerServiceUpdateMinecraftProjectFileHandler.ServeHTTP(w, r)
+		case GameServerServiceInstallGameServerModProcedure:
+			gameServerServiceInstallGameServerModHandler.ServeHTTP(w, r)
+		case GameServerServiceUninstallGameServerModProcedure:
+			gameServerServiceUninstallGameServerModHandler.ServeHTTP(w, r)
+		case GameServerServiceListGameServerModsProcedure:
+			gameServerServiceListGameServerModsHandler.ServeHTTP(w, r)
+		case GameServerServiceScheduleGameServerBackupProcedure:
+			gameServerServiceScheduleGameServerBackupHandler.ServeHTTP(w, r)
+		case GameServerServiceListGameServerBackupsProcedure:
+			gameServerServiceListGameServerBackupsHandler.ServeHTTP(w, r)
+		case GameServerServiceRestoreGameServerBackupProcedure:
+			gameServerServiceRestoreGameServerBackupHandler.ServeHTTP(w, r)
+		case GameServerServiceSetDiscordIntegrationProcedure:
+			gameServerServiceSetDiscordIntegrationHandler.ServeHTTP(w, r)
+		case GameServerServiceDeleteDiscordIntegrationProcedure:
+			gameServerServiceDeleteDiscordIntegrationHandler.ServeHTTP(w, r)
+		case GameServerServiceSetIdleShutdownPolicyProcedure:
+			gameServerServiceSetIdleShutdownPolicyHandler.ServeHTTP(w, r)
+		case GameServerServiceGetIdleShutdownPolicyProcedure:
+			gameServerServiceGetIdleShutdownPolicyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1276,6 +1686,18 @@ func (UnimplementedGameServerServiceHandler) StreamGameServerLogs(context.Contex
 	return connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.StreamGameServerLogs is not implemented"))
 }
 
+func (UnimplementedGameServerServiceHandler) ExecuteGameServerCommand(context.Context, *connect.Request[v1.ExecuteGameServerCommandRequest]) (*connect.Response[v1.ExecuteGameServerCommandResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.ExecuteGameServerCommand is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) ManagePlayerWhitelist(context.Context, *connect.Request[v1.ManagePlayerWhitelistRequest]) (*connect.Response[v1.ManagePlayerWhitelistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.ManagePlayerWhitelist is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) ManagePlayerBanList(context.Context, *connect.Request[v1.ManagePlayerBanListRequest]) (*connect.Response[v1.ManagePlayerBanListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.ManagePlayerBanList is not implemented"))
+}
+
 func (UnimplementedGameServerServiceHandler) GetGameServerMetrics(context.Context, *connect.Request[v1.GetGameServerMetricsRequest]) (*connect.Response[v1.GetGameServerMetricsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.GetGameServerMetrics is not implemented"))
 }
@@ -1344,6 +1766,14 @@ func (UnimplementedGameServerServiceHandler) RevokeGameServerFileTransferCredent
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.RevokeGameServerFileTransferCredential is not implemented"))
 }
 
+func (UnimplementedGameServerServiceHandler) AddAuthorizedKey(context.Context, *connect.Request[v1.AddAuthorizedKeyRequest]) (*connect.Response[v1.AddAuthorizedKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.AddAuthorizedKey is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) RemoveAuthorizedKey(context.Context, *connect.Request[v1.RemoveAuthorizedKeyRequest]) (*connect.Response[v1.RemoveAuthorizedKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.RemoveAuthorizedKey is not implemented"))
+}
+
 func (UnimplementedGameServerServiceHandler) GetMinecraftPlayerUUID(context.Context, *connect.Request[v1.GetMinecraftPlayerUUIDRequest]) (*connect.Response[v1.GetMinecraftPlayerUUIDResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.GetMinecraftPlayerUUID is not implemented"))
 }
@@ -1375,3 +1805,43 @@ func (UnimplementedGameServerServiceHandler) InstallMinecraftProjectFile(context
 func (UnimplementedGameServerServiceHandler) UpdateMinecraftProjectFile(context.Context, *connect.Request[v1.UpdateMinecraftProjectFileRequest]) (*connect.Response[v1.UpdateMinecraftProjectFileResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.UpdateMinecraftProjectFile is not implemented"))
 }
+
+func (UnimplementedGameServerServiceHandler) InstallGameServerMod(context.Context, *connect.Request[v1.InstallGameServerModRequest]) (*connect.Response[v1.InstallGameServerModResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.InstallGameServerMod is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) UninstallGameServerMod(context.Context, *connect.Request[v1.UninstallGameServerModRequest]) (*connect.Response[v1.UninstallGameServerModResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.UninstallGameServerMod is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) ListGameServerMods(context.Context, *connect.Request[v1.ListGameServerModsRequest]) (*connect.Response[v1.ListGameServerModsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.ListGameServerMods is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) ScheduleGameServerBackup(context.Context, *connect.Request[v1.ScheduleGameServerBackupRequest]) (*connect.Response[v1.ScheduleGameServerBackupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.ScheduleGameServerBackup is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) ListGameServerBackups(context.Context, *connect.Request[v1.ListGameServerBackupsRequest]) (*connect.Response[v1.ListGameServerBackupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.ListGameServerBackups is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) RestoreGameServerBackup(context.Context, *connect.Request[v1.RestoreGameServerBackupRequest]) (*connect.Response[v1.RestoreGameServerBackupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.RestoreGameServerBackup is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) SetDiscordIntegration(context.Context, *connect.Request[v1.SetDiscordIntegrationRequest]) (*connect.Response[v1.SetDiscordIntegrationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.SetDiscordIntegration is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) DeleteDiscordIntegration(context.Context, *connect.Request[v1.DeleteDiscordIntegrationRequest]) (*connect.Response[v1.DeleteDiscordIntegrationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.DeleteDiscordIntegration is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) SetIdleShutdownPolicy(context.Context, *connect.Request[v1.SetIdleShutdownPolicyRequest]) (*connect.Response[v1.SetIdleShutdownPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.SetIdleShutdownPolicy is not implemented"))
+}
+
+func (UnimplementedGameServerServiceHandler) GetIdleShutdownPolicy(context.Context, *connect.Request[v1.GetIdleShutdownPolicyRequest]) (*connect.Response[v1.GetIdleShutdownPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.gameservers.v1.GameServerService.GetIdleShutdownPolicy is not implemented"))
+}
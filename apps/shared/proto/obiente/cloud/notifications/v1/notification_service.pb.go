@@ -203,6 +203,55 @@ func (NotificationFrequency) EnumDescriptor() ([]byte, []int) {
 	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{2}
 }
 
+type WebhookChannelType int32
+
+const (
+	WebhookChannelType_WEBHOOK_CHANNEL_TYPE_UNSPECIFIED WebhookChannelType = 0
+	WebhookChannelType_WEBHOOK_CHANNEL_TYPE_SLACK       WebhookChannelType = 1
+	WebhookChannelType_WEBHOOK_CHANNEL_TYPE_DISCORD     WebhookChannelType = 2
+)
+
+// Enum value maps for WebhookChannelType.
+var (
+	WebhookChannelType_name = map[int32]string{
+		0: "WEBHOOK_CHANNEL_TYPE_UNSPECIFIED",
+		1: "WEBHOOK_CHANNEL_TYPE_SLACK",
+		2: "WEBHOOK_CHANNEL_TYPE_DISCORD",
+	}
+	WebhookChannelType_value = map[string]int32{
+		"WEBHOOK_CHANNEL_TYPE_UNSPECIFIED": 0,
+		"WEBHOOK_CHANNEL_TYPE_SLACK":       1,
+		"WEBHOOK_CHANNEL_TYPE_DISCORD":     2,
+	}
+)
+
+func (x WebhookChannelType) Enum() *WebhookChannelType {
+	p := new(WebhookChannelType)
+	*p = x
+	return p
+}
+
+func (x WebhookChannelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookChannelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_enumTypes[3].Descriptor()
+}
+
+func (WebhookChannelType) Type() protoreflect.EnumType {
+	return &file_obiente_cloud_notifications_v1_notification_service_proto_enumTypes[3]
+}
+
+func (x WebhookChannelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookChannelType.Descriptor instead.
+func (WebhookChannelType) EnumDescriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{3}
+}
+
 type ListNotificationsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional filters
@@ -210,10 +259,12 @@ type ListNotificationsRequest struct {
 	Type       *NotificationType     `protobuf:"varint,2,opt,name=type,proto3,enum=obiente.cloud.notifications.v1.NotificationType,oneof" json:"type,omitempty"`
 	Severity   *NotificationSeverity `protobuf:"varint,3,opt,name=severity,proto3,enum=obiente.cloud.notifications.v1.NotificationSeverity,oneof" json:"severity,omitempty"`
 	// Pagination
-	Page          int32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       int32 `protobuf:"varint,5,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Page    int32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage int32 `protobuf:"varint,5,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	// Also list deleted notifications that are not purged yet
+	IncludeDeleted *bool `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3,oneof" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListNotificationsRequest) Reset() {
@@ -281,6 +332,13 @@ func (x *ListNotificationsRequest) GetPerPage() int32 {
 	return 0
 }
 
+func (x *ListNotificationsRequest) GetIncludeDeleted() bool {
+	if x != nil && x.IncludeDeleted != nil {
+		return *x.IncludeDeleted
+	}
+	return false
+}
+
 type ListNotificationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
@@ -791,6 +849,199 @@ func (x *DeleteAllNotificationsResponse) GetDeletedCount() int32 {
 	return 0
 }
 
+type ArchiveNotificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ArchiveNotificationRequest) Reset() {
+	*x = ArchiveNotificationRequest{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveNotificationRequest) ProtoMessage() {}
+
+func (x *ArchiveNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveNotificationRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ArchiveNotificationRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+type ArchiveNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *Notification          `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveNotificationResponse) Reset() {
+	*x = ArchiveNotificationResponse{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveNotificationResponse) ProtoMessage() {}
+
+func (x *ArchiveNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveNotificationResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ArchiveNotificationResponse) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+type ListArchivedNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pagination
+	Page          int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArchivedNotificationsRequest) Reset() {
+	*x = ListArchivedNotificationsRequest{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArchivedNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedNotificationsRequest) ProtoMessage() {}
+
+func (x *ListArchivedNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListArchivedNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListArchivedNotificationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListArchivedNotificationsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type ListArchivedNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	Pagination    *v1.Pagination         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArchivedNotificationsResponse) Reset() {
+	*x = ListArchivedNotificationsResponse{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArchivedNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedNotificationsResponse) ProtoMessage() {}
+
+func (x *ListArchivedNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListArchivedNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListArchivedNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListArchivedNotificationsResponse) GetPagination() *v1.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 type GetUnreadCountRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional filters
@@ -802,7 +1053,7 @@ type GetUnreadCountRequest struct {
 
 func (x *GetUnreadCountRequest) Reset() {
 	*x = GetUnreadCountRequest{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[12]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -814,7 +1065,7 @@ func (x *GetUnreadCountRequest) String() string {
 func (*GetUnreadCountRequest) ProtoMessage() {}
 
 func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[12]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +1078,7 @@ func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
 func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{12}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetUnreadCountRequest) GetType() NotificationType {
@@ -853,7 +1104,7 @@ type GetUnreadCountResponse struct {
 
 func (x *GetUnreadCountResponse) Reset() {
 	*x = GetUnreadCountResponse{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[13]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -865,7 +1116,7 @@ func (x *GetUnreadCountResponse) String() string {
 func (*GetUnreadCountResponse) ProtoMessage() {}
 
 func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[13]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1129,7 @@ func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUnreadCountResponse.ProtoReflect.Descriptor instead.
 func (*GetUnreadCountResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{13}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetUnreadCountResponse) GetCount() int32 {
@@ -905,7 +1156,7 @@ type CreateNotificationRequest struct {
 
 func (x *CreateNotificationRequest) Reset() {
 	*x = CreateNotificationRequest{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[14]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -917,7 +1168,7 @@ func (x *CreateNotificationRequest) String() string {
 func (*CreateNotificationRequest) ProtoMessage() {}
 
 func (x *CreateNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[14]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,7 +1181,7 @@ func (x *CreateNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNotificationRequest.ProtoReflect.Descriptor instead.
 func (*CreateNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{14}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateNotificationRequest) GetUserId() string {
@@ -1005,7 +1256,7 @@ type CreateNotificationResponse struct {
 
 func (x *CreateNotificationResponse) Reset() {
 	*x = CreateNotificationResponse{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[15]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1017,7 +1268,7 @@ func (x *CreateNotificationResponse) String() string {
 func (*CreateNotificationResponse) ProtoMessage() {}
 
 func (x *CreateNotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[15]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,7 +1281,7 @@ func (x *CreateNotificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNotificationResponse.ProtoReflect.Descriptor instead.
 func (*CreateNotificationResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{15}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateNotificationResponse) GetNotification() *Notification {
@@ -1058,7 +1309,7 @@ type CreateOrganizationNotificationRequest struct {
 
 func (x *CreateOrganizationNotificationRequest) Reset() {
 	*x = CreateOrganizationNotificationRequest{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[16]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1070,7 +1321,7 @@ func (x *CreateOrganizationNotificationRequest) String() string {
 func (*CreateOrganizationNotificationRequest) ProtoMessage() {}
 
 func (x *CreateOrganizationNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[16]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1334,7 @@ func (x *CreateOrganizationNotificationRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use CreateOrganizationNotificationRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrganizationNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{16}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CreateOrganizationNotificationRequest) GetOrganizationId() string {
@@ -1159,7 +1410,7 @@ type CreateOrganizationNotificationResponse struct {
 
 func (x *CreateOrganizationNotificationResponse) Reset() {
 	*x = CreateOrganizationNotificationResponse{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[17]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1171,7 +1422,7 @@ func (x *CreateOrganizationNotificationResponse) String() string {
 func (*CreateOrganizationNotificationResponse) ProtoMessage() {}
 
 func (x *CreateOrganizationNotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[17]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1184,7 +1435,7 @@ func (x *CreateOrganizationNotificationResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use CreateOrganizationNotificationResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrganizationNotificationResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{17}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CreateOrganizationNotificationResponse) GetCreatedCount() int32 {
@@ -1218,13 +1469,15 @@ type Notification struct {
 	ClientOnly     bool                   `protobuf:"varint,13,opt,name=client_only,json=clientOnly,proto3" json:"client_only,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ArchivedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	DeletedAt      *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Notification) Reset() {
 	*x = Notification{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[18]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1236,7 +1489,7 @@ func (x *Notification) String() string {
 func (*Notification) ProtoMessage() {}
 
 func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[18]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,7 +1502,7 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Notification.ProtoReflect.Descriptor instead.
 func (*Notification) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{18}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Notification) GetId() string {
@@ -1357,6 +1610,20 @@ func (x *Notification) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Notification) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 type GetNotificationTypesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1365,7 +1632,7 @@ type GetNotificationTypesRequest struct {
 
 func (x *GetNotificationTypesRequest) Reset() {
 	*x = GetNotificationTypesRequest{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[19]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1377,7 +1644,7 @@ func (x *GetNotificationTypesRequest) String() string {
 func (*GetNotificationTypesRequest) ProtoMessage() {}
 
 func (x *GetNotificationTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[19]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1390,7 +1657,7 @@ func (x *GetNotificationTypesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotificationTypesRequest.ProtoReflect.Descriptor instead.
 func (*GetNotificationTypesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{19}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{23}
 }
 
 type GetNotificationTypesResponse struct {
@@ -1402,7 +1669,7 @@ type GetNotificationTypesResponse struct {
 
 func (x *GetNotificationTypesResponse) Reset() {
 	*x = GetNotificationTypesResponse{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[20]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1414,7 +1681,7 @@ func (x *GetNotificationTypesResponse) String() string {
 func (*GetNotificationTypesResponse) ProtoMessage() {}
 
 func (x *GetNotificationTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[20]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1427,7 +1694,7 @@ func (x *GetNotificationTypesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotificationTypesResponse.ProtoReflect.Descriptor instead.
 func (*GetNotificationTypesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{20}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetNotificationTypesResponse) GetTypes() []*NotificationTypeInfo {
@@ -1451,7 +1718,7 @@ type NotificationTypeInfo struct {
 
 func (x *NotificationTypeInfo) Reset() {
 	*x = NotificationTypeInfo{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[21]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1463,7 +1730,7 @@ func (x *NotificationTypeInfo) String() string {
 func (*NotificationTypeInfo) ProtoMessage() {}
 
 func (x *NotificationTypeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[21]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1476,7 +1743,7 @@ func (x *NotificationTypeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationTypeInfo.ProtoReflect.Descriptor instead.
 func (*NotificationTypeInfo) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{21}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *NotificationTypeInfo) GetType() NotificationType {
@@ -1529,7 +1796,7 @@ type GetNotificationPreferencesRequest struct {
 
 func (x *GetNotificationPreferencesRequest) Reset() {
 	*x = GetNotificationPreferencesRequest{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[22]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1541,7 +1808,7 @@ func (x *GetNotificationPreferencesRequest) String() string {
 func (*GetNotificationPreferencesRequest) ProtoMessage() {}
 
 func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[22]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1554,19 +1821,20 @@ func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
 func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{22}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{26}
 }
 
 type GetNotificationPreferencesResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences    []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	SmsPhoneNumber *string                   `protobuf:"bytes,2,opt,name=sms_phone_number,json=smsPhoneNumber,proto3,oneof" json:"sms_phone_number,omitempty"` // Number SMS alerts are sent to, if set
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetNotificationPreferencesResponse) Reset() {
 	*x = GetNotificationPreferencesResponse{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[23]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1578,7 +1846,7 @@ func (x *GetNotificationPreferencesResponse) String() string {
 func (*GetNotificationPreferencesResponse) ProtoMessage() {}
 
 func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[23]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1591,7 +1859,7 @@ func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
 func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{23}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetNotificationPreferencesResponse) GetPreferences() []*NotificationPreference {
@@ -1601,16 +1869,24 @@ func (x *GetNotificationPreferencesResponse) GetPreferences() []*NotificationPre
 	return nil
 }
 
+func (x *GetNotificationPreferencesResponse) GetSmsPhoneNumber() string {
+	if x != nil && x.SmsPhoneNumber != nil {
+		return *x.SmsPhoneNumber
+	}
+	return ""
+}
+
 type UpdateNotificationPreferencesRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences    []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	SmsPhoneNumber *string                   `protobuf:"bytes,2,opt,name=sms_phone_number,json=smsPhoneNumber,proto3,oneof" json:"sms_phone_number,omitempty"` // E.164 number for SMS alerts; an empty string removes it
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateNotificationPreferencesRequest) Reset() {
 	*x = UpdateNotificationPreferencesRequest{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[24]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1622,7 +1898,7 @@ func (x *UpdateNotificationPreferencesRequest) String() string {
 func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
 
 func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[24]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +1911,7 @@ func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
 func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{24}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateNotificationPreferencesRequest) GetPreferences() []*NotificationPreference {
@@ -1645,16 +1921,24 @@ func (x *UpdateNotificationPreferencesRequest) GetPreferences() []*NotificationP
 	return nil
 }
 
+func (x *UpdateNotificationPreferencesRequest) GetSmsPhoneNumber() string {
+	if x != nil && x.SmsPhoneNumber != nil {
+		return *x.SmsPhoneNumber
+	}
+	return ""
+}
+
 type UpdateNotificationPreferencesResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences    []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	SmsPhoneNumber *string                   `protobuf:"bytes,2,opt,name=sms_phone_number,json=smsPhoneNumber,proto3,oneof" json:"sms_phone_number,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateNotificationPreferencesResponse) Reset() {
 	*x = UpdateNotificationPreferencesResponse{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[25]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1666,7 +1950,7 @@ func (x *UpdateNotificationPreferencesResponse) String() string {
 func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
 
 func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[25]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,7 +1963,7 @@ func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
 func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{25}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateNotificationPreferencesResponse) GetPreferences() []*NotificationPreference {
@@ -1689,6 +1973,13 @@ func (x *UpdateNotificationPreferencesResponse) GetPreferences() []*Notification
 	return nil
 }
 
+func (x *UpdateNotificationPreferencesResponse) GetSmsPhoneNumber() string {
+	if x != nil && x.SmsPhoneNumber != nil {
+		return *x.SmsPhoneNumber
+	}
+	return ""
+}
+
 type NotificationPreference struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	NotificationType NotificationType       `protobuf:"varint,1,opt,name=notification_type,json=notificationType,proto3,enum=obiente.cloud.notifications.v1.NotificationType" json:"notification_type,omitempty"`
@@ -1696,13 +1987,14 @@ type NotificationPreference struct {
 	InAppEnabled     bool                   `protobuf:"varint,3,opt,name=in_app_enabled,json=inAppEnabled,proto3" json:"in_app_enabled,omitempty"`
 	Frequency        NotificationFrequency  `protobuf:"varint,4,opt,name=frequency,proto3,enum=obiente.cloud.notifications.v1.NotificationFrequency" json:"frequency,omitempty"`
 	MinSeverity      NotificationSeverity   `protobuf:"varint,5,opt,name=min_severity,json=minSeverity,proto3,enum=obiente.cloud.notifications.v1.NotificationSeverity" json:"min_severity,omitempty"`
+	SmsEnabled       bool                   `protobuf:"varint,6,opt,name=sms_enabled,json=smsEnabled,proto3" json:"sms_enabled,omitempty"` // Only CRITICAL notifications are sent by SMS
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *NotificationPreference) Reset() {
 	*x = NotificationPreference{}
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[26]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1714,7 +2006,7 @@ func (x *NotificationPreference) String() string {
 func (*NotificationPreference) ProtoMessage() {}
 
 func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[26]
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1727,7 +2019,7 @@ func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
 func (*NotificationPreference) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{26}
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *NotificationPreference) GetNotificationType() NotificationType {
@@ -1765,21 +2057,681 @@ func (x *NotificationPreference) GetMinSeverity() NotificationSeverity {
 	return NotificationSeverity_NOTIFICATION_SEVERITY_UNSPECIFIED
 }
 
+func (x *NotificationPreference) GetSmsEnabled() bool {
+	if x != nil {
+		return x.SmsEnabled
+	}
+	return false
+}
+
+type GetNotificationDigestPolicyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetNotificationDigestPolicyRequest) Reset() {
+	*x = GetNotificationDigestPolicyRequest{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationDigestPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationDigestPolicyRequest) ProtoMessage() {}
+
+func (x *GetNotificationDigestPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationDigestPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationDigestPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetNotificationDigestPolicyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetNotificationDigestPolicyResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Policy        *NotificationDigestPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationDigestPolicyResponse) Reset() {
+	*x = GetNotificationDigestPolicyResponse{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationDigestPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationDigestPolicyResponse) ProtoMessage() {}
+
+func (x *GetNotificationDigestPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationDigestPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationDigestPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetNotificationDigestPolicyResponse) GetPolicy() *NotificationDigestPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetNotificationDigestPolicyRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId    string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Enabled           bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	NotificationTypes []NotificationType     `protobuf:"varint,3,rep,packed,name=notification_types,json=notificationTypes,proto3,enum=obiente.cloud.notifications.v1.NotificationType" json:"notification_types,omitempty"` // Types to group; empty groups every type
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetNotificationDigestPolicyRequest) Reset() {
+	*x = SetNotificationDigestPolicyRequest{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationDigestPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationDigestPolicyRequest) ProtoMessage() {}
+
+func (x *SetNotificationDigestPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationDigestPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationDigestPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetNotificationDigestPolicyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetNotificationDigestPolicyRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetNotificationDigestPolicyRequest) GetNotificationTypes() []NotificationType {
+	if x != nil {
+		return x.NotificationTypes
+	}
+	return nil
+}
+
+type SetNotificationDigestPolicyResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Policy        *NotificationDigestPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationDigestPolicyResponse) Reset() {
+	*x = SetNotificationDigestPolicyResponse{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationDigestPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationDigestPolicyResponse) ProtoMessage() {}
+
+func (x *SetNotificationDigestPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationDigestPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetNotificationDigestPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetNotificationDigestPolicyResponse) GetPolicy() *NotificationDigestPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Groups an organization's notifications of the same type into one digest per window.
+// CRITICAL notifications are always delivered immediately.
+type NotificationDigestPolicy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId    string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Enabled           bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	NotificationTypes []NotificationType     `protobuf:"varint,3,rep,packed,name=notification_types,json=notificationTypes,proto3,enum=obiente.cloud.notifications.v1.NotificationType" json:"notification_types,omitempty"` // Types to group; empty groups every type
+	WindowMinutes     int32                  `protobuf:"varint,4,opt,name=window_minutes,json=windowMinutes,proto3" json:"window_minutes,omitempty"`                                                                         // Length of the grouping window
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NotificationDigestPolicy) Reset() {
+	*x = NotificationDigestPolicy{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationDigestPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationDigestPolicy) ProtoMessage() {}
+
+func (x *NotificationDigestPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationDigestPolicy.ProtoReflect.Descriptor instead.
+func (*NotificationDigestPolicy) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *NotificationDigestPolicy) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *NotificationDigestPolicy) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NotificationDigestPolicy) GetNotificationTypes() []NotificationType {
+	if x != nil {
+		return x.NotificationTypes
+	}
+	return nil
+}
+
+func (x *NotificationDigestPolicy) GetWindowMinutes() int32 {
+	if x != nil {
+		return x.WindowMinutes
+	}
+	return 0
+}
+
+func (x *NotificationDigestPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateWebhookConfigRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ChannelType      WebhookChannelType     `protobuf:"varint,2,opt,name=channel_type,json=channelType,proto3,enum=obiente.cloud.notifications.v1.WebhookChannelType" json:"channel_type,omitempty"`
+	WebhookUrl       string                 `protobuf:"bytes,3,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`                                                                                    // Slack incoming webhook or Discord channel webhook URL
+	NotifySeverities []NotificationSeverity `protobuf:"varint,4,rep,packed,name=notify_severities,json=notifySeverities,proto3,enum=obiente.cloud.notifications.v1.NotificationSeverity" json:"notify_severities,omitempty"` // Severities to deliver; empty delivers every severity
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateWebhookConfigRequest) Reset() {
+	*x = CreateWebhookConfigRequest{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookConfigRequest) ProtoMessage() {}
+
+func (x *CreateWebhookConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookConfigRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookConfigRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateWebhookConfigRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateWebhookConfigRequest) GetChannelType() WebhookChannelType {
+	if x != nil {
+		return x.ChannelType
+	}
+	return WebhookChannelType_WEBHOOK_CHANNEL_TYPE_UNSPECIFIED
+}
+
+func (x *CreateWebhookConfigRequest) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *CreateWebhookConfigRequest) GetNotifySeverities() []NotificationSeverity {
+	if x != nil {
+		return x.NotifySeverities
+	}
+	return nil
+}
+
+type CreateWebhookConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *WebhookConfig         `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookConfigResponse) Reset() {
+	*x = CreateWebhookConfigResponse{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookConfigResponse) ProtoMessage() {}
+
+func (x *CreateWebhookConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookConfigResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookConfigResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateWebhookConfigResponse) GetConfig() *WebhookConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type DeleteWebhookConfigRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ConfigId       string                 `protobuf:"bytes,2,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookConfigRequest) Reset() {
+	*x = DeleteWebhookConfigRequest{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookConfigRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookConfigRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteWebhookConfigRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteWebhookConfigRequest) GetConfigId() string {
+	if x != nil {
+		return x.ConfigId
+	}
+	return ""
+}
+
+type DeleteWebhookConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookConfigResponse) Reset() {
+	*x = DeleteWebhookConfigResponse{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookConfigResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookConfigResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookConfigResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteWebhookConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListWebhookConfigsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListWebhookConfigsRequest) Reset() {
+	*x = ListWebhookConfigsRequest{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookConfigsRequest) ProtoMessage() {}
+
+func (x *ListWebhookConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookConfigsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListWebhookConfigsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListWebhookConfigsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*WebhookConfig       `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookConfigsResponse) Reset() {
+	*x = ListWebhookConfigsResponse{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookConfigsResponse) ProtoMessage() {}
+
+func (x *ListWebhookConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookConfigsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListWebhookConfigsResponse) GetConfigs() []*WebhookConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+// Posts an organization's notifications to a Slack or Discord webhook
+type WebhookConfig struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId   string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ChannelType      WebhookChannelType     `protobuf:"varint,3,opt,name=channel_type,json=channelType,proto3,enum=obiente.cloud.notifications.v1.WebhookChannelType" json:"channel_type,omitempty"`
+	WebhookUrl       string                 `protobuf:"bytes,4,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`                                                                                    // Redacted; only the host and the last characters are shown
+	NotifySeverities []NotificationSeverity `protobuf:"varint,5,rep,packed,name=notify_severities,json=notifySeverities,proto3,enum=obiente.cloud.notifications.v1.NotificationSeverity" json:"notify_severities,omitempty"` // Severities to deliver; empty delivers every severity
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WebhookConfig) Reset() {
+	*x = WebhookConfig{}
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookConfig) ProtoMessage() {}
+
+func (x *WebhookConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookConfig.ProtoReflect.Descriptor instead.
+func (*WebhookConfig) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WebhookConfig) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookConfig) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *WebhookConfig) GetChannelType() WebhookChannelType {
+	if x != nil {
+		return x.ChannelType
+	}
+	return WebhookChannelType_WEBHOOK_CHANNEL_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookConfig) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *WebhookConfig) GetNotifySeverities() []NotificationSeverity {
+	if x != nil {
+		return x.NotifySeverities
+	}
+	return nil
+}
+
+func (x *WebhookConfig) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 var File_obiente_cloud_notifications_v1_notification_service_proto protoreflect.FileDescriptor
 
 const file_obiente_cloud_notifications_v1_notification_service_proto_rawDesc = "" +
 	"\n" +
-	"9obiente/cloud/notifications/v1/notification_service.proto\x12\x1eobiente.cloud.notifications.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$obiente/cloud/common/v1/common.proto\"\xb7\x02\n" +
+	"9obiente/cloud/notifications/v1/notification_service.proto\x12\x1eobiente.cloud.notifications.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$obiente/cloud/common/v1/common.proto\"\xf9\x02\n" +
 	"\x18ListNotificationsRequest\x12$\n" +
 	"\vunread_only\x18\x01 \x01(\bH\x00R\n" +
 	"unreadOnly\x88\x01\x01\x12I\n" +
 	"\x04type\x18\x02 \x01(\x0e20.obiente.cloud.notifications.v1.NotificationTypeH\x01R\x04type\x88\x01\x01\x12U\n" +
 	"\bseverity\x18\x03 \x01(\x0e24.obiente.cloud.notifications.v1.NotificationSeverityH\x02R\bseverity\x88\x01\x01\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x05 \x01(\x05R\aperPageB\x0e\n" +
+	"\bper_page\x18\x05 \x01(\x05R\aperPage\x12,\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bH\x03R\x0eincludeDeleted\x88\x01\x01B\x0e\n" +
 	"\f_unread_onlyB\a\n" +
 	"\x05_typeB\v\n" +
-	"\t_severity\"\xb4\x01\n" +
+	"\t_severityB\x12\n" +
+	"\x10_include_deleted\"\xb4\x01\n" +
 	"\x19ListNotificationsResponse\x12R\n" +
 	"\rnotifications\x18\x01 \x03(\v2,.obiente.cloud.notifications.v1.NotificationR\rnotifications\x12C\n" +
 	"\n" +
@@ -1811,7 +2763,19 @@ const file_obiente_cloud_notifications_v1_notification_service_proto_rawDesc = "
 	"_read_onlyB\a\n" +
 	"\x05_type\"E\n" +
 	"\x1eDeleteAllNotificationsResponse\x12#\n" +
-	"\rdeleted_count\x18\x01 \x01(\x05R\fdeletedCount\"\xda\x01\n" +
+	"\rdeleted_count\x18\x01 \x01(\x05R\fdeletedCount\"E\n" +
+	"\x1aArchiveNotificationRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\"o\n" +
+	"\x1bArchiveNotificationResponse\x12P\n" +
+	"\fnotification\x18\x01 \x01(\v2,.obiente.cloud.notifications.v1.NotificationR\fnotification\"Q\n" +
+	" ListArchivedNotificationsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\"\xbc\x01\n" +
+	"!ListArchivedNotificationsResponse\x12R\n" +
+	"\rnotifications\x18\x01 \x03(\v2,.obiente.cloud.notifications.v1.NotificationR\rnotifications\x12C\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2#.obiente.cloud.common.v1.PaginationR\n" +
+	"pagination\"\xda\x01\n" +
 	"\x15GetUnreadCountRequest\x12I\n" +
 	"\x04type\x18\x01 \x01(\x0e20.obiente.cloud.notifications.v1.NotificationTypeH\x00R\x04type\x88\x01\x01\x12\\\n" +
 	"\fmin_severity\x18\x02 \x01(\x0e24.obiente.cloud.notifications.v1.NotificationSeverityH\x01R\vminSeverity\x88\x01\x01B\a\n" +
@@ -1856,7 +2820,7 @@ const file_obiente_cloud_notifications_v1_notification_service_proto_rawDesc = "
 	"\r_action_label\"\xa1\x01\n" +
 	"&CreateOrganizationNotificationResponse\x12#\n" +
 	"\rcreated_count\x18\x01 \x01(\x05R\fcreatedCount\x12R\n" +
-	"\rnotifications\x18\x02 \x03(\v2,.obiente.cloud.notifications.v1.NotificationR\rnotifications\"\xb3\x06\n" +
+	"\rnotifications\x18\x02 \x03(\v2,.obiente.cloud.notifications.v1.NotificationR\rnotifications\"\xd4\a\n" +
 	"\fNotification\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12,\n" +
@@ -1877,7 +2841,11 @@ const file_obiente_cloud_notifications_v1_notification_service_proto_rawDesc = "
 	"\n" +
 	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a;\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12@\n" +
+	"\varchived_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\n" +
+	"archivedAt\x88\x01\x01\x12>\n" +
+	"\n" +
+	"deleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampH\x05R\tdeletedAt\x88\x01\x01\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x12\n" +
@@ -1885,7 +2853,9 @@ const file_obiente_cloud_notifications_v1_notification_service_proto_rawDesc = "
 	"\n" +
 	"\b_read_atB\r\n" +
 	"\v_action_urlB\x0f\n" +
-	"\r_action_label\"\x1d\n" +
+	"\r_action_labelB\x0e\n" +
+	"\f_archived_atB\r\n" +
+	"\v_deleted_at\"\x1d\n" +
 	"\x1bGetNotificationTypesRequest\"j\n" +
 	"\x1cGetNotificationTypesResponse\x12J\n" +
 	"\x05types\x18\x01 \x03(\v24.obiente.cloud.notifications.v1.NotificationTypeInfoR\x05types\"\xe3\x02\n" +
@@ -1896,19 +2866,71 @@ const file_obiente_cloud_notifications_v1_notification_service_proto_rawDesc = "
 	"\x15default_email_enabled\x18\x04 \x01(\bR\x13defaultEmailEnabled\x123\n" +
 	"\x16default_in_app_enabled\x18\x05 \x01(\bR\x13defaultInAppEnabled\x12f\n" +
 	"\x14default_min_severity\x18\x06 \x01(\x0e24.obiente.cloud.notifications.v1.NotificationSeverityR\x12defaultMinSeverity\"#\n" +
-	"!GetNotificationPreferencesRequest\"~\n" +
+	"!GetNotificationPreferencesRequest\"\xc2\x01\n" +
 	"\"GetNotificationPreferencesResponse\x12X\n" +
-	"\vpreferences\x18\x01 \x03(\v26.obiente.cloud.notifications.v1.NotificationPreferenceR\vpreferences\"\x80\x01\n" +
+	"\vpreferences\x18\x01 \x03(\v26.obiente.cloud.notifications.v1.NotificationPreferenceR\vpreferences\x12-\n" +
+	"\x10sms_phone_number\x18\x02 \x01(\tH\x00R\x0esmsPhoneNumber\x88\x01\x01B\x13\n" +
+	"\x11_sms_phone_number\"\xc4\x01\n" +
 	"$UpdateNotificationPreferencesRequest\x12X\n" +
-	"\vpreferences\x18\x01 \x03(\v26.obiente.cloud.notifications.v1.NotificationPreferenceR\vpreferences\"\x81\x01\n" +
+	"\vpreferences\x18\x01 \x03(\v26.obiente.cloud.notifications.v1.NotificationPreferenceR\vpreferences\x12-\n" +
+	"\x10sms_phone_number\x18\x02 \x01(\tH\x00R\x0esmsPhoneNumber\x88\x01\x01B\x13\n" +
+	"\x11_sms_phone_number\"\xc5\x01\n" +
 	"%UpdateNotificationPreferencesResponse\x12X\n" +
-	"\vpreferences\x18\x01 \x03(\v26.obiente.cloud.notifications.v1.NotificationPreferenceR\vpreferences\"\xf0\x02\n" +
+	"\vpreferences\x18\x01 \x03(\v26.obiente.cloud.notifications.v1.NotificationPreferenceR\vpreferences\x12-\n" +
+	"\x10sms_phone_number\x18\x02 \x01(\tH\x00R\x0esmsPhoneNumber\x88\x01\x01B\x13\n" +
+	"\x11_sms_phone_number\"\x91\x03\n" +
 	"\x16NotificationPreference\x12]\n" +
 	"\x11notification_type\x18\x01 \x01(\x0e20.obiente.cloud.notifications.v1.NotificationTypeR\x10notificationType\x12#\n" +
 	"\remail_enabled\x18\x02 \x01(\bR\femailEnabled\x12$\n" +
 	"\x0ein_app_enabled\x18\x03 \x01(\bR\finAppEnabled\x12S\n" +
 	"\tfrequency\x18\x04 \x01(\x0e25.obiente.cloud.notifications.v1.NotificationFrequencyR\tfrequency\x12W\n" +
-	"\fmin_severity\x18\x05 \x01(\x0e24.obiente.cloud.notifications.v1.NotificationSeverityR\vminSeverity*\xc6\x02\n" +
+	"\fmin_severity\x18\x05 \x01(\x0e24.obiente.cloud.notifications.v1.NotificationSeverityR\vminSeverity\x12\x1f\n" +
+	"\vsms_enabled\x18\x06 \x01(\bR\n" +
+	"smsEnabled\"M\n" +
+	"\"GetNotificationDigestPolicyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"w\n" +
+	"#GetNotificationDigestPolicyResponse\x12P\n" +
+	"\x06policy\x18\x01 \x01(\v28.obiente.cloud.notifications.v1.NotificationDigestPolicyR\x06policy\"\xc8\x01\n" +
+	"\"SetNotificationDigestPolicyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12_\n" +
+	"\x12notification_types\x18\x03 \x03(\x0e20.obiente.cloud.notifications.v1.NotificationTypeR\x11notificationTypes\"w\n" +
+	"#SetNotificationDigestPolicyResponse\x12P\n" +
+	"\x06policy\x18\x01 \x01(\v28.obiente.cloud.notifications.v1.NotificationDigestPolicyR\x06policy\"\xb4\x02\n" +
+	"\x18NotificationDigestPolicy\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12_\n" +
+	"\x12notification_types\x18\x03 \x03(\x0e20.obiente.cloud.notifications.v1.NotificationTypeR\x11notificationTypes\x12%\n" +
+	"\x0ewindow_minutes\x18\x04 \x01(\x05R\rwindowMinutes\x12>\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tupdatedAt\x88\x01\x01B\r\n" +
+	"\v_updated_at\"\xa0\x02\n" +
+	"\x1aCreateWebhookConfigRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12U\n" +
+	"\fchannel_type\x18\x02 \x01(\x0e22.obiente.cloud.notifications.v1.WebhookChannelTypeR\vchannelType\x12\x1f\n" +
+	"\vwebhook_url\x18\x03 \x01(\tR\n" +
+	"webhookUrl\x12a\n" +
+	"\x11notify_severities\x18\x04 \x03(\x0e24.obiente.cloud.notifications.v1.NotificationSeverityR\x10notifySeverities\"d\n" +
+	"\x1bCreateWebhookConfigResponse\x12E\n" +
+	"\x06config\x18\x01 \x01(\v2-.obiente.cloud.notifications.v1.WebhookConfigR\x06config\"b\n" +
+	"\x1aDeleteWebhookConfigRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tconfig_id\x18\x02 \x01(\tR\bconfigId\"7\n" +
+	"\x1bDeleteWebhookConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"D\n" +
+	"\x19ListWebhookConfigsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"e\n" +
+	"\x1aListWebhookConfigsResponse\x12G\n" +
+	"\aconfigs\x18\x01 \x03(\v2-.obiente.cloud.notifications.v1.WebhookConfigR\aconfigs\"\xde\x02\n" +
+	"\rWebhookConfig\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12U\n" +
+	"\fchannel_type\x18\x03 \x01(\x0e22.obiente.cloud.notifications.v1.WebhookChannelTypeR\vchannelType\x12\x1f\n" +
+	"\vwebhook_url\x18\x04 \x01(\tR\n" +
+	"webhookUrl\x12a\n" +
+	"\x11notify_severities\x18\x05 \x03(\x0e24.obiente.cloud.notifications.v1.NotificationSeverityR\x10notifySeverities\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt*\xc6\x02\n" +
 	"\x10NotificationType\x12!\n" +
 	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16NOTIFICATION_TYPE_INFO\x10\x01\x12\x1d\n" +
@@ -1931,7 +2953,11 @@ const file_obiente_cloud_notifications_v1_notification_service_proto_rawDesc = "
 	" NOTIFICATION_FREQUENCY_IMMEDIATE\x10\x01\x12 \n" +
 	"\x1cNOTIFICATION_FREQUENCY_DAILY\x10\x02\x12!\n" +
 	"\x1dNOTIFICATION_FREQUENCY_WEEKLY\x10\x03\x12 \n" +
-	"\x1cNOTIFICATION_FREQUENCY_NEVER\x10\x042\xea\r\n" +
+	"\x1cNOTIFICATION_FREQUENCY_NEVER\x10\x04*|\n" +
+	"\x12WebhookChannelType\x12$\n" +
+	" WEBHOOK_CHANNEL_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aWEBHOOK_CHANNEL_TYPE_SLACK\x10\x01\x12 \n" +
+	"\x1cWEBHOOK_CHANNEL_TYPE_DISCORD\x10\x022\xa0\x16\n" +
 	"\x13NotificationService\x12\x88\x01\n" +
 	"\x11ListNotifications\x128.obiente.cloud.notifications.v1.ListNotificationsRequest\x1a9.obiente.cloud.notifications.v1.ListNotificationsResponse\x12\x82\x01\n" +
 	"\x0fGetNotification\x126.obiente.cloud.notifications.v1.GetNotificationRequest\x1a7.obiente.cloud.notifications.v1.GetNotificationResponse\x12s\n" +
@@ -1939,13 +2965,20 @@ const file_obiente_cloud_notifications_v1_notification_service_proto_rawDesc = "
 	"MarkAsRead\x121.obiente.cloud.notifications.v1.MarkAsReadRequest\x1a2.obiente.cloud.notifications.v1.MarkAsReadResponse\x12|\n" +
 	"\rMarkAllAsRead\x124.obiente.cloud.notifications.v1.MarkAllAsReadRequest\x1a5.obiente.cloud.notifications.v1.MarkAllAsReadResponse\x12\x8b\x01\n" +
 	"\x12DeleteNotification\x129.obiente.cloud.notifications.v1.DeleteNotificationRequest\x1a:.obiente.cloud.notifications.v1.DeleteNotificationResponse\x12\x97\x01\n" +
-	"\x16DeleteAllNotifications\x12=.obiente.cloud.notifications.v1.DeleteAllNotificationsRequest\x1a>.obiente.cloud.notifications.v1.DeleteAllNotificationsResponse\x12\x7f\n" +
+	"\x16DeleteAllNotifications\x12=.obiente.cloud.notifications.v1.DeleteAllNotificationsRequest\x1a>.obiente.cloud.notifications.v1.DeleteAllNotificationsResponse\x12\x8e\x01\n" +
+	"\x13ArchiveNotification\x12:.obiente.cloud.notifications.v1.ArchiveNotificationRequest\x1a;.obiente.cloud.notifications.v1.ArchiveNotificationResponse\x12\xa0\x01\n" +
+	"\x19ListArchivedNotifications\x12@.obiente.cloud.notifications.v1.ListArchivedNotificationsRequest\x1aA.obiente.cloud.notifications.v1.ListArchivedNotificationsResponse\x12\x7f\n" +
 	"\x0eGetUnreadCount\x125.obiente.cloud.notifications.v1.GetUnreadCountRequest\x1a6.obiente.cloud.notifications.v1.GetUnreadCountResponse\x12\x8b\x01\n" +
 	"\x12CreateNotification\x129.obiente.cloud.notifications.v1.CreateNotificationRequest\x1a:.obiente.cloud.notifications.v1.CreateNotificationResponse\x12\xaf\x01\n" +
 	"\x1eCreateOrganizationNotification\x12E.obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest\x1aF.obiente.cloud.notifications.v1.CreateOrganizationNotificationResponse\x12\x91\x01\n" +
 	"\x14GetNotificationTypes\x12;.obiente.cloud.notifications.v1.GetNotificationTypesRequest\x1a<.obiente.cloud.notifications.v1.GetNotificationTypesResponse\x12\xa3\x01\n" +
 	"\x1aGetNotificationPreferences\x12A.obiente.cloud.notifications.v1.GetNotificationPreferencesRequest\x1aB.obiente.cloud.notifications.v1.GetNotificationPreferencesResponse\x12\xac\x01\n" +
-	"\x1dUpdateNotificationPreferences\x12D.obiente.cloud.notifications.v1.UpdateNotificationPreferencesRequest\x1aE.obiente.cloud.notifications.v1.UpdateNotificationPreferencesResponseB[ZYgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1;notificationsv1b\x06proto3"
+	"\x1dUpdateNotificationPreferences\x12D.obiente.cloud.notifications.v1.UpdateNotificationPreferencesRequest\x1aE.obiente.cloud.notifications.v1.UpdateNotificationPreferencesResponse\x12\xa6\x01\n" +
+	"\x1bGetNotificationDigestPolicy\x12B.obiente.cloud.notifications.v1.GetNotificationDigestPolicyRequest\x1aC.obiente.cloud.notifications.v1.GetNotificationDigestPolicyResponse\x12\xa6\x01\n" +
+	"\x1bSetNotificationDigestPolicy\x12B.obiente.cloud.notifications.v1.SetNotificationDigestPolicyRequest\x1aC.obiente.cloud.notifications.v1.SetNotificationDigestPolicyResponse\x12\x8e\x01\n" +
+	"\x13CreateWebhookConfig\x12:.obiente.cloud.notifications.v1.CreateWebhookConfigRequest\x1a;.obiente.cloud.notifications.v1.CreateWebhookConfigResponse\x12\x8e\x01\n" +
+	"\x13DeleteWebhookConfig\x12:.obiente.cloud.notifications.v1.DeleteWebhookConfigRequest\x1a;.obiente.cloud.notifications.v1.DeleteWebhookConfigResponse\x12\x8b\x01\n" +
+	"\x12ListWebhookConfigs\x129.obiente.cloud.notifications.v1.ListWebhookConfigsRequest\x1a:.obiente.cloud.notifications.v1.ListWebhookConfigsResponseB[ZYgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1;notificationsv1b\x06proto3"
 
 var (
 	file_obiente_cloud_notifications_v1_notification_service_proto_rawDescOnce sync.Once
@@ -1959,109 +2992,157 @@ func file_obiente_cloud_notifications_v1_notification_service_proto_rawDescGZIP(
 	return file_obiente_cloud_notifications_v1_notification_service_proto_rawDescData
 }
 
-var file_obiente_cloud_notifications_v1_notification_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_obiente_cloud_notifications_v1_notification_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_obiente_cloud_notifications_v1_notification_service_proto_goTypes = []any{
 	(NotificationType)(0),                          // 0: obiente.cloud.notifications.v1.NotificationType
 	(NotificationSeverity)(0),                      // 1: obiente.cloud.notifications.v1.NotificationSeverity
 	(NotificationFrequency)(0),                     // 2: obiente.cloud.notifications.v1.NotificationFrequency
-	(*ListNotificationsRequest)(nil),               // 3: obiente.cloud.notifications.v1.ListNotificationsRequest
-	(*ListNotificationsResponse)(nil),              // 4: obiente.cloud.notifications.v1.ListNotificationsResponse
-	(*GetNotificationRequest)(nil),                 // 5: obiente.cloud.notifications.v1.GetNotificationRequest
-	(*GetNotificationResponse)(nil),                // 6: obiente.cloud.notifications.v1.GetNotificationResponse
-	(*MarkAsReadRequest)(nil),                      // 7: obiente.cloud.notifications.v1.MarkAsReadRequest
-	(*MarkAsReadResponse)(nil),                     // 8: obiente.cloud.notifications.v1.MarkAsReadResponse
-	(*MarkAllAsReadRequest)(nil),                   // 9: obiente.cloud.notifications.v1.MarkAllAsReadRequest
-	(*MarkAllAsReadResponse)(nil),                  // 10: obiente.cloud.notifications.v1.MarkAllAsReadResponse
-	(*DeleteNotificationRequest)(nil),              // 11: obiente.cloud.notifications.v1.DeleteNotificationRequest
-	(*DeleteNotificationResponse)(nil),             // 12: obiente.cloud.notifications.v1.DeleteNotificationResponse
-	(*DeleteAllNotificationsRequest)(nil),          // 13: obiente.cloud.notifications.v1.DeleteAllNotificationsRequest
-	(*DeleteAllNotificationsResponse)(nil),         // 14: obiente.cloud.notifications.v1.DeleteAllNotificationsResponse
-	(*GetUnreadCountRequest)(nil),                  // 15: obiente.cloud.notifications.v1.GetUnreadCountRequest
-	(*GetUnreadCountResponse)(nil),                 // 16: obiente.cloud.notifications.v1.GetUnreadCountResponse
-	(*CreateNotificationRequest)(nil),              // 17: obiente.cloud.notifications.v1.CreateNotificationRequest
-	(*CreateNotificationResponse)(nil),             // 18: obiente.cloud.notifications.v1.CreateNotificationResponse
-	(*CreateOrganizationNotificationRequest)(nil),  // 19: obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest
-	(*CreateOrganizationNotificationResponse)(nil), // 20: obiente.cloud.notifications.v1.CreateOrganizationNotificationResponse
-	(*Notification)(nil),                           // 21: obiente.cloud.notifications.v1.Notification
-	(*GetNotificationTypesRequest)(nil),            // 22: obiente.cloud.notifications.v1.GetNotificationTypesRequest
-	(*GetNotificationTypesResponse)(nil),           // 23: obiente.cloud.notifications.v1.GetNotificationTypesResponse
-	(*NotificationTypeInfo)(nil),                   // 24: obiente.cloud.notifications.v1.NotificationTypeInfo
-	(*GetNotificationPreferencesRequest)(nil),      // 25: obiente.cloud.notifications.v1.GetNotificationPreferencesRequest
-	(*GetNotificationPreferencesResponse)(nil),     // 26: obiente.cloud.notifications.v1.GetNotificationPreferencesResponse
-	(*UpdateNotificationPreferencesRequest)(nil),   // 27: obiente.cloud.notifications.v1.UpdateNotificationPreferencesRequest
-	(*UpdateNotificationPreferencesResponse)(nil),  // 28: obiente.cloud.notifications.v1.UpdateNotificationPreferencesResponse
-	(*NotificationPreference)(nil),                 // 29: obiente.cloud.notifications.v1.NotificationPreference
-	nil,                                            // 30: obiente.cloud.notifications.v1.CreateNotificationRequest.MetadataEntry
-	nil,                                            // 31: obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest.MetadataEntry
-	nil,                                            // 32: obiente.cloud.notifications.v1.Notification.MetadataEntry
-	(*v1.Pagination)(nil),                          // 33: obiente.cloud.common.v1.Pagination
-	(*timestamppb.Timestamp)(nil),                  // 34: google.protobuf.Timestamp
+	(WebhookChannelType)(0),                        // 3: obiente.cloud.notifications.v1.WebhookChannelType
+	(*ListNotificationsRequest)(nil),               // 4: obiente.cloud.notifications.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),              // 5: obiente.cloud.notifications.v1.ListNotificationsResponse
+	(*GetNotificationRequest)(nil),                 // 6: obiente.cloud.notifications.v1.GetNotificationRequest
+	(*GetNotificationResponse)(nil),                // 7: obiente.cloud.notifications.v1.GetNotificationResponse
+	(*MarkAsReadRequest)(nil),                      // 8: obiente.cloud.notifications.v1.MarkAsReadRequest
+	(*MarkAsReadResponse)(nil),                     // 9: obiente.cloud.notifications.v1.MarkAsReadResponse
+	(*MarkAllAsReadRequest)(nil),                   // 10: obiente.cloud.notifications.v1.MarkAllAsReadRequest
+	(*MarkAllAsReadResponse)(nil),                  // 11: obiente.cloud.notifications.v1.MarkAllAsReadResponse
+	(*DeleteNotificationRequest)(nil),              // 12: obiente.cloud.notifications.v1.DeleteNotificationRequest
+	(*DeleteNotificationResponse)(nil),             // 13: obiente.cloud.notifications.v1.DeleteNotificationResponse
+	(*DeleteAllNotificationsRequest)(nil),          // 14: obiente.cloud.notifications.v1.DeleteAllNotificationsRequest
+	(*DeleteAllNotificationsResponse)(nil),         // 15: obiente.cloud.notifications.v1.DeleteAllNotificationsResponse
+	(*ArchiveNotificationRequest)(nil),             // 16: obiente.cloud.notifications.v1.ArchiveNotificationRequest
+	(*ArchiveNotificationResponse)(nil),            // 17: obiente.cloud.notifications.v1.ArchiveNotificationResponse
+	(*ListArchivedNotificationsRequest)(nil),       // 18: obiente.cloud.notifications.v1.ListArchivedNotificationsRequest
+	(*ListArchivedNotificationsResponse)(nil),      // 19: obiente.cloud.notifications.v1.ListArchivedNotificationsResponse
+	(*GetUnreadCountRequest)(nil),                  // 20: obiente.cloud.notifications.v1.GetUnreadCountRequest
+	(*GetUnreadCountResponse)(nil),                 // 21: obiente.cloud.notifications.v1.GetUnreadCountResponse
+	(*CreateNotificationRequest)(nil),              // 22: obiente.cloud.notifications.v1.CreateNotificationRequest
+	(*CreateNotificationResponse)(nil),             // 23: obiente.cloud.notifications.v1.CreateNotificationResponse
+	(*CreateOrganizationNotificationRequest)(nil),  // 24: obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest
+	(*CreateOrganizationNotificationResponse)(nil), // 25: obiente.cloud.notifications.v1.CreateOrganizationNotificationResponse
+	(*Notification)(nil),                           // 26: obiente.cloud.notifications.v1.Notification
+	(*GetNotificationTypesRequest)(nil),            // 27: obiente.cloud.notifications.v1.GetNotificationTypesRequest
+	(*GetNotificationTypesResponse)(nil),           // 28: obiente.cloud.notifications.v1.GetNotificationTypesResponse
+	(*NotificationTypeInfo)(nil),                   // 29: obiente.cloud.notifications.v1.NotificationTypeInfo
+	(*GetNotificationPreferencesRequest)(nil),      // 30: obiente.cloud.notifications.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),     // 31: obiente.cloud.notifications.v1.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),   // 32: obiente.cloud.notifications.v1.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil),  // 33: obiente.cloud.notifications.v1.UpdateNotificationPreferencesResponse
+	(*NotificationPreference)(nil),                 // 34: obiente.cloud.notifications.v1.NotificationPreference
+	(*GetNotificationDigestPolicyRequest)(nil),     // 35: obiente.cloud.notifications.v1.GetNotificationDigestPolicyRequest
+	(*GetNotificationDigestPolicyResponse)(nil),    // 36: obiente.cloud.notifications.v1.GetNotificationDigestPolicyResponse
+	(*SetNotificationDigestPolicyRequest)(nil),     // 37: obiente.cloud.notifications.v1.SetNotificationDigestPolicyRequest
+	(*SetNotificationDigestPolicyResponse)(nil),    // 38: obiente.cloud.notifications.v1.SetNotificationDigestPolicyResponse
+	(*NotificationDigestPolicy)(nil),               // 39: obiente.cloud.notifications.v1.NotificationDigestPolicy
+	(*CreateWebhookConfigRequest)(nil),             // 40: obiente.cloud.notifications.v1.CreateWebhookConfigRequest
+	(*CreateWebhookConfigResponse)(nil),            // 41: obiente.cloud.notifications.v1.CreateWebhookConfigResponse
+	(*DeleteWebhookConfigRequest)(nil),             // 42: obiente.cloud.notifications.v1.DeleteWebhookConfigRequest
+	(*DeleteWebhookConfigResponse)(nil),            // 43: obiente.cloud.notifications.v1.DeleteWebhookConfigResponse
+	(*ListWebhookConfigsRequest)(nil),              // 44: obiente.cloud.notifications.v1.ListWebhookConfigsRequest
+	(*ListWebhookConfigsResponse)(nil),             // 45: obiente.cloud.notifications.v1.ListWebhookConfigsResponse
+	(*WebhookConfig)(nil),                          // 46: obiente.cloud.notifications.v1.WebhookConfig
+	nil,                                            // 47: obiente.cloud.notifications.v1.CreateNotificationRequest.MetadataEntry
+	nil,                                            // 48: obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest.MetadataEntry
+	nil,                                            // 49: obiente.cloud.notifications.v1.Notification.MetadataEntry
+	(*v1.Pagination)(nil),                          // 50: obiente.cloud.common.v1.Pagination
+	(*timestamppb.Timestamp)(nil),                  // 51: google.protobuf.Timestamp
 }
 var file_obiente_cloud_notifications_v1_notification_service_proto_depIdxs = []int32{
 	0,  // 0: obiente.cloud.notifications.v1.ListNotificationsRequest.type:type_name -> obiente.cloud.notifications.v1.NotificationType
 	1,  // 1: obiente.cloud.notifications.v1.ListNotificationsRequest.severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
-	21, // 2: obiente.cloud.notifications.v1.ListNotificationsResponse.notifications:type_name -> obiente.cloud.notifications.v1.Notification
-	33, // 3: obiente.cloud.notifications.v1.ListNotificationsResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
-	21, // 4: obiente.cloud.notifications.v1.GetNotificationResponse.notification:type_name -> obiente.cloud.notifications.v1.Notification
-	21, // 5: obiente.cloud.notifications.v1.MarkAsReadResponse.notification:type_name -> obiente.cloud.notifications.v1.Notification
+	26, // 2: obiente.cloud.notifications.v1.ListNotificationsResponse.notifications:type_name -> obiente.cloud.notifications.v1.Notification
+	50, // 3: obiente.cloud.notifications.v1.ListNotificationsResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	26, // 4: obiente.cloud.notifications.v1.GetNotificationResponse.notification:type_name -> obiente.cloud.notifications.v1.Notification
+	26, // 5: obiente.cloud.notifications.v1.MarkAsReadResponse.notification:type_name -> obiente.cloud.notifications.v1.Notification
 	0,  // 6: obiente.cloud.notifications.v1.MarkAllAsReadRequest.type:type_name -> obiente.cloud.notifications.v1.NotificationType
 	1,  // 7: obiente.cloud.notifications.v1.MarkAllAsReadRequest.severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
 	0,  // 8: obiente.cloud.notifications.v1.DeleteAllNotificationsRequest.type:type_name -> obiente.cloud.notifications.v1.NotificationType
-	0,  // 9: obiente.cloud.notifications.v1.GetUnreadCountRequest.type:type_name -> obiente.cloud.notifications.v1.NotificationType
-	1,  // 10: obiente.cloud.notifications.v1.GetUnreadCountRequest.min_severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
-	0,  // 11: obiente.cloud.notifications.v1.CreateNotificationRequest.type:type_name -> obiente.cloud.notifications.v1.NotificationType
-	1,  // 12: obiente.cloud.notifications.v1.CreateNotificationRequest.severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
-	30, // 13: obiente.cloud.notifications.v1.CreateNotificationRequest.metadata:type_name -> obiente.cloud.notifications.v1.CreateNotificationRequest.MetadataEntry
-	21, // 14: obiente.cloud.notifications.v1.CreateNotificationResponse.notification:type_name -> obiente.cloud.notifications.v1.Notification
-	0,  // 15: obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest.type:type_name -> obiente.cloud.notifications.v1.NotificationType
-	1,  // 16: obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest.severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
-	31, // 17: obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest.metadata:type_name -> obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest.MetadataEntry
-	21, // 18: obiente.cloud.notifications.v1.CreateOrganizationNotificationResponse.notifications:type_name -> obiente.cloud.notifications.v1.Notification
-	0,  // 19: obiente.cloud.notifications.v1.Notification.type:type_name -> obiente.cloud.notifications.v1.NotificationType
-	1,  // 20: obiente.cloud.notifications.v1.Notification.severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
-	34, // 21: obiente.cloud.notifications.v1.Notification.read_at:type_name -> google.protobuf.Timestamp
-	32, // 22: obiente.cloud.notifications.v1.Notification.metadata:type_name -> obiente.cloud.notifications.v1.Notification.MetadataEntry
-	34, // 23: obiente.cloud.notifications.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
-	34, // 24: obiente.cloud.notifications.v1.Notification.updated_at:type_name -> google.protobuf.Timestamp
-	24, // 25: obiente.cloud.notifications.v1.GetNotificationTypesResponse.types:type_name -> obiente.cloud.notifications.v1.NotificationTypeInfo
-	0,  // 26: obiente.cloud.notifications.v1.NotificationTypeInfo.type:type_name -> obiente.cloud.notifications.v1.NotificationType
-	1,  // 27: obiente.cloud.notifications.v1.NotificationTypeInfo.default_min_severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
-	29, // 28: obiente.cloud.notifications.v1.GetNotificationPreferencesResponse.preferences:type_name -> obiente.cloud.notifications.v1.NotificationPreference
-	29, // 29: obiente.cloud.notifications.v1.UpdateNotificationPreferencesRequest.preferences:type_name -> obiente.cloud.notifications.v1.NotificationPreference
-	29, // 30: obiente.cloud.notifications.v1.UpdateNotificationPreferencesResponse.preferences:type_name -> obiente.cloud.notifications.v1.NotificationPreference
-	0,  // 31: obiente.cloud.notifications.v1.NotificationPreference.notification_type:type_name -> obiente.cloud.notifications.v1.NotificationType
-	2,  // 32: obiente.cloud.notifications.v1.NotificationPreference.frequency:type_name -> obiente.cloud.notifications.v1.NotificationFrequency
-	1,  // 33: obiente.cloud.notifications.v1.NotificationPreference.min_severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
-	3,  // 34: obiente.cloud.notifications.v1.NotificationService.ListNotifications:input_type -> obiente.cloud.notifications.v1.ListNotificationsRequest
-	5,  // 35: obiente.cloud.notifications.v1.NotificationService.GetNotification:input_type -> obiente.cloud.notifications.v1.GetNotificationRequest
-	7,  // 36: obiente.cloud.notifications.v1.NotificationService.MarkAsRead:input_type -> obiente.cloud.notifications.v1.MarkAsReadRequest
-	9,  // 37: obiente.cloud.notifications.v1.NotificationService.MarkAllAsRead:input_type -> obiente.cloud.notifications.v1.MarkAllAsReadRequest
-	11, // 38: obiente.cloud.notifications.v1.NotificationService.DeleteNotification:input_type -> obiente.cloud.notifications.v1.DeleteNotificationRequest
-	13, // 39: obiente.cloud.notifications.v1.NotificationService.DeleteAllNotifications:input_type -> obiente.cloud.notifications.v1.DeleteAllNotificationsRequest
-	15, // 40: obiente.cloud.notifications.v1.NotificationService.GetUnreadCount:input_type -> obiente.cloud.notifications.v1.GetUnreadCountRequest
-	17, // 41: obiente.cloud.notifications.v1.NotificationService.CreateNotification:input_type -> obiente.cloud.notifications.v1.CreateNotificationRequest
-	19, // 42: obiente.cloud.notifications.v1.NotificationService.CreateOrganizationNotification:input_type -> obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest
-	22, // 43: obiente.cloud.notifications.v1.NotificationService.GetNotificationTypes:input_type -> obiente.cloud.notifications.v1.GetNotificationTypesRequest
-	25, // 44: obiente.cloud.notifications.v1.NotificationService.GetNotificationPreferences:input_type -> obiente.cloud.notifications.v1.GetNotificationPreferencesRequest
-	27, // 45: obiente.cloud.notifications.v1.NotificationService.UpdateNotificationPreferences:input_type -> obiente.cloud.notifications.v1.UpdateNotificationPreferencesRequest
-	4,  // 46: obiente.cloud.notifications.v1.NotificationService.ListNotifications:output_type -> obiente.cloud.notifications.v1.ListNotificationsResponse
-	6,  // 47: obiente.cloud.notifications.v1.NotificationService.GetNotification:output_type -> obiente.cloud.notifications.v1.GetNotificationResponse
-	8,  // 48: obiente.cloud.notifications.v1.NotificationService.MarkAsRead:output_type -> obiente.cloud.notifications.v1.MarkAsReadResponse
-	10, // 49: obiente.cloud.notifications.v1.NotificationService.MarkAllAsRead:output_type -> obiente.cloud.notifications.v1.MarkAllAsReadResponse
-	12, // 50: obiente.cloud.notifications.v1.NotificationService.DeleteNotification:output_type -> obiente.cloud.notifications.v1.DeleteNotificationResponse
-	14, // 51: obiente.cloud.notifications.v1.NotificationService.DeleteAllNotifications:output_type -> obiente.cloud.notifications.v1.DeleteAllNotificationsResponse
-	16, // 52: obiente.cloud.notifications.v1.NotificationService.GetUnreadCount:output_type -> obiente.cloud.notifications.v1.GetUnreadCountResponse
-	18, // 53: obiente.cloud.notifications.v1.NotificationService.CreateNotification:output_type -> obiente.cloud.notifications.v1.CreateNotificationResponse
-	20, // 54: obiente.cloud.notifications.v1.NotificationService.CreateOrganizationNotification:output_type -> obiente.cloud.notifications.v1.CreateOrganizationNotificationResponse
-	23, // 55: obiente.cloud.notifications.v1.NotificationService.GetNotificationTypes:output_type -> obiente.cloud.notifications.v1.GetNotificationTypesResponse
-	26, // 56: obiente.cloud.notifications.v1.NotificationService.GetNotificationPreferences:output_type -> obiente.cloud.notifications.v1.GetNotificationPreferencesResponse
-	28, // 57: obiente.cloud.notifications.v1.NotificationService.UpdateNotificationPreferences:output_type -> obiente.cloud.notifications.v1.UpdateNotificationPreferencesResponse
-	46, // [46:58] is the sub-list for method output_type
-	34, // [34:46] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	26, // 9: obiente.cloud.notifications.v1.ArchiveNotificationResponse.notification:type_name -> obiente.cloud.notifications.v1.Notification
+	26, // 10: obiente.cloud.notifications.v1.ListArchivedNotificationsResponse.notifications:type_name -> obiente.cloud.notifications.v1.Notification
+	50, // 11: obiente.cloud.notifications.v1.ListArchivedNotificationsResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	0,  // 12: obiente.cloud.notifications.v1.GetUnreadCountRequest.type:type_name -> obiente.cloud.notifications.v1.NotificationType
+	1,  // 13: obiente.cloud.notifications.v1.GetUnreadCountRequest.min_severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
+	0,  // 14: obiente.cloud.notifications.v1.CreateNotificationRequest.type:type_name -> obiente.cloud.notifications.v1.NotificationType
+	1,  // 15: obiente.cloud.notifications.v1.CreateNotificationRequest.severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
+	47, // 16: obiente.cloud.notifications.v1.CreateNotificationRequest.metadata:type_name -> obiente.cloud.notifications.v1.CreateNotificationRequest.MetadataEntry
+	26, // 17: obiente.cloud.notifications.v1.CreateNotificationResponse.notification:type_name -> obiente.cloud.notifications.v1.Notification
+	0,  // 18: obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest.type:type_name -> obiente.cloud.notifications.v1.NotificationType
+	1,  // 19: obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest.severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
+	48, // 20: obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest.metadata:type_name -> obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest.MetadataEntry
+	26, // 21: obiente.cloud.notifications.v1.CreateOrganizationNotificationResponse.notifications:type_name -> obiente.cloud.notifications.v1.Notification
+	0,  // 22: obiente.cloud.notifications.v1.Notification.type:type_name -> obiente.cloud.notifications.v1.NotificationType
+	1,  // 23: obiente.cloud.notifications.v1.Notification.severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
+	51, // 24: obiente.cloud.notifications.v1.Notification.read_at:type_name -> google.protobuf.Timestamp
+	49, // 25: obiente.cloud.notifications.v1.Notification.metadata:type_name -> obiente.cloud.notifications.v1.Notification.MetadataEntry
+	51, // 26: obiente.cloud.notifications.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	51, // 27: obiente.cloud.notifications.v1.Notification.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 28: obiente.cloud.notifications.v1.Notification.archived_at:type_name -> google.protobuf.Timestamp
+	51, // 29: obiente.cloud.notifications.v1.Notification.deleted_at:type_name -> google.protobuf.Timestamp
+	29, // 30: obiente.cloud.notifications.v1.GetNotificationTypesResponse.types:type_name -> obiente.cloud.notifications.v1.NotificationTypeInfo
+	0,  // 31: obiente.cloud.notifications.v1.NotificationTypeInfo.type:type_name -> obiente.cloud.notifications.v1.NotificationType
+	1,  // 32: obiente.cloud.notifications.v1.NotificationTypeInfo.default_min_severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
+	34, // 33: obiente.cloud.notifications.v1.GetNotificationPreferencesResponse.preferences:type_name -> obiente.cloud.notifications.v1.NotificationPreference
+	34, // 34: obiente.cloud.notifications.v1.UpdateNotificationPreferencesRequest.preferences:type_name -> obiente.cloud.notifications.v1.NotificationPreference
+	34, // 35: obiente.cloud.notifications.v1.UpdateNotificationPreferencesResponse.preferences:type_name -> obiente.cloud.notifications.v1.NotificationPreference
+	0,  // 36: obiente.cloud.notifications.v1.NotificationPreference.notification_type:type_name -> obiente.cloud.notifications.v1.NotificationType
+	2,  // 37: obiente.cloud.notifications.v1.NotificationPreference.frequency:type_name -> obiente.cloud.notifications.v1.NotificationFrequency
+	1,  // 38: obiente.cloud.notifications.v1.NotificationPreference.min_severity:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
+	39, // 39: obiente.cloud.notifications.v1.GetNotificationDigestPolicyResponse.policy:type_name -> obiente.cloud.notifications.v1.NotificationDigestPolicy
+	0,  // 40: obiente.cloud.notifications.v1.SetNotificationDigestPolicyRequest.notification_types:type_name -> obiente.cloud.notifications.v1.NotificationType
+	39, // 41: obiente.cloud.notifications.v1.SetNotificationDigestPolicyResponse.policy:type_name -> obiente.cloud.notifications.v1.NotificationDigestPolicy
+	0,  // 42: obiente.cloud.notifications.v1.NotificationDigestPolicy.notification_types:type_name -> obiente.cloud.notifications.v1.NotificationType
+	51, // 43: obiente.cloud.notifications.v1.NotificationDigestPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 44: obiente.cloud.notifications.v1.CreateWebhookConfigRequest.channel_type:type_name -> obiente.cloud.notifications.v1.WebhookChannelType
+	1,  // 45: obiente.cloud.notifications.v1.CreateWebhookConfigRequest.notify_severities:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
+	46, // 46: obiente.cloud.notifications.v1.CreateWebhookConfigResponse.config:type_name -> obiente.cloud.notifications.v1.WebhookConfig
+	46, // 47: obiente.cloud.notifications.v1.ListWebhookConfigsResponse.configs:type_name -> obiente.cloud.notifications.v1.WebhookConfig
+	3,  // 48: obiente.cloud.notifications.v1.WebhookConfig.channel_type:type_name -> obiente.cloud.notifications.v1.WebhookChannelType
+	1,  // 49: obiente.cloud.notifications.v1.WebhookConfig.notify_severities:type_name -> obiente.cloud.notifications.v1.NotificationSeverity
+	51, // 50: obiente.cloud.notifications.v1.WebhookConfig.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 51: obiente.cloud.notifications.v1.NotificationService.ListNotifications:input_type -> obiente.cloud.notifications.v1.ListNotificationsRequest
+	6,  // 52: obiente.cloud.notifications.v1.NotificationService.GetNotification:input_type -> obiente.cloud.notifications.v1.GetNotificationRequest
+	8,  // 53: obiente.cloud.notifications.v1.NotificationService.MarkAsRead:input_type -> obiente.cloud.notifications.v1.MarkAsReadRequest
+	10, // 54: obiente.cloud.notifications.v1.NotificationService.MarkAllAsRead:input_type -> obiente.cloud.notifications.v1.MarkAllAsReadRequest
+	12, // 55: obiente.cloud.notifications.v1.NotificationService.DeleteNotification:input_type -> obiente.cloud.notifications.v1.DeleteNotificationRequest
+	14, // 56: obiente.cloud.notifications.v1.NotificationService.DeleteAllNotifications:input_type -> obiente.cloud.notifications.v1.DeleteAllNotificationsRequest
+	16, // 57: obiente.cloud.notifications.v1.NotificationService.ArchiveNotification:input_type -> obiente.cloud.notifications.v1.ArchiveNotificationRequest
+	18, // 58: obiente.cloud.notifications.v1.NotificationService.ListArchivedNotifications:input_type -> obiente.cloud.notifications.v1.ListArchivedNotificationsRequest
+	20, // 59: obiente.cloud.notifications.v1.NotificationService.GetUnreadCount:input_type -> obiente.cloud.notifications.v1.GetUnreadCountRequest
+	22, // 60: obiente.cloud.notifications.v1.NotificationService.CreateNotification:input_type -> obiente.cloud.notifications.v1.CreateNotificationRequest
+	24, // 61: obiente.cloud.notifications.v1.NotificationService.CreateOrganizationNotification:input_type -> obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest
+	27, // 62: obiente.cloud.notifications.v1.NotificationService.GetNotificationTypes:input_type -> obiente.cloud.notifications.v1.GetNotificationTypesRequest
+	30, // 63: obiente.cloud.notifications.v1.NotificationService.GetNotificationPreferences:input_type -> obiente.cloud.notifications.v1.GetNotificationPreferencesRequest
+	32, // 64: obiente.cloud.notifications.v1.NotificationService.UpdateNotificationPreferences:input_type -> obiente.cloud.notifications.v1.UpdateNotificationPreferencesRequest
+	35, // 65: obiente.cloud.notifications.v1.NotificationService.GetNotificationDigestPolicy:input_type -> obiente.cloud.notifications.v1.GetNotificationDigestPolicyRequest
+	37, // 66: obiente.cloud.notifications.v1.NotificationService.SetNotificationDigestPolicy:input_type -> obiente.cloud.notifications.v1.SetNotificationDigestPolicyRequest
+	40, // 67: obiente.cloud.notifications.v1.NotificationService.CreateWebhookConfig:input_type -> obiente.cloud.notifications.v1.CreateWebhookConfigRequest
+	42, // 68: obiente.cloud.notifications.v1.NotificationService.DeleteWebhookConfig:input_type -> obiente.cloud.notifications.v1.DeleteWebhookConfigRequest
+	44, // 69: obiente.cloud.notifications.v1.NotificationService.ListWebhookConfigs:input_type -> obiente.cloud.notifications.v1.ListWebhookConfigsRequest
+	5,  // 70: obiente.cloud.notifications.v1.NotificationService.ListNotifications:output_type -> obiente.cloud.notifications.v1.ListNotificationsResponse
+	7,  // 71: obiente.cloud.notifications.v1.NotificationService.GetNotification:output_type -> obiente.cloud.notifications.v1.GetNotificationResponse
+	9,  // 72: obiente.cloud.notifications.v1.NotificationService.MarkAsRead:output_type -> obiente.cloud.notifications.v1.MarkAsReadResponse
+	11, // 73: obiente.cloud.notifications.v1.NotificationService.MarkAllAsRead:output_type -> obiente.cloud.notifications.v1.MarkAllAsReadResponse
+	13, // 74: obiente.cloud.notifications.v1.NotificationService.DeleteNotification:output_type -> obiente.cloud.notifications.v1.DeleteNotificationResponse
+	15, // 75: obiente.cloud.notifications.v1.NotificationService.DeleteAllNotifications:output_type -> obiente.cloud.notifications.v1.DeleteAllNotificationsResponse
+	17, // 76: obiente.cloud.notifications.v1.NotificationService.ArchiveNotification:output_type -> obiente.cloud.notifications.v1.ArchiveNotificationResponse
+	19, // 77: obiente.cloud.notifications.v1.NotificationService.ListArchivedNotifications:output_type -> obiente.cloud.notifications.v1.ListArchivedNotificationsResponse
+	21, // 78: obiente.cloud.notifications.v1.NotificationService.GetUnreadCount:output_type -> obiente.cloud.notifications.v1.GetUnreadCountResponse
+	23, // 79: obiente.cloud.notifications.v1.NotificationService.CreateNotification:output_type -> obiente.cloud.notifications.v1.CreateNotificationResponse
+	25, // 80: obiente.cloud.notifications.v1.NotificationService.CreateOrganizationNotification:output_type -> obiente.cloud.notifications.v1.CreateOrganizationNotificationResponse
+	28, // 81: obiente.cloud.notifications.v1.NotificationService.GetNotificationTypes:output_type -> obiente.cloud.notifications.v1.GetNotificationTypesResponse
+	31, // 82: obiente.cloud.notifications.v1.NotificationService.GetNotificationPreferences:output_type -> obiente.cloud.notifications.v1.GetNotificationPreferencesResponse
+	33, // 83: obiente.cloud.notifications.v1.NotificationService.UpdateNotificationPreferences:output_type -> obiente.cloud.notifications.v1.UpdateNotificationPreferencesResponse
+	36, // 84: obiente.cloud.notifications.v1.NotificationService.GetNotificationDigestPolicy:output_type -> obiente.cloud.notifications.v1.GetNotificationDigestPolicyResponse
+	38, // 85: obiente.cloud.notifications.v1.NotificationService.SetNotificationDigestPolicy:output_type -> obiente.cloud.notifications.v1.SetNotificationDigestPolicyResponse
+	41, // 86: obiente.cloud.notifications.v1.NotificationService.CreateWebhookConfig:output_type -> obiente.cloud.notifications.v1.CreateWebhookConfigResponse
+	43, // 87: obiente.cloud.notifications.v1.NotificationService.DeleteWebhookConfig:output_type -> obiente.cloud.notifications.v1.DeleteWebhookConfigResponse
+	45, // 88: obiente.cloud.notifications.v1.NotificationService.ListWebhookConfigs:output_type -> obiente.cloud.notifications.v1.ListWebhookConfigsResponse
+	70, // [70:89] is the sub-list for method output_type
+	51, // [51:70] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_obiente_cloud_notifications_v1_notification_service_proto_init() }
@@ -2072,17 +3153,21 @@ func file_obiente_cloud_notifications_v1_notification_service_proto_init() {
 	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[0].OneofWrappers = []any{}
 	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[6].OneofWrappers = []any{}
 	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[10].OneofWrappers = []any{}
-	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[12].OneofWrappers = []any{}
-	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[14].OneofWrappers = []any{}
 	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[16].OneofWrappers = []any{}
 	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[18].OneofWrappers = []any{}
+	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[20].OneofWrappers = []any{}
+	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[28].OneofWrappers = []any{}
+	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[29].OneofWrappers = []any{}
+	file_obiente_cloud_notifications_v1_notification_service_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_obiente_cloud_notifications_v1_notification_service_proto_rawDesc), len(file_obiente_cloud_notifications_v1_notification_service_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   30,
+			NumEnums:      4,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -51,6 +51,12 @@ const (
 	// NotificationServiceDeleteAllNotificationsProcedure is the fully-qualified name of the
 	// NotificationService's DeleteAllNotifications RPC.
 	NotificationServiceDeleteAllNotificationsProcedure = "/obiente.cloud.notifications.v1.NotificationService/DeleteAllNotifications"
+	// NotificationServiceArchiveNotificationProcedure is the fully-qualified name of the
+	// NotificationService's ArchiveNotification RPC.
+	NotificationServiceArchiveNotificationProcedure = "/obiente.cloud.notifications.v1.NotificationService/ArchiveNotification"
+	// NotificationServiceListArchivedNotificationsProcedure is the fully-qualified name of the
+	// NotificationService's ListArchivedNotifications RPC.
+	NotificationServiceListArchivedNotificationsProcedure = "/obiente.cloud.notifications.v1.NotificationService/ListArchivedNotifications"
 	// NotificationServiceGetUnreadCountProcedure is the fully-qualified name of the
 	// NotificationService's GetUnreadCount RPC.
 	NotificationServiceGetUnreadCountProcedure = "/obiente.cloud.notifications.v1.NotificationService/GetUnreadCount"
@@ -69,6 +75,21 @@ const (
 	// NotificationServiceUpdateNotificationPreferencesProcedure is the fully-qualified name of the
 	// NotificationService's UpdateNotificationPreferences RPC.
 	NotificationServiceUpdateNotificationPreferencesProcedure = "/obiente.cloud.notifications.v1.NotificationService/UpdateNotificationPreferences"
+	// NotificationServiceGetNotificationDigestPolicyProcedure is the fully-qualified name of the
+	// NotificationService's GetNotificationDigestPolicy RPC.
+	NotificationServiceGetNotificationDigestPolicyProcedure = "/obiente.cloud.notifications.v1.NotificationService/GetNotificationDigestPolicy"
+	// NotificationServiceSetNotificationDigestPolicyProcedure is the fully-qualified name of the
+	// NotificationService's SetNotificationDigestPolicy RPC.
+	NotificationServiceSetNotificationDigestPolicyProcedure = "/obiente.cloud.notifications.v1.NotificationService/SetNotificationDigestPolicy"
+	// NotificationServiceCreateWebhookConfigProcedure is the fully-qualified name of the
+	// NotificationService's CreateWebhookConfig RPC.
+	NotificationServiceCreateWebhookConfigProcedure = "/obiente.cloud.notifications.v1.NotificationService/CreateWebhookConfig"
+	// NotificationServiceDeleteWebhookConfigProcedure is the fully-qualified name of the
+	// NotificationService's DeleteWebhookConfig RPC.
+	NotificationServiceDeleteWebhookConfigProcedure = "/obiente.cloud.notifications.v1.NotificationService/DeleteWebhookConfig"
+	// NotificationServiceListWebhookConfigsProcedure is the fully-qualified name of the
+	// NotificationService's ListWebhookConfigs RPC.
+	NotificationServiceListWebhookConfigsProcedure = "/obiente.cloud.notifications.v1.NotificationService/ListWebhookConfigs"
 )
 
 // NotificationServiceClient is a client for the obiente.cloud.notifications.v1.NotificationService
@@ -82,10 +103,14 @@ type NotificationServiceClient interface {
 	MarkAsRead(context.Context, *connect.Request[v1.MarkAsReadRequest]) (*connect.Response[v1.MarkAsReadResponse], error)
 	// Mark all notifications as read
 	MarkAllAsRead(context.Context, *connect.Request[v1.MarkAllAsReadRequest]) (*connect.Response[v1.MarkAllAsReadResponse], error)
-	// Delete a notification
+	// Delete a notification (soft delete; purged after NOTIFICATION_HARD_DELETE_DAYS)
 	DeleteNotification(context.Context, *connect.Request[v1.DeleteNotificationRequest]) (*connect.Response[v1.DeleteNotificationResponse], error)
-	// Delete all notifications
+	// Delete all notifications (soft delete; purged after NOTIFICATION_HARD_DELETE_DAYS)
 	DeleteAllNotifications(context.Context, *connect.Request[v1.DeleteAllNotificationsRequest]) (*connect.Response[v1.DeleteAllNotificationsResponse], error)
+	// Archive a notification, removing it from the inbox
+	ArchiveNotification(context.Context, *connect.Request[v1.ArchiveNotificationRequest]) (*connect.Response[v1.ArchiveNotificationResponse], error)
+	// List archived notifications
+	ListArchivedNotifications(context.Context, *connect.Request[v1.ListArchivedNotificationsRequest]) (*connect.Response[v1.ListArchivedNotificationsResponse], error)
 	// Get unread count
 	GetUnreadCount(context.Context, *connect.Request[v1.GetUnreadCountRequest]) (*connect.Response[v1.GetUnreadCountResponse], error)
 	// Create a notification (internal/admin use)
@@ -98,6 +123,16 @@ type NotificationServiceClient interface {
 	GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error)
 	// Update user's notification preferences
 	UpdateNotificationPreferences(context.Context, *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error)
+	// Get an organization's notification digest policy
+	GetNotificationDigestPolicy(context.Context, *connect.Request[v1.GetNotificationDigestPolicyRequest]) (*connect.Response[v1.GetNotificationDigestPolicyResponse], error)
+	// Set an organization's notification digest policy
+	SetNotificationDigestPolicy(context.Context, *connect.Request[v1.SetNotificationDigestPolicyRequest]) (*connect.Response[v1.SetNotificationDigestPolicyResponse], error)
+	// Add a Slack or Discord webhook that receives an organization's notifications
+	CreateWebhookConfig(context.Context, *connect.Request[v1.CreateWebhookConfigRequest]) (*connect.Response[v1.CreateWebhookConfigResponse], error)
+	// Remove a notification webhook from an organization
+	DeleteWebhookConfig(context.Context, *connect.Request[v1.DeleteWebhookConfigRequest]) (*connect.Response[v1.DeleteWebhookConfigResponse], error)
+	// List an organization's notification webhooks
+	ListWebhookConfigs(context.Context, *connect.Request[v1.ListWebhookConfigsRequest]) (*connect.Response[v1.ListWebhookConfigsResponse], error)
 }
 
 // NewNotificationServiceClient constructs a client for the
@@ -148,6 +183,18 @@ func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(notificationServiceMethods.ByName("DeleteAllNotifications")),
 			connect.WithClientOptions(opts...),
 		),
+		archiveNotification: connect.NewClient[v1.ArchiveNotificationRequest, v1.ArchiveNotificationResponse](
+			httpClient,
+			baseURL+NotificationServiceArchiveNotificationProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("ArchiveNotification")),
+			connect.WithClientOptions(opts...),
+		),
+		listArchivedNotifications: connect.NewClient[v1.ListArchivedNotificationsRequest, v1.ListArchivedNotificationsResponse](
+			httpClient,
+			baseURL+NotificationServiceListArchivedNotificationsProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("ListArchivedNotifications")),
+			connect.WithClientOptions(opts...),
+		),
 		getUnreadCount: connect.NewClient[v1.GetUnreadCountRequest, v1.GetUnreadCountResponse](
 			httpClient,
 			baseURL+NotificationServiceGetUnreadCountProcedure,
@@ -184,6 +231,36 @@ func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(notificationServiceMethods.ByName("UpdateNotificationPreferences")),
 			connect.WithClientOptions(opts...),
 		),
+		getNotificationDigestPolicy: connect.NewClient[v1.GetNotificationDigestPolicyRequest, v1.GetNotificationDigestPolicyResponse](
+			httpClient,
+			baseURL+NotificationServiceGetNotificationDigestPolicyProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("GetNotificationDigestPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		setNotificationDigestPolicy: connect.NewClient[v1.SetNotificationDigestPolicyRequest, v1.SetNotificationDigestPolicyResponse](
+			httpClient,
+			baseURL+NotificationServiceSetNotificationDigestPolicyProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("SetNotificationDigestPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		createWebhookConfig: connect.NewClient[v1.CreateWebhookConfigRequest, v1.CreateWebhookConfigResponse](
+			httpClient,
+			baseURL+NotificationServiceCreateWebhookConfigProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("CreateWebhookConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhookConfig: connect.NewClient[v1.DeleteWebhookConfigRequest, v1.DeleteWebhookConfigResponse](
+			httpClient,
+			baseURL+NotificationServiceDeleteWebhookConfigProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("DeleteWebhookConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookConfigs: connect.NewClient[v1.ListWebhookConfigsRequest, v1.ListWebhookConfigsResponse](
+			httpClient,
+			baseURL+NotificationServiceListWebhookConfigsProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("ListWebhookConfigs")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -195,12 +272,19 @@ type notificationServiceClient struct {
 	markAllAsRead                  *connect.Client[v1.MarkAllAsReadRequest, v1.MarkAllAsReadResponse]
 	deleteNotification             *connect.Client[v1.DeleteNotificationRequest, v1.DeleteNotificationResponse]
 	deleteAllNotifications         *connect.Client[v1.DeleteAllNotificationsRequest, v1.DeleteAllNotificationsResponse]
+	archiveNotification            *connect.Client[v1.ArchiveNotificationRequest, v1.ArchiveNotificationResponse]
+	listArchivedNotifications      *connect.Client[v1.ListArchivedNotificationsRequest, v1.ListArchivedNotificationsResponse]
 	getUnreadCount                 *connect.Client[v1.GetUnreadCountRequest, v1.GetUnreadCountResponse]
 	createNotification             *connect.Client[v1.CreateNotificationRequest, v1.CreateNotificationResponse]
 	createOrganizationNotification *connect.Client[v1.CreateOrganizationNotificationRequest, v1.CreateOrganizationNotificationResponse]
 	getNotificationTypes           *connect.Client[v1.GetNotificationTypesRequest, v1.GetNotificationTypesResponse]
 	getNotificationPreferences     *connect.Client[v1.GetNotificationPreferencesRequest, v1.GetNotificationPreferencesResponse]
 	updateNotificationPreferences  *connect.Client[v1.UpdateNotificationPreferencesRequest, v1.UpdateNotificationPreferencesResponse]
+	getNotificationDigestPolicy    *connect.Client[v1.GetNotificationDigestPolicyRequest, v1.GetNotificationDigestPolicyResponse]
+	setNotificationDigestPolicy    *connect.Client[v1.SetNotificationDigestPolicyRequest, v1.SetNotificationDigestPolicyResponse]
+	createWebhookConfig            *connect.Client[v1.CreateWebhookConfigRequest, v1.CreateWebhookConfigResponse]
+	deleteWebhookConfig            *connect.Client[v1.DeleteWebhookConfigRequest, v1.DeleteWebhookConfigResponse]
+	listWebhookConfigs             *connect.Client[v1.ListWebhookConfigsRequest, v1.ListWebhookConfigsResponse]
 }
 
 // ListNotifications calls obiente.cloud.notifications.v1.NotificationService.ListNotifications.
@@ -234,6 +318,17 @@ func (c *notificationServiceClient) DeleteAllNotifications(ctx context.Context,
 	return c.deleteAllNotifications.CallUnary(ctx, req)
 }
 
+// ArchiveNotification calls obiente.cloud.notifications.v1.NotificationService.ArchiveNotification.
+func (c *notificationServiceClient) ArchiveNotification(ctx context.Context, req *connect.Request[v1.ArchiveNotificationRequest]) (*connect.Response[v1.ArchiveNotificationResponse], error) {
+	return c.archiveNotification.CallUnary(ctx, req)
+}
+
+// ListArchivedNotifications calls
+// obiente.cloud.notifications.v1.NotificationService.ListArchivedNotifications.
+func (c *notificationServiceClient) ListArchivedNotifications(ctx context.Context, req *connect.Request[v1.ListArchivedNotificationsRequest]) (*connect.Response[v1.ListArchivedNotificationsResponse], error) {
+	return c.listArchivedNotifications.CallUnary(ctx, req)
+}
+
 // GetUnreadCount calls obiente.cloud.notifications.v1.NotificationService.GetUnreadCount.
 func (c *notificationServiceClient) GetUnreadCount(ctx context.Context, req *connect.Request[v1.GetUnreadCountRequest]) (*connect.Response[v1.GetUnreadCountResponse], error) {
 	return c.getUnreadCount.CallUnary(ctx, req)
@@ -268,6 +363,33 @@ func (c *notificationServiceClient) UpdateNotificationPreferences(ctx context.Co
 	return c.updateNotificationPreferences.CallUnary(ctx, req)
 }
 
+// GetNotificationDigestPolicy calls
+// obiente.cloud.notifications.v1.NotificationService.GetNotificationDigestPolicy.
+func (c *notificationServiceClient) GetNotificationDigestPolicy(ctx context.Context, req *connect.Request[v1.GetNotificationDigestPolicyRequest]) (*connect.Response[v1.GetNotificationDigestPolicyResponse], error) {
+	return c.getNotificationDigestPolicy.CallUnary(ctx, req)
+}
+
+// SetNotificationDigestPolicy calls
+// obiente.cloud.notifications.v1.NotificationService.SetNotificationDigestPolicy.
+func (c *notificationServiceClient) SetNotificationDigestPolicy(ctx context.Context, req *connect.Request[v1.SetNotificationDigestPolicyRequest]) (*connect.Response[v1.SetNotificationDigestPolicyResponse], error) {
+	return c.setNotificationDigestPolicy.CallUnary(ctx, req)
+}
+
+// CreateWebhookConfig calls obiente.cloud.notifications.v1.NotificationService.CreateWebhookConfig.
+func (c *notificationServiceClient) CreateWebhookConfig(ctx context.Context, req *connect.Request[v1.CreateWebhookConfigRequest]) (*connect.Response[v1.CreateWebhookConfigResponse], error) {
+	return c.createWebhookConfig.CallUnary(ctx, req)
+}
+
+// DeleteWebhookConfig calls obiente.cloud.notifications.v1.NotificationService.DeleteWebhookConfig.
+func (c *notificationServiceClient) DeleteWebhookConfig(ctx context.Context, req *connect.Request[v1.DeleteWebhookConfigRequest]) (*connect.Response[v1.DeleteWebhookConfigResponse], error) {
+	return c.deleteWebhookConfig.CallUnary(ctx, req)
+}
+
+// ListWebhookConfigs calls obiente.cloud.notifications.v1.NotificationService.ListWebhookConfigs.
+func (c *notificationServiceClient) ListWebhookConfigs(ctx context.Context, req *connect.Request[v1.ListWebhookConfigsRequest]) (*connect.Response[v1.ListWebhookConfigsResponse], error) {
+	return c.listWebhookConfigs.CallUnary(ctx, req)
+}
+
 // NotificationServiceHandler is an implementation of the
 // obiente.cloud.notifications.v1.NotificationService service.
 type NotificationServiceHandler interface {
@@ -279,10 +401,14 @@ type NotificationServiceHandler interface {
 	MarkAsRead(context.Context, *connect.Request[v1.MarkAsReadRequest]) (*connect.Response[v1.MarkAsReadResponse], error)
 	// Mark all notifications as read
 	MarkAllAsRead(context.Context, *connect.Request[v1.MarkAllAsReadRequest]) (*connect.Response[v1.MarkAllAsReadResponse], error)
-	// Delete a notification
+	// Delete a notification (soft delete; purged after NOTIFICATION_HARD_DELETE_DAYS)
 	DeleteNotification(context.Context, *connect.Request[v1.DeleteNotificationRequest]) (*connect.Response[v1.DeleteNotificationResponse], error)
-	// Delete all notifications
+	// Delete all notifications (soft delete; purged after NOTIFICATION_HARD_DELETE_DAYS)
 	DeleteAllNotifications(context.Context, *connect.Request[v1.DeleteAllNotificationsRequest]) (*connect.Response[v1.DeleteAllNotificationsResponse], error)
+	// Archive a notification, removing it from the inbox
+	ArchiveNotification(context.Context, *connect.Request[v1.ArchiveNotificationRequest]) (*connect.Response[v1.ArchiveNotificationResponse], error)
+	// List archived notifications
+	ListArchivedNotifications(context.Context, *connect.Request[v1.ListArchivedNotificationsRequest]) (*connect.Response[v1.ListArchivedNotificationsResponse], error)
 	// Get unread count
 	GetUnreadCount(context.Context, *connect.Request[v1.GetUnreadCountRequest]) (*connect.Response[v1.GetUnreadCountResponse], error)
 	// Create a notification (internal/admin use)
@@ -295,6 +421,16 @@ type NotificationServiceHandler interface {
 	GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error)
 	// Update user's notification preferences
 	UpdateNotificationPreferences(context.Context, *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error)
+	// Get an organization's notification digest policy
+	GetNotificationDigestPolicy(context.Context, *connect.Request[v1.GetNotificationDigestPolicyRequest]) (*connect.Response[v1.GetNotificationDigestPolicyResponse], error)
+	// Set an organization's notification digest policy
+	SetNotificationDigestPolicy(context.Context, *connect.Request[v1.SetNotificationDigestPolicyRequest]) (*connect.Response[v1.SetNotificationDigestPolicyResponse], error)
+	// Add a Slack or Discord webhook that receives an organization's notifications
+	CreateWebhookConfig(context.Context, *connect.Request[v1.CreateWebhookConfigRequest]) (*connect.Response[v1.CreateWebhookConfigResponse], error)
+	// Remove a notification webhook from an organization
+	DeleteWebhookConfig(context.Context, *connect.Request[v1.DeleteWebhookConfigRequest]) (*connect.Response[v1.DeleteWebhookConfigResponse], error)
+	// List an organization's notification webhooks
+	ListWebhookConfigs(context.Context, *connect.Request[v1.ListWebhookConfigsRequest]) (*connect.Response[v1.ListWebhookConfigsResponse], error)
 }
 
 // NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -340,6 +476,18 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 		connect.WithSchema(notificationServiceMethods.ByName("DeleteAllNotifications")),
 		connect.WithHandlerOptions(opts...),
 	)
+	notificationServiceArchiveNotificationHandler := connect.NewUnaryHandler(
+		NotificationServiceArchiveNotificationProcedure,
+		svc.ArchiveNotification,
+		connect.WithSchema(notificationServiceMethods.ByName("ArchiveNotification")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceListArchivedNotificationsHandler := connect.NewUnaryHandler(
+		NotificationServiceListArchivedNotificationsProcedure,
+		svc.ListArchivedNotifications,
+		connect.WithSchema(notificationServiceMethods.ByName("ListArchivedNotifications")),
+		connect.WithHandlerOptions(opts...),
+	)
 	notificationServiceGetUnreadCountHandler := connect.NewUnaryHandler(
 		NotificationServiceGetUnreadCountProcedure,
 		svc.GetUnreadCount,
@@ -376,6 +524,36 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 		connect.WithSchema(notificationServiceMethods.ByName("UpdateNotificationPreferences")),
 		connect.WithHandlerOptions(opts...),
 	)
+	notificationServiceGetNotificationDigestPolicyHandler := connect.NewUnaryHandler(
+		NotificationServiceGetNotificationDigestPolicyProcedure,
+		svc.GetNotificationDigestPolicy,
+		connect.WithSchema(notificationServiceMethods.ByName("GetNotificationDigestPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceSetNotificationDigestPolicyHandler := connect.NewUnaryHandler(
+		NotificationServiceSetNotificationDigestPolicyProcedure,
+		svc.SetNotificationDigestPolicy,
+		connect.WithSchema(notificationServiceMethods.ByName("SetNotificationDigestPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceCreateWebhookConfigHandler := connect.NewUnaryHandler(
+		NotificationServiceCreateWebhookConfigProcedure,
+		svc.CreateWebhookConfig,
+		connect.WithSchema(notificationServiceMethods.ByName("CreateWebhookConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceDeleteWebhookConfigHandler := connect.NewUnaryHandler(
+		NotificationServiceDeleteWebhookConfigProcedure,
+		svc.DeleteWebhookConfig,
+		connect.WithSchema(notificationServiceMethods.ByName("DeleteWebhookConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceListWebhookConfigsHandler := connect.NewUnaryHandler(
+		NotificationServiceListWebhookConfigsProcedure,
+		svc.ListWebhookConfigs,
+		connect.WithSchema(notificationServiceMethods.ByName("ListWebhookConfigs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/obiente.cloud.notifications.v1.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case NotificationServiceListNotificationsProcedure:
@@ -390,6 +568,10 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 			notificationServiceDeleteNotificationHandler.ServeHTTP(w, r)
 		case NotificationServiceDeleteAllNotificationsProcedure:
 			notificationServiceDeleteAllNotificationsHandler.ServeHTTP(w, r)
+		case NotificationServiceArchiveNotificationProcedure:
+			notificationServiceArchiveNotificationHandler.ServeHTTP(w, r)
+		case NotificationServiceListArchivedNotificationsProcedure:
+			notificationServiceListArchivedNotificationsHandler.ServeHTTP(w, r)
 		case NotificationServiceGetUnreadCountProcedure:
 			notificationServiceGetUnreadCountHandler.ServeHTTP(w, r)
 		case NotificationServiceCreateNotificationProcedure:
@@ -402,6 +584,16 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 			notificationServiceGetNotificationPreferencesHandler.ServeHTTP(w, r)
 		case NotificationServiceUpdateNotificationPreferencesProcedure:
 			notificationServiceUpdateNotificationPreferencesHandler.ServeHTTP(w, r)
+		case NotificationServiceGetNotificationDigestPolicyProcedure:
+			notificationServiceGetNotificationDigestPolicyHandler.ServeHTTP(w, r)
+		case NotificationServiceSetNotificationDigestPolicyProcedure:
+			notificationServiceSetNotificationDigestPolicyHandler.ServeHTTP(w, r)
+		case NotificationServiceCreateWebhookConfigProcedure:
+			notificationServiceCreateWebhookConfigHandler.ServeHTTP(w, r)
+		case NotificationServiceDeleteWebhookConfigProcedure:
+			notificationServiceDeleteWebhookConfigHandler.ServeHTTP(w, r)
+		case NotificationServiceListWebhookConfigsProcedure:
+			notificationServiceListWebhookConfigsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -435,6 +627,14 @@ func (UnimplementedNotificationServiceHandler) DeleteAllNotifications(context.Co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.notifications.v1.NotificationService.DeleteAllNotifications is not implemented"))
 }
 
+func (UnimplementedNotificationServiceHandler) ArchiveNotification(context.Context, *connect.Request[v1.ArchiveNotificationRequest]) (*connect.Response[v1.ArchiveNotificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.notifications.v1.NotificationService.ArchiveNotification is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) ListArchivedNotifications(context.Context, *connect.Request[v1.ListArchivedNotificationsRequest]) (*connect.Response[v1.ListArchivedNotificationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.notifications.v1.NotificationService.ListArchivedNotifications is not implemented"))
+}
+
 func (UnimplementedNotificationServiceHandler) GetUnreadCount(context.Context, *connect.Request[v1.GetUnreadCountRequest]) (*connect.Response[v1.GetUnreadCountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.notifications.v1.NotificationService.GetUnreadCount is not implemented"))
 }
@@ -458,3 +658,23 @@ func (UnimplementedNotificationServiceHandler) GetNotificationPreferences(contex
 func (UnimplementedNotificationServiceHandler) UpdateNotificationPreferences(context.Context, *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.notifications.v1.NotificationService.UpdateNotificationPreferences is not implemented"))
 }
+
+func (UnimplementedNotificationServiceHandler) GetNotificationDigestPolicy(context.Context, *connect.Request[v1.GetNotificationDigestPolicyRequest]) (*connect.Response[v1.GetNotificationDigestPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.notifications.v1.NotificationService.GetNotificationDigestPolicy is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) SetNotificationDigestPolicy(context.Context, *connect.Request[v1.SetNotificationDigestPolicyRequest]) (*connect.Response[v1.SetNotificationDigestPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.notifications.v1.NotificationService.SetNotificationDigestPolicy is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) CreateWebhookConfig(context.Context, *connect.Request[v1.CreateWebhookConfigRequest]) (*connect.Response[v1.CreateWebhookConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.notifications.v1.NotificationService.CreateWebhookConfig is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) DeleteWebhookConfig(context.Context, *connect.Request[v1.DeleteWebhookConfigRequest]) (*connect.Response[v1.DeleteWebhookConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.notifications.v1.NotificationService.DeleteWebhookConfig is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) ListWebhookConfigs(context.Context, *connect.Request[v1.ListWebhookConfigsRequest]) (*connect.Response[v1.ListWebhookConfigsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.notifications.v1.NotificationService.ListWebhookConfigs is not implemented"))
+}
@@ -1200,6 +1200,7 @@ type PendingInvite struct {
 	Role             string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
 	InvitedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=invited_at,json=invitedAt,proto3" json:"invited_at,omitempty"`
 	InviterEmail     string                 `protobuf:"bytes,6,opt,name=inviter_email,json=inviterEmail,proto3" json:"inviter_email,omitempty"` // Email of the person who sent the invite
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`          // The invite can no longer be accepted after this time
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1276,6 +1277,13 @@ func (x *PendingInvite) GetInviterEmail() string {
 	return ""
 }
 
+func (x *PendingInvite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type AcceptInviteRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -1823,8 +1831,12 @@ type Organization struct {
 	PlanInfo *PlanInfo `protobuf:"bytes,12,opt,name=plan_info,json=planInfo,proto3,oneof" json:"plan_info,omitempty"`
 	// Total amount paid in cents (for safety check/auto-upgrade)
 	TotalPaidCents int64 `protobuf:"varint,13,opt,name=total_paid_cents,json=totalPaidCents,proto3" json:"total_paid_cents,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Set when status is "merged": the organization everything was moved to
+	MergedIntoOrganizationId *string `protobuf:"bytes,14,opt,name=merged_into_organization_id,json=mergedIntoOrganizationId,proto3,oneof" json:"merged_into_organization_id,omitempty"`
+	// Set for managed organizations: the organization whose plan, quota and billing they use
+	ParentOrganizationId *string `protobuf:"bytes,15,opt,name=parent_organization_id,json=parentOrganizationId,proto3,oneof" json:"parent_organization_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Organization) Reset() {
@@ -1948,6 +1960,20 @@ func (x *Organization) GetTotalPaidCents() int64 {
 	return 0
 }
 
+func (x *Organization) GetMergedIntoOrganizationId() string {
+	if x != nil && x.MergedIntoOrganizationId != nil {
+		return *x.MergedIntoOrganizationId
+	}
+	return ""
+}
+
+func (x *Organization) GetParentOrganizationId() string {
+	if x != nil && x.ParentOrganizationId != nil {
+		return *x.ParentOrganizationId
+	}
+	return ""
+}
+
 type PlanInfo struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
 	PlanId      string                 `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
@@ -2759,27 +2785,30 @@ func (x *CreditTransaction) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type GetMyPermissionsRequest struct {
+type GetOrganizationAuditLogRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Pagination
+	Page          int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32 `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyPermissionsRequest) Reset() {
-	*x = GetMyPermissionsRequest{}
+func (x *GetOrganizationAuditLogRequest) Reset() {
+	*x = GetOrganizationAuditLogRequest{}
 	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyPermissionsRequest) String() string {
+func (x *GetOrganizationAuditLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyPermissionsRequest) ProtoMessage() {}
+func (*GetOrganizationAuditLogRequest) ProtoMessage() {}
 
-func (x *GetMyPermissionsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOrganizationAuditLogRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2791,39 +2820,54 @@ func (x *GetMyPermissionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyPermissionsRequest.ProtoReflect.Descriptor instead.
-func (*GetMyPermissionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrganizationAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationAuditLogRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetMyPermissionsRequest) GetOrganizationId() string {
+func (x *GetOrganizationAuditLogRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-type GetMyPermissionsResponse struct {
+func (x *GetOrganizationAuditLogRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetOrganizationAuditLogRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GetOrganizationAuditLogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Permissions   []string               `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Pagination    *v1.Pagination         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyPermissionsResponse) Reset() {
-	*x = GetMyPermissionsResponse{}
+func (x *GetOrganizationAuditLogResponse) Reset() {
+	*x = GetOrganizationAuditLogResponse{}
 	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyPermissionsResponse) String() string {
+func (x *GetOrganizationAuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyPermissionsResponse) ProtoMessage() {}
+func (*GetOrganizationAuditLogResponse) ProtoMessage() {}
 
-func (x *GetMyPermissionsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOrganizationAuditLogResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2835,41 +2879,57 @@ func (x *GetMyPermissionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyPermissionsResponse.ProtoReflect.Descriptor instead.
-func (*GetMyPermissionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrganizationAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationAuditLogResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetMyPermissionsResponse) GetPermissions() []string {
+func (x *GetOrganizationAuditLogResponse) GetEvents() []*AuditEvent {
 	if x != nil {
-		return x.Permissions
+		return x.Events
 	}
 	return nil
 }
 
-// Request to set the active plan for an organization (superadmin only)
-type AdminSetPlanRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	PlanId         string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"` // The plan to assign (must match OrganizationPlan.ID)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetOrganizationAuditLogResponse) GetPagination() *v1.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
 }
 
-func (x *AdminSetPlanRequest) Reset() {
-	*x = AdminSetPlanRequest{}
+type AuditEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// User ID who performed the action ("system" for automatic actions)
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// RPC method name, e.g. "UpdateOrganization"
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// Service name, e.g. "OrganizationService"
+	Service      string  `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	ResourceType *string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`
+	ResourceId   *string `protobuf:"bytes,6,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	// Changed fields for update actions
+	Diff          []*AuditFieldChange    `protobuf:"bytes,7,rep,name=diff,proto3" json:"diff,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
 	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminSetPlanRequest) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminSetPlanRequest) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *AdminSetPlanRequest) ProtoReflect() protoreflect.Message {
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2881,48 +2941,92 @@ func (x *AdminSetPlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminSetPlanRequest.ProtoReflect.Descriptor instead.
-func (*AdminSetPlanRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *AdminSetPlanRequest) GetOrganizationId() string {
+func (x *AuditEvent) GetId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AdminSetPlanRequest) GetPlanId() string {
+func (x *AuditEvent) GetActor() string {
 	if x != nil {
-		return x.PlanId
+		return x.Actor
 	}
 	return ""
 }
 
-// Response for AdminSetPlan
-type AdminSetPlanResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
-	PlanId        string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetDiff() []*AuditFieldChange {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type AuditFieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Dotted proto field path, e.g. "name"
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// JSON encoded values; unset when the field was empty
+	OldValue      *string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3,oneof" json:"old_value,omitempty"`
+	NewValue      *string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3,oneof" json:"new_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AdminSetPlanResponse) Reset() {
-	*x = AdminSetPlanResponse{}
+func (x *AuditFieldChange) Reset() {
+	*x = AuditFieldChange{}
 	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminSetPlanResponse) String() string {
+func (x *AuditFieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminSetPlanResponse) ProtoMessage() {}
+func (*AuditFieldChange) ProtoMessage() {}
 
-func (x *AdminSetPlanResponse) ProtoReflect() protoreflect.Message {
+func (x *AuditFieldChange) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2934,34 +3038,2731 @@ func (x *AdminSetPlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminSetPlanResponse.ProtoReflect.Descriptor instead.
-func (*AdminSetPlanResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditFieldChange.ProtoReflect.Descriptor instead.
+func (*AuditFieldChange) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *AdminSetPlanResponse) GetOrganization() *Organization {
+func (x *AuditFieldChange) GetField() string {
 	if x != nil {
-		return x.Organization
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AuditFieldChange) GetOldValue() string {
+	if x != nil && x.OldValue != nil {
+		return *x.OldValue
+	}
+	return ""
+}
+
+func (x *AuditFieldChange) GetNewValue() string {
+	if x != nil && x.NewValue != nil {
+		return *x.NewValue
+	}
+	return ""
+}
+
+type GetOrganizationActivityFeedRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Return events older than this event, i.e. next_after_id of the previous page; unset for the newest events
+	AfterId *string `protobuf:"bytes,2,opt,name=after_id,json=afterId,proto3,oneof" json:"after_id,omitempty"`
+	// Events per page (default 50, max 100)
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Only events of this type, e.g. "deployment.created"
+	EventType *string `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`
+	// Only events performed by this user
+	ActorId       *string `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationActivityFeedRequest) Reset() {
+	*x = GetOrganizationActivityFeedRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationActivityFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationActivityFeedRequest) ProtoMessage() {}
+
+func (x *GetOrganizationActivityFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationActivityFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationActivityFeedRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetOrganizationActivityFeedRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetOrganizationActivityFeedRequest) GetAfterId() string {
+	if x != nil && x.AfterId != nil {
+		return *x.AfterId
+	}
+	return ""
+}
+
+func (x *GetOrganizationActivityFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetOrganizationActivityFeedRequest) GetEventType() string {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return ""
+}
+
+func (x *GetOrganizationActivityFeedRequest) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+type GetOrganizationActivityFeedResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*ActivityEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// after_id for the next page; unset on the last page
+	NextAfterId   *string `protobuf:"bytes,2,opt,name=next_after_id,json=nextAfterId,proto3,oneof" json:"next_after_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationActivityFeedResponse) Reset() {
+	*x = GetOrganizationActivityFeedResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationActivityFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationActivityFeedResponse) ProtoMessage() {}
+
+func (x *GetOrganizationActivityFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationActivityFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationActivityFeedResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetOrganizationActivityFeedResponse) GetEvents() []*ActivityEvent {
+	if x != nil {
+		return x.Events
 	}
 	return nil
 }
 
-func (x *AdminSetPlanResponse) GetPlanId() string {
+func (x *GetOrganizationActivityFeedResponse) GetNextAfterId() string {
+	if x != nil && x.NextAfterId != nil {
+		return *x.NextAfterId
+	}
+	return ""
+}
+
+type ActivityEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "<resource_type>.<created|updated|deleted>", e.g. "member.created"
+	EventType string `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// User ID who performed the change ("system" for automatic changes)
+	ActorId string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// "deployment", "game_server", "vps", "member", "billing_account", "payment_method" or "spend_alert"
+	ResourceType string  `protobuf:"bytes,4,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId   *string `protobuf:"bytes,5,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	// Event details, e.g. "action" (the RPC method name) and the resource's "name"
+	Metadata      map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityEvent) Reset() {
+	*x = ActivityEvent{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityEvent) ProtoMessage() {}
+
+func (x *ActivityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[49]
 	if x != nil {
-		return x.PlanId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityEvent.ProtoReflect.Descriptor instead.
+func (*ActivityEvent) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ActivityEvent) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-var File_obiente_cloud_organizations_v1_organization_service_proto protoreflect.FileDescriptor
+func (x *ActivityEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
 
-const file_obiente_cloud_organizations_v1_organization_service_proto_rawDesc = "" +
-	"\n" +
-	"9obiente/cloud/organizations/v1/organization_service.proto\x12\x1eobiente.cloud.organizations.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(obiente/cloud/auth/v1/auth_service.proto\x1a$obiente/cloud/common/v1/common.proto\"_\n" +
-	"\x0fGetUsageRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
-	"\x05month\x18\x02 \x01(\tH\x00R\x05month\x88\x01\x01B\b\n" +
-	"\x06_month\"\xb6\x02\n" +
+func (x *ActivityEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ActivityEvent) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ActivityEvent) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
+}
+
+func (x *ActivityEvent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ActivityEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SAMLConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Identity provider entity ID (issuer)
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Identity provider single sign-on URL
+	SsoUrl string `protobuf:"bytes,3,opt,name=sso_url,json=ssoUrl,proto3" json:"sso_url,omitempty"`
+	// PEM encoded identity provider signing certificate
+	Certificate string `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// Maps "email", "name", "given_name" and "family_name" to IdP attribute names.
+	// Without an "email" mapping the assertion's NameID is used.
+	AttributeMapping map[string]string `protobuf:"bytes,5,rep,name=attribute_mapping,json=attributeMapping,proto3" json:"attribute_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Service provider URLs to register with the identity provider
+	SpEntityId    string                 `protobuf:"bytes,6,opt,name=sp_entity_id,json=spEntityId,proto3" json:"sp_entity_id,omitempty"`
+	AcsUrl        string                 `protobuf:"bytes,7,opt,name=acs_url,json=acsUrl,proto3" json:"acs_url,omitempty"`
+	MetadataUrl   string                 `protobuf:"bytes,8,opt,name=metadata_url,json=metadataUrl,proto3" json:"metadata_url,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SAMLConfig) Reset() {
+	*x = SAMLConfig{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAMLConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAMLConfig) ProtoMessage() {}
+
+func (x *SAMLConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAMLConfig.ProtoReflect.Descriptor instead.
+func (*SAMLConfig) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SAMLConfig) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SAMLConfig) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *SAMLConfig) GetSsoUrl() string {
+	if x != nil {
+		return x.SsoUrl
+	}
+	return ""
+}
+
+func (x *SAMLConfig) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *SAMLConfig) GetAttributeMapping() map[string]string {
+	if x != nil {
+		return x.AttributeMapping
+	}
+	return nil
+}
+
+func (x *SAMLConfig) GetSpEntityId() string {
+	if x != nil {
+		return x.SpEntityId
+	}
+	return ""
+}
+
+func (x *SAMLConfig) GetAcsUrl() string {
+	if x != nil {
+		return x.AcsUrl
+	}
+	return ""
+}
+
+func (x *SAMLConfig) GetMetadataUrl() string {
+	if x != nil {
+		return x.MetadataUrl
+	}
+	return ""
+}
+
+func (x *SAMLConfig) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ConfigureSAMLRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	EntityId         string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	SsoUrl           string                 `protobuf:"bytes,3,opt,name=sso_url,json=ssoUrl,proto3" json:"sso_url,omitempty"`
+	Certificate      string                 `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	AttributeMapping map[string]string      `protobuf:"bytes,5,rep,name=attribute_mapping,json=attributeMapping,proto3" json:"attribute_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ConfigureSAMLRequest) Reset() {
+	*x = ConfigureSAMLRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureSAMLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureSAMLRequest) ProtoMessage() {}
+
+func (x *ConfigureSAMLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureSAMLRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureSAMLRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ConfigureSAMLRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ConfigureSAMLRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ConfigureSAMLRequest) GetSsoUrl() string {
+	if x != nil {
+		return x.SsoUrl
+	}
+	return ""
+}
+
+func (x *ConfigureSAMLRequest) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *ConfigureSAMLRequest) GetAttributeMapping() map[string]string {
+	if x != nil {
+		return x.AttributeMapping
+	}
+	return nil
+}
+
+type ConfigureSAMLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *SAMLConfig            `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureSAMLResponse) Reset() {
+	*x = ConfigureSAMLResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureSAMLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureSAMLResponse) ProtoMessage() {}
+
+func (x *ConfigureSAMLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureSAMLResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureSAMLResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ConfigureSAMLResponse) GetConfig() *SAMLConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GetSAMLConfigRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSAMLConfigRequest) Reset() {
+	*x = GetSAMLConfigRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSAMLConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSAMLConfigRequest) ProtoMessage() {}
+
+func (x *GetSAMLConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSAMLConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetSAMLConfigRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetSAMLConfigRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetSAMLConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset when SAML is not configured
+	Config        *SAMLConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSAMLConfigResponse) Reset() {
+	*x = GetSAMLConfigResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSAMLConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSAMLConfigResponse) ProtoMessage() {}
+
+func (x *GetSAMLConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSAMLConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetSAMLConfigResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetSAMLConfigResponse) GetConfig() *SAMLConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GetMyPermissionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMyPermissionsRequest) Reset() {
+	*x = GetMyPermissionsRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyPermissionsRequest) ProtoMessage() {}
+
+func (x *GetMyPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetMyPermissionsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetMyPermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   []string               `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyPermissionsResponse) Reset() {
+	*x = GetMyPermissionsResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyPermissionsResponse) ProtoMessage() {}
+
+func (x *GetMyPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetMyPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetMyPermissionsResponse) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// Request to set the active plan for an organization (superadmin only)
+type AdminSetPlanRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PlanId         string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"` // The plan to assign (must match OrganizationPlan.ID)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminSetPlanRequest) Reset() {
+	*x = AdminSetPlanRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetPlanRequest) ProtoMessage() {}
+
+func (x *AdminSetPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetPlanRequest.ProtoReflect.Descriptor instead.
+func (*AdminSetPlanRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AdminSetPlanRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AdminSetPlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// Response for AdminSetPlan
+type AdminSetPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	PlanId        string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetPlanResponse) Reset() {
+	*x = AdminSetPlanResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetPlanResponse) ProtoMessage() {}
+
+func (x *AdminSetPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetPlanResponse.ProtoReflect.Descriptor instead.
+func (*AdminSetPlanResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AdminSetPlanResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *AdminSetPlanResponse) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// A resource together with all of its tags
+type TaggedResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "deployment", "gameserver" or "vps"
+	ResourceType  string            `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string            `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Tags          map[string]string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaggedResource) Reset() {
+	*x = TaggedResource{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaggedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaggedResource) ProtoMessage() {}
+
+func (x *TaggedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaggedResource.ProtoReflect.Descriptor instead.
+func (*TaggedResource) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TaggedResource) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *TaggedResource) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *TaggedResource) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AddResourceTagRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ResourceType   string                 `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId     string                 `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Lowercase letters, digits and dashes, at most 63 characters
+	Key string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// At most 63 characters
+	Value         string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddResourceTagRequest) Reset() {
+	*x = AddResourceTagRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddResourceTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResourceTagRequest) ProtoMessage() {}
+
+func (x *AddResourceTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResourceTagRequest.ProtoReflect.Descriptor instead.
+func (*AddResourceTagRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AddResourceTagRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AddResourceTagRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AddResourceTagRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AddResourceTagRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AddResourceTagRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AddResourceTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *TaggedResource        `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddResourceTagResponse) Reset() {
+	*x = AddResourceTagResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddResourceTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResourceTagResponse) ProtoMessage() {}
+
+func (x *AddResourceTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResourceTagResponse.ProtoReflect.Descriptor instead.
+func (*AddResourceTagResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AddResourceTagResponse) GetResource() *TaggedResource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+type RemoveResourceTagRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ResourceType   string                 `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId     string                 `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Key            string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveResourceTagRequest) Reset() {
+	*x = RemoveResourceTagRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveResourceTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveResourceTagRequest) ProtoMessage() {}
+
+func (x *RemoveResourceTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveResourceTagRequest.ProtoReflect.Descriptor instead.
+func (*RemoveResourceTagRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RemoveResourceTagRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RemoveResourceTagRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *RemoveResourceTagRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *RemoveResourceTagRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type RemoveResourceTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *TaggedResource        `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveResourceTagResponse) Reset() {
+	*x = RemoveResourceTagResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveResourceTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveResourceTagResponse) ProtoMessage() {}
+
+func (x *RemoveResourceTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveResourceTagResponse.ProtoReflect.Descriptor instead.
+func (*RemoveResourceTagResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RemoveResourceTagResponse) GetResource() *TaggedResource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+type ListResourcesByTagRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Resources must carry every key=value pair
+	Tags map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Only list resources of this type
+	ResourceType  *string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcesByTagRequest) Reset() {
+	*x = ListResourcesByTagRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesByTagRequest) ProtoMessage() {}
+
+func (x *ListResourcesByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesByTagRequest.ProtoReflect.Descriptor instead.
+func (*ListResourcesByTagRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListResourcesByTagRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListResourcesByTagRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListResourcesByTagRequest) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+type ListResourcesByTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resources     []*TaggedResource      `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcesByTagResponse) Reset() {
+	*x = ListResourcesByTagResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesByTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesByTagResponse) ProtoMessage() {}
+
+func (x *ListResourcesByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesByTagResponse.ProtoReflect.Descriptor instead.
+func (*ListResourcesByTagResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListResourcesByTagResponse) GetResources() []*TaggedResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type Team struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	MemberUserIds  []string               `protobuf:"bytes,5,rep,name=member_user_ids,json=memberUserIds,proto3" json:"member_user_ids,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Team) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Team) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Team) GetMemberUserIds() []string {
+	if x != nil {
+		return x.MemberUserIds
+	}
+	return nil
+}
+
+func (x *Team) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Limits for the resources tagged with a team. Unset or 0 means no team limit.
+type TeamQuota struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CpuCores            *int32                 `protobuf:"varint,1,opt,name=cpu_cores,json=cpuCores,proto3,oneof" json:"cpu_cores,omitempty"`
+	MemoryBytes         *int64                 `protobuf:"varint,2,opt,name=memory_bytes,json=memoryBytes,proto3,oneof" json:"memory_bytes,omitempty"`
+	DeploymentsMax      *int32                 `protobuf:"varint,3,opt,name=deployments_max,json=deploymentsMax,proto3,oneof" json:"deployments_max,omitempty"`
+	MaxVpsInstances     *int32                 `protobuf:"varint,4,opt,name=max_vps_instances,json=maxVpsInstances,proto3,oneof" json:"max_vps_instances,omitempty"`
+	BandwidthBytesMonth *int64                 `protobuf:"varint,5,opt,name=bandwidth_bytes_month,json=bandwidthBytesMonth,proto3,oneof" json:"bandwidth_bytes_month,omitempty"`
+	StorageBytes        *int64                 `protobuf:"varint,6,opt,name=storage_bytes,json=storageBytes,proto3,oneof" json:"storage_bytes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TeamQuota) Reset() {
+	*x = TeamQuota{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamQuota) ProtoMessage() {}
+
+func (x *TeamQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamQuota.ProtoReflect.Descriptor instead.
+func (*TeamQuota) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *TeamQuota) GetCpuCores() int32 {
+	if x != nil && x.CpuCores != nil {
+		return *x.CpuCores
+	}
+	return 0
+}
+
+func (x *TeamQuota) GetMemoryBytes() int64 {
+	if x != nil && x.MemoryBytes != nil {
+		return *x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *TeamQuota) GetDeploymentsMax() int32 {
+	if x != nil && x.DeploymentsMax != nil {
+		return *x.DeploymentsMax
+	}
+	return 0
+}
+
+func (x *TeamQuota) GetMaxVpsInstances() int32 {
+	if x != nil && x.MaxVpsInstances != nil {
+		return *x.MaxVpsInstances
+	}
+	return 0
+}
+
+func (x *TeamQuota) GetBandwidthBytesMonth() int64 {
+	if x != nil && x.BandwidthBytesMonth != nil {
+		return *x.BandwidthBytesMonth
+	}
+	return 0
+}
+
+func (x *TeamQuota) GetStorageBytes() int64 {
+	if x != nil && x.StorageBytes != nil {
+		return *x.StorageBytes
+	}
+	return 0
+}
+
+type TeamUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Deployments    int32                  `protobuf:"varint,1,opt,name=deployments,proto3" json:"deployments,omitempty"`
+	GameServers    int32                  `protobuf:"varint,2,opt,name=game_servers,json=gameServers,proto3" json:"game_servers,omitempty"`
+	VpsInstances   int32                  `protobuf:"varint,3,opt,name=vps_instances,json=vpsInstances,proto3" json:"vps_instances,omitempty"`
+	MemoryBytes    int64                  `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	CpuCores       int32                  `protobuf:"varint,5,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	StorageBytes   int64                  `protobuf:"varint,6,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	BandwidthBytes int64                  `protobuf:"varint,7,opt,name=bandwidth_bytes,json=bandwidthBytes,proto3" json:"bandwidth_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TeamUsage) Reset() {
+	*x = TeamUsage{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamUsage) ProtoMessage() {}
+
+func (x *TeamUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamUsage.ProtoReflect.Descriptor instead.
+func (*TeamUsage) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TeamUsage) GetDeployments() int32 {
+	if x != nil {
+		return x.Deployments
+	}
+	return 0
+}
+
+func (x *TeamUsage) GetGameServers() int32 {
+	if x != nil {
+		return x.GameServers
+	}
+	return 0
+}
+
+func (x *TeamUsage) GetVpsInstances() int32 {
+	if x != nil {
+		return x.VpsInstances
+	}
+	return 0
+}
+
+func (x *TeamUsage) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *TeamUsage) GetCpuCores() int32 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
+}
+
+func (x *TeamUsage) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *TeamUsage) GetBandwidthBytes() int64 {
+	if x != nil {
+		return x.BandwidthBytes
+	}
+	return 0
+}
+
+type CreateTeamRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Members of the organization to add to the team
+	MemberUserIds []string `protobuf:"bytes,3,rep,name=member_user_ids,json=memberUserIds,proto3" json:"member_user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateTeamRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetMemberUserIds() []string {
+	if x != nil {
+		return x.MemberUserIds
+	}
+	return nil
+}
+
+type CreateTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamResponse) Reset() {
+	*x = CreateTeamResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamResponse) ProtoMessage() {}
+
+func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamResponse.ProtoReflect.Descriptor instead.
+func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type DeleteTeamRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TeamId         string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamRequest) ProtoMessage() {}
+
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeleteTeamRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteTeamRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+type DeleteTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamResponse) Reset() {
+	*x = DeleteTeamResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamResponse) ProtoMessage() {}
+
+func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteTeamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetTeamQuotaRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TeamId         string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Quota          *TeamQuota             `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetTeamQuotaRequest) Reset() {
+	*x = SetTeamQuotaRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamQuotaRequest) ProtoMessage() {}
+
+func (x *SetTeamQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SetTeamQuotaRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetTeamQuotaRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *SetTeamQuotaRequest) GetQuota() *TeamQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type SetTeamQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *TeamQuota             `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamQuotaResponse) Reset() {
+	*x = SetTeamQuotaResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamQuotaResponse) ProtoMessage() {}
+
+func (x *SetTeamQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamQuotaResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SetTeamQuotaResponse) GetQuota() *TeamQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type GetTeamUsageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TeamId         string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTeamUsageRequest) Reset() {
+	*x = GetTeamUsageRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamUsageRequest) ProtoMessage() {}
+
+func (x *GetTeamUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamUsageRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetTeamUsageRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetTeamUsageRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+type GetTeamUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Team  *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Usage *TeamUsage             `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Unset when the team has no quota
+	Quota         *TeamQuota `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamUsageResponse) Reset() {
+	*x = GetTeamUsageResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamUsageResponse) ProtoMessage() {}
+
+func (x *GetTeamUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamUsageResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetTeamUsageResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *GetTeamUsageResponse) GetUsage() *TeamUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *GetTeamUsageResponse) GetQuota() *TeamQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type MergeOrganizationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Organization to merge; it is left empty and marked merged
+	SourceOrganizationId string `protobuf:"bytes,1,opt,name=source_organization_id,json=sourceOrganizationId,proto3" json:"source_organization_id,omitempty"`
+	// Organization that receives the resources, credits and members
+	TargetOrganizationId string `protobuf:"bytes,2,opt,name=target_organization_id,json=targetOrganizationId,proto3" json:"target_organization_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MergeOrganizationsRequest) Reset() {
+	*x = MergeOrganizationsRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeOrganizationsRequest) ProtoMessage() {}
+
+func (x *MergeOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*MergeOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MergeOrganizationsRequest) GetSourceOrganizationId() string {
+	if x != nil {
+		return x.SourceOrganizationId
+	}
+	return ""
+}
+
+func (x *MergeOrganizationsRequest) GetTargetOrganizationId() string {
+	if x != nil {
+		return x.TargetOrganizationId
+	}
+	return ""
+}
+
+type MergeOrganizationsResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	TargetOrganization      *Organization          `protobuf:"bytes,1,opt,name=target_organization,json=targetOrganization,proto3" json:"target_organization,omitempty"`
+	DeploymentsMoved        int32                  `protobuf:"varint,2,opt,name=deployments_moved,json=deploymentsMoved,proto3" json:"deployments_moved,omitempty"`
+	GameServersMoved        int32                  `protobuf:"varint,3,opt,name=game_servers_moved,json=gameServersMoved,proto3" json:"game_servers_moved,omitempty"`
+	VpsInstancesMoved       int32                  `protobuf:"varint,4,opt,name=vps_instances_moved,json=vpsInstancesMoved,proto3" json:"vps_instances_moved,omitempty"`
+	MembersMoved            int32                  `protobuf:"varint,5,opt,name=members_moved,json=membersMoved,proto3" json:"members_moved,omitempty"`
+	CreditTransactionsMoved int32                  `protobuf:"varint,6,opt,name=credit_transactions_moved,json=creditTransactionsMoved,proto3" json:"credit_transactions_moved,omitempty"`
+	// Credits in cents added to the target organization's balance
+	CreditsMovedCents int64 `protobuf:"varint,7,opt,name=credits_moved_cents,json=creditsMovedCents,proto3" json:"credits_moved_cents,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MergeOrganizationsResponse) Reset() {
+	*x = MergeOrganizationsResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeOrganizationsResponse) ProtoMessage() {}
+
+func (x *MergeOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*MergeOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *MergeOrganizationsResponse) GetTargetOrganization() *Organization {
+	if x != nil {
+		return x.TargetOrganization
+	}
+	return nil
+}
+
+func (x *MergeOrganizationsResponse) GetDeploymentsMoved() int32 {
+	if x != nil {
+		return x.DeploymentsMoved
+	}
+	return 0
+}
+
+func (x *MergeOrganizationsResponse) GetGameServersMoved() int32 {
+	if x != nil {
+		return x.GameServersMoved
+	}
+	return 0
+}
+
+func (x *MergeOrganizationsResponse) GetVpsInstancesMoved() int32 {
+	if x != nil {
+		return x.VpsInstancesMoved
+	}
+	return 0
+}
+
+func (x *MergeOrganizationsResponse) GetMembersMoved() int32 {
+	if x != nil {
+		return x.MembersMoved
+	}
+	return 0
+}
+
+func (x *MergeOrganizationsResponse) GetCreditTransactionsMoved() int32 {
+	if x != nil {
+		return x.CreditTransactionsMoved
+	}
+	return 0
+}
+
+func (x *MergeOrganizationsResponse) GetCreditsMovedCents() int64 {
+	if x != nil {
+		return x.CreditsMovedCents
+	}
+	return 0
+}
+
+type CreateManagedOrganizationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Parent organization
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional: invited as an admin of the new organization and stored as its billing contact
+	BillingEmail  string `protobuf:"bytes,3,opt,name=billing_email,json=billingEmail,proto3" json:"billing_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateManagedOrganizationRequest) Reset() {
+	*x = CreateManagedOrganizationRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManagedOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManagedOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateManagedOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManagedOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateManagedOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateManagedOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateManagedOrganizationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateManagedOrganizationRequest) GetBillingEmail() string {
+	if x != nil {
+		return x.BillingEmail
+	}
+	return ""
+}
+
+type CreateManagedOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateManagedOrganizationResponse) Reset() {
+	*x = CreateManagedOrganizationResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManagedOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManagedOrganizationResponse) ProtoMessage() {}
+
+func (x *CreateManagedOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManagedOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*CreateManagedOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateManagedOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+type ListManagedOrganizationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Parent organization
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListManagedOrganizationsRequest) Reset() {
+	*x = ListManagedOrganizationsRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListManagedOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManagedOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListManagedOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManagedOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListManagedOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListManagedOrganizationsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListManagedOrganizationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListManagedOrganizationsResponse) Reset() {
+	*x = ListManagedOrganizationsResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListManagedOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManagedOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListManagedOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManagedOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListManagedOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListManagedOrganizationsResponse) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+// An edge of the role inheritance DAG: the child role inherits the parent role's permissions
+type RoleInheritanceEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChildRoleId   string                 `protobuf:"bytes,1,opt,name=child_role_id,json=childRoleId,proto3" json:"child_role_id,omitempty"`
+	ParentRoleId  string                 `protobuf:"bytes,2,opt,name=parent_role_id,json=parentRoleId,proto3" json:"parent_role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleInheritanceEdge) Reset() {
+	*x = RoleInheritanceEdge{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleInheritanceEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleInheritanceEdge) ProtoMessage() {}
+
+func (x *RoleInheritanceEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleInheritanceEdge.ProtoReflect.Descriptor instead.
+func (*RoleInheritanceEdge) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RoleInheritanceEdge) GetChildRoleId() string {
+	if x != nil {
+		return x.ChildRoleId
+	}
+	return ""
+}
+
+func (x *RoleInheritanceEdge) GetParentRoleId() string {
+	if x != nil {
+		return x.ParentRoleId
+	}
+	return ""
+}
+
+type AddRoleInheritanceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ChildRoleId    string                 `protobuf:"bytes,2,opt,name=child_role_id,json=childRoleId,proto3" json:"child_role_id,omitempty"`
+	ParentRoleId   string                 `protobuf:"bytes,3,opt,name=parent_role_id,json=parentRoleId,proto3" json:"parent_role_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddRoleInheritanceRequest) Reset() {
+	*x = AddRoleInheritanceRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoleInheritanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoleInheritanceRequest) ProtoMessage() {}
+
+func (x *AddRoleInheritanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRoleInheritanceRequest.ProtoReflect.Descriptor instead.
+func (*AddRoleInheritanceRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AddRoleInheritanceRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AddRoleInheritanceRequest) GetChildRoleId() string {
+	if x != nil {
+		return x.ChildRoleId
+	}
+	return ""
+}
+
+func (x *AddRoleInheritanceRequest) GetParentRoleId() string {
+	if x != nil {
+		return x.ParentRoleId
+	}
+	return ""
+}
+
+type AddRoleInheritanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edge          *RoleInheritanceEdge   `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRoleInheritanceResponse) Reset() {
+	*x = AddRoleInheritanceResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoleInheritanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoleInheritanceResponse) ProtoMessage() {}
+
+func (x *AddRoleInheritanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRoleInheritanceResponse.ProtoReflect.Descriptor instead.
+func (*AddRoleInheritanceResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AddRoleInheritanceResponse) GetEdge() *RoleInheritanceEdge {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+type RemoveRoleInheritanceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ChildRoleId    string                 `protobuf:"bytes,2,opt,name=child_role_id,json=childRoleId,proto3" json:"child_role_id,omitempty"`
+	ParentRoleId   string                 `protobuf:"bytes,3,opt,name=parent_role_id,json=parentRoleId,proto3" json:"parent_role_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveRoleInheritanceRequest) Reset() {
+	*x = RemoveRoleInheritanceRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoleInheritanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleInheritanceRequest) ProtoMessage() {}
+
+func (x *RemoveRoleInheritanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRoleInheritanceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRoleInheritanceRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RemoveRoleInheritanceRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RemoveRoleInheritanceRequest) GetChildRoleId() string {
+	if x != nil {
+		return x.ChildRoleId
+	}
+	return ""
+}
+
+func (x *RemoveRoleInheritanceRequest) GetParentRoleId() string {
+	if x != nil {
+		return x.ParentRoleId
+	}
+	return ""
+}
+
+type RemoveRoleInheritanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRoleInheritanceResponse) Reset() {
+	*x = RemoveRoleInheritanceResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoleInheritanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleInheritanceResponse) ProtoMessage() {}
+
+func (x *RemoveRoleInheritanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRoleInheritanceResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRoleInheritanceResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RemoveRoleInheritanceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetEffectivePermissionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetEffectivePermissionsRequest) Reset() {
+	*x = GetEffectivePermissionsRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectivePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectivePermissionsRequest) ProtoMessage() {}
+
+func (x *GetEffectivePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectivePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetEffectivePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetEffectivePermissionsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetEffectivePermissionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetEffectivePermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   []string               `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEffectivePermissionsResponse) Reset() {
+	*x = GetEffectivePermissionsResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectivePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectivePermissionsResponse) ProtoMessage() {}
+
+func (x *GetEffectivePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectivePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetEffectivePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetEffectivePermissionsResponse) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type RolesGraphRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RolesGraphRequest) Reset() {
+	*x = RolesGraphRequest{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolesGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolesGraphRequest) ProtoMessage() {}
+
+func (x *RolesGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolesGraphRequest.ProtoReflect.Descriptor instead.
+func (*RolesGraphRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RolesGraphRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type RoleGraphNode struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions          []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`                                               // The role's own permissions
+	EffectivePermissions []string               `protobuf:"bytes,4,rep,name=effective_permissions,json=effectivePermissions,proto3" json:"effective_permissions,omitempty"` // Including permissions inherited from ancestor roles
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RoleGraphNode) Reset() {
+	*x = RoleGraphNode{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleGraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleGraphNode) ProtoMessage() {}
+
+func (x *RoleGraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleGraphNode.ProtoReflect.Descriptor instead.
+func (*RoleGraphNode) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RoleGraphNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoleGraphNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleGraphNode) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *RoleGraphNode) GetEffectivePermissions() []string {
+	if x != nil {
+		return x.EffectivePermissions
+	}
+	return nil
+}
+
+type RolesGraphResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*RoleGraphNode       `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Edges         []*RoleInheritanceEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolesGraphResponse) Reset() {
+	*x = RolesGraphResponse{}
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolesGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolesGraphResponse) ProtoMessage() {}
+
+func (x *RolesGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolesGraphResponse.ProtoReflect.Descriptor instead.
+func (*RolesGraphResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RolesGraphResponse) GetRoles() []*RoleGraphNode {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *RolesGraphResponse) GetEdges() []*RoleInheritanceEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+var File_obiente_cloud_organizations_v1_organization_service_proto protoreflect.FileDescriptor
+
+const file_obiente_cloud_organizations_v1_organization_service_proto_rawDesc = "" +
+	"\n" +
+	"9obiente/cloud/organizations/v1/organization_service.proto\x12\x1eobiente.cloud.organizations.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(obiente/cloud/auth/v1/auth_service.proto\x1a$obiente/cloud/common/v1/common.proto\"_\n" +
+	"\x0fGetUsageRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\x05month\x18\x02 \x01(\tH\x00R\x05month\x88\x01\x01B\b\n" +
+	"\x06_month\"\xb6\x02\n" +
 	"\x10GetUsageResponse\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\tR\x05month\x12F\n" +
@@ -3050,7 +5851,7 @@ const file_obiente_cloud_organizations_v1_organization_service_proto_rawDesc = "
 	"\ainvites\x18\x01 \x03(\v2-.obiente.cloud.organizations.v1.PendingInviteR\ainvites\x12C\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2#.obiente.cloud.common.v1.PaginationR\n" +
-	"pagination\"\xe9\x01\n" +
+	"pagination\"\xa4\x02\n" +
 	"\rPendingInvite\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12+\n" +
@@ -3058,7 +5859,9 @@ const file_obiente_cloud_organizations_v1_organization_service_proto_rawDesc = "
 	"\x04role\x18\x04 \x01(\tR\x04role\x129\n" +
 	"\n" +
 	"invited_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tinvitedAt\x12#\n" +
-	"\rinviter_email\x18\x06 \x01(\tR\finviterEmail\"[\n" +
+	"\rinviter_email\x18\x06 \x01(\tR\finviterEmail\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"[\n" +
 	"\x13AcceptInviteRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tmember_id\x18\x02 \x01(\tR\bmemberId\"\xb4\x01\n" +
@@ -3090,7 +5893,7 @@ const file_obiente_cloud_organizations_v1_organization_service_proto_rawDesc = "
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x127\n" +
 	"\x18previous_owner_member_id\x18\x02 \x01(\tR\x15previousOwnerMemberId\x12-\n" +
 	"\x13new_owner_member_id\x18\x03 \x01(\tR\x10newOwnerMemberId\x12#\n" +
-	"\rfallback_role\x18\x04 \x01(\tR\ffallbackRole\"\xf2\x03\n" +
+	"\rfallback_role\x18\x04 \x01(\tR\ffallbackRole\"\xac\x05\n" +
 	"\fOrganization\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -3106,10 +5909,14 @@ const file_obiente_cloud_organizations_v1_organization_service_proto_rawDesc = "
 	" \x01(\x05R\x0emaxTeamMembers\x12\x18\n" +
 	"\acredits\x18\v \x01(\x03R\acredits\x12J\n" +
 	"\tplan_info\x18\f \x01(\v2(.obiente.cloud.organizations.v1.PlanInfoH\x01R\bplanInfo\x88\x01\x01\x12(\n" +
-	"\x10total_paid_cents\x18\r \x01(\x03R\x0etotalPaidCentsB\t\n" +
+	"\x10total_paid_cents\x18\r \x01(\x03R\x0etotalPaidCents\x12B\n" +
+	"\x1bmerged_into_organization_id\x18\x0e \x01(\tH\x02R\x18mergedIntoOrganizationId\x88\x01\x01\x129\n" +
+	"\x16parent_organization_id\x18\x0f \x01(\tH\x03R\x14parentOrganizationId\x88\x01\x01B\t\n" +
 	"\a_domainB\f\n" +
 	"\n" +
-	"_plan_info\"\xe0\x03\n" +
+	"_plan_infoB\x1e\n" +
+	"\x1c_merged_into_organization_idB\x19\n" +
+	"\x17_parent_organization_id\"\xe0\x03\n" +
 	"\bPlanInfo\x12\x17\n" +
 	"\aplan_id\x18\x01 \x01(\tR\x06planId\x12\x1b\n" +
 	"\tplan_name\x18\x02 \x01(\tR\bplanName\x12 \n" +
@@ -3180,7 +5987,97 @@ const file_obiente_cloud_organizations_v1_organization_service_proto_rawDesc = "
 	"\n" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
 	"\x05_noteB\r\n" +
-	"\v_created_by\"B\n" +
+	"\v_created_by\"x\n" +
+	"\x1eGetOrganizationAuditLogRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\"\xaa\x01\n" +
+	"\x1fGetOrganizationAuditLogResponse\x12B\n" +
+	"\x06events\x18\x01 \x03(\v2*.obiente.cloud.organizations.v1.AuditEventR\x06events\x12C\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2#.obiente.cloud.common.v1.PaginationR\n" +
+	"pagination\"\xd6\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x18\n" +
+	"\aservice\x18\x04 \x01(\tR\aservice\x12(\n" +
+	"\rresource_type\x18\x05 \x01(\tH\x00R\fresourceType\x88\x01\x01\x12$\n" +
+	"\vresource_id\x18\x06 \x01(\tH\x01R\n" +
+	"resourceId\x88\x01\x01\x12D\n" +
+	"\x04diff\x18\a \x03(\v20.obiente.cloud.organizations.v1.AuditFieldChangeR\x04diff\x128\n" +
+	"\ttimestamp\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\ttimestampB\x10\n" +
+	"\x0e_resource_typeB\x0e\n" +
+	"\f_resource_id\"\x88\x01\n" +
+	"\x10AuditFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12 \n" +
+	"\told_value\x18\x02 \x01(\tH\x00R\boldValue\x88\x01\x01\x12 \n" +
+	"\tnew_value\x18\x03 \x01(\tH\x01R\bnewValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_old_valueB\f\n" +
+	"\n" +
+	"_new_value\"\xf7\x01\n" +
+	"\"GetOrganizationActivityFeedRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1e\n" +
+	"\bafter_id\x18\x02 \x01(\tH\x00R\aafterId\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\"\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tH\x01R\teventType\x88\x01\x01\x12\x1e\n" +
+	"\bactor_id\x18\x05 \x01(\tH\x02R\aactorId\x88\x01\x01B\v\n" +
+	"\t_after_idB\r\n" +
+	"\v_event_typeB\v\n" +
+	"\t_actor_id\"\xa7\x01\n" +
+	"#GetOrganizationActivityFeedResponse\x12E\n" +
+	"\x06events\x18\x01 \x03(\v2-.obiente.cloud.organizations.v1.ActivityEventR\x06events\x12'\n" +
+	"\rnext_after_id\x18\x02 \x01(\tH\x00R\vnextAfterId\x88\x01\x01B\x10\n" +
+	"\x0e_next_after_id\"\x85\x03\n" +
+	"\rActivityEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12#\n" +
+	"\rresource_type\x18\x04 \x01(\tR\fresourceType\x12$\n" +
+	"\vresource_id\x18\x05 \x01(\tH\x00R\n" +
+	"resourceId\x88\x01\x01\x12W\n" +
+	"\bmetadata\x18\x06 \x03(\v2;.obiente.cloud.organizations.v1.ActivityEvent.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_resource_id\"\xda\x03\n" +
+	"\n" +
+	"SAMLConfig\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x17\n" +
+	"\asso_url\x18\x03 \x01(\tR\x06ssoUrl\x12 \n" +
+	"\vcertificate\x18\x04 \x01(\tR\vcertificate\x12m\n" +
+	"\x11attribute_mapping\x18\x05 \x03(\v2@.obiente.cloud.organizations.v1.SAMLConfig.AttributeMappingEntryR\x10attributeMapping\x12 \n" +
+	"\fsp_entity_id\x18\x06 \x01(\tR\n" +
+	"spEntityId\x12\x17\n" +
+	"\aacs_url\x18\a \x01(\tR\x06acsUrl\x12!\n" +
+	"\fmetadata_url\x18\b \x01(\tR\vmetadataUrl\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aC\n" +
+	"\x15AttributeMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd5\x02\n" +
+	"\x14ConfigureSAMLRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x17\n" +
+	"\asso_url\x18\x03 \x01(\tR\x06ssoUrl\x12 \n" +
+	"\vcertificate\x18\x04 \x01(\tR\vcertificate\x12w\n" +
+	"\x11attribute_mapping\x18\x05 \x03(\v2J.obiente.cloud.organizations.v1.ConfigureSAMLRequest.AttributeMappingEntryR\x10attributeMapping\x1aC\n" +
+	"\x15AttributeMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"[\n" +
+	"\x15ConfigureSAMLResponse\x12B\n" +
+	"\x06config\x18\x01 \x01(\v2*.obiente.cloud.organizations.v1.SAMLConfigR\x06config\"?\n" +
+	"\x14GetSAMLConfigRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"[\n" +
+	"\x15GetSAMLConfigResponse\x12B\n" +
+	"\x06config\x18\x01 \x01(\v2*.obiente.cloud.organizations.v1.SAMLConfigR\x06config\"B\n" +
 	"\x17GetMyPermissionsRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"<\n" +
 	"\x18GetMyPermissionsResponse\x12 \n" +
@@ -3190,7 +6087,148 @@ const file_obiente_cloud_organizations_v1_organization_service_proto_rawDesc = "
 	"\aplan_id\x18\x02 \x01(\tR\x06planId\"\x81\x01\n" +
 	"\x14AdminSetPlanResponse\x12P\n" +
 	"\forganization\x18\x01 \x01(\v2,.obiente.cloud.organizations.v1.OrganizationR\forganization\x12\x17\n" +
-	"\aplan_id\x18\x02 \x01(\tR\x06planId2\x9c\x14\n" +
+	"\aplan_id\x18\x02 \x01(\tR\x06planId\"\xdd\x01\n" +
+	"\x0eTaggedResource\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\x12L\n" +
+	"\x04tags\x18\x03 \x03(\v28.obiente.cloud.organizations.v1.TaggedResource.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xae\x01\n" +
+	"\x15AddResourceTagRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\tR\n" +
+	"resourceId\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\"d\n" +
+	"\x16AddResourceTagResponse\x12J\n" +
+	"\bresource\x18\x01 \x01(\v2..obiente.cloud.organizations.v1.TaggedResourceR\bresource\"\x9b\x01\n" +
+	"\x18RemoveResourceTagRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\tR\n" +
+	"resourceId\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\"g\n" +
+	"\x19RemoveResourceTagResponse\x12J\n" +
+	"\bresource\x18\x01 \x01(\v2..obiente.cloud.organizations.v1.TaggedResourceR\bresource\"\x92\x02\n" +
+	"\x19ListResourcesByTagRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12W\n" +
+	"\x04tags\x18\x02 \x03(\v2C.obiente.cloud.organizations.v1.ListResourcesByTagRequest.TagsEntryR\x04tags\x12(\n" +
+	"\rresource_type\x18\x03 \x01(\tH\x00R\fresourceType\x88\x01\x01\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x10\n" +
+	"\x0e_resource_type\"j\n" +
+	"\x1aListResourcesByTagResponse\x12L\n" +
+	"\tresources\x18\x01 \x03(\v2..obiente.cloud.organizations.v1.TaggedResourceR\tresources\"\xd5\x01\n" +
+	"\x04Team\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12&\n" +
+	"\x0fmember_user_ids\x18\x05 \x03(\tR\rmemberUserIds\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8c\x03\n" +
+	"\tTeamQuota\x12 \n" +
+	"\tcpu_cores\x18\x01 \x01(\x05H\x00R\bcpuCores\x88\x01\x01\x12&\n" +
+	"\fmemory_bytes\x18\x02 \x01(\x03H\x01R\vmemoryBytes\x88\x01\x01\x12,\n" +
+	"\x0fdeployments_max\x18\x03 \x01(\x05H\x02R\x0edeploymentsMax\x88\x01\x01\x12/\n" +
+	"\x11max_vps_instances\x18\x04 \x01(\x05H\x03R\x0fmaxVpsInstances\x88\x01\x01\x127\n" +
+	"\x15bandwidth_bytes_month\x18\x05 \x01(\x03H\x04R\x13bandwidthBytesMonth\x88\x01\x01\x12(\n" +
+	"\rstorage_bytes\x18\x06 \x01(\x03H\x05R\fstorageBytes\x88\x01\x01B\f\n" +
+	"\n" +
+	"_cpu_coresB\x0f\n" +
+	"\r_memory_bytesB\x12\n" +
+	"\x10_deployments_maxB\x14\n" +
+	"\x12_max_vps_instancesB\x18\n" +
+	"\x16_bandwidth_bytes_monthB\x10\n" +
+	"\x0e_storage_bytes\"\x83\x02\n" +
+	"\tTeamUsage\x12 \n" +
+	"\vdeployments\x18\x01 \x01(\x05R\vdeployments\x12!\n" +
+	"\fgame_servers\x18\x02 \x01(\x05R\vgameServers\x12#\n" +
+	"\rvps_instances\x18\x03 \x01(\x05R\fvpsInstances\x12!\n" +
+	"\fmemory_bytes\x18\x04 \x01(\x03R\vmemoryBytes\x12\x1b\n" +
+	"\tcpu_cores\x18\x05 \x01(\x05R\bcpuCores\x12#\n" +
+	"\rstorage_bytes\x18\x06 \x01(\x03R\fstorageBytes\x12'\n" +
+	"\x0fbandwidth_bytes\x18\a \x01(\x03R\x0ebandwidthBytes\"x\n" +
+	"\x11CreateTeamRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
+	"\x0fmember_user_ids\x18\x03 \x03(\tR\rmemberUserIds\"N\n" +
+	"\x12CreateTeamResponse\x128\n" +
+	"\x04team\x18\x01 \x01(\v2$.obiente.cloud.organizations.v1.TeamR\x04team\"U\n" +
+	"\x11DeleteTeamRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\".\n" +
+	"\x12DeleteTeamResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x98\x01\n" +
+	"\x13SetTeamQuotaRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\x12?\n" +
+	"\x05quota\x18\x03 \x01(\v2).obiente.cloud.organizations.v1.TeamQuotaR\x05quota\"W\n" +
+	"\x14SetTeamQuotaResponse\x12?\n" +
+	"\x05quota\x18\x01 \x01(\v2).obiente.cloud.organizations.v1.TeamQuotaR\x05quota\"W\n" +
+	"\x13GetTeamUsageRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\"\xd2\x01\n" +
+	"\x14GetTeamUsageResponse\x128\n" +
+	"\x04team\x18\x01 \x01(\v2$.obiente.cloud.organizations.v1.TeamR\x04team\x12?\n" +
+	"\x05usage\x18\x02 \x01(\v2).obiente.cloud.organizations.v1.TeamUsageR\x05usage\x12?\n" +
+	"\x05quota\x18\x03 \x01(\v2).obiente.cloud.organizations.v1.TeamQuotaR\x05quota\"\x87\x01\n" +
+	"\x19MergeOrganizationsRequest\x124\n" +
+	"\x16source_organization_id\x18\x01 \x01(\tR\x14sourceOrganizationId\x124\n" +
+	"\x16target_organization_id\x18\x02 \x01(\tR\x14targetOrganizationId\"\x97\x03\n" +
+	"\x1aMergeOrganizationsResponse\x12]\n" +
+	"\x13target_organization\x18\x01 \x01(\v2,.obiente.cloud.organizations.v1.OrganizationR\x12targetOrganization\x12+\n" +
+	"\x11deployments_moved\x18\x02 \x01(\x05R\x10deploymentsMoved\x12,\n" +
+	"\x12game_servers_moved\x18\x03 \x01(\x05R\x10gameServersMoved\x12.\n" +
+	"\x13vps_instances_moved\x18\x04 \x01(\x05R\x11vpsInstancesMoved\x12#\n" +
+	"\rmembers_moved\x18\x05 \x01(\x05R\fmembersMoved\x12:\n" +
+	"\x19credit_transactions_moved\x18\x06 \x01(\x05R\x17creditTransactionsMoved\x12.\n" +
+	"\x13credits_moved_cents\x18\a \x01(\x03R\x11creditsMovedCents\"\x84\x01\n" +
+	" CreateManagedOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rbilling_email\x18\x03 \x01(\tR\fbillingEmail\"u\n" +
+	"!CreateManagedOrganizationResponse\x12P\n" +
+	"\forganization\x18\x01 \x01(\v2,.obiente.cloud.organizations.v1.OrganizationR\forganization\"J\n" +
+	"\x1fListManagedOrganizationsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"v\n" +
+	" ListManagedOrganizationsResponse\x12R\n" +
+	"\rorganizations\x18\x01 \x03(\v2,.obiente.cloud.organizations.v1.OrganizationR\rorganizations\"_\n" +
+	"\x13RoleInheritanceEdge\x12\"\n" +
+	"\rchild_role_id\x18\x01 \x01(\tR\vchildRoleId\x12$\n" +
+	"\x0eparent_role_id\x18\x02 \x01(\tR\fparentRoleId\"\x8e\x01\n" +
+	"\x19AddRoleInheritanceRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\"\n" +
+	"\rchild_role_id\x18\x02 \x01(\tR\vchildRoleId\x12$\n" +
+	"\x0eparent_role_id\x18\x03 \x01(\tR\fparentRoleId\"e\n" +
+	"\x1aAddRoleInheritanceResponse\x12G\n" +
+	"\x04edge\x18\x01 \x01(\v23.obiente.cloud.organizations.v1.RoleInheritanceEdgeR\x04edge\"\x91\x01\n" +
+	"\x1cRemoveRoleInheritanceRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\"\n" +
+	"\rchild_role_id\x18\x02 \x01(\tR\vchildRoleId\x12$\n" +
+	"\x0eparent_role_id\x18\x03 \x01(\tR\fparentRoleId\"9\n" +
+	"\x1dRemoveRoleInheritanceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"b\n" +
+	"\x1eGetEffectivePermissionsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"C\n" +
+	"\x1fGetEffectivePermissionsResponse\x12 \n" +
+	"\vpermissions\x18\x01 \x03(\tR\vpermissions\"<\n" +
+	"\x11RolesGraphRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x8a\x01\n" +
+	"\rRoleGraphNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\x123\n" +
+	"\x15effective_permissions\x18\x04 \x03(\tR\x14effectivePermissions\"\xa4\x01\n" +
+	"\x12RolesGraphResponse\x12C\n" +
+	"\x05roles\x18\x01 \x03(\v2-.obiente.cloud.organizations.v1.RoleGraphNodeR\x05roles\x12I\n" +
+	"\x05edges\x18\x02 \x03(\v23.obiente.cloud.organizations.v1.RoleInheritanceEdgeR\x05edges2\xe0'\n" +
 	"\x13OrganizationService\x12y\n" +
 	"\fAdminSetPlan\x123.obiente.cloud.organizations.v1.AdminSetPlanRequest\x1a4.obiente.cloud.organizations.v1.AdminSetPlanResponse\x12\x88\x01\n" +
 	"\x11ListOrganizations\x128.obiente.cloud.organizations.v1.ListOrganizationsRequest\x1a9.obiente.cloud.organizations.v1.ListOrganizationsResponse\x12\x8b\x01\n" +
@@ -3212,7 +6250,28 @@ const file_obiente_cloud_organizations_v1_organization_service_proto_rawDesc = "
 	"\x0fAdminAddCredits\x126.obiente.cloud.organizations.v1.AdminAddCreditsRequest\x1a7.obiente.cloud.organizations.v1.AdminAddCreditsResponse\x12\x8b\x01\n" +
 	"\x12AdminRemoveCredits\x129.obiente.cloud.organizations.v1.AdminRemoveCreditsRequest\x1a:.obiente.cloud.organizations.v1.AdminRemoveCreditsResponse\x12y\n" +
 	"\fGetCreditLog\x123.obiente.cloud.organizations.v1.GetCreditLogRequest\x1a4.obiente.cloud.organizations.v1.GetCreditLogResponse\x12\x85\x01\n" +
-	"\x10GetMyPermissions\x127.obiente.cloud.organizations.v1.GetMyPermissionsRequest\x1a8.obiente.cloud.organizations.v1.GetMyPermissionsResponseB[ZYgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1;organizationsv1b\x06proto3"
+	"\x10GetMyPermissions\x127.obiente.cloud.organizations.v1.GetMyPermissionsRequest\x1a8.obiente.cloud.organizations.v1.GetMyPermissionsResponse\x12\x9a\x01\n" +
+	"\x17GetOrganizationAuditLog\x12>.obiente.cloud.organizations.v1.GetOrganizationAuditLogRequest\x1a?.obiente.cloud.organizations.v1.GetOrganizationAuditLogResponse\x12\xa6\x01\n" +
+	"\x1bGetOrganizationActivityFeed\x12B.obiente.cloud.organizations.v1.GetOrganizationActivityFeedRequest\x1aC.obiente.cloud.organizations.v1.GetOrganizationActivityFeedResponse\x12|\n" +
+	"\rConfigureSAML\x124.obiente.cloud.organizations.v1.ConfigureSAMLRequest\x1a5.obiente.cloud.organizations.v1.ConfigureSAMLResponse\x12|\n" +
+	"\rGetSAMLConfig\x124.obiente.cloud.organizations.v1.GetSAMLConfigRequest\x1a5.obiente.cloud.organizations.v1.GetSAMLConfigResponse\x12\x7f\n" +
+	"\x0eAddResourceTag\x125.obiente.cloud.organizations.v1.AddResourceTagRequest\x1a6.obiente.cloud.organizations.v1.AddResourceTagResponse\x12\x88\x01\n" +
+	"\x11RemoveResourceTag\x128.obiente.cloud.organizations.v1.RemoveResourceTagRequest\x1a9.obiente.cloud.organizations.v1.RemoveResourceTagResponse\x12\x8b\x01\n" +
+	"\x12ListResourcesByTag\x129.obiente.cloud.organizations.v1.ListResourcesByTagRequest\x1a:.obiente.cloud.organizations.v1.ListResourcesByTagResponse\x12s\n" +
+	"\n" +
+	"CreateTeam\x121.obiente.cloud.organizations.v1.CreateTeamRequest\x1a2.obiente.cloud.organizations.v1.CreateTeamResponse\x12s\n" +
+	"\n" +
+	"DeleteTeam\x121.obiente.cloud.organizations.v1.DeleteTeamRequest\x1a2.obiente.cloud.organizations.v1.DeleteTeamResponse\x12y\n" +
+	"\fSetTeamQuota\x123.obiente.cloud.organizations.v1.SetTeamQuotaRequest\x1a4.obiente.cloud.organizations.v1.SetTeamQuotaResponse\x12y\n" +
+	"\fGetTeamUsage\x123.obiente.cloud.organizations.v1.GetTeamUsageRequest\x1a4.obiente.cloud.organizations.v1.GetTeamUsageResponse\x12\x8b\x01\n" +
+	"\x12MergeOrganizations\x129.obiente.cloud.organizations.v1.MergeOrganizationsRequest\x1a:.obiente.cloud.organizations.v1.MergeOrganizationsResponse\x12\xa0\x01\n" +
+	"\x19CreateManagedOrganization\x12@.obiente.cloud.organizations.v1.CreateManagedOrganizationRequest\x1aA.obiente.cloud.organizations.v1.CreateManagedOrganizationResponse\x12\x9d\x01\n" +
+	"\x18ListManagedOrganizations\x12?.obiente.cloud.organizations.v1.ListManagedOrganizationsRequest\x1a@.obiente.cloud.organizations.v1.ListManagedOrganizationsResponse\x12\x8b\x01\n" +
+	"\x12AddRoleInheritance\x129.obiente.cloud.organizations.v1.AddRoleInheritanceRequest\x1a:.obiente.cloud.organizations.v1.AddRoleInheritanceResponse\x12\x94\x01\n" +
+	"\x15RemoveRoleInheritance\x12<.obiente.cloud.organizations.v1.RemoveRoleInheritanceRequest\x1a=.obiente.cloud.organizations.v1.RemoveRoleInheritanceResponse\x12\x9a\x01\n" +
+	"\x17GetEffectivePermissions\x12>.obiente.cloud.organizations.v1.GetEffectivePermissionsRequest\x1a?.obiente.cloud.organizations.v1.GetEffectivePermissionsResponse\x12s\n" +
+	"\n" +
+	"RolesGraph\x121.obiente.cloud.organizations.v1.RolesGraphRequest\x1a2.obiente.cloud.organizations.v1.RolesGraphResponseB[ZYgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/organizations/v1;organizationsv1b\x06proto3"
 
 var (
 	file_obiente_cloud_organizations_v1_organization_service_proto_rawDescOnce sync.Once
@@ -3226,133 +6285,251 @@ func file_obiente_cloud_organizations_v1_organization_service_proto_rawDescGZIP(
 	return file_obiente_cloud_organizations_v1_organization_service_proto_rawDescData
 }
 
-var file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
 var file_obiente_cloud_organizations_v1_organization_service_proto_goTypes = []any{
-	(*GetUsageRequest)(nil),            // 0: obiente.cloud.organizations.v1.GetUsageRequest
-	(*GetUsageResponse)(nil),           // 1: obiente.cloud.organizations.v1.GetUsageResponse
-	(*UsageMetrics)(nil),               // 2: obiente.cloud.organizations.v1.UsageMetrics
-	(*UsageQuota)(nil),                 // 3: obiente.cloud.organizations.v1.UsageQuota
-	(*ListOrganizationsRequest)(nil),   // 4: obiente.cloud.organizations.v1.ListOrganizationsRequest
-	(*ListOrganizationsResponse)(nil),  // 5: obiente.cloud.organizations.v1.ListOrganizationsResponse
-	(*CreateOrganizationRequest)(nil),  // 6: obiente.cloud.organizations.v1.CreateOrganizationRequest
-	(*CreateOrganizationResponse)(nil), // 7: obiente.cloud.organizations.v1.CreateOrganizationResponse
-	(*GetOrganizationRequest)(nil),     // 8: obiente.cloud.organizations.v1.GetOrganizationRequest
-	(*GetOrganizationResponse)(nil),    // 9: obiente.cloud.organizations.v1.GetOrganizationResponse
-	(*UpdateOrganizationRequest)(nil),  // 10: obiente.cloud.organizations.v1.UpdateOrganizationRequest
-	(*UpdateOrganizationResponse)(nil), // 11: obiente.cloud.organizations.v1.UpdateOrganizationResponse
-	(*ListMembersRequest)(nil),         // 12: obiente.cloud.organizations.v1.ListMembersRequest
-	(*ListMembersResponse)(nil),        // 13: obiente.cloud.organizations.v1.ListMembersResponse
-	(*InviteMemberRequest)(nil),        // 14: obiente.cloud.organizations.v1.InviteMemberRequest
-	(*InviteMemberResponse)(nil),       // 15: obiente.cloud.organizations.v1.InviteMemberResponse
-	(*ResendInviteRequest)(nil),        // 16: obiente.cloud.organizations.v1.ResendInviteRequest
-	(*ResendInviteResponse)(nil),       // 17: obiente.cloud.organizations.v1.ResendInviteResponse
-	(*ListMyInvitesRequest)(nil),       // 18: obiente.cloud.organizations.v1.ListMyInvitesRequest
-	(*ListMyInvitesResponse)(nil),      // 19: obiente.cloud.organizations.v1.ListMyInvitesResponse
-	(*PendingInvite)(nil),              // 20: obiente.cloud.organizations.v1.PendingInvite
-	(*AcceptInviteRequest)(nil),        // 21: obiente.cloud.organizations.v1.AcceptInviteRequest
-	(*AcceptInviteResponse)(nil),       // 22: obiente.cloud.organizations.v1.AcceptInviteResponse
-	(*DeclineInviteRequest)(nil),       // 23: obiente.cloud.organizations.v1.DeclineInviteRequest
-	(*DeclineInviteResponse)(nil),      // 24: obiente.cloud.organizations.v1.DeclineInviteResponse
-	(*UpdateMemberRequest)(nil),        // 25: obiente.cloud.organizations.v1.UpdateMemberRequest
-	(*UpdateMemberResponse)(nil),       // 26: obiente.cloud.organizations.v1.UpdateMemberResponse
-	(*RemoveMemberRequest)(nil),        // 27: obiente.cloud.organizations.v1.RemoveMemberRequest
-	(*RemoveMemberResponse)(nil),       // 28: obiente.cloud.organizations.v1.RemoveMemberResponse
-	(*TransferOwnershipRequest)(nil),   // 29: obiente.cloud.organizations.v1.TransferOwnershipRequest
-	(*TransferOwnershipResponse)(nil),  // 30: obiente.cloud.organizations.v1.TransferOwnershipResponse
-	(*Organization)(nil),               // 31: obiente.cloud.organizations.v1.Organization
-	(*PlanInfo)(nil),                   // 32: obiente.cloud.organizations.v1.PlanInfo
-	(*OrganizationMember)(nil),         // 33: obiente.cloud.organizations.v1.OrganizationMember
-	(*AddCreditsRequest)(nil),          // 34: obiente.cloud.organizations.v1.AddCreditsRequest
-	(*AddCreditsResponse)(nil),         // 35: obiente.cloud.organizations.v1.AddCreditsResponse
-	(*AdminAddCreditsRequest)(nil),     // 36: obiente.cloud.organizations.v1.AdminAddCreditsRequest
-	(*AdminAddCreditsResponse)(nil),    // 37: obiente.cloud.organizations.v1.AdminAddCreditsResponse
-	(*AdminRemoveCreditsRequest)(nil),  // 38: obiente.cloud.organizations.v1.AdminRemoveCreditsRequest
-	(*AdminRemoveCreditsResponse)(nil), // 39: obiente.cloud.organizations.v1.AdminRemoveCreditsResponse
-	(*GetCreditLogRequest)(nil),        // 40: obiente.cloud.organizations.v1.GetCreditLogRequest
-	(*GetCreditLogResponse)(nil),       // 41: obiente.cloud.organizations.v1.GetCreditLogResponse
-	(*CreditTransaction)(nil),          // 42: obiente.cloud.organizations.v1.CreditTransaction
-	(*GetMyPermissionsRequest)(nil),    // 43: obiente.cloud.organizations.v1.GetMyPermissionsRequest
-	(*GetMyPermissionsResponse)(nil),   // 44: obiente.cloud.organizations.v1.GetMyPermissionsResponse
-	(*AdminSetPlanRequest)(nil),        // 45: obiente.cloud.organizations.v1.AdminSetPlanRequest
-	(*AdminSetPlanResponse)(nil),       // 46: obiente.cloud.organizations.v1.AdminSetPlanResponse
-	(*v1.Pagination)(nil),              // 47: obiente.cloud.common.v1.Pagination
-	(*timestamppb.Timestamp)(nil),      // 48: google.protobuf.Timestamp
-	(*v11.User)(nil),                   // 49: obiente.cloud.auth.v1.User
+	(*GetUsageRequest)(nil),                     // 0: obiente.cloud.organizations.v1.GetUsageRequest
+	(*GetUsageResponse)(nil),                    // 1: obiente.cloud.organizations.v1.GetUsageResponse
+	(*UsageMetrics)(nil),                        // 2: obiente.cloud.organizations.v1.UsageMetrics
+	(*UsageQuota)(nil),                          // 3: obiente.cloud.organizations.v1.UsageQuota
+	(*ListOrganizationsRequest)(nil),            // 4: obiente.cloud.organizations.v1.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),           // 5: obiente.cloud.organizations.v1.ListOrganizationsResponse
+	(*CreateOrganizationRequest)(nil),           // 6: obiente.cloud.organizations.v1.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),          // 7: obiente.cloud.organizations.v1.CreateOrganizationResponse
+	(*GetOrganizationRequest)(nil),              // 8: obiente.cloud.organizations.v1.GetOrganizationRequest
+	(*GetOrganizationResponse)(nil),             // 9: obiente.cloud.organizations.v1.GetOrganizationResponse
+	(*UpdateOrganizationRequest)(nil),           // 10: obiente.cloud.organizations.v1.UpdateOrganizationRequest
+	(*UpdateOrganizationResponse)(nil),          // 11: obiente.cloud.organizations.v1.UpdateOrganizationResponse
+	(*ListMembersRequest)(nil),                  // 12: obiente.cloud.organizations.v1.ListMembersRequest
+	(*ListMembersResponse)(nil),                 // 13: obiente.cloud.organizations.v1.ListMembersResponse
+	(*InviteMemberRequest)(nil),                 // 14: obiente.cloud.organizations.v1.InviteMemberRequest
+	(*InviteMemberResponse)(nil),                // 15: obiente.cloud.organizations.v1.InviteMemberResponse
+	(*ResendInviteRequest)(nil),                 // 16: obiente.cloud.organizations.v1.ResendInviteRequest
+	(*ResendInviteResponse)(nil),                // 17: obiente.cloud.organizations.v1.ResendInviteResponse
+	(*ListMyInvitesRequest)(nil),                // 18: obiente.cloud.organizations.v1.ListMyInvitesRequest
+	(*ListMyInvitesResponse)(nil),               // 19: obiente.cloud.organizations.v1.ListMyInvitesResponse
+	(*PendingInvite)(nil),                       // 20: obiente.cloud.organizations.v1.PendingInvite
+	(*AcceptInviteRequest)(nil),                 // 21: obiente.cloud.organizations.v1.AcceptInviteRequest
+	(*AcceptInviteResponse)(nil),                // 22: obiente.cloud.organizations.v1.AcceptInviteResponse
+	(*DeclineInviteRequest)(nil),                // 23: obiente.cloud.organizations.v1.DeclineInviteRequest
+	(*DeclineInviteResponse)(nil),               // 24: obiente.cloud.organizations.v1.DeclineInviteResponse
+	(*UpdateMemberRequest)(nil),                 // 25: obiente.cloud.organizations.v1.UpdateMemberRequest
+	(*UpdateMemberResponse)(nil),                // 26: obiente.cloud.organizations.v1.UpdateMemberResponse
+	(*RemoveMemberRequest)(nil),                 // 27: obiente.cloud.organizations.v1.RemoveMemberRequest
+	(*RemoveMemberResponse)(nil),                // 28: obiente.cloud.organizations.v1.RemoveMemberResponse
+	(*TransferOwnershipRequest)(nil),            // 29: obiente.cloud.organizations.v1.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil),           // 30: obiente.cloud.organizations.v1.TransferOwnershipResponse
+	(*Organization)(nil),                        // 31: obiente.cloud.organizations.v1.Organization
+	(*PlanInfo)(nil),                            // 32: obiente.cloud.organizations.v1.PlanInfo
+	(*OrganizationMember)(nil),                  // 33: obiente.cloud.organizations.v1.OrganizationMember
+	(*AddCreditsRequest)(nil),                   // 34: obiente.cloud.organizations.v1.AddCreditsRequest
+	(*AddCreditsResponse)(nil),                  // 35: obiente.cloud.organizations.v1.AddCreditsResponse
+	(*AdminAddCreditsRequest)(nil),              // 36: obiente.cloud.organizations.v1.AdminAddCreditsRequest
+	(*AdminAddCreditsResponse)(nil),             // 37: obiente.cloud.organizations.v1.AdminAddCreditsResponse
+	(*AdminRemoveCreditsRequest)(nil),           // 38: obiente.cloud.organizations.v1.AdminRemoveCreditsRequest
+	(*AdminRemoveCreditsResponse)(nil),          // 39: obiente.cloud.organizations.v1.AdminRemoveCreditsResponse
+	(*GetCreditLogRequest)(nil),                 // 40: obiente.cloud.organizations.v1.GetCreditLogRequest
+	(*GetCreditLogResponse)(nil),                // 41: obiente.cloud.organizations.v1.GetCreditLogResponse
+	(*CreditTransaction)(nil),                   // 42: obiente.cloud.organizations.v1.CreditTransaction
+	(*GetOrganizationAuditLogRequest)(nil),      // 43: obiente.cloud.organizations.v1.GetOrganizationAuditLogRequest
+	(*GetOrganizationAuditLogResponse)(nil),     // 44: obiente.cloud.organizations.v1.GetOrganizationAuditLogResponse
+	(*AuditEvent)(nil),                          // 45: obiente.cloud.organizations.v1.AuditEvent
+	(*AuditFieldChange)(nil),                    // 46: obiente.cloud.organizations.v1.AuditFieldChange
+	(*GetOrganizationActivityFeedRequest)(nil),  // 47: obiente.cloud.organizations.v1.GetOrganizationActivityFeedRequest
+	(*GetOrganizationActivityFeedResponse)(nil), // 48: obiente.cloud.organizations.v1.GetOrganizationActivityFeedResponse
+	(*ActivityEvent)(nil),                       // 49: obiente.cloud.organizations.v1.ActivityEvent
+	(*SAMLConfig)(nil),                          // 50: obiente.cloud.organizations.v1.SAMLConfig
+	(*ConfigureSAMLRequest)(nil),                // 51: obiente.cloud.organizations.v1.ConfigureSAMLRequest
+	(*ConfigureSAMLResponse)(nil),               // 52: obiente.cloud.organizations.v1.ConfigureSAMLResponse
+	(*GetSAMLConfigRequest)(nil),                // 53: obiente.cloud.organizations.v1.GetSAMLConfigRequest
+	(*GetSAMLConfigResponse)(nil),               // 54: obiente.cloud.organizations.v1.GetSAMLConfigResponse
+	(*GetMyPermissionsRequest)(nil),             // 55: obiente.cloud.organizations.v1.GetMyPermissionsRequest
+	(*GetMyPermissionsResponse)(nil),            // 56: obiente.cloud.organizations.v1.GetMyPermissionsResponse
+	(*AdminSetPlanRequest)(nil),                 // 57: obiente.cloud.organizations.v1.AdminSetPlanRequest
+	(*AdminSetPlanResponse)(nil),                // 58: obiente.cloud.organizations.v1.AdminSetPlanResponse
+	(*TaggedResource)(nil),                      // 59: obiente.cloud.organizations.v1.TaggedResource
+	(*AddResourceTagRequest)(nil),               // 60: obiente.cloud.organizations.v1.AddResourceTagRequest
+	(*AddResourceTagResponse)(nil),              // 61: obiente.cloud.organizations.v1.AddResourceTagResponse
+	(*RemoveResourceTagRequest)(nil),            // 62: obiente.cloud.organizations.v1.RemoveResourceTagRequest
+	(*RemoveResourceTagResponse)(nil),           // 63: obiente.cloud.organizations.v1.RemoveResourceTagResponse
+	(*ListResourcesByTagRequest)(nil),           // 64: obiente.cloud.organizations.v1.ListResourcesByTagRequest
+	(*ListResourcesByTagResponse)(nil),          // 65: obiente.cloud.organizations.v1.ListResourcesByTagResponse
+	(*Team)(nil),                                // 66: obiente.cloud.organizations.v1.Team
+	(*TeamQuota)(nil),                           // 67: obiente.cloud.organizations.v1.TeamQuota
+	(*TeamUsage)(nil),                           // 68: obiente.cloud.organizations.v1.TeamUsage
+	(*CreateTeamRequest)(nil),                   // 69: obiente.cloud.organizations.v1.CreateTeamRequest
+	(*CreateTeamResponse)(nil),                  // 70: obiente.cloud.organizations.v1.CreateTeamResponse
+	(*DeleteTeamRequest)(nil),                   // 71: obiente.cloud.organizations.v1.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),                  // 72: obiente.cloud.organizations.v1.DeleteTeamResponse
+	(*SetTeamQuotaRequest)(nil),                 // 73: obiente.cloud.organizations.v1.SetTeamQuotaRequest
+	(*SetTeamQuotaResponse)(nil),                // 74: obiente.cloud.organizations.v1.SetTeamQuotaResponse
+	(*GetTeamUsageRequest)(nil),                 // 75: obiente.cloud.organizations.v1.GetTeamUsageRequest
+	(*GetTeamUsageResponse)(nil),                // 76: obiente.cloud.organizations.v1.GetTeamUsageResponse
+	(*MergeOrganizationsRequest)(nil),           // 77: obiente.cloud.organizations.v1.MergeOrganizationsRequest
+	(*MergeOrganizationsResponse)(nil),          // 78: obiente.cloud.organizations.v1.MergeOrganizationsResponse
+	(*CreateManagedOrganizationRequest)(nil),    // 79: obiente.cloud.organizations.v1.CreateManagedOrganizationRequest
+	(*CreateManagedOrganizationResponse)(nil),   // 80: obiente.cloud.organizations.v1.CreateManagedOrganizationResponse
+	(*ListManagedOrganizationsRequest)(nil),     // 81: obiente.cloud.organizations.v1.ListManagedOrganizationsRequest
+	(*ListManagedOrganizationsResponse)(nil),    // 82: obiente.cloud.organizations.v1.ListManagedOrganizationsResponse
+	(*RoleInheritanceEdge)(nil),                 // 83: obiente.cloud.organizations.v1.RoleInheritanceEdge
+	(*AddRoleInheritanceRequest)(nil),           // 84: obiente.cloud.organizations.v1.AddRoleInheritanceRequest
+	(*AddRoleInheritanceResponse)(nil),          // 85: obiente.cloud.organizations.v1.AddRoleInheritanceResponse
+	(*RemoveRoleInheritanceRequest)(nil),        // 86: obiente.cloud.organizations.v1.RemoveRoleInheritanceRequest
+	(*RemoveRoleInheritanceResponse)(nil),       // 87: obiente.cloud.organizations.v1.RemoveRoleInheritanceResponse
+	(*GetEffectivePermissionsRequest)(nil),      // 88: obiente.cloud.organizations.v1.GetEffectivePermissionsRequest
+	(*GetEffectivePermissionsResponse)(nil),     // 89: obiente.cloud.organizations.v1.GetEffectivePermissionsResponse
+	(*RolesGraphRequest)(nil),                   // 90: obiente.cloud.organizations.v1.RolesGraphRequest
+	(*RoleGraphNode)(nil),                       // 91: obiente.cloud.organizations.v1.RoleGraphNode
+	(*RolesGraphResponse)(nil),                  // 92: obiente.cloud.organizations.v1.RolesGraphResponse
+	nil,                                         // 93: obiente.cloud.organizations.v1.ActivityEvent.MetadataEntry
+	nil,                                         // 94: obiente.cloud.organizations.v1.SAMLConfig.AttributeMappingEntry
+	nil,                                         // 95: obiente.cloud.organizations.v1.ConfigureSAMLRequest.AttributeMappingEntry
+	nil,                                         // 96: obiente.cloud.organizations.v1.TaggedResource.TagsEntry
+	nil,                                         // 97: obiente.cloud.organizations.v1.ListResourcesByTagRequest.TagsEntry
+	(*v1.Pagination)(nil),                       // 98: obiente.cloud.common.v1.Pagination
+	(*timestamppb.Timestamp)(nil),               // 99: google.protobuf.Timestamp
+	(*v11.User)(nil),                            // 100: obiente.cloud.auth.v1.User
 }
 var file_obiente_cloud_organizations_v1_organization_service_proto_depIdxs = []int32{
-	2,  // 0: obiente.cloud.organizations.v1.GetUsageResponse.current:type_name -> obiente.cloud.organizations.v1.UsageMetrics
-	2,  // 1: obiente.cloud.organizations.v1.GetUsageResponse.estimated_monthly:type_name -> obiente.cloud.organizations.v1.UsageMetrics
-	3,  // 2: obiente.cloud.organizations.v1.GetUsageResponse.quota:type_name -> obiente.cloud.organizations.v1.UsageQuota
-	31, // 3: obiente.cloud.organizations.v1.ListOrganizationsResponse.organizations:type_name -> obiente.cloud.organizations.v1.Organization
-	47, // 4: obiente.cloud.organizations.v1.ListOrganizationsResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
-	31, // 5: obiente.cloud.organizations.v1.CreateOrganizationResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
-	31, // 6: obiente.cloud.organizations.v1.GetOrganizationResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
-	31, // 7: obiente.cloud.organizations.v1.UpdateOrganizationResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
-	33, // 8: obiente.cloud.organizations.v1.ListMembersResponse.members:type_name -> obiente.cloud.organizations.v1.OrganizationMember
-	47, // 9: obiente.cloud.organizations.v1.ListMembersResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
-	33, // 10: obiente.cloud.organizations.v1.InviteMemberResponse.member:type_name -> obiente.cloud.organizations.v1.OrganizationMember
-	20, // 11: obiente.cloud.organizations.v1.ListMyInvitesResponse.invites:type_name -> obiente.cloud.organizations.v1.PendingInvite
-	47, // 12: obiente.cloud.organizations.v1.ListMyInvitesResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
-	48, // 13: obiente.cloud.organizations.v1.PendingInvite.invited_at:type_name -> google.protobuf.Timestamp
-	33, // 14: obiente.cloud.organizations.v1.AcceptInviteResponse.member:type_name -> obiente.cloud.organizations.v1.OrganizationMember
-	31, // 15: obiente.cloud.organizations.v1.AcceptInviteResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
-	33, // 16: obiente.cloud.organizations.v1.UpdateMemberResponse.member:type_name -> obiente.cloud.organizations.v1.OrganizationMember
-	48, // 17: obiente.cloud.organizations.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
-	32, // 18: obiente.cloud.organizations.v1.Organization.plan_info:type_name -> obiente.cloud.organizations.v1.PlanInfo
-	49, // 19: obiente.cloud.organizations.v1.OrganizationMember.user:type_name -> obiente.cloud.auth.v1.User
-	48, // 20: obiente.cloud.organizations.v1.OrganizationMember.joined_at:type_name -> google.protobuf.Timestamp
-	31, // 21: obiente.cloud.organizations.v1.AddCreditsResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
-	31, // 22: obiente.cloud.organizations.v1.AdminAddCreditsResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
-	31, // 23: obiente.cloud.organizations.v1.AdminRemoveCreditsResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
-	42, // 24: obiente.cloud.organizations.v1.GetCreditLogResponse.transactions:type_name -> obiente.cloud.organizations.v1.CreditTransaction
-	47, // 25: obiente.cloud.organizations.v1.GetCreditLogResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
-	48, // 26: obiente.cloud.organizations.v1.CreditTransaction.created_at:type_name -> google.protobuf.Timestamp
-	31, // 27: obiente.cloud.organizations.v1.AdminSetPlanResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
-	45, // 28: obiente.cloud.organizations.v1.OrganizationService.AdminSetPlan:input_type -> obiente.cloud.organizations.v1.AdminSetPlanRequest
-	4,  // 29: obiente.cloud.organizations.v1.OrganizationService.ListOrganizations:input_type -> obiente.cloud.organizations.v1.ListOrganizationsRequest
-	6,  // 30: obiente.cloud.organizations.v1.OrganizationService.CreateOrganization:input_type -> obiente.cloud.organizations.v1.CreateOrganizationRequest
-	8,  // 31: obiente.cloud.organizations.v1.OrganizationService.GetOrganization:input_type -> obiente.cloud.organizations.v1.GetOrganizationRequest
-	10, // 32: obiente.cloud.organizations.v1.OrganizationService.UpdateOrganization:input_type -> obiente.cloud.organizations.v1.UpdateOrganizationRequest
-	12, // 33: obiente.cloud.organizations.v1.OrganizationService.ListMembers:input_type -> obiente.cloud.organizations.v1.ListMembersRequest
-	14, // 34: obiente.cloud.organizations.v1.OrganizationService.InviteMember:input_type -> obiente.cloud.organizations.v1.InviteMemberRequest
-	16, // 35: obiente.cloud.organizations.v1.OrganizationService.ResendInvite:input_type -> obiente.cloud.organizations.v1.ResendInviteRequest
-	18, // 36: obiente.cloud.organizations.v1.OrganizationService.ListMyInvites:input_type -> obiente.cloud.organizations.v1.ListMyInvitesRequest
-	21, // 37: obiente.cloud.organizations.v1.OrganizationService.AcceptInvite:input_type -> obiente.cloud.organizations.v1.AcceptInviteRequest
-	23, // 38: obiente.cloud.organizations.v1.OrganizationService.DeclineInvite:input_type -> obiente.cloud.organizations.v1.DeclineInviteRequest
-	25, // 39: obiente.cloud.organizations.v1.OrganizationService.UpdateMember:input_type -> obiente.cloud.organizations.v1.UpdateMemberRequest
-	27, // 40: obiente.cloud.organizations.v1.OrganizationService.RemoveMember:input_type -> obiente.cloud.organizations.v1.RemoveMemberRequest
-	29, // 41: obiente.cloud.organizations.v1.OrganizationService.TransferOwnership:input_type -> obiente.cloud.organizations.v1.TransferOwnershipRequest
-	0,  // 42: obiente.cloud.organizations.v1.OrganizationService.GetUsage:input_type -> obiente.cloud.organizations.v1.GetUsageRequest
-	34, // 43: obiente.cloud.organizations.v1.OrganizationService.AddCredits:input_type -> obiente.cloud.organizations.v1.AddCreditsRequest
-	36, // 44: obiente.cloud.organizations.v1.OrganizationService.AdminAddCredits:input_type -> obiente.cloud.organizations.v1.AdminAddCreditsRequest
-	38, // 45: obiente.cloud.organizations.v1.OrganizationService.AdminRemoveCredits:input_type -> obiente.cloud.organizations.v1.AdminRemoveCreditsRequest
-	40, // 46: obiente.cloud.organizations.v1.OrganizationService.GetCreditLog:input_type -> obiente.cloud.organizations.v1.GetCreditLogRequest
-	43, // 47: obiente.cloud.organizations.v1.OrganizationService.GetMyPermissions:input_type -> obiente.cloud.organizations.v1.GetMyPermissionsRequest
-	46, // 48: obiente.cloud.organizations.v1.OrganizationService.AdminSetPlan:output_type -> obiente.cloud.organizations.v1.AdminSetPlanResponse
-	5,  // 49: obiente.cloud.organizations.v1.OrganizationService.ListOrganizations:output_type -> obiente.cloud.organizations.v1.ListOrganizationsResponse
-	7,  // 50: obiente.cloud.organizations.v1.OrganizationService.CreateOrganization:output_type -> obiente.cloud.organizations.v1.CreateOrganizationResponse
-	9,  // 51: obiente.cloud.organizations.v1.OrganizationService.GetOrganization:output_type -> obiente.cloud.organizations.v1.GetOrganizationResponse
-	11, // 52: obiente.cloud.organizations.v1.OrganizationService.UpdateOrganization:output_type -> obiente.cloud.organizations.v1.UpdateOrganizationResponse
-	13, // 53: obiente.cloud.organizations.v1.OrganizationService.ListMembers:output_type -> obiente.cloud.organizations.v1.ListMembersResponse
-	15, // 54: obiente.cloud.organizations.v1.OrganizationService.InviteMember:output_type -> obiente.cloud.organizations.v1.InviteMemberResponse
-	17, // 55: obiente.cloud.organizations.v1.OrganizationService.ResendInvite:output_type -> obiente.cloud.organizations.v1.ResendInviteResponse
-	19, // 56: obiente.cloud.organizations.v1.OrganizationService.ListMyInvites:output_type -> obiente.cloud.organizations.v1.ListMyInvitesResponse
-	22, // 57: obiente.cloud.organizations.v1.OrganizationService.AcceptInvite:output_type -> obiente.cloud.organizations.v1.AcceptInviteResponse
-	24, // 58: obiente.cloud.organizations.v1.OrganizationService.DeclineInvite:output_type -> obiente.cloud.organizations.v1.DeclineInviteResponse
-	26, // 59: obiente.cloud.organizations.v1.OrganizationService.UpdateMember:output_type -> obiente.cloud.organizations.v1.UpdateMemberResponse
-	28, // 60: obiente.cloud.organizations.v1.OrganizationService.RemoveMember:output_type -> obiente.cloud.organizations.v1.RemoveMemberResponse
-	30, // 61: obiente.cloud.organizations.v1.OrganizationService.TransferOwnership:output_type -> obiente.cloud.organizations.v1.TransferOwnershipResponse
-	1,  // 62: obiente.cloud.organizations.v1.OrganizationService.GetUsage:output_type -> obiente.cloud.organizations.v1.GetUsageResponse
-	35, // 63: obiente.cloud.organizations.v1.OrganizationService.AddCredits:output_type -> obiente.cloud.organizations.v1.AddCreditsResponse
-	37, // 64: obiente.cloud.organizations.v1.OrganizationService.AdminAddCredits:output_type -> obiente.cloud.organizations.v1.AdminAddCreditsResponse
-	39, // 65: obiente.cloud.organizations.v1.OrganizationService.AdminRemoveCredits:output_type -> obiente.cloud.organizations.v1.AdminRemoveCreditsResponse
-	41, // 66: obiente.cloud.organizations.v1.OrganizationService.GetCreditLog:output_type -> obiente.cloud.organizations.v1.GetCreditLogResponse
-	44, // 67: obiente.cloud.organizations.v1.OrganizationService.GetMyPermissions:output_type -> obiente.cloud.organizations.v1.GetMyPermissionsResponse
-	48, // [48:68] is the sub-list for method output_type
-	28, // [28:48] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	2,   // 0: obiente.cloud.organizations.v1.GetUsageResponse.current:type_name -> obiente.cloud.organizations.v1.UsageMetrics
+	2,   // 1: obiente.cloud.organizations.v1.GetUsageResponse.estimated_monthly:type_name -> obiente.cloud.organizations.v1.UsageMetrics
+	3,   // 2: obiente.cloud.organizations.v1.GetUsageResponse.quota:type_name -> obiente.cloud.organizations.v1.UsageQuota
+	31,  // 3: obiente.cloud.organizations.v1.ListOrganizationsResponse.organizations:type_name -> obiente.cloud.organizations.v1.Organization
+	98,  // 4: obiente.cloud.organizations.v1.ListOrganizationsResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	31,  // 5: obiente.cloud.organizations.v1.CreateOrganizationResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
+	31,  // 6: obiente.cloud.organizations.v1.GetOrganizationResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
+	31,  // 7: obiente.cloud.organizations.v1.UpdateOrganizationResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
+	33,  // 8: obiente.cloud.organizations.v1.ListMembersResponse.members:type_name -> obiente.cloud.organizations.v1.OrganizationMember
+	98,  // 9: obiente.cloud.organizations.v1.ListMembersResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	33,  // 10: obiente.cloud.organizations.v1.InviteMemberResponse.member:type_name -> obiente.cloud.organizations.v1.OrganizationMember
+	20,  // 11: obiente.cloud.organizations.v1.ListMyInvitesResponse.invites:type_name -> obiente.cloud.organizations.v1.PendingInvite
+	98,  // 12: obiente.cloud.organizations.v1.ListMyInvitesResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	99,  // 13: obiente.cloud.organizations.v1.PendingInvite.invited_at:type_name -> google.protobuf.Timestamp
+	99,  // 14: obiente.cloud.organizations.v1.PendingInvite.expires_at:type_name -> google.protobuf.Timestamp
+	33,  // 15: obiente.cloud.organizations.v1.AcceptInviteResponse.member:type_name -> obiente.cloud.organizations.v1.OrganizationMember
+	31,  // 16: obiente.cloud.organizations.v1.AcceptInviteResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
+	33,  // 17: obiente.cloud.organizations.v1.UpdateMemberResponse.member:type_name -> obiente.cloud.organizations.v1.OrganizationMember
+	99,  // 18: obiente.cloud.organizations.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	32,  // 19: obiente.cloud.organizations.v1.Organization.plan_info:type_name -> obiente.cloud.organizations.v1.PlanInfo
+	100, // 20: obiente.cloud.organizations.v1.OrganizationMember.user:type_name -> obiente.cloud.auth.v1.User
+	99,  // 21: obiente.cloud.organizations.v1.OrganizationMember.joined_at:type_name -> google.protobuf.Timestamp
+	31,  // 22: obiente.cloud.organizations.v1.AddCreditsResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
+	31,  // 23: obiente.cloud.organizations.v1.AdminAddCreditsResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
+	31,  // 24: obiente.cloud.organizations.v1.AdminRemoveCreditsResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
+	42,  // 25: obiente.cloud.organizations.v1.GetCreditLogResponse.transactions:type_name -> obiente.cloud.organizations.v1.CreditTransaction
+	98,  // 26: obiente.cloud.organizations.v1.GetCreditLogResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	99,  // 27: obiente.cloud.organizations.v1.CreditTransaction.created_at:type_name -> google.protobuf.Timestamp
+	45,  // 28: obiente.cloud.organizations.v1.GetOrganizationAuditLogResponse.events:type_name -> obiente.cloud.organizations.v1.AuditEvent
+	98,  // 29: obiente.cloud.organizations.v1.GetOrganizationAuditLogResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	46,  // 30: obiente.cloud.organizations.v1.AuditEvent.diff:type_name -> obiente.cloud.organizations.v1.AuditFieldChange
+	99,  // 31: obiente.cloud.organizations.v1.AuditEvent.timestamp:type_name -> google.protobuf.Timestamp
+	49,  // 32: obiente.cloud.organizations.v1.GetOrganizationActivityFeedResponse.events:type_name -> obiente.cloud.organizations.v1.ActivityEvent
+	93,  // 33: obiente.cloud.organizations.v1.ActivityEvent.metadata:type_name -> obiente.cloud.organizations.v1.ActivityEvent.MetadataEntry
+	99,  // 34: obiente.cloud.organizations.v1.ActivityEvent.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 35: obiente.cloud.organizations.v1.SAMLConfig.attribute_mapping:type_name -> obiente.cloud.organizations.v1.SAMLConfig.AttributeMappingEntry
+	99,  // 36: obiente.cloud.organizations.v1.SAMLConfig.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 37: obiente.cloud.organizations.v1.ConfigureSAMLRequest.attribute_mapping:type_name -> obiente.cloud.organizations.v1.ConfigureSAMLRequest.AttributeMappingEntry
+	50,  // 38: obiente.cloud.organizations.v1.ConfigureSAMLResponse.config:type_name -> obiente.cloud.organizations.v1.SAMLConfig
+	50,  // 39: obiente.cloud.organizations.v1.GetSAMLConfigResponse.config:type_name -> obiente.cloud.organizations.v1.SAMLConfig
+	31,  // 40: obiente.cloud.organizations.v1.AdminSetPlanResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
+	96,  // 41: obiente.cloud.organizations.v1.TaggedResource.tags:type_name -> obiente.cloud.organizations.v1.TaggedResource.TagsEntry
+	59,  // 42: obiente.cloud.organizations.v1.AddResourceTagResponse.resource:type_name -> obiente.cloud.organizations.v1.TaggedResource
+	59,  // 43: obiente.cloud.organizations.v1.RemoveResourceTagResponse.resource:type_name -> obiente.cloud.organizations.v1.TaggedResource
+	97,  // 44: obiente.cloud.organizations.v1.ListResourcesByTagRequest.tags:type_name -> obiente.cloud.organizations.v1.ListResourcesByTagRequest.TagsEntry
+	59,  // 45: obiente.cloud.organizations.v1.ListResourcesByTagResponse.resources:type_name -> obiente.cloud.organizations.v1.TaggedResource
+	99,  // 46: obiente.cloud.organizations.v1.Team.created_at:type_name -> google.protobuf.Timestamp
+	66,  // 47: obiente.cloud.organizations.v1.CreateTeamResponse.team:type_name -> obiente.cloud.organizations.v1.Team
+	67,  // 48: obiente.cloud.organizations.v1.SetTeamQuotaRequest.quota:type_name -> obiente.cloud.organizations.v1.TeamQuota
+	67,  // 49: obiente.cloud.organizations.v1.SetTeamQuotaResponse.quota:type_name -> obiente.cloud.organizations.v1.TeamQuota
+	66,  // 50: obiente.cloud.organizations.v1.GetTeamUsageResponse.team:type_name -> obiente.cloud.organizations.v1.Team
+	68,  // 51: obiente.cloud.organizations.v1.GetTeamUsageResponse.usage:type_name -> obiente.cloud.organizations.v1.TeamUsage
+	67,  // 52: obiente.cloud.organizations.v1.GetTeamUsageResponse.quota:type_name -> obiente.cloud.organizations.v1.TeamQuota
+	31,  // 53: obiente.cloud.organizations.v1.MergeOrganizationsResponse.target_organization:type_name -> obiente.cloud.organizations.v1.Organization
+	31,  // 54: obiente.cloud.organizations.v1.CreateManagedOrganizationResponse.organization:type_name -> obiente.cloud.organizations.v1.Organization
+	31,  // 55: obiente.cloud.organizations.v1.ListManagedOrganizationsResponse.organizations:type_name -> obiente.cloud.organizations.v1.Organization
+	83,  // 56: obiente.cloud.organizations.v1.AddRoleInheritanceResponse.edge:type_name -> obiente.cloud.organizations.v1.RoleInheritanceEdge
+	91,  // 57: obiente.cloud.organizations.v1.RolesGraphResponse.roles:type_name -> obiente.cloud.organizations.v1.RoleGraphNode
+	83,  // 58: obiente.cloud.organizations.v1.RolesGraphResponse.edges:type_name -> obiente.cloud.organizations.v1.RoleInheritanceEdge
+	57,  // 59: obiente.cloud.organizations.v1.OrganizationService.AdminSetPlan:input_type -> obiente.cloud.organizations.v1.AdminSetPlanRequest
+	4,   // 60: obiente.cloud.organizations.v1.OrganizationService.ListOrganizations:input_type -> obiente.cloud.organizations.v1.ListOrganizationsRequest
+	6,   // 61: obiente.cloud.organizations.v1.OrganizationService.CreateOrganization:input_type -> obiente.cloud.organizations.v1.CreateOrganizationRequest
+	8,   // 62: obiente.cloud.organizations.v1.OrganizationService.GetOrganization:input_type -> obiente.cloud.organizations.v1.GetOrganizationRequest
+	10,  // 63: obiente.cloud.organizations.v1.OrganizationService.UpdateOrganization:input_type -> obiente.cloud.organizations.v1.UpdateOrganizationRequest
+	12,  // 64: obiente.cloud.organizations.v1.OrganizationService.ListMembers:input_type -> obiente.cloud.organizations.v1.ListMembersRequest
+	14,  // 65: obiente.cloud.organizations.v1.OrganizationService.InviteMember:input_type -> obiente.cloud.organizations.v1.InviteMemberRequest
+	16,  // 66: obiente.cloud.organizations.v1.OrganizationService.ResendInvite:input_type -> obiente.cloud.organizations.v1.ResendInviteRequest
+	18,  // 67: obiente.cloud.organizations.v1.OrganizationService.ListMyInvites:input_type -> obiente.cloud.organizations.v1.ListMyInvitesRequest
+	21,  // 68: obiente.cloud.organizations.v1.OrganizationService.AcceptInvite:input_type -> obiente.cloud.organizations.v1.AcceptInviteRequest
+	23,  // 69: obiente.cloud.organizations.v1.OrganizationService.DeclineInvite:input_type -> obiente.cloud.organizations.v1.DeclineInviteRequest
+	25,  // 70: obiente.cloud.organizations.v1.OrganizationService.UpdateMember:input_type -> obiente.cloud.organizations.v1.UpdateMemberRequest
+	27,  // 71: obiente.cloud.organizations.v1.OrganizationService.RemoveMember:input_type -> obiente.cloud.organizations.v1.RemoveMemberRequest
+	29,  // 72: obiente.cloud.organizations.v1.OrganizationService.TransferOwnership:input_type -> obiente.cloud.organizations.v1.TransferOwnershipRequest
+	0,   // 73: obiente.cloud.organizations.v1.OrganizationService.GetUsage:input_type -> obiente.cloud.organizations.v1.GetUsageRequest
+	34,  // 74: obiente.cloud.organizations.v1.OrganizationService.AddCredits:input_type -> obiente.cloud.organizations.v1.AddCreditsRequest
+	36,  // 75: obiente.cloud.organizations.v1.OrganizationService.AdminAddCredits:input_type -> obiente.cloud.organizations.v1.AdminAddCreditsRequest
+	38,  // 76: obiente.cloud.organizations.v1.OrganizationService.AdminRemoveCredits:input_type -> obiente.cloud.organizations.v1.AdminRemoveCreditsRequest
+	40,  // 77: obiente.cloud.organizations.v1.OrganizationService.GetCreditLog:input_type -> obiente.cloud.organizations.v1.GetCreditLogRequest
+	55,  // 78: obiente.cloud.organizations.v1.OrganizationService.GetMyPermissions:input_type -> obiente.cloud.organizations.v1.GetMyPermissionsRequest
+	43,  // 79: obiente.cloud.organizations.v1.OrganizationService.GetOrganizationAuditLog:input_type -> obiente.cloud.organizations.v1.GetOrganizationAuditLogRequest
+	47,  // 80: obiente.cloud.organizations.v1.OrganizationService.GetOrganizationActivityFeed:input_type -> obiente.cloud.organizations.v1.GetOrganizationActivityFeedRequest
+	51,  // 81: obiente.cloud.organizations.v1.OrganizationService.ConfigureSAML:input_type -> obiente.cloud.organizations.v1.ConfigureSAMLRequest
+	53,  // 82: obiente.cloud.organizations.v1.OrganizationService.GetSAMLConfig:input_type -> obiente.cloud.organizations.v1.GetSAMLConfigRequest
+	60,  // 83: obiente.cloud.organizations.v1.OrganizationService.AddResourceTag:input_type -> obiente.cloud.organizations.v1.AddResourceTagRequest
+	62,  // 84: obiente.cloud.organizations.v1.OrganizationService.RemoveResourceTag:input_type -> obiente.cloud.organizations.v1.RemoveResourceTagRequest
+	64,  // 85: obiente.cloud.organizations.v1.OrganizationService.ListResourcesByTag:input_type -> obiente.cloud.organizations.v1.ListResourcesByTagRequest
+	69,  // 86: obiente.cloud.organizations.v1.OrganizationService.CreateTeam:input_type -> obiente.cloud.organizations.v1.CreateTeamRequest
+	71,  // 87: obiente.cloud.organizations.v1.OrganizationService.DeleteTeam:input_type -> obiente.cloud.organizations.v1.DeleteTeamRequest
+	73,  // 88: obiente.cloud.organizations.v1.OrganizationService.SetTeamQuota:input_type -> obiente.cloud.organizations.v1.SetTeamQuotaRequest
+	75,  // 89: obiente.cloud.organizations.v1.OrganizationService.GetTeamUsage:input_type -> obiente.cloud.organizations.v1.GetTeamUsageRequest
+	77,  // 90: obiente.cloud.organizations.v1.OrganizationService.MergeOrganizations:input_type -> obiente.cloud.organizations.v1.MergeOrganizationsRequest
+	79,  // 91: obiente.cloud.organizations.v1.OrganizationService.CreateManagedOrganization:input_type -> obiente.cloud.organizations.v1.CreateManagedOrganizationRequest
+	81,  // 92: obiente.cloud.organizations.v1.OrganizationService.ListManagedOrganizations:input_type -> obiente.cloud.organizations.v1.ListManagedOrganizationsRequest
+	84,  // 93: obiente.cloud.organizations.v1.OrganizationService.AddRoleInheritance:input_type -> obiente.cloud.organizations.v1.AddRoleInheritanceRequest
+	86,  // 94: obiente.cloud.organizations.v1.OrganizationService.RemoveRoleInheritance:input_type -> obiente.cloud.organizations.v1.RemoveRoleInheritanceRequest
+	88,  // 95: obiente.cloud.organizations.v1.OrganizationService.GetEffectivePermissions:input_type -> obiente.cloud.organizations.v1.GetEffectivePermissionsRequest
+	90,  // 96: obiente.cloud.organizations.v1.OrganizationService.RolesGraph:input_type -> obiente.cloud.organizations.v1.RolesGraphRequest
+	58,  // 97: obiente.cloud.organizations.v1.OrganizationService.AdminSetPlan:output_type -> obiente.cloud.organizations.v1.AdminSetPlanResponse
+	5,   // 98: obiente.cloud.organizations.v1.OrganizationService.ListOrganizations:output_type -> obiente.cloud.organizations.v1.ListOrganizationsResponse
+	7,   // 99: obiente.cloud.organizations.v1.OrganizationService.CreateOrganization:output_type -> obiente.cloud.organizations.v1.CreateOrganizationResponse
+	9,   // 100: obiente.cloud.organizations.v1.OrganizationService.GetOrganization:output_type -> obiente.cloud.organizations.v1.GetOrganizationResponse
+	11,  // 101: obiente.cloud.organizations.v1.OrganizationService.UpdateOrganization:output_type -> obiente.cloud.organizations.v1.UpdateOrganizationResponse
+	13,  // 102: obiente.cloud.organizations.v1.OrganizationService.ListMembers:output_type -> obiente.cloud.organizations.v1.ListMembersResponse
+	15,  // 103: obiente.cloud.organizations.v1.OrganizationService.InviteMember:output_type -> obiente.cloud.organizations.v1.InviteMemberResponse
+	17,  // 104: obiente.cloud.organizations.v1.OrganizationService.ResendInvite:output_type -> obiente.cloud.organizations.v1.ResendInviteResponse
+	19,  // 105: obiente.cloud.organizations.v1.OrganizationService.ListMyInvites:output_type -> obiente.cloud.organizations.v1.ListMyInvitesResponse
+	22,  // 106: obiente.cloud.organizations.v1.OrganizationService.AcceptInvite:output_type -> obiente.cloud.organizations.v1.AcceptInviteResponse
+	24,  // 107: obiente.cloud.organizations.v1.OrganizationService.DeclineInvite:output_type -> obiente.cloud.organizations.v1.DeclineInviteResponse
+	26,  // 108: obiente.cloud.organizations.v1.OrganizationService.UpdateMember:output_type -> obiente.cloud.organizations.v1.UpdateMemberResponse
+	28,  // 109: obiente.cloud.organizations.v1.OrganizationService.RemoveMember:output_type -> obiente.cloud.organizations.v1.RemoveMemberResponse
+	30,  // 110: obiente.cloud.organizations.v1.OrganizationService.TransferOwnership:output_type -> obiente.cloud.organizations.v1.TransferOwnershipResponse
+	1,   // 111: obiente.cloud.organizations.v1.OrganizationService.GetUsage:output_type -> obiente.cloud.organizations.v1.GetUsageResponse
+	35,  // 112: obiente.cloud.organizations.v1.OrganizationService.AddCredits:output_type -> obiente.cloud.organizations.v1.AddCreditsResponse
+	37,  // 113: obiente.cloud.organizations.v1.OrganizationService.AdminAddCredits:output_type -> obiente.cloud.organizations.v1.AdminAddCreditsResponse
+	39,  // 114: obiente.cloud.organizations.v1.OrganizationService.AdminRemoveCredits:output_type -> obiente.cloud.organizations.v1.AdminRemoveCreditsResponse
+	41,  // 115: obiente.cloud.organizations.v1.OrganizationService.GetCreditLog:output_type -> obiente.cloud.organizations.v1.GetCreditLogResponse
+	56,  // 116: obiente.cloud.organizations.v1.OrganizationService.GetMyPermissions:output_type -> obiente.cloud.organizations.v1.GetMyPermissionsResponse
+	44,  // 117: obiente.cloud.organizations.v1.OrganizationService.GetOrganizationAuditLog:output_type -> obiente.cloud.organizations.v1.GetOrganizationAuditLogResponse
+	48,  // 118: obiente.cloud.organizations.v1.OrganizationService.GetOrganizationActivityFeed:output_type -> obiente.cloud.organizations.v1.GetOrganizationActivityFeedResponse
+	52,  // 119: obiente.cloud.organizations.v1.OrganizationService.ConfigureSAML:output_type -> obiente.cloud.organizations.v1.ConfigureSAMLResponse
+	54,  // 120: obiente.cloud.organizations.v1.OrganizationService.GetSAMLConfig:output_type -> obiente.cloud.organizations.v1.GetSAMLConfigResponse
+	61,  // 121: obiente.cloud.organizations.v1.OrganizationService.AddResourceTag:output_type -> obiente.cloud.organizations.v1.AddResourceTagResponse
+	63,  // 122: obiente.cloud.organizations.v1.OrganizationService.RemoveResourceTag:output_type -> obiente.cloud.organizations.v1.RemoveResourceTagResponse
+	65,  // 123: obiente.cloud.organizations.v1.OrganizationService.ListResourcesByTag:output_type -> obiente.cloud.organizations.v1.ListResourcesByTagResponse
+	70,  // 124: obiente.cloud.organizations.v1.OrganizationService.CreateTeam:output_type -> obiente.cloud.organizations.v1.CreateTeamResponse
+	72,  // 125: obiente.cloud.organizations.v1.OrganizationService.DeleteTeam:output_type -> obiente.cloud.organizations.v1.DeleteTeamResponse
+	74,  // 126: obiente.cloud.organizations.v1.OrganizationService.SetTeamQuota:output_type -> obiente.cloud.organizations.v1.SetTeamQuotaResponse
+	76,  // 127: obiente.cloud.organizations.v1.OrganizationService.GetTeamUsage:output_type -> obiente.cloud.organizations.v1.GetTeamUsageResponse
+	78,  // 128: obiente.cloud.organizations.v1.OrganizationService.MergeOrganizations:output_type -> obiente.cloud.organizations.v1.MergeOrganizationsResponse
+	80,  // 129: obiente.cloud.organizations.v1.OrganizationService.CreateManagedOrganization:output_type -> obiente.cloud.organizations.v1.CreateManagedOrganizationResponse
+	82,  // 130: obiente.cloud.organizations.v1.OrganizationService.ListManagedOrganizations:output_type -> obiente.cloud.organizations.v1.ListManagedOrganizationsResponse
+	85,  // 131: obiente.cloud.organizations.v1.OrganizationService.AddRoleInheritance:output_type -> obiente.cloud.organizations.v1.AddRoleInheritanceResponse
+	87,  // 132: obiente.cloud.organizations.v1.OrganizationService.RemoveRoleInheritance:output_type -> obiente.cloud.organizations.v1.RemoveRoleInheritanceResponse
+	89,  // 133: obiente.cloud.organizations.v1.OrganizationService.GetEffectivePermissions:output_type -> obiente.cloud.organizations.v1.GetEffectivePermissionsResponse
+	92,  // 134: obiente.cloud.organizations.v1.OrganizationService.RolesGraph:output_type -> obiente.cloud.organizations.v1.RolesGraphResponse
+	97,  // [97:135] is the sub-list for method output_type
+	59,  // [59:97] is the sub-list for method input_type
+	59,  // [59:59] is the sub-list for extension type_name
+	59,  // [59:59] is the sub-list for extension extendee
+	0,   // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_obiente_cloud_organizations_v1_organization_service_proto_init() }
@@ -3370,13 +6547,20 @@ func file_obiente_cloud_organizations_v1_organization_service_proto_init() {
 	file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[36].OneofWrappers = []any{}
 	file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[38].OneofWrappers = []any{}
 	file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[42].OneofWrappers = []any{}
+	file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[45].OneofWrappers = []any{}
+	file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[46].OneofWrappers = []any{}
+	file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[47].OneofWrappers = []any{}
+	file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[64].OneofWrappers = []any{}
+	file_obiente_cloud_organizations_v1_organization_service_proto_msgTypes[67].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_obiente_cloud_organizations_v1_organization_service_proto_rawDesc), len(file_obiente_cloud_organizations_v1_organization_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   47,
+			NumMessages:   98,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -93,6 +93,60 @@ const (
 	// OrganizationServiceGetMyPermissionsProcedure is the fully-qualified name of the
 	// OrganizationService's GetMyPermissions RPC.
 	OrganizationServiceGetMyPermissionsProcedure = "/obiente.cloud.organizations.v1.OrganizationService/GetMyPermissions"
+	// OrganizationServiceGetOrganizationAuditLogProcedure is the fully-qualified name of the
+	// OrganizationService's GetOrganizationAuditLog RPC.
+	OrganizationServiceGetOrganizationAuditLogProcedure = "/obiente.cloud.organizations.v1.OrganizationService/GetOrganizationAuditLog"
+	// OrganizationServiceGetOrganizationActivityFeedProcedure is the fully-qualified name of the
+	// OrganizationService's GetOrganizationActivityFeed RPC.
+	OrganizationServiceGetOrganizationActivityFeedProcedure = "/obiente.cloud.organizations.v1.OrganizationService/GetOrganizationActivityFeed"
+	// OrganizationServiceConfigureSAMLProcedure is the fully-qualified name of the
+	// OrganizationService's ConfigureSAML RPC.
+	OrganizationServiceConfigureSAMLProcedure = "/obiente.cloud.organizations.v1.OrganizationService/ConfigureSAML"
+	// OrganizationServiceGetSAMLConfigProcedure is the fully-qualified name of the
+	// OrganizationService's GetSAMLConfig RPC.
+	OrganizationServiceGetSAMLConfigProcedure = "/obiente.cloud.organizations.v1.OrganizationService/GetSAMLConfig"
+	// OrganizationServiceAddResourceTagProcedure is the fully-qualified name of the
+	// OrganizationService's AddResourceTag RPC.
+	OrganizationServiceAddResourceTagProcedure = "/obiente.cloud.organizations.v1.OrganizationService/AddResourceTag"
+	// OrganizationServiceRemoveResourceTagProcedure is the fully-qualified name of the
+	// OrganizationService's RemoveResourceTag RPC.
+	OrganizationServiceRemoveResourceTagProcedure = "/obiente.cloud.organizations.v1.OrganizationService/RemoveResourceTag"
+	// OrganizationServiceListResourcesByTagProcedure is the fully-qualified name of the
+	// OrganizationService's ListResourcesByTag RPC.
+	OrganizationServiceListResourcesByTagProcedure = "/obiente.cloud.organizations.v1.OrganizationService/ListResourcesByTag"
+	// OrganizationServiceCreateTeamProcedure is the fully-qualified name of the OrganizationService's
+	// CreateTeam RPC.
+	OrganizationServiceCreateTeamProcedure = "/obiente.cloud.organizations.v1.OrganizationService/CreateTeam"
+	// OrganizationServiceDeleteTeamProcedure is the fully-qualified name of the OrganizationService's
+	// DeleteTeam RPC.
+	OrganizationServiceDeleteTeamProcedure = "/obiente.cloud.organizations.v1.OrganizationService/DeleteTeam"
+	// OrganizationServiceSetTeamQuotaProcedure is the fully-qualified name of the OrganizationService's
+	// SetTeamQuota RPC.
+	OrganizationServiceSetTeamQuotaProcedure = "/obiente.cloud.organizations.v1.OrganizationService/SetTeamQuota"
+	// OrganizationServiceGetTeamUsageProcedure is the fully-qualified name of the OrganizationService's
+	// GetTeamUsage RPC.
+	OrganizationServiceGetTeamUsageProcedure = "/obiente.cloud.organizations.v1.OrganizationService/GetTeamUsage"
+	// OrganizationServiceMergeOrganizationsProcedure is the fully-qualified name of the
+	// OrganizationService's MergeOrganizations RPC.
+	OrganizationServiceMergeOrganizationsProcedure = "/obiente.cloud.organizations.v1.OrganizationService/MergeOrganizations"
+	// OrganizationServiceCreateManagedOrganizationProcedure is the fully-qualified name of the
+	// OrganizationService's CreateManagedOrganization RPC.
+	OrganizationServiceCreateManagedOrganizationProcedure = "/obiente.cloud.organizations.v1.OrganizationService/CreateManagedOrganization"
+	// OrganizationServiceListManagedOrganizationsProcedure is the fully-qualified name of the
+	// OrganizationService's ListManagedOrganizations RPC.
+	OrganizationServiceListManagedOrganizationsProcedure = "/obiente.cloud.organizations.v1.OrganizationService/ListManagedOrganizations"
+	// OrganizationServiceAddRoleInheritanceProcedure is the fully-qualified name of the
+	// OrganizationService's AddRoleInheritance RPC.
+	OrganizationServiceAddRoleInheritanceProcedure = "/obiente.cloud.organizations.v1.OrganizationService/AddRoleInheritance"
+	// OrganizationServiceRemoveRoleInheritanceProcedure is the fully-qualified name of the
+	// OrganizationService's RemoveRoleInheritance RPC.
+	OrganizationServiceRemoveRoleInheritanceProcedure = "/obiente.cloud.organizations.v1.OrganizationService/RemoveRoleInheritance"
+	// OrganizationServiceGetEffectivePermissionsProcedure is the fully-qualified name of the
+	// OrganizationService's GetEffectivePermissions RPC.
+	OrganizationServiceGetEffectivePermissionsProcedure = "/obiente.cloud.organizations.v1.OrganizationService/GetEffectivePermissions"
+	// OrganizationServiceRolesGraphProcedure is the fully-qualified name of the OrganizationService's
+	// RolesGraph RPC.
+	OrganizationServiceRolesGraphProcedure = "/obiente.cloud.organizations.v1.OrganizationService/RolesGraph"
 )
 
 // OrganizationServiceClient is a client for the obiente.cloud.organizations.v1.OrganizationService
@@ -138,6 +192,43 @@ type OrganizationServiceClient interface {
 	GetCreditLog(context.Context, *connect.Request[v1.GetCreditLogRequest]) (*connect.Response[v1.GetCreditLogResponse], error)
 	// Get current user's permissions for an organization
 	GetMyPermissions(context.Context, *connect.Request[v1.GetMyPermissionsRequest]) (*connect.Response[v1.GetMyPermissionsResponse], error)
+	// Get the organization's audit trail, including field-level changes for updates (owner/admin only)
+	GetOrganizationAuditLog(context.Context, *connect.Request[v1.GetOrganizationAuditLogRequest]) (*connect.Response[v1.GetOrganizationAuditLogResponse], error)
+	// Get the organization's activity feed of changes to its deployments, game servers, VPSes, members and billing, newest first (owner/admin only)
+	GetOrganizationActivityFeed(context.Context, *connect.Request[v1.GetOrganizationActivityFeedRequest]) (*connect.Response[v1.GetOrganizationActivityFeedResponse], error)
+	// Create or replace the organization's SAML 2.0 identity provider (owner/admin only)
+	ConfigureSAML(context.Context, *connect.Request[v1.ConfigureSAMLRequest]) (*connect.Response[v1.ConfigureSAMLResponse], error)
+	// Get the organization's SAML 2.0 identity provider and service provider URLs
+	GetSAMLConfig(context.Context, *connect.Request[v1.GetSAMLConfigRequest]) (*connect.Response[v1.GetSAMLConfigResponse], error)
+	// Set a key=value tag on a deployment, game server or VPS, replacing the key's current value
+	AddResourceTag(context.Context, *connect.Request[v1.AddResourceTagRequest]) (*connect.Response[v1.AddResourceTagResponse], error)
+	// Remove a tag from a deployment, game server or VPS
+	RemoveResourceTag(context.Context, *connect.Request[v1.RemoveResourceTagRequest]) (*connect.Response[v1.RemoveResourceTagResponse], error)
+	// List the organization's resources that carry every given tag
+	ListResourcesByTag(context.Context, *connect.Request[v1.ListResourcesByTagRequest]) (*connect.Response[v1.ListResourcesByTagResponse], error)
+	// Create a team of organization members (owner/admin only)
+	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
+	// Delete a team with its quota; its resources lose their team tag (owner/admin only)
+	DeleteTeam(context.Context, *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[v1.DeleteTeamResponse], error)
+	// Set the quota for the resources tagged with a team (owner/admin only)
+	SetTeamQuota(context.Context, *connect.Request[v1.SetTeamQuotaRequest]) (*connect.Response[v1.SetTeamQuotaResponse], error)
+	// Get a team's resource usage and quota
+	GetTeamUsage(context.Context, *connect.Request[v1.GetTeamUsageRequest]) (*connect.Response[v1.GetTeamUsageResponse], error)
+	// Admin: Move all resources, credits and members of one organization into another and mark it merged (superadmin only)
+	MergeOrganizations(context.Context, *connect.Request[v1.MergeOrganizationsRequest]) (*connect.Response[v1.MergeOrganizationsResponse], error)
+	// Create an organization managed by this one, e.g. for a reseller's customer (owner/admin only).
+	// Managed organizations use the parent's plan and quota, and their charges are billed to the parent.
+	CreateManagedOrganization(context.Context, *connect.Request[v1.CreateManagedOrganizationRequest]) (*connect.Response[v1.CreateManagedOrganizationResponse], error)
+	// List the organizations managed by this one
+	ListManagedOrganizations(context.Context, *connect.Request[v1.ListManagedOrganizationsRequest]) (*connect.Response[v1.ListManagedOrganizationsResponse], error)
+	// Make a custom role inherit every permission of a parent role; rejects edges that would create a cycle (owner/admin only)
+	AddRoleInheritance(context.Context, *connect.Request[v1.AddRoleInheritanceRequest]) (*connect.Response[v1.AddRoleInheritanceResponse], error)
+	// Stop a custom role inheriting from a parent role (owner/admin only)
+	RemoveRoleInheritance(context.Context, *connect.Request[v1.RemoveRoleInheritanceRequest]) (*connect.Response[v1.RemoveRoleInheritanceResponse], error)
+	// Get a member's flattened permissions, including those inherited from ancestor roles (owner/admin, or the member themselves)
+	GetEffectivePermissions(context.Context, *connect.Request[v1.GetEffectivePermissionsRequest]) (*connect.Response[v1.GetEffectivePermissionsResponse], error)
+	// Get the organization's custom roles and their inheritance edges as a DAG for visualization (owner/admin only)
+	RolesGraph(context.Context, *connect.Request[v1.RolesGraphRequest]) (*connect.Response[v1.RolesGraphResponse], error)
 }
 
 // NewOrganizationServiceClient constructs a client for the
@@ -272,31 +363,157 @@ func NewOrganizationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(organizationServiceMethods.ByName("GetMyPermissions")),
 			connect.WithClientOptions(opts...),
 		),
+		getOrganizationAuditLog: connect.NewClient[v1.GetOrganizationAuditLogRequest, v1.GetOrganizationAuditLogResponse](
+			httpClient,
+			baseURL+OrganizationServiceGetOrganizationAuditLogProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("GetOrganizationAuditLog")),
+			connect.WithClientOptions(opts...),
+		),
+		getOrganizationActivityFeed: connect.NewClient[v1.GetOrganizationActivityFeedRequest, v1.GetOrganizationActivityFeedResponse](
+			httpClient,
+			baseURL+OrganizationServiceGetOrganizationActivityFeedProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("GetOrganizationActivityFeed")),
+			connect.WithClientOptions(opts...),
+		),
+		configureSAML: connect.NewClient[v1.ConfigureSAMLRequest, v1.ConfigureSAMLResponse](
+			httpClient,
+			baseURL+OrganizationServiceConfigureSAMLProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("ConfigureSAML")),
+			connect.WithClientOptions(opts...),
+		),
+		getSAMLConfig: connect.NewClient[v1.GetSAMLConfigRequest, v1.GetSAMLConfigResponse](
+			httpClient,
+			baseURL+OrganizationServiceGetSAMLConfigProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("GetSAMLConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		addResourceTag: connect.NewClient[v1.AddResourceTagRequest, v1.AddResourceTagResponse](
+			httpClient,
+			baseURL+OrganizationServiceAddResourceTagProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("AddResourceTag")),
+			connect.WithClientOptions(opts...),
+		),
+		removeResourceTag: connect.NewClient[v1.RemoveResourceTagRequest, v1.RemoveResourceTagResponse](
+			httpClient,
+			baseURL+OrganizationServiceRemoveResourceTagProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("RemoveResourceTag")),
+			connect.WithClientOptions(opts...),
+		),
+		listResourcesByTag: connect.NewClient[v1.ListResourcesByTagRequest, v1.ListResourcesByTagResponse](
+			httpClient,
+			baseURL+OrganizationServiceListResourcesByTagProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("ListResourcesByTag")),
+			connect.WithClientOptions(opts...),
+		),
+		createTeam: connect.NewClient[v1.CreateTeamRequest, v1.CreateTeamResponse](
+			httpClient,
+			baseURL+OrganizationServiceCreateTeamProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("CreateTeam")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTeam: connect.NewClient[v1.DeleteTeamRequest, v1.DeleteTeamResponse](
+			httpClient,
+			baseURL+OrganizationServiceDeleteTeamProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("DeleteTeam")),
+			connect.WithClientOptions(opts...),
+		),
+		setTeamQuota: connect.NewClient[v1.SetTeamQuotaRequest, v1.SetTeamQuotaResponse](
+			httpClient,
+			baseURL+OrganizationServiceSetTeamQuotaProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("SetTeamQuota")),
+			connect.WithClientOptions(opts...),
+		),
+		getTeamUsage: connect.NewClient[v1.GetTeamUsageRequest, v1.GetTeamUsageResponse](
+			httpClient,
+			baseURL+OrganizationServiceGetTeamUsageProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("GetTeamUsage")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeOrganizations: connect.NewClient[v1.MergeOrganizationsRequest, v1.MergeOrganizationsResponse](
+			httpClient,
+			baseURL+OrganizationServiceMergeOrganizationsProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("MergeOrganizations")),
+			connect.WithClientOptions(opts...),
+		),
+		createManagedOrganization: connect.NewClient[v1.CreateManagedOrganizationRequest, v1.CreateManagedOrganizationResponse](
+			httpClient,
+			baseURL+OrganizationServiceCreateManagedOrganizationProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("CreateManagedOrganization")),
+			connect.WithClientOptions(opts...),
+		),
+		listManagedOrganizations: connect.NewClient[v1.ListManagedOrganizationsRequest, v1.ListManagedOrganizationsResponse](
+			httpClient,
+			baseURL+OrganizationServiceListManagedOrganizationsProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("ListManagedOrganizations")),
+			connect.WithClientOptions(opts...),
+		),
+		addRoleInheritance: connect.NewClient[v1.AddRoleInheritanceRequest, v1.AddRoleInheritanceResponse](
+			httpClient,
+			baseURL+OrganizationServiceAddRoleInheritanceProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("AddRoleInheritance")),
+			connect.WithClientOptions(opts...),
+		),
+		removeRoleInheritance: connect.NewClient[v1.RemoveRoleInheritanceRequest, v1.RemoveRoleInheritanceResponse](
+			httpClient,
+			baseURL+OrganizationServiceRemoveRoleInheritanceProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("RemoveRoleInheritance")),
+			connect.WithClientOptions(opts...),
+		),
+		getEffectivePermissions: connect.NewClient[v1.GetEffectivePermissionsRequest, v1.GetEffectivePermissionsResponse](
+			httpClient,
+			baseURL+OrganizationServiceGetEffectivePermissionsProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("GetEffectivePermissions")),
+			connect.WithClientOptions(opts...),
+		),
+		rolesGraph: connect.NewClient[v1.RolesGraphRequest, v1.RolesGraphResponse](
+			httpClient,
+			baseURL+OrganizationServiceRolesGraphProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("RolesGraph")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // organizationServiceClient implements OrganizationServiceClient.
 type organizationServiceClient struct {
-	adminSetPlan       *connect.Client[v1.AdminSetPlanRequest, v1.AdminSetPlanResponse]
-	listOrganizations  *connect.Client[v1.ListOrganizationsRequest, v1.ListOrganizationsResponse]
-	createOrganization *connect.Client[v1.CreateOrganizationRequest, v1.CreateOrganizationResponse]
-	getOrganization    *connect.Client[v1.GetOrganizationRequest, v1.GetOrganizationResponse]
-	updateOrganization *connect.Client[v1.UpdateOrganizationRequest, v1.UpdateOrganizationResponse]
-	listMembers        *connect.Client[v1.ListMembersRequest, v1.ListMembersResponse]
-	inviteMember       *connect.Client[v1.InviteMemberRequest, v1.InviteMemberResponse]
-	resendInvite       *connect.Client[v1.ResendInviteRequest, v1.ResendInviteResponse]
-	listMyInvites      *connect.Client[v1.ListMyInvitesRequest, v1.ListMyInvitesResponse]
-	acceptInvite       *connect.Client[v1.AcceptInviteRequest, v1.AcceptInviteResponse]
-	declineInvite      *connect.Client[v1.DeclineInviteRequest, v1.DeclineInviteResponse]
-	updateMember       *connect.Client[v1.UpdateMemberRequest, v1.UpdateMemberResponse]
-	removeMember       *connect.Client[v1.RemoveMemberRequest, v1.RemoveMemberResponse]
-	transferOwnership  *connect.Client[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse]
-	getUsage           *connect.Client[v1.GetUsageRequest, v1.GetUsageResponse]
-	addCredits         *connect.Client[v1.AddCreditsRequest, v1.AddCreditsResponse]
-	adminAddCredits    *connect.Client[v1.AdminAddCreditsRequest, v1.AdminAddCreditsResponse]
-	adminRemoveCredits *connect.Client[v1.AdminRemoveCreditsRequest, v1.AdminRemoveCreditsResponse]
-	getCreditLog       *connect.Client[v1.GetCreditLogRequest, v1.GetCreditLogResponse]
-	getMyPermissions   *connect.Client[v1.GetMyPermissionsRequest, v1.GetMyPermissionsResponse]
+	adminSetPlan                *connect.Client[v1.AdminSetPlanRequest, v1.AdminSetPlanResponse]
+	listOrganizations           *connect.Client[v1.ListOrganizationsRequest, v1.ListOrganizationsResponse]
+	createOrganization          *connect.Client[v1.CreateOrganizationRequest, v1.CreateOrganizationResponse]
+	getOrganization             *connect.Client[v1.GetOrganizationRequest, v1.GetOrganizationResponse]
+	updateOrganization          *connect.Client[v1.UpdateOrganizationRequest, v1.UpdateOrganizationResponse]
+	listMembers                 *connect.Client[v1.ListMembersRequest, v1.ListMembersResponse]
+	inviteMember                *connect.Client[v1.InviteMemberRequest, v1.InviteMemberResponse]
+	resendInvite                *connect.Client[v1.ResendInviteRequest, v1.ResendInviteResponse]
+	listMyInvites               *connect.Client[v1.ListMyInvitesRequest, v1.ListMyInvitesResponse]
+	acceptInvite                *connect.Client[v1.AcceptInviteRequest, v1.AcceptInviteResponse]
+	declineInvite               *connect.Client[v1.DeclineInviteRequest, v1.DeclineInviteResponse]
+	updateMember                *connect.Client[v1.UpdateMemberRequest, v1.UpdateMemberResponse]
+	removeMember                *connect.Client[v1.RemoveMemberRequest, v1.RemoveMemberResponse]
+	transferOwnership           *connect.Client[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse]
+	getUsage                    *connect.Client[v1.GetUsageRequest, v1.GetUsageResponse]
+	addCredits                  *connect.Client[v1.AddCreditsRequest, v1.AddCreditsResponse]
+	adminAddCredits             *connect.Client[v1.AdminAddCreditsRequest, v1.AdminAddCreditsResponse]
+	adminRemoveCredits          *connect.Client[v1.AdminRemoveCreditsRequest, v1.AdminRemoveCreditsResponse]
+	getCreditLog                *connect.Client[v1.GetCreditLogRequest, v1.GetCreditLogResponse]
+	getMyPermissions            *connect.Client[v1.GetMyPermissionsRequest, v1.GetMyPermissionsResponse]
+	getOrganizationAuditLog     *connect.Client[v1.GetOrganizationAuditLogRequest, v1.GetOrganizationAuditLogResponse]
+	getOrganizationActivityFeed *connect.Client[v1.GetOrganizationActivityFeedRequest, v1.GetOrganizationActivityFeedResponse]
+	configureSAML               *connect.Client[v1.ConfigureSAMLRequest, v1.ConfigureSAMLResponse]
+	getSAMLConfig               *connect.Client[v1.GetSAMLConfigRequest, v1.GetSAMLConfigResponse]
+	addResourceTag              *connect.Client[v1.AddResourceTagRequest, v1.AddResourceTagResponse]
+	removeResourceTag           *connect.Client[v1.RemoveResourceTagRequest, v1.RemoveResourceTagResponse]
+	listResourcesByTag          *connect.Client[v1.ListResourcesByTagRequest, v1.ListResourcesByTagResponse]
+	createTeam                  *connect.Client[v1.CreateTeamRequest, v1.CreateTeamResponse]
+	deleteTeam                  *connect.Client[v1.DeleteTeamRequest, v1.DeleteTeamResponse]
+	setTeamQuota                *connect.Client[v1.SetTeamQuotaRequest, v1.SetTeamQuotaResponse]
+	getTeamUsage                *connect.Client[v1.GetTeamUsageRequest, v1.GetTeamUsageResponse]
+	mergeOrganizations          *connect.Client[v1.MergeOrganizationsRequest, v1.MergeOrganizationsResponse]
+	createManagedOrganization   *connect.Client[v1.CreateManagedOrganizationRequest, v1.CreateManagedOrganizationResponse]
+	listManagedOrganizations    *connect.Client[v1.ListManagedOrganizationsRequest, v1.ListManagedOrganizationsResponse]
+	addRoleInheritance          *connect.Client[v1.AddRoleInheritanceRequest, v1.AddRoleInheritanceResponse]
+	removeRoleInheritance       *connect.Client[v1.RemoveRoleInheritanceRequest, v1.RemoveRoleInheritanceResponse]
+	getEffectivePermissions     *connect.Client[v1.GetEffectivePermissionsRequest, v1.GetEffectivePermissionsResponse]
+	rolesGraph                  *connect.Client[v1.RolesGraphRequest, v1.RolesGraphResponse]
 }
 
 // AdminSetPlan calls obiente.cloud.organizations.v1.OrganizationService.AdminSetPlan.
@@ -399,6 +616,102 @@ func (c *organizationServiceClient) GetMyPermissions(ctx context.Context, req *c
 	return c.getMyPermissions.CallUnary(ctx, req)
 }
 
+// GetOrganizationAuditLog calls
+// obiente.cloud.organizations.v1.OrganizationService.GetOrganizationAuditLog.
+func (c *organizationServiceClient) GetOrganizationAuditLog(ctx context.Context, req *connect.Request[v1.GetOrganizationAuditLogRequest]) (*connect.Response[v1.GetOrganizationAuditLogResponse], error) {
+	return c.getOrganizationAuditLog.CallUnary(ctx, req)
+}
+
+// GetOrganizationActivityFeed calls
+// obiente.cloud.organizations.v1.OrganizationService.GetOrganizationActivityFeed.
+func (c *organizationServiceClient) GetOrganizationActivityFeed(ctx context.Context, req *connect.Request[v1.GetOrganizationActivityFeedRequest]) (*connect.Response[v1.GetOrganizationActivityFeedResponse], error) {
+	return c.getOrganizationActivityFeed.CallUnary(ctx, req)
+}
+
+// ConfigureSAML calls obiente.cloud.organizations.v1.OrganizationService.ConfigureSAML.
+func (c *organizationServiceClient) ConfigureSAML(ctx context.Context, req *connect.Request[v1.ConfigureSAMLRequest]) (*connect.Response[v1.ConfigureSAMLResponse], error) {
+	return c.configureSAML.CallUnary(ctx, req)
+}
+
+// GetSAMLConfig calls obiente.cloud.organizations.v1.OrganizationService.GetSAMLConfig.
+func (c *organizationServiceClient) GetSAMLConfig(ctx context.Context, req *connect.Request[v1.GetSAMLConfigRequest]) (*connect.Response[v1.GetSAMLConfigResponse], error) {
+	return c.getSAMLConfig.CallUnary(ctx, req)
+}
+
+// AddResourceTag calls obiente.cloud.organizations.v1.OrganizationService.AddResourceTag.
+func (c *organizationServiceClient) AddResourceTag(ctx context.Context, req *connect.Request[v1.AddResourceTagRequest]) (*connect.Response[v1.AddResourceTagResponse], error) {
+	return c.addResourceTag.CallUnary(ctx, req)
+}
+
+// RemoveResourceTag calls obiente.cloud.organizations.v1.OrganizationService.RemoveResourceTag.
+func (c *organizationServiceClient) RemoveResourceTag(ctx context.Context, req *connect.Request[v1.RemoveResourceTagRequest]) (*connect.Response[v1.RemoveResourceTagResponse], error) {
+	return c.removeResourceTag.CallUnary(ctx, req)
+}
+
+// ListResourcesByTag calls obiente.cloud.organizations.v1.OrganizationService.ListResourcesByTag.
+func (c *organizationServiceClient) ListResourcesByTag(ctx context.Context, req *connect.Request[v1.ListResourcesByTagRequest]) (*connect.Response[v1.ListResourcesByTagResponse], error) {
+	return c.listResourcesByTag.CallUnary(ctx, req)
+}
+
+// CreateTeam calls obiente.cloud.organizations.v1.OrganizationService.CreateTeam.
+func (c *organizationServiceClient) CreateTeam(ctx context.Context, req *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error) {
+	return c.createTeam.CallUnary(ctx, req)
+}
+
+// DeleteTeam calls obiente.cloud.organizations.v1.OrganizationService.DeleteTeam.
+func (c *organizationServiceClient) DeleteTeam(ctx context.Context, req *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[v1.DeleteTeamResponse], error) {
+	return c.deleteTeam.CallUnary(ctx, req)
+}
+
+// SetTeamQuota calls obiente.cloud.organizations.v1.OrganizationService.SetTeamQuota.
+func (c *organizationServiceClient) SetTeamQuota(ctx context.Context, req *connect.Request[v1.SetTeamQuotaRequest]) (*connect.Response[v1.SetTeamQuotaResponse], error) {
+	return c.setTeamQuota.CallUnary(ctx, req)
+}
+
+// GetTeamUsage calls obiente.cloud.organizations.v1.OrganizationService.GetTeamUsage.
+func (c *organizationServiceClient) GetTeamUsage(ctx context.Context, req *connect.Request[v1.GetTeamUsageRequest]) (*connect.Response[v1.GetTeamUsageResponse], error) {
+	return c.getTeamUsage.CallUnary(ctx, req)
+}
+
+// MergeOrganizations calls obiente.cloud.organizations.v1.OrganizationService.MergeOrganizations.
+func (c *organizationServiceClient) MergeOrganizations(ctx context.Context, req *connect.Request[v1.MergeOrganizationsRequest]) (*connect.Response[v1.MergeOrganizationsResponse], error) {
+	return c.mergeOrganizations.CallUnary(ctx, req)
+}
+
+// CreateManagedOrganization calls
+// obiente.cloud.organizations.v1.OrganizationService.CreateManagedOrganization.
+func (c *organizationServiceClient) CreateManagedOrganization(ctx context.Context, req *connect.Request[v1.CreateManagedOrganizationRequest]) (*connect.Response[v1.CreateManagedOrganizationResponse], error) {
+	return c.createManagedOrganization.CallUnary(ctx, req)
+}
+
+// ListManagedOrganizations calls
+// obiente.cloud.organizations.v1.OrganizationService.ListManagedOrganizations.
+func (c *organizationServiceClient) ListManagedOrganizations(ctx context.Context, req *connect.Request[v1.ListManagedOrganizationsRequest]) (*connect.Response[v1.ListManagedOrganizationsResponse], error) {
+	return c.listManagedOrganizations.CallUnary(ctx, req)
+}
+
+// AddRoleInheritance calls obiente.cloud.organizations.v1.OrganizationService.AddRoleInheritance.
+func (c *organizationServiceClient) AddRoleInheritance(ctx context.Context, req *connect.Request[v1.AddRoleInheritanceRequest]) (*connect.Response[v1.AddRoleInheritanceResponse], error) {
+	return c.addRoleInheritance.CallUnary(ctx, req)
+}
+
+// RemoveRoleInheritance calls
+// obiente.cloud.organizations.v1.OrganizationService.RemoveRoleInheritance.
+func (c *organizationServiceClient) RemoveRoleInheritance(ctx context.Context, req *connect.Request[v1.RemoveRoleInheritanceRequest]) (*connect.Response[v1.RemoveRoleInheritanceResponse], error) {
+	return c.removeRoleInheritance.CallUnary(ctx, req)
+}
+
+// GetEffectivePermissions calls
+// obiente.cloud.organizations.v1.OrganizationService.GetEffectivePermissions.
+func (c *organizationServiceClient) GetEffectivePermissions(ctx context.Context, req *connect.Request[v1.GetEffectivePermissionsRequest]) (*connect.Response[v1.GetEffectivePermissionsResponse], error) {
+	return c.getEffectivePermissions.CallUnary(ctx, req)
+}
+
+// RolesGraph calls obiente.cloud.organizations.v1.OrganizationService.RolesGraph.
+func (c *organizationServiceClient) RolesGraph(ctx context.Context, req *connect.Request[v1.RolesGraphRequest]) (*connect.Response[v1.RolesGraphResponse], error) {
+	return c.rolesGraph.CallUnary(ctx, req)
+}
+
 // OrganizationServiceHandler is an implementation of the
 // obiente.cloud.organizations.v1.OrganizationService service.
 type OrganizationServiceHandler interface {
@@ -442,6 +755,43 @@ type OrganizationServiceHandler interface {
 	GetCreditLog(context.Context, *connect.Request[v1.GetCreditLogRequest]) (*connect.Response[v1.GetCreditLogResponse], error)
 	// Get current user's permissions for an organization
 	GetMyPermissions(context.Context, *connect.Request[v1.GetMyPermissionsRequest]) (*connect.Response[v1.GetMyPermissionsResponse], error)
+	// Get the organization's audit trail, including field-level changes for updates (owner/admin only)
+	GetOrganizationAuditLog(context.Context, *connect.Request[v1.GetOrganizationAuditLogRequest]) (*connect.Response[v1.GetOrganizationAuditLogResponse], error)
+	// Get the organization's activity feed of changes to its deployments, game servers, VPSes, members and billing, newest first (owner/admin only)
+	GetOrganizationActivityFeed(context.Context, *connect.Request[v1.GetOrganizationActivityFeedRequest]) (*connect.Response[v1.GetOrganizationActivityFeedResponse], error)
+	// Create or replace the organization's SAML 2.0 identity provider (owner/admin only)
+	ConfigureSAML(context.Context, *connect.Request[v1.ConfigureSAMLRequest]) (*connect.Response[v1.ConfigureSAMLResponse], error)
+	// Get the organization's SAML 2.0 identity provider and service provider URLs
+	GetSAMLConfig(context.Context, *connect.Request[v1.GetSAMLConfigRequest]) (*connect.Response[v1.GetSAMLConfigResponse], error)
+	// Set a key=value tag on a deployment, game server or VPS, replacing the key's current value
+	AddResourceTag(context.Context, *connect.Request[v1.AddResourceTagRequest]) (*connect.Response[v1.AddResourceTagResponse], error)
+	// Remove a tag from a deployment, game server or VPS
+	RemoveResourceTag(context.Context, *connect.Request[v1.RemoveResourceTagRequest]) (*connect.Response[v1.RemoveResourceTagResponse], error)
+	// List the organization's resources that carry every given tag
+	ListResourcesByTag(context.Context, *connect.Request[v1.ListResourcesByTagRequest]) (*connect.Response[v1.ListResourcesByTagResponse], error)
+	// Create a team of organization members (owner/admin only)
+	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
+	// Delete a team with its quota; its resources lose their team tag (owner/admin only)
+	DeleteTeam(context.Context, *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[v1.DeleteTeamResponse], error)
+	// Set the quota for the resources tagged with a team (owner/admin only)
+	SetTeamQuota(context.Context, *connect.Request[v1.SetTeamQuotaRequest]) (*connect.Response[v1.SetTeamQuotaResponse], error)
+	// Get a team's resource usage and quota
+	GetTeamUsage(context.Context, *connect.Request[v1.GetTeamUsageRequest]) (*connect.Response[v1.GetTeamUsageResponse], error)
+	// Admin: Move all resources, credits and members of one organization into another and mark it merged (superadmin only)
+	MergeOrganizations(context.Context, *connect.Request[v1.MergeOrganizationsRequest]) (*connect.Response[v1.MergeOrganizationsResponse], error)
+	// Create an organization managed by this one, e.g. for a reseller's customer (owner/admin only).
+	// Managed organizations use the parent's plan and quota, and their charges are billed to the parent.
+	CreateManagedOrganization(context.Context, *connect.Request[v1.CreateManagedOrganizationRequest]) (*connect.Response[v1.CreateManagedOrganizationResponse], error)
+	// List the organizations managed by this one
+	ListManagedOrganizations(context.Context, *connect.Request[v1.ListManagedOrganizationsRequest]) (*connect.Response[v1.ListManagedOrganizationsResponse], error)
+	// Make a custom role inherit every permission of a parent role; rejects edges that would create a cycle (owner/admin only)
+	AddRoleInheritance(context.Context, *connect.Request[v1.AddRoleInheritanceRequest]) (*connect.Response[v1.AddRoleInheritanceResponse], error)
+	// Stop a custom role inheriting from a parent role (owner/admin only)
+	RemoveRoleInheritance(context.Context, *connect.Request[v1.RemoveRoleInheritanceRequest]) (*connect.Response[v1.RemoveRoleInheritanceResponse], error)
+	// Get a member's flattened permissions, including those inherited from ancestor roles (owner/admin, or the member themselves)
+	GetEffectivePermissions(context.Context, *connect.Request[v1.GetEffectivePermissionsRequest]) (*connect.Response[v1.GetEffectivePermissionsResponse], error)
+	// Get the organization's custom roles and their inheritance edges as a DAG for visualization (owner/admin only)
+	RolesGraph(context.Context, *connect.Request[v1.RolesGraphRequest]) (*connect.Response[v1.RolesGraphResponse], error)
 }
 
 // NewOrganizationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -571,6 +921,114 @@ func NewOrganizationServiceHandler(svc OrganizationServiceHandler, opts ...conne
 		connect.WithSchema(organizationServiceMethods.ByName("GetMyPermissions")),
 		connect.WithHandlerOptions(opts...),
 	)
+	organizationServiceGetOrganizationAuditLogHandler := connect.NewUnaryHandler(
+		OrganizationServiceGetOrganizationAuditLogProcedure,
+		svc.GetOrganizationAuditLog,
+		connect.WithSchema(organizationServiceMethods.ByName("GetOrganizationAuditLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceGetOrganizationActivityFeedHandler := connect.NewUnaryHandler(
+		OrganizationServiceGetOrganizationActivityFeedProcedure,
+		svc.GetOrganizationActivityFeed,
+		connect.WithSchema(organizationServiceMethods.ByName("GetOrganizationActivityFeed")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceConfigureSAMLHandler := connect.NewUnaryHandler(
+		OrganizationServiceConfigureSAMLProcedure,
+		svc.ConfigureSAML,
+		connect.WithSchema(organizationServiceMethods.ByName("ConfigureSAML")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceGetSAMLConfigHandler := connect.NewUnaryHandler(
+		OrganizationServiceGetSAMLConfigProcedure,
+		svc.GetSAMLConfig,
+		connect.WithSchema(organizationServiceMethods.ByName("GetSAMLConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceAddResourceTagHandler := connect.NewUnaryHandler(
+		OrganizationServiceAddResourceTagProcedure,
+		svc.AddResourceTag,
+		connect.WithSchema(organizationServiceMethods.ByName("AddResourceTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceRemoveResourceTagHandler := connect.NewUnaryHandler(
+		OrganizationServiceRemoveResourceTagProcedure,
+		svc.RemoveResourceTag,
+		connect.WithSchema(organizationServiceMethods.ByName("RemoveResourceTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceListResourcesByTagHandler := connect.NewUnaryHandler(
+		OrganizationServiceListResourcesByTagProcedure,
+		svc.ListResourcesByTag,
+		connect.WithSchema(organizationServiceMethods.ByName("ListResourcesByTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceCreateTeamHandler := connect.NewUnaryHandler(
+		OrganizationServiceCreateTeamProcedure,
+		svc.CreateTeam,
+		connect.WithSchema(organizationServiceMethods.ByName("CreateTeam")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceDeleteTeamHandler := connect.NewUnaryHandler(
+		OrganizationServiceDeleteTeamProcedure,
+		svc.DeleteTeam,
+		connect.WithSchema(organizationServiceMethods.ByName("DeleteTeam")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceSetTeamQuotaHandler := connect.NewUnaryHandler(
+		OrganizationServiceSetTeamQuotaProcedure,
+		svc.SetTeamQuota,
+		connect.WithSchema(organizationServiceMethods.ByName("SetTeamQuota")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceGetTeamUsageHandler := connect.NewUnaryHandler(
+		OrganizationServiceGetTeamUsageProcedure,
+		svc.GetTeamUsage,
+		connect.WithSchema(organizationServiceMethods.ByName("GetTeamUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceMergeOrganizationsHandler := connect.NewUnaryHandler(
+		OrganizationServiceMergeOrganizationsProcedure,
+		svc.MergeOrganizations,
+		connect.WithSchema(organizationServiceMethods.ByName("MergeOrganizations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceCreateManagedOrganizationHandler := connect.NewUnaryHandler(
+		OrganizationServiceCreateManagedOrganizationProcedure,
+		svc.CreateManagedOrganization,
+		connect.WithSchema(organizationServiceMethods.ByName("CreateManagedOrganization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceListManagedOrganizationsHandler := connect.NewUnaryHandler(
+		OrganizationServiceListManagedOrganizationsProcedure,
+		svc.ListManagedOrganizations,
+		connect.WithSchema(organizationServiceMethods.ByName("ListManagedOrganizations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceAddRoleInheritanceHandler := connect.NewUnaryHandler(
+		OrganizationServiceAddRoleInheritanceProcedure,
+		svc.AddRoleInheritance,
+		connect.WithSchema(organizationServiceMethods.ByName("AddRoleInheritance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceRemoveRoleInheritanceHandler := connect.NewUnaryHandler(
+		OrganizationServiceRemoveRoleInheritanceProcedure,
+		svc.RemoveRoleInheritance,
+		connect.WithSchema(organizationServiceMethods.ByName("RemoveRoleInheritance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceGetEffectivePermissionsHandler := connect.NewUnaryHandler(
+		OrganizationServiceGetEffectivePermissionsProcedure,
+		svc.GetEffectivePermissions,
+		connect.WithSchema(organizationServiceMethods.ByName("GetEffectivePermissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceRolesGraphHandler := connect.NewUnaryHandler(
+		OrganizationServiceRolesGraphProcedure,
+		svc.RolesGraph,
+		connect.WithSchema(organizationServiceMethods.ByName("RolesGraph")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/obiente.cloud.organizations.v1.OrganizationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OrganizationServiceAdminSetPlanProcedure:
@@ -613,6 +1071,42 @@ func NewOrganizationServiceHandler(svc OrganizationServiceHandler, opts ...conne
 			organizationServiceGetCreditLogHandler.ServeHTTP(w, r)
 		case OrganizationServiceGetMyPermissionsProcedure:
 			organizationServiceGetMyPermissionsHandler.ServeHTTP(w, r)
+		case OrganizationServiceGetOrganizationAuditLogProcedure:
+			organizationServiceGetOrganizationAuditLogHandler.ServeHTTP(w, r)
+		case OrganizationServiceGetOrganizationActivityFeedProcedure:
+			organizationServiceGetOrganizationActivityFeedHandler.ServeHTTP(w, r)
+		case OrganizationServiceConfigureSAMLProcedure:
+			organizationServiceConfigureSAMLHandler.ServeHTTP(w, r)
+		case OrganizationServiceGetSAMLConfigProcedure:
+			organizationServiceGetSAMLConfigHandler.ServeHTTP(w, r)
+		case OrganizationServiceAddResourceTagProcedure:
+			organizationServiceAddResourceTagHandler.ServeHTTP(w, r)
+		case OrganizationServiceRemoveResourceTagProcedure:
+			organizationServiceRemoveResourceTagHandler.ServeHTTP(w, r)
+		case OrganizationServiceListResourcesByTagProcedure:
+			organizationServiceListResourcesByTagHandler.ServeHTTP(w, r)
+		case OrganizationServiceCreateTeamProcedure:
+			organizationServiceCreateTeamHandler.ServeHTTP(w, r)
+		case OrganizationServiceDeleteTeamProcedure:
+			organizationServiceDeleteTeamHandler.ServeHTTP(w, r)
+		case OrganizationServiceSetTeamQuotaProcedure:
+			organizationServiceSetTeamQuotaHandler.ServeHTTP(w, r)
+		case OrganizationServiceGetTeamUsageProcedure:
+			organizationServiceGetTeamUsageHandler.ServeHTTP(w, r)
+		case OrganizationServiceMergeOrganizationsProcedure:
+			organizationServiceMergeOrganizationsHandler.ServeHTTP(w, r)
+		case OrganizationServiceCreateManagedOrganizationProcedure:
+			organizationServiceCreateManagedOrganizationHandler.ServeHTTP(w, r)
+		case OrganizationServiceListManagedOrganizationsProcedure:
+			organizationServiceListManagedOrganizationsHandler.ServeHTTP(w, r)
+		case OrganizationServiceAddRoleInheritanceProcedure:
+			organizationServiceAddRoleInheritanceHandler.ServeHTTP(w, r)
+		case OrganizationServiceRemoveRoleInheritanceProcedure:
+			organizationServiceRemoveRoleInheritanceHandler.ServeHTTP(w, r)
+		case OrganizationServiceGetEffectivePermissionsProcedure:
+			organizationServiceGetEffectivePermissionsHandler.ServeHTTP(w, r)
+		case OrganizationServiceRolesGraphProcedure:
+			organizationServiceRolesGraphHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -701,3 +1195,75 @@ func (UnimplementedOrganizationServiceHandler) GetCreditLog(context.Context, *co
 func (UnimplementedOrganizationServiceHandler) GetMyPermissions(context.Context, *connect.Request[v1.GetMyPermissionsRequest]) (*connect.Response[v1.GetMyPermissionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.GetMyPermissions is not implemented"))
 }
+
+func (UnimplementedOrganizationServiceHandler) GetOrganizationAuditLog(context.Context, *connect.Request[v1.GetOrganizationAuditLogRequest]) (*connect.Response[v1.GetOrganizationAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.GetOrganizationAuditLog is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) GetOrganizationActivityFeed(context.Context, *connect.Request[v1.GetOrganizationActivityFeedRequest]) (*connect.Response[v1.GetOrganizationActivityFeedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.GetOrganizationActivityFeed is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) ConfigureSAML(context.Context, *connect.Request[v1.ConfigureSAMLRequest]) (*connect.Response[v1.ConfigureSAMLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.ConfigureSAML is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) GetSAMLConfig(context.Context, *connect.Request[v1.GetSAMLConfigRequest]) (*connect.Response[v1.GetSAMLConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.GetSAMLConfig is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) AddResourceTag(context.Context, *connect.Request[v1.AddResourceTagRequest]) (*connect.Response[v1.AddResourceTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.AddResourceTag is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) RemoveResourceTag(context.Context, *connect.Request[v1.RemoveResourceTagRequest]) (*connect.Response[v1.RemoveResourceTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.RemoveResourceTag is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) ListResourcesByTag(context.Context, *connect.Request[v1.ListResourcesByTagRequest]) (*connect.Response[v1.ListResourcesByTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.ListResourcesByTag is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.CreateTeam is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) DeleteTeam(context.Context, *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[v1.DeleteTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.DeleteTeam is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) SetTeamQuota(context.Context, *connect.Request[v1.SetTeamQuotaRequest]) (*connect.Response[v1.SetTeamQuotaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.SetTeamQuota is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) GetTeamUsage(context.Context, *connect.Request[v1.GetTeamUsageRequest]) (*connect.Response[v1.GetTeamUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.GetTeamUsage is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) MergeOrganizations(context.Context, *connect.Request[v1.MergeOrganizationsRequest]) (*connect.Response[v1.MergeOrganizationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.MergeOrganizations is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) CreateManagedOrganization(context.Context, *connect.Request[v1.CreateManagedOrganizationRequest]) (*connect.Response[v1.CreateManagedOrganizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.CreateManagedOrganization is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) ListManagedOrganizations(context.Context, *connect.Request[v1.ListManagedOrganizationsRequest]) (*connect.Response[v1.ListManagedOrganizationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.ListManagedOrganizations is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) AddRoleInheritance(context.Context, *connect.Request[v1.AddRoleInheritanceRequest]) (*connect.Response[v1.AddRoleInheritanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.AddRoleInheritance is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) RemoveRoleInheritance(context.Context, *connect.Request[v1.RemoveRoleInheritanceRequest]) (*connect.Response[v1.RemoveRoleInheritanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.RemoveRoleInheritance is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) GetEffectivePermissions(context.Context, *connect.Request[v1.GetEffectivePermissionsRequest]) (*connect.Response[v1.GetEffectivePermissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.GetEffectivePermissions is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) RolesGraph(context.Context, *connect.Request[v1.RolesGraphRequest]) (*connect.Response[v1.RolesGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.organizations.v1.OrganizationService.RolesGraph is not implemented"))
+}
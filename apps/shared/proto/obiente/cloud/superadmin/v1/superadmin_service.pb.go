@@ -1057,27 +1057,32 @@ func (x *ListDNSRecordsResponse) GetRecords() []*DNSRecord {
 	return nil
 }
 
-// Get DNS Config Request
-type GetDNSConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// List DNS Query Logs Request
+type ListDNSQueryLogsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Filter by the organization owning the queried resource
+	Domain         *string                `protobuf:"bytes,2,opt,name=domain,proto3,oneof" json:"domain,omitempty"`                                       // Filter by domain, including its subdomains
+	Since          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3,oneof" json:"since,omitempty"`                                         // Only queries at or after this time
+	Limit          *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                                        // Limit number of results (default: 100, max: 1000)
+	Offset         *int32                 `protobuf:"varint,5,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                                      // Offset for pagination (default: 0)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetDNSConfigRequest) Reset() {
-	*x = GetDNSConfigRequest{}
+func (x *ListDNSQueryLogsRequest) Reset() {
+	*x = ListDNSQueryLogsRequest{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDNSConfigRequest) String() string {
+func (x *ListDNSQueryLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDNSConfigRequest) ProtoMessage() {}
+func (*ListDNSQueryLogsRequest) ProtoMessage() {}
 
-func (x *GetDNSConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDNSQueryLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1089,37 +1094,75 @@ func (x *GetDNSConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDNSConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetDNSConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDNSQueryLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListDNSQueryLogsRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{12}
 }
 
-// DNS Configuration
-type DNSConfig struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	TraefikIps         []string               `protobuf:"bytes,1,rep,name=traefik_ips,json=traefikIps,proto3" json:"traefik_ips,omitempty"`                                                                                                       // All configured Traefik IPs
-	TraefikIpsByRegion map[string]*TraefikIPs `protobuf:"bytes,2,rep,name=traefik_ips_by_region,json=traefikIpsByRegion,proto3" json:"traefik_ips_by_region,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Traefik IPs grouped by region
-	DnsServerIps       []string               `protobuf:"bytes,3,rep,name=dns_server_ips,json=dnsServerIps,proto3" json:"dns_server_ips,omitempty"`                                                                                               // DNS server IPs
-	DnsPort            string                 `protobuf:"bytes,4,opt,name=dns_port,json=dnsPort,proto3" json:"dns_port,omitempty"`                                                                                                                // DNS server port
-	CacheTtlSeconds    int64                  `protobuf:"varint,5,opt,name=cache_ttl_seconds,json=cacheTtlSeconds,proto3" json:"cache_ttl_seconds,omitempty"`                                                                                     // Cache TTL in seconds
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ListDNSQueryLogsRequest) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return ""
 }
 
-func (x *DNSConfig) Reset() {
-	*x = DNSConfig{}
+func (x *ListDNSQueryLogsRequest) GetDomain() string {
+	if x != nil && x.Domain != nil {
+		return *x.Domain
+	}
+	return ""
+}
+
+func (x *ListDNSQueryLogsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListDNSQueryLogsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListDNSQueryLogsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+// A DNS query answered by the DNS service
+type DNSQueryLog struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`                        // Queried name, lowercase without the trailing dot
+	QueryType      string                 `protobuf:"bytes,4,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"` // A, SRV, TXT, ...
+	ClientIp       string                 `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	Result         string                 `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`                                             // Response code: NOERROR, NXDOMAIN, SERVFAIL, ...
+	LatencyMicros  int64                  `protobuf:"varint,7,opt,name=latency_micros,json=latencyMicros,proto3" json:"latency_micros,omitempty"`         // Time to answer the query in microseconds
+	OrganizationId *string                `protobuf:"bytes,8,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Organization owning the queried resource, if known
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DNSQueryLog) Reset() {
+	*x = DNSQueryLog{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DNSConfig) String() string {
+func (x *DNSQueryLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DNSConfig) ProtoMessage() {}
+func (*DNSQueryLog) ProtoMessage() {}
 
-func (x *DNSConfig) ProtoReflect() protoreflect.Message {
+func (x *DNSQueryLog) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1131,68 +1174,90 @@ func (x *DNSConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DNSConfig.ProtoReflect.Descriptor instead.
-func (*DNSConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSQueryLog.ProtoReflect.Descriptor instead.
+func (*DNSQueryLog) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DNSConfig) GetTraefikIps() []string {
+func (x *DNSQueryLog) GetId() string {
 	if x != nil {
-		return x.TraefikIps
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *DNSConfig) GetTraefikIpsByRegion() map[string]*TraefikIPs {
+func (x *DNSQueryLog) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TraefikIpsByRegion
+		return x.Timestamp
 	}
 	return nil
 }
 
-func (x *DNSConfig) GetDnsServerIps() []string {
+func (x *DNSQueryLog) GetDomain() string {
 	if x != nil {
-		return x.DnsServerIps
+		return x.Domain
 	}
-	return nil
+	return ""
 }
 
-func (x *DNSConfig) GetDnsPort() string {
+func (x *DNSQueryLog) GetQueryType() string {
 	if x != nil {
-		return x.DnsPort
+		return x.QueryType
 	}
 	return ""
 }
 
-func (x *DNSConfig) GetCacheTtlSeconds() int64 {
+func (x *DNSQueryLog) GetClientIp() string {
 	if x != nil {
-		return x.CacheTtlSeconds
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *DNSQueryLog) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *DNSQueryLog) GetLatencyMicros() int64 {
+	if x != nil {
+		return x.LatencyMicros
 	}
 	return 0
 }
 
-type TraefikIPs struct {
+func (x *DNSQueryLog) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return ""
+}
+
+// List DNS Query Logs Response
+type ListDNSQueryLogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
-	Ips           []string               `protobuf:"bytes,2,rep,name=ips,proto3" json:"ips,omitempty"`
+	Logs          []*DNSQueryLog         `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Total number of logs matching filters
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TraefikIPs) Reset() {
-	*x = TraefikIPs{}
+func (x *ListDNSQueryLogsResponse) Reset() {
+	*x = ListDNSQueryLogsResponse{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TraefikIPs) String() string {
+func (x *ListDNSQueryLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TraefikIPs) ProtoMessage() {}
+func (*ListDNSQueryLogsResponse) ProtoMessage() {}
 
-func (x *TraefikIPs) ProtoReflect() protoreflect.Message {
+func (x *ListDNSQueryLogsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1204,47 +1269,48 @@ func (x *TraefikIPs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TraefikIPs.ProtoReflect.Descriptor instead.
-func (*TraefikIPs) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDNSQueryLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListDNSQueryLogsResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *TraefikIPs) GetRegion() string {
+func (x *ListDNSQueryLogsResponse) GetLogs() []*DNSQueryLog {
 	if x != nil {
-		return x.Region
+		return x.Logs
 	}
-	return ""
+	return nil
 }
 
-func (x *TraefikIPs) GetIps() []string {
+func (x *ListDNSQueryLogsResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.Ips
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-// Get DNS Config Response
-type GetDNSConfigResponse struct {
+// A private IP the DNS service answers with for clients inside client_cidr
+type SplitHorizonEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        *DNSConfig             `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	ClientCidr    string                 `protobuf:"bytes,1,opt,name=client_cidr,json=clientCidr,proto3" json:"client_cidr,omitempty"` // e.g. 10.0.0.0/8; the most specific CIDR containing the client wins
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`                                   // IPv4 address answered instead of the Traefik IP
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDNSConfigResponse) Reset() {
-	*x = GetDNSConfigResponse{}
+func (x *SplitHorizonEntry) Reset() {
+	*x = SplitHorizonEntry{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDNSConfigResponse) String() string {
+func (x *SplitHorizonEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDNSConfigResponse) ProtoMessage() {}
+func (*SplitHorizonEntry) ProtoMessage() {}
 
-func (x *GetDNSConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *SplitHorizonEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1256,42 +1322,48 @@ func (x *GetDNSConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDNSConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetDNSConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SplitHorizonEntry.ProtoReflect.Descriptor instead.
+func (*SplitHorizonEntry) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetDNSConfigResponse) GetConfig() *DNSConfig {
+func (x *SplitHorizonEntry) GetClientCidr() string {
 	if x != nil {
-		return x.Config
+		return x.ClientCidr
 	}
-	return nil
+	return ""
 }
 
-// List Delegated DNS Records Request
-type ListDelegatedDNSRecordsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Filter by organization ID
-	ApiKeyId       *string                `protobuf:"bytes,2,opt,name=api_key_id,json=apiKeyId,proto3,oneof" json:"api_key_id,omitempty"`                 // Filter by API key ID
-	RecordType     *string                `protobuf:"bytes,3,opt,name=record_type,json=recordType,proto3,oneof" json:"record_type,omitempty"`             // Filter by record type (A, SRV) - empty means all
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *SplitHorizonEntry) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
 }
 
-func (x *ListDelegatedDNSRecordsRequest) Reset() {
-	*x = ListDelegatedDNSRecordsRequest{}
+// Configure Split Horizon Request
+type ConfigureSplitHorizonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeploymentId  string                 `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Entries       []*SplitHorizonEntry   `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"` // Replaces all existing entries; empty clears them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureSplitHorizonRequest) Reset() {
+	*x = ConfigureSplitHorizonRequest{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDelegatedDNSRecordsRequest) String() string {
+func (x *ConfigureSplitHorizonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDelegatedDNSRecordsRequest) ProtoMessage() {}
+func (*ConfigureSplitHorizonRequest) ProtoMessage() {}
 
-func (x *ListDelegatedDNSRecordsRequest) ProtoReflect() protoreflect.Message {
+func (x *ConfigureSplitHorizonRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1303,64 +1375,47 @@ func (x *ListDelegatedDNSRecordsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDelegatedDNSRecordsRequest.ProtoReflect.Descriptor instead.
-func (*ListDelegatedDNSRecordsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfigureSplitHorizonRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureSplitHorizonRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListDelegatedDNSRecordsRequest) GetOrganizationId() string {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
-	}
-	return ""
-}
-
-func (x *ListDelegatedDNSRecordsRequest) GetApiKeyId() string {
-	if x != nil && x.ApiKeyId != nil {
-		return *x.ApiKeyId
+func (x *ConfigureSplitHorizonRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *ListDelegatedDNSRecordsRequest) GetRecordType() string {
-	if x != nil && x.RecordType != nil {
-		return *x.RecordType
+func (x *ConfigureSplitHorizonRequest) GetEntries() []*SplitHorizonEntry {
+	if x != nil {
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-// Delegated DNS Record Information
-type DelegatedDNSRecord struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Domain         string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`                                       // Full domain (e.g., deploy-123.my.obiente.cloud)
-	RecordType     string                 `protobuf:"bytes,3,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`             // A or SRV
-	Records        []string               `protobuf:"bytes,4,rep,name=records,proto3" json:"records,omitempty"`                                     // Record values (IP addresses for A, SRV strings for SRV)
-	SourceApi      string                 `protobuf:"bytes,5,opt,name=source_api,json=sourceApi,proto3" json:"source_api,omitempty"`                // URL of the API that pushed this record
-	ApiKeyId       string                 `protobuf:"bytes,6,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`                 // ID of the API key that delegated this record
-	OrganizationId string                 `protobuf:"bytes,7,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization that owns the API key
-	Ttl            int64                  `protobuf:"varint,8,opt,name=ttl,proto3" json:"ttl,omitempty"`                                            // TTL in seconds
-	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                // When this record expires
-	LastUpdated    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`         // Last time this record was updated
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Configure Split Horizon Response
+type ConfigureSplitHorizonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*SplitHorizonEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DelegatedDNSRecord) Reset() {
-	*x = DelegatedDNSRecord{}
+func (x *ConfigureSplitHorizonResponse) Reset() {
+	*x = ConfigureSplitHorizonResponse{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DelegatedDNSRecord) String() string {
+func (x *ConfigureSplitHorizonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelegatedDNSRecord) ProtoMessage() {}
+func (*ConfigureSplitHorizonResponse) ProtoMessage() {}
 
-func (x *DelegatedDNSRecord) ProtoReflect() protoreflect.Message {
+func (x *ConfigureSplitHorizonResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1372,111 +1427,82 @@ func (x *DelegatedDNSRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelegatedDNSRecord.ProtoReflect.Descriptor instead.
-func (*DelegatedDNSRecord) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfigureSplitHorizonResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureSplitHorizonResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DelegatedDNSRecord) GetId() string {
+func (x *ConfigureSplitHorizonResponse) GetEntries() []*SplitHorizonEntry {
 	if x != nil {
-		return x.Id
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-func (x *DelegatedDNSRecord) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
+// Get DNS Config Request
+type GetDNSConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DelegatedDNSRecord) GetRecordType() string {
-	if x != nil {
-		return x.RecordType
-	}
-	return ""
+func (x *GetDNSConfigRequest) Reset() {
+	*x = GetDNSConfigRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DelegatedDNSRecord) GetRecords() []string {
-	if x != nil {
-		return x.Records
-	}
-	return nil
+func (x *GetDNSConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DelegatedDNSRecord) GetSourceApi() string {
-	if x != nil {
-		return x.SourceApi
-	}
-	return ""
-}
+func (*GetDNSConfigRequest) ProtoMessage() {}
 
-func (x *DelegatedDNSRecord) GetApiKeyId() string {
+func (x *GetDNSConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[18]
 	if x != nil {
-		return x.ApiKeyId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DelegatedDNSRecord) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
+// Deprecated: Use GetDNSConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetDNSConfigRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DelegatedDNSRecord) GetTtl() int64 {
-	if x != nil {
-		return x.Ttl
-	}
-	return 0
-}
-
-func (x *DelegatedDNSRecord) GetExpiresAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return nil
-}
-
-func (x *DelegatedDNSRecord) GetLastUpdated() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastUpdated
-	}
-	return nil
-}
-
-func (x *DelegatedDNSRecord) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-// List Delegated DNS Records Response
-type ListDelegatedDNSRecordsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Records       []*DelegatedDNSRecord  `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// DNS Configuration
+type DNSConfig struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TraefikIps         []string               `protobuf:"bytes,1,rep,name=traefik_ips,json=traefikIps,proto3" json:"traefik_ips,omitempty"`                                                                                                       // All configured Traefik IPs
+	TraefikIpsByRegion map[string]*TraefikIPs `protobuf:"bytes,2,rep,name=traefik_ips_by_region,json=traefikIpsByRegion,proto3" json:"traefik_ips_by_region,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Traefik IPs grouped by region
+	DnsServerIps       []string               `protobuf:"bytes,3,rep,name=dns_server_ips,json=dnsServerIps,proto3" json:"dns_server_ips,omitempty"`                                                                                               // DNS server IPs
+	DnsPort            string                 `protobuf:"bytes,4,opt,name=dns_port,json=dnsPort,proto3" json:"dns_port,omitempty"`                                                                                                                // DNS server port
+	CacheTtlSeconds    int64                  `protobuf:"varint,5,opt,name=cache_ttl_seconds,json=cacheTtlSeconds,proto3" json:"cache_ttl_seconds,omitempty"`                                                                                     // Cache TTL in seconds
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListDelegatedDNSRecordsResponse) Reset() {
-	*x = ListDelegatedDNSRecordsResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[18]
+func (x *DNSConfig) Reset() {
+	*x = DNSConfig{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDelegatedDNSRecordsResponse) String() string {
+func (x *DNSConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDelegatedDNSRecordsResponse) ProtoMessage() {}
+func (*DNSConfig) ProtoMessage() {}
 
-func (x *ListDelegatedDNSRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[18]
+func (x *DNSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1487,79 +1513,68 @@ func (x *ListDelegatedDNSRecordsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDelegatedDNSRecordsResponse.ProtoReflect.Descriptor instead.
-func (*ListDelegatedDNSRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DNSConfig.ProtoReflect.Descriptor instead.
+func (*DNSConfig) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListDelegatedDNSRecordsResponse) GetRecords() []*DelegatedDNSRecord {
+func (x *DNSConfig) GetTraefikIps() []string {
 	if x != nil {
-		return x.Records
+		return x.TraefikIps
 	}
 	return nil
 }
 
-// Has Delegated DNS Request
-type HasDelegatedDNSRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *HasDelegatedDNSRequest) Reset() {
-	*x = HasDelegatedDNSRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DNSConfig) GetTraefikIpsByRegion() map[string]*TraefikIPs {
+	if x != nil {
+		return x.TraefikIpsByRegion
+	}
+	return nil
 }
 
-func (x *HasDelegatedDNSRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DNSConfig) GetDnsServerIps() []string {
+	if x != nil {
+		return x.DnsServerIps
+	}
+	return nil
 }
 
-func (*HasDelegatedDNSRequest) ProtoMessage() {}
-
-func (x *HasDelegatedDNSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[19]
+func (x *DNSConfig) GetDnsPort() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DnsPort
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use HasDelegatedDNSRequest.ProtoReflect.Descriptor instead.
-func (*HasDelegatedDNSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{19}
+func (x *DNSConfig) GetCacheTtlSeconds() int64 {
+	if x != nil {
+		return x.CacheTtlSeconds
+	}
+	return 0
 }
 
-// Has Delegated DNS Response
-type HasDelegatedDNSResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	HasDelegatedDns bool                   `protobuf:"varint,1,opt,name=has_delegated_dns,json=hasDelegatedDns,proto3" json:"has_delegated_dns,omitempty"` // Whether the user has delegated DNS
-	OrganizationId  string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`       // Organization ID that has delegated DNS (if any)
-	ApiKeyId        string                 `protobuf:"bytes,3,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`                       // API key ID (if any)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type TraefikIPs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Ips           []string               `protobuf:"bytes,2,rep,name=ips,proto3" json:"ips,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HasDelegatedDNSResponse) Reset() {
-	*x = HasDelegatedDNSResponse{}
+func (x *TraefikIPs) Reset() {
+	*x = TraefikIPs{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HasDelegatedDNSResponse) String() string {
+func (x *TraefikIPs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HasDelegatedDNSResponse) ProtoMessage() {}
+func (*TraefikIPs) ProtoMessage() {}
 
-func (x *HasDelegatedDNSResponse) ProtoReflect() protoreflect.Message {
+func (x *TraefikIPs) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1571,53 +1586,47 @@ func (x *HasDelegatedDNSResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HasDelegatedDNSResponse.ProtoReflect.Descriptor instead.
-func (*HasDelegatedDNSResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TraefikIPs.ProtoReflect.Descriptor instead.
+func (*TraefikIPs) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *HasDelegatedDNSResponse) GetHasDelegatedDns() bool {
-	if x != nil {
-		return x.HasDelegatedDns
-	}
-	return false
-}
-
-func (x *HasDelegatedDNSResponse) GetOrganizationId() string {
+func (x *TraefikIPs) GetRegion() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Region
 	}
 	return ""
 }
 
-func (x *HasDelegatedDNSResponse) GetApiKeyId() string {
+func (x *TraefikIPs) GetIps() []string {
 	if x != nil {
-		return x.ApiKeyId
+		return x.Ips
 	}
-	return ""
+	return nil
 }
 
-// Get Pricing Request - public endpoint
-type GetPricingRequest struct {
+// Get DNS Config Response
+type GetDNSConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *DNSConfig             `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPricingRequest) Reset() {
-	*x = GetPricingRequest{}
+func (x *GetDNSConfigResponse) Reset() {
+	*x = GetDNSConfigResponse{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPricingRequest) String() string {
+func (x *GetDNSConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPricingRequest) ProtoMessage() {}
+func (*GetDNSConfigResponse) ProtoMessage() {}
 
-func (x *GetPricingRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDNSConfigResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1629,37 +1638,42 @@ func (x *GetPricingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPricingRequest.ProtoReflect.Descriptor instead.
-func (*GetPricingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDNSConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetDNSConfigResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{21}
 }
 
-// Get Pricing Response
-type GetPricingResponse struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	CpuCostPerCoreSecond    float64                `protobuf:"fixed64,1,opt,name=cpu_cost_per_core_second,json=cpuCostPerCoreSecond,proto3" json:"cpu_cost_per_core_second,omitempty"`
-	MemoryCostPerByteSecond float64                `protobuf:"fixed64,2,opt,name=memory_cost_per_byte_second,json=memoryCostPerByteSecond,proto3" json:"memory_cost_per_byte_second,omitempty"`
-	BandwidthCostPerByte    float64                `protobuf:"fixed64,3,opt,name=bandwidth_cost_per_byte,json=bandwidthCostPerByte,proto3" json:"bandwidth_cost_per_byte,omitempty"`
-	StorageCostPerByteMonth float64                `protobuf:"fixed64,4,opt,name=storage_cost_per_byte_month,json=storageCostPerByteMonth,proto3" json:"storage_cost_per_byte_month,omitempty"`
-	PricingInfo             string                 `protobuf:"bytes,5,opt,name=pricing_info,json=pricingInfo,proto3" json:"pricing_info,omitempty"` // Human-readable description
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *GetDNSConfigResponse) GetConfig() *DNSConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
 }
 
-func (x *GetPricingResponse) Reset() {
-	*x = GetPricingResponse{}
+// List Delegated DNS Records Request
+type ListDelegatedDNSRecordsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Filter by organization ID
+	ApiKeyId       *string                `protobuf:"bytes,2,opt,name=api_key_id,json=apiKeyId,proto3,oneof" json:"api_key_id,omitempty"`                 // Filter by API key ID
+	RecordType     *string                `protobuf:"bytes,3,opt,name=record_type,json=recordType,proto3,oneof" json:"record_type,omitempty"`             // Filter by record type (A, SRV) - empty means all
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListDelegatedDNSRecordsRequest) Reset() {
+	*x = ListDelegatedDNSRecordsRequest{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPricingResponse) String() string {
+func (x *ListDelegatedDNSRecordsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPricingResponse) ProtoMessage() {}
+func (*ListDelegatedDNSRecordsRequest) ProtoMessage() {}
 
-func (x *GetPricingResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDelegatedDNSRecordsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1671,70 +1685,64 @@ func (x *GetPricingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPricingResponse.ProtoReflect.Descriptor instead.
-func (*GetPricingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDelegatedDNSRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListDelegatedDNSRecordsRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetPricingResponse) GetCpuCostPerCoreSecond() float64 {
-	if x != nil {
-		return x.CpuCostPerCoreSecond
-	}
-	return 0
-}
-
-func (x *GetPricingResponse) GetMemoryCostPerByteSecond() float64 {
-	if x != nil {
-		return x.MemoryCostPerByteSecond
-	}
-	return 0
-}
-
-func (x *GetPricingResponse) GetBandwidthCostPerByte() float64 {
-	if x != nil {
-		return x.BandwidthCostPerByte
+func (x *ListDelegatedDNSRecordsRequest) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPricingResponse) GetStorageCostPerByteMonth() float64 {
-	if x != nil {
-		return x.StorageCostPerByteMonth
+func (x *ListDelegatedDNSRecordsRequest) GetApiKeyId() string {
+	if x != nil && x.ApiKeyId != nil {
+		return *x.ApiKeyId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPricingResponse) GetPricingInfo() string {
-	if x != nil {
-		return x.PricingInfo
+func (x *ListDelegatedDNSRecordsRequest) GetRecordType() string {
+	if x != nil && x.RecordType != nil {
+		return *x.RecordType
 	}
 	return ""
 }
 
-// Create DNS Delegation API Key Request
-type CreateDNSDelegationAPIKeyRequest struct {
+// Delegated DNS Record Information
+type DelegatedDNSRecord struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Description    string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`                                   // Description of who/what this key is for
-	SourceApi      *string                `protobuf:"bytes,2,opt,name=source_api,json=sourceApi,proto3,oneof" json:"source_api,omitempty"`                // URL of the API that will use this key (optional)
-	OrganizationId *string                `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Organization ID (optional, required for non-superadmins, inferred from user's memberships if not provided)
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domain         string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`                                       // Full domain (e.g., deploy-123.my.obiente.cloud)
+	RecordType     string                 `protobuf:"bytes,3,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`             // A or SRV
+	Records        []string               `protobuf:"bytes,4,rep,name=records,proto3" json:"records,omitempty"`                                     // Record values (IP addresses for A, SRV strings for SRV)
+	SourceApi      string                 `protobuf:"bytes,5,opt,name=source_api,json=sourceApi,proto3" json:"source_api,omitempty"`                // URL of the API that pushed this record
+	ApiKeyId       string                 `protobuf:"bytes,6,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`                 // ID of the API key that delegated this record
+	OrganizationId string                 `protobuf:"bytes,7,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization that owns the API key
+	Ttl            int64                  `protobuf:"varint,8,opt,name=ttl,proto3" json:"ttl,omitempty"`                                            // TTL in seconds
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                // When this record expires
+	LastUpdated    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`         // Last time this record was updated
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateDNSDelegationAPIKeyRequest) Reset() {
-	*x = CreateDNSDelegationAPIKeyRequest{}
+func (x *DelegatedDNSRecord) Reset() {
+	*x = DelegatedDNSRecord{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDNSDelegationAPIKeyRequest) String() string {
+func (x *DelegatedDNSRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDNSDelegationAPIKeyRequest) ProtoMessage() {}
+func (*DelegatedDNSRecord) ProtoMessage() {}
 
-func (x *CreateDNSDelegationAPIKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *DelegatedDNSRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1746,116 +1754,111 @@ func (x *CreateDNSDelegationAPIKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDNSDelegationAPIKeyRequest.ProtoReflect.Descriptor instead.
-func (*CreateDNSDelegationAPIKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelegatedDNSRecord.ProtoReflect.Descriptor instead.
+func (*DelegatedDNSRecord) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreateDNSDelegationAPIKeyRequest) GetDescription() string {
+func (x *DelegatedDNSRecord) GetId() string {
 	if x != nil {
-		return x.Description
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateDNSDelegationAPIKeyRequest) GetSourceApi() string {
-	if x != nil && x.SourceApi != nil {
-		return *x.SourceApi
+func (x *DelegatedDNSRecord) GetDomain() string {
+	if x != nil {
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *CreateDNSDelegationAPIKeyRequest) GetOrganizationId() string {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
+func (x *DelegatedDNSRecord) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
 	}
 	return ""
 }
 
-// Create DNS Delegation API Key Response
-type CreateDNSDelegationAPIKeyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // The generated API key (shown only once)
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`             // Success message
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`     // Description of the API key
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DelegatedDNSRecord) GetRecords() []string {
+	if x != nil {
+		return x.Records
+	}
+	return nil
 }
 
-func (x *CreateDNSDelegationAPIKeyResponse) Reset() {
-	*x = CreateDNSDelegationAPIKeyResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DelegatedDNSRecord) GetSourceApi() string {
+	if x != nil {
+		return x.SourceApi
+	}
+	return ""
 }
 
-func (x *CreateDNSDelegationAPIKeyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DelegatedDNSRecord) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
 }
 
-func (*CreateDNSDelegationAPIKeyResponse) ProtoMessage() {}
-
-func (x *CreateDNSDelegationAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[24]
+func (x *DelegatedDNSRecord) GetOrganizationId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OrganizationId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateDNSDelegationAPIKeyResponse.ProtoReflect.Descriptor instead.
-func (*CreateDNSDelegationAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{24}
+func (x *DelegatedDNSRecord) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
 }
 
-func (x *CreateDNSDelegationAPIKeyResponse) GetApiKey() string {
+func (x *DelegatedDNSRecord) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ApiKey
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateDNSDelegationAPIKeyResponse) GetMessage() string {
+func (x *DelegatedDNSRecord) GetLastUpdated() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.LastUpdated
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateDNSDelegationAPIKeyResponse) GetDescription() string {
+func (x *DelegatedDNSRecord) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Description
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-// Revoke DNS Delegation API Key Request
-type RevokeDNSDelegationAPIKeyRequest struct {
+// List Delegated DNS Records Response
+type ListDelegatedDNSRecordsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // The API key to revoke
+	Records       []*DelegatedDNSRecord  `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeDNSDelegationAPIKeyRequest) Reset() {
-	*x = RevokeDNSDelegationAPIKeyRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[25]
+func (x *ListDelegatedDNSRecordsResponse) Reset() {
+	*x = ListDelegatedDNSRecordsResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeDNSDelegationAPIKeyRequest) String() string {
+func (x *ListDelegatedDNSRecordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeDNSDelegationAPIKeyRequest) ProtoMessage() {}
+func (*ListDelegatedDNSRecordsResponse) ProtoMessage() {}
 
-func (x *RevokeDNSDelegationAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[25]
+func (x *ListDelegatedDNSRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1866,42 +1869,40 @@ func (x *RevokeDNSDelegationAPIKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeDNSDelegationAPIKeyRequest.ProtoReflect.Descriptor instead.
-func (*RevokeDNSDelegationAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ListDelegatedDNSRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListDelegatedDNSRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RevokeDNSDelegationAPIKeyRequest) GetApiKey() string {
+func (x *ListDelegatedDNSRecordsResponse) GetRecords() []*DelegatedDNSRecord {
 	if x != nil {
-		return x.ApiKey
+		return x.Records
 	}
-	return ""
+	return nil
 }
 
-// Revoke DNS Delegation API Key Response
-type RevokeDNSDelegationAPIKeyResponse struct {
+// Has Delegated DNS Request
+type HasDelegatedDNSRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Success message
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeDNSDelegationAPIKeyResponse) Reset() {
-	*x = RevokeDNSDelegationAPIKeyResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[26]
+func (x *HasDelegatedDNSRequest) Reset() {
+	*x = HasDelegatedDNSRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeDNSDelegationAPIKeyResponse) String() string {
+func (x *HasDelegatedDNSRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeDNSDelegationAPIKeyResponse) ProtoMessage() {}
+func (*HasDelegatedDNSRequest) ProtoMessage() {}
 
-func (x *RevokeDNSDelegationAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[26]
+func (x *HasDelegatedDNSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,48 +1913,36 @@ func (x *RevokeDNSDelegationAPIKeyResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeDNSDelegationAPIKeyResponse.ProtoReflect.Descriptor instead.
-func (*RevokeDNSDelegationAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *RevokeDNSDelegationAPIKeyResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *RevokeDNSDelegationAPIKeyResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use HasDelegatedDNSRequest.ProtoReflect.Descriptor instead.
+func (*HasDelegatedDNSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{25}
 }
 
-// Revoke DNS Delegation API Key For Organization Request
-type RevokeDNSDelegationAPIKeyForOrganizationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization ID whose API key to revoke
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Has Delegated DNS Response
+type HasDelegatedDNSResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HasDelegatedDns bool                   `protobuf:"varint,1,opt,name=has_delegated_dns,json=hasDelegatedDns,proto3" json:"has_delegated_dns,omitempty"` // Whether the user has delegated DNS
+	OrganizationId  string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`       // Organization ID that has delegated DNS (if any)
+	ApiKeyId        string                 `protobuf:"bytes,3,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`                       // API key ID (if any)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RevokeDNSDelegationAPIKeyForOrganizationRequest) Reset() {
-	*x = RevokeDNSDelegationAPIKeyForOrganizationRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[27]
+func (x *HasDelegatedDNSResponse) Reset() {
+	*x = HasDelegatedDNSResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeDNSDelegationAPIKeyForOrganizationRequest) String() string {
+func (x *HasDelegatedDNSResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeDNSDelegationAPIKeyForOrganizationRequest) ProtoMessage() {}
+func (*HasDelegatedDNSResponse) ProtoMessage() {}
 
-func (x *RevokeDNSDelegationAPIKeyForOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[27]
+func (x *HasDelegatedDNSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1964,42 +1953,54 @@ func (x *RevokeDNSDelegationAPIKeyForOrganizationRequest) ProtoReflect() protore
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeDNSDelegationAPIKeyForOrganizationRequest.ProtoReflect.Descriptor instead.
-func (*RevokeDNSDelegationAPIKeyForOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use HasDelegatedDNSResponse.ProtoReflect.Descriptor instead.
+func (*HasDelegatedDNSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *RevokeDNSDelegationAPIKeyForOrganizationRequest) GetOrganizationId() string {
+func (x *HasDelegatedDNSResponse) GetHasDelegatedDns() bool {
+	if x != nil {
+		return x.HasDelegatedDns
+	}
+	return false
+}
+
+func (x *HasDelegatedDNSResponse) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-// Revoke DNS Delegation API Key For Organization Response
-type RevokeDNSDelegationAPIKeyForOrganizationResponse struct {
+func (x *HasDelegatedDNSResponse) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+// Get Pricing Request - public endpoint
+type GetPricingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Success message
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeDNSDelegationAPIKeyForOrganizationResponse) Reset() {
-	*x = RevokeDNSDelegationAPIKeyForOrganizationResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[28]
+func (x *GetPricingRequest) Reset() {
+	*x = GetPricingRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeDNSDelegationAPIKeyForOrganizationResponse) String() string {
+func (x *GetPricingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeDNSDelegationAPIKeyForOrganizationResponse) ProtoMessage() {}
+func (*GetPricingRequest) ProtoMessage() {}
 
-func (x *RevokeDNSDelegationAPIKeyForOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[28]
+func (x *GetPricingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2010,47 +2011,112 @@ func (x *RevokeDNSDelegationAPIKeyForOrganizationResponse) ProtoReflect() protor
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeDNSDelegationAPIKeyForOrganizationResponse.ProtoReflect.Descriptor instead.
-func (*RevokeDNSDelegationAPIKeyForOrganizationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPricingRequest.ProtoReflect.Descriptor instead.
+func (*GetPricingRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{27}
+}
+
+// Get Pricing Response
+type GetPricingResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	CpuCostPerCoreSecond    float64                `protobuf:"fixed64,1,opt,name=cpu_cost_per_core_second,json=cpuCostPerCoreSecond,proto3" json:"cpu_cost_per_core_second,omitempty"`
+	MemoryCostPerByteSecond float64                `protobuf:"fixed64,2,opt,name=memory_cost_per_byte_second,json=memoryCostPerByteSecond,proto3" json:"memory_cost_per_byte_second,omitempty"`
+	BandwidthCostPerByte    float64                `protobuf:"fixed64,3,opt,name=bandwidth_cost_per_byte,json=bandwidthCostPerByte,proto3" json:"bandwidth_cost_per_byte,omitempty"`
+	StorageCostPerByteMonth float64                `protobuf:"fixed64,4,opt,name=storage_cost_per_byte_month,json=storageCostPerByteMonth,proto3" json:"storage_cost_per_byte_month,omitempty"`
+	PricingInfo             string                 `protobuf:"bytes,5,opt,name=pricing_info,json=pricingInfo,proto3" json:"pricing_info,omitempty"` // Human-readable description
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetPricingResponse) Reset() {
+	*x = GetPricingResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPricingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPricingResponse) ProtoMessage() {}
+
+func (x *GetPricingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPricingResponse.ProtoReflect.Descriptor instead.
+func (*GetPricingResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *RevokeDNSDelegationAPIKeyForOrganizationResponse) GetSuccess() bool {
+func (x *GetPricingResponse) GetCpuCostPerCoreSecond() float64 {
 	if x != nil {
-		return x.Success
+		return x.CpuCostPerCoreSecond
 	}
-	return false
+	return 0
 }
 
-func (x *RevokeDNSDelegationAPIKeyForOrganizationResponse) GetMessage() string {
+func (x *GetPricingResponse) GetMemoryCostPerByteSecond() float64 {
 	if x != nil {
-		return x.Message
+		return x.MemoryCostPerByteSecond
+	}
+	return 0
+}
+
+func (x *GetPricingResponse) GetBandwidthCostPerByte() float64 {
+	if x != nil {
+		return x.BandwidthCostPerByte
+	}
+	return 0
+}
+
+func (x *GetPricingResponse) GetStorageCostPerByteMonth() float64 {
+	if x != nil {
+		return x.StorageCostPerByteMonth
+	}
+	return 0
+}
+
+func (x *GetPricingResponse) GetPricingInfo() string {
+	if x != nil {
+		return x.PricingInfo
 	}
 	return ""
 }
 
-// List DNS Delegation API Keys Request
-type ListDNSDelegationAPIKeysRequest struct {
+// Create DNS Delegation API Key Request
+type CreateDNSDelegationAPIKeyRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Filter by organization ID (optional)
+	Description    string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`                                   // Description of who/what this key is for
+	SourceApi      *string                `protobuf:"bytes,2,opt,name=source_api,json=sourceApi,proto3,oneof" json:"source_api,omitempty"`                // URL of the API that will use this key (optional)
+	OrganizationId *string                `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Organization ID (optional, required for non-superadmins, inferred from user's memberships if not provided)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListDNSDelegationAPIKeysRequest) Reset() {
-	*x = ListDNSDelegationAPIKeysRequest{}
+func (x *CreateDNSDelegationAPIKeyRequest) Reset() {
+	*x = CreateDNSDelegationAPIKeyRequest{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDNSDelegationAPIKeysRequest) String() string {
+func (x *CreateDNSDelegationAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDNSDelegationAPIKeysRequest) ProtoMessage() {}
+func (*CreateDNSDelegationAPIKeyRequest) ProtoMessage() {}
 
-func (x *ListDNSDelegationAPIKeysRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateDNSDelegationAPIKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2062,47 +2128,56 @@ func (x *ListDNSDelegationAPIKeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDNSDelegationAPIKeysRequest.ProtoReflect.Descriptor instead.
-func (*ListDNSDelegationAPIKeysRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateDNSDelegationAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateDNSDelegationAPIKeyRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListDNSDelegationAPIKeysRequest) GetOrganizationId() string {
+func (x *CreateDNSDelegationAPIKeyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateDNSDelegationAPIKeyRequest) GetSourceApi() string {
+	if x != nil && x.SourceApi != nil {
+		return *x.SourceApi
+	}
+	return ""
+}
+
+func (x *CreateDNSDelegationAPIKeyRequest) GetOrganizationId() string {
 	if x != nil && x.OrganizationId != nil {
 		return *x.OrganizationId
 	}
 	return ""
 }
 
-// DNS Delegation API Key Info
-type DNSDelegationAPIKeyInfo struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                   // API key ID
-	Description          string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                                 // Description
-	SourceApi            string                 `protobuf:"bytes,3,opt,name=source_api,json=sourceApi,proto3" json:"source_api,omitempty"`                                    // Source API URL (if set)
-	OrganizationId       string                 `protobuf:"bytes,4,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`                     // Organization ID (if set)
-	IsActive             bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                                      // Whether the key is active
-	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                    // When the key was created
-	RevokedAt            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`                                    // When the key was revoked (if revoked)
-	StripeSubscriptionId string                 `protobuf:"bytes,8,opt,name=stripe_subscription_id,json=stripeSubscriptionId,proto3" json:"stripe_subscription_id,omitempty"` // Stripe subscription ID (if linked to subscription)
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// Create DNS Delegation API Key Response
+type CreateDNSDelegationAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // The generated API key (shown only once)
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`             // Success message
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`     // Description of the API key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DNSDelegationAPIKeyInfo) Reset() {
-	*x = DNSDelegationAPIKeyInfo{}
+func (x *CreateDNSDelegationAPIKeyResponse) Reset() {
+	*x = CreateDNSDelegationAPIKeyResponse{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DNSDelegationAPIKeyInfo) String() string {
+func (x *CreateDNSDelegationAPIKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DNSDelegationAPIKeyInfo) ProtoMessage() {}
+func (*CreateDNSDelegationAPIKeyResponse) ProtoMessage() {}
 
-func (x *DNSDelegationAPIKeyInfo) ProtoReflect() protoreflect.Message {
+func (x *CreateDNSDelegationAPIKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2114,89 +2189,54 @@ func (x *DNSDelegationAPIKeyInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DNSDelegationAPIKeyInfo.ProtoReflect.Descriptor instead.
-func (*DNSDelegationAPIKeyInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateDNSDelegationAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateDNSDelegationAPIKeyResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DNSDelegationAPIKeyInfo) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *DNSDelegationAPIKeyInfo) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *DNSDelegationAPIKeyInfo) GetSourceApi() string {
+func (x *CreateDNSDelegationAPIKeyResponse) GetApiKey() string {
 	if x != nil {
-		return x.SourceApi
+		return x.ApiKey
 	}
 	return ""
 }
 
-func (x *DNSDelegationAPIKeyInfo) GetOrganizationId() string {
+func (x *CreateDNSDelegationAPIKeyResponse) GetMessage() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Message
 	}
 	return ""
 }
 
-func (x *DNSDelegationAPIKeyInfo) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
-}
-
-func (x *DNSDelegationAPIKeyInfo) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *DNSDelegationAPIKeyInfo) GetRevokedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.RevokedAt
-	}
-	return nil
-}
-
-func (x *DNSDelegationAPIKeyInfo) GetStripeSubscriptionId() string {
+func (x *CreateDNSDelegationAPIKeyResponse) GetDescription() string {
 	if x != nil {
-		return x.StripeSubscriptionId
+		return x.Description
 	}
 	return ""
 }
 
-// List DNS Delegation API Keys Response
-type ListDNSDelegationAPIKeysResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	ApiKeys       []*DNSDelegationAPIKeyInfo `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+// Revoke DNS Delegation API Key Request
+type RevokeDNSDelegationAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // The API key to revoke
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDNSDelegationAPIKeysResponse) Reset() {
-	*x = ListDNSDelegationAPIKeysResponse{}
+func (x *RevokeDNSDelegationAPIKeyRequest) Reset() {
+	*x = RevokeDNSDelegationAPIKeyRequest{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDNSDelegationAPIKeysResponse) String() string {
+func (x *RevokeDNSDelegationAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDNSDelegationAPIKeysResponse) ProtoMessage() {}
+func (*RevokeDNSDelegationAPIKeyRequest) ProtoMessage() {}
 
-func (x *ListDNSDelegationAPIKeysResponse) ProtoReflect() protoreflect.Message {
+func (x *RevokeDNSDelegationAPIKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2208,39 +2248,41 @@ func (x *ListDNSDelegationAPIKeysResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDNSDelegationAPIKeysResponse.ProtoReflect.Descriptor instead.
-func (*ListDNSDelegationAPIKeysResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeDNSDelegationAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeDNSDelegationAPIKeyRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListDNSDelegationAPIKeysResponse) GetApiKeys() []*DNSDelegationAPIKeyInfo {
+func (x *RevokeDNSDelegationAPIKeyRequest) GetApiKey() string {
 	if x != nil {
-		return x.ApiKeys
+		return x.ApiKey
 	}
-	return nil
+	return ""
 }
 
-// Get Abuse Detection Request
-type GetAbuseDetectionRequest struct {
+// Revoke DNS Delegation API Key Response
+type RevokeDNSDelegationAPIKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Success message
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAbuseDetectionRequest) Reset() {
-	*x = GetAbuseDetectionRequest{}
+func (x *RevokeDNSDelegationAPIKeyResponse) Reset() {
+	*x = RevokeDNSDelegationAPIKeyResponse{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAbuseDetectionRequest) String() string {
+func (x *RevokeDNSDelegationAPIKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAbuseDetectionRequest) ProtoMessage() {}
+func (*RevokeDNSDelegationAPIKeyResponse) ProtoMessage() {}
 
-func (x *GetAbuseDetectionRequest) ProtoReflect() protoreflect.Message {
+func (x *RevokeDNSDelegationAPIKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2252,35 +2294,47 @@ func (x *GetAbuseDetectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAbuseDetectionRequest.ProtoReflect.Descriptor instead.
-func (*GetAbuseDetectionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeDNSDelegationAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeDNSDelegationAPIKeyResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{32}
 }
 
-// Abuse Detection Response
-type GetAbuseDetectionResponse struct {
-	state                   protoimpl.MessageState    `protogen:"open.v1"`
-	SuspiciousOrganizations []*SuspiciousOrganization `protobuf:"bytes,1,rep,name=suspicious_organizations,json=suspiciousOrganizations,proto3" json:"suspicious_organizations,omitempty"`
-	SuspiciousActivities    []*SuspiciousActivity     `protobuf:"bytes,2,rep,name=suspicious_activities,json=suspiciousActivities,proto3" json:"suspicious_activities,omitempty"`
-	Metrics                 *AbuseMetrics             `protobuf:"bytes,3,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *RevokeDNSDelegationAPIKeyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
 }
 
-func (x *GetAbuseDetectionResponse) Reset() {
-	*x = GetAbuseDetectionResponse{}
+func (x *RevokeDNSDelegationAPIKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Revoke DNS Delegation API Key For Organization Request
+type RevokeDNSDelegationAPIKeyForOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization ID whose API key to revoke
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RevokeDNSDelegationAPIKeyForOrganizationRequest) Reset() {
+	*x = RevokeDNSDelegationAPIKeyForOrganizationRequest{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAbuseDetectionResponse) String() string {
+func (x *RevokeDNSDelegationAPIKeyForOrganizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAbuseDetectionResponse) ProtoMessage() {}
+func (*RevokeDNSDelegationAPIKeyForOrganizationRequest) ProtoMessage() {}
 
-func (x *GetAbuseDetectionResponse) ProtoReflect() protoreflect.Message {
+func (x *RevokeDNSDelegationAPIKeyForOrganizationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2292,62 +2346,41 @@ func (x *GetAbuseDetectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAbuseDetectionResponse.ProtoReflect.Descriptor instead.
-func (*GetAbuseDetectionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeDNSDelegationAPIKeyForOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeDNSDelegationAPIKeyForOrganizationRequest) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetAbuseDetectionResponse) GetSuspiciousOrganizations() []*SuspiciousOrganization {
-	if x != nil {
-		return x.SuspiciousOrganizations
-	}
-	return nil
-}
-
-func (x *GetAbuseDetectionResponse) GetSuspiciousActivities() []*SuspiciousActivity {
-	if x != nil {
-		return x.SuspiciousActivities
-	}
-	return nil
-}
-
-func (x *GetAbuseDetectionResponse) GetMetrics() *AbuseMetrics {
+func (x *RevokeDNSDelegationAPIKeyForOrganizationRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Metrics
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-// Suspicious Organization
-type SuspiciousOrganization struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId        string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	OrganizationName      string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
-	Reason                string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                                            // Why it's flagged
-	RiskScore             int64                  `protobuf:"varint,4,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`                                    // 0-100, higher is more suspicious
-	CreatedCount_24H      int64                  `protobuf:"varint,5,opt,name=created_count_24h,json=createdCount24h,proto3" json:"created_count_24h,omitempty"`                // Resources created in last 24h
-	FailedDeployments_24H int64                  `protobuf:"varint,6,opt,name=failed_deployments_24h,json=failedDeployments24h,proto3" json:"failed_deployments_24h,omitempty"` // Failed deployments in last 24h
-	TotalCreditsSpent     int64                  `protobuf:"varint,7,opt,name=total_credits_spent,json=totalCreditsSpent,proto3" json:"total_credits_spent,omitempty"`          // Total credits spent
-	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	LastActivity          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+// Revoke DNS Delegation API Key For Organization Response
+type RevokeDNSDelegationAPIKeyForOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Success message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuspiciousOrganization) Reset() {
-	*x = SuspiciousOrganization{}
+func (x *RevokeDNSDelegationAPIKeyForOrganizationResponse) Reset() {
+	*x = RevokeDNSDelegationAPIKeyForOrganizationResponse{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuspiciousOrganization) String() string {
+func (x *RevokeDNSDelegationAPIKeyForOrganizationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspiciousOrganization) ProtoMessage() {}
+func (*RevokeDNSDelegationAPIKeyForOrganizationResponse) ProtoMessage() {}
 
-func (x *SuspiciousOrganization) ProtoReflect() protoreflect.Message {
+func (x *RevokeDNSDelegationAPIKeyForOrganizationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2359,103 +2392,100 @@ func (x *SuspiciousOrganization) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspiciousOrganization.ProtoReflect.Descriptor instead.
-func (*SuspiciousOrganization) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeDNSDelegationAPIKeyForOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeDNSDelegationAPIKeyForOrganizationResponse) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SuspiciousOrganization) GetOrganizationId() string {
+func (x *RevokeDNSDelegationAPIKeyForOrganizationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.OrganizationId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *SuspiciousOrganization) GetOrganizationName() string {
+func (x *RevokeDNSDelegationAPIKeyForOrganizationResponse) GetMessage() string {
 	if x != nil {
-		return x.OrganizationName
+		return x.Message
 	}
 	return ""
 }
 
-func (x *SuspiciousOrganization) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
+// List DNS Delegation API Keys Request
+type ListDNSDelegationAPIKeysRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Filter by organization ID (optional)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SuspiciousOrganization) GetRiskScore() int64 {
-	if x != nil {
-		return x.RiskScore
-	}
-	return 0
+func (x *ListDNSDelegationAPIKeysRequest) Reset() {
+	*x = ListDNSDelegationAPIKeysRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuspiciousOrganization) GetCreatedCount_24H() int64 {
-	if x != nil {
-		return x.CreatedCount_24H
-	}
-	return 0
+func (x *ListDNSDelegationAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SuspiciousOrganization) GetFailedDeployments_24H() int64 {
-	if x != nil {
-		return x.FailedDeployments_24H
-	}
-	return 0
-}
+func (*ListDNSDelegationAPIKeysRequest) ProtoMessage() {}
 
-func (x *SuspiciousOrganization) GetTotalCreditsSpent() int64 {
+func (x *ListDNSDelegationAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[35]
 	if x != nil {
-		return x.TotalCreditsSpent
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SuspiciousOrganization) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use ListDNSDelegationAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListDNSDelegationAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SuspiciousOrganization) GetLastActivity() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastActivity
+func (x *ListDNSDelegationAPIKeysRequest) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-// Suspicious Activity
-type SuspiciousActivity struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrganizationId   string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	OrganizationName string                 `protobuf:"bytes,7,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"` // Organization name for display
-	ActivityType     string                 `protobuf:"bytes,3,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`             // "rapid_creation", "failed_payments", "unusual_usage", etc.
-	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Severity         int64                  `protobuf:"varint,5,opt,name=severity,proto3" json:"severity,omitempty"` // 0-100
-	OccurredAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// DNS Delegation API Key Info
+type DNSDelegationAPIKeyInfo struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                   // API key ID
+	Description          string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                                 // Description
+	SourceApi            string                 `protobuf:"bytes,3,opt,name=source_api,json=sourceApi,proto3" json:"source_api,omitempty"`                                    // Source API URL (if set)
+	OrganizationId       string                 `protobuf:"bytes,4,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`                     // Organization ID (if set)
+	IsActive             bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                                      // Whether the key is active
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                    // When the key was created
+	RevokedAt            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`                                    // When the key was revoked (if revoked)
+	StripeSubscriptionId string                 `protobuf:"bytes,8,opt,name=stripe_subscription_id,json=stripeSubscriptionId,proto3" json:"stripe_subscription_id,omitempty"` // Stripe subscription ID (if linked to subscription)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *SuspiciousActivity) Reset() {
-	*x = SuspiciousActivity{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[35]
+func (x *DNSDelegationAPIKeyInfo) Reset() {
+	*x = DNSDelegationAPIKeyInfo{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuspiciousActivity) String() string {
+func (x *DNSDelegationAPIKeyInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspiciousActivity) ProtoMessage() {}
+func (*DNSDelegationAPIKeyInfo) ProtoMessage() {}
 
-func (x *SuspiciousActivity) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[35]
+func (x *DNSDelegationAPIKeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2466,87 +2496,90 @@ func (x *SuspiciousActivity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspiciousActivity.ProtoReflect.Descriptor instead.
-func (*SuspiciousActivity) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use DNSDelegationAPIKeyInfo.ProtoReflect.Descriptor instead.
+func (*DNSDelegationAPIKeyInfo) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SuspiciousActivity) GetId() string {
+func (x *DNSDelegationAPIKeyInfo) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *SuspiciousActivity) GetOrganizationId() string {
+func (x *DNSDelegationAPIKeyInfo) GetDescription() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Description
 	}
 	return ""
 }
 
-func (x *SuspiciousActivity) GetOrganizationName() string {
+func (x *DNSDelegationAPIKeyInfo) GetSourceApi() string {
 	if x != nil {
-		return x.OrganizationName
+		return x.SourceApi
 	}
 	return ""
 }
 
-func (x *SuspiciousActivity) GetActivityType() string {
+func (x *DNSDelegationAPIKeyInfo) GetOrganizationId() string {
 	if x != nil {
-		return x.ActivityType
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *SuspiciousActivity) GetDescription() string {
+func (x *DNSDelegationAPIKeyInfo) GetIsActive() bool {
 	if x != nil {
-		return x.Description
+		return x.IsActive
 	}
-	return ""
+	return false
 }
 
-func (x *SuspiciousActivity) GetSeverity() int64 {
+func (x *DNSDelegationAPIKeyInfo) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Severity
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *SuspiciousActivity) GetOccurredAt() *timestamppb.Timestamp {
+func (x *DNSDelegationAPIKeyInfo) GetRevokedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OccurredAt
+		return x.RevokedAt
 	}
 	return nil
 }
 
-// Abuse Metrics
-type AbuseMetrics struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	TotalSuspiciousOrgs       int64                  `protobuf:"varint,1,opt,name=total_suspicious_orgs,json=totalSuspiciousOrgs,proto3" json:"total_suspicious_orgs,omitempty"`
-	HighRiskOrgs              int64                  `protobuf:"varint,2,opt,name=high_risk_orgs,json=highRiskOrgs,proto3" json:"high_risk_orgs,omitempty"`                // Risk score > 70
-	RapidCreations_24H        int64                  `protobuf:"varint,3,opt,name=rapid_creations_24h,json=rapidCreations24h,proto3" json:"rapid_creations_24h,omitempty"` // Organizations with >10 resources created in 24h
-	FailedPaymentAttempts_24H int64                  `protobuf:"varint,4,opt,name=failed_payment_attempts_24h,json=failedPaymentAttempts24h,proto3" json:"failed_payment_attempts_24h,omitempty"`
-	UnusualUsageSpikes_24H    int64                  `protobuf:"varint,5,opt,name=unusual_usage_spikes_24h,json=unusualUsageSpikes24h,proto3" json:"unusual_usage_spikes_24h,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
-}
-
-func (x *AbuseMetrics) Reset() {
-	*x = AbuseMetrics{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DNSDelegationAPIKeyInfo) GetStripeSubscriptionId() string {
+	if x != nil {
+		return x.StripeSubscriptionId
+	}
+	return ""
 }
 
-func (x *AbuseMetrics) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+// List DNS Delegation API Keys Response
+type ListDNSDelegationAPIKeysResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	ApiKeys       []*DNSDelegationAPIKeyInfo `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func (*AbuseMetrics) ProtoMessage() {}
+func (x *ListDNSDelegationAPIKeysResponse) Reset() {
+	*x = ListDNSDelegationAPIKeysResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func (x *AbuseMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[36]
+func (x *ListDNSDelegationAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDNSDelegationAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListDNSDelegationAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2557,70 +2590,40 @@ func (x *AbuseMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbuseMetrics.ProtoReflect.Descriptor instead.
-func (*AbuseMetrics) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *AbuseMetrics) GetTotalSuspiciousOrgs() int64 {
-	if x != nil {
-		return x.TotalSuspiciousOrgs
-	}
-	return 0
-}
-
-func (x *AbuseMetrics) GetHighRiskOrgs() int64 {
-	if x != nil {
-		return x.HighRiskOrgs
-	}
-	return 0
-}
-
-func (x *AbuseMetrics) GetRapidCreations_24H() int64 {
-	if x != nil {
-		return x.RapidCreations_24H
-	}
-	return 0
-}
-
-func (x *AbuseMetrics) GetFailedPaymentAttempts_24H() int64 {
-	if x != nil {
-		return x.FailedPaymentAttempts_24H
-	}
-	return 0
+// Deprecated: Use ListDNSDelegationAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListDNSDelegationAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AbuseMetrics) GetUnusualUsageSpikes_24H() int64 {
+func (x *ListDNSDelegationAPIKeysResponse) GetApiKeys() []*DNSDelegationAPIKeyInfo {
 	if x != nil {
-		return x.UnusualUsageSpikes_24H
+		return x.ApiKeys
 	}
-	return 0
+	return nil
 }
 
-// Get Income Overview Request
-type GetIncomeOverviewRequest struct {
+// Get Abuse Detection Request
+type GetAbuseDetectionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     *string                `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // ISO 8601 date string (optional, defaults to 30 days ago)
-	EndDate       *string                `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // ISO 8601 date string (optional, defaults to now)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIncomeOverviewRequest) Reset() {
-	*x = GetIncomeOverviewRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[37]
+func (x *GetAbuseDetectionRequest) Reset() {
+	*x = GetAbuseDetectionRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIncomeOverviewRequest) String() string {
+func (x *GetAbuseDetectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIncomeOverviewRequest) ProtoMessage() {}
+func (*GetAbuseDetectionRequest) ProtoMessage() {}
 
-func (x *GetIncomeOverviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[37]
+func (x *GetAbuseDetectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2631,52 +2634,36 @@ func (x *GetIncomeOverviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIncomeOverviewRequest.ProtoReflect.Descriptor instead.
-func (*GetIncomeOverviewRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *GetIncomeOverviewRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
-	}
-	return ""
-}
-
-func (x *GetIncomeOverviewRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
-	}
-	return ""
+// Deprecated: Use GetAbuseDetectionRequest.ProtoReflect.Descriptor instead.
+func (*GetAbuseDetectionRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{38}
 }
 
-// Income Overview Response
-type GetIncomeOverviewResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Summary        *IncomeSummary         `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
-	MonthlyIncome  []*MonthlyIncome       `protobuf:"bytes,2,rep,name=monthly_income,json=monthlyIncome,proto3" json:"monthly_income,omitempty"`
-	TopCustomers   []*TopCustomer         `protobuf:"bytes,3,rep,name=top_customers,json=topCustomers,proto3" json:"top_customers,omitempty"`
-	Transactions   []*BillingTransaction  `protobuf:"bytes,4,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	PaymentMetrics *PaymentMetrics        `protobuf:"bytes,5,opt,name=payment_metrics,json=paymentMetrics,proto3" json:"payment_metrics,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Abuse Detection Response
+type GetAbuseDetectionResponse struct {
+	state                   protoimpl.MessageState    `protogen:"open.v1"`
+	SuspiciousOrganizations []*SuspiciousOrganization `protobuf:"bytes,1,rep,name=suspicious_organizations,json=suspiciousOrganizations,proto3" json:"suspicious_organizations,omitempty"`
+	SuspiciousActivities    []*SuspiciousActivity     `protobuf:"bytes,2,rep,name=suspicious_activities,json=suspiciousActivities,proto3" json:"suspicious_activities,omitempty"`
+	Metrics                 *AbuseMetrics             `protobuf:"bytes,3,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *GetIncomeOverviewResponse) Reset() {
-	*x = GetIncomeOverviewResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[38]
+func (x *GetAbuseDetectionResponse) Reset() {
+	*x = GetAbuseDetectionResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIncomeOverviewResponse) String() string {
+func (x *GetAbuseDetectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIncomeOverviewResponse) ProtoMessage() {}
+func (*GetAbuseDetectionResponse) ProtoMessage() {}
 
-func (x *GetIncomeOverviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[38]
+func (x *GetAbuseDetectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2687,75 +2674,63 @@ func (x *GetIncomeOverviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIncomeOverviewResponse.ProtoReflect.Descriptor instead.
-func (*GetIncomeOverviewResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *GetIncomeOverviewResponse) GetSummary() *IncomeSummary {
-	if x != nil {
-		return x.Summary
-	}
-	return nil
-}
-
-func (x *GetIncomeOverviewResponse) GetMonthlyIncome() []*MonthlyIncome {
-	if x != nil {
-		return x.MonthlyIncome
-	}
-	return nil
+// Deprecated: Use GetAbuseDetectionResponse.ProtoReflect.Descriptor instead.
+func (*GetAbuseDetectionResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetIncomeOverviewResponse) GetTopCustomers() []*TopCustomer {
+func (x *GetAbuseDetectionResponse) GetSuspiciousOrganizations() []*SuspiciousOrganization {
 	if x != nil {
-		return x.TopCustomers
+		return x.SuspiciousOrganizations
 	}
 	return nil
 }
 
-func (x *GetIncomeOverviewResponse) GetTransactions() []*BillingTransaction {
+func (x *GetAbuseDetectionResponse) GetSuspiciousActivities() []*SuspiciousActivity {
 	if x != nil {
-		return x.Transactions
+		return x.SuspiciousActivities
 	}
 	return nil
 }
 
-func (x *GetIncomeOverviewResponse) GetPaymentMetrics() *PaymentMetrics {
+func (x *GetAbuseDetectionResponse) GetMetrics() *AbuseMetrics {
 	if x != nil {
-		return x.PaymentMetrics
+		return x.Metrics
 	}
 	return nil
 }
 
-// Income Summary
-type IncomeSummary struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	TotalRevenue            float64                `protobuf:"fixed64,1,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`                                    // Total revenue in period
-	MonthlyRecurringRevenue float64                `protobuf:"fixed64,2,opt,name=monthly_recurring_revenue,json=monthlyRecurringRevenue,proto3" json:"monthly_recurring_revenue,omitempty"` // Estimated MRR
-	AverageMonthlyRevenue   float64                `protobuf:"fixed64,3,opt,name=average_monthly_revenue,json=averageMonthlyRevenue,proto3" json:"average_monthly_revenue,omitempty"`       // Average monthly revenue
-	TotalTransactions       int64                  `protobuf:"varint,4,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
-	TotalRefunds            float64                `protobuf:"fixed64,5,opt,name=total_refunds,json=totalRefunds,proto3" json:"total_refunds,omitempty"`
-	NetRevenue              float64                `protobuf:"fixed64,6,opt,name=net_revenue,json=netRevenue,proto3" json:"net_revenue,omitempty"`                                       // Revenue minus refunds
-	EstimatedMonthlyIncome  float64                `protobuf:"fixed64,7,opt,name=estimated_monthly_income,json=estimatedMonthlyIncome,proto3" json:"estimated_monthly_income,omitempty"` // Estimated monthly income from all orgs based on usage
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+// Suspicious Organization
+type SuspiciousOrganization struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId        string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName      string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	Reason                string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                                            // Why it's flagged
+	RiskScore             int64                  `protobuf:"varint,4,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`                                    // 0-100, higher is more suspicious
+	CreatedCount_24H      int64                  `protobuf:"varint,5,opt,name=created_count_24h,json=createdCount24h,proto3" json:"created_count_24h,omitempty"`                // Resources created in last 24h
+	FailedDeployments_24H int64                  `protobuf:"varint,6,opt,name=failed_deployments_24h,json=failedDeployments24h,proto3" json:"failed_deployments_24h,omitempty"` // Failed deployments in last 24h
+	TotalCreditsSpent     int64                  `protobuf:"varint,7,opt,name=total_credits_spent,json=totalCreditsSpent,proto3" json:"total_credits_spent,omitempty"`          // Total credits spent
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastActivity          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *IncomeSummary) Reset() {
-	*x = IncomeSummary{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[39]
+func (x *SuspiciousOrganization) Reset() {
+	*x = SuspiciousOrganization{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IncomeSummary) String() string {
+func (x *SuspiciousOrganization) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncomeSummary) ProtoMessage() {}
+func (*SuspiciousOrganization) ProtoMessage() {}
 
-func (x *IncomeSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[39]
+func (x *SuspiciousOrganization) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2766,156 +2741,102 @@ func (x *IncomeSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncomeSummary.ProtoReflect.Descriptor instead.
-func (*IncomeSummary) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use SuspiciousOrganization.ProtoReflect.Descriptor instead.
+func (*SuspiciousOrganization) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *IncomeSummary) GetTotalRevenue() float64 {
+func (x *SuspiciousOrganization) GetOrganizationId() string {
 	if x != nil {
-		return x.TotalRevenue
+		return x.OrganizationId
 	}
-	return 0
+	return ""
 }
 
-func (x *IncomeSummary) GetMonthlyRecurringRevenue() float64 {
+func (x *SuspiciousOrganization) GetOrganizationName() string {
 	if x != nil {
-		return x.MonthlyRecurringRevenue
+		return x.OrganizationName
 	}
-	return 0
+	return ""
 }
 
-func (x *IncomeSummary) GetAverageMonthlyRevenue() float64 {
+func (x *SuspiciousOrganization) GetReason() string {
 	if x != nil {
-		return x.AverageMonthlyRevenue
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *IncomeSummary) GetTotalTransactions() int64 {
+func (x *SuspiciousOrganization) GetRiskScore() int64 {
 	if x != nil {
-		return x.TotalTransactions
+		return x.RiskScore
 	}
 	return 0
 }
 
-func (x *IncomeSummary) GetTotalRefunds() float64 {
+func (x *SuspiciousOrganization) GetCreatedCount_24H() int64 {
 	if x != nil {
-		return x.TotalRefunds
+		return x.CreatedCount_24H
 	}
 	return 0
 }
 
-func (x *IncomeSummary) GetNetRevenue() float64 {
+func (x *SuspiciousOrganization) GetFailedDeployments_24H() int64 {
 	if x != nil {
-		return x.NetRevenue
+		return x.FailedDeployments_24H
 	}
 	return 0
 }
 
-func (x *IncomeSummary) GetEstimatedMonthlyIncome() float64 {
+func (x *SuspiciousOrganization) GetTotalCreditsSpent() int64 {
 	if x != nil {
-		return x.EstimatedMonthlyIncome
+		return x.TotalCreditsSpent
 	}
 	return 0
 }
 
-// Monthly Income
-type MonthlyIncome struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Month            string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM format
-	Revenue          float64                `protobuf:"fixed64,2,opt,name=revenue,proto3" json:"revenue,omitempty"`
-	TransactionCount int64                  `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
-	Refunds          float64                `protobuf:"fixed64,4,opt,name=refunds,proto3" json:"refunds,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *MonthlyIncome) Reset() {
-	*x = MonthlyIncome{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MonthlyIncome) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MonthlyIncome) ProtoMessage() {}
-
-func (x *MonthlyIncome) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[40]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MonthlyIncome.ProtoReflect.Descriptor instead.
-func (*MonthlyIncome) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *MonthlyIncome) GetMonth() string {
-	if x != nil {
-		return x.Month
-	}
-	return ""
-}
-
-func (x *MonthlyIncome) GetRevenue() float64 {
-	if x != nil {
-		return x.Revenue
-	}
-	return 0
-}
-
-func (x *MonthlyIncome) GetTransactionCount() int64 {
+func (x *SuspiciousOrganization) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TransactionCount
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *MonthlyIncome) GetRefunds() float64 {
+func (x *SuspiciousOrganization) GetLastActivity() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Refunds
+		return x.LastActivity
 	}
-	return 0
+	return nil
 }
 
-// Top Customer
-type TopCustomer struct {
+// Suspicious Activity
+type SuspiciousActivity struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
-	TotalRevenue     float64                `protobuf:"fixed64,3,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
-	TransactionCount int64                  `protobuf:"varint,4,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
-	FirstPayment     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=first_payment,json=firstPayment,proto3" json:"first_payment,omitempty"`
-	LastPayment      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_payment,json=lastPayment,proto3" json:"last_payment,omitempty"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId   string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,7,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"` // Organization name for display
+	ActivityType     string                 `protobuf:"bytes,3,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`             // "rapid_creation", "failed_payments", "unusual_usage", etc.
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Severity         int64                  `protobuf:"varint,5,opt,name=severity,proto3" json:"severity,omitempty"` // 0-100
+	OccurredAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *TopCustomer) Reset() {
-	*x = TopCustomer{}
+func (x *SuspiciousActivity) Reset() {
+	*x = SuspiciousActivity{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopCustomer) String() string {
+func (x *SuspiciousActivity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopCustomer) ProtoMessage() {}
+func (*SuspiciousActivity) ProtoMessage() {}
 
-func (x *TopCustomer) ProtoReflect() protoreflect.Message {
+func (x *SuspiciousActivity) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2927,85 +2848,86 @@ func (x *TopCustomer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopCustomer.ProtoReflect.Descriptor instead.
-func (*TopCustomer) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuspiciousActivity.ProtoReflect.Descriptor instead.
+func (*SuspiciousActivity) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *TopCustomer) GetOrganizationId() string {
+func (x *SuspiciousActivity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SuspiciousActivity) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *TopCustomer) GetOrganizationName() string {
+func (x *SuspiciousActivity) GetOrganizationName() string {
 	if x != nil {
 		return x.OrganizationName
 	}
 	return ""
 }
 
-func (x *TopCustomer) GetTotalRevenue() float64 {
+func (x *SuspiciousActivity) GetActivityType() string {
 	if x != nil {
-		return x.TotalRevenue
+		return x.ActivityType
 	}
-	return 0
+	return ""
 }
 
-func (x *TopCustomer) GetTransactionCount() int64 {
+func (x *SuspiciousActivity) GetDescription() string {
 	if x != nil {
-		return x.TransactionCount
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *TopCustomer) GetFirstPayment() *timestamppb.Timestamp {
+func (x *SuspiciousActivity) GetSeverity() int64 {
 	if x != nil {
-		return x.FirstPayment
+		return x.Severity
 	}
-	return nil
+	return 0
 }
 
-func (x *TopCustomer) GetLastPayment() *timestamppb.Timestamp {
+func (x *SuspiciousActivity) GetOccurredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastPayment
+		return x.OccurredAt
 	}
 	return nil
 }
 
-// Billing Transaction
-type BillingTransaction struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrganizationId        string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	OrganizationName      string                 `protobuf:"bytes,3,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
-	Type                  string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // "payment", "refund", "credit_add", "credit_remove"
-	AmountCents           float64                `protobuf:"fixed64,5,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
-	Currency              string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
-	Status                string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // "succeeded", "failed", "pending"
-	StripeInvoiceId       *string                `protobuf:"bytes,8,opt,name=stripe_invoice_id,json=stripeInvoiceId,proto3,oneof" json:"stripe_invoice_id,omitempty"`
-	StripePaymentIntentId *string                `protobuf:"bytes,9,opt,name=stripe_payment_intent_id,json=stripePaymentIntentId,proto3,oneof" json:"stripe_payment_intent_id,omitempty"`
-	Note                  *string                `protobuf:"bytes,10,opt,name=note,proto3,oneof" json:"note,omitempty"`
-	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+// Abuse Metrics
+type AbuseMetrics struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	TotalSuspiciousOrgs       int64                  `protobuf:"varint,1,opt,name=total_suspicious_orgs,json=totalSuspiciousOrgs,proto3" json:"total_suspicious_orgs,omitempty"`
+	HighRiskOrgs              int64                  `protobuf:"varint,2,opt,name=high_risk_orgs,json=highRiskOrgs,proto3" json:"high_risk_orgs,omitempty"`                // Risk score > 70
+	RapidCreations_24H        int64                  `protobuf:"varint,3,opt,name=rapid_creations_24h,json=rapidCreations24h,proto3" json:"rapid_creations_24h,omitempty"` // Organizations with >10 resources created in 24h
+	FailedPaymentAttempts_24H int64                  `protobuf:"varint,4,opt,name=failed_payment_attempts_24h,json=failedPaymentAttempts24h,proto3" json:"failed_payment_attempts_24h,omitempty"`
+	UnusualUsageSpikes_24H    int64                  `protobuf:"varint,5,opt,name=unusual_usage_spikes_24h,json=unusualUsageSpikes24h,proto3" json:"unusual_usage_spikes_24h,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *BillingTransaction) Reset() {
-	*x = BillingTransaction{}
+func (x *AbuseMetrics) Reset() {
+	*x = AbuseMetrics{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BillingTransaction) String() string {
+func (x *AbuseMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BillingTransaction) ProtoMessage() {}
+func (*AbuseMetrics) ProtoMessage() {}
 
-func (x *BillingTransaction) ProtoReflect() protoreflect.Message {
+func (x *AbuseMetrics) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3017,116 +2939,126 @@ func (x *BillingTransaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BillingTransaction.ProtoReflect.Descriptor instead.
-func (*BillingTransaction) Descriptor() ([]byte, []int) {
+// Deprecated: Use AbuseMetrics.ProtoReflect.Descriptor instead.
+func (*AbuseMetrics) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *BillingTransaction) GetId() string {
+func (x *AbuseMetrics) GetTotalSuspiciousOrgs() int64 {
 	if x != nil {
-		return x.Id
+		return x.TotalSuspiciousOrgs
 	}
-	return ""
+	return 0
 }
 
-func (x *BillingTransaction) GetOrganizationId() string {
+func (x *AbuseMetrics) GetHighRiskOrgs() int64 {
 	if x != nil {
-		return x.OrganizationId
+		return x.HighRiskOrgs
 	}
-	return ""
+	return 0
 }
 
-func (x *BillingTransaction) GetOrganizationName() string {
+func (x *AbuseMetrics) GetRapidCreations_24H() int64 {
 	if x != nil {
-		return x.OrganizationName
+		return x.RapidCreations_24H
 	}
-	return ""
+	return 0
 }
 
-func (x *BillingTransaction) GetType() string {
+func (x *AbuseMetrics) GetFailedPaymentAttempts_24H() int64 {
 	if x != nil {
-		return x.Type
+		return x.FailedPaymentAttempts_24H
 	}
-	return ""
+	return 0
 }
 
-func (x *BillingTransaction) GetAmountCents() float64 {
+func (x *AbuseMetrics) GetUnusualUsageSpikes_24H() int64 {
 	if x != nil {
-		return x.AmountCents
+		return x.UnusualUsageSpikes_24H
 	}
 	return 0
 }
 
-func (x *BillingTransaction) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
+// Get Income Overview Request
+type GetIncomeOverviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *string                `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // ISO 8601 date string (optional, defaults to 30 days ago)
+	EndDate       *string                `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // ISO 8601 date string (optional, defaults to now)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BillingTransaction) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *GetIncomeOverviewRequest) Reset() {
+	*x = GetIncomeOverviewRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *BillingTransaction) GetStripeInvoiceId() string {
-	if x != nil && x.StripeInvoiceId != nil {
-		return *x.StripeInvoiceId
-	}
-	return ""
+func (x *GetIncomeOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BillingTransaction) GetStripePaymentIntentId() string {
-	if x != nil && x.StripePaymentIntentId != nil {
-		return *x.StripePaymentIntentId
+func (*GetIncomeOverviewRequest) ProtoMessage() {}
+
+func (x *GetIncomeOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *BillingTransaction) GetNote() string {
-	if x != nil && x.Note != nil {
-		return *x.Note
+// Deprecated: Use GetIncomeOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetIncomeOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetIncomeOverviewRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
 	}
 	return ""
 }
 
-func (x *BillingTransaction) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (x *GetIncomeOverviewRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
 	}
-	return nil
+	return ""
 }
 
-// Payment Metrics
-type PaymentMetrics struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	SuccessRate          float64                `protobuf:"fixed64,1,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"` // Percentage of successful payments
-	SuccessfulPayments   int64                  `protobuf:"varint,2,opt,name=successful_payments,json=successfulPayments,proto3" json:"successful_payments,omitempty"`
-	FailedPayments       int64                  `protobuf:"varint,3,opt,name=failed_payments,json=failedPayments,proto3" json:"failed_payments,omitempty"`
-	PendingPayments      int64                  `protobuf:"varint,4,opt,name=pending_payments,json=pendingPayments,proto3" json:"pending_payments,omitempty"`
-	AveragePaymentAmount float64                `protobuf:"fixed64,5,opt,name=average_payment_amount,json=averagePaymentAmount,proto3" json:"average_payment_amount,omitempty"`
-	LargestPayment       float64                `protobuf:"fixed64,6,opt,name=largest_payment,json=largestPayment,proto3" json:"largest_payment,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// Income Overview Response
+type GetIncomeOverviewResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Summary        *IncomeSummary         `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	MonthlyIncome  []*MonthlyIncome       `protobuf:"bytes,2,rep,name=monthly_income,json=monthlyIncome,proto3" json:"monthly_income,omitempty"`
+	TopCustomers   []*TopCustomer         `protobuf:"bytes,3,rep,name=top_customers,json=topCustomers,proto3" json:"top_customers,omitempty"`
+	Transactions   []*BillingTransaction  `protobuf:"bytes,4,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	PaymentMetrics *PaymentMetrics        `protobuf:"bytes,5,opt,name=payment_metrics,json=paymentMetrics,proto3" json:"payment_metrics,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *PaymentMetrics) Reset() {
-	*x = PaymentMetrics{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[43]
+func (x *GetIncomeOverviewResponse) Reset() {
+	*x = GetIncomeOverviewResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentMetrics) String() string {
+func (x *GetIncomeOverviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentMetrics) ProtoMessage() {}
+func (*GetIncomeOverviewResponse) ProtoMessage() {}
 
-func (x *PaymentMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[43]
+func (x *GetIncomeOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3137,80 +3069,75 @@ func (x *PaymentMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentMetrics.ProtoReflect.Descriptor instead.
-func (*PaymentMetrics) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *PaymentMetrics) GetSuccessRate() float64 {
-	if x != nil {
-		return x.SuccessRate
-	}
-	return 0
+// Deprecated: Use GetIncomeOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GetIncomeOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *PaymentMetrics) GetSuccessfulPayments() int64 {
+func (x *GetIncomeOverviewResponse) GetSummary() *IncomeSummary {
 	if x != nil {
-		return x.SuccessfulPayments
+		return x.Summary
 	}
-	return 0
+	return nil
 }
 
-func (x *PaymentMetrics) GetFailedPayments() int64 {
+func (x *GetIncomeOverviewResponse) GetMonthlyIncome() []*MonthlyIncome {
 	if x != nil {
-		return x.FailedPayments
+		return x.MonthlyIncome
 	}
-	return 0
+	return nil
 }
 
-func (x *PaymentMetrics) GetPendingPayments() int64 {
+func (x *GetIncomeOverviewResponse) GetTopCustomers() []*TopCustomer {
 	if x != nil {
-		return x.PendingPayments
+		return x.TopCustomers
 	}
-	return 0
+	return nil
 }
 
-func (x *PaymentMetrics) GetAveragePaymentAmount() float64 {
+func (x *GetIncomeOverviewResponse) GetTransactions() []*BillingTransaction {
 	if x != nil {
-		return x.AveragePaymentAmount
+		return x.Transactions
 	}
-	return 0
+	return nil
 }
 
-func (x *PaymentMetrics) GetLargestPayment() float64 {
+func (x *GetIncomeOverviewResponse) GetPaymentMetrics() *PaymentMetrics {
 	if x != nil {
-		return x.LargestPayment
+		return x.PaymentMetrics
 	}
-	return 0
+	return nil
 }
 
-// List All Invoices Request
-type ListAllInvoicesRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Filter by organization ID (optional)
-	Status         *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`                                       // Filter by status: "draft", "open", "paid", "uncollectible", "void" (optional)
-	Limit          *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                                        // Limit number of results (default: 50, max: 500)
-	StartDate      *string                `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`                // ISO 8601 date string (optional)
-	EndDate        *string                `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`                      // ISO 8601 date string (optional)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Income Summary
+type IncomeSummary struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	TotalRevenue            float64                `protobuf:"fixed64,1,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`                                    // Total revenue in period
+	MonthlyRecurringRevenue float64                `protobuf:"fixed64,2,opt,name=monthly_recurring_revenue,json=monthlyRecurringRevenue,proto3" json:"monthly_recurring_revenue,omitempty"` // Estimated MRR
+	AverageMonthlyRevenue   float64                `protobuf:"fixed64,3,opt,name=average_monthly_revenue,json=averageMonthlyRevenue,proto3" json:"average_monthly_revenue,omitempty"`       // Average monthly revenue
+	TotalTransactions       int64                  `protobuf:"varint,4,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	TotalRefunds            float64                `protobuf:"fixed64,5,opt,name=total_refunds,json=totalRefunds,proto3" json:"total_refunds,omitempty"`
+	NetRevenue              float64                `protobuf:"fixed64,6,opt,name=net_revenue,json=netRevenue,proto3" json:"net_revenue,omitempty"`                                       // Revenue minus refunds
+	EstimatedMonthlyIncome  float64                `protobuf:"fixed64,7,opt,name=estimated_monthly_income,json=estimatedMonthlyIncome,proto3" json:"estimated_monthly_income,omitempty"` // Estimated monthly income from all orgs based on usage
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *ListAllInvoicesRequest) Reset() {
-	*x = ListAllInvoicesRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[44]
+func (x *IncomeSummary) Reset() {
+	*x = IncomeSummary{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllInvoicesRequest) String() string {
+func (x *IncomeSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllInvoicesRequest) ProtoMessage() {}
+func (*IncomeSummary) ProtoMessage() {}
 
-func (x *ListAllInvoicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[44]
+func (x *IncomeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3221,132 +3148,85 @@ func (x *ListAllInvoicesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllInvoicesRequest.ProtoReflect.Descriptor instead.
-func (*ListAllInvoicesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *ListAllInvoicesRequest) GetOrganizationId() string {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
-	}
-	return ""
-}
-
-func (x *ListAllInvoicesRequest) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return ""
+// Deprecated: Use IncomeSummary.ProtoReflect.Descriptor instead.
+func (*IncomeSummary) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListAllInvoicesRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *IncomeSummary) GetTotalRevenue() float64 {
+	if x != nil {
+		return x.TotalRevenue
 	}
 	return 0
 }
 
-func (x *ListAllInvoicesRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
+func (x *IncomeSummary) GetMonthlyRecurringRevenue() float64 {
+	if x != nil {
+		return x.MonthlyRecurringRevenue
 	}
-	return ""
+	return 0
 }
 
-func (x *ListAllInvoicesRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
+func (x *IncomeSummary) GetAverageMonthlyRevenue() float64 {
+	if x != nil {
+		return x.AverageMonthlyRevenue
 	}
-	return ""
-}
-
-// List All Invoices Response
-type ListAllInvoicesResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Invoices      []*InvoiceWithOrganization `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
-	HasMore       bool                       `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	TotalCount    int64                      `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListAllInvoicesResponse) Reset() {
-	*x = ListAllInvoicesResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListAllInvoicesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*ListAllInvoicesResponse) ProtoMessage() {}
-
-func (x *ListAllInvoicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[45]
+func (x *IncomeSummary) GetTotalTransactions() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TotalTransactions
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListAllInvoicesResponse.ProtoReflect.Descriptor instead.
-func (*ListAllInvoicesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{45}
+	return 0
 }
 
-func (x *ListAllInvoicesResponse) GetInvoices() []*InvoiceWithOrganization {
+func (x *IncomeSummary) GetTotalRefunds() float64 {
 	if x != nil {
-		return x.Invoices
+		return x.TotalRefunds
 	}
-	return nil
+	return 0
 }
 
-func (x *ListAllInvoicesResponse) GetHasMore() bool {
+func (x *IncomeSummary) GetNetRevenue() float64 {
 	if x != nil {
-		return x.HasMore
+		return x.NetRevenue
 	}
-	return false
+	return 0
 }
 
-func (x *ListAllInvoicesResponse) GetTotalCount() int64 {
+func (x *IncomeSummary) GetEstimatedMonthlyIncome() float64 {
 	if x != nil {
-		return x.TotalCount
+		return x.EstimatedMonthlyIncome
 	}
 	return 0
 }
 
-// Invoice with Organization Info
-type InvoiceWithOrganization struct {
+// Monthly Income
+type MonthlyIncome struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	Invoice          *v11.Invoice           `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
-	OrganizationId   string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	OrganizationName string                 `protobuf:"bytes,3,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
-	CustomerEmail    string                 `protobuf:"bytes,4,opt,name=customer_email,json=customerEmail,proto3" json:"customer_email,omitempty"`
+	Month            string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM format
+	Revenue          float64                `protobuf:"fixed64,2,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	TransactionCount int64                  `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	Refunds          float64                `protobuf:"fixed64,4,opt,name=refunds,proto3" json:"refunds,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *InvoiceWithOrganization) Reset() {
-	*x = InvoiceWithOrganization{}
+func (x *MonthlyIncome) Reset() {
+	*x = MonthlyIncome{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvoiceWithOrganization) String() string {
+func (x *MonthlyIncome) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvoiceWithOrganization) ProtoMessage() {}
+func (*MonthlyIncome) ProtoMessage() {}
 
-func (x *InvoiceWithOrganization) ProtoReflect() protoreflect.Message {
+func (x *MonthlyIncome) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3358,61 +3238,66 @@ func (x *InvoiceWithOrganization) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvoiceWithOrganization.ProtoReflect.Descriptor instead.
-func (*InvoiceWithOrganization) Descriptor() ([]byte, []int) {
+// Deprecated: Use MonthlyIncome.ProtoReflect.Descriptor instead.
+func (*MonthlyIncome) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *InvoiceWithOrganization) GetInvoice() *v11.Invoice {
+func (x *MonthlyIncome) GetMonth() string {
 	if x != nil {
-		return x.Invoice
+		return x.Month
 	}
-	return nil
+	return ""
 }
 
-func (x *InvoiceWithOrganization) GetOrganizationId() string {
+func (x *MonthlyIncome) GetRevenue() float64 {
 	if x != nil {
-		return x.OrganizationId
+		return x.Revenue
 	}
-	return ""
+	return 0
 }
 
-func (x *InvoiceWithOrganization) GetOrganizationName() string {
+func (x *MonthlyIncome) GetTransactionCount() int64 {
 	if x != nil {
-		return x.OrganizationName
+		return x.TransactionCount
 	}
-	return ""
+	return 0
 }
 
-func (x *InvoiceWithOrganization) GetCustomerEmail() string {
+func (x *MonthlyIncome) GetRefunds() float64 {
 	if x != nil {
-		return x.CustomerEmail
+		return x.Refunds
 	}
-	return ""
+	return 0
 }
 
-// Send Invoice Reminder Request
-type SendInvoiceReminderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InvoiceId     string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"` // Stripe invoice ID
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Top Customer
+type TopCustomer struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	TotalRevenue     float64                `protobuf:"fixed64,3,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
+	TransactionCount int64                  `protobuf:"varint,4,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	FirstPayment     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=first_payment,json=firstPayment,proto3" json:"first_payment,omitempty"`
+	LastPayment      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_payment,json=lastPayment,proto3" json:"last_payment,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SendInvoiceReminderRequest) Reset() {
-	*x = SendInvoiceReminderRequest{}
+func (x *TopCustomer) Reset() {
+	*x = TopCustomer{}
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendInvoiceReminderRequest) String() string {
+func (x *TopCustomer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendInvoiceReminderRequest) ProtoMessage() {}
+func (*TopCustomer) ProtoMessage() {}
 
-func (x *SendInvoiceReminderRequest) ProtoReflect() protoreflect.Message {
+func (x *TopCustomer) ProtoReflect() protoreflect.Message {
 	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3424,186 +3309,86 @@ func (x *SendInvoiceReminderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendInvoiceReminderRequest.ProtoReflect.Descriptor instead.
-func (*SendInvoiceReminderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TopCustomer.ProtoReflect.Descriptor instead.
+func (*TopCustomer) Descriptor() ([]byte, []int) {
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SendInvoiceReminderRequest) GetInvoiceId() string {
+func (x *TopCustomer) GetOrganizationId() string {
 	if x != nil {
-		return x.InvoiceId
+		return x.OrganizationId
 	}
 	return ""
 }
 
-// Send Invoice Reminder Response
-type SendInvoiceReminderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SendInvoiceReminderResponse) Reset() {
-	*x = SendInvoiceReminderResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[48]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SendInvoiceReminderResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SendInvoiceReminderResponse) ProtoMessage() {}
-
-func (x *SendInvoiceReminderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[48]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SendInvoiceReminderResponse.ProtoReflect.Descriptor instead.
-func (*SendInvoiceReminderResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *SendInvoiceReminderResponse) GetSuccess() bool {
+func (x *TopCustomer) GetOrganizationName() string {
 	if x != nil {
-		return x.Success
+		return x.OrganizationName
 	}
-	return false
+	return ""
 }
 
-func (x *SendInvoiceReminderResponse) GetMessage() string {
+func (x *TopCustomer) GetTotalRevenue() float64 {
 	if x != nil {
-		return x.Message
+		return x.TotalRevenue
 	}
-	return ""
-}
-
-// List Plans Request
-type ListPlansRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListPlansRequest) Reset() {
-	*x = ListPlansRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[49]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListPlansRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*ListPlansRequest) ProtoMessage() {}
-
-func (x *ListPlansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[49]
+func (x *TopCustomer) GetTransactionCount() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TransactionCount
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListPlansRequest.ProtoReflect.Descriptor instead.
-func (*ListPlansRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{49}
-}
-
-// List Plans Response
-type ListPlansResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plans         []*Plan                `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListPlansResponse) Reset() {
-	*x = ListPlansResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[50]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListPlansResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*ListPlansResponse) ProtoMessage() {}
-
-func (x *ListPlansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[50]
+func (x *TopCustomer) GetFirstPayment() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FirstPayment
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListPlansResponse.ProtoReflect.Descriptor instead.
-func (*ListPlansResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{50}
+	return nil
 }
 
-func (x *ListPlansResponse) GetPlans() []*Plan {
+func (x *TopCustomer) GetLastPayment() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Plans
+		return x.LastPayment
 	}
 	return nil
 }
 
-// Create Plan Request
-type CreatePlanRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CpuCores                int32                  `protobuf:"varint,2,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
-	MemoryBytes             int64                  `protobuf:"varint,3,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
-	DeploymentsMax          int32                  `protobuf:"varint,4,opt,name=deployments_max,json=deploymentsMax,proto3" json:"deployments_max,omitempty"`
-	MaxVpsInstances         int32                  `protobuf:"varint,10,opt,name=max_vps_instances,json=maxVpsInstances,proto3" json:"max_vps_instances,omitempty"` // Maximum VPS instances (0 = unlimited)
-	BandwidthBytesMonth     int64                  `protobuf:"varint,5,opt,name=bandwidth_bytes_month,json=bandwidthBytesMonth,proto3" json:"bandwidth_bytes_month,omitempty"`
-	StorageBytes            int64                  `protobuf:"varint,6,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
-	MinimumPaymentCents     int64                  `protobuf:"varint,7,opt,name=minimum_payment_cents,json=minimumPaymentCents,proto3" json:"minimum_payment_cents,omitempty"`               // Minimum payment in cents to automatically upgrade to this plan
-	MonthlyFreeCreditsCents int64                  `protobuf:"varint,8,opt,name=monthly_free_credits_cents,json=monthlyFreeCreditsCents,proto3" json:"monthly_free_credits_cents,omitempty"` // Monthly free credits in cents granted to organizations on this plan
-	TrialDays               int32                  `protobuf:"varint,11,opt,name=trial_days,json=trialDays,proto3" json:"trial_days,omitempty"`                                              // Number of trial days for Stripe subscriptions (0 = no trial)
-	Description             string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`                                                             // Optional description of the plan
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+// Billing Transaction
+type BillingTransaction struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId        string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName      string                 `protobuf:"bytes,3,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	Type                  string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // "payment", "refund", "credit_add", "credit_remove"
+	AmountCents           float64                `protobuf:"fixed64,5,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	Currency              string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status                string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // "succeeded", "failed", "pending"
+	StripeInvoiceId       *string                `protobuf:"bytes,8,opt,name=stripe_invoice_id,json=stripeInvoiceId,proto3,oneof" json:"stripe_invoice_id,omitempty"`
+	StripePaymentIntentId *string                `protobuf:"bytes,9,opt,name=stripe_payment_intent_id,json=stripePaymentIntentId,proto3,oneof" json:"stripe_payment_intent_id,omitempty"`
+	Note                  *string                `protobuf:"bytes,10,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *CreatePlanRequest) Reset() {
-	*x = CreatePlanRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[51]
+func (x *BillingTransaction) Reset() {
+	*x = BillingTransaction{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePlanRequest) String() string {
+func (x *BillingTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePlanRequest) ProtoMessage() {}
+func (*BillingTransaction) ProtoMessage() {}
 
-func (x *CreatePlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[51]
+func (x *BillingTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3614,111 +3399,116 @@ func (x *CreatePlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePlanRequest.ProtoReflect.Descriptor instead.
-func (*CreatePlanRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use BillingTransaction.ProtoReflect.Descriptor instead.
+func (*BillingTransaction) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CreatePlanRequest) GetName() string {
+func (x *BillingTransaction) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreatePlanRequest) GetCpuCores() int32 {
+func (x *BillingTransaction) GetOrganizationId() string {
 	if x != nil {
-		return x.CpuCores
+		return x.OrganizationId
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatePlanRequest) GetMemoryBytes() int64 {
+func (x *BillingTransaction) GetOrganizationName() string {
 	if x != nil {
-		return x.MemoryBytes
+		return x.OrganizationName
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatePlanRequest) GetDeploymentsMax() int32 {
+func (x *BillingTransaction) GetType() string {
 	if x != nil {
-		return x.DeploymentsMax
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatePlanRequest) GetMaxVpsInstances() int32 {
+func (x *BillingTransaction) GetAmountCents() float64 {
 	if x != nil {
-		return x.MaxVpsInstances
+		return x.AmountCents
 	}
 	return 0
 }
 
-func (x *CreatePlanRequest) GetBandwidthBytesMonth() int64 {
+func (x *BillingTransaction) GetCurrency() string {
 	if x != nil {
-		return x.BandwidthBytesMonth
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatePlanRequest) GetStorageBytes() int64 {
+func (x *BillingTransaction) GetStatus() string {
 	if x != nil {
-		return x.StorageBytes
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatePlanRequest) GetMinimumPaymentCents() int64 {
-	if x != nil {
-		return x.MinimumPaymentCents
+func (x *BillingTransaction) GetStripeInvoiceId() string {
+	if x != nil && x.StripeInvoiceId != nil {
+		return *x.StripeInvoiceId
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatePlanRequest) GetMonthlyFreeCreditsCents() int64 {
-	if x != nil {
-		return x.MonthlyFreeCreditsCents
+func (x *BillingTransaction) GetStripePaymentIntentId() string {
+	if x != nil && x.StripePaymentIntentId != nil {
+		return *x.StripePaymentIntentId
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatePlanRequest) GetTrialDays() int32 {
-	if x != nil {
-		return x.TrialDays
+func (x *BillingTransaction) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatePlanRequest) GetDescription() string {
+func (x *BillingTransaction) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Description
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-// Create Plan Response
-type CreatePlanResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plan          *Plan                  `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Payment Metrics
+type PaymentMetrics struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	SuccessRate          float64                `protobuf:"fixed64,1,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"` // Percentage of successful payments
+	SuccessfulPayments   int64                  `protobuf:"varint,2,opt,name=successful_payments,json=successfulPayments,proto3" json:"successful_payments,omitempty"`
+	FailedPayments       int64                  `protobuf:"varint,3,opt,name=failed_payments,json=failedPayments,proto3" json:"failed_payments,omitempty"`
+	PendingPayments      int64                  `protobuf:"varint,4,opt,name=pending_payments,json=pendingPayments,proto3" json:"pending_payments,omitempty"`
+	AveragePaymentAmount float64                `protobuf:"fixed64,5,opt,name=average_payment_amount,json=averagePaymentAmount,proto3" json:"average_payment_amount,omitempty"`
+	LargestPayment       float64                `protobuf:"fixed64,6,opt,name=largest_payment,json=largestPayment,proto3" json:"largest_payment,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *CreatePlanResponse) Reset() {
-	*x = CreatePlanResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[52]
+func (x *PaymentMetrics) Reset() {
+	*x = PaymentMetrics{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePlanResponse) String() string {
+func (x *PaymentMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePlanResponse) ProtoMessage() {}
+func (*PaymentMetrics) ProtoMessage() {}
 
-func (x *CreatePlanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[52]
+func (x *PaymentMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3729,174 +3519,155 @@ func (x *CreatePlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePlanResponse.ProtoReflect.Descriptor instead.
-func (*CreatePlanResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use PaymentMetrics.ProtoReflect.Descriptor instead.
+func (*PaymentMetrics) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreatePlanResponse) GetPlan() *Plan {
+func (x *PaymentMetrics) GetSuccessRate() float64 {
 	if x != nil {
-		return x.Plan
+		return x.SuccessRate
 	}
-	return nil
-}
-
-// Update Plan Request
-type UpdatePlanRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                    *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	CpuCores                *int32                 `protobuf:"varint,3,opt,name=cpu_cores,json=cpuCores,proto3,oneof" json:"cpu_cores,omitempty"`
-	MemoryBytes             *int64                 `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3,oneof" json:"memory_bytes,omitempty"`
-	DeploymentsMax          *int32                 `protobuf:"varint,5,opt,name=deployments_max,json=deploymentsMax,proto3,oneof" json:"deployments_max,omitempty"`
-	MaxVpsInstances         *int32                 `protobuf:"varint,11,opt,name=max_vps_instances,json=maxVpsInstances,proto3,oneof" json:"max_vps_instances,omitempty"` // Maximum VPS instances (0 = unlimited)
-	BandwidthBytesMonth     *int64                 `protobuf:"varint,6,opt,name=bandwidth_bytes_month,json=bandwidthBytesMonth,proto3,oneof" json:"bandwidth_bytes_month,omitempty"`
-	StorageBytes            *int64                 `protobuf:"varint,7,opt,name=storage_bytes,json=storageBytes,proto3,oneof" json:"storage_bytes,omitempty"`
-	MinimumPaymentCents     *int64                 `protobuf:"varint,8,opt,name=minimum_payment_cents,json=minimumPaymentCents,proto3,oneof" json:"minimum_payment_cents,omitempty"`
-	MonthlyFreeCreditsCents *int64                 `protobuf:"varint,9,opt,name=monthly_free_credits_cents,json=monthlyFreeCreditsCents,proto3,oneof" json:"monthly_free_credits_cents,omitempty"`
-	TrialDays               *int32                 `protobuf:"varint,12,opt,name=trial_days,json=trialDays,proto3,oneof" json:"trial_days,omitempty"` // Number of trial days for Stripe subscriptions (0 = no trial)
-	Description             *string                `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
-}
-
-func (x *UpdatePlanRequest) Reset() {
-	*x = UpdatePlanRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[53]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdatePlanRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*UpdatePlanRequest) ProtoMessage() {}
-
-func (x *UpdatePlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[53]
+func (x *PaymentMetrics) GetSuccessfulPayments() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SuccessfulPayments
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdatePlanRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePlanRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{53}
+	return 0
 }
 
-func (x *UpdatePlanRequest) GetId() string {
+func (x *PaymentMetrics) GetFailedPayments() int64 {
 	if x != nil {
-		return x.Id
+		return x.FailedPayments
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdatePlanRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *PaymentMetrics) GetPendingPayments() int64 {
+	if x != nil {
+		return x.PendingPayments
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdatePlanRequest) GetCpuCores() int32 {
-	if x != nil && x.CpuCores != nil {
-		return *x.CpuCores
+func (x *PaymentMetrics) GetAveragePaymentAmount() float64 {
+	if x != nil {
+		return x.AveragePaymentAmount
 	}
 	return 0
 }
 
-func (x *UpdatePlanRequest) GetMemoryBytes() int64 {
-	if x != nil && x.MemoryBytes != nil {
-		return *x.MemoryBytes
+func (x *PaymentMetrics) GetLargestPayment() float64 {
+	if x != nil {
+		return x.LargestPayment
 	}
 	return 0
 }
 
-func (x *UpdatePlanRequest) GetDeploymentsMax() int32 {
-	if x != nil && x.DeploymentsMax != nil {
-		return *x.DeploymentsMax
-	}
-	return 0
+// List All Invoices Request
+type ListAllInvoicesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Filter by organization ID (optional)
+	Status         *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`                                       // Filter by status: "draft", "open", "paid", "uncollectible", "void" (optional)
+	Limit          *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                                        // Limit number of results (default: 50, max: 500)
+	StartDate      *string                `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`                // ISO 8601 date string (optional)
+	EndDate        *string                `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`                      // ISO 8601 date string (optional)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdatePlanRequest) GetMaxVpsInstances() int32 {
-	if x != nil && x.MaxVpsInstances != nil {
-		return *x.MaxVpsInstances
-	}
-	return 0
+func (x *ListAllInvoicesRequest) Reset() {
+	*x = ListAllInvoicesRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePlanRequest) GetBandwidthBytesMonth() int64 {
-	if x != nil && x.BandwidthBytesMonth != nil {
-		return *x.BandwidthBytesMonth
+func (x *ListAllInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllInvoicesRequest) ProtoMessage() {}
+
+func (x *ListAllInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdatePlanRequest) GetStorageBytes() int64 {
-	if x != nil && x.StorageBytes != nil {
-		return *x.StorageBytes
+// Deprecated: Use ListAllInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListAllInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListAllInvoicesRequest) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdatePlanRequest) GetMinimumPaymentCents() int64 {
-	if x != nil && x.MinimumPaymentCents != nil {
-		return *x.MinimumPaymentCents
+func (x *ListAllInvoicesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdatePlanRequest) GetMonthlyFreeCreditsCents() int64 {
-	if x != nil && x.MonthlyFreeCreditsCents != nil {
-		return *x.MonthlyFreeCreditsCents
+func (x *ListAllInvoicesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
 	return 0
 }
 
-func (x *UpdatePlanRequest) GetTrialDays() int32 {
-	if x != nil && x.TrialDays != nil {
-		return *x.TrialDays
+func (x *ListAllInvoicesRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdatePlanRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ListAllInvoicesRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
 	}
 	return ""
 }
 
-// Update Plan Response
-type UpdatePlanResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plan          *Plan                  `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+// List All Invoices Response
+type ListAllInvoicesResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Invoices      []*InvoiceWithOrganization `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	HasMore       bool                       `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	TotalCount    int64                      `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePlanResponse) Reset() {
-	*x = UpdatePlanResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[54]
+func (x *ListAllInvoicesResponse) Reset() {
+	*x = ListAllInvoicesResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePlanResponse) String() string {
+func (x *ListAllInvoicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePlanResponse) ProtoMessage() {}
+func (*ListAllInvoicesResponse) ProtoMessage() {}
 
-func (x *UpdatePlanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[54]
+func (x *ListAllInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3907,41 +3678,58 @@ func (x *UpdatePlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePlanResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePlanResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ListAllInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListAllInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *UpdatePlanResponse) GetPlan() *Plan {
+func (x *ListAllInvoicesResponse) GetInvoices() []*InvoiceWithOrganization {
 	if x != nil {
-		return x.Plan
+		return x.Invoices
 	}
 	return nil
 }
 
-// Delete Plan Request
-type DeletePlanRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListAllInvoicesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
 }
 
-func (x *DeletePlanRequest) Reset() {
-	*x = DeletePlanRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[55]
+func (x *ListAllInvoicesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Invoice with Organization Info
+type InvoiceWithOrganization struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Invoice          *v11.Invoice           `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	OrganizationId   string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,3,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	CustomerEmail    string                 `protobuf:"bytes,4,opt,name=customer_email,json=customerEmail,proto3" json:"customer_email,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InvoiceWithOrganization) Reset() {
+	*x = InvoiceWithOrganization{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePlanRequest) String() string {
+func (x *InvoiceWithOrganization) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePlanRequest) ProtoMessage() {}
+func (*InvoiceWithOrganization) ProtoMessage() {}
 
-func (x *DeletePlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[55]
+func (x *InvoiceWithOrganization) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3952,41 +3740,62 @@ func (x *DeletePlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePlanRequest.ProtoReflect.Descriptor instead.
-func (*DeletePlanRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use InvoiceWithOrganization.ProtoReflect.Descriptor instead.
+func (*InvoiceWithOrganization) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *DeletePlanRequest) GetId() string {
+func (x *InvoiceWithOrganization) GetInvoice() *v11.Invoice {
 	if x != nil {
-		return x.Id
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *InvoiceWithOrganization) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
 	}
 	return ""
 }
 
-// Delete Plan Response
-type DeletePlanResponse struct {
+func (x *InvoiceWithOrganization) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *InvoiceWithOrganization) GetCustomerEmail() string {
+	if x != nil {
+		return x.CustomerEmail
+	}
+	return ""
+}
+
+// Send Invoice Reminder Request
+type SendInvoiceReminderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	InvoiceId     string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"` // Stripe invoice ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePlanResponse) Reset() {
-	*x = DeletePlanResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[56]
+func (x *SendInvoiceReminderRequest) Reset() {
+	*x = SendInvoiceReminderRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePlanResponse) String() string {
+func (x *SendInvoiceReminderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePlanResponse) ProtoMessage() {}
+func (*SendInvoiceReminderRequest) ProtoMessage() {}
 
-func (x *DeletePlanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[56]
+func (x *SendInvoiceReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3997,52 +3806,42 @@ func (x *DeletePlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePlanResponse.ProtoReflect.Descriptor instead.
-func (*DeletePlanResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use SendInvoiceReminderRequest.ProtoReflect.Descriptor instead.
+func (*SendInvoiceReminderRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *DeletePlanResponse) GetSuccess() bool {
+func (x *SendInvoiceReminderRequest) GetInvoiceId() string {
 	if x != nil {
-		return x.Success
+		return x.InvoiceId
 	}
-	return false
+	return ""
 }
 
-// Plan
-type Plan struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CpuCores                int32                  `protobuf:"varint,3,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
-	MemoryBytes             int64                  `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
-	DeploymentsMax          int32                  `protobuf:"varint,5,opt,name=deployments_max,json=deploymentsMax,proto3" json:"deployments_max,omitempty"`
-	MaxVpsInstances         int32                  `protobuf:"varint,11,opt,name=max_vps_instances,json=maxVpsInstances,proto3" json:"max_vps_instances,omitempty"` // Maximum VPS instances (0 = unlimited)
-	BandwidthBytesMonth     int64                  `protobuf:"varint,6,opt,name=bandwidth_bytes_month,json=bandwidthBytesMonth,proto3" json:"bandwidth_bytes_month,omitempty"`
-	StorageBytes            int64                  `protobuf:"varint,7,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
-	MinimumPaymentCents     int64                  `protobuf:"varint,8,opt,name=minimum_payment_cents,json=minimumPaymentCents,proto3" json:"minimum_payment_cents,omitempty"`               // Minimum payment in cents to automatically upgrade to this plan
-	MonthlyFreeCreditsCents int64                  `protobuf:"varint,9,opt,name=monthly_free_credits_cents,json=monthlyFreeCreditsCents,proto3" json:"monthly_free_credits_cents,omitempty"` // Monthly free credits in cents granted to organizations on this plan
-	TrialDays               int32                  `protobuf:"varint,12,opt,name=trial_days,json=trialDays,proto3" json:"trial_days,omitempty"`                                              // Number of trial days for Stripe subscriptions (0 = no trial)
-	Description             string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+// Send Invoice Reminder Response
+type SendInvoiceReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Plan) Reset() {
-	*x = Plan{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[57]
+func (x *SendInvoiceReminderResponse) Reset() {
+	*x = SendInvoiceReminderResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Plan) String() string {
+func (x *SendInvoiceReminderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plan) ProtoMessage() {}
+func (*SendInvoiceReminderResponse) ProtoMessage() {}
 
-func (x *Plan) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[57]
+func (x *SendInvoiceReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4053,119 +3852,85 @@ func (x *Plan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
-func (*Plan) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use SendInvoiceReminderResponse.ProtoReflect.Descriptor instead.
+func (*SendInvoiceReminderResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Plan) GetId() string {
+func (x *SendInvoiceReminderResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *Plan) GetName() string {
+func (x *SendInvoiceReminderResponse) GetMessage() string {
 	if x != nil {
-		return x.Name
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Plan) GetCpuCores() int32 {
-	if x != nil {
-		return x.CpuCores
-	}
-	return 0
+// List Plans Request
+type ListPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Plan) GetMemoryBytes() int64 {
-	if x != nil {
-		return x.MemoryBytes
-	}
-	return 0
+func (x *ListPlansRequest) Reset() {
+	*x = ListPlansRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Plan) GetDeploymentsMax() int32 {
-	if x != nil {
-		return x.DeploymentsMax
-	}
-	return 0
+func (x *ListPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Plan) GetMaxVpsInstances() int32 {
-	if x != nil {
-		return x.MaxVpsInstances
-	}
-	return 0
-}
+func (*ListPlansRequest) ProtoMessage() {}
 
-func (x *Plan) GetBandwidthBytesMonth() int64 {
+func (x *ListPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[55]
 	if x != nil {
-		return x.BandwidthBytesMonth
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Plan) GetStorageBytes() int64 {
-	if x != nil {
-		return x.StorageBytes
-	}
-	return 0
+// Deprecated: Use ListPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListPlansRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *Plan) GetMinimumPaymentCents() int64 {
-	if x != nil {
-		return x.MinimumPaymentCents
-	}
-	return 0
+// List Plans Response
+type ListPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*Plan                `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Plan) GetMonthlyFreeCreditsCents() int64 {
-	if x != nil {
-		return x.MonthlyFreeCreditsCents
-	}
-	return 0
+func (x *ListPlansResponse) Reset() {
+	*x = ListPlansResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Plan) GetTrialDays() int32 {
-	if x != nil {
-		return x.TrialDays
-	}
-	return 0
+func (x *ListPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Plan) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-// Assign Plan To Organization Request
-type AssignPlanToOrganizationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	PlanId         string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *AssignPlanToOrganizationRequest) Reset() {
-	*x = AssignPlanToOrganizationRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[58]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AssignPlanToOrganizationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AssignPlanToOrganizationRequest) ProtoMessage() {}
-
-func (x *AssignPlanToOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[58]
+func (*ListPlansResponse) ProtoMessage() {}
+
+func (x *ListPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4176,49 +3941,52 @@ func (x *AssignPlanToOrganizationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignPlanToOrganizationRequest.ProtoReflect.Descriptor instead.
-func (*AssignPlanToOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *AssignPlanToOrganizationRequest) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
+// Deprecated: Use ListPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListPlansResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *AssignPlanToOrganizationRequest) GetPlanId() string {
+func (x *ListPlansResponse) GetPlans() []*Plan {
 	if x != nil {
-		return x.PlanId
+		return x.Plans
 	}
-	return ""
+	return nil
 }
 
-// Assign Plan To Organization Response
-type AssignPlanToOrganizationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Create Plan Request
+type CreatePlanRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CpuCores                int32                  `protobuf:"varint,2,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	MemoryBytes             int64                  `protobuf:"varint,3,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	DeploymentsMax          int32                  `protobuf:"varint,4,opt,name=deployments_max,json=deploymentsMax,proto3" json:"deployments_max,omitempty"`
+	MaxVpsInstances         int32                  `protobuf:"varint,10,opt,name=max_vps_instances,json=maxVpsInstances,proto3" json:"max_vps_instances,omitempty"` // Maximum VPS instances (0 = unlimited)
+	BandwidthBytesMonth     int64                  `protobuf:"varint,5,opt,name=bandwidth_bytes_month,json=bandwidthBytesMonth,proto3" json:"bandwidth_bytes_month,omitempty"`
+	StorageBytes            int64                  `protobuf:"varint,6,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	MinimumPaymentCents     int64                  `protobuf:"varint,7,opt,name=minimum_payment_cents,json=minimumPaymentCents,proto3" json:"minimum_payment_cents,omitempty"`               // Minimum payment in cents to automatically upgrade to this plan
+	MonthlyFreeCreditsCents int64                  `protobuf:"varint,8,opt,name=monthly_free_credits_cents,json=monthlyFreeCreditsCents,proto3" json:"monthly_free_credits_cents,omitempty"` // Monthly free credits in cents granted to organizations on this plan
+	TrialDays               int32                  `protobuf:"varint,11,opt,name=trial_days,json=trialDays,proto3" json:"trial_days,omitempty"`                                              // Number of trial days for Stripe subscriptions (0 = no trial)
+	MaxReplicas             int32                  `protobuf:"varint,12,opt,name=max_replicas,json=maxReplicas,proto3" json:"max_replicas,omitempty"`                                        // Maximum replicas per deployment (0 = unlimited)
+	Description             string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`                                                             // Optional description of the plan
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *AssignPlanToOrganizationResponse) Reset() {
-	*x = AssignPlanToOrganizationResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[59]
+func (x *CreatePlanRequest) Reset() {
+	*x = CreatePlanRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignPlanToOrganizationResponse) String() string {
+func (x *CreatePlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignPlanToOrganizationResponse) ProtoMessage() {}
+func (*CreatePlanRequest) ProtoMessage() {}
 
-func (x *AssignPlanToOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[59]
+func (x *CreatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4229,110 +3997,118 @@ func (x *AssignPlanToOrganizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignPlanToOrganizationResponse.ProtoReflect.Descriptor instead.
-func (*AssignPlanToOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use CreatePlanRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AssignPlanToOrganizationResponse) GetSuccess() bool {
+func (x *CreatePlanRequest) GetName() string {
 	if x != nil {
-		return x.Success
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *AssignPlanToOrganizationResponse) GetMessage() string {
+func (x *CreatePlanRequest) GetCpuCores() int32 {
 	if x != nil {
-		return x.Message
+		return x.CpuCores
 	}
-	return ""
+	return 0
 }
 
-// List Users Request
-type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`                      // Page number (default: 1)
-	PerPage       *int32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3,oneof" json:"per_page,omitempty"` // Results per page (default: 50, max: 100)
-	Search        *string                `protobuf:"bytes,3,opt,name=search,proto3,oneof" json:"search,omitempty"`                   // Search by email, name, or user ID
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreatePlanRequest) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
 }
 
-func (x *ListUsersRequest) Reset() {
-	*x = ListUsersRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreatePlanRequest) GetDeploymentsMax() int32 {
+	if x != nil {
+		return x.DeploymentsMax
+	}
+	return 0
 }
 
-func (x *ListUsersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreatePlanRequest) GetMaxVpsInstances() int32 {
+	if x != nil {
+		return x.MaxVpsInstances
+	}
+	return 0
 }
 
-func (*ListUsersRequest) ProtoMessage() {}
+func (x *CreatePlanRequest) GetBandwidthBytesMonth() int64 {
+	if x != nil {
+		return x.BandwidthBytesMonth
+	}
+	return 0
+}
 
-func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[60]
+func (x *CreatePlanRequest) GetStorageBytes() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StorageBytes
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{60}
+func (x *CreatePlanRequest) GetMinimumPaymentCents() int64 {
+	if x != nil {
+		return x.MinimumPaymentCents
+	}
+	return 0
 }
 
-func (x *ListUsersRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
+func (x *CreatePlanRequest) GetMonthlyFreeCreditsCents() int64 {
+	if x != nil {
+		return x.MonthlyFreeCreditsCents
 	}
 	return 0
 }
 
-func (x *ListUsersRequest) GetPerPage() int32 {
-	if x != nil && x.PerPage != nil {
-		return *x.PerPage
+func (x *CreatePlanRequest) GetTrialDays() int32 {
+	if x != nil {
+		return x.TrialDays
 	}
 	return 0
 }
 
-func (x *ListUsersRequest) GetSearch() string {
-	if x != nil && x.Search != nil {
-		return *x.Search
+func (x *CreatePlanRequest) GetMaxReplicas() int32 {
+	if x != nil {
+		return x.MaxReplicas
+	}
+	return 0
+}
+
+func (x *CreatePlanRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-// List Users Response
-type ListUsersResponse struct {
+// Create Plan Response
+type CreatePlanResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*UserInfo            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Pagination    *v12.Pagination        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Plan          *Plan                  `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[61]
+func (x *CreatePlanResponse) Reset() {
+	*x = CreatePlanResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *CreatePlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*CreatePlanResponse) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[61]
+func (x *CreatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4343,48 +4119,53 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *ListUsersResponse) GetUsers() []*UserInfo {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+// Deprecated: Use CreatePlanResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListUsersResponse) GetPagination() *v12.Pagination {
+func (x *CreatePlanResponse) GetPlan() *Plan {
 	if x != nil {
-		return x.Pagination
+		return x.Plan
 	}
 	return nil
 }
 
-// Get User Request
-type GetUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID to fetch
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Update Plan Request
+type UpdatePlanRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                    *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	CpuCores                *int32                 `protobuf:"varint,3,opt,name=cpu_cores,json=cpuCores,proto3,oneof" json:"cpu_cores,omitempty"`
+	MemoryBytes             *int64                 `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3,oneof" json:"memory_bytes,omitempty"`
+	DeploymentsMax          *int32                 `protobuf:"varint,5,opt,name=deployments_max,json=deploymentsMax,proto3,oneof" json:"deployments_max,omitempty"`
+	MaxVpsInstances         *int32                 `protobuf:"varint,11,opt,name=max_vps_instances,json=maxVpsInstances,proto3,oneof" json:"max_vps_instances,omitempty"` // Maximum VPS instances (0 = unlimited)
+	BandwidthBytesMonth     *int64                 `protobuf:"varint,6,opt,name=bandwidth_bytes_month,json=bandwidthBytesMonth,proto3,oneof" json:"bandwidth_bytes_month,omitempty"`
+	StorageBytes            *int64                 `protobuf:"varint,7,opt,name=storage_bytes,json=storageBytes,proto3,oneof" json:"storage_bytes,omitempty"`
+	MinimumPaymentCents     *int64                 `protobuf:"varint,8,opt,name=minimum_payment_cents,json=minimumPaymentCents,proto3,oneof" json:"minimum_payment_cents,omitempty"`
+	MonthlyFreeCreditsCents *int64                 `protobuf:"varint,9,opt,name=monthly_free_credits_cents,json=monthlyFreeCreditsCents,proto3,oneof" json:"monthly_free_credits_cents,omitempty"`
+	TrialDays               *int32                 `protobuf:"varint,12,opt,name=trial_days,json=trialDays,proto3,oneof" json:"trial_days,omitempty"`       // Number of trial days for Stripe subscriptions (0 = no trial)
+	MaxReplicas             *int32                 `protobuf:"varint,13,opt,name=max_replicas,json=maxReplicas,proto3,oneof" json:"max_replicas,omitempty"` // Maximum replicas per deployment (0 = unlimited)
+	Description             *string                `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[62]
+func (x *UpdatePlanRequest) Reset() {
+	*x = UpdatePlanRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *UpdatePlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*UpdatePlanRequest) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[62]
+func (x *UpdatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4395,42 +4176,125 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use UpdatePlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetUserRequest) GetUserId() string {
+func (x *UpdatePlanRequest) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-// Get User Response
-type GetUserResponse struct {
+func (x *UpdatePlanRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePlanRequest) GetCpuCores() int32 {
+	if x != nil && x.CpuCores != nil {
+		return *x.CpuCores
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetMemoryBytes() int64 {
+	if x != nil && x.MemoryBytes != nil {
+		return *x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetDeploymentsMax() int32 {
+	if x != nil && x.DeploymentsMax != nil {
+		return *x.DeploymentsMax
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetMaxVpsInstances() int32 {
+	if x != nil && x.MaxVpsInstances != nil {
+		return *x.MaxVpsInstances
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetBandwidthBytesMonth() int64 {
+	if x != nil && x.BandwidthBytesMonth != nil {
+		return *x.BandwidthBytesMonth
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetStorageBytes() int64 {
+	if x != nil && x.StorageBytes != nil {
+		return *x.StorageBytes
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetMinimumPaymentCents() int64 {
+	if x != nil && x.MinimumPaymentCents != nil {
+		return *x.MinimumPaymentCents
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetMonthlyFreeCreditsCents() int64 {
+	if x != nil && x.MonthlyFreeCreditsCents != nil {
+		return *x.MonthlyFreeCreditsCents
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetTrialDays() int32 {
+	if x != nil && x.TrialDays != nil {
+		return *x.TrialDays
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetMaxReplicas() int32 {
+	if x != nil && x.MaxReplicas != nil {
+		return *x.MaxReplicas
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// Update Plan Response
+type UpdatePlanResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Organizations []*UserOrganization    `protobuf:"bytes,2,rep,name=organizations,proto3" json:"organizations,omitempty"` // Organizations this user belongs to
+	Plan          *Plan                  `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserResponse) Reset() {
-	*x = GetUserResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[63]
+func (x *UpdatePlanResponse) Reset() {
+	*x = UpdatePlanResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserResponse) String() string {
+func (x *UpdatePlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponse) ProtoMessage() {}
+func (*UpdatePlanResponse) ProtoMessage() {}
 
-func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[63]
+func (x *UpdatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4441,51 +4305,41 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *GetUserResponse) GetUser() *UserInfo {
-	if x != nil {
-		return x.User
-	}
-	return nil
+// Deprecated: Use UpdatePlanResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetUserResponse) GetOrganizations() []*UserOrganization {
+func (x *UpdatePlanResponse) GetPlan() *Plan {
 	if x != nil {
-		return x.Organizations
+		return x.Plan
 	}
 	return nil
 }
 
-// List Dormant Resource Owners Request
-type ListDormantResourceOwnersRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Page            *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`                                                // Page number (default: 1)
-	PerPage         *int32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3,oneof" json:"per_page,omitempty"`                           // Results per page (default: 25, max: 100)
-	Search          *string                `protobuf:"bytes,3,opt,name=search,proto3,oneof" json:"search,omitempty"`                                             // Search by user or organization metadata
-	MinInactiveDays *int32                 `protobuf:"varint,4,opt,name=min_inactive_days,json=minInactiveDays,proto3,oneof" json:"min_inactive_days,omitempty"` // Minimum days since last observed activity (default: 30)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Delete Plan Request
+type DeletePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDormantResourceOwnersRequest) Reset() {
-	*x = ListDormantResourceOwnersRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[64]
+func (x *DeletePlanRequest) Reset() {
+	*x = DeletePlanRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDormantResourceOwnersRequest) String() string {
+func (x *DeletePlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDormantResourceOwnersRequest) ProtoMessage() {}
+func (*DeletePlanRequest) ProtoMessage() {}
 
-func (x *ListDormantResourceOwnersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[64]
+func (x *DeletePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4496,67 +4350,41 @@ func (x *ListDormantResourceOwnersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDormantResourceOwnersRequest.ProtoReflect.Descriptor instead.
-func (*ListDormantResourceOwnersRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{64}
-}
-
-func (x *ListDormantResourceOwnersRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
-	}
-	return 0
-}
-
-func (x *ListDormantResourceOwnersRequest) GetPerPage() int32 {
-	if x != nil && x.PerPage != nil {
-		return *x.PerPage
-	}
-	return 0
+// Deprecated: Use DeletePlanRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlanRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ListDormantResourceOwnersRequest) GetSearch() string {
-	if x != nil && x.Search != nil {
-		return *x.Search
+func (x *DeletePlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListDormantResourceOwnersRequest) GetMinInactiveDays() int32 {
-	if x != nil && x.MinInactiveDays != nil {
-		return *x.MinInactiveDays
-	}
-	return 0
-}
-
-// Dormant resource summary for the current filter set
-type DormantResourceSummary struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	DormantUsers         int32                  `protobuf:"varint,1,opt,name=dormant_users,json=dormantUsers,proto3" json:"dormant_users,omitempty"`
-	UsersWithVps         int32                  `protobuf:"varint,2,opt,name=users_with_vps,json=usersWithVps,proto3" json:"users_with_vps,omitempty"`
-	UsersWithDatabases   int32                  `protobuf:"varint,3,opt,name=users_with_databases,json=usersWithDatabases,proto3" json:"users_with_databases,omitempty"`
-	UsersWithDeployments int32                  `protobuf:"varint,4,opt,name=users_with_deployments,json=usersWithDeployments,proto3" json:"users_with_deployments,omitempty"`
-	UsersWithGameServers int32                  `protobuf:"varint,5,opt,name=users_with_game_servers,json=usersWithGameServers,proto3" json:"users_with_game_servers,omitempty"`
-	TotalReservedBytes   int64                  `protobuf:"varint,6,opt,name=total_reserved_bytes,json=totalReservedBytes,proto3" json:"total_reserved_bytes,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// Delete Plan Response
+type DeletePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DormantResourceSummary) Reset() {
-	*x = DormantResourceSummary{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[65]
+func (x *DeletePlanResponse) Reset() {
+	*x = DeletePlanResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DormantResourceSummary) String() string {
+func (x *DeletePlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DormantResourceSummary) ProtoMessage() {}
+func (*DeletePlanResponse) ProtoMessage() {}
 
-func (x *DormantResourceSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[65]
+func (x *DeletePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4567,82 +4395,53 @@ func (x *DormantResourceSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DormantResourceSummary.ProtoReflect.Descriptor instead.
-func (*DormantResourceSummary) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use DeletePlanResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlanResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *DormantResourceSummary) GetDormantUsers() int32 {
+func (x *DeletePlanResponse) GetSuccess() bool {
 	if x != nil {
-		return x.DormantUsers
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *DormantResourceSummary) GetUsersWithVps() int32 {
-	if x != nil {
-		return x.UsersWithVps
-	}
-	return 0
+// Plan
+type Plan struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CpuCores                int32                  `protobuf:"varint,3,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	MemoryBytes             int64                  `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	DeploymentsMax          int32                  `protobuf:"varint,5,opt,name=deployments_max,json=deploymentsMax,proto3" json:"deployments_max,omitempty"`
+	MaxVpsInstances         int32                  `protobuf:"varint,11,opt,name=max_vps_instances,json=maxVpsInstances,proto3" json:"max_vps_instances,omitempty"` // Maximum VPS instances (0 = unlimited)
+	BandwidthBytesMonth     int64                  `protobuf:"varint,6,opt,name=bandwidth_bytes_month,json=bandwidthBytesMonth,proto3" json:"bandwidth_bytes_month,omitempty"`
+	StorageBytes            int64                  `protobuf:"varint,7,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	MinimumPaymentCents     int64                  `protobuf:"varint,8,opt,name=minimum_payment_cents,json=minimumPaymentCents,proto3" json:"minimum_payment_cents,omitempty"`               // Minimum payment in cents to automatically upgrade to this plan
+	MonthlyFreeCreditsCents int64                  `protobuf:"varint,9,opt,name=monthly_free_credits_cents,json=monthlyFreeCreditsCents,proto3" json:"monthly_free_credits_cents,omitempty"` // Monthly free credits in cents granted to organizations on this plan
+	TrialDays               int32                  `protobuf:"varint,12,opt,name=trial_days,json=trialDays,proto3" json:"trial_days,omitempty"`                                              // Number of trial days for Stripe subscriptions (0 = no trial)
+	MaxReplicas             int32                  `protobuf:"varint,13,opt,name=max_replicas,json=maxReplicas,proto3" json:"max_replicas,omitempty"`                                        // Maximum replicas per deployment (0 = unlimited)
+	Description             string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *DormantResourceSummary) GetUsersWithDatabases() int32 {
-	if x != nil {
-		return x.UsersWithDatabases
-	}
-	return 0
-}
-
-func (x *DormantResourceSummary) GetUsersWithDeployments() int32 {
-	if x != nil {
-		return x.UsersWithDeployments
-	}
-	return 0
-}
-
-func (x *DormantResourceSummary) GetUsersWithGameServers() int32 {
-	if x != nil {
-		return x.UsersWithGameServers
-	}
-	return 0
-}
-
-func (x *DormantResourceSummary) GetTotalReservedBytes() int64 {
-	if x != nil {
-		return x.TotalReservedBytes
-	}
-	return 0
-}
-
-// Organization-specific retained resources for a dormant user
-type DormantResourceOrganization struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
-	VpsCount         int32                  `protobuf:"varint,3,opt,name=vps_count,json=vpsCount,proto3" json:"vps_count,omitempty"`
-	DatabaseCount    int32                  `protobuf:"varint,4,opt,name=database_count,json=databaseCount,proto3" json:"database_count,omitempty"`
-	DeploymentCount  int32                  `protobuf:"varint,5,opt,name=deployment_count,json=deploymentCount,proto3" json:"deployment_count,omitempty"`
-	GameServerCount  int32                  `protobuf:"varint,6,opt,name=game_server_count,json=gameServerCount,proto3" json:"game_server_count,omitempty"`
-	ReservedBytes    int64                  `protobuf:"varint,7,opt,name=reserved_bytes,json=reservedBytes,proto3" json:"reserved_bytes,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *DormantResourceOrganization) Reset() {
-	*x = DormantResourceOrganization{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[66]
+func (x *Plan) Reset() {
+	*x = Plan{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DormantResourceOrganization) String() string {
+func (x *Plan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DormantResourceOrganization) ProtoMessage() {}
+func (*Plan) ProtoMessage() {}
 
-func (x *DormantResourceOrganization) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[66]
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4653,227 +4452,180 @@ func (x *DormantResourceOrganization) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DormantResourceOrganization.ProtoReflect.Descriptor instead.
-func (*DormantResourceOrganization) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DormantResourceOrganization) GetOrganizationId() string {
+func (x *Plan) GetId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DormantResourceOrganization) GetOrganizationName() string {
+func (x *Plan) GetName() string {
 	if x != nil {
-		return x.OrganizationName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DormantResourceOrganization) GetVpsCount() int32 {
+func (x *Plan) GetCpuCores() int32 {
 	if x != nil {
-		return x.VpsCount
+		return x.CpuCores
 	}
 	return 0
 }
 
-func (x *DormantResourceOrganization) GetDatabaseCount() int32 {
+func (x *Plan) GetMemoryBytes() int64 {
 	if x != nil {
-		return x.DatabaseCount
+		return x.MemoryBytes
 	}
 	return 0
 }
 
-func (x *DormantResourceOrganization) GetDeploymentCount() int32 {
+func (x *Plan) GetDeploymentsMax() int32 {
 	if x != nil {
-		return x.DeploymentCount
+		return x.DeploymentsMax
 	}
 	return 0
 }
 
-func (x *DormantResourceOrganization) GetGameServerCount() int32 {
+func (x *Plan) GetMaxVpsInstances() int32 {
 	if x != nil {
-		return x.GameServerCount
+		return x.MaxVpsInstances
 	}
 	return 0
 }
 
-func (x *DormantResourceOrganization) GetReservedBytes() int64 {
+func (x *Plan) GetBandwidthBytesMonth() int64 {
 	if x != nil {
-		return x.ReservedBytes
+		return x.BandwidthBytesMonth
 	}
 	return 0
 }
 
-// Dormant resource owner details
-type DormantResourceOwner struct {
-	state                 protoimpl.MessageState         `protogen:"open.v1"`
-	User                  *UserInfo                      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	LastActivityAt        *timestamppb.Timestamp         `protobuf:"bytes,2,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
-	LastActivitySource    string                         `protobuf:"bytes,3,opt,name=last_activity_source,json=lastActivitySource,proto3" json:"last_activity_source,omitempty"` // audit_log | profile_updated | profile_created | resource_updated | resource_created | unknown
-	InactiveDays          int32                          `protobuf:"varint,4,opt,name=inactive_days,json=inactiveDays,proto3" json:"inactive_days,omitempty"`
-	OrganizationCount     int32                          `protobuf:"varint,5,opt,name=organization_count,json=organizationCount,proto3" json:"organization_count,omitempty"`
-	VpsCount              int32                          `protobuf:"varint,6,opt,name=vps_count,json=vpsCount,proto3" json:"vps_count,omitempty"`
-	DatabaseCount         int32                          `protobuf:"varint,7,opt,name=database_count,json=databaseCount,proto3" json:"database_count,omitempty"`
-	DeploymentCount       int32                          `protobuf:"varint,8,opt,name=deployment_count,json=deploymentCount,proto3" json:"deployment_count,omitempty"`
-	GameServerCount       int32                          `protobuf:"varint,9,opt,name=game_server_count,json=gameServerCount,proto3" json:"game_server_count,omitempty"`
-	TotalReservedBytes    int64                          `protobuf:"varint,10,opt,name=total_reserved_bytes,json=totalReservedBytes,proto3" json:"total_reserved_bytes,omitempty"`
-	LastResourceCreatedAt *timestamppb.Timestamp         `protobuf:"bytes,11,opt,name=last_resource_created_at,json=lastResourceCreatedAt,proto3" json:"last_resource_created_at,omitempty"`
-	LastResourceUpdatedAt *timestamppb.Timestamp         `protobuf:"bytes,12,opt,name=last_resource_updated_at,json=lastResourceUpdatedAt,proto3" json:"last_resource_updated_at,omitempty"`
-	Organizations         []*DormantResourceOrganization `protobuf:"bytes,13,rep,name=organizations,proto3" json:"organizations,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
-}
-
-func (x *DormantResourceOwner) Reset() {
-	*x = DormantResourceOwner{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[67]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DormantResourceOwner) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DormantResourceOwner) ProtoMessage() {}
-
-func (x *DormantResourceOwner) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[67]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DormantResourceOwner.ProtoReflect.Descriptor instead.
-func (*DormantResourceOwner) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *DormantResourceOwner) GetUser() *UserInfo {
+func (x *Plan) GetStorageBytes() int64 {
 	if x != nil {
-		return x.User
+		return x.StorageBytes
 	}
-	return nil
+	return 0
 }
 
-func (x *DormantResourceOwner) GetLastActivityAt() *timestamppb.Timestamp {
+func (x *Plan) GetMinimumPaymentCents() int64 {
 	if x != nil {
-		return x.LastActivityAt
+		return x.MinimumPaymentCents
 	}
-	return nil
+	return 0
 }
 
-func (x *DormantResourceOwner) GetLastActivitySource() string {
+func (x *Plan) GetMonthlyFreeCreditsCents() int64 {
 	if x != nil {
-		return x.LastActivitySource
+		return x.MonthlyFreeCreditsCents
 	}
-	return ""
+	return 0
 }
 
-func (x *DormantResourceOwner) GetInactiveDays() int32 {
+func (x *Plan) GetTrialDays() int32 {
 	if x != nil {
-		return x.InactiveDays
+		return x.TrialDays
 	}
 	return 0
 }
 
-func (x *DormantResourceOwner) GetOrganizationCount() int32 {
+func (x *Plan) GetMaxReplicas() int32 {
 	if x != nil {
-		return x.OrganizationCount
+		return x.MaxReplicas
 	}
 	return 0
 }
 
-func (x *DormantResourceOwner) GetVpsCount() int32 {
+func (x *Plan) GetDescription() string {
 	if x != nil {
-		return x.VpsCount
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *DormantResourceOwner) GetDatabaseCount() int32 {
-	if x != nil {
-		return x.DatabaseCount
-	}
-	return 0
+// Assign Plan To Organization Request
+type AssignPlanToOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PlanId         string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DormantResourceOwner) GetDeploymentCount() int32 {
-	if x != nil {
-		return x.DeploymentCount
-	}
-	return 0
+func (x *AssignPlanToOrganizationRequest) Reset() {
+	*x = AssignPlanToOrganizationRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DormantResourceOwner) GetGameServerCount() int32 {
-	if x != nil {
-		return x.GameServerCount
-	}
-	return 0
+func (x *AssignPlanToOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DormantResourceOwner) GetTotalReservedBytes() int64 {
+func (*AssignPlanToOrganizationRequest) ProtoMessage() {}
+
+func (x *AssignPlanToOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[64]
 	if x != nil {
-		return x.TotalReservedBytes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DormantResourceOwner) GetLastResourceCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastResourceCreatedAt
-	}
-	return nil
+// Deprecated: Use AssignPlanToOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*AssignPlanToOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *DormantResourceOwner) GetLastResourceUpdatedAt() *timestamppb.Timestamp {
+func (x *AssignPlanToOrganizationRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.LastResourceUpdatedAt
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *DormantResourceOwner) GetOrganizations() []*DormantResourceOrganization {
+func (x *AssignPlanToOrganizationRequest) GetPlanId() string {
 	if x != nil {
-		return x.Organizations
+		return x.PlanId
 	}
-	return nil
+	return ""
 }
 
-// List Dormant Resource Owners Response
-type ListDormantResourceOwnersResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Owners        []*DormantResourceOwner `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
-	Pagination    *v12.Pagination         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Summary       *DormantResourceSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+// Assign Plan To Organization Response
+type AssignPlanToOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDormantResourceOwnersResponse) Reset() {
-	*x = ListDormantResourceOwnersResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[68]
+func (x *AssignPlanToOrganizationResponse) Reset() {
+	*x = AssignPlanToOrganizationResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDormantResourceOwnersResponse) String() string {
+func (x *AssignPlanToOrganizationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDormantResourceOwnersResponse) ProtoMessage() {}
+func (*AssignPlanToOrganizationResponse) ProtoMessage() {}
 
-func (x *ListDormantResourceOwnersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[68]
-	if x != nil {
+func (x *AssignPlanToOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[65]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -4883,64 +4635,50 @@ func (x *ListDormantResourceOwnersResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDormantResourceOwnersResponse.ProtoReflect.Descriptor instead.
-func (*ListDormantResourceOwnersResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *ListDormantResourceOwnersResponse) GetOwners() []*DormantResourceOwner {
-	if x != nil {
-		return x.Owners
-	}
-	return nil
+// Deprecated: Use AssignPlanToOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*AssignPlanToOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ListDormantResourceOwnersResponse) GetPagination() *v12.Pagination {
+func (x *AssignPlanToOrganizationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Pagination
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *ListDormantResourceOwnersResponse) GetSummary() *DormantResourceSummary {
+func (x *AssignPlanToOrganizationResponse) GetMessage() string {
 	if x != nil {
-		return x.Summary
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-// User Info
-type UserInfo struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email             string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	PreferredUsername string                 `protobuf:"bytes,4,opt,name=preferred_username,json=preferredUsername,proto3" json:"preferred_username,omitempty"`
-	Locale            string                 `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
-	EmailVerified     bool                   `protobuf:"varint,6,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
-	AvatarUrl         *string                `protobuf:"bytes,7,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
-	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Roles             []string               `protobuf:"bytes,10,rep,name=roles,proto3" json:"roles,omitempty"` // User roles (e.g., "superadmin")
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// List Users Request
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`                      // Page number (default: 1)
+	PerPage       *int32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3,oneof" json:"per_page,omitempty"` // Results per page (default: 50, max: 100)
+	Search        *string                `protobuf:"bytes,3,opt,name=search,proto3,oneof" json:"search,omitempty"`                   // Search by email, name, or user ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserInfo) Reset() {
-	*x = UserInfo{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[69]
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserInfo) String() string {
+func (x *ListUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo) ProtoMessage() {}
+func (*ListUsersRequest) ProtoMessage() {}
 
-func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[69]
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4951,108 +4689,108 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
-func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *UserInfo) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *ListUsersRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UserInfo) GetEmail() string {
-	if x != nil {
-		return x.Email
+func (x *ListUsersRequest) GetPerPage() int32 {
+	if x != nil && x.PerPage != nil {
+		return *x.PerPage
 	}
-	return ""
+	return 0
 }
 
-func (x *UserInfo) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *ListUsersRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
 	}
 	return ""
 }
 
-func (x *UserInfo) GetPreferredUsername() string {
-	if x != nil {
-		return x.PreferredUsername
-	}
-	return ""
+// List Users Response
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserInfo            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Pagination    *v12.Pagination        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserInfo) GetLocale() string {
-	if x != nil {
-		return x.Locale
-	}
-	return ""
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserInfo) GetEmailVerified() bool {
-	if x != nil {
-		return x.EmailVerified
-	}
-	return false
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfo) GetAvatarUrl() string {
-	if x != nil && x.AvatarUrl != nil {
-		return *x.AvatarUrl
-	}
-	return ""
-}
+func (*ListUsersResponse) ProtoMessage() {}
 
-func (x *UserInfo) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[67]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfo) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListUsersResponse) GetUsers() []*UserInfo {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Users
 	}
 	return nil
 }
 
-func (x *UserInfo) GetRoles() []string {
+func (x *ListUsersResponse) GetPagination() *v12.Pagination {
 	if x != nil {
-		return x.Roles
+		return x.Pagination
 	}
 	return nil
 }
 
-// User Organization
-type UserOrganization struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
-	Role             string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`     // Member role in this organization
-	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "active" or "invited"
-	JoinedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Get User Request
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID to fetch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserOrganization) Reset() {
-	*x = UserOrganization{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[70]
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserOrganization) String() string {
+func (x *GetUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserOrganization) ProtoMessage() {}
+func (*GetUserRequest) ProtoMessage() {}
 
-func (x *UserOrganization) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[70]
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5063,73 +4801,42 @@ func (x *UserOrganization) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserOrganization.ProtoReflect.Descriptor instead.
-func (*UserOrganization) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{70}
-}
-
-func (x *UserOrganization) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
-}
-
-func (x *UserOrganization) GetOrganizationName() string {
-	if x != nil {
-		return x.OrganizationName
-	}
-	return ""
-}
-
-func (x *UserOrganization) GetRole() string {
-	if x != nil {
-		return x.Role
-	}
-	return ""
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UserOrganization) GetStatus() string {
+func (x *GetUserRequest) GetUserId() string {
 	if x != nil {
-		return x.Status
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UserOrganization) GetJoinedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.JoinedAt
-	}
-	return nil
-}
-
-// List All VPS Request
-type ListAllVPSRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Filter by organization ID (optional)
-	Status         *v13.VPSStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=obiente.cloud.vps.v1.VPSStatus,oneof" json:"status,omitempty"`  // Filter by status (optional)
-	Page           *int32                 `protobuf:"varint,3,opt,name=page,proto3,oneof" json:"page,omitempty"`                                          // Page number (default: 1)
-	PerPage        *int32                 `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3,oneof" json:"per_page,omitempty"`                     // Results per page (default: 50, max: 100)
-	Search         *string                `protobuf:"bytes,5,opt,name=search,proto3,oneof" json:"search,omitempty"`                                       // Search by name, ID, organization ID, region, size
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Get User Response
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Organizations []*UserOrganization    `protobuf:"bytes,2,rep,name=organizations,proto3" json:"organizations,omitempty"` // Organizations this user belongs to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllVPSRequest) Reset() {
-	*x = ListAllVPSRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[71]
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllVPSRequest) String() string {
+func (x *GetUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllVPSRequest) ProtoMessage() {}
+func (*GetUserResponse) ProtoMessage() {}
 
-func (x *ListAllVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[71]
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5140,72 +4847,51 @@ func (x *ListAllVPSRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllVPSRequest.ProtoReflect.Descriptor instead.
-func (*ListAllVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *ListAllVPSRequest) GetOrganizationId() string {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
-	}
-	return ""
-}
-
-func (x *ListAllVPSRequest) GetStatus() v13.VPSStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return v13.VPSStatus(0)
-}
-
-func (x *ListAllVPSRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
-	}
-	return 0
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ListAllVPSRequest) GetPerPage() int32 {
-	if x != nil && x.PerPage != nil {
-		return *x.PerPage
+func (x *GetUserResponse) GetUser() *UserInfo {
+	if x != nil {
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-func (x *ListAllVPSRequest) GetSearch() string {
-	if x != nil && x.Search != nil {
-		return *x.Search
+func (x *GetUserResponse) GetOrganizations() []*UserOrganization {
+	if x != nil {
+		return x.Organizations
 	}
-	return ""
+	return nil
 }
 
-// VPS Overview
-type VPSOverview struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Vps              *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
-	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"` // Organization name (for display)
-	OwnerId          *string                `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`                      // Organization owner user ID
-	OwnerName        *string                `protobuf:"bytes,4,opt,name=owner_name,json=ownerName,proto3,oneof" json:"owner_name,omitempty"`                // Organization owner name or email
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// List Dormant Resource Owners Request
+type ListDormantResourceOwnersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`                                                // Page number (default: 1)
+	PerPage         *int32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3,oneof" json:"per_page,omitempty"`                           // Results per page (default: 25, max: 100)
+	Search          *string                `protobuf:"bytes,3,opt,name=search,proto3,oneof" json:"search,omitempty"`                                             // Search by user or organization metadata
+	MinInactiveDays *int32                 `protobuf:"varint,4,opt,name=min_inactive_days,json=minInactiveDays,proto3,oneof" json:"min_inactive_days,omitempty"` // Minimum days since last observed activity (default: 30)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *VPSOverview) Reset() {
-	*x = VPSOverview{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[72]
+func (x *ListDormantResourceOwnersRequest) Reset() {
+	*x = ListDormantResourceOwnersRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VPSOverview) String() string {
+func (x *ListDormantResourceOwnersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VPSOverview) ProtoMessage() {}
+func (*ListDormantResourceOwnersRequest) ProtoMessage() {}
 
-func (x *VPSOverview) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[72]
+func (x *ListDormantResourceOwnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5216,63 +4902,67 @@ func (x *VPSOverview) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VPSOverview.ProtoReflect.Descriptor instead.
-func (*VPSOverview) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use ListDormantResourceOwnersRequest.ProtoReflect.Descriptor instead.
+func (*ListDormantResourceOwnersRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *VPSOverview) GetVps() *v13.VPSInstance {
-	if x != nil {
-		return x.Vps
+func (x *ListDormantResourceOwnersRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *VPSOverview) GetOrganizationName() string {
-	if x != nil {
-		return x.OrganizationName
+func (x *ListDormantResourceOwnersRequest) GetPerPage() int32 {
+	if x != nil && x.PerPage != nil {
+		return *x.PerPage
 	}
-	return ""
+	return 0
 }
 
-func (x *VPSOverview) GetOwnerId() string {
-	if x != nil && x.OwnerId != nil {
-		return *x.OwnerId
+func (x *ListDormantResourceOwnersRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
 	}
 	return ""
 }
 
-func (x *VPSOverview) GetOwnerName() string {
-	if x != nil && x.OwnerName != nil {
-		return *x.OwnerName
+func (x *ListDormantResourceOwnersRequest) GetMinInactiveDays() int32 {
+	if x != nil && x.MinInactiveDays != nil {
+		return *x.MinInactiveDays
 	}
-	return ""
+	return 0
 }
 
-// List All VPS Response
-type ListAllVPSResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VpsInstances  []*VPSOverview         `protobuf:"bytes,1,rep,name=vps_instances,json=vpsInstances,proto3" json:"vps_instances,omitempty"`
-	Pagination    *v12.Pagination        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Dormant resource summary for the current filter set
+type DormantResourceSummary struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DormantUsers         int32                  `protobuf:"varint,1,opt,name=dormant_users,json=dormantUsers,proto3" json:"dormant_users,omitempty"`
+	UsersWithVps         int32                  `protobuf:"varint,2,opt,name=users_with_vps,json=usersWithVps,proto3" json:"users_with_vps,omitempty"`
+	UsersWithDatabases   int32                  `protobuf:"varint,3,opt,name=users_with_databases,json=usersWithDatabases,proto3" json:"users_with_databases,omitempty"`
+	UsersWithDeployments int32                  `protobuf:"varint,4,opt,name=users_with_deployments,json=usersWithDeployments,proto3" json:"users_with_deployments,omitempty"`
+	UsersWithGameServers int32                  `protobuf:"varint,5,opt,name=users_with_game_servers,json=usersWithGameServers,proto3" json:"users_with_game_servers,omitempty"`
+	TotalReservedBytes   int64                  `protobuf:"varint,6,opt,name=total_reserved_bytes,json=totalReservedBytes,proto3" json:"total_reserved_bytes,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *ListAllVPSResponse) Reset() {
-	*x = ListAllVPSResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[73]
+func (x *DormantResourceSummary) Reset() {
+	*x = DormantResourceSummary{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllVPSResponse) String() string {
+func (x *DormantResourceSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllVPSResponse) ProtoMessage() {}
+func (*DormantResourceSummary) ProtoMessage() {}
 
-func (x *ListAllVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[73]
+func (x *DormantResourceSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5283,101 +4973,82 @@ func (x *ListAllVPSResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllVPSResponse.ProtoReflect.Descriptor instead.
-func (*ListAllVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use DormantResourceSummary.ProtoReflect.Descriptor instead.
+func (*DormantResourceSummary) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ListAllVPSResponse) GetVpsInstances() []*VPSOverview {
+func (x *DormantResourceSummary) GetDormantUsers() int32 {
 	if x != nil {
-		return x.VpsInstances
+		return x.DormantUsers
 	}
-	return nil
+	return 0
 }
 
-func (x *ListAllVPSResponse) GetPagination() *v12.Pagination {
+func (x *DormantResourceSummary) GetUsersWithVps() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.UsersWithVps
 	}
-	return nil
-}
-
-// List VPS Sizes Request
-type ListVPSSizesRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Region             *string                `protobuf:"bytes,1,opt,name=region,proto3,oneof" json:"region,omitempty"`                                                    // Filter by region (optional)
-	IncludeUnavailable *bool                  `protobuf:"varint,2,opt,name=include_unavailable,json=includeUnavailable,proto3,oneof" json:"include_unavailable,omitempty"` // Include unavailable sizes (default: false)
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *ListVPSSizesRequest) Reset() {
-	*x = ListVPSSizesRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[74]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListVPSSizesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*ListVPSSizesRequest) ProtoMessage() {}
-
-func (x *ListVPSSizesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[74]
+func (x *DormantResourceSummary) GetUsersWithDatabases() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UsersWithDatabases
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListVPSSizesRequest.ProtoReflect.Descriptor instead.
-func (*ListVPSSizesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{74}
+func (x *DormantResourceSummary) GetUsersWithDeployments() int32 {
+	if x != nil {
+		return x.UsersWithDeployments
+	}
+	return 0
 }
 
-func (x *ListVPSSizesRequest) GetRegion() string {
-	if x != nil && x.Region != nil {
-		return *x.Region
+func (x *DormantResourceSummary) GetUsersWithGameServers() int32 {
+	if x != nil {
+		return x.UsersWithGameServers
 	}
-	return ""
+	return 0
 }
 
-func (x *ListVPSSizesRequest) GetIncludeUnavailable() bool {
-	if x != nil && x.IncludeUnavailable != nil {
-		return *x.IncludeUnavailable
+func (x *DormantResourceSummary) GetTotalReservedBytes() int64 {
+	if x != nil {
+		return x.TotalReservedBytes
 	}
-	return false
+	return 0
 }
 
-// List VPS Sizes Response
-type ListVPSSizesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sizes         []*v12.VPSSize         `protobuf:"bytes,1,rep,name=sizes,proto3" json:"sizes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Organization-specific retained resources for a dormant user
+type DormantResourceOrganization struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	VpsCount         int32                  `protobuf:"varint,3,opt,name=vps_count,json=vpsCount,proto3" json:"vps_count,omitempty"`
+	DatabaseCount    int32                  `protobuf:"varint,4,opt,name=database_count,json=databaseCount,proto3" json:"database_count,omitempty"`
+	DeploymentCount  int32                  `protobuf:"varint,5,opt,name=deployment_count,json=deploymentCount,proto3" json:"deployment_count,omitempty"`
+	GameServerCount  int32                  `protobuf:"varint,6,opt,name=game_server_count,json=gameServerCount,proto3" json:"game_server_count,omitempty"`
+	ReservedBytes    int64                  `protobuf:"varint,7,opt,name=reserved_bytes,json=reservedBytes,proto3" json:"reserved_bytes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListVPSSizesResponse) Reset() {
-	*x = ListVPSSizesResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[75]
+func (x *DormantResourceOrganization) Reset() {
+	*x = DormantResourceOrganization{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVPSSizesResponse) String() string {
+func (x *DormantResourceOrganization) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVPSSizesResponse) ProtoMessage() {}
+func (*DormantResourceOrganization) ProtoMessage() {}
 
-func (x *ListVPSSizesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[75]
+func (x *DormantResourceOrganization) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5388,50 +5059,95 @@ func (x *ListVPSSizesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVPSSizesResponse.ProtoReflect.Descriptor instead.
-func (*ListVPSSizesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use DormantResourceOrganization.ProtoReflect.Descriptor instead.
+func (*DormantResourceOrganization) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListVPSSizesResponse) GetSizes() []*v12.VPSSize {
+func (x *DormantResourceOrganization) GetOrganizationId() string {
 	if x != nil {
-		return x.Sizes
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-// Create VPS Size Request
-type CreateVPSSizeRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                 // Size ID (e.g., "small", "medium", "custom-1")
-	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                             // Display name
-	Description         string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                               // Description
-	CpuCores            int32                  `protobuf:"varint,4,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`                                    // Number of CPU cores
-	MemoryBytes         int64                  `protobuf:"varint,5,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`                           // Memory in bytes
-	DiskBytes           int64                  `protobuf:"varint,6,opt,name=disk_bytes,json=diskBytes,proto3" json:"disk_bytes,omitempty"`                                 // Disk space in bytes
-	BandwidthBytesMonth int64                  `protobuf:"varint,7,opt,name=bandwidth_bytes_month,json=bandwidthBytesMonth,proto3" json:"bandwidth_bytes_month,omitempty"` // Monthly bandwidth limit (0 = unlimited)
-	MinimumPaymentCents int64                  `protobuf:"varint,8,opt,name=minimum_payment_cents,json=minimumPaymentCents,proto3" json:"minimum_payment_cents,omitempty"` // Minimum payment in cents required to create this VPS size (0 = no requirement)
-	Available           bool                   `protobuf:"varint,9,opt,name=available,proto3" json:"available,omitempty"`                                                  // Whether this size is available
-	Region              string                 `protobuf:"bytes,10,opt,name=region,proto3" json:"region,omitempty"`                                                        // Region (empty = all regions)
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *DormantResourceOrganization) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
 }
 
-func (x *CreateVPSSizeRequest) Reset() {
-	*x = CreateVPSSizeRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[76]
+func (x *DormantResourceOrganization) GetVpsCount() int32 {
+	if x != nil {
+		return x.VpsCount
+	}
+	return 0
+}
+
+func (x *DormantResourceOrganization) GetDatabaseCount() int32 {
+	if x != nil {
+		return x.DatabaseCount
+	}
+	return 0
+}
+
+func (x *DormantResourceOrganization) GetDeploymentCount() int32 {
+	if x != nil {
+		return x.DeploymentCount
+	}
+	return 0
+}
+
+func (x *DormantResourceOrganization) GetGameServerCount() int32 {
+	if x != nil {
+		return x.GameServerCount
+	}
+	return 0
+}
+
+func (x *DormantResourceOrganization) GetReservedBytes() int64 {
+	if x != nil {
+		return x.ReservedBytes
+	}
+	return 0
+}
+
+// Dormant resource owner details
+type DormantResourceOwner struct {
+	state                 protoimpl.MessageState         `protogen:"open.v1"`
+	User                  *UserInfo                      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	LastActivityAt        *timestamppb.Timestamp         `protobuf:"bytes,2,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	LastActivitySource    string                         `protobuf:"bytes,3,opt,name=last_activity_source,json=lastActivitySource,proto3" json:"last_activity_source,omitempty"` // audit_log | profile_updated | profile_created | resource_updated | resource_created | unknown
+	InactiveDays          int32                          `protobuf:"varint,4,opt,name=inactive_days,json=inactiveDays,proto3" json:"inactive_days,omitempty"`
+	OrganizationCount     int32                          `protobuf:"varint,5,opt,name=organization_count,json=organizationCount,proto3" json:"organization_count,omitempty"`
+	VpsCount              int32                          `protobuf:"varint,6,opt,name=vps_count,json=vpsCount,proto3" json:"vps_count,omitempty"`
+	DatabaseCount         int32                          `protobuf:"varint,7,opt,name=database_count,json=databaseCount,proto3" json:"database_count,omitempty"`
+	DeploymentCount       int32                          `protobuf:"varint,8,opt,name=deployment_count,json=deploymentCount,proto3" json:"deployment_count,omitempty"`
+	GameServerCount       int32                          `protobuf:"varint,9,opt,name=game_server_count,json=gameServerCount,proto3" json:"game_server_count,omitempty"`
+	TotalReservedBytes    int64                          `protobuf:"varint,10,opt,name=total_reserved_bytes,json=totalReservedBytes,proto3" json:"total_reserved_bytes,omitempty"`
+	LastResourceCreatedAt *timestamppb.Timestamp         `protobuf:"bytes,11,opt,name=last_resource_created_at,json=lastResourceCreatedAt,proto3" json:"last_resource_created_at,omitempty"`
+	LastResourceUpdatedAt *timestamppb.Timestamp         `protobuf:"bytes,12,opt,name=last_resource_updated_at,json=lastResourceUpdatedAt,proto3" json:"last_resource_updated_at,omitempty"`
+	Organizations         []*DormantResourceOrganization `protobuf:"bytes,13,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DormantResourceOwner) Reset() {
+	*x = DormantResourceOwner{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVPSSizeRequest) String() string {
+func (x *DormantResourceOwner) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVPSSizeRequest) ProtoMessage() {}
+func (*DormantResourceOwner) ProtoMessage() {}
 
-func (x *CreateVPSSizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[76]
+func (x *DormantResourceOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5442,158 +5158,127 @@ func (x *CreateVPSSizeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVPSSizeRequest.ProtoReflect.Descriptor instead.
-func (*CreateVPSSizeRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use DormantResourceOwner.ProtoReflect.Descriptor instead.
+func (*DormantResourceOwner) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *CreateVPSSizeRequest) GetId() string {
+func (x *DormantResourceOwner) GetUser() *UserInfo {
 	if x != nil {
-		return x.Id
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateVPSSizeRequest) GetName() string {
+func (x *DormantResourceOwner) GetLastActivityAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.LastActivityAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateVPSSizeRequest) GetDescription() string {
+func (x *DormantResourceOwner) GetLastActivitySource() string {
 	if x != nil {
-		return x.Description
+		return x.LastActivitySource
 	}
 	return ""
 }
 
-func (x *CreateVPSSizeRequest) GetCpuCores() int32 {
+func (x *DormantResourceOwner) GetInactiveDays() int32 {
 	if x != nil {
-		return x.CpuCores
+		return x.InactiveDays
 	}
 	return 0
 }
 
-func (x *CreateVPSSizeRequest) GetMemoryBytes() int64 {
+func (x *DormantResourceOwner) GetOrganizationCount() int32 {
 	if x != nil {
-		return x.MemoryBytes
+		return x.OrganizationCount
 	}
 	return 0
 }
 
-func (x *CreateVPSSizeRequest) GetDiskBytes() int64 {
+func (x *DormantResourceOwner) GetVpsCount() int32 {
 	if x != nil {
-		return x.DiskBytes
+		return x.VpsCount
 	}
 	return 0
 }
 
-func (x *CreateVPSSizeRequest) GetBandwidthBytesMonth() int64 {
+func (x *DormantResourceOwner) GetDatabaseCount() int32 {
 	if x != nil {
-		return x.BandwidthBytesMonth
+		return x.DatabaseCount
 	}
 	return 0
 }
 
-func (x *CreateVPSSizeRequest) GetMinimumPaymentCents() int64 {
+func (x *DormantResourceOwner) GetDeploymentCount() int32 {
 	if x != nil {
-		return x.MinimumPaymentCents
+		return x.DeploymentCount
 	}
 	return 0
 }
 
-func (x *CreateVPSSizeRequest) GetAvailable() bool {
+func (x *DormantResourceOwner) GetGameServerCount() int32 {
 	if x != nil {
-		return x.Available
+		return x.GameServerCount
 	}
-	return false
+	return 0
 }
 
-func (x *CreateVPSSizeRequest) GetRegion() string {
+func (x *DormantResourceOwner) GetTotalReservedBytes() int64 {
 	if x != nil {
-		return x.Region
+		return x.TotalReservedBytes
 	}
-	return ""
-}
-
-// Create VPS Size Response
-type CreateVPSSizeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Size          *v12.VPSSize           `protobuf:"bytes,1,opt,name=size,proto3" json:"size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateVPSSizeResponse) Reset() {
-	*x = CreateVPSSizeResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[77]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateVPSSizeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*CreateVPSSizeResponse) ProtoMessage() {}
-
-func (x *CreateVPSSizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[77]
+func (x *DormantResourceOwner) GetLastResourceCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastResourceCreatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateVPSSizeResponse.ProtoReflect.Descriptor instead.
-func (*CreateVPSSizeResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{77}
+func (x *DormantResourceOwner) GetLastResourceUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastResourceUpdatedAt
+	}
+	return nil
 }
 
-func (x *CreateVPSSizeResponse) GetSize() *v12.VPSSize {
+func (x *DormantResourceOwner) GetOrganizations() []*DormantResourceOrganization {
 	if x != nil {
-		return x.Size
+		return x.Organizations
 	}
 	return nil
 }
 
-// Update VPS Size Request
-type UpdateVPSSizeRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Size ID to update
-	Name                *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description         *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	CpuCores            *int32                 `protobuf:"varint,4,opt,name=cpu_cores,json=cpuCores,proto3,oneof" json:"cpu_cores,omitempty"`
-	MemoryBytes         *int64                 `protobuf:"varint,5,opt,name=memory_bytes,json=memoryBytes,proto3,oneof" json:"memory_bytes,omitempty"`
-	DiskBytes           *int64                 `protobuf:"varint,6,opt,name=disk_bytes,json=diskBytes,proto3,oneof" json:"disk_bytes,omitempty"`
-	BandwidthBytesMonth *int64                 `protobuf:"varint,7,opt,name=bandwidth_bytes_month,json=bandwidthBytesMonth,proto3,oneof" json:"bandwidth_bytes_month,omitempty"`
-	MinimumPaymentCents *int64                 `protobuf:"varint,8,opt,name=minimum_payment_cents,json=minimumPaymentCents,proto3,oneof" json:"minimum_payment_cents,omitempty"` // Minimum payment in cents required to create this VPS size (0 = no requirement)
-	Available           *bool                  `protobuf:"varint,9,opt,name=available,proto3,oneof" json:"available,omitempty"`
-	Region              *string                `protobuf:"bytes,10,opt,name=region,proto3,oneof" json:"region,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+// List Dormant Resource Owners Response
+type ListDormantResourceOwnersResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Owners        []*DormantResourceOwner `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
+	Pagination    *v12.Pagination         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Summary       *DormantResourceSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVPSSizeRequest) Reset() {
-	*x = UpdateVPSSizeRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[78]
+func (x *ListDormantResourceOwnersResponse) Reset() {
+	*x = ListDormantResourceOwnersResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVPSSizeRequest) String() string {
+func (x *ListDormantResourceOwnersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVPSSizeRequest) ProtoMessage() {}
+func (*ListDormantResourceOwnersResponse) ProtoMessage() {}
 
-func (x *UpdateVPSSizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[78]
+func (x *ListDormantResourceOwnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5604,149 +5289,176 @@ func (x *UpdateVPSSizeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVPSSizeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateVPSSizeRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use ListDormantResourceOwnersResponse.ProtoReflect.Descriptor instead.
+func (*ListDormantResourceOwnersResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *UpdateVPSSizeRequest) GetId() string {
+func (x *ListDormantResourceOwnersResponse) GetOwners() []*DormantResourceOwner {
 	if x != nil {
-		return x.Id
+		return x.Owners
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateVPSSizeRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *ListDormantResourceOwnersResponse) GetPagination() *v12.Pagination {
+	if x != nil {
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateVPSSizeRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ListDormantResourceOwnersResponse) GetSummary() *DormantResourceSummary {
+	if x != nil {
+		return x.Summary
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateVPSSizeRequest) GetCpuCores() int32 {
-	if x != nil && x.CpuCores != nil {
-		return *x.CpuCores
-	}
-	return 0
+// User Info
+type UserInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email             string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PreferredUsername string                 `protobuf:"bytes,4,opt,name=preferred_username,json=preferredUsername,proto3" json:"preferred_username,omitempty"`
+	Locale            string                 `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	EmailVerified     bool                   `protobuf:"varint,6,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	AvatarUrl         *string                `protobuf:"bytes,7,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Roles             []string               `protobuf:"bytes,10,rep,name=roles,proto3" json:"roles,omitempty"` // User roles (e.g., "superadmin")
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *UpdateVPSSizeRequest) GetMemoryBytes() int64 {
-	if x != nil && x.MemoryBytes != nil {
-		return *x.MemoryBytes
-	}
-	return 0
+func (x *UserInfo) Reset() {
+	*x = UserInfo{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVPSSizeRequest) GetDiskBytes() int64 {
-	if x != nil && x.DiskBytes != nil {
-		return *x.DiskBytes
-	}
-	return 0
+func (x *UserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateVPSSizeRequest) GetBandwidthBytesMonth() int64 {
-	if x != nil && x.BandwidthBytesMonth != nil {
-		return *x.BandwidthBytesMonth
+func (*UserInfo) ProtoMessage() {}
+
+func (x *UserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateVPSSizeRequest) GetMinimumPaymentCents() int64 {
-	if x != nil && x.MinimumPaymentCents != nil {
-		return *x.MinimumPaymentCents
+// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
+func (*UserInfo) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UserInfo) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateVPSSizeRequest) GetAvailable() bool {
-	if x != nil && x.Available != nil {
-		return *x.Available
+func (x *UserInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateVPSSizeRequest) GetRegion() string {
-	if x != nil && x.Region != nil {
-		return *x.Region
+func (x *UserInfo) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-// Update VPS Size Response
-type UpdateVPSSizeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Size          *v12.VPSSize           `protobuf:"bytes,1,opt,name=size,proto3" json:"size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UserInfo) GetPreferredUsername() string {
+	if x != nil {
+		return x.PreferredUsername
+	}
+	return ""
 }
 
-func (x *UpdateVPSSizeResponse) Reset() {
-	*x = UpdateVPSSizeResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[79]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UserInfo) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
 }
 
-func (x *UpdateVPSSizeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfo) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
 }
 
-func (*UpdateVPSSizeResponse) ProtoMessage() {}
+func (x *UserInfo) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
+	}
+	return ""
+}
 
-func (x *UpdateVPSSizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[79]
+func (x *UserInfo) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UpdateVPSSizeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateVPSSizeResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{79}
+func (x *UserInfo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-func (x *UpdateVPSSizeResponse) GetSize() *v12.VPSSize {
+func (x *UserInfo) GetRoles() []string {
 	if x != nil {
-		return x.Size
+		return x.Roles
 	}
 	return nil
 }
 
-// Delete VPS Size Request
-type DeleteVPSSizeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Size ID to delete
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// User Organization
+type UserOrganization struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	Role             string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`     // Member role in this organization
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "active" or "invited"
+	JoinedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *DeleteVPSSizeRequest) Reset() {
-	*x = DeleteVPSSizeRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[80]
+func (x *UserOrganization) Reset() {
+	*x = UserOrganization{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVPSSizeRequest) String() string {
+func (x *UserOrganization) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVPSSizeRequest) ProtoMessage() {}
+func (*UserOrganization) ProtoMessage() {}
 
-func (x *DeleteVPSSizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[80]
+func (x *UserOrganization) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5757,41 +5469,73 @@ func (x *DeleteVPSSizeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVPSSizeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteVPSSizeRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use UserOrganization.ProtoReflect.Descriptor instead.
+func (*UserOrganization) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *DeleteVPSSizeRequest) GetId() string {
+func (x *UserOrganization) GetOrganizationId() string {
 	if x != nil {
-		return x.Id
+		return x.OrganizationId
 	}
 	return ""
 }
 
-// Delete VPS Size Response
-type DeleteVPSSizeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UserOrganization) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
 }
 
-func (x *DeleteVPSSizeResponse) Reset() {
-	*x = DeleteVPSSizeResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[81]
+func (x *UserOrganization) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UserOrganization) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserOrganization) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// List All VPS Request
+type ListAllVPSRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Filter by organization ID (optional)
+	Status         *v13.VPSStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=obiente.cloud.vps.v1.VPSStatus,oneof" json:"status,omitempty"`  // Filter by status (optional)
+	Page           *int32                 `protobuf:"varint,3,opt,name=page,proto3,oneof" json:"page,omitempty"`                                          // Page number (default: 1)
+	PerPage        *int32                 `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3,oneof" json:"per_page,omitempty"`                     // Results per page (default: 50, max: 100)
+	Search         *string                `protobuf:"bytes,5,opt,name=search,proto3,oneof" json:"search,omitempty"`                                       // Search by name, ID, organization ID, region, size
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAllVPSRequest) Reset() {
+	*x = ListAllVPSRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVPSSizeResponse) String() string {
+func (x *ListAllVPSRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVPSSizeResponse) ProtoMessage() {}
+func (*ListAllVPSRequest) ProtoMessage() {}
 
-func (x *DeleteVPSSizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[81]
+func (x *ListAllVPSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5802,41 +5546,2471 @@ func (x *DeleteVPSSizeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVPSSizeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVPSSizeResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use ListAllVPSRequest.ProtoReflect.Descriptor instead.
+func (*ListAllVPSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *DeleteVPSSizeResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
+func (x *ListAllVPSRequest) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
 	}
-	return false
+	return ""
 }
 
-// Superadmin Get VPS Request (superadmin - bypasses organization checks)
-type SuperadminGetVPSRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"` // VPS ID to fetch
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListAllVPSRequest) GetStatus() v13.VPSStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return v13.VPSStatus(0)
+}
+
+func (x *ListAllVPSRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListAllVPSRequest) GetPerPage() int32 {
+	if x != nil && x.PerPage != nil {
+		return *x.PerPage
+	}
+	return 0
+}
+
+func (x *ListAllVPSRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+// VPS Overview
+type VPSOverview struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Vps              *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"` // Organization name (for display)
+	OwnerId          *string                `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`                      // Organization owner user ID
+	OwnerName        *string                `protobuf:"bytes,4,opt,name=owner_name,json=ownerName,proto3,oneof" json:"owner_name,omitempty"`                // Organization owner name or email
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VPSOverview) Reset() {
+	*x = VPSOverview{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VPSOverview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPSOverview) ProtoMessage() {}
+
+func (x *VPSOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VPSOverview.ProtoReflect.Descriptor instead.
+func (*VPSOverview) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *VPSOverview) GetVps() *v13.VPSInstance {
+	if x != nil {
+		return x.Vps
+	}
+	return nil
+}
+
+func (x *VPSOverview) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *VPSOverview) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *VPSOverview) GetOwnerName() string {
+	if x != nil && x.OwnerName != nil {
+		return *x.OwnerName
+	}
+	return ""
+}
+
+// List All VPS Response
+type ListAllVPSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VpsInstances  []*VPSOverview         `protobuf:"bytes,1,rep,name=vps_instances,json=vpsInstances,proto3" json:"vps_instances,omitempty"`
+	Pagination    *v12.Pagination        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllVPSResponse) Reset() {
+	*x = ListAllVPSResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllVPSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllVPSResponse) ProtoMessage() {}
+
+func (x *ListAllVPSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllVPSResponse.ProtoReflect.Descriptor instead.
+func (*ListAllVPSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListAllVPSResponse) GetVpsInstances() []*VPSOverview {
+	if x != nil {
+		return x.VpsInstances
+	}
+	return nil
+}
+
+func (x *ListAllVPSResponse) GetPagination() *v12.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// List VPS Sizes Request
+type ListVPSSizesRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Region             *string                `protobuf:"bytes,1,opt,name=region,proto3,oneof" json:"region,omitempty"`                                                    // Filter by region (optional)
+	IncludeUnavailable *bool                  `protobuf:"varint,2,opt,name=include_unavailable,json=includeUnavailable,proto3,oneof" json:"include_unavailable,omitempty"` // Include unavailable sizes (default: false)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListVPSSizesRequest) Reset() {
+	*x = ListVPSSizesRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVPSSizesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVPSSizesRequest) ProtoMessage() {}
+
+func (x *ListVPSSizesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVPSSizesRequest.ProtoReflect.Descriptor instead.
+func (*ListVPSSizesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListVPSSizesRequest) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+func (x *ListVPSSizesRequest) GetIncludeUnavailable() bool {
+	if x != nil && x.IncludeUnavailable != nil {
+		return *x.IncludeUnavailable
+	}
+	return false
+}
+
+// List VPS Sizes Response
+type ListVPSSizesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sizes         []*v12.VPSSize         `protobuf:"bytes,1,rep,name=sizes,proto3" json:"sizes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVPSSizesResponse) Reset() {
+	*x = ListVPSSizesResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVPSSizesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVPSSizesResponse) ProtoMessage() {}
+
+func (x *ListVPSSizesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVPSSizesResponse.ProtoReflect.Descriptor instead.
+func (*ListVPSSizesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListVPSSizesResponse) GetSizes() []*v12.VPSSize {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+// Create VPS Size Request
+type CreateVPSSizeRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                 // Size ID (e.g., "small", "medium", "custom-1")
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                             // Display name
+	Description         string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                               // Description
+	CpuCores            int32                  `protobuf:"varint,4,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`                                    // Number of CPU cores
+	MemoryBytes         int64                  `protobuf:"varint,5,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`                           // Memory in bytes
+	DiskBytes           int64                  `protobuf:"varint,6,opt,name=disk_bytes,json=diskBytes,proto3" json:"disk_bytes,omitempty"`                                 // Disk space in bytes
+	BandwidthBytesMonth int64                  `protobuf:"varint,7,opt,name=bandwidth_bytes_month,json=bandwidthBytesMonth,proto3" json:"bandwidth_bytes_month,omitempty"` // Monthly bandwidth limit (0 = unlimited)
+	MinimumPaymentCents int64                  `protobuf:"varint,8,opt,name=minimum_payment_cents,json=minimumPaymentCents,proto3" json:"minimum_payment_cents,omitempty"` // Minimum payment in cents required to create this VPS size (0 = no requirement)
+	Available           bool                   `protobuf:"varint,9,opt,name=available,proto3" json:"available,omitempty"`                                                  // Whether this size is available
+	Region              string                 `protobuf:"bytes,10,opt,name=region,proto3" json:"region,omitempty"`                                                        // Region (empty = all regions)
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateVPSSizeRequest) Reset() {
+	*x = CreateVPSSizeRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVPSSizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVPSSizeRequest) ProtoMessage() {}
+
+func (x *CreateVPSSizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVPSSizeRequest.ProtoReflect.Descriptor instead.
+func (*CreateVPSSizeRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateVPSSizeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateVPSSizeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVPSSizeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateVPSSizeRequest) GetCpuCores() int32 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
+}
+
+func (x *CreateVPSSizeRequest) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *CreateVPSSizeRequest) GetDiskBytes() int64 {
+	if x != nil {
+		return x.DiskBytes
+	}
+	return 0
+}
+
+func (x *CreateVPSSizeRequest) GetBandwidthBytesMonth() int64 {
+	if x != nil {
+		return x.BandwidthBytesMonth
+	}
+	return 0
+}
+
+func (x *CreateVPSSizeRequest) GetMinimumPaymentCents() int64 {
+	if x != nil {
+		return x.MinimumPaymentCents
+	}
+	return 0
+}
+
+func (x *CreateVPSSizeRequest) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *CreateVPSSizeRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+// Create VPS Size Response
+type CreateVPSSizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          *v12.VPSSize           `protobuf:"bytes,1,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVPSSizeResponse) Reset() {
+	*x = CreateVPSSizeResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVPSSizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVPSSizeResponse) ProtoMessage() {}
+
+func (x *CreateVPSSizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVPSSizeResponse.ProtoReflect.Descriptor instead.
+func (*CreateVPSSizeResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CreateVPSSizeResponse) GetSize() *v12.VPSSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+// Update VPS Size Request
+type UpdateVPSSizeRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Size ID to update
+	Name                *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description         *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CpuCores            *int32                 `protobuf:"varint,4,opt,name=cpu_cores,json=cpuCores,proto3,oneof" json:"cpu_cores,omitempty"`
+	MemoryBytes         *int64                 `protobuf:"varint,5,opt,name=memory_bytes,json=memoryBytes,proto3,oneof" json:"memory_bytes,omitempty"`
+	DiskBytes           *int64                 `protobuf:"varint,6,opt,name=disk_bytes,json=diskBytes,proto3,oneof" json:"disk_bytes,omitempty"`
+	BandwidthBytesMonth *int64                 `protobuf:"varint,7,opt,name=bandwidth_bytes_month,json=bandwidthBytesMonth,proto3,oneof" json:"bandwidth_bytes_month,omitempty"`
+	MinimumPaymentCents *int64                 `protobuf:"varint,8,opt,name=minimum_payment_cents,json=minimumPaymentCents,proto3,oneof" json:"minimum_payment_cents,omitempty"` // Minimum payment in cents required to create this VPS size (0 = no requirement)
+	Available           *bool                  `protobuf:"varint,9,opt,name=available,proto3,oneof" json:"available,omitempty"`
+	Region              *string                `protobuf:"bytes,10,opt,name=region,proto3,oneof" json:"region,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateVPSSizeRequest) Reset() {
+	*x = UpdateVPSSizeRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVPSSizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVPSSizeRequest) ProtoMessage() {}
+
+func (x *UpdateVPSSizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVPSSizeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVPSSizeRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateVPSSizeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateVPSSizeRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateVPSSizeRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateVPSSizeRequest) GetCpuCores() int32 {
+	if x != nil && x.CpuCores != nil {
+		return *x.CpuCores
+	}
+	return 0
+}
+
+func (x *UpdateVPSSizeRequest) GetMemoryBytes() int64 {
+	if x != nil && x.MemoryBytes != nil {
+		return *x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *UpdateVPSSizeRequest) GetDiskBytes() int64 {
+	if x != nil && x.DiskBytes != nil {
+		return *x.DiskBytes
+	}
+	return 0
+}
+
+func (x *UpdateVPSSizeRequest) GetBandwidthBytesMonth() int64 {
+	if x != nil && x.BandwidthBytesMonth != nil {
+		return *x.BandwidthBytesMonth
+	}
+	return 0
+}
+
+func (x *UpdateVPSSizeRequest) GetMinimumPaymentCents() int64 {
+	if x != nil && x.MinimumPaymentCents != nil {
+		return *x.MinimumPaymentCents
+	}
+	return 0
+}
+
+func (x *UpdateVPSSizeRequest) GetAvailable() bool {
+	if x != nil && x.Available != nil {
+		return *x.Available
+	}
+	return false
+}
+
+func (x *UpdateVPSSizeRequest) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+// Update VPS Size Response
+type UpdateVPSSizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          *v12.VPSSize           `protobuf:"bytes,1,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVPSSizeResponse) Reset() {
+	*x = UpdateVPSSizeResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVPSSizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVPSSizeResponse) ProtoMessage() {}
+
+func (x *UpdateVPSSizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVPSSizeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVPSSizeResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UpdateVPSSizeResponse) GetSize() *v12.VPSSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+// Delete VPS Size Request
+type DeleteVPSSizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Size ID to delete
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVPSSizeRequest) Reset() {
+	*x = DeleteVPSSizeRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVPSSizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVPSSizeRequest) ProtoMessage() {}
+
+func (x *DeleteVPSSizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVPSSizeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVPSSizeRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteVPSSizeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Delete VPS Size Response
+type DeleteVPSSizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVPSSizeResponse) Reset() {
+	*x = DeleteVPSSizeResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVPSSizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVPSSizeResponse) ProtoMessage() {}
+
+func (x *DeleteVPSSizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVPSSizeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVPSSizeResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DeleteVPSSizeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Superadmin Get VPS Request (superadmin - bypasses organization checks)
+type SuperadminGetVPSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"` // VPS ID to fetch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminGetVPSRequest) Reset() {
+	*x = SuperadminGetVPSRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminGetVPSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminGetVPSRequest) ProtoMessage() {}
+
+func (x *SuperadminGetVPSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminGetVPSRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminGetVPSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SuperadminGetVPSRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+// Superadmin Get VPS Response
+type SuperadminGetVPSResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Vps              *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"` // Organization name (for display)
+	CreatedBy        *UserInfo              `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                // User who created the VPS (if available)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SuperadminGetVPSResponse) Reset() {
+	*x = SuperadminGetVPSResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminGetVPSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminGetVPSResponse) ProtoMessage() {}
+
+func (x *SuperadminGetVPSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminGetVPSResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminGetVPSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SuperadminGetVPSResponse) GetVps() *v13.VPSInstance {
+	if x != nil {
+		return x.Vps
+	}
+	return nil
+}
+
+func (x *SuperadminGetVPSResponse) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *SuperadminGetVPSResponse) GetCreatedBy() *UserInfo {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+// Superadmin Resize VPS Request
+type SuperadminResizeVPSRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VpsId          string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`                             // VPS ID to resize
+	NewSize        string                 `protobuf:"bytes,2,opt,name=new_size,json=newSize,proto3" json:"new_size,omitempty"`                       // New VPS size ID (e.g., "small", "medium", "large"). Use "custom" for custom sizing.
+	GrowDisk       bool                   `protobuf:"varint,3,opt,name=grow_disk,json=growDisk,proto3" json:"grow_disk,omitempty"`                   // Whether to grow the disk (default: true)
+	ApplyCloudinit bool                   `protobuf:"varint,4,opt,name=apply_cloudinit,json=applyCloudinit,proto3" json:"apply_cloudinit,omitempty"` // Whether to apply cloud-init for disk growth (default: true)
+	// Custom size options (used when new_size is "custom" or empty)
+	CustomCpuCores    *int32 `protobuf:"varint,5,opt,name=custom_cpu_cores,json=customCpuCores,proto3,oneof" json:"custom_cpu_cores,omitempty"`          // Custom CPU cores (required if using custom size)
+	CustomMemoryBytes *int64 `protobuf:"varint,6,opt,name=custom_memory_bytes,json=customMemoryBytes,proto3,oneof" json:"custom_memory_bytes,omitempty"` // Custom memory in bytes (required if using custom size)
+	CustomDiskBytes   *int64 `protobuf:"varint,7,opt,name=custom_disk_bytes,json=customDiskBytes,proto3,oneof" json:"custom_disk_bytes,omitempty"`       // Custom disk in bytes (required if using custom size)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SuperadminResizeVPSRequest) Reset() {
+	*x = SuperadminResizeVPSRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminResizeVPSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminResizeVPSRequest) ProtoMessage() {}
+
+func (x *SuperadminResizeVPSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminResizeVPSRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminResizeVPSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SuperadminResizeVPSRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+func (x *SuperadminResizeVPSRequest) GetNewSize() string {
+	if x != nil {
+		return x.NewSize
+	}
+	return ""
+}
+
+func (x *SuperadminResizeVPSRequest) GetGrowDisk() bool {
+	if x != nil {
+		return x.GrowDisk
+	}
+	return false
+}
+
+func (x *SuperadminResizeVPSRequest) GetApplyCloudinit() bool {
+	if x != nil {
+		return x.ApplyCloudinit
+	}
+	return false
+}
+
+func (x *SuperadminResizeVPSRequest) GetCustomCpuCores() int32 {
+	if x != nil && x.CustomCpuCores != nil {
+		return *x.CustomCpuCores
+	}
+	return 0
+}
+
+func (x *SuperadminResizeVPSRequest) GetCustomMemoryBytes() int64 {
+	if x != nil && x.CustomMemoryBytes != nil {
+		return *x.CustomMemoryBytes
+	}
+	return 0
+}
+
+func (x *SuperadminResizeVPSRequest) GetCustomDiskBytes() int64 {
+	if x != nil && x.CustomDiskBytes != nil {
+		return *x.CustomDiskBytes
+	}
+	return 0
+}
+
+// Superadmin Resize VPS Response
+type SuperadminResizeVPSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vps           *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Information message about the resize operation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminResizeVPSResponse) Reset() {
+	*x = SuperadminResizeVPSResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminResizeVPSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminResizeVPSResponse) ProtoMessage() {}
+
+func (x *SuperadminResizeVPSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminResizeVPSResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminResizeVPSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SuperadminResizeVPSResponse) GetVps() *v13.VPSInstance {
+	if x != nil {
+		return x.Vps
+	}
+	return nil
+}
+
+func (x *SuperadminResizeVPSResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Superadmin Suspend VPS Request
+type SuperadminSuspendVPSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"` // VPS ID to suspend
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`      // Optional reason for suspension
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminSuspendVPSRequest) Reset() {
+	*x = SuperadminSuspendVPSRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminSuspendVPSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminSuspendVPSRequest) ProtoMessage() {}
+
+func (x *SuperadminSuspendVPSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminSuspendVPSRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminSuspendVPSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SuperadminSuspendVPSRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+func (x *SuperadminSuspendVPSRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// Superadmin Suspend VPS Response
+type SuperadminSuspendVPSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vps           *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminSuspendVPSResponse) Reset() {
+	*x = SuperadminSuspendVPSResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminSuspendVPSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminSuspendVPSResponse) ProtoMessage() {}
+
+func (x *SuperadminSuspendVPSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminSuspendVPSResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminSuspendVPSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SuperadminSuspendVPSResponse) GetVps() *v13.VPSInstance {
+	if x != nil {
+		return x.Vps
+	}
+	return nil
+}
+
+func (x *SuperadminSuspendVPSResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Superadmin Unsuspend VPS Request
+type SuperadminUnsuspendVPSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"` // VPS ID to unsuspend
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminUnsuspendVPSRequest) Reset() {
+	*x = SuperadminUnsuspendVPSRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminUnsuspendVPSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminUnsuspendVPSRequest) ProtoMessage() {}
+
+func (x *SuperadminUnsuspendVPSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminUnsuspendVPSRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminUnsuspendVPSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SuperadminUnsuspendVPSRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+// Superadmin Unsuspend VPS Response
+type SuperadminUnsuspendVPSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vps           *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminUnsuspendVPSResponse) Reset() {
+	*x = SuperadminUnsuspendVPSResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminUnsuspendVPSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminUnsuspendVPSResponse) ProtoMessage() {}
+
+func (x *SuperadminUnsuspendVPSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminUnsuspendVPSResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminUnsuspendVPSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SuperadminUnsuspendVPSResponse) GetVps() *v13.VPSInstance {
+	if x != nil {
+		return x.Vps
+	}
+	return nil
+}
+
+func (x *SuperadminUnsuspendVPSResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Superadmin Update VPS CloudInit Request
+type SuperadminUpdateVPSCloudInitRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VpsId            string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`                                       // VPS ID
+	CloudInit        *v13.CloudInitConfig   `protobuf:"bytes,2,opt,name=cloud_init,json=cloudInit,proto3" json:"cloud_init,omitempty"`                           // New cloud-init configuration
+	GrowDiskIfNeeded bool                   `protobuf:"varint,3,opt,name=grow_disk_if_needed,json=growDiskIfNeeded,proto3" json:"grow_disk_if_needed,omitempty"` // Whether to grow disk if cloud-init requires it (default: true)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SuperadminUpdateVPSCloudInitRequest) Reset() {
+	*x = SuperadminUpdateVPSCloudInitRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminUpdateVPSCloudInitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminUpdateVPSCloudInitRequest) ProtoMessage() {}
+
+func (x *SuperadminUpdateVPSCloudInitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminUpdateVPSCloudInitRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminUpdateVPSCloudInitRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SuperadminUpdateVPSCloudInitRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+func (x *SuperadminUpdateVPSCloudInitRequest) GetCloudInit() *v13.CloudInitConfig {
+	if x != nil {
+		return x.CloudInit
+	}
+	return nil
+}
+
+func (x *SuperadminUpdateVPSCloudInitRequest) GetGrowDiskIfNeeded() bool {
+	if x != nil {
+		return x.GrowDiskIfNeeded
+	}
+	return false
+}
+
+// Superadmin Update VPS CloudInit Response
+type SuperadminUpdateVPSCloudInitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vps           *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminUpdateVPSCloudInitResponse) Reset() {
+	*x = SuperadminUpdateVPSCloudInitResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminUpdateVPSCloudInitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminUpdateVPSCloudInitResponse) ProtoMessage() {}
+
+func (x *SuperadminUpdateVPSCloudInitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminUpdateVPSCloudInitResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminUpdateVPSCloudInitResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SuperadminUpdateVPSCloudInitResponse) GetVps() *v13.VPSInstance {
+	if x != nil {
+		return x.Vps
+	}
+	return nil
+}
+
+func (x *SuperadminUpdateVPSCloudInitResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Superadmin Force Stop VPS Request
+type SuperadminForceStopVPSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"` // VPS ID to force stop
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminForceStopVPSRequest) Reset() {
+	*x = SuperadminForceStopVPSRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminForceStopVPSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminForceStopVPSRequest) ProtoMessage() {}
+
+func (x *SuperadminForceStopVPSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminForceStopVPSRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminForceStopVPSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SuperadminForceStopVPSRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+// Superadmin Force Stop VPS Response
+type SuperadminForceStopVPSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vps           *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminForceStopVPSResponse) Reset() {
+	*x = SuperadminForceStopVPSResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminForceStopVPSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminForceStopVPSResponse) ProtoMessage() {}
+
+func (x *SuperadminForceStopVPSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminForceStopVPSResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminForceStopVPSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SuperadminForceStopVPSResponse) GetVps() *v13.VPSInstance {
+	if x != nil {
+		return x.Vps
+	}
+	return nil
+}
+
+func (x *SuperadminForceStopVPSResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Superadmin Force Delete VPS Request
+type SuperadminForceDeleteVPSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`                 // VPS ID to force delete
+	HardDelete    bool                   `protobuf:"varint,2,opt,name=hard_delete,json=hardDelete,proto3" json:"hard_delete,omitempty"` // If true, perform hard delete (default: false, soft delete)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminForceDeleteVPSRequest) Reset() {
+	*x = SuperadminForceDeleteVPSRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminForceDeleteVPSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminForceDeleteVPSRequest) ProtoMessage() {}
+
+func (x *SuperadminForceDeleteVPSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminForceDeleteVPSRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminForceDeleteVPSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SuperadminForceDeleteVPSRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+func (x *SuperadminForceDeleteVPSRequest) GetHardDelete() bool {
+	if x != nil {
+		return x.HardDelete
+	}
+	return false
+}
+
+// Superadmin Force Delete VPS Response
+type SuperadminForceDeleteVPSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminForceDeleteVPSResponse) Reset() {
+	*x = SuperadminForceDeleteVPSResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminForceDeleteVPSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminForceDeleteVPSResponse) ProtoMessage() {}
+
+func (x *SuperadminForceDeleteVPSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminForceDeleteVPSResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminForceDeleteVPSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SuperadminForceDeleteVPSResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SuperadminForceDeleteVPSResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Superadmin Migrate VPS Request
+type SuperadminMigrateVPSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`                // VPS ID to migrate
+	TargetNode    string                 `protobuf:"bytes,2,opt,name=target_node,json=targetNode,proto3" json:"target_node,omitempty"` // Proxmox node name to migrate the VPS to
+	Live          bool                   `protobuf:"varint,3,opt,name=live,proto3" json:"live,omitempty"`                              // If true, migrate the VM while it is running (online migration)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminMigrateVPSRequest) Reset() {
+	*x = SuperadminMigrateVPSRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminMigrateVPSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminMigrateVPSRequest) ProtoMessage() {}
+
+func (x *SuperadminMigrateVPSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminMigrateVPSRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminMigrateVPSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SuperadminMigrateVPSRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+func (x *SuperadminMigrateVPSRequest) GetTargetNode() string {
+	if x != nil {
+		return x.TargetNode
+	}
+	return ""
+}
+
+func (x *SuperadminMigrateVPSRequest) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+// Superadmin Migrate VPS Response
+type SuperadminMigrateVPSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vps           *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminMigrateVPSResponse) Reset() {
+	*x = SuperadminMigrateVPSResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperadminMigrateVPSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperadminMigrateVPSResponse) ProtoMessage() {}
+
+func (x *SuperadminMigrateVPSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperadminMigrateVPSResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminMigrateVPSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SuperadminMigrateVPSResponse) GetVps() *v13.VPSInstance {
+	if x != nil {
+		return x.Vps
+	}
+	return nil
+}
+
+func (x *SuperadminMigrateVPSResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// List Unpatched VPS Request
+type ListUnpatchedVPSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"` // Minimum days since the last patch run (default: 30)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnpatchedVPSRequest) Reset() {
+	*x = ListUnpatchedVPSRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnpatchedVPSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnpatchedVPSRequest) ProtoMessage() {}
+
+func (x *ListUnpatchedVPSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnpatchedVPSRequest.ProtoReflect.Descriptor instead.
+func (*ListUnpatchedVPSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListUnpatchedVPSRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// List Unpatched VPS Response
+type ListUnpatchedVPSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VpsInstances  []*v13.VPSInstance     `protobuf:"bytes,1,rep,name=vps_instances,json=vpsInstances,proto3" json:"vps_instances,omitempty"` // Least recently patched first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnpatchedVPSResponse) Reset() {
+	*x = ListUnpatchedVPSResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnpatchedVPSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnpatchedVPSResponse) ProtoMessage() {}
+
+func (x *ListUnpatchedVPSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnpatchedVPSResponse.ProtoReflect.Descriptor instead.
+func (*ListUnpatchedVPSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListUnpatchedVPSResponse) GetVpsInstances() []*v13.VPSInstance {
+	if x != nil {
+		return x.VpsInstances
+	}
+	return nil
+}
+
+// List Stripe Webhook Events Request
+type ListStripeWebhookEventsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Filter by organization ID (optional)
+	EventType      *string                `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`                // Filter by event type (optional, e.g., "invoice.paid")
+	CustomerId     *string                `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`             // Filter by Stripe customer ID (optional)
+	SubscriptionId *string                `protobuf:"bytes,4,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"` // Filter by Stripe subscription ID (optional)
+	InvoiceId      *string                `protobuf:"bytes,5,opt,name=invoice_id,json=invoiceId,proto3,oneof" json:"invoice_id,omitempty"`                // Filter by Stripe invoice ID (optional)
+	Limit          *int32                 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                                        // Limit number of results (default: 50, max: 500)
+	Offset         *int32                 `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                                      // Offset for pagination (default: 0)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListStripeWebhookEventsRequest) Reset() {
+	*x = ListStripeWebhookEventsRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStripeWebhookEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStripeWebhookEventsRequest) ProtoMessage() {}
+
+func (x *ListStripeWebhookEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStripeWebhookEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListStripeWebhookEventsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListStripeWebhookEventsRequest) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListStripeWebhookEventsRequest) GetEventType() string {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return ""
+}
+
+func (x *ListStripeWebhookEventsRequest) GetCustomerId() string {
+	if x != nil && x.CustomerId != nil {
+		return *x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListStripeWebhookEventsRequest) GetSubscriptionId() string {
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *ListStripeWebhookEventsRequest) GetInvoiceId() string {
+	if x != nil && x.InvoiceId != nil {
+		return *x.InvoiceId
+	}
+	return ""
+}
+
+func (x *ListStripeWebhookEventsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListStripeWebhookEventsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+// Stripe Webhook Event with organization info
+type StripeWebhookEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                 // Stripe event ID (evt_*)
+	EventType         string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`                                  // Event type (e.g., "invoice.paid")
+	ProcessedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`                            // When the event was processed
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                  // When the event was created
+	OrganizationId    *string                `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`             // Organization ID if available
+	OrganizationName  *string                `protobuf:"bytes,6,opt,name=organization_name,json=organizationName,proto3,oneof" json:"organization_name,omitempty"`       // Organization name if available
+	CustomerId        *string                `protobuf:"bytes,7,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`                         // Stripe customer ID if available
+	SubscriptionId    *string                `protobuf:"bytes,8,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`             // Stripe subscription ID if available
+	InvoiceId         *string                `protobuf:"bytes,9,opt,name=invoice_id,json=invoiceId,proto3,oneof" json:"invoice_id,omitempty"`                            // Stripe invoice ID if available
+	CheckoutSessionId *string                `protobuf:"bytes,10,opt,name=checkout_session_id,json=checkoutSessionId,proto3,oneof" json:"checkout_session_id,omitempty"` // Stripe checkout session ID if available
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StripeWebhookEvent) Reset() {
+	*x = StripeWebhookEvent{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StripeWebhookEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StripeWebhookEvent) ProtoMessage() {}
+
+func (x *StripeWebhookEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StripeWebhookEvent.ProtoReflect.Descriptor instead.
+func (*StripeWebhookEvent) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *StripeWebhookEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StripeWebhookEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *StripeWebhookEvent) GetProcessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return nil
+}
+
+func (x *StripeWebhookEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StripeWebhookEvent) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return ""
+}
+
+func (x *StripeWebhookEvent) GetOrganizationName() string {
+	if x != nil && x.OrganizationName != nil {
+		return *x.OrganizationName
+	}
+	return ""
+}
+
+func (x *StripeWebhookEvent) GetCustomerId() string {
+	if x != nil && x.CustomerId != nil {
+		return *x.CustomerId
+	}
+	return ""
+}
+
+func (x *StripeWebhookEvent) GetSubscriptionId() string {
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *StripeWebhookEvent) GetInvoiceId() string {
+	if x != nil && x.InvoiceId != nil {
+		return *x.InvoiceId
+	}
+	return ""
+}
+
+func (x *StripeWebhookEvent) GetCheckoutSessionId() string {
+	if x != nil && x.CheckoutSessionId != nil {
+		return *x.CheckoutSessionId
+	}
+	return ""
+}
+
+// List Stripe Webhook Events Response
+type ListStripeWebhookEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*StripeWebhookEvent  `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Total number of events matching filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStripeWebhookEventsResponse) Reset() {
+	*x = ListStripeWebhookEventsResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStripeWebhookEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStripeWebhookEventsResponse) ProtoMessage() {}
+
+func (x *ListStripeWebhookEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStripeWebhookEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListStripeWebhookEventsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListStripeWebhookEventsResponse) GetEvents() []*StripeWebhookEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListStripeWebhookEventsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// List Nodes Request
+type ListNodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *string                `protobuf:"bytes,1,opt,name=role,proto3,oneof" json:"role,omitempty"`                 // Filter by role: "manager", "worker" (optional)
+	Availability  *string                `protobuf:"bytes,2,opt,name=availability,proto3,oneof" json:"availability,omitempty"` // Filter by availability: "active", "pause", "drain" (optional)
+	Status        *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`             // Filter by status: "ready", "down" (optional)
+	Region        *string                `protobuf:"bytes,4,opt,name=region,proto3,oneof" json:"region,omitempty"`             // Filter by region (optional)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodesRequest) Reset() {
+	*x = ListNodesRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodesRequest) ProtoMessage() {}
+
+func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodesRequest.ProtoReflect.Descriptor instead.
+func (*ListNodesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListNodesRequest) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return ""
+}
+
+func (x *ListNodesRequest) GetAvailability() string {
+	if x != nil && x.Availability != nil {
+		return *x.Availability
+	}
+	return ""
+}
+
+func (x *ListNodesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListNodesRequest) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+// List Nodes Response
+type ListNodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*NodeInfo            `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodesResponse) Reset() {
+	*x = ListNodesResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodesResponse) ProtoMessage() {}
+
+func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
+func (*ListNodesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListNodesResponse) GetNodes() []*NodeInfo {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// Get Node Request
+type GetNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"` // Node ID to fetch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeRequest) Reset() {
+	*x = GetNodeRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeRequest) ProtoMessage() {}
+
+func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+// Get Node Response
+type GetNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *NodeInfo              `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeResponse) Reset() {
+	*x = GetNodeResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeResponse) ProtoMessage() {}
+
+func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetNodeResponse) GetNode() *NodeInfo {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+// Update Node Config Request
+type UpdateNodeConfigRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId                 string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`                                                                                             // Node ID to update
+	Subdomain              *string                `protobuf:"bytes,2,opt,name=subdomain,proto3,oneof" json:"subdomain,omitempty"`                                                                                               // Node subdomain identifier (e.g., "node1", "us-east-1")
+	UseNodeSpecificDomains *bool                  `protobuf:"varint,3,opt,name=use_node_specific_domains,json=useNodeSpecificDomains,proto3,oneof" json:"use_node_specific_domains,omitempty"`                                  // Enable node-specific domains for this node's microservices
+	ServiceDomainPattern   *string                `protobuf:"bytes,4,opt,name=service_domain_pattern,json=serviceDomainPattern,proto3,oneof" json:"service_domain_pattern,omitempty"`                                           // Service domain pattern: "node-service" or "service-node"
+	Region                 *string                `protobuf:"bytes,5,opt,name=region,proto3,oneof" json:"region,omitempty"`                                                                                                     // Region identifier
+	MaxDeployments         *int32                 `protobuf:"varint,6,opt,name=max_deployments,json=maxDeployments,proto3,oneof" json:"max_deployments,omitempty"`                                                              // Maximum deployments allowed on this node
+	CustomLabels           map[string]string      `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Custom labels (key-value pairs)
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateNodeConfigRequest) Reset() {
+	*x = UpdateNodeConfigRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNodeConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNodeConfigRequest) ProtoMessage() {}
+
+func (x *UpdateNodeConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNodeConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNodeConfigRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *UpdateNodeConfigRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *UpdateNodeConfigRequest) GetSubdomain() string {
+	if x != nil && x.Subdomain != nil {
+		return *x.Subdomain
+	}
+	return ""
+}
+
+func (x *UpdateNodeConfigRequest) GetUseNodeSpecificDomains() bool {
+	if x != nil && x.UseNodeSpecificDomains != nil {
+		return *x.UseNodeSpecificDomains
+	}
+	return false
+}
+
+func (x *UpdateNodeConfigRequest) GetServiceDomainPattern() string {
+	if x != nil && x.ServiceDomainPattern != nil {
+		return *x.ServiceDomainPattern
+	}
+	return ""
+}
+
+func (x *UpdateNodeConfigRequest) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+func (x *UpdateNodeConfigRequest) GetMaxDeployments() int32 {
+	if x != nil && x.MaxDeployments != nil {
+		return *x.MaxDeployments
+	}
+	return 0
+}
+
+func (x *UpdateNodeConfigRequest) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+// Update Node Config Response
+type UpdateNodeConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *NodeInfo              `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Success message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNodeConfigResponse) Reset() {
+	*x = UpdateNodeConfigResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNodeConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNodeConfigResponse) ProtoMessage() {}
+
+func (x *UpdateNodeConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNodeConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNodeConfigResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UpdateNodeConfigResponse) GetNode() *NodeInfo {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *UpdateNodeConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Node Info
+type NodeInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Swarm node ID
+	Hostname        string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Ip              string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	Role            string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                                // "manager" or "worker"
+	Availability    string                 `protobuf:"bytes,5,opt,name=availability,proto3" json:"availability,omitempty"`                                // "active", "pause", "drain"
+	Status          string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                            // "ready", "down"
+	TotalCpu        int32                  `protobuf:"varint,7,opt,name=total_cpu,json=totalCpu,proto3" json:"total_cpu,omitempty"`                       // Total CPU cores
+	TotalMemory     int64                  `protobuf:"varint,8,opt,name=total_memory,json=totalMemory,proto3" json:"total_memory,omitempty"`              // Total memory in bytes
+	UsedCpu         float64                `protobuf:"fixed64,9,opt,name=used_cpu,json=usedCpu,proto3" json:"used_cpu,omitempty"`                         // Used CPU percentage
+	UsedMemory      int64                  `protobuf:"varint,10,opt,name=used_memory,json=usedMemory,proto3" json:"used_memory,omitempty"`                // Used memory in bytes
+	DeploymentCount int32                  `protobuf:"varint,11,opt,name=deployment_count,json=deploymentCount,proto3" json:"deployment_count,omitempty"` // Number of deployments on this node
+	MaxDeployments  int32                  `protobuf:"varint,12,opt,name=max_deployments,json=maxDeployments,proto3" json:"max_deployments,omitempty"`    // Max deployments allowed
+	Region          *string                `protobuf:"bytes,13,opt,name=region,proto3,oneof" json:"region,omitempty"`                                     // Region identifier
+	Config          *NodeConfig            `protobuf:"bytes,14,opt,name=config,proto3" json:"config,omitempty"`                                           // Node-specific configuration
+	LastHeartbeat   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NodeInfo) Reset() {
+	*x = NodeInfo{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfo) ProtoMessage() {}
+
+func (x *NodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *NodeInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetAvailability() string {
+	if x != nil {
+		return x.Availability
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetTotalCpu() int32 {
+	if x != nil {
+		return x.TotalCpu
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetTotalMemory() int64 {
+	if x != nil {
+		return x.TotalMemory
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetUsedCpu() float64 {
+	if x != nil {
+		return x.UsedCpu
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetUsedMemory() int64 {
+	if x != nil {
+		return x.UsedMemory
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetDeploymentCount() int32 {
+	if x != nil {
+		return x.DeploymentCount
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetMaxDeployments() int32 {
+	if x != nil {
+		return x.MaxDeployments
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetConfig() *NodeConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetLastHeartbeat() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHeartbeat
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Node Configuration (stored in Labels JSON field)
+type NodeConfig struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Subdomain              *string                `protobuf:"bytes,1,opt,name=subdomain,proto3,oneof" json:"subdomain,omitempty"`                                                                                               // Node subdomain identifier
+	UseNodeSpecificDomains *bool                  `protobuf:"varint,2,opt,name=use_node_specific_domains,json=useNodeSpecificDomains,proto3,oneof" json:"use_node_specific_domains,omitempty"`                                  // Enable node-specific domains
+	ServiceDomainPattern   *string                `protobuf:"bytes,3,opt,name=service_domain_pattern,json=serviceDomainPattern,proto3,oneof" json:"service_domain_pattern,omitempty"`                                           // "node-service" or "service-node"
+	CustomLabels           map[string]string      `protobuf:"bytes,4,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Custom labels
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *SuperadminGetVPSRequest) Reset() {
-	*x = SuperadminGetVPSRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[82]
+func (x *NodeConfig) Reset() {
+	*x = NodeConfig{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminGetVPSRequest) String() string {
+func (x *NodeConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminGetVPSRequest) ProtoMessage() {}
+func (*NodeConfig) ProtoMessage() {}
 
-func (x *SuperadminGetVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[82]
+func (x *NodeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5847,43 +8021,61 @@ func (x *SuperadminGetVPSRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminGetVPSRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminGetVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use NodeConfig.ProtoReflect.Descriptor instead.
+func (*NodeConfig) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *SuperadminGetVPSRequest) GetVpsId() string {
-	if x != nil {
-		return x.VpsId
+func (x *NodeConfig) GetSubdomain() string {
+	if x != nil && x.Subdomain != nil {
+		return *x.Subdomain
 	}
 	return ""
 }
 
-// Superadmin Get VPS Response
-type SuperadminGetVPSResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Vps              *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
-	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"` // Organization name (for display)
-	CreatedBy        *UserInfo              `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                // User who created the VPS (if available)
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *NodeConfig) GetUseNodeSpecificDomains() bool {
+	if x != nil && x.UseNodeSpecificDomains != nil {
+		return *x.UseNodeSpecificDomains
+	}
+	return false
 }
 
-func (x *SuperadminGetVPSResponse) Reset() {
-	*x = SuperadminGetVPSResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[83]
+func (x *NodeConfig) GetServiceDomainPattern() string {
+	if x != nil && x.ServiceDomainPattern != nil {
+		return *x.ServiceDomainPattern
+	}
+	return ""
+}
+
+func (x *NodeConfig) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+// Superadmin permissions catalog
+type ListSuperadminPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuperadminPermissionsRequest) Reset() {
+	*x = ListSuperadminPermissionsRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminGetVPSResponse) String() string {
+func (x *ListSuperadminPermissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminGetVPSResponse) ProtoMessage() {}
+func (*ListSuperadminPermissionsRequest) ProtoMessage() {}
 
-func (x *SuperadminGetVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[83]
+func (x *ListSuperadminPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5894,62 +8086,35 @@ func (x *SuperadminGetVPSResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminGetVPSResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminGetVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{83}
-}
-
-func (x *SuperadminGetVPSResponse) GetVps() *v13.VPSInstance {
-	if x != nil {
-		return x.Vps
-	}
-	return nil
-}
-
-func (x *SuperadminGetVPSResponse) GetOrganizationName() string {
-	if x != nil {
-		return x.OrganizationName
-	}
-	return ""
-}
-
-func (x *SuperadminGetVPSResponse) GetCreatedBy() *UserInfo {
-	if x != nil {
-		return x.CreatedBy
-	}
-	return nil
+// Deprecated: Use ListSuperadminPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSuperadminPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{117}
 }
 
-// Superadmin Resize VPS Request
-type SuperadminResizeVPSRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	VpsId          string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`                             // VPS ID to resize
-	NewSize        string                 `protobuf:"bytes,2,opt,name=new_size,json=newSize,proto3" json:"new_size,omitempty"`                       // New VPS size ID (e.g., "small", "medium", "large"). Use "custom" for custom sizing.
-	GrowDisk       bool                   `protobuf:"varint,3,opt,name=grow_disk,json=growDisk,proto3" json:"grow_disk,omitempty"`                   // Whether to grow the disk (default: true)
-	ApplyCloudinit bool                   `protobuf:"varint,4,opt,name=apply_cloudinit,json=applyCloudinit,proto3" json:"apply_cloudinit,omitempty"` // Whether to apply cloud-init for disk growth (default: true)
-	// Custom size options (used when new_size is "custom" or empty)
-	CustomCpuCores    *int32 `protobuf:"varint,5,opt,name=custom_cpu_cores,json=customCpuCores,proto3,oneof" json:"custom_cpu_cores,omitempty"`          // Custom CPU cores (required if using custom size)
-	CustomMemoryBytes *int64 `protobuf:"varint,6,opt,name=custom_memory_bytes,json=customMemoryBytes,proto3,oneof" json:"custom_memory_bytes,omitempty"` // Custom memory in bytes (required if using custom size)
-	CustomDiskBytes   *int64 `protobuf:"varint,7,opt,name=custom_disk_bytes,json=customDiskBytes,proto3,oneof" json:"custom_disk_bytes,omitempty"`       // Custom disk in bytes (required if using custom size)
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type SuperadminPermissionDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                         // e.g., admin.roles.create
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                       // human friendly
+	ResourceType  string                 `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // admin | organization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminResizeVPSRequest) Reset() {
-	*x = SuperadminResizeVPSRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[84]
+func (x *SuperadminPermissionDefinition) Reset() {
+	*x = SuperadminPermissionDefinition{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminResizeVPSRequest) String() string {
+func (x *SuperadminPermissionDefinition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminResizeVPSRequest) ProtoMessage() {}
+func (*SuperadminPermissionDefinition) ProtoMessage() {}
 
-func (x *SuperadminResizeVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[84]
+func (x *SuperadminPermissionDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5960,84 +8125,54 @@ func (x *SuperadminResizeVPSRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminResizeVPSRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminResizeVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use SuperadminPermissionDefinition.ProtoReflect.Descriptor instead.
+func (*SuperadminPermissionDefinition) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *SuperadminResizeVPSRequest) GetVpsId() string {
+func (x *SuperadminPermissionDefinition) GetId() string {
 	if x != nil {
-		return x.VpsId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SuperadminResizeVPSRequest) GetNewSize() string {
+func (x *SuperadminPermissionDefinition) GetDescription() string {
 	if x != nil {
-		return x.NewSize
+		return x.Description
 	}
 	return ""
 }
 
-func (x *SuperadminResizeVPSRequest) GetGrowDisk() bool {
-	if x != nil {
-		return x.GrowDisk
-	}
-	return false
-}
-
-func (x *SuperadminResizeVPSRequest) GetApplyCloudinit() bool {
+func (x *SuperadminPermissionDefinition) GetResourceType() string {
 	if x != nil {
-		return x.ApplyCloudinit
-	}
-	return false
-}
-
-func (x *SuperadminResizeVPSRequest) GetCustomCpuCores() int32 {
-	if x != nil && x.CustomCpuCores != nil {
-		return *x.CustomCpuCores
-	}
-	return 0
-}
-
-func (x *SuperadminResizeVPSRequest) GetCustomMemoryBytes() int64 {
-	if x != nil && x.CustomMemoryBytes != nil {
-		return *x.CustomMemoryBytes
-	}
-	return 0
-}
-
-func (x *SuperadminResizeVPSRequest) GetCustomDiskBytes() int64 {
-	if x != nil && x.CustomDiskBytes != nil {
-		return *x.CustomDiskBytes
+		return x.ResourceType
 	}
-	return 0
+	return ""
 }
 
-// Superadmin Resize VPS Response
-type SuperadminResizeVPSResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vps           *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Information message about the resize operation
+type ListSuperadminPermissionsResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Permissions   []*SuperadminPermissionDefinition `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminResizeVPSResponse) Reset() {
-	*x = SuperadminResizeVPSResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[85]
+func (x *ListSuperadminPermissionsResponse) Reset() {
+	*x = ListSuperadminPermissionsResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminResizeVPSResponse) String() string {
+func (x *ListSuperadminPermissionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminResizeVPSResponse) ProtoMessage() {}
+func (*ListSuperadminPermissionsResponse) ProtoMessage() {}
 
-func (x *SuperadminResizeVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[85]
+func (x *ListSuperadminPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6048,102 +8183,78 @@ func (x *SuperadminResizeVPSResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminResizeVPSResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminResizeVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use ListSuperadminPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSuperadminPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *SuperadminResizeVPSResponse) GetVps() *v13.VPSInstance {
+func (x *ListSuperadminPermissionsResponse) GetPermissions() []*SuperadminPermissionDefinition {
 	if x != nil {
-		return x.Vps
+		return x.Permissions
 	}
 	return nil
 }
 
-func (x *SuperadminResizeVPSResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-// Superadmin Suspend VPS Request
-type SuperadminSuspendVPSRequest struct {
+// Get current user's superadmin permissions
+type GetMySuperadminPermissionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"` // VPS ID to suspend
-	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`      // Optional reason for suspension
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminSuspendVPSRequest) Reset() {
-	*x = SuperadminSuspendVPSRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[86]
+func (x *GetMySuperadminPermissionsRequest) Reset() {
+	*x = GetMySuperadminPermissionsRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminSuspendVPSRequest) String() string {
+func (x *GetMySuperadminPermissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminSuspendVPSRequest) ProtoMessage() {}
-
-func (x *SuperadminSuspendVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[86]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SuperadminSuspendVPSRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminSuspendVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{86}
-}
+func (*GetMySuperadminPermissionsRequest) ProtoMessage() {}
 
-func (x *SuperadminSuspendVPSRequest) GetVpsId() string {
+func (x *GetMySuperadminPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[120]
 	if x != nil {
-		return x.VpsId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SuperadminSuspendVPSRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
-	}
-	return ""
+// Deprecated: Use GetMySuperadminPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetMySuperadminPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{120}
 }
 
-// Superadmin Suspend VPS Response
-type SuperadminSuspendVPSResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vps           *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetMySuperadminPermissionsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Permissions      []string               `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`                                      // List of permission IDs the user has (e.g., ["superadmin.overview.read", "superadmin.vps.read"])
+	IsFullSuperadmin bool                   `protobuf:"varint,2,opt,name=is_full_superadmin,json=isFullSuperadmin,proto3" json:"is_full_superadmin,omitempty"` // true if user is a full superadmin (email-based), false if role-based
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SuperadminSuspendVPSResponse) Reset() {
-	*x = SuperadminSuspendVPSResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[87]
+func (x *GetMySuperadminPermissionsResponse) Reset() {
+	*x = GetMySuperadminPermissionsResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminSuspendVPSResponse) String() string {
+func (x *GetMySuperadminPermissionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminSuspendVPSResponse) ProtoMessage() {}
+func (*GetMySuperadminPermissionsResponse) ProtoMessage() {}
 
-func (x *SuperadminSuspendVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[87]
+func (x *GetMySuperadminPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6154,48 +8265,47 @@ func (x *SuperadminSuspendVPSResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminSuspendVPSResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminSuspendVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use GetMySuperadminPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetMySuperadminPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *SuperadminSuspendVPSResponse) GetVps() *v13.VPSInstance {
+func (x *GetMySuperadminPermissionsResponse) GetPermissions() []string {
 	if x != nil {
-		return x.Vps
+		return x.Permissions
 	}
 	return nil
 }
 
-func (x *SuperadminSuspendVPSResponse) GetMessage() string {
+func (x *GetMySuperadminPermissionsResponse) GetIsFullSuperadmin() bool {
 	if x != nil {
-		return x.Message
+		return x.IsFullSuperadmin
 	}
-	return ""
+	return false
 }
 
-// Superadmin Unsuspend VPS Request
-type SuperadminUnsuspendVPSRequest struct {
+// Superadmin role management messages
+type ListSuperadminRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"` // VPS ID to unsuspend
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminUnsuspendVPSRequest) Reset() {
-	*x = SuperadminUnsuspendVPSRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[88]
+func (x *ListSuperadminRolesRequest) Reset() {
+	*x = ListSuperadminRolesRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminUnsuspendVPSRequest) String() string {
+func (x *ListSuperadminRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminUnsuspendVPSRequest) ProtoMessage() {}
+func (*ListSuperadminRolesRequest) ProtoMessage() {}
 
-func (x *SuperadminUnsuspendVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[88]
+func (x *ListSuperadminRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6206,42 +8316,36 @@ func (x *SuperadminUnsuspendVPSRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminUnsuspendVPSRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminUnsuspendVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{88}
-}
-
-func (x *SuperadminUnsuspendVPSRequest) GetVpsId() string {
-	if x != nil {
-		return x.VpsId
-	}
-	return ""
+// Deprecated: Use ListSuperadminRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListSuperadminRolesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{122}
 }
 
-// Superadmin Unsuspend VPS Response
-type SuperadminUnsuspendVPSResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vps           *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SuperadminRole struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PermissionsJson string                 `protobuf:"bytes,4,opt,name=permissions_json,json=permissionsJson,proto3" json:"permissions_json,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SuperadminUnsuspendVPSResponse) Reset() {
-	*x = SuperadminUnsuspendVPSResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[89]
+func (x *SuperadminRole) Reset() {
+	*x = SuperadminRole{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminUnsuspendVPSResponse) String() string {
+func (x *SuperadminRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminUnsuspendVPSResponse) ProtoMessage() {}
+func (*SuperadminRole) ProtoMessage() {}
 
-func (x *SuperadminUnsuspendVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[89]
+func (x *SuperadminRole) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6252,50 +8356,61 @@ func (x *SuperadminUnsuspendVPSResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminUnsuspendVPSResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminUnsuspendVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use SuperadminRole.ProtoReflect.Descriptor instead.
+func (*SuperadminRole) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *SuperadminUnsuspendVPSResponse) GetVps() *v13.VPSInstance {
+func (x *SuperadminRole) GetId() string {
 	if x != nil {
-		return x.Vps
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *SuperadminUnsuspendVPSResponse) GetMessage() string {
+func (x *SuperadminRole) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-// Superadmin Update VPS CloudInit Request
-type SuperadminUpdateVPSCloudInitRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	VpsId            string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`                                       // VPS ID
-	CloudInit        *v13.CloudInitConfig   `protobuf:"bytes,2,opt,name=cloud_init,json=cloudInit,proto3" json:"cloud_init,omitempty"`                           // New cloud-init configuration
-	GrowDiskIfNeeded bool                   `protobuf:"varint,3,opt,name=grow_disk_if_needed,json=growDiskIfNeeded,proto3" json:"grow_disk_if_needed,omitempty"` // Whether to grow disk if cloud-init requires it (default: true)
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *SuperadminRole) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *SuperadminUpdateVPSCloudInitRequest) Reset() {
-	*x = SuperadminUpdateVPSCloudInitRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[90]
+func (x *SuperadminRole) GetPermissionsJson() string {
+	if x != nil {
+		return x.PermissionsJson
+	}
+	return ""
+}
+
+type ListSuperadminRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*SuperadminRole      `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuperadminRolesResponse) Reset() {
+	*x = ListSuperadminRolesResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminUpdateVPSCloudInitRequest) String() string {
+func (x *ListSuperadminRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminUpdateVPSCloudInitRequest) ProtoMessage() {}
+func (*ListSuperadminRolesResponse) ProtoMessage() {}
 
-func (x *SuperadminUpdateVPSCloudInitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[90]
+func (x *ListSuperadminRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6306,56 +8421,42 @@ func (x *SuperadminUpdateVPSCloudInitRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminUpdateVPSCloudInitRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminUpdateVPSCloudInitRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{90}
-}
-
-func (x *SuperadminUpdateVPSCloudInitRequest) GetVpsId() string {
-	if x != nil {
-		return x.VpsId
-	}
-	return ""
+// Deprecated: Use ListSuperadminRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListSuperadminRolesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *SuperadminUpdateVPSCloudInitRequest) GetCloudInit() *v13.CloudInitConfig {
+func (x *ListSuperadminRolesResponse) GetRoles() []*SuperadminRole {
 	if x != nil {
-		return x.CloudInit
+		return x.Roles
 	}
 	return nil
 }
 
-func (x *SuperadminUpdateVPSCloudInitRequest) GetGrowDiskIfNeeded() bool {
-	if x != nil {
-		return x.GrowDiskIfNeeded
-	}
-	return false
-}
-
-// Superadmin Update VPS CloudInit Response
-type SuperadminUpdateVPSCloudInitResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vps           *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateSuperadminRoleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PermissionsJson string                 `protobuf:"bytes,3,opt,name=permissions_json,json=permissionsJson,proto3" json:"permissions_json,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SuperadminUpdateVPSCloudInitResponse) Reset() {
-	*x = SuperadminUpdateVPSCloudInitResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[91]
+func (x *CreateSuperadminRoleRequest) Reset() {
+	*x = CreateSuperadminRoleRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminUpdateVPSCloudInitResponse) String() string {
+func (x *CreateSuperadminRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminUpdateVPSCloudInitResponse) ProtoMessage() {}
+func (*CreateSuperadminRoleRequest) ProtoMessage() {}
 
-func (x *SuperadminUpdateVPSCloudInitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[91]
+func (x *CreateSuperadminRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6366,48 +8467,54 @@ func (x *SuperadminUpdateVPSCloudInitResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminUpdateVPSCloudInitResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminUpdateVPSCloudInitResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use CreateSuperadminRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateSuperadminRoleRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *SuperadminUpdateVPSCloudInitResponse) GetVps() *v13.VPSInstance {
+func (x *CreateSuperadminRoleRequest) GetName() string {
 	if x != nil {
-		return x.Vps
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *SuperadminUpdateVPSCloudInitResponse) GetMessage() string {
+func (x *CreateSuperadminRoleRequest) GetDescription() string {
 	if x != nil {
-		return x.Message
+		return x.Description
 	}
 	return ""
 }
 
-// Superadmin Force Stop VPS Request
-type SuperadminForceStopVPSRequest struct {
+func (x *CreateSuperadminRoleRequest) GetPermissionsJson() string {
+	if x != nil {
+		return x.PermissionsJson
+	}
+	return ""
+}
+
+type CreateSuperadminRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"` // VPS ID to force stop
+	Role          *SuperadminRole        `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminForceStopVPSRequest) Reset() {
-	*x = SuperadminForceStopVPSRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[92]
+func (x *CreateSuperadminRoleResponse) Reset() {
+	*x = CreateSuperadminRoleResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminForceStopVPSRequest) String() string {
+func (x *CreateSuperadminRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminForceStopVPSRequest) ProtoMessage() {}
+func (*CreateSuperadminRoleResponse) ProtoMessage() {}
 
-func (x *SuperadminForceStopVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[92]
+func (x *CreateSuperadminRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6418,42 +8525,43 @@ func (x *SuperadminForceStopVPSRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminForceStopVPSRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminForceStopVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use CreateSuperadminRoleResponse.ProtoReflect.Descriptor instead.
+func (*CreateSuperadminRoleResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *SuperadminForceStopVPSRequest) GetVpsId() string {
+func (x *CreateSuperadminRoleResponse) GetRole() *SuperadminRole {
 	if x != nil {
-		return x.VpsId
+		return x.Role
 	}
-	return ""
+	return nil
 }
 
-// Superadmin Force Stop VPS Response
-type SuperadminForceStopVPSResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vps           *v13.VPSInstance       `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateSuperadminRoleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PermissionsJson string                 `protobuf:"bytes,4,opt,name=permissions_json,json=permissionsJson,proto3" json:"permissions_json,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SuperadminForceStopVPSResponse) Reset() {
-	*x = SuperadminForceStopVPSResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[93]
+func (x *UpdateSuperadminRoleRequest) Reset() {
+	*x = UpdateSuperadminRoleRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminForceStopVPSResponse) String() string {
+func (x *UpdateSuperadminRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminForceStopVPSResponse) ProtoMessage() {}
+func (*UpdateSuperadminRoleRequest) ProtoMessage() {}
 
-func (x *SuperadminForceStopVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[93]
+func (x *UpdateSuperadminRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6464,49 +8572,61 @@ func (x *SuperadminForceStopVPSResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminForceStopVPSResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminForceStopVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use UpdateSuperadminRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSuperadminRoleRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *SuperadminForceStopVPSResponse) GetVps() *v13.VPSInstance {
+func (x *UpdateSuperadminRoleRequest) GetId() string {
 	if x != nil {
-		return x.Vps
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *SuperadminForceStopVPSResponse) GetMessage() string {
+func (x *UpdateSuperadminRoleRequest) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-// Superadmin Force Delete VPS Request
-type SuperadminForceDeleteVPSRequest struct {
+func (x *UpdateSuperadminRoleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSuperadminRoleRequest) GetPermissionsJson() string {
+	if x != nil {
+		return x.PermissionsJson
+	}
+	return ""
+}
+
+type UpdateSuperadminRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`                 // VPS ID to force delete
-	HardDelete    bool                   `protobuf:"varint,2,opt,name=hard_delete,json=hardDelete,proto3" json:"hard_delete,omitempty"` // If true, perform hard delete (default: false, soft delete)
+	Role          *SuperadminRole        `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminForceDeleteVPSRequest) Reset() {
-	*x = SuperadminForceDeleteVPSRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[94]
+func (x *UpdateSuperadminRoleResponse) Reset() {
+	*x = UpdateSuperadminRoleResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminForceDeleteVPSRequest) String() string {
+func (x *UpdateSuperadminRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminForceDeleteVPSRequest) ProtoMessage() {}
+func (*UpdateSuperadminRoleResponse) ProtoMessage() {}
 
-func (x *SuperadminForceDeleteVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[94]
+func (x *UpdateSuperadminRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6517,49 +8637,40 @@ func (x *SuperadminForceDeleteVPSRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminForceDeleteVPSRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminForceDeleteVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{94}
-}
-
-func (x *SuperadminForceDeleteVPSRequest) GetVpsId() string {
-	if x != nil {
-		return x.VpsId
-	}
-	return ""
+// Deprecated: Use UpdateSuperadminRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSuperadminRoleResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *SuperadminForceDeleteVPSRequest) GetHardDelete() bool {
+func (x *UpdateSuperadminRoleResponse) GetRole() *SuperadminRole {
 	if x != nil {
-		return x.HardDelete
+		return x.Role
 	}
-	return false
+	return nil
 }
 
-// Superadmin Force Delete VPS Response
-type SuperadminForceDeleteVPSResponse struct {
+type DeleteSuperadminRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminForceDeleteVPSResponse) Reset() {
-	*x = SuperadminForceDeleteVPSResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[95]
+func (x *DeleteSuperadminRoleRequest) Reset() {
+	*x = DeleteSuperadminRoleRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminForceDeleteVPSResponse) String() string {
+func (x *DeleteSuperadminRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminForceDeleteVPSResponse) ProtoMessage() {}
+func (*DeleteSuperadminRoleRequest) ProtoMessage() {}
 
-func (x *SuperadminForceDeleteVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[95]
+func (x *DeleteSuperadminRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6570,54 +8681,40 @@ func (x *SuperadminForceDeleteVPSResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminForceDeleteVPSResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminForceDeleteVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{95}
-}
-
-func (x *SuperadminForceDeleteVPSResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use DeleteSuperadminRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSuperadminRoleRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *SuperadminForceDeleteVPSResponse) GetMessage() string {
+func (x *DeleteSuperadminRoleRequest) GetId() string {
 	if x != nil {
-		return x.Message
+		return x.Id
 	}
 	return ""
 }
 
-// List Stripe Webhook Events Request
-type ListStripeWebhookEventsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Filter by organization ID (optional)
-	EventType      *string                `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`                // Filter by event type (optional, e.g., "invoice.paid")
-	CustomerId     *string                `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`             // Filter by Stripe customer ID (optional)
-	SubscriptionId *string                `protobuf:"bytes,4,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"` // Filter by Stripe subscription ID (optional)
-	InvoiceId      *string                `protobuf:"bytes,5,opt,name=invoice_id,json=invoiceId,proto3,oneof" json:"invoice_id,omitempty"`                // Filter by Stripe invoice ID (optional)
-	Limit          *int32                 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                                        // Limit number of results (default: 50, max: 500)
-	Offset         *int32                 `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                                      // Offset for pagination (default: 0)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DeleteSuperadminRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListStripeWebhookEventsRequest) Reset() {
-	*x = ListStripeWebhookEventsRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[96]
+func (x *DeleteSuperadminRoleResponse) Reset() {
+	*x = DeleteSuperadminRoleResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStripeWebhookEventsRequest) String() string {
+func (x *DeleteSuperadminRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStripeWebhookEventsRequest) ProtoMessage() {}
+func (*DeleteSuperadminRoleResponse) ProtoMessage() {}
 
-func (x *ListStripeWebhookEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[96]
+func (x *DeleteSuperadminRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6628,92 +8725,79 @@ func (x *ListStripeWebhookEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStripeWebhookEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListStripeWebhookEventsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use DeleteSuperadminRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSuperadminRoleResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *ListStripeWebhookEventsRequest) GetOrganizationId() string {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
+func (x *DeleteSuperadminRoleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ListStripeWebhookEventsRequest) GetEventType() string {
-	if x != nil && x.EventType != nil {
-		return *x.EventType
-	}
-	return ""
+// Superadmin role binding messages
+type ListSuperadminRoleBindingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListStripeWebhookEventsRequest) GetCustomerId() string {
-	if x != nil && x.CustomerId != nil {
-		return *x.CustomerId
-	}
-	return ""
+func (x *ListSuperadminRoleBindingsRequest) Reset() {
+	*x = ListSuperadminRoleBindingsRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStripeWebhookEventsRequest) GetSubscriptionId() string {
-	if x != nil && x.SubscriptionId != nil {
-		return *x.SubscriptionId
-	}
-	return ""
+func (x *ListSuperadminRoleBindingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListStripeWebhookEventsRequest) GetInvoiceId() string {
-	if x != nil && x.InvoiceId != nil {
-		return *x.InvoiceId
-	}
-	return ""
-}
+func (*ListSuperadminRoleBindingsRequest) ProtoMessage() {}
 
-func (x *ListStripeWebhookEventsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *ListSuperadminRoleBindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListStripeWebhookEventsRequest) GetOffset() int32 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
-	}
-	return 0
+// Deprecated: Use ListSuperadminRoleBindingsRequest.ProtoReflect.Descriptor instead.
+func (*ListSuperadminRoleBindingsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{131}
 }
 
-// Stripe Webhook Event with organization info
-type StripeWebhookEvent struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                 // Stripe event ID (evt_*)
-	EventType         string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`                                  // Event type (e.g., "invoice.paid")
-	ProcessedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`                            // When the event was processed
-	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                  // When the event was created
-	OrganizationId    *string                `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`             // Organization ID if available
-	OrganizationName  *string                `protobuf:"bytes,6,opt,name=organization_name,json=organizationName,proto3,oneof" json:"organization_name,omitempty"`       // Organization name if available
-	CustomerId        *string                `protobuf:"bytes,7,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`                         // Stripe customer ID if available
-	SubscriptionId    *string                `protobuf:"bytes,8,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`             // Stripe subscription ID if available
-	InvoiceId         *string                `protobuf:"bytes,9,opt,name=invoice_id,json=invoiceId,proto3,oneof" json:"invoice_id,omitempty"`                            // Stripe invoice ID if available
-	CheckoutSessionId *string                `protobuf:"bytes,10,opt,name=checkout_session_id,json=checkoutSessionId,proto3,oneof" json:"checkout_session_id,omitempty"` // Stripe checkout session ID if available
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type SuperadminRoleBinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StripeWebhookEvent) Reset() {
-	*x = StripeWebhookEvent{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[97]
+func (x *SuperadminRoleBinding) Reset() {
+	*x = SuperadminRoleBinding{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StripeWebhookEvent) String() string {
+func (x *SuperadminRoleBinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StripeWebhookEvent) ProtoMessage() {}
+func (*SuperadminRoleBinding) ProtoMessage() {}
 
-func (x *StripeWebhookEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[97]
+func (x *SuperadminRoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6724,105 +8808,99 @@ func (x *StripeWebhookEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StripeWebhookEvent.ProtoReflect.Descriptor instead.
-func (*StripeWebhookEvent) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use SuperadminRoleBinding.ProtoReflect.Descriptor instead.
+func (*SuperadminRoleBinding) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *StripeWebhookEvent) GetId() string {
+func (x *SuperadminRoleBinding) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *StripeWebhookEvent) GetEventType() string {
+func (x *SuperadminRoleBinding) GetUserId() string {
 	if x != nil {
-		return x.EventType
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *StripeWebhookEvent) GetProcessedAt() *timestamppb.Timestamp {
+func (x *SuperadminRoleBinding) GetRoleId() string {
 	if x != nil {
-		return x.ProcessedAt
+		return x.RoleId
 	}
-	return nil
+	return ""
 }
 
-func (x *StripeWebhookEvent) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+type ListSuperadminRoleBindingsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Bindings      []*SuperadminRoleBinding `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StripeWebhookEvent) GetOrganizationId() string {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
-	}
-	return ""
+func (x *ListSuperadminRoleBindingsResponse) Reset() {
+	*x = ListSuperadminRoleBindingsResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StripeWebhookEvent) GetOrganizationName() string {
-	if x != nil && x.OrganizationName != nil {
-		return *x.OrganizationName
-	}
-	return ""
+func (x *ListSuperadminRoleBindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StripeWebhookEvent) GetCustomerId() string {
-	if x != nil && x.CustomerId != nil {
-		return *x.CustomerId
-	}
-	return ""
-}
+func (*ListSuperadminRoleBindingsResponse) ProtoMessage() {}
 
-func (x *StripeWebhookEvent) GetSubscriptionId() string {
-	if x != nil && x.SubscriptionId != nil {
-		return *x.SubscriptionId
+func (x *ListSuperadminRoleBindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StripeWebhookEvent) GetInvoiceId() string {
-	if x != nil && x.InvoiceId != nil {
-		return *x.InvoiceId
-	}
-	return ""
+// Deprecated: Use ListSuperadminRoleBindingsResponse.ProtoReflect.Descriptor instead.
+func (*ListSuperadminRoleBindingsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *StripeWebhookEvent) GetCheckoutSessionId() string {
-	if x != nil && x.CheckoutSessionId != nil {
-		return *x.CheckoutSessionId
+func (x *ListSuperadminRoleBindingsResponse) GetBindings() []*SuperadminRoleBinding {
+	if x != nil {
+		return x.Bindings
 	}
-	return ""
+	return nil
 }
 
-// List Stripe Webhook Events Response
-type ListStripeWebhookEventsResponse struct {
+type CreateSuperadminRoleBindingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*StripeWebhookEvent  `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Total number of events matching filters
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListStripeWebhookEventsResponse) Reset() {
-	*x = ListStripeWebhookEventsResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[98]
+func (x *CreateSuperadminRoleBindingRequest) Reset() {
+	*x = CreateSuperadminRoleBindingRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStripeWebhookEventsResponse) String() string {
+func (x *CreateSuperadminRoleBindingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStripeWebhookEventsResponse) ProtoMessage() {}
+func (*CreateSuperadminRoleBindingRequest) ProtoMessage() {}
 
-func (x *ListStripeWebhookEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[98]
+func (x *CreateSuperadminRoleBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6833,51 +8911,47 @@ func (x *ListStripeWebhookEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStripeWebhookEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListStripeWebhookEventsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use CreateSuperadminRoleBindingRequest.ProtoReflect.Descriptor instead.
+func (*CreateSuperadminRoleBindingRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *ListStripeWebhookEventsResponse) GetEvents() []*StripeWebhookEvent {
+func (x *CreateSuperadminRoleBindingRequest) GetUserId() string {
 	if x != nil {
-		return x.Events
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListStripeWebhookEventsResponse) GetTotalCount() int64 {
+func (x *CreateSuperadminRoleBindingRequest) GetRoleId() string {
 	if x != nil {
-		return x.TotalCount
+		return x.RoleId
 	}
-	return 0
+	return ""
 }
 
-// List Nodes Request
-type ListNodesRequest struct {
+type CreateSuperadminRoleBindingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          *string                `protobuf:"bytes,1,opt,name=role,proto3,oneof" json:"role,omitempty"`                 // Filter by role: "manager", "worker" (optional)
-	Availability  *string                `protobuf:"bytes,2,opt,name=availability,proto3,oneof" json:"availability,omitempty"` // Filter by availability: "active", "pause", "drain" (optional)
-	Status        *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`             // Filter by status: "ready", "down" (optional)
-	Region        *string                `protobuf:"bytes,4,opt,name=region,proto3,oneof" json:"region,omitempty"`             // Filter by region (optional)
+	Binding       *SuperadminRoleBinding `protobuf:"bytes,1,opt,name=binding,proto3" json:"binding,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodesRequest) Reset() {
-	*x = ListNodesRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[99]
+func (x *CreateSuperadminRoleBindingResponse) Reset() {
+	*x = CreateSuperadminRoleBindingResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodesRequest) String() string {
+func (x *CreateSuperadminRoleBindingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodesRequest) ProtoMessage() {}
+func (*CreateSuperadminRoleBindingResponse) ProtoMessage() {}
 
-func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[99]
+func (x *CreateSuperadminRoleBindingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6888,62 +8962,84 @@ func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodesRequest.ProtoReflect.Descriptor instead.
-func (*ListNodesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use CreateSuperadminRoleBindingResponse.ProtoReflect.Descriptor instead.
+func (*CreateSuperadminRoleBindingResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *ListNodesRequest) GetRole() string {
-	if x != nil && x.Role != nil {
-		return *x.Role
+func (x *CreateSuperadminRoleBindingResponse) GetBinding() *SuperadminRoleBinding {
+	if x != nil {
+		return x.Binding
 	}
-	return ""
+	return nil
 }
 
-func (x *ListNodesRequest) GetAvailability() string {
-	if x != nil && x.Availability != nil {
-		return *x.Availability
-	}
-	return ""
+type DeleteSuperadminRoleBindingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodesRequest) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *DeleteSuperadminRoleBindingRequest) Reset() {
+	*x = DeleteSuperadminRoleBindingRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSuperadminRoleBindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSuperadminRoleBindingRequest) ProtoMessage() {}
+
+func (x *DeleteSuperadminRoleBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListNodesRequest) GetRegion() string {
-	if x != nil && x.Region != nil {
-		return *x.Region
+// Deprecated: Use DeleteSuperadminRoleBindingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSuperadminRoleBindingRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *DeleteSuperadminRoleBindingRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-// List Nodes Response
-type ListNodesResponse struct {
+type DeleteSuperadminRoleBindingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nodes         []*NodeInfo            `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *ListNodesResponse) Reset() {
-	*x = ListNodesResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[100]
+
+func (x *DeleteSuperadminRoleBindingResponse) Reset() {
+	*x = DeleteSuperadminRoleBindingResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodesResponse) String() string {
+func (x *DeleteSuperadminRoleBindingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodesResponse) ProtoMessage() {}
+func (*DeleteSuperadminRoleBindingResponse) ProtoMessage() {}
 
-func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[100]
+func (x *DeleteSuperadminRoleBindingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6954,41 +9050,42 @@ func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
-func (*ListNodesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use DeleteSuperadminRoleBindingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSuperadminRoleBindingResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *ListNodesResponse) GetNodes() []*NodeInfo {
+func (x *DeleteSuperadminRoleBindingResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Nodes
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// Get Node Request
-type GetNodeRequest struct {
+type SuspendUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"` // Node ID to fetch
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // null = indefinite
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodeRequest) Reset() {
-	*x = GetNodeRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[101]
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodeRequest) String() string {
+func (x *SuspendUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeRequest) ProtoMessage() {}
+func (*SuspendUserRequest) ProtoMessage() {}
 
-func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[101]
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6999,41 +9096,55 @@ func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeRequest.ProtoReflect.Descriptor instead.
-func (*GetNodeRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *GetNodeRequest) GetNodeId() string {
+func (x *SuspendUserRequest) GetUserId() string {
 	if x != nil {
-		return x.NodeId
+		return x.UserId
 	}
 	return ""
 }
 
-// Get Node Response
-type GetNodeResponse struct {
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SuspendUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *NodeInfo              `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Ban           *UserBanInfo           `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodeResponse) Reset() {
-	*x = GetNodeResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[102]
+func (x *SuspendUserResponse) Reset() {
+	*x = SuspendUserResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodeResponse) String() string {
+func (x *SuspendUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeResponse) ProtoMessage() {}
+func (*SuspendUserResponse) ProtoMessage() {}
 
-func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[102]
+func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7044,47 +9155,47 @@ func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeResponse.ProtoReflect.Descriptor instead.
-func (*GetNodeResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
+func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *GetNodeResponse) GetNode() *NodeInfo {
+func (x *SuspendUserResponse) GetBan() *UserBanInfo {
 	if x != nil {
-		return x.Node
+		return x.Ban
 	}
 	return nil
 }
 
-// Update Node Config Request
-type UpdateNodeConfigRequest struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	NodeId                 string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`                                                                                             // Node ID to update
-	Subdomain              *string                `protobuf:"bytes,2,opt,name=subdomain,proto3,oneof" json:"subdomain,omitempty"`                                                                                               // Node subdomain identifier (e.g., "node1", "us-east-1")
-	UseNodeSpecificDomains *bool                  `protobuf:"varint,3,opt,name=use_node_specific_domains,json=useNodeSpecificDomains,proto3,oneof" json:"use_node_specific_domains,omitempty"`                                  // Enable node-specific domains for this node's microservices
-	ServiceDomainPattern   *string                `protobuf:"bytes,4,opt,name=service_domain_pattern,json=serviceDomainPattern,proto3,oneof" json:"service_domain_pattern,omitempty"`                                           // Service domain pattern: "node-service" or "service-node"
-	Region                 *string                `protobuf:"bytes,5,opt,name=region,proto3,oneof" json:"region,omitempty"`                                                                                                     // Region identifier
-	MaxDeployments         *int32                 `protobuf:"varint,6,opt,name=max_deployments,json=maxDeployments,proto3,oneof" json:"max_deployments,omitempty"`                                                              // Maximum deployments allowed on this node
-	CustomLabels           map[string]string      `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Custom labels (key-value pairs)
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *SuspendUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *UpdateNodeConfigRequest) Reset() {
-	*x = UpdateNodeConfigRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[103]
+type UnsuspendUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsuspendUserRequest) Reset() {
+	*x = UnsuspendUserRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNodeConfigRequest) String() string {
+func (x *UnsuspendUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNodeConfigRequest) ProtoMessage() {}
+func (*UnsuspendUserRequest) ProtoMessage() {}
 
-func (x *UpdateNodeConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[103]
+func (x *UnsuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7095,84 +9206,40 @@ func (x *UpdateNodeConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNodeConfigRequest.ProtoReflect.Descriptor instead.
-func (*UpdateNodeConfigRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use UnsuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*UnsuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *UpdateNodeConfigRequest) GetNodeId() string {
+func (x *UnsuspendUserRequest) GetUserId() string {
 	if x != nil {
-		return x.NodeId
-	}
-	return ""
-}
-
-func (x *UpdateNodeConfigRequest) GetSubdomain() string {
-	if x != nil && x.Subdomain != nil {
-		return *x.Subdomain
-	}
-	return ""
-}
-
-func (x *UpdateNodeConfigRequest) GetUseNodeSpecificDomains() bool {
-	if x != nil && x.UseNodeSpecificDomains != nil {
-		return *x.UseNodeSpecificDomains
-	}
-	return false
-}
-
-func (x *UpdateNodeConfigRequest) GetServiceDomainPattern() string {
-	if x != nil && x.ServiceDomainPattern != nil {
-		return *x.ServiceDomainPattern
-	}
-	return ""
-}
-
-func (x *UpdateNodeConfigRequest) GetRegion() string {
-	if x != nil && x.Region != nil {
-		return *x.Region
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateNodeConfigRequest) GetMaxDeployments() int32 {
-	if x != nil && x.MaxDeployments != nil {
-		return *x.MaxDeployments
-	}
-	return 0
-}
-
-func (x *UpdateNodeConfigRequest) GetCustomLabels() map[string]string {
-	if x != nil {
-		return x.CustomLabels
-	}
-	return nil
-}
-
-// Update Node Config Response
-type UpdateNodeConfigResponse struct {
+type UnsuspendUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *NodeInfo              `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Success message
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateNodeConfigResponse) Reset() {
-	*x = UpdateNodeConfigResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[104]
+func (x *UnsuspendUserResponse) Reset() {
+	*x = UnsuspendUserResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNodeConfigResponse) String() string {
+func (x *UnsuspendUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNodeConfigResponse) ProtoMessage() {}
+func (*UnsuspendUserResponse) ProtoMessage() {}
 
-func (x *UpdateNodeConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[104]
+func (x *UnsuspendUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7183,64 +9250,41 @@ func (x *UpdateNodeConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNodeConfigResponse.ProtoReflect.Descriptor instead.
-func (*UpdateNodeConfigResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{104}
-}
-
-func (x *UpdateNodeConfigResponse) GetNode() *NodeInfo {
-	if x != nil {
-		return x.Node
-	}
-	return nil
+// Deprecated: Use UnsuspendUserResponse.ProtoReflect.Descriptor instead.
+func (*UnsuspendUserResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *UpdateNodeConfigResponse) GetMessage() string {
+func (x *UnsuspendUserResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// Node Info
-type NodeInfo struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Swarm node ID
-	Hostname        string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Ip              string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
-	Role            string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                                // "manager" or "worker"
-	Availability    string                 `protobuf:"bytes,5,opt,name=availability,proto3" json:"availability,omitempty"`                                // "active", "pause", "drain"
-	Status          string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                            // "ready", "down"
-	TotalCpu        int32                  `protobuf:"varint,7,opt,name=total_cpu,json=totalCpu,proto3" json:"total_cpu,omitempty"`                       // Total CPU cores
-	TotalMemory     int64                  `protobuf:"varint,8,opt,name=total_memory,json=totalMemory,proto3" json:"total_memory,omitempty"`              // Total memory in bytes
-	UsedCpu         float64                `protobuf:"fixed64,9,opt,name=used_cpu,json=usedCpu,proto3" json:"used_cpu,omitempty"`                         // Used CPU percentage
-	UsedMemory      int64                  `protobuf:"varint,10,opt,name=used_memory,json=usedMemory,proto3" json:"used_memory,omitempty"`                // Used memory in bytes
-	DeploymentCount int32                  `protobuf:"varint,11,opt,name=deployment_count,json=deploymentCount,proto3" json:"deployment_count,omitempty"` // Number of deployments on this node
-	MaxDeployments  int32                  `protobuf:"varint,12,opt,name=max_deployments,json=maxDeployments,proto3" json:"max_deployments,omitempty"`    // Max deployments allowed
-	Region          *string                `protobuf:"bytes,13,opt,name=region,proto3,oneof" json:"region,omitempty"`                                     // Region identifier
-	Config          *NodeConfig            `protobuf:"bytes,14,opt,name=config,proto3" json:"config,omitempty"`                                           // Node-specific configuration
-	LastHeartbeat   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type BanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeInfo) Reset() {
-	*x = NodeInfo{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[105]
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeInfo) String() string {
+func (x *BanUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeInfo) ProtoMessage() {}
+func (*BanUserRequest) ProtoMessage() {}
 
-func (x *NodeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[105]
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7251,156 +9295,143 @@ func (x *NodeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
-func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *NodeInfo) GetId() string {
+func (x *BanUserRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *NodeInfo) GetHostname() string {
-	if x != nil {
-		return x.Hostname
+func (x *BanUserRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
 	return ""
 }
 
-func (x *NodeInfo) GetIp() string {
-	if x != nil {
-		return x.Ip
-	}
-	return ""
+type BanUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ban           *UserBanInfo           `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeInfo) GetRole() string {
-	if x != nil {
-		return x.Role
-	}
-	return ""
+func (x *BanUserResponse) Reset() {
+	*x = BanUserResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeInfo) GetAvailability() string {
-	if x != nil {
-		return x.Availability
-	}
-	return ""
+func (x *BanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NodeInfo) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
+func (*BanUserResponse) ProtoMessage() {}
 
-func (x *NodeInfo) GetTotalCpu() int32 {
+func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[143]
 	if x != nil {
-		return x.TotalCpu
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *NodeInfo) GetTotalMemory() int64 {
-	if x != nil {
-		return x.TotalMemory
-	}
-	return 0
+// Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
+func (*BanUserResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *NodeInfo) GetUsedCpu() float64 {
+func (x *BanUserResponse) GetBan() *UserBanInfo {
 	if x != nil {
-		return x.UsedCpu
+		return x.Ban
 	}
-	return 0
+	return nil
 }
 
-func (x *NodeInfo) GetUsedMemory() int64 {
+func (x *BanUserResponse) GetMessage() string {
 	if x != nil {
-		return x.UsedMemory
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *NodeInfo) GetDeploymentCount() int32 {
-	if x != nil {
-		return x.DeploymentCount
-	}
-	return 0
+type UnbanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeInfo) GetMaxDeployments() int32 {
-	if x != nil {
-		return x.MaxDeployments
-	}
-	return 0
+func (x *UnbanUserRequest) Reset() {
+	*x = UnbanUserRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeInfo) GetRegion() string {
-	if x != nil && x.Region != nil {
-		return *x.Region
-	}
-	return ""
+func (x *UnbanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NodeInfo) GetConfig() *NodeConfig {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
+func (*UnbanUserRequest) ProtoMessage() {}
 
-func (x *NodeInfo) GetLastHeartbeat() *timestamppb.Timestamp {
+func (x *UnbanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[144]
 	if x != nil {
-		return x.LastHeartbeat
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *NodeInfo) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use UnbanUserRequest.ProtoReflect.Descriptor instead.
+func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *NodeInfo) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *UnbanUserRequest) GetUserId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// Node Configuration (stored in Labels JSON field)
-type NodeConfig struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Subdomain              *string                `protobuf:"bytes,1,opt,name=subdomain,proto3,oneof" json:"subdomain,omitempty"`                                                                                               // Node subdomain identifier
-	UseNodeSpecificDomains *bool                  `protobuf:"varint,2,opt,name=use_node_specific_domains,json=useNodeSpecificDomains,proto3,oneof" json:"use_node_specific_domains,omitempty"`                                  // Enable node-specific domains
-	ServiceDomainPattern   *string                `protobuf:"bytes,3,opt,name=service_domain_pattern,json=serviceDomainPattern,proto3,oneof" json:"service_domain_pattern,omitempty"`                                           // "node-service" or "service-node"
-	CustomLabels           map[string]string      `protobuf:"bytes,4,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Custom labels
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type UnbanUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeConfig) Reset() {
-	*x = NodeConfig{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[106]
+func (x *UnbanUserResponse) Reset() {
+	*x = UnbanUserResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeConfig) String() string {
+func (x *UnbanUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeConfig) ProtoMessage() {}
+func (*UnbanUserResponse) ProtoMessage() {}
 
-func (x *NodeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[106]
+func (x *UnbanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7411,61 +9442,84 @@ func (x *NodeConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeConfig.ProtoReflect.Descriptor instead.
-func (*NodeConfig) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use UnbanUserResponse.ProtoReflect.Descriptor instead.
+func (*UnbanUserResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *NodeConfig) GetSubdomain() string {
-	if x != nil && x.Subdomain != nil {
-		return *x.Subdomain
+func (x *UnbanUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *NodeConfig) GetUseNodeSpecificDomains() bool {
-	if x != nil && x.UseNodeSpecificDomains != nil {
-		return *x.UseNodeSpecificDomains
-	}
-	return false
+type GetUserBanStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeConfig) GetServiceDomainPattern() string {
-	if x != nil && x.ServiceDomainPattern != nil {
-		return *x.ServiceDomainPattern
+func (x *GetUserBanStatusRequest) Reset() {
+	*x = GetUserBanStatusRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBanStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBanStatusRequest) ProtoMessage() {}
+
+func (x *GetUserBanStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *NodeConfig) GetCustomLabels() map[string]string {
+// Deprecated: Use GetUserBanStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetUserBanStatusRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetUserBanStatusRequest) GetUserId() string {
 	if x != nil {
-		return x.CustomLabels
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// Superadmin permissions catalog
-type ListSuperadminPermissionsRequest struct {
+type GetUserBanStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ban           *UserBanInfo           `protobuf:"bytes,1,opt,name=ban,proto3,oneof" json:"ban,omitempty"` // null if not banned/suspended
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSuperadminPermissionsRequest) Reset() {
-	*x = ListSuperadminPermissionsRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[107]
+func (x *GetUserBanStatusResponse) Reset() {
+	*x = GetUserBanStatusResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSuperadminPermissionsRequest) String() string {
+func (x *GetUserBanStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSuperadminPermissionsRequest) ProtoMessage() {}
+func (*GetUserBanStatusResponse) ProtoMessage() {}
 
-func (x *ListSuperadminPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[107]
+func (x *GetUserBanStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7476,35 +9530,48 @@ func (x *ListSuperadminPermissionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSuperadminPermissionsRequest.ProtoReflect.Descriptor instead.
-func (*ListSuperadminPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use GetUserBanStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetUserBanStatusResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{147}
 }
 
-type SuperadminPermissionDefinition struct {
+func (x *GetUserBanStatusResponse) GetBan() *UserBanInfo {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+// UserBanInfo represents a current or historical ban/suspension record
+type UserBanInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                         // e.g., admin.roles.create
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                       // human friendly
-	ResourceType  string                 `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // admin | organization
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // "suspended" | "banned"
+	Reason        *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	BannedBy      string                 `protobuf:"bytes,5,opt,name=banned_by,json=bannedBy,proto3" json:"banned_by,omitempty"` // superadmin user ID
+	BannedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=banned_at,json=bannedAt,proto3" json:"banned_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // null = permanent
+	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminPermissionDefinition) Reset() {
-	*x = SuperadminPermissionDefinition{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[108]
+func (x *UserBanInfo) Reset() {
+	*x = UserBanInfo{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminPermissionDefinition) String() string {
+func (x *UserBanInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminPermissionDefinition) ProtoMessage() {}
+func (*UserBanInfo) ProtoMessage() {}
 
-func (x *SuperadminPermissionDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[108]
+func (x *UserBanInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7515,98 +9582,97 @@ func (x *SuperadminPermissionDefinition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminPermissionDefinition.ProtoReflect.Descriptor instead.
-func (*SuperadminPermissionDefinition) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use UserBanInfo.ProtoReflect.Descriptor instead.
+func (*UserBanInfo) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *SuperadminPermissionDefinition) GetId() string {
+func (x *UserBanInfo) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *SuperadminPermissionDefinition) GetDescription() string {
+func (x *UserBanInfo) GetUserId() string {
 	if x != nil {
-		return x.Description
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *SuperadminPermissionDefinition) GetResourceType() string {
+func (x *UserBanInfo) GetType() string {
 	if x != nil {
-		return x.ResourceType
+		return x.Type
 	}
 	return ""
 }
 
-type ListSuperadminPermissionsResponse struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Permissions   []*SuperadminPermissionDefinition `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListSuperadminPermissionsResponse) Reset() {
-	*x = ListSuperadminPermissionsResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[109]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UserBanInfo) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
 }
 
-func (x *ListSuperadminPermissionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserBanInfo) GetBannedBy() string {
+	if x != nil {
+		return x.BannedBy
+	}
+	return ""
 }
 
-func (*ListSuperadminPermissionsResponse) ProtoMessage() {}
-
-func (x *ListSuperadminPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[109]
+func (x *UserBanInfo) GetBannedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BannedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListSuperadminPermissionsResponse.ProtoReflect.Descriptor instead.
-func (*ListSuperadminPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{109}
+func (x *UserBanInfo) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-func (x *ListSuperadminPermissionsResponse) GetPermissions() []*SuperadminPermissionDefinition {
+func (x *UserBanInfo) GetIsActive() bool {
 	if x != nil {
-		return x.Permissions
+		return x.IsActive
 	}
-	return nil
+	return false
 }
 
-// Get current user's superadmin permissions
-type GetMySuperadminPermissionsRequest struct {
+// BanEntry blocks logins from an IP range or with emails of a domain
+type BanEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`   // "ip_range" | "email_domain"
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"` // CIDR block, or a domain where "*.example.com" matches all subdomains of example.com
+	Reason        *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // superadmin user ID
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // null = permanent
+	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMySuperadminPermissionsRequest) Reset() {
-	*x = GetMySuperadminPermissionsRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[110]
+func (x *BanEntry) Reset() {
+	*x = BanEntry{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMySuperadminPermissionsRequest) String() string {
+func (x *BanEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMySuperadminPermissionsRequest) ProtoMessage() {}
+func (*BanEntry) ProtoMessage() {}
 
-func (x *GetMySuperadminPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[110]
+func (x *BanEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7617,85 +9683,92 @@ func (x *GetMySuperadminPermissionsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMySuperadminPermissionsRequest.ProtoReflect.Descriptor instead.
-func (*GetMySuperadminPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use BanEntry.ProtoReflect.Descriptor instead.
+func (*BanEntry) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{149}
 }
 
-type GetMySuperadminPermissionsResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Permissions      []string               `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`                                      // List of permission IDs the user has (e.g., ["superadmin.overview.read", "superadmin.vps.read"])
-	IsFullSuperadmin bool                   `protobuf:"varint,2,opt,name=is_full_superadmin,json=isFullSuperadmin,proto3" json:"is_full_superadmin,omitempty"` // true if user is a full superadmin (email-based), false if role-based
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *BanEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *GetMySuperadminPermissionsResponse) Reset() {
-	*x = GetMySuperadminPermissionsResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[111]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BanEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *GetMySuperadminPermissionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BanEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
 }
 
-func (*GetMySuperadminPermissionsResponse) ProtoMessage() {}
+func (x *BanEntry) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
 
-func (x *GetMySuperadminPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[111]
+func (x *BanEntry) GetCreatedBy() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedBy
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetMySuperadminPermissionsResponse.ProtoReflect.Descriptor instead.
-func (*GetMySuperadminPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{111}
+func (x *BanEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *GetMySuperadminPermissionsResponse) GetPermissions() []string {
+func (x *BanEntry) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Permissions
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *GetMySuperadminPermissionsResponse) GetIsFullSuperadmin() bool {
+func (x *BanEntry) GetIsActive() bool {
 	if x != nil {
-		return x.IsFullSuperadmin
+		return x.IsActive
 	}
 	return false
 }
 
-// Superadmin role management messages
-type ListSuperadminRolesRequest struct {
+type CreateBanEntryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`   // "ip_range" | "email_domain"
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // A single IP is stored as a /32 or /128 range
+	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSuperadminRolesRequest) Reset() {
-	*x = ListSuperadminRolesRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[112]
+func (x *CreateBanEntryRequest) Reset() {
+	*x = CreateBanEntryRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSuperadminRolesRequest) String() string {
+func (x *CreateBanEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSuperadminRolesRequest) ProtoMessage() {}
+func (*CreateBanEntryRequest) ProtoMessage() {}
 
-func (x *ListSuperadminRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[112]
+func (x *CreateBanEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7706,36 +9779,61 @@ func (x *ListSuperadminRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSuperadminRolesRequest.ProtoReflect.Descriptor instead.
-func (*ListSuperadminRolesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use CreateBanEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateBanEntryRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{150}
 }
 
-type SuperadminRole struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	PermissionsJson string                 `protobuf:"bytes,4,opt,name=permissions_json,json=permissionsJson,proto3" json:"permissions_json,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *CreateBanEntryRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *SuperadminRole) Reset() {
-	*x = SuperadminRole{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[113]
+func (x *CreateBanEntryRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CreateBanEntryRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *CreateBanEntryRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateBanEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *BanEntry              `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBanEntryResponse) Reset() {
+	*x = CreateBanEntryResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminRole) String() string {
+func (x *CreateBanEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminRole) ProtoMessage() {}
+func (*CreateBanEntryResponse) ProtoMessage() {}
 
-func (x *SuperadminRole) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[113]
+func (x *CreateBanEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7746,61 +9844,84 @@ func (x *SuperadminRole) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminRole.ProtoReflect.Descriptor instead.
-func (*SuperadminRole) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use CreateBanEntryResponse.ProtoReflect.Descriptor instead.
+func (*CreateBanEntryResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *SuperadminRole) GetId() string {
+func (x *CreateBanEntryResponse) GetEntry() *BanEntry {
 	if x != nil {
-		return x.Id
+		return x.Entry
 	}
-	return ""
+	return nil
+}
+
+type DeleteBanEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBanEntryRequest) Reset() {
+	*x = DeleteBanEntryRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminRole) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *DeleteBanEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SuperadminRole) GetDescription() string {
+func (*DeleteBanEntryRequest) ProtoMessage() {}
+
+func (x *DeleteBanEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[152]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SuperadminRole) GetPermissionsJson() string {
+// Deprecated: Use DeleteBanEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBanEntryRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *DeleteBanEntryRequest) GetId() string {
 	if x != nil {
-		return x.PermissionsJson
+		return x.Id
 	}
 	return ""
 }
 
-type ListSuperadminRolesResponse struct {
+type DeleteBanEntryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Roles         []*SuperadminRole      `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSuperadminRolesResponse) Reset() {
-	*x = ListSuperadminRolesResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[114]
+func (x *DeleteBanEntryResponse) Reset() {
+	*x = DeleteBanEntryResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSuperadminRolesResponse) String() string {
+func (x *DeleteBanEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSuperadminRolesResponse) ProtoMessage() {}
+func (*DeleteBanEntryResponse) ProtoMessage() {}
 
-func (x *ListSuperadminRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[114]
+func (x *DeleteBanEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7811,42 +9932,41 @@ func (x *ListSuperadminRolesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSuperadminRolesResponse.ProtoReflect.Descriptor instead.
-func (*ListSuperadminRolesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use DeleteBanEntryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBanEntryResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *ListSuperadminRolesResponse) GetRoles() []*SuperadminRole {
+func (x *DeleteBanEntryResponse) GetMessage() string {
 	if x != nil {
-		return x.Roles
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type CreateSuperadminRoleRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	PermissionsJson string                 `protobuf:"bytes,3,opt,name=permissions_json,json=permissionsJson,proto3" json:"permissions_json,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ListBanEntriesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"` // Only entries of this type
+	IncludeExpired bool                   `protobuf:"varint,2,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateSuperadminRoleRequest) Reset() {
-	*x = CreateSuperadminRoleRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[115]
+func (x *ListBanEntriesRequest) Reset() {
+	*x = ListBanEntriesRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSuperadminRoleRequest) String() string {
+func (x *ListBanEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSuperadminRoleRequest) ProtoMessage() {}
+func (*ListBanEntriesRequest) ProtoMessage() {}
 
-func (x *CreateSuperadminRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[115]
+func (x *ListBanEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7857,54 +9977,47 @@ func (x *CreateSuperadminRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSuperadminRoleRequest.ProtoReflect.Descriptor instead.
-func (*CreateSuperadminRoleRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{115}
-}
-
-func (x *CreateSuperadminRoleRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ListBanEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListBanEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *CreateSuperadminRoleRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *ListBanEntriesRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
 
-func (x *CreateSuperadminRoleRequest) GetPermissionsJson() string {
+func (x *ListBanEntriesRequest) GetIncludeExpired() bool {
 	if x != nil {
-		return x.PermissionsJson
+		return x.IncludeExpired
 	}
-	return ""
+	return false
 }
 
-type CreateSuperadminRoleResponse struct {
+type ListBanEntriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          *SuperadminRole        `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Entries       []*BanEntry            `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSuperadminRoleResponse) Reset() {
-	*x = CreateSuperadminRoleResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[116]
+func (x *ListBanEntriesResponse) Reset() {
+	*x = ListBanEntriesResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSuperadminRoleResponse) String() string {
+func (x *ListBanEntriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSuperadminRoleResponse) ProtoMessage() {}
+func (*ListBanEntriesResponse) ProtoMessage() {}
 
-func (x *CreateSuperadminRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[116]
+func (x *ListBanEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7915,43 +10028,47 @@ func (x *CreateSuperadminRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSuperadminRoleResponse.ProtoReflect.Descriptor instead.
-func (*CreateSuperadminRoleResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use ListBanEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListBanEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *CreateSuperadminRoleResponse) GetRole() *SuperadminRole {
+func (x *ListBanEntriesResponse) GetEntries() []*BanEntry {
 	if x != nil {
-		return x.Role
+		return x.Entries
 	}
 	return nil
 }
 
-type UpdateSuperadminRoleRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	PermissionsJson string                 `protobuf:"bytes,4,opt,name=permissions_json,json=permissionsJson,proto3" json:"permissions_json,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// OrgIPAllocation is a subnet an organization can take static VPS IPs from
+type OrgIPAllocation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Cidr           string                 `protobuf:"bytes,3,opt,name=cidr,proto3" json:"cidr,omitempty"` // e.g. "10.20.0.0/24"
+	Gateway        string                 `protobuf:"bytes,4,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	DnsServers     []string               `protobuf:"bytes,5,rep,name=dns_servers,json=dnsServers,proto3" json:"dns_servers,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // superadmin user ID
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateSuperadminRoleRequest) Reset() {
-	*x = UpdateSuperadminRoleRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[117]
+func (x *OrgIPAllocation) Reset() {
+	*x = OrgIPAllocation{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSuperadminRoleRequest) String() string {
+func (x *OrgIPAllocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSuperadminRoleRequest) ProtoMessage() {}
+func (*OrgIPAllocation) ProtoMessage() {}
 
-func (x *UpdateSuperadminRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[117]
+func (x *OrgIPAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7962,105 +10079,85 @@ func (x *UpdateSuperadminRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSuperadminRoleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSuperadminRoleRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use OrgIPAllocation.ProtoReflect.Descriptor instead.
+func (*OrgIPAllocation) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *UpdateSuperadminRoleRequest) GetId() string {
+func (x *OrgIPAllocation) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateSuperadminRoleRequest) GetName() string {
+func (x *OrgIPAllocation) GetOrganizationId() string {
 	if x != nil {
-		return x.Name
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *UpdateSuperadminRoleRequest) GetDescription() string {
+func (x *OrgIPAllocation) GetCidr() string {
 	if x != nil {
-		return x.Description
+		return x.Cidr
 	}
 	return ""
 }
 
-func (x *UpdateSuperadminRoleRequest) GetPermissionsJson() string {
+func (x *OrgIPAllocation) GetGateway() string {
 	if x != nil {
-		return x.PermissionsJson
+		return x.Gateway
 	}
 	return ""
 }
 
-type UpdateSuperadminRoleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          *SuperadminRole        `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateSuperadminRoleResponse) Reset() {
-	*x = UpdateSuperadminRoleResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[118]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateSuperadminRoleResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateSuperadminRoleResponse) ProtoMessage() {}
-
-func (x *UpdateSuperadminRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[118]
+func (x *OrgIPAllocation) GetDnsServers() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DnsServers
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UpdateSuperadminRoleResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSuperadminRoleResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{118}
+func (x *OrgIPAllocation) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
 }
 
-func (x *UpdateSuperadminRoleResponse) GetRole() *SuperadminRole {
+func (x *OrgIPAllocation) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Role
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type DeleteSuperadminRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AllocateStaticIPRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Cidr           string                 `protobuf:"bytes,2,opt,name=cidr,proto3" json:"cidr,omitempty"`                               // Must not overlap a subnet allocated to any organization
+	Gateway        string                 `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`                         // Host address inside the subnet
+	DnsServers     []string               `protobuf:"bytes,4,rep,name=dns_servers,json=dnsServers,proto3" json:"dns_servers,omitempty"` // Default DNS servers for VPSes using the subnet
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteSuperadminRoleRequest) Reset() {
-	*x = DeleteSuperadminRoleRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[119]
+func (x *AllocateStaticIPRequest) Reset() {
+	*x = AllocateStaticIPRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSuperadminRoleRequest) String() string {
+func (x *AllocateStaticIPRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSuperadminRoleRequest) ProtoMessage() {}
+func (*AllocateStaticIPRequest) ProtoMessage() {}
 
-func (x *DeleteSuperadminRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[119]
+func (x *AllocateStaticIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8071,40 +10168,61 @@ func (x *DeleteSuperadminRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSuperadminRoleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSuperadminRoleRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use AllocateStaticIPRequest.ProtoReflect.Descriptor instead.
+func (*AllocateStaticIPRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *DeleteSuperadminRoleRequest) GetId() string {
+func (x *AllocateStaticIPRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Id
+		return x.OrganizationId
 	}
 	return ""
 }
 
-type DeleteSuperadminRoleResponse struct {
+func (x *AllocateStaticIPRequest) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *AllocateStaticIPRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *AllocateStaticIPRequest) GetDnsServers() []string {
+	if x != nil {
+		return x.DnsServers
+	}
+	return nil
+}
+
+type AllocateStaticIPResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Allocation    *OrgIPAllocation       `protobuf:"bytes,1,opt,name=allocation,proto3" json:"allocation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSuperadminRoleResponse) Reset() {
-	*x = DeleteSuperadminRoleResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[120]
+func (x *AllocateStaticIPResponse) Reset() {
+	*x = AllocateStaticIPResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSuperadminRoleResponse) String() string {
+func (x *AllocateStaticIPResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSuperadminRoleResponse) ProtoMessage() {}
+func (*AllocateStaticIPResponse) ProtoMessage() {}
 
-func (x *DeleteSuperadminRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[120]
+func (x *AllocateStaticIPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8115,40 +10233,42 @@ func (x *DeleteSuperadminRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSuperadminRoleResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSuperadminRoleResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use AllocateStaticIPResponse.ProtoReflect.Descriptor instead.
+func (*AllocateStaticIPResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *DeleteSuperadminRoleResponse) GetSuccess() bool {
+func (x *AllocateStaticIPResponse) GetAllocation() *OrgIPAllocation {
 	if x != nil {
-		return x.Success
+		return x.Allocation
 	}
-	return false
+	return nil
 }
 
-// Superadmin role binding messages
-type ListSuperadminRoleBindingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SuspendOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Reason         *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListSuperadminRoleBindingsRequest) Reset() {
-	*x = ListSuperadminRoleBindingsRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[121]
+func (x *SuspendOrganizationRequest) Reset() {
+	*x = SuspendOrganizationRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSuperadminRoleBindingsRequest) String() string {
+func (x *SuspendOrganizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSuperadminRoleBindingsRequest) ProtoMessage() {}
-
-func (x *ListSuperadminRoleBindingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[121]
+func (*SuspendOrganizationRequest) ProtoMessage() {}
+
+func (x *SuspendOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8159,35 +10279,55 @@ func (x *ListSuperadminRoleBindingsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSuperadminRoleBindingsRequest.ProtoReflect.Descriptor instead.
-func (*ListSuperadminRoleBindingsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use SuspendOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*SuspendOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{159}
 }
 
-type SuperadminRoleBinding struct {
+func (x *SuspendOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SuspendOrganizationRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *SuspendOrganizationRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SuspendOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RoleId        string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // new status
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminRoleBinding) Reset() {
-	*x = SuperadminRoleBinding{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[122]
+func (x *SuspendOrganizationResponse) Reset() {
+	*x = SuspendOrganizationResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminRoleBinding) String() string {
+func (x *SuspendOrganizationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminRoleBinding) ProtoMessage() {}
+func (*SuspendOrganizationResponse) ProtoMessage() {}
 
-func (x *SuperadminRoleBinding) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[122]
+func (x *SuspendOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8198,54 +10338,47 @@ func (x *SuperadminRoleBinding) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminRoleBinding.ProtoReflect.Descriptor instead.
-func (*SuperadminRoleBinding) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{122}
-}
-
-func (x *SuperadminRoleBinding) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use SuspendOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*SuspendOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *SuperadminRoleBinding) GetUserId() string {
+func (x *SuspendOrganizationResponse) GetMessage() string {
 	if x != nil {
-		return x.UserId
+		return x.Message
 	}
 	return ""
 }
 
-func (x *SuperadminRoleBinding) GetRoleId() string {
+func (x *SuspendOrganizationResponse) GetStatus() string {
 	if x != nil {
-		return x.RoleId
+		return x.Status
 	}
 	return ""
 }
 
-type ListSuperadminRoleBindingsResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Bindings      []*SuperadminRoleBinding `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UnsuspendOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListSuperadminRoleBindingsResponse) Reset() {
-	*x = ListSuperadminRoleBindingsResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[123]
+func (x *UnsuspendOrganizationRequest) Reset() {
+	*x = UnsuspendOrganizationRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSuperadminRoleBindingsResponse) String() string {
+func (x *UnsuspendOrganizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSuperadminRoleBindingsResponse) ProtoMessage() {}
+func (*UnsuspendOrganizationRequest) ProtoMessage() {}
 
-func (x *ListSuperadminRoleBindingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[123]
+func (x *UnsuspendOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8256,41 +10389,40 @@ func (x *ListSuperadminRoleBindingsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSuperadminRoleBindingsResponse.ProtoReflect.Descriptor instead.
-func (*ListSuperadminRoleBindingsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{123}
+// Deprecated: Use UnsuspendOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*UnsuspendOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *ListSuperadminRoleBindingsResponse) GetBindings() []*SuperadminRoleBinding {
+func (x *UnsuspendOrganizationRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Bindings
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-type CreateSuperadminRoleBindingRequest struct {
+type UnsuspendOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RoleId        string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSuperadminRoleBindingRequest) Reset() {
-	*x = CreateSuperadminRoleBindingRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[124]
+func (x *UnsuspendOrganizationResponse) Reset() {
+	*x = UnsuspendOrganizationResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSuperadminRoleBindingRequest) String() string {
+func (x *UnsuspendOrganizationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSuperadminRoleBindingRequest) ProtoMessage() {}
+func (*UnsuspendOrganizationResponse) ProtoMessage() {}
 
-func (x *CreateSuperadminRoleBindingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[124]
+func (x *UnsuspendOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8301,47 +10433,41 @@ func (x *CreateSuperadminRoleBindingRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSuperadminRoleBindingRequest.ProtoReflect.Descriptor instead.
-func (*CreateSuperadminRoleBindingRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{124}
-}
-
-func (x *CreateSuperadminRoleBindingRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use UnsuspendOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*UnsuspendOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *CreateSuperadminRoleBindingRequest) GetRoleId() string {
+func (x *UnsuspendOrganizationResponse) GetMessage() string {
 	if x != nil {
-		return x.RoleId
+		return x.Message
 	}
 	return ""
 }
 
-type CreateSuperadminRoleBindingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Binding       *SuperadminRoleBinding `protobuf:"bytes,1,opt,name=binding,proto3" json:"binding,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BanOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Reason         *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateSuperadminRoleBindingResponse) Reset() {
-	*x = CreateSuperadminRoleBindingResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[125]
+func (x *BanOrganizationRequest) Reset() {
+	*x = BanOrganizationRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSuperadminRoleBindingResponse) String() string {
+func (x *BanOrganizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSuperadminRoleBindingResponse) ProtoMessage() {}
+func (*BanOrganizationRequest) ProtoMessage() {}
 
-func (x *CreateSuperadminRoleBindingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[125]
+func (x *BanOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8352,40 +10478,48 @@ func (x *CreateSuperadminRoleBindingResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSuperadminRoleBindingResponse.ProtoReflect.Descriptor instead.
-func (*CreateSuperadminRoleBindingResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use BanOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*BanOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *CreateSuperadminRoleBindingResponse) GetBinding() *SuperadminRoleBinding {
+func (x *BanOrganizationRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Binding
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-type DeleteSuperadminRoleBindingRequest struct {
+func (x *BanOrganizationRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type BanOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSuperadminRoleBindingRequest) Reset() {
-	*x = DeleteSuperadminRoleBindingRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[126]
+func (x *BanOrganizationResponse) Reset() {
+	*x = BanOrganizationResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSuperadminRoleBindingRequest) String() string {
+func (x *BanOrganizationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSuperadminRoleBindingRequest) ProtoMessage() {}
+func (*BanOrganizationResponse) ProtoMessage() {}
 
-func (x *DeleteSuperadminRoleBindingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[126]
+func (x *BanOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8396,40 +10530,47 @@ func (x *DeleteSuperadminRoleBindingRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSuperadminRoleBindingRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSuperadminRoleBindingRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use BanOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*BanOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *DeleteSuperadminRoleBindingRequest) GetId() string {
+func (x *BanOrganizationResponse) GetMessage() string {
 	if x != nil {
-		return x.Id
+		return x.Message
 	}
 	return ""
 }
 
-type DeleteSuperadminRoleBindingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BanOrganizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *DeleteSuperadminRoleBindingResponse) Reset() {
-	*x = DeleteSuperadminRoleBindingResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[127]
+type UnbanOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnbanOrganizationRequest) Reset() {
+	*x = UnbanOrganizationRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSuperadminRoleBindingResponse) String() string {
+func (x *UnbanOrganizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSuperadminRoleBindingResponse) ProtoMessage() {}
+func (*UnbanOrganizationRequest) ProtoMessage() {}
 
-func (x *DeleteSuperadminRoleBindingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[127]
+func (x *UnbanOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8440,42 +10581,40 @@ func (x *DeleteSuperadminRoleBindingResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSuperadminRoleBindingResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSuperadminRoleBindingResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use UnbanOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*UnbanOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *DeleteSuperadminRoleBindingResponse) GetSuccess() bool {
+func (x *UnbanOrganizationRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Success
+		return x.OrganizationId
 	}
-	return false
+	return ""
 }
 
-type SuspendUserRequest struct {
+type UnbanOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // null = indefinite
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuspendUserRequest) Reset() {
-	*x = SuspendUserRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[128]
+func (x *UnbanOrganizationResponse) Reset() {
+	*x = UnbanOrganizationResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuspendUserRequest) String() string {
+func (x *UnbanOrganizationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspendUserRequest) ProtoMessage() {}
+func (*UnbanOrganizationResponse) ProtoMessage() {}
 
-func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[128]
+func (x *UnbanOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8486,55 +10625,46 @@ func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
-func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use UnbanOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*UnbanOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *SuspendUserRequest) GetUserId() string {
+func (x *UnbanOrganizationResponse) GetMessage() string {
 	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *SuspendUserRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+		return x.Message
 	}
 	return ""
 }
 
-func (x *SuspendUserRequest) GetExpiresAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return nil
-}
-
-type SuspendUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ban           *UserBanInfo           `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GameServerOverview struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GameServer       *v14.GameServer        `protobuf:"bytes,1,opt,name=game_server,json=gameServer,proto3" json:"game_server,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	CreatedByUser    *UserInfo              `protobuf:"bytes,3,opt,name=created_by_user,json=createdByUser,proto3,oneof" json:"created_by_user,omitempty"`
+	IsSuspended      bool                   `protobuf:"varint,4,opt,name=is_suspended,json=isSuspended,proto3" json:"is_suspended,omitempty"`
+	SuspensionReason *string                `protobuf:"bytes,5,opt,name=suspension_reason,json=suspensionReason,proto3,oneof" json:"suspension_reason,omitempty"`
+	OwnerId          *string                `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`       // Organization owner user ID
+	OwnerName        *string                `protobuf:"bytes,7,opt,name=owner_name,json=ownerName,proto3,oneof" json:"owner_name,omitempty"` // Organization owner name or email
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SuspendUserResponse) Reset() {
-	*x = SuspendUserResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[129]
+func (x *GameServerOverview) Reset() {
+	*x = GameServerOverview{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuspendUserResponse) String() string {
+func (x *GameServerOverview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspendUserResponse) ProtoMessage() {}
+func (*GameServerOverview) ProtoMessage() {}
 
-func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[129]
+func (x *GameServerOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8545,47 +10675,87 @@ func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
-func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use GameServerOverview.ProtoReflect.Descriptor instead.
+func (*GameServerOverview) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *SuspendUserResponse) GetBan() *UserBanInfo {
+func (x *GameServerOverview) GetGameServer() *v14.GameServer {
 	if x != nil {
-		return x.Ban
+		return x.GameServer
 	}
 	return nil
 }
 
-func (x *SuspendUserResponse) GetMessage() string {
+func (x *GameServerOverview) GetOrganizationName() string {
 	if x != nil {
-		return x.Message
+		return x.OrganizationName
 	}
 	return ""
 }
 
-type UnsuspendUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GameServerOverview) GetCreatedByUser() *UserInfo {
+	if x != nil {
+		return x.CreatedByUser
+	}
+	return nil
 }
 
-func (x *UnsuspendUserRequest) Reset() {
-	*x = UnsuspendUserRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[130]
+func (x *GameServerOverview) GetIsSuspended() bool {
+	if x != nil {
+		return x.IsSuspended
+	}
+	return false
+}
+
+func (x *GameServerOverview) GetSuspensionReason() string {
+	if x != nil && x.SuspensionReason != nil {
+		return *x.SuspensionReason
+	}
+	return ""
+}
+
+func (x *GameServerOverview) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *GameServerOverview) GetOwnerName() string {
+	if x != nil && x.OwnerName != nil {
+		return *x.OwnerName
+	}
+	return ""
+}
+
+type ListAllGameServersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	Status         *v14.GameServerStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=obiente.cloud.gameservers.v1.GameServerStatus,oneof" json:"status,omitempty"`
+	Search         *string                `protobuf:"bytes,3,opt,name=search,proto3,oneof" json:"search,omitempty"` // name, ID, org
+	Page           *int32                 `protobuf:"varint,4,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PerPage        *int32                 `protobuf:"varint,5,opt,name=per_page,json=perPage,proto3,oneof" json:"per_page,omitempty"`
+	FlaggedOnly    *bool                  `protobuf:"varint,6,opt,name=flagged_only,json=flaggedOnly,proto3,oneof" json:"flagged_only,omitempty"` // only servers flagged by abuse detection
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAllGameServersRequest) Reset() {
+	*x = ListAllGameServersRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsuspendUserRequest) String() string {
+func (x *ListAllGameServersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsuspendUserRequest) ProtoMessage() {}
+func (*ListAllGameServersRequest) ProtoMessage() {}
 
-func (x *UnsuspendUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[130]
+func (x *ListAllGameServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8596,40 +10766,76 @@ func (x *UnsuspendUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsuspendUserRequest.ProtoReflect.Descriptor instead.
-func (*UnsuspendUserRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{130}
+// Deprecated: Use ListAllGameServersRequest.ProtoReflect.Descriptor instead.
+func (*ListAllGameServersRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *UnsuspendUserRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
+func (x *ListAllGameServersRequest) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
 	}
 	return ""
 }
 
-type UnsuspendUserResponse struct {
+func (x *ListAllGameServersRequest) GetStatus() v14.GameServerStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return v14.GameServerStatus(0)
+}
+
+func (x *ListAllGameServersRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *ListAllGameServersRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListAllGameServersRequest) GetPerPage() int32 {
+	if x != nil && x.PerPage != nil {
+		return *x.PerPage
+	}
+	return 0
+}
+
+func (x *ListAllGameServersRequest) GetFlaggedOnly() bool {
+	if x != nil && x.FlaggedOnly != nil {
+		return *x.FlaggedOnly
+	}
+	return false
+}
+
+type ListAllGameServersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	GameServers   []*GameServerOverview  `protobuf:"bytes,1,rep,name=game_servers,json=gameServers,proto3" json:"game_servers,omitempty"`
+	Pagination    *v12.Pagination        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnsuspendUserResponse) Reset() {
-	*x = UnsuspendUserResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[131]
+func (x *ListAllGameServersResponse) Reset() {
+	*x = ListAllGameServersResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsuspendUserResponse) String() string {
+func (x *ListAllGameServersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsuspendUserResponse) ProtoMessage() {}
+func (*ListAllGameServersResponse) ProtoMessage() {}
 
-func (x *UnsuspendUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[131]
+func (x *ListAllGameServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8640,41 +10846,47 @@ func (x *UnsuspendUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsuspendUserResponse.ProtoReflect.Descriptor instead.
-func (*UnsuspendUserResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{131}
+// Deprecated: Use ListAllGameServersResponse.ProtoReflect.Descriptor instead.
+func (*ListAllGameServersResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *UnsuspendUserResponse) GetMessage() string {
+func (x *ListAllGameServersResponse) GetGameServers() []*GameServerOverview {
 	if x != nil {
-		return x.Message
+		return x.GameServers
 	}
-	return ""
+	return nil
 }
 
-type BanUserRequest struct {
+func (x *ListAllGameServersResponse) GetPagination() *v12.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SuperadminGetGameServerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BanUserRequest) Reset() {
-	*x = BanUserRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[132]
+func (x *SuperadminGetGameServerRequest) Reset() {
+	*x = SuperadminGetGameServerRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BanUserRequest) String() string {
+func (x *SuperadminGetGameServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BanUserRequest) ProtoMessage() {}
+func (*SuperadminGetGameServerRequest) ProtoMessage() {}
 
-func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[132]
+func (x *SuperadminGetGameServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8685,48 +10897,40 @@ func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
-func (*BanUserRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use SuperadminGetGameServerRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminGetGameServerRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *BanUserRequest) GetUserId() string {
+func (x *SuperadminGetGameServerRequest) GetGameServerId() string {
 	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *BanUserRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+		return x.GameServerId
 	}
 	return ""
 }
 
-type BanUserResponse struct {
+type SuperadminGetGameServerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ban           *UserBanInfo           `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	GameServer    *GameServerOverview    `protobuf:"bytes,1,opt,name=game_server,json=gameServer,proto3" json:"game_server,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BanUserResponse) Reset() {
-	*x = BanUserResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[133]
+func (x *SuperadminGetGameServerResponse) Reset() {
+	*x = SuperadminGetGameServerResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BanUserResponse) String() string {
+func (x *SuperadminGetGameServerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BanUserResponse) ProtoMessage() {}
+func (*SuperadminGetGameServerResponse) ProtoMessage() {}
 
-func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[133]
+func (x *SuperadminGetGameServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8737,47 +10941,41 @@ func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
-func (*BanUserResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use SuperadminGetGameServerResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminGetGameServerResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *BanUserResponse) GetBan() *UserBanInfo {
+func (x *SuperadminGetGameServerResponse) GetGameServer() *GameServerOverview {
 	if x != nil {
-		return x.Ban
+		return x.GameServer
 	}
 	return nil
 }
 
-func (x *BanUserResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type UnbanUserRequest struct {
+type SuperadminSuspendGameServerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnbanUserRequest) Reset() {
-	*x = UnbanUserRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[134]
+func (x *SuperadminSuspendGameServerRequest) Reset() {
+	*x = SuperadminSuspendGameServerRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnbanUserRequest) String() string {
+func (x *SuperadminSuspendGameServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnbanUserRequest) ProtoMessage() {}
+func (*SuperadminSuspendGameServerRequest) ProtoMessage() {}
 
-func (x *UnbanUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[134]
+func (x *SuperadminSuspendGameServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8788,40 +10986,48 @@ func (x *UnbanUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnbanUserRequest.ProtoReflect.Descriptor instead.
-func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use SuperadminSuspendGameServerRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminSuspendGameServerRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *UnbanUserRequest) GetUserId() string {
+func (x *SuperadminSuspendGameServerRequest) GetGameServerId() string {
 	if x != nil {
-		return x.UserId
+		return x.GameServerId
 	}
 	return ""
 }
 
-type UnbanUserResponse struct {
+func (x *SuperadminSuspendGameServerRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type SuperadminSuspendGameServerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	GameServer    *v14.GameServer        `protobuf:"bytes,1,opt,name=game_server,json=gameServer,proto3" json:"game_server,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnbanUserResponse) Reset() {
-	*x = UnbanUserResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[135]
+func (x *SuperadminSuspendGameServerResponse) Reset() {
+	*x = SuperadminSuspendGameServerResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnbanUserResponse) String() string {
+func (x *SuperadminSuspendGameServerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnbanUserResponse) ProtoMessage() {}
+func (*SuperadminSuspendGameServerResponse) ProtoMessage() {}
 
-func (x *UnbanUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[135]
+func (x *SuperadminSuspendGameServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8832,40 +11038,47 @@ func (x *UnbanUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnbanUserResponse.ProtoReflect.Descriptor instead.
-func (*UnbanUserResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{135}
+// Deprecated: Use SuperadminSuspendGameServerResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminSuspendGameServerResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *UnbanUserResponse) GetMessage() string {
+func (x *SuperadminSuspendGameServerResponse) GetGameServer() *v14.GameServer {
+	if x != nil {
+		return x.GameServer
+	}
+	return nil
+}
+
+func (x *SuperadminSuspendGameServerResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetUserBanStatusRequest struct {
+type SuperadminUnsuspendGameServerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserBanStatusRequest) Reset() {
-	*x = GetUserBanStatusRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[136]
+func (x *SuperadminUnsuspendGameServerRequest) Reset() {
+	*x = SuperadminUnsuspendGameServerRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserBanStatusRequest) String() string {
+func (x *SuperadminUnsuspendGameServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserBanStatusRequest) ProtoMessage() {}
+func (*SuperadminUnsuspendGameServerRequest) ProtoMessage() {}
 
-func (x *GetUserBanStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[136]
+func (x *SuperadminUnsuspendGameServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8876,40 +11089,41 @@ func (x *GetUserBanStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserBanStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetUserBanStatusRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{136}
+// Deprecated: Use SuperadminUnsuspendGameServerRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminUnsuspendGameServerRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *GetUserBanStatusRequest) GetUserId() string {
+func (x *SuperadminUnsuspendGameServerRequest) GetGameServerId() string {
 	if x != nil {
-		return x.UserId
+		return x.GameServerId
 	}
 	return ""
 }
 
-type GetUserBanStatusResponse struct {
+type SuperadminUnsuspendGameServerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ban           *UserBanInfo           `protobuf:"bytes,1,opt,name=ban,proto3,oneof" json:"ban,omitempty"` // null if not banned/suspended
+	GameServer    *v14.GameServer        `protobuf:"bytes,1,opt,name=game_server,json=gameServer,proto3" json:"game_server,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserBanStatusResponse) Reset() {
-	*x = GetUserBanStatusResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[137]
+func (x *SuperadminUnsuspendGameServerResponse) Reset() {
+	*x = SuperadminUnsuspendGameServerResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserBanStatusResponse) String() string {
+func (x *SuperadminUnsuspendGameServerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserBanStatusResponse) ProtoMessage() {}
+func (*SuperadminUnsuspendGameServerResponse) ProtoMessage() {}
 
-func (x *GetUserBanStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[137]
+func (x *SuperadminUnsuspendGameServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8920,48 +11134,48 @@ func (x *GetUserBanStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserBanStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetUserBanStatusResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{137}
+// Deprecated: Use SuperadminUnsuspendGameServerResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminUnsuspendGameServerResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *GetUserBanStatusResponse) GetBan() *UserBanInfo {
+func (x *SuperadminUnsuspendGameServerResponse) GetGameServer() *v14.GameServer {
 	if x != nil {
-		return x.Ban
+		return x.GameServer
 	}
 	return nil
 }
 
-// UserBanInfo represents a current or historical ban/suspension record
-type UserBanInfo struct {
+func (x *SuperadminUnsuspendGameServerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SuperadminForceStopGameServerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // "suspended" | "banned"
-	Reason        *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
-	BannedBy      string                 `protobuf:"bytes,5,opt,name=banned_by,json=bannedBy,proto3" json:"banned_by,omitempty"` // superadmin user ID
-	BannedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=banned_at,json=bannedAt,proto3" json:"banned_at,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // null = permanent
-	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserBanInfo) Reset() {
-	*x = UserBanInfo{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[138]
+func (x *SuperadminForceStopGameServerRequest) Reset() {
+	*x = SuperadminForceStopGameServerRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserBanInfo) String() string {
+func (x *SuperadminForceStopGameServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserBanInfo) ProtoMessage() {}
+func (*SuperadminForceStopGameServerRequest) ProtoMessage() {}
 
-func (x *UserBanInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[138]
+func (x *SuperadminForceStopGameServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8972,91 +11186,101 @@ func (x *UserBanInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserBanInfo.ProtoReflect.Descriptor instead.
-func (*UserBanInfo) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{138}
+// Deprecated: Use SuperadminForceStopGameServerRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminForceStopGameServerRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *UserBanInfo) GetId() string {
+func (x *SuperadminForceStopGameServerRequest) GetGameServerId() string {
 	if x != nil {
-		return x.Id
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *UserBanInfo) GetUserId() string {
-	if x != nil {
-		return x.UserId
+func (x *SuperadminForceStopGameServerRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
 	return ""
 }
 
-func (x *UserBanInfo) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+type SuperadminForceStopGameServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServer    *v14.GameServer        `protobuf:"bytes,1,opt,name=game_server,json=gameServer,proto3" json:"game_server,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserBanInfo) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
-	}
-	return ""
+func (x *SuperadminForceStopGameServerResponse) Reset() {
+	*x = SuperadminForceStopGameServerResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserBanInfo) GetBannedBy() string {
-	if x != nil {
-		return x.BannedBy
-	}
-	return ""
+func (x *SuperadminForceStopGameServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserBanInfo) GetBannedAt() *timestamppb.Timestamp {
+func (*SuperadminForceStopGameServerResponse) ProtoMessage() {}
+
+func (x *SuperadminForceStopGameServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[177]
 	if x != nil {
-		return x.BannedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UserBanInfo) GetExpiresAt() *timestamppb.Timestamp {
+// Deprecated: Use SuperadminForceStopGameServerResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminForceStopGameServerResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *SuperadminForceStopGameServerResponse) GetGameServer() *v14.GameServer {
 	if x != nil {
-		return x.ExpiresAt
+		return x.GameServer
 	}
 	return nil
 }
 
-func (x *UserBanInfo) GetIsActive() bool {
+func (x *SuperadminForceStopGameServerResponse) GetMessage() string {
 	if x != nil {
-		return x.IsActive
+		return x.Message
 	}
-	return false
-}
-
-type SuspendOrganizationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Reason         *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
-	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	return ""
 }
 
-func (x *SuspendOrganizationRequest) Reset() {
-	*x = SuspendOrganizationRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[139]
+type SuperadminForceDeleteGameServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	HardDelete    bool                   `protobuf:"varint,3,opt,name=hard_delete,json=hardDelete,proto3" json:"hard_delete,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperadminForceDeleteGameServerRequest) Reset() {
+	*x = SuperadminForceDeleteGameServerRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuspendOrganizationRequest) String() string {
+func (x *SuperadminForceDeleteGameServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspendOrganizationRequest) ProtoMessage() {}
+func (*SuperadminForceDeleteGameServerRequest) ProtoMessage() {}
 
-func (x *SuspendOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[139]
+func (x *SuperadminForceDeleteGameServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9067,55 +11291,55 @@ func (x *SuspendOrganizationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspendOrganizationRequest.ProtoReflect.Descriptor instead.
-func (*SuspendOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{139}
+// Deprecated: Use SuperadminForceDeleteGameServerRequest.ProtoReflect.Descriptor instead.
+func (*SuperadminForceDeleteGameServerRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *SuspendOrganizationRequest) GetOrganizationId() string {
+func (x *SuperadminForceDeleteGameServerRequest) GetGameServerId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.GameServerId
 	}
 	return ""
 }
 
-func (x *SuspendOrganizationRequest) GetReason() string {
+func (x *SuperadminForceDeleteGameServerRequest) GetReason() string {
 	if x != nil && x.Reason != nil {
 		return *x.Reason
 	}
 	return ""
 }
 
-func (x *SuspendOrganizationRequest) GetExpiresAt() *timestamppb.Timestamp {
+func (x *SuperadminForceDeleteGameServerRequest) GetHardDelete() bool {
 	if x != nil {
-		return x.ExpiresAt
+		return x.HardDelete
 	}
-	return nil
+	return false
 }
 
-type SuspendOrganizationResponse struct {
+type SuperadminForceDeleteGameServerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // new status
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuspendOrganizationResponse) Reset() {
-	*x = SuspendOrganizationResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[140]
+func (x *SuperadminForceDeleteGameServerResponse) Reset() {
+	*x = SuperadminForceDeleteGameServerResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuspendOrganizationResponse) String() string {
+func (x *SuperadminForceDeleteGameServerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspendOrganizationResponse) ProtoMessage() {}
+func (*SuperadminForceDeleteGameServerResponse) ProtoMessage() {}
 
-func (x *SuspendOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[140]
+func (x *SuperadminForceDeleteGameServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9126,47 +11350,47 @@ func (x *SuspendOrganizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspendOrganizationResponse.ProtoReflect.Descriptor instead.
-func (*SuspendOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{140}
+// Deprecated: Use SuperadminForceDeleteGameServerResponse.ProtoReflect.Descriptor instead.
+func (*SuperadminForceDeleteGameServerResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *SuspendOrganizationResponse) GetMessage() string {
+func (x *SuperadminForceDeleteGameServerResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Message
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *SuspendOrganizationResponse) GetStatus() string {
+func (x *SuperadminForceDeleteGameServerResponse) GetMessage() string {
 	if x != nil {
-		return x.Status
+		return x.Message
 	}
 	return ""
 }
 
-type UnsuspendOrganizationRequest struct {
+type LiftSuspensionRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UnsuspendOrganizationRequest) Reset() {
-	*x = UnsuspendOrganizationRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[141]
+func (x *LiftSuspensionRequest) Reset() {
+	*x = LiftSuspensionRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsuspendOrganizationRequest) String() string {
+func (x *LiftSuspensionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsuspendOrganizationRequest) ProtoMessage() {}
+func (*LiftSuspensionRequest) ProtoMessage() {}
 
-func (x *UnsuspendOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[141]
+func (x *LiftSuspensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9177,40 +11401,41 @@ func (x *UnsuspendOrganizationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsuspendOrganizationRequest.ProtoReflect.Descriptor instead.
-func (*UnsuspendOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{141}
+// Deprecated: Use LiftSuspensionRequest.ProtoReflect.Descriptor instead.
+func (*LiftSuspensionRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *UnsuspendOrganizationRequest) GetOrganizationId() string {
+func (x *LiftSuspensionRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-type UnsuspendOrganizationResponse struct {
+type LiftSuspensionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // new status
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnsuspendOrganizationResponse) Reset() {
-	*x = UnsuspendOrganizationResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[142]
+func (x *LiftSuspensionResponse) Reset() {
+	*x = LiftSuspensionResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsuspendOrganizationResponse) String() string {
+func (x *LiftSuspensionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsuspendOrganizationResponse) ProtoMessage() {}
+func (*LiftSuspensionResponse) ProtoMessage() {}
 
-func (x *UnsuspendOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[142]
+func (x *LiftSuspensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9221,41 +11446,47 @@ func (x *UnsuspendOrganizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsuspendOrganizationResponse.ProtoReflect.Descriptor instead.
-func (*UnsuspendOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{142}
+// Deprecated: Use LiftSuspensionResponse.ProtoReflect.Descriptor instead.
+func (*LiftSuspensionResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *UnsuspendOrganizationResponse) GetMessage() string {
+func (x *LiftSuspensionResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type BanOrganizationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Reason         *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *LiftSuspensionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *BanOrganizationRequest) Reset() {
-	*x = BanOrganizationRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[143]
+// Get Platform Usage Summary Request
+type GetPlatformUsageSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformUsageSummaryRequest) Reset() {
+	*x = GetPlatformUsageSummaryRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BanOrganizationRequest) String() string {
+func (x *GetPlatformUsageSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BanOrganizationRequest) ProtoMessage() {}
+func (*GetPlatformUsageSummaryRequest) ProtoMessage() {}
 
-func (x *BanOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[143]
+func (x *GetPlatformUsageSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9266,48 +11497,130 @@ func (x *BanOrganizationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BanOrganizationRequest.ProtoReflect.Descriptor instead.
-func (*BanOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{143}
+// Deprecated: Use GetPlatformUsageSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformUsageSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *BanOrganizationRequest) GetOrganizationId() string {
+// Resources of one organization that reported usage this month
+type OrganizationResourceUsage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	DeploymentCount  int64                  `protobuf:"varint,3,opt,name=deployment_count,json=deploymentCount,proto3" json:"deployment_count,omitempty"`
+	GameServerCount  int64                  `protobuf:"varint,4,opt,name=game_server_count,json=gameServerCount,proto3" json:"game_server_count,omitempty"`
+	VpsCount         int64                  `protobuf:"varint,5,opt,name=vps_count,json=vpsCount,proto3" json:"vps_count,omitempty"`
+	TotalResources   int64                  `protobuf:"varint,6,opt,name=total_resources,json=totalResources,proto3" json:"total_resources,omitempty"`
+	ResourceHours    int64                  `protobuf:"varint,7,opt,name=resource_hours,json=resourceHours,proto3" json:"resource_hours,omitempty"` // Hours of recorded usage summed over all resources
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OrganizationResourceUsage) Reset() {
+	*x = OrganizationResourceUsage{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationResourceUsage) ProtoMessage() {}
+
+func (x *OrganizationResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationResourceUsage.ProtoReflect.Descriptor instead.
+func (*OrganizationResourceUsage) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *OrganizationResourceUsage) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *BanOrganizationRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+func (x *OrganizationResourceUsage) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
 	}
 	return ""
 }
 
-type BanOrganizationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrganizationResourceUsage) GetDeploymentCount() int64 {
+	if x != nil {
+		return x.DeploymentCount
+	}
+	return 0
 }
 
-func (x *BanOrganizationResponse) Reset() {
-	*x = BanOrganizationResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[144]
+func (x *OrganizationResourceUsage) GetGameServerCount() int64 {
+	if x != nil {
+		return x.GameServerCount
+	}
+	return 0
+}
+
+func (x *OrganizationResourceUsage) GetVpsCount() int64 {
+	if x != nil {
+		return x.VpsCount
+	}
+	return 0
+}
+
+func (x *OrganizationResourceUsage) GetTotalResources() int64 {
+	if x != nil {
+		return x.TotalResources
+	}
+	return 0
+}
+
+func (x *OrganizationResourceUsage) GetResourceHours() int64 {
+	if x != nil {
+		return x.ResourceHours
+	}
+	return 0
+}
+
+// Active resources placed in one region
+type RegionResourceUsage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Region          string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	DeploymentCount int64                  `protobuf:"varint,2,opt,name=deployment_count,json=deploymentCount,proto3" json:"deployment_count,omitempty"`
+	GameServerCount int64                  `protobuf:"varint,3,opt,name=game_server_count,json=gameServerCount,proto3" json:"game_server_count,omitempty"`
+	VpsCount        int64                  `protobuf:"varint,4,opt,name=vps_count,json=vpsCount,proto3" json:"vps_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RegionResourceUsage) Reset() {
+	*x = RegionResourceUsage{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BanOrganizationResponse) String() string {
+func (x *RegionResourceUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BanOrganizationResponse) ProtoMessage() {}
+func (*RegionResourceUsage) ProtoMessage() {}
 
-func (x *BanOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[144]
+func (x *RegionResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9318,91 +11631,156 @@ func (x *BanOrganizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BanOrganizationResponse.ProtoReflect.Descriptor instead.
-func (*BanOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{144}
+// Deprecated: Use RegionResourceUsage.ProtoReflect.Descriptor instead.
+func (*RegionResourceUsage) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *BanOrganizationResponse) GetMessage() string {
+func (x *RegionResourceUsage) GetRegion() string {
 	if x != nil {
-		return x.Message
+		return x.Region
 	}
 	return ""
 }
 
-func (x *BanOrganizationResponse) GetStatus() string {
+func (x *RegionResourceUsage) GetDeploymentCount() int64 {
 	if x != nil {
-		return x.Status
+		return x.DeploymentCount
 	}
-	return ""
+	return 0
 }
 
-type UnbanOrganizationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *RegionResourceUsage) GetGameServerCount() int64 {
+	if x != nil {
+		return x.GameServerCount
+	}
+	return 0
 }
 
-func (x *UnbanOrganizationRequest) Reset() {
-	*x = UnbanOrganizationRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[145]
+func (x *RegionResourceUsage) GetVpsCount() int64 {
+	if x != nil {
+		return x.VpsCount
+	}
+	return 0
+}
+
+// Get Platform Usage Summary Response
+type GetPlatformUsageSummaryResponse struct {
+	state                protoimpl.MessageState       `protogen:"open.v1"`
+	ActiveDeployments    int64                        `protobuf:"varint,1,opt,name=active_deployments,json=activeDeployments,proto3" json:"active_deployments,omitempty"` // Deployments that reported usage in the last 24 hours
+	ActiveGameServers    int64                        `protobuf:"varint,2,opt,name=active_game_servers,json=activeGameServers,proto3" json:"active_game_servers,omitempty"`
+	ActiveVps            int64                        `protobuf:"varint,3,opt,name=active_vps,json=activeVps,proto3" json:"active_vps,omitempty"`
+	CreditsConsumedCents int64                        `protobuf:"varint,4,opt,name=credits_consumed_cents,json=creditsConsumedCents,proto3" json:"credits_consumed_cents,omitempty"` // Usage charges since the start of the month
+	TopOrganizations     []*OrganizationResourceUsage `protobuf:"bytes,5,rep,name=top_organizations,json=topOrganizations,proto3" json:"top_organizations,omitempty"`                // Top 10 organizations by resource count
+	Regions              []*RegionResourceUsage       `protobuf:"bytes,6,rep,name=regions,proto3" json:"regions,omitempty"`
+	GeneratedAt          *timestamppb.Timestamp       `protobuf:"bytes,7,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetPlatformUsageSummaryResponse) Reset() {
+	*x = GetPlatformUsageSummaryResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnbanOrganizationRequest) String() string {
+func (x *GetPlatformUsageSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnbanOrganizationRequest) ProtoMessage() {}
+func (*GetPlatformUsageSummaryResponse) ProtoMessage() {}
 
-func (x *UnbanOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[145]
+func (x *GetPlatformUsageSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformUsageSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetPlatformUsageSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *GetPlatformUsageSummaryResponse) GetActiveDeployments() int64 {
+	if x != nil {
+		return x.ActiveDeployments
+	}
+	return 0
+}
+
+func (x *GetPlatformUsageSummaryResponse) GetActiveGameServers() int64 {
+	if x != nil {
+		return x.ActiveGameServers
+	}
+	return 0
+}
+
+func (x *GetPlatformUsageSummaryResponse) GetActiveVps() int64 {
+	if x != nil {
+		return x.ActiveVps
+	}
+	return 0
+}
+
+func (x *GetPlatformUsageSummaryResponse) GetCreditsConsumedCents() int64 {
+	if x != nil {
+		return x.CreditsConsumedCents
+	}
+	return 0
+}
+
+func (x *GetPlatformUsageSummaryResponse) GetTopOrganizations() []*OrganizationResourceUsage {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TopOrganizations
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UnbanOrganizationRequest.ProtoReflect.Descriptor instead.
-func (*UnbanOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{145}
+func (x *GetPlatformUsageSummaryResponse) GetRegions() []*RegionResourceUsage {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
 }
 
-func (x *UnbanOrganizationRequest) GetOrganizationId() string {
+func (x *GetPlatformUsageSummaryResponse) GetGeneratedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OrganizationId
+		return x.GeneratedAt
 	}
-	return ""
+	return nil
 }
 
-type UnbanOrganizationResponse struct {
+// Get All Organizations Usage Request
+type GetAllOrganizationsUsageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`                      // Page number (default: 1)
+	PerPage       *int32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3,oneof" json:"per_page,omitempty"` // Results per page (default: 25, max: 100)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnbanOrganizationResponse) Reset() {
-	*x = UnbanOrganizationResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[146]
+func (x *GetAllOrganizationsUsageRequest) Reset() {
+	*x = GetAllOrganizationsUsageRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnbanOrganizationResponse) String() string {
+func (x *GetAllOrganizationsUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnbanOrganizationResponse) ProtoMessage() {}
+func (*GetAllOrganizationsUsageRequest) ProtoMessage() {}
 
-func (x *UnbanOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[146]
+func (x *GetAllOrganizationsUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9413,46 +11791,49 @@ func (x *UnbanOrganizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnbanOrganizationResponse.ProtoReflect.Descriptor instead.
-func (*UnbanOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{146}
+// Deprecated: Use GetAllOrganizationsUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetAllOrganizationsUsageRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *UnbanOrganizationResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
+func (x *GetAllOrganizationsUsageRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
 	}
-	return ""
+	return 0
 }
 
-type GameServerOverview struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	GameServer       *v14.GameServer        `protobuf:"bytes,1,opt,name=game_server,json=gameServer,proto3" json:"game_server,omitempty"`
-	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
-	CreatedByUser    *UserInfo              `protobuf:"bytes,3,opt,name=created_by_user,json=createdByUser,proto3,oneof" json:"created_by_user,omitempty"`
-	IsSuspended      bool                   `protobuf:"varint,4,opt,name=is_suspended,json=isSuspended,proto3" json:"is_suspended,omitempty"`
-	SuspensionReason *string                `protobuf:"bytes,5,opt,name=suspension_reason,json=suspensionReason,proto3,oneof" json:"suspension_reason,omitempty"`
-	OwnerId          *string                `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`       // Organization owner user ID
-	OwnerName        *string                `protobuf:"bytes,7,opt,name=owner_name,json=ownerName,proto3,oneof" json:"owner_name,omitempty"` // Organization owner name or email
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *GetAllOrganizationsUsageRequest) GetPerPage() int32 {
+	if x != nil && x.PerPage != nil {
+		return *x.PerPage
+	}
+	return 0
 }
 
-func (x *GameServerOverview) Reset() {
-	*x = GameServerOverview{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[147]
+// Get All Organizations Usage Response
+type GetAllOrganizationsUsageResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Organizations []*OrganizationResourceUsage `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"` // Sorted by total resource usage, highest first
+	Pagination    *v12.Pagination              `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllOrganizationsUsageResponse) Reset() {
+	*x = GetAllOrganizationsUsageResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameServerOverview) String() string {
+func (x *GetAllOrganizationsUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServerOverview) ProtoMessage() {}
+func (*GetAllOrganizationsUsageResponse) ProtoMessage() {}
 
-func (x *GameServerOverview) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[147]
+func (x *GetAllOrganizationsUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9463,87 +11844,50 @@ func (x *GameServerOverview) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServerOverview.ProtoReflect.Descriptor instead.
-func (*GameServerOverview) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{147}
+// Deprecated: Use GetAllOrganizationsUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetAllOrganizationsUsageResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *GameServerOverview) GetGameServer() *v14.GameServer {
+func (x *GetAllOrganizationsUsageResponse) GetOrganizations() []*OrganizationResourceUsage {
 	if x != nil {
-		return x.GameServer
+		return x.Organizations
 	}
 	return nil
 }
 
-func (x *GameServerOverview) GetOrganizationName() string {
-	if x != nil {
-		return x.OrganizationName
-	}
-	return ""
-}
-
-func (x *GameServerOverview) GetCreatedByUser() *UserInfo {
+func (x *GetAllOrganizationsUsageResponse) GetPagination() *v12.Pagination {
 	if x != nil {
-		return x.CreatedByUser
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *GameServerOverview) GetIsSuspended() bool {
-	if x != nil {
-		return x.IsSuspended
-	}
-	return false
-}
-
-func (x *GameServerOverview) GetSuspensionReason() string {
-	if x != nil && x.SuspensionReason != nil {
-		return *x.SuspensionReason
-	}
-	return ""
-}
-
-func (x *GameServerOverview) GetOwnerId() string {
-	if x != nil && x.OwnerId != nil {
-		return *x.OwnerId
-	}
-	return ""
-}
-
-func (x *GameServerOverview) GetOwnerName() string {
-	if x != nil && x.OwnerName != nil {
-		return *x.OwnerName
-	}
-	return ""
-}
-
-type ListAllGameServersRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
-	Status         *v14.GameServerStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=obiente.cloud.gameservers.v1.GameServerStatus,oneof" json:"status,omitempty"`
-	Search         *string                `protobuf:"bytes,3,opt,name=search,proto3,oneof" json:"search,omitempty"` // name, ID, org
-	Page           *int32                 `protobuf:"varint,4,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PerPage        *int32                 `protobuf:"varint,5,opt,name=per_page,json=perPage,proto3,oneof" json:"per_page,omitempty"`
-	FlaggedOnly    *bool                  `protobuf:"varint,6,opt,name=flagged_only,json=flaggedOnly,proto3,oneof" json:"flagged_only,omitempty"` // only servers flagged by abuse detection
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Set Maintenance Mode Request
+type SetMaintenanceModeRequest struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Enabled                  bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Message                  string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                                                      // Shown to users whose requests are rejected during maintenance
+	EstimatedDurationMinutes int32                  `protobuf:"varint,3,opt,name=estimated_duration_minutes,json=estimatedDurationMinutes,proto3" json:"estimated_duration_minutes,omitempty"` // Used to tell users when maintenance should end
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *ListAllGameServersRequest) Reset() {
-	*x = ListAllGameServersRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[148]
+func (x *SetMaintenanceModeRequest) Reset() {
+	*x = SetMaintenanceModeRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllGameServersRequest) String() string {
+func (x *SetMaintenanceModeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllGameServersRequest) ProtoMessage() {}
+func (*SetMaintenanceModeRequest) ProtoMessage() {}
 
-func (x *ListAllGameServersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[148]
+func (x *SetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9554,76 +11898,57 @@ func (x *ListAllGameServersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllGameServersRequest.ProtoReflect.Descriptor instead.
-func (*ListAllGameServersRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{148}
-}
-
-func (x *ListAllGameServersRequest) GetOrganizationId() string {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
-	}
-	return ""
+// Deprecated: Use SetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *ListAllGameServersRequest) GetStatus() v14.GameServerStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *SetMaintenanceModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
 	}
-	return v14.GameServerStatus(0)
+	return false
 }
 
-func (x *ListAllGameServersRequest) GetSearch() string {
-	if x != nil && x.Search != nil {
-		return *x.Search
+func (x *SetMaintenanceModeRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ListAllGameServersRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
-	}
-	return 0
-}
-
-func (x *ListAllGameServersRequest) GetPerPage() int32 {
-	if x != nil && x.PerPage != nil {
-		return *x.PerPage
+func (x *SetMaintenanceModeRequest) GetEstimatedDurationMinutes() int32 {
+	if x != nil {
+		return x.EstimatedDurationMinutes
 	}
 	return 0
 }
 
-func (x *ListAllGameServersRequest) GetFlaggedOnly() bool {
-	if x != nil && x.FlaggedOnly != nil {
-		return *x.FlaggedOnly
-	}
-	return false
-}
-
-type ListAllGameServersResponse struct {
+// Set Maintenance Mode Response
+type SetMaintenanceModeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServers   []*GameServerOverview  `protobuf:"bytes,1,rep,name=game_servers,json=gameServers,proto3" json:"game_servers,omitempty"`
-	Pagination    *v12.Pagination        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	EstimatedEnd  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=estimated_end,json=estimatedEnd,proto3" json:"estimated_end,omitempty"` // Unset when maintenance is disabled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllGameServersResponse) Reset() {
-	*x = ListAllGameServersResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[149]
+func (x *SetMaintenanceModeResponse) Reset() {
+	*x = SetMaintenanceModeResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllGameServersResponse) String() string {
+func (x *SetMaintenanceModeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllGameServersResponse) ProtoMessage() {}
+func (*SetMaintenanceModeResponse) ProtoMessage() {}
 
-func (x *ListAllGameServersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[149]
+func (x *SetMaintenanceModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9634,47 +11959,55 @@ func (x *ListAllGameServersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllGameServersResponse.ProtoReflect.Descriptor instead.
-func (*ListAllGameServersResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{149}
+// Deprecated: Use SetMaintenanceModeResponse.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *ListAllGameServersResponse) GetGameServers() []*GameServerOverview {
+func (x *SetMaintenanceModeResponse) GetEnabled() bool {
 	if x != nil {
-		return x.GameServers
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *ListAllGameServersResponse) GetPagination() *v12.Pagination {
+func (x *SetMaintenanceModeResponse) GetMessage() string {
 	if x != nil {
-		return x.Pagination
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetMaintenanceModeResponse) GetEstimatedEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EstimatedEnd
 	}
 	return nil
 }
 
-type SuperadminGetGameServerRequest struct {
+// Drain Cluster Node Request
+type DrainClusterNodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"` // Swarm node ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminGetGameServerRequest) Reset() {
-	*x = SuperadminGetGameServerRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[150]
+func (x *DrainClusterNodeRequest) Reset() {
+	*x = DrainClusterNodeRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminGetGameServerRequest) String() string {
+func (x *DrainClusterNodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminGetGameServerRequest) ProtoMessage() {}
+func (*DrainClusterNodeRequest) ProtoMessage() {}
 
-func (x *SuperadminGetGameServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[150]
+func (x *DrainClusterNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9685,40 +12018,43 @@ func (x *SuperadminGetGameServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminGetGameServerRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminGetGameServerRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{150}
+// Deprecated: Use DrainClusterNodeRequest.ProtoReflect.Descriptor instead.
+func (*DrainClusterNodeRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *SuperadminGetGameServerRequest) GetGameServerId() string {
+func (x *DrainClusterNodeRequest) GetNodeId() string {
 	if x != nil {
-		return x.GameServerId
+		return x.NodeId
 	}
 	return ""
 }
 
-type SuperadminGetGameServerResponse struct {
+// Drain Cluster Node Response
+type DrainClusterNodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServer    *GameServerOverview    `protobuf:"bytes,1,opt,name=game_server,json=gameServer,proto3" json:"game_server,omitempty"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "draining" while containers are moving off the node
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminGetGameServerResponse) Reset() {
-	*x = SuperadminGetGameServerResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[151]
+func (x *DrainClusterNodeResponse) Reset() {
+	*x = DrainClusterNodeResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminGetGameServerResponse) String() string {
+func (x *DrainClusterNodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminGetGameServerResponse) ProtoMessage() {}
+func (*DrainClusterNodeResponse) ProtoMessage() {}
 
-func (x *SuperadminGetGameServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[151]
+func (x *DrainClusterNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9729,41 +12065,55 @@ func (x *SuperadminGetGameServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminGetGameServerResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminGetGameServerResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{151}
+// Deprecated: Use DrainClusterNodeResponse.ProtoReflect.Descriptor instead.
+func (*DrainClusterNodeResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *SuperadminGetGameServerResponse) GetGameServer() *GameServerOverview {
+func (x *DrainClusterNodeResponse) GetNodeId() string {
 	if x != nil {
-		return x.GameServer
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-type SuperadminSuspendGameServerRequest struct {
+func (x *DrainClusterNodeResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DrainClusterNodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Enable Cluster Node Request
+type EnableClusterNodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"` // Swarm node ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminSuspendGameServerRequest) Reset() {
-	*x = SuperadminSuspendGameServerRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[152]
+func (x *EnableClusterNodeRequest) Reset() {
+	*x = EnableClusterNodeRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminSuspendGameServerRequest) String() string {
+func (x *EnableClusterNodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminSuspendGameServerRequest) ProtoMessage() {}
+func (*EnableClusterNodeRequest) ProtoMessage() {}
 
-func (x *SuperadminSuspendGameServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[152]
+func (x *EnableClusterNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9771,51 +12121,46 @@ func (x *SuperadminSuspendGameServerRequest) ProtoReflect() protoreflect.Message
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SuperadminSuspendGameServerRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminSuspendGameServerRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{152}
-}
-
-func (x *SuperadminSuspendGameServerRequest) GetGameServerId() string {
-	if x != nil {
-		return x.GameServerId
-	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SuperadminSuspendGameServerRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+// Deprecated: Use EnableClusterNodeRequest.ProtoReflect.Descriptor instead.
+func (*EnableClusterNodeRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *EnableClusterNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
 	}
 	return ""
 }
 
-type SuperadminSuspendGameServerResponse struct {
+// Enable Cluster Node Response
+type EnableClusterNodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServer    *v14.GameServer        `protobuf:"bytes,1,opt,name=game_server,json=gameServer,proto3" json:"game_server,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "active"
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminSuspendGameServerResponse) Reset() {
-	*x = SuperadminSuspendGameServerResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[153]
+func (x *EnableClusterNodeResponse) Reset() {
+	*x = EnableClusterNodeResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminSuspendGameServerResponse) String() string {
+func (x *EnableClusterNodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminSuspendGameServerResponse) ProtoMessage() {}
+func (*EnableClusterNodeResponse) ProtoMessage() {}
 
-func (x *SuperadminSuspendGameServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[153]
+func (x *EnableClusterNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9826,47 +12171,55 @@ func (x *SuperadminSuspendGameServerResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminSuspendGameServerResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminSuspendGameServerResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{153}
+// Deprecated: Use EnableClusterNodeResponse.ProtoReflect.Descriptor instead.
+func (*EnableClusterNodeResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *SuperadminSuspendGameServerResponse) GetGameServer() *v14.GameServer {
+func (x *EnableClusterNodeResponse) GetNodeId() string {
 	if x != nil {
-		return x.GameServer
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *SuperadminSuspendGameServerResponse) GetMessage() string {
+func (x *EnableClusterNodeResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EnableClusterNodeResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type SuperadminUnsuspendGameServerRequest struct {
+// Rotate Proxmox Token Request
+type RotateProxmoxTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
+	NewToken      string                 `protobuf:"bytes,1,opt,name=new_token,json=newToken,proto3" json:"new_token,omitempty"` // USER@REALM!TOKENID=SECRET
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminUnsuspendGameServerRequest) Reset() {
-	*x = SuperadminUnsuspendGameServerRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[154]
+func (x *RotateProxmoxTokenRequest) Reset() {
+	*x = RotateProxmoxTokenRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminUnsuspendGameServerRequest) String() string {
+func (x *RotateProxmoxTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminUnsuspendGameServerRequest) ProtoMessage() {}
+func (*RotateProxmoxTokenRequest) ProtoMessage() {}
 
-func (x *SuperadminUnsuspendGameServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[154]
+func (x *RotateProxmoxTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9877,41 +12230,42 @@ func (x *SuperadminUnsuspendGameServerRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminUnsuspendGameServerRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminUnsuspendGameServerRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{154}
+// Deprecated: Use RotateProxmoxTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateProxmoxTokenRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *SuperadminUnsuspendGameServerRequest) GetGameServerId() string {
+func (x *RotateProxmoxTokenRequest) GetNewToken() string {
 	if x != nil {
-		return x.GameServerId
+		return x.NewToken
 	}
 	return ""
 }
 
-type SuperadminUnsuspendGameServerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServer    *v14.GameServer        `protobuf:"bytes,1,opt,name=game_server,json=gameServer,proto3" json:"game_server,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Rotate Proxmox Token Response
+type RotateProxmoxTokenResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TokenName              string                 `protobuf:"bytes,1,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`                                            // USER@REALM!TOKENID of the new token
+	PreviousTokenRetiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=previous_token_retires_at,json=previousTokenRetiresAt,proto3" json:"previous_token_retires_at,omitempty"` // When the previous token stops being used
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *SuperadminUnsuspendGameServerResponse) Reset() {
-	*x = SuperadminUnsuspendGameServerResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[155]
+func (x *RotateProxmoxTokenResponse) Reset() {
+	*x = RotateProxmoxTokenResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminUnsuspendGameServerResponse) String() string {
+func (x *RotateProxmoxTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminUnsuspendGameServerResponse) ProtoMessage() {}
+func (*RotateProxmoxTokenResponse) ProtoMessage() {}
 
-func (x *SuperadminUnsuspendGameServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[155]
+func (x *RotateProxmoxTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9922,48 +12276,47 @@ func (x *SuperadminUnsuspendGameServerResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminUnsuspendGameServerResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminUnsuspendGameServerResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{155}
+// Deprecated: Use RotateProxmoxTokenResponse.ProtoReflect.Descriptor instead.
+func (*RotateProxmoxTokenResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *SuperadminUnsuspendGameServerResponse) GetGameServer() *v14.GameServer {
+func (x *RotateProxmoxTokenResponse) GetTokenName() string {
 	if x != nil {
-		return x.GameServer
+		return x.TokenName
 	}
-	return nil
+	return ""
 }
 
-func (x *SuperadminUnsuspendGameServerResponse) GetMessage() string {
+func (x *RotateProxmoxTokenResponse) GetPreviousTokenRetiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.PreviousTokenRetiresAt
 	}
-	return ""
+	return nil
 }
 
-type SuperadminForceStopGameServerRequest struct {
+// Get Capacity Report Request
+type GetCapacityReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminForceStopGameServerRequest) Reset() {
-	*x = SuperadminForceStopGameServerRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[156]
+func (x *GetCapacityReportRequest) Reset() {
+	*x = GetCapacityReportRequest{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminForceStopGameServerRequest) String() string {
+func (x *GetCapacityReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminForceStopGameServerRequest) ProtoMessage() {}
+func (*GetCapacityReportRequest) ProtoMessage() {}
 
-func (x *SuperadminForceStopGameServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[156]
+func (x *GetCapacityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9974,48 +12327,50 @@ func (x *SuperadminForceStopGameServerRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminForceStopGameServerRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminForceStopGameServerRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{156}
-}
-
-func (x *SuperadminForceStopGameServerRequest) GetGameServerId() string {
-	if x != nil {
-		return x.GameServerId
-	}
-	return ""
-}
-
-func (x *SuperadminForceStopGameServerRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
-	}
-	return ""
+// Deprecated: Use GetCapacityReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCapacityReportRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{196}
 }
 
-type SuperadminForceStopGameServerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServer    *v14.GameServer        `protobuf:"bytes,1,opt,name=game_server,json=gameServer,proto3" json:"game_server,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Capacity of one Docker or Proxmox node. Usage is in percent (0-100); growth is in percentage
+// points per day from a linear regression over the last 7 days of samples.
+type CapacityReport struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	NodeId             string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName           string                 `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	NodeType           string                 `protobuf:"bytes,3,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"` // "docker" or "proxmox"
+	Region             string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	CpuPercent         float64                `protobuf:"fixed64,5,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	MemoryPercent      float64                `protobuf:"fixed64,6,opt,name=memory_percent,json=memoryPercent,proto3" json:"memory_percent,omitempty"`
+	DiskPercent        *float64               `protobuf:"fixed64,7,opt,name=disk_percent,json=diskPercent,proto3,oneof" json:"disk_percent,omitempty"`      // Unset when the node does not report disk usage (Docker nodes)
+	DeploymentCount    int32                  `protobuf:"varint,8,opt,name=deployment_count,json=deploymentCount,proto3" json:"deployment_count,omitempty"` // Deployments on Docker nodes, VPS instances on Proxmox nodes
+	CpuGrowthPerDay    float64                `protobuf:"fixed64,9,opt,name=cpu_growth_per_day,json=cpuGrowthPerDay,proto3" json:"cpu_growth_per_day,omitempty"`
+	MemoryGrowthPerDay float64                `protobuf:"fixed64,10,opt,name=memory_growth_per_day,json=memoryGrowthPerDay,proto3" json:"memory_growth_per_day,omitempty"`
+	DiskGrowthPerDay   *float64               `protobuf:"fixed64,11,opt,name=disk_growth_per_day,json=diskGrowthPerDay,proto3,oneof" json:"disk_growth_per_day,omitempty"`
+	CpuDaysToFull      *float64               `protobuf:"fixed64,12,opt,name=cpu_days_to_full,json=cpuDaysToFull,proto3,oneof" json:"cpu_days_to_full,omitempty"` // Unset when usage is not growing or there are too few samples
+	MemoryDaysToFull   *float64               `protobuf:"fixed64,13,opt,name=memory_days_to_full,json=memoryDaysToFull,proto3,oneof" json:"memory_days_to_full,omitempty"`
+	DiskDaysToFull     *float64               `protobuf:"fixed64,14,opt,name=disk_days_to_full,json=diskDaysToFull,proto3,oneof" json:"disk_days_to_full,omitempty"`
+	RiskLevel          string                 `protobuf:"bytes,15,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`        // "LOW", "MEDIUM", "HIGH" or "CRITICAL"
+	SampleCount        int32                  `protobuf:"varint,16,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"` // Samples the growth was computed from
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *SuperadminForceStopGameServerResponse) Reset() {
-	*x = SuperadminForceStopGameServerResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[157]
+func (x *CapacityReport) Reset() {
+	*x = CapacityReport{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminForceStopGameServerResponse) String() string {
+func (x *CapacityReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminForceStopGameServerResponse) ProtoMessage() {}
+func (*CapacityReport) ProtoMessage() {}
 
-func (x *SuperadminForceStopGameServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[157]
+func (x *CapacityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10026,108 +12381,147 @@ func (x *SuperadminForceStopGameServerResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminForceStopGameServerResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminForceStopGameServerResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{157}
+// Deprecated: Use CapacityReport.ProtoReflect.Descriptor instead.
+func (*CapacityReport) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *SuperadminForceStopGameServerResponse) GetGameServer() *v14.GameServer {
+func (x *CapacityReport) GetNodeId() string {
 	if x != nil {
-		return x.GameServer
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *SuperadminForceStopGameServerResponse) GetMessage() string {
+func (x *CapacityReport) GetNodeName() string {
 	if x != nil {
-		return x.Message
+		return x.NodeName
 	}
 	return ""
 }
 
-type SuperadminForceDeleteGameServerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameServerId  string                 `protobuf:"bytes,1,opt,name=game_server_id,json=gameServerId,proto3" json:"game_server_id,omitempty"`
-	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
-	HardDelete    bool                   `protobuf:"varint,3,opt,name=hard_delete,json=hardDelete,proto3" json:"hard_delete,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CapacityReport) GetNodeType() string {
+	if x != nil {
+		return x.NodeType
+	}
+	return ""
 }
 
-func (x *SuperadminForceDeleteGameServerRequest) Reset() {
-	*x = SuperadminForceDeleteGameServerRequest{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[158]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CapacityReport) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
 }
 
-func (x *SuperadminForceDeleteGameServerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CapacityReport) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
 }
 
-func (*SuperadminForceDeleteGameServerRequest) ProtoMessage() {}
+func (x *CapacityReport) GetMemoryPercent() float64 {
+	if x != nil {
+		return x.MemoryPercent
+	}
+	return 0
+}
 
-func (x *SuperadminForceDeleteGameServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[158]
+func (x *CapacityReport) GetDiskPercent() float64 {
+	if x != nil && x.DiskPercent != nil {
+		return *x.DiskPercent
+	}
+	return 0
+}
+
+func (x *CapacityReport) GetDeploymentCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DeploymentCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SuperadminForceDeleteGameServerRequest.ProtoReflect.Descriptor instead.
-func (*SuperadminForceDeleteGameServerRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{158}
+func (x *CapacityReport) GetCpuGrowthPerDay() float64 {
+	if x != nil {
+		return x.CpuGrowthPerDay
+	}
+	return 0
 }
 
-func (x *SuperadminForceDeleteGameServerRequest) GetGameServerId() string {
+func (x *CapacityReport) GetMemoryGrowthPerDay() float64 {
 	if x != nil {
-		return x.GameServerId
+		return x.MemoryGrowthPerDay
 	}
-	return ""
+	return 0
 }
 
-func (x *SuperadminForceDeleteGameServerRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+func (x *CapacityReport) GetDiskGrowthPerDay() float64 {
+	if x != nil && x.DiskGrowthPerDay != nil {
+		return *x.DiskGrowthPerDay
+	}
+	return 0
+}
+
+func (x *CapacityReport) GetCpuDaysToFull() float64 {
+	if x != nil && x.CpuDaysToFull != nil {
+		return *x.CpuDaysToFull
+	}
+	return 0
+}
+
+func (x *CapacityReport) GetMemoryDaysToFull() float64 {
+	if x != nil && x.MemoryDaysToFull != nil {
+		return *x.MemoryDaysToFull
+	}
+	return 0
+}
+
+func (x *CapacityReport) GetDiskDaysToFull() float64 {
+	if x != nil && x.DiskDaysToFull != nil {
+		return *x.DiskDaysToFull
+	}
+	return 0
+}
+
+func (x *CapacityReport) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
 	}
 	return ""
 }
 
-func (x *SuperadminForceDeleteGameServerRequest) GetHardDelete() bool {
+func (x *CapacityReport) GetSampleCount() int32 {
 	if x != nil {
-		return x.HardDelete
+		return x.SampleCount
 	}
-	return false
+	return 0
 }
 
-type SuperadminForceDeleteGameServerResponse struct {
+// Get Capacity Report Response
+type GetCapacityReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Nodes         []*CapacityReport      `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"` // Highest risk first
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuperadminForceDeleteGameServerResponse) Reset() {
-	*x = SuperadminForceDeleteGameServerResponse{}
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[159]
+func (x *GetCapacityReportResponse) Reset() {
+	*x = GetCapacityReportResponse{}
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuperadminForceDeleteGameServerResponse) String() string {
+func (x *GetCapacityReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuperadminForceDeleteGameServerResponse) ProtoMessage() {}
+func (*GetCapacityReportResponse) ProtoMessage() {}
 
-func (x *SuperadminForceDeleteGameServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[159]
+func (x *GetCapacityReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10138,23 +12532,23 @@ func (x *SuperadminForceDeleteGameServerResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuperadminForceDeleteGameServerResponse.ProtoReflect.Descriptor instead.
-func (*SuperadminForceDeleteGameServerResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{159}
+// Deprecated: Use GetCapacityReportResponse.ProtoReflect.Descriptor instead.
+func (*GetCapacityReportResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *SuperadminForceDeleteGameServerResponse) GetSuccess() bool {
+func (x *GetCapacityReportResponse) GetNodes() []*CapacityReport {
 	if x != nil {
-		return x.Success
+		return x.Nodes
 	}
-	return false
+	return nil
 }
 
-func (x *SuperadminForceDeleteGameServerResponse) GetMessage() string {
+func (x *GetCapacityReportResponse) GetGeneratedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.GeneratedAt
 	}
-	return ""
+	return nil
 }
 
 var File_obiente_cloud_superadmin_v1_superadmin_service_proto protoreflect.FileDescriptor
@@ -10274,7 +12668,42 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"\x06status\x18\f \x01(\tR\x06status\x12?\n" +
 	"\rlast_resolved\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\flastResolved\"Z\n" +
 	"\x16ListDNSRecordsResponse\x12@\n" +
-	"\arecords\x18\x01 \x03(\v2&.obiente.cloud.superadmin.v1.DNSRecordR\arecords\"\x15\n" +
+	"\arecords\x18\x01 \x03(\v2&.obiente.cloud.superadmin.v1.DNSRecordR\arecords\"\x91\x02\n" +
+	"\x17ListDNSQueryLogsRequest\x12,\n" +
+	"\x0forganization_id\x18\x01 \x01(\tH\x00R\x0eorganizationId\x88\x01\x01\x12\x1b\n" +
+	"\x06domain\x18\x02 \x01(\tH\x01R\x06domain\x88\x01\x01\x125\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x05since\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x03R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x05 \x01(\x05H\x04R\x06offset\x88\x01\x01B\x12\n" +
+	"\x10_organization_idB\t\n" +
+	"\a_domainB\b\n" +
+	"\x06_sinceB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"\xac\x02\n" +
+	"\vDNSQueryLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12\x1d\n" +
+	"\n" +
+	"query_type\x18\x04 \x01(\tR\tqueryType\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\x12\x16\n" +
+	"\x06result\x18\x06 \x01(\tR\x06result\x12%\n" +
+	"\x0elatency_micros\x18\a \x01(\x03R\rlatencyMicros\x12,\n" +
+	"\x0forganization_id\x18\b \x01(\tH\x00R\x0eorganizationId\x88\x01\x01B\x12\n" +
+	"\x10_organization_id\"y\n" +
+	"\x18ListDNSQueryLogsResponse\x12<\n" +
+	"\x04logs\x18\x01 \x03(\v2(.obiente.cloud.superadmin.v1.DNSQueryLogR\x04logs\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\"D\n" +
+	"\x11SplitHorizonEntry\x12\x1f\n" +
+	"\vclient_cidr\x18\x01 \x01(\tR\n" +
+	"clientCidr\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\"\x8d\x01\n" +
+	"\x1cConfigureSplitHorizonRequest\x12#\n" +
+	"\rdeployment_id\x18\x01 \x01(\tR\fdeploymentId\x12H\n" +
+	"\aentries\x18\x02 \x03(\v2..obiente.cloud.superadmin.v1.SplitHorizonEntryR\aentries\"i\n" +
+	"\x1dConfigureSplitHorizonResponse\x12H\n" +
+	"\aentries\x18\x01 \x03(\v2..obiente.cloud.superadmin.v1.SplitHorizonEntryR\aentries\"\x15\n" +
 	"\x13GetDNSConfigRequest\"\xfc\x02\n" +
 	"\tDNSConfig\x12\x1f\n" +
 	"\vtraefik_ips\x18\x01 \x03(\tR\n" +
@@ -10491,7 +12920,7 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"\x12\n" +
 	"\x10ListPlansRequest\"L\n" +
 	"\x11ListPlansResponse\x127\n" +
-	"\x05plans\x18\x01 \x03(\v2!.obiente.cloud.superadmin.v1.PlanR\x05plans\"\xc7\x03\n" +
+	"\x05plans\x18\x01 \x03(\v2!.obiente.cloud.superadmin.v1.PlanR\x05plans\"\xea\x03\n" +
 	"\x11CreatePlanRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
 	"\tcpu_cores\x18\x02 \x01(\x05R\bcpuCores\x12!\n" +
@@ -10504,10 +12933,11 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"\x15minimum_payment_cents\x18\a \x01(\x03R\x13minimumPaymentCents\x12;\n" +
 	"\x1amonthly_free_credits_cents\x18\b \x01(\x03R\x17monthlyFreeCreditsCents\x12\x1d\n" +
 	"\n" +
-	"trial_days\x18\v \x01(\x05R\ttrialDays\x12 \n" +
+	"trial_days\x18\v \x01(\x05R\ttrialDays\x12!\n" +
+	"\fmax_replicas\x18\f \x01(\x05R\vmaxReplicas\x12 \n" +
 	"\vdescription\x18\t \x01(\tR\vdescription\"K\n" +
 	"\x12CreatePlanResponse\x125\n" +
-	"\x04plan\x18\x01 \x01(\v2!.obiente.cloud.superadmin.v1.PlanR\x04plan\"\xe4\x05\n" +
+	"\x04plan\x18\x01 \x01(\v2!.obiente.cloud.superadmin.v1.PlanR\x04plan\"\x9d\x06\n" +
 	"\x11UpdatePlanRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
@@ -10520,10 +12950,11 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"\x15minimum_payment_cents\x18\b \x01(\x03H\aR\x13minimumPaymentCents\x88\x01\x01\x12@\n" +
 	"\x1amonthly_free_credits_cents\x18\t \x01(\x03H\bR\x17monthlyFreeCreditsCents\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"trial_days\x18\f \x01(\x05H\tR\ttrialDays\x88\x01\x01\x12%\n" +
+	"trial_days\x18\f \x01(\x05H\tR\ttrialDays\x88\x01\x01\x12&\n" +
+	"\fmax_replicas\x18\r \x01(\x05H\n" +
+	"R\vmaxReplicas\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\n" +
-	" \x01(\tH\n" +
-	"R\vdescription\x88\x01\x01B\a\n" +
+	" \x01(\tH\vR\vdescription\x88\x01\x01B\a\n" +
 	"\x05_nameB\f\n" +
 	"\n" +
 	"_cpu_coresB\x0f\n" +
@@ -10534,14 +12965,15 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"\x0e_storage_bytesB\x18\n" +
 	"\x16_minimum_payment_centsB\x1d\n" +
 	"\x1b_monthly_free_credits_centsB\r\n" +
-	"\v_trial_daysB\x0e\n" +
+	"\v_trial_daysB\x0f\n" +
+	"\r_max_replicasB\x0e\n" +
 	"\f_description\"K\n" +
 	"\x12UpdatePlanResponse\x125\n" +
 	"\x04plan\x18\x01 \x01(\v2!.obiente.cloud.superadmin.v1.PlanR\x04plan\"#\n" +
 	"\x11DeletePlanRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
 	"\x12DeletePlanResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xca\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xed\x03\n" +
 	"\x04Plan\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
@@ -10554,7 +12986,8 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"\x15minimum_payment_cents\x18\b \x01(\x03R\x13minimumPaymentCents\x12;\n" +
 	"\x1amonthly_free_credits_cents\x18\t \x01(\x03R\x17monthlyFreeCreditsCents\x12\x1d\n" +
 	"\n" +
-	"trial_days\x18\f \x01(\x05R\ttrialDays\x12 \n" +
+	"trial_days\x18\f \x01(\x05R\ttrialDays\x12!\n" +
+	"\fmax_replicas\x18\r \x01(\x05R\vmaxReplicas\x12 \n" +
 	"\vdescription\x18\n" +
 	" \x01(\tR\vdescription\"c\n" +
 	"\x1fAssignPlanToOrganizationRequest\x12'\n" +
@@ -10776,7 +13209,19 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"hardDelete\"V\n" +
 	" SuperadminForceDeleteVPSResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x8d\x03\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"i\n" +
+	"\x1bSuperadminMigrateVPSRequest\x12\x15\n" +
+	"\x06vps_id\x18\x01 \x01(\tR\x05vpsId\x12\x1f\n" +
+	"\vtarget_node\x18\x02 \x01(\tR\n" +
+	"targetNode\x12\x12\n" +
+	"\x04live\x18\x03 \x01(\bR\x04live\"m\n" +
+	"\x1cSuperadminMigrateVPSResponse\x123\n" +
+	"\x03vps\x18\x01 \x01(\v2!.obiente.cloud.vps.v1.VPSInstanceR\x03vps\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"-\n" +
+	"\x17ListUnpatchedVPSRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\"b\n" +
+	"\x18ListUnpatchedVPSResponse\x12F\n" +
+	"\rvps_instances\x18\x01 \x03(\v2!.obiente.cloud.vps.v1.VPSInstanceR\fvpsInstances\"\x8d\x03\n" +
 	"\x1eListStripeWebhookEventsRequest\x12,\n" +
 	"\x0forganization_id\x18\x01 \x01(\tH\x00R\x0eorganizationId\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -10985,7 +13430,62 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01\x12\x1b\n" +
 	"\tis_active\x18\b \x01(\bR\bisActiveB\t\n" +
 	"\a_reasonB\r\n" +
-	"\v_expires_at\"\xbc\x01\n" +
+	"\v_expires_at\"\xb2\x02\n" +
+	"\bBanEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01\x12\x1b\n" +
+	"\tis_active\x18\b \x01(\bR\bisActiveB\t\n" +
+	"\a_reasonB\r\n" +
+	"\v_expires_at\"\xb8\x01\n" +
+	"\x15CreateBanEntryRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01\x12>\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01B\t\n" +
+	"\a_reasonB\r\n" +
+	"\v_expires_at\"U\n" +
+	"\x16CreateBanEntryResponse\x12;\n" +
+	"\x05entry\x18\x01 \x01(\v2%.obiente.cloud.superadmin.v1.BanEntryR\x05entry\"'\n" +
+	"\x15DeleteBanEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x16DeleteBanEntryResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"b\n" +
+	"\x15ListBanEntriesRequest\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12'\n" +
+	"\x0finclude_expired\x18\x02 \x01(\bR\x0eincludeExpiredB\a\n" +
+	"\x05_type\"Y\n" +
+	"\x16ListBanEntriesResponse\x12?\n" +
+	"\aentries\x18\x01 \x03(\v2%.obiente.cloud.superadmin.v1.BanEntryR\aentries\"\xf3\x01\n" +
+	"\x0fOrgIPAllocation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04cidr\x18\x03 \x01(\tR\x04cidr\x12\x18\n" +
+	"\agateway\x18\x04 \x01(\tR\agateway\x12\x1f\n" +
+	"\vdns_servers\x18\x05 \x03(\tR\n" +
+	"dnsServers\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x91\x01\n" +
+	"\x17AllocateStaticIPRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04cidr\x18\x02 \x01(\tR\x04cidr\x12\x18\n" +
+	"\agateway\x18\x03 \x01(\tR\agateway\x12\x1f\n" +
+	"\vdns_servers\x18\x04 \x03(\tR\n" +
+	"dnsServers\"h\n" +
+	"\x18AllocateStaticIPResponse\x12L\n" +
+	"\n" +
+	"allocation\x18\x01 \x01(\v2,.obiente.cloud.superadmin.v1.OrgIPAllocationR\n" +
+	"allocation\"\xbc\x01\n" +
 	"\x1aSuspendOrganizationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01\x12>\n" +
@@ -11078,14 +13578,109 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"\a_reason\"]\n" +
 	"'SuperadminForceDeleteGameServerResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xc0I\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"@\n" +
+	"\x15LiftSuspensionRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"J\n" +
+	"\x16LiftSuspensionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\" \n" +
+	"\x1eGetPlatformUsageSummaryRequest\"\xb5\x02\n" +
+	"\x19OrganizationResourceUsage\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12+\n" +
+	"\x11organization_name\x18\x02 \x01(\tR\x10organizationName\x12)\n" +
+	"\x10deployment_count\x18\x03 \x01(\x03R\x0fdeploymentCount\x12*\n" +
+	"\x11game_server_count\x18\x04 \x01(\x03R\x0fgameServerCount\x12\x1b\n" +
+	"\tvps_count\x18\x05 \x01(\x03R\bvpsCount\x12'\n" +
+	"\x0ftotal_resources\x18\x06 \x01(\x03R\x0etotalResources\x12%\n" +
+	"\x0eresource_hours\x18\a \x01(\x03R\rresourceHours\"\xa1\x01\n" +
+	"\x13RegionResourceUsage\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12)\n" +
+	"\x10deployment_count\x18\x02 \x01(\x03R\x0fdeploymentCount\x12*\n" +
+	"\x11game_server_count\x18\x03 \x01(\x03R\x0fgameServerCount\x12\x1b\n" +
+	"\tvps_count\x18\x04 \x01(\x03R\bvpsCount\"\xc5\x03\n" +
+	"\x1fGetPlatformUsageSummaryResponse\x12-\n" +
+	"\x12active_deployments\x18\x01 \x01(\x03R\x11activeDeployments\x12.\n" +
+	"\x13active_game_servers\x18\x02 \x01(\x03R\x11activeGameServers\x12\x1d\n" +
+	"\n" +
+	"active_vps\x18\x03 \x01(\x03R\tactiveVps\x124\n" +
+	"\x16credits_consumed_cents\x18\x04 \x01(\x03R\x14creditsConsumedCents\x12c\n" +
+	"\x11top_organizations\x18\x05 \x03(\v26.obiente.cloud.superadmin.v1.OrganizationResourceUsageR\x10topOrganizations\x12J\n" +
+	"\aregions\x18\x06 \x03(\v20.obiente.cloud.superadmin.v1.RegionResourceUsageR\aregions\x12=\n" +
+	"\fgenerated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"p\n" +
+	"\x1fGetAllOrganizationsUsageRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12\x1e\n" +
+	"\bper_page\x18\x02 \x01(\x05H\x01R\aperPage\x88\x01\x01B\a\n" +
+	"\x05_pageB\v\n" +
+	"\t_per_page\"\xc5\x01\n" +
+	" GetAllOrganizationsUsageResponse\x12\\\n" +
+	"\rorganizations\x18\x01 \x03(\v26.obiente.cloud.superadmin.v1.OrganizationResourceUsageR\rorganizations\x12C\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2#.obiente.cloud.common.v1.PaginationR\n" +
+	"pagination\"\x8d\x01\n" +
+	"\x19SetMaintenanceModeRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12<\n" +
+	"\x1aestimated_duration_minutes\x18\x03 \x01(\x05R\x18estimatedDurationMinutes\"\x91\x01\n" +
+	"\x1aSetMaintenanceModeResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12?\n" +
+	"\restimated_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\festimatedEnd\"2\n" +
+	"\x17DrainClusterNodeRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"e\n" +
+	"\x18DrainClusterNodeResponse\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"3\n" +
+	"\x18EnableClusterNodeRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"f\n" +
+	"\x19EnableClusterNodeResponse\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"8\n" +
+	"\x19RotateProxmoxTokenRequest\x12\x1b\n" +
+	"\tnew_token\x18\x01 \x01(\tR\bnewToken\"\x92\x01\n" +
+	"\x1aRotateProxmoxTokenResponse\x12\x1d\n" +
+	"\n" +
+	"token_name\x18\x01 \x01(\tR\ttokenName\x12U\n" +
+	"\x19previous_token_retires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x16previousTokenRetiresAt\"\x1a\n" +
+	"\x18GetCapacityReportRequest\"\xea\x05\n" +
+	"\x0eCapacityReport\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x12\x1b\n" +
+	"\tnode_type\x18\x03 \x01(\tR\bnodeType\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x1f\n" +
+	"\vcpu_percent\x18\x05 \x01(\x01R\n" +
+	"cpuPercent\x12%\n" +
+	"\x0ememory_percent\x18\x06 \x01(\x01R\rmemoryPercent\x12&\n" +
+	"\fdisk_percent\x18\a \x01(\x01H\x00R\vdiskPercent\x88\x01\x01\x12)\n" +
+	"\x10deployment_count\x18\b \x01(\x05R\x0fdeploymentCount\x12+\n" +
+	"\x12cpu_growth_per_day\x18\t \x01(\x01R\x0fcpuGrowthPerDay\x121\n" +
+	"\x15memory_growth_per_day\x18\n" +
+	" \x01(\x01R\x12memoryGrowthPerDay\x122\n" +
+	"\x13disk_growth_per_day\x18\v \x01(\x01H\x01R\x10diskGrowthPerDay\x88\x01\x01\x12,\n" +
+	"\x10cpu_days_to_full\x18\f \x01(\x01H\x02R\rcpuDaysToFull\x88\x01\x01\x122\n" +
+	"\x13memory_days_to_full\x18\r \x01(\x01H\x03R\x10memoryDaysToFull\x88\x01\x01\x12.\n" +
+	"\x11disk_days_to_full\x18\x0e \x01(\x01H\x04R\x0ediskDaysToFull\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x0f \x01(\tR\triskLevel\x12!\n" +
+	"\fsample_count\x18\x10 \x01(\x05R\vsampleCountB\x0f\n" +
+	"\r_disk_percentB\x16\n" +
+	"\x14_disk_growth_per_dayB\x13\n" +
+	"\x11_cpu_days_to_fullB\x16\n" +
+	"\x14_memory_days_to_fullB\x14\n" +
+	"\x12_disk_days_to_full\"\x9d\x01\n" +
+	"\x19GetCapacityReportResponse\x12A\n" +
+	"\x05nodes\x18\x01 \x03(\v2+.obiente.cloud.superadmin.v1.CapacityReportR\x05nodes\x12=\n" +
+	"\fgenerated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt2\x9aZ\n" +
 	"\x11SuperadminService\x12p\n" +
 	"\vGetOverview\x12/.obiente.cloud.superadmin.v1.GetOverviewRequest\x1a0.obiente.cloud.superadmin.v1.GetOverviewResponse\x12g\n" +
 	"\bQueryDNS\x12,.obiente.cloud.superadmin.v1.QueryDNSRequest\x1a-.obiente.cloud.superadmin.v1.QueryDNSResponse\x12y\n" +
 	"\x0eListDNSRecords\x122.obiente.cloud.superadmin.v1.ListDNSRecordsRequest\x1a3.obiente.cloud.superadmin.v1.ListDNSRecordsResponse\x12s\n" +
 	"\fGetDNSConfig\x120.obiente.cloud.superadmin.v1.GetDNSConfigRequest\x1a1.obiente.cloud.superadmin.v1.GetDNSConfigResponse\x12\x94\x01\n" +
 	"\x17ListDelegatedDNSRecords\x12;.obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsRequest\x1a<.obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsResponse\x12|\n" +
-	"\x0fHasDelegatedDNS\x123.obiente.cloud.superadmin.v1.HasDelegatedDNSRequest\x1a4.obiente.cloud.superadmin.v1.HasDelegatedDNSResponse\x12\x9a\x01\n" +
+	"\x0fHasDelegatedDNS\x123.obiente.cloud.superadmin.v1.HasDelegatedDNSRequest\x1a4.obiente.cloud.superadmin.v1.HasDelegatedDNSResponse\x12\x7f\n" +
+	"\x10ListDNSQueryLogs\x124.obiente.cloud.superadmin.v1.ListDNSQueryLogsRequest\x1a5.obiente.cloud.superadmin.v1.ListDNSQueryLogsResponse\x12\x8e\x01\n" +
+	"\x15ConfigureSplitHorizon\x129.obiente.cloud.superadmin.v1.ConfigureSplitHorizonRequest\x1a:.obiente.cloud.superadmin.v1.ConfigureSplitHorizonResponse\x12\x9a\x01\n" +
 	"\x19CreateDNSDelegationAPIKey\x12=.obiente.cloud.superadmin.v1.CreateDNSDelegationAPIKeyRequest\x1a>.obiente.cloud.superadmin.v1.CreateDNSDelegationAPIKeyResponse\x12\x97\x01\n" +
 	"\x18ListDNSDelegationAPIKeys\x12<.obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysRequest\x1a=.obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysResponse\x12\x9a\x01\n" +
 	"\x19RevokeDNSDelegationAPIKey\x12=.obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyRequest\x1a>.obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyResponse\x12\xc7\x01\n" +
@@ -11093,7 +13688,9 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"\n" +
 	"GetPricing\x12..obiente.cloud.superadmin.v1.GetPricingRequest\x1a/.obiente.cloud.superadmin.v1.GetPricingResponse\x12\x82\x01\n" +
 	"\x11GetAbuseDetection\x125.obiente.cloud.superadmin.v1.GetAbuseDetectionRequest\x1a6.obiente.cloud.superadmin.v1.GetAbuseDetectionResponse\x12\x82\x01\n" +
-	"\x11GetIncomeOverview\x125.obiente.cloud.superadmin.v1.GetIncomeOverviewRequest\x1a6.obiente.cloud.superadmin.v1.GetIncomeOverviewResponse\x12|\n" +
+	"\x11GetIncomeOverview\x125.obiente.cloud.superadmin.v1.GetIncomeOverviewRequest\x1a6.obiente.cloud.superadmin.v1.GetIncomeOverviewResponse\x12\x94\x01\n" +
+	"\x17GetPlatformUsageSummary\x12;.obiente.cloud.superadmin.v1.GetPlatformUsageSummaryRequest\x1a<.obiente.cloud.superadmin.v1.GetPlatformUsageSummaryResponse\x12\x97\x01\n" +
+	"\x18GetAllOrganizationsUsage\x12<.obiente.cloud.superadmin.v1.GetAllOrganizationsUsageRequest\x1a=.obiente.cloud.superadmin.v1.GetAllOrganizationsUsageResponse\x12|\n" +
 	"\x0fListAllInvoices\x123.obiente.cloud.superadmin.v1.ListAllInvoicesRequest\x1a4.obiente.cloud.superadmin.v1.ListAllInvoicesResponse\x12\x88\x01\n" +
 	"\x13SendInvoiceReminder\x127.obiente.cloud.superadmin.v1.SendInvoiceReminderRequest\x1a8.obiente.cloud.superadmin.v1.SendInvoiceReminderResponse\x12j\n" +
 	"\tListPlans\x12-.obiente.cloud.superadmin.v1.ListPlansRequest\x1a..obiente.cloud.superadmin.v1.ListPlansResponse\x12m\n" +
@@ -11111,11 +13708,16 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"\rUnsuspendUser\x121.obiente.cloud.superadmin.v1.UnsuspendUserRequest\x1a2.obiente.cloud.superadmin.v1.UnsuspendUserResponse\x12d\n" +
 	"\aBanUser\x12+.obiente.cloud.superadmin.v1.BanUserRequest\x1a,.obiente.cloud.superadmin.v1.BanUserResponse\x12j\n" +
 	"\tUnbanUser\x12-.obiente.cloud.superadmin.v1.UnbanUserRequest\x1a..obiente.cloud.superadmin.v1.UnbanUserResponse\x12\x7f\n" +
-	"\x10GetUserBanStatus\x124.obiente.cloud.superadmin.v1.GetUserBanStatusRequest\x1a5.obiente.cloud.superadmin.v1.GetUserBanStatusResponse\x12\x88\x01\n" +
+	"\x10GetUserBanStatus\x124.obiente.cloud.superadmin.v1.GetUserBanStatusRequest\x1a5.obiente.cloud.superadmin.v1.GetUserBanStatusResponse\x12y\n" +
+	"\x0eCreateBanEntry\x122.obiente.cloud.superadmin.v1.CreateBanEntryRequest\x1a3.obiente.cloud.superadmin.v1.CreateBanEntryResponse\x12y\n" +
+	"\x0eDeleteBanEntry\x122.obiente.cloud.superadmin.v1.DeleteBanEntryRequest\x1a3.obiente.cloud.superadmin.v1.DeleteBanEntryResponse\x12y\n" +
+	"\x0eListBanEntries\x122.obiente.cloud.superadmin.v1.ListBanEntriesRequest\x1a3.obiente.cloud.superadmin.v1.ListBanEntriesResponse\x12\x88\x01\n" +
 	"\x13SuspendOrganization\x127.obiente.cloud.superadmin.v1.SuspendOrganizationRequest\x1a8.obiente.cloud.superadmin.v1.SuspendOrganizationResponse\x12\x8e\x01\n" +
 	"\x15UnsuspendOrganization\x129.obiente.cloud.superadmin.v1.UnsuspendOrganizationRequest\x1a:.obiente.cloud.superadmin.v1.UnsuspendOrganizationResponse\x12|\n" +
 	"\x0fBanOrganization\x123.obiente.cloud.superadmin.v1.BanOrganizationRequest\x1a4.obiente.cloud.superadmin.v1.BanOrganizationResponse\x12\x82\x01\n" +
-	"\x11UnbanOrganization\x125.obiente.cloud.superadmin.v1.UnbanOrganizationRequest\x1a6.obiente.cloud.superadmin.v1.UnbanOrganizationResponse\x12m\n" +
+	"\x11UnbanOrganization\x125.obiente.cloud.superadmin.v1.UnbanOrganizationRequest\x1a6.obiente.cloud.superadmin.v1.UnbanOrganizationResponse\x12y\n" +
+	"\x0eLiftSuspension\x122.obiente.cloud.superadmin.v1.LiftSuspensionRequest\x1a3.obiente.cloud.superadmin.v1.LiftSuspensionResponse\x12\x85\x01\n" +
+	"\x12SetMaintenanceMode\x126.obiente.cloud.superadmin.v1.SetMaintenanceModeRequest\x1a7.obiente.cloud.superadmin.v1.SetMaintenanceModeResponse\x12m\n" +
 	"\n" +
 	"ListAllVPS\x12..obiente.cloud.superadmin.v1.ListAllVPSRequest\x1a/.obiente.cloud.superadmin.v1.ListAllVPSResponse\x12\x7f\n" +
 	"\x10SuperadminGetVPS\x124.obiente.cloud.superadmin.v1.SuperadminGetVPSRequest\x1a5.obiente.cloud.superadmin.v1.SuperadminGetVPSResponse\x12\x88\x01\n" +
@@ -11124,7 +13726,9 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"\x16SuperadminUnsuspendVPS\x12:.obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSRequest\x1a;.obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSResponse\x12\xa3\x01\n" +
 	"\x1cSuperadminUpdateVPSCloudInit\x12@.obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitRequest\x1aA.obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitResponse\x12\x91\x01\n" +
 	"\x16SuperadminForceStopVPS\x12:.obiente.cloud.superadmin.v1.SuperadminForceStopVPSRequest\x1a;.obiente.cloud.superadmin.v1.SuperadminForceStopVPSResponse\x12\x97\x01\n" +
-	"\x18SuperadminForceDeleteVPS\x12<.obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSRequest\x1a=.obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSResponse\x12s\n" +
+	"\x18SuperadminForceDeleteVPS\x12<.obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSRequest\x1a=.obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSResponse\x12\x8b\x01\n" +
+	"\x14SuperadminMigrateVPS\x128.obiente.cloud.superadmin.v1.SuperadminMigrateVPSRequest\x1a9.obiente.cloud.superadmin.v1.SuperadminMigrateVPSResponse\x12\x7f\n" +
+	"\x10ListUnpatchedVPS\x124.obiente.cloud.superadmin.v1.ListUnpatchedVPSRequest\x1a5.obiente.cloud.superadmin.v1.ListUnpatchedVPSResponse\x12s\n" +
 	"\fListVPSSizes\x120.obiente.cloud.superadmin.v1.ListVPSSizesRequest\x1a1.obiente.cloud.superadmin.v1.ListVPSSizesResponse\x12v\n" +
 	"\rCreateVPSSize\x121.obiente.cloud.superadmin.v1.CreateVPSSizeRequest\x1a2.obiente.cloud.superadmin.v1.CreateVPSSizeResponse\x12v\n" +
 	"\rUpdateVPSSize\x121.obiente.cloud.superadmin.v1.UpdateVPSSizeRequest\x1a2.obiente.cloud.superadmin.v1.UpdateVPSSizeResponse\x12v\n" +
@@ -11134,12 +13738,17 @@ const file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc = "" +
 	"\x11UpdateVPSPublicIP\x12..obiente.cloud.vps.v1.UpdateVPSPublicIPRequest\x1a/.obiente.cloud.vps.v1.UpdateVPSPublicIPResponse\x12t\n" +
 	"\x11DeleteVPSPublicIP\x12..obiente.cloud.vps.v1.DeleteVPSPublicIPRequest\x1a/.obiente.cloud.vps.v1.DeleteVPSPublicIPResponse\x12t\n" +
 	"\x11AssignVPSPublicIP\x12..obiente.cloud.vps.v1.AssignVPSPublicIPRequest\x1a/.obiente.cloud.vps.v1.AssignVPSPublicIPResponse\x12z\n" +
-	"\x13UnassignVPSPublicIP\x120.obiente.cloud.vps.v1.UnassignVPSPublicIPRequest\x1a1.obiente.cloud.vps.v1.UnassignVPSPublicIPResponse\x12s\n" +
+	"\x13UnassignVPSPublicIP\x120.obiente.cloud.vps.v1.UnassignVPSPublicIPRequest\x1a1.obiente.cloud.vps.v1.UnassignVPSPublicIPResponse\x12\x7f\n" +
+	"\x10AllocateStaticIP\x124.obiente.cloud.superadmin.v1.AllocateStaticIPRequest\x1a5.obiente.cloud.superadmin.v1.AllocateStaticIPResponse\x12s\n" +
 	"\fGetOrgLeases\x120.obiente.cloud.vpsgateway.v1.GetOrgLeasesRequest\x1a1.obiente.cloud.vpsgateway.v1.GetOrgLeasesResponse\x12\x94\x01\n" +
 	"\x17ListStripeWebhookEvents\x12;.obiente.cloud.superadmin.v1.ListStripeWebhookEventsRequest\x1a<.obiente.cloud.superadmin.v1.ListStripeWebhookEventsResponse\x12j\n" +
 	"\tListNodes\x12-.obiente.cloud.superadmin.v1.ListNodesRequest\x1a..obiente.cloud.superadmin.v1.ListNodesResponse\x12d\n" +
 	"\aGetNode\x12+.obiente.cloud.superadmin.v1.GetNodeRequest\x1a,.obiente.cloud.superadmin.v1.GetNodeResponse\x12\x7f\n" +
-	"\x10UpdateNodeConfig\x124.obiente.cloud.superadmin.v1.UpdateNodeConfigRequest\x1a5.obiente.cloud.superadmin.v1.UpdateNodeConfigResponse\x12\x9a\x01\n" +
+	"\x10UpdateNodeConfig\x124.obiente.cloud.superadmin.v1.UpdateNodeConfigRequest\x1a5.obiente.cloud.superadmin.v1.UpdateNodeConfigResponse\x12\x7f\n" +
+	"\x10DrainClusterNode\x124.obiente.cloud.superadmin.v1.DrainClusterNodeRequest\x1a5.obiente.cloud.superadmin.v1.DrainClusterNodeResponse\x12\x82\x01\n" +
+	"\x11EnableClusterNode\x125.obiente.cloud.superadmin.v1.EnableClusterNodeRequest\x1a6.obiente.cloud.superadmin.v1.EnableClusterNodeResponse\x12\x85\x01\n" +
+	"\x12RotateProxmoxToken\x126.obiente.cloud.superadmin.v1.RotateProxmoxTokenRequest\x1a7.obiente.cloud.superadmin.v1.RotateProxmoxTokenResponse\x12\x82\x01\n" +
+	"\x11GetCapacityReport\x125.obiente.cloud.superadmin.v1.GetCapacityReportRequest\x1a6.obiente.cloud.superadmin.v1.GetCapacityReportResponse\x12\x9a\x01\n" +
 	"\x19ListSuperadminPermissions\x12=.obiente.cloud.superadmin.v1.ListSuperadminPermissionsRequest\x1a>.obiente.cloud.superadmin.v1.ListSuperadminPermissionsResponse\x12\x9d\x01\n" +
 	"\x1aGetMySuperadminPermissions\x12>.obiente.cloud.superadmin.v1.GetMySuperadminPermissionsRequest\x1a?.obiente.cloud.superadmin.v1.GetMySuperadminPermissionsResponse\x12\x85\x01\n" +
 	"\x12ListAllGameServers\x126.obiente.cloud.superadmin.v1.ListAllGameServersRequest\x1a7.obiente.cloud.superadmin.v1.ListAllGameServersResponse\x12\x94\x01\n" +
@@ -11168,7 +13777,7 @@ func file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescGZIP() []b
 	return file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDescData
 }
 
-var file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes = make([]protoimpl.MessageInfo, 163)
+var file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes = make([]protoimpl.MessageInfo, 202)
 var file_obiente_cloud_superadmin_v1_superadmin_service_proto_goTypes = []any{
 	(*GetOverviewRequest)(nil),                               // 0: obiente.cloud.superadmin.v1.GetOverviewRequest
 	(*GetOverviewResponse)(nil),                              // 1: obiente.cloud.superadmin.v1.GetOverviewResponse
@@ -11182,182 +13791,221 @@ var file_obiente_cloud_superadmin_v1_superadmin_service_proto_goTypes = []any{
 	(*ListDNSRecordsRequest)(nil),                            // 9: obiente.cloud.superadmin.v1.ListDNSRecordsRequest
 	(*DNSRecord)(nil),                                        // 10: obiente.cloud.superadmin.v1.DNSRecord
 	(*ListDNSRecordsResponse)(nil),                           // 11: obiente.cloud.superadmin.v1.ListDNSRecordsResponse
-	(*GetDNSConfigRequest)(nil),                              // 12: obiente.cloud.superadmin.v1.GetDNSConfigRequest
-	(*DNSConfig)(nil),                                        // 13: obiente.cloud.superadmin.v1.DNSConfig
-	(*TraefikIPs)(nil),                                       // 14: obiente.cloud.superadmin.v1.TraefikIPs
-	(*GetDNSConfigResponse)(nil),                             // 15: obiente.cloud.superadmin.v1.GetDNSConfigResponse
-	(*ListDelegatedDNSRecordsRequest)(nil),                   // 16: obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsRequest
-	(*DelegatedDNSRecord)(nil),                               // 17: obiente.cloud.superadmin.v1.DelegatedDNSRecord
-	(*ListDelegatedDNSRecordsResponse)(nil),                  // 18: obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsResponse
-	(*HasDelegatedDNSRequest)(nil),                           // 19: obiente.cloud.superadmin.v1.HasDelegatedDNSRequest
-	(*HasDelegatedDNSResponse)(nil),                          // 20: obiente.cloud.superadmin.v1.HasDelegatedDNSResponse
-	(*GetPricingRequest)(nil),                                // 21: obiente.cloud.superadmin.v1.GetPricingRequest
-	(*GetPricingResponse)(nil),                               // 22: obiente.cloud.superadmin.v1.GetPricingResponse
-	(*CreateDNSDelegationAPIKeyRequest)(nil),                 // 23: obiente.cloud.superadmin.v1.CreateDNSDelegationAPIKeyRequest
-	(*CreateDNSDelegationAPIKeyResponse)(nil),                // 24: obiente.cloud.superadmin.v1.CreateDNSDelegationAPIKeyResponse
-	(*RevokeDNSDelegationAPIKeyRequest)(nil),                 // 25: obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyRequest
-	(*RevokeDNSDelegationAPIKeyResponse)(nil),                // 26: obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyResponse
-	(*RevokeDNSDelegationAPIKeyForOrganizationRequest)(nil),  // 27: obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyForOrganizationRequest
-	(*RevokeDNSDelegationAPIKeyForOrganizationResponse)(nil), // 28: obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyForOrganizationResponse
-	(*ListDNSDelegationAPIKeysRequest)(nil),                  // 29: obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysRequest
-	(*DNSDelegationAPIKeyInfo)(nil),                          // 30: obiente.cloud.superadmin.v1.DNSDelegationAPIKeyInfo
-	(*ListDNSDelegationAPIKeysResponse)(nil),                 // 31: obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysResponse
-	(*GetAbuseDetectionRequest)(nil),                         // 32: obiente.cloud.superadmin.v1.GetAbuseDetectionRequest
-	(*GetAbuseDetectionResponse)(nil),                        // 33: obiente.cloud.superadmin.v1.GetAbuseDetectionResponse
-	(*SuspiciousOrganization)(nil),                           // 34: obiente.cloud.superadmin.v1.SuspiciousOrganization
-	(*SuspiciousActivity)(nil),                               // 35: obiente.cloud.superadmin.v1.SuspiciousActivity
-	(*AbuseMetrics)(nil),                                     // 36: obiente.cloud.superadmin.v1.AbuseMetrics
-	(*GetIncomeOverviewRequest)(nil),                         // 37: obiente.cloud.superadmin.v1.GetIncomeOverviewRequest
-	(*GetIncomeOverviewResponse)(nil),                        // 38: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse
-	(*IncomeSummary)(nil),                                    // 39: obiente.cloud.superadmin.v1.IncomeSummary
-	(*MonthlyIncome)(nil),                                    // 40: obiente.cloud.superadmin.v1.MonthlyIncome
-	(*TopCustomer)(nil),                                      // 41: obiente.cloud.superadmin.v1.TopCustomer
-	(*BillingTransaction)(nil),                               // 42: obiente.cloud.superadmin.v1.BillingTransaction
-	(*PaymentMetrics)(nil),                                   // 43: obiente.cloud.superadmin.v1.PaymentMetrics
-	(*ListAllInvoicesRequest)(nil),                           // 44: obiente.cloud.superadmin.v1.ListAllInvoicesRequest
-	(*ListAllInvoicesResponse)(nil),                          // 45: obiente.cloud.superadmin.v1.ListAllInvoicesResponse
-	(*InvoiceWithOrganization)(nil),                          // 46: obiente.cloud.superadmin.v1.InvoiceWithOrganization
-	(*SendInvoiceReminderRequest)(nil),                       // 47: obiente.cloud.superadmin.v1.SendInvoiceReminderRequest
-	(*SendInvoiceReminderResponse)(nil),                      // 48: obiente.cloud.superadmin.v1.SendInvoiceReminderResponse
-	(*ListPlansRequest)(nil),                                 // 49: obiente.cloud.superadmin.v1.ListPlansRequest
-	(*ListPlansResponse)(nil),                                // 50: obiente.cloud.superadmin.v1.ListPlansResponse
-	(*CreatePlanRequest)(nil),                                // 51: obiente.cloud.superadmin.v1.CreatePlanRequest
-	(*CreatePlanResponse)(nil),                               // 52: obiente.cloud.superadmin.v1.CreatePlanResponse
-	(*UpdatePlanRequest)(nil),                                // 53: obiente.cloud.superadmin.v1.UpdatePlanRequest
-	(*UpdatePlanResponse)(nil),                               // 54: obiente.cloud.superadmin.v1.UpdatePlanResponse
-	(*DeletePlanRequest)(nil),                                // 55: obiente.cloud.superadmin.v1.DeletePlanRequest
-	(*DeletePlanResponse)(nil),                               // 56: obiente.cloud.superadmin.v1.DeletePlanResponse
-	(*Plan)(nil),                                             // 57: obiente.cloud.superadmin.v1.Plan
-	(*AssignPlanToOrganizationRequest)(nil),                  // 58: obiente.cloud.superadmin.v1.AssignPlanToOrganizationRequest
-	(*AssignPlanToOrganizationResponse)(nil),                 // 59: obiente.cloud.superadmin.v1.AssignPlanToOrganizationResponse
-	(*ListUsersRequest)(nil),                                 // 60: obiente.cloud.superadmin.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),                                // 61: obiente.cloud.superadmin.v1.ListUsersResponse
-	(*GetUserRequest)(nil),                                   // 62: obiente.cloud.superadmin.v1.GetUserRequest
-	(*GetUserResponse)(nil),                                  // 63: obiente.cloud.superadmin.v1.GetUserResponse
-	(*ListDormantResourceOwnersRequest)(nil),                 // 64: obiente.cloud.superadmin.v1.ListDormantResourceOwnersRequest
-	(*DormantResourceSummary)(nil),                           // 65: obiente.cloud.superadmin.v1.DormantResourceSummary
-	(*DormantResourceOrganization)(nil),                      // 66: obiente.cloud.superadmin.v1.DormantResourceOrganization
-	(*DormantResourceOwner)(nil),                             // 67: obiente.cloud.superadmin.v1.DormantResourceOwner
-	(*ListDormantResourceOwnersResponse)(nil),                // 68: obiente.cloud.superadmin.v1.ListDormantResourceOwnersResponse
-	(*UserInfo)(nil),                                         // 69: obiente.cloud.superadmin.v1.UserInfo
-	(*UserOrganization)(nil),                                 // 70: obiente.cloud.superadmin.v1.UserOrganization
-	(*ListAllVPSRequest)(nil),                                // 71: obiente.cloud.superadmin.v1.ListAllVPSRequest
-	(*VPSOverview)(nil),                                      // 72: obiente.cloud.superadmin.v1.VPSOverview
-	(*ListAllVPSResponse)(nil),                               // 73: obiente.cloud.superadmin.v1.ListAllVPSResponse
-	(*ListVPSSizesRequest)(nil),                              // 74: obiente.cloud.superadmin.v1.ListVPSSizesRequest
-	(*ListVPSSizesResponse)(nil),                             // 75: obiente.cloud.superadmin.v1.ListVPSSizesResponse
-	(*CreateVPSSizeRequest)(nil),                             // 76: obiente.cloud.superadmin.v1.CreateVPSSizeRequest
-	(*CreateVPSSizeResponse)(nil),                            // 77: obiente.cloud.superadmin.v1.CreateVPSSizeResponse
-	(*UpdateVPSSizeRequest)(nil),                             // 78: obiente.cloud.superadmin.v1.UpdateVPSSizeRequest
-	(*UpdateVPSSizeResponse)(nil),                            // 79: obiente.cloud.superadmin.v1.UpdateVPSSizeResponse
-	(*DeleteVPSSizeRequest)(nil),                             // 80: obiente.cloud.superadmin.v1.DeleteVPSSizeRequest
-	(*DeleteVPSSizeResponse)(nil),                            // 81: obiente.cloud.superadmin.v1.DeleteVPSSizeResponse
-	(*SuperadminGetVPSRequest)(nil),                          // 82: obiente.cloud.superadmin.v1.SuperadminGetVPSRequest
-	(*SuperadminGetVPSResponse)(nil),                         // 83: obiente.cloud.superadmin.v1.SuperadminGetVPSResponse
-	(*SuperadminResizeVPSRequest)(nil),                       // 84: obiente.cloud.superadmin.v1.SuperadminResizeVPSRequest
-	(*SuperadminResizeVPSResponse)(nil),                      // 85: obiente.cloud.superadmin.v1.SuperadminResizeVPSResponse
-	(*SuperadminSuspendVPSRequest)(nil),                      // 86: obiente.cloud.superadmin.v1.SuperadminSuspendVPSRequest
-	(*SuperadminSuspendVPSResponse)(nil),                     // 87: obiente.cloud.superadmin.v1.SuperadminSuspendVPSResponse
-	(*SuperadminUnsuspendVPSRequest)(nil),                    // 88: obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSRequest
-	(*SuperadminUnsuspendVPSResponse)(nil),                   // 89: obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSResponse
-	(*SuperadminUpdateVPSCloudInitRequest)(nil),              // 90: obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitRequest
-	(*SuperadminUpdateVPSCloudInitResponse)(nil),             // 91: obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitResponse
-	(*SuperadminForceStopVPSRequest)(nil),                    // 92: obiente.cloud.superadmin.v1.SuperadminForceStopVPSRequest
-	(*SuperadminForceStopVPSResponse)(nil),                   // 93: obiente.cloud.superadmin.v1.SuperadminForceStopVPSResponse
-	(*SuperadminForceDeleteVPSRequest)(nil),                  // 94: obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSRequest
-	(*SuperadminForceDeleteVPSResponse)(nil),                 // 95: obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSResponse
-	(*ListStripeWebhookEventsRequest)(nil),                   // 96: obiente.cloud.superadmin.v1.ListStripeWebhookEventsRequest
-	(*StripeWebhookEvent)(nil),                               // 97: obiente.cloud.superadmin.v1.StripeWebhookEvent
-	(*ListStripeWebhookEventsResponse)(nil),                  // 98: obiente.cloud.superadmin.v1.ListStripeWebhookEventsResponse
-	(*ListNodesRequest)(nil),                                 // 99: obiente.cloud.superadmin.v1.ListNodesRequest
-	(*ListNodesResponse)(nil),                                // 100: obiente.cloud.superadmin.v1.ListNodesResponse
-	(*GetNodeRequest)(nil),                                   // 101: obiente.cloud.superadmin.v1.GetNodeRequest
-	(*GetNodeResponse)(nil),                                  // 102: obiente.cloud.superadmin.v1.GetNodeResponse
-	(*UpdateNodeConfigRequest)(nil),                          // 103: obiente.cloud.superadmin.v1.UpdateNodeConfigRequest
-	(*UpdateNodeConfigResponse)(nil),                         // 104: obiente.cloud.superadmin.v1.UpdateNodeConfigResponse
-	(*NodeInfo)(nil),                                         // 105: obiente.cloud.superadmin.v1.NodeInfo
-	(*NodeConfig)(nil),                                       // 106: obiente.cloud.superadmin.v1.NodeConfig
-	(*ListSuperadminPermissionsRequest)(nil),                 // 107: obiente.cloud.superadmin.v1.ListSuperadminPermissionsRequest
-	(*SuperadminPermissionDefinition)(nil),                   // 108: obiente.cloud.superadmin.v1.SuperadminPermissionDefinition
-	(*ListSuperadminPermissionsResponse)(nil),                // 109: obiente.cloud.superadmin.v1.ListSuperadminPermissionsResponse
-	(*GetMySuperadminPermissionsRequest)(nil),                // 110: obiente.cloud.superadmin.v1.GetMySuperadminPermissionsRequest
-	(*GetMySuperadminPermissionsResponse)(nil),               // 111: obiente.cloud.superadmin.v1.GetMySuperadminPermissionsResponse
-	(*ListSuperadminRolesRequest)(nil),                       // 112: obiente.cloud.superadmin.v1.ListSuperadminRolesRequest
-	(*SuperadminRole)(nil),                                   // 113: obiente.cloud.superadmin.v1.SuperadminRole
-	(*ListSuperadminRolesResponse)(nil),                      // 114: obiente.cloud.superadmin.v1.ListSuperadminRolesResponse
-	(*CreateSuperadminRoleRequest)(nil),                      // 115: obiente.cloud.superadmin.v1.CreateSuperadminRoleRequest
-	(*CreateSuperadminRoleResponse)(nil),                     // 116: obiente.cloud.superadmin.v1.CreateSuperadminRoleResponse
-	(*UpdateSuperadminRoleRequest)(nil),                      // 117: obiente.cloud.superadmin.v1.UpdateSuperadminRoleRequest
-	(*UpdateSuperadminRoleResponse)(nil),                     // 118: obiente.cloud.superadmin.v1.UpdateSuperadminRoleResponse
-	(*DeleteSuperadminRoleRequest)(nil),                      // 119: obiente.cloud.superadmin.v1.DeleteSuperadminRoleRequest
-	(*DeleteSuperadminRoleResponse)(nil),                     // 120: obiente.cloud.superadmin.v1.DeleteSuperadminRoleResponse
-	(*ListSuperadminRoleBindingsRequest)(nil),                // 121: obiente.cloud.superadmin.v1.ListSuperadminRoleBindingsRequest
-	(*SuperadminRoleBinding)(nil),                            // 122: obiente.cloud.superadmin.v1.SuperadminRoleBinding
-	(*ListSuperadminRoleBindingsResponse)(nil),               // 123: obiente.cloud.superadmin.v1.ListSuperadminRoleBindingsResponse
-	(*CreateSuperadminRoleBindingRequest)(nil),               // 124: obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingRequest
-	(*CreateSuperadminRoleBindingResponse)(nil),              // 125: obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingResponse
-	(*DeleteSuperadminRoleBindingRequest)(nil),               // 126: obiente.cloud.superadmin.v1.DeleteSuperadminRoleBindingRequest
-	(*DeleteSuperadminRoleBindingResponse)(nil),              // 127: obiente.cloud.superadmin.v1.DeleteSuperadminRoleBindingResponse
-	(*SuspendUserRequest)(nil),                               // 128: obiente.cloud.superadmin.v1.SuspendUserRequest
-	(*SuspendUserResponse)(nil),                              // 129: obiente.cloud.superadmin.v1.SuspendUserResponse
-	(*UnsuspendUserRequest)(nil),                             // 130: obiente.cloud.superadmin.v1.UnsuspendUserRequest
-	(*UnsuspendUserResponse)(nil),                            // 131: obiente.cloud.superadmin.v1.UnsuspendUserResponse
-	(*BanUserRequest)(nil),                                   // 132: obiente.cloud.superadmin.v1.BanUserRequest
-	(*BanUserResponse)(nil),                                  // 133: obiente.cloud.superadmin.v1.BanUserResponse
-	(*UnbanUserRequest)(nil),                                 // 134: obiente.cloud.superadmin.v1.UnbanUserRequest
-	(*UnbanUserResponse)(nil),                                // 135: obiente.cloud.superadmin.v1.UnbanUserResponse
-	(*GetUserBanStatusRequest)(nil),                          // 136: obiente.cloud.superadmin.v1.GetUserBanStatusRequest
-	(*GetUserBanStatusResponse)(nil),                         // 137: obiente.cloud.superadmin.v1.GetUserBanStatusResponse
-	(*UserBanInfo)(nil),                                      // 138: obiente.cloud.superadmin.v1.UserBanInfo
-	(*SuspendOrganizationRequest)(nil),                       // 139: obiente.cloud.superadmin.v1.SuspendOrganizationRequest
-	(*SuspendOrganizationResponse)(nil),                      // 140: obiente.cloud.superadmin.v1.SuspendOrganizationResponse
-	(*UnsuspendOrganizationRequest)(nil),                     // 141: obiente.cloud.superadmin.v1.UnsuspendOrganizationRequest
-	(*UnsuspendOrganizationResponse)(nil),                    // 142: obiente.cloud.superadmin.v1.UnsuspendOrganizationResponse
-	(*BanOrganizationRequest)(nil),                           // 143: obiente.cloud.superadmin.v1.BanOrganizationRequest
-	(*BanOrganizationResponse)(nil),                          // 144: obiente.cloud.superadmin.v1.BanOrganizationResponse
-	(*UnbanOrganizationRequest)(nil),                         // 145: obiente.cloud.superadmin.v1.UnbanOrganizationRequest
-	(*UnbanOrganizationResponse)(nil),                        // 146: obiente.cloud.superadmin.v1.UnbanOrganizationResponse
-	(*GameServerOverview)(nil),                               // 147: obiente.cloud.superadmin.v1.GameServerOverview
-	(*ListAllGameServersRequest)(nil),                        // 148: obiente.cloud.superadmin.v1.ListAllGameServersRequest
-	(*ListAllGameServersResponse)(nil),                       // 149: obiente.cloud.superadmin.v1.ListAllGameServersResponse
-	(*SuperadminGetGameServerRequest)(nil),                   // 150: obiente.cloud.superadmin.v1.SuperadminGetGameServerRequest
-	(*SuperadminGetGameServerResponse)(nil),                  // 151: obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse
-	(*SuperadminSuspendGameServerRequest)(nil),               // 152: obiente.cloud.superadmin.v1.SuperadminSuspendGameServerRequest
-	(*SuperadminSuspendGameServerResponse)(nil),              // 153: obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse
-	(*SuperadminUnsuspendGameServerRequest)(nil),             // 154: obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerRequest
-	(*SuperadminUnsuspendGameServerResponse)(nil),            // 155: obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse
-	(*SuperadminForceStopGameServerRequest)(nil),             // 156: obiente.cloud.superadmin.v1.SuperadminForceStopGameServerRequest
-	(*SuperadminForceStopGameServerResponse)(nil),            // 157: obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse
-	(*SuperadminForceDeleteGameServerRequest)(nil),           // 158: obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerRequest
-	(*SuperadminForceDeleteGameServerResponse)(nil),          // 159: obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerResponse
-	nil,                                     // 160: obiente.cloud.superadmin.v1.DNSConfig.TraefikIpsByRegionEntry
-	nil,                                     // 161: obiente.cloud.superadmin.v1.UpdateNodeConfigRequest.CustomLabelsEntry
-	nil,                                     // 162: obiente.cloud.superadmin.v1.NodeConfig.CustomLabelsEntry
-	(*timestamppb.Timestamp)(nil),           // 163: google.protobuf.Timestamp
-	(v1.Environment)(0),                     // 164: obiente.cloud.deployments.v1.Environment
-	(v1.DeploymentStatus)(0),                // 165: obiente.cloud.deployments.v1.DeploymentStatus
-	(*v11.Invoice)(nil),                     // 166: obiente.cloud.billing.v1.Invoice
-	(*v12.Pagination)(nil),                  // 167: obiente.cloud.common.v1.Pagination
-	(v13.VPSStatus)(0),                      // 168: obiente.cloud.vps.v1.VPSStatus
-	(*v13.VPSInstance)(nil),                 // 169: obiente.cloud.vps.v1.VPSInstance
-	(*v12.VPSSize)(nil),                     // 170: obiente.cloud.common.v1.VPSSize
-	(*v13.CloudInitConfig)(nil),             // 171: obiente.cloud.vps.v1.CloudInitConfig
-	(*v14.GameServer)(nil),                  // 172: obiente.cloud.gameservers.v1.GameServer
-	(v14.GameServerStatus)(0),               // 173: obiente.cloud.gameservers.v1.GameServerStatus
-	(*v13.ListVPSPublicIPsRequest)(nil),     // 174: obiente.cloud.vps.v1.ListVPSPublicIPsRequest
-	(*v13.CreateVPSPublicIPRequest)(nil),    // 175: obiente.cloud.vps.v1.CreateVPSPublicIPRequest
-	(*v13.UpdateVPSPublicIPRequest)(nil),    // 176: obiente.cloud.vps.v1.UpdateVPSPublicIPRequest
-	(*v13.DeleteVPSPublicIPRequest)(nil),    // 177: obiente.cloud.vps.v1.DeleteVPSPublicIPRequest
-	(*v13.AssignVPSPublicIPRequest)(nil),    // 178: obiente.cloud.vps.v1.AssignVPSPublicIPRequest
-	(*v13.UnassignVPSPublicIPRequest)(nil),  // 179: obiente.cloud.vps.v1.UnassignVPSPublicIPRequest
-	(*v15.GetOrgLeasesRequest)(nil),         // 180: obiente.cloud.vpsgateway.v1.GetOrgLeasesRequest
-	(*v13.ListVPSPublicIPsResponse)(nil),    // 181: obiente.cloud.vps.v1.ListVPSPublicIPsResponse
-	(*v13.CreateVPSPublicIPResponse)(nil),   // 182: obiente.cloud.vps.v1.CreateVPSPublicIPResponse
-	(*v13.UpdateVPSPublicIPResponse)(nil),   // 183: obiente.cloud.vps.v1.UpdateVPSPublicIPResponse
-	(*v13.DeleteVPSPublicIPResponse)(nil),   // 184: obiente.cloud.vps.v1.DeleteVPSPublicIPResponse
-	(*v13.AssignVPSPublicIPResponse)(nil),   // 185: obiente.cloud.vps.v1.AssignVPSPublicIPResponse
-	(*v13.UnassignVPSPublicIPResponse)(nil), // 186: obiente.cloud.vps.v1.UnassignVPSPublicIPResponse
-	(*v15.GetOrgLeasesResponse)(nil),        // 187: obiente.cloud.vpsgateway.v1.GetOrgLeasesResponse
+	(*ListDNSQueryLogsRequest)(nil),                          // 12: obiente.cloud.superadmin.v1.ListDNSQueryLogsRequest
+	(*DNSQueryLog)(nil),                                      // 13: obiente.cloud.superadmin.v1.DNSQueryLog
+	(*ListDNSQueryLogsResponse)(nil),                         // 14: obiente.cloud.superadmin.v1.ListDNSQueryLogsResponse
+	(*SplitHorizonEntry)(nil),                                // 15: obiente.cloud.superadmin.v1.SplitHorizonEntry
+	(*ConfigureSplitHorizonRequest)(nil),                     // 16: obiente.cloud.superadmin.v1.ConfigureSplitHorizonRequest
+	(*ConfigureSplitHorizonResponse)(nil),                    // 17: obiente.cloud.superadmin.v1.ConfigureSplitHorizonResponse
+	(*GetDNSConfigRequest)(nil),                              // 18: obiente.cloud.superadmin.v1.GetDNSConfigRequest
+	(*DNSConfig)(nil),                                        // 19: obiente.cloud.superadmin.v1.DNSConfig
+	(*TraefikIPs)(nil),                                       // 20: obiente.cloud.superadmin.v1.TraefikIPs
+	(*GetDNSConfigResponse)(nil),                             // 21: obiente.cloud.superadmin.v1.GetDNSConfigResponse
+	(*ListDelegatedDNSRecordsRequest)(nil),                   // 22: obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsRequest
+	(*DelegatedDNSRecord)(nil),                               // 23: obiente.cloud.superadmin.v1.DelegatedDNSRecord
+	(*ListDelegatedDNSRecordsResponse)(nil),                  // 24: obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsResponse
+	(*HasDelegatedDNSRequest)(nil),                           // 25: obiente.cloud.superadmin.v1.HasDelegatedDNSRequest
+	(*HasDelegatedDNSResponse)(nil),                          // 26: obiente.cloud.superadmin.v1.HasDelegatedDNSResponse
+	(*GetPricingRequest)(nil),                                // 27: obiente.cloud.superadmin.v1.GetPricingRequest
+	(*GetPricingResponse)(nil),                               // 28: obiente.cloud.superadmin.v1.GetPricingResponse
+	(*CreateDNSDelegationAPIKeyRequest)(nil),                 // 29: obiente.cloud.superadmin.v1.CreateDNSDelegationAPIKeyRequest
+	(*CreateDNSDelegationAPIKeyResponse)(nil),                // 30: obiente.cloud.superadmin.v1.CreateDNSDelegationAPIKeyResponse
+	(*RevokeDNSDelegationAPIKeyRequest)(nil),                 // 31: obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyRequest
+	(*RevokeDNSDelegationAPIKeyResponse)(nil),                // 32: obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyResponse
+	(*RevokeDNSDelegationAPIKeyForOrganizationRequest)(nil),  // 33: obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyForOrganizationRequest
+	(*RevokeDNSDelegationAPIKeyForOrganizationResponse)(nil), // 34: obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyForOrganizationResponse
+	(*ListDNSDelegationAPIKeysRequest)(nil),                  // 35: obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysRequest
+	(*DNSDelegationAPIKeyInfo)(nil),                          // 36: obiente.cloud.superadmin.v1.DNSDelegationAPIKeyInfo
+	(*ListDNSDelegationAPIKeysResponse)(nil),                 // 37: obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysResponse
+	(*GetAbuseDetectionRequest)(nil),                         // 38: obiente.cloud.superadmin.v1.GetAbuseDetectionRequest
+	(*GetAbuseDetectionResponse)(nil),                        // 39: obiente.cloud.superadmin.v1.GetAbuseDetectionResponse
+	(*SuspiciousOrganization)(nil),                           // 40: obiente.cloud.superadmin.v1.SuspiciousOrganization
+	(*SuspiciousActivity)(nil),                               // 41: obiente.cloud.superadmin.v1.SuspiciousActivity
+	(*AbuseMetrics)(nil),                                     // 42: obiente.cloud.superadmin.v1.AbuseMetrics
+	(*GetIncomeOverviewRequest)(nil),                         // 43: obiente.cloud.superadmin.v1.GetIncomeOverviewRequest
+	(*GetIncomeOverviewResponse)(nil),                        // 44: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse
+	(*IncomeSummary)(nil),                                    // 45: obiente.cloud.superadmin.v1.IncomeSummary
+	(*MonthlyIncome)(nil),                                    // 46: obiente.cloud.superadmin.v1.MonthlyIncome
+	(*TopCustomer)(nil),                                      // 47: obiente.cloud.superadmin.v1.TopCustomer
+	(*BillingTransaction)(nil),                               // 48: obiente.cloud.superadmin.v1.BillingTransaction
+	(*PaymentMetrics)(nil),                                   // 49: obiente.cloud.superadmin.v1.PaymentMetrics
+	(*ListAllInvoicesRequest)(nil),                           // 50: obiente.cloud.superadmin.v1.ListAllInvoicesRequest
+	(*ListAllInvoicesResponse)(nil),                          // 51: obiente.cloud.superadmin.v1.ListAllInvoicesResponse
+	(*InvoiceWithOrganization)(nil),                          // 52: obiente.cloud.superadmin.v1.InvoiceWithOrganization
+	(*SendInvoiceReminderRequest)(nil),                       // 53: obiente.cloud.superadmin.v1.SendInvoiceReminderRequest
+	(*SendInvoiceReminderResponse)(nil),                      // 54: obiente.cloud.superadmin.v1.SendInvoiceReminderResponse
+	(*ListPlansRequest)(nil),                                 // 55: obiente.cloud.superadmin.v1.ListPlansRequest
+	(*ListPlansResponse)(nil),                                // 56: obiente.cloud.superadmin.v1.ListPlansResponse
+	(*CreatePlanRequest)(nil),                                // 57: obiente.cloud.superadmin.v1.CreatePlanRequest
+	(*CreatePlanResponse)(nil),                               // 58: obiente.cloud.superadmin.v1.CreatePlanResponse
+	(*UpdatePlanRequest)(nil),                                // 59: obiente.cloud.superadmin.v1.UpdatePlanRequest
+	(*UpdatePlanResponse)(nil),                               // 60: obiente.cloud.superadmin.v1.UpdatePlanResponse
+	(*DeletePlanRequest)(nil),                                // 61: obiente.cloud.superadmin.v1.DeletePlanRequest
+	(*DeletePlanResponse)(nil),                               // 62: obiente.cloud.superadmin.v1.DeletePlanResponse
+	(*Plan)(nil),                                             // 63: obiente.cloud.superadmin.v1.Plan
+	(*AssignPlanToOrganizationRequest)(nil),                  // 64: obiente.cloud.superadmin.v1.AssignPlanToOrganizationRequest
+	(*AssignPlanToOrganizationResponse)(nil),                 // 65: obiente.cloud.superadmin.v1.AssignPlanToOrganizationResponse
+	(*ListUsersRequest)(nil),                                 // 66: obiente.cloud.superadmin.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                                // 67: obiente.cloud.superadmin.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                                   // 68: obiente.cloud.superadmin.v1.GetUserRequest
+	(*GetUserResponse)(nil),                                  // 69: obiente.cloud.superadmin.v1.GetUserResponse
+	(*ListDormantResourceOwnersRequest)(nil),                 // 70: obiente.cloud.superadmin.v1.ListDormantResourceOwnersRequest
+	(*DormantResourceSummary)(nil),                           // 71: obiente.cloud.superadmin.v1.DormantResourceSummary
+	(*DormantResourceOrganization)(nil),                      // 72: obiente.cloud.superadmin.v1.DormantResourceOrganization
+	(*DormantResourceOwner)(nil),                             // 73: obiente.cloud.superadmin.v1.DormantResourceOwner
+	(*ListDormantResourceOwnersResponse)(nil),                // 74: obiente.cloud.superadmin.v1.ListDormantResourceOwnersResponse
+	(*UserInfo)(nil),                                         // 75: obiente.cloud.superadmin.v1.UserInfo
+	(*UserOrganization)(nil),                                 // 76: obiente.cloud.superadmin.v1.UserOrganization
+	(*ListAllVPSRequest)(nil),                                // 77: obiente.cloud.superadmin.v1.ListAllVPSRequest
+	(*VPSOverview)(nil),                                      // 78: obiente.cloud.superadmin.v1.VPSOverview
+	(*ListAllVPSResponse)(nil),                               // 79: obiente.cloud.superadmin.v1.ListAllVPSResponse
+	(*ListVPSSizesRequest)(nil),                              // 80: obiente.cloud.superadmin.v1.ListVPSSizesRequest
+	(*ListVPSSizesResponse)(nil),                             // 81: obiente.cloud.superadmin.v1.ListVPSSizesResponse
+	(*CreateVPSSizeRequest)(nil),                             // 82: obiente.cloud.superadmin.v1.CreateVPSSizeRequest
+	(*CreateVPSSizeResponse)(nil),                            // 83: obiente.cloud.superadmin.v1.CreateVPSSizeResponse
+	(*UpdateVPSSizeRequest)(nil),                             // 84: obiente.cloud.superadmin.v1.UpdateVPSSizeRequest
+	(*UpdateVPSSizeResponse)(nil),                            // 85: obiente.cloud.superadmin.v1.UpdateVPSSizeResponse
+	(*DeleteVPSSizeRequest)(nil),                             // 86: obiente.cloud.superadmin.v1.DeleteVPSSizeRequest
+	(*DeleteVPSSizeResponse)(nil),                            // 87: obiente.cloud.superadmin.v1.DeleteVPSSizeResponse
+	(*SuperadminGetVPSRequest)(nil),                          // 88: obiente.cloud.superadmin.v1.SuperadminGetVPSRequest
+	(*SuperadminGetVPSResponse)(nil),                         // 89: obiente.cloud.superadmin.v1.SuperadminGetVPSResponse
+	(*SuperadminResizeVPSRequest)(nil),                       // 90: obiente.cloud.superadmin.v1.SuperadminResizeVPSRequest
+	(*SuperadminResizeVPSResponse)(nil),                      // 91: obiente.cloud.superadmin.v1.SuperadminResizeVPSResponse
+	(*SuperadminSuspendVPSRequest)(nil),                      // 92: obiente.cloud.superadmin.v1.SuperadminSuspendVPSRequest
+	(*SuperadminSuspendVPSResponse)(nil),                     // 93: obiente.cloud.superadmin.v1.SuperadminSuspendVPSResponse
+	(*SuperadminUnsuspendVPSRequest)(nil),                    // 94: obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSRequest
+	(*SuperadminUnsuspendVPSResponse)(nil),                   // 95: obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSResponse
+	(*SuperadminUpdateVPSCloudInitRequest)(nil),              // 96: obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitRequest
+	(*SuperadminUpdateVPSCloudInitResponse)(nil),             // 97: obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitResponse
+	(*SuperadminForceStopVPSRequest)(nil),                    // 98: obiente.cloud.superadmin.v1.SuperadminForceStopVPSRequest
+	(*SuperadminForceStopVPSResponse)(nil),                   // 99: obiente.cloud.superadmin.v1.SuperadminForceStopVPSResponse
+	(*SuperadminForceDeleteVPSRequest)(nil),                  // 100: obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSRequest
+	(*SuperadminForceDeleteVPSResponse)(nil),                 // 101: obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSResponse
+	(*SuperadminMigrateVPSRequest)(nil),                      // 102: obiente.cloud.superadmin.v1.SuperadminMigrateVPSRequest
+	(*SuperadminMigrateVPSResponse)(nil),                     // 103: obiente.cloud.superadmin.v1.SuperadminMigrateVPSResponse
+	(*ListUnpatchedVPSRequest)(nil),                          // 104: obiente.cloud.superadmin.v1.ListUnpatchedVPSRequest
+	(*ListUnpatchedVPSResponse)(nil),                         // 105: obiente.cloud.superadmin.v1.ListUnpatchedVPSResponse
+	(*ListStripeWebhookEventsRequest)(nil),                   // 106: obiente.cloud.superadmin.v1.ListStripeWebhookEventsRequest
+	(*StripeWebhookEvent)(nil),                               // 107: obiente.cloud.superadmin.v1.StripeWebhookEvent
+	(*ListStripeWebhookEventsResponse)(nil),                  // 108: obiente.cloud.superadmin.v1.ListStripeWebhookEventsResponse
+	(*ListNodesRequest)(nil),                                 // 109: obiente.cloud.superadmin.v1.ListNodesRequest
+	(*ListNodesResponse)(nil),                                // 110: obiente.cloud.superadmin.v1.ListNodesResponse
+	(*GetNodeRequest)(nil),                                   // 111: obiente.cloud.superadmin.v1.GetNodeRequest
+	(*GetNodeResponse)(nil),                                  // 112: obiente.cloud.superadmin.v1.GetNodeResponse
+	(*UpdateNodeConfigRequest)(nil),                          // 113: obiente.cloud.superadmin.v1.UpdateNodeConfigRequest
+	(*UpdateNodeConfigResponse)(nil),                         // 114: obiente.cloud.superadmin.v1.UpdateNodeConfigResponse
+	(*NodeInfo)(nil),                                         // 115: obiente.cloud.superadmin.v1.NodeInfo
+	(*NodeConfig)(nil),                                       // 116: obiente.cloud.superadmin.v1.NodeConfig
+	(*ListSuperadminPermissionsRequest)(nil),                 // 117: obiente.cloud.superadmin.v1.ListSuperadminPermissionsRequest
+	(*SuperadminPermissionDefinition)(nil),                   // 118: obiente.cloud.superadmin.v1.SuperadminPermissionDefinition
+	(*ListSuperadminPermissionsResponse)(nil),                // 119: obiente.cloud.superadmin.v1.ListSuperadminPermissionsResponse
+	(*GetMySuperadminPermissionsRequest)(nil),                // 120: obiente.cloud.superadmin.v1.GetMySuperadminPermissionsRequest
+	(*GetMySuperadminPermissionsResponse)(nil),               // 121: obiente.cloud.superadmin.v1.GetMySuperadminPermissionsResponse
+	(*ListSuperadminRolesRequest)(nil),                       // 122: obiente.cloud.superadmin.v1.ListSuperadminRolesRequest
+	(*SuperadminRole)(nil),                                   // 123: obiente.cloud.superadmin.v1.SuperadminRole
+	(*ListSuperadminRolesResponse)(nil),                      // 124: obiente.cloud.superadmin.v1.ListSuperadminRolesResponse
+	(*CreateSuperadminRoleRequest)(nil),                      // 125: obiente.cloud.superadmin.v1.CreateSuperadminRoleRequest
+	(*CreateSuperadminRoleResponse)(nil),                     // 126: obiente.cloud.superadmin.v1.CreateSuperadminRoleResponse
+	(*UpdateSuperadminRoleRequest)(nil),                      // 127: obiente.cloud.superadmin.v1.UpdateSuperadminRoleRequest
+	(*UpdateSuperadminRoleResponse)(nil),                     // 128: obiente.cloud.superadmin.v1.UpdateSuperadminRoleResponse
+	(*DeleteSuperadminRoleRequest)(nil),                      // 129: obiente.cloud.superadmin.v1.DeleteSuperadminRoleRequest
+	(*DeleteSuperadminRoleResponse)(nil),                     // 130: obiente.cloud.superadmin.v1.DeleteSuperadminRoleResponse
+	(*ListSuperadminRoleBindingsRequest)(nil),                // 131: obiente.cloud.superadmin.v1.ListSuperadminRoleBindingsRequest
+	(*SuperadminRoleBinding)(nil),                            // 132: obiente.cloud.superadmin.v1.SuperadminRoleBinding
+	(*ListSuperadminRoleBindingsResponse)(nil),               // 133: obiente.cloud.superadmin.v1.ListSuperadminRoleBindingsResponse
+	(*CreateSuperadminRoleBindingRequest)(nil),               // 134: obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingRequest
+	(*CreateSuperadminRoleBindingResponse)(nil),              // 135: obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingResponse
+	(*DeleteSuperadminRoleBindingRequest)(nil),               // 136: obiente.cloud.superadmin.v1.DeleteSuperadminRoleBindingRequest
+	(*DeleteSuperadminRoleBindingResponse)(nil),              // 137: obiente.cloud.superadmin.v1.DeleteSuperadminRoleBindingResponse
+	(*SuspendUserRequest)(nil),                               // 138: obiente.cloud.superadmin.v1.SuspendUserRequest
+	(*SuspendUserResponse)(nil),                              // 139: obiente.cloud.superadmin.v1.SuspendUserResponse
+	(*UnsuspendUserRequest)(nil),                             // 140: obiente.cloud.superadmin.v1.UnsuspendUserRequest
+	(*UnsuspendUserResponse)(nil),                            // 141: obiente.cloud.superadmin.v1.UnsuspendUserResponse
+	(*BanUserRequest)(nil),                                   // 142: obiente.cloud.superadmin.v1.BanUserRequest
+	(*BanUserResponse)(nil),                                  // 143: obiente.cloud.superadmin.v1.BanUserResponse
+	(*UnbanUserRequest)(nil),                                 // 144: obiente.cloud.superadmin.v1.UnbanUserRequest
+	(*UnbanUserResponse)(nil),                                // 145: obiente.cloud.superadmin.v1.UnbanUserResponse
+	(*GetUserBanStatusRequest)(nil),                          // 146: obiente.cloud.superadmin.v1.GetUserBanStatusRequest
+	(*GetUserBanStatusResponse)(nil),                         // 147: obiente.cloud.superadmin.v1.GetUserBanStatusResponse
+	(*UserBanInfo)(nil),                                      // 148: obiente.cloud.superadmin.v1.UserBanInfo
+	(*BanEntry)(nil),                                         // 149: obiente.cloud.superadmin.v1.BanEntry
+	(*CreateBanEntryRequest)(nil),                            // 150: obiente.cloud.superadmin.v1.CreateBanEntryRequest
+	(*CreateBanEntryResponse)(nil),                           // 151: obiente.cloud.superadmin.v1.CreateBanEntryResponse
+	(*DeleteBanEntryRequest)(nil),                            // 152: obiente.cloud.superadmin.v1.DeleteBanEntryRequest
+	(*DeleteBanEntryResponse)(nil),                           // 153: obiente.cloud.superadmin.v1.DeleteBanEntryResponse
+	(*ListBanEntriesRequest)(nil),                            // 154: obiente.cloud.superadmin.v1.ListBanEntriesRequest
+	(*ListBanEntriesResponse)(nil),                           // 155: obiente.cloud.superadmin.v1.ListBanEntriesResponse
+	(*OrgIPAllocation)(nil),                                  // 156: obiente.cloud.superadmin.v1.OrgIPAllocation
+	(*AllocateStaticIPRequest)(nil),                          // 157: obiente.cloud.superadmin.v1.AllocateStaticIPRequest
+	(*AllocateStaticIPResponse)(nil),                         // 158: obiente.cloud.superadmin.v1.AllocateStaticIPResponse
+	(*SuspendOrganizationRequest)(nil),                       // 159: obiente.cloud.superadmin.v1.SuspendOrganizationRequest
+	(*SuspendOrganizationResponse)(nil),                      // 160: obiente.cloud.superadmin.v1.SuspendOrganizationResponse
+	(*UnsuspendOrganizationRequest)(nil),                     // 161: obiente.cloud.superadmin.v1.UnsuspendOrganizationRequest
+	(*UnsuspendOrganizationResponse)(nil),                    // 162: obiente.cloud.superadmin.v1.UnsuspendOrganizationResponse
+	(*BanOrganizationRequest)(nil),                           // 163: obiente.cloud.superadmin.v1.BanOrganizationRequest
+	(*BanOrganizationResponse)(nil),                          // 164: obiente.cloud.superadmin.v1.BanOrganizationResponse
+	(*UnbanOrganizationRequest)(nil),                         // 165: obiente.cloud.superadmin.v1.UnbanOrganizationRequest
+	(*UnbanOrganizationResponse)(nil),                        // 166: obiente.cloud.superadmin.v1.UnbanOrganizationResponse
+	(*GameServerOverview)(nil),                               // 167: obiente.cloud.superadmin.v1.GameServerOverview
+	(*ListAllGameServersRequest)(nil),                        // 168: obiente.cloud.superadmin.v1.ListAllGameServersRequest
+	(*ListAllGameServersResponse)(nil),                       // 169: obiente.cloud.superadmin.v1.ListAllGameServersResponse
+	(*SuperadminGetGameServerRequest)(nil),                   // 170: obiente.cloud.superadmin.v1.SuperadminGetGameServerRequest
+	(*SuperadminGetGameServerResponse)(nil),                  // 171: obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse
+	(*SuperadminSuspendGameServerRequest)(nil),               // 172: obiente.cloud.superadmin.v1.SuperadminSuspendGameServerRequest
+	(*SuperadminSuspendGameServerResponse)(nil),              // 173: obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse
+	(*SuperadminUnsuspendGameServerRequest)(nil),             // 174: obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerRequest
+	(*SuperadminUnsuspendGameServerResponse)(nil),            // 175: obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse
+	(*SuperadminForceStopGameServerRequest)(nil),             // 176: obiente.cloud.superadmin.v1.SuperadminForceStopGameServerRequest
+	(*SuperadminForceStopGameServerResponse)(nil),            // 177: obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse
+	(*SuperadminForceDeleteGameServerRequest)(nil),           // 178: obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerRequest
+	(*SuperadminForceDeleteGameServerResponse)(nil),          // 179: obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerResponse
+	(*LiftSuspensionRequest)(nil),                            // 180: obiente.cloud.superadmin.v1.LiftSuspensionRequest
+	(*LiftSuspensionResponse)(nil),                           // 181: obiente.cloud.superadmin.v1.LiftSuspensionResponse
+	(*GetPlatformUsageSummaryRequest)(nil),                   // 182: obiente.cloud.superadmin.v1.GetPlatformUsageSummaryRequest
+	(*OrganizationResourceUsage)(nil),                        // 183: obiente.cloud.superadmin.v1.OrganizationResourceUsage
+	(*RegionResourceUsage)(nil),                              // 184: obiente.cloud.superadmin.v1.RegionResourceUsage
+	(*GetPlatformUsageSummaryResponse)(nil),                  // 185: obiente.cloud.superadmin.v1.GetPlatformUsageSummaryResponse
+	(*GetAllOrganizationsUsageRequest)(nil),                  // 186: obiente.cloud.superadmin.v1.GetAllOrganizationsUsageRequest
+	(*GetAllOrganizationsUsageResponse)(nil),                 // 187: obiente.cloud.superadmin.v1.GetAllOrganizationsUsageResponse
+	(*SetMaintenanceModeRequest)(nil),                        // 188: obiente.cloud.superadmin.v1.SetMaintenanceModeRequest
+	(*SetMaintenanceModeResponse)(nil),                       // 189: obiente.cloud.superadmin.v1.SetMaintenanceModeResponse
+	(*DrainClusterNodeRequest)(nil),                          // 190: obiente.cloud.superadmin.v1.DrainClusterNodeRequest
+	(*DrainClusterNodeResponse)(nil),                         // 191: obiente.cloud.superadmin.v1.DrainClusterNodeResponse
+	(*EnableClusterNodeRequest)(nil),                         // 192: obiente.cloud.superadmin.v1.EnableClusterNodeRequest
+	(*EnableClusterNodeResponse)(nil),                        // 193: obiente.cloud.superadmin.v1.EnableClusterNodeResponse
+	(*RotateProxmoxTokenRequest)(nil),                        // 194: obiente.cloud.superadmin.v1.RotateProxmoxTokenRequest
+	(*RotateProxmoxTokenResponse)(nil),                       // 195: obiente.cloud.superadmin.v1.RotateProxmoxTokenResponse
+	(*GetCapacityReportRequest)(nil),                         // 196: obiente.cloud.superadmin.v1.GetCapacityReportRequest
+	(*CapacityReport)(nil),                                   // 197: obiente.cloud.superadmin.v1.CapacityReport
+	(*GetCapacityReportResponse)(nil),                        // 198: obiente.cloud.superadmin.v1.GetCapacityReportResponse
+	nil,                                                      // 199: obiente.cloud.superadmin.v1.DNSConfig.TraefikIpsByRegionEntry
+	nil,                                                      // 200: obiente.cloud.superadmin.v1.UpdateNodeConfigRequest.CustomLabelsEntry
+	nil,                                                      // 201: obiente.cloud.superadmin.v1.NodeConfig.CustomLabelsEntry
+	(*timestamppb.Timestamp)(nil),                            // 202: google.protobuf.Timestamp
+	(v1.Environment)(0),                                      // 203: obiente.cloud.deployments.v1.Environment
+	(v1.DeploymentStatus)(0),                                 // 204: obiente.cloud.deployments.v1.DeploymentStatus
+	(*v11.Invoice)(nil),                                      // 205: obiente.cloud.billing.v1.Invoice
+	(*v12.Pagination)(nil),                                   // 206: obiente.cloud.common.v1.Pagination
+	(v13.VPSStatus)(0),                                       // 207: obiente.cloud.vps.v1.VPSStatus
+	(*v13.VPSInstance)(nil),                                  // 208: obiente.cloud.vps.v1.VPSInstance
+	(*v12.VPSSize)(nil),                                      // 209: obiente.cloud.common.v1.VPSSize
+	(*v13.CloudInitConfig)(nil),                              // 210: obiente.cloud.vps.v1.CloudInitConfig
+	(*v14.GameServer)(nil),                                   // 211: obiente.cloud.gameservers.v1.GameServer
+	(v14.GameServerStatus)(0),                                // 212: obiente.cloud.gameservers.v1.GameServerStatus
+	(*v13.ListVPSPublicIPsRequest)(nil),                      // 213: obiente.cloud.vps.v1.ListVPSPublicIPsRequest
+	(*v13.CreateVPSPublicIPRequest)(nil),                     // 214: obiente.cloud.vps.v1.CreateVPSPublicIPRequest
+	(*v13.UpdateVPSPublicIPRequest)(nil),                     // 215: obiente.cloud.vps.v1.UpdateVPSPublicIPRequest
+	(*v13.DeleteVPSPublicIPRequest)(nil),                     // 216: obiente.cloud.vps.v1.DeleteVPSPublicIPRequest
+	(*v13.AssignVPSPublicIPRequest)(nil),                     // 217: obiente.cloud.vps.v1.AssignVPSPublicIPRequest
+	(*v13.UnassignVPSPublicIPRequest)(nil),                   // 218: obiente.cloud.vps.v1.UnassignVPSPublicIPRequest
+	(*v15.GetOrgLeasesRequest)(nil),                          // 219: obiente.cloud.vpsgateway.v1.GetOrgLeasesRequest
+	(*v13.ListVPSPublicIPsResponse)(nil),                     // 220: obiente.cloud.vps.v1.ListVPSPublicIPsResponse
+	(*v13.CreateVPSPublicIPResponse)(nil),                    // 221: obiente.cloud.vps.v1.CreateVPSPublicIPResponse
+	(*v13.UpdateVPSPublicIPResponse)(nil),                    // 222: obiente.cloud.vps.v1.UpdateVPSPublicIPResponse
+	(*v13.DeleteVPSPublicIPResponse)(nil),                    // 223: obiente.cloud.vps.v1.DeleteVPSPublicIPResponse
+	(*v13.AssignVPSPublicIPResponse)(nil),                    // 224: obiente.cloud.vps.v1.AssignVPSPublicIPResponse
+	(*v13.UnassignVPSPublicIPResponse)(nil),                  // 225: obiente.cloud.vps.v1.UnassignVPSPublicIPResponse
+	(*v15.GetOrgLeasesResponse)(nil),                         // 226: obiente.cloud.vpsgateway.v1.GetOrgLeasesResponse
 }
 var file_obiente_cloud_superadmin_v1_superadmin_service_proto_depIdxs = []int32{
 	2,   // 0: obiente.cloud.superadmin.v1.GetOverviewResponse.counts:type_name -> obiente.cloud.superadmin.v1.OverviewCounts
@@ -11365,252 +14013,307 @@ var file_obiente_cloud_superadmin_v1_superadmin_service_proto_depIdxs = []int32{
 	4,   // 2: obiente.cloud.superadmin.v1.GetOverviewResponse.pending_invites:type_name -> obiente.cloud.superadmin.v1.SuperadminPendingInvite
 	5,   // 3: obiente.cloud.superadmin.v1.GetOverviewResponse.deployments:type_name -> obiente.cloud.superadmin.v1.DeploymentOverview
 	6,   // 4: obiente.cloud.superadmin.v1.GetOverviewResponse.usages:type_name -> obiente.cloud.superadmin.v1.OrganizationUsage
-	163, // 5: obiente.cloud.superadmin.v1.OrganizationOverview.created_at:type_name -> google.protobuf.Timestamp
-	163, // 6: obiente.cloud.superadmin.v1.SuperadminPendingInvite.invited_at:type_name -> google.protobuf.Timestamp
-	164, // 7: obiente.cloud.superadmin.v1.DeploymentOverview.environment:type_name -> obiente.cloud.deployments.v1.Environment
-	165, // 8: obiente.cloud.superadmin.v1.DeploymentOverview.status:type_name -> obiente.cloud.deployments.v1.DeploymentStatus
-	163, // 9: obiente.cloud.superadmin.v1.DeploymentOverview.created_at:type_name -> google.protobuf.Timestamp
-	163, // 10: obiente.cloud.superadmin.v1.DeploymentOverview.last_deployed_at:type_name -> google.protobuf.Timestamp
-	163, // 11: obiente.cloud.superadmin.v1.DNSRecord.last_resolved:type_name -> google.protobuf.Timestamp
+	202, // 5: obiente.cloud.superadmin.v1.OrganizationOverview.created_at:type_name -> google.protobuf.Timestamp
+	202, // 6: obiente.cloud.superadmin.v1.SuperadminPendingInvite.invited_at:type_name -> google.protobuf.Timestamp
+	203, // 7: obiente.cloud.superadmin.v1.DeploymentOverview.environment:type_name -> obiente.cloud.deployments.v1.Environment
+	204, // 8: obiente.cloud.superadmin.v1.DeploymentOverview.status:type_name -> obiente.cloud.deployments.v1.DeploymentStatus
+	202, // 9: obiente.cloud.superadmin.v1.DeploymentOverview.created_at:type_name -> google.protobuf.Timestamp
+	202, // 10: obiente.cloud.superadmin.v1.DeploymentOverview.last_deployed_at:type_name -> google.protobuf.Timestamp
+	202, // 11: obiente.cloud.superadmin.v1.DNSRecord.last_resolved:type_name -> google.protobuf.Timestamp
 	10,  // 12: obiente.cloud.superadmin.v1.ListDNSRecordsResponse.records:type_name -> obiente.cloud.superadmin.v1.DNSRecord
-	160, // 13: obiente.cloud.superadmin.v1.DNSConfig.traefik_ips_by_region:type_name -> obiente.cloud.superadmin.v1.DNSConfig.TraefikIpsByRegionEntry
-	13,  // 14: obiente.cloud.superadmin.v1.GetDNSConfigResponse.config:type_name -> obiente.cloud.superadmin.v1.DNSConfig
-	163, // 15: obiente.cloud.superadmin.v1.DelegatedDNSRecord.expires_at:type_name -> google.protobuf.Timestamp
-	163, // 16: obiente.cloud.superadmin.v1.DelegatedDNSRecord.last_updated:type_name -> google.protobuf.Timestamp
-	163, // 17: obiente.cloud.superadmin.v1.DelegatedDNSRecord.created_at:type_name -> google.protobuf.Timestamp
-	17,  // 18: obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsResponse.records:type_name -> obiente.cloud.superadmin.v1.DelegatedDNSRecord
-	163, // 19: obiente.cloud.superadmin.v1.DNSDelegationAPIKeyInfo.created_at:type_name -> google.protobuf.Timestamp
-	163, // 20: obiente.cloud.superadmin.v1.DNSDelegationAPIKeyInfo.revoked_at:type_name -> google.protobuf.Timestamp
-	30,  // 21: obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysResponse.api_keys:type_name -> obiente.cloud.superadmin.v1.DNSDelegationAPIKeyInfo
-	34,  // 22: obiente.cloud.superadmin.v1.GetAbuseDetectionResponse.suspicious_organizations:type_name -> obiente.cloud.superadmin.v1.SuspiciousOrganization
-	35,  // 23: obiente.cloud.superadmin.v1.GetAbuseDetectionResponse.suspicious_activities:type_name -> obiente.cloud.superadmin.v1.SuspiciousActivity
-	36,  // 24: obiente.cloud.superadmin.v1.GetAbuseDetectionResponse.metrics:type_name -> obiente.cloud.superadmin.v1.AbuseMetrics
-	163, // 25: obiente.cloud.superadmin.v1.SuspiciousOrganization.created_at:type_name -> google.protobuf.Timestamp
-	163, // 26: obiente.cloud.superadmin.v1.SuspiciousOrganization.last_activity:type_name -> google.protobuf.Timestamp
-	163, // 27: obiente.cloud.superadmin.v1.SuspiciousActivity.occurred_at:type_name -> google.protobuf.Timestamp
-	39,  // 28: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse.summary:type_name -> obiente.cloud.superadmin.v1.IncomeSummary
-	40,  // 29: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse.monthly_income:type_name -> obiente.cloud.superadmin.v1.MonthlyIncome
-	41,  // 30: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse.top_customers:type_name -> obiente.cloud.superadmin.v1.TopCustomer
-	42,  // 31: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse.transactions:type_name -> obiente.cloud.superadmin.v1.BillingTransaction
-	43,  // 32: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse.payment_metrics:type_name -> obiente.cloud.superadmin.v1.PaymentMetrics
-	163, // 33: obiente.cloud.superadmin.v1.TopCustomer.first_payment:type_name -> google.protobuf.Timestamp
-	163, // 34: obiente.cloud.superadmin.v1.TopCustomer.last_payment:type_name -> google.protobuf.Timestamp
-	163, // 35: obiente.cloud.superadmin.v1.BillingTransaction.created_at:type_name -> google.protobuf.Timestamp
-	46,  // 36: obiente.cloud.superadmin.v1.ListAllInvoicesResponse.invoices:type_name -> obiente.cloud.superadmin.v1.InvoiceWithOrganization
-	166, // 37: obiente.cloud.superadmin.v1.InvoiceWithOrganization.invoice:type_name -> obiente.cloud.billing.v1.Invoice
-	57,  // 38: obiente.cloud.superadmin.v1.ListPlansResponse.plans:type_name -> obiente.cloud.superadmin.v1.Plan
-	57,  // 39: obiente.cloud.superadmin.v1.CreatePlanResponse.plan:type_name -> obiente.cloud.superadmin.v1.Plan
-	57,  // 40: obiente.cloud.superadmin.v1.UpdatePlanResponse.plan:type_name -> obiente.cloud.superadmin.v1.Plan
-	69,  // 41: obiente.cloud.superadmin.v1.ListUsersResponse.users:type_name -> obiente.cloud.superadmin.v1.UserInfo
-	167, // 42: obiente.cloud.superadmin.v1.ListUsersResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
-	69,  // 43: obiente.cloud.superadmin.v1.GetUserResponse.user:type_name -> obiente.cloud.superadmin.v1.UserInfo
-	70,  // 44: obiente.cloud.superadmin.v1.GetUserResponse.organizations:type_name -> obiente.cloud.superadmin.v1.UserOrganization
-	69,  // 45: obiente.cloud.superadmin.v1.DormantResourceOwner.user:type_name -> obiente.cloud.superadmin.v1.UserInfo
-	163, // 46: obiente.cloud.superadmin.v1.DormantResourceOwner.last_activity_at:type_name -> google.protobuf.Timestamp
-	163, // 47: obiente.cloud.superadmin.v1.DormantResourceOwner.last_resource_created_at:type_name -> google.protobuf.Timestamp
-	163, // 48: obiente.cloud.superadmin.v1.DormantResourceOwner.last_resource_updated_at:type_name -> google.protobuf.Timestamp
-	66,  // 49: obiente.cloud.superadmin.v1.DormantResourceOwner.organizations:type_name -> obiente.cloud.superadmin.v1.DormantResourceOrganization
-	67,  // 50: obiente.cloud.superadmin.v1.ListDormantResourceOwnersResponse.owners:type_name -> obiente.cloud.superadmin.v1.DormantResourceOwner
-	167, // 51: obiente.cloud.superadmin.v1.ListDormantResourceOwnersResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
-	65,  // 52: obiente.cloud.superadmin.v1.ListDormantResourceOwnersResponse.summary:type_name -> obiente.cloud.superadmin.v1.DormantResourceSummary
-	163, // 53: obiente.cloud.superadmin.v1.UserInfo.created_at:type_name -> google.protobuf.Timestamp
-	163, // 54: obiente.cloud.superadmin.v1.UserInfo.updated_at:type_name -> google.protobuf.Timestamp
-	163, // 55: obiente.cloud.superadmin.v1.UserOrganization.joined_at:type_name -> google.protobuf.Timestamp
-	168, // 56: obiente.cloud.superadmin.v1.ListAllVPSRequest.status:type_name -> obiente.cloud.vps.v1.VPSStatus
-	169, // 57: obiente.cloud.superadmin.v1.VPSOverview.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	72,  // 58: obiente.cloud.superadmin.v1.ListAllVPSResponse.vps_instances:type_name -> obiente.cloud.superadmin.v1.VPSOverview
-	167, // 59: obiente.cloud.superadmin.v1.ListAllVPSResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
-	170, // 60: obiente.cloud.superadmin.v1.ListVPSSizesResponse.sizes:type_name -> obiente.cloud.common.v1.VPSSize
-	170, // 61: obiente.cloud.superadmin.v1.CreateVPSSizeResponse.size:type_name -> obiente.cloud.common.v1.VPSSize
-	170, // 62: obiente.cloud.superadmin.v1.UpdateVPSSizeResponse.size:type_name -> obiente.cloud.common.v1.VPSSize
-	169, // 63: obiente.cloud.superadmin.v1.SuperadminGetVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	69,  // 64: obiente.cloud.superadmin.v1.SuperadminGetVPSResponse.created_by:type_name -> obiente.cloud.superadmin.v1.UserInfo
-	169, // 65: obiente.cloud.superadmin.v1.SuperadminResizeVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	169, // 66: obiente.cloud.superadmin.v1.SuperadminSuspendVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	169, // 67: obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	171, // 68: obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitRequest.cloud_init:type_name -> obiente.cloud.vps.v1.CloudInitConfig
-	169, // 69: obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	169, // 70: obiente.cloud.superadmin.v1.SuperadminForceStopVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	163, // 71: obiente.cloud.superadmin.v1.StripeWebhookEvent.processed_at:type_name -> google.protobuf.Timestamp
-	163, // 72: obiente.cloud.superadmin.v1.StripeWebhookEvent.created_at:type_name -> google.protobuf.Timestamp
-	97,  // 73: obiente.cloud.superadmin.v1.ListStripeWebhookEventsResponse.events:type_name -> obiente.cloud.superadmin.v1.StripeWebhookEvent
-	105, // 74: obiente.cloud.superadmin.v1.ListNodesResponse.nodes:type_name -> obiente.cloud.superadmin.v1.NodeInfo
-	105, // 75: obiente.cloud.superadmin.v1.GetNodeResponse.node:type_name -> obiente.cloud.superadmin.v1.NodeInfo
-	161, // 76: obiente.cloud.superadmin.v1.UpdateNodeConfigRequest.custom_labels:type_name -> obiente.cloud.superadmin.v1.UpdateNodeConfigRequest.CustomLabelsEntry
-	105, // 77: obiente.cloud.superadmin.v1.UpdateNodeConfigResponse.node:type_name -> obiente.cloud.superadmin.v1.NodeInfo
-	106, // 78: obiente.cloud.superadmin.v1.NodeInfo.config:type_name -> obiente.cloud.superadmin.v1.NodeConfig
-	163, // 79: obiente.cloud.superadmin.v1.NodeInfo.last_heartbeat:type_name -> google.protobuf.Timestamp
-	163, // 80: obiente.cloud.superadmin.v1.NodeInfo.created_at:type_name -> google.protobuf.Timestamp
-	163, // 81: obiente.cloud.superadmin.v1.NodeInfo.updated_at:type_name -> google.protobuf.Timestamp
-	162, // 82: obiente.cloud.superadmin.v1.NodeConfig.custom_labels:type_name -> obiente.cloud.superadmin.v1.NodeConfig.CustomLabelsEntry
-	108, // 83: obiente.cloud.superadmin.v1.ListSuperadminPermissionsResponse.permissions:type_name -> obiente.cloud.superadmin.v1.SuperadminPermissionDefinition
-	113, // 84: obiente.cloud.superadmin.v1.ListSuperadminRolesResponse.roles:type_name -> obiente.cloud.superadmin.v1.SuperadminRole
-	113, // 85: obiente.cloud.superadmin.v1.CreateSuperadminRoleResponse.role:type_name -> obiente.cloud.superadmin.v1.SuperadminRole
-	113, // 86: obiente.cloud.superadmin.v1.UpdateSuperadminRoleResponse.role:type_name -> obiente.cloud.superadmin.v1.SuperadminRole
-	122, // 87: obiente.cloud.superadmin.v1.ListSuperadminRoleBindingsResponse.bindings:type_name -> obiente.cloud.superadmin.v1.SuperadminRoleBinding
-	122, // 88: obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingResponse.binding:type_name -> obiente.cloud.superadmin.v1.SuperadminRoleBinding
-	163, // 89: obiente.cloud.superadmin.v1.SuspendUserRequest.expires_at:type_name -> google.protobuf.Timestamp
-	138, // 90: obiente.cloud.superadmin.v1.SuspendUserResponse.ban:type_name -> obiente.cloud.superadmin.v1.UserBanInfo
-	138, // 91: obiente.cloud.superadmin.v1.BanUserResponse.ban:type_name -> obiente.cloud.superadmin.v1.UserBanInfo
-	138, // 92: obiente.cloud.superadmin.v1.GetUserBanStatusResponse.ban:type_name -> obiente.cloud.superadmin.v1.UserBanInfo
-	163, // 93: obiente.cloud.superadmin.v1.UserBanInfo.banned_at:type_name -> google.protobuf.Timestamp
-	163, // 94: obiente.cloud.superadmin.v1.UserBanInfo.expires_at:type_name -> google.protobuf.Timestamp
-	163, // 95: obiente.cloud.superadmin.v1.SuspendOrganizationRequest.expires_at:type_name -> google.protobuf.Timestamp
-	172, // 96: obiente.cloud.superadmin.v1.GameServerOverview.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
-	69,  // 97: obiente.cloud.superadmin.v1.GameServerOverview.created_by_user:type_name -> obiente.cloud.superadmin.v1.UserInfo
-	173, // 98: obiente.cloud.superadmin.v1.ListAllGameServersRequest.status:type_name -> obiente.cloud.gameservers.v1.GameServerStatus
-	147, // 99: obiente.cloud.superadmin.v1.ListAllGameServersResponse.game_servers:type_name -> obiente.cloud.superadmin.v1.GameServerOverview
-	167, // 100: obiente.cloud.superadmin.v1.ListAllGameServersResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
-	147, // 101: obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse.game_server:type_name -> obiente.cloud.superadmin.v1.GameServerOverview
-	172, // 102: obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
-	172, // 103: obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
-	172, // 104: obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
-	14,  // 105: obiente.cloud.superadmin.v1.DNSConfig.TraefikIpsByRegionEntry.value:type_name -> obiente.cloud.superadmin.v1.TraefikIPs
-	0,   // 106: obiente.cloud.superadmin.v1.SuperadminService.GetOverview:input_type -> obiente.cloud.superadmin.v1.GetOverviewRequest
-	7,   // 107: obiente.cloud.superadmin.v1.SuperadminService.QueryDNS:input_type -> obiente.cloud.superadmin.v1.QueryDNSRequest
-	9,   // 108: obiente.cloud.superadmin.v1.SuperadminService.ListDNSRecords:input_type -> obiente.cloud.superadmin.v1.ListDNSRecordsRequest
-	12,  // 109: obiente.cloud.superadmin.v1.SuperadminService.GetDNSConfig:input_type -> obiente.cloud.superadmin.v1.GetDNSConfigRequest
-	16,  // 110: obiente.cloud.superadmin.v1.SuperadminService.ListDelegatedDNSRecords:input_type -> obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsRequest
-	19,  // 111: obiente.cloud.superadmin.v1.SuperadminService.HasDelegatedDNS:input_type -> obiente.cloud.superadmin.v1.HasDelegatedDNSRequest
-	23,  // 112: obiente.cloud.superadmin.v1.SuperadminService.CreateDNSDelegationAPIKey:input_type -> obiente.cloud.superadmin.v1.CreateDNSDelegationAPIKeyRequest
-	29,  // 113: obiente.cloud.superadmin.v1.SuperadminService.ListDNSDelegationAPIKeys:input_type -> obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysRequest
-	25,  // 114: obiente.cloud.superadmin.v1.SuperadminService.RevokeDNSDelegationAPIKey:input_type -> obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyRequest
-	27,  // 115: obiente.cloud.superadmin.v1.SuperadminService.RevokeDNSDelegationAPIKeyForOrganization:input_type -> obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyForOrganizationRequest
-	21,  // 116: obiente.cloud.superadmin.v1.SuperadminService.GetPricing:input_type -> obiente.cloud.superadmin.v1.GetPricingRequest
-	32,  // 117: obiente.cloud.superadmin.v1.SuperadminService.GetAbuseDetection:input_type -> obiente.cloud.superadmin.v1.GetAbuseDetectionRequest
-	37,  // 118: obiente.cloud.superadmin.v1.SuperadminService.GetIncomeOverview:input_type -> obiente.cloud.superadmin.v1.GetIncomeOverviewRequest
-	44,  // 119: obiente.cloud.superadmin.v1.SuperadminService.ListAllInvoices:input_type -> obiente.cloud.superadmin.v1.ListAllInvoicesRequest
-	47,  // 120: obiente.cloud.superadmin.v1.SuperadminService.SendInvoiceReminder:input_type -> obiente.cloud.superadmin.v1.SendInvoiceReminderRequest
-	49,  // 121: obiente.cloud.superadmin.v1.SuperadminService.ListPlans:input_type -> obiente.cloud.superadmin.v1.ListPlansRequest
-	51,  // 122: obiente.cloud.superadmin.v1.SuperadminService.CreatePlan:input_type -> obiente.cloud.superadmin.v1.CreatePlanRequest
-	53,  // 123: obiente.cloud.superadmin.v1.SuperadminService.UpdatePlan:input_type -> obiente.cloud.superadmin.v1.UpdatePlanRequest
-	55,  // 124: obiente.cloud.superadmin.v1.SuperadminService.DeletePlan:input_type -> obiente.cloud.superadmin.v1.DeletePlanRequest
-	58,  // 125: obiente.cloud.superadmin.v1.SuperadminService.AssignPlanToOrganization:input_type -> obiente.cloud.superadmin.v1.AssignPlanToOrganizationRequest
-	60,  // 126: obiente.cloud.superadmin.v1.SuperadminService.ListUsers:input_type -> obiente.cloud.superadmin.v1.ListUsersRequest
-	62,  // 127: obiente.cloud.superadmin.v1.SuperadminService.GetUser:input_type -> obiente.cloud.superadmin.v1.GetUserRequest
-	64,  // 128: obiente.cloud.superadmin.v1.SuperadminService.ListDormantResourceOwners:input_type -> obiente.cloud.superadmin.v1.ListDormantResourceOwnersRequest
-	128, // 129: obiente.cloud.superadmin.v1.SuperadminService.SuspendUser:input_type -> obiente.cloud.superadmin.v1.SuspendUserRequest
-	130, // 130: obiente.cloud.superadmin.v1.SuperadminService.UnsuspendUser:input_type -> obiente.cloud.superadmin.v1.UnsuspendUserRequest
-	132, // 131: obiente.cloud.superadmin.v1.SuperadminService.BanUser:input_type -> obiente.cloud.superadmin.v1.BanUserRequest
-	134, // 132: obiente.cloud.superadmin.v1.SuperadminService.UnbanUser:input_type -> obiente.cloud.superadmin.v1.UnbanUserRequest
-	136, // 133: obiente.cloud.superadmin.v1.SuperadminService.GetUserBanStatus:input_type -> obiente.cloud.superadmin.v1.GetUserBanStatusRequest
-	139, // 134: obiente.cloud.superadmin.v1.SuperadminService.SuspendOrganization:input_type -> obiente.cloud.superadmin.v1.SuspendOrganizationRequest
-	141, // 135: obiente.cloud.superadmin.v1.SuperadminService.UnsuspendOrganization:input_type -> obiente.cloud.superadmin.v1.UnsuspendOrganizationRequest
-	143, // 136: obiente.cloud.superadmin.v1.SuperadminService.BanOrganization:input_type -> obiente.cloud.superadmin.v1.BanOrganizationRequest
-	145, // 137: obiente.cloud.superadmin.v1.SuperadminService.UnbanOrganization:input_type -> obiente.cloud.superadmin.v1.UnbanOrganizationRequest
-	71,  // 138: obiente.cloud.superadmin.v1.SuperadminService.ListAllVPS:input_type -> obiente.cloud.superadmin.v1.ListAllVPSRequest
-	82,  // 139: obiente.cloud.superadmin.v1.SuperadminService.SuperadminGetVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminGetVPSRequest
-	84,  // 140: obiente.cloud.superadmin.v1.SuperadminService.SuperadminResizeVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminResizeVPSRequest
-	86,  // 141: obiente.cloud.superadmin.v1.SuperadminService.SuperadminSuspendVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminSuspendVPSRequest
-	88,  // 142: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUnsuspendVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSRequest
-	90,  // 143: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUpdateVPSCloudInit:input_type -> obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitRequest
-	92,  // 144: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceStopVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminForceStopVPSRequest
-	94,  // 145: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceDeleteVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSRequest
-	74,  // 146: obiente.cloud.superadmin.v1.SuperadminService.ListVPSSizes:input_type -> obiente.cloud.superadmin.v1.ListVPSSizesRequest
-	76,  // 147: obiente.cloud.superadmin.v1.SuperadminService.CreateVPSSize:input_type -> obiente.cloud.superadmin.v1.CreateVPSSizeRequest
-	78,  // 148: obiente.cloud.superadmin.v1.SuperadminService.UpdateVPSSize:input_type -> obiente.cloud.superadmin.v1.UpdateVPSSizeRequest
-	80,  // 149: obiente.cloud.superadmin.v1.SuperadminService.DeleteVPSSize:input_type -> obiente.cloud.superadmin.v1.DeleteVPSSizeRequest
-	174, // 150: obiente.cloud.superadmin.v1.SuperadminService.ListVPSPublicIPs:input_type -> obiente.cloud.vps.v1.ListVPSPublicIPsRequest
-	175, // 151: obiente.cloud.superadmin.v1.SuperadminService.CreateVPSPublicIP:input_type -> obiente.cloud.vps.v1.CreateVPSPublicIPRequest
-	176, // 152: obiente.cloud.superadmin.v1.SuperadminService.UpdateVPSPublicIP:input_type -> obiente.cloud.vps.v1.UpdateVPSPublicIPRequest
-	177, // 153: obiente.cloud.superadmin.v1.SuperadminService.DeleteVPSPublicIP:input_type -> obiente.cloud.vps.v1.DeleteVPSPublicIPRequest
-	178, // 154: obiente.cloud.superadmin.v1.SuperadminService.AssignVPSPublicIP:input_type -> obiente.cloud.vps.v1.AssignVPSPublicIPRequest
-	179, // 155: obiente.cloud.superadmin.v1.SuperadminService.UnassignVPSPublicIP:input_type -> obiente.cloud.vps.v1.UnassignVPSPublicIPRequest
-	180, // 156: obiente.cloud.superadmin.v1.SuperadminService.GetOrgLeases:input_type -> obiente.cloud.vpsgateway.v1.GetOrgLeasesRequest
-	96,  // 157: obiente.cloud.superadmin.v1.SuperadminService.ListStripeWebhookEvents:input_type -> obiente.cloud.superadmin.v1.ListStripeWebhookEventsRequest
-	99,  // 158: obiente.cloud.superadmin.v1.SuperadminService.ListNodes:input_type -> obiente.cloud.superadmin.v1.ListNodesRequest
-	101, // 159: obiente.cloud.superadmin.v1.SuperadminService.GetNode:input_type -> obiente.cloud.superadmin.v1.GetNodeRequest
-	103, // 160: obiente.cloud.superadmin.v1.SuperadminService.UpdateNodeConfig:input_type -> obiente.cloud.superadmin.v1.UpdateNodeConfigRequest
-	107, // 161: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminPermissions:input_type -> obiente.cloud.superadmin.v1.ListSuperadminPermissionsRequest
-	110, // 162: obiente.cloud.superadmin.v1.SuperadminService.GetMySuperadminPermissions:input_type -> obiente.cloud.superadmin.v1.GetMySuperadminPermissionsRequest
-	148, // 163: obiente.cloud.superadmin.v1.SuperadminService.ListAllGameServers:input_type -> obiente.cloud.superadmin.v1.ListAllGameServersRequest
-	150, // 164: obiente.cloud.superadmin.v1.SuperadminService.SuperadminGetGameServer:input_type -> obiente.cloud.superadmin.v1.SuperadminGetGameServerRequest
-	152, // 165: obiente.cloud.superadmin.v1.SuperadminService.SuperadminSuspendGameServer:input_type -> obiente.cloud.superadmin.v1.SuperadminSuspendGameServerRequest
-	154, // 166: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUnsuspendGameServer:input_type -> obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerRequest
-	156, // 167: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceStopGameServer:input_type -> obiente.cloud.superadmin.v1.SuperadminForceStopGameServerRequest
-	158, // 168: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceDeleteGameServer:input_type -> obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerRequest
-	112, // 169: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminRoles:input_type -> obiente.cloud.superadmin.v1.ListSuperadminRolesRequest
-	115, // 170: obiente.cloud.superadmin.v1.SuperadminService.CreateSuperadminRole:input_type -> obiente.cloud.superadmin.v1.CreateSuperadminRoleRequest
-	117, // 171: obiente.cloud.superadmin.v1.SuperadminService.UpdateSuperadminRole:input_type -> obiente.cloud.superadmin.v1.UpdateSuperadminRoleRequest
-	119, // 172: obiente.cloud.superadmin.v1.SuperadminService.DeleteSuperadminRole:input_type -> obiente.cloud.superadmin.v1.DeleteSuperadminRoleRequest
-	121, // 173: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminRoleBindings:input_type -> obiente.cloud.superadmin.v1.ListSuperadminRoleBindingsRequest
-	124, // 174: obiente.cloud.superadmin.v1.SuperadminService.CreateSuperadminRoleBinding:input_type -> obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingRequest
-	126, // 175: obiente.cloud.superadmin.v1.SuperadminService.DeleteSuperadminRoleBinding:input_type -> obiente.cloud.superadmin.v1.DeleteSuperadminRoleBindingRequest
-	1,   // 176: obiente.cloud.superadmin.v1.SuperadminService.GetOverview:output_type -> obiente.cloud.superadmin.v1.GetOverviewResponse
-	8,   // 177: obiente.cloud.superadmin.v1.SuperadminService.QueryDNS:output_type -> obiente.cloud.superadmin.v1.QueryDNSResponse
-	11,  // 178: obiente.cloud.superadmin.v1.SuperadminService.ListDNSRecords:output_type -> obiente.cloud.superadmin.v1.ListDNSRecordsResponse
-	15,  // 179: obiente.cloud.superadmin.v1.SuperadminService.GetDNSConfig:output_type -> obiente.cloud.superadmin.v1.GetDNSConfigResponse
-	18,  // 180: obiente.cloud.superadmin.v1.SuperadminService.ListDelegatedDNSRecords:output_type -> obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsResponse
-	20,  // 181: obiente.cloud.superadmin.v1.SuperadminService.HasDelegatedDNS:output_type -> obiente.cloud.superadmin.v1.HasDelegatedDNSResponse
-	24,  // 182: obiente.cloud.superadmin.v1.SuperadminService.CreateDNSDelegationAPIKey:output_type -> obiente.cloud.superadmin.v1.CreateDNSDelegationAPIKeyResponse
-	31,  // 183: obiente.cloud.superadmin.v1.SuperadminService.ListDNSDelegationAPIKeys:output_type -> obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysResponse
-	26,  // 184: obiente.cloud.superadmin.v1.SuperadminService.RevokeDNSDelegationAPIKey:output_type -> obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyResponse
-	28,  // 185: obiente.cloud.superadmin.v1.SuperadminService.RevokeDNSDelegationAPIKeyForOrganization:output_type -> obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyForOrganizationResponse
-	22,  // 186: obiente.cloud.superadmin.v1.SuperadminService.GetPricing:output_type -> obiente.cloud.superadmin.v1.GetPricingResponse
-	33,  // 187: obiente.cloud.superadmin.v1.SuperadminService.GetAbuseDetection:output_type -> obiente.cloud.superadmin.v1.GetAbuseDetectionResponse
-	38,  // 188: obiente.cloud.superadmin.v1.SuperadminService.GetIncomeOverview:output_type -> obiente.cloud.superadmin.v1.GetIncomeOverviewResponse
-	45,  // 189: obiente.cloud.superadmin.v1.SuperadminService.ListAllInvoices:output_type -> obiente.cloud.superadmin.v1.ListAllInvoicesResponse
-	48,  // 190: obiente.cloud.superadmin.v1.SuperadminService.SendInvoiceReminder:output_type -> obiente.cloud.superadmin.v1.SendInvoiceReminderResponse
-	50,  // 191: obiente.cloud.superadmin.v1.SuperadminService.ListPlans:output_type -> obiente.cloud.superadmin.v1.ListPlansResponse
-	52,  // 192: obiente.cloud.superadmin.v1.SuperadminService.CreatePlan:output_type -> obiente.cloud.superadmin.v1.CreatePlanResponse
-	54,  // 193: obiente.cloud.superadmin.v1.SuperadminService.UpdatePlan:output_type -> obiente.cloud.superadmin.v1.UpdatePlanResponse
-	56,  // 194: obiente.cloud.superadmin.v1.SuperadminService.DeletePlan:output_type -> obiente.cloud.superadmin.v1.DeletePlanResponse
-	59,  // 195: obiente.cloud.superadmin.v1.SuperadminService.AssignPlanToOrganization:output_type -> obiente.cloud.superadmin.v1.AssignPlanToOrganizationResponse
-	61,  // 196: obiente.cloud.superadmin.v1.SuperadminService.ListUsers:output_type -> obiente.cloud.superadmin.v1.ListUsersResponse
-	63,  // 197: obiente.cloud.superadmin.v1.SuperadminService.GetUser:output_type -> obiente.cloud.superadmin.v1.GetUserResponse
-	68,  // 198: obiente.cloud.superadmin.v1.SuperadminService.ListDormantResourceOwners:output_type -> obiente.cloud.superadmin.v1.ListDormantResourceOwnersResponse
-	129, // 199: obiente.cloud.superadmin.v1.SuperadminService.SuspendUser:output_type -> obiente.cloud.superadmin.v1.SuspendUserResponse
-	131, // 200: obiente.cloud.superadmin.v1.SuperadminService.UnsuspendUser:output_type -> obiente.cloud.superadmin.v1.UnsuspendUserResponse
-	133, // 201: obiente.cloud.superadmin.v1.SuperadminService.BanUser:output_type -> obiente.cloud.superadmin.v1.BanUserResponse
-	135, // 202: obiente.cloud.superadmin.v1.SuperadminService.UnbanUser:output_type -> obiente.cloud.superadmin.v1.UnbanUserResponse
-	137, // 203: obiente.cloud.superadmin.v1.SuperadminService.GetUserBanStatus:output_type -> obiente.cloud.superadmin.v1.GetUserBanStatusResponse
-	140, // 204: obiente.cloud.superadmin.v1.SuperadminService.SuspendOrganization:output_type -> obiente.cloud.superadmin.v1.SuspendOrganizationResponse
-	142, // 205: obiente.cloud.superadmin.v1.SuperadminService.UnsuspendOrganization:output_type -> obiente.cloud.superadmin.v1.UnsuspendOrganizationResponse
-	144, // 206: obiente.cloud.superadmin.v1.SuperadminService.BanOrganization:output_type -> obiente.cloud.superadmin.v1.BanOrganizationResponse
-	146, // 207: obiente.cloud.superadmin.v1.SuperadminService.UnbanOrganization:output_type -> obiente.cloud.superadmin.v1.UnbanOrganizationResponse
-	73,  // 208: obiente.cloud.superadmin.v1.SuperadminService.ListAllVPS:output_type -> obiente.cloud.superadmin.v1.ListAllVPSResponse
-	83,  // 209: obiente.cloud.superadmin.v1.SuperadminService.SuperadminGetVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminGetVPSResponse
-	85,  // 210: obiente.cloud.superadmin.v1.SuperadminService.SuperadminResizeVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminResizeVPSResponse
-	87,  // 211: obiente.cloud.superadmin.v1.SuperadminService.SuperadminSuspendVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminSuspendVPSResponse
-	89,  // 212: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUnsuspendVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSResponse
-	91,  // 213: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUpdateVPSCloudInit:output_type -> obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitResponse
-	93,  // 214: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceStopVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminForceStopVPSResponse
-	95,  // 215: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceDeleteVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSResponse
-	75,  // 216: obiente.cloud.superadmin.v1.SuperadminService.ListVPSSizes:output_type -> obiente.cloud.superadmin.v1.ListVPSSizesResponse
-	77,  // 217: obiente.cloud.superadmin.v1.SuperadminService.CreateVPSSize:output_type -> obiente.cloud.superadmin.v1.CreateVPSSizeResponse
-	79,  // 218: obiente.cloud.superadmin.v1.SuperadminService.UpdateVPSSize:output_type -> obiente.cloud.superadmin.v1.UpdateVPSSizeResponse
-	81,  // 219: obiente.cloud.superadmin.v1.SuperadminService.DeleteVPSSize:output_type -> obiente.cloud.superadmin.v1.DeleteVPSSizeResponse
-	181, // 220: obiente.cloud.superadmin.v1.SuperadminService.ListVPSPublicIPs:output_type -> obiente.cloud.vps.v1.ListVPSPublicIPsResponse
-	182, // 221: obiente.cloud.superadmin.v1.SuperadminService.CreateVPSPublicIP:output_type -> obiente.cloud.vps.v1.CreateVPSPublicIPResponse
-	183, // 222: obiente.cloud.superadmin.v1.SuperadminService.UpdateVPSPublicIP:output_type -> obiente.cloud.vps.v1.UpdateVPSPublicIPResponse
-	184, // 223: obiente.cloud.superadmin.v1.SuperadminService.DeleteVPSPublicIP:output_type -> obiente.cloud.vps.v1.DeleteVPSPublicIPResponse
-	185, // 224: obiente.cloud.superadmin.v1.SuperadminService.AssignVPSPublicIP:output_type -> obiente.cloud.vps.v1.AssignVPSPublicIPResponse
-	186, // 225: obiente.cloud.superadmin.v1.SuperadminService.UnassignVPSPublicIP:output_type -> obiente.cloud.vps.v1.UnassignVPSPublicIPResponse
-	187, // 226: obiente.cloud.superadmin.v1.SuperadminService.GetOrgLeases:output_type -> obiente.cloud.vpsgateway.v1.GetOrgLeasesResponse
-	98,  // 227: obiente.cloud.superadmin.v1.SuperadminService.ListStripeWebhookEvents:output_type -> obiente.cloud.superadmin.v1.ListStripeWebhookEventsResponse
-	100, // 228: obiente.cloud.superadmin.v1.SuperadminService.ListNodes:output_type -> obiente.cloud.superadmin.v1.ListNodesResponse
-	102, // 229: obiente.cloud.superadmin.v1.SuperadminService.GetNode:output_type -> obiente.cloud.superadmin.v1.GetNodeResponse
-	104, // 230: obiente.cloud.superadmin.v1.SuperadminService.UpdateNodeConfig:output_type -> obiente.cloud.superadmin.v1.UpdateNodeConfigResponse
-	109, // 231: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminPermissions:output_type -> obiente.cloud.superadmin.v1.ListSuperadminPermissionsResponse
-	111, // 232: obiente.cloud.superadmin.v1.SuperadminService.GetMySuperadminPermissions:output_type -> obiente.cloud.superadmin.v1.GetMySuperadminPermissionsResponse
-	149, // 233: obiente.cloud.superadmin.v1.SuperadminService.ListAllGameServers:output_type -> obiente.cloud.superadmin.v1.ListAllGameServersResponse
-	151, // 234: obiente.cloud.superadmin.v1.SuperadminService.SuperadminGetGameServer:output_type -> obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse
-	153, // 235: obiente.cloud.superadmin.v1.SuperadminService.SuperadminSuspendGameServer:output_type -> obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse
-	155, // 236: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUnsuspendGameServer:output_type -> obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse
-	157, // 237: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceStopGameServer:output_type -> obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse
-	159, // 238: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceDeleteGameServer:output_type -> obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerResponse
-	114, // 239: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminRoles:output_type -> obiente.cloud.superadmin.v1.ListSuperadminRolesResponse
-	116, // 240: obiente.cloud.superadmin.v1.SuperadminService.CreateSuperadminRole:output_type -> obiente.cloud.superadmin.v1.CreateSuperadminRoleResponse
-	118, // 241: obiente.cloud.superadmin.v1.SuperadminService.UpdateSuperadminRole:output_type -> obiente.cloud.superadmin.v1.UpdateSuperadminRoleResponse
-	120, // 242: obiente.cloud.superadmin.v1.SuperadminService.DeleteSuperadminRole:output_type -> obiente.cloud.superadmin.v1.DeleteSuperadminRoleResponse
-	123, // 243: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminRoleBindings:output_type -> obiente.cloud.superadmin.v1.ListSuperadminRoleBindingsResponse
-	125, // 244: obiente.cloud.superadmin.v1.SuperadminService.CreateSuperadminRoleBinding:output_type -> obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingResponse
-	127, // 245: obiente.cloud.superadmin.v1.SuperadminService.DeleteSuperadminRoleBinding:output_type -> obiente.cloud.superadmin.v1.DeleteSuperadminRoleBindingResponse
-	176, // [176:246] is the sub-list for method output_type
-	106, // [106:176] is the sub-list for method input_type
-	106, // [106:106] is the sub-list for extension type_name
-	106, // [106:106] is the sub-list for extension extendee
-	0,   // [0:106] is the sub-list for field type_name
+	202, // 13: obiente.cloud.superadmin.v1.ListDNSQueryLogsRequest.since:type_name -> google.protobuf.Timestamp
+	202, // 14: obiente.cloud.superadmin.v1.DNSQueryLog.timestamp:type_name -> google.protobuf.Timestamp
+	13,  // 15: obiente.cloud.superadmin.v1.ListDNSQueryLogsResponse.logs:type_name -> obiente.cloud.superadmin.v1.DNSQueryLog
+	15,  // 16: obiente.cloud.superadmin.v1.ConfigureSplitHorizonRequest.entries:type_name -> obiente.cloud.superadmin.v1.SplitHorizonEntry
+	15,  // 17: obiente.cloud.superadmin.v1.ConfigureSplitHorizonResponse.entries:type_name -> obiente.cloud.superadmin.v1.SplitHorizonEntry
+	199, // 18: obiente.cloud.superadmin.v1.DNSConfig.traefik_ips_by_region:type_name -> obiente.cloud.superadmin.v1.DNSConfig.TraefikIpsByRegionEntry
+	19,  // 19: obiente.cloud.superadmin.v1.GetDNSConfigResponse.config:type_name -> obiente.cloud.superadmin.v1.DNSConfig
+	202, // 20: obiente.cloud.superadmin.v1.DelegatedDNSRecord.expires_at:type_name -> google.protobuf.Timestamp
+	202, // 21: obiente.cloud.superadmin.v1.DelegatedDNSRecord.last_updated:type_name -> google.protobuf.Timestamp
+	202, // 22: obiente.cloud.superadmin.v1.DelegatedDNSRecord.created_at:type_name -> google.protobuf.Timestamp
+	23,  // 23: obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsResponse.records:type_name -> obiente.cloud.superadmin.v1.DelegatedDNSRecord
+	202, // 24: obiente.cloud.superadmin.v1.DNSDelegationAPIKeyInfo.created_at:type_name -> google.protobuf.Timestamp
+	202, // 25: obiente.cloud.superadmin.v1.DNSDelegationAPIKeyInfo.revoked_at:type_name -> google.protobuf.Timestamp
+	36,  // 26: obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysResponse.api_keys:type_name -> obiente.cloud.superadmin.v1.DNSDelegationAPIKeyInfo
+	40,  // 27: obiente.cloud.superadmin.v1.GetAbuseDetectionResponse.suspicious_organizations:type_name -> obiente.cloud.superadmin.v1.SuspiciousOrganization
+	41,  // 28: obiente.cloud.superadmin.v1.GetAbuseDetectionResponse.suspicious_activities:type_name -> obiente.cloud.superadmin.v1.SuspiciousActivity
+	42,  // 29: obiente.cloud.superadmin.v1.GetAbuseDetectionResponse.metrics:type_name -> obiente.cloud.superadmin.v1.AbuseMetrics
+	202, // 30: obiente.cloud.superadmin.v1.SuspiciousOrganization.created_at:type_name -> google.protobuf.Timestamp
+	202, // 31: obiente.cloud.superadmin.v1.SuspiciousOrganization.last_activity:type_name -> google.protobuf.Timestamp
+	202, // 32: obiente.cloud.superadmin.v1.SuspiciousActivity.occurred_at:type_name -> google.protobuf.Timestamp
+	45,  // 33: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse.summary:type_name -> obiente.cloud.superadmin.v1.IncomeSummary
+	46,  // 34: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse.monthly_income:type_name -> obiente.cloud.superadmin.v1.MonthlyIncome
+	47,  // 35: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse.top_customers:type_name -> obiente.cloud.superadmin.v1.TopCustomer
+	48,  // 36: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse.transactions:type_name -> obiente.cloud.superadmin.v1.BillingTransaction
+	49,  // 37: obiente.cloud.superadmin.v1.GetIncomeOverviewResponse.payment_metrics:type_name -> obiente.cloud.superadmin.v1.PaymentMetrics
+	202, // 38: obiente.cloud.superadmin.v1.TopCustomer.first_payment:type_name -> google.protobuf.Timestamp
+	202, // 39: obiente.cloud.superadmin.v1.TopCustomer.last_payment:type_name -> google.protobuf.Timestamp
+	202, // 40: obiente.cloud.superadmin.v1.BillingTransaction.created_at:type_name -> google.protobuf.Timestamp
+	52,  // 41: obiente.cloud.superadmin.v1.ListAllInvoicesResponse.invoices:type_name -> obiente.cloud.superadmin.v1.InvoiceWithOrganization
+	205, // 42: obiente.cloud.superadmin.v1.InvoiceWithOrganization.invoice:type_name -> obiente.cloud.billing.v1.Invoice
+	63,  // 43: obiente.cloud.superadmin.v1.ListPlansResponse.plans:type_name -> obiente.cloud.superadmin.v1.Plan
+	63,  // 44: obiente.cloud.superadmin.v1.CreatePlanResponse.plan:type_name -> obiente.cloud.superadmin.v1.Plan
+	63,  // 45: obiente.cloud.superadmin.v1.UpdatePlanResponse.plan:type_name -> obiente.cloud.superadmin.v1.Plan
+	75,  // 46: obiente.cloud.superadmin.v1.ListUsersResponse.users:type_name -> obiente.cloud.superadmin.v1.UserInfo
+	206, // 47: obiente.cloud.superadmin.v1.ListUsersResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	75,  // 48: obiente.cloud.superadmin.v1.GetUserResponse.user:type_name -> obiente.cloud.superadmin.v1.UserInfo
+	76,  // 49: obiente.cloud.superadmin.v1.GetUserResponse.organizations:type_name -> obiente.cloud.superadmin.v1.UserOrganization
+	75,  // 50: obiente.cloud.superadmin.v1.DormantResourceOwner.user:type_name -> obiente.cloud.superadmin.v1.UserInfo
+	202, // 51: obiente.cloud.superadmin.v1.DormantResourceOwner.last_activity_at:type_name -> google.protobuf.Timestamp
+	202, // 52: obiente.cloud.superadmin.v1.DormantResourceOwner.last_resource_created_at:type_name -> google.protobuf.Timestamp
+	202, // 53: obiente.cloud.superadmin.v1.DormantResourceOwner.last_resource_updated_at:type_name -> google.protobuf.Timestamp
+	72,  // 54: obiente.cloud.superadmin.v1.DormantResourceOwner.organizations:type_name -> obiente.cloud.superadmin.v1.DormantResourceOrganization
+	73,  // 55: obiente.cloud.superadmin.v1.ListDormantResourceOwnersResponse.owners:type_name -> obiente.cloud.superadmin.v1.DormantResourceOwner
+	206, // 56: obiente.cloud.superadmin.v1.ListDormantResourceOwnersResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	71,  // 57: obiente.cloud.superadmin.v1.ListDormantResourceOwnersResponse.summary:type_name -> obiente.cloud.superadmin.v1.DormantResourceSummary
+	202, // 58: obiente.cloud.superadmin.v1.UserInfo.created_at:type_name -> google.protobuf.Timestamp
+	202, // 59: obiente.cloud.superadmin.v1.UserInfo.updated_at:type_name -> google.protobuf.Timestamp
+	202, // 60: obiente.cloud.superadmin.v1.UserOrganization.joined_at:type_name -> google.protobuf.Timestamp
+	207, // 61: obiente.cloud.superadmin.v1.ListAllVPSRequest.status:type_name -> obiente.cloud.vps.v1.VPSStatus
+	208, // 62: obiente.cloud.superadmin.v1.VPSOverview.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	78,  // 63: obiente.cloud.superadmin.v1.ListAllVPSResponse.vps_instances:type_name -> obiente.cloud.superadmin.v1.VPSOverview
+	206, // 64: obiente.cloud.superadmin.v1.ListAllVPSResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	209, // 65: obiente.cloud.superadmin.v1.ListVPSSizesResponse.sizes:type_name -> obiente.cloud.common.v1.VPSSize
+	209, // 66: obiente.cloud.superadmin.v1.CreateVPSSizeResponse.size:type_name -> obiente.cloud.common.v1.VPSSize
+	209, // 67: obiente.cloud.superadmin.v1.UpdateVPSSizeResponse.size:type_name -> obiente.cloud.common.v1.VPSSize
+	208, // 68: obiente.cloud.superadmin.v1.SuperadminGetVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	75,  // 69: obiente.cloud.superadmin.v1.SuperadminGetVPSResponse.created_by:type_name -> obiente.cloud.superadmin.v1.UserInfo
+	208, // 70: obiente.cloud.superadmin.v1.SuperadminResizeVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	208, // 71: obiente.cloud.superadmin.v1.SuperadminSuspendVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	208, // 72: obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	210, // 73: obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitRequest.cloud_init:type_name -> obiente.cloud.vps.v1.CloudInitConfig
+	208, // 74: obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	208, // 75: obiente.cloud.superadmin.v1.SuperadminForceStopVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	208, // 76: obiente.cloud.superadmin.v1.SuperadminMigrateVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	208, // 77: obiente.cloud.superadmin.v1.ListUnpatchedVPSResponse.vps_instances:type_name -> obiente.cloud.vps.v1.VPSInstance
+	202, // 78: obiente.cloud.superadmin.v1.StripeWebhookEvent.processed_at:type_name -> google.protobuf.Timestamp
+	202, // 79: obiente.cloud.superadmin.v1.StripeWebhookEvent.created_at:type_name -> google.protobuf.Timestamp
+	107, // 80: obiente.cloud.superadmin.v1.ListStripeWebhookEventsResponse.events:type_name -> obiente.cloud.superadmin.v1.StripeWebhookEvent
+	115, // 81: obiente.cloud.superadmin.v1.ListNodesResponse.nodes:type_name -> obiente.cloud.superadmin.v1.NodeInfo
+	115, // 82: obiente.cloud.superadmin.v1.GetNodeResponse.node:type_name -> obiente.cloud.superadmin.v1.NodeInfo
+	200, // 83: obiente.cloud.superadmin.v1.UpdateNodeConfigRequest.custom_labels:type_name -> obiente.cloud.superadmin.v1.UpdateNodeConfigRequest.CustomLabelsEntry
+	115, // 84: obiente.cloud.superadmin.v1.UpdateNodeConfigResponse.node:type_name -> obiente.cloud.superadmin.v1.NodeInfo
+	116, // 85: obiente.cloud.superadmin.v1.NodeInfo.config:type_name -> obiente.cloud.superadmin.v1.NodeConfig
+	202, // 86: obiente.cloud.superadmin.v1.NodeInfo.last_heartbeat:type_name -> google.protobuf.Timestamp
+	202, // 87: obiente.cloud.superadmin.v1.NodeInfo.created_at:type_name -> google.protobuf.Timestamp
+	202, // 88: obiente.cloud.superadmin.v1.NodeInfo.updated_at:type_name -> google.protobuf.Timestamp
+	201, // 89: obiente.cloud.superadmin.v1.NodeConfig.custom_labels:type_name -> obiente.cloud.superadmin.v1.NodeConfig.CustomLabelsEntry
+	118, // 90: obiente.cloud.superadmin.v1.ListSuperadminPermissionsResponse.permissions:type_name -> obiente.cloud.superadmin.v1.SuperadminPermissionDefinition
+	123, // 91: obiente.cloud.superadmin.v1.ListSuperadminRolesResponse.roles:type_name -> obiente.cloud.superadmin.v1.SuperadminRole
+	123, // 92: obiente.cloud.superadmin.v1.CreateSuperadminRoleResponse.role:type_name -> obiente.cloud.superadmin.v1.SuperadminRole
+	123, // 93: obiente.cloud.superadmin.v1.UpdateSuperadminRoleResponse.role:type_name -> obiente.cloud.superadmin.v1.SuperadminRole
+	132, // 94: obiente.cloud.superadmin.v1.ListSuperadminRoleBindingsResponse.bindings:type_name -> obiente.cloud.superadmin.v1.SuperadminRoleBinding
+	132, // 95: obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingResponse.binding:type_name -> obiente.cloud.superadmin.v1.SuperadminRoleBinding
+	202, // 96: obiente.cloud.superadmin.v1.SuspendUserRequest.expires_at:type_name -> google.protobuf.Timestamp
+	148, // 97: obiente.cloud.superadmin.v1.SuspendUserResponse.ban:type_name -> obiente.cloud.superadmin.v1.UserBanInfo
+	148, // 98: obiente.cloud.superadmin.v1.BanUserResponse.ban:type_name -> obiente.cloud.superadmin.v1.UserBanInfo
+	148, // 99: obiente.cloud.superadmin.v1.GetUserBanStatusResponse.ban:type_name -> obiente.cloud.superadmin.v1.UserBanInfo
+	202, // 100: obiente.cloud.superadmin.v1.UserBanInfo.banned_at:type_name -> google.protobuf.Timestamp
+	202, // 101: obiente.cloud.superadmin.v1.UserBanInfo.expires_at:type_name -> google.protobuf.Timestamp
+	202, // 102: obiente.cloud.superadmin.v1.BanEntry.created_at:type_name -> google.protobuf.Timestamp
+	202, // 103: obiente.cloud.superadmin.v1.BanEntry.expires_at:type_name -> google.protobuf.Timestamp
+	202, // 104: obiente.cloud.superadmin.v1.CreateBanEntryRequest.expires_at:type_name -> google.protobuf.Timestamp
+	149, // 105: obiente.cloud.superadmin.v1.CreateBanEntryResponse.entry:type_name -> obiente.cloud.superadmin.v1.BanEntry
+	149, // 106: obiente.cloud.superadmin.v1.ListBanEntriesResponse.entries:type_name -> obiente.cloud.superadmin.v1.BanEntry
+	202, // 107: obiente.cloud.superadmin.v1.OrgIPAllocation.created_at:type_name -> google.protobuf.Timestamp
+	156, // 108: obiente.cloud.superadmin.v1.AllocateStaticIPResponse.allocation:type_name -> obiente.cloud.superadmin.v1.OrgIPAllocation
+	202, // 109: obiente.cloud.superadmin.v1.SuspendOrganizationRequest.expires_at:type_name -> google.protobuf.Timestamp
+	211, // 110: obiente.cloud.superadmin.v1.GameServerOverview.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
+	75,  // 111: obiente.cloud.superadmin.v1.GameServerOverview.created_by_user:type_name -> obiente.cloud.superadmin.v1.UserInfo
+	212, // 112: obiente.cloud.superadmin.v1.ListAllGameServersRequest.status:type_name -> obiente.cloud.gameservers.v1.GameServerStatus
+	167, // 113: obiente.cloud.superadmin.v1.ListAllGameServersResponse.game_servers:type_name -> obiente.cloud.superadmin.v1.GameServerOverview
+	206, // 114: obiente.cloud.superadmin.v1.ListAllGameServersResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	167, // 115: obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse.game_server:type_name -> obiente.cloud.superadmin.v1.GameServerOverview
+	211, // 116: obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
+	211, // 117: obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
+	211, // 118: obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse.game_server:type_name -> obiente.cloud.gameservers.v1.GameServer
+	183, // 119: obiente.cloud.superadmin.v1.GetPlatformUsageSummaryResponse.top_organizations:type_name -> obiente.cloud.superadmin.v1.OrganizationResourceUsage
+	184, // 120: obiente.cloud.superadmin.v1.GetPlatformUsageSummaryResponse.regions:type_name -> obiente.cloud.superadmin.v1.RegionResourceUsage
+	202, // 121: obiente.cloud.superadmin.v1.GetPlatformUsageSummaryResponse.generated_at:type_name -> google.protobuf.Timestamp
+	183, // 122: obiente.cloud.superadmin.v1.GetAllOrganizationsUsageResponse.organizations:type_name -> obiente.cloud.superadmin.v1.OrganizationResourceUsage
+	206, // 123: obiente.cloud.superadmin.v1.GetAllOrganizationsUsageResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	202, // 124: obiente.cloud.superadmin.v1.SetMaintenanceModeResponse.estimated_end:type_name -> google.protobuf.Timestamp
+	202, // 125: obiente.cloud.superadmin.v1.RotateProxmoxTokenResponse.previous_token_retires_at:type_name -> google.protobuf.Timestamp
+	197, // 126: obiente.cloud.superadmin.v1.GetCapacityReportResponse.nodes:type_name -> obiente.cloud.superadmin.v1.CapacityReport
+	202, // 127: obiente.cloud.superadmin.v1.GetCapacityReportResponse.generated_at:type_name -> google.protobuf.Timestamp
+	20,  // 128: obiente.cloud.superadmin.v1.DNSConfig.TraefikIpsByRegionEntry.value:type_name -> obiente.cloud.superadmin.v1.TraefikIPs
+	0,   // 129: obiente.cloud.superadmin.v1.SuperadminService.GetOverview:input_type -> obiente.cloud.superadmin.v1.GetOverviewRequest
+	7,   // 130: obiente.cloud.superadmin.v1.SuperadminService.QueryDNS:input_type -> obiente.cloud.superadmin.v1.QueryDNSRequest
+	9,   // 131: obiente.cloud.superadmin.v1.SuperadminService.ListDNSRecords:input_type -> obiente.cloud.superadmin.v1.ListDNSRecordsRequest
+	18,  // 132: obiente.cloud.superadmin.v1.SuperadminService.GetDNSConfig:input_type -> obiente.cloud.superadmin.v1.GetDNSConfigRequest
+	22,  // 133: obiente.cloud.superadmin.v1.SuperadminService.ListDelegatedDNSRecords:input_type -> obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsRequest
+	25,  // 134: obiente.cloud.superadmin.v1.SuperadminService.HasDelegatedDNS:input_type -> obiente.cloud.superadmin.v1.HasDelegatedDNSRequest
+	12,  // 135: obiente.cloud.superadmin.v1.SuperadminService.ListDNSQueryLogs:input_type -> obiente.cloud.superadmin.v1.ListDNSQueryLogsRequest
+	16,  // 136: obiente.cloud.superadmin.v1.SuperadminService.ConfigureSplitHorizon:input_type -> obiente.cloud.superadmin.v1.ConfigureSplitHorizonRequest
+	29,  // 137: obiente.cloud.superadmin.v1.SuperadminService.CreateDNSDelegationAPIKey:input_type -> obiente.cloud.superadmin.v1.CreateDNSDelegationAPIKeyRequest
+	35,  // 138: obiente.cloud.superadmin.v1.SuperadminService.ListDNSDelegationAPIKeys:input_type -> obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysRequest
+	31,  // 139: obiente.cloud.superadmin.v1.SuperadminService.RevokeDNSDelegationAPIKey:input_type -> obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyRequest
+	33,  // 140: obiente.cloud.superadmin.v1.SuperadminService.RevokeDNSDelegationAPIKeyForOrganization:input_type -> obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyForOrganizationRequest
+	27,  // 141: obiente.cloud.superadmin.v1.SuperadminService.GetPricing:input_type -> obiente.cloud.superadmin.v1.GetPricingRequest
+	38,  // 142: obiente.cloud.superadmin.v1.SuperadminService.GetAbuseDetection:input_type -> obiente.cloud.superadmin.v1.GetAbuseDetectionRequest
+	43,  // 143: obiente.cloud.superadmin.v1.SuperadminService.GetIncomeOverview:input_type -> obiente.cloud.superadmin.v1.GetIncomeOverviewRequest
+	182, // 144: obiente.cloud.superadmin.v1.SuperadminService.GetPlatformUsageSummary:input_type -> obiente.cloud.superadmin.v1.GetPlatformUsageSummaryRequest
+	186, // 145: obiente.cloud.superadmin.v1.SuperadminService.GetAllOrganizationsUsage:input_type -> obiente.cloud.superadmin.v1.GetAllOrganizationsUsageRequest
+	50,  // 146: obiente.cloud.superadmin.v1.SuperadminService.ListAllInvoices:input_type -> obiente.cloud.superadmin.v1.ListAllInvoicesRequest
+	53,  // 147: obiente.cloud.superadmin.v1.SuperadminService.SendInvoiceReminder:input_type -> obiente.cloud.superadmin.v1.SendInvoiceReminderRequest
+	55,  // 148: obiente.cloud.superadmin.v1.SuperadminService.ListPlans:input_type -> obiente.cloud.superadmin.v1.ListPlansRequest
+	57,  // 149: obiente.cloud.superadmin.v1.SuperadminService.CreatePlan:input_type -> obiente.cloud.superadmin.v1.CreatePlanRequest
+	59,  // 150: obiente.cloud.superadmin.v1.SuperadminService.UpdatePlan:input_type -> obiente.cloud.superadmin.v1.UpdatePlanRequest
+	61,  // 151: obiente.cloud.superadmin.v1.SuperadminService.DeletePlan:input_type -> obiente.cloud.superadmin.v1.DeletePlanRequest
+	64,  // 152: obiente.cloud.superadmin.v1.SuperadminService.AssignPlanToOrganization:input_type -> obiente.cloud.superadmin.v1.AssignPlanToOrganizationRequest
+	66,  // 153: obiente.cloud.superadmin.v1.SuperadminService.ListUsers:input_type -> obiente.cloud.superadmin.v1.ListUsersRequest
+	68,  // 154: obiente.cloud.superadmin.v1.SuperadminService.GetUser:input_type -> obiente.cloud.superadmin.v1.GetUserRequest
+	70,  // 155: obiente.cloud.superadmin.v1.SuperadminService.ListDormantResourceOwners:input_type -> obiente.cloud.superadmin.v1.ListDormantResourceOwnersRequest
+	138, // 156: obiente.cloud.superadmin.v1.SuperadminService.SuspendUser:input_type -> obiente.cloud.superadmin.v1.SuspendUserRequest
+	140, // 157: obiente.cloud.superadmin.v1.SuperadminService.UnsuspendUser:input_type -> obiente.cloud.superadmin.v1.UnsuspendUserRequest
+	142, // 158: obiente.cloud.superadmin.v1.SuperadminService.BanUser:input_type -> obiente.cloud.superadmin.v1.BanUserRequest
+	144, // 159: obiente.cloud.superadmin.v1.SuperadminService.UnbanUser:input_type -> obiente.cloud.superadmin.v1.UnbanUserRequest
+	146, // 160: obiente.cloud.superadmin.v1.SuperadminService.GetUserBanStatus:input_type -> obiente.cloud.superadmin.v1.GetUserBanStatusRequest
+	150, // 161: obiente.cloud.superadmin.v1.SuperadminService.CreateBanEntry:input_type -> obiente.cloud.superadmin.v1.CreateBanEntryRequest
+	152, // 162: obiente.cloud.superadmin.v1.SuperadminService.DeleteBanEntry:input_type -> obiente.cloud.superadmin.v1.DeleteBanEntryRequest
+	154, // 163: obiente.cloud.superadmin.v1.SuperadminService.ListBanEntries:input_type -> obiente.cloud.superadmin.v1.ListBanEntriesRequest
+	159, // 164: obiente.cloud.superadmin.v1.SuperadminService.SuspendOrganization:input_type -> obiente.cloud.superadmin.v1.SuspendOrganizationRequest
+	161, // 165: obiente.cloud.superadmin.v1.SuperadminService.UnsuspendOrganization:input_type -> obiente.cloud.superadmin.v1.UnsuspendOrganizationRequest
+	163, // 166: obiente.cloud.superadmin.v1.SuperadminService.BanOrganization:input_type -> obiente.cloud.superadmin.v1.BanOrganizationRequest
+	165, // 167: obiente.cloud.superadmin.v1.SuperadminService.UnbanOrganization:input_type -> obiente.cloud.superadmin.v1.UnbanOrganizationRequest
+	180, // 168: obiente.cloud.superadmin.v1.SuperadminService.LiftSuspension:input_type -> obiente.cloud.superadmin.v1.LiftSuspensionRequest
+	188, // 169: obiente.cloud.superadmin.v1.SuperadminService.SetMaintenanceMode:input_type -> obiente.cloud.superadmin.v1.SetMaintenanceModeRequest
+	77,  // 170: obiente.cloud.superadmin.v1.SuperadminService.ListAllVPS:input_type -> obiente.cloud.superadmin.v1.ListAllVPSRequest
+	88,  // 171: obiente.cloud.superadmin.v1.SuperadminService.SuperadminGetVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminGetVPSRequest
+	90,  // 172: obiente.cloud.superadmin.v1.SuperadminService.SuperadminResizeVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminResizeVPSRequest
+	92,  // 173: obiente.cloud.superadmin.v1.SuperadminService.SuperadminSuspendVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminSuspendVPSRequest
+	94,  // 174: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUnsuspendVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSRequest
+	96,  // 175: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUpdateVPSCloudInit:input_type -> obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitRequest
+	98,  // 176: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceStopVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminForceStopVPSRequest
+	100, // 177: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceDeleteVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSRequest
+	102, // 178: obiente.cloud.superadmin.v1.SuperadminService.SuperadminMigrateVPS:input_type -> obiente.cloud.superadmin.v1.SuperadminMigrateVPSRequest
+	104, // 179: obiente.cloud.superadmin.v1.SuperadminService.ListUnpatchedVPS:input_type -> obiente.cloud.superadmin.v1.ListUnpatchedVPSRequest
+	80,  // 180: obiente.cloud.superadmin.v1.SuperadminService.ListVPSSizes:input_type -> obiente.cloud.superadmin.v1.ListVPSSizesRequest
+	82,  // 181: obiente.cloud.superadmin.v1.SuperadminService.CreateVPSSize:input_type -> obiente.cloud.superadmin.v1.CreateVPSSizeRequest
+	84,  // 182: obiente.cloud.superadmin.v1.SuperadminService.UpdateVPSSize:input_type -> obiente.cloud.superadmin.v1.UpdateVPSSizeRequest
+	86,  // 183: obiente.cloud.superadmin.v1.SuperadminService.DeleteVPSSize:input_type -> obiente.cloud.superadmin.v1.DeleteVPSSizeRequest
+	213, // 184: obiente.cloud.superadmin.v1.SuperadminService.ListVPSPublicIPs:input_type -> obiente.cloud.vps.v1.ListVPSPublicIPsRequest
+	214, // 185: obiente.cloud.superadmin.v1.SuperadminService.CreateVPSPublicIP:input_type -> obiente.cloud.vps.v1.CreateVPSPublicIPRequest
+	215, // 186: obiente.cloud.superadmin.v1.SuperadminService.UpdateVPSPublicIP:input_type -> obiente.cloud.vps.v1.UpdateVPSPublicIPRequest
+	216, // 187: obiente.cloud.superadmin.v1.SuperadminService.DeleteVPSPublicIP:input_type -> obiente.cloud.vps.v1.DeleteVPSPublicIPRequest
+	217, // 188: obiente.cloud.superadmin.v1.SuperadminService.AssignVPSPublicIP:input_type -> obiente.cloud.vps.v1.AssignVPSPublicIPRequest
+	218, // 189: obiente.cloud.superadmin.v1.SuperadminService.UnassignVPSPublicIP:input_type -> obiente.cloud.vps.v1.UnassignVPSPublicIPRequest
+	157, // 190: obiente.cloud.superadmin.v1.SuperadminService.AllocateStaticIP:input_type -> obiente.cloud.superadmin.v1.AllocateStaticIPRequest
+	219, // 191: obiente.cloud.superadmin.v1.SuperadminService.GetOrgLeases:input_type -> obiente.cloud.vpsgateway.v1.GetOrgLeasesRequest
+	106, // 192: obiente.cloud.superadmin.v1.SuperadminService.ListStripeWebhookEvents:input_type -> obiente.cloud.superadmin.v1.ListStripeWebhookEventsRequest
+	109, // 193: obiente.cloud.superadmin.v1.SuperadminService.ListNodes:input_type -> obiente.cloud.superadmin.v1.ListNodesRequest
+	111, // 194: obiente.cloud.superadmin.v1.SuperadminService.GetNode:input_type -> obiente.cloud.superadmin.v1.GetNodeRequest
+	113, // 195: obiente.cloud.superadmin.v1.SuperadminService.UpdateNodeConfig:input_type -> obiente.cloud.superadmin.v1.UpdateNodeConfigRequest
+	190, // 196: obiente.cloud.superadmin.v1.SuperadminService.DrainClusterNode:input_type -> obiente.cloud.superadmin.v1.DrainClusterNodeRequest
+	192, // 197: obiente.cloud.superadmin.v1.SuperadminService.EnableClusterNode:input_type -> obiente.cloud.superadmin.v1.EnableClusterNodeRequest
+	194, // 198: obiente.cloud.superadmin.v1.SuperadminService.RotateProxmoxToken:input_type -> obiente.cloud.superadmin.v1.RotateProxmoxTokenRequest
+	196, // 199: obiente.cloud.superadmin.v1.SuperadminService.GetCapacityReport:input_type -> obiente.cloud.superadmin.v1.GetCapacityReportRequest
+	117, // 200: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminPermissions:input_type -> obiente.cloud.superadmin.v1.ListSuperadminPermissionsRequest
+	120, // 201: obiente.cloud.superadmin.v1.SuperadminService.GetMySuperadminPermissions:input_type -> obiente.cloud.superadmin.v1.GetMySuperadminPermissionsRequest
+	168, // 202: obiente.cloud.superadmin.v1.SuperadminService.ListAllGameServers:input_type -> obiente.cloud.superadmin.v1.ListAllGameServersRequest
+	170, // 203: obiente.cloud.superadmin.v1.SuperadminService.SuperadminGetGameServer:input_type -> obiente.cloud.superadmin.v1.SuperadminGetGameServerRequest
+	172, // 204: obiente.cloud.superadmin.v1.SuperadminService.SuperadminSuspendGameServer:input_type -> obiente.cloud.superadmin.v1.SuperadminSuspendGameServerRequest
+	174, // 205: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUnsuspendGameServer:input_type -> obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerRequest
+	176, // 206: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceStopGameServer:input_type -> obiente.cloud.superadmin.v1.SuperadminForceStopGameServerRequest
+	178, // 207: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceDeleteGameServer:input_type -> obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerRequest
+	122, // 208: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminRoles:input_type -> obiente.cloud.superadmin.v1.ListSuperadminRolesRequest
+	125, // 209: obiente.cloud.superadmin.v1.SuperadminService.CreateSuperadminRole:input_type -> obiente.cloud.superadmin.v1.CreateSuperadminRoleRequest
+	127, // 210: obiente.cloud.superadmin.v1.SuperadminService.UpdateSuperadminRole:input_type -> obiente.cloud.superadmin.v1.UpdateSuperadminRoleRequest
+	129, // 211: obiente.cloud.superadmin.v1.SuperadminService.DeleteSuperadminRole:input_type -> obiente.cloud.superadmin.v1.DeleteSuperadminRoleRequest
+	131, // 212: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminRoleBindings:input_type -> obiente.cloud.superadmin.v1.ListSuperadminRoleBindingsRequest
+	134, // 213: obiente.cloud.superadmin.v1.SuperadminService.CreateSuperadminRoleBinding:input_type -> obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingRequest
+	136, // 214: obiente.cloud.superadmin.v1.SuperadminService.DeleteSuperadminRoleBinding:input_type -> obiente.cloud.superadmin.v1.DeleteSuperadminRoleBindingRequest
+	1,   // 215: obiente.cloud.superadmin.v1.SuperadminService.GetOverview:output_type -> obiente.cloud.superadmin.v1.GetOverviewResponse
+	8,   // 216: obiente.cloud.superadmin.v1.SuperadminService.QueryDNS:output_type -> obiente.cloud.superadmin.v1.QueryDNSResponse
+	11,  // 217: obiente.cloud.superadmin.v1.SuperadminService.ListDNSRecords:output_type -> obiente.cloud.superadmin.v1.ListDNSRecordsResponse
+	21,  // 218: obiente.cloud.superadmin.v1.SuperadminService.GetDNSConfig:output_type -> obiente.cloud.superadmin.v1.GetDNSConfigResponse
+	24,  // 219: obiente.cloud.superadmin.v1.SuperadminService.ListDelegatedDNSRecords:output_type -> obiente.cloud.superadmin.v1.ListDelegatedDNSRecordsResponse
+	26,  // 220: obiente.cloud.superadmin.v1.SuperadminService.HasDelegatedDNS:output_type -> obiente.cloud.superadmin.v1.HasDelegatedDNSResponse
+	14,  // 221: obiente.cloud.superadmin.v1.SuperadminService.ListDNSQueryLogs:output_type -> obiente.cloud.superadmin.v1.ListDNSQueryLogsResponse
+	17,  // 222: obiente.cloud.superadmin.v1.SuperadminService.ConfigureSplitHorizon:output_type -> obiente.cloud.superadmin.v1.ConfigureSplitHorizonResponse
+	30,  // 223: obiente.cloud.superadmin.v1.SuperadminService.CreateDNSDelegationAPIKey:output_type -> obiente.cloud.superadmin.v1.CreateDNSDelegationAPIKeyResponse
+	37,  // 224: obiente.cloud.superadmin.v1.SuperadminService.ListDNSDelegationAPIKeys:output_type -> obiente.cloud.superadmin.v1.ListDNSDelegationAPIKeysResponse
+	32,  // 225: obiente.cloud.superadmin.v1.SuperadminService.RevokeDNSDelegationAPIKey:output_type -> obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyResponse
+	34,  // 226: obiente.cloud.superadmin.v1.SuperadminService.RevokeDNSDelegationAPIKeyForOrganization:output_type -> obiente.cloud.superadmin.v1.RevokeDNSDelegationAPIKeyForOrganizationResponse
+	28,  // 227: obiente.cloud.superadmin.v1.SuperadminService.GetPricing:output_type -> obiente.cloud.superadmin.v1.GetPricingResponse
+	39,  // 228: obiente.cloud.superadmin.v1.SuperadminService.GetAbuseDetection:output_type -> obiente.cloud.superadmin.v1.GetAbuseDetectionResponse
+	44,  // 229: obiente.cloud.superadmin.v1.SuperadminService.GetIncomeOverview:output_type -> obiente.cloud.superadmin.v1.GetIncomeOverviewResponse
+	185, // 230: obiente.cloud.superadmin.v1.SuperadminService.GetPlatformUsageSummary:output_type -> obiente.cloud.superadmin.v1.GetPlatformUsageSummaryResponse
+	187, // 231: obiente.cloud.superadmin.v1.SuperadminService.GetAllOrganizationsUsage:output_type -> obiente.cloud.superadmin.v1.GetAllOrganizationsUsageResponse
+	51,  // 232: obiente.cloud.superadmin.v1.SuperadminService.ListAllInvoices:output_type -> obiente.cloud.superadmin.v1.ListAllInvoicesResponse
+	54,  // 233: obiente.cloud.superadmin.v1.SuperadminService.SendInvoiceReminder:output_type -> obiente.cloud.superadmin.v1.SendInvoiceReminderResponse
+	56,  // 234: obiente.cloud.superadmin.v1.SuperadminService.ListPlans:output_type -> obiente.cloud.superadmin.v1.ListPlansResponse
+	58,  // 235: obiente.cloud.superadmin.v1.SuperadminService.CreatePlan:output_type -> obiente.cloud.superadmin.v1.CreatePlanResponse
+	60,  // 236: obiente.cloud.superadmin.v1.SuperadminService.UpdatePlan:output_type -> obiente.cloud.superadmin.v1.UpdatePlanResponse
+	62,  // 237: obiente.cloud.superadmin.v1.SuperadminService.DeletePlan:output_type -> obiente.cloud.superadmin.v1.DeletePlanResponse
+	65,  // 238: obiente.cloud.superadmin.v1.SuperadminService.AssignPlanToOrganization:output_type -> obiente.cloud.superadmin.v1.AssignPlanToOrganizationResponse
+	67,  // 239: obiente.cloud.superadmin.v1.SuperadminService.ListUsers:output_type -> obiente.cloud.superadmin.v1.ListUsersResponse
+	69,  // 240: obiente.cloud.superadmin.v1.SuperadminService.GetUser:output_type -> obiente.cloud.superadmin.v1.GetUserResponse
+	74,  // 241: obiente.cloud.superadmin.v1.SuperadminService.ListDormantResourceOwners:output_type -> obiente.cloud.superadmin.v1.ListDormantResourceOwnersResponse
+	139, // 242: obiente.cloud.superadmin.v1.SuperadminService.SuspendUser:output_type -> obiente.cloud.superadmin.v1.SuspendUserResponse
+	141, // 243: obiente.cloud.superadmin.v1.SuperadminService.UnsuspendUser:output_type -> obiente.cloud.superadmin.v1.UnsuspendUserResponse
+	143, // 244: obiente.cloud.superadmin.v1.SuperadminService.BanUser:output_type -> obiente.cloud.superadmin.v1.BanUserResponse
+	145, // 245: obiente.cloud.superadmin.v1.SuperadminService.UnbanUser:output_type -> obiente.cloud.superadmin.v1.UnbanUserResponse
+	147, // 246: obiente.cloud.superadmin.v1.SuperadminService.GetUserBanStatus:output_type -> obiente.cloud.superadmin.v1.GetUserBanStatusResponse
+	151, // 247: obiente.cloud.superadmin.v1.SuperadminService.CreateBanEntry:output_type -> obiente.cloud.superadmin.v1.CreateBanEntryResponse
+	153, // 248: obiente.cloud.superadmin.v1.SuperadminService.DeleteBanEntry:output_type -> obiente.cloud.superadmin.v1.DeleteBanEntryResponse
+	155, // 249: obiente.cloud.superadmin.v1.SuperadminService.ListBanEntries:output_type -> obiente.cloud.superadmin.v1.ListBanEntriesResponse
+	160, // 250: obiente.cloud.superadmin.v1.SuperadminService.SuspendOrganization:output_type -> obiente.cloud.superadmin.v1.SuspendOrganizationResponse
+	162, // 251: obiente.cloud.superadmin.v1.SuperadminService.UnsuspendOrganization:output_type -> obiente.cloud.superadmin.v1.UnsuspendOrganizationResponse
+	164, // 252: obiente.cloud.superadmin.v1.SuperadminService.BanOrganization:output_type -> obiente.cloud.superadmin.v1.BanOrganizationResponse
+	166, // 253: obiente.cloud.superadmin.v1.SuperadminService.UnbanOrganization:output_type -> obiente.cloud.superadmin.v1.UnbanOrganizationResponse
+	181, // 254: obiente.cloud.superadmin.v1.SuperadminService.LiftSuspension:output_type -> obiente.cloud.superadmin.v1.LiftSuspensionResponse
+	189, // 255: obiente.cloud.superadmin.v1.SuperadminService.SetMaintenanceMode:output_type -> obiente.cloud.superadmin.v1.SetMaintenanceModeResponse
+	79,  // 256: obiente.cloud.superadmin.v1.SuperadminService.ListAllVPS:output_type -> obiente.cloud.superadmin.v1.ListAllVPSResponse
+	89,  // 257: obiente.cloud.superadmin.v1.SuperadminService.SuperadminGetVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminGetVPSResponse
+	91,  // 258: obiente.cloud.superadmin.v1.SuperadminService.SuperadminResizeVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminResizeVPSResponse
+	93,  // 259: obiente.cloud.superadmin.v1.SuperadminService.SuperadminSuspendVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminSuspendVPSResponse
+	95,  // 260: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUnsuspendVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminUnsuspendVPSResponse
+	97,  // 261: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUpdateVPSCloudInit:output_type -> obiente.cloud.superadmin.v1.SuperadminUpdateVPSCloudInitResponse
+	99,  // 262: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceStopVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminForceStopVPSResponse
+	101, // 263: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceDeleteVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminForceDeleteVPSResponse
+	103, // 264: obiente.cloud.superadmin.v1.SuperadminService.SuperadminMigrateVPS:output_type -> obiente.cloud.superadmin.v1.SuperadminMigrateVPSResponse
+	105, // 265: obiente.cloud.superadmin.v1.SuperadminService.ListUnpatchedVPS:output_type -> obiente.cloud.superadmin.v1.ListUnpatchedVPSResponse
+	81,  // 266: obiente.cloud.superadmin.v1.SuperadminService.ListVPSSizes:output_type -> obiente.cloud.superadmin.v1.ListVPSSizesResponse
+	83,  // 267: obiente.cloud.superadmin.v1.SuperadminService.CreateVPSSize:output_type -> obiente.cloud.superadmin.v1.CreateVPSSizeResponse
+	85,  // 268: obiente.cloud.superadmin.v1.SuperadminService.UpdateVPSSize:output_type -> obiente.cloud.superadmin.v1.UpdateVPSSizeResponse
+	87,  // 269: obiente.cloud.superadmin.v1.SuperadminService.DeleteVPSSize:output_type -> obiente.cloud.superadmin.v1.DeleteVPSSizeResponse
+	220, // 270: obiente.cloud.superadmin.v1.SuperadminService.ListVPSPublicIPs:output_type -> obiente.cloud.vps.v1.ListVPSPublicIPsResponse
+	221, // 271: obiente.cloud.superadmin.v1.SuperadminService.CreateVPSPublicIP:output_type -> obiente.cloud.vps.v1.CreateVPSPublicIPResponse
+	222, // 272: obiente.cloud.superadmin.v1.SuperadminService.UpdateVPSPublicIP:output_type -> obiente.cloud.vps.v1.UpdateVPSPublicIPResponse
+	223, // 273: obiente.cloud.superadmin.v1.SuperadminService.DeleteVPSPublicIP:output_type -> obiente.cloud.vps.v1.DeleteVPSPublicIPResponse
+	224, // 274: obiente.cloud.superadmin.v1.SuperadminService.AssignVPSPublicIP:output_type -> obiente.cloud.vps.v1.AssignVPSPublicIPResponse
+	225, // 275: obiente.cloud.superadmin.v1.SuperadminService.UnassignVPSPublicIP:output_type -> obiente.cloud.vps.v1.UnassignVPSPublicIPResponse
+	158, // 276: obiente.cloud.superadmin.v1.SuperadminService.AllocateStaticIP:output_type -> obiente.cloud.superadmin.v1.AllocateStaticIPResponse
+	226, // 277: obiente.cloud.superadmin.v1.SuperadminService.GetOrgLeases:output_type -> obiente.cloud.vpsgateway.v1.GetOrgLeasesResponse
+	108, // 278: obiente.cloud.superadmin.v1.SuperadminService.ListStripeWebhookEvents:output_type -> obiente.cloud.superadmin.v1.ListStripeWebhookEventsResponse
+	110, // 279: obiente.cloud.superadmin.v1.SuperadminService.ListNodes:output_type -> obiente.cloud.superadmin.v1.ListNodesResponse
+	112, // 280: obiente.cloud.superadmin.v1.SuperadminService.GetNode:output_type -> obiente.cloud.superadmin.v1.GetNodeResponse
+	114, // 281: obiente.cloud.superadmin.v1.SuperadminService.UpdateNodeConfig:output_type -> obiente.cloud.superadmin.v1.UpdateNodeConfigResponse
+	191, // 282: obiente.cloud.superadmin.v1.SuperadminService.DrainClusterNode:output_type -> obiente.cloud.superadmin.v1.DrainClusterNodeResponse
+	193, // 283: obiente.cloud.superadmin.v1.SuperadminService.EnableClusterNode:output_type -> obiente.cloud.superadmin.v1.EnableClusterNodeResponse
+	195, // 284: obiente.cloud.superadmin.v1.SuperadminService.RotateProxmoxToken:output_type -> obiente.cloud.superadmin.v1.RotateProxmoxTokenResponse
+	198, // 285: obiente.cloud.superadmin.v1.SuperadminService.GetCapacityReport:output_type -> obiente.cloud.superadmin.v1.GetCapacityReportResponse
+	119, // 286: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminPermissions:output_type -> obiente.cloud.superadmin.v1.ListSuperadminPermissionsResponse
+	121, // 287: obiente.cloud.superadmin.v1.SuperadminService.GetMySuperadminPermissions:output_type -> obiente.cloud.superadmin.v1.GetMySuperadminPermissionsResponse
+	169, // 288: obiente.cloud.superadmin.v1.SuperadminService.ListAllGameServers:output_type -> obiente.cloud.superadmin.v1.ListAllGameServersResponse
+	171, // 289: obiente.cloud.superadmin.v1.SuperadminService.SuperadminGetGameServer:output_type -> obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse
+	173, // 290: obiente.cloud.superadmin.v1.SuperadminService.SuperadminSuspendGameServer:output_type -> obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse
+	175, // 291: obiente.cloud.superadmin.v1.SuperadminService.SuperadminUnsuspendGameServer:output_type -> obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse
+	177, // 292: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceStopGameServer:output_type -> obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse
+	179, // 293: obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceDeleteGameServer:output_type -> obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerResponse
+	124, // 294: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminRoles:output_type -> obiente.cloud.superadmin.v1.ListSuperadminRolesResponse
+	126, // 295: obiente.cloud.superadmin.v1.SuperadminService.CreateSuperadminRole:output_type -> obiente.cloud.superadmin.v1.CreateSuperadminRoleResponse
+	128, // 296: obiente.cloud.superadmin.v1.SuperadminService.UpdateSuperadminRole:output_type -> obiente.cloud.superadmin.v1.UpdateSuperadminRoleResponse
+	130, // 297: obiente.cloud.superadmin.v1.SuperadminService.DeleteSuperadminRole:output_type -> obiente.cloud.superadmin.v1.DeleteSuperadminRoleResponse
+	133, // 298: obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminRoleBindings:output_type -> obiente.cloud.superadmin.v1.ListSuperadminRoleBindingsResponse
+	135, // 299: obiente.cloud.superadmin.v1.SuperadminService.CreateSuperadminRoleBinding:output_type -> obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingResponse
+	137, // 300: obiente.cloud.superadmin.v1.SuperadminService.DeleteSuperadminRoleBinding:output_type -> obiente.cloud.superadmin.v1.DeleteSuperadminRoleBindingResponse
+	215, // [215:301] is the sub-list for method output_type
+	129, // [129:215] is the sub-list for method input_type
+	129, // [129:129] is the sub-list for extension type_name
+	129, // [129:129] is the sub-list for extension extendee
+	0,   // [0:129] is the sub-list for field type_name
 }
 
 func init() { file_obiente_cloud_superadmin_v1_superadmin_service_proto_init() }
@@ -11622,47 +14325,54 @@ func file_obiente_cloud_superadmin_v1_superadmin_service_proto_init() {
 	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[3].OneofWrappers = []any{}
 	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[5].OneofWrappers = []any{}
 	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[9].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[16].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[12].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[22].OneofWrappers = []any{}
 	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[29].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[37].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[42].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[44].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[53].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[60].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[64].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[69].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[71].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[72].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[74].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[35].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[50].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[59].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[66].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[70].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[75].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[77].OneofWrappers = []any{}
 	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[78].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[83].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[80].OneofWrappers = []any{}
 	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[84].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[86].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[96].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[97].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[99].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[103].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[105].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[89].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[90].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[92].OneofWrappers = []any{}
 	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[106].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[128].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[132].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[137].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[107].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[109].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[113].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[115].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[116].OneofWrappers = []any{}
 	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[138].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[139].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[143].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[142].OneofWrappers = []any{}
 	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[147].OneofWrappers = []any{}
 	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[148].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[152].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[156].OneofWrappers = []any{}
-	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[158].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[149].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[150].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[154].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[159].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[163].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[167].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[168].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[172].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[176].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[178].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[186].OneofWrappers = []any{}
+	file_obiente_cloud_superadmin_v1_superadmin_service_proto_msgTypes[197].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc), len(file_obiente_cloud_superadmin_v1_superadmin_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   163,
+			NumMessages:   202,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -53,6 +53,12 @@ const (
 	// SuperadminServiceHasDelegatedDNSProcedure is the fully-qualified name of the SuperadminService's
 	// HasDelegatedDNS RPC.
 	SuperadminServiceHasDelegatedDNSProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/HasDelegatedDNS"
+	// SuperadminServiceListDNSQueryLogsProcedure is the fully-qualified name of the SuperadminService's
+	// ListDNSQueryLogs RPC.
+	SuperadminServiceListDNSQueryLogsProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/ListDNSQueryLogs"
+	// SuperadminServiceConfigureSplitHorizonProcedure is the fully-qualified name of the
+	// SuperadminService's ConfigureSplitHorizon RPC.
+	SuperadminServiceConfigureSplitHorizonProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/ConfigureSplitHorizon"
 	// SuperadminServiceCreateDNSDelegationAPIKeyProcedure is the fully-qualified name of the
 	// SuperadminService's CreateDNSDelegationAPIKey RPC.
 	SuperadminServiceCreateDNSDelegationAPIKeyProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/CreateDNSDelegationAPIKey"
@@ -74,6 +80,12 @@ const (
 	// SuperadminServiceGetIncomeOverviewProcedure is the fully-qualified name of the
 	// SuperadminService's GetIncomeOverview RPC.
 	SuperadminServiceGetIncomeOverviewProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/GetIncomeOverview"
+	// SuperadminServiceGetPlatformUsageSummaryProcedure is the fully-qualified name of the
+	// SuperadminService's GetPlatformUsageSummary RPC.
+	SuperadminServiceGetPlatformUsageSummaryProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/GetPlatformUsageSummary"
+	// SuperadminServiceGetAllOrganizationsUsageProcedure is the fully-qualified name of the
+	// SuperadminService's GetAllOrganizationsUsage RPC.
+	SuperadminServiceGetAllOrganizationsUsageProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/GetAllOrganizationsUsage"
 	// SuperadminServiceListAllInvoicesProcedure is the fully-qualified name of the SuperadminService's
 	// ListAllInvoices RPC.
 	SuperadminServiceListAllInvoicesProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/ListAllInvoices"
@@ -119,6 +131,15 @@ const (
 	// SuperadminServiceGetUserBanStatusProcedure is the fully-qualified name of the SuperadminService's
 	// GetUserBanStatus RPC.
 	SuperadminServiceGetUserBanStatusProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/GetUserBanStatus"
+	// SuperadminServiceCreateBanEntryProcedure is the fully-qualified name of the SuperadminService's
+	// CreateBanEntry RPC.
+	SuperadminServiceCreateBanEntryProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/CreateBanEntry"
+	// SuperadminServiceDeleteBanEntryProcedure is the fully-qualified name of the SuperadminService's
+	// DeleteBanEntry RPC.
+	SuperadminServiceDeleteBanEntryProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/DeleteBanEntry"
+	// SuperadminServiceListBanEntriesProcedure is the fully-qualified name of the SuperadminService's
+	// ListBanEntries RPC.
+	SuperadminServiceListBanEntriesProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/ListBanEntries"
 	// SuperadminServiceSuspendOrganizationProcedure is the fully-qualified name of the
 	// SuperadminService's SuspendOrganization RPC.
 	SuperadminServiceSuspendOrganizationProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/SuspendOrganization"
@@ -131,6 +152,12 @@ const (
 	// SuperadminServiceUnbanOrganizationProcedure is the fully-qualified name of the
 	// SuperadminService's UnbanOrganization RPC.
 	SuperadminServiceUnbanOrganizationProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/UnbanOrganization"
+	// SuperadminServiceLiftSuspensionProcedure is the fully-qualified name of the SuperadminService's
+	// LiftSuspension RPC.
+	SuperadminServiceLiftSuspensionProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/LiftSuspension"
+	// SuperadminServiceSetMaintenanceModeProcedure is the fully-qualified name of the
+	// SuperadminService's SetMaintenanceMode RPC.
+	SuperadminServiceSetMaintenanceModeProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/SetMaintenanceMode"
 	// SuperadminServiceListAllVPSProcedure is the fully-qualified name of the SuperadminService's
 	// ListAllVPS RPC.
 	SuperadminServiceListAllVPSProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/ListAllVPS"
@@ -155,6 +182,12 @@ const (
 	// SuperadminServiceSuperadminForceDeleteVPSProcedure is the fully-qualified name of the
 	// SuperadminService's SuperadminForceDeleteVPS RPC.
 	SuperadminServiceSuperadminForceDeleteVPSProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/SuperadminForceDeleteVPS"
+	// SuperadminServiceSuperadminMigrateVPSProcedure is the fully-qualified name of the
+	// SuperadminService's SuperadminMigrateVPS RPC.
+	SuperadminServiceSuperadminMigrateVPSProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/SuperadminMigrateVPS"
+	// SuperadminServiceListUnpatchedVPSProcedure is the fully-qualified name of the SuperadminService's
+	// ListUnpatchedVPS RPC.
+	SuperadminServiceListUnpatchedVPSProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/ListUnpatchedVPS"
 	// SuperadminServiceListVPSSizesProcedure is the fully-qualified name of the SuperadminService's
 	// ListVPSSizes RPC.
 	SuperadminServiceListVPSSizesProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/ListVPSSizes"
@@ -185,6 +218,9 @@ const (
 	// SuperadminServiceUnassignVPSPublicIPProcedure is the fully-qualified name of the
 	// SuperadminService's UnassignVPSPublicIP RPC.
 	SuperadminServiceUnassignVPSPublicIPProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/UnassignVPSPublicIP"
+	// SuperadminServiceAllocateStaticIPProcedure is the fully-qualified name of the SuperadminService's
+	// AllocateStaticIP RPC.
+	SuperadminServiceAllocateStaticIPProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/AllocateStaticIP"
 	// SuperadminServiceGetOrgLeasesProcedure is the fully-qualified name of the SuperadminService's
 	// GetOrgLeases RPC.
 	SuperadminServiceGetOrgLeasesProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/GetOrgLeases"
@@ -200,6 +236,18 @@ const (
 	// SuperadminServiceUpdateNodeConfigProcedure is the fully-qualified name of the SuperadminService's
 	// UpdateNodeConfig RPC.
 	SuperadminServiceUpdateNodeConfigProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/UpdateNodeConfig"
+	// SuperadminServiceDrainClusterNodeProcedure is the fully-qualified name of the SuperadminService's
+	// DrainClusterNode RPC.
+	SuperadminServiceDrainClusterNodeProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/DrainClusterNode"
+	// SuperadminServiceEnableClusterNodeProcedure is the fully-qualified name of the
+	// SuperadminService's EnableClusterNode RPC.
+	SuperadminServiceEnableClusterNodeProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/EnableClusterNode"
+	// SuperadminServiceRotateProxmoxTokenProcedure is the fully-qualified name of the
+	// SuperadminService's RotateProxmoxToken RPC.
+	SuperadminServiceRotateProxmoxTokenProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/RotateProxmoxToken"
+	// SuperadminServiceGetCapacityReportProcedure is the fully-qualified name of the
+	// SuperadminService's GetCapacityReport RPC.
+	SuperadminServiceGetCapacityReportProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/GetCapacityReport"
 	// SuperadminServiceListSuperadminPermissionsProcedure is the fully-qualified name of the
 	// SuperadminService's ListSuperadminPermissions RPC.
 	SuperadminServiceListSuperadminPermissionsProcedure = "/obiente.cloud.superadmin.v1.SuperadminService/ListSuperadminPermissions"
@@ -258,6 +306,8 @@ type SuperadminServiceClient interface {
 	GetDNSConfig(context.Context, *connect.Request[v1.GetDNSConfigRequest]) (*connect.Response[v1.GetDNSConfigResponse], error)
 	ListDelegatedDNSRecords(context.Context, *connect.Request[v1.ListDelegatedDNSRecordsRequest]) (*connect.Response[v1.ListDelegatedDNSRecordsResponse], error)
 	HasDelegatedDNS(context.Context, *connect.Request[v1.HasDelegatedDNSRequest]) (*connect.Response[v1.HasDelegatedDNSResponse], error)
+	ListDNSQueryLogs(context.Context, *connect.Request[v1.ListDNSQueryLogsRequest]) (*connect.Response[v1.ListDNSQueryLogsResponse], error)
+	ConfigureSplitHorizon(context.Context, *connect.Request[v1.ConfigureSplitHorizonRequest]) (*connect.Response[v1.ConfigureSplitHorizonResponse], error)
 	// DNS Delegation API Key management
 	CreateDNSDelegationAPIKey(context.Context, *connect.Request[v1.CreateDNSDelegationAPIKeyRequest]) (*connect.Response[v1.CreateDNSDelegationAPIKeyResponse], error)
 	ListDNSDelegationAPIKeys(context.Context, *connect.Request[v1.ListDNSDelegationAPIKeysRequest]) (*connect.Response[v1.ListDNSDelegationAPIKeysResponse], error)
@@ -269,6 +319,9 @@ type SuperadminServiceClient interface {
 	GetAbuseDetection(context.Context, *connect.Request[v1.GetAbuseDetectionRequest]) (*connect.Response[v1.GetAbuseDetectionResponse], error)
 	// Income and billing overview endpoints
 	GetIncomeOverview(context.Context, *connect.Request[v1.GetIncomeOverviewRequest]) (*connect.Response[v1.GetIncomeOverviewResponse], error)
+	// Platform resource usage endpoints
+	GetPlatformUsageSummary(context.Context, *connect.Request[v1.GetPlatformUsageSummaryRequest]) (*connect.Response[v1.GetPlatformUsageSummaryResponse], error)
+	GetAllOrganizationsUsage(context.Context, *connect.Request[v1.GetAllOrganizationsUsageRequest]) (*connect.Response[v1.GetAllOrganizationsUsageResponse], error)
 	// Invoice management endpoints
 	ListAllInvoices(context.Context, *connect.Request[v1.ListAllInvoicesRequest]) (*connect.Response[v1.ListAllInvoicesResponse], error)
 	SendInvoiceReminder(context.Context, *connect.Request[v1.SendInvoiceReminderRequest]) (*connect.Response[v1.SendInvoiceReminderResponse], error)
@@ -287,11 +340,20 @@ type SuperadminServiceClient interface {
 	BanUser(context.Context, *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error)
 	UnbanUser(context.Context, *connect.Request[v1.UnbanUserRequest]) (*connect.Response[v1.UnbanUserResponse], error)
 	GetUserBanStatus(context.Context, *connect.Request[v1.GetUserBanStatusRequest]) (*connect.Response[v1.GetUserBanStatusResponse], error)
+	// Platform ban list: logins from banned IP ranges or with emails of banned domains are rejected
+	CreateBanEntry(context.Context, *connect.Request[v1.CreateBanEntryRequest]) (*connect.Response[v1.CreateBanEntryResponse], error)
+	DeleteBanEntry(context.Context, *connect.Request[v1.DeleteBanEntryRequest]) (*connect.Response[v1.DeleteBanEntryResponse], error)
+	ListBanEntries(context.Context, *connect.Request[v1.ListBanEntriesRequest]) (*connect.Response[v1.ListBanEntriesResponse], error)
 	// Organization moderation endpoints
 	SuspendOrganization(context.Context, *connect.Request[v1.SuspendOrganizationRequest]) (*connect.Response[v1.SuspendOrganizationResponse], error)
 	UnsuspendOrganization(context.Context, *connect.Request[v1.UnsuspendOrganizationRequest]) (*connect.Response[v1.UnsuspendOrganizationResponse], error)
 	BanOrganization(context.Context, *connect.Request[v1.BanOrganizationRequest]) (*connect.Response[v1.BanOrganizationResponse], error)
 	UnbanOrganization(context.Context, *connect.Request[v1.UnbanOrganizationRequest]) (*connect.Response[v1.UnbanOrganizationResponse], error)
+	// Lifts an organization suspension and records when it ended
+	LiftSuspension(context.Context, *connect.Request[v1.LiftSuspensionRequest]) (*connect.Response[v1.LiftSuspensionResponse], error)
+	// Platform maintenance endpoints
+	// Puts the platform in read-only mode: mutating RPCs are rejected until maintenance is disabled
+	SetMaintenanceMode(context.Context, *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error)
 	// VPS management endpoints
 	ListAllVPS(context.Context, *connect.Request[v1.ListAllVPSRequest]) (*connect.Response[v1.ListAllVPSResponse], error)
 	SuperadminGetVPS(context.Context, *connect.Request[v1.SuperadminGetVPSRequest]) (*connect.Response[v1.SuperadminGetVPSResponse], error)
@@ -301,6 +363,9 @@ type SuperadminServiceClient interface {
 	SuperadminUpdateVPSCloudInit(context.Context, *connect.Request[v1.SuperadminUpdateVPSCloudInitRequest]) (*connect.Response[v1.SuperadminUpdateVPSCloudInitResponse], error)
 	SuperadminForceStopVPS(context.Context, *connect.Request[v1.SuperadminForceStopVPSRequest]) (*connect.Response[v1.SuperadminForceStopVPSResponse], error)
 	SuperadminForceDeleteVPS(context.Context, *connect.Request[v1.SuperadminForceDeleteVPSRequest]) (*connect.Response[v1.SuperadminForceDeleteVPSResponse], error)
+	SuperadminMigrateVPS(context.Context, *connect.Request[v1.SuperadminMigrateVPSRequest]) (*connect.Response[v1.SuperadminMigrateVPSResponse], error)
+	// Lists VPS instances whose OS was last patched (or, if never patched, created) more than the given number of days ago
+	ListUnpatchedVPS(context.Context, *connect.Request[v1.ListUnpatchedVPSRequest]) (*connect.Response[v1.ListUnpatchedVPSResponse], error)
 	// VPS size catalog management endpoints
 	ListVPSSizes(context.Context, *connect.Request[v1.ListVPSSizesRequest]) (*connect.Response[v1.ListVPSSizesResponse], error)
 	CreateVPSSize(context.Context, *connect.Request[v1.CreateVPSSizeRequest]) (*connect.Response[v1.CreateVPSSizeResponse], error)
@@ -314,6 +379,8 @@ type SuperadminServiceClient interface {
 	DeleteVPSPublicIP(context.Context, *connect.Request[v11.DeleteVPSPublicIPRequest]) (*connect.Response[v11.DeleteVPSPublicIPResponse], error)
 	AssignVPSPublicIP(context.Context, *connect.Request[v11.AssignVPSPublicIPRequest]) (*connect.Response[v11.AssignVPSPublicIPResponse], error)
 	UnassignVPSPublicIP(context.Context, *connect.Request[v11.UnassignVPSPublicIPRequest]) (*connect.Response[v11.UnassignVPSPublicIPResponse], error)
+	// Assign a subnet to an organization for static VPS IPs
+	AllocateStaticIP(context.Context, *connect.Request[v1.AllocateStaticIPRequest]) (*connect.Response[v1.AllocateStaticIPResponse], error)
 	// DHCP lease management endpoints
 	// Get all DHCP leases for an organization (from VPSGatewayService)
 	GetOrgLeases(context.Context, *connect.Request[v12.GetOrgLeasesRequest]) (*connect.Response[v12.GetOrgLeasesResponse], error)
@@ -323,6 +390,17 @@ type SuperadminServiceClient interface {
 	ListNodes(context.Context, *connect.Request[v1.ListNodesRequest]) (*connect.Response[v1.ListNodesResponse], error)
 	GetNode(context.Context, *connect.Request[v1.GetNodeRequest]) (*connect.Response[v1.GetNodeResponse], error)
 	UpdateNodeConfig(context.Context, *connect.Request[v1.UpdateNodeConfigRequest]) (*connect.Response[v1.UpdateNodeConfigResponse], error)
+	// Drain a Swarm node for maintenance: containers move to other nodes and the
+	// caller is notified when the drain completes or times out
+	DrainClusterNode(context.Context, *connect.Request[v1.DrainClusterNodeRequest]) (*connect.Response[v1.DrainClusterNodeResponse], error)
+	// Re-enable a drained node so it accepts deployments again
+	EnableClusterNode(context.Context, *connect.Request[v1.EnableClusterNodeRequest]) (*connect.Response[v1.EnableClusterNodeResponse], error)
+	// Rotate the Proxmox API token used for VPS management. The new token is validated first and the
+	// previous token keeps working for a short grace period.
+	RotateProxmoxToken(context.Context, *connect.Request[v1.RotateProxmoxTokenRequest]) (*connect.Response[v1.RotateProxmoxTokenResponse], error)
+	// Get current CPU, memory and disk usage of every Docker and Proxmox node, with the growth over
+	// the last 7 days and the estimated days until each resource is full
+	GetCapacityReport(context.Context, *connect.Request[v1.GetCapacityReportRequest]) (*connect.Response[v1.GetCapacityReportResponse], error)
 	// Superadmin permissions catalog (only superadmin-only permissions)
 	ListSuperadminPermissions(context.Context, *connect.Request[v1.ListSuperadminPermissionsRequest]) (*connect.Response[v1.ListSuperadminPermissionsResponse], error)
 	// Get current user's superadmin permissions (from their role bindings)
@@ -393,6 +471,18 @@ func NewSuperadminServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(superadminServiceMethods.ByName("HasDelegatedDNS")),
 			connect.WithClientOptions(opts...),
 		),
+		listDNSQueryLogs: connect.NewClient[v1.ListDNSQueryLogsRequest, v1.ListDNSQueryLogsResponse](
+			httpClient,
+			baseURL+SuperadminServiceListDNSQueryLogsProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("ListDNSQueryLogs")),
+			connect.WithClientOptions(opts...),
+		),
+		configureSplitHorizon: connect.NewClient[v1.ConfigureSplitHorizonRequest, v1.ConfigureSplitHorizonResponse](
+			httpClient,
+			baseURL+SuperadminServiceConfigureSplitHorizonProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("ConfigureSplitHorizon")),
+			connect.WithClientOptions(opts...),
+		),
 		createDNSDelegationAPIKey: connect.NewClient[v1.CreateDNSDelegationAPIKeyRequest, v1.CreateDNSDelegationAPIKeyResponse](
 			httpClient,
 			baseURL+SuperadminServiceCreateDNSDelegationAPIKeyProcedure,
@@ -435,6 +525,18 @@ func NewSuperadminServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(superadminServiceMethods.ByName("GetIncomeOverview")),
 			connect.WithClientOptions(opts...),
 		),
+		getPlatformUsageSummary: connect.NewClient[v1.GetPlatformUsageSummaryRequest, v1.GetPlatformUsageSummaryResponse](
+			httpClient,
+			baseURL+SuperadminServiceGetPlatformUsageSummaryProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("GetPlatformUsageSummary")),
+			connect.WithClientOptions(opts...),
+		),
+		getAllOrganizationsUsage: connect.NewClient[v1.GetAllOrganizationsUsageRequest, v1.GetAllOrganizationsUsageResponse](
+			httpClient,
+			baseURL+SuperadminServiceGetAllOrganizationsUsageProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("GetAllOrganizationsUsage")),
+			connect.WithClientOptions(opts...),
+		),
 		listAllInvoices: connect.NewClient[v1.ListAllInvoicesRequest, v1.ListAllInvoicesResponse](
 			httpClient,
 			baseURL+SuperadminServiceListAllInvoicesProcedure,
@@ -525,6 +627,24 @@ func NewSuperadminServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(superadminServiceMethods.ByName("GetUserBanStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		createBanEntry: connect.NewClient[v1.CreateBanEntryRequest, v1.CreateBanEntryResponse](
+			httpClient,
+			baseURL+SuperadminServiceCreateBanEntryProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("CreateBanEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteBanEntry: connect.NewClient[v1.DeleteBanEntryRequest, v1.DeleteBanEntryResponse](
+			httpClient,
+			baseURL+SuperadminServiceDeleteBanEntryProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("DeleteBanEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		listBanEntries: connect.NewClient[v1.ListBanEntriesRequest, v1.ListBanEntriesResponse](
+			httpClient,
+			baseURL+SuperadminServiceListBanEntriesProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("ListBanEntries")),
+			connect.WithClientOptions(opts...),
+		),
 		suspendOrganization: connect.NewClient[v1.SuspendOrganizationRequest, v1.SuspendOrganizationResponse](
 			httpClient,
 			baseURL+SuperadminServiceSuspendOrganizationProcedure,
@@ -549,6 +669,18 @@ func NewSuperadminServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(superadminServiceMethods.ByName("UnbanOrganization")),
 			connect.WithClientOptions(opts...),
 		),
+		liftSuspension: connect.NewClient[v1.LiftSuspensionRequest, v1.LiftSuspensionResponse](
+			httpClient,
+			baseURL+SuperadminServiceLiftSuspensionProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("LiftSuspension")),
+			connect.WithClientOptions(opts...),
+		),
+		setMaintenanceMode: connect.NewClient[v1.SetMaintenanceModeRequest, v1.SetMaintenanceModeResponse](
+			httpClient,
+			baseURL+SuperadminServiceSetMaintenanceModeProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("SetMaintenanceMode")),
+			connect.WithClientOptions(opts...),
+		),
 		listAllVPS: connect.NewClient[v1.ListAllVPSRequest, v1.ListAllVPSResponse](
 			httpClient,
 			baseURL+SuperadminServiceListAllVPSProcedure,
@@ -597,6 +729,18 @@ func NewSuperadminServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(superadminServiceMethods.ByName("SuperadminForceDeleteVPS")),
 			connect.WithClientOptions(opts...),
 		),
+		superadminMigrateVPS: connect.NewClient[v1.SuperadminMigrateVPSRequest, v1.SuperadminMigrateVPSResponse](
+			httpClient,
+			baseURL+SuperadminServiceSuperadminMigrateVPSProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("SuperadminMigrateVPS")),
+			connect.WithClientOptions(opts...),
+		),
+		listUnpatchedVPS: connect.NewClient[v1.ListUnpatchedVPSRequest, v1.ListUnpatchedVPSResponse](
+			httpClient,
+			baseURL+SuperadminServiceListUnpatchedVPSProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("ListUnpatchedVPS")),
+			connect.WithClientOptions(opts...),
+		),
 		listVPSSizes: connect.NewClient[v1.ListVPSSizesRequest, v1.ListVPSSizesResponse](
 			httpClient,
 			baseURL+SuperadminServiceListVPSSizesProcedure,
@@ -657,6 +801,12 @@ func NewSuperadminServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(superadminServiceMethods.ByName("UnassignVPSPublicIP")),
 			connect.WithClientOptions(opts...),
 		),
+		allocateStaticIP: connect.NewClient[v1.AllocateStaticIPRequest, v1.AllocateStaticIPResponse](
+			httpClient,
+			baseURL+SuperadminServiceAllocateStaticIPProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("AllocateStaticIP")),
+			connect.WithClientOptions(opts...),
+		),
 		getOrgLeases: connect.NewClient[v12.GetOrgLeasesRequest, v12.GetOrgLeasesResponse](
 			httpClient,
 			baseURL+SuperadminServiceGetOrgLeasesProcedure,
@@ -687,6 +837,30 @@ func NewSuperadminServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(superadminServiceMethods.ByName("UpdateNodeConfig")),
 			connect.WithClientOptions(opts...),
 		),
+		drainClusterNode: connect.NewClient[v1.DrainClusterNodeRequest, v1.DrainClusterNodeResponse](
+			httpClient,
+			baseURL+SuperadminServiceDrainClusterNodeProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("DrainClusterNode")),
+			connect.WithClientOptions(opts...),
+		),
+		enableClusterNode: connect.NewClient[v1.EnableClusterNodeRequest, v1.EnableClusterNodeResponse](
+			httpClient,
+			baseURL+SuperadminServiceEnableClusterNodeProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("EnableClusterNode")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateProxmoxToken: connect.NewClient[v1.RotateProxmoxTokenRequest, v1.RotateProxmoxTokenResponse](
+			httpClient,
+			baseURL+SuperadminServiceRotateProxmoxTokenProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("RotateProxmoxToken")),
+			connect.WithClientOptions(opts...),
+		),
+		getCapacityReport: connect.NewClient[v1.GetCapacityReportRequest, v1.GetCapacityReportResponse](
+			httpClient,
+			baseURL+SuperadminServiceGetCapacityReportProcedure,
+			connect.WithSchema(superadminServiceMethods.ByName("GetCapacityReport")),
+			connect.WithClientOptions(opts...),
+		),
 		listSuperadminPermissions: connect.NewClient[v1.ListSuperadminPermissionsRequest, v1.ListSuperadminPermissionsResponse](
 			httpClient,
 			baseURL+SuperadminServiceListSuperadminPermissionsProcedure,
@@ -788,6 +962,8 @@ type superadminServiceClient struct {
 	getDNSConfig                             *connect.Client[v1.GetDNSConfigRequest, v1.GetDNSConfigResponse]
 	listDelegatedDNSRecords                  *connect.Client[v1.ListDelegatedDNSRecordsRequest, v1.ListDelegatedDNSRecordsResponse]
 	hasDelegatedDNS                          *connect.Client[v1.HasDelegatedDNSRequest, v1.HasDelegatedDNSResponse]
+	listDNSQueryLogs                         *connect.Client[v1.ListDNSQueryLogsRequest, v1.ListDNSQueryLogsResponse]
+	configureSplitHorizon                    *connect.Client[v1.ConfigureSplitHorizonRequest, v1.ConfigureSplitHorizonResponse]
 	createDNSDelegationAPIKey                *connect.Client[v1.CreateDNSDelegationAPIKeyRequest, v1.CreateDNSDelegationAPIKeyResponse]
 	listDNSDelegationAPIKeys                 *connect.Client[v1.ListDNSDelegationAPIKeysRequest, v1.ListDNSDelegationAPIKeysResponse]
 	revokeDNSDelegationAPIKey                *connect.Client[v1.RevokeDNSDelegationAPIKeyRequest, v1.RevokeDNSDelegationAPIKeyResponse]
@@ -795,6 +971,8 @@ type superadminServiceClient struct {
 	getPricing                               *connect.Client[v1.GetPricingRequest, v1.GetPricingResponse]
 	getAbuseDetection                        *connect.Client[v1.GetAbuseDetectionRequest, v1.GetAbuseDetectionResponse]
 	getIncomeOverview                        *connect.Client[v1.GetIncomeOverviewRequest, v1.GetIncomeOverviewResponse]
+	getPlatformUsageSummary                  *connect.Client[v1.GetPlatformUsageSummaryRequest, v1.GetPlatformUsageSummaryResponse]
+	getAllOrganizationsUsage                 *connect.Client[v1.GetAllOrganizationsUsageRequest, v1.GetAllOrganizationsUsageResponse]
 	listAllInvoices                          *connect.Client[v1.ListAllInvoicesRequest, v1.ListAllInvoicesResponse]
 	sendInvoiceReminder                      *connect.Client[v1.SendInvoiceReminderRequest, v1.SendInvoiceReminderResponse]
 	listPlans                                *connect.Client[v1.ListPlansRequest, v1.ListPlansResponse]
@@ -810,10 +988,15 @@ type superadminServiceClient struct {
 	banUser                                  *connect.Client[v1.BanUserRequest, v1.BanUserResponse]
 	unbanUser                                *connect.Client[v1.UnbanUserRequest, v1.UnbanUserResponse]
 	getUserBanStatus                         *connect.Client[v1.GetUserBanStatusRequest, v1.GetUserBanStatusResponse]
+	createBanEntry                           *connect.Client[v1.CreateBanEntryRequest, v1.CreateBanEntryResponse]
+	deleteBanEntry                           *connect.Client[v1.DeleteBanEntryRequest, v1.DeleteBanEntryResponse]
+	listBanEntries                           *connect.Client[v1.ListBanEntriesRequest, v1.ListBanEntriesResponse]
 	suspendOrganization                      *connect.Client[v1.SuspendOrganizationRequest, v1.SuspendOrganizationResponse]
 	unsuspendOrganization                    *connect.Client[v1.UnsuspendOrganizationRequest, v1.UnsuspendOrganizationResponse]
 	banOrganization                          *connect.Client[v1.BanOrganizationRequest, v1.BanOrganizationResponse]
 	unbanOrganization                        *connect.Client[v1.UnbanOrganizationRequest, v1.UnbanOrganizationResponse]
+	liftSuspension                           *connect.Client[v1.LiftSuspensionRequest, v1.LiftSuspensionResponse]
+	setMaintenanceMode                       *connect.Client[v1.SetMaintenanceModeRequest, v1.SetMaintenanceModeResponse]
 	listAllVPS                               *connect.Client[v1.ListAllVPSRequest, v1.ListAllVPSResponse]
 	superadminGetVPS                         *connect.Client[v1.SuperadminGetVPSRequest, v1.SuperadminGetVPSResponse]
 	superadminResizeVPS                      *connect.Client[v1.SuperadminResizeVPSRequest, v1.SuperadminResizeVPSResponse]
@@ -822,6 +1005,8 @@ type superadminServiceClient struct {
 	superadminUpdateVPSCloudInit             *connect.Client[v1.SuperadminUpdateVPSCloudInitRequest, v1.SuperadminUpdateVPSCloudInitResponse]
 	superadminForceStopVPS                   *connect.Client[v1.SuperadminForceStopVPSRequest, v1.SuperadminForceStopVPSResponse]
 	superadminForceDeleteVPS                 *connect.Client[v1.SuperadminForceDeleteVPSRequest, v1.SuperadminForceDeleteVPSResponse]
+	superadminMigrateVPS                     *connect.Client[v1.SuperadminMigrateVPSRequest, v1.SuperadminMigrateVPSResponse]
+	listUnpatchedVPS                         *connect.Client[v1.ListUnpatchedVPSRequest, v1.ListUnpatchedVPSResponse]
 	listVPSSizes                             *connect.Client[v1.ListVPSSizesRequest, v1.ListVPSSizesResponse]
 	createVPSSize                            *connect.Client[v1.CreateVPSSizeRequest, v1.CreateVPSSizeResponse]
 	updateVPSSize                            *connect.Client[v1.UpdateVPSSizeRequest, v1.UpdateVPSSizeResponse]
@@ -832,11 +1017,16 @@ type superadminServiceClient struct {
 	deleteVPSPublicIP                        *connect.Client[v11.DeleteVPSPublicIPRequest, v11.DeleteVPSPublicIPResponse]
 	assignVPSPublicIP                        *connect.Client[v11.AssignVPSPublicIPRequest, v11.AssignVPSPublicIPResponse]
 	unassignVPSPublicIP                      *connect.Client[v11.UnassignVPSPublicIPRequest, v11.UnassignVPSPublicIPResponse]
+	allocateStaticIP                         *connect.Client[v1.AllocateStaticIPRequest, v1.AllocateStaticIPResponse]
 	getOrgLeases                             *connect.Client[v12.GetOrgLeasesRequest, v12.GetOrgLeasesResponse]
 	listStripeWebhookEvents                  *connect.Client[v1.ListStripeWebhookEventsRequest, v1.ListStripeWebhookEventsResponse]
 	listNodes                                *connect.Client[v1.ListNodesRequest, v1.ListNodesResponse]
 	getNode                                  *connect.Client[v1.GetNodeRequest, v1.GetNodeResponse]
 	updateNodeConfig                         *connect.Client[v1.UpdateNodeConfigRequest, v1.UpdateNodeConfigResponse]
+	drainClusterNode                         *connect.Client[v1.DrainClusterNodeRequest, v1.DrainClusterNodeResponse]
+	enableClusterNode                        *connect.Client[v1.EnableClusterNodeRequest, v1.EnableClusterNodeResponse]
+	rotateProxmoxToken                       *connect.Client[v1.RotateProxmoxTokenRequest, v1.RotateProxmoxTokenResponse]
+	getCapacityReport                        *connect.Client[v1.GetCapacityReportRequest, v1.GetCapacityReportResponse]
 	listSuperadminPermissions                *connect.Client[v1.ListSuperadminPermissionsRequest, v1.ListSuperadminPermissionsResponse]
 	getMySuperadminPermissions               *connect.Client[v1.GetMySuperadminPermissionsRequest, v1.GetMySuperadminPermissionsResponse]
 	listAllGameServers                       *connect.Client[v1.ListAllGameServersRequest, v1.ListAllGameServersResponse]
@@ -885,6 +1075,16 @@ func (c *superadminServiceClient) HasDelegatedDNS(ctx context.Context, req *conn
 	return c.hasDelegatedDNS.CallUnary(ctx, req)
 }
 
+// ListDNSQueryLogs calls obiente.cloud.superadmin.v1.SuperadminService.ListDNSQueryLogs.
+func (c *superadminServiceClient) ListDNSQueryLogs(ctx context.Context, req *connect.Request[v1.ListDNSQueryLogsRequest]) (*connect.Response[v1.ListDNSQueryLogsResponse], error) {
+	return c.listDNSQueryLogs.CallUnary(ctx, req)
+}
+
+// ConfigureSplitHorizon calls obiente.cloud.superadmin.v1.SuperadminService.ConfigureSplitHorizon.
+func (c *superadminServiceClient) ConfigureSplitHorizon(ctx context.Context, req *connect.Request[v1.ConfigureSplitHorizonRequest]) (*connect.Response[v1.ConfigureSplitHorizonResponse], error) {
+	return c.configureSplitHorizon.CallUnary(ctx, req)
+}
+
 // CreateDNSDelegationAPIKey calls
 // obiente.cloud.superadmin.v1.SuperadminService.CreateDNSDelegationAPIKey.
 func (c *superadminServiceClient) CreateDNSDelegationAPIKey(ctx context.Context, req *connect.Request[v1.CreateDNSDelegationAPIKeyRequest]) (*connect.Response[v1.CreateDNSDelegationAPIKeyResponse], error) {
@@ -924,6 +1124,18 @@ func (c *superadminServiceClient) GetIncomeOverview(ctx context.Context, req *co
 	return c.getIncomeOverview.CallUnary(ctx, req)
 }
 
+// GetPlatformUsageSummary calls
+// obiente.cloud.superadmin.v1.SuperadminService.GetPlatformUsageSummary.
+func (c *superadminServiceClient) GetPlatformUsageSummary(ctx context.Context, req *connect.Request[v1.GetPlatformUsageSummaryRequest]) (*connect.Response[v1.GetPlatformUsageSummaryResponse], error) {
+	return c.getPlatformUsageSummary.CallUnary(ctx, req)
+}
+
+// GetAllOrganizationsUsage calls
+// obiente.cloud.superadmin.v1.SuperadminService.GetAllOrganizationsUsage.
+func (c *superadminServiceClient) GetAllOrganizationsUsage(ctx context.Context, req *connect.Request[v1.GetAllOrganizationsUsageRequest]) (*connect.Response[v1.GetAllOrganizationsUsageResponse], error) {
+	return c.getAllOrganizationsUsage.CallUnary(ctx, req)
+}
+
 // ListAllInvoices calls obiente.cloud.superadmin.v1.SuperadminService.ListAllInvoices.
 func (c *superadminServiceClient) ListAllInvoices(ctx context.Context, req *connect.Request[v1.ListAllInvoicesRequest]) (*connect.Response[v1.ListAllInvoicesResponse], error) {
 	return c.listAllInvoices.CallUnary(ctx, req)
@@ -1001,6 +1213,21 @@ func (c *superadminServiceClient) GetUserBanStatus(ctx context.Context, req *con
 	return c.getUserBanStatus.CallUnary(ctx, req)
 }
 
+// CreateBanEntry calls obiente.cloud.superadmin.v1.SuperadminService.CreateBanEntry.
+func (c *superadminServiceClient) CreateBanEntry(ctx context.Context, req *connect.Request[v1.CreateBanEntryRequest]) (*connect.Response[v1.CreateBanEntryResponse], error) {
+	return c.createBanEntry.CallUnary(ctx, req)
+}
+
+// DeleteBanEntry calls obiente.cloud.superadmin.v1.SuperadminService.DeleteBanEntry.
+func (c *superadminServiceClient) DeleteBanEntry(ctx context.Context, req *connect.Request[v1.DeleteBanEntryRequest]) (*connect.Response[v1.DeleteBanEntryResponse], error) {
+	return c.deleteBanEntry.CallUnary(ctx, req)
+}
+
+// ListBanEntries calls obiente.cloud.superadmin.v1.SuperadminService.ListBanEntries.
+func (c *superadminServiceClient) ListBanEntries(ctx context.Context, req *connect.Request[v1.ListBanEntriesRequest]) (*connect.Response[v1.ListBanEntriesResponse], error) {
+	return c.listBanEntries.CallUnary(ctx, req)
+}
+
 // SuspendOrganization calls obiente.cloud.superadmin.v1.SuperadminService.SuspendOrganization.
 func (c *superadminServiceClient) SuspendOrganization(ctx context.Context, req *connect.Request[v1.SuspendOrganizationRequest]) (*connect.Response[v1.SuspendOrganizationResponse], error) {
 	return c.suspendOrganization.CallUnary(ctx, req)
@@ -1021,6 +1248,16 @@ func (c *superadminServiceClient) UnbanOrganization(ctx context.Context, req *co
 	return c.unbanOrganization.CallUnary(ctx, req)
 }
 
+// LiftSuspension calls obiente.cloud.superadmin.v1.SuperadminService.LiftSuspension.
+func (c *superadminServiceClient) LiftSuspension(ctx context.Context, req *connect.Request[v1.LiftSuspensionRequest]) (*connect.Response[v1.LiftSuspensionResponse], error) {
+	return c.liftSuspension.CallUnary(ctx, req)
+}
+
+// SetMaintenanceMode calls obiente.cloud.superadmin.v1.SuperadminService.SetMaintenanceMode.
+func (c *superadminServiceClient) SetMaintenanceMode(ctx context.Context, req *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error) {
+	return c.setMaintenanceMode.CallUnary(ctx, req)
+}
+
 // ListAllVPS calls obiente.cloud.superadmin.v1.SuperadminService.ListAllVPS.
 func (c *superadminServiceClient) ListAllVPS(ctx context.Context, req *connect.Request[v1.ListAllVPSRequest]) (*connect.Response[v1.ListAllVPSResponse], error) {
 	return c.listAllVPS.CallUnary(ctx, req)
@@ -1065,6 +1302,16 @@ func (c *superadminServiceClient) SuperadminForceDeleteVPS(ctx context.Context,
 	return c.superadminForceDeleteVPS.CallUnary(ctx, req)
 }
 
+// SuperadminMigrateVPS calls obiente.cloud.superadmin.v1.SuperadminService.SuperadminMigrateVPS.
+func (c *superadminServiceClient) SuperadminMigrateVPS(ctx context.Context, req *connect.Request[v1.SuperadminMigrateVPSRequest]) (*connect.Response[v1.SuperadminMigrateVPSResponse], error) {
+	return c.superadminMigrateVPS.CallUnary(ctx, req)
+}
+
+// ListUnpatchedVPS calls obiente.cloud.superadmin.v1.SuperadminService.ListUnpatchedVPS.
+func (c *superadminServiceClient) ListUnpatchedVPS(ctx context.Context, req *connect.Request[v1.ListUnpatchedVPSRequest]) (*connect.Response[v1.ListUnpatchedVPSResponse], error) {
+	return c.listUnpatchedVPS.CallUnary(ctx, req)
+}
+
 // ListVPSSizes calls obiente.cloud.superadmin.v1.SuperadminService.ListVPSSizes.
 func (c *superadminServiceClient) ListVPSSizes(ctx context.Context, req *connect.Request[v1.ListVPSSizesRequest]) (*connect.Response[v1.ListVPSSizesResponse], error) {
 	return c.listVPSSizes.CallUnary(ctx, req)
@@ -1115,6 +1362,11 @@ func (c *superadminServiceClient) UnassignVPSPublicIP(ctx context.Context, req *
 	return c.unassignVPSPublicIP.CallUnary(ctx, req)
 }
 
+// AllocateStaticIP calls obiente.cloud.superadmin.v1.SuperadminService.AllocateStaticIP.
+func (c *superadminServiceClient) AllocateStaticIP(ctx context.Context, req *connect.Request[v1.AllocateStaticIPRequest]) (*connect.Response[v1.AllocateStaticIPResponse], error) {
+	return c.allocateStaticIP.CallUnary(ctx, req)
+}
+
 // GetOrgLeases calls obiente.cloud.superadmin.v1.SuperadminService.GetOrgLeases.
 func (c *superadminServiceClient) GetOrgLeases(ctx context.Context, req *connect.Request[v12.GetOrgLeasesRequest]) (*connect.Response[v12.GetOrgLeasesResponse], error) {
 	return c.getOrgLeases.CallUnary(ctx, req)
@@ -1141,6 +1393,26 @@ func (c *superadminServiceClient) UpdateNodeConfig(ctx context.Context, req *con
 	return c.updateNodeConfig.CallUnary(ctx, req)
 }
 
+// DrainClusterNode calls obiente.cloud.superadmin.v1.SuperadminService.DrainClusterNode.
+func (c *superadminServiceClient) DrainClusterNode(ctx context.Context, req *connect.Request[v1.DrainClusterNodeRequest]) (*connect.Response[v1.DrainClusterNodeResponse], error) {
+	return c.drainClusterNode.CallUnary(ctx, req)
+}
+
+// EnableClusterNode calls obiente.cloud.superadmin.v1.SuperadminService.EnableClusterNode.
+func (c *superadminServiceClient) EnableClusterNode(ctx context.Context, req *connect.Request[v1.EnableClusterNodeRequest]) (*connect.Response[v1.EnableClusterNodeResponse], error) {
+	return c.enableClusterNode.CallUnary(ctx, req)
+}
+
+// RotateProxmoxToken calls obiente.cloud.superadmin.v1.SuperadminService.RotateProxmoxToken.
+func (c *superadminServiceClient) RotateProxmoxToken(ctx context.Context, req *connect.Request[v1.RotateProxmoxTokenRequest]) (*connect.Response[v1.RotateProxmoxTokenResponse], error) {
+	return c.rotateProxmoxToken.CallUnary(ctx, req)
+}
+
+// GetCapacityReport calls obiente.cloud.superadmin.v1.SuperadminService.GetCapacityReport.
+func (c *superadminServiceClient) GetCapacityReport(ctx context.Context, req *connect.Request[v1.GetCapacityReportRequest]) (*connect.Response[v1.GetCapacityReportResponse], error) {
+	return c.getCapacityReport.CallUnary(ctx, req)
+}
+
 // ListSuperadminPermissions calls
 // obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminPermissions.
 func (c *superadminServiceClient) ListSuperadminPermissions(ctx context.Context, req *connect.Request[v1.ListSuperadminPermissionsRequest]) (*connect.Response[v1.ListSuperadminPermissionsResponse], error) {
@@ -1237,6 +1509,8 @@ type SuperadminServiceHandler interface {
 	GetDNSConfig(context.Context, *connect.Request[v1.GetDNSConfigRequest]) (*connect.Response[v1.GetDNSConfigResponse], error)
 	ListDelegatedDNSRecords(context.Context, *connect.Request[v1.ListDelegatedDNSRecordsRequest]) (*connect.Response[v1.ListDelegatedDNSRecordsResponse], error)
 	HasDelegatedDNS(context.Context, *connect.Request[v1.HasDelegatedDNSRequest]) (*connect.Response[v1.HasDelegatedDNSResponse], error)
+	ListDNSQueryLogs(context.Context, *connect.Request[v1.ListDNSQueryLogsRequest]) (*connect.Response[v1.ListDNSQueryLogsResponse], error)
+	ConfigureSplitHorizon(context.Context, *connect.Request[v1.ConfigureSplitHorizonRequest]) (*connect.Response[v1.ConfigureSplitHorizonResponse], error)
 	// DNS Delegation API Key management
 	CreateDNSDelegationAPIKey(context.Context, *connect.Request[v1.CreateDNSDelegationAPIKeyRequest]) (*connect.Response[v1.CreateDNSDelegationAPIKeyResponse], error)
 	ListDNSDelegationAPIKeys(context.Context, *connect.Request[v1.ListDNSDelegationAPIKeysRequest]) (*connect.Response[v1.ListDNSDelegationAPIKeysResponse], error)
@@ -1248,6 +1522,9 @@ type SuperadminServiceHandler interface {
 	GetAbuseDetection(context.Context, *connect.Request[v1.GetAbuseDetectionRequest]) (*connect.Response[v1.GetAbuseDetectionResponse], error)
 	// Income and billing overview endpoints
 	GetIncomeOverview(context.Context, *connect.Request[v1.GetIncomeOverviewRequest]) (*connect.Response[v1.GetIncomeOverviewResponse], error)
+	// Platform resource usage endpoints
+	GetPlatformUsageSummary(context.Context, *connect.Request[v1.GetPlatformUsageSummaryRequest]) (*connect.Response[v1.GetPlatformUsageSummaryResponse], error)
+	GetAllOrganizationsUsage(context.Context, *connect.Request[v1.GetAllOrganizationsUsageRequest]) (*connect.Response[v1.GetAllOrganizationsUsageResponse], error)
 	// Invoice management endpoints
 	ListAllInvoices(context.Context, *connect.Request[v1.ListAllInvoicesRequest]) (*connect.Response[v1.ListAllInvoicesResponse], error)
 	SendInvoiceReminder(context.Context, *connect.Request[v1.SendInvoiceReminderRequest]) (*connect.Response[v1.SendInvoiceReminderResponse], error)
@@ -1266,11 +1543,20 @@ type SuperadminServiceHandler interface {
 	BanUser(context.Context, *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error)
 	UnbanUser(context.Context, *connect.Request[v1.UnbanUserRequest]) (*connect.Response[v1.UnbanUserResponse], error)
 	GetUserBanStatus(context.Context, *connect.Request[v1.GetUserBanStatusRequest]) (*connect.Response[v1.GetUserBanStatusResponse], error)
+	// Platform ban list: logins from banned IP ranges or with emails of banned domains are rejected
+	CreateBanEntry(context.Context, *connect.Request[v1.CreateBanEntryRequest]) (*connect.Response[v1.CreateBanEntryResponse], error)
+	DeleteBanEntry(context.Context, *connect.Request[v1.DeleteBanEntryRequest]) (*connect.Response[v1.DeleteBanEntryResponse], error)
+	ListBanEntries(context.Context, *connect.Request[v1.ListBanEntriesRequest]) (*connect.Response[v1.ListBanEntriesResponse], error)
 	// Organization moderation endpoints
 	SuspendOrganization(context.Context, *connect.Request[v1.SuspendOrganizationRequest]) (*connect.Response[v1.SuspendOrganizationResponse], error)
 	UnsuspendOrganization(context.Context, *connect.Request[v1.UnsuspendOrganizationRequest]) (*connect.Response[v1.UnsuspendOrganizationResponse], error)
 	BanOrganization(context.Context, *connect.Request[v1.BanOrganizationRequest]) (*connect.Response[v1.BanOrganizationResponse], error)
 	UnbanOrganization(context.Context, *connect.Request[v1.UnbanOrganizationRequest]) (*connect.Response[v1.UnbanOrganizationResponse], error)
+	// Lifts an organization suspension and records when it ended
+	LiftSuspension(context.Context, *connect.Request[v1.LiftSuspensionRequest]) (*connect.Response[v1.LiftSuspensionResponse], error)
+	// Platform maintenance endpoints
+	// Puts the platform in read-only mode: mutating RPCs are rejected until maintenance is disabled
+	SetMaintenanceMode(context.Context, *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error)
 	// VPS management endpoints
 	ListAllVPS(context.Context, *connect.Request[v1.ListAllVPSRequest]) (*connect.Response[v1.ListAllVPSResponse], error)
 	SuperadminGetVPS(context.Context, *connect.Request[v1.SuperadminGetVPSRequest]) (*connect.Response[v1.SuperadminGetVPSResponse], error)
@@ -1280,6 +1566,9 @@ type SuperadminServiceHandler interface {
 	SuperadminUpdateVPSCloudInit(context.Context, *connect.Request[v1.SuperadminUpdateVPSCloudInitRequest]) (*connect.Response[v1.SuperadminUpdateVPSCloudInitResponse], error)
 	SuperadminForceStopVPS(context.Context, *connect.Request[v1.SuperadminForceStopVPSRequest]) (*connect.Response[v1.SuperadminForceStopVPSResponse], error)
 	SuperadminForceDeleteVPS(context.Context, *connect.Request[v1.SuperadminForceDeleteVPSRequest]) (*connect.Response[v1.SuperadminForceDeleteVPSResponse], error)
+	SuperadminMigrateVPS(context.Context, *connect.Request[v1.SuperadminMigrateVPSRequest]) (*connect.Response[v1.SuperadminMigrateVPSResponse], error)
+	// Lists VPS instances whose OS was last patched (or, if never patched, created) more than the given number of days ago
+	ListUnpatchedVPS(context.Context, *connect.Request[v1.ListUnpatchedVPSRequest]) (*connect.Response[v1.ListUnpatchedVPSResponse], error)
 	// VPS size catalog management endpoints
 	ListVPSSizes(context.Context, *connect.Request[v1.ListVPSSizesRequest]) (*connect.Response[v1.ListVPSSizesResponse], error)
 	CreateVPSSize(context.Context, *connect.Request[v1.CreateVPSSizeRequest]) (*connect.Response[v1.CreateVPSSizeResponse], error)
@@ -1293,6 +1582,8 @@ type SuperadminServiceHandler interface {
 	DeleteVPSPublicIP(context.Context, *connect.Request[v11.DeleteVPSPublicIPRequest]) (*connect.Response[v11.DeleteVPSPublicIPResponse], error)
 	AssignVPSPublicIP(context.Context, *connect.Request[v11.AssignVPSPublicIPRequest]) (*connect.Response[v11.AssignVPSPublicIPResponse], error)
 	UnassignVPSPublicIP(context.Context, *connect.Request[v11.UnassignVPSPublicIPRequest]) (*connect.Response[v11.UnassignVPSPublicIPResponse], error)
+	// Assign a subnet to an organization for static VPS IPs
+	AllocateStaticIP(context.Context, *connect.Request[v1.AllocateStaticIPRequest]) (*connect.Response[v1.AllocateStaticIPResponse], error)
 	// DHCP lease management endpoints
 	// Get all DHCP leases for an organization (from VPSGatewayService)
 	GetOrgLeases(context.Context, *connect.Request[v12.GetOrgLeasesRequest]) (*connect.Response[v12.GetOrgLeasesResponse], error)
@@ -1302,6 +1593,17 @@ type SuperadminServiceHandler interface {
 	ListNodes(context.Context, *connect.Request[v1.ListNodesRequest]) (*connect.Response[v1.ListNodesResponse], error)
 	GetNode(context.Context, *connect.Request[v1.GetNodeRequest]) (*connect.Response[v1.GetNodeResponse], error)
 	UpdateNodeConfig(context.Context, *connect.Request[v1.UpdateNodeConfigRequest]) (*connect.Response[v1.UpdateNodeConfigResponse], error)
+	// Drain a Swarm node for maintenance: containers move to other nodes and the
+	// caller is notified when the drain completes or times out
+	DrainClusterNode(context.Context, *connect.Request[v1.DrainClusterNodeRequest]) (*connect.Response[v1.DrainClusterNodeResponse], error)
+	// Re-enable a drained node so it accepts deployments again
+	EnableClusterNode(context.Context, *connect.Request[v1.EnableClusterNodeRequest]) (*connect.Response[v1.EnableClusterNodeResponse], error)
+	// Rotate the Proxmox API token used for VPS management. The new token is validated first and the
+	// previous token keeps working for a short grace period.
+	RotateProxmoxToken(context.Context, *connect.Request[v1.RotateProxmoxTokenRequest]) (*connect.Response[v1.RotateProxmoxTokenResponse], error)
+	// Get current CPU, memory and disk usage of every Docker and Proxmox node, with the growth over
+	// the last 7 days and the estimated days until each resource is full
+	GetCapacityReport(context.Context, *connect.Request[v1.GetCapacityReportRequest]) (*connect.Response[v1.GetCapacityReportResponse], error)
 	// Superadmin permissions catalog (only superadmin-only permissions)
 	ListSuperadminPermissions(context.Context, *connect.Request[v1.ListSuperadminPermissionsRequest]) (*connect.Response[v1.ListSuperadminPermissionsResponse], error)
 	// Get current user's superadmin permissions (from their role bindings)
@@ -1367,6 +1669,18 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 		connect.WithSchema(superadminServiceMethods.ByName("HasDelegatedDNS")),
 		connect.WithHandlerOptions(opts...),
 	)
+	superadminServiceListDNSQueryLogsHandler := connect.NewUnaryHandler(
+		SuperadminServiceListDNSQueryLogsProcedure,
+		svc.ListDNSQueryLogs,
+		connect.WithSchema(superadminServiceMethods.ByName("ListDNSQueryLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	superadminServiceConfigureSplitHorizonHandler := connect.NewUnaryHandler(
+		SuperadminServiceConfigureSplitHorizonProcedure,
+		svc.ConfigureSplitHorizon,
+		connect.WithSchema(superadminServiceMethods.ByName("ConfigureSplitHorizon")),
+		connect.WithHandlerOptions(opts...),
+	)
 	superadminServiceCreateDNSDelegationAPIKeyHandler := connect.NewUnaryHandler(
 		SuperadminServiceCreateDNSDelegationAPIKeyProcedure,
 		svc.CreateDNSDelegationAPIKey,
@@ -1409,6 +1723,18 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 		connect.WithSchema(superadminServiceMethods.ByName("GetIncomeOverview")),
 		connect.WithHandlerOptions(opts...),
 	)
+	superadminServiceGetPlatformUsageSummaryHandler := connect.NewUnaryHandler(
+		SuperadminServiceGetPlatformUsageSummaryProcedure,
+		svc.GetPlatformUsageSummary,
+		connect.WithSchema(superadminServiceMethods.ByName("GetPlatformUsageSummary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	superadminServiceGetAllOrganizationsUsageHandler := connect.NewUnaryHandler(
+		SuperadminServiceGetAllOrganizationsUsageProcedure,
+		svc.GetAllOrganizationsUsage,
+		connect.WithSchema(superadminServiceMethods.ByName("GetAllOrganizationsUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	superadminServiceListAllInvoicesHandler := connect.NewUnaryHandler(
 		SuperadminServiceListAllInvoicesProcedure,
 		svc.ListAllInvoices,
@@ -1499,6 +1825,24 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 		connect.WithSchema(superadminServiceMethods.ByName("GetUserBanStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	superadminServiceCreateBanEntryHandler := connect.NewUnaryHandler(
+		SuperadminServiceCreateBanEntryProcedure,
+		svc.CreateBanEntry,
+		connect.WithSchema(superadminServiceMethods.ByName("CreateBanEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	superadminServiceDeleteBanEntryHandler := connect.NewUnaryHandler(
+		SuperadminServiceDeleteBanEntryProcedure,
+		svc.DeleteBanEntry,
+		connect.WithSchema(superadminServiceMethods.ByName("DeleteBanEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	superadminServiceListBanEntriesHandler := connect.NewUnaryHandler(
+		SuperadminServiceListBanEntriesProcedure,
+		svc.ListBanEntries,
+		connect.WithSchema(superadminServiceMethods.ByName("ListBanEntries")),
+		connect.WithHandlerOptions(opts...),
+	)
 	superadminServiceSuspendOrganizationHandler := connect.NewUnaryHandler(
 		SuperadminServiceSuspendOrganizationProcedure,
 		svc.SuspendOrganization,
@@ -1523,6 +1867,18 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 		connect.WithSchema(superadminServiceMethods.ByName("UnbanOrganization")),
 		connect.WithHandlerOptions(opts...),
 	)
+	superadminServiceLiftSuspensionHandler := connect.NewUnaryHandler(
+		SuperadminServiceLiftSuspensionProcedure,
+		svc.LiftSuspension,
+		connect.WithSchema(superadminServiceMethods.ByName("LiftSuspension")),
+		connect.WithHandlerOptions(opts...),
+	)
+	superadminServiceSetMaintenanceModeHandler := connect.NewUnaryHandler(
+		SuperadminServiceSetMaintenanceModeProcedure,
+		svc.SetMaintenanceMode,
+		connect.WithSchema(superadminServiceMethods.ByName("SetMaintenanceMode")),
+		connect.WithHandlerOptions(opts...),
+	)
 	superadminServiceListAllVPSHandler := connect.NewUnaryHandler(
 		SuperadminServiceListAllVPSProcedure,
 		svc.ListAllVPS,
@@ -1571,6 +1927,18 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 		connect.WithSchema(superadminServiceMethods.ByName("SuperadminForceDeleteVPS")),
 		connect.WithHandlerOptions(opts...),
 	)
+	superadminServiceSuperadminMigrateVPSHandler := connect.NewUnaryHandler(
+		SuperadminServiceSuperadminMigrateVPSProcedure,
+		svc.SuperadminMigrateVPS,
+		connect.WithSchema(superadminServiceMethods.ByName("SuperadminMigrateVPS")),
+		connect.WithHandlerOptions(opts...),
+	)
+	superadminServiceListUnpatchedVPSHandler := connect.NewUnaryHandler(
+		SuperadminServiceListUnpatchedVPSProcedure,
+		svc.ListUnpatchedVPS,
+		connect.WithSchema(superadminServiceMethods.ByName("ListUnpatchedVPS")),
+		connect.WithHandlerOptions(opts...),
+	)
 	superadminServiceListVPSSizesHandler := connect.NewUnaryHandler(
 		SuperadminServiceListVPSSizesProcedure,
 		svc.ListVPSSizes,
@@ -1631,6 +1999,12 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 		connect.WithSchema(superadminServiceMethods.ByName("UnassignVPSPublicIP")),
 		connect.WithHandlerOptions(opts...),
 	)
+	superadminServiceAllocateStaticIPHandler := connect.NewUnaryHandler(
+		SuperadminServiceAllocateStaticIPProcedure,
+		svc.AllocateStaticIP,
+		connect.WithSchema(superadminServiceMethods.ByName("AllocateStaticIP")),
+		connect.WithHandlerOptions(opts...),
+	)
 	superadminServiceGetOrgLeasesHandler := connect.NewUnaryHandler(
 		SuperadminServiceGetOrgLeasesProcedure,
 		svc.GetOrgLeases,
@@ -1661,6 +2035,30 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 		connect.WithSchema(superadminServiceMethods.ByName("UpdateNodeConfig")),
 		connect.WithHandlerOptions(opts...),
 	)
+	superadminServiceDrainClusterNodeHandler := connect.NewUnaryHandler(
+		SuperadminServiceDrainClusterNodeProcedure,
+		svc.DrainClusterNode,
+		connect.WithSchema(superadminServiceMethods.ByName("DrainClusterNode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	superadminServiceEnableClusterNodeHandler := connect.NewUnaryHandler(
+		SuperadminServiceEnableClusterNodeProcedure,
+		svc.EnableClusterNode,
+		connect.WithSchema(superadminServiceMethods.ByName("EnableClusterNode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	superadminServiceRotateProxmoxTokenHandler := connect.NewUnaryHandler(
+		SuperadminServiceRotateProxmoxTokenProcedure,
+		svc.RotateProxmoxToken,
+		connect.WithSchema(superadminServiceMethods.ByName("RotateProxmoxToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	superadminServiceGetCapacityReportHandler := connect.NewUnaryHandler(
+		SuperadminServiceGetCapacityReportProcedure,
+		svc.GetCapacityReport,
+		connect.WithSchema(superadminServiceMethods.ByName("GetCapacityReport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	superadminServiceListSuperadminPermissionsHandler := connect.NewUnaryHandler(
 		SuperadminServiceListSuperadminPermissionsProcedure,
 		svc.ListSuperadminPermissions,
@@ -1765,6 +2163,10 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 			superadminServiceListDelegatedDNSRecordsHandler.ServeHTTP(w, r)
 		case SuperadminServiceHasDelegatedDNSProcedure:
 			superadminServiceHasDelegatedDNSHandler.ServeHTTP(w, r)
+		case SuperadminServiceListDNSQueryLogsProcedure:
+			superadminServiceListDNSQueryLogsHandler.ServeHTTP(w, r)
+		case SuperadminServiceConfigureSplitHorizonProcedure:
+			superadminServiceConfigureSplitHorizonHandler.ServeHTTP(w, r)
 		case SuperadminServiceCreateDNSDelegationAPIKeyProcedure:
 			superadminServiceCreateDNSDelegationAPIKeyHandler.ServeHTTP(w, r)
 		case SuperadminServiceListDNSDelegationAPIKeysProcedure:
@@ -1779,6 +2181,10 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 			superadminServiceGetAbuseDetectionHandler.ServeHTTP(w, r)
 		case SuperadminServiceGetIncomeOverviewProcedure:
 			superadminServiceGetIncomeOverviewHandler.ServeHTTP(w, r)
+		case SuperadminServiceGetPlatformUsageSummaryProcedure:
+			superadminServiceGetPlatformUsageSummaryHandler.ServeHTTP(w, r)
+		case SuperadminServiceGetAllOrganizationsUsageProcedure:
+			superadminServiceGetAllOrganizationsUsageHandler.ServeHTTP(w, r)
 		case SuperadminServiceListAllInvoicesProcedure:
 			superadminServiceListAllInvoicesHandler.ServeHTTP(w, r)
 		case SuperadminServiceSendInvoiceReminderProcedure:
@@ -1809,6 +2215,12 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 			superadminServiceUnbanUserHandler.ServeHTTP(w, r)
 		case SuperadminServiceGetUserBanStatusProcedure:
 			superadminServiceGetUserBanStatusHandler.ServeHTTP(w, r)
+		case SuperadminServiceCreateBanEntryProcedure:
+			superadminServiceCreateBanEntryHandler.ServeHTTP(w, r)
+		case SuperadminServiceDeleteBanEntryProcedure:
+			superadminServiceDeleteBanEntryHandler.ServeHTTP(w, r)
+		case SuperadminServiceListBanEntriesProcedure:
+			superadminServiceListBanEntriesHandler.ServeHTTP(w, r)
 		case SuperadminServiceSuspendOrganizationProcedure:
 			superadminServiceSuspendOrganizationHandler.ServeHTTP(w, r)
 		case SuperadminServiceUnsuspendOrganizationProcedure:
@@ -1817,6 +2229,10 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 			superadminServiceBanOrganizationHandler.ServeHTTP(w, r)
 		case SuperadminServiceUnbanOrganizationProcedure:
 			superadminServiceUnbanOrganizationHandler.ServeHTTP(w, r)
+		case SuperadminServiceLiftSuspensionProcedure:
+			superadminServiceLiftSuspensionHandler.ServeHTTP(w, r)
+		case SuperadminServiceSetMaintenanceModeProcedure:
+			superadminServiceSetMaintenanceModeHandler.ServeHTTP(w, r)
 		case SuperadminServiceListAllVPSProcedure:
 			superadminServiceListAllVPSHandler.ServeHTTP(w, r)
 		case SuperadminServiceSuperadminGetVPSProcedure:
@@ -1833,6 +2249,10 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 			superadminServiceSuperadminForceStopVPSHandler.ServeHTTP(w, r)
 		case SuperadminServiceSuperadminForceDeleteVPSProcedure:
 			superadminServiceSuperadminForceDeleteVPSHandler.ServeHTTP(w, r)
+		case SuperadminServiceSuperadminMigrateVPSProcedure:
+			superadminServiceSuperadminMigrateVPSHandler.ServeHTTP(w, r)
+		case SuperadminServiceListUnpatchedVPSProcedure:
+			superadminServiceListUnpatchedVPSHandler.ServeHTTP(w, r)
 		case SuperadminServiceListVPSSizesProcedure:
 			superadminServiceListVPSSizesHandler.ServeHTTP(w, r)
 		case SuperadminServiceCreateVPSSizeProcedure:
@@ -1853,6 +2273,8 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 			superadminServiceAssignVPSPublicIPHandler.ServeHTTP(w, r)
 		case SuperadminServiceUnassignVPSPublicIPProcedure:
 			superadminServiceUnassignVPSPublicIPHandler.ServeHTTP(w, r)
+		case SuperadminServiceAllocateStaticIPProcedure:
+			superadminServiceAllocateStaticIPHandler.ServeHTTP(w, r)
 		case SuperadminServiceGetOrgLeasesProcedure:
 			superadminServiceGetOrgLeasesHandler.ServeHTTP(w, r)
 		case SuperadminServiceListStripeWebhookEventsProcedure:
@@ -1863,6 +2285,14 @@ func NewSuperadminServiceHandler(svc SuperadminServiceHandler, opts ...connect.H
 			superadminServiceGetNodeHandler.ServeHTTP(w, r)
 		case SuperadminServiceUpdateNodeConfigProcedure:
 			superadminServiceUpdateNodeConfigHandler.ServeHTTP(w, r)
+		case SuperadminServiceDrainClusterNodeProcedure:
+			superadminServiceDrainClusterNodeHandler.ServeHTTP(w, r)
+		case SuperadminServiceEnableClusterNodeProcedure:
+			superadminServiceEnableClusterNodeHandler.ServeHTTP(w, r)
+		case SuperadminServiceRotateProxmoxTokenProcedure:
+			superadminServiceRotateProxmoxTokenHandler.ServeHTTP(w, r)
+		case SuperadminServiceGetCapacityReportProcedure:
+			superadminServiceGetCapacityReportHandler.ServeHTTP(w, r)
 		case SuperadminServiceListSuperadminPermissionsProcedure:
 			superadminServiceListSuperadminPermissionsHandler.ServeHTTP(w, r)
 		case SuperadminServiceGetMySuperadminPermissionsProcedure:
@@ -1926,6 +2356,14 @@ func (UnimplementedSuperadminServiceHandler) HasDelegatedDNS(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.HasDelegatedDNS is not implemented"))
 }
 
+func (UnimplementedSuperadminServiceHandler) ListDNSQueryLogs(context.Context, *connect.Request[v1.ListDNSQueryLogsRequest]) (*connect.Response[v1.ListDNSQueryLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.ListDNSQueryLogs is not implemented"))
+}
+
+func (UnimplementedSuperadminServiceHandler) ConfigureSplitHorizon(context.Context, *connect.Request[v1.ConfigureSplitHorizonRequest]) (*connect.Response[v1.ConfigureSplitHorizonResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.ConfigureSplitHorizon is not implemented"))
+}
+
 func (UnimplementedSuperadminServiceHandler) CreateDNSDelegationAPIKey(context.Context, *connect.Request[v1.CreateDNSDelegationAPIKeyRequest]) (*connect.Response[v1.CreateDNSDelegationAPIKeyResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.CreateDNSDelegationAPIKey is not implemented"))
 }
@@ -1954,6 +2392,14 @@ func (UnimplementedSuperadminServiceHandler) GetIncomeOverview(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.GetIncomeOverview is not implemented"))
 }
 
+func (UnimplementedSuperadminServiceHandler) GetPlatformUsageSummary(context.Context, *connect.Request[v1.GetPlatformUsageSummaryRequest]) (*connect.Response[v1.GetPlatformUsageSummaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.GetPlatformUsageSummary is not implemented"))
+}
+
+func (UnimplementedSuperadminServiceHandler) GetAllOrganizationsUsage(context.Context, *connect.Request[v1.GetAllOrganizationsUsageRequest]) (*connect.Response[v1.GetAllOrganizationsUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.GetAllOrganizationsUsage is not implemented"))
+}
+
 func (UnimplementedSuperadminServiceHandler) ListAllInvoices(context.Context, *connect.Request[v1.ListAllInvoicesRequest]) (*connect.Response[v1.ListAllInvoicesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.ListAllInvoices is not implemented"))
 }
@@ -2014,6 +2460,18 @@ func (UnimplementedSuperadminServiceHandler) GetUserBanStatus(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.GetUserBanStatus is not implemented"))
 }
 
+func (UnimplementedSuperadminServiceHandler) CreateBanEntry(context.Context, *connect.Request[v1.CreateBanEntryRequest]) (*connect.Response[v1.CreateBanEntryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.CreateBanEntry is not implemented"))
+}
+
+func (UnimplementedSuperadminServiceHandler) DeleteBanEntry(context.Context, *connect.Request[v1.DeleteBanEntryRequest]) (*connect.Response[v1.DeleteBanEntryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.DeleteBanEntry is not implemented"))
+}
+
+func (UnimplementedSuperadminServiceHandler) ListBanEntries(context.Context, *connect.Request[v1.ListBanEntriesRequest]) (*connect.Response[v1.ListBanEntriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.ListBanEntries is not implemented"))
+}
+
 func (UnimplementedSuperadminServiceHandler) SuspendOrganization(context.Context, *connect.Request[v1.SuspendOrganizationRequest]) (*connect.Response[v1.SuspendOrganizationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.SuspendOrganization is not implemented"))
 }
@@ -2030,6 +2488,14 @@ func (UnimplementedSuperadminServiceHandler) UnbanOrganization(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.UnbanOrganization is not implemented"))
 }
 
+func (UnimplementedSuperadminServiceHandler) LiftSuspension(context.Context, *connect.Request[v1.LiftSuspensionRequest]) (*connect.Response[v1.LiftSuspensionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.LiftSuspension is not implemented"))
+}
+
+func (UnimplementedSuperadminServiceHandler) SetMaintenanceMode(context.Context, *connect.Request[v1.SetMaintenanceModeRequest]) (*connect.Response[v1.SetMaintenanceModeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.SetMaintenanceMode is not implemented"))
+}
+
 func (UnimplementedSuperadminServiceHandler) ListAllVPS(context.Context, *connect.Request[v1.ListAllVPSRequest]) (*connect.Response[v1.ListAllVPSResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.ListAllVPS is not implemented"))
 }
@@ -2062,6 +2528,14 @@ func (UnimplementedSuperadminServiceHandler) SuperadminForceDeleteVPS(context.Co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.SuperadminForceDeleteVPS is not implemented"))
 }
 
+func (UnimplementedSuperadminServiceHandler) SuperadminMigrateVPS(context.Context, *connect.Request[v1.SuperadminMigrateVPSRequest]) (*connect.Response[v1.SuperadminMigrateVPSResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.SuperadminMigrateVPS is not implemented"))
+}
+
+func (UnimplementedSuperadminServiceHandler) ListUnpatchedVPS(context.Context, *connect.Request[v1.ListUnpatchedVPSRequest]) (*connect.Response[v1.ListUnpatchedVPSResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.ListUnpatchedVPS is not implemented"))
+}
+
 func (UnimplementedSuperadminServiceHandler) ListVPSSizes(context.Context, *connect.Request[v1.ListVPSSizesRequest]) (*connect.Response[v1.ListVPSSizesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.ListVPSSizes is not implemented"))
 }
@@ -2102,6 +2576,10 @@ func (UnimplementedSuperadminServiceHandler) UnassignVPSPublicIP(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.UnassignVPSPublicIP is not implemented"))
 }
 
+func (UnimplementedSuperadminServiceHandler) AllocateStaticIP(context.Context, *connect.Request[v1.AllocateStaticIPRequest]) (*connect.Response[v1.AllocateStaticIPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.AllocateStaticIP is not implemented"))
+}
+
 func (UnimplementedSuperadminServiceHandler) GetOrgLeases(context.Context, *connect.Request[v12.GetOrgLeasesRequest]) (*connect.Response[v12.GetOrgLeasesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.GetOrgLeases is not implemented"))
 }
@@ -2122,6 +2600,22 @@ func (UnimplementedSuperadminServiceHandler) UpdateNodeConfig(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.UpdateNodeConfig is not implemented"))
 }
 
+func (UnimplementedSuperadminServiceHandler) DrainClusterNode(context.Context, *connect.Request[v1.DrainClusterNodeRequest]) (*connect.Response[v1.DrainClusterNodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.DrainClusterNode is not implemented"))
+}
+
+func (UnimplementedSuperadminServiceHandler) EnableClusterNode(context.Context, *connect.Request[v1.EnableClusterNodeRequest]) (*connect.Response[v1.EnableClusterNodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.EnableClusterNode is not implemented"))
+}
+
+func (UnimplementedSuperadminServiceHandler) RotateProxmoxToken(context.Context, *connect.Request[v1.RotateProxmoxTokenRequest]) (*connect.Response[v1.RotateProxmoxTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.RotateProxmoxToken is not implemented"))
+}
+
+func (UnimplementedSuperadminServiceHandler) GetCapacityReport(context.Context, *connect.Request[v1.GetCapacityReportRequest]) (*connect.Response[v1.GetCapacityReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.GetCapacityReport is not implemented"))
+}
+
 func (UnimplementedSuperadminServiceHandler) ListSuperadminPermissions(context.Context, *connect.Request[v1.ListSuperadminPermissionsRequest]) (*connect.Response[v1.ListSuperadminPermissionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.superadmin.v1.SuperadminService.ListSuperadminPermissions is not implemented"))
 }
@@ -2086,6 +2086,395 @@ func (x *RemoveSSHAliasResponse) GetMessage() string {
 	return ""
 }
 
+// CloudInitTemplate is a reusable cloud-init userData template
+// Content is a Go text/template; available variables are
+// {{.VPSID}}, {{.Hostname}}, {{.RootPassword}}, {{.SSHKeys}} and {{.Region}}
+type CloudInitTemplate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Content        string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"` // Template source, must start with "#cloud-config"
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CloudInitTemplate) Reset() {
+	*x = CloudInitTemplate{}
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloudInitTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudInitTemplate) ProtoMessage() {}
+
+func (x *CloudInitTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudInitTemplate.ProtoReflect.Descriptor instead.
+func (*CloudInitTemplate) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_config_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CloudInitTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CloudInitTemplate) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CloudInitTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloudInitTemplate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CloudInitTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CloudInitTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateCloudInitTemplateRequest creates a cloud-init template
+type CreateCloudInitTemplateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Content        string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCloudInitTemplateRequest) Reset() {
+	*x = CreateCloudInitTemplateRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCloudInitTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCloudInitTemplateRequest) ProtoMessage() {}
+
+func (x *CreateCloudInitTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCloudInitTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateCloudInitTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_config_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateCloudInitTemplateRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateCloudInitTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCloudInitTemplateRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// CreateCloudInitTemplateResponse returns the created template
+type CreateCloudInitTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *CloudInitTemplate     `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCloudInitTemplateResponse) Reset() {
+	*x = CreateCloudInitTemplateResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCloudInitTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCloudInitTemplateResponse) ProtoMessage() {}
+
+func (x *CreateCloudInitTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCloudInitTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateCloudInitTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_config_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateCloudInitTemplateResponse) GetTemplate() *CloudInitTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// GetCloudInitTemplateRequest requests a cloud-init template
+type GetCloudInitTemplateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TemplateId     string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCloudInitTemplateRequest) Reset() {
+	*x = GetCloudInitTemplateRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudInitTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudInitTemplateRequest) ProtoMessage() {}
+
+func (x *GetCloudInitTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudInitTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetCloudInitTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_config_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCloudInitTemplateRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetCloudInitTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// GetCloudInitTemplateResponse returns the template
+type GetCloudInitTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *CloudInitTemplate     `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloudInitTemplateResponse) Reset() {
+	*x = GetCloudInitTemplateResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudInitTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudInitTemplateResponse) ProtoMessage() {}
+
+func (x *GetCloudInitTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudInitTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetCloudInitTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_config_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetCloudInitTemplateResponse) GetTemplate() *CloudInitTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// DeleteCloudInitTemplateRequest deletes a cloud-init template
+type DeleteCloudInitTemplateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TemplateId     string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteCloudInitTemplateRequest) Reset() {
+	*x = DeleteCloudInitTemplateRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCloudInitTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCloudInitTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteCloudInitTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCloudInitTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCloudInitTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_config_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteCloudInitTemplateRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteCloudInitTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// DeleteCloudInitTemplateResponse confirms the deletion
+type DeleteCloudInitTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // Confirmation message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCloudInitTemplateResponse) Reset() {
+	*x = DeleteCloudInitTemplateResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCloudInitTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCloudInitTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteCloudInitTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCloudInitTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCloudInitTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_config_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteCloudInitTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_obiente_cloud_vps_v1_vps_config_service_proto protoreflect.FileDescriptor
 
 const file_obiente_cloud_vps_v1_vps_config_service_proto_rawDesc = "" +
@@ -2248,7 +2637,34 @@ const file_obiente_cloud_vps_v1_vps_config_service_proto_rawDesc = "" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
 	"\x06vps_id\x18\x02 \x01(\tR\x05vpsId\"2\n" +
 	"\x16RemoveSSHAliasResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\x83\x0f\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xf0\x01\n" +
+	"\x11CloudInitTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"w\n" +
+	"\x1eCreateCloudInitTemplateRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"f\n" +
+	"\x1fCreateCloudInitTemplateResponse\x12C\n" +
+	"\btemplate\x18\x01 \x01(\v2'.obiente.cloud.vps.v1.CloudInitTemplateR\btemplate\"g\n" +
+	"\x1bGetCloudInitTemplateRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\"c\n" +
+	"\x1cGetCloudInitTemplateResponse\x12C\n" +
+	"\btemplate\x18\x01 \x01(\v2'.obiente.cloud.vps.v1.CloudInitTemplateR\btemplate\"j\n" +
+	"\x1eDeleteCloudInitTemplateRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\";\n" +
+	"\x1fDeleteCloudInitTemplateResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\x94\x12\n" +
 	"\x10VPSConfigService\x12w\n" +
 	"\x12GetCloudInitConfig\x12/.obiente.cloud.vps.v1.GetCloudInitConfigRequest\x1a0.obiente.cloud.vps.v1.GetCloudInitConfigResponse\x12}\n" +
 	"\x14GetCloudInitUserData\x121.obiente.cloud.vps.v1.GetCloudInitUserDataRequest\x1a2.obiente.cloud.vps.v1.GetCloudInitUserDataResponse\x12\x80\x01\n" +
@@ -2266,7 +2682,10 @@ const file_obiente_cloud_vps_v1_vps_config_service_proto_rawDesc = "" +
 	"\rGetBastionKey\x12*.obiente.cloud.vps.v1.GetBastionKeyRequest\x1a+.obiente.cloud.vps.v1.GetBastionKeyResponse\x12b\n" +
 	"\vGetSSHAlias\x12(.obiente.cloud.vps.v1.GetSSHAliasRequest\x1a).obiente.cloud.vps.v1.GetSSHAliasResponse\x12b\n" +
 	"\vSetSSHAlias\x12(.obiente.cloud.vps.v1.SetSSHAliasRequest\x1a).obiente.cloud.vps.v1.SetSSHAliasResponse\x12k\n" +
-	"\x0eRemoveSSHAlias\x12+.obiente.cloud.vps.v1.RemoveSSHAliasRequest\x1a,.obiente.cloud.vps.v1.RemoveSSHAliasResponseBGZEgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1;vpsv1b\x06proto3"
+	"\x0eRemoveSSHAlias\x12+.obiente.cloud.vps.v1.RemoveSSHAliasRequest\x1a,.obiente.cloud.vps.v1.RemoveSSHAliasResponse\x12\x86\x01\n" +
+	"\x17CreateCloudInitTemplate\x124.obiente.cloud.vps.v1.CreateCloudInitTemplateRequest\x1a5.obiente.cloud.vps.v1.CreateCloudInitTemplateResponse\x12}\n" +
+	"\x14GetCloudInitTemplate\x121.obiente.cloud.vps.v1.GetCloudInitTemplateRequest\x1a2.obiente.cloud.vps.v1.GetCloudInitTemplateResponse\x12\x86\x01\n" +
+	"\x17DeleteCloudInitTemplate\x124.obiente.cloud.vps.v1.DeleteCloudInitTemplateRequest\x1a5.obiente.cloud.vps.v1.DeleteCloudInitTemplateResponseBGZEgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1;vpsv1b\x06proto3"
 
 var (
 	file_obiente_cloud_vps_v1_vps_config_service_proto_rawDescOnce sync.Once
@@ -2280,97 +2699,114 @@ func file_obiente_cloud_vps_v1_vps_config_service_proto_rawDescGZIP() []byte {
 	return file_obiente_cloud_vps_v1_vps_config_service_proto_rawDescData
 }
 
-var file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_obiente_cloud_vps_v1_vps_config_service_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_obiente_cloud_vps_v1_vps_config_service_proto_goTypes = []any{
-	(*GetCloudInitConfigRequest)(nil),     // 0: obiente.cloud.vps.v1.GetCloudInitConfigRequest
-	(*GetCloudInitConfigResponse)(nil),    // 1: obiente.cloud.vps.v1.GetCloudInitConfigResponse
-	(*GetCloudInitUserDataRequest)(nil),   // 2: obiente.cloud.vps.v1.GetCloudInitUserDataRequest
-	(*GetCloudInitUserDataResponse)(nil),  // 3: obiente.cloud.vps.v1.GetCloudInitUserDataResponse
-	(*UpdateCloudInitConfigRequest)(nil),  // 4: obiente.cloud.vps.v1.UpdateCloudInitConfigRequest
-	(*UpdateCloudInitConfigResponse)(nil), // 5: obiente.cloud.vps.v1.UpdateCloudInitConfigResponse
-	(*ListVPSUsersRequest)(nil),           // 6: obiente.cloud.vps.v1.ListVPSUsersRequest
-	(*ListVPSUsersResponse)(nil),          // 7: obiente.cloud.vps.v1.ListVPSUsersResponse
-	(*VPSUser)(nil),                       // 8: obiente.cloud.vps.v1.VPSUser
-	(*CreateVPSUserRequest)(nil),          // 9: obiente.cloud.vps.v1.CreateVPSUserRequest
-	(*CreateVPSUserResponse)(nil),         // 10: obiente.cloud.vps.v1.CreateVPSUserResponse
-	(*UpdateVPSUserRequest)(nil),          // 11: obiente.cloud.vps.v1.UpdateVPSUserRequest
-	(*UpdateVPSUserResponse)(nil),         // 12: obiente.cloud.vps.v1.UpdateVPSUserResponse
-	(*DeleteVPSUserRequest)(nil),          // 13: obiente.cloud.vps.v1.DeleteVPSUserRequest
-	(*DeleteVPSUserResponse)(nil),         // 14: obiente.cloud.vps.v1.DeleteVPSUserResponse
-	(*SetUserPasswordRequest)(nil),        // 15: obiente.cloud.vps.v1.SetUserPasswordRequest
-	(*SetUserPasswordResponse)(nil),       // 16: obiente.cloud.vps.v1.SetUserPasswordResponse
-	(*UpdateUserSSHKeysRequest)(nil),      // 17: obiente.cloud.vps.v1.UpdateUserSSHKeysRequest
-	(*UpdateUserSSHKeysResponse)(nil),     // 18: obiente.cloud.vps.v1.UpdateUserSSHKeysResponse
-	(*RotateTerminalKeyRequest)(nil),      // 19: obiente.cloud.vps.v1.RotateTerminalKeyRequest
-	(*RotateTerminalKeyResponse)(nil),     // 20: obiente.cloud.vps.v1.RotateTerminalKeyResponse
-	(*RemoveTerminalKeyRequest)(nil),      // 21: obiente.cloud.vps.v1.RemoveTerminalKeyRequest
-	(*RemoveTerminalKeyResponse)(nil),     // 22: obiente.cloud.vps.v1.RemoveTerminalKeyResponse
-	(*GetTerminalKeyRequest)(nil),         // 23: obiente.cloud.vps.v1.GetTerminalKeyRequest
-	(*GetTerminalKeyResponse)(nil),        // 24: obiente.cloud.vps.v1.GetTerminalKeyResponse
-	(*RotateBastionKeyRequest)(nil),       // 25: obiente.cloud.vps.v1.RotateBastionKeyRequest
-	(*RotateBastionKeyResponse)(nil),      // 26: obiente.cloud.vps.v1.RotateBastionKeyResponse
-	(*GetBastionKeyRequest)(nil),          // 27: obiente.cloud.vps.v1.GetBastionKeyRequest
-	(*GetBastionKeyResponse)(nil),         // 28: obiente.cloud.vps.v1.GetBastionKeyResponse
-	(*GetSSHAliasRequest)(nil),            // 29: obiente.cloud.vps.v1.GetSSHAliasRequest
-	(*GetSSHAliasResponse)(nil),           // 30: obiente.cloud.vps.v1.GetSSHAliasResponse
-	(*SetSSHAliasRequest)(nil),            // 31: obiente.cloud.vps.v1.SetSSHAliasRequest
-	(*SetSSHAliasResponse)(nil),           // 32: obiente.cloud.vps.v1.SetSSHAliasResponse
-	(*RemoveSSHAliasRequest)(nil),         // 33: obiente.cloud.vps.v1.RemoveSSHAliasRequest
-	(*RemoveSSHAliasResponse)(nil),        // 34: obiente.cloud.vps.v1.RemoveSSHAliasResponse
-	(*CloudInitConfig)(nil),               // 35: obiente.cloud.vps.v1.CloudInitConfig
-	(*timestamppb.Timestamp)(nil),         // 36: google.protobuf.Timestamp
+	(*GetCloudInitConfigRequest)(nil),       // 0: obiente.cloud.vps.v1.GetCloudInitConfigRequest
+	(*GetCloudInitConfigResponse)(nil),      // 1: obiente.cloud.vps.v1.GetCloudInitConfigResponse
+	(*GetCloudInitUserDataRequest)(nil),     // 2: obiente.cloud.vps.v1.GetCloudInitUserDataRequest
+	(*GetCloudInitUserDataResponse)(nil),    // 3: obiente.cloud.vps.v1.GetCloudInitUserDataResponse
+	(*UpdateCloudInitConfigRequest)(nil),    // 4: obiente.cloud.vps.v1.UpdateCloudInitConfigRequest
+	(*UpdateCloudInitConfigResponse)(nil),   // 5: obiente.cloud.vps.v1.UpdateCloudInitConfigResponse
+	(*ListVPSUsersRequest)(nil),             // 6: obiente.cloud.vps.v1.ListVPSUsersRequest
+	(*ListVPSUsersResponse)(nil),            // 7: obiente.cloud.vps.v1.ListVPSUsersResponse
+	(*VPSUser)(nil),                         // 8: obiente.cloud.vps.v1.VPSUser
+	(*CreateVPSUserRequest)(nil),            // 9: obiente.cloud.vps.v1.CreateVPSUserRequest
+	(*CreateVPSUserResponse)(nil),           // 10: obiente.cloud.vps.v1.CreateVPSUserResponse
+	(*UpdateVPSUserRequest)(nil),            // 11: obiente.cloud.vps.v1.UpdateVPSUserRequest
+	(*UpdateVPSUserResponse)(nil),           // 12: obiente.cloud.vps.v1.UpdateVPSUserResponse
+	(*DeleteVPSUserRequest)(nil),            // 13: obiente.cloud.vps.v1.DeleteVPSUserRequest
+	(*DeleteVPSUserResponse)(nil),           // 14: obiente.cloud.vps.v1.DeleteVPSUserResponse
+	(*SetUserPasswordRequest)(nil),          // 15: obiente.cloud.vps.v1.SetUserPasswordRequest
+	(*SetUserPasswordResponse)(nil),         // 16: obiente.cloud.vps.v1.SetUserPasswordResponse
+	(*UpdateUserSSHKeysRequest)(nil),        // 17: obiente.cloud.vps.v1.UpdateUserSSHKeysRequest
+	(*UpdateUserSSHKeysResponse)(nil),       // 18: obiente.cloud.vps.v1.UpdateUserSSHKeysResponse
+	(*RotateTerminalKeyRequest)(nil),        // 19: obiente.cloud.vps.v1.RotateTerminalKeyRequest
+	(*RotateTerminalKeyResponse)(nil),       // 20: obiente.cloud.vps.v1.RotateTerminalKeyResponse
+	(*RemoveTerminalKeyRequest)(nil),        // 21: obiente.cloud.vps.v1.RemoveTerminalKeyRequest
+	(*RemoveTerminalKeyResponse)(nil),       // 22: obiente.cloud.vps.v1.RemoveTerminalKeyResponse
+	(*GetTerminalKeyRequest)(nil),           // 23: obiente.cloud.vps.v1.GetTerminalKeyRequest
+	(*GetTerminalKeyResponse)(nil),          // 24: obiente.cloud.vps.v1.GetTerminalKeyResponse
+	(*RotateBastionKeyRequest)(nil),         // 25: obiente.cloud.vps.v1.RotateBastionKeyRequest
+	(*RotateBastionKeyResponse)(nil),        // 26: obiente.cloud.vps.v1.RotateBastionKeyResponse
+	(*GetBastionKeyRequest)(nil),            // 27: obiente.cloud.vps.v1.GetBastionKeyRequest
+	(*GetBastionKeyResponse)(nil),           // 28: obiente.cloud.vps.v1.GetBastionKeyResponse
+	(*GetSSHAliasRequest)(nil),              // 29: obiente.cloud.vps.v1.GetSSHAliasRequest
+	(*GetSSHAliasResponse)(nil),             // 30: obiente.cloud.vps.v1.GetSSHAliasResponse
+	(*SetSSHAliasRequest)(nil),              // 31: obiente.cloud.vps.v1.SetSSHAliasRequest
+	(*SetSSHAliasResponse)(nil),             // 32: obiente.cloud.vps.v1.SetSSHAliasResponse
+	(*RemoveSSHAliasRequest)(nil),           // 33: obiente.cloud.vps.v1.RemoveSSHAliasRequest
+	(*RemoveSSHAliasResponse)(nil),          // 34: obiente.cloud.vps.v1.RemoveSSHAliasResponse
+	(*CloudInitTemplate)(nil),               // 35: obiente.cloud.vps.v1.CloudInitTemplate
+	(*CreateCloudInitTemplateRequest)(nil),  // 36: obiente.cloud.vps.v1.CreateCloudInitTemplateRequest
+	(*CreateCloudInitTemplateResponse)(nil), // 37: obiente.cloud.vps.v1.CreateCloudInitTemplateResponse
+	(*GetCloudInitTemplateRequest)(nil),     // 38: obiente.cloud.vps.v1.GetCloudInitTemplateRequest
+	(*GetCloudInitTemplateResponse)(nil),    // 39: obiente.cloud.vps.v1.GetCloudInitTemplateResponse
+	(*DeleteCloudInitTemplateRequest)(nil),  // 40: obiente.cloud.vps.v1.DeleteCloudInitTemplateRequest
+	(*DeleteCloudInitTemplateResponse)(nil), // 41: obiente.cloud.vps.v1.DeleteCloudInitTemplateResponse
+	(*CloudInitConfig)(nil),                 // 42: obiente.cloud.vps.v1.CloudInitConfig
+	(*timestamppb.Timestamp)(nil),           // 43: google.protobuf.Timestamp
 }
 var file_obiente_cloud_vps_v1_vps_config_service_proto_depIdxs = []int32{
-	35, // 0: obiente.cloud.vps.v1.GetCloudInitConfigResponse.cloud_init:type_name -> obiente.cloud.vps.v1.CloudInitConfig
-	35, // 1: obiente.cloud.vps.v1.UpdateCloudInitConfigRequest.cloud_init:type_name -> obiente.cloud.vps.v1.CloudInitConfig
-	35, // 2: obiente.cloud.vps.v1.UpdateCloudInitConfigResponse.cloud_init:type_name -> obiente.cloud.vps.v1.CloudInitConfig
+	42, // 0: obiente.cloud.vps.v1.GetCloudInitConfigResponse.cloud_init:type_name -> obiente.cloud.vps.v1.CloudInitConfig
+	42, // 1: obiente.cloud.vps.v1.UpdateCloudInitConfigRequest.cloud_init:type_name -> obiente.cloud.vps.v1.CloudInitConfig
+	42, // 2: obiente.cloud.vps.v1.UpdateCloudInitConfigResponse.cloud_init:type_name -> obiente.cloud.vps.v1.CloudInitConfig
 	8,  // 3: obiente.cloud.vps.v1.ListVPSUsersResponse.users:type_name -> obiente.cloud.vps.v1.VPSUser
 	8,  // 4: obiente.cloud.vps.v1.CreateVPSUserResponse.user:type_name -> obiente.cloud.vps.v1.VPSUser
 	8,  // 5: obiente.cloud.vps.v1.UpdateVPSUserResponse.user:type_name -> obiente.cloud.vps.v1.VPSUser
 	8,  // 6: obiente.cloud.vps.v1.UpdateUserSSHKeysResponse.user:type_name -> obiente.cloud.vps.v1.VPSUser
-	36, // 7: obiente.cloud.vps.v1.GetTerminalKeyResponse.created_at:type_name -> google.protobuf.Timestamp
-	36, // 8: obiente.cloud.vps.v1.GetTerminalKeyResponse.updated_at:type_name -> google.protobuf.Timestamp
-	36, // 9: obiente.cloud.vps.v1.GetBastionKeyResponse.created_at:type_name -> google.protobuf.Timestamp
-	36, // 10: obiente.cloud.vps.v1.GetBastionKeyResponse.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 11: obiente.cloud.vps.v1.VPSConfigService.GetCloudInitConfig:input_type -> obiente.cloud.vps.v1.GetCloudInitConfigRequest
-	2,  // 12: obiente.cloud.vps.v1.VPSConfigService.GetCloudInitUserData:input_type -> obiente.cloud.vps.v1.GetCloudInitUserDataRequest
-	4,  // 13: obiente.cloud.vps.v1.VPSConfigService.UpdateCloudInitConfig:input_type -> obiente.cloud.vps.v1.UpdateCloudInitConfigRequest
-	6,  // 14: obiente.cloud.vps.v1.VPSConfigService.ListVPSUsers:input_type -> obiente.cloud.vps.v1.ListVPSUsersRequest
-	9,  // 15: obiente.cloud.vps.v1.VPSConfigService.CreateVPSUser:input_type -> obiente.cloud.vps.v1.CreateVPSUserRequest
-	11, // 16: obiente.cloud.vps.v1.VPSConfigService.UpdateVPSUser:input_type -> obiente.cloud.vps.v1.UpdateVPSUserRequest
-	13, // 17: obiente.cloud.vps.v1.VPSConfigService.DeleteVPSUser:input_type -> obiente.cloud.vps.v1.DeleteVPSUserRequest
-	15, // 18: obiente.cloud.vps.v1.VPSConfigService.SetUserPassword:input_type -> obiente.cloud.vps.v1.SetUserPasswordRequest
-	17, // 19: obiente.cloud.vps.v1.VPSConfigService.UpdateUserSSHKeys:input_type -> obiente.cloud.vps.v1.UpdateUserSSHKeysRequest
-	19, // 20: obiente.cloud.vps.v1.VPSConfigService.RotateTerminalKey:input_type -> obiente.cloud.vps.v1.RotateTerminalKeyRequest
-	21, // 21: obiente.cloud.vps.v1.VPSConfigService.RemoveTerminalKey:input_type -> obiente.cloud.vps.v1.RemoveTerminalKeyRequest
-	23, // 22: obiente.cloud.vps.v1.VPSConfigService.GetTerminalKey:input_type -> obiente.cloud.vps.v1.GetTerminalKeyRequest
-	25, // 23: obiente.cloud.vps.v1.VPSConfigService.RotateBastionKey:input_type -> obiente.cloud.vps.v1.RotateBastionKeyRequest
-	27, // 24: obiente.cloud.vps.v1.VPSConfigService.GetBastionKey:input_type -> obiente.cloud.vps.v1.GetBastionKeyRequest
-	29, // 25: obiente.cloud.vps.v1.VPSConfigService.GetSSHAlias:input_type -> obiente.cloud.vps.v1.GetSSHAliasRequest
-	31, // 26: obiente.cloud.vps.v1.VPSConfigService.SetSSHAlias:input_type -> obiente.cloud.vps.v1.SetSSHAliasRequest
-	33, // 27: obiente.cloud.vps.v1.VPSConfigService.RemoveSSHAlias:input_type -> obiente.cloud.vps.v1.RemoveSSHAliasRequest
-	1,  // 28: obiente.cloud.vps.v1.VPSConfigService.GetCloudInitConfig:output_type -> obiente.cloud.vps.v1.GetCloudInitConfigResponse
-	3,  // 29: obiente.cloud.vps.v1.VPSConfigService.GetCloudInitUserData:output_type -> obiente.cloud.vps.v1.GetCloudInitUserDataResponse
-	5,  // 30: obiente.cloud.vps.v1.VPSConfigService.UpdateCloudInitConfig:output_type -> obiente.cloud.vps.v1.UpdateCloudInitConfigResponse
-	7,  // 31: obiente.cloud.vps.v1.VPSConfigService.ListVPSUsers:output_type -> obiente.cloud.vps.v1.ListVPSUsersResponse
-	10, // 32: obiente.cloud.vps.v1.VPSConfigService.CreateVPSUser:output_type -> obiente.cloud.vps.v1.CreateVPSUserResponse
-	12, // 33: obiente.cloud.vps.v1.VPSConfigService.UpdateVPSUser:output_type -> obiente.cloud.vps.v1.UpdateVPSUserResponse
-	14, // 34: obiente.cloud.vps.v1.VPSConfigService.DeleteVPSUser:output_type -> obiente.cloud.vps.v1.DeleteVPSUserResponse
-	16, // 35: obiente.cloud.vps.v1.VPSConfigService.SetUserPassword:output_type -> obiente.cloud.vps.v1.SetUserPasswordResponse
-	18, // 36: obiente.cloud.vps.v1.VPSConfigService.UpdateUserSSHKeys:output_type -> obiente.cloud.vps.v1.UpdateUserSSHKeysResponse
-	20, // 37: obiente.cloud.vps.v1.VPSConfigService.RotateTerminalKey:output_type -> obiente.cloud.vps.v1.RotateTerminalKeyResponse
-	22, // 38: obiente.cloud.vps.v1.VPSConfigService.RemoveTerminalKey:output_type -> obiente.cloud.vps.v1.RemoveTerminalKeyResponse
-	24, // 39: obiente.cloud.vps.v1.VPSConfigService.GetTerminalKey:output_type -> obiente.cloud.vps.v1.GetTerminalKeyResponse
-	26, // 40: obiente.cloud.vps.v1.VPSConfigService.RotateBastionKey:output_type -> obiente.cloud.vps.v1.RotateBastionKeyResponse
-	28, // 41: obiente.cloud.vps.v1.VPSConfigService.GetBastionKey:output_type -> obiente.cloud.vps.v1.GetBastionKeyResponse
-	30, // 42: obiente.cloud.vps.v1.VPSConfigService.GetSSHAlias:output_type -> obiente.cloud.vps.v1.GetSSHAliasResponse
-	32, // 43: obiente.cloud.vps.v1.VPSConfigService.SetSSHAlias:output_type -> obiente.cloud.vps.v1.SetSSHAliasResponse
-	34, // 44: obiente.cloud.vps.v1.VPSConfigService.RemoveSSHAlias:output_type -> obiente.cloud.vps.v1.RemoveSSHAliasResponse
-	28, // [28:45] is the sub-list for method output_type
-	11, // [11:28] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	43, // 7: obiente.cloud.vps.v1.GetTerminalKeyResponse.created_at:type_name -> google.protobuf.Timestamp
+	43, // 8: obiente.cloud.vps.v1.GetTerminalKeyResponse.updated_at:type_name -> google.protobuf.Timestamp
+	43, // 9: obiente.cloud.vps.v1.GetBastionKeyResponse.created_at:type_name -> google.protobuf.Timestamp
+	43, // 10: obiente.cloud.vps.v1.GetBastionKeyResponse.updated_at:type_name -> google.protobuf.Timestamp
+	43, // 11: obiente.cloud.vps.v1.CloudInitTemplate.created_at:type_name -> google.protobuf.Timestamp
+	43, // 12: obiente.cloud.vps.v1.CloudInitTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	35, // 13: obiente.cloud.vps.v1.CreateCloudInitTemplateResponse.template:type_name -> obiente.cloud.vps.v1.CloudInitTemplate
+	35, // 14: obiente.cloud.vps.v1.GetCloudInitTemplateResponse.template:type_name -> obiente.cloud.vps.v1.CloudInitTemplate
+	0,  // 15: obiente.cloud.vps.v1.VPSConfigService.GetCloudInitConfig:input_type -> obiente.cloud.vps.v1.GetCloudInitConfigRequest
+	2,  // 16: obiente.cloud.vps.v1.VPSConfigService.GetCloudInitUserData:input_type -> obiente.cloud.vps.v1.GetCloudInitUserDataRequest
+	4,  // 17: obiente.cloud.vps.v1.VPSConfigService.UpdateCloudInitConfig:input_type -> obiente.cloud.vps.v1.UpdateCloudInitConfigRequest
+	6,  // 18: obiente.cloud.vps.v1.VPSConfigService.ListVPSUsers:input_type -> obiente.cloud.vps.v1.ListVPSUsersRequest
+	9,  // 19: obiente.cloud.vps.v1.VPSConfigService.CreateVPSUser:input_type -> obiente.cloud.vps.v1.CreateVPSUserRequest
+	11, // 20: obiente.cloud.vps.v1.VPSConfigService.UpdateVPSUser:input_type -> obiente.cloud.vps.v1.UpdateVPSUserRequest
+	13, // 21: obiente.cloud.vps.v1.VPSConfigService.DeleteVPSUser:input_type -> obiente.cloud.vps.v1.DeleteVPSUserRequest
+	15, // 22: obiente.cloud.vps.v1.VPSConfigService.SetUserPassword:input_type -> obiente.cloud.vps.v1.SetUserPasswordRequest
+	17, // 23: obiente.cloud.vps.v1.VPSConfigService.UpdateUserSSHKeys:input_type -> obiente.cloud.vps.v1.UpdateUserSSHKeysRequest
+	19, // 24: obiente.cloud.vps.v1.VPSConfigService.RotateTerminalKey:input_type -> obiente.cloud.vps.v1.RotateTerminalKeyRequest
+	21, // 25: obiente.cloud.vps.v1.VPSConfigService.RemoveTerminalKey:input_type -> obiente.cloud.vps.v1.RemoveTerminalKeyRequest
+	23, // 26: obiente.cloud.vps.v1.VPSConfigService.GetTerminalKey:input_type -> obiente.cloud.vps.v1.GetTerminalKeyRequest
+	25, // 27: obiente.cloud.vps.v1.VPSConfigService.RotateBastionKey:input_type -> obiente.cloud.vps.v1.RotateBastionKeyRequest
+	27, // 28: obiente.cloud.vps.v1.VPSConfigService.GetBastionKey:input_type -> obiente.cloud.vps.v1.GetBastionKeyRequest
+	29, // 29: obiente.cloud.vps.v1.VPSConfigService.GetSSHAlias:input_type -> obiente.cloud.vps.v1.GetSSHAliasRequest
+	31, // 30: obiente.cloud.vps.v1.VPSConfigService.SetSSHAlias:input_type -> obiente.cloud.vps.v1.SetSSHAliasRequest
+	33, // 31: obiente.cloud.vps.v1.VPSConfigService.RemoveSSHAlias:input_type -> obiente.cloud.vps.v1.RemoveSSHAliasRequest
+	36, // 32: obiente.cloud.vps.v1.VPSConfigService.CreateCloudInitTemplate:input_type -> obiente.cloud.vps.v1.CreateCloudInitTemplateRequest
+	38, // 33: obiente.cloud.vps.v1.VPSConfigService.GetCloudInitTemplate:input_type -> obiente.cloud.vps.v1.GetCloudInitTemplateRequest
+	40, // 34: obiente.cloud.vps.v1.VPSConfigService.DeleteCloudInitTemplate:input_type -> obiente.cloud.vps.v1.DeleteCloudInitTemplateRequest
+	1,  // 35: obiente.cloud.vps.v1.VPSConfigService.GetCloudInitConfig:output_type -> obiente.cloud.vps.v1.GetCloudInitConfigResponse
+	3,  // 36: obiente.cloud.vps.v1.VPSConfigService.GetCloudInitUserData:output_type -> obiente.cloud.vps.v1.GetCloudInitUserDataResponse
+	5,  // 37: obiente.cloud.vps.v1.VPSConfigService.UpdateCloudInitConfig:output_type -> obiente.cloud.vps.v1.UpdateCloudInitConfigResponse
+	7,  // 38: obiente.cloud.vps.v1.VPSConfigService.ListVPSUsers:output_type -> obiente.cloud.vps.v1.ListVPSUsersResponse
+	10, // 39: obiente.cloud.vps.v1.VPSConfigService.CreateVPSUser:output_type -> obiente.cloud.vps.v1.CreateVPSUserResponse
+	12, // 40: obiente.cloud.vps.v1.VPSConfigService.UpdateVPSUser:output_type -> obiente.cloud.vps.v1.UpdateVPSUserResponse
+	14, // 41: obiente.cloud.vps.v1.VPSConfigService.DeleteVPSUser:output_type -> obiente.cloud.vps.v1.DeleteVPSUserResponse
+	16, // 42: obiente.cloud.vps.v1.VPSConfigService.SetUserPassword:output_type -> obiente.cloud.vps.v1.SetUserPasswordResponse
+	18, // 43: obiente.cloud.vps.v1.VPSConfigService.UpdateUserSSHKeys:output_type -> obiente.cloud.vps.v1.UpdateUserSSHKeysResponse
+	20, // 44: obiente.cloud.vps.v1.VPSConfigService.RotateTerminalKey:output_type -> obiente.cloud.vps.v1.RotateTerminalKeyResponse
+	22, // 45: obiente.cloud.vps.v1.VPSConfigService.RemoveTerminalKey:output_type -> obiente.cloud.vps.v1.RemoveTerminalKeyResponse
+	24, // 46: obiente.cloud.vps.v1.VPSConfigService.GetTerminalKey:output_type -> obiente.cloud.vps.v1.GetTerminalKeyResponse
+	26, // 47: obiente.cloud.vps.v1.VPSConfigService.RotateBastionKey:output_type -> obiente.cloud.vps.v1.RotateBastionKeyResponse
+	28, // 48: obiente.cloud.vps.v1.VPSConfigService.GetBastionKey:output_type -> obiente.cloud.vps.v1.GetBastionKeyResponse
+	30, // 49: obiente.cloud.vps.v1.VPSConfigService.GetSSHAlias:output_type -> obiente.cloud.vps.v1.GetSSHAliasResponse
+	32, // 50: obiente.cloud.vps.v1.VPSConfigService.SetSSHAlias:output_type -> obiente.cloud.vps.v1.SetSSHAliasResponse
+	34, // 51: obiente.cloud.vps.v1.VPSConfigService.RemoveSSHAlias:output_type -> obiente.cloud.vps.v1.RemoveSSHAliasResponse
+	37, // 52: obiente.cloud.vps.v1.VPSConfigService.CreateCloudInitTemplate:output_type -> obiente.cloud.vps.v1.CreateCloudInitTemplateResponse
+	39, // 53: obiente.cloud.vps.v1.VPSConfigService.GetCloudInitTemplate:output_type -> obiente.cloud.vps.v1.GetCloudInitTemplateResponse
+	41, // 54: obiente.cloud.vps.v1.VPSConfigService.DeleteCloudInitTemplate:output_type -> obiente.cloud.vps.v1.DeleteCloudInitTemplateResponse
+	35, // [35:55] is the sub-list for method output_type
+	15, // [15:35] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_obiente_cloud_vps_v1_vps_config_service_proto_init() }
@@ -2389,7 +2825,7 @@ func file_obiente_cloud_vps_v1_vps_config_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_obiente_cloud_vps_v1_vps_config_service_proto_rawDesc), len(file_obiente_cloud_vps_v1_vps_config_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   35,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
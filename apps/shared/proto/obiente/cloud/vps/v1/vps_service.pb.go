@@ -39,6 +39,8 @@ const (
 	VPSStatus_DELETING               VPSStatus = 8  // VPS is being deleted
 	VPSStatus_DELETED                VPSStatus = 9  // VPS has been deleted (soft delete)
 	VPSStatus_SUSPENDED              VPSStatus = 10 // VPS is suspended (superadmin action, prevents normal operations)
+	VPSStatus_UNRESPONSIVE           VPSStatus = 11 // VM is running but the guest OS stopped answering guest agent pings
+	VPSStatus_TERMINATED             VPSStatus = 12 // VM was converted into a VPS template and no longer exists as a VPS
 )
 
 // Enum value maps for VPSStatus.
@@ -55,6 +57,8 @@ var (
 		8:  "DELETING",
 		9:  "DELETED",
 		10: "SUSPENDED",
+		11: "UNRESPONSIVE",
+		12: "TERMINATED",
 	}
 	VPSStatus_value = map[string]int32{
 		"VPS_STATUS_UNSPECIFIED": 0,
@@ -68,6 +72,8 @@ var (
 		"DELETING":               8,
 		"DELETED":                9,
 		"SUSPENDED":              10,
+		"UNRESPONSIVE":           11,
+		"TERMINATED":             12,
 	}
 )
 
@@ -457,7 +463,18 @@ type CreateVPSRequest struct {
 	// Cloud-init configuration
 	CloudInit *CloudInitConfig `protobuf:"bytes,10,opt,name=cloud_init,json=cloudInit,proto3,oneof" json:"cloud_init,omitempty"`
 	// Root password configuration (if not set, password will be auto-generated)
-	RootPassword  *string `protobuf:"bytes,11,opt,name=root_password,json=rootPassword,proto3,oneof" json:"root_password,omitempty"` // Custom root password (optional, auto-generated if not provided)
+	RootPassword *string `protobuf:"bytes,11,opt,name=root_password,json=rootPassword,proto3,oneof" json:"root_password,omitempty"` // Custom root password (optional, auto-generated if not provided)
+	// Cloud-init template to render and merge into the generated userData (see VPSConfigService.CreateCloudInitTemplate)
+	CloudInitTemplateId *string `protobuf:"bytes,12,opt,name=cloud_init_template_id,json=cloudInitTemplateId,proto3,oneof" json:"cloud_init_template_id,omitempty"`
+	// VPS template to clone the VPS from instead of the OS image (see CreateVPSTemplate)
+	TemplateId *string `protobuf:"bytes,13,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	// Static IP configuration (replaces DHCP). The IP must be inside a subnet allocated to the
+	// organization (see SuperadminService.AllocateStaticIP).
+	StaticIp   *string  `protobuf:"bytes,14,opt,name=static_ip,json=staticIp,proto3,oneof" json:"static_ip,omitempty"` // e.g. "10.20.0.10"
+	Gateway    *string  `protobuf:"bytes,15,opt,name=gateway,proto3,oneof" json:"gateway,omitempty"`                   // Defaults to the gateway of the allocated subnet
+	DnsServers []string `protobuf:"bytes,16,rep,name=dns_servers,json=dnsServers,proto3" json:"dns_servers,omitempty"` // Defaults to the DNS servers of the allocated subnet
+	// Host GPU to pass through to the VPS. The VPS uses the q35 machine type.
+	Gpu           *GPUConfig `protobuf:"bytes,17,opt,name=gpu,proto3,oneof" json:"gpu,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -569,6 +586,101 @@ func (x *CreateVPSRequest) GetRootPassword() string {
 	return ""
 }
 
+func (x *CreateVPSRequest) GetCloudInitTemplateId() string {
+	if x != nil && x.CloudInitTemplateId != nil {
+		return *x.CloudInitTemplateId
+	}
+	return ""
+}
+
+func (x *CreateVPSRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateVPSRequest) GetStaticIp() string {
+	if x != nil && x.StaticIp != nil {
+		return *x.StaticIp
+	}
+	return ""
+}
+
+func (x *CreateVPSRequest) GetGateway() string {
+	if x != nil && x.Gateway != nil {
+		return *x.Gateway
+	}
+	return ""
+}
+
+func (x *CreateVPSRequest) GetDnsServers() []string {
+	if x != nil {
+		return x.DnsServers
+	}
+	return nil
+}
+
+func (x *CreateVPSRequest) GetGpu() *GPUConfig {
+	if x != nil {
+		return x.Gpu
+	}
+	return nil
+}
+
+// GPUConfig selects a host GPU to pass through to a VPS
+type GPUConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PciId         string                 `protobuf:"bytes,1,opt,name=pci_id,json=pciId,proto3" json:"pci_id,omitempty"` // Host PCI address of the GPU (e.g. "0000:01:00.0")
+	Driver        *string                `protobuf:"bytes,2,opt,name=driver,proto3,oneof" json:"driver,omitempty"`      // "vfio-pci" (default, secondary device) or "passthrough" (primary display)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GPUConfig) Reset() {
+	*x = GPUConfig{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GPUConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUConfig) ProtoMessage() {}
+
+func (x *GPUConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUConfig.ProtoReflect.Descriptor instead.
+func (*GPUConfig) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GPUConfig) GetPciId() string {
+	if x != nil {
+		return x.PciId
+	}
+	return ""
+}
+
+func (x *GPUConfig) GetDriver() string {
+	if x != nil && x.Driver != nil {
+		return *x.Driver
+	}
+	return ""
+}
+
 // CloudInitConfig contains cloud-init configuration options
 type CloudInitConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -595,7 +707,7 @@ type CloudInitConfig struct {
 
 func (x *CloudInitConfig) Reset() {
 	*x = CloudInitConfig{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[3]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -607,7 +719,7 @@ func (x *CloudInitConfig) String() string {
 func (*CloudInitConfig) ProtoMessage() {}
 
 func (x *CloudInitConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[3]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,7 +732,7 @@ func (x *CloudInitConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloudInitConfig.ProtoReflect.Descriptor instead.
 func (*CloudInitConfig) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{3}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CloudInitConfig) GetUsers() []*CloudInitUser {
@@ -718,7 +830,7 @@ type CloudInitUser struct {
 
 func (x *CloudInitUser) Reset() {
 	*x = CloudInitUser{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[4]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -730,7 +842,7 @@ func (x *CloudInitUser) String() string {
 func (*CloudInitUser) ProtoMessage() {}
 
 func (x *CloudInitUser) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[4]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +855,7 @@ func (x *CloudInitUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloudInitUser.ProtoReflect.Descriptor instead.
 func (*CloudInitUser) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{4}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CloudInitUser) GetName() string {
@@ -824,7 +936,7 @@ type CloudInitWriteFile struct {
 
 func (x *CloudInitWriteFile) Reset() {
 	*x = CloudInitWriteFile{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[5]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -836,7 +948,7 @@ func (x *CloudInitWriteFile) String() string {
 func (*CloudInitWriteFile) ProtoMessage() {}
 
 func (x *CloudInitWriteFile) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[5]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,7 +961,7 @@ func (x *CloudInitWriteFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloudInitWriteFile.ProtoReflect.Descriptor instead.
 func (*CloudInitWriteFile) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{5}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CloudInitWriteFile) GetPath() string {
@@ -903,7 +1015,7 @@ type CreateVPSResponse struct {
 
 func (x *CreateVPSResponse) Reset() {
 	*x = CreateVPSResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[6]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -915,7 +1027,7 @@ func (x *CreateVPSResponse) String() string {
 func (*CreateVPSResponse) ProtoMessage() {}
 
 func (x *CreateVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[6]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,7 +1040,7 @@ func (x *CreateVPSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVPSResponse.ProtoReflect.Descriptor instead.
 func (*CreateVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{6}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateVPSResponse) GetVps() *VPSInstance {
@@ -948,7 +1060,7 @@ type GetVPSRequest struct {
 
 func (x *GetVPSRequest) Reset() {
 	*x = GetVPSRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[7]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -960,7 +1072,7 @@ func (x *GetVPSRequest) String() string {
 func (*GetVPSRequest) ProtoMessage() {}
 
 func (x *GetVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[7]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,7 +1085,7 @@ func (x *GetVPSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVPSRequest.ProtoReflect.Descriptor instead.
 func (*GetVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{7}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetVPSRequest) GetOrganizationId() string {
@@ -999,7 +1111,7 @@ type GetVPSResponse struct {
 
 func (x *GetVPSResponse) Reset() {
 	*x = GetVPSResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[8]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1011,7 +1123,7 @@ func (x *GetVPSResponse) String() string {
 func (*GetVPSResponse) ProtoMessage() {}
 
 func (x *GetVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[8]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,7 +1136,7 @@ func (x *GetVPSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVPSResponse.ProtoReflect.Descriptor instead.
 func (*GetVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{8}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetVPSResponse) GetVps() *VPSInstance {
@@ -1047,7 +1159,7 @@ type UpdateVPSRequest struct {
 
 func (x *UpdateVPSRequest) Reset() {
 	*x = UpdateVPSRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[9]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1059,7 +1171,7 @@ func (x *UpdateVPSRequest) String() string {
 func (*UpdateVPSRequest) ProtoMessage() {}
 
 func (x *UpdateVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[9]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +1184,7 @@ func (x *UpdateVPSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVPSRequest.ProtoReflect.Descriptor instead.
 func (*UpdateVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{9}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateVPSRequest) GetOrganizationId() string {
@@ -1119,7 +1231,7 @@ type UpdateVPSResponse struct {
 
 func (x *UpdateVPSResponse) Reset() {
 	*x = UpdateVPSResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[10]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1131,7 +1243,7 @@ func (x *UpdateVPSResponse) String() string {
 func (*UpdateVPSResponse) ProtoMessage() {}
 
 func (x *UpdateVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[10]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,7 +1256,7 @@ func (x *UpdateVPSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVPSResponse.ProtoReflect.Descriptor instead.
 func (*UpdateVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{10}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateVPSResponse) GetVps() *VPSInstance {
@@ -1165,7 +1277,7 @@ type DeleteVPSRequest struct {
 
 func (x *DeleteVPSRequest) Reset() {
 	*x = DeleteVPSRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[11]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1177,7 +1289,7 @@ func (x *DeleteVPSRequest) String() string {
 func (*DeleteVPSRequest) ProtoMessage() {}
 
 func (x *DeleteVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[11]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,7 +1302,7 @@ func (x *DeleteVPSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVPSRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{11}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteVPSRequest) GetOrganizationId() string {
@@ -1223,7 +1335,7 @@ type DeleteVPSResponse struct {
 
 func (x *DeleteVPSResponse) Reset() {
 	*x = DeleteVPSResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[12]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1235,7 +1347,7 @@ func (x *DeleteVPSResponse) String() string {
 func (*DeleteVPSResponse) ProtoMessage() {}
 
 func (x *DeleteVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[12]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,7 +1360,7 @@ func (x *DeleteVPSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVPSResponse.ProtoReflect.Descriptor instead.
 func (*DeleteVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{12}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteVPSResponse) GetSuccess() bool {
@@ -1268,7 +1380,7 @@ type StartVPSRequest struct {
 
 func (x *StartVPSRequest) Reset() {
 	*x = StartVPSRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[13]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1280,7 +1392,7 @@ func (x *StartVPSRequest) String() string {
 func (*StartVPSRequest) ProtoMessage() {}
 
 func (x *StartVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[13]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,7 +1405,7 @@ func (x *StartVPSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartVPSRequest.ProtoReflect.Descriptor instead.
 func (*StartVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{13}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StartVPSRequest) GetOrganizationId() string {
@@ -1319,7 +1431,7 @@ type StartVPSResponse struct {
 
 func (x *StartVPSResponse) Reset() {
 	*x = StartVPSResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[14]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1331,7 +1443,7 @@ func (x *StartVPSResponse) String() string {
 func (*StartVPSResponse) ProtoMessage() {}
 
 func (x *StartVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[14]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1344,7 +1456,7 @@ func (x *StartVPSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartVPSResponse.ProtoReflect.Descriptor instead.
 func (*StartVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{14}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *StartVPSResponse) GetVps() *VPSInstance {
@@ -1364,7 +1476,7 @@ type StopVPSRequest struct {
 
 func (x *StopVPSRequest) Reset() {
 	*x = StopVPSRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[15]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1376,7 +1488,7 @@ func (x *StopVPSRequest) String() string {
 func (*StopVPSRequest) ProtoMessage() {}
 
 func (x *StopVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[15]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,7 +1501,7 @@ func (x *StopVPSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopVPSRequest.ProtoReflect.Descriptor instead.
 func (*StopVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{15}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *StopVPSRequest) GetOrganizationId() string {
@@ -1415,7 +1527,7 @@ type StopVPSResponse struct {
 
 func (x *StopVPSResponse) Reset() {
 	*x = StopVPSResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[16]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1427,7 +1539,7 @@ func (x *StopVPSResponse) String() string {
 func (*StopVPSResponse) ProtoMessage() {}
 
 func (x *StopVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[16]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1440,7 +1552,7 @@ func (x *StopVPSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopVPSResponse.ProtoReflect.Descriptor instead.
 func (*StopVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{16}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *StopVPSResponse) GetVps() *VPSInstance {
@@ -1460,7 +1572,7 @@ type RebootVPSRequest struct {
 
 func (x *RebootVPSRequest) Reset() {
 	*x = RebootVPSRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[17]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1472,7 +1584,7 @@ func (x *RebootVPSRequest) String() string {
 func (*RebootVPSRequest) ProtoMessage() {}
 
 func (x *RebootVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[17]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1485,7 +1597,7 @@ func (x *RebootVPSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RebootVPSRequest.ProtoReflect.Descriptor instead.
 func (*RebootVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{17}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RebootVPSRequest) GetOrganizationId() string {
@@ -1511,7 +1623,7 @@ type RebootVPSResponse struct {
 
 func (x *RebootVPSResponse) Reset() {
 	*x = RebootVPSResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[18]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1523,7 +1635,7 @@ func (x *RebootVPSResponse) String() string {
 func (*RebootVPSResponse) ProtoMessage() {}
 
 func (x *RebootVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[18]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,7 +1648,7 @@ func (x *RebootVPSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RebootVPSResponse.ProtoReflect.Descriptor instead.
 func (*RebootVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{18}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RebootVPSResponse) GetVps() *VPSInstance {
@@ -1546,29 +1658,30 @@ func (x *RebootVPSResponse) GetVps() *VPSInstance {
 	return nil
 }
 
-type StreamVPSStatusRequest struct {
+type PatchVPSRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	VpsId          string                 `protobuf:"bytes,2,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
+	RebootAfter    bool                   `protobuf:"varint,3,opt,name=reboot_after,json=rebootAfter,proto3" json:"reboot_after,omitempty"` // Reboot the VPS once the packages are upgraded (e.g. to load a new kernel)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *StreamVPSStatusRequest) Reset() {
-	*x = StreamVPSStatusRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[19]
+func (x *PatchVPSRequest) Reset() {
+	*x = PatchVPSRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamVPSStatusRequest) String() string {
+func (x *PatchVPSRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamVPSStatusRequest) ProtoMessage() {}
+func (*PatchVPSRequest) ProtoMessage() {}
 
-func (x *StreamVPSStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[19]
+func (x *PatchVPSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1579,50 +1692,54 @@ func (x *StreamVPSStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamVPSStatusRequest.ProtoReflect.Descriptor instead.
-func (*StreamVPSStatusRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use PatchVPSRequest.ProtoReflect.Descriptor instead.
+func (*PatchVPSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *StreamVPSStatusRequest) GetOrganizationId() string {
+func (x *PatchVPSRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *StreamVPSStatusRequest) GetVpsId() string {
+func (x *PatchVPSRequest) GetVpsId() string {
 	if x != nil {
 		return x.VpsId
 	}
 	return ""
 }
 
-type VPSStatusUpdate struct {
+func (x *PatchVPSRequest) GetRebootAfter() bool {
+	if x != nil {
+		return x.RebootAfter
+	}
+	return false
+}
+
+type PatchVPSResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
-	Status        VPSStatus              `protobuf:"varint,2,opt,name=status,proto3,enum=obiente.cloud.vps.v1.VPSStatus" json:"status,omitempty"`
-	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PatchId       string                 `protobuf:"bytes,1,opt,name=patch_id,json=patchId,proto3" json:"patch_id,omitempty"` // ID of the patch run in the VPS patch history
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VPSStatusUpdate) Reset() {
-	*x = VPSStatusUpdate{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[20]
+func (x *PatchVPSResponse) Reset() {
+	*x = PatchVPSResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VPSStatusUpdate) String() string {
+func (x *PatchVPSResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VPSStatusUpdate) ProtoMessage() {}
+func (*PatchVPSResponse) ProtoMessage() {}
 
-func (x *VPSStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[20]
+func (x *PatchVPSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,66 +1750,41 @@ func (x *VPSStatusUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VPSStatusUpdate.ProtoReflect.Descriptor instead.
-func (*VPSStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *VPSStatusUpdate) GetVpsId() string {
-	if x != nil {
-		return x.VpsId
-	}
-	return ""
+// Deprecated: Use PatchVPSResponse.ProtoReflect.Descriptor instead.
+func (*PatchVPSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *VPSStatusUpdate) GetStatus() VPSStatus {
+func (x *PatchVPSResponse) GetPatchId() string {
 	if x != nil {
-		return x.Status
-	}
-	return VPSStatus_VPS_STATUS_UNSPECIFIED
-}
-
-func (x *VPSStatusUpdate) GetMessage() string {
-	if x != nil && x.Message != nil {
-		return *x.Message
+		return x.PatchId
 	}
 	return ""
 }
 
-func (x *VPSStatusUpdate) GetTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Timestamp
-	}
-	return nil
-}
-
-type GetVPSMetricsRequest struct {
+type ForceStopVPSRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	VpsId          string                 `protobuf:"bytes,2,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
-	StartTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// Optional: aggregation interval (e.g., "1m", "5m", "1h")
-	Interval      *string `protobuf:"bytes,5,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetVPSMetricsRequest) Reset() {
-	*x = GetVPSMetricsRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[21]
+func (x *ForceStopVPSRequest) Reset() {
+	*x = ForceStopVPSRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVPSMetricsRequest) String() string {
+func (x *ForceStopVPSRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVPSMetricsRequest) ProtoMessage() {}
+func (*ForceStopVPSRequest) ProtoMessage() {}
 
-func (x *GetVPSMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[21]
+func (x *ForceStopVPSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1703,30 +1795,250 @@ func (x *GetVPSMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVPSMetricsRequest.ProtoReflect.Descriptor instead.
-func (*GetVPSMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ForceStopVPSRequest.ProtoReflect.Descriptor instead.
+func (*ForceStopVPSRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetVPSMetricsRequest) GetOrganizationId() string {
+func (x *ForceStopVPSRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *GetVPSMetricsRequest) GetVpsId() string {
+func (x *ForceStopVPSRequest) GetVpsId() string {
 	if x != nil {
 		return x.VpsId
 	}
 	return ""
 }
 
-func (x *GetVPSMetricsRequest) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+type ForceStopVPSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vps           *VPSInstance           `protobuf:"bytes,1,opt,name=vps,proto3" json:"vps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceStopVPSResponse) Reset() {
+	*x = ForceStopVPSResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceStopVPSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceStopVPSResponse) ProtoMessage() {}
+
+func (x *ForceStopVPSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceStopVPSResponse.ProtoReflect.Descriptor instead.
+func (*ForceStopVPSResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ForceStopVPSResponse) GetVps() *VPSInstance {
+	if x != nil {
+		return x.Vps
+	}
+	return nil
+}
+
+type StreamVPSStatusRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	VpsId          string                 `protobuf:"bytes,2,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamVPSStatusRequest) Reset() {
+	*x = StreamVPSStatusRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamVPSStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamVPSStatusRequest) ProtoMessage() {}
+
+func (x *StreamVPSStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamVPSStatusRequest.ProtoReflect.Descriptor instead.
+func (*StreamVPSStatusRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StreamVPSStatusRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *StreamVPSStatusRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+type VPSStatusUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VpsId         string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
+	Status        VPSStatus              `protobuf:"varint,2,opt,name=status,proto3,enum=obiente.cloud.vps.v1.VPSStatus" json:"status,omitempty"`
+	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VPSStatusUpdate) Reset() {
+	*x = VPSStatusUpdate{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VPSStatusUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPSStatusUpdate) ProtoMessage() {}
+
+func (x *VPSStatusUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VPSStatusUpdate.ProtoReflect.Descriptor instead.
+func (*VPSStatusUpdate) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VPSStatusUpdate) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+func (x *VPSStatusUpdate) GetStatus() VPSStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VPSStatus_VPS_STATUS_UNSPECIFIED
+}
+
+func (x *VPSStatusUpdate) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *VPSStatusUpdate) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type GetVPSMetricsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	VpsId          string                 `protobuf:"bytes,2,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Optional: aggregation interval (e.g., "1m", "5m", "1h")
+	Interval      *string `protobuf:"bytes,5,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVPSMetricsRequest) Reset() {
+	*x = GetVPSMetricsRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVPSMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVPSMetricsRequest) ProtoMessage() {}
+
+func (x *GetVPSMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVPSMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetVPSMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetVPSMetricsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetVPSMetricsRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+func (x *GetVPSMetricsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
 func (x *GetVPSMetricsRequest) GetEndTime() *timestamppb.Timestamp {
@@ -1752,7 +2064,7 @@ type GetVPSMetricsResponse struct {
 
 func (x *GetVPSMetricsResponse) Reset() {
 	*x = GetVPSMetricsResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[22]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1764,7 +2076,7 @@ func (x *GetVPSMetricsResponse) String() string {
 func (*GetVPSMetricsResponse) ProtoMessage() {}
 
 func (x *GetVPSMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[22]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1777,7 +2089,7 @@ func (x *GetVPSMetricsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVPSMetricsResponse.ProtoReflect.Descriptor instead.
 func (*GetVPSMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{22}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetVPSMetricsResponse) GetMetrics() []*VPSMetric {
@@ -1797,7 +2109,7 @@ type StreamVPSMetricsRequest struct {
 
 func (x *StreamVPSMetricsRequest) Reset() {
 	*x = StreamVPSMetricsRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[23]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1809,7 +2121,7 @@ func (x *StreamVPSMetricsRequest) String() string {
 func (*StreamVPSMetricsRequest) ProtoMessage() {}
 
 func (x *StreamVPSMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[23]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,7 +2134,7 @@ func (x *StreamVPSMetricsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamVPSMetricsRequest.ProtoReflect.Descriptor instead.
 func (*StreamVPSMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{23}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *StreamVPSMetricsRequest) GetOrganizationId() string {
@@ -1858,7 +2170,7 @@ type VPSMetric struct {
 
 func (x *VPSMetric) Reset() {
 	*x = VPSMetric{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[24]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1870,7 +2182,7 @@ func (x *VPSMetric) String() string {
 func (*VPSMetric) ProtoMessage() {}
 
 func (x *VPSMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[24]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1883,7 +2195,7 @@ func (x *VPSMetric) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VPSMetric.ProtoReflect.Descriptor instead.
 func (*VPSMetric) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{24}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *VPSMetric) GetVpsId() string {
@@ -1975,7 +2287,7 @@ type GetVPSUsageRequest struct {
 
 func (x *GetVPSUsageRequest) Reset() {
 	*x = GetVPSUsageRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[25]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1987,7 +2299,7 @@ func (x *GetVPSUsageRequest) String() string {
 func (*GetVPSUsageRequest) ProtoMessage() {}
 
 func (x *GetVPSUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[25]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2000,7 +2312,7 @@ func (x *GetVPSUsageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVPSUsageRequest.ProtoReflect.Descriptor instead.
 func (*GetVPSUsageRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{25}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetVPSUsageRequest) GetOrganizationId() string {
@@ -2036,7 +2348,7 @@ type FindVPSByLeaseRequest struct {
 
 func (x *FindVPSByLeaseRequest) Reset() {
 	*x = FindVPSByLeaseRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[26]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2048,7 +2360,7 @@ func (x *FindVPSByLeaseRequest) String() string {
 func (*FindVPSByLeaseRequest) ProtoMessage() {}
 
 func (x *FindVPSByLeaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[26]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2061,7 +2373,7 @@ func (x *FindVPSByLeaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindVPSByLeaseRequest.ProtoReflect.Descriptor instead.
 func (*FindVPSByLeaseRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{26}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *FindVPSByLeaseRequest) GetIp() string {
@@ -2084,13 +2396,16 @@ type FindVPSByLeaseResponse struct {
 	VpsId string `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
 	// Organization ID owning the VPS (if any)
 	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Bandwidth limits the gateway enforces for this lease (Mbit/s, 0 = unlimited)
+	MaxMbitDown   int32 `protobuf:"varint,3,opt,name=max_mbit_down,json=maxMbitDown,proto3" json:"max_mbit_down,omitempty"`
+	MaxMbitUp     int32 `protobuf:"varint,4,opt,name=max_mbit_up,json=maxMbitUp,proto3" json:"max_mbit_up,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FindVPSByLeaseResponse) Reset() {
 	*x = FindVPSByLeaseResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[27]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2102,7 +2417,7 @@ func (x *FindVPSByLeaseResponse) String() string {
 func (*FindVPSByLeaseResponse) ProtoMessage() {}
 
 func (x *FindVPSByLeaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[27]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2115,7 +2430,7 @@ func (x *FindVPSByLeaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindVPSByLeaseResponse.ProtoReflect.Descriptor instead.
 func (*FindVPSByLeaseResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{27}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *FindVPSByLeaseResponse) GetVpsId() string {
@@ -2132,10 +2447,24 @@ func (x *FindVPSByLeaseResponse) GetOrganizationId() string {
 	return ""
 }
 
-type GetVPSUsageResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	VpsId            string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
-	Month            string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM format
+func (x *FindVPSByLeaseResponse) GetMaxMbitDown() int32 {
+	if x != nil {
+		return x.MaxMbitDown
+	}
+	return 0
+}
+
+func (x *FindVPSByLeaseResponse) GetMaxMbitUp() int32 {
+	if x != nil {
+		return x.MaxMbitUp
+	}
+	return 0
+}
+
+type GetVPSUsageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VpsId            string                 `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
+	Month            string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM format
 	Current          *VPSUsageMetrics       `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
 	EstimatedMonthly *VPSUsageMetrics       `protobuf:"bytes,4,opt,name=estimated_monthly,json=estimatedMonthly,proto3" json:"estimated_monthly,omitempty"`
 	// Estimated cost in cents (e.g., 2347 = $23.47)
@@ -2146,7 +2475,7 @@ type GetVPSUsageResponse struct {
 
 func (x *GetVPSUsageResponse) Reset() {
 	*x = GetVPSUsageResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[28]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2158,7 +2487,7 @@ func (x *GetVPSUsageResponse) String() string {
 func (*GetVPSUsageResponse) ProtoMessage() {}
 
 func (x *GetVPSUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[28]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2171,7 +2500,7 @@ func (x *GetVPSUsageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVPSUsageResponse.ProtoReflect.Descriptor instead.
 func (*GetVPSUsageResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{28}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetVPSUsageResponse) GetVpsId() string {
@@ -2230,7 +2559,7 @@ type VPSUsageMetrics struct {
 
 func (x *VPSUsageMetrics) Reset() {
 	*x = VPSUsageMetrics{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[29]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2242,7 +2571,7 @@ func (x *VPSUsageMetrics) String() string {
 func (*VPSUsageMetrics) ProtoMessage() {}
 
 func (x *VPSUsageMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[29]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2255,7 +2584,7 @@ func (x *VPSUsageMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VPSUsageMetrics.ProtoReflect.Descriptor instead.
 func (*VPSUsageMetrics) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{29}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *VPSUsageMetrics) GetCpuCoreSeconds() int64 {
@@ -2344,7 +2673,7 @@ type ListAvailableVPSSizesRequest struct {
 
 func (x *ListAvailableVPSSizesRequest) Reset() {
 	*x = ListAvailableVPSSizesRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[30]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2356,7 +2685,7 @@ func (x *ListAvailableVPSSizesRequest) String() string {
 func (*ListAvailableVPSSizesRequest) ProtoMessage() {}
 
 func (x *ListAvailableVPSSizesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[30]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,7 +2698,7 @@ func (x *ListAvailableVPSSizesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAvailableVPSSizesRequest.ProtoReflect.Descriptor instead.
 func (*ListAvailableVPSSizesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{30}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListAvailableVPSSizesRequest) GetRegion() string {
@@ -2388,7 +2717,7 @@ type ListAvailableVPSSizesResponse struct {
 
 func (x *ListAvailableVPSSizesResponse) Reset() {
 	*x = ListAvailableVPSSizesResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[31]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2400,7 +2729,7 @@ func (x *ListAvailableVPSSizesResponse) String() string {
 func (*ListAvailableVPSSizesResponse) ProtoMessage() {}
 
 func (x *ListAvailableVPSSizesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[31]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2413,7 +2742,7 @@ func (x *ListAvailableVPSSizesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAvailableVPSSizesResponse.ProtoReflect.Descriptor instead.
 func (*ListAvailableVPSSizesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{31}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ListAvailableVPSSizesResponse) GetSizes() []*v1.VPSSize {
@@ -2431,7 +2760,7 @@ type ListVPSRegionsRequest struct {
 
 func (x *ListVPSRegionsRequest) Reset() {
 	*x = ListVPSRegionsRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[32]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2443,7 +2772,7 @@ func (x *ListVPSRegionsRequest) String() string {
 func (*ListVPSRegionsRequest) ProtoMessage() {}
 
 func (x *ListVPSRegionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[32]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2456,7 +2785,7 @@ func (x *ListVPSRegionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVPSRegionsRequest.ProtoReflect.Descriptor instead.
 func (*ListVPSRegionsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{32}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{37}
 }
 
 type ListVPSRegionsResponse struct {
@@ -2468,7 +2797,7 @@ type ListVPSRegionsResponse struct {
 
 func (x *ListVPSRegionsResponse) Reset() {
 	*x = ListVPSRegionsResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[33]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2480,7 +2809,7 @@ func (x *ListVPSRegionsResponse) String() string {
 func (*ListVPSRegionsResponse) ProtoMessage() {}
 
 func (x *ListVPSRegionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[33]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2493,7 +2822,7 @@ func (x *ListVPSRegionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVPSRegionsResponse.ProtoReflect.Descriptor instead.
 func (*ListVPSRegionsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{33}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListVPSRegionsResponse) GetRegions() []*VPSRegion {
@@ -2513,7 +2842,7 @@ type GetVPSProxyInfoRequest struct {
 
 func (x *GetVPSProxyInfoRequest) Reset() {
 	*x = GetVPSProxyInfoRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[34]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2525,7 +2854,7 @@ func (x *GetVPSProxyInfoRequest) String() string {
 func (*GetVPSProxyInfoRequest) ProtoMessage() {}
 
 func (x *GetVPSProxyInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[34]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2538,7 +2867,7 @@ func (x *GetVPSProxyInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVPSProxyInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetVPSProxyInfoRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{34}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetVPSProxyInfoRequest) GetOrganizationId() string {
@@ -2572,7 +2901,7 @@ type GetVPSProxyInfoResponse struct {
 
 func (x *GetVPSProxyInfoResponse) Reset() {
 	*x = GetVPSProxyInfoResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[35]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2584,7 +2913,7 @@ func (x *GetVPSProxyInfoResponse) String() string {
 func (*GetVPSProxyInfoResponse) ProtoMessage() {}
 
 func (x *GetVPSProxyInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[35]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2597,7 +2926,7 @@ func (x *GetVPSProxyInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVPSProxyInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetVPSProxyInfoResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{35}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetVPSProxyInfoResponse) GetVpsId() string {
@@ -2635,6 +2964,237 @@ func (x *GetVPSProxyInfoResponse) GetConnectionInstructions() string {
 	return ""
 }
 
+type GetVPSConsoleURLRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	VpsId          string                 `protobuf:"bytes,2,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetVPSConsoleURLRequest) Reset() {
+	*x = GetVPSConsoleURLRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVPSConsoleURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVPSConsoleURLRequest) ProtoMessage() {}
+
+func (x *GetVPSConsoleURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVPSConsoleURLRequest.ProtoReflect.Descriptor instead.
+func (*GetVPSConsoleURLRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetVPSConsoleURLRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetVPSConsoleURLRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+type GetVPSConsoleURLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// noVNC-compatible WebSocket URL (e.g., wss://api.obiente.cloud/vps/{vps_id}/console?token=...)
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// VNC password to send during the RFB handshake
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// The console must be opened before this time; the URL can only be used once
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVPSConsoleURLResponse) Reset() {
+	*x = GetVPSConsoleURLResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVPSConsoleURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVPSConsoleURLResponse) ProtoMessage() {}
+
+func (x *GetVPSConsoleURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVPSConsoleURLResponse.ProtoReflect.Descriptor instead.
+func (*GetVPSConsoleURLResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetVPSConsoleURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetVPSConsoleURLResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetVPSConsoleURLResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type GetVPSVNCSessionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	VpsId          string                 `protobuf:"bytes,2,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetVPSVNCSessionRequest) Reset() {
+	*x = GetVPSVNCSessionRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVPSVNCSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVPSVNCSessionRequest) ProtoMessage() {}
+
+func (x *GetVPSVNCSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVPSVNCSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetVPSVNCSessionRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetVPSVNCSessionRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetVPSVNCSessionRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+type GetVPSVNCSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// noVNC-compatible WebSocket URL (e.g., wss://api.obiente.cloud/vps/{vps_id}/vnc?token=...)
+	// The URL can be reconnected to; no VNC password is needed
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The session ends after this long without an open connection (e.g. once the browser tab is closed)
+	IdleTimeoutSeconds int32 `protobuf:"varint,2,opt,name=idle_timeout_seconds,json=idleTimeoutSeconds,proto3" json:"idle_timeout_seconds,omitempty"`
+	// The session ends at this time at the latest, when the token it was requested with expires
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVPSVNCSessionResponse) Reset() {
+	*x = GetVPSVNCSessionResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVPSVNCSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVPSVNCSessionResponse) ProtoMessage() {}
+
+func (x *GetVPSVNCSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVPSVNCSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetVPSVNCSessionResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetVPSVNCSessionResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetVPSVNCSessionResponse) GetIdleTimeoutSeconds() int32 {
+	if x != nil {
+		return x.IdleTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *GetVPSVNCSessionResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type VPSRegion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                // Region ID (e.g., "nbg1", "nyc1")
@@ -2648,7 +3208,7 @@ type VPSRegion struct {
 
 func (x *VPSRegion) Reset() {
 	*x = VPSRegion{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[36]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2660,7 +3220,7 @@ func (x *VPSRegion) String() string {
 func (*VPSRegion) ProtoMessage() {}
 
 func (x *VPSRegion) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[36]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2673,7 +3233,7 @@ func (x *VPSRegion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VPSRegion.ProtoReflect.Descriptor instead.
 func (*VPSRegion) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{36}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *VPSRegion) GetId() string {
@@ -2745,14 +3305,15 @@ type VPSInstance struct {
 	OrganizationId string `protobuf:"bytes,23,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	CreatedBy      string `protobuf:"bytes,24,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	// Current resource usage (if available)
-	CurrentMetrics *VPSMetric `protobuf:"bytes,25,opt,name=current_metrics,json=currentMetrics,proto3,oneof" json:"current_metrics,omitempty"`
+	CurrentMetrics *VPSMetric             `protobuf:"bytes,25,opt,name=current_metrics,json=currentMetrics,proto3,oneof" json:"current_metrics,omitempty"`
+	LastPatchedAt  *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=last_patched_at,json=lastPatchedAt,proto3,oneof" json:"last_patched_at,omitempty"` // Last successful OS patch run (PatchVPS)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *VPSInstance) Reset() {
 	*x = VPSInstance{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[37]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2764,7 +3325,7 @@ func (x *VPSInstance) String() string {
 func (*VPSInstance) ProtoMessage() {}
 
 func (x *VPSInstance) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[37]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2777,7 +3338,7 @@ func (x *VPSInstance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VPSInstance.ProtoReflect.Descriptor instead.
 func (*VPSInstance) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{37}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *VPSInstance) GetId() string {
@@ -2955,6 +3516,13 @@ func (x *VPSInstance) GetCurrentMetrics() *VPSMetric {
 	return nil
 }
 
+func (x *VPSInstance) GetLastPatchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPatchedAt
+	}
+	return nil
+}
+
 // Firewall rule management messages
 type ListFirewallRulesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -2966,7 +3534,7 @@ type ListFirewallRulesRequest struct {
 
 func (x *ListFirewallRulesRequest) Reset() {
 	*x = ListFirewallRulesRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[38]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2978,7 +3546,7 @@ func (x *ListFirewallRulesRequest) String() string {
 func (*ListFirewallRulesRequest) ProtoMessage() {}
 
 func (x *ListFirewallRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[38]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2991,7 +3559,7 @@ func (x *ListFirewallRulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFirewallRulesRequest.ProtoReflect.Descriptor instead.
 func (*ListFirewallRulesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{38}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ListFirewallRulesRequest) GetOrganizationId() string {
@@ -3017,7 +3585,7 @@ type ListFirewallRulesResponse struct {
 
 func (x *ListFirewallRulesResponse) Reset() {
 	*x = ListFirewallRulesResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[39]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3029,7 +3597,7 @@ func (x *ListFirewallRulesResponse) String() string {
 func (*ListFirewallRulesResponse) ProtoMessage() {}
 
 func (x *ListFirewallRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[39]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3042,7 +3610,7 @@ func (x *ListFirewallRulesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFirewallRulesResponse.ProtoReflect.Descriptor instead.
 func (*ListFirewallRulesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{39}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ListFirewallRulesResponse) GetRules() []*FirewallRule {
@@ -3063,7 +3631,7 @@ type GetFirewallRuleRequest struct {
 
 func (x *GetFirewallRuleRequest) Reset() {
 	*x = GetFirewallRuleRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[40]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3075,7 +3643,7 @@ func (x *GetFirewallRuleRequest) String() string {
 func (*GetFirewallRuleRequest) ProtoMessage() {}
 
 func (x *GetFirewallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[40]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3088,7 +3656,7 @@ func (x *GetFirewallRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFirewallRuleRequest.ProtoReflect.Descriptor instead.
 func (*GetFirewallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{40}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetFirewallRuleRequest) GetOrganizationId() string {
@@ -3121,7 +3689,7 @@ type GetFirewallRuleResponse struct {
 
 func (x *GetFirewallRuleResponse) Reset() {
 	*x = GetFirewallRuleResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[41]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3133,7 +3701,7 @@ func (x *GetFirewallRuleResponse) String() string {
 func (*GetFirewallRuleResponse) ProtoMessage() {}
 
 func (x *GetFirewallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[41]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3146,7 +3714,7 @@ func (x *GetFirewallRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFirewallRuleResponse.ProtoReflect.Descriptor instead.
 func (*GetFirewallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{41}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetFirewallRuleResponse) GetRule() *FirewallRule {
@@ -3168,7 +3736,7 @@ type CreateFirewallRuleRequest struct {
 
 func (x *CreateFirewallRuleRequest) Reset() {
 	*x = CreateFirewallRuleRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[42]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3180,7 +3748,7 @@ func (x *CreateFirewallRuleRequest) String() string {
 func (*CreateFirewallRuleRequest) ProtoMessage() {}
 
 func (x *CreateFirewallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[42]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3193,7 +3761,7 @@ func (x *CreateFirewallRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFirewallRuleRequest.ProtoReflect.Descriptor instead.
 func (*CreateFirewallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{42}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *CreateFirewallRuleRequest) GetOrganizationId() string {
@@ -3233,7 +3801,7 @@ type CreateFirewallRuleResponse struct {
 
 func (x *CreateFirewallRuleResponse) Reset() {
 	*x = CreateFirewallRuleResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[43]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3245,7 +3813,7 @@ func (x *CreateFirewallRuleResponse) String() string {
 func (*CreateFirewallRuleResponse) ProtoMessage() {}
 
 func (x *CreateFirewallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[43]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3258,7 +3826,7 @@ func (x *CreateFirewallRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFirewallRuleResponse.ProtoReflect.Descriptor instead.
 func (*CreateFirewallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{43}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *CreateFirewallRuleResponse) GetRule() *FirewallRule {
@@ -3280,7 +3848,7 @@ type UpdateFirewallRuleRequest struct {
 
 func (x *UpdateFirewallRuleRequest) Reset() {
 	*x = UpdateFirewallRuleRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[44]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3292,7 +3860,7 @@ func (x *UpdateFirewallRuleRequest) String() string {
 func (*UpdateFirewallRuleRequest) ProtoMessage() {}
 
 func (x *UpdateFirewallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[44]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3305,7 +3873,7 @@ func (x *UpdateFirewallRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFirewallRuleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateFirewallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{44}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *UpdateFirewallRuleRequest) GetOrganizationId() string {
@@ -3345,7 +3913,7 @@ type UpdateFirewallRuleResponse struct {
 
 func (x *UpdateFirewallRuleResponse) Reset() {
 	*x = UpdateFirewallRuleResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[45]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3357,7 +3925,7 @@ func (x *UpdateFirewallRuleResponse) String() string {
 func (*UpdateFirewallRuleResponse) ProtoMessage() {}
 
 func (x *UpdateFirewallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[45]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3370,7 +3938,7 @@ func (x *UpdateFirewallRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFirewallRuleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateFirewallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{45}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *UpdateFirewallRuleResponse) GetRule() *FirewallRule {
@@ -3391,7 +3959,7 @@ type DeleteFirewallRuleRequest struct {
 
 func (x *DeleteFirewallRuleRequest) Reset() {
 	*x = DeleteFirewallRuleRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[46]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3403,7 +3971,7 @@ func (x *DeleteFirewallRuleRequest) String() string {
 func (*DeleteFirewallRuleRequest) ProtoMessage() {}
 
 func (x *DeleteFirewallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[46]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3416,7 +3984,7 @@ func (x *DeleteFirewallRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFirewallRuleRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFirewallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{46}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *DeleteFirewallRuleRequest) GetOrganizationId() string {
@@ -3449,7 +4017,7 @@ type DeleteFirewallRuleResponse struct {
 
 func (x *DeleteFirewallRuleResponse) Reset() {
 	*x = DeleteFirewallRuleResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[47]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3461,7 +4029,7 @@ func (x *DeleteFirewallRuleResponse) String() string {
 func (*DeleteFirewallRuleResponse) ProtoMessage() {}
 
 func (x *DeleteFirewallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[47]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3474,7 +4042,7 @@ func (x *DeleteFirewallRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFirewallRuleResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFirewallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{47}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *DeleteFirewallRuleResponse) GetSuccess() bool {
@@ -3494,7 +4062,7 @@ type GetFirewallOptionsRequest struct {
 
 func (x *GetFirewallOptionsRequest) Reset() {
 	*x = GetFirewallOptionsRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[48]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3506,7 +4074,7 @@ func (x *GetFirewallOptionsRequest) String() string {
 func (*GetFirewallOptionsRequest) ProtoMessage() {}
 
 func (x *GetFirewallOptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[48]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3519,7 +4087,7 @@ func (x *GetFirewallOptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFirewallOptionsRequest.ProtoReflect.Descriptor instead.
 func (*GetFirewallOptionsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{48}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetFirewallOptionsRequest) GetOrganizationId() string {
@@ -3545,7 +4113,7 @@ type GetFirewallOptionsResponse struct {
 
 func (x *GetFirewallOptionsResponse) Reset() {
 	*x = GetFirewallOptionsResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[49]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3557,7 +4125,7 @@ func (x *GetFirewallOptionsResponse) String() string {
 func (*GetFirewallOptionsResponse) ProtoMessage() {}
 
 func (x *GetFirewallOptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[49]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3570,7 +4138,7 @@ func (x *GetFirewallOptionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFirewallOptionsResponse.ProtoReflect.Descriptor instead.
 func (*GetFirewallOptionsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{49}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetFirewallOptionsResponse) GetOptions() *FirewallOptions {
@@ -3591,7 +4159,7 @@ type UpdateFirewallOptionsRequest struct {
 
 func (x *UpdateFirewallOptionsRequest) Reset() {
 	*x = UpdateFirewallOptionsRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[50]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3603,7 +4171,7 @@ func (x *UpdateFirewallOptionsRequest) String() string {
 func (*UpdateFirewallOptionsRequest) ProtoMessage() {}
 
 func (x *UpdateFirewallOptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[50]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3616,7 +4184,7 @@ func (x *UpdateFirewallOptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFirewallOptionsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateFirewallOptionsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{50}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *UpdateFirewallOptionsRequest) GetOrganizationId() string {
@@ -3649,7 +4217,7 @@ type UpdateFirewallOptionsResponse struct {
 
 func (x *UpdateFirewallOptionsResponse) Reset() {
 	*x = UpdateFirewallOptionsResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[51]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3661,7 +4229,7 @@ func (x *UpdateFirewallOptionsResponse) String() string {
 func (*UpdateFirewallOptionsResponse) ProtoMessage() {}
 
 func (x *UpdateFirewallOptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[51]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3674,7 +4242,7 @@ func (x *UpdateFirewallOptionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFirewallOptionsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateFirewallOptionsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{51}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *UpdateFirewallOptionsResponse) GetOptions() *FirewallOptions {
@@ -3709,7 +4277,7 @@ type FirewallRule struct {
 
 func (x *FirewallRule) Reset() {
 	*x = FirewallRule{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[52]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3721,7 +4289,7 @@ func (x *FirewallRule) String() string {
 func (*FirewallRule) ProtoMessage() {}
 
 func (x *FirewallRule) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[52]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3734,7 +4302,7 @@ func (x *FirewallRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirewallRule.ProtoReflect.Descriptor instead.
 func (*FirewallRule) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{52}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *FirewallRule) GetPos() int32 {
@@ -3854,7 +4422,7 @@ type FirewallOptions struct {
 
 func (x *FirewallOptions) Reset() {
 	*x = FirewallOptions{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[53]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3866,7 +4434,7 @@ func (x *FirewallOptions) String() string {
 func (*FirewallOptions) ProtoMessage() {}
 
 func (x *FirewallOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[53]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3879,7 +4447,7 @@ func (x *FirewallOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirewallOptions.ProtoReflect.Descriptor instead.
 func (*FirewallOptions) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{53}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *FirewallOptions) GetEnable() bool {
@@ -3975,7 +4543,7 @@ type SSHKey struct {
 
 func (x *SSHKey) Reset() {
 	*x = SSHKey{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[54]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3987,7 +4555,7 @@ func (x *SSHKey) String() string {
 func (*SSHKey) ProtoMessage() {}
 
 func (x *SSHKey) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[54]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4000,7 +4568,7 @@ func (x *SSHKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSHKey.ProtoReflect.Descriptor instead.
 func (*SSHKey) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{54}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *SSHKey) GetId() string {
@@ -4062,7 +4630,7 @@ type ListSSHKeysRequest struct {
 
 func (x *ListSSHKeysRequest) Reset() {
 	*x = ListSSHKeysRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[55]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4074,7 +4642,7 @@ func (x *ListSSHKeysRequest) String() string {
 func (*ListSSHKeysRequest) ProtoMessage() {}
 
 func (x *ListSSHKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[55]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4087,7 +4655,7 @@ func (x *ListSSHKeysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSSHKeysRequest.ProtoReflect.Descriptor instead.
 func (*ListSSHKeysRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{55}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ListSSHKeysRequest) GetOrganizationId() string {
@@ -4113,7 +4681,7 @@ type ListSSHKeysResponse struct {
 
 func (x *ListSSHKeysResponse) Reset() {
 	*x = ListSSHKeysResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[56]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4125,7 +4693,7 @@ func (x *ListSSHKeysResponse) String() string {
 func (*ListSSHKeysResponse) ProtoMessage() {}
 
 func (x *ListSSHKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[56]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4138,7 +4706,7 @@ func (x *ListSSHKeysResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSSHKeysResponse.ProtoReflect.Descriptor instead.
 func (*ListSSHKeysResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{56}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ListSSHKeysResponse) GetKeys() []*SSHKey {
@@ -4160,7 +4728,7 @@ type AddSSHKeyRequest struct {
 
 func (x *AddSSHKeyRequest) Reset() {
 	*x = AddSSHKeyRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[57]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4172,7 +4740,7 @@ func (x *AddSSHKeyRequest) String() string {
 func (*AddSSHKeyRequest) ProtoMessage() {}
 
 func (x *AddSSHKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[57]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4185,7 +4753,7 @@ func (x *AddSSHKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSSHKeyRequest.ProtoReflect.Descriptor instead.
 func (*AddSSHKeyRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{57}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *AddSSHKeyRequest) GetOrganizationId() string {
@@ -4225,7 +4793,7 @@ type AddSSHKeyResponse struct {
 
 func (x *AddSSHKeyResponse) Reset() {
 	*x = AddSSHKeyResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[58]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4237,7 +4805,7 @@ func (x *AddSSHKeyResponse) String() string {
 func (*AddSSHKeyResponse) ProtoMessage() {}
 
 func (x *AddSSHKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[58]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4250,7 +4818,7 @@ func (x *AddSSHKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSSHKeyResponse.ProtoReflect.Descriptor instead.
 func (*AddSSHKeyResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{58}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *AddSSHKeyResponse) GetKey() *SSHKey {
@@ -4271,7 +4839,7 @@ type UpdateSSHKeyRequest struct {
 
 func (x *UpdateSSHKeyRequest) Reset() {
 	*x = UpdateSSHKeyRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[59]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4283,7 +4851,7 @@ func (x *UpdateSSHKeyRequest) String() string {
 func (*UpdateSSHKeyRequest) ProtoMessage() {}
 
 func (x *UpdateSSHKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[59]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4296,7 +4864,7 @@ func (x *UpdateSSHKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSSHKeyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSSHKeyRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{59}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *UpdateSSHKeyRequest) GetOrganizationId() string {
@@ -4329,7 +4897,7 @@ type UpdateSSHKeyResponse struct {
 
 func (x *UpdateSSHKeyResponse) Reset() {
 	*x = UpdateSSHKeyResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[60]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4341,7 +4909,7 @@ func (x *UpdateSSHKeyResponse) String() string {
 func (*UpdateSSHKeyResponse) ProtoMessage() {}
 
 func (x *UpdateSSHKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[60]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4354,7 +4922,7 @@ func (x *UpdateSSHKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSSHKeyResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSSHKeyResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{60}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *UpdateSSHKeyResponse) GetKey() *SSHKey {
@@ -4374,7 +4942,7 @@ type RemoveSSHKeyRequest struct {
 
 func (x *RemoveSSHKeyRequest) Reset() {
 	*x = RemoveSSHKeyRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[61]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4386,7 +4954,7 @@ func (x *RemoveSSHKeyRequest) String() string {
 func (*RemoveSSHKeyRequest) ProtoMessage() {}
 
 func (x *RemoveSSHKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[61]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4399,7 +4967,7 @@ func (x *RemoveSSHKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveSSHKeyRequest.ProtoReflect.Descriptor instead.
 func (*RemoveSSHKeyRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{61}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *RemoveSSHKeyRequest) GetOrganizationId() string {
@@ -4428,7 +4996,7 @@ type RemoveSSHKeyResponse struct {
 
 func (x *RemoveSSHKeyResponse) Reset() {
 	*x = RemoveSSHKeyResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[62]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4440,7 +5008,7 @@ func (x *RemoveSSHKeyResponse) String() string {
 func (*RemoveSSHKeyResponse) ProtoMessage() {}
 
 func (x *RemoveSSHKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[62]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4453,7 +5021,7 @@ func (x *RemoveSSHKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveSSHKeyResponse.ProtoReflect.Descriptor instead.
 func (*RemoveSSHKeyResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{62}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *RemoveSSHKeyResponse) GetAffectedVpsIds() []string {
@@ -4481,7 +5049,7 @@ type ResetVPSPasswordRequest struct {
 
 func (x *ResetVPSPasswordRequest) Reset() {
 	*x = ResetVPSPasswordRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[63]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4493,7 +5061,7 @@ func (x *ResetVPSPasswordRequest) String() string {
 func (*ResetVPSPasswordRequest) ProtoMessage() {}
 
 func (x *ResetVPSPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[63]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4506,7 +5074,7 @@ func (x *ResetVPSPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetVPSPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ResetVPSPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{63}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *ResetVPSPasswordRequest) GetOrganizationId() string {
@@ -4535,7 +5103,7 @@ type ResetVPSPasswordResponse struct {
 
 func (x *ResetVPSPasswordResponse) Reset() {
 	*x = ResetVPSPasswordResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[64]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4547,7 +5115,7 @@ func (x *ResetVPSPasswordResponse) String() string {
 func (*ResetVPSPasswordResponse) ProtoMessage() {}
 
 func (x *ResetVPSPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[64]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4560,7 +5128,7 @@ func (x *ResetVPSPasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetVPSPasswordResponse.ProtoReflect.Descriptor instead.
 func (*ResetVPSPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{64}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ResetVPSPasswordResponse) GetVpsId() string {
@@ -4595,7 +5163,7 @@ type ReinitializeVPSRequest struct {
 
 func (x *ReinitializeVPSRequest) Reset() {
 	*x = ReinitializeVPSRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[65]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4607,7 +5175,7 @@ func (x *ReinitializeVPSRequest) String() string {
 func (*ReinitializeVPSRequest) ProtoMessage() {}
 
 func (x *ReinitializeVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[65]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4620,7 +5188,7 @@ func (x *ReinitializeVPSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReinitializeVPSRequest.ProtoReflect.Descriptor instead.
 func (*ReinitializeVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{65}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ReinitializeVPSRequest) GetOrganizationId() string {
@@ -4648,7 +5216,7 @@ type ReinitializeVPSResponse struct {
 
 func (x *ReinitializeVPSResponse) Reset() {
 	*x = ReinitializeVPSResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[66]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4660,7 +5228,7 @@ func (x *ReinitializeVPSResponse) String() string {
 func (*ReinitializeVPSResponse) ProtoMessage() {}
 
 func (x *ReinitializeVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[66]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4673,7 +5241,7 @@ func (x *ReinitializeVPSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReinitializeVPSResponse.ProtoReflect.Descriptor instead.
 func (*ReinitializeVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{66}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ReinitializeVPSResponse) GetVps() *VPSInstance {
@@ -4708,7 +5276,7 @@ type StreamVPSLogsRequest struct {
 
 func (x *StreamVPSLogsRequest) Reset() {
 	*x = StreamVPSLogsRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[67]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4720,7 +5288,7 @@ func (x *StreamVPSLogsRequest) String() string {
 func (*StreamVPSLogsRequest) ProtoMessage() {}
 
 func (x *StreamVPSLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[67]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4733,7 +5301,7 @@ func (x *StreamVPSLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamVPSLogsRequest.ProtoReflect.Descriptor instead.
 func (*StreamVPSLogsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{67}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *StreamVPSLogsRequest) GetOrganizationId() string {
@@ -4762,7 +5330,7 @@ type VPSLogLine struct {
 
 func (x *VPSLogLine) Reset() {
 	*x = VPSLogLine{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[68]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4774,7 +5342,7 @@ func (x *VPSLogLine) String() string {
 func (*VPSLogLine) ProtoMessage() {}
 
 func (x *VPSLogLine) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[68]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4787,7 +5355,7 @@ func (x *VPSLogLine) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VPSLogLine.ProtoReflect.Descriptor instead.
 func (*VPSLogLine) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{68}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *VPSLogLine) GetLine() string {
@@ -4830,7 +5398,7 @@ type GetVPSJournalLogsRequest struct {
 
 func (x *GetVPSJournalLogsRequest) Reset() {
 	*x = GetVPSJournalLogsRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[69]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4842,7 +5410,7 @@ func (x *GetVPSJournalLogsRequest) String() string {
 func (*GetVPSJournalLogsRequest) ProtoMessage() {}
 
 func (x *GetVPSJournalLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[69]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4855,7 +5423,7 @@ func (x *GetVPSJournalLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVPSJournalLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetVPSJournalLogsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{69}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *GetVPSJournalLogsRequest) GetOrganizationId() string {
@@ -4895,7 +5463,7 @@ type GetVPSJournalLogsResponse struct {
 
 func (x *GetVPSJournalLogsResponse) Reset() {
 	*x = GetVPSJournalLogsResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[70]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4907,7 +5475,7 @@ func (x *GetVPSJournalLogsResponse) String() string {
 func (*GetVPSJournalLogsResponse) ProtoMessage() {}
 
 func (x *GetVPSJournalLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[70]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4920,7 +5488,7 @@ func (x *GetVPSJournalLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVPSJournalLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetVPSJournalLogsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{70}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GetVPSJournalLogsResponse) GetLogs() []*VPSLogLine {
@@ -4941,7 +5509,7 @@ type ListVPSServicesRequest struct {
 
 func (x *ListVPSServicesRequest) Reset() {
 	*x = ListVPSServicesRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[71]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4953,7 +5521,7 @@ func (x *ListVPSServicesRequest) String() string {
 func (*ListVPSServicesRequest) ProtoMessage() {}
 
 func (x *ListVPSServicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[71]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4966,7 +5534,7 @@ func (x *ListVPSServicesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVPSServicesRequest.ProtoReflect.Descriptor instead.
 func (*ListVPSServicesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{71}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *ListVPSServicesRequest) GetOrganizationId() string {
@@ -5003,7 +5571,7 @@ type VPSSystemService struct {
 
 func (x *VPSSystemService) Reset() {
 	*x = VPSSystemService{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[72]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5015,7 +5583,7 @@ func (x *VPSSystemService) String() string {
 func (*VPSSystemService) ProtoMessage() {}
 
 func (x *VPSSystemService) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[72]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5028,7 +5596,7 @@ func (x *VPSSystemService) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VPSSystemService.ProtoReflect.Descriptor instead.
 func (*VPSSystemService) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{72}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *VPSSystemService) GetName() string {
@@ -5076,7 +5644,7 @@ type ListVPSServicesResponse struct {
 
 func (x *ListVPSServicesResponse) Reset() {
 	*x = ListVPSServicesResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[73]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5088,7 +5656,7 @@ func (x *ListVPSServicesResponse) String() string {
 func (*ListVPSServicesResponse) ProtoMessage() {}
 
 func (x *ListVPSServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[73]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5101,7 +5669,7 @@ func (x *ListVPSServicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVPSServicesResponse.ProtoReflect.Descriptor instead.
 func (*ListVPSServicesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{73}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *ListVPSServicesResponse) GetServices() []*VPSSystemService {
@@ -5128,7 +5696,7 @@ type ImportVPSRequest struct {
 
 func (x *ImportVPSRequest) Reset() {
 	*x = ImportVPSRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[74]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5140,7 +5708,7 @@ func (x *ImportVPSRequest) String() string {
 func (*ImportVPSRequest) ProtoMessage() {}
 
 func (x *ImportVPSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[74]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5153,7 +5721,7 @@ func (x *ImportVPSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportVPSRequest.ProtoReflect.Descriptor instead.
 func (*ImportVPSRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{74}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *ImportVPSRequest) GetOrganizationId() string {
@@ -5175,7 +5743,7 @@ type ImportVPSResponse struct {
 
 func (x *ImportVPSResponse) Reset() {
 	*x = ImportVPSResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[75]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5187,7 +5755,7 @@ func (x *ImportVPSResponse) String() string {
 func (*ImportVPSResponse) ProtoMessage() {}
 
 func (x *ImportVPSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[75]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5200,7 +5768,7 @@ func (x *ImportVPSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportVPSResponse.ProtoReflect.Descriptor instead.
 func (*ImportVPSResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{75}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *ImportVPSResponse) GetImportedCount() int32 {
@@ -5242,7 +5810,7 @@ type GetVPSLeasesRequest struct {
 
 func (x *GetVPSLeasesRequest) Reset() {
 	*x = GetVPSLeasesRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[76]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5254,7 +5822,7 @@ func (x *GetVPSLeasesRequest) String() string {
 func (*GetVPSLeasesRequest) ProtoMessage() {}
 
 func (x *GetVPSLeasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[76]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5267,7 +5835,7 @@ func (x *GetVPSLeasesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVPSLeasesRequest.ProtoReflect.Descriptor instead.
 func (*GetVPSLeasesRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{76}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetVPSLeasesRequest) GetOrganizationId() string {
@@ -5298,7 +5866,7 @@ type VPSLease struct {
 
 func (x *VPSLease) Reset() {
 	*x = VPSLease{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[77]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5310,7 +5878,7 @@ func (x *VPSLease) String() string {
 func (*VPSLease) ProtoMessage() {}
 
 func (x *VPSLease) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[77]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5323,7 +5891,7 @@ func (x *VPSLease) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VPSLease.ProtoReflect.Descriptor instead.
 func (*VPSLease) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{77}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *VPSLease) GetVpsId() string {
@@ -5377,7 +5945,7 @@ type GetVPSLeasesResponse struct {
 
 func (x *GetVPSLeasesResponse) Reset() {
 	*x = GetVPSLeasesResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[78]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5389,7 +5957,7 @@ func (x *GetVPSLeasesResponse) String() string {
 func (*GetVPSLeasesResponse) ProtoMessage() {}
 
 func (x *GetVPSLeasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[78]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5402,7 +5970,7 @@ func (x *GetVPSLeasesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVPSLeasesResponse.ProtoReflect.Descriptor instead.
 func (*GetVPSLeasesResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{78}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetVPSLeasesResponse) GetLeases() []*VPSLease {
@@ -5428,7 +5996,7 @@ type RegisterLeaseRequest struct {
 
 func (x *RegisterLeaseRequest) Reset() {
 	*x = RegisterLeaseRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[79]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5440,7 +6008,7 @@ func (x *RegisterLeaseRequest) String() string {
 func (*RegisterLeaseRequest) ProtoMessage() {}
 
 func (x *RegisterLeaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[79]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5453,7 +6021,7 @@ func (x *RegisterLeaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterLeaseRequest.ProtoReflect.Descriptor instead.
 func (*RegisterLeaseRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{79}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *RegisterLeaseRequest) GetVpsId() string {
@@ -5515,7 +6083,7 @@ type RegisterLeaseResponse struct {
 
 func (x *RegisterLeaseResponse) Reset() {
 	*x = RegisterLeaseResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[80]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5527,7 +6095,7 @@ func (x *RegisterLeaseResponse) String() string {
 func (*RegisterLeaseResponse) ProtoMessage() {}
 
 func (x *RegisterLeaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[80]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5540,7 +6108,7 @@ func (x *RegisterLeaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterLeaseResponse.ProtoReflect.Descriptor instead.
 func (*RegisterLeaseResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{80}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *RegisterLeaseResponse) GetSuccess() bool {
@@ -5568,7 +6136,7 @@ type ReleaseLeaseRequest struct {
 
 func (x *ReleaseLeaseRequest) Reset() {
 	*x = ReleaseLeaseRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[81]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5580,7 +6148,7 @@ func (x *ReleaseLeaseRequest) String() string {
 func (*ReleaseLeaseRequest) ProtoMessage() {}
 
 func (x *ReleaseLeaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[81]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5593,7 +6161,7 @@ func (x *ReleaseLeaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseLeaseRequest.ProtoReflect.Descriptor instead.
 func (*ReleaseLeaseRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{81}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *ReleaseLeaseRequest) GetVpsId() string {
@@ -5620,7 +6188,7 @@ type ReleaseLeaseResponse struct {
 
 func (x *ReleaseLeaseResponse) Reset() {
 	*x = ReleaseLeaseResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[82]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5632,7 +6200,7 @@ func (x *ReleaseLeaseResponse) String() string {
 func (*ReleaseLeaseResponse) ProtoMessage() {}
 
 func (x *ReleaseLeaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[82]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5645,7 +6213,7 @@ func (x *ReleaseLeaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseLeaseResponse.ProtoReflect.Descriptor instead.
 func (*ReleaseLeaseResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{82}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ReleaseLeaseResponse) GetSuccess() bool {
@@ -5674,7 +6242,7 @@ type AssignVPSPublicIPRequest struct {
 
 func (x *AssignVPSPublicIPRequest) Reset() {
 	*x = AssignVPSPublicIPRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[83]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5686,7 +6254,7 @@ func (x *AssignVPSPublicIPRequest) String() string {
 func (*AssignVPSPublicIPRequest) ProtoMessage() {}
 
 func (x *AssignVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[83]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5699,7 +6267,7 @@ func (x *AssignVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignVPSPublicIPRequest.ProtoReflect.Descriptor instead.
 func (*AssignVPSPublicIPRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{83}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *AssignVPSPublicIPRequest) GetOrganizationId() string {
@@ -5733,7 +6301,7 @@ type AssignVPSPublicIPResponse struct {
 
 func (x *AssignVPSPublicIPResponse) Reset() {
 	*x = AssignVPSPublicIPResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[84]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5745,7 +6313,7 @@ func (x *AssignVPSPublicIPResponse) String() string {
 func (*AssignVPSPublicIPResponse) ProtoMessage() {}
 
 func (x *AssignVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[84]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5758,7 +6326,7 @@ func (x *AssignVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignVPSPublicIPResponse.ProtoReflect.Descriptor instead.
 func (*AssignVPSPublicIPResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{84}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *AssignVPSPublicIPResponse) GetSuccess() bool {
@@ -5787,7 +6355,7 @@ type UnassignVPSPublicIPRequest struct {
 
 func (x *UnassignVPSPublicIPRequest) Reset() {
 	*x = UnassignVPSPublicIPRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[85]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5799,7 +6367,7 @@ func (x *UnassignVPSPublicIPRequest) String() string {
 func (*UnassignVPSPublicIPRequest) ProtoMessage() {}
 
 func (x *UnassignVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[85]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5812,7 +6380,7 @@ func (x *UnassignVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnassignVPSPublicIPRequest.ProtoReflect.Descriptor instead.
 func (*UnassignVPSPublicIPRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{85}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *UnassignVPSPublicIPRequest) GetOrganizationId() string {
@@ -5846,7 +6414,7 @@ type UnassignVPSPublicIPResponse struct {
 
 func (x *UnassignVPSPublicIPResponse) Reset() {
 	*x = UnassignVPSPublicIPResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[86]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5858,7 +6426,7 @@ func (x *UnassignVPSPublicIPResponse) String() string {
 func (*UnassignVPSPublicIPResponse) ProtoMessage() {}
 
 func (x *UnassignVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[86]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5871,7 +6439,7 @@ func (x *UnassignVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnassignVPSPublicIPResponse.ProtoReflect.Descriptor instead.
 func (*UnassignVPSPublicIPResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{86}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *UnassignVPSPublicIPResponse) GetSuccess() bool {
@@ -5909,7 +6477,7 @@ type VPSPublicIP struct {
 
 func (x *VPSPublicIP) Reset() {
 	*x = VPSPublicIP{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[87]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5921,7 +6489,7 @@ func (x *VPSPublicIP) String() string {
 func (*VPSPublicIP) ProtoMessage() {}
 
 func (x *VPSPublicIP) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[87]
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5934,7 +6502,7 @@ func (x *VPSPublicIP) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VPSPublicIP.ProtoReflect.Descriptor instead.
 func (*VPSPublicIP) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{87}
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *VPSPublicIP) GetId() string {
@@ -6033,21 +6601,930 @@ type ListVPSPublicIPsRequest struct {
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListVPSPublicIPsRequest) Reset() {
-	*x = ListVPSPublicIPsRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[88]
+func (x *ListVPSPublicIPsRequest) Reset() {
+	*x = ListVPSPublicIPsRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVPSPublicIPsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVPSPublicIPsRequest) ProtoMessage() {}
+
+func (x *ListVPSPublicIPsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVPSPublicIPsRequest.ProtoReflect.Descriptor instead.
+func (*ListVPSPublicIPsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListVPSPublicIPsRequest) GetVpsId() string {
+	if x != nil && x.VpsId != nil {
+		return *x.VpsId
+	}
+	return ""
+}
+
+func (x *ListVPSPublicIPsRequest) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListVPSPublicIPsRequest) GetIncludeUnassigned() bool {
+	if x != nil && x.IncludeUnassigned != nil {
+		return *x.IncludeUnassigned
+	}
+	return false
+}
+
+func (x *ListVPSPublicIPsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListVPSPublicIPsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+// List VPS Public IPs Response
+type ListVPSPublicIPsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ips           []*VPSPublicIP         `protobuf:"bytes,1,rep,name=ips,proto3" json:"ips,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Total number of IPs matching filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVPSPublicIPsResponse) Reset() {
+	*x = ListVPSPublicIPsResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVPSPublicIPsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVPSPublicIPsResponse) ProtoMessage() {}
+
+func (x *ListVPSPublicIPsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVPSPublicIPsResponse.ProtoReflect.Descriptor instead.
+func (*ListVPSPublicIPsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListVPSPublicIPsResponse) GetIps() []*VPSPublicIP {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+func (x *ListVPSPublicIPsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Create VPS Public IP Request
+type CreateVPSPublicIPRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IpAddress        string                 `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`                         // IPv4 or IPv6 address
+	MonthlyCostCents int64                  `protobuf:"varint,2,opt,name=monthly_cost_cents,json=monthlyCostCents,proto3" json:"monthly_cost_cents,omitempty"` // Monthly cost in cents (set by superadmin)
+	Gateway          *string                `protobuf:"bytes,3,opt,name=gateway,proto3,oneof" json:"gateway,omitempty"`                                        // Gateway IP for this public IP (set by superadmin)
+	Netmask          *string                `protobuf:"bytes,4,opt,name=netmask,proto3,oneof" json:"netmask,omitempty"`                                        // Netmask/CIDR for this public IP (set by superadmin, e.g., "24" or "255.255.255.0")
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateVPSPublicIPRequest) Reset() {
+	*x = CreateVPSPublicIPRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVPSPublicIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVPSPublicIPRequest) ProtoMessage() {}
+
+func (x *CreateVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVPSPublicIPRequest.ProtoReflect.Descriptor instead.
+func (*CreateVPSPublicIPRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CreateVPSPublicIPRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *CreateVPSPublicIPRequest) GetMonthlyCostCents() int64 {
+	if x != nil {
+		return x.MonthlyCostCents
+	}
+	return 0
+}
+
+func (x *CreateVPSPublicIPRequest) GetGateway() string {
+	if x != nil && x.Gateway != nil {
+		return *x.Gateway
+	}
+	return ""
+}
+
+func (x *CreateVPSPublicIPRequest) GetNetmask() string {
+	if x != nil && x.Netmask != nil {
+		return *x.Netmask
+	}
+	return ""
+}
+
+// Create VPS Public IP Response
+type CreateVPSPublicIPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            *VPSPublicIP           `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVPSPublicIPResponse) Reset() {
+	*x = CreateVPSPublicIPResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVPSPublicIPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVPSPublicIPResponse) ProtoMessage() {}
+
+func (x *CreateVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVPSPublicIPResponse.ProtoReflect.Descriptor instead.
+func (*CreateVPSPublicIPResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreateVPSPublicIPResponse) GetIp() *VPSPublicIP {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+// Update VPS Public IP Request
+type UpdateVPSPublicIPRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                              // IP ID to update
+	MonthlyCostCents *int64                 `protobuf:"varint,2,opt,name=monthly_cost_cents,json=monthlyCostCents,proto3,oneof" json:"monthly_cost_cents,omitempty"` // Update monthly cost in cents
+	Gateway          *string                `protobuf:"bytes,3,opt,name=gateway,proto3,oneof" json:"gateway,omitempty"`                                              // Update gateway IP for this public IP
+	Netmask          *string                `protobuf:"bytes,4,opt,name=netmask,proto3,oneof" json:"netmask,omitempty"`                                              // Update netmask/CIDR for this public IP (e.g., "24" or "255.255.255.0")
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateVPSPublicIPRequest) Reset() {
+	*x = UpdateVPSPublicIPRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVPSPublicIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVPSPublicIPRequest) ProtoMessage() {}
+
+func (x *UpdateVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVPSPublicIPRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVPSPublicIPRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UpdateVPSPublicIPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateVPSPublicIPRequest) GetMonthlyCostCents() int64 {
+	if x != nil && x.MonthlyCostCents != nil {
+		return *x.MonthlyCostCents
+	}
+	return 0
+}
+
+func (x *UpdateVPSPublicIPRequest) GetGateway() string {
+	if x != nil && x.Gateway != nil {
+		return *x.Gateway
+	}
+	return ""
+}
+
+func (x *UpdateVPSPublicIPRequest) GetNetmask() string {
+	if x != nil && x.Netmask != nil {
+		return *x.Netmask
+	}
+	return ""
+}
+
+// Update VPS Public IP Response
+type UpdateVPSPublicIPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            *VPSPublicIP           `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVPSPublicIPResponse) Reset() {
+	*x = UpdateVPSPublicIPResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVPSPublicIPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVPSPublicIPResponse) ProtoMessage() {}
+
+func (x *UpdateVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVPSPublicIPResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVPSPublicIPResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UpdateVPSPublicIPResponse) GetIp() *VPSPublicIP {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+// Delete VPS Public IP Request
+type DeleteVPSPublicIPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // IP ID to delete
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVPSPublicIPRequest) Reset() {
+	*x = DeleteVPSPublicIPRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVPSPublicIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVPSPublicIPRequest) ProtoMessage() {}
+
+func (x *DeleteVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVPSPublicIPRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVPSPublicIPRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DeleteVPSPublicIPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Delete VPS Public IP Response
+type DeleteVPSPublicIPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVPSPublicIPResponse) Reset() {
+	*x = DeleteVPSPublicIPResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVPSPublicIPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVPSPublicIPResponse) ProtoMessage() {}
+
+func (x *DeleteVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVPSPublicIPResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVPSPublicIPResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DeleteVPSPublicIPResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// VPSTemplate is a VM converted into a Proxmox template that new VPS instances can be cloned from
+type VPSTemplate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description    *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	SourceVpsId    string                 `protobuf:"bytes,5,opt,name=source_vps_id,json=sourceVpsId,proto3" json:"source_vps_id,omitempty"`    // VPS the template was created from
+	Region         string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`                                   // VPS instances cloned from the template are created in this region
+	Image          VPSImage               `protobuf:"varint,7,opt,name=image,proto3,enum=obiente.cloud.vps.v1.VPSImage" json:"image,omitempty"` // OS image of the source VPS
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VPSTemplate) Reset() {
+	*x = VPSTemplate{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VPSTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPSTemplate) ProtoMessage() {}
+
+func (x *VPSTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VPSTemplate.ProtoReflect.Descriptor instead.
+func (*VPSTemplate) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *VPSTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VPSTemplate) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *VPSTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VPSTemplate) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *VPSTemplate) GetSourceVpsId() string {
+	if x != nil {
+		return x.SourceVpsId
+	}
+	return ""
+}
+
+func (x *VPSTemplate) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *VPSTemplate) GetImage() VPSImage {
+	if x != nil {
+		return x.Image
+	}
+	return VPSImage_VPS_IMAGE_UNSPECIFIED
+}
+
+func (x *VPSTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateVPSTemplateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	VpsId          string                 `protobuf:"bytes,2,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description    *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateVPSTemplateRequest) Reset() {
+	*x = CreateVPSTemplateRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVPSTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVPSTemplateRequest) ProtoMessage() {}
+
+func (x *CreateVPSTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVPSTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateVPSTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CreateVPSTemplateRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateVPSTemplateRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+func (x *CreateVPSTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVPSTemplateRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type CreateVPSTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *VPSTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVPSTemplateResponse) Reset() {
+	*x = CreateVPSTemplateResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVPSTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVPSTemplateResponse) ProtoMessage() {}
+
+func (x *CreateVPSTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVPSTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateVPSTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CreateVPSTemplateResponse) GetTemplate() *VPSTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListVPSTemplatesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListVPSTemplatesRequest) Reset() {
+	*x = ListVPSTemplatesRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVPSTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVPSTemplatesRequest) ProtoMessage() {}
+
+func (x *ListVPSTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVPSTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListVPSTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListVPSTemplatesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListVPSTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*VPSTemplate         `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVPSTemplatesResponse) Reset() {
+	*x = ListVPSTemplatesResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVPSTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVPSTemplatesResponse) ProtoMessage() {}
+
+func (x *ListVPSTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVPSTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListVPSTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListVPSTemplatesResponse) GetTemplates() []*VPSTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteVPSTemplateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TemplateId     string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteVPSTemplateRequest) Reset() {
+	*x = DeleteVPSTemplateRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVPSTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVPSTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteVPSTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVPSTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVPSTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *DeleteVPSTemplateRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteVPSTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type DeleteVPSTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVPSTemplateResponse) Reset() {
+	*x = DeleteVPSTemplateResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVPSTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVPSTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteVPSTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVPSTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVPSTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *DeleteVPSTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// VPSISO is an install ISO an organization uploaded for its VPS instances
+type VPSISO struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SizeBytes      int64                  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	UploadedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VPSISO) Reset() {
+	*x = VPSISO{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VPSISO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPSISO) ProtoMessage() {}
+
+func (x *VPSISO) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VPSISO.ProtoReflect.Descriptor instead.
+func (*VPSISO) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *VPSISO) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *VPSISO) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VPSISO) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *VPSISO) GetUploadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return nil
+}
+
+type ListOrgISOsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListOrgISOsRequest) Reset() {
+	*x = ListOrgISOsRequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVPSPublicIPsRequest) String() string {
+func (x *ListOrgISOsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVPSPublicIPsRequest) ProtoMessage() {}
+func (*ListOrgISOsRequest) ProtoMessage() {}
 
-func (x *ListVPSPublicIPsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[88]
+func (x *ListOrgISOsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6058,70 +7535,42 @@ func (x *ListVPSPublicIPsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVPSPublicIPsRequest.ProtoReflect.Descriptor instead.
-func (*ListVPSPublicIPsRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{88}
-}
-
-func (x *ListVPSPublicIPsRequest) GetVpsId() string {
-	if x != nil && x.VpsId != nil {
-		return *x.VpsId
-	}
-	return ""
-}
-
-func (x *ListVPSPublicIPsRequest) GetOrganizationId() string {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
-	}
-	return ""
-}
-
-func (x *ListVPSPublicIPsRequest) GetIncludeUnassigned() bool {
-	if x != nil && x.IncludeUnassigned != nil {
-		return *x.IncludeUnassigned
-	}
-	return false
-}
-
-func (x *ListVPSPublicIPsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use ListOrgISOsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrgISOsRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *ListVPSPublicIPsRequest) GetPerPage() int32 {
+func (x *ListOrgISOsRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.PerPage
+		return x.OrganizationId
 	}
-	return 0
+	return ""
 }
 
-// List VPS Public IPs Response
-type ListVPSPublicIPsResponse struct {
+type ListOrgISOsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ips           []*VPSPublicIP         `protobuf:"bytes,1,rep,name=ips,proto3" json:"ips,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Total number of IPs matching filters
+	Isos          []*VPSISO              `protobuf:"bytes,1,rep,name=isos,proto3" json:"isos,omitempty"`
+	UsedBytes     int64                  `protobuf:"varint,2,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`    // ISO storage used by the organization
+	QuotaBytes    int64                  `protobuf:"varint,3,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"` // ISO storage the organization may use
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVPSPublicIPsResponse) Reset() {
-	*x = ListVPSPublicIPsResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[89]
+func (x *ListOrgISOsResponse) Reset() {
+	*x = ListOrgISOsResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVPSPublicIPsResponse) String() string {
+func (x *ListOrgISOsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVPSPublicIPsResponse) ProtoMessage() {}
+func (*ListOrgISOsResponse) ProtoMessage() {}
 
-func (x *ListVPSPublicIPsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[89]
+func (x *ListOrgISOsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6132,51 +7581,55 @@ func (x *ListVPSPublicIPsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVPSPublicIPsResponse.ProtoReflect.Descriptor instead.
-func (*ListVPSPublicIPsResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use ListOrgISOsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrgISOsResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *ListVPSPublicIPsResponse) GetIps() []*VPSPublicIP {
+func (x *ListOrgISOsResponse) GetIsos() []*VPSISO {
 	if x != nil {
-		return x.Ips
+		return x.Isos
 	}
 	return nil
 }
 
-func (x *ListVPSPublicIPsResponse) GetTotalCount() int64 {
+func (x *ListOrgISOsResponse) GetUsedBytes() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.UsedBytes
 	}
 	return 0
 }
 
-// Create VPS Public IP Request
-type CreateVPSPublicIPRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	IpAddress        string                 `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`                         // IPv4 or IPv6 address
-	MonthlyCostCents int64                  `protobuf:"varint,2,opt,name=monthly_cost_cents,json=monthlyCostCents,proto3" json:"monthly_cost_cents,omitempty"` // Monthly cost in cents (set by superadmin)
-	Gateway          *string                `protobuf:"bytes,3,opt,name=gateway,proto3,oneof" json:"gateway,omitempty"`                                        // Gateway IP for this public IP (set by superadmin)
-	Netmask          *string                `protobuf:"bytes,4,opt,name=netmask,proto3,oneof" json:"netmask,omitempty"`                                        // Netmask/CIDR for this public IP (set by superadmin, e.g., "24" or "255.255.255.0")
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *ListOrgISOsResponse) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
 }
 
-func (x *CreateVPSPublicIPRequest) Reset() {
-	*x = CreateVPSPublicIPRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[90]
+type DeleteISORequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	IsoName        string                 `protobuf:"bytes,2,opt,name=iso_name,json=isoName,proto3" json:"iso_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteISORequest) Reset() {
+	*x = DeleteISORequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVPSPublicIPRequest) String() string {
+func (x *DeleteISORequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVPSPublicIPRequest) ProtoMessage() {}
+func (*DeleteISORequest) ProtoMessage() {}
 
-func (x *CreateVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[90]
+func (x *DeleteISORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6187,62 +7640,47 @@ func (x *CreateVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVPSPublicIPRequest.ProtoReflect.Descriptor instead.
-func (*CreateVPSPublicIPRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use DeleteISORequest.ProtoReflect.Descriptor instead.
+func (*DeleteISORequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *CreateVPSPublicIPRequest) GetIpAddress() string {
+func (x *DeleteISORequest) GetOrganizationId() string {
 	if x != nil {
-		return x.IpAddress
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *CreateVPSPublicIPRequest) GetMonthlyCostCents() int64 {
+func (x *DeleteISORequest) GetIsoName() string {
 	if x != nil {
-		return x.MonthlyCostCents
-	}
-	return 0
-}
-
-func (x *CreateVPSPublicIPRequest) GetGateway() string {
-	if x != nil && x.Gateway != nil {
-		return *x.Gateway
-	}
-	return ""
-}
-
-func (x *CreateVPSPublicIPRequest) GetNetmask() string {
-	if x != nil && x.Netmask != nil {
-		return *x.Netmask
+		return x.IsoName
 	}
 	return ""
 }
 
-// Create VPS Public IP Response
-type CreateVPSPublicIPResponse struct {
+type DeleteISOResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ip            *VPSPublicIP           `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVPSPublicIPResponse) Reset() {
-	*x = CreateVPSPublicIPResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[91]
+func (x *DeleteISOResponse) Reset() {
+	*x = DeleteISOResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVPSPublicIPResponse) String() string {
+func (x *DeleteISOResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVPSPublicIPResponse) ProtoMessage() {}
+func (*DeleteISOResponse) ProtoMessage() {}
 
-func (x *CreateVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[91]
+func (x *DeleteISOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6253,44 +7691,42 @@ func (x *CreateVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVPSPublicIPResponse.ProtoReflect.Descriptor instead.
-func (*CreateVPSPublicIPResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use DeleteISOResponse.ProtoReflect.Descriptor instead.
+func (*DeleteISOResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *CreateVPSPublicIPResponse) GetIp() *VPSPublicIP {
+func (x *DeleteISOResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Ip
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// Update VPS Public IP Request
-type UpdateVPSPublicIPRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                              // IP ID to update
-	MonthlyCostCents *int64                 `protobuf:"varint,2,opt,name=monthly_cost_cents,json=monthlyCostCents,proto3,oneof" json:"monthly_cost_cents,omitempty"` // Update monthly cost in cents
-	Gateway          *string                `protobuf:"bytes,3,opt,name=gateway,proto3,oneof" json:"gateway,omitempty"`                                              // Update gateway IP for this public IP
-	Netmask          *string                `protobuf:"bytes,4,opt,name=netmask,proto3,oneof" json:"netmask,omitempty"`                                              // Update netmask/CIDR for this public IP (e.g., "24" or "255.255.255.0")
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type MountISORequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	VpsId          string                 `protobuf:"bytes,2,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
+	IsoName        string                 `protobuf:"bytes,3,opt,name=iso_name,json=isoName,proto3" json:"iso_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateVPSPublicIPRequest) Reset() {
-	*x = UpdateVPSPublicIPRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[92]
+func (x *MountISORequest) Reset() {
+	*x = MountISORequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVPSPublicIPRequest) String() string {
+func (x *MountISORequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVPSPublicIPRequest) ProtoMessage() {}
+func (*MountISORequest) ProtoMessage() {}
 
-func (x *UpdateVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[92]
+func (x *MountISORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6301,62 +7737,54 @@ func (x *UpdateVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVPSPublicIPRequest.ProtoReflect.Descriptor instead.
-func (*UpdateVPSPublicIPRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use MountISORequest.ProtoReflect.Descriptor instead.
+func (*MountISORequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *UpdateVPSPublicIPRequest) GetId() string {
+func (x *MountISORequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Id
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *UpdateVPSPublicIPRequest) GetMonthlyCostCents() int64 {
-	if x != nil && x.MonthlyCostCents != nil {
-		return *x.MonthlyCostCents
-	}
-	return 0
-}
-
-func (x *UpdateVPSPublicIPRequest) GetGateway() string {
-	if x != nil && x.Gateway != nil {
-		return *x.Gateway
+func (x *MountISORequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
 	}
 	return ""
 }
 
-func (x *UpdateVPSPublicIPRequest) GetNetmask() string {
-	if x != nil && x.Netmask != nil {
-		return *x.Netmask
+func (x *MountISORequest) GetIsoName() string {
+	if x != nil {
+		return x.IsoName
 	}
 	return ""
 }
 
-// Update VPS Public IP Response
-type UpdateVPSPublicIPResponse struct {
+type MountISOResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ip            *VPSPublicIP           `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVPSPublicIPResponse) Reset() {
-	*x = UpdateVPSPublicIPResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[93]
+func (x *MountISOResponse) Reset() {
+	*x = MountISOResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVPSPublicIPResponse) String() string {
+func (x *MountISOResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVPSPublicIPResponse) ProtoMessage() {}
+func (*MountISOResponse) ProtoMessage() {}
 
-func (x *UpdateVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[93]
+func (x *MountISOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6367,41 +7795,41 @@ func (x *UpdateVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVPSPublicIPResponse.ProtoReflect.Descriptor instead.
-func (*UpdateVPSPublicIPResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use MountISOResponse.ProtoReflect.Descriptor instead.
+func (*MountISOResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *UpdateVPSPublicIPResponse) GetIp() *VPSPublicIP {
+func (x *MountISOResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Ip
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// Delete VPS Public IP Request
-type DeleteVPSPublicIPRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // IP ID to delete
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UnmountISORequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	VpsId          string                 `protobuf:"bytes,2,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteVPSPublicIPRequest) Reset() {
-	*x = DeleteVPSPublicIPRequest{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[94]
+func (x *UnmountISORequest) Reset() {
+	*x = UnmountISORequest{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVPSPublicIPRequest) String() string {
+func (x *UnmountISORequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVPSPublicIPRequest) ProtoMessage() {}
+func (*UnmountISORequest) ProtoMessage() {}
 
-func (x *DeleteVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[94]
+func (x *UnmountISORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6412,41 +7840,47 @@ func (x *DeleteVPSPublicIPRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVPSPublicIPRequest.ProtoReflect.Descriptor instead.
-func (*DeleteVPSPublicIPRequest) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use UnmountISORequest.ProtoReflect.Descriptor instead.
+func (*UnmountISORequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *DeleteVPSPublicIPRequest) GetId() string {
+func (x *UnmountISORequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Id
+		return x.OrganizationId
 	}
 	return ""
 }
 
-// Delete VPS Public IP Response
-type DeleteVPSPublicIPResponse struct {
+func (x *UnmountISORequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+type UnmountISOResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteVPSPublicIPResponse) Reset() {
-	*x = DeleteVPSPublicIPResponse{}
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[95]
+func (x *UnmountISOResponse) Reset() {
+	*x = UnmountISOResponse{}
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVPSPublicIPResponse) String() string {
+func (x *UnmountISOResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVPSPublicIPResponse) ProtoMessage() {}
+func (*UnmountISOResponse) ProtoMessage() {}
 
-func (x *DeleteVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[95]
+func (x *UnmountISOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6457,12 +7891,12 @@ func (x *DeleteVPSPublicIPResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVPSPublicIPResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVPSPublicIPResponse) Descriptor() ([]byte, []int) {
-	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use UnmountISOResponse.ProtoReflect.Descriptor instead.
+func (*UnmountISOResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *DeleteVPSPublicIPResponse) GetSuccess() bool {
+func (x *UnmountISOResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
@@ -6484,7 +7918,7 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\rvps_instances\x18\x01 \x03(\v2!.obiente.cloud.vps.v1.VPSInstanceR\fvpsInstances\x12C\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2#.obiente.cloud.common.v1.PaginationR\n" +
-	"pagination\"\xec\x04\n" +
+	"pagination\"\xb3\a\n" +
 	"\x10CreateVPSRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
@@ -6499,7 +7933,15 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\n" +
 	"cloud_init\x18\n" +
 	" \x01(\v2%.obiente.cloud.vps.v1.CloudInitConfigH\x03R\tcloudInit\x88\x01\x01\x12(\n" +
-	"\rroot_password\x18\v \x01(\tH\x04R\frootPassword\x88\x01\x01\x1a;\n" +
+	"\rroot_password\x18\v \x01(\tH\x04R\frootPassword\x88\x01\x01\x128\n" +
+	"\x16cloud_init_template_id\x18\f \x01(\tH\x05R\x13cloudInitTemplateId\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\r \x01(\tH\x06R\n" +
+	"templateId\x88\x01\x01\x12 \n" +
+	"\tstatic_ip\x18\x0e \x01(\tH\aR\bstaticIp\x88\x01\x01\x12\x1d\n" +
+	"\agateway\x18\x0f \x01(\tH\bR\agateway\x88\x01\x01\x12\x1f\n" +
+	"\vdns_servers\x18\x10 \x03(\tR\n" +
+	"dnsServers\x126\n" +
+	"\x03gpu\x18\x11 \x01(\v2\x1f.obiente.cloud.vps.v1.GPUConfigH\tR\x03gpu\x88\x01\x01\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
@@ -6507,7 +7949,18 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\t_image_idB\r\n" +
 	"\v_ssh_key_idB\r\n" +
 	"\v_cloud_initB\x10\n" +
-	"\x0e_root_password\"\xd2\x04\n" +
+	"\x0e_root_passwordB\x19\n" +
+	"\x17_cloud_init_template_idB\x0e\n" +
+	"\f_template_idB\f\n" +
+	"\n" +
+	"_static_ipB\n" +
+	"\n" +
+	"\b_gatewayB\x06\n" +
+	"\x04_gpu\"J\n" +
+	"\tGPUConfig\x12\x15\n" +
+	"\x06pci_id\x18\x01 \x01(\tR\x05pciId\x12\x1b\n" +
+	"\x06driver\x18\x02 \x01(\tH\x00R\x06driver\x88\x01\x01B\t\n" +
+	"\a_driver\"\xd2\x04\n" +
 	"\x0fCloudInitConfig\x129\n" +
 	"\x05users\x18\x01 \x03(\v2#.obiente.cloud.vps.v1.CloudInitUserR\x05users\x12\x1f\n" +
 	"\bhostname\x18\x02 \x01(\tH\x00R\bhostname\x88\x01\x01\x12\x1f\n" +
@@ -6598,6 +8051,17 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
 	"\x06vps_id\x18\x02 \x01(\tR\x05vpsId\"H\n" +
 	"\x11RebootVPSResponse\x123\n" +
+	"\x03vps\x18\x01 \x01(\v2!.obiente.cloud.vps.v1.VPSInstanceR\x03vps\"t\n" +
+	"\x0fPatchVPSRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
+	"\x06vps_id\x18\x02 \x01(\tR\x05vpsId\x12!\n" +
+	"\freboot_after\x18\x03 \x01(\bR\vrebootAfter\"-\n" +
+	"\x10PatchVPSResponse\x12\x19\n" +
+	"\bpatch_id\x18\x01 \x01(\tR\apatchId\"U\n" +
+	"\x13ForceStopVPSRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
+	"\x06vps_id\x18\x02 \x01(\tR\x05vpsId\"K\n" +
+	"\x14ForceStopVPSResponse\x123\n" +
 	"\x03vps\x18\x01 \x01(\v2!.obiente.cloud.vps.v1.VPSInstanceR\x03vps\"X\n" +
 	"\x16StreamVPSStatusRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
@@ -6642,10 +8106,12 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\x06_month\"9\n" +
 	"\x15FindVPSByLeaseRequest\x12\x0e\n" +
 	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x10\n" +
-	"\x03mac\x18\x02 \x01(\tR\x03mac\"X\n" +
+	"\x03mac\x18\x02 \x01(\tR\x03mac\"\x9c\x01\n" +
 	"\x16FindVPSByLeaseResponse\x12\x15\n" +
 	"\x06vps_id\x18\x01 \x01(\tR\x05vpsId\x12'\n" +
-	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"\x89\x02\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\"\n" +
+	"\rmax_mbit_down\x18\x03 \x01(\x05R\vmaxMbitDown\x12\x1e\n" +
+	"\vmax_mbit_up\x18\x04 \x01(\x05R\tmaxMbitUp\"\x89\x02\n" +
 	"\x13GetVPSUsageResponse\x12\x15\n" +
 	"\x06vps_id\x18\x01 \x01(\tR\x05vpsId\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\tR\x05month\x12?\n" +
@@ -6687,13 +8153,29 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\x11ssh_proxy_command\x18\x03 \x01(\tR\x0fsshProxyCommand\x12\x1e\n" +
 	"\bssh_port\x18\x04 \x01(\x05H\x00R\asshPort\x88\x01\x01\x127\n" +
 	"\x17connection_instructions\x18\x05 \x01(\tR\x16connectionInstructionsB\v\n" +
-	"\t_ssh_port\"{\n" +
+	"\t_ssh_port\"Y\n" +
+	"\x17GetVPSConsoleURLRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
+	"\x06vps_id\x18\x02 \x01(\tR\x05vpsId\"\x83\x01\n" +
+	"\x18GetVPSConsoleURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"Y\n" +
+	"\x17GetVPSVNCSessionRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
+	"\x06vps_id\x18\x02 \x01(\tR\x05vpsId\"\x99\x01\n" +
+	"\x18GetVPSVNCSessionResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x120\n" +
+	"\x14idle_timeout_seconds\x18\x02 \x01(\x05R\x12idleTimeoutSeconds\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"{\n" +
 	"\tVPSRegion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
 	"\acountry\x18\x03 \x01(\tR\acountry\x12\x12\n" +
 	"\x04city\x18\x04 \x01(\tR\x04city\x12\x1c\n" +
-	"\tavailable\x18\x05 \x01(\bR\tavailable\"\x82\n" +
+	"\tavailable\x18\x05 \x01(\bR\tavailable\"\xdf\n" +
 	"\n" +
 	"\vVPSInstance\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -6728,7 +8210,8 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\x0forganization_id\x18\x17 \x01(\tR\x0eorganizationId\x12\x1d\n" +
 	"\n" +
 	"created_by\x18\x18 \x01(\tR\tcreatedBy\x12M\n" +
-	"\x0fcurrent_metrics\x18\x19 \x01(\v2\x1f.obiente.cloud.vps.v1.VPSMetricH\bR\x0ecurrentMetrics\x88\x01\x01\x1a;\n" +
+	"\x0fcurrent_metrics\x18\x19 \x01(\v2\x1f.obiente.cloud.vps.v1.VPSMetricH\bR\x0ecurrentMetrics\x88\x01\x01\x12G\n" +
+	"\x0flast_patched_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampH\tR\rlastPatchedAt\x88\x01\x01\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
@@ -6741,7 +8224,8 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\x0e_root_passwordB\x12\n" +
 	"\x10_last_started_atB\r\n" +
 	"\v_deleted_atB\x12\n" +
-	"\x10_current_metrics\"Z\n" +
+	"\x10_current_metricsB\x12\n" +
+	"\x10_last_patched_at\"Z\n" +
 	"\x18ListFirewallRulesRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
 	"\x06vps_id\x18\x02 \x01(\tR\x05vpsId\"U\n" +
@@ -7054,7 +8538,67 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\x18DeleteVPSPublicIPRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
 	"\x19DeleteVPSPublicIPResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess*\xb0\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xbe\x02\n" +
+	"\vVPSTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\"\n" +
+	"\rsource_vps_id\x18\x05 \x01(\tR\vsourceVpsId\x12\x16\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\x124\n" +
+	"\x05image\x18\a \x01(\x0e2\x1e.obiente.cloud.vps.v1.VPSImageR\x05image\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_description\"\xa5\x01\n" +
+	"\x18CreateVPSTemplateRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
+	"\x06vps_id\x18\x02 \x01(\tR\x05vpsId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"Z\n" +
+	"\x19CreateVPSTemplateResponse\x12=\n" +
+	"\btemplate\x18\x01 \x01(\v2!.obiente.cloud.vps.v1.VPSTemplateR\btemplate\"B\n" +
+	"\x17ListVPSTemplatesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"[\n" +
+	"\x18ListVPSTemplatesResponse\x12?\n" +
+	"\ttemplates\x18\x01 \x03(\v2!.obiente.cloud.vps.v1.VPSTemplateR\ttemplates\"d\n" +
+	"\x18DeleteVPSTemplateRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\"5\n" +
+	"\x19DeleteVPSTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa1\x01\n" +
+	"\x06VPSISO\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\x12;\n" +
+	"\vuploaded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"uploadedAt\"=\n" +
+	"\x12ListOrgISOsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x87\x01\n" +
+	"\x13ListOrgISOsResponse\x120\n" +
+	"\x04isos\x18\x01 \x03(\v2\x1c.obiente.cloud.vps.v1.VPSISOR\x04isos\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x02 \x01(\x03R\tusedBytes\x12\x1f\n" +
+	"\vquota_bytes\x18\x03 \x01(\x03R\n" +
+	"quotaBytes\"V\n" +
+	"\x10DeleteISORequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\biso_name\x18\x02 \x01(\tR\aisoName\"-\n" +
+	"\x11DeleteISOResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"l\n" +
+	"\x0fMountISORequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
+	"\x06vps_id\x18\x02 \x01(\tR\x05vpsId\x12\x19\n" +
+	"\biso_name\x18\x03 \x01(\tR\aisoName\",\n" +
+	"\x10MountISOResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"S\n" +
+	"\x11UnmountISORequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
+	"\x06vps_id\x18\x02 \x01(\tR\x05vpsId\".\n" +
+	"\x12UnmountISOResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess*\xd2\x01\n" +
 	"\tVPSStatus\x12\x1a\n" +
 	"\x16VPS_STATUS_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bCREATING\x10\x01\x12\f\n" +
@@ -7068,7 +8612,10 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\bDELETING\x10\b\x12\v\n" +
 	"\aDELETED\x10\t\x12\r\n" +
 	"\tSUSPENDED\x10\n" +
-	"*\x98\x01\n" +
+	"\x12\x10\n" +
+	"\fUNRESPONSIVE\x10\v\x12\x0e\n" +
+	"\n" +
+	"TERMINATED\x10\f*\x98\x01\n" +
 	"\bVPSImage\x12\x19\n" +
 	"\x15VPS_IMAGE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fUBUNTU_22_04\x10\x01\x12\x10\n" +
@@ -7097,7 +8644,7 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\x04ICMP\x10\x03\x12\n" +
 	"\n" +
 	"\x06ICMPV6\x10\x04\x12\a\n" +
-	"\x03ALL\x10\x052\xd4\x1f\n" +
+	"\x03ALL\x10\x052\xd9(\n" +
 	"\n" +
 	"VPSService\x12V\n" +
 	"\aListVPS\x12$.obiente.cloud.vps.v1.ListVPSRequest\x1a%.obiente.cloud.vps.v1.ListVPSResponse\x12\\\n" +
@@ -7107,14 +8654,18 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\tDeleteVPS\x12&.obiente.cloud.vps.v1.DeleteVPSRequest\x1a'.obiente.cloud.vps.v1.DeleteVPSResponse\x12Y\n" +
 	"\bStartVPS\x12%.obiente.cloud.vps.v1.StartVPSRequest\x1a&.obiente.cloud.vps.v1.StartVPSResponse\x12V\n" +
 	"\aStopVPS\x12$.obiente.cloud.vps.v1.StopVPSRequest\x1a%.obiente.cloud.vps.v1.StopVPSResponse\x12\\\n" +
-	"\tRebootVPS\x12&.obiente.cloud.vps.v1.RebootVPSRequest\x1a'.obiente.cloud.vps.v1.RebootVPSResponse\x12h\n" +
+	"\tRebootVPS\x12&.obiente.cloud.vps.v1.RebootVPSRequest\x1a'.obiente.cloud.vps.v1.RebootVPSResponse\x12e\n" +
+	"\fForceStopVPS\x12).obiente.cloud.vps.v1.ForceStopVPSRequest\x1a*.obiente.cloud.vps.v1.ForceStopVPSResponse\x12Y\n" +
+	"\bPatchVPS\x12%.obiente.cloud.vps.v1.PatchVPSRequest\x1a&.obiente.cloud.vps.v1.PatchVPSResponse\x12h\n" +
 	"\x0fStreamVPSStatus\x12,.obiente.cloud.vps.v1.StreamVPSStatusRequest\x1a%.obiente.cloud.vps.v1.VPSStatusUpdate0\x01\x12h\n" +
 	"\rGetVPSMetrics\x12*.obiente.cloud.vps.v1.GetVPSMetricsRequest\x1a+.obiente.cloud.vps.v1.GetVPSMetricsResponse\x12d\n" +
 	"\x10StreamVPSMetrics\x12-.obiente.cloud.vps.v1.StreamVPSMetricsRequest\x1a\x1f.obiente.cloud.vps.v1.VPSMetric0\x01\x12b\n" +
 	"\vGetVPSUsage\x12(.obiente.cloud.vps.v1.GetVPSUsageRequest\x1a).obiente.cloud.vps.v1.GetVPSUsageResponse\x12w\n" +
 	"\fListVPSSizes\x122.obiente.cloud.vps.v1.ListAvailableVPSSizesRequest\x1a3.obiente.cloud.vps.v1.ListAvailableVPSSizesResponse\x12k\n" +
 	"\x0eListVPSRegions\x12+.obiente.cloud.vps.v1.ListVPSRegionsRequest\x1a,.obiente.cloud.vps.v1.ListVPSRegionsResponse\x12n\n" +
-	"\x0fGetVPSProxyInfo\x12,.obiente.cloud.vps.v1.GetVPSProxyInfoRequest\x1a-.obiente.cloud.vps.v1.GetVPSProxyInfoResponse\x12t\n" +
+	"\x0fGetVPSProxyInfo\x12,.obiente.cloud.vps.v1.GetVPSProxyInfoRequest\x1a-.obiente.cloud.vps.v1.GetVPSProxyInfoResponse\x12q\n" +
+	"\x10GetVPSConsoleURL\x12-.obiente.cloud.vps.v1.GetVPSConsoleURLRequest\x1a..obiente.cloud.vps.v1.GetVPSConsoleURLResponse\x12q\n" +
+	"\x10GetVPSVNCSession\x12-.obiente.cloud.vps.v1.GetVPSVNCSessionRequest\x1a..obiente.cloud.vps.v1.GetVPSVNCSessionResponse\x12t\n" +
 	"\x11ListFirewallRules\x12..obiente.cloud.vps.v1.ListFirewallRulesRequest\x1a/.obiente.cloud.vps.v1.ListFirewallRulesResponse\x12n\n" +
 	"\x0fGetFirewallRule\x12,.obiente.cloud.vps.v1.GetFirewallRuleRequest\x1a-.obiente.cloud.vps.v1.GetFirewallRuleResponse\x12w\n" +
 	"\x12CreateFirewallRule\x12/.obiente.cloud.vps.v1.CreateFirewallRuleRequest\x1a0.obiente.cloud.vps.v1.CreateFirewallRuleResponse\x12w\n" +
@@ -7137,7 +8688,15 @@ const file_obiente_cloud_vps_v1_vps_service_proto_rawDesc = "" +
 	"\rRegisterLease\x12*.obiente.cloud.vps.v1.RegisterLeaseRequest\x1a+.obiente.cloud.vps.v1.RegisterLeaseResponse\x12e\n" +
 	"\fReleaseLease\x12).obiente.cloud.vps.v1.ReleaseLeaseRequest\x1a*.obiente.cloud.vps.v1.ReleaseLeaseResponse\x12t\n" +
 	"\x11AssignVPSPublicIP\x12..obiente.cloud.vps.v1.AssignVPSPublicIPRequest\x1a/.obiente.cloud.vps.v1.AssignVPSPublicIPResponse\x12z\n" +
-	"\x13UnassignVPSPublicIP\x120.obiente.cloud.vps.v1.UnassignVPSPublicIPRequest\x1a1.obiente.cloud.vps.v1.UnassignVPSPublicIPResponseBGZEgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1;vpsv1b\x06proto3"
+	"\x13UnassignVPSPublicIP\x120.obiente.cloud.vps.v1.UnassignVPSPublicIPRequest\x1a1.obiente.cloud.vps.v1.UnassignVPSPublicIPResponse\x12t\n" +
+	"\x11CreateVPSTemplate\x12..obiente.cloud.vps.v1.CreateVPSTemplateRequest\x1a/.obiente.cloud.vps.v1.CreateVPSTemplateResponse\x12q\n" +
+	"\x10ListVPSTemplates\x12-.obiente.cloud.vps.v1.ListVPSTemplatesRequest\x1a..obiente.cloud.vps.v1.ListVPSTemplatesResponse\x12t\n" +
+	"\x11DeleteVPSTemplate\x12..obiente.cloud.vps.v1.DeleteVPSTemplateRequest\x1a/.obiente.cloud.vps.v1.DeleteVPSTemplateResponse\x12b\n" +
+	"\vListOrgISOs\x12(.obiente.cloud.vps.v1.ListOrgISOsRequest\x1a).obiente.cloud.vps.v1.ListOrgISOsResponse\x12\\\n" +
+	"\tDeleteISO\x12&.obiente.cloud.vps.v1.DeleteISORequest\x1a'.obiente.cloud.vps.v1.DeleteISOResponse\x12Y\n" +
+	"\bMountISO\x12%.obiente.cloud.vps.v1.MountISORequest\x1a&.obiente.cloud.vps.v1.MountISOResponse\x12_\n" +
+	"\n" +
+	"UnmountISO\x12'.obiente.cloud.vps.v1.UnmountISORequest\x1a(.obiente.cloud.vps.v1.UnmountISOResponseBGZEgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1;vpsv1b\x06proto3"
 
 var (
 	file_obiente_cloud_vps_v1_vps_service_proto_rawDescOnce sync.Once
@@ -7152,7 +8711,7 @@ func file_obiente_cloud_vps_v1_vps_service_proto_rawDescGZIP() []byte {
 }
 
 var file_obiente_cloud_vps_v1_vps_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_obiente_cloud_vps_v1_vps_service_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_obiente_cloud_vps_v1_vps_service_proto_msgTypes = make([]protoimpl.MessageInfo, 124)
 var file_obiente_cloud_vps_v1_vps_service_proto_goTypes = []any{
 	(VPSStatus)(0),                        // 0: obiente.cloud.vps.v1.VPSStatus
 	(VPSImage)(0),                         // 1: obiente.cloud.vps.v1.VPSImage
@@ -7162,253 +8721,311 @@ var file_obiente_cloud_vps_v1_vps_service_proto_goTypes = []any{
 	(*ListVPSRequest)(nil),                // 5: obiente.cloud.vps.v1.ListVPSRequest
 	(*ListVPSResponse)(nil),               // 6: obiente.cloud.vps.v1.ListVPSResponse
 	(*CreateVPSRequest)(nil),              // 7: obiente.cloud.vps.v1.CreateVPSRequest
-	(*CloudInitConfig)(nil),               // 8: obiente.cloud.vps.v1.CloudInitConfig
-	(*CloudInitUser)(nil),                 // 9: obiente.cloud.vps.v1.CloudInitUser
-	(*CloudInitWriteFile)(nil),            // 10: obiente.cloud.vps.v1.CloudInitWriteFile
-	(*CreateVPSResponse)(nil),             // 11: obiente.cloud.vps.v1.CreateVPSResponse
-	(*GetVPSRequest)(nil),                 // 12: obiente.cloud.vps.v1.GetVPSRequest
-	(*GetVPSResponse)(nil),                // 13: obiente.cloud.vps.v1.GetVPSResponse
-	(*UpdateVPSRequest)(nil),              // 14: obiente.cloud.vps.v1.UpdateVPSRequest
-	(*UpdateVPSResponse)(nil),             // 15: obiente.cloud.vps.v1.UpdateVPSResponse
-	(*DeleteVPSRequest)(nil),              // 16: obiente.cloud.vps.v1.DeleteVPSRequest
-	(*DeleteVPSResponse)(nil),             // 17: obiente.cloud.vps.v1.DeleteVPSResponse
-	(*StartVPSRequest)(nil),               // 18: obiente.cloud.vps.v1.StartVPSRequest
-	(*StartVPSResponse)(nil),              // 19: obiente.cloud.vps.v1.StartVPSResponse
-	(*StopVPSRequest)(nil),                // 20: obiente.cloud.vps.v1.StopVPSRequest
-	(*StopVPSResponse)(nil),               // 21: obiente.cloud.vps.v1.StopVPSResponse
-	(*RebootVPSRequest)(nil),              // 22: obiente.cloud.vps.v1.RebootVPSRequest
-	(*RebootVPSResponse)(nil),             // 23: obiente.cloud.vps.v1.RebootVPSResponse
-	(*StreamVPSStatusRequest)(nil),        // 24: obiente.cloud.vps.v1.StreamVPSStatusRequest
-	(*VPSStatusUpdate)(nil),               // 25: obiente.cloud.vps.v1.VPSStatusUpdate
-	(*GetVPSMetricsRequest)(nil),          // 26: obiente.cloud.vps.v1.GetVPSMetricsRequest
-	(*GetVPSMetricsResponse)(nil),         // 27: obiente.cloud.vps.v1.GetVPSMetricsResponse
-	(*StreamVPSMetricsRequest)(nil),       // 28: obiente.cloud.vps.v1.StreamVPSMetricsRequest
-	(*VPSMetric)(nil),                     // 29: obiente.cloud.vps.v1.VPSMetric
-	(*GetVPSUsageRequest)(nil),            // 30: obiente.cloud.vps.v1.GetVPSUsageRequest
-	(*FindVPSByLeaseRequest)(nil),         // 31: obiente.cloud.vps.v1.FindVPSByLeaseRequest
-	(*FindVPSByLeaseResponse)(nil),        // 32: obiente.cloud.vps.v1.FindVPSByLeaseResponse
-	(*GetVPSUsageResponse)(nil),           // 33: obiente.cloud.vps.v1.GetVPSUsageResponse
-	(*VPSUsageMetrics)(nil),               // 34: obiente.cloud.vps.v1.VPSUsageMetrics
-	(*ListAvailableVPSSizesRequest)(nil),  // 35: obiente.cloud.vps.v1.ListAvailableVPSSizesRequest
-	(*ListAvailableVPSSizesResponse)(nil), // 36: obiente.cloud.vps.v1.ListAvailableVPSSizesResponse
-	(*ListVPSRegionsRequest)(nil),         // 37: obiente.cloud.vps.v1.ListVPSRegionsRequest
-	(*ListVPSRegionsResponse)(nil),        // 38: obiente.cloud.vps.v1.ListVPSRegionsResponse
-	(*GetVPSProxyInfoRequest)(nil),        // 39: obiente.cloud.vps.v1.GetVPSProxyInfoRequest
-	(*GetVPSProxyInfoResponse)(nil),       // 40: obiente.cloud.vps.v1.GetVPSProxyInfoResponse
-	(*VPSRegion)(nil),                     // 41: obiente.cloud.vps.v1.VPSRegion
-	(*VPSInstance)(nil),                   // 42: obiente.cloud.vps.v1.VPSInstance
-	(*ListFirewallRulesRequest)(nil),      // 43: obiente.cloud.vps.v1.ListFirewallRulesRequest
-	(*ListFirewallRulesResponse)(nil),     // 44: obiente.cloud.vps.v1.ListFirewallRulesResponse
-	(*GetFirewallRuleRequest)(nil),        // 45: obiente.cloud.vps.v1.GetFirewallRuleRequest
-	(*GetFirewallRuleResponse)(nil),       // 46: obiente.cloud.vps.v1.GetFirewallRuleResponse
-	(*CreateFirewallRuleRequest)(nil),     // 47: obiente.cloud.vps.v1.CreateFirewallRuleRequest
-	(*CreateFirewallRuleResponse)(nil),    // 48: obiente.cloud.vps.v1.CreateFirewallRuleResponse
-	(*UpdateFirewallRuleRequest)(nil),     // 49: obiente.cloud.vps.v1.UpdateFirewallRuleRequest
-	(*UpdateFirewallRuleResponse)(nil),    // 50: obiente.cloud.vps.v1.UpdateFirewallRuleResponse
-	(*DeleteFirewallRuleRequest)(nil),     // 51: obiente.cloud.vps.v1.DeleteFirewallRuleRequest
-	(*DeleteFirewallRuleResponse)(nil),    // 52: obiente.cloud.vps.v1.DeleteFirewallRuleResponse
-	(*GetFirewallOptionsRequest)(nil),     // 53: obiente.cloud.vps.v1.GetFirewallOptionsRequest
-	(*GetFirewallOptionsResponse)(nil),    // 54: obiente.cloud.vps.v1.GetFirewallOptionsResponse
-	(*UpdateFirewallOptionsRequest)(nil),  // 55: obiente.cloud.vps.v1.UpdateFirewallOptionsRequest
-	(*UpdateFirewallOptionsResponse)(nil), // 56: obiente.cloud.vps.v1.UpdateFirewallOptionsResponse
-	(*FirewallRule)(nil),                  // 57: obiente.cloud.vps.v1.FirewallRule
-	(*FirewallOptions)(nil),               // 58: obiente.cloud.vps.v1.FirewallOptions
-	(*SSHKey)(nil),                        // 59: obiente.cloud.vps.v1.SSHKey
-	(*ListSSHKeysRequest)(nil),            // 60: obiente.cloud.vps.v1.ListSSHKeysRequest
-	(*ListSSHKeysResponse)(nil),           // 61: obiente.cloud.vps.v1.ListSSHKeysResponse
-	(*AddSSHKeyRequest)(nil),              // 62: obiente.cloud.vps.v1.AddSSHKeyRequest
-	(*AddSSHKeyResponse)(nil),             // 63: obiente.cloud.vps.v1.AddSSHKeyResponse
-	(*UpdateSSHKeyRequest)(nil),           // 64: obiente.cloud.vps.v1.UpdateSSHKeyRequest
-	(*UpdateSSHKeyResponse)(nil),          // 65: obiente.cloud.vps.v1.UpdateSSHKeyResponse
-	(*RemoveSSHKeyRequest)(nil),           // 66: obiente.cloud.vps.v1.RemoveSSHKeyRequest
-	(*RemoveSSHKeyResponse)(nil),          // 67: obiente.cloud.vps.v1.RemoveSSHKeyResponse
-	(*ResetVPSPasswordRequest)(nil),       // 68: obiente.cloud.vps.v1.ResetVPSPasswordRequest
-	(*ResetVPSPasswordResponse)(nil),      // 69: obiente.cloud.vps.v1.ResetVPSPasswordResponse
-	(*ReinitializeVPSRequest)(nil),        // 70: obiente.cloud.vps.v1.ReinitializeVPSRequest
-	(*ReinitializeVPSResponse)(nil),       // 71: obiente.cloud.vps.v1.ReinitializeVPSResponse
-	(*StreamVPSLogsRequest)(nil),          // 72: obiente.cloud.vps.v1.StreamVPSLogsRequest
-	(*VPSLogLine)(nil),                    // 73: obiente.cloud.vps.v1.VPSLogLine
-	(*GetVPSJournalLogsRequest)(nil),      // 74: obiente.cloud.vps.v1.GetVPSJournalLogsRequest
-	(*GetVPSJournalLogsResponse)(nil),     // 75: obiente.cloud.vps.v1.GetVPSJournalLogsResponse
-	(*ListVPSServicesRequest)(nil),        // 76: obiente.cloud.vps.v1.ListVPSServicesRequest
-	(*VPSSystemService)(nil),              // 77: obiente.cloud.vps.v1.VPSSystemService
-	(*ListVPSServicesResponse)(nil),       // 78: obiente.cloud.vps.v1.ListVPSServicesResponse
-	(*ImportVPSRequest)(nil),              // 79: obiente.cloud.vps.v1.ImportVPSRequest
-	(*ImportVPSResponse)(nil),             // 80: obiente.cloud.vps.v1.ImportVPSResponse
-	(*GetVPSLeasesRequest)(nil),           // 81: obiente.cloud.vps.v1.GetVPSLeasesRequest
-	(*VPSLease)(nil),                      // 82: obiente.cloud.vps.v1.VPSLease
-	(*GetVPSLeasesResponse)(nil),          // 83: obiente.cloud.vps.v1.GetVPSLeasesResponse
-	(*RegisterLeaseRequest)(nil),          // 84: obiente.cloud.vps.v1.RegisterLeaseRequest
-	(*RegisterLeaseResponse)(nil),         // 85: obiente.cloud.vps.v1.RegisterLeaseResponse
-	(*ReleaseLeaseRequest)(nil),           // 86: obiente.cloud.vps.v1.ReleaseLeaseRequest
-	(*ReleaseLeaseResponse)(nil),          // 87: obiente.cloud.vps.v1.ReleaseLeaseResponse
-	(*AssignVPSPublicIPRequest)(nil),      // 88: obiente.cloud.vps.v1.AssignVPSPublicIPRequest
-	(*AssignVPSPublicIPResponse)(nil),     // 89: obiente.cloud.vps.v1.AssignVPSPublicIPResponse
-	(*UnassignVPSPublicIPRequest)(nil),    // 90: obiente.cloud.vps.v1.UnassignVPSPublicIPRequest
-	(*UnassignVPSPublicIPResponse)(nil),   // 91: obiente.cloud.vps.v1.UnassignVPSPublicIPResponse
-	(*VPSPublicIP)(nil),                   // 92: obiente.cloud.vps.v1.VPSPublicIP
-	(*ListVPSPublicIPsRequest)(nil),       // 93: obiente.cloud.vps.v1.ListVPSPublicIPsRequest
-	(*ListVPSPublicIPsResponse)(nil),      // 94: obiente.cloud.vps.v1.ListVPSPublicIPsResponse
-	(*CreateVPSPublicIPRequest)(nil),      // 95: obiente.cloud.vps.v1.CreateVPSPublicIPRequest
-	(*CreateVPSPublicIPResponse)(nil),     // 96: obiente.cloud.vps.v1.CreateVPSPublicIPResponse
-	(*UpdateVPSPublicIPRequest)(nil),      // 97: obiente.cloud.vps.v1.UpdateVPSPublicIPRequest
-	(*UpdateVPSPublicIPResponse)(nil),     // 98: obiente.cloud.vps.v1.UpdateVPSPublicIPResponse
-	(*DeleteVPSPublicIPRequest)(nil),      // 99: obiente.cloud.vps.v1.DeleteVPSPublicIPRequest
-	(*DeleteVPSPublicIPResponse)(nil),     // 100: obiente.cloud.vps.v1.DeleteVPSPublicIPResponse
-	nil,                                   // 101: obiente.cloud.vps.v1.CreateVPSRequest.MetadataEntry
-	nil,                                   // 102: obiente.cloud.vps.v1.UpdateVPSRequest.MetadataEntry
-	nil,                                   // 103: obiente.cloud.vps.v1.VPSInstance.MetadataEntry
-	(*v1.Pagination)(nil),                 // 104: obiente.cloud.common.v1.Pagination
-	(*timestamppb.Timestamp)(nil),         // 105: google.protobuf.Timestamp
-	(*v1.VPSSize)(nil),                    // 106: obiente.cloud.common.v1.VPSSize
+	(*GPUConfig)(nil),                     // 8: obiente.cloud.vps.v1.GPUConfig
+	(*CloudInitConfig)(nil),               // 9: obiente.cloud.vps.v1.CloudInitConfig
+	(*CloudInitUser)(nil),                 // 10: obiente.cloud.vps.v1.CloudInitUser
+	(*CloudInitWriteFile)(nil),            // 11: obiente.cloud.vps.v1.CloudInitWriteFile
+	(*CreateVPSResponse)(nil),             // 12: obiente.cloud.vps.v1.CreateVPSResponse
+	(*GetVPSRequest)(nil),                 // 13: obiente.cloud.vps.v1.GetVPSRequest
+	(*GetVPSResponse)(nil),                // 14: obiente.cloud.vps.v1.GetVPSResponse
+	(*UpdateVPSRequest)(nil),              // 15: obiente.cloud.vps.v1.UpdateVPSRequest
+	(*UpdateVPSResponse)(nil),             // 16: obiente.cloud.vps.v1.UpdateVPSResponse
+	(*DeleteVPSRequest)(nil),              // 17: obiente.cloud.vps.v1.DeleteVPSRequest
+	(*DeleteVPSResponse)(nil),             // 18: obiente.cloud.vps.v1.DeleteVPSResponse
+	(*StartVPSRequest)(nil),               // 19: obiente.cloud.vps.v1.StartVPSRequest
+	(*StartVPSResponse)(nil),              // 20: obiente.cloud.vps.v1.StartVPSResponse
+	(*StopVPSRequest)(nil),                // 21: obiente.cloud.vps.v1.StopVPSRequest
+	(*StopVPSResponse)(nil),               // 22: obiente.cloud.vps.v1.StopVPSResponse
+	(*RebootVPSRequest)(nil),              // 23: obiente.cloud.vps.v1.RebootVPSRequest
+	(*RebootVPSResponse)(nil),             // 24: obiente.cloud.vps.v1.RebootVPSResponse
+	(*PatchVPSRequest)(nil),               // 25: obiente.cloud.vps.v1.PatchVPSRequest
+	(*PatchVPSResponse)(nil),              // 26: obiente.cloud.vps.v1.PatchVPSResponse
+	(*ForceStopVPSRequest)(nil),           // 27: obiente.cloud.vps.v1.ForceStopVPSRequest
+	(*ForceStopVPSResponse)(nil),          // 28: obiente.cloud.vps.v1.ForceStopVPSResponse
+	(*StreamVPSStatusRequest)(nil),        // 29: obiente.cloud.vps.v1.StreamVPSStatusRequest
+	(*VPSStatusUpdate)(nil),               // 30: obiente.cloud.vps.v1.VPSStatusUpdate
+	(*GetVPSMetricsRequest)(nil),          // 31: obiente.cloud.vps.v1.GetVPSMetricsRequest
+	(*GetVPSMetricsResponse)(nil),         // 32: obiente.cloud.vps.v1.GetVPSMetricsResponse
+	(*StreamVPSMetricsRequest)(nil),       // 33: obiente.cloud.vps.v1.StreamVPSMetricsRequest
+	(*VPSMetric)(nil),                     // 34: obiente.cloud.vps.v1.VPSMetric
+	(*GetVPSUsageRequest)(nil),            // 35: obiente.cloud.vps.v1.GetVPSUsageRequest
+	(*FindVPSByLeaseRequest)(nil),         // 36: obiente.cloud.vps.v1.FindVPSByLeaseRequest
+	(*FindVPSByLeaseResponse)(nil),        // 37: obiente.cloud.vps.v1.FindVPSByLeaseResponse
+	(*GetVPSUsageResponse)(nil),           // 38: obiente.cloud.vps.v1.GetVPSUsageResponse
+	(*VPSUsageMetrics)(nil),               // 39: obiente.cloud.vps.v1.VPSUsageMetrics
+	(*ListAvailableVPSSizesRequest)(nil),  // 40: obiente.cloud.vps.v1.ListAvailableVPSSizesRequest
+	(*ListAvailableVPSSizesResponse)(nil), // 41: obiente.cloud.vps.v1.ListAvailableVPSSizesResponse
+	(*ListVPSRegionsRequest)(nil),         // 42: obiente.cloud.vps.v1.ListVPSRegionsRequest
+	(*ListVPSRegionsResponse)(nil),        // 43: obiente.cloud.vps.v1.ListVPSRegionsResponse
+	(*GetVPSProxyInfoRequest)(nil),        // 44: obiente.cloud.vps.v1.GetVPSProxyInfoRequest
+	(*GetVPSProxyInfoResponse)(nil),       // 45: obiente.cloud.vps.v1.GetVPSProxyInfoResponse
+	(*GetVPSConsoleURLRequest)(nil),       // 46: obiente.cloud.vps.v1.GetVPSConsoleURLRequest
+	(*GetVPSConsoleURLResponse)(nil),      // 47: obiente.cloud.vps.v1.GetVPSConsoleURLResponse
+	(*GetVPSVNCSessionRequest)(nil),       // 48: obiente.cloud.vps.v1.GetVPSVNCSessionRequest
+	(*GetVPSVNCSessionResponse)(nil),      // 49: obiente.cloud.vps.v1.GetVPSVNCSessionResponse
+	(*VPSRegion)(nil),                     // 50: obiente.cloud.vps.v1.VPSRegion
+	(*VPSInstance)(nil),                   // 51: obiente.cloud.vps.v1.VPSInstance
+	(*ListFirewallRulesRequest)(nil),      // 52: obiente.cloud.vps.v1.ListFirewallRulesRequest
+	(*ListFirewallRulesResponse)(nil),     // 53: obiente.cloud.vps.v1.ListFirewallRulesResponse
+	(*GetFirewallRuleRequest)(nil),        // 54: obiente.cloud.vps.v1.GetFirewallRuleRequest
+	(*GetFirewallRuleResponse)(nil),       // 55: obiente.cloud.vps.v1.GetFirewallRuleResponse
+	(*CreateFirewallRuleRequest)(nil),     // 56: obiente.cloud.vps.v1.CreateFirewallRuleRequest
+	(*CreateFirewallRuleResponse)(nil),    // 57: obiente.cloud.vps.v1.CreateFirewallRuleResponse
+	(*UpdateFirewallRuleRequest)(nil),     // 58: obiente.cloud.vps.v1.UpdateFirewallRuleRequest
+	(*UpdateFirewallRuleResponse)(nil),    // 59: obiente.cloud.vps.v1.UpdateFirewallRuleResponse
+	(*DeleteFirewallRuleRequest)(nil),     // 60: obiente.cloud.vps.v1.DeleteFirewallRuleRequest
+	(*DeleteFirewallRuleResponse)(nil),    // 61: obiente.cloud.vps.v1.DeleteFirewallRuleResponse
+	(*GetFirewallOptionsRequest)(nil),     // 62: obiente.cloud.vps.v1.GetFirewallOptionsRequest
+	(*GetFirewallOptionsResponse)(nil),    // 63: obiente.cloud.vps.v1.GetFirewallOptionsResponse
+	(*UpdateFirewallOptionsRequest)(nil),  // 64: obiente.cloud.vps.v1.UpdateFirewallOptionsRequest
+	(*UpdateFirewallOptionsResponse)(nil), // 65: obiente.cloud.vps.v1.UpdateFirewallOptionsResponse
+	(*FirewallRule)(nil),                  // 66: obiente.cloud.vps.v1.FirewallRule
+	(*FirewallOptions)(nil),               // 67: obiente.cloud.vps.v1.FirewallOptions
+	(*SSHKey)(nil),                        // 68: obiente.cloud.vps.v1.SSHKey
+	(*ListSSHKeysRequest)(nil),            // 69: obiente.cloud.vps.v1.ListSSHKeysRequest
+	(*ListSSHKeysResponse)(nil),           // 70: obiente.cloud.vps.v1.ListSSHKeysResponse
+	(*AddSSHKeyRequest)(nil),              // 71: obiente.cloud.vps.v1.AddSSHKeyRequest
+	(*AddSSHKeyResponse)(nil),             // 72: obiente.cloud.vps.v1.AddSSHKeyResponse
+	(*UpdateSSHKeyRequest)(nil),           // 73: obiente.cloud.vps.v1.UpdateSSHKeyRequest
+	(*UpdateSSHKeyResponse)(nil),          // 74: obiente.cloud.vps.v1.UpdateSSHKeyResponse
+	(*RemoveSSHKeyRequest)(nil),           // 75: obiente.cloud.vps.v1.RemoveSSHKeyRequest
+	(*RemoveSSHKeyResponse)(nil),          // 76: obiente.cloud.vps.v1.RemoveSSHKeyResponse
+	(*ResetVPSPasswordRequest)(nil),       // 77: obiente.cloud.vps.v1.ResetVPSPasswordRequest
+	(*ResetVPSPasswordResponse)(nil),      // 78: obiente.cloud.vps.v1.ResetVPSPasswordResponse
+	(*ReinitializeVPSRequest)(nil),        // 79: obiente.cloud.vps.v1.ReinitializeVPSRequest
+	(*ReinitializeVPSResponse)(nil),       // 80: obiente.cloud.vps.v1.ReinitializeVPSResponse
+	(*StreamVPSLogsRequest)(nil),          // 81: obiente.cloud.vps.v1.StreamVPSLogsRequest
+	(*VPSLogLine)(nil),                    // 82: obiente.cloud.vps.v1.VPSLogLine
+	(*GetVPSJournalLogsRequest)(nil),      // 83: obiente.cloud.vps.v1.GetVPSJournalLogsRequest
+	(*GetVPSJournalLogsResponse)(nil),     // 84: obiente.cloud.vps.v1.GetVPSJournalLogsResponse
+	(*ListVPSServicesRequest)(nil),        // 85: obiente.cloud.vps.v1.ListVPSServicesRequest
+	(*VPSSystemService)(nil),              // 86: obiente.cloud.vps.v1.VPSSystemService
+	(*ListVPSServicesResponse)(nil),       // 87: obiente.cloud.vps.v1.ListVPSServicesResponse
+	(*ImportVPSRequest)(nil),              // 88: obiente.cloud.vps.v1.ImportVPSRequest
+	(*ImportVPSResponse)(nil),             // 89: obiente.cloud.vps.v1.ImportVPSResponse
+	(*GetVPSLeasesRequest)(nil),           // 90: obiente.cloud.vps.v1.GetVPSLeasesRequest
+	(*VPSLease)(nil),                      // 91: obiente.cloud.vps.v1.VPSLease
+	(*GetVPSLeasesResponse)(nil),          // 92: obiente.cloud.vps.v1.GetVPSLeasesResponse
+	(*RegisterLeaseRequest)(nil),          // 93: obiente.cloud.vps.v1.RegisterLeaseRequest
+	(*RegisterLeaseResponse)(nil),         // 94: obiente.cloud.vps.v1.RegisterLeaseResponse
+	(*ReleaseLeaseRequest)(nil),           // 95: obiente.cloud.vps.v1.ReleaseLeaseRequest
+	(*ReleaseLeaseResponse)(nil),          // 96: obiente.cloud.vps.v1.ReleaseLeaseResponse
+	(*AssignVPSPublicIPRequest)(nil),      // 97: obiente.cloud.vps.v1.AssignVPSPublicIPRequest
+	(*AssignVPSPublicIPResponse)(nil),     // 98: obiente.cloud.vps.v1.AssignVPSPublicIPResponse
+	(*UnassignVPSPublicIPRequest)(nil),    // 99: obiente.cloud.vps.v1.UnassignVPSPublicIPRequest
+	(*UnassignVPSPublicIPResponse)(nil),   // 100: obiente.cloud.vps.v1.UnassignVPSPublicIPResponse
+	(*VPSPublicIP)(nil),                   // 101: obiente.cloud.vps.v1.VPSPublicIP
+	(*ListVPSPublicIPsRequest)(nil),       // 102: obiente.cloud.vps.v1.ListVPSPublicIPsRequest
+	(*ListVPSPublicIPsResponse)(nil),      // 103: obiente.cloud.vps.v1.ListVPSPublicIPsResponse
+	(*CreateVPSPublicIPRequest)(nil),      // 104: obiente.cloud.vps.v1.CreateVPSPublicIPRequest
+	(*CreateVPSPublicIPResponse)(nil),     // 105: obiente.cloud.vps.v1.CreateVPSPublicIPResponse
+	(*UpdateVPSPublicIPRequest)(nil),      // 106: obiente.cloud.vps.v1.UpdateVPSPublicIPRequest
+	(*UpdateVPSPublicIPResponse)(nil),     // 107: obiente.cloud.vps.v1.UpdateVPSPublicIPResponse
+	(*DeleteVPSPublicIPRequest)(nil),      // 108: obiente.cloud.vps.v1.DeleteVPSPublicIPRequest
+	(*DeleteVPSPublicIPResponse)(nil),     // 109: obiente.cloud.vps.v1.DeleteVPSPublicIPResponse
+	(*VPSTemplate)(nil),                   // 110: obiente.cloud.vps.v1.VPSTemplate
+	(*CreateVPSTemplateRequest)(nil),      // 111: obiente.cloud.vps.v1.CreateVPSTemplateRequest
+	(*CreateVPSTemplateResponse)(nil),     // 112: obiente.cloud.vps.v1.CreateVPSTemplateResponse
+	(*ListVPSTemplatesRequest)(nil),       // 113: obiente.cloud.vps.v1.ListVPSTemplatesRequest
+	(*ListVPSTemplatesResponse)(nil),      // 114: obiente.cloud.vps.v1.ListVPSTemplatesResponse
+	(*DeleteVPSTemplateRequest)(nil),      // 115: obiente.cloud.vps.v1.DeleteVPSTemplateRequest
+	(*DeleteVPSTemplateResponse)(nil),     // 116: obiente.cloud.vps.v1.DeleteVPSTemplateResponse
+	(*VPSISO)(nil),                        // 117: obiente.cloud.vps.v1.VPSISO
+	(*ListOrgISOsRequest)(nil),            // 118: obiente.cloud.vps.v1.ListOrgISOsRequest
+	(*ListOrgISOsResponse)(nil),           // 119: obiente.cloud.vps.v1.ListOrgISOsResponse
+	(*DeleteISORequest)(nil),              // 120: obiente.cloud.vps.v1.DeleteISORequest
+	(*DeleteISOResponse)(nil),             // 121: obiente.cloud.vps.v1.DeleteISOResponse
+	(*MountISORequest)(nil),               // 122: obiente.cloud.vps.v1.MountISORequest
+	(*MountISOResponse)(nil),              // 123: obiente.cloud.vps.v1.MountISOResponse
+	(*UnmountISORequest)(nil),             // 124: obiente.cloud.vps.v1.UnmountISORequest
+	(*UnmountISOResponse)(nil),            // 125: obiente.cloud.vps.v1.UnmountISOResponse
+	nil,                                   // 126: obiente.cloud.vps.v1.CreateVPSRequest.MetadataEntry
+	nil,                                   // 127: obiente.cloud.vps.v1.UpdateVPSRequest.MetadataEntry
+	nil,                                   // 128: obiente.cloud.vps.v1.VPSInstance.MetadataEntry
+	(*v1.Pagination)(nil),                 // 129: obiente.cloud.common.v1.Pagination
+	(*timestamppb.Timestamp)(nil),         // 130: google.protobuf.Timestamp
+	(*v1.VPSSize)(nil),                    // 131: obiente.cloud.common.v1.VPSSize
 }
 var file_obiente_cloud_vps_v1_vps_service_proto_depIdxs = []int32{
 	0,   // 0: obiente.cloud.vps.v1.ListVPSRequest.status:type_name -> obiente.cloud.vps.v1.VPSStatus
-	42,  // 1: obiente.cloud.vps.v1.ListVPSResponse.vps_instances:type_name -> obiente.cloud.vps.v1.VPSInstance
-	104, // 2: obiente.cloud.vps.v1.ListVPSResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
+	51,  // 1: obiente.cloud.vps.v1.ListVPSResponse.vps_instances:type_name -> obiente.cloud.vps.v1.VPSInstance
+	129, // 2: obiente.cloud.vps.v1.ListVPSResponse.pagination:type_name -> obiente.cloud.common.v1.Pagination
 	1,   // 3: obiente.cloud.vps.v1.CreateVPSRequest.image:type_name -> obiente.cloud.vps.v1.VPSImage
-	101, // 4: obiente.cloud.vps.v1.CreateVPSRequest.metadata:type_name -> obiente.cloud.vps.v1.CreateVPSRequest.MetadataEntry
-	8,   // 5: obiente.cloud.vps.v1.CreateVPSRequest.cloud_init:type_name -> obiente.cloud.vps.v1.CloudInitConfig
-	9,   // 6: obiente.cloud.vps.v1.CloudInitConfig.users:type_name -> obiente.cloud.vps.v1.CloudInitUser
-	10,  // 7: obiente.cloud.vps.v1.CloudInitConfig.write_files:type_name -> obiente.cloud.vps.v1.CloudInitWriteFile
-	42,  // 8: obiente.cloud.vps.v1.CreateVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	42,  // 9: obiente.cloud.vps.v1.GetVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	102, // 10: obiente.cloud.vps.v1.UpdateVPSRequest.metadata:type_name -> obiente.cloud.vps.v1.UpdateVPSRequest.MetadataEntry
-	42,  // 11: obiente.cloud.vps.v1.UpdateVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	42,  // 12: obiente.cloud.vps.v1.StartVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	42,  // 13: obiente.cloud.vps.v1.StopVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	42,  // 14: obiente.cloud.vps.v1.RebootVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	0,   // 15: obiente.cloud.vps.v1.VPSStatusUpdate.status:type_name -> obiente.cloud.vps.v1.VPSStatus
-	105, // 16: obiente.cloud.vps.v1.VPSStatusUpdate.timestamp:type_name -> google.protobuf.Timestamp
-	105, // 17: obiente.cloud.vps.v1.GetVPSMetricsRequest.start_time:type_name -> google.protobuf.Timestamp
-	105, // 18: obiente.cloud.vps.v1.GetVPSMetricsRequest.end_time:type_name -> google.protobuf.Timestamp
-	29,  // 19: obiente.cloud.vps.v1.GetVPSMetricsResponse.metrics:type_name -> obiente.cloud.vps.v1.VPSMetric
-	105, // 20: obiente.cloud.vps.v1.VPSMetric.timestamp:type_name -> google.protobuf.Timestamp
-	34,  // 21: obiente.cloud.vps.v1.GetVPSUsageResponse.current:type_name -> obiente.cloud.vps.v1.VPSUsageMetrics
-	34,  // 22: obiente.cloud.vps.v1.GetVPSUsageResponse.estimated_monthly:type_name -> obiente.cloud.vps.v1.VPSUsageMetrics
-	106, // 23: obiente.cloud.vps.v1.ListAvailableVPSSizesResponse.sizes:type_name -> obiente.cloud.common.v1.VPSSize
-	41,  // 24: obiente.cloud.vps.v1.ListVPSRegionsResponse.regions:type_name -> obiente.cloud.vps.v1.VPSRegion
-	0,   // 25: obiente.cloud.vps.v1.VPSInstance.status:type_name -> obiente.cloud.vps.v1.VPSStatus
-	1,   // 26: obiente.cloud.vps.v1.VPSInstance.image:type_name -> obiente.cloud.vps.v1.VPSImage
-	103, // 27: obiente.cloud.vps.v1.VPSInstance.metadata:type_name -> obiente.cloud.vps.v1.VPSInstance.MetadataEntry
-	105, // 28: obiente.cloud.vps.v1.VPSInstance.created_at:type_name -> google.protobuf.Timestamp
-	105, // 29: obiente.cloud.vps.v1.VPSInstance.updated_at:type_name -> google.protobuf.Timestamp
-	105, // 30: obiente.cloud.vps.v1.VPSInstance.last_started_at:type_name -> google.protobuf.Timestamp
-	105, // 31: obiente.cloud.vps.v1.VPSInstance.deleted_at:type_name -> google.protobuf.Timestamp
-	29,  // 32: obiente.cloud.vps.v1.VPSInstance.current_metrics:type_name -> obiente.cloud.vps.v1.VPSMetric
-	57,  // 33: obiente.cloud.vps.v1.ListFirewallRulesResponse.rules:type_name -> obiente.cloud.vps.v1.FirewallRule
-	57,  // 34: obiente.cloud.vps.v1.GetFirewallRuleResponse.rule:type_name -> obiente.cloud.vps.v1.FirewallRule
-	57,  // 35: obiente.cloud.vps.v1.CreateFirewallRuleRequest.rule:type_name -> obiente.cloud.vps.v1.FirewallRule
-	57,  // 36: obiente.cloud.vps.v1.CreateFirewallRuleResponse.rule:type_name -> obiente.cloud.vps.v1.FirewallRule
-	57,  // 37: obiente.cloud.vps.v1.UpdateFirewallRuleRequest.rule:type_name -> obiente.cloud.vps.v1.FirewallRule
-	57,  // 38: obiente.cloud.vps.v1.UpdateFirewallRuleResponse.rule:type_name -> obiente.cloud.vps.v1.FirewallRule
-	58,  // 39: obiente.cloud.vps.v1.GetFirewallOptionsResponse.options:type_name -> obiente.cloud.vps.v1.FirewallOptions
-	58,  // 40: obiente.cloud.vps.v1.UpdateFirewallOptionsRequest.options:type_name -> obiente.cloud.vps.v1.FirewallOptions
-	58,  // 41: obiente.cloud.vps.v1.UpdateFirewallOptionsResponse.options:type_name -> obiente.cloud.vps.v1.FirewallOptions
-	2,   // 42: obiente.cloud.vps.v1.FirewallRule.action:type_name -> obiente.cloud.vps.v1.FirewallAction
-	3,   // 43: obiente.cloud.vps.v1.FirewallRule.type:type_name -> obiente.cloud.vps.v1.FirewallDirection
-	4,   // 44: obiente.cloud.vps.v1.FirewallRule.protocol:type_name -> obiente.cloud.vps.v1.FirewallProtocol
-	105, // 45: obiente.cloud.vps.v1.SSHKey.created_at:type_name -> google.protobuf.Timestamp
-	105, // 46: obiente.cloud.vps.v1.SSHKey.updated_at:type_name -> google.protobuf.Timestamp
-	59,  // 47: obiente.cloud.vps.v1.ListSSHKeysResponse.keys:type_name -> obiente.cloud.vps.v1.SSHKey
-	59,  // 48: obiente.cloud.vps.v1.AddSSHKeyResponse.key:type_name -> obiente.cloud.vps.v1.SSHKey
-	59,  // 49: obiente.cloud.vps.v1.UpdateSSHKeyResponse.key:type_name -> obiente.cloud.vps.v1.SSHKey
-	42,  // 50: obiente.cloud.vps.v1.ReinitializeVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	105, // 51: obiente.cloud.vps.v1.VPSLogLine.timestamp:type_name -> google.protobuf.Timestamp
-	73,  // 52: obiente.cloud.vps.v1.GetVPSJournalLogsResponse.logs:type_name -> obiente.cloud.vps.v1.VPSLogLine
-	77,  // 53: obiente.cloud.vps.v1.ListVPSServicesResponse.services:type_name -> obiente.cloud.vps.v1.VPSSystemService
-	105, // 54: obiente.cloud.vps.v1.ListVPSServicesResponse.fetched_at:type_name -> google.protobuf.Timestamp
-	42,  // 55: obiente.cloud.vps.v1.ImportVPSResponse.imported_vps:type_name -> obiente.cloud.vps.v1.VPSInstance
-	105, // 56: obiente.cloud.vps.v1.VPSLease.expires_at:type_name -> google.protobuf.Timestamp
-	82,  // 57: obiente.cloud.vps.v1.GetVPSLeasesResponse.leases:type_name -> obiente.cloud.vps.v1.VPSLease
-	105, // 58: obiente.cloud.vps.v1.RegisterLeaseRequest.expires_at:type_name -> google.protobuf.Timestamp
-	105, // 59: obiente.cloud.vps.v1.VPSPublicIP.assigned_at:type_name -> google.protobuf.Timestamp
-	105, // 60: obiente.cloud.vps.v1.VPSPublicIP.created_at:type_name -> google.protobuf.Timestamp
-	105, // 61: obiente.cloud.vps.v1.VPSPublicIP.updated_at:type_name -> google.protobuf.Timestamp
-	92,  // 62: obiente.cloud.vps.v1.ListVPSPublicIPsResponse.ips:type_name -> obiente.cloud.vps.v1.VPSPublicIP
-	92,  // 63: obiente.cloud.vps.v1.CreateVPSPublicIPResponse.ip:type_name -> obiente.cloud.vps.v1.VPSPublicIP
-	92,  // 64: obiente.cloud.vps.v1.UpdateVPSPublicIPResponse.ip:type_name -> obiente.cloud.vps.v1.VPSPublicIP
-	5,   // 65: obiente.cloud.vps.v1.VPSService.ListVPS:input_type -> obiente.cloud.vps.v1.ListVPSRequest
-	7,   // 66: obiente.cloud.vps.v1.VPSService.CreateVPS:input_type -> obiente.cloud.vps.v1.CreateVPSRequest
-	12,  // 67: obiente.cloud.vps.v1.VPSService.GetVPS:input_type -> obiente.cloud.vps.v1.GetVPSRequest
-	14,  // 68: obiente.cloud.vps.v1.VPSService.UpdateVPS:input_type -> obiente.cloud.vps.v1.UpdateVPSRequest
-	16,  // 69: obiente.cloud.vps.v1.VPSService.DeleteVPS:input_type -> obiente.cloud.vps.v1.DeleteVPSRequest
-	18,  // 70: obiente.cloud.vps.v1.VPSService.StartVPS:input_type -> obiente.cloud.vps.v1.StartVPSRequest
-	20,  // 71: obiente.cloud.vps.v1.VPSService.StopVPS:input_type -> obiente.cloud.vps.v1.StopVPSRequest
-	22,  // 72: obiente.cloud.vps.v1.VPSService.RebootVPS:input_type -> obiente.cloud.vps.v1.RebootVPSRequest
-	24,  // 73: obiente.cloud.vps.v1.VPSService.StreamVPSStatus:input_type -> obiente.cloud.vps.v1.StreamVPSStatusRequest
-	26,  // 74: obiente.cloud.vps.v1.VPSService.GetVPSMetrics:input_type -> obiente.cloud.vps.v1.GetVPSMetricsRequest
-	28,  // 75: obiente.cloud.vps.v1.VPSService.StreamVPSMetrics:input_type -> obiente.cloud.vps.v1.StreamVPSMetricsRequest
-	30,  // 76: obiente.cloud.vps.v1.VPSService.GetVPSUsage:input_type -> obiente.cloud.vps.v1.GetVPSUsageRequest
-	35,  // 77: obiente.cloud.vps.v1.VPSService.ListVPSSizes:input_type -> obiente.cloud.vps.v1.ListAvailableVPSSizesRequest
-	37,  // 78: obiente.cloud.vps.v1.VPSService.ListVPSRegions:input_type -> obiente.cloud.vps.v1.ListVPSRegionsRequest
-	39,  // 79: obiente.cloud.vps.v1.VPSService.GetVPSProxyInfo:input_type -> obiente.cloud.vps.v1.GetVPSProxyInfoRequest
-	43,  // 80: obiente.cloud.vps.v1.VPSService.ListFirewallRules:input_type -> obiente.cloud.vps.v1.ListFirewallRulesRequest
-	45,  // 81: obiente.cloud.vps.v1.VPSService.GetFirewallRule:input_type -> obiente.cloud.vps.v1.GetFirewallRuleRequest
-	47,  // 82: obiente.cloud.vps.v1.VPSService.CreateFirewallRule:input_type -> obiente.cloud.vps.v1.CreateFirewallRuleRequest
-	49,  // 83: obiente.cloud.vps.v1.VPSService.UpdateFirewallRule:input_type -> obiente.cloud.vps.v1.UpdateFirewallRuleRequest
-	51,  // 84: obiente.cloud.vps.v1.VPSService.DeleteFirewallRule:input_type -> obiente.cloud.vps.v1.DeleteFirewallRuleRequest
-	53,  // 85: obiente.cloud.vps.v1.VPSService.GetFirewallOptions:input_type -> obiente.cloud.vps.v1.GetFirewallOptionsRequest
-	55,  // 86: obiente.cloud.vps.v1.VPSService.UpdateFirewallOptions:input_type -> obiente.cloud.vps.v1.UpdateFirewallOptionsRequest
-	60,  // 87: obiente.cloud.vps.v1.VPSService.ListSSHKeys:input_type -> obiente.cloud.vps.v1.ListSSHKeysRequest
-	62,  // 88: obiente.cloud.vps.v1.VPSService.AddSSHKey:input_type -> obiente.cloud.vps.v1.AddSSHKeyRequest
-	64,  // 89: obiente.cloud.vps.v1.VPSService.UpdateSSHKey:input_type -> obiente.cloud.vps.v1.UpdateSSHKeyRequest
-	66,  // 90: obiente.cloud.vps.v1.VPSService.RemoveSSHKey:input_type -> obiente.cloud.vps.v1.RemoveSSHKeyRequest
-	68,  // 91: obiente.cloud.vps.v1.VPSService.ResetVPSPassword:input_type -> obiente.cloud.vps.v1.ResetVPSPasswordRequest
-	70,  // 92: obiente.cloud.vps.v1.VPSService.ReinitializeVPS:input_type -> obiente.cloud.vps.v1.ReinitializeVPSRequest
-	72,  // 93: obiente.cloud.vps.v1.VPSService.StreamVPSLogs:input_type -> obiente.cloud.vps.v1.StreamVPSLogsRequest
-	74,  // 94: obiente.cloud.vps.v1.VPSService.GetVPSJournalLogs:input_type -> obiente.cloud.vps.v1.GetVPSJournalLogsRequest
-	76,  // 95: obiente.cloud.vps.v1.VPSService.ListVPSServices:input_type -> obiente.cloud.vps.v1.ListVPSServicesRequest
-	79,  // 96: obiente.cloud.vps.v1.VPSService.ImportVPS:input_type -> obiente.cloud.vps.v1.ImportVPSRequest
-	81,  // 97: obiente.cloud.vps.v1.VPSService.GetVPSLeases:input_type -> obiente.cloud.vps.v1.GetVPSLeasesRequest
-	31,  // 98: obiente.cloud.vps.v1.VPSService.FindVPSByLease:input_type -> obiente.cloud.vps.v1.FindVPSByLeaseRequest
-	84,  // 99: obiente.cloud.vps.v1.VPSService.RegisterLease:input_type -> obiente.cloud.vps.v1.RegisterLeaseRequest
-	86,  // 100: obiente.cloud.vps.v1.VPSService.ReleaseLease:input_type -> obiente.cloud.vps.v1.ReleaseLeaseRequest
-	88,  // 101: obiente.cloud.vps.v1.VPSService.AssignVPSPublicIP:input_type -> obiente.cloud.vps.v1.AssignVPSPublicIPRequest
-	90,  // 102: obiente.cloud.vps.v1.VPSService.UnassignVPSPublicIP:input_type -> obiente.cloud.vps.v1.UnassignVPSPublicIPRequest
-	6,   // 103: obiente.cloud.vps.v1.VPSService.ListVPS:output_type -> obiente.cloud.vps.v1.ListVPSResponse
-	11,  // 104: obiente.cloud.vps.v1.VPSService.CreateVPS:output_type -> obiente.cloud.vps.v1.CreateVPSResponse
-	13,  // 105: obiente.cloud.vps.v1.VPSService.GetVPS:output_type -> obiente.cloud.vps.v1.GetVPSResponse
-	15,  // 106: obiente.cloud.vps.v1.VPSService.UpdateVPS:output_type -> obiente.cloud.vps.v1.UpdateVPSResponse
-	17,  // 107: obiente.cloud.vps.v1.VPSService.DeleteVPS:output_type -> obiente.cloud.vps.v1.DeleteVPSResponse
-	19,  // 108: obiente.cloud.vps.v1.VPSService.StartVPS:output_type -> obiente.cloud.vps.v1.StartVPSResponse
-	21,  // 109: obiente.cloud.vps.v1.VPSService.StopVPS:output_type -> obiente.cloud.vps.v1.StopVPSResponse
-	23,  // 110: obiente.cloud.vps.v1.VPSService.RebootVPS:output_type -> obiente.cloud.vps.v1.RebootVPSResponse
-	25,  // 111: obiente.cloud.vps.v1.VPSService.StreamVPSStatus:output_type -> obiente.cloud.vps.v1.VPSStatusUpdate
-	27,  // 112: obiente.cloud.vps.v1.VPSService.GetVPSMetrics:output_type -> obiente.cloud.vps.v1.GetVPSMetricsResponse
-	29,  // 113: obiente.cloud.vps.v1.VPSService.StreamVPSMetrics:output_type -> obiente.cloud.vps.v1.VPSMetric
-	33,  // 114: obiente.cloud.vps.v1.VPSService.GetVPSUsage:output_type -> obiente.cloud.vps.v1.GetVPSUsageResponse
-	36,  // 115: obiente.cloud.vps.v1.VPSService.ListVPSSizes:output_type -> obiente.cloud.vps.v1.ListAvailableVPSSizesResponse
-	38,  // 116: obiente.cloud.vps.v1.VPSService.ListVPSRegions:output_type -> obiente.cloud.vps.v1.ListVPSRegionsResponse
-	40,  // 117: obiente.cloud.vps.v1.VPSService.GetVPSProxyInfo:output_type -> obiente.cloud.vps.v1.GetVPSProxyInfoResponse
-	44,  // 118: obiente.cloud.vps.v1.VPSService.ListFirewallRules:output_type -> obiente.cloud.vps.v1.ListFirewallRulesResponse
-	46,  // 119: obiente.cloud.vps.v1.VPSService.GetFirewallRule:output_type -> obiente.cloud.vps.v1.GetFirewallRuleResponse
-	48,  // 120: obiente.cloud.vps.v1.VPSService.CreateFirewallRule:output_type -> obiente.cloud.vps.v1.CreateFirewallRuleResponse
-	50,  // 121: obiente.cloud.vps.v1.VPSService.UpdateFirewallRule:output_type -> obiente.cloud.vps.v1.UpdateFirewallRuleResponse
-	52,  // 122: obiente.cloud.vps.v1.VPSService.DeleteFirewallRule:output_type -> obiente.cloud.vps.v1.DeleteFirewallRuleResponse
-	54,  // 123: obiente.cloud.vps.v1.VPSService.GetFirewallOptions:output_type -> obiente.cloud.vps.v1.GetFirewallOptionsResponse
-	56,  // 124: obiente.cloud.vps.v1.VPSService.UpdateFirewallOptions:output_type -> obiente.cloud.vps.v1.UpdateFirewallOptionsResponse
-	61,  // 125: obiente.cloud.vps.v1.VPSService.ListSSHKeys:output_type -> obiente.cloud.vps.v1.ListSSHKeysResponse
-	63,  // 126: obiente.cloud.vps.v1.VPSService.AddSSHKey:output_type -> obiente.cloud.vps.v1.AddSSHKeyResponse
-	65,  // 127: obiente.cloud.vps.v1.VPSService.UpdateSSHKey:output_type -> obiente.cloud.vps.v1.UpdateSSHKeyResponse
-	67,  // 128: obiente.cloud.vps.v1.VPSService.RemoveSSHKey:output_type -> obiente.cloud.vps.v1.RemoveSSHKeyResponse
-	69,  // 129: obiente.cloud.vps.v1.VPSService.ResetVPSPassword:output_type -> obiente.cloud.vps.v1.ResetVPSPasswordResponse
-	71,  // 130: obiente.cloud.vps.v1.VPSService.ReinitializeVPS:output_type -> obiente.cloud.vps.v1.ReinitializeVPSResponse
-	73,  // 131: obiente.cloud.vps.v1.VPSService.StreamVPSLogs:output_type -> obiente.cloud.vps.v1.VPSLogLine
-	75,  // 132: obiente.cloud.vps.v1.VPSService.GetVPSJournalLogs:output_type -> obiente.cloud.vps.v1.GetVPSJournalLogsResponse
-	78,  // 133: obiente.cloud.vps.v1.VPSService.ListVPSServices:output_type -> obiente.cloud.vps.v1.ListVPSServicesResponse
-	80,  // 134: obiente.cloud.vps.v1.VPSService.ImportVPS:output_type -> obiente.cloud.vps.v1.ImportVPSResponse
-	83,  // 135: obiente.cloud.vps.v1.VPSService.GetVPSLeases:output_type -> obiente.cloud.vps.v1.GetVPSLeasesResponse
-	32,  // 136: obiente.cloud.vps.v1.VPSService.FindVPSByLease:output_type -> obiente.cloud.vps.v1.FindVPSByLeaseResponse
-	85,  // 137: obiente.cloud.vps.v1.VPSService.RegisterLease:output_type -> obiente.cloud.vps.v1.RegisterLeaseResponse
-	87,  // 138: obiente.cloud.vps.v1.VPSService.ReleaseLease:output_type -> obiente.cloud.vps.v1.ReleaseLeaseResponse
-	89,  // 139: obiente.cloud.vps.v1.VPSService.AssignVPSPublicIP:output_type -> obiente.cloud.vps.v1.AssignVPSPublicIPResponse
-	91,  // 140: obiente.cloud.vps.v1.VPSService.UnassignVPSPublicIP:output_type -> obiente.cloud.vps.v1.UnassignVPSPublicIPResponse
-	103, // [103:141] is the sub-list for method output_type
-	65,  // [65:103] is the sub-list for method input_type
-	65,  // [65:65] is the sub-list for extension type_name
-	65,  // [65:65] is the sub-list for extension extendee
-	0,   // [0:65] is the sub-list for field type_name
+	126, // 4: obiente.cloud.vps.v1.CreateVPSRequest.metadata:type_name -> obiente.cloud.vps.v1.CreateVPSRequest.MetadataEntry
+	9,   // 5: obiente.cloud.vps.v1.CreateVPSRequest.cloud_init:type_name -> obiente.cloud.vps.v1.CloudInitConfig
+	8,   // 6: obiente.cloud.vps.v1.CreateVPSRequest.gpu:type_name -> obiente.cloud.vps.v1.GPUConfig
+	10,  // 7: obiente.cloud.vps.v1.CloudInitConfig.users:type_name -> obiente.cloud.vps.v1.CloudInitUser
+	11,  // 8: obiente.cloud.vps.v1.CloudInitConfig.write_files:type_name -> obiente.cloud.vps.v1.CloudInitWriteFile
+	51,  // 9: obiente.cloud.vps.v1.CreateVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	51,  // 10: obiente.cloud.vps.v1.GetVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	127, // 11: obiente.cloud.vps.v1.UpdateVPSRequest.metadata:type_name -> obiente.cloud.vps.v1.UpdateVPSRequest.MetadataEntry
+	51,  // 12: obiente.cloud.vps.v1.UpdateVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	51,  // 13: obiente.cloud.vps.v1.StartVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	51,  // 14: obiente.cloud.vps.v1.StopVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	51,  // 15: obiente.cloud.vps.v1.RebootVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	51,  // 16: obiente.cloud.vps.v1.ForceStopVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	0,   // 17: obiente.cloud.vps.v1.VPSStatusUpdate.status:type_name -> obiente.cloud.vps.v1.VPSStatus
+	130, // 18: obiente.cloud.vps.v1.VPSStatusUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	130, // 19: obiente.cloud.vps.v1.GetVPSMetricsRequest.start_time:type_name -> google.protobuf.Timestamp
+	130, // 20: obiente.cloud.vps.v1.GetVPSMetricsRequest.end_time:type_name -> google.protobuf.Timestamp
+	34,  // 21: obiente.cloud.vps.v1.GetVPSMetricsResponse.metrics:type_name -> obiente.cloud.vps.v1.VPSMetric
+	130, // 22: obiente.cloud.vps.v1.VPSMetric.timestamp:type_name -> google.protobuf.Timestamp
+	39,  // 23: obiente.cloud.vps.v1.GetVPSUsageResponse.current:type_name -> obiente.cloud.vps.v1.VPSUsageMetrics
+	39,  // 24: obiente.cloud.vps.v1.GetVPSUsageResponse.estimated_monthly:type_name -> obiente.cloud.vps.v1.VPSUsageMetrics
+	131, // 25: obiente.cloud.vps.v1.ListAvailableVPSSizesResponse.sizes:type_name -> obiente.cloud.common.v1.VPSSize
+	50,  // 26: obiente.cloud.vps.v1.ListVPSRegionsResponse.regions:type_name -> obiente.cloud.vps.v1.VPSRegion
+	130, // 27: obiente.cloud.vps.v1.GetVPSConsoleURLResponse.expires_at:type_name -> google.protobuf.Timestamp
+	130, // 28: obiente.cloud.vps.v1.GetVPSVNCSessionResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,   // 29: obiente.cloud.vps.v1.VPSInstance.status:type_name -> obiente.cloud.vps.v1.VPSStatus
+	1,   // 30: obiente.cloud.vps.v1.VPSInstance.image:type_name -> obiente.cloud.vps.v1.VPSImage
+	128, // 31: obiente.cloud.vps.v1.VPSInstance.metadata:type_name -> obiente.cloud.vps.v1.VPSInstance.MetadataEntry
+	130, // 32: obiente.cloud.vps.v1.VPSInstance.created_at:type_name -> google.protobuf.Timestamp
+	130, // 33: obiente.cloud.vps.v1.VPSInstance.updated_at:type_name -> google.protobuf.Timestamp
+	130, // 34: obiente.cloud.vps.v1.VPSInstance.last_started_at:type_name -> google.protobuf.Timestamp
+	130, // 35: obiente.cloud.vps.v1.VPSInstance.deleted_at:type_name -> google.protobuf.Timestamp
+	34,  // 36: obiente.cloud.vps.v1.VPSInstance.current_metrics:type_name -> obiente.cloud.vps.v1.VPSMetric
+	130, // 37: obiente.cloud.vps.v1.VPSInstance.last_patched_at:type_name -> google.protobuf.Timestamp
+	66,  // 38: obiente.cloud.vps.v1.ListFirewallRulesResponse.rules:type_name -> obiente.cloud.vps.v1.FirewallRule
+	66,  // 39: obiente.cloud.vps.v1.GetFirewallRuleResponse.rule:type_name -> obiente.cloud.vps.v1.FirewallRule
+	66,  // 40: obiente.cloud.vps.v1.CreateFirewallRuleRequest.rule:type_name -> obiente.cloud.vps.v1.FirewallRule
+	66,  // 41: obiente.cloud.vps.v1.CreateFirewallRuleResponse.rule:type_name -> obiente.cloud.vps.v1.FirewallRule
+	66,  // 42: obiente.cloud.vps.v1.UpdateFirewallRuleRequest.rule:type_name -> obiente.cloud.vps.v1.FirewallRule
+	66,  // 43: obiente.cloud.vps.v1.UpdateFirewallRuleResponse.rule:type_name -> obiente.cloud.vps.v1.FirewallRule
+	67,  // 44: obiente.cloud.vps.v1.GetFirewallOptionsResponse.options:type_name -> obiente.cloud.vps.v1.FirewallOptions
+	67,  // 45: obiente.cloud.vps.v1.UpdateFirewallOptionsRequest.options:type_name -> obiente.cloud.vps.v1.FirewallOptions
+	67,  // 46: obiente.cloud.vps.v1.UpdateFirewallOptionsResponse.options:type_name -> obiente.cloud.vps.v1.FirewallOptions
+	2,   // 47: obiente.cloud.vps.v1.FirewallRule.action:type_name -> obiente.cloud.vps.v1.FirewallAction
+	3,   // 48: obiente.cloud.vps.v1.FirewallRule.type:type_name -> obiente.cloud.vps.v1.FirewallDirection
+	4,   // 49: obiente.cloud.vps.v1.FirewallRule.protocol:type_name -> obiente.cloud.vps.v1.FirewallProtocol
+	130, // 50: obiente.cloud.vps.v1.SSHKey.created_at:type_name -> google.protobuf.Timestamp
+	130, // 51: obiente.cloud.vps.v1.SSHKey.updated_at:type_name -> google.protobuf.Timestamp
+	68,  // 52: obiente.cloud.vps.v1.ListSSHKeysResponse.keys:type_name -> obiente.cloud.vps.v1.SSHKey
+	68,  // 53: obiente.cloud.vps.v1.AddSSHKeyResponse.key:type_name -> obiente.cloud.vps.v1.SSHKey
+	68,  // 54: obiente.cloud.vps.v1.UpdateSSHKeyResponse.key:type_name -> obiente.cloud.vps.v1.SSHKey
+	51,  // 55: obiente.cloud.vps.v1.ReinitializeVPSResponse.vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	130, // 56: obiente.cloud.vps.v1.VPSLogLine.timestamp:type_name -> google.protobuf.Timestamp
+	82,  // 57: obiente.cloud.vps.v1.GetVPSJournalLogsResponse.logs:type_name -> obiente.cloud.vps.v1.VPSLogLine
+	86,  // 58: obiente.cloud.vps.v1.ListVPSServicesResponse.services:type_name -> obiente.cloud.vps.v1.VPSSystemService
+	130, // 59: obiente.cloud.vps.v1.ListVPSServicesResponse.fetched_at:type_name -> google.protobuf.Timestamp
+	51,  // 60: obiente.cloud.vps.v1.ImportVPSResponse.imported_vps:type_name -> obiente.cloud.vps.v1.VPSInstance
+	130, // 61: obiente.cloud.vps.v1.VPSLease.expires_at:type_name -> google.protobuf.Timestamp
+	91,  // 62: obiente.cloud.vps.v1.GetVPSLeasesResponse.leases:type_name -> obiente.cloud.vps.v1.VPSLease
+	130, // 63: obiente.cloud.vps.v1.RegisterLeaseRequest.expires_at:type_name -> google.protobuf.Timestamp
+	130, // 64: obiente.cloud.vps.v1.VPSPublicIP.assigned_at:type_name -> google.protobuf.Timestamp
+	130, // 65: obiente.cloud.vps.v1.VPSPublicIP.created_at:type_name -> google.protobuf.Timestamp
+	130, // 66: obiente.cloud.vps.v1.VPSPublicIP.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 67: obiente.cloud.vps.v1.ListVPSPublicIPsResponse.ips:type_name -> obiente.cloud.vps.v1.VPSPublicIP
+	101, // 68: obiente.cloud.vps.v1.CreateVPSPublicIPResponse.ip:type_name -> obiente.cloud.vps.v1.VPSPublicIP
+	101, // 69: obiente.cloud.vps.v1.UpdateVPSPublicIPResponse.ip:type_name -> obiente.cloud.vps.v1.VPSPublicIP
+	1,   // 70: obiente.cloud.vps.v1.VPSTemplate.image:type_name -> obiente.cloud.vps.v1.VPSImage
+	130, // 71: obiente.cloud.vps.v1.VPSTemplate.created_at:type_name -> google.protobuf.Timestamp
+	110, // 72: obiente.cloud.vps.v1.CreateVPSTemplateResponse.template:type_name -> obiente.cloud.vps.v1.VPSTemplate
+	110, // 73: obiente.cloud.vps.v1.ListVPSTemplatesResponse.templates:type_name -> obiente.cloud.vps.v1.VPSTemplate
+	130, // 74: obiente.cloud.vps.v1.VPSISO.uploaded_at:type_name -> google.protobuf.Timestamp
+	117, // 75: obiente.cloud.vps.v1.ListOrgISOsResponse.isos:type_name -> obiente.cloud.vps.v1.VPSISO
+	5,   // 76: obiente.cloud.vps.v1.VPSService.ListVPS:input_type -> obiente.cloud.vps.v1.ListVPSRequest
+	7,   // 77: obiente.cloud.vps.v1.VPSService.CreateVPS:input_type -> obiente.cloud.vps.v1.CreateVPSRequest
+	13,  // 78: obiente.cloud.vps.v1.VPSService.GetVPS:input_type -> obiente.cloud.vps.v1.GetVPSRequest
+	15,  // 79: obiente.cloud.vps.v1.VPSService.UpdateVPS:input_type -> obiente.cloud.vps.v1.UpdateVPSRequest
+	17,  // 80: obiente.cloud.vps.v1.VPSService.DeleteVPS:input_type -> obiente.cloud.vps.v1.DeleteVPSRequest
+	19,  // 81: obiente.cloud.vps.v1.VPSService.StartVPS:input_type -> obiente.cloud.vps.v1.StartVPSRequest
+	21,  // 82: obiente.cloud.vps.v1.VPSService.StopVPS:input_type -> obiente.cloud.vps.v1.StopVPSRequest
+	23,  // 83: obiente.cloud.vps.v1.VPSService.RebootVPS:input_type -> obiente.cloud.vps.v1.RebootVPSRequest
+	27,  // 84: obiente.cloud.vps.v1.VPSService.ForceStopVPS:input_type -> obiente.cloud.vps.v1.ForceStopVPSRequest
+	25,  // 85: obiente.cloud.vps.v1.VPSService.PatchVPS:input_type -> obiente.cloud.vps.v1.PatchVPSRequest
+	29,  // 86: obiente.cloud.vps.v1.VPSService.StreamVPSStatus:input_type -> obiente.cloud.vps.v1.StreamVPSStatusRequest
+	31,  // 87: obiente.cloud.vps.v1.VPSService.GetVPSMetrics:input_type -> obiente.cloud.vps.v1.GetVPSMetricsRequest
+	33,  // 88: obiente.cloud.vps.v1.VPSService.StreamVPSMetrics:input_type -> obiente.cloud.vps.v1.StreamVPSMetricsRequest
+	35,  // 89: obiente.cloud.vps.v1.VPSService.GetVPSUsage:input_type -> obiente.cloud.vps.v1.GetVPSUsageRequest
+	40,  // 90: obiente.cloud.vps.v1.VPSService.ListVPSSizes:input_type -> obiente.cloud.vps.v1.ListAvailableVPSSizesRequest
+	42,  // 91: obiente.cloud.vps.v1.VPSService.ListVPSRegions:input_type -> obiente.cloud.vps.v1.ListVPSRegionsRequest
+	44,  // 92: obiente.cloud.vps.v1.VPSService.GetVPSProxyInfo:input_type -> obiente.cloud.vps.v1.GetVPSProxyInfoRequest
+	46,  // 93: obiente.cloud.vps.v1.VPSService.GetVPSConsoleURL:input_type -> obiente.cloud.vps.v1.GetVPSConsoleURLRequest
+	48,  // 94: obiente.cloud.vps.v1.VPSService.GetVPSVNCSession:input_type -> obiente.cloud.vps.v1.GetVPSVNCSessionRequest
+	52,  // 95: obiente.cloud.vps.v1.VPSService.ListFirewallRules:input_type -> obiente.cloud.vps.v1.ListFirewallRulesRequest
+	54,  // 96: obiente.cloud.vps.v1.VPSService.GetFirewallRule:input_type -> obiente.cloud.vps.v1.GetFirewallRuleRequest
+	56,  // 97: obiente.cloud.vps.v1.VPSService.CreateFirewallRule:input_type -> obiente.cloud.vps.v1.CreateFirewallRuleRequest
+	58,  // 98: obiente.cloud.vps.v1.VPSService.UpdateFirewallRule:input_type -> obiente.cloud.vps.v1.UpdateFirewallRuleRequest
+	60,  // 99: obiente.cloud.vps.v1.VPSService.DeleteFirewallRule:input_type -> obiente.cloud.vps.v1.DeleteFirewallRuleRequest
+	62,  // 100: obiente.cloud.vps.v1.VPSService.GetFirewallOptions:input_type -> obiente.cloud.vps.v1.GetFirewallOptionsRequest
+	64,  // 101: obiente.cloud.vps.v1.VPSService.UpdateFirewallOptions:input_type -> obiente.cloud.vps.v1.UpdateFirewallOptionsRequest
+	69,  // 102: obiente.cloud.vps.v1.VPSService.ListSSHKeys:input_type -> obiente.cloud.vps.v1.ListSSHKeysRequest
+	71,  // 103: obiente.cloud.vps.v1.VPSService.AddSSHKey:input_type -> obiente.cloud.vps.v1.AddSSHKeyRequest
+	73,  // 104: obiente.cloud.vps.v1.VPSService.UpdateSSHKey:input_type -> obiente.cloud.vps.v1.UpdateSSHKeyRequest
+	75,  // 105: obiente.cloud.vps.v1.VPSService.RemoveSSHKey:input_type -> obiente.cloud.vps.v1.RemoveSSHKeyRequest
+	77,  // 106: obiente.cloud.vps.v1.VPSService.ResetVPSPassword:input_type -> obiente.cloud.vps.v1.ResetVPSPasswordRequest
+	79,  // 107: obiente.cloud.vps.v1.VPSService.ReinitializeVPS:input_type -> obiente.cloud.vps.v1.ReinitializeVPSRequest
+	81,  // 108: obiente.cloud.vps.v1.VPSService.StreamVPSLogs:input_type -> obiente.cloud.vps.v1.StreamVPSLogsRequest
+	83,  // 109: obiente.cloud.vps.v1.VPSService.GetVPSJournalLogs:input_type -> obiente.cloud.vps.v1.GetVPSJournalLogsRequest
+	85,  // 110: obiente.cloud.vps.v1.VPSService.ListVPSServices:input_type -> obiente.cloud.vps.v1.ListVPSServicesRequest
+	88,  // 111: obiente.cloud.vps.v1.VPSService.ImportVPS:input_type -> obiente.cloud.vps.v1.ImportVPSRequest
+	90,  // 112: obiente.cloud.vps.v1.VPSService.GetVPSLeases:input_type -> obiente.cloud.vps.v1.GetVPSLeasesRequest
+	36,  // 113: obiente.cloud.vps.v1.VPSService.FindVPSByLease:input_type -> obiente.cloud.vps.v1.FindVPSByLeaseRequest
+	93,  // 114: obiente.cloud.vps.v1.VPSService.RegisterLease:input_type -> obiente.cloud.vps.v1.RegisterLeaseRequest
+	95,  // 115: obiente.cloud.vps.v1.VPSService.ReleaseLease:input_type -> obiente.cloud.vps.v1.ReleaseLeaseRequest
+	97,  // 116: obiente.cloud.vps.v1.VPSService.AssignVPSPublicIP:input_type -> obiente.cloud.vps.v1.AssignVPSPublicIPRequest
+	99,  // 117: obiente.cloud.vps.v1.VPSService.UnassignVPSPublicIP:input_type -> obiente.cloud.vps.v1.UnassignVPSPublicIPRequest
+	111, // 118: obiente.cloud.vps.v1.VPSService.CreateVPSTemplate:input_type -> obiente.cloud.vps.v1.CreateVPSTemplateRequest
+	113, // 119: obiente.cloud.vps.v1.VPSService.ListVPSTemplates:input_type -> obiente.cloud.vps.v1.ListVPSTemplatesRequest
+	115, // 120: obiente.cloud.vps.v1.VPSService.DeleteVPSTemplate:input_type -> obiente.cloud.vps.v1.DeleteVPSTemplateRequest
+	118, // 121: obiente.cloud.vps.v1.VPSService.ListOrgISOs:input_type -> obiente.cloud.vps.v1.ListOrgISOsRequest
+	120, // 122: obiente.cloud.vps.v1.VPSService.DeleteISO:input_type -> obiente.cloud.vps.v1.DeleteISORequest
+	122, // 123: obiente.cloud.vps.v1.VPSService.MountISO:input_type -> obiente.cloud.vps.v1.MountISORequest
+	124, // 124: obiente.cloud.vps.v1.VPSService.UnmountISO:input_type -> obiente.cloud.vps.v1.UnmountISORequest
+	6,   // 125: obiente.cloud.vps.v1.VPSService.ListVPS:output_type -> obiente.cloud.vps.v1.ListVPSResponse
+	12,  // 126: obiente.cloud.vps.v1.VPSService.CreateVPS:output_type -> obiente.cloud.vps.v1.CreateVPSResponse
+	14,  // 127: obiente.cloud.vps.v1.VPSService.GetVPS:output_type -> obiente.cloud.vps.v1.GetVPSResponse
+	16,  // 128: obiente.cloud.vps.v1.VPSService.UpdateVPS:output_type -> obiente.cloud.vps.v1.UpdateVPSResponse
+	18,  // 129: obiente.cloud.vps.v1.VPSService.DeleteVPS:output_type -> obiente.cloud.vps.v1.DeleteVPSResponse
+	20,  // 130: obiente.cloud.vps.v1.VPSService.StartVPS:output_type -> obiente.cloud.vps.v1.StartVPSResponse
+	22,  // 131: obiente.cloud.vps.v1.VPSService.StopVPS:output_type -> obiente.cloud.vps.v1.StopVPSResponse
+	24,  // 132: obiente.cloud.vps.v1.VPSService.RebootVPS:output_type -> obiente.cloud.vps.v1.RebootVPSResponse
+	28,  // 133: obiente.cloud.vps.v1.VPSService.ForceStopVPS:output_type -> obiente.cloud.vps.v1.ForceStopVPSResponse
+	26,  // 134: obiente.cloud.vps.v1.VPSService.PatchVPS:output_type -> obiente.cloud.vps.v1.PatchVPSResponse
+	30,  // 135: obiente.cloud.vps.v1.VPSService.StreamVPSStatus:output_type -> obiente.cloud.vps.v1.VPSStatusUpdate
+	32,  // 136: obiente.cloud.vps.v1.VPSService.GetVPSMetrics:output_type -> obiente.cloud.vps.v1.GetVPSMetricsResponse
+	34,  // 137: obiente.cloud.vps.v1.VPSService.StreamVPSMetrics:output_type -> obiente.cloud.vps.v1.VPSMetric
+	38,  // 138: obiente.cloud.vps.v1.VPSService.GetVPSUsage:output_type -> obiente.cloud.vps.v1.GetVPSUsageResponse
+	41,  // 139: obiente.cloud.vps.v1.VPSService.ListVPSSizes:output_type -> obiente.cloud.vps.v1.ListAvailableVPSSizesResponse
+	43,  // 140: obiente.cloud.vps.v1.VPSService.ListVPSRegions:output_type -> obiente.cloud.vps.v1.ListVPSRegionsResponse
+	45,  // 141: obiente.cloud.vps.v1.VPSService.GetVPSProxyInfo:output_type -> obiente.cloud.vps.v1.GetVPSProxyInfoResponse
+	47,  // 142: obiente.cloud.vps.v1.VPSService.GetVPSConsoleURL:output_type -> obiente.cloud.vps.v1.GetVPSConsoleURLResponse
+	49,  // 143: obiente.cloud.vps.v1.VPSService.GetVPSVNCSession:output_type -> obiente.cloud.vps.v1.GetVPSVNCSessionResponse
+	53,  // 144: obiente.cloud.vps.v1.VPSService.ListFirewallRules:output_type -> obiente.cloud.vps.v1.ListFirewallRulesResponse
+	55,  // 145: obiente.cloud.vps.v1.VPSService.GetFirewallRule:output_type -> obiente.cloud.vps.v1.GetFirewallRuleResponse
+	57,  // 146: obiente.cloud.vps.v1.VPSService.CreateFirewallRule:output_type -> obiente.cloud.vps.v1.CreateFirewallRuleResponse
+	59,  // 147: obiente.cloud.vps.v1.VPSService.UpdateFirewallRule:output_type -> obiente.cloud.vps.v1.UpdateFirewallRuleResponse
+	61,  // 148: obiente.cloud.vps.v1.VPSService.DeleteFirewallRule:output_type -> obiente.cloud.vps.v1.DeleteFirewallRuleResponse
+	63,  // 149: obiente.cloud.vps.v1.VPSService.GetFirewallOptions:output_type -> obiente.cloud.vps.v1.GetFirewallOptionsResponse
+	65,  // 150: obiente.cloud.vps.v1.VPSService.UpdateFirewallOptions:output_type -> obiente.cloud.vps.v1.UpdateFirewallOptionsResponse
+	70,  // 151: obiente.cloud.vps.v1.VPSService.ListSSHKeys:output_type -> obiente.cloud.vps.v1.ListSSHKeysResponse
+	72,  // 152: obiente.cloud.vps.v1.VPSService.AddSSHKey:output_type -> obiente.cloud.vps.v1.AddSSHKeyResponse
+	74,  // 153: obiente.cloud.vps.v1.VPSService.UpdateSSHKey:output_type -> obiente.cloud.vps.v1.UpdateSSHKeyResponse
+	76,  // 154: obiente.cloud.vps.v1.VPSService.RemoveSSHKey:output_type -> obiente.cloud.vps.v1.RemoveSSHKeyResponse
+	78,  // 155: obiente.cloud.vps.v1.VPSService.ResetVPSPassword:output_type -> obiente.cloud.vps.v1.ResetVPSPasswordResponse
+	80,  // 156: obiente.cloud.vps.v1.VPSService.ReinitializeVPS:output_type -> obiente.cloud.vps.v1.ReinitializeVPSResponse
+	82,  // 157: obiente.cloud.vps.v1.VPSService.StreamVPSLogs:output_type -> obiente.cloud.vps.v1.VPSLogLine
+	84,  // 158: obiente.cloud.vps.v1.VPSService.GetVPSJournalLogs:output_type -> obiente.cloud.vps.v1.GetVPSJournalLogsResponse
+	87,  // 159: obiente.cloud.vps.v1.VPSService.ListVPSServices:output_type -> obiente.cloud.vps.v1.ListVPSServicesResponse
+	89,  // 160: obiente.cloud.vps.v1.VPSService.ImportVPS:output_type -> obiente.cloud.vps.v1.ImportVPSResponse
+	92,  // 161: obiente.cloud.vps.v1.VPSService.GetVPSLeases:output_type -> obiente.cloud.vps.v1.GetVPSLeasesResponse
+	37,  // 162: obiente.cloud.vps.v1.VPSService.FindVPSByLease:output_type -> obiente.cloud.vps.v1.FindVPSByLeaseResponse
+	94,  // 163: obiente.cloud.vps.v1.VPSService.RegisterLease:output_type -> obiente.cloud.vps.v1.RegisterLeaseResponse
+	96,  // 164: obiente.cloud.vps.v1.VPSService.ReleaseLease:output_type -> obiente.cloud.vps.v1.ReleaseLeaseResponse
+	98,  // 165: obiente.cloud.vps.v1.VPSService.AssignVPSPublicIP:output_type -> obiente.cloud.vps.v1.AssignVPSPublicIPResponse
+	100, // 166: obiente.cloud.vps.v1.VPSService.UnassignVPSPublicIP:output_type -> obiente.cloud.vps.v1.UnassignVPSPublicIPResponse
+	112, // 167: obiente.cloud.vps.v1.VPSService.CreateVPSTemplate:output_type -> obiente.cloud.vps.v1.CreateVPSTemplateResponse
+	114, // 168: obiente.cloud.vps.v1.VPSService.ListVPSTemplates:output_type -> obiente.cloud.vps.v1.ListVPSTemplatesResponse
+	116, // 169: obiente.cloud.vps.v1.VPSService.DeleteVPSTemplate:output_type -> obiente.cloud.vps.v1.DeleteVPSTemplateResponse
+	119, // 170: obiente.cloud.vps.v1.VPSService.ListOrgISOs:output_type -> obiente.cloud.vps.v1.ListOrgISOsResponse
+	121, // 171: obiente.cloud.vps.v1.VPSService.DeleteISO:output_type -> obiente.cloud.vps.v1.DeleteISOResponse
+	123, // 172: obiente.cloud.vps.v1.VPSService.MountISO:output_type -> obiente.cloud.vps.v1.MountISOResponse
+	125, // 173: obiente.cloud.vps.v1.VPSService.UnmountISO:output_type -> obiente.cloud.vps.v1.UnmountISOResponse
+	125, // [125:174] is the sub-list for method output_type
+	76,  // [76:125] is the sub-list for method input_type
+	76,  // [76:76] is the sub-list for extension type_name
+	76,  // [76:76] is the sub-list for extension extendee
+	0,   // [0:76] is the sub-list for field type_name
 }
 
 func init() { file_obiente_cloud_vps_v1_vps_service_proto_init() }
@@ -7421,34 +9038,37 @@ func file_obiente_cloud_vps_v1_vps_service_proto_init() {
 	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[3].OneofWrappers = []any{}
 	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[4].OneofWrappers = []any{}
 	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[5].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[9].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[20].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[6].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[10].OneofWrappers = []any{}
 	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[25].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[29].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[26].OneofWrappers = []any{}
 	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[30].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[34].OneofWrappers = []any{}
 	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[35].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[37].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[42].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[52].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[53].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[54].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[55].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[57].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[40].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[46].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[51].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[61].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[62].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[63].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[64].OneofWrappers = []any{}
 	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[66].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[69].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[76].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[87].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[88].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[90].OneofWrappers = []any{}
-	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[92].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[75].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[78].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[85].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[96].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[97].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[99].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[101].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[105].OneofWrappers = []any{}
+	file_obiente_cloud_vps_v1_vps_service_proto_msgTypes[106].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_obiente_cloud_vps_v1_vps_service_proto_rawDesc), len(file_obiente_cloud_vps_v1_vps_service_proto_rawDesc)),
 			NumEnums:      5,
-			NumMessages:   99,
+			NumMessages:   124,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -84,6 +84,15 @@ const (
 	// VPSConfigServiceRemoveSSHAliasProcedure is the fully-qualified name of the VPSConfigService's
 	// RemoveSSHAlias RPC.
 	VPSConfigServiceRemoveSSHAliasProcedure = "/obiente.cloud.vps.v1.VPSConfigService/RemoveSSHAlias"
+	// VPSConfigServiceCreateCloudInitTemplateProcedure is the fully-qualified name of the
+	// VPSConfigService's CreateCloudInitTemplate RPC.
+	VPSConfigServiceCreateCloudInitTemplateProcedure = "/obiente.cloud.vps.v1.VPSConfigService/CreateCloudInitTemplate"
+	// VPSConfigServiceGetCloudInitTemplateProcedure is the fully-qualified name of the
+	// VPSConfigService's GetCloudInitTemplate RPC.
+	VPSConfigServiceGetCloudInitTemplateProcedure = "/obiente.cloud.vps.v1.VPSConfigService/GetCloudInitTemplate"
+	// VPSConfigServiceDeleteCloudInitTemplateProcedure is the fully-qualified name of the
+	// VPSConfigService's DeleteCloudInitTemplate RPC.
+	VPSConfigServiceDeleteCloudInitTemplateProcedure = "/obiente.cloud.vps.v1.VPSConfigService/DeleteCloudInitTemplate"
 )
 
 // VPSConfigServiceClient is a client for the obiente.cloud.vps.v1.VPSConfigService service.
@@ -122,6 +131,12 @@ type VPSConfigServiceClient interface {
 	SetSSHAlias(context.Context, *connect.Request[v1.SetSSHAliasRequest]) (*connect.Response[v1.SetSSHAliasResponse], error)
 	// Remove the SSH alias for a VPS instance
 	RemoveSSHAlias(context.Context, *connect.Request[v1.RemoveSSHAliasRequest]) (*connect.Response[v1.RemoveSSHAliasResponse], error)
+	// Create a reusable cloud-init userData template for an organization
+	CreateCloudInitTemplate(context.Context, *connect.Request[v1.CreateCloudInitTemplateRequest]) (*connect.Response[v1.CreateCloudInitTemplateResponse], error)
+	// Get a cloud-init userData template
+	GetCloudInitTemplate(context.Context, *connect.Request[v1.GetCloudInitTemplateRequest]) (*connect.Response[v1.GetCloudInitTemplateResponse], error)
+	// Delete a cloud-init userData template
+	DeleteCloudInitTemplate(context.Context, *connect.Request[v1.DeleteCloudInitTemplateRequest]) (*connect.Response[v1.DeleteCloudInitTemplateResponse], error)
 }
 
 // NewVPSConfigServiceClient constructs a client for the obiente.cloud.vps.v1.VPSConfigService
@@ -237,28 +252,49 @@ func NewVPSConfigServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(vPSConfigServiceMethods.ByName("RemoveSSHAlias")),
 			connect.WithClientOptions(opts...),
 		),
+		createCloudInitTemplate: connect.NewClient[v1.CreateCloudInitTemplateRequest, v1.CreateCloudInitTemplateResponse](
+			httpClient,
+			baseURL+VPSConfigServiceCreateCloudInitTemplateProcedure,
+			connect.WithSchema(vPSConfigServiceMethods.ByName("CreateCloudInitTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		getCloudInitTemplate: connect.NewClient[v1.GetCloudInitTemplateRequest, v1.GetCloudInitTemplateResponse](
+			httpClient,
+			baseURL+VPSConfigServiceGetCloudInitTemplateProcedure,
+			connect.WithSchema(vPSConfigServiceMethods.ByName("GetCloudInitTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCloudInitTemplate: connect.NewClient[v1.DeleteCloudInitTemplateRequest, v1.DeleteCloudInitTemplateResponse](
+			httpClient,
+			baseURL+VPSConfigServiceDeleteCloudInitTemplateProcedure,
+			connect.WithSchema(vPSConfigServiceMethods.ByName("DeleteCloudInitTemplate")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // vPSConfigServiceClient implements VPSConfigServiceClient.
 type vPSConfigServiceClient struct {
-	getCloudInitConfig    *connect.Client[v1.GetCloudInitConfigRequest, v1.GetCloudInitConfigResponse]
-	getCloudInitUserData  *connect.Client[v1.GetCloudInitUserDataRequest, v1.GetCloudInitUserDataResponse]
-	updateCloudInitConfig *connect.Client[v1.UpdateCloudInitConfigRequest, v1.UpdateCloudInitConfigResponse]
-	listVPSUsers          *connect.Client[v1.ListVPSUsersRequest, v1.ListVPSUsersResponse]
-	createVPSUser         *connect.Client[v1.CreateVPSUserRequest, v1.CreateVPSUserResponse]
-	updateVPSUser         *connect.Client[v1.UpdateVPSUserRequest, v1.UpdateVPSUserResponse]
-	deleteVPSUser         *connect.Client[v1.DeleteVPSUserRequest, v1.DeleteVPSUserResponse]
-	setUserPassword       *connect.Client[v1.SetUserPasswordRequest, v1.SetUserPasswordResponse]
-	updateUserSSHKeys     *connect.Client[v1.UpdateUserSSHKeysRequest, v1.UpdateUserSSHKeysResponse]
-	rotateTerminalKey     *connect.Client[v1.RotateTerminalKeyRequest, v1.RotateTerminalKeyResponse]
-	removeTerminalKey     *connect.Client[v1.RemoveTerminalKeyRequest, v1.RemoveTerminalKeyResponse]
-	getTerminalKey        *connect.Client[v1.GetTerminalKeyRequest, v1.GetTerminalKeyResponse]
-	rotateBastionKey      *connect.Client[v1.RotateBastionKeyRequest, v1.RotateBastionKeyResponse]
-	getBastionKey         *connect.Client[v1.GetBastionKeyRequest, v1.GetBastionKeyResponse]
-	getSSHAlias           *connect.Client[v1.GetSSHAliasRequest, v1.GetSSHAliasResponse]
-	setSSHAlias           *connect.Client[v1.SetSSHAliasRequest, v1.SetSSHAliasResponse]
-	removeSSHAlias        *connect.Client[v1.RemoveSSHAliasRequest, v1.RemoveSSHAliasResponse]
+	getCloudInitConfig      *connect.Client[v1.GetCloudInitConfigRequest, v1.GetCloudInitConfigResponse]
+	getCloudInitUserData    *connect.Client[v1.GetCloudInitUserDataRequest, v1.GetCloudInitUserDataResponse]
+	updateCloudInitConfig   *connect.Client[v1.UpdateCloudInitConfigRequest, v1.UpdateCloudInitConfigResponse]
+	listVPSUsers            *connect.Client[v1.ListVPSUsersRequest, v1.ListVPSUsersResponse]
+	createVPSUser           *connect.Client[v1.CreateVPSUserRequest, v1.CreateVPSUserResponse]
+	updateVPSUser           *connect.Client[v1.UpdateVPSUserRequest, v1.UpdateVPSUserResponse]
+	deleteVPSUser           *connect.Client[v1.DeleteVPSUserRequest, v1.DeleteVPSUserResponse]
+	setUserPassword         *connect.Client[v1.SetUserPasswordRequest, v1.SetUserPasswordResponse]
+	updateUserSSHKeys       *connect.Client[v1.UpdateUserSSHKeysRequest, v1.UpdateUserSSHKeysResponse]
+	rotateTerminalKey       *connect.Client[v1.RotateTerminalKeyRequest, v1.RotateTerminalKeyResponse]
+	removeTerminalKey       *connect.Client[v1.RemoveTerminalKeyRequest, v1.RemoveTerminalKeyResponse]
+	getTerminalKey          *connect.Client[v1.GetTerminalKeyRequest, v1.GetTerminalKeyResponse]
+	rotateBastionKey        *connect.Client[v1.RotateBastionKeyRequest, v1.RotateBastionKeyResponse]
+	getBastionKey           *connect.Client[v1.GetBastionKeyRequest, v1.GetBastionKeyResponse]
+	getSSHAlias             *connect.Client[v1.GetSSHAliasRequest, v1.GetSSHAliasResponse]
+	setSSHAlias             *connect.Client[v1.SetSSHAliasRequest, v1.SetSSHAliasResponse]
+	removeSSHAlias          *connect.Client[v1.RemoveSSHAliasRequest, v1.RemoveSSHAliasResponse]
+	createCloudInitTemplate *connect.Client[v1.CreateCloudInitTemplateRequest, v1.CreateCloudInitTemplateResponse]
+	getCloudInitTemplate    *connect.Client[v1.GetCloudInitTemplateRequest, v1.GetCloudInitTemplateResponse]
+	deleteCloudInitTemplate *connect.Client[v1.DeleteCloudInitTemplateRequest, v1.DeleteCloudInitTemplateResponse]
 }
 
 // GetCloudInitConfig calls obiente.cloud.vps.v1.VPSConfigService.GetCloudInitConfig.
@@ -346,6 +382,21 @@ func (c *vPSConfigServiceClient) RemoveSSHAlias(ctx context.Context, req *connec
 	return c.removeSSHAlias.CallUnary(ctx, req)
 }
 
+// CreateCloudInitTemplate calls obiente.cloud.vps.v1.VPSConfigService.CreateCloudInitTemplate.
+func (c *vPSConfigServiceClient) CreateCloudInitTemplate(ctx context.Context, req *connect.Request[v1.CreateCloudInitTemplateRequest]) (*connect.Response[v1.CreateCloudInitTemplateResponse], error) {
+	return c.createCloudInitTemplate.CallUnary(ctx, req)
+}
+
+// GetCloudInitTemplate calls obiente.cloud.vps.v1.VPSConfigService.GetCloudInitTemplate.
+func (c *vPSConfigServiceClient) GetCloudInitTemplate(ctx context.Context, req *connect.Request[v1.GetCloudInitTemplateRequest]) (*connect.Response[v1.GetCloudInitTemplateResponse], error) {
+	return c.getCloudInitTemplate.CallUnary(ctx, req)
+}
+
+// DeleteCloudInitTemplate calls obiente.cloud.vps.v1.VPSConfigService.DeleteCloudInitTemplate.
+func (c *vPSConfigServiceClient) DeleteCloudInitTemplate(ctx context.Context, req *connect.Request[v1.DeleteCloudInitTemplateRequest]) (*connect.Response[v1.DeleteCloudInitTemplateResponse], error) {
+	return c.deleteCloudInitTemplate.CallUnary(ctx, req)
+}
+
 // VPSConfigServiceHandler is an implementation of the obiente.cloud.vps.v1.VPSConfigService
 // service.
 type VPSConfigServiceHandler interface {
@@ -383,6 +434,12 @@ type VPSConfigServiceHandler interface {
 	SetSSHAlias(context.Context, *connect.Request[v1.SetSSHAliasRequest]) (*connect.Response[v1.SetSSHAliasResponse], error)
 	// Remove the SSH alias for a VPS instance
 	RemoveSSHAlias(context.Context, *connect.Request[v1.RemoveSSHAliasRequest]) (*connect.Response[v1.RemoveSSHAliasResponse], error)
+	// Create a reusable cloud-init userData template for an organization
+	CreateCloudInitTemplate(context.Context, *connect.Request[v1.CreateCloudInitTemplateRequest]) (*connect.Response[v1.CreateCloudInitTemplateResponse], error)
+	// Get a cloud-init userData template
+	GetCloudInitTemplate(context.Context, *connect.Request[v1.GetCloudInitTemplateRequest]) (*connect.Response[v1.GetCloudInitTemplateResponse], error)
+	// Delete a cloud-init userData template
+	DeleteCloudInitTemplate(context.Context, *connect.Request[v1.DeleteCloudInitTemplateRequest]) (*connect.Response[v1.DeleteCloudInitTemplateResponse], error)
 }
 
 // NewVPSConfigServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -494,6 +551,24 @@ func NewVPSConfigServiceHandler(svc VPSConfigServiceHandler, opts ...connect.Han
 		connect.WithSchema(vPSConfigServiceMethods.ByName("RemoveSSHAlias")),
 		connect.WithHandlerOptions(opts...),
 	)
+	vPSConfigServiceCreateCloudInitTemplateHandler := connect.NewUnaryHandler(
+		VPSConfigServiceCreateCloudInitTemplateProcedure,
+		svc.CreateCloudInitTemplate,
+		connect.WithSchema(vPSConfigServiceMethods.ByName("CreateCloudInitTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vPSConfigServiceGetCloudInitTemplateHandler := connect.NewUnaryHandler(
+		VPSConfigServiceGetCloudInitTemplateProcedure,
+		svc.GetCloudInitTemplate,
+		connect.WithSchema(vPSConfigServiceMethods.ByName("GetCloudInitTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vPSConfigServiceDeleteCloudInitTemplateHandler := connect.NewUnaryHandler(
+		VPSConfigServiceDeleteCloudInitTemplateProcedure,
+		svc.DeleteCloudInitTemplate,
+		connect.WithSchema(vPSConfigServiceMethods.ByName("DeleteCloudInitTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/obiente.cloud.vps.v1.VPSConfigService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case VPSConfigServiceGetCloudInitConfigProcedure:
@@ -530,6 +605,12 @@ func NewVPSConfigServiceHandler(svc VPSConfigServiceHandler, opts ...connect.Han
 			vPSConfigServiceSetSSHAliasHandler.ServeHTTP(w, r)
 		case VPSConfigServiceRemoveSSHAliasProcedure:
 			vPSConfigServiceRemoveSSHAliasHandler.ServeHTTP(w, r)
+		case VPSConfigServiceCreateCloudInitTemplateProcedure:
+			vPSConfigServiceCreateCloudInitTemplateHandler.ServeHTTP(w, r)
+		case VPSConfigServiceGetCloudInitTemplateProcedure:
+			vPSConfigServiceGetCloudInitTemplateHandler.ServeHTTP(w, r)
+		case VPSConfigServiceDeleteCloudInitTemplateProcedure:
+			vPSConfigServiceDeleteCloudInitTemplateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -606,3 +687,15 @@ func (UnimplementedVPSConfigServiceHandler) SetSSHAlias(context.Context, *connec
 func (UnimplementedVPSConfigServiceHandler) RemoveSSHAlias(context.Context, *connect.Request[v1.RemoveSSHAliasRequest]) (*connect.Response[v1.RemoveSSHAliasResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSConfigService.RemoveSSHAlias is not implemented"))
 }
+
+func (UnimplementedVPSConfigServiceHandler) CreateCloudInitTemplate(context.Context, *connect.Request[v1.CreateCloudInitTemplateRequest]) (*connect.Response[v1.CreateCloudInitTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSConfigService.CreateCloudInitTemplate is not implemented"))
+}
+
+func (UnimplementedVPSConfigServiceHandler) GetCloudInitTemplate(context.Context, *connect.Request[v1.GetCloudInitTemplateRequest]) (*connect.Response[v1.GetCloudInitTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSConfigService.GetCloudInitTemplate is not implemented"))
+}
+
+func (UnimplementedVPSConfigServiceHandler) DeleteCloudInitTemplate(context.Context, *connect.Request[v1.DeleteCloudInitTemplateRequest]) (*connect.Response[v1.DeleteCloudInitTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSConfigService.DeleteCloudInitTemplate is not implemented"))
+}
@@ -49,6 +49,10 @@ const (
 	VPSServiceStopVPSProcedure = "/obiente.cloud.vps.v1.VPSService/StopVPS"
 	// VPSServiceRebootVPSProcedure is the fully-qualified name of the VPSService's RebootVPS RPC.
 	VPSServiceRebootVPSProcedure = "/obiente.cloud.vps.v1.VPSService/RebootVPS"
+	// VPSServiceForceStopVPSProcedure is the fully-qualified name of the VPSService's ForceStopVPS RPC.
+	VPSServiceForceStopVPSProcedure = "/obiente.cloud.vps.v1.VPSService/ForceStopVPS"
+	// VPSServicePatchVPSProcedure is the fully-qualified name of the VPSService's PatchVPS RPC.
+	VPSServicePatchVPSProcedure = "/obiente.cloud.vps.v1.VPSService/PatchVPS"
 	// VPSServiceStreamVPSStatusProcedure is the fully-qualified name of the VPSService's
 	// StreamVPSStatus RPC.
 	VPSServiceStreamVPSStatusProcedure = "/obiente.cloud.vps.v1.VPSService/StreamVPSStatus"
@@ -68,6 +72,12 @@ const (
 	// VPSServiceGetVPSProxyInfoProcedure is the fully-qualified name of the VPSService's
 	// GetVPSProxyInfo RPC.
 	VPSServiceGetVPSProxyInfoProcedure = "/obiente.cloud.vps.v1.VPSService/GetVPSProxyInfo"
+	// VPSServiceGetVPSConsoleURLProcedure is the fully-qualified name of the VPSService's
+	// GetVPSConsoleURL RPC.
+	VPSServiceGetVPSConsoleURLProcedure = "/obiente.cloud.vps.v1.VPSService/GetVPSConsoleURL"
+	// VPSServiceGetVPSVNCSessionProcedure is the fully-qualified name of the VPSService's
+	// GetVPSVNCSession RPC.
+	VPSServiceGetVPSVNCSessionProcedure = "/obiente.cloud.vps.v1.VPSService/GetVPSVNCSession"
 	// VPSServiceListFirewallRulesProcedure is the fully-qualified name of the VPSService's
 	// ListFirewallRules RPC.
 	VPSServiceListFirewallRulesProcedure = "/obiente.cloud.vps.v1.VPSService/ListFirewallRules"
@@ -130,6 +140,23 @@ const (
 	// VPSServiceUnassignVPSPublicIPProcedure is the fully-qualified name of the VPSService's
 	// UnassignVPSPublicIP RPC.
 	VPSServiceUnassignVPSPublicIPProcedure = "/obiente.cloud.vps.v1.VPSService/UnassignVPSPublicIP"
+	// VPSServiceCreateVPSTemplateProcedure is the fully-qualified name of the VPSService's
+	// CreateVPSTemplate RPC.
+	VPSServiceCreateVPSTemplateProcedure = "/obiente.cloud.vps.v1.VPSService/CreateVPSTemplate"
+	// VPSServiceListVPSTemplatesProcedure is the fully-qualified name of the VPSService's
+	// ListVPSTemplates RPC.
+	VPSServiceListVPSTemplatesProcedure = "/obiente.cloud.vps.v1.VPSService/ListVPSTemplates"
+	// VPSServiceDeleteVPSTemplateProcedure is the fully-qualified name of the VPSService's
+	// DeleteVPSTemplate RPC.
+	VPSServiceDeleteVPSTemplateProcedure = "/obiente.cloud.vps.v1.VPSService/DeleteVPSTemplate"
+	// VPSServiceListOrgISOsProcedure is the fully-qualified name of the VPSService's ListOrgISOs RPC.
+	VPSServiceListOrgISOsProcedure = "/obiente.cloud.vps.v1.VPSService/ListOrgISOs"
+	// VPSServiceDeleteISOProcedure is the fully-qualified name of the VPSService's DeleteISO RPC.
+	VPSServiceDeleteISOProcedure = "/obiente.cloud.vps.v1.VPSService/DeleteISO"
+	// VPSServiceMountISOProcedure is the fully-qualified name of the VPSService's MountISO RPC.
+	VPSServiceMountISOProcedure = "/obiente.cloud.vps.v1.VPSService/MountISO"
+	// VPSServiceUnmountISOProcedure is the fully-qualified name of the VPSService's UnmountISO RPC.
+	VPSServiceUnmountISOProcedure = "/obiente.cloud.vps.v1.VPSService/UnmountISO"
 )
 
 // VPSServiceClient is a client for the obiente.cloud.vps.v1.VPSService service.
@@ -146,10 +173,16 @@ type VPSServiceClient interface {
 	DeleteVPS(context.Context, *connect.Request[v1.DeleteVPSRequest]) (*connect.Response[v1.DeleteVPSResponse], error)
 	// Start a stopped VPS instance
 	StartVPS(context.Context, *connect.Request[v1.StartVPSRequest]) (*connect.Response[v1.StartVPSResponse], error)
-	// Stop a running VPS instance
+	// Stop a running VPS instance with an ACPI shutdown
 	StopVPS(context.Context, *connect.Request[v1.StopVPSRequest]) (*connect.Response[v1.StopVPSResponse], error)
 	// Reboot a VPS instance
 	RebootVPS(context.Context, *connect.Request[v1.RebootVPSRequest]) (*connect.Response[v1.RebootVPSResponse], error)
+	// Power off a VPS instance immediately, without a guest shutdown
+	ForceStopVPS(context.Context, *connect.Request[v1.ForceStopVPSRequest]) (*connect.Response[v1.ForceStopVPSResponse], error)
+	// Upgrade the OS packages of a VPS (apt-get, dnf or yum, detected from /etc/os-release)
+	// The patch script is injected as cloud-init vendor-data and runs when the VM is (re)started.
+	// The run continues in the background; its result is stored in the VPS patch history.
+	PatchVPS(context.Context, *connect.Request[v1.PatchVPSRequest]) (*connect.Response[v1.PatchVPSResponse], error)
 	// Stream VPS status updates
 	StreamVPSStatus(context.Context, *connect.Request[v1.StreamVPSStatusRequest]) (*connect.ServerStreamForClient[v1.VPSStatusUpdate], error)
 	// Get VPS instance metrics (real-time or historical)
@@ -164,6 +197,10 @@ type VPSServiceClient interface {
 	ListVPSRegions(context.Context, *connect.Request[v1.ListVPSRegionsRequest]) (*connect.Response[v1.ListVPSRegionsResponse], error)
 	// Get VPS proxy connection info (for accessing VPS without dedicated IP)
 	GetVPSProxyInfo(context.Context, *connect.Request[v1.GetVPSProxyInfoRequest]) (*connect.Response[v1.GetVPSProxyInfoResponse], error)
+	// Get a short-lived noVNC console URL for a VPS (works before SSH is available, e.g. during boot)
+	GetVPSConsoleURL(context.Context, *connect.Request[v1.GetVPSConsoleURLRequest]) (*connect.Response[v1.GetVPSConsoleURLResponse], error)
+	// Get a long-lived noVNC session for a VPS that needs no VNC password (max 3 per organization)
+	GetVPSVNCSession(context.Context, *connect.Request[v1.GetVPSVNCSessionRequest]) (*connect.Response[v1.GetVPSVNCSessionResponse], error)
 	// Firewall management
 	ListFirewallRules(context.Context, *connect.Request[v1.ListFirewallRulesRequest]) (*connect.Response[v1.ListFirewallRulesResponse], error)
 	GetFirewallRule(context.Context, *connect.Request[v1.GetFirewallRuleRequest]) (*connect.Response[v1.GetFirewallRuleResponse], error)
@@ -210,6 +247,24 @@ type VPSServiceClient interface {
 	AssignVPSPublicIP(context.Context, *connect.Request[v1.AssignVPSPublicIPRequest]) (*connect.Response[v1.AssignVPSPublicIPResponse], error)
 	// Unassign a public IP from a VPS (removes DHCP static lease)
 	UnassignVPSPublicIP(context.Context, *connect.Request[v1.UnassignVPSPublicIPRequest]) (*connect.Response[v1.UnassignVPSPublicIPResponse], error)
+	// Convert a VPS into a template (golden image) that new VPS instances can be cloned from
+	// The VM is shut down through the guest agent and the VPS is marked as TERMINATED
+	CreateVPSTemplate(context.Context, *connect.Request[v1.CreateVPSTemplateRequest]) (*connect.Response[v1.CreateVPSTemplateResponse], error)
+	// List the VPS templates of an organization
+	ListVPSTemplates(context.Context, *connect.Request[v1.ListVPSTemplatesRequest]) (*connect.Response[v1.ListVPSTemplatesResponse], error)
+	// Delete a VPS template and its VM from Proxmox
+	// VPS instances already cloned from the template are not affected
+	DeleteVPSTemplate(context.Context, *connect.Request[v1.DeleteVPSTemplateRequest]) (*connect.Response[v1.DeleteVPSTemplateResponse], error)
+	// List the install ISOs of an organization and its ISO storage usage
+	// ISOs are uploaded with a multipart POST to /vps/isos/upload (see the VPS service README)
+	ListOrgISOs(context.Context, *connect.Request[v1.ListOrgISOsRequest]) (*connect.Response[v1.ListOrgISOsResponse], error)
+	// Delete an install ISO of an organization from the ISO storage
+	DeleteISO(context.Context, *connect.Request[v1.DeleteISORequest]) (*connect.Response[v1.DeleteISOResponse], error)
+	// Insert an ISO of the organization into the VPS's CD-ROM drive and boot from it first
+	// Takes effect on the next start; the first mount requires the VPS to be stopped
+	MountISO(context.Context, *connect.Request[v1.MountISORequest]) (*connect.Response[v1.MountISOResponse], error)
+	// Eject the ISO from the VPS's CD-ROM drive
+	UnmountISO(context.Context, *connect.Request[v1.UnmountISORequest]) (*connect.Response[v1.UnmountISOResponse], error)
 }
 
 // NewVPSServiceClient constructs a client for the obiente.cloud.vps.v1.VPSService service. By
@@ -271,6 +326,18 @@ func NewVPSServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(vPSServiceMethods.ByName("RebootVPS")),
 			connect.WithClientOptions(opts...),
 		),
+		forceStopVPS: connect.NewClient[v1.ForceStopVPSRequest, v1.ForceStopVPSResponse](
+			httpClient,
+			baseURL+VPSServiceForceStopVPSProcedure,
+			connect.WithSchema(vPSServiceMethods.ByName("ForceStopVPS")),
+			connect.WithClientOptions(opts...),
+		),
+		patchVPS: connect.NewClient[v1.PatchVPSRequest, v1.PatchVPSResponse](
+			httpClient,
+			baseURL+VPSServicePatchVPSProcedure,
+			connect.WithSchema(vPSServiceMethods.ByName("PatchVPS")),
+			connect.WithClientOptions(opts...),
+		),
 		streamVPSStatus: connect.NewClient[v1.StreamVPSStatusRequest, v1.VPSStatusUpdate](
 			httpClient,
 			baseURL+VPSServiceStreamVPSStatusProcedure,
@@ -313,6 +380,18 @@ func NewVPSServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(vPSServiceMethods.ByName("GetVPSProxyInfo")),
 			connect.WithClientOptions(opts...),
 		),
+		getVPSConsoleURL: connect.NewClient[v1.GetVPSConsoleURLRequest, v1.GetVPSConsoleURLResponse](
+			httpClient,
+			baseURL+VPSServiceGetVPSConsoleURLProcedure,
+			connect.WithSchema(vPSServiceMethods.ByName("GetVPSConsoleURL")),
+			connect.WithClientOptions(opts...),
+		),
+		getVPSVNCSession: connect.NewClient[v1.GetVPSVNCSessionRequest, v1.GetVPSVNCSessionResponse](
+			httpClient,
+			baseURL+VPSServiceGetVPSVNCSessionProcedure,
+			connect.WithSchema(vPSServiceMethods.ByName("GetVPSVNCSession")),
+			connect.WithClientOptions(opts...),
+		),
 		listFirewallRules: connect.NewClient[v1.ListFirewallRulesRequest, v1.ListFirewallRulesResponse](
 			httpClient,
 			baseURL+VPSServiceListFirewallRulesProcedure,
@@ -451,6 +530,48 @@ func NewVPSServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(vPSServiceMethods.ByName("UnassignVPSPublicIP")),
 			connect.WithClientOptions(opts...),
 		),
+		createVPSTemplate: connect.NewClient[v1.CreateVPSTemplateRequest, v1.CreateVPSTemplateResponse](
+			httpClient,
+			baseURL+VPSServiceCreateVPSTemplateProcedure,
+			connect.WithSchema(vPSServiceMethods.ByName("CreateVPSTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		listVPSTemplates: connect.NewClient[v1.ListVPSTemplatesRequest, v1.ListVPSTemplatesResponse](
+			httpClient,
+			baseURL+VPSServiceListVPSTemplatesProcedure,
+			connect.WithSchema(vPSServiceMethods.ByName("ListVPSTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteVPSTemplate: connect.NewClient[v1.DeleteVPSTemplateRequest, v1.DeleteVPSTemplateResponse](
+			httpClient,
+			baseURL+VPSServiceDeleteVPSTemplateProcedure,
+			connect.WithSchema(vPSServiceMethods.ByName("DeleteVPSTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		listOrgISOs: connect.NewClient[v1.ListOrgISOsRequest, v1.ListOrgISOsResponse](
+			httpClient,
+			baseURL+VPSServiceListOrgISOsProcedure,
+			connect.WithSchema(vPSServiceMethods.ByName("ListOrgISOs")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteISO: connect.NewClient[v1.DeleteISORequest, v1.DeleteISOResponse](
+			httpClient,
+			baseURL+VPSServiceDeleteISOProcedure,
+			connect.WithSchema(vPSServiceMethods.ByName("DeleteISO")),
+			connect.WithClientOptions(opts...),
+		),
+		mountISO: connect.NewClient[v1.MountISORequest, v1.MountISOResponse](
+			httpClient,
+			baseURL+VPSServiceMountISOProcedure,
+			connect.WithSchema(vPSServiceMethods.ByName("MountISO")),
+			connect.WithClientOptions(opts...),
+		),
+		unmountISO: connect.NewClient[v1.UnmountISORequest, v1.UnmountISOResponse](
+			httpClient,
+			baseURL+VPSServiceUnmountISOProcedure,
+			connect.WithSchema(vPSServiceMethods.ByName("UnmountISO")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -464,6 +585,8 @@ type vPSServiceClient struct {
 	startVPS              *connect.Client[v1.StartVPSRequest, v1.StartVPSResponse]
 	stopVPS               *connect.Client[v1.StopVPSRequest, v1.StopVPSResponse]
 	rebootVPS             *connect.Client[v1.RebootVPSRequest, v1.RebootVPSResponse]
+	forceStopVPS          *connect.Client[v1.ForceStopVPSRequest, v1.ForceStopVPSResponse]
+	patchVPS              *connect.Client[v1.PatchVPSRequest, v1.PatchVPSResponse]
 	streamVPSStatus       *connect.Client[v1.StreamVPSStatusRequest, v1.VPSStatusUpdate]
 	getVPSMetrics         *connect.Client[v1.GetVPSMetricsRequest, v1.GetVPSMetricsResponse]
 	streamVPSMetrics      *connect.Client[v1.StreamVPSMetricsRequest, v1.VPSMetric]
@@ -471,6 +594,8 @@ type vPSServiceClient struct {
 	listVPSSizes          *connect.Client[v1.ListAvailableVPSSizesRequest, v1.ListAvailableVPSSizesResponse]
 	listVPSRegions        *connect.Client[v1.ListVPSRegionsRequest, v1.ListVPSRegionsResponse]
 	getVPSProxyInfo       *connect.Client[v1.GetVPSProxyInfoRequest, v1.GetVPSProxyInfoResponse]
+	getVPSConsoleURL      *connect.Client[v1.GetVPSConsoleURLRequest, v1.GetVPSConsoleURLResponse]
+	getVPSVNCSession      *connect.Client[v1.GetVPSVNCSessionRequest, v1.GetVPSVNCSessionResponse]
 	listFirewallRules     *connect.Client[v1.ListFirewallRulesRequest, v1.ListFirewallRulesResponse]
 	getFirewallRule       *connect.Client[v1.GetFirewallRuleRequest, v1.GetFirewallRuleResponse]
 	createFirewallRule    *connect.Client[v1.CreateFirewallRuleRequest, v1.CreateFirewallRuleResponse]
@@ -494,6 +619,13 @@ type vPSServiceClient struct {
 	releaseLease          *connect.Client[v1.ReleaseLeaseRequest, v1.ReleaseLeaseResponse]
 	assignVPSPublicIP     *connect.Client[v1.AssignVPSPublicIPRequest, v1.AssignVPSPublicIPResponse]
 	unassignVPSPublicIP   *connect.Client[v1.UnassignVPSPublicIPRequest, v1.UnassignVPSPublicIPResponse]
+	createVPSTemplate     *connect.Client[v1.CreateVPSTemplateRequest, v1.CreateVPSTemplateResponse]
+	listVPSTemplates      *connect.Client[v1.ListVPSTemplatesRequest, v1.ListVPSTemplatesResponse]
+	deleteVPSTemplate     *connect.Client[v1.DeleteVPSTemplateRequest, v1.DeleteVPSTemplateResponse]
+	listOrgISOs           *connect.Client[v1.ListOrgISOsRequest, v1.ListOrgISOsResponse]
+	deleteISO             *connect.Client[v1.DeleteISORequest, v1.DeleteISOResponse]
+	mountISO              *connect.Client[v1.MountISORequest, v1.MountISOResponse]
+	unmountISO            *connect.Client[v1.UnmountISORequest, v1.UnmountISOResponse]
 }
 
 // ListVPS calls obiente.cloud.vps.v1.VPSService.ListVPS.
@@ -536,6 +668,16 @@ func (c *vPSServiceClient) RebootVPS(ctx context.Context, req *connect.Request[v
 	return c.rebootVPS.CallUnary(ctx, req)
 }
 
+// ForceStopVPS calls obiente.cloud.vps.v1.VPSService.ForceStopVPS.
+func (c *vPSServiceClient) ForceStopVPS(ctx context.Context, req *connect.Request[v1.ForceStopVPSRequest]) (*connect.Response[v1.ForceStopVPSResponse], error) {
+	return c.forceStopVPS.CallUnary(ctx, req)
+}
+
+// PatchVPS calls obiente.cloud.vps.v1.VPSService.PatchVPS.
+func (c *vPSServiceClient) PatchVPS(ctx context.Context, req *connect.Request[v1.PatchVPSRequest]) (*connect.Response[v1.PatchVPSResponse], error) {
+	return c.patchVPS.CallUnary(ctx, req)
+}
+
 // StreamVPSStatus calls obiente.cloud.vps.v1.VPSService.StreamVPSStatus.
 func (c *vPSServiceClient) StreamVPSStatus(ctx context.Context, req *connect.Request[v1.StreamVPSStatusRequest]) (*connect.ServerStreamForClient[v1.VPSStatusUpdate], error) {
 	return c.streamVPSStatus.CallServerStream(ctx, req)
@@ -571,6 +713,16 @@ func (c *vPSServiceClient) GetVPSProxyInfo(ctx context.Context, req *connect.Req
 	return c.getVPSProxyInfo.CallUnary(ctx, req)
 }
 
+// GetVPSConsoleURL calls obiente.cloud.vps.v1.VPSService.GetVPSConsoleURL.
+func (c *vPSServiceClient) GetVPSConsoleURL(ctx context.Context, req *connect.Request[v1.GetVPSConsoleURLRequest]) (*connect.Response[v1.GetVPSConsoleURLResponse], error) {
+	return c.getVPSConsoleURL.CallUnary(ctx, req)
+}
+
+// GetVPSVNCSession calls obiente.cloud.vps.v1.VPSService.GetVPSVNCSession.
+func (c *vPSServiceClient) GetVPSVNCSession(ctx context.Context, req *connect.Request[v1.GetVPSVNCSessionRequest]) (*connect.Response[v1.GetVPSVNCSessionResponse], error) {
+	return c.getVPSVNCSession.CallUnary(ctx, req)
+}
+
 // ListFirewallRules calls obiente.cloud.vps.v1.VPSService.ListFirewallRules.
 func (c *vPSServiceClient) ListFirewallRules(ctx context.Context, req *connect.Request[v1.ListFirewallRulesRequest]) (*connect.Response[v1.ListFirewallRulesResponse], error) {
 	return c.listFirewallRules.CallUnary(ctx, req)
@@ -686,6 +838,41 @@ func (c *vPSServiceClient) UnassignVPSPublicIP(ctx context.Context, req *connect
 	return c.unassignVPSPublicIP.CallUnary(ctx, req)
 }
 
+// CreateVPSTemplate calls obiente.cloud.vps.v1.VPSService.CreateVPSTemplate.
+func (c *vPSServiceClient) CreateVPSTemplate(ctx context.Context, req *connect.Request[v1.CreateVPSTemplateRequest]) (*connect.Response[v1.CreateVPSTemplateResponse], error) {
+	return c.createVPSTemplate.CallUnary(ctx, req)
+}
+
+// ListVPSTemplates calls obiente.cloud.vps.v1.VPSService.ListVPSTemplates.
+func (c *vPSServiceClient) ListVPSTemplates(ctx context.Context, req *connect.Request[v1.ListVPSTemplatesRequest]) (*connect.Response[v1.ListVPSTemplatesResponse], error) {
+	return c.listVPSTemplates.CallUnary(ctx, req)
+}
+
+// DeleteVPSTemplate calls obiente.cloud.vps.v1.VPSService.DeleteVPSTemplate.
+func (c *vPSServiceClient) DeleteVPSTemplate(ctx context.Context, req *connect.Request[v1.DeleteVPSTemplateRequest]) (*connect.Response[v1.DeleteVPSTemplateResponse], error) {
+	return c.deleteVPSTemplate.CallUnary(ctx, req)
+}
+
+// ListOrgISOs calls obiente.cloud.vps.v1.VPSService.ListOrgISOs.
+func (c *vPSServiceClient) ListOrgISOs(ctx context.Context, req *connect.Request[v1.ListOrgISOsRequest]) (*connect.Response[v1.ListOrgISOsResponse], error) {
+	return c.listOrgISOs.CallUnary(ctx, req)
+}
+
+// DeleteISO calls obiente.cloud.vps.v1.VPSService.DeleteISO.
+func (c *vPSServiceClient) DeleteISO(ctx context.Context, req *connect.Request[v1.DeleteISORequest]) (*connect.Response[v1.DeleteISOResponse], error) {
+	return c.deleteISO.CallUnary(ctx, req)
+}
+
+// MountISO calls obiente.cloud.vps.v1.VPSService.MountISO.
+func (c *vPSServiceClient) MountISO(ctx context.Context, req *connect.Request[v1.MountISORequest]) (*connect.Response[v1.MountISOResponse], error) {
+	return c.mountISO.CallUnary(ctx, req)
+}
+
+// UnmountISO calls obiente.cloud.vps.v1.VPSService.UnmountISO.
+func (c *vPSServiceClient) UnmountISO(ctx context.Context, req *connect.Request[v1.UnmountISORequest]) (*connect.Response[v1.UnmountISOResponse], error) {
+	return c.unmountISO.CallUnary(ctx, req)
+}
+
 // VPSServiceHandler is an implementation of the obiente.cloud.vps.v1.VPSService service.
 type VPSServiceHandler interface {
 	// List organization VPS instances
@@ -700,10 +887,16 @@ type VPSServiceHandler interface {
 	DeleteVPS(context.Context, *connect.Request[v1.DeleteVPSRequest]) (*connect.Response[v1.DeleteVPSResponse], error)
 	// Start a stopped VPS instance
 	StartVPS(context.Context, *connect.Request[v1.StartVPSRequest]) (*connect.Response[v1.StartVPSResponse], error)
-	// Stop a running VPS instance
+	// Stop a running VPS instance with an ACPI shutdown
 	StopVPS(context.Context, *connect.Request[v1.StopVPSRequest]) (*connect.Response[v1.StopVPSResponse], error)
 	// Reboot a VPS instance
 	RebootVPS(context.Context, *connect.Request[v1.RebootVPSRequest]) (*connect.Response[v1.RebootVPSResponse], error)
+	// Power off a VPS instance immediately, without a guest shutdown
+	ForceStopVPS(context.Context, *connect.Request[v1.ForceStopVPSRequest]) (*connect.Response[v1.ForceStopVPSResponse], error)
+	// Upgrade the OS packages of a VPS (apt-get, dnf or yum, detected from /etc/os-release)
+	// The patch script is injected as cloud-init vendor-data and runs when the VM is (re)started.
+	// The run continues in the background; its result is stored in the VPS patch history.
+	PatchVPS(context.Context, *connect.Request[v1.PatchVPSRequest]) (*connect.Response[v1.PatchVPSResponse], error)
 	// Stream VPS status updates
 	StreamVPSStatus(context.Context, *connect.Request[v1.StreamVPSStatusRequest], *connect.ServerStream[v1.VPSStatusUpdate]) error
 	// Get VPS instance metrics (real-time or historical)
@@ -718,6 +911,10 @@ type VPSServiceHandler interface {
 	ListVPSRegions(context.Context, *connect.Request[v1.ListVPSRegionsRequest]) (*connect.Response[v1.ListVPSRegionsResponse], error)
 	// Get VPS proxy connection info (for accessing VPS without dedicated IP)
 	GetVPSProxyInfo(context.Context, *connect.Request[v1.GetVPSProxyInfoRequest]) (*connect.Response[v1.GetVPSProxyInfoResponse], error)
+	// Get a short-lived noVNC console URL for a VPS (works before SSH is available, e.g. during boot)
+	GetVPSConsoleURL(context.Context, *connect.Request[v1.GetVPSConsoleURLRequest]) (*connect.Response[v1.GetVPSConsoleURLResponse], error)
+	// Get a long-lived noVNC session for a VPS that needs no VNC password (max 3 per organization)
+	GetVPSVNCSession(context.Context, *connect.Request[v1.GetVPSVNCSessionRequest]) (*connect.Response[v1.GetVPSVNCSessionResponse], error)
 	// Firewall management
 	ListFirewallRules(context.Context, *connect.Request[v1.ListFirewallRulesRequest]) (*connect.Response[v1.ListFirewallRulesResponse], error)
 	GetFirewallRule(context.Context, *connect.Request[v1.GetFirewallRuleRequest]) (*connect.Response[v1.GetFirewallRuleResponse], error)
@@ -764,6 +961,24 @@ type VPSServiceHandler interface {
 	AssignVPSPublicIP(context.Context, *connect.Request[v1.AssignVPSPublicIPRequest]) (*connect.Response[v1.AssignVPSPublicIPResponse], error)
 	// Unassign a public IP from a VPS (removes DHCP static lease)
 	UnassignVPSPublicIP(context.Context, *connect.Request[v1.UnassignVPSPublicIPRequest]) (*connect.Response[v1.UnassignVPSPublicIPResponse], error)
+	// Convert a VPS into a template (golden image) that new VPS instances can be cloned from
+	// The VM is shut down through the guest agent and the VPS is marked as TERMINATED
+	CreateVPSTemplate(context.Context, *connect.Request[v1.CreateVPSTemplateRequest]) (*connect.Response[v1.CreateVPSTemplateResponse], error)
+	// List the VPS templates of an organization
+	ListVPSTemplates(context.Context, *connect.Request[v1.ListVPSTemplatesRequest]) (*connect.Response[v1.ListVPSTemplatesResponse], error)
+	// Delete a VPS template and its VM from Proxmox
+	// VPS instances already cloned from the template are not affected
+	DeleteVPSTemplate(context.Context, *connect.Request[v1.DeleteVPSTemplateRequest]) (*connect.Response[v1.DeleteVPSTemplateResponse], error)
+	// List the install ISOs of an organization and its ISO storage usage
+	// ISOs are uploaded with a multipart POST to /vps/isos/upload (see the VPS service README)
+	ListOrgISOs(context.Context, *connect.Request[v1.ListOrgISOsRequest]) (*connect.Response[v1.ListOrgISOsResponse], error)
+	// Delete an install ISO of an organization from the ISO storage
+	DeleteISO(context.Context, *connect.Request[v1.DeleteISORequest]) (*connect.Response[v1.DeleteISOResponse], error)
+	// Insert an ISO of the organization into the VPS's CD-ROM drive and boot from it first
+	// Takes effect on the next start; the first mount requires the VPS to be stopped
+	MountISO(context.Context, *connect.Request[v1.MountISORequest]) (*connect.Response[v1.MountISOResponse], error)
+	// Eject the ISO from the VPS's CD-ROM drive
+	UnmountISO(context.Context, *connect.Request[v1.UnmountISORequest]) (*connect.Response[v1.UnmountISOResponse], error)
 }
 
 // NewVPSServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -821,6 +1036,18 @@ func NewVPSServiceHandler(svc VPSServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(vPSServiceMethods.ByName("RebootVPS")),
 		connect.WithHandlerOptions(opts...),
 	)
+	vPSServiceForceStopVPSHandler := connect.NewUnaryHandler(
+		VPSServiceForceStopVPSProcedure,
+		svc.ForceStopVPS,
+		connect.WithSchema(vPSServiceMethods.ByName("ForceStopVPS")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vPSServicePatchVPSHandler := connect.NewUnaryHandler(
+		VPSServicePatchVPSProcedure,
+		svc.PatchVPS,
+		connect.WithSchema(vPSServiceMethods.ByName("PatchVPS")),
+		connect.WithHandlerOptions(opts...),
+	)
 	vPSServiceStreamVPSStatusHandler := connect.NewServerStreamHandler(
 		VPSServiceStreamVPSStatusProcedure,
 		svc.StreamVPSStatus,
@@ -863,6 +1090,18 @@ func NewVPSServiceHandler(svc VPSServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(vPSServiceMethods.ByName("GetVPSProxyInfo")),
 		connect.WithHandlerOptions(opts...),
 	)
+	vPSServiceGetVPSConsoleURLHandler := connect.NewUnaryHandler(
+		VPSServiceGetVPSConsoleURLProcedure,
+		svc.GetVPSConsoleURL,
+		connect.WithSchema(vPSServiceMethods.ByName("GetVPSConsoleURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vPSServiceGetVPSVNCSessionHandler := connect.NewUnaryHandler(
+		VPSServiceGetVPSVNCSessionProcedure,
+		svc.GetVPSVNCSession,
+		connect.WithSchema(vPSServiceMethods.ByName("GetVPSVNCSession")),
+		connect.WithHandlerOptions(opts...),
+	)
 	vPSServiceListFirewallRulesHandler := connect.NewUnaryHandler(
 		VPSServiceListFirewallRulesProcedure,
 		svc.ListFirewallRules,
@@ -1001,6 +1240,48 @@ func NewVPSServiceHandler(svc VPSServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(vPSServiceMethods.ByName("UnassignVPSPublicIP")),
 		connect.WithHandlerOptions(opts...),
 	)
+	vPSServiceCreateVPSTemplateHandler := connect.NewUnaryHandler(
+		VPSServiceCreateVPSTemplateProcedure,
+		svc.CreateVPSTemplate,
+		connect.WithSchema(vPSServiceMethods.ByName("CreateVPSTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vPSServiceListVPSTemplatesHandler := connect.NewUnaryHandler(
+		VPSServiceListVPSTemplatesProcedure,
+		svc.ListVPSTemplates,
+		connect.WithSchema(vPSServiceMethods.ByName("ListVPSTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vPSServiceDeleteVPSTemplateHandler := connect.NewUnaryHandler(
+		VPSServiceDeleteVPSTemplateProcedure,
+		svc.DeleteVPSTemplate,
+		connect.WithSchema(vPSServiceMethods.ByName("DeleteVPSTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vPSServiceListOrgISOsHandler := connect.NewUnaryHandler(
+		VPSServiceListOrgISOsProcedure,
+		svc.ListOrgISOs,
+		connect.WithSchema(vPSServiceMethods.ByName("ListOrgISOs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vPSServiceDeleteISOHandler := connect.NewUnaryHandler(
+		VPSServiceDeleteISOProcedure,
+		svc.DeleteISO,
+		connect.WithSchema(vPSServiceMethods.ByName("DeleteISO")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vPSServiceMountISOHandler := connect.NewUnaryHandler(
+		VPSServiceMountISOProcedure,
+		svc.MountISO,
+		connect.WithSchema(vPSServiceMethods.ByName("MountISO")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vPSServiceUnmountISOHandler := connect.NewUnaryHandler(
+		VPSServiceUnmountISOProcedure,
+		svc.UnmountISO,
+		connect.WithSchema(vPSServiceMethods.ByName("UnmountISO")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/obiente.cloud.vps.v1.VPSService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case VPSServiceListVPSProcedure:
@@ -1019,6 +1300,10 @@ func NewVPSServiceHandler(svc VPSServiceHandler, opts ...connect.HandlerOption)
 			vPSServiceStopVPSHandler.ServeHTTP(w, r)
 		case VPSServiceRebootVPSProcedure:
 			vPSServiceRebootVPSHandler.ServeHTTP(w, r)
+		case VPSServiceForceStopVPSProcedure:
+			vPSServiceForceStopVPSHandler.ServeHTTP(w, r)
+		case VPSServicePatchVPSProcedure:
+			vPSServicePatchVPSHandler.ServeHTTP(w, r)
 		case VPSServiceStreamVPSStatusProcedure:
 			vPSServiceStreamVPSStatusHandler.ServeHTTP(w, r)
 		case VPSServiceGetVPSMetricsProcedure:
@@ -1033,6 +1318,10 @@ func NewVPSServiceHandler(svc VPSServiceHandler, opts ...connect.HandlerOption)
 			vPSServiceListVPSRegionsHandler.ServeHTTP(w, r)
 		case VPSServiceGetVPSProxyInfoProcedure:
 			vPSServiceGetVPSProxyInfoHandler.ServeHTTP(w, r)
+		case VPSServiceGetVPSConsoleURLProcedure:
+			vPSServiceGetVPSConsoleURLHandler.ServeHTTP(w, r)
+		case VPSServiceGetVPSVNCSessionProcedure:
+			vPSServiceGetVPSVNCSessionHandler.ServeHTTP(w, r)
 		case VPSServiceListFirewallRulesProcedure:
 			vPSServiceListFirewallRulesHandler.ServeHTTP(w, r)
 		case VPSServiceGetFirewallRuleProcedure:
@@ -1079,6 +1368,20 @@ func NewVPSServiceHandler(svc VPSServiceHandler, opts ...connect.HandlerOption)
 			vPSServiceAssignVPSPublicIPHandler.ServeHTTP(w, r)
 		case VPSServiceUnassignVPSPublicIPProcedure:
 			vPSServiceUnassignVPSPublicIPHandler.ServeHTTP(w, r)
+		case VPSServiceCreateVPSTemplateProcedure:
+			vPSServiceCreateVPSTemplateHandler.ServeHTTP(w, r)
+		case VPSServiceListVPSTemplatesProcedure:
+			vPSServiceListVPSTemplatesHandler.ServeHTTP(w, r)
+		case VPSServiceDeleteVPSTemplateProcedure:
+			vPSServiceDeleteVPSTemplateHandler.ServeHTTP(w, r)
+		case VPSServiceListOrgISOsProcedure:
+			vPSServiceListOrgISOsHandler.ServeHTTP(w, r)
+		case VPSServiceDeleteISOProcedure:
+			vPSServiceDeleteISOHandler.ServeHTTP(w, r)
+		case VPSServiceMountISOProcedure:
+			vPSServiceMountISOHandler.ServeHTTP(w, r)
+		case VPSServiceUnmountISOProcedure:
+			vPSServiceUnmountISOHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1120,6 +1423,14 @@ func (UnimplementedVPSServiceHandler) RebootVPS(context.Context, *connect.Reques
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.RebootVPS is not implemented"))
 }
 
+func (UnimplementedVPSServiceHandler) ForceStopVPS(context.Context, *connect.Request[v1.ForceStopVPSRequest]) (*connect.Response[v1.ForceStopVPSResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.ForceStopVPS is not implemented"))
+}
+
+func (UnimplementedVPSServiceHandler) PatchVPS(context.Context, *connect.Request[v1.PatchVPSRequest]) (*connect.Response[v1.PatchVPSResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.PatchVPS is not implemented"))
+}
+
 func (UnimplementedVPSServiceHandler) StreamVPSStatus(context.Context, *connect.Request[v1.StreamVPSStatusRequest], *connect.ServerStream[v1.VPSStatusUpdate]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.StreamVPSStatus is not implemented"))
 }
@@ -1148,6 +1459,14 @@ func (UnimplementedVPSServiceHandler) GetVPSProxyInfo(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.GetVPSProxyInfo is not implemented"))
 }
 
+func (UnimplementedVPSServiceHandler) GetVPSConsoleURL(context.Context, *connect.Request[v1.GetVPSConsoleURLRequest]) (*connect.Response[v1.GetVPSConsoleURLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.GetVPSConsoleURL is not implemented"))
+}
+
+func (UnimplementedVPSServiceHandler) GetVPSVNCSession(context.Context, *connect.Request[v1.GetVPSVNCSessionRequest]) (*connect.Response[v1.GetVPSVNCSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.GetVPSVNCSession is not implemented"))
+}
+
 func (UnimplementedVPSServiceHandler) ListFirewallRules(context.Context, *connect.Request[v1.ListFirewallRulesRequest]) (*connect.Response[v1.ListFirewallRulesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.ListFirewallRules is not implemented"))
 }
@@ -1239,3 +1558,31 @@ func (UnimplementedVPSServiceHandler) AssignVPSPublicIP(context.Context, *connec
 func (UnimplementedVPSServiceHandler) UnassignVPSPublicIP(context.Context, *connect.Request[v1.UnassignVPSPublicIPRequest]) (*connect.Response[v1.UnassignVPSPublicIPResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.UnassignVPSPublicIP is not implemented"))
 }
+
+func (UnimplementedVPSServiceHandler) CreateVPSTemplate(context.Context, *connect.Request[v1.CreateVPSTemplateRequest]) (*connect.Response[v1.CreateVPSTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.CreateVPSTemplate is not implemented"))
+}
+
+func (UnimplementedVPSServiceHandler) ListVPSTemplates(context.Context, *connect.Request[v1.ListVPSTemplatesRequest]) (*connect.Response[v1.ListVPSTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.ListVPSTemplates is not implemented"))
+}
+
+func (UnimplementedVPSServiceHandler) DeleteVPSTemplate(context.Context, *connect.Request[v1.DeleteVPSTemplateRequest]) (*connect.Response[v1.DeleteVPSTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.DeleteVPSTemplate is not implemented"))
+}
+
+func (UnimplementedVPSServiceHandler) ListOrgISOs(context.Context, *connect.Request[v1.ListOrgISOsRequest]) (*connect.Response[v1.ListOrgISOsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.ListOrgISOs is not implemented"))
+}
+
+func (UnimplementedVPSServiceHandler) DeleteISO(context.Context, *connect.Request[v1.DeleteISORequest]) (*connect.Response[v1.DeleteISOResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.DeleteISO is not implemented"))
+}
+
+func (UnimplementedVPSServiceHandler) MountISO(context.Context, *connect.Request[v1.MountISORequest]) (*connect.Response[v1.MountISOResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.MountISO is not implemented"))
+}
+
+func (UnimplementedVPSServiceHandler) UnmountISO(context.Context, *connect.Request[v1.UnmountISORequest]) (*connect.Response[v1.UnmountISOResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vps.v1.VPSService.UnmountISO is not implemented"))
+}
@@ -2270,6 +2270,125 @@ func (x *SyncAllocationsResponse) GetDiscoveredAllocations() []*DesiredAllocatio
 	return nil
 }
 
+// UpdateBandwidthRequest sets the bandwidth limits enforced for a VPS's lease
+type UpdateBandwidthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// VPS ID
+	VpsId string `protobuf:"bytes,1,opt,name=vps_id,json=vpsId,proto3" json:"vps_id,omitempty"`
+	// Download limit in Mbit/s (0 = unlimited)
+	MaxMbitDown int32 `protobuf:"varint,2,opt,name=max_mbit_down,json=maxMbitDown,proto3" json:"max_mbit_down,omitempty"`
+	// Upload limit in Mbit/s (0 = unlimited)
+	MaxMbitUp     int32 `protobuf:"varint,3,opt,name=max_mbit_up,json=maxMbitUp,proto3" json:"max_mbit_up,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBandwidthRequest) Reset() {
+	*x = UpdateBandwidthRequest{}
+	mi := &file_obiente_cloud_vpsgateway_v1_gateway_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBandwidthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBandwidthRequest) ProtoMessage() {}
+
+func (x *UpdateBandwidthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vpsgateway_v1_gateway_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBandwidthRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBandwidthRequest) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vpsgateway_v1_gateway_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateBandwidthRequest) GetVpsId() string {
+	if x != nil {
+		return x.VpsId
+	}
+	return ""
+}
+
+func (x *UpdateBandwidthRequest) GetMaxMbitDown() int32 {
+	if x != nil {
+		return x.MaxMbitDown
+	}
+	return 0
+}
+
+func (x *UpdateBandwidthRequest) GetMaxMbitUp() int32 {
+	if x != nil {
+		return x.MaxMbitUp
+	}
+	return 0
+}
+
+// UpdateBandwidthResponse confirms the new limits were applied
+type UpdateBandwidthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success status
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Message (optional)
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBandwidthResponse) Reset() {
+	*x = UpdateBandwidthResponse{}
+	mi := &file_obiente_cloud_vpsgateway_v1_gateway_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBandwidthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBandwidthResponse) ProtoMessage() {}
+
+func (x *UpdateBandwidthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_obiente_cloud_vpsgateway_v1_gateway_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBandwidthResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBandwidthResponse) Descriptor() ([]byte, []int) {
+	return file_obiente_cloud_vpsgateway_v1_gateway_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateBandwidthResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateBandwidthResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_obiente_cloud_vpsgateway_v1_gateway_service_proto protoreflect.FileDescriptor
 
 const file_obiente_cloud_vpsgateway_v1_gateway_service_proto_rawDesc = "" +
@@ -2459,7 +2578,14 @@ const file_obiente_cloud_vpsgateway_v1_gateway_service_proto_rawDesc = "" +
 	"\x05added\x18\x02 \x01(\x05R\x05added\x12\x18\n" +
 	"\aremoved\x18\x03 \x01(\x05R\aremoved\x12\x18\n" +
 	"\amessage\x18\x04 \x01(\tR\amessage\x12e\n" +
-	"\x16discovered_allocations\x18\x05 \x03(\v2..obiente.cloud.vpsgateway.v1.DesiredAllocationR\x15discoveredAllocations2\xa4\f\n" +
+	"\x16discovered_allocations\x18\x05 \x03(\v2..obiente.cloud.vpsgateway.v1.DesiredAllocationR\x15discoveredAllocations\"s\n" +
+	"\x16UpdateBandwidthRequest\x12\x15\n" +
+	"\x06vps_id\x18\x01 \x01(\tR\x05vpsId\x12\"\n" +
+	"\rmax_mbit_down\x18\x02 \x01(\x05R\vmaxMbitDown\x12\x1e\n" +
+	"\vmax_mbit_up\x18\x03 \x01(\x05R\tmaxMbitUp\"M\n" +
+	"\x17UpdateBandwidthResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xa2\r\n" +
 	"\x11VPSGatewayService\x12o\n" +
 	"\x0fRegisterGateway\x12+.obiente.cloud.vpsgateway.v1.GatewayMessage\x1a+.obiente.cloud.vpsgateway.v1.GatewayMessage(\x010\x01\x12m\n" +
 	"\n" +
@@ -2474,7 +2600,8 @@ const file_obiente_cloud_vpsgateway_v1_gateway_service_proto_rawDesc = "" +
 	"\fGetOrgLeases\x120.obiente.cloud.vpsgateway.v1.GetOrgLeasesRequest\x1a1.obiente.cloud.vpsgateway.v1.GetOrgLeasesResponse\x12|\n" +
 	"\x0fSyncAllocations\x123.obiente.cloud.vpsgateway.v1.SyncAllocationsRequest\x1a4.obiente.cloud.vpsgateway.v1.SyncAllocationsResponse\x12\x85\x01\n" +
 	"\x12AddStaticDHCPLease\x126.obiente.cloud.vpsgateway.v1.AddStaticDHCPLeaseRequest\x1a7.obiente.cloud.vpsgateway.v1.AddStaticDHCPLeaseResponse\x12\x8e\x01\n" +
-	"\x15RemoveStaticDHCPLease\x129.obiente.cloud.vpsgateway.v1.RemoveStaticDHCPLeaseRequest\x1a:.obiente.cloud.vpsgateway.v1.RemoveStaticDHCPLeaseResponseBUZSgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/vpsgateway/v1;vpsgatewayv1b\x06proto3"
+	"\x15RemoveStaticDHCPLease\x129.obiente.cloud.vpsgateway.v1.RemoveStaticDHCPLeaseRequest\x1a:.obiente.cloud.vpsgateway.v1.RemoveStaticDHCPLeaseResponse\x12|\n" +
+	"\x0fUpdateBandwidth\x123.obiente.cloud.vpsgateway.v1.UpdateBandwidthRequest\x1a4.obiente.cloud.vpsgateway.v1.UpdateBandwidthResponseBUZSgithub.com/obiente/cloud/apps/shared/proto/obiente/cloud/vpsgateway/v1;vpsgatewayv1b\x06proto3"
 
 var (
 	file_obiente_cloud_vpsgateway_v1_gateway_service_proto_rawDescOnce sync.Once
@@ -2488,7 +2615,7 @@ func file_obiente_cloud_vpsgateway_v1_gateway_service_proto_rawDescGZIP() []byte
 	return file_obiente_cloud_vpsgateway_v1_gateway_service_proto_rawDescData
 }
 
-var file_obiente_cloud_vpsgateway_v1_gateway_service_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_obiente_cloud_vpsgateway_v1_gateway_service_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_obiente_cloud_vpsgateway_v1_gateway_service_proto_goTypes = []any{
 	(*AddStaticDHCPLeaseRequest)(nil),     // 0: obiente.cloud.vpsgateway.v1.AddStaticDHCPLeaseRequest
 	(*AddStaticDHCPLeaseResponse)(nil),    // 1: obiente.cloud.vpsgateway.v1.AddStaticDHCPLeaseResponse
@@ -2522,21 +2649,23 @@ var file_obiente_cloud_vpsgateway_v1_gateway_service_proto_goTypes = []any{
 	(*DesiredAllocation)(nil),             // 29: obiente.cloud.vpsgateway.v1.DesiredAllocation
 	(*SyncAllocationsRequest)(nil),        // 30: obiente.cloud.vpsgateway.v1.SyncAllocationsRequest
 	(*SyncAllocationsResponse)(nil),       // 31: obiente.cloud.vpsgateway.v1.SyncAllocationsResponse
-	(*timestamppb.Timestamp)(nil),         // 32: google.protobuf.Timestamp
+	(*UpdateBandwidthRequest)(nil),        // 32: obiente.cloud.vpsgateway.v1.UpdateBandwidthRequest
+	(*UpdateBandwidthResponse)(nil),       // 33: obiente.cloud.vpsgateway.v1.UpdateBandwidthResponse
+	(*timestamppb.Timestamp)(nil),         // 34: google.protobuf.Timestamp
 }
 var file_obiente_cloud_vpsgateway_v1_gateway_service_proto_depIdxs = []int32{
-	32, // 0: obiente.cloud.vpsgateway.v1.AllocateIPResponse.lease_expires:type_name -> google.protobuf.Timestamp
-	32, // 1: obiente.cloud.vpsgateway.v1.IPAllocation.allocated_at:type_name -> google.protobuf.Timestamp
-	32, // 2: obiente.cloud.vpsgateway.v1.IPAllocation.lease_expires:type_name -> google.protobuf.Timestamp
+	34, // 0: obiente.cloud.vpsgateway.v1.AllocateIPResponse.lease_expires:type_name -> google.protobuf.Timestamp
+	34, // 1: obiente.cloud.vpsgateway.v1.IPAllocation.allocated_at:type_name -> google.protobuf.Timestamp
+	34, // 2: obiente.cloud.vpsgateway.v1.IPAllocation.lease_expires:type_name -> google.protobuf.Timestamp
 	13, // 3: obiente.cloud.vpsgateway.v1.ListIPsResponse.allocations:type_name -> obiente.cloud.vpsgateway.v1.IPAllocation
 	20, // 4: obiente.cloud.vpsgateway.v1.GatewayMessage.registration:type_name -> obiente.cloud.vpsgateway.v1.GatewayRegistration
 	21, // 5: obiente.cloud.vpsgateway.v1.GatewayMessage.request:type_name -> obiente.cloud.vpsgateway.v1.GatewayRequest
 	22, // 6: obiente.cloud.vpsgateway.v1.GatewayMessage.response:type_name -> obiente.cloud.vpsgateway.v1.GatewayResponse
-	32, // 7: obiente.cloud.vpsgateway.v1.GatewayMessage.heartbeat:type_name -> google.protobuf.Timestamp
+	34, // 7: obiente.cloud.vpsgateway.v1.GatewayMessage.heartbeat:type_name -> google.protobuf.Timestamp
 	30, // 8: obiente.cloud.vpsgateway.v1.GatewayMessage.sync_allocations:type_name -> obiente.cloud.vpsgateway.v1.SyncAllocationsRequest
 	31, // 9: obiente.cloud.vpsgateway.v1.GatewayMessage.sync_result:type_name -> obiente.cloud.vpsgateway.v1.SyncAllocationsResponse
-	32, // 10: obiente.cloud.vpsgateway.v1.LeaseRecord.expires_at:type_name -> google.protobuf.Timestamp
-	32, // 11: obiente.cloud.vpsgateway.v1.OrgLeaseRecord.expires_at:type_name -> google.protobuf.Timestamp
+	34, // 10: obiente.cloud.vpsgateway.v1.LeaseRecord.expires_at:type_name -> google.protobuf.Timestamp
+	34, // 11: obiente.cloud.vpsgateway.v1.OrgLeaseRecord.expires_at:type_name -> google.protobuf.Timestamp
 	23, // 12: obiente.cloud.vpsgateway.v1.GetLeasesResponse.leases:type_name -> obiente.cloud.vpsgateway.v1.LeaseRecord
 	24, // 13: obiente.cloud.vpsgateway.v1.GetOrgLeasesResponse.leases:type_name -> obiente.cloud.vpsgateway.v1.OrgLeaseRecord
 	29, // 14: obiente.cloud.vpsgateway.v1.SyncAllocationsRequest.allocations:type_name -> obiente.cloud.vpsgateway.v1.DesiredAllocation
@@ -2554,21 +2683,23 @@ var file_obiente_cloud_vpsgateway_v1_gateway_service_proto_depIdxs = []int32{
 	30, // 26: obiente.cloud.vpsgateway.v1.VPSGatewayService.SyncAllocations:input_type -> obiente.cloud.vpsgateway.v1.SyncAllocationsRequest
 	0,  // 27: obiente.cloud.vpsgateway.v1.VPSGatewayService.AddStaticDHCPLease:input_type -> obiente.cloud.vpsgateway.v1.AddStaticDHCPLeaseRequest
 	2,  // 28: obiente.cloud.vpsgateway.v1.VPSGatewayService.RemoveStaticDHCPLease:input_type -> obiente.cloud.vpsgateway.v1.RemoveStaticDHCPLeaseRequest
-	19, // 29: obiente.cloud.vpsgateway.v1.VPSGatewayService.RegisterGateway:output_type -> obiente.cloud.vpsgateway.v1.GatewayMessage
-	5,  // 30: obiente.cloud.vpsgateway.v1.VPSGatewayService.AllocateIP:output_type -> obiente.cloud.vpsgateway.v1.AllocateIPResponse
-	7,  // 31: obiente.cloud.vpsgateway.v1.VPSGatewayService.AllocatePublicIP:output_type -> obiente.cloud.vpsgateway.v1.AllocatePublicIPResponse
-	9,  // 32: obiente.cloud.vpsgateway.v1.VPSGatewayService.ReleaseIP:output_type -> obiente.cloud.vpsgateway.v1.ReleaseIPResponse
-	11, // 33: obiente.cloud.vpsgateway.v1.VPSGatewayService.ReleasePublicIP:output_type -> obiente.cloud.vpsgateway.v1.ReleasePublicIPResponse
-	14, // 34: obiente.cloud.vpsgateway.v1.VPSGatewayService.ListIPs:output_type -> obiente.cloud.vpsgateway.v1.ListIPsResponse
-	16, // 35: obiente.cloud.vpsgateway.v1.VPSGatewayService.ProxySSH:output_type -> obiente.cloud.vpsgateway.v1.ProxySSHResponse
-	18, // 36: obiente.cloud.vpsgateway.v1.VPSGatewayService.GetGatewayInfo:output_type -> obiente.cloud.vpsgateway.v1.GetGatewayInfoResponse
-	26, // 37: obiente.cloud.vpsgateway.v1.VPSGatewayService.GetLeases:output_type -> obiente.cloud.vpsgateway.v1.GetLeasesResponse
-	28, // 38: obiente.cloud.vpsgateway.v1.VPSGatewayService.GetOrgLeases:output_type -> obiente.cloud.vpsgateway.v1.GetOrgLeasesResponse
-	31, // 39: obiente.cloud.vpsgateway.v1.VPSGatewayService.SyncAllocations:output_type -> obiente.cloud.vpsgateway.v1.SyncAllocationsResponse
-	1,  // 40: obiente.cloud.vpsgateway.v1.VPSGatewayService.AddStaticDHCPLease:output_type -> obiente.cloud.vpsgateway.v1.AddStaticDHCPLeaseResponse
-	3,  // 41: obiente.cloud.vpsgateway.v1.VPSGatewayService.RemoveStaticDHCPLease:output_type -> obiente.cloud.vpsgateway.v1.RemoveStaticDHCPLeaseResponse
-	29, // [29:42] is the sub-list for method output_type
-	16, // [16:29] is the sub-list for method input_type
+	32, // 29: obiente.cloud.vpsgateway.v1.VPSGatewayService.UpdateBandwidth:input_type -> obiente.cloud.vpsgateway.v1.UpdateBandwidthRequest
+	19, // 30: obiente.cloud.vpsgateway.v1.VPSGatewayService.RegisterGateway:output_type -> obiente.cloud.vpsgateway.v1.GatewayMessage
+	5,  // 31: obiente.cloud.vpsgateway.v1.VPSGatewayService.AllocateIP:output_type -> obiente.cloud.vpsgateway.v1.AllocateIPResponse
+	7,  // 32: obiente.cloud.vpsgateway.v1.VPSGatewayService.AllocatePublicIP:output_type -> obiente.cloud.vpsgateway.v1.AllocatePublicIPResponse
+	9,  // 33: obiente.cloud.vpsgateway.v1.VPSGatewayService.ReleaseIP:output_type -> obiente.cloud.vpsgateway.v1.ReleaseIPResponse
+	11, // 34: obiente.cloud.vpsgateway.v1.VPSGatewayService.ReleasePublicIP:output_type -> obiente.cloud.vpsgateway.v1.ReleasePublicIPResponse
+	14, // 35: obiente.cloud.vpsgateway.v1.VPSGatewayService.ListIPs:output_type -> obiente.cloud.vpsgateway.v1.ListIPsResponse
+	16, // 36: obiente.cloud.vpsgateway.v1.VPSGatewayService.ProxySSH:output_type -> obiente.cloud.vpsgateway.v1.ProxySSHResponse
+	18, // 37: obiente.cloud.vpsgateway.v1.VPSGatewayService.GetGatewayInfo:output_type -> obiente.cloud.vpsgateway.v1.GetGatewayInfoResponse
+	26, // 38: obiente.cloud.vpsgateway.v1.VPSGatewayService.GetLeases:output_type -> obiente.cloud.vpsgateway.v1.GetLeasesResponse
+	28, // 39: obiente.cloud.vpsgateway.v1.VPSGatewayService.GetOrgLeases:output_type -> obiente.cloud.vpsgateway.v1.GetOrgLeasesResponse
+	31, // 40: obiente.cloud.vpsgateway.v1.VPSGatewayService.SyncAllocations:output_type -> obiente.cloud.vpsgateway.v1.SyncAllocationsResponse
+	1,  // 41: obiente.cloud.vpsgateway.v1.VPSGatewayService.AddStaticDHCPLease:output_type -> obiente.cloud.vpsgateway.v1.AddStaticDHCPLeaseResponse
+	3,  // 42: obiente.cloud.vpsgateway.v1.VPSGatewayService.RemoveStaticDHCPLease:output_type -> obiente.cloud.vpsgateway.v1.RemoveStaticDHCPLeaseResponse
+	33, // 43: obiente.cloud.vpsgateway.v1.VPSGatewayService.UpdateBandwidth:output_type -> obiente.cloud.vpsgateway.v1.UpdateBandwidthResponse
+	30, // [30:44] is the sub-list for method output_type
+	16, // [16:30] is the sub-list for method input_type
 	16, // [16:16] is the sub-list for extension type_name
 	16, // [16:16] is the sub-list for extension extendee
 	0,  // [0:16] is the sub-list for field type_name
@@ -2585,7 +2716,7 @@ func file_obiente_cloud_vpsgateway_v1_gateway_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_obiente_cloud_vpsgateway_v1_gateway_service_proto_rawDesc), len(file_obiente_cloud_vpsgateway_v1_gateway_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -72,6 +72,9 @@ const (
 	// VPSGatewayServiceRemoveStaticDHCPLeaseProcedure is the fully-qualified name of the
 	// VPSGatewayService's RemoveStaticDHCPLease RPC.
 	VPSGatewayServiceRemoveStaticDHCPLeaseProcedure = "/obiente.cloud.vpsgateway.v1.VPSGatewayService/RemoveStaticDHCPLease"
+	// VPSGatewayServiceUpdateBandwidthProcedure is the fully-qualified name of the VPSGatewayService's
+	// UpdateBandwidth RPC.
+	VPSGatewayServiceUpdateBandwidthProcedure = "/obiente.cloud.vpsgateway.v1.VPSGatewayService/UpdateBandwidth"
 )
 
 // VPSGatewayServiceClient is a client for the obiente.cloud.vpsgateway.v1.VPSGatewayService
@@ -109,6 +112,8 @@ type VPSGatewayServiceClient interface {
 	AddStaticDHCPLease(context.Context, *connect.Request[v1.AddStaticDHCPLeaseRequest]) (*connect.Response[v1.AddStaticDHCPLeaseResponse], error)
 	// Remove a static DHCP lease (MAC -> IP)
 	RemoveStaticDHCPLease(context.Context, *connect.Request[v1.RemoveStaticDHCPLeaseRequest]) (*connect.Response[v1.RemoveStaticDHCPLeaseResponse], error)
+	// UpdateBandwidth re-applies the bandwidth limits of a VPS's lease, e.g. after a resize
+	UpdateBandwidth(context.Context, *connect.Request[v1.UpdateBandwidthRequest]) (*connect.Response[v1.UpdateBandwidthResponse], error)
 }
 
 // NewVPSGatewayServiceClient constructs a client for the
@@ -201,6 +206,12 @@ func NewVPSGatewayServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(vPSGatewayServiceMethods.ByName("RemoveStaticDHCPLease")),
 			connect.WithClientOptions(opts...),
 		),
+		updateBandwidth: connect.NewClient[v1.UpdateBandwidthRequest, v1.UpdateBandwidthResponse](
+			httpClient,
+			baseURL+VPSGatewayServiceUpdateBandwidthProcedure,
+			connect.WithSchema(vPSGatewayServiceMethods.ByName("UpdateBandwidth")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -219,6 +230,7 @@ type vPSGatewayServiceClient struct {
 	syncAllocations       *connect.Client[v1.SyncAllocationsRequest, v1.SyncAllocationsResponse]
 	addStaticDHCPLease    *connect.Client[v1.AddStaticDHCPLeaseRequest, v1.AddStaticDHCPLeaseResponse]
 	removeStaticDHCPLease *connect.Client[v1.RemoveStaticDHCPLeaseRequest, v1.RemoveStaticDHCPLeaseResponse]
+	updateBandwidth       *connect.Client[v1.UpdateBandwidthRequest, v1.UpdateBandwidthResponse]
 }
 
 // RegisterGateway calls obiente.cloud.vpsgateway.v1.VPSGatewayService.RegisterGateway.
@@ -286,6 +298,11 @@ func (c *vPSGatewayServiceClient) RemoveStaticDHCPLease(ctx context.Context, req
 	return c.removeStaticDHCPLease.CallUnary(ctx, req)
 }
 
+// UpdateBandwidth calls obiente.cloud.vpsgateway.v1.VPSGatewayService.UpdateBandwidth.
+func (c *vPSGatewayServiceClient) UpdateBandwidth(ctx context.Context, req *connect.Request[v1.UpdateBandwidthRequest]) (*connect.Response[v1.UpdateBandwidthResponse], error) {
+	return c.updateBandwidth.CallUnary(ctx, req)
+}
+
 // VPSGatewayServiceHandler is an implementation of the
 // obiente.cloud.vpsgateway.v1.VPSGatewayService service.
 type VPSGatewayServiceHandler interface {
@@ -321,6 +338,8 @@ type VPSGatewayServiceHandler interface {
 	AddStaticDHCPLease(context.Context, *connect.Request[v1.AddStaticDHCPLeaseRequest]) (*connect.Response[v1.AddStaticDHCPLeaseResponse], error)
 	// Remove a static DHCP lease (MAC -> IP)
 	RemoveStaticDHCPLease(context.Context, *connect.Request[v1.RemoveStaticDHCPLeaseRequest]) (*connect.Response[v1.RemoveStaticDHCPLeaseResponse], error)
+	// UpdateBandwidth re-applies the bandwidth limits of a VPS's lease, e.g. after a resize
+	UpdateBandwidth(context.Context, *connect.Request[v1.UpdateBandwidthRequest]) (*connect.Response[v1.UpdateBandwidthResponse], error)
 }
 
 // NewVPSGatewayServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -408,6 +427,12 @@ func NewVPSGatewayServiceHandler(svc VPSGatewayServiceHandler, opts ...connect.H
 		connect.WithSchema(vPSGatewayServiceMethods.ByName("RemoveStaticDHCPLease")),
 		connect.WithHandlerOptions(opts...),
 	)
+	vPSGatewayServiceUpdateBandwidthHandler := connect.NewUnaryHandler(
+		VPSGatewayServiceUpdateBandwidthProcedure,
+		svc.UpdateBandwidth,
+		connect.WithSchema(vPSGatewayServiceMethods.ByName("UpdateBandwidth")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/obiente.cloud.vpsgateway.v1.VPSGatewayService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case VPSGatewayServiceRegisterGatewayProcedure:
@@ -436,6 +461,8 @@ func NewVPSGatewayServiceHandler(svc VPSGatewayServiceHandler, opts ...connect.H
 			vPSGatewayServiceAddStaticDHCPLeaseHandler.ServeHTTP(w, r)
 		case VPSGatewayServiceRemoveStaticDHCPLeaseProcedure:
 			vPSGatewayServiceRemoveStaticDHCPLeaseHandler.ServeHTTP(w, r)
+		case VPSGatewayServiceUpdateBandwidthProcedure:
+			vPSGatewayServiceUpdateBandwidthHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -496,3 +523,7 @@ func (UnimplementedVPSGatewayServiceHandler) AddStaticDHCPLease(context.Context,
 func (UnimplementedVPSGatewayServiceHandler) RemoveStaticDHCPLease(context.Context, *connect.Request[v1.RemoveStaticDHCPLeaseRequest]) (*connect.Response[v1.RemoveStaticDHCPLeaseResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vpsgateway.v1.VPSGatewayService.RemoveStaticDHCPLease is not implemented"))
 }
+
+func (UnimplementedVPSGatewayServiceHandler) UpdateBandwidth(context.Context, *connect.Request[v1.UpdateBandwidthRequest]) (*connect.Response[v1.UpdateBandwidthResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("obiente.cloud.vpsgateway.v1.VPSGatewayService.UpdateBandwidth is not implemented"))
+}
@@ -0,0 +1,136 @@
+package superadmin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ─── Platform ban list ───────────────────────────────────────────────────────
+
+func (s *Service) CreateBanEntry(ctx context.Context, req *connect.Request[superadminv1.CreateBanEntryRequest]) (*connect.Response[superadminv1.CreateBanEntryResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.bans.create") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	banType := strings.TrimSpace(req.Msg.GetType())
+	value, err := database.NormalizeBanListValue(banType, req.Msg.GetValue())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	entry := &database.BanListEntry{
+		ID:        uuid.New().String(),
+		Type:      banType,
+		Value:     value,
+		Reason:    nullableString(strings.TrimSpace(req.Msg.GetReason())),
+		CreatedBy: user.Id,
+		CreatedAt: time.Now(),
+	}
+	if req.Msg.ExpiresAt != nil {
+		expiresAt := req.Msg.GetExpiresAt().AsTime()
+		if !expiresAt.After(entry.CreatedAt) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expires_at must be in the future"))
+		}
+		entry.ExpiresAt = &expiresAt
+	}
+
+	if err := database.DB.WithContext(ctx).Create(entry).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create ban entry: %w", err))
+	}
+	if err := database.InvalidateBanListCache(ctx); err != nil {
+		logger.Warn("[Moderation] Failed to invalidate ban list cache: %v", err)
+	}
+
+	logger.Info("[Moderation] Ban list entry %s %s created by %s", entry.Type, entry.Value, user.Id)
+	return connect.NewResponse(&superadminv1.CreateBanEntryResponse{Entry: banEntryToProto(entry)}), nil
+}
+
+func (s *Service) DeleteBanEntry(ctx context.Context, req *connect.Request[superadminv1.DeleteBanEntryRequest]) (*connect.Response[superadminv1.DeleteBanEntryResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.bans.delete") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	entryID := req.Msg.GetId()
+	if entryID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+
+	result := database.DB.WithContext(ctx).Where("id = ?", entryID).Delete(&database.BanListEntry{})
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete ban entry: %w", result.Error))
+	}
+	if result.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("ban entry %s not found", entryID))
+	}
+	if err := database.InvalidateBanListCache(ctx); err != nil {
+		logger.Warn("[Moderation] Failed to invalidate ban list cache: %v", err)
+	}
+
+	logger.Info("[Moderation] Ban list entry %s deleted by %s", entryID, user.Id)
+	return connect.NewResponse(&superadminv1.DeleteBanEntryResponse{Message: "Ban entry deleted"}), nil
+}
+
+func (s *Service) ListBanEntries(ctx context.Context, req *connect.Request[superadminv1.ListBanEntriesRequest]) (*connect.Response[superadminv1.ListBanEntriesResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.bans.read") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	query := database.DB.WithContext(ctx).Model(&database.BanListEntry{})
+	if req.Msg.Type != nil {
+		query = query.Where("type = ?", req.Msg.GetType())
+	}
+	if !req.Msg.GetIncludeExpired() {
+		query = query.Where("expires_at IS NULL OR expires_at > ?", time.Now())
+	}
+
+	var entries []database.BanListEntry
+	if err := query.Order("created_at DESC").Find(&entries).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list ban entries: %w", err))
+	}
+
+	resp := &superadminv1.ListBanEntriesResponse{Entries: make([]*superadminv1.BanEntry, len(entries))}
+	for i := range entries {
+		resp.Entries[i] = banEntryToProto(&entries[i])
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func banEntryToProto(e *database.BanListEntry) *superadminv1.BanEntry {
+	entry := &superadminv1.BanEntry{
+		Id:        e.ID,
+		Type:      e.Type,
+		Value:     e.Value,
+		Reason:    e.Reason,
+		CreatedBy: e.CreatedBy,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+		IsActive:  e.Active(time.Now()),
+	}
+	if e.ExpiresAt != nil {
+		entry.ExpiresAt = timestamppb.New(*e.ExpiresAt)
+	}
+	return entry
+}
@@ -0,0 +1,503 @@
+package superadmin
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/email"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/platform"
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+	vpsorch "github.com/obiente/cloud/apps/vps-service/orchestrator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Capacity risk levels reported per node
+const (
+	CapacityRiskLow      = "LOW"
+	CapacityRiskMedium   = "MEDIUM"
+	CapacityRiskHigh     = "HIGH"
+	CapacityRiskCritical = "CRITICAL"
+)
+
+const (
+	// Growth is the slope of a linear regression over this window of hourly samples
+	capacityGrowthWindow = 7 * 24 * time.Hour
+	// Fewer samples than this (six hours) give too noisy a slope to project from
+	capacityMinSamples = 6
+	// Samples are kept a little longer than the growth window
+	capacitySampleRetention = 30 * 24 * time.Hour
+
+	capacityReportLockKeyPrefix = "superadmin:capacity_report:"
+)
+
+// capacityRiskThresholds maps each risk level to the usage percent and days-to-full at which it
+// starts, most severe first
+var capacityRiskThresholds = []struct {
+	level      string
+	usage      float64
+	daysToFull float64
+}{
+	{CapacityRiskCritical, 90, 7},
+	{CapacityRiskHigh, 80, 30},
+	{CapacityRiskMedium, 70, 90},
+}
+
+var capacityRiskRank = map[string]int{
+	CapacityRiskLow:      0,
+	CapacityRiskMedium:   1,
+	CapacityRiskHigh:     2,
+	CapacityRiskCritical: 3,
+}
+
+// listProxmoxNodes is replaced in tests
+var listProxmoxNodes = func(ctx context.Context) ([]vpsorch.ProxmoxNodeCapacity, error) {
+	config, err := vpsorch.GetProxmoxConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := vpsorch.NewProxmoxClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return client.ListNodesWithCapacity(ctx)
+}
+
+var (
+	capacityMailerOnce sync.Once
+	capacityMailer     email.Sender
+)
+
+func getCapacityMailer() email.Sender {
+	capacityMailerOnce.Do(func() {
+		capacityMailer = email.NewSenderFromEnv()
+	})
+	return capacityMailer
+}
+
+// GetCapacityReport returns the current usage, growth and days-to-full of every Docker and Proxmox node
+func (s *Service) GetCapacityReport(ctx context.Context, _ *connect.Request[superadminv1.GetCapacityReportRequest]) (*connect.Response[superadminv1.GetCapacityReportResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.nodes.read") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	now := time.Now()
+	reports, err := buildCapacityReport(ctx, now)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to build capacity report: %w", err))
+	}
+	return connect.NewResponse(&superadminv1.GetCapacityReportResponse{
+		Nodes:       reports,
+		GeneratedAt: timestamppb.New(now),
+	}), nil
+}
+
+// buildCapacityReport collects the current node usage and projects it with the recorded samples
+func buildCapacityReport(ctx context.Context, now time.Time) ([]*superadminv1.CapacityReport, error) {
+	current, regions, err := collectNodeCapacity(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	history, err := database.ListNodeCapacitySamples(ctx, now.Add(-capacityGrowthWindow))
+	if err != nil {
+		// Still report current usage; growth and days-to-full stay unset
+		logger.Warn("[CapacityReport] Failed to load node capacity samples: %v", err)
+	}
+	return capacityReports(current, regions, history), nil
+}
+
+// collectNodeCapacity reads the current usage of the Docker nodes from node_metadata and of the
+// Proxmox nodes from the Proxmox API. It returns one sample per node and each Docker node's region.
+func collectNodeCapacity(ctx context.Context, now time.Time) ([]database.NodeCapacitySample, map[string]string, error) {
+	var nodes []database.NodeMetadata
+	if err := database.DB.WithContext(ctx).Find(&nodes).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	samples := make([]database.NodeCapacitySample, 0, len(nodes))
+	regions := make(map[string]string, len(nodes))
+	for _, node := range nodes {
+		samples = append(samples, database.NodeCapacitySample{
+			NodeID:          node.ID,
+			Timestamp:       now,
+			NodeName:        node.Hostname,
+			NodeType:        database.NodeTypeDocker,
+			CPUPercent:      clampPercent(node.UsedCPU),
+			MemoryPercent:   usagePercent(node.UsedMemory, node.TotalMemory),
+			DeploymentCount: node.DeploymentCount,
+		})
+		regions[node.ID] = node.Region
+	}
+
+	proxmoxNodes, err := listProxmoxNodes(ctx)
+	if err != nil {
+		// Proxmox is optional; Docker-only installations report their Docker nodes
+		logger.Debug("[CapacityReport] Skipping Proxmox nodes: %v", err)
+		return samples, regions, nil
+	}
+
+	var vpsCounts []struct {
+		NodeID string
+		Count  int
+	}
+	if err := database.DB.WithContext(ctx).Model(&database.VPSInstance{}).
+		Select("node_id, COUNT(*) AS count").
+		Where("deleted_at IS NULL AND node_id IS NOT NULL").
+		Group("node_id").
+		Scan(&vpsCounts).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to count VPS instances per node: %w", err)
+	}
+	vpsPerNode := make(map[string]int, len(vpsCounts))
+	for _, count := range vpsCounts {
+		vpsPerNode[count.NodeID] = count.Count
+	}
+
+	for _, node := range proxmoxNodes {
+		if node.Status != "online" {
+			continue
+		}
+		sample := database.NodeCapacitySample{
+			NodeID:          proxmoxCapacityNodeID(node.Node),
+			Timestamp:       now,
+			NodeName:        node.Node,
+			NodeType:        database.NodeTypeProxmox,
+			CPUPercent:      clampPercent(node.CPU * 100),
+			MemoryPercent:   usagePercent(node.Mem, node.MaxMem),
+			DeploymentCount: vpsPerNode[node.Node],
+		}
+		if node.MaxDisk > 0 {
+			disk := usagePercent(node.Disk, node.MaxDisk)
+			sample.DiskPercent = &disk
+		}
+		samples = append(samples, sample)
+	}
+	return samples, regions, nil
+}
+
+// proxmoxCapacityNodeID keeps Proxmox node names apart from Swarm node IDs
+func proxmoxCapacityNodeID(node string) string {
+	return database.NodeTypeProxmox + ":" + node
+}
+
+func usagePercent(used, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return clampPercent(float64(used) / float64(total) * 100)
+}
+
+func clampPercent(value float64) float64 {
+	return math.Max(0, math.Min(100, value))
+}
+
+// capacityReports builds a report per node from its current sample and its history, highest risk first
+func capacityReports(current []database.NodeCapacitySample, regions map[string]string, history []database.NodeCapacitySample) []*superadminv1.CapacityReport {
+	historyByNode := make(map[string][]database.NodeCapacitySample)
+	for _, sample := range history {
+		historyByNode[sample.NodeID] = append(historyByNode[sample.NodeID], sample)
+	}
+
+	reports := make([]*superadminv1.CapacityReport, 0, len(current))
+	for _, sample := range current {
+		samples := historyByNode[sample.NodeID]
+		report := &superadminv1.CapacityReport{
+			NodeId:          sample.NodeID,
+			NodeName:        sample.NodeName,
+			NodeType:        sample.NodeType,
+			Region:          regions[sample.NodeID],
+			CpuPercent:      sample.CPUPercent,
+			MemoryPercent:   sample.MemoryPercent,
+			DiskPercent:     sample.DiskPercent,
+			DeploymentCount: int32(sample.DeploymentCount),
+			SampleCount:     int32(len(samples)),
+		}
+
+		usage := []float64{sample.CPUPercent, sample.MemoryPercent}
+		if sample.DiskPercent != nil {
+			usage = append(usage, *sample.DiskPercent)
+		}
+		var daysToFull []float64
+		if len(samples) >= capacityMinSamples {
+			cpuGrowth, cpuDays := projectCapacity(samples, sample.CPUPercent, func(s database.NodeCapacitySample) (float64, bool) { return s.CPUPercent, true })
+			memoryGrowth, memoryDays := projectCapacity(samples, sample.MemoryPercent, func(s database.NodeCapacitySample) (float64, bool) { return s.MemoryPercent, true })
+			report.CpuGrowthPerDay, report.CpuDaysToFull = cpuGrowth, cpuDays
+			report.MemoryGrowthPerDay, report.MemoryDaysToFull = memoryGrowth, memoryDays
+			if sample.DiskPercent != nil {
+				diskGrowth, diskDays := projectCapacity(samples, *sample.DiskPercent, func(s database.NodeCapacitySample) (float64, bool) {
+					if s.DiskPercent == nil {
+						return 0, false
+					}
+					return *s.DiskPercent, true
+				})
+				report.DiskGrowthPerDay, report.DiskDaysToFull = &diskGrowth, diskDays
+			}
+			for _, days := range []*float64{report.CpuDaysToFull, report.MemoryDaysToFull, report.DiskDaysToFull} {
+				if days != nil {
+					daysToFull = append(daysToFull, *days)
+				}
+			}
+		}
+		report.RiskLevel = capacityRiskLevel(usage, daysToFull)
+		reports = append(reports, report)
+	}
+
+	sort.SliceStable(reports, func(i, j int) bool {
+		if capacityRiskRank[reports[i].RiskLevel] != capacityRiskRank[reports[j].RiskLevel] {
+			return capacityRiskRank[reports[i].RiskLevel] > capacityRiskRank[reports[j].RiskLevel]
+		}
+		return reports[i].NodeName < reports[j].NodeName
+	})
+	return reports
+}
+
+// projectCapacity fits the samples' usage over time and returns the growth in percentage points
+// per day and, when usage is growing, the days until it reaches 100% from the current usage
+func projectCapacity(samples []database.NodeCapacitySample, current float64, value func(database.NodeCapacitySample) (float64, bool)) (float64, *float64) {
+	xs := make([]float64, 0, len(samples))
+	ys := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		y, ok := value(sample)
+		if !ok {
+			continue
+		}
+		xs = append(xs, sample.Timestamp.Sub(samples[0].Timestamp).Hours()/24)
+		ys = append(ys, y)
+	}
+	if len(xs) < capacityMinSamples {
+		return 0, nil
+	}
+	slope, _, ok := linearRegression(xs, ys)
+	if !ok || slope <= 0 {
+		return slope, nil
+	}
+	days := (100 - current) / slope
+	return slope, &days
+}
+
+// linearRegression fits y = intercept + slope*x by ordinary least squares. ok is false when there
+// are fewer than two points or all x values are equal.
+func linearRegression(xs, ys []float64) (slope, intercept float64, ok bool) {
+	n := len(xs)
+	if n < 2 || n != len(ys) {
+		return 0, 0, false
+	}
+	var meanX, meanY float64
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= float64(n)
+	meanY /= float64(n)
+
+	// Centering first keeps the sums small and avoids cancellation with large x values
+	var covariance, varianceX float64
+	for i := range xs {
+		dx := xs[i] - meanX
+		covariance += dx * (ys[i] - meanY)
+		varianceX += dx * dx
+	}
+	if varianceX == 0 {
+		return 0, 0, false
+	}
+	slope = covariance / varianceX
+	return slope, meanY - slope*meanX, true
+}
+
+// capacityRiskLevel rates a node by its highest usage and its shortest time to full
+func capacityRiskLevel(usage []float64, daysToFull []float64) string {
+	for _, threshold := range capacityRiskThresholds {
+		for _, percent := range usage {
+			if percent >= threshold.usage {
+				return threshold.level
+			}
+		}
+		for _, days := range daysToFull {
+			if days <= threshold.daysToFull {
+				return threshold.level
+			}
+		}
+	}
+	return CapacityRiskLow
+}
+
+// StartNodeCapacitySampler records every node's usage hourly for capacity planning
+func StartNodeCapacitySampler(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		recordNodeCapacitySamples(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			logger.Info("[CapacityReport] Node capacity sampler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func recordNodeCapacitySamples(ctx context.Context, now time.Time) {
+	// Every replica samples; truncating to the hour leaves one sample per node and hour
+	samples, _, err := collectNodeCapacity(ctx, now.UTC().Truncate(time.Hour))
+	if err != nil {
+		logger.Warn("[CapacityReport] Failed to collect node capacity: %v", err)
+		return
+	}
+	if err := database.RecordNodeCapacitySamples(ctx, samples); err != nil {
+		logger.Warn("[CapacityReport] %v", err)
+		return
+	}
+	if _, err := database.CleanOldNodeCapacitySamples(ctx, now.Add(-capacitySampleRetention)); err != nil {
+		logger.Warn("[CapacityReport] %v", err)
+	}
+}
+
+// nextCapacityReport returns the next weekly report time, Mondays at 09:00 UTC
+func nextCapacityReport(now time.Time) time.Time {
+	now = now.UTC()
+	daysUntilMonday := (int(time.Monday) - int(now.Weekday()) + 7) % 7
+	next := time.Date(now.Year(), now.Month(), now.Day()+daysUntilMonday, 9, 0, 0, 0, time.UTC)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 7)
+	}
+	return next
+}
+
+// StartCapacityReportMailer emails the capacity report to the superadmins every week when any
+// node is at HIGH or CRITICAL risk
+func StartCapacityReportMailer(ctx context.Context) {
+	for {
+		timer := time.NewTimer(time.Until(nextCapacityReport(time.Now())))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			logger.Info("[CapacityReport] Capacity report mailer stopped")
+			return
+		case <-timer.C:
+		}
+		sendCapacityReport(ctx, time.Now())
+	}
+}
+
+func sendCapacityReport(ctx context.Context, now time.Time) {
+	mailer := getCapacityMailer()
+	if !mailer.Enabled() {
+		return
+	}
+	recipients := make([]string, 0)
+	for address := range getSuperAdminEmails() {
+		recipients = append(recipients, address)
+	}
+	if len(recipients) == 0 {
+		logger.Warn("[CapacityReport] No superadmin emails configured, skipping capacity report")
+		return
+	}
+	sort.Strings(recipients)
+
+	// Only one replica sends each week's report
+	if database.RedisClient != nil {
+		year, week := now.UTC().ISOWeek()
+		lockKey := fmt.Sprintf("%s%d-%02d", capacityReportLockKeyPrefix, year, week)
+		acquired, err := database.RedisClient.GetClient().SetNX(ctx, lockKey, "1", 8*24*time.Hour).Result()
+		if err == nil && !acquired {
+			return
+		}
+	}
+
+	reports, err := buildCapacityReport(ctx, now)
+	if err != nil {
+		logger.Warn("[CapacityReport] Failed to build capacity report: %v", err)
+		return
+	}
+	msg := capacityReportEmail(reports, recipients)
+	if msg == nil {
+		logger.Info("[CapacityReport] No node at HIGH or CRITICAL risk, skipping capacity report email")
+		return
+	}
+	if err := mailer.Send(ctx, msg); err != nil {
+		logger.Warn("[CapacityReport] Failed to send capacity report: %v", err)
+	}
+}
+
+// capacityReportEmail builds the weekly email listing the nodes at HIGH or CRITICAL risk; it
+// returns nil when there are none
+func capacityReportEmail(reports []*superadminv1.CapacityReport, recipients []string) *email.Message {
+	var bullets []email.Bullet
+	critical := 0
+	for _, report := range reports {
+		if capacityRiskRank[report.GetRiskLevel()] < capacityRiskRank[CapacityRiskHigh] {
+			continue
+		}
+		if report.GetRiskLevel() == CapacityRiskCritical {
+			critical++
+		}
+		bullets = append(bullets, email.Bullet{
+			Label:       fmt.Sprintf("%s (%s) - %s", report.GetNodeName(), report.GetNodeType(), report.GetRiskLevel()),
+			Description: describeCapacity(report),
+		})
+	}
+	if len(bullets) == 0 {
+		return nil
+	}
+
+	subject := fmt.Sprintf("Capacity report: %d node(s) at risk", len(bullets))
+	tmpl := email.TemplateData{
+		Subject:     subject,
+		PreviewText: "Some nodes are close to full",
+		Greeting:    "Hi,",
+		Heading:     "Weekly capacity report",
+		IntroLines: []string{
+			"The following nodes are at HIGH or CRITICAL risk of running out of capacity. Days to full are projected from the last 7 days of usage.",
+		},
+		Highlights: []email.Highlight{
+			{Label: "Nodes at risk", Value: fmt.Sprintf("%d", len(bullets))},
+			{Label: "Critical", Value: fmt.Sprintf("%d", critical)},
+			{Label: "Nodes reported", Value: fmt.Sprintf("%d", len(reports))},
+		},
+		Sections: []email.Section{{Title: "Nodes at risk", Bullets: bullets}},
+		CTA: &email.CTA{
+			Label: "Open superadmin dashboard",
+			URL:   platform.DashboardURL() + "/superadmin",
+		},
+		Category: email.CategorySystem,
+	}
+	return &email.Message{
+		To:       recipients,
+		Subject:  subject,
+		Template: &tmpl,
+		Category: email.CategorySystem,
+	}
+}
+
+// describeCapacity summarizes a node's usage and the resources projected to fill up
+func describeCapacity(report *superadminv1.CapacityReport) string {
+	parts := []string{fmt.Sprintf("CPU %.1f%%", report.GetCpuPercent()), fmt.Sprintf("memory %.1f%%", report.GetMemoryPercent())}
+	if report.DiskPercent != nil {
+		parts = append(parts, fmt.Sprintf("disk %.1f%%", report.GetDiskPercent()))
+	}
+	description := strings.Join(parts, ", ")
+	for _, resource := range []struct {
+		name string
+		days *float64
+	}{
+		{"CPU", report.CpuDaysToFull},
+		{"memory", report.MemoryDaysToFull},
+		{"disk", report.DiskDaysToFull},
+	} {
+		if resource.days != nil {
+			description += fmt.Sprintf("; %s full in about %.0f days", resource.name, math.Ceil(*resource.days))
+		}
+	}
+	return description
+}
@@ -0,0 +1,128 @@
+package superadmin
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+)
+
+func TestLinearRegression(t *testing.T) {
+	tests := []struct {
+		name          string
+		xs, ys        []float64
+		wantSlope     float64
+		wantIntercept float64
+		wantOK        bool
+	}{
+		{name: "exact line", xs: []float64{0, 1, 2, 3}, ys: []float64{10, 12, 14, 16}, wantSlope: 2, wantIntercept: 10, wantOK: true},
+		{name: "decreasing", xs: []float64{0, 1, 2}, ys: []float64{50, 45, 40}, wantSlope: -5, wantIntercept: 50, wantOK: true},
+		{name: "noisy", xs: []float64{0, 1, 2, 3}, ys: []float64{1, 3, 2, 4}, wantSlope: 0.8, wantIntercept: 1.3, wantOK: true},
+		{name: "flat", xs: []float64{0, 1, 2}, ys: []float64{30, 30, 30}, wantSlope: 0, wantIntercept: 30, wantOK: true},
+		{name: "large x values", xs: []float64{1e9, 1e9 + 1, 1e9 + 2}, ys: []float64{1, 2, 3}, wantSlope: 1, wantIntercept: 1 - 1e9, wantOK: true},
+		{name: "single point", xs: []float64{1}, ys: []float64{1}},
+		{name: "equal x values", xs: []float64{2, 2, 2}, ys: []float64{1, 2, 3}},
+		{name: "mismatched lengths", xs: []float64{0, 1, 2}, ys: []float64{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slope, intercept, ok := linearRegression(tt.xs, tt.ys)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if math.Abs(slope-tt.wantSlope) > 1e-9 || math.Abs(intercept-tt.wantIntercept) > 1e-6 {
+				t.Fatalf("got slope %v intercept %v, want %v and %v", slope, intercept, tt.wantSlope, tt.wantIntercept)
+			}
+		})
+	}
+}
+
+func TestCapacityReports(t *testing.T) {
+	start := time.Date(2026, 4, 6, 0, 0, 0, 0, time.UTC)
+	disk := 50.0
+	var history []database.NodeCapacitySample
+	for hour := 0; hour < 48; hour++ {
+		// Memory of node-a grows 2 points a day; node-b stays flat
+		history = append(history,
+			database.NodeCapacitySample{NodeID: "node-a", Timestamp: start.Add(time.Duration(hour) * time.Hour), CPUPercent: 20, MemoryPercent: 40 + float64(hour)/12},
+			database.NodeCapacitySample{NodeID: "node-b", Timestamp: start.Add(time.Duration(hour) * time.Hour), CPUPercent: 10, MemoryPercent: 30, DiskPercent: &disk},
+		)
+	}
+	current := []database.NodeCapacitySample{
+		{NodeID: "node-b", NodeName: "b", NodeType: database.NodeTypeProxmox, CPUPercent: 10, MemoryPercent: 30, DiskPercent: &disk},
+		{NodeID: "node-a", NodeName: "a", NodeType: database.NodeTypeDocker, CPUPercent: 20, MemoryPercent: 44},
+		{NodeID: "node-c", NodeName: "c", NodeType: database.NodeTypeDocker, CPUPercent: 95, MemoryPercent: 10},
+	}
+
+	reports := capacityReports(current, map[string]string{"node-a": "eu-west-1"}, history)
+	if len(reports) != 3 {
+		t.Fatalf("expected 3 reports, got %d", len(reports))
+	}
+	wantOrder := []string{"node-c", "node-a", "node-b"}
+	for i, nodeID := range wantOrder {
+		if reports[i].GetNodeId() != nodeID {
+			t.Fatalf("expected %s at position %d, got %s", nodeID, i, reports[i].GetNodeId())
+		}
+	}
+
+	critical := reports[0]
+	if critical.GetRiskLevel() != CapacityRiskCritical || critical.SampleCount != 0 || critical.CpuDaysToFull != nil {
+		t.Fatalf("node without history at 95%% CPU should be CRITICAL with no projection: %+v", critical)
+	}
+
+	growing := reports[1]
+	if math.Abs(growing.GetMemoryGrowthPerDay()-2) > 1e-6 || growing.MemoryDaysToFull == nil || math.Abs(growing.GetMemoryDaysToFull()-28) > 1e-6 {
+		t.Fatalf("expected memory to grow 2 points a day and fill in 28 days: %+v", growing)
+	}
+	if growing.CpuDaysToFull != nil || growing.DiskPercent != nil || growing.DiskGrowthPerDay != nil {
+		t.Fatalf("flat CPU and missing disk should have no projection: %+v", growing)
+	}
+	if growing.GetRiskLevel() != CapacityRiskHigh || growing.GetRegion() != "eu-west-1" {
+		t.Fatalf("expected HIGH risk in eu-west-1, got %s in %q", growing.GetRiskLevel(), growing.GetRegion())
+	}
+
+	if flat := reports[2]; flat.GetRiskLevel() != CapacityRiskLow || flat.DiskGrowthPerDay == nil || flat.GetDiskGrowthPerDay() != 0 || flat.DiskDaysToFull != nil {
+		t.Fatalf("flat node should be LOW with zero disk growth: %+v", flat)
+	}
+}
+
+func TestCapacityRiskLevel(t *testing.T) {
+	tests := []struct {
+		usage      []float64
+		daysToFull []float64
+		want       string
+	}{
+		{[]float64{10, 20}, nil, CapacityRiskLow},
+		{[]float64{10, 70}, nil, CapacityRiskMedium},
+		{[]float64{85, 20}, []float64{200}, CapacityRiskHigh},
+		{[]float64{10, 20, 90}, nil, CapacityRiskCritical},
+		{[]float64{10, 20}, []float64{90}, CapacityRiskMedium},
+		{[]float64{10, 20}, []float64{60, 30}, CapacityRiskHigh},
+		{[]float64{75, 20}, []float64{6.5}, CapacityRiskCritical},
+	}
+	for _, tt := range tests {
+		if got := capacityRiskLevel(tt.usage, tt.daysToFull); got != tt.want {
+			t.Errorf("capacityRiskLevel(%v, %v) = %s, want %s", tt.usage, tt.daysToFull, got, tt.want)
+		}
+	}
+}
+
+func TestNextCapacityReport(t *testing.T) {
+	tests := []struct {
+		now  time.Time
+		want time.Time
+	}{
+		{time.Date(2026, 4, 8, 12, 0, 0, 0, time.UTC), time.Date(2026, 4, 13, 9, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 4, 13, 8, 59, 0, 0, time.UTC), time.Date(2026, 4, 13, 9, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 4, 13, 9, 0, 0, 0, time.UTC), time.Date(2026, 4, 20, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := nextCapacityReport(tt.now); !got.Equal(tt.want) {
+			t.Errorf("nextCapacityReport(%v) = %v, want %v", tt.now, got, tt.want)
+		}
+	}
+}
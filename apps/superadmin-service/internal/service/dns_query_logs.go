@@ -0,0 +1,78 @@
+package superadmin
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListDNSQueryLogs lists the queries answered by the DNS service, newest first
+func (s *Service) ListDNSQueryLogs(ctx context.Context, req *connect.Request[superadminv1.ListDNSQueryLogsRequest]) (*connect.Response[superadminv1.ListDNSQueryLogsResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.dns.read") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+	if database.MetricsDB == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("metrics database not available"))
+	}
+
+	limit := int(req.Msg.GetLimit())
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	offset := int(req.Msg.GetOffset())
+	if offset < 0 {
+		offset = 0
+	}
+
+	filter := database.DNSQueryLogFilter{
+		OrganizationID: req.Msg.GetOrganizationId(),
+		Domain:         req.Msg.GetDomain(),
+		Limit:          limit,
+		Offset:         offset,
+	}
+	if req.Msg.Since != nil {
+		filter.Since = req.Msg.GetSince().AsTime()
+	}
+
+	logs, total, err := database.ListDNSQueryLogs(ctx, filter)
+	if err != nil {
+		logger.Error("[SuperAdmin] Failed to list DNS query logs: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoLogs := make([]*superadminv1.DNSQueryLog, 0, len(logs))
+	for _, entry := range logs {
+		protoLog := &superadminv1.DNSQueryLog{
+			Id:            entry.ID,
+			Timestamp:     timestamppb.New(entry.Timestamp),
+			Domain:        entry.Domain,
+			QueryType:     entry.QueryType,
+			ClientIp:      entry.ClientIP,
+			Result:        entry.Result,
+			LatencyMicros: entry.LatencyMicros,
+		}
+		if entry.OrganizationID != "" {
+			organizationID := entry.OrganizationID
+			protoLog.OrganizationId = &organizationID
+		}
+		protoLogs = append(protoLogs, protoLog)
+	}
+
+	return connect.NewResponse(&superadminv1.ListDNSQueryLogsResponse{
+		Logs:       protoLogs,
+		TotalCount: total,
+	}), nil
+}
@@ -0,0 +1,138 @@
+package superadmin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/middleware"
+	"github.com/obiente/cloud/apps/shared/pkg/notifications"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetMaintenanceMode handles the SetMaintenanceMode RPC
+func (s *Service) SetMaintenanceMode(ctx context.Context, req *connect.Request[superadminv1.SetMaintenanceModeRequest]) (*connect.Response[superadminv1.SetMaintenanceModeResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.maintenance.update") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+	if req.Msg.GetEnabled() && req.Msg.GetEstimatedDurationMinutes() <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("estimated_duration_minutes must be positive"))
+	}
+
+	if err := SetMaintenanceMode(ctx, req.Msg.GetEnabled(), req.Msg.GetMessage(), req.Msg.GetEstimatedDurationMinutes()); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &superadminv1.SetMaintenanceModeResponse{Enabled: req.Msg.GetEnabled()}
+	state, err := middleware.GetMaintenanceState(ctx)
+	if err == nil && state != nil {
+		resp.Message = state.Message
+		resp.EstimatedEnd = timestamppb.New(state.Until)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// SetMaintenanceMode puts the platform in or out of maintenance. While enabled, the maintenance
+// interceptor rejects mutating RPCs with the given message. All active users are notified when
+// maintenance starts and when it ends.
+func SetMaintenanceMode(ctx context.Context, enabled bool, message string, estimatedDurationMinutes int32) error {
+	if database.RedisClient == nil {
+		return fmt.Errorf("maintenance mode requires Redis")
+	}
+
+	previous, err := middleware.GetMaintenanceState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !enabled {
+		if previous == nil {
+			return nil
+		}
+		if err := database.RedisClient.Delete(ctx, middleware.MaintenanceModeKey); err != nil {
+			return fmt.Errorf("clear maintenance state: %w", err)
+		}
+		logger.Info("[SuperAdmin] Platform maintenance ended")
+		go notifyAllActiveUsers(
+			notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_LOW,
+			"Maintenance complete",
+			"Platform maintenance has finished. All features are available again.",
+		)
+		return nil
+	}
+
+	now := time.Now().UTC()
+	state := middleware.MaintenanceState{
+		Until:     now.Add(time.Duration(estimatedDurationMinutes) * time.Minute),
+		Message:   message,
+		StartedAt: now,
+	}
+	if previous != nil {
+		// Extending maintenance keeps its original start
+		state.StartedAt = previous.StartedAt
+	}
+	if user, err := auth.GetUserFromContext(ctx); err == nil {
+		state.StartedBy = user.Id
+	}
+	// The key has no expiry: maintenance lasts until it is disabled, even if it overruns the estimate
+	if err := database.RedisClient.Set(ctx, middleware.MaintenanceModeKey, state, 0); err != nil {
+		return fmt.Errorf("store maintenance state: %w", err)
+	}
+	logger.Info("[SuperAdmin] Platform maintenance enabled until %s", state.Until.Format(time.RFC3339))
+
+	if previous == nil {
+		text := fmt.Sprintf("The platform is in maintenance until about %s. Creating, changing, starting and stopping resources is unavailable until then.", state.Until.Format("January 2, 2006 15:04 MST"))
+		if message != "" {
+			text += " " + message
+		}
+		go notifyAllActiveUsers(
+			notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+			"Scheduled maintenance in progress",
+			text,
+		)
+	}
+	return nil
+}
+
+// notifyAllActiveUsers sends a system notification to every user with an active organization membership
+func notifyAllActiveUsers(severity notificationsv1.NotificationSeverity, title, message string) {
+	ctx := context.Background()
+
+	var userIDs []string
+	if err := database.DB.WithContext(ctx).
+		Model(&database.OrganizationMember{}).
+		Where("status = ? AND user_id <> ''", "active").
+		Distinct("user_id").
+		Pluck("user_id", &userIDs).Error; err != nil {
+		logger.Warn("[SuperAdmin] Failed to list active users for maintenance notification: %v", err)
+		return
+	}
+
+	failed := 0
+	for _, userID := range userIDs {
+		if err := notifications.CreateNotificationForUser(
+			ctx,
+			userID,
+			nil,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM,
+			severity,
+			title,
+			message,
+			nil, nil,
+			map[string]string{"source": "maintenance"},
+		); err != nil {
+			failed++
+		}
+	}
+	logger.Info("[SuperAdmin] Sent maintenance notification %q to %d users (%d failed)", title, len(userIDs)-failed, failed)
+}
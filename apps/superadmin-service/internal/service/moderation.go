@@ -34,35 +34,22 @@ func (s *Service) SuspendOrganization(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
 	}
 
-	var org database.Organization
-	if err := database.DB.First(&org, "id = ?", orgID).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load organization: %w", err))
-	}
-
-	now := time.Now()
 	reason := req.Msg.GetReason()
-	var expires *time.Time
+	var duration time.Duration
 	if req.Msg.ExpiresAt != nil {
-		t := req.Msg.ExpiresAt.AsTime()
-		expires = &t
+		duration = time.Until(req.Msg.ExpiresAt.AsTime())
+		if duration <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expires_at must be in the future"))
+		}
 	}
 
-	updates := map[string]interface{}{
-		"status":             "suspended",
-		"suspended_at":       now,
-		"suspended_by":       user.Id,
-		"suspension_reason":  nullableString(reason),
-		"suspension_expires": expires,
-	}
-	if err := database.DB.Model(&org).Updates(updates).Error; err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to suspend organization: %w", err))
+	if err := suspendOrganization(ctx, orgID, reason, user.Id, duration); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	logger.Info("[Moderation] Organization %s suspended by %s (reason: %s)", orgID, user.Id, reason)
-
 	return connect.NewResponse(&superadminv1.SuspendOrganizationResponse{
 		Message: "Organization suspended successfully",
 		Status:  "suspended",
@@ -83,14 +70,7 @@ func (s *Service) UnsuspendOrganization(ctx context.Context, req *connect.Reques
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
 	}
 
-	updates := map[string]interface{}{
-		"status":             "active",
-		"suspended_at":       nil,
-		"suspended_by":       nil,
-		"suspension_reason":  nil,
-		"suspension_expires": nil,
-	}
-	if err := database.DB.Model(&database.Organization{}).Where("id = ?", orgID).Updates(updates).Error; err != nil {
+	if err := liftOrganizationSuspension(orgID, user.Id); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to unsuspend organization: %w", err))
 	}
 
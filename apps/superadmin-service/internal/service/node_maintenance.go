@@ -0,0 +1,130 @@
+package superadmin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+)
+
+// orchestratorNodeResponse is the body returned by the orchestrator's internal node endpoints
+type orchestratorNodeResponse struct {
+	NodeID  string `json:"node_id"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Error   string `json:"error"`
+}
+
+var orchestratorHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// DrainClusterNode handles the DrainClusterNode RPC
+func (s *Service) DrainClusterNode(ctx context.Context, req *connect.Request[superadminv1.DrainClusterNodeRequest]) (*connect.Response[superadminv1.DrainClusterNodeResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.nodes.update") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+	if req.Msg.GetNodeId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("node_id is required"))
+	}
+
+	result, err := callOrchestratorNodeEndpoint(ctx, "drain", req.Msg.GetNodeId(), user.Id)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("[SuperAdmin] User %s started draining node %s", user.Id, req.Msg.GetNodeId())
+
+	return connect.NewResponse(&superadminv1.DrainClusterNodeResponse{
+		NodeId:  result.NodeID,
+		Status:  result.Status,
+		Message: result.Message,
+	}), nil
+}
+
+// EnableClusterNode handles the EnableClusterNode RPC
+func (s *Service) EnableClusterNode(ctx context.Context, req *connect.Request[superadminv1.EnableClusterNodeRequest]) (*connect.Response[superadminv1.EnableClusterNodeResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.nodes.update") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+	if req.Msg.GetNodeId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("node_id is required"))
+	}
+
+	result, err := callOrchestratorNodeEndpoint(ctx, "enable", req.Msg.GetNodeId(), user.Id)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("[SuperAdmin] User %s re-enabled node %s", user.Id, req.Msg.GetNodeId())
+
+	return connect.NewResponse(&superadminv1.EnableClusterNodeResponse{
+		NodeId:  result.NodeID,
+		Status:  result.Status,
+		Message: result.Message,
+	}), nil
+}
+
+// callOrchestratorNodeEndpoint posts to the orchestrator's internal /internal/nodes/<action>
+// endpoint and maps its HTTP status to a connect error
+func callOrchestratorNodeEndpoint(ctx context.Context, action, nodeID, requestedBy string) (*orchestratorNodeResponse, error) {
+	secret := os.Getenv("INTERNAL_SERVICE_SECRET")
+	if secret == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("INTERNAL_SERVICE_SECRET is not configured"))
+	}
+	baseURL := os.Getenv("ORCHESTRATOR_SERVICE_URL")
+	if baseURL == "" {
+		baseURL = "http://orchestrator-service:3007"
+	}
+
+	body, err := json.Marshal(map[string]string{"node_id": nodeID, "requested_by": requestedBy})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(baseURL, "/")+"/internal/nodes/"+action, bytes.NewReader(body))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-internal-service-secret", secret)
+
+	resp, err := orchestratorHTTPClient.Do(httpReq)
+	if err != nil {
+		logger.Error("[SuperAdmin] Failed to reach orchestrator to %s node %s: %v", action, nodeID, err)
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("orchestrator unavailable"))
+	}
+	defer resp.Body.Close()
+
+	var result orchestratorNodeResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&result); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid orchestrator response: %w", err))
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted:
+		return &result, nil
+	case http.StatusBadRequest:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s", result.Error))
+	case http.StatusNotFound:
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("%s", result.Error))
+	case http.StatusConflict:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s", result.Error))
+	default:
+		logger.Error("[SuperAdmin] Orchestrator returned %d for %s of node %s: %s", resp.StatusCode, action, nodeID, result.Error)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to %s node: %s", action, result.Error))
+	}
+}
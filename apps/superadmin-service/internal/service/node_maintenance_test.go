@@ -0,0 +1,54 @@
+package superadmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+func TestCallOrchestratorNodeEndpoint(t *testing.T) {
+	var gotPath, gotSecret string
+	var gotBody map[string]string
+	status := http.StatusAccepted
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotSecret = r.Header.Get("x-internal-service-secret")
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.WriteHeader(status)
+		if status == http.StatusAccepted {
+			_, _ = w.Write([]byte(`{"node_id":"node-1","status":"draining","message":"Draining node worker-1"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":"node node-1 is already draining"}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("ORCHESTRATOR_SERVICE_URL", server.URL)
+	t.Setenv("INTERNAL_SERVICE_SECRET", "s3cret")
+
+	result, err := callOrchestratorNodeEndpoint(context.Background(), "drain", "node-1", "user-1")
+	if err != nil {
+		t.Fatalf("drain: %v", err)
+	}
+	if gotPath != "/internal/nodes/drain" || gotSecret != "s3cret" {
+		t.Fatalf("unexpected request path %q or secret %q", gotPath, gotSecret)
+	}
+	if gotBody["node_id"] != "node-1" || gotBody["requested_by"] != "user-1" {
+		t.Fatalf("unexpected request body %v", gotBody)
+	}
+	if result.Status != "draining" {
+		t.Fatalf("status = %q, want draining", result.Status)
+	}
+
+	status = http.StatusConflict
+	_, err = callOrchestratorNodeEndpoint(context.Background(), "drain", "node-1", "user-1")
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeFailedPrecondition {
+		t.Fatalf("conflict mapped to %v, want FailedPrecondition", err)
+	}
+}
@@ -0,0 +1,70 @@
+package superadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// AllocateStaticIP assigns a subnet to an organization for static VPS IPs (superadmin only)
+func (s *Service) AllocateStaticIP(ctx context.Context, req *connect.Request[superadminv1.AllocateStaticIPRequest]) (*connect.Response[superadminv1.AllocateStaticIPResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.ip_allocations.create") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	var org database.Organization
+	if err := database.DB.WithContext(ctx).Select("id").First(&org, "id = ?", orgID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization %s not found", orgID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get organization: %w", err))
+	}
+
+	allocation := &database.OrgIPAllocation{
+		ID:             uuid.New().String(),
+		OrganizationID: orgID,
+		CIDR:           req.Msg.GetCidr(),
+		Gateway:        req.Msg.GetGateway(),
+		DNSServers:     strings.Join(req.Msg.GetDnsServers(), ","),
+		CreatedBy:      user.Id,
+	}
+	if err := database.AllocateOrgIPRange(ctx, database.DB, allocation); err != nil {
+		if errors.Is(err, database.ErrIPRangeOverlaps) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	logger.Info("[SuperAdmin] Allocated IP range %s to organization %s (by %s)", allocation.CIDR, orgID, user.Id)
+	return connect.NewResponse(&superadminv1.AllocateStaticIPResponse{
+		Allocation: &superadminv1.OrgIPAllocation{
+			Id:             allocation.ID,
+			OrganizationId: allocation.OrganizationID,
+			Cidr:           allocation.CIDR,
+			Gateway:        allocation.Gateway,
+			DnsServers:     allocation.DNSServerList(),
+			CreatedBy:      allocation.CreatedBy,
+			CreatedAt:      timestamppb.New(allocation.CreatedAt),
+		},
+	}), nil
+}
@@ -0,0 +1,357 @@
+package superadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/email"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/notifications"
+	"github.com/obiente/cloud/apps/shared/pkg/platform"
+	"github.com/obiente/cloud/apps/shared/pkg/services/common"
+
+	deploymentsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/deployments/v1"
+	gameserversv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/gameservers/v1"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+
+	"connectrpc.com/connect"
+	"github.com/moby/moby/client"
+	"gorm.io/gorm"
+)
+
+// suspendedBySystem marks suspensions imposed by abuse detection rather than an operator
+const suspendedBySystem = "system"
+
+var (
+	suspensionMailerOnce sync.Once
+	suspensionMailer     email.Sender
+)
+
+func getSuspensionMailer() email.Sender {
+	suspensionMailerOnce.Do(func() {
+		suspensionMailer = email.NewSenderFromEnv()
+	})
+	return suspensionMailer
+}
+
+// SuspendOrganization suspends an organization on behalf of the platform: it stops the
+// organization's running deployments and game servers, records the suspension in
+// org_suspensions and tells the organization by email and in-platform notification.
+// A zero duration suspends until the suspension is lifted.
+func SuspendOrganization(ctx context.Context, orgID, reason string, duration time.Duration) error {
+	return suspendOrganization(ctx, orgID, reason, suspendedBySystem, duration)
+}
+
+func suspendOrganization(ctx context.Context, orgID, reason, suspendedBy string, duration time.Duration) error {
+	var org database.Organization
+	if err := database.DB.First(&org, "id = ?", orgID).Error; err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var expires *time.Time
+	if duration > 0 {
+		t := now.Add(duration)
+		expires = &t
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		updates := map[string]interface{}{
+			"status":             database.OrganizationStatusSuspended,
+			"suspended_at":       now,
+			"suspended_by":       suspendedBy,
+			"suspension_reason":  nullableString(reason),
+			"suspension_expires": expires,
+		}
+		if err := tx.Model(&org).Updates(updates).Error; err != nil {
+			return fmt.Errorf("failed to suspend organization: %w", err)
+		}
+
+		// Suspending an already suspended organization replaces the open record
+		var suspension database.OrgSuspension
+		err := tx.Where("organization_id = ? AND lifted_at IS NULL", orgID).First(&suspension).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to load suspension record: %w", err)
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			suspension = database.OrgSuspension{ID: uuid.New().String(), OrganizationID: orgID}
+		}
+		suspension.Reason = nullableString(reason)
+		suspension.SuspendedBy = suspendedBy
+		suspension.SuspendedAt = now
+		suspension.ExpiresAt = expires
+		if err := tx.Save(&suspension).Error; err != nil {
+			return fmt.Errorf("failed to record suspension: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("[Moderation] Organization %s suspended by %s (reason: %s)", orgID, suspendedBy, reason)
+
+	stopOrganizationWorkloads(ctx, orgID)
+	notifyOrganizationSuspended(ctx, &org, reason, expires)
+	return nil
+}
+
+// liftOrganizationSuspension reactivates a suspended organization and closes its suspension record.
+// Stopped workloads are left stopped for the organization to start again.
+func liftOrganizationSuspension(orgID, liftedBy string) error {
+	now := time.Now()
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&database.Organization{}).
+			Where("id = ? AND status = ?", orgID, database.OrganizationStatusSuspended).
+			Updates(map[string]interface{}{
+				"status":             database.OrganizationStatusActive,
+				"suspended_at":       nil,
+				"suspended_by":       nil,
+				"suspension_reason":  nil,
+				"suspension_expires": nil,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to lift suspension: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Model(&database.OrgSuspension{}).
+			Where("organization_id = ? AND lifted_at IS NULL", orgID).
+			Updates(map[string]interface{}{"lifted_at": now, "lifted_by": liftedBy}).Error; err != nil {
+			return fmt.Errorf("failed to close suspension record: %w", err)
+		}
+		return nil
+	})
+}
+
+// LiftSuspension lifts an organization suspension
+func (s *Service) LiftSuspension(ctx context.Context, req *connect.Request[superadminv1.LiftSuspensionRequest]) (*connect.Response[superadminv1.LiftSuspensionResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.organizations.update") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	if orgID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+
+	if err := liftOrganizationSuspension(orgID, user.Id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("organization %s is not suspended", orgID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	logger.Info("[Moderation] Suspension of organization %s lifted by %s", orgID, user.Id)
+	return connect.NewResponse(&superadminv1.LiftSuspensionResponse{
+		Message: "Suspension lifted",
+		Status:  database.OrganizationStatusActive,
+	}), nil
+}
+
+// AutoSuspendAbusiveOrganizations suspends flagged organizations whose risk score reaches
+// ABUSE_AUTO_SUSPEND_RISK_SCORE. Automatic suspension is off when the variable is unset.
+// Suspensions last ABUSE_AUTO_SUSPEND_DURATION (default 72h).
+func AutoSuspendAbusiveOrganizations(ctx context.Context, orgs []*superadminv1.SuspiciousOrganization) {
+	threshold, err := strconv.ParseInt(strings.TrimSpace(os.Getenv("ABUSE_AUTO_SUSPEND_RISK_SCORE")), 10, 64)
+	if err != nil || threshold <= 0 {
+		return
+	}
+	duration := 72 * time.Hour
+	if raw := strings.TrimSpace(os.Getenv("ABUSE_AUTO_SUSPEND_DURATION")); raw != "" {
+		if d, err := time.ParseDuration(raw); err == nil && d > 0 {
+			duration = d
+		} else {
+			logger.Warn("[AbuseDetection] Invalid ABUSE_AUTO_SUSPEND_DURATION %q, using %s", raw, duration)
+		}
+	}
+
+	for _, flagged := range orgs {
+		if flagged.RiskScore < threshold {
+			continue
+		}
+		// Organizations already suspended or banned keep their current moderation state
+		var status string
+		if err := database.DB.Model(&database.Organization{}).Select("status").
+			Where("id = ?", flagged.OrganizationId).Scan(&status).Error; err != nil {
+			logger.Warn("[AbuseDetection] Failed to load status of organization %s: %v", flagged.OrganizationId, err)
+			continue
+		}
+		if status == database.OrganizationStatusSuspended || status == database.OrganizationStatusBanned {
+			continue
+		}
+
+		reason := fmt.Sprintf("Automatic suspension: %s (risk score %d)", flagged.Reason, flagged.RiskScore)
+		if err := SuspendOrganization(ctx, flagged.OrganizationId, reason, duration); err != nil {
+			logger.Warn("[AbuseDetection] Failed to suspend organization %s: %v", flagged.OrganizationId, err)
+		}
+	}
+}
+
+// stopOrganizationWorkloads stops the organization's running deployment containers and game servers.
+// Failures are logged; the suspension stands either way and the downstream services refuse to restart them.
+func stopOrganizationWorkloads(ctx context.Context, orgID string) {
+	var deploymentIDs []string
+	if err := database.DB.Model(&database.Deployment{}).
+		Where("organization_id = ? AND status IN ?", orgID, []int32{
+			int32(deploymentsv1.DeploymentStatus_RUNNING),
+			int32(deploymentsv1.DeploymentStatus_DEPLOYING),
+			int32(deploymentsv1.DeploymentStatus_BUILDING),
+		}).
+		Pluck("id", &deploymentIDs).Error; err != nil {
+		logger.Warn("[Moderation] Failed to list deployments of organization %s: %v", orgID, err)
+	}
+	if len(deploymentIDs) > 0 {
+		var locations []database.DeploymentLocation
+		if err := database.DB.Where("deployment_id IN ? AND status = ?", deploymentIDs, "running").Find(&locations).Error; err != nil {
+			logger.Warn("[Moderation] Failed to list deployment containers of organization %s: %v", orgID, err)
+		}
+		for _, location := range locations {
+			if err := stopDeploymentContainer(ctx, location.ContainerID); err != nil {
+				logger.Warn("[Moderation] Failed to stop deployment container %s: %v", location.ContainerID, err)
+				continue
+			}
+			database.DB.Model(&database.DeploymentLocation{}).Where("id = ?", location.ID).Update("status", "stopped")
+		}
+		database.DB.Model(&database.Deployment{}).Where("id IN ?", deploymentIDs).
+			Update("status", int32(deploymentsv1.DeploymentStatus_STOPPED))
+	}
+
+	var gameServers []database.GameServer
+	if err := database.DB.Where("organization_id = ? AND status IN ?", orgID, []int32{
+		int32(gameserversv1.GameServerStatus_STARTING),
+		int32(gameserversv1.GameServerStatus_RUNNING),
+		int32(gameserversv1.GameServerStatus_RESTARTING),
+	}).Find(&gameServers).Error; err != nil {
+		logger.Warn("[Moderation] Failed to list game servers of organization %s: %v", orgID, err)
+	}
+	for _, gs := range gameServers {
+		if gs.ContainerID != nil {
+			if err := stopGameServerContainer(ctx, *gs.ContainerID); err != nil {
+				logger.Warn("[Moderation] Failed to stop game server container %s: %v", *gs.ContainerID, err)
+			}
+		}
+		database.DB.Model(&database.GameServer{}).Where("id = ?", gs.ID).
+			Update("status", int32(gameserversv1.GameServerStatus_STOPPED))
+	}
+
+	logger.Info("[Moderation] Stopped %d deployment(s) and %d game server(s) of suspended organization %s", len(deploymentIDs), len(gameServers), orgID)
+}
+
+// stopDeploymentContainer stops a deployment container, refusing containers Obiente does not manage.
+func stopDeploymentContainer(ctx context.Context, containerID string) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return fmt.Errorf("create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	info, err := cli.ContainerInspect(ctx, containerID, client.ContainerInspectOptions{})
+	if err != nil {
+		// Container probably already gone; treat as success
+		return nil
+	}
+	if info.Container.Config.Labels["cloud.obiente.managed"] != "true" {
+		return fmt.Errorf("refusing to stop container %s: not managed by Obiente Cloud", containerID)
+	}
+
+	timeout := 30
+	_, err = cli.ContainerStop(ctx, containerID, client.ContainerStopOptions{Timeout: &timeout})
+	return err
+}
+
+// notifyOrganizationSuspended tells the organization about its suspension: an email to the
+// billing contact and a critical notification for its owners and admins.
+func notifyOrganizationSuspended(ctx context.Context, org *database.Organization, reason string, expires *time.Time) {
+	if reason == "" {
+		reason = "Violation of the terms of service"
+	}
+	until := "until it is lifted by our team"
+	if expires != nil {
+		until = "until " + expires.UTC().Format("January 2, 2006 15:04 MST")
+	}
+
+	if err := notifications.CreateNotificationForOrganization(
+		ctx,
+		org.ID,
+		notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM,
+		notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL,
+		"Organization suspended",
+		fmt.Sprintf("%s has been suspended %s. Running deployments and game servers were stopped. Reason: %s", org.Name, until, reason),
+		nil, nil,
+		map[string]string{"organization_id": org.ID, "reason": reason},
+		[]string{"owner", "admin"},
+	); err != nil {
+		logger.Warn("[Moderation] Failed to notify organization %s of suspension: %v", org.ID, err)
+	}
+
+	mailer := getSuspensionMailer()
+	if !mailer.Enabled() {
+		return
+	}
+	billing, err := common.GetBillingAccount(org.ID)
+	if err != nil {
+		logger.Warn("[Moderation] Failed to load billing account of organization %s: %v", org.ID, err)
+		return
+	}
+	if billing == nil || billing.BillingEmail == nil || strings.TrimSpace(*billing.BillingEmail) == "" {
+		logger.Info("[Moderation] Organization %s has no billing contact, skipping suspension email", org.ID)
+		return
+	}
+
+	subject := fmt.Sprintf("Your organization %s has been suspended", org.Name)
+	tmpl := email.TemplateData{
+		Subject:     subject,
+		PreviewText: "Your organization's resources have been stopped",
+		Greeting:    fmt.Sprintf("Hi %s,", org.Name),
+		Heading:     "Organization suspended",
+		IntroLines: []string{
+			fmt.Sprintf("Your organization '%s' has been suspended %s.", org.Name, until),
+			"Running deployments and game servers were stopped, and the organization's resources cannot be managed while the suspension is in place.",
+		},
+		Highlights: []email.Highlight{
+			{Label: "Reason", Value: reason},
+		},
+		Sections: []email.Section{
+			{
+				Title: "What you can do",
+				Lines: []string{
+					"Reply to this email or contact support if you believe this is a mistake",
+				},
+			},
+		},
+		CTA: &email.CTA{
+			Label: "Open dashboard",
+			URL:   platform.DashboardURL(),
+		},
+		Category: email.CategoryNotification,
+	}
+	msg := &email.Message{
+		To:       []string{strings.TrimSpace(*billing.BillingEmail)},
+		Subject:  subject,
+		Template: &tmpl,
+		Category: email.CategoryNotification,
+		Metadata: map[string]string{
+			"organization_id": org.ID,
+		},
+	}
+	if err := mailer.Send(ctx, msg); err != nil {
+		logger.Warn("[Moderation] Failed to send suspension email for organization %s: %v", org.ID, err)
+	}
+}
@@ -0,0 +1,447 @@
+package superadmin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	commonv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/common/v1"
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	platformUsageCacheKey = "superadmin:platform_usage_summary"
+	platformUsageCacheTTL = 5 * time.Minute
+
+	// Hourly aggregates arrive late, so a resource counts as active if it reported usage
+	// at any point in this window rather than in the current hour
+	platformUsageActiveWindow = 24 * time.Hour
+
+	platformUsageTopOrganizations = 10
+	platformUsageUnknownRegion    = "unknown"
+)
+
+// platformUsageSources lists the metrics hypertables holding hourly usage per resource
+var platformUsageSources = []struct {
+	resourceType string
+	table        string
+	idColumn     string
+}{
+	{"deployment", "deployment_usage_hourly", "deployment_id"},
+	{"gameserver", "game_server_usage_hourly", "game_server_id"},
+	{"vps", "vps_usage_hourly", "vps_instance_id"},
+}
+
+type platformUsageRow struct {
+	ResourceType   string    `gorm:"-"`
+	ResourceID     string    `gorm:"column:resource_id"`
+	OrganizationID string    `gorm:"column:organization_id"`
+	Hours          int64     `gorm:"column:hours"`
+	LastHour       time.Time `gorm:"column:last_hour"`
+}
+
+type organizationUsageAggregate struct {
+	OrganizationID   string `json:"organization_id"`
+	OrganizationName string `json:"organization_name"`
+	DeploymentCount  int64  `json:"deployment_count"`
+	GameServerCount  int64  `json:"game_server_count"`
+	VPSCount         int64  `json:"vps_count"`
+	ResourceHours    int64  `json:"resource_hours"`
+}
+
+func (o organizationUsageAggregate) totalResources() int64 {
+	return o.DeploymentCount + o.GameServerCount + o.VPSCount
+}
+
+type regionUsageAggregate struct {
+	Region          string `json:"region"`
+	DeploymentCount int64  `json:"deployment_count"`
+	GameServerCount int64  `json:"game_server_count"`
+	VPSCount        int64  `json:"vps_count"`
+}
+
+// platformUsageSnapshot is the cached result shared by GetPlatformUsageSummary and
+// GetAllOrganizationsUsage
+type platformUsageSnapshot struct {
+	ActiveDeployments    int64                        `json:"active_deployments"`
+	ActiveGameServers    int64                        `json:"active_game_servers"`
+	ActiveVPS            int64                        `json:"active_vps"`
+	CreditsConsumedCents int64                        `json:"credits_consumed_cents"`
+	Organizations        []organizationUsageAggregate `json:"organizations"`
+	Regions              []regionUsageAggregate       `json:"regions"`
+	GeneratedAt          time.Time                    `json:"generated_at"`
+}
+
+// GetPlatformUsageSummary returns resource consumption across all organizations
+func (s *Service) GetPlatformUsageSummary(ctx context.Context, _ *connect.Request[superadminv1.GetPlatformUsageSummaryRequest]) (*connect.Response[superadminv1.GetPlatformUsageSummaryResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.overview.read") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	snapshot, err := getPlatformUsageSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	top := snapshot.Organizations
+	if len(top) > platformUsageTopOrganizations {
+		top = top[:platformUsageTopOrganizations]
+	}
+	regions := make([]*superadminv1.RegionResourceUsage, 0, len(snapshot.Regions))
+	for _, region := range snapshot.Regions {
+		regions = append(regions, &superadminv1.RegionResourceUsage{
+			Region:          region.Region,
+			DeploymentCount: region.DeploymentCount,
+			GameServerCount: region.GameServerCount,
+			VpsCount:        region.VPSCount,
+		})
+	}
+
+	return connect.NewResponse(&superadminv1.GetPlatformUsageSummaryResponse{
+		ActiveDeployments:    snapshot.ActiveDeployments,
+		ActiveGameServers:    snapshot.ActiveGameServers,
+		ActiveVps:            snapshot.ActiveVPS,
+		CreditsConsumedCents: snapshot.CreditsConsumedCents,
+		TopOrganizations:     organizationUsageToProto(top),
+		Regions:              regions,
+		GeneratedAt:          timestamppb.New(snapshot.GeneratedAt),
+	}), nil
+}
+
+// GetAllOrganizationsUsage returns per-organization resource counts, highest usage first
+func (s *Service) GetAllOrganizationsUsage(ctx context.Context, req *connect.Request[superadminv1.GetAllOrganizationsUsageRequest]) (*connect.Response[superadminv1.GetAllOrganizationsUsageResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.overview.read") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	page := int(req.Msg.GetPage())
+	if page < 1 {
+		page = 1
+	}
+	perPage := int(req.Msg.GetPerPage())
+	if perPage < 1 {
+		perPage = 25
+	}
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	snapshot, err := getPlatformUsageSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	total := len(snapshot.Organizations)
+	totalPages := 0
+	if total > 0 {
+		totalPages = (total + perPage - 1) / perPage
+	}
+	start := (page - 1) * perPage
+	if start > total {
+		start = total
+	}
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+
+	return connect.NewResponse(&superadminv1.GetAllOrganizationsUsageResponse{
+		Organizations: organizationUsageToProto(snapshot.Organizations[start:end]),
+		Pagination: &commonv1.Pagination{
+			Page:       int32(page),
+			PerPage:    int32(perPage),
+			Total:      int32(total),
+			TotalPages: int32(totalPages),
+		},
+	}), nil
+}
+
+// getPlatformUsageSnapshot returns the cached usage snapshot, rebuilding it from the metrics
+// database when the cache is empty or unavailable
+func getPlatformUsageSnapshot(ctx context.Context) (*platformUsageSnapshot, error) {
+	if database.RedisClient != nil {
+		if cached, err := database.RedisClient.Get(ctx, platformUsageCacheKey); err == nil && cached != "" {
+			var snapshot platformUsageSnapshot
+			if err := json.Unmarshal([]byte(cached), &snapshot); err == nil {
+				return &snapshot, nil
+			}
+		}
+	}
+
+	if database.DB == nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database not initialised"))
+	}
+	if database.MetricsDB == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("metrics database not available"))
+	}
+
+	snapshot, err := buildPlatformUsageSnapshot(ctx, time.Now().UTC())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("build platform usage summary: %w", err))
+	}
+
+	if database.RedisClient != nil {
+		if err := database.RedisClient.Set(ctx, platformUsageCacheKey, snapshot, platformUsageCacheTTL); err != nil {
+			logger.Warn("[SuperAdmin] Failed to cache platform usage summary: %v", err)
+		}
+	}
+	return snapshot, nil
+}
+
+func buildPlatformUsageSnapshot(ctx context.Context, now time.Time) (*platformUsageSnapshot, error) {
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	rows, err := loadPlatformUsageRows(ctx, monthStart)
+	if err != nil {
+		return nil, err
+	}
+
+	organizations := aggregateOrganizationUsage(rows)
+	orgIDs := make(map[string]struct{}, len(organizations))
+	for _, org := range organizations {
+		orgIDs[org.OrganizationID] = struct{}{}
+	}
+	orgNames, err := loadOrganizationNames(ctx, keysFromSet(orgIDs))
+	if err != nil {
+		return nil, fmt.Errorf("load organization names: %w", err)
+	}
+	for i := range organizations {
+		organizations[i].OrganizationName = orgNames[organizations[i].OrganizationID]
+		if organizations[i].OrganizationName == "" {
+			organizations[i].OrganizationName = organizations[i].OrganizationID
+		}
+	}
+
+	active := activePlatformResources(rows, now.Add(-platformUsageActiveWindow))
+	resourceRegions, err := loadResourceRegions(ctx, active)
+	if err != nil {
+		return nil, err
+	}
+
+	var creditsConsumed int64
+	if err := database.DB.WithContext(ctx).
+		Model(&database.CreditTransaction{}).
+		Select("COALESCE(-SUM(amount_cents), 0)").
+		Where("type = ? AND created_at >= ?", "usage", monthStart).
+		Scan(&creditsConsumed).Error; err != nil {
+		return nil, fmt.Errorf("sum credits consumed: %w", err)
+	}
+
+	return &platformUsageSnapshot{
+		ActiveDeployments:    int64(len(active["deployment"])),
+		ActiveGameServers:    int64(len(active["gameserver"])),
+		ActiveVPS:            int64(len(active["vps"])),
+		CreditsConsumedCents: creditsConsumed,
+		Organizations:        organizations,
+		Regions:              aggregateRegionUsage(active, resourceRegions),
+		GeneratedAt:          now,
+	}, nil
+}
+
+// loadPlatformUsageRows returns one row per resource that reported usage since the given time
+func loadPlatformUsageRows(ctx context.Context, since time.Time) ([]platformUsageRow, error) {
+	var rows []platformUsageRow
+	for _, source := range platformUsageSources {
+		var sourceRows []platformUsageRow
+		if err := database.MetricsDB.WithContext(ctx).
+			Table(source.table).
+			Select(source.idColumn+" AS resource_id, organization_id, COUNT(*) AS hours, MAX(hour) AS last_hour").
+			Where("hour >= ?", since).
+			Group(source.idColumn + ", organization_id").
+			Scan(&sourceRows).Error; err != nil {
+			return nil, fmt.Errorf("query %s: %w", source.table, err)
+		}
+		for i := range sourceRows {
+			sourceRows[i].ResourceType = source.resourceType
+		}
+		rows = append(rows, sourceRows...)
+	}
+	return rows, nil
+}
+
+// aggregateOrganizationUsage counts resources per organization, sorted by total resources
+// and then by hours of usage, highest first
+func aggregateOrganizationUsage(rows []platformUsageRow) []organizationUsageAggregate {
+	byOrg := make(map[string]*organizationUsageAggregate)
+	for _, row := range rows {
+		if row.OrganizationID == "" {
+			continue
+		}
+		org := byOrg[row.OrganizationID]
+		if org == nil {
+			org = &organizationUsageAggregate{OrganizationID: row.OrganizationID}
+			byOrg[row.OrganizationID] = org
+		}
+		switch row.ResourceType {
+		case "deployment":
+			org.DeploymentCount++
+		case "gameserver":
+			org.GameServerCount++
+		case "vps":
+			org.VPSCount++
+		}
+		org.ResourceHours += row.Hours
+	}
+
+	organizations := make([]organizationUsageAggregate, 0, len(byOrg))
+	for _, org := range byOrg {
+		organizations = append(organizations, *org)
+	}
+	sort.Slice(organizations, func(i, j int) bool {
+		if organizations[i].totalResources() != organizations[j].totalResources() {
+			return organizations[i].totalResources() > organizations[j].totalResources()
+		}
+		if organizations[i].ResourceHours != organizations[j].ResourceHours {
+			return organizations[i].ResourceHours > organizations[j].ResourceHours
+		}
+		return organizations[i].OrganizationID < organizations[j].OrganizationID
+	})
+	return organizations
+}
+
+// activePlatformResources returns the IDs of resources that reported usage since the
+// given time, keyed by resource type
+func activePlatformResources(rows []platformUsageRow, since time.Time) map[string][]string {
+	active := make(map[string][]string)
+	for _, row := range rows {
+		if row.LastHour.Before(since) {
+			continue
+		}
+		active[row.ResourceType] = append(active[row.ResourceType], row.ResourceID)
+	}
+	return active
+}
+
+// loadResourceRegions maps active resources to their region, keyed by resource type and ID.
+// VPS instances carry their region; deployments and game servers take the region of the
+// node they run on.
+func loadResourceRegions(ctx context.Context, active map[string][]string) (map[string]map[string]string, error) {
+	type regionRow struct {
+		ResourceID string `gorm:"column:resource_id"`
+		Region     string `gorm:"column:region"`
+	}
+	regions := make(map[string]map[string]string, len(platformUsageSources))
+
+	queries := map[string]func(ids []string) ([]regionRow, error){
+		"deployment": func(ids []string) ([]regionRow, error) {
+			var rows []regionRow
+			err := database.DB.WithContext(ctx).
+				Table("deployment_locations dl").
+				Select("dl.deployment_id AS resource_id, nm.region").
+				Joins("LEFT JOIN node_metadata nm ON nm.id = dl.node_id").
+				Where("dl.deployment_id IN ? AND dl.status = ?", ids, "running").
+				Scan(&rows).Error
+			return rows, err
+		},
+		"gameserver": func(ids []string) ([]regionRow, error) {
+			var rows []regionRow
+			err := database.DB.WithContext(ctx).
+				Table("game_server_locations gl").
+				Select("gl.game_server_id AS resource_id, nm.region").
+				Joins("LEFT JOIN node_metadata nm ON nm.id = gl.node_id").
+				Where("gl.game_server_id IN ? AND gl.status = ?", ids, "running").
+				Scan(&rows).Error
+			return rows, err
+		},
+		"vps": func(ids []string) ([]regionRow, error) {
+			var rows []regionRow
+			err := database.DB.WithContext(ctx).
+				Model(&database.VPSInstance{}).
+				Select("id AS resource_id, region").
+				Where("id IN ?", ids).
+				Scan(&rows).Error
+			return rows, err
+		},
+	}
+
+	for resourceType, ids := range active {
+		query, ok := queries[resourceType]
+		if !ok || len(ids) == 0 {
+			continue
+		}
+		rows, err := query(ids)
+		if err != nil {
+			return nil, fmt.Errorf("load %s regions: %w", resourceType, err)
+		}
+		regions[resourceType] = make(map[string]string, len(rows))
+		for _, row := range rows {
+			// Replicated resources run on several nodes; count them once
+			if _, seen := regions[resourceType][row.ResourceID]; !seen {
+				regions[resourceType][row.ResourceID] = row.Region
+			}
+		}
+	}
+	return regions, nil
+}
+
+// aggregateRegionUsage counts active resources per region, largest regions first.
+// Resources with no known placement are grouped under "unknown".
+func aggregateRegionUsage(active map[string][]string, resourceRegions map[string]map[string]string) []regionUsageAggregate {
+	byRegion := make(map[string]*regionUsageAggregate)
+	for resourceType, ids := range active {
+		for _, id := range ids {
+			name := resourceRegions[resourceType][id]
+			if name == "" {
+				name = platformUsageUnknownRegion
+			}
+			region := byRegion[name]
+			if region == nil {
+				region = &regionUsageAggregate{Region: name}
+				byRegion[name] = region
+			}
+			switch resourceType {
+			case "deployment":
+				region.DeploymentCount++
+			case "gameserver":
+				region.GameServerCount++
+			case "vps":
+				region.VPSCount++
+			}
+		}
+	}
+
+	regions := make([]regionUsageAggregate, 0, len(byRegion))
+	for _, region := range byRegion {
+		regions = append(regions, *region)
+	}
+	sort.Slice(regions, func(i, j int) bool {
+		ti := regions[i].DeploymentCount + regions[i].GameServerCount + regions[i].VPSCount
+		tj := regions[j].DeploymentCount + regions[j].GameServerCount + regions[j].VPSCount
+		if ti != tj {
+			return ti > tj
+		}
+		return regions[i].Region < regions[j].Region
+	})
+	return regions
+}
+
+func organizationUsageToProto(organizations []organizationUsageAggregate) []*superadminv1.OrganizationResourceUsage {
+	result := make([]*superadminv1.OrganizationResourceUsage, 0, len(organizations))
+	for _, org := range organizations {
+		result = append(result, &superadminv1.OrganizationResourceUsage{
+			OrganizationId:   org.OrganizationID,
+			OrganizationName: org.OrganizationName,
+			DeploymentCount:  org.DeploymentCount,
+			GameServerCount:  org.GameServerCount,
+			VpsCount:         org.VPSCount,
+			TotalResources:   org.totalResources(),
+			ResourceHours:    org.ResourceHours,
+		})
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+package superadmin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAggregateOrganizationUsage(t *testing.T) {
+	organizations := aggregateOrganizationUsage([]platformUsageRow{
+		{ResourceType: "deployment", ResourceID: "dep-1", OrganizationID: "org-1", Hours: 10},
+		{ResourceType: "gameserver", ResourceID: "gs-1", OrganizationID: "org-2", Hours: 5},
+		{ResourceType: "vps", ResourceID: "vps-1", OrganizationID: "org-2", Hours: 5},
+		{ResourceType: "deployment", ResourceID: "dep-2", OrganizationID: "org-3", Hours: 40},
+		{ResourceType: "deployment", ResourceID: "dep-3", OrganizationID: "", Hours: 100},
+	})
+
+	if len(organizations) != 3 {
+		t.Fatalf("expected 3 organizations, got %d", len(organizations))
+	}
+	wantOrder := []string{"org-2", "org-3", "org-1"}
+	for i, orgID := range wantOrder {
+		if organizations[i].OrganizationID != orgID {
+			t.Fatalf("expected %s at position %d, got %s", orgID, i, organizations[i].OrganizationID)
+		}
+	}
+	if org := organizations[0]; org.GameServerCount != 1 || org.VPSCount != 1 || org.totalResources() != 2 || org.ResourceHours != 10 {
+		t.Fatalf("unexpected counts for org-2: %+v", org)
+	}
+}
+
+func TestAggregateRegionUsage(t *testing.T) {
+	now := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+	active := activePlatformResources([]platformUsageRow{
+		{ResourceType: "deployment", ResourceID: "dep-1", LastHour: now.Add(-time.Hour)},
+		{ResourceType: "deployment", ResourceID: "dep-2", LastHour: now.Add(-48 * time.Hour)},
+		{ResourceType: "vps", ResourceID: "vps-1", LastHour: now.Add(-2 * time.Hour)},
+		{ResourceType: "vps", ResourceID: "vps-2", LastHour: now.Add(-3 * time.Hour)},
+		{ResourceType: "gameserver", ResourceID: "gs-1", LastHour: now.Add(-time.Hour)},
+	}, now.Add(-platformUsageActiveWindow))
+
+	if len(active["deployment"]) != 1 || len(active["vps"]) != 2 || len(active["gameserver"]) != 1 {
+		t.Fatalf("unexpected active resources: %+v", active)
+	}
+
+	regions := aggregateRegionUsage(active, map[string]map[string]string{
+		"deployment": {"dep-1": "eu-west-1"},
+		"vps":        {"vps-1": "eu-west-1", "vps-2": "us-east-1"},
+	})
+	if len(regions) != 3 {
+		t.Fatalf("expected 3 regions, got %+v", regions)
+	}
+	if regions[0].Region != "eu-west-1" || regions[0].DeploymentCount != 1 || regions[0].VPSCount != 1 {
+		t.Fatalf("unexpected first region: %+v", regions[0])
+	}
+	if regions[1].Region != platformUsageUnknownRegion || regions[1].GameServerCount != 1 {
+		t.Fatalf("expected game server without placement under %q, got %+v", platformUsageUnknownRegion, regions[1])
+	}
+}
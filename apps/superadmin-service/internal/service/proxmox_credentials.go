@@ -0,0 +1,61 @@
+package superadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+	vpsorch "github.com/obiente/cloud/apps/vps-service/orchestrator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// validateProxmoxToken checks a new token against the Proxmox nodes; replaced in tests
+var validateProxmoxToken = vpsorch.ValidateProxmoxToken
+
+// RotateProxmoxToken handles the RotateProxmoxToken RPC
+func (s *Service) RotateProxmoxToken(ctx context.Context, req *connect.Request[superadminv1.RotateProxmoxTokenRequest]) (*connect.Response[superadminv1.RotateProxmoxTokenResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.proxmox.update") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	token, err := vpsorch.ParseProxmoxToken(req.Msg.GetNewToken())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	credentials := vpsorch.ProxmoxCredentials()
+	current, ok := credentials.Primary()
+	if !ok {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("Proxmox is configured with password authentication"))
+	}
+	if current == token {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("token %s is already in use", token.Name()))
+	}
+
+	retiresAt, err := credentials.Rotate(ctx, token, user.Id, func(ctx context.Context, token vpsorch.ProxmoxToken) error {
+		if err := validateProxmoxToken(ctx, token); err != nil {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("token validation failed: %w", err))
+		}
+		return nil
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to rotate Proxmox token: %w", err))
+	}
+	logger.Info("[SuperAdmin] User %s rotated the Proxmox API token to %s", user.Id, token.Name())
+
+	return connect.NewResponse(&superadminv1.RotateProxmoxTokenResponse{
+		TokenName:              token.Name(),
+		PreviousTokenRetiresAt: timestamppb.New(retiresAt),
+	}), nil
+}
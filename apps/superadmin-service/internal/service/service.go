@@ -157,7 +157,7 @@ func (s *Service) QueryDNS(ctx context.Context, req *connect.Request[superadminv
 	parts := strings.Split(strings.ToLower(domain), ".")
 
 	// Handle SRV queries: _minecraft._tcp.gs-123.my.obiente.cloud
-	// Also supports: _minecraft._udp (Bedrock), _rust._udp
+	// Also supports: _minecraft._udp (Bedrock), _rust._udp, _valheim._udp, _cs2._udp
 	if recordType == "SRV" {
 		if len(parts) < 4 {
 			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid SRV domain format"))
@@ -183,7 +183,7 @@ func (s *Service) QueryDNS(ctx context.Context, req *connect.Request[superadminv
 		}
 
 		// Validate SRV service/protocol matches game type
-		// GameType enum values: MINECRAFT = 1, MINECRAFT_JAVA = 2, MINECRAFT_BEDROCK = 3, RUST = 6
+		// GameType enum values: MINECRAFT = 1, MINECRAFT_JAVA = 2, MINECRAFT_BEDROCK = 3, VALHEIM = 4, RUST = 6, CS2 = 7
 		isValid := false
 		if service == "_minecraft" {
 			if protocol == "_tcp" && (gameType == 1 || gameType == 2) {
@@ -196,6 +196,12 @@ func (s *Service) QueryDNS(ctx context.Context, req *connect.Request[superadminv
 		} else if service == "_rust" && protocol == "_udp" && gameType == 6 {
 			// Rust uses UDP
 			isValid = true
+		} else if service == "_valheim" && protocol == "_udp" && gameType == 4 {
+			// Valheim uses UDP
+			isValid = true
+		} else if service == "_cs2" && protocol == "_udp" && gameType == 7 {
+			// Counter-Strike 2 uses UDP
+			isValid = true
 		}
 
 		if !isValid {
@@ -644,7 +650,7 @@ func (s *Service) listGameServerDNSRecords(req *connect.Request[superadminv1.Lis
 		})
 
 		// SRV records for games that support them
-		// GameType enum values: MINECRAFT = 1, MINECRAFT_JAVA = 2, MINECRAFT_BEDROCK = 3, RUST = 6
+		// GameType enum values: MINECRAFT = 1, MINECRAFT_JAVA = 2, MINECRAFT_BEDROCK = 3, VALHEIM = 4, RUST = 6, CS2 = 7
 		if row.GameType == 1 || row.GameType == 2 {
 			// Minecraft Java Edition - TCP SRV record
 			// Format: _minecraft._tcp.gs-123.my.obiente.cloud
@@ -698,6 +704,42 @@ func (s *Service) listGameServerDNSRecords(req *connect.Request[superadminv1.Lis
 				LastResolved:   timestamppb.New(now),
 			})
 		}
+
+		if row.GameType == 4 {
+			// Valheim - UDP SRV record
+			// Format: _valheim._udp.gs-123.my.obiente.cloud
+			srvDomain := fmt.Sprintf("_valheim._udp.%s.my.obiente.cloud", row.GameServerID)
+			records = append(records, &superadminv1.DNSRecord{
+				RecordType:     "SRV",
+				GameServerId:   row.GameServerID,
+				OrganizationId: row.OrganizationID,
+				GameServerName: row.GameServerName,
+				Domain:         srvDomain,
+				Target:         aRecordDomain, // A record domain for SRV target
+				Port:           port,
+				Region:         region,
+				Status:         fmt.Sprintf("%d", row.Status),
+				LastResolved:   timestamppb.New(now),
+			})
+		}
+
+		if row.GameType == 7 {
+			// Counter-Strike 2 - UDP SRV record
+			// Format: _cs2._udp.gs-123.my.obiente.cloud
+			srvDomain := fmt.Sprintf("_cs2._udp.%s.my.obiente.cloud", row.GameServerID)
+			records = append(records, &superadminv1.DNSRecord{
+				RecordType:     "SRV",
+				GameServerId:   row.GameServerID,
+				OrganizationId: row.OrganizationID,
+				GameServerName: row.GameServerName,
+				Domain:         srvDomain,
+				Target:         aRecordDomain, // A record domain for SRV target
+				Port:           port,
+				Region:         region,
+				Status:         fmt.Sprintf("%d", row.Status),
+				LastResolved:   timestamppb.New(now),
+			})
+		}
 	}
 
 	return records, nil
@@ -2357,6 +2399,7 @@ func (s *Service) ListPlans(ctx context.Context, _ *connect.Request[superadminv1
 			MemoryBytes:             plan.MemoryBytes,
 			DeploymentsMax:          int32(plan.DeploymentsMax),
 			MaxVpsInstances:         int32(plan.MaxVpsInstances),
+			MaxReplicas:             int32(plan.MaxReplicas),
 			BandwidthBytesMonth:     plan.BandwidthBytesMonth,
 			StorageBytes:            plan.StorageBytes,
 			MinimumPaymentCents:     plan.MinimumPaymentCents,
@@ -2387,6 +2430,7 @@ func (s *Service) CreatePlan(ctx context.Context, req *connect.Request[superadmi
 		MemoryBytes:             req.Msg.GetMemoryBytes(),
 		DeploymentsMax:          int(req.Msg.GetDeploymentsMax()),
 		MaxVpsInstances:         int(req.Msg.GetMaxVpsInstances()),
+		MaxReplicas:             int(req.Msg.GetMaxReplicas()),
 		BandwidthBytesMonth:     req.Msg.GetBandwidthBytesMonth(),
 		StorageBytes:            req.Msg.GetStorageBytes(),
 		MinimumPaymentCents:     req.Msg.GetMinimumPaymentCents(),
@@ -2406,6 +2450,7 @@ func (s *Service) CreatePlan(ctx context.Context, req *connect.Request[superadmi
 		MemoryBytes:             plan.MemoryBytes,
 		DeploymentsMax:          int32(plan.DeploymentsMax),
 		MaxVpsInstances:         int32(plan.MaxVpsInstances),
+		MaxReplicas:             int32(plan.MaxReplicas),
 		BandwidthBytesMonth:     plan.BandwidthBytesMonth,
 		StorageBytes:            plan.StorageBytes,
 		MinimumPaymentCents:     plan.MinimumPaymentCents,
@@ -2447,6 +2492,9 @@ func (s *Service) UpdatePlan(ctx context.Context, req *connect.Request[superadmi
 	if req.Msg.MaxVpsInstances != nil {
 		plan.MaxVpsInstances = int(*req.Msg.MaxVpsInstances)
 	}
+	if req.Msg.MaxReplicas != nil {
+		plan.MaxReplicas = int(*req.Msg.MaxReplicas)
+	}
 	if req.Msg.BandwidthBytesMonth != nil {
 		plan.BandwidthBytesMonth = *req.Msg.BandwidthBytesMonth
 	}
@@ -2477,6 +2525,7 @@ func (s *Service) UpdatePlan(ctx context.Context, req *connect.Request[superadmi
 		MemoryBytes:             plan.MemoryBytes,
 		DeploymentsMax:          int32(plan.DeploymentsMax),
 		MaxVpsInstances:         int32(plan.MaxVpsInstances),
+		MaxReplicas:             int32(plan.MaxReplicas),
 		BandwidthBytesMonth:     plan.BandwidthBytesMonth,
 		StorageBytes:            plan.StorageBytes,
 		MinimumPaymentCents:     plan.MinimumPaymentCents,
@@ -0,0 +1,91 @@
+package superadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+	"gorm.io/gorm"
+)
+
+// maxSplitHorizonEntries bounds the CIDRs a single deployment can carry
+const maxSplitHorizonEntries = 50
+
+// ConfigureSplitHorizon replaces the split-horizon entries of a deployment: DNS clients inside one
+// of the CIDRs get the entry's private IP instead of the Traefik IP
+func (s *Service) ConfigureSplitHorizon(ctx context.Context, req *connect.Request[superadminv1.ConfigureSplitHorizonRequest]) (*connect.Response[superadminv1.ConfigureSplitHorizonResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.dns.update") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	deploymentID := strings.TrimSpace(req.Msg.GetDeploymentId())
+	if deploymentID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("deployment_id is required"))
+	}
+	if err := database.DB.WithContext(ctx).Select("id").First(&database.Deployment{}, "id = ?", deploymentID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	entries, err := splitHorizonEntriesFromProto(req.Msg.GetEntries())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := database.SetSplitHorizonIPs(deploymentID, entries); err != nil {
+		logger.Error("[SuperAdmin] Failed to configure split-horizon DNS for deployment %s: %v", deploymentID, err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Cached answers of every client are dropped so the new entries apply immediately
+	if database.RedisClient != nil {
+		if err := database.RedisClient.DeletePattern(ctx, fmt.Sprintf("dns:split:*:%s", deploymentID)); err != nil {
+			logger.Warn("[SuperAdmin] Failed to clear cached split-horizon answers for deployment %s: %v", deploymentID, err)
+		}
+	}
+
+	protoEntries := make([]*superadminv1.SplitHorizonEntry, 0, len(entries))
+	for _, entry := range entries {
+		protoEntries = append(protoEntries, &superadminv1.SplitHorizonEntry{ClientCidr: entry.ClientCIDR, Ip: entry.IP})
+	}
+	return connect.NewResponse(&superadminv1.ConfigureSplitHorizonResponse{Entries: protoEntries}), nil
+}
+
+// splitHorizonEntriesFromProto validates split-horizon entries and normalizes their CIDRs to the
+// network address (10.1.2.3/8 becomes 10.0.0.0/8)
+func splitHorizonEntriesFromProto(protoEntries []*superadminv1.SplitHorizonEntry) ([]database.SplitHorizonIP, error) {
+	if len(protoEntries) > maxSplitHorizonEntries {
+		return nil, fmt.Errorf("a deployment can have at most %d split-horizon entries", maxSplitHorizonEntries)
+	}
+	entries := make([]database.SplitHorizonIP, 0, len(protoEntries))
+	seen := make(map[string]bool, len(protoEntries))
+	for _, protoEntry := range protoEntries {
+		_, network, err := net.ParseCIDR(strings.TrimSpace(protoEntry.GetClientCidr()))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client CIDR %q", protoEntry.GetClientCidr())
+		}
+		ip := net.ParseIP(strings.TrimSpace(protoEntry.GetIp()))
+		if ip == nil || ip.To4() == nil {
+			return nil, fmt.Errorf("invalid IP %q for %s: an IPv4 address is required", protoEntry.GetIp(), network)
+		}
+		cidr := network.String()
+		if seen[cidr] {
+			return nil, fmt.Errorf("client CIDR %s is listed more than once", cidr)
+		}
+		seen[cidr] = true
+		entries = append(entries, database.SplitHorizonIP{ClientCIDR: cidr, IP: ip.To4().String()})
+	}
+	return entries, nil
+}
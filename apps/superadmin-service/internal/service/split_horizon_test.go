@@ -0,0 +1,32 @@
+package superadmin
+
+import (
+	"testing"
+
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+)
+
+func TestSplitHorizonEntriesFromProto(t *testing.T) {
+	entries, err := splitHorizonEntriesFromProto([]*superadminv1.SplitHorizonEntry{
+		{ClientCidr: " 10.1.2.3/8 ", Ip: "172.16.0.10"},
+		{ClientCidr: "fd00::/8", Ip: "::ffff:172.16.0.11"},
+	})
+	if err != nil {
+		t.Fatalf("splitHorizonEntriesFromProto: %v", err)
+	}
+	if entries[0].ClientCIDR != "10.0.0.0/8" || entries[0].IP != "172.16.0.10" || entries[1].IP != "172.16.0.11" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	invalid := [][]*superadminv1.SplitHorizonEntry{
+		{{ClientCidr: "10.0.0.0", Ip: "172.16.0.10"}},
+		{{ClientCidr: "10.0.0.0/8", Ip: "fd00::10"}},
+		{{ClientCidr: "10.0.0.0/8", Ip: "internal.example"}},
+		{{ClientCidr: "10.0.0.0/8", Ip: "172.16.0.10"}, {ClientCidr: "10.9.0.0/8", Ip: "172.16.0.11"}},
+	}
+	for _, protoEntries := range invalid {
+		if _, err := splitHorizonEntriesFromProto(protoEntries); err == nil {
+			t.Errorf("splitHorizonEntriesFromProto(%v) accepted invalid entries", protoEntries)
+		}
+	}
+}
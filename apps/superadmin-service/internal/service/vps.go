@@ -12,11 +12,13 @@ import (
 	"github.com/obiente/cloud/apps/shared/pkg/auth"
 	"github.com/obiente/cloud/apps/shared/pkg/database"
 	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/notifications"
 	"github.com/obiente/cloud/apps/shared/pkg/services/organizations"
 	vpsorch "github.com/obiente/cloud/apps/vps-service/orchestrator"
 	vpsservice "github.com/obiente/cloud/apps/vps-service/pkg/service"
 
 	commonv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/common/v1"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
 	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
 	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
 
@@ -303,6 +305,9 @@ func convertVPSInstanceToProto(vps *database.VPSInstance) *vpsv1.VPSInstance {
 	if vps.LastStartedAt != nil {
 		protoVPS.LastStartedAt = timestamppb.New(*vps.LastStartedAt)
 	}
+	if vps.LastPatchedAt != nil {
+		protoVPS.LastPatchedAt = timestamppb.New(*vps.LastPatchedAt)
+	}
 	if vps.DeletedAt != nil {
 		protoVPS.DeletedAt = timestamppb.New(*vps.DeletedAt)
 	}
@@ -639,6 +644,16 @@ func (s *Service) SuperadminResizeVPS(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update VPS in database: %w", err))
 	}
 
+	// The new size may come with different bandwidth limits
+	if vpsManager, err := vpsorch.NewVPSManager(); err != nil {
+		logger.Warn("[SuperAdmin] Failed to create VPS manager to update bandwidth of VPS %s: %v", vps.ID, err)
+	} else {
+		if err := vpsManager.UpdateVPSBandwidth(ctx, vps.ID); err != nil {
+			logger.Warn("[SuperAdmin] Failed to update bandwidth limits of VPS %s after resize: %v", vps.ID, err)
+		}
+		vpsManager.Close()
+	}
+
 	// Restart VM if it was running
 	if wasRunning {
 		logger.Info("[SuperAdmin] Starting VM %d after resize", vmIDInt)
@@ -922,3 +937,129 @@ func (s *Service) SuperadminForceDeleteVPS(ctx context.Context, req *connect.Req
 		Message: message,
 	}), nil
 }
+
+// SuperadminMigrateVPS moves a VPS instance to another Proxmox node (superadmin only)
+func (s *Service) SuperadminMigrateVPS(ctx context.Context, req *connect.Request[superadminv1.SuperadminMigrateVPSRequest]) (*connect.Response[superadminv1.SuperadminMigrateVPSResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.vps.update") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	vpsID := req.Msg.GetVpsId()
+	if vpsID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("vps_id is required"))
+	}
+	targetNode := strings.TrimSpace(req.Msg.GetTargetNode())
+	if targetNode == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("target_node is required"))
+	}
+
+	// Get VPS instance
+	var vps database.VPSInstance
+	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VPS instance %s not found", vpsID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS: %w", err))
+	}
+
+	if vps.InstanceID == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("VPS has no instance ID (not provisioned yet)"))
+	}
+	if vps.NodeID != nil && *vps.NodeID == targetNode {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("VPS is already on node %s", targetNode))
+	}
+
+	sourceNode := ""
+	if vps.NodeID != nil {
+		sourceNode = *vps.NodeID
+	}
+	live := req.Msg.GetLive()
+
+	vpsManager, err := vpsorch.NewVPSManager()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create VPS manager: %w", err))
+	}
+	defer vpsManager.Close()
+
+	migrationNote := "Your VPS will be stopped briefly while it is moved."
+	if live {
+		migrationNote = "The VPS stays online during the migration, but you may notice a short pause."
+	}
+	// Only tell the owner once the preflight checks passed and Proxmox started the migration
+	started := false
+	onStarted := func() {
+		started = true
+		notifyVPSMigration(ctx, &vps, "vps_migration_started",
+			fmt.Sprintf("VPS Maintenance: %s is being migrated", vps.Name),
+			fmt.Sprintf("Your VPS '%s' is being migrated to another host for maintenance. %s", vps.Name, migrationNote),
+			sourceNode, targetNode)
+	}
+
+	logger.Info("[SuperAdmin] User %s migrating VPS %s from node %s to node %s (live: %v)", user.Id, vpsID, sourceNode, targetNode, live)
+	if err := vpsManager.MigrateVPS(ctx, vpsID, targetNode, live, onStarted); err != nil {
+		if started {
+			notifyVPSMigration(ctx, &vps, "vps_migration_failed",
+				fmt.Sprintf("VPS Maintenance: migration of %s failed", vps.Name),
+				fmt.Sprintf("The migration of your VPS '%s' did not complete. It remains on its current host and our team has been alerted.", vps.Name),
+				sourceNode, targetNode)
+		}
+		if errors.Is(err, vpsorch.ErrMigrationSameNode) ||
+			errors.Is(err, vpsorch.ErrMigrationTargetNotFound) ||
+			errors.Is(err, vpsorch.ErrMigrationTargetOffline) ||
+			errors.Is(err, vpsorch.ErrMigrationInsufficientMemory) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to migrate VPS: %w", err))
+	}
+
+	// Refresh VPS to pick up the new node
+	if err := database.DB.Where("id = ?", vpsID).First(&vps).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to reload VPS: %w", err))
+	}
+
+	notifyVPSMigration(ctx, &vps, "vps_migration_completed",
+		fmt.Sprintf("VPS Maintenance: %s migrated", vps.Name),
+		fmt.Sprintf("Your VPS '%s' has been migrated to a new host and is available again.", vps.Name),
+		sourceNode, targetNode)
+
+	return connect.NewResponse(&superadminv1.SuperadminMigrateVPSResponse{
+		Vps:     convertVPSInstanceToProto(&vps),
+		Message: fmt.Sprintf("VPS migrated to node %s successfully", targetNode),
+	}), nil
+}
+
+// notifyVPSMigration sends a high-severity notification about a VPS migration to the VPS owner
+func notifyVPSMigration(ctx context.Context, vps *database.VPSInstance, eventType, title, message, sourceNode, targetNode string) {
+	if vps.CreatedBy == "" {
+		logger.Warn("[SuperAdmin] VPS %s has no owner, skipping %s notification", vps.ID, eventType)
+		return
+	}
+
+	orgID := vps.OrganizationID
+	actionURL := fmt.Sprintf("/vps/%s", vps.ID)
+	actionLabel := "View VPS"
+	if err := notifications.CreateNotificationForUser(
+		ctx,
+		vps.CreatedBy,
+		&orgID,
+		notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM,
+		notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+		title,
+		message,
+		&actionURL,
+		&actionLabel,
+		map[string]string{
+			"vps_id":      vps.ID,
+			"vps_name":    vps.Name,
+			"event_type":  eventType,
+			"source_node": sourceNode,
+			"target_node": targetNode,
+		},
+	); err != nil {
+		logger.Warn("[SuperAdmin] Failed to send %s notification for VPS %s: %v", eventType, vps.ID, err)
+	}
+}
@@ -0,0 +1,45 @@
+package superadmin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	superadminv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/superadmin/v1"
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+)
+
+// ListUnpatchedVPS lists VPS instances whose OS has not been patched in the given number of days
+func (s *Service) ListUnpatchedVPS(ctx context.Context, req *connect.Request[superadminv1.ListUnpatchedVPSRequest]) (*connect.Response[superadminv1.ListUnpatchedVPSResponse], error) {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unauthenticated"))
+	}
+	if !auth.HasSuperadminPermission(ctx, user, "superadmin.vps.read") {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("superadmin access required"))
+	}
+
+	days := int(req.Msg.GetDays())
+	if days <= 0 {
+		days = 30
+	}
+
+	instances, err := database.ListUnpatchedVPSInstances(ctx, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		logger.Error("[SuperAdmin] Failed to list unpatched VPS instances: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoInstances := make([]*vpsv1.VPSInstance, 0, len(instances))
+	for i := range instances {
+		protoInstances = append(protoInstances, convertVPSInstanceToProto(&instances[i]))
+	}
+
+	return connect.NewResponse(&superadminv1.ListUnpatchedVPSResponse{
+		VpsInstances: protoInstances,
+	}), nil
+}
@@ -89,6 +89,7 @@ func main() {
 		&database.OrganizationMember{},
 		&database.OrgRole{},
 		&database.OrgRoleBinding{},
+		&database.RoleInheritance{},
 		&database.BillingAccount{},
 		&database.CreditTransaction{},
 		&database.StripeWebhookEvent{},
@@ -98,6 +99,7 @@ func main() {
 		&database.GitHubIntegration{},
 		&database.SuperadminRole{},
 		&database.SuperadminRoleBinding{},
+		&database.OrgSuspension{},
 	)
 
 	// Initialize database
@@ -113,6 +115,13 @@ func main() {
 		logger.Info("✓ Metrics database initialized")
 	}
 
+	// Initialize Redis (for cached usage summaries, etc.)
+	if err := database.InitRedis(); err != nil {
+		logger.Warn("Redis initialization failed: %v. Some features may not work correctly.", err)
+	} else {
+		logger.Info("✓ Redis initialized")
+	}
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "3011"
@@ -128,6 +137,9 @@ func main() {
 	// Create audit interceptor
 	auditInterceptor := middleware.AuditLogInterceptor()
 
+	// Create maintenance mode interceptor (rejects mutating RPCs during platform maintenance)
+	maintenanceInterceptor := middleware.MaintenanceModeInterceptor()
+
 	// Register all service procedures for permission discovery
 	auth.RegisterAllServices()
 
@@ -135,7 +147,7 @@ func main() {
 	superadminService := superadminsvc.NewService()
 	superadminPath, superadminHandler := superadminv1connect.NewSuperadminServiceHandler(
 		superadminService,
-		connect.WithInterceptors(auditInterceptor, authInterceptor),
+		connect.WithInterceptors(auditInterceptor, authInterceptor, maintenanceInterceptor),
 	)
 	mux.Handle(superadminPath, superadminHandler)
 
@@ -184,6 +196,10 @@ func main() {
 	go startAbuseDetectionService(shutdownCtx)
 	logger.Info("✓ Abuse detection service started")
 
+	// Sample node capacity hourly and email the weekly capacity report
+	go startCapacityPlanning(shutdownCtx)
+	logger.Info("✓ Capacity planning started")
+
 	// Start server in a goroutine
 	serverErr := make(chan error, 1)
 	go func() {
@@ -238,6 +254,18 @@ func startAbuseDetectionService(ctx context.Context) {
 	}
 }
 
+// startCapacityPlanning records node capacity samples and sends the weekly capacity report once
+// the databases, including the metrics database, are ready
+func startCapacityPlanning(ctx context.Context) {
+	if err := waitForDatabaseReadiness(ctx, true); err != nil {
+		logger.Info("[CapacityReport] Service stopped before initial run: %v", err)
+		return
+	}
+
+	go superadminsvc.StartCapacityReportMailer(ctx)
+	superadminsvc.StartNodeCapacitySampler(ctx)
+}
+
 // runAbuseDetection executes the abuse detection and handles errors
 // DetectAbuse will automatically send notifications if abuse is found
 func runAbuseDetection(ctx context.Context) {
@@ -260,5 +288,8 @@ func runAbuseDetection(ctx context.Context) {
 		if totalOrgs > 0 || totalActivities > 0 {
 			logger.Info("[AbuseDetection] Abuse detected! Triggering notification to superadmins (in background goroutine).")
 		}
+
+		// Suspend the highest-risk organizations when automatic suspension is enabled
+		superadminsvc.AutoSuspendAbusiveOrganizations(bgCtx, result.SuspiciousOrganizations)
 	}
 }
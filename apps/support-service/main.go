@@ -55,6 +55,13 @@ func main() {
 	}
 	logger.Info("✓ Database initialized")
 
+	// Initialize Redis (for maintenance mode, etc.)
+	if err := database.InitRedis(); err != nil {
+		logger.Warn("Redis initialization failed: %v. Some features may not work correctly.", err)
+	} else {
+		logger.Info("✓ Redis initialized")
+	}
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "3009"
@@ -70,11 +77,14 @@ func main() {
 	// Create audit interceptor
 	auditInterceptor := middleware.AuditLogInterceptor()
 
+	// Create maintenance mode interceptor (rejects mutating RPCs during platform maintenance)
+	maintenanceInterceptor := middleware.MaintenanceModeInterceptor()
+
 	// Register support service
 	supportService := support.NewService(database.DB)
 	supportPath, supportHandler := supportv1connect.NewSupportServiceHandler(
 		supportService,
-		connect.WithInterceptors(auditInterceptor, authInterceptor),
+		connect.WithInterceptors(auditInterceptor, authInterceptor, maintenanceInterceptor),
 	)
 	mux.Handle(supportPath, supportHandler)
 
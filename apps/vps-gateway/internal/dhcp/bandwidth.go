@@ -0,0 +1,160 @@
+package dhcp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"vps-gateway/internal/logger"
+)
+
+// BandwidthLimiter enforces per-lease bandwidth limits (implemented by network.BandwidthShaper)
+type BandwidthLimiter interface {
+	ApplyBandwidth(leaseIP string, downMbit, upMbit int) error
+	RemoveBandwidth(leaseIP string) error
+}
+
+// SetBandwidthLimiter enables bandwidth shaping for DHCP leases
+// Limits are resolved through the API client (FindVPSByLease) and applied by the background reconciler
+func (m *Manager) SetBandwidthLimiter(limiter BandwidthLimiter) {
+	m.bandwidthMu.Lock()
+	defer m.bandwidthMu.Unlock()
+	m.bandwidth = limiter
+	if m.shapedLeases == nil {
+		m.shapedLeases = make(map[string]string)
+	}
+}
+
+// Interface returns the bridge interface dnsmasq serves leases on
+func (m *Manager) Interface() string {
+	return m.interfaceName
+}
+
+// reconcileBandwidth applies limits to leases that have not been shaped yet and removes
+// limits from leases that are no longer active
+// Must NOT be called while holding m.mu (FindVPSByLease may block on the VPS service)
+func (m *Manager) reconcileBandwidth() {
+	m.bandwidthMu.Lock()
+	defer m.bandwidthMu.Unlock()
+	if m.bandwidth == nil {
+		return
+	}
+
+	leases, err := m.GetActiveLeases()
+	if err != nil {
+		logger.Debug("reconcileBandwidth: failed to read leases: %v", err)
+		return
+	}
+
+	active := make(map[string]string, len(leases))
+	for _, lease := range leases {
+		if lease.IP == nil || lease.MAC == "" {
+			continue
+		}
+		active[lease.IP.String()] = lease.MAC
+	}
+
+	// Lift limits from expired leases (or leases now held by a different MAC)
+	for ip, mac := range m.shapedLeases {
+		if active[ip] == mac {
+			continue
+		}
+		if err := m.bandwidth.RemoveBandwidth(ip); err != nil {
+			logger.Warn("Failed to remove bandwidth limits for expired lease %s: %v", ip, err)
+			continue
+		}
+		delete(m.shapedLeases, ip)
+	}
+
+	m.apiClientMu.RLock()
+	client := m.apiClient
+	m.apiClientMu.RUnlock()
+	if client == nil {
+		return
+	}
+
+	for ip, mac := range active {
+		if _, shaped := m.shapedLeases[ip]; shaped {
+			continue
+		}
+
+		timeout := m.findVPSTimeout
+		if timeout <= 0 {
+			timeout = 15 * time.Second
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		resp, err := client.FindVPSByLease(ctx, ip, mac)
+		cancel()
+		if err != nil || resp == nil || resp.GetVpsId() == "" {
+			// Not resolved yet (VPS service not connected or unknown lease) - retry on the next pass
+			continue
+		}
+
+		downMbit := int(resp.GetMaxMbitDown())
+		upMbit := int(resp.GetMaxMbitUp())
+		if err := m.bandwidth.ApplyBandwidth(ip, downMbit, upMbit); err != nil {
+			logger.Warn("Failed to apply bandwidth limits for VPS %s (IP %s): %v", resp.GetVpsId(), ip, err)
+			continue
+		}
+		m.shapedLeases[ip] = mac
+
+		m.mu.Lock()
+		if alloc, ok := m.allocations[resp.GetVpsId()]; ok {
+			alloc.MaxMbitDown = downMbit
+			alloc.MaxMbitUp = upMbit
+		}
+		m.mu.Unlock()
+	}
+}
+
+// UpdateBandwidth applies new limits to a VPS's lease, e.g. after the VPS was resized
+// The reconciler only resolves limits for leases it has not shaped yet, so changes must be pushed here
+func (m *Manager) UpdateBandwidth(vpsID string, downMbit, upMbit int) error {
+	m.mu.RLock()
+	alloc, ok := m.allocations[vpsID]
+	var ip, mac string
+	if ok {
+		ip, mac = alloc.IPAddress.String(), alloc.MACAddress
+	}
+	m.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("no IP allocation for VPS %s", vpsID)
+	}
+
+	m.bandwidthMu.Lock()
+	defer m.bandwidthMu.Unlock()
+	if m.bandwidth == nil {
+		return nil
+	}
+
+	if err := m.bandwidth.ApplyBandwidth(ip, downMbit, upMbit); err != nil {
+		return err
+	}
+	m.shapedLeases[ip] = mac
+
+	m.mu.Lock()
+	if alloc, ok := m.allocations[vpsID]; ok {
+		alloc.MaxMbitDown = downMbit
+		alloc.MaxMbitUp = upMbit
+	}
+	m.mu.Unlock()
+	return nil
+}
+
+// removeLeaseBandwidth lifts bandwidth limits from a released lease
+// Must NOT be called while holding m.mu
+func (m *Manager) removeLeaseBandwidth(ip string) {
+	m.bandwidthMu.Lock()
+	defer m.bandwidthMu.Unlock()
+	if m.bandwidth == nil {
+		return
+	}
+	if _, shaped := m.shapedLeases[ip]; !shaped {
+		return
+	}
+	if err := m.bandwidth.RemoveBandwidth(ip); err != nil {
+		logger.Warn("Failed to remove bandwidth limits for released lease %s: %v", ip, err)
+		return
+	}
+	delete(m.shapedLeases, ip)
+}
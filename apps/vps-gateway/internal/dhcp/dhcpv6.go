@@ -0,0 +1,691 @@
+package dhcp
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"vps-gateway/internal/logger"
+	"vps-gateway/internal/metrics"
+
+	"golang.org/x/net/ipv6"
+)
+
+// DHCPv6 message types (RFC 8415 section 7.3)
+const (
+	dhcpv6MsgSolicit            = 1
+	dhcpv6MsgAdvertise          = 2
+	dhcpv6MsgRequest            = 3
+	dhcpv6MsgConfirm            = 4
+	dhcpv6MsgRenew              = 5
+	dhcpv6MsgRebind             = 6
+	dhcpv6MsgReply              = 7
+	dhcpv6MsgRelease            = 8
+	dhcpv6MsgInformationRequest = 11
+)
+
+// DHCPv6 option codes
+const (
+	dhcpv6OptClientID     = 1
+	dhcpv6OptServerID     = 2
+	dhcpv6OptIANA         = 3
+	dhcpv6OptIAAddr       = 5
+	dhcpv6OptStatusCode   = 13
+	dhcpv6OptRapidCommit  = 14
+	dhcpv6OptDNSServers   = 23
+	dhcpv6HeaderLen       = 4
+	dhcpv6OptionHeaderLen = 4
+)
+
+// DHCPv6 status codes
+const (
+	dhcpv6StatusSuccess      = 0
+	dhcpv6StatusNoAddrsAvail = 2
+	dhcpv6StatusNoBinding    = 3
+	dhcpv6StatusNotOnLink    = 4
+)
+
+const (
+	duidTypeLLT    = 1
+	duidTypeLL     = 3
+	hwTypeEthernet = 1
+
+	dhcpv6DefaultPort      = 547
+	dhcpv6DefaultLeaseTime = time.Hour
+
+	// dhcpv6MaxAllocationScan bounds the search for a free address in the prefix
+	dhcpv6MaxAllocationScan = 1 << 16
+)
+
+// dhcpv6AllServersAndRelays is the multicast group clients send to (ff02::1:2)
+var dhcpv6AllServersAndRelays = net.ParseIP("ff02::1:2")
+
+// IPv6Lease is an address handed out by the DHCPv6 server, keyed by the client's MAC address
+type IPv6Lease struct {
+	MAC            string
+	IP             net.IP
+	VPSID          string // Set once FindVPSByLease resolves the lease
+	OrganizationID string
+	ExpiresAt      time.Time
+}
+
+// DHCPv6Manager serves DHCPv6 on the VPS network, assigning addresses from VPS_IPV6_PREFIX.
+// Leases are kept in the IPv4 Manager's lease store so a VPS's addresses share one MAC mapping.
+type DHCPv6Manager struct {
+	conn       net.PacketConn
+	leases     *Manager
+	prefix     *net.IPNet
+	serverDUID []byte
+	dnsServers []net.IP
+	leaseTime  time.Duration
+	allocMu    sync.Mutex     // Serializes address allocation
+	resolving  sync.WaitGroup // In-flight FindVPSByLease calls
+}
+
+// NewDHCPv6Manager starts a DHCPv6 server on the DHCP interface when VPS_IPV6_PREFIX is set
+// (e.g. fd00::/48). It returns nil without an error when IPv6 is not configured.
+func NewDHCPv6Manager(leases *Manager) (*DHCPv6Manager, error) {
+	prefixStr := strings.TrimSpace(os.Getenv("VPS_IPV6_PREFIX"))
+	if prefixStr == "" {
+		return nil, nil
+	}
+	prefix, err := parseIPv6Prefix(prefixStr)
+	if err != nil {
+		return nil, err
+	}
+
+	port := dhcpv6DefaultPort
+	if portStr := os.Getenv("GATEWAY_DHCPV6_PORT"); portStr != "" {
+		port, err = strconv.Atoi(portStr)
+		if err != nil || port <= 0 || port > 65535 {
+			return nil, fmt.Errorf("invalid GATEWAY_DHCPV6_PORT: %s", portStr)
+		}
+	}
+	leaseTime := parseDuration(os.Getenv("GATEWAY_DHCPV6_LEASE_TIME"), dhcpv6DefaultLeaseTime)
+
+	var dnsServers []net.IP
+	for _, dns := range strings.Split(os.Getenv("GATEWAY_DHCPV6_DNS"), ",") {
+		if ip := net.ParseIP(strings.TrimSpace(dns)); ip != nil && ip.To4() == nil {
+			dnsServers = append(dnsServers, ip)
+		}
+	}
+
+	iface, err := net.InterfaceByName(leases.interfaceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find DHCPv6 interface %s: %w", leases.interfaceName, err)
+	}
+
+	conn, err := net.ListenPacket("udp6", fmt.Sprintf("[::]:%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for DHCPv6 on port %d: %w", port, err)
+	}
+	if err := ipv6.NewPacketConn(conn).JoinGroup(iface, &net.UDPAddr{IP: dhcpv6AllServersAndRelays}); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to join DHCPv6 multicast group on %s: %w", iface.Name, err)
+	}
+
+	manager := newDHCPv6Manager(conn, leases, prefix, serverDUID(iface.HardwareAddr), dnsServers, leaseTime)
+	go manager.Serve()
+
+	logger.Info("DHCPv6 server listening on %s port %d, assigning addresses from %s", iface.Name, port, prefix.String())
+	return manager, nil
+}
+
+func newDHCPv6Manager(conn net.PacketConn, leases *Manager, prefix *net.IPNet, serverDUID []byte, dnsServers []net.IP, leaseTime time.Duration) *DHCPv6Manager {
+	return &DHCPv6Manager{
+		conn:       conn,
+		leases:     leases,
+		prefix:     prefix,
+		serverDUID: serverDUID,
+		dnsServers: dnsServers,
+		leaseTime:  leaseTime,
+	}
+}
+
+// parseIPv6Prefix parses an IPv6 prefix in CIDR notation
+func parseIPv6Prefix(prefixStr string) (*net.IPNet, error) {
+	ip, prefix, err := net.ParseCIDR(prefixStr)
+	if err != nil || ip.To4() != nil {
+		return nil, fmt.Errorf("invalid VPS_IPV6_PREFIX: %s (expected an IPv6 prefix like fd00::/48)", prefixStr)
+	}
+	if ones, _ := prefix.Mask.Size(); ones > 120 {
+		return nil, fmt.Errorf("VPS_IPV6_PREFIX %s is too small (use /120 or shorter)", prefixStr)
+	}
+	return prefix, nil
+}
+
+// serverDUID builds a DUID-LL from the interface's MAC address, or a random one if it has none
+func serverDUID(hwAddr net.HardwareAddr) []byte {
+	if len(hwAddr) != 6 {
+		hwAddr = make(net.HardwareAddr, 6)
+		_, _ = rand.Read(hwAddr)
+		hwAddr[0] = (hwAddr[0] | 0x02) &^ 0x01 // Locally administered, unicast
+	}
+	duid := make([]byte, 4, 4+len(hwAddr))
+	binary.BigEndian.PutUint16(duid[0:2], duidTypeLL)
+	binary.BigEndian.PutUint16(duid[2:4], hwTypeEthernet)
+	return append(duid, hwAddr...)
+}
+
+// Serve answers DHCPv6 requests until the connection is closed
+func (d *DHCPv6Manager) Serve() {
+	buf := make([]byte, 4096)
+	for {
+		n, addr, err := d.conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			logger.Warn("[DHCPv6] Read error: %v", err)
+			continue
+		}
+
+		reply := d.handle(buf[:n], addr)
+		if reply == nil {
+			continue
+		}
+		if _, err := d.conn.WriteTo(reply, addr); err != nil {
+			logger.Warn("[DHCPv6] Failed to send reply to %s: %v", addr.String(), err)
+		}
+	}
+}
+
+// Close stops the server and waits for lease lookups to finish
+func (d *DHCPv6Manager) Close() error {
+	err := d.conn.Close()
+	d.resolving.Wait()
+	return err
+}
+
+// handle processes one client message and returns the reply to send, or nil to send nothing
+func (d *DHCPv6Manager) handle(packet []byte, src net.Addr) []byte {
+	msg, err := parseDHCPv6Message(packet)
+	if err != nil {
+		logger.Debug("[DHCPv6] Ignoring malformed message from %s: %v", src.String(), err)
+		return nil
+	}
+
+	clientID := msg.option(dhcpv6OptClientID)
+	if clientID == nil && msg.msgType != dhcpv6MsgInformationRequest {
+		logger.Debug("[DHCPv6] Ignoring message type %d without a client ID from %s", msg.msgType, src.String())
+		return nil
+	}
+	// Messages aimed at another server are not ours to answer
+	if serverID := msg.option(dhcpv6OptServerID); serverID != nil && !bytes.Equal(serverID, d.serverDUID) {
+		return nil
+	}
+
+	mac, ok := macFromDUID(clientID)
+	if !ok {
+		if udpAddr, isUDP := src.(*net.UDPAddr); isUDP {
+			mac, ok = macFromLinkLocal(udpAddr.IP)
+		}
+	}
+	if !ok && msg.msgType != dhcpv6MsgInformationRequest {
+		logger.Debug("[DHCPv6] Ignoring message type %d from %s: cannot determine client MAC address", msg.msgType, src.String())
+		return nil
+	}
+
+	reply := &dhcpv6Message{msgType: dhcpv6MsgReply, txID: msg.txID}
+	reply.addOption(dhcpv6OptServerID, d.serverDUID)
+	if clientID != nil {
+		reply.addOption(dhcpv6OptClientID, clientID)
+	}
+
+	switch msg.msgType {
+	case dhcpv6MsgSolicit:
+		metrics.RecordDHCPv6LeaseEvent(metrics.DHCPv6LeaseEventSolicit)
+		if msg.option(dhcpv6OptRapidCommit) != nil {
+			reply.addOption(dhcpv6OptRapidCommit, nil)
+			d.assignAddresses(reply, msg, mac, true)
+		} else {
+			reply.msgType = dhcpv6MsgAdvertise
+			d.assignAddresses(reply, msg, mac, false)
+		}
+	case dhcpv6MsgRequest:
+		if msg.option(dhcpv6OptServerID) == nil {
+			return nil
+		}
+		d.assignAddresses(reply, msg, mac, true)
+	case dhcpv6MsgRenew, dhcpv6MsgRebind:
+		if msg.msgType == dhcpv6MsgRenew && msg.option(dhcpv6OptServerID) == nil {
+			return nil
+		}
+		d.renewAddresses(reply, msg, mac)
+	case dhcpv6MsgRelease:
+		if msg.option(dhcpv6OptServerID) == nil {
+			return nil
+		}
+		d.release(mac)
+		reply.addOption(dhcpv6OptStatusCode, marshalDHCPv6Status(dhcpv6StatusSuccess, "released"))
+	case dhcpv6MsgConfirm:
+		status := uint16(dhcpv6StatusSuccess)
+		for _, ia := range msg.identityAssociations() {
+			for _, addr := range ia.addresses {
+				if !d.prefix.Contains(addr) {
+					status = dhcpv6StatusNotOnLink
+				}
+			}
+		}
+		reply.addOption(dhcpv6OptStatusCode, marshalDHCPv6Status(status, ""))
+	case dhcpv6MsgInformationRequest:
+		// Only configuration options, no addresses
+	default:
+		return nil
+	}
+
+	if len(d.dnsServers) > 0 {
+		dns := make([]byte, 0, 16*len(d.dnsServers))
+		for _, server := range d.dnsServers {
+			dns = append(dns, server.To16()...)
+		}
+		reply.addOption(dhcpv6OptDNSServers, dns)
+	}
+	return reply.marshal()
+}
+
+// assignAddresses answers each IA_NA in the request with the client's address. With commit set
+// the lease is stored and resolved to a VPS; otherwise the address is only offered.
+func (d *DHCPv6Manager) assignAddresses(reply, msg *dhcpv6Message, mac string, commit bool) {
+	ias := msg.identityAssociations()
+	if len(ias) == 0 {
+		reply.addOption(dhcpv6OptStatusCode, marshalDHCPv6Status(dhcpv6StatusNoAddrsAvail, "only IA_NA is supported"))
+		return
+	}
+
+	d.allocMu.Lock()
+	ip, err := d.addressFor(mac)
+	var lease *IPv6Lease
+	if err == nil && commit {
+		lease = d.leases.storeIPv6Lease(mac, ip, time.Now().Add(d.leaseTime))
+	}
+	d.allocMu.Unlock()
+
+	for _, ia := range ias {
+		if err != nil {
+			reply.addOption(dhcpv6OptIANA, marshalIANA(ia.iaid, 0, 0, marshalDHCPv6Status(dhcpv6StatusNoAddrsAvail, err.Error())))
+			continue
+		}
+		reply.addOption(dhcpv6OptIANA, d.iaWithAddress(ia.iaid, ip))
+	}
+	if err != nil {
+		logger.Warn("[DHCPv6] No address available for %s: %v", mac, err)
+		return
+	}
+
+	if lease != nil {
+		metrics.RecordDHCPv6LeaseEvent(metrics.DHCPv6LeaseEventRequest)
+		logger.Info("[DHCPv6] Leased %s to %s", ip.String(), mac)
+		if lease.VPSID == "" {
+			d.resolveLease(mac, ip)
+		}
+	}
+}
+
+// renewAddresses extends the client's lease if it still owns the addresses it asks about
+func (d *DHCPv6Manager) renewAddresses(reply, msg *dhcpv6Message, mac string) {
+	lease := d.leases.ipv6LeaseForMAC(mac)
+	for _, ia := range msg.identityAssociations() {
+		owned := lease != nil
+		for _, addr := range ia.addresses {
+			if lease == nil || !addr.Equal(lease.IP) {
+				owned = false
+			}
+		}
+		if !owned {
+			reply.addOption(dhcpv6OptIANA, marshalIANA(ia.iaid, 0, 0, marshalDHCPv6Status(dhcpv6StatusNoBinding, "no binding for this client")))
+			continue
+		}
+		d.leases.storeIPv6Lease(mac, lease.IP, time.Now().Add(d.leaseTime))
+		reply.addOption(dhcpv6OptIANA, d.iaWithAddress(ia.iaid, lease.IP))
+		metrics.RecordDHCPv6LeaseEvent(metrics.DHCPv6LeaseEventRenew)
+	}
+}
+
+func (d *DHCPv6Manager) release(mac string) {
+	if lease := d.leases.releaseIPv6Lease(mac); lease != nil {
+		metrics.RecordDHCPv6LeaseEvent(metrics.DHCPv6LeaseEventRelease)
+		logger.Info("[DHCPv6] Released %s from %s", lease.IP.String(), mac)
+	}
+}
+
+// iaWithAddress builds an IA_NA holding addr with the lease time as valid lifetime; clients
+// renew at half and rebind at 80% of it
+func (d *DHCPv6Manager) iaWithAddress(iaid [4]byte, addr net.IP) []byte {
+	lifetime := uint32(d.leaseTime / time.Second)
+	return marshalIANA(iaid, lifetime/2, lifetime/10*8, marshalDHCPv6Option(dhcpv6OptIAAddr, marshalIAAddr(addr, lifetime, lifetime)))
+}
+
+// addressFor returns the client's current address, or the next free address in the prefix.
+// The first two addresses (subnet-router anycast and the gateway) are never handed out.
+// Must be called while holding allocMu.
+func (d *DHCPv6Manager) addressFor(mac string) (net.IP, error) {
+	if lease := d.leases.ipv6LeaseForMAC(mac); lease != nil && d.prefix.Contains(lease.IP) {
+		return lease.IP, nil
+	}
+
+	candidate := make(net.IP, net.IPv6len)
+	copy(candidate, d.prefix.IP.To16())
+	for i := 0; i < dhcpv6MaxAllocationScan; i++ {
+		incrementIP(candidate)
+		if !d.prefix.Contains(candidate) {
+			break
+		}
+		if i == 0 {
+			continue // ::1 is the gateway
+		}
+		if !d.leases.ipv6AddressInUse(candidate) {
+			return append(net.IP(nil), candidate...), nil
+		}
+	}
+	return nil, fmt.Errorf("no free address in %s", d.prefix.String())
+}
+
+// resolveLease asks the VPS services which VPS owns the lease, as dnsmasq leases are resolved
+func (d *DHCPv6Manager) resolveLease(mac string, ip net.IP) {
+	d.leases.apiClientMu.RLock()
+	client := d.leases.apiClient
+	d.leases.apiClientMu.RUnlock()
+	if client == nil {
+		return
+	}
+
+	timeout := d.leases.findVPSTimeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	d.resolving.Add(1)
+	go func() {
+		defer d.resolving.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		resp, err := client.FindVPSByLease(ctx, ip.String(), mac)
+		if err != nil || resp == nil || resp.GetVpsId() == "" {
+			logger.Debug("[DHCPv6] Lease %s (MAC %s) not resolved to a VPS: %v", ip.String(), mac, err)
+			return
+		}
+		d.leases.setIPv6LeaseOwner(mac, resp.GetVpsId(), resp.GetOrganizationId())
+		logger.Info("[DHCPv6] Lease %s (MAC %s) belongs to VPS %s", ip.String(), mac, resp.GetVpsId())
+	}()
+}
+
+// ipv6LeaseForMAC returns the unexpired DHCPv6 lease of a MAC address, or nil
+func (m *Manager) ipv6LeaseForMAC(mac string) *IPv6Lease {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	lease, ok := m.ipv6Leases[strings.ToLower(mac)]
+	if !ok || time.Now().After(lease.ExpiresAt) {
+		return nil
+	}
+	copied := *lease
+	return &copied
+}
+
+// ipv6AddressInUse reports whether an unexpired DHCPv6 lease holds ip
+func (m *Manager) ipv6AddressInUse(ip net.IP) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	now := time.Now()
+	for _, lease := range m.ipv6Leases {
+		if lease.IP.Equal(ip) && now.Before(lease.ExpiresAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// storeIPv6Lease records or extends a DHCPv6 lease and sets the IPv6 address of the VPS
+// allocation with the same MAC address. Expired leases are dropped.
+func (m *Manager) storeIPv6Lease(mac string, ip net.IP, expiresAt time.Time) *IPv6Lease {
+	mac = strings.ToLower(mac)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ipv6Leases == nil {
+		m.ipv6Leases = make(map[string]*IPv6Lease)
+	}
+
+	now := time.Now()
+	for leaseMAC, lease := range m.ipv6Leases {
+		if leaseMAC != mac && now.After(lease.ExpiresAt) {
+			delete(m.ipv6Leases, leaseMAC)
+			metrics.RecordDHCPv6LeaseEvent(metrics.DHCPv6LeaseEventExpire)
+		}
+	}
+
+	lease, ok := m.ipv6Leases[mac]
+	if !ok || !lease.IP.Equal(ip) {
+		lease = &IPv6Lease{MAC: mac, IP: ip}
+		m.ipv6Leases[mac] = lease
+	}
+	lease.ExpiresAt = expiresAt
+
+	for _, alloc := range m.allocations {
+		if strings.EqualFold(alloc.MACAddress, mac) {
+			alloc.IPv6Address = ip
+			lease.VPSID, lease.OrganizationID = alloc.VPSID, alloc.OrganizationID
+		}
+	}
+	metrics.SetDHCPv6ActiveLeases(float64(len(m.ipv6Leases)))
+
+	copied := *lease
+	return &copied
+}
+
+// setIPv6LeaseOwner records the VPS a DHCPv6 lease was resolved to
+func (m *Manager) setIPv6LeaseOwner(mac, vpsID, orgID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	lease, ok := m.ipv6Leases[strings.ToLower(mac)]
+	if !ok {
+		return
+	}
+	lease.VPSID, lease.OrganizationID = vpsID, orgID
+	if alloc, exists := m.allocations[vpsID]; exists {
+		alloc.IPv6Address = lease.IP
+	}
+}
+
+// releaseIPv6Lease removes a MAC's DHCPv6 lease and returns it, or nil if it had none
+func (m *Manager) releaseIPv6Lease(mac string) *IPv6Lease {
+	mac = strings.ToLower(mac)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	lease, ok := m.ipv6Leases[mac]
+	if !ok {
+		return nil
+	}
+	delete(m.ipv6Leases, mac)
+	for _, alloc := range m.allocations {
+		if alloc.IPv6Address.Equal(lease.IP) {
+			alloc.IPv6Address = nil
+		}
+	}
+	metrics.SetDHCPv6ActiveLeases(float64(len(m.ipv6Leases)))
+	return lease
+}
+
+// dhcpv6Option is a single option of a DHCPv6 message
+type dhcpv6Option struct {
+	code uint16
+	data []byte
+}
+
+// dhcpv6Message is a client/server DHCPv6 message (relay messages are not supported)
+type dhcpv6Message struct {
+	msgType uint8
+	txID    [3]byte
+	options []dhcpv6Option
+}
+
+// identityAssociation is a parsed IA_NA option
+type identityAssociation struct {
+	iaid      [4]byte
+	addresses []net.IP
+}
+
+func parseDHCPv6Message(packet []byte) (*dhcpv6Message, error) {
+	if len(packet) < dhcpv6HeaderLen {
+		return nil, fmt.Errorf("message too short (%d bytes)", len(packet))
+	}
+	msg := &dhcpv6Message{msgType: packet[0]}
+	copy(msg.txID[:], packet[1:4])
+	options, err := parseDHCPv6Options(packet[dhcpv6HeaderLen:])
+	if err != nil {
+		return nil, err
+	}
+	msg.options = options
+	return msg, nil
+}
+
+func parseDHCPv6Options(data []byte) ([]dhcpv6Option, error) {
+	var options []dhcpv6Option
+	for len(data) > 0 {
+		if len(data) < dhcpv6OptionHeaderLen {
+			return nil, fmt.Errorf("truncated option header")
+		}
+		code := binary.BigEndian.Uint16(data[0:2])
+		length := int(binary.BigEndian.Uint16(data[2:4]))
+		if len(data) < dhcpv6OptionHeaderLen+length {
+			return nil, fmt.Errorf("option %d overruns the message", code)
+		}
+		options = append(options, dhcpv6Option{code: code, data: data[dhcpv6OptionHeaderLen : dhcpv6OptionHeaderLen+length]})
+		data = data[dhcpv6OptionHeaderLen+length:]
+	}
+	return options, nil
+}
+
+// option returns the data of the first option with the given code, or nil if it is absent.
+// Present options without data return an empty, non-nil slice.
+func (m *dhcpv6Message) option(code uint16) []byte {
+	for _, opt := range m.options {
+		if opt.code == code {
+			if opt.data == nil {
+				return []byte{}
+			}
+			return opt.data
+		}
+	}
+	return nil
+}
+
+func (m *dhcpv6Message) addOption(code uint16, data []byte) {
+	m.options = append(m.options, dhcpv6Option{code: code, data: data})
+}
+
+// identityAssociations returns the message's well-formed IA_NA options
+func (m *dhcpv6Message) identityAssociations() []identityAssociation {
+	var ias []identityAssociation
+	for _, opt := range m.options {
+		if opt.code != dhcpv6OptIANA || len(opt.data) < 12 {
+			continue
+		}
+		ia := identityAssociation{}
+		copy(ia.iaid[:], opt.data[0:4])
+		subOptions, err := parseDHCPv6Options(opt.data[12:])
+		if err != nil {
+			continue
+		}
+		for _, sub := range subOptions {
+			if sub.code == dhcpv6OptIAAddr && len(sub.data) >= 24 {
+				ia.addresses = append(ia.addresses, net.IP(append([]byte(nil), sub.data[0:16]...)))
+			}
+		}
+		ias = append(ias, ia)
+	}
+	return ias
+}
+
+func (m *dhcpv6Message) marshal() []byte {
+	out := []byte{m.msgType, m.txID[0], m.txID[1], m.txID[2]}
+	for _, opt := range m.options {
+		out = append(out, marshalDHCPv6Option(opt.code, opt.data)...)
+	}
+	return out
+}
+
+func marshalDHCPv6Option(code uint16, data []byte) []byte {
+	out := make([]byte, dhcpv6OptionHeaderLen, dhcpv6OptionHeaderLen+len(data))
+	binary.BigEndian.PutUint16(out[0:2], code)
+	binary.BigEndian.PutUint16(out[2:4], uint16(len(data)))
+	return append(out, data...)
+}
+
+// marshalIANA builds IA_NA option data; options holds already marshalled sub-options
+func marshalIANA(iaid [4]byte, t1, t2 uint32, options []byte) []byte {
+	out := make([]byte, 12, 12+len(options))
+	copy(out[0:4], iaid[:])
+	binary.BigEndian.PutUint32(out[4:8], t1)
+	binary.BigEndian.PutUint32(out[8:12], t2)
+	return append(out, options...)
+}
+
+func marshalIAAddr(addr net.IP, preferred, valid uint32) []byte {
+	out := make([]byte, 24)
+	copy(out[0:16], addr.To16())
+	binary.BigEndian.PutUint32(out[16:20], preferred)
+	binary.BigEndian.PutUint32(out[20:24], valid)
+	return out
+}
+
+// marshalDHCPv6Status builds a complete status code option
+func marshalDHCPv6Status(code uint16, message string) []byte {
+	data := make([]byte, 2, 2+len(message))
+	binary.BigEndian.PutUint16(data, code)
+	return marshalDHCPv6Option(dhcpv6OptStatusCode, append(data, message...))
+}
+
+// macFromDUID extracts the Ethernet address from a DUID-LLT or DUID-LL
+func macFromDUID(duid []byte) (string, bool) {
+	if len(duid) < 4 || binary.BigEndian.Uint16(duid[2:4]) != hwTypeEthernet {
+		return "", false
+	}
+	var hwAddr []byte
+	switch binary.BigEndian.Uint16(duid[0:2]) {
+	case duidTypeLLT:
+		if len(duid) != 14 {
+			return "", false
+		}
+		hwAddr = duid[8:14]
+	case duidTypeLL:
+		if len(duid) != 10 {
+			return "", false
+		}
+		hwAddr = duid[4:10]
+	default:
+		return "", false
+	}
+	return net.HardwareAddr(hwAddr).String(), true
+}
+
+// macFromLinkLocal recovers the MAC address from an EUI-64 link-local address
+func macFromLinkLocal(ip net.IP) (string, bool) {
+	ip = ip.To16()
+	if ip == nil || !ip.IsLinkLocalUnicast() || ip.To4() != nil || ip[11] != 0xff || ip[12] != 0xfe {
+		return "", false
+	}
+	mac := net.HardwareAddr{ip[8] ^ 0x02, ip[9], ip[10], ip[13], ip[14], ip[15]}
+	return mac.String(), true
+}
+
+// incrementIP adds one to an IP address in place
+func incrementIP(ip net.IP) {
+	for i := len(ip) - 1; i >= 0; i-- {
+		ip[i]++
+		if ip[i] != 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package dhcp
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"vps-gateway/internal/metrics"
+
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+)
+
+// fakePacketConn is an in-memory UDP listener: packets sent to in are read by the server and
+// replies are delivered on out
+type fakePacketConn struct {
+	in     chan fakePacket
+	out    chan fakePacket
+	closed chan struct{}
+}
+
+type fakePacket struct {
+	data []byte
+	addr net.Addr
+}
+
+func newFakePacketConn() *fakePacketConn {
+	return &fakePacketConn{in: make(chan fakePacket, 4), out: make(chan fakePacket, 4), closed: make(chan struct{})}
+}
+
+func (c *fakePacketConn) ReadFrom(p []byte) (int, net.Addr, error) {
+	select {
+	case pkt := <-c.in:
+		return copy(p, pkt.data), pkt.addr, nil
+	case <-c.closed:
+		return 0, nil, net.ErrClosed
+	}
+}
+
+func (c *fakePacketConn) WriteTo(p []byte, addr net.Addr) (int, error) {
+	c.out <- fakePacket{data: append([]byte(nil), p...), addr: addr}
+	return len(p), nil
+}
+
+func (c *fakePacketConn) Close() error {
+	close(c.closed)
+	return nil
+}
+
+func (c *fakePacketConn) LocalAddr() net.Addr {
+	return &net.UDPAddr{IP: net.IPv6unspecified, Port: dhcpv6DefaultPort}
+}
+func (c *fakePacketConn) SetDeadline(t time.Time) error      { return nil }
+func (c *fakePacketConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *fakePacketConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// exchange sends a client message to the server and returns its parsed reply
+func (c *fakePacketConn) exchange(t *testing.T, msg *dhcpv6Message) *dhcpv6Message {
+	t.Helper()
+	client := &net.UDPAddr{IP: net.ParseIP("fe80::5054:ff:feaa:bbcc"), Port: 546}
+	c.in <- fakePacket{data: msg.marshal(), addr: client}
+	select {
+	case pkt := <-c.out:
+		if pkt.addr.String() != client.String() {
+			t.Fatalf("reply sent to %s, want %s", pkt.addr, client)
+		}
+		reply, err := parseDHCPv6Message(pkt.data)
+		if err != nil {
+			t.Fatalf("failed to parse reply: %v", err)
+		}
+		return reply
+	case <-time.After(2 * time.Second):
+		t.Fatalf("no reply to message type %d", msg.msgType)
+		return nil
+	}
+}
+
+func dhcpv6Events(t *testing.T, event string) float64 {
+	return gatheredValue(t, "gateway_dhcpv6_leases_total", map[string]string{"event": event})
+}
+
+func TestDHCPv6SolicitRequestRelease(t *testing.T) {
+	metrics.Init()
+
+	const mac = "52:54:00:aa:bb:cc"
+	leases := newTestManager(t)
+	// The allocation's MAC is not known yet, so the lease is matched to it through FindVPSByLease
+	leases.allocations["vps-1"] = &Allocation{VPSID: "vps-1", OrganizationID: "org-1", IPAddress: net.ParseIP("10.15.3.20")}
+	api := &fakeLeaseAPI{responses: map[string]*vpsv1.FindVPSByLeaseResponse{
+		"fd00::2": {VpsId: "vps-1", OrganizationId: "org-1"},
+	}}
+	leases.SetAPIClient(api)
+
+	prefix, err := parseIPv6Prefix("fd00::/48")
+	if err != nil {
+		t.Fatalf("parseIPv6Prefix: %v", err)
+	}
+	conn := newFakePacketConn()
+	server := newDHCPv6Manager(conn, leases, prefix, serverDUID(net.HardwareAddr{0x02, 0, 0, 0, 0, 1}), []net.IP{net.ParseIP("2606:4700:4700::1111")}, time.Hour)
+	go server.Serve()
+
+	solicits := dhcpv6Events(t, metrics.DHCPv6LeaseEventSolicit)
+	requests := dhcpv6Events(t, metrics.DHCPv6LeaseEventRequest)
+	releases := dhcpv6Events(t, metrics.DHCPv6LeaseEventRelease)
+
+	// The client identifies itself with a DUID-LLT, so the lease is keyed by its MAC address
+	clientDUID := []byte{0, duidTypeLLT, 0, hwTypeEthernet, 0x2a, 0x2b, 0x2c, 0x2d, 0x52, 0x54, 0x00, 0xaa, 0xbb, 0xcc}
+	iaid := [4]byte{0, 0, 0, 1}
+
+	solicit := &dhcpv6Message{msgType: dhcpv6MsgSolicit, txID: [3]byte{1, 2, 3}}
+	solicit.addOption(dhcpv6OptClientID, clientDUID)
+	solicit.addOption(dhcpv6OptIANA, marshalIANA(iaid, 0, 0, nil))
+	advertise := conn.exchange(t, solicit)
+	if advertise.msgType != dhcpv6MsgAdvertise || advertise.txID != solicit.txID {
+		t.Fatalf("SOLICIT answered with type %d txid %v, want ADVERTISE with the same txid", advertise.msgType, advertise.txID)
+	}
+	offered := advertise.identityAssociations()
+	if len(offered) != 1 || len(offered[0].addresses) != 1 || !offered[0].addresses[0].Equal(net.ParseIP("fd00::2")) {
+		t.Fatalf("ADVERTISE offered %+v, want fd00::2", offered)
+	}
+	if leases.ipv6LeaseForMAC(mac) != nil {
+		t.Fatal("ADVERTISE must not commit a lease")
+	}
+
+	request := &dhcpv6Message{msgType: dhcpv6MsgRequest, txID: [3]byte{4, 5, 6}}
+	request.addOption(dhcpv6OptClientID, clientDUID)
+	request.addOption(dhcpv6OptServerID, advertise.option(dhcpv6OptServerID))
+	request.addOption(dhcpv6OptIANA, marshalIANA(iaid, 0, 0, marshalDHCPv6Option(dhcpv6OptIAAddr, marshalIAAddr(offered[0].addresses[0], 0, 0))))
+	reply := conn.exchange(t, request)
+	if reply.msgType != dhcpv6MsgReply {
+		t.Fatalf("REQUEST answered with type %d, want REPLY", reply.msgType)
+	}
+	if dns := reply.option(dhcpv6OptDNSServers); !net.IP(dns).Equal(net.ParseIP("2606:4700:4700::1111")) {
+		t.Fatalf("REPLY DNS servers = %v, want 2606:4700:4700::1111", net.IP(dns))
+	}
+
+	// Stop serving so the lease lookup has finished before checking the owner
+	server.Close()
+	lease := leases.ipv6LeaseForMAC(mac)
+	if lease == nil || !lease.IP.Equal(net.ParseIP("fd00::2")) || lease.VPSID != "vps-1" || lease.OrganizationID != "org-1" {
+		t.Fatalf("lease = %+v, want fd00::2 owned by vps-1", lease)
+	}
+	if !leases.allocations["vps-1"].IPv6Address.Equal(lease.IP) {
+		t.Fatalf("allocation IPv6 address = %v, want %v", leases.allocations["vps-1"].IPv6Address, lease.IP)
+	}
+
+	// A second client gets the next address in the prefix
+	other, err := server.addressFor("52:54:00:dd:ee:ff")
+	if err != nil || !other.Equal(net.ParseIP("fd00::3")) {
+		t.Fatalf("addressFor second client = %v (%v), want fd00::3", other, err)
+	}
+
+	release := &dhcpv6Message{msgType: dhcpv6MsgRelease}
+	release.addOption(dhcpv6OptClientID, clientDUID)
+	release.addOption(dhcpv6OptServerID, server.serverDUID)
+	if server.handle(release.marshal(), &net.UDPAddr{IP: net.ParseIP("fd00::2")}) == nil {
+		t.Fatal("RELEASE was not answered")
+	}
+	if leases.ipv6LeaseForMAC(mac) != nil || leases.allocations["vps-1"].IPv6Address != nil {
+		t.Fatal("RELEASE left the lease in place")
+	}
+
+	if got := dhcpv6Events(t, metrics.DHCPv6LeaseEventSolicit) - solicits; got != 1 {
+		t.Fatalf("solicit events = %v, want 1", got)
+	}
+	if got := dhcpv6Events(t, metrics.DHCPv6LeaseEventRequest) - requests; got != 1 {
+		t.Fatalf("request events = %v, want 1", got)
+	}
+	if got := dhcpv6Events(t, metrics.DHCPv6LeaseEventRelease) - releases; got != 1 {
+		t.Fatalf("release events = %v, want 1", got)
+	}
+}
+
+func TestDHCPv6IgnoresOtherServers(t *testing.T) {
+	prefix, _ := parseIPv6Prefix("fd00::/48")
+	server := newDHCPv6Manager(newFakePacketConn(), newTestManager(t), prefix, serverDUID(net.HardwareAddr{0x02, 0, 0, 0, 0, 1}), nil, time.Hour)
+
+	request := &dhcpv6Message{msgType: dhcpv6MsgRequest}
+	request.addOption(dhcpv6OptClientID, []byte{0, duidTypeLL, 0, hwTypeEthernet, 0x52, 0x54, 0x00, 0xaa, 0xbb, 0xcc})
+	request.addOption(dhcpv6OptServerID, serverDUID(net.HardwareAddr{0x02, 0, 0, 0, 0, 2}))
+	request.addOption(dhcpv6OptIANA, marshalIANA([4]byte{1}, 0, 0, nil))
+	if reply := server.handle(request.marshal(), &net.UDPAddr{IP: net.ParseIP("fe80::1")}); reply != nil {
+		t.Fatalf("answered a REQUEST for another server: %x", reply)
+	}
+}
+
+func TestMACFromLinkLocal(t *testing.T) {
+	mac, ok := macFromLinkLocal(net.ParseIP("fe80::5054:ff:feaa:bbcc"))
+	if !ok || mac != "52:54:00:aa:bb:cc" {
+		t.Fatalf("macFromLinkLocal = %q, %v; want 52:54:00:aa:bb:cc", mac, ok)
+	}
+	if _, ok := macFromLinkLocal(net.ParseIP("fd00::2")); ok {
+		t.Fatal("macFromLinkLocal accepted a non link-local address")
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"vps-gateway/internal/logger"
+	"vps-gateway/internal/metrics"
 
 	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
 )
@@ -27,6 +28,7 @@ type Manager struct {
 	gateway            net.IP
 	listenIP           net.IP // IP address to listen on (for multi-node support)
 	dnsServers         []net.IP
+	domainName         string // Sent to clients as DHCP option 15
 	interfaceName      string
 	leasesFile         string
 	hostsFile          string
@@ -43,6 +45,10 @@ type Manager struct {
 	reconcileInterval  time.Duration // Interval for background reconciliation
 	apiClient          APIClient     // API client for bidirectional stream communication
 	apiClientMu        sync.RWMutex  // Protects API client access
+	bandwidth          BandwidthLimiter  // Optional per-lease bandwidth shaping
+	shapedLeases       map[string]string // lease IP -> MAC of leases whose bandwidth limits were applied
+	bandwidthMu        sync.Mutex        // Protects bandwidth and shapedLeases
+	ipv6Leases         map[string]*IPv6Lease // MAC -> DHCPv6 lease, guarded by mu
 }
 
 // APIClient interface defines the methods needed from the API client
@@ -58,6 +64,10 @@ type Allocation struct {
 	MACAddress     string
 	AllocatedAt    time.Time
 	LeaseExpires   time.Time
+	MaxMbitDown    int  // Download limit applied by the bandwidth shaper (0 = unlimited)
+	MaxMbitUp      int  // Upload limit applied by the bandwidth shaper (0 = unlimited)
+	LeaseAcked     bool   // dnsmasq has acknowledged a lease for this allocation
+	IPv6Address    net.IP // Address leased by the DHCPv6 server, if any
 }
 
 // LeaseInfo represents an active DHCP lease from dnsmasq
@@ -76,6 +86,7 @@ type Config struct {
 	Gateway              string
 	ListenIP             string        // IP to listen on (optional, defaults to gateway IP)
 	DNSServers           string        // Comma-separated
+	DomainName           string
 	Interface            string
 	LeasesDir            string
 	AllocationTTL        time.Duration // TTL for allocations without active leases
@@ -113,7 +124,8 @@ func NewManager() (*Manager, error) {
 		SubnetMask:        os.Getenv("GATEWAY_DHCP_SUBNET_MASK"),
 		Gateway:           os.Getenv("GATEWAY_DHCP_GATEWAY"),
 		ListenIP:          os.Getenv("GATEWAY_DHCP_LISTEN_IP"), // Optional: IP to listen on (for multi-node)
-		DNSServers:        os.Getenv("GATEWAY_DHCP_DNS"),
+		DNSServers:        os.Getenv("GATEWAY_DNS_SERVERS"),
+		DomainName:        os.Getenv("GATEWAY_DOMAIN_NAME"),
 		Interface:         os.Getenv("GATEWAY_DHCP_INTERFACE"),
 		LeasesDir:         os.Getenv("GATEWAY_DHCP_LEASES_DIR"),
 		AllocationTTL:     allocationTTL,
@@ -126,6 +138,16 @@ func NewManager() (*Manager, error) {
 	if config.LeasesDir == "" {
 		config.LeasesDir = "/var/lib/obiente/vps-gateway"
 	}
+	// GATEWAY_DHCP_DNS and GATEWAY_DHCP_DOMAIN are the older names of these settings
+	if config.DNSServers == "" {
+		config.DNSServers = os.Getenv("GATEWAY_DHCP_DNS")
+	}
+	if config.DomainName == "" {
+		config.DomainName = os.Getenv("GATEWAY_DHCP_DOMAIN")
+	}
+	if config.DomainName == "" {
+		config.DomainName = "vps.local"
+	}
 
 	// Validate required config
 	if config.PoolStart == "" || config.PoolEnd == "" {
@@ -203,9 +225,15 @@ func NewManager() (*Manager, error) {
 	if config.DNSServers != "" {
 		for _, dns := range strings.Split(config.DNSServers, ",") {
 			dns = strings.TrimSpace(dns)
-			if ip := net.ParseIP(dns); ip != nil {
-				dnsServers = append(dnsServers, ip)
+			if dns == "" {
+				continue
+			}
+			ip := net.ParseIP(dns)
+			if ip == nil || ip.To4() == nil {
+				logger.Warn("Ignoring invalid IPv4 DNS server '%s'", dns)
+				continue
 			}
+			dnsServers = append(dnsServers, ip.To4())
 		}
 	}
 	if len(dnsServers) == 0 {
@@ -228,6 +256,7 @@ func NewManager() (*Manager, error) {
 		gateway:           gateway,
 		listenIP:          listenIP,
 		dnsServers:        dnsServers,
+		domainName:        config.DomainName,
 		interfaceName:     config.Interface,
 		hostsFile:         hostsFile,
 		leasesFile:        leasesFile,
@@ -368,6 +397,7 @@ func (m *Manager) AllocateIP(ctx context.Context, vpsID, orgID, macAddress, pref
 
 	// Create allocation using helper
 	alloc := m.addOrUpdateAllocation(vpsID, orgID, ip, macAddress)
+	metrics.RecordDHCPLeaseEvent(metrics.DHCPLeaseEventDiscover)
 
 	// For public IPs (outside DHCP pool), skip dnsmasq configuration
 	// Public IPs are statically configured on the VPS, not via DHCP
@@ -412,9 +442,11 @@ func (m *Manager) ReleaseIP(ctx context.Context, vpsID, ipAddress string) error
 	}
 
 	m.removeAllocationByVPSID(vpsID)
+	metrics.RecordDHCPLeaseEvent(metrics.DHCPLeaseEventRelease)
 
 	// Sync hosts file
 	m.mu.Unlock()
+	m.removeLeaseBandwidth(alloc.IPAddress.String())
 	if err := m.syncHostsFileFromAllocations(); err != nil {
 		logger.Warn("[ReleaseIP] Failed to sync hosts file: %v", err)
 	}
@@ -715,11 +747,8 @@ func (m *Manager) generateDNSMasqConfig(configFile string) error {
 	// DNS server configuration
 	// Enable DNS server on port 53
 	writer.WriteString("port=53\n")
-	// Set domain for VPS network (optional, can be configured via env var)
-	domain := os.Getenv("GATEWAY_DHCP_DOMAIN")
-	if domain == "" {
-		domain = "vps.local" // Default domain
-	}
+	// Set domain for VPS network
+	domain := m.domainName // GATEWAY_DOMAIN_NAME, defaults to vps.local
 	writer.WriteString(fmt.Sprintf("domain=%s\n", domain))
 	// Enable hostname expansion (allows hostname.domain resolution)
 	writer.WriteString("expand-hosts\n")
@@ -741,20 +770,17 @@ func (m *Manager) generateDNSMasqConfig(configFile string) error {
 	// Convert IPMask back to dotted decimal format
 	maskIP := net.IP(m.subnetMask)
 	netmaskStr := maskIP.String()
-	writer.WriteString(fmt.Sprintf("dhcp-range=%s,%s,%s,12h\n", m.poolStart.String(), m.poolEnd.String(), netmaskStr))
-	writer.WriteString(fmt.Sprintf("dhcp-option=option:router,%s\n", m.gateway.String()))
-
-	// DNS servers (for upstream DNS resolution)
-	// Combine all DNS servers into a single dhcp-option line (option 6 = DNS)
-	if len(m.dnsServers) > 0 {
-		dnsList := make([]string, len(m.dnsServers))
-		for i, dns := range m.dnsServers {
-			dnsList[i] = dns.String()
-			// Also add as upstream DNS server for dnsmasq itself
-			writer.WriteString(fmt.Sprintf("server=%s\n", dns.String()))
+	writer.WriteString(fmt.Sprintf("dhcp-range=%s,%s,%s,%d\n", m.poolStart.String(), m.poolEnd.String(), netmaskStr, int(dhcpLeaseTime.Seconds())))
+
+	// Upstream DNS resolution for dnsmasq itself
+	for _, dns := range m.dnsServers {
+		writer.WriteString(fmt.Sprintf("server=%s\n", dns.String()))
+	}
+	// Router, DNS servers (option 6) and domain name (option 15) sent with every OFFER and ACK
+	for _, option := range m.BuildDHCPOptions() {
+		if line, ok := option.dnsmasqOption(); ok {
+			writer.WriteString(line + "\n")
 		}
-		// Format: dhcp-option=6,1.1.1.1,1.0.0.1 (option number, comma-separated DNS servers)
-		writer.WriteString(fmt.Sprintf("dhcp-option=6,%s\n", strings.Join(dnsList, ",")))
 	}
 
 	// File paths
@@ -796,11 +822,11 @@ func (m *Manager) reloadDNSMasq() error {
 	return nil
 }
 
+// saveAllocations persists the allocations map
+// Must be called while holding m.mu lock
 func (m *Manager) saveAllocations() error {
 	// The allocations map is the source of truth
 	// Syncing hosts file ensures it's always up to date
-	m.mu.RLock()
-	defer m.mu.RUnlock()
 	return m.syncHostsFileFromAllocations()
 }
 
@@ -970,6 +996,7 @@ func (m *Manager) syncWithLeases() error {
 	if err := scanner.Err(); err != nil {
 		return fmt.Errorf("failed to read leases file: %w", err)
 	}
+	metrics.SetDHCPActiveLeases(float64(len(leaseMap)))
 
 	// Update allocations with actual lease information
 	// Build an ip->lease map to support matching by IP when MAC is not present
@@ -995,7 +1022,11 @@ func (m *Manager) syncWithLeases() error {
 					alloc.IPAddress = lease.ip
 					updated = true
 				}
-				// Update lease expiry
+				// A new or extended expiry means dnsmasq granted or renewed the lease
+				if alloc.LeaseExpires.Unix() != lease.expires {
+					metrics.RecordDHCPLeaseEvent(metrics.DHCPLeaseEventRequest)
+				}
+				m.markLeaseAcked(alloc)
 				alloc.LeaseExpires = time.Unix(lease.expires, 0)
 				continue
 			}
@@ -1020,6 +1051,10 @@ func (m *Manager) syncWithLeases() error {
 				}
 
 				// Update lease expiry
+				if alloc.LeaseExpires.Unix() != lease.expires {
+					metrics.RecordDHCPLeaseEvent(metrics.DHCPLeaseEventRequest)
+				}
+				m.markLeaseAcked(alloc)
 				alloc.LeaseExpires = time.Unix(lease.expires, 0)
 				updated = true
 			} else {
@@ -1051,6 +1086,7 @@ func (m *Manager) syncWithLeases() error {
 		)
 
 		delete(m.allocations, vpsID)
+		metrics.RecordDHCPLeaseEvent(metrics.DHCPLeaseEventExpire)
 		updated = true
 		// NOTE: Lease release handled by SyncAllocations stream
 		logger.Debug("Removed stale allocation for VPS %s", vpsID)
@@ -1124,6 +1160,51 @@ func (m *Manager) GetActiveLeases() ([]LeaseInfo, error) {
 	return leases, nil
 }
 
+// ActiveLeaseIPs returns the addresses of the active leases in the dnsmasq lease file
+// Used by network.SNATManager to restore SNAT rules after a restart
+func (m *Manager) ActiveLeaseIPs() ([]string, error) {
+	leases, err := m.GetActiveLeases()
+	if err != nil {
+		return nil, err
+	}
+	ips := make([]string, 0, len(leases))
+	for _, lease := range leases {
+		ips = append(ips, lease.IP.String())
+	}
+	return ips, nil
+}
+
+// ReapplyLeases reloads allocations from the persisted hosts and lease files and has dnsmasq serve them
+// Called when the gateway becomes the VRRP master and takes over DHCP from its peer
+func (m *Manager) ReapplyLeases() error {
+	m.mu.Lock()
+	err := m.loadAllocations()
+	m.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to load allocations: %w", err)
+	}
+
+	if err := m.syncWithLeases(); err != nil {
+		return fmt.Errorf("failed to sync with leases: %w", err)
+	}
+
+	m.mu.RLock()
+	err = m.syncHostsFileFromAllocations()
+	count := len(m.allocations)
+	m.mu.RUnlock()
+	if err != nil {
+		return fmt.Errorf("failed to sync hosts file: %w", err)
+	}
+
+	m.reconcileBandwidth()
+
+	if err := m.reloadDNSMasq(); err != nil {
+		return fmt.Errorf("failed to reload dnsmasq: %w", err)
+	}
+	logger.Info("Re-applied %d DHCP allocations from the lease store", count)
+	return nil
+}
+
 // backgroundReconciler periodically syncs with dnsmasq leases and prunes
 // allocations for VPS instances that no longer exist in the VPS Service.
 func (m *Manager) backgroundReconciler() {
@@ -1145,6 +1226,9 @@ func (m *Manager) backgroundReconciler() {
 			continue
 		}
 
+		// Apply bandwidth limits to new leases and lift them from expired ones
+		m.reconcileBandwidth()
+
 		// Check allocations against VPS Service and remove ones that are deleted
 		// Also enforce TTL to prevent unbounded memory growth
 		m.mu.Lock()
@@ -1178,6 +1262,7 @@ func (m *Manager) backgroundReconciler() {
 		for _, vpsID := range toRemove {
 			alloc := m.allocations[vpsID]
 			delete(m.allocations, vpsID)
+			metrics.RecordDHCPLeaseEvent(metrics.DHCPLeaseEventExpire)
 			logger.Info("Pruned allocation for deleted VPS: %s (ip=%s)", vpsID, alloc.IPAddress.String())
 			// NOTE: Database cleanup handled by SyncAllocations stream
 		}
@@ -1220,6 +1305,7 @@ func (m *Manager) AddStaticDHCPLease(ctx context.Context, macAddress, ipAddress,
 		return fmt.Errorf("failed to sync hosts file: %w", err)
 	}
 	m.mu.Lock()
+	metrics.RecordDHCPLeaseEvent(metrics.DHCPLeaseEventDiscover)
 	logger.Info("Added static DHCP lease: MAC=%s IP=%s VPSID=%s is_public=%v", macAddress, ipAddress, vpsID, isPublic)
 	return nil
 }
@@ -1237,6 +1323,7 @@ func (m *Manager) RemoveStaticDHCPLease(ctx context.Context, macAddress, ipAddre
 		return fmt.Errorf("allocation mismatch for VPSID %s: expected MAC %s IP %s, got MAC %s IP %s", vpsID, macAddress, ipAddress, alloc.MACAddress, alloc.IPAddress.String())
 	}
 	delete(m.allocations, vpsID)
+	metrics.RecordDHCPLeaseEvent(metrics.DHCPLeaseEventRelease)
 	m.mu.Unlock()
 	if err := m.syncHostsFileFromAllocations(); err != nil {
 		m.mu.Lock()
@@ -1267,6 +1354,7 @@ func (m *Manager) RegisterLeaseDirectly(ctx context.Context, vpsID, orgID string
 	
 	// Add allocation using helper
 	m.addOrUpdateAllocation(vpsID, orgID, ipAddress, macAddress)
+	metrics.RecordDHCPLeaseEvent(metrics.DHCPLeaseEventDiscover)
 	m.mu.Unlock()
 	
 	// Sync hosts file
@@ -1344,6 +1432,17 @@ func (m *Manager) addOrUpdateAllocation(vpsID, organizationID string, ipAddress
 	return alloc
 }
 
+// markLeaseAcked counts an allocation once dnsmasq has acknowledged its first lease
+// Reserving an IP (AllocateIP) is only an offer; the VM may never request it
+// Must be called while holding m.mu lock
+func (m *Manager) markLeaseAcked(alloc *Allocation) {
+	if alloc.LeaseAcked {
+		return
+	}
+	alloc.LeaseAcked = true
+	metrics.RecordDHCPAllocation(alloc.OrganizationID)
+}
+
 // removeAllocationByVPSID removes an allocation from the map
 // Must be called while holding m.mu lock
 func (m *Manager) removeAllocationByVPSID(vpsID string) {
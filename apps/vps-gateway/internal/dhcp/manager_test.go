@@ -0,0 +1,247 @@
+package dhcp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"vps-gateway/internal/metrics"
+
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func newTestManager(t *testing.T) *Manager {
+	t.Helper()
+	dir := t.TempDir()
+	return &Manager{
+		poolStart:     net.ParseIP("10.15.3.20").To4(),
+		poolEnd:       net.ParseIP("10.15.3.30").To4(),
+		subnetMask:    net.CIDRMask(24, 32),
+		gateway:       net.ParseIP("10.15.3.1").To4(),
+		leasesFile:    filepath.Join(dir, "dnsmasq.leases"),
+		hostsFile:     filepath.Join(dir, "hosts"),
+		allocations:   make(map[string]*Allocation),
+		allocationTTL: time.Hour,
+	}
+}
+
+// gatheredValue returns the current value of a registered counter or gauge
+func gatheredValue(t *testing.T, name string, labels map[string]string) float64 {
+	t.Helper()
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, mf := range families {
+		if mf.GetName() != name {
+			continue
+		}
+	metricLoop:
+		for _, m := range mf.GetMetric() {
+			for _, lp := range m.GetLabel() {
+				if labels[lp.GetName()] != lp.GetValue() {
+					continue metricLoop
+				}
+			}
+			if m.GetCounter() != nil {
+				return m.GetCounter().GetValue()
+			}
+			return m.GetGauge().GetValue()
+		}
+	}
+	return 0
+}
+
+func leaseEvents(t *testing.T, event string) float64 {
+	return gatheredValue(t, "gateway_dhcp_leases_total", map[string]string{"event": event})
+}
+
+func allocationsTotal(t *testing.T, orgID string) float64 {
+	return gatheredValue(t, "vps_gateway_dhcp_allocations_total", map[string]string{"organization_id": orgID})
+}
+
+func TestDHCPLeaseLifecycleMetrics(t *testing.T) {
+	// Init is called by main as well; registering twice must not panic
+	metrics.Init()
+	metrics.Init()
+
+	ctx := context.Background()
+	m := newTestManager(t)
+	const mac = "52:54:00:aa:bb:cc"
+
+	discover := leaseEvents(t, metrics.DHCPLeaseEventDiscover)
+	allocations := allocationsTotal(t, "org-1")
+	alloc, err := m.AllocateIP(ctx, "vps-1", "org-1", mac, "", false)
+	if err != nil {
+		t.Fatalf("AllocateIP failed: %v", err)
+	}
+	if got := leaseEvents(t, metrics.DHCPLeaseEventDiscover); got != discover+1 {
+		t.Fatalf("discover events = %v, want %v", got, discover+1)
+	}
+	// Reserving an IP is not an allocation until dnsmasq acknowledges the lease
+	if got := allocationsTotal(t, "org-1"); got != allocations {
+		t.Fatalf("allocations before ACK = %v, want %v", got, allocations)
+	}
+
+	// Allocating again for the same VPS must not count as a new discover
+	if _, err := m.AllocateIP(ctx, "vps-1", "org-1", mac, "", false); err != nil {
+		t.Fatalf("AllocateIP (repeat) failed: %v", err)
+	}
+	if got := leaseEvents(t, metrics.DHCPLeaseEventDiscover); got != discover+1 {
+		t.Fatalf("discover events after repeat = %v, want %v", got, discover+1)
+	}
+
+	// dnsmasq grants the lease
+	request := leaseEvents(t, metrics.DHCPLeaseEventRequest)
+	expiry := time.Now().Add(time.Hour).Unix()
+	writeLeases(t, m.leasesFile, fmt.Sprintf("%d %s %s vps-1 *\n", expiry, mac, alloc.IPAddress))
+	if err := m.syncWithLeases(); err != nil {
+		t.Fatalf("syncWithLeases failed: %v", err)
+	}
+	if got := leaseEvents(t, metrics.DHCPLeaseEventRequest); got != request+1 {
+		t.Fatalf("request events = %v, want %v", got, request+1)
+	}
+	if got := gatheredValue(t, "gateway_dhcp_active_leases", nil); got != 1 {
+		t.Fatalf("active leases = %v, want 1", got)
+	}
+	if got := allocationsTotal(t, "org-1"); got != allocations+1 {
+		t.Fatalf("allocations after ACK = %v, want %v", got, allocations+1)
+	}
+
+	// An unchanged lease is not a new request; a renewal is
+	if err := m.syncWithLeases(); err != nil {
+		t.Fatalf("syncWithLeases failed: %v", err)
+	}
+	if got := leaseEvents(t, metrics.DHCPLeaseEventRequest); got != request+1 {
+		t.Fatalf("request events after unchanged sync = %v, want %v", got, request+1)
+	}
+	writeLeases(t, m.leasesFile, fmt.Sprintf("%d %s %s vps-1 *\n", expiry+600, mac, alloc.IPAddress))
+	if err := m.syncWithLeases(); err != nil {
+		t.Fatalf("syncWithLeases failed: %v", err)
+	}
+	if got := leaseEvents(t, metrics.DHCPLeaseEventRequest); got != request+2 {
+		t.Fatalf("request events after renewal = %v, want %v", got, request+2)
+	}
+	if got := allocationsTotal(t, "org-1"); got != allocations+1 {
+		t.Fatalf("allocations after renewal = %v, want %v", got, allocations+1)
+	}
+
+	release := leaseEvents(t, metrics.DHCPLeaseEventRelease)
+	if err := m.ReleaseIP(ctx, "vps-1", alloc.IPAddress.String()); err != nil {
+		t.Fatalf("ReleaseIP failed: %v", err)
+	}
+	if got := leaseEvents(t, metrics.DHCPLeaseEventRelease); got != release+1 {
+		t.Fatalf("release events = %v, want %v", got, release+1)
+	}
+
+	// A pool allocation whose lease disappears from dnsmasq expires
+	expire := leaseEvents(t, metrics.DHCPLeaseEventExpire)
+	if _, err := m.AllocateIP(ctx, "vps-2", "org-1", "52:54:00:dd:ee:ff", "", false); err != nil {
+		t.Fatalf("AllocateIP failed: %v", err)
+	}
+	writeLeases(t, m.leasesFile, "")
+	if err := m.syncWithLeases(); err != nil {
+		t.Fatalf("syncWithLeases failed: %v", err)
+	}
+	if got := leaseEvents(t, metrics.DHCPLeaseEventExpire); got != expire+1 {
+		t.Fatalf("expire events = %v, want %v", got, expire+1)
+	}
+	if got := gatheredValue(t, "gateway_dhcp_active_leases", nil); got != 0 {
+		t.Fatalf("active leases = %v, want 0", got)
+	}
+}
+
+func writeLeases(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write leases file: %v", err)
+	}
+}
+
+type fakeLeaseAPI struct {
+	responses map[string]*vpsv1.FindVPSByLeaseResponse // ip -> response
+}
+
+func (f *fakeLeaseAPI) FindVPSByLease(ctx context.Context, ip string, mac string) (*vpsv1.FindVPSByLeaseResponse, error) {
+	if resp, ok := f.responses[ip]; ok {
+		return resp, nil
+	}
+	return &vpsv1.FindVPSByLeaseResponse{}, nil
+}
+
+type fakeLimiter struct {
+	limits map[string][2]int
+}
+
+func (f *fakeLimiter) ApplyBandwidth(leaseIP string, downMbit, upMbit int) error {
+	f.limits[leaseIP] = [2]int{downMbit, upMbit}
+	return nil
+}
+
+func (f *fakeLimiter) RemoveBandwidth(leaseIP string) error {
+	delete(f.limits, leaseIP)
+	return nil
+}
+
+func TestReconcileBandwidth(t *testing.T) {
+	m := newTestManager(t)
+	limiter := &fakeLimiter{limits: make(map[string][2]int)}
+	api := &fakeLeaseAPI{responses: make(map[string]*vpsv1.FindVPSByLeaseResponse)}
+	m.apiClient = api
+	m.SetBandwidthLimiter(limiter)
+
+	const mac = "52:54:00:aa:bb:cc"
+	expiry := time.Now().Add(time.Hour).Unix()
+	writeLeases(t, m.leasesFile, fmt.Sprintf("%d %s 10.15.3.25 vps-1 *\n", expiry, mac))
+
+	// Unresolved leases are not shaped and are retried on the next pass
+	m.reconcileBandwidth()
+	if len(limiter.limits) != 0 {
+		t.Fatalf("expected no limits for unresolved lease, got %v", limiter.limits)
+	}
+
+	api.responses["10.15.3.25"] = &vpsv1.FindVPSByLeaseResponse{VpsId: "vps-1", OrganizationId: "org-1", MaxMbitDown: 100, MaxMbitUp: 50}
+	m.reconcileBandwidth()
+	if got := limiter.limits["10.15.3.25"]; got != [2]int{100, 50} {
+		t.Fatalf("limits = %v, want [100 50]", got)
+	}
+
+	// An expired lease has its limits lifted
+	writeLeases(t, m.leasesFile, "")
+	m.reconcileBandwidth()
+	if _, ok := limiter.limits["10.15.3.25"]; ok {
+		t.Fatal("expected limits removed after lease expiry")
+	}
+}
+
+func TestUpdateBandwidth(t *testing.T) {
+	m := newTestManager(t)
+	limiter := &fakeLimiter{limits: make(map[string][2]int)}
+	m.SetBandwidthLimiter(limiter)
+
+	const mac = "52:54:00:aa:bb:cc"
+	m.allocations["vps-1"] = &Allocation{VPSID: "vps-1", IPAddress: net.ParseIP("10.15.3.25").To4(), MACAddress: mac, MaxMbitDown: 100, MaxMbitUp: 50}
+
+	// A resize pushes the new limits without waiting for the lease to be re-resolved
+	if err := m.UpdateBandwidth("vps-1", 200, 100); err != nil {
+		t.Fatalf("UpdateBandwidth failed: %v", err)
+	}
+	if got := limiter.limits["10.15.3.25"]; got != [2]int{200, 100} {
+		t.Fatalf("limits = %v, want [200 100]", got)
+	}
+	if alloc := m.allocations["vps-1"]; alloc.MaxMbitDown != 200 || alloc.MaxMbitUp != 100 {
+		t.Fatalf("allocation limits = %d/%d, want 200/100", alloc.MaxMbitDown, alloc.MaxMbitUp)
+	}
+	if m.shapedLeases["10.15.3.25"] != mac {
+		t.Fatal("expected lease tracked as shaped so the reconciler lifts it on expiry")
+	}
+
+	if err := m.UpdateBandwidth("vps-unknown", 10, 10); err == nil {
+		t.Fatal("expected error for a VPS without an allocation")
+	}
+}
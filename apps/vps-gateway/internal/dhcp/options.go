@@ -0,0 +1,73 @@
+package dhcp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// DHCPv4 option codes (RFC 2132)
+const (
+	dhcpOptSubnetMask = 1
+	dhcpOptRouter     = 3
+	dhcpOptDNSServers = 6
+	dhcpOptDomainName = 15
+	dhcpOptLeaseTime  = 51
+)
+
+// dhcpLeaseTime is the lease lifetime handed out by dnsmasq
+const dhcpLeaseTime = 12 * time.Hour
+
+// DHCPOption is a DHCPv4 option in its wire format
+type DHCPOption struct {
+	Code byte
+	Data []byte
+}
+
+// BuildDHCPOptions assembles the options sent in every OFFER and ACK: lease time, subnet mask,
+// router, DNS servers and, when configured, the domain name
+func (m *Manager) BuildDHCPOptions() []DHCPOption {
+	leaseTime := make([]byte, 4)
+	binary.BigEndian.PutUint32(leaseTime, uint32(dhcpLeaseTime.Seconds()))
+
+	options := []DHCPOption{
+		{Code: dhcpOptLeaseTime, Data: leaseTime},
+		{Code: dhcpOptSubnetMask, Data: []byte(m.subnetMask)},
+		{Code: dhcpOptRouter, Data: []byte(m.gateway.To4())},
+	}
+	if len(m.dnsServers) > 0 {
+		dns := make([]byte, 0, 4*len(m.dnsServers))
+		for _, server := range m.dnsServers {
+			if ip := server.To4(); ip != nil {
+				dns = append(dns, ip...)
+			}
+		}
+		options = append(options, DHCPOption{Code: dhcpOptDNSServers, Data: dns})
+	}
+	if m.domainName != "" {
+		options = append(options, DHCPOption{Code: dhcpOptDomainName, Data: []byte(m.domainName)})
+	}
+	return options
+}
+
+// dnsmasqOption formats an option as a dnsmasq dhcp-option line. Lease time and subnet mask are
+// part of dhcp-range and are not written as options.
+func (o DHCPOption) dnsmasqOption() (string, bool) {
+	switch o.Code {
+	case dhcpOptRouter, dhcpOptDNSServers:
+		ips := make([]string, 0, len(o.Data)/4)
+		for i := 0; i+4 <= len(o.Data); i += 4 {
+			ips = append(ips, net.IP(o.Data[i:i+4]).String())
+		}
+		if len(ips) == 0 {
+			return "", false
+		}
+		return fmt.Sprintf("dhcp-option=%d,%s", o.Code, strings.Join(ips, ",")), true
+	case dhcpOptDomainName:
+		return fmt.Sprintf("dhcp-option=%d,%s", o.Code, string(o.Data)), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,145 @@
+package dhcp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	dhcpOptMessageType = 53
+	dhcpOptPad         = 0
+	dhcpOptEnd         = 255
+	dhcpMsgOffer       = 2
+	dhcpMsgAck         = 5
+	dhcpHeaderLen      = 236
+)
+
+var dhcpMagicCookie = []byte{99, 130, 83, 99}
+
+// marshalDHCPReply encodes a BOOTREPLY (RFC 2131) carrying the given options
+func marshalDHCPReply(msgType byte, xid uint32, yiaddr net.IP, mac net.HardwareAddr, options []DHCPOption) []byte {
+	packet := make([]byte, dhcpHeaderLen)
+	packet[0] = 2 // BOOTREPLY
+	packet[1] = 1 // Ethernet
+	packet[2] = byte(len(mac))
+	binary.BigEndian.PutUint32(packet[4:8], xid)
+	copy(packet[16:20], yiaddr.To4())
+	copy(packet[28:44], mac)
+	packet = append(packet, dhcpMagicCookie...)
+	packet = append(packet, dhcpOptMessageType, 1, msgType)
+	for _, option := range options {
+		packet = append(packet, option.Code, byte(len(option.Data)))
+		packet = append(packet, option.Data...)
+	}
+	return append(packet, dhcpOptEnd)
+}
+
+// parseDHCPReply decodes the options of a DHCPv4 packet
+func parseDHCPReply(packet []byte) (map[byte][]byte, error) {
+	if len(packet) < dhcpHeaderLen+len(dhcpMagicCookie) || !bytes.Equal(packet[dhcpHeaderLen:dhcpHeaderLen+4], dhcpMagicCookie) {
+		return nil, fmt.Errorf("not a DHCP packet")
+	}
+	options := make(map[byte][]byte)
+	data := packet[dhcpHeaderLen+4:]
+	for len(data) > 0 {
+		code := data[0]
+		if code == dhcpOptEnd {
+			return options, nil
+		}
+		if code == dhcpOptPad {
+			data = data[1:]
+			continue
+		}
+		if len(data) < 2 || len(data) < 2+int(data[1]) {
+			return nil, fmt.Errorf("truncated option %d", code)
+		}
+		if _, ok := options[code]; ok {
+			return nil, fmt.Errorf("duplicate option %d", code)
+		}
+		options[code] = data[2 : 2+int(data[1])]
+		data = data[2+int(data[1]):]
+	}
+	return nil, fmt.Errorf("missing end option")
+}
+
+func TestBuildDHCPOptions(t *testing.T) {
+	m := newTestManager(t)
+	m.dnsServers = []net.IP{net.ParseIP("1.1.1.1").To4(), net.ParseIP("9.9.9.9").To4()}
+	m.domainName = "vps.example.com"
+
+	for _, msgType := range []byte{dhcpMsgOffer, dhcpMsgAck} {
+		packet := marshalDHCPReply(msgType, 0x1234, net.ParseIP("10.15.3.21"), net.HardwareAddr{0x52, 0x54, 0, 0, 0, 1}, m.BuildDHCPOptions())
+		options, err := parseDHCPReply(packet)
+		if err != nil {
+			t.Fatalf("message type %d: %v", msgType, err)
+		}
+		want := map[byte][]byte{
+			dhcpOptMessageType: {msgType},
+			dhcpOptLeaseTime:   {0, 0, 0xa8, 0xc0}, // 43200 seconds
+			dhcpOptSubnetMask:  {255, 255, 255, 0},
+			dhcpOptRouter:      {10, 15, 3, 1},
+			dhcpOptDNSServers:  {1, 1, 1, 1, 9, 9, 9, 9},
+			dhcpOptDomainName:  []byte("vps.example.com"),
+		}
+		if len(options) != len(want) {
+			t.Fatalf("message type %d: options %v, want %v", msgType, options, want)
+		}
+		for code, value := range want {
+			if !bytes.Equal(options[code], value) {
+				t.Fatalf("message type %d: option %d = %v, want %v", msgType, code, options[code], value)
+			}
+		}
+	}
+
+	// The domain name option is left out when no domain is configured
+	m.domainName = ""
+	for _, option := range m.BuildDHCPOptions() {
+		if option.Code == dhcpOptDomainName {
+			t.Fatalf("domain name option sent without a domain: %q", option.Data)
+		}
+	}
+}
+
+func TestGenerateDNSMasqConfigOptions(t *testing.T) {
+	m := newTestManager(t)
+	m.interfaceName = "eth1"
+	m.listenIP = m.gateway
+	m.dnsServers = []net.IP{net.ParseIP("1.1.1.1").To4(), net.ParseIP("9.9.9.9").To4()}
+	m.domainName = "vps.example.com"
+
+	configFile := filepath.Join(t.TempDir(), "dnsmasq.conf")
+	if err := m.generateDNSMasqConfig(configFile); err != nil {
+		t.Fatalf("generateDNSMasqConfig: %v", err)
+	}
+	content, err := os.ReadFile(configFile)
+	if err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+	lines := strings.Split(string(content), "\n")
+	for _, want := range []string{
+		"dhcp-range=10.15.3.20,10.15.3.30,255.255.255.0,43200",
+		"dhcp-option=3,10.15.3.1",
+		"dhcp-option=6,1.1.1.1,9.9.9.9",
+		"dhcp-option=15,vps.example.com",
+		"domain=vps.example.com",
+		"server=1.1.1.1",
+		"server=9.9.9.9",
+	} {
+		found := false
+		for _, line := range lines {
+			if line == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("config is missing %q:\n%s", want, content)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -83,6 +84,61 @@ var (
 		[]string{"organization_id", "vps_id", "direction"}, // direction: "in" or "out"
 	)
 
+	// DHCP lease lifecycle metrics
+	dhcpLeasesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gateway_dhcp_leases_total",
+			Help: "Total number of DHCP lease events",
+		},
+		[]string{"event"}, // event: "discover", "request", "release" or "expire"
+	)
+
+	dhcpActiveLeases = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "gateway_dhcp_active_leases",
+			Help: "Number of unexpired leases in the dnsmasq leases file",
+		},
+	)
+
+	// DHCPv6 lease lifecycle metrics
+	dhcpv6LeasesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gateway_dhcpv6_leases_total",
+			Help: "Total number of DHCPv6 lease events",
+		},
+		[]string{"event"}, // event: "solicit", "request", "renew", "release" or "expire"
+	)
+
+	dhcpv6ActiveLeases = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "gateway_dhcpv6_active_leases",
+			Help: "Number of leases held by the DHCPv6 server",
+		},
+	)
+
+	// SNAT connection tracking metrics
+	snatActiveConnections = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "gateway_snat_active_connections",
+			Help: "Number of conntrack entries translated to the gateway's outbound IP",
+		},
+	)
+
+	snatBytesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gateway_snat_bytes_total",
+			Help: "Total bytes seen on SNAT connections (requires nf_conntrack_acct)",
+		},
+		[]string{"direction"}, // direction: "in" or "out"
+	)
+
+	sshProxySessionsActive = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "gateway_ssh_proxy_sessions_active",
+			Help: "Number of SSH proxy sessions with an established target connection",
+		},
+	)
+
 	// Gateway health metrics
 	gatewayUptime = prometheus.NewGauge(
 		prometheus.GaugeOpts{
@@ -99,8 +155,15 @@ var (
 	)
 )
 
-// Init initializes Prometheus metrics
+var initOnce sync.Once
+
+// Init registers the gateway metrics with the default Prometheus registry
+// Safe to call more than once; only the first call registers
 func Init() {
+	initOnce.Do(register)
+}
+
+func register() {
 	prometheus.MustRegister(
 		dhcpAllocationsTotal,
 		dhcpReleasesTotal,
@@ -113,6 +176,13 @@ func Init() {
 		sshProxyBytesTransmitted,
 		gatewayUptime,
 		dhcpServerStatus,
+		dhcpLeasesTotal,
+		dhcpActiveLeases,
+		dhcpv6LeasesTotal,
+		dhcpv6ActiveLeases,
+		snatActiveConnections,
+		snatBytesTotal,
+		sshProxySessionsActive,
 	)
 }
 
@@ -121,7 +191,7 @@ func Handler() http.Handler {
 	return promhttp.Handler()
 }
 
-// RecordDHCPAllocation records a DHCP IP allocation
+// RecordDHCPAllocation records a DHCP IP allocation once dnsmasq has acknowledged its lease
 func RecordDHCPAllocation(orgID string) {
 	dhcpAllocationsTotal.WithLabelValues(orgID).Inc()
 }
@@ -180,6 +250,63 @@ func SetDHCPServerStatus(running bool) {
 	dhcpServerStatus.Set(status)
 }
 
+// DHCP lease events
+const (
+	DHCPLeaseEventDiscover = "discover"
+	DHCPLeaseEventRequest  = "request"
+	DHCPLeaseEventRelease  = "release"
+	DHCPLeaseEventExpire   = "expire"
+)
+
+// RecordDHCPLeaseEvent records a DHCP lease lifecycle event
+func RecordDHCPLeaseEvent(event string) {
+	dhcpLeasesTotal.WithLabelValues(event).Inc()
+}
+
+// SetDHCPActiveLeases sets the number of active DHCP leases
+func SetDHCPActiveLeases(count float64) {
+	dhcpActiveLeases.Set(count)
+}
+
+// DHCPv6 lease events
+const (
+	DHCPv6LeaseEventSolicit = "solicit"
+	DHCPv6LeaseEventRequest = "request"
+	DHCPv6LeaseEventRenew   = "renew"
+	DHCPv6LeaseEventRelease = "release"
+	DHCPv6LeaseEventExpire  = "expire"
+)
+
+// RecordDHCPv6LeaseEvent records a DHCPv6 lease lifecycle event
+func RecordDHCPv6LeaseEvent(event string) {
+	dhcpv6LeasesTotal.WithLabelValues(event).Inc()
+}
+
+// SetDHCPv6ActiveLeases sets the number of active DHCPv6 leases
+func SetDHCPv6ActiveLeases(count float64) {
+	dhcpv6ActiveLeases.Set(count)
+}
+
+// SetSNATActiveConnections sets the number of active SNAT connections
+func SetSNATActiveConnections(count float64) {
+	snatActiveConnections.Set(count)
+}
+
+// RecordSNATBytes records bytes transferred on SNAT connections
+func RecordSNATBytes(direction string, bytes uint64) {
+	snatBytesTotal.WithLabelValues(direction).Add(float64(bytes))
+}
+
+// SSHProxySessionStarted records an established SSH proxy session
+func SSHProxySessionStarted() {
+	sshProxySessionsActive.Inc()
+}
+
+// SSHProxySessionEnded records a closed SSH proxy session
+func SSHProxySessionEnded() {
+	sshProxySessionsActive.Dec()
+}
+
 // GetMetricsText returns Prometheus metrics in text format
 func GetMetricsText() (string, error) {
 	// Use prometheus registry to gather metrics
@@ -199,6 +326,13 @@ func GetMetricsText() (string, error) {
 			sshProxyBytesTransmitted,
 			gatewayUptime,
 			dhcpServerStatus,
+			dhcpLeasesTotal,
+			dhcpActiveLeases,
+			dhcpv6LeasesTotal,
+			dhcpv6ActiveLeases,
+			snatActiveConnections,
+			snatBytesTotal,
+			sshProxySessionsActive,
 		)
 	}
 
@@ -0,0 +1,367 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"vps-gateway/internal/logger"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// maxClassID is the highest HTB class minor number; minor 0 is the qdisc itself
+const maxClassID = 0xffff
+
+// trafficControl is the subset of netlink traffic-control operations the shaper needs
+// The default implementation talks to the kernel over netlink; tests substitute a fake
+type trafficControl interface {
+	// EnsureIFB creates (if needed) and brings up an IFB device, redirecting dev's ingress to it
+	EnsureIFB(dev, ifb string) error
+	// EnsureHTBRoot installs an HTB root qdisc (handle 1:) on dev; unclassified traffic is not shaped
+	EnsureHTBRoot(dev string) error
+	// ReplaceClass creates or updates HTB class 1:<classID> with the given rate/ceil
+	ReplaceClass(dev string, classID uint16, rateMbit int) error
+	// DeleteClass removes HTB class 1:<classID>
+	DeleteClass(dev string, classID uint16) error
+	// ReplaceFilter steers packets whose source ("src") or destination ("dst") is ip into class 1:<classID>
+	ReplaceFilter(dev string, classID uint16, match string, ip net.IP) error
+	// DeleteFilter removes the filter added for class 1:<classID>
+	DeleteFilter(dev string, classID uint16) error
+}
+
+// BandwidthShaper enforces per-VM bandwidth limits with HTB on the gateway bridge interface
+//
+// Download (traffic to the VM) is shaped on the bridge interface's egress. Upload (traffic from
+// the VM) arrives as ingress, which HTB cannot shape, so it is redirected to an IFB device and
+// shaped on that device's egress. Each lease gets one class per direction; class IDs are
+// handed out from a pool and reused once a lease's shaping is removed.
+type BandwidthShaper struct {
+	iface    string
+	ifbIface string
+	tc       trafficControl
+
+	mu          sync.Mutex
+	initialized bool
+	leases      map[string]uint16 // lease IP -> class ID
+}
+
+// NewBandwidthShaper creates a shaper for the given bridge interface
+// Returns nil if iface is empty (shaping disabled)
+func NewBandwidthShaper(iface string) *BandwidthShaper {
+	if iface == "" {
+		return nil
+	}
+	return newBandwidthShaper(iface, &netlinkTC{})
+}
+
+func newBandwidthShaper(iface string, tc trafficControl) *BandwidthShaper {
+	// Interface names are limited to 15 characters (IFNAMSIZ - 1)
+	ifb := "ifb-" + iface
+	if len(ifb) > 15 {
+		ifb = ifb[:15]
+	}
+	return &BandwidthShaper{
+		iface:    iface,
+		ifbIface: ifb,
+		tc:       tc,
+		leases:   make(map[string]uint16),
+	}
+}
+
+// ApplyBandwidth limits a lease to downMbit (to the VM) and upMbit (from the VM)
+// A limit of 0 or less leaves that direction unshaped; if both are unlimited any existing shaping is removed
+func (b *BandwidthShaper) ApplyBandwidth(leaseIP string, downMbit, upMbit int) error {
+	if b == nil {
+		return nil
+	}
+	if downMbit <= 0 && upMbit <= 0 {
+		return b.RemoveBandwidth(leaseIP)
+	}
+
+	ip, err := parseLeaseIP(leaseIP)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	classID, existing := b.leases[ip.String()]
+	if !existing {
+		if classID, err = b.allocateClassID(); err != nil {
+			return fmt.Errorf("cannot shape %s: %w", ip, err)
+		}
+	}
+
+	if err := b.ensureInitialized(); err != nil {
+		return err
+	}
+
+	if err := b.applyDirection(b.iface, classID, "dst", ip, downMbit); err != nil {
+		return fmt.Errorf("failed to shape download for %s: %w", ip, err)
+	}
+	if err := b.applyDirection(b.ifbIface, classID, "src", ip, upMbit); err != nil {
+		return fmt.Errorf("failed to shape upload for %s: %w", ip, err)
+	}
+
+	b.leases[ip.String()] = classID
+	logger.Info("[BandwidthShaper] Applied bandwidth limits for %s: down=%d Mbit/s up=%d Mbit/s", ip, downMbit, upMbit)
+	return nil
+}
+
+// RemoveBandwidth removes any shaping applied to a lease
+func (b *BandwidthShaper) RemoveBandwidth(leaseIP string) error {
+	if b == nil {
+		return nil
+	}
+
+	ip, err := parseLeaseIP(leaseIP)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	classID, ok := b.leases[ip.String()]
+	if !ok {
+		return nil
+	}
+
+	var errs []string
+	for _, dev := range []string{b.iface, b.ifbIface} {
+		if err := b.removeDirection(dev, classID); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	delete(b.leases, ip.String())
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to remove bandwidth limits for %s: %s", ip, strings.Join(errs, "; "))
+	}
+	logger.Info("[BandwidthShaper] Removed bandwidth limits for %s", ip)
+	return nil
+}
+
+// ensureInitialized sets up the root qdiscs and IFB redirect on first use
+// Must be called while holding b.mu
+func (b *BandwidthShaper) ensureInitialized() error {
+	if b.initialized {
+		return nil
+	}
+	if err := b.tc.EnsureIFB(b.iface, b.ifbIface); err != nil {
+		return fmt.Errorf("failed to set up IFB device %s: %w", b.ifbIface, err)
+	}
+	for _, dev := range []string{b.iface, b.ifbIface} {
+		if err := b.tc.EnsureHTBRoot(dev); err != nil {
+			return fmt.Errorf("failed to set up HTB qdisc on %s: %w", dev, err)
+		}
+	}
+	b.initialized = true
+	return nil
+}
+
+func (b *BandwidthShaper) applyDirection(dev string, classID uint16, match string, ip net.IP, rateMbit int) error {
+	if rateMbit <= 0 {
+		return b.removeDirection(dev, classID)
+	}
+	if err := b.tc.ReplaceClass(dev, classID, rateMbit); err != nil {
+		return err
+	}
+	return b.tc.ReplaceFilter(dev, classID, match, ip)
+}
+
+func (b *BandwidthShaper) removeDirection(dev string, classID uint16) error {
+	// Filter first so no packets are steered into a class that no longer exists
+	if err := b.tc.DeleteFilter(dev, classID); err != nil {
+		return err
+	}
+	return b.tc.DeleteClass(dev, classID)
+}
+
+// allocateClassID returns the lowest class ID not held by a shaped lease
+// Must be called while holding b.mu
+func (b *BandwidthShaper) allocateClassID() (uint16, error) {
+	used := make(map[uint16]bool, len(b.leases))
+	for _, id := range b.leases {
+		used[id] = true
+	}
+	for id := 1; id <= maxClassID; id++ {
+		if !used[uint16(id)] {
+			return uint16(id), nil
+		}
+	}
+	return 0, fmt.Errorf("all %d traffic classes are in use", maxClassID)
+}
+
+func parseLeaseIP(leaseIP string) (net.IP, error) {
+	ip := net.ParseIP(leaseIP).To4()
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IPv4 lease address: %s", leaseIP)
+	}
+	return ip, nil
+}
+
+// netlinkTC implements trafficControl with rtnetlink requests, the same ones tc and ip send
+type netlinkTC struct{}
+
+var (
+	htbRootHandle = netlink.MakeHandle(1, 0)
+	ingressHandle = netlink.MakeHandle(0xffff, 0)
+)
+
+// ignoreMissing treats "already gone" errors from delete requests as success
+func ignoreMissing(err error) error {
+	if errors.Is(err, unix.ENOENT) || errors.Is(err, unix.EINVAL) {
+		return nil
+	}
+	return err
+}
+
+func linkIndex(dev string) (int, error) {
+	link, err := netlink.LinkByName(dev)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find link %s: %w", dev, err)
+	}
+	return link.Attrs().Index, nil
+}
+
+func (t *netlinkTC) EnsureIFB(dev, ifb string) error {
+	ifbLink, err := netlink.LinkByName(ifb)
+	if err != nil {
+		if err := netlink.LinkAdd(&netlink.Ifb{LinkAttrs: netlink.LinkAttrs{Name: ifb}}); err != nil && !errors.Is(err, unix.EEXIST) {
+			return fmt.Errorf("failed to create %s: %w", ifb, err)
+		}
+		if ifbLink, err = netlink.LinkByName(ifb); err != nil {
+			return fmt.Errorf("failed to find %s after creating it: %w", ifb, err)
+		}
+	}
+	if err := netlink.LinkSetUp(ifbLink); err != nil {
+		return fmt.Errorf("failed to bring up %s: %w", ifb, err)
+	}
+
+	devIndex, err := linkIndex(dev)
+	if err != nil {
+		return err
+	}
+	if err := netlink.QdiscReplace(&netlink.Ingress{QdiscAttrs: netlink.QdiscAttrs{
+		LinkIndex: devIndex,
+		Handle:    ingressHandle,
+		Parent:    netlink.HANDLE_INGRESS,
+	}}); err != nil {
+		return fmt.Errorf("failed to add ingress qdisc to %s: %w", dev, err)
+	}
+
+	// Match every packet and redirect it to the IFB device's egress
+	redirect := &netlink.U32{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: devIndex,
+			Parent:    ingressHandle,
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Sel: &netlink.TcU32Sel{
+			Flags: netlink.TC_U32_TERMINAL,
+			Keys:  []netlink.TcU32Key{{Mask: 0, Val: 0}},
+		},
+		Actions: []netlink.Action{netlink.NewMirredAction(ifbLink.Attrs().Index)},
+	}
+	if err := netlink.FilterReplace(redirect); err != nil {
+		return fmt.Errorf("failed to redirect %s ingress to %s: %w", dev, ifb, err)
+	}
+	return nil
+}
+
+func (t *netlinkTC) EnsureHTBRoot(dev string) error {
+	index, err := linkIndex(dev)
+	if err != nil {
+		return err
+	}
+	// Defcls 0: unclassified traffic bypasses shaping
+	qdisc := netlink.NewHtb(netlink.QdiscAttrs{
+		LinkIndex: index,
+		Handle:    htbRootHandle,
+		Parent:    netlink.HANDLE_ROOT,
+	})
+	return netlink.QdiscReplace(qdisc)
+}
+
+func (t *netlinkTC) ReplaceClass(dev string, classID uint16, rateMbit int) error {
+	index, err := linkIndex(dev)
+	if err != nil {
+		return err
+	}
+	rate := uint64(rateMbit) * 1000 * 1000 // bits per second
+	class := netlink.NewHtbClass(netlink.ClassAttrs{
+		LinkIndex: index,
+		Parent:    htbRootHandle,
+		Handle:    netlink.MakeHandle(1, classID),
+	}, netlink.HtbClassAttrs{Rate: rate, Ceil: rate})
+	return netlink.ClassReplace(class)
+}
+
+func (t *netlinkTC) DeleteClass(dev string, classID uint16) error {
+	index, err := linkIndex(dev)
+	if err != nil {
+		return err
+	}
+	class := netlink.NewHtbClass(netlink.ClassAttrs{
+		LinkIndex: index,
+		Parent:    htbRootHandle,
+		Handle:    netlink.MakeHandle(1, classID),
+	}, netlink.HtbClassAttrs{})
+	return ignoreMissing(netlink.ClassDel(class))
+}
+
+// leaseFilterAttrs identifies a lease's filter; its class ID doubles as the filter priority
+// so each lease's filter can be replaced and deleted on its own
+func leaseFilterAttrs(index int, classID uint16) netlink.FilterAttrs {
+	return netlink.FilterAttrs{
+		LinkIndex: index,
+		Parent:    htbRootHandle,
+		Priority:  classID,
+		Protocol:  unix.ETH_P_IP,
+	}
+}
+
+func (t *netlinkTC) ReplaceFilter(dev string, classID uint16, match string, ip net.IP) error {
+	index, err := linkIndex(dev)
+	if err != nil {
+		return err
+	}
+	if err := t.DeleteFilter(dev, classID); err != nil {
+		return err
+	}
+
+	// Offsets into the IPv4 header: source address at 12, destination at 16
+	offset := int32(16)
+	if match == "src" {
+		offset = 12
+	}
+	filter := &netlink.U32{
+		FilterAttrs: leaseFilterAttrs(index, classID),
+		ClassId:     netlink.MakeHandle(1, classID),
+		Sel: &netlink.TcU32Sel{
+			Flags: netlink.TC_U32_TERMINAL,
+			Keys: []netlink.TcU32Key{{
+				Mask: 0xffffffff,
+				Val:  uint32(ip[0])<<24 | uint32(ip[1])<<16 | uint32(ip[2])<<8 | uint32(ip[3]),
+				Off:  offset,
+			}},
+		},
+	}
+	return netlink.FilterAdd(filter)
+}
+
+func (t *netlinkTC) DeleteFilter(dev string, classID uint16) error {
+	index, err := linkIndex(dev)
+	if err != nil {
+		return err
+	}
+	filter := &netlink.U32{FilterAttrs: leaseFilterAttrs(index, classID)}
+	return ignoreMissing(netlink.FilterDel(filter))
+}
@@ -0,0 +1,216 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"testing"
+)
+
+type fakeTC struct {
+	ifb      map[string]string // dev -> ifb
+	roots    map[string]bool
+	classes  map[string]int    // "dev/classID" -> rate
+	filters  map[string]string // "dev/classID" -> "match ip"
+	failNext error
+}
+
+func newFakeTC() *fakeTC {
+	return &fakeTC{
+		ifb:     make(map[string]string),
+		roots:   make(map[string]bool),
+		classes: make(map[string]int),
+		filters: make(map[string]string),
+	}
+}
+
+func key(dev string, classID uint16) string {
+	return fmt.Sprintf("%s/%x", dev, classID)
+}
+
+func (f *fakeTC) EnsureIFB(dev, ifb string) error {
+	f.ifb[dev] = ifb
+	return nil
+}
+
+func (f *fakeTC) EnsureHTBRoot(dev string) error {
+	f.roots[dev] = true
+	return nil
+}
+
+func (f *fakeTC) ReplaceClass(dev string, classID uint16, rateMbit int) error {
+	if err := f.failNext; err != nil {
+		f.failNext = nil
+		return err
+	}
+	f.classes[key(dev, classID)] = rateMbit
+	return nil
+}
+
+func (f *fakeTC) DeleteClass(dev string, classID uint16) error {
+	delete(f.classes, key(dev, classID))
+	return nil
+}
+
+func (f *fakeTC) ReplaceFilter(dev string, classID uint16, match string, ip net.IP) error {
+	f.filters[key(dev, classID)] = match + " " + ip.String()
+	return nil
+}
+
+func (f *fakeTC) DeleteFilter(dev string, classID uint16) error {
+	delete(f.filters, key(dev, classID))
+	return nil
+}
+
+func TestBandwidthShaperApplyAndRemove(t *testing.T) {
+	tc := newFakeTC()
+	b := newBandwidthShaper("vmbr1", tc)
+
+	if err := b.ApplyBandwidth("10.15.3.25", 100, 50); err != nil {
+		t.Fatalf("ApplyBandwidth failed: %v", err)
+	}
+
+	if tc.ifb["vmbr1"] != "ifb-vmbr1" {
+		t.Fatalf("expected ingress of vmbr1 redirected to ifb-vmbr1, got %q", tc.ifb["vmbr1"])
+	}
+	if !tc.roots["vmbr1"] || !tc.roots["ifb-vmbr1"] {
+		t.Fatalf("expected HTB roots on both devices, got %v", tc.roots)
+	}
+
+	// First shaped lease gets class 1:1
+	if got := tc.classes["vmbr1/1"]; got != 100 {
+		t.Errorf("download class rate = %d, want 100", got)
+	}
+	if got := tc.filters["vmbr1/1"]; got != "dst 10.15.3.25" {
+		t.Errorf("download filter = %q, want %q", got, "dst 10.15.3.25")
+	}
+	if got := tc.classes["ifb-vmbr1/1"]; got != 50 {
+		t.Errorf("upload class rate = %d, want 50", got)
+	}
+	if got := tc.filters["ifb-vmbr1/1"]; got != "src 10.15.3.25" {
+		t.Errorf("upload filter = %q, want %q", got, "src 10.15.3.25")
+	}
+
+	// Updating to download-only removes the upload class
+	if err := b.ApplyBandwidth("10.15.3.25", 200, 0); err != nil {
+		t.Fatalf("ApplyBandwidth update failed: %v", err)
+	}
+	if got := tc.classes["vmbr1/1"]; got != 200 {
+		t.Errorf("updated download class rate = %d, want 200", got)
+	}
+	if _, ok := tc.classes["ifb-vmbr1/1"]; ok {
+		t.Errorf("expected upload class to be removed when upload is unlimited")
+	}
+
+	if err := b.RemoveBandwidth("10.15.3.25"); err != nil {
+		t.Fatalf("RemoveBandwidth failed: %v", err)
+	}
+	if len(tc.classes) != 0 || len(tc.filters) != 0 {
+		t.Fatalf("expected no classes or filters after removal, got classes=%v filters=%v", tc.classes, tc.filters)
+	}
+
+	// Removing an unknown lease is a no-op
+	if err := b.RemoveBandwidth("10.15.3.26"); err != nil {
+		t.Fatalf("RemoveBandwidth for unknown lease failed: %v", err)
+	}
+}
+
+func TestBandwidthShaperUnlimitedRemovesShaping(t *testing.T) {
+	tc := newFakeTC()
+	b := newBandwidthShaper("vmbr1", tc)
+
+	// Unlimited in both directions never touches tc
+	if err := b.ApplyBandwidth("10.15.3.25", 0, 0); err != nil {
+		t.Fatalf("ApplyBandwidth failed: %v", err)
+	}
+	if len(tc.roots) != 0 {
+		t.Fatalf("expected no qdiscs for an unlimited lease, got %v", tc.roots)
+	}
+
+	if err := b.ApplyBandwidth("10.15.3.25", 10, 10); err != nil {
+		t.Fatalf("ApplyBandwidth failed: %v", err)
+	}
+	if err := b.ApplyBandwidth("10.15.3.25", 0, 0); err != nil {
+		t.Fatalf("ApplyBandwidth failed: %v", err)
+	}
+	if len(tc.classes) != 0 || len(tc.filters) != 0 {
+		t.Fatalf("expected shaping removed, got classes=%v filters=%v", tc.classes, tc.filters)
+	}
+}
+
+func TestBandwidthShaperErrors(t *testing.T) {
+	tc := newFakeTC()
+	b := newBandwidthShaper("vmbr1", tc)
+
+	if err := b.ApplyBandwidth("not-an-ip", 10, 10); err == nil {
+		t.Error("expected error for invalid lease IP")
+	}
+
+	// A failed apply does not record the lease
+	tc.failNext = fmt.Errorf("tc failed")
+	if err := b.ApplyBandwidth("10.15.3.26", 10, 10); err == nil {
+		t.Fatal("expected tc failure to be returned")
+	}
+	if _, ok := b.leases["10.15.3.26"]; ok {
+		t.Error("failed lease should not be tracked")
+	}
+}
+
+func TestBandwidthShaperClassIDs(t *testing.T) {
+	tc := newFakeTC()
+	b := newBandwidthShaper("vmbr1", tc)
+
+	// Leases sharing the low 16 bits of their address get distinct classes
+	for _, ip := range []string{"10.15.3.25", "10.16.3.25", "10.15.0.0"} {
+		if err := b.ApplyBandwidth(ip, 10, 10); err != nil {
+			t.Fatalf("ApplyBandwidth(%s) failed: %v", ip, err)
+		}
+	}
+	if got := tc.filters["vmbr1/1"]; got != "dst 10.15.3.25" {
+		t.Errorf("class 1 filter = %q", got)
+	}
+	if got := tc.filters["vmbr1/2"]; got != "dst 10.16.3.25" {
+		t.Errorf("class 2 filter = %q", got)
+	}
+	if got := tc.filters["vmbr1/3"]; got != "dst 10.15.0.0" {
+		t.Errorf("class 3 filter = %q", got)
+	}
+
+	// Updating a lease keeps its class
+	if err := b.ApplyBandwidth("10.16.3.25", 20, 20); err != nil {
+		t.Fatalf("ApplyBandwidth update failed: %v", err)
+	}
+	if got := b.leases["10.16.3.25"]; got != 2 {
+		t.Errorf("updated lease moved to class %d, want 2", got)
+	}
+
+	// A freed class is handed to the next lease
+	if err := b.RemoveBandwidth("10.15.3.25"); err != nil {
+		t.Fatalf("RemoveBandwidth failed: %v", err)
+	}
+	if err := b.ApplyBandwidth("10.15.3.26", 10, 10); err != nil {
+		t.Fatalf("ApplyBandwidth failed: %v", err)
+	}
+	if got := tc.filters["vmbr1/1"]; got != "dst 10.15.3.26" {
+		t.Errorf("reused class 1 filter = %q, want %q", got, "dst 10.15.3.26")
+	}
+}
+
+func TestBandwidthShaperNil(t *testing.T) {
+	if NewBandwidthShaper("") != nil {
+		t.Fatal("expected nil shaper without an interface")
+	}
+	var b *BandwidthShaper
+	if err := b.ApplyBandwidth("10.15.3.25", 10, 10); err != nil {
+		t.Errorf("nil ApplyBandwidth returned %v", err)
+	}
+	if err := b.RemoveBandwidth("10.15.3.25"); err != nil {
+		t.Errorf("nil RemoveBandwidth returned %v", err)
+	}
+}
+
+func TestBandwidthShaperIFBNameTruncated(t *testing.T) {
+	b := newBandwidthShaper("enp129s0f1np1", newFakeTC())
+	if len(b.ifbIface) > 15 {
+		t.Fatalf("IFB name %q exceeds 15 characters", b.ifbIface)
+	}
+}
@@ -0,0 +1,159 @@
+package network
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"vps-gateway/internal/logger"
+	"vps-gateway/internal/metrics"
+)
+
+const conntrackPath = "/proc/net/nf_conntrack"
+
+// conntrackEntry is a connection tracked by netfilter
+// Byte counters are only present when nf_conntrack_acct is enabled
+type conntrackEntry struct {
+	key        string // Original-direction tuple, identifies the connection across polls
+	origBytes  uint64 // Bytes sent by the VPS (original direction)
+	replyBytes uint64 // Bytes received by the VPS (reply direction)
+}
+
+// parseConntrack returns the entries whose reply tuple is addressed to outboundIP,
+// i.e. connections that were source-NATed to the gateway's outbound IP
+//
+// Line format (tuples repeat for original and reply direction):
+// ipv4 2 tcp 6 431999 ESTABLISHED src=10.15.3.5 dst=1.1.1.1 sport=40000 dport=443 packets=5 bytes=300 src=1.1.1.1 dst=203.0.113.10 sport=443 dport=40000 packets=4 bytes=500 [ASSURED] mark=0 use=1
+func parseConntrack(r io.Reader, outboundIP string) ([]conntrackEntry, error) {
+	var entries []conntrackEntry
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+
+		var tuples [2][]string
+		var byteCounts [2]uint64
+		direction := -1
+		for _, field := range fields {
+			if strings.HasPrefix(field, "src=") {
+				direction++
+				if direction > 1 {
+					break
+				}
+			}
+			if direction < 0 {
+				continue
+			}
+			switch {
+			case strings.HasPrefix(field, "src="), strings.HasPrefix(field, "dst="),
+				strings.HasPrefix(field, "sport="), strings.HasPrefix(field, "dport="):
+				tuples[direction] = append(tuples[direction], field)
+			case strings.HasPrefix(field, "bytes="):
+				byteCounts[direction], _ = strconv.ParseUint(strings.TrimPrefix(field, "bytes="), 10, 64)
+			}
+		}
+		if direction < 1 {
+			continue
+		}
+
+		// SNAT rewrites the source, so replies are addressed to the outbound IP
+		if !containsField(tuples[1], "dst="+outboundIP) {
+			continue
+		}
+
+		entries = append(entries, conntrackEntry{
+			key:        fields[2] + " " + strings.Join(tuples[0], " "),
+			origBytes:  byteCounts[0],
+			replyBytes: byteCounts[1],
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read conntrack table: %w", err)
+	}
+	return entries, nil
+}
+
+func containsField(fields []string, want string) bool {
+	for _, f := range fields {
+		if f == want {
+			return true
+		}
+	}
+	return false
+}
+
+// conntrackByteTracker turns per-connection byte counters into monotonic totals
+// Bytes exchanged after the last poll of a connection that then closes are not counted
+type conntrackByteTracker struct {
+	seen map[string]conntrackEntry
+}
+
+// update records the latest entries and returns the bytes transferred since the previous update
+func (t *conntrackByteTracker) update(entries []conntrackEntry) (outBytes, inBytes uint64) {
+	next := make(map[string]conntrackEntry, len(entries))
+	for _, e := range entries {
+		prev, ok := t.seen[e.key]
+		if ok && e.origBytes >= prev.origBytes && e.replyBytes >= prev.replyBytes {
+			outBytes += e.origBytes - prev.origBytes
+			inBytes += e.replyBytes - prev.replyBytes
+		} else {
+			// New connection (or a reused tuple whose counters restarted)
+			outBytes += e.origBytes
+			inBytes += e.replyBytes
+		}
+		next[e.key] = e
+	}
+	t.seen = next
+	return outBytes, inBytes
+}
+
+// StartConntrackMetrics periodically exports SNAT connection counts and byte totals
+// from the netfilter conntrack table until ctx is cancelled
+func (s *SNATManager) StartConntrackMetrics(ctx context.Context, interval time.Duration) {
+	if s == nil {
+		// No manager (outbound IP not configured)
+		return
+	}
+
+	tracker := &conntrackByteTracker{}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.collectConntrackMetrics(tracker); err != nil {
+			logger.Debug("Failed to collect SNAT conntrack metrics: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SNATManager) collectConntrackMetrics(tracker *conntrackByteTracker) error {
+	file, err := os.Open(conntrackPath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", conntrackPath, err)
+	}
+	defer file.Close()
+
+	entries, err := parseConntrack(file, s.outboundIP)
+	if err != nil {
+		return err
+	}
+
+	metrics.SetSNATActiveConnections(float64(len(entries)))
+	outBytes, inBytes := tracker.update(entries)
+	metrics.RecordSNATBytes("out", outBytes)
+	metrics.RecordSNATBytes("in", inBytes)
+	return nil
+}
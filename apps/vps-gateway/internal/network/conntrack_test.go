@@ -0,0 +1,47 @@
+package network
+
+import (
+	"strings"
+	"testing"
+)
+
+const testConntrack = `ipv4     2 tcp      6 431999 ESTABLISHED src=10.15.3.5 dst=1.1.1.1 sport=40000 dport=443 packets=5 bytes=300 src=1.1.1.1 dst=203.0.113.10 sport=443 dport=40000 packets=4 bytes=500 [ASSURED] mark=0 use=1
+ipv4     2 udp      17 29 src=10.15.3.6 dst=8.8.8.8 sport=5353 dport=53 packets=1 bytes=60 src=8.8.8.8 dst=203.0.113.10 sport=53 dport=5353 packets=1 bytes=120 mark=0 use=1
+ipv4     2 tcp      6 431999 ESTABLISHED src=192.0.2.9 dst=10.15.3.1 sport=50000 dport=1537 packets=3 bytes=180 src=10.15.3.1 dst=192.0.2.9 sport=1537 dport=50000 packets=3 bytes=200 [ASSURED] mark=0 use=1
+`
+
+func TestParseConntrackFiltersByOutboundIP(t *testing.T) {
+	entries, err := parseConntrack(strings.NewReader(testConntrack), "203.0.113.10")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 SNAT entries, got %d: %+v", len(entries), entries)
+	}
+	if entries[0].origBytes != 300 || entries[0].replyBytes != 500 {
+		t.Fatalf("unexpected byte counts: %+v", entries[0])
+	}
+}
+
+func TestConntrackByteTrackerDeltas(t *testing.T) {
+	tracker := &conntrackByteTracker{}
+
+	out, in := tracker.update([]conntrackEntry{{key: "a", origBytes: 100, replyBytes: 200}})
+	if out != 100 || in != 200 {
+		t.Fatalf("first update = (%d, %d), want (100, 200)", out, in)
+	}
+
+	out, in = tracker.update([]conntrackEntry{
+		{key: "a", origBytes: 150, replyBytes: 260},
+		{key: "b", origBytes: 10, replyBytes: 20},
+	})
+	if out != 60 || in != 80 {
+		t.Fatalf("second update = (%d, %d), want (60, 80)", out, in)
+	}
+
+	// Tuple reused by a new connection: counters restart
+	out, in = tracker.update([]conntrackEntry{{key: "a", origBytes: 5, replyBytes: 7}})
+	if out != 5 || in != 7 {
+		t.Fatalf("third update = (%d, %d), want (5, 7)", out, in)
+	}
+}
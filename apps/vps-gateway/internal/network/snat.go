@@ -1,20 +1,63 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os/exec"
 	"strings"
+	"sync"
+	"time"
 
 	"vps-gateway/internal/logger"
 )
 
+// snatReconcileInterval is how often lease SNAT rules are reconciled with the lease store,
+// picking up new leases and dropping rules for expired ones
+const snatReconcileInterval = 30 * time.Second
+
+// LeaseStore lists the active leases whose outbound traffic is source-NATed (implemented by dhcp.Manager)
+// It is read from persistent storage, so it survives gateway restarts that flush iptables
+type LeaseStore interface {
+	ActiveLeaseIPs() ([]string, error)
+}
+
+// iptablesRunner is the subset of iptables operations the SNAT manager needs
+// The default implementation execs iptables; tests substitute a fake nat table
+type iptablesRunner interface {
+	// Run runs iptables with args and returns its combined output
+	Run(args ...string) ([]byte, error)
+	// SaveNAT returns the nat table in iptables-save format
+	SaveNAT() ([]byte, error)
+}
+
+type execIPTables struct{}
+
+func (execIPTables) Run(args ...string) ([]byte, error) {
+	return exec.Command("iptables", args...).CombinedOutput()
+}
+
+func (execIPTables) SaveNAT() ([]byte, error) {
+	return exec.Command("iptables-save", "-t", "nat").Output()
+}
+
 // SNATManager manages iptables SNAT rules for outbound traffic
+//
+// Each active lease gets its own POSTROUTING rule tagged with ruleComment. Start reconciles
+// those rules against the lease store, so rules flushed by a gateway restart are re-applied
+// and rules for leases that no longer exist are removed.
 type SNATManager struct {
 	outboundIP    string
 	vpsSubnet     string // CIDR notation (e.g., "10.15.3.0/24")
 	outboundIface string
 	ruleComment   string // Unique comment to identify our rules
+	ipv6Prefix    string // VPS IPv6 prefix masqueraded by ip6tables (empty when IPv6 is disabled)
+	leases        LeaseStore
+	iptables      iptablesRunner
+
+	mu   sync.Mutex // Serializes rule changes between reconciles, ApplySNAT and Close
+	stop context.CancelFunc
+	done chan struct{}
 }
 
 // NewSNATManager creates a new SNAT manager
@@ -22,7 +65,12 @@ type SNATManager struct {
 // gatewayIP: The gateway IP address (used to calculate subnet)
 // subnetMask: The subnet mask (used to calculate subnet)
 // outboundIface: The network interface for outbound traffic (optional, will be auto-detected if empty)
-func NewSNATManager(outboundIP, gatewayIP, subnetMask, outboundIface string) (*SNATManager, error) {
+// leases: The store listing leases to source-NAT
+func NewSNATManager(outboundIP, gatewayIP, subnetMask, outboundIface string, leases LeaseStore) (*SNATManager, error) {
+	return newSNATManager(outboundIP, gatewayIP, subnetMask, outboundIface, leases, execIPTables{})
+}
+
+func newSNATManager(outboundIP, gatewayIP, subnetMask, outboundIface string, leases LeaseStore, iptables iptablesRunner) (*SNATManager, error) {
 	if outboundIP == "" {
 		// No outbound IP configured, return nil manager (no-op)
 		return nil, nil
@@ -64,102 +112,338 @@ func NewSNATManager(outboundIP, gatewayIP, subnetMask, outboundIface string) (*S
 		vpsSubnet:     subnetCIDR,
 		outboundIface: outboundIface,
 		ruleComment:   ruleComment,
+		leases:        leases,
+		iptables:      iptables,
 	}, nil
 }
 
-// ConfigureSNAT sets up iptables SNAT rules for VPS outbound traffic
-func (s *SNATManager) ConfigureSNAT() error {
+// Start re-applies SNAT rules for every active lease in the lease store, removes rules for
+// leases that are gone, and keeps reconciling every snatReconcileInterval until ctx is
+// cancelled or Close is called
+func (s *SNATManager) Start(ctx context.Context) error {
 	if s == nil {
 		// No manager (outbound IP not configured)
 		return nil
 	}
 
-	logger.Info("Configuring iptables SNAT: %s -> %s on interface %s", s.vpsSubnet, s.outboundIP, s.outboundIface)
-
-	// Check if rule already exists
-	exists, err := s.ruleExists()
-	if err != nil {
-		return fmt.Errorf("failed to check if SNAT rule exists: %w", err)
+	logger.Info("Configuring iptables SNAT for leases in %s -> %s on interface %s", s.vpsSubnet, s.outboundIP, s.outboundIface)
+	if err := s.reconcile(); err != nil {
+		return err
 	}
 
-	if exists {
-		logger.Info("SNAT rule already exists, skipping configuration")
+	ctx, stop := context.WithCancel(ctx)
+	s.mu.Lock()
+	s.stop = stop
+	s.done = make(chan struct{})
+	done := s.done
+	s.mu.Unlock()
+
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(snatReconcileInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.reconcile(); err != nil {
+					logger.Warn("Failed to reconcile SNAT rules: %v", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// ApplySNAT adds the SNAT rule for a lease unless it is already in place
+func (s *SNATManager) ApplySNAT(leaseIP string) error {
+	if s == nil {
+		// No manager (outbound IP not configured)
 		return nil
 	}
 
-	// Add SNAT rule: iptables -t nat -A POSTROUTING -s <subnet> -o <interface> -j SNAT --to-source <outbound-ip>
-	cmd := exec.Command("iptables",
-		"-t", "nat",
-		"-A", "POSTROUTING",
-		"-s", s.vpsSubnet,
-		"-o", s.outboundIface,
-		"-j", "SNAT",
-		"--to-source", s.outboundIP,
-		"-m", "comment",
-		"--comment", s.ruleComment,
-	)
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	output, err := cmd.CombinedOutput()
+	source, err := s.leaseSource(leaseIP)
+	if err != nil {
+		return err
+	}
+	existing, err := s.existingRules()
 	if err != nil {
-		return fmt.Errorf("failed to add SNAT rule: %w (output: %s)", err, string(output))
+		return err
+	}
+	if len(existing[source]) > 0 {
+		return nil
 	}
+	return s.addRule(source)
+}
 
-	logger.Info("Successfully configured SNAT rule: %s -> %s on %s", s.vpsSubnet, s.outboundIP, s.outboundIface)
-	return nil
+// Reapply reconciles the SNAT rules with the lease store immediately instead of at the next interval
+// Called when the gateway becomes the VRRP master and takes over traffic from its peer
+func (s *SNATManager) Reapply() error {
+	if s == nil {
+		// No manager (outbound IP not configured)
+		return nil
+	}
+	return s.reconcile()
 }
 
-// RemoveSNAT removes iptables SNAT rules
-func (s *SNATManager) RemoveSNAT() error {
+// Close stops reconciling and removes all SNAT rules added by the manager, including the IPv6 MASQUERADE rule
+func (s *SNATManager) Close() error {
 	if s == nil {
 		// No manager (outbound IP not configured)
 		return nil
 	}
 
-	logger.Info("Removing iptables SNAT rule for %s", s.outboundIP)
+	s.mu.Lock()
+	stop, done := s.stop, s.done
+	s.stop, s.done = nil, nil
+	s.mu.Unlock()
+	if stop != nil {
+		stop()
+		<-done
+	}
 
-	// Remove SNAT rule by comment
-	cmd := exec.Command("iptables",
-		"-t", "nat",
-		"-D", "POSTROUTING",
-		"-s", s.vpsSubnet,
+	logger.Info("Removing iptables SNAT rules for %s", s.outboundIP)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.existingRules()
+	if err != nil {
+		return err
+	}
+	for source, rules := range existing {
+		for _, rule := range rules {
+			if err := s.deleteRule(rule); err != nil {
+				return fmt.Errorf("failed to remove SNAT rule for %s: %w", source, err)
+			}
+		}
+	}
+
+	logger.Info("Successfully removed SNAT rules for %s", s.outboundIP)
+	return s.removeIPv6Masquerade()
+}
+
+// reconcile makes the SNAT rules match the lease store: one rule per active lease in the VPS
+// subnet, with duplicates and rules for other sources (expired leases, the subnet-wide rule
+// of older gateway versions) removed
+func (s *SNATManager) reconcile() error {
+	leaseIPs, err := s.leases.ActiveLeaseIPs()
+	if err != nil {
+		return fmt.Errorf("failed to list active leases: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wanted := make(map[string]bool, len(leaseIPs))
+	for _, leaseIP := range leaseIPs {
+		source, err := s.leaseSource(leaseIP)
+		if err != nil {
+			logger.Debug("Not source-NATing lease %s: %v", leaseIP, err)
+			continue
+		}
+		wanted[source] = true
+	}
+
+	existing, err := s.existingRules()
+	if err != nil {
+		return err
+	}
+
+	added, removed := 0, 0
+	for source, rules := range existing {
+		keep := 0
+		if wanted[source] {
+			keep = 1
+		}
+		for _, rule := range rules[keep:] {
+			if err := s.deleteRule(rule); err != nil {
+				return fmt.Errorf("failed to remove stale SNAT rule for %s: %w", source, err)
+			}
+			removed++
+		}
+	}
+	for source := range wanted {
+		if len(existing[source]) > 0 {
+			continue
+		}
+		if err := s.addRule(source); err != nil {
+			return err
+		}
+		added++
+	}
+
+	if added > 0 || removed > 0 {
+		logger.Info("Reconciled SNAT rules: %d added, %d removed, %d active leases", added, removed, len(wanted))
+	}
+	return nil
+}
+
+// leaseSource returns the rule source (a /32 CIDR) for a lease in the VPS subnet
+func (s *SNATManager) leaseSource(leaseIP string) (string, error) {
+	ip := net.ParseIP(strings.TrimSpace(leaseIP))
+	if ip == nil || ip.To4() == nil {
+		return "", fmt.Errorf("invalid lease IP address: %s", leaseIP)
+	}
+	_, subnet, err := net.ParseCIDR(s.vpsSubnet)
+	if err != nil {
+		return "", fmt.Errorf("invalid VPS subnet %s: %w", s.vpsSubnet, err)
+	}
+	if !subnet.Contains(ip) {
+		return "", fmt.Errorf("lease IP %s is outside the VPS subnet %s", ip, s.vpsSubnet)
+	}
+	return ip.To4().String() + "/32", nil
+}
+
+// existingRules returns our POSTROUTING rules from iptables-save, as their rule specs grouped by source
+func (s *SNATManager) existingRules() (map[string][][]string, error) {
+	output, err := s.iptables.SaveNAT()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list iptables rules: %w", err)
+	}
+	return parseSNATRules(string(output), s.ruleComment), nil
+}
+
+// parseSNATRules extracts the POSTROUTING rules tagged with comment from iptables-save output
+//
+// Line format:
+// -A POSTROUTING -s 10.15.3.5/32 -o eth0 -m comment --comment vps-gateway-snat-203.0.113.10 -j SNAT --to-source 203.0.113.10
+func parseSNATRules(save, comment string) map[string][][]string {
+	rules := make(map[string][][]string)
+	for _, line := range strings.Split(save, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "-A" || fields[1] != "POSTROUTING" {
+			continue
+		}
+		source, tagged := "", false
+		for i := 2; i+1 < len(fields); i++ {
+			switch fields[i] {
+			case "-s":
+				source = fields[i+1]
+			case "--comment":
+				tagged = strings.Trim(fields[i+1], `"`) == comment
+			}
+		}
+		if !tagged {
+			continue
+		}
+		if !strings.Contains(source, "/") {
+			source += "/32"
+		}
+		rules[source] = append(rules[source], fields[2:])
+	}
+	return rules
+}
+
+// snatRuleSpec builds the rule spec for a source, in the order iptables-save prints it
+func (s *SNATManager) snatRuleSpec(source string) []string {
+	return []string{
+		"-s", source,
 		"-o", s.outboundIface,
-		"-j", "SNAT",
-		"--to-source", s.outboundIP,
 		"-m", "comment",
 		"--comment", s.ruleComment,
-	)
+		"-j", "SNAT",
+		"--to-source", s.outboundIP,
+	}
+}
+
+// addRule appends the SNAT rule for source: iptables -t nat -A POSTROUTING -s <lease>/32 -o <interface> ... -j SNAT --to-source <outbound-ip>
+func (s *SNATManager) addRule(source string) error {
+	args := append([]string{"-t", "nat", "-A", "POSTROUTING"}, s.snatRuleSpec(source)...)
+	output, err := s.iptables.Run(args...)
+	if err != nil {
+		return fmt.Errorf("failed to add SNAT rule for %s: %w (output: %s)", source, err, string(output))
+	}
+	return nil
+}
 
-	output, err := cmd.CombinedOutput()
+// deleteRule deletes a rule by the spec it was listed with, so rules with an outdated interface are removed too
+func (s *SNATManager) deleteRule(spec []string) error {
+	args := append([]string{"-t", "nat", "-D", "POSTROUTING"}, spec...)
+	output, err := s.iptables.Run(args...)
 	if err != nil {
 		// Rule might not exist, which is fine
 		if strings.Contains(string(output), "No chain/target/match") ||
 			strings.Contains(string(output), "Bad rule") {
-			logger.Info("SNAT rule not found (may have been removed already)")
 			return nil
 		}
-		return fmt.Errorf("failed to remove SNAT rule: %w (output: %s)", err, string(output))
+		return fmt.Errorf("%w (output: %s)", err, string(output))
 	}
+	return nil
+}
 
-	logger.Info("Successfully removed SNAT rule for %s", s.outboundIP)
+// SetIPv6Prefix sets the VPS IPv6 prefix (CIDR notation) masqueraded by ConfigureIPv6Masquerade
+func (s *SNATManager) SetIPv6Prefix(prefix string) error {
+	if s == nil {
+		return nil
+	}
+	ip, network, err := net.ParseCIDR(prefix)
+	if err != nil || ip.To4() != nil {
+		return fmt.Errorf("invalid IPv6 prefix: %s", prefix)
+	}
+	s.ipv6Prefix = network.String()
 	return nil
 }
 
-// ruleExists checks if the SNAT rule already exists
-func (s *SNATManager) ruleExists() (bool, error) {
-	// List existing rules and check for our comment
-	cmd := exec.Command("iptables",
-		"-t", "nat",
-		"-L", "POSTROUTING",
-		"-n", "-v",
-	)
+// ConfigureIPv6Masquerade sets up an ip6tables MASQUERADE rule for outbound VPS IPv6 traffic
+func (s *SNATManager) ConfigureIPv6Masquerade() error {
+	if s == nil || s.ipv6Prefix == "" {
+		return nil
+	}
+
+	logger.Info("Configuring ip6tables MASQUERADE: %s on interface %s", s.ipv6Prefix, s.outboundIface)
+
+	// -C exits non-zero when the rule does not exist yet
+	if err := exec.Command("ip6tables", s.ipv6MasqueradeArgs("-C")...).Run(); err == nil {
+		logger.Info("IPv6 MASQUERADE rule already exists, skipping configuration")
+		return nil
+	}
+
+	output, err := exec.Command("ip6tables", s.ipv6MasqueradeArgs("-A")...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to add IPv6 MASQUERADE rule: %w (output: %s)", err, string(output))
+	}
+
+	logger.Info("Successfully configured IPv6 MASQUERADE rule: %s on %s", s.ipv6Prefix, s.outboundIface)
+	return nil
+}
+
+// removeIPv6Masquerade removes the ip6tables MASQUERADE rule, if IPv6 is enabled
+func (s *SNATManager) removeIPv6Masquerade() error {
+	if s.ipv6Prefix == "" {
+		return nil
+	}
 
-	output, err := cmd.CombinedOutput()
+	output, err := exec.Command("ip6tables", s.ipv6MasqueradeArgs("-D")...).CombinedOutput()
 	if err != nil {
-		return false, fmt.Errorf("failed to list iptables rules: %w", err)
+		// Rule might not exist, which is fine
+		if strings.Contains(string(output), "No chain/target/match") ||
+			strings.Contains(string(output), "Bad rule") {
+			logger.Info("IPv6 MASQUERADE rule not found (may have been removed already)")
+			return nil
+		}
+		return fmt.Errorf("failed to remove IPv6 MASQUERADE rule: %w (output: %s)", err, string(output))
 	}
 
-	// Check if our comment appears in the output
-	return strings.Contains(string(output), s.ruleComment), nil
+	logger.Info("Successfully removed IPv6 MASQUERADE rule for %s", s.ipv6Prefix)
+	return nil
+}
+
+// ipv6MasqueradeArgs builds the ip6tables arguments for the MASQUERADE rule with the given action (-A, -C or -D)
+func (s *SNATManager) ipv6MasqueradeArgs(action string) []string {
+	return []string{
+		"-t", "nat",
+		action, "POSTROUTING",
+		"-s", s.ipv6Prefix,
+		"-o", s.outboundIface,
+		"-j", "MASQUERADE",
+		"-m", "comment",
+		"--comment", s.ruleComment + "-ipv6",
+	}
 }
 
 // calculateSubnetCIDR calculates the subnet CIDR from gateway IP and subnet mask
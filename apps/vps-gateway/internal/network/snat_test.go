@@ -0,0 +1,138 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestIPv6MasqueradeArgs(t *testing.T) {
+	s := &SNATManager{outboundIP: "203.0.113.10", outboundIface: "eth0", ruleComment: "vps-gateway-snat-203.0.113.10"}
+	if err := s.SetIPv6Prefix("10.0.0.0/8"); err == nil {
+		t.Fatal("SetIPv6Prefix accepted an IPv4 prefix")
+	}
+	if err := s.SetIPv6Prefix("fd00::1/48"); err != nil {
+		t.Fatalf("SetIPv6Prefix: %v", err)
+	}
+
+	got := strings.Join(s.ipv6MasqueradeArgs("-A"), " ")
+	want := "-t nat -A POSTROUTING -s fd00::/48 -o eth0 -j MASQUERADE -m comment --comment vps-gateway-snat-203.0.113.10-ipv6"
+	if got != want {
+		t.Fatalf("ipv6MasqueradeArgs = %q, want %q", got, want)
+	}
+}
+
+// fakeIPTables keeps the nat POSTROUTING chain in memory, like the kernel across gateway restarts
+type fakeIPTables struct {
+	mu    sync.Mutex
+	rules []string // Rule specs in iptables-save order, without "-A POSTROUTING"
+}
+
+func (f *fakeIPTables) Run(args ...string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(args) < 4 || args[0] != "-t" || args[1] != "nat" || args[3] != "POSTROUTING" {
+		return nil, fmt.Errorf("unexpected iptables call: %v", args)
+	}
+	spec := strings.Join(args[4:], " ")
+	switch args[2] {
+	case "-A":
+		f.rules = append(f.rules, spec)
+		return nil, nil
+	case "-D":
+		for i, rule := range f.rules {
+			if rule == spec {
+				f.rules = append(f.rules[:i], f.rules[i+1:]...)
+				return nil, nil
+			}
+		}
+		return []byte("iptables: Bad rule (does a matching rule exist in that chain?)."), errors.New("exit status 1")
+	}
+	return nil, fmt.Errorf("unexpected iptables action %s", args[2])
+}
+
+func (f *fakeIPTables) SaveNAT() ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out strings.Builder
+	out.WriteString("*nat\n:PREROUTING ACCEPT [0:0]\n:POSTROUTING ACCEPT [0:0]\n")
+	for _, rule := range f.rules {
+		out.WriteString("-A POSTROUTING " + rule + "\n")
+	}
+	out.WriteString("COMMIT\n")
+	return []byte(out.String()), nil
+}
+
+type fakeLeaseStore []string
+
+func (f fakeLeaseStore) ActiveLeaseIPs() ([]string, error) { return f, nil }
+
+func TestSNATManagerRestoresLeaseRulesAfterRestart(t *testing.T) {
+	const comment = "--comment vps-gateway-snat-203.0.113.10"
+	leaseRule := func(ip string) string {
+		return "-s " + ip + "/32 -o eth0 -m comment " + comment + " -j SNAT --to-source 203.0.113.10"
+	}
+	docker := "-s 172.17.0.0/16 ! -o docker0 -j MASQUERADE"
+	iptables := &fakeIPTables{rules: []string{
+		docker,
+		leaseRule("10.15.3.5"),
+		leaseRule("10.15.3.99"), // Lease expired while the gateway was down
+		"-s 10.15.3.0/24 -o eth0 -m comment " + comment + " -j SNAT --to-source 203.0.113.10", // Subnet-wide rule of older gateways
+	}}
+	leases := fakeLeaseStore{"10.15.3.5", "10.15.3.6", "192.168.1.7"}
+
+	assertRules := func(want ...string) {
+		t.Helper()
+		iptables.mu.Lock()
+		got := append([]string(nil), iptables.rules...)
+		iptables.mu.Unlock()
+		sort.Strings(got)
+		sort.Strings(want)
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Fatalf("POSTROUTING rules:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+		}
+	}
+
+	start := func() *SNATManager {
+		t.Helper()
+		s, err := newSNATManager("203.0.113.10", "10.15.3.1", "24", "eth0", leases, iptables)
+		if err != nil {
+			t.Fatalf("newSNATManager: %v", err)
+		}
+		if err := s.Start(context.Background()); err != nil {
+			t.Fatalf("Start: %v", err)
+		}
+		return s
+	}
+
+	// Existing rules are kept without duplicates; stale ones go; the out-of-subnet lease is ignored
+	s := start()
+	assertRules(docker, leaseRule("10.15.3.5"), leaseRule("10.15.3.6"))
+	if err := s.ApplySNAT("10.15.3.6"); err != nil {
+		t.Fatalf("ApplySNAT: %v", err)
+	}
+	assertRules(docker, leaseRule("10.15.3.5"), leaseRule("10.15.3.6"))
+
+	// Restart: shutting down removes the gateway's rules, starting again restores them
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	assertRules(docker)
+	s = start()
+	assertRules(docker, leaseRule("10.15.3.5"), leaseRule("10.15.3.6"))
+
+	// Later reconciles follow lease changes
+	s.leases = fakeLeaseStore{"10.15.3.6", "10.15.3.7"}
+	if err := s.reconcile(); err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	assertRules(docker, leaseRule("10.15.3.6"), leaseRule("10.15.3.7"))
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	assertRules(docker)
+}
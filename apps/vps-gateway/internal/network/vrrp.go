@@ -0,0 +1,456 @@
+package network
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"vps-gateway/internal/logger"
+
+	"golang.org/x/sys/unix"
+)
+
+// VRRP states reported by keepalived through its notify FIFO
+const (
+	VRRPStateInit   = "INIT"
+	VRRPStateBackup = "BACKUP"
+	VRRPStateMaster = "MASTER"
+	VRRPStateFault  = "FAULT"
+)
+
+const (
+	// vrrpAdvertInterval is how often the master advertises; a backup declares it dead after
+	// three missed adverts plus a priority-based skew, so takeover happens in under a second
+	vrrpAdvertInterval = 250 * time.Millisecond
+	// vrrpInstanceName is the keepalived vrrp_instance name written to the config
+	vrrpInstanceName = "VPS_GATEWAY"
+	// vrrpRestartDelay is how long to wait before restarting keepalived after it exits
+	vrrpRestartDelay = time.Second
+	// defaultVRRPConfigDir holds the generated keepalived config and notify FIFO
+	defaultVRRPConfigDir = "/run/vps-gateway"
+)
+
+// keepalivedCommand builds the command that runs keepalived in the foreground with configFile
+// The default execs keepalived; tests substitute a stand-in VRRP speaker
+type keepalivedCommand func(configFile, pidFile string) *exec.Cmd
+
+func execKeepalived(configFile, pidFile string) *exec.Cmd {
+	return exec.Command("keepalived",
+		"--dont-fork",
+		"--log-console",
+		"--vrrp",
+		"--use-file="+configFile,
+		"--vrrp_pid="+pidFile,
+	)
+}
+
+// VRRPConfig configures the gateway's VRRP instance
+type VRRPConfig struct {
+	Interface    string   // Interface VRRP adverts are sent on and the virtual IP is added to
+	VirtualIP    string   // Virtual IP in CIDR notation (e.g., "10.15.3.1/24")
+	Priority     int      // 1-254; the highest priority gateway becomes master
+	RouterID     int      // 1-255; must match on all gateways sharing the virtual IP
+	UnicastPeers []string // Peer addresses for unicast adverts (multicast when empty)
+	ConfigDir    string   // Directory for the generated keepalived config and notify FIFO
+}
+
+// VRRPManager runs keepalived to share a virtual gateway IP between a primary and a secondary gateway
+//
+// Both gateways run keepalived in BACKUP state with different priorities, so the higher
+// priority gateway is elected master and holds the virtual IP. keepalived reports state
+// changes on a notify FIFO; when this gateway becomes master the OnMaster hooks re-apply
+// state the previous master held (SNAT rules, DHCP leases) from the persistent store.
+type VRRPManager struct {
+	config     VRRPConfig
+	configFile string
+	pidFile    string
+	fifoPath   string
+	command    keepalivedCommand
+
+	mu          sync.Mutex
+	state       string
+	stateSince  time.Time
+	transitions int
+	onMaster    []func() error
+	cmd         *exec.Cmd
+	fifo        *os.File
+	closed      bool
+	done        chan struct{}
+}
+
+// NewVRRPManagerFromEnv creates a VRRP manager from the GATEWAY_VRRP_* environment variables
+// Returns nil if GATEWAY_VRRP_VIRTUAL_IP is not set (HA disabled)
+func NewVRRPManagerFromEnv() (*VRRPManager, error) {
+	virtualIP := strings.TrimSpace(os.Getenv("GATEWAY_VRRP_VIRTUAL_IP"))
+	if virtualIP == "" {
+		return nil, nil
+	}
+
+	config := VRRPConfig{
+		Interface: strings.TrimSpace(os.Getenv("GATEWAY_VRRP_INTERFACE")),
+		VirtualIP: virtualIP,
+		Priority:  100,
+		RouterID:  51,
+		ConfigDir: defaultVRRPConfigDir,
+	}
+	if value := os.Getenv("GATEWAY_VRRP_PRIORITY"); value != "" {
+		priority, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GATEWAY_VRRP_PRIORITY %q: %w", value, err)
+		}
+		config.Priority = priority
+	}
+	if value := os.Getenv("GATEWAY_VRRP_ROUTER_ID"); value != "" {
+		routerID, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GATEWAY_VRRP_ROUTER_ID %q: %w", value, err)
+		}
+		config.RouterID = routerID
+	}
+	for _, peer := range strings.Split(os.Getenv("GATEWAY_VRRP_UNICAST_PEERS"), ",") {
+		if peer = strings.TrimSpace(peer); peer != "" {
+			config.UnicastPeers = append(config.UnicastPeers, peer)
+		}
+	}
+	if dir := os.Getenv("GATEWAY_VRRP_CONFIG_DIR"); dir != "" {
+		config.ConfigDir = dir
+	}
+	return NewVRRPManager(config)
+}
+
+// NewVRRPManager creates a VRRP manager for config
+func NewVRRPManager(config VRRPConfig) (*VRRPManager, error) {
+	return newVRRPManager(config, execKeepalived)
+}
+
+func newVRRPManager(config VRRPConfig, command keepalivedCommand) (*VRRPManager, error) {
+	if config.Interface == "" {
+		return nil, errors.New("GATEWAY_VRRP_INTERFACE is required when GATEWAY_VRRP_VIRTUAL_IP is set")
+	}
+	virtualIP, err := parseVirtualIP(config.VirtualIP)
+	if err != nil {
+		return nil, err
+	}
+	config.VirtualIP = virtualIP
+	if config.Priority < 1 || config.Priority > 254 {
+		return nil, fmt.Errorf("invalid VRRP priority %d (must be between 1 and 254)", config.Priority)
+	}
+	if config.RouterID < 1 || config.RouterID > 255 {
+		return nil, fmt.Errorf("invalid VRRP router ID %d (must be between 1 and 255)", config.RouterID)
+	}
+	for _, peer := range config.UnicastPeers {
+		if ip := net.ParseIP(peer); ip == nil || ip.To4() == nil {
+			return nil, fmt.Errorf("invalid VRRP unicast peer address: %s", peer)
+		}
+	}
+	if config.ConfigDir == "" {
+		config.ConfigDir = defaultVRRPConfigDir
+	}
+
+	return &VRRPManager{
+		config:     config,
+		configFile: filepath.Join(config.ConfigDir, "keepalived.conf"),
+		pidFile:    filepath.Join(config.ConfigDir, "keepalived.pid"),
+		fifoPath:   filepath.Join(config.ConfigDir, "keepalived.fifo"),
+		command:    command,
+		state:      VRRPStateInit,
+		stateSince: time.Now(),
+	}, nil
+}
+
+// parseVirtualIP validates an IPv4 virtual IP, given with or without a prefix length (a bare address is a /32)
+func parseVirtualIP(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if !strings.Contains(value, "/") {
+		value += "/32"
+	}
+	ip, subnet, err := net.ParseCIDR(value)
+	if err != nil || ip.To4() == nil {
+		return "", fmt.Errorf("invalid VRRP virtual IP address: %s", value)
+	}
+	ones, _ := subnet.Mask.Size()
+	return fmt.Sprintf("%s/%d", ip.To4(), ones), nil
+}
+
+// OnMaster registers fn to run every time this gateway becomes the VRRP master
+// Hooks run in registration order; a failing hook is logged and does not stop the others
+func (v *VRRPManager) OnMaster(fn func() error) {
+	if v == nil {
+		return
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.onMaster = append(v.onMaster, fn)
+}
+
+// Start writes the keepalived config, starts keepalived and follows its state changes
+// keepalived is restarted if it exits until Close is called
+func (v *VRRPManager) Start() error {
+	if v == nil {
+		// No manager (HA not configured)
+		return nil
+	}
+
+	logger.Info("Configuring VRRP: virtual IP %s on %s (router ID %d, priority %d)", v.config.VirtualIP, v.config.Interface, v.config.RouterID, v.config.Priority)
+	if err := os.MkdirAll(v.config.ConfigDir, 0755); err != nil {
+		return fmt.Errorf("failed to create VRRP config directory: %w", err)
+	}
+	if err := os.WriteFile(v.configFile, []byte(v.keepalivedConfig()), 0644); err != nil {
+		return fmt.Errorf("failed to write keepalived config: %w", err)
+	}
+
+	// keepalived writes one line per state change to the FIFO. Opening it read-write keeps it
+	// from reporting EOF while keepalived restarts and lets keepalived open it without blocking.
+	_ = os.Remove(v.fifoPath)
+	if err := unix.Mkfifo(v.fifoPath, 0600); err != nil {
+		return fmt.Errorf("failed to create keepalived notify FIFO: %w", err)
+	}
+	fifo, err := os.OpenFile(v.fifoPath, os.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open keepalived notify FIFO: %w", err)
+	}
+
+	v.mu.Lock()
+	v.fifo = fifo
+	v.done = make(chan struct{})
+	v.mu.Unlock()
+
+	if err := v.startKeepalived(); err != nil {
+		fifo.Close()
+		return err
+	}
+
+	go v.readNotifications(fifo)
+	return nil
+}
+
+// startKeepalived starts keepalived and restarts it if it exits before Close
+func (v *VRRPManager) startKeepalived() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.closed {
+		return nil
+	}
+
+	cmd := v.command(v.configFile, v.pidFile)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start keepalived: %w", err)
+	}
+	v.cmd = cmd
+
+	go func() {
+		err := cmd.Wait()
+		v.mu.Lock()
+		closed := v.closed
+		v.mu.Unlock()
+		if closed {
+			return
+		}
+
+		// The virtual IP is gone with keepalived; report a fault until it is back
+		logger.Error("keepalived exited unexpectedly: %v; restarting in %s", err, vrrpRestartDelay)
+		v.setState(VRRPStateFault)
+		time.Sleep(vrrpRestartDelay)
+		if err := v.startKeepalived(); err != nil {
+			logger.Error("Failed to restart keepalived: %v", err)
+		}
+	}()
+	return nil
+}
+
+// readNotifications applies the state changes keepalived writes to the notify FIFO
+// Lines have the form: INSTANCE "VPS_GATEWAY" MASTER 150
+func (v *VRRPManager) readNotifications(fifo *os.File) {
+	defer close(v.done)
+	scanner := bufio.NewScanner(fifo)
+	for scanner.Scan() {
+		state, ok := parseVRRPNotification(scanner.Text())
+		if !ok {
+			continue
+		}
+		if v.setState(state) && state == VRRPStateMaster {
+			v.runOnMaster()
+		}
+	}
+	if err := scanner.Err(); err != nil && !errors.Is(err, os.ErrClosed) {
+		logger.Warn("Stopped reading keepalived notifications: %v", err)
+	}
+}
+
+// parseVRRPNotification returns the state from a notify FIFO line for our VRRP instance
+func parseVRRPNotification(line string) (string, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 3 || fields[0] != "INSTANCE" || strings.Trim(fields[1], `"`) != vrrpInstanceName {
+		return "", false
+	}
+	switch state := fields[2]; state {
+	case VRRPStateMaster, VRRPStateBackup, VRRPStateFault:
+		return state, true
+	case "STOP":
+		return VRRPStateInit, true
+	}
+	return "", false
+}
+
+// setState records a state change and reports whether the state actually changed
+func (v *VRRPManager) setState(state string) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.state == state {
+		return false
+	}
+	logger.Info("VRRP state changed: %s -> %s", v.state, state)
+	v.state = state
+	v.stateSince = time.Now()
+	v.transitions++
+	return true
+}
+
+// runOnMaster runs the OnMaster hooks after this gateway takes over the virtual IP
+func (v *VRRPManager) runOnMaster() {
+	v.mu.Lock()
+	hooks := append([]func() error(nil), v.onMaster...)
+	v.mu.Unlock()
+
+	start := time.Now()
+	for _, hook := range hooks {
+		if err := hook(); err != nil {
+			logger.Error("Failed to re-apply gateway state after becoming VRRP master: %v", err)
+		}
+	}
+	logger.Info("Re-applied gateway state as VRRP master in %s", time.Since(start).Round(time.Millisecond))
+}
+
+// State returns the current VRRP state (INIT until keepalived reports one)
+func (v *VRRPManager) State() string {
+	if v == nil {
+		return ""
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.state
+}
+
+// VRRPStatus is the /health/vrrp response body
+type VRRPStatus struct {
+	Enabled     bool      `json:"enabled"`
+	State       string    `json:"state,omitempty"`
+	Since       time.Time `json:"since"`
+	Transitions int       `json:"transitions"`
+	Interface   string    `json:"interface,omitempty"`
+	VirtualIP   string    `json:"virtual_ip,omitempty"`
+	Priority    int       `json:"priority,omitempty"`
+	RouterID    int       `json:"router_id,omitempty"`
+}
+
+// Status returns the current VRRP state with the instance configuration
+func (v *VRRPManager) Status() VRRPStatus {
+	if v == nil {
+		return VRRPStatus{}
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return VRRPStatus{
+		Enabled:     true,
+		State:       v.state,
+		Since:       v.stateSince,
+		Transitions: v.transitions,
+		Interface:   v.config.Interface,
+		VirtualIP:   v.config.VirtualIP,
+		Priority:    v.config.Priority,
+		RouterID:    v.config.RouterID,
+	}
+}
+
+// HealthHandler serves the VRRP status as JSON for /health/vrrp
+// Responds 503 while keepalived reports a fault or has not reported a state yet
+func (v *VRRPManager) HealthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := v.Status()
+		code := http.StatusOK
+		if status.Enabled && status.State != VRRPStateMaster && status.State != VRRPStateBackup {
+			code = http.StatusServiceUnavailable
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			logger.Debug("Failed to write VRRP status: %v", err)
+		}
+	})
+}
+
+// Close stops keepalived, which releases the virtual IP so the peer takes over
+func (v *VRRPManager) Close() error {
+	if v == nil {
+		// No manager (HA not configured)
+		return nil
+	}
+
+	v.mu.Lock()
+	v.closed = true
+	cmd, fifo, done := v.cmd, v.fifo, v.done
+	v.mu.Unlock()
+
+	if cmd != nil && cmd.Process != nil {
+		// SIGTERM makes keepalived send a priority 0 advert, so the backup takes over immediately
+		if err := cmd.Process.Signal(unix.SIGTERM); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			logger.Warn("Failed to stop keepalived: %v", err)
+		}
+	}
+	if fifo != nil {
+		fifo.Close()
+		<-done
+	}
+	_ = os.Remove(v.fifoPath)
+
+	v.setState(VRRPStateInit)
+	logger.Info("Stopped VRRP for virtual IP %s", v.config.VirtualIP)
+	return nil
+}
+
+// keepalivedConfig renders the keepalived config for the VRRP instance
+//
+// Every gateway starts as BACKUP and the highest priority one is elected master, so a
+// recovered primary takes the virtual IP back from the secondary.
+func (v *VRRPManager) keepalivedConfig() string {
+	var b strings.Builder
+	b.WriteString("# Generated by vps-gateway; changes are overwritten on restart\n")
+	b.WriteString("global_defs {\n")
+	b.WriteString("    router_id vps-gateway\n")
+	b.WriteString("    vrrp_version 3\n")
+	b.WriteString("    vrrp_garp_master_delay 0\n")
+	b.WriteString("    vrrp_garp_master_refresh 60\n")
+	fmt.Fprintf(&b, "    notify_fifo %s\n", v.fifoPath)
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(&b, "vrrp_instance %s {\n", vrrpInstanceName)
+	b.WriteString("    state BACKUP\n")
+	fmt.Fprintf(&b, "    interface %s\n", v.config.Interface)
+	fmt.Fprintf(&b, "    virtual_router_id %d\n", v.config.RouterID)
+	fmt.Fprintf(&b, "    priority %d\n", v.config.Priority)
+	fmt.Fprintf(&b, "    advert_int %s\n", strconv.FormatFloat(vrrpAdvertInterval.Seconds(), 'f', -1, 64))
+	if len(v.config.UnicastPeers) > 0 {
+		b.WriteString("    unicast_peer {\n")
+		for _, peer := range v.config.UnicastPeers {
+			fmt.Fprintf(&b, "        %s\n", peer)
+		}
+		b.WriteString("    }\n")
+	}
+	b.WriteString("    virtual_ipaddress {\n")
+	fmt.Fprintf(&b, "        %s dev %s\n", v.config.VirtualIP, v.config.Interface)
+	b.WriteString("    }\n")
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,364 @@
+package network
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestKeepalivedConfig(t *testing.T) {
+	v, err := newVRRPManager(VRRPConfig{
+		Interface:    "vmbr1",
+		VirtualIP:    "10.15.3.1/24",
+		Priority:     150,
+		RouterID:     42,
+		UnicastPeers: []string{"192.0.2.11"},
+		ConfigDir:    "/run/test",
+	}, execKeepalived)
+	if err != nil {
+		t.Fatalf("newVRRPManager: %v", err)
+	}
+
+	config := v.keepalivedConfig()
+	for _, want := range []string{
+		"vrrp_version 3",
+		"notify_fifo /run/test/keepalived.fifo",
+		"vrrp_instance VPS_GATEWAY {",
+		"state BACKUP",
+		"interface vmbr1",
+		"virtual_router_id 42",
+		"priority 150",
+		"advert_int 0.25",
+		"unicast_peer {\n        192.0.2.11\n    }",
+		"virtual_ipaddress {\n        10.15.3.1/24 dev vmbr1\n    }",
+	} {
+		if !strings.Contains(config, want) {
+			t.Errorf("keepalived config is missing %q:\n%s", want, config)
+		}
+	}
+}
+
+func TestNewVRRPManagerValidation(t *testing.T) {
+	valid := VRRPConfig{Interface: "eth0", VirtualIP: "10.15.3.1", Priority: 100, RouterID: 51}
+	v, err := newVRRPManager(valid, execKeepalived)
+	if err != nil {
+		t.Fatalf("newVRRPManager: %v", err)
+	}
+	if v.config.VirtualIP != "10.15.3.1/32" {
+		t.Fatalf("virtual IP = %s, want a /32 for a bare address", v.config.VirtualIP)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*VRRPConfig)
+	}{
+		{"no interface", func(c *VRRPConfig) { c.Interface = "" }},
+		{"invalid virtual IP", func(c *VRRPConfig) { c.VirtualIP = "10.15.3" }},
+		{"IPv6 virtual IP", func(c *VRRPConfig) { c.VirtualIP = "fd00::1/64" }},
+		{"priority too low", func(c *VRRPConfig) { c.Priority = 0 }},
+		{"address owner priority", func(c *VRRPConfig) { c.Priority = 255 }},
+		{"router ID out of range", func(c *VRRPConfig) { c.RouterID = 256 }},
+		{"invalid unicast peer", func(c *VRRPConfig) { c.UnicastPeers = []string{"gateway-2"} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			if _, err := newVRRPManager(config, execKeepalived); err == nil {
+				t.Fatalf("newVRRPManager accepted %+v", config)
+			}
+		})
+	}
+}
+
+func TestParseVRRPNotification(t *testing.T) {
+	tests := []struct {
+		line  string
+		state string
+		ok    bool
+	}{
+		{`INSTANCE "VPS_GATEWAY" MASTER 150`, VRRPStateMaster, true},
+		{`INSTANCE "VPS_GATEWAY" BACKUP 100`, VRRPStateBackup, true},
+		{`INSTANCE "VPS_GATEWAY" FAULT 100`, VRRPStateFault, true},
+		{`INSTANCE "VPS_GATEWAY" STOP 100`, VRRPStateInit, true},
+		{`INSTANCE "OTHER" MASTER 100`, "", false},
+		{`GROUP "VPS_GATEWAY" MASTER`, "", false},
+		{`INSTANCE "VPS_GATEWAY" DELETED 100`, "", false},
+		{``, "", false},
+	}
+	for _, tt := range tests {
+		state, ok := parseVRRPNotification(tt.line)
+		if state != tt.state || ok != tt.ok {
+			t.Errorf("parseVRRPNotification(%q) = %q, %v, want %q, %v", tt.line, state, ok, tt.state, tt.ok)
+		}
+	}
+}
+
+func TestVRRPHealthHandler(t *testing.T) {
+	serve := func(v *VRRPManager) (int, VRRPStatus) {
+		t.Helper()
+		recorder := httptest.NewRecorder()
+		v.HealthHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/health/vrrp", nil))
+		var status VRRPStatus
+		if err := json.Unmarshal(recorder.Body.Bytes(), &status); err != nil {
+			t.Fatalf("decode status: %v", err)
+		}
+		return recorder.Code, status
+	}
+
+	// HA disabled
+	if code, status := serve(nil); code != http.StatusOK || status.Enabled {
+		t.Fatalf("disabled VRRP: %d %+v, want 200 and not enabled", code, status)
+	}
+
+	v, err := newVRRPManager(VRRPConfig{Interface: "eth0", VirtualIP: "10.15.3.1/24", Priority: 150, RouterID: 51}, execKeepalived)
+	if err != nil {
+		t.Fatalf("newVRRPManager: %v", err)
+	}
+	if code, status := serve(v); code != http.StatusServiceUnavailable || status.State != VRRPStateInit {
+		t.Fatalf("before keepalived reports: %d %+v, want 503 in INIT", code, status)
+	}
+	v.setState(VRRPStateMaster)
+	code, status := serve(v)
+	if code != http.StatusOK || status.State != VRRPStateMaster || status.VirtualIP != "10.15.3.1/24" || status.Priority != 150 || status.Transitions != 1 {
+		t.Fatalf("master: %d %+v", code, status)
+	}
+	v.setState(VRRPStateFault)
+	if code, _ := serve(v); code != http.StatusServiceUnavailable {
+		t.Fatalf("fault: %d, want 503", code)
+	}
+}
+
+// TestVRRPHelperProcess is not a real test: it is the keepalived stand-in started by TestFailover
+//
+// It speaks a minimal VRRP over UDP on localhost, using the priority and advert interval from
+// the generated keepalived config and reporting state changes on its notify FIFO like keepalived.
+func TestVRRPHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_VRRP_HELPER") != "1" {
+		return
+	}
+	configFile := os.Args[len(os.Args)-1]
+	if err := runVRRPSpeaker(configFile, os.Getenv("VRRP_HELPER_LISTEN"), os.Getenv("VRRP_HELPER_PEER")); err != nil {
+		fmt.Fprintf(os.Stderr, "vrrp helper: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func runVRRPSpeaker(configFile, listenAddr, peerAddr string) error {
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+	var priority int
+	var advertInterval time.Duration
+	var fifoPath string
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		switch fields[0] {
+		case "priority":
+			priority, _ = strconv.Atoi(fields[1])
+		case "advert_int":
+			seconds, _ := strconv.ParseFloat(fields[1], 64)
+			advertInterval = time.Duration(seconds * float64(time.Second))
+		case "notify_fifo":
+			fifoPath = fields[1]
+		}
+	}
+	if priority == 0 || advertInterval == 0 || fifoPath == "" {
+		return fmt.Errorf("incomplete config %s", configFile)
+	}
+
+	fifo, err := os.OpenFile(fifoPath, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	notify := func(state string) {
+		fmt.Fprintf(fifo, "INSTANCE \"%s\" %s %d\n", vrrpInstanceName, state, priority)
+	}
+
+	conn, err := net.ListenPacket("udp", listenAddr)
+	if err != nil {
+		return err
+	}
+	peer, err := net.ResolveUDPAddr("udp", peerAddr)
+	if err != nil {
+		return err
+	}
+	adverts := make(chan int)
+	go func() {
+		buf := make([]byte, 16)
+		for {
+			n, _, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if advertPriority, err := strconv.Atoi(string(buf[:n])); err == nil {
+				adverts <- advertPriority
+			}
+		}
+	}()
+
+	// RFC 5798: a backup takes over after three missed adverts plus a skew that favours higher priorities
+	masterDown := 3*advertInterval + time.Duration(256-priority)*advertInterval/256
+	state := VRRPStateBackup
+	notify(state)
+	masterDownTimer := time.NewTimer(masterDown)
+	ticker := time.NewTicker(advertInterval)
+	for {
+		select {
+		case advertPriority := <-adverts:
+			if state == VRRPStateMaster && advertPriority > priority {
+				state = VRRPStateBackup
+				notify(state)
+			}
+			if state == VRRPStateBackup && advertPriority >= priority {
+				masterDownTimer.Reset(masterDown)
+			}
+		case <-masterDownTimer.C:
+			if state == VRRPStateBackup {
+				state = VRRPStateMaster
+				notify(state)
+			}
+		case <-ticker.C:
+			if state == VRRPStateMaster {
+				conn.WriteTo([]byte(strconv.Itoa(priority)), peer)
+			}
+		}
+	}
+}
+
+// freeUDPAddr returns a localhost UDP address that is free at the time of the call
+func freeUDPAddr(t *testing.T) string {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer conn.Close()
+	return conn.LocalAddr().String()
+}
+
+func TestFailover(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starts VRRP speaker processes")
+	}
+
+	primaryAddr, secondaryAddr := freeUDPAddr(t), freeUDPAddr(t)
+	speaker := func(listen, peer string) keepalivedCommand {
+		return func(configFile, pidFile string) *exec.Cmd {
+			cmd := exec.Command(os.Args[0], "-test.run=^TestVRRPHelperProcess$", "--", configFile)
+			cmd.Env = append(os.Environ(), "GO_WANT_VRRP_HELPER=1", "VRRP_HELPER_LISTEN="+listen, "VRRP_HELPER_PEER="+peer)
+			return cmd
+		}
+	}
+
+	// The secondary restores SNAT rules from the lease store when it takes over
+	iptables := &fakeIPTables{}
+	snat, err := newSNATManager("203.0.113.10", "10.15.3.1", "24", "eth0", fakeLeaseStore{"10.15.3.5", "10.15.3.6"}, iptables)
+	if err != nil {
+		t.Fatalf("newSNATManager: %v", err)
+	}
+
+	start := func(priority int, listen, peer string) (*VRRPManager, chan time.Time) {
+		t.Helper()
+		v, err := newVRRPManager(VRRPConfig{
+			Interface: "eth0",
+			VirtualIP: "10.15.3.1/24",
+			Priority:  priority,
+			RouterID:  51,
+			ConfigDir: t.TempDir(),
+		}, speaker(listen, peer))
+		if err != nil {
+			t.Fatalf("newVRRPManager: %v", err)
+		}
+		becameMaster := make(chan time.Time, 4)
+		v.OnMaster(func() error {
+			becameMaster <- time.Now()
+			return nil
+		})
+		if err := v.Start(); err != nil {
+			t.Fatalf("Start: %v", err)
+		}
+		t.Cleanup(func() { v.Close() })
+		return v, becameMaster
+	}
+	waitForState := func(v *VRRPManager, want string, timeout time.Duration) {
+		t.Helper()
+		deadline := time.Now().Add(timeout)
+		for v.State() != want {
+			if time.Now().After(deadline) {
+				t.Fatalf("state %s after %s, want %s", v.State(), timeout, want)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	primary, primaryMaster := start(150, primaryAddr, secondaryAddr)
+	waitForState(primary, VRRPStateMaster, 5*time.Second)
+	select {
+	case <-primaryMaster:
+	case <-time.After(time.Second):
+		t.Fatal("primary became master without running its OnMaster hooks")
+	}
+
+	secondary, secondaryMaster := start(100, secondaryAddr, primaryAddr)
+	secondary.OnMaster(snat.Reapply)
+	waitForState(secondary, VRRPStateBackup, 5*time.Second)
+	// The secondary stays backup while the primary advertises
+	time.Sleep(4 * vrrpAdvertInterval)
+	if state := secondary.State(); state != VRRPStateBackup {
+		t.Fatalf("secondary is %s while the primary is up, want BACKUP", state)
+	}
+
+	// Kill the primary the way a crashed gateway takes its keepalived down with it
+	primary.mu.Lock()
+	primary.closed = true
+	primaryProcess := primary.cmd.Process
+	primary.mu.Unlock()
+	killedAt := time.Now()
+	if err := primaryProcess.Kill(); err != nil {
+		t.Fatalf("kill primary: %v", err)
+	}
+
+	select {
+	case tookOver := <-secondaryMaster:
+		if elapsed := tookOver.Sub(killedAt); elapsed > 2*time.Second {
+			t.Fatalf("secondary took over %s after the primary died, want within 2s", elapsed)
+		} else {
+			t.Logf("secondary took over %s after the primary died", elapsed.Round(time.Millisecond))
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("secondary is %s 2s after the primary died, want MASTER", secondary.State())
+	}
+	if state := secondary.State(); state != VRRPStateMaster {
+		t.Fatalf("secondary state %s, want MASTER", state)
+	}
+
+	// The SNAT hook runs after the test hook; give it a moment to restore the lease rules
+	deadline := time.Now().Add(time.Second)
+	for {
+		output, _ := iptables.SaveNAT()
+		rules := parseSNATRules(string(output), snat.ruleComment)
+		if len(rules) == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("SNAT rules after failover: %v, want one per lease", rules)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
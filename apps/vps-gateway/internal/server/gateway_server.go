@@ -24,6 +24,7 @@ type GatewayServer struct {
 	httpServer *http.Server
 	port       int
 	apiSecret  string
+	tlsEnabled bool
 }
 
 func NewGatewayServer(dhcpManager *dhcp.Manager, sshProxy *sshproxy.Proxy, port int) (*GatewayServer, error) {
@@ -64,10 +65,17 @@ func NewGatewayServer(dhcpManager *dhcp.Manager, sshProxy *sshproxy.Proxy, port
 		IdleTimeout: 10 * time.Minute, // Allow 10 min idle before closing
 	})
 
+	// Optional TLS / mutual TLS (falls back to h2c + API secret when not configured)
+	tlsConfig, err := loadServerTLSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure TLS: %w", err)
+	}
+
 	// Create HTTP server with h2c handler
 	httpServer := &http.Server{
-		Addr:    fmt.Sprintf(":%d", port),
-		Handler: h2cHandler,
+		Addr:      fmt.Sprintf(":%d", port),
+		Handler:   h2cHandler,
+		TLSConfig: tlsConfig,
 	}
 
 	return &GatewayServer{
@@ -75,11 +83,21 @@ func NewGatewayServer(dhcpManager *dhcp.Manager, sshProxy *sshproxy.Proxy, port
 		httpServer: httpServer,
 		port:       port,
 		apiSecret:  apiSecret,
+		tlsEnabled: tlsConfig != nil,
 	}, nil
 }
 
 // Start starts the gateway server
 func (s *GatewayServer) Start() error {
+	if s.tlsEnabled {
+		mode := "TLS"
+		if s.httpServer.TLSConfig.ClientCAs != nil {
+			mode = "mutual TLS"
+		}
+		logger.Info("Starting gateway gRPC server on port %d with %s (OCG - Obiente Cloud Gateway)", s.port, mode)
+		// Certificates are already loaded into TLSConfig
+		return s.httpServer.ListenAndServeTLS("", "")
+	}
 	logger.Info("Starting gateway gRPC server on port %d (OCG - Obiente Cloud Gateway)", s.port)
 	return s.httpServer.ListenAndServe()
 }
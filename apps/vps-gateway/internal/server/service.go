@@ -85,8 +85,7 @@ func (s *GatewayService) AllocateIP(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to allocate IP: %w", err))
 	}
 
-	// Record metrics
-	metrics.RecordDHCPAllocation(req.Msg.OrganizationId)
+	// The allocation itself is counted once dnsmasq acknowledges the lease
 
 	// Update active allocations count for this org
 	orgAllocs, _ := s.dhcpManager.ListIPs(ctx, req.Msg.OrganizationId, "")
@@ -280,6 +279,28 @@ func (s *GatewayService) ListIPs(
 	return connect.NewResponse(resp), nil
 }
 
+// UpdateBandwidth re-applies the bandwidth limits of a VPS's lease
+func (s *GatewayService) UpdateBandwidth(
+	ctx context.Context,
+	req *connect.Request[vpsgatewayv1.UpdateBandwidthRequest],
+) (*connect.Response[vpsgatewayv1.UpdateBandwidthResponse], error) {
+	if req.Msg.VpsId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("vps_id is required"))
+	}
+
+	err := s.dhcpManager.UpdateBandwidth(req.Msg.VpsId, int(req.Msg.MaxMbitDown), int(req.Msg.MaxMbitUp))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed to update bandwidth: %w", err))
+	}
+
+	resp := &vpsgatewayv1.UpdateBandwidthResponse{
+		Success: true,
+		Message: fmt.Sprintf("Updated bandwidth limits for VPS %s", req.Msg.VpsId),
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 // ProxySSH proxies SSH connections via bidirectional stream
 func (s *GatewayService) ProxySSH(
 	ctx context.Context,
@@ -300,6 +321,7 @@ func (s *GatewayService) ProxySSH(
 
 	var connectionID string
 	startTime := time.Now()
+	establishedSessions := 0
 
 	logger.Info("[GatewayService] ProxySSH stream opened")
 
@@ -324,6 +346,9 @@ func (s *GatewayService) ProxySSH(
 			metrics.RecordSSHProxyConnectionDuration(connectionID, connectionID, duration)
 			metrics.SetSSHProxyConnectionsActive(-1)
 		}
+		for i := 0; i < establishedSessions; i++ {
+			metrics.SSHProxySessionEnded()
+		}
 	}()
 
 	for {
@@ -497,6 +522,8 @@ func (s *GatewayService) ProxySSH(
 			// Record metrics
 			metrics.RecordSSHProxyConnection(connectionID, connectionID)
 			metrics.SetSSHProxyConnectionsActive(1)
+			metrics.SSHProxySessionStarted()
+			establishedSessions++
 
 		case "data":
 			// Forward data from client to target (write to clientPipe)
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// loadServerTLSConfig builds the gateway's TLS configuration from the environment
+//
+// GATEWAY_TLS_CERT_PATH / GATEWAY_TLS_KEY_PATH enable TLS. GATEWAY_CA_CERT_PATH additionally
+// requires vps-service to present a client certificate signed by that CA (mutual TLS).
+// Returns nil when no certificate is configured; the server then keeps serving cleartext
+// HTTP/2 (h2c) authenticated by GATEWAY_API_SECRET only.
+func loadServerTLSConfig() (*tls.Config, error) {
+	certPath := os.Getenv("GATEWAY_TLS_CERT_PATH")
+	keyPath := os.Getenv("GATEWAY_TLS_KEY_PATH")
+	caPath := os.Getenv("GATEWAY_CA_CERT_PATH")
+
+	if certPath == "" && keyPath == "" {
+		if caPath != "" {
+			return nil, fmt.Errorf("GATEWAY_CA_CERT_PATH requires GATEWAY_TLS_CERT_PATH and GATEWAY_TLS_KEY_PATH")
+		}
+		return nil, nil
+	}
+	if certPath == "" || keyPath == "" {
+		return nil, fmt.Errorf("GATEWAY_TLS_CERT_PATH and GATEWAY_TLS_KEY_PATH must be set together")
+	}
+
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gateway TLS certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+
+	if caPath != "" {
+		caPEM, err := os.ReadFile(caPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read GATEWAY_CA_CERT_PATH: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in GATEWAY_CA_CERT_PATH (%s)", caPath)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
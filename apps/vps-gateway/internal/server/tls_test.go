@@ -0,0 +1,144 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func newTestCert(t *testing.T, name string, parent *testCert, isCA bool) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	signer, signerKey := tmpl, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func writeTestFile(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestLoadServerTLSConfigFallback(t *testing.T) {
+	t.Setenv("GATEWAY_TLS_CERT_PATH", "")
+	t.Setenv("GATEWAY_TLS_KEY_PATH", "")
+	t.Setenv("GATEWAY_CA_CERT_PATH", "")
+
+	cfg, err := loadServerTLSConfig()
+	if err != nil || cfg != nil {
+		t.Fatalf("expected no TLS config without cert paths, got %v, %v", cfg, err)
+	}
+
+	t.Setenv("GATEWAY_CA_CERT_PATH", "/nonexistent/ca.pem")
+	if _, err := loadServerTLSConfig(); err == nil {
+		t.Fatalf("expected error for CA without server certificate")
+	}
+}
+
+func TestLoadServerTLSConfigMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "test-ca", nil, true)
+	serverCert := newTestCert(t, "gateway", ca, false)
+	clientCert := newTestCert(t, "vps-service", ca, false)
+	otherCA := newTestCert(t, "other-ca", nil, true)
+	untrustedClient := newTestCert(t, "intruder", otherCA, false)
+
+	t.Setenv("GATEWAY_TLS_CERT_PATH", writeTestFile(t, dir, "server.pem", serverCert.certPEM))
+	t.Setenv("GATEWAY_TLS_KEY_PATH", writeTestFile(t, dir, "server-key.pem", serverCert.keyPEM))
+	t.Setenv("GATEWAY_CA_CERT_PATH", writeTestFile(t, dir, "ca.pem", ca.certPEM))
+
+	cfg, err := loadServerTLSConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.ClientAuth != tls.RequireAndVerifyClientCert {
+		t.Fatalf("expected client certificates to be required, got %v", cfg.ClientAuth)
+	}
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	srv.TLS = cfg
+	srv.StartTLS()
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	get := func(client *testCert) error {
+		tlsConfig := &tls.Config{RootCAs: roots}
+		if client != nil {
+			pair, err := tls.X509KeyPair(client.certPEM, client.keyPEM)
+			if err != nil {
+				t.Fatalf("failed to load client key pair: %v", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{pair}
+		}
+		httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+		resp, err := httpClient.Get(srv.URL)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	}
+
+	if err := get(clientCert); err != nil {
+		t.Fatalf("trusted client certificate rejected: %v", err)
+	}
+	if err := get(nil); err == nil {
+		t.Fatalf("expected handshake failure without a client certificate")
+	}
+	if err := get(untrustedClient); err == nil {
+		t.Fatalf("expected handshake failure with an untrusted client certificate")
+	}
+}
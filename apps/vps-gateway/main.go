@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
@@ -31,7 +33,7 @@ func getEnvInt(key string, defaultValue int) int {
 func main() {
 	// Parse command line flags
 	grpcPort := flag.Int("grpc-port", getEnvInt("GATEWAY_GRPC_PORT", 1537), "gRPC server port (default: 1537 = OCG)")
-	_ = flag.Int("metrics-port", getEnvInt("GATEWAY_METRICS_PORT", 9091), "Prometheus metrics port")
+	metricsPort := flag.Int("metrics-port", getEnvInt("GATEWAY_METRICS_PORT", 9091), "Prometheus metrics port")
 	flag.Parse()
 
 	// Initialize logger
@@ -66,14 +68,49 @@ func main() {
 	var snatManager *network.SNATManager
 	if outboundIP != "" {
 		logger.Info("GATEWAY_OUTBOUND_IP configured: %s", outboundIP)
-		snatManager, err = network.NewSNATManager(outboundIP, gatewayIP, subnetMask, outboundIface)
+		snatManager, err = network.NewSNATManager(outboundIP, gatewayIP, subnetMask, outboundIface, dhcpManager)
 		if err != nil {
 			log.Fatalf("Failed to initialize SNAT manager: %v", err)
 		}
 	}
 
-	// Initialize metrics
+	// Re-apply SNAT rules for persisted leases (a restart flushes them) and keep them in sync
+	if err := snatManager.Start(context.Background()); err != nil {
+		log.Fatalf("Failed to configure SNAT rules: %v", err)
+	}
+
+	// Share the gateway IP with a peer gateway over VRRP (no-op without GATEWAY_VRRP_VIRTUAL_IP)
+	vrrpManager, err := network.NewVRRPManagerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize VRRP manager: %v", err)
+	}
+	// Taking over from a failed peer: restore its SNAT rules and DHCP leases from the persisted lease store
+	vrrpManager.OnMaster(snatManager.Reapply)
+	vrrpManager.OnMaster(dhcpManager.ReapplyLeases)
+	if err := vrrpManager.Start(); err != nil {
+		log.Fatalf("Failed to start VRRP: %v", err)
+	}
+
+	// Initialize metrics and serve them on the metrics port
 	metrics.Init()
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+	metricsMux.Handle("/health/vrrp", vrrpManager.HealthHandler())
+	metricsServer := &http.Server{
+		Addr:    fmt.Sprintf(":%d", *metricsPort),
+		Handler: metricsMux,
+	}
+	go func() {
+		logger.Info("Starting metrics server on port %d", *metricsPort)
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("Metrics server error: %v", err)
+		}
+	}()
+
+	// Export SNAT connection tracking metrics (no-op without an outbound IP)
+	metricsCtx, stopMetrics := context.WithCancel(context.Background())
+	defer stopMetrics()
+	go snatManager.StartConntrackMetrics(metricsCtx, 15*time.Second)
 
 	// Create and start gateway server (VPS services connect TO gateway)
 	gatewayServer, err := server.NewGatewayServer(dhcpManager, sshProxy, *grpcPort)
@@ -84,6 +121,26 @@ func main() {
 	// Provide gateway service to DHCP manager for FindVPSByLease requests
 	dhcpManager.SetAPIClient(gatewayServer.GetService())
 
+	// Shape per-VM bandwidth on the bridge interface using limits from the VPS size
+	if shaper := network.NewBandwidthShaper(dhcpManager.Interface()); shaper != nil {
+		dhcpManager.SetBandwidthLimiter(shaper)
+	}
+
+	// Serve DHCPv6 from VPS_IPV6_PREFIX (no-op when unset); leases share the IPv4 lease store
+	dhcpv6Manager, err := dhcp.NewDHCPv6Manager(dhcpManager)
+	if err != nil {
+		log.Fatalf("Failed to start DHCPv6 server: %v", err)
+	}
+	if ipv6Prefix := os.Getenv("VPS_IPV6_PREFIX"); dhcpv6Manager != nil {
+		if snatManager == nil {
+			logger.Warn("VPS_IPV6_PREFIX is set but GATEWAY_OUTBOUND_IP is not; outbound IPv6 traffic will not be masqueraded")
+		} else if err := snatManager.SetIPv6Prefix(ipv6Prefix); err != nil {
+			log.Fatalf("Failed to configure IPv6 masquerade: %v", err)
+		} else if err := snatManager.ConfigureIPv6Masquerade(); err != nil {
+			log.Fatalf("Failed to configure IPv6 masquerade: %v", err)
+		}
+	}
+
 	// Start server in background
 	serverErrChan := make(chan error, 1)
 	go func() {
@@ -107,24 +164,38 @@ func main() {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	// Release the virtual IP first so the peer gateway takes over while we shut down
+	if err := vrrpManager.Close(); err != nil {
+		logger.Error("Error stopping VRRP: %v", err)
+	}
+
 	if err := gatewayServer.Shutdown(shutdownCtx); err != nil {
 		logger.Error("Error shutting down gateway server: %v", err)
 	}
 
+	stopMetrics()
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error("Error shutting down metrics server: %v", err)
+	}
+
 	// Cleanup
 	if err := dhcpManager.Close(); err != nil {
 		logger.Error("Error closing DHCP manager: %v", err)
 	}
 
+	if dhcpv6Manager != nil {
+		if err := dhcpv6Manager.Close(); err != nil {
+			logger.Error("Error closing DHCPv6 server: %v", err)
+		}
+	}
+
 	if err := sshProxy.Close(); err != nil {
 		logger.Error("Error closing SSH proxy: %v", err)
 	}
 
 	// Remove SNAT rules
-	if snatManager != nil {
-		if err := snatManager.RemoveSNAT(); err != nil {
-			logger.Error("Error removing SNAT rules: %v", err)
-		}
+	if err := snatManager.Close(); err != nil {
+		logger.Error("Error removing SNAT rules: %v", err)
 	}
 
 	logger.Info("Shutdown complete")
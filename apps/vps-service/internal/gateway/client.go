@@ -2,10 +2,8 @@ package gateway
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"io"
-	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -20,11 +18,11 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
-	"golang.org/x/net/http2"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/obiente/cloud/apps/shared/pkg/database"
+	orchestrator "github.com/obiente/cloud/apps/vps-service/orchestrator"
 )
 
 // RequestHandler handles incoming requests from the gateway
@@ -154,6 +152,13 @@ func (c *GatewayClient) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to parse gateway endpoints: %w", err)
 	}
 
+	// Fail fast on TLS misconfiguration instead of retrying forever in maintainConnection
+	for nodeName, gatewayURL := range gateways {
+		if _, err := orchestrator.NewGatewayTransport(gatewayURL); err != nil {
+			return fmt.Errorf("invalid TLS configuration for gateway %s: %w", nodeName, err)
+		}
+	}
+
 	logger.Info("[GatewayClient] Starting connections to %d gateways", len(gateways))
 
 	// Start connection to each gateway
@@ -196,15 +201,10 @@ func (c *GatewayClient) maintainConnection(ctx context.Context, nodeName, gatewa
 }
 
 func (c *GatewayClient) connectAndServe(ctx context.Context, nodeName, gatewayURL string) error {
-	// Create HTTP client with h2c support and keep-alive settings
-	transport := &http2.Transport{
-		AllowHTTP: true,
-		DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
-			return net.Dial(network, addr)
-		},
-		// Enable HTTP/2 ping frames to keep connection alive
-		ReadIdleTimeout: 45 * time.Second, // Send ping if no reads for 45s
-		PingTimeout:     15 * time.Second, // Wait 15s for pong response
+	// Create HTTP client with HTTP/2 keep-alive settings (h2c for http:// URLs, TLS/mTLS for https://)
+	transport, err := orchestrator.NewGatewayTransport(gatewayURL)
+	if err != nil {
+		return fmt.Errorf("failed to configure gateway transport: %w", err)
 	}
 	httpClient := &http.Client{
 		Transport: transport,
@@ -130,6 +130,12 @@ func (h *FindVPSByLeaseHandler) HandleRequest(ctx context.Context, method string
 	if found {
 		resp.VpsId = vpsID
 		resp.OrganizationId = orgID
+		if down, up, err := database.GetVPSBandwidthLimits(vpsID); err == nil {
+			resp.MaxMbitDown = down
+			resp.MaxMbitUp = up
+		} else {
+			logger.Debug("[FindVPSByLeaseHandler] No bandwidth limits for VPS %s: %v", vpsID, err)
+		}
 		logger.Info("[FindVPSByLeaseHandler] ========== RESPONSE: VPS=%s Org=%s ==========", vpsID, orgID)
 	} else {
 		logger.Warn("[FindVPSByLeaseHandler] ========== RESPONSE: NOT FOUND (MAC=%s IP=%s) ==========", mac, ip)
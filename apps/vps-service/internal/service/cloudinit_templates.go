@@ -0,0 +1,140 @@
+package vps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	orchestrator "github.com/obiente/cloud/apps/vps-service/orchestrator"
+
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// checkCloudInitTemplatePermission verifies organization-wide VPS permissions for template management
+func (s *ConfigService) checkCloudInitTemplatePermission(ctx context.Context, orgID string, permission string) error {
+	if orgID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	return auth.CheckScopedPermissionWithError(ctx, s.permissionChecker, orgID, auth.ScopedPermission{
+		Permission:   permission,
+		ResourceType: "vps",
+	})
+}
+
+// CreateCloudInitTemplate creates a reusable cloud-init userData template
+func (s *ConfigService) CreateCloudInitTemplate(ctx context.Context, req *connect.Request[vpsv1.CreateCloudInitTemplateRequest]) (*connect.Response[vpsv1.CreateCloudInitTemplateResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.checkCloudInitTemplatePermission(ctx, orgID, auth.PermissionVPSCreate); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Msg.GetName())
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	content := req.Msg.GetContent()
+	if err := orchestrator.ValidateCloudInitTemplate(content); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userInfo, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required: %w", err))
+	}
+
+	tmpl := &database.VPSCloudInitTemplate{
+		ID:             fmt.Sprintf("cit-%s", uuid.NewString()),
+		OrganizationID: orgID,
+		Name:           name,
+		Content:        content,
+		CreatedBy:      userInfo.Id,
+	}
+	if err := database.CreateVPSCloudInitTemplate(tmpl); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	logger.Info("[VPS Service] Created cloud-init template %s for organization %s", tmpl.ID, orgID)
+
+	return connect.NewResponse(&vpsv1.CreateCloudInitTemplateResponse{
+		Template: cloudInitTemplateToProto(tmpl),
+	}), nil
+}
+
+// GetCloudInitTemplate retrieves a cloud-init userData template
+func (s *ConfigService) GetCloudInitTemplate(ctx context.Context, req *connect.Request[vpsv1.GetCloudInitTemplateRequest]) (*connect.Response[vpsv1.GetCloudInitTemplateResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.checkCloudInitTemplatePermission(ctx, orgID, auth.PermissionVPSRead); err != nil {
+		return nil, err
+	}
+
+	tmpl, err := database.GetVPSCloudInitTemplate(orgID, req.Msg.GetTemplateId())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("cloud-init template %s not found", req.Msg.GetTemplateId()))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get cloud-init template: %w", err))
+	}
+
+	return connect.NewResponse(&vpsv1.GetCloudInitTemplateResponse{
+		Template: cloudInitTemplateToProto(tmpl),
+	}), nil
+}
+
+// DeleteCloudInitTemplate deletes a cloud-init userData template
+// VPS instances already created from the template are not affected
+func (s *ConfigService) DeleteCloudInitTemplate(ctx context.Context, req *connect.Request[vpsv1.DeleteCloudInitTemplateRequest]) (*connect.Response[vpsv1.DeleteCloudInitTemplateResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.checkCloudInitTemplatePermission(ctx, orgID, auth.PermissionVPSDelete); err != nil {
+		return nil, err
+	}
+
+	templateID := req.Msg.GetTemplateId()
+	deleted, err := database.DeleteVPSCloudInitTemplate(orgID, templateID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !deleted {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("cloud-init template %s not found", templateID))
+	}
+
+	logger.Info("[VPS Service] Deleted cloud-init template %s for organization %s", templateID, orgID)
+
+	return connect.NewResponse(&vpsv1.DeleteCloudInitTemplateResponse{
+		Message: "Cloud-init template deleted",
+	}), nil
+}
+
+func cloudInitTemplateToProto(tmpl *database.VPSCloudInitTemplate) *vpsv1.CloudInitTemplate {
+	return &vpsv1.CloudInitTemplate{
+		Id:             tmpl.ID,
+		OrganizationId: tmpl.OrganizationID,
+		Name:           tmpl.Name,
+		Content:        tmpl.Content,
+		CreatedAt:      timestamppb.New(tmpl.CreatedAt),
+		UpdatedAt:      timestamppb.New(tmpl.UpdatedAt),
+	}
+}
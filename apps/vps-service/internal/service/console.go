@@ -0,0 +1,335 @@
+package vps
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	orchestrator "github.com/obiente/cloud/apps/vps-service/orchestrator"
+
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"nhooyr.io/websocket"
+)
+
+const (
+	// consoleSessionTTL is how long a console URL can be opened for; Proxmox VNC tickets are short-lived too
+	consoleSessionTTL       = 30 * time.Second
+	consoleSessionKeyPrefix = "vps:console:"
+)
+
+var errConsoleSessionInvalid = errors.New("console session is invalid or has expired")
+
+// consoleAvailableStatuses are the statuses in which the VM is up on Proxmox and has a console
+var consoleAvailableStatuses = map[int32]bool{
+	int32(vpsv1.VPSStatus_STARTING):     true,
+	int32(vpsv1.VPSStatus_RUNNING):      true,
+	int32(vpsv1.VPSStatus_REBOOTING):    true,
+	int32(vpsv1.VPSStatus_UNRESPONSIVE): true,
+}
+
+// consoleSessionCache is the subset of database.RedisCache used to hand console sessions
+// from GetVPSConsoleURL to the console websocket
+type consoleSessionCache interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// consoleSession is stored in Redis under vps:console:{vpsID} until the console websocket picks it up
+type consoleSession struct {
+	Token          string `json:"token"`
+	URL            string `json:"url"`
+	OrganizationID string `json:"organization_id"`
+	NodeName       string `json:"node_name"`
+	ProxmoxWSURL   string `json:"proxmox_ws_url"`
+}
+
+func consoleSessionKey(vpsID string) string {
+	return consoleSessionKeyPrefix + vpsID
+}
+
+// GetVPSConsoleURL opens a VNC proxy on the VM's Proxmox node and returns a noVNC-compatible
+// websocket URL for it. Unlike the terminal, this works before SSH is up (e.g. during boot).
+func (s *Service) GetVPSConsoleURL(ctx context.Context, req *connect.Request[vpsv1.GetVPSConsoleURLRequest]) (*connect.Response[vpsv1.GetVPSConsoleURLResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	vpsID := req.Msg.GetVpsId()
+	nodeName, vmID, err := s.consoleTarget(ctx, orgID, vpsID)
+	if err != nil {
+		return nil, err
+	}
+
+	proxmoxClient, closeManager, err := proxmoxClientForNode(nodeName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer closeManager()
+
+	vncProxy, err := proxmoxClient.CreateVNCProxy(ctx, nodeName, vmID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to open VNC console: %w", err))
+	}
+
+	token, err := generateConsoleToken()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate console token: %w", err))
+	}
+
+	consoleURL := consoleWebSocketURL(resolveAPIBaseURL(), vpsID, token)
+	session := consoleSession{
+		Token:          token,
+		URL:            consoleURL,
+		OrganizationID: orgID,
+		NodeName:       nodeName,
+		ProxmoxWSURL:   vncProxy.WebSocketURL,
+	}
+	expiresAt := time.Now().Add(consoleSessionTTL)
+	if err := s.consoleSessions.Set(ctx, consoleSessionKey(vpsID), session, consoleSessionTTL); err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to store console session: %w", err))
+	}
+
+	return connect.NewResponse(&vpsv1.GetVPSConsoleURLResponse{
+		Url:       consoleURL,
+		Password:  vncProxy.Ticket,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}), nil
+}
+
+// consoleTarget checks that the caller may open a console on the VPS and that it is running, and
+// returns the Proxmox node and VM ID of the VPS
+func (s *Service) consoleTarget(ctx context.Context, orgID, vpsID string) (string, int, error) {
+	if err := s.checkOrganizationPermission(ctx, orgID); err != nil {
+		return "", 0, err
+	}
+
+	if err := s.checkVPSPermission(ctx, vpsID, auth.PermissionVPSRead); err != nil {
+		return "", 0, err
+	}
+
+	if s.consoleSessions == nil {
+		return "", 0, connect.NewError(connect.CodeUnavailable, fmt.Errorf("console access requires Redis, which is not available"))
+	}
+
+	var vps database.VPSInstance
+	if err := database.DB.Where("id = ? AND organization_id = ? AND deleted_at IS NULL", vpsID, orgID).First(&vps).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("VPS instance %s not found", vpsID))
+		}
+		return "", 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS: %w", err))
+	}
+
+	if !consoleAvailableStatuses[vps.Status] {
+		return "", 0, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("console is only available while the VPS is running (status: %d)", vps.Status))
+	}
+	if vps.InstanceID == nil {
+		return "", 0, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("VPS has no instance ID"))
+	}
+	vmID, err := strconv.Atoi(*vps.InstanceID)
+	if err != nil {
+		return "", 0, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid VM ID: %w", err))
+	}
+	if vps.NodeID == nil || *vps.NodeID == "" {
+		return "", 0, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("VPS has no node ID - cannot determine which Proxmox node to use"))
+	}
+	return *vps.NodeID, vmID, nil
+}
+
+// HandleVPSConsoleWebSocket proxies a noVNC client to the VM's Proxmox VNC websocket.
+// Route pattern: /vps/{vps_id}/console?token=... where the token comes from GetVPSConsoleURL
+// (browsers can't send an Authorization header on websocket upgrades).
+func (s *Service) HandleVPSConsoleWebSocket(w http.ResponseWriter, r *http.Request) {
+	vpsID := consoleVPSIDFromPath(r.URL.Path)
+	token := r.URL.Query().Get("token")
+	if vpsID == "" || token == "" {
+		http.Error(w, "VPS ID and console token are required", http.StatusBadRequest)
+		return
+	}
+
+	session, err := s.takeConsoleSession(r.Context(), vpsID, token)
+	if err != nil {
+		log.Printf("[VPS Console WS] Rejected console connection for VPS %s: %v", vpsID, err)
+		http.Error(w, errConsoleSessionInvalid.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	acceptOptions, ok := websocketAcceptOptions(w, r, "[VPS Console WS]")
+	if !ok {
+		return
+	}
+	// noVNC negotiates the "binary" subprotocol, as does Proxmox's vncwebsocket
+	acceptOptions.Subprotocols = []string{"binary"}
+
+	// Dial Proxmox before accepting so a failure is reported as a plain HTTP error
+	dialCtx, dialCancel := context.WithTimeout(r.Context(), 10*time.Second)
+	proxmoxConn, err := dialProxmoxConsole(dialCtx, session.NodeName, session.ProxmoxWSURL)
+	dialCancel()
+	if err != nil {
+		log.Printf("[VPS Console WS] Failed to connect to Proxmox VNC websocket for VPS %s: %v", vpsID, err)
+		http.Error(w, "Failed to connect to VPS console", http.StatusBadGateway)
+		return
+	}
+	defer proxmoxConn.Close(websocket.StatusInternalError, "")
+
+	clientConn, err := websocket.Accept(w, r, acceptOptions)
+	if err != nil {
+		log.Printf("[VPS Console WS] Failed to accept websocket connection: %v", err)
+		return
+	}
+	defer clientConn.Close(websocket.StatusInternalError, "")
+
+	// Framebuffer updates easily exceed the default 32 KiB message limit
+	clientConn.SetReadLimit(-1)
+	proxmoxConn.SetReadLimit(-1)
+
+	log.Printf("[VPS Console WS] Console connected for VPS %s on node %s", vpsID, session.NodeName)
+
+	proxyCtx, cancel := s.detachedContext(0)
+	defer cancel()
+	errChan := make(chan error, 2)
+	go func() { errChan <- pipeWebSocket(proxyCtx, clientConn, proxmoxConn) }()
+	go func() { errChan <- pipeWebSocket(proxyCtx, proxmoxConn, clientConn) }()
+
+	err = <-errChan
+	cancel()
+	if status := websocket.CloseStatus(err); status != websocket.StatusNormalClosure && status != websocket.StatusGoingAway && !errors.Is(err, context.Canceled) {
+		log.Printf("[VPS Console WS] Console for VPS %s closed: %v", vpsID, err)
+	}
+	clientConn.Close(websocket.StatusNormalClosure, "")
+	proxmoxConn.Close(websocket.StatusNormalClosure, "")
+}
+
+// takeConsoleSession returns the stored session if the token matches, and removes it so the
+// console URL can only be used once
+func (s *Service) takeConsoleSession(ctx context.Context, vpsID, token string) (*consoleSession, error) {
+	if s.consoleSessions == nil {
+		return nil, errConsoleSessionInvalid
+	}
+	data, err := s.consoleSessions.Get(ctx, consoleSessionKey(vpsID))
+	if err != nil || data == "" {
+		return nil, errConsoleSessionInvalid
+	}
+	var session consoleSession
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return nil, fmt.Errorf("failed to decode console session: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(session.Token), []byte(token)) != 1 {
+		return nil, errConsoleSessionInvalid
+	}
+	if err := s.consoleSessions.Delete(ctx, consoleSessionKey(vpsID)); err != nil {
+		return nil, fmt.Errorf("failed to consume console session: %w", err)
+	}
+	return &session, nil
+}
+
+// dialProxmoxConsole connects to a Proxmox vncwebsocket endpoint, authenticating the upgrade
+// request the same way as the termproxy connection (API token header or PVEAuthCookie)
+func dialProxmoxConsole(ctx context.Context, nodeName, proxmoxWSURL string) (*websocket.Conn, error) {
+	proxmoxClient, closeManager, err := proxmoxClientForNode(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	defer closeManager()
+
+	headers := make(http.Header)
+	if authHeader := proxmoxClient.GetAuthHeader(); authHeader != "" {
+		headers.Set("Authorization", authHeader)
+	} else {
+		ticket, err := proxmoxClient.GetOrCreateTicketForWebSocket(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Proxmox ticket: %w", err)
+		}
+		headers.Set("Cookie", (&http.Cookie{Name: "PVEAuthCookie", Value: ticket}).String())
+	}
+
+	conn, _, err := websocket.Dial(ctx, proxmoxWSURL, &websocket.DialOptions{
+		HTTPClient:   &http.Client{Transport: proxmoxClient.GetHTTPClient().Transport},
+		HTTPHeader:   headers,
+		Subprotocols: []string{"binary"},
+	})
+	return conn, err
+}
+
+// proxmoxClientForNode creates a VPS manager and returns the Proxmox client for a node, along
+// with a function that releases the manager
+func proxmoxClientForNode(nodeName string) (*orchestrator.ProxmoxClient, func(), error) {
+	vpsManager, err := orchestrator.NewVPSManager()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create VPS manager: %w", err)
+	}
+	proxmoxClient, err := vpsManager.GetProxmoxClientForNode(nodeName)
+	if err != nil {
+		vpsManager.Close()
+		return nil, nil, fmt.Errorf("failed to get Proxmox client for node %s: %w", nodeName, err)
+	}
+	return proxmoxClient, func() { vpsManager.Close() }, nil
+}
+
+// pipeWebSocket copies messages from src to dst until either side fails
+func pipeWebSocket(ctx context.Context, dst, src *websocket.Conn) error {
+	for {
+		messageType, data, err := src.Read(ctx)
+		if err != nil {
+			return err
+		}
+		if err := dst.Write(ctx, messageType, data); err != nil {
+			return err
+		}
+	}
+}
+
+// consoleVPSIDFromPath extracts the VPS ID from /vps/{vps_id}/console
+func consoleVPSIDFromPath(path string) string {
+	return vpsIDFromWebSocketPath(path, "console")
+}
+
+// vpsIDFromWebSocketPath extracts the VPS ID from /vps/{vps_id}/{endpoint}
+func vpsIDFromWebSocketPath(path, endpoint string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != 3 || parts[0] != "vps" || parts[2] != endpoint {
+		return ""
+	}
+	return parts[1]
+}
+
+// consoleWebSocketURL builds the public console websocket URL from the API base URL
+func consoleWebSocketURL(apiBaseURL, vpsID, token string) string {
+	return vpsWebSocketURL(apiBaseURL, vpsID, "console", token)
+}
+
+// vpsWebSocketURL builds the public URL of the /vps/{vps_id}/{endpoint} websocket from the API base URL
+func vpsWebSocketURL(apiBaseURL, vpsID, endpoint, token string) string {
+	wsBase := strings.TrimSuffix(apiBaseURL, "/")
+	if strings.HasPrefix(wsBase, "https://") {
+		wsBase = "wss://" + strings.TrimPrefix(wsBase, "https://")
+	} else if strings.HasPrefix(wsBase, "http://") {
+		wsBase = "ws://" + strings.TrimPrefix(wsBase, "http://")
+	}
+	return fmt.Sprintf("%s/vps/%s/%s?token=%s", wsBase, vpsID, endpoint, token)
+}
+
+func generateConsoleToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,257 @@
+package vps
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+	"gorm.io/gorm"
+	"nhooyr.io/websocket"
+)
+
+const (
+	mockProxmoxVNCTicket = "PVEVNC:6543210::mock-ticket"
+	mockProxmoxAuth      = "PVEAPIToken=root@pam!console=secret"
+)
+
+// memoryConsoleCache is an in-memory consoleSessionCache that stores values JSON-encoded and
+// expires them, like Redis
+type memoryConsoleCache struct {
+	mu          sync.Mutex
+	values      map[string]string
+	expirations map[string]time.Duration
+	deadlines   map[string]time.Time
+}
+
+func newMemoryConsoleCache() *memoryConsoleCache {
+	return &memoryConsoleCache{values: map[string]string{}, expirations: map[string]time.Duration{}, deadlines: map[string]time.Time{}}
+}
+
+func (c *memoryConsoleCache) Get(ctx context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if deadline, ok := c.deadlines[key]; ok && !time.Now().Before(deadline) {
+		delete(c.values, key)
+		delete(c.deadlines, key)
+	}
+	return c.values[key], nil
+}
+
+func (c *memoryConsoleCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = string(data)
+	c.expirations[key] = expiration
+	if expiration > 0 {
+		c.deadlines[key] = time.Now().Add(expiration)
+	} else {
+		delete(c.deadlines, key)
+	}
+	return nil
+}
+
+func (c *memoryConsoleCache) Delete(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		delete(c.values, key)
+		delete(c.deadlines, key)
+	}
+	return nil
+}
+
+// newMockProxmox serves vncproxy and a vncwebsocket that greets with an RFB version and echoes messages
+func newMockProxmox(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api2/json/nodes/pve1/qemu/101/vncproxy", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Authorization") != mockProxmoxAuth {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("websocket") != "1" {
+			http.Error(w, "websocket=1 is required", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"ticket":"` + mockProxmoxVNCTicket + `","port":"5900","upid":"UPID:pve1"}}`))
+	})
+	mux.HandleFunc("/api2/json/nodes/pve1/qemu/101/vncwebsocket", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.Header.Get("Authorization") != mockProxmoxAuth || query.Get("vncticket") != mockProxmoxVNCTicket || query.Get("port") != "5900" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{Subprotocols: []string{"binary"}})
+		if err != nil {
+			return
+		}
+		defer conn.Close(websocket.StatusNormalClosure, "")
+		if err := conn.Write(r.Context(), websocket.MessageBinary, []byte("RFB 003.008\n")); err != nil {
+			return
+		}
+		for {
+			messageType, data, err := conn.Read(r.Context())
+			if err != nil {
+				return
+			}
+			if err := conn.Write(r.Context(), messageType, data); err != nil {
+				return
+			}
+		}
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// setupConsoleTest seeds org-a/org-b data with a running org-a VPS on the mock Proxmox node and
+// serves the console route the way main.go does
+func setupConsoleTest(t *testing.T) (*Service, *memoryConsoleCache, *gorm.DB) {
+	t.Helper()
+	db := newVPSServiceTestDB(t)
+	seedVPSServiceIsolationData(t, db)
+	if err := db.Model(&database.VPSInstance{}).Where("id IN ?", []string{"vps-org-a-owner", "vps-org-b-owner"}).Updates(map[string]any{
+		"status":      int32(vpsv1.VPSStatus_RUNNING),
+		"instance_id": "101",
+		"node_id":     "pve1",
+	}).Error; err != nil {
+		t.Fatalf("mark VPS running: %v", err)
+	}
+
+	proxmox := newMockProxmox(t)
+	t.Setenv("PROXMOX_NODE_API_ENDPOINTS", "pve1:"+proxmox.URL)
+	t.Setenv("PROXMOX_USERNAME", "root@pam")
+	t.Setenv("PROXMOX_TOKEN_ID", "console")
+	t.Setenv("PROXMOX_TOKEN_SECRET", "secret")
+	t.Setenv("CORS_ORIGIN", "*")
+
+	cache := newMemoryConsoleCache()
+	service := &Service{
+		permissionChecker: auth.NewPermissionChecker(),
+		consoleSessions:   cache,
+		backgroundCtx:     context.Background(),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/vps/", service.HandleVPSConsoleWebSocket)
+	api := httptest.NewServer(mux)
+	t.Cleanup(api.Close)
+	t.Setenv("API_BASE_URL", api.URL)
+
+	return service, cache, db
+}
+
+func TestGetVPSConsoleURLProxiesProxmoxVNC(t *testing.T) {
+	service, cache, _ := setupConsoleTest(t)
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	resp, err := service.GetVPSConsoleURL(ctx, connect.NewRequest(&vpsv1.GetVPSConsoleURLRequest{
+		OrganizationId: "org-a",
+		VpsId:          "vps-org-a-owner",
+	}))
+	if err != nil {
+		t.Fatalf("GetVPSConsoleURL: %v", err)
+	}
+	consoleURL := resp.Msg.GetUrl()
+	if !strings.HasPrefix(consoleURL, "ws://") || !strings.Contains(consoleURL, "/vps/vps-org-a-owner/console?token=") {
+		t.Fatalf("console URL = %q, want ws://.../vps/vps-org-a-owner/console?token=...", consoleURL)
+	}
+	if resp.Msg.GetPassword() != mockProxmoxVNCTicket {
+		t.Fatalf("password = %q, want the Proxmox VNC ticket", resp.Msg.GetPassword())
+	}
+	if remaining := time.Until(resp.Msg.GetExpiresAt().AsTime()); remaining <= 0 || remaining > consoleSessionTTL {
+		t.Fatalf("expires in %v, want within %v", remaining, consoleSessionTTL)
+	}
+	if got := cache.expirations["vps:console:vps-org-a-owner"]; got != 30*time.Second {
+		t.Fatalf("console session TTL = %v, want 30s", got)
+	}
+	var stored consoleSession
+	if err := json.Unmarshal([]byte(cache.values["vps:console:vps-org-a-owner"]), &stored); err != nil {
+		t.Fatalf("decode stored session: %v", err)
+	}
+	if stored.URL != consoleURL {
+		t.Fatalf("stored URL = %q, want %q", stored.URL, consoleURL)
+	}
+
+	dialCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, _, err := websocket.Dial(dialCtx, consoleURL, &websocket.DialOptions{Subprotocols: []string{"binary"}})
+	if err != nil {
+		t.Fatalf("dial console: %v", err)
+	}
+	defer conn.Close(websocket.StatusNormalClosure, "")
+
+	_, greeting, err := conn.Read(dialCtx)
+	if err != nil || string(greeting) != "RFB 003.008\n" {
+		t.Fatalf("read RFB greeting = %q, %v", greeting, err)
+	}
+	if err := conn.Write(dialCtx, websocket.MessageBinary, []byte("RFB 003.008\n")); err != nil {
+		t.Fatalf("write to console: %v", err)
+	}
+	if _, echoed, err := conn.Read(dialCtx); err != nil || string(echoed) != "RFB 003.008\n" {
+		t.Fatalf("read proxied reply = %q, %v", echoed, err)
+	}
+
+	// The URL is single-use
+	if _, resp, err := websocket.Dial(dialCtx, consoleURL, nil); err == nil || resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("second dial with the same URL: err=%v, want 401", err)
+	}
+}
+
+func TestGetVPSConsoleURLRejectsInvalidRequests(t *testing.T) {
+	service, _, db := setupConsoleTest(t)
+	orgAUser := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+	orgBUser := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-b", Email: "user-org-b@example.com"})
+
+	if err := db.Model(&database.VPSInstance{}).Where("id = ?", "vps-org-a-peer").Update("status", int32(vpsv1.VPSStatus_STOPPED)).Error; err != nil {
+		t.Fatalf("mark VPS stopped: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		orgID string
+		vpsID string
+		want  connect.Code
+	}{
+		{"non-member", orgBUser, "org-a", "vps-org-a-owner", connect.CodePermissionDenied},
+		{"other organization's VPS", orgAUser, "org-a", "vps-org-b-owner", connect.CodePermissionDenied},
+		{"stopped VPS", orgAUser, "org-a", "vps-org-a-peer", connect.CodeFailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.GetVPSConsoleURL(tt.ctx, connect.NewRequest(&vpsv1.GetVPSConsoleURLRequest{
+				OrganizationId: tt.orgID,
+				VpsId:          tt.vpsID,
+			}))
+			if connect.CodeOf(err) != tt.want {
+				t.Fatalf("GetVPSConsoleURL code = %v, want %v: %v", connect.CodeOf(err), tt.want, err)
+			}
+		})
+	}
+
+	// A made-up token must not reach Proxmox
+	api := strings.TrimPrefix(consoleWebSocketURL(resolveAPIBaseURL(), "vps-org-a-owner", "guess"), "ws://")
+	resp, err := http.Get("http://" + api)
+	if err != nil {
+		t.Fatalf("request console with a made-up token: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("made-up token status = %d, want 401", resp.StatusCode)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/netip"
 	"strings"
 	"time"
 
@@ -276,8 +277,47 @@ func (s *Service) CreateVPS(ctx context.Context, req *connect.Request[vpsv1.Crea
 		config.CloudInit = cloudInit
 	}
 
+	// Load cloud-init template (rendered with VPS variables during provisioning)
+	if templateID := req.Msg.GetCloudInitTemplateId(); templateID != "" {
+		tmpl, err := database.GetVPSCloudInitTemplate(orgID, templateID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("cloud-init template %s not found", templateID))
+			}
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get cloud-init template: %w", err))
+		}
+		// Re-validate: the blocklist may have changed since the template was saved
+		if err := orchestrator.ValidateCloudInitTemplate(tmpl.Content); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cloud-init template %s: %w", templateID, err))
+		}
+		config.CloudInitTemplate = &tmpl.Content
+	}
+
+	// Clone from a VPS template of the organization instead of the OS image
+	if templateID := req.Msg.GetTemplateId(); templateID != "" {
+		tmpl, err := database.GetVPSTemplate(orgID, templateID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VPS template %s not found", templateID))
+			}
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS template: %w", err))
+		}
+		if config.Region == "" {
+			config.Region = tmpl.Region
+		} else if config.Region != tmpl.Region {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("VPS template %s is only available in region %s", templateID, tmpl.Region))
+		}
+		config.Image = int(tmpl.Image)
+		config.ImageID = tmpl.ImageID
+		config.SourceTemplate = &orchestrator.VPSTemplateSource{
+			Name:     tmpl.Name,
+			NodeName: tmpl.NodeName,
+			VMID:     tmpl.ProxmoxTemplateID,
+		}
+	}
+
 	// Get size from catalog
-	sizeCatalog, err := database.GetVPSSizeCatalog(req.Msg.GetSize(), req.Msg.GetRegion())
+	sizeCatalog, err := database.GetVPSSizeCatalog(req.Msg.GetSize(), config.Region)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid VPS size: %w", err))
 	}
@@ -301,10 +341,26 @@ func (s *Service) CreateVPS(ctx context.Context, req *connect.Request[vpsv1.Crea
 		}
 	}
 
+	if gpu := req.Msg.GetGpu(); gpu != nil {
+		config.GPU = &orchestrator.GPUConfig{PCIID: gpu.GetPciId(), Driver: gpu.GetDriver()}
+		if err := config.GPU.Validate(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
 	config.CPUCores = sizeCatalog.CPUCores
 	config.MemoryBytes = sizeCatalog.MemoryBytes
 	config.DiskBytes = sizeCatalog.DiskBytes
 
+	// Reserve the static IP last so that no other check can fail while it is held
+	if req.Msg.GetStaticIp() != "" {
+		staticIP, err := reserveStaticIP(ctx, orgID, vpsID, req.Msg)
+		if err != nil {
+			return nil, err
+		}
+		config.StaticIP = staticIP
+	}
+
 	// Create VPS via manager
 	// Use independent context to avoid HTTP request timeout/cancellation
 	// VPS creation can take 1-2 minutes, but HTTP requests typically timeout at 30-60 seconds
@@ -312,6 +368,14 @@ func (s *Service) CreateVPS(ctx context.Context, req *connect.Request[vpsv1.Crea
 	defer createCancel()
 	vpsInstance, rootPassword, err := s.vpsManager.CreateVPS(createCtx, config, logWriter)
 	if err != nil {
+		if config.StaticIP != nil {
+			if releaseErr := database.ReleaseVPSStaticIP(createCtx, database.DB, vpsID); releaseErr != nil {
+				logger.Warn("[VPS Service] Failed to release static IP of VPS %s after failed creation: %v", vpsID, releaseErr)
+			}
+		}
+		if errors.Is(err, orchestrator.ErrGPUInUse) || errors.Is(err, orchestrator.ErrGPUNotFound) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed to create VPS: %w", err))
+		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create VPS: %w", err))
 	}
 
@@ -349,6 +413,65 @@ func (s *Service) CreateVPS(ctx context.Context, req *connect.Request[vpsv1.Crea
 	return response, nil
 }
 
+// reserveStaticIP reserves the requested static IP for a new VPS from the organization's IP
+// allocations and returns its network configuration. The gateway and DNS servers default to
+// the ones of the allocation.
+func reserveStaticIP(ctx context.Context, orgID, vpsID string, msg *vpsv1.CreateVPSRequest) (*orchestrator.StaticIPConfig, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(msg.GetStaticIp()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid static IP %q", msg.GetStaticIp()))
+	}
+	addr = addr.Unmap()
+	var gateway netip.Addr
+	if msg.GetGateway() != "" {
+		gw, err := netip.ParseAddr(strings.TrimSpace(msg.GetGateway()))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid gateway %q", msg.GetGateway()))
+		}
+		gateway = gw.Unmap()
+	}
+	dnsServers := make([]string, 0, len(msg.GetDnsServers()))
+	for _, server := range msg.GetDnsServers() {
+		dns, err := netip.ParseAddr(strings.TrimSpace(server))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid DNS server %q", server))
+		}
+		dnsServers = append(dnsServers, dns.String())
+	}
+
+	allocation, err := database.ReserveVPSStaticIP(ctx, database.DB, orgID, vpsID, addr.String())
+	switch {
+	case errors.Is(err, database.ErrStaticIPInUse):
+		return nil, connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, database.ErrStaticIPNotAllocated), errors.Is(err, database.ErrStaticIPReserved):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	prefix, err := netip.ParsePrefix(allocation.CIDR)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid IP allocation %s: %w", allocation.ID, err))
+	}
+	if !gateway.IsValid() {
+		gateway, _ = netip.ParseAddr(allocation.Gateway)
+	} else if !prefix.Contains(gateway) {
+		if releaseErr := database.ReleaseVPSStaticIP(ctx, database.DB, vpsID); releaseErr != nil {
+			logger.Warn("[VPS Service] Failed to release static IP of VPS %s: %v", vpsID, releaseErr)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("gateway %s is not in the subnet %s", gateway, prefix))
+	}
+	if len(dnsServers) == 0 {
+		dnsServers = allocation.DNSServerList()
+	}
+
+	return &orchestrator.StaticIPConfig{
+		Address:    netip.PrefixFrom(addr, prefix.Bits()).String(),
+		Gateway:    gateway.String(),
+		DNSServers: dnsServers,
+	}, nil
+}
+
 // GetVPS retrieves a VPS instance by ID
 func (s *Service) GetVPS(ctx context.Context, req *connect.Request[vpsv1.GetVPSRequest]) (*connect.Response[vpsv1.GetVPSResponse], error) {
 	ctx, err := s.ensureAuthenticated(ctx, req)
@@ -607,6 +730,9 @@ func vpsToProto(vps *database.VPSInstance) *vpsv1.VPSInstance {
 	if vps.LastStartedAt != nil {
 		proto.LastStartedAt = timestamppb.New(*vps.LastStartedAt)
 	}
+	if vps.LastPatchedAt != nil {
+		proto.LastPatchedAt = timestamppb.New(*vps.LastPatchedAt)
+	}
 	if vps.DeletedAt != nil {
 		proto.DeletedAt = timestamppb.New(*vps.DeletedAt)
 	}
@@ -169,6 +169,8 @@ func newVPSServiceTestDB(t *testing.T) *gorm.DB {
 	}
 	if err := db.AutoMigrate(
 		&database.VPSInstance{},
+		&database.VPSPowerEvent{},
+		&database.VPSPatchHistory{},
 		&database.Notification{},
 		&database.Organization{},
 		&database.OrganizationMember{},
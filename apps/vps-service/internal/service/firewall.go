@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 
 	orchestrator "github.com/obiente/cloud/apps/vps-service/orchestrator"
 
 	"github.com/obiente/cloud/apps/shared/pkg/auth"
 	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
 
 	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
 
@@ -44,6 +47,39 @@ func (s *Service) ListFirewallRules(ctx context.Context, req *connect.Request[vp
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS: %w", err))
 	}
 
+	// Proxmox is the source of truth; the mirror is only served when it cannot be reached
+	rules, err := listLiveFirewallRules(ctx, &vps)
+	if err != nil {
+		mirrored, mirrorErr := database.GetVPSFirewallRules(vps.ID)
+		if mirrorErr != nil || len(mirrored) == 0 {
+			return nil, err
+		}
+		logger.Warn("[VPS Service] Failed to list firewall rules for VPS %s from Proxmox: %v (serving mirrored rules)", vps.ID, err)
+		protoRules := make([]*vpsv1.FirewallRule, len(mirrored))
+		for i := range mirrored {
+			protoRules[i] = firewallRuleMirrorToProto(&mirrored[i])
+		}
+		return connect.NewResponse(&vpsv1.ListFirewallRulesResponse{
+			Rules: protoRules,
+		}), nil
+	}
+
+	// Convert to proto
+	protoRules := make([]*vpsv1.FirewallRule, len(rules))
+	for i, rule := range rules {
+		protoRules[i] = firewallRuleToProto(rule, i)
+	}
+
+	// Reconcile the mirror with what Proxmox reported, including changes made outside the API
+	storeFirewallRuleMirror(&vps, rules)
+
+	return connect.NewResponse(&vpsv1.ListFirewallRulesResponse{
+		Rules: protoRules,
+	}), nil
+}
+
+// listLiveFirewallRules reads the VM's firewall rules from the Proxmox node it runs on
+func listLiveFirewallRules(ctx context.Context, vps *database.VPSInstance) ([]map[string]interface{}, error) {
 	if vps.InstanceID == nil {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("VPS has no instance ID"))
 	}
@@ -54,12 +90,10 @@ func (s *Service) ListFirewallRules(ctx context.Context, req *connect.Request[vp
 	}
 
 	// Get node name from VPS (required)
-	nodeName := ""
-	if vps.NodeID != nil && *vps.NodeID != "" {
-		nodeName = *vps.NodeID
-	} else {
+	if vps.NodeID == nil || *vps.NodeID == "" {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("VPS has no node ID - cannot determine which Proxmox node to use"))
 	}
+	nodeName := *vps.NodeID
 
 	// Get Proxmox client for the node where VPS is running
 	vpsManager, err := orchestrator.NewVPSManager()
@@ -73,21 +107,11 @@ func (s *Service) ListFirewallRules(ctx context.Context, req *connect.Request[vp
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get Proxmox client for node %s: %w", nodeName, err))
 	}
 
-	// List firewall rules
 	rules, err := proxmoxClient.ListFirewallRules(ctx, nodeName, vmID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list firewall rules: %w", err))
 	}
-
-	// Convert to proto
-	protoRules := make([]*vpsv1.FirewallRule, len(rules))
-	for i, rule := range rules {
-		protoRules[i] = firewallRuleToProto(rule, i)
-	}
-
-	return connect.NewResponse(&vpsv1.ListFirewallRulesResponse{
-		Rules: protoRules,
-	}), nil
+	return rules, nil
 }
 
 // GetFirewallRule gets a specific firewall rule
@@ -173,6 +197,10 @@ func (s *Service) CreateFirewallRule(ctx context.Context, req *connect.Request[v
 		return nil, err
 	}
 
+	if err := validateFirewallRule(req.Msg.GetRule()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// Get VPS instance
 	var vps database.VPSInstance
 	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
@@ -230,6 +258,7 @@ func (s *Service) CreateFirewallRule(ctx context.Context, req *connect.Request[v
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list firewall rules after creation: %w", err))
 	}
+	storeFirewallRuleMirror(&vps, rules)
 
 	// Find the rule we just created (it should be at the specified position or at the end)
 	var createdRule map[string]interface{}
@@ -268,6 +297,10 @@ func (s *Service) UpdateFirewallRule(ctx context.Context, req *connect.Request[v
 		return nil, err
 	}
 
+	if err := validateFirewallRule(req.Msg.GetRule()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// Get VPS instance
 	var vps database.VPSInstance
 	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
@@ -313,6 +346,7 @@ func (s *Service) UpdateFirewallRule(ctx context.Context, req *connect.Request[v
 	if err := proxmoxClient.UpdateFirewallRule(ctx, nodeName, vmID, int(req.Msg.GetRulePos()), ruleData); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update firewall rule: %w", err))
 	}
+	syncFirewallRuleMirror(ctx, proxmoxClient, nodeName, vmID, &vps)
 
 	// Get the updated rule
 	rule, err := proxmoxClient.GetFirewallRule(ctx, nodeName, vmID, int(req.Msg.GetRulePos()))
@@ -384,6 +418,7 @@ func (s *Service) DeleteFirewallRule(ctx context.Context, req *connect.Request[v
 	if err := proxmoxClient.DeleteFirewallRule(ctx, nodeName, vmID, int(req.Msg.GetRulePos())); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete firewall rule: %w", err))
 	}
+	syncFirewallRuleMirror(ctx, proxmoxClient, nodeName, vmID, &vps)
 
 	return connect.NewResponse(&vpsv1.DeleteFirewallRuleResponse{
 		Success: true,
@@ -532,6 +567,178 @@ func (s *Service) UpdateFirewallOptions(ctx context.Context, req *connect.Reques
 
 // Helper functions
 
+// validateFirewallRule checks a rule before it is sent to Proxmox so bad input is rejected
+// with a clear error instead of an opaque Proxmox 400
+func validateFirewallRule(rule *vpsv1.FirewallRule) error {
+	if rule == nil {
+		return fmt.Errorf("rule is required")
+	}
+
+	switch rule.Action {
+	case vpsv1.FirewallAction_ACCEPT, vpsv1.FirewallAction_REJECT, vpsv1.FirewallAction_DROP:
+	default:
+		return fmt.Errorf("invalid firewall action: must be ACCEPT, REJECT or DROP")
+	}
+
+	switch rule.Type {
+	case vpsv1.FirewallDirection_IN, vpsv1.FirewallDirection_OUT:
+	default:
+		return fmt.Errorf("invalid firewall direction: must be IN or OUT")
+	}
+
+	protocol := vpsv1.FirewallProtocol_FIREWALL_PROTOCOL_UNSPECIFIED
+	if rule.Protocol != nil {
+		protocol = *rule.Protocol
+		switch protocol {
+		case vpsv1.FirewallProtocol_TCP, vpsv1.FirewallProtocol_UDP, vpsv1.FirewallProtocol_ICMP,
+			vpsv1.FirewallProtocol_ICMPV6, vpsv1.FirewallProtocol_ALL:
+		default:
+			return fmt.Errorf("invalid firewall protocol")
+		}
+	}
+
+	// Ports only make sense for TCP and UDP
+	portsAllowed := protocol == vpsv1.FirewallProtocol_TCP || protocol == vpsv1.FirewallProtocol_UDP
+	if rule.Dport != nil && *rule.Dport != "" {
+		if !portsAllowed {
+			return fmt.Errorf("dport requires protocol TCP or UDP")
+		}
+		if err := validateFirewallPorts(*rule.Dport); err != nil {
+			return fmt.Errorf("invalid dport: %w", err)
+		}
+	}
+	if rule.Sport != nil && *rule.Sport != "" {
+		if !portsAllowed {
+			return fmt.Errorf("sport requires protocol TCP or UDP")
+		}
+		if err := validateFirewallPorts(*rule.Sport); err != nil {
+			return fmt.Errorf("invalid sport: %w", err)
+		}
+	}
+
+	if rule.IcmpType != nil && protocol != vpsv1.FirewallProtocol_ICMP && protocol != vpsv1.FirewallProtocol_ICMPV6 {
+		return fmt.Errorf("icmp_type requires protocol ICMP or ICMPV6")
+	}
+
+	return nil
+}
+
+// firewallServiceNamePattern matches service names from /etc/services, which Proxmox accepts in place of port numbers
+var firewallServiceNamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.+-]*$`)
+
+// validateFirewallPorts validates a Proxmox port list: comma separated ports, service names or "start:end" ranges
+func validateFirewallPorts(ports string) error {
+	for _, part := range strings.Split(ports, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return fmt.Errorf("empty port in %q", ports)
+		}
+
+		bounds := strings.Split(part, ":")
+		if len(bounds) > 2 {
+			return fmt.Errorf("invalid port range %q", part)
+		}
+
+		values := make([]int, 0, len(bounds))
+		for _, bound := range bounds {
+			if firewallServiceNamePattern.MatchString(bound) {
+				// Proxmox resolves the name; there is no number to compare in a range
+				continue
+			}
+			port, err := strconv.Atoi(bound)
+			if err != nil || port < 1 || port > 65535 {
+				return fmt.Errorf("port %q must be a number between 1 and 65535 or a service name", bound)
+			}
+			values = append(values, port)
+		}
+
+		if len(values) == 2 && values[0] > values[1] {
+			return fmt.Errorf("invalid port range %q: start is greater than end", part)
+		}
+	}
+	return nil
+}
+
+// syncFirewallRuleMirror re-reads the rules from Proxmox and rewrites the mirror.
+// Failures are only logged: the rule change itself already succeeded in Proxmox.
+func syncFirewallRuleMirror(ctx context.Context, proxmoxClient *orchestrator.ProxmoxClient, nodeName string, vmID int, vps *database.VPSInstance) {
+	rules, err := proxmoxClient.ListFirewallRules(ctx, nodeName, vmID)
+	if err != nil {
+		logger.Warn("[VPS Service] Failed to list firewall rules for mirror sync of VPS %s: %v", vps.ID, err)
+		// Drop the stale mirror so the next list goes to Proxmox
+		if delErr := database.DeleteVPSFirewallRules(vps.ID); delErr != nil {
+			logger.Warn("[VPS Service] Failed to clear firewall rule mirror for VPS %s: %v", vps.ID, delErr)
+		}
+		return
+	}
+	storeFirewallRuleMirror(vps, rules)
+}
+
+// storeFirewallRuleMirror stores rules as returned by Proxmox in the vps_firewall_rules mirror
+func storeFirewallRuleMirror(vps *database.VPSInstance, rules []map[string]interface{}) {
+	mirrored := make([]database.VPSFirewallRule, len(rules))
+	for i, rule := range rules {
+		mirrored[i] = firewallRuleProtoToMirror(firewallRuleToProto(rule, i))
+	}
+	if err := database.ReplaceVPSFirewallRules(vps.ID, vps.OrganizationID, mirrored); err != nil {
+		logger.Warn("[VPS Service] Failed to update firewall rule mirror for VPS %s: %v", vps.ID, err)
+	}
+}
+
+func firewallRuleProtoToMirror(rule *vpsv1.FirewallRule) database.VPSFirewallRule {
+	// Reuse the Proxmox form encoding so the mirror stores Proxmox's own values
+	data := firewallRuleToFormData(rule)
+	mirrored := database.VPSFirewallRule{
+		Pos:       int(rule.Pos),
+		Enable:    rule.Enable,
+		Action:    data.Get("action"),
+		Type:      data.Get("type"),
+		Comment:   data.Get("comment"),
+		Source:    data.Get("source"),
+		Dest:      data.Get("dest"),
+		Iface:     data.Get("iface"),
+		MacSource: data.Get("mac-source"),
+		Protocol:  data.Get("protocol"),
+		Dport:     data.Get("dport"),
+		Sport:     data.Get("sport"),
+		Log:       rule.Log,
+	}
+	if rule.IcmpType != nil {
+		icmpType := int(*rule.IcmpType)
+		mirrored.IcmpType = &icmpType
+	}
+	return mirrored
+}
+
+func firewallRuleMirrorToProto(rule *database.VPSFirewallRule) *vpsv1.FirewallRule {
+	// Build the same shape Proxmox returns and reuse the existing conversion
+	raw := map[string]interface{}{
+		"action":     rule.Action,
+		"type":       rule.Type,
+		"comment":    rule.Comment,
+		"source":     rule.Source,
+		"dest":       rule.Dest,
+		"iface":      rule.Iface,
+		"mac-source": rule.MacSource,
+		"protocol":   rule.Protocol,
+		"dport":      rule.Dport,
+		"sport":      rule.Sport,
+		"enable":     rule.Enable,
+	}
+	if rule.IcmpType != nil {
+		raw["icmp-type"] = float64(*rule.IcmpType)
+	}
+	if rule.Log != nil {
+		raw["log"] = *rule.Log
+	}
+
+	protoRule := firewallRuleToProto(raw, rule.Pos)
+	if rule.Comment == "" {
+		protoRule.Comment = nil
+	}
+	return protoRule
+}
+
 func firewallRuleToProto(rule map[string]interface{}, pos int) *vpsv1.FirewallRule {
 	protoRule := &vpsv1.FirewallRule{
 		Pos: int32(pos),
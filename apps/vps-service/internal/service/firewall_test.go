@@ -0,0 +1,87 @@
+package vps
+
+import (
+	"testing"
+
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+)
+
+func TestValidateFirewallRule(t *testing.T) {
+	t.Parallel()
+
+	tcp := vpsv1.FirewallProtocol_TCP
+	icmp := vpsv1.FirewallProtocol_ICMP
+	str := func(s string) *string { return &s }
+	icmpType := int32(8)
+
+	tests := []struct {
+		name    string
+		rule    *vpsv1.FirewallRule
+		wantErr bool
+	}{
+		{name: "nil rule", rule: nil, wantErr: true},
+		{name: "minimal rule", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_ACCEPT, Type: vpsv1.FirewallDirection_IN}},
+		{name: "missing action", rule: &vpsv1.FirewallRule{Type: vpsv1.FirewallDirection_IN}, wantErr: true},
+		{name: "missing direction", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_DROP}, wantErr: true},
+		{name: "tcp port list and range", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_ACCEPT, Type: vpsv1.FirewallDirection_IN, Protocol: &tcp, Dport: str("22,80,8000:8080"), Sport: str("1024:65535")}},
+		{name: "port without protocol", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_ACCEPT, Type: vpsv1.FirewallDirection_IN, Dport: str("22")}, wantErr: true},
+		{name: "port with icmp", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_ACCEPT, Type: vpsv1.FirewallDirection_IN, Protocol: &icmp, Dport: str("22")}, wantErr: true},
+		{name: "port out of range", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_ACCEPT, Type: vpsv1.FirewallDirection_IN, Protocol: &tcp, Dport: str("70000")}, wantErr: true},
+		{name: "reversed range", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_ACCEPT, Type: vpsv1.FirewallDirection_IN, Protocol: &tcp, Dport: str("9000:8000")}, wantErr: true},
+		{name: "service names", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_ACCEPT, Type: vpsv1.FirewallDirection_IN, Protocol: &tcp, Dport: str("ssh,http,https:8443")}},
+		{name: "invalid service name", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_ACCEPT, Type: vpsv1.FirewallDirection_IN, Protocol: &tcp, Dport: str("ssh$(id)")}, wantErr: true},
+		{name: "non numeric port", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_ACCEPT, Type: vpsv1.FirewallDirection_IN, Protocol: &tcp, Sport: str("22;rm")}, wantErr: true},
+		{name: "icmp type with icmp", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_ACCEPT, Type: vpsv1.FirewallDirection_IN, Protocol: &icmp, IcmpType: &icmpType}},
+		{name: "icmp type with tcp", rule: &vpsv1.FirewallRule{Action: vpsv1.FirewallAction_ACCEPT, Type: vpsv1.FirewallDirection_IN, Protocol: &tcp, IcmpType: &icmpType}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateFirewallRule(tc.rule)
+			if tc.wantErr && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestFirewallRuleMirrorRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tcp := vpsv1.FirewallProtocol_TCP
+	comment := "ssh"
+	dport := "22"
+	logEnabled := true
+	rule := &vpsv1.FirewallRule{
+		Pos:      3,
+		Enable:   true,
+		Action:   vpsv1.FirewallAction_REJECT,
+		Type:     vpsv1.FirewallDirection_OUT,
+		Comment:  &comment,
+		Protocol: &tcp,
+		Dport:    &dport,
+		Log:      &logEnabled,
+	}
+
+	mirrored := firewallRuleProtoToMirror(rule)
+	if mirrored.Action != "REJECT" || mirrored.Type != "out" || mirrored.Protocol != "tcp" {
+		t.Fatalf("unexpected mirror values: %+v", mirrored)
+	}
+
+	got := firewallRuleMirrorToProto(&mirrored)
+	if got.GetPos() != 3 || !got.GetEnable() || got.GetAction() != rule.Action || got.GetType() != rule.Type {
+		t.Fatalf("round trip mismatch: %+v", got)
+	}
+	if got.GetComment() != comment || got.GetProtocol() != tcp || got.GetDport() != dport || !got.GetLog() {
+		t.Fatalf("round trip mismatch: %+v", got)
+	}
+	if got.Source != nil || got.Sport != nil {
+		t.Fatalf("expected unset fields to stay nil: %+v", got)
+	}
+}
@@ -0,0 +1,265 @@
+package vps
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/quota"
+	orchestrator "github.com/obiente/cloud/apps/vps-service/orchestrator"
+
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// HandleUploadISO streams an install ISO into the organization's ISO storage.
+// Expected query params:
+// - organizationId (required)
+// - name (optional, defaults to the uploaded file's name; must end in .iso)
+// Client must POST multipart/form-data with a single file field named "file".
+func (s *Service) HandleUploadISO(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	ctx := r.Context()
+
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		http.Error(w, "unauthenticated", http.StatusUnauthorized)
+		return
+	}
+	ctx, _, err := auth.AuthenticateAndSetContext(ctx, authHeader)
+	if err != nil {
+		http.Error(w, "unauthenticated", http.StatusUnauthorized)
+		return
+	}
+
+	orgID := r.URL.Query().Get("organizationId")
+	if err := s.checkOrgVPSPermission(ctx, orgID, auth.PermissionVPSCreate); err != nil {
+		if connect.CodeOf(err) == connect.CodeInvalidArgument {
+			http.Error(w, "organizationId is required", http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+	if s.vpsManager == nil {
+		http.Error(w, "VPS manager not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	// Reject uploads that cannot fit before any of the ISO is transferred
+	remaining, err := quota.RemainingISOStorage(orgID)
+	if err != nil {
+		http.Error(w, "failed to check ISO storage quota", http.StatusInternalServerError)
+		return
+	}
+	if r.ContentLength > 0 && r.ContentLength > remaining+64*1024 {
+		http.Error(w, quota.ErrISOQuotaExceeded.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	mr, err := r.MultipartReader()
+	if err != nil {
+		http.Error(w, "invalid multipart request", http.StatusBadRequest)
+		return
+	}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			http.Error(w, "file field is required", http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			http.Error(w, "error reading multipart", http.StatusBadRequest)
+			return
+		}
+		if part.FormName() != "file" {
+			io.Copy(io.Discard, part)
+			continue
+		}
+
+		isoName := r.URL.Query().Get("name")
+		if isoName == "" {
+			isoName = part.FileName()
+		}
+		if err := s.vpsManager.UploadISO(ctx, orgID, isoName, part); err != nil {
+			status := http.StatusInternalServerError
+			switch {
+			case errors.Is(err, orchestrator.ErrInvalidISOName):
+				status = http.StatusBadRequest
+			case errors.Is(err, orchestrator.ErrISOExists):
+				status = http.StatusConflict
+			case errors.Is(err, quota.ErrISOQuotaExceeded):
+				status = http.StatusRequestEntityTooLarge
+			default:
+				logger.Error("[VPS Service] Failed to upload ISO %s for organization %s: %v", isoName, orgID, err)
+			}
+			http.Error(w, fmt.Sprintf("upload failed: %v", err), status)
+			return
+		}
+
+		iso, err := database.GetVPSISO(orgID, isoName)
+		if err != nil {
+			http.Error(w, "failed to load uploaded ISO", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"name":       iso.ISOName,
+			"size_bytes": iso.SizeBytes,
+		})
+		return
+	}
+}
+
+// ListOrgISOs lists the install ISOs of an organization and its ISO storage usage
+func (s *Service) ListOrgISOs(ctx context.Context, req *connect.Request[vpsv1.ListOrgISOsRequest]) (*connect.Response[vpsv1.ListOrgISOsResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.checkOrgVPSPermission(ctx, orgID, auth.PermissionVPSRead); err != nil {
+		return nil, err
+	}
+
+	isos, err := database.ListVPSISOs(orgID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	used, limit, err := quota.GetISOStorageUsage(orgID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get ISO storage usage: %w", err))
+	}
+
+	protoISOs := make([]*vpsv1.VPSISO, len(isos))
+	for i := range isos {
+		protoISOs[i] = vpsISOToProto(&isos[i])
+	}
+
+	return connect.NewResponse(&vpsv1.ListOrgISOsResponse{
+		Isos:       protoISOs,
+		UsedBytes:  used,
+		QuotaBytes: limit,
+	}), nil
+}
+
+// DeleteISO deletes an install ISO of an organization from the ISO storage
+func (s *Service) DeleteISO(ctx context.Context, req *connect.Request[vpsv1.DeleteISORequest]) (*connect.Response[vpsv1.DeleteISOResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.checkOrgVPSPermission(ctx, orgID, auth.PermissionVPSDelete); err != nil {
+		return nil, err
+	}
+	if s.vpsManager == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("VPS manager not available"))
+	}
+
+	isoName := req.Msg.GetIsoName()
+	if err := s.vpsManager.DeleteISO(ctx, orgID, isoName); err != nil {
+		if errors.Is(err, orchestrator.ErrISONotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("ISO %s not found", isoName))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete ISO: %w", err))
+	}
+
+	return connect.NewResponse(&vpsv1.DeleteISOResponse{
+		Success: true,
+	}), nil
+}
+
+// MountISO inserts an ISO of the organization into the VPS's CD-ROM drive
+func (s *Service) MountISO(ctx context.Context, req *connect.Request[vpsv1.MountISORequest]) (*connect.Response[vpsv1.MountISOResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	vpsID := req.Msg.GetVpsId()
+	if err := s.checkISOTargetVPS(ctx, req.Msg.GetOrganizationId(), vpsID); err != nil {
+		return nil, err
+	}
+
+	isoName := strings.TrimSpace(req.Msg.GetIsoName())
+	if isoName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("iso_name is required"))
+	}
+	if err := s.vpsManager.MountISO(ctx, vpsID, isoName); err != nil {
+		switch {
+		case errors.Is(err, orchestrator.ErrISONotFound):
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("ISO %s not found", isoName))
+		case errors.Is(err, orchestrator.ErrISONotOnNode), errors.Is(err, orchestrator.ErrCloudInitDriveBusy):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to mount ISO: %w", err))
+	}
+
+	return connect.NewResponse(&vpsv1.MountISOResponse{
+		Success: true,
+	}), nil
+}
+
+// UnmountISO ejects the ISO from the VPS's CD-ROM drive
+func (s *Service) UnmountISO(ctx context.Context, req *connect.Request[vpsv1.UnmountISORequest]) (*connect.Response[vpsv1.UnmountISOResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	vpsID := req.Msg.GetVpsId()
+	if err := s.checkISOTargetVPS(ctx, req.Msg.GetOrganizationId(), vpsID); err != nil {
+		return nil, err
+	}
+	if err := s.vpsManager.UnmountISO(ctx, vpsID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to unmount ISO: %w", err))
+	}
+
+	return connect.NewResponse(&vpsv1.UnmountISOResponse{
+		Success: true,
+	}), nil
+}
+
+// checkISOTargetVPS verifies that the VPS belongs to the organization and that the user may change it
+func (s *Service) checkISOTargetVPS(ctx context.Context, orgID, vpsID string) error {
+	if err := s.checkVPSPermission(ctx, vpsID, auth.PermissionVPSUpdate); err != nil {
+		return err
+	}
+	var vps database.VPSInstance
+	if err := database.DB.Select("id").Where("id = ? AND organization_id = ? AND deleted_at IS NULL", vpsID, orgID).First(&vps).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("VPS %s not found", vpsID))
+		}
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS: %w", err))
+	}
+	if s.vpsManager == nil {
+		return connect.NewError(connect.CodeUnavailable, fmt.Errorf("VPS manager not available"))
+	}
+	return nil
+}
+
+func vpsISOToProto(iso *database.VPSISO) *vpsv1.VPSISO {
+	return &vpsv1.VPSISO{
+		OrganizationId: iso.OrgID,
+		Name:           iso.ISOName,
+		SizeBytes:      iso.SizeBytes,
+		UploadedAt:     timestamppb.New(iso.UploadedAt),
+	}
+}
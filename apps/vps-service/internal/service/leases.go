@@ -118,7 +118,7 @@ func (s *Service) FindVPSByLease(ctx context.Context, req *connect.Request[vpsv1
 		var lease database.DHCPLease
 		if err := database.DB.WithContext(ctx).Where("mac_address = ?", mac).First(&lease).Error; err == nil {
 			logger.Info("[FindVPSByLease] ✓ Found VPS %s by MAC in dhcp_leases", lease.VPSID)
-			return connect.NewResponse(&vpsv1.FindVPSByLeaseResponse{VpsId: lease.VPSID, OrganizationId: lease.OrganizationID}), nil
+			return connect.NewResponse(foundLeaseResponse(lease.VPSID, lease.OrganizationID)), nil
 		} else {
 			logger.Debug("[FindVPSByLease] dhcp_leases lookup failed: %v", err)
 		}
@@ -128,7 +128,7 @@ func (s *Service) FindVPSByLease(ctx context.Context, req *connect.Request[vpsv1
 		var vps database.VPSInstance
 		if err := database.DB.WithContext(ctx).Where("mac_address = ? AND deleted_at IS NULL", mac).First(&vps).Error; err == nil {
 			logger.Info("[FindVPSByLease] ✓ Found VPS %s by MAC in vps_instances (self-healing mode)", vps.ID)
-			return connect.NewResponse(&vpsv1.FindVPSByLeaseResponse{VpsId: vps.ID, OrganizationId: vps.OrganizationID}), nil
+			return connect.NewResponse(foundLeaseResponse(vps.ID, vps.OrganizationID)), nil
 		} else {
 			logger.Debug("[FindVPSByLease] vps_instances lookup failed: %v", err)
 		}
@@ -142,10 +142,7 @@ func (s *Service) FindVPSByLease(ctx context.Context, req *connect.Request[vpsv1
 				logger.Error("[FindVPSByLease] ✗ Proxmox API lookup failed for MAC %s: %v", mac, err)
 			} else if vpsFromProxmox != nil {
 				logger.Info("[FindVPSByLease] ✓ Found VPS %s by MAC via Proxmox API (initial lease creation)", vpsFromProxmox.ID)
-				return connect.NewResponse(&vpsv1.FindVPSByLeaseResponse{
-					VpsId:          vpsFromProxmox.ID,
-					OrganizationId: vpsFromProxmox.OrganizationID,
-				}), nil
+				return connect.NewResponse(foundLeaseResponse(vpsFromProxmox.ID, vpsFromProxmox.OrganizationID)), nil
 			} else {
 				logger.Warn("[FindVPSByLease] ✗ Proxmox API returned nil (no VPS found with MAC %s)", mac)
 			}
@@ -159,7 +156,7 @@ func (s *Service) FindVPSByLease(ctx context.Context, req *connect.Request[vpsv1
 		var lease database.DHCPLease
 		if err := database.DB.WithContext(ctx).Where("ip_address = ?", ip).First(&lease).Error; err == nil {
 			logger.Debug("[FindVPSByLease] Found VPS %s by IP in dhcp_leases", lease.VPSID)
-			return connect.NewResponse(&vpsv1.FindVPSByLeaseResponse{VpsId: lease.VPSID, OrganizationId: lease.OrganizationID}), nil
+			return connect.NewResponse(foundLeaseResponse(lease.VPSID, lease.OrganizationID)), nil
 		}
 	}
 
@@ -214,3 +211,16 @@ func (s *Service) ReleaseLease(ctx context.Context, req *connect.Request[vpsv1.R
 		Message: "Lease released successfully",
 	}), nil
 }
+
+// foundLeaseResponse builds a FindVPSByLease response for a resolved VPS,
+// including the bandwidth limits the gateway should enforce for the lease
+func foundLeaseResponse(vpsID, orgID string) *vpsv1.FindVPSByLeaseResponse {
+	resp := &vpsv1.FindVPSByLeaseResponse{VpsId: vpsID, OrganizationId: orgID}
+	if down, up, err := database.GetVPSBandwidthLimits(vpsID); err == nil {
+		resp.MaxMbitDown = down
+		resp.MaxMbitUp = up
+	} else {
+		logger.Debug("[FindVPSByLease] No bandwidth limits for VPS %s: %v", vpsID, err)
+	}
+	return resp
+}
@@ -59,50 +59,20 @@ func (s *Service) StartVPS(ctx context.Context, req *connect.Request[vpsv1.Start
 		return nil, err
 	}
 
-	var vps database.VPSInstance
-	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VPS instance %s not found", vpsID))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS: %w", err))
-	}
-
-	// Sync status from Proxmox before performing action to ensure we have current status
-	// This prevents issues where VPS is stuck in REBOOTING or other transitional states
-	s.syncVPSStatusFromProxmox(ctx, vpsID)
-
-	// Check if VPS was marked as DELETED during sync
-	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err == nil {
-		if vps.Status == 9 { // DELETED
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VM has been deleted from Proxmox"))
-		}
-	}
-
-	// Start VM via Proxmox
-	if err := s.vpsManager.StartVPS(ctx, vpsID); err != nil {
-		// Check if VM was deleted - provide user-friendly error message
-		if strings.Contains(err.Error(), "has been deleted from Proxmox") || strings.Contains(err.Error(), "VM has been deleted") {
-			// Don't send notification here - syncVPSStatusFromProxmox already sent it if needed
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VM has been deleted from Proxmox"))
-		}
-		// For other errors, don't change status or send notifications
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to start VPS: %w", err))
-	}
-
-	// Refresh VPS instance
-	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to refresh VPS: %w", err))
+	vps, err := s.runPowerAction(ctx, vpsID, vpsPowerStart)
+	if err != nil {
+		return nil, err
 	}
 
 	// Send notification for VPS started
-	s.notifyVPSStarted(ctx, &vps)
+	s.notifyVPSStarted(ctx, vps)
 
 	return connect.NewResponse(&vpsv1.StartVPSResponse{
-		Vps: vpsToProto(&vps),
+		Vps: vpsToProto(vps),
 	}), nil
 }
 
-// StopVPS stops a running VPS instance
+// StopVPS shuts a running VPS instance down with an ACPI shutdown
 func (s *Service) StopVPS(ctx context.Context, req *connect.Request[vpsv1.StopVPSRequest]) (*connect.Response[vpsv1.StopVPSResponse], error) {
 	ctx, err := s.ensureAuthenticated(ctx, req)
 	if err != nil {
@@ -114,51 +84,21 @@ func (s *Service) StopVPS(ctx context.Context, req *connect.Request[vpsv1.StopVP
 		return nil, err
 	}
 
-	var vps database.VPSInstance
-	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VPS instance %s not found", vpsID))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS: %w", err))
-	}
-
-	// Sync status from Proxmox before performing action to ensure we have current status
-	// This prevents issues where VPS is stuck in REBOOTING or other transitional states
-	s.syncVPSStatusFromProxmox(ctx, vpsID)
-
-	// Check if VPS was marked as DELETED during sync
-	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err == nil {
-		if vps.Status == 9 { // DELETED
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VM has been deleted from Proxmox"))
-		}
-	}
-
-	// Stop VM via Proxmox
-	if err := s.vpsManager.StopVPS(ctx, vpsID, false); err != nil {
-		// Check if VM was deleted - provide user-friendly error message
-		if strings.Contains(err.Error(), "has been deleted from Proxmox") || strings.Contains(err.Error(), "VM has been deleted") {
-			// Don't send notification here - syncVPSStatusFromProxmox already sent it if needed
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VM has been deleted from Proxmox"))
-		}
-		// For other errors, don't change status or send notifications
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to stop VPS: %w", err))
-	}
-
-	// Refresh VPS instance
-	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to refresh VPS: %w", err))
+	vps, err := s.runPowerAction(ctx, vpsID, vpsPowerStop)
+	if err != nil {
+		return nil, err
 	}
 
 	// Send notification for VPS stopped
-	s.notifyVPSStopped(ctx, &vps)
+	s.notifyVPSStopped(ctx, vps)
 
 	return connect.NewResponse(&vpsv1.StopVPSResponse{
-		Vps: vpsToProto(&vps),
+		Vps: vpsToProto(vps),
 	}), nil
 }
 
-// RebootVPS reboots a VPS instance
-func (s *Service) RebootVPS(ctx context.Context, req *connect.Request[vpsv1.RebootVPSRequest]) (*connect.Response[vpsv1.RebootVPSResponse], error) {
+// ForceStopVPS powers a VPS instance off immediately, without waiting for the guest OS
+func (s *Service) ForceStopVPS(ctx context.Context, req *connect.Request[vpsv1.ForceStopVPSRequest]) (*connect.Response[vpsv1.ForceStopVPSResponse], error) {
 	ctx, err := s.ensureAuthenticated(ctx, req)
 	if err != nil {
 		return nil, err
@@ -169,46 +109,41 @@ func (s *Service) RebootVPS(ctx context.Context, req *connect.Request[vpsv1.Rebo
 		return nil, err
 	}
 
-	var vps database.VPSInstance
-	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VPS instance %s not found", vpsID))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS: %w", err))
+	vps, err := s.runPowerAction(ctx, vpsID, vpsPowerForceStop)
+	if err != nil {
+		return nil, err
 	}
 
-	// Sync status from Proxmox before performing action to ensure we have current status
-	// This prevents issues where VPS is stuck in REBOOTING or other transitional states
-	s.syncVPSStatusFromProxmox(ctx, vpsID)
+	// Always tell the owner, since a force stop can lose unsaved data in the guest
+	s.notifyVPSForceStopped(ctx, vps)
 
-	// Check if VPS was marked as DELETED during sync
-	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err == nil {
-		if vps.Status == 9 { // DELETED
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VM has been deleted from Proxmox"))
-		}
+	return connect.NewResponse(&vpsv1.ForceStopVPSResponse{
+		Vps: vpsToProto(vps),
+	}), nil
+}
+
+// RebootVPS reboots a VPS instance
+func (s *Service) RebootVPS(ctx context.Context, req *connect.Request[vpsv1.RebootVPSRequest]) (*connect.Response[vpsv1.RebootVPSResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	// Reboot VM via Proxmox
-	if err := s.vpsManager.RebootVPS(ctx, vpsID); err != nil {
-		// Check if VM was deleted - provide user-friendly error message
-		if strings.Contains(err.Error(), "has been deleted from Proxmox") || strings.Contains(err.Error(), "VM has been deleted") {
-			// Don't send notification here - syncVPSStatusFromProxmox already sent it if needed
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VM has been deleted from Proxmox"))
-		}
-		// For other errors, don't change status or send notifications
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to reboot VPS: %w", err))
+	vpsID := req.Msg.GetVpsId()
+	if err := s.checkVPSPermission(ctx, vpsID, auth.PermissionVPSManage); err != nil {
+		return nil, err
 	}
 
-	// Refresh VPS instance
-	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to refresh VPS: %w", err))
+	vps, err := s.runPowerAction(ctx, vpsID, vpsPowerReboot)
+	if err != nil {
+		return nil, err
 	}
 
 	// Send notification for VPS rebooted
-	s.notifyVPSRebooted(ctx, &vps)
+	s.notifyVPSRebooted(ctx, vps)
 
 	return connect.NewResponse(&vpsv1.RebootVPSResponse{
-		Vps: vpsToProto(&vps),
+		Vps: vpsToProto(vps),
 	}), nil
 }
 
@@ -270,36 +205,7 @@ func (s *Service) GetVPSProxyInfo(ctx context.Context, req *connect.Request[vpsv
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS: %w", err))
 	}
 
-	// Get API base URL from environment or construct from configuration
-	apiBaseURL := os.Getenv("API_BASE_URL")
-	if apiBaseURL == "" {
-		// Check if domain-based routing is enabled
-		useDomainRouting := os.Getenv("USE_DOMAIN_ROUTING")
-		domain := os.Getenv("DOMAIN")
-		useTraefikRouting := os.Getenv("USE_TRAEFIK_ROUTING")
-
-		// If domain routing is enabled and domain is set, use domain-based URL
-		if (useDomainRouting == "true" || useDomainRouting == "1") && domain != "" && domain != "localhost" {
-			scheme := "http"
-			if useTraefikRouting == "true" || useTraefikRouting == "1" {
-				scheme = "https"
-			}
-			apiBaseURL = fmt.Sprintf("%s://api.%s", scheme, domain)
-		} else {
-			// Fallback to service name or localhost
-			port := os.Getenv("PORT")
-			if port == "" {
-				port = "3001"
-			}
-			// Try service name first (works in Docker networks)
-			if os.Getenv("ENABLE_SWARM") != "false" {
-				apiBaseURL = fmt.Sprintf("http://api-gateway:%s", port)
-			} else {
-				// For localhost/dev, use HTTP
-				apiBaseURL = fmt.Sprintf("http://localhost:%s", port)
-			}
-		}
-	}
+	apiBaseURL := resolveAPIBaseURL()
 
 	// Construct WebSocket URL for terminal access
 	wsURL := fmt.Sprintf("%s/vps/%s/terminal/ws", apiBaseURL, vpsID)
@@ -394,3 +300,38 @@ Note:
 		ConnectionInstructions: instructions,
 	}), nil
 }
+
+// resolveAPIBaseURL returns the public API base URL from API_BASE_URL, or constructs it from
+// the routing configuration
+func resolveAPIBaseURL() string {
+	apiBaseURL := os.Getenv("API_BASE_URL")
+	if apiBaseURL == "" {
+		// Check if domain-based routing is enabled
+		useDomainRouting := os.Getenv("USE_DOMAIN_ROUTING")
+		domain := os.Getenv("DOMAIN")
+		useTraefikRouting := os.Getenv("USE_TRAEFIK_ROUTING")
+
+		// If domain routing is enabled and domain is set, use domain-based URL
+		if (useDomainRouting == "true" || useDomainRouting == "1") && domain != "" && domain != "localhost" {
+			scheme := "http"
+			if useTraefikRouting == "true" || useTraefikRouting == "1" {
+				scheme = "https"
+			}
+			apiBaseURL = fmt.Sprintf("%s://api.%s", scheme, domain)
+		} else {
+			// Fallback to service name or localhost
+			port := os.Getenv("PORT")
+			if port == "" {
+				port = "3001"
+			}
+			// Try service name first (works in Docker networks)
+			if os.Getenv("ENABLE_SWARM") != "false" {
+				apiBaseURL = fmt.Sprintf("http://api-gateway:%s", port)
+			} else {
+				// For localhost/dev, use HTTP
+				apiBaseURL = fmt.Sprintf("http://localhost:%s", port)
+			}
+		}
+	}
+	return apiBaseURL
+}
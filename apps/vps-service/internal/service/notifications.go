@@ -12,6 +12,9 @@ import (
 	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
 )
 
+// createUserNotification is replaced in tests
+var createUserNotification = notifications.CreateNotificationForUser
+
 // notifyVPSEvent sends a notification for a VPS event
 func (s *Service) notifyVPSEvent(ctx context.Context, vps *database.VPSInstance, eventType string, severity notificationsv1.NotificationSeverity, title, message string, metadata map[string]string) {
 	// Get user from context if available
@@ -72,7 +75,7 @@ func (s *Service) notifyVPSEvent(ctx context.Context, vps *database.VPSInstance,
 		metadata["vm_id"] = *vps.InstanceID
 	}
 
-	if err := notifications.CreateNotificationForUser(
+	if err := createUserNotification(
 		ctx,
 		userID,
 		orgID,
@@ -164,6 +167,50 @@ func (s *Service) notifyVPSRebooted(ctx context.Context, vps *database.VPSInstan
 	s.notifyVPSEvent(ctx, vps, "vps_rebooted", notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_LOW, title, message, metadata)
 }
 
+// notifyVPSForceStopped notifies the VPS owner that their VPS was powered off without a clean shutdown
+func (s *Service) notifyVPSForceStopped(ctx context.Context, vps *database.VPSInstance) {
+	title := fmt.Sprintf("VPS Force Stopped: %s", vps.Name)
+	message := fmt.Sprintf("Your VPS instance '%s' was force stopped. Unsaved data in the guest may have been lost.", vps.Name)
+
+	metadata := map[string]string{
+		"vps_status": fmt.Sprintf("%d", vps.Status),
+	}
+	if userInfo, err := auth.GetUserFromContext(ctx); err == nil && userInfo != nil {
+		metadata["stopped_by"] = userInfo.Id
+	}
+
+	if vps.CreatedBy == "" {
+		s.notifyVPSEvent(ctx, vps, "vps_force_stopped", notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH, title, message, metadata)
+		return
+	}
+
+	// Notify the owner rather than whoever forced the stop
+	orgID := &vps.OrganizationID
+	if vps.OrganizationID == "" {
+		orgID = nil
+	}
+	actionURL := fmt.Sprintf("/vps/%s", vps.ID)
+	actionLabel := "View VPS"
+	metadata["vps_id"] = vps.ID
+	metadata["vps_name"] = vps.Name
+	metadata["event_type"] = "vps_force_stopped"
+
+	if err := createUserNotification(
+		ctx,
+		vps.CreatedBy,
+		orgID,
+		notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM,
+		notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+		title,
+		message,
+		&actionURL,
+		&actionLabel,
+		metadata,
+	); err != nil {
+		logger.Warn("[VPS Notifications] Failed to notify owner of force stopped VPS %s: %v", vps.ID, err)
+	}
+}
+
 // notifyVPSFailed sends a notification when a VPS fails
 func (s *Service) notifyVPSFailed(ctx context.Context, vps *database.VPSInstance, reason string) {
 	title := fmt.Sprintf("VPS Failed: %s", vps.Name)
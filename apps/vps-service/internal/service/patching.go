@@ -0,0 +1,380 @@
+package vps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/notifications"
+	orchestrator "github.com/obiente/cloud/apps/vps-service/orchestrator"
+
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPatchMaxAgeDays = 30
+	maxPatchOutputBytes    = 64 * 1024
+)
+
+var (
+	// vpsPatchTimeout bounds a whole patch run, from the restart to the script's status file. Replaced in tests.
+	vpsPatchTimeout = 45 * time.Minute
+	// vpsPatchPollInterval is how often the guest agent is asked whether the patch script finished. Replaced in tests.
+	vpsPatchPollInterval = 15 * time.Second
+	// vpsPatchTaskTimeout bounds each Proxmox power task of a patch run. Replaced in tests.
+	vpsPatchTaskTimeout = 3 * time.Minute
+)
+
+// vpsPatchClient is the part of the Proxmox client a patch run uses
+type vpsPatchClient interface {
+	GetVMStatus(ctx context.Context, nodeName string, vmID int) (string, error)
+	AttachVendorData(ctx context.Context, nodeName string, vmID int, vendorData string) (string, error)
+	RestoreCicustom(ctx context.Context, nodeName string, vmID int, cicustom string) error
+	PowerAction(ctx context.Context, nodeName string, vmID int, action string) (string, error)
+	WaitForTask(ctx context.Context, nodeName, upid string, timeout time.Duration) error
+	RunGuestShellCommand(ctx context.Context, nodeName string, vmID int, command string) ([]byte, int, error)
+}
+
+// vpsPatchClientForNode returns the Proxmox client for a node (replaced in tests)
+var vpsPatchClientForNode = func(nodeName string) (vpsPatchClient, func(), error) {
+	client, closeClient, err := proxmoxClientForNode(nodeName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, closeClient, nil
+}
+
+// PatchVPS upgrades the OS packages of a VPS. The patch script is attached to the VM as cloud-init
+// vendor-data and the VM is restarted so cloud-init runs it; the run then continues in the background
+// and is recorded in vps_patch_history.
+func (s *Service) PatchVPS(ctx context.Context, req *connect.Request[vpsv1.PatchVPSRequest]) (*connect.Response[vpsv1.PatchVPSResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	vpsID := req.Msg.GetVpsId()
+	if err := s.checkVPSPermission(ctx, vpsID, auth.PermissionVPSManage); err != nil {
+		return nil, err
+	}
+
+	var vps database.VPSInstance
+	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VPS instance %s not found", vpsID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS: %w", err))
+	}
+	switch vpsv1.VPSStatus(vps.Status) {
+	case vpsv1.VPSStatus_RUNNING, vpsv1.VPSStatus_STOPPED, vpsv1.VPSStatus_UNRESPONSIVE:
+	default:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("VPS must be running or stopped to be patched (status: %s)", vpsv1.VPSStatus(vps.Status)))
+	}
+	if vps.InstanceID == nil || vps.NodeID == nil || *vps.NodeID == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("VPS has not been provisioned on a node yet"))
+	}
+
+	// Runs older than the patch timeout were cut off (e.g. by a restart) and no longer block new ones
+	var running int64
+	if err := database.DB.Model(&database.VPSPatchHistory{}).
+		Where("vps_id = ? AND status = ? AND started_at > ?", vps.ID, database.VPSPatchStatusRunning, time.Now().Add(-vpsPatchTimeout)).
+		Count(&running).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check running patches: %w", err))
+	}
+	if running > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("a patch run is already in progress for this VPS"))
+	}
+
+	requestedBy := ""
+	if user, err := auth.GetUserFromContext(ctx); err == nil && user != nil {
+		requestedBy = user.Id
+	}
+	patch := &database.VPSPatchHistory{
+		ID:             fmt.Sprintf("vpp-%s", uuid.NewString()),
+		VPSID:          vps.ID,
+		OrganizationID: vps.OrganizationID,
+		RequestedBy:    requestedBy,
+		RebootAfter:    req.Msg.GetRebootAfter(),
+		Status:         database.VPSPatchStatusRunning,
+		StartedAt:      time.Now(),
+	}
+	if err := database.DB.Create(patch).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to record patch run: %w", err))
+	}
+
+	go func() {
+		runCtx, cancel := s.detachedContext(vpsPatchTimeout)
+		defer cancel()
+		s.runVPSPatch(runCtx, &vps, patch)
+	}()
+
+	return connect.NewResponse(&vpsv1.PatchVPSResponse{
+		PatchId: patch.ID,
+	}), nil
+}
+
+// runVPSPatch performs a patch run and records its outcome. A VPS that was stopped is shut down again afterwards.
+func (s *Service) runVPSPatch(ctx context.Context, vps *database.VPSInstance, patch *database.VPSPatchHistory) {
+	logger.Info("[VPS Patch] Starting patch run %s for VPS %s", patch.ID, vps.ID)
+	result, err := s.patchVM(ctx, vps, patch)
+	if err != nil {
+		logger.Warn("[VPS Patch] Patch run %s for VPS %s failed: %v", patch.ID, vps.ID, err)
+		s.finishPatch(vps, patch, database.VPSPatchStatusFailed, result, err.Error())
+		s.notifyVPSEvent(ctx, vps, "vps_patch_failed", notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+			"VPS Patching Failed",
+			fmt.Sprintf("Patching the OS packages of VPS %s failed: %v", vps.Name, err),
+			map[string]string{"vps_id": vps.ID, "vps_name": vps.Name, "patch_id": patch.ID})
+		return
+	}
+
+	logger.Info("[VPS Patch] Patch run %s for VPS %s succeeded", patch.ID, vps.ID)
+	s.finishPatch(vps, patch, database.VPSPatchStatusSucceeded, result, "")
+	s.notifyVPSEvent(ctx, vps, "vps_patched", notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_LOW,
+		"VPS Patched",
+		fmt.Sprintf("The OS packages of VPS %s were upgraded with %s.", vps.Name, result.PackageManager),
+		map[string]string{"vps_id": vps.ID, "vps_name": vps.Name, "patch_id": patch.ID})
+}
+
+// patchVM attaches the patch vendor-data, restarts the VM and waits for the patch script's status file
+func (s *Service) patchVM(ctx context.Context, vps *database.VPSInstance, patch *database.VPSPatchHistory) (*orchestrator.PatchRunStatus, error) {
+	nodeName := *vps.NodeID
+	vmID, err := strconv.Atoi(*vps.InstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VM ID %q: %w", *vps.InstanceID, err)
+	}
+	vendorData, err := orchestrator.PatchVendorData(patch.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, closeClient, err := vpsPatchClientForNode(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	defer closeClient()
+
+	vmStatus, err := client.GetVMStatus(ctx, nodeName, vmID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VM status: %w", err)
+	}
+	wasRunning := vmStatus == "running"
+
+	previousCicustom, err := client.AttachVendorData(ctx, nodeName, vmID, vendorData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach patch script: %w", err)
+	}
+	restored := false
+	restoreCicustom := func() {
+		if restored {
+			return
+		}
+		restored = true
+		restoreCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := client.RestoreCicustom(restoreCtx, nodeName, vmID, previousCicustom); err != nil {
+			logger.Warn("[VPS Patch] Failed to restore cloud-init config of VPS %s: %v", vps.ID, err)
+		}
+	}
+	defer restoreCicustom()
+
+	// Proxmox regenerates the cloud-init drive only when the VM starts, so a running VM is shut down first
+	if wasRunning {
+		if err := runPatchPowerAction(ctx, client, nodeName, vmID, "shutdown"); err != nil {
+			return nil, err
+		}
+	}
+	if err := runPatchPowerAction(ctx, client, nodeName, vmID, "start"); err != nil {
+		return nil, err
+	}
+	// The drive was generated at start; later boots no longer need the vendor-data
+	restoreCicustom()
+
+	result, err := waitForPatchResult(ctx, client, nodeName, vmID, patch.ID)
+	if err != nil {
+		return nil, err
+	}
+	if result.ExitCode != 0 {
+		return result, fmt.Errorf("patch script exited with code %d", result.ExitCode)
+	}
+
+	switch {
+	case !wasRunning:
+		if err := runPatchPowerAction(ctx, client, nodeName, vmID, "shutdown"); err != nil {
+			logger.Warn("[VPS Patch] Failed to shut VPS %s down again after patching: %v", vps.ID, err)
+		}
+	case patch.RebootAfter:
+		if err := runPatchPowerAction(ctx, client, nodeName, vmID, "reboot"); err != nil {
+			logger.Warn("[VPS Patch] Failed to reboot VPS %s after patching: %v", vps.ID, err)
+		}
+	}
+	return result, nil
+}
+
+func runPatchPowerAction(ctx context.Context, client vpsPatchClient, nodeName string, vmID int, action string) error {
+	upid, err := client.PowerAction(ctx, nodeName, vmID, action)
+	if err != nil {
+		return fmt.Errorf("failed to %s VM: %w", action, err)
+	}
+	if err := client.WaitForTask(ctx, nodeName, upid, vpsPatchTaskTimeout); err != nil {
+		return fmt.Errorf("VM %s did not complete: %w", action, err)
+	}
+	return nil
+}
+
+// waitForPatchResult polls the guest agent until the patch script wrote its status file. Errors while
+// polling are expected until the guest agent is up, so only the overall timeout ends the wait.
+func waitForPatchResult(ctx context.Context, client vpsPatchClient, nodeName string, vmID int, patchID string) (*orchestrator.PatchRunStatus, error) {
+	ticker := time.NewTicker(vpsPatchPollInterval)
+	defer ticker.Stop()
+
+	var lastErr error
+	for {
+		output, _, err := client.RunGuestShellCommand(ctx, nodeName, vmID, orchestrator.PatchStatusCommand(patchID))
+		if err == nil {
+			if status, ok := orchestrator.ParsePatchStatus(string(output)); ok {
+				return &status, nil
+			}
+		} else {
+			lastErr = err
+		}
+
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return nil, fmt.Errorf("patch script did not finish within %s (last guest agent error: %v)", vpsPatchTimeout, lastErr)
+			}
+			return nil, fmt.Errorf("patch script did not finish within %s", vpsPatchTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// finishPatch stores the outcome of a patch run, and the patch time on the VPS when it succeeded
+func (s *Service) finishPatch(vps *database.VPSInstance, patch *database.VPSPatchHistory, status string, result *orchestrator.PatchRunStatus, failure string) {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":       status,
+		"completed_at": now,
+	}
+	output := failure
+	if result != nil {
+		exitCode := int32(result.ExitCode)
+		updates["exit_code"] = exitCode
+		updates["package_manager"] = result.PackageManager
+		output = strings.TrimSpace(strings.TrimSpace(result.Output) + "\n" + failure)
+	}
+	if len(output) > maxPatchOutputBytes {
+		output = output[len(output)-maxPatchOutputBytes:]
+	}
+	updates["output"] = output
+	if err := database.DB.Model(patch).Updates(updates).Error; err != nil {
+		logger.Warn("[VPS Patch] Failed to record result of patch run %s: %v", patch.ID, err)
+	}
+
+	vpsUpdates := map[string]interface{}{"updated_at": now}
+	if status == database.VPSPatchStatusSucceeded {
+		vpsUpdates["last_patched_at"] = now
+	}
+	if err := database.DB.Model(&database.VPSInstance{}).Where("id = ?", vps.ID).Updates(vpsUpdates).Error; err != nil {
+		logger.Warn("[VPS Patch] Failed to record patch time of VPS %s: %v", vps.ID, err)
+	}
+}
+
+// patchMaxAgeDays reads VPS_PATCH_MAX_AGE_DAYS, the age after which a VPS counts as unpatched (default 30)
+func patchMaxAgeDays() int {
+	if value := strings.TrimSpace(os.Getenv("VPS_PATCH_MAX_AGE_DAYS")); value != "" {
+		if days, err := strconv.Atoi(value); err == nil && days > 0 {
+			return days
+		}
+	}
+	return defaultPatchMaxAgeDays
+}
+
+// nextPatchCheck returns the next weekly patch check time, Mondays at 09:00 UTC
+func nextPatchCheck(now time.Time) time.Time {
+	now = now.UTC()
+	daysUntilMonday := (int(time.Monday) - int(now.Weekday()) + 7) % 7
+	next := time.Date(now.Year(), now.Month(), now.Day()+daysUntilMonday, 9, 0, 0, 0, time.UTC)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 7)
+	}
+	return next
+}
+
+// createOrganizationNotification is replaced in tests
+var createOrganizationNotification = notifications.CreateNotificationForOrganization
+
+// StartPatchCheckNotifier notifies organizations every week about VPS instances that have not been
+// patched in VPS_PATCH_MAX_AGE_DAYS days
+func StartPatchCheckNotifier(ctx context.Context) {
+	for {
+		timer := time.NewTimer(time.Until(nextPatchCheck(time.Now())))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			logger.Info("[VPS Patch] Patch check notifier stopped")
+			return
+		case <-timer.C:
+		}
+
+		checkCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+		if err := notifyUnpatchedVPS(checkCtx, patchMaxAgeDays()); err != nil {
+			logger.Warn("[VPS Patch] Weekly patch check failed: %v", err)
+		}
+		cancel()
+	}
+}
+
+// notifyUnpatchedVPS sends each organization one notification listing its VPS instances not patched in maxAgeDays
+func notifyUnpatchedVPS(ctx context.Context, maxAgeDays int) error {
+	instances, err := database.ListUnpatchedVPSInstances(ctx, time.Now().AddDate(0, 0, -maxAgeDays))
+	if err != nil {
+		return err
+	}
+
+	byOrganization := make(map[string][]database.VPSInstance)
+	var organizationIDs []string
+	for _, instance := range instances {
+		if instance.OrganizationID == "" {
+			continue
+		}
+		if _, ok := byOrganization[instance.OrganizationID]; !ok {
+			organizationIDs = append(organizationIDs, instance.OrganizationID)
+		}
+		byOrganization[instance.OrganizationID] = append(byOrganization[instance.OrganizationID], instance)
+	}
+
+	for _, organizationID := range organizationIDs {
+		orgInstances := byOrganization[organizationID]
+		names := make([]string, 0, len(orgInstances))
+		for _, instance := range orgInstances {
+			names = append(names, instance.Name)
+		}
+		message := fmt.Sprintf("%d VPS instance(s) have not been patched in over %d days: %s. Patch them to install the latest security updates.",
+			len(orgInstances), maxAgeDays, strings.Join(names, ", "))
+		metadata := map[string]string{
+			"vps_count":    strconv.Itoa(len(orgInstances)),
+			"max_age_days": strconv.Itoa(maxAgeDays),
+		}
+		if err := createOrganizationNotification(ctx, organizationID,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_SYSTEM,
+			notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_MEDIUM,
+			"VPS Security Patches Pending", message, nil, nil, metadata, nil); err != nil {
+			logger.Warn("[VPS Patch] Failed to notify organization %s about unpatched VPS instances: %v", organizationID, err)
+		}
+	}
+	logger.Info("[VPS Patch] Weekly patch check: %d unpatched VPS instance(s) in %d organization(s)", len(instances), len(organizationIDs))
+	return nil
+}
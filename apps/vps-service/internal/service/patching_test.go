@@ -0,0 +1,219 @@
+package vps
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+	"gorm.io/gorm"
+)
+
+// fakePatchClient records the calls of a patch run and reports the patch script as finished with exitCode
+type fakePatchClient struct {
+	mu       sync.Mutex
+	status   string
+	exitCode int
+	calls    []string
+	cicustom string
+}
+
+func (f *fakePatchClient) record(call string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, call)
+}
+
+func (f *fakePatchClient) GetVMStatus(ctx context.Context, nodeName string, vmID int) (string, error) {
+	return f.status, nil
+}
+
+func (f *fakePatchClient) AttachVendorData(ctx context.Context, nodeName string, vmID int, vendorData string) (string, error) {
+	f.record("attach")
+	previous := f.cicustom
+	f.cicustom = "user=local:snippets/vm-101-user-data,vendor=local:snippets/vm-101-vendor-data"
+	return previous, nil
+}
+
+func (f *fakePatchClient) RestoreCicustom(ctx context.Context, nodeName string, vmID int, cicustom string) error {
+	f.record("restore")
+	f.cicustom = cicustom
+	return nil
+}
+
+func (f *fakePatchClient) PowerAction(ctx context.Context, nodeName string, vmID int, action string) (string, error) {
+	f.record(action)
+	return "UPID:pve1:qm" + action, nil
+}
+
+func (f *fakePatchClient) WaitForTask(ctx context.Context, nodeName, upid string, timeout time.Duration) error {
+	return nil
+}
+
+func (f *fakePatchClient) RunGuestShellCommand(ctx context.Context, nodeName string, vmID int, command string) ([]byte, int, error) {
+	return []byte(fmt.Sprintf("exit=%d\npm=apt-get\n---\nupgraded 3 packages\n", f.exitCode)), 0, nil
+}
+
+func setupPatchTest(t *testing.T, client *fakePatchClient) (*Service, *gorm.DB) {
+	t.Helper()
+	db := newVPSServiceTestDB(t)
+	seedVPSServiceIsolationData(t, db)
+	if err := db.Model(&database.VPSInstance{}).Where("id = ?", "vps-org-a-owner").Updates(map[string]any{
+		"instance_id": "101",
+		"node_id":     "pve1",
+	}).Error; err != nil {
+		t.Fatalf("provision VPS: %v", err)
+	}
+
+	previousClient := vpsPatchClientForNode
+	vpsPatchClientForNode = func(nodeName string) (vpsPatchClient, func(), error) {
+		return client, func() {}, nil
+	}
+	previousNotify := createUserNotification
+	createUserNotification = func(ctx context.Context, userID string, orgID *string, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity, title, message string, actionURL, actionLabel *string, metadata map[string]string) error {
+		return nil
+	}
+	previousInterval := vpsPatchPollInterval
+	vpsPatchPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() {
+		vpsPatchClientForNode = previousClient
+		createUserNotification = previousNotify
+		vpsPatchPollInterval = previousInterval
+	})
+
+	return &Service{backgroundCtx: context.Background()}, db
+}
+
+func runTestPatch(t *testing.T, service *Service, db *gorm.DB, rebootAfter bool) database.VPSPatchHistory {
+	t.Helper()
+	var vps database.VPSInstance
+	if err := db.First(&vps, "id = ?", "vps-org-a-owner").Error; err != nil {
+		t.Fatalf("load VPS: %v", err)
+	}
+	patch := &database.VPSPatchHistory{ID: "vpp-test", VPSID: vps.ID, OrganizationID: vps.OrganizationID, RebootAfter: rebootAfter, Status: database.VPSPatchStatusRunning, StartedAt: time.Now()}
+	if err := db.Create(patch).Error; err != nil {
+		t.Fatalf("create patch run: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	service.runVPSPatch(ctx, &vps, patch)
+
+	var stored database.VPSPatchHistory
+	if err := db.First(&stored, "id = ?", patch.ID).Error; err != nil {
+		t.Fatalf("load patch run: %v", err)
+	}
+	return stored
+}
+
+func TestRunVPSPatchRebootsRunningVPS(t *testing.T) {
+	client := &fakePatchClient{status: "running", cicustom: "user=local:snippets/vm-101-user-data"}
+	service, db := setupPatchTest(t, client)
+
+	patch := runTestPatch(t, service, db, true)
+	if patch.Status != database.VPSPatchStatusSucceeded || patch.ExitCode == nil || *patch.ExitCode != 0 || patch.PackageManager != "apt-get" || patch.CompletedAt == nil {
+		t.Fatalf("patch run = %+v, want a completed apt-get run with exit code 0", patch)
+	}
+	if !strings.Contains(patch.Output, "upgraded 3 packages") {
+		t.Fatalf("patch output = %q, want the guest log", patch.Output)
+	}
+	if want := "attach,shutdown,start,restore,reboot"; strings.Join(client.calls, ",") != want {
+		t.Fatalf("calls = %v, want %s", client.calls, want)
+	}
+	if client.cicustom != "user=local:snippets/vm-101-user-data" {
+		t.Fatalf("cicustom = %q, want the original user-data only", client.cicustom)
+	}
+
+	var vps database.VPSInstance
+	db.First(&vps, "id = ?", "vps-org-a-owner")
+	if vps.LastPatchedAt == nil {
+		t.Fatalf("last_patched_at not set after a successful patch run")
+	}
+}
+
+func TestRunVPSPatchStopsStoppedVPSAgain(t *testing.T) {
+	client := &fakePatchClient{status: "stopped"}
+	service, db := setupPatchTest(t, client)
+
+	if patch := runTestPatch(t, service, db, true); patch.Status != database.VPSPatchStatusSucceeded {
+		t.Fatalf("patch status = %q, want succeeded", patch.Status)
+	}
+	if want := "attach,start,restore,shutdown"; strings.Join(client.calls, ",") != want {
+		t.Fatalf("calls = %v, want %s", client.calls, want)
+	}
+}
+
+func TestRunVPSPatchRecordsFailedExit(t *testing.T) {
+	client := &fakePatchClient{status: "running", exitCode: 100}
+	service, db := setupPatchTest(t, client)
+
+	patch := runTestPatch(t, service, db, true)
+	if patch.Status != database.VPSPatchStatusFailed || patch.ExitCode == nil || *patch.ExitCode != 100 {
+		t.Fatalf("patch run = %+v, want a failed run with exit code 100", patch)
+	}
+	if !strings.Contains(patch.Output, "exited with code 100") {
+		t.Fatalf("patch output = %q, want the failure reason", patch.Output)
+	}
+	if strings.Contains(strings.Join(client.calls, ","), "reboot") {
+		t.Fatalf("calls = %v, want no reboot after a failed run", client.calls)
+	}
+
+	var vps database.VPSInstance
+	db.First(&vps, "id = ?", "vps-org-a-owner")
+	if vps.LastPatchedAt != nil {
+		t.Fatalf("last_patched_at set after a failed patch run")
+	}
+}
+
+func TestNextPatchCheck(t *testing.T) {
+	tests := []struct {
+		now  time.Time
+		want time.Time
+	}{
+		{time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC), time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)}, // Wednesday
+		{time.Date(2026, 3, 9, 8, 59, 0, 0, time.UTC), time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)}, // Monday morning
+		{time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC), time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC)}, // Monday at the check
+		{time.Date(2026, 3, 8, 23, 0, 0, 0, time.UTC), time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)}, // Sunday night
+	}
+	for _, tt := range tests {
+		if got := nextPatchCheck(tt.now); !got.Equal(tt.want) {
+			t.Errorf("nextPatchCheck(%s) = %s, want %s", tt.now, got, tt.want)
+		}
+	}
+}
+
+func TestNotifyUnpatchedVPS(t *testing.T) {
+	db := newVPSServiceTestDB(t)
+	seedVPSServiceIsolationData(t, db)
+	old := time.Now().AddDate(0, 0, -60)
+	recent := time.Now().AddDate(0, 0, -5)
+	db.Model(&database.VPSInstance{}).Where("id IN ?", []string{"vps-org-a-owner", "vps-org-a-peer"}).Update("created_at", old)
+	db.Model(&database.VPSInstance{}).Where("id = ?", "vps-org-a-peer").Update("last_patched_at", recent)
+	db.Model(&database.VPSInstance{}).Where("id = ?", "vps-org-b-owner").Updates(map[string]any{
+		"created_at": old,
+		"status":     int32(vpsv1.VPSStatus_DELETED),
+	})
+
+	notified := map[string]string{}
+	previousNotify := createOrganizationNotification
+	createOrganizationNotification = func(ctx context.Context, orgID string, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity, title, message string, actionURL, actionLabel *string, metadata map[string]string, roles []string) error {
+		notified[orgID] = message
+		return nil
+	}
+	t.Cleanup(func() { createOrganizationNotification = previousNotify })
+
+	if err := notifyUnpatchedVPS(context.Background(), 30); err != nil {
+		t.Fatalf("notifyUnpatchedVPS: %v", err)
+	}
+	if len(notified) != 1 {
+		t.Fatalf("notified organizations %v, want only org-a", notified)
+	}
+	if message := notified["org-a"]; !strings.Contains(message, "Org A VPS") || strings.Contains(message, "Org A Peer VPS") {
+		t.Fatalf("org-a message = %q, want only the never patched VPS", message)
+	}
+}
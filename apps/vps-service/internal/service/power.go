@@ -0,0 +1,146 @@
+package vps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	orchestrator "github.com/obiente/cloud/apps/vps-service/orchestrator"
+
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// vpsPowerAction maps a power action to its Proxmox status endpoint and the VPS status it leaves behind
+type vpsPowerAction struct {
+	name          string // recorded in vps_power_events.action
+	proxmoxAction string // POST /nodes/{node}/qemu/{vmid}/status/{proxmoxAction}
+	finalStatus   vpsv1.VPSStatus
+}
+
+var (
+	vpsPowerStart     = vpsPowerAction{name: "start", proxmoxAction: "start", finalStatus: vpsv1.VPSStatus_RUNNING}
+	vpsPowerStop      = vpsPowerAction{name: "stop", proxmoxAction: "shutdown", finalStatus: vpsv1.VPSStatus_STOPPED}
+	vpsPowerReboot    = vpsPowerAction{name: "reboot", proxmoxAction: "reboot", finalStatus: vpsv1.VPSStatus_RUNNING}
+	vpsPowerForceStop = vpsPowerAction{name: "force_stop", proxmoxAction: "stop", finalStatus: vpsv1.VPSStatus_STOPPED}
+)
+
+// vpsPowerTaskTimeouts bounds how long each action's Proxmox task may run. An ACPI shutdown waits
+// on the guest OS, so stop and reboot get the longest. Replaced in tests.
+var vpsPowerTaskTimeouts = map[string]time.Duration{
+	vpsPowerStart.name:     time.Minute,
+	vpsPowerStop.name:      2 * time.Minute,
+	vpsPowerReboot.name:    2 * time.Minute,
+	vpsPowerForceStop.name: 30 * time.Second,
+}
+
+// runPowerAction sends a power action to Proxmox, waits for its task to finish and records the
+// outcome in vps_power_events. It returns the refreshed VPS on success.
+func (s *Service) runPowerAction(ctx context.Context, vpsID string, action vpsPowerAction) (*database.VPSInstance, error) {
+	var vps database.VPSInstance
+	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VPS instance %s not found", vpsID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS: %w", err))
+	}
+	if vps.Status == int32(vpsv1.VPSStatus_DELETED) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VM has been deleted from Proxmox"))
+	}
+	if vps.InstanceID == nil || vps.NodeID == nil || *vps.NodeID == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("VPS has not been provisioned on a node yet"))
+	}
+	vmID, err := strconv.Atoi(*vps.InstanceID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid VM ID %q: %w", *vps.InstanceID, err))
+	}
+
+	actorID := ""
+	if user, err := auth.GetUserFromContext(ctx); err == nil && user != nil {
+		actorID = user.Id
+	}
+	event := &database.VPSPowerEvent{
+		ID:          fmt.Sprintf("vpe-%s", uuid.NewString()),
+		VPSID:       vps.ID,
+		Action:      action.name,
+		ActorID:     actorID,
+		RequestedAt: time.Now(),
+		Result:      "pending",
+	}
+	if err := database.DB.Create(event).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to record power event: %w", err))
+	}
+
+	proxmoxClient, closeClient, err := proxmoxClientForNode(*vps.NodeID)
+	if err != nil {
+		s.finishPowerEvent(event, "failed: "+err.Error(), false)
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	defer closeClient()
+
+	upid, err := proxmoxClient.PowerAction(ctx, *vps.NodeID, vmID, action.proxmoxAction)
+	if err != nil {
+		s.finishPowerEvent(event, "failed: "+err.Error(), true)
+		if strings.Contains(err.Error(), "has been deleted from Proxmox") {
+			if err := database.DB.Model(&vps).Update("status", int32(vpsv1.VPSStatus_DELETED)).Error; err != nil {
+				logger.Warn("[VPS Service] Failed to mark VPS %s as deleted: %v", vps.ID, err)
+			}
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VM has been deleted from Proxmox"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to %s VPS: %w", action.proxmoxAction, err))
+	}
+	event.TaskID = upid
+
+	timeout := vpsPowerTaskTimeouts[action.name]
+	taskCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := proxmoxClient.WaitForTask(taskCtx, *vps.NodeID, upid, timeout); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, orchestrator.ErrTaskTimeout) {
+			s.finishPowerEvent(event, "timeout", false)
+			return nil, connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("VPS %s did not complete within %s", action.name, timeout))
+		}
+		s.finishPowerEvent(event, "failed: "+err.Error(), true)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("VPS %s failed: %w", action.name, err))
+	}
+	s.finishPowerEvent(event, "ok", true)
+
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":     int32(action.finalStatus),
+		"updated_at": now,
+	}
+	if action.finalStatus == vpsv1.VPSStatus_RUNNING {
+		updates["last_started_at"] = now
+	}
+	if err := database.DB.Model(&vps).Updates(updates).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update VPS status: %w", err))
+	}
+	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to refresh VPS: %w", err))
+	}
+	return &vps, nil
+}
+
+// finishPowerEvent stores the result of a power action. Timed-out actions keep a nil completed_at
+// because the Proxmox task may still be running.
+func (s *Service) finishPowerEvent(event *database.VPSPowerEvent, result string, completed bool) {
+	updates := map[string]interface{}{"result": result}
+	if event.TaskID != "" {
+		updates["task_id"] = event.TaskID
+	}
+	if completed {
+		updates["completed_at"] = time.Now()
+	}
+	if err := database.DB.Model(event).Updates(updates).Error; err != nil {
+		logger.Warn("[VPS Service] Failed to record result of %s for VPS %s: %v", event.Action, event.VPSID, err)
+	}
+}
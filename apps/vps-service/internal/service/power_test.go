@@ -0,0 +1,183 @@
+package vps
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	notificationsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/notifications/v1"
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+	"gorm.io/gorm"
+)
+
+// mockPowerProxmox records power actions on VM 101 and reports their tasks as running until completeTasks is set
+type mockPowerProxmox struct {
+	mu            sync.Mutex
+	actions       []string
+	completeTasks bool
+}
+
+func (m *mockPowerProxmox) serve(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api2/json/nodes/pve1/qemu/101/status/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Authorization") != mockProxmoxAuth {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		action := strings.TrimPrefix(r.URL.Path, "/api2/json/nodes/pve1/qemu/101/status/")
+		m.mu.Lock()
+		m.actions = append(m.actions, action)
+		m.mu.Unlock()
+		_, _ = w.Write([]byte(`{"data":"UPID:pve1:0000A1B2:00C0FFEE:6700000:qm` + action + `:101:root@pam:"}`))
+	})
+	mux.HandleFunc("/api2/json/nodes/pve1/tasks/", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		complete := m.completeTasks
+		m.mu.Unlock()
+		if complete {
+			_, _ = w.Write([]byte(`{"data":{"status":"stopped","exitstatus":"OK"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"status":"running"}}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func setupPowerTest(t *testing.T) (*Service, *mockPowerProxmox, *gorm.DB) {
+	t.Helper()
+	db := newVPSServiceTestDB(t)
+	seedVPSServiceIsolationData(t, db)
+	if err := db.Model(&database.VPSInstance{}).Where("id IN ?", []string{"vps-org-a-owner", "vps-org-a-peer"}).Updates(map[string]any{
+		"status":      int32(vpsv1.VPSStatus_RUNNING),
+		"instance_id": "101",
+		"node_id":     "pve1",
+	}).Error; err != nil {
+		t.Fatalf("mark VPS running: %v", err)
+	}
+
+	proxmox := &mockPowerProxmox{}
+	server := proxmox.serve(t)
+	t.Setenv("PROXMOX_NODE_API_ENDPOINTS", "pve1:"+server.URL)
+	t.Setenv("PROXMOX_USERNAME", "root@pam")
+	t.Setenv("PROXMOX_TOKEN_ID", "console")
+	t.Setenv("PROXMOX_TOKEN_SECRET", "secret")
+
+	previousTimeouts := vpsPowerTaskTimeouts
+	vpsPowerTaskTimeouts = map[string]time.Duration{
+		vpsPowerStart.name:     200 * time.Millisecond,
+		vpsPowerStop.name:      200 * time.Millisecond,
+		vpsPowerReboot.name:    200 * time.Millisecond,
+		vpsPowerForceStop.name: 200 * time.Millisecond,
+	}
+	t.Cleanup(func() { vpsPowerTaskTimeouts = previousTimeouts })
+
+	service := &Service{
+		permissionChecker: auth.NewPermissionChecker(),
+		backgroundCtx:     context.Background(),
+	}
+	return service, proxmox, db
+}
+
+func TestPowerActionsReturnDeadlineExceededWhenTaskNeverCompletes(t *testing.T) {
+	service, proxmox, db := setupPowerTest(t)
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	calls := []struct {
+		action        string
+		proxmoxAction string
+		call          func() error
+	}{
+		{"stop", "shutdown", func() error {
+			_, err := service.StopVPS(ctx, connect.NewRequest(&vpsv1.StopVPSRequest{OrganizationId: "org-a", VpsId: "vps-org-a-owner"}))
+			return err
+		}},
+		{"force_stop", "stop", func() error {
+			_, err := service.ForceStopVPS(ctx, connect.NewRequest(&vpsv1.ForceStopVPSRequest{OrganizationId: "org-a", VpsId: "vps-org-a-owner"}))
+			return err
+		}},
+		{"reboot", "reboot", func() error {
+			_, err := service.RebootVPS(ctx, connect.NewRequest(&vpsv1.RebootVPSRequest{OrganizationId: "org-a", VpsId: "vps-org-a-owner"}))
+			return err
+		}},
+	}
+	for _, c := range calls {
+		started := time.Now()
+		if err := c.call(); connect.CodeOf(err) != connect.CodeDeadlineExceeded {
+			t.Fatalf("%s code = %v, want %v: %v", c.action, connect.CodeOf(err), connect.CodeDeadlineExceeded, err)
+		}
+		if elapsed := time.Since(started); elapsed > 5*time.Second {
+			t.Fatalf("%s took %v, want it to give up at the task timeout", c.action, elapsed)
+		}
+
+		var event database.VPSPowerEvent
+		if err := db.Where("vps_id = ? AND action = ?", "vps-org-a-owner", c.action).First(&event).Error; err != nil {
+			t.Fatalf("load %s power event: %v", c.action, err)
+		}
+		if event.Result != "timeout" || event.CompletedAt != nil || event.ActorID != "user-org-a" || !strings.Contains(event.TaskID, "qm"+c.proxmoxAction) {
+			t.Fatalf("%s event = result %q completed %v actor %q task %q, want an uncompleted timeout by user-org-a", c.action, event.Result, event.CompletedAt, event.ActorID, event.TaskID)
+		}
+	}
+
+	if want := []string{"shutdown", "stop", "reboot"}; strings.Join(proxmox.actions, ",") != strings.Join(want, ",") {
+		t.Fatalf("Proxmox actions = %v, want %v", proxmox.actions, want)
+	}
+
+	var vps database.VPSInstance
+	db.First(&vps, "id = ?", "vps-org-a-owner")
+	if vps.Status != int32(vpsv1.VPSStatus_RUNNING) {
+		t.Fatalf("status after timeouts = %v, want RUNNING unchanged", vpsv1.VPSStatus(vps.Status))
+	}
+}
+
+func TestForceStopVPSNotifiesOwner(t *testing.T) {
+	service, proxmox, db := setupPowerTest(t)
+	proxmox.completeTasks = true
+
+	var notified []string
+	previousNotify := createUserNotification
+	createUserNotification = func(ctx context.Context, userID string, orgID *string, notificationType notificationsv1.NotificationType, severity notificationsv1.NotificationSeverity, title, message string, actionURL, actionLabel *string, metadata map[string]string) error {
+		notified = append(notified, userID)
+		if metadata["stopped_by"] != "user-org-a" {
+			t.Errorf("notification metadata stopped_by = %q, want user-org-a", metadata["stopped_by"])
+		}
+		return nil
+	}
+	t.Cleanup(func() { createUserNotification = previousNotify })
+
+	// The organization owner force stops a VPS created by another member
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+	resp, err := service.ForceStopVPS(ctx, connect.NewRequest(&vpsv1.ForceStopVPSRequest{OrganizationId: "org-a", VpsId: "vps-org-a-peer"}))
+	if err != nil {
+		t.Fatalf("ForceStopVPS: %v", err)
+	}
+	if resp.Msg.GetVps().GetStatus() != vpsv1.VPSStatus_STOPPED {
+		t.Fatalf("status = %v, want STOPPED", resp.Msg.GetVps().GetStatus())
+	}
+	if len(notified) != 1 || notified[0] != "user-org-a-peer" {
+		t.Fatalf("notified %v, want the VPS owner user-org-a-peer", notified)
+	}
+
+	var event database.VPSPowerEvent
+	if err := db.Where("vps_id = ?", "vps-org-a-peer").First(&event).Error; err != nil {
+		t.Fatalf("load power event: %v", err)
+	}
+	if event.Action != "force_stop" || event.Result != "ok" || event.CompletedAt == nil {
+		t.Fatalf("event = action %q result %q completed %v, want a completed force_stop", event.Action, event.Result, event.CompletedAt)
+	}
+
+	orgBUser := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-b", Email: "user-org-b@example.com"})
+	if _, err := service.ForceStopVPS(orgBUser, connect.NewRequest(&vpsv1.ForceStopVPSRequest{OrganizationId: "org-a", VpsId: "vps-org-a-owner"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("cross-organization force stop code = %v, want %v: %v", connect.CodeOf(err), connect.CodePermissionDenied, err)
+	}
+}
@@ -30,6 +30,7 @@ type Service struct {
 	quotaChecker      *quota.Checker
 	vpsManager        *orchestrator.VPSManager
 	sshPool           *SSHConnectionPool
+	consoleSessions   consoleSessionCache // nil when Redis is unavailable
 	backgroundCtx     context.Context
 }
 
@@ -41,6 +42,9 @@ func NewService(backgroundCtx context.Context, vpsManager *orchestrator.VPSManag
 		sshPool:           NewSSHConnectionPool(nil),
 		backgroundCtx:     backgroundCtx,
 	}
+	if database.RedisClient != nil {
+		svc.consoleSessions = database.RedisClient
+	}
 	if backgroundCtx != nil {
 		go func() {
 			<-backgroundCtx.Done()
@@ -76,70 +76,11 @@ func (s *Service) HandleVPSTerminalWebSocket(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// Validate origin using CORS configuration
-	origin := r.Header.Get("Origin")
-	if !middleware.IsOriginAllowed(origin) {
-		log.Printf("[VPS Terminal WS] Origin %s not allowed", origin)
-		http.Error(w, "Origin not allowed", http.StatusForbidden)
+	acceptOptions, ok := websocketAcceptOptions(w, r, "[VPS Terminal WS]")
+	if !ok {
 		return
 	}
 
-	// When requests come through the API gateway, the Host header is set to the internal service name
-	// (e.g., "vps-service:3008"), but the Origin header is from the original client (e.g., "localhost:3000").
-	// The websocket library validates Origin against Host, so we need to fix the Host header to match
-	// the Origin's hostname when behind a gateway/proxy.
-	// Extract hostname from Origin if available, otherwise keep original Host
-	originalHost := r.Host
-	if origin != "" {
-		originURL, err := url.Parse(origin)
-		if err == nil && originURL.Host != "" {
-			// Use the hostname from Origin for Host header validation
-			// This allows the websocket library to properly validate when behind a gateway
-			r.Host = originURL.Host
-			log.Printf("[VPS Terminal WS] Adjusted Host header: %s -> %s (from Origin)", originalHost, r.Host)
-		}
-	}
-
-	// Prepare origin patterns for WebSocket library
-	acceptOptions := &websocket.AcceptOptions{
-		CompressionMode: websocket.CompressionDisabled, // Disable compression for better performance with binary data
-	}
-	corsConfig := middleware.DefaultCORSConfig()
-	isWildcard := len(corsConfig.AllowedOrigins) == 1 && corsConfig.AllowedOrigins[0] == "*"
-
-	log.Printf("[VPS Terminal WS] CORS config: wildcard=%v, allowedOrigins=%v, origin=%s, host=%s",
-		isWildcard, corsConfig.AllowedOrigins, origin, r.Host)
-
-	if isWildcard {
-		// Wildcard CORS configured - disable origin checking in WebSocket library
-		// Setting to nil completely disables origin validation (allows all origins)
-		// This is necessary when requests come through a gateway/proxy where Host != Origin
-		acceptOptions.OriginPatterns = nil
-		log.Printf("[VPS Terminal WS] Using wildcard origin pattern (nil = allow all)")
-	} else {
-		// Use all allowed origins from CORS config for WebSocket validation
-		// This ensures the websocket library can properly validate against all configured origins
-		// even when the Host header doesn't match (e.g., when behind a gateway)
-		acceptOptions.OriginPatterns = make([]string, len(corsConfig.AllowedOrigins))
-		copy(acceptOptions.OriginPatterns, corsConfig.AllowedOrigins)
-
-		// Also add the current origin if it's not already in the list
-		if origin != "" {
-			originInList := false
-			for _, allowed := range corsConfig.AllowedOrigins {
-				if allowed == origin {
-					originInList = true
-					break
-				}
-			}
-			if !originInList {
-				acceptOptions.OriginPatterns = append(acceptOptions.OriginPatterns, origin)
-				log.Printf("[VPS Terminal WS] Added origin %s to OriginPatterns (not in allowed list)", origin)
-			}
-		}
-		log.Printf("[VPS Terminal WS] Using OriginPatterns: %v", acceptOptions.OriginPatterns)
-	}
-
 	conn, err := websocket.Accept(w, r, acceptOptions)
 	if err != nil {
 		log.Printf("[VPS Terminal WS] Failed to accept websocket connection: %v", err)
@@ -604,6 +545,77 @@ func (s *Service) HandleVPSTerminalWebSocket(w http.ResponseWriter, r *http.Requ
 	conn.Close(websocket.StatusInternalError, "Terminal connection failed")
 }
 
+// websocketAcceptOptions validates the request origin against the CORS configuration and returns
+// accept options that still work behind the API gateway, where Host is the internal service name.
+// It writes the error response itself when the origin is rejected.
+func websocketAcceptOptions(w http.ResponseWriter, r *http.Request, logPrefix string) (*websocket.AcceptOptions, bool) {
+	// Validate origin using CORS configuration
+	origin := r.Header.Get("Origin")
+	if !middleware.IsOriginAllowed(origin) {
+		log.Printf(logPrefix+" Origin %s not allowed", origin)
+		http.Error(w, "Origin not allowed", http.StatusForbidden)
+		return nil, false
+	}
+
+	// When requests come through the API gateway, the Host header is set to the internal service name
+	// (e.g., "vps-service:3008"), but the Origin header is from the original client (e.g., "localhost:3000").
+	// The websocket library validates Origin against Host, so we need to fix the Host header to match
+	// the Origin's hostname when behind a gateway/proxy.
+	// Extract hostname from Origin if available, otherwise keep original Host
+	originalHost := r.Host
+	if origin != "" {
+		originURL, err := url.Parse(origin)
+		if err == nil && originURL.Host != "" {
+			// Use the hostname from Origin for Host header validation
+			// This allows the websocket library to properly validate when behind a gateway
+			r.Host = originURL.Host
+			log.Printf(logPrefix+" Adjusted Host header: %s -> %s (from Origin)", originalHost, r.Host)
+		}
+	}
+
+	// Prepare origin patterns for WebSocket library
+	acceptOptions := &websocket.AcceptOptions{
+		CompressionMode: websocket.CompressionDisabled, // Disable compression for better performance with binary data
+	}
+	corsConfig := middleware.DefaultCORSConfig()
+	isWildcard := len(corsConfig.AllowedOrigins) == 1 && corsConfig.AllowedOrigins[0] == "*"
+
+	log.Printf(logPrefix+" CORS config: wildcard=%v, allowedOrigins=%v, origin=%s, host=%s",
+		isWildcard, corsConfig.AllowedOrigins, origin, r.Host)
+
+	if isWildcard {
+		// Wildcard CORS configured - disable origin checking in WebSocket library
+		// Setting to nil completely disables origin validation (allows all origins)
+		// This is necessary when requests come through a gateway/proxy where Host != Origin
+		acceptOptions.OriginPatterns = nil
+		log.Print(logPrefix + " Using wildcard origin pattern (nil = allow all)")
+	} else {
+		// Use all allowed origins from CORS config for WebSocket validation
+		// This ensures the websocket library can properly validate against all configured origins
+		// even when the Host header doesn't match (e.g., when behind a gateway)
+		acceptOptions.OriginPatterns = make([]string, len(corsConfig.AllowedOrigins))
+		copy(acceptOptions.OriginPatterns, corsConfig.AllowedOrigins)
+
+		// Also add the current origin if it's not already in the list
+		if origin != "" {
+			originInList := false
+			for _, allowed := range corsConfig.AllowedOrigins {
+				if allowed == origin {
+					originInList = true
+					break
+				}
+			}
+			if !originInList {
+				acceptOptions.OriginPatterns = append(acceptOptions.OriginPatterns, origin)
+				log.Printf(logPrefix+" Added origin %s to OriginPatterns (not in allowed list)", origin)
+			}
+		}
+		log.Printf(logPrefix+" Using OriginPatterns: %v", acceptOptions.OriginPatterns)
+	}
+
+	return acceptOptions, true
+}
+
 // handleProxmoxTermProxy handles terminal access via Proxmox termproxy WebSocket
 // termproxy is designed for text terminals and is compatible with xterm.js
 // It requires sending an authentication message after WebSocket connection: "username:ticket\n"
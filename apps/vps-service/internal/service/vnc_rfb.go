@@ -0,0 +1,148 @@
+package vps
+
+import (
+	"crypto/des"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+// RFB (VNC protocol) handshake helpers for the VNC session proxy. The proxy authenticates to
+// Proxmox with the session's VNC ticket and offers the browser a connection without a password,
+// since the browser already proved access with the session token.
+
+const (
+	rfbVersion38 = "RFB 003.008\n"
+
+	rfbSecurityInvalid = 0
+	rfbSecurityNone    = 1
+	rfbSecurityVNCAuth = 2
+)
+
+// authenticateRFBServer performs the client side of an RFB 3.8 handshake with a VNC server,
+// answering VNC authentication with password. The server's ServerInit is left unread.
+func authenticateRFBServer(conn io.ReadWriter, password string) error {
+	version := make([]byte, len(rfbVersion38))
+	if _, err := io.ReadFull(conn, version); err != nil {
+		return fmt.Errorf("failed to read server version: %w", err)
+	}
+	if string(version[:4]) != "RFB " {
+		return fmt.Errorf("unexpected server greeting %q", version)
+	}
+	if _, err := io.WriteString(conn, rfbVersion38); err != nil {
+		return fmt.Errorf("failed to send client version: %w", err)
+	}
+
+	var count [1]byte
+	if _, err := io.ReadFull(conn, count[:]); err != nil {
+		return fmt.Errorf("failed to read security types: %w", err)
+	}
+	if count[0] == 0 {
+		return fmt.Errorf("server refused the connection: %s", readRFBReason(conn))
+	}
+	types := make([]byte, count[0])
+	if _, err := io.ReadFull(conn, types); err != nil {
+		return fmt.Errorf("failed to read security types: %w", err)
+	}
+
+	var securityType byte = rfbSecurityInvalid
+	for _, t := range types {
+		if t == rfbSecurityVNCAuth || (t == rfbSecurityNone && securityType == rfbSecurityInvalid) {
+			securityType = t
+		}
+	}
+	if securityType == rfbSecurityInvalid {
+		return fmt.Errorf("server offers no supported security type (offered: %v)", types)
+	}
+	if _, err := conn.Write([]byte{securityType}); err != nil {
+		return fmt.Errorf("failed to select security type: %w", err)
+	}
+
+	if securityType == rfbSecurityVNCAuth {
+		challenge := make([]byte, 16)
+		if _, err := io.ReadFull(conn, challenge); err != nil {
+			return fmt.Errorf("failed to read VNC authentication challenge: %w", err)
+		}
+		if _, err := conn.Write(vncAuthResponse(challenge, password)); err != nil {
+			return fmt.Errorf("failed to send VNC authentication response: %w", err)
+		}
+	}
+
+	var result [4]byte
+	if _, err := io.ReadFull(conn, result[:]); err != nil {
+		return fmt.Errorf("failed to read security result: %w", err)
+	}
+	if binary.BigEndian.Uint32(result[:]) != 0 {
+		return fmt.Errorf("VNC authentication failed: %s", readRFBReason(conn))
+	}
+	return nil
+}
+
+// acceptRFBClient performs the server side of an RFB handshake with a VNC client, offering only
+// the None security type. The client's ClientInit is left unread.
+func acceptRFBClient(conn io.ReadWriter) error {
+	if _, err := io.WriteString(conn, rfbVersion38); err != nil {
+		return fmt.Errorf("failed to send server version: %w", err)
+	}
+	version := make([]byte, len(rfbVersion38))
+	if _, err := io.ReadFull(conn, version); err != nil {
+		return fmt.Errorf("failed to read client version: %w", err)
+	}
+
+	var minor int
+	if _, err := fmt.Sscanf(string(version), "RFB 003.%03d\n", &minor); err != nil {
+		return fmt.Errorf("unexpected client version %q", version)
+	}
+	if minor < 7 {
+		// RFB 3.3: the server picks the security type and sends no security result for None
+		_, err := conn.Write(binary.BigEndian.AppendUint32(nil, rfbSecurityNone))
+		return err
+	}
+
+	if _, err := conn.Write([]byte{1, rfbSecurityNone}); err != nil {
+		return fmt.Errorf("failed to send security types: %w", err)
+	}
+	var selected [1]byte
+	if _, err := io.ReadFull(conn, selected[:]); err != nil {
+		return fmt.Errorf("failed to read selected security type: %w", err)
+	}
+	if selected[0] != rfbSecurityNone {
+		return fmt.Errorf("client selected unsupported security type %d", selected[0])
+	}
+	if minor < 8 {
+		return nil
+	}
+	_, err := conn.Write(binary.BigEndian.AppendUint32(nil, 0))
+	return err
+}
+
+// vncAuthResponse encrypts a VNC authentication challenge with the password. VNC uses DES with the
+// first 8 bytes of the password as key, with the bits of every key byte reversed.
+func vncAuthResponse(challenge []byte, password string) []byte {
+	key := make([]byte, 8)
+	copy(key, password)
+	for i, b := range key {
+		key[i] = bits.Reverse8(b)
+	}
+	cipher, _ := des.NewCipher(key) // Only fails for keys that aren't 8 bytes long
+
+	response := make([]byte, len(challenge))
+	for i := 0; i+des.BlockSize <= len(challenge); i += des.BlockSize {
+		cipher.Encrypt(response[i:i+des.BlockSize], challenge[i:i+des.BlockSize])
+	}
+	return response
+}
+
+// readRFBReason reads the reason string a server sends with a failure
+func readRFBReason(r io.Reader) string {
+	var length [4]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return "no reason given"
+	}
+	reason := make([]byte, min(binary.BigEndian.Uint32(length[:]), 1024))
+	if _, err := io.ReadFull(r, reason); err != nil {
+		return "no reason given"
+	}
+	return string(reason)
+}
@@ -0,0 +1,450 @@
+package vps
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"nhooyr.io/websocket"
+)
+
+const (
+	vncSessionKeyPrefix = "vps:vnc:"
+	// maxVNCSessionsPerOrg limits how many VPSes of an organization can have a VNC session at once
+	maxVNCSessionsPerOrg = 3
+	vncHandshakeTimeout  = 10 * time.Second
+)
+
+var (
+	// vncTicketRenewInterval is how often a session's Proxmox VNC ticket is replaced; a ticket only
+	// stays valid for about 30 seconds
+	vncTicketRenewInterval = 20 * time.Second
+	// vncIdleTimeout is how long a session lasts without an open connection, e.g. once the browser
+	// tab was closed
+	vncIdleTimeout = 10 * time.Minute
+)
+
+var errVNCSessionInvalid = errors.New("VNC session is invalid or has expired")
+
+// ProxmoxVNCSession is a long-lived VNC console session for a VPS, stored in Redis under
+// vps:vnc:{vps_id}. The service that created it keeps a fresh Proxmox VNC ticket in it, so the
+// /vps/{vps_id}/vnc websocket can connect to Proxmox at any time and the browser needs no VNC
+// password. A session is active while vps:vnc:{vps_id}:active exists, which is refreshed while a
+// connection is open and expires vncIdleTimeout after the last one closed.
+type ProxmoxVNCSession struct {
+	VPSID          string `json:"vps_id"`
+	Token          string `json:"token"`
+	OrganizationID string `json:"organization_id"`
+	UserID         string `json:"user_id"`
+	// JWTHash is the SHA-256 of the JWT the session was requested with
+	JWTHash string `json:"jwt_hash"`
+	// ExpiresAt is when that JWT expires, zero if it isn't known
+	ExpiresAt time.Time `json:"expires_at"`
+
+	NodeName     string    `json:"node_name"`
+	VMID         int       `json:"vm_id"`
+	ProxmoxWSURL string    `json:"proxmox_ws_url"`
+	Ticket       string    `json:"ticket"`
+	RenewedAt    time.Time `json:"renewed_at"`
+}
+
+func vncSessionKey(vpsID string) string {
+	return vncSessionKeyPrefix + vpsID
+}
+
+func vncSessionActiveKey(vpsID string) string {
+	return vncSessionKeyPrefix + vpsID + ":active"
+}
+
+// expired reports whether the JWT the session was requested with has expired
+func (v *ProxmoxVNCSession) expired(now time.Time) bool {
+	return !v.ExpiresAt.IsZero() && !now.Before(v.ExpiresAt)
+}
+
+// GetVPSVNCSession returns a noVNC websocket URL backed by a ProxmoxVNCSession. The session belongs
+// to the JWT of the request: asking again with the same JWT returns the same session, while a new
+// JWT replaces it. An organization can have sessions for at most maxVNCSessionsPerOrg VPSes.
+func (s *Service) GetVPSVNCSession(ctx context.Context, req *connect.Request[vpsv1.GetVPSVNCSessionRequest]) (*connect.Response[vpsv1.GetVPSVNCSessionResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	vpsID := req.Msg.GetVpsId()
+	nodeName, vmID, err := s.consoleTarget(ctx, orgID, vpsID)
+	if err != nil {
+		return nil, err
+	}
+
+	jwt := strings.TrimPrefix(req.Header().Get(auth.AuthorizationHeader), auth.BearerPrefix)
+	jwtHash := sha256.Sum256([]byte(jwt))
+
+	existing := s.loadVNCSession(ctx, vpsID)
+	if existing != nil && existing.UserID == user.Id && existing.JWTHash == hex.EncodeToString(jwtHash[:]) &&
+		!existing.expired(time.Now()) && s.activeVNCToken(ctx, vpsID) == existing.Token {
+		return connect.NewResponse(vncSessionResponse(existing)), nil
+	}
+
+	count, err := s.countVNCSessions(ctx, orgID, vpsID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if count >= maxVNCSessionsPerOrg {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("organization already has %d VNC sessions open; close one and try again", count))
+	}
+
+	token, err := generateConsoleToken()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate VNC session token: %w", err))
+	}
+	session := &ProxmoxVNCSession{
+		VPSID:          vpsID,
+		Token:          token,
+		OrganizationID: orgID,
+		UserID:         user.Id,
+		JWTHash:        hex.EncodeToString(jwtHash[:]),
+		ExpiresAt:      jwtExpiry(jwt),
+		NodeName:       nodeName,
+		VMID:           vmID,
+	}
+	if err := s.renewVNCTicket(ctx, session); err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to open VNC console: %w", err))
+	}
+	// The user has the idle timeout to connect; replacing the marker retires any previous session
+	if err := s.consoleSessions.Set(ctx, vncSessionActiveKey(vpsID), token, vncIdleTimeout); err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to store VNC session: %w", err))
+	}
+
+	go s.runVNCSessionRenewal(vpsID, token)
+
+	return connect.NewResponse(vncSessionResponse(session)), nil
+}
+
+func vncSessionResponse(session *ProxmoxVNCSession) *vpsv1.GetVPSVNCSessionResponse {
+	resp := &vpsv1.GetVPSVNCSessionResponse{
+		Url:                vpsWebSocketURL(resolveAPIBaseURL(), session.VPSID, "vnc", session.Token),
+		IdleTimeoutSeconds: int32(vncIdleTimeout / time.Second),
+	}
+	if !session.ExpiresAt.IsZero() {
+		resp.ExpiresAt = timestamppb.New(session.ExpiresAt)
+	}
+	return resp
+}
+
+// countVNCSessions counts the active VNC sessions of an organization, leaving out the VPS with ID
+// exceptVPSID since a new session for it replaces its current one
+func (s *Service) countVNCSessions(ctx context.Context, orgID, exceptVPSID string) (int, error) {
+	var vpsIDs []string
+	if err := database.DB.Model(&database.VPSInstance{}).
+		Where("organization_id = ? AND id <> ? AND deleted_at IS NULL", orgID, exceptVPSID).
+		Pluck("id", &vpsIDs).Error; err != nil {
+		return 0, fmt.Errorf("failed to list VPS instances: %w", err)
+	}
+
+	count := 0
+	for _, vpsID := range vpsIDs {
+		if s.activeVNCToken(ctx, vpsID) != "" {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// loadVNCSession returns the VNC session of a VPS, or nil if it has none
+func (s *Service) loadVNCSession(ctx context.Context, vpsID string) *ProxmoxVNCSession {
+	data, err := s.consoleSessions.Get(ctx, vncSessionKey(vpsID))
+	if err != nil || data == "" {
+		return nil
+	}
+	var session ProxmoxVNCSession
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		log.Printf("[VPS VNC] Failed to decode VNC session for VPS %s: %v", vpsID, err)
+		return nil
+	}
+	return &session
+}
+
+// activeVNCToken returns the token of the VPS's active VNC session, or "" once it has been idle for
+// vncIdleTimeout
+func (s *Service) activeVNCToken(ctx context.Context, vpsID string) string {
+	data, err := s.consoleSessions.Get(ctx, vncSessionActiveKey(vpsID))
+	if err != nil || data == "" {
+		return ""
+	}
+	var token string
+	if err := json.Unmarshal([]byte(data), &token); err != nil {
+		return ""
+	}
+	return token
+}
+
+// activeVNCSession returns the VNC session of a VPS if token belongs to it and it hasn't expired
+func (s *Service) activeVNCSession(ctx context.Context, vpsID, token string) (*ProxmoxVNCSession, error) {
+	session := s.loadVNCSession(ctx, vpsID)
+	if session == nil || subtle.ConstantTimeCompare([]byte(session.Token), []byte(token)) != 1 {
+		return nil, errVNCSessionInvalid
+	}
+	if s.activeVNCToken(ctx, vpsID) != token || session.expired(time.Now()) {
+		s.endVNCSession(ctx, vpsID, token)
+		return nil, errVNCSessionInvalid
+	}
+	return session, nil
+}
+
+// endVNCSession removes the VNC session of a VPS and its active marker if they belong to token
+func (s *Service) endVNCSession(ctx context.Context, vpsID, token string) {
+	if session := s.loadVNCSession(ctx, vpsID); session != nil && session.Token == token {
+		_ = s.consoleSessions.Delete(ctx, vncSessionKey(vpsID))
+	}
+	if s.activeVNCToken(ctx, vpsID) == token {
+		_ = s.consoleSessions.Delete(ctx, vncSessionActiveKey(vpsID))
+	}
+}
+
+// renewVNCTicket opens a new VNC proxy on the session's Proxmox node and stores the session with
+// its ticket
+func (s *Service) renewVNCTicket(ctx context.Context, session *ProxmoxVNCSession) error {
+	proxmoxClient, closeManager, err := proxmoxClientForNode(session.NodeName)
+	if err != nil {
+		return err
+	}
+	defer closeManager()
+
+	vncProxy, err := proxmoxClient.CreateVNCProxy(ctx, session.NodeName, session.VMID)
+	if err != nil {
+		return err
+	}
+	session.ProxmoxWSURL = vncProxy.WebSocketURL
+	session.Ticket = vncProxy.Ticket
+	session.RenewedAt = time.Now()
+
+	// Without renewals the ticket is useless anyway, so the session expires with the active marker
+	if err := s.consoleSessions.Set(ctx, vncSessionKey(session.VPSID), session, vncIdleTimeout+vncTicketRenewInterval); err != nil {
+		return fmt.Errorf("failed to store VNC session: %w", err)
+	}
+	return nil
+}
+
+// renewVNCSession replaces the Proxmox VNC ticket of the VPS's session with token, and ends the
+// session once it is idle or its JWT has expired
+func (s *Service) renewVNCSession(ctx context.Context, vpsID, token string) error {
+	session, err := s.activeVNCSession(ctx, vpsID, token)
+	if err != nil {
+		return err
+	}
+	return s.renewVNCTicket(ctx, session)
+}
+
+// runVNCSessionRenewal renews the ticket of the VPS's session with token every vncTicketRenewInterval
+// until the session ends or is replaced
+func (s *Service) runVNCSessionRenewal(vpsID, token string) {
+	ctx, cancel := s.detachedContext(0)
+	defer cancel()
+
+	ticker := time.NewTicker(vncTicketRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		renewCtx, renewCancel := context.WithTimeout(ctx, 15*time.Second)
+		err := s.renewVNCSession(renewCtx, vpsID, token)
+		renewCancel()
+		if errors.Is(err, errVNCSessionInvalid) {
+			log.Printf("[VPS VNC] VNC session for VPS %s ended", vpsID)
+			return
+		}
+		if err != nil {
+			log.Printf("[VPS VNC] Failed to renew VNC ticket for VPS %s (will retry): %v", vpsID, err)
+		}
+	}
+}
+
+// HandleVPSVNCWebSocket proxies a noVNC client to the VM's Proxmox VNC websocket, authenticating to
+// Proxmox with the session's current ticket. The client connects without a VNC password.
+// Route pattern: /vps/{vps_id}/vnc?token=... where the token comes from GetVPSVNCSession.
+func (s *Service) HandleVPSVNCWebSocket(w http.ResponseWriter, r *http.Request) {
+	vpsID := vpsIDFromWebSocketPath(r.URL.Path, "vnc")
+	token := r.URL.Query().Get("token")
+	if vpsID == "" || token == "" {
+		http.Error(w, "VPS ID and session token are required", http.StatusBadRequest)
+		return
+	}
+	if s.consoleSessions == nil {
+		http.Error(w, errVNCSessionInvalid.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	session, err := s.activeVNCSession(r.Context(), vpsID, token)
+	if err != nil {
+		log.Printf("[VPS VNC] Rejected VNC connection for VPS %s: %v", vpsID, err)
+		http.Error(w, errVNCSessionInvalid.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	acceptOptions, ok := websocketAcceptOptions(w, r, "[VPS VNC]")
+	if !ok {
+		return
+	}
+	// noVNC negotiates the "binary" subprotocol
+	acceptOptions.Subprotocols = []string{"binary"}
+
+	proxyCtx, cancel := s.detachedContext(0)
+	defer cancel()
+
+	// Connect to Proxmox before accepting so a failure is reported as a plain HTTP error
+	proxmoxStream, err := s.dialVNCSession(proxyCtx, session)
+	if err != nil {
+		log.Printf("[VPS VNC] Failed to connect to Proxmox VNC websocket for VPS %s: %v", vpsID, err)
+		http.Error(w, "Failed to connect to VPS console", http.StatusBadGateway)
+		return
+	}
+	defer proxmoxStream.Close()
+
+	clientConn, err := websocket.Accept(w, r, acceptOptions)
+	if err != nil {
+		log.Printf("[VPS VNC] Failed to accept websocket connection: %v", err)
+		return
+	}
+	clientStream := websocket.NetConn(proxyCtx, clientConn, websocket.MessageBinary)
+	defer clientStream.Close()
+
+	_ = clientStream.SetDeadline(time.Now().Add(vncHandshakeTimeout))
+	if err := acceptRFBClient(clientStream); err != nil {
+		log.Printf("[VPS VNC] VNC handshake with the client for VPS %s failed: %v", vpsID, err)
+		return
+	}
+	_ = clientStream.SetDeadline(time.Time{})
+
+	log.Printf("[VPS VNC] VNC session connected for VPS %s on node %s", vpsID, session.NodeName)
+
+	// Keep the session active while connected; the idle timeout starts once the connection closes
+	go s.keepVNCSessionActive(proxyCtx, vpsID, token)
+	defer func() {
+		touchCtx, touchCancel := s.detachedContext(5 * time.Second)
+		defer touchCancel()
+		s.touchVNCSession(touchCtx, vpsID, token)
+	}()
+
+	errChan := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(proxmoxStream, clientStream)
+		errChan <- err
+	}()
+	go func() {
+		_, err := io.Copy(clientStream, proxmoxStream)
+		errChan <- err
+	}()
+
+	err = <-errChan
+	cancel()
+	if err != nil && !errors.Is(err, context.Canceled) {
+		log.Printf("[VPS VNC] VNC connection for VPS %s closed: %v", vpsID, err)
+	}
+}
+
+// dialVNCSession connects to the Proxmox VNC websocket of a session and authenticates with its
+// ticket, returning a stream that stays open until ctx is done. A Proxmox VNC proxy accepts a
+// single connection, so if the current one is used up or has timed out the ticket is renewed once.
+func (s *Service) dialVNCSession(ctx context.Context, session *ProxmoxVNCSession) (net.Conn, error) {
+	stream, err := dialVNCSessionOnce(ctx, session)
+	if err == nil {
+		return stream, nil
+	}
+	log.Printf("[VPS VNC] Connecting with the current VNC ticket for VPS %s failed, renewing it: %v", session.VPSID, err)
+
+	renewCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+	if err := s.renewVNCTicket(renewCtx, session); err != nil {
+		return nil, fmt.Errorf("failed to renew VNC ticket: %w", err)
+	}
+	return dialVNCSessionOnce(ctx, session)
+}
+
+func dialVNCSessionOnce(ctx context.Context, session *ProxmoxVNCSession) (net.Conn, error) {
+	dialCtx, cancel := context.WithTimeout(ctx, vncHandshakeTimeout)
+	defer cancel()
+	conn, err := dialProxmoxConsole(dialCtx, session.NodeName, session.ProxmoxWSURL)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := websocket.NetConn(ctx, conn, websocket.MessageBinary)
+	_ = stream.SetDeadline(time.Now().Add(vncHandshakeTimeout))
+	if err := authenticateRFBServer(stream, session.Ticket); err != nil {
+		stream.Close()
+		return nil, err
+	}
+	_ = stream.SetDeadline(time.Time{})
+	return stream, nil
+}
+
+// keepVNCSessionActive refreshes the session's active marker until ctx is done
+func (s *Service) keepVNCSessionActive(ctx context.Context, vpsID, token string) {
+	ticker := time.NewTicker(vncIdleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		s.touchVNCSession(ctx, vpsID, token)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// touchVNCSession restarts the idle timeout of the VPS's session with token, unless it was replaced
+func (s *Service) touchVNCSession(ctx context.Context, vpsID, token string) {
+	if s.activeVNCToken(ctx, vpsID) != token {
+		return
+	}
+	if err := s.consoleSessions.Set(ctx, vncSessionActiveKey(vpsID), token, vncIdleTimeout); err != nil {
+		log.Printf("[VPS VNC] Failed to refresh VNC session for VPS %s: %v", vpsID, err)
+	}
+}
+
+// jwtExpiry returns the exp claim of a JWT, or the zero time if the token isn't a JWT with one.
+// The token was already validated by the auth interceptor.
+func jwtExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
@@ -0,0 +1,404 @@
+package vps
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+	"nhooyr.io/websocket"
+)
+
+// mockVNCProxmox issues a new ticket for every vncproxy call. Like Proxmox, only recent tickets
+// are accepted, each for a single connection, and the VNC server asks for the ticket as password.
+type mockVNCProxmox struct {
+	mu      sync.Mutex
+	tickets []string
+	used    map[string]bool
+}
+
+func (m *mockVNCProxmox) issued() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.tickets)
+}
+
+// useTicket reports whether ticket is one of the two newest tickets and wasn't used before
+func (m *mockVNCProxmox) useTicket(ticket string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	recent := m.tickets[max(0, len(m.tickets)-2):]
+	for _, t := range recent {
+		if t == ticket && !m.used[ticket] {
+			m.used[ticket] = true
+			return true
+		}
+	}
+	return false
+}
+
+func newMockVNCProxmox(t *testing.T) (*mockVNCProxmox, *httptest.Server) {
+	t.Helper()
+	proxmox := &mockVNCProxmox{used: map[string]bool{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api2/json/nodes/pve1/qemu/101/vncproxy", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Authorization") != mockProxmoxAuth {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		proxmox.mu.Lock()
+		ticket := fmt.Sprintf("PVEVNC:%08d::mock-ticket", len(proxmox.tickets)+1)
+		proxmox.tickets = append(proxmox.tickets, ticket)
+		proxmox.mu.Unlock()
+		_, _ = w.Write([]byte(`{"data":{"ticket":"` + ticket + `","port":"5900","upid":"UPID:pve1"}}`))
+	})
+	mux.HandleFunc("/api2/json/nodes/pve1/qemu/101/vncwebsocket", func(w http.ResponseWriter, r *http.Request) {
+		ticket := r.URL.Query().Get("vncticket")
+		if r.Header.Get("Authorization") != mockProxmoxAuth || !proxmox.useTicket(ticket) {
+			http.Error(w, "invalid ticket", http.StatusUnauthorized)
+			return
+		}
+		conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{Subprotocols: []string{"binary"}})
+		if err != nil {
+			return
+		}
+		stream := websocket.NetConn(r.Context(), conn, websocket.MessageBinary)
+		defer stream.Close()
+		serveMockVNC(stream, ticket)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return proxmox, server
+}
+
+// serveMockVNC performs an RFB 3.8 handshake with VNC authentication, answers ClientInit with
+// "desktop" and then echoes everything
+func serveMockVNC(stream net.Conn, password string) {
+	version := make([]byte, 12)
+	if _, err := io.WriteString(stream, rfbVersion38); err != nil {
+		return
+	}
+	if _, err := io.ReadFull(stream, version); err != nil || string(version) != rfbVersion38 {
+		return
+	}
+	selected := make([]byte, 1)
+	if _, err := stream.Write([]byte{1, rfbSecurityVNCAuth}); err != nil {
+		return
+	}
+	if _, err := io.ReadFull(stream, selected); err != nil || selected[0] != rfbSecurityVNCAuth {
+		return
+	}
+	challenge := make([]byte, 16)
+	_, _ = rand.Read(challenge)
+	response := make([]byte, 16)
+	if _, err := stream.Write(challenge); err != nil {
+		return
+	}
+	if _, err := io.ReadFull(stream, response); err != nil {
+		return
+	}
+	if !bytes.Equal(response, vncAuthResponse(challenge, password)) {
+		_, _ = stream.Write([]byte{0, 0, 0, 1, 0, 0, 0, 6, 'd', 'e', 'n', 'i', 'e', 'd'})
+		return
+	}
+	if _, err := stream.Write([]byte{0, 0, 0, 0}); err != nil {
+		return
+	}
+	if _, err := io.ReadFull(stream, selected); err != nil {
+		return
+	}
+	if _, err := io.WriteString(stream, "desktop"); err != nil {
+		return
+	}
+	_, _ = io.Copy(stream, stream)
+}
+
+// setupVNCTest seeds org-a/org-b data with running VPSes on the mock Proxmox node and serves the
+// VNC route the way main.go does
+func setupVNCTest(t *testing.T, renewInterval, idleTimeout time.Duration) (*Service, *memoryConsoleCache, *mockVNCProxmox) {
+	t.Helper()
+	db := newVPSServiceTestDB(t)
+	seedVPSServiceIsolationData(t, db)
+	now := time.Now().UTC()
+	for _, id := range []string{"vps-org-a-2", "vps-org-a-3"} {
+		if err := db.Create(testVPS(id, id, "org-a", "user-org-a", now)).Error; err != nil {
+			t.Fatalf("seed VPS: %v", err)
+		}
+	}
+	if err := db.Model(&database.VPSInstance{}).Where("1 = 1").Updates(map[string]any{
+		"status":      int32(vpsv1.VPSStatus_RUNNING),
+		"instance_id": "101",
+		"node_id":     "pve1",
+	}).Error; err != nil {
+		t.Fatalf("mark VPS running: %v", err)
+	}
+
+	proxmox, proxmoxServer := newMockVNCProxmox(t)
+	t.Setenv("PROXMOX_NODE_API_ENDPOINTS", "pve1:"+proxmoxServer.URL)
+	t.Setenv("PROXMOX_USERNAME", "root@pam")
+	t.Setenv("PROXMOX_TOKEN_ID", "console")
+	t.Setenv("PROXMOX_TOKEN_SECRET", "secret")
+	t.Setenv("CORS_ORIGIN", "*")
+
+	previousRenew, previousIdle := vncTicketRenewInterval, vncIdleTimeout
+	vncTicketRenewInterval, vncIdleTimeout = renewInterval, idleTimeout
+	t.Cleanup(func() { vncTicketRenewInterval, vncIdleTimeout = previousRenew, previousIdle })
+
+	cache := newMemoryConsoleCache()
+	backgroundCtx, cancel := context.WithCancel(context.Background())
+	service := &Service{
+		permissionChecker: auth.NewPermissionChecker(),
+		consoleSessions:   cache,
+		backgroundCtx:     backgroundCtx,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/vps/", service.HandleVPSVNCWebSocket)
+	api := httptest.NewServer(mux)
+	t.Cleanup(api.Close)
+	t.Setenv("API_BASE_URL", api.URL)
+	// Stops the ticket renewals before the environment is restored
+	t.Cleanup(cancel)
+
+	return service, cache, proxmox
+}
+
+func vncSessionRequest(orgID, vpsID, jwt string) *connect.Request[vpsv1.GetVPSVNCSessionRequest] {
+	req := connect.NewRequest(&vpsv1.GetVPSVNCSessionRequest{OrganizationId: orgID, VpsId: vpsID})
+	req.Header().Set("Authorization", "Bearer "+jwt)
+	return req
+}
+
+// testJWT returns an unsigned JWT expiring at exp
+func testJWT(exp time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"user-org-a","exp":%d}`, exp.Unix())))
+	return header + "." + payload + ".signature"
+}
+
+// connectVNC opens a VNC session URL and completes the RFB handshake, which must not ask for a password
+func connectVNC(t *testing.T, ctx context.Context, url string) net.Conn {
+	t.Helper()
+	conn, _, err := websocket.Dial(ctx, url, &websocket.DialOptions{Subprotocols: []string{"binary"}})
+	if err != nil {
+		t.Fatalf("dial VNC session: %v", err)
+	}
+	stream := websocket.NetConn(ctx, conn, websocket.MessageBinary)
+	t.Cleanup(func() { stream.Close() })
+
+	version := make([]byte, 12)
+	if _, err := io.ReadFull(stream, version); err != nil || string(version) != rfbVersion38 {
+		t.Fatalf("read server version = %q, %v", version, err)
+	}
+	if _, err := io.WriteString(stream, rfbVersion38); err != nil {
+		t.Fatalf("write client version: %v", err)
+	}
+	types := make([]byte, 2)
+	if _, err := io.ReadFull(stream, types); err != nil || !bytes.Equal(types, []byte{1, rfbSecurityNone}) {
+		t.Fatalf("security types = %v, %v; want only None", types, err)
+	}
+	if _, err := stream.Write([]byte{rfbSecurityNone}); err != nil {
+		t.Fatalf("select security type: %v", err)
+	}
+	result := make([]byte, 4)
+	if _, err := io.ReadFull(stream, result); err != nil || !bytes.Equal(result, []byte{0, 0, 0, 0}) {
+		t.Fatalf("security result = %v, %v", result, err)
+	}
+	if _, err := stream.Write([]byte{1}); err != nil {
+		t.Fatalf("write ClientInit: %v", err)
+	}
+	serverInit := make([]byte, len("desktop"))
+	if _, err := io.ReadFull(stream, serverInit); err != nil || string(serverInit) != "desktop" {
+		t.Fatalf("ServerInit = %q, %v", serverInit, err)
+	}
+	return stream
+}
+
+func TestVNCSessionRenewsProxmoxTicket(t *testing.T) {
+	service, cache, proxmox := setupVNCTest(t, 50*time.Millisecond, time.Minute)
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+	jwt := testJWT(time.Now().Add(time.Hour))
+
+	resp, err := service.GetVPSVNCSession(ctx, vncSessionRequest("org-a", "vps-org-a-owner", jwt))
+	if err != nil {
+		t.Fatalf("GetVPSVNCSession: %v", err)
+	}
+	url := resp.Msg.GetUrl()
+	if !strings.HasPrefix(url, "ws://") || !strings.Contains(url, "/vps/vps-org-a-owner/vnc?token=") {
+		t.Fatalf("VNC URL = %q, want ws://.../vps/vps-org-a-owner/vnc?token=...", url)
+	}
+	if resp.Msg.GetIdleTimeoutSeconds() != 60 {
+		t.Fatalf("idle timeout = %ds, want 60s", resp.Msg.GetIdleTimeoutSeconds())
+	}
+	if got, want := resp.Msg.GetExpiresAt().AsTime(), jwtExpiry(jwt); !got.Equal(want) {
+		t.Fatalf("expires at %v, want the JWT expiry %v", got, want)
+	}
+
+	// The ticket is replaced in the background while nobody is connected
+	deadline := time.Now().Add(5 * time.Second)
+	for proxmox.issued() < 4 {
+		if time.Now().After(deadline) {
+			t.Fatalf("only %d tickets issued, want the session to keep renewing its ticket", proxmox.issued())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if stored := service.loadVNCSession(context.Background(), "vps-org-a-owner"); stored == nil || stored.Ticket == "PVEVNC:00000001::mock-ticket" {
+		t.Fatalf("stored session = %+v, want a renewed ticket", stored)
+	}
+	cache.mu.Lock()
+	markerTTL := cache.expirations["vps:vnc:vps-org-a-owner:active"]
+	cache.mu.Unlock()
+	if markerTTL != time.Minute {
+		t.Fatalf("active marker TTL = %v, want the idle timeout", markerTTL)
+	}
+
+	// Asking again with the same JWT returns the same session
+	again, err := service.GetVPSVNCSession(ctx, vncSessionRequest("org-a", "vps-org-a-owner", jwt))
+	if err != nil || again.Msg.GetUrl() != url {
+		t.Fatalf("second GetVPSVNCSession = %q, %v; want %q", again.Msg.GetUrl(), err, url)
+	}
+
+	dialCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream := connectVNC(t, dialCtx, url)
+	if _, err := io.WriteString(stream, "ping"); err != nil {
+		t.Fatalf("write to console: %v", err)
+	}
+	echoed := make([]byte, 4)
+	if _, err := io.ReadFull(stream, echoed); err != nil || string(echoed) != "ping" {
+		t.Fatalf("read proxied reply = %q, %v", echoed, err)
+	}
+	stream.Close()
+
+	// The URL can be reconnected to, although the previous ticket was used up
+	connectVNC(t, dialCtx, url).Close()
+
+	// Another JWT replaces the session
+	replaced, err := service.GetVPSVNCSession(ctx, vncSessionRequest("org-a", "vps-org-a-owner", testJWT(time.Now().Add(2*time.Hour))))
+	if err != nil {
+		t.Fatalf("GetVPSVNCSession with another JWT: %v", err)
+	}
+	if replaced.Msg.GetUrl() == url {
+		t.Fatal("another JWT got the same session")
+	}
+	if _, resp, err := websocket.Dial(dialCtx, url, nil); err == nil || resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("dial replaced session: err=%v, want 401", err)
+	}
+}
+
+func TestVNCSessionExpiresWhenIdle(t *testing.T) {
+	service, cache, proxmox := setupVNCTest(t, 50*time.Millisecond, 300*time.Millisecond)
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	resp, err := service.GetVPSVNCSession(ctx, vncSessionRequest("org-a", "vps-org-a-owner", testJWT(time.Now().Add(time.Hour))))
+	if err != nil {
+		t.Fatalf("GetVPSVNCSession: %v", err)
+	}
+
+	// Nobody connects, as if the browser tab had been closed
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		cache.mu.Lock()
+		_, stored := cache.values["vps:vnc:vps-org-a-owner"]
+		cache.mu.Unlock()
+		if !stored {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("idle VNC session was not removed")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	// Renewal stops with the session
+	issued := proxmox.issued()
+	time.Sleep(200 * time.Millisecond)
+	if proxmox.issued() != issued {
+		t.Fatalf("tickets kept being issued after the session expired (%d -> %d)", issued, proxmox.issued())
+	}
+
+	dialCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, resp, err := websocket.Dial(dialCtx, resp.Msg.GetUrl(), nil); err == nil || resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("dial expired session: err=%v, want 401", err)
+	}
+
+	// The next request starts a new session
+	if _, err := service.GetVPSVNCSession(ctx, vncSessionRequest("org-a", "vps-org-a-owner", testJWT(time.Now().Add(time.Hour)))); err != nil {
+		t.Fatalf("GetVPSVNCSession after expiry: %v", err)
+	}
+}
+
+func TestVNCSessionEndsWithJWT(t *testing.T) {
+	service, _, _ := setupVNCTest(t, 50*time.Millisecond, time.Minute)
+	ctx := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+
+	resp, err := service.GetVPSVNCSession(ctx, vncSessionRequest("org-a", "vps-org-a-owner", testJWT(time.Now().Add(time.Second))))
+	if err != nil {
+		t.Fatalf("GetVPSVNCSession: %v", err)
+	}
+	time.Sleep(time.Until(resp.Msg.GetExpiresAt().AsTime()) + 100*time.Millisecond)
+
+	dialCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, resp, err := websocket.Dial(dialCtx, resp.Msg.GetUrl(), nil); err == nil || resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("dial session after its JWT expired: err=%v, want 401", err)
+	}
+}
+
+func TestGetVPSVNCSessionLimitsSessionsPerOrganization(t *testing.T) {
+	service, _, _ := setupVNCTest(t, time.Minute, time.Minute)
+	orgAUser := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-a", Email: "user-org-a@example.com"})
+	orgBUser := auth.WithUser(context.Background(), &authv1.User{Id: "user-org-b", Email: "user-org-b@example.com"})
+	jwt := testJWT(time.Now().Add(time.Hour))
+
+	for _, vpsID := range []string{"vps-org-a-owner", "vps-org-a-peer", "vps-org-a-2"} {
+		if _, err := service.GetVPSVNCSession(orgAUser, vncSessionRequest("org-a", vpsID, jwt)); err != nil {
+			t.Fatalf("GetVPSVNCSession(%s): %v", vpsID, err)
+		}
+	}
+
+	_, err := service.GetVPSVNCSession(orgAUser, vncSessionRequest("org-a", "vps-org-a-3", jwt))
+	if connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Fatalf("fourth session code = %v, want %v: %v", connect.CodeOf(err), connect.CodeResourceExhausted, err)
+	}
+
+	// Existing sessions can still be requested again and replaced
+	if _, err := service.GetVPSVNCSession(orgAUser, vncSessionRequest("org-a", "vps-org-a-2", jwt)); err != nil {
+		t.Fatalf("GetVPSVNCSession for a VPS with a session: %v", err)
+	}
+	if _, err := service.GetVPSVNCSession(orgAUser, vncSessionRequest("org-a", "vps-org-a-2", testJWT(time.Now().Add(2*time.Hour)))); err != nil {
+		t.Fatalf("replace session: %v", err)
+	}
+
+	// Other organizations have their own limit
+	if _, err := service.GetVPSVNCSession(orgBUser, vncSessionRequest("org-b", "vps-org-b-owner", jwt)); err != nil {
+		t.Fatalf("GetVPSVNCSession for org-b: %v", err)
+	}
+}
+
+func TestJWTExpiry(t *testing.T) {
+	exp := time.Unix(1893456000, 0)
+	if got := jwtExpiry(testJWT(exp)); !got.Equal(exp) {
+		t.Fatalf("jwtExpiry = %v, want %v", got, exp)
+	}
+	for _, token := range []string{"", "opaque-access-token", "a.!!!.c", "e30.e30.sig"} {
+		if got := jwtExpiry(token); !got.IsZero() {
+			t.Fatalf("jwtExpiry(%q) = %v, want zero", token, got)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package vps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// CreateVPSTemplate converts a VPS into a template that new VPS instances of the organization can be cloned from
+// The VM is shut down gracefully, converted into a Proxmox template and the VPS is marked as TERMINATED
+func (s *Service) CreateVPSTemplate(ctx context.Context, req *connect.Request[vpsv1.CreateVPSTemplateRequest]) (*connect.Response[vpsv1.CreateVPSTemplateResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	vpsID := req.Msg.GetVpsId()
+	if err := s.checkVPSPermission(ctx, vpsID, auth.PermissionVPSManage); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Msg.GetName())
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+
+	var vps database.VPSInstance
+	if err := database.DB.Where("id = ? AND organization_id = ? AND deleted_at IS NULL", vpsID, orgID).First(&vps).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VPS %s not found", vpsID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS: %w", err))
+	}
+	if vps.InstanceID == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("VPS %s has no VM to create a template from", vpsID))
+	}
+
+	userInfo, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required: %w", err))
+	}
+
+	// Waiting for the guest to shut down can outlast the HTTP request timeout
+	templateCtx, templateCancel := s.detachedContext(15 * time.Minute)
+	defer templateCancel()
+	nodeName, vmID, err := s.vpsManager.CreateVPSTemplate(templateCtx, vpsID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create VPS template: %w", err))
+	}
+
+	tmpl := &database.VPSTemplate{
+		ID:                fmt.Sprintf("vpst-%s", uuid.NewString()),
+		OrganizationID:    orgID,
+		ProxmoxTemplateID: vmID,
+		NodeName:          nodeName,
+		Name:              name,
+		Description:       req.Msg.Description,
+		SourceVPSID:       vpsID,
+		Region:            vps.Region,
+		Image:             vps.Image,
+		ImageID:           vps.ImageID,
+		CreatedBy:         userInfo.Id,
+	}
+	if err := database.CreateVPSTemplate(tmpl); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	logger.Info("[VPS Service] Created VPS template %s from VPS %s (VM %d on node %s) for organization %s", tmpl.ID, vpsID, vmID, nodeName, orgID)
+
+	return connect.NewResponse(&vpsv1.CreateVPSTemplateResponse{
+		Template: vpsTemplateToProto(tmpl),
+	}), nil
+}
+
+// ListVPSTemplates lists the VPS templates of an organization
+func (s *Service) ListVPSTemplates(ctx context.Context, req *connect.Request[vpsv1.ListVPSTemplatesRequest]) (*connect.Response[vpsv1.ListVPSTemplatesResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.checkOrgVPSPermission(ctx, orgID, auth.PermissionVPSRead); err != nil {
+		return nil, err
+	}
+
+	templates, err := database.ListVPSTemplates(orgID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoTemplates := make([]*vpsv1.VPSTemplate, len(templates))
+	for i := range templates {
+		protoTemplates[i] = vpsTemplateToProto(&templates[i])
+	}
+
+	return connect.NewResponse(&vpsv1.ListVPSTemplatesResponse{
+		Templates: protoTemplates,
+	}), nil
+}
+
+// DeleteVPSTemplate deletes a VPS template and its VM from Proxmox
+// VPS instances already cloned from the template are not affected
+func (s *Service) DeleteVPSTemplate(ctx context.Context, req *connect.Request[vpsv1.DeleteVPSTemplateRequest]) (*connect.Response[vpsv1.DeleteVPSTemplateResponse], error) {
+	ctx, err := s.ensureAuthenticated(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.checkOrgVPSPermission(ctx, orgID, auth.PermissionVPSDelete); err != nil {
+		return nil, err
+	}
+
+	templateID := req.Msg.GetTemplateId()
+	tmpl, err := database.GetVPSTemplate(orgID, templateID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("VPS template %s not found", templateID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get VPS template: %w", err))
+	}
+
+	// Proxmox refuses to delete a template that linked clones still depend on, so keep the record in that case
+	if err := s.vpsManager.DeleteVPSTemplate(ctx, tmpl.NodeName, tmpl.ProxmoxTemplateID, tmpl.SourceVPSID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete VPS template: %w", err))
+	}
+	if _, err := database.DeleteVPSTemplate(orgID, templateID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	logger.Info("[VPS Service] Deleted VPS template %s (VM %d on node %s) for organization %s", templateID, tmpl.ProxmoxTemplateID, tmpl.NodeName, orgID)
+
+	return connect.NewResponse(&vpsv1.DeleteVPSTemplateResponse{
+		Success: true,
+	}), nil
+}
+
+// checkOrgVPSPermission verifies organization-wide VPS permissions for templates and ISOs
+func (s *Service) checkOrgVPSPermission(ctx context.Context, orgID string, permission string) error {
+	if orgID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id is required"))
+	}
+	return auth.CheckScopedPermissionWithError(ctx, s.permissionChecker, orgID, auth.ScopedPermission{
+		Permission:   permission,
+		ResourceType: "vps",
+	})
+}
+
+func vpsTemplateToProto(tmpl *database.VPSTemplate) *vpsv1.VPSTemplate {
+	return &vpsv1.VPSTemplate{
+		Id:             tmpl.ID,
+		OrganizationId: tmpl.OrganizationID,
+		Name:           tmpl.Name,
+		Description:    tmpl.Description,
+		SourceVpsId:    tmpl.SourceVPSID,
+		Region:         tmpl.Region,
+		Image:          vpsv1.VPSImage(tmpl.Image),
+		CreatedAt:      timestamppb.New(tmpl.CreatedAt),
+	}
+}
@@ -0,0 +1,86 @@
+package vps
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/obiente/cloud/apps/shared/pkg/auth"
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/quota"
+	authv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/auth/v1"
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestVPSTemplatesAreScopedToOrganization(t *testing.T) {
+	db := newVPSServiceTestDB(t)
+	if err := db.AutoMigrate(&database.VPSTemplate{}); err != nil {
+		t.Fatalf("migrate VPS templates: %v", err)
+	}
+	service := &Service{
+		permissionChecker: auth.NewPermissionChecker(),
+		quotaChecker:      quota.NewChecker(),
+	}
+
+	seedVPSServiceIsolationData(t, db)
+	for _, tmpl := range []*database.VPSTemplate{
+		{ID: "vpst-org-a", OrganizationID: "org-a", ProxmoxTemplateID: 9001, NodeName: "pve-1", Name: "Org A template", SourceVPSID: "vps-org-a-owner", Region: "eu-west-1", Image: int32(vpsv1.VPSImage_UBUNTU_24_04)},
+		{ID: "vpst-org-b", OrganizationID: "org-b", ProxmoxTemplateID: 9002, NodeName: "pve-1", Name: "Org B template", SourceVPSID: "vps-org-b-owner", Region: "eu-west-1", Image: int32(vpsv1.VPSImage_UBUNTU_24_04)},
+	} {
+		if err := database.CreateVPSTemplate(tmpl); err != nil {
+			t.Fatalf("seed VPS template: %v", err)
+		}
+	}
+
+	ctx := auth.WithUser(context.Background(), &authv1.User{
+		Id:    "user-org-a",
+		Email: "user-org-a@example.com",
+	})
+
+	list, err := service.ListVPSTemplates(ctx, connect.NewRequest(&vpsv1.ListVPSTemplatesRequest{OrganizationId: "org-a"}))
+	if err != nil {
+		t.Fatalf("list org-a VPS templates: %v", err)
+	}
+	if templates := list.Msg.GetTemplates(); len(templates) != 1 || templates[0].GetId() != "vpst-org-a" {
+		t.Fatalf("org-a templates = %v, want only vpst-org-a", templates)
+	}
+
+	_, err = service.ListVPSTemplates(ctx, connect.NewRequest(&vpsv1.ListVPSTemplatesRequest{OrganizationId: "org-b"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("cross-org list code = %v, want %v: %v", connect.CodeOf(err), connect.CodePermissionDenied, err)
+	}
+
+	// Another organization's template is looked up within the caller's organization and not found
+	_, err = service.DeleteVPSTemplate(ctx, connect.NewRequest(&vpsv1.DeleteVPSTemplateRequest{
+		OrganizationId: "org-a",
+		TemplateId:     "vpst-org-b",
+	}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("cross-org delete code = %v, want %v: %v", connect.CodeOf(err), connect.CodeNotFound, err)
+	}
+	if _, err := database.GetVPSTemplate("org-b", "vpst-org-b"); err != nil {
+		t.Fatalf("cross-org delete removed org-b template: %v", err)
+	}
+
+	_, err = service.CreateVPS(ctx, connect.NewRequest(&vpsv1.CreateVPSRequest{
+		OrganizationId: "org-a",
+		Name:           "Cloned VPS",
+		Size:           "small",
+		TemplateId:     proto.String("vpst-org-b"),
+	}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("create from cross-org template code = %v, want %v: %v", connect.CodeOf(err), connect.CodeNotFound, err)
+	}
+
+	_, err = service.CreateVPS(ctx, connect.NewRequest(&vpsv1.CreateVPSRequest{
+		OrganizationId: "org-a",
+		Name:           "Cloned VPS",
+		Region:         "us-east-1",
+		Size:           "small",
+		TemplateId:     proto.String("vpst-org-a"),
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("create from template in another region code = %v, want %v: %v", connect.CodeOf(err), connect.CodeInvalidArgument, err)
+	}
+}
@@ -66,6 +66,13 @@ func main() {
 	}
 	logger.Info("✓ Database initialized")
 
+	// Initialize Redis cache (for maintenance mode, etc.)
+	if err := database.InitRedis(); err != nil {
+		logger.Warn("Redis initialization failed: %v. Some features may not work correctly.", err)
+	} else {
+		logger.Info("✓ Redis initialized")
+	}
+
 	// Initialize Redis
 	redisAddr := os.Getenv("REDIS_URL")
 	if redisAddr == "" {
@@ -98,6 +105,12 @@ func main() {
 	// Create audit interceptor
 	auditInterceptor := middleware.AuditLogInterceptor()
 
+	// Create maintenance mode interceptor (rejects mutating RPCs during platform maintenance)
+	maintenanceInterceptor := middleware.MaintenanceModeInterceptor()
+
+	// Reject requests for suspended organizations
+	suspensionInterceptor := auth.OrgSuspensionInterceptor()
+
 	// Initialize VPS manager
 	// Create VPS manager directly (orchestrator service doesn't manage VPS manager)
 	var vpsManager *orchestrator.VPSManager
@@ -142,7 +155,7 @@ func main() {
 	// Register VPS service
 	vpsPath, vpsHandler := vpsv1connect.NewVPSServiceHandler(
 		vpsService,
-		connect.WithInterceptors(auditInterceptor, authInterceptor),
+		connect.WithInterceptors(auditInterceptor, authInterceptor, maintenanceInterceptor, suspensionInterceptor),
 	)
 	mux.Handle(vpsPath, vpsHandler)
 
@@ -186,15 +199,21 @@ func main() {
 	vpsConfigService := vpssvc.NewConfigService(vpsManager)
 	vpsConfigPath, vpsConfigHandler := vpsv1connect.NewVPSConfigServiceHandler(
 		vpsConfigService,
-		connect.WithInterceptors(auditInterceptor, authInterceptor),
+		connect.WithInterceptors(auditInterceptor, authInterceptor, maintenanceInterceptor, suspensionInterceptor),
 	)
 	mux.Handle(vpsConfigPath, vpsConfigHandler)
 
-	// VPS terminal WebSocket endpoint
-	// Route pattern: /vps/{vps_id}/terminal/ws
+	// ISO uploads (multipart streaming) and VPS terminal and console WebSocket endpoints
+	// Route patterns: /vps/isos/upload, /vps/{vps_id}/terminal/ws, /vps/{vps_id}/console, /vps/{vps_id}/vnc
 	mux.HandleFunc("/vps/", func(w http.ResponseWriter, r *http.Request) {
-		if strings.HasSuffix(r.URL.Path, "/terminal/ws") {
+		if r.URL.Path == "/vps/isos/upload" {
+			vpsService.HandleUploadISO(w, r)
+		} else if strings.HasSuffix(r.URL.Path, "/terminal/ws") {
 			vpsService.HandleVPSTerminalWebSocket(w, r)
+		} else if strings.HasSuffix(r.URL.Path, "/console") {
+			vpsService.HandleVPSConsoleWebSocket(w, r)
+		} else if strings.HasSuffix(r.URL.Path, "/vnc") {
+			vpsService.HandleVPSVNCWebSocket(w, r)
 		} else {
 			http.NotFound(w, r)
 		}
@@ -224,9 +243,13 @@ func main() {
 
 	// Start lease reconciler to ensure all VPSes have DHCP leases registered
 	// This handles cases where gateway was down during VPS creation
+	// The ISO cleanup removes the ISOs of deleted organizations from the ISO storage
 	if vpsManager != nil {
 		go vpsManager.StartLeaseReconciler(shutdownCtx)
 		logger.Info("✓ Lease reconciler started")
+
+		go vpsManager.StartISOCleanup(shutdownCtx)
+		logger.Info("✓ ISO cleanup started")
 	}
 
 	// Health check endpoint with replica ID
@@ -285,6 +308,13 @@ func main() {
 		// This imports VPSs that exist in Proxmox but are missing from the database
 		go startVPSImportSync(shutdownCtx, vpsManager)
 		logger.Info("✓ VPS import sync service started (10 minute interval)")
+
+		// Verify the Proxmox API token every 5 minutes and pick up rotations made through the superadmin API
+		orchestrator.StartProxmoxTokenHealthCheck(shutdownCtx)
+
+		// Notify organizations every Monday about VPS instances that have not been patched recently
+		go vpssvc.StartPatchCheckNotifier(shutdownCtx)
+		logger.Info("✓ VPS patch check notifier started (weekly)")
 	}
 
 	// Start server in a goroutine
@@ -338,12 +368,12 @@ func startVPSStatusSync(ctx context.Context, vpsManager *orchestrator.VPSManager
 	case <-startupDelay.C:
 	}
 	syncCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
-	deletedVPSs, err := vpsManager.SyncAllVPSStatuses(syncCtx)
+	syncResult, err := vpsManager.SyncAllVPSStatuses(syncCtx)
 	cancel()
 	if err != nil {
 		logger.Warn("[VPS Status Sync] Error on startup sync: %v", err)
 	} else {
-		sendDeletedVPSNotificationsWithTimeout(ctx, deletedVPSs)
+		sendStatusSyncNotificationsWithTimeout(ctx, syncResult)
 	}
 
 	for {
@@ -353,21 +383,22 @@ func startVPSStatusSync(ctx context.Context, vpsManager *orchestrator.VPSManager
 			return
 		case <-ticker.C:
 			syncCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
-			deletedVPSs, err := vpsManager.SyncAllVPSStatuses(syncCtx)
+			syncResult, err := vpsManager.SyncAllVPSStatuses(syncCtx)
 			cancel()
 			if err != nil {
 				logger.Warn("[VPS Status Sync] Error during periodic sync: %v", err)
 			} else {
-				sendDeletedVPSNotificationsWithTimeout(ctx, deletedVPSs)
+				sendStatusSyncNotificationsWithTimeout(ctx, syncResult)
 			}
 		}
 	}
 }
 
-func sendDeletedVPSNotificationsWithTimeout(ctx context.Context, deletedVPSs map[string]int32) {
+func sendStatusSyncNotificationsWithTimeout(ctx context.Context, syncResult *orchestrator.VPSStatusSyncResult) {
 	notifyCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
-	sendDeletedVPSNotifications(notifyCtx, deletedVPSs)
+	sendDeletedVPSNotifications(notifyCtx, syncResult.DeletedVPSs)
+	sendUnresponsiveVPSNotifications(notifyCtx, syncResult.UnresponsiveVPSs)
 }
 
 // sendDeletedVPSNotifications sends notifications for VPSs that were marked as deleted
@@ -425,6 +456,53 @@ func sendDeletedVPSNotifications(ctx context.Context, deletedVPSs map[string]int
 	}
 }
 
+// sendUnresponsiveVPSNotifications alerts VPS owners when the guest OS stopped answering guest agent pings
+func sendUnresponsiveVPSNotifications(ctx context.Context, unresponsiveVPSs []string) {
+	for _, vpsID := range unresponsiveVPSs {
+		var vps database.VPSInstance
+		if err := database.DB.Where("id = ?", vpsID).First(&vps).Error; err != nil {
+			logger.Warn("[VPS Status Sync] Failed to get VPS %s for notification: %v", vpsID, err)
+			continue
+		}
+		if vps.CreatedBy == "" {
+			continue
+		}
+
+		title := fmt.Sprintf("VPS Unresponsive: %s", vps.Name)
+		message := fmt.Sprintf("Your VPS instance '%s' is running but its operating system has stopped responding. You may need to reboot it.", vps.Name)
+
+		metadata := map[string]string{
+			"vps_id":     vps.ID,
+			"vps_name":   vps.Name,
+			"vps_status": fmt.Sprintf("%d", vps.Status),
+			"event_type": "vps_unresponsive",
+		}
+		if vps.InstanceID != nil {
+			metadata["vm_id"] = *vps.InstanceID
+		}
+
+		actionURL := fmt.Sprintf("/vps/%s", vps.ID)
+		actionLabel := "View VPS"
+		orgID := vps.OrganizationID
+		if err := notifications.CreateNotificationForUser(
+			ctx,
+			vps.CreatedBy,
+			&orgID,
+			notificationsv1.NotificationType_NOTIFICATION_TYPE_WARNING,
+			notificationsv1.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+			title,
+			message,
+			&actionURL,
+			&actionLabel,
+			metadata,
+		); err != nil {
+			logger.Warn("[VPS Status Sync] Failed to send notification for unresponsive VPS %s: %v", vpsID, err)
+		} else {
+			logger.Info("[VPS Status Sync] Sent notification for unresponsive VPS %s", vpsID)
+		}
+	}
+}
+
 // startVPSImportSync starts the periodic VPS import background service
 // This imports VPSs that exist in Proxmox but are missing from the database
 func startVPSImportSync(ctx context.Context, vpsManager *orchestrator.VPSManager) {
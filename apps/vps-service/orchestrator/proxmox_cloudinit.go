@@ -55,10 +55,14 @@ func generateCloudInitUserData(config *VPSConfig) string {
 	userData += fmt.Sprintf("  allow-pw: %v\n", sshAllowPW)
 	userData += "\n"
 
-	// Disable cloud-init network configuration - we use Proxmox's ipconfig0 instead
-	userData += "network:\n"
-	userData += "  config: disabled\n"
-	userData += "\n"
+	if config.StaticIP != nil {
+		userData += staticNetworkConfig(config.StaticIP)
+	} else {
+		// Disable cloud-init network configuration - we use Proxmox's ipconfig0 instead
+		userData += "network:\n"
+		userData += "  config: disabled\n"
+		userData += "\n"
+	}
 
 	// Hostname
 	if config.CloudInit != nil && config.CloudInit.Hostname != nil && *config.CloudInit.Hostname != "" {
@@ -1307,6 +1311,24 @@ func (pc *ProxmoxClient) UpdateCloudInitUserDataWithStaticIP(ctx context.Context
 	return nil
 }
 
+// staticNetworkConfig returns the cloud-init network config (version 2) for a static IP in place of DHCP
+func staticNetworkConfig(staticIP *StaticIPConfig) string {
+	return fmt.Sprintf(`network:
+  version: 2
+  ethernets:
+    eth0:
+      dhcp4: false
+      addresses:
+        - %s
+      routes:
+        - to: default
+          via: %s
+      nameservers:
+        addresses: [%s]
+
+`, staticIP.Address, staticIP.Gateway, strings.Join(staticIP.nameservers(), ", "))
+}
+
 // updateUserDataWithPublicIP adds a public IP address to cloud-init userData alongside the existing DHCP configuration
 // The public IP uses its own gateway for routing, while the internal IP continues to use DHCP via Proxmox ipconfig0
 func updateUserDataWithPublicIP(userData string, publicIP string, publicGateway string, netmask string) string {
@@ -0,0 +1,60 @@
+package orchestrator
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestGenerateCloudInitUserDataStaticIP(t *testing.T) {
+	staticIP := &StaticIPConfig{Address: "10.20.0.10/24", Gateway: "10.20.0.1", DNSServers: []string{"9.9.9.9"}}
+	userData := generateCloudInitUserData(&VPSConfig{VPSID: "vps-123", StaticIP: staticIP})
+	if strings.Contains(userData, "config: disabled") {
+		t.Fatalf("static IP userData still disables network config:\n%s", userData)
+	}
+
+	var doc struct {
+		Network struct {
+			Version   int `yaml:"version"`
+			Ethernets map[string]struct {
+				DHCP4     bool     `yaml:"dhcp4"`
+				Addresses []string `yaml:"addresses"`
+				Routes    []struct {
+					To  string `yaml:"to"`
+					Via string `yaml:"via"`
+				} `yaml:"routes"`
+				Nameservers struct {
+					Addresses []string `yaml:"addresses"`
+				} `yaml:"nameservers"`
+			} `yaml:"ethernets"`
+		} `yaml:"network"`
+	}
+	if err := yaml.Unmarshal([]byte(userData), &doc); err != nil {
+		t.Fatalf("userData is not valid YAML: %v\n%s", err, userData)
+	}
+	eth0, ok := doc.Network.Ethernets["eth0"]
+	if doc.Network.Version != 2 || !ok {
+		t.Fatalf("network config = %+v, want version 2 with eth0", doc.Network)
+	}
+	if eth0.DHCP4 || len(eth0.Addresses) != 1 || eth0.Addresses[0] != "10.20.0.10/24" {
+		t.Fatalf("eth0 = %+v, want only the static address without DHCP", eth0)
+	}
+	if len(eth0.Routes) != 1 || eth0.Routes[0].To != "default" || eth0.Routes[0].Via != "10.20.0.1" {
+		t.Fatalf("eth0 routes = %+v, want a default route via 10.20.0.1", eth0.Routes)
+	}
+	if len(eth0.Nameservers.Addresses) != 1 || eth0.Nameservers.Addresses[0] != "9.9.9.9" {
+		t.Fatalf("eth0 nameservers = %v, want [9.9.9.9]", eth0.Nameservers.Addresses)
+	}
+
+	if got, want := staticIP.ipConfig(), "ip=10.20.0.10/24,gw=10.20.0.1"; got != want {
+		t.Fatalf("ipconfig0 = %q, want %q", got, want)
+	}
+}
+
+func TestGenerateCloudInitUserDataDHCP(t *testing.T) {
+	userData := generateCloudInitUserData(&VPSConfig{VPSID: "vps-123"})
+	if !strings.Contains(userData, "network:\n  config: disabled\n") {
+		t.Fatalf("DHCP userData should leave networking to ipconfig0:\n%s", userData)
+	}
+}
@@ -305,3 +305,66 @@ func (pc *ProxmoxClient) GetSerialConsoleWebSocketURL(ctx context.Context, nodeN
 	return wsURL, nil
 }
 
+// VNCProxyInfo describes a VNC proxy opened on a Proxmox node for one VM
+type VNCProxyInfo struct {
+	WebSocketURL string // vncwebsocket endpoint, including port and vncticket
+	Port         int
+	Ticket       string // Also the VNC password noVNC must send during the RFB handshake
+}
+
+// CreateVNCProxy opens a websocket-capable VNC proxy for a VM via POST /nodes/{node}/qemu/{vmid}/vncproxy.
+// The ticket is only valid for a short time, so the websocket should be dialed promptly.
+func (pc *ProxmoxClient) CreateVNCProxy(ctx context.Context, nodeName string, vmID int) (*VNCProxyInfo, error) {
+	endpoint := fmt.Sprintf("/nodes/%s/qemu/%d/vncproxy", nodeName, vmID)
+	formData := url.Values{}
+	formData.Set("websocket", "1") // Required for vncwebsocket (noVNC) connections
+	resp, err := pc.apiRequestForm(ctx, "POST", endpoint, formData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VNC proxy: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get VNC proxy: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var vncResp struct {
+		Data struct {
+			Ticket string      `json:"ticket"`
+			Port   interface{} `json:"port"` // Can be string or int
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&vncResp); err != nil {
+		return nil, fmt.Errorf("failed to decode VNC proxy response: %w", err)
+	}
+
+	var port int
+	switch v := vncResp.Data.Port.(type) {
+	case float64:
+		port = int(v)
+	case string:
+		if _, err := fmt.Sscanf(v, "%d", &port); err != nil {
+			return nil, fmt.Errorf("failed to parse port as integer: %v", vncResp.Data.Port)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected port type: %T", vncResp.Data.Port)
+	}
+	if vncResp.Data.Ticket == "" {
+		return nil, fmt.Errorf("VNC proxy response did not include a ticket")
+	}
+
+	params := url.Values{}
+	params.Set("port", fmt.Sprintf("%d", port))
+	params.Set("vncticket", vncResp.Data.Ticket)
+	apiURL := strings.TrimSuffix(pc.config.APIURL, "/")
+	wsURL := fmt.Sprintf("%s/api2/json/nodes/%s/qemu/%d/vncwebsocket?%s", apiURL, nodeName, vmID, params.Encode())
+	wsURL = strings.Replace(wsURL, "https://", "wss://", 1)
+	wsURL = strings.Replace(wsURL, "http://", "ws://", 1)
+
+	return &VNCProxyInfo{
+		WebSocketURL: wsURL,
+		Port:         port,
+		Ticket:       vncResp.Data.Ticket,
+	}, nil
+}
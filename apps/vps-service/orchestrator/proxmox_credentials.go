@@ -0,0 +1,415 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/secrets"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// ProxmoxTokenGracePeriod is how long the replaced token is still tried after a rotation
+	ProxmoxTokenGracePeriod = 60 * time.Second
+	// proxmoxTokenHealthInterval is how often the active token is verified
+	proxmoxTokenHealthInterval = 5 * time.Minute
+	// proxmoxCredentialReloadInterval is how often a process picks up rotations made by another process
+	proxmoxCredentialReloadInterval = 30 * time.Second
+)
+
+// requiredProxmoxPrivileges are the privileges an API token needs, by ACL path
+var requiredProxmoxPrivileges = map[string][]string{
+	"/vms": {
+		"VM.Allocate", "VM.Audit", "VM.Clone", "VM.Config.CPU", "VM.Config.Cloudinit", "VM.Config.Disk",
+		"VM.Config.Memory", "VM.Config.Network", "VM.Config.Options", "VM.Console", "VM.Migrate", "VM.PowerMgmt",
+	},
+	"/storage": {"Datastore.AllocateSpace", "Datastore.Audit"},
+	"/nodes":   {"Sys.Audit"},
+}
+
+// ProxmoxToken is a Proxmox API token
+type ProxmoxToken struct {
+	Username string // USER@REALM
+	TokenID  string
+	Secret   string
+}
+
+// ParseProxmoxToken parses a token in the form USER@REALM!TOKENID=SECRET
+func ParseProxmoxToken(value string) (ProxmoxToken, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "PVEAPIToken=")
+	name, secret, ok := strings.Cut(value, "=")
+	if !ok || secret == "" {
+		return ProxmoxToken{}, fmt.Errorf("token must be in the form USER@REALM!TOKENID=SECRET")
+	}
+	username, tokenID, ok := strings.Cut(name, "!")
+	if !ok || tokenID == "" || !strings.Contains(username, "@") {
+		return ProxmoxToken{}, fmt.Errorf("token must be in the form USER@REALM!TOKENID=SECRET")
+	}
+	return ProxmoxToken{Username: username, TokenID: tokenID, Secret: secret}, nil
+}
+
+// String returns the token in the form USER@REALM!TOKENID=SECRET
+func (t ProxmoxToken) String() string {
+	return fmt.Sprintf("%s!%s=%s", t.Username, t.TokenID, t.Secret)
+}
+
+// Name returns the token without its secret, for logs
+func (t ProxmoxToken) Name() string {
+	return t.Username + "!" + t.TokenID
+}
+
+// AuthHeader returns the Authorization header value for the token
+func (t ProxmoxToken) AuthHeader() string {
+	return "PVEAPIToken=" + t.String()
+}
+
+// CredentialManager holds the Proxmox API token requests are made with (primary) and, for
+// ProxmoxTokenGracePeriod after a rotation, the token it replaced (standby). Rotations are stored
+// in the proxmox_credentials table, which takes precedence over PROXMOX_TOKEN_ID / PROXMOX_TOKEN_SECRET.
+type CredentialManager struct {
+	mu           sync.RWMutex
+	primary      ProxmoxToken
+	standby      *ProxmoxToken
+	standbyUntil time.Time
+	loadedAt     time.Time
+	persistent   bool // Whether rotations are loaded from and stored in the database
+}
+
+// NewCredentialManager creates a manager that only ever uses the given token
+func NewCredentialManager(token ProxmoxToken) *CredentialManager {
+	return &CredentialManager{primary: token}
+}
+
+var (
+	proxmoxCredentials     *CredentialManager
+	proxmoxCredentialsOnce sync.Once
+)
+
+// ProxmoxCredentials returns the process-wide manager, starting from the environment's token
+func ProxmoxCredentials() *CredentialManager {
+	proxmoxCredentialsOnce.Do(func() {
+		username := os.Getenv("PROXMOX_USERNAME")
+		if username == "" {
+			username = "root@pam"
+		}
+		proxmoxCredentials = &CredentialManager{
+			primary:    ProxmoxToken{Username: username, TokenID: os.Getenv("PROXMOX_TOKEN_ID"), Secret: os.Getenv("PROXMOX_TOKEN_SECRET")},
+			persistent: true,
+		}
+		if err := proxmoxCredentials.Load(context.Background()); err != nil {
+			logger.Warn("[ProxmoxCredentials] Failed to load rotated Proxmox token, using PROXMOX_TOKEN_ID: %v", err)
+		}
+	})
+	return proxmoxCredentials
+}
+
+// Primary returns the active token; ok is false when no token is configured (password authentication)
+func (m *CredentialManager) Primary() (token ProxmoxToken, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.primary, m.primary.TokenID != "" && m.primary.Secret != ""
+}
+
+// Tokens returns the tokens to try in order: the primary, then the standby during its grace period
+func (m *CredentialManager) Tokens() []ProxmoxToken {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	tokens := []ProxmoxToken{m.primary}
+	if m.standby != nil && time.Now().Before(m.standbyUntil) {
+		tokens = append(tokens, *m.standby)
+	}
+	return tokens
+}
+
+// Load reads the rotated token from the database. Without a rotated token the current one is kept.
+func (m *CredentialManager) Load(ctx context.Context) error {
+	if !m.persistent || database.DB == nil {
+		return nil
+	}
+	var record database.ProxmoxCredential
+	err := database.DB.WithContext(ctx).Where("id = ?", database.ProxmoxCredentialID).Take(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		m.mu.Lock()
+		m.loadedAt = time.Now()
+		m.mu.Unlock()
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get Proxmox credential: %w", err)
+	}
+
+	cipher, err := secrets.NewTokenCipherFromEnv()
+	if err != nil {
+		return err
+	}
+	primary, err := openProxmoxToken(cipher, record.EncryptedPrimaryToken)
+	if err != nil {
+		return fmt.Errorf("decrypt primary token: %w", err)
+	}
+	var standby *ProxmoxToken
+	var standbyUntil time.Time
+	if record.EncryptedStandbyToken != "" && record.StandbyRetiresAt != nil && time.Now().Before(*record.StandbyRetiresAt) {
+		token, err := openProxmoxToken(cipher, record.EncryptedStandbyToken)
+		if err != nil {
+			return fmt.Errorf("decrypt standby token: %w", err)
+		}
+		standby, standbyUntil = &token, *record.StandbyRetiresAt
+	}
+
+	m.mu.Lock()
+	if primary != m.primary {
+		logger.Info("[ProxmoxCredentials] Using rotated Proxmox token %s", primary.Name())
+	}
+	m.primary, m.standby, m.standbyUntil = primary, standby, standbyUntil
+	m.loadedAt = time.Now()
+	m.mu.Unlock()
+	return nil
+}
+
+// reloadIfStale reloads the tokens when they were last loaded before maxAge, so requests that fail
+// with every known token pick up a rotation made by another process
+func (m *CredentialManager) reloadIfStale(ctx context.Context, maxAge time.Duration) bool {
+	m.mu.RLock()
+	stale := m.persistent && time.Since(m.loadedAt) >= maxAge
+	before := m.primary
+	m.mu.RUnlock()
+	if !stale {
+		return false
+	}
+	if err := m.Load(ctx); err != nil {
+		logger.Warn("[ProxmoxCredentials] Failed to reload Proxmox token: %v", err)
+		return false
+	}
+	primary, _ := m.Primary()
+	return primary != before
+}
+
+// Rotate makes newToken the primary token once validate accepts it. The token is first stored as the
+// standby, then primary and standby are swapped in one statement, so the previous primary stays usable
+// as the standby for ProxmoxTokenGracePeriod. It returns when the previous token is retired.
+// Nothing changes when validation fails.
+func (m *CredentialManager) Rotate(ctx context.Context, newToken ProxmoxToken, rotatedBy string, validate func(context.Context, ProxmoxToken) error) (time.Time, error) {
+	if err := validate(ctx, newToken); err != nil {
+		return time.Time{}, err
+	}
+
+	now := time.Now()
+	retiresAt := now.Add(ProxmoxTokenGracePeriod)
+	if m.persistent {
+		if err := m.storeRotation(ctx, newToken, rotatedBy, now, retiresAt); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	m.mu.Lock()
+	previous := m.primary
+	m.primary = newToken
+	m.standby, m.standbyUntil = &previous, retiresAt
+	m.loadedAt = now
+	m.mu.Unlock()
+
+	logger.Info("[ProxmoxCredentials] Rotated Proxmox token to %s by %s; the previous token is retired at %s",
+		newToken.Name(), rotatedBy, retiresAt.Format(time.RFC3339))
+	time.AfterFunc(ProxmoxTokenGracePeriod, m.retireStandby)
+	return retiresAt, nil
+}
+
+func (m *CredentialManager) storeRotation(ctx context.Context, newToken ProxmoxToken, rotatedBy string, now, retiresAt time.Time) error {
+	cipher, err := secrets.NewTokenCipherFromEnv()
+	if err != nil {
+		return err
+	}
+	sealedNew, err := cipher.EncryptString(newToken.String())
+	if err != nil {
+		return fmt.Errorf("encrypt token: %w", err)
+	}
+
+	m.mu.RLock()
+	current := m.primary
+	m.mu.RUnlock()
+
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var record database.ProxmoxCredential
+		err := tx.Where("id = ?", database.ProxmoxCredentialID).Take(&record).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// First rotation: the environment's token becomes the stored primary
+			sealedCurrent, err := cipher.EncryptString(current.String())
+			if err != nil {
+				return fmt.Errorf("encrypt token: %w", err)
+			}
+			record = database.ProxmoxCredential{ID: database.ProxmoxCredentialID, EncryptedPrimaryToken: sealedCurrent, UpdatedAt: now}
+			if err := tx.Create(&record).Error; err != nil {
+				return fmt.Errorf("store Proxmox credential: %w", err)
+			}
+		} else if err != nil {
+			return fmt.Errorf("get Proxmox credential: %w", err)
+		}
+
+		if err := tx.Model(&database.ProxmoxCredential{}).Where("id = ?", database.ProxmoxCredentialID).
+			Updates(map[string]interface{}{"encrypted_standby_token": sealedNew, "updated_at": now}).Error; err != nil {
+			return fmt.Errorf("store standby token: %w", err)
+		}
+		// Both columns are assigned from the row's previous values
+		if err := tx.Model(&database.ProxmoxCredential{}).Where("id = ?", database.ProxmoxCredentialID).
+			Updates(map[string]interface{}{
+				"encrypted_primary_token": gorm.Expr("encrypted_standby_token"),
+				"encrypted_standby_token": gorm.Expr("encrypted_primary_token"),
+				"standby_retires_at":      retiresAt,
+				"rotated_by":              rotatedBy,
+				"rotated_at":              now,
+				"updated_at":              now,
+			}).Error; err != nil {
+			return fmt.Errorf("swap tokens: %w", err)
+		}
+		return nil
+	})
+}
+
+// retireStandby drops the standby token once its grace period is over. A standby from a later
+// rotation is kept until its own grace period ends.
+func (m *CredentialManager) retireStandby() {
+	now := time.Now()
+	m.mu.Lock()
+	if m.standby != nil && !now.Before(m.standbyUntil) {
+		logger.Info("[ProxmoxCredentials] Retired Proxmox token %s", m.standby.Name())
+		m.standby = nil
+	}
+	m.mu.Unlock()
+
+	if !m.persistent || database.DB == nil {
+		return
+	}
+	if err := database.DB.Model(&database.ProxmoxCredential{}).
+		Where("id = ? AND standby_retires_at <= ?", database.ProxmoxCredentialID, now).
+		Updates(map[string]interface{}{"encrypted_standby_token": "", "standby_retires_at": nil}).Error; err != nil {
+		logger.Warn("[ProxmoxCredentials] Failed to retire the previous Proxmox token: %v", err)
+	}
+}
+
+func openProxmoxToken(cipher *secrets.TokenCipher, sealed string) (ProxmoxToken, error) {
+	value, err := cipher.DecryptString(sealed)
+	if err != nil {
+		return ProxmoxToken{}, err
+	}
+	return ParseProxmoxToken(value)
+}
+
+// ValidateProxmoxToken checks that the token is accepted by every configured Proxmox node and has
+// the privileges VPS management needs
+func ValidateProxmoxToken(ctx context.Context, token ProxmoxToken) error {
+	nodes, err := GetAllProxmoxNodeNames()
+	if err != nil {
+		return err
+	}
+	if len(nodes) == 0 {
+		return fmt.Errorf("no Proxmox nodes configured")
+	}
+	skipTLSVerify := strings.EqualFold(os.Getenv("PROXMOX_SKIP_TLS_VERIFY"), "true") || os.Getenv("PROXMOX_SKIP_TLS_VERIFY") == "1"
+	for _, node := range nodes {
+		apiURL, err := resolveProxmoxURLForNode(node)
+		if err != nil {
+			return err
+		}
+		if err := checkProxmoxTokenPrivileges(ctx, apiURL, token, skipTLSVerify); err != nil {
+			return fmt.Errorf("node %s: %w", node, err)
+		}
+	}
+	return nil
+}
+
+// checkProxmoxTokenPrivileges verifies the token against one Proxmox API
+func checkProxmoxTokenPrivileges(ctx context.Context, apiURL string, token ProxmoxToken, skipTLSVerify bool) error {
+	client := &http.Client{
+		Timeout:   15 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: skipTLSVerify}},
+	}
+
+	paths := make([]string, 0, len(requiredProxmoxPrivileges))
+	for path := range requiredProxmoxPrivileges {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		reqURL := fmt.Sprintf("%s/api2/json/access/permissions?path=%s", strings.TrimSuffix(apiURL, "/"), url.QueryEscape(path))
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Authorization", token.AuthHeader())
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to reach Proxmox API: %w", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusUnauthorized {
+			return fmt.Errorf("token %s was rejected by Proxmox", token.Name())
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to check token permissions: %s (status: %d)", string(body), resp.StatusCode)
+		}
+
+		// Privileges map to their propagate flag, so a privilege is granted when it is present
+		var permissions struct {
+			Data map[string]map[string]int `json:"data"`
+		}
+		if err := json.Unmarshal(body, &permissions); err != nil {
+			return fmt.Errorf("failed to decode token permissions: %w", err)
+		}
+		var missing []string
+		for _, privilege := range requiredProxmoxPrivileges[path] {
+			if _, ok := permissions.Data[path][privilege]; !ok {
+				missing = append(missing, privilege)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("token %s is missing %s on %s", token.Name(), strings.Join(missing, ", "), path)
+		}
+	}
+	return nil
+}
+
+// StartProxmoxTokenHealthCheck verifies the active token every 5 minutes until ctx is done, picking up
+// rotations made by other services on the way
+func StartProxmoxTokenHealthCheck(ctx context.Context) {
+	credentials := ProxmoxCredentials()
+	if _, ok := credentials.Primary(); !ok {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(proxmoxTokenHealthInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := credentials.Load(ctx); err != nil {
+					logger.Warn("[ProxmoxCredentials] Failed to reload Proxmox token: %v", err)
+				}
+				token, _ := credentials.Primary()
+				if err := ValidateProxmoxToken(ctx, token); err != nil {
+					logger.Error("[ProxmoxCredentials] Health check of Proxmox token %s failed: %v", token.Name(), err)
+					continue
+				}
+				logger.Debug("[ProxmoxCredentials] Proxmox token %s is healthy", token.Name())
+			}
+		}
+	}()
+}
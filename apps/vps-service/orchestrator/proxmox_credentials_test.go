@@ -0,0 +1,152 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseProxmoxToken(t *testing.T) {
+	token, err := ParseProxmoxToken("PVEAPIToken=obiente@pve!rotated=5f1c-secret")
+	if err != nil {
+		t.Fatalf("ParseProxmoxToken: %v", err)
+	}
+	if token.Username != "obiente@pve" || token.TokenID != "rotated" || token.Secret != "5f1c-secret" {
+		t.Fatalf("parsed %+v", token)
+	}
+	if token.AuthHeader() != "PVEAPIToken=obiente@pve!rotated=5f1c-secret" || token.Name() != "obiente@pve!rotated" {
+		t.Fatalf("AuthHeader = %q, Name = %q", token.AuthHeader(), token.Name())
+	}
+
+	for _, value := range []string{"", "obiente@pve!rotated", "obiente@pve!rotated=", "obiente!rotated=secret", "obiente@pve=secret"} {
+		if _, err := ParseProxmoxToken(value); err == nil {
+			t.Errorf("ParseProxmoxToken(%q) succeeded", value)
+		}
+	}
+}
+
+func TestCheckProxmoxTokenPrivileges(t *testing.T) {
+	granted := map[string]map[string]int{}
+	for path, privileges := range requiredProxmoxPrivileges {
+		granted[path] = map[string]int{}
+		for _, privilege := range privileges {
+			// Privileges that are not propagated are reported as 0
+			granted[path][privilege] = 0
+		}
+	}
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "PVEAPIToken=obiente@pve!new=secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		path := r.URL.Query().Get("path")
+		mu.Lock()
+		defer mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]map[string]int{path: granted[path]}})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	token := ProxmoxToken{Username: "obiente@pve", TokenID: "new", Secret: "secret"}
+	if err := checkProxmoxTokenPrivileges(ctx, server.URL, token, false); err != nil {
+		t.Fatalf("token with every privilege: %v", err)
+	}
+
+	wrong := ProxmoxToken{Username: "obiente@pve", TokenID: "new", Secret: "wrong"}
+	if err := checkProxmoxTokenPrivileges(ctx, server.URL, wrong, false); err == nil || !strings.Contains(err.Error(), "rejected") {
+		t.Fatalf("rejected token: %v", err)
+	}
+
+	mu.Lock()
+	delete(granted["/vms"], "VM.Clone")
+	mu.Unlock()
+	if err := checkProxmoxTokenPrivileges(ctx, server.URL, token, false); err == nil || !strings.Contains(err.Error(), "VM.Clone") {
+		t.Fatalf("token without VM.Clone: %v", err)
+	}
+}
+
+func TestRotatedTokenKeepsPreviousTokenDuringGracePeriod(t *testing.T) {
+	previous := ProxmoxToken{Username: "obiente@pve", TokenID: "old", Secret: "old-secret"}
+	next := ProxmoxToken{Username: "obiente@pve", TokenID: "new", Secret: "new-secret"}
+
+	var mu sync.Mutex
+	accepted := map[string]bool{previous.AuthHeader(): true}
+	var used []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		used = append(used, r.Header.Get("Authorization"))
+		if !accepted[r.Header.Get("Authorization")] {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	credentials := NewCredentialManager(previous)
+	client, err := NewProxmoxClient(&ProxmoxConfig{APIURL: server.URL, TokenID: previous.TokenID, Secret: previous.Secret, Credentials: credentials})
+	if err != nil {
+		t.Fatalf("NewProxmoxClient: %v", err)
+	}
+	request := func() int {
+		t.Helper()
+		resp, err := client.apiRequest(context.Background(), http.MethodGet, "/version", nil)
+		if err != nil {
+			t.Fatalf("apiRequest: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// A token that fails validation is not used
+	invalid := errors.New("missing privileges")
+	if _, err := credentials.Rotate(context.Background(), next, "admin", func(context.Context, ProxmoxToken) error { return invalid }); !errors.Is(err, invalid) {
+		t.Fatalf("Rotate with an invalid token = %v", err)
+	}
+	if primary, _ := credentials.Primary(); primary != previous {
+		t.Fatalf("primary after failed rotation = %s", primary.Name())
+	}
+
+	retiresAt, err := credentials.Rotate(context.Background(), next, "admin", func(context.Context, ProxmoxToken) error { return nil })
+	if err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+	if until := time.Until(retiresAt); until <= 0 || until > ProxmoxTokenGracePeriod {
+		t.Fatalf("previous token retires in %v", until)
+	}
+
+	// Proxmox does not know the new token yet: requests fall back to the previous one
+	if status := request(); status != http.StatusOK {
+		t.Fatalf("request during grace period = %d", status)
+	}
+	mu.Lock()
+	accepted[next.AuthHeader()] = true
+	delete(accepted, previous.AuthHeader())
+	used = nil
+	mu.Unlock()
+	if status := request(); status != http.StatusOK {
+		t.Fatalf("request with the new token = %d", status)
+	}
+	mu.Lock()
+	if len(used) != 1 || used[0] != next.AuthHeader() {
+		t.Fatalf("requests used %v, want only the new token", used)
+	}
+	mu.Unlock()
+
+	// Once the grace period is over the previous token is no longer tried
+	credentials.mu.Lock()
+	credentials.standbyUntil = time.Now()
+	credentials.mu.Unlock()
+	credentials.retireStandby()
+	if tokens := credentials.Tokens(); len(tokens) != 1 || tokens[0] != next {
+		t.Fatalf("tokens after grace period = %v", tokens)
+	}
+}
@@ -0,0 +1,222 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// GPU passthrough
+
+// GPU drivers accepted in GPUConfig
+const (
+	GPUDriverVFIOPCI     = "vfio-pci"    // Secondary device for compute workloads
+	GPUDriverPassthrough = "passthrough" // Primary display of the VM (x-vga)
+)
+
+// gpuPCIClassPrefix is the PCI class of VGA-compatible display controllers
+const gpuPCIClassPrefix = "0x0300"
+
+// GPU provisioning errors. CreateVM wraps them so callers can tell a rejected request apart
+// from a provisioning failure.
+var (
+	ErrGPUNotFound = errors.New("GPU not found on the node")
+	ErrGPUInUse    = errors.New("GPU is in use by another VM")
+)
+
+var pciAddressPattern = regexp.MustCompile(`^([0-9a-fA-F]{4}:)?[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$`)
+
+// GPUConfig requests a host GPU passed through to the VM. Passthrough requires the q35 machine
+// type, which CreateVM sets automatically.
+type GPUConfig struct {
+	PCIID  string // Host PCI address, e.g. "0000:01:00.0"
+	Driver string // GPUDriverVFIOPCI (default) or GPUDriverPassthrough
+}
+
+// Validate checks the PCI address and driver and fills in the default driver
+func (c *GPUConfig) Validate() error {
+	c.PCIID = strings.TrimSpace(c.PCIID)
+	if !pciAddressPattern.MatchString(c.PCIID) {
+		return fmt.Errorf("invalid GPU PCI address %q (expected e.g. 0000:01:00.0)", c.PCIID)
+	}
+	switch c.Driver {
+	case "":
+		c.Driver = GPUDriverVFIOPCI
+	case GPUDriverVFIOPCI, GPUDriverPassthrough:
+	default:
+		return fmt.Errorf("invalid GPU driver %q (expected %s or %s)", c.Driver, GPUDriverVFIOPCI, GPUDriverPassthrough)
+	}
+	return nil
+}
+
+// hostPCI returns the Proxmox hostpci0 value for the GPU
+func (c *GPUConfig) hostPCI() string {
+	value := normalizePCIAddress(c.PCIID) + ",pcie=1"
+	if c.Driver == GPUDriverPassthrough {
+		value += ",x-vga=1"
+	}
+	return value
+}
+
+// GPUInfo describes a GPU of a Proxmox node as reported by GET /nodes/{node}/hardware/pci
+type GPUInfo struct {
+	PCIID      string // e.g. "0000:01:00.0"
+	VendorName string
+	DeviceName string
+	IOMMUGroup int  // -1 when IOMMU is disabled on the node
+	InUse      bool // Assigned to a VM of the node
+	VMID       int  // VM the GPU is assigned to when InUse
+}
+
+// ListAvailableGPUs lists the VGA GPUs of a node and whether each one is already passed through to a VM
+func (pc *ProxmoxClient) ListAvailableGPUs(ctx context.Context, nodeName string) ([]*GPUInfo, error) {
+	resp, err := pc.apiRequest(ctx, "GET", fmt.Sprintf("/nodes/%s/hardware/pci", nodeName), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PCI devices: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to list PCI devices: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var pciResp struct {
+		Data []struct {
+			ID         string `json:"id"`
+			Class      string `json:"class"`
+			VendorName string `json:"vendor_name"`
+			DeviceName string `json:"device_name"`
+			IOMMUGroup int    `json:"iommugroup"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&pciResp); err != nil {
+		return nil, fmt.Errorf("failed to decode PCI devices: %w", err)
+	}
+
+	assignments, err := pc.gpuAssignments(ctx, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	gpus := make([]*GPUInfo, 0)
+	for _, device := range pciResp.Data {
+		if !strings.HasPrefix(strings.ToLower(device.Class), gpuPCIClassPrefix) {
+			continue
+		}
+		gpu := &GPUInfo{
+			PCIID:      device.ID,
+			VendorName: device.VendorName,
+			DeviceName: device.DeviceName,
+			IOMMUGroup: device.IOMMUGroup,
+		}
+		gpu.VMID, gpu.InUse = assignedVM(assignments, device.ID)
+		gpus = append(gpus, gpu)
+	}
+	return gpus, nil
+}
+
+// checkGPUAvailable returns ErrGPUNotFound if the requested GPU is not a GPU of the node, or
+// ErrGPUInUse if another VM already has it
+func (pc *ProxmoxClient) checkGPUAvailable(ctx context.Context, nodeName string, gpu *GPUConfig) error {
+	gpus, err := pc.ListAvailableGPUs(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+	for _, candidate := range gpus {
+		if normalizePCIAddress(candidate.PCIID) != normalizePCIAddress(gpu.PCIID) {
+			continue
+		}
+		if candidate.InUse {
+			return fmt.Errorf("%w: %s on node %s is assigned to VM %d", ErrGPUInUse, gpu.PCIID, nodeName, candidate.VMID)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: %s on node %s", ErrGPUNotFound, gpu.PCIID, nodeName)
+}
+
+// gpuAssignments maps the PCI addresses passed through to the node's VMs (hostpciN) to their VM IDs
+func (pc *ProxmoxClient) gpuAssignments(ctx context.Context, nodeName string) (map[string]int, error) {
+	resp, err := pc.apiRequest(ctx, "GET", fmt.Sprintf("/nodes/%s/qemu", nodeName), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VMs: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to list VMs: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var vmsResp struct {
+		Data []struct {
+			Vmid int `json:"vmid"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&vmsResp); err != nil {
+		return nil, fmt.Errorf("failed to decode VMs on node %s: %w", nodeName, err)
+	}
+
+	assignments := make(map[string]int)
+	for _, vm := range vmsResp.Data {
+		vmConfig, err := pc.GetVMConfig(ctx, nodeName, vm.Vmid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get config of VM %d: %w", vm.Vmid, err)
+		}
+		for key, value := range vmConfig {
+			if !strings.HasPrefix(key, "hostpci") {
+				continue
+			}
+			if str, ok := value.(string); ok {
+				if address := hostPCIAddress(str); address != "" {
+					assignments[address] = vm.Vmid
+				}
+			}
+		}
+	}
+	return assignments, nil
+}
+
+// hostPCIAddress returns the normalized host PCI address of a hostpciN value such as
+// "0000:01:00.0,pcie=1" or "host=01:00,x-vga=1". Resource mappings (mapping=...) have no address.
+func hostPCIAddress(value string) string {
+	for _, option := range strings.Split(value, ",") {
+		key, val, hasKey := strings.Cut(option, "=")
+		if !hasKey {
+			return normalizePCIAddress(key)
+		}
+		if key == "host" {
+			return normalizePCIAddress(val)
+		}
+	}
+	return ""
+}
+
+// assignedVM reports which VM a PCI device is assigned to. A hostpci entry without a function
+// (e.g. "01:00") passes through every function of the device.
+func assignedVM(assignments map[string]int, pciID string) (int, bool) {
+	address := normalizePCIAddress(pciID)
+	if vmID, ok := assignments[address]; ok {
+		return vmID, true
+	}
+	if device, _, ok := strings.Cut(address, "."); ok {
+		if vmID, ok := assignments[device]; ok {
+			return vmID, true
+		}
+	}
+	return 0, false
+}
+
+// normalizePCIAddress lowercases a PCI address and adds the default 0000 domain
+func normalizePCIAddress(address string) string {
+	address = strings.ToLower(strings.TrimSpace(address))
+	if strings.Count(address, ":") == 1 {
+		address = "0000:" + address
+	}
+	return address
+}
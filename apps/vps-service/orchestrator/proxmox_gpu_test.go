@@ -0,0 +1,104 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newGPUTestClient serves a node with two GPUs, a NIC and two VMs; VM 101 has the second GPU
+func newGPUTestClient(t *testing.T) *ProxmoxClient {
+	t.Helper()
+	responses := map[string]string{
+		"/api2/json/nodes/pve1/hardware/pci": `{"data":[
+			{"id":"0000:01:00.0","class":"0x030000","vendor_name":"NVIDIA Corporation","device_name":"AD102 [GeForce RTX 4090]","iommugroup":12},
+			{"id":"0000:01:00.1","class":"0x040300","vendor_name":"NVIDIA Corporation","device_name":"AD102 High Definition Audio","iommugroup":12},
+			{"id":"0000:02:00.0","class":"0x030000","vendor_name":"NVIDIA Corporation","device_name":"GA102 [RTX A6000]","iommugroup":13},
+			{"id":"0000:03:00.0","class":"0x020000","vendor_name":"Intel Corporation","device_name":"Ethernet Controller X710","iommugroup":14}
+		]}`,
+		"/api2/json/nodes/pve1/qemu":            `{"data":[{"vmid":100},{"vmid":101}]}`,
+		"/api2/json/nodes/pve1/qemu/100/config": `{"data":{"name":"vps-a","net0":"virtio,bridge=vmbr0"}}`,
+		"/api2/json/nodes/pve1/qemu/101/config": `{"data":{"name":"vps-b","hostpci0":"02:00,pcie=1,x-vga=1"}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewProxmoxClient(&ProxmoxConfig{APIURL: server.URL, TokenID: "obiente@pve!test", Secret: "secret"})
+	if err != nil {
+		t.Fatalf("NewProxmoxClient: %v", err)
+	}
+	return client
+}
+
+func TestListAvailableGPUs(t *testing.T) {
+	client := newGPUTestClient(t)
+
+	gpus, err := client.ListAvailableGPUs(context.Background(), "pve1")
+	if err != nil {
+		t.Fatalf("ListAvailableGPUs: %v", err)
+	}
+	if len(gpus) != 2 {
+		t.Fatalf("expected the 2 VGA devices, got %d", len(gpus))
+	}
+	if gpu := gpus[0]; gpu.PCIID != "0000:01:00.0" || gpu.InUse || gpu.IOMMUGroup != 12 {
+		t.Fatalf("unexpected first GPU: %+v", gpu)
+	}
+	// hostpci0 of VM 101 passes through every function of 02:00
+	if gpu := gpus[1]; gpu.PCIID != "0000:02:00.0" || !gpu.InUse || gpu.VMID != 101 {
+		t.Fatalf("expected the second GPU to be used by VM 101: %+v", gpu)
+	}
+}
+
+func TestCheckGPUAvailable(t *testing.T) {
+	client := newGPUTestClient(t)
+
+	tests := []struct {
+		pciID string
+		want  error
+	}{
+		{"0000:01:00.0", nil},
+		{"01:00.0", nil},
+		{"0000:02:00.0", ErrGPUInUse},
+		{"0000:03:00.0", ErrGPUNotFound}, // Not a GPU
+		{"0000:04:00.0", ErrGPUNotFound},
+	}
+	for _, tt := range tests {
+		err := client.checkGPUAvailable(context.Background(), "pve1", &GPUConfig{PCIID: tt.pciID})
+		if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("checkGPUAvailable(%s) = %v, want %v", tt.pciID, err, tt.want)
+		}
+	}
+}
+
+func TestGPUConfig(t *testing.T) {
+	tests := []struct {
+		config  GPUConfig
+		hostPCI string
+		wantErr bool
+	}{
+		{config: GPUConfig{PCIID: "0000:01:00.0"}, hostPCI: "0000:01:00.0,pcie=1"},
+		{config: GPUConfig{PCIID: "01:00.0", Driver: GPUDriverPassthrough}, hostPCI: "0000:01:00.0,pcie=1,x-vga=1"},
+		{config: GPUConfig{PCIID: "0000:01:00.0", Driver: "nouveau"}, wantErr: true},
+		{config: GPUConfig{PCIID: "0000:01:00.0,x-vga=1"}, wantErr: true},
+		{config: GPUConfig{PCIID: "01:00"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		config := tt.config
+		err := config.Validate()
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("Validate(%+v) = %v, want error %v", tt.config, err, tt.wantErr)
+		}
+		if err == nil && config.hostPCI() != tt.hostPCI {
+			t.Fatalf("hostPCI(%+v) = %s, want %s", tt.config, config.hostPCI(), tt.hostPCI)
+		}
+	}
+}
@@ -0,0 +1,518 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+	"github.com/obiente/cloud/apps/shared/pkg/quota"
+	"gorm.io/gorm"
+)
+
+// ISO uploads and mounting
+
+const (
+	// isoCDROMDrive is the CD-ROM drive uploaded ISOs are inserted into
+	isoCDROMDrive = "ide2"
+	// isoCloudInitDrive takes over the cloud-init drive of VMs cloned from a template with cloud-init on ide2
+	isoCloudInitDrive = "ide3"
+	// isoImportTimeout bounds how long Proxmox may take to move an uploaded ISO into the storage
+	isoImportTimeout = 10 * time.Minute
+	// isoCleanupInterval is how often ISOs of deleted organizations are removed
+	isoCleanupInterval = time.Hour
+)
+
+// ISO errors. Callers check them with errors.Is to pick the response code.
+var (
+	ErrInvalidISOName     = errors.New("ISO names may only contain letters, digits, '.', '_' and '-' and must end in .iso")
+	ErrISOExists          = errors.New("an ISO with this name already exists")
+	ErrISONotFound        = errors.New("ISO not found")
+	ErrISONotOnNode       = errors.New("ISO is not available on the VPS node")
+	ErrCloudInitDriveBusy = errors.New("the cloud-init drive of a running VPS occupies the CD-ROM drive; stop the VPS to mount its first ISO")
+)
+
+var isoNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,99}\.iso$`)
+
+// ValidateISOName checks an ISO name chosen by an organization
+func ValidateISOName(isoName string) error {
+	if !isoNamePattern.MatchString(isoName) {
+		return ErrInvalidISOName
+	}
+	return nil
+}
+
+// ISOStorage returns the Proxmox storage ISOs are uploaded to (PROXMOX_ISO_STORAGE, default "local")
+func ISOStorage() string {
+	if storage := strings.TrimSpace(os.Getenv("PROXMOX_ISO_STORAGE")); storage != "" {
+		return storage
+	}
+	return "local"
+}
+
+// ISONode returns the node ISO uploads go through (PROXMOX_ISO_NODE). Empty uses the first node of the cluster.
+// VPS instances on other nodes can only mount the ISOs when ISOStorage is shared storage.
+func ISONode() string {
+	return strings.TrimSpace(os.Getenv("PROXMOX_ISO_NODE"))
+}
+
+// isoFilename namespaces an organization's ISO in the ISO storage, which all organizations share
+func isoFilename(orgID, isoName string) string {
+	return fmt.Sprintf("%s-%s", orgID, isoName)
+}
+
+// UploadISO streams an ISO into the ISO storage and records it in vps_isos. The upload fails with
+// quota.ErrISOQuotaExceeded as soon as it outgrows the organization's remaining ISO storage.
+func (pc *ProxmoxClient) UploadISO(ctx context.Context, orgID string, isoName string, data io.Reader) error {
+	if err := ValidateISOName(isoName); err != nil {
+		return err
+	}
+	if _, err := database.GetVPSISO(orgID, isoName); err == nil {
+		return ErrISOExists
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to look up ISO: %w", err)
+	}
+
+	remaining, err := quota.RemainingISOStorage(orgID)
+	if err != nil {
+		return fmt.Errorf("failed to check ISO storage quota: %w", err)
+	}
+	if remaining <= 0 {
+		return quota.ErrISOQuotaExceeded
+	}
+
+	nodeName := ISONode()
+	if nodeName == "" {
+		nodes, err := pc.ListNodes(ctx)
+		if err != nil || len(nodes) == 0 {
+			return fmt.Errorf("failed to find Proxmox node: %w", err)
+		}
+		nodeName = nodes[0]
+	}
+	storage := ISOStorage()
+	filename := isoFilename(orgID, isoName)
+
+	size, err := pc.uploadISOFile(ctx, nodeName, storage, filename, data, remaining)
+	if err != nil {
+		return err
+	}
+
+	iso := &database.VPSISO{
+		OrgID:       orgID,
+		ISOName:     isoName,
+		NodeName:    nodeName,
+		StoragePath: fmt.Sprintf("%s:iso/%s", storage, filename),
+		SizeBytes:   size,
+		UploadedAt:  time.Now(),
+	}
+	if err := database.CreateVPSISO(iso); err != nil {
+		// An unrecorded ISO would use storage outside of any quota
+		if deleteErr := pc.DeleteISO(ctx, nodeName, iso.StoragePath); deleteErr != nil {
+			logger.Warn("[ProxmoxClient] Failed to remove unrecorded ISO %s: %v", iso.StoragePath, deleteErr)
+		}
+		return err
+	}
+
+	logger.Info("[ProxmoxClient] Uploaded ISO %s (%d bytes) for organization %s to %s on node %s", isoName, size, orgID, iso.StoragePath, nodeName)
+	return nil
+}
+
+// uploadISOFile streams data as a multipart POST to /nodes/{node}/storage/{storage}/upload and
+// waits for Proxmox to import it. It returns the size of the ISO, which may not exceed limit bytes.
+// Reference: https://pve.proxmox.com/pve-docs/api-viewer/index.html#/nodes/{node}/storage/{storage}/upload
+func (pc *ProxmoxClient) uploadISOFile(ctx context.Context, nodeName, storage, filename string, data io.Reader, limit int64) (int64, error) {
+	if err := pc.ensureAuthenticated(ctx); err != nil {
+		return 0, err
+	}
+
+	body := &isoUploadReader{reader: data, limit: limit}
+	pr, pw := io.Pipe()
+	// Unblocks the writer when Proxmox answers before reading the whole body
+	defer pr.Close()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeISOMultipart(mw, filename, body))
+	}()
+
+	apiURL := strings.TrimSuffix(pc.config.APIURL, "/")
+	reqURL := fmt.Sprintf("%s/api2/json/nodes/%s/storage/%s/upload", apiURL, nodeName, storage)
+	req, err := http.NewRequestWithContext(ctx, "POST", reqURL, pr)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	// The streamed body cannot be sent again, so unlike apiRequest only the primary token is tried
+	if pc.useToken {
+		token, _ := pc.credentials.Primary()
+		req.Header.Set("Authorization", token.AuthHeader())
+	} else {
+		req.AddCookie(&http.Cookie{
+			Name:  "PVEAuthCookie",
+			Value: pc.ticket.Ticket,
+		})
+		req.Header.Set("CSRFPreventionToken", pc.ticket.CSRF)
+	}
+
+	// ISOs take far longer to upload than the client's request timeout; ctx bounds the upload instead
+	uploadClient := &http.Client{Transport: pc.httpClient.Transport}
+	resp, err := uploadClient.Do(req)
+	if body.exceeded.Load() {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return 0, quota.ErrISOQuotaExceeded
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to upload ISO: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return 0, fmt.Errorf("failed to upload ISO: %s (status: %d)", string(respBody), resp.StatusCode)
+	}
+
+	// Proxmox moves the uploaded file into the storage in an imgcopy task
+	var uploadResp struct {
+		Data *string `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&uploadResp); err != nil {
+		return 0, fmt.Errorf("failed to decode upload response: %w", err)
+	}
+	if uploadResp.Data != nil && *uploadResp.Data != "" {
+		if err := pc.waitForTask(ctx, nodeName, *uploadResp.Data, isoImportTimeout); err != nil {
+			return 0, fmt.Errorf("failed to import ISO: %w", err)
+		}
+	}
+
+	return body.read.Load(), nil
+}
+
+// writeISOMultipart writes the upload form: the content type followed by the ISO as the "filename" file
+func writeISOMultipart(mw *multipart.Writer, filename string, data io.Reader) error {
+	if err := mw.WriteField("content", "iso"); err != nil {
+		return err
+	}
+	part, err := mw.CreateFormFile("filename", filename)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, data); err != nil {
+		return err
+	}
+	return mw.Close()
+}
+
+// isoUploadReader counts the bytes of an ISO upload and fails once they exceed limit
+type isoUploadReader struct {
+	reader   io.Reader
+	limit    int64
+	read     atomic.Int64
+	exceeded atomic.Bool
+}
+
+func (r *isoUploadReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if r.read.Add(int64(n)) > r.limit {
+		r.exceeded.Store(true)
+		return n, quota.ErrISOQuotaExceeded
+	}
+	return n, err
+}
+
+// DeleteISO removes an ISO volume (e.g. "local:iso/org-123-debian-12.iso") from its storage
+func (pc *ProxmoxClient) DeleteISO(ctx context.Context, nodeName, volumeID string) error {
+	storage, _, ok := strings.Cut(volumeID, ":")
+	if !ok {
+		return fmt.Errorf("invalid ISO volume %q", volumeID)
+	}
+	endpoint := fmt.Sprintf("/nodes/%s/storage/%s/content/%s", nodeName, storage, url.PathEscape(volumeID))
+	resp, err := pc.apiRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete ISO: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to delete ISO: %s (status: %d)", string(body), resp.StatusCode)
+	}
+	return nil
+}
+
+// MountISO inserts an ISO volume into the VM's CD-ROM drive and puts the drive first in the boot
+// order, so the VM boots the installer on its next start. VMs cloned from a template keep their
+// cloud-init drive on ide2; it moves to ide3 first, which Proxmox only allows while the VM is stopped.
+func (pc *ProxmoxClient) MountISO(ctx context.Context, nodeName string, vmID int, volumeID string) error {
+	vmConfig, err := pc.GetVMConfig(ctx, nodeName, vmID)
+	if err != nil {
+		return fmt.Errorf("failed to get VM config: %w", err)
+	}
+
+	if drive, _ := vmConfig[isoCDROMDrive].(string); strings.Contains(drive, "cloudinit") {
+		if err := pc.moveCloudInitDrive(ctx, nodeName, vmID, vmConfig); err != nil {
+			return err
+		}
+	}
+
+	bootdisk, _ := vmConfig["bootdisk"].(string)
+	boot, _ := vmConfig["boot"].(string)
+	return pc.UpdateVMConfig(ctx, nodeName, vmID, map[string]interface{}{
+		isoCDROMDrive: volumeID + ",media=cdrom",
+		"boot":        bootOrderWithCDROM(boot, bootdisk),
+	})
+}
+
+// UnmountISO ejects the ISO from the VM's CD-ROM drive and removes the drive from the boot order
+func (pc *ProxmoxClient) UnmountISO(ctx context.Context, nodeName string, vmID int) error {
+	vmConfig, err := pc.GetVMConfig(ctx, nodeName, vmID)
+	if err != nil {
+		return fmt.Errorf("failed to get VM config: %w", err)
+	}
+
+	drive, _ := vmConfig[isoCDROMDrive].(string)
+	if drive == "" || strings.Contains(drive, "cloudinit") {
+		// Nothing was ever mounted
+		return nil
+	}
+
+	update := map[string]interface{}{
+		isoCDROMDrive: "none,media=cdrom",
+	}
+	boot, _ := vmConfig["boot"].(string)
+	if order := bootOrderWithoutCDROM(boot); order != "" {
+		update["boot"] = order
+	}
+	return pc.UpdateVMConfig(ctx, nodeName, vmID, update)
+}
+
+// moveCloudInitDrive recreates the cloud-init drive on ide3 to free ide2 for ISOs. Proxmox
+// regenerates the drive's contents from the VM config, so nothing is lost.
+func (pc *ProxmoxClient) moveCloudInitDrive(ctx context.Context, nodeName string, vmID int, vmConfig map[string]interface{}) error {
+	if _, taken := vmConfig[isoCloudInitDrive]; taken {
+		return fmt.Errorf("cannot move the cloud-init drive: %s is already in use", isoCloudInitDrive)
+	}
+	status, err := pc.GetVMStatus(ctx, nodeName, vmID)
+	if err != nil {
+		return fmt.Errorf("failed to get VM status: %w", err)
+	}
+	if status != "stopped" {
+		return ErrCloudInitDriveBusy
+	}
+
+	drive, _ := vmConfig[isoCDROMDrive].(string)
+	storage, _, ok := strings.Cut(drive, ":")
+	if !ok {
+		return fmt.Errorf("unexpected cloud-init drive %q", drive)
+	}
+	// The new drive reuses the volume name, so the old one has to go first
+	if err := pc.UpdateVMConfig(ctx, nodeName, vmID, map[string]interface{}{"delete": isoCDROMDrive}); err != nil {
+		return fmt.Errorf("failed to remove the cloud-init drive from %s: %w", isoCDROMDrive, err)
+	}
+	if err := pc.UpdateVMConfig(ctx, nodeName, vmID, map[string]interface{}{isoCloudInitDrive: storage + ":cloudinit"}); err != nil {
+		return fmt.Errorf("failed to create the cloud-init drive on %s: %w", isoCloudInitDrive, err)
+	}
+	logger.Info("[ProxmoxClient] Moved the cloud-init drive of VM %d from %s to %s", vmID, isoCDROMDrive, isoCloudInitDrive)
+	return nil
+}
+
+// bootOrderDevices returns the devices of a "order=scsi0;net0" boot value. Legacy values
+// ("cdn", empty) yield nil.
+func bootOrderDevices(boot string) []string {
+	for _, option := range strings.Split(boot, ",") {
+		if order, ok := strings.CutPrefix(strings.TrimSpace(option), "order="); ok {
+			return strings.Split(order, ";")
+		}
+	}
+	return nil
+}
+
+// bootOrderWithCDROM puts the CD-ROM drive first in the boot order. Legacy boot values are
+// replaced with the CD-ROM drive followed by the boot disk.
+func bootOrderWithCDROM(boot, bootdisk string) string {
+	devices := []string{isoCDROMDrive}
+	existing := bootOrderDevices(boot)
+	if existing == nil && bootdisk != "" {
+		existing = []string{bootdisk}
+	}
+	for _, device := range existing {
+		if device != isoCDROMDrive && device != "" {
+			devices = append(devices, device)
+		}
+	}
+	return "order=" + strings.Join(devices, ";")
+}
+
+// bootOrderWithoutCDROM removes the CD-ROM drive from the boot order. It returns "" when the boot
+// order has no other devices, in which case it is left as it is.
+func bootOrderWithoutCDROM(boot string) string {
+	var devices []string
+	for _, device := range bootOrderDevices(boot) {
+		if device != isoCDROMDrive && device != "" {
+			devices = append(devices, device)
+		}
+	}
+	if len(devices) == 0 {
+		return ""
+	}
+	return "order=" + strings.Join(devices, ";")
+}
+
+// UploadISO uploads an ISO of an organization through the ISO node
+func (vm *VPSManager) UploadISO(ctx context.Context, orgID string, isoName string, data io.Reader) error {
+	proxmoxClient, err := vm.GetProxmoxClientForNode(ISONode())
+	if err != nil {
+		return fmt.Errorf("failed to get Proxmox client for ISO uploads: %w", err)
+	}
+	return proxmoxClient.UploadISO(ctx, orgID, isoName, data)
+}
+
+// DeleteISO removes an ISO of an organization from the ISO storage and from vps_isos
+func (vm *VPSManager) DeleteISO(ctx context.Context, orgID, isoName string) error {
+	iso, err := database.GetVPSISO(orgID, isoName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrISONotFound
+		}
+		return fmt.Errorf("failed to get ISO: %w", err)
+	}
+	return vm.deleteISO(ctx, iso)
+}
+
+func (vm *VPSManager) deleteISO(ctx context.Context, iso *database.VPSISO) error {
+	proxmoxClient, err := vm.GetProxmoxClientForNode(iso.NodeName)
+	if err != nil {
+		return fmt.Errorf("failed to get Proxmox client for node %s: %w", iso.NodeName, err)
+	}
+	if err := proxmoxClient.DeleteISO(ctx, iso.NodeName, iso.StoragePath); err != nil {
+		// An ISO removed from the storage by hand only leaves its record behind
+		if !strings.Contains(err.Error(), "does not exist") {
+			return err
+		}
+		logger.Warn("[VPSManager] ISO %s of organization %s was already removed from the storage", iso.StoragePath, iso.OrgID)
+	}
+	if _, err := database.DeleteVPSISO(iso.OrgID, iso.ISOName); err != nil {
+		return err
+	}
+	logger.Info("[VPSManager] Deleted ISO %s of organization %s (%s)", iso.ISOName, iso.OrgID, iso.StoragePath)
+	return nil
+}
+
+// MountISO inserts an ISO of the VPS's organization into the VPS's CD-ROM drive
+func (vm *VPSManager) MountISO(ctx context.Context, vpsID, isoName string) error {
+	vps, proxmoxClient, nodeName, vmID, err := vm.vpsProxmoxVM(ctx, vpsID)
+	if err != nil {
+		return err
+	}
+
+	iso, err := database.GetVPSISO(vps.OrganizationID, isoName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrISONotFound
+		}
+		return fmt.Errorf("failed to get ISO: %w", err)
+	}
+	if iso.NodeName != nodeName {
+		// Node-local storage (e.g. "local") only holds the ISO on the node it was uploaded through
+		storage, _, _ := strings.Cut(iso.StoragePath, ":")
+		info, err := proxmoxClient.getStorageInfo(ctx, nodeName, storage)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrISONotOnNode, err)
+		}
+		if shared, _ := info["shared"].(float64); shared != 1 {
+			return fmt.Errorf("%w: storage %s of node %s is not shared with node %s", ErrISONotOnNode, storage, iso.NodeName, nodeName)
+		}
+	}
+
+	if err := proxmoxClient.MountISO(ctx, nodeName, vmID, iso.StoragePath); err != nil {
+		return err
+	}
+	logger.Info("[VPSManager] Mounted ISO %s on VPS %s (VM %d on node %s)", iso.StoragePath, vpsID, vmID, nodeName)
+	return nil
+}
+
+// UnmountISO ejects the ISO from the VPS's CD-ROM drive
+func (vm *VPSManager) UnmountISO(ctx context.Context, vpsID string) error {
+	_, proxmoxClient, nodeName, vmID, err := vm.vpsProxmoxVM(ctx, vpsID)
+	if err != nil {
+		return err
+	}
+	if err := proxmoxClient.UnmountISO(ctx, nodeName, vmID); err != nil {
+		return err
+	}
+	logger.Info("[VPSManager] Unmounted ISO from VPS %s (VM %d on node %s)", vpsID, vmID, nodeName)
+	return nil
+}
+
+// vpsProxmoxVM loads a VPS and resolves the Proxmox client, node and VMID of its VM
+func (vm *VPSManager) vpsProxmoxVM(ctx context.Context, vpsID string) (*database.VPSInstance, *ProxmoxClient, string, int, error) {
+	var vps database.VPSInstance
+	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
+		return nil, nil, "", 0, fmt.Errorf("VPS not found: %w", err)
+	}
+	if vps.InstanceID == nil {
+		return nil, nil, "", 0, fmt.Errorf("VPS has no instance ID")
+	}
+
+	nodeName := ""
+	if vps.NodeID != nil && *vps.NodeID != "" {
+		nodeName = *vps.NodeID
+	}
+	proxmoxClient, err := vm.GetProxmoxClientForNode(nodeName)
+	if err != nil {
+		return nil, nil, "", 0, fmt.Errorf("failed to get Proxmox client for node %s: %w", nodeName, err)
+	}
+
+	vmIDInt := 0
+	fmt.Sscanf(*vps.InstanceID, "%d", &vmIDInt)
+	if vmIDInt == 0 {
+		return nil, nil, "", 0, fmt.Errorf("invalid VM ID: %s", *vps.InstanceID)
+	}
+
+	if nodeName == "" {
+		if nodeName, err = proxmoxClient.FindVMNode(ctx, vmIDInt); err != nil {
+			return nil, nil, "", 0, fmt.Errorf("failed to find Proxmox node of VM %d: %w", vmIDInt, err)
+		}
+	}
+	return &vps, proxmoxClient, nodeName, vmIDInt, nil
+}
+
+// StartISOCleanup periodically removes the ISOs of deleted organizations from the ISO storage
+func (vm *VPSManager) StartISOCleanup(ctx context.Context) {
+	ticker := time.NewTicker(isoCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		vm.cleanupOrphanedISOs(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (vm *VPSManager) cleanupOrphanedISOs(ctx context.Context) {
+	isos, err := database.ListOrphanedVPSISOs()
+	if err != nil {
+		logger.Warn("[ISOCleanup] Failed to list ISOs of deleted organizations: %v", err)
+		return
+	}
+	for i := range isos {
+		if err := vm.deleteISO(ctx, &isos[i]); err != nil {
+			logger.Warn("[ISOCleanup] Failed to delete ISO %s of deleted organization %s: %v", isos[i].StoragePath, isos[i].OrgID, err)
+		}
+	}
+}
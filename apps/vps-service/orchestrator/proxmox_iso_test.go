@@ -0,0 +1,208 @@
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/obiente/cloud/apps/shared/pkg/quota"
+)
+
+func newISOTestClient(t *testing.T, handler http.HandlerFunc) *ProxmoxClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewProxmoxClient(&ProxmoxConfig{APIURL: server.URL, TokenID: "obiente@pve!test", Secret: "secret"})
+	if err != nil {
+		t.Fatalf("NewProxmoxClient: %v", err)
+	}
+	return client
+}
+
+func TestUploadISOFileStreamsMultipart(t *testing.T) {
+	iso := bytes.Repeat([]byte("ISO9660"), 4096)
+	var content, filename string
+	var received []byte
+
+	client := newISOTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api2/json/nodes/pve1/storage/local/upload":
+			if r.Header.Get("Authorization") == "" {
+				http.Error(w, "no token", http.StatusUnauthorized)
+				return
+			}
+			reader, err := r.MultipartReader()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			for {
+				part, err := reader.NextPart()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				data, _ := io.ReadAll(part)
+				switch part.FormName() {
+				case "content":
+					content = string(data)
+				case "filename":
+					filename, received = part.FileName(), data
+				}
+			}
+			w.Write([]byte(`{"data":"UPID:pve1:0001:imgcopy"}`))
+		case "/api2/json/nodes/pve1/tasks/UPID:pve1:0001:imgcopy/status":
+			w.Write([]byte(`{"data":{"status":"stopped","exitstatus":"OK"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	size, err := client.uploadISOFile(context.Background(), "pve1", "local", "org-a-debian.iso", bytes.NewReader(iso), int64(len(iso)))
+	if err != nil {
+		t.Fatalf("uploadISOFile: %v", err)
+	}
+	if size != int64(len(iso)) {
+		t.Fatalf("size = %d, want %d", size, len(iso))
+	}
+	if content != "iso" || filename != "org-a-debian.iso" || !bytes.Equal(received, iso) {
+		t.Fatalf("upload form: content=%q filename=%q, %d of %d bytes", content, filename, len(received), len(iso))
+	}
+}
+
+func TestUploadISOFileStopsAtQuota(t *testing.T) {
+	client := newISOTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.Write([]byte(`{"data":null}`))
+	})
+
+	_, err := client.uploadISOFile(context.Background(), "pve1", "local", "org-a-big.iso", bytes.NewReader(make([]byte, 64*1024)), 1024)
+	if !errors.Is(err, quota.ErrISOQuotaExceeded) {
+		t.Fatalf("uploadISOFile beyond the limit = %v, want %v", err, quota.ErrISOQuotaExceeded)
+	}
+}
+
+func TestValidateISOName(t *testing.T) {
+	for _, name := range []string{"debian-12.5.0-amd64-netinst.iso", "win_server_2022.iso"} {
+		if err := ValidateISOName(name); err != nil {
+			t.Errorf("ValidateISOName(%q) = %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "debian.img", "../etc/passwd.iso", "my iso.iso", ".hidden.iso"} {
+		if err := ValidateISOName(name); err == nil {
+			t.Errorf("ValidateISOName(%q) accepted an invalid name", name)
+		}
+	}
+}
+
+func TestBootOrderWithCDROM(t *testing.T) {
+	tests := []struct {
+		boot, bootdisk, want string
+	}{
+		{"order=scsi0;net0", "", "order=ide2;scsi0;net0"},
+		{"order=ide2;scsi0", "", "order=ide2;scsi0"},
+		{"order=scsi0;ide2;net0", "", "order=ide2;scsi0;net0"},
+		{"cdn", "virtio0", "order=ide2;virtio0"},
+		{"", "", "order=ide2"},
+	}
+	for _, tt := range tests {
+		if got := bootOrderWithCDROM(tt.boot, tt.bootdisk); got != tt.want {
+			t.Errorf("bootOrderWithCDROM(%q, %q) = %q, want %q", tt.boot, tt.bootdisk, got, tt.want)
+		}
+	}
+
+	if got := bootOrderWithoutCDROM("order=ide2;scsi0;net0"); got != "order=scsi0;net0" {
+		t.Errorf("bootOrderWithoutCDROM = %q, want order=scsi0;net0", got)
+	}
+	if got := bootOrderWithoutCDROM("order=ide2"); got != "" {
+		t.Errorf("bootOrderWithoutCDROM of a CD-ROM-only order = %q, want it left alone", got)
+	}
+}
+
+// isoVMServer fakes VM 100 on pve1 and records the config updates it receives
+type isoVMServer struct {
+	mu      sync.Mutex
+	status  string
+	config  string
+	updates []url.Values
+}
+
+func (s *isoVMServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case r.URL.Path == "/api2/json/nodes/pve1/qemu/100/status/current":
+		w.Write([]byte(`{"data":{"status":"` + s.status + `"}}`))
+	case r.URL.Path == "/api2/json/nodes/pve1/qemu/100/config" && r.Method == "GET":
+		w.Write([]byte(s.config))
+	case r.URL.Path == "/api2/json/nodes/pve1/qemu/100/config" && r.Method == "PUT":
+		r.ParseForm()
+		s.updates = append(s.updates, r.PostForm)
+		w.Write([]byte(`{"data":null}`))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestMountISOMovesCloudInitDrive(t *testing.T) {
+	vm := &isoVMServer{
+		status: "stopped",
+		config: `{"data":{"scsi0":"local-lvm:vm-100-disk-0","ide2":"local-lvm:vm-100-cloudinit,media=cdrom","boot":"order=scsi0;net0"}}`,
+	}
+	client := newISOTestClient(t, vm.ServeHTTP)
+
+	if err := client.MountISO(context.Background(), "pve1", 100, "local:iso/org-a-debian.iso"); err != nil {
+		t.Fatalf("MountISO: %v", err)
+	}
+	if len(vm.updates) != 3 {
+		t.Fatalf("config updates = %v, want the cloud-init move and the mount", vm.updates)
+	}
+	if vm.updates[0].Get("delete") != "ide2" || vm.updates[1].Get("ide3") != "local-lvm:cloudinit" {
+		t.Fatalf("cloud-init drive not moved to ide3: %v", vm.updates[:2])
+	}
+	mount := vm.updates[2]
+	if mount.Get("ide2") != "local:iso/org-a-debian.iso,media=cdrom" || mount.Get("boot") != "order=ide2;scsi0;net0" {
+		t.Fatalf("mount update = %v", mount)
+	}
+
+	// A running VM cannot give up its cloud-init drive
+	vm.status, vm.updates = "running", nil
+	if err := client.MountISO(context.Background(), "pve1", 100, "local:iso/org-a-debian.iso"); !errors.Is(err, ErrCloudInitDriveBusy) {
+		t.Fatalf("MountISO on a running VM = %v, want %v", err, ErrCloudInitDriveBusy)
+	}
+	if len(vm.updates) != 0 {
+		t.Fatalf("running VM was updated: %v", vm.updates)
+	}
+}
+
+func TestUnmountISO(t *testing.T) {
+	vm := &isoVMServer{
+		status: "running",
+		config: `{"data":{"ide2":"local:iso/org-a-debian.iso,media=cdrom","ide3":"local-lvm:vm-100-cloudinit,media=cdrom","boot":"order=ide2;scsi0;net0"}}`,
+	}
+	client := newISOTestClient(t, vm.ServeHTTP)
+
+	if err := client.UnmountISO(context.Background(), "pve1", 100); err != nil {
+		t.Fatalf("UnmountISO: %v", err)
+	}
+	if len(vm.updates) != 1 || vm.updates[0].Get("ide2") != "none,media=cdrom" || vm.updates[0].Get("boot") != "order=scsi0;net0" {
+		t.Fatalf("unmount updates = %v", vm.updates)
+	}
+
+	// Nothing to eject while the cloud-init drive is still on ide2
+	vm.config = `{"data":{"ide2":"local-lvm:vm-100-cloudinit,media=cdrom","boot":"order=scsi0"}}`
+	vm.updates = nil
+	if err := client.UnmountISO(context.Background(), "pve1", 100); err != nil || len(vm.updates) != 0 {
+		t.Fatalf("UnmountISO without an ISO = %v, updates %v", err, vm.updates)
+	}
+}
@@ -0,0 +1,262 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+// Node capacity and VM migration operations
+
+// migrationTaskTimeout bounds how long we wait for a Proxmox migration task.
+// Live migrations copy the full memory of the guest, so this is generous.
+const migrationTaskTimeout = 30 * time.Minute
+
+// Migration preflight errors. MigrateVM wraps them so callers can tell a
+// rejected request apart from a migration that failed in Proxmox.
+var (
+	ErrMigrationSameNode           = errors.New("VM is already on the target node")
+	ErrMigrationTargetNotFound     = errors.New("target node not found in Proxmox cluster")
+	ErrMigrationTargetOffline      = errors.New("target node is not online")
+	ErrMigrationInsufficientMemory = errors.New("target node does not have enough free memory")
+)
+
+// ErrTaskTimeout is returned by WaitForTask when a Proxmox task is still running at the timeout
+var ErrTaskTimeout = errors.New("timeout waiting for Proxmox task to complete")
+
+// ProxmoxNodeCapacity describes the resource usage of a Proxmox node as reported by GET /nodes
+type ProxmoxNodeCapacity struct {
+	Node    string
+	Status  string // "online", "offline", "unknown"
+	MaxMem  int64  // Total memory in bytes
+	Mem     int64  // Used memory in bytes
+	MaxCPU  int
+	CPU     float64 // CPU usage (0.0 - 1.0)
+	MaxDisk int64   // Root filesystem size in bytes
+	Disk    int64   // Root filesystem usage in bytes
+}
+
+// FreeMemory returns the memory in bytes that is not currently used on the node
+func (n ProxmoxNodeCapacity) FreeMemory() int64 {
+	if n.MaxMem <= n.Mem {
+		return 0
+	}
+	return n.MaxMem - n.Mem
+}
+
+// ListNodesWithCapacity lists all Proxmox nodes along with their memory and CPU capacity
+func (pc *ProxmoxClient) ListNodesWithCapacity(ctx context.Context) ([]ProxmoxNodeCapacity, error) {
+	resp, err := pc.apiRequest(ctx, "GET", "/nodes", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to list nodes: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var nodesResp struct {
+		Data []struct {
+			Node    string  `json:"node"`
+			Status  string  `json:"status"`
+			MaxMem  int64   `json:"maxmem"`
+			Mem     int64   `json:"mem"`
+			MaxCPU  int     `json:"maxcpu"`
+			CPU     float64 `json:"cpu"`
+			MaxDisk int64   `json:"maxdisk"`
+			Disk    int64   `json:"disk"`
+		} `json:"data"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&nodesResp); err != nil {
+		return nil, fmt.Errorf("failed to decode nodes response: %w", err)
+	}
+
+	nodes := make([]ProxmoxNodeCapacity, len(nodesResp.Data))
+	for i, n := range nodesResp.Data {
+		nodes[i] = ProxmoxNodeCapacity{
+			Node:    n.Node,
+			Status:  n.Status,
+			MaxMem:  n.MaxMem,
+			Mem:     n.Mem,
+			MaxCPU:  n.MaxCPU,
+			CPU:     n.CPU,
+			MaxDisk: n.MaxDisk,
+			Disk:    n.Disk,
+		}
+	}
+
+	return nodes, nil
+}
+
+// MigrateVM migrates the VM backing a VPS to another Proxmox node.
+// When live is true the VM is migrated online (online=1) without being stopped.
+// The call blocks until the Proxmox migration task finishes and then records the new node in the database.
+// onStarted, if set, is called once the preflight checks passed and Proxmox accepted the migration task.
+// Reference: https://pve.proxmox.com/pve-docs/api-viewer/index.html#/nodes/{node}/qemu/{vmid}/migrate
+func (pc *ProxmoxClient) MigrateVM(ctx context.Context, vpsID, targetNode string, live bool, onStarted func()) error {
+	if targetNode == "" {
+		return fmt.Errorf("target node is required")
+	}
+
+	var vps database.VPSInstance
+	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
+		return fmt.Errorf("VPS not found: %w", err)
+	}
+
+	if vps.InstanceID == nil {
+		return fmt.Errorf("VPS has no instance ID")
+	}
+
+	vmIDInt := 0
+	fmt.Sscanf(*vps.InstanceID, "%d", &vmIDInt)
+	if vmIDInt == 0 {
+		return fmt.Errorf("invalid VM ID: %s", *vps.InstanceID)
+	}
+
+	// Resolve the node the VM currently lives on
+	sourceNode := ""
+	if vps.NodeID != nil && *vps.NodeID != "" {
+		sourceNode = *vps.NodeID
+	} else {
+		node, err := pc.FindVMNode(ctx, vmIDInt)
+		if err != nil {
+			return fmt.Errorf("failed to find Proxmox node for VM %d: %w", vmIDInt, err)
+		}
+		sourceNode = node
+	}
+
+	if sourceNode == targetNode {
+		return fmt.Errorf("VM %d on node %s: %w", vmIDInt, targetNode, ErrMigrationSameNode)
+	}
+
+	// Make sure the target node can actually hold the VM before asking Proxmox to move it
+	nodes, err := pc.ListNodesWithCapacity(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check target node capacity: %w", err)
+	}
+	var target *ProxmoxNodeCapacity
+	for i := range nodes {
+		if nodes[i].Node == targetNode {
+			target = &nodes[i]
+			break
+		}
+	}
+	if target == nil {
+		return fmt.Errorf("%w: %s", ErrMigrationTargetNotFound, targetNode)
+	}
+	if target.Status != "" && target.Status != "online" {
+		return fmt.Errorf("%w: %s (status: %s)", ErrMigrationTargetOffline, targetNode, target.Status)
+	}
+	if free := target.FreeMemory(); free < vps.MemoryBytes {
+		return fmt.Errorf("%w: %s has %d bytes available, %d bytes required", ErrMigrationInsufficientMemory, targetNode, free, vps.MemoryBytes)
+	}
+
+	endpoint := fmt.Sprintf("/nodes/%s/qemu/%d/migrate", sourceNode, vmIDInt)
+	formData := url.Values{}
+	formData.Set("target", targetNode)
+	if live {
+		formData.Set("online", "1")
+		// Local disks must be copied along with the guest when the storage is not shared
+		formData.Set("with-local-disks", "1")
+	}
+
+	logger.Info("[ProxmoxClient] Migrating VM %d from node %s to node %s (live: %v)", vmIDInt, sourceNode, targetNode, live)
+
+	resp, err := pc.apiRequestForm(ctx, "POST", endpoint, formData)
+	if err != nil {
+		return fmt.Errorf("failed to migrate VM: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to migrate VM: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var migrateResp struct {
+		Data string `json:"data"` // UPID of the migration task
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&migrateResp); err != nil {
+		return fmt.Errorf("failed to decode migrate response: %w", err)
+	}
+	if migrateResp.Data == "" {
+		return fmt.Errorf("Proxmox did not return a task ID for the migration")
+	}
+	if onStarted != nil {
+		onStarted()
+	}
+
+	if err := pc.waitForTask(ctx, sourceNode, migrateResp.Data, migrationTaskTimeout); err != nil {
+		return fmt.Errorf("migration of VM %d to node %s failed: %w", vmIDInt, targetNode, err)
+	}
+
+	if err := database.DB.Model(&database.VPSInstance{}).
+		Where("id = ?", vps.ID).
+		Updates(map[string]interface{}{
+			"node_id":    targetNode,
+			"updated_at": time.Now(),
+		}).Error; err != nil {
+		return fmt.Errorf("VM migrated but failed to update VPS node: %w", err)
+	}
+
+	logger.Info("[ProxmoxClient] Successfully migrated VM %d to node %s", vmIDInt, targetNode)
+	return nil
+}
+
+// waitForTask polls a Proxmox task until it stops and returns an error if it did not exit with "OK"
+func (pc *ProxmoxClient) waitForTask(ctx context.Context, nodeName, upid string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	endpoint := fmt.Sprintf("/nodes/%s/tasks/%s/status", nodeName, url.PathEscape(upid))
+	for time.Now().Before(deadline) {
+		resp, err := pc.apiRequest(ctx, "GET", endpoint, nil)
+		if err != nil {
+			logger.Debug("[ProxmoxClient] Failed to get task %s status: %v", upid, err)
+		} else {
+			var taskResp struct {
+				Data struct {
+					Status     string `json:"status"`     // "running" or "stopped"
+					ExitStatus string `json:"exitstatus"` // "OK" on success, error message otherwise
+				} `json:"data"`
+			}
+			decodeErr := json.NewDecoder(resp.Body).Decode(&taskResp)
+			resp.Body.Close()
+			if decodeErr != nil {
+				logger.Debug("[ProxmoxClient] Failed to decode task %s status: %v", upid, decodeErr)
+			} else if taskResp.Data.Status == "stopped" {
+				if taskResp.Data.ExitStatus != "OK" {
+					return fmt.Errorf("task %s failed: %s", upid, taskResp.Data.ExitStatus)
+				}
+				return nil
+			}
+		}
+
+		select {
+		case <-ticker.C:
+			continue
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrTaskTimeout, upid)
+}
+
+// WaitForTask polls a Proxmox task until it stops. It returns ErrTaskTimeout if the task is still
+// running after timeout, or ctx's error if ctx ends first.
+func (pc *ProxmoxClient) WaitForTask(ctx context.Context, nodeName, upid string, timeout time.Duration) error {
+	return pc.waitForTask(ctx, nodeName, upid, timeout)
+}
@@ -0,0 +1,196 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+// patchIDPattern restricts patch IDs to characters that are safe in file names and shell commands
+var patchIDPattern = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+// patchStateDir holds the patch scripts and their status files inside the guest
+const patchStateDir = "/var/lib/obiente"
+
+// PatchLogPath is the guest file the output of patch run patchID is written to
+func PatchLogPath(patchID string) string {
+	return fmt.Sprintf("/var/log/obiente-patch-%s.log", patchID)
+}
+
+// PatchStatusPath is the guest file written when patch run patchID finishes
+func PatchStatusPath(patchID string) string {
+	return fmt.Sprintf("%s/patch-%s.status", patchStateDir, patchID)
+}
+
+// patchScript upgrades all packages with the package manager of the distribution named in
+// /etc/os-release, then records the exit code and package manager in the status file
+func patchScript(patchID string) string {
+	status := PatchStatusPath(patchID)
+	return `#!/bin/sh
+# Obiente Cloud OS patch run ` + patchID + `
+pm=""
+if [ -r /etc/os-release ]; then
+  . /etc/os-release
+fi
+case " ${ID:-} ${ID_LIKE:-} " in
+  *" debian "*|*" ubuntu "*) pm=apt-get ;;
+  *" fedora "*|*" rhel "*|*" centos "*)
+    if command -v dnf >/dev/null 2>&1; then pm=dnf; else pm=yum; fi ;;
+esac
+if [ -z "$pm" ]; then
+  for candidate in apt-get dnf yum; do
+    if command -v "$candidate" >/dev/null 2>&1; then pm=$candidate; break; fi
+  done
+fi
+echo "Patching ${PRETTY_NAME:-unknown OS} with ${pm:-no package manager}"
+case "$pm" in
+  apt-get)
+    export DEBIAN_FRONTEND=noninteractive
+    apt-get -o DPkg::Lock::Timeout=600 update &&
+      apt-get -o DPkg::Lock::Timeout=600 -o Dpkg::Options::=--force-confdef -o Dpkg::Options::=--force-confold -y upgrade
+    ;;
+  dnf) dnf -y upgrade --refresh ;;
+  yum) yum -y update ;;
+  *) echo "No supported package manager (apt-get, dnf or yum) found"; false ;;
+esac
+code=$?
+printf 'exit=%s\npm=%s\n' "$code" "$pm" > "` + status + `.tmp" && mv "` + status + `.tmp" "` + status + `"
+`
+}
+
+// PatchVendorData returns cloud-init vendor-data that runs the OS patch script of patchID on the next boot.
+// cloud-init-per keeps the script from running again on later boots, and the script runs detached
+// so it does not hold up the boot.
+func PatchVendorData(patchID string) (string, error) {
+	if !patchIDPattern.MatchString(patchID) {
+		return "", fmt.Errorf("invalid patch ID %q", patchID)
+	}
+	scriptPath := fmt.Sprintf("%s/patch-%s.sh", patchStateDir, patchID)
+	script := base64.StdEncoding.EncodeToString([]byte(patchScript(patchID)))
+	command := fmt.Sprintf("mkdir -p %s && echo %s | base64 -d > %s && (setsid sh %s > %s 2>&1 < /dev/null &)",
+		patchStateDir, script, scriptPath, scriptPath, PatchLogPath(patchID))
+
+	return fmt.Sprintf("#cloud-config\nbootcmd:\n  - [cloud-init-per, once, obiente-patch-%s, sh, -c, %s]\n",
+		patchID, strconv.Quote(command)), nil
+}
+
+// PatchStatusCommand returns a guest shell command that prints nothing while patch run patchID
+// is still running, and the status file, a "---" line and the end of the log once it finished
+func PatchStatusCommand(patchID string) string {
+	status := PatchStatusPath(patchID)
+	return fmt.Sprintf("if [ -f %s ]; then cat %s; echo ---; tail -c 65536 %s 2>/dev/null; fi", status, status, PatchLogPath(patchID))
+}
+
+// PatchRunStatus is the outcome of a finished patch run, as read from the guest
+type PatchRunStatus struct {
+	ExitCode       int
+	PackageManager string
+	Output         string
+}
+
+// ParsePatchStatus parses the output of PatchStatusCommand. ok is false while the run has not finished.
+func ParsePatchStatus(output string) (status PatchRunStatus, ok bool) {
+	header, log, found := strings.Cut(output, "---\n")
+	if !found {
+		return PatchRunStatus{}, false
+	}
+	exitSeen := false
+	for _, line := range strings.Split(header, "\n") {
+		key, value, _ := strings.Cut(strings.TrimSpace(line), "=")
+		switch key {
+		case "exit":
+			code, err := strconv.Atoi(value)
+			if err != nil {
+				return PatchRunStatus{}, false
+			}
+			status.ExitCode = code
+			exitSeen = true
+		case "pm":
+			status.PackageManager = value
+		}
+	}
+	status.Output = log
+	return status, exitSeen
+}
+
+// withVendorData replaces the vendor= entry of a cicustom value, keeping the user, network and meta entries
+func withVendorData(cicustom, vendorRef string) string {
+	var entries []string
+	for _, entry := range strings.Split(cicustom, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "vendor=") {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return strings.Join(append(entries, "vendor="+vendorRef), ",")
+}
+
+// snippetStorageFromCicustom returns the storage of the user= snippet, falling back to the configured snippet storage
+func snippetStorageFromCicustom(cicustom string) string {
+	for _, entry := range strings.Split(cicustom, ",") {
+		if ref, ok := strings.CutPrefix(strings.TrimSpace(entry), "user="); ok {
+			if storage, _, found := strings.Cut(ref, ":"); found && storage != "" {
+				return storage
+			}
+		}
+	}
+	if storage := os.Getenv("PROXMOX_SNIPPET_STORAGE"); storage != "" {
+		return storage
+	}
+	if storage := os.Getenv("PROXMOX_STORAGE_POOL"); storage != "" {
+		return storage
+	}
+	return "local"
+}
+
+// AttachVendorData writes vendorData to the VM's vendor-data snippet and adds it to the VM's cicustom.
+// Proxmox regenerates the cloud-init drive when the VM starts, so the vendor-data applies from the next start.
+// It returns the previous cicustom value so it can be restored with RestoreCicustom.
+func (pc *ProxmoxClient) AttachVendorData(ctx context.Context, nodeName string, vmID int, vendorData string) (string, error) {
+	vmConfig, err := pc.GetVMConfig(ctx, nodeName, vmID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get VM config: %w", err)
+	}
+	previous, _ := vmConfig["cicustom"].(string)
+
+	storage := snippetStorageFromCicustom(previous)
+	filename := fmt.Sprintf("vm-%d-vendor-data", vmID)
+	if _, err := pc.writeSnippetViaSSH(ctx, nodeName, storage, filename, vendorData); err != nil {
+		return "", fmt.Errorf("failed to write vendor-data snippet: %w", err)
+	}
+	if err := pc.UpdateVMCicustom(ctx, nodeName, vmID, withVendorData(previous, fmt.Sprintf("%s:snippets/%s", storage, filename))); err != nil {
+		return "", err
+	}
+	return previous, nil
+}
+
+// RestoreCicustom sets the VM's cicustom back to a value returned by AttachVendorData, removing it when that was empty
+func (pc *ProxmoxClient) RestoreCicustom(ctx context.Context, nodeName string, vmID int, cicustom string) error {
+	if cicustom != "" {
+		return pc.UpdateVMCicustom(ctx, nodeName, vmID, cicustom)
+	}
+
+	endpoint := fmt.Sprintf("/nodes/%s/qemu/%d/config", nodeName, vmID)
+	resp, err := pc.apiRequestForm(ctx, "PUT", endpoint, url.Values{"delete": {"cicustom"}})
+	if err != nil {
+		return fmt.Errorf("failed to remove cicustom: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to remove cicustom: %s (status: %d)", string(body), resp.StatusCode)
+	}
+	logger.Info("[ProxmoxClient] Removed cicustom from VM %d", vmID)
+	return nil
+}
@@ -0,0 +1,88 @@
+package orchestrator
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestPatchVendorData(t *testing.T) {
+	vendorData, err := PatchVendorData("vpp-123")
+	if err != nil {
+		t.Fatalf("PatchVendorData: %v", err)
+	}
+	if !strings.HasPrefix(vendorData, "#cloud-config\n") {
+		t.Fatalf("vendor-data does not start with #cloud-config:\n%s", vendorData)
+	}
+
+	var doc struct {
+		Bootcmd [][]string `yaml:"bootcmd"`
+	}
+	if err := yaml.Unmarshal([]byte(vendorData), &doc); err != nil {
+		t.Fatalf("vendor-data is not valid YAML: %v\n%s", err, vendorData)
+	}
+	if len(doc.Bootcmd) != 1 || len(doc.Bootcmd[0]) != 6 {
+		t.Fatalf("bootcmd = %v, want one cloud-init-per command", doc.Bootcmd)
+	}
+	cmd := doc.Bootcmd[0]
+	if cmd[0] != "cloud-init-per" || cmd[1] != "once" || cmd[2] != "obiente-patch-vpp-123" {
+		t.Fatalf("bootcmd = %v, want cloud-init-per once obiente-patch-vpp-123", cmd)
+	}
+	if !strings.Contains(cmd[5], "setsid sh /var/lib/obiente/patch-vpp-123.sh > "+PatchLogPath("vpp-123")) {
+		t.Fatalf("bootcmd does not run the patch script detached: %s", cmd[5])
+	}
+
+	if _, err := PatchVendorData("vpp-1; reboot"); err == nil {
+		t.Fatalf("PatchVendorData accepted a patch ID with shell characters")
+	}
+}
+
+func TestParsePatchStatus(t *testing.T) {
+	if _, ok := ParsePatchStatus(""); ok {
+		t.Fatalf("empty output parsed as finished")
+	}
+
+	status, ok := ParsePatchStatus("exit=100\npm=apt-get\n---\nE: Unable to locate package\n")
+	if !ok {
+		t.Fatalf("finished output not parsed")
+	}
+	if status.ExitCode != 100 || status.PackageManager != "apt-get" || status.Output != "E: Unable to locate package\n" {
+		t.Fatalf("status = %+v, want exit 100 from apt-get with the log", status)
+	}
+
+	if _, ok := ParsePatchStatus("pm=dnf\n---\n"); ok {
+		t.Fatalf("output without an exit code parsed as finished")
+	}
+}
+
+func TestWithVendorData(t *testing.T) {
+	tests := []struct {
+		cicustom string
+		want     string
+	}{
+		{"", "vendor=local:snippets/v"},
+		{"user=local:snippets/u", "user=local:snippets/u,vendor=local:snippets/v"},
+		{"user=local:snippets/u,vendor=local:snippets/old", "user=local:snippets/u,vendor=local:snippets/v"},
+	}
+	for _, tt := range tests {
+		if got := withVendorData(tt.cicustom, "local:snippets/v"); got != tt.want {
+			t.Errorf("withVendorData(%q) = %q, want %q", tt.cicustom, got, tt.want)
+		}
+	}
+}
+
+func TestSnippetStorageFromCicustom(t *testing.T) {
+	t.Setenv("PROXMOX_SNIPPET_STORAGE", "")
+	t.Setenv("PROXMOX_STORAGE_POOL", "")
+	if got := snippetStorageFromCicustom("user=cephfs:snippets/vm-100-user-data"); got != "cephfs" {
+		t.Errorf("storage = %q, want cephfs from the user= entry", got)
+	}
+	if got := snippetStorageFromCicustom(""); got != "local" {
+		t.Errorf("storage = %q, want local", got)
+	}
+	t.Setenv("PROXMOX_SNIPPET_STORAGE", "snippets")
+	if got := snippetStorageFromCicustom(""); got != "snippets" {
+		t.Errorf("storage = %q, want PROXMOX_SNIPPET_STORAGE", got)
+	}
+}
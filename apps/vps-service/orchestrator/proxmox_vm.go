@@ -389,8 +389,34 @@ func (pc *ProxmoxClient) CreateVM(ctx context.Context, config *VPSConfig, allowI
 			}
 		}
 	}
+	// VPS cloned from an organization's template must be created on the template's node
+	if config.SourceTemplate != nil {
+		templateNodeExists := false
+		for _, node := range nodes {
+			if node == config.SourceTemplate.NodeName {
+				templateNodeExists = true
+				break
+			}
+		}
+		if !templateNodeExists {
+			return nil, fmt.Errorf("node %s of VPS template (VMID %d) not found in cluster", config.SourceTemplate.NodeName, config.SourceTemplate.VMID)
+		}
+		nodeName = config.SourceTemplate.NodeName
+		logger.Info("[ProxmoxClient] Using node %s of VPS template (VMID %d)", nodeName, config.SourceTemplate.VMID)
+	}
 	writeLog("Server location selected", false)
 
+	// Check the GPU before anything is provisioned so a taken GPU fails fast
+	if config.GPU != nil {
+		if err := config.GPU.Validate(); err != nil {
+			return nil, err
+		}
+		if err := pc.checkGPUAvailable(ctx, nodeName, config.GPU); err != nil {
+			return nil, err
+		}
+		writeLog("GPU available", false)
+	}
+
 	writeLog("Preparing storage...", false)
 	// Get storage pool for VM disks (default to local-lvm)
 	storage := "local-lvm"
@@ -494,6 +520,13 @@ func (pc *ProxmoxClient) CreateVM(ctx context.Context, config *VPSConfig, allowI
 	}
 	vmConfig["net0"] = netConfig
 
+	// PCIe passthrough needs the q35 chipset; the default i440fx only has a PCI bus
+	if config.GPU != nil {
+		vmConfig["machine"] = "q35"
+		vmConfig["hostpci0"] = config.GPU.hostPCI()
+		logger.Info("[ProxmoxClient] Passing GPU %s through to VM %d (%s)", config.GPU.PCIID, vmID, config.GPU.Driver)
+	}
+
 	// Use cloud-init for modern Linux distributions (Ubuntu 22.04+, Debian 12+)
 	// For older images, fall back to ISO installation
 	useCloudInit := false
@@ -529,10 +562,20 @@ func (pc *ProxmoxClient) CreateVM(ctx context.Context, config *VPSConfig, allowI
 		}
 	}
 
+	if config.SourceTemplate != nil {
+		// Clone the organization's VPS template instead of the OS image template
+		imageTemplate = config.SourceTemplate.Name
+		useCloudInit = true
+	}
+
 	if useCloudInit && imageTemplate != "" {
 		// Find template
 		var err error
-		templateVMID, err = pc.findTemplate(ctx, nodeName, imageTemplate)
+		if config.SourceTemplate != nil {
+			templateVMID = config.SourceTemplate.VMID
+		} else {
+			templateVMID, err = pc.findTemplate(ctx, nodeName, imageTemplate)
+		}
 		if err != nil {
 			logger.Warn("[ProxmoxClient] Template %s not found, falling back to ISO installation: %v", imageTemplate, err)
 			useCloudInit = false
@@ -1260,6 +1303,14 @@ func (pc *ProxmoxClient) CreateVM(ctx context.Context, config *VPSConfig, allowI
 		}
 	}
 
+	if !useCloudInit && config.SourceTemplate != nil {
+		return nil, fmt.Errorf("failed to clone VPS template (VMID %d) to VM %d", config.SourceTemplate.VMID, vmID)
+	}
+
+	if !useCloudInit && config.CloudInitTemplate != nil && *config.CloudInitTemplate != "" {
+		return nil, fmt.Errorf("cloud-init template cannot be applied to VM %d: the image has no cloud-init template and would be installed from ISO", vmID)
+	}
+
 	if !useCloudInit {
 		// Fallback to ISO installation
 		// Note: ISO files must exist in Proxmox ISO storage for this to work
@@ -1292,6 +1343,10 @@ func (pc *ProxmoxClient) CreateVM(ctx context.Context, config *VPSConfig, allowI
 		// Use ip=dhcp without specifying interface - cloud-init will auto-detect
 		// Specifying interface name can cause issues if the interface name doesn't match
 		vmConfig["ipconfig0"] = "ip=dhcp"
+		if config.StaticIP != nil {
+			vmConfig["ipconfig0"] = config.StaticIP.ipConfig()
+			vmConfig["nameserver"] = strings.Join(config.StaticIP.nameservers(), " ")
+		}
 		vmConfig["ciuser"] = "root"
 		// Disable package upgrades via Proxmox ciupgrade parameter
 		// This works in conjunction with package_update/package_upgrade in cloud-init userData
@@ -1316,6 +1371,18 @@ func (pc *ProxmoxClient) CreateVM(ctx context.Context, config *VPSConfig, allowI
 		// This ensures guest agent, SSH server, and other essential services are properly configured
 		// The userData includes: SSH server installation, guest agent installation, root password, SSH keys, etc.
 		userData := GenerateCloudInitUserData(config)
+		if config.CloudInitTemplate != nil && *config.CloudInitTemplate != "" {
+			rendered, err := renderCloudInitTemplateForVPS(config, rootPassword)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render cloud-init template for VM %d: %w", vmID, err)
+			}
+			if userData == "" {
+				userData = rendered
+			} else {
+				userData = MergeCloudInitUserData(userData, rendered)
+			}
+			logger.Info("[ProxmoxClient] Applied cloud-init template to userData for VM %d", vmID)
+		}
 		if userData != "" {
 			// Create snippet file in Proxmox storage (use snippetStorage, not VM disk storage)
 			snippetPath, err := pc.CreateCloudInitSnippet(ctx, nodeName, snippetStorage, vmID, userData)
@@ -1662,7 +1729,12 @@ func (pc *ProxmoxClient) CreateVM(ctx context.Context, config *VPSConfig, allowI
 
 			// Retry with minimal cloud-init config
 			retryFormData := url.Values{}
-			retryFormData.Set("ipconfig0", "ip=dhcp")
+			if config.StaticIP != nil {
+				retryFormData.Set("ipconfig0", config.StaticIP.ipConfig())
+				retryFormData.Set("nameserver", strings.Join(config.StaticIP.nameservers(), " "))
+			} else {
+				retryFormData.Set("ipconfig0", "ip=dhcp")
+			}
 			retryFormData.Set("ciuser", "root")
 			retryFormData.Set("ciupgrade", "0")
 			// Safely get cipassword - it might not exist if using snippets
@@ -3004,6 +3076,42 @@ func (pc *ProxmoxClient) RebootVM(ctx context.Context, nodeName string, vmID int
 	return nil
 }
 
+// PowerAction posts a power action ("start", "shutdown", "stop", "reboot") to the VM's status
+// endpoint and returns the UPID of the Proxmox task carrying it out. "shutdown" asks the guest
+// to power off via ACPI; "stop" cuts power immediately.
+func (pc *ProxmoxClient) PowerAction(ctx context.Context, nodeName string, vmID int, action string) (string, error) {
+	endpoint := fmt.Sprintf("/nodes/%s/qemu/%d/status/%s", nodeName, vmID, action)
+	// Proxmox API expects form-encoded data for POST requests, even if empty
+	resp, err := pc.apiRequestForm(ctx, "POST", endpoint, url.Values{})
+	if err != nil {
+		if pc.isVMDeletedError(err, 0, "") {
+			return "", fmt.Errorf("VM %d has been deleted from Proxmox", vmID)
+		}
+		return "", fmt.Errorf("failed to %s VM: %w", action, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		bodyStr := string(body)
+		if pc.isVMDeletedError(nil, resp.StatusCode, bodyStr) {
+			return "", fmt.Errorf("VM %d has been deleted from Proxmox", vmID)
+		}
+		return "", fmt.Errorf("failed to %s VM: %s (status: %d)", action, bodyStr, resp.StatusCode)
+	}
+
+	var taskResp struct {
+		Data string `json:"data"` // UPID of the task
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&taskResp); err != nil {
+		return "", fmt.Errorf("failed to decode %s response: %w", action, err)
+	}
+	if taskResp.Data == "" {
+		return "", fmt.Errorf("Proxmox did not return a task ID for %s", action)
+	}
+	return taskResp.Data, nil
+}
+
 func (pc *ProxmoxClient) waitForVMStatus(ctx context.Context, nodeName string, vmID int, targetStatus string, timeout time.Duration) error {
 	deadline := time.Now().Add(timeout)
 	ticker := time.NewTicker(1 * time.Second)
@@ -3341,6 +3449,35 @@ func (pc *ProxmoxClient) CheckGuestAgentStatus(ctx context.Context, nodeName str
 	return false, nil
 }
 
+// GuestPing pings the guest OS through the QEMU guest agent
+// Returns false (without error) when the agent is configured but the guest does not answer, which
+// usually means the OS is hung even though Proxmox reports the VM as running.
+// Returns an error when the ping could not be performed (API failure or no guest agent configured).
+func (pc *ProxmoxClient) GuestPing(ctx context.Context, nodeName string, vmID int) (bool, error) {
+	endpoint := fmt.Sprintf("/nodes/%s/qemu/%d/agent/ping", nodeName, vmID)
+	resp, err := pc.apiRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to ping guest agent: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return true, nil
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	if strings.Contains(string(body), "No QEMU guest agent configured") {
+		return false, fmt.Errorf("guest agent is not configured for VM %d", vmID)
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		// Proxmox answers 500 "QEMU guest agent is not running" when the guest does not respond
+		logger.Debug("[ProxmoxClient] Guest agent ping failed for VM %d: %s (status: %d)", vmID, string(body), resp.StatusCode)
+		return false, nil
+	}
+
+	return false, fmt.Errorf("failed to ping guest agent: %s (status: %d)", string(body), resp.StatusCode)
+}
+
 // ExecuteGuestCommand executes a command on the VM via the QEMU guest agent
 // Returns the command output and exit code
 // Note: The command is executed asynchronously - we return immediately after starting it
@@ -24,7 +24,6 @@ package orchestrator
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"io"
 	"net"
@@ -41,7 +40,6 @@ import (
 	vpsgatewayv1connect "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vpsgateway/v1/vpsgatewayv1connect"
 
 	"connectrpc.com/connect"
-	"golang.org/x/net/http2"
 )
 
 // VPSGatewayClient handles communication with the vps-gateway service
@@ -156,21 +154,11 @@ func NewVPSGatewayClient(gatewayURL string) (*VPSGatewayClient, error) {
 		return nil, fmt.Errorf("VPS_GATEWAY_API_SECRET environment variable is required")
 	}
 
-	// Create HTTP client with timeout and HTTP/2 (h2c) support
-	// The gateway server uses h2c.NewHandler which supports cleartext HTTP/2
+	// Create HTTP client with HTTP/2 support (h2c for http:// URLs, TLS/mTLS for https://)
 	// Connect RPC bidirectional streaming requires HTTP/2
-	// Use http2.Transport with AllowHTTP for cleartext HTTP/2 (h2c) connections
-	transport := &http2.Transport{
-		AllowHTTP: true,
-		DialTLSContext: func(ctx context.Context, network, addr string, cfg *tls.Config) (net.Conn, error) {
-			// For h2c, we dial without TLS (cleartext)
-			// Use DialContext for proper timeout and cancellation support
-			var d net.Dialer
-			return d.DialContext(ctx, network, addr)
-		},
-		// Enable HTTP/2 ping frames to keep connection alive
-		ReadIdleTimeout: 45 * time.Second, // Send ping if no reads for 45s
-		PingTimeout:     15 * time.Second, // Wait 15s for pong response
+	transport, err := NewGatewayTransport(gatewayURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure gateway transport: %w", err)
 	}
 	httpClient := &http.Client{
 		Transport: transport,
@@ -265,6 +253,27 @@ func (c *VPSGatewayClient) ReleaseIP(ctx context.Context, vpsID string) error {
 	return nil
 }
 
+// UpdateBandwidth sets the bandwidth limits the gateway enforces for a VPS's lease (Mbit/s, 0 = unlimited)
+func (c *VPSGatewayClient) UpdateBandwidth(ctx context.Context, vpsID string, downMbit, upMbit int32) error {
+	req := connect.NewRequest(&vpsgatewayv1.UpdateBandwidthRequest{
+		VpsId:       vpsID,
+		MaxMbitDown: downMbit,
+		MaxMbitUp:   upMbit,
+	})
+
+	resp, err := c.client.UpdateBandwidth(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to update bandwidth on gateway: %w", err)
+	}
+
+	if !resp.Msg.Success {
+		return fmt.Errorf("gateway returned failure for bandwidth update: %s", resp.Msg.Message)
+	}
+
+	logger.Info("[VPSGateway] Updated bandwidth limits for VPS %s: down=%d up=%d Mbit/s", vpsID, downMbit, upMbit)
+	return nil
+}
+
 // ListIPs lists all allocated IP addresses, optionally filtered
 func (c *VPSGatewayClient) ListIPs(ctx context.Context, organizationID, vpsID string) ([]*vpsgatewayv1.IPAllocation, error) {
 	req := connect.NewRequest(&vpsgatewayv1.ListIPsRequest{
@@ -0,0 +1,82 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+
+	"golang.org/x/net/http2"
+)
+
+// NewGatewayTransport creates the HTTP/2 transport used to reach a vps-gateway
+//
+// http:// gateway URLs use cleartext HTTP/2 (h2c) and rely on VPS_GATEWAY_API_SECRET alone.
+// https:// gateway URLs use TLS; the gateway certificate is verified against VPS_GATEWAY_CA_CERT_PATH
+// (system roots if unset) and VPS_GATEWAY_CLIENT_CERT_PATH / VPS_GATEWAY_CLIENT_KEY_PATH are
+// presented as a client certificate for mutual TLS when set.
+func NewGatewayTransport(gatewayURL string) (*http2.Transport, error) {
+	transport := &http2.Transport{
+		// Enable HTTP/2 ping frames to keep connection alive
+		ReadIdleTimeout: 45 * time.Second, // Send ping if no reads for 45s
+		PingTimeout:     15 * time.Second, // Wait 15s for pong response
+	}
+
+	if !strings.HasPrefix(gatewayURL, "https://") {
+		if os.Getenv("VPS_GATEWAY_CLIENT_CERT_PATH") != "" {
+			logger.Warn("[VPSGatewayClient] VPS_GATEWAY_CLIENT_CERT_PATH is set but gateway URL %s is not https://; connecting without TLS", gatewayURL)
+		}
+		transport.AllowHTTP = true
+		transport.DialTLSContext = func(ctx context.Context, network, addr string, cfg *tls.Config) (net.Conn, error) {
+			// For h2c, we dial without TLS (cleartext)
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		}
+		return transport, nil
+	}
+
+	tlsConfig, err := gatewayClientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// gatewayClientTLSConfig builds the TLS configuration for https:// gateway connections
+func gatewayClientTLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caPath := os.Getenv("VPS_GATEWAY_CA_CERT_PATH"); caPath != "" {
+		caPEM, err := os.ReadFile(caPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read VPS_GATEWAY_CA_CERT_PATH: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in VPS_GATEWAY_CA_CERT_PATH (%s)", caPath)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	certPath := os.Getenv("VPS_GATEWAY_CLIENT_CERT_PATH")
+	keyPath := os.Getenv("VPS_GATEWAY_CLIENT_KEY_PATH")
+	if (certPath == "") != (keyPath == "") {
+		return nil, fmt.Errorf("VPS_GATEWAY_CLIENT_CERT_PATH and VPS_GATEWAY_CLIENT_KEY_PATH must be set together")
+	}
+	if certPath != "" {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load gateway client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
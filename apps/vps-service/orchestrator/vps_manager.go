@@ -36,6 +36,17 @@ type VPSManager struct {
 	bidiGatewayClient interface{}       // Bidirectional gateway client from internal/gateway package
 	gatewayClients    sync.Map          // Cache of gateway clients per node (key: nodeName string, value: *VPSGatewayClient)
 	proxmoxClients    sync.Map          // Cache of Proxmox clients per node (key: nodeName string, value: *ProxmoxClient)
+	guestPingFailures sync.Map          // Consecutive failed guest agent pings per VPS (key: vpsID string, value: int)
+}
+
+// guestPingFailureThreshold is the number of consecutive failed guest agent pings
+// (one per status sync run) after which a running VPS is marked as UNRESPONSIVE
+const guestPingFailureThreshold = 3
+
+// VPSStatusSyncResult describes the status transitions detected by SyncAllVPSStatuses
+type VPSStatusSyncResult struct {
+	DeletedVPSs      map[string]int32 // vpsID -> old status, for VPSs whose VM no longer exists in Proxmox
+	UnresponsiveVPSs []string         // VPSs that just transitioned to UNRESPONSIVE
 }
 
 // parseNodeEndpointsMapping parses the PROXMOX_NODE_ENDPOINTS environment variable (default mapping)
@@ -251,6 +262,13 @@ func GetProxmoxConfig(nodeName ...string) (*ProxmoxConfig, error) {
 	config.TokenID = os.Getenv("PROXMOX_TOKEN_ID")
 	config.Secret = os.Getenv("PROXMOX_TOKEN_SECRET")
 
+	// A token set through RotateProxmoxToken replaces the environment's token
+	credentials := ProxmoxCredentials()
+	if token, ok := credentials.Primary(); ok {
+		config.Username, config.TokenID, config.Secret = token.Username, token.TokenID, token.Secret
+		config.Credentials = credentials
+	}
+
 	// Validate that either password or token is provided
 	if config.Password == "" && (config.TokenID == "" || config.Secret == "") {
 		return nil, fmt.Errorf("either PROXMOX_PASSWORD or both PROXMOX_TOKEN_ID and PROXMOX_TOKEN_SECRET must be provided")
@@ -284,6 +302,10 @@ type ProxmoxConfig struct {
 	TokenID  string // Alternative: use API token instead of password
 	Secret   string // Token secret
 
+	// Credentials supplies the API token for each request so rotated tokens take effect without
+	// recreating clients. Nil uses TokenID and Secret as they are.
+	Credentials *CredentialManager
+
 	// SSH configuration for writing snippet files directly to Proxmox storage
 	// SSHHost is no longer used - SSH endpoints are resolved via PROXMOX_NODE_ENDPOINTS or PROXMOX_NODE_SSH_ENDPOINTS
 	SSHHost       string // Deprecated - not used (SSH endpoints resolved via node mapping)
@@ -764,8 +786,45 @@ type VPSConfig struct {
 	OwnerName        *string // Organization owner name (optional, fetched if not provided)
 
 	// Cloud-init configuration
-	CloudInit    *CloudInitConfig
-	RootPassword *string // Custom root password (optional, auto-generated if not provided)
+	CloudInit         *CloudInitConfig
+	CloudInitTemplate *string // Cloud-init template source rendered and merged into the generated userData (optional)
+	RootPassword      *string // Custom root password (optional, auto-generated if not provided)
+
+	// VPS template to clone instead of the OS image template (optional)
+	SourceTemplate *VPSTemplateSource
+
+	// Static IP configuration used instead of DHCP (optional)
+	StaticIP *StaticIPConfig
+
+	// Host GPU passed through to the VM (optional)
+	GPU *GPUConfig
+}
+
+// StaticIPConfig is the static network configuration of a VPS reserved from its organization's IP allocation
+type StaticIPConfig struct {
+	Address    string   // IP address with prefix length, e.g. "10.20.0.10/24"
+	Gateway    string   // Default gateway
+	DNSServers []string // Nameservers (defaults to 1.1.1.1 and 1.0.0.1)
+}
+
+// ipConfig returns the Proxmox ipconfig0 value for the static IP
+func (c *StaticIPConfig) ipConfig() string {
+	return fmt.Sprintf("ip=%s,gw=%s", c.Address, c.Gateway)
+}
+
+// nameservers returns the configured DNS servers or the defaults
+func (c *StaticIPConfig) nameservers() []string {
+	if len(c.DNSServers) == 0 {
+		return []string{"1.1.1.1", "1.0.0.1"}
+	}
+	return c.DNSServers
+}
+
+// VPSTemplateSource identifies a Proxmox template created by CreateVPSTemplate
+type VPSTemplateSource struct {
+	Name     string // Template name, used in logs
+	NodeName string
+	VMID     int
 }
 
 // CloudInitConfig contains cloud-init configuration options
@@ -1044,6 +1103,51 @@ func (vm *VPSManager) RebootVPS(ctx context.Context, vpsID string) error {
 	return nil
 }
 
+// MigrateVPS moves a VPS to another Proxmox node, optionally without stopping it (live migration).
+// onStarted is passed through to ProxmoxClient.MigrateVM.
+func (vm *VPSManager) MigrateVPS(ctx context.Context, vpsID, targetNode string, live bool, onStarted func()) error {
+	var vps database.VPSInstance
+	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
+		return fmt.Errorf("VPS not found: %w", err)
+	}
+
+	// Migration must be issued against the node that currently hosts the VM
+	nodeName := ""
+	if vps.NodeID != nil && *vps.NodeID != "" {
+		nodeName = *vps.NodeID
+	}
+
+	proxmoxClient, err := vm.GetProxmoxClientForNode(nodeName)
+	if err != nil {
+		return fmt.Errorf("failed to get Proxmox client for node %s: %w", nodeName, err)
+	}
+
+	return proxmoxClient.MigrateVM(ctx, vpsID, targetNode, live, onStarted)
+}
+
+// UpdateVPSBandwidth pushes the bandwidth limits of a VPS's current size to the gateway on its node.
+// Call it after the size changes; the gateway only resolves limits on its own when a lease first appears.
+func (vm *VPSManager) UpdateVPSBandwidth(ctx context.Context, vpsID string) error {
+	var vps database.VPSInstance
+	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
+		return fmt.Errorf("VPS not found: %w", err)
+	}
+	if vps.NodeID == nil || *vps.NodeID == "" {
+		return fmt.Errorf("VPS %s has no node ID", vpsID)
+	}
+
+	downMbit, upMbit, err := database.GetVPSBandwidthLimits(vpsID)
+	if err != nil {
+		return fmt.Errorf("failed to get bandwidth limits for VPS %s: %w", vpsID, err)
+	}
+
+	gatewayClient, err := vm.GetGatewayClientForNode(*vps.NodeID)
+	if err != nil {
+		return fmt.Errorf("failed to get gateway client for node %s: %w", *vps.NodeID, err)
+	}
+	return gatewayClient.UpdateBandwidth(ctx, vpsID, downMbit, upMbit)
+}
+
 // GetVPSStatus retrieves the current status of a VPS from Proxmox
 func (vm *VPSManager) GetVPSStatus(ctx context.Context, vpsID string) (string, error) {
 	var vps database.VPSInstance
@@ -1331,6 +1435,16 @@ func (vm *VPSManager) DeleteVPS(ctx context.Context, vpsID string) error {
 		logger.Info("[VPSManager] Deleted terminal key for VPS %s", vpsID)
 	}
 
+	// Delete mirrored firewall rules (the Proxmox rules go away with the VM)
+	if err := database.DeleteVPSFirewallRules(vpsID); err != nil {
+		logger.Warn("[VPSManager] Failed to delete firewall rule mirror for VPS %s: %v (continuing with VM deletion)", vpsID, err)
+	}
+
+	// Free the static IP for other VPSes of the organization
+	if err := database.ReleaseVPSStaticIP(ctx, database.DB, vpsID); err != nil {
+		logger.Warn("[VPSManager] Failed to release static IP of VPS %s: %v (continuing with VM deletion)", vpsID, err)
+	}
+
 	// DeleteVM will validate that the VM was created by our API by checking VM name matches VPS ID
 	// If nodeName is not set, try to find the VM on any node
 	if nodeName == "" {
@@ -1726,7 +1840,11 @@ func (vm *VPSManager) SyncVPSStatusFromProxmox(ctx context.Context, vpsID string
 	}
 
 	// Map and update status
-	vps.Status = mapProxmoxStatusToVPSStatus(proxmoxStatus)
+	// Proxmox reports a hung guest as running, so only a successful guest ping clears UNRESPONSIVE
+	status := mapProxmoxStatusToVPSStatus(proxmoxStatus)
+	if status != int32(vpsv1.VPSStatus_RUNNING) || vps.Status != int32(vpsv1.VPSStatus_UNRESPONSIVE) {
+		vps.Status = status
+	}
 	vps.UpdatedAt = time.Now()
 	if err := database.DB.Save(&vps).Error; err != nil {
 		return fmt.Errorf("failed to update VPS status: %w", err)
@@ -2494,7 +2612,7 @@ func (vm *VPSManager) ImportVPS(ctx context.Context, organizationID string) ([]I
 // SyncAllVPSStatuses syncs status and IP addresses for all VPS instances from Proxmox
 // This is used for periodic background sync to detect deleted VPSs and update IP addresses
 // Returns a map of VPS IDs that were marked as DELETED (oldStatus -> newStatus)
-func (vm *VPSManager) SyncAllVPSStatuses(ctx context.Context) (map[string]int32, error) {
+func (vm *VPSManager) SyncAllVPSStatuses(ctx context.Context) (*VPSStatusSyncResult, error) {
 	// Get all VPS instances that have an instance ID (are provisioned)
 	var vpsInstances []database.VPSInstance
 	if err := database.DB.Where("instance_id IS NOT NULL AND deleted_at IS NULL").Find(&vpsInstances).Error; err != nil {
@@ -2508,6 +2626,7 @@ func (vm *VPSManager) SyncAllVPSStatuses(ctx context.Context) (map[string]int32,
 	ipUpdatedCount := 0
 	errorCount := 0
 	deletedVPSs := make(map[string]int32) // vpsID -> oldStatus
+	var unresponsiveVPSs []string
 
 	for _, vps := range vpsInstances {
 		// Get old status for notification purposes
@@ -2543,6 +2662,15 @@ func (vm *VPSManager) SyncAllVPSStatuses(ctx context.Context) (map[string]int32,
 				syncedCount++
 			}
 
+			// Proxmox reports "running" even when the guest OS is hung, so confirm with the guest agent
+			if updatedVPS.Status == int32(vpsv1.VPSStatus_RUNNING) || updatedVPS.Status == int32(vpsv1.VPSStatus_UNRESPONSIVE) {
+				if vm.checkGuestResponsiveness(ctx, &updatedVPS) {
+					unresponsiveVPSs = append(unresponsiveVPSs, vps.ID)
+				}
+			} else {
+				vm.guestPingFailures.Delete(vps.ID)
+			}
+
 			// For running VPSs, also sync IP addresses
 			// Status 1 = RUNNING (from mapProxmoxStatusToVPSStatus)
 			if updatedVPS.Status == 1 {
@@ -2559,8 +2687,82 @@ func (vm *VPSManager) SyncAllVPSStatuses(ctx context.Context) (map[string]int32,
 		}
 	}
 
-	logger.Info("[VPSManager] Sync completed: %d status changed, %d IPs updated, %d marked as deleted, %d errors", syncedCount, ipUpdatedCount, deletedCount, errorCount)
-	return deletedVPSs, nil
+	logger.Info("[VPSManager] Sync completed: %d status changed, %d IPs updated, %d marked as deleted, %d newly unresponsive, %d errors", syncedCount, ipUpdatedCount, deletedCount, len(unresponsiveVPSs), errorCount)
+	return &VPSStatusSyncResult{
+		DeletedVPSs:      deletedVPSs,
+		UnresponsiveVPSs: unresponsiveVPSs,
+	}, nil
+}
+
+// checkGuestResponsiveness pings the guest agent of a VPS that Proxmox reports as running and
+// updates its status from the result (see guestPingStatus).
+// Returns true only when the VPS just transitioned to UNRESPONSIVE.
+func (vm *VPSManager) checkGuestResponsiveness(ctx context.Context, vps *database.VPSInstance) bool {
+	if vps.InstanceID == nil || vps.NodeID == nil || *vps.NodeID == "" {
+		return false
+	}
+	vmIDInt := 0
+	fmt.Sscanf(*vps.InstanceID, "%d", &vmIDInt)
+	if vmIDInt == 0 {
+		return false
+	}
+
+	proxmoxClient, err := vm.GetProxmoxClientForNode(*vps.NodeID)
+	if err != nil {
+		logger.Debug("[VPSManager] Failed to get Proxmox client for guest ping of VPS %s: %v", vps.ID, err)
+		return false
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	responsive, err := proxmoxClient.GuestPing(pingCtx, *vps.NodeID, vmIDInt)
+	cancel()
+	if err != nil {
+		// We could not ask the guest (no agent configured or API error) - this says nothing about
+		// the guest itself, so the status is left as it is
+		logger.Debug("[VPSManager] Guest ping skipped for VPS %s: %v", vps.ID, err)
+		return false
+	}
+
+	status := vm.guestPingStatus(vps.ID, vps.Status, responsive)
+	if status == vps.Status {
+		return false
+	}
+	if err := database.DB.Model(vps).Updates(map[string]interface{}{
+		"status":     status,
+		"updated_at": time.Now(),
+	}).Error; err != nil {
+		logger.Warn("[VPSManager] Failed to update guest status of VPS %s: %v", vps.ID, err)
+		return false
+	}
+	vps.Status = status
+
+	if status == int32(vpsv1.VPSStatus_RUNNING) {
+		logger.Info("[VPSManager] VPS %s is responding to guest agent pings again", vps.ID)
+		return false
+	}
+	return true
+}
+
+// guestPingStatus records a guest agent ping result and returns the status the VPS should have.
+// A VPS becomes UNRESPONSIVE after guestPingFailureThreshold consecutive failed pings and stays
+// UNRESPONSIVE until a ping succeeds.
+func (vm *VPSManager) guestPingStatus(vpsID string, status int32, responsive bool) int32 {
+	if responsive {
+		vm.guestPingFailures.Delete(vpsID)
+		return int32(vpsv1.VPSStatus_RUNNING)
+	}
+
+	failures := 1
+	if previous, ok := vm.guestPingFailures.Load(vpsID); ok {
+		failures += previous.(int)
+	}
+	vm.guestPingFailures.Store(vpsID, failures)
+	logger.Warn("[VPSManager] Guest agent ping failed for VPS %s (%d consecutive failures)", vpsID, failures)
+
+	if failures >= guestPingFailureThreshold {
+		return int32(vpsv1.VPSStatus_UNRESPONSIVE)
+	}
+	return status
 }
 
 // ImportMissingVPSForAllOrgs imports missing VPS instances for all organizations
@@ -0,0 +1,40 @@
+package orchestrator
+
+import (
+	"testing"
+
+	vpsv1 "github.com/obiente/cloud/apps/shared/proto/obiente/cloud/vps/v1"
+)
+
+func TestGuestPingStatus(t *testing.T) {
+	vm := &VPSManager{}
+	running := int32(vpsv1.VPSStatus_RUNNING)
+	unresponsive := int32(vpsv1.VPSStatus_UNRESPONSIVE)
+
+	// Two failures are not enough
+	for i := 1; i < guestPingFailureThreshold; i++ {
+		if got := vm.guestPingStatus("vps-1", running, false); got != running {
+			t.Fatalf("status after %d failed pings = %d, want RUNNING", i, got)
+		}
+	}
+	if got := vm.guestPingStatus("vps-1", running, false); got != unresponsive {
+		t.Fatalf("status after %d failed pings = %d, want UNRESPONSIVE", guestPingFailureThreshold, got)
+	}
+	// It stays UNRESPONSIVE while the guest keeps failing
+	if got := vm.guestPingStatus("vps-1", unresponsive, false); got != unresponsive {
+		t.Fatalf("status after another failed ping = %d, want UNRESPONSIVE", got)
+	}
+
+	// A single successful ping recovers and resets the count
+	if got := vm.guestPingStatus("vps-1", unresponsive, true); got != running {
+		t.Fatalf("status after a successful ping = %d, want RUNNING", got)
+	}
+	if got := vm.guestPingStatus("vps-1", running, false); got != running {
+		t.Fatalf("status after one failure following recovery = %d, want RUNNING", got)
+	}
+
+	// Failures are counted per VPS
+	if got := vm.guestPingStatus("vps-2", running, false); got != running {
+		t.Fatalf("first failure of another VPS = %d, want RUNNING", got)
+	}
+}
@@ -0,0 +1,157 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/obiente/cloud/apps/shared/pkg/database"
+	"github.com/obiente/cloud/apps/shared/pkg/logger"
+)
+
+// VPS template (golden image) operations
+
+// templateShutdownTimeout bounds how long we wait for the guest to power off before converting it
+const templateShutdownTimeout = 5 * time.Minute
+
+// templateConvertTimeout bounds how long we wait for Proxmox to convert a VM into a template
+const templateConvertTimeout = 10 * time.Minute
+
+// ShutdownVMViaGuestAgent asks the QEMU guest agent to power off the guest OS
+// Unlike StopVM this lets the guest flush its filesystems, which matters when the disk is reused as a template
+func (pc *ProxmoxClient) ShutdownVMViaGuestAgent(ctx context.Context, nodeName string, vmID int) error {
+	endpoint := fmt.Sprintf("/nodes/%s/qemu/%d/agent/shutdown", nodeName, vmID)
+	resp, err := pc.apiRequestForm(ctx, "POST", endpoint, url.Values{})
+	if err != nil {
+		return fmt.Errorf("failed to shut down VM via guest agent: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to shut down VM via guest agent: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	return nil
+}
+
+// ConvertVMToTemplate converts a stopped VM into a Proxmox template
+// Reference: https://pve.proxmox.com/pve-docs/api-viewer/index.html#/nodes/{node}/qemu/{vmid}/template
+func (pc *ProxmoxClient) ConvertVMToTemplate(ctx context.Context, nodeName string, vmID int) error {
+	endpoint := fmt.Sprintf("/nodes/%s/qemu/%d/template", nodeName, vmID)
+	resp, err := pc.apiRequestForm(ctx, "POST", endpoint, url.Values{})
+	if err != nil {
+		return fmt.Errorf("failed to convert VM to template: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to convert VM to template: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	// Newer Proxmox versions run the conversion as a task and return its UPID
+	var templateResp struct {
+		Data *string `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&templateResp); err == nil && templateResp.Data != nil && *templateResp.Data != "" {
+		if err := pc.waitForTask(ctx, nodeName, *templateResp.Data, templateConvertTimeout); err != nil {
+			return fmt.Errorf("conversion of VM %d to template failed: %w", vmID, err)
+		}
+	}
+
+	return nil
+}
+
+// CreateVPSTemplate gracefully shuts down a VPS and converts its VM into a Proxmox template
+// The VPS is marked TERMINATED and detached from the VM, which now belongs to the template
+// Returns the node and VMID of the template
+func (vm *VPSManager) CreateVPSTemplate(ctx context.Context, vpsID string) (string, int, error) {
+	var vps database.VPSInstance
+	if err := database.DB.Where("id = ? AND deleted_at IS NULL", vpsID).First(&vps).Error; err != nil {
+		return "", 0, fmt.Errorf("VPS not found: %w", err)
+	}
+
+	if vps.InstanceID == nil {
+		return "", 0, fmt.Errorf("VPS has no instance ID")
+	}
+
+	// Get Proxmox client for the node where VPS is running
+	nodeName := ""
+	if vps.NodeID != nil && *vps.NodeID != "" {
+		nodeName = *vps.NodeID
+	}
+
+	proxmoxClient, err := vm.GetProxmoxClientForNode(nodeName)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get Proxmox client for node %s: %w", nodeName, err)
+	}
+
+	vmIDInt := 0
+	fmt.Sscanf(*vps.InstanceID, "%d", &vmIDInt)
+	if vmIDInt == 0 {
+		return "", 0, fmt.Errorf("invalid VM ID: %s", *vps.InstanceID)
+	}
+
+	// Find node if not stored in VPS
+	if nodeName == "" {
+		node, err := proxmoxClient.FindVMNode(ctx, vmIDInt)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to find Proxmox node for VM %d: %w", vmIDInt, err)
+		}
+		nodeName = node
+	}
+
+	status, err := proxmoxClient.GetVMStatus(ctx, nodeName, vmIDInt)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get VM status: %w", err)
+	}
+	if status != "stopped" {
+		logger.Info("[VPSManager] Shutting down VM %d of VPS %s via guest agent before converting it to a template", vmIDInt, vpsID)
+		if err := proxmoxClient.ShutdownVMViaGuestAgent(ctx, nodeName, vmIDInt); err != nil {
+			return "", 0, err
+		}
+		// A forced stop could leave the disk inconsistent, so give up instead if the guest does not power off
+		if err := proxmoxClient.waitForVMStatus(ctx, nodeName, vmIDInt, "stopped", templateShutdownTimeout); err != nil {
+			return "", 0, fmt.Errorf("VM %d did not shut down: %w", vmIDInt, err)
+		}
+	}
+
+	if err := proxmoxClient.ConvertVMToTemplate(ctx, nodeName, vmIDInt); err != nil {
+		return "", 0, err
+	}
+	logger.Info("[VPSManager] Converted VM %d of VPS %s to a template on node %s", vmIDInt, vpsID, nodeName)
+
+	// Clear the instance ID so that status sync and DeleteVPS leave the template alone
+	if err := database.DB.Model(&database.VPSInstance{}).
+		Where("id = ?", vps.ID).
+		Updates(map[string]interface{}{
+			"status":      12, // TERMINATED
+			"instance_id": nil,
+			"updated_at":  time.Now(),
+		}).Error; err != nil {
+		return "", 0, fmt.Errorf("failed to mark VPS %s as terminated: %w", vpsID, err)
+	}
+
+	return nodeName, vmIDInt, nil
+}
+
+// DeleteVPSTemplate removes the Proxmox template of a VPS template
+// sourceVPSID is the VM name the template was created with, checked by DeleteVM before deletion
+func (vm *VPSManager) DeleteVPSTemplate(ctx context.Context, nodeName string, vmID int, sourceVPSID string) error {
+	proxmoxClient, err := vm.GetProxmoxClientForNode(nodeName)
+	if err != nil {
+		return fmt.Errorf("failed to get Proxmox client for node %s: %w", nodeName, err)
+	}
+
+	if err := proxmoxClient.DeleteVM(ctx, nodeName, vmID, sourceVPSID); err != nil {
+		return fmt.Errorf("failed to delete template VM %d: %w", vmID, err)
+	}
+	logger.Info("[VPSManager] Deleted template VM %d on node %s", vmID, nodeName)
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts"
+// @generated from file obiente/cloud/audit/v1/sftp_audit_service.proto (package obiente.cloud.audit.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file obiente/cloud/audit/v1/sftp_audit_service.proto.
+ */
+export const file_obiente_cloud_audit_v1_sftp_audit_service: GenFile = /*@__PURE__*/
+  fileDesc("Ci9vYmllbnRlL2Nsb3VkL2F1ZGl0L3YxL3NmdHBfYXVkaXRfc2VydmljZS5wcm90bxIWb2JpZW50ZS5jbG91ZC5hdWRpdC52MSLbAgoXR2V0U0ZUUEF1ZGl0TG9nc1JlcXVlc3QSHAoPb3JnYW5pemF0aW9uX2lkGAEgASgJSACIAQESGAoLcmVzb3VyY2VfaWQYAiABKAlIAYgBARIzCgpzdGFydF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBEjEKCGVuZF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgDiAEBEhYKCXBhZ2Vfc2l6ZRgFIAEoBUgEiAEBEjoKBmZvcm1hdBgGIAEoDjIqLm9iaWVudGUuY2xvdWQuYXVkaXQudjEuU0ZUUEF1ZGl0TG9nRm9ybWF0QhIKEF9vcmdhbml6YXRpb25faWRCDgoMX3Jlc291cmNlX2lkQg0KC19zdGFydF90aW1lQgsKCV9lbmRfdGltZUIMCgpfcGFnZV9zaXplIkkKGEdldFNGVFBBdWRpdExvZ3NSZXNwb25zZRIMCgRwYWdlGAEgASgFEhEKCXJvd19jb3VudBgCIAEoBRIMCgRkYXRhGAMgASgMKnoKElNGVFBBdWRpdExvZ0Zvcm1hdBIlCiFTRlRQX0FVRElUX0xPR19GT1JNQVRfVU5TUEVDSUZJRUQQABIeChpTRlRQX0FVRElUX0xPR19GT1JNQVRfSlNPThABEh0KGVNGVFBfQVVESVRfTE9HX0ZPUk1BVF9DU1YQAjKLAQoQU0ZUUEF1ZGl0U2VydmljZRJ3ChBHZXRTRlRQQXVkaXRMb2dzEi8ub2JpZW50ZS5jbG91ZC5hdWRpdC52MS5HZXRTRlRQQXVkaXRMb2dzUmVxdWVzdBowLm9iaWVudGUuY2xvdWQuYXVkaXQudjEuR2V0U0ZUUEF1ZGl0TG9nc1Jlc3BvbnNlMAFCS1pJZ2l0aHViLmNvbS9vYmllbnRlL2Nsb3VkL2FwcHMvc2hhcmVkL3Byb3RvL29iaWVudGUvY2xvdWQvYXVkaXQvdjE7YXVkaXR2MWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message obiente.cloud.audit.v1.GetSFTPAuditLogsRequest
+ */
+export type GetSFTPAuditLogsRequest = Message<"obiente.cloud.audit.v1.GetSFTPAuditLogsRequest"> & {
+  /**
+   * Organization to export (required for organization admins and owners)
+   *
+   * @generated from field: optional string organization_id = 1;
+   */
+  organizationId?: string;
+
+  /**
+   * Limit the export to one resource, e.g. a game server ID (optional)
+   *
+   * @generated from field: optional string resource_id = 2;
+   */
+  resourceId?: string;
+
+  /**
+   * Filter by time range (optional)
+   *
+   * @generated from field: optional google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp end_time = 4;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * Events per streamed page. Default: 500, Max: 5000
+   *
+   * @generated from field: optional int32 page_size = 5;
+   */
+  pageSize?: number;
+
+  /**
+   * @generated from field: obiente.cloud.audit.v1.SFTPAuditLogFormat format = 6;
+   */
+  format: SFTPAuditLogFormat;
+};
+
+/**
+ * Describes the message obiente.cloud.audit.v1.GetSFTPAuditLogsRequest.
+ * Use `create(GetSFTPAuditLogsRequestSchema)` to create a new message.
+ */
+export const GetSFTPAuditLogsRequestSchema: GenMessage<GetSFTPAuditLogsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_audit_v1_sftp_audit_service, 0);
+
+/**
+ * @generated from message obiente.cloud.audit.v1.GetSFTPAuditLogsResponse
+ */
+export type GetSFTPAuditLogsResponse = Message<"obiente.cloud.audit.v1.GetSFTPAuditLogsResponse"> & {
+  /**
+   * 1-based page number
+   *
+   * @generated from field: int32 page = 1;
+   */
+  page: number;
+
+  /**
+   * Number of events in this page
+   *
+   * @generated from field: int32 row_count = 2;
+   */
+  rowCount: number;
+
+  /**
+   * Events encoded in the requested format; concatenate pages for the full export
+   *
+   * @generated from field: bytes data = 3;
+   */
+  data: Uint8Array;
+};
+
+/**
+ * Describes the message obiente.cloud.audit.v1.GetSFTPAuditLogsResponse.
+ * Use `create(GetSFTPAuditLogsResponseSchema)` to create a new message.
+ */
+export const GetSFTPAuditLogsResponseSchema: GenMessage<GetSFTPAuditLogsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_audit_v1_sftp_audit_service, 1);
+
+/**
+ * @generated from enum obiente.cloud.audit.v1.SFTPAuditLogFormat
+ */
+export enum SFTPAuditLogFormat {
+  /**
+   * Defaults to JSON
+   *
+   * @generated from enum value: SFTP_AUDIT_LOG_FORMAT_UNSPECIFIED = 0;
+   */
+  SFTP_AUDIT_LOG_FORMAT_UNSPECIFIED = 0,
+
+  /**
+   * JSON Lines: one object per event
+   *
+   * @generated from enum value: SFTP_AUDIT_LOG_FORMAT_JSON = 1;
+   */
+  SFTP_AUDIT_LOG_FORMAT_JSON = 1,
+
+  /**
+   * CSV with a header row at the start of the first page
+   *
+   * @generated from enum value: SFTP_AUDIT_LOG_FORMAT_CSV = 2;
+   */
+  SFTP_AUDIT_LOG_FORMAT_CSV = 2,
+}
+
+/**
+ * Describes the enum obiente.cloud.audit.v1.SFTPAuditLogFormat.
+ */
+export const SFTPAuditLogFormatSchema: GenEnum<SFTPAuditLogFormat> = /*@__PURE__*/
+  enumDesc(file_obiente_cloud_audit_v1_sftp_audit_service, 0);
+
+/**
+ * @generated from service obiente.cloud.audit.v1.SFTPAuditService
+ */
+export const SFTPAuditService: GenService<{
+  /**
+   * Export SFTP audit events (logins, transfers, file changes and quota breaches) for compliance.
+   * Matching events are streamed oldest first, one page per message.
+   *
+   * @generated from rpc obiente.cloud.audit.v1.SFTPAuditService.GetSFTPAuditLogs
+   */
+  getSFTPAuditLogs: {
+    methodKind: "server_streaming";
+    input: typeof GetSFTPAuditLogsRequestSchema;
+    output: typeof GetSFTPAuditLogsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_obiente_cloud_audit_v1_sftp_audit_service, 0);
+
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file obiente/cloud/billing/v1/billing_service.proto.
  */
 export const file_obiente_cloud_billing_v1_billing_service: GenFile = /*@__PURE__*/
-  fileDesc("Ci5vYmllbnRlL2Nsb3VkL2JpbGxpbmcvdjEvYmlsbGluZ19zZXJ2aWNlLnByb3RvEhhvYmllbnRlLmNsb3VkLmJpbGxpbmcudjEinwEKHENyZWF0ZUNoZWNrb3V0U2Vzc2lvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhQKDGFtb3VudF9jZW50cxgCIAEoAxIYCgtzdWNjZXNzX3VybBgDIAEoCUgAiAEBEhcKCmNhbmNlbF91cmwYBCABKAlIAYgBAUIOCgxfc3VjY2Vzc191cmxCDQoLX2NhbmNlbF91cmwiSQodQ3JlYXRlQ2hlY2tvdXRTZXNzaW9uUmVzcG9uc2USEgoKc2Vzc2lvbl9pZBgBIAEoCRIUCgxjaGVja291dF91cmwYAiABKAkigQEKGkNyZWF0ZVBheW1lbnRJbnRlbnRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIUCgxhbW91bnRfY2VudHMYAiABKAMSHgoRcGF5bWVudF9tZXRob2RfaWQYAyABKAlIAIgBAUIUChJfcGF5bWVudF9tZXRob2RfaWQiTwobQ3JlYXRlUGF5bWVudEludGVudFJlc3BvbnNlEhkKEXBheW1lbnRfaW50ZW50X2lkGAEgASgJEhUKDWNsaWVudF9zZWNyZXQYAiABKAkiXQoaQ3JlYXRlUG9ydGFsU2Vzc2lvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhcKCnJldHVybl91cmwYAiABKAlIAIgBAUINCgtfcmV0dXJuX3VybCIxChtDcmVhdGVQb3J0YWxTZXNzaW9uUmVzcG9uc2USEgoKcG9ydGFsX3VybBgBIAEoCSIzChhHZXRCaWxsaW5nQWNjb3VudFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIlYKGUdldEJpbGxpbmdBY2NvdW50UmVzcG9uc2USOQoHYWNjb3VudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5CaWxsaW5nQWNjb3VudCKhAgobVXBkYXRlQmlsbGluZ0FjY291bnRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIaCg1iaWxsaW5nX2VtYWlsGAIgASgJSACIAQESGQoMY29tcGFueV9uYW1lGAMgASgJSAGIAQESEwoGdGF4X2lkGAQgASgJSAKIAQESNwoHYWRkcmVzcxgFIAEoCzIhLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5BZGRyZXNzSAOIAQESGQoMYmlsbGluZ19kYXRlGAYgASgFSASIAQFCEAoOX2JpbGxpbmdfZW1haWxCDwoNX2NvbXBhbnlfbmFtZUIJCgdfdGF4X2lkQgoKCF9hZGRyZXNzQg8KDV9iaWxsaW5nX2RhdGUiWQocVXBkYXRlQmlsbGluZ0FjY291bnRSZXNwb25zZRI5CgdhY2NvdW50GAEgASgLMigub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkJpbGxpbmdBY2NvdW50IjQKGUxpc3RQYXltZW50TWV0aG9kc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIl4KGkxpc3RQYXltZW50TWV0aG9kc1Jlc3BvbnNlEkAKD3BheW1lbnRfbWV0aG9kcxgBIAMoCzInLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5QYXltZW50TWV0aG9kIjQKF0dldFBheW1lbnRTdGF0dXNSZXF1ZXN0EhkKEXBheW1lbnRfaW50ZW50X2lkGAEgASgJIlgKGEdldFBheW1lbnRTdGF0dXNSZXNwb25zZRIOCgZzdGF0dXMYASABKAkSGgoNZXJyb3JfbWVzc2FnZRgCIAEoCUgAiAEBQhAKDl9lcnJvcl9tZXNzYWdlIlsKGENyZWF0ZVNldHVwSW50ZW50UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFwoKcmV0dXJuX3VybBgCIAEoCUgAiAEBQg0KC19yZXR1cm5fdXJsIksKGUNyZWF0ZVNldHVwSW50ZW50UmVzcG9uc2USFQoNY2xpZW50X3NlY3JldBgBIAEoCRIXCg9zZXR1cF9pbnRlbnRfaWQYAiABKAkiUAoaQXR0YWNoUGF5bWVudE1ldGhvZFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhkKEXBheW1lbnRfbWV0aG9kX2lkGAIgASgJIl4KG0F0dGFjaFBheW1lbnRNZXRob2RSZXNwb25zZRI/Cg5wYXltZW50X21ldGhvZBgBIAEoCzInLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5QYXltZW50TWV0aG9kIlAKGkRldGFjaFBheW1lbnRNZXRob2RSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIZChFwYXltZW50X21ldGhvZF9pZBgCIAEoCSIuChtEZXRhY2hQYXltZW50TWV0aG9kUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJUCh5TZXREZWZhdWx0UGF5bWVudE1ldGhvZFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhkKEXBheW1lbnRfbWV0aG9kX2lkGAIgASgJIjIKH1NldERlZmF1bHRQYXltZW50TWV0aG9kUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJMChNMaXN0SW52b2ljZXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRISCgVsaW1pdBgCIAEoBUgAiAEBQggKBl9saW1pdCJdChRMaXN0SW52b2ljZXNSZXNwb25zZRIzCghpbnZvaWNlcxgBIAMoCzIhLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5JbnZvaWNlEhAKCGhhc19tb3JlGAIgASgIIv4ECgdJbnZvaWNlEgoKAmlkGAEgASgJEg4KBm51bWJlchgCIAEoCRIOCgZzdGF0dXMYAyABKAkSEgoKYW1vdW50X2R1ZRgEIAEoAxITCgthbW91bnRfcGFpZBgFIAEoAxIQCghjdXJyZW5jeRgGIAEoCRIoCgRkYXRlGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIxCghkdWVfZGF0ZRgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARIYCgtpbnZvaWNlX3BkZhgJIAEoCUgBiAEBEh8KEmhvc3RlZF9pbnZvaWNlX3VybBgKIAEoCUgCiAEBEhgKC2Rlc2NyaXB0aW9uGAsgASgJSAOIAQESFQoIc3VidG90YWwYDCABKANIBIgBARISCgV0b3RhbBgNIAEoA0gFiAEBEh0KEGFtb3VudF9yZW1haW5pbmcYDiABKANIBogBARIwCgdwYWlkX2F0GA8gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgHiAEBEhoKDWF0dGVtcHRfY291bnQYECABKAVICIgBARIeChFjb2xsZWN0aW9uX21ldGhvZBgRIAEoCUgJiAEBQgsKCV9kdWVfZGF0ZUIOCgxfaW52b2ljZV9wZGZCFQoTX2hvc3RlZF9pbnZvaWNlX3VybEIOCgxfZGVzY3JpcHRpb25CCwoJX3N1YnRvdGFsQggKBl90b3RhbEITChFfYW1vdW50X3JlbWFpbmluZ0IKCghfcGFpZF9hdEIQCg5fYXR0ZW1wdF9jb3VudEIUChJfY29sbGVjdGlvbl9tZXRob2QiyAMKDkJpbGxpbmdBY2NvdW50EgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIfChJzdHJpcGVfY3VzdG9tZXJfaWQYAyABKAlIAIgBARIOCgZzdGF0dXMYBCABKAkSGgoNYmlsbGluZ19lbWFpbBgFIAEoCUgBiAEBEhkKDGNvbXBhbnlfbmFtZRgGIAEoCUgCiAEBEhMKBnRheF9pZBgHIAEoCUgDiAEBEjcKB2FkZHJlc3MYCCABKAsyIS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQWRkcmVzc0gEiAEBEhkKDGJpbGxpbmdfZGF0ZRgJIAEoBUgFiAEBEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhUKE19zdHJpcGVfY3VzdG9tZXJfaWRCEAoOX2JpbGxpbmdfZW1haWxCDwoNX2NvbXBhbnlfbmFtZUIJCgdfdGF4X2lkQgoKCF9hZGRyZXNzQg8KDV9iaWxsaW5nX2RhdGUisAEKDVBheW1lbnRNZXRob2QSCgoCaWQYASABKAkSDAoEdHlwZRgCIAEoCRI4CgRjYXJkGAMgASgLMiUub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkNhcmREZXRhaWxzSACIAQESEgoKaXNfZGVmYXVsdBgEIAEoCBIuCgpjcmVhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIHCgVfY2FyZCJsCgtDYXJkRGV0YWlscxINCgVicmFuZBgBIAEoCRINCgVsYXN0NBgCIAEoCRIRCglleHBfbW9udGgYAyABKAUSEAoIZXhwX3llYXIYBCABKAUSEQoEbmFtZRgFIAEoCUgAiAEBQgcKBV9uYW1lIogBCgdBZGRyZXNzEg0KBWxpbmUxGAEgASgJEhIKBWxpbmUyGAIgASgJSACIAQESDAoEY2l0eRgDIAEoCRISCgVzdGF0ZRgEIAEoCUgBiAEBEhMKC3Bvc3RhbF9jb2RlGAUgASgJEg8KB2NvdW50cnkYBiABKAlCCAoGX2xpbmUyQggKBl9zdGF0ZSKbAQouQ3JlYXRlRE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvbkNoZWNrb3V0UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSGAoLc3VjY2Vzc191cmwYAiABKAlIAIgBARIXCgpjYW5jZWxfdXJsGAMgASgJSAGIAQFCDgoMX3N1Y2Nlc3NfdXJsQg0KC19jYW5jZWxfdXJsIlsKL0NyZWF0ZUROU0RlbGVnYXRpb25TdWJzY3JpcHRpb25DaGVja291dFJlc3BvbnNlEhIKCnNlc3Npb25faWQYASABKAkSFAoMY2hlY2tvdXRfdXJsGAIgASgJIkQKKUdldEROU0RlbGVnYXRpb25TdWJzY3JpcHRpb25TdGF0dXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSKtAgoqR2V0RE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvblN0YXR1c1Jlc3BvbnNlEh8KF2hhc19hY3RpdmVfc3Vic2NyaXB0aW9uGAEgASgIEh4KFnN0cmlwZV9zdWJzY3JpcHRpb25faWQYAiABKAkSEwoLaGFzX2FwaV9rZXkYAyABKAgSNgoSYXBpX2tleV9jcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIcChRjYW5jZWxfYXRfcGVyaW9kX2VuZBgFIAEoCBI2ChJjdXJyZW50X3BlcmlvZF9lbmQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhsKE2FwaV9rZXlfZGVzY3JpcHRpb24YByABKAkiQQomQ2FuY2VsRE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJInwKJ0NhbmNlbEROU0RlbGVnYXRpb25TdWJzY3JpcHRpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkSLwoLY2FuY2VsZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjMKGExpc3RTdWJzY3JpcHRpb25zUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiWgoZTGlzdFN1YnNjcmlwdGlvbnNSZXNwb25zZRI9Cg1zdWJzY3JpcHRpb25zGAEgAygLMiYub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlN1YnNjcmlwdGlvbiL5AgoMU3Vic2NyaXB0aW9uEgoKAmlkGAEgASgJEg4KBnN0YXR1cxgCIAEoCRI4ChRjdXJyZW50X3BlcmlvZF9zdGFydBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNgoSY3VycmVudF9wZXJpb2RfZW5kGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgtjYW5jZWxlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHAoUY2FuY2VsX2F0X3BlcmlvZF9lbmQYBiABKAgSDgoGYW1vdW50GAcgASgDEhAKCGN1cnJlbmN5GAggASgJEhAKCGludGVydmFsGAkgASgJEhYKDmludGVydmFsX2NvdW50GAogASgFEhMKC2Rlc2NyaXB0aW9uGAsgASgJEisKB2NyZWF0ZWQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wInUKJlVwZGF0ZVN1YnNjcmlwdGlvblBheW1lbnRNZXRob2RSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIXCg9zdWJzY3JpcHRpb25faWQYAiABKAkSGQoRcGF5bWVudF9tZXRob2RfaWQYAyABKAkieAonVXBkYXRlU3Vic2NyaXB0aW9uUGF5bWVudE1ldGhvZFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSPAoMc3Vic2NyaXB0aW9uGAIgASgLMiYub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlN1YnNjcmlwdGlvbiJNChlDYW5jZWxTdWJzY3JpcHRpb25SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIXCg9zdWJzY3JpcHRpb25faWQYAiABKAkifAoaQ2FuY2VsU3Vic2NyaXB0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEjwKDHN1YnNjcmlwdGlvbhgDIAEoCzImLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5TdWJzY3JpcHRpb24iOgoOUGF5QmlsbFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg8KB2JpbGxfaWQYAiABKAkiaAoPUGF5QmlsbFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCRIzCgRiaWxsGAMgASgLMiUub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLk1vbnRobHlCaWxsIkkKEExpc3RCaWxsc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhIKBWxpbWl0GAIgASgFSACIAQFCCAoGX2xpbWl0IlsKEUxpc3RCaWxsc1Jlc3BvbnNlEjQKBWJpbGxzGAEgAygLMiUub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLk1vbnRobHlCaWxsEhAKCGhhc19tb3JlGAIgASgIIuQDCgtNb250aGx5QmlsbBIKCgJpZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSOAoUYmlsbGluZ19wZXJpb2Rfc3RhcnQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjYKEmJpbGxpbmdfcGVyaW9kX2VuZBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMYW1vdW50X2NlbnRzGAUgASgDEg4KBnN0YXR1cxgGIAEoCRIwCgdwYWlkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEiwKCGR1ZV9kYXRlGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIcCg91c2FnZV9icmVha2Rvd24YCSABKAlIAYgBARIRCgRub3RlGAogASgJSAKIAQESLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCCgoIX3BhaWRfYXRCEgoQX3VzYWdlX2JyZWFrZG93bkIHCgVfbm90ZSI1ChpHZW5lcmF0ZUN1cnJlbnRCaWxsUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkijAEKG0dlbmVyYXRlQ3VycmVudEJpbGxSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkSMwoEYmlsbBgDIAEoCzIlLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5Nb250aGx5QmlsbBIWCg5hbHJlYWR5X2V4aXN0cxgEIAEoCDK+FgoOQmlsbGluZ1NlcnZpY2USiAEKFUNyZWF0ZUNoZWNrb3V0U2Vzc2lvbhI2Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5DcmVhdGVDaGVja291dFNlc3Npb25SZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkNyZWF0ZUNoZWNrb3V0U2Vzc2lvblJlc3BvbnNlEoIBChNDcmVhdGVQYXltZW50SW50ZW50EjQub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkNyZWF0ZVBheW1lbnRJbnRlbnRSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkNyZWF0ZVBheW1lbnRJbnRlbnRSZXNwb25zZRKCAQoTQ3JlYXRlUG9ydGFsU2Vzc2lvbhI0Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5DcmVhdGVQb3J0YWxTZXNzaW9uUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5DcmVhdGVQb3J0YWxTZXNzaW9uUmVzcG9uc2USfAoRQ3JlYXRlU2V0dXBJbnRlbnQSMi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ3JlYXRlU2V0dXBJbnRlbnRSZXF1ZXN0GjMub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkNyZWF0ZVNldHVwSW50ZW50UmVzcG9uc2USfAoRR2V0QmlsbGluZ0FjY291bnQSMi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuR2V0QmlsbGluZ0FjY291bnRSZXF1ZXN0GjMub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkdldEJpbGxpbmdBY2NvdW50UmVzcG9uc2UShQEKFFVwZGF0ZUJpbGxpbmdBY2NvdW50EjUub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlVwZGF0ZUJpbGxpbmdBY2NvdW50UmVxdWVzdBo2Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5VcGRhdGVCaWxsaW5nQWNjb3VudFJlc3BvbnNlEn8KEkxpc3RQYXltZW50TWV0aG9kcxIzLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5MaXN0UGF5bWVudE1ldGhvZHNSZXF1ZXN0GjQub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkxpc3RQYXltZW50TWV0aG9kc1Jlc3BvbnNlEoIBChNBdHRhY2hQYXltZW50TWV0aG9kEjQub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkF0dGFjaFBheW1lbnRNZXRob2RSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkF0dGFjaFBheW1lbnRNZXRob2RSZXNwb25zZRKCAQoTRGV0YWNoUGF5bWVudE1ldGhvZBI0Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5EZXRhY2hQYXltZW50TWV0aG9kUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5EZXRhY2hQYXltZW50TWV0aG9kUmVzcG9uc2USjgEKF1NldERlZmF1bHRQYXltZW50TWV0aG9kEjgub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlNldERlZmF1bHRQYXltZW50TWV0aG9kUmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5TZXREZWZhdWx0UGF5bWVudE1ldGhvZFJlc3BvbnNlEnkKEEdldFBheW1lbnRTdGF0dXMSMS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuR2V0UGF5bWVudFN0YXR1c1JlcXVlc3QaMi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuR2V0UGF5bWVudFN0YXR1c1Jlc3BvbnNlEm0KDExpc3RJbnZvaWNlcxItLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5MaXN0SW52b2ljZXNSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkxpc3RJbnZvaWNlc1Jlc3BvbnNlEr4BCidDcmVhdGVETlNEZWxlZ2F0aW9uU3Vic2NyaXB0aW9uQ2hlY2tvdXQSSC5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ3JlYXRlRE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvbkNoZWNrb3V0UmVxdWVzdBpJLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5DcmVhdGVETlNEZWxlZ2F0aW9uU3Vic2NyaXB0aW9uQ2hlY2tvdXRSZXNwb25zZRKvAQoiR2V0RE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvblN0YXR1cxJDLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZXRETlNEZWxlZ2F0aW9uU3Vic2NyaXB0aW9uU3RhdHVzUmVxdWVzdBpELm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZXRETlNEZWxlZ2F0aW9uU3Vic2NyaXB0aW9uU3RhdHVzUmVzcG9uc2USpgEKH0NhbmNlbEROU0RlbGVnYXRpb25TdWJzY3JpcHRpb24SQC5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ2FuY2VsRE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvblJlcXVlc3QaQS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ2FuY2VsRE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvblJlc3BvbnNlEnwKEUxpc3RTdWJzY3JpcHRpb25zEjIub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkxpc3RTdWJzY3JpcHRpb25zUmVxdWVzdBozLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5MaXN0U3Vic2NyaXB0aW9uc1Jlc3BvbnNlEqYBCh9VcGRhdGVTdWJzY3JpcHRpb25QYXltZW50TWV0aG9kEkAub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlVwZGF0ZVN1YnNjcmlwdGlvblBheW1lbnRNZXRob2RSZXF1ZXN0GkEub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlVwZGF0ZVN1YnNjcmlwdGlvblBheW1lbnRNZXRob2RSZXNwb25zZRJ/ChJDYW5jZWxTdWJzY3JpcHRpb24SMy5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ2FuY2VsU3Vic2NyaXB0aW9uUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5DYW5jZWxTdWJzY3JpcHRpb25SZXNwb25zZRJeCgdQYXlCaWxsEigub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlBheUJpbGxSZXF1ZXN0Gikub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlBheUJpbGxSZXNwb25zZRJkCglMaXN0QmlsbHMSKi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuTGlzdEJpbGxzUmVxdWVzdBorLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5MaXN0QmlsbHNSZXNwb25zZRKCAQoTR2VuZXJhdGVDdXJyZW50QmlsbBI0Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZW5lcmF0ZUN1cnJlbnRCaWxsUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZW5lcmF0ZUN1cnJlbnRCaWxsUmVzcG9uc2VCT1pNZ2l0aHViLmNvbS9vYmllbnRlL2Nsb3VkL2FwcHMvc2hhcmVkL3Byb3RvL29iaWVudGUvY2xvdWQvYmlsbGluZy92MTtiaWxsaW5ndjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("Ci5vYmllbnRlL2Nsb3VkL2JpbGxpbmcvdjEvYmlsbGluZ19zZXJ2aWNlLnByb3RvEhhvYmllbnRlLmNsb3VkLmJpbGxpbmcudjEinwEKHENyZWF0ZUNoZWNrb3V0U2Vzc2lvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhQKDGFtb3VudF9jZW50cxgCIAEoAxIYCgtzdWNjZXNzX3VybBgDIAEoCUgAiAEBEhcKCmNhbmNlbF91cmwYBCABKAlIAYgBAUIOCgxfc3VjY2Vzc191cmxCDQoLX2NhbmNlbF91cmwiSQodQ3JlYXRlQ2hlY2tvdXRTZXNzaW9uUmVzcG9uc2USEgoKc2Vzc2lvbl9pZBgBIAEoCRIUCgxjaGVja291dF91cmwYAiABKAkigQEKGkNyZWF0ZVBheW1lbnRJbnRlbnRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIUCgxhbW91bnRfY2VudHMYAiABKAMSHgoRcGF5bWVudF9tZXRob2RfaWQYAyABKAlIAIgBAUIUChJfcGF5bWVudF9tZXRob2RfaWQiTwobQ3JlYXRlUGF5bWVudEludGVudFJlc3BvbnNlEhkKEXBheW1lbnRfaW50ZW50X2lkGAEgASgJEhUKDWNsaWVudF9zZWNyZXQYAiABKAkiXQoaQ3JlYXRlUG9ydGFsU2Vzc2lvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhcKCnJldHVybl91cmwYAiABKAlIAIgBAUINCgtfcmV0dXJuX3VybCIxChtDcmVhdGVQb3J0YWxTZXNzaW9uUmVzcG9uc2USEgoKcG9ydGFsX3VybBgBIAEoCSIzChhHZXRCaWxsaW5nQWNjb3VudFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIlYKGUdldEJpbGxpbmdBY2NvdW50UmVzcG9uc2USOQoHYWNjb3VudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5CaWxsaW5nQWNjb3VudCLJAgobVXBkYXRlQmlsbGluZ0FjY291bnRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIaCg1iaWxsaW5nX2VtYWlsGAIgASgJSACIAQESGQoMY29tcGFueV9uYW1lGAMgASgJSAGIAQESEwoGdGF4X2lkGAQgASgJSAKIAQESNwoHYWRkcmVzcxgFIAEoCzIhLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5BZGRyZXNzSAOIAQESGQoMYmlsbGluZ19kYXRlGAYgASgFSASIAQESFwoKdmF0X251bWJlchgHIAEoCUgFiAEBQhAKDl9iaWxsaW5nX2VtYWlsQg8KDV9jb21wYW55X25hbWVCCQoHX3RheF9pZEIKCghfYWRkcmVzc0IPCg1fYmlsbGluZ19kYXRlQg0KC192YXRfbnVtYmVyIlkKHFVwZGF0ZUJpbGxpbmdBY2NvdW50UmVzcG9uc2USOQoHYWNjb3VudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5CaWxsaW5nQWNjb3VudCI0ChlMaXN0UGF5bWVudE1ldGhvZHNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSJeChpMaXN0UGF5bWVudE1ldGhvZHNSZXNwb25zZRJACg9wYXltZW50X21ldGhvZHMYASADKAsyJy5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuUGF5bWVudE1ldGhvZCI0ChdHZXRQYXltZW50U3RhdHVzUmVxdWVzdBIZChFwYXltZW50X2ludGVudF9pZBgBIAEoCSJYChhHZXRQYXltZW50U3RhdHVzUmVzcG9uc2USDgoGc3RhdHVzGAEgASgJEhoKDWVycm9yX21lc3NhZ2UYAiABKAlIAIgBAUIQCg5fZXJyb3JfbWVzc2FnZSJbChhDcmVhdGVTZXR1cEludGVudFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhcKCnJldHVybl91cmwYAiABKAlIAIgBAUINCgtfcmV0dXJuX3VybCJLChlDcmVhdGVTZXR1cEludGVudFJlc3BvbnNlEhUKDWNsaWVudF9zZWNyZXQYASABKAkSFwoPc2V0dXBfaW50ZW50X2lkGAIgASgJIlAKGkF0dGFjaFBheW1lbnRNZXRob2RSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIZChFwYXltZW50X21ldGhvZF9pZBgCIAEoCSJeChtBdHRhY2hQYXltZW50TWV0aG9kUmVzcG9uc2USPwoOcGF5bWVudF9tZXRob2QYASABKAsyJy5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuUGF5bWVudE1ldGhvZCJQChpEZXRhY2hQYXltZW50TWV0aG9kUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSGQoRcGF5bWVudF9tZXRob2RfaWQYAiABKAkiLgobRGV0YWNoUGF5bWVudE1ldGhvZFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiVAoeU2V0RGVmYXVsdFBheW1lbnRNZXRob2RSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIZChFwYXltZW50X21ldGhvZF9pZBgCIAEoCSIyCh9TZXREZWZhdWx0UGF5bWVudE1ldGhvZFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiTAoTTGlzdEludm9pY2VzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSEgoFbGltaXQYAiABKAVIAIgBAUIICgZfbGltaXQiXQoUTGlzdEludm9pY2VzUmVzcG9uc2USMwoIaW52b2ljZXMYASADKAsyIS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuSW52b2ljZRIQCghoYXNfbW9yZRgCIAEoCCL+BAoHSW52b2ljZRIKCgJpZBgBIAEoCRIOCgZudW1iZXIYAiABKAkSDgoGc3RhdHVzGAMgASgJEhIKCmFtb3VudF9kdWUYBCABKAMSEwoLYW1vdW50X3BhaWQYBSABKAMSEAoIY3VycmVuY3kYBiABKAkSKAoEZGF0ZRgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMQoIZHVlX2RhdGUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESGAoLaW52b2ljZV9wZGYYCSABKAlIAYgBARIfChJob3N0ZWRfaW52b2ljZV91cmwYCiABKAlIAogBARIYCgtkZXNjcmlwdGlvbhgLIAEoCUgDiAEBEhUKCHN1YnRvdGFsGAwgASgDSASIAQESEgoFdG90YWwYDSABKANIBYgBARIdChBhbW91bnRfcmVtYWluaW5nGA4gASgDSAaIAQESMAoHcGFpZF9hdBgPIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIB4gBARIaCg1hdHRlbXB0X2NvdW50GBAgASgFSAiIAQESHgoRY29sbGVjdGlvbl9tZXRob2QYESABKAlICYgBAUILCglfZHVlX2RhdGVCDgoMX2ludm9pY2VfcGRmQhUKE19ob3N0ZWRfaW52b2ljZV91cmxCDgoMX2Rlc2NyaXB0aW9uQgsKCV9zdWJ0b3RhbEIICgZfdG90YWxCEwoRX2Ftb3VudF9yZW1haW5pbmdCCgoIX3BhaWRfYXRCEAoOX2F0dGVtcHRfY291bnRCFAoSX2NvbGxlY3Rpb25fbWV0aG9kIoIECg5CaWxsaW5nQWNjb3VudBIKCgJpZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSHwoSc3RyaXBlX2N1c3RvbWVyX2lkGAMgASgJSACIAQESDgoGc3RhdHVzGAQgASgJEhoKDWJpbGxpbmdfZW1haWwYBSABKAlIAYgBARIZCgxjb21wYW55X25hbWUYBiABKAlIAogBARITCgZ0YXhfaWQYByABKAlIA4gBARI3CgdhZGRyZXNzGAggASgLMiEub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkFkZHJlc3NIBIgBARIZCgxiaWxsaW5nX2RhdGUYCSABKAVIBYgBARIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCgp2YXRfbnVtYmVyGAwgASgJSAaIAQESEAoIY3VycmVuY3kYDSABKAlCFQoTX3N0cmlwZV9jdXN0b21lcl9pZEIQCg5fYmlsbGluZ19lbWFpbEIPCg1fY29tcGFueV9uYW1lQgkKB190YXhfaWRCCgoIX2FkZHJlc3NCDwoNX2JpbGxpbmdfZGF0ZUINCgtfdmF0X251bWJlciKwAQoNUGF5bWVudE1ldGhvZBIKCgJpZBgBIAEoCRIMCgR0eXBlGAIgASgJEjgKBGNhcmQYAyABKAsyJS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ2FyZERldGFpbHNIAIgBARISCgppc19kZWZhdWx0GAQgASgIEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgcKBV9jYXJkImwKC0NhcmREZXRhaWxzEg0KBWJyYW5kGAEgASgJEg0KBWxhc3Q0GAIgASgJEhEKCWV4cF9tb250aBgDIAEoBRIQCghleHBfeWVhchgEIAEoBRIRCgRuYW1lGAUgASgJSACIAQFCBwoFX25hbWUiiAEKB0FkZHJlc3MSDQoFbGluZTEYASABKAkSEgoFbGluZTIYAiABKAlIAIgBARIMCgRjaXR5GAMgASgJEhIKBXN0YXRlGAQgASgJSAGIAQESEwoLcG9zdGFsX2NvZGUYBSABKAkSDwoHY291bnRyeRgGIAEoCUIICgZfbGluZTJCCAoGX3N0YXRlIpsBCi5DcmVhdGVETlNEZWxlZ2F0aW9uU3Vic2NyaXB0aW9uQ2hlY2tvdXRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIYCgtzdWNjZXNzX3VybBgCIAEoCUgAiAEBEhcKCmNhbmNlbF91cmwYAyABKAlIAYgBAUIOCgxfc3VjY2Vzc191cmxCDQoLX2NhbmNlbF91cmwiWwovQ3JlYXRlRE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvbkNoZWNrb3V0UmVzcG9uc2USEgoKc2Vzc2lvbl9pZBgBIAEoCRIUCgxjaGVja291dF91cmwYAiABKAkiRAopR2V0RE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvblN0YXR1c1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIq0CCipHZXRETlNEZWxlZ2F0aW9uU3Vic2NyaXB0aW9uU3RhdHVzUmVzcG9uc2USHwoXaGFzX2FjdGl2ZV9zdWJzY3JpcHRpb24YASABKAgSHgoWc3RyaXBlX3N1YnNjcmlwdGlvbl9pZBgCIAEoCRITCgtoYXNfYXBpX2tleRgDIAEoCBI2ChJhcGlfa2V5X2NyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhwKFGNhbmNlbF9hdF9wZXJpb2RfZW5kGAUgASgIEjYKEmN1cnJlbnRfcGVyaW9kX2VuZBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGwoTYXBpX2tleV9kZXNjcmlwdGlvbhgHIAEoCSJBCiZDYW5jZWxETlNEZWxlZ2F0aW9uU3Vic2NyaXB0aW9uUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkifAonQ2FuY2VsRE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCRIvCgtjYW5jZWxlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiMwoYTGlzdFN1YnNjcmlwdGlvbnNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSJaChlMaXN0U3Vic2NyaXB0aW9uc1Jlc3BvbnNlEj0KDXN1YnNjcmlwdGlvbnMYASADKAsyJi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuU3Vic2NyaXB0aW9uIvkCCgxTdWJzY3JpcHRpb24SCgoCaWQYASABKAkSDgoGc3RhdHVzGAIgASgJEjgKFGN1cnJlbnRfcGVyaW9kX3N0YXJ0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI2ChJjdXJyZW50X3BlcmlvZF9lbmQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC2NhbmNlbGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIcChRjYW5jZWxfYXRfcGVyaW9kX2VuZBgGIAEoCBIOCgZhbW91bnQYByABKAMSEAoIY3VycmVuY3kYCCABKAkSEAoIaW50ZXJ2YWwYCSABKAkSFgoOaW50ZXJ2YWxfY291bnQYCiABKAUSEwoLZGVzY3JpcHRpb24YCyABKAkSKwoHY3JlYXRlZBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAidQomVXBkYXRlU3Vic2NyaXB0aW9uUGF5bWVudE1ldGhvZFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhcKD3N1YnNjcmlwdGlvbl9pZBgCIAEoCRIZChFwYXltZW50X21ldGhvZF9pZBgDIAEoCSJ4CidVcGRhdGVTdWJzY3JpcHRpb25QYXltZW50TWV0aG9kUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI8CgxzdWJzY3JpcHRpb24YAiABKAsyJi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuU3Vic2NyaXB0aW9uIk0KGUNhbmNlbFN1YnNjcmlwdGlvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhcKD3N1YnNjcmlwdGlvbl9pZBgCIAEoCSJ8ChpDYW5jZWxTdWJzY3JpcHRpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkSPAoMc3Vic2NyaXB0aW9uGAMgASgLMiYub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlN1YnNjcmlwdGlvbiI6Cg5QYXlCaWxsUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDwoHYmlsbF9pZBgCIAEoCSJoCg9QYXlCaWxsUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEjMKBGJpbGwYAyABKAsyJS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuTW9udGhseUJpbGwiSQoQTGlzdEJpbGxzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSEgoFbGltaXQYAiABKAVIAIgBAUIICgZfbGltaXQiWwoRTGlzdEJpbGxzUmVzcG9uc2USNAoFYmlsbHMYASADKAsyJS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuTW9udGhseUJpbGwSEAoIaGFzX21vcmUYAiABKAginAQKC01vbnRobHlCaWxsEgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRI4ChRiaWxsaW5nX3BlcmlvZF9zdGFydBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNgoSYmlsbGluZ19wZXJpb2RfZW5kGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxhbW91bnRfY2VudHMYBSABKAMSDgoGc3RhdHVzGAYgASgJEjAKB3BhaWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESLAoIZHVlX2RhdGUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhwKD3VzYWdlX2JyZWFrZG93bhgJIAEoCUgBiAEBEhEKBG5vdGUYCiABKAlIAogBARIuCgpjcmVhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIYChBkaXNwbGF5X2N1cnJlbmN5GA0gASgJEhwKFGRpc3BsYXlfYW1vdW50X2NlbnRzGA4gASgDQgoKCF9wYWlkX2F0QhIKEF91c2FnZV9icmVha2Rvd25CBwoFX25vdGUiNQoaR2VuZXJhdGVDdXJyZW50QmlsbFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIowBChtHZW5lcmF0ZUN1cnJlbnRCaWxsUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEjMKBGJpbGwYAyABKAsyJS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuTW9udGhseUJpbGwSFgoOYWxyZWFkeV9leGlzdHMYBCABKAgiQAoWRG93bmxvYWRJbnZvaWNlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDQoFbW9udGgYAiABKAkiTgoXRG93bmxvYWRJbnZvaWNlUmVzcG9uc2USDQoFY2h1bmsYASABKAwSEAoIZmlsZW5hbWUYAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoAyKGAwoMRHVubmluZ1N0YXRlEhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRISCgppbnZvaWNlX2lkGAIgASgJEhUKDWF0dGVtcHRfY291bnQYAyABKAUSDQoFc3RhZ2UYBCABKAkSMwoPd2FybmluZ19zZW50X2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBJCCh5yZXNvdXJjZV9jcmVhdGlvbl9zdXNwZW5kZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjoKFnJlc291cmNlc19zdXNwZW5kZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjoKFm1hcmtlZF9mb3JfZGVsZXRpb25fYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjIKDmxhc3RfZmFpbGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIxChZHZXREdW5uaW5nU3RhdGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSJQChdHZXREdW5uaW5nU3RhdGVSZXNwb25zZRI1CgVzdGF0ZRgBIAEoCzImLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5EdW5uaW5nU3RhdGUiMwoYUmVzZXREdW5uaW5nU3RhdGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSI9ChlSZXNldER1bm5pbmdTdGF0ZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJyCgxSZWZlcnJhbENvZGUSDAoEY29kZRgBIAEoCRIQCghtYXhfdXNlcxgCIAEoBRISCgp1c2VzX2NvdW50GAMgASgFEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIj8KGUNyZWF0ZVJlZmVycmFsQ29kZVJlcXVlc3QSFQoIbWF4X3VzZXMYASABKAVIAIgBAUILCglfbWF4X3VzZXMiWwoaQ3JlYXRlUmVmZXJyYWxDb2RlUmVzcG9uc2USPQoNcmVmZXJyYWxfY29kZRgBIAEoCzImLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5SZWZlcnJhbENvZGUiGAoWR2V0UmVmZXJyYWxDb2RlUmVxdWVzdCJYChdHZXRSZWZlcnJhbENvZGVSZXNwb25zZRI9Cg1yZWZlcnJhbF9jb2RlGAEgASgLMiYub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlJlZmVycmFsQ29kZSIpChlSZWRlZW1SZWZlcnJhbENvZGVSZXF1ZXN0EgwKBGNvZGUYASABKAkiTQoaUmVkZWVtUmVmZXJyYWxDb2RlUmVzcG9uc2USFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhYKDmNyZWRpdGVkX2NlbnRzGAIgASgDInYKCEN1cnJlbmN5EgwKBGNvZGUYASABKAkSDgoGc3ltYm9sGAIgASgJEhwKFGV4Y2hhbmdlX3JhdGVfdG9fdXNkGAMgASgBEi4KCnVwZGF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIiwKEUdldEJhbGFuY2VSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSJ6ChJHZXRCYWxhbmNlUmVzcG9uc2USFQoNYmFsYW5jZV9jZW50cxgBIAEoAxIQCghjdXJyZW5jeRgCIAEoCRIdChVkaXNwbGF5X2JhbGFuY2VfY2VudHMYAyABKAMSHAoUZXhjaGFuZ2VfcmF0ZV90b191c2QYBCABKAEiHwodR2V0U3VwcG9ydGVkQ3VycmVuY2llc1JlcXVlc3QiWAoeR2V0U3VwcG9ydGVkQ3VycmVuY2llc1Jlc3BvbnNlEjYKCmN1cnJlbmNpZXMYASADKAsyIi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ3VycmVuY3kiSAobU2V0UHJlZmVycmVkQ3VycmVuY3lSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIQCghjdXJyZW5jeRgCIAEoCSJZChxTZXRQcmVmZXJyZWRDdXJyZW5jeVJlc3BvbnNlEjkKB2FjY291bnQYASABKAsyKC5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQmlsbGluZ0FjY291bnQivwEKClNwZW5kQWxlcnQSCgoCaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEhcKD3RocmVzaG9sZF9jZW50cxgDIAEoAxISCgphbGVydF90eXBlGAQgASgJEi8KC25vdGlmaWVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJfChdDcmVhdGVTcGVuZEFsZXJ0UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFwoPdGhyZXNob2xkX2NlbnRzGAIgASgDEhIKCmFsZXJ0X3R5cGUYAyABKAkiTwoYQ3JlYXRlU3BlbmRBbGVydFJlc3BvbnNlEjMKBWFsZXJ0GAEgASgLMiQub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlNwZW5kQWxlcnQiRAoXRGVsZXRlU3BlbmRBbGVydFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhAKCGFsZXJ0X2lkGAIgASgJIisKGERlbGV0ZVNwZW5kQWxlcnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIjEKFkxpc3RTcGVuZEFsZXJ0c1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJImoKF0xpc3RTcGVuZEFsZXJ0c1Jlc3BvbnNlEjQKBmFsZXJ0cxgBIAMoCzIkLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5TcGVuZEFsZXJ0EhkKEW1vbnRoX3NwZW5kX2NlbnRzGAIgASgDIuUBCg1TcGVuZEZvcmVjYXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRITCgtwZXJpb2RfZGF5cxgCIAEoBRIXCg9wcm9qZWN0ZWRfY2VudHMYAyABKAMSEwoLbG93ZXJfY2VudHMYBCABKAMSEwoLdXBwZXJfY2VudHMYBSABKAMSGwoTdHJlbmRfY2VudHNfcGVyX2RheRgGIAEoARIUCgxoaXN0b3J5X2RheXMYByABKAUSMAoMZ2VuZXJhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJHChdHZXRTcGVuZEZvcmVjYXN0UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSEwoLcGVyaW9kX2RheXMYAiABKAUiVQoYR2V0U3BlbmRGb3JlY2FzdFJlc3BvbnNlEjkKCGZvcmVjYXN0GAEgASgLMicub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlNwZW5kRm9yZWNhc3QiuAMKFFRheEV4ZW1wdENlcnRpZmljYXRlEgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIYChBjZXJ0aWZpY2F0ZV90eXBlGAMgASgJEhoKEmNlcnRpZmljYXRlX251bWJlchgEIAEoCRIZChFpc3N1aW5nX2F1dGhvcml0eRgFIAEoCRIuCgp2YWxpZF9mcm9tGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgt2YWxpZF91bnRpbBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGc3RhdHVzGAggASgJEhgKC3ZlcmlmaWVkX2J5GAkgASgJSACIAQESLwoLdmVyaWZpZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnJldm9rZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQg4KDF92ZXJpZmllZF9ieSLuAQohU3VibWl0VGF4RXhlbXB0Q2VydGlmaWNhdGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIYChBjZXJ0aWZpY2F0ZV90eXBlGAIgASgJEhoKEmNlcnRpZmljYXRlX251bWJlchgDIAEoCRIZChFpc3N1aW5nX2F1dGhvcml0eRgEIAEoCRIuCgp2YWxpZF9mcm9tGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgt2YWxpZF91bnRpbBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiaQoiU3VibWl0VGF4RXhlbXB0Q2VydGlmaWNhdGVSZXNwb25zZRJDCgtjZXJ0aWZpY2F0ZRgBIAEoCzIuLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5UYXhFeGVtcHRDZXJ0aWZpY2F0ZSI7CiFWZXJpZnlUYXhFeGVtcHRDZXJ0aWZpY2F0ZVJlcXVlc3QSFgoOY2VydGlmaWNhdGVfaWQYASABKAkiaQoiVmVyaWZ5VGF4RXhlbXB0Q2VydGlmaWNhdGVSZXNwb25zZRJDCgtjZXJ0aWZpY2F0ZRgBIAEoCzIuLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5UYXhFeGVtcHRDZXJ0aWZpY2F0ZSI7CiFSZXZva2VUYXhFeGVtcHRDZXJ0aWZpY2F0ZVJlcXVlc3QSFgoOY2VydGlmaWNhdGVfaWQYASABKAkiaQoiUmV2b2tlVGF4RXhlbXB0Q2VydGlmaWNhdGVSZXNwb25zZRJDCgtjZXJ0aWZpY2F0ZRgBIAEoCzIuLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5UYXhFeGVtcHRDZXJ0aWZpY2F0ZTLeJgoOQmlsbGluZ1NlcnZpY2USiAEKFUNyZWF0ZUNoZWNrb3V0U2Vzc2lvbhI2Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5DcmVhdGVDaGVja291dFNlc3Npb25SZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkNyZWF0ZUNoZWNrb3V0U2Vzc2lvblJlc3BvbnNlEoIBChNDcmVhdGVQYXltZW50SW50ZW50EjQub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkNyZWF0ZVBheW1lbnRJbnRlbnRSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkNyZWF0ZVBheW1lbnRJbnRlbnRSZXNwb25zZRKCAQoTQ3JlYXRlUG9ydGFsU2Vzc2lvbhI0Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5DcmVhdGVQb3J0YWxTZXNzaW9uUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5DcmVhdGVQb3J0YWxTZXNzaW9uUmVzcG9uc2USfAoRQ3JlYXRlU2V0dXBJbnRlbnQSMi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ3JlYXRlU2V0dXBJbnRlbnRSZXF1ZXN0GjMub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkNyZWF0ZVNldHVwSW50ZW50UmVzcG9uc2USfAoRR2V0QmlsbGluZ0FjY291bnQSMi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuR2V0QmlsbGluZ0FjY291bnRSZXF1ZXN0GjMub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkdldEJpbGxpbmdBY2NvdW50UmVzcG9uc2UShQEKFFVwZGF0ZUJpbGxpbmdBY2NvdW50EjUub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlVwZGF0ZUJpbGxpbmdBY2NvdW50UmVxdWVzdBo2Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5VcGRhdGVCaWxsaW5nQWNjb3VudFJlc3BvbnNlEn8KEkxpc3RQYXltZW50TWV0aG9kcxIzLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5MaXN0UGF5bWVudE1ldGhvZHNSZXF1ZXN0GjQub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkxpc3RQYXltZW50TWV0aG9kc1Jlc3BvbnNlEoIBChNBdHRhY2hQYXltZW50TWV0aG9kEjQub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkF0dGFjaFBheW1lbnRNZXRob2RSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkF0dGFjaFBheW1lbnRNZXRob2RSZXNwb25zZRKCAQoTRGV0YWNoUGF5bWVudE1ldGhvZBI0Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5EZXRhY2hQYXltZW50TWV0aG9kUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5EZXRhY2hQYXltZW50TWV0aG9kUmVzcG9uc2USjgEKF1NldERlZmF1bHRQYXltZW50TWV0aG9kEjgub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlNldERlZmF1bHRQYXltZW50TWV0aG9kUmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5TZXREZWZhdWx0UGF5bWVudE1ldGhvZFJlc3BvbnNlEnkKEEdldFBheW1lbnRTdGF0dXMSMS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuR2V0UGF5bWVudFN0YXR1c1JlcXVlc3QaMi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuR2V0UGF5bWVudFN0YXR1c1Jlc3BvbnNlEm0KDExpc3RJbnZvaWNlcxItLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5MaXN0SW52b2ljZXNSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkxpc3RJbnZvaWNlc1Jlc3BvbnNlEr4BCidDcmVhdGVETlNEZWxlZ2F0aW9uU3Vic2NyaXB0aW9uQ2hlY2tvdXQSSC5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ3JlYXRlRE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvbkNoZWNrb3V0UmVxdWVzdBpJLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5DcmVhdGVETlNEZWxlZ2F0aW9uU3Vic2NyaXB0aW9uQ2hlY2tvdXRSZXNwb25zZRKvAQoiR2V0RE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvblN0YXR1cxJDLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZXRETlNEZWxlZ2F0aW9uU3Vic2NyaXB0aW9uU3RhdHVzUmVxdWVzdBpELm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZXRETlNEZWxlZ2F0aW9uU3Vic2NyaXB0aW9uU3RhdHVzUmVzcG9uc2USpgEKH0NhbmNlbEROU0RlbGVnYXRpb25TdWJzY3JpcHRpb24SQC5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ2FuY2VsRE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvblJlcXVlc3QaQS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ2FuY2VsRE5TRGVsZWdhdGlvblN1YnNjcmlwdGlvblJlc3BvbnNlEnwKEUxpc3RTdWJzY3JpcHRpb25zEjIub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkxpc3RTdWJzY3JpcHRpb25zUmVxdWVzdBozLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5MaXN0U3Vic2NyaXB0aW9uc1Jlc3BvbnNlEqYBCh9VcGRhdGVTdWJzY3JpcHRpb25QYXltZW50TWV0aG9kEkAub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlVwZGF0ZVN1YnNjcmlwdGlvblBheW1lbnRNZXRob2RSZXF1ZXN0GkEub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlVwZGF0ZVN1YnNjcmlwdGlvblBheW1lbnRNZXRob2RSZXNwb25zZRJ/ChJDYW5jZWxTdWJzY3JpcHRpb24SMy5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ2FuY2VsU3Vic2NyaXB0aW9uUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5DYW5jZWxTdWJzY3JpcHRpb25SZXNwb25zZRJeCgdQYXlCaWxsEigub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlBheUJpbGxSZXF1ZXN0Gikub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlBheUJpbGxSZXNwb25zZRJkCglMaXN0QmlsbHMSKi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuTGlzdEJpbGxzUmVxdWVzdBorLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5MaXN0QmlsbHNSZXNwb25zZRKCAQoTR2VuZXJhdGVDdXJyZW50QmlsbBI0Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZW5lcmF0ZUN1cnJlbnRCaWxsUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZW5lcmF0ZUN1cnJlbnRCaWxsUmVzcG9uc2USeAoPRG93bmxvYWRJbnZvaWNlEjAub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkRvd25sb2FkSW52b2ljZVJlcXVlc3QaMS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuRG93bmxvYWRJbnZvaWNlUmVzcG9uc2UwARJ2Cg9HZXREdW5uaW5nU3RhdGUSMC5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuR2V0RHVubmluZ1N0YXRlUmVxdWVzdBoxLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZXREdW5uaW5nU3RhdGVSZXNwb25zZRJ8ChFSZXNldER1bm5pbmdTdGF0ZRIyLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5SZXNldER1bm5pbmdTdGF0ZVJlcXVlc3QaMy5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuUmVzZXREdW5uaW5nU3RhdGVSZXNwb25zZRJ/ChJDcmVhdGVSZWZlcnJhbENvZGUSMy5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ3JlYXRlUmVmZXJyYWxDb2RlUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5DcmVhdGVSZWZlcnJhbENvZGVSZXNwb25zZRJ2Cg9HZXRSZWZlcnJhbENvZGUSMC5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuR2V0UmVmZXJyYWxDb2RlUmVxdWVzdBoxLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZXRSZWZlcnJhbENvZGVSZXNwb25zZRJ/ChJSZWRlZW1SZWZlcnJhbENvZGUSMy5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuUmVkZWVtUmVmZXJyYWxDb2RlUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5SZWRlZW1SZWZlcnJhbENvZGVSZXNwb25zZRJnCgpHZXRCYWxhbmNlEisub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkdldEJhbGFuY2VSZXF1ZXN0Giwub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkdldEJhbGFuY2VSZXNwb25zZRKLAQoWR2V0U3VwcG9ydGVkQ3VycmVuY2llcxI3Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZXRTdXBwb3J0ZWRDdXJyZW5jaWVzUmVxdWVzdBo4Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5HZXRTdXBwb3J0ZWRDdXJyZW5jaWVzUmVzcG9uc2UShQEKFFNldFByZWZlcnJlZEN1cnJlbmN5EjUub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlNldFByZWZlcnJlZEN1cnJlbmN5UmVxdWVzdBo2Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5TZXRQcmVmZXJyZWRDdXJyZW5jeVJlc3BvbnNlEnkKEENyZWF0ZVNwZW5kQWxlcnQSMS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ3JlYXRlU3BlbmRBbGVydFJlcXVlc3QaMi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuQ3JlYXRlU3BlbmRBbGVydFJlc3BvbnNlEnkKEERlbGV0ZVNwZW5kQWxlcnQSMS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuRGVsZXRlU3BlbmRBbGVydFJlcXVlc3QaMi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuRGVsZXRlU3BlbmRBbGVydFJlc3BvbnNlEnYKD0xpc3RTcGVuZEFsZXJ0cxIwLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5MaXN0U3BlbmRBbGVydHNSZXF1ZXN0GjEub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkxpc3RTcGVuZEFsZXJ0c1Jlc3BvbnNlEnkKEEdldFNwZW5kRm9yZWNhc3QSMS5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuR2V0U3BlbmRGb3JlY2FzdFJlcXVlc3QaMi5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuR2V0U3BlbmRGb3JlY2FzdFJlc3BvbnNlEpcBChpTdWJtaXRUYXhFeGVtcHRDZXJ0aWZpY2F0ZRI7Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5TdWJtaXRUYXhFeGVtcHRDZXJ0aWZpY2F0ZVJlcXVlc3QaPC5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuU3VibWl0VGF4RXhlbXB0Q2VydGlmaWNhdGVSZXNwb25zZRKXAQoaVmVyaWZ5VGF4RXhlbXB0Q2VydGlmaWNhdGUSOy5vYmllbnRlLmNsb3VkLmJpbGxpbmcudjEuVmVyaWZ5VGF4RXhlbXB0Q2VydGlmaWNhdGVSZXF1ZXN0Gjwub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlZlcmlmeVRheEV4ZW1wdENlcnRpZmljYXRlUmVzcG9uc2USlwEKGlJldm9rZVRheEV4ZW1wdENlcnRpZmljYXRlEjsub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLlJldm9rZVRheEV4ZW1wdENlcnRpZmljYXRlUmVxdWVzdBo8Lm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5SZXZva2VUYXhFeGVtcHRDZXJ0aWZpY2F0ZVJlc3BvbnNlQk9aTWdpdGh1Yi5jb20vb2JpZW50ZS9jbG91ZC9hcHBzL3NoYXJlZC9wcm90by9vYmllbnRlL2Nsb3VkL2JpbGxpbmcvdjE7YmlsbGluZ3YxYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message obiente.cloud.billing.v1.CreateCheckoutSessionRequest
@@ -247,6 +247,13 @@ export type UpdateBillingAccountRequest = Message<"obiente.cloud.billing.v1.Upda
    * @generated from field: optional int32 billing_date = 6;
    */
   billingDate?: number;
+
+  /**
+   * EU VAT number (empty string clears it)
+   *
+   * @generated from field: optional string vat_number = 7;
+   */
+  vatNumber?: string;
 };
 
 /**
@@ -758,6 +765,20 @@ export type BillingAccount = Message<"obiente.cloud.billing.v1.BillingAccount">
    * @generated from field: google.protobuf.Timestamp updated_at = 11;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * EU VAT number; enables reverse charge for EU business customers
+   *
+   * @generated from field: optional string vat_number = 12;
+   */
+  vatNumber?: string;
+
+  /**
+   * ISO 4217 code of the preferred display currency (e.g. "USD")
+   *
+   * @generated from field: string currency = 13;
+   */
+  currency: string;
 };
 
 /**
@@ -1450,6 +1471,18 @@ export type MonthlyBill = Message<"obiente.cloud.billing.v1.MonthlyBill"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 12;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * The amount in the organization's preferred currency when the bill was created (amount_cents is USD)
+   *
+   * @generated from field: string display_currency = 13;
+   */
+  displayCurrency: string;
+
+  /**
+   * @generated from field: int64 display_amount_cents = 14;
+   */
+  displayAmountCents: bigint;
 };
 
 /**
@@ -1515,219 +1548,1371 @@ export const GenerateCurrentBillResponseSchema: GenMessage<GenerateCurrentBillRe
   messageDesc(file_obiente_cloud_billing_v1_billing_service, 48);
 
 /**
- * @generated from service obiente.cloud.billing.v1.BillingService
+ * @generated from message obiente.cloud.billing.v1.DownloadInvoiceRequest
  */
-export const BillingService: GenService<{
+export type DownloadInvoiceRequest = Message<"obiente.cloud.billing.v1.DownloadInvoiceRequest"> & {
   /**
-   * Create a Stripe Checkout Session for purchasing credits
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreateCheckoutSession
+   * @generated from field: string organization_id = 1;
    */
-  createCheckoutSession: {
-    methodKind: "unary";
-    input: typeof CreateCheckoutSessionRequestSchema;
-    output: typeof CreateCheckoutSessionResponseSchema;
-  },
+  organizationId: string;
+
   /**
-   * Create a Payment Intent for purchasing credits using an existing payment method
+   * Invoiced month in YYYY-MM format
    *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreatePaymentIntent
+   * @generated from field: string month = 2;
    */
-  createPaymentIntent: {
-    methodKind: "unary";
-    input: typeof CreatePaymentIntentRequestSchema;
-    output: typeof CreatePaymentIntentResponseSchema;
-  },
+  month: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.DownloadInvoiceRequest.
+ * Use `create(DownloadInvoiceRequestSchema)` to create a new message.
+ */
+export const DownloadInvoiceRequestSchema: GenMessage<DownloadInvoiceRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 49);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.DownloadInvoiceResponse
+ */
+export type DownloadInvoiceResponse = Message<"obiente.cloud.billing.v1.DownloadInvoiceResponse"> & {
   /**
-   * Create a Stripe Customer Portal session for managing billing
+   * Raw PDF data for this chunk
    *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreatePortalSession
+   * @generated from field: bytes chunk = 1;
    */
-  createPortalSession: {
-    methodKind: "unary";
-    input: typeof CreatePortalSessionRequestSchema;
-    output: typeof CreatePortalSessionResponseSchema;
-  },
+  chunk: Uint8Array;
+
   /**
-   * Create a Setup Intent for collecting payment methods without a payment
+   * Suggested filename (first message only)
    *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreateSetupIntent
+   * @generated from field: string filename = 2;
    */
-  createSetupIntent: {
-    methodKind: "unary";
-    input: typeof CreateSetupIntentRequestSchema;
-    output: typeof CreateSetupIntentResponseSchema;
-  },
+  filename: string;
+
   /**
-   * Get billing account information for an organization
+   * Total PDF size in bytes (first message only)
    *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.GetBillingAccount
+   * @generated from field: int64 total_size = 3;
    */
-  getBillingAccount: {
-    methodKind: "unary";
-    input: typeof GetBillingAccountRequestSchema;
-    output: typeof GetBillingAccountResponseSchema;
-  },
+  totalSize: bigint;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.DownloadInvoiceResponse.
+ * Use `create(DownloadInvoiceResponseSchema)` to create a new message.
+ */
+export const DownloadInvoiceResponseSchema: GenMessage<DownloadInvoiceResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 50);
+
+/**
+ * DunningState tracks the escalation of a failed invoice payment
+ *
+ * @generated from message obiente.cloud.billing.v1.DunningState
+ */
+export type DunningState = Message<"obiente.cloud.billing.v1.DunningState"> & {
   /**
-   * Create or update billing account
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.UpdateBillingAccount
+   * @generated from field: string organization_id = 1;
    */
-  updateBillingAccount: {
-    methodKind: "unary";
-    input: typeof UpdateBillingAccountRequestSchema;
-    output: typeof UpdateBillingAccountResponseSchema;
-  },
+  organizationId: string;
+
   /**
-   * List payment methods for an organization
+   * Stripe invoice that failed
    *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.ListPaymentMethods
+   * @generated from field: string invoice_id = 2;
    */
-  listPaymentMethods: {
-    methodKind: "unary";
-    input: typeof ListPaymentMethodsRequestSchema;
-    output: typeof ListPaymentMethodsResponseSchema;
-  },
+  invoiceId: string;
+
   /**
-   * Attach a payment method to customer
+   * Most recent failed payment attempt
    *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.AttachPaymentMethod
+   * @generated from field: int32 attempt_count = 3;
    */
-  attachPaymentMethod: {
-    methodKind: "unary";
-    input: typeof AttachPaymentMethodRequestSchema;
-    output: typeof AttachPaymentMethodResponseSchema;
-  },
+  attemptCount: number;
+
   /**
-   * Detach a payment method from customer
+   * "warning", "resource_creation_suspended", "resources_suspended" or "marked_for_deletion"
    *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.DetachPaymentMethod
+   * @generated from field: string stage = 4;
    */
-  detachPaymentMethod: {
-    methodKind: "unary";
-    input: typeof DetachPaymentMethodRequestSchema;
-    output: typeof DetachPaymentMethodResponseSchema;
-  },
+  stage: string;
+
   /**
-   * Set default payment method for customer
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.SetDefaultPaymentMethod
+   * @generated from field: google.protobuf.Timestamp warning_sent_at = 5;
    */
-  setDefaultPaymentMethod: {
-    methodKind: "unary";
-    input: typeof SetDefaultPaymentMethodRequestSchema;
-    output: typeof SetDefaultPaymentMethodResponseSchema;
-  },
+  warningSentAt?: Timestamp;
+
   /**
-   * Get payment intent status
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.GetPaymentStatus
+   * @generated from field: google.protobuf.Timestamp resource_creation_suspended_at = 6;
    */
-  getPaymentStatus: {
-    methodKind: "unary";
-    input: typeof GetPaymentStatusRequestSchema;
-    output: typeof GetPaymentStatusResponseSchema;
-  },
+  resourceCreationSuspendedAt?: Timestamp;
+
   /**
-   * List invoices for an organization
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.ListInvoices
+   * @generated from field: google.protobuf.Timestamp resources_suspended_at = 7;
    */
-  listInvoices: {
-    methodKind: "unary";
-    input: typeof ListInvoicesRequestSchema;
-    output: typeof ListInvoicesResponseSchema;
-  },
+  resourcesSuspendedAt?: Timestamp;
+
   /**
-   * Create a Stripe Checkout Session for DNS Delegation subscription ($2/month)
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreateDNSDelegationSubscriptionCheckout
+   * @generated from field: google.protobuf.Timestamp marked_for_deletion_at = 8;
    */
-  createDNSDelegationSubscriptionCheckout: {
-    methodKind: "unary";
-    input: typeof CreateDNSDelegationSubscriptionCheckoutRequestSchema;
-    output: typeof CreateDNSDelegationSubscriptionCheckoutResponseSchema;
-  },
+  markedForDeletionAt?: Timestamp;
+
   /**
-   * Get DNS delegation subscription status for an organization
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.GetDNSDelegationSubscriptionStatus
+   * @generated from field: google.protobuf.Timestamp last_failed_at = 9;
    */
-  getDNSDelegationSubscriptionStatus: {
-    methodKind: "unary";
-    input: typeof GetDNSDelegationSubscriptionStatusRequestSchema;
-    output: typeof GetDNSDelegationSubscriptionStatusResponseSchema;
-  },
+  lastFailedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.DunningState.
+ * Use `create(DunningStateSchema)` to create a new message.
+ */
+export const DunningStateSchema: GenMessage<DunningState> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 51);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.GetDunningStateRequest
+ */
+export type GetDunningStateRequest = Message<"obiente.cloud.billing.v1.GetDunningStateRequest"> & {
   /**
-   * Cancel DNS delegation subscription
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.CancelDNSDelegationSubscription
+   * @generated from field: string organization_id = 1;
    */
-  cancelDNSDelegationSubscription: {
-    methodKind: "unary";
-    input: typeof CancelDNSDelegationSubscriptionRequestSchema;
-    output: typeof CancelDNSDelegationSubscriptionResponseSchema;
-  },
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.GetDunningStateRequest.
+ * Use `create(GetDunningStateRequestSchema)` to create a new message.
+ */
+export const GetDunningStateRequestSchema: GenMessage<GetDunningStateRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 52);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.GetDunningStateResponse
+ */
+export type GetDunningStateResponse = Message<"obiente.cloud.billing.v1.GetDunningStateResponse"> & {
   /**
-   * List all subscriptions for an organization
+   * Unset when the organization has no failed payment in dunning
    *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.ListSubscriptions
+   * @generated from field: obiente.cloud.billing.v1.DunningState state = 1;
    */
-  listSubscriptions: {
-    methodKind: "unary";
-    input: typeof ListSubscriptionsRequestSchema;
-    output: typeof ListSubscriptionsResponseSchema;
-  },
+  state?: DunningState;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.GetDunningStateResponse.
+ * Use `create(GetDunningStateResponseSchema)` to create a new message.
+ */
+export const GetDunningStateResponseSchema: GenMessage<GetDunningStateResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 53);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.ResetDunningStateRequest
+ */
+export type ResetDunningStateRequest = Message<"obiente.cloud.billing.v1.ResetDunningStateRequest"> & {
   /**
-   * Update subscription payment method
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.UpdateSubscriptionPaymentMethod
+   * @generated from field: string organization_id = 1;
    */
-  updateSubscriptionPaymentMethod: {
-    methodKind: "unary";
-    input: typeof UpdateSubscriptionPaymentMethodRequestSchema;
-    output: typeof UpdateSubscriptionPaymentMethodResponseSchema;
-  },
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.ResetDunningStateRequest.
+ * Use `create(ResetDunningStateRequestSchema)` to create a new message.
+ */
+export const ResetDunningStateRequestSchema: GenMessage<ResetDunningStateRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 54);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.ResetDunningStateResponse
+ */
+export type ResetDunningStateResponse = Message<"obiente.cloud.billing.v1.ResetDunningStateResponse"> & {
   /**
-   * Cancel a subscription by ID
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.CancelSubscription
+   * @generated from field: bool success = 1;
    */
-  cancelSubscription: {
-    methodKind: "unary";
-    input: typeof CancelSubscriptionRequestSchema;
-    output: typeof CancelSubscriptionResponseSchema;
-  },
+  success: boolean;
+
   /**
-   * Pay a monthly bill prematurely (before due date)
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.PayBill
+   * @generated from field: string message = 2;
    */
-  payBill: {
-    methodKind: "unary";
-    input: typeof PayBillRequestSchema;
-    output: typeof PayBillResponseSchema;
-  },
+  message: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.ResetDunningStateResponse.
+ * Use `create(ResetDunningStateResponseSchema)` to create a new message.
+ */
+export const ResetDunningStateResponseSchema: GenMessage<ResetDunningStateResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 55);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.ReferralCode
+ */
+export type ReferralCode = Message<"obiente.cloud.billing.v1.ReferralCode"> & {
   /**
-   * List monthly bills for an organization
-   *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.ListBills
+   * @generated from field: string code = 1;
    */
-  listBills: {
-    methodKind: "unary";
-    input: typeof ListBillsRequestSchema;
-    output: typeof ListBillsResponseSchema;
-  },
+  code: string;
+
   /**
-   * Generate the current bill early (before billing date)
-   * This allows users to create and pay their current bill before their scheduled billing date
+   * 0 = unlimited
    *
-   * @generated from rpc obiente.cloud.billing.v1.BillingService.GenerateCurrentBill
+   * @generated from field: int32 max_uses = 2;
    */
-  generateCurrentBill: {
-    methodKind: "unary";
-    input: typeof GenerateCurrentBillRequestSchema;
-    output: typeof GenerateCurrentBillResponseSchema;
+  maxUses: number;
+
+  /**
+   * @generated from field: int32 uses_count = 3;
+   */
+  usesCount: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.ReferralCode.
+ * Use `create(ReferralCodeSchema)` to create a new message.
+ */
+export const ReferralCodeSchema: GenMessage<ReferralCode> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 56);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.CreateReferralCodeRequest
+ */
+export type CreateReferralCodeRequest = Message<"obiente.cloud.billing.v1.CreateReferralCodeRequest"> & {
+  /**
+   * Maximum number of redemptions (unset or 0 = unlimited)
+   *
+   * @generated from field: optional int32 max_uses = 1;
+   */
+  maxUses?: number;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.CreateReferralCodeRequest.
+ * Use `create(CreateReferralCodeRequestSchema)` to create a new message.
+ */
+export const CreateReferralCodeRequestSchema: GenMessage<CreateReferralCodeRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 57);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.CreateReferralCodeResponse
+ */
+export type CreateReferralCodeResponse = Message<"obiente.cloud.billing.v1.CreateReferralCodeResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.billing.v1.ReferralCode referral_code = 1;
+   */
+  referralCode?: ReferralCode;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.CreateReferralCodeResponse.
+ * Use `create(CreateReferralCodeResponseSchema)` to create a new message.
+ */
+export const CreateReferralCodeResponseSchema: GenMessage<CreateReferralCodeResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 58);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.GetReferralCodeRequest
+ */
+export type GetReferralCodeRequest = Message<"obiente.cloud.billing.v1.GetReferralCodeRequest"> & {
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.GetReferralCodeRequest.
+ * Use `create(GetReferralCodeRequestSchema)` to create a new message.
+ */
+export const GetReferralCodeRequestSchema: GenMessage<GetReferralCodeRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 59);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.GetReferralCodeResponse
+ */
+export type GetReferralCodeResponse = Message<"obiente.cloud.billing.v1.GetReferralCodeResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.billing.v1.ReferralCode referral_code = 1;
+   */
+  referralCode?: ReferralCode;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.GetReferralCodeResponse.
+ * Use `create(GetReferralCodeResponseSchema)` to create a new message.
+ */
+export const GetReferralCodeResponseSchema: GenMessage<GetReferralCodeResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 60);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.RedeemReferralCodeRequest
+ */
+export type RedeemReferralCodeRequest = Message<"obiente.cloud.billing.v1.RedeemReferralCodeRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.RedeemReferralCodeRequest.
+ * Use `create(RedeemReferralCodeRequestSchema)` to create a new message.
+ */
+export const RedeemReferralCodeRequestSchema: GenMessage<RedeemReferralCodeRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 61);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.RedeemReferralCodeResponse
+ */
+export type RedeemReferralCodeResponse = Message<"obiente.cloud.billing.v1.RedeemReferralCodeResponse"> & {
+  /**
+   * Organization the credits were added to
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: int64 credited_cents = 2;
+   */
+  creditedCents: bigint;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.RedeemReferralCodeResponse.
+ * Use `create(RedeemReferralCodeResponseSchema)` to create a new message.
+ */
+export const RedeemReferralCodeResponseSchema: GenMessage<RedeemReferralCodeResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 62);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.Currency
+ */
+export type Currency = Message<"obiente.cloud.billing.v1.Currency"> & {
+  /**
+   * ISO 4217 code, e.g. "EUR"
+   *
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * @generated from field: string symbol = 2;
+   */
+  symbol: string;
+
+  /**
+   * Units of this currency per US dollar
+   *
+   * @generated from field: double exchange_rate_to_usd = 3;
+   */
+  exchangeRateToUsd: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 4;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.Currency.
+ * Use `create(CurrencySchema)` to create a new message.
+ */
+export const CurrencySchema: GenMessage<Currency> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 63);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.GetBalanceRequest
+ */
+export type GetBalanceRequest = Message<"obiente.cloud.billing.v1.GetBalanceRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.GetBalanceRequest.
+ * Use `create(GetBalanceRequestSchema)` to create a new message.
+ */
+export const GetBalanceRequestSchema: GenMessage<GetBalanceRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 64);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.GetBalanceResponse
+ */
+export type GetBalanceResponse = Message<"obiente.cloud.billing.v1.GetBalanceResponse"> & {
+  /**
+   * Credit balance in USD cents
+   *
+   * @generated from field: int64 balance_cents = 1;
+   */
+  balanceCents: bigint;
+
+  /**
+   * Preferred currency of the organization
+   *
+   * @generated from field: string currency = 2;
+   */
+  currency: string;
+
+  /**
+   * Balance converted to currency, rounded to the nearest cent
+   *
+   * @generated from field: int64 display_balance_cents = 3;
+   */
+  displayBalanceCents: bigint;
+
+  /**
+   * @generated from field: double exchange_rate_to_usd = 4;
+   */
+  exchangeRateToUsd: number;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.GetBalanceResponse.
+ * Use `create(GetBalanceResponseSchema)` to create a new message.
+ */
+export const GetBalanceResponseSchema: GenMessage<GetBalanceResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 65);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.GetSupportedCurrenciesRequest
+ */
+export type GetSupportedCurrenciesRequest = Message<"obiente.cloud.billing.v1.GetSupportedCurrenciesRequest"> & {
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.GetSupportedCurrenciesRequest.
+ * Use `create(GetSupportedCurrenciesRequestSchema)` to create a new message.
+ */
+export const GetSupportedCurrenciesRequestSchema: GenMessage<GetSupportedCurrenciesRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 66);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.GetSupportedCurrenciesResponse
+ */
+export type GetSupportedCurrenciesResponse = Message<"obiente.cloud.billing.v1.GetSupportedCurrenciesResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.billing.v1.Currency currencies = 1;
+   */
+  currencies: Currency[];
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.GetSupportedCurrenciesResponse.
+ * Use `create(GetSupportedCurrenciesResponseSchema)` to create a new message.
+ */
+export const GetSupportedCurrenciesResponseSchema: GenMessage<GetSupportedCurrenciesResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 67);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.SetPreferredCurrencyRequest
+ */
+export type SetPreferredCurrencyRequest = Message<"obiente.cloud.billing.v1.SetPreferredCurrencyRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * ISO 4217 code returned by GetSupportedCurrencies
+   *
+   * @generated from field: string currency = 2;
+   */
+  currency: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.SetPreferredCurrencyRequest.
+ * Use `create(SetPreferredCurrencyRequestSchema)` to create a new message.
+ */
+export const SetPreferredCurrencyRequestSchema: GenMessage<SetPreferredCurrencyRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 68);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.SetPreferredCurrencyResponse
+ */
+export type SetPreferredCurrencyResponse = Message<"obiente.cloud.billing.v1.SetPreferredCurrencyResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.billing.v1.BillingAccount account = 1;
+   */
+  account?: BillingAccount;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.SetPreferredCurrencyResponse.
+ * Use `create(SetPreferredCurrencyResponseSchema)` to create a new message.
+ */
+export const SetPreferredCurrencyResponseSchema: GenMessage<SetPreferredCurrencyResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 69);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.SpendAlert
+ */
+export type SpendAlert = Message<"obiente.cloud.billing.v1.SpendAlert"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId: string;
+
+  /**
+   * Monthly spend in USD cents that triggers the alert
+   *
+   * @generated from field: int64 threshold_cents = 3;
+   */
+  thresholdCents: bigint;
+
+  /**
+   * "email", "suspend_new" (block new resources) or "suspend_all" (suspend the organization)
+   *
+   * @generated from field: string alert_type = 4;
+   */
+  alertType: string;
+
+  /**
+   * When the alert fired this month; unset until it does
+   *
+   * @generated from field: google.protobuf.Timestamp notified_at = 5;
+   */
+  notifiedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.SpendAlert.
+ * Use `create(SpendAlertSchema)` to create a new message.
+ */
+export const SpendAlertSchema: GenMessage<SpendAlert> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 70);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.CreateSpendAlertRequest
+ */
+export type CreateSpendAlertRequest = Message<"obiente.cloud.billing.v1.CreateSpendAlertRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * Must be positive
+   *
+   * @generated from field: int64 threshold_cents = 2;
+   */
+  thresholdCents: bigint;
+
+  /**
+   * @generated from field: string alert_type = 3;
+   */
+  alertType: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.CreateSpendAlertRequest.
+ * Use `create(CreateSpendAlertRequestSchema)` to create a new message.
+ */
+export const CreateSpendAlertRequestSchema: GenMessage<CreateSpendAlertRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 71);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.CreateSpendAlertResponse
+ */
+export type CreateSpendAlertResponse = Message<"obiente.cloud.billing.v1.CreateSpendAlertResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.billing.v1.SpendAlert alert = 1;
+   */
+  alert?: SpendAlert;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.CreateSpendAlertResponse.
+ * Use `create(CreateSpendAlertResponseSchema)` to create a new message.
+ */
+export const CreateSpendAlertResponseSchema: GenMessage<CreateSpendAlertResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 72);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.DeleteSpendAlertRequest
+ */
+export type DeleteSpendAlertRequest = Message<"obiente.cloud.billing.v1.DeleteSpendAlertRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string alert_id = 2;
+   */
+  alertId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.DeleteSpendAlertRequest.
+ * Use `create(DeleteSpendAlertRequestSchema)` to create a new message.
+ */
+export const DeleteSpendAlertRequestSchema: GenMessage<DeleteSpendAlertRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 73);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.DeleteSpendAlertResponse
+ */
+export type DeleteSpendAlertResponse = Message<"obiente.cloud.billing.v1.DeleteSpendAlertResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.DeleteSpendAlertResponse.
+ * Use `create(DeleteSpendAlertResponseSchema)` to create a new message.
+ */
+export const DeleteSpendAlertResponseSchema: GenMessage<DeleteSpendAlertResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 74);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.ListSpendAlertsRequest
+ */
+export type ListSpendAlertsRequest = Message<"obiente.cloud.billing.v1.ListSpendAlertsRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.ListSpendAlertsRequest.
+ * Use `create(ListSpendAlertsRequestSchema)` to create a new message.
+ */
+export const ListSpendAlertsRequestSchema: GenMessage<ListSpendAlertsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 75);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.ListSpendAlertsResponse
+ */
+export type ListSpendAlertsResponse = Message<"obiente.cloud.billing.v1.ListSpendAlertsResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.billing.v1.SpendAlert alerts = 1;
+   */
+  alerts: SpendAlert[];
+
+  /**
+   * Metered usage cost since the start of the month (UTC)
+   *
+   * @generated from field: int64 month_spend_cents = 2;
+   */
+  monthSpendCents: bigint;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.ListSpendAlertsResponse.
+ * Use `create(ListSpendAlertsResponseSchema)` to create a new message.
+ */
+export const ListSpendAlertsResponseSchema: GenMessage<ListSpendAlertsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 76);
+
+/**
+ * SpendForecast projects an organization's metered spend from a linear trend over its recent daily
+ * spend, adjusted for day-of-week patterns
+ *
+ * @generated from message obiente.cloud.billing.v1.SpendForecast
+ */
+export type SpendForecast = Message<"obiente.cloud.billing.v1.SpendForecast"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * Days projected, starting today (UTC)
+   *
+   * @generated from field: int32 period_days = 2;
+   */
+  periodDays: number;
+
+  /**
+   * Projected metered spend over the period in USD cents
+   *
+   * @generated from field: int64 projected_cents = 3;
+   */
+  projectedCents: bigint;
+
+  /**
+   * Lower bound of the 95% confidence interval
+   *
+   * @generated from field: int64 lower_cents = 4;
+   */
+  lowerCents: bigint;
+
+  /**
+   * Upper bound of the 95% confidence interval
+   *
+   * @generated from field: int64 upper_cents = 5;
+   */
+  upperCents: bigint;
+
+  /**
+   * Daily change of the spend trend
+   *
+   * @generated from field: double trend_cents_per_day = 6;
+   */
+  trendCentsPerDay: number;
+
+  /**
+   * Days of spend history the forecast is based on (at most 60)
+   *
+   * @generated from field: int32 history_days = 7;
+   */
+  historyDays: number;
+
+  /**
+   * Forecasts are cached for 6 hours
+   *
+   * @generated from field: google.protobuf.Timestamp generated_at = 8;
+   */
+  generatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.SpendForecast.
+ * Use `create(SpendForecastSchema)` to create a new message.
+ */
+export const SpendForecastSchema: GenMessage<SpendForecast> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 77);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.GetSpendForecastRequest
+ */
+export type GetSpendForecastRequest = Message<"obiente.cloud.billing.v1.GetSpendForecastRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * Defaults to 30, at most 365
+   *
+   * @generated from field: int32 period_days = 2;
+   */
+  periodDays: number;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.GetSpendForecastRequest.
+ * Use `create(GetSpendForecastRequestSchema)` to create a new message.
+ */
+export const GetSpendForecastRequestSchema: GenMessage<GetSpendForecastRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 78);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.GetSpendForecastResponse
+ */
+export type GetSpendForecastResponse = Message<"obiente.cloud.billing.v1.GetSpendForecastResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.billing.v1.SpendForecast forecast = 1;
+   */
+  forecast?: SpendForecast;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.GetSpendForecastResponse.
+ * Use `create(GetSpendForecastResponseSchema)` to create a new message.
+ */
+export const GetSpendForecastResponseSchema: GenMessage<GetSpendForecastResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 79);
+
+/**
+ * TaxExemptCertificate exempts a non-profit, educational or government organization from tax once verified
+ *
+ * @generated from message obiente.cloud.billing.v1.TaxExemptCertificate
+ */
+export type TaxExemptCertificate = Message<"obiente.cloud.billing.v1.TaxExemptCertificate"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId: string;
+
+  /**
+   * "nonprofit", "edu" or "gov"
+   *
+   * @generated from field: string certificate_type = 3;
+   */
+  certificateType: string;
+
+  /**
+   * @generated from field: string certificate_number = 4;
+   */
+  certificateNumber: string;
+
+  /**
+   * @generated from field: string issuing_authority = 5;
+   */
+  issuingAuthority: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp valid_from = 6;
+   */
+  validFrom?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp valid_until = 7;
+   */
+  validUntil?: Timestamp;
+
+  /**
+   * "pending", "verified", "revoked" or "expired"
+   *
+   * @generated from field: string status = 8;
+   */
+  status: string;
+
+  /**
+   * @generated from field: optional string verified_by = 9;
+   */
+  verifiedBy?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp verified_at = 10;
+   */
+  verifiedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp revoked_at = 11;
+   */
+  revokedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 12;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.TaxExemptCertificate.
+ * Use `create(TaxExemptCertificateSchema)` to create a new message.
+ */
+export const TaxExemptCertificateSchema: GenMessage<TaxExemptCertificate> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 80);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.SubmitTaxExemptCertificateRequest
+ */
+export type SubmitTaxExemptCertificateRequest = Message<"obiente.cloud.billing.v1.SubmitTaxExemptCertificateRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string certificate_type = 2;
+   */
+  certificateType: string;
+
+  /**
+   * @generated from field: string certificate_number = 3;
+   */
+  certificateNumber: string;
+
+  /**
+   * @generated from field: string issuing_authority = 4;
+   */
+  issuingAuthority: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp valid_from = 5;
+   */
+  validFrom?: Timestamp;
+
+  /**
+   * Must be after valid_from and in the future
+   *
+   * @generated from field: google.protobuf.Timestamp valid_until = 6;
+   */
+  validUntil?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.SubmitTaxExemptCertificateRequest.
+ * Use `create(SubmitTaxExemptCertificateRequestSchema)` to create a new message.
+ */
+export const SubmitTaxExemptCertificateRequestSchema: GenMessage<SubmitTaxExemptCertificateRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 81);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.SubmitTaxExemptCertificateResponse
+ */
+export type SubmitTaxExemptCertificateResponse = Message<"obiente.cloud.billing.v1.SubmitTaxExemptCertificateResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.billing.v1.TaxExemptCertificate certificate = 1;
+   */
+  certificate?: TaxExemptCertificate;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.SubmitTaxExemptCertificateResponse.
+ * Use `create(SubmitTaxExemptCertificateResponseSchema)` to create a new message.
+ */
+export const SubmitTaxExemptCertificateResponseSchema: GenMessage<SubmitTaxExemptCertificateResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 82);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.VerifyTaxExemptCertificateRequest
+ */
+export type VerifyTaxExemptCertificateRequest = Message<"obiente.cloud.billing.v1.VerifyTaxExemptCertificateRequest"> & {
+  /**
+   * @generated from field: string certificate_id = 1;
+   */
+  certificateId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.VerifyTaxExemptCertificateRequest.
+ * Use `create(VerifyTaxExemptCertificateRequestSchema)` to create a new message.
+ */
+export const VerifyTaxExemptCertificateRequestSchema: GenMessage<VerifyTaxExemptCertificateRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 83);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.VerifyTaxExemptCertificateResponse
+ */
+export type VerifyTaxExemptCertificateResponse = Message<"obiente.cloud.billing.v1.VerifyTaxExemptCertificateResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.billing.v1.TaxExemptCertificate certificate = 1;
+   */
+  certificate?: TaxExemptCertificate;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.VerifyTaxExemptCertificateResponse.
+ * Use `create(VerifyTaxExemptCertificateResponseSchema)` to create a new message.
+ */
+export const VerifyTaxExemptCertificateResponseSchema: GenMessage<VerifyTaxExemptCertificateResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 84);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.RevokeTaxExemptCertificateRequest
+ */
+export type RevokeTaxExemptCertificateRequest = Message<"obiente.cloud.billing.v1.RevokeTaxExemptCertificateRequest"> & {
+  /**
+   * @generated from field: string certificate_id = 1;
+   */
+  certificateId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.RevokeTaxExemptCertificateRequest.
+ * Use `create(RevokeTaxExemptCertificateRequestSchema)` to create a new message.
+ */
+export const RevokeTaxExemptCertificateRequestSchema: GenMessage<RevokeTaxExemptCertificateRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 85);
+
+/**
+ * @generated from message obiente.cloud.billing.v1.RevokeTaxExemptCertificateResponse
+ */
+export type RevokeTaxExemptCertificateResponse = Message<"obiente.cloud.billing.v1.RevokeTaxExemptCertificateResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.billing.v1.TaxExemptCertificate certificate = 1;
+   */
+  certificate?: TaxExemptCertificate;
+};
+
+/**
+ * Describes the message obiente.cloud.billing.v1.RevokeTaxExemptCertificateResponse.
+ * Use `create(RevokeTaxExemptCertificateResponseSchema)` to create a new message.
+ */
+export const RevokeTaxExemptCertificateResponseSchema: GenMessage<RevokeTaxExemptCertificateResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_billing_v1_billing_service, 86);
+
+/**
+ * @generated from service obiente.cloud.billing.v1.BillingService
+ */
+export const BillingService: GenService<{
+  /**
+   * Create a Stripe Checkout Session for purchasing credits
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreateCheckoutSession
+   */
+  createCheckoutSession: {
+    methodKind: "unary";
+    input: typeof CreateCheckoutSessionRequestSchema;
+    output: typeof CreateCheckoutSessionResponseSchema;
+  },
+  /**
+   * Create a Payment Intent for purchasing credits using an existing payment method
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreatePaymentIntent
+   */
+  createPaymentIntent: {
+    methodKind: "unary";
+    input: typeof CreatePaymentIntentRequestSchema;
+    output: typeof CreatePaymentIntentResponseSchema;
+  },
+  /**
+   * Create a Stripe Customer Portal session for managing billing
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreatePortalSession
+   */
+  createPortalSession: {
+    methodKind: "unary";
+    input: typeof CreatePortalSessionRequestSchema;
+    output: typeof CreatePortalSessionResponseSchema;
+  },
+  /**
+   * Create a Setup Intent for collecting payment methods without a payment
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreateSetupIntent
+   */
+  createSetupIntent: {
+    methodKind: "unary";
+    input: typeof CreateSetupIntentRequestSchema;
+    output: typeof CreateSetupIntentResponseSchema;
+  },
+  /**
+   * Get billing account information for an organization
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.GetBillingAccount
+   */
+  getBillingAccount: {
+    methodKind: "unary";
+    input: typeof GetBillingAccountRequestSchema;
+    output: typeof GetBillingAccountResponseSchema;
+  },
+  /**
+   * Create or update billing account
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.UpdateBillingAccount
+   */
+  updateBillingAccount: {
+    methodKind: "unary";
+    input: typeof UpdateBillingAccountRequestSchema;
+    output: typeof UpdateBillingAccountResponseSchema;
+  },
+  /**
+   * List payment methods for an organization
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.ListPaymentMethods
+   */
+  listPaymentMethods: {
+    methodKind: "unary";
+    input: typeof ListPaymentMethodsRequestSchema;
+    output: typeof ListPaymentMethodsResponseSchema;
+  },
+  /**
+   * Attach a payment method to customer
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.AttachPaymentMethod
+   */
+  attachPaymentMethod: {
+    methodKind: "unary";
+    input: typeof AttachPaymentMethodRequestSchema;
+    output: typeof AttachPaymentMethodResponseSchema;
+  },
+  /**
+   * Detach a payment method from customer
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.DetachPaymentMethod
+   */
+  detachPaymentMethod: {
+    methodKind: "unary";
+    input: typeof DetachPaymentMethodRequestSchema;
+    output: typeof DetachPaymentMethodResponseSchema;
+  },
+  /**
+   * Set default payment method for customer
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.SetDefaultPaymentMethod
+   */
+  setDefaultPaymentMethod: {
+    methodKind: "unary";
+    input: typeof SetDefaultPaymentMethodRequestSchema;
+    output: typeof SetDefaultPaymentMethodResponseSchema;
+  },
+  /**
+   * Get payment intent status
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.GetPaymentStatus
+   */
+  getPaymentStatus: {
+    methodKind: "unary";
+    input: typeof GetPaymentStatusRequestSchema;
+    output: typeof GetPaymentStatusResponseSchema;
+  },
+  /**
+   * List invoices for an organization
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.ListInvoices
+   */
+  listInvoices: {
+    methodKind: "unary";
+    input: typeof ListInvoicesRequestSchema;
+    output: typeof ListInvoicesResponseSchema;
+  },
+  /**
+   * Create a Stripe Checkout Session for DNS Delegation subscription ($2/month)
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreateDNSDelegationSubscriptionCheckout
+   */
+  createDNSDelegationSubscriptionCheckout: {
+    methodKind: "unary";
+    input: typeof CreateDNSDelegationSubscriptionCheckoutRequestSchema;
+    output: typeof CreateDNSDelegationSubscriptionCheckoutResponseSchema;
+  },
+  /**
+   * Get DNS delegation subscription status for an organization
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.GetDNSDelegationSubscriptionStatus
+   */
+  getDNSDelegationSubscriptionStatus: {
+    methodKind: "unary";
+    input: typeof GetDNSDelegationSubscriptionStatusRequestSchema;
+    output: typeof GetDNSDelegationSubscriptionStatusResponseSchema;
+  },
+  /**
+   * Cancel DNS delegation subscription
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.CancelDNSDelegationSubscription
+   */
+  cancelDNSDelegationSubscription: {
+    methodKind: "unary";
+    input: typeof CancelDNSDelegationSubscriptionRequestSchema;
+    output: typeof CancelDNSDelegationSubscriptionResponseSchema;
+  },
+  /**
+   * List all subscriptions for an organization
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.ListSubscriptions
+   */
+  listSubscriptions: {
+    methodKind: "unary";
+    input: typeof ListSubscriptionsRequestSchema;
+    output: typeof ListSubscriptionsResponseSchema;
+  },
+  /**
+   * Update subscription payment method
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.UpdateSubscriptionPaymentMethod
+   */
+  updateSubscriptionPaymentMethod: {
+    methodKind: "unary";
+    input: typeof UpdateSubscriptionPaymentMethodRequestSchema;
+    output: typeof UpdateSubscriptionPaymentMethodResponseSchema;
+  },
+  /**
+   * Cancel a subscription by ID
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.CancelSubscription
+   */
+  cancelSubscription: {
+    methodKind: "unary";
+    input: typeof CancelSubscriptionRequestSchema;
+    output: typeof CancelSubscriptionResponseSchema;
+  },
+  /**
+   * Pay a monthly bill prematurely (before due date)
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.PayBill
+   */
+  payBill: {
+    methodKind: "unary";
+    input: typeof PayBillRequestSchema;
+    output: typeof PayBillResponseSchema;
+  },
+  /**
+   * List monthly bills for an organization
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.ListBills
+   */
+  listBills: {
+    methodKind: "unary";
+    input: typeof ListBillsRequestSchema;
+    output: typeof ListBillsResponseSchema;
+  },
+  /**
+   * Generate the current bill early (before billing date)
+   * This allows users to create and pay their current bill before their scheduled billing date
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.GenerateCurrentBill
+   */
+  generateCurrentBill: {
+    methodKind: "unary";
+    input: typeof GenerateCurrentBillRequestSchema;
+    output: typeof GenerateCurrentBillResponseSchema;
+  },
+  /**
+   * Download the generated invoice PDF for a month
+   * The PDF is streamed in chunks; filename and total_size are set on the first message
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.DownloadInvoice
+   */
+  downloadInvoice: {
+    methodKind: "server_streaming";
+    input: typeof DownloadInvoiceRequestSchema;
+    output: typeof DownloadInvoiceResponseSchema;
+  },
+  /**
+   * Get how far an organization's failed invoice has escalated through dunning (superadmin only)
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.GetDunningState
+   */
+  getDunningState: {
+    methodKind: "unary";
+    input: typeof GetDunningStateRequestSchema;
+    output: typeof GetDunningStateResponseSchema;
+  },
+  /**
+   * Clear an organization's dunning state and lift the restrictions it imposed (superadmin only)
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.ResetDunningState
+   */
+  resetDunningState: {
+    methodKind: "unary";
+    input: typeof ResetDunningStateRequestSchema;
+    output: typeof ResetDunningStateResponseSchema;
+  },
+  /**
+   * Create a referral code for the current user (at most 3 per 30 days)
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreateReferralCode
+   */
+  createReferralCode: {
+    methodKind: "unary";
+    input: typeof CreateReferralCodeRequestSchema;
+    output: typeof CreateReferralCodeResponseSchema;
+  },
+  /**
+   * Get the current user's most recent referral code
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.GetReferralCode
+   */
+  getReferralCode: {
+    methodKind: "unary";
+    input: typeof GetReferralCodeRequestSchema;
+    output: typeof GetReferralCodeResponseSchema;
+  },
+  /**
+   * Redeem a referral code as a newly signed-up user, crediting both the new user and the referrer
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.RedeemReferralCode
+   */
+  redeemReferralCode: {
+    methodKind: "unary";
+    input: typeof RedeemReferralCodeRequestSchema;
+    output: typeof RedeemReferralCodeResponseSchema;
+  },
+  /**
+   * Get an organization's credit balance, converted to its preferred currency
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.GetBalance
+   */
+  getBalance: {
+    methodKind: "unary";
+    input: typeof GetBalanceRequestSchema;
+    output: typeof GetBalanceResponseSchema;
+  },
+  /**
+   * List the currencies prices can be displayed in, with their current exchange rates
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.GetSupportedCurrencies
+   */
+  getSupportedCurrencies: {
+    methodKind: "unary";
+    input: typeof GetSupportedCurrenciesRequestSchema;
+    output: typeof GetSupportedCurrenciesResponseSchema;
+  },
+  /**
+   * Set the currency an organization's balance, bills and credit purchases are shown and charged in
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.SetPreferredCurrency
+   */
+  setPreferredCurrency: {
+    methodKind: "unary";
+    input: typeof SetPreferredCurrencyRequestSchema;
+    output: typeof SetPreferredCurrencyResponseSchema;
+  },
+  /**
+   * Add a threshold on an organization's monthly spend that emails the billing contact or suspends the organization
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.CreateSpendAlert
+   */
+  createSpendAlert: {
+    methodKind: "unary";
+    input: typeof CreateSpendAlertRequestSchema;
+    output: typeof CreateSpendAlertResponseSchema;
+  },
+  /**
+   * Remove one of an organization's spend alerts
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.DeleteSpendAlert
+   */
+  deleteSpendAlert: {
+    methodKind: "unary";
+    input: typeof DeleteSpendAlertRequestSchema;
+    output: typeof DeleteSpendAlertResponseSchema;
+  },
+  /**
+   * List an organization's spend alerts with its spend so far this month
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.ListSpendAlerts
+   */
+  listSpendAlerts: {
+    methodKind: "unary";
+    input: typeof ListSpendAlertsRequestSchema;
+    output: typeof ListSpendAlertsResponseSchema;
+  },
+  /**
+   * Project an organization's metered spend over the coming days from its last 60 days of usage
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.GetSpendForecast
+   */
+  getSpendForecast: {
+    methodKind: "unary";
+    input: typeof GetSpendForecastRequestSchema;
+    output: typeof GetSpendForecastResponseSchema;
+  },
+  /**
+   * Submit a tax-exempt certificate for a non-profit, educational or government organization
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.SubmitTaxExemptCertificate
+   */
+  submitTaxExemptCertificate: {
+    methodKind: "unary";
+    input: typeof SubmitTaxExemptCertificateRequestSchema;
+    output: typeof SubmitTaxExemptCertificateResponseSchema;
+  },
+  /**
+   * Verify a submitted tax-exempt certificate so the organization is no longer charged tax (superadmin only)
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.VerifyTaxExemptCertificate
+   */
+  verifyTaxExemptCertificate: {
+    methodKind: "unary";
+    input: typeof VerifyTaxExemptCertificateRequestSchema;
+    output: typeof VerifyTaxExemptCertificateResponseSchema;
+  },
+  /**
+   * Revoke a tax-exempt certificate so the organization is charged tax again (superadmin only)
+   *
+   * @generated from rpc obiente.cloud.billing.v1.BillingService.RevokeTaxExemptCertificate
+   */
+  revokeTaxExemptCertificate: {
+    methodKind: "unary";
+    input: typeof RevokeTaxExemptCertificateRequestSchema;
+    output: typeof RevokeTaxExemptCertificateResponseSchema;
   },
 }> = /*@__PURE__*/
   serviceDesc(file_obiente_cloud_billing_v1_billing_service, 0);
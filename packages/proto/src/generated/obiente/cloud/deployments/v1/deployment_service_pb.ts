@@ -15,7 +15,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file obiente/cloud/deployments/v1/deployment_service.proto.
  */
 export const file_obiente_cloud_deployments_v1_deployment_service: GenFile = /*@__PURE__*/
-  fileDesc("CjVvYmllbnRlL2Nsb3VkL2RlcGxveW1lbnRzL3YxL2RlcGxveW1lbnRfc2VydmljZS5wcm90bxIcb2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MSKhAQoWTGlzdERlcGxveW1lbnRzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSQwoGc3RhdHVzGAIgASgOMi4ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50U3RhdHVzSACIAQESDAoEcGFnZRgDIAEoBRIQCghwZXJfcGFnZRgEIAEoBUIJCgdfc3RhdHVzIpEBChdMaXN0RGVwbG95bWVudHNSZXNwb25zZRI9CgtkZXBsb3ltZW50cxgBIAMoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudBI3CgpwYWdpbmF0aW9uGAIgASgLMiMub2JpZW50ZS5jbG91ZC5jb21tb24udjEuUGFnaW5hdGlvbiKQAQoXQ3JlYXRlRGVwbG95bWVudFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSPgoLZW52aXJvbm1lbnQYAyABKA4yKS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkVudmlyb25tZW50Eg4KBmdyb3VwcxgEIAMoCSJYChhDcmVhdGVEZXBsb3ltZW50UmVzcG9uc2USPAoKZGVwbG95bWVudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudCJGChRHZXREZXBsb3ltZW50UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCSJVChVHZXREZXBsb3ltZW50UmVzcG9uc2USPAoKZGVwbG95bWVudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudCLaDQoXVXBkYXRlRGVwbG95bWVudFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSEQoEbmFtZRgDIAEoCUgAiAEBEhsKDnJlcG9zaXRvcnlfdXJsGAQgASgJSAGIAQESIgoVZ2l0aHViX2ludGVncmF0aW9uX2lkGA4gASgJSAKIAQESEwoGYnJhbmNoGAUgASgJSAOIAQESGgoNYnVpbGRfY29tbWFuZBgGIAEoCUgEiAEBEhwKD2luc3RhbGxfY29tbWFuZBgHIAEoCUgFiAEBEhoKDXN0YXJ0X2NvbW1hbmQYESABKAlIBogBARIcCg9kb2NrZXJmaWxlX3BhdGgYDCABKAlIB4gBARIeChFjb21wb3NlX2ZpbGVfcGF0aBgNIAEoCUgIiAEBEhcKCmJ1aWxkX3BhdGgYEiABKAlICYgBARIeChFidWlsZF9vdXRwdXRfcGF0aBgTIAEoCUgKiAEBEhYKCXVzZV9uZ2lueBgUIAEoCEgLiAEBEhkKDG5naW54X2NvbmZpZxgVIAEoCUgMiAEBEhMKBmRvbWFpbhgIIAEoCUgNiAEBEhYKDmN1c3RvbV9kb21haW5zGAkgAygJEhEKBHBvcnQYCiABKAVIDogBARJICg5idWlsZF9zdHJhdGVneRgLIAEoDjIrLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQnVpbGRTdHJhdGVneUgPiAEBEkMKC2Vudmlyb25tZW50GA8gASgOMikub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5FbnZpcm9ubWVudEgQiAEBEg4KBmdyb3VwcxgQIAMoCRIWCgljcHVfbGltaXQYFiABKAFIEYgBARIZCgxtZW1vcnlfbGltaXQYFyABKANIEogBARJMChBoZWFsdGhjaGVja190eXBlGBggASgOMi0ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5IZWFsdGhDaGVja1R5cGVIE4gBARIdChBoZWFsdGhjaGVja19wb3J0GBkgASgFSBSIAQESHQoQaGVhbHRoY2hlY2tfcGF0aBgaIAEoCUgViAEBEigKG2hlYWx0aGNoZWNrX2V4cGVjdGVkX3N0YXR1cxgbIAEoBUgWiAEBEicKGmhlYWx0aGNoZWNrX2N1c3RvbV9jb21tYW5kGBwgASgJSBeIAQESGAoLYXV0b19kZXBsb3kYHSABKAhIGIgBARJYCgpidWlsZF9hcmdzGB4gAygLMkQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5VcGRhdGVEZXBsb3ltZW50UmVxdWVzdC5CdWlsZEFyZ3NFbnRyeRJKChJkb2NrZXJmaWxlX3ZvbHVtZXMYHyADKAsyLi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRvY2tlcmZpbGVWb2x1bWUSWwoYZG9ja2VyZmlsZV9idWlsZF9vcHRpb25zGCAgASgLMjQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Eb2NrZXJmaWxlQnVpbGRPcHRpb25zSBmIAQEaMAoOQnVpbGRBcmdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIHCgVfbmFtZUIRCg9fcmVwb3NpdG9yeV91cmxCGAoWX2dpdGh1Yl9pbnRlZ3JhdGlvbl9pZEIJCgdfYnJhbmNoQhAKDl9idWlsZF9jb21tYW5kQhIKEF9pbnN0YWxsX2NvbW1hbmRCEAoOX3N0YXJ0X2NvbW1hbmRCEgoQX2RvY2tlcmZpbGVfcGF0aEIUChJfY29tcG9zZV9maWxlX3BhdGhCDQoLX2J1aWxkX3BhdGhCFAoSX2J1aWxkX291dHB1dF9wYXRoQgwKCl91c2VfbmdpbnhCDwoNX25naW54X2NvbmZpZ0IJCgdfZG9tYWluQgcKBV9wb3J0QhEKD19idWlsZF9zdHJhdGVneUIOCgxfZW52aXJvbm1lbnRCDAoKX2NwdV9saW1pdEIPCg1fbWVtb3J5X2xpbWl0QhMKEV9oZWFsdGhjaGVja190eXBlQhMKEV9oZWFsdGhjaGVja19wb3J0QhMKEV9oZWFsdGhjaGVja19wYXRoQh4KHF9oZWFsdGhjaGVja19leHBlY3RlZF9zdGF0dXNCHQobX2hlYWx0aGNoZWNrX2N1c3RvbV9jb21tYW5kQg4KDF9hdXRvX2RlcGxveUIbChlfZG9ja2VyZmlsZV9idWlsZF9vcHRpb25zIlgKGFVwZGF0ZURlcGxveW1lbnRSZXNwb25zZRI8CgpkZXBsb3ltZW50GAEgASgLMigub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50IkoKGFRyaWdnZXJEZXBsb3ltZW50UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCSJCChlUcmlnZ2VyRGVwbG95bWVudFJlc3BvbnNlEhUKDWRlcGxveW1lbnRfaWQYASABKAkSDgoGc3RhdHVzGAIgASgJIk8KHVN0cmVhbURlcGxveW1lbnRTdGF0dXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJItcBChZEZXBsb3ltZW50U3RhdHVzVXBkYXRlEhUKDWRlcGxveW1lbnRfaWQYASABKAkSPgoGc3RhdHVzGAIgASgOMi4ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50U3RhdHVzEhUKDWhlYWx0aF9zdGF0dXMYAyABKAkSFAoHbWVzc2FnZRgEIAEoCUgAiAEBEi0KCXRpbWVzdGFtcBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCCgoIX21lc3NhZ2UiiAEKGEdldERlcGxveW1lbnRMb2dzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRISCgVsaW5lcxgDIAEoBUgAiAEBEhMKBmZvbGxvdxgEIAEoCEgBiAEBQggKBl9saW5lc0IJCgdfZm9sbG93IikKGUdldERlcGxveW1lbnRMb2dzUmVzcG9uc2USDAoEbG9ncxgBIAMoCSLBAQobU3RyZWFtRGVwbG95bWVudExvZ3NSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhEKBHRhaWwYAyABKAVIAIgBARIZCgxjb250YWluZXJfaWQYBCABKAlIAYgBARIZCgxzZXJ2aWNlX25hbWUYBSABKAlIAogBAUIHCgVfdGFpbEIPCg1fY29udGFpbmVyX2lkQg8KDV9zZXJ2aWNlX25hbWUiSAoWU3RyZWFtQnVpbGRMb2dzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCSKtAQoRRGVwbG95bWVudExvZ0xpbmUSFQoNZGVwbG95bWVudF9pZBgBIAEoCRIMCgRsaW5lGAIgASgJEi0KCXRpbWVzdGFtcBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGc3RkZXJyGAQgASgIEjQKCWxvZ19sZXZlbBgFIAEoDjIhLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLkxvZ0xldmVsIkgKFlN0YXJ0RGVwbG95bWVudFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkiVwoXU3RhcnREZXBsb3ltZW50UmVzcG9uc2USPAoKZGVwbG95bWVudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudCJHChVTdG9wRGVwbG95bWVudFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkiVgoWU3RvcERlcGxveW1lbnRSZXNwb25zZRI8CgpkZXBsb3ltZW50GAEgASgLMigub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50IkkKF0RlbGV0ZURlcGxveW1lbnRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJIisKGERlbGV0ZURlcGxveW1lbnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIkoKGFJlc3RhcnREZXBsb3ltZW50UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCSJZChlSZXN0YXJ0RGVwbG95bWVudFJlc3BvbnNlEjwKCmRlcGxveW1lbnQYASABKAsyKC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnQiWgoWU2NhbGVEZXBsb3ltZW50UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIQCghyZXBsaWNhcxgDIAEoBSJXChdTY2FsZURlcGxveW1lbnRSZXNwb25zZRI8CgpkZXBsb3ltZW50GAEgASgLMigub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50Ik0KG0dldERlcGxveW1lbnRFbnZWYXJzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCSI4ChxHZXREZXBsb3ltZW50RW52VmFyc1Jlc3BvbnNlEhgKEGVudl9maWxlX2NvbnRlbnQYASABKAkiagoeVXBkYXRlRGVwbG95bWVudEVudlZhcnNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhgKEGVudl9maWxlX2NvbnRlbnQYAyABKAkiXwofVXBkYXRlRGVwbG95bWVudEVudlZhcnNSZXNwb25zZRI8CgpkZXBsb3ltZW50GAEgASgLMigub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50Ik0KG0dldERlcGxveW1lbnRDb21wb3NlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCSI0ChxHZXREZXBsb3ltZW50Q29tcG9zZVJlc3BvbnNlEhQKDGNvbXBvc2VfeWFtbBgBIAEoCSJoCiBWYWxpZGF0ZURlcGxveW1lbnRDb21wb3NlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIUCgxjb21wb3NlX3lhbWwYAyABKAkiqAEKIVZhbGlkYXRlRGVwbG95bWVudENvbXBvc2VSZXNwb25zZRJPChF2YWxpZGF0aW9uX2Vycm9ycxgBIAMoCzI0Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQ29tcG9zZVZhbGlkYXRpb25FcnJvchIdChB2YWxpZGF0aW9uX2Vycm9yGAIgASgJSACIAQFCEwoRX3ZhbGlkYXRpb25fZXJyb3IiZgoeVXBkYXRlRGVwbG95bWVudENvbXBvc2VSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhQKDGNvbXBvc2VfeWFtbBgDIAEoCSLkAQofVXBkYXRlRGVwbG95bWVudENvbXBvc2VSZXNwb25zZRI8CgpkZXBsb3ltZW50GAEgASgLMigub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50Eh0KEHZhbGlkYXRpb25fZXJyb3IYAiABKAlIAIgBARJPChF2YWxpZGF0aW9uX2Vycm9ycxgDIAMoCzI0Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQ29tcG9zZVZhbGlkYXRpb25FcnJvckITChFfdmFsaWRhdGlvbl9lcnJvciKpAQoWQ29tcG9zZVZhbGlkYXRpb25FcnJvchIMCgRsaW5lGAEgASgFEg4KBmNvbHVtbhgCIAEoBRIPCgdtZXNzYWdlGAMgASgJEhAKCHNldmVyaXR5GAQgASgJEhIKCnN0YXJ0X2xpbmUYBSABKAUSEAoIZW5kX2xpbmUYBiABKAUSFAoMc3RhcnRfY29sdW1uGAcgASgFEhIKCmVuZF9jb2x1bW4YCCABKAUiaQoWTGlzdEdpdEh1YlJlcG9zUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFgoOaW50ZWdyYXRpb25faWQYAiABKAkSDAoEcGFnZRgDIAEoBRIQCghwZXJfcGFnZRgEIAEoBSKHAQoKR2l0SHViUmVwbxIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhEKCWZ1bGxfbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRILCgN1cmwYBSABKAkSEgoKaXNfcHJpdmF0ZRgGIAEoCBIWCg5kZWZhdWx0X2JyYW5jaBgHIAEoCSJhChdMaXN0R2l0SHViUmVwb3NSZXNwb25zZRI3CgVyZXBvcxgBIAMoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2l0SHViUmVwbxINCgV0b3RhbBgCIAEoBSJjChhHZXRHaXRIdWJCcmFuY2hlc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhYKDmludGVncmF0aW9uX2lkGAIgASgJEhYKDnJlcG9fZnVsbF9uYW1lGAMgASgJIj0KDEdpdEh1YkJyYW5jaBIMCgRuYW1lGAEgASgJEhIKCmlzX2RlZmF1bHQYAiABKAgSCwoDc2hhGAMgASgJIlkKGUdldEdpdEh1YkJyYW5jaGVzUmVzcG9uc2USPAoIYnJhbmNoZXMYASADKAsyKi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdpdEh1YkJyYW5jaCJ9ChRHZXRHaXRIdWJGaWxlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFgoOaW50ZWdyYXRpb25faWQYAiABKAkSFgoOcmVwb19mdWxsX25hbWUYAyABKAkSDgoGYnJhbmNoGAQgASgJEgwKBHBhdGgYBSABKAkiSAoVR2V0R2l0SHViRmlsZVJlc3BvbnNlEg8KB2NvbnRlbnQYASABKAkSEAoIZW5jb2RpbmcYAiABKAkSDAoEc2l6ZRgDIAEoAyJBCiZMaXN0QXZhaWxhYmxlR2l0SHViSW50ZWdyYXRpb25zUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAki9AEKF0dpdEh1YkludGVncmF0aW9uT3B0aW9uEgoKAmlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEg8KB2lzX3VzZXIYAyABKAgSFgoOb2JpZW50ZV9vcmdfaWQYBCABKAkSGAoQb2JpZW50ZV9vcmdfbmFtZRgFIAEoCRIRCglhdXRoX3R5cGUYBiABKAkSIgoaZ2l0aHViX2FwcF9pbnN0YWxsYXRpb25faWQYByABKAMSIAoYZ2l0aHViX2FwcF9hY2NvdW50X2xvZ2luGAggASgJEh8KF2dpdGh1Yl9hcHBfYWNjb3VudF90eXBlGAkgASgJInYKJ0xpc3RBdmFpbGFibGVHaXRIdWJJbnRlZ3JhdGlvbnNSZXNwb25zZRJLCgxpbnRlZ3JhdGlvbnMYASADKAsyNS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdpdEh1YkludGVncmF0aW9uT3B0aW9uImkKG1N0cmVhbVRlcm1pbmFsT3V0cHV0UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIMCgRjb2xzGAMgASgFEgwKBHJvd3MYBCABKAUidQoYU2VuZFRlcm1pbmFsSW5wdXRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEg0KBWlucHV0GAMgASgMEgwKBGNvbHMYBCABKAUSDAoEcm93cxgFIAEoBSIsChlTZW5kVGVybWluYWxJbnB1dFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgilgEKDVRlcm1pbmFsSW5wdXQSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSFAoMY29udGFpbmVyX2lkGAYgASgJEhQKDHNlcnZpY2VfbmFtZRgHIAEoCRINCgVpbnB1dBgDIAEoDBIMCgRjb2xzGAQgASgFEgwKBHJvd3MYBSABKAUiLgoOVGVybWluYWxPdXRwdXQSDgoGb3V0cHV0GAEgASgMEgwKBGV4aXQYAiABKAgiVgoKVm9sdW1lSW5mbxIMCgRuYW1lGAEgASgJEhMKC21vdW50X3BvaW50GAIgASgJEg4KBnNvdXJjZRgDIAEoCRIVCg1pc19wZXJzaXN0ZW50GAQgASgIIrcCChlMaXN0Q29udGFpbmVyRmlsZXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEgwKBHBhdGgYAyABKAkSGAoLdm9sdW1lX25hbWUYBCABKAlIAIgBARIUCgxsaXN0X3ZvbHVtZXMYBSABKAgSEwoGY3Vyc29yGAYgASgJSAGIAQESFgoJcGFnZV9zaXplGAcgASgFSAKIAQESGQoMY29udGFpbmVyX2lkGAggASgJSAOIAQESGQoMc2VydmljZV9uYW1lGAkgASgJSASIAQFCDgoMX3ZvbHVtZV9uYW1lQgkKB19jdXJzb3JCDAoKX3BhZ2Vfc2l6ZUIPCg1fY29udGFpbmVyX2lkQg8KDV9zZXJ2aWNlX25hbWUiggQKDUNvbnRhaW5lckZpbGUSDAoEbmFtZRgBIAEoCRIMCgRwYXRoGAIgASgJEhQKDGlzX2RpcmVjdG9yeRgDIAEoCBIMCgRzaXplGAQgASgDEhMKC3Blcm1pc3Npb25zGAUgASgJEhgKC3ZvbHVtZV9uYW1lGAYgASgJSACIAQESEgoFb3duZXIYByABKAlIAYgBARISCgVncm91cBgIIAEoCUgCiAEBEhcKCm1vZGVfb2N0YWwYCSABKA1IA4gBARIXCgppc19zeW1saW5rGAogASgISASIAQESGwoOc3ltbGlua190YXJnZXQYCyABKAlIBYgBARIWCgltaW1lX3R5cGUYDCABKAlIBogBARI2Cg1tb2RpZmllZF90aW1lGA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgHiAEBEjUKDGNyZWF0ZWRfdGltZRgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBICIgBAUIOCgxfdm9sdW1lX25hbWVCCAoGX293bmVyQggKBl9ncm91cEINCgtfbW9kZV9vY3RhbEINCgtfaXNfc3ltbGlua0IRCg9fc3ltbGlua190YXJnZXRCDAoKX21pbWVfdHlwZUIQCg5fbW9kaWZpZWRfdGltZUIPCg1fY3JlYXRlZF90aW1lIpMCChpMaXN0Q29udGFpbmVyRmlsZXNSZXNwb25zZRI6CgVmaWxlcxgBIAMoCzIrLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQ29udGFpbmVyRmlsZRIUCgxjdXJyZW50X3BhdGgYAiABKAkSOQoHdm9sdW1lcxgDIAMoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVm9sdW1lSW5mbxIRCglpc192b2x1bWUYBCABKAgSGQoRY29udGFpbmVyX3J1bm5pbmcYBSABKAgSEAoIaGFzX21vcmUYBiABKAgSGAoLbmV4dF9jdXJzb3IYByABKAlIAIgBAUIOCgxfbmV4dF9jdXJzb3Ii2QEKF0dldENvbnRhaW5lckZpbGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEgwKBHBhdGgYAyABKAkSGAoLdm9sdW1lX25hbWUYBCABKAlIAIgBARIZCgxjb250YWluZXJfaWQYBSABKAlIAYgBARIZCgxzZXJ2aWNlX25hbWUYBiABKAlIAogBAUIOCgxfdm9sdW1lX25hbWVCDwoNX2NvbnRhaW5lcl9pZEIPCg1fc2VydmljZV9uYW1lIsIBChhHZXRDb250YWluZXJGaWxlUmVzcG9uc2USDwoHY29udGVudBgBIAEoCRIQCghlbmNvZGluZxgCIAEoCRIMCgRzaXplGAMgASgDEhYKCXRydW5jYXRlZBgEIAEoCEgAiAEBEkIKCG1ldGFkYXRhGAUgASgLMisub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Db250YWluZXJGaWxlSAGIAQFCDAoKX3RydW5jYXRlZEILCglfbWV0YWRhdGEifQobVXBsb2FkQ29udGFpbmVyRmlsZXNSZXF1ZXN0EkwKCG1ldGFkYXRhGAEgASgLMjoub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5VcGxvYWRDb250YWluZXJGaWxlc01ldGFkYXRhEhAKCHRhcl9kYXRhGAIgASgMIqUCChxVcGxvYWRDb250YWluZXJGaWxlc01ldGFkYXRhEhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhgKEGRlc3RpbmF0aW9uX3BhdGgYAyABKAkSOQoFZmlsZXMYBCADKAsyKi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkZpbGVNZXRhZGF0YRIYCgt2b2x1bWVfbmFtZRgFIAEoCUgAiAEBEhkKDGNvbnRhaW5lcl9pZBgGIAEoCUgBiAEBEhkKDHNlcnZpY2VfbmFtZRgHIAEoCUgCiAEBQg4KDF92b2x1bWVfbmFtZUIPCg1fY29udGFpbmVyX2lkQg8KDV9zZXJ2aWNlX25hbWUiTgoMRmlsZU1ldGFkYXRhEgwKBG5hbWUYASABKAkSDAoEc2l6ZRgCIAEoAxIUCgxpc19kaXJlY3RvcnkYAyABKAgSDAoEcGF0aBgEIAEoCSJlChxVcGxvYWRDb250YWluZXJGaWxlc1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSEgoFZXJyb3IYAiABKAlIAIgBARIWCg5maWxlc191cGxvYWRlZBgDIAEoBUIICgZfZXJyb3Ii6QEKIENodW5rVXBsb2FkQ29udGFpbmVyRmlsZXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEj0KBnVwbG9hZBgDIAEoCzItLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLkNodW5rZWRVcGxvYWRQYXlsb2FkEhkKDGNvbnRhaW5lcl9pZBgEIAEoCUgAiAEBEhkKDHNlcnZpY2VfbmFtZRgFIAEoCUgBiAEBQg8KDV9jb250YWluZXJfaWRCDwoNX3NlcnZpY2VfbmFtZSJqCiFDaHVua1VwbG9hZENvbnRhaW5lckZpbGVzUmVzcG9uc2USRQoGcmVzdWx0GAEgASgLMjUub2JpZW50ZS5jbG91ZC5jb21tb24udjEuQ2h1bmtlZFVwbG9hZFJlc3BvbnNlUGF5bG9hZCKqAQodRGVsZXRlQ29udGFpbmVyRW50cmllc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSDQoFcGF0aHMYAyADKAkSGAoLdm9sdW1lX25hbWUYBCABKAlIAIgBARIRCglyZWN1cnNpdmUYBSABKAgSDQoFZm9yY2UYBiABKAhCDgoMX3ZvbHVtZV9uYW1lIjwKG0RlbGV0ZUNvbnRhaW5lckVudHJpZXNFcnJvchIMCgRwYXRoGAEgASgJEg8KB21lc3NhZ2UYAiABKAkikwEKHkRlbGV0ZUNvbnRhaW5lckVudHJpZXNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhUKDWRlbGV0ZWRfcGF0aHMYAiADKAkSSQoGZXJyb3JzGAMgAygLMjkub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZWxldGVDb250YWluZXJFbnRyaWVzRXJyb3IitAEKG1JlbmFtZUNvbnRhaW5lckVudHJ5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRITCgtzb3VyY2VfcGF0aBgDIAEoCRITCgt0YXJnZXRfcGF0aBgEIAEoCRIYCgt2b2x1bWVfbmFtZRgFIAEoCUgAiAEBEhEKCW92ZXJ3cml0ZRgGIAEoCEIOCgxfdm9sdW1lX25hbWUiegocUmVuYW1lQ29udGFpbmVyRW50cnlSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEj8KBWVudHJ5GAIgASgLMisub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Db250YWluZXJGaWxlSACIAQFCCAoGX2VudHJ5Iv4CChtDcmVhdGVDb250YWluZXJFbnRyeVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSEwoLcGFyZW50X3BhdGgYAyABKAkSDAoEbmFtZRgEIAEoCRI+CgR0eXBlGAUgASgOMjAub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Db250YWluZXJFbnRyeVR5cGUSFQoIdGVtcGxhdGUYBiABKAlIAIgBARIYCgt2b2x1bWVfbmFtZRgHIAEoCUgBiAEBEhcKCm1vZGVfb2N0YWwYCCABKA1IAogBARIZCgxjb250YWluZXJfaWQYCSABKAlIA4gBARIZCgxzZXJ2aWNlX25hbWUYCiABKAlIBIgBAUILCglfdGVtcGxhdGVCDgoMX3ZvbHVtZV9uYW1lQg0KC19tb2RlX29jdGFsQg8KDV9jb250YWluZXJfaWRCDwoNX3NlcnZpY2VfbmFtZSJaChxDcmVhdGVDb250YWluZXJFbnRyeVJlc3BvbnNlEjoKBWVudHJ5GAEgASgLMisub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Db250YWluZXJGaWxlIukBChlXcml0ZUNvbnRhaW5lckZpbGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEgwKBHBhdGgYAyABKAkSGAoLdm9sdW1lX25hbWUYBCABKAlIAIgBARIPCgdjb250ZW50GAUgASgJEhAKCGVuY29kaW5nGAYgASgJEhkKEWNyZWF0ZV9pZl9taXNzaW5nGAcgASgIEhcKCm1vZGVfb2N0YWwYCCABKA1IAYgBAUIOCgxfdm9sdW1lX25hbWVCDQoLX21vZGVfb2N0YWwilgEKGldyaXRlQ29udGFpbmVyRmlsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSPwoFZW50cnkYAiABKAsyKy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkNvbnRhaW5lckZpbGVIAIgBARISCgVlcnJvchgDIAEoCUgBiAEBQggKBl9lbnRyeUIICgZfZXJyb3Ii/AEKHEV4dHJhY3REZXBsb3ltZW50RmlsZVJlcXVlc3QSFQoNZGVwbG95bWVudF9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEAoIemlwX3BhdGgYAyABKAkSGAoQZGVzdGluYXRpb25fcGF0aBgEIAEoCRIYCgt2b2x1bWVfbmFtZRgFIAEoCUgAiAEBEhkKDGNvbnRhaW5lcl9pZBgGIAEoCUgBiAEBEhkKDHNlcnZpY2VfbmFtZRgHIAEoCUgCiAEBQg4KDF92b2x1bWVfbmFtZUIPCg1fY29udGFpbmVyX2lkQg8KDV9zZXJ2aWNlX25hbWUiZwodRXh0cmFjdERlcGxveW1lbnRGaWxlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBISCgVlcnJvchgCIAEoCUgAiAEBEhcKD2ZpbGVzX2V4dHJhY3RlZBgDIAEoBUIICgZfZXJyb3IiqAIKIkNyZWF0ZURlcGxveW1lbnRGaWxlQXJjaGl2ZVJlcXVlc3QSFQoNZGVwbG95bWVudF9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSUAoPYXJjaGl2ZV9yZXF1ZXN0GAMgASgLMjcub2JpZW50ZS5jbG91ZC5jb21tb24udjEuQ3JlYXRlU2VydmVyRmlsZUFyY2hpdmVSZXF1ZXN0EhgKC3ZvbHVtZV9uYW1lGAQgASgJSACIAQESGQoMY29udGFpbmVyX2lkGAUgASgJSAGIAQESGQoMc2VydmljZV9uYW1lGAYgASgJSAKIAQFCDgoMX3ZvbHVtZV9uYW1lQg8KDV9jb250YWluZXJfaWRCDwoNX3NlcnZpY2VfbmFtZSJ5CiNDcmVhdGVEZXBsb3ltZW50RmlsZUFyY2hpdmVSZXNwb25zZRJSChBhcmNoaXZlX3Jlc3BvbnNlGAEgASgLMjgub2JpZW50ZS5jbG91ZC5jb21tb24udjEuQ3JlYXRlU2VydmVyRmlsZUFyY2hpdmVSZXNwb25zZSLCAQoLUm91dGluZ1J1bGUSCgoCaWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIOCgZkb21haW4YAyABKAkSFAoMc2VydmljZV9uYW1lGAQgASgJEhMKC3BhdGhfcHJlZml4GAUgASgJEhMKC3RhcmdldF9wb3J0GAYgASgFEhAKCHByb3RvY29sGAcgASgJEhMKC3NzbF9lbmFibGVkGAggASgIEhkKEXNzbF9jZXJ0X3Jlc29sdmVyGAkgASgJIk4KHEdldERlcGxveW1lbnRSb3V0aW5nc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkiWQodR2V0RGVwbG95bWVudFJvdXRpbmdzUmVzcG9uc2USOAoFcnVsZXMYASADKAsyKS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlJvdXRpbmdSdWxlIosBCh9VcGRhdGVEZXBsb3ltZW50Um91dGluZ3NSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEjgKBXJ1bGVzGAMgAygLMikub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Sb3V0aW5nUnVsZSJcCiBVcGRhdGVEZXBsb3ltZW50Um91dGluZ3NSZXNwb25zZRI4CgVydWxlcxgBIAMoCzIpLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuUm91dGluZ1J1bGUiUgogR2V0RGVwbG95bWVudFNlcnZpY2VOYW1lc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkiOgohR2V0RGVwbG95bWVudFNlcnZpY2VOYW1lc1Jlc3BvbnNlEhUKDXNlcnZpY2VfbmFtZXMYASADKAkiYwohR2V0RG9tYWluVmVyaWZpY2F0aW9uVG9rZW5SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEg4KBmRvbWFpbhgDIAEoCSKGAQoiR2V0RG9tYWluVmVyaWZpY2F0aW9uVG9rZW5SZXNwb25zZRIOCgZkb21haW4YASABKAkSDQoFdG9rZW4YAiABKAkSFwoPdHh0X3JlY29yZF9uYW1lGAMgASgJEhgKEHR4dF9yZWNvcmRfdmFsdWUYBCABKAkSDgoGc3RhdHVzGAUgASgJIl4KHFZlcmlmeURvbWFpbk93bmVyc2hpcFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSDgoGZG9tYWluGAMgASgJInMKHVZlcmlmeURvbWFpbk93bmVyc2hpcFJlc3BvbnNlEg4KBmRvbWFpbhgBIAEoCRIQCgh2ZXJpZmllZBgCIAEoCBIOCgZzdGF0dXMYAyABKAkSFAoHbWVzc2FnZRgEIAEoCUgAiAEBQgoKCF9tZXNzYWdlIvkCChtHZXREZXBsb3ltZW50TWV0cmljc1JlcXVlc3QSFQoNZGVwbG95bWVudF9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSMwoKc3RhcnRfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARIxCghlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBARIYCgtsYXRlc3Rfb25seRgFIAEoCEgCiAEBEhkKDGNvbnRhaW5lcl9pZBgGIAEoCUgDiAEBEhkKDHNlcnZpY2VfbmFtZRgHIAEoCUgEiAEBEhYKCWFnZ3JlZ2F0ZRgIIAEoCEgFiAEBQg0KC19zdGFydF90aW1lQgsKCV9lbmRfdGltZUIOCgxfbGF0ZXN0X29ubHlCDwoNX2NvbnRhaW5lcl9pZEIPCg1fc2VydmljZV9uYW1lQgwKCl9hZ2dyZWdhdGUiXwocR2V0RGVwbG95bWVudE1ldHJpY3NSZXNwb25zZRI/CgdtZXRyaWNzGAEgAygLMi4ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50TWV0cmljIoICCh5TdHJlYW1EZXBsb3ltZW50TWV0cmljc1JlcXVlc3QSFQoNZGVwbG95bWVudF9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSHQoQaW50ZXJ2YWxfc2Vjb25kcxgDIAEoBUgAiAEBEhkKDGNvbnRhaW5lcl9pZBgEIAEoCUgBiAEBEhkKDHNlcnZpY2VfbmFtZRgFIAEoCUgCiAEBEhYKCWFnZ3JlZ2F0ZRgGIAEoCEgDiAEBQhMKEV9pbnRlcnZhbF9zZWNvbmRzQg8KDV9jb250YWluZXJfaWRCDwoNX3NlcnZpY2VfbmFtZUIMCgpfYWdncmVnYXRlIqYDChBEZXBsb3ltZW50TWV0cmljEhUKDWRlcGxveW1lbnRfaWQYASABKAkSLQoJdGltZXN0YW1wGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIZChFjcHVfdXNhZ2VfcGVyY2VudBgDIAEoARIaChJtZW1vcnlfdXNhZ2VfYnl0ZXMYBCABKAMSGAoQbmV0d29ya19yeF9ieXRlcxgFIAEoAxIYChBuZXR3b3JrX3R4X2J5dGVzGAYgASgDEhcKD2Rpc2tfcmVhZF9ieXRlcxgHIAEoAxIYChBkaXNrX3dyaXRlX2J5dGVzGAggASgDEhoKDXJlcXVlc3RfY291bnQYCSABKANIAIgBARIYCgtlcnJvcl9jb3VudBgKIAEoA0gBiAEBEhkKDGNvbnRhaW5lcl9pZBgLIAEoCUgCiAEBEhkKDHNlcnZpY2VfbmFtZRgMIAEoCUgDiAEBQhAKDl9yZXF1ZXN0X2NvdW50Qg4KDF9lcnJvcl9jb3VudEIPCg1fY29udGFpbmVyX2lkQg8KDV9zZXJ2aWNlX25hbWUiaQoZR2V0RGVwbG95bWVudFVzYWdlUmVxdWVzdBIVCg1kZXBsb3ltZW50X2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRISCgVtb250aBgDIAEoCUgAiAEBQggKBl9tb250aCLzAQoaR2V0RGVwbG95bWVudFVzYWdlUmVzcG9uc2USFQoNZGVwbG95bWVudF9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSDQoFbW9udGgYAyABKAkSRQoHY3VycmVudBgEIAEoCzI0Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudFVzYWdlTWV0cmljcxJPChFlc3RpbWF0ZWRfbW9udGhseRgFIAEoCzI0Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudFVzYWdlTWV0cmljcyLaAwoWRGVwbG95bWVudFVzYWdlTWV0cmljcxIYChBjcHVfY29yZV9zZWNvbmRzGAEgASgDEhsKE21lbW9yeV9ieXRlX3NlY29uZHMYAiABKAMSGgoSYmFuZHdpZHRoX3J4X2J5dGVzGAMgASgDEhoKEmJhbmR3aWR0aF90eF9ieXRlcxgEIAEoAxIVCg1zdG9yYWdlX2J5dGVzGAUgASgDEhUKDXJlcXVlc3RfY291bnQYBiABKAMSEwoLZXJyb3JfY291bnQYByABKAMSFgoOdXB0aW1lX3NlY29uZHMYCCABKAMSHAoUZXN0aW1hdGVkX2Nvc3RfY2VudHMYCSABKAMSGwoOY3B1X2Nvc3RfY2VudHMYCiABKANIAIgBARIeChFtZW1vcnlfY29zdF9jZW50cxgLIAEoA0gBiAEBEiEKFGJhbmR3aWR0aF9jb3N0X2NlbnRzGAwgASgDSAKIAQESHwoSc3RvcmFnZV9jb3N0X2NlbnRzGA0gASgDSAOIAQFCEQoPX2NwdV9jb3N0X2NlbnRzQhQKEl9tZW1vcnlfY29zdF9jZW50c0IXChVfYmFuZHdpZHRoX2Nvc3RfY2VudHNCFQoTX3N0b3JhZ2VfY29zdF9jZW50cyKaEgoKRGVwbG95bWVudBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBmRvbWFpbhgDIAEoCRIWCg5jdXN0b21fZG9tYWlucxgEIAMoCRI6CgR0eXBlGAUgASgOMiwub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50VHlwZRJDCg5idWlsZF9zdHJhdGVneRgaIAEoDjIrLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQnVpbGRTdHJhdGVneRIbCg5yZXBvc2l0b3J5X3VybBgGIAEoCUgAiAEBEg4KBmJyYW5jaBgHIAEoCRIaCg1idWlsZF9jb21tYW5kGAggASgJSAGIAQESHAoPaW5zdGFsbF9jb21tYW5kGAkgASgJSAKIAQESGgoNc3RhcnRfY29tbWFuZBgdIAEoCUgDiAEBEhwKD2RvY2tlcmZpbGVfcGF0aBgbIAEoCUgEiAEBEh4KEWNvbXBvc2VfZmlsZV9wYXRoGBwgASgJSAWIAQESFwoKYnVpbGRfcGF0aBgiIAEoCUgGiAEBEh4KEWJ1aWxkX291dHB1dF9wYXRoGCMgASgJSAeIAQESFgoJdXNlX25naW54GCQgASgISAiIAQESGQoMbmdpbnhfY29uZmlnGCUgASgJSAmIAQESPgoGc3RhdHVzGAogASgOMi4ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50U3RhdHVzEhUKDWhlYWx0aF9zdGF0dXMYCyABKAkSNAoQbGFzdF9kZXBsb3llZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoPYmFuZHdpZHRoX3VzYWdlGA0gASgDEhUKDXN0b3JhZ2VfdXNhZ2UYDiABKAMSLgoKY3JlYXRlZF9hdBgPIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKYnVpbGRfdGltZRgQIAEoBRIMCgRzaXplGBEgASgJEj4KC2Vudmlyb25tZW50GBIgASgOMikub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5FbnZpcm9ubWVudBIOCgZncm91cHMYICADKAkSEgoFaW1hZ2UYEyABKAlICogBARIRCgRwb3J0GBQgASgFSAuIAQESFQoIcmVwbGljYXMYFSABKAVIDIgBARIVCg1jb250YWluZXJfaWRzGBYgAygJEhQKB25vZGVfaWQYFyABKAlIDYgBARIaCg1ub2RlX2hvc3RuYW1lGBggASgJSA6IAQESRwoIZW52X3ZhcnMYGSADKAsyNS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnQuRW52VmFyc0VudHJ5EiIKFWdpdGh1Yl9pbnRlZ3JhdGlvbl9pZBghIAEoCUgPiAEBEh8KEmNvbnRhaW5lcnNfcnVubmluZxgeIAEoBUgQiAEBEh0KEGNvbnRhaW5lcnNfdG90YWwYHyABKAVIEYgBARIWCgljcHVfbGltaXQYJiABKAFIEogBARIZCgxtZW1vcnlfbGltaXQYJyABKANIE4gBARJMChBoZWFsdGhjaGVja190eXBlGCggASgOMi0ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5IZWFsdGhDaGVja1R5cGVIFIgBARIdChBoZWFsdGhjaGVja19wb3J0GCkgASgFSBWIAQESHQoQaGVhbHRoY2hlY2tfcGF0aBgqIAEoCUgWiAEBEigKG2hlYWx0aGNoZWNrX2V4cGVjdGVkX3N0YXR1cxgrIAEoBUgXiAEBEicKGmhlYWx0aGNoZWNrX2N1c3RvbV9jb21tYW5kGCwgASgJSBiIAQESGAoLYXV0b19kZXBsb3kYLSABKAhIGYgBARJLCgpidWlsZF9hcmdzGC4gAygLMjcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50LkJ1aWxkQXJnc0VudHJ5EkoKEmRvY2tlcmZpbGVfdm9sdW1lcxgvIAMoCzIuLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRG9ja2VyZmlsZVZvbHVtZRJbChhkb2NrZXJmaWxlX2J1aWxkX29wdGlvbnMYMCABKAsyNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRvY2tlcmZpbGVCdWlsZE9wdGlvbnNIGogBARouCgxFbnZWYXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARowCg5CdWlsZEFyZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQhEKD19yZXBvc2l0b3J5X3VybEIQCg5fYnVpbGRfY29tbWFuZEISChBfaW5zdGFsbF9jb21tYW5kQhAKDl9zdGFydF9jb21tYW5kQhIKEF9kb2NrZXJmaWxlX3BhdGhCFAoSX2NvbXBvc2VfZmlsZV9wYXRoQg0KC19idWlsZF9wYXRoQhQKEl9idWlsZF9vdXRwdXRfcGF0aEIMCgpfdXNlX25naW54Qg8KDV9uZ2lueF9jb25maWdCCAoGX2ltYWdlQgcKBV9wb3J0QgsKCV9yZXBsaWNhc0IKCghfbm9kZV9pZEIQCg5fbm9kZV9ob3N0bmFtZUIYChZfZ2l0aHViX2ludGVncmF0aW9uX2lkQhUKE19jb250YWluZXJzX3J1bm5pbmdCEwoRX2NvbnRhaW5lcnNfdG90YWxCDAoKX2NwdV9saW1pdEIPCg1fbWVtb3J5X2xpbWl0QhMKEV9oZWFsdGhjaGVja190eXBlQhMKEV9oZWFsdGhjaGVja19wb3J0QhMKEV9oZWFsdGhjaGVja19wYXRoQh4KHF9oZWFsdGhjaGVja19leHBlY3RlZF9zdGF0dXNCHQobX2hlYWx0aGNoZWNrX2N1c3RvbV9jb21tYW5kQg4KDF9hdXRvX2RlcGxveUIbChlfZG9ja2VyZmlsZV9idWlsZF9vcHRpb25zIkcKEERvY2tlcmZpbGVWb2x1bWUSDAoEbmFtZRgBIAEoCRISCgptb3VudF9wYXRoGAIgASgJEhEKCXJlYWRfb25seRgDIAEoCCKdAgoWRG9ja2VyZmlsZUJ1aWxkT3B0aW9ucxITCgZ0YXJnZXQYASABKAlIAIgBARIVCghwbGF0Zm9ybRgCIAEoCUgBiAEBEhUKCG5vX2NhY2hlGAMgASgISAKIAQESEQoEcHVsbBgEIAEoCEgDiAEBElAKBmxhYmVscxgFIAMoCzJALm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRG9ja2VyZmlsZUJ1aWxkT3B0aW9ucy5MYWJlbHNFbnRyeRotCgtMYWJlbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQgkKB190YXJnZXRCCwoJX3BsYXRmb3JtQgsKCV9ub19jYWNoZUIHCgVfcHVsbCJRCh9MaXN0RGVwbG95bWVudENvbnRhaW5lcnNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJImkKIExpc3REZXBsb3ltZW50Q29udGFpbmVyc1Jlc3BvbnNlEkUKCmNvbnRhaW5lcnMYASADKAsyMS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnRDb250YWluZXIiswIKE0RlcGxveW1lbnRDb250YWluZXISFAoMY29udGFpbmVyX2lkGAEgASgJEhkKDHNlcnZpY2VfbmFtZRgCIAEoCUgAiAEBEg4KBnN0YXR1cxgDIAEoCRIUCgdub2RlX2lkGAQgASgJSAGIAQESGgoNbm9kZV9ob3N0bmFtZRgFIAEoCUgCiAEBEhEKBHBvcnQYBiABKAVIA4gBARIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIPCg1fc2VydmljZV9uYW1lQgoKCF9ub2RlX2lkQhAKDl9ub2RlX2hvc3RuYW1lQgcKBV9wb3J0InAKGlN0cmVhbUNvbnRhaW5lckxvZ3NSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhQKDGNvbnRhaW5lcl9pZBgDIAEoCRIMCgR0YWlsGAQgASgFIl0KFVN0YXJ0Q29udGFpbmVyUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIUCgxjb250YWluZXJfaWQYAyABKAkiRwoWU3RhcnRDb250YWluZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhIKBWVycm9yGAIgASgJSACIAQFCCAoGX2Vycm9yIlwKFFN0b3BDb250YWluZXJSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhQKDGNvbnRhaW5lcl9pZBgDIAEoCSJGChVTdG9wQ29udGFpbmVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBISCgVlcnJvchgCIAEoCUgAiAEBQggKBl9lcnJvciJfChdSZXN0YXJ0Q29udGFpbmVyUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIUCgxjb250YWluZXJfaWQYAyABKAkiSQoYUmVzdGFydENvbnRhaW5lclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSEgoFZXJyb3IYAiABKAlIAIgBAUIICgZfZXJyb3IigQEKEUxpc3RCdWlsZHNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhIKBWxpbWl0GAMgASgFSACIAQESEwoGb2Zmc2V0GAQgASgFSAGIAQFCCAoGX2xpbWl0QgkKB19vZmZzZXQiWAoSTGlzdEJ1aWxkc1Jlc3BvbnNlEjMKBmJ1aWxkcxgBIAMoCzIjLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQnVpbGQSDQoFdG90YWwYAiABKAUiUwoPR2V0QnVpbGRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhAKCGJ1aWxkX2lkGAMgASgJIkYKEEdldEJ1aWxkUmVzcG9uc2USMgoFYnVpbGQYASABKAsyIy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkJ1aWxkIpUBChNHZXRCdWlsZExvZ3NSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhAKCGJ1aWxkX2lkGAMgASgJEhIKBWxpbWl0GAQgASgFSACIAQESEwoGb2Zmc2V0GAUgASgFSAGIAQFCCAoGX2xpbWl0QgkKB19vZmZzZXQiZAoUR2V0QnVpbGRMb2dzUmVzcG9uc2USPQoEbG9ncxgBIAMoCzIvLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudExvZ0xpbmUSDQoFdG90YWwYAiABKAUiWAoUUmV2ZXJ0VG9CdWlsZFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSEAoIYnVpbGRfaWQYAyABKAkiawoVUmV2ZXJ0VG9CdWlsZFJlc3BvbnNlEjwKCmRlcGxveW1lbnQYASABKAsyKC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnQSFAoMbmV3X2J1aWxkX2lkGAIgASgJIlYKEkRlbGV0ZUJ1aWxkUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIQCghidWlsZF9pZBgDIAEoCSImChNEZWxldGVCdWlsZFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgixwcKBUJ1aWxkEgoKAmlkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAMgASgJEhQKDGJ1aWxkX251bWJlchgEIAEoBRI5CgZzdGF0dXMYBSABKA4yKS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkJ1aWxkU3RhdHVzEi4KCnN0YXJ0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjUKDGNvbXBsZXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARISCgpidWlsZF90aW1lGAggASgFEhQKDHRyaWdnZXJlZF9ieRgJIAEoCRIbCg5yZXBvc2l0b3J5X3VybBgKIAEoCUgBiAEBEg4KBmJyYW5jaBgLIAEoCRIXCgpjb21taXRfc2hhGAwgASgJSAKIAQESGgoNYnVpbGRfY29tbWFuZBgNIAEoCUgDiAEBEhwKD2luc3RhbGxfY29tbWFuZBgOIAEoCUgEiAEBEhoKDXN0YXJ0X2NvbW1hbmQYDyABKAlIBYgBARIcCg9kb2NrZXJmaWxlX3BhdGgYECABKAlIBogBARIeChFjb21wb3NlX2ZpbGVfcGF0aBgRIAEoCUgHiAEBEkMKDmJ1aWxkX3N0cmF0ZWd5GBIgASgOMisub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5CdWlsZFN0cmF0ZWd5EhcKCmltYWdlX25hbWUYEyABKAlICIgBARIZCgxjb21wb3NlX3lhbWwYFCABKAlICYgBARIRCgRzaXplGBUgASgJSAqIAQESEgoFZXJyb3IYFiABKAlIC4gBARIuCgpjcmVhdGVkX2F0GBcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GBggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIPCg1fY29tcGxldGVkX2F0QhEKD19yZXBvc2l0b3J5X3VybEINCgtfY29tbWl0X3NoYUIQCg5fYnVpbGRfY29tbWFuZEISChBfaW5zdGFsbF9jb21tYW5kQhAKDl9zdGFydF9jb21tYW5kQhIKEF9kb2NrZXJmaWxlX3BhdGhCFAoSX2NvbXBvc2VfZmlsZV9wYXRoQg0KC19pbWFnZV9uYW1lQg8KDV9jb21wb3NlX3lhbWxCBwoFX3NpemVCCAoGX2Vycm9yKpsBCg5EZXBsb3ltZW50VHlwZRIfChtERVBMT1lNRU5UX1RZUEVfVU5TUEVDSUZJRUQQABIKCgZET0NLRVIQARIKCgZTVEFUSUMQAhIICgROT0RFEAMSBgoCR08QBBIKCgZQWVRIT04QBRIICgRSVUJZEAYSCAoEUlVTVBAHEggKBEpBVkEQCBIHCgNQSFAQCRILCgdHRU5FUklDEAoqkQEKDUJ1aWxkU3RyYXRlZ3kSHgoaQlVJTERfU1RSQVRFR1lfVU5TUEVDSUZJRUQQABIMCghSQUlMUEFDSxABEgwKCE5JWFBBQ0tTEAISDgoKRE9DS0VSRklMRRADEhEKDVBMQUlOX0NPTVBPU0UQBBIQCgxDT01QT1NFX1JFUE8QBhIPCgtTVEFUSUNfU0lURRAFKlgKC0Vudmlyb25tZW50EhsKF0VOVklST05NRU5UX1VOU1BFQ0lGSUVEEAASDgoKUFJPRFVDVElPThABEgsKB1NUQUdJTkcQAhIPCgtERVZFTE9QTUVOVBADKoUBChBEZXBsb3ltZW50U3RhdHVzEiEKHURFUExPWU1FTlRfU1RBVFVTX1VOU1BFQ0lGSUVEEAASCwoHQ1JFQVRFRBABEgwKCEJVSUxESU5HEAISCwoHUlVOTklORxADEgsKB1NUT1BQRUQQBBIKCgZGQUlMRUQQBRINCglERVBMT1lJTkcQBip3CgtCdWlsZFN0YXR1cxIcChhCVUlMRF9TVEFUVVNfVU5TUEVDSUZJRUQQABIRCg1CVUlMRF9QRU5ESU5HEAESEgoOQlVJTERfQlVJTERJTkcQAhIRCg1CVUlMRF9TVUNDRVNTEAMSEAoMQlVJTERfRkFJTEVEEAQqkAEKD0hlYWx0aENoZWNrVHlwZRIgChxIRUFMVEhDSEVDS19UWVBFX1VOU1BFQ0lGSUVEEAASGAoUSEVBTFRIQ0hFQ0tfRElTQUJMRUQQARITCg9IRUFMVEhDSEVDS19UQ1AQAhIUChBIRUFMVEhDSEVDS19IVFRQEAMSFgoSSEVBTFRIQ0hFQ0tfQ1VTVE9NEAQqnwEKEkNvbnRhaW5lckVudHJ5VHlwZRIkCiBDT05UQUlORVJfRU5UUllfVFlQRV9VTlNQRUNJRklFRBAAEh0KGUNPTlRBSU5FUl9FTlRSWV9UWVBFX0ZJTEUQARIiCh5DT05UQUlORVJfRU5UUllfVFlQRV9ESVJFQ1RPUlkQAhIgChxDT05UQUlORVJfRU5UUllfVFlQRV9TWU1MSU5LEAMymDoKEURlcGxveW1lbnRTZXJ2aWNlEn4KD0xpc3REZXBsb3ltZW50cxI0Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuTGlzdERlcGxveW1lbnRzUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuTGlzdERlcGxveW1lbnRzUmVzcG9uc2USgQEKEENyZWF0ZURlcGxveW1lbnQSNS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkNyZWF0ZURlcGxveW1lbnRSZXF1ZXN0GjYub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5DcmVhdGVEZXBsb3ltZW50UmVzcG9uc2USeAoNR2V0RGVwbG95bWVudBIyLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudFJlcXVlc3QaMy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRSZXNwb25zZRKBAQoQVXBkYXRlRGVwbG95bWVudBI1Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVXBkYXRlRGVwbG95bWVudFJlcXVlc3QaNi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlVwZGF0ZURlcGxveW1lbnRSZXNwb25zZRKEAQoRVHJpZ2dlckRlcGxveW1lbnQSNi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlRyaWdnZXJEZXBsb3ltZW50UmVxdWVzdBo3Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVHJpZ2dlckRlcGxveW1lbnRSZXNwb25zZRKNAQoWU3RyZWFtRGVwbG95bWVudFN0YXR1cxI7Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU3RyZWFtRGVwbG95bWVudFN0YXR1c1JlcXVlc3QaNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnRTdGF0dXNVcGRhdGUwARKEAQoRR2V0RGVwbG95bWVudExvZ3MSNi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRMb2dzUmVxdWVzdBo3Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudExvZ3NSZXNwb25zZRKEAQoUU3RyZWFtRGVwbG95bWVudExvZ3MSOS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlN0cmVhbURlcGxveW1lbnRMb2dzUmVxdWVzdBovLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudExvZ0xpbmUwARJ6Cg9TdHJlYW1CdWlsZExvZ3MSNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlN0cmVhbUJ1aWxkTG9nc1JlcXVlc3QaLy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnRMb2dMaW5lMAESjQEKFEdldERlcGxveW1lbnRNZXRyaWNzEjkub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXREZXBsb3ltZW50TWV0cmljc1JlcXVlc3QaOi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRNZXRyaWNzUmVzcG9uc2USiQEKF1N0cmVhbURlcGxveW1lbnRNZXRyaWNzEjwub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TdHJlYW1EZXBsb3ltZW50TWV0cmljc1JlcXVlc3QaLi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnRNZXRyaWMwARKHAQoSR2V0RGVwbG95bWVudFVzYWdlEjcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXREZXBsb3ltZW50VXNhZ2VSZXF1ZXN0Gjgub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXREZXBsb3ltZW50VXNhZ2VSZXNwb25zZRJ+Cg9TdGFydERlcGxveW1lbnQSNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlN0YXJ0RGVwbG95bWVudFJlcXVlc3QaNS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlN0YXJ0RGVwbG95bWVudFJlc3BvbnNlEnsKDlN0b3BEZXBsb3ltZW50EjMub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TdG9wRGVwbG95bWVudFJlcXVlc3QaNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlN0b3BEZXBsb3ltZW50UmVzcG9uc2USgQEKEERlbGV0ZURlcGxveW1lbnQSNS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlbGV0ZURlcGxveW1lbnRSZXF1ZXN0GjYub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZWxldGVEZXBsb3ltZW50UmVzcG9uc2UShAEKEVJlc3RhcnREZXBsb3ltZW50EjYub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5SZXN0YXJ0RGVwbG95bWVudFJlcXVlc3QaNy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlJlc3RhcnREZXBsb3ltZW50UmVzcG9uc2USfgoPU2NhbGVEZXBsb3ltZW50EjQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TY2FsZURlcGxveW1lbnRSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TY2FsZURlcGxveW1lbnRSZXNwb25zZRKNAQoUR2V0RGVwbG95bWVudEVudlZhcnMSOS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRFbnZWYXJzUmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudEVudlZhcnNSZXNwb25zZRKWAQoXVXBkYXRlRGVwbG95bWVudEVudlZhcnMSPC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlVwZGF0ZURlcGxveW1lbnRFbnZWYXJzUmVxdWVzdBo9Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVXBkYXRlRGVwbG95bWVudEVudlZhcnNSZXNwb25zZRKNAQoUR2V0RGVwbG95bWVudENvbXBvc2USOS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRDb21wb3NlUmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudENvbXBvc2VSZXNwb25zZRKcAQoZVmFsaWRhdGVEZXBsb3ltZW50Q29tcG9zZRI+Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVmFsaWRhdGVEZXBsb3ltZW50Q29tcG9zZVJlcXVlc3QaPy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlZhbGlkYXRlRGVwbG95bWVudENvbXBvc2VSZXNwb25zZRKWAQoXVXBkYXRlRGVwbG95bWVudENvbXBvc2USPC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlVwZGF0ZURlcGxveW1lbnRDb21wb3NlUmVxdWVzdBo9Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVXBkYXRlRGVwbG95bWVudENvbXBvc2VSZXNwb25zZRJ+Cg9MaXN0R2l0SHViUmVwb3MSNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkxpc3RHaXRIdWJSZXBvc1JlcXVlc3QaNS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkxpc3RHaXRIdWJSZXBvc1Jlc3BvbnNlEoQBChFHZXRHaXRIdWJCcmFuY2hlcxI2Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0R2l0SHViQnJhbmNoZXNSZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXRHaXRIdWJCcmFuY2hlc1Jlc3BvbnNlEngKDUdldEdpdEh1YkZpbGUSMi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldEdpdEh1YkZpbGVSZXF1ZXN0GjMub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXRHaXRIdWJGaWxlUmVzcG9uc2USbwoKTGlzdEJ1aWxkcxIvLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuTGlzdEJ1aWxkc1JlcXVlc3QaMC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkxpc3RCdWlsZHNSZXNwb25zZRJpCghHZXRCdWlsZBItLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0QnVpbGRSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXRCdWlsZFJlc3BvbnNlEnUKDEdldEJ1aWxkTG9ncxIxLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0QnVpbGRMb2dzUmVxdWVzdBoyLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0QnVpbGRMb2dzUmVzcG9uc2USeAoNUmV2ZXJ0VG9CdWlsZBIyLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuUmV2ZXJ0VG9CdWlsZFJlcXVlc3QaMy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlJldmVydFRvQnVpbGRSZXNwb25zZRJyCgtEZWxldGVCdWlsZBIwLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVsZXRlQnVpbGRSZXF1ZXN0GjEub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZWxldGVCdWlsZFJlc3BvbnNlEq4BCh9MaXN0QXZhaWxhYmxlR2l0SHViSW50ZWdyYXRpb25zEkQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5MaXN0QXZhaWxhYmxlR2l0SHViSW50ZWdyYXRpb25zUmVxdWVzdBpFLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuTGlzdEF2YWlsYWJsZUdpdEh1YkludGVncmF0aW9uc1Jlc3BvbnNlEm8KDlN0cmVhbVRlcm1pbmFsEisub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5UZXJtaW5hbElucHV0Giwub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5UZXJtaW5hbE91dHB1dCgBMAESgQEKFFN0cmVhbVRlcm1pbmFsT3V0cHV0Ejkub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TdHJlYW1UZXJtaW5hbE91dHB1dFJlcXVlc3QaLC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlRlcm1pbmFsT3V0cHV0MAEShAEKEVNlbmRUZXJtaW5hbElucHV0EjYub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TZW5kVGVybWluYWxJbnB1dFJlcXVlc3QaNy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlNlbmRUZXJtaW5hbElucHV0UmVzcG9uc2UShwEKEkxpc3RDb250YWluZXJGaWxlcxI3Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuTGlzdENvbnRhaW5lckZpbGVzUmVxdWVzdBo4Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuTGlzdENvbnRhaW5lckZpbGVzUmVzcG9uc2USgQEKEEdldENvbnRhaW5lckZpbGUSNS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldENvbnRhaW5lckZpbGVSZXF1ZXN0GjYub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXRDb250YWluZXJGaWxlUmVzcG9uc2USjQEKFFVwbG9hZENvbnRhaW5lckZpbGVzEjkub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5VcGxvYWRDb250YWluZXJGaWxlc1JlcXVlc3QaOi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlVwbG9hZENvbnRhaW5lckZpbGVzUmVzcG9uc2USnAEKGUNodW5rVXBsb2FkQ29udGFpbmVyRmlsZXMSPi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkNodW5rVXBsb2FkQ29udGFpbmVyRmlsZXNSZXF1ZXN0Gj8ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5DaHVua1VwbG9hZENvbnRhaW5lckZpbGVzUmVzcG9uc2USkwEKFkRlbGV0ZUNvbnRhaW5lckVudHJpZXMSOy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlbGV0ZUNvbnRhaW5lckVudHJpZXNSZXF1ZXN0Gjwub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZWxldGVDb250YWluZXJFbnRyaWVzUmVzcG9uc2USjQEKFFJlbmFtZUNvbnRhaW5lckVudHJ5Ejkub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5SZW5hbWVDb250YWluZXJFbnRyeVJlcXVlc3QaOi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlJlbmFtZUNvbnRhaW5lckVudHJ5UmVzcG9uc2USjQEKFENyZWF0ZUNvbnRhaW5lckVudHJ5Ejkub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5DcmVhdGVDb250YWluZXJFbnRyeVJlcXVlc3QaOi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkNyZWF0ZUNvbnRhaW5lckVudHJ5UmVzcG9uc2UShwEKEldyaXRlQ29udGFpbmVyRmlsZRI3Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuV3JpdGVDb250YWluZXJGaWxlUmVxdWVzdBo4Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuV3JpdGVDb250YWluZXJGaWxlUmVzcG9uc2USkAEKFUV4dHJhY3REZXBsb3ltZW50RmlsZRI6Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRXh0cmFjdERlcGxveW1lbnRGaWxlUmVxdWVzdBo7Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRXh0cmFjdERlcGxveW1lbnRGaWxlUmVzcG9uc2USogEKG0NyZWF0ZURlcGxveW1lbnRGaWxlQXJjaGl2ZRJALm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQ3JlYXRlRGVwbG95bWVudEZpbGVBcmNoaXZlUmVxdWVzdBpBLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQ3JlYXRlRGVwbG95bWVudEZpbGVBcmNoaXZlUmVzcG9uc2USkAEKFUdldERlcGxveW1lbnRSb3V0aW5ncxI6Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudFJvdXRpbmdzUmVxdWVzdBo7Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudFJvdXRpbmdzUmVzcG9uc2USmQEKGFVwZGF0ZURlcGxveW1lbnRSb3V0aW5ncxI9Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVXBkYXRlRGVwbG95bWVudFJvdXRpbmdzUmVxdWVzdBo+Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVXBkYXRlRGVwbG95bWVudFJvdXRpbmdzUmVzcG9uc2USnAEKGUdldERlcGxveW1lbnRTZXJ2aWNlTmFtZXMSPi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRTZXJ2aWNlTmFtZXNSZXF1ZXN0Gj8ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXREZXBsb3ltZW50U2VydmljZU5hbWVzUmVzcG9uc2USnwEKGkdldERvbWFpblZlcmlmaWNhdGlvblRva2VuEj8ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXREb21haW5WZXJpZmljYXRpb25Ub2tlblJlcXVlc3QaQC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERvbWFpblZlcmlmaWNhdGlvblRva2VuUmVzcG9uc2USkAEKFVZlcmlmeURvbWFpbk93bmVyc2hpcBI6Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVmVyaWZ5RG9tYWluT3duZXJzaGlwUmVxdWVzdBo7Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVmVyaWZ5RG9tYWluT3duZXJzaGlwUmVzcG9uc2USmQEKGExpc3REZXBsb3ltZW50Q29udGFpbmVycxI9Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuTGlzdERlcGxveW1lbnRDb250YWluZXJzUmVxdWVzdBo+Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuTGlzdERlcGxveW1lbnRDb250YWluZXJzUmVzcG9uc2USggEKE1N0cmVhbUNvbnRhaW5lckxvZ3MSOC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlN0cmVhbUNvbnRhaW5lckxvZ3NSZXF1ZXN0Gi8ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50TG9nTGluZTABEnsKDlN0YXJ0Q29udGFpbmVyEjMub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TdGFydENvbnRhaW5lclJlcXVlc3QaNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlN0YXJ0Q29udGFpbmVyUmVzcG9uc2USeAoNU3RvcENvbnRhaW5lchIyLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU3RvcENvbnRhaW5lclJlcXVlc3QaMy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlN0b3BDb250YWluZXJSZXNwb25zZRKBAQoQUmVzdGFydENvbnRhaW5lchI1Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuUmVzdGFydENvbnRhaW5lclJlcXVlc3QaNi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlJlc3RhcnRDb250YWluZXJSZXNwb25zZUJXWlVnaXRodWIuY29tL29iaWVudGUvY2xvdWQvYXBwcy9zaGFyZWQvcHJvdG8vb2JpZW50ZS9jbG91ZC9kZXBsb3ltZW50cy92MTtkZXBsb3ltZW50c3YxYgZwcm90bzM", [file_google_protobuf_timestamp, file_obiente_cloud_organizations_v1_organization_service, file_obiente_cloud_common_v1_common]);
+  fileDesc("CjVvYmllbnRlL2Nsb3VkL2RlcGxveW1lbnRzL3YxL2RlcGxveW1lbnRfc2VydmljZS5wcm90bxIcb2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MSKcAgoWTGlzdERlcGxveW1lbnRzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSQwoGc3RhdHVzGAIgASgOMi4ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50U3RhdHVzSACIAQESDAoEcGFnZRgDIAEoBRIQCghwZXJfcGFnZRgEIAEoBRJMCgR0YWdzGAUgAygLMj4ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5MaXN0RGVwbG95bWVudHNSZXF1ZXN0LlRhZ3NFbnRyeRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIJCgdfc3RhdHVzIpEBChdMaXN0RGVwbG95bWVudHNSZXNwb25zZRI9CgtkZXBsb3ltZW50cxgBIAMoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudBI3CgpwYWdpbmF0aW9uGAIgASgLMiMub2JpZW50ZS5jbG91ZC5jb21tb24udjEuUGFnaW5hdGlvbiLXAQoXQ3JlYXRlRGVwbG95bWVudFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSPgoLZW52aXJvbm1lbnQYAyABKA4yKS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkVudmlyb25tZW50Eg4KBmdyb3VwcxgEIAMoCRISCgppc19wcmV2aWV3GAUgASgIEhQKB3RlYW1faWQYBiABKAlIAIgBARIPCgdyZWdpb25zGAcgAygJQgoKCF90ZWFtX2lkIrQBChhDcmVhdGVEZXBsb3ltZW50UmVzcG9uc2USPAoKZGVwbG95bWVudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudBITCgt0cmFlZmlrX2lwcxgCIAMoCRJFCgdyZWdpb25zGAMgAygLMjQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50UmVnaW9uU3RhdHVzIkYKFEdldERlcGxveW1lbnRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJIlUKFUdldERlcGxveW1lbnRSZXNwb25zZRI8CgpkZXBsb3ltZW50GAEgASgLMigub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50IqcOChdVcGRhdGVEZXBsb3ltZW50UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIRCgRuYW1lGAMgASgJSACIAQESGwoOcmVwb3NpdG9yeV91cmwYBCABKAlIAYgBARIiChVnaXRodWJfaW50ZWdyYXRpb25faWQYDiABKAlIAogBARITCgZicmFuY2gYBSABKAlIA4gBARIaCg1idWlsZF9jb21tYW5kGAYgASgJSASIAQESHAoPaW5zdGFsbF9jb21tYW5kGAcgASgJSAWIAQESGgoNc3RhcnRfY29tbWFuZBgRIAEoCUgGiAEBEhwKD2RvY2tlcmZpbGVfcGF0aBgMIAEoCUgHiAEBEh4KEWNvbXBvc2VfZmlsZV9wYXRoGA0gASgJSAiIAQESFwoKYnVpbGRfcGF0aBgSIAEoCUgJiAEBEh4KEWJ1aWxkX291dHB1dF9wYXRoGBMgASgJSAqIAQESFgoJdXNlX25naW54GBQgASgISAuIAQESGQoMbmdpbnhfY29uZmlnGBUgASgJSAyIAQESEwoGZG9tYWluGAggASgJSA2IAQESFgoOY3VzdG9tX2RvbWFpbnMYCSADKAkSEQoEcG9ydBgKIAEoBUgOiAEBEkgKDmJ1aWxkX3N0cmF0ZWd5GAsgASgOMisub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5CdWlsZFN0cmF0ZWd5SA+IAQESQwoLZW52aXJvbm1lbnQYDyABKA4yKS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkVudmlyb25tZW50SBCIAQESDgoGZ3JvdXBzGBAgAygJEhYKCWNwdV9saW1pdBgWIAEoAUgRiAEBEhkKDG1lbW9yeV9saW1pdBgXIAEoA0gSiAEBEkwKEGhlYWx0aGNoZWNrX3R5cGUYGCABKA4yLS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkhlYWx0aENoZWNrVHlwZUgTiAEBEh0KEGhlYWx0aGNoZWNrX3BvcnQYGSABKAVIFIgBARIdChBoZWFsdGhjaGVja19wYXRoGBogASgJSBWIAQESKAobaGVhbHRoY2hlY2tfZXhwZWN0ZWRfc3RhdHVzGBsgASgFSBaIAQESJwoaaGVhbHRoY2hlY2tfY3VzdG9tX2NvbW1hbmQYHCABKAlIF4gBARIYCgthdXRvX2RlcGxveRgdIAEoCEgYiAEBElgKCmJ1aWxkX2FyZ3MYHiADKAsyRC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlVwZGF0ZURlcGxveW1lbnRSZXF1ZXN0LkJ1aWxkQXJnc0VudHJ5EkoKEmRvY2tlcmZpbGVfdm9sdW1lcxgfIAMoCzIuLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRG9ja2VyZmlsZVZvbHVtZRJbChhkb2NrZXJmaWxlX2J1aWxkX29wdGlvbnMYICABKAsyNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRvY2tlcmZpbGVCdWlsZE9wdGlvbnNIGYgBARIPCgdyZWdpb25zGCEgAygJEiEKFHplcm9fZG93bnRpbWVfZGVwbG95GCIgASgISBqIAQEaMAoOQnVpbGRBcmdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIHCgVfbmFtZUIRCg9fcmVwb3NpdG9yeV91cmxCGAoWX2dpdGh1Yl9pbnRlZ3JhdGlvbl9pZEIJCgdfYnJhbmNoQhAKDl9idWlsZF9jb21tYW5kQhIKEF9pbnN0YWxsX2NvbW1hbmRCEAoOX3N0YXJ0X2NvbW1hbmRCEgoQX2RvY2tlcmZpbGVfcGF0aEIUChJfY29tcG9zZV9maWxlX3BhdGhCDQoLX2J1aWxkX3BhdGhCFAoSX2J1aWxkX291dHB1dF9wYXRoQgwKCl91c2VfbmdpbnhCDwoNX25naW54X2NvbmZpZ0IJCgdfZG9tYWluQgcKBV9wb3J0QhEKD19idWlsZF9zdHJhdGVneUIOCgxfZW52aXJvbm1lbnRCDAoKX2NwdV9saW1pdEIPCg1fbWVtb3J5X2xpbWl0QhMKEV9oZWFsdGhjaGVja190eXBlQhMKEV9oZWFsdGhjaGVja19wb3J0QhMKEV9oZWFsdGhjaGVja19wYXRoQh4KHF9oZWFsdGhjaGVja19leHBlY3RlZF9zdGF0dXNCHQobX2hlYWx0aGNoZWNrX2N1c3RvbV9jb21tYW5kQg4KDF9hdXRvX2RlcGxveUIbChlfZG9ja2VyZmlsZV9idWlsZF9vcHRpb25zQhcKFV96ZXJvX2Rvd250aW1lX2RlcGxveSJYChhVcGRhdGVEZXBsb3ltZW50UmVzcG9uc2USPAoKZGVwbG95bWVudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudCJKChhUcmlnZ2VyRGVwbG95bWVudFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkiQgoZVHJpZ2dlckRlcGxveW1lbnRSZXNwb25zZRIVCg1kZXBsb3ltZW50X2lkGAEgASgJEg4KBnN0YXR1cxgCIAEoCSJPCh1TdHJlYW1EZXBsb3ltZW50U3RhdHVzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCSLXAQoWRGVwbG95bWVudFN0YXR1c1VwZGF0ZRIVCg1kZXBsb3ltZW50X2lkGAEgASgJEj4KBnN0YXR1cxgCIAEoDjIuLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudFN0YXR1cxIVCg1oZWFsdGhfc3RhdHVzGAMgASgJEhQKB21lc3NhZ2UYBCABKAlIAIgBARItCgl0aW1lc3RhbXAYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgoKCF9tZXNzYWdlIogBChhHZXREZXBsb3ltZW50TG9nc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSEgoFbGluZXMYAyABKAVIAIgBARITCgZmb2xsb3cYBCABKAhIAYgBAUIICgZfbGluZXNCCQoHX2ZvbGxvdyIpChlHZXREZXBsb3ltZW50TG9nc1Jlc3BvbnNlEgwKBGxvZ3MYASADKAkiwQEKG1N0cmVhbURlcGxveW1lbnRMb2dzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIRCgR0YWlsGAMgASgFSACIAQESGQoMY29udGFpbmVyX2lkGAQgASgJSAGIAQESGQoMc2VydmljZV9uYW1lGAUgASgJSAKIAQFCBwoFX3RhaWxCDwoNX2NvbnRhaW5lcl9pZEIPCg1fc2VydmljZV9uYW1lIkgKFlN0cmVhbUJ1aWxkTG9nc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkirQEKEURlcGxveW1lbnRMb2dMaW5lEhUKDWRlcGxveW1lbnRfaWQYASABKAkSDAoEbGluZRgCIAEoCRItCgl0aW1lc3RhbXAYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg4KBnN0ZGVychgEIAEoCBI0Cglsb2dfbGV2ZWwYBSABKA4yIS5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5Mb2dMZXZlbCJIChZTdGFydERlcGxveW1lbnRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJIlcKF1N0YXJ0RGVwbG95bWVudFJlc3BvbnNlEjwKCmRlcGxveW1lbnQYASABKAsyKC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnQiRwoVU3RvcERlcGxveW1lbnRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJIlYKFlN0b3BEZXBsb3ltZW50UmVzcG9uc2USPAoKZGVwbG95bWVudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudCJJChdEZWxldGVEZXBsb3ltZW50UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCSIrChhEZWxldGVEZXBsb3ltZW50UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJKChhSZXN0YXJ0RGVwbG95bWVudFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkiWQoZUmVzdGFydERlcGxveW1lbnRSZXNwb25zZRI8CgpkZXBsb3ltZW50GAEgASgLMigub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50Im0KGVJvbGxiYWNrRGVwbG95bWVudFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSFAoHdmVyc2lvbhgDIAEoBUgAiAEBQgoKCF92ZXJzaW9uIq0BChpSb2xsYmFja0RlcGxveW1lbnRSZXNwb25zZRI8CgpkZXBsb3ltZW50GAEgASgLMigub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50EkUKB3ZlcnNpb24YAiABKAsyLy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnRWZXJzaW9uSACIAQFCCgoIX3ZlcnNpb24ikQEKEURlcGxveW1lbnRWZXJzaW9uEhYKDnZlcnNpb25fbnVtYmVyGAEgASgFEg0KBWltYWdlGAIgASgJEhUKDWVudl92YXJzX2hhc2gYAyABKAkSDgoGYWN0aXZlGAQgASgIEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIk8KHUxpc3REZXBsb3ltZW50VmVyc2lvbnNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJImMKHkxpc3REZXBsb3ltZW50VmVyc2lvbnNSZXNwb25zZRJBCgh2ZXJzaW9ucxgBIAMoCzIvLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudFZlcnNpb24iWgoWU2NhbGVEZXBsb3ltZW50UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIQCghyZXBsaWNhcxgDIAEoBSJXChdTY2FsZURlcGxveW1lbnRSZXNwb25zZRI8CgpkZXBsb3ltZW50GAEgASgLMigub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50InMKGFNldFJlc291cmNlTGltaXRzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIRCgljcHVfbGltaXQYAyABKAESFAoMbWVtb3J5X2xpbWl0GAQgASgDIlkKGVNldFJlc291cmNlTGltaXRzUmVzcG9uc2USPAoKZGVwbG95bWVudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudCJNChtHZXREZXBsb3ltZW50RW52VmFyc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkiOAocR2V0RGVwbG95bWVudEVudlZhcnNSZXNwb25zZRIYChBlbnZfZmlsZV9jb250ZW50GAEgASgJImoKHlVwZGF0ZURlcGxveW1lbnRFbnZWYXJzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIYChBlbnZfZmlsZV9jb250ZW50GAMgASgJIl8KH1VwZGF0ZURlcGxveW1lbnRFbnZWYXJzUmVzcG9uc2USPAoKZGVwbG95bWVudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudCIuChNSb3RhdGVFbnZLZXlSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSJHChRSb3RhdGVFbnZLZXlSZXNwb25zZRIOCgZrZXlfaWQYASABKAkSHwoXZGVwbG95bWVudHNfcmVlbmNyeXB0ZWQYAiABKAUiTQobR2V0RGVwbG95bWVudENvbXBvc2VSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJIjQKHEdldERlcGxveW1lbnRDb21wb3NlUmVzcG9uc2USFAoMY29tcG9zZV95YW1sGAEgASgJImgKIFZhbGlkYXRlRGVwbG95bWVudENvbXBvc2VSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhQKDGNvbXBvc2VfeWFtbBgDIAEoCSKoAQohVmFsaWRhdGVEZXBsb3ltZW50Q29tcG9zZVJlc3BvbnNlEk8KEXZhbGlkYXRpb25fZXJyb3JzGAEgAygLMjQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Db21wb3NlVmFsaWRhdGlvbkVycm9yEh0KEHZhbGlkYXRpb25fZXJyb3IYAiABKAlIAIgBAUITChFfdmFsaWRhdGlvbl9lcnJvciJmCh5VcGRhdGVEZXBsb3ltZW50Q29tcG9zZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSFAoMY29tcG9zZV95YW1sGAMgASgJIuQBCh9VcGRhdGVEZXBsb3ltZW50Q29tcG9zZVJlc3BvbnNlEjwKCmRlcGxveW1lbnQYASABKAsyKC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnQSHQoQdmFsaWRhdGlvbl9lcnJvchgCIAEoCUgAiAEBEk8KEXZhbGlkYXRpb25fZXJyb3JzGAMgAygLMjQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Db21wb3NlVmFsaWRhdGlvbkVycm9yQhMKEV92YWxpZGF0aW9uX2Vycm9yIqkBChZDb21wb3NlVmFsaWRhdGlvbkVycm9yEgwKBGxpbmUYASABKAUSDgoGY29sdW1uGAIgASgFEg8KB21lc3NhZ2UYAyABKAkSEAoIc2V2ZXJpdHkYBCABKAkSEgoKc3RhcnRfbGluZRgFIAEoBRIQCghlbmRfbGluZRgGIAEoBRIUCgxzdGFydF9jb2x1bW4YByABKAUSEgoKZW5kX2NvbHVtbhgIIAEoBSJpChZMaXN0R2l0SHViUmVwb3NSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIWCg5pbnRlZ3JhdGlvbl9pZBgCIAEoCRIMCgRwYWdlGAMgASgFEhAKCHBlcl9wYWdlGAQgASgFIocBCgpHaXRIdWJSZXBvEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEQoJZnVsbF9uYW1lGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEgsKA3VybBgFIAEoCRISCgppc19wcml2YXRlGAYgASgIEhYKDmRlZmF1bHRfYnJhbmNoGAcgASgJImEKF0xpc3RHaXRIdWJSZXBvc1Jlc3BvbnNlEjcKBXJlcG9zGAEgAygLMigub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HaXRIdWJSZXBvEg0KBXRvdGFsGAIgASgFImMKGEdldEdpdEh1YkJyYW5jaGVzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFgoOaW50ZWdyYXRpb25faWQYAiABKAkSFgoOcmVwb19mdWxsX25hbWUYAyABKAkiPQoMR2l0SHViQnJhbmNoEgwKBG5hbWUYASABKAkSEgoKaXNfZGVmYXVsdBgCIAEoCBILCgNzaGEYAyABKAkiWQoZR2V0R2l0SHViQnJhbmNoZXNSZXNwb25zZRI8CghicmFuY2hlcxgBIAMoCzIqLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2l0SHViQnJhbmNoIn0KFEdldEdpdEh1YkZpbGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIWCg5pbnRlZ3JhdGlvbl9pZBgCIAEoCRIWCg5yZXBvX2Z1bGxfbmFtZRgDIAEoCRIOCgZicmFuY2gYBCABKAkSDAoEcGF0aBgFIAEoCSJIChVHZXRHaXRIdWJGaWxlUmVzcG9uc2USDwoHY29udGVudBgBIAEoCRIQCghlbmNvZGluZxgCIAEoCRIMCgRzaXplGAMgASgDIkEKJkxpc3RBdmFpbGFibGVHaXRIdWJJbnRlZ3JhdGlvbnNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSL0AQoXR2l0SHViSW50ZWdyYXRpb25PcHRpb24SCgoCaWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSDwoHaXNfdXNlchgDIAEoCBIWCg5vYmllbnRlX29yZ19pZBgEIAEoCRIYChBvYmllbnRlX29yZ19uYW1lGAUgASgJEhEKCWF1dGhfdHlwZRgGIAEoCRIiChpnaXRodWJfYXBwX2luc3RhbGxhdGlvbl9pZBgHIAEoAxIgChhnaXRodWJfYXBwX2FjY291bnRfbG9naW4YCCABKAkSHwoXZ2l0aHViX2FwcF9hY2NvdW50X3R5cGUYCSABKAkidgonTGlzdEF2YWlsYWJsZUdpdEh1YkludGVncmF0aW9uc1Jlc3BvbnNlEksKDGludGVncmF0aW9ucxgBIAMoCzI1Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2l0SHViSW50ZWdyYXRpb25PcHRpb24iSQoWUm90YXRlRGVwbG95S2V5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFgoOaW50ZWdyYXRpb25faWQYAiABKAkigAEKD0dpdEh1YkRlcGxveUtleRISCgpyZXBvc2l0b3J5GAEgASgJEhIKCnB1YmxpY19rZXkYAiABKAkSFQoNZ2l0aHViX2tleV9pZBgDIAEoAxIuCgpyb3RhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJdChdSb3RhdGVEZXBsb3lLZXlSZXNwb25zZRJCCgtkZXBsb3lfa2V5cxgBIAMoCzItLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2l0SHViRGVwbG95S2V5Ik0KGlJvdGF0ZVdlYmhvb2tTZWNyZXRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIWCg5pbnRlZ3JhdGlvbl9pZBgCIAEoCSIzChtSb3RhdGVXZWJob29rU2VjcmV0UmVzcG9uc2USFAoMcmVwb3NpdG9yaWVzGAEgAygJImkKG1N0cmVhbVRlcm1pbmFsT3V0cHV0UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIMCgRjb2xzGAMgASgFEgwKBHJvd3MYBCABKAUidQoYU2VuZFRlcm1pbmFsSW5wdXRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEg0KBWlucHV0GAMgASgMEgwKBGNvbHMYBCABKAUSDAoEcm93cxgFIAEoBSIsChlTZW5kVGVybWluYWxJbnB1dFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgilgEKDVRlcm1pbmFsSW5wdXQSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSFAoMY29udGFpbmVyX2lkGAYgASgJEhQKDHNlcnZpY2VfbmFtZRgHIAEoCRINCgVpbnB1dBgDIAEoDBIMCgRjb2xzGAQgASgFEgwKBHJvd3MYBSABKAUiLgoOVGVybWluYWxPdXRwdXQSDgoGb3V0cHV0GAEgASgMEgwKBGV4aXQYAiABKAgiVgoKVm9sdW1lSW5mbxIMCgRuYW1lGAEgASgJEhMKC21vdW50X3BvaW50GAIgASgJEg4KBnNvdXJjZRgDIAEoCRIVCg1pc19wZXJzaXN0ZW50GAQgASgIIrcCChlMaXN0Q29udGFpbmVyRmlsZXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEgwKBHBhdGgYAyABKAkSGAoLdm9sdW1lX25hbWUYBCABKAlIAIgBARIUCgxsaXN0X3ZvbHVtZXMYBSABKAgSEwoGY3Vyc29yGAYgASgJSAGIAQESFgoJcGFnZV9zaXplGAcgASgFSAKIAQESGQoMY29udGFpbmVyX2lkGAggASgJSAOIAQESGQoMc2VydmljZV9uYW1lGAkgASgJSASIAQFCDgoMX3ZvbHVtZV9uYW1lQgkKB19jdXJzb3JCDAoKX3BhZ2Vfc2l6ZUIPCg1fY29udGFpbmVyX2lkQg8KDV9zZXJ2aWNlX25hbWUiggQKDUNvbnRhaW5lckZpbGUSDAoEbmFtZRgBIAEoCRIMCgRwYXRoGAIgASgJEhQKDGlzX2RpcmVjdG9yeRgDIAEoCBIMCgRzaXplGAQgASgDEhMKC3Blcm1pc3Npb25zGAUgASgJEhgKC3ZvbHVtZV9uYW1lGAYgASgJSACIAQESEgoFb3duZXIYByABKAlIAYgBARISCgVncm91cBgIIAEoCUgCiAEBEhcKCm1vZGVfb2N0YWwYCSABKA1IA4gBARIXCgppc19zeW1saW5rGAogASgISASIAQESGwoOc3ltbGlua190YXJnZXQYCyABKAlIBYgBARIWCgltaW1lX3R5cGUYDCABKAlIBogBARI2Cg1tb2RpZmllZF90aW1lGA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgHiAEBEjUKDGNyZWF0ZWRfdGltZRgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBICIgBAUIOCgxfdm9sdW1lX25hbWVCCAoGX293bmVyQggKBl9ncm91cEINCgtfbW9kZV9vY3RhbEINCgtfaXNfc3ltbGlua0IRCg9fc3ltbGlua190YXJnZXRCDAoKX21pbWVfdHlwZUIQCg5fbW9kaWZpZWRfdGltZUIPCg1fY3JlYXRlZF90aW1lIpMCChpMaXN0Q29udGFpbmVyRmlsZXNSZXNwb25zZRI6CgVmaWxlcxgBIAMoCzIrLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQ29udGFpbmVyRmlsZRIUCgxjdXJyZW50X3BhdGgYAiABKAkSOQoHdm9sdW1lcxgDIAMoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVm9sdW1lSW5mbxIRCglpc192b2x1bWUYBCABKAgSGQoRY29udGFpbmVyX3J1bm5pbmcYBSABKAgSEAoIaGFzX21vcmUYBiABKAgSGAoLbmV4dF9jdXJzb3IYByABKAlIAIgBAUIOCgxfbmV4dF9jdXJzb3Ii2QEKF0dldENvbnRhaW5lckZpbGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEgwKBHBhdGgYAyABKAkSGAoLdm9sdW1lX25hbWUYBCABKAlIAIgBARIZCgxjb250YWluZXJfaWQYBSABKAlIAYgBARIZCgxzZXJ2aWNlX25hbWUYBiABKAlIAogBAUIOCgxfdm9sdW1lX25hbWVCDwoNX2NvbnRhaW5lcl9pZEIPCg1fc2VydmljZV9uYW1lIsIBChhHZXRDb250YWluZXJGaWxlUmVzcG9uc2USDwoHY29udGVudBgBIAEoCRIQCghlbmNvZGluZxgCIAEoCRIMCgRzaXplGAMgASgDEhYKCXRydW5jYXRlZBgEIAEoCEgAiAEBEkIKCG1ldGFkYXRhGAUgASgLMisub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Db250YWluZXJGaWxlSAGIAQFCDAoKX3RydW5jYXRlZEILCglfbWV0YWRhdGEifQobVXBsb2FkQ29udGFpbmVyRmlsZXNSZXF1ZXN0EkwKCG1ldGFkYXRhGAEgASgLMjoub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5VcGxvYWRDb250YWluZXJGaWxlc01ldGFkYXRhEhAKCHRhcl9kYXRhGAIgASgMIqUCChxVcGxvYWRDb250YWluZXJGaWxlc01ldGFkYXRhEhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhgKEGRlc3RpbmF0aW9uX3BhdGgYAyABKAkSOQoFZmlsZXMYBCADKAsyKi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkZpbGVNZXRhZGF0YRIYCgt2b2x1bWVfbmFtZRgFIAEoCUgAiAEBEhkKDGNvbnRhaW5lcl9pZBgGIAEoCUgBiAEBEhkKDHNlcnZpY2VfbmFtZRgHIAEoCUgCiAEBQg4KDF92b2x1bWVfbmFtZUIPCg1fY29udGFpbmVyX2lkQg8KDV9zZXJ2aWNlX25hbWUiTgoMRmlsZU1ldGFkYXRhEgwKBG5hbWUYASABKAkSDAoEc2l6ZRgCIAEoAxIUCgxpc19kaXJlY3RvcnkYAyABKAgSDAoEcGF0aBgEIAEoCSJlChxVcGxvYWRDb250YWluZXJGaWxlc1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSEgoFZXJyb3IYAiABKAlIAIgBARIWCg5maWxlc191cGxvYWRlZBgDIAEoBUIICgZfZXJyb3Ii6QEKIENodW5rVXBsb2FkQ29udGFpbmVyRmlsZXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEj0KBnVwbG9hZBgDIAEoCzItLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLkNodW5rZWRVcGxvYWRQYXlsb2FkEhkKDGNvbnRhaW5lcl9pZBgEIAEoCUgAiAEBEhkKDHNlcnZpY2VfbmFtZRgFIAEoCUgBiAEBQg8KDV9jb250YWluZXJfaWRCDwoNX3NlcnZpY2VfbmFtZSJqCiFDaHVua1VwbG9hZENvbnRhaW5lckZpbGVzUmVzcG9uc2USRQoGcmVzdWx0GAEgASgLMjUub2JpZW50ZS5jbG91ZC5jb21tb24udjEuQ2h1bmtlZFVwbG9hZFJlc3BvbnNlUGF5bG9hZCKqAQodRGVsZXRlQ29udGFpbmVyRW50cmllc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSDQoFcGF0aHMYAyADKAkSGAoLdm9sdW1lX25hbWUYBCABKAlIAIgBARIRCglyZWN1cnNpdmUYBSABKAgSDQoFZm9yY2UYBiABKAhCDgoMX3ZvbHVtZV9uYW1lIjwKG0RlbGV0ZUNvbnRhaW5lckVudHJpZXNFcnJvchIMCgRwYXRoGAEgASgJEg8KB21lc3NhZ2UYAiABKAkikwEKHkRlbGV0ZUNvbnRhaW5lckVudHJpZXNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhUKDWRlbGV0ZWRfcGF0aHMYAiADKAkSSQoGZXJyb3JzGAMgAygLMjkub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZWxldGVDb250YWluZXJFbnRyaWVzRXJyb3IitAEKG1JlbmFtZUNvbnRhaW5lckVudHJ5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRITCgtzb3VyY2VfcGF0aBgDIAEoCRITCgt0YXJnZXRfcGF0aBgEIAEoCRIYCgt2b2x1bWVfbmFtZRgFIAEoCUgAiAEBEhEKCW92ZXJ3cml0ZRgGIAEoCEIOCgxfdm9sdW1lX25hbWUiegocUmVuYW1lQ29udGFpbmVyRW50cnlSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEj8KBWVudHJ5GAIgASgLMisub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Db250YWluZXJGaWxlSACIAQFCCAoGX2VudHJ5Iv4CChtDcmVhdGVDb250YWluZXJFbnRyeVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSEwoLcGFyZW50X3BhdGgYAyABKAkSDAoEbmFtZRgEIAEoCRI+CgR0eXBlGAUgASgOMjAub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Db250YWluZXJFbnRyeVR5cGUSFQoIdGVtcGxhdGUYBiABKAlIAIgBARIYCgt2b2x1bWVfbmFtZRgHIAEoCUgBiAEBEhcKCm1vZGVfb2N0YWwYCCABKA1IAogBARIZCgxjb250YWluZXJfaWQYCSABKAlIA4gBARIZCgxzZXJ2aWNlX25hbWUYCiABKAlIBIgBAUILCglfdGVtcGxhdGVCDgoMX3ZvbHVtZV9uYW1lQg0KC19tb2RlX29jdGFsQg8KDV9jb250YWluZXJfaWRCDwoNX3NlcnZpY2VfbmFtZSJaChxDcmVhdGVDb250YWluZXJFbnRyeVJlc3BvbnNlEjoKBWVudHJ5GAEgASgLMisub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Db250YWluZXJGaWxlIukBChlXcml0ZUNvbnRhaW5lckZpbGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEgwKBHBhdGgYAyABKAkSGAoLdm9sdW1lX25hbWUYBCABKAlIAIgBARIPCgdjb250ZW50GAUgASgJEhAKCGVuY29kaW5nGAYgASgJEhkKEWNyZWF0ZV9pZl9taXNzaW5nGAcgASgIEhcKCm1vZGVfb2N0YWwYCCABKA1IAYgBAUIOCgxfdm9sdW1lX25hbWVCDQoLX21vZGVfb2N0YWwilgEKGldyaXRlQ29udGFpbmVyRmlsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSPwoFZW50cnkYAiABKAsyKy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkNvbnRhaW5lckZpbGVIAIgBARISCgVlcnJvchgDIAEoCUgBiAEBQggKBl9lbnRyeUIICgZfZXJyb3Ii/AEKHEV4dHJhY3REZXBsb3ltZW50RmlsZVJlcXVlc3QSFQoNZGVwbG95bWVudF9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEAoIemlwX3BhdGgYAyABKAkSGAoQZGVzdGluYXRpb25fcGF0aBgEIAEoCRIYCgt2b2x1bWVfbmFtZRgFIAEoCUgAiAEBEhkKDGNvbnRhaW5lcl9pZBgGIAEoCUgBiAEBEhkKDHNlcnZpY2VfbmFtZRgHIAEoCUgCiAEBQg4KDF92b2x1bWVfbmFtZUIPCg1fY29udGFpbmVyX2lkQg8KDV9zZXJ2aWNlX25hbWUiZwodRXh0cmFjdERlcGxveW1lbnRGaWxlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBISCgVlcnJvchgCIAEoCUgAiAEBEhcKD2ZpbGVzX2V4dHJhY3RlZBgDIAEoBUIICgZfZXJyb3IiqAIKIkNyZWF0ZURlcGxveW1lbnRGaWxlQXJjaGl2ZVJlcXVlc3QSFQoNZGVwbG95bWVudF9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSUAoPYXJjaGl2ZV9yZXF1ZXN0GAMgASgLMjcub2JpZW50ZS5jbG91ZC5jb21tb24udjEuQ3JlYXRlU2VydmVyRmlsZUFyY2hpdmVSZXF1ZXN0EhgKC3ZvbHVtZV9uYW1lGAQgASgJSACIAQESGQoMY29udGFpbmVyX2lkGAUgASgJSAGIAQESGQoMc2VydmljZV9uYW1lGAYgASgJSAKIAQFCDgoMX3ZvbHVtZV9uYW1lQg8KDV9jb250YWluZXJfaWRCDwoNX3NlcnZpY2VfbmFtZSJ5CiNDcmVhdGVEZXBsb3ltZW50RmlsZUFyY2hpdmVSZXNwb25zZRJSChBhcmNoaXZlX3Jlc3BvbnNlGAEgASgLMjgub2JpZW50ZS5jbG91ZC5jb21tb24udjEuQ3JlYXRlU2VydmVyRmlsZUFyY2hpdmVSZXNwb25zZSLCAQoLUm91dGluZ1J1bGUSCgoCaWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIOCgZkb21haW4YAyABKAkSFAoMc2VydmljZV9uYW1lGAQgASgJEhMKC3BhdGhfcHJlZml4GAUgASgJEhMKC3RhcmdldF9wb3J0GAYgASgFEhAKCHByb3RvY29sGAcgASgJEhMKC3NzbF9lbmFibGVkGAggASgIEhkKEXNzbF9jZXJ0X3Jlc29sdmVyGAkgASgJIk4KHEdldERlcGxveW1lbnRSb3V0aW5nc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkiWQodR2V0RGVwbG95bWVudFJvdXRpbmdzUmVzcG9uc2USOAoFcnVsZXMYASADKAsyKS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlJvdXRpbmdSdWxlIosBCh9VcGRhdGVEZXBsb3ltZW50Um91dGluZ3NSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEjgKBXJ1bGVzGAMgAygLMikub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Sb3V0aW5nUnVsZSJcCiBVcGRhdGVEZXBsb3ltZW50Um91dGluZ3NSZXNwb25zZRI4CgVydWxlcxgBIAMoCzIpLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuUm91dGluZ1J1bGUiUgogR2V0RGVwbG95bWVudFNlcnZpY2VOYW1lc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkiOgohR2V0RGVwbG95bWVudFNlcnZpY2VOYW1lc1Jlc3BvbnNlEhUKDXNlcnZpY2VfbmFtZXMYASADKAkiYwohR2V0RG9tYWluVmVyaWZpY2F0aW9uVG9rZW5SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEg4KBmRvbWFpbhgDIAEoCSKGAQoiR2V0RG9tYWluVmVyaWZpY2F0aW9uVG9rZW5SZXNwb25zZRIOCgZkb21haW4YASABKAkSDQoFdG9rZW4YAiABKAkSFwoPdHh0X3JlY29yZF9uYW1lGAMgASgJEhgKEHR4dF9yZWNvcmRfdmFsdWUYBCABKAkSDgoGc3RhdHVzGAUgASgJIl4KHFZlcmlmeURvbWFpbk93bmVyc2hpcFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSDgoGZG9tYWluGAMgASgJInMKHVZlcmlmeURvbWFpbk93bmVyc2hpcFJlc3BvbnNlEg4KBmRvbWFpbhgBIAEoCRIQCgh2ZXJpZmllZBgCIAEoCBIOCgZzdGF0dXMYAyABKAkSFAoHbWVzc2FnZRgEIAEoCUgAiAEBQgoKCF9tZXNzYWdlIoADCgxDdXN0b21Eb21haW4SCgoCaWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIOCgZkb21haW4YAyABKAkSEAoIdmVyaWZpZWQYBCABKAgSDgoGc3RhdHVzGAUgASgJEh0KFWNoYWxsZW5nZV9yZWNvcmRfbmFtZRgGIAEoCRIeChZjaGFsbGVuZ2VfcmVjb3JkX3ZhbHVlGAcgASgJEhgKC3Rsc19jZXJ0X2lkGAggASgJSACIAQESPwoWY2VydGlmaWNhdGVfZXhwaXJlc19hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBARIXCgpsYXN0X2Vycm9yGAogASgJSAKIAQESLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCDgoMX3Rsc19jZXJ0X2lkQhkKF19jZXJ0aWZpY2F0ZV9leHBpcmVzX2F0Qg0KC19sYXN0X2Vycm9yIlsKGUNyZWF0ZUN1c3RvbURvbWFpblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSDgoGZG9tYWluGAMgASgJIl8KGkNyZWF0ZUN1c3RvbURvbWFpblJlc3BvbnNlEkEKDWN1c3RvbV9kb21haW4YASABKAsyKi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkN1c3RvbURvbWFpbiJbChlWZXJpZnlDdXN0b21Eb21haW5SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEg4KBmRvbWFpbhgDIAEoCSJfChpWZXJpZnlDdXN0b21Eb21haW5SZXNwb25zZRJBCg1jdXN0b21fZG9tYWluGAEgASgLMioub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5DdXN0b21Eb21haW4ihQIKIlVwZGF0ZURlcGxveW1lbnRIZWFsdGhDaGVja1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSDwoHZW5hYmxlZBgDIAEoCBIMCgRwYXRoGAQgASgJEhEKBHBvcnQYBSABKAVIAIgBARIYChBpbnRlcnZhbF9zZWNvbmRzGAYgASgFEhcKD3RpbWVvdXRfc2Vjb25kcxgHIAEoBRIPCgdyZXRyaWVzGAggASgFEhwKD2V4cGVjdGVkX3N0YXR1cxgJIAEoBUgBiAEBQgcKBV9wb3J0QhIKEF9leHBlY3RlZF9zdGF0dXMiYwojVXBkYXRlRGVwbG95bWVudEhlYWx0aENoZWNrUmVzcG9uc2USPAoKZGVwbG95bWVudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudCJJCgxBZmZpbml0eVJ1bGUSEQoJcnVsZV90eXBlGAEgASgJEhEKCWxhYmVsX2tleRgCIAEoCRITCgtsYWJlbF92YWx1ZRgDIAEoCSKOAQohU2V0RGVwbG95bWVudEFmZmluaXR5UnVsZXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEjkKBXJ1bGVzGAMgAygLMioub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5BZmZpbml0eVJ1bGUiXwoiU2V0RGVwbG95bWVudEFmZmluaXR5UnVsZXNSZXNwb25zZRI5CgVydWxlcxgBIAMoCzIqLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQWZmaW5pdHlSdWxlIlMKIUdldERlcGxveW1lbnRBZmZpbml0eVJ1bGVzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCSJfCiJHZXREZXBsb3ltZW50QWZmaW5pdHlSdWxlc1Jlc3BvbnNlEjkKBXJ1bGVzGAEgAygLMioub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5BZmZpbml0eVJ1bGUiiQIKDVNjYWxlU2NoZWR1bGUSCgoCaWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIXCg9jcm9uX2V4cHJlc3Npb24YAyABKAkSFQoNcmVwbGljYV9jb3VudBgEIAEoBRIvCgtuZXh0X3J1bl9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNAoLbGFzdF9ydW5fYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCDgoMX2xhc3RfcnVuX2F0InwKGkNyZWF0ZVNjYWxlU2NoZWR1bGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhcKD2Nyb25fZXhwcmVzc2lvbhgDIAEoCRIVCg1yZXBsaWNhX2NvdW50GAQgASgFIlwKG0NyZWF0ZVNjYWxlU2NoZWR1bGVSZXNwb25zZRI9CghzY2hlZHVsZRgBIAEoCzIrLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU2NhbGVTY2hlZHVsZSJhChpEZWxldGVTY2FsZVNjaGVkdWxlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRITCgtzY2hlZHVsZV9pZBgDIAEoCSIuChtEZWxldGVTY2FsZVNjaGVkdWxlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJLChlMaXN0U2NhbGVTY2hlZHVsZXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJIlwKGkxpc3RTY2FsZVNjaGVkdWxlc1Jlc3BvbnNlEj4KCXNjaGVkdWxlcxgBIAMoCzIrLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU2NhbGVTY2hlZHVsZSLFAgoPQXV0b1NjYWxlUG9saWN5EhUKDWRlcGxveW1lbnRfaWQYASABKAkSFAoMbWluX3JlcGxpY2FzGAIgASgFEhQKDG1heF9yZXBsaWNhcxgDIAEoBRIaChJ0YXJnZXRfY3B1X3BlcmNlbnQYBCABKAESIQoZc2NhbGVfdXBfY29vbGRvd25fc2Vjb25kcxgFIAEoBRIjChtzY2FsZV9kb3duX2Nvb2xkb3duX3NlY29uZHMYBiABKAUSDwoHZW5hYmxlZBgHIAEoCBI3Cg5sYXN0X3NjYWxlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARIuCgp1cGRhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIRCg9fbGFzdF9zY2FsZWRfYXQi2wEKGVNldEF1dG9TY2FsZVBvbGljeVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSFAoMbWluX3JlcGxpY2FzGAMgASgFEhQKDG1heF9yZXBsaWNhcxgEIAEoBRIaChJ0YXJnZXRfY3B1X3BlcmNlbnQYBSABKAESIQoZc2NhbGVfdXBfY29vbGRvd25fc2Vjb25kcxgGIAEoBRIjChtzY2FsZV9kb3duX2Nvb2xkb3duX3NlY29uZHMYByABKAUiWwoaU2V0QXV0b1NjYWxlUG9saWN5UmVzcG9uc2USPQoGcG9saWN5GAEgASgLMi0ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5BdXRvU2NhbGVQb2xpY3kiSwoZR2V0QXV0b1NjYWxlUG9saWN5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCSJrChpHZXRBdXRvU2NhbGVQb2xpY3lSZXNwb25zZRJCCgZwb2xpY3kYASABKAsyLS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkF1dG9TY2FsZVBvbGljeUgAiAEBQgkKB19wb2xpY3kiSQoXRGlzYWJsZUF1dG9TY2FsZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkiKwoYRGlzYWJsZUF1dG9TY2FsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgi6QEKFkRlcGxveW1lbnRSZWdpb25TdGF0dXMSDgoGcmVnaW9uGAEgASgJEg8KB3ByaW1hcnkYAiABKAgSDgoGc3RhdHVzGAMgASgJEhUKDWhlYWx0aF9zdGF0dXMYBCABKAkSFAoMY29udGFpbmVyX2lkGAUgASgJEhIKCnRyYWVmaWtfaXAYBiABKAkSDwoHbm9kZV9pZBgHIAEoCRISCgVlcnJvchgIIAEoCUgAiAEBEi4KCnVwZGF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQggKBl9lcnJvciJSCiBHZXREZXBsb3ltZW50UmVnaW9uU3RhdHVzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCSJqCiFHZXREZXBsb3ltZW50UmVnaW9uU3RhdHVzUmVzcG9uc2USRQoHcmVnaW9ucxgBIAMoCzI0Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudFJlZ2lvblN0YXR1cyL5AgobR2V0RGVwbG95bWVudE1ldHJpY3NSZXF1ZXN0EhUKDWRlcGxveW1lbnRfaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEjMKCnN0YXJ0X3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESMQoIZW5kX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESGAoLbGF0ZXN0X29ubHkYBSABKAhIAogBARIZCgxjb250YWluZXJfaWQYBiABKAlIA4gBARIZCgxzZXJ2aWNlX25hbWUYByABKAlIBIgBARIWCglhZ2dyZWdhdGUYCCABKAhIBYgBAUINCgtfc3RhcnRfdGltZUILCglfZW5kX3RpbWVCDgoMX2xhdGVzdF9vbmx5Qg8KDV9jb250YWluZXJfaWRCDwoNX3NlcnZpY2VfbmFtZUIMCgpfYWdncmVnYXRlIl8KHEdldERlcGxveW1lbnRNZXRyaWNzUmVzcG9uc2USPwoHbWV0cmljcxgBIAMoCzIuLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudE1ldHJpYyKCAgoeU3RyZWFtRGVwbG95bWVudE1ldHJpY3NSZXF1ZXN0EhUKDWRlcGxveW1lbnRfaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEh0KEGludGVydmFsX3NlY29uZHMYAyABKAVIAIgBARIZCgxjb250YWluZXJfaWQYBCABKAlIAYgBARIZCgxzZXJ2aWNlX25hbWUYBSABKAlIAogBARIWCglhZ2dyZWdhdGUYBiABKAhIA4gBAUITChFfaW50ZXJ2YWxfc2Vjb25kc0IPCg1fY29udGFpbmVyX2lkQg8KDV9zZXJ2aWNlX25hbWVCDAoKX2FnZ3JlZ2F0ZSKmAwoQRGVwbG95bWVudE1ldHJpYxIVCg1kZXBsb3ltZW50X2lkGAEgASgJEi0KCXRpbWVzdGFtcBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGQoRY3B1X3VzYWdlX3BlcmNlbnQYAyABKAESGgoSbWVtb3J5X3VzYWdlX2J5dGVzGAQgASgDEhgKEG5ldHdvcmtfcnhfYnl0ZXMYBSABKAMSGAoQbmV0d29ya190eF9ieXRlcxgGIAEoAxIXCg9kaXNrX3JlYWRfYnl0ZXMYByABKAMSGAoQZGlza193cml0ZV9ieXRlcxgIIAEoAxIaCg1yZXF1ZXN0X2NvdW50GAkgASgDSACIAQESGAoLZXJyb3JfY291bnQYCiABKANIAYgBARIZCgxjb250YWluZXJfaWQYCyABKAlIAogBARIZCgxzZXJ2aWNlX25hbWUYDCABKAlIA4gBAUIQCg5fcmVxdWVzdF9jb3VudEIOCgxfZXJyb3JfY291bnRCDwoNX2NvbnRhaW5lcl9pZEIPCg1fc2VydmljZV9uYW1lImkKGUdldERlcGxveW1lbnRVc2FnZVJlcXVlc3QSFQoNZGVwbG95bWVudF9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEgoFbW9udGgYAyABKAlIAIgBAUIICgZfbW9udGgi8wEKGkdldERlcGxveW1lbnRVc2FnZVJlc3BvbnNlEhUKDWRlcGxveW1lbnRfaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEg0KBW1vbnRoGAMgASgJEkUKB2N1cnJlbnQYBCABKAsyNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnRVc2FnZU1ldHJpY3MSTwoRZXN0aW1hdGVkX21vbnRobHkYBSABKAsyNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnRVc2FnZU1ldHJpY3Mi2gMKFkRlcGxveW1lbnRVc2FnZU1ldHJpY3MSGAoQY3B1X2NvcmVfc2Vjb25kcxgBIAEoAxIbChNtZW1vcnlfYnl0ZV9zZWNvbmRzGAIgASgDEhoKEmJhbmR3aWR0aF9yeF9ieXRlcxgDIAEoAxIaChJiYW5kd2lkdGhfdHhfYnl0ZXMYBCABKAMSFQoNc3RvcmFnZV9ieXRlcxgFIAEoAxIVCg1yZXF1ZXN0X2NvdW50GAYgASgDEhMKC2Vycm9yX2NvdW50GAcgASgDEhYKDnVwdGltZV9zZWNvbmRzGAggASgDEhwKFGVzdGltYXRlZF9jb3N0X2NlbnRzGAkgASgDEhsKDmNwdV9jb3N0X2NlbnRzGAogASgDSACIAQESHgoRbWVtb3J5X2Nvc3RfY2VudHMYCyABKANIAYgBARIhChRiYW5kd2lkdGhfY29zdF9jZW50cxgMIAEoA0gCiAEBEh8KEnN0b3JhZ2VfY29zdF9jZW50cxgNIAEoA0gDiAEBQhEKD19jcHVfY29zdF9jZW50c0IUChJfbWVtb3J5X2Nvc3RfY2VudHNCFwoVX2JhbmR3aWR0aF9jb3N0X2NlbnRzQhUKE19zdG9yYWdlX2Nvc3RfY2VudHMishQKCkRlcGxveW1lbnQSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIOCgZkb21haW4YAyABKAkSFgoOY3VzdG9tX2RvbWFpbnMYBCADKAkSOgoEdHlwZRgFIAEoDjIsLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudFR5cGUSQwoOYnVpbGRfc3RyYXRlZ3kYGiABKA4yKy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkJ1aWxkU3RyYXRlZ3kSGwoOcmVwb3NpdG9yeV91cmwYBiABKAlIAIgBARIOCgZicmFuY2gYByABKAkSGgoNYnVpbGRfY29tbWFuZBgIIAEoCUgBiAEBEhwKD2luc3RhbGxfY29tbWFuZBgJIAEoCUgCiAEBEhoKDXN0YXJ0X2NvbW1hbmQYHSABKAlIA4gBARIcCg9kb2NrZXJmaWxlX3BhdGgYGyABKAlIBIgBARIeChFjb21wb3NlX2ZpbGVfcGF0aBgcIAEoCUgFiAEBEhcKCmJ1aWxkX3BhdGgYIiABKAlIBogBARIeChFidWlsZF9vdXRwdXRfcGF0aBgjIAEoCUgHiAEBEhYKCXVzZV9uZ2lueBgkIAEoCEgIiAEBEhkKDG5naW54X2NvbmZpZxglIAEoCUgJiAEBEj4KBnN0YXR1cxgKIAEoDjIuLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudFN0YXR1cxIVCg1oZWFsdGhfc3RhdHVzGAsgASgJEjQKEGxhc3RfZGVwbG95ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhcKD2JhbmR3aWR0aF91c2FnZRgNIAEoAxIVCg1zdG9yYWdlX3VzYWdlGA4gASgDEi4KCmNyZWF0ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCmJ1aWxkX3RpbWUYECABKAUSDAoEc2l6ZRgRIAEoCRI+CgtlbnZpcm9ubWVudBgSIAEoDjIpLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRW52aXJvbm1lbnQSDgoGZ3JvdXBzGCAgAygJEhIKBWltYWdlGBMgASgJSAqIAQESEQoEcG9ydBgUIAEoBUgLiAEBEhUKCHJlcGxpY2FzGBUgASgFSAyIAQESFQoNY29udGFpbmVyX2lkcxgWIAMoCRIUCgdub2RlX2lkGBcgASgJSA2IAQESGgoNbm9kZV9ob3N0bmFtZRgYIAEoCUgOiAEBEkcKCGVudl92YXJzGBkgAygLMjUub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50LkVudlZhcnNFbnRyeRIiChVnaXRodWJfaW50ZWdyYXRpb25faWQYISABKAlID4gBARIfChJjb250YWluZXJzX3J1bm5pbmcYHiABKAVIEIgBARIdChBjb250YWluZXJzX3RvdGFsGB8gASgFSBGIAQESFgoJY3B1X2xpbWl0GCYgASgBSBKIAQESGQoMbWVtb3J5X2xpbWl0GCcgASgDSBOIAQESTAoQaGVhbHRoY2hlY2tfdHlwZRgoIAEoDjItLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuSGVhbHRoQ2hlY2tUeXBlSBSIAQESHQoQaGVhbHRoY2hlY2tfcG9ydBgpIAEoBUgViAEBEh0KEGhlYWx0aGNoZWNrX3BhdGgYKiABKAlIFogBARIoChtoZWFsdGhjaGVja19leHBlY3RlZF9zdGF0dXMYKyABKAVIF4gBARInChpoZWFsdGhjaGVja19jdXN0b21fY29tbWFuZBgsIAEoCUgYiAEBEhgKC2F1dG9fZGVwbG95GC0gASgISBmIAQESSwoKYnVpbGRfYXJncxguIAMoCzI3Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudC5CdWlsZEFyZ3NFbnRyeRJKChJkb2NrZXJmaWxlX3ZvbHVtZXMYLyADKAsyLi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRvY2tlcmZpbGVWb2x1bWUSWwoYZG9ja2VyZmlsZV9idWlsZF9vcHRpb25zGDAgASgLMjQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Eb2NrZXJmaWxlQnVpbGRPcHRpb25zSBqIAQESFAoMYWN0aXZlX2NvbG9yGDEgASgJEhIKCmlzX3ByZXZpZXcYMiABKAgSKQocaGVhbHRoY2hlY2tfaW50ZXJ2YWxfc2Vjb25kcxgzIAEoBUgbiAEBEigKG2hlYWx0aGNoZWNrX3RpbWVvdXRfc2Vjb25kcxg0IAEoBUgciAEBEiAKE2hlYWx0aGNoZWNrX3JldHJpZXMYNSABKAVIHYgBARIcChR6ZXJvX2Rvd250aW1lX2RlcGxveRg2IAEoCBouCgxFbnZWYXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARowCg5CdWlsZEFyZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQhEKD19yZXBvc2l0b3J5X3VybEIQCg5fYnVpbGRfY29tbWFuZEISChBfaW5zdGFsbF9jb21tYW5kQhAKDl9zdGFydF9jb21tYW5kQhIKEF9kb2NrZXJmaWxlX3BhdGhCFAoSX2NvbXBvc2VfZmlsZV9wYXRoQg0KC19idWlsZF9wYXRoQhQKEl9idWlsZF9vdXRwdXRfcGF0aEIMCgpfdXNlX25naW54Qg8KDV9uZ2lueF9jb25maWdCCAoGX2ltYWdlQgcKBV9wb3J0QgsKCV9yZXBsaWNhc0IKCghfbm9kZV9pZEIQCg5fbm9kZV9ob3N0bmFtZUIYChZfZ2l0aHViX2ludGVncmF0aW9uX2lkQhUKE19jb250YWluZXJzX3J1bm5pbmdCEwoRX2NvbnRhaW5lcnNfdG90YWxCDAoKX2NwdV9saW1pdEIPCg1fbWVtb3J5X2xpbWl0QhMKEV9oZWFsdGhjaGVja190eXBlQhMKEV9oZWFsdGhjaGVja19wb3J0QhMKEV9oZWFsdGhjaGVja19wYXRoQh4KHF9oZWFsdGhjaGVja19leHBlY3RlZF9zdGF0dXNCHQobX2hlYWx0aGNoZWNrX2N1c3RvbV9jb21tYW5kQg4KDF9hdXRvX2RlcGxveUIbChlfZG9ja2VyZmlsZV9idWlsZF9vcHRpb25zQh8KHV9oZWFsdGhjaGVja19pbnRlcnZhbF9zZWNvbmRzQh4KHF9oZWFsdGhjaGVja190aW1lb3V0X3NlY29uZHNCFgoUX2hlYWx0aGNoZWNrX3JldHJpZXMiRwoQRG9ja2VyZmlsZVZvbHVtZRIMCgRuYW1lGAEgASgJEhIKCm1vdW50X3BhdGgYAiABKAkSEQoJcmVhZF9vbmx5GAMgASgIIp0CChZEb2NrZXJmaWxlQnVpbGRPcHRpb25zEhMKBnRhcmdldBgBIAEoCUgAiAEBEhUKCHBsYXRmb3JtGAIgASgJSAGIAQESFQoIbm9fY2FjaGUYAyABKAhIAogBARIRCgRwdWxsGAQgASgISAOIAQESUAoGbGFiZWxzGAUgAygLMkAub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Eb2NrZXJmaWxlQnVpbGRPcHRpb25zLkxhYmVsc0VudHJ5Gi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCCQoHX3RhcmdldEILCglfcGxhdGZvcm1CCwoJX25vX2NhY2hlQgcKBV9wdWxsIlEKH0xpc3REZXBsb3ltZW50Q29udGFpbmVyc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkiaQogTGlzdERlcGxveW1lbnRDb250YWluZXJzUmVzcG9uc2USRQoKY29udGFpbmVycxgBIAMoCzIxLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudENvbnRhaW5lciKzAgoTRGVwbG95bWVudENvbnRhaW5lchIUCgxjb250YWluZXJfaWQYASABKAkSGQoMc2VydmljZV9uYW1lGAIgASgJSACIAQESDgoGc3RhdHVzGAMgASgJEhQKB25vZGVfaWQYBCABKAlIAYgBARIaCg1ub2RlX2hvc3RuYW1lGAUgASgJSAKIAQESEQoEcG9ydBgGIAEoBUgDiAEBEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQg8KDV9zZXJ2aWNlX25hbWVCCgoIX25vZGVfaWRCEAoOX25vZGVfaG9zdG5hbWVCBwoFX3BvcnQicAoaU3RyZWFtQ29udGFpbmVyTG9nc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSFAoMY29udGFpbmVyX2lkGAMgASgJEgwKBHRhaWwYBCABKAUiXQoVU3RhcnRDb250YWluZXJSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhQKDGNvbnRhaW5lcl9pZBgDIAEoCSJHChZTdGFydENvbnRhaW5lclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSEgoFZXJyb3IYAiABKAlIAIgBAUIICgZfZXJyb3IiXAoUU3RvcENvbnRhaW5lclJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSFAoMY29udGFpbmVyX2lkGAMgASgJIkYKFVN0b3BDb250YWluZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhIKBWVycm9yGAIgASgJSACIAQFCCAoGX2Vycm9yIl8KF1Jlc3RhcnRDb250YWluZXJSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhQKDGNvbnRhaW5lcl9pZBgDIAEoCSJJChhSZXN0YXJ0Q29udGFpbmVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBISCgVlcnJvchgCIAEoCUgAiAEBQggKBl9lcnJvciKBAQoRTGlzdEJ1aWxkc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSEgoFbGltaXQYAyABKAVIAIgBARITCgZvZmZzZXQYBCABKAVIAYgBAUIICgZfbGltaXRCCQoHX29mZnNldCJYChJMaXN0QnVpbGRzUmVzcG9uc2USMwoGYnVpbGRzGAEgAygLMiMub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5CdWlsZBINCgV0b3RhbBgCIAEoBSJTCg9HZXRCdWlsZFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSEAoIYnVpbGRfaWQYAyABKAkiRgoQR2V0QnVpbGRSZXNwb25zZRIyCgVidWlsZBgBIAEoCzIjLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQnVpbGQilQEKE0dldEJ1aWxkTG9nc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSEAoIYnVpbGRfaWQYAyABKAkSEgoFbGltaXQYBCABKAVIAIgBARITCgZvZmZzZXQYBSABKAVIAYgBAUIICgZfbGltaXRCCQoHX29mZnNldCJkChRHZXRCdWlsZExvZ3NSZXNwb25zZRI9CgRsb2dzGAEgAygLMi8ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50TG9nTGluZRINCgV0b3RhbBgCIAEoBSJYChRSZXZlcnRUb0J1aWxkUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIQCghidWlsZF9pZBgDIAEoCSJrChVSZXZlcnRUb0J1aWxkUmVzcG9uc2USPAoKZGVwbG95bWVudBgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudBIUCgxuZXdfYnVpbGRfaWQYAiABKAkiVgoSRGVsZXRlQnVpbGRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCg1kZXBsb3ltZW50X2lkGAIgASgJEhAKCGJ1aWxkX2lkGAMgASgJIiYKE0RlbGV0ZUJ1aWxkUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCKTCAoFQnVpbGQSCgoCaWQYASABKAkSFQoNZGVwbG95bWVudF9pZBgCIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAyABKAkSFAoMYnVpbGRfbnVtYmVyGAQgASgFEjkKBnN0YXR1cxgFIAEoDjIpLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQnVpbGRTdGF0dXMSLgoKc3RhcnRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoMY29tcGxldGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEhIKCmJ1aWxkX3RpbWUYCCABKAUSFAoMdHJpZ2dlcmVkX2J5GAkgASgJEhsKDnJlcG9zaXRvcnlfdXJsGAogASgJSAGIAQESDgoGYnJhbmNoGAsgASgJEhcKCmNvbW1pdF9zaGEYDCABKAlIAogBARIaCg1idWlsZF9jb21tYW5kGA0gASgJSAOIAQESHAoPaW5zdGFsbF9jb21tYW5kGA4gASgJSASIAQESGgoNc3RhcnRfY29tbWFuZBgPIAEoCUgFiAEBEhwKD2RvY2tlcmZpbGVfcGF0aBgQIAEoCUgGiAEBEh4KEWNvbXBvc2VfZmlsZV9wYXRoGBEgASgJSAeIAQESQwoOYnVpbGRfc3RyYXRlZ3kYEiABKA4yKy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkJ1aWxkU3RyYXRlZ3kSFwoKaW1hZ2VfbmFtZRgTIAEoCUgIiAEBEhkKDGNvbXBvc2VfeWFtbBgUIAEoCUgJiAEBEhEKBHNpemUYFSABKAlICogBARISCgVlcnJvchgWIAEoCUgLiAEBEi4KCmNyZWF0ZWRfYXQYFyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYGCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEkoKDWxpbnRfd2FybmluZ3MYGSADKAsyMy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRvY2tlcmZpbGVMaW50V2FybmluZ0IPCg1fY29tcGxldGVkX2F0QhEKD19yZXBvc2l0b3J5X3VybEINCgtfY29tbWl0X3NoYUIQCg5fYnVpbGRfY29tbWFuZEISChBfaW5zdGFsbF9jb21tYW5kQhAKDl9zdGFydF9jb21tYW5kQhIKEF9kb2NrZXJmaWxlX3BhdGhCFAoSX2NvbXBvc2VfZmlsZV9wYXRoQg0KC19pbWFnZV9uYW1lQg8KDV9jb21wb3NlX3lhbWxCBwoFX3NpemVCCAoGX2Vycm9yIkQKFURvY2tlcmZpbGVMaW50V2FybmluZxIMCgRydWxlGAEgASgJEgwKBGxpbmUYAiABKAUSDwoHbWVzc2FnZRgDIAEoCSqbAQoORGVwbG95bWVudFR5cGUSHwobREVQTE9ZTUVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASCgoGRE9DS0VSEAESCgoGU1RBVElDEAISCAoETk9ERRADEgYKAkdPEAQSCgoGUFlUSE9OEAUSCAoEUlVCWRAGEggKBFJVU1QQBxIICgRKQVZBEAgSBwoDUEhQEAkSCwoHR0VORVJJQxAKKpEBCg1CdWlsZFN0cmF0ZWd5Eh4KGkJVSUxEX1NUUkFURUdZX1VOU1BFQ0lGSUVEEAASDAoIUkFJTFBBQ0sQARIMCghOSVhQQUNLUxACEg4KCkRPQ0tFUkZJTEUQAxIRCg1QTEFJTl9DT01QT1NFEAQSEAoMQ09NUE9TRV9SRVBPEAYSDwoLU1RBVElDX1NJVEUQBSpYCgtFbnZpcm9ubWVudBIbChdFTlZJUk9OTUVOVF9VTlNQRUNJRklFRBAAEg4KClBST0RVQ1RJT04QARILCgdTVEFHSU5HEAISDwoLREVWRUxPUE1FTlQQAyqFAQoQRGVwbG95bWVudFN0YXR1cxIhCh1ERVBMT1lNRU5UX1NUQVRVU19VTlNQRUNJRklFRBAAEgsKB0NSRUFURUQQARIMCghCVUlMRElORxACEgsKB1JVTk5JTkcQAxILCgdTVE9QUEVEEAQSCgoGRkFJTEVEEAUSDQoJREVQTE9ZSU5HEAYqdwoLQnVpbGRTdGF0dXMSHAoYQlVJTERfU1RBVFVTX1VOU1BFQ0lGSUVEEAASEQoNQlVJTERfUEVORElORxABEhIKDkJVSUxEX0JVSUxESU5HEAISEQoNQlVJTERfU1VDQ0VTUxADEhAKDEJVSUxEX0ZBSUxFRBAEKpABCg9IZWFsdGhDaGVja1R5cGUSIAocSEVBTFRIQ0hFQ0tfVFlQRV9VTlNQRUNJRklFRBAAEhgKFEhFQUxUSENIRUNLX0RJU0FCTEVEEAESEwoPSEVBTFRIQ0hFQ0tfVENQEAISFAoQSEVBTFRIQ0hFQ0tfSFRUUBADEhYKEkhFQUxUSENIRUNLX0NVU1RPTRAEKp8BChJDb250YWluZXJFbnRyeVR5cGUSJAogQ09OVEFJTkVSX0VOVFJZX1RZUEVfVU5TUEVDSUZJRUQQABIdChlDT05UQUlORVJfRU5UUllfVFlQRV9GSUxFEAESIgoeQ09OVEFJTkVSX0VOVFJZX1RZUEVfRElSRUNUT1JZEAISIAocQ09OVEFJTkVSX0VOVFJZX1RZUEVfU1lNTElOSxADMptOChFEZXBsb3ltZW50U2VydmljZRJ+Cg9MaXN0RGVwbG95bWVudHMSNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkxpc3REZXBsb3ltZW50c1JlcXVlc3QaNS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkxpc3REZXBsb3ltZW50c1Jlc3BvbnNlEoEBChBDcmVhdGVEZXBsb3ltZW50EjUub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5DcmVhdGVEZXBsb3ltZW50UmVxdWVzdBo2Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQ3JlYXRlRGVwbG95bWVudFJlc3BvbnNlEngKDUdldERlcGxveW1lbnQSMi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRSZXF1ZXN0GjMub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXREZXBsb3ltZW50UmVzcG9uc2USgQEKEFVwZGF0ZURlcGxveW1lbnQSNS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlVwZGF0ZURlcGxveW1lbnRSZXF1ZXN0GjYub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5VcGRhdGVEZXBsb3ltZW50UmVzcG9uc2UShAEKEVRyaWdnZXJEZXBsb3ltZW50EjYub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5UcmlnZ2VyRGVwbG95bWVudFJlcXVlc3QaNy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlRyaWdnZXJEZXBsb3ltZW50UmVzcG9uc2USjQEKFlN0cmVhbURlcGxveW1lbnRTdGF0dXMSOy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlN0cmVhbURlcGxveW1lbnRTdGF0dXNSZXF1ZXN0GjQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50U3RhdHVzVXBkYXRlMAEShAEKEUdldERlcGxveW1lbnRMb2dzEjYub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXREZXBsb3ltZW50TG9nc1JlcXVlc3QaNy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRMb2dzUmVzcG9uc2UShAEKFFN0cmVhbURlcGxveW1lbnRMb2dzEjkub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TdHJlYW1EZXBsb3ltZW50TG9nc1JlcXVlc3QaLy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnRMb2dMaW5lMAESegoPU3RyZWFtQnVpbGRMb2dzEjQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TdHJlYW1CdWlsZExvZ3NSZXF1ZXN0Gi8ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50TG9nTGluZTABEo0BChRHZXREZXBsb3ltZW50TWV0cmljcxI5Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudE1ldHJpY3NSZXF1ZXN0Gjoub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXREZXBsb3ltZW50TWV0cmljc1Jlc3BvbnNlEokBChdTdHJlYW1EZXBsb3ltZW50TWV0cmljcxI8Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU3RyZWFtRGVwbG95bWVudE1ldHJpY3NSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZXBsb3ltZW50TWV0cmljMAEShwEKEkdldERlcGxveW1lbnRVc2FnZRI3Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudFVzYWdlUmVxdWVzdBo4Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudFVzYWdlUmVzcG9uc2USfgoPU3RhcnREZXBsb3ltZW50EjQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TdGFydERlcGxveW1lbnRSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TdGFydERlcGxveW1lbnRSZXNwb25zZRJ7Cg5TdG9wRGVwbG95bWVudBIzLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU3RvcERlcGxveW1lbnRSZXF1ZXN0GjQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TdG9wRGVwbG95bWVudFJlc3BvbnNlEoEBChBEZWxldGVEZXBsb3ltZW50EjUub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZWxldGVEZXBsb3ltZW50UmVxdWVzdBo2Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVsZXRlRGVwbG95bWVudFJlc3BvbnNlEoQBChFSZXN0YXJ0RGVwbG95bWVudBI2Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuUmVzdGFydERlcGxveW1lbnRSZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5SZXN0YXJ0RGVwbG95bWVudFJlc3BvbnNlEocBChJSb2xsYmFja0RlcGxveW1lbnQSNy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlJvbGxiYWNrRGVwbG95bWVudFJlcXVlc3QaOC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlJvbGxiYWNrRGVwbG95bWVudFJlc3BvbnNlEpMBChZMaXN0RGVwbG95bWVudFZlcnNpb25zEjsub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5MaXN0RGVwbG95bWVudFZlcnNpb25zUmVxdWVzdBo8Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuTGlzdERlcGxveW1lbnRWZXJzaW9uc1Jlc3BvbnNlEn4KD1NjYWxlRGVwbG95bWVudBI0Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU2NhbGVEZXBsb3ltZW50UmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU2NhbGVEZXBsb3ltZW50UmVzcG9uc2UShAEKEVNldFJlc291cmNlTGltaXRzEjYub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TZXRSZXNvdXJjZUxpbWl0c1JlcXVlc3QaNy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlNldFJlc291cmNlTGltaXRzUmVzcG9uc2USjQEKFEdldERlcGxveW1lbnRFbnZWYXJzEjkub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXREZXBsb3ltZW50RW52VmFyc1JlcXVlc3QaOi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRFbnZWYXJzUmVzcG9uc2USlgEKF1VwZGF0ZURlcGxveW1lbnRFbnZWYXJzEjwub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5VcGRhdGVEZXBsb3ltZW50RW52VmFyc1JlcXVlc3QaPS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlVwZGF0ZURlcGxveW1lbnRFbnZWYXJzUmVzcG9uc2USdQoMUm90YXRlRW52S2V5EjEub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Sb3RhdGVFbnZLZXlSZXF1ZXN0GjIub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Sb3RhdGVFbnZLZXlSZXNwb25zZRKNAQoUR2V0RGVwbG95bWVudENvbXBvc2USOS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRDb21wb3NlUmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudENvbXBvc2VSZXNwb25zZRKcAQoZVmFsaWRhdGVEZXBsb3ltZW50Q29tcG9zZRI+Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVmFsaWRhdGVEZXBsb3ltZW50Q29tcG9zZVJlcXVlc3QaPy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlZhbGlkYXRlRGVwbG95bWVudENvbXBvc2VSZXNwb25zZRKWAQoXVXBkYXRlRGVwbG95bWVudENvbXBvc2USPC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlVwZGF0ZURlcGxveW1lbnRDb21wb3NlUmVxdWVzdBo9Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVXBkYXRlRGVwbG95bWVudENvbXBvc2VSZXNwb25zZRJ+Cg9MaXN0R2l0SHViUmVwb3MSNC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkxpc3RHaXRIdWJSZXBvc1JlcXVlc3QaNS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkxpc3RHaXRIdWJSZXBvc1Jlc3BvbnNlEoQBChFHZXRHaXRIdWJCcmFuY2hlcxI2Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0R2l0SHViQnJhbmNoZXNSZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXRHaXRIdWJCcmFuY2hlc1Jlc3BvbnNlEngKDUdldEdpdEh1YkZpbGUSMi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldEdpdEh1YkZpbGVSZXF1ZXN0GjMub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXRHaXRIdWJGaWxlUmVzcG9uc2USbwoKTGlzdEJ1aWxkcxIvLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuTGlzdEJ1aWxkc1JlcXVlc3QaMC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkxpc3RCdWlsZHNSZXNwb25zZRJpCghHZXRCdWlsZBItLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0QnVpbGRSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXRCdWlsZFJlc3BvbnNlEnUKDEdldEJ1aWxkTG9ncxIxLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0QnVpbGRMb2dzUmVxdWVzdBoyLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0QnVpbGRMb2dzUmVzcG9uc2USeAoNUmV2ZXJ0VG9CdWlsZBIyLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuUmV2ZXJ0VG9CdWlsZFJlcXVlc3QaMy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlJldmVydFRvQnVpbGRSZXNwb25zZRJyCgtEZWxldGVCdWlsZBIwLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVsZXRlQnVpbGRSZXF1ZXN0GjEub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5EZWxldGVCdWlsZFJlc3BvbnNlEq4BCh9MaXN0QXZhaWxhYmxlR2l0SHViSW50ZWdyYXRpb25zEkQub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5MaXN0QXZhaWxhYmxlR2l0SHViSW50ZWdyYXRpb25zUmVxdWVzdBpFLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuTGlzdEF2YWlsYWJsZUdpdEh1YkludGVncmF0aW9uc1Jlc3BvbnNlEn4KD1JvdGF0ZURlcGxveUtleRI0Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuUm90YXRlRGVwbG95S2V5UmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuUm90YXRlRGVwbG95S2V5UmVzcG9uc2USigEKE1JvdGF0ZVdlYmhvb2tTZWNyZXQSOC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlJvdGF0ZVdlYmhvb2tTZWNyZXRSZXF1ZXN0Gjkub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Sb3RhdGVXZWJob29rU2VjcmV0UmVzcG9uc2USbwoOU3RyZWFtVGVybWluYWwSKy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlRlcm1pbmFsSW5wdXQaLC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlRlcm1pbmFsT3V0cHV0KAEwARKBAQoUU3RyZWFtVGVybWluYWxPdXRwdXQSOS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlN0cmVhbVRlcm1pbmFsT3V0cHV0UmVxdWVzdBosLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVGVybWluYWxPdXRwdXQwARKEAQoRU2VuZFRlcm1pbmFsSW5wdXQSNi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlNlbmRUZXJtaW5hbElucHV0UmVxdWVzdBo3Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU2VuZFRlcm1pbmFsSW5wdXRSZXNwb25zZRKHAQoSTGlzdENvbnRhaW5lckZpbGVzEjcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5MaXN0Q29udGFpbmVyRmlsZXNSZXF1ZXN0Gjgub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5MaXN0Q29udGFpbmVyRmlsZXNSZXNwb25zZRKBAQoQR2V0Q29udGFpbmVyRmlsZRI1Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0Q29udGFpbmVyRmlsZVJlcXVlc3QaNi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldENvbnRhaW5lckZpbGVSZXNwb25zZRKNAQoUVXBsb2FkQ29udGFpbmVyRmlsZXMSOS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlVwbG9hZENvbnRhaW5lckZpbGVzUmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuVXBsb2FkQ29udGFpbmVyRmlsZXNSZXNwb25zZRKcAQoZQ2h1bmtVcGxvYWRDb250YWluZXJGaWxlcxI+Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQ2h1bmtVcGxvYWRDb250YWluZXJGaWxlc1JlcXVlc3QaPy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkNodW5rVXBsb2FkQ29udGFpbmVyRmlsZXNSZXNwb25zZRKTAQoWRGVsZXRlQ29udGFpbmVyRW50cmllcxI7Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVsZXRlQ29udGFpbmVyRW50cmllc1JlcXVlc3QaPC5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlbGV0ZUNvbnRhaW5lckVudHJpZXNSZXNwb25zZRKNAQoUUmVuYW1lQ29udGFpbmVyRW50cnkSOS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlJlbmFtZUNvbnRhaW5lckVudHJ5UmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuUmVuYW1lQ29udGFpbmVyRW50cnlSZXNwb25zZRKNAQoUQ3JlYXRlQ29udGFpbmVyRW50cnkSOS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkNyZWF0ZUNvbnRhaW5lckVudHJ5UmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQ3JlYXRlQ29udGFpbmVyRW50cnlSZXNwb25zZRKHAQoSV3JpdGVDb250YWluZXJGaWxlEjcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Xcml0ZUNvbnRhaW5lckZpbGVSZXF1ZXN0Gjgub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5Xcml0ZUNvbnRhaW5lckZpbGVSZXNwb25zZRKQAQoVRXh0cmFjdERlcGxveW1lbnRGaWxlEjoub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5FeHRyYWN0RGVwbG95bWVudEZpbGVSZXF1ZXN0Gjsub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5FeHRyYWN0RGVwbG95bWVudEZpbGVSZXNwb25zZRKiAQobQ3JlYXRlRGVwbG95bWVudEZpbGVBcmNoaXZlEkAub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5DcmVhdGVEZXBsb3ltZW50RmlsZUFyY2hpdmVSZXF1ZXN0GkEub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5DcmVhdGVEZXBsb3ltZW50RmlsZUFyY2hpdmVSZXNwb25zZRKQAQoVR2V0RGVwbG95bWVudFJvdXRpbmdzEjoub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXREZXBsb3ltZW50Um91dGluZ3NSZXF1ZXN0Gjsub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXREZXBsb3ltZW50Um91dGluZ3NSZXNwb25zZRKZAQoYVXBkYXRlRGVwbG95bWVudFJvdXRpbmdzEj0ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5VcGRhdGVEZXBsb3ltZW50Um91dGluZ3NSZXF1ZXN0Gj4ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5VcGRhdGVEZXBsb3ltZW50Um91dGluZ3NSZXNwb25zZRKcAQoZR2V0RGVwbG95bWVudFNlcnZpY2VOYW1lcxI+Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudFNlcnZpY2VOYW1lc1JlcXVlc3QaPy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRTZXJ2aWNlTmFtZXNSZXNwb25zZRKfAQoaR2V0RG9tYWluVmVyaWZpY2F0aW9uVG9rZW4SPy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERvbWFpblZlcmlmaWNhdGlvblRva2VuUmVxdWVzdBpALm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RG9tYWluVmVyaWZpY2F0aW9uVG9rZW5SZXNwb25zZRKQAQoVVmVyaWZ5RG9tYWluT3duZXJzaGlwEjoub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5WZXJpZnlEb21haW5Pd25lcnNoaXBSZXF1ZXN0Gjsub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5WZXJpZnlEb21haW5Pd25lcnNoaXBSZXNwb25zZRKHAQoSQ3JlYXRlQ3VzdG9tRG9tYWluEjcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5DcmVhdGVDdXN0b21Eb21haW5SZXF1ZXN0Gjgub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5DcmVhdGVDdXN0b21Eb21haW5SZXNwb25zZRKHAQoSVmVyaWZ5Q3VzdG9tRG9tYWluEjcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5WZXJpZnlDdXN0b21Eb21haW5SZXF1ZXN0Gjgub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5WZXJpZnlDdXN0b21Eb21haW5SZXNwb25zZRKiAQobVXBkYXRlRGVwbG95bWVudEhlYWx0aENoZWNrEkAub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5VcGRhdGVEZXBsb3ltZW50SGVhbHRoQ2hlY2tSZXF1ZXN0GkEub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5VcGRhdGVEZXBsb3ltZW50SGVhbHRoQ2hlY2tSZXNwb25zZRKfAQoaU2V0RGVwbG95bWVudEFmZmluaXR5UnVsZXMSPy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlNldERlcGxveW1lbnRBZmZpbml0eVJ1bGVzUmVxdWVzdBpALm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU2V0RGVwbG95bWVudEFmZmluaXR5UnVsZXNSZXNwb25zZRKfAQoaR2V0RGVwbG95bWVudEFmZmluaXR5UnVsZXMSPy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRBZmZpbml0eVJ1bGVzUmVxdWVzdBpALm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudEFmZmluaXR5UnVsZXNSZXNwb25zZRKKAQoTQ3JlYXRlU2NhbGVTY2hlZHVsZRI4Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuQ3JlYXRlU2NhbGVTY2hlZHVsZVJlcXVlc3QaOS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkNyZWF0ZVNjYWxlU2NoZWR1bGVSZXNwb25zZRKKAQoTRGVsZXRlU2NhbGVTY2hlZHVsZRI4Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVsZXRlU2NhbGVTY2hlZHVsZVJlcXVlc3QaOS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlbGV0ZVNjYWxlU2NoZWR1bGVSZXNwb25zZRKHAQoSTGlzdFNjYWxlU2NoZWR1bGVzEjcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5MaXN0U2NhbGVTY2hlZHVsZXNSZXF1ZXN0Gjgub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5MaXN0U2NhbGVTY2hlZHVsZXNSZXNwb25zZRKHAQoSU2V0QXV0b1NjYWxlUG9saWN5Ejcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TZXRBdXRvU2NhbGVQb2xpY3lSZXF1ZXN0Gjgub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TZXRBdXRvU2NhbGVQb2xpY3lSZXNwb25zZRKHAQoSR2V0QXV0b1NjYWxlUG9saWN5Ejcub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXRBdXRvU2NhbGVQb2xpY3lSZXF1ZXN0Gjgub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5HZXRBdXRvU2NhbGVQb2xpY3lSZXNwb25zZRKBAQoQRGlzYWJsZUF1dG9TY2FsZRI1Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGlzYWJsZUF1dG9TY2FsZVJlcXVlc3QaNi5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRpc2FibGVBdXRvU2NhbGVSZXNwb25zZRKcAQoZR2V0RGVwbG95bWVudFJlZ2lvblN0YXR1cxI+Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuR2V0RGVwbG95bWVudFJlZ2lvblN0YXR1c1JlcXVlc3QaPy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkdldERlcGxveW1lbnRSZWdpb25TdGF0dXNSZXNwb25zZRKZAQoYTGlzdERlcGxveW1lbnRDb250YWluZXJzEj0ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5MaXN0RGVwbG95bWVudENvbnRhaW5lcnNSZXF1ZXN0Gj4ub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5MaXN0RGVwbG95bWVudENvbnRhaW5lcnNSZXNwb25zZRKCAQoTU3RyZWFtQ29udGFpbmVyTG9ncxI4Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU3RyZWFtQ29udGFpbmVyTG9nc1JlcXVlc3QaLy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkRlcGxveW1lbnRMb2dMaW5lMAESewoOU3RhcnRDb250YWluZXISMy5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLlN0YXJ0Q29udGFpbmVyUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU3RhcnRDb250YWluZXJSZXNwb25zZRJ4Cg1TdG9wQ29udGFpbmVyEjIub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5TdG9wQ29udGFpbmVyUmVxdWVzdBozLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuU3RvcENvbnRhaW5lclJlc3BvbnNlEoEBChBSZXN0YXJ0Q29udGFpbmVyEjUub2JpZW50ZS5jbG91ZC5kZXBsb3ltZW50cy52MS5SZXN0YXJ0Q29udGFpbmVyUmVxdWVzdBo2Lm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuUmVzdGFydENvbnRhaW5lclJlc3BvbnNlQldaVWdpdGh1Yi5jb20vb2JpZW50ZS9jbG91ZC9hcHBzL3NoYXJlZC9wcm90by9vYmllbnRlL2Nsb3VkL2RlcGxveW1lbnRzL3YxO2RlcGxveW1lbnRzdjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_obiente_cloud_organizations_v1_organization_service, file_obiente_cloud_common_v1_common]);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ListDeploymentsRequest
@@ -40,6 +40,13 @@ export type ListDeploymentsRequest = Message<"obiente.cloud.deployments.v1.ListD
    * @generated from field: int32 per_page = 4;
    */
   perPage: number;
+
+  /**
+   * Only list deployments that carry every key=value tag
+   *
+   * @generated from field: map<string, string> tags = 5;
+   */
+  tags: { [key: string]: string };
 };
 
 /**
@@ -98,6 +105,27 @@ export type CreateDeploymentRequest = Message<"obiente.cloud.deployments.v1.Crea
    * @generated from field: repeated string groups = 4;
    */
   groups: string[];
+
+  /**
+   * Create an ephemeral preview environment (used by pull request webhooks)
+   *
+   * @generated from field: bool is_preview = 5;
+   */
+  isPreview: boolean;
+
+  /**
+   * Team to assign the deployment to; counts towards the team's quota
+   *
+   * @generated from field: optional string team_id = 6;
+   */
+  teamId?: string;
+
+  /**
+   * Regions to run the deployment in; the first is the primary and the rest are replicas
+   *
+   * @generated from field: repeated string regions = 7;
+   */
+  regions: string[];
 };
 
 /**
@@ -115,6 +143,20 @@ export type CreateDeploymentResponse = Message<"obiente.cloud.deployments.v1.Cre
    * @generated from field: obiente.cloud.deployments.v1.Deployment deployment = 1;
    */
   deployment?: Deployment;
+
+  /**
+   * Traefik IPs of the regions the deployment was provisioned in
+   *
+   * @generated from field: repeated string traefik_ips = 2;
+   */
+  traefikIps: string[];
+
+  /**
+   * Per-region result of a multi-region create
+   *
+   * @generated from field: repeated obiente.cloud.deployments.v1.DeploymentRegionStatus regions = 3;
+   */
+  regions: DeploymentRegionStatus[];
 };
 
 /**
@@ -372,6 +414,20 @@ export type UpdateDeploymentRequest = Message<"obiente.cloud.deployments.v1.Upda
    * @generated from field: optional obiente.cloud.deployments.v1.DockerfileBuildOptions dockerfile_build_options = 32;
    */
   dockerfileBuildOptions?: DockerfileBuildOptions;
+
+  /**
+   * Regions of a multi-region deployment to re-provision with the update; others are left as they are
+   *
+   * @generated from field: repeated string regions = 33;
+   */
+  regions: string[];
+
+  /**
+   * Drain in-flight requests from the old containers on redeploys instead of stopping them at the cut-over
+   *
+   * @generated from field: optional bool zero_downtime_deploy = 34;
+   */
+  zeroDowntimeDeploy?: boolean;
 };
 
 /**
@@ -812,6 +868,143 @@ export type RestartDeploymentResponse = Message<"obiente.cloud.deployments.v1.Re
 export const RestartDeploymentResponseSchema: GenMessage<RestartDeploymentResponse> = /*@__PURE__*/
   messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 24);
 
+/**
+ * @generated from message obiente.cloud.deployments.v1.RollbackDeploymentRequest
+ */
+export type RollbackDeploymentRequest = Message<"obiente.cloud.deployments.v1.RollbackDeploymentRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * Version number to roll back to (see ListDeploymentVersions)
+   *
+   * @generated from field: optional int32 version = 3;
+   */
+  version?: number;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.RollbackDeploymentRequest.
+ * Use `create(RollbackDeploymentRequestSchema)` to create a new message.
+ */
+export const RollbackDeploymentRequestSchema: GenMessage<RollbackDeploymentRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 25);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.RollbackDeploymentResponse
+ */
+export type RollbackDeploymentResponse = Message<"obiente.cloud.deployments.v1.RollbackDeploymentResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.deployments.v1.Deployment deployment = 1;
+   */
+  deployment?: Deployment;
+
+  /**
+   * Version that is now active (set when rolling back to a version)
+   *
+   * @generated from field: optional obiente.cloud.deployments.v1.DeploymentVersion version = 2;
+   */
+  version?: DeploymentVersion;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.RollbackDeploymentResponse.
+ * Use `create(RollbackDeploymentResponseSchema)` to create a new message.
+ */
+export const RollbackDeploymentResponseSchema: GenMessage<RollbackDeploymentResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 26);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.DeploymentVersion
+ */
+export type DeploymentVersion = Message<"obiente.cloud.deployments.v1.DeploymentVersion"> & {
+  /**
+   * @generated from field: int32 version_number = 1;
+   */
+  versionNumber: number;
+
+  /**
+   * Image pinned by digest
+   *
+   * @generated from field: string image = 2;
+   */
+  image: string;
+
+  /**
+   * SHA-256 of the environment variables
+   *
+   * @generated from field: string env_vars_hash = 3;
+   */
+  envVarsHash: string;
+
+  /**
+   * Version the deployment currently runs
+   *
+   * @generated from field: bool active = 4;
+   */
+  active: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.DeploymentVersion.
+ * Use `create(DeploymentVersionSchema)` to create a new message.
+ */
+export const DeploymentVersionSchema: GenMessage<DeploymentVersion> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 27);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.ListDeploymentVersionsRequest
+ */
+export type ListDeploymentVersionsRequest = Message<"obiente.cloud.deployments.v1.ListDeploymentVersionsRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.ListDeploymentVersionsRequest.
+ * Use `create(ListDeploymentVersionsRequestSchema)` to create a new message.
+ */
+export const ListDeploymentVersionsRequestSchema: GenMessage<ListDeploymentVersionsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 28);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.ListDeploymentVersionsResponse
+ */
+export type ListDeploymentVersionsResponse = Message<"obiente.cloud.deployments.v1.ListDeploymentVersionsResponse"> & {
+  /**
+   * Newest first
+   *
+   * @generated from field: repeated obiente.cloud.deployments.v1.DeploymentVersion versions = 1;
+   */
+  versions: DeploymentVersion[];
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.ListDeploymentVersionsResponse.
+ * Use `create(ListDeploymentVersionsResponseSchema)` to create a new message.
+ */
+export const ListDeploymentVersionsResponseSchema: GenMessage<ListDeploymentVersionsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 29);
+
 /**
  * @generated from message obiente.cloud.deployments.v1.ScaleDeploymentRequest
  */
@@ -837,7 +1030,7 @@ export type ScaleDeploymentRequest = Message<"obiente.cloud.deployments.v1.Scale
  * Use `create(ScaleDeploymentRequestSchema)` to create a new message.
  */
 export const ScaleDeploymentRequestSchema: GenMessage<ScaleDeploymentRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 25);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 30);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ScaleDeploymentResponse
@@ -854,7 +1047,60 @@ export type ScaleDeploymentResponse = Message<"obiente.cloud.deployments.v1.Scal
  * Use `create(ScaleDeploymentResponseSchema)` to create a new message.
  */
 export const ScaleDeploymentResponseSchema: GenMessage<ScaleDeploymentResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 26);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 31);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.SetResourceLimitsRequest
+ */
+export type SetResourceLimitsRequest = Message<"obiente.cloud.deployments.v1.SetResourceLimitsRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * CPU limit in cores, at least 0.1 and at most the plan's CPU cores
+   *
+   * @generated from field: double cpu_limit = 3;
+   */
+  cpuLimit: number;
+
+  /**
+   * Memory limit in MB, at least 128 and at most the plan's memory
+   *
+   * @generated from field: int64 memory_limit = 4;
+   */
+  memoryLimit: bigint;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.SetResourceLimitsRequest.
+ * Use `create(SetResourceLimitsRequestSchema)` to create a new message.
+ */
+export const SetResourceLimitsRequestSchema: GenMessage<SetResourceLimitsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 32);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.SetResourceLimitsResponse
+ */
+export type SetResourceLimitsResponse = Message<"obiente.cloud.deployments.v1.SetResourceLimitsResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.deployments.v1.Deployment deployment = 1;
+   */
+  deployment?: Deployment;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.SetResourceLimitsResponse.
+ * Use `create(SetResourceLimitsResponseSchema)` to create a new message.
+ */
+export const SetResourceLimitsResponseSchema: GenMessage<SetResourceLimitsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 33);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentEnvVarsRequest
@@ -876,7 +1122,7 @@ export type GetDeploymentEnvVarsRequest = Message<"obiente.cloud.deployments.v1.
  * Use `create(GetDeploymentEnvVarsRequestSchema)` to create a new message.
  */
 export const GetDeploymentEnvVarsRequestSchema: GenMessage<GetDeploymentEnvVarsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 27);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 34);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentEnvVarsResponse
@@ -895,7 +1141,7 @@ export type GetDeploymentEnvVarsResponse = Message<"obiente.cloud.deployments.v1
  * Use `create(GetDeploymentEnvVarsResponseSchema)` to create a new message.
  */
 export const GetDeploymentEnvVarsResponseSchema: GenMessage<GetDeploymentEnvVarsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 28);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 35);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsRequest
@@ -924,7 +1170,7 @@ export type UpdateDeploymentEnvVarsRequest = Message<"obiente.cloud.deployments.
  * Use `create(UpdateDeploymentEnvVarsRequestSchema)` to create a new message.
  */
 export const UpdateDeploymentEnvVarsRequestSchema: GenMessage<UpdateDeploymentEnvVarsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 29);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 36);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.UpdateDeploymentEnvVarsResponse
@@ -941,7 +1187,46 @@ export type UpdateDeploymentEnvVarsResponse = Message<"obiente.cloud.deployments
  * Use `create(UpdateDeploymentEnvVarsResponseSchema)` to create a new message.
  */
 export const UpdateDeploymentEnvVarsResponseSchema: GenMessage<UpdateDeploymentEnvVarsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 30);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 37);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.RotateEnvKeyRequest
+ */
+export type RotateEnvKeyRequest = Message<"obiente.cloud.deployments.v1.RotateEnvKeyRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.RotateEnvKeyRequest.
+ * Use `create(RotateEnvKeyRequestSchema)` to create a new message.
+ */
+export const RotateEnvKeyRequestSchema: GenMessage<RotateEnvKeyRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 38);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.RotateEnvKeyResponse
+ */
+export type RotateEnvKeyResponse = Message<"obiente.cloud.deployments.v1.RotateEnvKeyResponse"> & {
+  /**
+   * @generated from field: string key_id = 1;
+   */
+  keyId: string;
+
+  /**
+   * @generated from field: int32 deployments_reencrypted = 2;
+   */
+  deploymentsReencrypted: number;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.RotateEnvKeyResponse.
+ * Use `create(RotateEnvKeyResponseSchema)` to create a new message.
+ */
+export const RotateEnvKeyResponseSchema: GenMessage<RotateEnvKeyResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 39);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentComposeRequest
@@ -963,7 +1248,7 @@ export type GetDeploymentComposeRequest = Message<"obiente.cloud.deployments.v1.
  * Use `create(GetDeploymentComposeRequestSchema)` to create a new message.
  */
 export const GetDeploymentComposeRequestSchema: GenMessage<GetDeploymentComposeRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 31);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 40);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentComposeResponse
@@ -980,7 +1265,7 @@ export type GetDeploymentComposeResponse = Message<"obiente.cloud.deployments.v1
  * Use `create(GetDeploymentComposeResponseSchema)` to create a new message.
  */
 export const GetDeploymentComposeResponseSchema: GenMessage<GetDeploymentComposeResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 32);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 41);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ValidateDeploymentComposeRequest
@@ -1007,7 +1292,7 @@ export type ValidateDeploymentComposeRequest = Message<"obiente.cloud.deployment
  * Use `create(ValidateDeploymentComposeRequestSchema)` to create a new message.
  */
 export const ValidateDeploymentComposeRequestSchema: GenMessage<ValidateDeploymentComposeRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 33);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 42);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ValidateDeploymentComposeResponse
@@ -1031,7 +1316,7 @@ export type ValidateDeploymentComposeResponse = Message<"obiente.cloud.deploymen
  * Use `create(ValidateDeploymentComposeResponseSchema)` to create a new message.
  */
 export const ValidateDeploymentComposeResponseSchema: GenMessage<ValidateDeploymentComposeResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 34);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 43);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.UpdateDeploymentComposeRequest
@@ -1058,7 +1343,7 @@ export type UpdateDeploymentComposeRequest = Message<"obiente.cloud.deployments.
  * Use `create(UpdateDeploymentComposeRequestSchema)` to create a new message.
  */
 export const UpdateDeploymentComposeRequestSchema: GenMessage<UpdateDeploymentComposeRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 35);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 44);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.UpdateDeploymentComposeResponse
@@ -1087,7 +1372,7 @@ export type UpdateDeploymentComposeResponse = Message<"obiente.cloud.deployments
  * Use `create(UpdateDeploymentComposeResponseSchema)` to create a new message.
  */
 export const UpdateDeploymentComposeResponseSchema: GenMessage<UpdateDeploymentComposeResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 36);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 45);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ComposeValidationError
@@ -1153,7 +1438,7 @@ export type ComposeValidationError = Message<"obiente.cloud.deployments.v1.Compo
  * Use `create(ComposeValidationErrorSchema)` to create a new message.
  */
 export const ComposeValidationErrorSchema: GenMessage<ComposeValidationError> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 37);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 46);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ListGitHubReposRequest
@@ -1189,7 +1474,7 @@ export type ListGitHubReposRequest = Message<"obiente.cloud.deployments.v1.ListG
  * Use `create(ListGitHubReposRequestSchema)` to create a new message.
  */
 export const ListGitHubReposRequestSchema: GenMessage<ListGitHubReposRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 38);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 47);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GitHubRepo
@@ -1236,7 +1521,7 @@ export type GitHubRepo = Message<"obiente.cloud.deployments.v1.GitHubRepo"> & {
  * Use `create(GitHubRepoSchema)` to create a new message.
  */
 export const GitHubRepoSchema: GenMessage<GitHubRepo> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 39);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 48);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ListGitHubReposResponse
@@ -1258,7 +1543,7 @@ export type ListGitHubReposResponse = Message<"obiente.cloud.deployments.v1.List
  * Use `create(ListGitHubReposResponseSchema)` to create a new message.
  */
 export const ListGitHubReposResponseSchema: GenMessage<ListGitHubReposResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 40);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 49);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetGitHubBranchesRequest
@@ -1291,7 +1576,7 @@ export type GetGitHubBranchesRequest = Message<"obiente.cloud.deployments.v1.Get
  * Use `create(GetGitHubBranchesRequestSchema)` to create a new message.
  */
 export const GetGitHubBranchesRequestSchema: GenMessage<GetGitHubBranchesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 41);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 50);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GitHubBranch
@@ -1318,7 +1603,7 @@ export type GitHubBranch = Message<"obiente.cloud.deployments.v1.GitHubBranch">
  * Use `create(GitHubBranchSchema)` to create a new message.
  */
 export const GitHubBranchSchema: GenMessage<GitHubBranch> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 42);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 51);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetGitHubBranchesResponse
@@ -1335,7 +1620,7 @@ export type GetGitHubBranchesResponse = Message<"obiente.cloud.deployments.v1.Ge
  * Use `create(GetGitHubBranchesResponseSchema)` to create a new message.
  */
 export const GetGitHubBranchesResponseSchema: GenMessage<GetGitHubBranchesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 43);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 52);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetGitHubFileRequest
@@ -1380,7 +1665,7 @@ export type GetGitHubFileRequest = Message<"obiente.cloud.deployments.v1.GetGitH
  * Use `create(GetGitHubFileRequestSchema)` to create a new message.
  */
 export const GetGitHubFileRequestSchema: GenMessage<GetGitHubFileRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 44);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 53);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetGitHubFileResponse
@@ -1409,7 +1694,7 @@ export type GetGitHubFileResponse = Message<"obiente.cloud.deployments.v1.GetGit
  * Use `create(GetGitHubFileResponseSchema)` to create a new message.
  */
 export const GetGitHubFileResponseSchema: GenMessage<GetGitHubFileResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 45);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 54);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsRequest
@@ -1428,7 +1713,7 @@ export type ListAvailableGitHubIntegrationsRequest = Message<"obiente.cloud.depl
  * Use `create(ListAvailableGitHubIntegrationsRequestSchema)` to create a new message.
  */
 export const ListAvailableGitHubIntegrationsRequestSchema: GenMessage<ListAvailableGitHubIntegrationsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 46);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 55);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GitHubIntegrationOption
@@ -1493,7 +1778,7 @@ export type GitHubIntegrationOption = Message<"obiente.cloud.deployments.v1.GitH
  * Use `create(GitHubIntegrationOptionSchema)` to create a new message.
  */
 export const GitHubIntegrationOptionSchema: GenMessage<GitHubIntegrationOption> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 47);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 56);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ListAvailableGitHubIntegrationsResponse
@@ -1510,43 +1795,163 @@ export type ListAvailableGitHubIntegrationsResponse = Message<"obiente.cloud.dep
  * Use `create(ListAvailableGitHubIntegrationsResponseSchema)` to create a new message.
  */
 export const ListAvailableGitHubIntegrationsResponseSchema: GenMessage<ListAvailableGitHubIntegrationsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 48);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 57);
 
 /**
- * @generated from message obiente.cloud.deployments.v1.StreamTerminalOutputRequest
+ * @generated from message obiente.cloud.deployments.v1.RotateDeployKeyRequest
  */
-export type StreamTerminalOutputRequest = Message<"obiente.cloud.deployments.v1.StreamTerminalOutputRequest"> & {
+export type RotateDeployKeyRequest = Message<"obiente.cloud.deployments.v1.RotateDeployKeyRequest"> & {
   /**
+   * Optional: organization that owns the integration
+   *
    * @generated from field: string organization_id = 1;
    */
   organizationId: string;
 
   /**
-   * @generated from field: string deployment_id = 2;
+   * @generated from field: string integration_id = 2;
    */
-  deploymentId: string;
+  integrationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.RotateDeployKeyRequest.
+ * Use `create(RotateDeployKeyRequestSchema)` to create a new message.
+ */
+export const RotateDeployKeyRequestSchema: GenMessage<RotateDeployKeyRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 58);
 
+/**
+ * @generated from message obiente.cloud.deployments.v1.GitHubDeployKey
+ */
+export type GitHubDeployKey = Message<"obiente.cloud.deployments.v1.GitHubDeployKey"> & {
   /**
-   * Terminal width
+   * owner/repo
    *
-   * @generated from field: int32 cols = 3;
+   * @generated from field: string repository = 1;
    */
-  cols: number;
+  repository: string;
 
   /**
-   * Terminal height
+   * OpenSSH authorized_keys format
    *
-   * @generated from field: int32 rows = 4;
+   * @generated from field: string public_key = 2;
    */
-  rows: number;
-};
+  publicKey: string;
 
-/**
- * Describes the message obiente.cloud.deployments.v1.StreamTerminalOutputRequest.
+  /**
+   * @generated from field: int64 github_key_id = 3;
+   */
+  githubKeyId: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp rotated_at = 4;
+   */
+  rotatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.GitHubDeployKey.
+ * Use `create(GitHubDeployKeySchema)` to create a new message.
+ */
+export const GitHubDeployKeySchema: GenMessage<GitHubDeployKey> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 59);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.RotateDeployKeyResponse
+ */
+export type RotateDeployKeyResponse = Message<"obiente.cloud.deployments.v1.RotateDeployKeyResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.deployments.v1.GitHubDeployKey deploy_keys = 1;
+   */
+  deployKeys: GitHubDeployKey[];
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.RotateDeployKeyResponse.
+ * Use `create(RotateDeployKeyResponseSchema)` to create a new message.
+ */
+export const RotateDeployKeyResponseSchema: GenMessage<RotateDeployKeyResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 60);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.RotateWebhookSecretRequest
+ */
+export type RotateWebhookSecretRequest = Message<"obiente.cloud.deployments.v1.RotateWebhookSecretRequest"> & {
+  /**
+   * Optional: organization that owns the integration
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string integration_id = 2;
+   */
+  integrationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.RotateWebhookSecretRequest.
+ * Use `create(RotateWebhookSecretRequestSchema)` to create a new message.
+ */
+export const RotateWebhookSecretRequestSchema: GenMessage<RotateWebhookSecretRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 61);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.RotateWebhookSecretResponse
+ */
+export type RotateWebhookSecretResponse = Message<"obiente.cloud.deployments.v1.RotateWebhookSecretResponse"> & {
+  /**
+   * Repositories whose webhook secret was replaced
+   *
+   * @generated from field: repeated string repositories = 1;
+   */
+  repositories: string[];
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.RotateWebhookSecretResponse.
+ * Use `create(RotateWebhookSecretResponseSchema)` to create a new message.
+ */
+export const RotateWebhookSecretResponseSchema: GenMessage<RotateWebhookSecretResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 62);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.StreamTerminalOutputRequest
+ */
+export type StreamTerminalOutputRequest = Message<"obiente.cloud.deployments.v1.StreamTerminalOutputRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * Terminal width
+   *
+   * @generated from field: int32 cols = 3;
+   */
+  cols: number;
+
+  /**
+   * Terminal height
+   *
+   * @generated from field: int32 rows = 4;
+   */
+  rows: number;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.StreamTerminalOutputRequest.
  * Use `create(StreamTerminalOutputRequestSchema)` to create a new message.
  */
 export const StreamTerminalOutputRequestSchema: GenMessage<StreamTerminalOutputRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 49);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 63);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.SendTerminalInputRequest
@@ -1589,7 +1994,7 @@ export type SendTerminalInputRequest = Message<"obiente.cloud.deployments.v1.Sen
  * Use `create(SendTerminalInputRequestSchema)` to create a new message.
  */
 export const SendTerminalInputRequestSchema: GenMessage<SendTerminalInputRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 50);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 64);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.SendTerminalInputResponse
@@ -1606,7 +2011,7 @@ export type SendTerminalInputResponse = Message<"obiente.cloud.deployments.v1.Se
  * Use `create(SendTerminalInputResponseSchema)` to create a new message.
  */
 export const SendTerminalInputResponseSchema: GenMessage<SendTerminalInputResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 51);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 65);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.TerminalInput
@@ -1663,7 +2068,7 @@ export type TerminalInput = Message<"obiente.cloud.deployments.v1.TerminalInput"
  * Use `create(TerminalInputSchema)` to create a new message.
  */
 export const TerminalInputSchema: GenMessage<TerminalInput> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 52);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 66);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.TerminalOutput
@@ -1689,7 +2094,7 @@ export type TerminalOutput = Message<"obiente.cloud.deployments.v1.TerminalOutpu
  * Use `create(TerminalOutputSchema)` to create a new message.
  */
 export const TerminalOutputSchema: GenMessage<TerminalOutput> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 53);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 67);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.VolumeInfo
@@ -1729,7 +2134,7 @@ export type VolumeInfo = Message<"obiente.cloud.deployments.v1.VolumeInfo"> & {
  * Use `create(VolumeInfoSchema)` to create a new message.
  */
 export const VolumeInfoSchema: GenMessage<VolumeInfo> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 54);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 68);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ListContainerFilesRequest
@@ -1796,7 +2201,7 @@ export type ListContainerFilesRequest = Message<"obiente.cloud.deployments.v1.Li
  * Use `create(ListContainerFilesRequestSchema)` to create a new message.
  */
 export const ListContainerFilesRequestSchema: GenMessage<ListContainerFilesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 55);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 69);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ContainerFile
@@ -1880,7 +2285,7 @@ export type ContainerFile = Message<"obiente.cloud.deployments.v1.ContainerFile"
  * Use `create(ContainerFileSchema)` to create a new message.
  */
 export const ContainerFileSchema: GenMessage<ContainerFile> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 56);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 70);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ListContainerFilesResponse
@@ -1933,7 +2338,7 @@ export type ListContainerFilesResponse = Message<"obiente.cloud.deployments.v1.L
  * Use `create(ListContainerFilesResponseSchema)` to create a new message.
  */
 export const ListContainerFilesResponseSchema: GenMessage<ListContainerFilesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 57);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 71);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetContainerFileRequest
@@ -1983,7 +2388,7 @@ export type GetContainerFileRequest = Message<"obiente.cloud.deployments.v1.GetC
  * Use `create(GetContainerFileRequestSchema)` to create a new message.
  */
 export const GetContainerFileRequestSchema: GenMessage<GetContainerFileRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 58);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 72);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetContainerFileResponse
@@ -2022,7 +2427,7 @@ export type GetContainerFileResponse = Message<"obiente.cloud.deployments.v1.Get
  * Use `create(GetContainerFileResponseSchema)` to create a new message.
  */
 export const GetContainerFileResponseSchema: GenMessage<GetContainerFileResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 59);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 73);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.UploadContainerFilesRequest
@@ -2048,7 +2453,7 @@ export type UploadContainerFilesRequest = Message<"obiente.cloud.deployments.v1.
  * Use `create(UploadContainerFilesRequestSchema)` to create a new message.
  */
 export const UploadContainerFilesRequestSchema: GenMessage<UploadContainerFilesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 60);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 74);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.UploadContainerFilesMetadata
@@ -2105,7 +2510,7 @@ export type UploadContainerFilesMetadata = Message<"obiente.cloud.deployments.v1
  * Use `create(UploadContainerFilesMetadataSchema)` to create a new message.
  */
 export const UploadContainerFilesMetadataSchema: GenMessage<UploadContainerFilesMetadata> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 61);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 75);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.FileMetadata
@@ -2145,7 +2550,7 @@ export type FileMetadata = Message<"obiente.cloud.deployments.v1.FileMetadata">
  * Use `create(FileMetadataSchema)` to create a new message.
  */
 export const FileMetadataSchema: GenMessage<FileMetadata> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 62);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 76);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.UploadContainerFilesResponse
@@ -2174,7 +2579,7 @@ export type UploadContainerFilesResponse = Message<"obiente.cloud.deployments.v1
  * Use `create(UploadContainerFilesResponseSchema)` to create a new message.
  */
 export const UploadContainerFilesResponseSchema: GenMessage<UploadContainerFilesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 63);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 77);
 
 /**
  * Chunked upload for deployments using shared payload for consistency with other services.
@@ -2217,7 +2622,7 @@ export type ChunkUploadContainerFilesRequest = Message<"obiente.cloud.deployment
  * Use `create(ChunkUploadContainerFilesRequestSchema)` to create a new message.
  */
 export const ChunkUploadContainerFilesRequestSchema: GenMessage<ChunkUploadContainerFilesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 64);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 78);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ChunkUploadContainerFilesResponse
@@ -2234,7 +2639,7 @@ export type ChunkUploadContainerFilesResponse = Message<"obiente.cloud.deploymen
  * Use `create(ChunkUploadContainerFilesResponseSchema)` to create a new message.
  */
 export const ChunkUploadContainerFilesResponseSchema: GenMessage<ChunkUploadContainerFilesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 65);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 79);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.DeleteContainerEntriesRequest
@@ -2276,7 +2681,7 @@ export type DeleteContainerEntriesRequest = Message<"obiente.cloud.deployments.v
  * Use `create(DeleteContainerEntriesRequestSchema)` to create a new message.
  */
 export const DeleteContainerEntriesRequestSchema: GenMessage<DeleteContainerEntriesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 66);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 80);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.DeleteContainerEntriesError
@@ -2298,7 +2703,7 @@ export type DeleteContainerEntriesError = Message<"obiente.cloud.deployments.v1.
  * Use `create(DeleteContainerEntriesErrorSchema)` to create a new message.
  */
 export const DeleteContainerEntriesErrorSchema: GenMessage<DeleteContainerEntriesError> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 67);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 81);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.DeleteContainerEntriesResponse
@@ -2325,7 +2730,7 @@ export type DeleteContainerEntriesResponse = Message<"obiente.cloud.deployments.
  * Use `create(DeleteContainerEntriesResponseSchema)` to create a new message.
  */
 export const DeleteContainerEntriesResponseSchema: GenMessage<DeleteContainerEntriesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 68);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 82);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.RenameContainerEntryRequest
@@ -2367,7 +2772,7 @@ export type RenameContainerEntryRequest = Message<"obiente.cloud.deployments.v1.
  * Use `create(RenameContainerEntryRequestSchema)` to create a new message.
  */
 export const RenameContainerEntryRequestSchema: GenMessage<RenameContainerEntryRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 69);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 83);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.RenameContainerEntryResponse
@@ -2389,7 +2794,7 @@ export type RenameContainerEntryResponse = Message<"obiente.cloud.deployments.v1
  * Use `create(RenameContainerEntryResponseSchema)` to create a new message.
  */
 export const RenameContainerEntryResponseSchema: GenMessage<RenameContainerEntryResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 70);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 84);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.CreateContainerEntryRequest
@@ -2457,7 +2862,7 @@ export type CreateContainerEntryRequest = Message<"obiente.cloud.deployments.v1.
  * Use `create(CreateContainerEntryRequestSchema)` to create a new message.
  */
 export const CreateContainerEntryRequestSchema: GenMessage<CreateContainerEntryRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 71);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 85);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.CreateContainerEntryResponse
@@ -2474,7 +2879,7 @@ export type CreateContainerEntryResponse = Message<"obiente.cloud.deployments.v1
  * Use `create(CreateContainerEntryResponseSchema)` to create a new message.
  */
 export const CreateContainerEntryResponseSchema: GenMessage<CreateContainerEntryResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 72);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 86);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.WriteContainerFileRequest
@@ -2528,7 +2933,7 @@ export type WriteContainerFileRequest = Message<"obiente.cloud.deployments.v1.Wr
  * Use `create(WriteContainerFileRequestSchema)` to create a new message.
  */
 export const WriteContainerFileRequestSchema: GenMessage<WriteContainerFileRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 73);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 87);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.WriteContainerFileResponse
@@ -2555,7 +2960,7 @@ export type WriteContainerFileResponse = Message<"obiente.cloud.deployments.v1.W
  * Use `create(WriteContainerFileResponseSchema)` to create a new message.
  */
 export const WriteContainerFileResponseSchema: GenMessage<WriteContainerFileResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 74);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 88);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ExtractDeploymentFileRequest
@@ -2612,7 +3017,7 @@ export type ExtractDeploymentFileRequest = Message<"obiente.cloud.deployments.v1
  * Use `create(ExtractDeploymentFileRequestSchema)` to create a new message.
  */
 export const ExtractDeploymentFileRequestSchema: GenMessage<ExtractDeploymentFileRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 75);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 89);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ExtractDeploymentFileResponse
@@ -2645,7 +3050,7 @@ export type ExtractDeploymentFileResponse = Message<"obiente.cloud.deployments.v
  * Use `create(ExtractDeploymentFileResponseSchema)` to create a new message.
  */
 export const ExtractDeploymentFileResponseSchema: GenMessage<ExtractDeploymentFileResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 76);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 90);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.CreateDeploymentFileArchiveRequest
@@ -2695,7 +3100,7 @@ export type CreateDeploymentFileArchiveRequest = Message<"obiente.cloud.deployme
  * Use `create(CreateDeploymentFileArchiveRequestSchema)` to create a new message.
  */
 export const CreateDeploymentFileArchiveRequestSchema: GenMessage<CreateDeploymentFileArchiveRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 77);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 91);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.CreateDeploymentFileArchiveResponse
@@ -2714,7 +3119,7 @@ export type CreateDeploymentFileArchiveResponse = Message<"obiente.cloud.deploym
  * Use `create(CreateDeploymentFileArchiveResponseSchema)` to create a new message.
  */
 export const CreateDeploymentFileArchiveResponseSchema: GenMessage<CreateDeploymentFileArchiveResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 78);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 92);
 
 /**
  * Routing configuration messages
@@ -2779,7 +3184,7 @@ export type RoutingRule = Message<"obiente.cloud.deployments.v1.RoutingRule"> &
  * Use `create(RoutingRuleSchema)` to create a new message.
  */
 export const RoutingRuleSchema: GenMessage<RoutingRule> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 79);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 93);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentRoutingsRequest
@@ -2801,7 +3206,7 @@ export type GetDeploymentRoutingsRequest = Message<"obiente.cloud.deployments.v1
  * Use `create(GetDeploymentRoutingsRequestSchema)` to create a new message.
  */
 export const GetDeploymentRoutingsRequestSchema: GenMessage<GetDeploymentRoutingsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 80);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 94);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentRoutingsResponse
@@ -2818,7 +3223,7 @@ export type GetDeploymentRoutingsResponse = Message<"obiente.cloud.deployments.v
  * Use `create(GetDeploymentRoutingsResponseSchema)` to create a new message.
  */
 export const GetDeploymentRoutingsResponseSchema: GenMessage<GetDeploymentRoutingsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 81);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 95);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.UpdateDeploymentRoutingsRequest
@@ -2847,7 +3252,7 @@ export type UpdateDeploymentRoutingsRequest = Message<"obiente.cloud.deployments
  * Use `create(UpdateDeploymentRoutingsRequestSchema)` to create a new message.
  */
 export const UpdateDeploymentRoutingsRequestSchema: GenMessage<UpdateDeploymentRoutingsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 82);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 96);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.UpdateDeploymentRoutingsResponse
@@ -2864,7 +3269,7 @@ export type UpdateDeploymentRoutingsResponse = Message<"obiente.cloud.deployment
  * Use `create(UpdateDeploymentRoutingsResponseSchema)` to create a new message.
  */
 export const UpdateDeploymentRoutingsResponseSchema: GenMessage<UpdateDeploymentRoutingsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 83);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 97);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentServiceNamesRequest
@@ -2886,7 +3291,7 @@ export type GetDeploymentServiceNamesRequest = Message<"obiente.cloud.deployment
  * Use `create(GetDeploymentServiceNamesRequestSchema)` to create a new message.
  */
 export const GetDeploymentServiceNamesRequestSchema: GenMessage<GetDeploymentServiceNamesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 84);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 98);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentServiceNamesResponse
@@ -2903,7 +3308,7 @@ export type GetDeploymentServiceNamesResponse = Message<"obiente.cloud.deploymen
  * Use `create(GetDeploymentServiceNamesResponseSchema)` to create a new message.
  */
 export const GetDeploymentServiceNamesResponseSchema: GenMessage<GetDeploymentServiceNamesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 85);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 99);
 
 /**
  * Domain verification messages
@@ -2922,123 +3327,986 @@ export type GetDomainVerificationTokenRequest = Message<"obiente.cloud.deploymen
   deploymentId: string;
 
   /**
-   * @generated from field: string domain = 3;
+   * @generated from field: string domain = 3;
+   */
+  domain: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.GetDomainVerificationTokenRequest.
+ * Use `create(GetDomainVerificationTokenRequestSchema)` to create a new message.
+ */
+export const GetDomainVerificationTokenRequestSchema: GenMessage<GetDomainVerificationTokenRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 100);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.GetDomainVerificationTokenResponse
+ */
+export type GetDomainVerificationTokenResponse = Message<"obiente.cloud.deployments.v1.GetDomainVerificationTokenResponse"> & {
+  /**
+   * @generated from field: string domain = 1;
+   */
+  domain: string;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token: string;
+
+  /**
+   * e.g., "_obiente-verification.example.com"
+   *
+   * @generated from field: string txt_record_name = 3;
+   */
+  txtRecordName: string;
+
+  /**
+   * e.g., "obiente-verification=abc123..."
+   *
+   * @generated from field: string txt_record_value = 4;
+   */
+  txtRecordValue: string;
+
+  /**
+   * "pending", "verified", "failed", "expired"
+   *
+   * @generated from field: string status = 5;
+   */
+  status: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.GetDomainVerificationTokenResponse.
+ * Use `create(GetDomainVerificationTokenResponseSchema)` to create a new message.
+ */
+export const GetDomainVerificationTokenResponseSchema: GenMessage<GetDomainVerificationTokenResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 101);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest
+ */
+export type VerifyDomainOwnershipRequest = Message<"obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * @generated from field: string domain = 3;
+   */
+  domain: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest.
+ * Use `create(VerifyDomainOwnershipRequestSchema)` to create a new message.
+ */
+export const VerifyDomainOwnershipRequestSchema: GenMessage<VerifyDomainOwnershipRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 102);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse
+ */
+export type VerifyDomainOwnershipResponse = Message<"obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse"> & {
+  /**
+   * @generated from field: string domain = 1;
+   */
+  domain: string;
+
+  /**
+   * @generated from field: bool verified = 2;
+   */
+  verified: boolean;
+
+  /**
+   * "verified", "failed", "pending"
+   *
+   * @generated from field: string status = 3;
+   */
+  status: string;
+
+  /**
+   * Error message if verification failed
+   *
+   * @generated from field: optional string message = 4;
+   */
+  message?: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse.
+ * Use `create(VerifyDomainOwnershipResponseSchema)` to create a new message.
+ */
+export const VerifyDomainOwnershipResponseSchema: GenMessage<VerifyDomainOwnershipResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 103);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.CustomDomain
+ */
+export type CustomDomain = Message<"obiente.cloud.deployments.v1.CustomDomain"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * @generated from field: string domain = 3;
+   */
+  domain: string;
+
+  /**
+   * @generated from field: bool verified = 4;
+   */
+  verified: boolean;
+
+  /**
+   * "pending", "issued", "failed"
+   *
+   * @generated from field: string status = 5;
+   */
+  status: string;
+
+  /**
+   * TXT record to publish for the ACME DNS-01 challenge
+   *
+   * @generated from field: string challenge_record_name = 6;
+   */
+  challengeRecordName: string;
+
+  /**
+   * @generated from field: string challenge_record_value = 7;
+   */
+  challengeRecordValue: string;
+
+  /**
+   * @generated from field: optional string tls_cert_id = 8;
+   */
+  tlsCertId?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp certificate_expires_at = 9;
+   */
+  certificateExpiresAt?: Timestamp;
+
+  /**
+   * @generated from field: optional string last_error = 10;
+   */
+  lastError?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.CustomDomain.
+ * Use `create(CustomDomainSchema)` to create a new message.
+ */
+export const CustomDomainSchema: GenMessage<CustomDomain> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 104);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.CreateCustomDomainRequest
+ */
+export type CreateCustomDomainRequest = Message<"obiente.cloud.deployments.v1.CreateCustomDomainRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * @generated from field: string domain = 3;
+   */
+  domain: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.CreateCustomDomainRequest.
+ * Use `create(CreateCustomDomainRequestSchema)` to create a new message.
+ */
+export const CreateCustomDomainRequestSchema: GenMessage<CreateCustomDomainRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 105);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.CreateCustomDomainResponse
+ */
+export type CreateCustomDomainResponse = Message<"obiente.cloud.deployments.v1.CreateCustomDomainResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.deployments.v1.CustomDomain custom_domain = 1;
+   */
+  customDomain?: CustomDomain;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.CreateCustomDomainResponse.
+ * Use `create(CreateCustomDomainResponseSchema)` to create a new message.
+ */
+export const CreateCustomDomainResponseSchema: GenMessage<CreateCustomDomainResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 106);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.VerifyCustomDomainRequest
+ */
+export type VerifyCustomDomainRequest = Message<"obiente.cloud.deployments.v1.VerifyCustomDomainRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * @generated from field: string domain = 3;
+   */
+  domain: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.VerifyCustomDomainRequest.
+ * Use `create(VerifyCustomDomainRequestSchema)` to create a new message.
+ */
+export const VerifyCustomDomainRequestSchema: GenMessage<VerifyCustomDomainRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 107);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.VerifyCustomDomainResponse
+ */
+export type VerifyCustomDomainResponse = Message<"obiente.cloud.deployments.v1.VerifyCustomDomainResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.deployments.v1.CustomDomain custom_domain = 1;
+   */
+  customDomain?: CustomDomain;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.VerifyCustomDomainResponse.
+ * Use `create(VerifyCustomDomainResponseSchema)` to create a new message.
+ */
+export const VerifyCustomDomainResponseSchema: GenMessage<VerifyCustomDomainResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 108);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckRequest
+ */
+export type UpdateDeploymentHealthCheckRequest = Message<"obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * Disables probing when false; the other fields are then ignored
+   *
+   * @generated from field: bool enabled = 3;
+   */
+  enabled: boolean;
+
+  /**
+   * HTTP path to GET (default: "/")
+   *
+   * @generated from field: string path = 4;
+   */
+  path: string;
+
+  /**
+   * Container port (default: the deployment port)
+   *
+   * @generated from field: optional int32 port = 5;
+   */
+  port?: number;
+
+  /**
+   * Default: 30
+   *
+   * @generated from field: int32 interval_seconds = 6;
+   */
+  intervalSeconds: number;
+
+  /**
+   * Default: 5
+   *
+   * @generated from field: int32 timeout_seconds = 7;
+   */
+  timeoutSeconds: number;
+
+  /**
+   * Default: 3
+   *
+   * @generated from field: int32 retries = 8;
+   */
+  retries: number;
+
+  /**
+   * Expected HTTP status (default: any 2xx or 3xx)
+   *
+   * @generated from field: optional int32 expected_status = 9;
+   */
+  expectedStatus?: number;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckRequest.
+ * Use `create(UpdateDeploymentHealthCheckRequestSchema)` to create a new message.
+ */
+export const UpdateDeploymentHealthCheckRequestSchema: GenMessage<UpdateDeploymentHealthCheckRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 109);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckResponse
+ */
+export type UpdateDeploymentHealthCheckResponse = Message<"obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.deployments.v1.Deployment deployment = 1;
+   */
+  deployment?: Deployment;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.UpdateDeploymentHealthCheckResponse.
+ * Use `create(UpdateDeploymentHealthCheckResponseSchema)` to create a new message.
+ */
+export const UpdateDeploymentHealthCheckResponseSchema: GenMessage<UpdateDeploymentHealthCheckResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 110);
+
+/**
+ * AffinityRule places a deployment relative to the organization's deployments tagged label_key=label_value
+ *
+ * @generated from message obiente.cloud.deployments.v1.AffinityRule
+ */
+export type AffinityRule = Message<"obiente.cloud.deployments.v1.AffinityRule"> & {
+  /**
+   * "affinity" prefers nodes already running a matching deployment, "anti-affinity" excludes them
+   *
+   * @generated from field: string rule_type = 1;
+   */
+  ruleType: string;
+
+  /**
+   * @generated from field: string label_key = 2;
+   */
+  labelKey: string;
+
+  /**
+   * @generated from field: string label_value = 3;
+   */
+  labelValue: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.AffinityRule.
+ * Use `create(AffinityRuleSchema)` to create a new message.
+ */
+export const AffinityRuleSchema: GenMessage<AffinityRule> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 111);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.SetDeploymentAffinityRulesRequest
+ */
+export type SetDeploymentAffinityRulesRequest = Message<"obiente.cloud.deployments.v1.SetDeploymentAffinityRulesRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * Replaces all existing rules; empty clears them
+   *
+   * @generated from field: repeated obiente.cloud.deployments.v1.AffinityRule rules = 3;
+   */
+  rules: AffinityRule[];
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.SetDeploymentAffinityRulesRequest.
+ * Use `create(SetDeploymentAffinityRulesRequestSchema)` to create a new message.
+ */
+export const SetDeploymentAffinityRulesRequestSchema: GenMessage<SetDeploymentAffinityRulesRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 112);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.SetDeploymentAffinityRulesResponse
+ */
+export type SetDeploymentAffinityRulesResponse = Message<"obiente.cloud.deployments.v1.SetDeploymentAffinityRulesResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.deployments.v1.AffinityRule rules = 1;
+   */
+  rules: AffinityRule[];
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.SetDeploymentAffinityRulesResponse.
+ * Use `create(SetDeploymentAffinityRulesResponseSchema)` to create a new message.
+ */
+export const SetDeploymentAffinityRulesResponseSchema: GenMessage<SetDeploymentAffinityRulesResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 113);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.GetDeploymentAffinityRulesRequest
+ */
+export type GetDeploymentAffinityRulesRequest = Message<"obiente.cloud.deployments.v1.GetDeploymentAffinityRulesRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.GetDeploymentAffinityRulesRequest.
+ * Use `create(GetDeploymentAffinityRulesRequestSchema)` to create a new message.
+ */
+export const GetDeploymentAffinityRulesRequestSchema: GenMessage<GetDeploymentAffinityRulesRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 114);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.GetDeploymentAffinityRulesResponse
+ */
+export type GetDeploymentAffinityRulesResponse = Message<"obiente.cloud.deployments.v1.GetDeploymentAffinityRulesResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.deployments.v1.AffinityRule rules = 1;
+   */
+  rules: AffinityRule[];
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.GetDeploymentAffinityRulesResponse.
+ * Use `create(GetDeploymentAffinityRulesResponseSchema)` to create a new message.
+ */
+export const GetDeploymentAffinityRulesResponseSchema: GenMessage<GetDeploymentAffinityRulesResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 115);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.ScaleSchedule
+ */
+export type ScaleSchedule = Message<"obiente.cloud.deployments.v1.ScaleSchedule"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * Five fields (minute hour day-of-month month day-of-week), evaluated in UTC
+   *
+   * @generated from field: string cron_expression = 3;
+   */
+  cronExpression: string;
+
+  /**
+   * 0 stops the deployment
+   *
+   * @generated from field: int32 replica_count = 4;
+   */
+  replicaCount: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp next_run_at = 5;
+   */
+  nextRunAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_run_at = 6;
+   */
+  lastRunAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.ScaleSchedule.
+ * Use `create(ScaleScheduleSchema)` to create a new message.
+ */
+export const ScaleScheduleSchema: GenMessage<ScaleSchedule> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 116);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.CreateScaleScheduleRequest
+ */
+export type CreateScaleScheduleRequest = Message<"obiente.cloud.deployments.v1.CreateScaleScheduleRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * @generated from field: string cron_expression = 3;
+   */
+  cronExpression: string;
+
+  /**
+   * Between 0 and the plan's maximum replicas per deployment
+   *
+   * @generated from field: int32 replica_count = 4;
+   */
+  replicaCount: number;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.CreateScaleScheduleRequest.
+ * Use `create(CreateScaleScheduleRequestSchema)` to create a new message.
+ */
+export const CreateScaleScheduleRequestSchema: GenMessage<CreateScaleScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 117);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.CreateScaleScheduleResponse
+ */
+export type CreateScaleScheduleResponse = Message<"obiente.cloud.deployments.v1.CreateScaleScheduleResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.deployments.v1.ScaleSchedule schedule = 1;
+   */
+  schedule?: ScaleSchedule;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.CreateScaleScheduleResponse.
+ * Use `create(CreateScaleScheduleResponseSchema)` to create a new message.
+ */
+export const CreateScaleScheduleResponseSchema: GenMessage<CreateScaleScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 118);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.DeleteScaleScheduleRequest
+ */
+export type DeleteScaleScheduleRequest = Message<"obiente.cloud.deployments.v1.DeleteScaleScheduleRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * @generated from field: string schedule_id = 3;
+   */
+  scheduleId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.DeleteScaleScheduleRequest.
+ * Use `create(DeleteScaleScheduleRequestSchema)` to create a new message.
+ */
+export const DeleteScaleScheduleRequestSchema: GenMessage<DeleteScaleScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 119);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.DeleteScaleScheduleResponse
+ */
+export type DeleteScaleScheduleResponse = Message<"obiente.cloud.deployments.v1.DeleteScaleScheduleResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.DeleteScaleScheduleResponse.
+ * Use `create(DeleteScaleScheduleResponseSchema)` to create a new message.
+ */
+export const DeleteScaleScheduleResponseSchema: GenMessage<DeleteScaleScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 120);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.ListScaleSchedulesRequest
+ */
+export type ListScaleSchedulesRequest = Message<"obiente.cloud.deployments.v1.ListScaleSchedulesRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.ListScaleSchedulesRequest.
+ * Use `create(ListScaleSchedulesRequestSchema)` to create a new message.
+ */
+export const ListScaleSchedulesRequestSchema: GenMessage<ListScaleSchedulesRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 121);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.ListScaleSchedulesResponse
+ */
+export type ListScaleSchedulesResponse = Message<"obiente.cloud.deployments.v1.ListScaleSchedulesResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.deployments.v1.ScaleSchedule schedules = 1;
+   */
+  schedules: ScaleSchedule[];
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.ListScaleSchedulesResponse.
+ * Use `create(ListScaleSchedulesResponseSchema)` to create a new message.
+ */
+export const ListScaleSchedulesResponseSchema: GenMessage<ListScaleSchedulesResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 122);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.AutoScalePolicy
+ */
+export type AutoScalePolicy = Message<"obiente.cloud.deployments.v1.AutoScalePolicy"> & {
+  /**
+   * @generated from field: string deployment_id = 1;
+   */
+  deploymentId: string;
+
+  /**
+   * At least 1
+   *
+   * @generated from field: int32 min_replicas = 2;
+   */
+  minReplicas: number;
+
+  /**
+   * At most the plan's maximum replicas per deployment
+   *
+   * @generated from field: int32 max_replicas = 3;
+   */
+  maxReplicas: number;
+
+  /**
+   * Average CPU utilization per replica to hold; 100 is one full core
+   *
+   * @generated from field: double target_cpu_percent = 4;
+   */
+  targetCpuPercent: number;
+
+  /**
+   * Minimum time after a scaling before scaling up again
+   *
+   * @generated from field: int32 scale_up_cooldown_seconds = 5;
+   */
+  scaleUpCooldownSeconds: number;
+
+  /**
+   * Minimum time after a scaling before scaling down again
+   *
+   * @generated from field: int32 scale_down_cooldown_seconds = 6;
+   */
+  scaleDownCooldownSeconds: number;
+
+  /**
+   * @generated from field: bool enabled = 7;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_scaled_at = 8;
+   */
+  lastScaledAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 9;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.AutoScalePolicy.
+ * Use `create(AutoScalePolicySchema)` to create a new message.
+ */
+export const AutoScalePolicySchema: GenMessage<AutoScalePolicy> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 123);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.SetAutoScalePolicyRequest
+ */
+export type SetAutoScalePolicyRequest = Message<"obiente.cloud.deployments.v1.SetAutoScalePolicyRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+
+  /**
+   * @generated from field: int32 min_replicas = 3;
+   */
+  minReplicas: number;
+
+  /**
+   * @generated from field: int32 max_replicas = 4;
+   */
+  maxReplicas: number;
+
+  /**
+   * @generated from field: double target_cpu_percent = 5;
+   */
+  targetCpuPercent: number;
+
+  /**
+   * @generated from field: int32 scale_up_cooldown_seconds = 6;
+   */
+  scaleUpCooldownSeconds: number;
+
+  /**
+   * @generated from field: int32 scale_down_cooldown_seconds = 7;
+   */
+  scaleDownCooldownSeconds: number;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.SetAutoScalePolicyRequest.
+ * Use `create(SetAutoScalePolicyRequestSchema)` to create a new message.
+ */
+export const SetAutoScalePolicyRequestSchema: GenMessage<SetAutoScalePolicyRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 124);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.SetAutoScalePolicyResponse
+ */
+export type SetAutoScalePolicyResponse = Message<"obiente.cloud.deployments.v1.SetAutoScalePolicyResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.deployments.v1.AutoScalePolicy policy = 1;
+   */
+  policy?: AutoScalePolicy;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.SetAutoScalePolicyResponse.
+ * Use `create(SetAutoScalePolicyResponseSchema)` to create a new message.
+ */
+export const SetAutoScalePolicyResponseSchema: GenMessage<SetAutoScalePolicyResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 125);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.GetAutoScalePolicyRequest
+ */
+export type GetAutoScalePolicyRequest = Message<"obiente.cloud.deployments.v1.GetAutoScalePolicyRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.GetAutoScalePolicyRequest.
+ * Use `create(GetAutoScalePolicyRequestSchema)` to create a new message.
+ */
+export const GetAutoScalePolicyRequestSchema: GenMessage<GetAutoScalePolicyRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 126);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.GetAutoScalePolicyResponse
+ */
+export type GetAutoScalePolicyResponse = Message<"obiente.cloud.deployments.v1.GetAutoScalePolicyResponse"> & {
+  /**
+   * Unset when the deployment never had a policy
+   *
+   * @generated from field: optional obiente.cloud.deployments.v1.AutoScalePolicy policy = 1;
+   */
+  policy?: AutoScalePolicy;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.GetAutoScalePolicyResponse.
+ * Use `create(GetAutoScalePolicyResponseSchema)` to create a new message.
+ */
+export const GetAutoScalePolicyResponseSchema: GenMessage<GetAutoScalePolicyResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 127);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.DisableAutoScaleRequest
+ */
+export type DisableAutoScaleRequest = Message<"obiente.cloud.deployments.v1.DisableAutoScaleRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.DisableAutoScaleRequest.
+ * Use `create(DisableAutoScaleRequestSchema)` to create a new message.
+ */
+export const DisableAutoScaleRequestSchema: GenMessage<DisableAutoScaleRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 128);
+
+/**
+ * @generated from message obiente.cloud.deployments.v1.DisableAutoScaleResponse
+ */
+export type DisableAutoScaleResponse = Message<"obiente.cloud.deployments.v1.DisableAutoScaleResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
    */
-  domain: string;
+  success: boolean;
 };
 
 /**
- * Describes the message obiente.cloud.deployments.v1.GetDomainVerificationTokenRequest.
- * Use `create(GetDomainVerificationTokenRequestSchema)` to create a new message.
+ * Describes the message obiente.cloud.deployments.v1.DisableAutoScaleResponse.
+ * Use `create(DisableAutoScaleResponseSchema)` to create a new message.
  */
-export const GetDomainVerificationTokenRequestSchema: GenMessage<GetDomainVerificationTokenRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 86);
+export const DisableAutoScaleResponseSchema: GenMessage<DisableAutoScaleResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 129);
 
 /**
- * @generated from message obiente.cloud.deployments.v1.GetDomainVerificationTokenResponse
+ * @generated from message obiente.cloud.deployments.v1.DeploymentRegionStatus
  */
-export type GetDomainVerificationTokenResponse = Message<"obiente.cloud.deployments.v1.GetDomainVerificationTokenResponse"> & {
+export type DeploymentRegionStatus = Message<"obiente.cloud.deployments.v1.DeploymentRegionStatus"> & {
   /**
-   * @generated from field: string domain = 1;
+   * @generated from field: string region = 1;
    */
-  domain: string;
+  region: string;
 
   /**
-   * @generated from field: string token = 2;
+   * The primary region; the others are replicas
+   *
+   * @generated from field: bool primary = 2;
    */
-  token: string;
+  primary: boolean;
 
   /**
-   * e.g., "_obiente-verification.example.com"
+   * pending, provisioned or failed
    *
-   * @generated from field: string txt_record_name = 3;
+   * @generated from field: string status = 3;
    */
-  txtRecordName: string;
+  status: string;
 
   /**
-   * e.g., "obiente-verification=abc123..."
+   * healthy, unhealthy or unknown
    *
-   * @generated from field: string txt_record_value = 4;
+   * @generated from field: string health_status = 4;
    */
-  txtRecordValue: string;
+  healthStatus: string;
 
   /**
-   * "pending", "verified", "failed", "expired"
-   *
-   * @generated from field: string status = 5;
+   * @generated from field: string container_id = 5;
    */
-  status: string;
-};
+  containerId: string;
 
-/**
- * Describes the message obiente.cloud.deployments.v1.GetDomainVerificationTokenResponse.
- * Use `create(GetDomainVerificationTokenResponseSchema)` to create a new message.
- */
-export const GetDomainVerificationTokenResponseSchema: GenMessage<GetDomainVerificationTokenResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 87);
+  /**
+   * @generated from field: string traefik_ip = 6;
+   */
+  traefikIp: string;
 
-/**
- * @generated from message obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest
- */
-export type VerifyDomainOwnershipRequest = Message<"obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest"> & {
   /**
-   * @generated from field: string organization_id = 1;
+   * @generated from field: string node_id = 7;
    */
-  organizationId: string;
+  nodeId: string;
 
   /**
-   * @generated from field: string deployment_id = 2;
+   * Why provisioning failed
+   *
+   * @generated from field: optional string error = 8;
    */
-  deploymentId: string;
+  error?: string;
 
   /**
-   * @generated from field: string domain = 3;
+   * @generated from field: google.protobuf.Timestamp updated_at = 9;
    */
-  domain: string;
+  updatedAt?: Timestamp;
 };
 
 /**
- * Describes the message obiente.cloud.deployments.v1.VerifyDomainOwnershipRequest.
- * Use `create(VerifyDomainOwnershipRequestSchema)` to create a new message.
+ * Describes the message obiente.cloud.deployments.v1.DeploymentRegionStatus.
+ * Use `create(DeploymentRegionStatusSchema)` to create a new message.
  */
-export const VerifyDomainOwnershipRequestSchema: GenMessage<VerifyDomainOwnershipRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 88);
+export const DeploymentRegionStatusSchema: GenMessage<DeploymentRegionStatus> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 130);
 
 /**
- * @generated from message obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse
+ * @generated from message obiente.cloud.deployments.v1.GetDeploymentRegionStatusRequest
  */
-export type VerifyDomainOwnershipResponse = Message<"obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse"> & {
+export type GetDeploymentRegionStatusRequest = Message<"obiente.cloud.deployments.v1.GetDeploymentRegionStatusRequest"> & {
   /**
-   * @generated from field: string domain = 1;
+   * @generated from field: string organization_id = 1;
    */
-  domain: string;
+  organizationId: string;
 
   /**
-   * @generated from field: bool verified = 2;
+   * @generated from field: string deployment_id = 2;
    */
-  verified: boolean;
+  deploymentId: string;
+};
 
-  /**
-   * "verified", "failed", "pending"
-   *
-   * @generated from field: string status = 3;
-   */
-  status: string;
+/**
+ * Describes the message obiente.cloud.deployments.v1.GetDeploymentRegionStatusRequest.
+ * Use `create(GetDeploymentRegionStatusRequestSchema)` to create a new message.
+ */
+export const GetDeploymentRegionStatusRequestSchema: GenMessage<GetDeploymentRegionStatusRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 131);
 
+/**
+ * @generated from message obiente.cloud.deployments.v1.GetDeploymentRegionStatusResponse
+ */
+export type GetDeploymentRegionStatusResponse = Message<"obiente.cloud.deployments.v1.GetDeploymentRegionStatusResponse"> & {
   /**
-   * Error message if verification failed
-   *
-   * @generated from field: optional string message = 4;
+   * @generated from field: repeated obiente.cloud.deployments.v1.DeploymentRegionStatus regions = 1;
    */
-  message?: string;
+  regions: DeploymentRegionStatus[];
 };
 
 /**
- * Describes the message obiente.cloud.deployments.v1.VerifyDomainOwnershipResponse.
- * Use `create(VerifyDomainOwnershipResponseSchema)` to create a new message.
+ * Describes the message obiente.cloud.deployments.v1.GetDeploymentRegionStatusResponse.
+ * Use `create(GetDeploymentRegionStatusResponseSchema)` to create a new message.
  */
-export const VerifyDomainOwnershipResponseSchema: GenMessage<VerifyDomainOwnershipResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 89);
+export const GetDeploymentRegionStatusResponseSchema: GenMessage<GetDeploymentRegionStatusResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 132);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentMetricsRequest
@@ -3101,7 +4369,7 @@ export type GetDeploymentMetricsRequest = Message<"obiente.cloud.deployments.v1.
  * Use `create(GetDeploymentMetricsRequestSchema)` to create a new message.
  */
 export const GetDeploymentMetricsRequestSchema: GenMessage<GetDeploymentMetricsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 90);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 133);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentMetricsResponse
@@ -3118,7 +4386,7 @@ export type GetDeploymentMetricsResponse = Message<"obiente.cloud.deployments.v1
  * Use `create(GetDeploymentMetricsResponseSchema)` to create a new message.
  */
 export const GetDeploymentMetricsResponseSchema: GenMessage<GetDeploymentMetricsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 91);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 134);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.StreamDeploymentMetricsRequest
@@ -3169,7 +4437,7 @@ export type StreamDeploymentMetricsRequest = Message<"obiente.cloud.deployments.
  * Use `create(StreamDeploymentMetricsRequestSchema)` to create a new message.
  */
 export const StreamDeploymentMetricsRequestSchema: GenMessage<StreamDeploymentMetricsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 92);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 135);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.DeploymentMetric
@@ -3261,7 +4529,7 @@ export type DeploymentMetric = Message<"obiente.cloud.deployments.v1.DeploymentM
  * Use `create(DeploymentMetricSchema)` to create a new message.
  */
 export const DeploymentMetricSchema: GenMessage<DeploymentMetric> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 93);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 136);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentUsageRequest
@@ -3290,7 +4558,7 @@ export type GetDeploymentUsageRequest = Message<"obiente.cloud.deployments.v1.Ge
  * Use `create(GetDeploymentUsageRequestSchema)` to create a new message.
  */
 export const GetDeploymentUsageRequestSchema: GenMessage<GetDeploymentUsageRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 94);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 137);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetDeploymentUsageResponse
@@ -3329,7 +4597,7 @@ export type GetDeploymentUsageResponse = Message<"obiente.cloud.deployments.v1.G
  * Use `create(GetDeploymentUsageResponseSchema)` to create a new message.
  */
 export const GetDeploymentUsageResponseSchema: GenMessage<GetDeploymentUsageResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 95);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 138);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.DeploymentUsageMetrics
@@ -3410,7 +4678,7 @@ export type DeploymentUsageMetrics = Message<"obiente.cloud.deployments.v1.Deplo
  * Use `create(DeploymentUsageMetricsSchema)` to create a new message.
  */
 export const DeploymentUsageMetricsSchema: GenMessage<DeploymentUsageMetrics> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 96);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 139);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.Deployment
@@ -3718,6 +4986,48 @@ export type Deployment = Message<"obiente.cloud.deployments.v1.Deployment"> & {
    * @generated from field: optional obiente.cloud.deployments.v1.DockerfileBuildOptions dockerfile_build_options = 48;
    */
   dockerfileBuildOptions?: DockerfileBuildOptions;
+
+  /**
+   * Color ("blue" or "green") of the containers currently serving traffic
+   *
+   * @generated from field: string active_color = 49;
+   */
+  activeColor: string;
+
+  /**
+   * Ephemeral preview environment created for a GitHub pull request
+   *
+   * @generated from field: bool is_preview = 50;
+   */
+  isPreview: boolean;
+
+  /**
+   * HTTP health check probe interval (unset when the health monitor does not probe)
+   *
+   * @generated from field: optional int32 healthcheck_interval_seconds = 51;
+   */
+  healthcheckIntervalSeconds?: number;
+
+  /**
+   * HTTP health check request timeout
+   *
+   * @generated from field: optional int32 healthcheck_timeout_seconds = 52;
+   */
+  healthcheckTimeoutSeconds?: number;
+
+  /**
+   * Consecutive failed probes before the deployment is unhealthy
+   *
+   * @generated from field: optional int32 healthcheck_retries = 53;
+   */
+  healthcheckRetries?: number;
+
+  /**
+   * Redeploys drain in-flight requests from the old containers before removing them
+   *
+   * @generated from field: bool zero_downtime_deploy = 54;
+   */
+  zeroDowntimeDeploy: boolean;
 };
 
 /**
@@ -3725,7 +5035,7 @@ export type Deployment = Message<"obiente.cloud.deployments.v1.Deployment"> & {
  * Use `create(DeploymentSchema)` to create a new message.
  */
 export const DeploymentSchema: GenMessage<Deployment> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 97);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 140);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.DockerfileVolume
@@ -3758,7 +5068,7 @@ export type DockerfileVolume = Message<"obiente.cloud.deployments.v1.DockerfileV
  * Use `create(DockerfileVolumeSchema)` to create a new message.
  */
 export const DockerfileVolumeSchema: GenMessage<DockerfileVolume> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 98);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 141);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.DockerfileBuildOptions
@@ -3805,7 +5115,7 @@ export type DockerfileBuildOptions = Message<"obiente.cloud.deployments.v1.Docke
  * Use `create(DockerfileBuildOptionsSchema)` to create a new message.
  */
 export const DockerfileBuildOptionsSchema: GenMessage<DockerfileBuildOptions> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 99);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 142);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ListDeploymentContainersRequest
@@ -3827,7 +5137,7 @@ export type ListDeploymentContainersRequest = Message<"obiente.cloud.deployments
  * Use `create(ListDeploymentContainersRequestSchema)` to create a new message.
  */
 export const ListDeploymentContainersRequestSchema: GenMessage<ListDeploymentContainersRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 100);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 143);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ListDeploymentContainersResponse
@@ -3844,7 +5154,7 @@ export type ListDeploymentContainersResponse = Message<"obiente.cloud.deployment
  * Use `create(ListDeploymentContainersResponseSchema)` to create a new message.
  */
 export const ListDeploymentContainersResponseSchema: GenMessage<ListDeploymentContainersResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 101);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 144);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.DeploymentContainer
@@ -3900,7 +5210,7 @@ export type DeploymentContainer = Message<"obiente.cloud.deployments.v1.Deployme
  * Use `create(DeploymentContainerSchema)` to create a new message.
  */
 export const DeploymentContainerSchema: GenMessage<DeploymentContainer> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 102);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 145);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.StreamContainerLogsRequest
@@ -3936,7 +5246,7 @@ export type StreamContainerLogsRequest = Message<"obiente.cloud.deployments.v1.S
  * Use `create(StreamContainerLogsRequestSchema)` to create a new message.
  */
 export const StreamContainerLogsRequestSchema: GenMessage<StreamContainerLogsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 103);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 146);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.StartContainerRequest
@@ -3965,7 +5275,7 @@ export type StartContainerRequest = Message<"obiente.cloud.deployments.v1.StartC
  * Use `create(StartContainerRequestSchema)` to create a new message.
  */
 export const StartContainerRequestSchema: GenMessage<StartContainerRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 104);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 147);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.StartContainerResponse
@@ -3987,7 +5297,7 @@ export type StartContainerResponse = Message<"obiente.cloud.deployments.v1.Start
  * Use `create(StartContainerResponseSchema)` to create a new message.
  */
 export const StartContainerResponseSchema: GenMessage<StartContainerResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 105);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 148);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.StopContainerRequest
@@ -4016,7 +5326,7 @@ export type StopContainerRequest = Message<"obiente.cloud.deployments.v1.StopCon
  * Use `create(StopContainerRequestSchema)` to create a new message.
  */
 export const StopContainerRequestSchema: GenMessage<StopContainerRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 106);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 149);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.StopContainerResponse
@@ -4038,7 +5348,7 @@ export type StopContainerResponse = Message<"obiente.cloud.deployments.v1.StopCo
  * Use `create(StopContainerResponseSchema)` to create a new message.
  */
 export const StopContainerResponseSchema: GenMessage<StopContainerResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 107);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 150);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.RestartContainerRequest
@@ -4067,7 +5377,7 @@ export type RestartContainerRequest = Message<"obiente.cloud.deployments.v1.Rest
  * Use `create(RestartContainerRequestSchema)` to create a new message.
  */
 export const RestartContainerRequestSchema: GenMessage<RestartContainerRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 108);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 151);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.RestartContainerResponse
@@ -4089,7 +5399,7 @@ export type RestartContainerResponse = Message<"obiente.cloud.deployments.v1.Res
  * Use `create(RestartContainerResponseSchema)` to create a new message.
  */
 export const RestartContainerResponseSchema: GenMessage<RestartContainerResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 109);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 152);
 
 /**
  * Build history messages
@@ -4127,7 +5437,7 @@ export type ListBuildsRequest = Message<"obiente.cloud.deployments.v1.ListBuilds
  * Use `create(ListBuildsRequestSchema)` to create a new message.
  */
 export const ListBuildsRequestSchema: GenMessage<ListBuildsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 110);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 153);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.ListBuildsResponse
@@ -4151,7 +5461,7 @@ export type ListBuildsResponse = Message<"obiente.cloud.deployments.v1.ListBuild
  * Use `create(ListBuildsResponseSchema)` to create a new message.
  */
 export const ListBuildsResponseSchema: GenMessage<ListBuildsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 111);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 154);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetBuildRequest
@@ -4178,7 +5488,7 @@ export type GetBuildRequest = Message<"obiente.cloud.deployments.v1.GetBuildRequ
  * Use `create(GetBuildRequestSchema)` to create a new message.
  */
 export const GetBuildRequestSchema: GenMessage<GetBuildRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 112);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 155);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetBuildResponse
@@ -4195,7 +5505,7 @@ export type GetBuildResponse = Message<"obiente.cloud.deployments.v1.GetBuildRes
  * Use `create(GetBuildResponseSchema)` to create a new message.
  */
 export const GetBuildResponseSchema: GenMessage<GetBuildResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 113);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 156);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetBuildLogsRequest
@@ -4236,7 +5546,7 @@ export type GetBuildLogsRequest = Message<"obiente.cloud.deployments.v1.GetBuild
  * Use `create(GetBuildLogsRequestSchema)` to create a new message.
  */
 export const GetBuildLogsRequestSchema: GenMessage<GetBuildLogsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 114);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 157);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.GetBuildLogsResponse
@@ -4260,7 +5570,7 @@ export type GetBuildLogsResponse = Message<"obiente.cloud.deployments.v1.GetBuil
  * Use `create(GetBuildLogsResponseSchema)` to create a new message.
  */
 export const GetBuildLogsResponseSchema: GenMessage<GetBuildLogsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 115);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 158);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.RevertToBuildRequest
@@ -4287,7 +5597,7 @@ export type RevertToBuildRequest = Message<"obiente.cloud.deployments.v1.RevertT
  * Use `create(RevertToBuildRequestSchema)` to create a new message.
  */
 export const RevertToBuildRequestSchema: GenMessage<RevertToBuildRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 116);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 159);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.RevertToBuildResponse
@@ -4313,7 +5623,7 @@ export type RevertToBuildResponse = Message<"obiente.cloud.deployments.v1.Revert
  * Use `create(RevertToBuildResponseSchema)` to create a new message.
  */
 export const RevertToBuildResponseSchema: GenMessage<RevertToBuildResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 117);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 160);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.DeleteBuildRequest
@@ -4340,7 +5650,7 @@ export type DeleteBuildRequest = Message<"obiente.cloud.deployments.v1.DeleteBui
  * Use `create(DeleteBuildRequestSchema)` to create a new message.
  */
 export const DeleteBuildRequestSchema: GenMessage<DeleteBuildRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 118);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 161);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.DeleteBuildResponse
@@ -4357,7 +5667,7 @@ export type DeleteBuildResponse = Message<"obiente.cloud.deployments.v1.DeleteBu
  * Use `create(DeleteBuildResponseSchema)` to create a new message.
  */
 export const DeleteBuildResponseSchema: GenMessage<DeleteBuildResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 119);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 162);
 
 /**
  * @generated from message obiente.cloud.deployments.v1.Build
@@ -4502,6 +5812,13 @@ export type Build = Message<"obiente.cloud.deployments.v1.Build"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 24;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * Dockerfile lint findings (Dockerfile builds only)
+   *
+   * @generated from field: repeated obiente.cloud.deployments.v1.DockerfileLintWarning lint_warnings = 25;
+   */
+  lintWarnings: DockerfileLintWarning[];
 };
 
 /**
@@ -4509,7 +5826,41 @@ export type Build = Message<"obiente.cloud.deployments.v1.Build"> & {
  * Use `create(BuildSchema)` to create a new message.
  */
 export const BuildSchema: GenMessage<Build> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 120);
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 163);
+
+/**
+ * DockerfileLintWarning is a problem the Dockerfile linter found before the image was built.
+ * Builds fail on lint warnings only when the deployments service runs with BLOCK_ON_LINT=true.
+ *
+ * @generated from message obiente.cloud.deployments.v1.DockerfileLintWarning
+ */
+export type DockerfileLintWarning = Message<"obiente.cloud.deployments.v1.DockerfileLintWarning"> & {
+  /**
+   * latest-base-image, missing-expose, plaintext-secret, copy-before-workdir or root-user
+   *
+   * @generated from field: string rule = 1;
+   */
+  rule: string;
+
+  /**
+   * 1-based Dockerfile line, 0 when the warning is about the whole file
+   *
+   * @generated from field: int32 line = 2;
+   */
+  line: number;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message obiente.cloud.deployments.v1.DockerfileLintWarning.
+ * Use `create(DockerfileLintWarningSchema)` to create a new message.
+ */
+export const DockerfileLintWarningSchema: GenMessage<DockerfileLintWarning> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_deployments_v1_deployment_service, 164);
 
 /**
  * Enumerations for typed fields used by the frontend
@@ -5016,6 +6367,27 @@ export const DeploymentService: GenService<{
     input: typeof RestartDeploymentRequestSchema;
     output: typeof RestartDeploymentResponseSchema;
   },
+  /**
+   * Roll back to a previous deployment version, or roll back an in-progress blue-green deployment
+   * (keeping the active containers running) when no version is given
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.RollbackDeployment
+   */
+  rollbackDeployment: {
+    methodKind: "unary";
+    input: typeof RollbackDeploymentRequestSchema;
+    output: typeof RollbackDeploymentResponseSchema;
+  },
+  /**
+   * List the retained versions of a deployment that it can be rolled back to
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.ListDeploymentVersions
+   */
+  listDeploymentVersions: {
+    methodKind: "unary";
+    input: typeof ListDeploymentVersionsRequestSchema;
+    output: typeof ListDeploymentVersionsResponseSchema;
+  },
   /**
    * Scale a deployment
    *
@@ -5026,6 +6398,16 @@ export const DeploymentService: GenService<{
     input: typeof ScaleDeploymentRequestSchema;
     output: typeof ScaleDeploymentResponseSchema;
   },
+  /**
+   * Set the CPU and memory limits of a deployment's containers; running containers are updated in place
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.SetResourceLimits
+   */
+  setResourceLimits: {
+    methodKind: "unary";
+    input: typeof SetResourceLimitsRequestSchema;
+    output: typeof SetResourceLimitsResponseSchema;
+  },
   /**
    * Get deployment environment variables
    *
@@ -5046,6 +6428,16 @@ export const DeploymentService: GenService<{
     input: typeof UpdateDeploymentEnvVarsRequestSchema;
     output: typeof UpdateDeploymentEnvVarsResponseSchema;
   },
+  /**
+   * Rotate the organization's environment variable encryption key and re-encrypt its deployments
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.RotateEnvKey
+   */
+  rotateEnvKey: {
+    methodKind: "unary";
+    input: typeof RotateEnvKeyRequestSchema;
+    output: typeof RotateEnvKeyResponseSchema;
+  },
   /**
    * Get deployment docker compose configuration
    *
@@ -5168,6 +6560,26 @@ export const DeploymentService: GenService<{
     input: typeof ListAvailableGitHubIntegrationsRequestSchema;
     output: typeof ListAvailableGitHubIntegrationsResponseSchema;
   },
+  /**
+   * Replace the SSH deploy keys of the repositories deployed through a GitHub integration
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.RotateDeployKey
+   */
+  rotateDeployKey: {
+    methodKind: "unary";
+    input: typeof RotateDeployKeyRequestSchema;
+    output: typeof RotateDeployKeyResponseSchema;
+  },
+  /**
+   * Replace the HMAC secrets of the repository webhooks that deliver to Obiente for a GitHub integration
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.RotateWebhookSecret
+   */
+  rotateWebhookSecret: {
+    methodKind: "unary";
+    input: typeof RotateWebhookSecretRequestSchema;
+    output: typeof RotateWebhookSecretResponseSchema;
+  },
   /**
    * Terminal access
    * Stream terminal with bidirectional streaming (replaces StreamTerminalOutput + SendTerminalInput)
@@ -5356,6 +6768,126 @@ export const DeploymentService: GenService<{
     input: typeof VerifyDomainOwnershipRequestSchema;
     output: typeof VerifyDomainOwnershipResponseSchema;
   },
+  /**
+   * Map a custom domain to a deployment and start an ACME DNS-01 challenge for its certificate
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.CreateCustomDomain
+   */
+  createCustomDomain: {
+    methodKind: "unary";
+    input: typeof CreateCustomDomainRequestSchema;
+    output: typeof CreateCustomDomainResponseSchema;
+  },
+  /**
+   * Poll a custom domain: once the challenge TXT record is published, issue the certificate and route the domain
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.VerifyCustomDomain
+   */
+  verifyCustomDomain: {
+    methodKind: "unary";
+    input: typeof VerifyCustomDomainRequestSchema;
+    output: typeof VerifyCustomDomainResponseSchema;
+  },
+  /**
+   * Configure the HTTP health check the health monitor probes; unhealthy deployments are redeployed
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.UpdateDeploymentHealthCheck
+   */
+  updateDeploymentHealthCheck: {
+    methodKind: "unary";
+    input: typeof UpdateDeploymentHealthCheckRequestSchema;
+    output: typeof UpdateDeploymentHealthCheckResponseSchema;
+  },
+  /**
+   * Replace the affinity rules used to place the deployment relative to the organization's labelled deployments
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.SetDeploymentAffinityRules
+   */
+  setDeploymentAffinityRules: {
+    methodKind: "unary";
+    input: typeof SetDeploymentAffinityRulesRequestSchema;
+    output: typeof SetDeploymentAffinityRulesResponseSchema;
+  },
+  /**
+   * Get the affinity rules of a deployment
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.GetDeploymentAffinityRules
+   */
+  getDeploymentAffinityRules: {
+    methodKind: "unary";
+    input: typeof GetDeploymentAffinityRulesRequestSchema;
+    output: typeof GetDeploymentAffinityRulesResponseSchema;
+  },
+  /**
+   * Scale a deployment to a replica count each time a cron schedule matches
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.CreateScaleSchedule
+   */
+  createScaleSchedule: {
+    methodKind: "unary";
+    input: typeof CreateScaleScheduleRequestSchema;
+    output: typeof CreateScaleScheduleResponseSchema;
+  },
+  /**
+   * Delete a scale schedule of a deployment
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.DeleteScaleSchedule
+   */
+  deleteScaleSchedule: {
+    methodKind: "unary";
+    input: typeof DeleteScaleScheduleRequestSchema;
+    output: typeof DeleteScaleScheduleResponseSchema;
+  },
+  /**
+   * List the scale schedules of a deployment
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.ListScaleSchedules
+   */
+  listScaleSchedules: {
+    methodKind: "unary";
+    input: typeof ListScaleSchedulesRequestSchema;
+    output: typeof ListScaleSchedulesResponseSchema;
+  },
+  /**
+   * Scale a deployment between a minimum and maximum replica count to hold a target CPU utilization
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.SetAutoScalePolicy
+   */
+  setAutoScalePolicy: {
+    methodKind: "unary";
+    input: typeof SetAutoScalePolicyRequestSchema;
+    output: typeof SetAutoScalePolicyResponseSchema;
+  },
+  /**
+   * Get the auto-scaling policy of a deployment
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.GetAutoScalePolicy
+   */
+  getAutoScalePolicy: {
+    methodKind: "unary";
+    input: typeof GetAutoScalePolicyRequestSchema;
+    output: typeof GetAutoScalePolicyResponseSchema;
+  },
+  /**
+   * Stop auto-scaling a deployment; it keeps its current replica count
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.DisableAutoScale
+   */
+  disableAutoScale: {
+    methodKind: "unary";
+    input: typeof DisableAutoScaleRequestSchema;
+    output: typeof DisableAutoScaleResponseSchema;
+  },
+  /**
+   * Get the provisioning status and health of a multi-region deployment in each of its regions
+   *
+   * @generated from rpc obiente.cloud.deployments.v1.DeploymentService.GetDeploymentRegionStatus
+   */
+  getDeploymentRegionStatus: {
+    methodKind: "unary";
+    input: typeof GetDeploymentRegionStatusRequestSchema;
+    output: typeof GetDeploymentRegionStatusResponseSchema;
+  },
   /**
    * List all containers for a deployment
    *
@@ -15,7 +15,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file obiente/cloud/gameservers/v1/game_server_service.proto.
  */
 export const file_obiente_cloud_gameservers_v1_game_server_service: GenFile = /*@__PURE__*/
-  fileDesc("CjZvYmllbnRlL2Nsb3VkL2dhbWVzZXJ2ZXJzL3YxL2dhbWVfc2VydmVyX3NlcnZpY2UucHJvdG8SHG9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEipwEKFkxpc3RHYW1lU2VydmVyc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhYKCWdhbWVfdHlwZRgCIAEoCUgAiAEBEkMKBnN0YXR1cxgDIAEoDjIuLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlclN0YXR1c0gBiAEBQgwKCl9nYW1lX3R5cGVCCQoHX3N0YXR1cyJZChdMaXN0R2FtZVNlcnZlcnNSZXNwb25zZRI+CgxnYW1lX3NlcnZlcnMYASADKAsyKC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXIi2QQKF0NyZWF0ZUdhbWVTZXJ2ZXJSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEjkKCWdhbWVfdHlwZRgDIAEoDjImLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVR5cGUSGQoMbWVtb3J5X2J5dGVzGAQgASgDSACIAQESFgoJY3B1X2NvcmVzGAUgASgFSAGIAQESEQoEcG9ydBgGIAEoBUgCiAEBEhkKDGRvY2tlcl9pbWFnZRgHIAEoCUgDiAEBEhoKDXN0YXJ0X2NvbW1hbmQYCCABKAlIBIgBARJUCghlbnZfdmFycxgJIAMoCzJCLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuQ3JlYXRlR2FtZVNlcnZlclJlcXVlc3QuRW52VmFyc0VudHJ5EhsKDnNlcnZlcl92ZXJzaW9uGAogASgJSAWIAQESGAoLZGVzY3JpcHRpb24YCyABKAlIBogBARIeChFleHRyYV9wb3J0c19jb3VudBgMIAEoBUgHiAEBGi4KDEVudlZhcnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg8KDV9tZW1vcnlfYnl0ZXNCDAoKX2NwdV9jb3Jlc0IHCgVfcG9ydEIPCg1fZG9ja2VyX2ltYWdlQhAKDl9zdGFydF9jb21tYW5kQhEKD19zZXJ2ZXJfdmVyc2lvbkIOCgxfZGVzY3JpcHRpb25CFAoSX2V4dHJhX3BvcnRzX2NvdW50IlkKGENyZWF0ZUdhbWVTZXJ2ZXJSZXNwb25zZRI9CgtnYW1lX3NlcnZlchgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlciIuChRHZXRHYW1lU2VydmVyUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCSJWChVHZXRHYW1lU2VydmVyUmVzcG9uc2USPQoLZ2FtZV9zZXJ2ZXIYASABKAsyKC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXIi4wMKF1VwZGF0ZUdhbWVTZXJ2ZXJSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIZCgxtZW1vcnlfYnl0ZXMYAyABKANIAYgBARIWCgljcHVfY29yZXMYBCABKAVIAogBARJUCghlbnZfdmFycxgFIAMoCzJCLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuVXBkYXRlR2FtZVNlcnZlclJlcXVlc3QuRW52VmFyc0VudHJ5EhoKDXN0YXJ0X2NvbW1hbmQYBiABKAlIA4gBARIYCgtkZXNjcmlwdGlvbhgHIAEoCUgEiAEBEhsKDnNlcnZlcl92ZXJzaW9uGAggASgJSAWIAQESHgoRZXh0cmFfcG9ydHNfY291bnQYCSABKAVIBogBARouCgxFbnZWYXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIHCgVfbmFtZUIPCg1fbWVtb3J5X2J5dGVzQgwKCl9jcHVfY29yZXNCEAoOX3N0YXJ0X2NvbW1hbmRCDgoMX2Rlc2NyaXB0aW9uQhEKD19zZXJ2ZXJfdmVyc2lvbkIUChJfZXh0cmFfcG9ydHNfY291bnQiWQoYVXBkYXRlR2FtZVNlcnZlclJlc3BvbnNlEj0KC2dhbWVfc2VydmVyGAEgASgLMigub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyIjEKF0RlbGV0ZUdhbWVTZXJ2ZXJSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJIisKGERlbGV0ZUdhbWVTZXJ2ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIjAKFlN0YXJ0R2FtZVNlcnZlclJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkiWAoXU3RhcnRHYW1lU2VydmVyUmVzcG9uc2USPQoLZ2FtZV9zZXJ2ZXIYASABKAsyKC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXIiLwoVU3RvcEdhbWVTZXJ2ZXJSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJIlcKFlN0b3BHYW1lU2VydmVyUmVzcG9uc2USPQoLZ2FtZV9zZXJ2ZXIYASABKAsyKC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXIiMgoYUmVzdGFydEdhbWVTZXJ2ZXJSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJIloKGVJlc3RhcnRHYW1lU2VydmVyUmVzcG9uc2USPQoLZ2FtZV9zZXJ2ZXIYASABKAsyKC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXIi0AEKE0dhbWVTZXJ2ZXJIVFRQUm91dGUSCgoCaWQYASABKAkSFgoOZ2FtZV9zZXJ2ZXJfaWQYAiABKAkSDgoGZG9tYWluGAMgASgJEhMKC3BhdGhfcHJlZml4GAQgASgJEhMKC3RhcmdldF9wb3J0GAUgASgFEhAKCHByb3RvY29sGAYgASgJEhMKC3NzbF9lbmFibGVkGAcgASgIEh4KEXNzbF9jZXJ0X3Jlc29sdmVyGAggASgJSACIAQFCFAoSX3NzbF9jZXJ0X3Jlc29sdmVyIlEKHkdldEdhbWVTZXJ2ZXJIVFRQUm91dGVzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkiZAofR2V0R2FtZVNlcnZlckhUVFBSb3V0ZXNSZXNwb25zZRJBCgZyb3V0ZXMYASADKAsyMS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJIVFRQUm91dGUiygIKIFVwc2VydEdhbWVTZXJ2ZXJIVFRQUm91dGVSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIVCghyb3V0ZV9pZBgDIAEoCUgAiAEBEg4KBmRvbWFpbhgEIAEoCRIYCgtwYXRoX3ByZWZpeBgFIAEoCUgBiAEBEhMKC3RhcmdldF9wb3J0GAYgASgFEhUKCHByb3RvY29sGAcgASgJSAKIAQESGAoLc3NsX2VuYWJsZWQYCCABKAhIA4gBARIeChFzc2xfY2VydF9yZXNvbHZlchgJIAEoCUgEiAEBQgsKCV9yb3V0ZV9pZEIOCgxfcGF0aF9wcmVmaXhCCwoJX3Byb3RvY29sQg4KDF9zc2xfZW5hYmxlZEIUChJfc3NsX2NlcnRfcmVzb2x2ZXIiZQohVXBzZXJ0R2FtZVNlcnZlckhUVFBSb3V0ZVJlc3BvbnNlEkAKBXJvdXRlGAEgASgLMjEub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVySFRUUFJvdXRlImUKIERlbGV0ZUdhbWVTZXJ2ZXJIVFRQUm91dGVSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIQCghyb3V0ZV9pZBgDIAEoCSI0CiFEZWxldGVHYW1lU2VydmVySFRUUFJvdXRlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJuCitHZXRHYW1lU2VydmVyRG9tYWluVmVyaWZpY2F0aW9uVG9rZW5SZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIOCgZkb21haW4YAyABKAkikAEKLEdldEdhbWVTZXJ2ZXJEb21haW5WZXJpZmljYXRpb25Ub2tlblJlc3BvbnNlEg4KBmRvbWFpbhgBIAEoCRINCgV0b2tlbhgCIAEoCRIXCg90eHRfcmVjb3JkX25hbWUYAyABKAkSGAoQdHh0X3JlY29yZF92YWx1ZRgEIAEoCRIOCgZzdGF0dXMYBSABKAkiYAodVmVyaWZ5R2FtZVNlcnZlckRvbWFpblJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEg4KBmRvbWFpbhgDIAEoCSJ0Ch5WZXJpZnlHYW1lU2VydmVyRG9tYWluUmVzcG9uc2USDgoGZG9tYWluGAEgASgJEhAKCHZlcmlmaWVkGAIgASgIEg4KBnN0YXR1cxgDIAEoCRIUCgdtZXNzYWdlGAQgASgJSACIAQFCCgoIX21lc3NhZ2UiNwodU3RyZWFtR2FtZVNlcnZlclN0YXR1c1JlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkiwQEKFkdhbWVTZXJ2ZXJTdGF0dXNVcGRhdGUSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSPgoGc3RhdHVzGAIgASgOMi4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyU3RhdHVzEhQKB21lc3NhZ2UYAyABKAlIAIgBARItCgl0aW1lc3RhbXAYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgoKCF9tZXNzYWdlIvABChhHZXRHYW1lU2VydmVyTG9nc1JlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSEgoFbGltaXQYAiABKAVIAIgBARIuCgVzaW5jZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBARIuCgV1bnRpbBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAogBARIZCgxzZWFyY2hfcXVlcnkYBSABKAlIA4gBAUIICgZfbGltaXRCCAoGX3NpbmNlQggKBl91bnRpbEIPCg1fc2VhcmNoX3F1ZXJ5IlsKGUdldEdhbWVTZXJ2ZXJMb2dzUmVzcG9uc2USPgoFbGluZXMYASADKAsyLy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJMb2dMaW5lIpECChtTdHJlYW1HYW1lU2VydmVyTG9nc1JlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSEwoGZm9sbG93GAIgASgISACIAQESEQoEdGFpbBgDIAEoBUgBiAEBEi4KBXNpbmNlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBEi4KBXVudGlsGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgDiAEBEhkKDHNlYXJjaF9xdWVyeRgGIAEoCUgEiAEBQgkKB19mb2xsb3dCBwoFX3RhaWxCCAoGX3NpbmNlQggKBl91bnRpbEIPCg1fc2VhcmNoX3F1ZXJ5IqEBChFHYW1lU2VydmVyTG9nTGluZRIMCgRsaW5lGAEgASgJEi0KCXRpbWVzdGFtcBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoFbGV2ZWwYAyABKA4yIS5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5Mb2dMZXZlbEgAiAEBEg4KBnN0ZGVychgEIAEoCEIICgZfbGV2ZWwi4wEKG0dldEdhbWVTZXJ2ZXJNZXRyaWNzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRIzCgpzdGFydF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEjEKCGVuZF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEhgKC2FnZ3JlZ2F0aW9uGAQgASgJSAKIAQFCDQoLX3N0YXJ0X3RpbWVCCwoJX2VuZF90aW1lQg4KDF9hZ2dyZWdhdGlvbiJfChxHZXRHYW1lU2VydmVyTWV0cmljc1Jlc3BvbnNlEj8KB21ldHJpY3MYASADKAsyLi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJNZXRyaWMiOAoeU3RyZWFtR2FtZVNlcnZlck1ldHJpY3NSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJIqMEChBHYW1lU2VydmVyTWV0cmljEhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEi0KCXRpbWVzdGFtcBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHgoRY3B1X3VzYWdlX3BlcmNlbnQYAyABKAFIAIgBARIfChJtZW1vcnlfdXNhZ2VfYnl0ZXMYBCABKANIAYgBARIfChJtZW1vcnlfbGltaXRfYnl0ZXMYBSABKANIAogBARIdChBuZXR3b3JrX3J4X2J5dGVzGAYgASgDSAOIAQESHQoQbmV0d29ya190eF9ieXRlcxgHIAEoA0gEiAEBEhwKD2Rpc2tfcmVhZF9ieXRlcxgKIAEoA0gFiAEBEh0KEGRpc2tfd3JpdGVfYnl0ZXMYCyABKANIBogBARIZCgxwbGF5ZXJfY291bnQYCCABKAVIB4gBARIYCgttYXhfcGxheWVycxgJIAEoBUgIiAEBQhQKEl9jcHVfdXNhZ2VfcGVyY2VudEIVChNfbWVtb3J5X3VzYWdlX2J5dGVzQhUKE19tZW1vcnlfbGltaXRfYnl0ZXNCEwoRX25ldHdvcmtfcnhfYnl0ZXNCEwoRX25ldHdvcmtfdHhfYnl0ZXNCEgoQX2Rpc2tfcmVhZF9ieXRlc0ITChFfZGlza193cml0ZV9ieXRlc0IPCg1fcGxheWVyX2NvdW50Qg4KDF9tYXhfcGxheWVycyJqChlHZXRHYW1lU2VydmVyVXNhZ2VSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRISCgVtb250aBgDIAEoCUgAiAEBQggKBl9tb250aCL0AQoaR2V0R2FtZVNlcnZlclVzYWdlUmVzcG9uc2USFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEg0KBW1vbnRoGAMgASgJEkUKB2N1cnJlbnQYBCABKAsyNC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJVc2FnZU1ldHJpY3MSTwoRZXN0aW1hdGVkX21vbnRobHkYBSABKAsyNC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJVc2FnZU1ldHJpY3MirgMKFkdhbWVTZXJ2ZXJVc2FnZU1ldHJpY3MSGAoQY3B1X2NvcmVfc2Vjb25kcxgBIAEoAxIbChNtZW1vcnlfYnl0ZV9zZWNvbmRzGAIgASgDEhoKEmJhbmR3aWR0aF9yeF9ieXRlcxgDIAEoAxIaChJiYW5kd2lkdGhfdHhfYnl0ZXMYBCABKAMSFQoNc3RvcmFnZV9ieXRlcxgFIAEoAxIWCg51cHRpbWVfc2Vjb25kcxgGIAEoAxIcChRlc3RpbWF0ZWRfY29zdF9jZW50cxgHIAEoAxIbCg5jcHVfY29zdF9jZW50cxgIIAEoA0gAiAEBEh4KEW1lbW9yeV9jb3N0X2NlbnRzGAkgASgDSAGIAQESIQoUYmFuZHdpZHRoX2Nvc3RfY2VudHMYCiABKANIAogBARIfChJzdG9yYWdlX2Nvc3RfY2VudHMYCyABKANIA4gBAUIRCg9fY3B1X2Nvc3RfY2VudHNCFAoSX21lbW9yeV9jb3N0X2NlbnRzQhcKFV9iYW5kd2lkdGhfY29zdF9jZW50c0IVChNfc3RvcmFnZV9jb3N0X2NlbnRzIqgHCgpHYW1lU2VydmVyEgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhgKC2Rlc2NyaXB0aW9uGAQgASgJSACIAQESOQoJZ2FtZV90eXBlGAUgASgOMiYub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lVHlwZRI+CgZzdGF0dXMYBiABKA4yLi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJTdGF0dXMSFAoMbWVtb3J5X2J5dGVzGAcgASgDEhEKCWNwdV9jb3JlcxgIIAEoBRIMCgRwb3J0GAkgASgFEhMKC2V4dHJhX3BvcnRzGBcgAygFEhQKDGRvY2tlcl9pbWFnZRgKIAEoCRIaCg1zdGFydF9jb21tYW5kGAsgASgJSAGIAQESRwoIZW52X3ZhcnMYDCADKAsyNS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXIuRW52VmFyc0VudHJ5EhsKDnNlcnZlcl92ZXJzaW9uGA0gASgJSAKIAQESGQoMcGxheWVyX2NvdW50GA4gASgFSAOIAQESGAoLbWF4X3BsYXllcnMYDyABKAVIBIgBARIuCgpjcmVhdGVkX2F0GBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI4Cg9sYXN0X3N0YXJ0ZWRfYXQYEiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAWIAQESGQoMY29udGFpbmVyX2lkGBMgASgJSAaIAQESGwoOY29udGFpbmVyX25hbWUYFCABKAlIB4gBARIVCg1zdG9yYWdlX2J5dGVzGBUgASgDEhIKCmNyZWF0ZWRfYnkYFiABKAkaLgoMRW52VmFyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCDgoMX2Rlc2NyaXB0aW9uQhAKDl9zdGFydF9jb21tYW5kQhEKD19zZXJ2ZXJfdmVyc2lvbkIPCg1fcGxheWVyX2NvdW50Qg4KDF9tYXhfcGxheWVyc0ISChBfbGFzdF9zdGFydGVkX2F0Qg8KDV9jb250YWluZXJfaWRCEQoPX2NvbnRhaW5lcl9uYW1lIoMECg5HYW1lU2VydmVyRmlsZRIMCgRuYW1lGAEgASgJEgwKBHBhdGgYAiABKAkSFAoMaXNfZGlyZWN0b3J5GAMgASgIEgwKBHNpemUYBCABKAMSEwoLcGVybWlzc2lvbnMYBSABKAkSGAoLdm9sdW1lX25hbWUYBiABKAlIAIgBARISCgVvd25lchgHIAEoCUgBiAEBEhIKBWdyb3VwGAggASgJSAKIAQESFwoKbW9kZV9vY3RhbBgJIAEoDUgDiAEBEhcKCmlzX3N5bWxpbmsYCiABKAhIBIgBARIbCg5zeW1saW5rX3RhcmdldBgLIAEoCUgFiAEBEhYKCW1pbWVfdHlwZRgMIAEoCUgGiAEBEjYKDW1vZGlmaWVkX3RpbWUYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAeIAQESNQoMY3JlYXRlZF90aW1lGA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgIiAEBQg4KDF92b2x1bWVfbmFtZUIICgZfb3duZXJCCAoGX2dyb3VwQg0KC19tb2RlX29jdGFsQg0KC19pc19zeW1saW5rQhEKD19zeW1saW5rX3RhcmdldEIMCgpfbWltZV90eXBlQhAKDl9tb2RpZmllZF90aW1lQg8KDV9jcmVhdGVkX3RpbWUiYAoUR2FtZVNlcnZlclZvbHVtZUluZm8SDAoEbmFtZRgBIAEoCRITCgttb3VudF9wb2ludBgCIAEoCRIOCgZzb3VyY2UYAyABKAkSFQoNaXNfcGVyc2lzdGVudBgEIAEoCCLeAQoaTGlzdEdhbWVTZXJ2ZXJGaWxlc1JlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSDAoEcGF0aBgCIAEoCRIYCgt2b2x1bWVfbmFtZRgDIAEoCUgAiAEBEhMKBmN1cnNvchgEIAEoCUgBiAEBEhYKCXBhZ2Vfc2l6ZRgFIAEoBUgCiAEBEhkKDGxpc3Rfdm9sdW1lcxgGIAEoCEgDiAEBQg4KDF92b2x1bWVfbmFtZUIJCgdfY3Vyc29yQgwKCl9wYWdlX3NpemVCDwoNX2xpc3Rfdm9sdW1lcyKfAgobTGlzdEdhbWVTZXJ2ZXJGaWxlc1Jlc3BvbnNlEjsKBWZpbGVzGAEgAygLMiwub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyRmlsZRIUCgxjdXJyZW50X3BhdGgYAiABKAkSQwoHdm9sdW1lcxgDIAMoCzIyLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlclZvbHVtZUluZm8SEQoJaXNfdm9sdW1lGAQgASgIEhkKEWNvbnRhaW5lcl9ydW5uaW5nGAUgASgIEhAKCGhhc19tb3JlGAYgASgIEhgKC25leHRfY3Vyc29yGAcgASgJSACIAQFCDgoMX25leHRfY3Vyc29yIpsCChxTZWFyY2hHYW1lU2VydmVyRmlsZXNSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEg0KBXF1ZXJ5GAIgASgJEhYKCXJvb3RfcGF0aBgDIAEoCUgAiAEBEhgKC3ZvbHVtZV9uYW1lGAQgASgJSAGIAQESGAoLbWF4X3Jlc3VsdHMYBSABKAVIAogBARIXCgpmaWxlc19vbmx5GAYgASgISAOIAQESHQoQZGlyZWN0b3JpZXNfb25seRgHIAEoCEgEiAEBQgwKCl9yb290X3BhdGhCDgoMX3ZvbHVtZV9uYW1lQg4KDF9tYXhfcmVzdWx0c0INCgtfZmlsZXNfb25seUITChFfZGlyZWN0b3JpZXNfb25seSKgAQodU2VhcmNoR2FtZVNlcnZlckZpbGVzUmVzcG9uc2USPQoHcmVzdWx0cxgBIAMoCzIsLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckZpbGUSEwoLdG90YWxfZm91bmQYAiABKAUSEAoIaGFzX21vcmUYAyABKAgSGQoRY29udGFpbmVyX3J1bm5pbmcYBCABKAgiagoYR2V0R2FtZVNlcnZlckZpbGVSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkSGAoLdm9sdW1lX25hbWUYAyABKAlIAIgBAUIOCgxfdm9sdW1lX25hbWUixAEKGUdldEdhbWVTZXJ2ZXJGaWxlUmVzcG9uc2USDwoHY29udGVudBgBIAEoCRIQCghlbmNvZGluZxgCIAEoCRIMCgRzaXplGAMgASgDEhYKCXRydW5jYXRlZBgEIAEoCEgAiAEBEkMKCG1ldGFkYXRhGAUgASgLMiwub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyRmlsZUgBiAEBQgwKCl90cnVuY2F0ZWRCCwoJX21ldGFkYXRhIn8KHFVwbG9hZEdhbWVTZXJ2ZXJGaWxlc1JlcXVlc3QSTQoIbWV0YWRhdGEYASABKAsyOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlVwbG9hZEdhbWVTZXJ2ZXJGaWxlc01ldGFkYXRhEhAKCHRhcl9kYXRhGAIgASgMIsABCh1VcGxvYWRHYW1lU2VydmVyRmlsZXNNZXRhZGF0YRIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRIYChBkZXN0aW5hdGlvbl9wYXRoGAIgASgJEkMKBWZpbGVzGAMgAygLMjQub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyRmlsZU1ldGFkYXRhEhgKC3ZvbHVtZV9uYW1lGAQgASgJSACIAQFCDgoMX3ZvbHVtZV9uYW1lIlgKFkdhbWVTZXJ2ZXJGaWxlTWV0YWRhdGESDAoEbmFtZRgBIAEoCRIMCgRzaXplGAIgASgDEhQKDGlzX2RpcmVjdG9yeRgDIAEoCBIMCgRwYXRoGAQgASgJImYKHVVwbG9hZEdhbWVTZXJ2ZXJGaWxlc1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSEgoFZXJyb3IYAiABKAlIAIgBARIWCg5maWxlc191cGxvYWRlZBgDIAEoBUIICgZfZXJyb3IiegohQ2h1bmtVcGxvYWRHYW1lU2VydmVyRmlsZXNSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEj0KBnVwbG9hZBgCIAEoCzItLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLkNodW5rZWRVcGxvYWRQYXlsb2FkImsKIkNodW5rVXBsb2FkR2FtZVNlcnZlckZpbGVzUmVzcG9uc2USRQoGcmVzdWx0GAEgASgLMjUub2JpZW50ZS5jbG91ZC5jb21tb24udjEuQ2h1bmtlZFVwbG9hZFJlc3BvbnNlUGF5bG9hZCKTAQoeRGVsZXRlR2FtZVNlcnZlckVudHJpZXNSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEg0KBXBhdGhzGAIgAygJEhgKC3ZvbHVtZV9uYW1lGAMgASgJSACIAQESEQoJcmVjdXJzaXZlGAQgASgIEg0KBWZvcmNlGAUgASgIQg4KDF92b2x1bWVfbmFtZSI9ChxEZWxldGVHYW1lU2VydmVyRW50cmllc0Vycm9yEgwKBHBhdGgYASABKAkSDwoHbWVzc2FnZRgCIAEoCSKVAQofRGVsZXRlR2FtZVNlcnZlckVudHJpZXNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhUKDWRlbGV0ZWRfcGF0aHMYAiADKAkSSgoGZXJyb3JzGAMgAygLMjoub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5EZWxldGVHYW1lU2VydmVyRW50cmllc0Vycm9yIp0BChxSZW5hbWVHYW1lU2VydmVyRW50cnlSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhMKC3NvdXJjZV9wYXRoGAIgASgJEhMKC3RhcmdldF9wYXRoGAMgASgJEhgKC3ZvbHVtZV9uYW1lGAQgASgJSACIAQESEQoJb3ZlcndyaXRlGAUgASgIQg4KDF92b2x1bWVfbmFtZSJ8Ch1SZW5hbWVHYW1lU2VydmVyRW50cnlSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEkAKBWVudHJ5GAIgASgLMiwub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyRmlsZUgAiAEBQggKBl9lbnRyeSKQAgocQ3JlYXRlR2FtZVNlcnZlckVudHJ5UmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRITCgtwYXJlbnRfcGF0aBgCIAEoCRIMCgRuYW1lGAMgASgJEj8KBHR5cGUYBCABKA4yMS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJFbnRyeVR5cGUSFQoIdGVtcGxhdGUYBSABKAlIAIgBARIYCgt2b2x1bWVfbmFtZRgGIAEoCUgBiAEBEhcKCm1vZGVfb2N0YWwYByABKA1IAogBAUILCglfdGVtcGxhdGVCDgoMX3ZvbHVtZV9uYW1lQg0KC19tb2RlX29jdGFsIlwKHUNyZWF0ZUdhbWVTZXJ2ZXJFbnRyeVJlc3BvbnNlEjsKBWVudHJ5GAEgASgLMiwub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyRmlsZSLSAQoaV3JpdGVHYW1lU2VydmVyRmlsZVJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSDAoEcGF0aBgCIAEoCRIYCgt2b2x1bWVfbmFtZRgDIAEoCUgAiAEBEg8KB2NvbnRlbnQYBCABKAkSEAoIZW5jb2RpbmcYBSABKAkSGQoRY3JlYXRlX2lmX21pc3NpbmcYBiABKAgSFwoKbW9kZV9vY3RhbBgHIAEoDUgBiAEBQg4KDF92b2x1bWVfbmFtZUINCgtfbW9kZV9vY3RhbCKYAQobV3JpdGVHYW1lU2VydmVyRmlsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSQAoFZW50cnkYAiABKAsyLC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJGaWxlSACIAQESEgoFZXJyb3IYAyABKAlIAYgBAUIICgZfZW50cnlCCAoGX2Vycm9yIowBChxFeHRyYWN0R2FtZVNlcnZlckZpbGVSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhAKCHppcF9wYXRoGAIgASgJEhgKEGRlc3RpbmF0aW9uX3BhdGgYAyABKAkSGAoLdm9sdW1lX25hbWUYBCABKAlIAIgBAUIOCgxfdm9sdW1lX25hbWUiZwodRXh0cmFjdEdhbWVTZXJ2ZXJGaWxlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBISCgVlcnJvchgCIAEoCUgAiAEBEhcKD2ZpbGVzX2V4dHJhY3RlZBgDIAEoBUIICgZfZXJyb3IiuAEKIkNyZWF0ZUdhbWVTZXJ2ZXJGaWxlQXJjaGl2ZVJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSUAoPYXJjaGl2ZV9yZXF1ZXN0GAIgASgLMjcub2JpZW50ZS5jbG91ZC5jb21tb24udjEuQ3JlYXRlU2VydmVyRmlsZUFyY2hpdmVSZXF1ZXN0EhgKC3ZvbHVtZV9uYW1lGAMgASgJSACIAQFCDgoMX3ZvbHVtZV9uYW1lInkKI0NyZWF0ZUdhbWVTZXJ2ZXJGaWxlQXJjaGl2ZVJlc3BvbnNlElIKEGFyY2hpdmVfcmVzcG9uc2UYASABKAsyOC5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5DcmVhdGVTZXJ2ZXJGaWxlQXJjaGl2ZVJlc3BvbnNlIpoCCiBHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhAKCHVzZXJuYW1lGAMgASgJEg4KBnNjb3BlcxgEIAMoCRI1CgxsYXN0X3VzZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESMwoKZXhwaXJlc19hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBARIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIPCg1fbGFzdF91c2VkX2F0Qg0KC19leHBpcmVzX2F0IncKJEdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDb25uZWN0aW9uSW5mbxIMCgRob3N0GAEgASgJEgwKBHBvcnQYAiABKAUSEAoIdXNlcm5hbWUYAyABKAkSEAoIcHJvdG9jb2wYBCABKAkSDwoHY29tbWFuZBgFIAEoCSJGCixMaXN0R2FtZVNlcnZlckZpbGVUcmFuc2ZlckNyZWRlbnRpYWxzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCSLcAQotTGlzdEdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDcmVkZW50aWFsc1Jlc3BvbnNlElMKC2NyZWRlbnRpYWxzGAEgAygLMj4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbBJWCgpjb25uZWN0aW9uGAIgASgLMkIub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyRmlsZVRyYW5zZmVyQ29ubmVjdGlvbkluZm8iqQEKLUNyZWF0ZUdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDcmVkZW50aWFsUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBnNjb3BlcxgDIAMoCRIzCgpleHBpcmVzX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBQg0KC19leHBpcmVzX2F0Iu4BCi5DcmVhdGVHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbFJlc3BvbnNlElIKCmNyZWRlbnRpYWwYASABKAsyPi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDcmVkZW50aWFsEhAKCHBhc3N3b3JkGAIgASgJElYKCmNvbm5lY3Rpb24YAyABKAsyQi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDb25uZWN0aW9uSW5mbyJeCi1SZXZva2VHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbFJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSFQoNY3JlZGVudGlhbF9pZBgCIAEoCSJBCi5SZXZva2VHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiMQodR2V0TWluZWNyYWZ0UGxheWVyVVVJRFJlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkiWAoeR2V0TWluZWNyYWZ0UGxheWVyVVVJRFJlc3BvbnNlEhEKBHV1aWQYASABKAlIAIgBARIRCgRuYW1lGAIgASgJSAGIAQFCBwoFX3V1aWRCBwoFX25hbWUiMAogR2V0TWluZWNyYWZ0UGxheWVyUHJvZmlsZVJlcXVlc3QSDAoEdXVpZBgBIAEoCSKDAQohR2V0TWluZWNyYWZ0UGxheWVyUHJvZmlsZVJlc3BvbnNlEhEKBHV1aWQYASABKAlIAIgBARIRCgRuYW1lGAIgASgJSAGIAQESFwoKYXZhdGFyX3VybBgDIAEoCUgCiAEBQgcKBV91dWlkQgcKBV9uYW1lQg0KC19hdmF0YXJfdXJsIvkDChBNaW5lY3JhZnRQcm9qZWN0EgoKAmlkGAEgASgJEgwKBHNsdWcYAiABKAkSDQoFdGl0bGUYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSSAoMcHJvamVjdF90eXBlGAUgASgOMjIub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5NaW5lY3JhZnRQcm9qZWN0VHlwZRIQCghpY29uX3VybBgGIAEoCRISCgpjYXRlZ29yaWVzGAcgAygJEg8KB2xvYWRlcnMYCCADKAkSFQoNZ2FtZV92ZXJzaW9ucxgJIAMoCRIPCgdhdXRob3JzGAogAygJEhEKCWRvd25sb2FkcxgLIAEoAxIOCgZyYXRpbmcYDCABKAESHgoRbGF0ZXN0X3ZlcnNpb25faWQYDSABKAlIAIgBARIYCgtwcm9qZWN0X3VybBgOIAEoCUgBiAEBEhcKCnNvdXJjZV91cmwYDyABKAlIAogBARIXCgppc3N1ZXNfdXJsGBAgASgJSAOIAQESEQoEYm9keRgRIAEoCUgEiAEBEg8KB2dhbGxlcnkYEiADKAlCFAoSX2xhdGVzdF92ZXJzaW9uX2lkQg4KDF9wcm9qZWN0X3VybEINCgtfc291cmNlX3VybEINCgtfaXNzdWVzX3VybEIHCgVfYm9keSKYAgocTGlzdE1pbmVjcmFmdFByb2plY3RzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRISCgVxdWVyeRgCIAEoCUgAiAEBEhUKDWdhbWVfdmVyc2lvbnMYAyADKAkSDwoHbG9hZGVycxgEIAMoCRISCgpjYXRlZ29yaWVzGAUgAygJEhMKBmN1cnNvchgGIAEoCUgBiAEBEhIKBWxpbWl0GAcgASgFSAKIAQESSAoMcHJvamVjdF90eXBlGAggASgOMjIub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5NaW5lY3JhZnRQcm9qZWN0VHlwZUIICgZfcXVlcnlCCQoHX2N1cnNvckIICgZfbGltaXQinQEKHUxpc3RNaW5lY3JhZnRQcm9qZWN0c1Jlc3BvbnNlEkAKCHByb2plY3RzGAEgAygLMi4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5NaW5lY3JhZnRQcm9qZWN0EhAKCGhhc19tb3JlGAIgASgIEhgKC25leHRfY3Vyc29yGAMgASgJSACIAQFCDgoMX25leHRfY3Vyc29yIpQGCh1JbnN0YWxsZWRNaW5lY3JhZnRQcm9qZWN0RmlsZRIKCgJpZBgBIAEoCRIQCghmaWxlbmFtZRgCIAEoCRIWCg5pbnN0YWxsZWRfcGF0aBgDIAEoCRJICgxwcm9qZWN0X3R5cGUYBCABKA4yMi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1pbmVjcmFmdFByb2plY3RUeXBlEhIKCnNpemVfYnl0ZXMYBSABKAMSNAoLbW9kaWZpZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESDwoHbWFuYWdlZBgHIAEoCBIXCgpwcm9qZWN0X2lkGAggASgJSAGIAQESGQoMcHJvamVjdF9zbHVnGAkgASgJSAKIAQESEgoFdGl0bGUYCiABKAlIA4gBARIVCghpY29uX3VybBgLIAEoCUgEiAEBEhcKCnZlcnNpb25faWQYDCABKAlIBYgBARIbCg52ZXJzaW9uX251bWJlchgNIAEoCUgGiAEBEhUKDWdhbWVfdmVyc2lvbnMYDiADKAkSDwoHbG9hZGVycxgPIAMoCRI1CgxpbnN0YWxsZWRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAeIAQESGAoQdXBkYXRlX2F2YWlsYWJsZRgRIAEoCBIeChFsYXRlc3RfdmVyc2lvbl9pZBgSIAEoCUgIiAEBEiIKFWxhdGVzdF92ZXJzaW9uX251bWJlchgTIAEoCUgJiAEBEhwKFGxhdGVzdF9nYW1lX3ZlcnNpb25zGBQgAygJQg4KDF9tb2RpZmllZF9hdEINCgtfcHJvamVjdF9pZEIPCg1fcHJvamVjdF9zbHVnQggKBl90aXRsZUILCglfaWNvbl91cmxCDQoLX3ZlcnNpb25faWRCEQoPX3ZlcnNpb25fbnVtYmVyQg8KDV9pbnN0YWxsZWRfYXRCFAoSX2xhdGVzdF92ZXJzaW9uX2lkQhgKFl9sYXRlc3RfdmVyc2lvbl9udW1iZXIitwEKJUxpc3RJbnN0YWxsZWRNaW5lY3JhZnRQcm9qZWN0c1JlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSSAoMcHJvamVjdF90eXBlGAIgASgOMjIub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5NaW5lY3JhZnRQcm9qZWN0VHlwZRIaCg1jaGVja191cGRhdGVzGAMgASgISACIAQFCEAoOX2NoZWNrX3VwZGF0ZXMidAomTGlzdEluc3RhbGxlZE1pbmVjcmFmdFByb2plY3RzUmVzcG9uc2USSgoFZmlsZXMYASADKAsyOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkluc3RhbGxlZE1pbmVjcmFmdFByb2plY3RGaWxlItkBChRNaW5lY3JhZnRQcm9qZWN0RmlsZRIQCghmaWxlbmFtZRgBIAEoCRILCgN1cmwYAiABKAkSEgoKc2l6ZV9ieXRlcxgDIAEoAxJOCgZoYXNoZXMYBCADKAsyPi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1pbmVjcmFmdFByb2plY3RGaWxlLkhhc2hlc0VudHJ5Eg8KB3ByaW1hcnkYBSABKAgaLQoLSGFzaGVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKOAwoXTWluZWNyYWZ0UHJvamVjdFZlcnNpb24SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIWCg52ZXJzaW9uX251bWJlchgDIAEoCRIVCg1nYW1lX3ZlcnNpb25zGAQgAygJEg8KB2xvYWRlcnMYBSADKAkSHQoVc2VydmVyX3NpZGVfc3VwcG9ydGVkGAYgASgIEh0KFWNsaWVudF9zaWRlX3N1cHBvcnRlZBgHIAEoCBI1CgxwdWJsaXNoZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESFgoJY2hhbmdlbG9nGAkgASgJSAGIAQESQQoFZmlsZXMYCiADKAsyMi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1pbmVjcmFmdFByb2plY3RGaWxlEhkKDHZlcnNpb25fdHlwZRgLIAEoCUgCiAEBQg8KDV9wdWJsaXNoZWRfYXRCDAoKX2NoYW5nZWxvZ0IPCg1fdmVyc2lvbl90eXBlIpoCCiJHZXRNaW5lY3JhZnRQcm9qZWN0VmVyc2lvbnNSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkSFQoNZ2FtZV92ZXJzaW9ucxgDIAMoCRIPCgdsb2FkZXJzGAQgAygJEkgKDHByb2plY3RfdHlwZRgFIAEoDjIyLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTWluZWNyYWZ0UHJvamVjdFR5cGUSEgoFbGltaXQYBiABKAVIAIgBARIgChNpbmNsdWRlX3ByZXJlbGVhc2VzGAcgASgISAGIAQFCCAoGX2xpbWl0QhYKFF9pbmNsdWRlX3ByZXJlbGVhc2VzIm4KI0dldE1pbmVjcmFmdFByb2plY3RWZXJzaW9uc1Jlc3BvbnNlEkcKCHZlcnNpb25zGAEgAygLMjUub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5NaW5lY3JhZnRQcm9qZWN0VmVyc2lvbiJIChpHZXRNaW5lY3JhZnRQcm9qZWN0UmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJIl4KG0dldE1pbmVjcmFmdFByb2plY3RSZXNwb25zZRI/Cgdwcm9qZWN0GAEgASgLMi4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5NaW5lY3JhZnRQcm9qZWN0IrwCCiJJbnN0YWxsTWluZWNyYWZ0UHJvamVjdEZpbGVSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkSEgoKdmVyc2lvbl9pZBgDIAEoCRJICgxwcm9qZWN0X3R5cGUYBCABKA4yMi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1pbmVjcmFmdFByb2plY3RUeXBlEhoKDXByb2plY3RfdGl0bGUYBSABKAlIAIgBARIZCgxwcm9qZWN0X3NsdWcYBiABKAlIAYgBARIdChBwcm9qZWN0X2ljb25fdXJsGAcgASgJSAKIAQFCEAoOX3Byb2plY3RfdGl0bGVCDwoNX3Byb2plY3Rfc2x1Z0ITChFfcHJvamVjdF9pY29uX3VybCKcAQojSW5zdGFsbE1pbmVjcmFmdFByb2plY3RGaWxlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIQCghmaWxlbmFtZRgCIAEoCRIWCg5pbnN0YWxsZWRfcGF0aBgDIAEoCRIYChByZXN0YXJ0X3JlcXVpcmVkGAQgASgIEhQKB21lc3NhZ2UYBSABKAlIAIgBAUIKCghfbWVzc2FnZSLVAgohVXBkYXRlTWluZWNyYWZ0UHJvamVjdEZpbGVSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkSEgoKdmVyc2lvbl9pZBgDIAEoCRJICgxwcm9qZWN0X3R5cGUYBCABKA4yMi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1pbmVjcmFmdFByb2plY3RUeXBlEhgKEGN1cnJlbnRfZmlsZW5hbWUYBSABKAkSGgoNcHJvamVjdF90aXRsZRgGIAEoCUgAiAEBEhkKDHByb2plY3Rfc2x1ZxgHIAEoCUgBiAEBEh0KEHByb2plY3RfaWNvbl91cmwYCCABKAlIAogBAUIQCg5fcHJvamVjdF90aXRsZUIPCg1fcHJvamVjdF9zbHVnQhMKEV9wcm9qZWN0X2ljb25fdXJsItEBCiJVcGRhdGVNaW5lY3JhZnRQcm9qZWN0RmlsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSEAoIZmlsZW5hbWUYAiABKAkSFgoOaW5zdGFsbGVkX3BhdGgYAyABKAkSHgoRcmVwbGFjZWRfZmlsZW5hbWUYBCABKAlIAIgBARIYChByZXN0YXJ0X3JlcXVpcmVkGAUgASgIEhQKB21lc3NhZ2UYBiABKAlIAYgBAUIUChJfcmVwbGFjZWRfZmlsZW5hbWVCCgoIX21lc3NhZ2Uq6QEKCEdhbWVUeXBlEhkKFUdBTUVfVFlQRV9VTlNQRUNJRklFRBAAEg0KCU1JTkVDUkFGVBABEhIKDk1JTkVDUkFGVF9KQVZBEAISFQoRTUlORUNSQUZUX0JFRFJPQ0sQAxILCgdWQUxIRUlNEAQSDAoIVEVSUkFSSUEQBRIICgRSVVNUEAYSBwoDQ1MyEAcSBwoDVEYyEAgSBwoDQVJLEAkSCQoFQ09OQU4QChIOCgpTRVZFTl9EQVlTEAsSDAoIRkFDVE9SSU8QDBIUChBTUEFDRURfRU5HSU5FRVJTEA0SCQoFT1RIRVIQYyqVAQoQR2FtZVNlcnZlclN0YXR1cxIiCh5HQU1FX1NFUlZFUl9TVEFUVVNfVU5TUEVDSUZJRUQQABILCgdDUkVBVEVEEAESDAoIU1RBUlRJTkcQAhILCgdSVU5OSU5HEAMSDAoIU1RPUFBJTkcQBBILCgdTVE9QUEVEEAUSCgoGRkFJTEVEEAYSDgoKUkVTVEFSVElORxAHKqgBChNHYW1lU2VydmVyRW50cnlUeXBlEiYKIkdBTUVfU0VSVkVSX0VOVFJZX1RZUEVfVU5TUEVDSUZJRUQQABIfChtHQU1FX1NFUlZFUl9FTlRSWV9UWVBFX0ZJTEUQARIkCiBHQU1FX1NFUlZFUl9FTlRSWV9UWVBFX0RJUkVDVE9SWRACEiIKHkdBTUVfU0VSVkVSX0VOVFJZX1RZUEVfU1lNTElOSxADKoEBChRNaW5lY3JhZnRQcm9qZWN0VHlwZRImCiJNSU5FQ1JBRlRfUFJPSkVDVF9UWVBFX1VOU1BFQ0lGSUVEEAASHgoaTUlORUNSQUZUX1BST0pFQ1RfVFlQRV9NT0QQARIhCh1NSU5FQ1JBRlRfUFJPSkVDVF9UWVBFX1BMVUdJThACMqwwChFHYW1lU2VydmVyU2VydmljZRJ+Cg9MaXN0R2FtZVNlcnZlcnMSNC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RHYW1lU2VydmVyc1JlcXVlc3QaNS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RHYW1lU2VydmVyc1Jlc3BvbnNlEoEBChBDcmVhdGVHYW1lU2VydmVyEjUub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5DcmVhdGVHYW1lU2VydmVyUmVxdWVzdBo2Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuQ3JlYXRlR2FtZVNlcnZlclJlc3BvbnNlEngKDUdldEdhbWVTZXJ2ZXISMi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldEdhbWVTZXJ2ZXJSZXF1ZXN0GjMub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRHYW1lU2VydmVyUmVzcG9uc2USgQEKEFVwZGF0ZUdhbWVTZXJ2ZXISNS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlVwZGF0ZUdhbWVTZXJ2ZXJSZXF1ZXN0GjYub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5VcGRhdGVHYW1lU2VydmVyUmVzcG9uc2USgQEKEERlbGV0ZUdhbWVTZXJ2ZXISNS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkRlbGV0ZUdhbWVTZXJ2ZXJSZXF1ZXN0GjYub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5EZWxldGVHYW1lU2VydmVyUmVzcG9uc2USfgoPU3RhcnRHYW1lU2VydmVyEjQub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5TdGFydEdhbWVTZXJ2ZXJSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5TdGFydEdhbWVTZXJ2ZXJSZXNwb25zZRJ7Cg5TdG9wR2FtZVNlcnZlchIzLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuU3RvcEdhbWVTZXJ2ZXJSZXF1ZXN0GjQub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5TdG9wR2FtZVNlcnZlclJlc3BvbnNlEoQBChFSZXN0YXJ0R2FtZVNlcnZlchI2Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuUmVzdGFydEdhbWVTZXJ2ZXJSZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5SZXN0YXJ0R2FtZVNlcnZlclJlc3BvbnNlEpYBChdHZXRHYW1lU2VydmVySFRUUFJvdXRlcxI8Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0R2FtZVNlcnZlckhUVFBSb3V0ZXNSZXF1ZXN0Gj0ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRHYW1lU2VydmVySFRUUFJvdXRlc1Jlc3BvbnNlEpwBChlVcHNlcnRHYW1lU2VydmVySFRUUFJvdXRlEj4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5VcHNlcnRHYW1lU2VydmVySFRUUFJvdXRlUmVxdWVzdBo/Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuVXBzZXJ0R2FtZVNlcnZlckhUVFBSb3V0ZVJlc3BvbnNlEpwBChlEZWxldGVHYW1lU2VydmVySFRUUFJvdXRlEj4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5EZWxldGVHYW1lU2VydmVySFRUUFJvdXRlUmVxdWVzdBo/Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuRGVsZXRlR2FtZVNlcnZlckhUVFBSb3V0ZVJlc3BvbnNlEr0BCiRHZXRHYW1lU2VydmVyRG9tYWluVmVyaWZpY2F0aW9uVG9rZW4SSS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldEdhbWVTZXJ2ZXJEb21haW5WZXJpZmljYXRpb25Ub2tlblJlcXVlc3QaSi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldEdhbWVTZXJ2ZXJEb21haW5WZXJpZmljYXRpb25Ub2tlblJlc3BvbnNlEpMBChZWZXJpZnlHYW1lU2VydmVyRG9tYWluEjsub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5WZXJpZnlHYW1lU2VydmVyRG9tYWluUmVxdWVzdBo8Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuVmVyaWZ5R2FtZVNlcnZlckRvbWFpblJlc3BvbnNlEo0BChZTdHJlYW1HYW1lU2VydmVyU3RhdHVzEjsub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5TdHJlYW1HYW1lU2VydmVyU3RhdHVzUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlclN0YXR1c1VwZGF0ZTABEoQBChFHZXRHYW1lU2VydmVyTG9ncxI2Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0R2FtZVNlcnZlckxvZ3NSZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRHYW1lU2VydmVyTG9nc1Jlc3BvbnNlEoQBChRTdHJlYW1HYW1lU2VydmVyTG9ncxI5Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuU3RyZWFtR2FtZVNlcnZlckxvZ3NSZXF1ZXN0Gi8ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyTG9nTGluZTABEo0BChRHZXRHYW1lU2VydmVyTWV0cmljcxI5Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0R2FtZVNlcnZlck1ldHJpY3NSZXF1ZXN0Gjoub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRHYW1lU2VydmVyTWV0cmljc1Jlc3BvbnNlEokBChdTdHJlYW1HYW1lU2VydmVyTWV0cmljcxI8Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuU3RyZWFtR2FtZVNlcnZlck1ldHJpY3NSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyTWV0cmljMAEShwEKEkdldEdhbWVTZXJ2ZXJVc2FnZRI3Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0R2FtZVNlcnZlclVzYWdlUmVxdWVzdBo4Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0R2FtZVNlcnZlclVzYWdlUmVzcG9uc2USigEKE0xpc3RHYW1lU2VydmVyRmlsZXMSOC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RHYW1lU2VydmVyRmlsZXNSZXF1ZXN0Gjkub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5MaXN0R2FtZVNlcnZlckZpbGVzUmVzcG9uc2USkAEKFVNlYXJjaEdhbWVTZXJ2ZXJGaWxlcxI6Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuU2VhcmNoR2FtZVNlcnZlckZpbGVzUmVxdWVzdBo7Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuU2VhcmNoR2FtZVNlcnZlckZpbGVzUmVzcG9uc2UShAEKEUdldEdhbWVTZXJ2ZXJGaWxlEjYub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRHYW1lU2VydmVyRmlsZVJlcXVlc3QaNy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldEdhbWVTZXJ2ZXJGaWxlUmVzcG9uc2USkAEKFVVwbG9hZEdhbWVTZXJ2ZXJGaWxlcxI6Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuVXBsb2FkR2FtZVNlcnZlckZpbGVzUmVxdWVzdBo7Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuVXBsb2FkR2FtZVNlcnZlckZpbGVzUmVzcG9uc2USnwEKGkNodW5rVXBsb2FkR2FtZVNlcnZlckZpbGVzEj8ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5DaHVua1VwbG9hZEdhbWVTZXJ2ZXJGaWxlc1JlcXVlc3QaQC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkNodW5rVXBsb2FkR2FtZVNlcnZlckZpbGVzUmVzcG9uc2USlgEKF0RlbGV0ZUdhbWVTZXJ2ZXJFbnRyaWVzEjwub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5EZWxldGVHYW1lU2VydmVyRW50cmllc1JlcXVlc3QaPS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkRlbGV0ZUdhbWVTZXJ2ZXJFbnRyaWVzUmVzcG9uc2USkAEKFUNyZWF0ZUdhbWVTZXJ2ZXJFbnRyeRI6Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuQ3JlYXRlR2FtZVNlcnZlckVudHJ5UmVxdWVzdBo7Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuQ3JlYXRlR2FtZVNlcnZlckVudHJ5UmVzcG9uc2USigEKE1dyaXRlR2FtZVNlcnZlckZpbGUSOC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLldyaXRlR2FtZVNlcnZlckZpbGVSZXF1ZXN0Gjkub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5Xcml0ZUdhbWVTZXJ2ZXJGaWxlUmVzcG9uc2USkAEKFVJlbmFtZUdhbWVTZXJ2ZXJFbnRyeRI6Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuUmVuYW1lR2FtZVNlcnZlckVudHJ5UmVxdWVzdBo7Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuUmVuYW1lR2FtZVNlcnZlckVudHJ5UmVzcG9uc2USkAEKFUV4dHJhY3RHYW1lU2VydmVyRmlsZRI6Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuRXh0cmFjdEdhbWVTZXJ2ZXJGaWxlUmVxdWVzdBo7Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuRXh0cmFjdEdhbWVTZXJ2ZXJGaWxlUmVzcG9uc2USogEKG0NyZWF0ZUdhbWVTZXJ2ZXJGaWxlQXJjaGl2ZRJALm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuQ3JlYXRlR2FtZVNlcnZlckZpbGVBcmNoaXZlUmVxdWVzdBpBLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuQ3JlYXRlR2FtZVNlcnZlckZpbGVBcmNoaXZlUmVzcG9uc2USwAEKJUxpc3RHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbHMSSi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbHNSZXF1ZXN0Gksub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5MaXN0R2FtZVNlcnZlckZpbGVUcmFuc2ZlckNyZWRlbnRpYWxzUmVzcG9uc2USwwEKJkNyZWF0ZUdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDcmVkZW50aWFsEksub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5DcmVhdGVHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbFJlcXVlc3QaTC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkNyZWF0ZUdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDcmVkZW50aWFsUmVzcG9uc2USwwEKJlJldm9rZUdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDcmVkZW50aWFsEksub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5SZXZva2VHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbFJlcXVlc3QaTC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlJldm9rZUdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDcmVkZW50aWFsUmVzcG9uc2USkwEKFkdldE1pbmVjcmFmdFBsYXllclVVSUQSOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldE1pbmVjcmFmdFBsYXllclVVSURSZXF1ZXN0Gjwub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRNaW5lY3JhZnRQbGF5ZXJVVUlEUmVzcG9uc2USnAEKGUdldE1pbmVjcmFmdFBsYXllclByb2ZpbGUSPi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldE1pbmVjcmFmdFBsYXllclByb2ZpbGVSZXF1ZXN0Gj8ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRNaW5lY3JhZnRQbGF5ZXJQcm9maWxlUmVzcG9uc2USkAEKFUxpc3RNaW5lY3JhZnRQcm9qZWN0cxI6Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTGlzdE1pbmVjcmFmdFByb2plY3RzUmVxdWVzdBo7Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTGlzdE1pbmVjcmFmdFByb2plY3RzUmVzcG9uc2USqwEKHkxpc3RJbnN0YWxsZWRNaW5lY3JhZnRQcm9qZWN0cxJDLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTGlzdEluc3RhbGxlZE1pbmVjcmFmdFByb2plY3RzUmVxdWVzdBpELm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTGlzdEluc3RhbGxlZE1pbmVjcmFmdFByb2plY3RzUmVzcG9uc2USogEKG0dldE1pbmVjcmFmdFByb2plY3RWZXJzaW9ucxJALm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0TWluZWNyYWZ0UHJvamVjdFZlcnNpb25zUmVxdWVzdBpBLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0TWluZWNyYWZ0UHJvamVjdFZlcnNpb25zUmVzcG9uc2USigEKE0dldE1pbmVjcmFmdFByb2plY3QSOC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldE1pbmVjcmFmdFByb2plY3RSZXF1ZXN0Gjkub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRNaW5lY3JhZnRQcm9qZWN0UmVzcG9uc2USogEKG0luc3RhbGxNaW5lY3JhZnRQcm9qZWN0RmlsZRJALm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuSW5zdGFsbE1pbmVjcmFmdFByb2plY3RGaWxlUmVxdWVzdBpBLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuSW5zdGFsbE1pbmVjcmFmdFByb2plY3RGaWxlUmVzcG9uc2USnwEKGlVwZGF0ZU1pbmVjcmFmdFByb2plY3RGaWxlEj8ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5VcGRhdGVNaW5lY3JhZnRQcm9qZWN0RmlsZVJlcXVlc3QaQC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlVwZGF0ZU1pbmVjcmFmdFByb2plY3RGaWxlUmVzcG9uc2VCV1pVZ2l0aHViLmNvbS9vYmllbnRlL2Nsb3VkL2FwcHMvc2hhcmVkL3Byb3RvL29iaWVudGUvY2xvdWQvZ2FtZXNlcnZlcnMvdjE7Z2FtZXNlcnZlcnN2MWIGcHJvdG8z", [file_google_protobuf_timestamp, file_obiente_cloud_organizations_v1_organization_service, file_obiente_cloud_common_v1_common]);
+  fileDesc("CjZvYmllbnRlL2Nsb3VkL2dhbWVzZXJ2ZXJzL3YxL2dhbWVfc2VydmVyX3NlcnZpY2UucHJvdG8SHG9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEiogIKFkxpc3RHYW1lU2VydmVyc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhYKCWdhbWVfdHlwZRgCIAEoCUgAiAEBEkMKBnN0YXR1cxgDIAEoDjIuLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlclN0YXR1c0gBiAEBEkwKBHRhZ3MYBCADKAsyPi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RHYW1lU2VydmVyc1JlcXVlc3QuVGFnc0VudHJ5GisKCVRhZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQgwKCl9nYW1lX3R5cGVCCQoHX3N0YXR1cyJZChdMaXN0R2FtZVNlcnZlcnNSZXNwb25zZRI+CgxnYW1lX3NlcnZlcnMYASADKAsyKC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXIi+wQKF0NyZWF0ZUdhbWVTZXJ2ZXJSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEjkKCWdhbWVfdHlwZRgDIAEoDjImLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVR5cGUSGQoMbWVtb3J5X2J5dGVzGAQgASgDSACIAQESFgoJY3B1X2NvcmVzGAUgASgFSAGIAQESEQoEcG9ydBgGIAEoBUgCiAEBEhkKDGRvY2tlcl9pbWFnZRgHIAEoCUgDiAEBEhoKDXN0YXJ0X2NvbW1hbmQYCCABKAlIBIgBARJUCghlbnZfdmFycxgJIAMoCzJCLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuQ3JlYXRlR2FtZVNlcnZlclJlcXVlc3QuRW52VmFyc0VudHJ5EhsKDnNlcnZlcl92ZXJzaW9uGAogASgJSAWIAQESGAoLZGVzY3JpcHRpb24YCyABKAlIBogBARIeChFleHRyYV9wb3J0c19jb3VudBgMIAEoBUgHiAEBEhQKB3RlYW1faWQYDSABKAlICIgBARouCgxFbnZWYXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIPCg1fbWVtb3J5X2J5dGVzQgwKCl9jcHVfY29yZXNCBwoFX3BvcnRCDwoNX2RvY2tlcl9pbWFnZUIQCg5fc3RhcnRfY29tbWFuZEIRCg9fc2VydmVyX3ZlcnNpb25CDgoMX2Rlc2NyaXB0aW9uQhQKEl9leHRyYV9wb3J0c19jb3VudEIKCghfdGVhbV9pZCJZChhDcmVhdGVHYW1lU2VydmVyUmVzcG9uc2USPQoLZ2FtZV9zZXJ2ZXIYASABKAsyKC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXIiLgoUR2V0R2FtZVNlcnZlclJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkiVgoVR2V0R2FtZVNlcnZlclJlc3BvbnNlEj0KC2dhbWVfc2VydmVyGAEgASgLMigub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyIuMDChdVcGRhdGVHYW1lU2VydmVyUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRIRCgRuYW1lGAIgASgJSACIAQESGQoMbWVtb3J5X2J5dGVzGAMgASgDSAGIAQESFgoJY3B1X2NvcmVzGAQgASgFSAKIAQESVAoIZW52X3ZhcnMYBSADKAsyQi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlVwZGF0ZUdhbWVTZXJ2ZXJSZXF1ZXN0LkVudlZhcnNFbnRyeRIaCg1zdGFydF9jb21tYW5kGAYgASgJSAOIAQESGAoLZGVzY3JpcHRpb24YByABKAlIBIgBARIbCg5zZXJ2ZXJfdmVyc2lvbhgIIAEoCUgFiAEBEh4KEWV4dHJhX3BvcnRzX2NvdW50GAkgASgFSAaIAQEaLgoMRW52VmFyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCBwoFX25hbWVCDwoNX21lbW9yeV9ieXRlc0IMCgpfY3B1X2NvcmVzQhAKDl9zdGFydF9jb21tYW5kQg4KDF9kZXNjcmlwdGlvbkIRCg9fc2VydmVyX3ZlcnNpb25CFAoSX2V4dHJhX3BvcnRzX2NvdW50IlkKGFVwZGF0ZUdhbWVTZXJ2ZXJSZXNwb25zZRI9CgtnYW1lX3NlcnZlchgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlciIxChdEZWxldGVHYW1lU2VydmVyUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCSIrChhEZWxldGVHYW1lU2VydmVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIwChZTdGFydEdhbWVTZXJ2ZXJSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJIlgKF1N0YXJ0R2FtZVNlcnZlclJlc3BvbnNlEj0KC2dhbWVfc2VydmVyGAEgASgLMigub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyIi8KFVN0b3BHYW1lU2VydmVyUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCSJXChZTdG9wR2FtZVNlcnZlclJlc3BvbnNlEj0KC2dhbWVfc2VydmVyGAEgASgLMigub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyIjIKGFJlc3RhcnRHYW1lU2VydmVyUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCSJaChlSZXN0YXJ0R2FtZVNlcnZlclJlc3BvbnNlEj0KC2dhbWVfc2VydmVyGAEgASgLMigub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyIkoKH0V4ZWN1dGVHYW1lU2VydmVyQ29tbWFuZFJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSDwoHY29tbWFuZBgCIAEoCSIyCiBFeGVjdXRlR2FtZVNlcnZlckNvbW1hbmRSZXNwb25zZRIOCgZvdXRwdXQYASABKAkisQEKD1BsYXllckxpc3RFbnRyeRIMCgR1dWlkGAEgASgJEgwKBG5hbWUYAiABKAkSFAoHY3JlYXRlZBgDIAEoCUgAiAEBEhMKBnNvdXJjZRgEIAEoCUgBiAEBEhQKB2V4cGlyZXMYBSABKAlIAogBARITCgZyZWFzb24YBiABKAlIA4gBAUIKCghfY3JlYXRlZEIJCgdfc291cmNlQgoKCF9leHBpcmVzQgkKB19yZWFzb24iiwEKHE1hbmFnZVBsYXllcldoaXRlbGlzdFJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSPgoGYWN0aW9uGAIgASgOMi4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5QbGF5ZXJMaXN0QWN0aW9uEhMKC3BsYXllcl9uYW1lGAMgASgJIm8KHU1hbmFnZVBsYXllcldoaXRlbGlzdFJlc3BvbnNlEj4KB3BsYXllcnMYASADKAsyLS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlBsYXllckxpc3RFbnRyeRIOCgZvdXRwdXQYAiABKAkiiQEKGk1hbmFnZVBsYXllckJhbkxpc3RSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEj4KBmFjdGlvbhgCIAEoDjIuLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuUGxheWVyTGlzdEFjdGlvbhITCgtwbGF5ZXJfbmFtZRgDIAEoCSJtChtNYW5hZ2VQbGF5ZXJCYW5MaXN0UmVzcG9uc2USPgoHcGxheWVycxgBIAMoCzItLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuUGxheWVyTGlzdEVudHJ5Eg4KBm91dHB1dBgCIAEoCSLQAQoTR2FtZVNlcnZlckhUVFBSb3V0ZRIKCgJpZBgBIAEoCRIWCg5nYW1lX3NlcnZlcl9pZBgCIAEoCRIOCgZkb21haW4YAyABKAkSEwoLcGF0aF9wcmVmaXgYBCABKAkSEwoLdGFyZ2V0X3BvcnQYBSABKAUSEAoIcHJvdG9jb2wYBiABKAkSEwoLc3NsX2VuYWJsZWQYByABKAgSHgoRc3NsX2NlcnRfcmVzb2x2ZXIYCCABKAlIAIgBAUIUChJfc3NsX2NlcnRfcmVzb2x2ZXIiUQoeR2V0R2FtZVNlcnZlckhUVFBSb3V0ZXNSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCSJkCh9HZXRHYW1lU2VydmVySFRUUFJvdXRlc1Jlc3BvbnNlEkEKBnJvdXRlcxgBIAMoCzIxLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckhUVFBSb3V0ZSLKAgogVXBzZXJ0R2FtZVNlcnZlckhUVFBSb3V0ZVJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEhUKCHJvdXRlX2lkGAMgASgJSACIAQESDgoGZG9tYWluGAQgASgJEhgKC3BhdGhfcHJlZml4GAUgASgJSAGIAQESEwoLdGFyZ2V0X3BvcnQYBiABKAUSFQoIcHJvdG9jb2wYByABKAlIAogBARIYCgtzc2xfZW5hYmxlZBgIIAEoCEgDiAEBEh4KEXNzbF9jZXJ0X3Jlc29sdmVyGAkgASgJSASIAQFCCwoJX3JvdXRlX2lkQg4KDF9wYXRoX3ByZWZpeEILCglfcHJvdG9jb2xCDgoMX3NzbF9lbmFibGVkQhQKEl9zc2xfY2VydF9yZXNvbHZlciJlCiFVcHNlcnRHYW1lU2VydmVySFRUUFJvdXRlUmVzcG9uc2USQAoFcm91dGUYASABKAsyMS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJIVFRQUm91dGUiZQogRGVsZXRlR2FtZVNlcnZlckhUVFBSb3V0ZVJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEhAKCHJvdXRlX2lkGAMgASgJIjQKIURlbGV0ZUdhbWVTZXJ2ZXJIVFRQUm91dGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIm4KK0dldEdhbWVTZXJ2ZXJEb21haW5WZXJpZmljYXRpb25Ub2tlblJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEg4KBmRvbWFpbhgDIAEoCSKQAQosR2V0R2FtZVNlcnZlckRvbWFpblZlcmlmaWNhdGlvblRva2VuUmVzcG9uc2USDgoGZG9tYWluGAEgASgJEg0KBXRva2VuGAIgASgJEhcKD3R4dF9yZWNvcmRfbmFtZRgDIAEoCRIYChB0eHRfcmVjb3JkX3ZhbHVlGAQgASgJEg4KBnN0YXR1cxgFIAEoCSJgCh1WZXJpZnlHYW1lU2VydmVyRG9tYWluUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSDgoGZG9tYWluGAMgASgJInQKHlZlcmlmeUdhbWVTZXJ2ZXJEb21haW5SZXNwb25zZRIOCgZkb21haW4YASABKAkSEAoIdmVyaWZpZWQYAiABKAgSDgoGc3RhdHVzGAMgASgJEhQKB21lc3NhZ2UYBCABKAlIAIgBAUIKCghfbWVzc2FnZSI3Ch1TdHJlYW1HYW1lU2VydmVyU3RhdHVzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCSLBAQoWR2FtZVNlcnZlclN0YXR1c1VwZGF0ZRIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRI+CgZzdGF0dXMYAiABKA4yLi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJTdGF0dXMSFAoHbWVzc2FnZRgDIAEoCUgAiAEBEi0KCXRpbWVzdGFtcBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCCgoIX21lc3NhZ2Ui8AEKGEdldEdhbWVTZXJ2ZXJMb2dzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRISCgVsaW1pdBgCIAEoBUgAiAEBEi4KBXNpbmNlGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEi4KBXVudGlsGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBEhkKDHNlYXJjaF9xdWVyeRgFIAEoCUgDiAEBQggKBl9saW1pdEIICgZfc2luY2VCCAoGX3VudGlsQg8KDV9zZWFyY2hfcXVlcnkiWwoZR2V0R2FtZVNlcnZlckxvZ3NSZXNwb25zZRI+CgVsaW5lcxgBIAMoCzIvLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckxvZ0xpbmUikQIKG1N0cmVhbUdhbWVTZXJ2ZXJMb2dzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRITCgZmb2xsb3cYAiABKAhIAIgBARIRCgR0YWlsGAMgASgFSAGIAQESLgoFc2luY2UYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAKIAQESLgoFdW50aWwYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAOIAQESGQoMc2VhcmNoX3F1ZXJ5GAYgASgJSASIAQFCCQoHX2ZvbGxvd0IHCgVfdGFpbEIICgZfc2luY2VCCAoGX3VudGlsQg8KDV9zZWFyY2hfcXVlcnkioQEKEUdhbWVTZXJ2ZXJMb2dMaW5lEgwKBGxpbmUYASABKAkSLQoJdGltZXN0YW1wGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI1CgVsZXZlbBgDIAEoDjIhLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLkxvZ0xldmVsSACIAQESDgoGc3RkZXJyGAQgASgIQggKBl9sZXZlbCLjAQobR2V0R2FtZVNlcnZlck1ldHJpY3NSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEjMKCnN0YXJ0X3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESMQoIZW5kX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESGAoLYWdncmVnYXRpb24YBCABKAlIAogBAUINCgtfc3RhcnRfdGltZUILCglfZW5kX3RpbWVCDgoMX2FnZ3JlZ2F0aW9uIl8KHEdldEdhbWVTZXJ2ZXJNZXRyaWNzUmVzcG9uc2USPwoHbWV0cmljcxgBIAMoCzIuLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlck1ldHJpYyI4Ch5TdHJlYW1HYW1lU2VydmVyTWV0cmljc1JlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkivQQKEEdhbWVTZXJ2ZXJNZXRyaWMSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSLQoJdGltZXN0YW1wGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIeChFjcHVfdXNhZ2VfcGVyY2VudBgDIAEoAUgAiAEBEh8KEm1lbW9yeV91c2FnZV9ieXRlcxgEIAEoA0gBiAEBEh8KEm1lbW9yeV9saW1pdF9ieXRlcxgFIAEoA0gCiAEBEh0KEG5ldHdvcmtfcnhfYnl0ZXMYBiABKANIA4gBARIdChBuZXR3b3JrX3R4X2J5dGVzGAcgASgDSASIAQESHAoPZGlza19yZWFkX2J5dGVzGAogASgDSAWIAQESHQoQZGlza193cml0ZV9ieXRlcxgLIAEoA0gGiAEBEhkKDHBsYXllcl9jb3VudBgIIAEoBUgHiAEBEhgKC21heF9wbGF5ZXJzGAkgASgFSAiIAQESEAoDdHBzGAwgASgBSAmIAQFCFAoSX2NwdV91c2FnZV9wZXJjZW50QhUKE19tZW1vcnlfdXNhZ2VfYnl0ZXNCFQoTX21lbW9yeV9saW1pdF9ieXRlc0ITChFfbmV0d29ya19yeF9ieXRlc0ITChFfbmV0d29ya190eF9ieXRlc0ISChBfZGlza19yZWFkX2J5dGVzQhMKEV9kaXNrX3dyaXRlX2J5dGVzQg8KDV9wbGF5ZXJfY291bnRCDgoMX21heF9wbGF5ZXJzQgYKBF90cHMiagoZR2V0R2FtZVNlcnZlclVzYWdlUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEgoFbW9udGgYAyABKAlIAIgBAUIICgZfbW9udGgi9AEKGkdldEdhbWVTZXJ2ZXJVc2FnZVJlc3BvbnNlEhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRINCgVtb250aBgDIAEoCRJFCgdjdXJyZW50GAQgASgLMjQub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyVXNhZ2VNZXRyaWNzEk8KEWVzdGltYXRlZF9tb250aGx5GAUgASgLMjQub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyVXNhZ2VNZXRyaWNzIq4DChZHYW1lU2VydmVyVXNhZ2VNZXRyaWNzEhgKEGNwdV9jb3JlX3NlY29uZHMYASABKAMSGwoTbWVtb3J5X2J5dGVfc2Vjb25kcxgCIAEoAxIaChJiYW5kd2lkdGhfcnhfYnl0ZXMYAyABKAMSGgoSYmFuZHdpZHRoX3R4X2J5dGVzGAQgASgDEhUKDXN0b3JhZ2VfYnl0ZXMYBSABKAMSFgoOdXB0aW1lX3NlY29uZHMYBiABKAMSHAoUZXN0aW1hdGVkX2Nvc3RfY2VudHMYByABKAMSGwoOY3B1X2Nvc3RfY2VudHMYCCABKANIAIgBARIeChFtZW1vcnlfY29zdF9jZW50cxgJIAEoA0gBiAEBEiEKFGJhbmR3aWR0aF9jb3N0X2NlbnRzGAogASgDSAKIAQESHwoSc3RvcmFnZV9jb3N0X2NlbnRzGAsgASgDSAOIAQFCEQoPX2NwdV9jb3N0X2NlbnRzQhQKEl9tZW1vcnlfY29zdF9jZW50c0IXChVfYmFuZHdpZHRoX2Nvc3RfY2VudHNCFQoTX3N0b3JhZ2VfY29zdF9jZW50cyKoBwoKR2FtZVNlcnZlchIKCgJpZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSDAoEbmFtZRgDIAEoCRIYCgtkZXNjcmlwdGlvbhgEIAEoCUgAiAEBEjkKCWdhbWVfdHlwZRgFIAEoDjImLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVR5cGUSPgoGc3RhdHVzGAYgASgOMi4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyU3RhdHVzEhQKDG1lbW9yeV9ieXRlcxgHIAEoAxIRCgljcHVfY29yZXMYCCABKAUSDAoEcG9ydBgJIAEoBRITCgtleHRyYV9wb3J0cxgXIAMoBRIUCgxkb2NrZXJfaW1hZ2UYCiABKAkSGgoNc3RhcnRfY29tbWFuZBgLIAEoCUgBiAEBEkcKCGVudl92YXJzGAwgAygLMjUub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyLkVudlZhcnNFbnRyeRIbCg5zZXJ2ZXJfdmVyc2lvbhgNIAEoCUgCiAEBEhkKDHBsYXllcl9jb3VudBgOIAEoBUgDiAEBEhgKC21heF9wbGF5ZXJzGA8gASgFSASIAQESLgoKY3JlYXRlZF9hdBgQIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgRIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOAoPbGFzdF9zdGFydGVkX2F0GBIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgFiAEBEhkKDGNvbnRhaW5lcl9pZBgTIAEoCUgGiAEBEhsKDmNvbnRhaW5lcl9uYW1lGBQgASgJSAeIAQESFQoNc3RvcmFnZV9ieXRlcxgVIAEoAxISCgpjcmVhdGVkX2J5GBYgASgJGi4KDEVudlZhcnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg4KDF9kZXNjcmlwdGlvbkIQCg5fc3RhcnRfY29tbWFuZEIRCg9fc2VydmVyX3ZlcnNpb25CDwoNX3BsYXllcl9jb3VudEIOCgxfbWF4X3BsYXllcnNCEgoQX2xhc3Rfc3RhcnRlZF9hdEIPCg1fY29udGFpbmVyX2lkQhEKD19jb250YWluZXJfbmFtZSKDBAoOR2FtZVNlcnZlckZpbGUSDAoEbmFtZRgBIAEoCRIMCgRwYXRoGAIgASgJEhQKDGlzX2RpcmVjdG9yeRgDIAEoCBIMCgRzaXplGAQgASgDEhMKC3Blcm1pc3Npb25zGAUgASgJEhgKC3ZvbHVtZV9uYW1lGAYgASgJSACIAQESEgoFb3duZXIYByABKAlIAYgBARISCgVncm91cBgIIAEoCUgCiAEBEhcKCm1vZGVfb2N0YWwYCSABKA1IA4gBARIXCgppc19zeW1saW5rGAogASgISASIAQESGwoOc3ltbGlua190YXJnZXQYCyABKAlIBYgBARIWCgltaW1lX3R5cGUYDCABKAlIBogBARI2Cg1tb2RpZmllZF90aW1lGA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgHiAEBEjUKDGNyZWF0ZWRfdGltZRgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBICIgBAUIOCgxfdm9sdW1lX25hbWVCCAoGX293bmVyQggKBl9ncm91cEINCgtfbW9kZV9vY3RhbEINCgtfaXNfc3ltbGlua0IRCg9fc3ltbGlua190YXJnZXRCDAoKX21pbWVfdHlwZUIQCg5fbW9kaWZpZWRfdGltZUIPCg1fY3JlYXRlZF90aW1lImAKFEdhbWVTZXJ2ZXJWb2x1bWVJbmZvEgwKBG5hbWUYASABKAkSEwoLbW91bnRfcG9pbnQYAiABKAkSDgoGc291cmNlGAMgASgJEhUKDWlzX3BlcnNpc3RlbnQYBCABKAgi3gEKGkxpc3RHYW1lU2VydmVyRmlsZXNSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkSGAoLdm9sdW1lX25hbWUYAyABKAlIAIgBARITCgZjdXJzb3IYBCABKAlIAYgBARIWCglwYWdlX3NpemUYBSABKAVIAogBARIZCgxsaXN0X3ZvbHVtZXMYBiABKAhIA4gBAUIOCgxfdm9sdW1lX25hbWVCCQoHX2N1cnNvckIMCgpfcGFnZV9zaXplQg8KDV9saXN0X3ZvbHVtZXMinwIKG0xpc3RHYW1lU2VydmVyRmlsZXNSZXNwb25zZRI7CgVmaWxlcxgBIAMoCzIsLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckZpbGUSFAoMY3VycmVudF9wYXRoGAIgASgJEkMKB3ZvbHVtZXMYAyADKAsyMi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJWb2x1bWVJbmZvEhEKCWlzX3ZvbHVtZRgEIAEoCBIZChFjb250YWluZXJfcnVubmluZxgFIAEoCBIQCghoYXNfbW9yZRgGIAEoCBIYCgtuZXh0X2N1cnNvchgHIAEoCUgAiAEBQg4KDF9uZXh0X2N1cnNvciKbAgocU2VhcmNoR2FtZVNlcnZlckZpbGVzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRINCgVxdWVyeRgCIAEoCRIWCglyb290X3BhdGgYAyABKAlIAIgBARIYCgt2b2x1bWVfbmFtZRgEIAEoCUgBiAEBEhgKC21heF9yZXN1bHRzGAUgASgFSAKIAQESFwoKZmlsZXNfb25seRgGIAEoCEgDiAEBEh0KEGRpcmVjdG9yaWVzX29ubHkYByABKAhIBIgBAUIMCgpfcm9vdF9wYXRoQg4KDF92b2x1bWVfbmFtZUIOCgxfbWF4X3Jlc3VsdHNCDQoLX2ZpbGVzX29ubHlCEwoRX2RpcmVjdG9yaWVzX29ubHkioAEKHVNlYXJjaEdhbWVTZXJ2ZXJGaWxlc1Jlc3BvbnNlEj0KB3Jlc3VsdHMYASADKAsyLC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJGaWxlEhMKC3RvdGFsX2ZvdW5kGAIgASgFEhAKCGhhc19tb3JlGAMgASgIEhkKEWNvbnRhaW5lcl9ydW5uaW5nGAQgASgIImoKGEdldEdhbWVTZXJ2ZXJGaWxlUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRIMCgRwYXRoGAIgASgJEhgKC3ZvbHVtZV9uYW1lGAMgASgJSACIAQFCDgoMX3ZvbHVtZV9uYW1lIsQBChlHZXRHYW1lU2VydmVyRmlsZVJlc3BvbnNlEg8KB2NvbnRlbnQYASABKAkSEAoIZW5jb2RpbmcYAiABKAkSDAoEc2l6ZRgDIAEoAxIWCgl0cnVuY2F0ZWQYBCABKAhIAIgBARJDCghtZXRhZGF0YRgFIAEoCzIsLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckZpbGVIAYgBAUIMCgpfdHJ1bmNhdGVkQgsKCV9tZXRhZGF0YSJ/ChxVcGxvYWRHYW1lU2VydmVyRmlsZXNSZXF1ZXN0Ek0KCG1ldGFkYXRhGAEgASgLMjsub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5VcGxvYWRHYW1lU2VydmVyRmlsZXNNZXRhZGF0YRIQCgh0YXJfZGF0YRgCIAEoDCLAAQodVXBsb2FkR2FtZVNlcnZlckZpbGVzTWV0YWRhdGESFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSGAoQZGVzdGluYXRpb25fcGF0aBgCIAEoCRJDCgVmaWxlcxgDIAMoCzI0Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckZpbGVNZXRhZGF0YRIYCgt2b2x1bWVfbmFtZRgEIAEoCUgAiAEBQg4KDF92b2x1bWVfbmFtZSJYChZHYW1lU2VydmVyRmlsZU1ldGFkYXRhEgwKBG5hbWUYASABKAkSDAoEc2l6ZRgCIAEoAxIUCgxpc19kaXJlY3RvcnkYAyABKAgSDAoEcGF0aBgEIAEoCSJmCh1VcGxvYWRHYW1lU2VydmVyRmlsZXNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhIKBWVycm9yGAIgASgJSACIAQESFgoOZmlsZXNfdXBsb2FkZWQYAyABKAVCCAoGX2Vycm9yInoKIUNodW5rVXBsb2FkR2FtZVNlcnZlckZpbGVzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRI9CgZ1cGxvYWQYAiABKAsyLS5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5DaHVua2VkVXBsb2FkUGF5bG9hZCJrCiJDaHVua1VwbG9hZEdhbWVTZXJ2ZXJGaWxlc1Jlc3BvbnNlEkUKBnJlc3VsdBgBIAEoCzI1Lm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLkNodW5rZWRVcGxvYWRSZXNwb25zZVBheWxvYWQikwEKHkRlbGV0ZUdhbWVTZXJ2ZXJFbnRyaWVzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRINCgVwYXRocxgCIAMoCRIYCgt2b2x1bWVfbmFtZRgDIAEoCUgAiAEBEhEKCXJlY3Vyc2l2ZRgEIAEoCBINCgVmb3JjZRgFIAEoCEIOCgxfdm9sdW1lX25hbWUiPQocRGVsZXRlR2FtZVNlcnZlckVudHJpZXNFcnJvchIMCgRwYXRoGAEgASgJEg8KB21lc3NhZ2UYAiABKAkilQEKH0RlbGV0ZUdhbWVTZXJ2ZXJFbnRyaWVzUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIVCg1kZWxldGVkX3BhdGhzGAIgAygJEkoKBmVycm9ycxgDIAMoCzI6Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuRGVsZXRlR2FtZVNlcnZlckVudHJpZXNFcnJvciKdAQocUmVuYW1lR2FtZVNlcnZlckVudHJ5UmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRITCgtzb3VyY2VfcGF0aBgCIAEoCRITCgt0YXJnZXRfcGF0aBgDIAEoCRIYCgt2b2x1bWVfbmFtZRgEIAEoCUgAiAEBEhEKCW92ZXJ3cml0ZRgFIAEoCEIOCgxfdm9sdW1lX25hbWUifAodUmVuYW1lR2FtZVNlcnZlckVudHJ5UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBJACgVlbnRyeRgCIAEoCzIsLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckZpbGVIAIgBAUIICgZfZW50cnkikAIKHENyZWF0ZUdhbWVTZXJ2ZXJFbnRyeVJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSEwoLcGFyZW50X3BhdGgYAiABKAkSDAoEbmFtZRgDIAEoCRI/CgR0eXBlGAQgASgOMjEub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyRW50cnlUeXBlEhUKCHRlbXBsYXRlGAUgASgJSACIAQESGAoLdm9sdW1lX25hbWUYBiABKAlIAYgBARIXCgptb2RlX29jdGFsGAcgASgNSAKIAQFCCwoJX3RlbXBsYXRlQg4KDF92b2x1bWVfbmFtZUINCgtfbW9kZV9vY3RhbCJcCh1DcmVhdGVHYW1lU2VydmVyRW50cnlSZXNwb25zZRI7CgVlbnRyeRgBIAEoCzIsLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckZpbGUi0gEKGldyaXRlR2FtZVNlcnZlckZpbGVSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEgwKBHBhdGgYAiABKAkSGAoLdm9sdW1lX25hbWUYAyABKAlIAIgBARIPCgdjb250ZW50GAQgASgJEhAKCGVuY29kaW5nGAUgASgJEhkKEWNyZWF0ZV9pZl9taXNzaW5nGAYgASgIEhcKCm1vZGVfb2N0YWwYByABKA1IAYgBAUIOCgxfdm9sdW1lX25hbWVCDQoLX21vZGVfb2N0YWwimAEKG1dyaXRlR2FtZVNlcnZlckZpbGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEkAKBWVudHJ5GAIgASgLMiwub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyRmlsZUgAiAEBEhIKBWVycm9yGAMgASgJSAGIAQFCCAoGX2VudHJ5QggKBl9lcnJvciKMAQocRXh0cmFjdEdhbWVTZXJ2ZXJGaWxlUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRIQCgh6aXBfcGF0aBgCIAEoCRIYChBkZXN0aW5hdGlvbl9wYXRoGAMgASgJEhgKC3ZvbHVtZV9uYW1lGAQgASgJSACIAQFCDgoMX3ZvbHVtZV9uYW1lImcKHUV4dHJhY3RHYW1lU2VydmVyRmlsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSEgoFZXJyb3IYAiABKAlIAIgBARIXCg9maWxlc19leHRyYWN0ZWQYAyABKAVCCAoGX2Vycm9yIrgBCiJDcmVhdGVHYW1lU2VydmVyRmlsZUFyY2hpdmVSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJElAKD2FyY2hpdmVfcmVxdWVzdBgCIAEoCzI3Lm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLkNyZWF0ZVNlcnZlckZpbGVBcmNoaXZlUmVxdWVzdBIYCgt2b2x1bWVfbmFtZRgDIAEoCUgAiAEBQg4KDF92b2x1bWVfbmFtZSJ5CiNDcmVhdGVHYW1lU2VydmVyRmlsZUFyY2hpdmVSZXNwb25zZRJSChBhcmNoaXZlX3Jlc3BvbnNlGAEgASgLMjgub2JpZW50ZS5jbG91ZC5jb21tb24udjEuQ3JlYXRlU2VydmVyRmlsZUFyY2hpdmVSZXNwb25zZSKBAwogR2FtZVNlcnZlckZpbGVUcmFuc2ZlckNyZWRlbnRpYWwSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIQCgh1c2VybmFtZRgDIAEoCRIOCgZzY29wZXMYBCADKAkSNQoMbGFzdF91c2VkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEjMKCmV4cGlyZXNfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNYWxsb3dlZF9wYXRocxgIIAMoCRJOCg9hdXRob3JpemVkX2tleXMYCSADKAsyNS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJBdXRob3JpemVkS2V5Qg8KDV9sYXN0X3VzZWRfYXRCDQoLX2V4cGlyZXNfYXQiQgoXR2FtZVNlcnZlckF1dGhvcml6ZWRLZXkSEgoKcHVibGljX2tleRgBIAEoCRITCgtmaW5nZXJwcmludBgCIAEoCSJ3CiRHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ29ubmVjdGlvbkluZm8SDAoEaG9zdBgBIAEoCRIMCgRwb3J0GAIgASgFEhAKCHVzZXJuYW1lGAMgASgJEhAKCHByb3RvY29sGAQgASgJEg8KB2NvbW1hbmQYBSABKAkiRgosTGlzdEdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDcmVkZW50aWFsc1JlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAki3AEKLUxpc3RHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbHNSZXNwb25zZRJTCgtjcmVkZW50aWFscxgBIAMoCzI+Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckZpbGVUcmFuc2ZlckNyZWRlbnRpYWwSVgoKY29ubmVjdGlvbhgCIAEoCzJCLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckZpbGVUcmFuc2ZlckNvbm5lY3Rpb25JbmZvIsABCi1DcmVhdGVHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbFJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSDAoEbmFtZRgCIAEoCRIOCgZzY29wZXMYAyADKAkSMwoKZXhwaXJlc19hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARIVCg1hbGxvd2VkX3BhdGhzGAUgAygJQg0KC19leHBpcmVzX2F0Iu4BCi5DcmVhdGVHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbFJlc3BvbnNlElIKCmNyZWRlbnRpYWwYASABKAsyPi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDcmVkZW50aWFsEhAKCHBhc3N3b3JkGAIgASgJElYKCmNvbm5lY3Rpb24YAyABKAsyQi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDb25uZWN0aW9uSW5mbyJeCi1SZXZva2VHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbFJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSFQoNY3JlZGVudGlhbF9pZBgCIAEoCSJBCi5SZXZva2VHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiXAoXQWRkQXV0aG9yaXplZEtleVJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSFQoNY3JlZGVudGlhbF9pZBgCIAEoCRISCgpwdWJsaWNfa2V5GAMgASgJIm4KGEFkZEF1dGhvcml6ZWRLZXlSZXNwb25zZRJSCgpjcmVkZW50aWFsGAEgASgLMj4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbCJgChpSZW1vdmVBdXRob3JpemVkS2V5UmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRIVCg1jcmVkZW50aWFsX2lkGAIgASgJEhMKC2ZpbmdlcnByaW50GAMgASgJInEKG1JlbW92ZUF1dGhvcml6ZWRLZXlSZXNwb25zZRJSCgpjcmVkZW50aWFsGAEgASgLMj4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbCIxCh1HZXRNaW5lY3JhZnRQbGF5ZXJVVUlEUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCSJYCh5HZXRNaW5lY3JhZnRQbGF5ZXJVVUlEUmVzcG9uc2USEQoEdXVpZBgBIAEoCUgAiAEBEhEKBG5hbWUYAiABKAlIAYgBAUIHCgVfdXVpZEIHCgVfbmFtZSIwCiBHZXRNaW5lY3JhZnRQbGF5ZXJQcm9maWxlUmVxdWVzdBIMCgR1dWlkGAEgASgJIoMBCiFHZXRNaW5lY3JhZnRQbGF5ZXJQcm9maWxlUmVzcG9uc2USEQoEdXVpZBgBIAEoCUgAiAEBEhEKBG5hbWUYAiABKAlIAYgBARIXCgphdmF0YXJfdXJsGAMgASgJSAKIAQFCBwoFX3V1aWRCBwoFX25hbWVCDQoLX2F2YXRhcl91cmwi+QMKEE1pbmVjcmFmdFByb2plY3QSCgoCaWQYASABKAkSDAoEc2x1ZxgCIAEoCRINCgV0aXRsZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRJICgxwcm9qZWN0X3R5cGUYBSABKA4yMi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1pbmVjcmFmdFByb2plY3RUeXBlEhAKCGljb25fdXJsGAYgASgJEhIKCmNhdGVnb3JpZXMYByADKAkSDwoHbG9hZGVycxgIIAMoCRIVCg1nYW1lX3ZlcnNpb25zGAkgAygJEg8KB2F1dGhvcnMYCiADKAkSEQoJZG93bmxvYWRzGAsgASgDEg4KBnJhdGluZxgMIAEoARIeChFsYXRlc3RfdmVyc2lvbl9pZBgNIAEoCUgAiAEBEhgKC3Byb2plY3RfdXJsGA4gASgJSAGIAQESFwoKc291cmNlX3VybBgPIAEoCUgCiAEBEhcKCmlzc3Vlc191cmwYECABKAlIA4gBARIRCgRib2R5GBEgASgJSASIAQESDwoHZ2FsbGVyeRgSIAMoCUIUChJfbGF0ZXN0X3ZlcnNpb25faWRCDgoMX3Byb2plY3RfdXJsQg0KC19zb3VyY2VfdXJsQg0KC19pc3N1ZXNfdXJsQgcKBV9ib2R5IpgCChxMaXN0TWluZWNyYWZ0UHJvamVjdHNSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhIKBXF1ZXJ5GAIgASgJSACIAQESFQoNZ2FtZV92ZXJzaW9ucxgDIAMoCRIPCgdsb2FkZXJzGAQgAygJEhIKCmNhdGVnb3JpZXMYBSADKAkSEwoGY3Vyc29yGAYgASgJSAGIAQESEgoFbGltaXQYByABKAVIAogBARJICgxwcm9qZWN0X3R5cGUYCCABKA4yMi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1pbmVjcmFmdFByb2plY3RUeXBlQggKBl9xdWVyeUIJCgdfY3Vyc29yQggKBl9saW1pdCKdAQodTGlzdE1pbmVjcmFmdFByb2plY3RzUmVzcG9uc2USQAoIcHJvamVjdHMYASADKAsyLi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1pbmVjcmFmdFByb2plY3QSEAoIaGFzX21vcmUYAiABKAgSGAoLbmV4dF9jdXJzb3IYAyABKAlIAIgBAUIOCgxfbmV4dF9jdXJzb3IilAYKHUluc3RhbGxlZE1pbmVjcmFmdFByb2plY3RGaWxlEgoKAmlkGAEgASgJEhAKCGZpbGVuYW1lGAIgASgJEhYKDmluc3RhbGxlZF9wYXRoGAMgASgJEkgKDHByb2plY3RfdHlwZRgEIAEoDjIyLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTWluZWNyYWZ0UHJvamVjdFR5cGUSEgoKc2l6ZV9ieXRlcxgFIAEoAxI0Cgttb2RpZmllZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARIPCgdtYW5hZ2VkGAcgASgIEhcKCnByb2plY3RfaWQYCCABKAlIAYgBARIZCgxwcm9qZWN0X3NsdWcYCSABKAlIAogBARISCgV0aXRsZRgKIAEoCUgDiAEBEhUKCGljb25fdXJsGAsgASgJSASIAQESFwoKdmVyc2lvbl9pZBgMIAEoCUgFiAEBEhsKDnZlcnNpb25fbnVtYmVyGA0gASgJSAaIAQESFQoNZ2FtZV92ZXJzaW9ucxgOIAMoCRIPCgdsb2FkZXJzGA8gAygJEjUKDGluc3RhbGxlZF9hdBgQIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIB4gBARIYChB1cGRhdGVfYXZhaWxhYmxlGBEgASgIEh4KEWxhdGVzdF92ZXJzaW9uX2lkGBIgASgJSAiIAQESIgoVbGF0ZXN0X3ZlcnNpb25fbnVtYmVyGBMgASgJSAmIAQESHAoUbGF0ZXN0X2dhbWVfdmVyc2lvbnMYFCADKAlCDgoMX21vZGlmaWVkX2F0Qg0KC19wcm9qZWN0X2lkQg8KDV9wcm9qZWN0X3NsdWdCCAoGX3RpdGxlQgsKCV9pY29uX3VybEINCgtfdmVyc2lvbl9pZEIRCg9fdmVyc2lvbl9udW1iZXJCDwoNX2luc3RhbGxlZF9hdEIUChJfbGF0ZXN0X3ZlcnNpb25faWRCGAoWX2xhdGVzdF92ZXJzaW9uX251bWJlciK3AQolTGlzdEluc3RhbGxlZE1pbmVjcmFmdFByb2plY3RzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRJICgxwcm9qZWN0X3R5cGUYAiABKA4yMi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1pbmVjcmFmdFByb2plY3RUeXBlEhoKDWNoZWNrX3VwZGF0ZXMYAyABKAhIAIgBAUIQCg5fY2hlY2tfdXBkYXRlcyJ0CiZMaXN0SW5zdGFsbGVkTWluZWNyYWZ0UHJvamVjdHNSZXNwb25zZRJKCgVmaWxlcxgBIAMoCzI7Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuSW5zdGFsbGVkTWluZWNyYWZ0UHJvamVjdEZpbGUi2QEKFE1pbmVjcmFmdFByb2plY3RGaWxlEhAKCGZpbGVuYW1lGAEgASgJEgsKA3VybBgCIAEoCRISCgpzaXplX2J5dGVzGAMgASgDEk4KBmhhc2hlcxgEIAMoCzI+Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTWluZWNyYWZ0UHJvamVjdEZpbGUuSGFzaGVzRW50cnkSDwoHcHJpbWFyeRgFIAEoCBotCgtIYXNoZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIo4DChdNaW5lY3JhZnRQcm9qZWN0VmVyc2lvbhIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhYKDnZlcnNpb25fbnVtYmVyGAMgASgJEhUKDWdhbWVfdmVyc2lvbnMYBCADKAkSDwoHbG9hZGVycxgFIAMoCRIdChVzZXJ2ZXJfc2lkZV9zdXBwb3J0ZWQYBiABKAgSHQoVY2xpZW50X3NpZGVfc3VwcG9ydGVkGAcgASgIEjUKDHB1Ymxpc2hlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARIWCgljaGFuZ2Vsb2cYCSABKAlIAYgBARJBCgVmaWxlcxgKIAMoCzIyLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTWluZWNyYWZ0UHJvamVjdEZpbGUSGQoMdmVyc2lvbl90eXBlGAsgASgJSAKIAQFCDwoNX3B1Ymxpc2hlZF9hdEIMCgpfY2hhbmdlbG9nQg8KDV92ZXJzaW9uX3R5cGUimgIKIkdldE1pbmVjcmFmdFByb2plY3RWZXJzaW9uc1JlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSEgoKcHJvamVjdF9pZBgCIAEoCRIVCg1nYW1lX3ZlcnNpb25zGAMgAygJEg8KB2xvYWRlcnMYBCADKAkSSAoMcHJvamVjdF90eXBlGAUgASgOMjIub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5NaW5lY3JhZnRQcm9qZWN0VHlwZRISCgVsaW1pdBgGIAEoBUgAiAEBEiAKE2luY2x1ZGVfcHJlcmVsZWFzZXMYByABKAhIAYgBAUIICgZfbGltaXRCFgoUX2luY2x1ZGVfcHJlcmVsZWFzZXMibgojR2V0TWluZWNyYWZ0UHJvamVjdFZlcnNpb25zUmVzcG9uc2USRwoIdmVyc2lvbnMYASADKAsyNS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1pbmVjcmFmdFByb2plY3RWZXJzaW9uIkgKGkdldE1pbmVjcmFmdFByb2plY3RSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkiXgobR2V0TWluZWNyYWZ0UHJvamVjdFJlc3BvbnNlEj8KB3Byb2plY3QYASABKAsyLi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1pbmVjcmFmdFByb2plY3QivAIKIkluc3RhbGxNaW5lY3JhZnRQcm9qZWN0RmlsZVJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSEgoKcHJvamVjdF9pZBgCIAEoCRISCgp2ZXJzaW9uX2lkGAMgASgJEkgKDHByb2plY3RfdHlwZRgEIAEoDjIyLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTWluZWNyYWZ0UHJvamVjdFR5cGUSGgoNcHJvamVjdF90aXRsZRgFIAEoCUgAiAEBEhkKDHByb2plY3Rfc2x1ZxgGIAEoCUgBiAEBEh0KEHByb2plY3RfaWNvbl91cmwYByABKAlIAogBAUIQCg5fcHJvamVjdF90aXRsZUIPCg1fcHJvamVjdF9zbHVnQhMKEV9wcm9qZWN0X2ljb25fdXJsIpwBCiNJbnN0YWxsTWluZWNyYWZ0UHJvamVjdEZpbGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhAKCGZpbGVuYW1lGAIgASgJEhYKDmluc3RhbGxlZF9wYXRoGAMgASgJEhgKEHJlc3RhcnRfcmVxdWlyZWQYBCABKAgSFAoHbWVzc2FnZRgFIAEoCUgAiAEBQgoKCF9tZXNzYWdlItUCCiFVcGRhdGVNaW5lY3JhZnRQcm9qZWN0RmlsZVJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSEgoKcHJvamVjdF9pZBgCIAEoCRISCgp2ZXJzaW9uX2lkGAMgASgJEkgKDHByb2plY3RfdHlwZRgEIAEoDjIyLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTWluZWNyYWZ0UHJvamVjdFR5cGUSGAoQY3VycmVudF9maWxlbmFtZRgFIAEoCRIaCg1wcm9qZWN0X3RpdGxlGAYgASgJSACIAQESGQoMcHJvamVjdF9zbHVnGAcgASgJSAGIAQESHQoQcHJvamVjdF9pY29uX3VybBgIIAEoCUgCiAEBQhAKDl9wcm9qZWN0X3RpdGxlQg8KDV9wcm9qZWN0X3NsdWdCEwoRX3Byb2plY3RfaWNvbl91cmwi0QEKIlVwZGF0ZU1pbmVjcmFmdFByb2plY3RGaWxlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIQCghmaWxlbmFtZRgCIAEoCRIWCg5pbnN0YWxsZWRfcGF0aBgDIAEoCRIeChFyZXBsYWNlZF9maWxlbmFtZRgEIAEoCUgAiAEBEhgKEHJlc3RhcnRfcmVxdWlyZWQYBSABKAgSFAoHbWVzc2FnZRgGIAEoCUgBiAEBQhQKEl9yZXBsYWNlZF9maWxlbmFtZUIKCghfbWVzc2FnZSKoAQoNR2FtZVNlcnZlck1vZBIKCgJpZBgBIAEoCRIWCg5nYW1lX3NlcnZlcl9pZBgCIAEoCRIOCgZzb3VyY2UYAyABKAkSDgoGbW9kX2lkGAQgASgJEg8KB3ZlcnNpb24YBSABKAkSEAoIZmlsZW5hbWUYBiABKAkSMAoMaW5zdGFsbGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJmChtJbnN0YWxsR2FtZVNlcnZlck1vZFJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSDgoGc291cmNlGAIgASgJEg4KBm1vZF9pZBgDIAEoCRIPCgd2ZXJzaW9uGAQgASgJIlgKHEluc3RhbGxHYW1lU2VydmVyTW9kUmVzcG9uc2USOAoDbW9kGAEgASgLMisub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyTW9kIlcKHVVuaW5zdGFsbEdhbWVTZXJ2ZXJNb2RSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEg4KBnNvdXJjZRgCIAEoCRIOCgZtb2RfaWQYAyABKAkiMQoeVW5pbnN0YWxsR2FtZVNlcnZlck1vZFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiMwoZTGlzdEdhbWVTZXJ2ZXJNb2RzUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCSJXChpMaXN0R2FtZVNlcnZlck1vZHNSZXNwb25zZRI5CgRtb2RzGAEgAygLMisub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyTW9kIoACChBHYW1lU2VydmVyQmFja3VwEgoKAmlkGAEgASgJEhYKDmdhbWVfc2VydmVyX2lkGAIgASgJEhIKCnNpemVfYnl0ZXMYAyABKAMSDgoGc3RhdHVzGAQgASgJEhoKDWVycm9yX21lc3NhZ2UYBSABKAlIAIgBARIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI1Cgxjb21wbGV0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQFCEAoOX2Vycm9yX21lc3NhZ2VCDwoNX2NvbXBsZXRlZF9hdCJxCh9TY2hlZHVsZUdhbWVTZXJ2ZXJCYWNrdXBSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhAKCHNjaGVkdWxlGAIgASgJEhYKCXJldGVudGlvbhgDIAEoBUgAiAEBQgwKCl9yZXRlbnRpb24iRwogU2NoZWR1bGVHYW1lU2VydmVyQmFja3VwUmVzcG9uc2USEAoIc2NoZWR1bGUYASABKAkSEQoJcmV0ZW50aW9uGAIgASgFIjYKHExpc3RHYW1lU2VydmVyQmFja3Vwc1JlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkiYAodTGlzdEdhbWVTZXJ2ZXJCYWNrdXBzUmVzcG9uc2USPwoHYmFja3VwcxgBIAMoCzIuLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckJhY2t1cCJLCh5SZXN0b3JlR2FtZVNlcnZlckJhY2t1cFJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSEQoJYmFja3VwX2lkGAIgASgJIjIKH1Jlc3RvcmVHYW1lU2VydmVyQmFja3VwUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCK8AQoSRGlzY29yZEludGVncmF0aW9uEgoKAmlkGAEgASgJEhYKDmdhbWVfc2VydmVyX2lkGAIgASgJEhIKCmNoYW5uZWxfaWQYAyABKAkSDgoGZXZlbnRzGAQgAygJEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIoABChxTZXREaXNjb3JkSW50ZWdyYXRpb25SZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhYKCWJvdF90b2tlbhgCIAEoCUgAiAEBEhIKCmNoYW5uZWxfaWQYAyABKAkSDgoGZXZlbnRzGAQgAygJQgwKCl9ib3RfdG9rZW4iZgodU2V0RGlzY29yZEludGVncmF0aW9uUmVzcG9uc2USRQoLaW50ZWdyYXRpb24YASABKAsyMC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkRpc2NvcmRJbnRlZ3JhdGlvbiI5Ch9EZWxldGVEaXNjb3JkSW50ZWdyYXRpb25SZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJIjMKIERlbGV0ZURpc2NvcmRJbnRlZ3JhdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiqwEKEklkbGVTaHV0ZG93blBvbGljeRIcChRpZGxlX3RpbWVvdXRfbWludXRlcxgBIAEoBRIhChltaW5fcGxheWVyc190b19zdGF5X2FsaXZlGAIgASgFEjwKE2xhc3RfcGxheWVyX3NlZW5fYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQFCFgoUX2xhc3RfcGxheWVyX3NlZW5fYXQidwocU2V0SWRsZVNodXRkb3duUG9saWN5UmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRIcChRpZGxlX3RpbWVvdXRfbWludXRlcxgCIAEoBRIhChltaW5fcGxheWVyc190b19zdGF5X2FsaXZlGAMgASgFImEKHVNldElkbGVTaHV0ZG93blBvbGljeVJlc3BvbnNlEkAKBnBvbGljeRgBIAEoCzIwLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuSWRsZVNodXRkb3duUG9saWN5IjYKHEdldElkbGVTaHV0ZG93blBvbGljeVJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkiYQodR2V0SWRsZVNodXRkb3duUG9saWN5UmVzcG9uc2USQAoGcG9saWN5GAEgASgLMjAub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5JZGxlU2h1dGRvd25Qb2xpY3kq6QEKCEdhbWVUeXBlEhkKFUdBTUVfVFlQRV9VTlNQRUNJRklFRBAAEg0KCU1JTkVDUkFGVBABEhIKDk1JTkVDUkFGVF9KQVZBEAISFQoRTUlORUNSQUZUX0JFRFJPQ0sQAxILCgdWQUxIRUlNEAQSDAoIVEVSUkFSSUEQBRIICgRSVVNUEAYSBwoDQ1MyEAcSBwoDVEYyEAgSBwoDQVJLEAkSCQoFQ09OQU4QChIOCgpTRVZFTl9EQVlTEAsSDAoIRkFDVE9SSU8QDBIUChBTUEFDRURfRU5HSU5FRVJTEA0SCQoFT1RIRVIQYyqnAQoQR2FtZVNlcnZlclN0YXR1cxIiCh5HQU1FX1NFUlZFUl9TVEFUVVNfVU5TUEVDSUZJRUQQABILCgdDUkVBVEVEEAESDAoIU1RBUlRJTkcQAhILCgdSVU5OSU5HEAMSDAoIU1RPUFBJTkcQBBILCgdTVE9QUEVEEAUSCgoGRkFJTEVEEAYSDgoKUkVTVEFSVElORxAHEhAKDFNUT1BQRURfSURMRRAIKo4BChBQbGF5ZXJMaXN0QWN0aW9uEiIKHlBMQVlFUl9MSVNUX0FDVElPTl9VTlNQRUNJRklFRBAAEhoKFlBMQVlFUl9MSVNUX0FDVElPTl9BREQQARIdChlQTEFZRVJfTElTVF9BQ1RJT05fUkVNT1ZFEAISGwoXUExBWUVSX0xJU1RfQUNUSU9OX0xJU1QQAyqoAQoTR2FtZVNlcnZlckVudHJ5VHlwZRImCiJHQU1FX1NFUlZFUl9FTlRSWV9UWVBFX1VOU1BFQ0lGSUVEEAASHwobR0FNRV9TRVJWRVJfRU5UUllfVFlQRV9GSUxFEAESJAogR0FNRV9TRVJWRVJfRU5UUllfVFlQRV9ESVJFQ1RPUlkQAhIiCh5HQU1FX1NFUlZFUl9FTlRSWV9UWVBFX1NZTUxJTksQAyqBAQoUTWluZWNyYWZ0UHJvamVjdFR5cGUSJgoiTUlORUNSQUZUX1BST0pFQ1RfVFlQRV9VTlNQRUNJRklFRBAAEh4KGk1JTkVDUkFGVF9QUk9KRUNUX1RZUEVfTU9EEAESIQodTUlORUNSQUZUX1BST0pFQ1RfVFlQRV9QTFVHSU4QAjLGQQoRR2FtZVNlcnZlclNlcnZpY2USfgoPTGlzdEdhbWVTZXJ2ZXJzEjQub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5MaXN0R2FtZVNlcnZlcnNSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5MaXN0R2FtZVNlcnZlcnNSZXNwb25zZRKBAQoQQ3JlYXRlR2FtZVNlcnZlchI1Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuQ3JlYXRlR2FtZVNlcnZlclJlcXVlc3QaNi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkNyZWF0ZUdhbWVTZXJ2ZXJSZXNwb25zZRJ4Cg1HZXRHYW1lU2VydmVyEjIub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRHYW1lU2VydmVyUmVxdWVzdBozLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0R2FtZVNlcnZlclJlc3BvbnNlEoEBChBVcGRhdGVHYW1lU2VydmVyEjUub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5VcGRhdGVHYW1lU2VydmVyUmVxdWVzdBo2Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuVXBkYXRlR2FtZVNlcnZlclJlc3BvbnNlEoEBChBEZWxldGVHYW1lU2VydmVyEjUub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5EZWxldGVHYW1lU2VydmVyUmVxdWVzdBo2Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuRGVsZXRlR2FtZVNlcnZlclJlc3BvbnNlEn4KD1N0YXJ0R2FtZVNlcnZlchI0Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuU3RhcnRHYW1lU2VydmVyUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuU3RhcnRHYW1lU2VydmVyUmVzcG9uc2USewoOU3RvcEdhbWVTZXJ2ZXISMy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlN0b3BHYW1lU2VydmVyUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuU3RvcEdhbWVTZXJ2ZXJSZXNwb25zZRKEAQoRUmVzdGFydEdhbWVTZXJ2ZXISNi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlJlc3RhcnRHYW1lU2VydmVyUmVxdWVzdBo3Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuUmVzdGFydEdhbWVTZXJ2ZXJSZXNwb25zZRKWAQoXR2V0R2FtZVNlcnZlckhUVFBSb3V0ZXMSPC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldEdhbWVTZXJ2ZXJIVFRQUm91dGVzUmVxdWVzdBo9Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0R2FtZVNlcnZlckhUVFBSb3V0ZXNSZXNwb25zZRKcAQoZVXBzZXJ0R2FtZVNlcnZlckhUVFBSb3V0ZRI+Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuVXBzZXJ0R2FtZVNlcnZlckhUVFBSb3V0ZVJlcXVlc3QaPy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlVwc2VydEdhbWVTZXJ2ZXJIVFRQUm91dGVSZXNwb25zZRKcAQoZRGVsZXRlR2FtZVNlcnZlckhUVFBSb3V0ZRI+Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuRGVsZXRlR2FtZVNlcnZlckhUVFBSb3V0ZVJlcXVlc3QaPy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkRlbGV0ZUdhbWVTZXJ2ZXJIVFRQUm91dGVSZXNwb25zZRK9AQokR2V0R2FtZVNlcnZlckRvbWFpblZlcmlmaWNhdGlvblRva2VuEkkub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRHYW1lU2VydmVyRG9tYWluVmVyaWZpY2F0aW9uVG9rZW5SZXF1ZXN0Gkoub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRHYW1lU2VydmVyRG9tYWluVmVyaWZpY2F0aW9uVG9rZW5SZXNwb25zZRKTAQoWVmVyaWZ5R2FtZVNlcnZlckRvbWFpbhI7Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuVmVyaWZ5R2FtZVNlcnZlckRvbWFpblJlcXVlc3QaPC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlZlcmlmeUdhbWVTZXJ2ZXJEb21haW5SZXNwb25zZRKNAQoWU3RyZWFtR2FtZVNlcnZlclN0YXR1cxI7Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuU3RyZWFtR2FtZVNlcnZlclN0YXR1c1JlcXVlc3QaNC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJTdGF0dXNVcGRhdGUwARKEAQoRR2V0R2FtZVNlcnZlckxvZ3MSNi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldEdhbWVTZXJ2ZXJMb2dzUmVxdWVzdBo3Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0R2FtZVNlcnZlckxvZ3NSZXNwb25zZRKEAQoUU3RyZWFtR2FtZVNlcnZlckxvZ3MSOS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlN0cmVhbUdhbWVTZXJ2ZXJMb2dzUmVxdWVzdBovLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlckxvZ0xpbmUwARKZAQoYRXhlY3V0ZUdhbWVTZXJ2ZXJDb21tYW5kEj0ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5FeGVjdXRlR2FtZVNlcnZlckNvbW1hbmRSZXF1ZXN0Gj4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5FeGVjdXRlR2FtZVNlcnZlckNvbW1hbmRSZXNwb25zZRKQAQoVTWFuYWdlUGxheWVyV2hpdGVsaXN0Ejoub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5NYW5hZ2VQbGF5ZXJXaGl0ZWxpc3RSZXF1ZXN0Gjsub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5NYW5hZ2VQbGF5ZXJXaGl0ZWxpc3RSZXNwb25zZRKKAQoTTWFuYWdlUGxheWVyQmFuTGlzdBI4Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTWFuYWdlUGxheWVyQmFuTGlzdFJlcXVlc3QaOS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLk1hbmFnZVBsYXllckJhbkxpc3RSZXNwb25zZRKNAQoUR2V0R2FtZVNlcnZlck1ldHJpY3MSOS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldEdhbWVTZXJ2ZXJNZXRyaWNzUmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0R2FtZVNlcnZlck1ldHJpY3NSZXNwb25zZRKJAQoXU3RyZWFtR2FtZVNlcnZlck1ldHJpY3MSPC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlN0cmVhbUdhbWVTZXJ2ZXJNZXRyaWNzUmVxdWVzdBouLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlck1ldHJpYzABEocBChJHZXRHYW1lU2VydmVyVXNhZ2USNy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldEdhbWVTZXJ2ZXJVc2FnZVJlcXVlc3QaOC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldEdhbWVTZXJ2ZXJVc2FnZVJlc3BvbnNlEooBChNMaXN0R2FtZVNlcnZlckZpbGVzEjgub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5MaXN0R2FtZVNlcnZlckZpbGVzUmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTGlzdEdhbWVTZXJ2ZXJGaWxlc1Jlc3BvbnNlEpABChVTZWFyY2hHYW1lU2VydmVyRmlsZXMSOi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlNlYXJjaEdhbWVTZXJ2ZXJGaWxlc1JlcXVlc3QaOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlNlYXJjaEdhbWVTZXJ2ZXJGaWxlc1Jlc3BvbnNlEoQBChFHZXRHYW1lU2VydmVyRmlsZRI2Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0R2FtZVNlcnZlckZpbGVSZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRHYW1lU2VydmVyRmlsZVJlc3BvbnNlEpABChVVcGxvYWRHYW1lU2VydmVyRmlsZXMSOi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlVwbG9hZEdhbWVTZXJ2ZXJGaWxlc1JlcXVlc3QaOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlVwbG9hZEdhbWVTZXJ2ZXJGaWxlc1Jlc3BvbnNlEp8BChpDaHVua1VwbG9hZEdhbWVTZXJ2ZXJGaWxlcxI/Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuQ2h1bmtVcGxvYWRHYW1lU2VydmVyRmlsZXNSZXF1ZXN0GkAub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5DaHVua1VwbG9hZEdhbWVTZXJ2ZXJGaWxlc1Jlc3BvbnNlEpYBChdEZWxldGVHYW1lU2VydmVyRW50cmllcxI8Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuRGVsZXRlR2FtZVNlcnZlckVudHJpZXNSZXF1ZXN0Gj0ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5EZWxldGVHYW1lU2VydmVyRW50cmllc1Jlc3BvbnNlEpABChVDcmVhdGVHYW1lU2VydmVyRW50cnkSOi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkNyZWF0ZUdhbWVTZXJ2ZXJFbnRyeVJlcXVlc3QaOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkNyZWF0ZUdhbWVTZXJ2ZXJFbnRyeVJlc3BvbnNlEooBChNXcml0ZUdhbWVTZXJ2ZXJGaWxlEjgub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5Xcml0ZUdhbWVTZXJ2ZXJGaWxlUmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuV3JpdGVHYW1lU2VydmVyRmlsZVJlc3BvbnNlEpABChVSZW5hbWVHYW1lU2VydmVyRW50cnkSOi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlJlbmFtZUdhbWVTZXJ2ZXJFbnRyeVJlcXVlc3QaOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlJlbmFtZUdhbWVTZXJ2ZXJFbnRyeVJlc3BvbnNlEpABChVFeHRyYWN0R2FtZVNlcnZlckZpbGUSOi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkV4dHJhY3RHYW1lU2VydmVyRmlsZVJlcXVlc3QaOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkV4dHJhY3RHYW1lU2VydmVyRmlsZVJlc3BvbnNlEqIBChtDcmVhdGVHYW1lU2VydmVyRmlsZUFyY2hpdmUSQC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkNyZWF0ZUdhbWVTZXJ2ZXJGaWxlQXJjaGl2ZVJlcXVlc3QaQS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkNyZWF0ZUdhbWVTZXJ2ZXJGaWxlQXJjaGl2ZVJlc3BvbnNlEsABCiVMaXN0R2FtZVNlcnZlckZpbGVUcmFuc2ZlckNyZWRlbnRpYWxzEkoub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5MaXN0R2FtZVNlcnZlckZpbGVUcmFuc2ZlckNyZWRlbnRpYWxzUmVxdWVzdBpLLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuTGlzdEdhbWVTZXJ2ZXJGaWxlVHJhbnNmZXJDcmVkZW50aWFsc1Jlc3BvbnNlEsMBCiZDcmVhdGVHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbBJLLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuQ3JlYXRlR2FtZVNlcnZlckZpbGVUcmFuc2ZlckNyZWRlbnRpYWxSZXF1ZXN0Gkwub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5DcmVhdGVHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbFJlc3BvbnNlEsMBCiZSZXZva2VHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbBJLLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuUmV2b2tlR2FtZVNlcnZlckZpbGVUcmFuc2ZlckNyZWRlbnRpYWxSZXF1ZXN0Gkwub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5SZXZva2VHYW1lU2VydmVyRmlsZVRyYW5zZmVyQ3JlZGVudGlhbFJlc3BvbnNlEoEBChBBZGRBdXRob3JpemVkS2V5EjUub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5BZGRBdXRob3JpemVkS2V5UmVxdWVzdBo2Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuQWRkQXV0aG9yaXplZEtleVJlc3BvbnNlEooBChNSZW1vdmVBdXRob3JpemVkS2V5Ejgub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5SZW1vdmVBdXRob3JpemVkS2V5UmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuUmVtb3ZlQXV0aG9yaXplZEtleVJlc3BvbnNlEpMBChZHZXRNaW5lY3JhZnRQbGF5ZXJVVUlEEjsub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRNaW5lY3JhZnRQbGF5ZXJVVUlEUmVxdWVzdBo8Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0TWluZWNyYWZ0UGxheWVyVVVJRFJlc3BvbnNlEpwBChlHZXRNaW5lY3JhZnRQbGF5ZXJQcm9maWxlEj4ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRNaW5lY3JhZnRQbGF5ZXJQcm9maWxlUmVxdWVzdBo/Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0TWluZWNyYWZ0UGxheWVyUHJvZmlsZVJlc3BvbnNlEpABChVMaXN0TWluZWNyYWZ0UHJvamVjdHMSOi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RNaW5lY3JhZnRQcm9qZWN0c1JlcXVlc3QaOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RNaW5lY3JhZnRQcm9qZWN0c1Jlc3BvbnNlEqsBCh5MaXN0SW5zdGFsbGVkTWluZWNyYWZ0UHJvamVjdHMSQy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RJbnN0YWxsZWRNaW5lY3JhZnRQcm9qZWN0c1JlcXVlc3QaRC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RJbnN0YWxsZWRNaW5lY3JhZnRQcm9qZWN0c1Jlc3BvbnNlEqIBChtHZXRNaW5lY3JhZnRQcm9qZWN0VmVyc2lvbnMSQC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldE1pbmVjcmFmdFByb2plY3RWZXJzaW9uc1JlcXVlc3QaQS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldE1pbmVjcmFmdFByb2plY3RWZXJzaW9uc1Jlc3BvbnNlEooBChNHZXRNaW5lY3JhZnRQcm9qZWN0Ejgub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HZXRNaW5lY3JhZnRQcm9qZWN0UmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2V0TWluZWNyYWZ0UHJvamVjdFJlc3BvbnNlEqIBChtJbnN0YWxsTWluZWNyYWZ0UHJvamVjdEZpbGUSQC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkluc3RhbGxNaW5lY3JhZnRQcm9qZWN0RmlsZVJlcXVlc3QaQS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkluc3RhbGxNaW5lY3JhZnRQcm9qZWN0RmlsZVJlc3BvbnNlEp8BChpVcGRhdGVNaW5lY3JhZnRQcm9qZWN0RmlsZRI/Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuVXBkYXRlTWluZWNyYWZ0UHJvamVjdEZpbGVSZXF1ZXN0GkAub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5VcGRhdGVNaW5lY3JhZnRQcm9qZWN0RmlsZVJlc3BvbnNlEo0BChRJbnN0YWxsR2FtZVNlcnZlck1vZBI5Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuSW5zdGFsbEdhbWVTZXJ2ZXJNb2RSZXF1ZXN0Gjoub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5JbnN0YWxsR2FtZVNlcnZlck1vZFJlc3BvbnNlEpMBChZVbmluc3RhbGxHYW1lU2VydmVyTW9kEjsub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5Vbmluc3RhbGxHYW1lU2VydmVyTW9kUmVxdWVzdBo8Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuVW5pbnN0YWxsR2FtZVNlcnZlck1vZFJlc3BvbnNlEocBChJMaXN0R2FtZVNlcnZlck1vZHMSNy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RHYW1lU2VydmVyTW9kc1JlcXVlc3QaOC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RHYW1lU2VydmVyTW9kc1Jlc3BvbnNlEpkBChhTY2hlZHVsZUdhbWVTZXJ2ZXJCYWNrdXASPS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlNjaGVkdWxlR2FtZVNlcnZlckJhY2t1cFJlcXVlc3QaPi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlNjaGVkdWxlR2FtZVNlcnZlckJhY2t1cFJlc3BvbnNlEpABChVMaXN0R2FtZVNlcnZlckJhY2t1cHMSOi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RHYW1lU2VydmVyQmFja3Vwc1JlcXVlc3QaOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkxpc3RHYW1lU2VydmVyQmFja3Vwc1Jlc3BvbnNlEpYBChdSZXN0b3JlR2FtZVNlcnZlckJhY2t1cBI8Lm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuUmVzdG9yZUdhbWVTZXJ2ZXJCYWNrdXBSZXF1ZXN0Gj0ub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5SZXN0b3JlR2FtZVNlcnZlckJhY2t1cFJlc3BvbnNlEpABChVTZXREaXNjb3JkSW50ZWdyYXRpb24SOi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlNldERpc2NvcmRJbnRlZ3JhdGlvblJlcXVlc3QaOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlNldERpc2NvcmRJbnRlZ3JhdGlvblJlc3BvbnNlEpkBChhEZWxldGVEaXNjb3JkSW50ZWdyYXRpb24SPS5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkRlbGV0ZURpc2NvcmRJbnRlZ3JhdGlvblJlcXVlc3QaPi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkRlbGV0ZURpc2NvcmRJbnRlZ3JhdGlvblJlc3BvbnNlEpABChVTZXRJZGxlU2h1dGRvd25Qb2xpY3kSOi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlNldElkbGVTaHV0ZG93blBvbGljeVJlcXVlc3QaOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLlNldElkbGVTaHV0ZG93blBvbGljeVJlc3BvbnNlEpABChVHZXRJZGxlU2h1dGRvd25Qb2xpY3kSOi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldElkbGVTaHV0ZG93blBvbGljeVJlcXVlc3QaOy5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdldElkbGVTaHV0ZG93blBvbGljeVJlc3BvbnNlQldaVWdpdGh1Yi5jb20vb2JpZW50ZS9jbG91ZC9hcHBzL3NoYXJlZC9wcm90by9vYmllbnRlL2Nsb3VkL2dhbWVzZXJ2ZXJzL3YxO2dhbWVzZXJ2ZXJzdjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_obiente_cloud_organizations_v1_organization_service, file_obiente_cloud_common_v1_common]);
 
 /**
  * Request/Response messages
@@ -41,6 +41,13 @@ export type ListGameServersRequest = Message<"obiente.cloud.gameservers.v1.ListG
    * @generated from field: optional obiente.cloud.gameservers.v1.GameServerStatus status = 3;
    */
   status?: GameServerStatus;
+
+  /**
+   * Only list game servers that carry every key=value tag
+   *
+   * @generated from field: map<string, string> tags = 4;
+   */
+  tags: { [key: string]: string };
 };
 
 /**
@@ -154,6 +161,13 @@ export type CreateGameServerRequest = Message<"obiente.cloud.gameservers.v1.Crea
    * @generated from field: optional int32 extra_ports_count = 12;
    */
   extraPortsCount?: number;
+
+  /**
+   * Team to assign the game server to; counts towards the team's quota
+   *
+   * @generated from field: optional string team_id = 13;
+   */
+  teamId?: string;
 };
 
 /**
@@ -430,6 +444,209 @@ export type RestartGameServerResponse = Message<"obiente.cloud.gameservers.v1.Re
 export const RestartGameServerResponseSchema: GenMessage<RestartGameServerResponse> = /*@__PURE__*/
   messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 15);
 
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ExecuteGameServerCommandRequest
+ */
+export type ExecuteGameServerCommandRequest = Message<"obiente.cloud.gameservers.v1.ExecuteGameServerCommandRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * @generated from field: string command = 2;
+   */
+  command: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ExecuteGameServerCommandRequest.
+ * Use `create(ExecuteGameServerCommandRequestSchema)` to create a new message.
+ */
+export const ExecuteGameServerCommandRequestSchema: GenMessage<ExecuteGameServerCommandRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 16);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ExecuteGameServerCommandResponse
+ */
+export type ExecuteGameServerCommandResponse = Message<"obiente.cloud.gameservers.v1.ExecuteGameServerCommandResponse"> & {
+  /**
+   * Response returned by the game server's RCON console
+   *
+   * @generated from field: string output = 1;
+   */
+  output: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ExecuteGameServerCommandResponse.
+ * Use `create(ExecuteGameServerCommandResponseSchema)` to create a new message.
+ */
+export const ExecuteGameServerCommandResponseSchema: GenMessage<ExecuteGameServerCommandResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 17);
+
+/**
+ * Entry of whitelist.json or banned-players.json
+ *
+ * @generated from message obiente.cloud.gameservers.v1.PlayerListEntry
+ */
+export type PlayerListEntry = Message<"obiente.cloud.gameservers.v1.PlayerListEntry"> & {
+  /**
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Ban entries only
+   *
+   * @generated from field: optional string created = 3;
+   */
+  created?: string;
+
+  /**
+   * Ban entries only
+   *
+   * @generated from field: optional string source = 4;
+   */
+  source?: string;
+
+  /**
+   * Ban entries only
+   *
+   * @generated from field: optional string expires = 5;
+   */
+  expires?: string;
+
+  /**
+   * Ban entries only
+   *
+   * @generated from field: optional string reason = 6;
+   */
+  reason?: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.PlayerListEntry.
+ * Use `create(PlayerListEntrySchema)` to create a new message.
+ */
+export const PlayerListEntrySchema: GenMessage<PlayerListEntry> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 18);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ManagePlayerWhitelistRequest
+ */
+export type ManagePlayerWhitelistRequest = Message<"obiente.cloud.gameservers.v1.ManagePlayerWhitelistRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * @generated from field: obiente.cloud.gameservers.v1.PlayerListAction action = 2;
+   */
+  action: PlayerListAction;
+
+  /**
+   * Required for add and remove
+   *
+   * @generated from field: string player_name = 3;
+   */
+  playerName: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ManagePlayerWhitelistRequest.
+ * Use `create(ManagePlayerWhitelistRequestSchema)` to create a new message.
+ */
+export const ManagePlayerWhitelistRequestSchema: GenMessage<ManagePlayerWhitelistRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 19);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ManagePlayerWhitelistResponse
+ */
+export type ManagePlayerWhitelistResponse = Message<"obiente.cloud.gameservers.v1.ManagePlayerWhitelistResponse"> & {
+  /**
+   * Whitelist after the change
+   *
+   * @generated from field: repeated obiente.cloud.gameservers.v1.PlayerListEntry players = 1;
+   */
+  players: PlayerListEntry[];
+
+  /**
+   * RCON response to the add or remove command
+   *
+   * @generated from field: string output = 2;
+   */
+  output: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ManagePlayerWhitelistResponse.
+ * Use `create(ManagePlayerWhitelistResponseSchema)` to create a new message.
+ */
+export const ManagePlayerWhitelistResponseSchema: GenMessage<ManagePlayerWhitelistResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 20);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ManagePlayerBanListRequest
+ */
+export type ManagePlayerBanListRequest = Message<"obiente.cloud.gameservers.v1.ManagePlayerBanListRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * @generated from field: obiente.cloud.gameservers.v1.PlayerListAction action = 2;
+   */
+  action: PlayerListAction;
+
+  /**
+   * Required for add and remove
+   *
+   * @generated from field: string player_name = 3;
+   */
+  playerName: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ManagePlayerBanListRequest.
+ * Use `create(ManagePlayerBanListRequestSchema)` to create a new message.
+ */
+export const ManagePlayerBanListRequestSchema: GenMessage<ManagePlayerBanListRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 21);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ManagePlayerBanListResponse
+ */
+export type ManagePlayerBanListResponse = Message<"obiente.cloud.gameservers.v1.ManagePlayerBanListResponse"> & {
+  /**
+   * Ban list after the change
+   *
+   * @generated from field: repeated obiente.cloud.gameservers.v1.PlayerListEntry players = 1;
+   */
+  players: PlayerListEntry[];
+
+  /**
+   * RCON response to the ban or pardon command
+   *
+   * @generated from field: string output = 2;
+   */
+  output: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ManagePlayerBanListResponse.
+ * Use `create(ManagePlayerBanListResponseSchema)` to create a new message.
+ */
+export const ManagePlayerBanListResponseSchema: GenMessage<ManagePlayerBanListResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 22);
+
 /**
  * @generated from message obiente.cloud.gameservers.v1.GameServerHTTPRoute
  */
@@ -480,7 +697,7 @@ export type GameServerHTTPRoute = Message<"obiente.cloud.gameservers.v1.GameServ
  * Use `create(GameServerHTTPRouteSchema)` to create a new message.
  */
 export const GameServerHTTPRouteSchema: GenMessage<GameServerHTTPRoute> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 16);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 23);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesRequest
@@ -502,7 +719,7 @@ export type GetGameServerHTTPRoutesRequest = Message<"obiente.cloud.gameservers.
  * Use `create(GetGameServerHTTPRoutesRequestSchema)` to create a new message.
  */
 export const GetGameServerHTTPRoutesRequestSchema: GenMessage<GetGameServerHTTPRoutesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 17);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 24);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerHTTPRoutesResponse
@@ -519,7 +736,7 @@ export type GetGameServerHTTPRoutesResponse = Message<"obiente.cloud.gameservers
  * Use `create(GetGameServerHTTPRoutesResponseSchema)` to create a new message.
  */
 export const GetGameServerHTTPRoutesResponseSchema: GenMessage<GetGameServerHTTPRoutesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 18);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 25);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteRequest
@@ -576,7 +793,7 @@ export type UpsertGameServerHTTPRouteRequest = Message<"obiente.cloud.gameserver
  * Use `create(UpsertGameServerHTTPRouteRequestSchema)` to create a new message.
  */
 export const UpsertGameServerHTTPRouteRequestSchema: GenMessage<UpsertGameServerHTTPRouteRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 19);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 26);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.UpsertGameServerHTTPRouteResponse
@@ -593,7 +810,7 @@ export type UpsertGameServerHTTPRouteResponse = Message<"obiente.cloud.gameserve
  * Use `create(UpsertGameServerHTTPRouteResponseSchema)` to create a new message.
  */
 export const UpsertGameServerHTTPRouteResponseSchema: GenMessage<UpsertGameServerHTTPRouteResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 20);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 27);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.DeleteGameServerHTTPRouteRequest
@@ -620,7 +837,7 @@ export type DeleteGameServerHTTPRouteRequest = Message<"obiente.cloud.gameserver
  * Use `create(DeleteGameServerHTTPRouteRequestSchema)` to create a new message.
  */
 export const DeleteGameServerHTTPRouteRequestSchema: GenMessage<DeleteGameServerHTTPRouteRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 21);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 28);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.DeleteGameServerHTTPRouteResponse
@@ -637,7 +854,7 @@ export type DeleteGameServerHTTPRouteResponse = Message<"obiente.cloud.gameserve
  * Use `create(DeleteGameServerHTTPRouteResponseSchema)` to create a new message.
  */
 export const DeleteGameServerHTTPRouteResponseSchema: GenMessage<DeleteGameServerHTTPRouteResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 22);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 29);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerDomainVerificationTokenRequest
@@ -664,7 +881,7 @@ export type GetGameServerDomainVerificationTokenRequest = Message<"obiente.cloud
  * Use `create(GetGameServerDomainVerificationTokenRequestSchema)` to create a new message.
  */
 export const GetGameServerDomainVerificationTokenRequestSchema: GenMessage<GetGameServerDomainVerificationTokenRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 23);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 30);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerDomainVerificationTokenResponse
@@ -701,7 +918,7 @@ export type GetGameServerDomainVerificationTokenResponse = Message<"obiente.clou
  * Use `create(GetGameServerDomainVerificationTokenResponseSchema)` to create a new message.
  */
 export const GetGameServerDomainVerificationTokenResponseSchema: GenMessage<GetGameServerDomainVerificationTokenResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 24);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 31);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.VerifyGameServerDomainRequest
@@ -728,7 +945,7 @@ export type VerifyGameServerDomainRequest = Message<"obiente.cloud.gameservers.v
  * Use `create(VerifyGameServerDomainRequestSchema)` to create a new message.
  */
 export const VerifyGameServerDomainRequestSchema: GenMessage<VerifyGameServerDomainRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 25);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 32);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.VerifyGameServerDomainResponse
@@ -760,7 +977,7 @@ export type VerifyGameServerDomainResponse = Message<"obiente.cloud.gameservers.
  * Use `create(VerifyGameServerDomainResponseSchema)` to create a new message.
  */
 export const VerifyGameServerDomainResponseSchema: GenMessage<VerifyGameServerDomainResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 26);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 33);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.StreamGameServerStatusRequest
@@ -777,7 +994,7 @@ export type StreamGameServerStatusRequest = Message<"obiente.cloud.gameservers.v
  * Use `create(StreamGameServerStatusRequestSchema)` to create a new message.
  */
 export const StreamGameServerStatusRequestSchema: GenMessage<StreamGameServerStatusRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 27);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 34);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GameServerStatusUpdate
@@ -809,7 +1026,7 @@ export type GameServerStatusUpdate = Message<"obiente.cloud.gameservers.v1.GameS
  * Use `create(GameServerStatusUpdateSchema)` to create a new message.
  */
 export const GameServerStatusUpdateSchema: GenMessage<GameServerStatusUpdate> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 28);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 35);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerLogsRequest
@@ -854,7 +1071,7 @@ export type GetGameServerLogsRequest = Message<"obiente.cloud.gameservers.v1.Get
  * Use `create(GetGameServerLogsRequestSchema)` to create a new message.
  */
 export const GetGameServerLogsRequestSchema: GenMessage<GetGameServerLogsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 29);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 36);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerLogsResponse
@@ -871,7 +1088,7 @@ export type GetGameServerLogsResponse = Message<"obiente.cloud.gameservers.v1.Ge
  * Use `create(GetGameServerLogsResponseSchema)` to create a new message.
  */
 export const GetGameServerLogsResponseSchema: GenMessage<GetGameServerLogsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 30);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 37);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.StreamGameServerLogsRequest
@@ -923,7 +1140,7 @@ export type StreamGameServerLogsRequest = Message<"obiente.cloud.gameservers.v1.
  * Use `create(StreamGameServerLogsRequestSchema)` to create a new message.
  */
 export const StreamGameServerLogsRequestSchema: GenMessage<StreamGameServerLogsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 31);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 38);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GameServerLogLine
@@ -955,7 +1172,7 @@ export type GameServerLogLine = Message<"obiente.cloud.gameservers.v1.GameServer
  * Use `create(GameServerLogLineSchema)` to create a new message.
  */
 export const GameServerLogLineSchema: GenMessage<GameServerLogLine> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 32);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 39);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerMetricsRequest
@@ -989,7 +1206,7 @@ export type GetGameServerMetricsRequest = Message<"obiente.cloud.gameservers.v1.
  * Use `create(GetGameServerMetricsRequestSchema)` to create a new message.
  */
 export const GetGameServerMetricsRequestSchema: GenMessage<GetGameServerMetricsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 33);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 40);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerMetricsResponse
@@ -1006,7 +1223,7 @@ export type GetGameServerMetricsResponse = Message<"obiente.cloud.gameservers.v1
  * Use `create(GetGameServerMetricsResponseSchema)` to create a new message.
  */
 export const GetGameServerMetricsResponseSchema: GenMessage<GetGameServerMetricsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 34);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 41);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.StreamGameServerMetricsRequest
@@ -1023,7 +1240,7 @@ export type StreamGameServerMetricsRequest = Message<"obiente.cloud.gameservers.
  * Use `create(StreamGameServerMetricsRequestSchema)` to create a new message.
  */
 export const StreamGameServerMetricsRequestSchema: GenMessage<StreamGameServerMetricsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 35);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 42);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GameServerMetric
@@ -1101,6 +1318,13 @@ export type GameServerMetric = Message<"obiente.cloud.gameservers.v1.GameServerM
    * @generated from field: optional int32 max_players = 9;
    */
   maxPlayers?: number;
+
+  /**
+   * Ticks per second sampled over RCON (Minecraft servers with /tps)
+   *
+   * @generated from field: optional double tps = 12;
+   */
+  tps?: number;
 };
 
 /**
@@ -1108,7 +1332,7 @@ export type GameServerMetric = Message<"obiente.cloud.gameservers.v1.GameServerM
  * Use `create(GameServerMetricSchema)` to create a new message.
  */
 export const GameServerMetricSchema: GenMessage<GameServerMetric> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 36);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 43);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerUsageRequest
@@ -1137,7 +1361,7 @@ export type GetGameServerUsageRequest = Message<"obiente.cloud.gameservers.v1.Ge
  * Use `create(GetGameServerUsageRequestSchema)` to create a new message.
  */
 export const GetGameServerUsageRequestSchema: GenMessage<GetGameServerUsageRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 37);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 44);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerUsageResponse
@@ -1176,7 +1400,7 @@ export type GetGameServerUsageResponse = Message<"obiente.cloud.gameservers.v1.G
  * Use `create(GetGameServerUsageResponseSchema)` to create a new message.
  */
 export const GetGameServerUsageResponseSchema: GenMessage<GetGameServerUsageResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 38);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 45);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GameServerUsageMetrics
@@ -1247,7 +1471,7 @@ export type GameServerUsageMetrics = Message<"obiente.cloud.gameservers.v1.GameS
  * Use `create(GameServerUsageMetricsSchema)` to create a new message.
  */
 export const GameServerUsageMetricsSchema: GenMessage<GameServerUsageMetrics> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 39);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 46);
 
 /**
  * GameServer represents a game server instance
@@ -1394,7 +1618,7 @@ export type GameServer = Message<"obiente.cloud.gameservers.v1.GameServer"> & {
  * Use `create(GameServerSchema)` to create a new message.
  */
 export const GameServerSchema: GenMessage<GameServer> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 40);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 47);
 
 /**
  * File system messages (similar to deployments)
@@ -1480,7 +1704,7 @@ export type GameServerFile = Message<"obiente.cloud.gameservers.v1.GameServerFil
  * Use `create(GameServerFileSchema)` to create a new message.
  */
 export const GameServerFileSchema: GenMessage<GameServerFile> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 41);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 48);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GameServerVolumeInfo
@@ -1520,7 +1744,7 @@ export type GameServerVolumeInfo = Message<"obiente.cloud.gameservers.v1.GameSer
  * Use `create(GameServerVolumeInfoSchema)` to create a new message.
  */
 export const GameServerVolumeInfoSchema: GenMessage<GameServerVolumeInfo> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 42);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 49);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.ListGameServerFilesRequest
@@ -1572,7 +1796,7 @@ export type ListGameServerFilesRequest = Message<"obiente.cloud.gameservers.v1.L
  * Use `create(ListGameServerFilesRequestSchema)` to create a new message.
  */
 export const ListGameServerFilesRequestSchema: GenMessage<ListGameServerFilesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 43);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 50);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.ListGameServerFilesResponse
@@ -1625,7 +1849,7 @@ export type ListGameServerFilesResponse = Message<"obiente.cloud.gameservers.v1.
  * Use `create(ListGameServerFilesResponseSchema)` to create a new message.
  */
 export const ListGameServerFilesResponseSchema: GenMessage<ListGameServerFilesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 44);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 51);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.SearchGameServerFilesRequest
@@ -1684,7 +1908,7 @@ export type SearchGameServerFilesRequest = Message<"obiente.cloud.gameservers.v1
  * Use `create(SearchGameServerFilesRequestSchema)` to create a new message.
  */
 export const SearchGameServerFilesRequestSchema: GenMessage<SearchGameServerFilesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 45);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 52);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.SearchGameServerFilesResponse
@@ -1724,7 +1948,7 @@ export type SearchGameServerFilesResponse = Message<"obiente.cloud.gameservers.v
  * Use `create(SearchGameServerFilesResponseSchema)` to create a new message.
  */
 export const SearchGameServerFilesResponseSchema: GenMessage<SearchGameServerFilesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 46);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 53);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerFileRequest
@@ -1755,7 +1979,7 @@ export type GetGameServerFileRequest = Message<"obiente.cloud.gameservers.v1.Get
  * Use `create(GetGameServerFileRequestSchema)` to create a new message.
  */
 export const GetGameServerFileRequestSchema: GenMessage<GetGameServerFileRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 47);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 54);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetGameServerFileResponse
@@ -1794,7 +2018,7 @@ export type GetGameServerFileResponse = Message<"obiente.cloud.gameservers.v1.Ge
  * Use `create(GetGameServerFileResponseSchema)` to create a new message.
  */
 export const GetGameServerFileResponseSchema: GenMessage<GetGameServerFileResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 48);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 55);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.UploadGameServerFilesRequest
@@ -1820,7 +2044,7 @@ export type UploadGameServerFilesRequest = Message<"obiente.cloud.gameservers.v1
  * Use `create(UploadGameServerFilesRequestSchema)` to create a new message.
  */
 export const UploadGameServerFilesRequestSchema: GenMessage<UploadGameServerFilesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 49);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 56);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.UploadGameServerFilesMetadata
@@ -1858,7 +2082,7 @@ export type UploadGameServerFilesMetadata = Message<"obiente.cloud.gameservers.v
  * Use `create(UploadGameServerFilesMetadataSchema)` to create a new message.
  */
 export const UploadGameServerFilesMetadataSchema: GenMessage<UploadGameServerFilesMetadata> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 50);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 57);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GameServerFileMetadata
@@ -1898,7 +2122,7 @@ export type GameServerFileMetadata = Message<"obiente.cloud.gameservers.v1.GameS
  * Use `create(GameServerFileMetadataSchema)` to create a new message.
  */
 export const GameServerFileMetadataSchema: GenMessage<GameServerFileMetadata> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 51);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 58);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.UploadGameServerFilesResponse
@@ -1927,7 +2151,7 @@ export type UploadGameServerFilesResponse = Message<"obiente.cloud.gameservers.v
  * Use `create(UploadGameServerFilesResponseSchema)` to create a new message.
  */
 export const UploadGameServerFilesResponseSchema: GenMessage<UploadGameServerFilesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 52);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 59);
 
 /**
  * Chunked upload uses the shared payload/response for consistency across services.
@@ -1951,7 +2175,7 @@ export type ChunkUploadGameServerFilesRequest = Message<"obiente.cloud.gameserve
  * Use `create(ChunkUploadGameServerFilesRequestSchema)` to create a new message.
  */
 export const ChunkUploadGameServerFilesRequestSchema: GenMessage<ChunkUploadGameServerFilesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 53);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 60);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.ChunkUploadGameServerFilesResponse
@@ -1968,7 +2192,7 @@ export type ChunkUploadGameServerFilesResponse = Message<"obiente.cloud.gameserv
  * Use `create(ChunkUploadGameServerFilesResponseSchema)` to create a new message.
  */
 export const ChunkUploadGameServerFilesResponseSchema: GenMessage<ChunkUploadGameServerFilesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 54);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 61);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.DeleteGameServerEntriesRequest
@@ -2005,7 +2229,7 @@ export type DeleteGameServerEntriesRequest = Message<"obiente.cloud.gameservers.
  * Use `create(DeleteGameServerEntriesRequestSchema)` to create a new message.
  */
 export const DeleteGameServerEntriesRequestSchema: GenMessage<DeleteGameServerEntriesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 55);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 62);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.DeleteGameServerEntriesError
@@ -2027,7 +2251,7 @@ export type DeleteGameServerEntriesError = Message<"obiente.cloud.gameservers.v1
  * Use `create(DeleteGameServerEntriesErrorSchema)` to create a new message.
  */
 export const DeleteGameServerEntriesErrorSchema: GenMessage<DeleteGameServerEntriesError> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 56);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 63);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.DeleteGameServerEntriesResponse
@@ -2054,7 +2278,7 @@ export type DeleteGameServerEntriesResponse = Message<"obiente.cloud.gameservers
  * Use `create(DeleteGameServerEntriesResponseSchema)` to create a new message.
  */
 export const DeleteGameServerEntriesResponseSchema: GenMessage<DeleteGameServerEntriesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 57);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 64);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.RenameGameServerEntryRequest
@@ -2091,7 +2315,7 @@ export type RenameGameServerEntryRequest = Message<"obiente.cloud.gameservers.v1
  * Use `create(RenameGameServerEntryRequestSchema)` to create a new message.
  */
 export const RenameGameServerEntryRequestSchema: GenMessage<RenameGameServerEntryRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 58);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 65);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.RenameGameServerEntryResponse
@@ -2113,7 +2337,7 @@ export type RenameGameServerEntryResponse = Message<"obiente.cloud.gameservers.v
  * Use `create(RenameGameServerEntryResponseSchema)` to create a new message.
  */
 export const RenameGameServerEntryResponseSchema: GenMessage<RenameGameServerEntryResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 59);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 66);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.CreateGameServerEntryRequest
@@ -2162,7 +2386,7 @@ export type CreateGameServerEntryRequest = Message<"obiente.cloud.gameservers.v1
  * Use `create(CreateGameServerEntryRequestSchema)` to create a new message.
  */
 export const CreateGameServerEntryRequestSchema: GenMessage<CreateGameServerEntryRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 60);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 67);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.CreateGameServerEntryResponse
@@ -2179,7 +2403,7 @@ export type CreateGameServerEntryResponse = Message<"obiente.cloud.gameservers.v
  * Use `create(CreateGameServerEntryResponseSchema)` to create a new message.
  */
 export const CreateGameServerEntryResponseSchema: GenMessage<CreateGameServerEntryResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 61);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 68);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.WriteGameServerFileRequest
@@ -2228,7 +2452,7 @@ export type WriteGameServerFileRequest = Message<"obiente.cloud.gameservers.v1.W
  * Use `create(WriteGameServerFileRequestSchema)` to create a new message.
  */
 export const WriteGameServerFileRequestSchema: GenMessage<WriteGameServerFileRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 62);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 69);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.WriteGameServerFileResponse
@@ -2255,7 +2479,7 @@ export type WriteGameServerFileResponse = Message<"obiente.cloud.gameservers.v1.
  * Use `create(WriteGameServerFileResponseSchema)` to create a new message.
  */
 export const WriteGameServerFileResponseSchema: GenMessage<WriteGameServerFileResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 63);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 70);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.ExtractGameServerFileRequest
@@ -2293,7 +2517,7 @@ export type ExtractGameServerFileRequest = Message<"obiente.cloud.gameservers.v1
  * Use `create(ExtractGameServerFileRequestSchema)` to create a new message.
  */
 export const ExtractGameServerFileRequestSchema: GenMessage<ExtractGameServerFileRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 64);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 71);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.ExtractGameServerFileResponse
@@ -2326,7 +2550,7 @@ export type ExtractGameServerFileResponse = Message<"obiente.cloud.gameservers.v
  * Use `create(ExtractGameServerFileResponseSchema)` to create a new message.
  */
 export const ExtractGameServerFileResponseSchema: GenMessage<ExtractGameServerFileResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 65);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 72);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.CreateGameServerFileArchiveRequest
@@ -2357,7 +2581,7 @@ export type CreateGameServerFileArchiveRequest = Message<"obiente.cloud.gameserv
  * Use `create(CreateGameServerFileArchiveRequestSchema)` to create a new message.
  */
 export const CreateGameServerFileArchiveRequestSchema: GenMessage<CreateGameServerFileArchiveRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 66);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 73);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.CreateGameServerFileArchiveResponse
@@ -2376,7 +2600,7 @@ export type CreateGameServerFileArchiveResponse = Message<"obiente.cloud.gameser
  * Use `create(CreateGameServerFileArchiveResponseSchema)` to create a new message.
  */
 export const CreateGameServerFileArchiveResponseSchema: GenMessage<CreateGameServerFileArchiveResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 67);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 74);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GameServerFileTransferCredential
@@ -2416,6 +2640,20 @@ export type GameServerFileTransferCredential = Message<"obiente.cloud.gameserver
    * @generated from field: google.protobuf.Timestamp created_at = 7;
    */
   createdAt?: Timestamp;
+
+  /**
+   * Path prefixes the credential is restricted to; empty allows the whole server
+   *
+   * @generated from field: repeated string allowed_paths = 8;
+   */
+  allowedPaths: string[];
+
+  /**
+   * SSH public keys that log in as this credential
+   *
+   * @generated from field: repeated obiente.cloud.gameservers.v1.GameServerAuthorizedKey authorized_keys = 9;
+   */
+  authorizedKeys: GameServerAuthorizedKey[];
 };
 
 /**
@@ -2423,10 +2661,36 @@ export type GameServerFileTransferCredential = Message<"obiente.cloud.gameserver
  * Use `create(GameServerFileTransferCredentialSchema)` to create a new message.
  */
 export const GameServerFileTransferCredentialSchema: GenMessage<GameServerFileTransferCredential> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 68);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 75);
 
 /**
- * @generated from message obiente.cloud.gameservers.v1.GameServerFileTransferConnectionInfo
+ * @generated from message obiente.cloud.gameservers.v1.GameServerAuthorizedKey
+ */
+export type GameServerAuthorizedKey = Message<"obiente.cloud.gameservers.v1.GameServerAuthorizedKey"> & {
+  /**
+   * authorized_keys line, e.g. "ssh-ed25519 AAAA... user@host"
+   *
+   * @generated from field: string public_key = 1;
+   */
+  publicKey: string;
+
+  /**
+   * SHA256 fingerprint, e.g. "SHA256:..."
+   *
+   * @generated from field: string fingerprint = 2;
+   */
+  fingerprint: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.GameServerAuthorizedKey.
+ * Use `create(GameServerAuthorizedKeySchema)` to create a new message.
+ */
+export const GameServerAuthorizedKeySchema: GenMessage<GameServerAuthorizedKey> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 76);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.GameServerFileTransferConnectionInfo
  */
 export type GameServerFileTransferConnectionInfo = Message<"obiente.cloud.gameservers.v1.GameServerFileTransferConnectionInfo"> & {
   /**
@@ -2460,7 +2724,7 @@ export type GameServerFileTransferConnectionInfo = Message<"obiente.cloud.gamese
  * Use `create(GameServerFileTransferConnectionInfoSchema)` to create a new message.
  */
 export const GameServerFileTransferConnectionInfoSchema: GenMessage<GameServerFileTransferConnectionInfo> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 69);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 77);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsRequest
@@ -2477,7 +2741,7 @@ export type ListGameServerFileTransferCredentialsRequest = Message<"obiente.clou
  * Use `create(ListGameServerFileTransferCredentialsRequestSchema)` to create a new message.
  */
 export const ListGameServerFileTransferCredentialsRequestSchema: GenMessage<ListGameServerFileTransferCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 70);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 78);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.ListGameServerFileTransferCredentialsResponse
@@ -2499,7 +2763,7 @@ export type ListGameServerFileTransferCredentialsResponse = Message<"obiente.clo
  * Use `create(ListGameServerFileTransferCredentialsResponseSchema)` to create a new message.
  */
 export const ListGameServerFileTransferCredentialsResponseSchema: GenMessage<ListGameServerFileTransferCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 71);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 79);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialRequest
@@ -2524,6 +2788,13 @@ export type CreateGameServerFileTransferCredentialRequest = Message<"obiente.clo
    * @generated from field: optional google.protobuf.Timestamp expires_at = 4;
    */
   expiresAt?: Timestamp;
+
+  /**
+   * Restrict the credential to these path prefixes (e.g. "/plugins"); empty allows the whole server
+   *
+   * @generated from field: repeated string allowed_paths = 5;
+   */
+  allowedPaths: string[];
 };
 
 /**
@@ -2531,7 +2802,7 @@ export type CreateGameServerFileTransferCredentialRequest = Message<"obiente.clo
  * Use `create(CreateGameServerFileTransferCredentialRequestSchema)` to create a new message.
  */
 export const CreateGameServerFileTransferCredentialRequestSchema: GenMessage<CreateGameServerFileTransferCredentialRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 72);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 80);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.CreateGameServerFileTransferCredentialResponse
@@ -2558,7 +2829,7 @@ export type CreateGameServerFileTransferCredentialResponse = Message<"obiente.cl
  * Use `create(CreateGameServerFileTransferCredentialResponseSchema)` to create a new message.
  */
 export const CreateGameServerFileTransferCredentialResponseSchema: GenMessage<CreateGameServerFileTransferCredentialResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 73);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 81);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialRequest
@@ -2580,7 +2851,7 @@ export type RevokeGameServerFileTransferCredentialRequest = Message<"obiente.clo
  * Use `create(RevokeGameServerFileTransferCredentialRequestSchema)` to create a new message.
  */
 export const RevokeGameServerFileTransferCredentialRequestSchema: GenMessage<RevokeGameServerFileTransferCredentialRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 74);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 82);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.RevokeGameServerFileTransferCredentialResponse
@@ -2597,7 +2868,99 @@ export type RevokeGameServerFileTransferCredentialResponse = Message<"obiente.cl
  * Use `create(RevokeGameServerFileTransferCredentialResponseSchema)` to create a new message.
  */
 export const RevokeGameServerFileTransferCredentialResponseSchema: GenMessage<RevokeGameServerFileTransferCredentialResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 75);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 83);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.AddAuthorizedKeyRequest
+ */
+export type AddAuthorizedKeyRequest = Message<"obiente.cloud.gameservers.v1.AddAuthorizedKeyRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * @generated from field: string credential_id = 2;
+   */
+  credentialId: string;
+
+  /**
+   * authorized_keys line, e.g. "ssh-ed25519 AAAA... user@host"
+   *
+   * @generated from field: string public_key = 3;
+   */
+  publicKey: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.AddAuthorizedKeyRequest.
+ * Use `create(AddAuthorizedKeyRequestSchema)` to create a new message.
+ */
+export const AddAuthorizedKeyRequestSchema: GenMessage<AddAuthorizedKeyRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 84);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.AddAuthorizedKeyResponse
+ */
+export type AddAuthorizedKeyResponse = Message<"obiente.cloud.gameservers.v1.AddAuthorizedKeyResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.gameservers.v1.GameServerFileTransferCredential credential = 1;
+   */
+  credential?: GameServerFileTransferCredential;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.AddAuthorizedKeyResponse.
+ * Use `create(AddAuthorizedKeyResponseSchema)` to create a new message.
+ */
+export const AddAuthorizedKeyResponseSchema: GenMessage<AddAuthorizedKeyResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 85);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.RemoveAuthorizedKeyRequest
+ */
+export type RemoveAuthorizedKeyRequest = Message<"obiente.cloud.gameservers.v1.RemoveAuthorizedKeyRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * @generated from field: string credential_id = 2;
+   */
+  credentialId: string;
+
+  /**
+   * SHA256 fingerprint of the key to remove
+   *
+   * @generated from field: string fingerprint = 3;
+   */
+  fingerprint: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.RemoveAuthorizedKeyRequest.
+ * Use `create(RemoveAuthorizedKeyRequestSchema)` to create a new message.
+ */
+export const RemoveAuthorizedKeyRequestSchema: GenMessage<RemoveAuthorizedKeyRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 86);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.RemoveAuthorizedKeyResponse
+ */
+export type RemoveAuthorizedKeyResponse = Message<"obiente.cloud.gameservers.v1.RemoveAuthorizedKeyResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.gameservers.v1.GameServerFileTransferCredential credential = 1;
+   */
+  credential?: GameServerFileTransferCredential;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.RemoveAuthorizedKeyResponse.
+ * Use `create(RemoveAuthorizedKeyResponseSchema)` to create a new message.
+ */
+export const RemoveAuthorizedKeyResponseSchema: GenMessage<RemoveAuthorizedKeyResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 87);
 
 /**
  * Minecraft player lookup messages
@@ -2618,7 +2981,7 @@ export type GetMinecraftPlayerUUIDRequest = Message<"obiente.cloud.gameservers.v
  * Use `create(GetMinecraftPlayerUUIDRequestSchema)` to create a new message.
  */
 export const GetMinecraftPlayerUUIDRequestSchema: GenMessage<GetMinecraftPlayerUUIDRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 76);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 88);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetMinecraftPlayerUUIDResponse
@@ -2644,7 +3007,7 @@ export type GetMinecraftPlayerUUIDResponse = Message<"obiente.cloud.gameservers.
  * Use `create(GetMinecraftPlayerUUIDResponseSchema)` to create a new message.
  */
 export const GetMinecraftPlayerUUIDResponseSchema: GenMessage<GetMinecraftPlayerUUIDResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 77);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 89);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileRequest
@@ -2663,7 +3026,7 @@ export type GetMinecraftPlayerProfileRequest = Message<"obiente.cloud.gameserver
  * Use `create(GetMinecraftPlayerProfileRequestSchema)` to create a new message.
  */
 export const GetMinecraftPlayerProfileRequestSchema: GenMessage<GetMinecraftPlayerProfileRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 78);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 90);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetMinecraftPlayerProfileResponse
@@ -2696,7 +3059,7 @@ export type GetMinecraftPlayerProfileResponse = Message<"obiente.cloud.gameserve
  * Use `create(GetMinecraftPlayerProfileResponseSchema)` to create a new message.
  */
 export const GetMinecraftPlayerProfileResponseSchema: GenMessage<GetMinecraftPlayerProfileResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 79);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 91);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.MinecraftProject
@@ -2802,7 +3165,7 @@ export type MinecraftProject = Message<"obiente.cloud.gameservers.v1.MinecraftPr
  * Use `create(MinecraftProjectSchema)` to create a new message.
  */
 export const MinecraftProjectSchema: GenMessage<MinecraftProject> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 80);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 92);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.ListMinecraftProjectsRequest
@@ -2854,7 +3217,7 @@ export type ListMinecraftProjectsRequest = Message<"obiente.cloud.gameservers.v1
  * Use `create(ListMinecraftProjectsRequestSchema)` to create a new message.
  */
 export const ListMinecraftProjectsRequestSchema: GenMessage<ListMinecraftProjectsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 81);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 93);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.ListMinecraftProjectsResponse
@@ -2881,7 +3244,7 @@ export type ListMinecraftProjectsResponse = Message<"obiente.cloud.gameservers.v
  * Use `create(ListMinecraftProjectsResponseSchema)` to create a new message.
  */
 export const ListMinecraftProjectsResponseSchema: GenMessage<ListMinecraftProjectsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 82);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 94);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.InstalledMinecraftProjectFile
@@ -2993,7 +3356,7 @@ export type InstalledMinecraftProjectFile = Message<"obiente.cloud.gameservers.v
  * Use `create(InstalledMinecraftProjectFileSchema)` to create a new message.
  */
 export const InstalledMinecraftProjectFileSchema: GenMessage<InstalledMinecraftProjectFile> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 83);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 95);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsRequest
@@ -3020,7 +3383,7 @@ export type ListInstalledMinecraftProjectsRequest = Message<"obiente.cloud.games
  * Use `create(ListInstalledMinecraftProjectsRequestSchema)` to create a new message.
  */
 export const ListInstalledMinecraftProjectsRequestSchema: GenMessage<ListInstalledMinecraftProjectsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 84);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 96);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.ListInstalledMinecraftProjectsResponse
@@ -3037,7 +3400,7 @@ export type ListInstalledMinecraftProjectsResponse = Message<"obiente.cloud.game
  * Use `create(ListInstalledMinecraftProjectsResponseSchema)` to create a new message.
  */
 export const ListInstalledMinecraftProjectsResponseSchema: GenMessage<ListInstalledMinecraftProjectsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 85);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 97);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.MinecraftProjectFile
@@ -3074,7 +3437,7 @@ export type MinecraftProjectFile = Message<"obiente.cloud.gameservers.v1.Minecra
  * Use `create(MinecraftProjectFileSchema)` to create a new message.
  */
 export const MinecraftProjectFileSchema: GenMessage<MinecraftProjectFile> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 86);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 98);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.MinecraftProjectVersion
@@ -3143,7 +3506,7 @@ export type MinecraftProjectVersion = Message<"obiente.cloud.gameservers.v1.Mine
  * Use `create(MinecraftProjectVersionSchema)` to create a new message.
  */
 export const MinecraftProjectVersionSchema: GenMessage<MinecraftProjectVersion> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 87);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 99);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsRequest
@@ -3190,7 +3553,7 @@ export type GetMinecraftProjectVersionsRequest = Message<"obiente.cloud.gameserv
  * Use `create(GetMinecraftProjectVersionsRequestSchema)` to create a new message.
  */
 export const GetMinecraftProjectVersionsRequestSchema: GenMessage<GetMinecraftProjectVersionsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 88);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 100);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetMinecraftProjectVersionsResponse
@@ -3207,7 +3570,7 @@ export type GetMinecraftProjectVersionsResponse = Message<"obiente.cloud.gameser
  * Use `create(GetMinecraftProjectVersionsResponseSchema)` to create a new message.
  */
 export const GetMinecraftProjectVersionsResponseSchema: GenMessage<GetMinecraftProjectVersionsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 89);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 101);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetMinecraftProjectRequest
@@ -3229,7 +3592,7 @@ export type GetMinecraftProjectRequest = Message<"obiente.cloud.gameservers.v1.G
  * Use `create(GetMinecraftProjectRequestSchema)` to create a new message.
  */
 export const GetMinecraftProjectRequestSchema: GenMessage<GetMinecraftProjectRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 90);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 102);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.GetMinecraftProjectResponse
@@ -3246,7 +3609,7 @@ export type GetMinecraftProjectResponse = Message<"obiente.cloud.gameservers.v1.
  * Use `create(GetMinecraftProjectResponseSchema)` to create a new message.
  */
 export const GetMinecraftProjectResponseSchema: GenMessage<GetMinecraftProjectResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 91);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 103);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.InstallMinecraftProjectFileRequest
@@ -3293,7 +3656,7 @@ export type InstallMinecraftProjectFileRequest = Message<"obiente.cloud.gameserv
  * Use `create(InstallMinecraftProjectFileRequestSchema)` to create a new message.
  */
 export const InstallMinecraftProjectFileRequestSchema: GenMessage<InstallMinecraftProjectFileRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 92);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 104);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.InstallMinecraftProjectFileResponse
@@ -3330,7 +3693,7 @@ export type InstallMinecraftProjectFileResponse = Message<"obiente.cloud.gameser
  * Use `create(InstallMinecraftProjectFileResponseSchema)` to create a new message.
  */
 export const InstallMinecraftProjectFileResponseSchema: GenMessage<InstallMinecraftProjectFileResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 93);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 105);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileRequest
@@ -3382,7 +3745,7 @@ export type UpdateMinecraftProjectFileRequest = Message<"obiente.cloud.gameserve
  * Use `create(UpdateMinecraftProjectFileRequestSchema)` to create a new message.
  */
 export const UpdateMinecraftProjectFileRequestSchema: GenMessage<UpdateMinecraftProjectFileRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 94);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 106);
 
 /**
  * @generated from message obiente.cloud.gameservers.v1.UpdateMinecraftProjectFileResponse
@@ -3424,151 +3787,756 @@ export type UpdateMinecraftProjectFileResponse = Message<"obiente.cloud.gameserv
  * Use `create(UpdateMinecraftProjectFileResponseSchema)` to create a new message.
  */
 export const UpdateMinecraftProjectFileResponseSchema: GenMessage<UpdateMinecraftProjectFileResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 95);
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 107);
 
 /**
- * GameType represents the type of game server
- *
- * @generated from enum obiente.cloud.gameservers.v1.GameType
+ * @generated from message obiente.cloud.gameservers.v1.GameServerMod
  */
-export enum GameType {
+export type GameServerMod = Message<"obiente.cloud.gameservers.v1.GameServerMod"> & {
   /**
-   * @generated from enum value: GAME_TYPE_UNSPECIFIED = 0;
+   * @generated from field: string id = 1;
    */
-  GAME_TYPE_UNSPECIFIED = 0,
+  id: string;
 
   /**
-   * Minecraft (Java/Bedrock)
-   *
-   * @generated from enum value: MINECRAFT = 1;
+   * @generated from field: string game_server_id = 2;
    */
-  MINECRAFT = 1,
+  gameServerId: string;
 
   /**
-   * Minecraft Java Edition
+   * "modrinth" or "curseforge"
    *
-   * @generated from enum value: MINECRAFT_JAVA = 2;
+   * @generated from field: string source = 3;
    */
-  MINECRAFT_JAVA = 2,
+  source: string;
 
   /**
-   * Minecraft Bedrock Edition
+   * Modrinth project ID or CurseForge mod ID
    *
-   * @generated from enum value: MINECRAFT_BEDROCK = 3;
+   * @generated from field: string mod_id = 4;
    */
-  MINECRAFT_BEDROCK = 3,
+  modId: string;
 
   /**
-   * Valheim
+   * Modrinth version ID or CurseForge file ID
    *
-   * @generated from enum value: VALHEIM = 4;
+   * @generated from field: string version = 5;
    */
-  VALHEIM = 4,
+  version: string;
 
   /**
-   * Terraria
+   * Jar file name in the mods directory
    *
-   * @generated from enum value: TERRARIA = 5;
+   * @generated from field: string filename = 6;
    */
-  TERRARIA = 5,
+  filename: string;
 
   /**
-   * Rust
-   *
-   * @generated from enum value: RUST = 6;
+   * @generated from field: google.protobuf.Timestamp installed_at = 7;
    */
-  RUST = 6,
+  installedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.GameServerMod.
+ * Use `create(GameServerModSchema)` to create a new message.
+ */
+export const GameServerModSchema: GenMessage<GameServerMod> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 108);
 
+/**
+ * @generated from message obiente.cloud.gameservers.v1.InstallGameServerModRequest
+ */
+export type InstallGameServerModRequest = Message<"obiente.cloud.gameservers.v1.InstallGameServerModRequest"> & {
   /**
-   * Counter-Strike 2
-   *
-   * @generated from enum value: CS2 = 7;
+   * @generated from field: string game_server_id = 1;
    */
-  CS2 = 7,
+  gameServerId: string;
 
   /**
-   * Team Fortress 2
-   *
-   * @generated from enum value: TF2 = 8;
+   * @generated from field: string source = 2;
    */
-  TF2 = 8,
+  source: string;
 
   /**
-   * ARK: Survival Evolved
-   *
-   * @generated from enum value: ARK = 9;
+   * @generated from field: string mod_id = 3;
    */
-  ARK = 9,
+  modId: string;
 
   /**
-   * Conan Exiles
-   *
-   * @generated from enum value: CONAN = 10;
+   * @generated from field: string version = 4;
    */
-  CONAN = 10,
+  version: string;
+};
 
+/**
+ * Describes the message obiente.cloud.gameservers.v1.InstallGameServerModRequest.
+ * Use `create(InstallGameServerModRequestSchema)` to create a new message.
+ */
+export const InstallGameServerModRequestSchema: GenMessage<InstallGameServerModRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 109);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.InstallGameServerModResponse
+ */
+export type InstallGameServerModResponse = Message<"obiente.cloud.gameservers.v1.InstallGameServerModResponse"> & {
   /**
-   * 7 Days to Die
-   *
-   * @generated from enum value: SEVEN_DAYS = 11;
+   * @generated from field: obiente.cloud.gameservers.v1.GameServerMod mod = 1;
    */
-  SEVEN_DAYS = 11,
+  mod?: GameServerMod;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.InstallGameServerModResponse.
+ * Use `create(InstallGameServerModResponseSchema)` to create a new message.
+ */
+export const InstallGameServerModResponseSchema: GenMessage<InstallGameServerModResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 110);
 
+/**
+ * @generated from message obiente.cloud.gameservers.v1.UninstallGameServerModRequest
+ */
+export type UninstallGameServerModRequest = Message<"obiente.cloud.gameservers.v1.UninstallGameServerModRequest"> & {
   /**
-   * Factorio
-   *
-   * @generated from enum value: FACTORIO = 12;
+   * @generated from field: string game_server_id = 1;
    */
-  FACTORIO = 12,
+  gameServerId: string;
 
   /**
-   * Space Engineers
-   *
-   * @generated from enum value: SPACED_ENGINEERS = 13;
+   * @generated from field: string source = 2;
    */
-  SPACED_ENGINEERS = 13,
+  source: string;
 
   /**
-   * Other/Unknown game
-   *
-   * @generated from enum value: OTHER = 99;
+   * @generated from field: string mod_id = 3;
    */
-  OTHER = 99,
-}
+  modId: string;
+};
 
 /**
- * Describes the enum obiente.cloud.gameservers.v1.GameType.
+ * Describes the message obiente.cloud.gameservers.v1.UninstallGameServerModRequest.
+ * Use `create(UninstallGameServerModRequestSchema)` to create a new message.
  */
-export const GameTypeSchema: GenEnum<GameType> = /*@__PURE__*/
-  enumDesc(file_obiente_cloud_gameservers_v1_game_server_service, 0);
+export const UninstallGameServerModRequestSchema: GenMessage<UninstallGameServerModRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 111);
 
 /**
- * GameServerStatus represents the current status of a game server
- *
- * @generated from enum obiente.cloud.gameservers.v1.GameServerStatus
+ * @generated from message obiente.cloud.gameservers.v1.UninstallGameServerModResponse
  */
-export enum GameServerStatus {
+export type UninstallGameServerModResponse = Message<"obiente.cloud.gameservers.v1.UninstallGameServerModResponse"> & {
   /**
-   * @generated from enum value: GAME_SERVER_STATUS_UNSPECIFIED = 0;
+   * @generated from field: bool success = 1;
    */
-  GAME_SERVER_STATUS_UNSPECIFIED = 0,
+  success: boolean;
+};
 
+/**
+ * Describes the message obiente.cloud.gameservers.v1.UninstallGameServerModResponse.
+ * Use `create(UninstallGameServerModResponseSchema)` to create a new message.
+ */
+export const UninstallGameServerModResponseSchema: GenMessage<UninstallGameServerModResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 112);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ListGameServerModsRequest
+ */
+export type ListGameServerModsRequest = Message<"obiente.cloud.gameservers.v1.ListGameServerModsRequest"> & {
   /**
-   * Server created but not started
-   *
-   * @generated from enum value: CREATED = 1;
+   * @generated from field: string game_server_id = 1;
    */
-  CREATED = 1,
+  gameServerId: string;
+};
 
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ListGameServerModsRequest.
+ * Use `create(ListGameServerModsRequestSchema)` to create a new message.
+ */
+export const ListGameServerModsRequestSchema: GenMessage<ListGameServerModsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 113);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ListGameServerModsResponse
+ */
+export type ListGameServerModsResponse = Message<"obiente.cloud.gameservers.v1.ListGameServerModsResponse"> & {
   /**
-   * Server is starting
-   *
-   * @generated from enum value: STARTING = 2;
+   * @generated from field: repeated obiente.cloud.gameservers.v1.GameServerMod mods = 1;
    */
-  STARTING = 2,
+  mods: GameServerMod[];
+};
 
-  /**
-   * Server is running
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ListGameServerModsResponse.
+ * Use `create(ListGameServerModsResponseSchema)` to create a new message.
+ */
+export const ListGameServerModsResponseSchema: GenMessage<ListGameServerModsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 114);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.GameServerBackup
+ */
+export type GameServerBackup = Message<"obiente.cloud.gameservers.v1.GameServerBackup"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string game_server_id = 2;
+   */
+  gameServerId: string;
+
+  /**
+   * @generated from field: int64 size_bytes = 3;
+   */
+  sizeBytes: bigint;
+
+  /**
+   * "in_progress", "completed" or "failed"
+   *
+   * @generated from field: string status = 4;
+   */
+  status: string;
+
+  /**
+   * @generated from field: optional string error_message = 5;
+   */
+  errorMessage?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp completed_at = 7;
+   */
+  completedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.GameServerBackup.
+ * Use `create(GameServerBackupSchema)` to create a new message.
+ */
+export const GameServerBackupSchema: GenMessage<GameServerBackup> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 115);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ScheduleGameServerBackupRequest
+ */
+export type ScheduleGameServerBackupRequest = Message<"obiente.cloud.gameservers.v1.ScheduleGameServerBackupRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * Interval between backups (e.g. "6h", at least 1h); empty disables automatic backups
+   *
+   * @generated from field: string schedule = 2;
+   */
+  schedule: string;
+
+  /**
+   * Number of backups to keep (default 7)
+   *
+   * @generated from field: optional int32 retention = 3;
+   */
+  retention?: number;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ScheduleGameServerBackupRequest.
+ * Use `create(ScheduleGameServerBackupRequestSchema)` to create a new message.
+ */
+export const ScheduleGameServerBackupRequestSchema: GenMessage<ScheduleGameServerBackupRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 116);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ScheduleGameServerBackupResponse
+ */
+export type ScheduleGameServerBackupResponse = Message<"obiente.cloud.gameservers.v1.ScheduleGameServerBackupResponse"> & {
+  /**
+   * @generated from field: string schedule = 1;
+   */
+  schedule: string;
+
+  /**
+   * @generated from field: int32 retention = 2;
+   */
+  retention: number;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ScheduleGameServerBackupResponse.
+ * Use `create(ScheduleGameServerBackupResponseSchema)` to create a new message.
+ */
+export const ScheduleGameServerBackupResponseSchema: GenMessage<ScheduleGameServerBackupResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 117);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ListGameServerBackupsRequest
+ */
+export type ListGameServerBackupsRequest = Message<"obiente.cloud.gameservers.v1.ListGameServerBackupsRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ListGameServerBackupsRequest.
+ * Use `create(ListGameServerBackupsRequestSchema)` to create a new message.
+ */
+export const ListGameServerBackupsRequestSchema: GenMessage<ListGameServerBackupsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 118);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.ListGameServerBackupsResponse
+ */
+export type ListGameServerBackupsResponse = Message<"obiente.cloud.gameservers.v1.ListGameServerBackupsResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.gameservers.v1.GameServerBackup backups = 1;
+   */
+  backups: GameServerBackup[];
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.ListGameServerBackupsResponse.
+ * Use `create(ListGameServerBackupsResponseSchema)` to create a new message.
+ */
+export const ListGameServerBackupsResponseSchema: GenMessage<ListGameServerBackupsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 119);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.RestoreGameServerBackupRequest
+ */
+export type RestoreGameServerBackupRequest = Message<"obiente.cloud.gameservers.v1.RestoreGameServerBackupRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * @generated from field: string backup_id = 2;
+   */
+  backupId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.RestoreGameServerBackupRequest.
+ * Use `create(RestoreGameServerBackupRequestSchema)` to create a new message.
+ */
+export const RestoreGameServerBackupRequestSchema: GenMessage<RestoreGameServerBackupRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 120);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.RestoreGameServerBackupResponse
+ */
+export type RestoreGameServerBackupResponse = Message<"obiente.cloud.gameservers.v1.RestoreGameServerBackupResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.RestoreGameServerBackupResponse.
+ * Use `create(RestoreGameServerBackupResponseSchema)` to create a new message.
+ */
+export const RestoreGameServerBackupResponseSchema: GenMessage<RestoreGameServerBackupResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 121);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.DiscordIntegration
+ */
+export type DiscordIntegration = Message<"obiente.cloud.gameservers.v1.DiscordIntegration"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string game_server_id = 2;
+   */
+  gameServerId: string;
+
+  /**
+   * @generated from field: string channel_id = 3;
+   */
+  channelId: string;
+
+  /**
+   * "start", "stop", "crash", "player_join" and/or "player_leave"
+   *
+   * @generated from field: repeated string events = 4;
+   */
+  events: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 6;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.DiscordIntegration.
+ * Use `create(DiscordIntegrationSchema)` to create a new message.
+ */
+export const DiscordIntegrationSchema: GenMessage<DiscordIntegration> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 122);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.SetDiscordIntegrationRequest
+ */
+export type SetDiscordIntegrationRequest = Message<"obiente.cloud.gameservers.v1.SetDiscordIntegrationRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * Required when creating the integration; kept when omitted on update
+   *
+   * @generated from field: optional string bot_token = 2;
+   */
+  botToken?: string;
+
+  /**
+   * @generated from field: string channel_id = 3;
+   */
+  channelId: string;
+
+  /**
+   * Events to post; empty subscribes to all of them
+   *
+   * @generated from field: repeated string events = 4;
+   */
+  events: string[];
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.SetDiscordIntegrationRequest.
+ * Use `create(SetDiscordIntegrationRequestSchema)` to create a new message.
+ */
+export const SetDiscordIntegrationRequestSchema: GenMessage<SetDiscordIntegrationRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 123);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.SetDiscordIntegrationResponse
+ */
+export type SetDiscordIntegrationResponse = Message<"obiente.cloud.gameservers.v1.SetDiscordIntegrationResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.gameservers.v1.DiscordIntegration integration = 1;
+   */
+  integration?: DiscordIntegration;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.SetDiscordIntegrationResponse.
+ * Use `create(SetDiscordIntegrationResponseSchema)` to create a new message.
+ */
+export const SetDiscordIntegrationResponseSchema: GenMessage<SetDiscordIntegrationResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 124);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.DeleteDiscordIntegrationRequest
+ */
+export type DeleteDiscordIntegrationRequest = Message<"obiente.cloud.gameservers.v1.DeleteDiscordIntegrationRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.DeleteDiscordIntegrationRequest.
+ * Use `create(DeleteDiscordIntegrationRequestSchema)` to create a new message.
+ */
+export const DeleteDiscordIntegrationRequestSchema: GenMessage<DeleteDiscordIntegrationRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 125);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.DeleteDiscordIntegrationResponse
+ */
+export type DeleteDiscordIntegrationResponse = Message<"obiente.cloud.gameservers.v1.DeleteDiscordIntegrationResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.DeleteDiscordIntegrationResponse.
+ * Use `create(DeleteDiscordIntegrationResponseSchema)` to create a new message.
+ */
+export const DeleteDiscordIntegrationResponseSchema: GenMessage<DeleteDiscordIntegrationResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 126);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.IdleShutdownPolicy
+ */
+export type IdleShutdownPolicy = Message<"obiente.cloud.gameservers.v1.IdleShutdownPolicy"> & {
+  /**
+   * 0 disables idle shutdown
+   *
+   * @generated from field: int32 idle_timeout_minutes = 1;
+   */
+  idleTimeoutMinutes: number;
+
+  /**
+   * The server counts as idle while fewer players are online
+   *
+   * @generated from field: int32 min_players_to_stay_alive = 2;
+   */
+  minPlayersToStayAlive: number;
+
+  /**
+   * Last time enough players were online since the server started
+   *
+   * @generated from field: optional google.protobuf.Timestamp last_player_seen_at = 3;
+   */
+  lastPlayerSeenAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.IdleShutdownPolicy.
+ * Use `create(IdleShutdownPolicySchema)` to create a new message.
+ */
+export const IdleShutdownPolicySchema: GenMessage<IdleShutdownPolicy> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 127);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.SetIdleShutdownPolicyRequest
+ */
+export type SetIdleShutdownPolicyRequest = Message<"obiente.cloud.gameservers.v1.SetIdleShutdownPolicyRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * 0 disables idle shutdown, otherwise between 5 and 10080 (7 days)
+   *
+   * @generated from field: int32 idle_timeout_minutes = 2;
+   */
+  idleTimeoutMinutes: number;
+
+  /**
+   * Defaults to 1
+   *
+   * @generated from field: int32 min_players_to_stay_alive = 3;
+   */
+  minPlayersToStayAlive: number;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.SetIdleShutdownPolicyRequest.
+ * Use `create(SetIdleShutdownPolicyRequestSchema)` to create a new message.
+ */
+export const SetIdleShutdownPolicyRequestSchema: GenMessage<SetIdleShutdownPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 128);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.SetIdleShutdownPolicyResponse
+ */
+export type SetIdleShutdownPolicyResponse = Message<"obiente.cloud.gameservers.v1.SetIdleShutdownPolicyResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.gameservers.v1.IdleShutdownPolicy policy = 1;
+   */
+  policy?: IdleShutdownPolicy;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.SetIdleShutdownPolicyResponse.
+ * Use `create(SetIdleShutdownPolicyResponseSchema)` to create a new message.
+ */
+export const SetIdleShutdownPolicyResponseSchema: GenMessage<SetIdleShutdownPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 129);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.GetIdleShutdownPolicyRequest
+ */
+export type GetIdleShutdownPolicyRequest = Message<"obiente.cloud.gameservers.v1.GetIdleShutdownPolicyRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.GetIdleShutdownPolicyRequest.
+ * Use `create(GetIdleShutdownPolicyRequestSchema)` to create a new message.
+ */
+export const GetIdleShutdownPolicyRequestSchema: GenMessage<GetIdleShutdownPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 130);
+
+/**
+ * @generated from message obiente.cloud.gameservers.v1.GetIdleShutdownPolicyResponse
+ */
+export type GetIdleShutdownPolicyResponse = Message<"obiente.cloud.gameservers.v1.GetIdleShutdownPolicyResponse"> & {
+  /**
+   * Disabled when the game server has no policy
+   *
+   * @generated from field: obiente.cloud.gameservers.v1.IdleShutdownPolicy policy = 1;
+   */
+  policy?: IdleShutdownPolicy;
+};
+
+/**
+ * Describes the message obiente.cloud.gameservers.v1.GetIdleShutdownPolicyResponse.
+ * Use `create(GetIdleShutdownPolicyResponseSchema)` to create a new message.
+ */
+export const GetIdleShutdownPolicyResponseSchema: GenMessage<GetIdleShutdownPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_gameservers_v1_game_server_service, 131);
+
+/**
+ * GameType represents the type of game server
+ *
+ * @generated from enum obiente.cloud.gameservers.v1.GameType
+ */
+export enum GameType {
+  /**
+   * @generated from enum value: GAME_TYPE_UNSPECIFIED = 0;
+   */
+  GAME_TYPE_UNSPECIFIED = 0,
+
+  /**
+   * Minecraft (Java/Bedrock)
+   *
+   * @generated from enum value: MINECRAFT = 1;
+   */
+  MINECRAFT = 1,
+
+  /**
+   * Minecraft Java Edition
+   *
+   * @generated from enum value: MINECRAFT_JAVA = 2;
+   */
+  MINECRAFT_JAVA = 2,
+
+  /**
+   * Minecraft Bedrock Edition
+   *
+   * @generated from enum value: MINECRAFT_BEDROCK = 3;
+   */
+  MINECRAFT_BEDROCK = 3,
+
+  /**
+   * Valheim
+   *
+   * @generated from enum value: VALHEIM = 4;
+   */
+  VALHEIM = 4,
+
+  /**
+   * Terraria
+   *
+   * @generated from enum value: TERRARIA = 5;
+   */
+  TERRARIA = 5,
+
+  /**
+   * Rust
+   *
+   * @generated from enum value: RUST = 6;
+   */
+  RUST = 6,
+
+  /**
+   * Counter-Strike 2
+   *
+   * @generated from enum value: CS2 = 7;
+   */
+  CS2 = 7,
+
+  /**
+   * Team Fortress 2
+   *
+   * @generated from enum value: TF2 = 8;
+   */
+  TF2 = 8,
+
+  /**
+   * ARK: Survival Evolved
+   *
+   * @generated from enum value: ARK = 9;
+   */
+  ARK = 9,
+
+  /**
+   * Conan Exiles
+   *
+   * @generated from enum value: CONAN = 10;
+   */
+  CONAN = 10,
+
+  /**
+   * 7 Days to Die
+   *
+   * @generated from enum value: SEVEN_DAYS = 11;
+   */
+  SEVEN_DAYS = 11,
+
+  /**
+   * Factorio
+   *
+   * @generated from enum value: FACTORIO = 12;
+   */
+  FACTORIO = 12,
+
+  /**
+   * Space Engineers
+   *
+   * @generated from enum value: SPACED_ENGINEERS = 13;
+   */
+  SPACED_ENGINEERS = 13,
+
+  /**
+   * Other/Unknown game
+   *
+   * @generated from enum value: OTHER = 99;
+   */
+  OTHER = 99,
+}
+
+/**
+ * Describes the enum obiente.cloud.gameservers.v1.GameType.
+ */
+export const GameTypeSchema: GenEnum<GameType> = /*@__PURE__*/
+  enumDesc(file_obiente_cloud_gameservers_v1_game_server_service, 0);
+
+/**
+ * GameServerStatus represents the current status of a game server
+ *
+ * @generated from enum obiente.cloud.gameservers.v1.GameServerStatus
+ */
+export enum GameServerStatus {
+  /**
+   * @generated from enum value: GAME_SERVER_STATUS_UNSPECIFIED = 0;
+   */
+  GAME_SERVER_STATUS_UNSPECIFIED = 0,
+
+  /**
+   * Server created but not started
+   *
+   * @generated from enum value: CREATED = 1;
+   */
+  CREATED = 1,
+
+  /**
+   * Server is starting
+   *
+   * @generated from enum value: STARTING = 2;
+   */
+  STARTING = 2,
+
+  /**
+   * Server is running
    *
    * @generated from enum value: RUNNING = 3;
    */
@@ -3601,6 +4569,13 @@ export enum GameServerStatus {
    * @generated from enum value: RESTARTING = 7;
    */
   RESTARTING = 7,
+
+  /**
+   * Server was stopped automatically after being idle
+   *
+   * @generated from enum value: STOPPED_IDLE = 8;
+   */
+  STOPPED_IDLE = 8,
 }
 
 /**
@@ -3609,6 +4584,37 @@ export enum GameServerStatus {
 export const GameServerStatusSchema: GenEnum<GameServerStatus> = /*@__PURE__*/
   enumDesc(file_obiente_cloud_gameservers_v1_game_server_service, 1);
 
+/**
+ * @generated from enum obiente.cloud.gameservers.v1.PlayerListAction
+ */
+export enum PlayerListAction {
+  /**
+   * @generated from enum value: PLAYER_LIST_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PLAYER_LIST_ACTION_ADD = 1;
+   */
+  ADD = 1,
+
+  /**
+   * @generated from enum value: PLAYER_LIST_ACTION_REMOVE = 2;
+   */
+  REMOVE = 2,
+
+  /**
+   * @generated from enum value: PLAYER_LIST_ACTION_LIST = 3;
+   */
+  LIST = 3,
+}
+
+/**
+ * Describes the enum obiente.cloud.gameservers.v1.PlayerListAction.
+ */
+export const PlayerListActionSchema: GenEnum<PlayerListAction> = /*@__PURE__*/
+  enumDesc(file_obiente_cloud_gameservers_v1_game_server_service, 2);
+
 /**
  * @generated from enum obiente.cloud.gameservers.v1.GameServerEntryType
  */
@@ -3638,7 +4644,7 @@ export enum GameServerEntryType {
  * Describes the enum obiente.cloud.gameservers.v1.GameServerEntryType.
  */
 export const GameServerEntryTypeSchema: GenEnum<GameServerEntryType> = /*@__PURE__*/
-  enumDesc(file_obiente_cloud_gameservers_v1_game_server_service, 2);
+  enumDesc(file_obiente_cloud_gameservers_v1_game_server_service, 3);
 
 /**
  * Minecraft catalog messages
@@ -3666,7 +4672,7 @@ export enum MinecraftProjectType {
  * Describes the enum obiente.cloud.gameservers.v1.MinecraftProjectType.
  */
 export const MinecraftProjectTypeSchema: GenEnum<MinecraftProjectType> = /*@__PURE__*/
-  enumDesc(file_obiente_cloud_gameservers_v1_game_server_service, 3);
+  enumDesc(file_obiente_cloud_gameservers_v1_game_server_service, 4);
 
 /**
  * @generated from service obiente.cloud.gameservers.v1.GameServerService
@@ -3832,6 +4838,36 @@ export const GameServerService: GenService<{
     input: typeof StreamGameServerLogsRequestSchema;
     output: typeof GameServerLogLineSchema;
   },
+  /**
+   * Execute a console command over RCON (rate-limited to 60 per minute per game server)
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.ExecuteGameServerCommand
+   */
+  executeGameServerCommand: {
+    methodKind: "unary";
+    input: typeof ExecuteGameServerCommandRequestSchema;
+    output: typeof ExecuteGameServerCommandResponseSchema;
+  },
+  /**
+   * Add, remove or list whitelisted players of a running Minecraft Java server
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.ManagePlayerWhitelist
+   */
+  managePlayerWhitelist: {
+    methodKind: "unary";
+    input: typeof ManagePlayerWhitelistRequestSchema;
+    output: typeof ManagePlayerWhitelistResponseSchema;
+  },
+  /**
+   * Ban, pardon or list banned players of a running Minecraft Java server
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.ManagePlayerBanList
+   */
+  managePlayerBanList: {
+    methodKind: "unary";
+    input: typeof ManagePlayerBanListRequestSchema;
+    output: typeof ManagePlayerBanListResponseSchema;
+  },
   /**
    * Get game server metrics (real-time or historical)
    *
@@ -4004,6 +5040,26 @@ export const GameServerService: GenService<{
     input: typeof RevokeGameServerFileTransferCredentialRequestSchema;
     output: typeof RevokeGameServerFileTransferCredentialResponseSchema;
   },
+  /**
+   * Authorize an SSH public key to log in as an SFTP credential
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.AddAuthorizedKey
+   */
+  addAuthorizedKey: {
+    methodKind: "unary";
+    input: typeof AddAuthorizedKeyRequestSchema;
+    output: typeof AddAuthorizedKeyResponseSchema;
+  },
+  /**
+   * Remove an authorized SSH public key from an SFTP credential
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.RemoveAuthorizedKey
+   */
+  removeAuthorizedKey: {
+    methodKind: "unary";
+    input: typeof RemoveAuthorizedKeyRequestSchema;
+    output: typeof RemoveAuthorizedKeyResponseSchema;
+  },
   /**
    * Minecraft player lookup (proxies Mojang API to avoid CORS)
    * Get player UUID from username
@@ -4083,6 +5139,106 @@ export const GameServerService: GenService<{
     input: typeof UpdateMinecraftProjectFileRequestSchema;
     output: typeof UpdateMinecraftProjectFileResponseSchema;
   },
+  /**
+   * Install a mod from Modrinth or CurseForge, record it and restart the server
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.InstallGameServerMod
+   */
+  installGameServerMod: {
+    methodKind: "unary";
+    input: typeof InstallGameServerModRequestSchema;
+    output: typeof InstallGameServerModResponseSchema;
+  },
+  /**
+   * Remove an installed mod and restart the server
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.UninstallGameServerMod
+   */
+  uninstallGameServerMod: {
+    methodKind: "unary";
+    input: typeof UninstallGameServerModRequestSchema;
+    output: typeof UninstallGameServerModResponseSchema;
+  },
+  /**
+   * List mods installed through InstallGameServerMod
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.ListGameServerMods
+   */
+  listGameServerMods: {
+    methodKind: "unary";
+    input: typeof ListGameServerModsRequestSchema;
+    output: typeof ListGameServerModsResponseSchema;
+  },
+  /**
+   * Set how often the server's data directory is backed up to object storage and how many backups are kept
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.ScheduleGameServerBackup
+   */
+  scheduleGameServerBackup: {
+    methodKind: "unary";
+    input: typeof ScheduleGameServerBackupRequestSchema;
+    output: typeof ScheduleGameServerBackupResponseSchema;
+  },
+  /**
+   * List backups of a game server, newest first
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.ListGameServerBackups
+   */
+  listGameServerBackups: {
+    methodKind: "unary";
+    input: typeof ListGameServerBackupsRequestSchema;
+    output: typeof ListGameServerBackupsResponseSchema;
+  },
+  /**
+   * Restore a backup into the server's data directory (the server is stopped while restoring)
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.RestoreGameServerBackup
+   */
+  restoreGameServerBackup: {
+    methodKind: "unary";
+    input: typeof RestoreGameServerBackupRequestSchema;
+    output: typeof RestoreGameServerBackupResponseSchema;
+  },
+  /**
+   * Post game server events (start, stop, crash, player join/leave) to a Discord channel through a bot
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.SetDiscordIntegration
+   */
+  setDiscordIntegration: {
+    methodKind: "unary";
+    input: typeof SetDiscordIntegrationRequestSchema;
+    output: typeof SetDiscordIntegrationResponseSchema;
+  },
+  /**
+   * Stop posting game server events to Discord
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.DeleteDiscordIntegration
+   */
+  deleteDiscordIntegration: {
+    methodKind: "unary";
+    input: typeof DeleteDiscordIntegrationRequestSchema;
+    output: typeof DeleteDiscordIntegrationResponseSchema;
+  },
+  /**
+   * Stop the server automatically when no players have been online for a while
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.SetIdleShutdownPolicy
+   */
+  setIdleShutdownPolicy: {
+    methodKind: "unary";
+    input: typeof SetIdleShutdownPolicyRequestSchema;
+    output: typeof SetIdleShutdownPolicyResponseSchema;
+  },
+  /**
+   * Get the idle shutdown policy of a game server
+   *
+   * @generated from rpc obiente.cloud.gameservers.v1.GameServerService.GetIdleShutdownPolicy
+   */
+  getIdleShutdownPolicy: {
+    methodKind: "unary";
+    input: typeof GetIdleShutdownPolicyRequestSchema;
+    output: typeof GetIdleShutdownPolicyResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_obiente_cloud_gameservers_v1_game_server_service, 0);
 
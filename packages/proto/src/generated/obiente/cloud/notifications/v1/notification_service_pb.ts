@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file obiente/cloud/notifications/v1/notification_service.proto.
  */
 export const file_obiente_cloud_notifications_v1_notification_service: GenFile = /*@__PURE__*/
-  fileDesc("CjlvYmllbnRlL2Nsb3VkL25vdGlmaWNhdGlvbnMvdjEvbm90aWZpY2F0aW9uX3NlcnZpY2UucHJvdG8SHm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MSKMAgoYTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0EhgKC3VucmVhZF9vbmx5GAEgASgISACIAQESQwoEdHlwZRgCIAEoDjIwLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25UeXBlSAGIAQESSwoIc2V2ZXJpdHkYAyABKA4yNC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uU2V2ZXJpdHlIAogBARIMCgRwYWdlGAQgASgFEhAKCHBlcl9wYWdlGAUgASgFQg4KDF91bnJlYWRfb25seUIHCgVfdHlwZUILCglfc2V2ZXJpdHkimQEKGUxpc3ROb3RpZmljYXRpb25zUmVzcG9uc2USQwoNbm90aWZpY2F0aW9ucxgBIAMoCzIsLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb24SNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24iMQoWR2V0Tm90aWZpY2F0aW9uUmVxdWVzdBIXCg9ub3RpZmljYXRpb25faWQYASABKAkiXQoXR2V0Tm90aWZpY2F0aW9uUmVzcG9uc2USQgoMbm90aWZpY2F0aW9uGAEgASgLMiwub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvbiIsChFNYXJrQXNSZWFkUmVxdWVzdBIXCg9ub3RpZmljYXRpb25faWQYASABKAkiWAoSTWFya0FzUmVhZFJlc3BvbnNlEkIKDG5vdGlmaWNhdGlvbhgBIAEoCzIsLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb24ivgEKFE1hcmtBbGxBc1JlYWRSZXF1ZXN0EkMKBHR5cGUYASABKA4yMC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uVHlwZUgAiAEBEksKCHNldmVyaXR5GAIgASgOMjQub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblNldmVyaXR5SAGIAQFCBwoFX3R5cGVCCwoJX3NldmVyaXR5Ii0KFU1hcmtBbGxBc1JlYWRSZXNwb25zZRIUCgxtYXJrZWRfY291bnQYASABKAUiNAoZRGVsZXRlTm90aWZpY2F0aW9uUmVxdWVzdBIXCg9ub3RpZmljYXRpb25faWQYASABKAkiLQoaRGVsZXRlTm90aWZpY2F0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCKTAQodRGVsZXRlQWxsTm90aWZpY2F0aW9uc1JlcXVlc3QSFgoJcmVhZF9vbmx5GAEgASgISACIAQESQwoEdHlwZRgCIAEoDjIwLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25UeXBlSAGIAQFCDAoKX3JlYWRfb25seUIHCgVfdHlwZSI3Ch5EZWxldGVBbGxOb3RpZmljYXRpb25zUmVzcG9uc2USFQoNZGVsZXRlZF9jb3VudBgBIAEoBSLHAQoVR2V0VW5yZWFkQ291bnRSZXF1ZXN0EkMKBHR5cGUYASABKA4yMC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uVHlwZUgAiAEBEk8KDG1pbl9zZXZlcml0eRgCIAEoDjI0Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25TZXZlcml0eUgBiAEBQgcKBV90eXBlQg8KDV9taW5fc2V2ZXJpdHkiJwoWR2V0VW5yZWFkQ291bnRSZXNwb25zZRINCgVjb3VudBgBIAEoBSLmAwoZQ3JlYXRlTm90aWZpY2F0aW9uUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhwKD29yZ2FuaXphdGlvbl9pZBgCIAEoCUgAiAEBEj4KBHR5cGUYAyABKA4yMC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uVHlwZRJGCghzZXZlcml0eRgEIAEoDjI0Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25TZXZlcml0eRINCgV0aXRsZRgFIAEoCRIPCgdtZXNzYWdlGAYgASgJEhcKCmFjdGlvbl91cmwYByABKAlIAYgBARIZCgxhY3Rpb25fbGFiZWwYCCABKAlIAogBARJZCghtZXRhZGF0YRgJIAMoCzJHLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5DcmVhdGVOb3RpZmljYXRpb25SZXF1ZXN0Lk1ldGFkYXRhRW50cnkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQhIKEF9vcmdhbml6YXRpb25faWRCDQoLX2FjdGlvbl91cmxCDwoNX2FjdGlvbl9sYWJlbCJgChpDcmVhdGVOb3RpZmljYXRpb25SZXNwb25zZRJCCgxub3RpZmljYXRpb24YASABKAsyLC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uIuMDCiVDcmVhdGVPcmdhbml6YXRpb25Ob3RpZmljYXRpb25SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRI+CgR0eXBlGAIgASgOMjAub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblR5cGUSRgoIc2V2ZXJpdHkYAyABKA4yNC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uU2V2ZXJpdHkSDQoFdGl0bGUYBCABKAkSDwoHbWVzc2FnZRgFIAEoCRIXCgphY3Rpb25fdXJsGAYgASgJSACIAQESGQoMYWN0aW9uX2xhYmVsGAcgASgJSAGIAQESZQoIbWV0YWRhdGEYCCADKAsyUy5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuQ3JlYXRlT3JnYW5pemF0aW9uTm90aWZpY2F0aW9uUmVxdWVzdC5NZXRhZGF0YUVudHJ5Eg0KBXJvbGVzGAkgAygJGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUINCgtfYWN0aW9uX3VybEIPCg1fYWN0aW9uX2xhYmVsIoQBCiZDcmVhdGVPcmdhbml6YXRpb25Ob3RpZmljYXRpb25SZXNwb25zZRIVCg1jcmVhdGVkX2NvdW50GAEgASgFEkMKDW5vdGlmaWNhdGlvbnMYAiADKAsyLC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uIpkFCgxOb3RpZmljYXRpb24SCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIcCg9vcmdhbml6YXRpb25faWQYAyABKAlIAIgBARI+CgR0eXBlGAQgASgOMjAub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblR5cGUSRgoIc2V2ZXJpdHkYBSABKA4yNC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uU2V2ZXJpdHkSDQoFdGl0bGUYBiABKAkSDwoHbWVzc2FnZRgHIAEoCRIMCgRyZWFkGAggASgIEjAKB3JlYWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESFwoKYWN0aW9uX3VybBgKIAEoCUgCiAEBEhkKDGFjdGlvbl9sYWJlbBgLIAEoCUgDiAEBEkwKCG1ldGFkYXRhGAwgAygLMjoub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvbi5NZXRhZGF0YUVudHJ5EhMKC2NsaWVudF9vbmx5GA0gASgIEi4KCmNyZWF0ZWRfYXQYDiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUISChBfb3JnYW5pemF0aW9uX2lkQgoKCF9yZWFkX2F0Qg0KC19hY3Rpb25fdXJsQg8KDV9hY3Rpb25fbGFiZWwiHQobR2V0Tm90aWZpY2F0aW9uVHlwZXNSZXF1ZXN0ImMKHEdldE5vdGlmaWNhdGlvblR5cGVzUmVzcG9uc2USQwoFdHlwZXMYASADKAsyNC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uVHlwZUluZm8ijAIKFE5vdGlmaWNhdGlvblR5cGVJbmZvEj4KBHR5cGUYASABKA4yMC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uVHlwZRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEh0KFWRlZmF1bHRfZW1haWxfZW5hYmxlZBgEIAEoCBIeChZkZWZhdWx0X2luX2FwcF9lbmFibGVkGAUgASgIElIKFGRlZmF1bHRfbWluX3NldmVyaXR5GAYgASgOMjQub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblNldmVyaXR5IiMKIUdldE5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVxdWVzdCJxCiJHZXROb3RpZmljYXRpb25QcmVmZXJlbmNlc1Jlc3BvbnNlEksKC3ByZWZlcmVuY2VzGAEgAygLMjYub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblByZWZlcmVuY2UicwokVXBkYXRlTm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0EksKC3ByZWZlcmVuY2VzGAEgAygLMjYub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblByZWZlcmVuY2UidAolVXBkYXRlTm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXNwb25zZRJLCgtwcmVmZXJlbmNlcxgBIAMoCzI2Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25QcmVmZXJlbmNlIqoCChZOb3RpZmljYXRpb25QcmVmZXJlbmNlEksKEW5vdGlmaWNhdGlvbl90eXBlGAEgASgOMjAub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblR5cGUSFQoNZW1haWxfZW5hYmxlZBgCIAEoCBIWCg5pbl9hcHBfZW5hYmxlZBgDIAEoCBJICglmcmVxdWVuY3kYBCABKA4yNS5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uRnJlcXVlbmN5EkoKDG1pbl9zZXZlcml0eRgFIAEoDjI0Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25TZXZlcml0eSrGAgoQTm90aWZpY2F0aW9uVHlwZRIhCh1OT1RJRklDQVRJT05fVFlQRV9VTlNQRUNJRklFRBAAEhoKFk5PVElGSUNBVElPTl9UWVBFX0lORk8QARIdChlOT1RJRklDQVRJT05fVFlQRV9TVUNDRVNTEAISHQoZTk9USUZJQ0FUSU9OX1RZUEVfV0FSTklORxADEhsKF05PVElGSUNBVElPTl9UWVBFX0VSUk9SEAQSIAocTk9USUZJQ0FUSU9OX1RZUEVfREVQTE9ZTUVOVBAFEh0KGU5PVElGSUNBVElPTl9UWVBFX0JJTExJTkcQBhIbChdOT1RJRklDQVRJT05fVFlQRV9RVU9UQRAHEhwKGE5PVElGSUNBVElPTl9UWVBFX0lOVklURRAIEhwKGE5PVElGSUNBVElPTl9UWVBFX1NZU1RFTRAJKsIBChROb3RpZmljYXRpb25TZXZlcml0eRIlCiFOT1RJRklDQVRJT05fU0VWRVJJVFlfVU5TUEVDSUZJRUQQABIdChlOT1RJRklDQVRJT05fU0VWRVJJVFlfTE9XEAESIAocTk9USUZJQ0FUSU9OX1NFVkVSSVRZX01FRElVTRACEh4KGk5PVElGSUNBVElPTl9TRVZFUklUWV9ISUdIEAMSIgoeTk9USUZJQ0FUSU9OX1NFVkVSSVRZX0NSSVRJQ0FMEAQqzAEKFU5vdGlmaWNhdGlvbkZyZXF1ZW5jeRImCiJOT1RJRklDQVRJT05fRlJFUVVFTkNZX1VOU1BFQ0lGSUVEEAASJAogTk9USUZJQ0FUSU9OX0ZSRVFVRU5DWV9JTU1FRElBVEUQARIgChxOT1RJRklDQVRJT05fRlJFUVVFTkNZX0RBSUxZEAISIQodTk9USUZJQ0FUSU9OX0ZSRVFVRU5DWV9XRUVLTFkQAxIgChxOT1RJRklDQVRJT05fRlJFUVVFTkNZX05FVkVSEAQy6g0KE05vdGlmaWNhdGlvblNlcnZpY2USiAEKEUxpc3ROb3RpZmljYXRpb25zEjgub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkxpc3ROb3RpZmljYXRpb25zUmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5MaXN0Tm90aWZpY2F0aW9uc1Jlc3BvbnNlEoIBCg9HZXROb3RpZmljYXRpb24SNi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuR2V0Tm90aWZpY2F0aW9uUmVxdWVzdBo3Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5HZXROb3RpZmljYXRpb25SZXNwb25zZRJzCgpNYXJrQXNSZWFkEjEub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk1hcmtBc1JlYWRSZXF1ZXN0GjIub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk1hcmtBc1JlYWRSZXNwb25zZRJ8Cg1NYXJrQWxsQXNSZWFkEjQub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk1hcmtBbGxBc1JlYWRSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk1hcmtBbGxBc1JlYWRSZXNwb25zZRKLAQoSRGVsZXRlTm90aWZpY2F0aW9uEjkub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkRlbGV0ZU5vdGlmaWNhdGlvblJlcXVlc3QaOi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuRGVsZXRlTm90aWZpY2F0aW9uUmVzcG9uc2USlwEKFkRlbGV0ZUFsbE5vdGlmaWNhdGlvbnMSPS5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuRGVsZXRlQWxsTm90aWZpY2F0aW9uc1JlcXVlc3QaPi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuRGVsZXRlQWxsTm90aWZpY2F0aW9uc1Jlc3BvbnNlEn8KDkdldFVucmVhZENvdW50EjUub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkdldFVucmVhZENvdW50UmVxdWVzdBo2Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5HZXRVbnJlYWRDb3VudFJlc3BvbnNlEosBChJDcmVhdGVOb3RpZmljYXRpb24SOS5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuQ3JlYXRlTm90aWZpY2F0aW9uUmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5DcmVhdGVOb3RpZmljYXRpb25SZXNwb25zZRKvAQoeQ3JlYXRlT3JnYW5pemF0aW9uTm90aWZpY2F0aW9uEkUub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkNyZWF0ZU9yZ2FuaXphdGlvbk5vdGlmaWNhdGlvblJlcXVlc3QaRi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuQ3JlYXRlT3JnYW5pemF0aW9uTm90aWZpY2F0aW9uUmVzcG9uc2USkQEKFEdldE5vdGlmaWNhdGlvblR5cGVzEjsub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkdldE5vdGlmaWNhdGlvblR5cGVzUmVxdWVzdBo8Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5HZXROb3RpZmljYXRpb25UeXBlc1Jlc3BvbnNlEqMBChpHZXROb3RpZmljYXRpb25QcmVmZXJlbmNlcxJBLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5HZXROb3RpZmljYXRpb25QcmVmZXJlbmNlc1JlcXVlc3QaQi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXNwb25zZRKsAQodVXBkYXRlTm90aWZpY2F0aW9uUHJlZmVyZW5jZXMSRC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuVXBkYXRlTm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0GkUub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLlVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVzcG9uc2VCW1pZZ2l0aHViLmNvbS9vYmllbnRlL2Nsb3VkL2FwcHMvc2hhcmVkL3Byb3RvL29iaWVudGUvY2xvdWQvbm90aWZpY2F0aW9ucy92MTtub3RpZmljYXRpb25zdjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_obiente_cloud_common_v1_common]);
+  fileDesc("CjlvYmllbnRlL2Nsb3VkL25vdGlmaWNhdGlvbnMvdjEvbm90aWZpY2F0aW9uX3NlcnZpY2UucHJvdG8SHm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MSK+AgoYTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0EhgKC3VucmVhZF9vbmx5GAEgASgISACIAQESQwoEdHlwZRgCIAEoDjIwLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25UeXBlSAGIAQESSwoIc2V2ZXJpdHkYAyABKA4yNC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uU2V2ZXJpdHlIAogBARIMCgRwYWdlGAQgASgFEhAKCHBlcl9wYWdlGAUgASgFEhwKD2luY2x1ZGVfZGVsZXRlZBgGIAEoCEgDiAEBQg4KDF91bnJlYWRfb25seUIHCgVfdHlwZUILCglfc2V2ZXJpdHlCEgoQX2luY2x1ZGVfZGVsZXRlZCKZAQoZTGlzdE5vdGlmaWNhdGlvbnNSZXNwb25zZRJDCg1ub3RpZmljYXRpb25zGAEgAygLMiwub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvbhI3CgpwYWdpbmF0aW9uGAIgASgLMiMub2JpZW50ZS5jbG91ZC5jb21tb24udjEuUGFnaW5hdGlvbiIxChZHZXROb3RpZmljYXRpb25SZXF1ZXN0EhcKD25vdGlmaWNhdGlvbl9pZBgBIAEoCSJdChdHZXROb3RpZmljYXRpb25SZXNwb25zZRJCCgxub3RpZmljYXRpb24YASABKAsyLC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uIiwKEU1hcmtBc1JlYWRSZXF1ZXN0EhcKD25vdGlmaWNhdGlvbl9pZBgBIAEoCSJYChJNYXJrQXNSZWFkUmVzcG9uc2USQgoMbm90aWZpY2F0aW9uGAEgASgLMiwub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvbiK+AQoUTWFya0FsbEFzUmVhZFJlcXVlc3QSQwoEdHlwZRgBIAEoDjIwLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25UeXBlSACIAQESSwoIc2V2ZXJpdHkYAiABKA4yNC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uU2V2ZXJpdHlIAYgBAUIHCgVfdHlwZUILCglfc2V2ZXJpdHkiLQoVTWFya0FsbEFzUmVhZFJlc3BvbnNlEhQKDG1hcmtlZF9jb3VudBgBIAEoBSI0ChlEZWxldGVOb3RpZmljYXRpb25SZXF1ZXN0EhcKD25vdGlmaWNhdGlvbl9pZBgBIAEoCSItChpEZWxldGVOb3RpZmljYXRpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIpMBCh1EZWxldGVBbGxOb3RpZmljYXRpb25zUmVxdWVzdBIWCglyZWFkX29ubHkYASABKAhIAIgBARJDCgR0eXBlGAIgASgOMjAub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblR5cGVIAYgBAUIMCgpfcmVhZF9vbmx5QgcKBV90eXBlIjcKHkRlbGV0ZUFsbE5vdGlmaWNhdGlvbnNSZXNwb25zZRIVCg1kZWxldGVkX2NvdW50GAEgASgFIjUKGkFyY2hpdmVOb3RpZmljYXRpb25SZXF1ZXN0EhcKD25vdGlmaWNhdGlvbl9pZBgBIAEoCSJhChtBcmNoaXZlTm90aWZpY2F0aW9uUmVzcG9uc2USQgoMbm90aWZpY2F0aW9uGAEgASgLMiwub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvbiJCCiBMaXN0QXJjaGl2ZWROb3RpZmljYXRpb25zUmVxdWVzdBIMCgRwYWdlGAEgASgFEhAKCHBlcl9wYWdlGAIgASgFIqEBCiFMaXN0QXJjaGl2ZWROb3RpZmljYXRpb25zUmVzcG9uc2USQwoNbm90aWZpY2F0aW9ucxgBIAMoCzIsLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb24SNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24ixwEKFUdldFVucmVhZENvdW50UmVxdWVzdBJDCgR0eXBlGAEgASgOMjAub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblR5cGVIAIgBARJPCgxtaW5fc2V2ZXJpdHkYAiABKA4yNC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uU2V2ZXJpdHlIAYgBAUIHCgVfdHlwZUIPCg1fbWluX3NldmVyaXR5IicKFkdldFVucmVhZENvdW50UmVzcG9uc2USDQoFY291bnQYASABKAUi5gMKGUNyZWF0ZU5vdGlmaWNhdGlvblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIcCg9vcmdhbml6YXRpb25faWQYAiABKAlIAIgBARI+CgR0eXBlGAMgASgOMjAub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblR5cGUSRgoIc2V2ZXJpdHkYBCABKA4yNC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uU2V2ZXJpdHkSDQoFdGl0bGUYBSABKAkSDwoHbWVzc2FnZRgGIAEoCRIXCgphY3Rpb25fdXJsGAcgASgJSAGIAQESGQoMYWN0aW9uX2xhYmVsGAggASgJSAKIAQESWQoIbWV0YWRhdGEYCSADKAsyRy5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuQ3JlYXRlTm90aWZpY2F0aW9uUmVxdWVzdC5NZXRhZGF0YUVudHJ5Gi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUISChBfb3JnYW5pemF0aW9uX2lkQg0KC19hY3Rpb25fdXJsQg8KDV9hY3Rpb25fbGFiZWwiYAoaQ3JlYXRlTm90aWZpY2F0aW9uUmVzcG9uc2USQgoMbm90aWZpY2F0aW9uGAEgASgLMiwub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvbiLjAwolQ3JlYXRlT3JnYW5pemF0aW9uTm90aWZpY2F0aW9uUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSPgoEdHlwZRgCIAEoDjIwLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25UeXBlEkYKCHNldmVyaXR5GAMgASgOMjQub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblNldmVyaXR5Eg0KBXRpdGxlGAQgASgJEg8KB21lc3NhZ2UYBSABKAkSFwoKYWN0aW9uX3VybBgGIAEoCUgAiAEBEhkKDGFjdGlvbl9sYWJlbBgHIAEoCUgBiAEBEmUKCG1ldGFkYXRhGAggAygLMlMub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkNyZWF0ZU9yZ2FuaXphdGlvbk5vdGlmaWNhdGlvblJlcXVlc3QuTWV0YWRhdGFFbnRyeRINCgVyb2xlcxgJIAMoCRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCDQoLX2FjdGlvbl91cmxCDwoNX2FjdGlvbl9sYWJlbCKEAQomQ3JlYXRlT3JnYW5pemF0aW9uTm90aWZpY2F0aW9uUmVzcG9uc2USFQoNY3JlYXRlZF9jb3VudBgBIAEoBRJDCg1ub3RpZmljYXRpb25zGAIgAygLMiwub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvbiKjBgoMTm90aWZpY2F0aW9uEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSHAoPb3JnYW5pemF0aW9uX2lkGAMgASgJSACIAQESPgoEdHlwZRgEIAEoDjIwLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25UeXBlEkYKCHNldmVyaXR5GAUgASgOMjQub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblNldmVyaXR5Eg0KBXRpdGxlGAYgASgJEg8KB21lc3NhZ2UYByABKAkSDAoEcmVhZBgIIAEoCBIwCgdyZWFkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEhcKCmFjdGlvbl91cmwYCiABKAlIAogBARIZCgxhY3Rpb25fbGFiZWwYCyABKAlIA4gBARJMCghtZXRhZGF0YRgMIAMoCzI6Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb24uTWV0YWRhdGFFbnRyeRITCgtjbGllbnRfb25seRgNIAEoCBIuCgpjcmVhdGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GA8gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI0CgthcmNoaXZlZF9hdBgQIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIBIgBARIzCgpkZWxldGVkX2F0GBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgFiAEBGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUISChBfb3JnYW5pemF0aW9uX2lkQgoKCF9yZWFkX2F0Qg0KC19hY3Rpb25fdXJsQg8KDV9hY3Rpb25fbGFiZWxCDgoMX2FyY2hpdmVkX2F0Qg0KC19kZWxldGVkX2F0Ih0KG0dldE5vdGlmaWNhdGlvblR5cGVzUmVxdWVzdCJjChxHZXROb3RpZmljYXRpb25UeXBlc1Jlc3BvbnNlEkMKBXR5cGVzGAEgAygLMjQub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblR5cGVJbmZvIowCChROb3RpZmljYXRpb25UeXBlSW5mbxI+CgR0eXBlGAEgASgOMjAub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblR5cGUSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIdChVkZWZhdWx0X2VtYWlsX2VuYWJsZWQYBCABKAgSHgoWZGVmYXVsdF9pbl9hcHBfZW5hYmxlZBgFIAEoCBJSChRkZWZhdWx0X21pbl9zZXZlcml0eRgGIAEoDjI0Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25TZXZlcml0eSIjCiFHZXROb3RpZmljYXRpb25QcmVmZXJlbmNlc1JlcXVlc3QipQEKIkdldE5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVzcG9uc2USSwoLcHJlZmVyZW5jZXMYASADKAsyNi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uUHJlZmVyZW5jZRIdChBzbXNfcGhvbmVfbnVtYmVyGAIgASgJSACIAQFCEwoRX3Ntc19waG9uZV9udW1iZXIipwEKJFVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVxdWVzdBJLCgtwcmVmZXJlbmNlcxgBIAMoCzI2Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25QcmVmZXJlbmNlEh0KEHNtc19waG9uZV9udW1iZXIYAiABKAlIAIgBAUITChFfc21zX3Bob25lX251bWJlciKoAQolVXBkYXRlTm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXNwb25zZRJLCgtwcmVmZXJlbmNlcxgBIAMoCzI2Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25QcmVmZXJlbmNlEh0KEHNtc19waG9uZV9udW1iZXIYAiABKAlIAIgBAUITChFfc21zX3Bob25lX251bWJlciK/AgoWTm90aWZpY2F0aW9uUHJlZmVyZW5jZRJLChFub3RpZmljYXRpb25fdHlwZRgBIAEoDjIwLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb25UeXBlEhUKDWVtYWlsX2VuYWJsZWQYAiABKAgSFgoOaW5fYXBwX2VuYWJsZWQYAyABKAgSSAoJZnJlcXVlbmN5GAQgASgOMjUub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvbkZyZXF1ZW5jeRJKCgxtaW5fc2V2ZXJpdHkYBSABKA4yNC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uU2V2ZXJpdHkSEwoLc21zX2VuYWJsZWQYBiABKAgiPQoiR2V0Tm90aWZpY2F0aW9uRGlnZXN0UG9saWN5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkibwojR2V0Tm90aWZpY2F0aW9uRGlnZXN0UG9saWN5UmVzcG9uc2USSAoGcG9saWN5GAEgASgLMjgub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvbkRpZ2VzdFBvbGljeSKcAQoiU2V0Tm90aWZpY2F0aW9uRGlnZXN0UG9saWN5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDwoHZW5hYmxlZBgCIAEoCBJMChJub3RpZmljYXRpb25fdHlwZXMYAyADKA4yMC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uVHlwZSJvCiNTZXROb3RpZmljYXRpb25EaWdlc3RQb2xpY3lSZXNwb25zZRJICgZwb2xpY3kYASABKAsyOC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uRGlnZXN0UG9saWN5Iu4BChhOb3RpZmljYXRpb25EaWdlc3RQb2xpY3kSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg8KB2VuYWJsZWQYAiABKAgSTAoSbm90aWZpY2F0aW9uX3R5cGVzGAMgAygOMjAub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblR5cGUSFgoOd2luZG93X21pbnV0ZXMYBCABKAUSMwoKdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBAUINCgtfdXBkYXRlZF9hdCLlAQoaQ3JlYXRlV2ViaG9va0NvbmZpZ1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEkgKDGNoYW5uZWxfdHlwZRgCIAEoDjIyLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5XZWJob29rQ2hhbm5lbFR5cGUSEwoLd2ViaG9va191cmwYAyABKAkSTwoRbm90aWZ5X3NldmVyaXRpZXMYBCADKA4yNC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uU2V2ZXJpdHkiXAobQ3JlYXRlV2ViaG9va0NvbmZpZ1Jlc3BvbnNlEj0KBmNvbmZpZxgBIAEoCzItLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5XZWJob29rQ29uZmlnIkgKGkRlbGV0ZVdlYmhvb2tDb25maWdSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIRCgljb25maWdfaWQYAiABKAkiLgobRGVsZXRlV2ViaG9va0NvbmZpZ1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiNAoZTGlzdFdlYmhvb2tDb25maWdzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiXAoaTGlzdFdlYmhvb2tDb25maWdzUmVzcG9uc2USPgoHY29uZmlncxgBIAMoCzItLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5XZWJob29rQ29uZmlnIpQCCg1XZWJob29rQ29uZmlnEgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRJICgxjaGFubmVsX3R5cGUYAyABKA4yMi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuV2ViaG9va0NoYW5uZWxUeXBlEhMKC3dlYmhvb2tfdXJsGAQgASgJEk8KEW5vdGlmeV9zZXZlcml0aWVzGAUgAygOMjQub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvblNldmVyaXR5Ei4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wKsYCChBOb3RpZmljYXRpb25UeXBlEiEKHU5PVElGSUNBVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASGgoWTk9USUZJQ0FUSU9OX1RZUEVfSU5GTxABEh0KGU5PVElGSUNBVElPTl9UWVBFX1NVQ0NFU1MQAhIdChlOT1RJRklDQVRJT05fVFlQRV9XQVJOSU5HEAMSGwoXTk9USUZJQ0FUSU9OX1RZUEVfRVJST1IQBBIgChxOT1RJRklDQVRJT05fVFlQRV9ERVBMT1lNRU5UEAUSHQoZTk9USUZJQ0FUSU9OX1RZUEVfQklMTElORxAGEhsKF05PVElGSUNBVElPTl9UWVBFX1FVT1RBEAcSHAoYTk9USUZJQ0FUSU9OX1RZUEVfSU5WSVRFEAgSHAoYTk9USUZJQ0FUSU9OX1RZUEVfU1lTVEVNEAkqwgEKFE5vdGlmaWNhdGlvblNldmVyaXR5EiUKIU5PVElGSUNBVElPTl9TRVZFUklUWV9VTlNQRUNJRklFRBAAEh0KGU5PVElGSUNBVElPTl9TRVZFUklUWV9MT1cQARIgChxOT1RJRklDQVRJT05fU0VWRVJJVFlfTUVESVVNEAISHgoaTk9USUZJQ0FUSU9OX1NFVkVSSVRZX0hJR0gQAxIiCh5OT1RJRklDQVRJT05fU0VWRVJJVFlfQ1JJVElDQUwQBCrMAQoVTm90aWZpY2F0aW9uRnJlcXVlbmN5EiYKIk5PVElGSUNBVElPTl9GUkVRVUVOQ1lfVU5TUEVDSUZJRUQQABIkCiBOT1RJRklDQVRJT05fRlJFUVVFTkNZX0lNTUVESUFURRABEiAKHE5PVElGSUNBVElPTl9GUkVRVUVOQ1lfREFJTFkQAhIhCh1OT1RJRklDQVRJT05fRlJFUVVFTkNZX1dFRUtMWRADEiAKHE5PVElGSUNBVElPTl9GUkVRVUVOQ1lfTkVWRVIQBCp8ChJXZWJob29rQ2hhbm5lbFR5cGUSJAogV0VCSE9PS19DSEFOTkVMX1RZUEVfVU5TUEVDSUZJRUQQABIeChpXRUJIT09LX0NIQU5ORUxfVFlQRV9TTEFDSxABEiAKHFdFQkhPT0tfQ0hBTk5FTF9UWVBFX0RJU0NPUkQQAjKgFgoTTm90aWZpY2F0aW9uU2VydmljZRKIAQoRTGlzdE5vdGlmaWNhdGlvbnMSOC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0Gjkub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkxpc3ROb3RpZmljYXRpb25zUmVzcG9uc2USggEKD0dldE5vdGlmaWNhdGlvbhI2Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5HZXROb3RpZmljYXRpb25SZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkdldE5vdGlmaWNhdGlvblJlc3BvbnNlEnMKCk1hcmtBc1JlYWQSMS5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTWFya0FzUmVhZFJlcXVlc3QaMi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTWFya0FzUmVhZFJlc3BvbnNlEnwKDU1hcmtBbGxBc1JlYWQSNC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTWFya0FsbEFzUmVhZFJlcXVlc3QaNS5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTWFya0FsbEFzUmVhZFJlc3BvbnNlEosBChJEZWxldGVOb3RpZmljYXRpb24SOS5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuRGVsZXRlTm90aWZpY2F0aW9uUmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5EZWxldGVOb3RpZmljYXRpb25SZXNwb25zZRKXAQoWRGVsZXRlQWxsTm90aWZpY2F0aW9ucxI9Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5EZWxldGVBbGxOb3RpZmljYXRpb25zUmVxdWVzdBo+Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5EZWxldGVBbGxOb3RpZmljYXRpb25zUmVzcG9uc2USjgEKE0FyY2hpdmVOb3RpZmljYXRpb24SOi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuQXJjaGl2ZU5vdGlmaWNhdGlvblJlcXVlc3QaOy5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuQXJjaGl2ZU5vdGlmaWNhdGlvblJlc3BvbnNlEqABChlMaXN0QXJjaGl2ZWROb3RpZmljYXRpb25zEkAub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkxpc3RBcmNoaXZlZE5vdGlmaWNhdGlvbnNSZXF1ZXN0GkEub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkxpc3RBcmNoaXZlZE5vdGlmaWNhdGlvbnNSZXNwb25zZRJ/Cg5HZXRVbnJlYWRDb3VudBI1Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5HZXRVbnJlYWRDb3VudFJlcXVlc3QaNi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuR2V0VW5yZWFkQ291bnRSZXNwb25zZRKLAQoSQ3JlYXRlTm90aWZpY2F0aW9uEjkub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkNyZWF0ZU5vdGlmaWNhdGlvblJlcXVlc3QaOi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuQ3JlYXRlTm90aWZpY2F0aW9uUmVzcG9uc2USrwEKHkNyZWF0ZU9yZ2FuaXphdGlvbk5vdGlmaWNhdGlvbhJFLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5DcmVhdGVPcmdhbml6YXRpb25Ob3RpZmljYXRpb25SZXF1ZXN0GkYub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkNyZWF0ZU9yZ2FuaXphdGlvbk5vdGlmaWNhdGlvblJlc3BvbnNlEpEBChRHZXROb3RpZmljYXRpb25UeXBlcxI7Lm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5HZXROb3RpZmljYXRpb25UeXBlc1JlcXVlc3QaPC5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuR2V0Tm90aWZpY2F0aW9uVHlwZXNSZXNwb25zZRKjAQoaR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXMSQS5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0GkIub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkdldE5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVzcG9uc2USrAEKHVVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzEkQub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLlVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVxdWVzdBpFLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5VcGRhdGVOb3RpZmljYXRpb25QcmVmZXJlbmNlc1Jlc3BvbnNlEqYBChtHZXROb3RpZmljYXRpb25EaWdlc3RQb2xpY3kSQi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuR2V0Tm90aWZpY2F0aW9uRGlnZXN0UG9saWN5UmVxdWVzdBpDLm9iaWVudGUuY2xvdWQubm90aWZpY2F0aW9ucy52MS5HZXROb3RpZmljYXRpb25EaWdlc3RQb2xpY3lSZXNwb25zZRKmAQobU2V0Tm90aWZpY2F0aW9uRGlnZXN0UG9saWN5EkIub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLlNldE5vdGlmaWNhdGlvbkRpZ2VzdFBvbGljeVJlcXVlc3QaQy5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuU2V0Tm90aWZpY2F0aW9uRGlnZXN0UG9saWN5UmVzcG9uc2USjgEKE0NyZWF0ZVdlYmhvb2tDb25maWcSOi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuQ3JlYXRlV2ViaG9va0NvbmZpZ1JlcXVlc3QaOy5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuQ3JlYXRlV2ViaG9va0NvbmZpZ1Jlc3BvbnNlEo4BChNEZWxldGVXZWJob29rQ29uZmlnEjoub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkRlbGV0ZVdlYmhvb2tDb25maWdSZXF1ZXN0Gjsub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkRlbGV0ZVdlYmhvb2tDb25maWdSZXNwb25zZRKLAQoSTGlzdFdlYmhvb2tDb25maWdzEjkub2JpZW50ZS5jbG91ZC5ub3RpZmljYXRpb25zLnYxLkxpc3RXZWJob29rQ29uZmlnc1JlcXVlc3QaOi5vYmllbnRlLmNsb3VkLm5vdGlmaWNhdGlvbnMudjEuTGlzdFdlYmhvb2tDb25maWdzUmVzcG9uc2VCW1pZZ2l0aHViLmNvbS9vYmllbnRlL2Nsb3VkL2FwcHMvc2hhcmVkL3Byb3RvL29iaWVudGUvY2xvdWQvbm90aWZpY2F0aW9ucy92MTtub3RpZmljYXRpb25zdjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_obiente_cloud_common_v1_common]);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.ListNotificationsRequest
@@ -48,6 +48,13 @@ export type ListNotificationsRequest = Message<"obiente.cloud.notifications.v1.L
    * @generated from field: int32 per_page = 5;
    */
   perPage: number;
+
+  /**
+   * Also list deleted notifications that are not purged yet
+   *
+   * @generated from field: optional bool include_deleted = 6;
+   */
+  includeDeleted?: boolean;
 };
 
 /**
@@ -263,6 +270,86 @@ export type DeleteAllNotificationsResponse = Message<"obiente.cloud.notification
 export const DeleteAllNotificationsResponseSchema: GenMessage<DeleteAllNotificationsResponse> = /*@__PURE__*/
   messageDesc(file_obiente_cloud_notifications_v1_notification_service, 11);
 
+/**
+ * @generated from message obiente.cloud.notifications.v1.ArchiveNotificationRequest
+ */
+export type ArchiveNotificationRequest = Message<"obiente.cloud.notifications.v1.ArchiveNotificationRequest"> & {
+  /**
+   * @generated from field: string notification_id = 1;
+   */
+  notificationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.ArchiveNotificationRequest.
+ * Use `create(ArchiveNotificationRequestSchema)` to create a new message.
+ */
+export const ArchiveNotificationRequestSchema: GenMessage<ArchiveNotificationRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 12);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.ArchiveNotificationResponse
+ */
+export type ArchiveNotificationResponse = Message<"obiente.cloud.notifications.v1.ArchiveNotificationResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.notifications.v1.Notification notification = 1;
+   */
+  notification?: Notification;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.ArchiveNotificationResponse.
+ * Use `create(ArchiveNotificationResponseSchema)` to create a new message.
+ */
+export const ArchiveNotificationResponseSchema: GenMessage<ArchiveNotificationResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 13);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.ListArchivedNotificationsRequest
+ */
+export type ListArchivedNotificationsRequest = Message<"obiente.cloud.notifications.v1.ListArchivedNotificationsRequest"> & {
+  /**
+   * Pagination
+   *
+   * @generated from field: int32 page = 1;
+   */
+  page: number;
+
+  /**
+   * @generated from field: int32 per_page = 2;
+   */
+  perPage: number;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.ListArchivedNotificationsRequest.
+ * Use `create(ListArchivedNotificationsRequestSchema)` to create a new message.
+ */
+export const ListArchivedNotificationsRequestSchema: GenMessage<ListArchivedNotificationsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 14);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.ListArchivedNotificationsResponse
+ */
+export type ListArchivedNotificationsResponse = Message<"obiente.cloud.notifications.v1.ListArchivedNotificationsResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.notifications.v1.Notification notifications = 1;
+   */
+  notifications: Notification[];
+
+  /**
+   * @generated from field: obiente.cloud.common.v1.Pagination pagination = 2;
+   */
+  pagination?: Pagination;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.ListArchivedNotificationsResponse.
+ * Use `create(ListArchivedNotificationsResponseSchema)` to create a new message.
+ */
+export const ListArchivedNotificationsResponseSchema: GenMessage<ListArchivedNotificationsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 15);
+
 /**
  * @generated from message obiente.cloud.notifications.v1.GetUnreadCountRequest
  */
@@ -285,7 +372,7 @@ export type GetUnreadCountRequest = Message<"obiente.cloud.notifications.v1.GetU
  * Use `create(GetUnreadCountRequestSchema)` to create a new message.
  */
 export const GetUnreadCountRequestSchema: GenMessage<GetUnreadCountRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 12);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 16);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.GetUnreadCountResponse
@@ -302,7 +389,7 @@ export type GetUnreadCountResponse = Message<"obiente.cloud.notifications.v1.Get
  * Use `create(GetUnreadCountResponseSchema)` to create a new message.
  */
 export const GetUnreadCountResponseSchema: GenMessage<GetUnreadCountResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 13);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 17);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.CreateNotificationRequest
@@ -359,7 +446,7 @@ export type CreateNotificationRequest = Message<"obiente.cloud.notifications.v1.
  * Use `create(CreateNotificationRequestSchema)` to create a new message.
  */
 export const CreateNotificationRequestSchema: GenMessage<CreateNotificationRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 14);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 18);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.CreateNotificationResponse
@@ -376,7 +463,7 @@ export type CreateNotificationResponse = Message<"obiente.cloud.notifications.v1
  * Use `create(CreateNotificationResponseSchema)` to create a new message.
  */
 export const CreateNotificationResponseSchema: GenMessage<CreateNotificationResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 15);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 19);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.CreateOrganizationNotificationRequest
@@ -435,7 +522,7 @@ export type CreateOrganizationNotificationRequest = Message<"obiente.cloud.notif
  * Use `create(CreateOrganizationNotificationRequestSchema)` to create a new message.
  */
 export const CreateOrganizationNotificationRequestSchema: GenMessage<CreateOrganizationNotificationRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 16);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 20);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.CreateOrganizationNotificationResponse
@@ -457,7 +544,7 @@ export type CreateOrganizationNotificationResponse = Message<"obiente.cloud.noti
  * Use `create(CreateOrganizationNotificationResponseSchema)` to create a new message.
  */
 export const CreateOrganizationNotificationResponseSchema: GenMessage<CreateOrganizationNotificationResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 17);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 21);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.Notification
@@ -537,6 +624,16 @@ export type Notification = Message<"obiente.cloud.notifications.v1.Notification"
    * @generated from field: google.protobuf.Timestamp updated_at = 15;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp archived_at = 16;
+   */
+  archivedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp deleted_at = 17;
+   */
+  deletedAt?: Timestamp;
 };
 
 /**
@@ -544,7 +641,7 @@ export type Notification = Message<"obiente.cloud.notifications.v1.Notification"
  * Use `create(NotificationSchema)` to create a new message.
  */
 export const NotificationSchema: GenMessage<Notification> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 18);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 22);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.GetNotificationTypesRequest
@@ -557,7 +654,7 @@ export type GetNotificationTypesRequest = Message<"obiente.cloud.notifications.v
  * Use `create(GetNotificationTypesRequestSchema)` to create a new message.
  */
 export const GetNotificationTypesRequestSchema: GenMessage<GetNotificationTypesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 19);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 23);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.GetNotificationTypesResponse
@@ -574,7 +671,7 @@ export type GetNotificationTypesResponse = Message<"obiente.cloud.notifications.
  * Use `create(GetNotificationTypesResponseSchema)` to create a new message.
  */
 export const GetNotificationTypesResponseSchema: GenMessage<GetNotificationTypesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 20);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 24);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.NotificationTypeInfo
@@ -616,7 +713,7 @@ export type NotificationTypeInfo = Message<"obiente.cloud.notifications.v1.Notif
  * Use `create(NotificationTypeInfoSchema)` to create a new message.
  */
 export const NotificationTypeInfoSchema: GenMessage<NotificationTypeInfo> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 21);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 25);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.GetNotificationPreferencesRequest
@@ -629,7 +726,7 @@ export type GetNotificationPreferencesRequest = Message<"obiente.cloud.notificat
  * Use `create(GetNotificationPreferencesRequestSchema)` to create a new message.
  */
 export const GetNotificationPreferencesRequestSchema: GenMessage<GetNotificationPreferencesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 22);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 26);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.GetNotificationPreferencesResponse
@@ -639,6 +736,13 @@ export type GetNotificationPreferencesResponse = Message<"obiente.cloud.notifica
    * @generated from field: repeated obiente.cloud.notifications.v1.NotificationPreference preferences = 1;
    */
   preferences: NotificationPreference[];
+
+  /**
+   * Number SMS alerts are sent to, if set
+   *
+   * @generated from field: optional string sms_phone_number = 2;
+   */
+  smsPhoneNumber?: string;
 };
 
 /**
@@ -646,7 +750,7 @@ export type GetNotificationPreferencesResponse = Message<"obiente.cloud.notifica
  * Use `create(GetNotificationPreferencesResponseSchema)` to create a new message.
  */
 export const GetNotificationPreferencesResponseSchema: GenMessage<GetNotificationPreferencesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 23);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 27);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.UpdateNotificationPreferencesRequest
@@ -656,6 +760,13 @@ export type UpdateNotificationPreferencesRequest = Message<"obiente.cloud.notifi
    * @generated from field: repeated obiente.cloud.notifications.v1.NotificationPreference preferences = 1;
    */
   preferences: NotificationPreference[];
+
+  /**
+   * E.164 number for SMS alerts; an empty string removes it
+   *
+   * @generated from field: optional string sms_phone_number = 2;
+   */
+  smsPhoneNumber?: string;
 };
 
 /**
@@ -663,7 +774,7 @@ export type UpdateNotificationPreferencesRequest = Message<"obiente.cloud.notifi
  * Use `create(UpdateNotificationPreferencesRequestSchema)` to create a new message.
  */
 export const UpdateNotificationPreferencesRequestSchema: GenMessage<UpdateNotificationPreferencesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 24);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 28);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.UpdateNotificationPreferencesResponse
@@ -673,6 +784,11 @@ export type UpdateNotificationPreferencesResponse = Message<"obiente.cloud.notif
    * @generated from field: repeated obiente.cloud.notifications.v1.NotificationPreference preferences = 1;
    */
   preferences: NotificationPreference[];
+
+  /**
+   * @generated from field: optional string sms_phone_number = 2;
+   */
+  smsPhoneNumber?: string;
 };
 
 /**
@@ -680,7 +796,7 @@ export type UpdateNotificationPreferencesResponse = Message<"obiente.cloud.notif
  * Use `create(UpdateNotificationPreferencesResponseSchema)` to create a new message.
  */
 export const UpdateNotificationPreferencesResponseSchema: GenMessage<UpdateNotificationPreferencesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 25);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 29);
 
 /**
  * @generated from message obiente.cloud.notifications.v1.NotificationPreference
@@ -710,6 +826,13 @@ export type NotificationPreference = Message<"obiente.cloud.notifications.v1.Not
    * @generated from field: obiente.cloud.notifications.v1.NotificationSeverity min_severity = 5;
    */
   minSeverity: NotificationSeverity;
+
+  /**
+   * Only CRITICAL notifications are sent by SMS
+   *
+   * @generated from field: bool sms_enabled = 6;
+   */
+  smsEnabled: boolean;
 };
 
 /**
@@ -717,7 +840,305 @@ export type NotificationPreference = Message<"obiente.cloud.notifications.v1.Not
  * Use `create(NotificationPreferenceSchema)` to create a new message.
  */
 export const NotificationPreferenceSchema: GenMessage<NotificationPreference> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 26);
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 30);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.GetNotificationDigestPolicyRequest
+ */
+export type GetNotificationDigestPolicyRequest = Message<"obiente.cloud.notifications.v1.GetNotificationDigestPolicyRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.GetNotificationDigestPolicyRequest.
+ * Use `create(GetNotificationDigestPolicyRequestSchema)` to create a new message.
+ */
+export const GetNotificationDigestPolicyRequestSchema: GenMessage<GetNotificationDigestPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 31);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.GetNotificationDigestPolicyResponse
+ */
+export type GetNotificationDigestPolicyResponse = Message<"obiente.cloud.notifications.v1.GetNotificationDigestPolicyResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.notifications.v1.NotificationDigestPolicy policy = 1;
+   */
+  policy?: NotificationDigestPolicy;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.GetNotificationDigestPolicyResponse.
+ * Use `create(GetNotificationDigestPolicyResponseSchema)` to create a new message.
+ */
+export const GetNotificationDigestPolicyResponseSchema: GenMessage<GetNotificationDigestPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 32);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.SetNotificationDigestPolicyRequest
+ */
+export type SetNotificationDigestPolicyRequest = Message<"obiente.cloud.notifications.v1.SetNotificationDigestPolicyRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * Types to group; empty groups every type
+   *
+   * @generated from field: repeated obiente.cloud.notifications.v1.NotificationType notification_types = 3;
+   */
+  notificationTypes: NotificationType[];
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.SetNotificationDigestPolicyRequest.
+ * Use `create(SetNotificationDigestPolicyRequestSchema)` to create a new message.
+ */
+export const SetNotificationDigestPolicyRequestSchema: GenMessage<SetNotificationDigestPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 33);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.SetNotificationDigestPolicyResponse
+ */
+export type SetNotificationDigestPolicyResponse = Message<"obiente.cloud.notifications.v1.SetNotificationDigestPolicyResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.notifications.v1.NotificationDigestPolicy policy = 1;
+   */
+  policy?: NotificationDigestPolicy;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.SetNotificationDigestPolicyResponse.
+ * Use `create(SetNotificationDigestPolicyResponseSchema)` to create a new message.
+ */
+export const SetNotificationDigestPolicyResponseSchema: GenMessage<SetNotificationDigestPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 34);
+
+/**
+ * Groups an organization's notifications of the same type into one digest per window.
+ * CRITICAL notifications are always delivered immediately.
+ *
+ * @generated from message obiente.cloud.notifications.v1.NotificationDigestPolicy
+ */
+export type NotificationDigestPolicy = Message<"obiente.cloud.notifications.v1.NotificationDigestPolicy"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * Types to group; empty groups every type
+   *
+   * @generated from field: repeated obiente.cloud.notifications.v1.NotificationType notification_types = 3;
+   */
+  notificationTypes: NotificationType[];
+
+  /**
+   * Length of the grouping window
+   *
+   * @generated from field: int32 window_minutes = 4;
+   */
+  windowMinutes: number;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.NotificationDigestPolicy.
+ * Use `create(NotificationDigestPolicySchema)` to create a new message.
+ */
+export const NotificationDigestPolicySchema: GenMessage<NotificationDigestPolicy> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 35);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.CreateWebhookConfigRequest
+ */
+export type CreateWebhookConfigRequest = Message<"obiente.cloud.notifications.v1.CreateWebhookConfigRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: obiente.cloud.notifications.v1.WebhookChannelType channel_type = 2;
+   */
+  channelType: WebhookChannelType;
+
+  /**
+   * Slack incoming webhook or Discord channel webhook URL
+   *
+   * @generated from field: string webhook_url = 3;
+   */
+  webhookUrl: string;
+
+  /**
+   * Severities to deliver; empty delivers every severity
+   *
+   * @generated from field: repeated obiente.cloud.notifications.v1.NotificationSeverity notify_severities = 4;
+   */
+  notifySeverities: NotificationSeverity[];
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.CreateWebhookConfigRequest.
+ * Use `create(CreateWebhookConfigRequestSchema)` to create a new message.
+ */
+export const CreateWebhookConfigRequestSchema: GenMessage<CreateWebhookConfigRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 36);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.CreateWebhookConfigResponse
+ */
+export type CreateWebhookConfigResponse = Message<"obiente.cloud.notifications.v1.CreateWebhookConfigResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.notifications.v1.WebhookConfig config = 1;
+   */
+  config?: WebhookConfig;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.CreateWebhookConfigResponse.
+ * Use `create(CreateWebhookConfigResponseSchema)` to create a new message.
+ */
+export const CreateWebhookConfigResponseSchema: GenMessage<CreateWebhookConfigResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 37);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.DeleteWebhookConfigRequest
+ */
+export type DeleteWebhookConfigRequest = Message<"obiente.cloud.notifications.v1.DeleteWebhookConfigRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string config_id = 2;
+   */
+  configId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.DeleteWebhookConfigRequest.
+ * Use `create(DeleteWebhookConfigRequestSchema)` to create a new message.
+ */
+export const DeleteWebhookConfigRequestSchema: GenMessage<DeleteWebhookConfigRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 38);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.DeleteWebhookConfigResponse
+ */
+export type DeleteWebhookConfigResponse = Message<"obiente.cloud.notifications.v1.DeleteWebhookConfigResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.DeleteWebhookConfigResponse.
+ * Use `create(DeleteWebhookConfigResponseSchema)` to create a new message.
+ */
+export const DeleteWebhookConfigResponseSchema: GenMessage<DeleteWebhookConfigResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 39);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.ListWebhookConfigsRequest
+ */
+export type ListWebhookConfigsRequest = Message<"obiente.cloud.notifications.v1.ListWebhookConfigsRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.ListWebhookConfigsRequest.
+ * Use `create(ListWebhookConfigsRequestSchema)` to create a new message.
+ */
+export const ListWebhookConfigsRequestSchema: GenMessage<ListWebhookConfigsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 40);
+
+/**
+ * @generated from message obiente.cloud.notifications.v1.ListWebhookConfigsResponse
+ */
+export type ListWebhookConfigsResponse = Message<"obiente.cloud.notifications.v1.ListWebhookConfigsResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.notifications.v1.WebhookConfig configs = 1;
+   */
+  configs: WebhookConfig[];
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.ListWebhookConfigsResponse.
+ * Use `create(ListWebhookConfigsResponseSchema)` to create a new message.
+ */
+export const ListWebhookConfigsResponseSchema: GenMessage<ListWebhookConfigsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 41);
+
+/**
+ * Posts an organization's notifications to a Slack or Discord webhook
+ *
+ * @generated from message obiente.cloud.notifications.v1.WebhookConfig
+ */
+export type WebhookConfig = Message<"obiente.cloud.notifications.v1.WebhookConfig"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: obiente.cloud.notifications.v1.WebhookChannelType channel_type = 3;
+   */
+  channelType: WebhookChannelType;
+
+  /**
+   * Redacted; only the host and the last characters are shown
+   *
+   * @generated from field: string webhook_url = 4;
+   */
+  webhookUrl: string;
+
+  /**
+   * Severities to deliver; empty delivers every severity
+   *
+   * @generated from field: repeated obiente.cloud.notifications.v1.NotificationSeverity notify_severities = 5;
+   */
+  notifySeverities: NotificationSeverity[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.notifications.v1.WebhookConfig.
+ * Use `create(WebhookConfigSchema)` to create a new message.
+ */
+export const WebhookConfigSchema: GenMessage<WebhookConfig> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_notifications_v1_notification_service, 42);
 
 /**
  * @generated from enum obiente.cloud.notifications.v1.NotificationType
@@ -852,6 +1273,32 @@ export enum NotificationFrequency {
 export const NotificationFrequencySchema: GenEnum<NotificationFrequency> = /*@__PURE__*/
   enumDesc(file_obiente_cloud_notifications_v1_notification_service, 2);
 
+/**
+ * @generated from enum obiente.cloud.notifications.v1.WebhookChannelType
+ */
+export enum WebhookChannelType {
+  /**
+   * @generated from enum value: WEBHOOK_CHANNEL_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WEBHOOK_CHANNEL_TYPE_SLACK = 1;
+   */
+  SLACK = 1,
+
+  /**
+   * @generated from enum value: WEBHOOK_CHANNEL_TYPE_DISCORD = 2;
+   */
+  DISCORD = 2,
+}
+
+/**
+ * Describes the enum obiente.cloud.notifications.v1.WebhookChannelType.
+ */
+export const WebhookChannelTypeSchema: GenEnum<WebhookChannelType> = /*@__PURE__*/
+  enumDesc(file_obiente_cloud_notifications_v1_notification_service, 3);
+
 /**
  * @generated from service obiente.cloud.notifications.v1.NotificationService
  */
@@ -897,7 +1344,7 @@ export const NotificationService: GenService<{
     output: typeof MarkAllAsReadResponseSchema;
   },
   /**
-   * Delete a notification
+   * Delete a notification (soft delete; purged after NOTIFICATION_HARD_DELETE_DAYS)
    *
    * @generated from rpc obiente.cloud.notifications.v1.NotificationService.DeleteNotification
    */
@@ -907,7 +1354,7 @@ export const NotificationService: GenService<{
     output: typeof DeleteNotificationResponseSchema;
   },
   /**
-   * Delete all notifications
+   * Delete all notifications (soft delete; purged after NOTIFICATION_HARD_DELETE_DAYS)
    *
    * @generated from rpc obiente.cloud.notifications.v1.NotificationService.DeleteAllNotifications
    */
@@ -916,6 +1363,26 @@ export const NotificationService: GenService<{
     input: typeof DeleteAllNotificationsRequestSchema;
     output: typeof DeleteAllNotificationsResponseSchema;
   },
+  /**
+   * Archive a notification, removing it from the inbox
+   *
+   * @generated from rpc obiente.cloud.notifications.v1.NotificationService.ArchiveNotification
+   */
+  archiveNotification: {
+    methodKind: "unary";
+    input: typeof ArchiveNotificationRequestSchema;
+    output: typeof ArchiveNotificationResponseSchema;
+  },
+  /**
+   * List archived notifications
+   *
+   * @generated from rpc obiente.cloud.notifications.v1.NotificationService.ListArchivedNotifications
+   */
+  listArchivedNotifications: {
+    methodKind: "unary";
+    input: typeof ListArchivedNotificationsRequestSchema;
+    output: typeof ListArchivedNotificationsResponseSchema;
+  },
   /**
    * Get unread count
    *
@@ -976,6 +1443,56 @@ export const NotificationService: GenService<{
     input: typeof UpdateNotificationPreferencesRequestSchema;
     output: typeof UpdateNotificationPreferencesResponseSchema;
   },
+  /**
+   * Get an organization's notification digest policy
+   *
+   * @generated from rpc obiente.cloud.notifications.v1.NotificationService.GetNotificationDigestPolicy
+   */
+  getNotificationDigestPolicy: {
+    methodKind: "unary";
+    input: typeof GetNotificationDigestPolicyRequestSchema;
+    output: typeof GetNotificationDigestPolicyResponseSchema;
+  },
+  /**
+   * Set an organization's notification digest policy
+   *
+   * @generated from rpc obiente.cloud.notifications.v1.NotificationService.SetNotificationDigestPolicy
+   */
+  setNotificationDigestPolicy: {
+    methodKind: "unary";
+    input: typeof SetNotificationDigestPolicyRequestSchema;
+    output: typeof SetNotificationDigestPolicyResponseSchema;
+  },
+  /**
+   * Add a Slack or Discord webhook that receives an organization's notifications
+   *
+   * @generated from rpc obiente.cloud.notifications.v1.NotificationService.CreateWebhookConfig
+   */
+  createWebhookConfig: {
+    methodKind: "unary";
+    input: typeof CreateWebhookConfigRequestSchema;
+    output: typeof CreateWebhookConfigResponseSchema;
+  },
+  /**
+   * Remove a notification webhook from an organization
+   *
+   * @generated from rpc obiente.cloud.notifications.v1.NotificationService.DeleteWebhookConfig
+   */
+  deleteWebhookConfig: {
+    methodKind: "unary";
+    input: typeof DeleteWebhookConfigRequestSchema;
+    output: typeof DeleteWebhookConfigResponseSchema;
+  },
+  /**
+   * List an organization's notification webhooks
+   *
+   * @generated from rpc obiente.cloud.notifications.v1.NotificationService.ListWebhookConfigs
+   */
+  listWebhookConfigs: {
+    methodKind: "unary";
+    input: typeof ListWebhookConfigsRequestSchema;
+    output: typeof ListWebhookConfigsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_obiente_cloud_notifications_v1_notification_service, 0);
 
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file obiente/cloud/organizations/v1/organization_service.proto.
  */
 export const file_obiente_cloud_organizations_v1_organization_service: GenFile = /*@__PURE__*/
-  fileDesc("CjlvYmllbnRlL2Nsb3VkL29yZ2FuaXphdGlvbnMvdjEvb3JnYW5pemF0aW9uX3NlcnZpY2UucHJvdG8SHm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MSJICg9HZXRVc2FnZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhIKBW1vbnRoGAIgASgJSACIAQFCCAoGX21vbnRoIv0BChBHZXRVc2FnZVJlc3BvbnNlEhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRINCgVtb250aBgCIAEoCRI9CgdjdXJyZW50GAMgASgLMiwub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlVzYWdlTWV0cmljcxJHChFlc3RpbWF0ZWRfbW9udGhseRgEIAEoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Vc2FnZU1ldHJpY3MSOQoFcXVvdGEYBSABKAsyKi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuVXNhZ2VRdW90YSLpAwoMVXNhZ2VNZXRyaWNzEhgKEGNwdV9jb3JlX3NlY29uZHMYASABKAMSGwoTbWVtb3J5X2J5dGVfc2Vjb25kcxgCIAEoAxIaChJiYW5kd2lkdGhfcnhfYnl0ZXMYAyABKAMSGgoSYmFuZHdpZHRoX3R4X2J5dGVzGAQgASgDEhUKDXN0b3JhZ2VfYnl0ZXMYBSABKAMSHwoXZGVwbG95bWVudHNfYWN0aXZlX3BlYWsYBiABKAUSHAoUZXN0aW1hdGVkX2Nvc3RfY2VudHMYByABKAMSGwoOY3B1X2Nvc3RfY2VudHMYCCABKANIAIgBARIeChFtZW1vcnlfY29zdF9jZW50cxgJIAEoA0gBiAEBEiEKFGJhbmR3aWR0aF9jb3N0X2NlbnRzGAogASgDSAKIAQESHwoSc3RvcmFnZV9jb3N0X2NlbnRzGAsgASgDSAOIAQESIQoUcHVibGljX2lwX2Nvc3RfY2VudHMYDCABKANIBIgBAUIRCg9fY3B1X2Nvc3RfY2VudHNCFAoSX21lbW9yeV9jb3N0X2NlbnRzQhcKFV9iYW5kd2lkdGhfY29zdF9jZW50c0IVChNfc3RvcmFnZV9jb3N0X2NlbnRzQhcKFV9wdWJsaWNfaXBfY29zdF9jZW50cyKkAQoKVXNhZ2VRdW90YRIgChhjcHVfY29yZV9zZWNvbmRzX21vbnRobHkYASABKAMSIwobbWVtb3J5X2J5dGVfc2Vjb25kc19tb250aGx5GAIgASgDEh8KF2JhbmR3aWR0aF9ieXRlc19tb250aGx5GAMgASgDEhUKDXN0b3JhZ2VfYnl0ZXMYBCABKAMSFwoPZGVwbG95bWVudHNfbWF4GAUgASgFImAKGExpc3RPcmdhbml6YXRpb25zUmVxdWVzdBIMCgRwYWdlGAEgASgFEhAKCHBlcl9wYWdlGAIgASgFEhYKCW9ubHlfbWluZRgDIAEoCEgAiAEBQgwKCl9vbmx5X21pbmUimQEKGUxpc3RPcmdhbml6YXRpb25zUmVzcG9uc2USQwoNb3JnYW5pemF0aW9ucxgBIAMoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24SNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24iRQoZQ3JlYXRlT3JnYW5pemF0aW9uUmVxdWVzdBIMCgRuYW1lGAEgASgJEgwKBHNsdWcYAiABKAkSDAoEcGxhbhgDIAEoCSJgChpDcmVhdGVPcmdhbml6YXRpb25SZXNwb25zZRJCCgxvcmdhbml6YXRpb24YASABKAsyLC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuT3JnYW5pemF0aW9uIjEKFkdldE9yZ2FuaXphdGlvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIl0KF0dldE9yZ2FuaXphdGlvblJlc3BvbnNlEkIKDG9yZ2FuaXphdGlvbhgBIAEoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24icAoZVXBkYXRlT3JnYW5pemF0aW9uUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSEQoEbmFtZRgCIAEoCUgAiAEBEhMKBmRvbWFpbhgDIAEoCUgBiAEBQgcKBV9uYW1lQgkKB19kb21haW4iYAoaVXBkYXRlT3JnYW5pemF0aW9uUmVzcG9uc2USQgoMb3JnYW5pemF0aW9uGAEgASgLMiwub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLk9yZ2FuaXphdGlvbiJNChJMaXN0TWVtYmVyc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEgwKBHBhZ2UYAiABKAUSEAoIcGVyX3BhZ2UYAyABKAUikwEKE0xpc3RNZW1iZXJzUmVzcG9uc2USQwoHbWVtYmVycxgBIAMoCzIyLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb25NZW1iZXISNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24iSwoTSW52aXRlTWVtYmVyUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDQoFZW1haWwYAiABKAkSDAoEcm9sZRgDIAEoCSJaChRJbnZpdGVNZW1iZXJSZXNwb25zZRJCCgZtZW1iZXIYASABKAsyMi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuT3JnYW5pemF0aW9uTWVtYmVyIkEKE1Jlc2VuZEludml0ZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhEKCW1lbWJlcl9pZBgCIAEoCSInChRSZXNlbmRJbnZpdGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIjYKFExpc3RNeUludml0ZXNSZXF1ZXN0EgwKBHBhZ2UYASABKAUSEAoIcGVyX3BhZ2UYAiABKAUikAEKFUxpc3RNeUludml0ZXNSZXNwb25zZRI+CgdpbnZpdGVzGAEgAygLMi0ub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlBlbmRpbmdJbnZpdGUSNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24ipAEKDVBlbmRpbmdJbnZpdGUSCgoCaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEhkKEW9yZ2FuaXphdGlvbl9uYW1lGAMgASgJEgwKBHJvbGUYBCABKAkSLgoKaW52aXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNaW52aXRlcl9lbWFpbBgGIAEoCSJBChNBY2NlcHRJbnZpdGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIRCgltZW1iZXJfaWQYAiABKAkingEKFEFjY2VwdEludml0ZVJlc3BvbnNlEkIKBm1lbWJlchgBIAEoCzIyLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb25NZW1iZXISQgoMb3JnYW5pemF0aW9uGAIgASgLMiwub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLk9yZ2FuaXphdGlvbiJCChREZWNsaW5lSW52aXRlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSEQoJbWVtYmVyX2lkGAIgASgJIigKFURlY2xpbmVJbnZpdGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIl0KE1VwZGF0ZU1lbWJlclJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhEKCW1lbWJlcl9pZBgCIAEoCRIRCgRyb2xlGAMgASgJSACIAQFCBwoFX3JvbGUiWgoUVXBkYXRlTWVtYmVyUmVzcG9uc2USQgoGbWVtYmVyGAEgASgLMjIub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLk9yZ2FuaXphdGlvbk1lbWJlciJBChNSZW1vdmVNZW1iZXJSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIRCgltZW1iZXJfaWQYAiABKAkiJwoUUmVtb3ZlTWVtYmVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJnChhUcmFuc2Zlck93bmVyc2hpcFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhsKE25ld19vd25lcl9tZW1iZXJfaWQYAiABKAkSFQoNZmFsbGJhY2tfcm9sZRgDIAEoCSKCAQoZVHJhbnNmZXJPd25lcnNoaXBSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEiAKGHByZXZpb3VzX293bmVyX21lbWJlcl9pZBgCIAEoCRIbChNuZXdfb3duZXJfbWVtYmVyX2lkGAMgASgJEhUKDWZhbGxiYWNrX3JvbGUYBCABKAki7QIKDE9yZ2FuaXphdGlvbhIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBHNsdWcYAyABKAkSEwoGZG9tYWluGAQgASgJSACIAQESDAoEcGxhbhgFIAEoCRIOCgZzdGF0dXMYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoPbWF4X2RlcGxveW1lbnRzGAggASgFEhkKEW1heF92cHNfaW5zdGFuY2VzGAkgASgFEhgKEG1heF90ZWFtX21lbWJlcnMYCiABKAUSDwoHY3JlZGl0cxgLIAEoAxJACglwbGFuX2luZm8YDCABKAsyKC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuUGxhbkluZm9IAYgBARIYChB0b3RhbF9wYWlkX2NlbnRzGA0gASgDQgkKB19kb21haW5CDAoKX3BsYW5faW5mbyKtAgoIUGxhbkluZm8SDwoHcGxhbl9pZBgBIAEoCRIRCglwbGFuX25hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSEQoJY3B1X2NvcmVzGAQgASgFEhQKDG1lbW9yeV9ieXRlcxgFIAEoAxIXCg9kZXBsb3ltZW50c19tYXgYBiABKAUSGQoRbWF4X3Zwc19pbnN0YW5jZXMYCyABKAUSHQoVYmFuZHdpZHRoX2J5dGVzX21vbnRoGAcgASgDEhUKDXN0b3JhZ2VfYnl0ZXMYCCABKAMSHQoVbWluaW11bV9wYXltZW50X2NlbnRzGAkgASgDEiIKGm1vbnRobHlfZnJlZV9jcmVkaXRzX2NlbnRzGAogASgDEhIKCnRyaWFsX2RheXMYDCABKAUimAEKEk9yZ2FuaXphdGlvbk1lbWJlchIKCgJpZBgBIAEoCRIpCgR1c2VyGAIgASgLMhsub2JpZW50ZS5jbG91ZC5hdXRoLnYxLlVzZXISDAoEcm9sZRgDIAEoCRIOCgZzdGF0dXMYBCABKAkSLQoJam9pbmVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJeChFBZGRDcmVkaXRzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFAoMYW1vdW50X2NlbnRzGAIgASgDEhEKBG5vdGUYAyABKAlIAIgBAUIHCgVfbm90ZSKPAQoSQWRkQ3JlZGl0c1Jlc3BvbnNlEkIKDG9yZ2FuaXphdGlvbhgBIAEoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24SGQoRbmV3X2JhbGFuY2VfY2VudHMYAiABKAMSGgoSYW1vdW50X2FkZGVkX2NlbnRzGAMgASgDImMKFkFkbWluQWRkQ3JlZGl0c1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhQKDGFtb3VudF9jZW50cxgCIAEoAxIRCgRub3RlGAMgASgJSACIAQFCBwoFX25vdGUilAEKF0FkbWluQWRkQ3JlZGl0c1Jlc3BvbnNlEkIKDG9yZ2FuaXphdGlvbhgBIAEoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24SGQoRbmV3X2JhbGFuY2VfY2VudHMYAiABKAMSGgoSYW1vdW50X2FkZGVkX2NlbnRzGAMgASgDImYKGUFkbWluUmVtb3ZlQ3JlZGl0c1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhQKDGFtb3VudF9jZW50cxgCIAEoAxIRCgRub3RlGAMgASgJSACIAQFCBwoFX25vdGUimQEKGkFkbWluUmVtb3ZlQ3JlZGl0c1Jlc3BvbnNlEkIKDG9yZ2FuaXphdGlvbhgBIAEoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24SGQoRbmV3X2JhbGFuY2VfY2VudHMYAiABKAMSHAoUYW1vdW50X3JlbW92ZWRfY2VudHMYAyABKAMiTgoTR2V0Q3JlZGl0TG9nUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDAoEcGFnZRgCIAEoBRIQCghwZXJfcGFnZRgDIAEoBSKYAQoUR2V0Q3JlZGl0TG9nUmVzcG9uc2USRwoMdHJhbnNhY3Rpb25zGAEgAygLMjEub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkNyZWRpdFRyYW5zYWN0aW9uEjcKCnBhZ2luYXRpb24YAiABKAsyIy5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5QYWdpbmF0aW9uIvcBChFDcmVkaXRUcmFuc2FjdGlvbhIKCgJpZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSFAoMYW1vdW50X2NlbnRzGAMgASgDEhUKDWJhbGFuY2VfYWZ0ZXIYBCABKAMSDAoEdHlwZRgFIAEoCRIOCgZzb3VyY2UYBiABKAkSEQoEbm90ZRgHIAEoCUgAiAEBEhcKCmNyZWF0ZWRfYnkYCCABKAlIAYgBARIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIHCgVfbm90ZUINCgtfY3JlYXRlZF9ieSIyChdHZXRNeVBlcm1pc3Npb25zUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiLwoYR2V0TXlQZXJtaXNzaW9uc1Jlc3BvbnNlEhMKC3Blcm1pc3Npb25zGAEgAygJIj8KE0FkbWluU2V0UGxhblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg8KB3BsYW5faWQYAiABKAkiawoUQWRtaW5TZXRQbGFuUmVzcG9uc2USQgoMb3JnYW5pemF0aW9uGAEgASgLMiwub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLk9yZ2FuaXphdGlvbhIPCgdwbGFuX2lkGAIgASgJMpwUChNPcmdhbml6YXRpb25TZXJ2aWNlEnkKDEFkbWluU2V0UGxhbhIzLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5BZG1pblNldFBsYW5SZXF1ZXN0GjQub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkFkbWluU2V0UGxhblJlc3BvbnNlEogBChFMaXN0T3JnYW5pemF0aW9ucxI4Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5MaXN0T3JnYW5pemF0aW9uc1JlcXVlc3QaOS5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuTGlzdE9yZ2FuaXphdGlvbnNSZXNwb25zZRKLAQoSQ3JlYXRlT3JnYW5pemF0aW9uEjkub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkNyZWF0ZU9yZ2FuaXphdGlvblJlcXVlc3QaOi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQ3JlYXRlT3JnYW5pemF0aW9uUmVzcG9uc2USggEKD0dldE9yZ2FuaXphdGlvbhI2Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5HZXRPcmdhbml6YXRpb25SZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkdldE9yZ2FuaXphdGlvblJlc3BvbnNlEosBChJVcGRhdGVPcmdhbml6YXRpb24SOS5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuVXBkYXRlT3JnYW5pemF0aW9uUmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5VcGRhdGVPcmdhbml6YXRpb25SZXNwb25zZRJ2CgtMaXN0TWVtYmVycxIyLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5MaXN0TWVtYmVyc1JlcXVlc3QaMy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuTGlzdE1lbWJlcnNSZXNwb25zZRJ5CgxJbnZpdGVNZW1iZXISMy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuSW52aXRlTWVtYmVyUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5JbnZpdGVNZW1iZXJSZXNwb25zZRJ5CgxSZXNlbmRJbnZpdGUSMy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuUmVzZW5kSW52aXRlUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5SZXNlbmRJbnZpdGVSZXNwb25zZRJ8Cg1MaXN0TXlJbnZpdGVzEjQub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkxpc3RNeUludml0ZXNSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkxpc3RNeUludml0ZXNSZXNwb25zZRJ5CgxBY2NlcHRJbnZpdGUSMy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWNjZXB0SW52aXRlUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5BY2NlcHRJbnZpdGVSZXNwb25zZRJ8Cg1EZWNsaW5lSW52aXRlEjQub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkRlY2xpbmVJbnZpdGVSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkRlY2xpbmVJbnZpdGVSZXNwb25zZRJ5CgxVcGRhdGVNZW1iZXISMy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuVXBkYXRlTWVtYmVyUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5VcGRhdGVNZW1iZXJSZXNwb25zZRJ5CgxSZW1vdmVNZW1iZXISMy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuUmVtb3ZlTWVtYmVyUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5SZW1vdmVNZW1iZXJSZXNwb25zZRKIAQoRVHJhbnNmZXJPd25lcnNoaXASOC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuVHJhbnNmZXJPd25lcnNoaXBSZXF1ZXN0Gjkub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlRyYW5zZmVyT3duZXJzaGlwUmVzcG9uc2USbQoIR2V0VXNhZ2USLy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuR2V0VXNhZ2VSZXF1ZXN0GjAub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkdldFVzYWdlUmVzcG9uc2UScwoKQWRkQ3JlZGl0cxIxLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5BZGRDcmVkaXRzUmVxdWVzdBoyLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5BZGRDcmVkaXRzUmVzcG9uc2USggEKD0FkbWluQWRkQ3JlZGl0cxI2Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5BZG1pbkFkZENyZWRpdHNSZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkFkbWluQWRkQ3JlZGl0c1Jlc3BvbnNlEosBChJBZG1pblJlbW92ZUNyZWRpdHMSOS5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWRtaW5SZW1vdmVDcmVkaXRzUmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5BZG1pblJlbW92ZUNyZWRpdHNSZXNwb25zZRJ5CgxHZXRDcmVkaXRMb2cSMy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuR2V0Q3JlZGl0TG9nUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5HZXRDcmVkaXRMb2dSZXNwb25zZRKFAQoQR2V0TXlQZXJtaXNzaW9ucxI3Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5HZXRNeVBlcm1pc3Npb25zUmVxdWVzdBo4Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5HZXRNeVBlcm1pc3Npb25zUmVzcG9uc2VCW1pZZ2l0aHViLmNvbS9vYmllbnRlL2Nsb3VkL2FwcHMvc2hhcmVkL3Byb3RvL29iaWVudGUvY2xvdWQvb3JnYW5pemF0aW9ucy92MTtvcmdhbml6YXRpb25zdjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_obiente_cloud_auth_v1_auth_service, file_obiente_cloud_common_v1_common]);
+  fileDesc("CjlvYmllbnRlL2Nsb3VkL29yZ2FuaXphdGlvbnMvdjEvb3JnYW5pemF0aW9uX3NlcnZpY2UucHJvdG8SHm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MSJICg9HZXRVc2FnZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhIKBW1vbnRoGAIgASgJSACIAQFCCAoGX21vbnRoIv0BChBHZXRVc2FnZVJlc3BvbnNlEhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRINCgVtb250aBgCIAEoCRI9CgdjdXJyZW50GAMgASgLMiwub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlVzYWdlTWV0cmljcxJHChFlc3RpbWF0ZWRfbW9udGhseRgEIAEoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Vc2FnZU1ldHJpY3MSOQoFcXVvdGEYBSABKAsyKi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuVXNhZ2VRdW90YSLpAwoMVXNhZ2VNZXRyaWNzEhgKEGNwdV9jb3JlX3NlY29uZHMYASABKAMSGwoTbWVtb3J5X2J5dGVfc2Vjb25kcxgCIAEoAxIaChJiYW5kd2lkdGhfcnhfYnl0ZXMYAyABKAMSGgoSYmFuZHdpZHRoX3R4X2J5dGVzGAQgASgDEhUKDXN0b3JhZ2VfYnl0ZXMYBSABKAMSHwoXZGVwbG95bWVudHNfYWN0aXZlX3BlYWsYBiABKAUSHAoUZXN0aW1hdGVkX2Nvc3RfY2VudHMYByABKAMSGwoOY3B1X2Nvc3RfY2VudHMYCCABKANIAIgBARIeChFtZW1vcnlfY29zdF9jZW50cxgJIAEoA0gBiAEBEiEKFGJhbmR3aWR0aF9jb3N0X2NlbnRzGAogASgDSAKIAQESHwoSc3RvcmFnZV9jb3N0X2NlbnRzGAsgASgDSAOIAQESIQoUcHVibGljX2lwX2Nvc3RfY2VudHMYDCABKANIBIgBAUIRCg9fY3B1X2Nvc3RfY2VudHNCFAoSX21lbW9yeV9jb3N0X2NlbnRzQhcKFV9iYW5kd2lkdGhfY29zdF9jZW50c0IVChNfc3RvcmFnZV9jb3N0X2NlbnRzQhcKFV9wdWJsaWNfaXBfY29zdF9jZW50cyKkAQoKVXNhZ2VRdW90YRIgChhjcHVfY29yZV9zZWNvbmRzX21vbnRobHkYASABKAMSIwobbWVtb3J5X2J5dGVfc2Vjb25kc19tb250aGx5GAIgASgDEh8KF2JhbmR3aWR0aF9ieXRlc19tb250aGx5GAMgASgDEhUKDXN0b3JhZ2VfYnl0ZXMYBCABKAMSFwoPZGVwbG95bWVudHNfbWF4GAUgASgFImAKGExpc3RPcmdhbml6YXRpb25zUmVxdWVzdBIMCgRwYWdlGAEgASgFEhAKCHBlcl9wYWdlGAIgASgFEhYKCW9ubHlfbWluZRgDIAEoCEgAiAEBQgwKCl9vbmx5X21pbmUimQEKGUxpc3RPcmdhbml6YXRpb25zUmVzcG9uc2USQwoNb3JnYW5pemF0aW9ucxgBIAMoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24SNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24iRQoZQ3JlYXRlT3JnYW5pemF0aW9uUmVxdWVzdBIMCgRuYW1lGAEgASgJEgwKBHNsdWcYAiABKAkSDAoEcGxhbhgDIAEoCSJgChpDcmVhdGVPcmdhbml6YXRpb25SZXNwb25zZRJCCgxvcmdhbml6YXRpb24YASABKAsyLC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuT3JnYW5pemF0aW9uIjEKFkdldE9yZ2FuaXphdGlvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIl0KF0dldE9yZ2FuaXphdGlvblJlc3BvbnNlEkIKDG9yZ2FuaXphdGlvbhgBIAEoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24icAoZVXBkYXRlT3JnYW5pemF0aW9uUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSEQoEbmFtZRgCIAEoCUgAiAEBEhMKBmRvbWFpbhgDIAEoCUgBiAEBQgcKBV9uYW1lQgkKB19kb21haW4iYAoaVXBkYXRlT3JnYW5pemF0aW9uUmVzcG9uc2USQgoMb3JnYW5pemF0aW9uGAEgASgLMiwub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLk9yZ2FuaXphdGlvbiJNChJMaXN0TWVtYmVyc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEgwKBHBhZ2UYAiABKAUSEAoIcGVyX3BhZ2UYAyABKAUikwEKE0xpc3RNZW1iZXJzUmVzcG9uc2USQwoHbWVtYmVycxgBIAMoCzIyLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb25NZW1iZXISNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24iSwoTSW52aXRlTWVtYmVyUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDQoFZW1haWwYAiABKAkSDAoEcm9sZRgDIAEoCSJaChRJbnZpdGVNZW1iZXJSZXNwb25zZRJCCgZtZW1iZXIYASABKAsyMi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuT3JnYW5pemF0aW9uTWVtYmVyIkEKE1Jlc2VuZEludml0ZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhEKCW1lbWJlcl9pZBgCIAEoCSInChRSZXNlbmRJbnZpdGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIjYKFExpc3RNeUludml0ZXNSZXF1ZXN0EgwKBHBhZ2UYASABKAUSEAoIcGVyX3BhZ2UYAiABKAUikAEKFUxpc3RNeUludml0ZXNSZXNwb25zZRI+CgdpbnZpdGVzGAEgAygLMi0ub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlBlbmRpbmdJbnZpdGUSNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24i1AEKDVBlbmRpbmdJbnZpdGUSCgoCaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEhkKEW9yZ2FuaXphdGlvbl9uYW1lGAMgASgJEgwKBHJvbGUYBCABKAkSLgoKaW52aXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNaW52aXRlcl9lbWFpbBgGIAEoCRIuCgpleHBpcmVzX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJBChNBY2NlcHRJbnZpdGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIRCgltZW1iZXJfaWQYAiABKAkingEKFEFjY2VwdEludml0ZVJlc3BvbnNlEkIKBm1lbWJlchgBIAEoCzIyLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb25NZW1iZXISQgoMb3JnYW5pemF0aW9uGAIgASgLMiwub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLk9yZ2FuaXphdGlvbiJCChREZWNsaW5lSW52aXRlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSEQoJbWVtYmVyX2lkGAIgASgJIigKFURlY2xpbmVJbnZpdGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIl0KE1VwZGF0ZU1lbWJlclJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhEKCW1lbWJlcl9pZBgCIAEoCRIRCgRyb2xlGAMgASgJSACIAQFCBwoFX3JvbGUiWgoUVXBkYXRlTWVtYmVyUmVzcG9uc2USQgoGbWVtYmVyGAEgASgLMjIub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLk9yZ2FuaXphdGlvbk1lbWJlciJBChNSZW1vdmVNZW1iZXJSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIRCgltZW1iZXJfaWQYAiABKAkiJwoUUmVtb3ZlTWVtYmVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJnChhUcmFuc2Zlck93bmVyc2hpcFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhsKE25ld19vd25lcl9tZW1iZXJfaWQYAiABKAkSFQoNZmFsbGJhY2tfcm9sZRgDIAEoCSKCAQoZVHJhbnNmZXJPd25lcnNoaXBSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEiAKGHByZXZpb3VzX293bmVyX21lbWJlcl9pZBgCIAEoCRIbChNuZXdfb3duZXJfbWVtYmVyX2lkGAMgASgJEhUKDWZhbGxiYWNrX3JvbGUYBCABKAki9wMKDE9yZ2FuaXphdGlvbhIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBHNsdWcYAyABKAkSEwoGZG9tYWluGAQgASgJSACIAQESDAoEcGxhbhgFIAEoCRIOCgZzdGF0dXMYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoPbWF4X2RlcGxveW1lbnRzGAggASgFEhkKEW1heF92cHNfaW5zdGFuY2VzGAkgASgFEhgKEG1heF90ZWFtX21lbWJlcnMYCiABKAUSDwoHY3JlZGl0cxgLIAEoAxJACglwbGFuX2luZm8YDCABKAsyKC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuUGxhbkluZm9IAYgBARIYChB0b3RhbF9wYWlkX2NlbnRzGA0gASgDEigKG21lcmdlZF9pbnRvX29yZ2FuaXphdGlvbl9pZBgOIAEoCUgCiAEBEiMKFnBhcmVudF9vcmdhbml6YXRpb25faWQYDyABKAlIA4gBAUIJCgdfZG9tYWluQgwKCl9wbGFuX2luZm9CHgocX21lcmdlZF9pbnRvX29yZ2FuaXphdGlvbl9pZEIZChdfcGFyZW50X29yZ2FuaXphdGlvbl9pZCKtAgoIUGxhbkluZm8SDwoHcGxhbl9pZBgBIAEoCRIRCglwbGFuX25hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSEQoJY3B1X2NvcmVzGAQgASgFEhQKDG1lbW9yeV9ieXRlcxgFIAEoAxIXCg9kZXBsb3ltZW50c19tYXgYBiABKAUSGQoRbWF4X3Zwc19pbnN0YW5jZXMYCyABKAUSHQoVYmFuZHdpZHRoX2J5dGVzX21vbnRoGAcgASgDEhUKDXN0b3JhZ2VfYnl0ZXMYCCABKAMSHQoVbWluaW11bV9wYXltZW50X2NlbnRzGAkgASgDEiIKGm1vbnRobHlfZnJlZV9jcmVkaXRzX2NlbnRzGAogASgDEhIKCnRyaWFsX2RheXMYDCABKAUimAEKEk9yZ2FuaXphdGlvbk1lbWJlchIKCgJpZBgBIAEoCRIpCgR1c2VyGAIgASgLMhsub2JpZW50ZS5jbG91ZC5hdXRoLnYxLlVzZXISDAoEcm9sZRgDIAEoCRIOCgZzdGF0dXMYBCABKAkSLQoJam9pbmVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJeChFBZGRDcmVkaXRzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFAoMYW1vdW50X2NlbnRzGAIgASgDEhEKBG5vdGUYAyABKAlIAIgBAUIHCgVfbm90ZSKPAQoSQWRkQ3JlZGl0c1Jlc3BvbnNlEkIKDG9yZ2FuaXphdGlvbhgBIAEoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24SGQoRbmV3X2JhbGFuY2VfY2VudHMYAiABKAMSGgoSYW1vdW50X2FkZGVkX2NlbnRzGAMgASgDImMKFkFkbWluQWRkQ3JlZGl0c1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhQKDGFtb3VudF9jZW50cxgCIAEoAxIRCgRub3RlGAMgASgJSACIAQFCBwoFX25vdGUilAEKF0FkbWluQWRkQ3JlZGl0c1Jlc3BvbnNlEkIKDG9yZ2FuaXphdGlvbhgBIAEoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24SGQoRbmV3X2JhbGFuY2VfY2VudHMYAiABKAMSGgoSYW1vdW50X2FkZGVkX2NlbnRzGAMgASgDImYKGUFkbWluUmVtb3ZlQ3JlZGl0c1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhQKDGFtb3VudF9jZW50cxgCIAEoAxIRCgRub3RlGAMgASgJSACIAQFCBwoFX25vdGUimQEKGkFkbWluUmVtb3ZlQ3JlZGl0c1Jlc3BvbnNlEkIKDG9yZ2FuaXphdGlvbhgBIAEoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24SGQoRbmV3X2JhbGFuY2VfY2VudHMYAiABKAMSHAoUYW1vdW50X3JlbW92ZWRfY2VudHMYAyABKAMiTgoTR2V0Q3JlZGl0TG9nUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDAoEcGFnZRgCIAEoBRIQCghwZXJfcGFnZRgDIAEoBSKYAQoUR2V0Q3JlZGl0TG9nUmVzcG9uc2USRwoMdHJhbnNhY3Rpb25zGAEgAygLMjEub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkNyZWRpdFRyYW5zYWN0aW9uEjcKCnBhZ2luYXRpb24YAiABKAsyIy5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5QYWdpbmF0aW9uIvcBChFDcmVkaXRUcmFuc2FjdGlvbhIKCgJpZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSFAoMYW1vdW50X2NlbnRzGAMgASgDEhUKDWJhbGFuY2VfYWZ0ZXIYBCABKAMSDAoEdHlwZRgFIAEoCRIOCgZzb3VyY2UYBiABKAkSEQoEbm90ZRgHIAEoCUgAiAEBEhcKCmNyZWF0ZWRfYnkYCCABKAlIAYgBARIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIHCgVfbm90ZUINCgtfY3JlYXRlZF9ieSJZCh5HZXRPcmdhbml6YXRpb25BdWRpdExvZ1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEgwKBHBhZ2UYAiABKAUSEAoIcGVyX3BhZ2UYAyABKAUilgEKH0dldE9yZ2FuaXphdGlvbkF1ZGl0TG9nUmVzcG9uc2USOgoGZXZlbnRzGAEgAygLMioub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkF1ZGl0RXZlbnQSNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24ijwIKCkF1ZGl0RXZlbnQSCgoCaWQYASABKAkSDQoFYWN0b3IYAiABKAkSDgoGYWN0aW9uGAMgASgJEg8KB3NlcnZpY2UYBCABKAkSGgoNcmVzb3VyY2VfdHlwZRgFIAEoCUgAiAEBEhgKC3Jlc291cmNlX2lkGAYgASgJSAGIAQESPgoEZGlmZhgHIAMoCzIwLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5BdWRpdEZpZWxkQ2hhbmdlEi0KCXRpbWVzdGFtcBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCEAoOX3Jlc291cmNlX3R5cGVCDgoMX3Jlc291cmNlX2lkIm0KEEF1ZGl0RmllbGRDaGFuZ2USDQoFZmllbGQYASABKAkSFgoJb2xkX3ZhbHVlGAIgASgJSACIAQESFgoJbmV3X3ZhbHVlGAMgASgJSAGIAQFCDAoKX29sZF92YWx1ZUIMCgpfbmV3X3ZhbHVlIsABCiJHZXRPcmdhbml6YXRpb25BY3Rpdml0eUZlZWRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIVCghhZnRlcl9pZBgCIAEoCUgAiAEBEhEKCXBhZ2Vfc2l6ZRgDIAEoBRIXCgpldmVudF90eXBlGAQgASgJSAGIAQESFQoIYWN0b3JfaWQYBSABKAlIAogBAUILCglfYWZ0ZXJfaWRCDQoLX2V2ZW50X3R5cGVCCwoJX2FjdG9yX2lkIpIBCiNHZXRPcmdhbml6YXRpb25BY3Rpdml0eUZlZWRSZXNwb25zZRI9CgZldmVudHMYASADKAsyLS5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWN0aXZpdHlFdmVudBIaCg1uZXh0X2FmdGVyX2lkGAIgASgJSACIAQFCEAoOX25leHRfYWZ0ZXJfaWQisgIKDUFjdGl2aXR5RXZlbnQSCgoCaWQYASABKAkSEgoKZXZlbnRfdHlwZRgCIAEoCRIQCghhY3Rvcl9pZBgDIAEoCRIVCg1yZXNvdXJjZV90eXBlGAQgASgJEhgKC3Jlc291cmNlX2lkGAUgASgJSACIAQESTQoIbWV0YWRhdGEYBiADKAsyOy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWN0aXZpdHlFdmVudC5NZXRhZGF0YUVudHJ5Ei4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIOCgxfcmVzb3VyY2VfaWQi4QIKClNBTUxDb25maWcSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhEKCWVudGl0eV9pZBgCIAEoCRIPCgdzc29fdXJsGAMgASgJEhMKC2NlcnRpZmljYXRlGAQgASgJElsKEWF0dHJpYnV0ZV9tYXBwaW5nGAUgAygLMkAub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlNBTUxDb25maWcuQXR0cmlidXRlTWFwcGluZ0VudHJ5EhQKDHNwX2VudGl0eV9pZBgGIAEoCRIPCgdhY3NfdXJsGAcgASgJEhQKDG1ldGFkYXRhX3VybBgIIAEoCRIuCgp1cGRhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBo3ChVBdHRyaWJ1dGVNYXBwaW5nRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKIAgoUQ29uZmlndXJlU0FNTFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhEKCWVudGl0eV9pZBgCIAEoCRIPCgdzc29fdXJsGAMgASgJEhMKC2NlcnRpZmljYXRlGAQgASgJEmUKEWF0dHJpYnV0ZV9tYXBwaW5nGAUgAygLMkoub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkNvbmZpZ3VyZVNBTUxSZXF1ZXN0LkF0dHJpYnV0ZU1hcHBpbmdFbnRyeRo3ChVBdHRyaWJ1dGVNYXBwaW5nRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJTChVDb25maWd1cmVTQU1MUmVzcG9uc2USOgoGY29uZmlnGAEgASgLMioub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlNBTUxDb25maWciLwoUR2V0U0FNTENvbmZpZ1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIlMKFUdldFNBTUxDb25maWdSZXNwb25zZRI6CgZjb25maWcYASABKAsyKi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuU0FNTENvbmZpZyIyChdHZXRNeVBlcm1pc3Npb25zUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiLwoYR2V0TXlQZXJtaXNzaW9uc1Jlc3BvbnNlEhMKC3Blcm1pc3Npb25zGAEgAygJIj8KE0FkbWluU2V0UGxhblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg8KB3BsYW5faWQYAiABKAkiawoUQWRtaW5TZXRQbGFuUmVzcG9uc2USQgoMb3JnYW5pemF0aW9uGAEgASgLMiwub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLk9yZ2FuaXphdGlvbhIPCgdwbGFuX2lkGAIgASgJIrEBCg5UYWdnZWRSZXNvdXJjZRIVCg1yZXNvdXJjZV90eXBlGAEgASgJEhMKC3Jlc291cmNlX2lkGAIgASgJEkYKBHRhZ3MYAyADKAsyOC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuVGFnZ2VkUmVzb3VyY2UuVGFnc0VudHJ5GisKCVRhZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIngKFUFkZFJlc291cmNlVGFnUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSFQoNcmVzb3VyY2VfdHlwZRgCIAEoCRITCgtyZXNvdXJjZV9pZBgDIAEoCRILCgNrZXkYBCABKAkSDQoFdmFsdWUYBSABKAkiWgoWQWRkUmVzb3VyY2VUYWdSZXNwb25zZRJACghyZXNvdXJjZRgBIAEoCzIuLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5UYWdnZWRSZXNvdXJjZSJsChhSZW1vdmVSZXNvdXJjZVRhZ1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDXJlc291cmNlX3R5cGUYAiABKAkSEwoLcmVzb3VyY2VfaWQYAyABKAkSCwoDa2V5GAQgASgJIl0KGVJlbW92ZVJlc291cmNlVGFnUmVzcG9uc2USQAoIcmVzb3VyY2UYASABKAsyLi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuVGFnZ2VkUmVzb3VyY2Ui4gEKGUxpc3RSZXNvdXJjZXNCeVRhZ1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJElEKBHRhZ3MYAiADKAsyQy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuTGlzdFJlc291cmNlc0J5VGFnUmVxdWVzdC5UYWdzRW50cnkSGgoNcmVzb3VyY2VfdHlwZRgDIAEoCUgAiAEBGisKCVRhZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQhAKDl9yZXNvdXJjZV90eXBlIl8KGkxpc3RSZXNvdXJjZXNCeVRhZ1Jlc3BvbnNlEkEKCXJlc291cmNlcxgBIAMoCzIuLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5UYWdnZWRSZXNvdXJjZSKWAQoEVGVhbRIKCgJpZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSDAoEbmFtZRgDIAEoCRISCgpjcmVhdGVkX2J5GAQgASgJEhcKD21lbWJlcl91c2VyX2lkcxgFIAMoCRIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKxAgoJVGVhbVF1b3RhEhYKCWNwdV9jb3JlcxgBIAEoBUgAiAEBEhkKDG1lbW9yeV9ieXRlcxgCIAEoA0gBiAEBEhwKD2RlcGxveW1lbnRzX21heBgDIAEoBUgCiAEBEh4KEW1heF92cHNfaW5zdGFuY2VzGAQgASgFSAOIAQESIgoVYmFuZHdpZHRoX2J5dGVzX21vbnRoGAUgASgDSASIAQESGgoNc3RvcmFnZV9ieXRlcxgGIAEoA0gFiAEBQgwKCl9jcHVfY29yZXNCDwoNX21lbW9yeV9ieXRlc0ISChBfZGVwbG95bWVudHNfbWF4QhQKEl9tYXhfdnBzX2luc3RhbmNlc0IYChZfYmFuZHdpZHRoX2J5dGVzX21vbnRoQhAKDl9zdG9yYWdlX2J5dGVzIqYBCglUZWFtVXNhZ2USEwoLZGVwbG95bWVudHMYASABKAUSFAoMZ2FtZV9zZXJ2ZXJzGAIgASgFEhUKDXZwc19pbnN0YW5jZXMYAyABKAUSFAoMbWVtb3J5X2J5dGVzGAQgASgDEhEKCWNwdV9jb3JlcxgFIAEoBRIVCg1zdG9yYWdlX2J5dGVzGAYgASgDEhcKD2JhbmR3aWR0aF9ieXRlcxgHIAEoAyJTChFDcmVhdGVUZWFtUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDAoEbmFtZRgCIAEoCRIXCg9tZW1iZXJfdXNlcl9pZHMYAyADKAkiSAoSQ3JlYXRlVGVhbVJlc3BvbnNlEjIKBHRlYW0YASABKAsyJC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuVGVhbSI9ChFEZWxldGVUZWFtUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDwoHdGVhbV9pZBgCIAEoCSIlChJEZWxldGVUZWFtUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJ5ChNTZXRUZWFtUXVvdGFSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIPCgd0ZWFtX2lkGAIgASgJEjgKBXF1b3RhGAMgASgLMikub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlRlYW1RdW90YSJQChRTZXRUZWFtUXVvdGFSZXNwb25zZRI4CgVxdW90YRgBIAEoCzIpLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5UZWFtUXVvdGEiPwoTR2V0VGVhbVVzYWdlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDwoHdGVhbV9pZBgCIAEoCSK+AQoUR2V0VGVhbVVzYWdlUmVzcG9uc2USMgoEdGVhbRgBIAEoCzIkLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5UZWFtEjgKBXVzYWdlGAIgASgLMikub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlRlYW1Vc2FnZRI4CgVxdW90YRgDIAEoCzIpLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5UZWFtUXVvdGEiWwoZTWVyZ2VPcmdhbml6YXRpb25zUmVxdWVzdBIeChZzb3VyY2Vfb3JnYW5pemF0aW9uX2lkGAEgASgJEh4KFnRhcmdldF9vcmdhbml6YXRpb25faWQYAiABKAkikgIKGk1lcmdlT3JnYW5pemF0aW9uc1Jlc3BvbnNlEkkKE3RhcmdldF9vcmdhbml6YXRpb24YASABKAsyLC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuT3JnYW5pemF0aW9uEhkKEWRlcGxveW1lbnRzX21vdmVkGAIgASgFEhoKEmdhbWVfc2VydmVyc19tb3ZlZBgDIAEoBRIbChN2cHNfaW5zdGFuY2VzX21vdmVkGAQgASgFEhUKDW1lbWJlcnNfbW92ZWQYBSABKAUSIQoZY3JlZGl0X3RyYW5zYWN0aW9uc19tb3ZlZBgGIAEoBRIbChNjcmVkaXRzX21vdmVkX2NlbnRzGAcgASgDImAKIENyZWF0ZU1hbmFnZWRPcmdhbml6YXRpb25SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEhUKDWJpbGxpbmdfZW1haWwYAyABKAkiZwohQ3JlYXRlTWFuYWdlZE9yZ2FuaXphdGlvblJlc3BvbnNlEkIKDG9yZ2FuaXphdGlvbhgBIAEoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24iOgofTGlzdE1hbmFnZWRPcmdhbml6YXRpb25zUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiZwogTGlzdE1hbmFnZWRPcmdhbml6YXRpb25zUmVzcG9uc2USQwoNb3JnYW5pemF0aW9ucxgBIAMoCzIsLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Pcmdhbml6YXRpb24iRAoTUm9sZUluaGVyaXRhbmNlRWRnZRIVCg1jaGlsZF9yb2xlX2lkGAEgASgJEhYKDnBhcmVudF9yb2xlX2lkGAIgASgJImMKGUFkZFJvbGVJbmhlcml0YW5jZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWNoaWxkX3JvbGVfaWQYAiABKAkSFgoOcGFyZW50X3JvbGVfaWQYAyABKAkiXwoaQWRkUm9sZUluaGVyaXRhbmNlUmVzcG9uc2USQQoEZWRnZRgBIAEoCzIzLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Sb2xlSW5oZXJpdGFuY2VFZGdlImYKHFJlbW92ZVJvbGVJbmhlcml0YW5jZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhUKDWNoaWxkX3JvbGVfaWQYAiABKAkSFgoOcGFyZW50X3JvbGVfaWQYAyABKAkiMAodUmVtb3ZlUm9sZUluaGVyaXRhbmNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJKCh5HZXRFZmZlY3RpdmVQZXJtaXNzaW9uc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkiNgofR2V0RWZmZWN0aXZlUGVybWlzc2lvbnNSZXNwb25zZRITCgtwZXJtaXNzaW9ucxgBIAMoCSIsChFSb2xlc0dyYXBoUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiXQoNUm9sZUdyYXBoTm9kZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC3Blcm1pc3Npb25zGAMgAygJEh0KFWVmZmVjdGl2ZV9wZXJtaXNzaW9ucxgEIAMoCSKWAQoSUm9sZXNHcmFwaFJlc3BvbnNlEjwKBXJvbGVzGAEgAygLMi0ub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlJvbGVHcmFwaE5vZGUSQgoFZWRnZXMYAiADKAsyMy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuUm9sZUluaGVyaXRhbmNlRWRnZTLgJwoTT3JnYW5pemF0aW9uU2VydmljZRJ5CgxBZG1pblNldFBsYW4SMy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWRtaW5TZXRQbGFuUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5BZG1pblNldFBsYW5SZXNwb25zZRKIAQoRTGlzdE9yZ2FuaXphdGlvbnMSOC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuTGlzdE9yZ2FuaXphdGlvbnNSZXF1ZXN0Gjkub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkxpc3RPcmdhbml6YXRpb25zUmVzcG9uc2USiwEKEkNyZWF0ZU9yZ2FuaXphdGlvbhI5Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5DcmVhdGVPcmdhbml6YXRpb25SZXF1ZXN0Gjoub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkNyZWF0ZU9yZ2FuaXphdGlvblJlc3BvbnNlEoIBCg9HZXRPcmdhbml6YXRpb24SNi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuR2V0T3JnYW5pemF0aW9uUmVxdWVzdBo3Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5HZXRPcmdhbml6YXRpb25SZXNwb25zZRKLAQoSVXBkYXRlT3JnYW5pemF0aW9uEjkub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlVwZGF0ZU9yZ2FuaXphdGlvblJlcXVlc3QaOi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuVXBkYXRlT3JnYW5pemF0aW9uUmVzcG9uc2USdgoLTGlzdE1lbWJlcnMSMi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuTGlzdE1lbWJlcnNSZXF1ZXN0GjMub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkxpc3RNZW1iZXJzUmVzcG9uc2USeQoMSW52aXRlTWVtYmVyEjMub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkludml0ZU1lbWJlclJlcXVlc3QaNC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuSW52aXRlTWVtYmVyUmVzcG9uc2USeQoMUmVzZW5kSW52aXRlEjMub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlJlc2VuZEludml0ZVJlcXVlc3QaNC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuUmVzZW5kSW52aXRlUmVzcG9uc2USfAoNTGlzdE15SW52aXRlcxI0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5MaXN0TXlJbnZpdGVzUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5MaXN0TXlJbnZpdGVzUmVzcG9uc2USeQoMQWNjZXB0SW52aXRlEjMub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkFjY2VwdEludml0ZVJlcXVlc3QaNC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWNjZXB0SW52aXRlUmVzcG9uc2USfAoNRGVjbGluZUludml0ZRI0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5EZWNsaW5lSW52aXRlUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5EZWNsaW5lSW52aXRlUmVzcG9uc2USeQoMVXBkYXRlTWVtYmVyEjMub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlVwZGF0ZU1lbWJlclJlcXVlc3QaNC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuVXBkYXRlTWVtYmVyUmVzcG9uc2USeQoMUmVtb3ZlTWVtYmVyEjMub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlJlbW92ZU1lbWJlclJlcXVlc3QaNC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuUmVtb3ZlTWVtYmVyUmVzcG9uc2USiAEKEVRyYW5zZmVyT3duZXJzaGlwEjgub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlRyYW5zZmVyT3duZXJzaGlwUmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5UcmFuc2Zlck93bmVyc2hpcFJlc3BvbnNlEm0KCEdldFVzYWdlEi8ub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkdldFVzYWdlUmVxdWVzdBowLm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5HZXRVc2FnZVJlc3BvbnNlEnMKCkFkZENyZWRpdHMSMS5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWRkQ3JlZGl0c1JlcXVlc3QaMi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWRkQ3JlZGl0c1Jlc3BvbnNlEoIBCg9BZG1pbkFkZENyZWRpdHMSNi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWRtaW5BZGRDcmVkaXRzUmVxdWVzdBo3Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5BZG1pbkFkZENyZWRpdHNSZXNwb25zZRKLAQoSQWRtaW5SZW1vdmVDcmVkaXRzEjkub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkFkbWluUmVtb3ZlQ3JlZGl0c1JlcXVlc3QaOi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWRtaW5SZW1vdmVDcmVkaXRzUmVzcG9uc2USeQoMR2V0Q3JlZGl0TG9nEjMub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkdldENyZWRpdExvZ1JlcXVlc3QaNC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuR2V0Q3JlZGl0TG9nUmVzcG9uc2UShQEKEEdldE15UGVybWlzc2lvbnMSNy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuR2V0TXlQZXJtaXNzaW9uc1JlcXVlc3QaOC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuR2V0TXlQZXJtaXNzaW9uc1Jlc3BvbnNlEpoBChdHZXRPcmdhbml6YXRpb25BdWRpdExvZxI+Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5HZXRPcmdhbml6YXRpb25BdWRpdExvZ1JlcXVlc3QaPy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuR2V0T3JnYW5pemF0aW9uQXVkaXRMb2dSZXNwb25zZRKmAQobR2V0T3JnYW5pemF0aW9uQWN0aXZpdHlGZWVkEkIub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkdldE9yZ2FuaXphdGlvbkFjdGl2aXR5RmVlZFJlcXVlc3QaQy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuR2V0T3JnYW5pemF0aW9uQWN0aXZpdHlGZWVkUmVzcG9uc2USfAoNQ29uZmlndXJlU0FNTBI0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Db25maWd1cmVTQU1MUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5Db25maWd1cmVTQU1MUmVzcG9uc2USfAoNR2V0U0FNTENvbmZpZxI0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5HZXRTQU1MQ29uZmlnUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5HZXRTQU1MQ29uZmlnUmVzcG9uc2USfwoOQWRkUmVzb3VyY2VUYWcSNS5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWRkUmVzb3VyY2VUYWdSZXF1ZXN0GjYub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkFkZFJlc291cmNlVGFnUmVzcG9uc2USiAEKEVJlbW92ZVJlc291cmNlVGFnEjgub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlJlbW92ZVJlc291cmNlVGFnUmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5SZW1vdmVSZXNvdXJjZVRhZ1Jlc3BvbnNlEosBChJMaXN0UmVzb3VyY2VzQnlUYWcSOS5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuTGlzdFJlc291cmNlc0J5VGFnUmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5MaXN0UmVzb3VyY2VzQnlUYWdSZXNwb25zZRJzCgpDcmVhdGVUZWFtEjEub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkNyZWF0ZVRlYW1SZXF1ZXN0GjIub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkNyZWF0ZVRlYW1SZXNwb25zZRJzCgpEZWxldGVUZWFtEjEub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkRlbGV0ZVRlYW1SZXF1ZXN0GjIub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkRlbGV0ZVRlYW1SZXNwb25zZRJ5CgxTZXRUZWFtUXVvdGESMy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuU2V0VGVhbVF1b3RhUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5TZXRUZWFtUXVvdGFSZXNwb25zZRJ5CgxHZXRUZWFtVXNhZ2USMy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuR2V0VGVhbVVzYWdlUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5HZXRUZWFtVXNhZ2VSZXNwb25zZRKLAQoSTWVyZ2VPcmdhbml6YXRpb25zEjkub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLk1lcmdlT3JnYW5pemF0aW9uc1JlcXVlc3QaOi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuTWVyZ2VPcmdhbml6YXRpb25zUmVzcG9uc2USoAEKGUNyZWF0ZU1hbmFnZWRPcmdhbml6YXRpb24SQC5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQ3JlYXRlTWFuYWdlZE9yZ2FuaXphdGlvblJlcXVlc3QaQS5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQ3JlYXRlTWFuYWdlZE9yZ2FuaXphdGlvblJlc3BvbnNlEp0BChhMaXN0TWFuYWdlZE9yZ2FuaXphdGlvbnMSPy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuTGlzdE1hbmFnZWRPcmdhbml6YXRpb25zUmVxdWVzdBpALm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5MaXN0TWFuYWdlZE9yZ2FuaXphdGlvbnNSZXNwb25zZRKLAQoSQWRkUm9sZUluaGVyaXRhbmNlEjkub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLkFkZFJvbGVJbmhlcml0YW5jZVJlcXVlc3QaOi5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuQWRkUm9sZUluaGVyaXRhbmNlUmVzcG9uc2USlAEKFVJlbW92ZVJvbGVJbmhlcml0YW5jZRI8Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5SZW1vdmVSb2xlSW5oZXJpdGFuY2VSZXF1ZXN0Gj0ub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlJlbW92ZVJvbGVJbmhlcml0YW5jZVJlc3BvbnNlEpoBChdHZXRFZmZlY3RpdmVQZXJtaXNzaW9ucxI+Lm9iaWVudGUuY2xvdWQub3JnYW5pemF0aW9ucy52MS5HZXRFZmZlY3RpdmVQZXJtaXNzaW9uc1JlcXVlc3QaPy5vYmllbnRlLmNsb3VkLm9yZ2FuaXphdGlvbnMudjEuR2V0RWZmZWN0aXZlUGVybWlzc2lvbnNSZXNwb25zZRJzCgpSb2xlc0dyYXBoEjEub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlJvbGVzR3JhcGhSZXF1ZXN0GjIub2JpZW50ZS5jbG91ZC5vcmdhbml6YXRpb25zLnYxLlJvbGVzR3JhcGhSZXNwb25zZUJbWllnaXRodWIuY29tL29iaWVudGUvY2xvdWQvYXBwcy9zaGFyZWQvcHJvdG8vb2JpZW50ZS9jbG91ZC9vcmdhbml6YXRpb25zL3YxO29yZ2FuaXphdGlvbnN2MWIGcHJvdG8z", [file_google_protobuf_timestamp, file_obiente_cloud_auth_v1_auth_service, file_obiente_cloud_common_v1_common]);
 
 /**
  * @generated from message obiente.cloud.organizations.v1.GetUsageRequest
@@ -589,6 +589,13 @@ export type PendingInvite = Message<"obiente.cloud.organizations.v1.PendingInvit
    * @generated from field: string inviter_email = 6;
    */
   inviterEmail: string;
+
+  /**
+   * The invite can no longer be accepted after this time
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 7;
+   */
+  expiresAt?: Timestamp;
 };
 
 /**
@@ -899,6 +906,20 @@ export type Organization = Message<"obiente.cloud.organizations.v1.Organization"
    * @generated from field: int64 total_paid_cents = 13;
    */
   totalPaidCents: bigint;
+
+  /**
+   * Set when status is "merged": the organization everything was moved to
+   *
+   * @generated from field: optional string merged_into_organization_id = 14;
+   */
+  mergedIntoOrganizationId?: string;
+
+  /**
+   * Set for managed organizations: the organization whose plan, quota and billing they use
+   *
+   * @generated from field: optional string parent_organization_id = 15;
+   */
+  parentOrganizationId?: string;
 };
 
 /**
@@ -1334,247 +1355,1555 @@ export const CreditTransactionSchema: GenMessage<CreditTransaction> = /*@__PURE_
   messageDesc(file_obiente_cloud_organizations_v1_organization_service, 42);
 
 /**
- * @generated from message obiente.cloud.organizations.v1.GetMyPermissionsRequest
+ * @generated from message obiente.cloud.organizations.v1.GetOrganizationAuditLogRequest
  */
-export type GetMyPermissionsRequest = Message<"obiente.cloud.organizations.v1.GetMyPermissionsRequest"> & {
+export type GetOrganizationAuditLogRequest = Message<"obiente.cloud.organizations.v1.GetOrganizationAuditLogRequest"> & {
   /**
    * @generated from field: string organization_id = 1;
    */
   organizationId: string;
+
+  /**
+   * Pagination
+   *
+   * @generated from field: int32 page = 2;
+   */
+  page: number;
+
+  /**
+   * @generated from field: int32 per_page = 3;
+   */
+  perPage: number;
 };
 
 /**
- * Describes the message obiente.cloud.organizations.v1.GetMyPermissionsRequest.
- * Use `create(GetMyPermissionsRequestSchema)` to create a new message.
+ * Describes the message obiente.cloud.organizations.v1.GetOrganizationAuditLogRequest.
+ * Use `create(GetOrganizationAuditLogRequestSchema)` to create a new message.
  */
-export const GetMyPermissionsRequestSchema: GenMessage<GetMyPermissionsRequest> = /*@__PURE__*/
+export const GetOrganizationAuditLogRequestSchema: GenMessage<GetOrganizationAuditLogRequest> = /*@__PURE__*/
   messageDesc(file_obiente_cloud_organizations_v1_organization_service, 43);
 
 /**
- * @generated from message obiente.cloud.organizations.v1.GetMyPermissionsResponse
+ * @generated from message obiente.cloud.organizations.v1.GetOrganizationAuditLogResponse
  */
-export type GetMyPermissionsResponse = Message<"obiente.cloud.organizations.v1.GetMyPermissionsResponse"> & {
+export type GetOrganizationAuditLogResponse = Message<"obiente.cloud.organizations.v1.GetOrganizationAuditLogResponse"> & {
   /**
-   * @generated from field: repeated string permissions = 1;
+   * @generated from field: repeated obiente.cloud.organizations.v1.AuditEvent events = 1;
    */
-  permissions: string[];
+  events: AuditEvent[];
+
+  /**
+   * @generated from field: obiente.cloud.common.v1.Pagination pagination = 2;
+   */
+  pagination?: Pagination;
 };
 
 /**
- * Describes the message obiente.cloud.organizations.v1.GetMyPermissionsResponse.
- * Use `create(GetMyPermissionsResponseSchema)` to create a new message.
+ * Describes the message obiente.cloud.organizations.v1.GetOrganizationAuditLogResponse.
+ * Use `create(GetOrganizationAuditLogResponseSchema)` to create a new message.
  */
-export const GetMyPermissionsResponseSchema: GenMessage<GetMyPermissionsResponse> = /*@__PURE__*/
+export const GetOrganizationAuditLogResponseSchema: GenMessage<GetOrganizationAuditLogResponse> = /*@__PURE__*/
   messageDesc(file_obiente_cloud_organizations_v1_organization_service, 44);
 
 /**
- * Request to set the active plan for an organization (superadmin only)
- *
- * @generated from message obiente.cloud.organizations.v1.AdminSetPlanRequest
+ * @generated from message obiente.cloud.organizations.v1.AuditEvent
  */
-export type AdminSetPlanRequest = Message<"obiente.cloud.organizations.v1.AdminSetPlanRequest"> & {
+export type AuditEvent = Message<"obiente.cloud.organizations.v1.AuditEvent"> & {
   /**
-   * @generated from field: string organization_id = 1;
+   * @generated from field: string id = 1;
    */
-  organizationId: string;
+  id: string;
 
   /**
-   * The plan to assign (must match OrganizationPlan.ID)
+   * User ID who performed the action ("system" for automatic actions)
    *
-   * @generated from field: string plan_id = 2;
+   * @generated from field: string actor = 2;
    */
-  planId: string;
+  actor: string;
+
+  /**
+   * RPC method name, e.g. "UpdateOrganization"
+   *
+   * @generated from field: string action = 3;
+   */
+  action: string;
+
+  /**
+   * Service name, e.g. "OrganizationService"
+   *
+   * @generated from field: string service = 4;
+   */
+  service: string;
+
+  /**
+   * @generated from field: optional string resource_type = 5;
+   */
+  resourceType?: string;
+
+  /**
+   * @generated from field: optional string resource_id = 6;
+   */
+  resourceId?: string;
+
+  /**
+   * Changed fields for update actions
+   *
+   * @generated from field: repeated obiente.cloud.organizations.v1.AuditFieldChange diff = 7;
+   */
+  diff: AuditFieldChange[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 8;
+   */
+  timestamp?: Timestamp;
 };
 
 /**
- * Describes the message obiente.cloud.organizations.v1.AdminSetPlanRequest.
- * Use `create(AdminSetPlanRequestSchema)` to create a new message.
+ * Describes the message obiente.cloud.organizations.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
  */
-export const AdminSetPlanRequestSchema: GenMessage<AdminSetPlanRequest> = /*@__PURE__*/
+export const AuditEventSchema: GenMessage<AuditEvent> = /*@__PURE__*/
   messageDesc(file_obiente_cloud_organizations_v1_organization_service, 45);
 
 /**
- * Response for AdminSetPlan
- *
- * @generated from message obiente.cloud.organizations.v1.AdminSetPlanResponse
+ * @generated from message obiente.cloud.organizations.v1.AuditFieldChange
  */
-export type AdminSetPlanResponse = Message<"obiente.cloud.organizations.v1.AdminSetPlanResponse"> & {
+export type AuditFieldChange = Message<"obiente.cloud.organizations.v1.AuditFieldChange"> & {
   /**
-   * @generated from field: obiente.cloud.organizations.v1.Organization organization = 1;
+   * Dotted proto field path, e.g. "name"
+   *
+   * @generated from field: string field = 1;
    */
-  organization?: Organization;
+  field: string;
 
   /**
-   * @generated from field: string plan_id = 2;
+   * JSON encoded values; unset when the field was empty
+   *
+   * @generated from field: optional string old_value = 2;
    */
-  planId: string;
+  oldValue?: string;
+
+  /**
+   * @generated from field: optional string new_value = 3;
+   */
+  newValue?: string;
 };
 
 /**
- * Describes the message obiente.cloud.organizations.v1.AdminSetPlanResponse.
- * Use `create(AdminSetPlanResponseSchema)` to create a new message.
+ * Describes the message obiente.cloud.organizations.v1.AuditFieldChange.
+ * Use `create(AuditFieldChangeSchema)` to create a new message.
  */
-export const AdminSetPlanResponseSchema: GenMessage<AdminSetPlanResponse> = /*@__PURE__*/
+export const AuditFieldChangeSchema: GenMessage<AuditFieldChange> = /*@__PURE__*/
   messageDesc(file_obiente_cloud_organizations_v1_organization_service, 46);
 
 /**
- * @generated from service obiente.cloud.organizations.v1.OrganizationService
+ * @generated from message obiente.cloud.organizations.v1.GetOrganizationActivityFeedRequest
  */
-export const OrganizationService: GenService<{
+export type GetOrganizationActivityFeedRequest = Message<"obiente.cloud.organizations.v1.GetOrganizationActivityFeedRequest"> & {
   /**
-   * Admin: Set the active plan for an organization (superadmin only)
-   *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.AdminSetPlan
+   * @generated from field: string organization_id = 1;
    */
-  adminSetPlan: {
-    methodKind: "unary";
-    input: typeof AdminSetPlanRequestSchema;
-    output: typeof AdminSetPlanResponseSchema;
-  },
+  organizationId: string;
+
   /**
-   * List user's organizations
+   * Return events older than this event, i.e. next_after_id of the previous page; unset for the newest events
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.ListOrganizations
+   * @generated from field: optional string after_id = 2;
    */
-  listOrganizations: {
-    methodKind: "unary";
-    input: typeof ListOrganizationsRequestSchema;
-    output: typeof ListOrganizationsResponseSchema;
-  },
+  afterId?: string;
+
   /**
-   * Create new organization
+   * Events per page (default 50, max 100)
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.CreateOrganization
+   * @generated from field: int32 page_size = 3;
    */
-  createOrganization: {
-    methodKind: "unary";
-    input: typeof CreateOrganizationRequestSchema;
-    output: typeof CreateOrganizationResponseSchema;
-  },
+  pageSize: number;
+
   /**
-   * Get organization details
+   * Only events of this type, e.g. "deployment.created"
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.GetOrganization
+   * @generated from field: optional string event_type = 4;
    */
-  getOrganization: {
-    methodKind: "unary";
-    input: typeof GetOrganizationRequestSchema;
-    output: typeof GetOrganizationResponseSchema;
-  },
+  eventType?: string;
+
   /**
-   * Update organization
+   * Only events performed by this user
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.UpdateOrganization
+   * @generated from field: optional string actor_id = 5;
    */
-  updateOrganization: {
-    methodKind: "unary";
-    input: typeof UpdateOrganizationRequestSchema;
-    output: typeof UpdateOrganizationResponseSchema;
-  },
+  actorId?: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.GetOrganizationActivityFeedRequest.
+ * Use `create(GetOrganizationActivityFeedRequestSchema)` to create a new message.
+ */
+export const GetOrganizationActivityFeedRequestSchema: GenMessage<GetOrganizationActivityFeedRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 47);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.GetOrganizationActivityFeedResponse
+ */
+export type GetOrganizationActivityFeedResponse = Message<"obiente.cloud.organizations.v1.GetOrganizationActivityFeedResponse"> & {
   /**
-   * List organization members
-   *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.ListMembers
+   * @generated from field: repeated obiente.cloud.organizations.v1.ActivityEvent events = 1;
    */
-  listMembers: {
-    methodKind: "unary";
-    input: typeof ListMembersRequestSchema;
-    output: typeof ListMembersResponseSchema;
-  },
+  events: ActivityEvent[];
+
   /**
-   * Invite user to organization
+   * after_id for the next page; unset on the last page
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.InviteMember
+   * @generated from field: optional string next_after_id = 2;
    */
-  inviteMember: {
-    methodKind: "unary";
-    input: typeof InviteMemberRequestSchema;
-    output: typeof InviteMemberResponseSchema;
-  },
+  nextAfterId?: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.GetOrganizationActivityFeedResponse.
+ * Use `create(GetOrganizationActivityFeedResponseSchema)` to create a new message.
+ */
+export const GetOrganizationActivityFeedResponseSchema: GenMessage<GetOrganizationActivityFeedResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 48);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.ActivityEvent
+ */
+export type ActivityEvent = Message<"obiente.cloud.organizations.v1.ActivityEvent"> & {
   /**
-   * Resend invitation email to a pending member
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * "<resource_type>.<created|updated|deleted>", e.g. "member.created"
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.ResendInvite
+   * @generated from field: string event_type = 2;
    */
-  resendInvite: {
-    methodKind: "unary";
-    input: typeof ResendInviteRequestSchema;
-    output: typeof ResendInviteResponseSchema;
-  },
+  eventType: string;
+
   /**
-   * List invites sent to the current user
+   * User ID who performed the change ("system" for automatic changes)
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.ListMyInvites
+   * @generated from field: string actor_id = 3;
    */
-  listMyInvites: {
-    methodKind: "unary";
-    input: typeof ListMyInvitesRequestSchema;
-    output: typeof ListMyInvitesResponseSchema;
-  },
+  actorId: string;
+
   /**
-   * Accept an invitation to join an organization
+   * "deployment", "game_server", "vps", "member", "billing_account", "payment_method" or "spend_alert"
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.AcceptInvite
+   * @generated from field: string resource_type = 4;
    */
-  acceptInvite: {
-    methodKind: "unary";
-    input: typeof AcceptInviteRequestSchema;
-    output: typeof AcceptInviteResponseSchema;
-  },
+  resourceType: string;
+
   /**
-   * Decline an invitation to join an organization
+   * @generated from field: optional string resource_id = 5;
+   */
+  resourceId?: string;
+
+  /**
+   * Event details, e.g. "action" (the RPC method name) and the resource's "name"
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.DeclineInvite
+   * @generated from field: map<string, string> metadata = 6;
    */
-  declineInvite: {
-    methodKind: "unary";
-    input: typeof DeclineInviteRequestSchema;
-    output: typeof DeclineInviteResponseSchema;
-  },
+  metadata: { [key: string]: string };
+
   /**
-   * Update member role/permissions
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.ActivityEvent.
+ * Use `create(ActivityEventSchema)` to create a new message.
+ */
+export const ActivityEventSchema: GenMessage<ActivityEvent> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 49);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.SAMLConfig
+ */
+export type SAMLConfig = Message<"obiente.cloud.organizations.v1.SAMLConfig"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * Identity provider entity ID (issuer)
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.UpdateMember
+   * @generated from field: string entity_id = 2;
    */
-  updateMember: {
-    methodKind: "unary";
-    input: typeof UpdateMemberRequestSchema;
-    output: typeof UpdateMemberResponseSchema;
-  },
+  entityId: string;
+
   /**
-   * Remove member from organization
+   * Identity provider single sign-on URL
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.RemoveMember
+   * @generated from field: string sso_url = 3;
    */
-  removeMember: {
-    methodKind: "unary";
-    input: typeof RemoveMemberRequestSchema;
-    output: typeof RemoveMemberResponseSchema;
-  },
+  ssoUrl: string;
+
   /**
-   * Transfer organization ownership to another member
+   * PEM encoded identity provider signing certificate
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.TransferOwnership
+   * @generated from field: string certificate = 4;
    */
-  transferOwnership: {
-    methodKind: "unary";
-    input: typeof TransferOwnershipRequestSchema;
-    output: typeof TransferOwnershipResponseSchema;
-  },
+  certificate: string;
+
   /**
-   * Get current usage and billing information for the organization
+   * Maps "email", "name", "given_name" and "family_name" to IdP attribute names.
+   * Without an "email" mapping the assertion's NameID is used.
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.GetUsage
+   * @generated from field: map<string, string> attribute_mapping = 5;
    */
-  getUsage: {
-    methodKind: "unary";
-    input: typeof GetUsageRequestSchema;
-    output: typeof GetUsageResponseSchema;
-  },
+  attributeMapping: { [key: string]: string };
+
   /**
-   * Add credits to organization (for regular users/owners)
+   * Service provider URLs to register with the identity provider
    *
-   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.AddCredits
+   * @generated from field: string sp_entity_id = 6;
+   */
+  spEntityId: string;
+
+  /**
+   * @generated from field: string acs_url = 7;
+   */
+  acsUrl: string;
+
+  /**
+   * @generated from field: string metadata_url = 8;
+   */
+  metadataUrl: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 9;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.SAMLConfig.
+ * Use `create(SAMLConfigSchema)` to create a new message.
+ */
+export const SAMLConfigSchema: GenMessage<SAMLConfig> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 50);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.ConfigureSAMLRequest
+ */
+export type ConfigureSAMLRequest = Message<"obiente.cloud.organizations.v1.ConfigureSAMLRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string entity_id = 2;
+   */
+  entityId: string;
+
+  /**
+   * @generated from field: string sso_url = 3;
+   */
+  ssoUrl: string;
+
+  /**
+   * @generated from field: string certificate = 4;
+   */
+  certificate: string;
+
+  /**
+   * @generated from field: map<string, string> attribute_mapping = 5;
+   */
+  attributeMapping: { [key: string]: string };
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.ConfigureSAMLRequest.
+ * Use `create(ConfigureSAMLRequestSchema)` to create a new message.
+ */
+export const ConfigureSAMLRequestSchema: GenMessage<ConfigureSAMLRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 51);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.ConfigureSAMLResponse
+ */
+export type ConfigureSAMLResponse = Message<"obiente.cloud.organizations.v1.ConfigureSAMLResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.SAMLConfig config = 1;
+   */
+  config?: SAMLConfig;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.ConfigureSAMLResponse.
+ * Use `create(ConfigureSAMLResponseSchema)` to create a new message.
+ */
+export const ConfigureSAMLResponseSchema: GenMessage<ConfigureSAMLResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 52);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.GetSAMLConfigRequest
+ */
+export type GetSAMLConfigRequest = Message<"obiente.cloud.organizations.v1.GetSAMLConfigRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.GetSAMLConfigRequest.
+ * Use `create(GetSAMLConfigRequestSchema)` to create a new message.
+ */
+export const GetSAMLConfigRequestSchema: GenMessage<GetSAMLConfigRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 53);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.GetSAMLConfigResponse
+ */
+export type GetSAMLConfigResponse = Message<"obiente.cloud.organizations.v1.GetSAMLConfigResponse"> & {
+  /**
+   * Unset when SAML is not configured
+   *
+   * @generated from field: obiente.cloud.organizations.v1.SAMLConfig config = 1;
+   */
+  config?: SAMLConfig;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.GetSAMLConfigResponse.
+ * Use `create(GetSAMLConfigResponseSchema)` to create a new message.
+ */
+export const GetSAMLConfigResponseSchema: GenMessage<GetSAMLConfigResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 54);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.GetMyPermissionsRequest
+ */
+export type GetMyPermissionsRequest = Message<"obiente.cloud.organizations.v1.GetMyPermissionsRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.GetMyPermissionsRequest.
+ * Use `create(GetMyPermissionsRequestSchema)` to create a new message.
+ */
+export const GetMyPermissionsRequestSchema: GenMessage<GetMyPermissionsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 55);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.GetMyPermissionsResponse
+ */
+export type GetMyPermissionsResponse = Message<"obiente.cloud.organizations.v1.GetMyPermissionsResponse"> & {
+  /**
+   * @generated from field: repeated string permissions = 1;
+   */
+  permissions: string[];
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.GetMyPermissionsResponse.
+ * Use `create(GetMyPermissionsResponseSchema)` to create a new message.
+ */
+export const GetMyPermissionsResponseSchema: GenMessage<GetMyPermissionsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 56);
+
+/**
+ * Request to set the active plan for an organization (superadmin only)
+ *
+ * @generated from message obiente.cloud.organizations.v1.AdminSetPlanRequest
+ */
+export type AdminSetPlanRequest = Message<"obiente.cloud.organizations.v1.AdminSetPlanRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * The plan to assign (must match OrganizationPlan.ID)
+   *
+   * @generated from field: string plan_id = 2;
+   */
+  planId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.AdminSetPlanRequest.
+ * Use `create(AdminSetPlanRequestSchema)` to create a new message.
+ */
+export const AdminSetPlanRequestSchema: GenMessage<AdminSetPlanRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 57);
+
+/**
+ * Response for AdminSetPlan
+ *
+ * @generated from message obiente.cloud.organizations.v1.AdminSetPlanResponse
+ */
+export type AdminSetPlanResponse = Message<"obiente.cloud.organizations.v1.AdminSetPlanResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+
+  /**
+   * @generated from field: string plan_id = 2;
+   */
+  planId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.AdminSetPlanResponse.
+ * Use `create(AdminSetPlanResponseSchema)` to create a new message.
+ */
+export const AdminSetPlanResponseSchema: GenMessage<AdminSetPlanResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 58);
+
+/**
+ * A resource together with all of its tags
+ *
+ * @generated from message obiente.cloud.organizations.v1.TaggedResource
+ */
+export type TaggedResource = Message<"obiente.cloud.organizations.v1.TaggedResource"> & {
+  /**
+   * "deployment", "gameserver" or "vps"
+   *
+   * @generated from field: string resource_type = 1;
+   */
+  resourceType: string;
+
+  /**
+   * @generated from field: string resource_id = 2;
+   */
+  resourceId: string;
+
+  /**
+   * @generated from field: map<string, string> tags = 3;
+   */
+  tags: { [key: string]: string };
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.TaggedResource.
+ * Use `create(TaggedResourceSchema)` to create a new message.
+ */
+export const TaggedResourceSchema: GenMessage<TaggedResource> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 59);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.AddResourceTagRequest
+ */
+export type AddResourceTagRequest = Message<"obiente.cloud.organizations.v1.AddResourceTagRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string resource_type = 2;
+   */
+  resourceType: string;
+
+  /**
+   * @generated from field: string resource_id = 3;
+   */
+  resourceId: string;
+
+  /**
+   * Lowercase letters, digits and dashes, at most 63 characters
+   *
+   * @generated from field: string key = 4;
+   */
+  key: string;
+
+  /**
+   * At most 63 characters
+   *
+   * @generated from field: string value = 5;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.AddResourceTagRequest.
+ * Use `create(AddResourceTagRequestSchema)` to create a new message.
+ */
+export const AddResourceTagRequestSchema: GenMessage<AddResourceTagRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 60);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.AddResourceTagResponse
+ */
+export type AddResourceTagResponse = Message<"obiente.cloud.organizations.v1.AddResourceTagResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.TaggedResource resource = 1;
+   */
+  resource?: TaggedResource;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.AddResourceTagResponse.
+ * Use `create(AddResourceTagResponseSchema)` to create a new message.
+ */
+export const AddResourceTagResponseSchema: GenMessage<AddResourceTagResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 61);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.RemoveResourceTagRequest
+ */
+export type RemoveResourceTagRequest = Message<"obiente.cloud.organizations.v1.RemoveResourceTagRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string resource_type = 2;
+   */
+  resourceType: string;
+
+  /**
+   * @generated from field: string resource_id = 3;
+   */
+  resourceId: string;
+
+  /**
+   * @generated from field: string key = 4;
+   */
+  key: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.RemoveResourceTagRequest.
+ * Use `create(RemoveResourceTagRequestSchema)` to create a new message.
+ */
+export const RemoveResourceTagRequestSchema: GenMessage<RemoveResourceTagRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 62);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.RemoveResourceTagResponse
+ */
+export type RemoveResourceTagResponse = Message<"obiente.cloud.organizations.v1.RemoveResourceTagResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.TaggedResource resource = 1;
+   */
+  resource?: TaggedResource;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.RemoveResourceTagResponse.
+ * Use `create(RemoveResourceTagResponseSchema)` to create a new message.
+ */
+export const RemoveResourceTagResponseSchema: GenMessage<RemoveResourceTagResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 63);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.ListResourcesByTagRequest
+ */
+export type ListResourcesByTagRequest = Message<"obiente.cloud.organizations.v1.ListResourcesByTagRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * Resources must carry every key=value pair
+   *
+   * @generated from field: map<string, string> tags = 2;
+   */
+  tags: { [key: string]: string };
+
+  /**
+   * Only list resources of this type
+   *
+   * @generated from field: optional string resource_type = 3;
+   */
+  resourceType?: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.ListResourcesByTagRequest.
+ * Use `create(ListResourcesByTagRequestSchema)` to create a new message.
+ */
+export const ListResourcesByTagRequestSchema: GenMessage<ListResourcesByTagRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 64);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.ListResourcesByTagResponse
+ */
+export type ListResourcesByTagResponse = Message<"obiente.cloud.organizations.v1.ListResourcesByTagResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.organizations.v1.TaggedResource resources = 1;
+   */
+  resources: TaggedResource[];
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.ListResourcesByTagResponse.
+ * Use `create(ListResourcesByTagResponseSchema)` to create a new message.
+ */
+export const ListResourcesByTagResponseSchema: GenMessage<ListResourcesByTagResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 65);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.Team
+ */
+export type Team = Message<"obiente.cloud.organizations.v1.Team"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string created_by = 4;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: repeated string member_user_ids = 5;
+   */
+  memberUserIds: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.Team.
+ * Use `create(TeamSchema)` to create a new message.
+ */
+export const TeamSchema: GenMessage<Team> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 66);
+
+/**
+ * Limits for the resources tagged with a team. Unset or 0 means no team limit.
+ *
+ * @generated from message obiente.cloud.organizations.v1.TeamQuota
+ */
+export type TeamQuota = Message<"obiente.cloud.organizations.v1.TeamQuota"> & {
+  /**
+   * @generated from field: optional int32 cpu_cores = 1;
+   */
+  cpuCores?: number;
+
+  /**
+   * @generated from field: optional int64 memory_bytes = 2;
+   */
+  memoryBytes?: bigint;
+
+  /**
+   * @generated from field: optional int32 deployments_max = 3;
+   */
+  deploymentsMax?: number;
+
+  /**
+   * @generated from field: optional int32 max_vps_instances = 4;
+   */
+  maxVpsInstances?: number;
+
+  /**
+   * @generated from field: optional int64 bandwidth_bytes_month = 5;
+   */
+  bandwidthBytesMonth?: bigint;
+
+  /**
+   * @generated from field: optional int64 storage_bytes = 6;
+   */
+  storageBytes?: bigint;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.TeamQuota.
+ * Use `create(TeamQuotaSchema)` to create a new message.
+ */
+export const TeamQuotaSchema: GenMessage<TeamQuota> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 67);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.TeamUsage
+ */
+export type TeamUsage = Message<"obiente.cloud.organizations.v1.TeamUsage"> & {
+  /**
+   * @generated from field: int32 deployments = 1;
+   */
+  deployments: number;
+
+  /**
+   * @generated from field: int32 game_servers = 2;
+   */
+  gameServers: number;
+
+  /**
+   * @generated from field: int32 vps_instances = 3;
+   */
+  vpsInstances: number;
+
+  /**
+   * @generated from field: int64 memory_bytes = 4;
+   */
+  memoryBytes: bigint;
+
+  /**
+   * @generated from field: int32 cpu_cores = 5;
+   */
+  cpuCores: number;
+
+  /**
+   * @generated from field: int64 storage_bytes = 6;
+   */
+  storageBytes: bigint;
+
+  /**
+   * @generated from field: int64 bandwidth_bytes = 7;
+   */
+  bandwidthBytes: bigint;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.TeamUsage.
+ * Use `create(TeamUsageSchema)` to create a new message.
+ */
+export const TeamUsageSchema: GenMessage<TeamUsage> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 68);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.CreateTeamRequest
+ */
+export type CreateTeamRequest = Message<"obiente.cloud.organizations.v1.CreateTeamRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Members of the organization to add to the team
+   *
+   * @generated from field: repeated string member_user_ids = 3;
+   */
+  memberUserIds: string[];
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.CreateTeamRequest.
+ * Use `create(CreateTeamRequestSchema)` to create a new message.
+ */
+export const CreateTeamRequestSchema: GenMessage<CreateTeamRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 69);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.CreateTeamResponse
+ */
+export type CreateTeamResponse = Message<"obiente.cloud.organizations.v1.CreateTeamResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.Team team = 1;
+   */
+  team?: Team;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.CreateTeamResponse.
+ * Use `create(CreateTeamResponseSchema)` to create a new message.
+ */
+export const CreateTeamResponseSchema: GenMessage<CreateTeamResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 70);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.DeleteTeamRequest
+ */
+export type DeleteTeamRequest = Message<"obiente.cloud.organizations.v1.DeleteTeamRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string team_id = 2;
+   */
+  teamId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.DeleteTeamRequest.
+ * Use `create(DeleteTeamRequestSchema)` to create a new message.
+ */
+export const DeleteTeamRequestSchema: GenMessage<DeleteTeamRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 71);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.DeleteTeamResponse
+ */
+export type DeleteTeamResponse = Message<"obiente.cloud.organizations.v1.DeleteTeamResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.DeleteTeamResponse.
+ * Use `create(DeleteTeamResponseSchema)` to create a new message.
+ */
+export const DeleteTeamResponseSchema: GenMessage<DeleteTeamResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 72);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.SetTeamQuotaRequest
+ */
+export type SetTeamQuotaRequest = Message<"obiente.cloud.organizations.v1.SetTeamQuotaRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string team_id = 2;
+   */
+  teamId: string;
+
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.TeamQuota quota = 3;
+   */
+  quota?: TeamQuota;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.SetTeamQuotaRequest.
+ * Use `create(SetTeamQuotaRequestSchema)` to create a new message.
+ */
+export const SetTeamQuotaRequestSchema: GenMessage<SetTeamQuotaRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 73);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.SetTeamQuotaResponse
+ */
+export type SetTeamQuotaResponse = Message<"obiente.cloud.organizations.v1.SetTeamQuotaResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.TeamQuota quota = 1;
+   */
+  quota?: TeamQuota;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.SetTeamQuotaResponse.
+ * Use `create(SetTeamQuotaResponseSchema)` to create a new message.
+ */
+export const SetTeamQuotaResponseSchema: GenMessage<SetTeamQuotaResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 74);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.GetTeamUsageRequest
+ */
+export type GetTeamUsageRequest = Message<"obiente.cloud.organizations.v1.GetTeamUsageRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string team_id = 2;
+   */
+  teamId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.GetTeamUsageRequest.
+ * Use `create(GetTeamUsageRequestSchema)` to create a new message.
+ */
+export const GetTeamUsageRequestSchema: GenMessage<GetTeamUsageRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 75);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.GetTeamUsageResponse
+ */
+export type GetTeamUsageResponse = Message<"obiente.cloud.organizations.v1.GetTeamUsageResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.Team team = 1;
+   */
+  team?: Team;
+
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.TeamUsage usage = 2;
+   */
+  usage?: TeamUsage;
+
+  /**
+   * Unset when the team has no quota
+   *
+   * @generated from field: obiente.cloud.organizations.v1.TeamQuota quota = 3;
+   */
+  quota?: TeamQuota;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.GetTeamUsageResponse.
+ * Use `create(GetTeamUsageResponseSchema)` to create a new message.
+ */
+export const GetTeamUsageResponseSchema: GenMessage<GetTeamUsageResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 76);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.MergeOrganizationsRequest
+ */
+export type MergeOrganizationsRequest = Message<"obiente.cloud.organizations.v1.MergeOrganizationsRequest"> & {
+  /**
+   * Organization to merge; it is left empty and marked merged
+   *
+   * @generated from field: string source_organization_id = 1;
+   */
+  sourceOrganizationId: string;
+
+  /**
+   * Organization that receives the resources, credits and members
+   *
+   * @generated from field: string target_organization_id = 2;
+   */
+  targetOrganizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.MergeOrganizationsRequest.
+ * Use `create(MergeOrganizationsRequestSchema)` to create a new message.
+ */
+export const MergeOrganizationsRequestSchema: GenMessage<MergeOrganizationsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 77);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.MergeOrganizationsResponse
+ */
+export type MergeOrganizationsResponse = Message<"obiente.cloud.organizations.v1.MergeOrganizationsResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.Organization target_organization = 1;
+   */
+  targetOrganization?: Organization;
+
+  /**
+   * @generated from field: int32 deployments_moved = 2;
+   */
+  deploymentsMoved: number;
+
+  /**
+   * @generated from field: int32 game_servers_moved = 3;
+   */
+  gameServersMoved: number;
+
+  /**
+   * @generated from field: int32 vps_instances_moved = 4;
+   */
+  vpsInstancesMoved: number;
+
+  /**
+   * @generated from field: int32 members_moved = 5;
+   */
+  membersMoved: number;
+
+  /**
+   * @generated from field: int32 credit_transactions_moved = 6;
+   */
+  creditTransactionsMoved: number;
+
+  /**
+   * Credits in cents added to the target organization's balance
+   *
+   * @generated from field: int64 credits_moved_cents = 7;
+   */
+  creditsMovedCents: bigint;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.MergeOrganizationsResponse.
+ * Use `create(MergeOrganizationsResponseSchema)` to create a new message.
+ */
+export const MergeOrganizationsResponseSchema: GenMessage<MergeOrganizationsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 78);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.CreateManagedOrganizationRequest
+ */
+export type CreateManagedOrganizationRequest = Message<"obiente.cloud.organizations.v1.CreateManagedOrganizationRequest"> & {
+  /**
+   * Parent organization
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Optional: invited as an admin of the new organization and stored as its billing contact
+   *
+   * @generated from field: string billing_email = 3;
+   */
+  billingEmail: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.CreateManagedOrganizationRequest.
+ * Use `create(CreateManagedOrganizationRequestSchema)` to create a new message.
+ */
+export const CreateManagedOrganizationRequestSchema: GenMessage<CreateManagedOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 79);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.CreateManagedOrganizationResponse
+ */
+export type CreateManagedOrganizationResponse = Message<"obiente.cloud.organizations.v1.CreateManagedOrganizationResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.CreateManagedOrganizationResponse.
+ * Use `create(CreateManagedOrganizationResponseSchema)` to create a new message.
+ */
+export const CreateManagedOrganizationResponseSchema: GenMessage<CreateManagedOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 80);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.ListManagedOrganizationsRequest
+ */
+export type ListManagedOrganizationsRequest = Message<"obiente.cloud.organizations.v1.ListManagedOrganizationsRequest"> & {
+  /**
+   * Parent organization
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.ListManagedOrganizationsRequest.
+ * Use `create(ListManagedOrganizationsRequestSchema)` to create a new message.
+ */
+export const ListManagedOrganizationsRequestSchema: GenMessage<ListManagedOrganizationsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 81);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.ListManagedOrganizationsResponse
+ */
+export type ListManagedOrganizationsResponse = Message<"obiente.cloud.organizations.v1.ListManagedOrganizationsResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.organizations.v1.Organization organizations = 1;
+   */
+  organizations: Organization[];
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.ListManagedOrganizationsResponse.
+ * Use `create(ListManagedOrganizationsResponseSchema)` to create a new message.
+ */
+export const ListManagedOrganizationsResponseSchema: GenMessage<ListManagedOrganizationsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 82);
+
+/**
+ * An edge of the role inheritance DAG: the child role inherits the parent role's permissions
+ *
+ * @generated from message obiente.cloud.organizations.v1.RoleInheritanceEdge
+ */
+export type RoleInheritanceEdge = Message<"obiente.cloud.organizations.v1.RoleInheritanceEdge"> & {
+  /**
+   * @generated from field: string child_role_id = 1;
+   */
+  childRoleId: string;
+
+  /**
+   * @generated from field: string parent_role_id = 2;
+   */
+  parentRoleId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.RoleInheritanceEdge.
+ * Use `create(RoleInheritanceEdgeSchema)` to create a new message.
+ */
+export const RoleInheritanceEdgeSchema: GenMessage<RoleInheritanceEdge> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 83);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.AddRoleInheritanceRequest
+ */
+export type AddRoleInheritanceRequest = Message<"obiente.cloud.organizations.v1.AddRoleInheritanceRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string child_role_id = 2;
+   */
+  childRoleId: string;
+
+  /**
+   * @generated from field: string parent_role_id = 3;
+   */
+  parentRoleId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.AddRoleInheritanceRequest.
+ * Use `create(AddRoleInheritanceRequestSchema)` to create a new message.
+ */
+export const AddRoleInheritanceRequestSchema: GenMessage<AddRoleInheritanceRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 84);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.AddRoleInheritanceResponse
+ */
+export type AddRoleInheritanceResponse = Message<"obiente.cloud.organizations.v1.AddRoleInheritanceResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.organizations.v1.RoleInheritanceEdge edge = 1;
+   */
+  edge?: RoleInheritanceEdge;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.AddRoleInheritanceResponse.
+ * Use `create(AddRoleInheritanceResponseSchema)` to create a new message.
+ */
+export const AddRoleInheritanceResponseSchema: GenMessage<AddRoleInheritanceResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 85);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.RemoveRoleInheritanceRequest
+ */
+export type RemoveRoleInheritanceRequest = Message<"obiente.cloud.organizations.v1.RemoveRoleInheritanceRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string child_role_id = 2;
+   */
+  childRoleId: string;
+
+  /**
+   * @generated from field: string parent_role_id = 3;
+   */
+  parentRoleId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.RemoveRoleInheritanceRequest.
+ * Use `create(RemoveRoleInheritanceRequestSchema)` to create a new message.
+ */
+export const RemoveRoleInheritanceRequestSchema: GenMessage<RemoveRoleInheritanceRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 86);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.RemoveRoleInheritanceResponse
+ */
+export type RemoveRoleInheritanceResponse = Message<"obiente.cloud.organizations.v1.RemoveRoleInheritanceResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.RemoveRoleInheritanceResponse.
+ * Use `create(RemoveRoleInheritanceResponseSchema)` to create a new message.
+ */
+export const RemoveRoleInheritanceResponseSchema: GenMessage<RemoveRoleInheritanceResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 87);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.GetEffectivePermissionsRequest
+ */
+export type GetEffectivePermissionsRequest = Message<"obiente.cloud.organizations.v1.GetEffectivePermissionsRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.GetEffectivePermissionsRequest.
+ * Use `create(GetEffectivePermissionsRequestSchema)` to create a new message.
+ */
+export const GetEffectivePermissionsRequestSchema: GenMessage<GetEffectivePermissionsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 88);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.GetEffectivePermissionsResponse
+ */
+export type GetEffectivePermissionsResponse = Message<"obiente.cloud.organizations.v1.GetEffectivePermissionsResponse"> & {
+  /**
+   * @generated from field: repeated string permissions = 1;
+   */
+  permissions: string[];
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.GetEffectivePermissionsResponse.
+ * Use `create(GetEffectivePermissionsResponseSchema)` to create a new message.
+ */
+export const GetEffectivePermissionsResponseSchema: GenMessage<GetEffectivePermissionsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 89);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.RolesGraphRequest
+ */
+export type RolesGraphRequest = Message<"obiente.cloud.organizations.v1.RolesGraphRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.RolesGraphRequest.
+ * Use `create(RolesGraphRequestSchema)` to create a new message.
+ */
+export const RolesGraphRequestSchema: GenMessage<RolesGraphRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 90);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.RoleGraphNode
+ */
+export type RoleGraphNode = Message<"obiente.cloud.organizations.v1.RoleGraphNode"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The role's own permissions
+   *
+   * @generated from field: repeated string permissions = 3;
+   */
+  permissions: string[];
+
+  /**
+   * Including permissions inherited from ancestor roles
+   *
+   * @generated from field: repeated string effective_permissions = 4;
+   */
+  effectivePermissions: string[];
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.RoleGraphNode.
+ * Use `create(RoleGraphNodeSchema)` to create a new message.
+ */
+export const RoleGraphNodeSchema: GenMessage<RoleGraphNode> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 91);
+
+/**
+ * @generated from message obiente.cloud.organizations.v1.RolesGraphResponse
+ */
+export type RolesGraphResponse = Message<"obiente.cloud.organizations.v1.RolesGraphResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.organizations.v1.RoleGraphNode roles = 1;
+   */
+  roles: RoleGraphNode[];
+
+  /**
+   * @generated from field: repeated obiente.cloud.organizations.v1.RoleInheritanceEdge edges = 2;
+   */
+  edges: RoleInheritanceEdge[];
+};
+
+/**
+ * Describes the message obiente.cloud.organizations.v1.RolesGraphResponse.
+ * Use `create(RolesGraphResponseSchema)` to create a new message.
+ */
+export const RolesGraphResponseSchema: GenMessage<RolesGraphResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_organizations_v1_organization_service, 92);
+
+/**
+ * @generated from service obiente.cloud.organizations.v1.OrganizationService
+ */
+export const OrganizationService: GenService<{
+  /**
+   * Admin: Set the active plan for an organization (superadmin only)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.AdminSetPlan
+   */
+  adminSetPlan: {
+    methodKind: "unary";
+    input: typeof AdminSetPlanRequestSchema;
+    output: typeof AdminSetPlanResponseSchema;
+  },
+  /**
+   * List user's organizations
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.ListOrganizations
+   */
+  listOrganizations: {
+    methodKind: "unary";
+    input: typeof ListOrganizationsRequestSchema;
+    output: typeof ListOrganizationsResponseSchema;
+  },
+  /**
+   * Create new organization
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.CreateOrganization
+   */
+  createOrganization: {
+    methodKind: "unary";
+    input: typeof CreateOrganizationRequestSchema;
+    output: typeof CreateOrganizationResponseSchema;
+  },
+  /**
+   * Get organization details
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.GetOrganization
+   */
+  getOrganization: {
+    methodKind: "unary";
+    input: typeof GetOrganizationRequestSchema;
+    output: typeof GetOrganizationResponseSchema;
+  },
+  /**
+   * Update organization
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.UpdateOrganization
+   */
+  updateOrganization: {
+    methodKind: "unary";
+    input: typeof UpdateOrganizationRequestSchema;
+    output: typeof UpdateOrganizationResponseSchema;
+  },
+  /**
+   * List organization members
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.ListMembers
+   */
+  listMembers: {
+    methodKind: "unary";
+    input: typeof ListMembersRequestSchema;
+    output: typeof ListMembersResponseSchema;
+  },
+  /**
+   * Invite user to organization
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.InviteMember
+   */
+  inviteMember: {
+    methodKind: "unary";
+    input: typeof InviteMemberRequestSchema;
+    output: typeof InviteMemberResponseSchema;
+  },
+  /**
+   * Resend invitation email to a pending member
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.ResendInvite
+   */
+  resendInvite: {
+    methodKind: "unary";
+    input: typeof ResendInviteRequestSchema;
+    output: typeof ResendInviteResponseSchema;
+  },
+  /**
+   * List invites sent to the current user
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.ListMyInvites
+   */
+  listMyInvites: {
+    methodKind: "unary";
+    input: typeof ListMyInvitesRequestSchema;
+    output: typeof ListMyInvitesResponseSchema;
+  },
+  /**
+   * Accept an invitation to join an organization
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.AcceptInvite
+   */
+  acceptInvite: {
+    methodKind: "unary";
+    input: typeof AcceptInviteRequestSchema;
+    output: typeof AcceptInviteResponseSchema;
+  },
+  /**
+   * Decline an invitation to join an organization
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.DeclineInvite
+   */
+  declineInvite: {
+    methodKind: "unary";
+    input: typeof DeclineInviteRequestSchema;
+    output: typeof DeclineInviteResponseSchema;
+  },
+  /**
+   * Update member role/permissions
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.UpdateMember
+   */
+  updateMember: {
+    methodKind: "unary";
+    input: typeof UpdateMemberRequestSchema;
+    output: typeof UpdateMemberResponseSchema;
+  },
+  /**
+   * Remove member from organization
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.RemoveMember
+   */
+  removeMember: {
+    methodKind: "unary";
+    input: typeof RemoveMemberRequestSchema;
+    output: typeof RemoveMemberResponseSchema;
+  },
+  /**
+   * Transfer organization ownership to another member
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.TransferOwnership
+   */
+  transferOwnership: {
+    methodKind: "unary";
+    input: typeof TransferOwnershipRequestSchema;
+    output: typeof TransferOwnershipResponseSchema;
+  },
+  /**
+   * Get current usage and billing information for the organization
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.GetUsage
+   */
+  getUsage: {
+    methodKind: "unary";
+    input: typeof GetUsageRequestSchema;
+    output: typeof GetUsageResponseSchema;
+  },
+  /**
+   * Add credits to organization (for regular users/owners)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.AddCredits
    */
   addCredits: {
     methodKind: "unary";
@@ -1621,6 +2950,187 @@ export const OrganizationService: GenService<{
     input: typeof GetMyPermissionsRequestSchema;
     output: typeof GetMyPermissionsResponseSchema;
   },
+  /**
+   * Get the organization's audit trail, including field-level changes for updates (owner/admin only)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.GetOrganizationAuditLog
+   */
+  getOrganizationAuditLog: {
+    methodKind: "unary";
+    input: typeof GetOrganizationAuditLogRequestSchema;
+    output: typeof GetOrganizationAuditLogResponseSchema;
+  },
+  /**
+   * Get the organization's activity feed of changes to its deployments, game servers, VPSes, members and billing, newest first (owner/admin only)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.GetOrganizationActivityFeed
+   */
+  getOrganizationActivityFeed: {
+    methodKind: "unary";
+    input: typeof GetOrganizationActivityFeedRequestSchema;
+    output: typeof GetOrganizationActivityFeedResponseSchema;
+  },
+  /**
+   * Create or replace the organization's SAML 2.0 identity provider (owner/admin only)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.ConfigureSAML
+   */
+  configureSAML: {
+    methodKind: "unary";
+    input: typeof ConfigureSAMLRequestSchema;
+    output: typeof ConfigureSAMLResponseSchema;
+  },
+  /**
+   * Get the organization's SAML 2.0 identity provider and service provider URLs
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.GetSAMLConfig
+   */
+  getSAMLConfig: {
+    methodKind: "unary";
+    input: typeof GetSAMLConfigRequestSchema;
+    output: typeof GetSAMLConfigResponseSchema;
+  },
+  /**
+   * Set a key=value tag on a deployment, game server or VPS, replacing the key's current value
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.AddResourceTag
+   */
+  addResourceTag: {
+    methodKind: "unary";
+    input: typeof AddResourceTagRequestSchema;
+    output: typeof AddResourceTagResponseSchema;
+  },
+  /**
+   * Remove a tag from a deployment, game server or VPS
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.RemoveResourceTag
+   */
+  removeResourceTag: {
+    methodKind: "unary";
+    input: typeof RemoveResourceTagRequestSchema;
+    output: typeof RemoveResourceTagResponseSchema;
+  },
+  /**
+   * List the organization's resources that carry every given tag
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.ListResourcesByTag
+   */
+  listResourcesByTag: {
+    methodKind: "unary";
+    input: typeof ListResourcesByTagRequestSchema;
+    output: typeof ListResourcesByTagResponseSchema;
+  },
+  /**
+   * Create a team of organization members (owner/admin only)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.CreateTeam
+   */
+  createTeam: {
+    methodKind: "unary";
+    input: typeof CreateTeamRequestSchema;
+    output: typeof CreateTeamResponseSchema;
+  },
+  /**
+   * Delete a team with its quota; its resources lose their team tag (owner/admin only)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.DeleteTeam
+   */
+  deleteTeam: {
+    methodKind: "unary";
+    input: typeof DeleteTeamRequestSchema;
+    output: typeof DeleteTeamResponseSchema;
+  },
+  /**
+   * Set the quota for the resources tagged with a team (owner/admin only)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.SetTeamQuota
+   */
+  setTeamQuota: {
+    methodKind: "unary";
+    input: typeof SetTeamQuotaRequestSchema;
+    output: typeof SetTeamQuotaResponseSchema;
+  },
+  /**
+   * Get a team's resource usage and quota
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.GetTeamUsage
+   */
+  getTeamUsage: {
+    methodKind: "unary";
+    input: typeof GetTeamUsageRequestSchema;
+    output: typeof GetTeamUsageResponseSchema;
+  },
+  /**
+   * Admin: Move all resources, credits and members of one organization into another and mark it merged (superadmin only)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.MergeOrganizations
+   */
+  mergeOrganizations: {
+    methodKind: "unary";
+    input: typeof MergeOrganizationsRequestSchema;
+    output: typeof MergeOrganizationsResponseSchema;
+  },
+  /**
+   * Create an organization managed by this one, e.g. for a reseller's customer (owner/admin only).
+   * Managed organizations use the parent's plan and quota, and their charges are billed to the parent.
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.CreateManagedOrganization
+   */
+  createManagedOrganization: {
+    methodKind: "unary";
+    input: typeof CreateManagedOrganizationRequestSchema;
+    output: typeof CreateManagedOrganizationResponseSchema;
+  },
+  /**
+   * List the organizations managed by this one
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.ListManagedOrganizations
+   */
+  listManagedOrganizations: {
+    methodKind: "unary";
+    input: typeof ListManagedOrganizationsRequestSchema;
+    output: typeof ListManagedOrganizationsResponseSchema;
+  },
+  /**
+   * Make a custom role inherit every permission of a parent role; rejects edges that would create a cycle (owner/admin only)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.AddRoleInheritance
+   */
+  addRoleInheritance: {
+    methodKind: "unary";
+    input: typeof AddRoleInheritanceRequestSchema;
+    output: typeof AddRoleInheritanceResponseSchema;
+  },
+  /**
+   * Stop a custom role inheriting from a parent role (owner/admin only)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.RemoveRoleInheritance
+   */
+  removeRoleInheritance: {
+    methodKind: "unary";
+    input: typeof RemoveRoleInheritanceRequestSchema;
+    output: typeof RemoveRoleInheritanceResponseSchema;
+  },
+  /**
+   * Get a member's flattened permissions, including those inherited from ancestor roles (owner/admin, or the member themselves)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.GetEffectivePermissions
+   */
+  getEffectivePermissions: {
+    methodKind: "unary";
+    input: typeof GetEffectivePermissionsRequestSchema;
+    output: typeof GetEffectivePermissionsResponseSchema;
+  },
+  /**
+   * Get the organization's custom roles and their inheritance edges as a DAG for visualization (owner/admin only)
+   *
+   * @generated from rpc obiente.cloud.organizations.v1.OrganizationService.RolesGraph
+   */
+  rolesGraph: {
+    methodKind: "unary";
+    input: typeof RolesGraphRequestSchema;
+    output: typeof RolesGraphResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_obiente_cloud_organizations_v1_organization_service, 0);
 
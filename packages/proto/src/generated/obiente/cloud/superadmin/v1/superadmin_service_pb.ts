@@ -24,7 +24,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file obiente/cloud/superadmin/v1/superadmin_service.proto.
  */
 export const file_obiente_cloud_superadmin_v1_superadmin_service: GenFile = /*@__PURE__*/
-  fileDesc("CjRvYmllbnRlL2Nsb3VkL3N1cGVyYWRtaW4vdjEvc3VwZXJhZG1pbl9zZXJ2aWNlLnByb3RvEhtvYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEiFAoSR2V0T3ZlcnZpZXdSZXF1ZXN0IskEChNHZXRPdmVydmlld1Jlc3BvbnNlEjsKBmNvdW50cxgBIAEoCzIrLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5PdmVydmlld0NvdW50cxJICg1vcmdhbml6YXRpb25zGAIgAygLMjEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLk9yZ2FuaXphdGlvbk92ZXJ2aWV3Ek0KD3BlbmRpbmdfaW52aXRlcxgDIAMoCzI0Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluUGVuZGluZ0ludml0ZRJECgtkZXBsb3ltZW50cxgEIAMoCzIvLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZXBsb3ltZW50T3ZlcnZpZXcSPgoGdXNhZ2VzGAUgAygLMi4ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLk9yZ2FuaXphdGlvblVzYWdlEhcKCmFwaV9jb21taXQYBiABKAlIAIgBARIdChBkYXNoYm9hcmRfY29tbWl0GAcgASgJSAGIAQESHwoSYXBpX2NvbW1pdF9tZXNzYWdlGAggASgJSAKIAQESJQoYZGFzaGJvYXJkX2NvbW1pdF9tZXNzYWdlGAkgASgJSAOIAQFCDQoLX2FwaV9jb21taXRCEwoRX2Rhc2hib2FyZF9jb21taXRCFQoTX2FwaV9jb21taXRfbWVzc2FnZUIbChlfZGFzaGJvYXJkX2NvbW1pdF9tZXNzYWdlInkKDk92ZXJ2aWV3Q291bnRzEhsKE3RvdGFsX29yZ2FuaXphdGlvbnMYASABKAMSFgoOYWN0aXZlX21lbWJlcnMYAiABKAMSFwoPcGVuZGluZ19pbnZpdGVzGAMgASgDEhkKEXRvdGFsX2RlcGxveW1lbnRzGAQgASgDIr4CChRPcmdhbml6YXRpb25PdmVydmlldxIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBHNsdWcYAyABKAkSEwoGZG9tYWluGAQgASgJSACIAQESDAoEcGxhbhgFIAEoCRIOCgZzdGF0dXMYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMbWVtYmVyX2NvdW50GAggASgDEhQKDGludml0ZV9jb3VudBgJIAEoAxIYChBkZXBsb3ltZW50X2NvdW50GAogASgDEhUKCG93bmVyX2lkGAsgASgJSAGIAQESFwoKb3duZXJfbmFtZRgMIAEoCUgCiAEBQgkKB19kb21haW5CCwoJX293bmVyX2lkQg0KC19vd25lcl9uYW1lIosBChdTdXBlcmFkbWluUGVuZGluZ0ludml0ZRIKCgJpZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSDQoFZW1haWwYAyABKAkSDAoEcm9sZRgEIAEoCRIuCgppbnZpdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLPAwoSRGVwbG95bWVudE92ZXJ2aWV3EgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIeChFvcmdhbml6YXRpb25fbmFtZRgDIAEoCUgAiAEBEgwKBG5hbWUYBCABKAkSPgoLZW52aXJvbm1lbnQYBSABKA4yKS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkVudmlyb25tZW50Ej4KBnN0YXR1cxgGIAEoDjIuLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudFN0YXR1cxITCgZkb21haW4YByABKAlIAYgBARIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI0ChBsYXN0X2RlcGxveWVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCghvd25lcl9pZBgKIAEoCUgCiAEBEhcKCm93bmVyX25hbWUYCyABKAlIA4gBAUIUChJfb3JnYW5pemF0aW9uX25hbWVCCQoHX2RvbWFpbkILCglfb3duZXJfaWRCDQoLX293bmVyX25hbWUi/QEKEU9yZ2FuaXphdGlvblVzYWdlEhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIZChFvcmdhbml6YXRpb25fbmFtZRgCIAEoCRINCgVtb250aBgDIAEoCRIYChBjcHVfY29yZV9zZWNvbmRzGAQgASgDEhsKE21lbW9yeV9ieXRlX3NlY29uZHMYBSABKAMSGgoSYmFuZHdpZHRoX3J4X2J5dGVzGAYgASgDEhoKEmJhbmR3aWR0aF90eF9ieXRlcxgHIAEoAxIVCg1zdG9yYWdlX2J5dGVzGAggASgDEh8KF2RlcGxveW1lbnRzX2FjdGl2ZV9wZWFrGAkgASgFIjYKD1F1ZXJ5RE5TUmVxdWVzdBIOCgZkb21haW4YASABKAkSEwoLcmVjb3JkX3R5cGUYAiABKAkiZAoQUXVlcnlETlNSZXNwb25zZRIOCgZkb21haW4YASABKAkSEwoLcmVjb3JkX3R5cGUYAiABKAkSDwoHcmVjb3JkcxgDIAMoCRINCgVlcnJvchgEIAEoCRILCgN0dGwYBSABKAMioQEKFUxpc3RETlNSZWNvcmRzUmVxdWVzdBIaCg1kZXBsb3ltZW50X2lkGAEgASgJSACIAQESHAoPb3JnYW5pemF0aW9uX2lkGAIgASgJSAGIAQESGAoLcmVjb3JkX3R5cGUYAyABKAlIAogBAUIQCg5fZGVwbG95bWVudF9pZEISChBfb3JnYW5pemF0aW9uX2lkQg4KDF9yZWNvcmRfdHlwZSKyAgoJRE5TUmVjb3JkEhMKC3JlY29yZF90eXBlGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSFgoOZ2FtZV9zZXJ2ZXJfaWQYAyABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAQgASgJEhcKD2RlcGxveW1lbnRfbmFtZRgFIAEoCRIYChBnYW1lX3NlcnZlcl9uYW1lGAYgASgJEg4KBmRvbWFpbhgHIAEoCRIUCgxpcF9hZGRyZXNzZXMYCCADKAkSDgoGdGFyZ2V0GAkgASgJEgwKBHBvcnQYCiABKAUSDgoGcmVnaW9uGAsgASgJEg4KBnN0YXR1cxgMIAEoCRIxCg1sYXN0X3Jlc29sdmVkGA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJRChZMaXN0RE5TUmVjb3Jkc1Jlc3BvbnNlEjcKB3JlY29yZHMYASADKAsyJi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRE5TUmVjb3JkIhUKE0dldEROU0NvbmZpZ1JlcXVlc3QiqAIKCUROU0NvbmZpZxITCgt0cmFlZmlrX2lwcxgBIAMoCRJdChV0cmFlZmlrX2lwc19ieV9yZWdpb24YAiADKAsyPi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRE5TQ29uZmlnLlRyYWVmaWtJcHNCeVJlZ2lvbkVudHJ5EhYKDmRuc19zZXJ2ZXJfaXBzGAMgAygJEhAKCGRuc19wb3J0GAQgASgJEhkKEWNhY2hlX3R0bF9zZWNvbmRzGAUgASgDGmIKF1RyYWVmaWtJcHNCeVJlZ2lvbkVudHJ5EgsKA2tleRgBIAEoCRI2CgV2YWx1ZRgCIAEoCzInLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5UcmFlZmlrSVBzOgI4ASIpCgpUcmFlZmlrSVBzEg4KBnJlZ2lvbhgBIAEoCRILCgNpcHMYAiADKAkiTgoUR2V0RE5TQ29uZmlnUmVzcG9uc2USNgoGY29uZmlnGAEgASgLMiYub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkROU0NvbmZpZyKkAQoeTGlzdERlbGVnYXRlZEROU1JlY29yZHNSZXF1ZXN0EhwKD29yZ2FuaXphdGlvbl9pZBgBIAEoCUgAiAEBEhcKCmFwaV9rZXlfaWQYAiABKAlIAYgBARIYCgtyZWNvcmRfdHlwZRgDIAEoCUgCiAEBQhIKEF9vcmdhbml6YXRpb25faWRCDQoLX2FwaV9rZXlfaWRCDgoMX3JlY29yZF90eXBlIrYCChJEZWxlZ2F0ZWRETlNSZWNvcmQSCgoCaWQYASABKAkSDgoGZG9tYWluGAIgASgJEhMKC3JlY29yZF90eXBlGAMgASgJEg8KB3JlY29yZHMYBCADKAkSEgoKc291cmNlX2FwaRgFIAEoCRISCgphcGlfa2V5X2lkGAYgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgHIAEoCRILCgN0dGwYCCABKAMSLgoKZXhwaXJlc19hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMbGFzdF91cGRhdGVkGAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJjCh9MaXN0RGVsZWdhdGVkRE5TUmVjb3Jkc1Jlc3BvbnNlEkAKB3JlY29yZHMYASADKAsyLy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRGVsZWdhdGVkRE5TUmVjb3JkIhgKFkhhc0RlbGVnYXRlZEROU1JlcXVlc3QiYQoXSGFzRGVsZWdhdGVkRE5TUmVzcG9uc2USGQoRaGFzX2RlbGVnYXRlZF9kbnMYASABKAgSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEhIKCmFwaV9rZXlfaWQYAyABKAkiEwoRR2V0UHJpY2luZ1JlcXVlc3QitwEKEkdldFByaWNpbmdSZXNwb25zZRIgChhjcHVfY29zdF9wZXJfY29yZV9zZWNvbmQYASABKAESIwobbWVtb3J5X2Nvc3RfcGVyX2J5dGVfc2Vjb25kGAIgASgBEh8KF2JhbmR3aWR0aF9jb3N0X3Blcl9ieXRlGAMgASgBEiMKG3N0b3JhZ2VfY29zdF9wZXJfYnl0ZV9tb250aBgEIAEoARIUCgxwcmljaW5nX2luZm8YBSABKAkikQEKIENyZWF0ZUROU0RlbGVnYXRpb25BUElLZXlSZXF1ZXN0EhMKC2Rlc2NyaXB0aW9uGAEgASgJEhcKCnNvdXJjZV9hcGkYAiABKAlIAIgBARIcCg9vcmdhbml6YXRpb25faWQYAyABKAlIAYgBAUINCgtfc291cmNlX2FwaUISChBfb3JnYW5pemF0aW9uX2lkIloKIUNyZWF0ZUROU0RlbGVnYXRpb25BUElLZXlSZXNwb25zZRIPCgdhcGlfa2V5GAEgASgJEg8KB21lc3NhZ2UYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkiMwogUmV2b2tlRE5TRGVsZWdhdGlvbkFQSUtleVJlcXVlc3QSDwoHYXBpX2tleRgBIAEoCSJFCiFSZXZva2VETlNEZWxlZ2F0aW9uQVBJS2V5UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIkoKL1Jldm9rZUROU0RlbGVnYXRpb25BUElLZXlGb3JPcmdhbml6YXRpb25SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSJUCjBSZXZva2VETlNEZWxlZ2F0aW9uQVBJS2V5Rm9yT3JnYW5pemF0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIlMKH0xpc3RETlNEZWxlZ2F0aW9uQVBJS2V5c1JlcXVlc3QSHAoPb3JnYW5pemF0aW9uX2lkGAEgASgJSACIAQFCEgoQX29yZ2FuaXphdGlvbl9pZCL6AQoXRE5TRGVsZWdhdGlvbkFQSUtleUluZm8SCgoCaWQYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSEgoKc291cmNlX2FwaRgDIAEoCRIXCg9vcmdhbml6YXRpb25faWQYBCABKAkSEQoJaXNfYWN0aXZlGAUgASgIEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnJldm9rZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEh4KFnN0cmlwZV9zdWJzY3JpcHRpb25faWQYCCABKAkiagogTGlzdEROU0RlbGVnYXRpb25BUElLZXlzUmVzcG9uc2USRgoIYXBpX2tleXMYASADKAsyNC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRE5TRGVsZWdhdGlvbkFQSUtleUluZm8iGgoYR2V0QWJ1c2VEZXRlY3Rpb25SZXF1ZXN0Iv4BChlHZXRBYnVzZURldGVjdGlvblJlc3BvbnNlElUKGHN1c3BpY2lvdXNfb3JnYW5pemF0aW9ucxgBIAMoCzIzLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXNwaWNpb3VzT3JnYW5pemF0aW9uEk4KFXN1c3BpY2lvdXNfYWN0aXZpdGllcxgCIAMoCzIvLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXNwaWNpb3VzQWN0aXZpdHkSOgoHbWV0cmljcxgDIAEoCzIpLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5BYnVzZU1ldHJpY3MiqwIKFlN1c3BpY2lvdXNPcmdhbml6YXRpb24SFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhkKEW9yZ2FuaXphdGlvbl9uYW1lGAIgASgJEg4KBnJlYXNvbhgDIAEoCRISCgpyaXNrX3Njb3JlGAQgASgDEhkKEWNyZWF0ZWRfY291bnRfMjRoGAUgASgDEh4KFmZhaWxlZF9kZXBsb3ltZW50c18yNGgYBiABKAMSGwoTdG90YWxfY3JlZGl0c19zcGVudBgHIAEoAxIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIxCg1sYXN0X2FjdGl2aXR5GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLDAQoSU3VzcGljaW91c0FjdGl2aXR5EgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIZChFvcmdhbml6YXRpb25fbmFtZRgHIAEoCRIVCg1hY3Rpdml0eV90eXBlGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEhAKCHNldmVyaXR5GAUgASgDEi8KC29jY3VycmVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKpAQoMQWJ1c2VNZXRyaWNzEh0KFXRvdGFsX3N1c3BpY2lvdXNfb3JncxgBIAEoAxIWCg5oaWdoX3Jpc2tfb3JncxgCIAEoAxIbChNyYXBpZF9jcmVhdGlvbnNfMjRoGAMgASgDEiMKG2ZhaWxlZF9wYXltZW50X2F0dGVtcHRzXzI0aBgEIAEoAxIgChh1bnVzdWFsX3VzYWdlX3NwaWtlc18yNGgYBSABKAMiZgoYR2V0SW5jb21lT3ZlcnZpZXdSZXF1ZXN0EhcKCnN0YXJ0X2RhdGUYASABKAlIAIgBARIVCghlbmRfZGF0ZRgCIAEoCUgBiAEBQg0KC19zdGFydF9kYXRlQgsKCV9lbmRfZGF0ZSLqAgoZR2V0SW5jb21lT3ZlcnZpZXdSZXNwb25zZRI7CgdzdW1tYXJ5GAEgASgLMioub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkluY29tZVN1bW1hcnkSQgoObW9udGhseV9pbmNvbWUYAiADKAsyKi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTW9udGhseUluY29tZRI/Cg10b3BfY3VzdG9tZXJzGAMgAygLMigub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlRvcEN1c3RvbWVyEkUKDHRyYW5zYWN0aW9ucxgEIAMoCzIvLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5CaWxsaW5nVHJhbnNhY3Rpb24SRAoPcGF5bWVudF9tZXRyaWNzGAUgASgLMisub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlBheW1lbnRNZXRyaWNzItQBCg1JbmNvbWVTdW1tYXJ5EhUKDXRvdGFsX3JldmVudWUYASABKAESIQoZbW9udGhseV9yZWN1cnJpbmdfcmV2ZW51ZRgCIAEoARIfChdhdmVyYWdlX21vbnRobHlfcmV2ZW51ZRgDIAEoARIaChJ0b3RhbF90cmFuc2FjdGlvbnMYBCABKAMSFQoNdG90YWxfcmVmdW5kcxgFIAEoARITCgtuZXRfcmV2ZW51ZRgGIAEoARIgChhlc3RpbWF0ZWRfbW9udGhseV9pbmNvbWUYByABKAEiWwoNTW9udGhseUluY29tZRINCgVtb250aBgBIAEoCRIPCgdyZXZlbnVlGAIgASgBEhkKEXRyYW5zYWN0aW9uX2NvdW50GAMgASgDEg8KB3JlZnVuZHMYBCABKAEi2AEKC1RvcEN1c3RvbWVyEhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIZChFvcmdhbml6YXRpb25fbmFtZRgCIAEoCRIVCg10b3RhbF9yZXZlbnVlGAMgASgBEhkKEXRyYW5zYWN0aW9uX2NvdW50GAQgASgDEjEKDWZpcnN0X3BheW1lbnQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGxhc3RfcGF5bWVudBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi4AIKEkJpbGxpbmdUcmFuc2FjdGlvbhIKCgJpZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSGQoRb3JnYW5pemF0aW9uX25hbWUYAyABKAkSDAoEdHlwZRgEIAEoCRIUCgxhbW91bnRfY2VudHMYBSABKAESEAoIY3VycmVuY3kYBiABKAkSDgoGc3RhdHVzGAcgASgJEh4KEXN0cmlwZV9pbnZvaWNlX2lkGAggASgJSACIAQESJQoYc3RyaXBlX3BheW1lbnRfaW50ZW50X2lkGAkgASgJSAGIAQESEQoEbm90ZRgKIAEoCUgCiAEBEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhQKEl9zdHJpcGVfaW52b2ljZV9pZEIbChlfc3RyaXBlX3BheW1lbnRfaW50ZW50X2lkQgcKBV9ub3RlIq8BCg5QYXltZW50TWV0cmljcxIUCgxzdWNjZXNzX3JhdGUYASABKAESGwoTc3VjY2Vzc2Z1bF9wYXltZW50cxgCIAEoAxIXCg9mYWlsZWRfcGF5bWVudHMYAyABKAMSGAoQcGVuZGluZ19wYXltZW50cxgEIAEoAxIeChZhdmVyYWdlX3BheW1lbnRfYW1vdW50GAUgASgBEhcKD2xhcmdlc3RfcGF5bWVudBgGIAEoASLUAQoWTGlzdEFsbEludm9pY2VzUmVxdWVzdBIcCg9vcmdhbml6YXRpb25faWQYASABKAlIAIgBARITCgZzdGF0dXMYAiABKAlIAYgBARISCgVsaW1pdBgDIAEoBUgCiAEBEhcKCnN0YXJ0X2RhdGUYBCABKAlIA4gBARIVCghlbmRfZGF0ZRgFIAEoCUgEiAEBQhIKEF9vcmdhbml6YXRpb25faWRCCQoHX3N0YXR1c0IICgZfbGltaXRCDQoLX3N0YXJ0X2RhdGVCCwoJX2VuZF9kYXRlIogBChdMaXN0QWxsSW52b2ljZXNSZXNwb25zZRJGCghpbnZvaWNlcxgBIAMoCzI0Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5JbnZvaWNlV2l0aE9yZ2FuaXphdGlvbhIQCghoYXNfbW9yZRgCIAEoCBITCgt0b3RhbF9jb3VudBgDIAEoAyKZAQoXSW52b2ljZVdpdGhPcmdhbml6YXRpb24SMgoHaW52b2ljZRgBIAEoCzIhLm9iaWVudGUuY2xvdWQuYmlsbGluZy52MS5JbnZvaWNlEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIZChFvcmdhbml6YXRpb25fbmFtZRgDIAEoCRIWCg5jdXN0b21lcl9lbWFpbBgEIAEoCSIwChpTZW5kSW52b2ljZVJlbWluZGVyUmVxdWVzdBISCgppbnZvaWNlX2lkGAEgASgJIj8KG1NlbmRJbnZvaWNlUmVtaW5kZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiEgoQTGlzdFBsYW5zUmVxdWVzdCJFChFMaXN0UGxhbnNSZXNwb25zZRIwCgVwbGFucxgBIAMoCzIhLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5QbGFuIqACChFDcmVhdGVQbGFuUmVxdWVzdBIMCgRuYW1lGAEgASgJEhEKCWNwdV9jb3JlcxgCIAEoBRIUCgxtZW1vcnlfYnl0ZXMYAyABKAMSFwoPZGVwbG95bWVudHNfbWF4GAQgASgFEhkKEW1heF92cHNfaW5zdGFuY2VzGAogASgFEh0KFWJhbmR3aWR0aF9ieXRlc19tb250aBgFIAEoAxIVCg1zdG9yYWdlX2J5dGVzGAYgASgDEh0KFW1pbmltdW1fcGF5bWVudF9jZW50cxgHIAEoAxIiChptb250aGx5X2ZyZWVfY3JlZGl0c19jZW50cxgIIAEoAxISCgp0cmlhbF9kYXlzGAsgASgFEhMKC2Rlc2NyaXB0aW9uGAkgASgJIkUKEkNyZWF0ZVBsYW5SZXNwb25zZRIvCgRwbGFuGAEgASgLMiEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlBsYW4iuQQKEVVwZGF0ZVBsYW5SZXF1ZXN0EgoKAmlkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIWCgljcHVfY29yZXMYAyABKAVIAYgBARIZCgxtZW1vcnlfYnl0ZXMYBCABKANIAogBARIcCg9kZXBsb3ltZW50c19tYXgYBSABKAVIA4gBARIeChFtYXhfdnBzX2luc3RhbmNlcxgLIAEoBUgEiAEBEiIKFWJhbmR3aWR0aF9ieXRlc19tb250aBgGIAEoA0gFiAEBEhoKDXN0b3JhZ2VfYnl0ZXMYByABKANIBogBARIiChVtaW5pbXVtX3BheW1lbnRfY2VudHMYCCABKANIB4gBARInChptb250aGx5X2ZyZWVfY3JlZGl0c19jZW50cxgJIAEoA0gIiAEBEhcKCnRyaWFsX2RheXMYDCABKAVICYgBARIYCgtkZXNjcmlwdGlvbhgKIAEoCUgKiAEBQgcKBV9uYW1lQgwKCl9jcHVfY29yZXNCDwoNX21lbW9yeV9ieXRlc0ISChBfZGVwbG95bWVudHNfbWF4QhQKEl9tYXhfdnBzX2luc3RhbmNlc0IYChZfYmFuZHdpZHRoX2J5dGVzX21vbnRoQhAKDl9zdG9yYWdlX2J5dGVzQhgKFl9taW5pbXVtX3BheW1lbnRfY2VudHNCHQobX21vbnRobHlfZnJlZV9jcmVkaXRzX2NlbnRzQg0KC190cmlhbF9kYXlzQg4KDF9kZXNjcmlwdGlvbiJFChJVcGRhdGVQbGFuUmVzcG9uc2USLwoEcGxhbhgBIAEoCzIhLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5QbGFuIh8KEURlbGV0ZVBsYW5SZXF1ZXN0EgoKAmlkGAEgASgJIiUKEkRlbGV0ZVBsYW5SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIp8CCgRQbGFuEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEQoJY3B1X2NvcmVzGAMgASgFEhQKDG1lbW9yeV9ieXRlcxgEIAEoAxIXCg9kZXBsb3ltZW50c19tYXgYBSABKAUSGQoRbWF4X3Zwc19pbnN0YW5jZXMYCyABKAUSHQoVYmFuZHdpZHRoX2J5dGVzX21vbnRoGAYgASgDEhUKDXN0b3JhZ2VfYnl0ZXMYByABKAMSHQoVbWluaW11bV9wYXltZW50X2NlbnRzGAggASgDEiIKGm1vbnRobHlfZnJlZV9jcmVkaXRzX2NlbnRzGAkgASgDEhIKCnRyaWFsX2RheXMYDCABKAUSEwoLZGVzY3JpcHRpb24YCiABKAkiSwofQXNzaWduUGxhblRvT3JnYW5pemF0aW9uUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDwoHcGxhbl9pZBgCIAEoCSJECiBBc3NpZ25QbGFuVG9Pcmdhbml6YXRpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkicgoQTGlzdFVzZXJzUmVxdWVzdBIRCgRwYWdlGAEgASgFSACIAQESFQoIcGVyX3BhZ2UYAiABKAVIAYgBARITCgZzZWFyY2gYAyABKAlIAogBAUIHCgVfcGFnZUILCglfcGVyX3BhZ2VCCQoHX3NlYXJjaCKCAQoRTGlzdFVzZXJzUmVzcG9uc2USNAoFdXNlcnMYASADKAsyJS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXNlckluZm8SNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24iIQoOR2V0VXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSKMAQoPR2V0VXNlclJlc3BvbnNlEjMKBHVzZXIYASABKAsyJS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXNlckluZm8SRAoNb3JnYW5pemF0aW9ucxgCIAMoCzItLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Vc2VyT3JnYW5pemF0aW9uIrgBCiBMaXN0RG9ybWFudFJlc291cmNlT3duZXJzUmVxdWVzdBIRCgRwYWdlGAEgASgFSACIAQESFQoIcGVyX3BhZ2UYAiABKAVIAYgBARITCgZzZWFyY2gYAyABKAlIAogBARIeChFtaW5faW5hY3RpdmVfZGF5cxgEIAEoBUgDiAEBQgcKBV9wYWdlQgsKCV9wZXJfcGFnZUIJCgdfc2VhcmNoQhQKEl9taW5faW5hY3RpdmVfZGF5cyLEAQoWRG9ybWFudFJlc291cmNlU3VtbWFyeRIVCg1kb3JtYW50X3VzZXJzGAEgASgFEhYKDnVzZXJzX3dpdGhfdnBzGAIgASgFEhwKFHVzZXJzX3dpdGhfZGF0YWJhc2VzGAMgASgFEh4KFnVzZXJzX3dpdGhfZGVwbG95bWVudHMYBCABKAUSHwoXdXNlcnNfd2l0aF9nYW1lX3NlcnZlcnMYBSABKAUSHAoUdG90YWxfcmVzZXJ2ZWRfYnl0ZXMYBiABKAMiyQEKG0Rvcm1hbnRSZXNvdXJjZU9yZ2FuaXphdGlvbhIXCg9vcmdhbml6YXRpb25faWQYASABKAkSGQoRb3JnYW5pemF0aW9uX25hbWUYAiABKAkSEQoJdnBzX2NvdW50GAMgASgFEhYKDmRhdGFiYXNlX2NvdW50GAQgASgFEhgKEGRlcGxveW1lbnRfY291bnQYBSABKAUSGQoRZ2FtZV9zZXJ2ZXJfY291bnQYBiABKAUSFgoOcmVzZXJ2ZWRfYnl0ZXMYByABKAMinQQKFERvcm1hbnRSZXNvdXJjZU93bmVyEjMKBHVzZXIYASABKAsyJS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXNlckluZm8SNAoQbGFzdF9hY3Rpdml0eV9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHAoUbGFzdF9hY3Rpdml0eV9zb3VyY2UYAyABKAkSFQoNaW5hY3RpdmVfZGF5cxgEIAEoBRIaChJvcmdhbml6YXRpb25fY291bnQYBSABKAUSEQoJdnBzX2NvdW50GAYgASgFEhYKDmRhdGFiYXNlX2NvdW50GAcgASgFEhgKEGRlcGxveW1lbnRfY291bnQYCCABKAUSGQoRZ2FtZV9zZXJ2ZXJfY291bnQYCSABKAUSHAoUdG90YWxfcmVzZXJ2ZWRfYnl0ZXMYCiABKAMSPAoYbGFzdF9yZXNvdXJjZV9jcmVhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI8ChhsYXN0X3Jlc291cmNlX3VwZGF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEk8KDW9yZ2FuaXphdGlvbnMYDSADKAsyOC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRG9ybWFudFJlc291cmNlT3JnYW5pemF0aW9uIuUBCiFMaXN0RG9ybWFudFJlc291cmNlT3duZXJzUmVzcG9uc2USQQoGb3duZXJzGAEgAygLMjEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkRvcm1hbnRSZXNvdXJjZU93bmVyEjcKCnBhZ2luYXRpb24YAiABKAsyIy5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5QYWdpbmF0aW9uEkQKB3N1bW1hcnkYAyABKAsyMy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRG9ybWFudFJlc291cmNlU3VtbWFyeSKOAgoIVXNlckluZm8SCgoCaWQYASABKAkSDQoFZW1haWwYAiABKAkSDAoEbmFtZRgDIAEoCRIaChJwcmVmZXJyZWRfdXNlcm5hbWUYBCABKAkSDgoGbG9jYWxlGAUgASgJEhYKDmVtYWlsX3ZlcmlmaWVkGAYgASgIEhcKCmF2YXRhcl91cmwYByABKAlIAIgBARIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVyb2xlcxgKIAMoCUINCgtfYXZhdGFyX3VybCKTAQoQVXNlck9yZ2FuaXphdGlvbhIXCg9vcmdhbml6YXRpb25faWQYASABKAkSGQoRb3JnYW5pemF0aW9uX25hbWUYAiABKAkSDAoEcm9sZRgDIAEoCRIOCgZzdGF0dXMYBCABKAkSLQoJam9pbmVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLmAQoRTGlzdEFsbFZQU1JlcXVlc3QSHAoPb3JnYW5pemF0aW9uX2lkGAEgASgJSACIAQESNAoGc3RhdHVzGAIgASgOMh8ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTU3RhdHVzSAGIAQESEQoEcGFnZRgDIAEoBUgCiAEBEhUKCHBlcl9wYWdlGAQgASgFSAOIAQESEwoGc2VhcmNoGAUgASgJSASIAQFCEgoQX29yZ2FuaXphdGlvbl9pZEIJCgdfc3RhdHVzQgcKBV9wYWdlQgsKCV9wZXJfcGFnZUIJCgdfc2VhcmNoIqQBCgtWUFNPdmVydmlldxIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZRIZChFvcmdhbml6YXRpb25fbmFtZRgCIAEoCRIVCghvd25lcl9pZBgDIAEoCUgAiAEBEhcKCm93bmVyX25hbWUYBCABKAlIAYgBAUILCglfb3duZXJfaWRCDQoLX293bmVyX25hbWUijgEKEkxpc3RBbGxWUFNSZXNwb25zZRI/Cg12cHNfaW5zdGFuY2VzGAEgAygLMigub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlZQU092ZXJ2aWV3EjcKCnBhZ2luYXRpb24YAiABKAsyIy5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5QYWdpbmF0aW9uIm8KE0xpc3RWUFNTaXplc1JlcXVlc3QSEwoGcmVnaW9uGAEgASgJSACIAQESIAoTaW5jbHVkZV91bmF2YWlsYWJsZRgCIAEoCEgBiAEBQgkKB19yZWdpb25CFgoUX2luY2x1ZGVfdW5hdmFpbGFibGUiRwoUTGlzdFZQU1NpemVzUmVzcG9uc2USLwoFc2l6ZXMYASADKAsyIC5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5WUFNTaXplIuMBChRDcmVhdGVWUFNTaXplUmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhEKCWNwdV9jb3JlcxgEIAEoBRIUCgxtZW1vcnlfYnl0ZXMYBSABKAMSEgoKZGlza19ieXRlcxgGIAEoAxIdChViYW5kd2lkdGhfYnl0ZXNfbW9udGgYByABKAMSHQoVbWluaW11bV9wYXltZW50X2NlbnRzGAggASgDEhEKCWF2YWlsYWJsZRgJIAEoCBIOCgZyZWdpb24YCiABKAkiRwoVQ3JlYXRlVlBTU2l6ZVJlc3BvbnNlEi4KBHNpemUYASABKAsyIC5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5WUFNTaXplIqQDChRVcGRhdGVWUFNTaXplUmVxdWVzdBIKCgJpZBgBIAEoCRIRCgRuYW1lGAIgASgJSACIAQESGAoLZGVzY3JpcHRpb24YAyABKAlIAYgBARIWCgljcHVfY29yZXMYBCABKAVIAogBARIZCgxtZW1vcnlfYnl0ZXMYBSABKANIA4gBARIXCgpkaXNrX2J5dGVzGAYgASgDSASIAQESIgoVYmFuZHdpZHRoX2J5dGVzX21vbnRoGAcgASgDSAWIAQESIgoVbWluaW11bV9wYXltZW50X2NlbnRzGAggASgDSAaIAQESFgoJYXZhaWxhYmxlGAkgASgISAeIAQESEwoGcmVnaW9uGAogASgJSAiIAQFCBwoFX25hbWVCDgoMX2Rlc2NyaXB0aW9uQgwKCl9jcHVfY29yZXNCDwoNX21lbW9yeV9ieXRlc0INCgtfZGlza19ieXRlc0IYChZfYmFuZHdpZHRoX2J5dGVzX21vbnRoQhgKFl9taW5pbXVtX3BheW1lbnRfY2VudHNCDAoKX2F2YWlsYWJsZUIJCgdfcmVnaW9uIkcKFVVwZGF0ZVZQU1NpemVSZXNwb25zZRIuCgRzaXplGAEgASgLMiAub2JpZW50ZS5jbG91ZC5jb21tb24udjEuVlBTU2l6ZSIiChREZWxldGVWUFNTaXplUmVxdWVzdBIKCgJpZBgBIAEoCSIoChVEZWxldGVWUFNTaXplUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIpChdTdXBlcmFkbWluR2V0VlBTUmVxdWVzdBIOCgZ2cHNfaWQYASABKAkitAEKGFN1cGVyYWRtaW5HZXRWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZRIZChFvcmdhbml6YXRpb25fbmFtZRgCIAEoCRI+CgpjcmVhdGVkX2J5GAMgASgLMiUub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVzZXJJbmZvSACIAQFCDQoLX2NyZWF0ZWRfYnkijgIKGlN1cGVyYWRtaW5SZXNpemVWUFNSZXF1ZXN0Eg4KBnZwc19pZBgBIAEoCRIQCghuZXdfc2l6ZRgCIAEoCRIRCglncm93X2Rpc2sYAyABKAgSFwoPYXBwbHlfY2xvdWRpbml0GAQgASgIEh0KEGN1c3RvbV9jcHVfY29yZXMYBSABKAVIAIgBARIgChNjdXN0b21fbWVtb3J5X2J5dGVzGAYgASgDSAGIAQESHgoRY3VzdG9tX2Rpc2tfYnl0ZXMYByABKANIAogBAUITChFfY3VzdG9tX2NwdV9jb3Jlc0IWChRfY3VzdG9tX21lbW9yeV9ieXRlc0IUChJfY3VzdG9tX2Rpc2tfYnl0ZXMiXgobU3VwZXJhZG1pblJlc2l6ZVZQU1Jlc3BvbnNlEi4KA3ZwcxgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlEg8KB21lc3NhZ2UYAiABKAkiTQobU3VwZXJhZG1pblN1c3BlbmRWUFNSZXF1ZXN0Eg4KBnZwc19pZBgBIAEoCRITCgZyZWFzb24YAiABKAlIAIgBAUIJCgdfcmVhc29uIl8KHFN1cGVyYWRtaW5TdXNwZW5kVlBTUmVzcG9uc2USLgoDdnBzGAEgASgLMiEub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTSW5zdGFuY2USDwoHbWVzc2FnZRgCIAEoCSIvCh1TdXBlcmFkbWluVW5zdXNwZW5kVlBTUmVxdWVzdBIOCgZ2cHNfaWQYASABKAkiYQoeU3VwZXJhZG1pblVuc3VzcGVuZFZQU1Jlc3BvbnNlEi4KA3ZwcxgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlEg8KB21lc3NhZ2UYAiABKAkijQEKI1N1cGVyYWRtaW5VcGRhdGVWUFNDbG91ZEluaXRSZXF1ZXN0Eg4KBnZwc19pZBgBIAEoCRI5CgpjbG91ZF9pbml0GAIgASgLMiUub2JpZW50ZS5jbG91ZC52cHMudjEuQ2xvdWRJbml0Q29uZmlnEhsKE2dyb3dfZGlza19pZl9uZWVkZWQYAyABKAgiZwokU3VwZXJhZG1pblVwZGF0ZVZQU0Nsb3VkSW5pdFJlc3BvbnNlEi4KA3ZwcxgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlEg8KB21lc3NhZ2UYAiABKAkiLwodU3VwZXJhZG1pbkZvcmNlU3RvcFZQU1JlcXVlc3QSDgoGdnBzX2lkGAEgASgJImEKHlN1cGVyYWRtaW5Gb3JjZVN0b3BWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZRIPCgdtZXNzYWdlGAIgASgJIkYKH1N1cGVyYWRtaW5Gb3JjZURlbGV0ZVZQU1JlcXVlc3QSDgoGdnBzX2lkGAEgASgJEhMKC2hhcmRfZGVsZXRlGAIgASgIIkQKIFN1cGVyYWRtaW5Gb3JjZURlbGV0ZVZQU1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSK8AgoeTGlzdFN0cmlwZVdlYmhvb2tFdmVudHNSZXF1ZXN0EhwKD29yZ2FuaXphdGlvbl9pZBgBIAEoCUgAiAEBEhcKCmV2ZW50X3R5cGUYAiABKAlIAYgBARIYCgtjdXN0b21lcl9pZBgDIAEoCUgCiAEBEhwKD3N1YnNjcmlwdGlvbl9pZBgEIAEoCUgDiAEBEhcKCmludm9pY2VfaWQYBSABKAlIBIgBARISCgVsaW1pdBgGIAEoBUgFiAEBEhMKBm9mZnNldBgHIAEoBUgGiAEBQhIKEF9vcmdhbml6YXRpb25faWRCDQoLX2V2ZW50X3R5cGVCDgoMX2N1c3RvbWVyX2lkQhIKEF9zdWJzY3JpcHRpb25faWRCDQoLX2ludm9pY2VfaWRCCAoGX2xpbWl0QgkKB19vZmZzZXQivAMKElN0cmlwZVdlYmhvb2tFdmVudBIKCgJpZBgBIAEoCRISCgpldmVudF90eXBlGAIgASgJEjAKDHByb2Nlc3NlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHAoPb3JnYW5pemF0aW9uX2lkGAUgASgJSACIAQESHgoRb3JnYW5pemF0aW9uX25hbWUYBiABKAlIAYgBARIYCgtjdXN0b21lcl9pZBgHIAEoCUgCiAEBEhwKD3N1YnNjcmlwdGlvbl9pZBgIIAEoCUgDiAEBEhcKCmludm9pY2VfaWQYCSABKAlIBIgBARIgChNjaGVja291dF9zZXNzaW9uX2lkGAogASgJSAWIAQFCEgoQX29yZ2FuaXphdGlvbl9pZEIUChJfb3JnYW5pemF0aW9uX25hbWVCDgoMX2N1c3RvbWVyX2lkQhIKEF9zdWJzY3JpcHRpb25faWRCDQoLX2ludm9pY2VfaWRCFgoUX2NoZWNrb3V0X3Nlc3Npb25faWQidwofTGlzdFN0cmlwZVdlYmhvb2tFdmVudHNSZXNwb25zZRI/CgZldmVudHMYASADKAsyLy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3RyaXBlV2ViaG9va0V2ZW50EhMKC3RvdGFsX2NvdW50GAIgASgDIpoBChBMaXN0Tm9kZXNSZXF1ZXN0EhEKBHJvbGUYASABKAlIAIgBARIZCgxhdmFpbGFiaWxpdHkYAiABKAlIAYgBARITCgZzdGF0dXMYAyABKAlIAogBARITCgZyZWdpb24YBCABKAlIA4gBAUIHCgVfcm9sZUIPCg1fYXZhaWxhYmlsaXR5QgkKB19zdGF0dXNCCQoHX3JlZ2lvbiJJChFMaXN0Tm9kZXNSZXNwb25zZRI0CgVub2RlcxgBIAMoCzIlLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Ob2RlSW5mbyIhCg5HZXROb2RlUmVxdWVzdBIPCgdub2RlX2lkGAEgASgJIkYKD0dldE5vZGVSZXNwb25zZRIzCgRub2RlGAEgASgLMiUub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLk5vZGVJbmZvIrwDChdVcGRhdGVOb2RlQ29uZmlnUmVxdWVzdBIPCgdub2RlX2lkGAEgASgJEhYKCXN1YmRvbWFpbhgCIAEoCUgAiAEBEiYKGXVzZV9ub2RlX3NwZWNpZmljX2RvbWFpbnMYAyABKAhIAYgBARIjChZzZXJ2aWNlX2RvbWFpbl9wYXR0ZXJuGAQgASgJSAKIAQESEwoGcmVnaW9uGAUgASgJSAOIAQESHAoPbWF4X2RlcGxveW1lbnRzGAYgASgFSASIAQESXQoNY3VzdG9tX2xhYmVscxgHIAMoCzJGLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VcGRhdGVOb2RlQ29uZmlnUmVxdWVzdC5DdXN0b21MYWJlbHNFbnRyeRozChFDdXN0b21MYWJlbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQgwKCl9zdWJkb21haW5CHAoaX3VzZV9ub2RlX3NwZWNpZmljX2RvbWFpbnNCGQoXX3NlcnZpY2VfZG9tYWluX3BhdHRlcm5CCQoHX3JlZ2lvbkISChBfbWF4X2RlcGxveW1lbnRzImAKGFVwZGF0ZU5vZGVDb25maWdSZXNwb25zZRIzCgRub2RlGAEgASgLMiUub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLk5vZGVJbmZvEg8KB21lc3NhZ2UYAiABKAki2AMKCE5vZGVJbmZvEgoKAmlkGAEgASgJEhAKCGhvc3RuYW1lGAIgASgJEgoKAmlwGAMgASgJEgwKBHJvbGUYBCABKAkSFAoMYXZhaWxhYmlsaXR5GAUgASgJEg4KBnN0YXR1cxgGIAEoCRIRCgl0b3RhbF9jcHUYByABKAUSFAoMdG90YWxfbWVtb3J5GAggASgDEhAKCHVzZWRfY3B1GAkgASgBEhMKC3VzZWRfbWVtb3J5GAogASgDEhgKEGRlcGxveW1lbnRfY291bnQYCyABKAUSFwoPbWF4X2RlcGxveW1lbnRzGAwgASgFEhMKBnJlZ2lvbhgNIAEoCUgAiAEBEjcKBmNvbmZpZxgOIAEoCzInLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Ob2RlQ29uZmlnEjIKDmxhc3RfaGVhcnRiZWF0GA8gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIJCgdfcmVnaW9uIr8CCgpOb2RlQ29uZmlnEhYKCXN1YmRvbWFpbhgBIAEoCUgAiAEBEiYKGXVzZV9ub2RlX3NwZWNpZmljX2RvbWFpbnMYAiABKAhIAYgBARIjChZzZXJ2aWNlX2RvbWFpbl9wYXR0ZXJuGAMgASgJSAKIAQESUAoNY3VzdG9tX2xhYmVscxgEIAMoCzI5Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Ob2RlQ29uZmlnLkN1c3RvbUxhYmVsc0VudHJ5GjMKEUN1c3RvbUxhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCDAoKX3N1YmRvbWFpbkIcChpfdXNlX25vZGVfc3BlY2lmaWNfZG9tYWluc0IZChdfc2VydmljZV9kb21haW5fcGF0dGVybiIiCiBMaXN0U3VwZXJhZG1pblBlcm1pc3Npb25zUmVxdWVzdCJYCh5TdXBlcmFkbWluUGVybWlzc2lvbkRlZmluaXRpb24SCgoCaWQYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSFQoNcmVzb3VyY2VfdHlwZRgDIAEoCSJ1CiFMaXN0U3VwZXJhZG1pblBlcm1pc3Npb25zUmVzcG9uc2USUAoLcGVybWlzc2lvbnMYASADKAsyOy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblBlcm1pc3Npb25EZWZpbml0aW9uIiMKIUdldE15U3VwZXJhZG1pblBlcm1pc3Npb25zUmVxdWVzdCJVCiJHZXRNeVN1cGVyYWRtaW5QZXJtaXNzaW9uc1Jlc3BvbnNlEhMKC3Blcm1pc3Npb25zGAEgAygJEhoKEmlzX2Z1bGxfc3VwZXJhZG1pbhgCIAEoCCIcChpMaXN0U3VwZXJhZG1pblJvbGVzUmVxdWVzdCJZCg5TdXBlcmFkbWluUm9sZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhgKEHBlcm1pc3Npb25zX2pzb24YBCABKAkiWQobTGlzdFN1cGVyYWRtaW5Sb2xlc1Jlc3BvbnNlEjoKBXJvbGVzGAEgAygLMisub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Sb2xlIloKG0NyZWF0ZVN1cGVyYWRtaW5Sb2xlUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEhgKEHBlcm1pc3Npb25zX2pzb24YAyABKAkiWQocQ3JlYXRlU3VwZXJhZG1pblJvbGVSZXNwb25zZRI5CgRyb2xlGAEgASgLMisub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Sb2xlImYKG1VwZGF0ZVN1cGVyYWRtaW5Sb2xlUmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhgKEHBlcm1pc3Npb25zX2pzb24YBCABKAkiWQocVXBkYXRlU3VwZXJhZG1pblJvbGVSZXNwb25zZRI5CgRyb2xlGAEgASgLMisub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Sb2xlIikKG0RlbGV0ZVN1cGVyYWRtaW5Sb2xlUmVxdWVzdBIKCgJpZBgBIAEoCSIvChxEZWxldGVTdXBlcmFkbWluUm9sZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiIwohTGlzdFN1cGVyYWRtaW5Sb2xlQmluZGluZ3NSZXF1ZXN0IkUKFVN1cGVyYWRtaW5Sb2xlQmluZGluZxIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEg8KB3JvbGVfaWQYAyABKAkiagoiTGlzdFN1cGVyYWRtaW5Sb2xlQmluZGluZ3NSZXNwb25zZRJECghiaW5kaW5ncxgBIAMoCzIyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluUm9sZUJpbmRpbmciRgoiQ3JlYXRlU3VwZXJhZG1pblJvbGVCaW5kaW5nUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEg8KB3JvbGVfaWQYAiABKAkiagojQ3JlYXRlU3VwZXJhZG1pblJvbGVCaW5kaW5nUmVzcG9uc2USQwoHYmluZGluZxgBIAEoCzIyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluUm9sZUJpbmRpbmciMAoiRGVsZXRlU3VwZXJhZG1pblJvbGVCaW5kaW5nUmVxdWVzdBIKCgJpZBgBIAEoCSI2CiNEZWxldGVTdXBlcmFkbWluUm9sZUJpbmRpbmdSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIokBChJTdXNwZW5kVXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRITCgZyZWFzb24YAiABKAlIAIgBARIzCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBQgkKB19yZWFzb25CDQoLX2V4cGlyZXNfYXQiXQoTU3VzcGVuZFVzZXJSZXNwb25zZRI1CgNiYW4YASABKAsyKC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXNlckJhbkluZm8SDwoHbWVzc2FnZRgCIAEoCSInChRVbnN1c3BlbmRVc2VyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIigKFVVuc3VzcGVuZFVzZXJSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIkEKDkJhblVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEwoGcmVhc29uGAIgASgJSACIAQFCCQoHX3JlYXNvbiJZCg9CYW5Vc2VyUmVzcG9uc2USNQoDYmFuGAEgASgLMigub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVzZXJCYW5JbmZvEg8KB21lc3NhZ2UYAiABKAkiIwoQVW5iYW5Vc2VyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIiQKEVVuYmFuVXNlclJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiKgoXR2V0VXNlckJhblN0YXR1c1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSJeChhHZXRVc2VyQmFuU3RhdHVzUmVzcG9uc2USOgoDYmFuGAEgASgLMigub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVzZXJCYW5JbmZvSACIAQFCBgoEX2JhbiLxAQoLVXNlckJhbkluZm8SCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIMCgR0eXBlGAMgASgJEhMKBnJlYXNvbhgEIAEoCUgAiAEBEhEKCWJhbm5lZF9ieRgFIAEoCRItCgliYW5uZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKCmV4cGlyZXNfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESEQoJaXNfYWN0aXZlGAggASgIQgkKB19yZWFzb25CDQoLX2V4cGlyZXNfYXQimQEKGlN1c3BlbmRPcmdhbml6YXRpb25SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRITCgZyZWFzb24YAiABKAlIAIgBARIzCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBQgkKB19yZWFzb25CDQoLX2V4cGlyZXNfYXQiPgobU3VzcGVuZE9yZ2FuaXphdGlvblJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkSDgoGc3RhdHVzGAIgASgJIjcKHFVuc3VzcGVuZE9yZ2FuaXphdGlvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIjAKHVVuc3VzcGVuZE9yZ2FuaXphdGlvblJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiUQoWQmFuT3JnYW5pemF0aW9uUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSEwoGcmVhc29uGAIgASgJSACIAQFCCQoHX3JlYXNvbiI6ChdCYW5Pcmdhbml6YXRpb25SZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJEg4KBnN0YXR1cxgCIAEoCSIzChhVbmJhbk9yZ2FuaXphdGlvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIiwKGVVuYmFuT3JnYW5pemF0aW9uUmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSLfAgoSR2FtZVNlcnZlck92ZXJ2aWV3Ej0KC2dhbWVfc2VydmVyGAEgASgLMigub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyEhkKEW9yZ2FuaXphdGlvbl9uYW1lGAIgASgJEkMKD2NyZWF0ZWRfYnlfdXNlchgDIAEoCzIlLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Vc2VySW5mb0gAiAEBEhQKDGlzX3N1c3BlbmRlZBgEIAEoCBIeChFzdXNwZW5zaW9uX3JlYXNvbhgFIAEoCUgBiAEBEhUKCG93bmVyX2lkGAYgASgJSAKIAQESFwoKb3duZXJfbmFtZRgHIAEoCUgDiAEBQhIKEF9jcmVhdGVkX2J5X3VzZXJCFAoSX3N1c3BlbnNpb25fcmVhc29uQgsKCV9vd25lcl9pZEINCgtfb3duZXJfbmFtZSKpAgoZTGlzdEFsbEdhbWVTZXJ2ZXJzUmVxdWVzdBIcCg9vcmdhbml6YXRpb25faWQYASABKAlIAIgBARJDCgZzdGF0dXMYAiABKA4yLi5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXJTdGF0dXNIAYgBARITCgZzZWFyY2gYAyABKAlIAogBARIRCgRwYWdlGAQgASgFSAOIAQESFQoIcGVyX3BhZ2UYBSABKAVIBIgBARIZCgxmbGFnZ2VkX29ubHkYBiABKAhIBYgBAUISChBfb3JnYW5pemF0aW9uX2lkQgkKB19zdGF0dXNCCQoHX3NlYXJjaEIHCgVfcGFnZUILCglfcGVyX3BhZ2VCDwoNX2ZsYWdnZWRfb25seSKcAQoaTGlzdEFsbEdhbWVTZXJ2ZXJzUmVzcG9uc2USRQoMZ2FtZV9zZXJ2ZXJzGAEgAygLMi8ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdhbWVTZXJ2ZXJPdmVydmlldxI3CgpwYWdpbmF0aW9uGAIgASgLMiMub2JpZW50ZS5jbG91ZC5jb21tb24udjEuUGFnaW5hdGlvbiI4Ch5TdXBlcmFkbWluR2V0R2FtZVNlcnZlclJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkiZwofU3VwZXJhZG1pbkdldEdhbWVTZXJ2ZXJSZXNwb25zZRJECgtnYW1lX3NlcnZlchgBIAEoCzIvLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HYW1lU2VydmVyT3ZlcnZpZXciXAoiU3VwZXJhZG1pblN1c3BlbmRHYW1lU2VydmVyUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRITCgZyZWFzb24YAiABKAlIAIgBAUIJCgdfcmVhc29uInUKI1N1cGVyYWRtaW5TdXNwZW5kR2FtZVNlcnZlclJlc3BvbnNlEj0KC2dhbWVfc2VydmVyGAEgASgLMigub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyEg8KB21lc3NhZ2UYAiABKAkiPgokU3VwZXJhZG1pblVuc3VzcGVuZEdhbWVTZXJ2ZXJSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJIncKJVN1cGVyYWRtaW5VbnN1c3BlbmRHYW1lU2VydmVyUmVzcG9uc2USPQoLZ2FtZV9zZXJ2ZXIYASABKAsyKC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXISDwoHbWVzc2FnZRgCIAEoCSJeCiRTdXBlcmFkbWluRm9yY2VTdG9wR2FtZVNlcnZlclJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSEwoGcmVhc29uGAIgASgJSACIAQFCCQoHX3JlYXNvbiJ3CiVTdXBlcmFkbWluRm9yY2VTdG9wR2FtZVNlcnZlclJlc3BvbnNlEj0KC2dhbWVfc2VydmVyGAEgASgLMigub2JpZW50ZS5jbG91ZC5nYW1lc2VydmVycy52MS5HYW1lU2VydmVyEg8KB21lc3NhZ2UYAiABKAkidQomU3VwZXJhZG1pbkZvcmNlRGVsZXRlR2FtZVNlcnZlclJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkSEwoGcmVhc29uGAIgASgJSACIAQESEwoLaGFyZF9kZWxldGUYAyABKAhCCQoHX3JlYXNvbiJLCidTdXBlcmFkbWluRm9yY2VEZWxldGVHYW1lU2VydmVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJMsBJChFTdXBlcmFkbWluU2VydmljZRJwCgtHZXRPdmVydmlldxIvLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRPdmVydmlld1JlcXVlc3QaMC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0T3ZlcnZpZXdSZXNwb25zZRJnCghRdWVyeUROUxIsLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5RdWVyeUROU1JlcXVlc3QaLS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuUXVlcnlETlNSZXNwb25zZRJ5Cg5MaXN0RE5TUmVjb3JkcxIyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0RE5TUmVjb3Jkc1JlcXVlc3QaMy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdEROU1JlY29yZHNSZXNwb25zZRJzCgxHZXRETlNDb25maWcSMC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0RE5TQ29uZmlnUmVxdWVzdBoxLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRETlNDb25maWdSZXNwb25zZRKUAQoXTGlzdERlbGVnYXRlZEROU1JlY29yZHMSOy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdERlbGVnYXRlZEROU1JlY29yZHNSZXF1ZXN0Gjwub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3REZWxlZ2F0ZWRETlNSZWNvcmRzUmVzcG9uc2USfAoPSGFzRGVsZWdhdGVkRE5TEjMub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkhhc0RlbGVnYXRlZEROU1JlcXVlc3QaNC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuSGFzRGVsZWdhdGVkRE5TUmVzcG9uc2USmgEKGUNyZWF0ZUROU0RlbGVnYXRpb25BUElLZXkSPS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlRE5TRGVsZWdhdGlvbkFQSUtleVJlcXVlc3QaPi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlRE5TRGVsZWdhdGlvbkFQSUtleVJlc3BvbnNlEpcBChhMaXN0RE5TRGVsZWdhdGlvbkFQSUtleXMSPC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdEROU0RlbGVnYXRpb25BUElLZXlzUmVxdWVzdBo9Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0RE5TRGVsZWdhdGlvbkFQSUtleXNSZXNwb25zZRKaAQoZUmV2b2tlRE5TRGVsZWdhdGlvbkFQSUtleRI9Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5SZXZva2VETlNEZWxlZ2F0aW9uQVBJS2V5UmVxdWVzdBo+Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5SZXZva2VETlNEZWxlZ2F0aW9uQVBJS2V5UmVzcG9uc2USxwEKKFJldm9rZUROU0RlbGVnYXRpb25BUElLZXlGb3JPcmdhbml6YXRpb24STC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuUmV2b2tlRE5TRGVsZWdhdGlvbkFQSUtleUZvck9yZ2FuaXphdGlvblJlcXVlc3QaTS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuUmV2b2tlRE5TRGVsZWdhdGlvbkFQSUtleUZvck9yZ2FuaXphdGlvblJlc3BvbnNlEm0KCkdldFByaWNpbmcSLi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0UHJpY2luZ1JlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0UHJpY2luZ1Jlc3BvbnNlEoIBChFHZXRBYnVzZURldGVjdGlvbhI1Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRBYnVzZURldGVjdGlvblJlcXVlc3QaNi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0QWJ1c2VEZXRlY3Rpb25SZXNwb25zZRKCAQoRR2V0SW5jb21lT3ZlcnZpZXcSNS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0SW5jb21lT3ZlcnZpZXdSZXF1ZXN0GjYub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldEluY29tZU92ZXJ2aWV3UmVzcG9uc2USfAoPTGlzdEFsbEludm9pY2VzEjMub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RBbGxJbnZvaWNlc1JlcXVlc3QaNC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdEFsbEludm9pY2VzUmVzcG9uc2USiAEKE1NlbmRJbnZvaWNlUmVtaW5kZXISNy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU2VuZEludm9pY2VSZW1pbmRlclJlcXVlc3QaOC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU2VuZEludm9pY2VSZW1pbmRlclJlc3BvbnNlEmoKCUxpc3RQbGFucxItLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0UGxhbnNSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RQbGFuc1Jlc3BvbnNlEm0KCkNyZWF0ZVBsYW4SLi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlUGxhblJlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlUGxhblJlc3BvbnNlEm0KClVwZGF0ZVBsYW4SLi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXBkYXRlUGxhblJlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXBkYXRlUGxhblJlc3BvbnNlEm0KCkRlbGV0ZVBsYW4SLi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRGVsZXRlUGxhblJlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRGVsZXRlUGxhblJlc3BvbnNlEpcBChhBc3NpZ25QbGFuVG9Pcmdhbml6YXRpb24SPC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQXNzaWduUGxhblRvT3JnYW5pemF0aW9uUmVxdWVzdBo9Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Bc3NpZ25QbGFuVG9Pcmdhbml6YXRpb25SZXNwb25zZRJqCglMaXN0VXNlcnMSLS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdFVzZXJzUmVxdWVzdBouLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0VXNlcnNSZXNwb25zZRJkCgdHZXRVc2VyEisub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldFVzZXJSZXF1ZXN0Giwub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldFVzZXJSZXNwb25zZRKaAQoZTGlzdERvcm1hbnRSZXNvdXJjZU93bmVycxI9Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0RG9ybWFudFJlc291cmNlT3duZXJzUmVxdWVzdBo+Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0RG9ybWFudFJlc291cmNlT3duZXJzUmVzcG9uc2UScAoLU3VzcGVuZFVzZXISLy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VzcGVuZFVzZXJSZXF1ZXN0GjAub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1c3BlbmRVc2VyUmVzcG9uc2USdgoNVW5zdXNwZW5kVXNlchIxLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VbnN1c3BlbmRVc2VyUmVxdWVzdBoyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VbnN1c3BlbmRVc2VyUmVzcG9uc2USZAoHQmFuVXNlchIrLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5CYW5Vc2VyUmVxdWVzdBosLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5CYW5Vc2VyUmVzcG9uc2USagoJVW5iYW5Vc2VyEi0ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVuYmFuVXNlclJlcXVlc3QaLi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVW5iYW5Vc2VyUmVzcG9uc2USfwoQR2V0VXNlckJhblN0YXR1cxI0Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRVc2VyQmFuU3RhdHVzUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRVc2VyQmFuU3RhdHVzUmVzcG9uc2USiAEKE1N1c3BlbmRPcmdhbml6YXRpb24SNy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VzcGVuZE9yZ2FuaXphdGlvblJlcXVlc3QaOC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VzcGVuZE9yZ2FuaXphdGlvblJlc3BvbnNlEo4BChVVbnN1c3BlbmRPcmdhbml6YXRpb24SOS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVW5zdXNwZW5kT3JnYW5pemF0aW9uUmVxdWVzdBo6Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VbnN1c3BlbmRPcmdhbml6YXRpb25SZXNwb25zZRJ8Cg9CYW5Pcmdhbml6YXRpb24SMy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQmFuT3JnYW5pemF0aW9uUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5CYW5Pcmdhbml6YXRpb25SZXNwb25zZRKCAQoRVW5iYW5Pcmdhbml6YXRpb24SNS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVW5iYW5Pcmdhbml6YXRpb25SZXF1ZXN0GjYub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVuYmFuT3JnYW5pemF0aW9uUmVzcG9uc2USbQoKTGlzdEFsbFZQUxIuLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0QWxsVlBTUmVxdWVzdBovLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0QWxsVlBTUmVzcG9uc2USfwoQU3VwZXJhZG1pbkdldFZQUxI0Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluR2V0VlBTUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluR2V0VlBTUmVzcG9uc2USiAEKE1N1cGVyYWRtaW5SZXNpemVWUFMSNy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblJlc2l6ZVZQU1JlcXVlc3QaOC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblJlc2l6ZVZQU1Jlc3BvbnNlEosBChRTdXBlcmFkbWluU3VzcGVuZFZQUxI4Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluU3VzcGVuZFZQU1JlcXVlc3QaOS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblN1c3BlbmRWUFNSZXNwb25zZRKRAQoWU3VwZXJhZG1pblVuc3VzcGVuZFZQUxI6Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluVW5zdXNwZW5kVlBTUmVxdWVzdBo7Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluVW5zdXNwZW5kVlBTUmVzcG9uc2USowEKHFN1cGVyYWRtaW5VcGRhdGVWUFNDbG91ZEluaXQSQC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblVwZGF0ZVZQU0Nsb3VkSW5pdFJlcXVlc3QaQS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblVwZGF0ZVZQU0Nsb3VkSW5pdFJlc3BvbnNlEpEBChZTdXBlcmFkbWluRm9yY2VTdG9wVlBTEjoub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Gb3JjZVN0b3BWUFNSZXF1ZXN0Gjsub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Gb3JjZVN0b3BWUFNSZXNwb25zZRKXAQoYU3VwZXJhZG1pbkZvcmNlRGVsZXRlVlBTEjwub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Gb3JjZURlbGV0ZVZQU1JlcXVlc3QaPS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pbkZvcmNlRGVsZXRlVlBTUmVzcG9uc2UScwoMTGlzdFZQU1NpemVzEjAub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RWUFNTaXplc1JlcXVlc3QaMS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdFZQU1NpemVzUmVzcG9uc2USdgoNQ3JlYXRlVlBTU2l6ZRIxLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5DcmVhdGVWUFNTaXplUmVxdWVzdBoyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5DcmVhdGVWUFNTaXplUmVzcG9uc2USdgoNVXBkYXRlVlBTU2l6ZRIxLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VcGRhdGVWUFNTaXplUmVxdWVzdBoyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VcGRhdGVWUFNTaXplUmVzcG9uc2USdgoNRGVsZXRlVlBTU2l6ZRIxLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZWxldGVWUFNTaXplUmVxdWVzdBoyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZWxldGVWUFNTaXplUmVzcG9uc2UScQoQTGlzdFZQU1B1YmxpY0lQcxItLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RWUFNQdWJsaWNJUHNSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFZQU1B1YmxpY0lQc1Jlc3BvbnNlEnQKEUNyZWF0ZVZQU1B1YmxpY0lQEi4ub2JpZW50ZS5jbG91ZC52cHMudjEuQ3JlYXRlVlBTUHVibGljSVBSZXF1ZXN0Gi8ub2JpZW50ZS5jbG91ZC52cHMudjEuQ3JlYXRlVlBTUHVibGljSVBSZXNwb25zZRJ0ChFVcGRhdGVWUFNQdWJsaWNJUBIuLm9iaWVudGUuY2xvdWQudnBzLnYxLlVwZGF0ZVZQU1B1YmxpY0lQUmVxdWVzdBovLm9iaWVudGUuY2xvdWQudnBzLnYxLlVwZGF0ZVZQU1B1YmxpY0lQUmVzcG9uc2USdAoRRGVsZXRlVlBTUHVibGljSVASLi5vYmllbnRlLmNsb3VkLnZwcy52MS5EZWxldGVWUFNQdWJsaWNJUFJlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnZwcy52MS5EZWxldGVWUFNQdWJsaWNJUFJlc3BvbnNlEnQKEUFzc2lnblZQU1B1YmxpY0lQEi4ub2JpZW50ZS5jbG91ZC52cHMudjEuQXNzaWduVlBTUHVibGljSVBSZXF1ZXN0Gi8ub2JpZW50ZS5jbG91ZC52cHMudjEuQXNzaWduVlBTUHVibGljSVBSZXNwb25zZRJ6ChNVbmFzc2lnblZQU1B1YmxpY0lQEjAub2JpZW50ZS5jbG91ZC52cHMudjEuVW5hc3NpZ25WUFNQdWJsaWNJUFJlcXVlc3QaMS5vYmllbnRlLmNsb3VkLnZwcy52MS5VbmFzc2lnblZQU1B1YmxpY0lQUmVzcG9uc2UScwoMR2V0T3JnTGVhc2VzEjAub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLkdldE9yZ0xlYXNlc1JlcXVlc3QaMS5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2V0T3JnTGVhc2VzUmVzcG9uc2USlAEKF0xpc3RTdHJpcGVXZWJob29rRXZlbnRzEjsub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RTdHJpcGVXZWJob29rRXZlbnRzUmVxdWVzdBo8Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0U3RyaXBlV2ViaG9va0V2ZW50c1Jlc3BvbnNlEmoKCUxpc3ROb2RlcxItLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0Tm9kZXNSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3ROb2Rlc1Jlc3BvbnNlEmQKB0dldE5vZGUSKy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0Tm9kZVJlcXVlc3QaLC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0Tm9kZVJlc3BvbnNlEn8KEFVwZGF0ZU5vZGVDb25maWcSNC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXBkYXRlTm9kZUNvbmZpZ1JlcXVlc3QaNS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXBkYXRlTm9kZUNvbmZpZ1Jlc3BvbnNlEpoBChlMaXN0U3VwZXJhZG1pblBlcm1pc3Npb25zEj0ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RTdXBlcmFkbWluUGVybWlzc2lvbnNSZXF1ZXN0Gj4ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RTdXBlcmFkbWluUGVybWlzc2lvbnNSZXNwb25zZRKdAQoaR2V0TXlTdXBlcmFkbWluUGVybWlzc2lvbnMSPi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0TXlTdXBlcmFkbWluUGVybWlzc2lvbnNSZXF1ZXN0Gj8ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldE15U3VwZXJhZG1pblBlcm1pc3Npb25zUmVzcG9uc2UShQEKEkxpc3RBbGxHYW1lU2VydmVycxI2Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0QWxsR2FtZVNlcnZlcnNSZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RBbGxHYW1lU2VydmVyc1Jlc3BvbnNlEpQBChdTdXBlcmFkbWluR2V0R2FtZVNlcnZlchI7Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluR2V0R2FtZVNlcnZlclJlcXVlc3QaPC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pbkdldEdhbWVTZXJ2ZXJSZXNwb25zZRKgAQobU3VwZXJhZG1pblN1c3BlbmRHYW1lU2VydmVyEj8ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5TdXNwZW5kR2FtZVNlcnZlclJlcXVlc3QaQC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblN1c3BlbmRHYW1lU2VydmVyUmVzcG9uc2USpgEKHVN1cGVyYWRtaW5VbnN1c3BlbmRHYW1lU2VydmVyEkEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5VbnN1c3BlbmRHYW1lU2VydmVyUmVxdWVzdBpCLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluVW5zdXNwZW5kR2FtZVNlcnZlclJlc3BvbnNlEqYBCh1TdXBlcmFkbWluRm9yY2VTdG9wR2FtZVNlcnZlchJBLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluRm9yY2VTdG9wR2FtZVNlcnZlclJlcXVlc3QaQi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pbkZvcmNlU3RvcEdhbWVTZXJ2ZXJSZXNwb25zZRKsAQofU3VwZXJhZG1pbkZvcmNlRGVsZXRlR2FtZVNlcnZlchJDLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluRm9yY2VEZWxldGVHYW1lU2VydmVyUmVxdWVzdBpELm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluRm9yY2VEZWxldGVHYW1lU2VydmVyUmVzcG9uc2USiAEKE0xpc3RTdXBlcmFkbWluUm9sZXMSNy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdFN1cGVyYWRtaW5Sb2xlc1JlcXVlc3QaOC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdFN1cGVyYWRtaW5Sb2xlc1Jlc3BvbnNlEosBChRDcmVhdGVTdXBlcmFkbWluUm9sZRI4Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5DcmVhdGVTdXBlcmFkbWluUm9sZVJlcXVlc3QaOS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlU3VwZXJhZG1pblJvbGVSZXNwb25zZRKLAQoUVXBkYXRlU3VwZXJhZG1pblJvbGUSOC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXBkYXRlU3VwZXJhZG1pblJvbGVSZXF1ZXN0Gjkub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVwZGF0ZVN1cGVyYWRtaW5Sb2xlUmVzcG9uc2USiwEKFERlbGV0ZVN1cGVyYWRtaW5Sb2xlEjgub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkRlbGV0ZVN1cGVyYWRtaW5Sb2xlUmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZWxldGVTdXBlcmFkbWluUm9sZVJlc3BvbnNlEp0BChpMaXN0U3VwZXJhZG1pblJvbGVCaW5kaW5ncxI+Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0U3VwZXJhZG1pblJvbGVCaW5kaW5nc1JlcXVlc3QaPy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdFN1cGVyYWRtaW5Sb2xlQmluZGluZ3NSZXNwb25zZRKgAQobQ3JlYXRlU3VwZXJhZG1pblJvbGVCaW5kaW5nEj8ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkNyZWF0ZVN1cGVyYWRtaW5Sb2xlQmluZGluZ1JlcXVlc3QaQC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlU3VwZXJhZG1pblJvbGVCaW5kaW5nUmVzcG9uc2USoAEKG0RlbGV0ZVN1cGVyYWRtaW5Sb2xlQmluZGluZxI/Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZWxldGVTdXBlcmFkbWluUm9sZUJpbmRpbmdSZXF1ZXN0GkAub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkRlbGV0ZVN1cGVyYWRtaW5Sb2xlQmluZGluZ1Jlc3BvbnNlQlVaU2dpdGh1Yi5jb20vb2JpZW50ZS9jbG91ZC9hcHBzL3NoYXJlZC9wcm90by9vYmllbnRlL2Nsb3VkL3N1cGVyYWRtaW4vdjE7c3VwZXJhZG1pbnYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_obiente_cloud_deployments_v1_deployment_service, file_obiente_cloud_billing_v1_billing_service, file_obiente_cloud_common_v1_common, file_obiente_cloud_vps_v1_vps_service, file_obiente_cloud_vpsgateway_v1_gateway_service, file_obiente_cloud_gameservers_v1_game_server_service]);
+  fileDesc("CjRvYmllbnRlL2Nsb3VkL3N1cGVyYWRtaW4vdjEvc3VwZXJhZG1pbl9zZXJ2aWNlLnByb3RvEhtvYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEiFAoSR2V0T3ZlcnZpZXdSZXF1ZXN0IskEChNHZXRPdmVydmlld1Jlc3BvbnNlEjsKBmNvdW50cxgBIAEoCzIrLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5PdmVydmlld0NvdW50cxJICg1vcmdhbml6YXRpb25zGAIgAygLMjEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLk9yZ2FuaXphdGlvbk92ZXJ2aWV3Ek0KD3BlbmRpbmdfaW52aXRlcxgDIAMoCzI0Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluUGVuZGluZ0ludml0ZRJECgtkZXBsb3ltZW50cxgEIAMoCzIvLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZXBsb3ltZW50T3ZlcnZpZXcSPgoGdXNhZ2VzGAUgAygLMi4ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLk9yZ2FuaXphdGlvblVzYWdlEhcKCmFwaV9jb21taXQYBiABKAlIAIgBARIdChBkYXNoYm9hcmRfY29tbWl0GAcgASgJSAGIAQESHwoSYXBpX2NvbW1pdF9tZXNzYWdlGAggASgJSAKIAQESJQoYZGFzaGJvYXJkX2NvbW1pdF9tZXNzYWdlGAkgASgJSAOIAQFCDQoLX2FwaV9jb21taXRCEwoRX2Rhc2hib2FyZF9jb21taXRCFQoTX2FwaV9jb21taXRfbWVzc2FnZUIbChlfZGFzaGJvYXJkX2NvbW1pdF9tZXNzYWdlInkKDk92ZXJ2aWV3Q291bnRzEhsKE3RvdGFsX29yZ2FuaXphdGlvbnMYASABKAMSFgoOYWN0aXZlX21lbWJlcnMYAiABKAMSFwoPcGVuZGluZ19pbnZpdGVzGAMgASgDEhkKEXRvdGFsX2RlcGxveW1lbnRzGAQgASgDIr4CChRPcmdhbml6YXRpb25PdmVydmlldxIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBHNsdWcYAyABKAkSEwoGZG9tYWluGAQgASgJSACIAQESDAoEcGxhbhgFIAEoCRIOCgZzdGF0dXMYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMbWVtYmVyX2NvdW50GAggASgDEhQKDGludml0ZV9jb3VudBgJIAEoAxIYChBkZXBsb3ltZW50X2NvdW50GAogASgDEhUKCG93bmVyX2lkGAsgASgJSAGIAQESFwoKb3duZXJfbmFtZRgMIAEoCUgCiAEBQgkKB19kb21haW5CCwoJX293bmVyX2lkQg0KC19vd25lcl9uYW1lIosBChdTdXBlcmFkbWluUGVuZGluZ0ludml0ZRIKCgJpZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSDQoFZW1haWwYAyABKAkSDAoEcm9sZRgEIAEoCRIuCgppbnZpdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLPAwoSRGVwbG95bWVudE92ZXJ2aWV3EgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIeChFvcmdhbml6YXRpb25fbmFtZRgDIAEoCUgAiAEBEgwKBG5hbWUYBCABKAkSPgoLZW52aXJvbm1lbnQYBSABKA4yKS5vYmllbnRlLmNsb3VkLmRlcGxveW1lbnRzLnYxLkVudmlyb25tZW50Ej4KBnN0YXR1cxgGIAEoDjIuLm9iaWVudGUuY2xvdWQuZGVwbG95bWVudHMudjEuRGVwbG95bWVudFN0YXR1cxITCgZkb21haW4YByABKAlIAYgBARIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI0ChBsYXN0X2RlcGxveWVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCghvd25lcl9pZBgKIAEoCUgCiAEBEhcKCm93bmVyX25hbWUYCyABKAlIA4gBAUIUChJfb3JnYW5pemF0aW9uX25hbWVCCQoHX2RvbWFpbkILCglfb3duZXJfaWRCDQoLX293bmVyX25hbWUi/QEKEU9yZ2FuaXphdGlvblVzYWdlEhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIZChFvcmdhbml6YXRpb25fbmFtZRgCIAEoCRINCgVtb250aBgDIAEoCRIYChBjcHVfY29yZV9zZWNvbmRzGAQgASgDEhsKE21lbW9yeV9ieXRlX3NlY29uZHMYBSABKAMSGgoSYmFuZHdpZHRoX3J4X2J5dGVzGAYgASgDEhoKEmJhbmR3aWR0aF90eF9ieXRlcxgHIAEoAxIVCg1zdG9yYWdlX2J5dGVzGAggASgDEh8KF2RlcGxveW1lbnRzX2FjdGl2ZV9wZWFrGAkgASgFIjYKD1F1ZXJ5RE5TUmVxdWVzdBIOCgZkb21haW4YASABKAkSEwoLcmVjb3JkX3R5cGUYAiABKAkiZAoQUXVlcnlETlNSZXNwb25zZRIOCgZkb21haW4YASABKAkSEwoLcmVjb3JkX3R5cGUYAiABKAkSDwoHcmVjb3JkcxgDIAMoCRINCgVlcnJvchgEIAEoCRILCgN0dGwYBSABKAMioQEKFUxpc3RETlNSZWNvcmRzUmVxdWVzdBIaCg1kZXBsb3ltZW50X2lkGAEgASgJSACIAQESHAoPb3JnYW5pemF0aW9uX2lkGAIgASgJSAGIAQESGAoLcmVjb3JkX3R5cGUYAyABKAlIAogBAUIQCg5fZGVwbG95bWVudF9pZEISChBfb3JnYW5pemF0aW9uX2lkQg4KDF9yZWNvcmRfdHlwZSKyAgoJRE5TUmVjb3JkEhMKC3JlY29yZF90eXBlGAEgASgJEhUKDWRlcGxveW1lbnRfaWQYAiABKAkSFgoOZ2FtZV9zZXJ2ZXJfaWQYAyABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAQgASgJEhcKD2RlcGxveW1lbnRfbmFtZRgFIAEoCRIYChBnYW1lX3NlcnZlcl9uYW1lGAYgASgJEg4KBmRvbWFpbhgHIAEoCRIUCgxpcF9hZGRyZXNzZXMYCCADKAkSDgoGdGFyZ2V0GAkgASgJEgwKBHBvcnQYCiABKAUSDgoGcmVnaW9uGAsgASgJEg4KBnN0YXR1cxgMIAEoCRIxCg1sYXN0X3Jlc29sdmVkGA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJRChZMaXN0RE5TUmVjb3Jkc1Jlc3BvbnNlEjcKB3JlY29yZHMYASADKAsyJi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRE5TUmVjb3JkIuMBChdMaXN0RE5TUXVlcnlMb2dzUmVxdWVzdBIcCg9vcmdhbml6YXRpb25faWQYASABKAlIAIgBARITCgZkb21haW4YAiABKAlIAYgBARIuCgVzaW5jZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAogBARISCgVsaW1pdBgEIAEoBUgDiAEBEhMKBm9mZnNldBgFIAEoBUgEiAEBQhIKEF9vcmdhbml6YXRpb25faWRCCQoHX2RvbWFpbkIICgZfc2luY2VCCAoGX2xpbWl0QgkKB19vZmZzZXQi2QEKC0ROU1F1ZXJ5TG9nEgoKAmlkGAEgASgJEi0KCXRpbWVzdGFtcBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGZG9tYWluGAMgASgJEhIKCnF1ZXJ5X3R5cGUYBCABKAkSEQoJY2xpZW50X2lwGAUgASgJEg4KBnJlc3VsdBgGIAEoCRIWCg5sYXRlbmN5X21pY3JvcxgHIAEoAxIcCg9vcmdhbml6YXRpb25faWQYCCABKAlIAIgBAUISChBfb3JnYW5pemF0aW9uX2lkImcKGExpc3RETlNRdWVyeUxvZ3NSZXNwb25zZRI2CgRsb2dzGAEgAygLMigub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkROU1F1ZXJ5TG9nEhMKC3RvdGFsX2NvdW50GAIgASgDIjQKEVNwbGl0SG9yaXpvbkVudHJ5EhMKC2NsaWVudF9jaWRyGAEgASgJEgoKAmlwGAIgASgJInYKHENvbmZpZ3VyZVNwbGl0SG9yaXpvblJlcXVlc3QSFQoNZGVwbG95bWVudF9pZBgBIAEoCRI/CgdlbnRyaWVzGAIgAygLMi4ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlNwbGl0SG9yaXpvbkVudHJ5ImAKHUNvbmZpZ3VyZVNwbGl0SG9yaXpvblJlc3BvbnNlEj8KB2VudHJpZXMYASADKAsyLi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3BsaXRIb3Jpem9uRW50cnkiFQoTR2V0RE5TQ29uZmlnUmVxdWVzdCKoAgoJRE5TQ29uZmlnEhMKC3RyYWVmaWtfaXBzGAEgAygJEl0KFXRyYWVmaWtfaXBzX2J5X3JlZ2lvbhgCIAMoCzI+Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5ETlNDb25maWcuVHJhZWZpa0lwc0J5UmVnaW9uRW50cnkSFgoOZG5zX3NlcnZlcl9pcHMYAyADKAkSEAoIZG5zX3BvcnQYBCABKAkSGQoRY2FjaGVfdHRsX3NlY29uZHMYBSABKAMaYgoXVHJhZWZpa0lwc0J5UmVnaW9uRW50cnkSCwoDa2V5GAEgASgJEjYKBXZhbHVlGAIgASgLMicub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlRyYWVmaWtJUHM6AjgBIikKClRyYWVmaWtJUHMSDgoGcmVnaW9uGAEgASgJEgsKA2lwcxgCIAMoCSJOChRHZXRETlNDb25maWdSZXNwb25zZRI2CgZjb25maWcYASABKAsyJi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRE5TQ29uZmlnIqQBCh5MaXN0RGVsZWdhdGVkRE5TUmVjb3Jkc1JlcXVlc3QSHAoPb3JnYW5pemF0aW9uX2lkGAEgASgJSACIAQESFwoKYXBpX2tleV9pZBgCIAEoCUgBiAEBEhgKC3JlY29yZF90eXBlGAMgASgJSAKIAQFCEgoQX29yZ2FuaXphdGlvbl9pZEINCgtfYXBpX2tleV9pZEIOCgxfcmVjb3JkX3R5cGUitgIKEkRlbGVnYXRlZEROU1JlY29yZBIKCgJpZBgBIAEoCRIOCgZkb21haW4YAiABKAkSEwoLcmVjb3JkX3R5cGUYAyABKAkSDwoHcmVjb3JkcxgEIAMoCRISCgpzb3VyY2VfYXBpGAUgASgJEhIKCmFwaV9rZXlfaWQYBiABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAcgASgJEgsKA3R0bBgIIAEoAxIuCgpleHBpcmVzX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxsYXN0X3VwZGF0ZWQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wImMKH0xpc3REZWxlZ2F0ZWRETlNSZWNvcmRzUmVzcG9uc2USQAoHcmVjb3JkcxgBIAMoCzIvLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZWxlZ2F0ZWRETlNSZWNvcmQiGAoWSGFzRGVsZWdhdGVkRE5TUmVxdWVzdCJhChdIYXNEZWxlZ2F0ZWRETlNSZXNwb25zZRIZChFoYXNfZGVsZWdhdGVkX2RucxgBIAEoCBIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEgoKYXBpX2tleV9pZBgDIAEoCSITChFHZXRQcmljaW5nUmVxdWVzdCK3AQoSR2V0UHJpY2luZ1Jlc3BvbnNlEiAKGGNwdV9jb3N0X3Blcl9jb3JlX3NlY29uZBgBIAEoARIjChttZW1vcnlfY29zdF9wZXJfYnl0ZV9zZWNvbmQYAiABKAESHwoXYmFuZHdpZHRoX2Nvc3RfcGVyX2J5dGUYAyABKAESIwobc3RvcmFnZV9jb3N0X3Blcl9ieXRlX21vbnRoGAQgASgBEhQKDHByaWNpbmdfaW5mbxgFIAEoCSKRAQogQ3JlYXRlRE5TRGVsZWdhdGlvbkFQSUtleVJlcXVlc3QSEwoLZGVzY3JpcHRpb24YASABKAkSFwoKc291cmNlX2FwaRgCIAEoCUgAiAEBEhwKD29yZ2FuaXphdGlvbl9pZBgDIAEoCUgBiAEBQg0KC19zb3VyY2VfYXBpQhIKEF9vcmdhbml6YXRpb25faWQiWgohQ3JlYXRlRE5TRGVsZWdhdGlvbkFQSUtleVJlc3BvbnNlEg8KB2FwaV9rZXkYASABKAkSDwoHbWVzc2FnZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCSIzCiBSZXZva2VETlNEZWxlZ2F0aW9uQVBJS2V5UmVxdWVzdBIPCgdhcGlfa2V5GAEgASgJIkUKIVJldm9rZUROU0RlbGVnYXRpb25BUElLZXlSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiSgovUmV2b2tlRE5TRGVsZWdhdGlvbkFQSUtleUZvck9yZ2FuaXphdGlvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIlQKMFJldm9rZUROU0RlbGVnYXRpb25BUElLZXlGb3JPcmdhbml6YXRpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiUwofTGlzdEROU0RlbGVnYXRpb25BUElLZXlzUmVxdWVzdBIcCg9vcmdhbml6YXRpb25faWQYASABKAlIAIgBAUISChBfb3JnYW5pemF0aW9uX2lkIvoBChdETlNEZWxlZ2F0aW9uQVBJS2V5SW5mbxIKCgJpZBgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRISCgpzb3VyY2VfYXBpGAMgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgEIAEoCRIRCglpc19hY3RpdmUYBSABKAgSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKcmV2b2tlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHgoWc3RyaXBlX3N1YnNjcmlwdGlvbl9pZBgIIAEoCSJqCiBMaXN0RE5TRGVsZWdhdGlvbkFQSUtleXNSZXNwb25zZRJGCghhcGlfa2V5cxgBIAMoCzI0Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5ETlNEZWxlZ2F0aW9uQVBJS2V5SW5mbyIaChhHZXRBYnVzZURldGVjdGlvblJlcXVlc3Qi/gEKGUdldEFidXNlRGV0ZWN0aW9uUmVzcG9uc2USVQoYc3VzcGljaW91c19vcmdhbml6YXRpb25zGAEgAygLMjMub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1c3BpY2lvdXNPcmdhbml6YXRpb24STgoVc3VzcGljaW91c19hY3Rpdml0aWVzGAIgAygLMi8ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1c3BpY2lvdXNBY3Rpdml0eRI6CgdtZXRyaWNzGAMgASgLMikub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkFidXNlTWV0cmljcyKrAgoWU3VzcGljaW91c09yZ2FuaXphdGlvbhIXCg9vcmdhbml6YXRpb25faWQYASABKAkSGQoRb3JnYW5pemF0aW9uX25hbWUYAiABKAkSDgoGcmVhc29uGAMgASgJEhIKCnJpc2tfc2NvcmUYBCABKAMSGQoRY3JlYXRlZF9jb3VudF8yNGgYBSABKAMSHgoWZmFpbGVkX2RlcGxveW1lbnRzXzI0aBgGIAEoAxIbChN0b3RhbF9jcmVkaXRzX3NwZW50GAcgASgDEi4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKDWxhc3RfYWN0aXZpdHkYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIsMBChJTdXNwaWNpb3VzQWN0aXZpdHkSCgoCaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEhkKEW9yZ2FuaXphdGlvbl9uYW1lGAcgASgJEhUKDWFjdGl2aXR5X3R5cGUYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSEAoIc2V2ZXJpdHkYBSABKAMSLwoLb2NjdXJyZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIqkBCgxBYnVzZU1ldHJpY3MSHQoVdG90YWxfc3VzcGljaW91c19vcmdzGAEgASgDEhYKDmhpZ2hfcmlza19vcmdzGAIgASgDEhsKE3JhcGlkX2NyZWF0aW9uc18yNGgYAyABKAMSIwobZmFpbGVkX3BheW1lbnRfYXR0ZW1wdHNfMjRoGAQgASgDEiAKGHVudXN1YWxfdXNhZ2Vfc3Bpa2VzXzI0aBgFIAEoAyJmChhHZXRJbmNvbWVPdmVydmlld1JlcXVlc3QSFwoKc3RhcnRfZGF0ZRgBIAEoCUgAiAEBEhUKCGVuZF9kYXRlGAIgASgJSAGIAQFCDQoLX3N0YXJ0X2RhdGVCCwoJX2VuZF9kYXRlIuoCChlHZXRJbmNvbWVPdmVydmlld1Jlc3BvbnNlEjsKB3N1bW1hcnkYASABKAsyKi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuSW5jb21lU3VtbWFyeRJCCg5tb250aGx5X2luY29tZRgCIAMoCzIqLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Nb250aGx5SW5jb21lEj8KDXRvcF9jdXN0b21lcnMYAyADKAsyKC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVG9wQ3VzdG9tZXISRQoMdHJhbnNhY3Rpb25zGAQgAygLMi8ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkJpbGxpbmdUcmFuc2FjdGlvbhJECg9wYXltZW50X21ldHJpY3MYBSABKAsyKy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuUGF5bWVudE1ldHJpY3Mi1AEKDUluY29tZVN1bW1hcnkSFQoNdG90YWxfcmV2ZW51ZRgBIAEoARIhChltb250aGx5X3JlY3VycmluZ19yZXZlbnVlGAIgASgBEh8KF2F2ZXJhZ2VfbW9udGhseV9yZXZlbnVlGAMgASgBEhoKEnRvdGFsX3RyYW5zYWN0aW9ucxgEIAEoAxIVCg10b3RhbF9yZWZ1bmRzGAUgASgBEhMKC25ldF9yZXZlbnVlGAYgASgBEiAKGGVzdGltYXRlZF9tb250aGx5X2luY29tZRgHIAEoASJbCg1Nb250aGx5SW5jb21lEg0KBW1vbnRoGAEgASgJEg8KB3JldmVudWUYAiABKAESGQoRdHJhbnNhY3Rpb25fY291bnQYAyABKAMSDwoHcmVmdW5kcxgEIAEoASLYAQoLVG9wQ3VzdG9tZXISFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhkKEW9yZ2FuaXphdGlvbl9uYW1lGAIgASgJEhUKDXRvdGFsX3JldmVudWUYAyABKAESGQoRdHJhbnNhY3Rpb25fY291bnQYBCABKAMSMQoNZmlyc3RfcGF5bWVudBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMbGFzdF9wYXltZW50GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLgAgoSQmlsbGluZ1RyYW5zYWN0aW9uEgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIZChFvcmdhbml6YXRpb25fbmFtZRgDIAEoCRIMCgR0eXBlGAQgASgJEhQKDGFtb3VudF9jZW50cxgFIAEoARIQCghjdXJyZW5jeRgGIAEoCRIOCgZzdGF0dXMYByABKAkSHgoRc3RyaXBlX2ludm9pY2VfaWQYCCABKAlIAIgBARIlChhzdHJpcGVfcGF5bWVudF9pbnRlbnRfaWQYCSABKAlIAYgBARIRCgRub3RlGAogASgJSAKIAQESLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCFAoSX3N0cmlwZV9pbnZvaWNlX2lkQhsKGV9zdHJpcGVfcGF5bWVudF9pbnRlbnRfaWRCBwoFX25vdGUirwEKDlBheW1lbnRNZXRyaWNzEhQKDHN1Y2Nlc3NfcmF0ZRgBIAEoARIbChNzdWNjZXNzZnVsX3BheW1lbnRzGAIgASgDEhcKD2ZhaWxlZF9wYXltZW50cxgDIAEoAxIYChBwZW5kaW5nX3BheW1lbnRzGAQgASgDEh4KFmF2ZXJhZ2VfcGF5bWVudF9hbW91bnQYBSABKAESFwoPbGFyZ2VzdF9wYXltZW50GAYgASgBItQBChZMaXN0QWxsSW52b2ljZXNSZXF1ZXN0EhwKD29yZ2FuaXphdGlvbl9pZBgBIAEoCUgAiAEBEhMKBnN0YXR1cxgCIAEoCUgBiAEBEhIKBWxpbWl0GAMgASgFSAKIAQESFwoKc3RhcnRfZGF0ZRgEIAEoCUgDiAEBEhUKCGVuZF9kYXRlGAUgASgJSASIAQFCEgoQX29yZ2FuaXphdGlvbl9pZEIJCgdfc3RhdHVzQggKBl9saW1pdEINCgtfc3RhcnRfZGF0ZUILCglfZW5kX2RhdGUiiAEKF0xpc3RBbGxJbnZvaWNlc1Jlc3BvbnNlEkYKCGludm9pY2VzGAEgAygLMjQub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkludm9pY2VXaXRoT3JnYW5pemF0aW9uEhAKCGhhc19tb3JlGAIgASgIEhMKC3RvdGFsX2NvdW50GAMgASgDIpkBChdJbnZvaWNlV2l0aE9yZ2FuaXphdGlvbhIyCgdpbnZvaWNlGAEgASgLMiEub2JpZW50ZS5jbG91ZC5iaWxsaW5nLnYxLkludm9pY2USFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEhkKEW9yZ2FuaXphdGlvbl9uYW1lGAMgASgJEhYKDmN1c3RvbWVyX2VtYWlsGAQgASgJIjAKGlNlbmRJbnZvaWNlUmVtaW5kZXJSZXF1ZXN0EhIKCmludm9pY2VfaWQYASABKAkiPwobU2VuZEludm9pY2VSZW1pbmRlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSISChBMaXN0UGxhbnNSZXF1ZXN0IkUKEUxpc3RQbGFuc1Jlc3BvbnNlEjAKBXBsYW5zGAEgAygLMiEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlBsYW4itgIKEUNyZWF0ZVBsYW5SZXF1ZXN0EgwKBG5hbWUYASABKAkSEQoJY3B1X2NvcmVzGAIgASgFEhQKDG1lbW9yeV9ieXRlcxgDIAEoAxIXCg9kZXBsb3ltZW50c19tYXgYBCABKAUSGQoRbWF4X3Zwc19pbnN0YW5jZXMYCiABKAUSHQoVYmFuZHdpZHRoX2J5dGVzX21vbnRoGAUgASgDEhUKDXN0b3JhZ2VfYnl0ZXMYBiABKAMSHQoVbWluaW11bV9wYXltZW50X2NlbnRzGAcgASgDEiIKGm1vbnRobHlfZnJlZV9jcmVkaXRzX2NlbnRzGAggASgDEhIKCnRyaWFsX2RheXMYCyABKAUSFAoMbWF4X3JlcGxpY2FzGAwgASgFEhMKC2Rlc2NyaXB0aW9uGAkgASgJIkUKEkNyZWF0ZVBsYW5SZXNwb25zZRIvCgRwbGFuGAEgASgLMiEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlBsYW4i5QQKEVVwZGF0ZVBsYW5SZXF1ZXN0EgoKAmlkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIWCgljcHVfY29yZXMYAyABKAVIAYgBARIZCgxtZW1vcnlfYnl0ZXMYBCABKANIAogBARIcCg9kZXBsb3ltZW50c19tYXgYBSABKAVIA4gBARIeChFtYXhfdnBzX2luc3RhbmNlcxgLIAEoBUgEiAEBEiIKFWJhbmR3aWR0aF9ieXRlc19tb250aBgGIAEoA0gFiAEBEhoKDXN0b3JhZ2VfYnl0ZXMYByABKANIBogBARIiChVtaW5pbXVtX3BheW1lbnRfY2VudHMYCCABKANIB4gBARInChptb250aGx5X2ZyZWVfY3JlZGl0c19jZW50cxgJIAEoA0gIiAEBEhcKCnRyaWFsX2RheXMYDCABKAVICYgBARIZCgxtYXhfcmVwbGljYXMYDSABKAVICogBARIYCgtkZXNjcmlwdGlvbhgKIAEoCUgLiAEBQgcKBV9uYW1lQgwKCl9jcHVfY29yZXNCDwoNX21lbW9yeV9ieXRlc0ISChBfZGVwbG95bWVudHNfbWF4QhQKEl9tYXhfdnBzX2luc3RhbmNlc0IYChZfYmFuZHdpZHRoX2J5dGVzX21vbnRoQhAKDl9zdG9yYWdlX2J5dGVzQhgKFl9taW5pbXVtX3BheW1lbnRfY2VudHNCHQobX21vbnRobHlfZnJlZV9jcmVkaXRzX2NlbnRzQg0KC190cmlhbF9kYXlzQg8KDV9tYXhfcmVwbGljYXNCDgoMX2Rlc2NyaXB0aW9uIkUKElVwZGF0ZVBsYW5SZXNwb25zZRIvCgRwbGFuGAEgASgLMiEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlBsYW4iHwoRRGVsZXRlUGxhblJlcXVlc3QSCgoCaWQYASABKAkiJQoSRGVsZXRlUGxhblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgitQIKBFBsYW4SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIRCgljcHVfY29yZXMYAyABKAUSFAoMbWVtb3J5X2J5dGVzGAQgASgDEhcKD2RlcGxveW1lbnRzX21heBgFIAEoBRIZChFtYXhfdnBzX2luc3RhbmNlcxgLIAEoBRIdChViYW5kd2lkdGhfYnl0ZXNfbW9udGgYBiABKAMSFQoNc3RvcmFnZV9ieXRlcxgHIAEoAxIdChVtaW5pbXVtX3BheW1lbnRfY2VudHMYCCABKAMSIgoabW9udGhseV9mcmVlX2NyZWRpdHNfY2VudHMYCSABKAMSEgoKdHJpYWxfZGF5cxgMIAEoBRIUCgxtYXhfcmVwbGljYXMYDSABKAUSEwoLZGVzY3JpcHRpb24YCiABKAkiSwofQXNzaWduUGxhblRvT3JnYW5pemF0aW9uUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDwoHcGxhbl9pZBgCIAEoCSJECiBBc3NpZ25QbGFuVG9Pcmdhbml6YXRpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkicgoQTGlzdFVzZXJzUmVxdWVzdBIRCgRwYWdlGAEgASgFSACIAQESFQoIcGVyX3BhZ2UYAiABKAVIAYgBARITCgZzZWFyY2gYAyABKAlIAogBAUIHCgVfcGFnZUILCglfcGVyX3BhZ2VCCQoHX3NlYXJjaCKCAQoRTGlzdFVzZXJzUmVzcG9uc2USNAoFdXNlcnMYASADKAsyJS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXNlckluZm8SNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24iIQoOR2V0VXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSKMAQoPR2V0VXNlclJlc3BvbnNlEjMKBHVzZXIYASABKAsyJS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXNlckluZm8SRAoNb3JnYW5pemF0aW9ucxgCIAMoCzItLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Vc2VyT3JnYW5pemF0aW9uIrgBCiBMaXN0RG9ybWFudFJlc291cmNlT3duZXJzUmVxdWVzdBIRCgRwYWdlGAEgASgFSACIAQESFQoIcGVyX3BhZ2UYAiABKAVIAYgBARITCgZzZWFyY2gYAyABKAlIAogBARIeChFtaW5faW5hY3RpdmVfZGF5cxgEIAEoBUgDiAEBQgcKBV9wYWdlQgsKCV9wZXJfcGFnZUIJCgdfc2VhcmNoQhQKEl9taW5faW5hY3RpdmVfZGF5cyLEAQoWRG9ybWFudFJlc291cmNlU3VtbWFyeRIVCg1kb3JtYW50X3VzZXJzGAEgASgFEhYKDnVzZXJzX3dpdGhfdnBzGAIgASgFEhwKFHVzZXJzX3dpdGhfZGF0YWJhc2VzGAMgASgFEh4KFnVzZXJzX3dpdGhfZGVwbG95bWVudHMYBCABKAUSHwoXdXNlcnNfd2l0aF9nYW1lX3NlcnZlcnMYBSABKAUSHAoUdG90YWxfcmVzZXJ2ZWRfYnl0ZXMYBiABKAMiyQEKG0Rvcm1hbnRSZXNvdXJjZU9yZ2FuaXphdGlvbhIXCg9vcmdhbml6YXRpb25faWQYASABKAkSGQoRb3JnYW5pemF0aW9uX25hbWUYAiABKAkSEQoJdnBzX2NvdW50GAMgASgFEhYKDmRhdGFiYXNlX2NvdW50GAQgASgFEhgKEGRlcGxveW1lbnRfY291bnQYBSABKAUSGQoRZ2FtZV9zZXJ2ZXJfY291bnQYBiABKAUSFgoOcmVzZXJ2ZWRfYnl0ZXMYByABKAMinQQKFERvcm1hbnRSZXNvdXJjZU93bmVyEjMKBHVzZXIYASABKAsyJS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXNlckluZm8SNAoQbGFzdF9hY3Rpdml0eV9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHAoUbGFzdF9hY3Rpdml0eV9zb3VyY2UYAyABKAkSFQoNaW5hY3RpdmVfZGF5cxgEIAEoBRIaChJvcmdhbml6YXRpb25fY291bnQYBSABKAUSEQoJdnBzX2NvdW50GAYgASgFEhYKDmRhdGFiYXNlX2NvdW50GAcgASgFEhgKEGRlcGxveW1lbnRfY291bnQYCCABKAUSGQoRZ2FtZV9zZXJ2ZXJfY291bnQYCSABKAUSHAoUdG90YWxfcmVzZXJ2ZWRfYnl0ZXMYCiABKAMSPAoYbGFzdF9yZXNvdXJjZV9jcmVhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI8ChhsYXN0X3Jlc291cmNlX3VwZGF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEk8KDW9yZ2FuaXphdGlvbnMYDSADKAsyOC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRG9ybWFudFJlc291cmNlT3JnYW5pemF0aW9uIuUBCiFMaXN0RG9ybWFudFJlc291cmNlT3duZXJzUmVzcG9uc2USQQoGb3duZXJzGAEgAygLMjEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkRvcm1hbnRSZXNvdXJjZU93bmVyEjcKCnBhZ2luYXRpb24YAiABKAsyIy5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5QYWdpbmF0aW9uEkQKB3N1bW1hcnkYAyABKAsyMy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRG9ybWFudFJlc291cmNlU3VtbWFyeSKOAgoIVXNlckluZm8SCgoCaWQYASABKAkSDQoFZW1haWwYAiABKAkSDAoEbmFtZRgDIAEoCRIaChJwcmVmZXJyZWRfdXNlcm5hbWUYBCABKAkSDgoGbG9jYWxlGAUgASgJEhYKDmVtYWlsX3ZlcmlmaWVkGAYgASgIEhcKCmF2YXRhcl91cmwYByABKAlIAIgBARIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVyb2xlcxgKIAMoCUINCgtfYXZhdGFyX3VybCKTAQoQVXNlck9yZ2FuaXphdGlvbhIXCg9vcmdhbml6YXRpb25faWQYASABKAkSGQoRb3JnYW5pemF0aW9uX25hbWUYAiABKAkSDAoEcm9sZRgDIAEoCRIOCgZzdGF0dXMYBCABKAkSLQoJam9pbmVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLmAQoRTGlzdEFsbFZQU1JlcXVlc3QSHAoPb3JnYW5pemF0aW9uX2lkGAEgASgJSACIAQESNAoGc3RhdHVzGAIgASgOMh8ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTU3RhdHVzSAGIAQESEQoEcGFnZRgDIAEoBUgCiAEBEhUKCHBlcl9wYWdlGAQgASgFSAOIAQESEwoGc2VhcmNoGAUgASgJSASIAQFCEgoQX29yZ2FuaXphdGlvbl9pZEIJCgdfc3RhdHVzQgcKBV9wYWdlQgsKCV9wZXJfcGFnZUIJCgdfc2VhcmNoIqQBCgtWUFNPdmVydmlldxIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZRIZChFvcmdhbml6YXRpb25fbmFtZRgCIAEoCRIVCghvd25lcl9pZBgDIAEoCUgAiAEBEhcKCm93bmVyX25hbWUYBCABKAlIAYgBAUILCglfb3duZXJfaWRCDQoLX293bmVyX25hbWUijgEKEkxpc3RBbGxWUFNSZXNwb25zZRI/Cg12cHNfaW5zdGFuY2VzGAEgAygLMigub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlZQU092ZXJ2aWV3EjcKCnBhZ2luYXRpb24YAiABKAsyIy5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5QYWdpbmF0aW9uIm8KE0xpc3RWUFNTaXplc1JlcXVlc3QSEwoGcmVnaW9uGAEgASgJSACIAQESIAoTaW5jbHVkZV91bmF2YWlsYWJsZRgCIAEoCEgBiAEBQgkKB19yZWdpb25CFgoUX2luY2x1ZGVfdW5hdmFpbGFibGUiRwoUTGlzdFZQU1NpemVzUmVzcG9uc2USLwoFc2l6ZXMYASADKAsyIC5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5WUFNTaXplIuMBChRDcmVhdGVWUFNTaXplUmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhEKCWNwdV9jb3JlcxgEIAEoBRIUCgxtZW1vcnlfYnl0ZXMYBSABKAMSEgoKZGlza19ieXRlcxgGIAEoAxIdChViYW5kd2lkdGhfYnl0ZXNfbW9udGgYByABKAMSHQoVbWluaW11bV9wYXltZW50X2NlbnRzGAggASgDEhEKCWF2YWlsYWJsZRgJIAEoCBIOCgZyZWdpb24YCiABKAkiRwoVQ3JlYXRlVlBTU2l6ZVJlc3BvbnNlEi4KBHNpemUYASABKAsyIC5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5WUFNTaXplIqQDChRVcGRhdGVWUFNTaXplUmVxdWVzdBIKCgJpZBgBIAEoCRIRCgRuYW1lGAIgASgJSACIAQESGAoLZGVzY3JpcHRpb24YAyABKAlIAYgBARIWCgljcHVfY29yZXMYBCABKAVIAogBARIZCgxtZW1vcnlfYnl0ZXMYBSABKANIA4gBARIXCgpkaXNrX2J5dGVzGAYgASgDSASIAQESIgoVYmFuZHdpZHRoX2J5dGVzX21vbnRoGAcgASgDSAWIAQESIgoVbWluaW11bV9wYXltZW50X2NlbnRzGAggASgDSAaIAQESFgoJYXZhaWxhYmxlGAkgASgISAeIAQESEwoGcmVnaW9uGAogASgJSAiIAQFCBwoFX25hbWVCDgoMX2Rlc2NyaXB0aW9uQgwKCl9jcHVfY29yZXNCDwoNX21lbW9yeV9ieXRlc0INCgtfZGlza19ieXRlc0IYChZfYmFuZHdpZHRoX2J5dGVzX21vbnRoQhgKFl9taW5pbXVtX3BheW1lbnRfY2VudHNCDAoKX2F2YWlsYWJsZUIJCgdfcmVnaW9uIkcKFVVwZGF0ZVZQU1NpemVSZXNwb25zZRIuCgRzaXplGAEgASgLMiAub2JpZW50ZS5jbG91ZC5jb21tb24udjEuVlBTU2l6ZSIiChREZWxldGVWUFNTaXplUmVxdWVzdBIKCgJpZBgBIAEoCSIoChVEZWxldGVWUFNTaXplUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIpChdTdXBlcmFkbWluR2V0VlBTUmVxdWVzdBIOCgZ2cHNfaWQYASABKAkitAEKGFN1cGVyYWRtaW5HZXRWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZRIZChFvcmdhbml6YXRpb25fbmFtZRgCIAEoCRI+CgpjcmVhdGVkX2J5GAMgASgLMiUub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVzZXJJbmZvSACIAQFCDQoLX2NyZWF0ZWRfYnkijgIKGlN1cGVyYWRtaW5SZXNpemVWUFNSZXF1ZXN0Eg4KBnZwc19pZBgBIAEoCRIQCghuZXdfc2l6ZRgCIAEoCRIRCglncm93X2Rpc2sYAyABKAgSFwoPYXBwbHlfY2xvdWRpbml0GAQgASgIEh0KEGN1c3RvbV9jcHVfY29yZXMYBSABKAVIAIgBARIgChNjdXN0b21fbWVtb3J5X2J5dGVzGAYgASgDSAGIAQESHgoRY3VzdG9tX2Rpc2tfYnl0ZXMYByABKANIAogBAUITChFfY3VzdG9tX2NwdV9jb3Jlc0IWChRfY3VzdG9tX21lbW9yeV9ieXRlc0IUChJfY3VzdG9tX2Rpc2tfYnl0ZXMiXgobU3VwZXJhZG1pblJlc2l6ZVZQU1Jlc3BvbnNlEi4KA3ZwcxgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlEg8KB21lc3NhZ2UYAiABKAkiTQobU3VwZXJhZG1pblN1c3BlbmRWUFNSZXF1ZXN0Eg4KBnZwc19pZBgBIAEoCRITCgZyZWFzb24YAiABKAlIAIgBAUIJCgdfcmVhc29uIl8KHFN1cGVyYWRtaW5TdXNwZW5kVlBTUmVzcG9uc2USLgoDdnBzGAEgASgLMiEub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTSW5zdGFuY2USDwoHbWVzc2FnZRgCIAEoCSIvCh1TdXBlcmFkbWluVW5zdXNwZW5kVlBTUmVxdWVzdBIOCgZ2cHNfaWQYASABKAkiYQoeU3VwZXJhZG1pblVuc3VzcGVuZFZQU1Jlc3BvbnNlEi4KA3ZwcxgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlEg8KB21lc3NhZ2UYAiABKAkijQEKI1N1cGVyYWRtaW5VcGRhdGVWUFNDbG91ZEluaXRSZXF1ZXN0Eg4KBnZwc19pZBgBIAEoCRI5CgpjbG91ZF9pbml0GAIgASgLMiUub2JpZW50ZS5jbG91ZC52cHMudjEuQ2xvdWRJbml0Q29uZmlnEhsKE2dyb3dfZGlza19pZl9uZWVkZWQYAyABKAgiZwokU3VwZXJhZG1pblVwZGF0ZVZQU0Nsb3VkSW5pdFJlc3BvbnNlEi4KA3ZwcxgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlEg8KB21lc3NhZ2UYAiABKAkiLwodU3VwZXJhZG1pbkZvcmNlU3RvcFZQU1JlcXVlc3QSDgoGdnBzX2lkGAEgASgJImEKHlN1cGVyYWRtaW5Gb3JjZVN0b3BWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZRIPCgdtZXNzYWdlGAIgASgJIkYKH1N1cGVyYWRtaW5Gb3JjZURlbGV0ZVZQU1JlcXVlc3QSDgoGdnBzX2lkGAEgASgJEhMKC2hhcmRfZGVsZXRlGAIgASgIIkQKIFN1cGVyYWRtaW5Gb3JjZURlbGV0ZVZQU1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJQChtTdXBlcmFkbWluTWlncmF0ZVZQU1JlcXVlc3QSDgoGdnBzX2lkGAEgASgJEhMKC3RhcmdldF9ub2RlGAIgASgJEgwKBGxpdmUYAyABKAgiXwocU3VwZXJhZG1pbk1pZ3JhdGVWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZRIPCgdtZXNzYWdlGAIgASgJIicKF0xpc3RVbnBhdGNoZWRWUFNSZXF1ZXN0EgwKBGRheXMYASABKAUiVAoYTGlzdFVucGF0Y2hlZFZQU1Jlc3BvbnNlEjgKDXZwc19pbnN0YW5jZXMYASADKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZSK8AgoeTGlzdFN0cmlwZVdlYmhvb2tFdmVudHNSZXF1ZXN0EhwKD29yZ2FuaXphdGlvbl9pZBgBIAEoCUgAiAEBEhcKCmV2ZW50X3R5cGUYAiABKAlIAYgBARIYCgtjdXN0b21lcl9pZBgDIAEoCUgCiAEBEhwKD3N1YnNjcmlwdGlvbl9pZBgEIAEoCUgDiAEBEhcKCmludm9pY2VfaWQYBSABKAlIBIgBARISCgVsaW1pdBgGIAEoBUgFiAEBEhMKBm9mZnNldBgHIAEoBUgGiAEBQhIKEF9vcmdhbml6YXRpb25faWRCDQoLX2V2ZW50X3R5cGVCDgoMX2N1c3RvbWVyX2lkQhIKEF9zdWJzY3JpcHRpb25faWRCDQoLX2ludm9pY2VfaWRCCAoGX2xpbWl0QgkKB19vZmZzZXQivAMKElN0cmlwZVdlYmhvb2tFdmVudBIKCgJpZBgBIAEoCRISCgpldmVudF90eXBlGAIgASgJEjAKDHByb2Nlc3NlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHAoPb3JnYW5pemF0aW9uX2lkGAUgASgJSACIAQESHgoRb3JnYW5pemF0aW9uX25hbWUYBiABKAlIAYgBARIYCgtjdXN0b21lcl9pZBgHIAEoCUgCiAEBEhwKD3N1YnNjcmlwdGlvbl9pZBgIIAEoCUgDiAEBEhcKCmludm9pY2VfaWQYCSABKAlIBIgBARIgChNjaGVja291dF9zZXNzaW9uX2lkGAogASgJSAWIAQFCEgoQX29yZ2FuaXphdGlvbl9pZEIUChJfb3JnYW5pemF0aW9uX25hbWVCDgoMX2N1c3RvbWVyX2lkQhIKEF9zdWJzY3JpcHRpb25faWRCDQoLX2ludm9pY2VfaWRCFgoUX2NoZWNrb3V0X3Nlc3Npb25faWQidwofTGlzdFN0cmlwZVdlYmhvb2tFdmVudHNSZXNwb25zZRI/CgZldmVudHMYASADKAsyLy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3RyaXBlV2ViaG9va0V2ZW50EhMKC3RvdGFsX2NvdW50GAIgASgDIpoBChBMaXN0Tm9kZXNSZXF1ZXN0EhEKBHJvbGUYASABKAlIAIgBARIZCgxhdmFpbGFiaWxpdHkYAiABKAlIAYgBARITCgZzdGF0dXMYAyABKAlIAogBARITCgZyZWdpb24YBCABKAlIA4gBAUIHCgVfcm9sZUIPCg1fYXZhaWxhYmlsaXR5QgkKB19zdGF0dXNCCQoHX3JlZ2lvbiJJChFMaXN0Tm9kZXNSZXNwb25zZRI0CgVub2RlcxgBIAMoCzIlLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Ob2RlSW5mbyIhCg5HZXROb2RlUmVxdWVzdBIPCgdub2RlX2lkGAEgASgJIkYKD0dldE5vZGVSZXNwb25zZRIzCgRub2RlGAEgASgLMiUub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLk5vZGVJbmZvIrwDChdVcGRhdGVOb2RlQ29uZmlnUmVxdWVzdBIPCgdub2RlX2lkGAEgASgJEhYKCXN1YmRvbWFpbhgCIAEoCUgAiAEBEiYKGXVzZV9ub2RlX3NwZWNpZmljX2RvbWFpbnMYAyABKAhIAYgBARIjChZzZXJ2aWNlX2RvbWFpbl9wYXR0ZXJuGAQgASgJSAKIAQESEwoGcmVnaW9uGAUgASgJSAOIAQESHAoPbWF4X2RlcGxveW1lbnRzGAYgASgFSASIAQESXQoNY3VzdG9tX2xhYmVscxgHIAMoCzJGLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VcGRhdGVOb2RlQ29uZmlnUmVxdWVzdC5DdXN0b21MYWJlbHNFbnRyeRozChFDdXN0b21MYWJlbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQgwKCl9zdWJkb21haW5CHAoaX3VzZV9ub2RlX3NwZWNpZmljX2RvbWFpbnNCGQoXX3NlcnZpY2VfZG9tYWluX3BhdHRlcm5CCQoHX3JlZ2lvbkISChBfbWF4X2RlcGxveW1lbnRzImAKGFVwZGF0ZU5vZGVDb25maWdSZXNwb25zZRIzCgRub2RlGAEgASgLMiUub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLk5vZGVJbmZvEg8KB21lc3NhZ2UYAiABKAki2AMKCE5vZGVJbmZvEgoKAmlkGAEgASgJEhAKCGhvc3RuYW1lGAIgASgJEgoKAmlwGAMgASgJEgwKBHJvbGUYBCABKAkSFAoMYXZhaWxhYmlsaXR5GAUgASgJEg4KBnN0YXR1cxgGIAEoCRIRCgl0b3RhbF9jcHUYByABKAUSFAoMdG90YWxfbWVtb3J5GAggASgDEhAKCHVzZWRfY3B1GAkgASgBEhMKC3VzZWRfbWVtb3J5GAogASgDEhgKEGRlcGxveW1lbnRfY291bnQYCyABKAUSFwoPbWF4X2RlcGxveW1lbnRzGAwgASgFEhMKBnJlZ2lvbhgNIAEoCUgAiAEBEjcKBmNvbmZpZxgOIAEoCzInLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Ob2RlQ29uZmlnEjIKDmxhc3RfaGVhcnRiZWF0GA8gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIJCgdfcmVnaW9uIr8CCgpOb2RlQ29uZmlnEhYKCXN1YmRvbWFpbhgBIAEoCUgAiAEBEiYKGXVzZV9ub2RlX3NwZWNpZmljX2RvbWFpbnMYAiABKAhIAYgBARIjChZzZXJ2aWNlX2RvbWFpbl9wYXR0ZXJuGAMgASgJSAKIAQESUAoNY3VzdG9tX2xhYmVscxgEIAMoCzI5Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Ob2RlQ29uZmlnLkN1c3RvbUxhYmVsc0VudHJ5GjMKEUN1c3RvbUxhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCDAoKX3N1YmRvbWFpbkIcChpfdXNlX25vZGVfc3BlY2lmaWNfZG9tYWluc0IZChdfc2VydmljZV9kb21haW5fcGF0dGVybiIiCiBMaXN0U3VwZXJhZG1pblBlcm1pc3Npb25zUmVxdWVzdCJYCh5TdXBlcmFkbWluUGVybWlzc2lvbkRlZmluaXRpb24SCgoCaWQYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSFQoNcmVzb3VyY2VfdHlwZRgDIAEoCSJ1CiFMaXN0U3VwZXJhZG1pblBlcm1pc3Npb25zUmVzcG9uc2USUAoLcGVybWlzc2lvbnMYASADKAsyOy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblBlcm1pc3Npb25EZWZpbml0aW9uIiMKIUdldE15U3VwZXJhZG1pblBlcm1pc3Npb25zUmVxdWVzdCJVCiJHZXRNeVN1cGVyYWRtaW5QZXJtaXNzaW9uc1Jlc3BvbnNlEhMKC3Blcm1pc3Npb25zGAEgAygJEhoKEmlzX2Z1bGxfc3VwZXJhZG1pbhgCIAEoCCIcChpMaXN0U3VwZXJhZG1pblJvbGVzUmVxdWVzdCJZCg5TdXBlcmFkbWluUm9sZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhgKEHBlcm1pc3Npb25zX2pzb24YBCABKAkiWQobTGlzdFN1cGVyYWRtaW5Sb2xlc1Jlc3BvbnNlEjoKBXJvbGVzGAEgAygLMisub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Sb2xlIloKG0NyZWF0ZVN1cGVyYWRtaW5Sb2xlUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEhgKEHBlcm1pc3Npb25zX2pzb24YAyABKAkiWQocQ3JlYXRlU3VwZXJhZG1pblJvbGVSZXNwb25zZRI5CgRyb2xlGAEgASgLMisub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Sb2xlImYKG1VwZGF0ZVN1cGVyYWRtaW5Sb2xlUmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhgKEHBlcm1pc3Npb25zX2pzb24YBCABKAkiWQocVXBkYXRlU3VwZXJhZG1pblJvbGVSZXNwb25zZRI5CgRyb2xlGAEgASgLMisub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Sb2xlIikKG0RlbGV0ZVN1cGVyYWRtaW5Sb2xlUmVxdWVzdBIKCgJpZBgBIAEoCSIvChxEZWxldGVTdXBlcmFkbWluUm9sZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiIwohTGlzdFN1cGVyYWRtaW5Sb2xlQmluZGluZ3NSZXF1ZXN0IkUKFVN1cGVyYWRtaW5Sb2xlQmluZGluZxIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEg8KB3JvbGVfaWQYAyABKAkiagoiTGlzdFN1cGVyYWRtaW5Sb2xlQmluZGluZ3NSZXNwb25zZRJECghiaW5kaW5ncxgBIAMoCzIyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluUm9sZUJpbmRpbmciRgoiQ3JlYXRlU3VwZXJhZG1pblJvbGVCaW5kaW5nUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEg8KB3JvbGVfaWQYAiABKAkiagojQ3JlYXRlU3VwZXJhZG1pblJvbGVCaW5kaW5nUmVzcG9uc2USQwoHYmluZGluZxgBIAEoCzIyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluUm9sZUJpbmRpbmciMAoiRGVsZXRlU3VwZXJhZG1pblJvbGVCaW5kaW5nUmVxdWVzdBIKCgJpZBgBIAEoCSI2CiNEZWxldGVTdXBlcmFkbWluUm9sZUJpbmRpbmdSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIokBChJTdXNwZW5kVXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRITCgZyZWFzb24YAiABKAlIAIgBARIzCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBQgkKB19yZWFzb25CDQoLX2V4cGlyZXNfYXQiXQoTU3VzcGVuZFVzZXJSZXNwb25zZRI1CgNiYW4YASABKAsyKC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXNlckJhbkluZm8SDwoHbWVzc2FnZRgCIAEoCSInChRVbnN1c3BlbmRVc2VyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIigKFVVuc3VzcGVuZFVzZXJSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIkEKDkJhblVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEwoGcmVhc29uGAIgASgJSACIAQFCCQoHX3JlYXNvbiJZCg9CYW5Vc2VyUmVzcG9uc2USNQoDYmFuGAEgASgLMigub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVzZXJCYW5JbmZvEg8KB21lc3NhZ2UYAiABKAkiIwoQVW5iYW5Vc2VyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIiQKEVVuYmFuVXNlclJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiKgoXR2V0VXNlckJhblN0YXR1c1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSJeChhHZXRVc2VyQmFuU3RhdHVzUmVzcG9uc2USOgoDYmFuGAEgASgLMigub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVzZXJCYW5JbmZvSACIAQFCBgoEX2JhbiLxAQoLVXNlckJhbkluZm8SCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIMCgR0eXBlGAMgASgJEhMKBnJlYXNvbhgEIAEoCUgAiAEBEhEKCWJhbm5lZF9ieRgFIAEoCRItCgliYW5uZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKCmV4cGlyZXNfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESEQoJaXNfYWN0aXZlGAggASgIQgkKB19yZWFzb25CDQoLX2V4cGlyZXNfYXQi7gEKCEJhbkVudHJ5EgoKAmlkGAEgASgJEgwKBHR5cGUYAiABKAkSDQoFdmFsdWUYAyABKAkSEwoGcmVhc29uGAQgASgJSACIAQESEgoKY3JlYXRlZF9ieRgFIAEoCRIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIzCgpleHBpcmVzX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEhEKCWlzX2FjdGl2ZRgIIAEoCEIJCgdfcmVhc29uQg0KC19leHBpcmVzX2F0IpgBChVDcmVhdGVCYW5FbnRyeVJlcXVlc3QSDAoEdHlwZRgBIAEoCRINCgV2YWx1ZRgCIAEoCRITCgZyZWFzb24YAyABKAlIAIgBARIzCgpleHBpcmVzX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBQgkKB19yZWFzb25CDQoLX2V4cGlyZXNfYXQiTgoWQ3JlYXRlQmFuRW50cnlSZXNwb25zZRI0CgVlbnRyeRgBIAEoCzIlLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5CYW5FbnRyeSIjChVEZWxldGVCYW5FbnRyeVJlcXVlc3QSCgoCaWQYASABKAkiKQoWRGVsZXRlQmFuRW50cnlSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIkwKFUxpc3RCYW5FbnRyaWVzUmVxdWVzdBIRCgR0eXBlGAEgASgJSACIAQESFwoPaW5jbHVkZV9leHBpcmVkGAIgASgIQgcKBV90eXBlIlAKFkxpc3RCYW5FbnRyaWVzUmVzcG9uc2USNgoHZW50cmllcxgBIAMoCzIlLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5CYW5FbnRyeSKuAQoPT3JnSVBBbGxvY2F0aW9uEgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIMCgRjaWRyGAMgASgJEg8KB2dhdGV3YXkYBCABKAkSEwoLZG5zX3NlcnZlcnMYBSADKAkSEgoKY3JlYXRlZF9ieRgGIAEoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJmChdBbGxvY2F0ZVN0YXRpY0lQUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDAoEY2lkchgCIAEoCRIPCgdnYXRld2F5GAMgASgJEhMKC2Ruc19zZXJ2ZXJzGAQgAygJIlwKGEFsbG9jYXRlU3RhdGljSVBSZXNwb25zZRJACgphbGxvY2F0aW9uGAEgASgLMiwub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLk9yZ0lQQWxsb2NhdGlvbiKZAQoaU3VzcGVuZE9yZ2FuaXphdGlvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhMKBnJlYXNvbhgCIAEoCUgAiAEBEjMKCmV4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQFCCQoHX3JlYXNvbkINCgtfZXhwaXJlc19hdCI+ChtTdXNwZW5kT3JnYW5pemF0aW9uUmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCRIOCgZzdGF0dXMYAiABKAkiNwocVW5zdXNwZW5kT3JnYW5pemF0aW9uUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiMAodVW5zdXNwZW5kT3JnYW5pemF0aW9uUmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSJRChZCYW5Pcmdhbml6YXRpb25SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRITCgZyZWFzb24YAiABKAlIAIgBAUIJCgdfcmVhc29uIjoKF0Jhbk9yZ2FuaXphdGlvblJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkSDgoGc3RhdHVzGAIgASgJIjMKGFVuYmFuT3JnYW5pemF0aW9uUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiLAoZVW5iYW5Pcmdhbml6YXRpb25SZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIt8CChJHYW1lU2VydmVyT3ZlcnZpZXcSPQoLZ2FtZV9zZXJ2ZXIYASABKAsyKC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXISGQoRb3JnYW5pemF0aW9uX25hbWUYAiABKAkSQwoPY3JlYXRlZF9ieV91c2VyGAMgASgLMiUub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVzZXJJbmZvSACIAQESFAoMaXNfc3VzcGVuZGVkGAQgASgIEh4KEXN1c3BlbnNpb25fcmVhc29uGAUgASgJSAGIAQESFQoIb3duZXJfaWQYBiABKAlIAogBARIXCgpvd25lcl9uYW1lGAcgASgJSAOIAQFCEgoQX2NyZWF0ZWRfYnlfdXNlckIUChJfc3VzcGVuc2lvbl9yZWFzb25CCwoJX293bmVyX2lkQg0KC19vd25lcl9uYW1lIqkCChlMaXN0QWxsR2FtZVNlcnZlcnNSZXF1ZXN0EhwKD29yZ2FuaXphdGlvbl9pZBgBIAEoCUgAiAEBEkMKBnN0YXR1cxgCIAEoDjIuLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlclN0YXR1c0gBiAEBEhMKBnNlYXJjaBgDIAEoCUgCiAEBEhEKBHBhZ2UYBCABKAVIA4gBARIVCghwZXJfcGFnZRgFIAEoBUgEiAEBEhkKDGZsYWdnZWRfb25seRgGIAEoCEgFiAEBQhIKEF9vcmdhbml6YXRpb25faWRCCQoHX3N0YXR1c0IJCgdfc2VhcmNoQgcKBV9wYWdlQgsKCV9wZXJfcGFnZUIPCg1fZmxhZ2dlZF9vbmx5IpwBChpMaXN0QWxsR2FtZVNlcnZlcnNSZXNwb25zZRJFCgxnYW1lX3NlcnZlcnMYASADKAsyLy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2FtZVNlcnZlck92ZXJ2aWV3EjcKCnBhZ2luYXRpb24YAiABKAsyIy5vYmllbnRlLmNsb3VkLmNvbW1vbi52MS5QYWdpbmF0aW9uIjgKHlN1cGVyYWRtaW5HZXRHYW1lU2VydmVyUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCSJnCh9TdXBlcmFkbWluR2V0R2FtZVNlcnZlclJlc3BvbnNlEkQKC2dhbWVfc2VydmVyGAEgASgLMi8ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdhbWVTZXJ2ZXJPdmVydmlldyJcCiJTdXBlcmFkbWluU3VzcGVuZEdhbWVTZXJ2ZXJSZXF1ZXN0EhYKDmdhbWVfc2VydmVyX2lkGAEgASgJEhMKBnJlYXNvbhgCIAEoCUgAiAEBQgkKB19yZWFzb24idQojU3VwZXJhZG1pblN1c3BlbmRHYW1lU2VydmVyUmVzcG9uc2USPQoLZ2FtZV9zZXJ2ZXIYASABKAsyKC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXISDwoHbWVzc2FnZRgCIAEoCSI+CiRTdXBlcmFkbWluVW5zdXNwZW5kR2FtZVNlcnZlclJlcXVlc3QSFgoOZ2FtZV9zZXJ2ZXJfaWQYASABKAkidwolU3VwZXJhZG1pblVuc3VzcGVuZEdhbWVTZXJ2ZXJSZXNwb25zZRI9CgtnYW1lX3NlcnZlchgBIAEoCzIoLm9iaWVudGUuY2xvdWQuZ2FtZXNlcnZlcnMudjEuR2FtZVNlcnZlchIPCgdtZXNzYWdlGAIgASgJIl4KJFN1cGVyYWRtaW5Gb3JjZVN0b3BHYW1lU2VydmVyUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRITCgZyZWFzb24YAiABKAlIAIgBAUIJCgdfcmVhc29uIncKJVN1cGVyYWRtaW5Gb3JjZVN0b3BHYW1lU2VydmVyUmVzcG9uc2USPQoLZ2FtZV9zZXJ2ZXIYASABKAsyKC5vYmllbnRlLmNsb3VkLmdhbWVzZXJ2ZXJzLnYxLkdhbWVTZXJ2ZXISDwoHbWVzc2FnZRgCIAEoCSJ1CiZTdXBlcmFkbWluRm9yY2VEZWxldGVHYW1lU2VydmVyUmVxdWVzdBIWCg5nYW1lX3NlcnZlcl9pZBgBIAEoCRITCgZyZWFzb24YAiABKAlIAIgBARITCgtoYXJkX2RlbGV0ZRgDIAEoCEIJCgdfcmVhc29uIksKJ1N1cGVyYWRtaW5Gb3JjZURlbGV0ZUdhbWVTZXJ2ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiMAoVTGlmdFN1c3BlbnNpb25SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSI5ChZMaWZ0U3VzcGVuc2lvblJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkSDgoGc3RhdHVzGAIgASgJIiAKHkdldFBsYXRmb3JtVXNhZ2VTdW1tYXJ5UmVxdWVzdCLIAQoZT3JnYW5pemF0aW9uUmVzb3VyY2VVc2FnZRIXCg9vcmdhbml6YXRpb25faWQYASABKAkSGQoRb3JnYW5pemF0aW9uX25hbWUYAiABKAkSGAoQZGVwbG95bWVudF9jb3VudBgDIAEoAxIZChFnYW1lX3NlcnZlcl9jb3VudBgEIAEoAxIRCgl2cHNfY291bnQYBSABKAMSFwoPdG90YWxfcmVzb3VyY2VzGAYgASgDEhYKDnJlc291cmNlX2hvdXJzGAcgASgDIm0KE1JlZ2lvblJlc291cmNlVXNhZ2USDgoGcmVnaW9uGAEgASgJEhgKEGRlcGxveW1lbnRfY291bnQYAiABKAMSGQoRZ2FtZV9zZXJ2ZXJfY291bnQYAyABKAMSEQoJdnBzX2NvdW50GAQgASgDItYCCh9HZXRQbGF0Zm9ybVVzYWdlU3VtbWFyeVJlc3BvbnNlEhoKEmFjdGl2ZV9kZXBsb3ltZW50cxgBIAEoAxIbChNhY3RpdmVfZ2FtZV9zZXJ2ZXJzGAIgASgDEhIKCmFjdGl2ZV92cHMYAyABKAMSHgoWY3JlZGl0c19jb25zdW1lZF9jZW50cxgEIAEoAxJRChF0b3Bfb3JnYW5pemF0aW9ucxgFIAMoCzI2Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Pcmdhbml6YXRpb25SZXNvdXJjZVVzYWdlEkEKB3JlZ2lvbnMYBiADKAsyMC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuUmVnaW9uUmVzb3VyY2VVc2FnZRIwCgxnZW5lcmF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wImEKH0dldEFsbE9yZ2FuaXphdGlvbnNVc2FnZVJlcXVlc3QSEQoEcGFnZRgBIAEoBUgAiAEBEhUKCHBlcl9wYWdlGAIgASgFSAGIAQFCBwoFX3BhZ2VCCwoJX3Blcl9wYWdlIqoBCiBHZXRBbGxPcmdhbml6YXRpb25zVXNhZ2VSZXNwb25zZRJNCg1vcmdhbml6YXRpb25zGAEgAygLMjYub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLk9yZ2FuaXphdGlvblJlc291cmNlVXNhZ2USNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24iYQoZU2V0TWFpbnRlbmFuY2VNb2RlUmVxdWVzdBIPCgdlbmFibGVkGAEgASgIEg8KB21lc3NhZ2UYAiABKAkSIgoaZXN0aW1hdGVkX2R1cmF0aW9uX21pbnV0ZXMYAyABKAUicQoaU2V0TWFpbnRlbmFuY2VNb2RlUmVzcG9uc2USDwoHZW5hYmxlZBgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEjEKDWVzdGltYXRlZF9lbmQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIioKF0RyYWluQ2x1c3Rlck5vZGVSZXF1ZXN0Eg8KB25vZGVfaWQYASABKAkiTAoYRHJhaW5DbHVzdGVyTm9kZVJlc3BvbnNlEg8KB25vZGVfaWQYASABKAkSDgoGc3RhdHVzGAIgASgJEg8KB21lc3NhZ2UYAyABKAkiKwoYRW5hYmxlQ2x1c3Rlck5vZGVSZXF1ZXN0Eg8KB25vZGVfaWQYASABKAkiTQoZRW5hYmxlQ2x1c3Rlck5vZGVSZXNwb25zZRIPCgdub2RlX2lkGAEgASgJEg4KBnN0YXR1cxgCIAEoCRIPCgdtZXNzYWdlGAMgASgJIi4KGVJvdGF0ZVByb3htb3hUb2tlblJlcXVlc3QSEQoJbmV3X3Rva2VuGAEgASgJIm8KGlJvdGF0ZVByb3htb3hUb2tlblJlc3BvbnNlEhIKCnRva2VuX25hbWUYASABKAkSPQoZcHJldmlvdXNfdG9rZW5fcmV0aXJlc19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiGgoYR2V0Q2FwYWNpdHlSZXBvcnRSZXF1ZXN0Io0ECg5DYXBhY2l0eVJlcG9ydBIPCgdub2RlX2lkGAEgASgJEhEKCW5vZGVfbmFtZRgCIAEoCRIRCglub2RlX3R5cGUYAyABKAkSDgoGcmVnaW9uGAQgASgJEhMKC2NwdV9wZXJjZW50GAUgASgBEhYKDm1lbW9yeV9wZXJjZW50GAYgASgBEhkKDGRpc2tfcGVyY2VudBgHIAEoAUgAiAEBEhgKEGRlcGxveW1lbnRfY291bnQYCCABKAUSGgoSY3B1X2dyb3d0aF9wZXJfZGF5GAkgASgBEh0KFW1lbW9yeV9ncm93dGhfcGVyX2RheRgKIAEoARIgChNkaXNrX2dyb3d0aF9wZXJfZGF5GAsgASgBSAGIAQESHQoQY3B1X2RheXNfdG9fZnVsbBgMIAEoAUgCiAEBEiAKE21lbW9yeV9kYXlzX3RvX2Z1bGwYDSABKAFIA4gBARIeChFkaXNrX2RheXNfdG9fZnVsbBgOIAEoAUgEiAEBEhIKCnJpc2tfbGV2ZWwYDyABKAkSFAoMc2FtcGxlX2NvdW50GBAgASgFQg8KDV9kaXNrX3BlcmNlbnRCFgoUX2Rpc2tfZ3Jvd3RoX3Blcl9kYXlCEwoRX2NwdV9kYXlzX3RvX2Z1bGxCFgoUX21lbW9yeV9kYXlzX3RvX2Z1bGxCFAoSX2Rpc2tfZGF5c190b19mdWxsIokBChlHZXRDYXBhY2l0eVJlcG9ydFJlc3BvbnNlEjoKBW5vZGVzGAEgAygLMisub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkNhcGFjaXR5UmVwb3J0EjAKDGdlbmVyYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAymloKEVN1cGVyYWRtaW5TZXJ2aWNlEnAKC0dldE92ZXJ2aWV3Ei8ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldE92ZXJ2aWV3UmVxdWVzdBowLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRPdmVydmlld1Jlc3BvbnNlEmcKCFF1ZXJ5RE5TEiwub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlF1ZXJ5RE5TUmVxdWVzdBotLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5RdWVyeUROU1Jlc3BvbnNlEnkKDkxpc3RETlNSZWNvcmRzEjIub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RETlNSZWNvcmRzUmVxdWVzdBozLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0RE5TUmVjb3Jkc1Jlc3BvbnNlEnMKDEdldEROU0NvbmZpZxIwLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRETlNDb25maWdSZXF1ZXN0GjEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldEROU0NvbmZpZ1Jlc3BvbnNlEpQBChdMaXN0RGVsZWdhdGVkRE5TUmVjb3JkcxI7Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0RGVsZWdhdGVkRE5TUmVjb3Jkc1JlcXVlc3QaPC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdERlbGVnYXRlZEROU1JlY29yZHNSZXNwb25zZRJ8Cg9IYXNEZWxlZ2F0ZWRETlMSMy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuSGFzRGVsZWdhdGVkRE5TUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5IYXNEZWxlZ2F0ZWRETlNSZXNwb25zZRJ/ChBMaXN0RE5TUXVlcnlMb2dzEjQub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RETlNRdWVyeUxvZ3NSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RETlNRdWVyeUxvZ3NSZXNwb25zZRKOAQoVQ29uZmlndXJlU3BsaXRIb3Jpem9uEjkub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkNvbmZpZ3VyZVNwbGl0SG9yaXpvblJlcXVlc3QaOi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ29uZmlndXJlU3BsaXRIb3Jpem9uUmVzcG9uc2USmgEKGUNyZWF0ZUROU0RlbGVnYXRpb25BUElLZXkSPS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlRE5TRGVsZWdhdGlvbkFQSUtleVJlcXVlc3QaPi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlRE5TRGVsZWdhdGlvbkFQSUtleVJlc3BvbnNlEpcBChhMaXN0RE5TRGVsZWdhdGlvbkFQSUtleXMSPC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdEROU0RlbGVnYXRpb25BUElLZXlzUmVxdWVzdBo9Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0RE5TRGVsZWdhdGlvbkFQSUtleXNSZXNwb25zZRKaAQoZUmV2b2tlRE5TRGVsZWdhdGlvbkFQSUtleRI9Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5SZXZva2VETlNEZWxlZ2F0aW9uQVBJS2V5UmVxdWVzdBo+Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5SZXZva2VETlNEZWxlZ2F0aW9uQVBJS2V5UmVzcG9uc2USxwEKKFJldm9rZUROU0RlbGVnYXRpb25BUElLZXlGb3JPcmdhbml6YXRpb24STC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuUmV2b2tlRE5TRGVsZWdhdGlvbkFQSUtleUZvck9yZ2FuaXphdGlvblJlcXVlc3QaTS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuUmV2b2tlRE5TRGVsZWdhdGlvbkFQSUtleUZvck9yZ2FuaXphdGlvblJlc3BvbnNlEm0KCkdldFByaWNpbmcSLi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0UHJpY2luZ1JlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0UHJpY2luZ1Jlc3BvbnNlEoIBChFHZXRBYnVzZURldGVjdGlvbhI1Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRBYnVzZURldGVjdGlvblJlcXVlc3QaNi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0QWJ1c2VEZXRlY3Rpb25SZXNwb25zZRKCAQoRR2V0SW5jb21lT3ZlcnZpZXcSNS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0SW5jb21lT3ZlcnZpZXdSZXF1ZXN0GjYub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldEluY29tZU92ZXJ2aWV3UmVzcG9uc2USlAEKF0dldFBsYXRmb3JtVXNhZ2VTdW1tYXJ5Ejsub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldFBsYXRmb3JtVXNhZ2VTdW1tYXJ5UmVxdWVzdBo8Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRQbGF0Zm9ybVVzYWdlU3VtbWFyeVJlc3BvbnNlEpcBChhHZXRBbGxPcmdhbml6YXRpb25zVXNhZ2USPC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0QWxsT3JnYW5pemF0aW9uc1VzYWdlUmVxdWVzdBo9Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRBbGxPcmdhbml6YXRpb25zVXNhZ2VSZXNwb25zZRJ8Cg9MaXN0QWxsSW52b2ljZXMSMy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdEFsbEludm9pY2VzUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0QWxsSW52b2ljZXNSZXNwb25zZRKIAQoTU2VuZEludm9pY2VSZW1pbmRlchI3Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TZW5kSW52b2ljZVJlbWluZGVyUmVxdWVzdBo4Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TZW5kSW52b2ljZVJlbWluZGVyUmVzcG9uc2USagoJTGlzdFBsYW5zEi0ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RQbGFuc1JlcXVlc3QaLi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdFBsYW5zUmVzcG9uc2USbQoKQ3JlYXRlUGxhbhIuLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5DcmVhdGVQbGFuUmVxdWVzdBovLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5DcmVhdGVQbGFuUmVzcG9uc2USbQoKVXBkYXRlUGxhbhIuLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VcGRhdGVQbGFuUmVxdWVzdBovLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VcGRhdGVQbGFuUmVzcG9uc2USbQoKRGVsZXRlUGxhbhIuLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZWxldGVQbGFuUmVxdWVzdBovLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZWxldGVQbGFuUmVzcG9uc2USlwEKGEFzc2lnblBsYW5Ub09yZ2FuaXphdGlvbhI8Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Bc3NpZ25QbGFuVG9Pcmdhbml6YXRpb25SZXF1ZXN0Gj0ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkFzc2lnblBsYW5Ub09yZ2FuaXphdGlvblJlc3BvbnNlEmoKCUxpc3RVc2VycxItLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0VXNlcnNSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RVc2Vyc1Jlc3BvbnNlEmQKB0dldFVzZXISKy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0VXNlclJlcXVlc3QaLC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0VXNlclJlc3BvbnNlEpoBChlMaXN0RG9ybWFudFJlc291cmNlT3duZXJzEj0ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3REb3JtYW50UmVzb3VyY2VPd25lcnNSZXF1ZXN0Gj4ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3REb3JtYW50UmVzb3VyY2VPd25lcnNSZXNwb25zZRJwCgtTdXNwZW5kVXNlchIvLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXNwZW5kVXNlclJlcXVlc3QaMC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VzcGVuZFVzZXJSZXNwb25zZRJ2Cg1VbnN1c3BlbmRVc2VyEjEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVuc3VzcGVuZFVzZXJSZXF1ZXN0GjIub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVuc3VzcGVuZFVzZXJSZXNwb25zZRJkCgdCYW5Vc2VyEisub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkJhblVzZXJSZXF1ZXN0Giwub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkJhblVzZXJSZXNwb25zZRJqCglVbmJhblVzZXISLS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVW5iYW5Vc2VyUmVxdWVzdBouLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VbmJhblVzZXJSZXNwb25zZRJ/ChBHZXRVc2VyQmFuU3RhdHVzEjQub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldFVzZXJCYW5TdGF0dXNSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldFVzZXJCYW5TdGF0dXNSZXNwb25zZRJ5Cg5DcmVhdGVCYW5FbnRyeRIyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5DcmVhdGVCYW5FbnRyeVJlcXVlc3QaMy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlQmFuRW50cnlSZXNwb25zZRJ5Cg5EZWxldGVCYW5FbnRyeRIyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZWxldGVCYW5FbnRyeVJlcXVlc3QaMy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRGVsZXRlQmFuRW50cnlSZXNwb25zZRJ5Cg5MaXN0QmFuRW50cmllcxIyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0QmFuRW50cmllc1JlcXVlc3QaMy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdEJhbkVudHJpZXNSZXNwb25zZRKIAQoTU3VzcGVuZE9yZ2FuaXphdGlvbhI3Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXNwZW5kT3JnYW5pemF0aW9uUmVxdWVzdBo4Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXNwZW5kT3JnYW5pemF0aW9uUmVzcG9uc2USjgEKFVVuc3VzcGVuZE9yZ2FuaXphdGlvbhI5Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VbnN1c3BlbmRPcmdhbml6YXRpb25SZXF1ZXN0Gjoub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVuc3VzcGVuZE9yZ2FuaXphdGlvblJlc3BvbnNlEnwKD0Jhbk9yZ2FuaXphdGlvbhIzLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5CYW5Pcmdhbml6YXRpb25SZXF1ZXN0GjQub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkJhbk9yZ2FuaXphdGlvblJlc3BvbnNlEoIBChFVbmJhbk9yZ2FuaXphdGlvbhI1Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VbmJhbk9yZ2FuaXphdGlvblJlcXVlc3QaNi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVW5iYW5Pcmdhbml6YXRpb25SZXNwb25zZRJ5Cg5MaWZ0U3VzcGVuc2lvbhIyLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaWZ0U3VzcGVuc2lvblJlcXVlc3QaMy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlmdFN1c3BlbnNpb25SZXNwb25zZRKFAQoSU2V0TWFpbnRlbmFuY2VNb2RlEjYub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlNldE1haW50ZW5hbmNlTW9kZVJlcXVlc3QaNy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU2V0TWFpbnRlbmFuY2VNb2RlUmVzcG9uc2USbQoKTGlzdEFsbFZQUxIuLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0QWxsVlBTUmVxdWVzdBovLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0QWxsVlBTUmVzcG9uc2USfwoQU3VwZXJhZG1pbkdldFZQUxI0Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluR2V0VlBTUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluR2V0VlBTUmVzcG9uc2USiAEKE1N1cGVyYWRtaW5SZXNpemVWUFMSNy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblJlc2l6ZVZQU1JlcXVlc3QaOC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblJlc2l6ZVZQU1Jlc3BvbnNlEosBChRTdXBlcmFkbWluU3VzcGVuZFZQUxI4Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluU3VzcGVuZFZQU1JlcXVlc3QaOS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblN1c3BlbmRWUFNSZXNwb25zZRKRAQoWU3VwZXJhZG1pblVuc3VzcGVuZFZQUxI6Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluVW5zdXNwZW5kVlBTUmVxdWVzdBo7Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluVW5zdXNwZW5kVlBTUmVzcG9uc2USowEKHFN1cGVyYWRtaW5VcGRhdGVWUFNDbG91ZEluaXQSQC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblVwZGF0ZVZQU0Nsb3VkSW5pdFJlcXVlc3QaQS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblVwZGF0ZVZQU0Nsb3VkSW5pdFJlc3BvbnNlEpEBChZTdXBlcmFkbWluRm9yY2VTdG9wVlBTEjoub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Gb3JjZVN0b3BWUFNSZXF1ZXN0Gjsub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Gb3JjZVN0b3BWUFNSZXNwb25zZRKXAQoYU3VwZXJhZG1pbkZvcmNlRGVsZXRlVlBTEjwub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Gb3JjZURlbGV0ZVZQU1JlcXVlc3QaPS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pbkZvcmNlRGVsZXRlVlBTUmVzcG9uc2USiwEKFFN1cGVyYWRtaW5NaWdyYXRlVlBTEjgub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5NaWdyYXRlVlBTUmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluTWlncmF0ZVZQU1Jlc3BvbnNlEn8KEExpc3RVbnBhdGNoZWRWUFMSNC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdFVucGF0Y2hlZFZQU1JlcXVlc3QaNS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdFVucGF0Y2hlZFZQU1Jlc3BvbnNlEnMKDExpc3RWUFNTaXplcxIwLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0VlBTU2l6ZXNSZXF1ZXN0GjEub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RWUFNTaXplc1Jlc3BvbnNlEnYKDUNyZWF0ZVZQU1NpemUSMS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlVlBTU2l6ZVJlcXVlc3QaMi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlVlBTU2l6ZVJlc3BvbnNlEnYKDVVwZGF0ZVZQU1NpemUSMS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXBkYXRlVlBTU2l6ZVJlcXVlc3QaMi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuVXBkYXRlVlBTU2l6ZVJlc3BvbnNlEnYKDURlbGV0ZVZQU1NpemUSMS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRGVsZXRlVlBTU2l6ZVJlcXVlc3QaMi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRGVsZXRlVlBTU2l6ZVJlc3BvbnNlEnEKEExpc3RWUFNQdWJsaWNJUHMSLS5vYmllbnRlLmNsb3VkLnZwcy52MS5MaXN0VlBTUHVibGljSVBzUmVxdWVzdBouLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RWUFNQdWJsaWNJUHNSZXNwb25zZRJ0ChFDcmVhdGVWUFNQdWJsaWNJUBIuLm9iaWVudGUuY2xvdWQudnBzLnYxLkNyZWF0ZVZQU1B1YmxpY0lQUmVxdWVzdBovLm9iaWVudGUuY2xvdWQudnBzLnYxLkNyZWF0ZVZQU1B1YmxpY0lQUmVzcG9uc2USdAoRVXBkYXRlVlBTUHVibGljSVASLi5vYmllbnRlLmNsb3VkLnZwcy52MS5VcGRhdGVWUFNQdWJsaWNJUFJlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnZwcy52MS5VcGRhdGVWUFNQdWJsaWNJUFJlc3BvbnNlEnQKEURlbGV0ZVZQU1B1YmxpY0lQEi4ub2JpZW50ZS5jbG91ZC52cHMudjEuRGVsZXRlVlBTUHVibGljSVBSZXF1ZXN0Gi8ub2JpZW50ZS5jbG91ZC52cHMudjEuRGVsZXRlVlBTUHVibGljSVBSZXNwb25zZRJ0ChFBc3NpZ25WUFNQdWJsaWNJUBIuLm9iaWVudGUuY2xvdWQudnBzLnYxLkFzc2lnblZQU1B1YmxpY0lQUmVxdWVzdBovLm9iaWVudGUuY2xvdWQudnBzLnYxLkFzc2lnblZQU1B1YmxpY0lQUmVzcG9uc2USegoTVW5hc3NpZ25WUFNQdWJsaWNJUBIwLm9iaWVudGUuY2xvdWQudnBzLnYxLlVuYXNzaWduVlBTUHVibGljSVBSZXF1ZXN0GjEub2JpZW50ZS5jbG91ZC52cHMudjEuVW5hc3NpZ25WUFNQdWJsaWNJUFJlc3BvbnNlEn8KEEFsbG9jYXRlU3RhdGljSVASNC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQWxsb2NhdGVTdGF0aWNJUFJlcXVlc3QaNS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQWxsb2NhdGVTdGF0aWNJUFJlc3BvbnNlEnMKDEdldE9yZ0xlYXNlcxIwLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5HZXRPcmdMZWFzZXNSZXF1ZXN0GjEub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLkdldE9yZ0xlYXNlc1Jlc3BvbnNlEpQBChdMaXN0U3RyaXBlV2ViaG9va0V2ZW50cxI7Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0U3RyaXBlV2ViaG9va0V2ZW50c1JlcXVlc3QaPC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdFN0cmlwZVdlYmhvb2tFdmVudHNSZXNwb25zZRJqCglMaXN0Tm9kZXMSLS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdE5vZGVzUmVxdWVzdBouLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0Tm9kZXNSZXNwb25zZRJkCgdHZXROb2RlEisub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldE5vZGVSZXF1ZXN0Giwub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldE5vZGVSZXNwb25zZRJ/ChBVcGRhdGVOb2RlQ29uZmlnEjQub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVwZGF0ZU5vZGVDb25maWdSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVwZGF0ZU5vZGVDb25maWdSZXNwb25zZRJ/ChBEcmFpbkNsdXN0ZXJOb2RlEjQub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkRyYWluQ2x1c3Rlck5vZGVSZXF1ZXN0GjUub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkRyYWluQ2x1c3Rlck5vZGVSZXNwb25zZRKCAQoRRW5hYmxlQ2x1c3Rlck5vZGUSNS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRW5hYmxlQ2x1c3Rlck5vZGVSZXF1ZXN0GjYub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkVuYWJsZUNsdXN0ZXJOb2RlUmVzcG9uc2UShQEKElJvdGF0ZVByb3htb3hUb2tlbhI2Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5Sb3RhdGVQcm94bW94VG9rZW5SZXF1ZXN0Gjcub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlJvdGF0ZVByb3htb3hUb2tlblJlc3BvbnNlEoIBChFHZXRDYXBhY2l0eVJlcG9ydBI1Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRDYXBhY2l0eVJlcG9ydFJlcXVlc3QaNi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuR2V0Q2FwYWNpdHlSZXBvcnRSZXNwb25zZRKaAQoZTGlzdFN1cGVyYWRtaW5QZXJtaXNzaW9ucxI9Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0U3VwZXJhZG1pblBlcm1pc3Npb25zUmVxdWVzdBo+Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0U3VwZXJhZG1pblBlcm1pc3Npb25zUmVzcG9uc2USnQEKGkdldE15U3VwZXJhZG1pblBlcm1pc3Npb25zEj4ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkdldE15U3VwZXJhZG1pblBlcm1pc3Npb25zUmVxdWVzdBo/Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5HZXRNeVN1cGVyYWRtaW5QZXJtaXNzaW9uc1Jlc3BvbnNlEoUBChJMaXN0QWxsR2FtZVNlcnZlcnMSNi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdEFsbEdhbWVTZXJ2ZXJzUmVxdWVzdBo3Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5MaXN0QWxsR2FtZVNlcnZlcnNSZXNwb25zZRKUAQoXU3VwZXJhZG1pbkdldEdhbWVTZXJ2ZXISOy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pbkdldEdhbWVTZXJ2ZXJSZXF1ZXN0Gjwub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5HZXRHYW1lU2VydmVyUmVzcG9uc2USoAEKG1N1cGVyYWRtaW5TdXNwZW5kR2FtZVNlcnZlchI/Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluU3VzcGVuZEdhbWVTZXJ2ZXJSZXF1ZXN0GkAub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5TdXNwZW5kR2FtZVNlcnZlclJlc3BvbnNlEqYBCh1TdXBlcmFkbWluVW5zdXNwZW5kR2FtZVNlcnZlchJBLm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5TdXBlcmFkbWluVW5zdXNwZW5kR2FtZVNlcnZlclJlcXVlc3QaQi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pblVuc3VzcGVuZEdhbWVTZXJ2ZXJSZXNwb25zZRKmAQodU3VwZXJhZG1pbkZvcmNlU3RvcEdhbWVTZXJ2ZXISQS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pbkZvcmNlU3RvcEdhbWVTZXJ2ZXJSZXF1ZXN0GkIub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlN1cGVyYWRtaW5Gb3JjZVN0b3BHYW1lU2VydmVyUmVzcG9uc2USrAEKH1N1cGVyYWRtaW5Gb3JjZURlbGV0ZUdhbWVTZXJ2ZXISQy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pbkZvcmNlRGVsZXRlR2FtZVNlcnZlclJlcXVlc3QaRC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuU3VwZXJhZG1pbkZvcmNlRGVsZXRlR2FtZVNlcnZlclJlc3BvbnNlEogBChNMaXN0U3VwZXJhZG1pblJvbGVzEjcub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RTdXBlcmFkbWluUm9sZXNSZXF1ZXN0Gjgub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RTdXBlcmFkbWluUm9sZXNSZXNwb25zZRKLAQoUQ3JlYXRlU3VwZXJhZG1pblJvbGUSOC5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuQ3JlYXRlU3VwZXJhZG1pblJvbGVSZXF1ZXN0Gjkub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkNyZWF0ZVN1cGVyYWRtaW5Sb2xlUmVzcG9uc2USiwEKFFVwZGF0ZVN1cGVyYWRtaW5Sb2xlEjgub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLlVwZGF0ZVN1cGVyYWRtaW5Sb2xlUmVxdWVzdBo5Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5VcGRhdGVTdXBlcmFkbWluUm9sZVJlc3BvbnNlEosBChREZWxldGVTdXBlcmFkbWluUm9sZRI4Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZWxldGVTdXBlcmFkbWluUm9sZVJlcXVlc3QaOS5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRGVsZXRlU3VwZXJhZG1pblJvbGVSZXNwb25zZRKdAQoaTGlzdFN1cGVyYWRtaW5Sb2xlQmluZGluZ3MSPi5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuTGlzdFN1cGVyYWRtaW5Sb2xlQmluZGluZ3NSZXF1ZXN0Gj8ub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkxpc3RTdXBlcmFkbWluUm9sZUJpbmRpbmdzUmVzcG9uc2USoAEKG0NyZWF0ZVN1cGVyYWRtaW5Sb2xlQmluZGluZxI/Lm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5DcmVhdGVTdXBlcmFkbWluUm9sZUJpbmRpbmdSZXF1ZXN0GkAub2JpZW50ZS5jbG91ZC5zdXBlcmFkbWluLnYxLkNyZWF0ZVN1cGVyYWRtaW5Sb2xlQmluZGluZ1Jlc3BvbnNlEqABChtEZWxldGVTdXBlcmFkbWluUm9sZUJpbmRpbmcSPy5vYmllbnRlLmNsb3VkLnN1cGVyYWRtaW4udjEuRGVsZXRlU3VwZXJhZG1pblJvbGVCaW5kaW5nUmVxdWVzdBpALm9iaWVudGUuY2xvdWQuc3VwZXJhZG1pbi52MS5EZWxldGVTdXBlcmFkbWluUm9sZUJpbmRpbmdSZXNwb25zZUJVWlNnaXRodWIuY29tL29iaWVudGUvY2xvdWQvYXBwcy9zaGFyZWQvcHJvdG8vb2JpZW50ZS9jbG91ZC9zdXBlcmFkbWluL3YxO3N1cGVyYWRtaW52MWIGcHJvdG8z", [file_google_protobuf_timestamp, file_obiente_cloud_deployments_v1_deployment_service, file_obiente_cloud_billing_v1_billing_service, file_obiente_cloud_common_v1_common, file_obiente_cloud_vps_v1_vps_service, file_obiente_cloud_vpsgateway_v1_gateway_service, file_obiente_cloud_gameservers_v1_game_server_service]);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.GetOverviewRequest
@@ -607,6 +607,218 @@ export type ListDNSRecordsResponse = Message<"obiente.cloud.superadmin.v1.ListDN
 export const ListDNSRecordsResponseSchema: GenMessage<ListDNSRecordsResponse> = /*@__PURE__*/
   messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 11);
 
+/**
+ * List DNS Query Logs Request
+ *
+ * @generated from message obiente.cloud.superadmin.v1.ListDNSQueryLogsRequest
+ */
+export type ListDNSQueryLogsRequest = Message<"obiente.cloud.superadmin.v1.ListDNSQueryLogsRequest"> & {
+  /**
+   * Filter by the organization owning the queried resource
+   *
+   * @generated from field: optional string organization_id = 1;
+   */
+  organizationId?: string;
+
+  /**
+   * Filter by domain, including its subdomains
+   *
+   * @generated from field: optional string domain = 2;
+   */
+  domain?: string;
+
+  /**
+   * Only queries at or after this time
+   *
+   * @generated from field: optional google.protobuf.Timestamp since = 3;
+   */
+  since?: Timestamp;
+
+  /**
+   * Limit number of results (default: 100, max: 1000)
+   *
+   * @generated from field: optional int32 limit = 4;
+   */
+  limit?: number;
+
+  /**
+   * Offset for pagination (default: 0)
+   *
+   * @generated from field: optional int32 offset = 5;
+   */
+  offset?: number;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.ListDNSQueryLogsRequest.
+ * Use `create(ListDNSQueryLogsRequestSchema)` to create a new message.
+ */
+export const ListDNSQueryLogsRequestSchema: GenMessage<ListDNSQueryLogsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 12);
+
+/**
+ * A DNS query answered by the DNS service
+ *
+ * @generated from message obiente.cloud.superadmin.v1.DNSQueryLog
+ */
+export type DNSQueryLog = Message<"obiente.cloud.superadmin.v1.DNSQueryLog"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 2;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * Queried name, lowercase without the trailing dot
+   *
+   * @generated from field: string domain = 3;
+   */
+  domain: string;
+
+  /**
+   * A, SRV, TXT, ...
+   *
+   * @generated from field: string query_type = 4;
+   */
+  queryType: string;
+
+  /**
+   * @generated from field: string client_ip = 5;
+   */
+  clientIp: string;
+
+  /**
+   * Response code: NOERROR, NXDOMAIN, SERVFAIL, ...
+   *
+   * @generated from field: string result = 6;
+   */
+  result: string;
+
+  /**
+   * Time to answer the query in microseconds
+   *
+   * @generated from field: int64 latency_micros = 7;
+   */
+  latencyMicros: bigint;
+
+  /**
+   * Organization owning the queried resource, if known
+   *
+   * @generated from field: optional string organization_id = 8;
+   */
+  organizationId?: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.DNSQueryLog.
+ * Use `create(DNSQueryLogSchema)` to create a new message.
+ */
+export const DNSQueryLogSchema: GenMessage<DNSQueryLog> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 13);
+
+/**
+ * List DNS Query Logs Response
+ *
+ * @generated from message obiente.cloud.superadmin.v1.ListDNSQueryLogsResponse
+ */
+export type ListDNSQueryLogsResponse = Message<"obiente.cloud.superadmin.v1.ListDNSQueryLogsResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.superadmin.v1.DNSQueryLog logs = 1;
+   */
+  logs: DNSQueryLog[];
+
+  /**
+   * Total number of logs matching filters
+   *
+   * @generated from field: int64 total_count = 2;
+   */
+  totalCount: bigint;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.ListDNSQueryLogsResponse.
+ * Use `create(ListDNSQueryLogsResponseSchema)` to create a new message.
+ */
+export const ListDNSQueryLogsResponseSchema: GenMessage<ListDNSQueryLogsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 14);
+
+/**
+ * A private IP the DNS service answers with for clients inside client_cidr
+ *
+ * @generated from message obiente.cloud.superadmin.v1.SplitHorizonEntry
+ */
+export type SplitHorizonEntry = Message<"obiente.cloud.superadmin.v1.SplitHorizonEntry"> & {
+  /**
+   * e.g. 10.0.0.0/8; the most specific CIDR containing the client wins
+   *
+   * @generated from field: string client_cidr = 1;
+   */
+  clientCidr: string;
+
+  /**
+   * IPv4 address answered instead of the Traefik IP
+   *
+   * @generated from field: string ip = 2;
+   */
+  ip: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SplitHorizonEntry.
+ * Use `create(SplitHorizonEntrySchema)` to create a new message.
+ */
+export const SplitHorizonEntrySchema: GenMessage<SplitHorizonEntry> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 15);
+
+/**
+ * Configure Split Horizon Request
+ *
+ * @generated from message obiente.cloud.superadmin.v1.ConfigureSplitHorizonRequest
+ */
+export type ConfigureSplitHorizonRequest = Message<"obiente.cloud.superadmin.v1.ConfigureSplitHorizonRequest"> & {
+  /**
+   * @generated from field: string deployment_id = 1;
+   */
+  deploymentId: string;
+
+  /**
+   * Replaces all existing entries; empty clears them
+   *
+   * @generated from field: repeated obiente.cloud.superadmin.v1.SplitHorizonEntry entries = 2;
+   */
+  entries: SplitHorizonEntry[];
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.ConfigureSplitHorizonRequest.
+ * Use `create(ConfigureSplitHorizonRequestSchema)` to create a new message.
+ */
+export const ConfigureSplitHorizonRequestSchema: GenMessage<ConfigureSplitHorizonRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 16);
+
+/**
+ * Configure Split Horizon Response
+ *
+ * @generated from message obiente.cloud.superadmin.v1.ConfigureSplitHorizonResponse
+ */
+export type ConfigureSplitHorizonResponse = Message<"obiente.cloud.superadmin.v1.ConfigureSplitHorizonResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.superadmin.v1.SplitHorizonEntry entries = 1;
+   */
+  entries: SplitHorizonEntry[];
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.ConfigureSplitHorizonResponse.
+ * Use `create(ConfigureSplitHorizonResponseSchema)` to create a new message.
+ */
+export const ConfigureSplitHorizonResponseSchema: GenMessage<ConfigureSplitHorizonResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 17);
+
 /**
  * Get DNS Config Request
  *
@@ -620,7 +832,7 @@ export type GetDNSConfigRequest = Message<"obiente.cloud.superadmin.v1.GetDNSCon
  * Use `create(GetDNSConfigRequestSchema)` to create a new message.
  */
 export const GetDNSConfigRequestSchema: GenMessage<GetDNSConfigRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 12);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 18);
 
 /**
  * DNS Configuration
@@ -669,7 +881,7 @@ export type DNSConfig = Message<"obiente.cloud.superadmin.v1.DNSConfig"> & {
  * Use `create(DNSConfigSchema)` to create a new message.
  */
 export const DNSConfigSchema: GenMessage<DNSConfig> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 13);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 19);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.TraefikIPs
@@ -691,7 +903,7 @@ export type TraefikIPs = Message<"obiente.cloud.superadmin.v1.TraefikIPs"> & {
  * Use `create(TraefikIPsSchema)` to create a new message.
  */
 export const TraefikIPsSchema: GenMessage<TraefikIPs> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 14);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 20);
 
 /**
  * Get DNS Config Response
@@ -710,7 +922,7 @@ export type GetDNSConfigResponse = Message<"obiente.cloud.superadmin.v1.GetDNSCo
  * Use `create(GetDNSConfigResponseSchema)` to create a new message.
  */
 export const GetDNSConfigResponseSchema: GenMessage<GetDNSConfigResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 15);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 21);
 
 /**
  * List Delegated DNS Records Request
@@ -745,7 +957,7 @@ export type ListDelegatedDNSRecordsRequest = Message<"obiente.cloud.superadmin.v
  * Use `create(ListDelegatedDNSRecordsRequestSchema)` to create a new message.
  */
 export const ListDelegatedDNSRecordsRequestSchema: GenMessage<ListDelegatedDNSRecordsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 16);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 22);
 
 /**
  * Delegated DNS Record Information
@@ -832,7 +1044,7 @@ export type DelegatedDNSRecord = Message<"obiente.cloud.superadmin.v1.DelegatedD
  * Use `create(DelegatedDNSRecordSchema)` to create a new message.
  */
 export const DelegatedDNSRecordSchema: GenMessage<DelegatedDNSRecord> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 17);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 23);
 
 /**
  * List Delegated DNS Records Response
@@ -851,7 +1063,7 @@ export type ListDelegatedDNSRecordsResponse = Message<"obiente.cloud.superadmin.
  * Use `create(ListDelegatedDNSRecordsResponseSchema)` to create a new message.
  */
 export const ListDelegatedDNSRecordsResponseSchema: GenMessage<ListDelegatedDNSRecordsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 18);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 24);
 
 /**
  * Has Delegated DNS Request
@@ -866,7 +1078,7 @@ export type HasDelegatedDNSRequest = Message<"obiente.cloud.superadmin.v1.HasDel
  * Use `create(HasDelegatedDNSRequestSchema)` to create a new message.
  */
 export const HasDelegatedDNSRequestSchema: GenMessage<HasDelegatedDNSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 19);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 25);
 
 /**
  * Has Delegated DNS Response
@@ -901,7 +1113,7 @@ export type HasDelegatedDNSResponse = Message<"obiente.cloud.superadmin.v1.HasDe
  * Use `create(HasDelegatedDNSResponseSchema)` to create a new message.
  */
 export const HasDelegatedDNSResponseSchema: GenMessage<HasDelegatedDNSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 20);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 26);
 
 /**
  * Get Pricing Request - public endpoint
@@ -916,7 +1128,7 @@ export type GetPricingRequest = Message<"obiente.cloud.superadmin.v1.GetPricingR
  * Use `create(GetPricingRequestSchema)` to create a new message.
  */
 export const GetPricingRequestSchema: GenMessage<GetPricingRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 21);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 27);
 
 /**
  * Get Pricing Response
@@ -957,7 +1169,7 @@ export type GetPricingResponse = Message<"obiente.cloud.superadmin.v1.GetPricing
  * Use `create(GetPricingResponseSchema)` to create a new message.
  */
 export const GetPricingResponseSchema: GenMessage<GetPricingResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 22);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 28);
 
 /**
  * Create DNS Delegation API Key Request
@@ -992,7 +1204,7 @@ export type CreateDNSDelegationAPIKeyRequest = Message<"obiente.cloud.superadmin
  * Use `create(CreateDNSDelegationAPIKeyRequestSchema)` to create a new message.
  */
 export const CreateDNSDelegationAPIKeyRequestSchema: GenMessage<CreateDNSDelegationAPIKeyRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 23);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 29);
 
 /**
  * Create DNS Delegation API Key Response
@@ -1027,7 +1239,7 @@ export type CreateDNSDelegationAPIKeyResponse = Message<"obiente.cloud.superadmi
  * Use `create(CreateDNSDelegationAPIKeyResponseSchema)` to create a new message.
  */
 export const CreateDNSDelegationAPIKeyResponseSchema: GenMessage<CreateDNSDelegationAPIKeyResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 24);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 30);
 
 /**
  * Revoke DNS Delegation API Key Request
@@ -1048,7 +1260,7 @@ export type RevokeDNSDelegationAPIKeyRequest = Message<"obiente.cloud.superadmin
  * Use `create(RevokeDNSDelegationAPIKeyRequestSchema)` to create a new message.
  */
 export const RevokeDNSDelegationAPIKeyRequestSchema: GenMessage<RevokeDNSDelegationAPIKeyRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 25);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 31);
 
 /**
  * Revoke DNS Delegation API Key Response
@@ -1074,7 +1286,7 @@ export type RevokeDNSDelegationAPIKeyResponse = Message<"obiente.cloud.superadmi
  * Use `create(RevokeDNSDelegationAPIKeyResponseSchema)` to create a new message.
  */
 export const RevokeDNSDelegationAPIKeyResponseSchema: GenMessage<RevokeDNSDelegationAPIKeyResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 26);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 32);
 
 /**
  * Revoke DNS Delegation API Key For Organization Request
@@ -1095,7 +1307,7 @@ export type RevokeDNSDelegationAPIKeyForOrganizationRequest = Message<"obiente.c
  * Use `create(RevokeDNSDelegationAPIKeyForOrganizationRequestSchema)` to create a new message.
  */
 export const RevokeDNSDelegationAPIKeyForOrganizationRequestSchema: GenMessage<RevokeDNSDelegationAPIKeyForOrganizationRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 27);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 33);
 
 /**
  * Revoke DNS Delegation API Key For Organization Response
@@ -1121,7 +1333,7 @@ export type RevokeDNSDelegationAPIKeyForOrganizationResponse = Message<"obiente.
  * Use `create(RevokeDNSDelegationAPIKeyForOrganizationResponseSchema)` to create a new message.
  */
 export const RevokeDNSDelegationAPIKeyForOrganizationResponseSchema: GenMessage<RevokeDNSDelegationAPIKeyForOrganizationResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 28);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 34);
 
 /**
  * List DNS Delegation API Keys Request
@@ -1142,7 +1354,7 @@ export type ListDNSDelegationAPIKeysRequest = Message<"obiente.cloud.superadmin.
  * Use `create(ListDNSDelegationAPIKeysRequestSchema)` to create a new message.
  */
 export const ListDNSDelegationAPIKeysRequestSchema: GenMessage<ListDNSDelegationAPIKeysRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 29);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 35);
 
 /**
  * DNS Delegation API Key Info
@@ -1212,7 +1424,7 @@ export type DNSDelegationAPIKeyInfo = Message<"obiente.cloud.superadmin.v1.DNSDe
  * Use `create(DNSDelegationAPIKeyInfoSchema)` to create a new message.
  */
 export const DNSDelegationAPIKeyInfoSchema: GenMessage<DNSDelegationAPIKeyInfo> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 30);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 36);
 
 /**
  * List DNS Delegation API Keys Response
@@ -1231,7 +1443,7 @@ export type ListDNSDelegationAPIKeysResponse = Message<"obiente.cloud.superadmin
  * Use `create(ListDNSDelegationAPIKeysResponseSchema)` to create a new message.
  */
 export const ListDNSDelegationAPIKeysResponseSchema: GenMessage<ListDNSDelegationAPIKeysResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 31);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 37);
 
 /**
  * Get Abuse Detection Request
@@ -1246,7 +1458,7 @@ export type GetAbuseDetectionRequest = Message<"obiente.cloud.superadmin.v1.GetA
  * Use `create(GetAbuseDetectionRequestSchema)` to create a new message.
  */
 export const GetAbuseDetectionRequestSchema: GenMessage<GetAbuseDetectionRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 32);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 38);
 
 /**
  * Abuse Detection Response
@@ -1275,7 +1487,7 @@ export type GetAbuseDetectionResponse = Message<"obiente.cloud.superadmin.v1.Get
  * Use `create(GetAbuseDetectionResponseSchema)` to create a new message.
  */
 export const GetAbuseDetectionResponseSchema: GenMessage<GetAbuseDetectionResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 33);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 39);
 
 /**
  * Suspicious Organization
@@ -1344,7 +1556,7 @@ export type SuspiciousOrganization = Message<"obiente.cloud.superadmin.v1.Suspic
  * Use `create(SuspiciousOrganizationSchema)` to create a new message.
  */
 export const SuspiciousOrganizationSchema: GenMessage<SuspiciousOrganization> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 34);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 40);
 
 /**
  * Suspicious Activity
@@ -1399,7 +1611,7 @@ export type SuspiciousActivity = Message<"obiente.cloud.superadmin.v1.Suspicious
  * Use `create(SuspiciousActivitySchema)` to create a new message.
  */
 export const SuspiciousActivitySchema: GenMessage<SuspiciousActivity> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 35);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 41);
 
 /**
  * Abuse Metrics
@@ -1442,7 +1654,7 @@ export type AbuseMetrics = Message<"obiente.cloud.superadmin.v1.AbuseMetrics"> &
  * Use `create(AbuseMetricsSchema)` to create a new message.
  */
 export const AbuseMetricsSchema: GenMessage<AbuseMetrics> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 36);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 42);
 
 /**
  * Get Income Overview Request
@@ -1470,7 +1682,7 @@ export type GetIncomeOverviewRequest = Message<"obiente.cloud.superadmin.v1.GetI
  * Use `create(GetIncomeOverviewRequestSchema)` to create a new message.
  */
 export const GetIncomeOverviewRequestSchema: GenMessage<GetIncomeOverviewRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 37);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 43);
 
 /**
  * Income Overview Response
@@ -1509,7 +1721,7 @@ export type GetIncomeOverviewResponse = Message<"obiente.cloud.superadmin.v1.Get
  * Use `create(GetIncomeOverviewResponseSchema)` to create a new message.
  */
 export const GetIncomeOverviewResponseSchema: GenMessage<GetIncomeOverviewResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 38);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 44);
 
 /**
  * Income Summary
@@ -1568,7 +1780,7 @@ export type IncomeSummary = Message<"obiente.cloud.superadmin.v1.IncomeSummary">
  * Use `create(IncomeSummarySchema)` to create a new message.
  */
 export const IncomeSummarySchema: GenMessage<IncomeSummary> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 39);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 45);
 
 /**
  * Monthly Income
@@ -1604,7 +1816,7 @@ export type MonthlyIncome = Message<"obiente.cloud.superadmin.v1.MonthlyIncome">
  * Use `create(MonthlyIncomeSchema)` to create a new message.
  */
 export const MonthlyIncomeSchema: GenMessage<MonthlyIncome> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 40);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 46);
 
 /**
  * Top Customer
@@ -1648,7 +1860,7 @@ export type TopCustomer = Message<"obiente.cloud.superadmin.v1.TopCustomer"> & {
  * Use `create(TopCustomerSchema)` to create a new message.
  */
 export const TopCustomerSchema: GenMessage<TopCustomer> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 41);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 47);
 
 /**
  * Billing Transaction
@@ -1721,7 +1933,7 @@ export type BillingTransaction = Message<"obiente.cloud.superadmin.v1.BillingTra
  * Use `create(BillingTransactionSchema)` to create a new message.
  */
 export const BillingTransactionSchema: GenMessage<BillingTransaction> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 42);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 48);
 
 /**
  * Payment Metrics
@@ -1767,7 +1979,7 @@ export type PaymentMetrics = Message<"obiente.cloud.superadmin.v1.PaymentMetrics
  * Use `create(PaymentMetricsSchema)` to create a new message.
  */
 export const PaymentMetricsSchema: GenMessage<PaymentMetrics> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 43);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 49);
 
 /**
  * List All Invoices Request
@@ -1816,7 +2028,7 @@ export type ListAllInvoicesRequest = Message<"obiente.cloud.superadmin.v1.ListAl
  * Use `create(ListAllInvoicesRequestSchema)` to create a new message.
  */
 export const ListAllInvoicesRequestSchema: GenMessage<ListAllInvoicesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 44);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 50);
 
 /**
  * List All Invoices Response
@@ -1845,7 +2057,7 @@ export type ListAllInvoicesResponse = Message<"obiente.cloud.superadmin.v1.ListA
  * Use `create(ListAllInvoicesResponseSchema)` to create a new message.
  */
 export const ListAllInvoicesResponseSchema: GenMessage<ListAllInvoicesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 45);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 51);
 
 /**
  * Invoice with Organization Info
@@ -1879,7 +2091,7 @@ export type InvoiceWithOrganization = Message<"obiente.cloud.superadmin.v1.Invoi
  * Use `create(InvoiceWithOrganizationSchema)` to create a new message.
  */
 export const InvoiceWithOrganizationSchema: GenMessage<InvoiceWithOrganization> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 46);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 52);
 
 /**
  * Send Invoice Reminder Request
@@ -1900,7 +2112,7 @@ export type SendInvoiceReminderRequest = Message<"obiente.cloud.superadmin.v1.Se
  * Use `create(SendInvoiceReminderRequestSchema)` to create a new message.
  */
 export const SendInvoiceReminderRequestSchema: GenMessage<SendInvoiceReminderRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 47);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 53);
 
 /**
  * Send Invoice Reminder Response
@@ -1924,7 +2136,7 @@ export type SendInvoiceReminderResponse = Message<"obiente.cloud.superadmin.v1.S
  * Use `create(SendInvoiceReminderResponseSchema)` to create a new message.
  */
 export const SendInvoiceReminderResponseSchema: GenMessage<SendInvoiceReminderResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 48);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 54);
 
 /**
  * List Plans Request
@@ -1939,7 +2151,7 @@ export type ListPlansRequest = Message<"obiente.cloud.superadmin.v1.ListPlansReq
  * Use `create(ListPlansRequestSchema)` to create a new message.
  */
 export const ListPlansRequestSchema: GenMessage<ListPlansRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 49);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 55);
 
 /**
  * List Plans Response
@@ -1958,7 +2170,7 @@ export type ListPlansResponse = Message<"obiente.cloud.superadmin.v1.ListPlansRe
  * Use `create(ListPlansResponseSchema)` to create a new message.
  */
 export const ListPlansResponseSchema: GenMessage<ListPlansResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 50);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 56);
 
 /**
  * Create Plan Request
@@ -2024,6 +2236,13 @@ export type CreatePlanRequest = Message<"obiente.cloud.superadmin.v1.CreatePlanR
    */
   trialDays: number;
 
+  /**
+   * Maximum replicas per deployment (0 = unlimited)
+   *
+   * @generated from field: int32 max_replicas = 12;
+   */
+  maxReplicas: number;
+
   /**
    * Optional description of the plan
    *
@@ -2037,7 +2256,7 @@ export type CreatePlanRequest = Message<"obiente.cloud.superadmin.v1.CreatePlanR
  * Use `create(CreatePlanRequestSchema)` to create a new message.
  */
 export const CreatePlanRequestSchema: GenMessage<CreatePlanRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 51);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 57);
 
 /**
  * Create Plan Response
@@ -2056,7 +2275,7 @@ export type CreatePlanResponse = Message<"obiente.cloud.superadmin.v1.CreatePlan
  * Use `create(CreatePlanResponseSchema)` to create a new message.
  */
 export const CreatePlanResponseSchema: GenMessage<CreatePlanResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 52);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 58);
 
 /**
  * Update Plan Request
@@ -2123,6 +2342,13 @@ export type UpdatePlanRequest = Message<"obiente.cloud.superadmin.v1.UpdatePlanR
    */
   trialDays?: number;
 
+  /**
+   * Maximum replicas per deployment (0 = unlimited)
+   *
+   * @generated from field: optional int32 max_replicas = 13;
+   */
+  maxReplicas?: number;
+
   /**
    * @generated from field: optional string description = 10;
    */
@@ -2134,7 +2360,7 @@ export type UpdatePlanRequest = Message<"obiente.cloud.superadmin.v1.UpdatePlanR
  * Use `create(UpdatePlanRequestSchema)` to create a new message.
  */
 export const UpdatePlanRequestSchema: GenMessage<UpdatePlanRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 53);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 59);
 
 /**
  * Update Plan Response
@@ -2153,7 +2379,7 @@ export type UpdatePlanResponse = Message<"obiente.cloud.superadmin.v1.UpdatePlan
  * Use `create(UpdatePlanResponseSchema)` to create a new message.
  */
 export const UpdatePlanResponseSchema: GenMessage<UpdatePlanResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 54);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 60);
 
 /**
  * Delete Plan Request
@@ -2172,7 +2398,7 @@ export type DeletePlanRequest = Message<"obiente.cloud.superadmin.v1.DeletePlanR
  * Use `create(DeletePlanRequestSchema)` to create a new message.
  */
 export const DeletePlanRequestSchema: GenMessage<DeletePlanRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 55);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 61);
 
 /**
  * Delete Plan Response
@@ -2191,7 +2417,7 @@ export type DeletePlanResponse = Message<"obiente.cloud.superadmin.v1.DeletePlan
  * Use `create(DeletePlanResponseSchema)` to create a new message.
  */
 export const DeletePlanResponseSchema: GenMessage<DeletePlanResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 56);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 62);
 
 /**
  * Plan
@@ -2262,6 +2488,13 @@ export type Plan = Message<"obiente.cloud.superadmin.v1.Plan"> & {
    */
   trialDays: number;
 
+  /**
+   * Maximum replicas per deployment (0 = unlimited)
+   *
+   * @generated from field: int32 max_replicas = 13;
+   */
+  maxReplicas: number;
+
   /**
    * @generated from field: string description = 10;
    */
@@ -2273,7 +2506,7 @@ export type Plan = Message<"obiente.cloud.superadmin.v1.Plan"> & {
  * Use `create(PlanSchema)` to create a new message.
  */
 export const PlanSchema: GenMessage<Plan> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 57);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 63);
 
 /**
  * Assign Plan To Organization Request
@@ -2297,7 +2530,7 @@ export type AssignPlanToOrganizationRequest = Message<"obiente.cloud.superadmin.
  * Use `create(AssignPlanToOrganizationRequestSchema)` to create a new message.
  */
 export const AssignPlanToOrganizationRequestSchema: GenMessage<AssignPlanToOrganizationRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 58);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 64);
 
 /**
  * Assign Plan To Organization Response
@@ -2321,7 +2554,7 @@ export type AssignPlanToOrganizationResponse = Message<"obiente.cloud.superadmin
  * Use `create(AssignPlanToOrganizationResponseSchema)` to create a new message.
  */
 export const AssignPlanToOrganizationResponseSchema: GenMessage<AssignPlanToOrganizationResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 59);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 65);
 
 /**
  * List Users Request
@@ -2356,7 +2589,7 @@ export type ListUsersRequest = Message<"obiente.cloud.superadmin.v1.ListUsersReq
  * Use `create(ListUsersRequestSchema)` to create a new message.
  */
 export const ListUsersRequestSchema: GenMessage<ListUsersRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 60);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 66);
 
 /**
  * List Users Response
@@ -2380,7 +2613,7 @@ export type ListUsersResponse = Message<"obiente.cloud.superadmin.v1.ListUsersRe
  * Use `create(ListUsersResponseSchema)` to create a new message.
  */
 export const ListUsersResponseSchema: GenMessage<ListUsersResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 61);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 67);
 
 /**
  * Get User Request
@@ -2401,7 +2634,7 @@ export type GetUserRequest = Message<"obiente.cloud.superadmin.v1.GetUserRequest
  * Use `create(GetUserRequestSchema)` to create a new message.
  */
 export const GetUserRequestSchema: GenMessage<GetUserRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 62);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 68);
 
 /**
  * Get User Response
@@ -2427,7 +2660,7 @@ export type GetUserResponse = Message<"obiente.cloud.superadmin.v1.GetUserRespon
  * Use `create(GetUserResponseSchema)` to create a new message.
  */
 export const GetUserResponseSchema: GenMessage<GetUserResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 63);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 69);
 
 /**
  * List Dormant Resource Owners Request
@@ -2469,7 +2702,7 @@ export type ListDormantResourceOwnersRequest = Message<"obiente.cloud.superadmin
  * Use `create(ListDormantResourceOwnersRequestSchema)` to create a new message.
  */
 export const ListDormantResourceOwnersRequestSchema: GenMessage<ListDormantResourceOwnersRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 64);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 70);
 
 /**
  * Dormant resource summary for the current filter set
@@ -2513,7 +2746,7 @@ export type DormantResourceSummary = Message<"obiente.cloud.superadmin.v1.Dorman
  * Use `create(DormantResourceSummarySchema)` to create a new message.
  */
 export const DormantResourceSummarySchema: GenMessage<DormantResourceSummary> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 65);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 71);
 
 /**
  * Organization-specific retained resources for a dormant user
@@ -2562,7 +2795,7 @@ export type DormantResourceOrganization = Message<"obiente.cloud.superadmin.v1.D
  * Use `create(DormantResourceOrganizationSchema)` to create a new message.
  */
 export const DormantResourceOrganizationSchema: GenMessage<DormantResourceOrganization> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 66);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 72);
 
 /**
  * Dormant resource owner details
@@ -2643,7 +2876,7 @@ export type DormantResourceOwner = Message<"obiente.cloud.superadmin.v1.DormantR
  * Use `create(DormantResourceOwnerSchema)` to create a new message.
  */
 export const DormantResourceOwnerSchema: GenMessage<DormantResourceOwner> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 67);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 73);
 
 /**
  * List Dormant Resource Owners Response
@@ -2672,7 +2905,7 @@ export type ListDormantResourceOwnersResponse = Message<"obiente.cloud.superadmi
  * Use `create(ListDormantResourceOwnersResponseSchema)` to create a new message.
  */
 export const ListDormantResourceOwnersResponseSchema: GenMessage<ListDormantResourceOwnersResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 68);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 74);
 
 /**
  * User Info
@@ -2738,7 +2971,7 @@ export type UserInfo = Message<"obiente.cloud.superadmin.v1.UserInfo"> & {
  * Use `create(UserInfoSchema)` to create a new message.
  */
 export const UserInfoSchema: GenMessage<UserInfo> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 69);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 75);
 
 /**
  * User Organization
@@ -2781,7 +3014,7 @@ export type UserOrganization = Message<"obiente.cloud.superadmin.v1.UserOrganiza
  * Use `create(UserOrganizationSchema)` to create a new message.
  */
 export const UserOrganizationSchema: GenMessage<UserOrganization> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 70);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 76);
 
 /**
  * List All VPS Request
@@ -2830,7 +3063,7 @@ export type ListAllVPSRequest = Message<"obiente.cloud.superadmin.v1.ListAllVPSR
  * Use `create(ListAllVPSRequestSchema)` to create a new message.
  */
 export const ListAllVPSRequestSchema: GenMessage<ListAllVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 71);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 77);
 
 /**
  * VPS Overview
@@ -2870,7 +3103,7 @@ export type VPSOverview = Message<"obiente.cloud.superadmin.v1.VPSOverview"> & {
  * Use `create(VPSOverviewSchema)` to create a new message.
  */
 export const VPSOverviewSchema: GenMessage<VPSOverview> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 72);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 78);
 
 /**
  * List All VPS Response
@@ -2894,7 +3127,7 @@ export type ListAllVPSResponse = Message<"obiente.cloud.superadmin.v1.ListAllVPS
  * Use `create(ListAllVPSResponseSchema)` to create a new message.
  */
 export const ListAllVPSResponseSchema: GenMessage<ListAllVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 73);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 79);
 
 /**
  * List VPS Sizes Request
@@ -2922,7 +3155,7 @@ export type ListVPSSizesRequest = Message<"obiente.cloud.superadmin.v1.ListVPSSi
  * Use `create(ListVPSSizesRequestSchema)` to create a new message.
  */
 export const ListVPSSizesRequestSchema: GenMessage<ListVPSSizesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 74);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 80);
 
 /**
  * List VPS Sizes Response
@@ -2941,7 +3174,7 @@ export type ListVPSSizesResponse = Message<"obiente.cloud.superadmin.v1.ListVPSS
  * Use `create(ListVPSSizesResponseSchema)` to create a new message.
  */
 export const ListVPSSizesResponseSchema: GenMessage<ListVPSSizesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 75);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 81);
 
 /**
  * Create VPS Size Request
@@ -3025,7 +3258,7 @@ export type CreateVPSSizeRequest = Message<"obiente.cloud.superadmin.v1.CreateVP
  * Use `create(CreateVPSSizeRequestSchema)` to create a new message.
  */
 export const CreateVPSSizeRequestSchema: GenMessage<CreateVPSSizeRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 76);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 82);
 
 /**
  * Create VPS Size Response
@@ -3044,7 +3277,7 @@ export type CreateVPSSizeResponse = Message<"obiente.cloud.superadmin.v1.CreateV
  * Use `create(CreateVPSSizeResponseSchema)` to create a new message.
  */
 export const CreateVPSSizeResponseSchema: GenMessage<CreateVPSSizeResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 77);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 83);
 
 /**
  * Update VPS Size Request
@@ -3112,7 +3345,7 @@ export type UpdateVPSSizeRequest = Message<"obiente.cloud.superadmin.v1.UpdateVP
  * Use `create(UpdateVPSSizeRequestSchema)` to create a new message.
  */
 export const UpdateVPSSizeRequestSchema: GenMessage<UpdateVPSSizeRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 78);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 84);
 
 /**
  * Update VPS Size Response
@@ -3131,7 +3364,7 @@ export type UpdateVPSSizeResponse = Message<"obiente.cloud.superadmin.v1.UpdateV
  * Use `create(UpdateVPSSizeResponseSchema)` to create a new message.
  */
 export const UpdateVPSSizeResponseSchema: GenMessage<UpdateVPSSizeResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 79);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 85);
 
 /**
  * Delete VPS Size Request
@@ -3152,7 +3385,7 @@ export type DeleteVPSSizeRequest = Message<"obiente.cloud.superadmin.v1.DeleteVP
  * Use `create(DeleteVPSSizeRequestSchema)` to create a new message.
  */
 export const DeleteVPSSizeRequestSchema: GenMessage<DeleteVPSSizeRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 80);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 86);
 
 /**
  * Delete VPS Size Response
@@ -3171,7 +3404,7 @@ export type DeleteVPSSizeResponse = Message<"obiente.cloud.superadmin.v1.DeleteV
  * Use `create(DeleteVPSSizeResponseSchema)` to create a new message.
  */
 export const DeleteVPSSizeResponseSchema: GenMessage<DeleteVPSSizeResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 81);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 87);
 
 /**
  * Superadmin Get VPS Request (superadmin - bypasses organization checks)
@@ -3192,7 +3425,7 @@ export type SuperadminGetVPSRequest = Message<"obiente.cloud.superadmin.v1.Super
  * Use `create(SuperadminGetVPSRequestSchema)` to create a new message.
  */
 export const SuperadminGetVPSRequestSchema: GenMessage<SuperadminGetVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 82);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 88);
 
 /**
  * Superadmin Get VPS Response
@@ -3225,7 +3458,7 @@ export type SuperadminGetVPSResponse = Message<"obiente.cloud.superadmin.v1.Supe
  * Use `create(SuperadminGetVPSResponseSchema)` to create a new message.
  */
 export const SuperadminGetVPSResponseSchema: GenMessage<SuperadminGetVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 83);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 89);
 
 /**
  * Superadmin Resize VPS Request
@@ -3290,7 +3523,7 @@ export type SuperadminResizeVPSRequest = Message<"obiente.cloud.superadmin.v1.Su
  * Use `create(SuperadminResizeVPSRequestSchema)` to create a new message.
  */
 export const SuperadminResizeVPSRequestSchema: GenMessage<SuperadminResizeVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 84);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 90);
 
 /**
  * Superadmin Resize VPS Response
@@ -3316,7 +3549,7 @@ export type SuperadminResizeVPSResponse = Message<"obiente.cloud.superadmin.v1.S
  * Use `create(SuperadminResizeVPSResponseSchema)` to create a new message.
  */
 export const SuperadminResizeVPSResponseSchema: GenMessage<SuperadminResizeVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 85);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 91);
 
 /**
  * Superadmin Suspend VPS Request
@@ -3344,7 +3577,7 @@ export type SuperadminSuspendVPSRequest = Message<"obiente.cloud.superadmin.v1.S
  * Use `create(SuperadminSuspendVPSRequestSchema)` to create a new message.
  */
 export const SuperadminSuspendVPSRequestSchema: GenMessage<SuperadminSuspendVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 86);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 92);
 
 /**
  * Superadmin Suspend VPS Response
@@ -3368,7 +3601,7 @@ export type SuperadminSuspendVPSResponse = Message<"obiente.cloud.superadmin.v1.
  * Use `create(SuperadminSuspendVPSResponseSchema)` to create a new message.
  */
 export const SuperadminSuspendVPSResponseSchema: GenMessage<SuperadminSuspendVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 87);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 93);
 
 /**
  * Superadmin Unsuspend VPS Request
@@ -3389,7 +3622,7 @@ export type SuperadminUnsuspendVPSRequest = Message<"obiente.cloud.superadmin.v1
  * Use `create(SuperadminUnsuspendVPSRequestSchema)` to create a new message.
  */
 export const SuperadminUnsuspendVPSRequestSchema: GenMessage<SuperadminUnsuspendVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 88);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 94);
 
 /**
  * Superadmin Unsuspend VPS Response
@@ -3413,7 +3646,7 @@ export type SuperadminUnsuspendVPSResponse = Message<"obiente.cloud.superadmin.v
  * Use `create(SuperadminUnsuspendVPSResponseSchema)` to create a new message.
  */
 export const SuperadminUnsuspendVPSResponseSchema: GenMessage<SuperadminUnsuspendVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 89);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 95);
 
 /**
  * Superadmin Update VPS CloudInit Request
@@ -3448,7 +3681,7 @@ export type SuperadminUpdateVPSCloudInitRequest = Message<"obiente.cloud.superad
  * Use `create(SuperadminUpdateVPSCloudInitRequestSchema)` to create a new message.
  */
 export const SuperadminUpdateVPSCloudInitRequestSchema: GenMessage<SuperadminUpdateVPSCloudInitRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 90);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 96);
 
 /**
  * Superadmin Update VPS CloudInit Response
@@ -3472,7 +3705,7 @@ export type SuperadminUpdateVPSCloudInitResponse = Message<"obiente.cloud.supera
  * Use `create(SuperadminUpdateVPSCloudInitResponseSchema)` to create a new message.
  */
 export const SuperadminUpdateVPSCloudInitResponseSchema: GenMessage<SuperadminUpdateVPSCloudInitResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 91);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 97);
 
 /**
  * Superadmin Force Stop VPS Request
@@ -3493,7 +3726,7 @@ export type SuperadminForceStopVPSRequest = Message<"obiente.cloud.superadmin.v1
  * Use `create(SuperadminForceStopVPSRequestSchema)` to create a new message.
  */
 export const SuperadminForceStopVPSRequestSchema: GenMessage<SuperadminForceStopVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 92);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 98);
 
 /**
  * Superadmin Force Stop VPS Response
@@ -3517,7 +3750,7 @@ export type SuperadminForceStopVPSResponse = Message<"obiente.cloud.superadmin.v
  * Use `create(SuperadminForceStopVPSResponseSchema)` to create a new message.
  */
 export const SuperadminForceStopVPSResponseSchema: GenMessage<SuperadminForceStopVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 93);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 99);
 
 /**
  * Superadmin Force Delete VPS Request
@@ -3545,7 +3778,7 @@ export type SuperadminForceDeleteVPSRequest = Message<"obiente.cloud.superadmin.
  * Use `create(SuperadminForceDeleteVPSRequestSchema)` to create a new message.
  */
 export const SuperadminForceDeleteVPSRequestSchema: GenMessage<SuperadminForceDeleteVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 94);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 100);
 
 /**
  * Superadmin Force Delete VPS Response
@@ -3569,7 +3802,108 @@ export type SuperadminForceDeleteVPSResponse = Message<"obiente.cloud.superadmin
  * Use `create(SuperadminForceDeleteVPSResponseSchema)` to create a new message.
  */
 export const SuperadminForceDeleteVPSResponseSchema: GenMessage<SuperadminForceDeleteVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 95);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 101);
+
+/**
+ * Superadmin Migrate VPS Request
+ *
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminMigrateVPSRequest
+ */
+export type SuperadminMigrateVPSRequest = Message<"obiente.cloud.superadmin.v1.SuperadminMigrateVPSRequest"> & {
+  /**
+   * VPS ID to migrate
+   *
+   * @generated from field: string vps_id = 1;
+   */
+  vpsId: string;
+
+  /**
+   * Proxmox node name to migrate the VPS to
+   *
+   * @generated from field: string target_node = 2;
+   */
+  targetNode: string;
+
+  /**
+   * If true, migrate the VM while it is running (online migration)
+   *
+   * @generated from field: bool live = 3;
+   */
+  live: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminMigrateVPSRequest.
+ * Use `create(SuperadminMigrateVPSRequestSchema)` to create a new message.
+ */
+export const SuperadminMigrateVPSRequestSchema: GenMessage<SuperadminMigrateVPSRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 102);
+
+/**
+ * Superadmin Migrate VPS Response
+ *
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminMigrateVPSResponse
+ */
+export type SuperadminMigrateVPSResponse = Message<"obiente.cloud.superadmin.v1.SuperadminMigrateVPSResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.vps.v1.VPSInstance vps = 1;
+   */
+  vps?: VPSInstance;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminMigrateVPSResponse.
+ * Use `create(SuperadminMigrateVPSResponseSchema)` to create a new message.
+ */
+export const SuperadminMigrateVPSResponseSchema: GenMessage<SuperadminMigrateVPSResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 103);
+
+/**
+ * List Unpatched VPS Request
+ *
+ * @generated from message obiente.cloud.superadmin.v1.ListUnpatchedVPSRequest
+ */
+export type ListUnpatchedVPSRequest = Message<"obiente.cloud.superadmin.v1.ListUnpatchedVPSRequest"> & {
+  /**
+   * Minimum days since the last patch run (default: 30)
+   *
+   * @generated from field: int32 days = 1;
+   */
+  days: number;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.ListUnpatchedVPSRequest.
+ * Use `create(ListUnpatchedVPSRequestSchema)` to create a new message.
+ */
+export const ListUnpatchedVPSRequestSchema: GenMessage<ListUnpatchedVPSRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 104);
+
+/**
+ * List Unpatched VPS Response
+ *
+ * @generated from message obiente.cloud.superadmin.v1.ListUnpatchedVPSResponse
+ */
+export type ListUnpatchedVPSResponse = Message<"obiente.cloud.superadmin.v1.ListUnpatchedVPSResponse"> & {
+  /**
+   * Least recently patched first
+   *
+   * @generated from field: repeated obiente.cloud.vps.v1.VPSInstance vps_instances = 1;
+   */
+  vpsInstances: VPSInstance[];
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.ListUnpatchedVPSResponse.
+ * Use `create(ListUnpatchedVPSResponseSchema)` to create a new message.
+ */
+export const ListUnpatchedVPSResponseSchema: GenMessage<ListUnpatchedVPSResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 105);
 
 /**
  * List Stripe Webhook Events Request
@@ -3632,7 +3966,7 @@ export type ListStripeWebhookEventsRequest = Message<"obiente.cloud.superadmin.v
  * Use `create(ListStripeWebhookEventsRequestSchema)` to create a new message.
  */
 export const ListStripeWebhookEventsRequestSchema: GenMessage<ListStripeWebhookEventsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 96);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 106);
 
 /**
  * Stripe Webhook Event with organization info
@@ -3716,7 +4050,7 @@ export type StripeWebhookEvent = Message<"obiente.cloud.superadmin.v1.StripeWebh
  * Use `create(StripeWebhookEventSchema)` to create a new message.
  */
 export const StripeWebhookEventSchema: GenMessage<StripeWebhookEvent> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 97);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 107);
 
 /**
  * List Stripe Webhook Events Response
@@ -3742,7 +4076,7 @@ export type ListStripeWebhookEventsResponse = Message<"obiente.cloud.superadmin.
  * Use `create(ListStripeWebhookEventsResponseSchema)` to create a new message.
  */
 export const ListStripeWebhookEventsResponseSchema: GenMessage<ListStripeWebhookEventsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 98);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 108);
 
 /**
  * List Nodes Request
@@ -3784,7 +4118,7 @@ export type ListNodesRequest = Message<"obiente.cloud.superadmin.v1.ListNodesReq
  * Use `create(ListNodesRequestSchema)` to create a new message.
  */
 export const ListNodesRequestSchema: GenMessage<ListNodesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 99);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 109);
 
 /**
  * List Nodes Response
@@ -3803,7 +4137,7 @@ export type ListNodesResponse = Message<"obiente.cloud.superadmin.v1.ListNodesRe
  * Use `create(ListNodesResponseSchema)` to create a new message.
  */
 export const ListNodesResponseSchema: GenMessage<ListNodesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 100);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 110);
 
 /**
  * Get Node Request
@@ -3824,7 +4158,7 @@ export type GetNodeRequest = Message<"obiente.cloud.superadmin.v1.GetNodeRequest
  * Use `create(GetNodeRequestSchema)` to create a new message.
  */
 export const GetNodeRequestSchema: GenMessage<GetNodeRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 101);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 111);
 
 /**
  * Get Node Response
@@ -3843,7 +4177,7 @@ export type GetNodeResponse = Message<"obiente.cloud.superadmin.v1.GetNodeRespon
  * Use `create(GetNodeResponseSchema)` to create a new message.
  */
 export const GetNodeResponseSchema: GenMessage<GetNodeResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 102);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 112);
 
 /**
  * Update Node Config Request
@@ -3906,7 +4240,7 @@ export type UpdateNodeConfigRequest = Message<"obiente.cloud.superadmin.v1.Updat
  * Use `create(UpdateNodeConfigRequestSchema)` to create a new message.
  */
 export const UpdateNodeConfigRequestSchema: GenMessage<UpdateNodeConfigRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 103);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 113);
 
 /**
  * Update Node Config Response
@@ -3932,7 +4266,7 @@ export type UpdateNodeConfigResponse = Message<"obiente.cloud.superadmin.v1.Upda
  * Use `create(UpdateNodeConfigResponseSchema)` to create a new message.
  */
 export const UpdateNodeConfigResponseSchema: GenMessage<UpdateNodeConfigResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 104);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 114);
 
 /**
  * Node Info
@@ -4055,7 +4389,7 @@ export type NodeInfo = Message<"obiente.cloud.superadmin.v1.NodeInfo"> & {
  * Use `create(NodeInfoSchema)` to create a new message.
  */
 export const NodeInfoSchema: GenMessage<NodeInfo> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 105);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 115);
 
 /**
  * Node Configuration (stored in Labels JSON field)
@@ -4097,7 +4431,7 @@ export type NodeConfig = Message<"obiente.cloud.superadmin.v1.NodeConfig"> & {
  * Use `create(NodeConfigSchema)` to create a new message.
  */
 export const NodeConfigSchema: GenMessage<NodeConfig> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 106);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 116);
 
 /**
  * Superadmin permissions catalog
@@ -4112,7 +4446,7 @@ export type ListSuperadminPermissionsRequest = Message<"obiente.cloud.superadmin
  * Use `create(ListSuperadminPermissionsRequestSchema)` to create a new message.
  */
 export const ListSuperadminPermissionsRequestSchema: GenMessage<ListSuperadminPermissionsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 107);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 117);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.SuperadminPermissionDefinition
@@ -4145,7 +4479,7 @@ export type SuperadminPermissionDefinition = Message<"obiente.cloud.superadmin.v
  * Use `create(SuperadminPermissionDefinitionSchema)` to create a new message.
  */
 export const SuperadminPermissionDefinitionSchema: GenMessage<SuperadminPermissionDefinition> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 108);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 118);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.ListSuperadminPermissionsResponse
@@ -4162,7 +4496,7 @@ export type ListSuperadminPermissionsResponse = Message<"obiente.cloud.superadmi
  * Use `create(ListSuperadminPermissionsResponseSchema)` to create a new message.
  */
 export const ListSuperadminPermissionsResponseSchema: GenMessage<ListSuperadminPermissionsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 109);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 119);
 
 /**
  * Get current user's superadmin permissions
@@ -4177,7 +4511,7 @@ export type GetMySuperadminPermissionsRequest = Message<"obiente.cloud.superadmi
  * Use `create(GetMySuperadminPermissionsRequestSchema)` to create a new message.
  */
 export const GetMySuperadminPermissionsRequestSchema: GenMessage<GetMySuperadminPermissionsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 110);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 120);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.GetMySuperadminPermissionsResponse
@@ -4203,7 +4537,7 @@ export type GetMySuperadminPermissionsResponse = Message<"obiente.cloud.superadm
  * Use `create(GetMySuperadminPermissionsResponseSchema)` to create a new message.
  */
 export const GetMySuperadminPermissionsResponseSchema: GenMessage<GetMySuperadminPermissionsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 111);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 121);
 
 /**
  * Superadmin role management messages
@@ -4218,7 +4552,7 @@ export type ListSuperadminRolesRequest = Message<"obiente.cloud.superadmin.v1.Li
  * Use `create(ListSuperadminRolesRequestSchema)` to create a new message.
  */
 export const ListSuperadminRolesRequestSchema: GenMessage<ListSuperadminRolesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 112);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 122);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.SuperadminRole
@@ -4250,7 +4584,7 @@ export type SuperadminRole = Message<"obiente.cloud.superadmin.v1.SuperadminRole
  * Use `create(SuperadminRoleSchema)` to create a new message.
  */
 export const SuperadminRoleSchema: GenMessage<SuperadminRole> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 113);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 123);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.ListSuperadminRolesResponse
@@ -4267,7 +4601,7 @@ export type ListSuperadminRolesResponse = Message<"obiente.cloud.superadmin.v1.L
  * Use `create(ListSuperadminRolesResponseSchema)` to create a new message.
  */
 export const ListSuperadminRolesResponseSchema: GenMessage<ListSuperadminRolesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 114);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 124);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.CreateSuperadminRoleRequest
@@ -4294,7 +4628,7 @@ export type CreateSuperadminRoleRequest = Message<"obiente.cloud.superadmin.v1.C
  * Use `create(CreateSuperadminRoleRequestSchema)` to create a new message.
  */
 export const CreateSuperadminRoleRequestSchema: GenMessage<CreateSuperadminRoleRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 115);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 125);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.CreateSuperadminRoleResponse
@@ -4311,7 +4645,7 @@ export type CreateSuperadminRoleResponse = Message<"obiente.cloud.superadmin.v1.
  * Use `create(CreateSuperadminRoleResponseSchema)` to create a new message.
  */
 export const CreateSuperadminRoleResponseSchema: GenMessage<CreateSuperadminRoleResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 116);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 126);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.UpdateSuperadminRoleRequest
@@ -4343,7 +4677,7 @@ export type UpdateSuperadminRoleRequest = Message<"obiente.cloud.superadmin.v1.U
  * Use `create(UpdateSuperadminRoleRequestSchema)` to create a new message.
  */
 export const UpdateSuperadminRoleRequestSchema: GenMessage<UpdateSuperadminRoleRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 117);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 127);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.UpdateSuperadminRoleResponse
@@ -4360,7 +4694,7 @@ export type UpdateSuperadminRoleResponse = Message<"obiente.cloud.superadmin.v1.
  * Use `create(UpdateSuperadminRoleResponseSchema)` to create a new message.
  */
 export const UpdateSuperadminRoleResponseSchema: GenMessage<UpdateSuperadminRoleResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 118);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 128);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.DeleteSuperadminRoleRequest
@@ -4377,7 +4711,7 @@ export type DeleteSuperadminRoleRequest = Message<"obiente.cloud.superadmin.v1.D
  * Use `create(DeleteSuperadminRoleRequestSchema)` to create a new message.
  */
 export const DeleteSuperadminRoleRequestSchema: GenMessage<DeleteSuperadminRoleRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 119);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 129);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.DeleteSuperadminRoleResponse
@@ -4394,7 +4728,7 @@ export type DeleteSuperadminRoleResponse = Message<"obiente.cloud.superadmin.v1.
  * Use `create(DeleteSuperadminRoleResponseSchema)` to create a new message.
  */
 export const DeleteSuperadminRoleResponseSchema: GenMessage<DeleteSuperadminRoleResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 120);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 130);
 
 /**
  * Superadmin role binding messages
@@ -4409,7 +4743,7 @@ export type ListSuperadminRoleBindingsRequest = Message<"obiente.cloud.superadmi
  * Use `create(ListSuperadminRoleBindingsRequestSchema)` to create a new message.
  */
 export const ListSuperadminRoleBindingsRequestSchema: GenMessage<ListSuperadminRoleBindingsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 121);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 131);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.SuperadminRoleBinding
@@ -4436,7 +4770,7 @@ export type SuperadminRoleBinding = Message<"obiente.cloud.superadmin.v1.Superad
  * Use `create(SuperadminRoleBindingSchema)` to create a new message.
  */
 export const SuperadminRoleBindingSchema: GenMessage<SuperadminRoleBinding> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 122);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 132);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.ListSuperadminRoleBindingsResponse
@@ -4453,7 +4787,7 @@ export type ListSuperadminRoleBindingsResponse = Message<"obiente.cloud.superadm
  * Use `create(ListSuperadminRoleBindingsResponseSchema)` to create a new message.
  */
 export const ListSuperadminRoleBindingsResponseSchema: GenMessage<ListSuperadminRoleBindingsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 123);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 133);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingRequest
@@ -4475,7 +4809,7 @@ export type CreateSuperadminRoleBindingRequest = Message<"obiente.cloud.superadm
  * Use `create(CreateSuperadminRoleBindingRequestSchema)` to create a new message.
  */
 export const CreateSuperadminRoleBindingRequestSchema: GenMessage<CreateSuperadminRoleBindingRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 124);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 134);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.CreateSuperadminRoleBindingResponse
@@ -4492,7 +4826,7 @@ export type CreateSuperadminRoleBindingResponse = Message<"obiente.cloud.superad
  * Use `create(CreateSuperadminRoleBindingResponseSchema)` to create a new message.
  */
 export const CreateSuperadminRoleBindingResponseSchema: GenMessage<CreateSuperadminRoleBindingResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 125);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 135);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.DeleteSuperadminRoleBindingRequest
@@ -4509,7 +4843,7 @@ export type DeleteSuperadminRoleBindingRequest = Message<"obiente.cloud.superadm
  * Use `create(DeleteSuperadminRoleBindingRequestSchema)` to create a new message.
  */
 export const DeleteSuperadminRoleBindingRequestSchema: GenMessage<DeleteSuperadminRoleBindingRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 126);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 136);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.DeleteSuperadminRoleBindingResponse
@@ -4526,7 +4860,7 @@ export type DeleteSuperadminRoleBindingResponse = Message<"obiente.cloud.superad
  * Use `create(DeleteSuperadminRoleBindingResponseSchema)` to create a new message.
  */
 export const DeleteSuperadminRoleBindingResponseSchema: GenMessage<DeleteSuperadminRoleBindingResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 127);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 137);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.SuspendUserRequest
@@ -4555,7 +4889,7 @@ export type SuspendUserRequest = Message<"obiente.cloud.superadmin.v1.SuspendUse
  * Use `create(SuspendUserRequestSchema)` to create a new message.
  */
 export const SuspendUserRequestSchema: GenMessage<SuspendUserRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 128);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 138);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.SuspendUserResponse
@@ -4577,7 +4911,7 @@ export type SuspendUserResponse = Message<"obiente.cloud.superadmin.v1.SuspendUs
  * Use `create(SuspendUserResponseSchema)` to create a new message.
  */
 export const SuspendUserResponseSchema: GenMessage<SuspendUserResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 129);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 139);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.UnsuspendUserRequest
@@ -4594,7 +4928,7 @@ export type UnsuspendUserRequest = Message<"obiente.cloud.superadmin.v1.Unsuspen
  * Use `create(UnsuspendUserRequestSchema)` to create a new message.
  */
 export const UnsuspendUserRequestSchema: GenMessage<UnsuspendUserRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 130);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 140);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.UnsuspendUserResponse
@@ -4611,7 +4945,7 @@ export type UnsuspendUserResponse = Message<"obiente.cloud.superadmin.v1.Unsuspe
  * Use `create(UnsuspendUserResponseSchema)` to create a new message.
  */
 export const UnsuspendUserResponseSchema: GenMessage<UnsuspendUserResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 131);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 141);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.BanUserRequest
@@ -4633,7 +4967,7 @@ export type BanUserRequest = Message<"obiente.cloud.superadmin.v1.BanUserRequest
  * Use `create(BanUserRequestSchema)` to create a new message.
  */
 export const BanUserRequestSchema: GenMessage<BanUserRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 132);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 142);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.BanUserResponse
@@ -4655,7 +4989,7 @@ export type BanUserResponse = Message<"obiente.cloud.superadmin.v1.BanUserRespon
  * Use `create(BanUserResponseSchema)` to create a new message.
  */
 export const BanUserResponseSchema: GenMessage<BanUserResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 133);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 143);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.UnbanUserRequest
@@ -4672,7 +5006,7 @@ export type UnbanUserRequest = Message<"obiente.cloud.superadmin.v1.UnbanUserReq
  * Use `create(UnbanUserRequestSchema)` to create a new message.
  */
 export const UnbanUserRequestSchema: GenMessage<UnbanUserRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 134);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 144);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.UnbanUserResponse
@@ -4689,7 +5023,7 @@ export type UnbanUserResponse = Message<"obiente.cloud.superadmin.v1.UnbanUserRe
  * Use `create(UnbanUserResponseSchema)` to create a new message.
  */
 export const UnbanUserResponseSchema: GenMessage<UnbanUserResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 135);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 145);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.GetUserBanStatusRequest
@@ -4706,7 +5040,7 @@ export type GetUserBanStatusRequest = Message<"obiente.cloud.superadmin.v1.GetUs
  * Use `create(GetUserBanStatusRequestSchema)` to create a new message.
  */
 export const GetUserBanStatusRequestSchema: GenMessage<GetUserBanStatusRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 136);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 146);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.GetUserBanStatusResponse
@@ -4725,7 +5059,7 @@ export type GetUserBanStatusResponse = Message<"obiente.cloud.superadmin.v1.GetU
  * Use `create(GetUserBanStatusResponseSchema)` to create a new message.
  */
 export const GetUserBanStatusResponseSchema: GenMessage<GetUserBanStatusResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 137);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 147);
 
 /**
  * UserBanInfo represents a current or historical ban/suspension record
@@ -4785,41 +5119,339 @@ export type UserBanInfo = Message<"obiente.cloud.superadmin.v1.UserBanInfo"> & {
  * Use `create(UserBanInfoSchema)` to create a new message.
  */
 export const UserBanInfoSchema: GenMessage<UserBanInfo> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 138);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 148);
 
 /**
- * @generated from message obiente.cloud.superadmin.v1.SuspendOrganizationRequest
+ * BanEntry blocks logins from an IP range or with emails of a domain
+ *
+ * @generated from message obiente.cloud.superadmin.v1.BanEntry
  */
-export type SuspendOrganizationRequest = Message<"obiente.cloud.superadmin.v1.SuspendOrganizationRequest"> & {
+export type BanEntry = Message<"obiente.cloud.superadmin.v1.BanEntry"> & {
   /**
-   * @generated from field: string organization_id = 1;
+   * @generated from field: string id = 1;
    */
-  organizationId: string;
+  id: string;
 
   /**
-   * @generated from field: optional string reason = 2;
+   * "ip_range" | "email_domain"
+   *
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * CIDR block, or a domain where "*.example.com" matches all subdomains of example.com
+   *
+   * @generated from field: string value = 3;
+   */
+  value: string;
+
+  /**
+   * @generated from field: optional string reason = 4;
    */
   reason?: string;
 
   /**
-   * @generated from field: optional google.protobuf.Timestamp expires_at = 3;
+   * superadmin user ID
+   *
+   * @generated from field: string created_by = 5;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * null = permanent
+   *
+   * @generated from field: optional google.protobuf.Timestamp expires_at = 7;
    */
   expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: bool is_active = 8;
+   */
+  isActive: boolean;
 };
 
 /**
- * Describes the message obiente.cloud.superadmin.v1.SuspendOrganizationRequest.
- * Use `create(SuspendOrganizationRequestSchema)` to create a new message.
+ * Describes the message obiente.cloud.superadmin.v1.BanEntry.
+ * Use `create(BanEntrySchema)` to create a new message.
  */
-export const SuspendOrganizationRequestSchema: GenMessage<SuspendOrganizationRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 139);
+export const BanEntrySchema: GenMessage<BanEntry> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 149);
 
 /**
- * @generated from message obiente.cloud.superadmin.v1.SuspendOrganizationResponse
+ * @generated from message obiente.cloud.superadmin.v1.CreateBanEntryRequest
  */
-export type SuspendOrganizationResponse = Message<"obiente.cloud.superadmin.v1.SuspendOrganizationResponse"> & {
+export type CreateBanEntryRequest = Message<"obiente.cloud.superadmin.v1.CreateBanEntryRequest"> & {
   /**
-   * @generated from field: string message = 1;
+   * "ip_range" | "email_domain"
+   *
+   * @generated from field: string type = 1;
+   */
+  type: string;
+
+  /**
+   * A single IP is stored as a /32 or /128 range
+   *
+   * @generated from field: string value = 2;
+   */
+  value: string;
+
+  /**
+   * @generated from field: optional string reason = 3;
+   */
+  reason?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp expires_at = 4;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.CreateBanEntryRequest.
+ * Use `create(CreateBanEntryRequestSchema)` to create a new message.
+ */
+export const CreateBanEntryRequestSchema: GenMessage<CreateBanEntryRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 150);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.CreateBanEntryResponse
+ */
+export type CreateBanEntryResponse = Message<"obiente.cloud.superadmin.v1.CreateBanEntryResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.superadmin.v1.BanEntry entry = 1;
+   */
+  entry?: BanEntry;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.CreateBanEntryResponse.
+ * Use `create(CreateBanEntryResponseSchema)` to create a new message.
+ */
+export const CreateBanEntryResponseSchema: GenMessage<CreateBanEntryResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 151);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.DeleteBanEntryRequest
+ */
+export type DeleteBanEntryRequest = Message<"obiente.cloud.superadmin.v1.DeleteBanEntryRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.DeleteBanEntryRequest.
+ * Use `create(DeleteBanEntryRequestSchema)` to create a new message.
+ */
+export const DeleteBanEntryRequestSchema: GenMessage<DeleteBanEntryRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 152);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.DeleteBanEntryResponse
+ */
+export type DeleteBanEntryResponse = Message<"obiente.cloud.superadmin.v1.DeleteBanEntryResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.DeleteBanEntryResponse.
+ * Use `create(DeleteBanEntryResponseSchema)` to create a new message.
+ */
+export const DeleteBanEntryResponseSchema: GenMessage<DeleteBanEntryResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 153);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.ListBanEntriesRequest
+ */
+export type ListBanEntriesRequest = Message<"obiente.cloud.superadmin.v1.ListBanEntriesRequest"> & {
+  /**
+   * Only entries of this type
+   *
+   * @generated from field: optional string type = 1;
+   */
+  type?: string;
+
+  /**
+   * @generated from field: bool include_expired = 2;
+   */
+  includeExpired: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.ListBanEntriesRequest.
+ * Use `create(ListBanEntriesRequestSchema)` to create a new message.
+ */
+export const ListBanEntriesRequestSchema: GenMessage<ListBanEntriesRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 154);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.ListBanEntriesResponse
+ */
+export type ListBanEntriesResponse = Message<"obiente.cloud.superadmin.v1.ListBanEntriesResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.superadmin.v1.BanEntry entries = 1;
+   */
+  entries: BanEntry[];
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.ListBanEntriesResponse.
+ * Use `create(ListBanEntriesResponseSchema)` to create a new message.
+ */
+export const ListBanEntriesResponseSchema: GenMessage<ListBanEntriesResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 155);
+
+/**
+ * OrgIPAllocation is a subnet an organization can take static VPS IPs from
+ *
+ * @generated from message obiente.cloud.superadmin.v1.OrgIPAllocation
+ */
+export type OrgIPAllocation = Message<"obiente.cloud.superadmin.v1.OrgIPAllocation"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId: string;
+
+  /**
+   * e.g. "10.20.0.0/24"
+   *
+   * @generated from field: string cidr = 3;
+   */
+  cidr: string;
+
+  /**
+   * @generated from field: string gateway = 4;
+   */
+  gateway: string;
+
+  /**
+   * @generated from field: repeated string dns_servers = 5;
+   */
+  dnsServers: string[];
+
+  /**
+   * superadmin user ID
+   *
+   * @generated from field: string created_by = 6;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.OrgIPAllocation.
+ * Use `create(OrgIPAllocationSchema)` to create a new message.
+ */
+export const OrgIPAllocationSchema: GenMessage<OrgIPAllocation> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 156);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.AllocateStaticIPRequest
+ */
+export type AllocateStaticIPRequest = Message<"obiente.cloud.superadmin.v1.AllocateStaticIPRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * Must not overlap a subnet allocated to any organization
+   *
+   * @generated from field: string cidr = 2;
+   */
+  cidr: string;
+
+  /**
+   * Host address inside the subnet
+   *
+   * @generated from field: string gateway = 3;
+   */
+  gateway: string;
+
+  /**
+   * Default DNS servers for VPSes using the subnet
+   *
+   * @generated from field: repeated string dns_servers = 4;
+   */
+  dnsServers: string[];
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.AllocateStaticIPRequest.
+ * Use `create(AllocateStaticIPRequestSchema)` to create a new message.
+ */
+export const AllocateStaticIPRequestSchema: GenMessage<AllocateStaticIPRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 157);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.AllocateStaticIPResponse
+ */
+export type AllocateStaticIPResponse = Message<"obiente.cloud.superadmin.v1.AllocateStaticIPResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.superadmin.v1.OrgIPAllocation allocation = 1;
+   */
+  allocation?: OrgIPAllocation;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.AllocateStaticIPResponse.
+ * Use `create(AllocateStaticIPResponseSchema)` to create a new message.
+ */
+export const AllocateStaticIPResponseSchema: GenMessage<AllocateStaticIPResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 158);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuspendOrganizationRequest
+ */
+export type SuspendOrganizationRequest = Message<"obiente.cloud.superadmin.v1.SuspendOrganizationRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: optional string reason = 2;
+   */
+  reason?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuspendOrganizationRequest.
+ * Use `create(SuspendOrganizationRequestSchema)` to create a new message.
+ */
+export const SuspendOrganizationRequestSchema: GenMessage<SuspendOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 159);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuspendOrganizationResponse
+ */
+export type SuspendOrganizationResponse = Message<"obiente.cloud.superadmin.v1.SuspendOrganizationResponse"> & {
+  /**
+   * @generated from field: string message = 1;
    */
   message: string;
 
@@ -4836,7 +5468,7 @@ export type SuspendOrganizationResponse = Message<"obiente.cloud.superadmin.v1.S
  * Use `create(SuspendOrganizationResponseSchema)` to create a new message.
  */
 export const SuspendOrganizationResponseSchema: GenMessage<SuspendOrganizationResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 140);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 160);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.UnsuspendOrganizationRequest
@@ -4853,7 +5485,7 @@ export type UnsuspendOrganizationRequest = Message<"obiente.cloud.superadmin.v1.
  * Use `create(UnsuspendOrganizationRequestSchema)` to create a new message.
  */
 export const UnsuspendOrganizationRequestSchema: GenMessage<UnsuspendOrganizationRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 141);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 161);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.UnsuspendOrganizationResponse
@@ -4870,7 +5502,7 @@ export type UnsuspendOrganizationResponse = Message<"obiente.cloud.superadmin.v1
  * Use `create(UnsuspendOrganizationResponseSchema)` to create a new message.
  */
 export const UnsuspendOrganizationResponseSchema: GenMessage<UnsuspendOrganizationResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 142);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 162);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.BanOrganizationRequest
@@ -4892,7 +5524,7 @@ export type BanOrganizationRequest = Message<"obiente.cloud.superadmin.v1.BanOrg
  * Use `create(BanOrganizationRequestSchema)` to create a new message.
  */
 export const BanOrganizationRequestSchema: GenMessage<BanOrganizationRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 143);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 163);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.BanOrganizationResponse
@@ -4914,7 +5546,7 @@ export type BanOrganizationResponse = Message<"obiente.cloud.superadmin.v1.BanOr
  * Use `create(BanOrganizationResponseSchema)` to create a new message.
  */
 export const BanOrganizationResponseSchema: GenMessage<BanOrganizationResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 144);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 164);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.UnbanOrganizationRequest
@@ -4931,7 +5563,7 @@ export type UnbanOrganizationRequest = Message<"obiente.cloud.superadmin.v1.Unba
  * Use `create(UnbanOrganizationRequestSchema)` to create a new message.
  */
 export const UnbanOrganizationRequestSchema: GenMessage<UnbanOrganizationRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 145);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 165);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.UnbanOrganizationResponse
@@ -4948,7 +5580,7 @@ export type UnbanOrganizationResponse = Message<"obiente.cloud.superadmin.v1.Unb
  * Use `create(UnbanOrganizationResponseSchema)` to create a new message.
  */
 export const UnbanOrganizationResponseSchema: GenMessage<UnbanOrganizationResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 146);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 166);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.GameServerOverview
@@ -4999,7 +5631,7 @@ export type GameServerOverview = Message<"obiente.cloud.superadmin.v1.GameServer
  * Use `create(GameServerOverviewSchema)` to create a new message.
  */
 export const GameServerOverviewSchema: GenMessage<GameServerOverview> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 147);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 167);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.ListAllGameServersRequest
@@ -5045,7 +5677,7 @@ export type ListAllGameServersRequest = Message<"obiente.cloud.superadmin.v1.Lis
  * Use `create(ListAllGameServersRequestSchema)` to create a new message.
  */
 export const ListAllGameServersRequestSchema: GenMessage<ListAllGameServersRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 148);
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 168);
 
 /**
  * @generated from message obiente.cloud.superadmin.v1.ListAllGameServersResponse
@@ -5054,230 +5686,845 @@ export type ListAllGameServersResponse = Message<"obiente.cloud.superadmin.v1.Li
   /**
    * @generated from field: repeated obiente.cloud.superadmin.v1.GameServerOverview game_servers = 1;
    */
-  gameServers: GameServerOverview[];
+  gameServers: GameServerOverview[];
+
+  /**
+   * @generated from field: obiente.cloud.common.v1.Pagination pagination = 2;
+   */
+  pagination?: Pagination;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.ListAllGameServersResponse.
+ * Use `create(ListAllGameServersResponseSchema)` to create a new message.
+ */
+export const ListAllGameServersResponseSchema: GenMessage<ListAllGameServersResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 169);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminGetGameServerRequest
+ */
+export type SuperadminGetGameServerRequest = Message<"obiente.cloud.superadmin.v1.SuperadminGetGameServerRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminGetGameServerRequest.
+ * Use `create(SuperadminGetGameServerRequestSchema)` to create a new message.
+ */
+export const SuperadminGetGameServerRequestSchema: GenMessage<SuperadminGetGameServerRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 170);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse
+ */
+export type SuperadminGetGameServerResponse = Message<"obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.superadmin.v1.GameServerOverview game_server = 1;
+   */
+  gameServer?: GameServerOverview;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse.
+ * Use `create(SuperadminGetGameServerResponseSchema)` to create a new message.
+ */
+export const SuperadminGetGameServerResponseSchema: GenMessage<SuperadminGetGameServerResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 171);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminSuspendGameServerRequest
+ */
+export type SuperadminSuspendGameServerRequest = Message<"obiente.cloud.superadmin.v1.SuperadminSuspendGameServerRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * @generated from field: optional string reason = 2;
+   */
+  reason?: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminSuspendGameServerRequest.
+ * Use `create(SuperadminSuspendGameServerRequestSchema)` to create a new message.
+ */
+export const SuperadminSuspendGameServerRequestSchema: GenMessage<SuperadminSuspendGameServerRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 172);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse
+ */
+export type SuperadminSuspendGameServerResponse = Message<"obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.gameservers.v1.GameServer game_server = 1;
+   */
+  gameServer?: GameServer;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse.
+ * Use `create(SuperadminSuspendGameServerResponseSchema)` to create a new message.
+ */
+export const SuperadminSuspendGameServerResponseSchema: GenMessage<SuperadminSuspendGameServerResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 173);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerRequest
+ */
+export type SuperadminUnsuspendGameServerRequest = Message<"obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerRequest.
+ * Use `create(SuperadminUnsuspendGameServerRequestSchema)` to create a new message.
+ */
+export const SuperadminUnsuspendGameServerRequestSchema: GenMessage<SuperadminUnsuspendGameServerRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 174);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse
+ */
+export type SuperadminUnsuspendGameServerResponse = Message<"obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.gameservers.v1.GameServer game_server = 1;
+   */
+  gameServer?: GameServer;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse.
+ * Use `create(SuperadminUnsuspendGameServerResponseSchema)` to create a new message.
+ */
+export const SuperadminUnsuspendGameServerResponseSchema: GenMessage<SuperadminUnsuspendGameServerResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 175);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminForceStopGameServerRequest
+ */
+export type SuperadminForceStopGameServerRequest = Message<"obiente.cloud.superadmin.v1.SuperadminForceStopGameServerRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * @generated from field: optional string reason = 2;
+   */
+  reason?: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminForceStopGameServerRequest.
+ * Use `create(SuperadminForceStopGameServerRequestSchema)` to create a new message.
+ */
+export const SuperadminForceStopGameServerRequestSchema: GenMessage<SuperadminForceStopGameServerRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 176);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse
+ */
+export type SuperadminForceStopGameServerResponse = Message<"obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.gameservers.v1.GameServer game_server = 1;
+   */
+  gameServer?: GameServer;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse.
+ * Use `create(SuperadminForceStopGameServerResponseSchema)` to create a new message.
+ */
+export const SuperadminForceStopGameServerResponseSchema: GenMessage<SuperadminForceStopGameServerResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 177);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerRequest
+ */
+export type SuperadminForceDeleteGameServerRequest = Message<"obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerRequest"> & {
+  /**
+   * @generated from field: string game_server_id = 1;
+   */
+  gameServerId: string;
+
+  /**
+   * @generated from field: optional string reason = 2;
+   */
+  reason?: string;
+
+  /**
+   * @generated from field: bool hard_delete = 3;
+   */
+  hardDelete: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerRequest.
+ * Use `create(SuperadminForceDeleteGameServerRequestSchema)` to create a new message.
+ */
+export const SuperadminForceDeleteGameServerRequestSchema: GenMessage<SuperadminForceDeleteGameServerRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 178);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerResponse
+ */
+export type SuperadminForceDeleteGameServerResponse = Message<"obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerResponse.
+ * Use `create(SuperadminForceDeleteGameServerResponseSchema)` to create a new message.
+ */
+export const SuperadminForceDeleteGameServerResponseSchema: GenMessage<SuperadminForceDeleteGameServerResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 179);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.LiftSuspensionRequest
+ */
+export type LiftSuspensionRequest = Message<"obiente.cloud.superadmin.v1.LiftSuspensionRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.LiftSuspensionRequest.
+ * Use `create(LiftSuspensionRequestSchema)` to create a new message.
+ */
+export const LiftSuspensionRequestSchema: GenMessage<LiftSuspensionRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 180);
+
+/**
+ * @generated from message obiente.cloud.superadmin.v1.LiftSuspensionResponse
+ */
+export type LiftSuspensionResponse = Message<"obiente.cloud.superadmin.v1.LiftSuspensionResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+
+  /**
+   * new status
+   *
+   * @generated from field: string status = 2;
+   */
+  status: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.LiftSuspensionResponse.
+ * Use `create(LiftSuspensionResponseSchema)` to create a new message.
+ */
+export const LiftSuspensionResponseSchema: GenMessage<LiftSuspensionResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 181);
+
+/**
+ * Get Platform Usage Summary Request
+ *
+ * @generated from message obiente.cloud.superadmin.v1.GetPlatformUsageSummaryRequest
+ */
+export type GetPlatformUsageSummaryRequest = Message<"obiente.cloud.superadmin.v1.GetPlatformUsageSummaryRequest"> & {
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.GetPlatformUsageSummaryRequest.
+ * Use `create(GetPlatformUsageSummaryRequestSchema)` to create a new message.
+ */
+export const GetPlatformUsageSummaryRequestSchema: GenMessage<GetPlatformUsageSummaryRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 182);
+
+/**
+ * Resources of one organization that reported usage this month
+ *
+ * @generated from message obiente.cloud.superadmin.v1.OrganizationResourceUsage
+ */
+export type OrganizationResourceUsage = Message<"obiente.cloud.superadmin.v1.OrganizationResourceUsage"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string organization_name = 2;
+   */
+  organizationName: string;
+
+  /**
+   * @generated from field: int64 deployment_count = 3;
+   */
+  deploymentCount: bigint;
+
+  /**
+   * @generated from field: int64 game_server_count = 4;
+   */
+  gameServerCount: bigint;
+
+  /**
+   * @generated from field: int64 vps_count = 5;
+   */
+  vpsCount: bigint;
+
+  /**
+   * @generated from field: int64 total_resources = 6;
+   */
+  totalResources: bigint;
+
+  /**
+   * Hours of recorded usage summed over all resources
+   *
+   * @generated from field: int64 resource_hours = 7;
+   */
+  resourceHours: bigint;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.OrganizationResourceUsage.
+ * Use `create(OrganizationResourceUsageSchema)` to create a new message.
+ */
+export const OrganizationResourceUsageSchema: GenMessage<OrganizationResourceUsage> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 183);
+
+/**
+ * Active resources placed in one region
+ *
+ * @generated from message obiente.cloud.superadmin.v1.RegionResourceUsage
+ */
+export type RegionResourceUsage = Message<"obiente.cloud.superadmin.v1.RegionResourceUsage"> & {
+  /**
+   * @generated from field: string region = 1;
+   */
+  region: string;
+
+  /**
+   * @generated from field: int64 deployment_count = 2;
+   */
+  deploymentCount: bigint;
+
+  /**
+   * @generated from field: int64 game_server_count = 3;
+   */
+  gameServerCount: bigint;
+
+  /**
+   * @generated from field: int64 vps_count = 4;
+   */
+  vpsCount: bigint;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.RegionResourceUsage.
+ * Use `create(RegionResourceUsageSchema)` to create a new message.
+ */
+export const RegionResourceUsageSchema: GenMessage<RegionResourceUsage> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 184);
+
+/**
+ * Get Platform Usage Summary Response
+ *
+ * @generated from message obiente.cloud.superadmin.v1.GetPlatformUsageSummaryResponse
+ */
+export type GetPlatformUsageSummaryResponse = Message<"obiente.cloud.superadmin.v1.GetPlatformUsageSummaryResponse"> & {
+  /**
+   * Deployments that reported usage in the last 24 hours
+   *
+   * @generated from field: int64 active_deployments = 1;
+   */
+  activeDeployments: bigint;
+
+  /**
+   * @generated from field: int64 active_game_servers = 2;
+   */
+  activeGameServers: bigint;
+
+  /**
+   * @generated from field: int64 active_vps = 3;
+   */
+  activeVps: bigint;
+
+  /**
+   * Usage charges since the start of the month
+   *
+   * @generated from field: int64 credits_consumed_cents = 4;
+   */
+  creditsConsumedCents: bigint;
+
+  /**
+   * Top 10 organizations by resource count
+   *
+   * @generated from field: repeated obiente.cloud.superadmin.v1.OrganizationResourceUsage top_organizations = 5;
+   */
+  topOrganizations: OrganizationResourceUsage[];
+
+  /**
+   * @generated from field: repeated obiente.cloud.superadmin.v1.RegionResourceUsage regions = 6;
+   */
+  regions: RegionResourceUsage[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp generated_at = 7;
+   */
+  generatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.GetPlatformUsageSummaryResponse.
+ * Use `create(GetPlatformUsageSummaryResponseSchema)` to create a new message.
+ */
+export const GetPlatformUsageSummaryResponseSchema: GenMessage<GetPlatformUsageSummaryResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 185);
+
+/**
+ * Get All Organizations Usage Request
+ *
+ * @generated from message obiente.cloud.superadmin.v1.GetAllOrganizationsUsageRequest
+ */
+export type GetAllOrganizationsUsageRequest = Message<"obiente.cloud.superadmin.v1.GetAllOrganizationsUsageRequest"> & {
+  /**
+   * Page number (default: 1)
+   *
+   * @generated from field: optional int32 page = 1;
+   */
+  page?: number;
+
+  /**
+   * Results per page (default: 25, max: 100)
+   *
+   * @generated from field: optional int32 per_page = 2;
+   */
+  perPage?: number;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.GetAllOrganizationsUsageRequest.
+ * Use `create(GetAllOrganizationsUsageRequestSchema)` to create a new message.
+ */
+export const GetAllOrganizationsUsageRequestSchema: GenMessage<GetAllOrganizationsUsageRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 186);
+
+/**
+ * Get All Organizations Usage Response
+ *
+ * @generated from message obiente.cloud.superadmin.v1.GetAllOrganizationsUsageResponse
+ */
+export type GetAllOrganizationsUsageResponse = Message<"obiente.cloud.superadmin.v1.GetAllOrganizationsUsageResponse"> & {
+  /**
+   * Sorted by total resource usage, highest first
+   *
+   * @generated from field: repeated obiente.cloud.superadmin.v1.OrganizationResourceUsage organizations = 1;
+   */
+  organizations: OrganizationResourceUsage[];
+
+  /**
+   * @generated from field: obiente.cloud.common.v1.Pagination pagination = 2;
+   */
+  pagination?: Pagination;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.GetAllOrganizationsUsageResponse.
+ * Use `create(GetAllOrganizationsUsageResponseSchema)` to create a new message.
+ */
+export const GetAllOrganizationsUsageResponseSchema: GenMessage<GetAllOrganizationsUsageResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 187);
+
+/**
+ * Set Maintenance Mode Request
+ *
+ * @generated from message obiente.cloud.superadmin.v1.SetMaintenanceModeRequest
+ */
+export type SetMaintenanceModeRequest = Message<"obiente.cloud.superadmin.v1.SetMaintenanceModeRequest"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * Shown to users whose requests are rejected during maintenance
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+
+  /**
+   * Used to tell users when maintenance should end
+   *
+   * @generated from field: int32 estimated_duration_minutes = 3;
+   */
+  estimatedDurationMinutes: number;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SetMaintenanceModeRequest.
+ * Use `create(SetMaintenanceModeRequestSchema)` to create a new message.
+ */
+export const SetMaintenanceModeRequestSchema: GenMessage<SetMaintenanceModeRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 188);
+
+/**
+ * Set Maintenance Mode Response
+ *
+ * @generated from message obiente.cloud.superadmin.v1.SetMaintenanceModeResponse
+ */
+export type SetMaintenanceModeResponse = Message<"obiente.cloud.superadmin.v1.SetMaintenanceModeResponse"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+
+  /**
+   * Unset when maintenance is disabled
+   *
+   * @generated from field: google.protobuf.Timestamp estimated_end = 3;
+   */
+  estimatedEnd?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.SetMaintenanceModeResponse.
+ * Use `create(SetMaintenanceModeResponseSchema)` to create a new message.
+ */
+export const SetMaintenanceModeResponseSchema: GenMessage<SetMaintenanceModeResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 189);
+
+/**
+ * Drain Cluster Node Request
+ *
+ * @generated from message obiente.cloud.superadmin.v1.DrainClusterNodeRequest
+ */
+export type DrainClusterNodeRequest = Message<"obiente.cloud.superadmin.v1.DrainClusterNodeRequest"> & {
+  /**
+   * Swarm node ID
+   *
+   * @generated from field: string node_id = 1;
+   */
+  nodeId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.superadmin.v1.DrainClusterNodeRequest.
+ * Use `create(DrainClusterNodeRequestSchema)` to create a new message.
+ */
+export const DrainClusterNodeRequestSchema: GenMessage<DrainClusterNodeRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 190);
+
+/**
+ * Drain Cluster Node Response
+ *
+ * @generated from message obiente.cloud.superadmin.v1.DrainClusterNodeResponse
+ */
+export type DrainClusterNodeResponse = Message<"obiente.cloud.superadmin.v1.DrainClusterNodeResponse"> & {
+  /**
+   * @generated from field: string node_id = 1;
+   */
+  nodeId: string;
+
+  /**
+   * "draining" while containers are moving off the node
+   *
+   * @generated from field: string status = 2;
+   */
+  status: string;
 
   /**
-   * @generated from field: obiente.cloud.common.v1.Pagination pagination = 2;
+   * @generated from field: string message = 3;
    */
-  pagination?: Pagination;
+  message: string;
 };
 
 /**
- * Describes the message obiente.cloud.superadmin.v1.ListAllGameServersResponse.
- * Use `create(ListAllGameServersResponseSchema)` to create a new message.
+ * Describes the message obiente.cloud.superadmin.v1.DrainClusterNodeResponse.
+ * Use `create(DrainClusterNodeResponseSchema)` to create a new message.
  */
-export const ListAllGameServersResponseSchema: GenMessage<ListAllGameServersResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 149);
+export const DrainClusterNodeResponseSchema: GenMessage<DrainClusterNodeResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 191);
 
 /**
- * @generated from message obiente.cloud.superadmin.v1.SuperadminGetGameServerRequest
+ * Enable Cluster Node Request
+ *
+ * @generated from message obiente.cloud.superadmin.v1.EnableClusterNodeRequest
  */
-export type SuperadminGetGameServerRequest = Message<"obiente.cloud.superadmin.v1.SuperadminGetGameServerRequest"> & {
+export type EnableClusterNodeRequest = Message<"obiente.cloud.superadmin.v1.EnableClusterNodeRequest"> & {
   /**
-   * @generated from field: string game_server_id = 1;
+   * Swarm node ID
+   *
+   * @generated from field: string node_id = 1;
    */
-  gameServerId: string;
+  nodeId: string;
 };
 
 /**
- * Describes the message obiente.cloud.superadmin.v1.SuperadminGetGameServerRequest.
- * Use `create(SuperadminGetGameServerRequestSchema)` to create a new message.
+ * Describes the message obiente.cloud.superadmin.v1.EnableClusterNodeRequest.
+ * Use `create(EnableClusterNodeRequestSchema)` to create a new message.
  */
-export const SuperadminGetGameServerRequestSchema: GenMessage<SuperadminGetGameServerRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 150);
+export const EnableClusterNodeRequestSchema: GenMessage<EnableClusterNodeRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 192);
 
 /**
- * @generated from message obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse
+ * Enable Cluster Node Response
+ *
+ * @generated from message obiente.cloud.superadmin.v1.EnableClusterNodeResponse
  */
-export type SuperadminGetGameServerResponse = Message<"obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse"> & {
+export type EnableClusterNodeResponse = Message<"obiente.cloud.superadmin.v1.EnableClusterNodeResponse"> & {
   /**
-   * @generated from field: obiente.cloud.superadmin.v1.GameServerOverview game_server = 1;
+   * @generated from field: string node_id = 1;
    */
-  gameServer?: GameServerOverview;
+  nodeId: string;
+
+  /**
+   * "active"
+   *
+   * @generated from field: string status = 2;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
 };
 
 /**
- * Describes the message obiente.cloud.superadmin.v1.SuperadminGetGameServerResponse.
- * Use `create(SuperadminGetGameServerResponseSchema)` to create a new message.
+ * Describes the message obiente.cloud.superadmin.v1.EnableClusterNodeResponse.
+ * Use `create(EnableClusterNodeResponseSchema)` to create a new message.
  */
-export const SuperadminGetGameServerResponseSchema: GenMessage<SuperadminGetGameServerResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 151);
+export const EnableClusterNodeResponseSchema: GenMessage<EnableClusterNodeResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 193);
 
 /**
- * @generated from message obiente.cloud.superadmin.v1.SuperadminSuspendGameServerRequest
+ * Rotate Proxmox Token Request
+ *
+ * @generated from message obiente.cloud.superadmin.v1.RotateProxmoxTokenRequest
  */
-export type SuperadminSuspendGameServerRequest = Message<"obiente.cloud.superadmin.v1.SuperadminSuspendGameServerRequest"> & {
-  /**
-   * @generated from field: string game_server_id = 1;
-   */
-  gameServerId: string;
-
+export type RotateProxmoxTokenRequest = Message<"obiente.cloud.superadmin.v1.RotateProxmoxTokenRequest"> & {
   /**
-   * @generated from field: optional string reason = 2;
+   * USER@REALM!TOKENID=SECRET
+   *
+   * @generated from field: string new_token = 1;
    */
-  reason?: string;
+  newToken: string;
 };
 
 /**
- * Describes the message obiente.cloud.superadmin.v1.SuperadminSuspendGameServerRequest.
- * Use `create(SuperadminSuspendGameServerRequestSchema)` to create a new message.
+ * Describes the message obiente.cloud.superadmin.v1.RotateProxmoxTokenRequest.
+ * Use `create(RotateProxmoxTokenRequestSchema)` to create a new message.
  */
-export const SuperadminSuspendGameServerRequestSchema: GenMessage<SuperadminSuspendGameServerRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 152);
+export const RotateProxmoxTokenRequestSchema: GenMessage<RotateProxmoxTokenRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 194);
 
 /**
- * @generated from message obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse
+ * Rotate Proxmox Token Response
+ *
+ * @generated from message obiente.cloud.superadmin.v1.RotateProxmoxTokenResponse
  */
-export type SuperadminSuspendGameServerResponse = Message<"obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse"> & {
+export type RotateProxmoxTokenResponse = Message<"obiente.cloud.superadmin.v1.RotateProxmoxTokenResponse"> & {
   /**
-   * @generated from field: obiente.cloud.gameservers.v1.GameServer game_server = 1;
+   * USER@REALM!TOKENID of the new token
+   *
+   * @generated from field: string token_name = 1;
    */
-  gameServer?: GameServer;
+  tokenName: string;
 
   /**
-   * @generated from field: string message = 2;
+   * When the previous token stops being used
+   *
+   * @generated from field: google.protobuf.Timestamp previous_token_retires_at = 2;
    */
-  message: string;
+  previousTokenRetiresAt?: Timestamp;
 };
 
 /**
- * Describes the message obiente.cloud.superadmin.v1.SuperadminSuspendGameServerResponse.
- * Use `create(SuperadminSuspendGameServerResponseSchema)` to create a new message.
+ * Describes the message obiente.cloud.superadmin.v1.RotateProxmoxTokenResponse.
+ * Use `create(RotateProxmoxTokenResponseSchema)` to create a new message.
  */
-export const SuperadminSuspendGameServerResponseSchema: GenMessage<SuperadminSuspendGameServerResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 153);
+export const RotateProxmoxTokenResponseSchema: GenMessage<RotateProxmoxTokenResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 195);
 
 /**
- * @generated from message obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerRequest
+ * Get Capacity Report Request
+ *
+ * @generated from message obiente.cloud.superadmin.v1.GetCapacityReportRequest
  */
-export type SuperadminUnsuspendGameServerRequest = Message<"obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerRequest"> & {
-  /**
-   * @generated from field: string game_server_id = 1;
-   */
-  gameServerId: string;
+export type GetCapacityReportRequest = Message<"obiente.cloud.superadmin.v1.GetCapacityReportRequest"> & {
 };
 
 /**
- * Describes the message obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerRequest.
- * Use `create(SuperadminUnsuspendGameServerRequestSchema)` to create a new message.
+ * Describes the message obiente.cloud.superadmin.v1.GetCapacityReportRequest.
+ * Use `create(GetCapacityReportRequestSchema)` to create a new message.
  */
-export const SuperadminUnsuspendGameServerRequestSchema: GenMessage<SuperadminUnsuspendGameServerRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 154);
+export const GetCapacityReportRequestSchema: GenMessage<GetCapacityReportRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 196);
 
 /**
- * @generated from message obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse
+ * Capacity of one Docker or Proxmox node. Usage is in percent (0-100); growth is in percentage
+ * points per day from a linear regression over the last 7 days of samples.
+ *
+ * @generated from message obiente.cloud.superadmin.v1.CapacityReport
  */
-export type SuperadminUnsuspendGameServerResponse = Message<"obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse"> & {
+export type CapacityReport = Message<"obiente.cloud.superadmin.v1.CapacityReport"> & {
   /**
-   * @generated from field: obiente.cloud.gameservers.v1.GameServer game_server = 1;
+   * @generated from field: string node_id = 1;
    */
-  gameServer?: GameServer;
+  nodeId: string;
 
   /**
-   * @generated from field: string message = 2;
+   * @generated from field: string node_name = 2;
    */
-  message: string;
-};
+  nodeName: string;
 
-/**
- * Describes the message obiente.cloud.superadmin.v1.SuperadminUnsuspendGameServerResponse.
- * Use `create(SuperadminUnsuspendGameServerResponseSchema)` to create a new message.
- */
-export const SuperadminUnsuspendGameServerResponseSchema: GenMessage<SuperadminUnsuspendGameServerResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 155);
+  /**
+   * "docker" or "proxmox"
+   *
+   * @generated from field: string node_type = 3;
+   */
+  nodeType: string;
 
-/**
- * @generated from message obiente.cloud.superadmin.v1.SuperadminForceStopGameServerRequest
- */
-export type SuperadminForceStopGameServerRequest = Message<"obiente.cloud.superadmin.v1.SuperadminForceStopGameServerRequest"> & {
   /**
-   * @generated from field: string game_server_id = 1;
+   * @generated from field: string region = 4;
    */
-  gameServerId: string;
+  region: string;
 
   /**
-   * @generated from field: optional string reason = 2;
+   * @generated from field: double cpu_percent = 5;
    */
-  reason?: string;
-};
+  cpuPercent: number;
 
-/**
- * Describes the message obiente.cloud.superadmin.v1.SuperadminForceStopGameServerRequest.
- * Use `create(SuperadminForceStopGameServerRequestSchema)` to create a new message.
- */
-export const SuperadminForceStopGameServerRequestSchema: GenMessage<SuperadminForceStopGameServerRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 156);
+  /**
+   * @generated from field: double memory_percent = 6;
+   */
+  memoryPercent: number;
 
-/**
- * @generated from message obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse
- */
-export type SuperadminForceStopGameServerResponse = Message<"obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse"> & {
   /**
-   * @generated from field: obiente.cloud.gameservers.v1.GameServer game_server = 1;
+   * Unset when the node does not report disk usage (Docker nodes)
+   *
+   * @generated from field: optional double disk_percent = 7;
    */
-  gameServer?: GameServer;
+  diskPercent?: number;
 
   /**
-   * @generated from field: string message = 2;
+   * Deployments on Docker nodes, VPS instances on Proxmox nodes
+   *
+   * @generated from field: int32 deployment_count = 8;
    */
-  message: string;
-};
+  deploymentCount: number;
 
-/**
- * Describes the message obiente.cloud.superadmin.v1.SuperadminForceStopGameServerResponse.
- * Use `create(SuperadminForceStopGameServerResponseSchema)` to create a new message.
- */
-export const SuperadminForceStopGameServerResponseSchema: GenMessage<SuperadminForceStopGameServerResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 157);
+  /**
+   * @generated from field: double cpu_growth_per_day = 9;
+   */
+  cpuGrowthPerDay: number;
 
-/**
- * @generated from message obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerRequest
- */
-export type SuperadminForceDeleteGameServerRequest = Message<"obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerRequest"> & {
   /**
-   * @generated from field: string game_server_id = 1;
+   * @generated from field: double memory_growth_per_day = 10;
    */
-  gameServerId: string;
+  memoryGrowthPerDay: number;
 
   /**
-   * @generated from field: optional string reason = 2;
+   * @generated from field: optional double disk_growth_per_day = 11;
    */
-  reason?: string;
+  diskGrowthPerDay?: number;
 
   /**
-   * @generated from field: bool hard_delete = 3;
+   * Unset when usage is not growing or there are too few samples
+   *
+   * @generated from field: optional double cpu_days_to_full = 12;
    */
-  hardDelete: boolean;
+  cpuDaysToFull?: number;
+
+  /**
+   * @generated from field: optional double memory_days_to_full = 13;
+   */
+  memoryDaysToFull?: number;
+
+  /**
+   * @generated from field: optional double disk_days_to_full = 14;
+   */
+  diskDaysToFull?: number;
+
+  /**
+   * "LOW", "MEDIUM", "HIGH" or "CRITICAL"
+   *
+   * @generated from field: string risk_level = 15;
+   */
+  riskLevel: string;
+
+  /**
+   * Samples the growth was computed from
+   *
+   * @generated from field: int32 sample_count = 16;
+   */
+  sampleCount: number;
 };
 
 /**
- * Describes the message obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerRequest.
- * Use `create(SuperadminForceDeleteGameServerRequestSchema)` to create a new message.
+ * Describes the message obiente.cloud.superadmin.v1.CapacityReport.
+ * Use `create(CapacityReportSchema)` to create a new message.
  */
-export const SuperadminForceDeleteGameServerRequestSchema: GenMessage<SuperadminForceDeleteGameServerRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 158);
+export const CapacityReportSchema: GenMessage<CapacityReport> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 197);
 
 /**
- * @generated from message obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerResponse
+ * Get Capacity Report Response
+ *
+ * @generated from message obiente.cloud.superadmin.v1.GetCapacityReportResponse
  */
-export type SuperadminForceDeleteGameServerResponse = Message<"obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerResponse"> & {
+export type GetCapacityReportResponse = Message<"obiente.cloud.superadmin.v1.GetCapacityReportResponse"> & {
   /**
-   * @generated from field: bool success = 1;
+   * Highest risk first
+   *
+   * @generated from field: repeated obiente.cloud.superadmin.v1.CapacityReport nodes = 1;
    */
-  success: boolean;
+  nodes: CapacityReport[];
 
   /**
-   * @generated from field: string message = 2;
+   * @generated from field: google.protobuf.Timestamp generated_at = 2;
    */
-  message: string;
+  generatedAt?: Timestamp;
 };
 
 /**
- * Describes the message obiente.cloud.superadmin.v1.SuperadminForceDeleteGameServerResponse.
- * Use `create(SuperadminForceDeleteGameServerResponseSchema)` to create a new message.
+ * Describes the message obiente.cloud.superadmin.v1.GetCapacityReportResponse.
+ * Use `create(GetCapacityReportResponseSchema)` to create a new message.
  */
-export const SuperadminForceDeleteGameServerResponseSchema: GenMessage<SuperadminForceDeleteGameServerResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 159);
+export const GetCapacityReportResponseSchema: GenMessage<GetCapacityReportResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_superadmin_v1_superadmin_service, 198);
 
 /**
  * @generated from service obiente.cloud.superadmin.v1.SuperadminService
@@ -5335,6 +6582,22 @@ export const SuperadminService: GenService<{
     input: typeof HasDelegatedDNSRequestSchema;
     output: typeof HasDelegatedDNSResponseSchema;
   },
+  /**
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.ListDNSQueryLogs
+   */
+  listDNSQueryLogs: {
+    methodKind: "unary";
+    input: typeof ListDNSQueryLogsRequestSchema;
+    output: typeof ListDNSQueryLogsResponseSchema;
+  },
+  /**
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.ConfigureSplitHorizon
+   */
+  configureSplitHorizon: {
+    methodKind: "unary";
+    input: typeof ConfigureSplitHorizonRequestSchema;
+    output: typeof ConfigureSplitHorizonResponseSchema;
+  },
   /**
    * DNS Delegation API Key management
    *
@@ -5399,6 +6662,24 @@ export const SuperadminService: GenService<{
     input: typeof GetIncomeOverviewRequestSchema;
     output: typeof GetIncomeOverviewResponseSchema;
   },
+  /**
+   * Platform resource usage endpoints
+   *
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.GetPlatformUsageSummary
+   */
+  getPlatformUsageSummary: {
+    methodKind: "unary";
+    input: typeof GetPlatformUsageSummaryRequestSchema;
+    output: typeof GetPlatformUsageSummaryResponseSchema;
+  },
+  /**
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.GetAllOrganizationsUsage
+   */
+  getAllOrganizationsUsage: {
+    methodKind: "unary";
+    input: typeof GetAllOrganizationsUsageRequestSchema;
+    output: typeof GetAllOrganizationsUsageResponseSchema;
+  },
   /**
    * Invoice management endpoints
    *
@@ -5525,6 +6806,32 @@ export const SuperadminService: GenService<{
     input: typeof GetUserBanStatusRequestSchema;
     output: typeof GetUserBanStatusResponseSchema;
   },
+  /**
+   * Platform ban list: logins from banned IP ranges or with emails of banned domains are rejected
+   *
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.CreateBanEntry
+   */
+  createBanEntry: {
+    methodKind: "unary";
+    input: typeof CreateBanEntryRequestSchema;
+    output: typeof CreateBanEntryResponseSchema;
+  },
+  /**
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.DeleteBanEntry
+   */
+  deleteBanEntry: {
+    methodKind: "unary";
+    input: typeof DeleteBanEntryRequestSchema;
+    output: typeof DeleteBanEntryResponseSchema;
+  },
+  /**
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.ListBanEntries
+   */
+  listBanEntries: {
+    methodKind: "unary";
+    input: typeof ListBanEntriesRequestSchema;
+    output: typeof ListBanEntriesResponseSchema;
+  },
   /**
    * Organization moderation endpoints
    *
@@ -5559,6 +6866,27 @@ export const SuperadminService: GenService<{
     input: typeof UnbanOrganizationRequestSchema;
     output: typeof UnbanOrganizationResponseSchema;
   },
+  /**
+   * Lifts an organization suspension and records when it ended
+   *
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.LiftSuspension
+   */
+  liftSuspension: {
+    methodKind: "unary";
+    input: typeof LiftSuspensionRequestSchema;
+    output: typeof LiftSuspensionResponseSchema;
+  },
+  /**
+   * Platform maintenance endpoints
+   * Puts the platform in read-only mode: mutating RPCs are rejected until maintenance is disabled
+   *
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.SetMaintenanceMode
+   */
+  setMaintenanceMode: {
+    methodKind: "unary";
+    input: typeof SetMaintenanceModeRequestSchema;
+    output: typeof SetMaintenanceModeResponseSchema;
+  },
   /**
    * VPS management endpoints
    *
@@ -5625,6 +6953,24 @@ export const SuperadminService: GenService<{
     input: typeof SuperadminForceDeleteVPSRequestSchema;
     output: typeof SuperadminForceDeleteVPSResponseSchema;
   },
+  /**
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.SuperadminMigrateVPS
+   */
+  superadminMigrateVPS: {
+    methodKind: "unary";
+    input: typeof SuperadminMigrateVPSRequestSchema;
+    output: typeof SuperadminMigrateVPSResponseSchema;
+  },
+  /**
+   * Lists VPS instances whose OS was last patched (or, if never patched, created) more than the given number of days ago
+   *
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.ListUnpatchedVPS
+   */
+  listUnpatchedVPS: {
+    methodKind: "unary";
+    input: typeof ListUnpatchedVPSRequestSchema;
+    output: typeof ListUnpatchedVPSResponseSchema;
+  },
   /**
    * VPS size catalog management endpoints
    *
@@ -5710,6 +7056,16 @@ export const SuperadminService: GenService<{
     input: typeof UnassignVPSPublicIPRequestSchema;
     output: typeof UnassignVPSPublicIPResponseSchema;
   },
+  /**
+   * Assign a subnet to an organization for static VPS IPs
+   *
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.AllocateStaticIP
+   */
+  allocateStaticIP: {
+    methodKind: "unary";
+    input: typeof AllocateStaticIPRequestSchema;
+    output: typeof AllocateStaticIPResponseSchema;
+  },
   /**
    * DHCP lease management endpoints
    * Get all DHCP leases for an organization (from VPSGatewayService)
@@ -5757,6 +7113,49 @@ export const SuperadminService: GenService<{
     input: typeof UpdateNodeConfigRequestSchema;
     output: typeof UpdateNodeConfigResponseSchema;
   },
+  /**
+   * Drain a Swarm node for maintenance: containers move to other nodes and the
+   * caller is notified when the drain completes or times out
+   *
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.DrainClusterNode
+   */
+  drainClusterNode: {
+    methodKind: "unary";
+    input: typeof DrainClusterNodeRequestSchema;
+    output: typeof DrainClusterNodeResponseSchema;
+  },
+  /**
+   * Re-enable a drained node so it accepts deployments again
+   *
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.EnableClusterNode
+   */
+  enableClusterNode: {
+    methodKind: "unary";
+    input: typeof EnableClusterNodeRequestSchema;
+    output: typeof EnableClusterNodeResponseSchema;
+  },
+  /**
+   * Rotate the Proxmox API token used for VPS management. The new token is validated first and the
+   * previous token keeps working for a short grace period.
+   *
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.RotateProxmoxToken
+   */
+  rotateProxmoxToken: {
+    methodKind: "unary";
+    input: typeof RotateProxmoxTokenRequestSchema;
+    output: typeof RotateProxmoxTokenResponseSchema;
+  },
+  /**
+   * Get current CPU, memory and disk usage of every Docker and Proxmox node, with the growth over
+   * the last 7 days and the estimated days until each resource is full
+   *
+   * @generated from rpc obiente.cloud.superadmin.v1.SuperadminService.GetCapacityReport
+   */
+  getCapacityReport: {
+    methodKind: "unary";
+    input: typeof GetCapacityReportRequestSchema;
+    output: typeof GetCapacityReportResponseSchema;
+  },
   /**
    * Superadmin permissions catalog (only superadmin-only permissions)
    *
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file obiente/cloud/vps/v1/vps_config_service.proto.
  */
 export const file_obiente_cloud_vps_v1_vps_config_service: GenFile = /*@__PURE__*/
-  fileDesc("Ci1vYmllbnRlL2Nsb3VkL3Zwcy92MS92cHNfY29uZmlnX3NlcnZpY2UucHJvdG8SFG9iaWVudGUuY2xvdWQudnBzLnYxIkQKGUdldENsb3VkSW5pdENvbmZpZ1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSJXChpHZXRDbG91ZEluaXRDb25maWdSZXNwb25zZRI5CgpjbG91ZF9pbml0GAEgASgLMiUub2JpZW50ZS5jbG91ZC52cHMudjEuQ2xvdWRJbml0Q29uZmlnIkYKG0dldENsb3VkSW5pdFVzZXJEYXRhUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIjEKHEdldENsb3VkSW5pdFVzZXJEYXRhUmVzcG9uc2USEQoJdXNlcl9kYXRhGAEgASgJIoIBChxVcGRhdGVDbG91ZEluaXRDb25maWdSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSOQoKY2xvdWRfaW5pdBgDIAEoCzIlLm9iaWVudGUuY2xvdWQudnBzLnYxLkNsb3VkSW5pdENvbmZpZyJrCh1VcGRhdGVDbG91ZEluaXRDb25maWdSZXNwb25zZRI5CgpjbG91ZF9pbml0GAEgASgLMiUub2JpZW50ZS5jbG91ZC52cHMudjEuQ2xvdWRJbml0Q29uZmlnEg8KB21lc3NhZ2UYAiABKAkiPgoTTGlzdFZQU1VzZXJzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIkQKFExpc3RWUFNVc2Vyc1Jlc3BvbnNlEiwKBXVzZXJzGAEgAygLMh0ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTVXNlciLQAQoHVlBTVXNlchIMCgRuYW1lGAEgASgJEhQKDGhhc19wYXNzd29yZBgCIAEoCBIbChNzc2hfYXV0aG9yaXplZF9rZXlzGAMgAygJEgwKBHN1ZG8YBCABKAgSFQoNc3Vkb19ub3Bhc3N3ZBgFIAEoCBIOCgZncm91cHMYBiADKAkSEgoFc2hlbGwYByABKAlIAIgBARITCgtsb2NrX3Bhc3N3ZBgIIAEoCBISCgVnZWNvcxgJIAEoCUgBiAEBQggKBl9zaGVsbEIICgZfZ2Vjb3MizgIKFENyZWF0ZVZQU1VzZXJSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRIVCghwYXNzd29yZBgEIAEoCUgAiAEBEhsKE3NzaF9hdXRob3JpemVkX2tleXMYBSADKAkSEQoEc3VkbxgGIAEoCEgBiAEBEhoKDXN1ZG9fbm9wYXNzd2QYByABKAhIAogBARIOCgZncm91cHMYCCADKAkSEgoFc2hlbGwYCSABKAlIA4gBARIYCgtsb2NrX3Bhc3N3ZBgKIAEoCEgEiAEBEhIKBWdlY29zGAsgASgJSAWIAQFCCwoJX3Bhc3N3b3JkQgcKBV9zdWRvQhAKDl9zdWRvX25vcGFzc3dkQggKBl9zaGVsbEIOCgxfbG9ja19wYXNzd2RCCAoGX2dlY29zIlUKFUNyZWF0ZVZQU1VzZXJSZXNwb25zZRIrCgR1c2VyGAEgASgLMh0ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTVXNlchIPCgdtZXNzYWdlGAIgASgJIs4CChRVcGRhdGVWUFNVc2VyUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEgwKBG5hbWUYAyABKAkSFQoIbmV3X25hbWUYBCABKAlIAIgBARIbChNzc2hfYXV0aG9yaXplZF9rZXlzGAUgAygJEhEKBHN1ZG8YBiABKAhIAYgBARIaCg1zdWRvX25vcGFzc3dkGAcgASgISAKIAQESDgoGZ3JvdXBzGAggAygJEhIKBXNoZWxsGAkgASgJSAOIAQESGAoLbG9ja19wYXNzd2QYCiABKAhIBIgBARISCgVnZWNvcxgLIAEoCUgFiAEBQgsKCV9uZXdfbmFtZUIHCgVfc3Vkb0IQCg5fc3Vkb19ub3Bhc3N3ZEIICgZfc2hlbGxCDgoMX2xvY2tfcGFzc3dkQggKBl9nZWNvcyJVChVVcGRhdGVWUFNVc2VyUmVzcG9uc2USKwoEdXNlchgBIAEoCzIdLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1VzZXISDwoHbWVzc2FnZRgCIAEoCSJNChREZWxldGVWUFNVc2VyUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEgwKBG5hbWUYAyABKAkiKAoVRGVsZXRlVlBTVXNlclJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiZgoWU2V0VXNlclBhc3N3b3JkUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhEKCXVzZXJfbmFtZRgDIAEoCRIQCghwYXNzd29yZBgEIAEoCSIqChdTZXRVc2VyUGFzc3dvcmRSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJImsKGFVwZGF0ZVVzZXJTU0hLZXlzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhEKCXVzZXJfbmFtZRgDIAEoCRITCgtzc2hfa2V5X2lkcxgEIAMoCSJZChlVcGRhdGVVc2VyU1NIS2V5c1Jlc3BvbnNlEisKBHVzZXIYASABKAsyHS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNVc2VyEg8KB21lc3NhZ2UYAiABKAkiQwoYUm90YXRlVGVybWluYWxLZXlSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkiQQoZUm90YXRlVGVybWluYWxLZXlSZXNwb25zZRITCgtmaW5nZXJwcmludBgBIAEoCRIPCgdtZXNzYWdlGAIgASgJIkMKGFJlbW92ZVRlcm1pbmFsS2V5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIiwKGVJlbW92ZVRlcm1pbmFsS2V5UmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSJAChVHZXRUZXJtaW5hbEtleVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSKNAQoWR2V0VGVybWluYWxLZXlSZXNwb25zZRITCgtmaW5nZXJwcmludBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJCChdSb3RhdGVCYXN0aW9uS2V5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIkAKGFJvdGF0ZUJhc3Rpb25LZXlSZXNwb25zZRITCgtmaW5nZXJwcmludBgBIAEoCRIPCgdtZXNzYWdlGAIgASgJIj8KFEdldEJhc3Rpb25LZXlSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkijAEKFUdldEJhc3Rpb25LZXlSZXNwb25zZRITCgtmaW5nZXJwcmludBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI9ChJHZXRTU0hBbGlhc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSIzChNHZXRTU0hBbGlhc1Jlc3BvbnNlEhIKBWFsaWFzGAEgASgJSACIAQFCCAoGX2FsaWFzIkwKElNldFNTSEFsaWFzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEg0KBWFsaWFzGAMgASgJIjUKE1NldFNTSEFsaWFzUmVzcG9uc2USDQoFYWxpYXMYASABKAkSDwoHbWVzc2FnZRgCIAEoCSJAChVSZW1vdmVTU0hBbGlhc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSIpChZSZW1vdmVTU0hBbGlhc1Jlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkygw8KEFZQU0NvbmZpZ1NlcnZpY2USdwoSR2V0Q2xvdWRJbml0Q29uZmlnEi8ub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0Q2xvdWRJbml0Q29uZmlnUmVxdWVzdBowLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldENsb3VkSW5pdENvbmZpZ1Jlc3BvbnNlEn0KFEdldENsb3VkSW5pdFVzZXJEYXRhEjEub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0Q2xvdWRJbml0VXNlckRhdGFSZXF1ZXN0GjIub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0Q2xvdWRJbml0VXNlckRhdGFSZXNwb25zZRKAAQoVVXBkYXRlQ2xvdWRJbml0Q29uZmlnEjIub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlQ2xvdWRJbml0Q29uZmlnUmVxdWVzdBozLm9iaWVudGUuY2xvdWQudnBzLnYxLlVwZGF0ZUNsb3VkSW5pdENvbmZpZ1Jlc3BvbnNlEmUKDExpc3RWUFNVc2VycxIpLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RWUFNVc2Vyc1JlcXVlc3QaKi5vYmllbnRlLmNsb3VkLnZwcy52MS5MaXN0VlBTVXNlcnNSZXNwb25zZRJoCg1DcmVhdGVWUFNVc2VyEioub2JpZW50ZS5jbG91ZC52cHMudjEuQ3JlYXRlVlBTVXNlclJlcXVlc3QaKy5vYmllbnRlLmNsb3VkLnZwcy52MS5DcmVhdGVWUFNVc2VyUmVzcG9uc2USaAoNVXBkYXRlVlBTVXNlchIqLm9iaWVudGUuY2xvdWQudnBzLnYxLlVwZGF0ZVZQU1VzZXJSZXF1ZXN0Gisub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlVlBTVXNlclJlc3BvbnNlEmgKDURlbGV0ZVZQU1VzZXISKi5vYmllbnRlLmNsb3VkLnZwcy52MS5EZWxldGVWUFNVc2VyUmVxdWVzdBorLm9iaWVudGUuY2xvdWQudnBzLnYxLkRlbGV0ZVZQU1VzZXJSZXNwb25zZRJuCg9TZXRVc2VyUGFzc3dvcmQSLC5vYmllbnRlLmNsb3VkLnZwcy52MS5TZXRVc2VyUGFzc3dvcmRSZXF1ZXN0Gi0ub2JpZW50ZS5jbG91ZC52cHMudjEuU2V0VXNlclBhc3N3b3JkUmVzcG9uc2USdAoRVXBkYXRlVXNlclNTSEtleXMSLi5vYmllbnRlLmNsb3VkLnZwcy52MS5VcGRhdGVVc2VyU1NIS2V5c1JlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnZwcy52MS5VcGRhdGVVc2VyU1NIS2V5c1Jlc3BvbnNlEnQKEVJvdGF0ZVRlcm1pbmFsS2V5Ei4ub2JpZW50ZS5jbG91ZC52cHMudjEuUm90YXRlVGVybWluYWxLZXlSZXF1ZXN0Gi8ub2JpZW50ZS5jbG91ZC52cHMudjEuUm90YXRlVGVybWluYWxLZXlSZXNwb25zZRJ0ChFSZW1vdmVUZXJtaW5hbEtleRIuLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlbW92ZVRlcm1pbmFsS2V5UmVxdWVzdBovLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlbW92ZVRlcm1pbmFsS2V5UmVzcG9uc2USawoOR2V0VGVybWluYWxLZXkSKy5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRUZXJtaW5hbEtleVJlcXVlc3QaLC5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRUZXJtaW5hbEtleVJlc3BvbnNlEnEKEFJvdGF0ZUJhc3Rpb25LZXkSLS5vYmllbnRlLmNsb3VkLnZwcy52MS5Sb3RhdGVCYXN0aW9uS2V5UmVxdWVzdBouLm9iaWVudGUuY2xvdWQudnBzLnYxLlJvdGF0ZUJhc3Rpb25LZXlSZXNwb25zZRJoCg1HZXRCYXN0aW9uS2V5Eioub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0QmFzdGlvbktleVJlcXVlc3QaKy5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRCYXN0aW9uS2V5UmVzcG9uc2USYgoLR2V0U1NIQWxpYXMSKC5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRTU0hBbGlhc1JlcXVlc3QaKS5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRTU0hBbGlhc1Jlc3BvbnNlEmIKC1NldFNTSEFsaWFzEigub2JpZW50ZS5jbG91ZC52cHMudjEuU2V0U1NIQWxpYXNSZXF1ZXN0Gikub2JpZW50ZS5jbG91ZC52cHMudjEuU2V0U1NIQWxpYXNSZXNwb25zZRJrCg5SZW1vdmVTU0hBbGlhcxIrLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlbW92ZVNTSEFsaWFzUmVxdWVzdBosLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlbW92ZVNTSEFsaWFzUmVzcG9uc2VCR1pFZ2l0aHViLmNvbS9vYmllbnRlL2Nsb3VkL2FwcHMvc2hhcmVkL3Byb3RvL29iaWVudGUvY2xvdWQvdnBzL3YxO3Zwc3YxYgZwcm90bzM", [file_obiente_cloud_vps_v1_vps_service, file_google_protobuf_timestamp]);
+  fileDesc("Ci1vYmllbnRlL2Nsb3VkL3Zwcy92MS92cHNfY29uZmlnX3NlcnZpY2UucHJvdG8SFG9iaWVudGUuY2xvdWQudnBzLnYxIkQKGUdldENsb3VkSW5pdENvbmZpZ1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSJXChpHZXRDbG91ZEluaXRDb25maWdSZXNwb25zZRI5CgpjbG91ZF9pbml0GAEgASgLMiUub2JpZW50ZS5jbG91ZC52cHMudjEuQ2xvdWRJbml0Q29uZmlnIkYKG0dldENsb3VkSW5pdFVzZXJEYXRhUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIjEKHEdldENsb3VkSW5pdFVzZXJEYXRhUmVzcG9uc2USEQoJdXNlcl9kYXRhGAEgASgJIoIBChxVcGRhdGVDbG91ZEluaXRDb25maWdSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSOQoKY2xvdWRfaW5pdBgDIAEoCzIlLm9iaWVudGUuY2xvdWQudnBzLnYxLkNsb3VkSW5pdENvbmZpZyJrCh1VcGRhdGVDbG91ZEluaXRDb25maWdSZXNwb25zZRI5CgpjbG91ZF9pbml0GAEgASgLMiUub2JpZW50ZS5jbG91ZC52cHMudjEuQ2xvdWRJbml0Q29uZmlnEg8KB21lc3NhZ2UYAiABKAkiPgoTTGlzdFZQU1VzZXJzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIkQKFExpc3RWUFNVc2Vyc1Jlc3BvbnNlEiwKBXVzZXJzGAEgAygLMh0ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTVXNlciLQAQoHVlBTVXNlchIMCgRuYW1lGAEgASgJEhQKDGhhc19wYXNzd29yZBgCIAEoCBIbChNzc2hfYXV0aG9yaXplZF9rZXlzGAMgAygJEgwKBHN1ZG8YBCABKAgSFQoNc3Vkb19ub3Bhc3N3ZBgFIAEoCBIOCgZncm91cHMYBiADKAkSEgoFc2hlbGwYByABKAlIAIgBARITCgtsb2NrX3Bhc3N3ZBgIIAEoCBISCgVnZWNvcxgJIAEoCUgBiAEBQggKBl9zaGVsbEIICgZfZ2Vjb3MizgIKFENyZWF0ZVZQU1VzZXJSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRIVCghwYXNzd29yZBgEIAEoCUgAiAEBEhsKE3NzaF9hdXRob3JpemVkX2tleXMYBSADKAkSEQoEc3VkbxgGIAEoCEgBiAEBEhoKDXN1ZG9fbm9wYXNzd2QYByABKAhIAogBARIOCgZncm91cHMYCCADKAkSEgoFc2hlbGwYCSABKAlIA4gBARIYCgtsb2NrX3Bhc3N3ZBgKIAEoCEgEiAEBEhIKBWdlY29zGAsgASgJSAWIAQFCCwoJX3Bhc3N3b3JkQgcKBV9zdWRvQhAKDl9zdWRvX25vcGFzc3dkQggKBl9zaGVsbEIOCgxfbG9ja19wYXNzd2RCCAoGX2dlY29zIlUKFUNyZWF0ZVZQU1VzZXJSZXNwb25zZRIrCgR1c2VyGAEgASgLMh0ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTVXNlchIPCgdtZXNzYWdlGAIgASgJIs4CChRVcGRhdGVWUFNVc2VyUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEgwKBG5hbWUYAyABKAkSFQoIbmV3X25hbWUYBCABKAlIAIgBARIbChNzc2hfYXV0aG9yaXplZF9rZXlzGAUgAygJEhEKBHN1ZG8YBiABKAhIAYgBARIaCg1zdWRvX25vcGFzc3dkGAcgASgISAKIAQESDgoGZ3JvdXBzGAggAygJEhIKBXNoZWxsGAkgASgJSAOIAQESGAoLbG9ja19wYXNzd2QYCiABKAhIBIgBARISCgVnZWNvcxgLIAEoCUgFiAEBQgsKCV9uZXdfbmFtZUIHCgVfc3Vkb0IQCg5fc3Vkb19ub3Bhc3N3ZEIICgZfc2hlbGxCDgoMX2xvY2tfcGFzc3dkQggKBl9nZWNvcyJVChVVcGRhdGVWUFNVc2VyUmVzcG9uc2USKwoEdXNlchgBIAEoCzIdLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1VzZXISDwoHbWVzc2FnZRgCIAEoCSJNChREZWxldGVWUFNVc2VyUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEgwKBG5hbWUYAyABKAkiKAoVRGVsZXRlVlBTVXNlclJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiZgoWU2V0VXNlclBhc3N3b3JkUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhEKCXVzZXJfbmFtZRgDIAEoCRIQCghwYXNzd29yZBgEIAEoCSIqChdTZXRVc2VyUGFzc3dvcmRSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJImsKGFVwZGF0ZVVzZXJTU0hLZXlzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhEKCXVzZXJfbmFtZRgDIAEoCRITCgtzc2hfa2V5X2lkcxgEIAMoCSJZChlVcGRhdGVVc2VyU1NIS2V5c1Jlc3BvbnNlEisKBHVzZXIYASABKAsyHS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNVc2VyEg8KB21lc3NhZ2UYAiABKAkiQwoYUm90YXRlVGVybWluYWxLZXlSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkiQQoZUm90YXRlVGVybWluYWxLZXlSZXNwb25zZRITCgtmaW5nZXJwcmludBgBIAEoCRIPCgdtZXNzYWdlGAIgASgJIkMKGFJlbW92ZVRlcm1pbmFsS2V5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIiwKGVJlbW92ZVRlcm1pbmFsS2V5UmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSJAChVHZXRUZXJtaW5hbEtleVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSKNAQoWR2V0VGVybWluYWxLZXlSZXNwb25zZRITCgtmaW5nZXJwcmludBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJCChdSb3RhdGVCYXN0aW9uS2V5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIkAKGFJvdGF0ZUJhc3Rpb25LZXlSZXNwb25zZRITCgtmaW5nZXJwcmludBgBIAEoCRIPCgdtZXNzYWdlGAIgASgJIj8KFEdldEJhc3Rpb25LZXlSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkijAEKFUdldEJhc3Rpb25LZXlSZXNwb25zZRITCgtmaW5nZXJwcmludBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI9ChJHZXRTU0hBbGlhc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSIzChNHZXRTU0hBbGlhc1Jlc3BvbnNlEhIKBWFsaWFzGAEgASgJSACIAQFCCAoGX2FsaWFzIkwKElNldFNTSEFsaWFzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEg0KBWFsaWFzGAMgASgJIjUKE1NldFNTSEFsaWFzUmVzcG9uc2USDQoFYWxpYXMYASABKAkSDwoHbWVzc2FnZRgCIAEoCSJAChVSZW1vdmVTU0hBbGlhc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSIpChZSZW1vdmVTU0hBbGlhc1Jlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkitwEKEUNsb3VkSW5pdFRlbXBsYXRlEgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEg8KB2NvbnRlbnQYBCABKAkSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiWAoeQ3JlYXRlQ2xvdWRJbml0VGVtcGxhdGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEg8KB2NvbnRlbnQYAyABKAkiXAofQ3JlYXRlQ2xvdWRJbml0VGVtcGxhdGVSZXNwb25zZRI5Cgh0ZW1wbGF0ZRgBIAEoCzInLm9iaWVudGUuY2xvdWQudnBzLnYxLkNsb3VkSW5pdFRlbXBsYXRlIksKG0dldENsb3VkSW5pdFRlbXBsYXRlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSEwoLdGVtcGxhdGVfaWQYAiABKAkiWQocR2V0Q2xvdWRJbml0VGVtcGxhdGVSZXNwb25zZRI5Cgh0ZW1wbGF0ZRgBIAEoCzInLm9iaWVudGUuY2xvdWQudnBzLnYxLkNsb3VkSW5pdFRlbXBsYXRlIk4KHkRlbGV0ZUNsb3VkSW5pdFRlbXBsYXRlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSEwoLdGVtcGxhdGVfaWQYAiABKAkiMgofRGVsZXRlQ2xvdWRJbml0VGVtcGxhdGVSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJMpQSChBWUFNDb25maWdTZXJ2aWNlEncKEkdldENsb3VkSW5pdENvbmZpZxIvLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldENsb3VkSW5pdENvbmZpZ1JlcXVlc3QaMC5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRDbG91ZEluaXRDb25maWdSZXNwb25zZRJ9ChRHZXRDbG91ZEluaXRVc2VyRGF0YRIxLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldENsb3VkSW5pdFVzZXJEYXRhUmVxdWVzdBoyLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldENsb3VkSW5pdFVzZXJEYXRhUmVzcG9uc2USgAEKFVVwZGF0ZUNsb3VkSW5pdENvbmZpZxIyLm9iaWVudGUuY2xvdWQudnBzLnYxLlVwZGF0ZUNsb3VkSW5pdENvbmZpZ1JlcXVlc3QaMy5vYmllbnRlLmNsb3VkLnZwcy52MS5VcGRhdGVDbG91ZEluaXRDb25maWdSZXNwb25zZRJlCgxMaXN0VlBTVXNlcnMSKS5vYmllbnRlLmNsb3VkLnZwcy52MS5MaXN0VlBTVXNlcnNSZXF1ZXN0Gioub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFZQU1VzZXJzUmVzcG9uc2USaAoNQ3JlYXRlVlBTVXNlchIqLm9iaWVudGUuY2xvdWQudnBzLnYxLkNyZWF0ZVZQU1VzZXJSZXF1ZXN0Gisub2JpZW50ZS5jbG91ZC52cHMudjEuQ3JlYXRlVlBTVXNlclJlc3BvbnNlEmgKDVVwZGF0ZVZQU1VzZXISKi5vYmllbnRlLmNsb3VkLnZwcy52MS5VcGRhdGVWUFNVc2VyUmVxdWVzdBorLm9iaWVudGUuY2xvdWQudnBzLnYxLlVwZGF0ZVZQU1VzZXJSZXNwb25zZRJoCg1EZWxldGVWUFNVc2VyEioub2JpZW50ZS5jbG91ZC52cHMudjEuRGVsZXRlVlBTVXNlclJlcXVlc3QaKy5vYmllbnRlLmNsb3VkLnZwcy52MS5EZWxldGVWUFNVc2VyUmVzcG9uc2USbgoPU2V0VXNlclBhc3N3b3JkEiwub2JpZW50ZS5jbG91ZC52cHMudjEuU2V0VXNlclBhc3N3b3JkUmVxdWVzdBotLm9iaWVudGUuY2xvdWQudnBzLnYxLlNldFVzZXJQYXNzd29yZFJlc3BvbnNlEnQKEVVwZGF0ZVVzZXJTU0hLZXlzEi4ub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlVXNlclNTSEtleXNSZXF1ZXN0Gi8ub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlVXNlclNTSEtleXNSZXNwb25zZRJ0ChFSb3RhdGVUZXJtaW5hbEtleRIuLm9iaWVudGUuY2xvdWQudnBzLnYxLlJvdGF0ZVRlcm1pbmFsS2V5UmVxdWVzdBovLm9iaWVudGUuY2xvdWQudnBzLnYxLlJvdGF0ZVRlcm1pbmFsS2V5UmVzcG9uc2USdAoRUmVtb3ZlVGVybWluYWxLZXkSLi5vYmllbnRlLmNsb3VkLnZwcy52MS5SZW1vdmVUZXJtaW5hbEtleVJlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnZwcy52MS5SZW1vdmVUZXJtaW5hbEtleVJlc3BvbnNlEmsKDkdldFRlcm1pbmFsS2V5Eisub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0VGVybWluYWxLZXlSZXF1ZXN0Giwub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0VGVybWluYWxLZXlSZXNwb25zZRJxChBSb3RhdGVCYXN0aW9uS2V5Ei0ub2JpZW50ZS5jbG91ZC52cHMudjEuUm90YXRlQmFzdGlvbktleVJlcXVlc3QaLi5vYmllbnRlLmNsb3VkLnZwcy52MS5Sb3RhdGVCYXN0aW9uS2V5UmVzcG9uc2USaAoNR2V0QmFzdGlvbktleRIqLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldEJhc3Rpb25LZXlSZXF1ZXN0Gisub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0QmFzdGlvbktleVJlc3BvbnNlEmIKC0dldFNTSEFsaWFzEigub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0U1NIQWxpYXNSZXF1ZXN0Gikub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0U1NIQWxpYXNSZXNwb25zZRJiCgtTZXRTU0hBbGlhcxIoLm9iaWVudGUuY2xvdWQudnBzLnYxLlNldFNTSEFsaWFzUmVxdWVzdBopLm9iaWVudGUuY2xvdWQudnBzLnYxLlNldFNTSEFsaWFzUmVzcG9uc2USawoOUmVtb3ZlU1NIQWxpYXMSKy5vYmllbnRlLmNsb3VkLnZwcy52MS5SZW1vdmVTU0hBbGlhc1JlcXVlc3QaLC5vYmllbnRlLmNsb3VkLnZwcy52MS5SZW1vdmVTU0hBbGlhc1Jlc3BvbnNlEoYBChdDcmVhdGVDbG91ZEluaXRUZW1wbGF0ZRI0Lm9iaWVudGUuY2xvdWQudnBzLnYxLkNyZWF0ZUNsb3VkSW5pdFRlbXBsYXRlUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQudnBzLnYxLkNyZWF0ZUNsb3VkSW5pdFRlbXBsYXRlUmVzcG9uc2USfQoUR2V0Q2xvdWRJbml0VGVtcGxhdGUSMS5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRDbG91ZEluaXRUZW1wbGF0ZVJlcXVlc3QaMi5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRDbG91ZEluaXRUZW1wbGF0ZVJlc3BvbnNlEoYBChdEZWxldGVDbG91ZEluaXRUZW1wbGF0ZRI0Lm9iaWVudGUuY2xvdWQudnBzLnYxLkRlbGV0ZUNsb3VkSW5pdFRlbXBsYXRlUmVxdWVzdBo1Lm9iaWVudGUuY2xvdWQudnBzLnYxLkRlbGV0ZUNsb3VkSW5pdFRlbXBsYXRlUmVzcG9uc2VCR1pFZ2l0aHViLmNvbS9vYmllbnRlL2Nsb3VkL2FwcHMvc2hhcmVkL3Byb3RvL29iaWVudGUvY2xvdWQvdnBzL3YxO3Zwc3YxYgZwcm90bzM", [file_obiente_cloud_vps_v1_vps_service, file_google_protobuf_timestamp]);
 
 /**
  * GetCloudInitConfigRequest requests the cloud-init configuration for a VPS
@@ -1041,6 +1041,190 @@ export type RemoveSSHAliasResponse = Message<"obiente.cloud.vps.v1.RemoveSSHAlia
 export const RemoveSSHAliasResponseSchema: GenMessage<RemoveSSHAliasResponse> = /*@__PURE__*/
   messageDesc(file_obiente_cloud_vps_v1_vps_config_service, 34);
 
+/**
+ * CloudInitTemplate is a reusable cloud-init userData template
+ * Content is a Go text/template; available variables are
+ * {{.VPSID}}, {{.Hostname}}, {{.RootPassword}}, {{.SSHKeys}} and {{.Region}}
+ *
+ * @generated from message obiente.cloud.vps.v1.CloudInitTemplate
+ */
+export type CloudInitTemplate = Message<"obiente.cloud.vps.v1.CloudInitTemplate"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * Template source, must start with "#cloud-config"
+   *
+   * @generated from field: string content = 4;
+   */
+  content: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 6;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.CloudInitTemplate.
+ * Use `create(CloudInitTemplateSchema)` to create a new message.
+ */
+export const CloudInitTemplateSchema: GenMessage<CloudInitTemplate> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_config_service, 35);
+
+/**
+ * CreateCloudInitTemplateRequest creates a cloud-init template
+ *
+ * @generated from message obiente.cloud.vps.v1.CreateCloudInitTemplateRequest
+ */
+export type CreateCloudInitTemplateRequest = Message<"obiente.cloud.vps.v1.CreateCloudInitTemplateRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string content = 3;
+   */
+  content: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.CreateCloudInitTemplateRequest.
+ * Use `create(CreateCloudInitTemplateRequestSchema)` to create a new message.
+ */
+export const CreateCloudInitTemplateRequestSchema: GenMessage<CreateCloudInitTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_config_service, 36);
+
+/**
+ * CreateCloudInitTemplateResponse returns the created template
+ *
+ * @generated from message obiente.cloud.vps.v1.CreateCloudInitTemplateResponse
+ */
+export type CreateCloudInitTemplateResponse = Message<"obiente.cloud.vps.v1.CreateCloudInitTemplateResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.vps.v1.CloudInitTemplate template = 1;
+   */
+  template?: CloudInitTemplate;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.CreateCloudInitTemplateResponse.
+ * Use `create(CreateCloudInitTemplateResponseSchema)` to create a new message.
+ */
+export const CreateCloudInitTemplateResponseSchema: GenMessage<CreateCloudInitTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_config_service, 37);
+
+/**
+ * GetCloudInitTemplateRequest requests a cloud-init template
+ *
+ * @generated from message obiente.cloud.vps.v1.GetCloudInitTemplateRequest
+ */
+export type GetCloudInitTemplateRequest = Message<"obiente.cloud.vps.v1.GetCloudInitTemplateRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string template_id = 2;
+   */
+  templateId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.GetCloudInitTemplateRequest.
+ * Use `create(GetCloudInitTemplateRequestSchema)` to create a new message.
+ */
+export const GetCloudInitTemplateRequestSchema: GenMessage<GetCloudInitTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_config_service, 38);
+
+/**
+ * GetCloudInitTemplateResponse returns the template
+ *
+ * @generated from message obiente.cloud.vps.v1.GetCloudInitTemplateResponse
+ */
+export type GetCloudInitTemplateResponse = Message<"obiente.cloud.vps.v1.GetCloudInitTemplateResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.vps.v1.CloudInitTemplate template = 1;
+   */
+  template?: CloudInitTemplate;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.GetCloudInitTemplateResponse.
+ * Use `create(GetCloudInitTemplateResponseSchema)` to create a new message.
+ */
+export const GetCloudInitTemplateResponseSchema: GenMessage<GetCloudInitTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_config_service, 39);
+
+/**
+ * DeleteCloudInitTemplateRequest deletes a cloud-init template
+ *
+ * @generated from message obiente.cloud.vps.v1.DeleteCloudInitTemplateRequest
+ */
+export type DeleteCloudInitTemplateRequest = Message<"obiente.cloud.vps.v1.DeleteCloudInitTemplateRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string template_id = 2;
+   */
+  templateId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.DeleteCloudInitTemplateRequest.
+ * Use `create(DeleteCloudInitTemplateRequestSchema)` to create a new message.
+ */
+export const DeleteCloudInitTemplateRequestSchema: GenMessage<DeleteCloudInitTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_config_service, 40);
+
+/**
+ * DeleteCloudInitTemplateResponse confirms the deletion
+ *
+ * @generated from message obiente.cloud.vps.v1.DeleteCloudInitTemplateResponse
+ */
+export type DeleteCloudInitTemplateResponse = Message<"obiente.cloud.vps.v1.DeleteCloudInitTemplateResponse"> & {
+  /**
+   * Confirmation message
+   *
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.DeleteCloudInitTemplateResponse.
+ * Use `create(DeleteCloudInitTemplateResponseSchema)` to create a new message.
+ */
+export const DeleteCloudInitTemplateResponseSchema: GenMessage<DeleteCloudInitTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_config_service, 41);
+
 /**
  * VPSConfigService provides endpoints for managing VPS configuration
  * including cloud-init settings and user management
@@ -1218,6 +1402,36 @@ export const VPSConfigService: GenService<{
     input: typeof RemoveSSHAliasRequestSchema;
     output: typeof RemoveSSHAliasResponseSchema;
   },
+  /**
+   * Create a reusable cloud-init userData template for an organization
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSConfigService.CreateCloudInitTemplate
+   */
+  createCloudInitTemplate: {
+    methodKind: "unary";
+    input: typeof CreateCloudInitTemplateRequestSchema;
+    output: typeof CreateCloudInitTemplateResponseSchema;
+  },
+  /**
+   * Get a cloud-init userData template
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSConfigService.GetCloudInitTemplate
+   */
+  getCloudInitTemplate: {
+    methodKind: "unary";
+    input: typeof GetCloudInitTemplateRequestSchema;
+    output: typeof GetCloudInitTemplateResponseSchema;
+  },
+  /**
+   * Delete a cloud-init userData template
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSConfigService.DeleteCloudInitTemplate
+   */
+  deleteCloudInitTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteCloudInitTemplateRequestSchema;
+    output: typeof DeleteCloudInitTemplateResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_obiente_cloud_vps_v1_vps_config_service, 0);
 
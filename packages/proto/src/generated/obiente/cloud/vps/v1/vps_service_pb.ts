@@ -15,7 +15,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file obiente/cloud/vps/v1/vps_service.proto.
  */
 export const file_obiente_cloud_vps_v1_vps_service: GenFile = /*@__PURE__*/
-  fileDesc("CiZvYmllbnRlL2Nsb3VkL3Zwcy92MS92cHNfc2VydmljZS5wcm90bxIUb2JpZW50ZS5jbG91ZC52cHMudjEiigEKDkxpc3RWUFNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIMCgRwYWdlGAIgASgFEhAKCHBlcl9wYWdlGAMgASgFEjQKBnN0YXR1cxgEIAEoDjIfLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1N0YXR1c0gAiAEBQgkKB19zdGF0dXMihAEKD0xpc3RWUFNSZXNwb25zZRI4Cg12cHNfaW5zdGFuY2VzGAEgAygLMiEub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTSW5zdGFuY2USNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24i8gMKEENyZWF0ZVZQU1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSGAoLZGVzY3JpcHRpb24YAyABKAlIAIgBARIOCgZyZWdpb24YBCABKAkSLQoFaW1hZ2UYBSABKA4yHi5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbWFnZRIVCghpbWFnZV9pZBgGIAEoCUgBiAEBEgwKBHNpemUYByABKAkSFwoKc3NoX2tleV9pZBgIIAEoCUgCiAEBEkYKCG1ldGFkYXRhGAkgAygLMjQub2JpZW50ZS5jbG91ZC52cHMudjEuQ3JlYXRlVlBTUmVxdWVzdC5NZXRhZGF0YUVudHJ5Ej4KCmNsb3VkX2luaXQYCiABKAsyJS5vYmllbnRlLmNsb3VkLnZwcy52MS5DbG91ZEluaXRDb25maWdIA4gBARIaCg1yb290X3Bhc3N3b3JkGAsgASgJSASIAQEaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg4KDF9kZXNjcmlwdGlvbkILCglfaW1hZ2VfaWRCDQoLX3NzaF9rZXlfaWRCDQoLX2Nsb3VkX2luaXRCEAoOX3Jvb3RfcGFzc3dvcmQi1AMKD0Nsb3VkSW5pdENvbmZpZxIyCgV1c2VycxgBIAMoCzIjLm9iaWVudGUuY2xvdWQudnBzLnYxLkNsb3VkSW5pdFVzZXISFQoIaG9zdG5hbWUYAiABKAlIAIgBARIVCgh0aW1lem9uZRgDIAEoCUgBiAEBEhMKBmxvY2FsZRgEIAEoCUgCiAEBEhAKCHBhY2thZ2VzGAUgAygJEhsKDnBhY2thZ2VfdXBkYXRlGAYgASgISAOIAQESHAoPcGFja2FnZV91cGdyYWRlGAcgASgISASIAQESDgoGcnVuY21kGAggAygJEj0KC3dyaXRlX2ZpbGVzGAkgAygLMigub2JpZW50ZS5jbG91ZC52cHMudjEuQ2xvdWRJbml0V3JpdGVGaWxlEh8KEnNzaF9pbnN0YWxsX3NlcnZlchgKIAEoCEgFiAEBEhkKDHNzaF9hbGxvd19wdxgLIAEoCEgGiAEBQgsKCV9ob3N0bmFtZUILCglfdGltZXpvbmVCCQoHX2xvY2FsZUIRCg9fcGFja2FnZV91cGRhdGVCEgoQX3BhY2thZ2VfdXBncmFkZUIVChNfc3NoX2luc3RhbGxfc2VydmVyQg8KDV9zc2hfYWxsb3dfcHcingIKDUNsb3VkSW5pdFVzZXISDAoEbmFtZRgBIAEoCRIVCghwYXNzd29yZBgCIAEoCUgAiAEBEhsKE3NzaF9hdXRob3JpemVkX2tleXMYAyADKAkSEQoEc3VkbxgEIAEoCEgBiAEBEhoKDXN1ZG9fbm9wYXNzd2QYBSABKAhIAogBARIOCgZncm91cHMYBiADKAkSEgoFc2hlbGwYByABKAlIA4gBARIYCgtsb2NrX3Bhc3N3ZBgIIAEoCEgEiAEBEhIKBWdlY29zGAkgASgJSAWIAQFCCwoJX3Bhc3N3b3JkQgcKBV9zdWRvQhAKDl9zdWRvX25vcGFzc3dkQggKBl9zaGVsbEIOCgxfbG9ja19wYXNzd2RCCAoGX2dlY29zIrkBChJDbG91ZEluaXRXcml0ZUZpbGUSDAoEcGF0aBgBIAEoCRIPCgdjb250ZW50GAIgASgJEhIKBW93bmVyGAMgASgJSACIAQESGAoLcGVybWlzc2lvbnMYBCABKAlIAYgBARITCgZhcHBlbmQYBSABKAhIAogBARISCgVkZWZlchgGIAEoCEgDiAEBQggKBl9vd25lckIOCgxfcGVybWlzc2lvbnNCCQoHX2FwcGVuZEIICgZfZGVmZXIiQwoRQ3JlYXRlVlBTUmVzcG9uc2USLgoDdnBzGAEgASgLMiEub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTSW5zdGFuY2UiOAoNR2V0VlBTUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIkAKDkdldFZQU1Jlc3BvbnNlEi4KA3ZwcxgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlIvoBChBVcGRhdGVWUFNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSEQoEbmFtZRgDIAEoCUgAiAEBEhgKC2Rlc2NyaXB0aW9uGAQgASgJSAGIAQESRgoIbWV0YWRhdGEYBSADKAsyNC5vYmllbnRlLmNsb3VkLnZwcy52MS5VcGRhdGVWUFNSZXF1ZXN0Lk1ldGFkYXRhRW50cnkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQgcKBV9uYW1lQg4KDF9kZXNjcmlwdGlvbiJDChFVcGRhdGVWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZSJKChBEZWxldGVWUFNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSDQoFZm9yY2UYAyABKAgiJAoRRGVsZXRlVlBTUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCI6Cg9TdGFydFZQU1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSJCChBTdGFydFZQU1Jlc3BvbnNlEi4KA3ZwcxgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlIjkKDlN0b3BWUFNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkiQQoPU3RvcFZQU1Jlc3BvbnNlEi4KA3ZwcxgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlIjsKEFJlYm9vdFZQU1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSJDChFSZWJvb3RWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZSJBChZTdHJlYW1WUFNTdGF0dXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkiowEKD1ZQU1N0YXR1c1VwZGF0ZRIOCgZ2cHNfaWQYASABKAkSLwoGc3RhdHVzGAIgASgOMh8ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTU3RhdHVzEhQKB21lc3NhZ2UYAyABKAlIAIgBARItCgl0aW1lc3RhbXAYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgoKCF9tZXNzYWdlIsEBChRHZXRWUFNNZXRyaWNzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEi4KCnN0YXJ0X3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCghpbnRlcnZhbBgFIAEoCUgAiAEBQgsKCV9pbnRlcnZhbCJJChVHZXRWUFNNZXRyaWNzUmVzcG9uc2USMAoHbWV0cmljcxgBIAMoCzIfLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU01ldHJpYyJCChdTdHJlYW1WUFNNZXRyaWNzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIrQCCglWUFNNZXRyaWMSDgoGdnBzX2lkGAEgASgJEi0KCXRpbWVzdGFtcBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGQoRY3B1X3VzYWdlX3BlcmNlbnQYAyABKAESGQoRbWVtb3J5X3VzZWRfYnl0ZXMYBCABKAMSGgoSbWVtb3J5X3RvdGFsX2J5dGVzGAUgASgDEhcKD2Rpc2tfdXNlZF9ieXRlcxgGIAEoAxIYChBkaXNrX3RvdGFsX2J5dGVzGAcgASgDEhgKEG5ldHdvcmtfcnhfYnl0ZXMYCCABKAMSGAoQbmV0d29ya190eF9ieXRlcxgJIAEoAxIWCg5kaXNrX3JlYWRfaW9wcxgKIAEoARIXCg9kaXNrX3dyaXRlX2lvcHMYCyABKAEiWwoSR2V0VlBTVXNhZ2VSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSEgoFbW9udGgYAyABKAlIAIgBAUIICgZfbW9udGgiMAoVRmluZFZQU0J5TGVhc2VSZXF1ZXN0EgoKAmlwGAEgASgJEgsKA21hYxgCIAEoCSJBChZGaW5kVlBTQnlMZWFzZVJlc3BvbnNlEg4KBnZwc19pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkizAEKE0dldFZQU1VzYWdlUmVzcG9uc2USDgoGdnBzX2lkGAEgASgJEg0KBW1vbnRoGAIgASgJEjYKB2N1cnJlbnQYAyABKAsyJS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNVc2FnZU1ldHJpY3MSQAoRZXN0aW1hdGVkX21vbnRobHkYBCABKAsyJS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNVc2FnZU1ldHJpY3MSHAoUZXN0aW1hdGVkX2Nvc3RfY2VudHMYBSABKAMipAMKD1ZQU1VzYWdlTWV0cmljcxIYChBjcHVfY29yZV9zZWNvbmRzGAEgASgDEhsKE21lbW9yeV9ieXRlX3NlY29uZHMYAiABKAMSGgoSYmFuZHdpZHRoX3J4X2J5dGVzGAMgASgDEhoKEmJhbmR3aWR0aF90eF9ieXRlcxgEIAEoAxISCgpkaXNrX2J5dGVzGAUgASgDEhYKDnVwdGltZV9zZWNvbmRzGAYgASgDEhwKFGVzdGltYXRlZF9jb3N0X2NlbnRzGAcgASgDEhsKDmNwdV9jb3N0X2NlbnRzGAggASgDSACIAQESHgoRbWVtb3J5X2Nvc3RfY2VudHMYCSABKANIAYgBARIhChRiYW5kd2lkdGhfY29zdF9jZW50cxgKIAEoA0gCiAEBEh8KEnN0b3JhZ2VfY29zdF9jZW50cxgLIAEoA0gDiAEBQhEKD19jcHVfY29zdF9jZW50c0IUChJfbWVtb3J5X2Nvc3RfY2VudHNCFwoVX2JhbmR3aWR0aF9jb3N0X2NlbnRzQhUKE19zdG9yYWdlX2Nvc3RfY2VudHMiPgocTGlzdEF2YWlsYWJsZVZQU1NpemVzUmVxdWVzdBITCgZyZWdpb24YASABKAlIAIgBAUIJCgdfcmVnaW9uIlAKHUxpc3RBdmFpbGFibGVWUFNTaXplc1Jlc3BvbnNlEi8KBXNpemVzGAEgAygLMiAub2JpZW50ZS5jbG91ZC5jb21tb24udjEuVlBTU2l6ZSIXChVMaXN0VlBTUmVnaW9uc1JlcXVlc3QiSgoWTGlzdFZQU1JlZ2lvbnNSZXNwb25zZRIwCgdyZWdpb25zGAEgAygLMh8ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTUmVnaW9uIkEKFkdldFZQU1Byb3h5SW5mb1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSKiAQoXR2V0VlBTUHJveHlJbmZvUmVzcG9uc2USDgoGdnBzX2lkGAEgASgJEhcKD3Rlcm1pbmFsX3dzX3VybBgCIAEoCRIZChFzc2hfcHJveHlfY29tbWFuZBgDIAEoCRIVCghzc2hfcG9ydBgEIAEoBUgAiAEBEh8KF2Nvbm5lY3Rpb25faW5zdHJ1Y3Rpb25zGAUgASgJQgsKCV9zc2hfcG9ydCJXCglWUFNSZWdpb24SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIPCgdjb3VudHJ5GAMgASgJEgwKBGNpdHkYBCABKAkSEQoJYXZhaWxhYmxlGAUgASgIIugHCgtWUFNJbnN0YW5jZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKC2Rlc2NyaXB0aW9uGAMgASgJSACIAQESLwoGc3RhdHVzGAQgASgOMh8ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTU3RhdHVzEg4KBnJlZ2lvbhgFIAEoCRItCgVpbWFnZRgGIAEoDjIeLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0ltYWdlEhUKCGltYWdlX2lkGAcgASgJSAGIAQESDAoEc2l6ZRgIIAEoCRIRCgljcHVfY29yZXMYCSABKAUSFAoMbWVtb3J5X2J5dGVzGAogASgDEhIKCmRpc2tfYnl0ZXMYCyABKAMSFgoOaXB2NF9hZGRyZXNzZXMYDCADKAkSFgoOaXB2Nl9hZGRyZXNzZXMYDSADKAkSGAoLaW5zdGFuY2VfaWQYDiABKAlIAogBARIUCgdub2RlX2lkGA8gASgJSAOIAQESFwoKc3NoX2tleV9pZBgQIAEoCUgEiAEBEhoKDXJvb3RfcGFzc3dvcmQYESABKAlIBYgBARJBCghtZXRhZGF0YRgSIAMoCzIvLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlLk1ldGFkYXRhRW50cnkSLgoKY3JlYXRlZF9hdBgTIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgUIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOAoPbGFzdF9zdGFydGVkX2F0GBUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgGiAEBEjMKCmRlbGV0ZWRfYXQYFiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAeIAQESFwoPb3JnYW5pemF0aW9uX2lkGBcgASgJEhIKCmNyZWF0ZWRfYnkYGCABKAkSPQoPY3VycmVudF9tZXRyaWNzGBkgASgLMh8ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTTWV0cmljSAiIAQEaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg4KDF9kZXNjcmlwdGlvbkILCglfaW1hZ2VfaWRCDgoMX2luc3RhbmNlX2lkQgoKCF9ub2RlX2lkQg0KC19zc2hfa2V5X2lkQhAKDl9yb290X3Bhc3N3b3JkQhIKEF9sYXN0X3N0YXJ0ZWRfYXRCDQoLX2RlbGV0ZWRfYXRCEgoQX2N1cnJlbnRfbWV0cmljcyJDChhMaXN0RmlyZXdhbGxSdWxlc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSJOChlMaXN0RmlyZXdhbGxSdWxlc1Jlc3BvbnNlEjEKBXJ1bGVzGAEgAygLMiIub2JpZW50ZS5jbG91ZC52cHMudjEuRmlyZXdhbGxSdWxlIlMKFkdldEZpcmV3YWxsUnVsZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCRIQCghydWxlX3BvcxgDIAEoBSJLChdHZXRGaXJld2FsbFJ1bGVSZXNwb25zZRIwCgRydWxlGAEgASgLMiIub2JpZW50ZS5jbG91ZC52cHMudjEuRmlyZXdhbGxSdWxlIpABChlDcmVhdGVGaXJld2FsbFJ1bGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSMAoEcnVsZRgDIAEoCzIiLm9iaWVudGUuY2xvdWQudnBzLnYxLkZpcmV3YWxsUnVsZRIQCgNwb3MYBCABKAVIAIgBAUIGCgRfcG9zIk4KGkNyZWF0ZUZpcmV3YWxsUnVsZVJlc3BvbnNlEjAKBHJ1bGUYASABKAsyIi5vYmllbnRlLmNsb3VkLnZwcy52MS5GaXJld2FsbFJ1bGUiiAEKGVVwZGF0ZUZpcmV3YWxsUnVsZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCRIQCghydWxlX3BvcxgDIAEoBRIwCgRydWxlGAQgASgLMiIub2JpZW50ZS5jbG91ZC52cHMudjEuRmlyZXdhbGxSdWxlIk4KGlVwZGF0ZUZpcmV3YWxsUnVsZVJlc3BvbnNlEjAKBHJ1bGUYASABKAsyIi5vYmllbnRlLmNsb3VkLnZwcy52MS5GaXJld2FsbFJ1bGUiVgoZRGVsZXRlRmlyZXdhbGxSdWxlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhAKCHJ1bGVfcG9zGAMgASgFIi0KGkRlbGV0ZUZpcmV3YWxsUnVsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiRAoZR2V0RmlyZXdhbGxPcHRpb25zUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIlQKGkdldEZpcmV3YWxsT3B0aW9uc1Jlc3BvbnNlEjYKB29wdGlvbnMYASABKAsyJS5vYmllbnRlLmNsb3VkLnZwcy52MS5GaXJld2FsbE9wdGlvbnMifwocVXBkYXRlRmlyZXdhbGxPcHRpb25zUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEjYKB29wdGlvbnMYAyABKAsyJS5vYmllbnRlLmNsb3VkLnZwcy52MS5GaXJld2FsbE9wdGlvbnMiVwodVXBkYXRlRmlyZXdhbGxPcHRpb25zUmVzcG9uc2USNgoHb3B0aW9ucxgBIAEoCzIlLm9iaWVudGUuY2xvdWQudnBzLnYxLkZpcmV3YWxsT3B0aW9ucyKEBAoMRmlyZXdhbGxSdWxlEgsKA3BvcxgBIAEoBRIOCgZlbmFibGUYAiABKAgSNAoGYWN0aW9uGAMgASgOMiQub2JpZW50ZS5jbG91ZC52cHMudjEuRmlyZXdhbGxBY3Rpb24SNQoEdHlwZRgEIAEoDjInLm9iaWVudGUuY2xvdWQudnBzLnYxLkZpcmV3YWxsRGlyZWN0aW9uEhQKB2NvbW1lbnQYBSABKAlIAIgBARITCgZzb3VyY2UYBiABKAlIAYgBARIRCgRkZXN0GAcgASgJSAKIAQESEgoFaWZhY2UYCCABKAlIA4gBARIXCgptYWNfc291cmNlGAkgASgJSASIAQESPQoIcHJvdG9jb2wYCiABKA4yJi5vYmllbnRlLmNsb3VkLnZwcy52MS5GaXJld2FsbFByb3RvY29sSAWIAQESEgoFZHBvcnQYCyABKAlIBogBARISCgVzcG9ydBgMIAEoCUgHiAEBEhYKCWljbXBfdHlwZRgNIAEoBUgIiAEBEhAKA2xvZxgOIAEoCEgJiAEBQgoKCF9jb21tZW50QgkKB19zb3VyY2VCBwoFX2Rlc3RCCAoGX2lmYWNlQg0KC19tYWNfc291cmNlQgsKCV9wcm90b2NvbEIICgZfZHBvcnRCCAoGX3Nwb3J0QgwKCl9pY21wX3R5cGVCBgoEX2xvZyKPAwoPRmlyZXdhbGxPcHRpb25zEg4KBmVuYWJsZRgBIAEoCBIWCglwb2xpY3lfaW4YAiABKAlIAIgBARIXCgpwb2xpY3lfb3V0GAMgASgJSAGIAQESGQoMbG9nX2xldmVsX2luGAQgASgISAKIAQESGgoNbG9nX2xldmVsX291dBgFIAEoCEgDiAEBEhMKBm5mX2xvZxgGIAEoCEgEiAEBEhEKBGRoY3AYByABKAhIBYgBARIQCgNuZHAYCCABKAhIBogBARIRCgRyYWR2GAkgASgISAeIAQESFQoIaXBmaWx0ZXIYCiABKAhICIgBARIbCg5pcGZpbHRlcl9ydWxlcxgLIAEoCEgJiAEBQgwKCl9wb2xpY3lfaW5CDQoLX3BvbGljeV9vdXRCDwoNX2xvZ19sZXZlbF9pbkIQCg5fbG9nX2xldmVsX291dEIJCgdfbmZfbG9nQgcKBV9kaGNwQgYKBF9uZHBCBwoFX3JhZHZCCwoJX2lwZmlsdGVyQhEKD19pcGZpbHRlcl9ydWxlcyLzAQoGU1NIS2V5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEgoKcHVibGljX2tleRgDIAEoCRITCgtmaW5nZXJwcmludBgEIAEoCRITCgZ2cHNfaWQYBSABKAlIAIgBARIzCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEjMKCnVwZGF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAKIAQFCCQoHX3Zwc19pZEINCgtfY3JlYXRlZF9hdEINCgtfdXBkYXRlZF9hdCJNChJMaXN0U1NIS2V5c1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhMKBnZwc19pZBgCIAEoCUgAiAEBQgkKB192cHNfaWQiQQoTTGlzdFNTSEtleXNSZXNwb25zZRIqCgRrZXlzGAEgAygLMhwub2JpZW50ZS5jbG91ZC52cHMudjEuU1NIS2V5Im0KEEFkZFNTSEtleVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEgoKcHVibGljX2tleRgDIAEoCRITCgZ2cHNfaWQYBCABKAlIAIgBAUIJCgdfdnBzX2lkIj4KEUFkZFNTSEtleVJlc3BvbnNlEikKA2tleRgBIAEoCzIcLm9iaWVudGUuY2xvdWQudnBzLnYxLlNTSEtleSJMChNVcGRhdGVTU0hLZXlSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZrZXlfaWQYAiABKAkSDAoEbmFtZRgDIAEoCSJBChRVcGRhdGVTU0hLZXlSZXNwb25zZRIpCgNrZXkYASABKAsyHC5vYmllbnRlLmNsb3VkLnZwcy52MS5TU0hLZXkiPgoTUmVtb3ZlU1NIS2V5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGa2V5X2lkGAIgASgJIkwKFFJlbW92ZVNTSEtleVJlc3BvbnNlEhgKEGFmZmVjdGVkX3Zwc19pZHMYASADKAkSGgoSYWZmZWN0ZWRfdnBzX25hbWVzGAIgAygJIkIKF1Jlc2V0VlBTUGFzc3dvcmRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkiUgoYUmVzZXRWUFNQYXNzd29yZFJlc3BvbnNlEg4KBnZwc19pZBgBIAEoCRIVCg1yb290X3Bhc3N3b3JkGAIgASgJEg8KB21lc3NhZ2UYAyABKAkiQQoWUmVpbml0aWFsaXplVlBTUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIogBChdSZWluaXRpYWxpemVWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZRIaCg1yb290X3Bhc3N3b3JkGAIgASgJSACIAQESDwoHbWVzc2FnZRgDIAEoCUIQCg5fcm9vdF9wYXNzd29yZCI/ChRTdHJlYW1WUFNMb2dzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIm4KClZQU0xvZ0xpbmUSDAoEbGluZRgBIAEoCRIOCgZzdGRlcnIYAiABKAgSEwoLbGluZV9udW1iZXIYAyABKAUSLQoJdGltZXN0YW1wGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJuChhHZXRWUFNKb3VybmFsTG9nc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCRIRCgR1bml0GAMgASgJSACIAQESDQoFbGluZXMYBCABKAVCBwoFX3VuaXQiSwoZR2V0VlBTSm91cm5hbExvZ3NSZXNwb25zZRIuCgRsb2dzGAEgAygLMiAub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTTG9nTGluZSJbChZMaXN0VlBTU2VydmljZXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSGAoQaW5jbHVkZV9pbmFjdGl2ZRgDIAEoCCJyChBWUFNTeXN0ZW1TZXJ2aWNlEgwKBG5hbWUYASABKAkSEgoKbG9hZF9zdGF0ZRgCIAEoCRIUCgxhY3RpdmVfc3RhdGUYAyABKAkSEQoJc3ViX3N0YXRlGAQgASgJEhMKC2Rlc2NyaXB0aW9uGAUgASgJIoMBChdMaXN0VlBTU2VydmljZXNSZXNwb25zZRI4CghzZXJ2aWNlcxgBIAMoCzImLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1N5c3RlbVNlcnZpY2USLgoKZmV0Y2hlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiKwoQSW1wb3J0VlBTUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiiwEKEUltcG9ydFZQU1Jlc3BvbnNlEhYKDmltcG9ydGVkX2NvdW50GAEgASgFEjcKDGltcG9ydGVkX3ZwcxgCIAMoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlEhUKDXNraXBwZWRfY291bnQYAyABKAUSDgoGZXJyb3JzGAQgAygJIk4KE0dldFZQU0xlYXNlc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhMKBnZwc19pZBgCIAEoCUgAiAEBQgkKB192cHNfaWQinwEKCFZQU0xlYXNlEg4KBnZwc19pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEwoLbWFjX2FkZHJlc3MYAyABKAkSEgoKaXBfYWRkcmVzcxgEIAEoCRIuCgpleHBpcmVzX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglpc19wdWJsaWMYBiABKAgiRgoUR2V0VlBTTGVhc2VzUmVzcG9uc2USLgoGbGVhc2VzGAEgAygLMh4ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTTGVhc2UiwQEKFFJlZ2lzdGVyTGVhc2VSZXF1ZXN0Eg4KBnZwc19pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEwoLbWFjX2FkZHJlc3MYAyABKAkSEgoKaXBfYWRkcmVzcxgEIAEoCRIuCgpleHBpcmVzX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglpc19wdWJsaWMYBiABKAgSFAoMZ2F0ZXdheV9ub2RlGAcgASgJIjkKFVJlZ2lzdGVyTGVhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiOgoTUmVsZWFzZUxlYXNlUmVxdWVzdBIOCgZ2cHNfaWQYASABKAkSEwoLbWFjX2FkZHJlc3MYAiABKAkiOAoUUmVsZWFzZUxlYXNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIlYKGEFzc2lnblZQU1B1YmxpY0lQUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhEKCXB1YmxpY19pcBgDIAEoCSI9ChlBc3NpZ25WUFNQdWJsaWNJUFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJYChpVbmFzc2lnblZQU1B1YmxpY0lQUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhEKCXB1YmxpY19pcBgDIAEoCSI/ChtVbmFzc2lnblZQU1B1YmxpY0lQUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIt8DCgtWUFNQdWJsaWNJUBIKCgJpZBgBIAEoCRISCgppcF9hZGRyZXNzGAIgASgJEhMKBnZwc19pZBgDIAEoCUgAiAEBEhwKD29yZ2FuaXphdGlvbl9pZBgEIAEoCUgBiAEBEhUKCHZwc19uYW1lGAUgASgJSAKIAQESHgoRb3JnYW5pemF0aW9uX25hbWUYBiABKAlIA4gBARIaChJtb250aGx5X2Nvc3RfY2VudHMYByABKAMSFAoHZ2F0ZXdheRgLIAEoCUgEiAEBEhQKB25ldG1hc2sYDCABKAlIBYgBARI0Cgthc3NpZ25lZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIBogBARIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIJCgdfdnBzX2lkQhIKEF9vcmdhbml6YXRpb25faWRCCwoJX3Zwc19uYW1lQhQKEl9vcmdhbml6YXRpb25fbmFtZUIKCghfZ2F0ZXdheUIKCghfbmV0bWFza0IOCgxfYXNzaWduZWRfYXQiwwEKF0xpc3RWUFNQdWJsaWNJUHNSZXF1ZXN0EhMKBnZwc19pZBgBIAEoCUgAiAEBEhwKD29yZ2FuaXphdGlvbl9pZBgCIAEoCUgBiAEBEh8KEmluY2x1ZGVfdW5hc3NpZ25lZBgDIAEoCEgCiAEBEgwKBHBhZ2UYBCABKAUSEAoIcGVyX3BhZ2UYBSABKAVCCQoHX3Zwc19pZEISChBfb3JnYW5pemF0aW9uX2lkQhUKE19pbmNsdWRlX3VuYXNzaWduZWQiXwoYTGlzdFZQU1B1YmxpY0lQc1Jlc3BvbnNlEi4KA2lwcxgBIAMoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1B1YmxpY0lQEhMKC3RvdGFsX2NvdW50GAIgASgDIo4BChhDcmVhdGVWUFNQdWJsaWNJUFJlcXVlc3QSEgoKaXBfYWRkcmVzcxgBIAEoCRIaChJtb250aGx5X2Nvc3RfY2VudHMYAiABKAMSFAoHZ2F0ZXdheRgDIAEoCUgAiAEBEhQKB25ldG1hc2sYBCABKAlIAYgBAUIKCghfZ2F0ZXdheUIKCghfbmV0bWFzayJKChlDcmVhdGVWUFNQdWJsaWNJUFJlc3BvbnNlEi0KAmlwGAEgASgLMiEub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTUHVibGljSVAiogEKGFVwZGF0ZVZQU1B1YmxpY0lQUmVxdWVzdBIKCgJpZBgBIAEoCRIfChJtb250aGx5X2Nvc3RfY2VudHMYAiABKANIAIgBARIUCgdnYXRld2F5GAMgASgJSAGIAQESFAoHbmV0bWFzaxgEIAEoCUgCiAEBQhUKE19tb250aGx5X2Nvc3RfY2VudHNCCgoIX2dhdGV3YXlCCgoIX25ldG1hc2siSgoZVXBkYXRlVlBTUHVibGljSVBSZXNwb25zZRItCgJpcBgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1B1YmxpY0lQIiYKGERlbGV0ZVZQU1B1YmxpY0lQUmVxdWVzdBIKCgJpZBgBIAEoCSIsChlEZWxldGVWUFNQdWJsaWNJUFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgqsAEKCVZQU1N0YXR1cxIaChZWUFNfU1RBVFVTX1VOU1BFQ0lGSUVEEAASDAoIQ1JFQVRJTkcQARIMCghTVEFSVElORxACEgsKB1JVTk5JTkcQAxIMCghTVE9QUElORxAEEgsKB1NUT1BQRUQQBRINCglSRUJPT1RJTkcQBhIKCgZGQUlMRUQQBxIMCghERUxFVElORxAIEgsKB0RFTEVURUQQCRINCglTVVNQRU5ERUQQCiqYAQoIVlBTSW1hZ2USGQoVVlBTX0lNQUdFX1VOU1BFQ0lGSUVEEAASEAoMVUJVTlRVXzIyXzA0EAESEAoMVUJVTlRVXzI0XzA0EAISDQoJREVCSUFOXzEyEAMSDQoJREVCSUFOXzEzEAQSEQoNUk9DS1lfTElOVVhfORAFEhAKDEFMTUFfTElOVVhfORAGEgoKBkNVU1RPTRBjKlMKDkZpcmV3YWxsQWN0aW9uEh8KG0ZJUkVXQUxMX0FDVElPTl9VTlNQRUNJRklFRBAAEgoKBkFDQ0VQVBABEgoKBlJFSkVDVBACEggKBERST1AQAypIChFGaXJld2FsbERpcmVjdGlvbhIiCh5GSVJFV0FMTF9ESVJFQ1RJT05fVU5TUEVDSUZJRUQQABIGCgJJThABEgcKA09VVBACKmYKEEZpcmV3YWxsUHJvdG9jb2wSIQodRklSRVdBTExfUFJPVE9DT0xfVU5TUEVDSUZJRUQQABIHCgNUQ1AQARIHCgNVRFAQAhIICgRJQ01QEAMSCgoGSUNNUFY2EAQSBwoDQUxMEAUy1B8KClZQU1NlcnZpY2USVgoHTGlzdFZQUxIkLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RWUFNSZXF1ZXN0GiUub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFZQU1Jlc3BvbnNlElwKCUNyZWF0ZVZQUxImLm9iaWVudGUuY2xvdWQudnBzLnYxLkNyZWF0ZVZQU1JlcXVlc3QaJy5vYmllbnRlLmNsb3VkLnZwcy52MS5DcmVhdGVWUFNSZXNwb25zZRJTCgZHZXRWUFMSIy5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRWUFNSZXF1ZXN0GiQub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0VlBTUmVzcG9uc2USXAoJVXBkYXRlVlBTEiYub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlVlBTUmVxdWVzdBonLm9iaWVudGUuY2xvdWQudnBzLnYxLlVwZGF0ZVZQU1Jlc3BvbnNlElwKCURlbGV0ZVZQUxImLm9iaWVudGUuY2xvdWQudnBzLnYxLkRlbGV0ZVZQU1JlcXVlc3QaJy5vYmllbnRlLmNsb3VkLnZwcy52MS5EZWxldGVWUFNSZXNwb25zZRJZCghTdGFydFZQUxIlLm9iaWVudGUuY2xvdWQudnBzLnYxLlN0YXJ0VlBTUmVxdWVzdBomLm9iaWVudGUuY2xvdWQudnBzLnYxLlN0YXJ0VlBTUmVzcG9uc2USVgoHU3RvcFZQUxIkLm9iaWVudGUuY2xvdWQudnBzLnYxLlN0b3BWUFNSZXF1ZXN0GiUub2JpZW50ZS5jbG91ZC52cHMudjEuU3RvcFZQU1Jlc3BvbnNlElwKCVJlYm9vdFZQUxImLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlYm9vdFZQU1JlcXVlc3QaJy5vYmllbnRlLmNsb3VkLnZwcy52MS5SZWJvb3RWUFNSZXNwb25zZRJoCg9TdHJlYW1WUFNTdGF0dXMSLC5vYmllbnRlLmNsb3VkLnZwcy52MS5TdHJlYW1WUFNTdGF0dXNSZXF1ZXN0GiUub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTU3RhdHVzVXBkYXRlMAESaAoNR2V0VlBTTWV0cmljcxIqLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU01ldHJpY3NSZXF1ZXN0Gisub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0VlBTTWV0cmljc1Jlc3BvbnNlEmQKEFN0cmVhbVZQU01ldHJpY3MSLS5vYmllbnRlLmNsb3VkLnZwcy52MS5TdHJlYW1WUFNNZXRyaWNzUmVxdWVzdBofLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU01ldHJpYzABEmIKC0dldFZQU1VzYWdlEigub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0VlBTVXNhZ2VSZXF1ZXN0Gikub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0VlBTVXNhZ2VSZXNwb25zZRJ3CgxMaXN0VlBTU2l6ZXMSMi5vYmllbnRlLmNsb3VkLnZwcy52MS5MaXN0QXZhaWxhYmxlVlBTU2l6ZXNSZXF1ZXN0GjMub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdEF2YWlsYWJsZVZQU1NpemVzUmVzcG9uc2USawoOTGlzdFZQU1JlZ2lvbnMSKy5vYmllbnRlLmNsb3VkLnZwcy52MS5MaXN0VlBTUmVnaW9uc1JlcXVlc3QaLC5vYmllbnRlLmNsb3VkLnZwcy52MS5MaXN0VlBTUmVnaW9uc1Jlc3BvbnNlEm4KD0dldFZQU1Byb3h5SW5mbxIsLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU1Byb3h5SW5mb1JlcXVlc3QaLS5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRWUFNQcm94eUluZm9SZXNwb25zZRJ0ChFMaXN0RmlyZXdhbGxSdWxlcxIuLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RGaXJld2FsbFJ1bGVzUmVxdWVzdBovLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RGaXJld2FsbFJ1bGVzUmVzcG9uc2USbgoPR2V0RmlyZXdhbGxSdWxlEiwub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0RmlyZXdhbGxSdWxlUmVxdWVzdBotLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldEZpcmV3YWxsUnVsZVJlc3BvbnNlEncKEkNyZWF0ZUZpcmV3YWxsUnVsZRIvLm9iaWVudGUuY2xvdWQudnBzLnYxLkNyZWF0ZUZpcmV3YWxsUnVsZVJlcXVlc3QaMC5vYmllbnRlLmNsb3VkLnZwcy52MS5DcmVhdGVGaXJld2FsbFJ1bGVSZXNwb25zZRJ3ChJVcGRhdGVGaXJld2FsbFJ1bGUSLy5vYmllbnRlLmNsb3VkLnZwcy52MS5VcGRhdGVGaXJld2FsbFJ1bGVSZXF1ZXN0GjAub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlRmlyZXdhbGxSdWxlUmVzcG9uc2USdwoSRGVsZXRlRmlyZXdhbGxSdWxlEi8ub2JpZW50ZS5jbG91ZC52cHMudjEuRGVsZXRlRmlyZXdhbGxSdWxlUmVxdWVzdBowLm9iaWVudGUuY2xvdWQudnBzLnYxLkRlbGV0ZUZpcmV3YWxsUnVsZVJlc3BvbnNlEncKEkdldEZpcmV3YWxsT3B0aW9ucxIvLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldEZpcmV3YWxsT3B0aW9uc1JlcXVlc3QaMC5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRGaXJld2FsbE9wdGlvbnNSZXNwb25zZRKAAQoVVXBkYXRlRmlyZXdhbGxPcHRpb25zEjIub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlRmlyZXdhbGxPcHRpb25zUmVxdWVzdBozLm9iaWVudGUuY2xvdWQudnBzLnYxLlVwZGF0ZUZpcmV3YWxsT3B0aW9uc1Jlc3BvbnNlEmIKC0xpc3RTU0hLZXlzEigub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFNTSEtleXNSZXF1ZXN0Gikub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFNTSEtleXNSZXNwb25zZRJcCglBZGRTU0hLZXkSJi5vYmllbnRlLmNsb3VkLnZwcy52MS5BZGRTU0hLZXlSZXF1ZXN0Gicub2JpZW50ZS5jbG91ZC52cHMudjEuQWRkU1NIS2V5UmVzcG9uc2USZQoMVXBkYXRlU1NIS2V5Eikub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlU1NIS2V5UmVxdWVzdBoqLm9iaWVudGUuY2xvdWQudnBzLnYxLlVwZGF0ZVNTSEtleVJlc3BvbnNlEmUKDFJlbW92ZVNTSEtleRIpLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlbW92ZVNTSEtleVJlcXVlc3QaKi5vYmllbnRlLmNsb3VkLnZwcy52MS5SZW1vdmVTU0hLZXlSZXNwb25zZRJxChBSZXNldFZQU1Bhc3N3b3JkEi0ub2JpZW50ZS5jbG91ZC52cHMudjEuUmVzZXRWUFNQYXNzd29yZFJlcXVlc3QaLi5vYmllbnRlLmNsb3VkLnZwcy52MS5SZXNldFZQU1Bhc3N3b3JkUmVzcG9uc2USbgoPUmVpbml0aWFsaXplVlBTEiwub2JpZW50ZS5jbG91ZC52cHMudjEuUmVpbml0aWFsaXplVlBTUmVxdWVzdBotLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlaW5pdGlhbGl6ZVZQU1Jlc3BvbnNlEl8KDVN0cmVhbVZQU0xvZ3MSKi5vYmllbnRlLmNsb3VkLnZwcy52MS5TdHJlYW1WUFNMb2dzUmVxdWVzdBogLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0xvZ0xpbmUwARJ0ChFHZXRWUFNKb3VybmFsTG9ncxIuLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU0pvdXJuYWxMb2dzUmVxdWVzdBovLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU0pvdXJuYWxMb2dzUmVzcG9uc2USbgoPTGlzdFZQU1NlcnZpY2VzEiwub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFZQU1NlcnZpY2VzUmVxdWVzdBotLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RWUFNTZXJ2aWNlc1Jlc3BvbnNlElwKCUltcG9ydFZQUxImLm9iaWVudGUuY2xvdWQudnBzLnYxLkltcG9ydFZQU1JlcXVlc3QaJy5vYmllbnRlLmNsb3VkLnZwcy52MS5JbXBvcnRWUFNSZXNwb25zZRJlCgxHZXRWUFNMZWFzZXMSKS5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRWUFNMZWFzZXNSZXF1ZXN0Gioub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0VlBTTGVhc2VzUmVzcG9uc2USawoORmluZFZQU0J5TGVhc2USKy5vYmllbnRlLmNsb3VkLnZwcy52MS5GaW5kVlBTQnlMZWFzZVJlcXVlc3QaLC5vYmllbnRlLmNsb3VkLnZwcy52MS5GaW5kVlBTQnlMZWFzZVJlc3BvbnNlEmgKDVJlZ2lzdGVyTGVhc2USKi5vYmllbnRlLmNsb3VkLnZwcy52MS5SZWdpc3RlckxlYXNlUmVxdWVzdBorLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlZ2lzdGVyTGVhc2VSZXNwb25zZRJlCgxSZWxlYXNlTGVhc2USKS5vYmllbnRlLmNsb3VkLnZwcy52MS5SZWxlYXNlTGVhc2VSZXF1ZXN0Gioub2JpZW50ZS5jbG91ZC52cHMudjEuUmVsZWFzZUxlYXNlUmVzcG9uc2USdAoRQXNzaWduVlBTUHVibGljSVASLi5vYmllbnRlLmNsb3VkLnZwcy52MS5Bc3NpZ25WUFNQdWJsaWNJUFJlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnZwcy52MS5Bc3NpZ25WUFNQdWJsaWNJUFJlc3BvbnNlEnoKE1VuYXNzaWduVlBTUHVibGljSVASMC5vYmllbnRlLmNsb3VkLnZwcy52MS5VbmFzc2lnblZQU1B1YmxpY0lQUmVxdWVzdBoxLm9iaWVudGUuY2xvdWQudnBzLnYxLlVuYXNzaWduVlBTUHVibGljSVBSZXNwb25zZUJHWkVnaXRodWIuY29tL29iaWVudGUvY2xvdWQvYXBwcy9zaGFyZWQvcHJvdG8vb2JpZW50ZS9jbG91ZC92cHMvdjE7dnBzdjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_obiente_cloud_organizations_v1_organization_service, file_obiente_cloud_common_v1_common]);
+  fileDesc("CiZvYmllbnRlL2Nsb3VkL3Zwcy92MS92cHNfc2VydmljZS5wcm90bxIUb2JpZW50ZS5jbG91ZC52cHMudjEiigEKDkxpc3RWUFNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIMCgRwYWdlGAIgASgFEhAKCHBlcl9wYWdlGAMgASgFEjQKBnN0YXR1cxgEIAEoDjIfLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1N0YXR1c0gAiAEBQgkKB19zdGF0dXMihAEKD0xpc3RWUFNSZXNwb25zZRI4Cg12cHNfaW5zdGFuY2VzGAEgAygLMiEub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTSW5zdGFuY2USNwoKcGFnaW5hdGlvbhgCIAEoCzIjLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlBhZ2luYXRpb24i9AUKEENyZWF0ZVZQU1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSGAoLZGVzY3JpcHRpb24YAyABKAlIAIgBARIOCgZyZWdpb24YBCABKAkSLQoFaW1hZ2UYBSABKA4yHi5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbWFnZRIVCghpbWFnZV9pZBgGIAEoCUgBiAEBEgwKBHNpemUYByABKAkSFwoKc3NoX2tleV9pZBgIIAEoCUgCiAEBEkYKCG1ldGFkYXRhGAkgAygLMjQub2JpZW50ZS5jbG91ZC52cHMudjEuQ3JlYXRlVlBTUmVxdWVzdC5NZXRhZGF0YUVudHJ5Ej4KCmNsb3VkX2luaXQYCiABKAsyJS5vYmllbnRlLmNsb3VkLnZwcy52MS5DbG91ZEluaXRDb25maWdIA4gBARIaCg1yb290X3Bhc3N3b3JkGAsgASgJSASIAQESIwoWY2xvdWRfaW5pdF90ZW1wbGF0ZV9pZBgMIAEoCUgFiAEBEhgKC3RlbXBsYXRlX2lkGA0gASgJSAaIAQESFgoJc3RhdGljX2lwGA4gASgJSAeIAQESFAoHZ2F0ZXdheRgPIAEoCUgIiAEBEhMKC2Ruc19zZXJ2ZXJzGBAgAygJEjEKA2dwdRgRIAEoCzIfLm9iaWVudGUuY2xvdWQudnBzLnYxLkdQVUNvbmZpZ0gJiAEBGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIOCgxfZGVzY3JpcHRpb25CCwoJX2ltYWdlX2lkQg0KC19zc2hfa2V5X2lkQg0KC19jbG91ZF9pbml0QhAKDl9yb290X3Bhc3N3b3JkQhkKF19jbG91ZF9pbml0X3RlbXBsYXRlX2lkQg4KDF90ZW1wbGF0ZV9pZEIMCgpfc3RhdGljX2lwQgoKCF9nYXRld2F5QgYKBF9ncHUiOwoJR1BVQ29uZmlnEg4KBnBjaV9pZBgBIAEoCRITCgZkcml2ZXIYAiABKAlIAIgBAUIJCgdfZHJpdmVyItQDCg9DbG91ZEluaXRDb25maWcSMgoFdXNlcnMYASADKAsyIy5vYmllbnRlLmNsb3VkLnZwcy52MS5DbG91ZEluaXRVc2VyEhUKCGhvc3RuYW1lGAIgASgJSACIAQESFQoIdGltZXpvbmUYAyABKAlIAYgBARITCgZsb2NhbGUYBCABKAlIAogBARIQCghwYWNrYWdlcxgFIAMoCRIbCg5wYWNrYWdlX3VwZGF0ZRgGIAEoCEgDiAEBEhwKD3BhY2thZ2VfdXBncmFkZRgHIAEoCEgEiAEBEg4KBnJ1bmNtZBgIIAMoCRI9Cgt3cml0ZV9maWxlcxgJIAMoCzIoLm9iaWVudGUuY2xvdWQudnBzLnYxLkNsb3VkSW5pdFdyaXRlRmlsZRIfChJzc2hfaW5zdGFsbF9zZXJ2ZXIYCiABKAhIBYgBARIZCgxzc2hfYWxsb3dfcHcYCyABKAhIBogBAUILCglfaG9zdG5hbWVCCwoJX3RpbWV6b25lQgkKB19sb2NhbGVCEQoPX3BhY2thZ2VfdXBkYXRlQhIKEF9wYWNrYWdlX3VwZ3JhZGVCFQoTX3NzaF9pbnN0YWxsX3NlcnZlckIPCg1fc3NoX2FsbG93X3B3Ip4CCg1DbG91ZEluaXRVc2VyEgwKBG5hbWUYASABKAkSFQoIcGFzc3dvcmQYAiABKAlIAIgBARIbChNzc2hfYXV0aG9yaXplZF9rZXlzGAMgAygJEhEKBHN1ZG8YBCABKAhIAYgBARIaCg1zdWRvX25vcGFzc3dkGAUgASgISAKIAQESDgoGZ3JvdXBzGAYgAygJEhIKBXNoZWxsGAcgASgJSAOIAQESGAoLbG9ja19wYXNzd2QYCCABKAhIBIgBARISCgVnZWNvcxgJIAEoCUgFiAEBQgsKCV9wYXNzd29yZEIHCgVfc3Vkb0IQCg5fc3Vkb19ub3Bhc3N3ZEIICgZfc2hlbGxCDgoMX2xvY2tfcGFzc3dkQggKBl9nZWNvcyK5AQoSQ2xvdWRJbml0V3JpdGVGaWxlEgwKBHBhdGgYASABKAkSDwoHY29udGVudBgCIAEoCRISCgVvd25lchgDIAEoCUgAiAEBEhgKC3Blcm1pc3Npb25zGAQgASgJSAGIAQESEwoGYXBwZW5kGAUgASgISAKIAQESEgoFZGVmZXIYBiABKAhIA4gBAUIICgZfb3duZXJCDgoMX3Blcm1pc3Npb25zQgkKB19hcHBlbmRCCAoGX2RlZmVyIkMKEUNyZWF0ZVZQU1Jlc3BvbnNlEi4KA3ZwcxgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlIjgKDUdldFZQU1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSJACg5HZXRWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZSL6AQoQVXBkYXRlVlBTUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhEKBG5hbWUYAyABKAlIAIgBARIYCgtkZXNjcmlwdGlvbhgEIAEoCUgBiAEBEkYKCG1ldGFkYXRhGAUgAygLMjQub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlVlBTUmVxdWVzdC5NZXRhZGF0YUVudHJ5Gi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIHCgVfbmFtZUIOCgxfZGVzY3JpcHRpb24iQwoRVXBkYXRlVlBTUmVzcG9uc2USLgoDdnBzGAEgASgLMiEub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTSW5zdGFuY2UiSgoQRGVsZXRlVlBTUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEg0KBWZvcmNlGAMgASgIIiQKEURlbGV0ZVZQU1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiOgoPU3RhcnRWUFNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkiQgoQU3RhcnRWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZSI5Cg5TdG9wVlBTUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIkEKD1N0b3BWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZSI7ChBSZWJvb3RWUFNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkiQwoRUmVib290VlBTUmVzcG9uc2USLgoDdnBzGAEgASgLMiEub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTSW5zdGFuY2UiUAoPUGF0Y2hWUFNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSFAoMcmVib290X2FmdGVyGAMgASgIIiQKEFBhdGNoVlBTUmVzcG9uc2USEAoIcGF0Y2hfaWQYASABKAkiPgoTRm9yY2VTdG9wVlBTUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIkYKFEZvcmNlU3RvcFZQU1Jlc3BvbnNlEi4KA3ZwcxgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlIkEKFlN0cmVhbVZQU1N0YXR1c1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSKjAQoPVlBTU3RhdHVzVXBkYXRlEg4KBnZwc19pZBgBIAEoCRIvCgZzdGF0dXMYAiABKA4yHy5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNTdGF0dXMSFAoHbWVzc2FnZRgDIAEoCUgAiAEBEi0KCXRpbWVzdGFtcBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCCgoIX21lc3NhZ2UiwQEKFEdldFZQU01ldHJpY3NSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSLgoKc3RhcnRfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKCGludGVydmFsGAUgASgJSACIAQFCCwoJX2ludGVydmFsIkkKFUdldFZQU01ldHJpY3NSZXNwb25zZRIwCgdtZXRyaWNzGAEgAygLMh8ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTTWV0cmljIkIKF1N0cmVhbVZQU01ldHJpY3NSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkitAIKCVZQU01ldHJpYxIOCgZ2cHNfaWQYASABKAkSLQoJdGltZXN0YW1wGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIZChFjcHVfdXNhZ2VfcGVyY2VudBgDIAEoARIZChFtZW1vcnlfdXNlZF9ieXRlcxgEIAEoAxIaChJtZW1vcnlfdG90YWxfYnl0ZXMYBSABKAMSFwoPZGlza191c2VkX2J5dGVzGAYgASgDEhgKEGRpc2tfdG90YWxfYnl0ZXMYByABKAMSGAoQbmV0d29ya19yeF9ieXRlcxgIIAEoAxIYChBuZXR3b3JrX3R4X2J5dGVzGAkgASgDEhYKDmRpc2tfcmVhZF9pb3BzGAogASgBEhcKD2Rpc2tfd3JpdGVfaW9wcxgLIAEoASJbChJHZXRWUFNVc2FnZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCRISCgVtb250aBgDIAEoCUgAiAEBQggKBl9tb250aCIwChVGaW5kVlBTQnlMZWFzZVJlcXVlc3QSCgoCaXAYASABKAkSCwoDbWFjGAIgASgJIm0KFkZpbmRWUFNCeUxlYXNlUmVzcG9uc2USDgoGdnBzX2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIVCg1tYXhfbWJpdF9kb3duGAMgASgFEhMKC21heF9tYml0X3VwGAQgASgFIswBChNHZXRWUFNVc2FnZVJlc3BvbnNlEg4KBnZwc19pZBgBIAEoCRINCgVtb250aBgCIAEoCRI2CgdjdXJyZW50GAMgASgLMiUub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTVXNhZ2VNZXRyaWNzEkAKEWVzdGltYXRlZF9tb250aGx5GAQgASgLMiUub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTVXNhZ2VNZXRyaWNzEhwKFGVzdGltYXRlZF9jb3N0X2NlbnRzGAUgASgDIqQDCg9WUFNVc2FnZU1ldHJpY3MSGAoQY3B1X2NvcmVfc2Vjb25kcxgBIAEoAxIbChNtZW1vcnlfYnl0ZV9zZWNvbmRzGAIgASgDEhoKEmJhbmR3aWR0aF9yeF9ieXRlcxgDIAEoAxIaChJiYW5kd2lkdGhfdHhfYnl0ZXMYBCABKAMSEgoKZGlza19ieXRlcxgFIAEoAxIWCg51cHRpbWVfc2Vjb25kcxgGIAEoAxIcChRlc3RpbWF0ZWRfY29zdF9jZW50cxgHIAEoAxIbCg5jcHVfY29zdF9jZW50cxgIIAEoA0gAiAEBEh4KEW1lbW9yeV9jb3N0X2NlbnRzGAkgASgDSAGIAQESIQoUYmFuZHdpZHRoX2Nvc3RfY2VudHMYCiABKANIAogBARIfChJzdG9yYWdlX2Nvc3RfY2VudHMYCyABKANIA4gBAUIRCg9fY3B1X2Nvc3RfY2VudHNCFAoSX21lbW9yeV9jb3N0X2NlbnRzQhcKFV9iYW5kd2lkdGhfY29zdF9jZW50c0IVChNfc3RvcmFnZV9jb3N0X2NlbnRzIj4KHExpc3RBdmFpbGFibGVWUFNTaXplc1JlcXVlc3QSEwoGcmVnaW9uGAEgASgJSACIAQFCCQoHX3JlZ2lvbiJQCh1MaXN0QXZhaWxhYmxlVlBTU2l6ZXNSZXNwb25zZRIvCgVzaXplcxgBIAMoCzIgLm9iaWVudGUuY2xvdWQuY29tbW9uLnYxLlZQU1NpemUiFwoVTGlzdFZQU1JlZ2lvbnNSZXF1ZXN0IkoKFkxpc3RWUFNSZWdpb25zUmVzcG9uc2USMAoHcmVnaW9ucxgBIAMoCzIfLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1JlZ2lvbiJBChZHZXRWUFNQcm94eUluZm9SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkiogEKF0dldFZQU1Byb3h5SW5mb1Jlc3BvbnNlEg4KBnZwc19pZBgBIAEoCRIXCg90ZXJtaW5hbF93c191cmwYAiABKAkSGQoRc3NoX3Byb3h5X2NvbW1hbmQYAyABKAkSFQoIc3NoX3BvcnQYBCABKAVIAIgBARIfChdjb25uZWN0aW9uX2luc3RydWN0aW9ucxgFIAEoCUILCglfc3NoX3BvcnQiQgoXR2V0VlBTQ29uc29sZVVSTFJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSJpChhHZXRWUFNDb25zb2xlVVJMUmVzcG9uc2USCwoDdXJsGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJEi4KCmV4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkIKF0dldFZQU1ZOQ1Nlc3Npb25SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkidQoYR2V0VlBTVk5DU2Vzc2lvblJlc3BvbnNlEgsKA3VybBgBIAEoCRIcChRpZGxlX3RpbWVvdXRfc2Vjb25kcxgCIAEoBRIuCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJXCglWUFNSZWdpb24SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIPCgdjb3VudHJ5GAMgASgJEgwKBGNpdHkYBCABKAkSEQoJYXZhaWxhYmxlGAUgASgIIrYICgtWUFNJbnN0YW5jZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKC2Rlc2NyaXB0aW9uGAMgASgJSACIAQESLwoGc3RhdHVzGAQgASgOMh8ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTU3RhdHVzEg4KBnJlZ2lvbhgFIAEoCRItCgVpbWFnZRgGIAEoDjIeLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0ltYWdlEhUKCGltYWdlX2lkGAcgASgJSAGIAQESDAoEc2l6ZRgIIAEoCRIRCgljcHVfY29yZXMYCSABKAUSFAoMbWVtb3J5X2J5dGVzGAogASgDEhIKCmRpc2tfYnl0ZXMYCyABKAMSFgoOaXB2NF9hZGRyZXNzZXMYDCADKAkSFgoOaXB2Nl9hZGRyZXNzZXMYDSADKAkSGAoLaW5zdGFuY2VfaWQYDiABKAlIAogBARIUCgdub2RlX2lkGA8gASgJSAOIAQESFwoKc3NoX2tleV9pZBgQIAEoCUgEiAEBEhoKDXJvb3RfcGFzc3dvcmQYESABKAlIBYgBARJBCghtZXRhZGF0YRgSIAMoCzIvLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlLk1ldGFkYXRhRW50cnkSLgoKY3JlYXRlZF9hdBgTIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgUIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOAoPbGFzdF9zdGFydGVkX2F0GBUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgGiAEBEjMKCmRlbGV0ZWRfYXQYFiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAeIAQESFwoPb3JnYW5pemF0aW9uX2lkGBcgASgJEhIKCmNyZWF0ZWRfYnkYGCABKAkSPQoPY3VycmVudF9tZXRyaWNzGBkgASgLMh8ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTTWV0cmljSAiIAQESOAoPbGFzdF9wYXRjaGVkX2F0GBogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgJiAEBGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIOCgxfZGVzY3JpcHRpb25CCwoJX2ltYWdlX2lkQg4KDF9pbnN0YW5jZV9pZEIKCghfbm9kZV9pZEINCgtfc3NoX2tleV9pZEIQCg5fcm9vdF9wYXNzd29yZEISChBfbGFzdF9zdGFydGVkX2F0Qg0KC19kZWxldGVkX2F0QhIKEF9jdXJyZW50X21ldHJpY3NCEgoQX2xhc3RfcGF0Y2hlZF9hdCJDChhMaXN0RmlyZXdhbGxSdWxlc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSJOChlMaXN0RmlyZXdhbGxSdWxlc1Jlc3BvbnNlEjEKBXJ1bGVzGAEgAygLMiIub2JpZW50ZS5jbG91ZC52cHMudjEuRmlyZXdhbGxSdWxlIlMKFkdldEZpcmV3YWxsUnVsZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCRIQCghydWxlX3BvcxgDIAEoBSJLChdHZXRGaXJld2FsbFJ1bGVSZXNwb25zZRIwCgRydWxlGAEgASgLMiIub2JpZW50ZS5jbG91ZC52cHMudjEuRmlyZXdhbGxSdWxlIpABChlDcmVhdGVGaXJld2FsbFJ1bGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSMAoEcnVsZRgDIAEoCzIiLm9iaWVudGUuY2xvdWQudnBzLnYxLkZpcmV3YWxsUnVsZRIQCgNwb3MYBCABKAVIAIgBAUIGCgRfcG9zIk4KGkNyZWF0ZUZpcmV3YWxsUnVsZVJlc3BvbnNlEjAKBHJ1bGUYASABKAsyIi5vYmllbnRlLmNsb3VkLnZwcy52MS5GaXJld2FsbFJ1bGUiiAEKGVVwZGF0ZUZpcmV3YWxsUnVsZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCRIQCghydWxlX3BvcxgDIAEoBRIwCgRydWxlGAQgASgLMiIub2JpZW50ZS5jbG91ZC52cHMudjEuRmlyZXdhbGxSdWxlIk4KGlVwZGF0ZUZpcmV3YWxsUnVsZVJlc3BvbnNlEjAKBHJ1bGUYASABKAsyIi5vYmllbnRlLmNsb3VkLnZwcy52MS5GaXJld2FsbFJ1bGUiVgoZRGVsZXRlRmlyZXdhbGxSdWxlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhAKCHJ1bGVfcG9zGAMgASgFIi0KGkRlbGV0ZUZpcmV3YWxsUnVsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiRAoZR2V0RmlyZXdhbGxPcHRpb25zUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIlQKGkdldEZpcmV3YWxsT3B0aW9uc1Jlc3BvbnNlEjYKB29wdGlvbnMYASABKAsyJS5vYmllbnRlLmNsb3VkLnZwcy52MS5GaXJld2FsbE9wdGlvbnMifwocVXBkYXRlRmlyZXdhbGxPcHRpb25zUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEjYKB29wdGlvbnMYAyABKAsyJS5vYmllbnRlLmNsb3VkLnZwcy52MS5GaXJld2FsbE9wdGlvbnMiVwodVXBkYXRlRmlyZXdhbGxPcHRpb25zUmVzcG9uc2USNgoHb3B0aW9ucxgBIAEoCzIlLm9iaWVudGUuY2xvdWQudnBzLnYxLkZpcmV3YWxsT3B0aW9ucyKEBAoMRmlyZXdhbGxSdWxlEgsKA3BvcxgBIAEoBRIOCgZlbmFibGUYAiABKAgSNAoGYWN0aW9uGAMgASgOMiQub2JpZW50ZS5jbG91ZC52cHMudjEuRmlyZXdhbGxBY3Rpb24SNQoEdHlwZRgEIAEoDjInLm9iaWVudGUuY2xvdWQudnBzLnYxLkZpcmV3YWxsRGlyZWN0aW9uEhQKB2NvbW1lbnQYBSABKAlIAIgBARITCgZzb3VyY2UYBiABKAlIAYgBARIRCgRkZXN0GAcgASgJSAKIAQESEgoFaWZhY2UYCCABKAlIA4gBARIXCgptYWNfc291cmNlGAkgASgJSASIAQESPQoIcHJvdG9jb2wYCiABKA4yJi5vYmllbnRlLmNsb3VkLnZwcy52MS5GaXJld2FsbFByb3RvY29sSAWIAQESEgoFZHBvcnQYCyABKAlIBogBARISCgVzcG9ydBgMIAEoCUgHiAEBEhYKCWljbXBfdHlwZRgNIAEoBUgIiAEBEhAKA2xvZxgOIAEoCEgJiAEBQgoKCF9jb21tZW50QgkKB19zb3VyY2VCBwoFX2Rlc3RCCAoGX2lmYWNlQg0KC19tYWNfc291cmNlQgsKCV9wcm90b2NvbEIICgZfZHBvcnRCCAoGX3Nwb3J0QgwKCl9pY21wX3R5cGVCBgoEX2xvZyKPAwoPRmlyZXdhbGxPcHRpb25zEg4KBmVuYWJsZRgBIAEoCBIWCglwb2xpY3lfaW4YAiABKAlIAIgBARIXCgpwb2xpY3lfb3V0GAMgASgJSAGIAQESGQoMbG9nX2xldmVsX2luGAQgASgISAKIAQESGgoNbG9nX2xldmVsX291dBgFIAEoCEgDiAEBEhMKBm5mX2xvZxgGIAEoCEgEiAEBEhEKBGRoY3AYByABKAhIBYgBARIQCgNuZHAYCCABKAhIBogBARIRCgRyYWR2GAkgASgISAeIAQESFQoIaXBmaWx0ZXIYCiABKAhICIgBARIbCg5pcGZpbHRlcl9ydWxlcxgLIAEoCEgJiAEBQgwKCl9wb2xpY3lfaW5CDQoLX3BvbGljeV9vdXRCDwoNX2xvZ19sZXZlbF9pbkIQCg5fbG9nX2xldmVsX291dEIJCgdfbmZfbG9nQgcKBV9kaGNwQgYKBF9uZHBCBwoFX3JhZHZCCwoJX2lwZmlsdGVyQhEKD19pcGZpbHRlcl9ydWxlcyLzAQoGU1NIS2V5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEgoKcHVibGljX2tleRgDIAEoCRITCgtmaW5nZXJwcmludBgEIAEoCRITCgZ2cHNfaWQYBSABKAlIAIgBARIzCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEjMKCnVwZGF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAKIAQFCCQoHX3Zwc19pZEINCgtfY3JlYXRlZF9hdEINCgtfdXBkYXRlZF9hdCJNChJMaXN0U1NIS2V5c1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhMKBnZwc19pZBgCIAEoCUgAiAEBQgkKB192cHNfaWQiQQoTTGlzdFNTSEtleXNSZXNwb25zZRIqCgRrZXlzGAEgAygLMhwub2JpZW50ZS5jbG91ZC52cHMudjEuU1NIS2V5Im0KEEFkZFNTSEtleVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEgoKcHVibGljX2tleRgDIAEoCRITCgZ2cHNfaWQYBCABKAlIAIgBAUIJCgdfdnBzX2lkIj4KEUFkZFNTSEtleVJlc3BvbnNlEikKA2tleRgBIAEoCzIcLm9iaWVudGUuY2xvdWQudnBzLnYxLlNTSEtleSJMChNVcGRhdGVTU0hLZXlSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZrZXlfaWQYAiABKAkSDAoEbmFtZRgDIAEoCSJBChRVcGRhdGVTU0hLZXlSZXNwb25zZRIpCgNrZXkYASABKAsyHC5vYmllbnRlLmNsb3VkLnZwcy52MS5TU0hLZXkiPgoTUmVtb3ZlU1NIS2V5UmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGa2V5X2lkGAIgASgJIkwKFFJlbW92ZVNTSEtleVJlc3BvbnNlEhgKEGFmZmVjdGVkX3Zwc19pZHMYASADKAkSGgoSYWZmZWN0ZWRfdnBzX25hbWVzGAIgAygJIkIKF1Jlc2V0VlBTUGFzc3dvcmRSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkiUgoYUmVzZXRWUFNQYXNzd29yZFJlc3BvbnNlEg4KBnZwc19pZBgBIAEoCRIVCg1yb290X3Bhc3N3b3JkGAIgASgJEg8KB21lc3NhZ2UYAyABKAkiQQoWUmVpbml0aWFsaXplVlBTUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIogBChdSZWluaXRpYWxpemVWUFNSZXNwb25zZRIuCgN2cHMYASABKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbnN0YW5jZRIaCg1yb290X3Bhc3N3b3JkGAIgASgJSACIAQESDwoHbWVzc2FnZRgDIAEoCUIQCg5fcm9vdF9wYXNzd29yZCI/ChRTdHJlYW1WUFNMb2dzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIm4KClZQU0xvZ0xpbmUSDAoEbGluZRgBIAEoCRIOCgZzdGRlcnIYAiABKAgSEwoLbGluZV9udW1iZXIYAyABKAUSLQoJdGltZXN0YW1wGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJuChhHZXRWUFNKb3VybmFsTG9nc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCRIRCgR1bml0GAMgASgJSACIAQESDQoFbGluZXMYBCABKAVCBwoFX3VuaXQiSwoZR2V0VlBTSm91cm5hbExvZ3NSZXNwb25zZRIuCgRsb2dzGAEgAygLMiAub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTTG9nTGluZSJbChZMaXN0VlBTU2VydmljZXNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSGAoQaW5jbHVkZV9pbmFjdGl2ZRgDIAEoCCJyChBWUFNTeXN0ZW1TZXJ2aWNlEgwKBG5hbWUYASABKAkSEgoKbG9hZF9zdGF0ZRgCIAEoCRIUCgxhY3RpdmVfc3RhdGUYAyABKAkSEQoJc3ViX3N0YXRlGAQgASgJEhMKC2Rlc2NyaXB0aW9uGAUgASgJIoMBChdMaXN0VlBTU2VydmljZXNSZXNwb25zZRI4CghzZXJ2aWNlcxgBIAMoCzImLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1N5c3RlbVNlcnZpY2USLgoKZmV0Y2hlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiKwoQSW1wb3J0VlBTUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiiwEKEUltcG9ydFZQU1Jlc3BvbnNlEhYKDmltcG9ydGVkX2NvdW50GAEgASgFEjcKDGltcG9ydGVkX3ZwcxgCIAMoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0luc3RhbmNlEhUKDXNraXBwZWRfY291bnQYAyABKAUSDgoGZXJyb3JzGAQgAygJIk4KE0dldFZQU0xlYXNlc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhMKBnZwc19pZBgCIAEoCUgAiAEBQgkKB192cHNfaWQinwEKCFZQU0xlYXNlEg4KBnZwc19pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEwoLbWFjX2FkZHJlc3MYAyABKAkSEgoKaXBfYWRkcmVzcxgEIAEoCRIuCgpleHBpcmVzX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglpc19wdWJsaWMYBiABKAgiRgoUR2V0VlBTTGVhc2VzUmVzcG9uc2USLgoGbGVhc2VzGAEgAygLMh4ub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTTGVhc2UiwQEKFFJlZ2lzdGVyTGVhc2VSZXF1ZXN0Eg4KBnZwc19pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEwoLbWFjX2FkZHJlc3MYAyABKAkSEgoKaXBfYWRkcmVzcxgEIAEoCRIuCgpleHBpcmVzX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglpc19wdWJsaWMYBiABKAgSFAoMZ2F0ZXdheV9ub2RlGAcgASgJIjkKFVJlZ2lzdGVyTGVhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiOgoTUmVsZWFzZUxlYXNlUmVxdWVzdBIOCgZ2cHNfaWQYASABKAkSEwoLbWFjX2FkZHJlc3MYAiABKAkiOAoUUmVsZWFzZUxlYXNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIlYKGEFzc2lnblZQU1B1YmxpY0lQUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhEKCXB1YmxpY19pcBgDIAEoCSI9ChlBc3NpZ25WUFNQdWJsaWNJUFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJYChpVbmFzc2lnblZQU1B1YmxpY0lQUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhEKCXB1YmxpY19pcBgDIAEoCSI/ChtVbmFzc2lnblZQU1B1YmxpY0lQUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIt8DCgtWUFNQdWJsaWNJUBIKCgJpZBgBIAEoCRISCgppcF9hZGRyZXNzGAIgASgJEhMKBnZwc19pZBgDIAEoCUgAiAEBEhwKD29yZ2FuaXphdGlvbl9pZBgEIAEoCUgBiAEBEhUKCHZwc19uYW1lGAUgASgJSAKIAQESHgoRb3JnYW5pemF0aW9uX25hbWUYBiABKAlIA4gBARIaChJtb250aGx5X2Nvc3RfY2VudHMYByABKAMSFAoHZ2F0ZXdheRgLIAEoCUgEiAEBEhQKB25ldG1hc2sYDCABKAlIBYgBARI0Cgthc3NpZ25lZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIBogBARIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIJCgdfdnBzX2lkQhIKEF9vcmdhbml6YXRpb25faWRCCwoJX3Zwc19uYW1lQhQKEl9vcmdhbml6YXRpb25fbmFtZUIKCghfZ2F0ZXdheUIKCghfbmV0bWFza0IOCgxfYXNzaWduZWRfYXQiwwEKF0xpc3RWUFNQdWJsaWNJUHNSZXF1ZXN0EhMKBnZwc19pZBgBIAEoCUgAiAEBEhwKD29yZ2FuaXphdGlvbl9pZBgCIAEoCUgBiAEBEh8KEmluY2x1ZGVfdW5hc3NpZ25lZBgDIAEoCEgCiAEBEgwKBHBhZ2UYBCABKAUSEAoIcGVyX3BhZ2UYBSABKAVCCQoHX3Zwc19pZEISChBfb3JnYW5pemF0aW9uX2lkQhUKE19pbmNsdWRlX3VuYXNzaWduZWQiXwoYTGlzdFZQU1B1YmxpY0lQc1Jlc3BvbnNlEi4KA2lwcxgBIAMoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1B1YmxpY0lQEhMKC3RvdGFsX2NvdW50GAIgASgDIo4BChhDcmVhdGVWUFNQdWJsaWNJUFJlcXVlc3QSEgoKaXBfYWRkcmVzcxgBIAEoCRIaChJtb250aGx5X2Nvc3RfY2VudHMYAiABKAMSFAoHZ2F0ZXdheRgDIAEoCUgAiAEBEhQKB25ldG1hc2sYBCABKAlIAYgBAUIKCghfZ2F0ZXdheUIKCghfbmV0bWFzayJKChlDcmVhdGVWUFNQdWJsaWNJUFJlc3BvbnNlEi0KAmlwGAEgASgLMiEub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTUHVibGljSVAiogEKGFVwZGF0ZVZQU1B1YmxpY0lQUmVxdWVzdBIKCgJpZBgBIAEoCRIfChJtb250aGx5X2Nvc3RfY2VudHMYAiABKANIAIgBARIUCgdnYXRld2F5GAMgASgJSAGIAQESFAoHbmV0bWFzaxgEIAEoCUgCiAEBQhUKE19tb250aGx5X2Nvc3RfY2VudHNCCgoIX2dhdGV3YXlCCgoIX25ldG1hc2siSgoZVXBkYXRlVlBTUHVibGljSVBSZXNwb25zZRItCgJpcBgBIAEoCzIhLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1B1YmxpY0lQIiYKGERlbGV0ZVZQU1B1YmxpY0lQUmVxdWVzdBIKCgJpZBgBIAEoCSIsChlEZWxldGVWUFNQdWJsaWNJUFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgi8AEKC1ZQU1RlbXBsYXRlEgoKAmlkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhgKC2Rlc2NyaXB0aW9uGAQgASgJSACIAQESFQoNc291cmNlX3Zwc19pZBgFIAEoCRIOCgZyZWdpb24YBiABKAkSLQoFaW1hZ2UYByABKA4yHi5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJbWFnZRIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIOCgxfZGVzY3JpcHRpb24iewoYQ3JlYXRlVlBTVGVtcGxhdGVSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIOCgZ2cHNfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRIYCgtkZXNjcmlwdGlvbhgEIAEoCUgAiAEBQg4KDF9kZXNjcmlwdGlvbiJQChlDcmVhdGVWUFNUZW1wbGF0ZVJlc3BvbnNlEjMKCHRlbXBsYXRlGAEgASgLMiEub2JpZW50ZS5jbG91ZC52cHMudjEuVlBTVGVtcGxhdGUiMgoXTGlzdFZQU1RlbXBsYXRlc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIlAKGExpc3RWUFNUZW1wbGF0ZXNSZXNwb25zZRI0Cgl0ZW1wbGF0ZXMYASADKAsyIS5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNUZW1wbGF0ZSJIChhEZWxldGVWUFNUZW1wbGF0ZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhMKC3RlbXBsYXRlX2lkGAIgASgJIiwKGURlbGV0ZVZQU1RlbXBsYXRlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJ0CgZWUFNJU08SFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEgoKc2l6ZV9ieXRlcxgDIAEoAxIvCgt1cGxvYWRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiLQoSTGlzdE9yZ0lTT3NSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSJqChNMaXN0T3JnSVNPc1Jlc3BvbnNlEioKBGlzb3MYASADKAsyHC5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNJU08SEgoKdXNlZF9ieXRlcxgCIAEoAxITCgtxdW90YV9ieXRlcxgDIAEoAyI9ChBEZWxldGVJU09SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRIQCghpc29fbmFtZRgCIAEoCSIkChFEZWxldGVJU09SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIkwKD01vdW50SVNPUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJEhAKCGlzb19uYW1lGAMgASgJIiMKEE1vdW50SVNPUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCI8ChFVbm1vdW50SVNPUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDgoGdnBzX2lkGAIgASgJIiUKElVubW91bnRJU09SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIKtIBCglWUFNTdGF0dXMSGgoWVlBTX1NUQVRVU19VTlNQRUNJRklFRBAAEgwKCENSRUFUSU5HEAESDAoIU1RBUlRJTkcQAhILCgdSVU5OSU5HEAMSDAoIU1RPUFBJTkcQBBILCgdTVE9QUEVEEAUSDQoJUkVCT09USU5HEAYSCgoGRkFJTEVEEAcSDAoIREVMRVRJTkcQCBILCgdERUxFVEVEEAkSDQoJU1VTUEVOREVEEAoSEAoMVU5SRVNQT05TSVZFEAsSDgoKVEVSTUlOQVRFRBAMKpgBCghWUFNJbWFnZRIZChVWUFNfSU1BR0VfVU5TUEVDSUZJRUQQABIQCgxVQlVOVFVfMjJfMDQQARIQCgxVQlVOVFVfMjRfMDQQAhINCglERUJJQU5fMTIQAxINCglERUJJQU5fMTMQBBIRCg1ST0NLWV9MSU5VWF85EAUSEAoMQUxNQV9MSU5VWF85EAYSCgoGQ1VTVE9NEGMqUwoORmlyZXdhbGxBY3Rpb24SHwobRklSRVdBTExfQUNUSU9OX1VOU1BFQ0lGSUVEEAASCgoGQUNDRVBUEAESCgoGUkVKRUNUEAISCAoERFJPUBADKkgKEUZpcmV3YWxsRGlyZWN0aW9uEiIKHkZJUkVXQUxMX0RJUkVDVElPTl9VTlNQRUNJRklFRBAAEgYKAklOEAESBwoDT1VUEAIqZgoQRmlyZXdhbGxQcm90b2NvbBIhCh1GSVJFV0FMTF9QUk9UT0NPTF9VTlNQRUNJRklFRBAAEgcKA1RDUBABEgcKA1VEUBACEggKBElDTVAQAxIKCgZJQ01QVjYQBBIHCgNBTEwQBTLZKAoKVlBTU2VydmljZRJWCgdMaXN0VlBTEiQub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFZQU1JlcXVlc3QaJS5vYmllbnRlLmNsb3VkLnZwcy52MS5MaXN0VlBTUmVzcG9uc2USXAoJQ3JlYXRlVlBTEiYub2JpZW50ZS5jbG91ZC52cHMudjEuQ3JlYXRlVlBTUmVxdWVzdBonLm9iaWVudGUuY2xvdWQudnBzLnYxLkNyZWF0ZVZQU1Jlc3BvbnNlElMKBkdldFZQUxIjLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU1JlcXVlc3QaJC5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRWUFNSZXNwb25zZRJcCglVcGRhdGVWUFMSJi5vYmllbnRlLmNsb3VkLnZwcy52MS5VcGRhdGVWUFNSZXF1ZXN0Gicub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlVlBTUmVzcG9uc2USXAoJRGVsZXRlVlBTEiYub2JpZW50ZS5jbG91ZC52cHMudjEuRGVsZXRlVlBTUmVxdWVzdBonLm9iaWVudGUuY2xvdWQudnBzLnYxLkRlbGV0ZVZQU1Jlc3BvbnNlElkKCFN0YXJ0VlBTEiUub2JpZW50ZS5jbG91ZC52cHMudjEuU3RhcnRWUFNSZXF1ZXN0GiYub2JpZW50ZS5jbG91ZC52cHMudjEuU3RhcnRWUFNSZXNwb25zZRJWCgdTdG9wVlBTEiQub2JpZW50ZS5jbG91ZC52cHMudjEuU3RvcFZQU1JlcXVlc3QaJS5vYmllbnRlLmNsb3VkLnZwcy52MS5TdG9wVlBTUmVzcG9uc2USXAoJUmVib290VlBTEiYub2JpZW50ZS5jbG91ZC52cHMudjEuUmVib290VlBTUmVxdWVzdBonLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlYm9vdFZQU1Jlc3BvbnNlEmUKDEZvcmNlU3RvcFZQUxIpLm9iaWVudGUuY2xvdWQudnBzLnYxLkZvcmNlU3RvcFZQU1JlcXVlc3QaKi5vYmllbnRlLmNsb3VkLnZwcy52MS5Gb3JjZVN0b3BWUFNSZXNwb25zZRJZCghQYXRjaFZQUxIlLm9iaWVudGUuY2xvdWQudnBzLnYxLlBhdGNoVlBTUmVxdWVzdBomLm9iaWVudGUuY2xvdWQudnBzLnYxLlBhdGNoVlBTUmVzcG9uc2USaAoPU3RyZWFtVlBTU3RhdHVzEiwub2JpZW50ZS5jbG91ZC52cHMudjEuU3RyZWFtVlBTU3RhdHVzUmVxdWVzdBolLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU1N0YXR1c1VwZGF0ZTABEmgKDUdldFZQU01ldHJpY3MSKi5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRWUFNNZXRyaWNzUmVxdWVzdBorLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU01ldHJpY3NSZXNwb25zZRJkChBTdHJlYW1WUFNNZXRyaWNzEi0ub2JpZW50ZS5jbG91ZC52cHMudjEuU3RyZWFtVlBTTWV0cmljc1JlcXVlc3QaHy5vYmllbnRlLmNsb3VkLnZwcy52MS5WUFNNZXRyaWMwARJiCgtHZXRWUFNVc2FnZRIoLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU1VzYWdlUmVxdWVzdBopLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU1VzYWdlUmVzcG9uc2USdwoMTGlzdFZQU1NpemVzEjIub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdEF2YWlsYWJsZVZQU1NpemVzUmVxdWVzdBozLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RBdmFpbGFibGVWUFNTaXplc1Jlc3BvbnNlEmsKDkxpc3RWUFNSZWdpb25zEisub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFZQU1JlZ2lvbnNSZXF1ZXN0Giwub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFZQU1JlZ2lvbnNSZXNwb25zZRJuCg9HZXRWUFNQcm94eUluZm8SLC5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRWUFNQcm94eUluZm9SZXF1ZXN0Gi0ub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0VlBTUHJveHlJbmZvUmVzcG9uc2UScQoQR2V0VlBTQ29uc29sZVVSTBItLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU0NvbnNvbGVVUkxSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0VlBTQ29uc29sZVVSTFJlc3BvbnNlEnEKEEdldFZQU1ZOQ1Nlc3Npb24SLS5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRWUFNWTkNTZXNzaW9uUmVxdWVzdBouLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU1ZOQ1Nlc3Npb25SZXNwb25zZRJ0ChFMaXN0RmlyZXdhbGxSdWxlcxIuLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RGaXJld2FsbFJ1bGVzUmVxdWVzdBovLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RGaXJld2FsbFJ1bGVzUmVzcG9uc2USbgoPR2V0RmlyZXdhbGxSdWxlEiwub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0RmlyZXdhbGxSdWxlUmVxdWVzdBotLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldEZpcmV3YWxsUnVsZVJlc3BvbnNlEncKEkNyZWF0ZUZpcmV3YWxsUnVsZRIvLm9iaWVudGUuY2xvdWQudnBzLnYxLkNyZWF0ZUZpcmV3YWxsUnVsZVJlcXVlc3QaMC5vYmllbnRlLmNsb3VkLnZwcy52MS5DcmVhdGVGaXJld2FsbFJ1bGVSZXNwb25zZRJ3ChJVcGRhdGVGaXJld2FsbFJ1bGUSLy5vYmllbnRlLmNsb3VkLnZwcy52MS5VcGRhdGVGaXJld2FsbFJ1bGVSZXF1ZXN0GjAub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlRmlyZXdhbGxSdWxlUmVzcG9uc2USdwoSRGVsZXRlRmlyZXdhbGxSdWxlEi8ub2JpZW50ZS5jbG91ZC52cHMudjEuRGVsZXRlRmlyZXdhbGxSdWxlUmVxdWVzdBowLm9iaWVudGUuY2xvdWQudnBzLnYxLkRlbGV0ZUZpcmV3YWxsUnVsZVJlc3BvbnNlEncKEkdldEZpcmV3YWxsT3B0aW9ucxIvLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldEZpcmV3YWxsT3B0aW9uc1JlcXVlc3QaMC5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRGaXJld2FsbE9wdGlvbnNSZXNwb25zZRKAAQoVVXBkYXRlRmlyZXdhbGxPcHRpb25zEjIub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlRmlyZXdhbGxPcHRpb25zUmVxdWVzdBozLm9iaWVudGUuY2xvdWQudnBzLnYxLlVwZGF0ZUZpcmV3YWxsT3B0aW9uc1Jlc3BvbnNlEmIKC0xpc3RTU0hLZXlzEigub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFNTSEtleXNSZXF1ZXN0Gikub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFNTSEtleXNSZXNwb25zZRJcCglBZGRTU0hLZXkSJi5vYmllbnRlLmNsb3VkLnZwcy52MS5BZGRTU0hLZXlSZXF1ZXN0Gicub2JpZW50ZS5jbG91ZC52cHMudjEuQWRkU1NIS2V5UmVzcG9uc2USZQoMVXBkYXRlU1NIS2V5Eikub2JpZW50ZS5jbG91ZC52cHMudjEuVXBkYXRlU1NIS2V5UmVxdWVzdBoqLm9iaWVudGUuY2xvdWQudnBzLnYxLlVwZGF0ZVNTSEtleVJlc3BvbnNlEmUKDFJlbW92ZVNTSEtleRIpLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlbW92ZVNTSEtleVJlcXVlc3QaKi5vYmllbnRlLmNsb3VkLnZwcy52MS5SZW1vdmVTU0hLZXlSZXNwb25zZRJxChBSZXNldFZQU1Bhc3N3b3JkEi0ub2JpZW50ZS5jbG91ZC52cHMudjEuUmVzZXRWUFNQYXNzd29yZFJlcXVlc3QaLi5vYmllbnRlLmNsb3VkLnZwcy52MS5SZXNldFZQU1Bhc3N3b3JkUmVzcG9uc2USbgoPUmVpbml0aWFsaXplVlBTEiwub2JpZW50ZS5jbG91ZC52cHMudjEuUmVpbml0aWFsaXplVlBTUmVxdWVzdBotLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlaW5pdGlhbGl6ZVZQU1Jlc3BvbnNlEl8KDVN0cmVhbVZQU0xvZ3MSKi5vYmllbnRlLmNsb3VkLnZwcy52MS5TdHJlYW1WUFNMb2dzUmVxdWVzdBogLm9iaWVudGUuY2xvdWQudnBzLnYxLlZQU0xvZ0xpbmUwARJ0ChFHZXRWUFNKb3VybmFsTG9ncxIuLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU0pvdXJuYWxMb2dzUmVxdWVzdBovLm9iaWVudGUuY2xvdWQudnBzLnYxLkdldFZQU0pvdXJuYWxMb2dzUmVzcG9uc2USbgoPTGlzdFZQU1NlcnZpY2VzEiwub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFZQU1NlcnZpY2VzUmVxdWVzdBotLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RWUFNTZXJ2aWNlc1Jlc3BvbnNlElwKCUltcG9ydFZQUxImLm9iaWVudGUuY2xvdWQudnBzLnYxLkltcG9ydFZQU1JlcXVlc3QaJy5vYmllbnRlLmNsb3VkLnZwcy52MS5JbXBvcnRWUFNSZXNwb25zZRJlCgxHZXRWUFNMZWFzZXMSKS5vYmllbnRlLmNsb3VkLnZwcy52MS5HZXRWUFNMZWFzZXNSZXF1ZXN0Gioub2JpZW50ZS5jbG91ZC52cHMudjEuR2V0VlBTTGVhc2VzUmVzcG9uc2USawoORmluZFZQU0J5TGVhc2USKy5vYmllbnRlLmNsb3VkLnZwcy52MS5GaW5kVlBTQnlMZWFzZVJlcXVlc3QaLC5vYmllbnRlLmNsb3VkLnZwcy52MS5GaW5kVlBTQnlMZWFzZVJlc3BvbnNlEmgKDVJlZ2lzdGVyTGVhc2USKi5vYmllbnRlLmNsb3VkLnZwcy52MS5SZWdpc3RlckxlYXNlUmVxdWVzdBorLm9iaWVudGUuY2xvdWQudnBzLnYxLlJlZ2lzdGVyTGVhc2VSZXNwb25zZRJlCgxSZWxlYXNlTGVhc2USKS5vYmllbnRlLmNsb3VkLnZwcy52MS5SZWxlYXNlTGVhc2VSZXF1ZXN0Gioub2JpZW50ZS5jbG91ZC52cHMudjEuUmVsZWFzZUxlYXNlUmVzcG9uc2USdAoRQXNzaWduVlBTUHVibGljSVASLi5vYmllbnRlLmNsb3VkLnZwcy52MS5Bc3NpZ25WUFNQdWJsaWNJUFJlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnZwcy52MS5Bc3NpZ25WUFNQdWJsaWNJUFJlc3BvbnNlEnoKE1VuYXNzaWduVlBTUHVibGljSVASMC5vYmllbnRlLmNsb3VkLnZwcy52MS5VbmFzc2lnblZQU1B1YmxpY0lQUmVxdWVzdBoxLm9iaWVudGUuY2xvdWQudnBzLnYxLlVuYXNzaWduVlBTUHVibGljSVBSZXNwb25zZRJ0ChFDcmVhdGVWUFNUZW1wbGF0ZRIuLm9iaWVudGUuY2xvdWQudnBzLnYxLkNyZWF0ZVZQU1RlbXBsYXRlUmVxdWVzdBovLm9iaWVudGUuY2xvdWQudnBzLnYxLkNyZWF0ZVZQU1RlbXBsYXRlUmVzcG9uc2UScQoQTGlzdFZQU1RlbXBsYXRlcxItLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RWUFNUZW1wbGF0ZXNSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC52cHMudjEuTGlzdFZQU1RlbXBsYXRlc1Jlc3BvbnNlEnQKEURlbGV0ZVZQU1RlbXBsYXRlEi4ub2JpZW50ZS5jbG91ZC52cHMudjEuRGVsZXRlVlBTVGVtcGxhdGVSZXF1ZXN0Gi8ub2JpZW50ZS5jbG91ZC52cHMudjEuRGVsZXRlVlBTVGVtcGxhdGVSZXNwb25zZRJiCgtMaXN0T3JnSVNPcxIoLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RPcmdJU09zUmVxdWVzdBopLm9iaWVudGUuY2xvdWQudnBzLnYxLkxpc3RPcmdJU09zUmVzcG9uc2USXAoJRGVsZXRlSVNPEiYub2JpZW50ZS5jbG91ZC52cHMudjEuRGVsZXRlSVNPUmVxdWVzdBonLm9iaWVudGUuY2xvdWQudnBzLnYxLkRlbGV0ZUlTT1Jlc3BvbnNlElkKCE1vdW50SVNPEiUub2JpZW50ZS5jbG91ZC52cHMudjEuTW91bnRJU09SZXF1ZXN0GiYub2JpZW50ZS5jbG91ZC52cHMudjEuTW91bnRJU09SZXNwb25zZRJfCgpVbm1vdW50SVNPEicub2JpZW50ZS5jbG91ZC52cHMudjEuVW5tb3VudElTT1JlcXVlc3QaKC5vYmllbnRlLmNsb3VkLnZwcy52MS5Vbm1vdW50SVNPUmVzcG9uc2VCR1pFZ2l0aHViLmNvbS9vYmllbnRlL2Nsb3VkL2FwcHMvc2hhcmVkL3Byb3RvL29iaWVudGUvY2xvdWQvdnBzL3YxO3Zwc3YxYgZwcm90bzM", [file_google_protobuf_timestamp, file_obiente_cloud_organizations_v1_organization_service, file_obiente_cloud_common_v1_common]);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ListVPSRequest
@@ -149,6 +149,51 @@ export type CreateVPSRequest = Message<"obiente.cloud.vps.v1.CreateVPSRequest">
    * @generated from field: optional string root_password = 11;
    */
   rootPassword?: string;
+
+  /**
+   * Cloud-init template to render and merge into the generated userData (see VPSConfigService.CreateCloudInitTemplate)
+   *
+   * @generated from field: optional string cloud_init_template_id = 12;
+   */
+  cloudInitTemplateId?: string;
+
+  /**
+   * VPS template to clone the VPS from instead of the OS image (see CreateVPSTemplate)
+   *
+   * @generated from field: optional string template_id = 13;
+   */
+  templateId?: string;
+
+  /**
+   * Static IP configuration (replaces DHCP). The IP must be inside a subnet allocated to the
+   * organization (see SuperadminService.AllocateStaticIP).
+   *
+   * e.g. "10.20.0.10"
+   *
+   * @generated from field: optional string static_ip = 14;
+   */
+  staticIp?: string;
+
+  /**
+   * Defaults to the gateway of the allocated subnet
+   *
+   * @generated from field: optional string gateway = 15;
+   */
+  gateway?: string;
+
+  /**
+   * Defaults to the DNS servers of the allocated subnet
+   *
+   * @generated from field: repeated string dns_servers = 16;
+   */
+  dnsServers: string[];
+
+  /**
+   * Host GPU to pass through to the VPS. The VPS uses the q35 machine type.
+   *
+   * @generated from field: optional obiente.cloud.vps.v1.GPUConfig gpu = 17;
+   */
+  gpu?: GPUConfig;
 };
 
 /**
@@ -158,6 +203,34 @@ export type CreateVPSRequest = Message<"obiente.cloud.vps.v1.CreateVPSRequest">
 export const CreateVPSRequestSchema: GenMessage<CreateVPSRequest> = /*@__PURE__*/
   messageDesc(file_obiente_cloud_vps_v1_vps_service, 2);
 
+/**
+ * GPUConfig selects a host GPU to pass through to a VPS
+ *
+ * @generated from message obiente.cloud.vps.v1.GPUConfig
+ */
+export type GPUConfig = Message<"obiente.cloud.vps.v1.GPUConfig"> & {
+  /**
+   * Host PCI address of the GPU (e.g. "0000:01:00.0")
+   *
+   * @generated from field: string pci_id = 1;
+   */
+  pciId: string;
+
+  /**
+   * "vfio-pci" (default, secondary device) or "passthrough" (primary display)
+   *
+   * @generated from field: optional string driver = 2;
+   */
+  driver?: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.GPUConfig.
+ * Use `create(GPUConfigSchema)` to create a new message.
+ */
+export const GPUConfigSchema: GenMessage<GPUConfig> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 3);
+
 /**
  * CloudInitConfig contains cloud-init configuration options
  *
@@ -255,7 +328,7 @@ export type CloudInitConfig = Message<"obiente.cloud.vps.v1.CloudInitConfig"> &
  * Use `create(CloudInitConfigSchema)` to create a new message.
  */
 export const CloudInitConfigSchema: GenMessage<CloudInitConfig> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 3);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 4);
 
 /**
  * CloudInitUser represents a user to be created via cloud-init
@@ -332,7 +405,7 @@ export type CloudInitUser = Message<"obiente.cloud.vps.v1.CloudInitUser"> & {
  * Use `create(CloudInitUserSchema)` to create a new message.
  */
 export const CloudInitUserSchema: GenMessage<CloudInitUser> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 4);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 5);
 
 /**
  * CloudInitWriteFile represents a file to be written via cloud-init
@@ -388,7 +461,7 @@ export type CloudInitWriteFile = Message<"obiente.cloud.vps.v1.CloudInitWriteFil
  * Use `create(CloudInitWriteFileSchema)` to create a new message.
  */
 export const CloudInitWriteFileSchema: GenMessage<CloudInitWriteFile> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 5);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 6);
 
 /**
  * @generated from message obiente.cloud.vps.v1.CreateVPSResponse
@@ -405,7 +478,7 @@ export type CreateVPSResponse = Message<"obiente.cloud.vps.v1.CreateVPSResponse"
  * Use `create(CreateVPSResponseSchema)` to create a new message.
  */
 export const CreateVPSResponseSchema: GenMessage<CreateVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 6);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 7);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetVPSRequest
@@ -427,7 +500,7 @@ export type GetVPSRequest = Message<"obiente.cloud.vps.v1.GetVPSRequest"> & {
  * Use `create(GetVPSRequestSchema)` to create a new message.
  */
 export const GetVPSRequestSchema: GenMessage<GetVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 7);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 8);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetVPSResponse
@@ -444,7 +517,7 @@ export type GetVPSResponse = Message<"obiente.cloud.vps.v1.GetVPSResponse"> & {
  * Use `create(GetVPSResponseSchema)` to create a new message.
  */
 export const GetVPSResponseSchema: GenMessage<GetVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 8);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 9);
 
 /**
  * @generated from message obiente.cloud.vps.v1.UpdateVPSRequest
@@ -483,7 +556,7 @@ export type UpdateVPSRequest = Message<"obiente.cloud.vps.v1.UpdateVPSRequest">
  * Use `create(UpdateVPSRequestSchema)` to create a new message.
  */
 export const UpdateVPSRequestSchema: GenMessage<UpdateVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 9);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 10);
 
 /**
  * @generated from message obiente.cloud.vps.v1.UpdateVPSResponse
@@ -500,7 +573,7 @@ export type UpdateVPSResponse = Message<"obiente.cloud.vps.v1.UpdateVPSResponse"
  * Use `create(UpdateVPSResponseSchema)` to create a new message.
  */
 export const UpdateVPSResponseSchema: GenMessage<UpdateVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 10);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 11);
 
 /**
  * @generated from message obiente.cloud.vps.v1.DeleteVPSRequest
@@ -529,7 +602,7 @@ export type DeleteVPSRequest = Message<"obiente.cloud.vps.v1.DeleteVPSRequest">
  * Use `create(DeleteVPSRequestSchema)` to create a new message.
  */
 export const DeleteVPSRequestSchema: GenMessage<DeleteVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 11);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 12);
 
 /**
  * @generated from message obiente.cloud.vps.v1.DeleteVPSResponse
@@ -546,7 +619,7 @@ export type DeleteVPSResponse = Message<"obiente.cloud.vps.v1.DeleteVPSResponse"
  * Use `create(DeleteVPSResponseSchema)` to create a new message.
  */
 export const DeleteVPSResponseSchema: GenMessage<DeleteVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 12);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 13);
 
 /**
  * @generated from message obiente.cloud.vps.v1.StartVPSRequest
@@ -568,7 +641,7 @@ export type StartVPSRequest = Message<"obiente.cloud.vps.v1.StartVPSRequest"> &
  * Use `create(StartVPSRequestSchema)` to create a new message.
  */
 export const StartVPSRequestSchema: GenMessage<StartVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 13);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 14);
 
 /**
  * @generated from message obiente.cloud.vps.v1.StartVPSResponse
@@ -585,7 +658,7 @@ export type StartVPSResponse = Message<"obiente.cloud.vps.v1.StartVPSResponse">
  * Use `create(StartVPSResponseSchema)` to create a new message.
  */
 export const StartVPSResponseSchema: GenMessage<StartVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 14);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 15);
 
 /**
  * @generated from message obiente.cloud.vps.v1.StopVPSRequest
@@ -607,7 +680,7 @@ export type StopVPSRequest = Message<"obiente.cloud.vps.v1.StopVPSRequest"> & {
  * Use `create(StopVPSRequestSchema)` to create a new message.
  */
 export const StopVPSRequestSchema: GenMessage<StopVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 15);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 16);
 
 /**
  * @generated from message obiente.cloud.vps.v1.StopVPSResponse
@@ -624,7 +697,7 @@ export type StopVPSResponse = Message<"obiente.cloud.vps.v1.StopVPSResponse"> &
  * Use `create(StopVPSResponseSchema)` to create a new message.
  */
 export const StopVPSResponseSchema: GenMessage<StopVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 16);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 17);
 
 /**
  * @generated from message obiente.cloud.vps.v1.RebootVPSRequest
@@ -646,7 +719,7 @@ export type RebootVPSRequest = Message<"obiente.cloud.vps.v1.RebootVPSRequest">
  * Use `create(RebootVPSRequestSchema)` to create a new message.
  */
 export const RebootVPSRequestSchema: GenMessage<RebootVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 17);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 18);
 
 /**
  * @generated from message obiente.cloud.vps.v1.RebootVPSResponse
@@ -663,7 +736,94 @@ export type RebootVPSResponse = Message<"obiente.cloud.vps.v1.RebootVPSResponse"
  * Use `create(RebootVPSResponseSchema)` to create a new message.
  */
 export const RebootVPSResponseSchema: GenMessage<RebootVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 18);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 19);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.PatchVPSRequest
+ */
+export type PatchVPSRequest = Message<"obiente.cloud.vps.v1.PatchVPSRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string vps_id = 2;
+   */
+  vpsId: string;
+
+  /**
+   * Reboot the VPS once the packages are upgraded (e.g. to load a new kernel)
+   *
+   * @generated from field: bool reboot_after = 3;
+   */
+  rebootAfter: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.PatchVPSRequest.
+ * Use `create(PatchVPSRequestSchema)` to create a new message.
+ */
+export const PatchVPSRequestSchema: GenMessage<PatchVPSRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 20);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.PatchVPSResponse
+ */
+export type PatchVPSResponse = Message<"obiente.cloud.vps.v1.PatchVPSResponse"> & {
+  /**
+   * ID of the patch run in the VPS patch history
+   *
+   * @generated from field: string patch_id = 1;
+   */
+  patchId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.PatchVPSResponse.
+ * Use `create(PatchVPSResponseSchema)` to create a new message.
+ */
+export const PatchVPSResponseSchema: GenMessage<PatchVPSResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 21);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.ForceStopVPSRequest
+ */
+export type ForceStopVPSRequest = Message<"obiente.cloud.vps.v1.ForceStopVPSRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string vps_id = 2;
+   */
+  vpsId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.ForceStopVPSRequest.
+ * Use `create(ForceStopVPSRequestSchema)` to create a new message.
+ */
+export const ForceStopVPSRequestSchema: GenMessage<ForceStopVPSRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 22);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.ForceStopVPSResponse
+ */
+export type ForceStopVPSResponse = Message<"obiente.cloud.vps.v1.ForceStopVPSResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.vps.v1.VPSInstance vps = 1;
+   */
+  vps?: VPSInstance;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.ForceStopVPSResponse.
+ * Use `create(ForceStopVPSResponseSchema)` to create a new message.
+ */
+export const ForceStopVPSResponseSchema: GenMessage<ForceStopVPSResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 23);
 
 /**
  * @generated from message obiente.cloud.vps.v1.StreamVPSStatusRequest
@@ -685,7 +845,7 @@ export type StreamVPSStatusRequest = Message<"obiente.cloud.vps.v1.StreamVPSStat
  * Use `create(StreamVPSStatusRequestSchema)` to create a new message.
  */
 export const StreamVPSStatusRequestSchema: GenMessage<StreamVPSStatusRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 19);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 24);
 
 /**
  * @generated from message obiente.cloud.vps.v1.VPSStatusUpdate
@@ -717,7 +877,7 @@ export type VPSStatusUpdate = Message<"obiente.cloud.vps.v1.VPSStatusUpdate"> &
  * Use `create(VPSStatusUpdateSchema)` to create a new message.
  */
 export const VPSStatusUpdateSchema: GenMessage<VPSStatusUpdate> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 20);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 25);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetVPSMetricsRequest
@@ -756,7 +916,7 @@ export type GetVPSMetricsRequest = Message<"obiente.cloud.vps.v1.GetVPSMetricsRe
  * Use `create(GetVPSMetricsRequestSchema)` to create a new message.
  */
 export const GetVPSMetricsRequestSchema: GenMessage<GetVPSMetricsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 21);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 26);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetVPSMetricsResponse
@@ -773,7 +933,7 @@ export type GetVPSMetricsResponse = Message<"obiente.cloud.vps.v1.GetVPSMetricsR
  * Use `create(GetVPSMetricsResponseSchema)` to create a new message.
  */
 export const GetVPSMetricsResponseSchema: GenMessage<GetVPSMetricsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 22);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 27);
 
 /**
  * @generated from message obiente.cloud.vps.v1.StreamVPSMetricsRequest
@@ -795,7 +955,7 @@ export type StreamVPSMetricsRequest = Message<"obiente.cloud.vps.v1.StreamVPSMet
  * Use `create(StreamVPSMetricsRequestSchema)` to create a new message.
  */
 export const StreamVPSMetricsRequestSchema: GenMessage<StreamVPSMetricsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 23);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 28);
 
 /**
  * @generated from message obiente.cloud.vps.v1.VPSMetric
@@ -880,7 +1040,7 @@ export type VPSMetric = Message<"obiente.cloud.vps.v1.VPSMetric"> & {
  * Use `create(VPSMetricSchema)` to create a new message.
  */
 export const VPSMetricSchema: GenMessage<VPSMetric> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 24);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 29);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetVPSUsageRequest
@@ -909,7 +1069,7 @@ export type GetVPSUsageRequest = Message<"obiente.cloud.vps.v1.GetVPSUsageReques
  * Use `create(GetVPSUsageRequestSchema)` to create a new message.
  */
 export const GetVPSUsageRequestSchema: GenMessage<GetVPSUsageRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 25);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 30);
 
 /**
  * @generated from message obiente.cloud.vps.v1.FindVPSByLeaseRequest
@@ -935,7 +1095,7 @@ export type FindVPSByLeaseRequest = Message<"obiente.cloud.vps.v1.FindVPSByLease
  * Use `create(FindVPSByLeaseRequestSchema)` to create a new message.
  */
 export const FindVPSByLeaseRequestSchema: GenMessage<FindVPSByLeaseRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 26);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 31);
 
 /**
  * @generated from message obiente.cloud.vps.v1.FindVPSByLeaseResponse
@@ -954,6 +1114,18 @@ export type FindVPSByLeaseResponse = Message<"obiente.cloud.vps.v1.FindVPSByLeas
    * @generated from field: string organization_id = 2;
    */
   organizationId: string;
+
+  /**
+   * Bandwidth limits the gateway enforces for this lease (Mbit/s, 0 = unlimited)
+   *
+   * @generated from field: int32 max_mbit_down = 3;
+   */
+  maxMbitDown: number;
+
+  /**
+   * @generated from field: int32 max_mbit_up = 4;
+   */
+  maxMbitUp: number;
 };
 
 /**
@@ -961,7 +1133,7 @@ export type FindVPSByLeaseResponse = Message<"obiente.cloud.vps.v1.FindVPSByLeas
  * Use `create(FindVPSByLeaseResponseSchema)` to create a new message.
  */
 export const FindVPSByLeaseResponseSchema: GenMessage<FindVPSByLeaseResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 27);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 32);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetVPSUsageResponse
@@ -1002,7 +1174,7 @@ export type GetVPSUsageResponse = Message<"obiente.cloud.vps.v1.GetVPSUsageRespo
  * Use `create(GetVPSUsageResponseSchema)` to create a new message.
  */
 export const GetVPSUsageResponseSchema: GenMessage<GetVPSUsageResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 28);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 33);
 
 /**
  * @generated from message obiente.cloud.vps.v1.VPSUsageMetrics
@@ -1075,7 +1247,7 @@ export type VPSUsageMetrics = Message<"obiente.cloud.vps.v1.VPSUsageMetrics"> &
  * Use `create(VPSUsageMetricsSchema)` to create a new message.
  */
 export const VPSUsageMetricsSchema: GenMessage<VPSUsageMetrics> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 29);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 34);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ListAvailableVPSSizesRequest
@@ -1094,7 +1266,7 @@ export type ListAvailableVPSSizesRequest = Message<"obiente.cloud.vps.v1.ListAva
  * Use `create(ListAvailableVPSSizesRequestSchema)` to create a new message.
  */
 export const ListAvailableVPSSizesRequestSchema: GenMessage<ListAvailableVPSSizesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 30);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 35);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ListAvailableVPSSizesResponse
@@ -1111,7 +1283,7 @@ export type ListAvailableVPSSizesResponse = Message<"obiente.cloud.vps.v1.ListAv
  * Use `create(ListAvailableVPSSizesResponseSchema)` to create a new message.
  */
 export const ListAvailableVPSSizesResponseSchema: GenMessage<ListAvailableVPSSizesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 31);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 36);
 
 /**
  * Empty - returns all available Obiente Cloud regions
@@ -1126,7 +1298,7 @@ export type ListVPSRegionsRequest = Message<"obiente.cloud.vps.v1.ListVPSRegions
  * Use `create(ListVPSRegionsRequestSchema)` to create a new message.
  */
 export const ListVPSRegionsRequestSchema: GenMessage<ListVPSRegionsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 32);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 37);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ListVPSRegionsResponse
@@ -1143,7 +1315,7 @@ export type ListVPSRegionsResponse = Message<"obiente.cloud.vps.v1.ListVPSRegion
  * Use `create(ListVPSRegionsResponseSchema)` to create a new message.
  */
 export const ListVPSRegionsResponseSchema: GenMessage<ListVPSRegionsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 33);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 38);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetVPSProxyInfoRequest
@@ -1165,7 +1337,7 @@ export type GetVPSProxyInfoRequest = Message<"obiente.cloud.vps.v1.GetVPSProxyIn
  * Use `create(GetVPSProxyInfoRequestSchema)` to create a new message.
  */
 export const GetVPSProxyInfoRequestSchema: GenMessage<GetVPSProxyInfoRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 34);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 39);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetVPSProxyInfoResponse
@@ -1210,7 +1382,118 @@ export type GetVPSProxyInfoResponse = Message<"obiente.cloud.vps.v1.GetVPSProxyI
  * Use `create(GetVPSProxyInfoResponseSchema)` to create a new message.
  */
 export const GetVPSProxyInfoResponseSchema: GenMessage<GetVPSProxyInfoResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 35);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 40);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.GetVPSConsoleURLRequest
+ */
+export type GetVPSConsoleURLRequest = Message<"obiente.cloud.vps.v1.GetVPSConsoleURLRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string vps_id = 2;
+   */
+  vpsId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.GetVPSConsoleURLRequest.
+ * Use `create(GetVPSConsoleURLRequestSchema)` to create a new message.
+ */
+export const GetVPSConsoleURLRequestSchema: GenMessage<GetVPSConsoleURLRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 41);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.GetVPSConsoleURLResponse
+ */
+export type GetVPSConsoleURLResponse = Message<"obiente.cloud.vps.v1.GetVPSConsoleURLResponse"> & {
+  /**
+   * noVNC-compatible WebSocket URL (e.g., wss://api.obiente.cloud/vps/{vps_id}/console?token=...)
+   *
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * VNC password to send during the RFB handshake
+   *
+   * @generated from field: string password = 2;
+   */
+  password: string;
+
+  /**
+   * The console must be opened before this time; the URL can only be used once
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.GetVPSConsoleURLResponse.
+ * Use `create(GetVPSConsoleURLResponseSchema)` to create a new message.
+ */
+export const GetVPSConsoleURLResponseSchema: GenMessage<GetVPSConsoleURLResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 42);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.GetVPSVNCSessionRequest
+ */
+export type GetVPSVNCSessionRequest = Message<"obiente.cloud.vps.v1.GetVPSVNCSessionRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string vps_id = 2;
+   */
+  vpsId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.GetVPSVNCSessionRequest.
+ * Use `create(GetVPSVNCSessionRequestSchema)` to create a new message.
+ */
+export const GetVPSVNCSessionRequestSchema: GenMessage<GetVPSVNCSessionRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 43);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.GetVPSVNCSessionResponse
+ */
+export type GetVPSVNCSessionResponse = Message<"obiente.cloud.vps.v1.GetVPSVNCSessionResponse"> & {
+  /**
+   * noVNC-compatible WebSocket URL (e.g., wss://api.obiente.cloud/vps/{vps_id}/vnc?token=...)
+   * The URL can be reconnected to; no VNC password is needed
+   *
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * The session ends after this long without an open connection (e.g. once the browser tab is closed)
+   *
+   * @generated from field: int32 idle_timeout_seconds = 2;
+   */
+  idleTimeoutSeconds: number;
+
+  /**
+   * The session ends at this time at the latest, when the token it was requested with expires
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.GetVPSVNCSessionResponse.
+ * Use `create(GetVPSVNCSessionResponseSchema)` to create a new message.
+ */
+export const GetVPSVNCSessionResponseSchema: GenMessage<GetVPSVNCSessionResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 44);
 
 /**
  * @generated from message obiente.cloud.vps.v1.VPSRegion
@@ -1257,7 +1540,7 @@ export type VPSRegion = Message<"obiente.cloud.vps.v1.VPSRegion"> & {
  * Use `create(VPSRegionSchema)` to create a new message.
  */
 export const VPSRegionSchema: GenMessage<VPSRegion> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 36);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 45);
 
 /**
  * @generated from message obiente.cloud.vps.v1.VPSInstance
@@ -1411,6 +1694,13 @@ export type VPSInstance = Message<"obiente.cloud.vps.v1.VPSInstance"> & {
    * @generated from field: optional obiente.cloud.vps.v1.VPSMetric current_metrics = 25;
    */
   currentMetrics?: VPSMetric;
+
+  /**
+   * Last successful OS patch run (PatchVPS)
+   *
+   * @generated from field: optional google.protobuf.Timestamp last_patched_at = 26;
+   */
+  lastPatchedAt?: Timestamp;
 };
 
 /**
@@ -1418,7 +1708,7 @@ export type VPSInstance = Message<"obiente.cloud.vps.v1.VPSInstance"> & {
  * Use `create(VPSInstanceSchema)` to create a new message.
  */
 export const VPSInstanceSchema: GenMessage<VPSInstance> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 37);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 46);
 
 /**
  * Firewall rule management messages
@@ -1442,7 +1732,7 @@ export type ListFirewallRulesRequest = Message<"obiente.cloud.vps.v1.ListFirewal
  * Use `create(ListFirewallRulesRequestSchema)` to create a new message.
  */
 export const ListFirewallRulesRequestSchema: GenMessage<ListFirewallRulesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 38);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 47);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ListFirewallRulesResponse
@@ -1459,7 +1749,7 @@ export type ListFirewallRulesResponse = Message<"obiente.cloud.vps.v1.ListFirewa
  * Use `create(ListFirewallRulesResponseSchema)` to create a new message.
  */
 export const ListFirewallRulesResponseSchema: GenMessage<ListFirewallRulesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 39);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 48);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetFirewallRuleRequest
@@ -1488,7 +1778,7 @@ export type GetFirewallRuleRequest = Message<"obiente.cloud.vps.v1.GetFirewallRu
  * Use `create(GetFirewallRuleRequestSchema)` to create a new message.
  */
 export const GetFirewallRuleRequestSchema: GenMessage<GetFirewallRuleRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 40);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 49);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetFirewallRuleResponse
@@ -1505,7 +1795,7 @@ export type GetFirewallRuleResponse = Message<"obiente.cloud.vps.v1.GetFirewallR
  * Use `create(GetFirewallRuleResponseSchema)` to create a new message.
  */
 export const GetFirewallRuleResponseSchema: GenMessage<GetFirewallRuleResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 41);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 50);
 
 /**
  * @generated from message obiente.cloud.vps.v1.CreateFirewallRuleRequest
@@ -1539,7 +1829,7 @@ export type CreateFirewallRuleRequest = Message<"obiente.cloud.vps.v1.CreateFire
  * Use `create(CreateFirewallRuleRequestSchema)` to create a new message.
  */
 export const CreateFirewallRuleRequestSchema: GenMessage<CreateFirewallRuleRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 42);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 51);
 
 /**
  * @generated from message obiente.cloud.vps.v1.CreateFirewallRuleResponse
@@ -1556,7 +1846,7 @@ export type CreateFirewallRuleResponse = Message<"obiente.cloud.vps.v1.CreateFir
  * Use `create(CreateFirewallRuleResponseSchema)` to create a new message.
  */
 export const CreateFirewallRuleResponseSchema: GenMessage<CreateFirewallRuleResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 43);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 52);
 
 /**
  * @generated from message obiente.cloud.vps.v1.UpdateFirewallRuleRequest
@@ -1590,7 +1880,7 @@ export type UpdateFirewallRuleRequest = Message<"obiente.cloud.vps.v1.UpdateFire
  * Use `create(UpdateFirewallRuleRequestSchema)` to create a new message.
  */
 export const UpdateFirewallRuleRequestSchema: GenMessage<UpdateFirewallRuleRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 44);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 53);
 
 /**
  * @generated from message obiente.cloud.vps.v1.UpdateFirewallRuleResponse
@@ -1607,7 +1897,7 @@ export type UpdateFirewallRuleResponse = Message<"obiente.cloud.vps.v1.UpdateFir
  * Use `create(UpdateFirewallRuleResponseSchema)` to create a new message.
  */
 export const UpdateFirewallRuleResponseSchema: GenMessage<UpdateFirewallRuleResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 45);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 54);
 
 /**
  * @generated from message obiente.cloud.vps.v1.DeleteFirewallRuleRequest
@@ -1636,7 +1926,7 @@ export type DeleteFirewallRuleRequest = Message<"obiente.cloud.vps.v1.DeleteFire
  * Use `create(DeleteFirewallRuleRequestSchema)` to create a new message.
  */
 export const DeleteFirewallRuleRequestSchema: GenMessage<DeleteFirewallRuleRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 46);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 55);
 
 /**
  * @generated from message obiente.cloud.vps.v1.DeleteFirewallRuleResponse
@@ -1653,7 +1943,7 @@ export type DeleteFirewallRuleResponse = Message<"obiente.cloud.vps.v1.DeleteFir
  * Use `create(DeleteFirewallRuleResponseSchema)` to create a new message.
  */
 export const DeleteFirewallRuleResponseSchema: GenMessage<DeleteFirewallRuleResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 47);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 56);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetFirewallOptionsRequest
@@ -1675,7 +1965,7 @@ export type GetFirewallOptionsRequest = Message<"obiente.cloud.vps.v1.GetFirewal
  * Use `create(GetFirewallOptionsRequestSchema)` to create a new message.
  */
 export const GetFirewallOptionsRequestSchema: GenMessage<GetFirewallOptionsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 48);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 57);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetFirewallOptionsResponse
@@ -1692,7 +1982,7 @@ export type GetFirewallOptionsResponse = Message<"obiente.cloud.vps.v1.GetFirewa
  * Use `create(GetFirewallOptionsResponseSchema)` to create a new message.
  */
 export const GetFirewallOptionsResponseSchema: GenMessage<GetFirewallOptionsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 49);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 58);
 
 /**
  * @generated from message obiente.cloud.vps.v1.UpdateFirewallOptionsRequest
@@ -1719,7 +2009,7 @@ export type UpdateFirewallOptionsRequest = Message<"obiente.cloud.vps.v1.UpdateF
  * Use `create(UpdateFirewallOptionsRequestSchema)` to create a new message.
  */
 export const UpdateFirewallOptionsRequestSchema: GenMessage<UpdateFirewallOptionsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 50);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 59);
 
 /**
  * @generated from message obiente.cloud.vps.v1.UpdateFirewallOptionsResponse
@@ -1736,7 +2026,7 @@ export type UpdateFirewallOptionsResponse = Message<"obiente.cloud.vps.v1.Update
  * Use `create(UpdateFirewallOptionsResponseSchema)` to create a new message.
  */
 export const UpdateFirewallOptionsResponseSchema: GenMessage<UpdateFirewallOptionsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 51);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 60);
 
 /**
  * @generated from message obiente.cloud.vps.v1.FirewallRule
@@ -1852,7 +2142,7 @@ export type FirewallRule = Message<"obiente.cloud.vps.v1.FirewallRule"> & {
  * Use `create(FirewallRuleSchema)` to create a new message.
  */
 export const FirewallRuleSchema: GenMessage<FirewallRule> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 52);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 61);
 
 /**
  * @generated from message obiente.cloud.vps.v1.FirewallOptions
@@ -1941,7 +2231,7 @@ export type FirewallOptions = Message<"obiente.cloud.vps.v1.FirewallOptions"> &
  * Use `create(FirewallOptionsSchema)` to create a new message.
  */
 export const FirewallOptionsSchema: GenMessage<FirewallOptions> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 53);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 62);
 
 /**
  * SSH key management messages
@@ -1996,7 +2286,7 @@ export type SSHKey = Message<"obiente.cloud.vps.v1.SSHKey"> & {
  * Use `create(SSHKeySchema)` to create a new message.
  */
 export const SSHKeySchema: GenMessage<SSHKey> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 54);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 63);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ListSSHKeysRequest
@@ -2020,7 +2310,7 @@ export type ListSSHKeysRequest = Message<"obiente.cloud.vps.v1.ListSSHKeysReques
  * Use `create(ListSSHKeysRequestSchema)` to create a new message.
  */
 export const ListSSHKeysRequestSchema: GenMessage<ListSSHKeysRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 55);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 64);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ListSSHKeysResponse
@@ -2037,7 +2327,7 @@ export type ListSSHKeysResponse = Message<"obiente.cloud.vps.v1.ListSSHKeysRespo
  * Use `create(ListSSHKeysResponseSchema)` to create a new message.
  */
 export const ListSSHKeysResponseSchema: GenMessage<ListSSHKeysResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 56);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 65);
 
 /**
  * @generated from message obiente.cloud.vps.v1.AddSSHKeyRequest
@@ -2075,7 +2365,7 @@ export type AddSSHKeyRequest = Message<"obiente.cloud.vps.v1.AddSSHKeyRequest">
  * Use `create(AddSSHKeyRequestSchema)` to create a new message.
  */
 export const AddSSHKeyRequestSchema: GenMessage<AddSSHKeyRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 57);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 66);
 
 /**
  * @generated from message obiente.cloud.vps.v1.AddSSHKeyResponse
@@ -2092,7 +2382,7 @@ export type AddSSHKeyResponse = Message<"obiente.cloud.vps.v1.AddSSHKeyResponse"
  * Use `create(AddSSHKeyResponseSchema)` to create a new message.
  */
 export const AddSSHKeyResponseSchema: GenMessage<AddSSHKeyResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 58);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 67);
 
 /**
  * @generated from message obiente.cloud.vps.v1.UpdateSSHKeyRequest
@@ -2121,7 +2411,7 @@ export type UpdateSSHKeyRequest = Message<"obiente.cloud.vps.v1.UpdateSSHKeyRequ
  * Use `create(UpdateSSHKeyRequestSchema)` to create a new message.
  */
 export const UpdateSSHKeyRequestSchema: GenMessage<UpdateSSHKeyRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 59);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 68);
 
 /**
  * @generated from message obiente.cloud.vps.v1.UpdateSSHKeyResponse
@@ -2138,7 +2428,7 @@ export type UpdateSSHKeyResponse = Message<"obiente.cloud.vps.v1.UpdateSSHKeyRes
  * Use `create(UpdateSSHKeyResponseSchema)` to create a new message.
  */
 export const UpdateSSHKeyResponseSchema: GenMessage<UpdateSSHKeyResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 60);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 69);
 
 /**
  * @generated from message obiente.cloud.vps.v1.RemoveSSHKeyRequest
@@ -2160,7 +2450,7 @@ export type RemoveSSHKeyRequest = Message<"obiente.cloud.vps.v1.RemoveSSHKeyRequ
  * Use `create(RemoveSSHKeyRequestSchema)` to create a new message.
  */
 export const RemoveSSHKeyRequestSchema: GenMessage<RemoveSSHKeyRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 61);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 70);
 
 /**
  * @generated from message obiente.cloud.vps.v1.RemoveSSHKeyResponse
@@ -2187,7 +2477,7 @@ export type RemoveSSHKeyResponse = Message<"obiente.cloud.vps.v1.RemoveSSHKeyRes
  * Use `create(RemoveSSHKeyResponseSchema)` to create a new message.
  */
 export const RemoveSSHKeyResponseSchema: GenMessage<RemoveSSHKeyResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 62);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 71);
 
 /**
  * Password reset messages
@@ -2211,7 +2501,7 @@ export type ResetVPSPasswordRequest = Message<"obiente.cloud.vps.v1.ResetVPSPass
  * Use `create(ResetVPSPasswordRequestSchema)` to create a new message.
  */
 export const ResetVPSPasswordRequestSchema: GenMessage<ResetVPSPasswordRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 63);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 72);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ResetVPSPasswordResponse
@@ -2244,7 +2534,7 @@ export type ResetVPSPasswordResponse = Message<"obiente.cloud.vps.v1.ResetVPSPas
  * Use `create(ResetVPSPasswordResponseSchema)` to create a new message.
  */
 export const ResetVPSPasswordResponseSchema: GenMessage<ResetVPSPasswordResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 64);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 73);
 
 /**
  * Reinitialize VPS messages
@@ -2268,7 +2558,7 @@ export type ReinitializeVPSRequest = Message<"obiente.cloud.vps.v1.ReinitializeV
  * Use `create(ReinitializeVPSRequestSchema)` to create a new message.
  */
 export const ReinitializeVPSRequestSchema: GenMessage<ReinitializeVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 65);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 74);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ReinitializeVPSResponse
@@ -2299,7 +2589,7 @@ export type ReinitializeVPSResponse = Message<"obiente.cloud.vps.v1.Reinitialize
  * Use `create(ReinitializeVPSResponseSchema)` to create a new message.
  */
 export const ReinitializeVPSResponseSchema: GenMessage<ReinitializeVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 66);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 75);
 
 /**
  * VPS log streaming messages
@@ -2323,7 +2613,7 @@ export type StreamVPSLogsRequest = Message<"obiente.cloud.vps.v1.StreamVPSLogsRe
  * Use `create(StreamVPSLogsRequestSchema)` to create a new message.
  */
 export const StreamVPSLogsRequestSchema: GenMessage<StreamVPSLogsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 67);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 76);
 
 /**
  * @generated from message obiente.cloud.vps.v1.VPSLogLine
@@ -2363,7 +2653,7 @@ export type VPSLogLine = Message<"obiente.cloud.vps.v1.VPSLogLine"> & {
  * Use `create(VPSLogLineSchema)` to create a new message.
  */
 export const VPSLogLineSchema: GenMessage<VPSLogLine> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 68);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 77);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetVPSJournalLogsRequest
@@ -2399,7 +2689,7 @@ export type GetVPSJournalLogsRequest = Message<"obiente.cloud.vps.v1.GetVPSJourn
  * Use `create(GetVPSJournalLogsRequestSchema)` to create a new message.
  */
 export const GetVPSJournalLogsRequestSchema: GenMessage<GetVPSJournalLogsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 69);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 78);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetVPSJournalLogsResponse
@@ -2416,7 +2706,7 @@ export type GetVPSJournalLogsResponse = Message<"obiente.cloud.vps.v1.GetVPSJour
  * Use `create(GetVPSJournalLogsResponseSchema)` to create a new message.
  */
 export const GetVPSJournalLogsResponseSchema: GenMessage<GetVPSJournalLogsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 70);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 79);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ListVPSServicesRequest
@@ -2445,7 +2735,7 @@ export type ListVPSServicesRequest = Message<"obiente.cloud.vps.v1.ListVPSServic
  * Use `create(ListVPSServicesRequestSchema)` to create a new message.
  */
 export const ListVPSServicesRequestSchema: GenMessage<ListVPSServicesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 71);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 80);
 
 /**
  * @generated from message obiente.cloud.vps.v1.VPSSystemService
@@ -2482,7 +2772,7 @@ export type VPSSystemService = Message<"obiente.cloud.vps.v1.VPSSystemService">
  * Use `create(VPSSystemServiceSchema)` to create a new message.
  */
 export const VPSSystemServiceSchema: GenMessage<VPSSystemService> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 72);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 81);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ListVPSServicesResponse
@@ -2504,7 +2794,7 @@ export type ListVPSServicesResponse = Message<"obiente.cloud.vps.v1.ListVPSServi
  * Use `create(ListVPSServicesResponseSchema)` to create a new message.
  */
 export const ListVPSServicesResponseSchema: GenMessage<ListVPSServicesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 73);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 82);
 
 /**
  * Import VPS messages
@@ -2525,7 +2815,7 @@ export type ImportVPSRequest = Message<"obiente.cloud.vps.v1.ImportVPSRequest">
  * Use `create(ImportVPSRequestSchema)` to create a new message.
  */
 export const ImportVPSRequestSchema: GenMessage<ImportVPSRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 74);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 83);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ImportVPSResponse
@@ -2565,7 +2855,7 @@ export type ImportVPSResponse = Message<"obiente.cloud.vps.v1.ImportVPSResponse"
  * Use `create(ImportVPSResponseSchema)` to create a new message.
  */
 export const ImportVPSResponseSchema: GenMessage<ImportVPSResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 75);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 84);
 
 /**
  * GetVPSLeases retrieves DHCP lease information for VPS instances
@@ -2591,7 +2881,7 @@ export type GetVPSLeasesRequest = Message<"obiente.cloud.vps.v1.GetVPSLeasesRequ
  * Use `create(GetVPSLeasesRequestSchema)` to create a new message.
  */
 export const GetVPSLeasesRequestSchema: GenMessage<GetVPSLeasesRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 76);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 85);
 
 /**
  * @generated from message obiente.cloud.vps.v1.VPSLease
@@ -2635,7 +2925,7 @@ export type VPSLease = Message<"obiente.cloud.vps.v1.VPSLease"> & {
  * Use `create(VPSLeaseSchema)` to create a new message.
  */
 export const VPSLeaseSchema: GenMessage<VPSLease> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 77);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 86);
 
 /**
  * @generated from message obiente.cloud.vps.v1.GetVPSLeasesResponse
@@ -2652,7 +2942,7 @@ export type GetVPSLeasesResponse = Message<"obiente.cloud.vps.v1.GetVPSLeasesRes
  * Use `create(GetVPSLeasesResponseSchema)` to create a new message.
  */
 export const GetVPSLeasesResponseSchema: GenMessage<GetVPSLeasesResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 78);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 87);
 
 /**
  * RegisterLeaseRequest is called by gateway nodes to register a new DHCP lease
@@ -2705,7 +2995,7 @@ export type RegisterLeaseRequest = Message<"obiente.cloud.vps.v1.RegisterLeaseRe
  * Use `create(RegisterLeaseRequestSchema)` to create a new message.
  */
 export const RegisterLeaseRequestSchema: GenMessage<RegisterLeaseRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 79);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 88);
 
 /**
  * @generated from message obiente.cloud.vps.v1.RegisterLeaseResponse
@@ -2727,7 +3017,7 @@ export type RegisterLeaseResponse = Message<"obiente.cloud.vps.v1.RegisterLeaseR
  * Use `create(RegisterLeaseResponseSchema)` to create a new message.
  */
 export const RegisterLeaseResponseSchema: GenMessage<RegisterLeaseResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 80);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 89);
 
 /**
  * ReleaseLeaseRequest is called by gateway nodes to release a DHCP lease
@@ -2753,7 +3043,7 @@ export type ReleaseLeaseRequest = Message<"obiente.cloud.vps.v1.ReleaseLeaseRequ
  * Use `create(ReleaseLeaseRequestSchema)` to create a new message.
  */
 export const ReleaseLeaseRequestSchema: GenMessage<ReleaseLeaseRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 81);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 90);
 
 /**
  * @generated from message obiente.cloud.vps.v1.ReleaseLeaseResponse
@@ -2775,7 +3065,7 @@ export type ReleaseLeaseResponse = Message<"obiente.cloud.vps.v1.ReleaseLeaseRes
  * Use `create(ReleaseLeaseResponseSchema)` to create a new message.
  */
 export const ReleaseLeaseResponseSchema: GenMessage<ReleaseLeaseResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 82);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 91);
 
 /**
  * AssignVPSPublicIP assigns a public IP to a VPS and triggers DHCP lease creation
@@ -2804,7 +3094,7 @@ export type AssignVPSPublicIPRequest = Message<"obiente.cloud.vps.v1.AssignVPSPu
  * Use `create(AssignVPSPublicIPRequestSchema)` to create a new message.
  */
 export const AssignVPSPublicIPRequestSchema: GenMessage<AssignVPSPublicIPRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 83);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 92);
 
 /**
  * @generated from message obiente.cloud.vps.v1.AssignVPSPublicIPResponse
@@ -2826,7 +3116,7 @@ export type AssignVPSPublicIPResponse = Message<"obiente.cloud.vps.v1.AssignVPSP
  * Use `create(AssignVPSPublicIPResponseSchema)` to create a new message.
  */
 export const AssignVPSPublicIPResponseSchema: GenMessage<AssignVPSPublicIPResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 84);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 93);
 
 /**
  * UnassignVPSPublicIP removes a public IP from a VPS and removes DHCP lease
@@ -2855,7 +3145,7 @@ export type UnassignVPSPublicIPRequest = Message<"obiente.cloud.vps.v1.UnassignV
  * Use `create(UnassignVPSPublicIPRequestSchema)` to create a new message.
  */
 export const UnassignVPSPublicIPRequestSchema: GenMessage<UnassignVPSPublicIPRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 85);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 94);
 
 /**
  * @generated from message obiente.cloud.vps.v1.UnassignVPSPublicIPResponse
@@ -2877,7 +3167,7 @@ export type UnassignVPSPublicIPResponse = Message<"obiente.cloud.vps.v1.Unassign
  * Use `create(UnassignVPSPublicIPResponseSchema)` to create a new message.
  */
 export const UnassignVPSPublicIPResponseSchema: GenMessage<UnassignVPSPublicIPResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 86);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 95);
 
 /**
  * VPS Public IP
@@ -2971,7 +3261,7 @@ export type VPSPublicIP = Message<"obiente.cloud.vps.v1.VPSPublicIP"> & {
  * Use `create(VPSPublicIPSchema)` to create a new message.
  */
 export const VPSPublicIPSchema: GenMessage<VPSPublicIP> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 87);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 96);
 
 /**
  * List VPS Public IPs Request
@@ -3020,7 +3310,7 @@ export type ListVPSPublicIPsRequest = Message<"obiente.cloud.vps.v1.ListVPSPubli
  * Use `create(ListVPSPublicIPsRequestSchema)` to create a new message.
  */
 export const ListVPSPublicIPsRequestSchema: GenMessage<ListVPSPublicIPsRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 88);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 97);
 
 /**
  * List VPS Public IPs Response
@@ -3046,7 +3336,7 @@ export type ListVPSPublicIPsResponse = Message<"obiente.cloud.vps.v1.ListVPSPubl
  * Use `create(ListVPSPublicIPsResponseSchema)` to create a new message.
  */
 export const ListVPSPublicIPsResponseSchema: GenMessage<ListVPSPublicIPsResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 89);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 98);
 
 /**
  * Create VPS Public IP Request
@@ -3088,7 +3378,7 @@ export type CreateVPSPublicIPRequest = Message<"obiente.cloud.vps.v1.CreateVPSPu
  * Use `create(CreateVPSPublicIPRequestSchema)` to create a new message.
  */
 export const CreateVPSPublicIPRequestSchema: GenMessage<CreateVPSPublicIPRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 90);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 99);
 
 /**
  * Create VPS Public IP Response
@@ -3107,7 +3397,7 @@ export type CreateVPSPublicIPResponse = Message<"obiente.cloud.vps.v1.CreateVPSP
  * Use `create(CreateVPSPublicIPResponseSchema)` to create a new message.
  */
 export const CreateVPSPublicIPResponseSchema: GenMessage<CreateVPSPublicIPResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 91);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 100);
 
 /**
  * Update VPS Public IP Request
@@ -3149,7 +3439,7 @@ export type UpdateVPSPublicIPRequest = Message<"obiente.cloud.vps.v1.UpdateVPSPu
  * Use `create(UpdateVPSPublicIPRequestSchema)` to create a new message.
  */
 export const UpdateVPSPublicIPRequestSchema: GenMessage<UpdateVPSPublicIPRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 92);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 101);
 
 /**
  * Update VPS Public IP Response
@@ -3168,7 +3458,7 @@ export type UpdateVPSPublicIPResponse = Message<"obiente.cloud.vps.v1.UpdateVPSP
  * Use `create(UpdateVPSPublicIPResponseSchema)` to create a new message.
  */
 export const UpdateVPSPublicIPResponseSchema: GenMessage<UpdateVPSPublicIPResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 93);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 102);
 
 /**
  * Delete VPS Public IP Request
@@ -3189,7 +3479,7 @@ export type DeleteVPSPublicIPRequest = Message<"obiente.cloud.vps.v1.DeleteVPSPu
  * Use `create(DeleteVPSPublicIPRequestSchema)` to create a new message.
  */
 export const DeleteVPSPublicIPRequestSchema: GenMessage<DeleteVPSPublicIPRequest> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 94);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 103);
 
 /**
  * Delete VPS Public IP Response
@@ -3208,7 +3498,393 @@ export type DeleteVPSPublicIPResponse = Message<"obiente.cloud.vps.v1.DeleteVPSP
  * Use `create(DeleteVPSPublicIPResponseSchema)` to create a new message.
  */
 export const DeleteVPSPublicIPResponseSchema: GenMessage<DeleteVPSPublicIPResponse> = /*@__PURE__*/
-  messageDesc(file_obiente_cloud_vps_v1_vps_service, 95);
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 104);
+
+/**
+ * VPSTemplate is a VM converted into a Proxmox template that new VPS instances can be cloned from
+ *
+ * @generated from message obiente.cloud.vps.v1.VPSTemplate
+ */
+export type VPSTemplate = Message<"obiente.cloud.vps.v1.VPSTemplate"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: optional string description = 4;
+   */
+  description?: string;
+
+  /**
+   * VPS the template was created from
+   *
+   * @generated from field: string source_vps_id = 5;
+   */
+  sourceVpsId: string;
+
+  /**
+   * VPS instances cloned from the template are created in this region
+   *
+   * @generated from field: string region = 6;
+   */
+  region: string;
+
+  /**
+   * OS image of the source VPS
+   *
+   * @generated from field: obiente.cloud.vps.v1.VPSImage image = 7;
+   */
+  image: VPSImage;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.VPSTemplate.
+ * Use `create(VPSTemplateSchema)` to create a new message.
+ */
+export const VPSTemplateSchema: GenMessage<VPSTemplate> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 105);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.CreateVPSTemplateRequest
+ */
+export type CreateVPSTemplateRequest = Message<"obiente.cloud.vps.v1.CreateVPSTemplateRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string vps_id = 2;
+   */
+  vpsId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: optional string description = 4;
+   */
+  description?: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.CreateVPSTemplateRequest.
+ * Use `create(CreateVPSTemplateRequestSchema)` to create a new message.
+ */
+export const CreateVPSTemplateRequestSchema: GenMessage<CreateVPSTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 106);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.CreateVPSTemplateResponse
+ */
+export type CreateVPSTemplateResponse = Message<"obiente.cloud.vps.v1.CreateVPSTemplateResponse"> & {
+  /**
+   * @generated from field: obiente.cloud.vps.v1.VPSTemplate template = 1;
+   */
+  template?: VPSTemplate;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.CreateVPSTemplateResponse.
+ * Use `create(CreateVPSTemplateResponseSchema)` to create a new message.
+ */
+export const CreateVPSTemplateResponseSchema: GenMessage<CreateVPSTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 107);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.ListVPSTemplatesRequest
+ */
+export type ListVPSTemplatesRequest = Message<"obiente.cloud.vps.v1.ListVPSTemplatesRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.ListVPSTemplatesRequest.
+ * Use `create(ListVPSTemplatesRequestSchema)` to create a new message.
+ */
+export const ListVPSTemplatesRequestSchema: GenMessage<ListVPSTemplatesRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 108);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.ListVPSTemplatesResponse
+ */
+export type ListVPSTemplatesResponse = Message<"obiente.cloud.vps.v1.ListVPSTemplatesResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.vps.v1.VPSTemplate templates = 1;
+   */
+  templates: VPSTemplate[];
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.ListVPSTemplatesResponse.
+ * Use `create(ListVPSTemplatesResponseSchema)` to create a new message.
+ */
+export const ListVPSTemplatesResponseSchema: GenMessage<ListVPSTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 109);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.DeleteVPSTemplateRequest
+ */
+export type DeleteVPSTemplateRequest = Message<"obiente.cloud.vps.v1.DeleteVPSTemplateRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string template_id = 2;
+   */
+  templateId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.DeleteVPSTemplateRequest.
+ * Use `create(DeleteVPSTemplateRequestSchema)` to create a new message.
+ */
+export const DeleteVPSTemplateRequestSchema: GenMessage<DeleteVPSTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 110);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.DeleteVPSTemplateResponse
+ */
+export type DeleteVPSTemplateResponse = Message<"obiente.cloud.vps.v1.DeleteVPSTemplateResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.DeleteVPSTemplateResponse.
+ * Use `create(DeleteVPSTemplateResponseSchema)` to create a new message.
+ */
+export const DeleteVPSTemplateResponseSchema: GenMessage<DeleteVPSTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 111);
+
+/**
+ * VPSISO is an install ISO an organization uploaded for its VPS instances
+ *
+ * @generated from message obiente.cloud.vps.v1.VPSISO
+ */
+export type VPSISO = Message<"obiente.cloud.vps.v1.VPSISO"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int64 size_bytes = 3;
+   */
+  sizeBytes: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp uploaded_at = 4;
+   */
+  uploadedAt?: Timestamp;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.VPSISO.
+ * Use `create(VPSISOSchema)` to create a new message.
+ */
+export const VPSISOSchema: GenMessage<VPSISO> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 112);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.ListOrgISOsRequest
+ */
+export type ListOrgISOsRequest = Message<"obiente.cloud.vps.v1.ListOrgISOsRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.ListOrgISOsRequest.
+ * Use `create(ListOrgISOsRequestSchema)` to create a new message.
+ */
+export const ListOrgISOsRequestSchema: GenMessage<ListOrgISOsRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 113);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.ListOrgISOsResponse
+ */
+export type ListOrgISOsResponse = Message<"obiente.cloud.vps.v1.ListOrgISOsResponse"> & {
+  /**
+   * @generated from field: repeated obiente.cloud.vps.v1.VPSISO isos = 1;
+   */
+  isos: VPSISO[];
+
+  /**
+   * ISO storage used by the organization
+   *
+   * @generated from field: int64 used_bytes = 2;
+   */
+  usedBytes: bigint;
+
+  /**
+   * ISO storage the organization may use
+   *
+   * @generated from field: int64 quota_bytes = 3;
+   */
+  quotaBytes: bigint;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.ListOrgISOsResponse.
+ * Use `create(ListOrgISOsResponseSchema)` to create a new message.
+ */
+export const ListOrgISOsResponseSchema: GenMessage<ListOrgISOsResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 114);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.DeleteISORequest
+ */
+export type DeleteISORequest = Message<"obiente.cloud.vps.v1.DeleteISORequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string iso_name = 2;
+   */
+  isoName: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.DeleteISORequest.
+ * Use `create(DeleteISORequestSchema)` to create a new message.
+ */
+export const DeleteISORequestSchema: GenMessage<DeleteISORequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 115);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.DeleteISOResponse
+ */
+export type DeleteISOResponse = Message<"obiente.cloud.vps.v1.DeleteISOResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.DeleteISOResponse.
+ * Use `create(DeleteISOResponseSchema)` to create a new message.
+ */
+export const DeleteISOResponseSchema: GenMessage<DeleteISOResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 116);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.MountISORequest
+ */
+export type MountISORequest = Message<"obiente.cloud.vps.v1.MountISORequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string vps_id = 2;
+   */
+  vpsId: string;
+
+  /**
+   * @generated from field: string iso_name = 3;
+   */
+  isoName: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.MountISORequest.
+ * Use `create(MountISORequestSchema)` to create a new message.
+ */
+export const MountISORequestSchema: GenMessage<MountISORequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 117);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.MountISOResponse
+ */
+export type MountISOResponse = Message<"obiente.cloud.vps.v1.MountISOResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.MountISOResponse.
+ * Use `create(MountISOResponseSchema)` to create a new message.
+ */
+export const MountISOResponseSchema: GenMessage<MountISOResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 118);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.UnmountISORequest
+ */
+export type UnmountISORequest = Message<"obiente.cloud.vps.v1.UnmountISORequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string vps_id = 2;
+   */
+  vpsId: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.UnmountISORequest.
+ * Use `create(UnmountISORequestSchema)` to create a new message.
+ */
+export const UnmountISORequestSchema: GenMessage<UnmountISORequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 119);
+
+/**
+ * @generated from message obiente.cloud.vps.v1.UnmountISOResponse
+ */
+export type UnmountISOResponse = Message<"obiente.cloud.vps.v1.UnmountISOResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message obiente.cloud.vps.v1.UnmountISOResponse.
+ * Use `create(UnmountISOResponseSchema)` to create a new message.
+ */
+export const UnmountISOResponseSchema: GenMessage<UnmountISOResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vps_v1_vps_service, 120);
 
 /**
  * VPSStatus represents the current status of a VPS instance
@@ -3290,6 +3966,20 @@ export enum VPSStatus {
    * @generated from enum value: SUSPENDED = 10;
    */
   SUSPENDED = 10,
+
+  /**
+   * VM is running but the guest OS stopped answering guest agent pings
+   *
+   * @generated from enum value: UNRESPONSIVE = 11;
+   */
+  UNRESPONSIVE = 11,
+
+  /**
+   * VM was converted into a VPS template and no longer exists as a VPS
+   *
+   * @generated from enum value: TERMINATED = 12;
+   */
+  TERMINATED = 12,
 }
 
 /**
@@ -3534,7 +4224,7 @@ export const VPSService: GenService<{
     output: typeof StartVPSResponseSchema;
   },
   /**
-   * Stop a running VPS instance
+   * Stop a running VPS instance with an ACPI shutdown
    *
    * @generated from rpc obiente.cloud.vps.v1.VPSService.StopVPS
    */
@@ -3553,6 +4243,28 @@ export const VPSService: GenService<{
     input: typeof RebootVPSRequestSchema;
     output: typeof RebootVPSResponseSchema;
   },
+  /**
+   * Power off a VPS instance immediately, without a guest shutdown
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSService.ForceStopVPS
+   */
+  forceStopVPS: {
+    methodKind: "unary";
+    input: typeof ForceStopVPSRequestSchema;
+    output: typeof ForceStopVPSResponseSchema;
+  },
+  /**
+   * Upgrade the OS packages of a VPS (apt-get, dnf or yum, detected from /etc/os-release)
+   * The patch script is injected as cloud-init vendor-data and runs when the VM is (re)started.
+   * The run continues in the background; its result is stored in the VPS patch history.
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSService.PatchVPS
+   */
+  patchVPS: {
+    methodKind: "unary";
+    input: typeof PatchVPSRequestSchema;
+    output: typeof PatchVPSResponseSchema;
+  },
   /**
    * Stream VPS status updates
    *
@@ -3623,6 +4335,26 @@ export const VPSService: GenService<{
     input: typeof GetVPSProxyInfoRequestSchema;
     output: typeof GetVPSProxyInfoResponseSchema;
   },
+  /**
+   * Get a short-lived noVNC console URL for a VPS (works before SSH is available, e.g. during boot)
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSService.GetVPSConsoleURL
+   */
+  getVPSConsoleURL: {
+    methodKind: "unary";
+    input: typeof GetVPSConsoleURLRequestSchema;
+    output: typeof GetVPSConsoleURLResponseSchema;
+  },
+  /**
+   * Get a long-lived noVNC session for a VPS that needs no VNC password (max 3 per organization)
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSService.GetVPSVNCSession
+   */
+  getVPSVNCSession: {
+    methodKind: "unary";
+    input: typeof GetVPSVNCSessionRequestSchema;
+    output: typeof GetVPSVNCSessionResponseSchema;
+  },
   /**
    * Firewall management
    *
@@ -3844,6 +4576,80 @@ export const VPSService: GenService<{
     input: typeof UnassignVPSPublicIPRequestSchema;
     output: typeof UnassignVPSPublicIPResponseSchema;
   },
+  /**
+   * Convert a VPS into a template (golden image) that new VPS instances can be cloned from
+   * The VM is shut down through the guest agent and the VPS is marked as TERMINATED
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSService.CreateVPSTemplate
+   */
+  createVPSTemplate: {
+    methodKind: "unary";
+    input: typeof CreateVPSTemplateRequestSchema;
+    output: typeof CreateVPSTemplateResponseSchema;
+  },
+  /**
+   * List the VPS templates of an organization
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSService.ListVPSTemplates
+   */
+  listVPSTemplates: {
+    methodKind: "unary";
+    input: typeof ListVPSTemplatesRequestSchema;
+    output: typeof ListVPSTemplatesResponseSchema;
+  },
+  /**
+   * Delete a VPS template and its VM from Proxmox
+   * VPS instances already cloned from the template are not affected
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSService.DeleteVPSTemplate
+   */
+  deleteVPSTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteVPSTemplateRequestSchema;
+    output: typeof DeleteVPSTemplateResponseSchema;
+  },
+  /**
+   * List the install ISOs of an organization and its ISO storage usage
+   * ISOs are uploaded with a multipart POST to /vps/isos/upload (see the VPS service README)
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSService.ListOrgISOs
+   */
+  listOrgISOs: {
+    methodKind: "unary";
+    input: typeof ListOrgISOsRequestSchema;
+    output: typeof ListOrgISOsResponseSchema;
+  },
+  /**
+   * Delete an install ISO of an organization from the ISO storage
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSService.DeleteISO
+   */
+  deleteISO: {
+    methodKind: "unary";
+    input: typeof DeleteISORequestSchema;
+    output: typeof DeleteISOResponseSchema;
+  },
+  /**
+   * Insert an ISO of the organization into the VPS's CD-ROM drive and boot from it first
+   * Takes effect on the next start; the first mount requires the VPS to be stopped
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSService.MountISO
+   */
+  mountISO: {
+    methodKind: "unary";
+    input: typeof MountISORequestSchema;
+    output: typeof MountISOResponseSchema;
+  },
+  /**
+   * Eject the ISO from the VPS's CD-ROM drive
+   *
+   * @generated from rpc obiente.cloud.vps.v1.VPSService.UnmountISO
+   */
+  unmountISO: {
+    methodKind: "unary";
+    input: typeof UnmountISORequestSchema;
+    output: typeof UnmountISOResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_obiente_cloud_vps_v1_vps_service, 0);
 
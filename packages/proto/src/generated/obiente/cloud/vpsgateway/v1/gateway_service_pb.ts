@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file obiente/cloud/vpsgateway/v1/gateway_service.proto.
  */
 export const file_obiente_cloud_vpsgateway_v1_gateway_service: GenFile = /*@__PURE__*/
-  fileDesc("CjFvYmllbnRlL2Nsb3VkL3Zwc2dhdGV3YXkvdjEvZ2F0ZXdheV9zZXJ2aWNlLnByb3RvEhtvYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEigAEKGUFkZFN0YXRpY0RIQ1BMZWFzZVJlcXVlc3QSEwoLbWFjX2FkZHJlc3MYASABKAkSEgoKaXBfYWRkcmVzcxgCIAEoCRIOCgZ2cHNfaWQYAyABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAQgASgJEhEKCWlzX3B1YmxpYxgFIAEoCCI+ChpBZGRTdGF0aWNESENQTGVhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkigwEKHFJlbW92ZVN0YXRpY0RIQ1BMZWFzZVJlcXVlc3QSEwoLbWFjX2FkZHJlc3MYASABKAkSEgoKaXBfYWRkcmVzcxgCIAEoCRIOCgZ2cHNfaWQYAyABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAQgASgJEhEKCWlzX3B1YmxpYxgFIAEoCCJBCh1SZW1vdmVTdGF0aWNESENQTGVhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiZwoRQWxsb2NhdGVJUFJlcXVlc3QSDgoGdnBzX2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRITCgttYWNfYWRkcmVzcxgDIAEoCRIUCgxwcmVmZXJyZWRfaXAYBCABKAkilgEKEkFsbG9jYXRlSVBSZXNwb25zZRISCgppcF9hZGRyZXNzGAEgASgJEhMKC3N1Ym5ldF9tYXNrGAIgASgJEg8KB2dhdGV3YXkYAyABKAkSEwoLZG5zX3NlcnZlcnMYBCADKAkSMQoNbGVhc2VfZXhwaXJlcxgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAijAEKF0FsbG9jYXRlUHVibGljSVBSZXF1ZXN0Eg4KBnZwc19pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEwoLbWFjX2FkZHJlc3MYAyABKAkSEQoJcHVibGljX2lwGAQgASgJEg8KB2dhdGV3YXkYBSABKAkSDwoHbmV0bWFzaxgGIAEoCSJyChhBbGxvY2F0ZVB1YmxpY0lQUmVzcG9uc2USEgoKaXBfYWRkcmVzcxgBIAEoCRIPCgdnYXRld2F5GAIgASgJEg8KB25ldG1hc2sYAyABKAkSDwoHc3VjY2VzcxgEIAEoCBIPCgdtZXNzYWdlGAUgASgJIjYKEFJlbGVhc2VJUFJlcXVlc3QSDgoGdnBzX2lkGAEgASgJEhIKCmlwX2FkZHJlc3MYAiABKAkiNQoRUmVsZWFzZUlQUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIlAKFlJlbGVhc2VQdWJsaWNJUFJlcXVlc3QSDgoGdnBzX2lkGAEgASgJEhEKCXB1YmxpY19pcBgCIAEoCRITCgttYWNfYWRkcmVzcxgDIAEoCSI7ChdSZWxlYXNlUHVibGljSVBSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiOQoOTGlzdElQc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSLYAQoMSVBBbGxvY2F0aW9uEg4KBnZwc19pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEgoKaXBfYWRkcmVzcxgDIAEoCRITCgttYWNfYWRkcmVzcxgEIAEoCRIwCgxhbGxvY2F0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKDWxlYXNlX2V4cGlyZXMYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCWlzX3B1YmxpYxgHIAEoCCJRCg9MaXN0SVBzUmVzcG9uc2USPgoLYWxsb2NhdGlvbnMYASADKAsyKS5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuSVBBbGxvY2F0aW9uInoKD1Byb3h5U1NIUmVxdWVzdBIVCg1jb25uZWN0aW9uX2lkGAEgASgJEgwKBHR5cGUYAiABKAkSDgoGdGFyZ2V0GAMgASgJEgwKBHBvcnQYBCABKAUSDAoEZGF0YRgFIAEoDBIWCg5zc2hfcHVibGljX2tleRgGIAEoCSJUChBQcm94eVNTSFJlc3BvbnNlEhUKDWNvbm5lY3Rpb25faWQYASABKAkSDAoEdHlwZRgCIAEoCRIMCgRkYXRhGAMgASgMEg0KBWVycm9yGAQgASgJIhcKFUdldEdhdGV3YXlJbmZvUmVxdWVzdCLwAQoWR2V0R2F0ZXdheUluZm9SZXNwb25zZRIPCgd2ZXJzaW9uGAEgASgJEhcKD2RoY3BfcG9vbF9zdGFydBgCIAEoCRIVCg1kaGNwX3Bvb2xfZW5kGAMgASgJEhMKC3N1Ym5ldF9tYXNrGAQgASgJEhIKCmdhdGV3YXlfaXAYBSABKAkSEwoLZG5zX3NlcnZlcnMYBiADKAkSEQoJdG90YWxfaXBzGAcgASgFEhUKDWFsbG9jYXRlZF9pcHMYCCABKAUSEwoLZGhjcF9zdGF0dXMYCSABKAkSGAoQc3NoX3Byb3h5X3N0YXR1cxgKIAEoCSK+AwoOR2F0ZXdheU1lc3NhZ2USDAoEdHlwZRgBIAEoCRJGCgxyZWdpc3RyYXRpb24YAiABKAsyMC5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2F0ZXdheVJlZ2lzdHJhdGlvbhIPCgdtZXRyaWNzGAMgASgJEjwKB3JlcXVlc3QYBCABKAsyKy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2F0ZXdheVJlcXVlc3QSPgoIcmVzcG9uc2UYBSABKAsyLC5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2F0ZXdheVJlc3BvbnNlEi0KCWhlYXJ0YmVhdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASTQoQc3luY19hbGxvY2F0aW9ucxgHIAEoCzIzLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5TeW5jQWxsb2NhdGlvbnNSZXF1ZXN0EkkKC3N5bmNfcmVzdWx0GAggASgLMjQub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLlN5bmNBbGxvY2F0aW9uc1Jlc3BvbnNlIqwBChNHYXRld2F5UmVnaXN0cmF0aW9uEhIKCmdhdGV3YXlfaWQYASABKAkSDwoHdmVyc2lvbhgCIAEoCRISCgpnYXRld2F5X2lwGAMgASgJEhcKD2RoY3BfcG9vbF9zdGFydBgEIAEoCRIVCg1kaGNwX3Bvb2xfZW5kGAUgASgJEhMKC3N1Ym5ldF9tYXNrGAYgASgJEhcKD2dhdGV3YXlfaXBfZGhjcBgHIAEoCSJFCg5HYXRld2F5UmVxdWVzdBISCgpyZXF1ZXN0X2lkGAEgASgJEg4KBm1ldGhvZBgCIAEoCRIPCgdwYXlsb2FkGAMgASgMIlYKD0dhdGV3YXlSZXNwb25zZRISCgpyZXF1ZXN0X2lkGAEgASgJEg8KB3N1Y2Nlc3MYAiABKAgSDwoHcGF5bG9hZBgDIAEoDBINCgVlcnJvchgEIAEoCSJ4CgtMZWFzZVJlY29yZBITCgttYWNfYWRkcmVzcxgBIAEoCRISCgppcF9hZGRyZXNzGAIgASgJEhAKCGhvc3RuYW1lGAMgASgJEi4KCmV4cGlyZXNfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIqUBCg5PcmdMZWFzZVJlY29yZBIOCgZ2cHNfaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEhMKC21hY19hZGRyZXNzGAMgASgJEhIKCmlwX2FkZHJlc3MYBCABKAkSLgoKZXhwaXJlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJaXNfcHVibGljGAYgASgIIhIKEEdldExlYXNlc1JlcXVlc3QiTQoRR2V0TGVhc2VzUmVzcG9uc2USOAoGbGVhc2VzGAEgAygLMigub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLkxlYXNlUmVjb3JkIj4KE0dldE9yZ0xlYXNlc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSJTChRHZXRPcmdMZWFzZXNSZXNwb25zZRI7CgZsZWFzZXMYASADKAsyKy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuT3JnTGVhc2VSZWNvcmQieAoRRGVzaXJlZEFsbG9jYXRpb24SDgoGdnBzX2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRISCgppcF9hZGRyZXNzGAMgASgJEhMKC21hY19hZGRyZXNzGAQgASgJEhEKCWlzX3B1YmxpYxgFIAEoCCJdChZTeW5jQWxsb2NhdGlvbnNSZXF1ZXN0EkMKC2FsbG9jYXRpb25zGAEgAygLMi4ub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLkRlc2lyZWRBbGxvY2F0aW9uIqsBChdTeW5jQWxsb2NhdGlvbnNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg0KBWFkZGVkGAIgASgFEg8KB3JlbW92ZWQYAyABKAUSDwoHbWVzc2FnZRgEIAEoCRJOChZkaXNjb3ZlcmVkX2FsbG9jYXRpb25zGAUgAygLMi4ub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLkRlc2lyZWRBbGxvY2F0aW9uMqQMChFWUFNHYXRld2F5U2VydmljZRJvCg9SZWdpc3RlckdhdGV3YXkSKy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2F0ZXdheU1lc3NhZ2UaKy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2F0ZXdheU1lc3NhZ2UoATABEm0KCkFsbG9jYXRlSVASLi5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuQWxsb2NhdGVJUFJlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuQWxsb2NhdGVJUFJlc3BvbnNlEn8KEEFsbG9jYXRlUHVibGljSVASNC5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuQWxsb2NhdGVQdWJsaWNJUFJlcXVlc3QaNS5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuQWxsb2NhdGVQdWJsaWNJUFJlc3BvbnNlEmoKCVJlbGVhc2VJUBItLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5SZWxlYXNlSVBSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLlJlbGVhc2VJUFJlc3BvbnNlEnwKD1JlbGVhc2VQdWJsaWNJUBIzLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5SZWxlYXNlUHVibGljSVBSZXF1ZXN0GjQub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLlJlbGVhc2VQdWJsaWNJUFJlc3BvbnNlEmQKB0xpc3RJUHMSKy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuTGlzdElQc1JlcXVlc3QaLC5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuTGlzdElQc1Jlc3BvbnNlEmsKCFByb3h5U1NIEiwub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLlByb3h5U1NIUmVxdWVzdBotLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5Qcm94eVNTSFJlc3BvbnNlKAEwARJ5Cg5HZXRHYXRld2F5SW5mbxIyLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5HZXRHYXRld2F5SW5mb1JlcXVlc3QaMy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2V0R2F0ZXdheUluZm9SZXNwb25zZRJqCglHZXRMZWFzZXMSLS5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2V0TGVhc2VzUmVxdWVzdBouLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5HZXRMZWFzZXNSZXNwb25zZRJzCgxHZXRPcmdMZWFzZXMSMC5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2V0T3JnTGVhc2VzUmVxdWVzdBoxLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5HZXRPcmdMZWFzZXNSZXNwb25zZRJ8Cg9TeW5jQWxsb2NhdGlvbnMSMy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuU3luY0FsbG9jYXRpb25zUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5TeW5jQWxsb2NhdGlvbnNSZXNwb25zZRKFAQoSQWRkU3RhdGljREhDUExlYXNlEjYub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLkFkZFN0YXRpY0RIQ1BMZWFzZVJlcXVlc3QaNy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuQWRkU3RhdGljREhDUExlYXNlUmVzcG9uc2USjgEKFVJlbW92ZVN0YXRpY0RIQ1BMZWFzZRI5Lm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5SZW1vdmVTdGF0aWNESENQTGVhc2VSZXF1ZXN0Gjoub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLlJlbW92ZVN0YXRpY0RIQ1BMZWFzZVJlc3BvbnNlQlVaU2dpdGh1Yi5jb20vb2JpZW50ZS9jbG91ZC9hcHBzL3NoYXJlZC9wcm90by9vYmllbnRlL2Nsb3VkL3Zwc2dhdGV3YXkvdjE7dnBzZ2F0ZXdheXYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("CjFvYmllbnRlL2Nsb3VkL3Zwc2dhdGV3YXkvdjEvZ2F0ZXdheV9zZXJ2aWNlLnByb3RvEhtvYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEigAEKGUFkZFN0YXRpY0RIQ1BMZWFzZVJlcXVlc3QSEwoLbWFjX2FkZHJlc3MYASABKAkSEgoKaXBfYWRkcmVzcxgCIAEoCRIOCgZ2cHNfaWQYAyABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAQgASgJEhEKCWlzX3B1YmxpYxgFIAEoCCI+ChpBZGRTdGF0aWNESENQTGVhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkigwEKHFJlbW92ZVN0YXRpY0RIQ1BMZWFzZVJlcXVlc3QSEwoLbWFjX2FkZHJlc3MYASABKAkSEgoKaXBfYWRkcmVzcxgCIAEoCRIOCgZ2cHNfaWQYAyABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAQgASgJEhEKCWlzX3B1YmxpYxgFIAEoCCJBCh1SZW1vdmVTdGF0aWNESENQTGVhc2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiZwoRQWxsb2NhdGVJUFJlcXVlc3QSDgoGdnBzX2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRITCgttYWNfYWRkcmVzcxgDIAEoCRIUCgxwcmVmZXJyZWRfaXAYBCABKAkilgEKEkFsbG9jYXRlSVBSZXNwb25zZRISCgppcF9hZGRyZXNzGAEgASgJEhMKC3N1Ym5ldF9tYXNrGAIgASgJEg8KB2dhdGV3YXkYAyABKAkSEwoLZG5zX3NlcnZlcnMYBCADKAkSMQoNbGVhc2VfZXhwaXJlcxgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAijAEKF0FsbG9jYXRlUHVibGljSVBSZXF1ZXN0Eg4KBnZwc19pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEwoLbWFjX2FkZHJlc3MYAyABKAkSEQoJcHVibGljX2lwGAQgASgJEg8KB2dhdGV3YXkYBSABKAkSDwoHbmV0bWFzaxgGIAEoCSJyChhBbGxvY2F0ZVB1YmxpY0lQUmVzcG9uc2USEgoKaXBfYWRkcmVzcxgBIAEoCRIPCgdnYXRld2F5GAIgASgJEg8KB25ldG1hc2sYAyABKAkSDwoHc3VjY2VzcxgEIAEoCBIPCgdtZXNzYWdlGAUgASgJIjYKEFJlbGVhc2VJUFJlcXVlc3QSDgoGdnBzX2lkGAEgASgJEhIKCmlwX2FkZHJlc3MYAiABKAkiNQoRUmVsZWFzZUlQUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIlAKFlJlbGVhc2VQdWJsaWNJUFJlcXVlc3QSDgoGdnBzX2lkGAEgASgJEhEKCXB1YmxpY19pcBgCIAEoCRITCgttYWNfYWRkcmVzcxgDIAEoCSI7ChdSZWxlYXNlUHVibGljSVBSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiOQoOTGlzdElQc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSLYAQoMSVBBbGxvY2F0aW9uEg4KBnZwc19pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSEgoKaXBfYWRkcmVzcxgDIAEoCRITCgttYWNfYWRkcmVzcxgEIAEoCRIwCgxhbGxvY2F0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKDWxlYXNlX2V4cGlyZXMYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCWlzX3B1YmxpYxgHIAEoCCJRCg9MaXN0SVBzUmVzcG9uc2USPgoLYWxsb2NhdGlvbnMYASADKAsyKS5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuSVBBbGxvY2F0aW9uInoKD1Byb3h5U1NIUmVxdWVzdBIVCg1jb25uZWN0aW9uX2lkGAEgASgJEgwKBHR5cGUYAiABKAkSDgoGdGFyZ2V0GAMgASgJEgwKBHBvcnQYBCABKAUSDAoEZGF0YRgFIAEoDBIWCg5zc2hfcHVibGljX2tleRgGIAEoCSJUChBQcm94eVNTSFJlc3BvbnNlEhUKDWNvbm5lY3Rpb25faWQYASABKAkSDAoEdHlwZRgCIAEoCRIMCgRkYXRhGAMgASgMEg0KBWVycm9yGAQgASgJIhcKFUdldEdhdGV3YXlJbmZvUmVxdWVzdCLwAQoWR2V0R2F0ZXdheUluZm9SZXNwb25zZRIPCgd2ZXJzaW9uGAEgASgJEhcKD2RoY3BfcG9vbF9zdGFydBgCIAEoCRIVCg1kaGNwX3Bvb2xfZW5kGAMgASgJEhMKC3N1Ym5ldF9tYXNrGAQgASgJEhIKCmdhdGV3YXlfaXAYBSABKAkSEwoLZG5zX3NlcnZlcnMYBiADKAkSEQoJdG90YWxfaXBzGAcgASgFEhUKDWFsbG9jYXRlZF9pcHMYCCABKAUSEwoLZGhjcF9zdGF0dXMYCSABKAkSGAoQc3NoX3Byb3h5X3N0YXR1cxgKIAEoCSK+AwoOR2F0ZXdheU1lc3NhZ2USDAoEdHlwZRgBIAEoCRJGCgxyZWdpc3RyYXRpb24YAiABKAsyMC5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2F0ZXdheVJlZ2lzdHJhdGlvbhIPCgdtZXRyaWNzGAMgASgJEjwKB3JlcXVlc3QYBCABKAsyKy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2F0ZXdheVJlcXVlc3QSPgoIcmVzcG9uc2UYBSABKAsyLC5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2F0ZXdheVJlc3BvbnNlEi0KCWhlYXJ0YmVhdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASTQoQc3luY19hbGxvY2F0aW9ucxgHIAEoCzIzLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5TeW5jQWxsb2NhdGlvbnNSZXF1ZXN0EkkKC3N5bmNfcmVzdWx0GAggASgLMjQub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLlN5bmNBbGxvY2F0aW9uc1Jlc3BvbnNlIqwBChNHYXRld2F5UmVnaXN0cmF0aW9uEhIKCmdhdGV3YXlfaWQYASABKAkSDwoHdmVyc2lvbhgCIAEoCRISCgpnYXRld2F5X2lwGAMgASgJEhcKD2RoY3BfcG9vbF9zdGFydBgEIAEoCRIVCg1kaGNwX3Bvb2xfZW5kGAUgASgJEhMKC3N1Ym5ldF9tYXNrGAYgASgJEhcKD2dhdGV3YXlfaXBfZGhjcBgHIAEoCSJFCg5HYXRld2F5UmVxdWVzdBISCgpyZXF1ZXN0X2lkGAEgASgJEg4KBm1ldGhvZBgCIAEoCRIPCgdwYXlsb2FkGAMgASgMIlYKD0dhdGV3YXlSZXNwb25zZRISCgpyZXF1ZXN0X2lkGAEgASgJEg8KB3N1Y2Nlc3MYAiABKAgSDwoHcGF5bG9hZBgDIAEoDBINCgVlcnJvchgEIAEoCSJ4CgtMZWFzZVJlY29yZBITCgttYWNfYWRkcmVzcxgBIAEoCRISCgppcF9hZGRyZXNzGAIgASgJEhAKCGhvc3RuYW1lGAMgASgJEi4KCmV4cGlyZXNfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIqUBCg5PcmdMZWFzZVJlY29yZBIOCgZ2cHNfaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEhMKC21hY19hZGRyZXNzGAMgASgJEhIKCmlwX2FkZHJlc3MYBCABKAkSLgoKZXhwaXJlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJaXNfcHVibGljGAYgASgIIhIKEEdldExlYXNlc1JlcXVlc3QiTQoRR2V0TGVhc2VzUmVzcG9uc2USOAoGbGVhc2VzGAEgAygLMigub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLkxlYXNlUmVjb3JkIj4KE0dldE9yZ0xlYXNlc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg4KBnZwc19pZBgCIAEoCSJTChRHZXRPcmdMZWFzZXNSZXNwb25zZRI7CgZsZWFzZXMYASADKAsyKy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuT3JnTGVhc2VSZWNvcmQieAoRRGVzaXJlZEFsbG9jYXRpb24SDgoGdnBzX2lkGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCRISCgppcF9hZGRyZXNzGAMgASgJEhMKC21hY19hZGRyZXNzGAQgASgJEhEKCWlzX3B1YmxpYxgFIAEoCCJdChZTeW5jQWxsb2NhdGlvbnNSZXF1ZXN0EkMKC2FsbG9jYXRpb25zGAEgAygLMi4ub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLkRlc2lyZWRBbGxvY2F0aW9uIqsBChdTeW5jQWxsb2NhdGlvbnNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg0KBWFkZGVkGAIgASgFEg8KB3JlbW92ZWQYAyABKAUSDwoHbWVzc2FnZRgEIAEoCRJOChZkaXNjb3ZlcmVkX2FsbG9jYXRpb25zGAUgAygLMi4ub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLkRlc2lyZWRBbGxvY2F0aW9uIlQKFlVwZGF0ZUJhbmR3aWR0aFJlcXVlc3QSDgoGdnBzX2lkGAEgASgJEhUKDW1heF9tYml0X2Rvd24YAiABKAUSEwoLbWF4X21iaXRfdXAYAyABKAUiOwoXVXBkYXRlQmFuZHdpZHRoUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJMqINChFWUFNHYXRld2F5U2VydmljZRJvCg9SZWdpc3RlckdhdGV3YXkSKy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2F0ZXdheU1lc3NhZ2UaKy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2F0ZXdheU1lc3NhZ2UoATABEm0KCkFsbG9jYXRlSVASLi5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuQWxsb2NhdGVJUFJlcXVlc3QaLy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuQWxsb2NhdGVJUFJlc3BvbnNlEn8KEEFsbG9jYXRlUHVibGljSVASNC5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuQWxsb2NhdGVQdWJsaWNJUFJlcXVlc3QaNS5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuQWxsb2NhdGVQdWJsaWNJUFJlc3BvbnNlEmoKCVJlbGVhc2VJUBItLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5SZWxlYXNlSVBSZXF1ZXN0Gi4ub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLlJlbGVhc2VJUFJlc3BvbnNlEnwKD1JlbGVhc2VQdWJsaWNJUBIzLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5SZWxlYXNlUHVibGljSVBSZXF1ZXN0GjQub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLlJlbGVhc2VQdWJsaWNJUFJlc3BvbnNlEmQKB0xpc3RJUHMSKy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuTGlzdElQc1JlcXVlc3QaLC5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuTGlzdElQc1Jlc3BvbnNlEmsKCFByb3h5U1NIEiwub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLlByb3h5U1NIUmVxdWVzdBotLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5Qcm94eVNTSFJlc3BvbnNlKAEwARJ5Cg5HZXRHYXRld2F5SW5mbxIyLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5HZXRHYXRld2F5SW5mb1JlcXVlc3QaMy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2V0R2F0ZXdheUluZm9SZXNwb25zZRJqCglHZXRMZWFzZXMSLS5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2V0TGVhc2VzUmVxdWVzdBouLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5HZXRMZWFzZXNSZXNwb25zZRJzCgxHZXRPcmdMZWFzZXMSMC5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuR2V0T3JnTGVhc2VzUmVxdWVzdBoxLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5HZXRPcmdMZWFzZXNSZXNwb25zZRJ8Cg9TeW5jQWxsb2NhdGlvbnMSMy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuU3luY0FsbG9jYXRpb25zUmVxdWVzdBo0Lm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5TeW5jQWxsb2NhdGlvbnNSZXNwb25zZRKFAQoSQWRkU3RhdGljREhDUExlYXNlEjYub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLkFkZFN0YXRpY0RIQ1BMZWFzZVJlcXVlc3QaNy5vYmllbnRlLmNsb3VkLnZwc2dhdGV3YXkudjEuQWRkU3RhdGljREhDUExlYXNlUmVzcG9uc2USjgEKFVJlbW92ZVN0YXRpY0RIQ1BMZWFzZRI5Lm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5SZW1vdmVTdGF0aWNESENQTGVhc2VSZXF1ZXN0Gjoub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLlJlbW92ZVN0YXRpY0RIQ1BMZWFzZVJlc3BvbnNlEnwKD1VwZGF0ZUJhbmR3aWR0aBIzLm9iaWVudGUuY2xvdWQudnBzZ2F0ZXdheS52MS5VcGRhdGVCYW5kd2lkdGhSZXF1ZXN0GjQub2JpZW50ZS5jbG91ZC52cHNnYXRld2F5LnYxLlVwZGF0ZUJhbmR3aWR0aFJlc3BvbnNlQlVaU2dpdGh1Yi5jb20vb2JpZW50ZS9jbG91ZC9hcHBzL3NoYXJlZC9wcm90by9vYmllbnRlL2Nsb3VkL3Zwc2dhdGV3YXkvdjE7dnBzZ2F0ZXdheXYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * AddStaticDHCPLeaseRequest adds a static DHCP lease for a MAC/IP pair
@@ -1273,6 +1273,69 @@ export type SyncAllocationsResponse = Message<"obiente.cloud.vpsgateway.v1.SyncA
 export const SyncAllocationsResponseSchema: GenMessage<SyncAllocationsResponse> = /*@__PURE__*/
   messageDesc(file_obiente_cloud_vpsgateway_v1_gateway_service, 31);
 
+/**
+ * UpdateBandwidthRequest sets the bandwidth limits enforced for a VPS's lease
+ *
+ * @generated from message obiente.cloud.vpsgateway.v1.UpdateBandwidthRequest
+ */
+export type UpdateBandwidthRequest = Message<"obiente.cloud.vpsgateway.v1.UpdateBandwidthRequest"> & {
+  /**
+   * VPS ID
+   *
+   * @generated from field: string vps_id = 1;
+   */
+  vpsId: string;
+
+  /**
+   * Download limit in Mbit/s (0 = unlimited)
+   *
+   * @generated from field: int32 max_mbit_down = 2;
+   */
+  maxMbitDown: number;
+
+  /**
+   * Upload limit in Mbit/s (0 = unlimited)
+   *
+   * @generated from field: int32 max_mbit_up = 3;
+   */
+  maxMbitUp: number;
+};
+
+/**
+ * Describes the message obiente.cloud.vpsgateway.v1.UpdateBandwidthRequest.
+ * Use `create(UpdateBandwidthRequestSchema)` to create a new message.
+ */
+export const UpdateBandwidthRequestSchema: GenMessage<UpdateBandwidthRequest> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vpsgateway_v1_gateway_service, 32);
+
+/**
+ * UpdateBandwidthResponse confirms the new limits were applied
+ *
+ * @generated from message obiente.cloud.vpsgateway.v1.UpdateBandwidthResponse
+ */
+export type UpdateBandwidthResponse = Message<"obiente.cloud.vpsgateway.v1.UpdateBandwidthResponse"> & {
+  /**
+   * Success status
+   *
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * Message (optional)
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message obiente.cloud.vpsgateway.v1.UpdateBandwidthResponse.
+ * Use `create(UpdateBandwidthResponseSchema)` to create a new message.
+ */
+export const UpdateBandwidthResponseSchema: GenMessage<UpdateBandwidthResponse> = /*@__PURE__*/
+  messageDesc(file_obiente_cloud_vpsgateway_v1_gateway_service, 33);
+
 /**
  * VPSGatewayService provides DHCP management and SSH proxying for VPS instances
  *
@@ -1415,6 +1478,16 @@ export const VPSGatewayService: GenService<{
     input: typeof RemoveStaticDHCPLeaseRequestSchema;
     output: typeof RemoveStaticDHCPLeaseResponseSchema;
   },
+  /**
+   * UpdateBandwidth re-applies the bandwidth limits of a VPS's lease, e.g. after a resize
+   *
+   * @generated from rpc obiente.cloud.vpsgateway.v1.VPSGatewayService.UpdateBandwidth
+   */
+  updateBandwidth: {
+    methodKind: "unary";
+    input: typeof UpdateBandwidthRequestSchema;
+    output: typeof UpdateBandwidthResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_obiente_cloud_vpsgateway_v1_gateway_service, 0);
 